@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+
+	appserver "github.com/eslsoft/lession/internal/app/server"
+)
+
+var analyticsExportDay string
+
+var analyticsCmd = &cobra.Command{
+	Use:   "analytics",
+	Short: "Manage engagement analytics",
+}
+
+var analyticsExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export engagement analytics to the configured warehouse",
+	Long: `Export the engagement rollups, and the playback sessions when
+ANALYTICS_EXPORT_PLAYBACK_SESSIONS is set, to the warehouse from
+ANALYTICS_EXPORT_SINK. Without --day the days the scheduler exports are
+exported; exporting a day again replaces its data in the warehouse.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_ = godotenv.Load()
+		admin, err := appserver.InitializeAdmin()
+		if err != nil {
+			return err
+		}
+		defer admin.Close()
+
+		var exported int
+		if analyticsExportDay == "" {
+			exported, err = admin.Analytics.ExportRecentAnalytics(cmd.Context())
+		} else {
+			day, parseErr := time.Parse(time.DateOnly, analyticsExportDay)
+			if parseErr != nil {
+				return fmt.Errorf("--day must be a date like 2006-01-02")
+			}
+			exported, err = admin.Analytics.ExportAnalytics(cmd.Context(), day)
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "exported %d rows\n", exported)
+		return nil
+	},
+}
+
+func init() {
+	analyticsExportCmd.Flags().StringVar(&analyticsExportDay, "day", "", "UTC day to export, as YYYY-MM-DD")
+	analyticsCmd.AddCommand(analyticsExportCmd)
+	rootCmd.AddCommand(analyticsCmd)
+}
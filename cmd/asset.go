@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+)
+
+var assetFlags catalogFlags
+
+var assetCmd = &cobra.Command{
+	Use:   "asset",
+	Short: "Manage media assets",
+}
+
+var assetListOpts struct {
+	status    string
+	mediaType string
+	pageSize  uint32
+	pageToken string
+}
+
+var assetListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List assets",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &lessionv1.ListAssetsRequest{PageSize: assetListOpts.pageSize, PageToken: assetListOpts.pageToken}
+		if assetListOpts.status != "" {
+			status, ok := lessionv1.AssetStatus_value["ASSET_STATUS_"+strings.ToUpper(assetListOpts.status)]
+			if !ok || status == int32(lessionv1.AssetStatus_ASSET_STATUS_UNSPECIFIED) {
+				return fmt.Errorf("unknown asset status %q", assetListOpts.status)
+			}
+			req.Statuses = []lessionv1.AssetStatus{lessionv1.AssetStatus(status)}
+		}
+		if assetListOpts.mediaType != "" {
+			mediaType, ok := lessionv1.MediaType_value["MEDIA_TYPE_"+strings.ToUpper(assetListOpts.mediaType)]
+			if !ok || mediaType == int32(lessionv1.MediaType_MEDIA_TYPE_UNSPECIFIED) {
+				return fmt.Errorf("unknown asset type %q", assetListOpts.mediaType)
+			}
+			req.Types = []lessionv1.MediaType{lessionv1.MediaType(mediaType)}
+		}
+
+		api, err := assetFlags.open()
+		if err != nil {
+			return err
+		}
+		defer api.close()
+
+		res, err := api.assets.ListAssets(cmd.Context(), connect.NewRequest(req))
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tTYPE\tSTATUS\tUPDATED\tFILENAME")
+		for _, asset := range res.Msg.GetAssets() {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+				asset.GetId(),
+				strings.ToLower(strings.TrimPrefix(asset.GetType().String(), "MEDIA_TYPE_")),
+				strings.ToLower(strings.TrimPrefix(asset.GetStatus().String(), "ASSET_STATUS_")),
+				asset.GetUpdatedAt().AsTime().Format(time.RFC3339),
+				asset.GetOriginalFilename(),
+			)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		if token := res.Msg.GetNextPageToken(); token != "" {
+			fmt.Fprintf(cmd.ErrOrStderr(), "more results: --page-token %s\n", token)
+		}
+		return nil
+	},
+}
+
+var assetPurgeCmd = &cobra.Command{
+	Use:   "purge ASSET_ID...",
+	Short: "Permanently delete assets",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ids := make([]uuid.UUID, len(args))
+		for i, arg := range args {
+			id, err := uuid.Parse(arg)
+			if err != nil {
+				return fmt.Errorf("invalid asset id %q", arg)
+			}
+			ids[i] = id
+		}
+
+		api, err := assetFlags.open()
+		if err != nil {
+			return err
+		}
+		defer api.close()
+
+		// Keep going past failures so one bad id does not stop a batch.
+		var errs []error
+		for _, id := range ids {
+			if _, err := api.assets.DeleteAsset(cmd.Context(), connect.NewRequest(&lessionv1.DeleteAssetRequest{
+				AssetId:    id.String(),
+				HardDelete: true,
+			})); err != nil {
+				errs = append(errs, fmt.Errorf("purge asset %s: %w", id, err))
+				continue
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), id)
+		}
+		return errors.Join(errs...)
+	},
+}
+
+func init() {
+	assetFlags.register(assetCmd)
+
+	assetListCmd.Flags().StringVar(&assetListOpts.status, "status", "", "only list assets in this status: pending, processing, ready, failed or deleted")
+	assetListCmd.Flags().StringVar(&assetListOpts.mediaType, "type", "", "only list assets of this type: video or audio")
+	assetListCmd.Flags().Uint32Var(&assetListOpts.pageSize, "page-size", 0, "maximum number of assets to list")
+	assetListCmd.Flags().StringVar(&assetListOpts.pageToken, "page-token", "", "continue a previous listing")
+
+	assetCmd.AddCommand(assetListCmd, assetPurgeCmd)
+	rootCmd.AddCommand(assetCmd)
+}
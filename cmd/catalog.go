@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+
+	appserver "github.com/eslsoft/lession/internal/app/server"
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// catalogFlags select how the catalog commands reach the catalog: through
+// the Connect API of a running server, or directly through the database.
+type catalogFlags struct {
+	server string
+	apiKey string
+	direct bool
+}
+
+func (f *catalogFlags) register(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&f.server, "server", valueOrEnv("LESSION_SERVER", "http://localhost:8080"), "base URL of the lession API (env LESSION_SERVER)")
+	cmd.PersistentFlags().StringVar(&f.apiKey, "api-key", os.Getenv("LESSION_API_KEY"), "API key sent as a bearer token (env LESSION_API_KEY)")
+	cmd.PersistentFlags().BoolVar(&f.direct, "direct", false, "use the database from DATABASE_URL instead of the API")
+}
+
+// seriesAPI is the part of the series service the CLI uses. Both the Connect
+// client and the server-side handler implement it.
+type seriesAPI interface {
+	ListSeries(context.Context, *connect.Request[lessionv1.ListSeriesRequest]) (*connect.Response[lessionv1.ListSeriesResponse], error)
+	CreateSeries(context.Context, *connect.Request[lessionv1.CreateSeriesRequest]) (*connect.Response[lessionv1.CreateSeriesResponse], error)
+	UpdateSeries(context.Context, *connect.Request[lessionv1.UpdateSeriesRequest]) (*connect.Response[lessionv1.UpdateSeriesResponse], error)
+}
+
+// assetAPI is the part of the asset service the CLI uses.
+type assetAPI interface {
+	ListAssets(context.Context, *connect.Request[lessionv1.ListAssetsRequest]) (*connect.Response[lessionv1.ListAssetsResponse], error)
+	DeleteAsset(context.Context, *connect.Request[lessionv1.DeleteAssetRequest]) (*connect.Response[lessionv1.DeleteAssetResponse], error)
+}
+
+// packageExporter downloads LMS packages of a series.
+type packageExporter func(ctx context.Context, seriesID uuid.UUID, format core.PackageFormat) (*core.ContentPackage, error)
+
+// directCaller exports packages in direct mode with the entitlements of an
+// API key scoped to everything, as the CLI already has database access.
+var directCaller = core.Caller{UserID: "cli", APIKeyScopes: []string{core.APIKeyScopeAll}}
+
+// catalog bundles the catalog operations of the selected mode.
+type catalog struct {
+	series seriesAPI
+	assets assetAPI
+	export packageExporter
+	close  func() error
+}
+
+func (f *catalogFlags) open() (*catalog, error) {
+	if f.direct {
+		_ = godotenv.Load()
+		admin, err := appserver.InitializeAdmin()
+		if err != nil {
+			return nil, err
+		}
+		return &catalog{
+			series: admin.Series,
+			assets: admin.Assets,
+			export: func(ctx context.Context, seriesID uuid.UUID, format core.PackageFormat) (*core.ContentPackage, error) {
+				return admin.Exports.ExportSeriesPackage(core.NewCallerContext(ctx, directCaller), seriesID, format)
+			},
+			close: admin.Close,
+		}, nil
+	}
+
+	base := strings.TrimRight(f.server, "/")
+	if _, err := url.ParseRequestURI(base); err != nil {
+		return nil, fmt.Errorf("invalid --server %q: %w", f.server, err)
+	}
+	client := &http.Client{Transport: bearerTransport{apiKey: f.apiKey, next: http.DefaultTransport}}
+	return &catalog{
+		series: lessionv1connect.NewSeriesServiceClient(client, base),
+		assets: lessionv1connect.NewAssetServiceClient(client, base),
+		export: func(ctx context.Context, seriesID uuid.UUID, format core.PackageFormat) (*core.ContentPackage, error) {
+			return downloadSeriesPackage(ctx, client, base, seriesID, format)
+		},
+		close: func() error { return nil },
+	}, nil
+}
+
+// bearerTransport authenticates every request with an API key.
+type bearerTransport struct {
+	apiKey string
+	next   http.RoundTripper
+}
+
+func (t bearerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.apiKey == "" {
+		return t.next.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.apiKey)
+	return t.next.RoundTrip(req)
+}
+
+// downloadSeriesPackage fetches a package from the export endpoint, which is
+// served over plain HTTP rather than Connect.
+func downloadSeriesPackage(ctx context.Context, client *http.Client, base string, seriesID uuid.UUID, format core.PackageFormat) (*core.ContentPackage, error) {
+	endpoint := fmt.Sprintf("%s/exports/v1/series/%s/package?format=%s", base, seriesID, packageFormatName(format))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("export series %s: %s: %s", seriesID, res.Status, strings.TrimSpace(string(body)))
+	}
+
+	filename := seriesID.String() + ".zip"
+	if _, params, err := mime.ParseMediaType(res.Header.Get("Content-Disposition")); err == nil && params["filename"] != "" {
+		filename = params["filename"]
+	}
+	return &core.ContentPackage{Filename: filename, Data: body}, nil
+}
+
+// packageFormats maps the names the export endpoint accepts to formats.
+var packageFormats = map[string]core.PackageFormat{
+	"scorm12": core.PackageFormatSCORM12,
+	"xapi":    core.PackageFormatXAPI,
+}
+
+func packageFormatName(format core.PackageFormat) string {
+	for name, candidate := range packageFormats {
+		if candidate == format {
+			return name
+		}
+	}
+	return ""
+}
+
+func valueOrEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+
+	"github.com/eslsoft/lession/internal/adapter/db"
+	appserver "github.com/eslsoft/lession/internal/app/server"
+	"github.com/eslsoft/lession/internal/config"
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Apply, revert or inspect database migrations",
+}
+
+var migrateUpOpts struct {
+	steps    int
+	baseline string
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "Apply pending migrations",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withMigrator(func(migrator *db.Migrator) error {
+			if migrateUpOpts.baseline != "" {
+				if err := migrator.Baseline(cmd.Context(), migrateUpOpts.baseline); err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "baseline %s\n", migrateUpOpts.baseline)
+			}
+			applied, err := migrator.Up(cmd.Context(), migrateUpOpts.steps)
+			for _, version := range applied {
+				fmt.Fprintf(cmd.OutOrStdout(), "applied %s\n", version)
+			}
+			if err == nil && len(applied) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "no pending migrations")
+			}
+			return err
+		})
+	},
+}
+
+var migrateDownOpts struct {
+	steps int
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:   "down",
+	Short: "Revert the latest migrations",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withMigrator(func(migrator *db.Migrator) error {
+			reverted, err := migrator.Down(cmd.Context(), migrateDownOpts.steps)
+			for _, version := range reverted {
+				fmt.Fprintf(cmd.OutOrStdout(), "reverted %s\n", version)
+			}
+			return err
+		})
+	},
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "List migrations and whether they are applied",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withMigrator(func(migrator *db.Migrator) error {
+			statuses, err := migrator.Status(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "VERSION\tDESCRIPTION\tAPPLIED")
+			for _, status := range statuses {
+				applied := "pending"
+				switch {
+				case status.Baseline:
+					applied = "baseline"
+				case status.AppliedAt != nil:
+					applied = status.AppliedAt.Format(time.RFC3339)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\n", status.Version, status.Description, applied)
+			}
+			return w.Flush()
+		})
+	},
+}
+
+// withMigrator runs fn with a migrator on the database from DATABASE_URL.
+// The versioned migrations are written for PostgreSQL; MySQL and SQLite
+// databases are migrated from the Ent schema when the server starts.
+func withMigrator(fn func(*db.Migrator) error) error {
+	_ = godotenv.Load()
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	if cfg.DatabaseDialect != dialect.Postgres {
+		return fmt.Errorf("versioned migrations need PostgreSQL; %s databases are migrated on start with AUTO_MIGRATE", cfg.DatabaseDialect)
+	}
+	database, err := appserver.NewDatabase(cfg)
+	if err != nil {
+		return err
+	}
+	defer database.Close()
+	return fn(appserver.NewMigrator(database))
+}
+
+func init() {
+	migrateUpCmd.Flags().IntVar(&migrateUpOpts.steps, "steps", 0, "maximum number of migrations to apply, all when zero")
+	migrateUpCmd.Flags().StringVar(&migrateUpOpts.baseline, "baseline", "", "mark migrations up to this version as applied first, for a database created before versioned migrations")
+	migrateDownCmd.Flags().IntVar(&migrateDownOpts.steps, "steps", 1, "number of migrations to revert")
+
+	migrateCmd.AddCommand(migrateUpCmd, migrateDownCmd, migrateStatusCmd)
+	rootCmd.AddCommand(migrateCmd)
+}
@@ -5,12 +5,24 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+
+	"github.com/eslsoft/lession/internal/config"
 )
 
+var configFile string
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "lession",
 	Short: "Lesson service CLI",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// config.Load reads the file named by the environment, so the flag
+		// reaches every command that loads configuration.
+		if configFile != "" {
+			return os.Setenv(config.FileEnv, configFile)
+		}
+		return nil
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -21,6 +33,10 @@ func Execute() {
 	}
 }
 
+func init() {
+	rootCmd.PersistentFlags().StringVar(&configFile, "config", "", "YAML or TOML configuration file, overridden by environment variables (default $"+config.FileEnv+")")
+}
+
 // WithRootContext allows external callers to inject a context when executing the CLI.
 func WithRootContext(ctx context.Context) {
 	rootCmd.SetContext(ctx)
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+
+	appserver "github.com/eslsoft/lession/internal/app/server"
+)
+
+var searchCmd = &cobra.Command{
+	Use:   "search",
+	Short: "Maintain the content search index",
+}
+
+var searchReindexCmd = &cobra.Command{
+	Use:   "reindex",
+	Short: "Index every published series and episode in the configured search engine",
+	Long: `Index every published series and episode in the search engine from
+SEARCH_ENGINE, to fill a new index or repair one that missed events. The
+database search needs no index, so without a search engine this does
+nothing.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_ = godotenv.Load()
+		admin, err := appserver.InitializeAdmin()
+		if err != nil {
+			return err
+		}
+		defer admin.Close()
+
+		indexed, err := admin.Search.ReindexContent(cmd.Context())
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "indexed %d documents\n", indexed)
+		return nil
+	},
+}
+
+var searchReembedCmd = &cobra.Command{
+	Use:   "reembed",
+	Short: "Embed every published series and episode for semantic search",
+	Long: `Embed every published series and episode with the model from
+EMBEDDING_PROVIDER, to fill the embeddings after enabling semantic search or
+switching models. Content whose text and model are unchanged keeps its
+vector, so running this again is cheap.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_ = godotenv.Load()
+		admin, err := appserver.InitializeAdmin()
+		if err != nil {
+			return err
+		}
+		defer admin.Close()
+
+		embedded, err := admin.Semantic.ReembedContent(cmd.Context())
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "embedded %d documents\n", embedded)
+		return nil
+	},
+}
+
+func init() {
+	searchCmd.AddCommand(searchReindexCmd)
+	searchCmd.AddCommand(searchReembedCmd)
+	rootCmd.AddCommand(searchCmd)
+}
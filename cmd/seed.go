@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+
+	"github.com/eslsoft/lession/internal/adapter/fixtures"
+	appserver "github.com/eslsoft/lession/internal/app/server"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+var seedOpts struct {
+	file string
+	demo bool
+}
+
+var seedCmd = &cobra.Command{
+	Use:   "seed",
+	Short: "Load sample content into the database from DATABASE_URL",
+	Long: `Load sample assets, plans, series with their episodes and subscribed
+learners from a fixtures file, or the built-in demo content with --demo.
+Seeding again skips whatever already exists, so it is safe to repeat.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if (seedOpts.file == "") == !seedOpts.demo {
+			return fmt.Errorf("provide exactly one of --file and --demo")
+		}
+
+		var content core.Fixtures
+		var err error
+		if seedOpts.demo {
+			content, err = fixtures.Demo()
+		} else {
+			content, err = readFixtures(seedOpts.file)
+		}
+		if err != nil {
+			return err
+		}
+
+		_ = godotenv.Load()
+		admin, err := appserver.InitializeAdmin()
+		if err != nil {
+			return err
+		}
+		defer admin.Close()
+
+		result, err := admin.Seeds.Seed(cmd.Context(), content)
+		if result != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "created %d assets, %d plans, %d series and %d subscriptions; skipped %d existing\n",
+				result.Assets, result.Plans, result.Series, result.Subscriptions, result.Skipped)
+		}
+		return err
+	},
+}
+
+func readFixtures(path string) (core.Fixtures, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return core.Fixtures{}, err
+	}
+	defer f.Close()
+	return fixtures.Decode(f)
+}
+
+func init() {
+	seedCmd.Flags().StringVarP(&seedOpts.file, "file", "f", "", "YAML fixtures file to load")
+	seedCmd.Flags().BoolVar(&seedOpts.demo, "demo", false, "load the built-in demo content")
+	rootCmd.AddCommand(seedCmd)
+}
@@ -0,0 +1,215 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+)
+
+var seriesFlags catalogFlags
+
+var seriesCmd = &cobra.Command{
+	Use:   "series",
+	Short: "Manage catalog series",
+}
+
+var seriesListOpts struct {
+	status    string
+	pageSize  uint32
+	pageToken string
+}
+
+var seriesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List series",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &lessionv1.ListSeriesRequest{PageSize: seriesListOpts.pageSize, PageToken: seriesListOpts.pageToken}
+		if seriesListOpts.status != "" {
+			status, err := parseSeriesStatus(seriesListOpts.status)
+			if err != nil {
+				return err
+			}
+			req.Statuses = []lessionv1.SeriesStatus{status}
+		}
+
+		api, err := seriesFlags.open()
+		if err != nil {
+			return err
+		}
+		defer api.close()
+
+		res, err := api.series.ListSeries(cmd.Context(), connect.NewRequest(req))
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tSLUG\tSTATUS\tEPISODES\tTITLE")
+		for _, series := range res.Msg.GetSeries() {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n", series.GetId(), series.GetSlug(), seriesStatusName(series.GetStatus()), series.GetEpisodeCount(), series.GetTitle())
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		if token := res.Msg.GetNextPageToken(); token != "" {
+			fmt.Fprintf(cmd.ErrOrStderr(), "more results: --page-token %s\n", token)
+		}
+		return nil
+	},
+}
+
+var seriesCreateOpts struct {
+	slug      string
+	title     string
+	summary   string
+	language  string
+	level     string
+	tags      []string
+	authorIDs []string
+}
+
+var seriesCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a draft series",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		api, err := seriesFlags.open()
+		if err != nil {
+			return err
+		}
+		defer api.close()
+
+		res, err := api.series.CreateSeries(cmd.Context(), connect.NewRequest(&lessionv1.CreateSeriesRequest{
+			Series: &lessionv1.SeriesDraft{
+				Slug:      seriesCreateOpts.slug,
+				Title:     seriesCreateOpts.title,
+				Summary:   seriesCreateOpts.summary,
+				Language:  seriesCreateOpts.language,
+				Level:     seriesCreateOpts.level,
+				Tags:      seriesCreateOpts.tags,
+				AuthorIds: seriesCreateOpts.authorIDs,
+				Status:    lessionv1.SeriesStatus_SERIES_STATUS_DRAFT,
+			},
+		}))
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), res.Msg.GetSeries().GetId())
+		return nil
+	},
+}
+
+var seriesPublishCmd = &cobra.Command{
+	Use:   "publish SERIES_ID",
+	Short: "Publish a series",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := uuid.Parse(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid series id %q", args[0])
+		}
+
+		api, err := seriesFlags.open()
+		if err != nil {
+			return err
+		}
+		defer api.close()
+
+		res, err := api.series.UpdateSeries(cmd.Context(), connect.NewRequest(&lessionv1.UpdateSeriesRequest{
+			SeriesId:   id.String(),
+			Series:     &lessionv1.SeriesDraft{Status: lessionv1.SeriesStatus_SERIES_STATUS_PUBLISHED},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"status"}},
+		}))
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "%s %s\n", res.Msg.GetSeries().GetId(), seriesStatusName(res.Msg.GetSeries().GetStatus()))
+		return nil
+	},
+}
+
+var seriesExportOpts struct {
+	format string
+	output string
+}
+
+var seriesExportCmd = &cobra.Command{
+	Use:   "export SERIES_ID",
+	Short: "Download a series as an LMS package",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := uuid.Parse(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid series id %q", args[0])
+		}
+		format, ok := packageFormats[seriesExportOpts.format]
+		if !ok {
+			return fmt.Errorf("--format must be \"scorm12\" or \"xapi\"")
+		}
+
+		api, err := seriesFlags.open()
+		if err != nil {
+			return err
+		}
+		defer api.close()
+
+		pkg, err := api.export(cmd.Context(), id, format)
+		if err != nil {
+			return err
+		}
+		output := seriesExportOpts.output
+		if output == "" {
+			output = pkg.Filename
+		}
+		if err := os.WriteFile(output, pkg.Data, 0o644); err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), output)
+		return nil
+	},
+}
+
+func parseSeriesStatus(value string) (lessionv1.SeriesStatus, error) {
+	status, ok := lessionv1.SeriesStatus_value["SERIES_STATUS_"+strings.ToUpper(value)]
+	if !ok || status == int32(lessionv1.SeriesStatus_SERIES_STATUS_UNSPECIFIED) {
+		return 0, fmt.Errorf("unknown series status %q", value)
+	}
+	return lessionv1.SeriesStatus(status), nil
+}
+
+func seriesStatusName(status lessionv1.SeriesStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "SERIES_STATUS_"))
+}
+
+func init() {
+	seriesFlags.register(seriesCmd)
+
+	seriesListCmd.Flags().StringVar(&seriesListOpts.status, "status", "", "only list series in this status: draft, published or archived")
+	seriesListCmd.Flags().Uint32Var(&seriesListOpts.pageSize, "page-size", 0, "maximum number of series to list")
+	seriesListCmd.Flags().StringVar(&seriesListOpts.pageToken, "page-token", "", "continue a previous listing")
+
+	seriesCreateCmd.Flags().StringVar(&seriesCreateOpts.slug, "slug", "", "unique URL slug")
+	seriesCreateCmd.Flags().StringVar(&seriesCreateOpts.title, "title", "", "series title")
+	seriesCreateCmd.Flags().StringVar(&seriesCreateOpts.summary, "summary", "", "short synopsis")
+	seriesCreateCmd.Flags().StringVar(&seriesCreateOpts.language, "language", "", "ISO 639-1 language code")
+	seriesCreateCmd.Flags().StringVar(&seriesCreateOpts.level, "level", "", "difficulty level")
+	seriesCreateCmd.Flags().StringSliceVar(&seriesCreateOpts.tags, "tag", nil, "tag, repeatable")
+	seriesCreateCmd.Flags().StringSliceVar(&seriesCreateOpts.authorIDs, "author", nil, "author id, repeatable")
+	_ = seriesCreateCmd.MarkFlagRequired("slug")
+	_ = seriesCreateCmd.MarkFlagRequired("title")
+
+	seriesExportCmd.Flags().StringVar(&seriesExportOpts.format, "format", "scorm12", "package format: scorm12 or xapi")
+	seriesExportCmd.Flags().StringVarP(&seriesExportOpts.output, "output", "o", "", "file to write, defaults to the package's file name")
+
+	seriesCmd.AddCommand(seriesListCmd, seriesCreateCmd, seriesPublishCmd, seriesExportCmd)
+	rootCmd.AddCommand(seriesCmd)
+}
@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+
+	appserver "github.com/eslsoft/lession/internal/app/server"
+)
+
+var workerCmd = &cobra.Command{
+	Use:   "worker",
+	Short: "Run background jobs without serving HTTP",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_ = godotenv.Load()
+
+		worker, err := appserver.InitializeWorker()
+		if err != nil {
+			return err
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		return worker.Run(ctx)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(workerCmd)
+}
@@ -0,0 +1,208 @@
+// Package aeneas force-aligns transcripts with speech by running the aeneas
+// command line tool (https://www.readbeyond.it/aeneas/).
+package aeneas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// ProviderName identifies the aligner in configuration.
+	ProviderName = "aeneas"
+
+	maxOutputSize = 4096
+)
+
+// languages maps BCP 47 primary language subtags to the ISO 639-3 codes
+// aeneas expects.
+var languages = map[string]string{
+	"ar": "ara", "de": "deu", "en": "eng", "es": "spa", "fr": "fra",
+	"hi": "hin", "id": "ind", "it": "ita", "ja": "jpn", "ko": "kor",
+	"nl": "nld", "pl": "pol", "pt": "por", "ru": "rus", "sv": "swe",
+	"th": "tha", "tr": "tur", "uk": "ukr", "vi": "vie", "zh": "cmn",
+}
+
+// Aligner implements core.TranscriptAligner by downloading the audio and
+// running aeneas on it, one sentence per text fragment.
+type Aligner struct {
+	python     string
+	httpClient *http.Client
+	// run executes a command and returns its combined output.
+	run func(ctx context.Context, name string, args ...string) ([]byte, error)
+}
+
+// NewAligner constructs an aligner running aeneas with the given Python
+// interpreter, which must have the aeneas package installed.
+func NewAligner(python string) *Aligner {
+	if python == "" {
+		python = "python3"
+	}
+	return &Aligner{
+		python:     python,
+		httpClient: &http.Client{Timeout: 10 * time.Minute},
+		run: func(ctx context.Context, name string, args ...string) ([]byte, error) {
+			return exec.CommandContext(ctx, name, args...).CombinedOutput()
+		},
+	}
+}
+
+// WithHTTPClient overrides the HTTP client used to download audio.
+func (a *Aligner) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		a.httpClient = client
+	}
+}
+
+var _ core.TranscriptAligner = (*Aligner)(nil)
+
+// AlignTranscript returns one segment per sentence of the request.
+func (a *Aligner) AlignTranscript(ctx context.Context, req core.AlignmentRequest) ([]core.TranscriptSegment, error) {
+	language, err := taskLanguage(req.Language)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Sentences) == 0 {
+		return nil, errors.New("aeneas: no sentences to align")
+	}
+
+	dir, err := os.MkdirTemp("", "lession-aeneas-")
+	if err != nil {
+		return nil, fmt.Errorf("aeneas: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	audioPath := filepath.Join(dir, "audio"+audioExtension(req))
+	if err := a.download(ctx, req.AudioURL, audioPath); err != nil {
+		return nil, err
+	}
+	// Sentences are aligned as lines; a line break inside one would split it.
+	lines := make([]string, len(req.Sentences))
+	for i, sentence := range req.Sentences {
+		lines[i] = strings.Join(strings.Fields(sentence), " ")
+	}
+	textPath := filepath.Join(dir, "text.txt")
+	if err := os.WriteFile(textPath, []byte(strings.Join(lines, "\n")+"\n"), 0o600); err != nil {
+		return nil, fmt.Errorf("aeneas: %w", err)
+	}
+	outputPath := filepath.Join(dir, "sync.json")
+
+	config := "task_language=" + language + "|is_text_type=plain|os_task_file_format=json"
+	if output, err := a.run(ctx, a.python, "-m", "aeneas.tools.execute_task", audioPath, textPath, config, outputPath); err != nil {
+		if len(output) > maxOutputSize {
+			output = output[len(output)-maxOutputSize:]
+		}
+		return nil, fmt.Errorf("aeneas: execute task: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		return nil, fmt.Errorf("aeneas: read sync map: %w", err)
+	}
+	return parseSyncMap(data)
+}
+
+func (a *Aligner) download(ctx context.Context, audioURL, dest string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, audioURL, nil)
+	if err != nil {
+		return fmt.Errorf("aeneas: download audio: %w", err)
+	}
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("aeneas: download audio: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("aeneas: download audio: unexpected status %d", resp.StatusCode)
+	}
+
+	f, err := os.Create(dest)
+	if err != nil {
+		return fmt.Errorf("aeneas: %w", err)
+	}
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		f.Close()
+		return fmt.Errorf("aeneas: download audio: %w", err)
+	}
+	return f.Close()
+}
+
+// parseSyncMap reads the fragments of an aeneas JSON sync map.
+func parseSyncMap(data []byte) ([]core.TranscriptSegment, error) {
+	var syncMap struct {
+		Fragments []struct {
+			Begin string   `json:"begin"`
+			End   string   `json:"end"`
+			Lines []string `json:"lines"`
+		} `json:"fragments"`
+	}
+	if err := json.Unmarshal(data, &syncMap); err != nil {
+		return nil, fmt.Errorf("aeneas: decode sync map: %w", err)
+	}
+
+	segments := make([]core.TranscriptSegment, 0, len(syncMap.Fragments))
+	for i, fragment := range syncMap.Fragments {
+		begin, err := strconv.ParseFloat(fragment.Begin, 64)
+		if err != nil {
+			return nil, fmt.Errorf("aeneas: fragment %d: invalid begin %q", i, fragment.Begin)
+		}
+		end, err := strconv.ParseFloat(fragment.End, 64)
+		if err != nil {
+			return nil, fmt.Errorf("aeneas: fragment %d: invalid end %q", i, fragment.End)
+		}
+		segments = append(segments, core.TranscriptSegment{
+			Index: i,
+			Start: seconds(begin),
+			End:   seconds(end),
+			Text:  strings.Join(fragment.Lines, " "),
+		})
+	}
+	return segments, nil
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second)).Round(time.Millisecond)
+}
+
+// taskLanguage returns the aeneas language for a BCP 47 tag, English when
+// the tag is empty.
+func taskLanguage(tag string) (string, error) {
+	if tag == "" {
+		return languages["en"], nil
+	}
+	primary, _, _ := strings.Cut(strings.ToLower(tag), "-")
+	language, ok := languages[primary]
+	if !ok {
+		return "", fmt.Errorf("aeneas: unsupported language %q", tag)
+	}
+	return language, nil
+}
+
+// audioExtension picks a file extension ffmpeg, which aeneas decodes with,
+// can recognise the audio by.
+func audioExtension(req core.AlignmentRequest) string {
+	if u, err := url.Parse(req.AudioURL); err == nil {
+		if ext := path.Ext(u.Path); ext != "" {
+			return ext
+		}
+	}
+	if exts, err := mime.ExtensionsByType(req.MimeType); err == nil && len(exts) > 0 {
+		return exts[0]
+	}
+	return ""
+}
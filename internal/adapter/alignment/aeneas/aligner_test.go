@@ -0,0 +1,85 @@
+package aeneas
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestAligner_AlignTranscript(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ID3 audio"))
+	}))
+	defer server.Close()
+
+	var args []string
+	var text string
+	aligner := NewAligner("")
+	aligner.run = func(_ context.Context, name string, a ...string) ([]byte, error) {
+		args = append([]string{name}, a...)
+		data, _ := os.ReadFile(a[3])
+		text = string(data)
+		syncMap := `{"fragments":[
+			{"begin":"0.000","end":"1.480","id":"f000001","lines":["Hello there."]},
+			{"begin":"1.480","end":"3.250","id":"f000002","lines":["How are you?"]}]}`
+		return nil, os.WriteFile(a[5], []byte(syncMap), 0o600)
+	}
+
+	segments, err := aligner.AlignTranscript(context.Background(), core.AlignmentRequest{
+		AudioURL:  server.URL + "/episode.mp3",
+		Language:  "en-GB",
+		Sentences: []string{"Hello there.", "How are\nyou?"},
+	})
+	if err != nil {
+		t.Fatalf("AlignTranscript() error = %v", err)
+	}
+	if len(segments) != 2 || segments[1].Start != 1480*time.Millisecond || segments[1].End != 3250*time.Millisecond {
+		t.Fatalf("unexpected segments %+v", segments)
+	}
+	if args[0] != "python3" || args[2] != "aeneas.tools.execute_task" || !strings.HasSuffix(args[3], ".mp3") || !strings.Contains(args[5], "task_language=eng") {
+		t.Fatalf("unexpected command %q", args)
+	}
+	if text != "Hello there.\nHow are you?\n" {
+		t.Fatalf("unexpected text file %q", text)
+	}
+}
+
+func TestAligner_AlignTranscriptErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing.mp3" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("audio"))
+	}))
+	defer server.Close()
+
+	failing := func(context.Context, string, ...string) ([]byte, error) {
+		return []byte("ffmpeg not found"), errors.New("exit status 1")
+	}
+	tests := []struct {
+		name    string
+		req     core.AlignmentRequest
+		wantErr string
+	}{
+		{name: "unsupported language", req: core.AlignmentRequest{AudioURL: server.URL + "/a.mp3", Language: "xx", Sentences: []string{"Hi."}}, wantErr: "unsupported language"},
+		{name: "missing audio", req: core.AlignmentRequest{AudioURL: server.URL + "/missing.mp3", Sentences: []string{"Hi."}}, wantErr: "status 404"},
+		{name: "tool failure", req: core.AlignmentRequest{AudioURL: server.URL + "/a.mp3", Sentences: []string{"Hi."}}, wantErr: "ffmpeg not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aligner := NewAligner("python3")
+			aligner.run = failing
+			if _, err := aligner.AlignTranscript(context.Background(), tt.req); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("AlignTranscript() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+// Package openai drafts lessons with the chat completions API of OpenAI or
+// any service compatible with it.
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// ProviderName identifies the generator in configuration.
+	ProviderName = "openai"
+	// DefaultBaseURL is OpenAI's production API endpoint.
+	DefaultBaseURL = "https://api.openai.com/v1"
+	// DefaultModel is used when no model is configured.
+	DefaultModel = "gpt-4o-mini"
+
+	maxErrorBodySize = 4096
+)
+
+// systemPrompt fixes the shape of the JSON the model answers with.
+const systemPrompt = `You write listening lessons for learners of a foreign language.
+Answer with a single JSON object and nothing else, in this shape:
+{"title": string, "summary": string, "script": string,
+ "vocabulary": [{"term": string, "definition": string, "example": string}],
+ "questions": [{"prompt": string, "choices": [string], "answer_index": number, "explanation": string}]}
+The script is a natural dialogue or monologue with one speaker turn per line, written in
+the lesson language at the requested level. Definitions and explanations are simple enough
+for a learner at that level. answer_index is the zero-based index of the correct choice.`
+
+// Generator implements core.EpisodeDraftGenerator with a chat completions
+// model asked for JSON output.
+type Generator struct {
+	baseURL    string
+	apiKey     string
+	model      string
+	httpClient *http.Client
+}
+
+// NewGenerator constructs a generator authenticating with apiKey. model
+// falls back to DefaultModel when empty.
+func NewGenerator(apiKey, model string) (*Generator, error) {
+	if apiKey == "" {
+		return nil, errors.New("openai: api key is required")
+	}
+	if model == "" {
+		model = DefaultModel
+	}
+	return &Generator{
+		baseURL: DefaultBaseURL,
+		apiKey:  apiKey,
+		model:   model,
+		// Drafting a full lesson takes the model a while.
+		httpClient: &http.Client{Timeout: 2 * time.Minute},
+	}, nil
+}
+
+// WithBaseURL points the generator at a compatible API, or a test server.
+func (g *Generator) WithBaseURL(baseURL string) {
+	if baseURL != "" {
+		g.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithHTTPClient overrides the HTTP client used for API requests.
+func (g *Generator) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		g.httpClient = client
+	}
+}
+
+var _ core.EpisodeDraftGenerator = (*Generator)(nil)
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// lessonJSON is the object the system prompt asks the model for.
+type lessonJSON struct {
+	Title      string `json:"title"`
+	Summary    string `json:"summary"`
+	Script     string `json:"script"`
+	Vocabulary []struct {
+		Term       string `json:"term"`
+		Definition string `json:"definition"`
+		Example    string `json:"example"`
+	} `json:"vocabulary"`
+	Questions []struct {
+		Prompt      string   `json:"prompt"`
+		Choices     []string `json:"choices"`
+		AnswerIndex int      `json:"answer_index"`
+		Explanation string   `json:"explanation"`
+	} `json:"questions"`
+}
+
+// GenerateEpisodeDraft asks the model for a lesson on the requested topic.
+func (g *Generator) GenerateEpisodeDraft(ctx context.Context, req core.EpisodeDraftRequest) (*core.GeneratedEpisodeDraft, error) {
+	payload, err := json.Marshal(map[string]any{
+		"model": g.model,
+		"messages": []chatMessage{
+			{Role: "system", Content: systemPrompt},
+			{Role: "user", Content: userPrompt(req)},
+		},
+		"response_format": map[string]string{"type": "json_object"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, g.baseURL+"/chat/completions", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+g.apiKey)
+
+	resp, err := g.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("openai: chat completion: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return nil, fmt.Errorf("openai: chat completion: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var completion struct {
+		Model   string `json:"model"`
+		Choices []struct {
+			Message      chatMessage `json:"message"`
+			FinishReason string      `json:"finish_reason"`
+		} `json:"choices"`
+		Usage struct {
+			PromptTokens     int `json:"prompt_tokens"`
+			CompletionTokens int `json:"completion_tokens"`
+		} `json:"usage"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&completion); err != nil {
+		return nil, fmt.Errorf("openai: decode response: %w", err)
+	}
+	if len(completion.Choices) == 0 {
+		return nil, errors.New("openai: response has no choices")
+	}
+	choice := completion.Choices[0]
+	if choice.FinishReason == "length" {
+		return nil, errors.New("openai: the lesson was cut off at the token limit")
+	}
+
+	var lesson lessonJSON
+	if err := json.Unmarshal([]byte(choice.Message.Content), &lesson); err != nil {
+		return nil, fmt.Errorf("openai: decode lesson: %w", err)
+	}
+
+	draft := &core.GeneratedEpisodeDraft{
+		Title:   strings.TrimSpace(lesson.Title),
+		Summary: strings.TrimSpace(lesson.Summary),
+		Script:  strings.TrimSpace(lesson.Script),
+		Model:   completion.Model,
+		Usage: core.GenerationUsage{
+			PromptTokens:     completion.Usage.PromptTokens,
+			CompletionTokens: completion.Usage.CompletionTokens,
+		},
+	}
+	for _, item := range lesson.Vocabulary {
+		draft.Vocabulary = append(draft.Vocabulary, core.DraftVocabularyItem{Term: item.Term, Definition: item.Definition, Example: item.Example})
+	}
+	for _, question := range lesson.Questions {
+		draft.Questions = append(draft.Questions, core.DraftQuizQuestion{
+			Prompt:      question.Prompt,
+			Choices:     question.Choices,
+			AnswerIndex: question.AnswerIndex,
+			Explanation: question.Explanation,
+		})
+	}
+	return draft, nil
+}
+
+func userPrompt(req core.EpisodeDraftRequest) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Topic: %s\n", req.Topic)
+	if req.Level != "" {
+		fmt.Fprintf(&b, "CEFR level: %s\n", req.Level)
+	}
+	language := req.Language
+	if language == "" {
+		language = "en"
+	}
+	fmt.Fprintf(&b, "Lesson language: %s\n", language)
+	// Speech runs at about 130 words a minute at learner-friendly speeds.
+	fmt.Fprintf(&b, "Script length: about %d words (%d minutes read aloud)\n", int(req.Length.Minutes()*130), int(req.Length.Minutes()))
+	b.WriteString("Include 8 to 12 vocabulary items and 5 quiz questions.")
+	return b.String()
+}
@@ -0,0 +1,107 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestGenerator_GenerateEpisodeDraft(t *testing.T) {
+	var request struct {
+		Model    string        `json:"model"`
+		Messages []chatMessage `json:"messages"`
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /chat/completions", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		lesson := `{"title":"At the café","summary":"Ordering a drink.","script":"A: Hi!\nB: A latte, please.",
+			"vocabulary":[{"term":"latte","definition":"coffee with milk","example":"A latte, please."}],
+			"questions":[{"prompt":"What does B order?","choices":["Tea","A latte"],"answer_index":1,"explanation":"B asks for a latte."}]}`
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"model":   "gpt-4o-mini-2024-07-18",
+			"choices": []any{map[string]any{"message": map[string]string{"role": "assistant", "content": lesson}, "finish_reason": "stop"}},
+			"usage":   map[string]int{"prompt_tokens": 210, "completion_tokens": 640},
+		})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	generator, err := NewGenerator("secret", "")
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+	generator.WithBaseURL(server.URL)
+
+	draft, err := generator.GenerateEpisodeDraft(context.Background(), core.EpisodeDraftRequest{Topic: "Ordering coffee", Level: "A2", Length: 2 * time.Minute})
+	if err != nil {
+		t.Fatalf("GenerateEpisodeDraft() error = %v", err)
+	}
+	if draft.Title != "At the café" || draft.Script != "A: Hi!\nB: A latte, please." || draft.Model != "gpt-4o-mini-2024-07-18" {
+		t.Fatalf("unexpected draft %+v", draft)
+	}
+	if len(draft.Vocabulary) != 1 || draft.Vocabulary[0].Term != "latte" {
+		t.Fatalf("unexpected vocabulary %+v", draft.Vocabulary)
+	}
+	if len(draft.Questions) != 1 || draft.Questions[0].AnswerIndex != 1 || len(draft.Questions[0].Choices) != 2 {
+		t.Fatalf("unexpected questions %+v", draft.Questions)
+	}
+	if draft.Usage.Total() != 850 {
+		t.Fatalf("unexpected usage %+v", draft.Usage)
+	}
+	if request.Model != DefaultModel || len(request.Messages) != 2 || !strings.Contains(request.Messages[1].Content, "CEFR level: A2") {
+		t.Fatalf("unexpected request %+v", request)
+	}
+}
+
+func TestGenerator_GenerateEpisodeDraftErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		wantErr string
+	}{
+		{
+			name: "status",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				http.Error(w, "rate limited", http.StatusTooManyRequests)
+			},
+			wantErr: "unexpected status 429",
+		},
+		{
+			name: "truncated",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"choices":[{"message":{"content":"{\"title\":"},"finish_reason":"length"}]}`))
+			},
+			wantErr: "token limit",
+		},
+		{
+			name: "not json",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"choices":[{"message":{"content":"Sure! Here is a lesson"},"finish_reason":"stop"}]}`))
+			},
+			wantErr: "decode lesson",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+
+			generator, _ := NewGenerator("secret", "gpt-test")
+			generator.WithBaseURL(server.URL)
+			_, err := generator.GenerateEpisodeDraft(context.Background(), core.EpisodeDraftRequest{Topic: "Travel", Length: time.Minute})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("GenerateEpisodeDraft() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,386 @@
+package stripe
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// ProviderName identifies Stripe in webhook routes and persisted records.
+	ProviderName = "stripe"
+	// SignatureHeader carries the webhook signature computed by Stripe.
+	SignatureHeader = "Stripe-Signature"
+	// DefaultAPIBase is Stripe's production API endpoint.
+	DefaultAPIBase = "https://api.stripe.com"
+	// DefaultSignatureTolerance bounds how old a signed webhook may be, to
+	// limit replays.
+	DefaultSignatureTolerance = 5 * time.Minute
+)
+
+// Provider creates Stripe Checkout sessions and decodes Stripe webhooks. It
+// talks to the REST API directly; prices are sent inline so plans need no
+// matching objects in the Stripe dashboard.
+type Provider struct {
+	secretKey     string
+	webhookSecret string
+	apiBase       string
+	httpClient    *http.Client
+	tolerance     time.Duration
+	now           func() time.Time
+}
+
+// NewProvider constructs a Stripe provider from the account's secret API key
+// and the webhook endpoint's signing secret.
+func NewProvider(secretKey, webhookSecret string) (*Provider, error) {
+	if secretKey == "" || webhookSecret == "" {
+		return nil, errors.New("stripe: secret key and webhook secret are required")
+	}
+	return &Provider{
+		secretKey:     secretKey,
+		webhookSecret: webhookSecret,
+		apiBase:       DefaultAPIBase,
+		httpClient:    &http.Client{Timeout: 30 * time.Second},
+		tolerance:     DefaultSignatureTolerance,
+		now:           time.Now,
+	}, nil
+}
+
+// WithClock overrides the clock used to check webhook timestamps.
+func (p *Provider) WithClock(fn func() time.Time) {
+	if fn != nil {
+		p.now = fn
+	}
+}
+
+// WithAPIBase points the provider at a different API endpoint, such as a mock server.
+func (p *Provider) WithAPIBase(base string) {
+	if base != "" {
+		p.apiBase = strings.TrimRight(base, "/")
+	}
+}
+
+// WithHTTPClient overrides the HTTP client used for API calls.
+func (p *Provider) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		p.httpClient = client
+	}
+}
+
+var _ core.BillingProvider = (*Provider)(nil)
+
+// Name reports the provider name.
+func (p *Provider) Name() string {
+	return ProviderName
+}
+
+// CreateCheckoutSession creates a subscription-mode Checkout session. The
+// learner and plan are stored as metadata on both the session and the
+// resulting subscription so webhooks can be attributed.
+func (p *Provider) CreateCheckoutSession(ctx context.Context, req core.CheckoutRequest) (*core.CheckoutSession, error) {
+	interval, err := stripeInterval(req.Plan.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("mode", "subscription")
+	form.Set("success_url", req.SuccessURL)
+	form.Set("cancel_url", req.CancelURL)
+	form.Set("client_reference_id", req.UserID)
+	form.Set("line_items[0][quantity]", "1")
+	form.Set("line_items[0][price_data][currency]", strings.ToLower(req.Plan.Currency))
+	form.Set("line_items[0][price_data][unit_amount]", strconv.FormatInt(req.Plan.PriceCents, 10))
+	form.Set("line_items[0][price_data][recurring][interval]", interval)
+	form.Set("line_items[0][price_data][product_data][name]", req.Plan.Name)
+	for _, prefix := range []string{"metadata", "subscription_data[metadata]"} {
+		form.Set(prefix+"[user_id]", req.UserID)
+		form.Set(prefix+"[plan_id]", req.Plan.ID.String())
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.apiBase+"/v1/checkout/sessions", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("stripe: build checkout request: %w", err)
+	}
+	httpReq.SetBasicAuth(p.secretKey, "")
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("stripe: create checkout session: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("stripe: read checkout response: %w", err)
+	}
+	if resp.StatusCode/100 != 2 {
+		var apiErr struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		_ = json.Unmarshal(body, &apiErr)
+		return nil, fmt.Errorf("stripe: create checkout session: status %d: %s", resp.StatusCode, apiErr.Error.Message)
+	}
+
+	var session struct {
+		ID        string `json:"id"`
+		URL       string `json:"url"`
+		ExpiresAt int64  `json:"expires_at"`
+	}
+	if err := json.Unmarshal(body, &session); err != nil {
+		return nil, fmt.Errorf("stripe: decode checkout session: %w", err)
+	}
+
+	return &core.CheckoutSession{
+		ID:        session.ID,
+		URL:       session.URL,
+		ExpiresAt: unixTime(session.ExpiresAt),
+	}, nil
+}
+
+// ParseWebhookEvent verifies the Stripe-Signature header and maps the event
+// to a core.BillingEvent.
+func (p *Provider) ParseWebhookEvent(payload []byte, header map[string][]string) (*core.BillingEvent, error) {
+	if err := p.verifySignature(payload, http.Header(header).Get(SignatureHeader)); err != nil {
+		return nil, err
+	}
+
+	var envelope struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+		Data struct {
+			Object json.RawMessage `json:"object"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(payload, &envelope); err != nil {
+		return nil, fmt.Errorf("%w: decode stripe event: %v", core.ErrValidation, err)
+	}
+
+	event := &core.BillingEvent{ID: envelope.ID}
+	var err error
+	switch envelope.Type {
+	case "checkout.session.completed":
+		err = decodeCheckoutSession(envelope.Data.Object, event)
+	case "customer.subscription.updated":
+		err = decodeSubscription(envelope.Data.Object, event, core.BillingEventSubscriptionUpdated)
+	case "customer.subscription.deleted":
+		err = decodeSubscription(envelope.Data.Object, event, core.BillingEventSubscriptionDeleted)
+	case "invoice.paid":
+		err = decodeInvoice(envelope.Data.Object, event, core.InvoiceStatusPaid)
+	case "invoice.payment_failed":
+		err = decodeInvoice(envelope.Data.Object, event, core.InvoiceStatusOpen)
+	case "invoice.voided":
+		err = decodeInvoice(envelope.Data.Object, event, core.InvoiceStatusVoid)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: decode stripe %s: %v", core.ErrValidation, envelope.Type, err)
+	}
+	return event, nil
+}
+
+// verifySignature checks a header of the form "t=<unix>,v1=<hex>[,v1=<hex>]"
+// against HMAC-SHA256("<t>.<payload>") keyed by the webhook secret.
+func (p *Provider) verifySignature(payload []byte, signature string) error {
+	var (
+		timestamp  int64
+		candidates []string
+	)
+	for _, part := range strings.Split(signature, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp, _ = strconv.ParseInt(value, 10, 64)
+		case "v1":
+			candidates = append(candidates, value)
+		}
+	}
+	if timestamp == 0 || len(candidates) == 0 {
+		return fmt.Errorf("%w: malformed %s header", core.ErrValidation, SignatureHeader)
+	}
+
+	if age := p.now().Sub(time.Unix(timestamp, 0)); age > p.tolerance || age < -p.tolerance {
+		return fmt.Errorf("%w: stripe webhook timestamp outside tolerance", core.ErrValidation)
+	}
+
+	mac := hmac.New(sha256.New, []byte(p.webhookSecret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+
+	for _, candidate := range candidates {
+		decoded, err := hex.DecodeString(candidate)
+		if err == nil && hmac.Equal(decoded, expected) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: stripe webhook signature mismatch", core.ErrValidation)
+}
+
+type stripeMetadata struct {
+	UserID string `json:"user_id"`
+	PlanID string `json:"plan_id"`
+}
+
+func decodeCheckoutSession(raw json.RawMessage, event *core.BillingEvent) error {
+	var session struct {
+		Mode              string         `json:"mode"`
+		Subscription      string         `json:"subscription"`
+		ClientReferenceID string         `json:"client_reference_id"`
+		Metadata          stripeMetadata `json:"metadata"`
+	}
+	if err := json.Unmarshal(raw, &session); err != nil {
+		return err
+	}
+	if session.Mode != "subscription" {
+		return nil
+	}
+
+	planID, err := uuid.Parse(session.Metadata.PlanID)
+	if err != nil {
+		return fmt.Errorf("plan_id metadata: %w", err)
+	}
+	event.Type = core.BillingEventCheckoutCompleted
+	event.ExternalSubscriptionID = session.Subscription
+	event.UserID = session.Metadata.UserID
+	if event.UserID == "" {
+		event.UserID = session.ClientReferenceID
+	}
+	event.PlanID = planID
+	return nil
+}
+
+type stripePeriod struct {
+	CurrentPeriodStart int64 `json:"current_period_start"`
+	CurrentPeriodEnd   int64 `json:"current_period_end"`
+}
+
+func decodeSubscription(raw json.RawMessage, event *core.BillingEvent, eventType core.BillingEventType) error {
+	var subscription struct {
+		ID                string `json:"id"`
+		CancelAtPeriodEnd bool   `json:"cancel_at_period_end"`
+		stripePeriod
+		// Newer API versions report the billing period per subscription item.
+		Items struct {
+			Data []stripePeriod `json:"data"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(raw, &subscription); err != nil {
+		return err
+	}
+
+	period := subscription.stripePeriod
+	if period.CurrentPeriodEnd == 0 && len(subscription.Items.Data) > 0 {
+		period = subscription.Items.Data[0]
+	}
+	event.Type = eventType
+	event.ExternalSubscriptionID = subscription.ID
+	event.CancelAtPeriodEnd = subscription.CancelAtPeriodEnd
+	event.CurrentPeriodStart = unixTime(period.CurrentPeriodStart)
+	event.CurrentPeriodEnd = unixTime(period.CurrentPeriodEnd)
+	return nil
+}
+
+func decodeInvoice(raw json.RawMessage, event *core.BillingEvent, status core.InvoiceStatus) error {
+	var invoice struct {
+		ID               string `json:"id"`
+		Subscription     string `json:"subscription"`
+		AmountDue        int64  `json:"amount_due"`
+		AmountPaid       int64  `json:"amount_paid"`
+		Currency         string `json:"currency"`
+		HostedInvoiceURL string `json:"hosted_invoice_url"`
+		// Newer API versions nest the subscription under parent.
+		Parent struct {
+			SubscriptionDetails struct {
+				Subscription string `json:"subscription"`
+			} `json:"subscription_details"`
+		} `json:"parent"`
+		StatusTransitions struct {
+			PaidAt int64 `json:"paid_at"`
+		} `json:"status_transitions"`
+		// The invoice's own period_start/period_end describe the previous
+		// billing cycle, so the service period is read from the line item.
+		Lines struct {
+			Data []struct {
+				Period struct {
+					Start int64 `json:"start"`
+					End   int64 `json:"end"`
+				} `json:"period"`
+			} `json:"data"`
+		} `json:"lines"`
+	}
+	if err := json.Unmarshal(raw, &invoice); err != nil {
+		return err
+	}
+
+	subscriptionID := invoice.Subscription
+	if subscriptionID == "" {
+		subscriptionID = invoice.Parent.SubscriptionDetails.Subscription
+	}
+	if subscriptionID == "" {
+		// One-off invoices are not tied to a plan.
+		return nil
+	}
+
+	result := &core.Invoice{
+		ExternalID:  invoice.ID,
+		AmountCents: invoice.AmountDue,
+		Currency:    strings.ToUpper(invoice.Currency),
+		Status:      status,
+		HostedURL:   invoice.HostedInvoiceURL,
+	}
+	if status == core.InvoiceStatusPaid {
+		result.AmountCents = invoice.AmountPaid
+		paidAt := unixTime(invoice.StatusTransitions.PaidAt)
+		if !paidAt.IsZero() {
+			result.PaidAt = &paidAt
+		}
+	}
+	if len(invoice.Lines.Data) > 0 {
+		result.PeriodStart = unixTime(invoice.Lines.Data[0].Period.Start)
+		result.PeriodEnd = unixTime(invoice.Lines.Data[0].Period.End)
+	}
+
+	event.Type = core.BillingEventInvoiceUpdated
+	event.ExternalSubscriptionID = subscriptionID
+	event.Invoice = result
+	return nil
+}
+
+func stripeInterval(interval core.BillingInterval) (string, error) {
+	switch interval {
+	case core.BillingIntervalMonth:
+		return "month", nil
+	case core.BillingIntervalYear:
+		return "year", nil
+	default:
+		return "", fmt.Errorf("%w: unsupported billing interval %d", core.ErrValidation, interval)
+	}
+}
+
+func unixTime(seconds int64) time.Time {
+	if seconds == 0 {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0).UTC()
+}
@@ -0,0 +1,175 @@
+package stripe
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const testWebhookSecret = "whsec_test"
+
+func sign(t *testing.T, payload string, at time.Time) map[string][]string {
+	t.Helper()
+	timestamp := fmt.Sprint(at.Unix())
+	mac := hmac.New(sha256.New, []byte(testWebhookSecret))
+	mac.Write([]byte(timestamp + "." + payload))
+	return http.Header{SignatureHeader: {"t=" + timestamp + ",v1=" + hex.EncodeToString(mac.Sum(nil))}}
+}
+
+func newTestProvider(t *testing.T, now time.Time) *Provider {
+	t.Helper()
+	provider, err := NewProvider("sk_test", testWebhookSecret)
+	if err != nil {
+		t.Fatalf("NewProvider() error = %v", err)
+	}
+	provider.WithClock(func() time.Time { return now })
+	return provider
+}
+
+func TestProvider_CreateCheckoutSession(t *testing.T) {
+	plan := core.Plan{ID: uuid.New(), Name: "Monthly", PriceCents: 999, Currency: "USD", Interval: core.BillingIntervalMonth}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/checkout/sessions" {
+			http.NotFound(w, r)
+			return
+		}
+		if user, _, _ := r.BasicAuth(); user != "sk_test" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("ParseForm() error = %v", err)
+		}
+		for key, want := range map[string]string{
+			"mode":                                           "subscription",
+			"line_items[0][price_data][unit_amount]":         "999",
+			"line_items[0][price_data][currency]":            "usd",
+			"line_items[0][price_data][recurring][interval]": "month",
+			"subscription_data[metadata][plan_id]":           plan.ID.String(),
+			"metadata[user_id]":                              "learner",
+		} {
+			if got := r.PostForm.Get(key); got != want {
+				t.Errorf("form %s = %q, want %q", key, got, want)
+			}
+		}
+		_, _ = fmt.Fprint(w, `{"id":"cs_123","url":"https://checkout.stripe.com/c/cs_123","expires_at":1717243200}`)
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(t, time.Now())
+	provider.WithAPIBase(server.URL)
+
+	session, err := provider.CreateCheckoutSession(context.Background(), core.CheckoutRequest{
+		UserID:     "learner",
+		Plan:       plan,
+		SuccessURL: "https://app/ok",
+		CancelURL:  "https://app/cancel",
+	})
+	if err != nil {
+		t.Fatalf("CreateCheckoutSession() error = %v", err)
+	}
+	if session.ID != "cs_123" || session.URL != "https://checkout.stripe.com/c/cs_123" {
+		t.Fatalf("session = %#v", session)
+	}
+	if !session.ExpiresAt.Equal(time.Unix(1717243200, 0)) {
+		t.Fatalf("ExpiresAt = %v", session.ExpiresAt)
+	}
+}
+
+func TestProvider_ParseWebhookEvent(t *testing.T) {
+	now := time.Unix(1717243200, 0).UTC()
+	planID := uuid.New()
+	provider := newTestProvider(t, now)
+
+	tests := []struct {
+		name    string
+		payload string
+		check   func(t *testing.T, event *core.BillingEvent)
+	}{
+		{
+			name:    "checkout completed",
+			payload: fmt.Sprintf(`{"id":"evt_1","type":"checkout.session.completed","data":{"object":{"mode":"subscription","subscription":"sub_1","client_reference_id":"learner","metadata":{"plan_id":%q}}}}`, planID),
+			check: func(t *testing.T, event *core.BillingEvent) {
+				if event.Type != core.BillingEventCheckoutCompleted || event.ExternalSubscriptionID != "sub_1" || event.UserID != "learner" || event.PlanID != planID {
+					t.Fatalf("event = %#v", event)
+				}
+			},
+		},
+		{
+			name:    "subscription period from items",
+			payload: `{"id":"evt_2","type":"customer.subscription.updated","data":{"object":{"id":"sub_1","cancel_at_period_end":true,"items":{"data":[{"current_period_start":1717243200,"current_period_end":1719835200}]}}}}`,
+			check: func(t *testing.T, event *core.BillingEvent) {
+				if event.Type != core.BillingEventSubscriptionUpdated || !event.CancelAtPeriodEnd || event.CurrentPeriodEnd.Unix() != 1719835200 {
+					t.Fatalf("event = %#v", event)
+				}
+			},
+		},
+		{
+			name:    "paid invoice uses line period",
+			payload: `{"id":"evt_3","type":"invoice.paid","data":{"object":{"id":"in_1","parent":{"subscription_details":{"subscription":"sub_1"}},"amount_due":999,"amount_paid":999,"currency":"usd","status_transitions":{"paid_at":1717243200},"period_start":1714564800,"period_end":1717243200,"lines":{"data":[{"period":{"start":1717243200,"end":1719835200}}]}}}}`,
+			check: func(t *testing.T, event *core.BillingEvent) {
+				invoice := event.Invoice
+				if event.Type != core.BillingEventInvoiceUpdated || event.ExternalSubscriptionID != "sub_1" || invoice == nil {
+					t.Fatalf("event = %#v", event)
+				}
+				if invoice.Status != core.InvoiceStatusPaid || invoice.Currency != "USD" || invoice.PaidAt == nil || invoice.PeriodStart.Unix() != 1717243200 {
+					t.Fatalf("invoice = %#v", invoice)
+				}
+			},
+		},
+		{
+			name:    "unhandled type",
+			payload: `{"id":"evt_4","type":"customer.created","data":{"object":{}}}`,
+			check: func(t *testing.T, event *core.BillingEvent) {
+				if event.Type != core.BillingEventUnspecified {
+					t.Fatalf("event type = %v, want unspecified", event.Type)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := provider.ParseWebhookEvent([]byte(tt.payload), sign(t, tt.payload, now))
+			if err != nil {
+				t.Fatalf("ParseWebhookEvent() error = %v", err)
+			}
+			tt.check(t, event)
+		})
+	}
+}
+
+func TestProvider_ParseWebhookEventRejectsBadSignatures(t *testing.T) {
+	now := time.Unix(1717243200, 0)
+	provider := newTestProvider(t, now)
+	payload := `{"id":"evt_1","type":"customer.created","data":{"object":{}}}`
+
+	tests := []struct {
+		name   string
+		header map[string][]string
+	}{
+		{name: "missing header", header: http.Header{}},
+		{name: "tampered payload", header: sign(t, payload+" ", now)},
+		{name: "stale timestamp", header: sign(t, payload, now.Add(-time.Hour))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := provider.ParseWebhookEvent([]byte(payload), tt.header); !errors.Is(err, core.ErrValidation) {
+				t.Fatalf("ParseWebhookEvent() error = %v, want ErrValidation", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// InteractiveTranscriptCache implements core.InteractiveTranscriptCache on a
+// Store. Entries for earlier versions of an episode are never read again
+// and expire on their own.
+type InteractiveTranscriptCache struct {
+	store Store
+	ttl   time.Duration
+}
+
+// NewInteractiveTranscriptCache constructs a cache whose entries live for ttl.
+func NewInteractiveTranscriptCache(store Store, ttl time.Duration) *InteractiveTranscriptCache {
+	return &InteractiveTranscriptCache{store: store, ttl: ttl}
+}
+
+var _ core.InteractiveTranscriptCache = (*InteractiveTranscriptCache)(nil)
+
+// GetInteractiveTranscript implements core.InteractiveTranscriptCache.
+func (c *InteractiveTranscriptCache) GetInteractiveTranscript(ctx context.Context, key core.InteractiveTranscriptKey) (*core.InteractiveTranscript, bool) {
+	data, ok, err := c.store.Get(ctx, interactiveTranscriptKey(key))
+	if err != nil || !ok {
+		return nil, false
+	}
+	var transcript core.InteractiveTranscript
+	if err := json.Unmarshal(data, &transcript); err != nil {
+		return nil, false
+	}
+	return &transcript, true
+}
+
+// SetInteractiveTranscript implements core.InteractiveTranscriptCache.
+func (c *InteractiveTranscriptCache) SetInteractiveTranscript(ctx context.Context, key core.InteractiveTranscriptKey, transcript core.InteractiveTranscript) {
+	data, err := json.Marshal(transcript)
+	if err != nil {
+		return
+	}
+	_ = c.store.Set(ctx, interactiveTranscriptKey(key), data, c.ttl)
+}
+
+func interactiveTranscriptKey(key core.InteractiveTranscriptKey) string {
+	return fmt.Sprintf("transcript:interactive:%s:%s:%d", key.EpisodeID, key.TranslationLanguage, key.EpisodeUpdatedAt.UnixNano())
+}
@@ -0,0 +1,39 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestInteractiveTranscriptCache(t *testing.T) {
+	ctx := context.Background()
+	cache := NewInteractiveTranscriptCache(NewMemoryStore(10), time.Hour)
+	key := core.InteractiveTranscriptKey{
+		EpisodeID:           uuid.New(),
+		TranslationLanguage: "es",
+		EpisodeUpdatedAt:    time.Date(2024, 5, 15, 15, 0, 0, 0, time.UTC),
+	}
+
+	if _, ok := cache.GetInteractiveTranscript(ctx, key); ok {
+		t.Fatal("expected an empty cache to miss")
+	}
+	cache.SetInteractiveTranscript(ctx, key, core.InteractiveTranscript{
+		EpisodeID: key.EpisodeID,
+		Entries:   []core.DictionaryEntry{{Word: "ran", Lemma: "run"}},
+	})
+	got, ok := cache.GetInteractiveTranscript(ctx, key)
+	if !ok || got.EpisodeID != key.EpisodeID || len(got.Entries) != 1 || got.Entries[0].Lemma != "run" {
+		t.Fatalf("GetInteractiveTranscript() = %+v, %v", got, ok)
+	}
+
+	edited := key
+	edited.EpisodeUpdatedAt = key.EpisodeUpdatedAt.Add(time.Second)
+	if _, ok := cache.GetInteractiveTranscript(ctx, edited); ok {
+		t.Fatal("expected an edited episode to miss")
+	}
+}
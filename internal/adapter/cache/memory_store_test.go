@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore_EvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore(2)
+	_ = store.Set(ctx, "a", []byte("1"), 0)
+	_ = store.Set(ctx, "b", []byte("2"), 0)
+	if _, ok, _ := store.Get(ctx, "a"); !ok {
+		t.Fatal("expected a to be cached")
+	}
+	_ = store.Set(ctx, "c", []byte("3"), 0)
+
+	if _, ok, _ := store.Get(ctx, "b"); ok {
+		t.Fatal("expected b to be evicted as the least recently used")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok, _ := store.Get(ctx, key); !ok {
+			t.Fatalf("expected %s to be cached", key)
+		}
+	}
+	if store.Len() != 2 {
+		t.Fatalf("expected 2 entries, got %d", store.Len())
+	}
+}
+
+func TestMemoryStore_ExpiresEntries(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryStore(10)
+	store.WithClock(func() time.Time { return now })
+	_ = store.Set(ctx, "short", []byte("1"), time.Minute)
+	_ = store.Set(ctx, "forever", []byte("2"), 0)
+
+	now = now.Add(time.Minute)
+	if _, ok, _ := store.Get(ctx, "short"); ok {
+		t.Fatal("expected the entry to expire after its ttl")
+	}
+	if value, ok, _ := store.Get(ctx, "forever"); !ok || string(value) != "2" {
+		t.Fatalf("expected an entry without ttl to stay, got %q, %v", value, ok)
+	}
+
+	_ = store.Delete(ctx, "forever", "missing")
+	if store.Len() != 0 {
+		t.Fatalf("expected no entries, got %d", store.Len())
+	}
+}
@@ -0,0 +1,351 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// Names of the caches in the lession_cache_requests_total metric.
+const (
+	seriesCacheName     = "series"
+	seriesListCacheName = "series_list"
+)
+
+// listGenerationKey holds the generation of the cached listings. Changing it
+// invalidates every listing at once; the orphaned entries expire on their own.
+const listGenerationKey = "series:list:generation"
+
+// SeriesEventTypes lists the events that change what the series cache holds.
+var SeriesEventTypes = []core.EventType{
+	core.EventTypeSeriesUpdated,
+	core.EventTypeSeriesPublished,
+	core.EventTypeEpisodeCreated,
+	core.EventTypeEpisodeUpdated,
+	core.EventTypeEpisodePublished,
+	core.EventTypeEpisodeDeleted,
+}
+
+// SeriesRepository caches series by ID and the first page of series
+// listings in front of another repository. Writes through the repository
+// invalidate the entries they affect, HandleEvent does the same for changes
+// other processes announce through the outbox, and use cases that write
+// around the repository call InvalidateSeries. Anything else shows up once
+// entries expire. A failing store never fails a read; the repository is read instead.
+type SeriesRepository struct {
+	next     core.SeriesRepository
+	store    Store
+	ttl      time.Duration
+	listTTL  time.Duration
+	loads    singleflight.Group
+	requests *prometheus.CounterVec
+}
+
+// NewSeriesRepository constructs a cache in front of next whose entries live
+// for ttl. Concurrent misses for the same series share one repository read.
+func NewSeriesRepository(next core.SeriesRepository, store Store, ttl time.Duration) *SeriesRepository {
+	return &SeriesRepository{
+		next:    next,
+		store:   store,
+		ttl:     ttl,
+		listTTL: ttl,
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "lession_cache_requests_total",
+			Help: "Cache lookups, by cache and result: hit, miss or error.",
+		}, []string{"cache", "result"}),
+	}
+}
+
+// WithListTTL sets how long listings live, which defaults to the TTL of
+// series. Listings change whenever any series does, so they may warrant a
+// shorter one.
+func (r *SeriesRepository) WithListTTL(ttl time.Duration) {
+	if ttl > 0 {
+		r.listTTL = ttl
+	}
+}
+
+var (
+	_ core.SeriesRepository       = (*SeriesRepository)(nil)
+	_ core.SeriesCacheInvalidator = (*SeriesRepository)(nil)
+	_ prometheus.Collector        = (*SeriesRepository)(nil)
+)
+
+// Describe implements prometheus.Collector.
+func (r *SeriesRepository) Describe(ch chan<- *prometheus.Desc) {
+	r.requests.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (r *SeriesRepository) Collect(ch chan<- prometheus.Metric) {
+	r.requests.Collect(ch)
+}
+
+// seriesListPage is the cached form of a listing page.
+type seriesListPage struct {
+	Series    []core.Series
+	NextToken string
+}
+
+// ListSeries serves the first page of listings from the cache. Later pages
+// and text searches, which rarely repeat, always read the repository.
+func (r *SeriesRepository) ListSeries(ctx context.Context, filter core.SeriesListFilter) ([]core.Series, string, error) {
+	if filter.PageToken != "" || filter.Query != "" {
+		return r.next.ListSeries(ctx, filter)
+	}
+
+	key, err := r.listKey(ctx, filter)
+	if err != nil {
+		r.requests.WithLabelValues(seriesListCacheName, "error").Inc()
+		return r.next.ListSeries(ctx, filter)
+	}
+	var page seriesListPage
+	if r.lookup(ctx, seriesListCacheName, key, &page) {
+		return page.Series, page.NextToken, nil
+	}
+
+	series, nextToken, err := r.next.ListSeries(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+	r.fill(ctx, key, seriesListPage{Series: series, NextToken: nextToken}, r.listTTL)
+	return series, nextToken, nil
+}
+
+// GetSeries serves a series from the cache.
+func (r *SeriesRepository) GetSeries(ctx context.Context, id uuid.UUID, opts core.SeriesQueryOptions) (*core.Series, error) {
+	key := seriesKey(id, opts)
+	var series core.Series
+	if r.lookup(ctx, seriesCacheName, key, &series) {
+		return &series, nil
+	}
+
+	loaded, err, _ := r.loads.Do(key, func() (any, error) {
+		found, err := r.next.GetSeries(ctx, id, opts)
+		if err != nil {
+			return nil, err
+		}
+		r.fill(ctx, key, found, r.ttl)
+		return found, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Callers sharing a read each get their own copy to modify.
+	series = *loaded.(*core.Series)
+	return &series, nil
+}
+
+// GetSeriesBySlug implements core.SeriesRepository. Reads by slug are not
+// cached.
+func (r *SeriesRepository) GetSeriesBySlug(ctx context.Context, slug string, opts core.SeriesQueryOptions) (*core.Series, error) {
+	return r.next.GetSeriesBySlug(ctx, slug, opts)
+}
+
+// CreateSeries implements core.SeriesRepository.
+func (r *SeriesRepository) CreateSeries(ctx context.Context, series core.Series, events ...core.Event) (*core.Series, error) {
+	created, err := r.next.CreateSeries(ctx, series, events...)
+	if err != nil {
+		return nil, err
+	}
+	r.invalidate(ctx)
+	return created, nil
+}
+
+// UpdateSeries implements core.SeriesRepository.
+func (r *SeriesRepository) UpdateSeries(ctx context.Context, series core.Series, events ...core.Event) (*core.Series, error) {
+	updated, err := r.next.UpdateSeries(ctx, series, events...)
+	if err != nil {
+		return nil, err
+	}
+	r.invalidate(ctx, series.ID)
+	return updated, nil
+}
+
+// CreateEpisode implements core.SeriesRepository.
+func (r *SeriesRepository) CreateEpisode(ctx context.Context, episode core.Episode, events ...core.Event) (*core.Episode, error) {
+	created, err := r.next.CreateEpisode(ctx, episode, events...)
+	if err != nil {
+		return nil, err
+	}
+	r.invalidate(ctx, created.SeriesID)
+	return created, nil
+}
+
+// GetEpisode implements core.SeriesRepository.
+func (r *SeriesRepository) GetEpisode(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+	return r.next.GetEpisode(ctx, id)
+}
+
+// UpdateEpisode implements core.SeriesRepository.
+func (r *SeriesRepository) UpdateEpisode(ctx context.Context, episode core.Episode, events ...core.Event) (*core.Episode, error) {
+	updated, err := r.next.UpdateEpisode(ctx, episode, events...)
+	if err != nil {
+		return nil, err
+	}
+	r.invalidate(ctx, updated.SeriesID)
+	return updated, nil
+}
+
+// DeleteEpisode implements core.SeriesRepository.
+func (r *SeriesRepository) DeleteEpisode(ctx context.Context, id uuid.UUID, events ...core.Event) (*core.Episode, error) {
+	deleted, err := r.next.DeleteEpisode(ctx, id, events...)
+	if err != nil {
+		return nil, err
+	}
+	r.invalidate(ctx, deleted.SeriesID)
+	return deleted, nil
+}
+
+// ReplaceTags implements core.SeriesRepository.
+func (r *SeriesRepository) ReplaceTags(ctx context.Context, replacement core.TagReplacement) ([]uuid.UUID, error) {
+	ids, err := r.next.ReplaceTags(ctx, replacement)
+	if err != nil {
+		return nil, err
+	}
+	r.invalidate(ctx, ids...)
+	return ids, nil
+}
+
+// ReassignAuthor implements core.SeriesRepository.
+func (r *SeriesRepository) ReassignAuthor(ctx context.Context, reassignment core.ContentReassignment) (*core.ContentReassignment, error) {
+	reassigned, err := r.next.ReassignAuthor(ctx, reassignment)
+	if err != nil {
+		return nil, err
+	}
+	r.invalidate(ctx, reassigned.SeriesIDs...)
+	return reassigned, nil
+}
+
+// ReconcileEpisodeCounts implements core.SeriesRepository.
+func (r *SeriesRepository) ReconcileEpisodeCounts(ctx context.Context) ([]uuid.UUID, error) {
+	corrected, err := r.next.ReconcileEpisodeCounts(ctx)
+	if len(corrected) > 0 {
+		r.invalidate(ctx, corrected...)
+	}
+	return corrected, err
+}
+
+// ListEpisodesByAsset implements core.SeriesRepository.
+func (r *SeriesRepository) ListEpisodesByAsset(ctx context.Context, assetID uuid.UUID) ([]core.Episode, error) {
+	return r.next.ListEpisodesByAsset(ctx, assetID)
+}
+
+// SetEpisodeEstimatedLevel implements core.SeriesRepository.
+func (r *SeriesRepository) SetEpisodeEstimatedLevel(ctx context.Context, id uuid.UUID, level string) (*core.Episode, error) {
+	episode, err := r.next.SetEpisodeEstimatedLevel(ctx, id, level)
+	if err != nil {
+		return nil, err
+	}
+	r.invalidate(ctx, episode.SeriesID)
+	return episode, nil
+}
+
+// InvalidateSeries implements core.SeriesCacheInvalidator for changes made
+// outside the repository.
+func (r *SeriesRepository) InvalidateSeries(ctx context.Context, ids ...uuid.UUID) {
+	r.invalidate(ctx, ids...)
+}
+
+// HandleEvent invalidates the series an event is about. Subscribe it to the
+// SeriesEventTypes.
+func (r *SeriesRepository) HandleEvent(ctx context.Context, envelope core.EventEnvelope) error {
+	switch event := envelope.Event.(type) {
+	case core.SeriesUpdated:
+		r.invalidate(ctx, event.Series.ID)
+	case core.SeriesPublished:
+		r.invalidate(ctx, event.Series.ID)
+	case core.EpisodeCreated:
+		r.invalidate(ctx, event.Episode.SeriesID)
+	case core.EpisodeUpdated:
+		r.invalidate(ctx, event.Episode.SeriesID)
+	case core.EpisodePublished:
+		r.invalidate(ctx, event.Episode.SeriesID)
+	case core.EpisodeDeleted:
+		r.invalidate(ctx, event.Episode.SeriesID)
+	}
+	return nil
+}
+
+// lookup decodes the entry under key into dst, reporting whether there was
+// one.
+func (r *SeriesRepository) lookup(ctx context.Context, cache, key string, dst any) bool {
+	data, ok, err := r.store.Get(ctx, key)
+	switch {
+	case err != nil:
+		r.requests.WithLabelValues(cache, "error").Inc()
+		return false
+	case !ok || json.Unmarshal(data, dst) != nil:
+		r.requests.WithLabelValues(cache, "miss").Inc()
+		return false
+	default:
+		r.requests.WithLabelValues(cache, "hit").Inc()
+		return true
+	}
+}
+
+// fill stores value under key; a failure only costs the next read a miss.
+func (r *SeriesRepository) fill(ctx context.Context, key string, value any, ttl time.Duration) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	_ = r.store.Set(ctx, key, data, ttl)
+}
+
+// invalidate drops the cached variants of the given series and every
+// listing, since any change may reorder or filter them differently. Failures
+// leave entries to expire.
+func (r *SeriesRepository) invalidate(ctx context.Context, ids ...uuid.UUID) {
+	var keys []string
+	for _, id := range ids {
+		for _, episodes := range []bool{false, true} {
+			for _, metadata := range []bool{false, true} {
+				for _, omitTranscripts := range []bool{false, true} {
+					keys = append(keys, seriesKey(id, core.SeriesQueryOptions{
+						IncludeEpisodes: episodes,
+						IncludeMetadata: metadata,
+						OmitTranscripts: omitTranscripts,
+					}))
+				}
+			}
+		}
+	}
+	_ = r.store.Delete(ctx, keys...)
+	_ = r.store.Set(ctx, listGenerationKey, []byte(uuid.NewString()), 0)
+}
+
+// listKey names the cached listing for filter in the current generation. A
+// generation that was evicted is replaced by a new one rather than reused,
+// so listings from before an invalidation never come back.
+func (r *SeriesRepository) listKey(ctx context.Context, filter core.SeriesListFilter) (string, error) {
+	generation, ok, err := r.store.Get(ctx, listGenerationKey)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		generation = []byte(uuid.NewString())
+		if err := r.store.Set(ctx, listGenerationKey, generation, 0); err != nil {
+			return "", err
+		}
+	}
+	encoded, err := json.Marshal(filter)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(encoded)
+	return fmt.Sprintf("series:list:%s:%s", generation, hex.EncodeToString(sum[:])), nil
+}
+
+func seriesKey(id uuid.UUID, opts core.SeriesQueryOptions) string {
+	return fmt.Sprintf("series:%s:episodes=%t:metadata=%t:transcripts=%t", id, opts.IncludeEpisodes, opts.IncludeMetadata, !opts.OmitTranscripts)
+}
@@ -0,0 +1,191 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestSeriesRepository_GetSeriesCachesUntilUpdate(t *testing.T) {
+	ctx := context.Background()
+	repo, next, _ := newTestSeriesRepository(t)
+	id := uuid.New()
+	next.series[id] = core.Series{ID: id, Title: "Original"}
+
+	for range 2 {
+		got, err := repo.GetSeries(ctx, id, core.SeriesQueryOptions{IncludeEpisodes: true})
+		if err != nil || got.Title != "Original" {
+			t.Fatalf("GetSeries() = %+v, %v", got, err)
+		}
+	}
+	if next.gets != 1 {
+		t.Fatalf("expected one repository read, got %d", next.gets)
+	}
+	if hits := testutil.ToFloat64(repo.requests.WithLabelValues(seriesCacheName, "hit")); hits != 1 {
+		t.Fatalf("expected one hit, got %v", hits)
+	}
+
+	if _, err := repo.UpdateSeries(ctx, core.Series{ID: id, Title: "Renamed"}); err != nil {
+		t.Fatalf("UpdateSeries() error = %v", err)
+	}
+	got, err := repo.GetSeries(ctx, id, core.SeriesQueryOptions{IncludeEpisodes: true})
+	if err != nil || got.Title != "Renamed" {
+		t.Fatalf("expected the update to invalidate the entry, got %+v, %v", got, err)
+	}
+}
+
+func TestSeriesRepository_ListSeriesCachesFirstPage(t *testing.T) {
+	ctx := context.Background()
+	repo, next, _ := newTestSeriesRepository(t)
+	id := uuid.New()
+	next.series[id] = core.Series{ID: id, Title: "Listed"}
+	filter := core.SeriesListFilter{PageSize: 10}
+
+	for range 2 {
+		series, token, err := repo.ListSeries(ctx, filter)
+		if err != nil || len(series) != 1 || token != "next" {
+			t.Fatalf("ListSeries() = %+v, %q, %v", series, token, err)
+		}
+	}
+	if next.lists != 1 {
+		t.Fatalf("expected one repository listing, got %d", next.lists)
+	}
+
+	if _, _, err := repo.ListSeries(ctx, core.SeriesListFilter{PageSize: 10, PageToken: "next"}); err != nil {
+		t.Fatalf("ListSeries() error = %v", err)
+	}
+	if next.lists != 2 {
+		t.Fatalf("expected later pages to bypass the cache, got %d listings", next.lists)
+	}
+
+	err := repo.HandleEvent(ctx, core.EventEnvelope{Event: core.EpisodeCreated{Episode: core.Episode{SeriesID: id}}})
+	if err != nil {
+		t.Fatalf("HandleEvent() error = %v", err)
+	}
+	if _, _, err := repo.ListSeries(ctx, filter); err != nil {
+		t.Fatalf("ListSeries() error = %v", err)
+	}
+	if next.lists != 3 {
+		t.Fatalf("expected the event to invalidate listings, got %d listings", next.lists)
+	}
+}
+
+func TestSeriesRepository_CollapsesConcurrentMisses(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	release := make(chan struct{})
+	next := &blockingSeriesRepository{release: release, series: core.Series{ID: id, Title: "Shared"}}
+	repo := NewSeriesRepository(next, NewMemoryStore(10), time.Minute)
+
+	const callers = 8
+	var wg sync.WaitGroup
+	results := make(chan *core.Series, callers)
+	for range callers {
+		wg.Go(func() {
+			series, err := repo.GetSeries(ctx, id, core.SeriesQueryOptions{})
+			if err != nil {
+				t.Errorf("GetSeries() error = %v", err)
+			}
+			results <- series
+		})
+	}
+	// Let every caller miss before the first read completes.
+	for next.calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(results)
+
+	if calls := next.calls.Load(); calls != 1 {
+		t.Fatalf("expected one repository read, got %d", calls)
+	}
+	seen := map[*core.Series]bool{}
+	for series := range results {
+		if series == nil || series.Title != "Shared" || seen[series] {
+			t.Fatalf("expected every caller to get its own copy, got %+v", series)
+		}
+		seen[series] = true
+	}
+}
+
+func TestSeriesRepository_FallsBackWhenStoreFails(t *testing.T) {
+	repo, next, server := newTestSeriesRepository(t)
+	id := uuid.New()
+	next.series[id] = core.Series{ID: id, Title: "Original"}
+	server.Close()
+
+	got, err := repo.GetSeries(context.Background(), id, core.SeriesQueryOptions{})
+	if err != nil || got.Title != "Original" {
+		t.Fatalf("expected a read from the repository, got %+v, %v", got, err)
+	}
+	if errors := testutil.ToFloat64(repo.requests.WithLabelValues(seriesCacheName, "error")); errors != 1 {
+		t.Fatalf("expected one error, got %v", errors)
+	}
+}
+
+func newTestSeriesRepository(t *testing.T) (*SeriesRepository, *stubSeriesRepository, *miniredis.Miniredis) {
+	t.Helper()
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+
+	next := &stubSeriesRepository{series: map[uuid.UUID]core.Series{}}
+	return NewSeriesRepository(next, NewRedisStore(client, "test:"), time.Minute), next, server
+}
+
+// blockingSeriesRepository holds every read until release is closed.
+type blockingSeriesRepository struct {
+	core.SeriesRepository
+	release <-chan struct{}
+	series  core.Series
+	calls   atomic.Int32
+}
+
+func (s *blockingSeriesRepository) GetSeries(context.Context, uuid.UUID, core.SeriesQueryOptions) (*core.Series, error) {
+	s.calls.Add(1)
+	<-s.release
+	series := s.series
+	return &series, nil
+}
+
+// stubSeriesRepository serves series from memory and counts reads.
+type stubSeriesRepository struct {
+	core.SeriesRepository
+	series map[uuid.UUID]core.Series
+	gets   int
+	lists  int
+}
+
+func (s *stubSeriesRepository) GetSeries(_ context.Context, id uuid.UUID, _ core.SeriesQueryOptions) (*core.Series, error) {
+	s.gets++
+	series, ok := s.series[id]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	return &series, nil
+}
+
+func (s *stubSeriesRepository) ListSeries(context.Context, core.SeriesListFilter) ([]core.Series, string, error) {
+	s.lists++
+	var series []core.Series
+	for _, item := range s.series {
+		series = append(series, item)
+	}
+	return series, "next", nil
+}
+
+func (s *stubSeriesRepository) UpdateSeries(_ context.Context, series core.Series, _ ...core.Event) (*core.Series, error) {
+	s.series[series.ID] = series
+	return &series, nil
+}
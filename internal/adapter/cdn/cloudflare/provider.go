@@ -0,0 +1,125 @@
+// Package cloudflare purges content cached by Cloudflare through its API
+// (https://developers.cloudflare.com/api/resources/cache/).
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// ProviderName identifies the provider in configuration.
+	ProviderName = "cloudflare"
+	// DefaultBaseURL is Cloudflare's API endpoint.
+	DefaultBaseURL = "https://api.cloudflare.com/client/v4"
+
+	// maxPurgeItems is the most files or prefixes one purge request takes.
+	maxPurgeItems    = 30
+	maxErrorBodySize = 4096
+)
+
+// Provider implements core.CDNProvider for a Cloudflare zone.
+type Provider struct {
+	baseURL    string
+	zoneID     string
+	apiToken   string
+	httpClient *http.Client
+}
+
+// NewProvider constructs a provider purging the zone with an API token
+// allowed to purge its cache.
+func NewProvider(zoneID, apiToken string) (*Provider, error) {
+	if zoneID == "" || apiToken == "" {
+		return nil, errors.New("cloudflare: zone id and api token are required")
+	}
+	return &Provider{
+		baseURL:    DefaultBaseURL,
+		zoneID:     zoneID,
+		apiToken:   apiToken,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// WithBaseURL points the provider at another API endpoint, or a test server.
+func (p *Provider) WithBaseURL(baseURL string) {
+	if baseURL != "" {
+		p.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithHTTPClient overrides the HTTP client used for API requests.
+func (p *Provider) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		p.httpClient = client
+	}
+}
+
+var _ core.CDNProvider = (*Provider)(nil)
+
+// purgeRequest is the body of a purge_cache request. Prefixes are given
+// without the scheme.
+type purgeRequest struct {
+	Files    []string `json:"files,omitempty"`
+	Prefixes []string `json:"prefixes,omitempty"`
+}
+
+// Invalidate purges the URLs, purging by prefix for those ending in "*".
+func (p *Provider) Invalidate(ctx context.Context, urls []string) error {
+	var files, prefixes []string
+	for _, u := range urls {
+		if prefix, ok := strings.CutSuffix(u, "*"); ok {
+			_, prefix, _ = strings.Cut(prefix, "://")
+			prefixes = append(prefixes, prefix)
+			continue
+		}
+		files = append(files, u)
+	}
+	for len(files) > 0 || len(prefixes) > 0 {
+		var req purgeRequest
+		req.Files, files = split(files)
+		req.Prefixes, prefixes = split(prefixes)
+		if err := p.purge(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func split(items []string) ([]string, []string) {
+	n := min(len(items), maxPurgeItems)
+	return items[:n], items[n:]
+}
+
+func (p *Provider) purge(ctx context.Context, body purgeRequest) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/zones/%s/purge_cache", p.baseURL, p.zoneID), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.apiToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("cloudflare: purge cache: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return fmt.Errorf("cloudflare: purge cache: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
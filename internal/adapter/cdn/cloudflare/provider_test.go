@@ -0,0 +1,57 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestProvider_Invalidate(t *testing.T) {
+	var requests []purgeRequest
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /zones/zone-1/purge_cache", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		var req purgeRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		requests = append(requests, req)
+		_, _ = w.Write([]byte(`{"success":true}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	provider, err := NewProvider("zone-1", "token")
+	if err != nil {
+		t.Fatalf("NewProvider() error = %v", err)
+	}
+	provider.WithBaseURL(server.URL)
+
+	urls := []string{"https://media.example.com/hls/asset/*"}
+	for i := range maxPurgeItems + 1 {
+		urls = append(urls, fmt.Sprintf("https://media.example.com/uploads/%d.mp3", i))
+	}
+	if err := provider.Invalidate(context.Background(), urls); err != nil {
+		t.Fatalf("Invalidate() error = %v", err)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("expected the files to be purged in two requests, got %d", len(requests))
+	}
+	if !slices.Equal(requests[0].Prefixes, []string{"media.example.com/hls/asset/"}) || len(requests[0].Files) != maxPurgeItems {
+		t.Fatalf("unexpected first request %+v", requests[0])
+	}
+	if len(requests[1].Files) != 1 || len(requests[1].Prefixes) != 0 {
+		t.Fatalf("unexpected second request %+v", requests[1])
+	}
+
+	provider, _ = NewProvider("zone-2", "token")
+	provider.WithBaseURL(server.URL)
+	if err := provider.Invalidate(context.Background(), urls[:1]); err == nil {
+		t.Fatal("expected an error for an unknown zone")
+	}
+}
@@ -0,0 +1,193 @@
+// Package cloudfront invalidates content cached by an Amazon CloudFront
+// distribution through the CloudFront API.
+package cloudfront
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// ProviderName identifies the provider in configuration.
+	ProviderName = "cloudfront"
+	// DefaultEndpoint is the global CloudFront API endpoint.
+	DefaultEndpoint = "https://cloudfront.amazonaws.com"
+
+	apiVersion = "2020-05-31"
+	// signingRegion is the region CloudFront requests are signed for.
+	signingRegion    = "us-east-1"
+	maxErrorBodySize = 4096
+)
+
+// Provider implements core.CDNProvider for a CloudFront distribution.
+// Requests are signed with AWS Signature Version 4.
+type Provider struct {
+	endpoint        string
+	distributionID  string
+	accessKeyID     string
+	secretAccessKey string
+	httpClient      *http.Client
+	now             func() time.Time
+}
+
+// NewProvider constructs a provider invalidating the distribution with the
+// given access key, which needs cloudfront:CreateInvalidation.
+func NewProvider(distributionID, accessKeyID, secretAccessKey string) (*Provider, error) {
+	if distributionID == "" || accessKeyID == "" || secretAccessKey == "" {
+		return nil, errors.New("cloudfront: distribution id and access key are required")
+	}
+	return &Provider{
+		endpoint:        DefaultEndpoint,
+		distributionID:  distributionID,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		httpClient:      &http.Client{Timeout: 30 * time.Second},
+		now:             time.Now,
+	}, nil
+}
+
+// WithEndpoint points the provider at another API endpoint, or a test server.
+func (p *Provider) WithEndpoint(endpoint string) {
+	if endpoint != "" {
+		p.endpoint = strings.TrimRight(endpoint, "/")
+	}
+}
+
+// WithHTTPClient overrides the HTTP client used for API requests.
+func (p *Provider) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		p.httpClient = client
+	}
+}
+
+// WithClock overrides the clock used to sign requests.
+func (p *Provider) WithClock(fn func() time.Time) {
+	if fn != nil {
+		p.now = fn
+	}
+}
+
+var _ core.CDNProvider = (*Provider)(nil)
+
+// invalidationBatch is the body of a CreateInvalidation request.
+type invalidationBatch struct {
+	XMLName         xml.Name `xml:"InvalidationBatch"`
+	Namespace       string   `xml:"xmlns,attr"`
+	CallerReference string   `xml:"CallerReference"`
+	Quantity        int      `xml:"Paths>Quantity"`
+	Paths           []string `xml:"Paths>Items>Path"`
+}
+
+// Invalidate creates an invalidation of the paths of the URLs. CloudFront
+// treats a trailing "*" as a wildcard itself.
+func (p *Provider) Invalidate(ctx context.Context, urls []string) error {
+	paths := lo.Uniq(lo.FilterMap(urls, func(raw string, _ int) (string, bool) {
+		u, err := url.Parse(raw)
+		if err != nil || u.Path == "" {
+			return "", false
+		}
+		return u.EscapedPath(), true
+	}))
+	if len(paths) == 0 {
+		return nil
+	}
+
+	body, err := xml.Marshal(invalidationBatch{
+		Namespace:       "http://cloudfront.amazonaws.com/doc/" + apiVersion + "/",
+		CallerReference: uuid.NewString(),
+		Quantity:        len(paths),
+		Paths:           paths,
+	})
+	if err != nil {
+		return err
+	}
+	body = append([]byte(xml.Header), body...)
+	endpoint := fmt.Sprintf("%s/%s/distribution/%s/invalidation", p.endpoint, apiVersion, p.distributionID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/xml")
+	p.sign(req, body)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("cloudfront: create invalidation: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return fmt.Errorf("cloudfront: create invalidation: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to req.
+func (p *Provider) sign(req *http.Request, body []byte) {
+	now := p.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "content-type;host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		"",
+		"content-type:" + req.Header.Get("Content-Type"),
+		"host:" + req.URL.Host,
+		"x-amz-content-sha256:" + payloadHash,
+		"x-amz-date:" + amzDate,
+		"",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + signingRegion + "/cloudfront/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+p.secretAccessKey), date)
+	key = hmacSHA256(key, signingRegion)
+	key = hmacSHA256(key, "cloudfront")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		p.accessKeyID, scope, signedHeaders, signature))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,55 @@
+package cloudfront
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestProvider_Invalidate(t *testing.T) {
+	var (
+		path, auth string
+		batch      invalidationBatch
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		auth = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		if err := xml.Unmarshal(body, &batch); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider("E2EXAMPLE", "AKID", "secret")
+	if err != nil {
+		t.Fatalf("NewProvider() error = %v", err)
+	}
+	provider.WithEndpoint(server.URL)
+	provider.WithClock(func() time.Time { return time.Date(2024, 5, 16, 2, 0, 0, 0, time.UTC) })
+
+	err = provider.Invalidate(context.Background(), []string{
+		"https://media.example.com/uploads/lesson.mp3",
+		"https://media.example.com/hls/asset/*",
+		"https://media.example.com/uploads/lesson.mp3",
+	})
+	if err != nil {
+		t.Fatalf("Invalidate() error = %v", err)
+	}
+	if path != "/2020-05-31/distribution/E2EXAMPLE/invalidation" {
+		t.Fatalf("unexpected path %q", path)
+	}
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/20240516/us-east-1/cloudfront/aws4_request, SignedHeaders=") {
+		t.Fatalf("unexpected authorization %q", auth)
+	}
+	if batch.Quantity != 2 || !slices.Equal(batch.Paths, []string{"/uploads/lesson.mp3", "/hls/asset/*"}) || batch.CallerReference == "" {
+		t.Fatalf("unexpected invalidation batch %+v", batch)
+	}
+}
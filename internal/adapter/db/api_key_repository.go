@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entapikey "github.com/eslsoft/lession/internal/adapter/db/ent/generated/apikey"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// APIKeyRepository persists API keys using Ent.
+type APIKeyRepository struct {
+	client *entgenerated.Client
+}
+
+// NewAPIKeyRepository constructs an Ent-backed API key repository.
+func NewAPIKeyRepository(client *entgenerated.Client) *APIKeyRepository {
+	return &APIKeyRepository{client: client}
+}
+
+var _ core.APIKeyRepository = (*APIKeyRepository)(nil)
+
+// CreateAPIKey stores a new key.
+func (r *APIKeyRepository) CreateAPIKey(ctx context.Context, key core.APIKey) (*core.APIKey, error) {
+	row, err := r.client.APIKey.Create().
+		SetID(key.ID).
+		SetName(key.Name).
+		SetPrefix(key.Prefix).
+		SetHash(key.Hash).
+		SetScopes(key.Scopes).
+		SetNillableExpiresAt(key.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		if entgenerated.IsConstraintError(err) {
+			return nil, core.ErrAlreadyExists
+		}
+		return nil, err
+	}
+	return toDomainAPIKey(row), nil
+}
+
+// GetAPIKey loads a key by identifier.
+func (r *APIKeyRepository) GetAPIKey(ctx context.Context, id uuid.UUID) (*core.APIKey, error) {
+	row, err := r.client.APIKey.Get(ctx, id)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainAPIKey(row), nil
+}
+
+// GetAPIKeyByHash loads the key with the given digest.
+func (r *APIKeyRepository) GetAPIKeyByHash(ctx context.Context, hash string) (*core.APIKey, error) {
+	row, err := r.client.APIKey.Query().
+		Where(entapikey.Hash(hash)).
+		Only(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainAPIKey(row), nil
+}
+
+// RevokeAPIKey marks a key revoked, keeping the time of an earlier revocation.
+func (r *APIKeyRepository) RevokeAPIKey(ctx context.Context, id uuid.UUID, revokedAt time.Time) (*core.APIKey, error) {
+	if _, err := r.client.APIKey.Update().
+		Where(entapikey.ID(id), entapikey.RevokedAtIsNil()).
+		SetRevokedAt(revokedAt).
+		Save(ctx); err != nil {
+		return nil, err
+	}
+	return r.GetAPIKey(core.NewPrimaryReadContext(ctx), id)
+}
+
+// TouchAPIKey records when the key was last used.
+func (r *APIKeyRepository) TouchAPIKey(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	err := r.client.APIKey.UpdateOneID(id).
+		SetLastUsedAt(usedAt).
+		Exec(ctx)
+	if entgenerated.IsNotFound(err) {
+		return core.ErrNotFound
+	}
+	return err
+}
+
+// ListAPIKeys returns keys in creation order.
+func (r *APIKeyRepository) ListAPIKeys(ctx context.Context, filter core.APIKeyListFilter) ([]core.APIKey, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	query := r.client.APIKey.Query()
+	if !filter.IncludeRevoked {
+		query = query.Where(entapikey.RevokedAtIsNil())
+	}
+	rows, err := query.
+		Order(entapikey.ByCreatedAt(), entapikey.ByID()).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return lo.Map(rows, func(row *entgenerated.APIKey, _ int) core.APIKey {
+		return *toDomainAPIKey(row)
+	}), nextToken, nil
+}
+
+func toDomainAPIKey(row *entgenerated.APIKey) *core.APIKey {
+	return &core.APIKey{
+		ID:         row.ID,
+		Name:       row.Name,
+		Prefix:     row.Prefix,
+		Hash:       row.Hash,
+		Scopes:     row.Scopes,
+		LastUsedAt: row.LastUsedAt,
+		ExpiresAt:  row.ExpiresAt,
+		RevokedAt:  row.RevokedAt,
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+	}
+}
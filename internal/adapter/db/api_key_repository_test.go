@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestAPIKeyRepository_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	repo := NewAPIKeyRepository(newTestClient(t, "api_key_repo"))
+
+	now := time.Date(2024, 8, 7, 9, 0, 0, 0, time.UTC)
+	expiresAt := now.AddDate(1, 0, 0)
+	partner := core.APIKey{ID: uuid.New(), Name: "Partner", Prefix: "lsn_part", Hash: "hash-partner", Scopes: []string{"lession.v1.SeriesService"}, ExpiresAt: &expiresAt}
+	ci := core.APIKey{ID: uuid.New(), Name: "CI", Prefix: "lsn_ci00", Hash: "hash-ci", Scopes: []string{core.APIKeyScopeAll}}
+	for _, key := range []core.APIKey{partner, ci} {
+		if _, err := repo.CreateAPIKey(ctx, key); err != nil {
+			t.Fatalf("CreateAPIKey() error = %v", err)
+		}
+	}
+	duplicate := ci
+	duplicate.ID = uuid.New()
+	if _, err := repo.CreateAPIKey(ctx, duplicate); !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected ErrAlreadyExists for a duplicate hash, got %v", err)
+	}
+
+	found, err := repo.GetAPIKeyByHash(ctx, "hash-partner")
+	if err != nil {
+		t.Fatalf("GetAPIKeyByHash() error = %v", err)
+	}
+	if found.ID != partner.ID || len(found.Scopes) != 1 || found.Scopes[0] != "lession.v1.SeriesService" || found.ExpiresAt == nil {
+		t.Fatalf("unexpected key %+v", found)
+	}
+	if _, err := repo.GetAPIKeyByHash(ctx, "hash-unknown"); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for an unknown hash, got %v", err)
+	}
+
+	usedAt := now.Add(time.Hour)
+	if err := repo.TouchAPIKey(ctx, partner.ID, usedAt); err != nil {
+		t.Fatalf("TouchAPIKey() error = %v", err)
+	}
+	if err := repo.TouchAPIKey(ctx, uuid.New(), usedAt); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound touching a missing key, got %v", err)
+	}
+
+	revokedAt := now.Add(2 * time.Hour)
+	revoked, err := repo.RevokeAPIKey(ctx, partner.ID, revokedAt)
+	if err != nil {
+		t.Fatalf("RevokeAPIKey() error = %v", err)
+	}
+	if revoked.RevokedAt == nil || !revoked.RevokedAt.Equal(revokedAt) || revoked.LastUsedAt == nil || !revoked.LastUsedAt.Equal(usedAt) {
+		t.Fatalf("unexpected revoked key %+v", revoked)
+	}
+	again, err := repo.RevokeAPIKey(ctx, partner.ID, revokedAt.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("RevokeAPIKey() error = %v", err)
+	}
+	if !again.RevokedAt.Equal(revokedAt) {
+		t.Fatalf("expected the first revocation time to be kept, got %v", again.RevokedAt)
+	}
+
+	live, next, err := repo.ListAPIKeys(ctx, core.APIKeyListFilter{})
+	if err != nil {
+		t.Fatalf("ListAPIKeys() error = %v", err)
+	}
+	if len(live) != 1 || live[0].ID != ci.ID || next != "" {
+		t.Fatalf("expected revoked keys to be hidden, got %+v", live)
+	}
+	first, next, err := repo.ListAPIKeys(ctx, core.APIKeyListFilter{IncludeRevoked: true, PageSize: 1})
+	if err != nil {
+		t.Fatalf("ListAPIKeys() error = %v", err)
+	}
+	if len(first) != 1 || first[0].ID != partner.ID || next == "" {
+		t.Fatalf("expected the oldest key and a next page, got %+v, %q", first, next)
+	}
+	second, next, err := repo.ListAPIKeys(ctx, core.APIKeyListFilter{IncludeRevoked: true, PageSize: 1, PageToken: next})
+	if err != nil {
+		t.Fatalf("ListAPIKeys() error = %v", err)
+	}
+	if len(second) != 1 || second[0].ID != ci.ID || next != "" {
+		t.Fatalf("expected the newest key on the last page, got %+v, %q", second, next)
+	}
+}
@@ -9,10 +9,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 
 	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
 	entasset "github.com/eslsoft/lession/internal/adapter/db/ent/generated/asset"
+	entassetversion "github.com/eslsoft/lession/internal/adapter/db/ent/generated/assetversion"
 	entupload "github.com/eslsoft/lession/internal/adapter/db/ent/generated/uploadsession"
+	entschema "github.com/eslsoft/lession/internal/adapter/db/ent/schema"
 	"github.com/eslsoft/lession/internal/core"
 )
 
@@ -29,7 +32,7 @@ func NewAssetRepository(client *entgenerated.Client) *AssetRepository {
 var _ core.AssetRepository = (*AssetRepository)(nil)
 
 // CreateUploadSession stores an upload session record.
-func (r *AssetRepository) CreateUploadSession(ctx context.Context, session core.UploadSession) error {
+func (r *AssetRepository) CreateUploadSession(ctx context.Context, session core.UploadSession) (*core.UploadSession, error) {
 	builder := r.client.UploadSession.Create().
 		SetID(session.ID).
 		SetAssetKey(session.AssetKey).
@@ -44,15 +47,18 @@ func (r *AssetRepository) CreateUploadSession(ctx context.Context, session core.
 		SetMimeType(session.MimeType).
 		SetContentLength(session.ContentLength).
 		SetExpiresAt(session.ExpiresAt).
-		SetCreatedAt(session.CreatedAt).
-		SetUpdatedAt(session.UpdatedAt)
+		SetProvider(session.Provider).
+		SetNillableReplacesAssetID(lo.EmptyableToPtr(session.ReplacesAssetID))
 
-	_, err := builder.Save(ctx)
-	return err
+	row, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainUploadSession(row), nil
 }
 
 // UpdateUploadSession updates a persisted upload session.
-func (r *AssetRepository) UpdateUploadSession(ctx context.Context, session core.UploadSession) error {
+func (r *AssetRepository) UpdateUploadSession(ctx context.Context, session core.UploadSession) (*core.UploadSession, error) {
 	builder := r.client.UploadSession.UpdateOneID(session.ID).
 		SetStatus(int(session.Status)).
 		SetTargetMethod(session.Target.Method).
@@ -62,14 +68,36 @@ func (r *AssetRepository) UpdateUploadSession(ctx context.Context, session core.
 		SetOriginalFilename(session.OriginalFilename).
 		SetMimeType(session.MimeType).
 		SetContentLength(session.ContentLength).
-		SetExpiresAt(session.ExpiresAt).
-		SetUpdatedAt(session.UpdatedAt)
+		SetExpiresAt(session.ExpiresAt)
 
-	_, err := builder.Save(ctx)
-	if entgenerated.IsNotFound(err) {
-		return core.ErrNotFound
+	row, err := builder.Save(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
 	}
-	return err
+	return toDomainUploadSession(row), nil
+}
+
+// TransitionUploadSession updates the status of a session in a single
+// conditional statement, which matches no row once another transition
+// has moved the session out of the from statuses.
+func (r *AssetRepository) TransitionUploadSession(ctx context.Context, session core.UploadSession, from ...core.UploadStatus) (*core.UploadSession, error) {
+	updated, err := r.client.UploadSession.Update().
+		Where(
+			entupload.ID(session.ID),
+			entupload.StatusIn(lo.Map(from, func(status core.UploadStatus, _ int) int { return int(status) })...),
+		).
+		SetStatus(int(session.Status)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, core.ErrUploadInvalidState
+	}
+	return r.GetUploadSessionByID(ctx, session.ID)
 }
 
 // GetUploadSessionByID fetches a session by its identifier.
@@ -98,9 +126,98 @@ func (r *AssetRepository) GetUploadSessionByAssetKey(ctx context.Context, assetK
 	return toDomainUploadSession(row), nil
 }
 
-// CreateAsset persists a new asset record.
-func (r *AssetRepository) CreateAsset(ctx context.Context, asset core.Asset) error {
-	builder := r.client.Asset.Create().
+// ListExpiredUploadSessions returns open upload sessions whose upload window
+// closed before now, oldest first.
+func (r *AssetRepository) ListExpiredUploadSessions(ctx context.Context, now time.Time, limit int) ([]core.UploadSession, error) {
+	rows, err := r.client.UploadSession.Query().
+		Where(
+			entupload.StatusIn(int(core.UploadStatusAwaitingUpload), int(core.UploadStatusUploading)),
+			entupload.ExpiresAtLT(now),
+		).
+		Order(entupload.ByExpiresAt(), entupload.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]core.UploadSession, 0, len(rows))
+	for _, row := range rows {
+		sessions = append(sessions, *toDomainUploadSession(row))
+	}
+	return sessions, nil
+}
+
+// ListUploadSessions returns upload sessions newest first. Sessions created
+// at the same instant are ordered by ID, so pages stay stable while new
+// uploads are started.
+func (r *AssetRepository) ListUploadSessions(ctx context.Context, filter core.UploadListFilter) ([]core.UploadSession, string, error) {
+	offset, err := parseOffset(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	q := r.client.UploadSession.Query()
+
+	if len(filter.Statuses) > 0 {
+		statuses := make([]int, 0, len(filter.Statuses))
+		for _, status := range filter.Statuses {
+			statuses = append(statuses, int(status))
+		}
+		q = q.Where(entupload.StatusIn(statuses...))
+	}
+
+	if len(filter.Types) > 0 {
+		types := make([]int, 0, len(filter.Types))
+		for _, typ := range filter.Types {
+			types = append(types, int(typ))
+		}
+		q = q.Where(entupload.TypeIn(types...))
+	}
+
+	if !filter.CreatedAfter.IsZero() {
+		q = q.Where(entupload.CreatedAtGTE(filter.CreatedAfter))
+	}
+	if !filter.CreatedBefore.IsZero() {
+		q = q.Where(entupload.CreatedAtLT(filter.CreatedBefore))
+	}
+
+	rows, err := q.
+		Order(entupload.ByCreatedAt(sql.OrderDesc()), entupload.ByID(sql.OrderDesc())).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	sessions := make([]core.UploadSession, 0, len(rows))
+	for _, row := range rows {
+		sessions = append(sessions, *toDomainUploadSession(row))
+	}
+	return sessions, nextToken, nil
+}
+
+// CreateAsset persists a new asset record, recording events in the outbox
+// in the same transaction.
+func (r *AssetRepository) CreateAsset(ctx context.Context, asset core.Asset, events ...core.Event) (*core.Asset, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := tx.Asset.Create().
 		SetID(asset.ID).
 		SetAssetKey(asset.AssetKey).
 		SetType(int(asset.Type)).
@@ -109,8 +226,14 @@ func (r *AssetRepository) CreateAsset(ctx context.Context, asset core.Asset) err
 		SetMimeType(asset.MimeType).
 		SetFilesize(asset.Filesize).
 		SetDurationSeconds(int(asset.Duration / time.Second)).
-		SetCreatedAt(asset.CreatedAt).
-		SetUpdatedAt(asset.UpdatedAt)
+		SetProvider(asset.Provider).
+		SetHlsManifestURL(asset.HLSManifestURL).
+		SetNillableHlsKeyID(lo.EmptyableToPtr(asset.HLSKeyID)).
+		SetWidth(asset.Width).
+		SetHeight(asset.Height).
+		SetImageVariants(asset.ImageVariants).
+		SetFailureCode(int(asset.FailureCode)).
+		SetFailureReason(asset.FailureReason)
 
 	if asset.PlaybackURL != "" {
 		builder.SetPlaybackURL(asset.PlaybackURL)
@@ -119,19 +242,44 @@ func (r *AssetRepository) CreateAsset(ctx context.Context, asset core.Asset) err
 		builder.SetReadyAt(*asset.ReadyAt)
 	}
 
-	_, err := builder.Save(ctx)
-	return err
+	row, err := builder.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	stampEvents(events, row.ID, row.CreatedAt, row.UpdatedAt)
+	if err := writeOutbox(ctx, tx, row.CreatedAt, events); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toDomainAsset(row), nil
 }
 
-// UpdateAsset updates an existing asset record.
-func (r *AssetRepository) UpdateAsset(ctx context.Context, asset core.Asset) error {
-	builder := r.client.Asset.UpdateOneID(asset.ID).
+// UpdateAsset updates an existing asset record, recording events in the
+// outbox in the same transaction.
+func (r *AssetRepository) UpdateAsset(ctx context.Context, asset core.Asset, events ...core.Event) (*core.Asset, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := tx.Asset.UpdateOneID(asset.ID).
 		SetStatus(int(asset.Status)).
 		SetOriginalFilename(asset.OriginalFilename).
 		SetMimeType(asset.MimeType).
 		SetFilesize(asset.Filesize).
 		SetDurationSeconds(int(asset.Duration / time.Second)).
-		SetUpdatedAt(asset.UpdatedAt)
+		SetHlsManifestURL(asset.HLSManifestURL).
+		SetWidth(asset.Width).
+		SetHeight(asset.Height).
+		SetImageVariants(asset.ImageVariants).
+		SetFailureCode(int(asset.FailureCode)).
+		SetFailureReason(asset.FailureReason)
 
 	if asset.PlaybackURL != "" {
 		builder.SetPlaybackURL(asset.PlaybackURL)
@@ -139,17 +287,45 @@ func (r *AssetRepository) UpdateAsset(ctx context.Context, asset core.Asset) err
 		builder.SetPlaybackURL("")
 	}
 
+	// The key and provider only change when the content is replaced.
+	if asset.AssetKey != "" {
+		builder.SetAssetKey(asset.AssetKey)
+	}
+	if asset.Provider != "" {
+		builder.SetProvider(asset.Provider)
+	}
+
 	if asset.ReadyAt != nil {
 		builder.SetReadyAt(*asset.ReadyAt)
 	} else {
 		builder.ClearReadyAt()
 	}
 
-	_, err := builder.Save(ctx)
-	if entgenerated.IsNotFound(err) {
-		return core.ErrNotFound
+	if asset.HLSKeyID != uuid.Nil {
+		builder.SetHlsKeyID(asset.HLSKeyID)
+	} else {
+		builder.ClearHlsKeyID()
 	}
-	return err
+
+	row, err := builder.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+
+	stampEvents(events, row.ID, row.CreatedAt, row.UpdatedAt)
+	if err := writeOutbox(ctx, tx, row.UpdatedAt, events); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toDomainAsset(row), nil
 }
 
 // GetAssetByID fetches an asset by id.
@@ -192,6 +368,11 @@ func (r *AssetRepository) ListAssets(ctx context.Context, filter core.AssetListF
 
 	q := r.client.Asset.Query()
 
+	// Deleted assets are only listed when the filter asks for them.
+	if lo.Contains(filter.Statuses, core.AssetStatusDeleted) {
+		ctx = entschema.IncludeDeleted(ctx)
+	}
+
 	if len(filter.Statuses) > 0 {
 		statuses := make([]int, 0, len(filter.Statuses))
 		for _, status := range filter.Statuses {
@@ -212,6 +393,18 @@ func (r *AssetRepository) ListAssets(ctx context.Context, filter core.AssetListF
 		q = q.Where(entasset.AssetKeyIn(filter.AssetKeys...))
 	}
 
+	if len(filter.FailureCodes) > 0 {
+		codes := make([]int, 0, len(filter.FailureCodes))
+		for _, code := range filter.FailureCodes {
+			codes = append(codes, int(code))
+		}
+		q = q.Where(entasset.Status(int(core.AssetStatusFailed)), entasset.FailureCodeIn(codes...))
+	}
+
+	if !filter.UpdatedBefore.IsZero() {
+		q = q.Where(entasset.UpdatedAtLT(filter.UpdatedBefore))
+	}
+
 	rows, err := q.
 		Order(entasset.ByCreatedAt(sql.OrderDesc())).
 		Offset(offset).
@@ -235,10 +428,12 @@ func (r *AssetRepository) ListAssets(ctx context.Context, filter core.AssetListF
 	return assets, nextToken, nil
 }
 
-// DeleteAsset deletes or archives an asset depending on the flag.
+// DeleteAsset deletes or archives an asset depending on the flag. Archived
+// assets are soft deleted: they are only found again by listing deleted
+// assets.
 func (r *AssetRepository) DeleteAsset(ctx context.Context, id uuid.UUID, hardDelete bool) (*core.Asset, error) {
 	if hardDelete {
-		err := r.client.Asset.DeleteOneID(id).Exec(ctx)
+		err := r.client.Asset.DeleteOneID(id).Exec(entschema.IncludeDeleted(ctx))
 		if entgenerated.IsNotFound(err) {
 			return nil, core.ErrNotFound
 		}
@@ -248,7 +443,7 @@ func (r *AssetRepository) DeleteAsset(ctx context.Context, id uuid.UUID, hardDel
 	now := time.Now().UTC()
 	row, err := r.client.Asset.UpdateOneID(id).
 		SetStatus(int(core.AssetStatusDeleted)).
-		SetUpdatedAt(now).
+		SetDeletedAt(now).
 		Save(ctx)
 	if entgenerated.IsNotFound(err) {
 		return nil, core.ErrNotFound
@@ -260,6 +455,36 @@ func (r *AssetRepository) DeleteAsset(ctx context.Context, id uuid.UUID, hardDel
 	return domain, nil
 }
 
+// CreateAssetVersion records content an asset was served from before it was replaced.
+func (r *AssetRepository) CreateAssetVersion(ctx context.Context, version core.AssetVersion) error {
+	return r.client.AssetVersion.Create().
+		SetID(version.ID).
+		SetAssetID(version.AssetID).
+		SetAssetKey(version.AssetKey).
+		SetProvider(version.Provider).
+		SetOriginalFilename(version.OriginalFilename).
+		SetMimeType(version.MimeType).
+		SetFilesize(version.Filesize).
+		SetDurationSeconds(int(version.Duration / time.Second)).
+		SetPlaybackURL(version.PlaybackURL).
+		SetHlsManifestURL(version.HLSManifestURL).
+		Exec(ctx)
+}
+
+// ListAssetVersions returns the replaced content of an asset, newest first.
+func (r *AssetRepository) ListAssetVersions(ctx context.Context, assetID uuid.UUID) ([]core.AssetVersion, error) {
+	rows, err := r.client.AssetVersion.Query().
+		Where(entassetversion.AssetID(assetID)).
+		Order(entassetversion.ByCreatedAt(sql.OrderDesc()), entassetversion.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(row *entgenerated.AssetVersion, _ int) core.AssetVersion {
+		return toDomainAssetVersion(row)
+	}), nil
+}
+
 func toDomainAsset(row *entgenerated.Asset) *core.Asset {
 	if row == nil {
 		return nil
@@ -275,6 +500,14 @@ func toDomainAsset(row *entgenerated.Asset) *core.Asset {
 		Filesize:         row.Filesize,
 		Duration:         time.Duration(row.DurationSeconds) * time.Second,
 		PlaybackURL:      row.PlaybackURL,
+		HLSManifestURL:   row.HlsManifestURL,
+		HLSKeyID:         lo.FromPtr(row.HlsKeyID),
+		Width:            row.Width,
+		Height:           row.Height,
+		ImageVariants:    row.ImageVariants,
+		Provider:         row.Provider,
+		FailureCode:      core.AssetFailureCode(row.FailureCode),
+		FailureReason:    row.FailureReason,
 		CreatedAt:        row.CreatedAt,
 		UpdatedAt:        row.UpdatedAt,
 	}
@@ -308,11 +541,29 @@ func toDomainUploadSession(row *entgenerated.UploadSession) *core.UploadSession
 		MimeType:         row.MimeType,
 		ContentLength:    row.ContentLength,
 		ExpiresAt:        row.ExpiresAt,
+		Provider:         row.Provider,
+		ReplacesAssetID:  lo.FromPtr(row.ReplacesAssetID),
 		CreatedAt:        row.CreatedAt,
 		UpdatedAt:        row.UpdatedAt,
 	}
 }
 
+func toDomainAssetVersion(row *entgenerated.AssetVersion) core.AssetVersion {
+	return core.AssetVersion{
+		ID:               row.ID,
+		AssetID:          row.AssetID,
+		AssetKey:         row.AssetKey,
+		Provider:         row.Provider,
+		OriginalFilename: row.OriginalFilename,
+		MimeType:         row.MimeType,
+		Filesize:         row.Filesize,
+		Duration:         time.Duration(row.DurationSeconds) * time.Second,
+		PlaybackURL:      row.PlaybackURL,
+		HLSManifestURL:   row.HlsManifestURL,
+		CreatedAt:        row.CreatedAt,
+	}
+}
+
 func parseOffset(token string) (int, error) {
 	if strings.TrimSpace(token) == "" {
 		return 0, nil
@@ -0,0 +1,312 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	entschema "github.com/eslsoft/lession/internal/adapter/db/ent/schema"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestAssetRepository_DeleteAssetSoftDeletes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newTestClient(t, "asset_repo")
+	repo := NewAssetRepository(client)
+
+	asset := core.Asset{
+		ID:               uuid.New(),
+		AssetKey:         "assets/lecture.mp4",
+		Type:             core.AssetTypeVideo,
+		Status:           core.AssetStatusReady,
+		OriginalFilename: "lecture.mp4",
+		MimeType:         "video/mp4",
+	}
+	if _, err := repo.CreateAsset(ctx, asset); err != nil {
+		t.Fatalf("CreateAsset() error = %v", err)
+	}
+
+	deleted, err := repo.DeleteAsset(ctx, asset.ID, false)
+	if err != nil {
+		t.Fatalf("DeleteAsset() error = %v", err)
+	}
+	if deleted.Status != core.AssetStatusDeleted {
+		t.Fatalf("expected status deleted, got %v", deleted.Status)
+	}
+
+	if _, err := repo.GetAssetByID(ctx, asset.ID); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected a deleted asset not to be found, got %v", err)
+	}
+	if _, err := repo.GetAssetByKey(ctx, asset.AssetKey); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected a deleted asset not to be found by key, got %v", err)
+	}
+	listed, _, err := repo.ListAssets(ctx, core.AssetListFilter{})
+	if err != nil {
+		t.Fatalf("ListAssets() error = %v", err)
+	}
+	if len(listed) != 0 {
+		t.Fatalf("expected deleted assets left out of listings, got %d", len(listed))
+	}
+	listed, _, err = repo.ListAssets(ctx, core.AssetListFilter{Statuses: []core.AssetStatus{core.AssetStatusDeleted}})
+	if err != nil {
+		t.Fatalf("ListAssets() error = %v", err)
+	}
+	if len(listed) != 1 || listed[0].ID != asset.ID {
+		t.Fatalf("expected the deleted asset listed when asked for, got %v", listed)
+	}
+
+	if _, err := repo.DeleteAsset(ctx, asset.ID, true); err != nil {
+		t.Fatalf("DeleteAsset(hard) error = %v", err)
+	}
+	if exists, err := client.Asset.Query().Exist(entschema.IncludeDeleted(ctx)); err != nil || exists {
+		t.Fatalf("expected the asset row removed, exists=%v err=%v", exists, err)
+	}
+}
+
+func TestAssetRepository_ListFailedAssets(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newTestClient(t, "asset_repo")
+	repo := NewAssetRepository(client)
+
+	expired := core.Asset{
+		ID:            uuid.New(),
+		AssetKey:      "assets/expired.mp3",
+		Type:          core.AssetTypeAudio,
+		Status:        core.AssetStatusFailed,
+		FailureCode:   core.AssetFailureCodeUploadExpired,
+		FailureReason: "the upload session expired before the upload completed",
+	}
+	rejected := core.Asset{
+		ID:          uuid.New(),
+		AssetKey:    "assets/cover.jpg",
+		Type:        core.AssetTypeImage,
+		Status:      core.AssetStatusReady,
+		PlaybackURL: "https://uploads.example.com/cover.jpg",
+	}
+	for _, asset := range []core.Asset{expired, rejected} {
+		if _, err := repo.CreateAsset(ctx, asset); err != nil {
+			t.Fatalf("CreateAsset() error = %v", err)
+		}
+	}
+	rejected.Status = core.AssetStatusFailed
+	rejected.FailureCode = core.AssetFailureCodeInvalidMedia
+	rejected.FailureReason = "image cannot be decoded"
+	if _, err := repo.UpdateAsset(ctx, rejected); err != nil {
+		t.Fatalf("UpdateAsset() error = %v", err)
+	}
+
+	listed, _, err := repo.ListAssets(ctx, core.AssetListFilter{FailureCodes: []core.AssetFailureCode{core.AssetFailureCodeInvalidMedia}})
+	if err != nil {
+		t.Fatalf("ListAssets() error = %v", err)
+	}
+	if len(listed) != 1 || listed[0].ID != rejected.ID || listed[0].FailureReason != rejected.FailureReason {
+		t.Fatalf("expected the rejected image with its reason, got %+v", listed)
+	}
+	listed, _, err = repo.ListAssets(ctx, core.AssetListFilter{Statuses: []core.AssetStatus{core.AssetStatusFailed}})
+	if err != nil {
+		t.Fatalf("ListAssets() error = %v", err)
+	}
+	if len(listed) != 2 {
+		t.Fatalf("expected both failed assets, got %d", len(listed))
+	}
+}
+
+func TestAssetRepository_ReplaceContent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newTestClient(t, "asset_repo")
+	repo := NewAssetRepository(client)
+
+	now := time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)
+	asset := core.Asset{
+		ID:          uuid.New(),
+		AssetKey:    "assets/lesson.mp3",
+		Type:        core.AssetTypeAudio,
+		Status:      core.AssetStatusReady,
+		PlaybackURL: "https://cdn.example.com/assets/lesson.mp3",
+		Provider:    "s3",
+	}
+	if _, err := repo.CreateAsset(ctx, asset); err != nil {
+		t.Fatalf("CreateAsset() error = %v", err)
+	}
+
+	session := core.UploadSession{
+		ID:              uuid.New(),
+		AssetKey:        "assets/lesson-v2.mp3",
+		Type:            core.AssetTypeAudio,
+		Status:          core.UploadStatusAwaitingUpload,
+		ExpiresAt:       now.Add(time.Hour),
+		Provider:        "s3",
+		ReplacesAssetID: asset.ID,
+	}
+	if _, err := repo.CreateUploadSession(ctx, session); err != nil {
+		t.Fatalf("CreateUploadSession() error = %v", err)
+	}
+	stored, err := repo.GetUploadSessionByID(ctx, session.ID)
+	if err != nil {
+		t.Fatalf("GetUploadSessionByID() error = %v", err)
+	}
+	if stored.ReplacesAssetID != asset.ID {
+		t.Fatalf("ReplacesAssetID = %s, want %s", stored.ReplacesAssetID, asset.ID)
+	}
+
+	for _, key := range []string{"assets/lesson-v0.mp3", asset.AssetKey} {
+		version := core.AssetVersion{
+			ID:       uuid.New(),
+			AssetID:  asset.ID,
+			AssetKey: key,
+			Duration: 90 * time.Second,
+		}
+		if err := repo.CreateAssetVersion(ctx, version); err != nil {
+			t.Fatalf("CreateAssetVersion() error = %v", err)
+		}
+	}
+	asset.AssetKey = session.AssetKey
+	asset.PlaybackURL = "https://cdn.example.com/assets/lesson-v2.mp3"
+	replaced, err := repo.UpdateAsset(ctx, asset)
+	if err != nil {
+		t.Fatalf("UpdateAsset() error = %v", err)
+	}
+	if replaced.AssetKey != session.AssetKey || replaced.UpdatedAt.Before(replaced.CreatedAt) {
+		t.Fatalf("unexpected replaced asset %+v", replaced)
+	}
+
+	if _, err := repo.GetAssetByKey(ctx, session.AssetKey); err != nil {
+		t.Fatalf("expected the asset under its new key, got %v", err)
+	}
+	versions, err := repo.ListAssetVersions(ctx, asset.ID)
+	if err != nil {
+		t.Fatalf("ListAssetVersions() error = %v", err)
+	}
+	if len(versions) != 2 || versions[0].AssetKey != "assets/lesson.mp3" || versions[1].Duration != 90*time.Second {
+		t.Fatalf("expected versions newest first, got %+v", versions)
+	}
+}
+
+func TestAssetRepository_TransitionUploadSession(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newTestClient(t, "asset_repo")
+	repo := NewAssetRepository(client)
+
+	now := time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)
+	session := core.UploadSession{ID: uuid.New(), AssetKey: "assets/race.mp3", Type: core.AssetTypeAudio, Status: core.UploadStatusUploading, ExpiresAt: now.Add(time.Hour)}
+	created, err := repo.CreateUploadSession(ctx, session)
+	if err != nil {
+		t.Fatalf("CreateUploadSession() error = %v", err)
+	}
+
+	open := []core.UploadStatus{core.UploadStatusAwaitingUpload, core.UploadStatusUploading}
+	completed, cancelled := session, session
+	completed.Status = core.UploadStatusCompleted
+	cancelled.Status = core.UploadStatusCancelled
+	transitioned, err := repo.TransitionUploadSession(ctx, completed, open...)
+	if err != nil {
+		t.Fatalf("TransitionUploadSession() error = %v", err)
+	}
+	if transitioned.Status != core.UploadStatusCompleted || transitioned.UpdatedAt.Before(created.UpdatedAt) {
+		t.Fatalf("unexpected transitioned session %+v", transitioned)
+	}
+	if _, err := repo.TransitionUploadSession(ctx, cancelled, open...); !errors.Is(err, core.ErrUploadInvalidState) {
+		t.Fatalf("expected ErrUploadInvalidState once the session is completed, got %v", err)
+	}
+
+	stored, err := repo.GetUploadSessionByID(ctx, session.ID)
+	if err != nil {
+		t.Fatalf("GetUploadSessionByID() error = %v", err)
+	}
+	if stored.Status != core.UploadStatusCompleted || !stored.UpdatedAt.Equal(transitioned.UpdatedAt) {
+		t.Fatalf("expected the first transition to stick, got %+v", stored)
+	}
+}
+
+func TestAssetRepository_ListUploadSessions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newTestClient(t, "asset_repo")
+	repo := NewAssetRepository(client)
+
+	now := time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)
+	sessions := []core.UploadSession{
+		{ID: uuid.New(), AssetKey: "assets/a.mp3", Type: core.AssetTypeAudio, Status: core.UploadStatusCompleted, CreatedAt: now.Add(-48 * time.Hour)},
+		{ID: uuid.New(), AssetKey: "assets/b.mp3", Type: core.AssetTypeAudio, Status: core.UploadStatusAwaitingUpload, CreatedAt: now},
+		{ID: uuid.New(), AssetKey: "assets/c.mp3", Type: core.AssetTypeAudio, Status: core.UploadStatusAwaitingUpload, CreatedAt: now},
+		{ID: uuid.New(), AssetKey: "assets/d.mp4", Type: core.AssetTypeVideo, Status: core.UploadStatusExpired, CreatedAt: now.Add(-time.Hour)},
+	}
+	// The repository leaves creation times to the time mixin, so sessions
+	// are backdated through the client.
+	for _, session := range sessions {
+		err := client.UploadSession.Create().
+			SetID(session.ID).
+			SetAssetKey(session.AssetKey).
+			SetType(int(session.Type)).
+			SetStatus(int(session.Status)).
+			SetTargetMethod("PUT").
+			SetTargetURL("https://uploads.example.com/" + session.AssetKey).
+			SetOriginalFilename("").
+			SetMimeType("").
+			SetExpiresAt(session.CreatedAt.Add(time.Hour)).
+			SetCreatedAt(session.CreatedAt).
+			Exec(ctx)
+		if err != nil {
+			t.Fatalf("create upload session: %v", err)
+		}
+	}
+
+	// Sessions created at the same instant must not repeat or go missing
+	// across pages.
+	seen := map[uuid.UUID]bool{}
+	token := ""
+	for page := 0; ; page++ {
+		listed, next, err := repo.ListUploadSessions(ctx, core.UploadListFilter{PageSize: 1, PageToken: token})
+		if err != nil {
+			t.Fatalf("ListUploadSessions() error = %v", err)
+		}
+		for _, session := range listed {
+			if seen[session.ID] {
+				t.Fatalf("session %s listed twice", session.AssetKey)
+			}
+			seen[session.ID] = true
+		}
+		if page == 3 && listed[0].AssetKey != "assets/a.mp3" {
+			t.Fatalf("expected the oldest session last, got %s", listed[0].AssetKey)
+		}
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	if len(seen) != len(sessions) {
+		t.Fatalf("listed %d sessions, want %d", len(seen), len(sessions))
+	}
+
+	listed, _, err := repo.ListUploadSessions(ctx, core.UploadListFilter{
+		Statuses:     []core.UploadStatus{core.UploadStatusAwaitingUpload, core.UploadStatusExpired},
+		Types:        []core.AssetType{core.AssetTypeVideo},
+		CreatedAfter: now.Add(-2 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("ListUploadSessions() error = %v", err)
+	}
+	if len(listed) != 1 || listed[0].AssetKey != "assets/d.mp4" {
+		t.Fatalf("expected only the expired video upload, got %+v", listed)
+	}
+
+	listed, _, err = repo.ListUploadSessions(ctx, core.UploadListFilter{CreatedBefore: now})
+	if err != nil {
+		t.Fatalf("ListUploadSessions() error = %v", err)
+	}
+	if len(listed) != 2 || listed[0].AssetKey != "assets/d.mp4" || listed[1].AssetKey != "assets/a.mp3" {
+		t.Fatalf("expected sessions created before now, newest first, got %+v", listed)
+	}
+}
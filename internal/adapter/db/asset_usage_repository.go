@@ -0,0 +1,88 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entassetusageday "github.com/eslsoft/lession/internal/adapter/db/ent/generated/assetusageday"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// AssetUsageRepository persists daily asset access counts using Ent.
+type AssetUsageRepository struct {
+	client *entgenerated.Client
+}
+
+// NewAssetUsageRepository constructs an Ent-backed asset usage repository.
+func NewAssetUsageRepository(client *entgenerated.Client) *AssetUsageRepository {
+	return &AssetUsageRepository{client: client}
+}
+
+var _ core.AssetUsageRepository = (*AssetUsageRepository)(nil)
+
+// IncrementAssetUsage adds one to the day's count of the access kind,
+// creating the day's row on its first access.
+func (r *AssetUsageRepository) IncrementAssetUsage(ctx context.Context, assetID uuid.UUID, kind core.AssetAccessKind, day time.Time) error {
+	if kind != core.AssetAccessKindPlaybackURL && kind != core.AssetAccessKindDownload {
+		return fmt.Errorf("%w: unknown access kind %d", core.ErrValidation, kind)
+	}
+
+	increment := func() (int, error) {
+		update := r.client.AssetUsageDay.Update().
+			Where(entassetusageday.AssetID(assetID), entassetusageday.Day(day))
+		if kind == core.AssetAccessKindPlaybackURL {
+			update.AddPlaybackUrlsIssued(1)
+		} else {
+			update.AddDownloads(1)
+		}
+		return update.Save(ctx)
+	}
+	if updated, err := increment(); err != nil || updated > 0 {
+		return err
+	}
+
+	create := r.client.AssetUsageDay.Create().
+		SetAssetID(assetID).
+		SetDay(day)
+	if kind == core.AssetAccessKindPlaybackURL {
+		create.SetPlaybackUrlsIssued(1)
+	} else {
+		create.SetDownloads(1)
+	}
+	err := create.Exec(ctx)
+	if !entgenerated.IsConstraintError(err) {
+		return err
+	}
+	// A concurrent access created the day's row first.
+	_, err = increment()
+	return err
+}
+
+// ListAssetUsage returns the days within [from, to) the asset was accessed on, oldest first.
+func (r *AssetUsageRepository) ListAssetUsage(ctx context.Context, assetID uuid.UUID, from, to time.Time) ([]core.DailyAssetUsage, error) {
+	rows, err := r.client.AssetUsageDay.Query().
+		Where(
+			entassetusageday.AssetID(assetID),
+			entassetusageday.DayGTE(from),
+			entassetusageday.DayLT(to),
+		).
+		Order(entassetusageday.ByDay()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(row *entgenerated.AssetUsageDay, _ int) core.DailyAssetUsage {
+		return core.DailyAssetUsage{
+			Day: row.Day.UTC(),
+			Counts: core.AssetUsageCounts{
+				PlaybackURLsIssued: row.PlaybackUrlsIssued,
+				Downloads:          row.Downloads,
+			},
+		}
+	}), nil
+}
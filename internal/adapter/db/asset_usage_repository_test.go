@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestAssetUsageRepository_IncrementAndList(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newTestClient(t, "asset_usage_repo")
+	repo := NewAssetUsageRepository(client)
+
+	assetID := uuid.New()
+	may1 := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	may3 := may1.AddDate(0, 0, 2)
+	accesses := []struct {
+		assetID uuid.UUID
+		kind    core.AssetAccessKind
+		day     time.Time
+	}{
+		{assetID, core.AssetAccessKindPlaybackURL, may1},
+		{assetID, core.AssetAccessKindPlaybackURL, may1},
+		{assetID, core.AssetAccessKindDownload, may1},
+		{assetID, core.AssetAccessKindDownload, may3},
+		{uuid.New(), core.AssetAccessKindDownload, may1},
+	}
+	for _, access := range accesses {
+		if err := repo.IncrementAssetUsage(ctx, access.assetID, access.kind, access.day); err != nil {
+			t.Fatalf("IncrementAssetUsage() error = %v", err)
+		}
+	}
+	if err := repo.IncrementAssetUsage(ctx, assetID, core.AssetAccessKindUnspecified, may1); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected validation error for an unknown kind, got %v", err)
+	}
+
+	days, err := repo.ListAssetUsage(ctx, assetID, may1, may3.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("ListAssetUsage() error = %v", err)
+	}
+	want := []core.DailyAssetUsage{
+		{Day: may1, Counts: core.AssetUsageCounts{PlaybackURLsIssued: 2, Downloads: 1}},
+		{Day: may3, Counts: core.AssetUsageCounts{Downloads: 1}},
+	}
+	if len(days) != len(want) {
+		t.Fatalf("days = %+v, want %+v", days, want)
+	}
+	for i := range want {
+		if !days[i].Day.Equal(want[i].Day) || days[i].Counts != want[i].Counts {
+			t.Fatalf("day %d = %+v, want %+v", i, days[i], want[i])
+		}
+	}
+
+	days, err = repo.ListAssetUsage(ctx, assetID, may3, may3.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("ListAssetUsage() error = %v", err)
+	}
+	if len(days) != 1 || !days[0].Day.Equal(may3) {
+		t.Fatalf("expected only the last day in range, got %+v", days)
+	}
+}
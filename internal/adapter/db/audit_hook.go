@@ -0,0 +1,212 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"entgo.io/ent"
+	"github.com/google/uuid"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// unauditedTypes are bookkeeping entities whose changes are not audited:
+// the audit log itself, queues and delivery logs written by background work.
+var unauditedTypes = map[string]bool{
+	entgenerated.TypeAuditEntry:      true,
+	entgenerated.TypeEvent:           true,
+	entgenerated.TypeOutboxMessage:   true,
+	entgenerated.TypeJob:             true,
+	entgenerated.TypeScheduledTask:   true,
+	entgenerated.TypeWebhookDelivery: true,
+	entgenerated.TypeLTILoginState:   true,
+	entgenerated.TypeUsageRecord:     true,
+	entgenerated.TypeUsageSnapshot:   true,
+}
+
+// redactedFields hold credentials and keys; the audit log records that they
+// changed but not their values. Every field the schema marks Sensitive must
+// be listed.
+var redactedFields = map[string]bool{
+	"secret": true,
+	"hash":   true,
+	"token":  true,
+	"nonce":  true,
+	"key":    true,
+}
+
+const redactedValue = `"[redacted]"`
+
+// auditedMutation is implemented by every generated mutation.
+type auditedMutation interface {
+	ent.Mutation
+	ID() (uuid.UUID, bool)
+	IDs(ctx context.Context) ([]uuid.UUID, error)
+	Client() *entgenerated.Client
+}
+
+// AuditHook records every change to an audited entity in the audit log,
+// using the mutation's own client so the entry commits or rolls back with
+// the change. The actor and RPC are taken from the request context.
+func AuditHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			mutation, ok := m.(auditedMutation)
+			if !ok || unauditedTypes[m.Type()] {
+				return next.Mutate(ctx, m)
+			}
+
+			// Entities matched by updates and deletes, and the values they
+			// held, must be read before the change is applied.
+			var (
+				ids    []uuid.UUID
+				before map[string]string
+				err    error
+			)
+			if !m.Op().Is(ent.OpCreate) {
+				if ids, err = mutation.IDs(ctx); err != nil {
+					return nil, err
+				}
+			}
+			if m.Op().Is(ent.OpUpdateOne) {
+				if before, err = oldAuditValues(ctx, m); err != nil {
+					return nil, err
+				}
+			}
+
+			value, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+
+			if m.Op().Is(ent.OpCreate) {
+				if id, ok := mutation.ID(); ok {
+					ids = []uuid.UUID{id}
+				}
+			}
+			action, changes := auditChanges(m, before)
+			if action == core.AuditActionUpdate && !hasContentChange(changes) {
+				return value, nil
+			}
+			if err := writeAudit(ctx, mutation.Client(), m.Type(), ids, action, changes); err != nil {
+				return nil, err
+			}
+			return value, nil
+		})
+	}
+}
+
+// oldAuditValues reads the stored values of the fields an update-one
+// mutation changes.
+func oldAuditValues(ctx context.Context, m ent.Mutation) (map[string]string, error) {
+	before := map[string]string{}
+	for _, name := range append(m.Fields(), m.ClearedFields()...) {
+		old, err := m.OldField(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		before[name] = auditValue(name, old)
+	}
+	return before, nil
+}
+
+func auditChanges(m ent.Mutation, before map[string]string) (core.AuditAction, []core.AuditChange) {
+	switch {
+	case m.Op().Is(ent.OpCreate):
+		return core.AuditActionCreate, newValueChanges(m, nil)
+	case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+		return core.AuditActionDelete, nil
+	default:
+		return core.AuditActionUpdate, newValueChanges(m, before)
+	}
+}
+
+// newValueChanges lists the fields set or cleared by the mutation, leaving
+// out fields whose value did not actually change.
+func newValueChanges(m ent.Mutation, before map[string]string) []core.AuditChange {
+	var changes []core.AuditChange
+	for _, name := range m.Fields() {
+		value, _ := m.Field(name)
+		change := core.AuditChange{Field: name, Before: before[name], After: auditValue(name, value)}
+		if before != nil && change.Before == change.After && !redactedFields[name] {
+			continue
+		}
+		changes = append(changes, change)
+	}
+	for _, name := range m.ClearedFields() {
+		change := core.AuditChange{Field: name, Before: before[name]}
+		if before != nil && change.Before == auditValue(name, nil) {
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// bookkeepingFields are bumped by recounts and usage tracking; updates that
+// change nothing else are not audited.
+var bookkeepingFields = map[string]bool{
+	"updated_at":   true,
+	"last_used_at": true,
+}
+
+// hasContentChange reports whether an update changed more than bookkeeping
+// fields.
+func hasContentChange(changes []core.AuditChange) bool {
+	for _, change := range changes {
+		if !bookkeepingFields[change.Field] {
+			return true
+		}
+	}
+	return false
+}
+
+// auditValue encodes a field value as JSON, dereferencing optional values.
+func auditValue(name string, value ent.Value) string {
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			value = nil
+		} else {
+			value = rv.Elem().Interface()
+		}
+	}
+	if value == nil {
+		return ""
+	}
+	if redactedFields[name] {
+		return redactedValue
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%q", fmt.Sprint(value))
+	}
+	return string(encoded)
+}
+
+func writeAudit(ctx context.Context, client *entgenerated.Client, entityType string, ids []uuid.UUID, action core.AuditAction, changes []core.AuditChange) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	encoded, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+	caller, _ := core.CallerFromContext(ctx)
+	procedure, _ := core.ProcedureFromContext(ctx)
+
+	builders := make([]*entgenerated.AuditEntryCreate, 0, len(ids))
+	for _, id := range ids {
+		builders = append(builders, client.AuditEntry.Create().
+			SetActorID(caller.UserID).
+			SetProcedure(procedure).
+			SetEntityType(entityType).
+			SetEntityID(id.String()).
+			SetAction(int(action)).
+			SetChanges(encoded))
+	}
+	return client.AuditEntry.CreateBulk(builders...).Exec(ctx)
+}
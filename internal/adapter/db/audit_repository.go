@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entauditentry "github.com/eslsoft/lession/internal/adapter/db/ent/generated/auditentry"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// AuditRepository reads the audit log written by AuditHook.
+type AuditRepository struct {
+	client *entgenerated.Client
+}
+
+// NewAuditRepository constructs an Ent-backed audit repository.
+func NewAuditRepository(client *entgenerated.Client) *AuditRepository {
+	return &AuditRepository{client: client}
+}
+
+var _ core.AuditRepository = (*AuditRepository)(nil)
+
+// ListAuditEntries returns entries matching the filter, newest first.
+func (r *AuditRepository) ListAuditEntries(ctx context.Context, filter core.AuditListFilter) ([]core.AuditEntry, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	q := r.client.AuditEntry.Query()
+	if filter.EntityType != "" {
+		q = q.Where(entauditentry.EntityType(filter.EntityType))
+	}
+	if filter.EntityID != "" {
+		q = q.Where(entauditentry.EntityID(filter.EntityID))
+	}
+	if filter.ActorID != "" {
+		q = q.Where(entauditentry.ActorID(filter.ActorID))
+	}
+
+	rows, err := q.
+		Order(entauditentry.ByCreatedAt(sql.OrderDesc()), entauditentry.ByID()).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	entries := make([]core.AuditEntry, 0, len(rows))
+	for _, row := range rows {
+		entry, err := toDomainAuditEntry(row)
+		if err != nil {
+			return nil, "", err
+		}
+		entries = append(entries, *entry)
+	}
+	return entries, nextToken, nil
+}
+
+func toDomainAuditEntry(row *entgenerated.AuditEntry) (*core.AuditEntry, error) {
+	var changes []core.AuditChange
+	if len(row.Changes) > 0 {
+		if err := json.Unmarshal(row.Changes, &changes); err != nil {
+			return nil, err
+		}
+	}
+	return &core.AuditEntry{
+		ID:         row.ID,
+		ActorID:    row.ActorID,
+		Procedure:  row.Procedure,
+		EntityType: row.EntityType,
+		EntityID:   row.EntityID,
+		Action:     core.AuditAction(row.Action),
+		Changes:    changes,
+		CreatedAt:  row.CreatedAt,
+	}, nil
+}
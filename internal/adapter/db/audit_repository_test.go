@@ -0,0 +1,136 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestAuditHook_RecordsSeriesChanges(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "audit_repo")
+	client.Use(AuditHook())
+	repo := NewAuditRepository(client)
+	seriesRepo := NewSeriesRepository(client)
+
+	ctx = core.NewCallerContext(ctx, core.Caller{UserID: "editor-1"})
+	ctx = core.NewProcedureContext(ctx, "/lession.v1.SeriesService/UpdateSeries")
+
+	series := core.Series{
+		ID:       uuid.New(),
+		Slug:     "intro-series",
+		Title:    "Intro Series",
+		Language: "en",
+		Level:    "beginner",
+		Tags:     []string{"intro"},
+		Status:   core.SeriesStatusDraft,
+	}
+	if _, err := seriesRepo.CreateSeries(ctx, series); err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+
+	series.Title = "Introduction"
+	if _, err := seriesRepo.UpdateSeries(ctx, series); err != nil {
+		t.Fatalf("UpdateSeries() error = %v", err)
+	}
+
+	entries, next, err := repo.ListAuditEntries(ctx, core.AuditListFilter{EntityType: "Series", EntityID: series.ID.String()})
+	if err != nil {
+		t.Fatalf("ListAuditEntries() error = %v", err)
+	}
+	if len(entries) != 2 || next != "" {
+		t.Fatalf("expected a create and an update entry, got %+v", entries)
+	}
+
+	var update core.AuditEntry
+	for _, entry := range entries {
+		if entry.ActorID != "editor-1" || entry.Procedure != "/lession.v1.SeriesService/UpdateSeries" {
+			t.Fatalf("unexpected actor or procedure %+v", entry)
+		}
+		if entry.Action == core.AuditActionUpdate {
+			update = entry
+		}
+	}
+	changed := map[string]core.AuditChange{}
+	for _, change := range update.Changes {
+		changed[change.Field] = change
+	}
+	if len(changed) != 2 || changed["title"].Before != `"Intro Series"` || changed["title"].After != `"Introduction"` {
+		t.Fatalf("expected only title and updated_at to change, got %+v", update.Changes)
+	}
+
+	// Entries for other actors are filtered out.
+	if entries, _, _ := repo.ListAuditEntries(ctx, core.AuditListFilter{ActorID: "editor-2"}); len(entries) != 0 {
+		t.Fatalf("expected no entries for another actor, got %+v", entries)
+	}
+}
+
+func TestAuditHook_RedactsContentKeys(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "audit_repo")
+	client.Use(AuditHook())
+	repo := NewAuditRepository(client)
+
+	key := core.ContentKey{
+		ID:      uuid.New(),
+		AssetID: uuid.New(),
+		Key:     bytes.Repeat([]byte{0xA7}, 16),
+	}
+	if err := NewContentKeyRepository(client).CreateContentKey(ctx, key); err != nil {
+		t.Fatalf("CreateContentKey() error = %v", err)
+	}
+
+	entries, _, err := repo.ListAuditEntries(ctx, core.AuditListFilter{EntityType: "ContentKey", EntityID: key.ID.String()})
+	if err != nil {
+		t.Fatalf("ListAuditEntries() error = %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected a create entry, got %+v", entries)
+	}
+	encoded := base64.StdEncoding.EncodeToString(key.Key)
+	redacted := false
+	for _, change := range entries[0].Changes {
+		if strings.Contains(change.Before, encoded) || strings.Contains(change.After, encoded) {
+			t.Fatalf("key bytes reached the audit log: %+v", change)
+		}
+		redacted = redacted || (change.Field == "key" && change.After == redactedValue)
+	}
+	if !redacted {
+		t.Fatalf("expected the key to be recorded as redacted, got %+v", entries[0].Changes)
+	}
+}
+
+// sensitiveFieldPattern matches the fields of an Ent schema marked Sensitive.
+var sensitiveFieldPattern = regexp.MustCompile(`field\.\w+\("(\w+)"\)(?:\s*\.\s*\w+\([^)]*\))*\s*\.\s*Sensitive\(\)`)
+
+func TestAuditHook_RedactsSensitiveFields(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("ent", "schema", "*.go"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("listing schemas: %v", err)
+	}
+	found := 0
+	for _, file := range files {
+		source, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("ReadFile(%s) error = %v", file, err)
+		}
+		for _, match := range sensitiveFieldPattern.FindAllStringSubmatch(string(source), -1) {
+			found++
+			if !redactedFields[match[1]] {
+				t.Errorf("%s: sensitive field %q is not redacted in the audit log", file, match[1])
+			}
+		}
+	}
+	if found == 0 {
+		t.Fatal("expected the schemas to mark some fields Sensitive")
+	}
+}
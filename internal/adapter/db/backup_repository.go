@@ -0,0 +1,347 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/migrate"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// restoreBatchSize bounds how many rows one INSERT statement restores.
+const restoreBatchSize = 100
+
+// BackupRepository dumps and restores the tables of the Ent schema with
+// plain SQL on the driver of the transaction manager, so a restore can run
+// in one transaction.
+type BackupRepository struct {
+	driver dialect.Driver
+	tables map[string]*schema.Table
+	order  []string
+}
+
+// NewBackupRepository constructs a backup repository on the driver.
+func NewBackupRepository(driver dialect.Driver) *BackupRepository {
+	r := &BackupRepository{
+		driver: driver,
+		tables: lo.SliceToMap(migrate.Tables, func(table *schema.Table) (string, *schema.Table) {
+			return table.Name, table
+		}),
+	}
+	r.order = restoreOrder(migrate.Tables)
+	return r
+}
+
+var _ core.BackupRepository = (*BackupRepository)(nil)
+
+// BackupTables lists the tables, referenced tables first.
+func (r *BackupRepository) BackupTables() []string {
+	return r.order
+}
+
+// DumpTable calls fn with every row of table.
+func (r *BackupRepository) DumpTable(ctx context.Context, table string, fn func(row json.RawMessage) error) error {
+	t, err := r.table(table)
+	if err != nil {
+		return err
+	}
+
+	query, args := entsql.Dialect(r.driver.Dialect()).
+		Select(columnNames(t.Columns)...).
+		From(entsql.Table(t.Name)).
+		OrderBy(columnNames(t.PrimaryKey)...).
+		Query()
+	rows := &entsql.Rows{}
+	if err := r.driver.Query(ctx, query, args, rows); err != nil {
+		return fmt.Errorf("dump %s: %w", t.Name, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		targets := lo.Map(t.Columns, func(column *schema.Column, _ int) any {
+			return scanTarget(column)
+		})
+		if err := rows.Scan(targets...); err != nil {
+			return fmt.Errorf("dump %s: %w", t.Name, err)
+		}
+		row := make(map[string]any, len(t.Columns))
+		for i, column := range t.Columns {
+			row[column.Name] = scannedValue(targets[i])
+		}
+		encoded, err := json.Marshal(row)
+		if err != nil {
+			return fmt.Errorf("dump %s: %w", t.Name, err)
+		}
+		if err := fn(encoded); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// RestoreTable inserts rows into table, which must be empty.
+func (r *BackupRepository) RestoreTable(ctx context.Context, table string, next func() (json.RawMessage, error)) (int, error) {
+	t, err := r.table(table)
+	if err != nil {
+		return 0, err
+	}
+	if err := r.ensureEmpty(ctx, t); err != nil {
+		return 0, err
+	}
+
+	restored := 0
+	batch := make([][]any, 0, restoreBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		insert := entsql.Dialect(r.driver.Dialect()).
+			Insert(t.Name).
+			Columns(columnNames(t.Columns)...)
+		for _, values := range batch {
+			insert.Values(values...)
+		}
+		query, args := insert.Query()
+		if err := r.driver.Exec(ctx, query, args, nil); err != nil {
+			return fmt.Errorf("restore %s: %w", t.Name, err)
+		}
+		restored += len(batch)
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		raw, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return restored, err
+		}
+		values, err := rowValues(t, raw)
+		if err != nil {
+			return restored, fmt.Errorf("restore %s row %d: %w", t.Name, restored+len(batch)+1, err)
+		}
+		batch = append(batch, values)
+		if len(batch) == restoreBatchSize {
+			if err := flush(); err != nil {
+				return restored, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return restored, err
+	}
+
+	if restored > 0 {
+		if err := r.resetSequence(ctx, t); err != nil {
+			return restored, err
+		}
+	}
+	return restored, nil
+}
+
+func (r *BackupRepository) table(name string) (*schema.Table, error) {
+	t, ok := r.tables[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown table %q", core.ErrValidation, name)
+	}
+	return t, nil
+}
+
+func (r *BackupRepository) ensureEmpty(ctx context.Context, t *schema.Table) error {
+	query, args := entsql.Dialect(r.driver.Dialect()).
+		Select(columnNames(t.PrimaryKey)...).
+		From(entsql.Table(t.Name)).
+		Limit(1).
+		Query()
+	rows := &entsql.Rows{}
+	if err := r.driver.Query(ctx, query, args, rows); err != nil {
+		return fmt.Errorf("restore %s: %w", t.Name, err)
+	}
+	defer rows.Close()
+	if rows.Next() {
+		return fmt.Errorf("%w: table %s is not empty", core.ErrInvalidState, t.Name)
+	}
+	return rows.Err()
+}
+
+// resetSequence moves the identity sequence of an auto-increment key past
+// the restored keys. SQLite and MySQL do so on their own.
+func (r *BackupRepository) resetSequence(ctx context.Context, t *schema.Table) error {
+	if r.driver.Dialect() != dialect.Postgres || len(t.PrimaryKey) != 1 || !t.PrimaryKey[0].Increment {
+		return nil
+	}
+	key := t.PrimaryKey[0].Name
+	query := fmt.Sprintf(`SELECT setval(pg_get_serial_sequence('%s', '%s'), (SELECT MAX(%q) FROM %q))`, t.Name, key, key, t.Name)
+	if err := r.driver.Exec(ctx, query, []any{}, nil); err != nil {
+		return fmt.Errorf("restore %s: reset sequence: %w", t.Name, err)
+	}
+	return nil
+}
+
+// restoreOrder sorts tables so every table follows the tables its foreign
+// keys refer to, keeping the schema order otherwise.
+func restoreOrder(tables []*schema.Table) []string {
+	order := make([]string, 0, len(tables))
+	placed := make(map[string]bool, len(tables))
+	var place func(t *schema.Table)
+	place = func(t *schema.Table) {
+		if placed[t.Name] {
+			return
+		}
+		placed[t.Name] = true
+		for _, fk := range t.ForeignKeys {
+			if fk.RefTable != nil && fk.RefTable != t {
+				place(fk.RefTable)
+			}
+		}
+		order = append(order, t.Name)
+	}
+	for _, t := range tables {
+		place(t)
+	}
+	return order
+}
+
+func columnNames(columns []*schema.Column) []string {
+	return lo.Map(columns, func(column *schema.Column, _ int) string {
+		return column.Name
+	})
+}
+
+// scanTarget allocates a destination for a column value of any dialect.
+func scanTarget(column *schema.Column) any {
+	switch column.Type {
+	case field.TypeUUID:
+		return new(uuid.NullUUID)
+	case field.TypeTime:
+		return new(sql.NullTime)
+	case field.TypeBool:
+		return new(sql.NullBool)
+	case field.TypeInt, field.TypeInt8, field.TypeInt16, field.TypeInt32, field.TypeInt64,
+		field.TypeUint, field.TypeUint8, field.TypeUint16, field.TypeUint32, field.TypeUint64:
+		return new(sql.NullInt64)
+	case field.TypeFloat32, field.TypeFloat64:
+		return new(sql.NullFloat64)
+	case field.TypeBytes:
+		return new([]byte)
+	case field.TypeJSON:
+		return new(jsonColumn)
+	default:
+		return new(sql.NullString)
+	}
+}
+
+// scannedValue unwraps a scanned column value for JSON encoding, nil for
+// NULL.
+func scannedValue(target any) any {
+	switch v := target.(type) {
+	case *uuid.NullUUID:
+		return lo.Ternary[any](v.Valid, v.UUID, nil)
+	case *sql.NullTime:
+		return lo.Ternary[any](v.Valid, v.Time, nil)
+	case *sql.NullBool:
+		return lo.Ternary[any](v.Valid, v.Bool, nil)
+	case *sql.NullInt64:
+		return lo.Ternary[any](v.Valid, v.Int64, nil)
+	case *sql.NullFloat64:
+		return lo.Ternary[any](v.Valid, v.Float64, nil)
+	case *[]byte:
+		return lo.Ternary[any](*v != nil, *v, nil)
+	case *jsonColumn:
+		return lo.Ternary[any](len(*v) > 0, json.RawMessage(*v), nil)
+	case *sql.NullString:
+		return lo.Ternary[any](v.Valid, v.String, nil)
+	default:
+		return nil
+	}
+}
+
+// jsonColumn scans a JSON column, which drivers return as text or bytes.
+type jsonColumn []byte
+
+// Scan implements sql.Scanner.
+func (c *jsonColumn) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*c = nil
+	case string:
+		*c = []byte(v)
+	case []byte:
+		*c = append([]byte(nil), v...)
+	default:
+		return fmt.Errorf("unexpected JSON column value %T", src)
+	}
+	return nil
+}
+
+// rowValues decodes a dumped row into the insert values of the columns of
+// t, in column order.
+func rowValues(t *schema.Table, raw json.RawMessage) ([]any, error) {
+	var row map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &row); err != nil {
+		return nil, err
+	}
+	for name := range row {
+		if !lo.ContainsBy(t.Columns, func(column *schema.Column) bool { return column.Name == name }) {
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+	}
+
+	values := make([]any, len(t.Columns))
+	for i, column := range t.Columns {
+		value, err := columnValue(column, row[column.Name])
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %w", column.Name, err)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+func columnValue(column *schema.Column, raw json.RawMessage) (any, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var value any
+	var err error
+	switch column.Type {
+	case field.TypeUUID:
+		value, err = decodeJSON[uuid.UUID](raw)
+	case field.TypeTime:
+		value, err = decodeJSON[time.Time](raw)
+	case field.TypeBool:
+		value, err = decodeJSON[bool](raw)
+	case field.TypeInt, field.TypeInt8, field.TypeInt16, field.TypeInt32, field.TypeInt64,
+		field.TypeUint, field.TypeUint8, field.TypeUint16, field.TypeUint32, field.TypeUint64:
+		value, err = decodeJSON[int64](raw)
+	case field.TypeFloat32, field.TypeFloat64:
+		value, err = decodeJSON[float64](raw)
+	case field.TypeBytes:
+		value, err = decodeJSON[[]byte](raw)
+	case field.TypeJSON:
+		value = string(raw)
+	default:
+		value, err = decodeJSON[string](raw)
+	}
+	return value, err
+}
+
+func decodeJSON[T any](raw json.RawMessage) (T, error) {
+	var value T
+	err := json.Unmarshal(raw, &value)
+	return value, err
+}
@@ -0,0 +1,112 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/enttest"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestBackupRepository_DumpAndRestore(t *testing.T) {
+	ctx := context.Background()
+	source, sourceClient := setupBackupRepo(t, ctx, "backup_source")
+	defer sourceClient.Close()
+
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	seriesID := uuid.New()
+	series := core.Series{
+		ID:           seriesID,
+		Slug:         "backup-series",
+		Title:        "Backup Series",
+		Language:     "en",
+		Tags:         []string{"intro"},
+		Status:       core.SeriesStatusPublished,
+		EpisodeCount: 1,
+		PublishedAt:  &now,
+		Episodes: []core.Episode{{
+			ID:         uuid.New(),
+			SeriesID:   seriesID,
+			Seq:        1,
+			Title:      "Episode 1",
+			Duration:   time.Minute,
+			Status:     core.EpisodeStatusPublished,
+			Resource:   core.MediaResource{AssetID: uuid.New(), Type: core.MediaTypeAudio},
+			Transcript: core.Transcript{Language: "en", Format: core.TranscriptFormatPlain, Content: "Hello world"},
+		}},
+	}
+	if _, err := NewSeriesRepository(sourceClient).CreateSeries(ctx, series); err != nil {
+		t.Fatalf("CreateSeries returned error: %v", err)
+	}
+
+	dumps := map[string][]json.RawMessage{}
+	for _, table := range source.BackupTables() {
+		if err := source.DumpTable(ctx, table, func(row json.RawMessage) error {
+			dumps[table] = append(dumps[table], row)
+			return nil
+		}); err != nil {
+			t.Fatalf("DumpTable(%s) returned error: %v", table, err)
+		}
+	}
+	if len(dumps["series"]) != 1 || len(dumps["episodes"]) != 1 {
+		t.Fatalf("expected one series and one episode row, got %d and %d", len(dumps["series"]), len(dumps["episodes"]))
+	}
+
+	target, targetClient := setupBackupRepo(t, ctx, "backup_target")
+	defer targetClient.Close()
+	for _, table := range target.BackupTables() {
+		rows, err := target.RestoreTable(ctx, table, rowsOf(dumps[table]))
+		if err != nil {
+			t.Fatalf("RestoreTable(%s) returned error: %v", table, err)
+		}
+		if rows != len(dumps[table]) {
+			t.Fatalf("RestoreTable(%s) restored %d of %d rows", table, rows, len(dumps[table]))
+		}
+	}
+
+	restored, err := NewSeriesRepository(targetClient).GetSeries(ctx, seriesID, core.SeriesQueryOptions{IncludeEpisodes: true})
+	if err != nil {
+		t.Fatalf("GetSeries returned error: %v", err)
+	}
+	if restored.Slug != series.Slug || !restored.PublishedAt.Equal(now) || len(restored.Tags) != 1 {
+		t.Fatalf("unexpected restored series: %+v", restored)
+	}
+	if len(restored.Episodes) != 1 || restored.Episodes[0].Transcript.Content != "Hello world" || restored.Episodes[0].Duration != time.Minute {
+		t.Fatalf("unexpected restored episodes: %+v", restored.Episodes)
+	}
+
+	if _, err := target.RestoreTable(ctx, "series", rowsOf(dumps["series"])); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected ErrInvalidState restoring into a filled table, got %v", err)
+	}
+	if _, err := target.RestoreTable(ctx, "missing", rowsOf(nil)); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected ErrValidation for an unknown table, got %v", err)
+	}
+}
+
+func setupBackupRepo(t *testing.T, ctx context.Context, name string) (*BackupRepository, *entgenerated.Client) {
+	t.Helper()
+	driver := openSQLiteDriver(t, name)
+	client := enttest.NewClient(t, enttest.WithOptions(entgenerated.Driver(driver)))
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+	return NewBackupRepository(driver), client
+}
+
+func rowsOf(rows []json.RawMessage) func() (json.RawMessage, error) {
+	return func() (json.RawMessage, error) {
+		if len(rows) == 0 {
+			return nil, io.EOF
+		}
+		row := rows[0]
+		rows = rows[1:]
+		return row, nil
+	}
+}
@@ -0,0 +1,385 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entslot "github.com/eslsoft/lession/internal/adapter/db/ent/generated/availabilityslot"
+	entbooking "github.com/eslsoft/lession/internal/adapter/db/ent/generated/booking"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// BookingRepository persists availability slots and bookings using Ent.
+type BookingRepository struct {
+	client *entgenerated.Client
+}
+
+// NewBookingRepository constructs an Ent-backed booking repository.
+func NewBookingRepository(client *entgenerated.Client) *BookingRepository {
+	return &BookingRepository{client: client}
+}
+
+var _ core.BookingRepository = (*BookingRepository)(nil)
+
+// exclusionViolationState is the PostgreSQL error code of a row rejected by
+// an exclusion constraint.
+const exclusionViolationState = "23P01"
+
+// CreateAvailabilitySlots stores slots after checking none overlaps a slot
+// the teacher already offers. On PostgreSQL the
+// availabilityslot_teacher_id_overlap exclusion constraint rejects the
+// overlapping slots of concurrent requests the check cannot see.
+func (r *BookingRepository) CreateAvailabilitySlots(ctx context.Context, slots []core.AvailabilitySlot) ([]core.AvailabilitySlot, error) {
+	if len(slots) == 0 {
+		return nil, nil
+	}
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, slot := range slots {
+		overlapping, err := tx.AvailabilitySlot.Query().
+			Where(
+				entslot.TeacherID(slot.TeacherID),
+				entslot.StartsAtLT(slot.EndsAt),
+				entslot.EndsAtGT(slot.StartsAt),
+			).
+			Exist(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		if overlapping {
+			_ = tx.Rollback()
+			return nil, core.ErrAlreadyExists
+		}
+	}
+
+	builders := lo.Map(slots, func(slot core.AvailabilitySlot, _ int) *entgenerated.AvailabilitySlotCreate {
+		return tx.AvailabilitySlot.Create().
+			SetID(slot.ID).
+			SetTeacherID(slot.TeacherID).
+			SetStartsAt(slot.StartsAt).
+			SetEndsAt(slot.EndsAt)
+	})
+	rows, err := tx.AvailabilitySlot.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, overlapError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(row *entgenerated.AvailabilitySlot, _ int) core.AvailabilitySlot {
+		return *toDomainAvailabilitySlot(row)
+	}), nil
+}
+
+// GetAvailabilitySlot loads a slot by identifier.
+func (r *BookingRepository) GetAvailabilitySlot(ctx context.Context, id uuid.UUID) (*core.AvailabilitySlot, error) {
+	row, err := r.client.AvailabilitySlot.Get(ctx, id)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainAvailabilitySlot(row), nil
+}
+
+// DeleteAvailabilitySlot removes an unbooked slot together with the
+// cancelled bookings that referenced it.
+func (r *BookingRepository) DeleteAvailabilitySlot(ctx context.Context, id uuid.UUID) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	slot, err := tx.AvailabilitySlot.Get(ctx, id)
+	if err != nil {
+		_ = tx.Rollback()
+		if entgenerated.IsNotFound(err) {
+			return core.ErrNotFound
+		}
+		return err
+	}
+	if slot.Booked {
+		_ = tx.Rollback()
+		return core.ErrInvalidState
+	}
+
+	if _, err := tx.Booking.Delete().Where(entbooking.SlotID(id)).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	// The booked check is repeated in the delete so a booking made since the
+	// slot was read is not lost.
+	deleted, err := tx.AvailabilitySlot.Delete().
+		Where(entslot.ID(id), entslot.Booked(false)).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if deleted == 0 {
+		_ = tx.Rollback()
+		return core.ErrInvalidState
+	}
+
+	return tx.Commit()
+}
+
+// ListAvailabilitySlots returns slots starting in [From, To), earliest first.
+func (r *BookingRepository) ListAvailabilitySlots(ctx context.Context, filter core.AvailabilityFilter) ([]core.AvailabilitySlot, error) {
+	q := r.client.AvailabilitySlot.Query().
+		Where(
+			entslot.StartsAtGTE(filter.From),
+			entslot.StartsAtLT(filter.To),
+		)
+	if filter.TeacherID != "" {
+		q = q.Where(entslot.TeacherID(filter.TeacherID))
+	}
+	if !filter.IncludeBooked {
+		q = q.Where(entslot.Booked(false))
+	}
+
+	rows, err := q.Order(entslot.ByStartsAt(), entslot.ByID()).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(row *entgenerated.AvailabilitySlot, _ int) core.AvailabilitySlot {
+		return *toDomainAvailabilitySlot(row)
+	}), nil
+}
+
+// CreateBooking claims the slot with a conditional update, so of two
+// learners booking the same slot only one succeeds, then stores the booking.
+// On PostgreSQL the booking_learner_id_overlap exclusion constraint rejects
+// a learner's concurrent bookings of overlapping slots.
+func (r *BookingRepository) CreateBooking(ctx context.Context, booking core.Booking) (*core.Booking, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	overlapping, err := tx.Booking.Query().
+		Where(
+			entbooking.LearnerID(booking.LearnerID),
+			entbooking.Status(int(core.BookingStatusConfirmed)),
+			entbooking.StartsAtLT(booking.EndsAt),
+			entbooking.EndsAtGT(booking.StartsAt),
+		).
+		Exist(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if overlapping {
+		_ = tx.Rollback()
+		return nil, core.ErrAlreadyExists
+	}
+
+	claimed, err := tx.AvailabilitySlot.Update().
+		Where(entslot.ID(booking.SlotID), entslot.Booked(false)).
+		SetBooked(true).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if claimed == 0 {
+		exists, err := tx.AvailabilitySlot.Query().Where(entslot.ID(booking.SlotID)).Exist(ctx)
+		_ = tx.Rollback()
+		switch {
+		case err != nil:
+			return nil, err
+		case !exists:
+			return nil, core.ErrNotFound
+		default:
+			return nil, core.ErrAlreadyExists
+		}
+	}
+
+	row, err := tx.Booking.Create().
+		SetID(booking.ID).
+		SetSlotID(booking.SlotID).
+		SetTeacherID(booking.TeacherID).
+		SetLearnerID(booking.LearnerID).
+		SetStartsAt(booking.StartsAt).
+		SetEndsAt(booking.EndsAt).
+		SetNote(booking.Note).
+		SetStatus(int(booking.Status)).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, overlapError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toDomainBooking(row), nil
+}
+
+// overlapError reports err as core.ErrAlreadyExists when an exclusion
+// constraint rejected an overlapping slot or booking.
+func overlapError(err error) error {
+	var state interface{ SQLState() string }
+	if errors.As(err, &state) && state.SQLState() == exclusionViolationState {
+		return core.ErrAlreadyExists
+	}
+	return err
+}
+
+// GetBooking loads a booking by identifier.
+func (r *BookingRepository) GetBooking(ctx context.Context, id uuid.UUID) (*core.Booking, error) {
+	row, err := r.client.Booking.Get(ctx, id)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainBooking(row), nil
+}
+
+// CancelBooking records the cancellation of a confirmed booking and offers
+// its slot again.
+func (r *BookingRepository) CancelBooking(ctx context.Context, booking core.Booking) (*core.Booking, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	update := tx.Booking.Update().
+		Where(
+			entbooking.ID(booking.ID),
+			entbooking.Status(int(core.BookingStatusConfirmed)),
+		).
+		SetStatus(int(core.BookingStatusCancelled)).
+		SetCancelledBy(booking.CancelledBy).
+		SetCancelReason(booking.CancelReason)
+	if booking.CancelledAt != nil {
+		update.SetCancelledAt(*booking.CancelledAt)
+	}
+	cancelled, err := update.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if cancelled == 0 {
+		exists, err := tx.Booking.Query().Where(entbooking.ID(booking.ID)).Exist(ctx)
+		_ = tx.Rollback()
+		switch {
+		case err != nil:
+			return nil, err
+		case !exists:
+			return nil, core.ErrNotFound
+		default:
+			return nil, core.ErrInvalidState
+		}
+	}
+
+	err = tx.AvailabilitySlot.UpdateOneID(booking.SlotID).
+		SetBooked(false).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return r.GetBooking(core.NewPrimaryReadContext(ctx), booking.ID)
+}
+
+// ListBookings returns bookings matching the filter, earliest first.
+func (r *BookingRepository) ListBookings(ctx context.Context, filter core.BookingListFilter) ([]core.Booking, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	q := r.client.Booking.Query()
+	if filter.TeacherID != "" {
+		q = q.Where(entbooking.TeacherID(filter.TeacherID))
+	}
+	if filter.LearnerID != "" {
+		q = q.Where(entbooking.LearnerID(filter.LearnerID))
+	}
+	if !filter.From.IsZero() {
+		q = q.Where(entbooking.StartsAtGTE(filter.From))
+	}
+	if !filter.To.IsZero() {
+		q = q.Where(entbooking.StartsAtLT(filter.To))
+	}
+	if filter.Status != core.BookingStatusUnspecified {
+		q = q.Where(entbooking.Status(int(filter.Status)))
+	}
+
+	rows, err := q.
+		Order(entbooking.ByStartsAt(), entbooking.ByID()).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return lo.Map(rows, func(row *entgenerated.Booking, _ int) core.Booking {
+		return *toDomainBooking(row)
+	}), nextToken, nil
+}
+
+func toDomainAvailabilitySlot(row *entgenerated.AvailabilitySlot) *core.AvailabilitySlot {
+	return &core.AvailabilitySlot{
+		ID:        row.ID,
+		TeacherID: row.TeacherID,
+		StartsAt:  row.StartsAt.UTC(),
+		EndsAt:    row.EndsAt.UTC(),
+		Booked:    row.Booked,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
+
+func toDomainBooking(row *entgenerated.Booking) *core.Booking {
+	booking := &core.Booking{
+		ID:           row.ID,
+		SlotID:       row.SlotID,
+		TeacherID:    row.TeacherID,
+		LearnerID:    row.LearnerID,
+		StartsAt:     row.StartsAt.UTC(),
+		EndsAt:       row.EndsAt.UTC(),
+		Note:         row.Note,
+		Status:       core.BookingStatus(row.Status),
+		CancelledBy:  row.CancelledBy,
+		CancelReason: row.CancelReason,
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+	}
+	if row.CancelledAt != nil {
+		cancelledAt := row.CancelledAt.UTC()
+		booking.CancelledAt = &cancelledAt
+	}
+	return booking
+}
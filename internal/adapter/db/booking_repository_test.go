@@ -0,0 +1,216 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestBookingRepository_AvailabilityConflicts(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "booking_repo")
+	repo := NewBookingRepository(client)
+
+	start := time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC)
+	slot := func(teacherID string, from time.Time) core.AvailabilitySlot {
+		return core.AvailabilitySlot{ID: uuid.New(), TeacherID: teacherID, StartsAt: from, EndsAt: from.Add(time.Hour)}
+	}
+
+	slots, err := repo.CreateAvailabilitySlots(ctx, []core.AvailabilitySlot{slot("teacher", start), slot("teacher", start.Add(time.Hour))})
+	if err != nil {
+		t.Fatalf("CreateAvailabilitySlots() error = %v", err)
+	}
+	if len(slots) != 2 {
+		t.Fatalf("expected 2 slots, got %d", len(slots))
+	}
+
+	if _, err := repo.CreateAvailabilitySlots(ctx, []core.AvailabilitySlot{slot("teacher", start.Add(30*time.Minute))}); !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected an overlapping slot to be rejected, got %v", err)
+	}
+	if _, err := repo.CreateAvailabilitySlots(ctx, []core.AvailabilitySlot{slot("other", start.Add(30*time.Minute))}); err != nil {
+		t.Fatalf("expected another teacher's slot to be accepted, got %v", err)
+	}
+
+	listed, err := repo.ListAvailabilitySlots(ctx, core.AvailabilityFilter{TeacherID: "teacher", From: start, To: start.Add(24 * time.Hour)})
+	if err != nil {
+		t.Fatalf("ListAvailabilitySlots() error = %v", err)
+	}
+	if len(listed) != 2 || listed[0].ID != slots[0].ID {
+		t.Fatalf("expected the teacher's slots earliest first, got %#v", listed)
+	}
+}
+
+func TestBookingRepository_BookAndCancel(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "booking_repo")
+	repo := NewBookingRepository(client)
+
+	start := time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC)
+	slots, err := repo.CreateAvailabilitySlots(ctx, []core.AvailabilitySlot{
+		{ID: uuid.New(), TeacherID: "teacher", StartsAt: start, EndsAt: start.Add(time.Hour)},
+		{ID: uuid.New(), TeacherID: "other", StartsAt: start.Add(30 * time.Minute), EndsAt: start.Add(90 * time.Minute)},
+	})
+	if err != nil {
+		t.Fatalf("CreateAvailabilitySlots() error = %v", err)
+	}
+	book := func(slot core.AvailabilitySlot, learnerID string) (*core.Booking, error) {
+		return repo.CreateBooking(ctx, core.Booking{
+			ID:        uuid.New(),
+			SlotID:    slot.ID,
+			TeacherID: slot.TeacherID,
+			LearnerID: learnerID,
+			StartsAt:  slot.StartsAt,
+			EndsAt:    slot.EndsAt,
+			Status:    core.BookingStatusConfirmed,
+		})
+	}
+
+	booking, err := book(slots[0], "alice")
+	if err != nil {
+		t.Fatalf("CreateBooking() error = %v", err)
+	}
+	if _, err := book(slots[0], "bob"); !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected the slot to be taken, got %v", err)
+	}
+	if _, err := book(slots[1], "alice"); !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected the learner's overlapping booking to be rejected, got %v", err)
+	}
+	if err := repo.DeleteAvailabilitySlot(ctx, slots[0].ID); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected a booked slot to be kept, got %v", err)
+	}
+
+	cancelledAt := start.Add(-time.Hour)
+	booking.Status = core.BookingStatusCancelled
+	booking.CancelledAt = &cancelledAt
+	booking.CancelledBy = "alice"
+	booking.CancelReason = "sick"
+	cancelled, err := repo.CancelBooking(ctx, *booking)
+	if err != nil {
+		t.Fatalf("CancelBooking() error = %v", err)
+	}
+	if cancelled.Status != core.BookingStatusCancelled || cancelled.CancelledAt == nil || cancelled.CancelReason != "sick" {
+		t.Fatalf("unexpected cancelled booking %#v", cancelled)
+	}
+	if _, err := repo.CancelBooking(ctx, *booking); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected a second cancellation to fail, got %v", err)
+	}
+
+	if _, err := book(slots[0], "bob"); err != nil {
+		t.Fatalf("expected the freed slot to be bookable, got %v", err)
+	}
+	bookings, _, err := repo.ListBookings(ctx, core.BookingListFilter{TeacherID: "teacher", Status: core.BookingStatusConfirmed})
+	if err != nil {
+		t.Fatalf("ListBookings() error = %v", err)
+	}
+	if len(bookings) != 1 || bookings[0].LearnerID != "bob" {
+		t.Fatalf("expected bob's confirmed booking, got %#v", bookings)
+	}
+}
+
+func TestBookingRepository_DeleteAvailabilitySlot(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "booking_repo")
+	repo := NewBookingRepository(client)
+
+	start := time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC)
+	slots, err := repo.CreateAvailabilitySlots(ctx, []core.AvailabilitySlot{
+		{ID: uuid.New(), TeacherID: "teacher", StartsAt: start, EndsAt: start.Add(time.Hour)},
+	})
+	if err != nil {
+		t.Fatalf("CreateAvailabilitySlots() error = %v", err)
+	}
+	booking, err := repo.CreateBooking(ctx, core.Booking{
+		ID: uuid.New(), SlotID: slots[0].ID, TeacherID: "teacher", LearnerID: "alice",
+		StartsAt: start, EndsAt: start.Add(time.Hour), Status: core.BookingStatusConfirmed,
+	})
+	if err != nil {
+		t.Fatalf("CreateBooking() error = %v", err)
+	}
+	booking.CancelledBy = "teacher"
+	if _, err := repo.CancelBooking(ctx, *booking); err != nil {
+		t.Fatalf("CancelBooking() error = %v", err)
+	}
+
+	if err := repo.DeleteAvailabilitySlot(ctx, slots[0].ID); err != nil {
+		t.Fatalf("DeleteAvailabilitySlot() error = %v", err)
+	}
+	if _, err := repo.GetBooking(ctx, booking.ID); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected the cancelled booking to go with the slot, got %v", err)
+	}
+	if err := repo.DeleteAvailabilitySlot(ctx, slots[0].ID); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+func TestBookingRepository_ConcurrentOverlaps(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "booking_repo")
+	repo := NewBookingRepository(client)
+
+	start := time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC)
+	const attempts = 8
+	race := func(attempt func(i int) error) (created int) {
+		t.Helper()
+		var wg sync.WaitGroup
+		errs := make([]error, attempts)
+		for i := range attempts {
+			wg.Go(func() { errs[i] = attempt(i) })
+		}
+		wg.Wait()
+		for _, err := range errs {
+			switch {
+			case err == nil:
+				created++
+			case !errors.Is(err, core.ErrAlreadyExists):
+				t.Fatalf("expected ErrAlreadyExists for an overlap, got %v", err)
+			}
+		}
+		return created
+	}
+
+	created := race(func(i int) error {
+		from := start.Add(time.Duration(i) * time.Minute)
+		_, err := repo.CreateAvailabilitySlots(ctx, []core.AvailabilitySlot{
+			{ID: uuid.New(), TeacherID: "teacher", StartsAt: from, EndsAt: from.Add(time.Hour)},
+		})
+		return err
+	})
+	if created != 1 {
+		t.Fatalf("expected one of the overlapping slots to be created, got %d", created)
+	}
+
+	slots := make([]core.AvailabilitySlot, attempts)
+	for i := range slots {
+		from := start.Add(time.Duration(i) * time.Minute)
+		slots[i] = core.AvailabilitySlot{ID: uuid.New(), TeacherID: fmt.Sprintf("teacher-%d", i), StartsAt: from, EndsAt: from.Add(time.Hour)}
+	}
+	if _, err := repo.CreateAvailabilitySlots(ctx, slots); err != nil {
+		t.Fatalf("CreateAvailabilitySlots() error = %v", err)
+	}
+	booked := race(func(i int) error {
+		_, err := repo.CreateBooking(ctx, core.Booking{
+			ID: uuid.New(), SlotID: slots[i].ID, TeacherID: slots[i].TeacherID, LearnerID: "alice",
+			StartsAt: slots[i].StartsAt, EndsAt: slots[i].EndsAt, Status: core.BookingStatusConfirmed,
+		})
+		return err
+	})
+	if booked != 1 {
+		t.Fatalf("expected one of the learner's overlapping bookings to be made, got %d", booked)
+	}
+}
+
+func TestOverlapError(t *testing.T) {
+	if err := overlapError(sqlStateError(exclusionViolationState)); !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected an exclusion violation to be ErrAlreadyExists, got %v", err)
+	}
+	if err := overlapError(sqlStateError("23505")); errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected other errors to pass through, got %v", err)
+	}
+}
@@ -0,0 +1,348 @@
+package db
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entclassroom "github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroom"
+	entclassroomassignment "github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroomassignment"
+	entclassroommember "github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroommember"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// ClassroomRepository persists classrooms, their members and assignments using Ent.
+type ClassroomRepository struct {
+	client *entgenerated.Client
+}
+
+// NewClassroomRepository constructs an Ent-backed classroom repository.
+func NewClassroomRepository(client *entgenerated.Client) *ClassroomRepository {
+	return &ClassroomRepository{client: client}
+}
+
+var _ core.ClassroomRepository = (*ClassroomRepository)(nil)
+
+// CreateClassroom stores a classroom together with its initial learners.
+func (r *ClassroomRepository) CreateClassroom(ctx context.Context, classroom core.Classroom) (*core.Classroom, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Classroom.Create().
+		SetID(classroom.ID).
+		SetTeacherID(classroom.TeacherID).
+		SetName(classroom.Name).
+		SetDescription(classroom.Description).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := createClassroomMembers(ctx, tx, classroom.ID, classroom.LearnerIDs, classroom.CreatedAt); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.GetClassroom(core.NewPrimaryReadContext(ctx), classroom.ID)
+}
+
+// GetClassroom loads a classroom with its learners and assignments.
+func (r *ClassroomRepository) GetClassroom(ctx context.Context, id uuid.UUID) (*core.Classroom, error) {
+	row, err := r.classroomQuery().
+		Where(entclassroom.ID(id)).
+		Only(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainClassroom(row), nil
+}
+
+// UpdateClassroom persists classroom metadata.
+func (r *ClassroomRepository) UpdateClassroom(ctx context.Context, classroom core.Classroom) (*core.Classroom, error) {
+	err := r.client.Classroom.UpdateOneID(classroom.ID).
+		SetName(classroom.Name).
+		SetDescription(classroom.Description).
+		Exec(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return r.GetClassroom(core.NewPrimaryReadContext(ctx), classroom.ID)
+}
+
+// DeleteClassroom removes a classroom with its members and assignments.
+func (r *ClassroomRepository) DeleteClassroom(ctx context.Context, id uuid.UUID) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ClassroomMember.Delete().Where(entclassroommember.ClassroomID(id)).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if _, err := tx.ClassroomAssignment.Delete().Where(entclassroomassignment.ClassroomID(id)).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Classroom.DeleteOneID(id).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		if entgenerated.IsNotFound(err) {
+			return core.ErrNotFound
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ListClassrooms returns classrooms matching the filter, most recently updated first.
+func (r *ClassroomRepository) ListClassrooms(ctx context.Context, filter core.ClassroomListFilter) ([]core.Classroom, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	q := r.classroomQuery()
+	if filter.TeacherID != "" {
+		q = q.Where(entclassroom.TeacherID(filter.TeacherID))
+	}
+
+	rows, err := q.
+		Order(entclassroom.ByUpdatedAt(sql.OrderDesc()), entclassroom.ByID()).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return lo.Map(rows, func(row *entgenerated.Classroom, _ int) core.Classroom {
+		return *toDomainClassroom(row)
+	}), nextToken, nil
+}
+
+// AddClassroomLearners enrols learners who are not yet members.
+func (r *ClassroomRepository) AddClassroomLearners(ctx context.Context, id uuid.UUID, learnerIDs []string, joinedAt time.Time) (*core.Classroom, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Classroom.UpdateOneID(id).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+
+	existing, err := tx.ClassroomMember.Query().
+		Where(
+			entclassroommember.ClassroomID(id),
+			entclassroommember.UserIDIn(learnerIDs...),
+		).
+		Select(entclassroommember.FieldUserID).
+		Strings(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := createClassroomMembers(ctx, tx, id, lo.Without(learnerIDs, existing...), joinedAt); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.GetClassroom(core.NewPrimaryReadContext(ctx), id)
+}
+
+// RemoveClassroomLearners withdraws learners from a classroom.
+func (r *ClassroomRepository) RemoveClassroomLearners(ctx context.Context, id uuid.UUID, learnerIDs []string) (*core.Classroom, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Classroom.UpdateOneID(id).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+
+	_, err = tx.ClassroomMember.Delete().
+		Where(
+			entclassroommember.ClassroomID(id),
+			entclassroommember.UserIDIn(learnerIDs...),
+		).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.GetClassroom(core.NewPrimaryReadContext(ctx), id)
+}
+
+// CreateClassroomAssignment assigns a series to a classroom.
+func (r *ClassroomRepository) CreateClassroomAssignment(ctx context.Context, assignment core.ClassroomAssignment) (*core.ClassroomAssignment, error) {
+	row, err := r.client.ClassroomAssignment.Create().
+		SetID(assignment.ID).
+		SetClassroomID(assignment.ClassroomID).
+		SetSeriesID(assignment.SeriesID).
+		SetDueAt(assignment.DueAt).
+		Save(ctx)
+	if err != nil {
+		if entgenerated.IsConstraintError(err) {
+			return nil, core.ErrAlreadyExists
+		}
+		return nil, err
+	}
+	return toDomainClassroomAssignment(row), nil
+}
+
+// DeleteClassroomAssignment removes an assignment from a classroom.
+func (r *ClassroomRepository) DeleteClassroomAssignment(ctx context.Context, classroomID, assignmentID uuid.UUID) error {
+	deleted, err := r.client.ClassroomAssignment.Delete().
+		Where(
+			entclassroomassignment.ID(assignmentID),
+			entclassroomassignment.ClassroomID(classroomID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return core.ErrNotFound
+	}
+	return nil
+}
+
+// ListSeriesLearners returns learners enrolled in any classroom the series is assigned to.
+func (r *ClassroomRepository) ListSeriesLearners(ctx context.Context, seriesID uuid.UUID) ([]string, error) {
+	return r.client.ClassroomMember.Query().
+		Where(entclassroommember.HasClassroomWith(
+			entclassroom.HasAssignmentsWith(entclassroomassignment.SeriesID(seriesID)),
+		)).
+		Unique(true).
+		Order(entclassroommember.ByUserID()).
+		Select(entclassroommember.FieldUserID).
+		Strings(ctx)
+}
+
+// ListClassroomsWithAssignmentsDue returns classrooms with assignments due in
+// [from, to), loading only those assignments.
+func (r *ClassroomRepository) ListClassroomsWithAssignmentsDue(ctx context.Context, from, to time.Time) ([]core.Classroom, error) {
+	due := []predicate.ClassroomAssignment{
+		entclassroomassignment.DueAtGTE(from),
+		entclassroomassignment.DueAtLT(to),
+	}
+	rows, err := r.client.Classroom.Query().
+		Where(entclassroom.HasAssignmentsWith(due...)).
+		WithMembers(func(q *entgenerated.ClassroomMemberQuery) {
+			q.Order(entclassroommember.ByUserID())
+		}).
+		WithAssignments(func(q *entgenerated.ClassroomAssignmentQuery) {
+			q.Where(due...).Order(entclassroomassignment.ByDueAt(), entclassroomassignment.ByID())
+		}).
+		Order(entclassroom.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row *entgenerated.Classroom, _ int) core.Classroom {
+		return *toDomainClassroom(row)
+	}), nil
+}
+
+func (r *ClassroomRepository) classroomQuery() *entgenerated.ClassroomQuery {
+	return r.client.Classroom.Query().
+		WithMembers(func(q *entgenerated.ClassroomMemberQuery) {
+			q.Order(entclassroommember.ByUserID())
+		}).
+		WithAssignments(func(q *entgenerated.ClassroomAssignmentQuery) {
+			q.Order(entclassroomassignment.ByDueAt(), entclassroomassignment.ByID())
+		})
+}
+
+func createClassroomMembers(ctx context.Context, tx *entgenerated.Tx, classroomID uuid.UUID, learnerIDs []string, joinedAt time.Time) error {
+	if len(learnerIDs) == 0 {
+		return nil
+	}
+	builders := lo.Map(learnerIDs, func(learnerID string, _ int) *entgenerated.ClassroomMemberCreate {
+		return tx.ClassroomMember.Create().
+			SetClassroomID(classroomID).
+			SetUserID(learnerID).
+			SetJoinedAt(joinedAt)
+	})
+	return tx.ClassroomMember.CreateBulk(builders...).Exec(ctx)
+}
+
+func toDomainClassroom(row *entgenerated.Classroom) *core.Classroom {
+	return &core.Classroom{
+		ID:          row.ID,
+		TeacherID:   row.TeacherID,
+		Name:        row.Name,
+		Description: row.Description,
+		LearnerIDs: lo.Map(row.Edges.Members, func(member *entgenerated.ClassroomMember, _ int) string {
+			return member.UserID
+		}),
+		Assignments: lo.Map(row.Edges.Assignments, func(assignment *entgenerated.ClassroomAssignment, _ int) core.ClassroomAssignment {
+			return *toDomainClassroomAssignment(assignment)
+		}),
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
+
+func toDomainClassroomAssignment(row *entgenerated.ClassroomAssignment) *core.ClassroomAssignment {
+	return &core.ClassroomAssignment{
+		ID:          row.ID,
+		ClassroomID: row.ClassroomID,
+		SeriesID:    row.SeriesID,
+		DueAt:       row.DueAt.UTC(),
+		CreatedAt:   row.CreatedAt,
+	}
+}
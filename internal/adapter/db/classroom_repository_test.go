@@ -0,0 +1,107 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestClassroomRepository_MembersAndAssignments(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "classroom_repo")
+	repo := NewClassroomRepository(client)
+
+	now := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	seriesID := uuid.New()
+	createSeriesForTest(t, NewSeriesRepository(client), ctx, core.Series{
+		ID:     seriesID,
+		Slug:   "travel",
+		Title:  "Travel",
+		Status: core.SeriesStatusPublished,
+	})
+
+	classroomID := uuid.New()
+	_, err := repo.CreateClassroom(ctx, core.Classroom{
+		ID:         classroomID,
+		TeacherID:  "teacher",
+		Name:       "Spring cohort",
+		LearnerIDs: []string{"bob", "alice"},
+	})
+	if err != nil {
+		t.Fatalf("CreateClassroom() error = %v", err)
+	}
+
+	classroom, err := repo.AddClassroomLearners(ctx, classroomID, []string{"alice", "carol"}, now.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("AddClassroomLearners() error = %v", err)
+	}
+	if want := []string{"alice", "bob", "carol"}; !slices.Equal(classroom.LearnerIDs, want) {
+		t.Fatalf("learners = %v, want %v", classroom.LearnerIDs, want)
+	}
+
+	classroom, err = repo.RemoveClassroomLearners(ctx, classroomID, []string{"bob"})
+	if err != nil {
+		t.Fatalf("RemoveClassroomLearners() error = %v", err)
+	}
+	if want := []string{"alice", "carol"}; !slices.Equal(classroom.LearnerIDs, want) {
+		t.Fatalf("learners = %v, want %v", classroom.LearnerIDs, want)
+	}
+
+	assignment := core.ClassroomAssignment{
+		ID:          uuid.New(),
+		ClassroomID: classroomID,
+		SeriesID:    seriesID,
+		DueAt:       now.Add(7 * 24 * time.Hour),
+	}
+	if _, err := repo.CreateClassroomAssignment(ctx, assignment); err != nil {
+		t.Fatalf("CreateClassroomAssignment() error = %v", err)
+	}
+	duplicate := assignment
+	duplicate.ID = uuid.New()
+	if _, err := repo.CreateClassroomAssignment(ctx, duplicate); !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("duplicate assignment error = %v, want ErrAlreadyExists", err)
+	}
+
+	classroom, err = repo.GetClassroom(ctx, classroomID)
+	if err != nil {
+		t.Fatalf("GetClassroom() error = %v", err)
+	}
+	if len(classroom.Assignments) != 1 || classroom.Assignments[0].SeriesID != seriesID {
+		t.Fatalf("assignments = %#v", classroom.Assignments)
+	}
+
+	learners, err := repo.ListSeriesLearners(ctx, seriesID)
+	if err != nil {
+		t.Fatalf("ListSeriesLearners() error = %v", err)
+	}
+	if want := []string{"alice", "carol"}; !slices.Equal(learners, want) {
+		t.Fatalf("series learners = %v, want %v", learners, want)
+	}
+
+	due, err := repo.ListClassroomsWithAssignmentsDue(ctx, assignment.DueAt.Add(-time.Hour), assignment.DueAt.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("ListClassroomsWithAssignmentsDue() error = %v", err)
+	}
+	if len(due) != 1 || len(due[0].Assignments) != 1 || len(due[0].LearnerIDs) != 2 {
+		t.Fatalf("classrooms with assignments due = %#v", due)
+	}
+	if due, err := repo.ListClassroomsWithAssignmentsDue(ctx, now, now.Add(24*time.Hour)); err != nil || len(due) != 0 {
+		t.Fatalf("ListClassroomsWithAssignmentsDue(outside window) = %#v, %v", due, err)
+	}
+
+	if err := repo.DeleteClassroomAssignment(ctx, uuid.New(), assignment.ID); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("DeleteClassroomAssignment(other classroom) error = %v, want ErrNotFound", err)
+	}
+	if err := repo.DeleteClassroom(ctx, classroomID); err != nil {
+		t.Fatalf("DeleteClassroom() error = %v", err)
+	}
+	if _, err := repo.GetClassroom(ctx, classroomID); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("GetClassroom() after delete error = %v, want ErrNotFound", err)
+	}
+}
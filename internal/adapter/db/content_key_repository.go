@@ -0,0 +1,48 @@
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// ContentKeyRepository persists HLS content keys using Ent.
+type ContentKeyRepository struct {
+	client *entgenerated.Client
+}
+
+// NewContentKeyRepository constructs an Ent-backed content key repository.
+func NewContentKeyRepository(client *entgenerated.Client) *ContentKeyRepository {
+	return &ContentKeyRepository{client: client}
+}
+
+var _ core.ContentKeyRepository = (*ContentKeyRepository)(nil)
+
+// CreateContentKey stores a new content key.
+func (r *ContentKeyRepository) CreateContentKey(ctx context.Context, key core.ContentKey) error {
+	return r.client.ContentKey.Create().
+		SetID(key.ID).
+		SetAssetID(key.AssetID).
+		SetKey(key.Key).
+		Exec(ctx)
+}
+
+// GetContentKey fetches a content key by identifier.
+func (r *ContentKeyRepository) GetContentKey(ctx context.Context, id uuid.UUID) (*core.ContentKey, error) {
+	row, err := r.client.ContentKey.Get(ctx, id)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return &core.ContentKey{
+		ID:        row.ID,
+		AssetID:   row.AssetID,
+		Key:       row.Key,
+		CreatedAt: row.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestContentKeyRepository_CreateAndGet(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "content_key_repo")
+	repo := NewContentKeyRepository(client)
+
+	key := core.ContentKey{
+		ID:      uuid.New(),
+		AssetID: uuid.New(),
+		Key:     bytes.Repeat([]byte{0x2a}, 16),
+	}
+	if err := repo.CreateContentKey(ctx, key); err != nil {
+		t.Fatalf("CreateContentKey() error = %v", err)
+	}
+
+	got, err := repo.GetContentKey(ctx, key.ID)
+	if err != nil {
+		t.Fatalf("GetContentKey() error = %v", err)
+	}
+	if got.AssetID != key.AssetID || !bytes.Equal(got.Key, key.Key) || got.CreatedAt.IsZero() {
+		t.Fatalf("unexpected key %#v", got)
+	}
+	if _, err := repo.GetContentKey(ctx, uuid.New()); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
@@ -0,0 +1,34 @@
+package db
+
+import (
+	stdsql "database/sql"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "modernc.org/sqlite"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/enttest"
+)
+
+// newTestClient returns a client on a fresh in-memory SQLite database with
+// the schema created. The client is closed when the test ends.
+func newTestClient(t *testing.T, name string) *entgenerated.Client {
+	t.Helper()
+	client := enttest.NewClient(t, enttest.WithOptions(entgenerated.Driver(openSQLiteDriver(t, name))))
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+// openSQLiteDriver opens an in-memory SQLite database named name.
+func openSQLiteDriver(t *testing.T, name string) dialect.Driver {
+	t.Helper()
+	database, err := stdsql.Open("sqlite", "file:"+name+"?mode=memory&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed opening sqlite driver: %v", err)
+	}
+	// Every connection to an in-memory database sees its own database.
+	database.SetMaxOpenConns(1)
+	return entsql.OpenDB(dialect.SQLite, database)
+}
@@ -0,0 +1,98 @@
+package db
+
+import (
+	"context"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entattempt "github.com/eslsoft/lession/internal/adapter/db/ent/generated/dictationattempt"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// DictationRepository persists dictation practice attempts using Ent.
+type DictationRepository struct {
+	client *entgenerated.Client
+}
+
+// NewDictationRepository constructs an Ent-backed dictation repository.
+func NewDictationRepository(client *entgenerated.Client) *DictationRepository {
+	return &DictationRepository{client: client}
+}
+
+var _ core.DictationAttemptRepository = (*DictationRepository)(nil)
+
+// CreateDictationAttempt stores a scored dictation attempt.
+func (r *DictationRepository) CreateDictationAttempt(ctx context.Context, attempt core.DictationAttempt) (*core.DictationAttempt, error) {
+	row, err := r.client.DictationAttempt.Create().
+		SetID(attempt.ID).
+		SetUserID(attempt.UserID).
+		SetEpisodeID(attempt.EpisodeID).
+		SetItemIndex(attempt.ItemIndex).
+		SetAnswer(attempt.Answer).
+		SetExpected(attempt.Expected).
+		SetScore(attempt.Score).
+		SetFeedback(attempt.Feedback).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainDictationAttempt(row), nil
+}
+
+// ListDictationAttempts returns attempts matching the filter, newest first.
+func (r *DictationRepository) ListDictationAttempts(ctx context.Context, filter core.DictationAttemptFilter) ([]core.DictationAttempt, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	q := r.client.DictationAttempt.Query()
+	if filter.UserID != "" {
+		q = q.Where(entattempt.UserID(filter.UserID))
+	}
+	if filter.EpisodeID != uuid.Nil {
+		q = q.Where(entattempt.EpisodeID(filter.EpisodeID))
+	}
+
+	rows, err := q.
+		Order(entattempt.ByCreatedAt(sql.OrderDesc())).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return lo.Map(rows, func(row *entgenerated.DictationAttempt, _ int) core.DictationAttempt {
+		return *toDomainDictationAttempt(row)
+	}), nextToken, nil
+}
+
+func toDomainDictationAttempt(row *entgenerated.DictationAttempt) *core.DictationAttempt {
+	return &core.DictationAttempt{
+		ID:        row.ID,
+		UserID:    row.UserID,
+		EpisodeID: row.EpisodeID,
+		ItemIndex: row.ItemIndex,
+		Answer:    row.Answer,
+		Expected:  row.Expected,
+		Score:     row.Score,
+		Feedback:  row.Feedback,
+		CreatedAt: row.CreatedAt,
+	}
+}
@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestDictationRepository_CreateAndList(t *testing.T) {
+	ctx := context.Background()
+	repo := NewDictationRepository(newTestClient(t, "dictation_repo"))
+
+	episodeID, otherEpisodeID := uuid.New(), uuid.New()
+	attempts := []core.DictationAttempt{
+		{ID: uuid.New(), UserID: "learner", EpisodeID: episodeID, ItemIndex: 0, Answer: "good morning", Expected: "good morning", Score: 1},
+		{ID: uuid.New(), UserID: "learner", EpisodeID: episodeID, ItemIndex: 1, Answer: "how are", Expected: "how are you", Score: 0.67},
+		{ID: uuid.New(), UserID: "learner", EpisodeID: otherEpisodeID, ItemIndex: 0, Answer: "hello", Expected: "hello", Score: 1},
+		{ID: uuid.New(), UserID: "someone", EpisodeID: episodeID, ItemIndex: 0, Answer: "morning", Expected: "good morning", Score: 0.5},
+	}
+	attempts[1].Feedback = []core.DictationToken{
+		{Text: "how", Op: core.DictationTokenOpMatch},
+		{Text: "are", Op: core.DictationTokenOpMatch},
+		{Text: "you", Op: core.DictationTokenOpMissing},
+	}
+	for _, attempt := range attempts {
+		if _, err := repo.CreateDictationAttempt(ctx, attempt); err != nil {
+			t.Fatalf("CreateDictationAttempt() error = %v", err)
+		}
+	}
+
+	first, next, err := repo.ListDictationAttempts(ctx, core.DictationAttemptFilter{UserID: "learner", EpisodeID: episodeID, PageSize: 1})
+	if err != nil {
+		t.Fatalf("ListDictationAttempts() error = %v", err)
+	}
+	if len(first) != 1 || first[0].ID != attempts[1].ID || next == "" {
+		t.Fatalf("expected the newest attempt and a next page, got %+v, %q", first, next)
+	}
+	if feedback := first[0].Feedback; len(feedback) != 3 || feedback[2].Text != "you" || feedback[2].Op != core.DictationTokenOpMissing {
+		t.Fatalf("expected the feedback to round-trip, got %+v", feedback)
+	}
+	if first[0].Score != 0.67 || first[0].Expected != "how are you" {
+		t.Fatalf("unexpected attempt %+v", first[0])
+	}
+
+	second, next, err := repo.ListDictationAttempts(ctx, core.DictationAttemptFilter{UserID: "learner", EpisodeID: episodeID, PageSize: 1, PageToken: next})
+	if err != nil {
+		t.Fatalf("ListDictationAttempts() error = %v", err)
+	}
+	if len(second) != 1 || second[0].ID != attempts[0].ID || next != "" {
+		t.Fatalf("expected the oldest attempt on the last page, got %+v, %q", second, next)
+	}
+
+	all, _, err := repo.ListDictationAttempts(ctx, core.DictationAttemptFilter{UserID: "learner"})
+	if err != nil {
+		t.Fatalf("ListDictationAttempts() error = %v", err)
+	}
+	if len(all) != 3 || all[0].EpisodeID != otherEpisodeID {
+		t.Fatalf("expected every attempt of the learner newest first, got %+v", all)
+	}
+}
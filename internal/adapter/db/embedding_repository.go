@@ -0,0 +1,176 @@
+package db
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entembedding "github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentembedding"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/eslsoft/lession/internal/adapter/db/pgvector"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// EmbeddingRepository stores content embeddings using Ent. On PostgreSQL
+// vectors live in a pgvector column and the nearest are found by the
+// database; other databases compare every candidate in memory.
+type EmbeddingRepository struct {
+	client *entgenerated.Client
+}
+
+// NewEmbeddingRepository constructs an Ent-backed embedding repository.
+func NewEmbeddingRepository(client *entgenerated.Client) *EmbeddingRepository {
+	return &EmbeddingRepository{client: client}
+}
+
+var _ core.EmbeddingRepository = (*EmbeddingRepository)(nil)
+
+// GetEmbedding loads the embedding of a document.
+func (r *EmbeddingRepository) GetEmbedding(ctx context.Context, documentID string) (*core.ContentEmbedding, error) {
+	row, err := r.client.ContentEmbedding.Get(ctx, documentID)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainEmbedding(row), nil
+}
+
+// SaveEmbeddings replaces the embeddings of the documents in one transaction.
+func (r *EmbeddingRepository) SaveEmbeddings(ctx context.Context, embeddings ...core.ContentEmbedding) error {
+	if len(embeddings) == 0 {
+		return nil
+	}
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	ids := lo.Map(embeddings, func(e core.ContentEmbedding, _ int) string { return e.DocumentID })
+	if _, err := tx.ContentEmbedding.Delete().Where(entembedding.IDIn(ids...)).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	for _, embedding := range embeddings {
+		create := tx.ContentEmbedding.Create().
+			SetID(embedding.DocumentID).
+			SetKind(int(embedding.Kind)).
+			SetSeriesID(embedding.SeriesID).
+			SetTitle(embedding.Title).
+			SetSnippet(embedding.Snippet).
+			SetLanguage(embedding.Language).
+			SetLevel(embedding.Level).
+			SetTags(embedding.Tags).
+			SetModel(embedding.Model).
+			SetContentHash(embedding.ContentHash).
+			SetVector(pgvector.Vector(embedding.Vector))
+		if embedding.EpisodeID != uuid.Nil {
+			create.SetEpisodeID(embedding.EpisodeID)
+		}
+		if err := create.Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// DeleteEmbeddings removes the embeddings of the documents.
+func (r *EmbeddingRepository) DeleteEmbeddings(ctx context.Context, documentIDs ...string) error {
+	if len(documentIDs) == 0 {
+		return nil
+	}
+	_, err := r.client.ContentEmbedding.Delete().Where(entembedding.IDIn(documentIDs...)).Exec(ctx)
+	return err
+}
+
+// NearestEmbeddings returns hits for the embeddings closest to the query
+// vector by cosine similarity.
+func (r *EmbeddingRepository) NearestEmbeddings(ctx context.Context, query core.SimilarityQuery) ([]core.SearchHit, error) {
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	rows, err := r.client.ContentEmbedding.Query().
+		Where(embeddingFilters(query)...).
+		Order(func(s *sql.Selector) {
+			// PostgreSQL ranks by pgvector's cosine distance operator and
+			// returns the nearest; elsewhere every candidate is ranked below.
+			if s.Dialect() == dialect.Postgres {
+				s.OrderExpr(sql.Expr(s.C(entembedding.FieldVector)+" <=> CAST(? AS vector)", pgvector.Vector(query.Vector)))
+				s.Limit(limit)
+			}
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := lo.Map(rows, func(row *entgenerated.ContentEmbedding, _ int) core.SearchHit {
+		return core.SearchHit{
+			Kind:      core.SearchKind(row.Kind),
+			SeriesID:  row.SeriesID,
+			EpisodeID: row.EpisodeID,
+			Title:     row.Title,
+			Snippet:   row.Snippet,
+			Score:     pgvector.CosineSimilarity(query.Vector, row.Vector),
+		}
+	})
+	slices.SortStableFunc(hits, func(a, b core.SearchHit) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits, nil
+}
+
+func embeddingFilters(query core.SimilarityQuery) []predicate.ContentEmbedding {
+	preds := []predicate.ContentEmbedding{entembedding.Model(query.Model)}
+	if len(query.Kinds) > 0 {
+		preds = append(preds, entembedding.KindIn(lo.Map(query.Kinds, func(kind core.SearchKind, _ int) int { return int(kind) })...))
+	}
+	if query.Language != "" {
+		preds = append(preds, entembedding.Language(query.Language))
+	}
+	if query.Level != "" {
+		preds = append(preds, entembedding.Level(query.Level))
+	}
+	if len(query.Tags) > 0 {
+		preds = append(preds, func(s *sql.Selector) {
+			s.Where(sql.Or(lo.Map(query.Tags, func(tag string, _ int) *sql.Predicate {
+				return sqljson.ValueContains(entembedding.FieldTags, tag)
+			})...))
+		})
+	}
+	if len(query.ExcludeIDs) > 0 {
+		preds = append(preds, entembedding.IDNotIn(query.ExcludeIDs...))
+	}
+	return preds
+}
+
+func toDomainEmbedding(row *entgenerated.ContentEmbedding) *core.ContentEmbedding {
+	return &core.ContentEmbedding{
+		DocumentID:  row.ID,
+		Kind:        core.SearchKind(row.Kind),
+		SeriesID:    row.SeriesID,
+		EpisodeID:   row.EpisodeID,
+		Title:       row.Title,
+		Snippet:     row.Snippet,
+		Language:    row.Language,
+		Level:       row.Level,
+		Tags:        row.Tags,
+		Model:       row.Model,
+		ContentHash: row.ContentHash,
+		Vector:      row.Vector,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestEmbeddingRepository_SaveAndNearest(t *testing.T) {
+	ctx := context.Background()
+	repo := NewEmbeddingRepository(newTestClient(t, "embedding_repo"))
+	seriesID := uuid.New()
+
+	embedding := func(kind core.SearchKind, title, level string, vector ...float32) core.ContentEmbedding {
+		id := uuid.New()
+		e := core.ContentEmbedding{
+			DocumentID:  core.SearchDocumentID(kind, id),
+			Kind:        kind,
+			SeriesID:    seriesID,
+			Title:       title,
+			Language:    "en",
+			Level:       level,
+			Tags:        []string{"travel"},
+			Model:       "test",
+			ContentHash: title,
+			Vector:      vector,
+		}
+		if kind == core.SearchKindEpisode {
+			e.EpisodeID = id
+		}
+		return e
+	}
+	airport := embedding(core.SearchKindEpisode, "At the airport", "A2", 1, 0, 0)
+	hotel := embedding(core.SearchKindEpisode, "Checking in", "B1", 0.8, 0.6, 0)
+	cooking := embedding(core.SearchKindEpisode, "Cooking pasta", "A2", 0, 0, 1)
+	series := embedding(core.SearchKindSeries, "Travel English", "A2", 1, 0.1, 0)
+	if err := repo.SaveEmbeddings(ctx, airport, hotel, cooking, series); err != nil {
+		t.Fatalf("SaveEmbeddings() error = %v", err)
+	}
+
+	stored, err := repo.GetEmbedding(ctx, hotel.DocumentID)
+	if err != nil {
+		t.Fatalf("GetEmbedding() error = %v", err)
+	}
+	if !reflect.DeepEqual(stored.Vector, hotel.Vector) || stored.EpisodeID != hotel.EpisodeID || !reflect.DeepEqual(stored.Tags, hotel.Tags) {
+		t.Fatalf("unexpected embedding %+v", stored)
+	}
+
+	hits, err := repo.NearestEmbeddings(ctx, core.SimilarityQuery{
+		Vector:     []float32{1, 0, 0},
+		Model:      "test",
+		Kinds:      []core.SearchKind{core.SearchKindEpisode},
+		ExcludeIDs: []string{airport.DocumentID},
+		Limit:      2,
+	})
+	if err != nil {
+		t.Fatalf("NearestEmbeddings() error = %v", err)
+	}
+	if titles := hitTitles(hits); !reflect.DeepEqual(titles, []string{"Checking in", "Cooking pasta"}) {
+		t.Fatalf("unexpected hits %q", titles)
+	}
+	if hits[0].Score < 0.79 || hits[0].Score > 0.81 || hits[0].EpisodeID != hotel.EpisodeID {
+		t.Fatalf("unexpected best hit %+v", hits[0])
+	}
+
+	hits, err = repo.NearestEmbeddings(ctx, core.SimilarityQuery{Vector: []float32{1, 0, 0}, Model: "test", Level: "A2", Tags: []string{"travel"}})
+	if err != nil {
+		t.Fatalf("NearestEmbeddings() error = %v", err)
+	}
+	if titles := hitTitles(hits); !reflect.DeepEqual(titles, []string{"At the airport", "Travel English", "Cooking pasta"}) {
+		t.Fatalf("unexpected filtered hits %q", titles)
+	}
+	if hits, _ := repo.NearestEmbeddings(ctx, core.SimilarityQuery{Vector: []float32{1, 0, 0}, Model: "other"}); len(hits) != 0 {
+		t.Fatalf("expected vectors of other models to be ignored, got %+v", hits)
+	}
+
+	hotel.Vector = []float32{0, 1, 0}
+	if err := repo.SaveEmbeddings(ctx, hotel); err != nil {
+		t.Fatalf("SaveEmbeddings() replace error = %v", err)
+	}
+	if stored, _ := repo.GetEmbedding(ctx, hotel.DocumentID); !reflect.DeepEqual(stored.Vector, hotel.Vector) {
+		t.Fatalf("expected the embedding to be replaced, got %+v", stored)
+	}
+
+	if err := repo.DeleteEmbeddings(ctx, hotel.DocumentID, "episode-missing"); err != nil {
+		t.Fatalf("DeleteEmbeddings() error = %v", err)
+	}
+	if _, err := repo.GetEmbedding(ctx, hotel.DocumentID); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func hitTitles(hits []core.SearchHit) []string {
+	titles := make([]string, len(hits))
+	for i, hit := range hits {
+		titles[i] = hit.Title
+	}
+	return titles
+}
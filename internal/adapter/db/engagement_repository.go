@@ -0,0 +1,200 @@
+package db
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entrollup "github.com/eslsoft/lession/internal/adapter/db/ent/generated/engagementrollup"
+	entplayback "github.com/eslsoft/lession/internal/adapter/db/ent/generated/playbacksession"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// EngagementRepository aggregates playback sessions and persists engagement
+// rollups using Ent.
+type EngagementRepository struct {
+	client *entgenerated.Client
+}
+
+// NewEngagementRepository constructs an Ent-backed engagement repository.
+func NewEngagementRepository(client *entgenerated.Client) *EngagementRepository {
+	return &EngagementRepository{client: client}
+}
+
+var _ core.EngagementRepository = (*EngagementRepository)(nil)
+
+// ListPlayedEpisodes returns the episodes with sessions started within [from, to).
+func (r *EngagementRepository) ListPlayedEpisodes(ctx context.Context, from, to time.Time) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.client.PlaybackSession.Query().
+		Where(
+			entplayback.StartedAtGTE(from),
+			entplayback.StartedAtLT(to),
+		).
+		Unique(true).
+		Select(entplayback.FieldEpisodeID).
+		Scan(ctx, &ids)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// SummarizePlayback aggregates the sessions of the episodes in one query.
+func (r *EngagementRepository) SummarizePlayback(ctx context.Context, episodeIDs []uuid.UUID, from, to time.Time) (core.EngagementMetrics, error) {
+	if len(episodeIDs) == 0 {
+		return core.EngagementMetrics{}, nil
+	}
+	query := r.client.PlaybackSession.Query().
+		Where(entplayback.EpisodeIDIn(episodeIDs...))
+	if !from.IsZero() {
+		query = query.Where(entplayback.StartedAtGTE(from))
+	}
+	if !to.IsZero() {
+		query = query.Where(entplayback.StartedAtLT(to))
+	}
+
+	var rows []playbackSummary
+	err := query.
+		Aggregate(
+			func(s *sql.Selector) string {
+				return sql.As(sql.Count("*"), "sessions")
+			},
+			func(s *sql.Selector) string {
+				return sql.As(sql.Count(s.C(entplayback.FieldFinishedAt)), "finished_sessions")
+			},
+			func(s *sql.Selector) string {
+				return sql.As(sql.Count(sql.Distinct(s.C(entplayback.FieldUserID))), "unique_listeners")
+			},
+			func(s *sql.Selector) string {
+				return sql.As("COUNT(DISTINCT CASE WHEN "+s.C(entplayback.FieldFinishedAt)+" IS NOT NULL THEN "+s.C(entplayback.FieldUserID)+" END)", "completers")
+			},
+		).
+		Scan(ctx, &rows)
+	if err != nil || len(rows) == 0 {
+		return core.EngagementMetrics{}, err
+	}
+	return core.EngagementMetrics(rows[0]), nil
+}
+
+type playbackSummary struct {
+	Sessions         int `json:"sessions"`
+	FinishedSessions int `json:"finished_sessions"`
+	UniqueListeners  int `json:"unique_listeners"`
+	Completers       int `json:"completers"`
+}
+
+// SaveEngagementRollups replaces the rollups in a single transaction.
+func (r *EngagementRepository) SaveEngagementRollups(ctx context.Context, rollups []core.EngagementRollup) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, rollup := range rollups {
+		day := entrollup.DayIsNil()
+		if rollup.Day != nil {
+			day = entrollup.Day(*rollup.Day)
+		}
+		if _, err := tx.EngagementRollup.Delete().
+			Where(entrollup.SubjectID(rollup.SubjectID), day).
+			Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		if err := tx.EngagementRollup.Create().
+			SetSubjectID(rollup.SubjectID).
+			SetSeriesID(rollup.SeriesID).
+			SetNillableDay(rollup.Day).
+			SetSessions(rollup.Metrics.Sessions).
+			SetFinishedSessions(rollup.Metrics.FinishedSessions).
+			SetUniqueListeners(rollup.Metrics.UniqueListeners).
+			SetCompleters(rollup.Metrics.Completers).
+			Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListEngagementRollups returns the all-time rollup of the subject followed
+// by its daily rollups within [from, to).
+func (r *EngagementRepository) ListEngagementRollups(ctx context.Context, subjectID uuid.UUID, from, to time.Time) ([]core.EngagementRollup, error) {
+	rows, err := r.client.EngagementRollup.Query().
+		Where(
+			entrollup.SubjectID(subjectID),
+			entrollup.Or(
+				entrollup.DayIsNil(),
+				entrollup.And(entrollup.DayGTE(from), entrollup.DayLT(to)),
+			),
+		).
+		Order(entrollup.ByDay()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Databases disagree on where nulls sort, so the all-time rollup is
+	// moved to the front here.
+	slices.SortStableFunc(rows, func(a, b *entgenerated.EngagementRollup) int {
+		return cmp.Compare(lo.Ternary(a.Day == nil, 0, 1), lo.Ternary(b.Day == nil, 0, 1))
+	})
+	return lo.Map(rows, func(row *entgenerated.EngagementRollup, _ int) core.EngagementRollup {
+		return toDomainEngagementRollup(row)
+	}), nil
+}
+
+// ListDailyEngagementRollups returns the rollups of every subject for the
+// day, ordered by subject.
+func (r *EngagementRepository) ListDailyEngagementRollups(ctx context.Context, day time.Time) ([]core.EngagementRollup, error) {
+	rows, err := r.client.EngagementRollup.Query().
+		Where(entrollup.Day(day)).
+		Order(entrollup.BySubjectID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(row *entgenerated.EngagementRollup, _ int) core.EngagementRollup {
+		return toDomainEngagementRollup(row)
+	}), nil
+}
+
+// ListPlaybackSessions returns the sessions started within [from, to),
+// oldest first.
+func (r *EngagementRepository) ListPlaybackSessions(ctx context.Context, from, to time.Time) ([]core.PlaybackSession, error) {
+	rows, err := r.client.PlaybackSession.Query().
+		Where(
+			entplayback.StartedAtGTE(from),
+			entplayback.StartedAtLT(to),
+		).
+		Order(entplayback.ByStartedAt(), entplayback.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(row *entgenerated.PlaybackSession, _ int) core.PlaybackSession {
+		return *toDomainPlaybackSession(row)
+	}), nil
+}
+
+func toDomainEngagementRollup(row *entgenerated.EngagementRollup) core.EngagementRollup {
+	return core.EngagementRollup{
+		SubjectID: row.SubjectID,
+		SeriesID:  row.SeriesID,
+		Day:       row.Day,
+		Metrics: core.EngagementMetrics{
+			Sessions:         row.Sessions,
+			FinishedSessions: row.FinishedSessions,
+			UniqueListeners:  row.UniqueListeners,
+			Completers:       row.Completers,
+		},
+		UpdatedAt: row.UpdatedAt,
+	}
+}
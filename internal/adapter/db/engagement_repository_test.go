@@ -0,0 +1,108 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestEngagementRepository_SummarizePlayback(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "engagement_repo")
+	repo := NewEngagementRepository(client)
+
+	day := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	episodeIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	history := NewWatchHistoryRepository(client)
+	record := func(userID string, episodeID uuid.UUID, started time.Time, finished bool) {
+		session := core.PlaybackSession{ID: uuid.New(), UserID: userID, EpisodeID: episodeID, StartedAt: started}
+		if finished {
+			session.FinishedAt = &started
+		}
+		if _, err := history.CreatePlaybackSession(ctx, session); err != nil {
+			t.Fatalf("CreatePlaybackSession() error = %v", err)
+		}
+	}
+	record("u1", episodeIDs[0], day.Add(time.Hour), true)
+	record("u1", episodeIDs[0], day.Add(2*time.Hour), false)
+	record("u2", episodeIDs[1], day.Add(3*time.Hour), false)
+	record("u3", episodeIDs[0], day.Add(-time.Hour), true)
+
+	played, err := repo.ListPlayedEpisodes(ctx, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("ListPlayedEpisodes() error = %v", err)
+	}
+	if len(played) != 2 {
+		t.Fatalf("expected both episodes played that day, got %v", played)
+	}
+
+	sessions, err := repo.ListPlaybackSessions(ctx, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("ListPlaybackSessions() error = %v", err)
+	}
+	if len(sessions) != 3 || !sessions[0].StartedAt.Equal(day.Add(time.Hour)) || sessions[0].FinishedAt == nil {
+		t.Fatalf("expected the sessions started that day in order, got %#v", sessions)
+	}
+
+	metrics, err := repo.SummarizePlayback(ctx, episodeIDs, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("SummarizePlayback() error = %v", err)
+	}
+	if metrics != (core.EngagementMetrics{Sessions: 3, FinishedSessions: 1, UniqueListeners: 2, Completers: 1}) {
+		t.Fatalf("unexpected daily metrics %#v", metrics)
+	}
+
+	metrics, err = repo.SummarizePlayback(ctx, episodeIDs[:1], time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatalf("SummarizePlayback() error = %v", err)
+	}
+	if metrics != (core.EngagementMetrics{Sessions: 3, FinishedSessions: 2, UniqueListeners: 2, Completers: 2}) {
+		t.Fatalf("unexpected total metrics %#v", metrics)
+	}
+}
+
+func TestEngagementRepository_SaveAndListRollups(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "engagement_repo")
+	repo := NewEngagementRepository(client)
+
+	day := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	subjectID, seriesID := uuid.New(), uuid.New()
+	save := func(sessions int) {
+		err := repo.SaveEngagementRollups(ctx, []core.EngagementRollup{
+			{SubjectID: subjectID, SeriesID: seriesID, Day: &day, Metrics: core.EngagementMetrics{Sessions: sessions}},
+			{SubjectID: subjectID, SeriesID: seriesID, Metrics: core.EngagementMetrics{Sessions: sessions * 10}},
+		})
+		if err != nil {
+			t.Fatalf("SaveEngagementRollups() error = %v", err)
+		}
+	}
+	save(1)
+	save(2)
+
+	rollups, err := repo.ListEngagementRollups(ctx, subjectID, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("ListEngagementRollups() error = %v", err)
+	}
+	if len(rollups) != 2 {
+		t.Fatalf("expected saving again to replace the rollups, got %d", len(rollups))
+	}
+	if rollups[0].Day != nil || rollups[0].Metrics.Sessions != 20 {
+		t.Fatalf("expected the all-time rollup first, got %#v", rollups[0])
+	}
+	if rollups[1].Day == nil || rollups[1].Metrics.Sessions != 2 {
+		t.Fatalf("unexpected daily rollup %#v", rollups[1])
+	}
+
+	daily, err := repo.ListDailyEngagementRollups(ctx, day)
+	if err != nil {
+		t.Fatalf("ListDailyEngagementRollups() error = %v", err)
+	}
+	if len(daily) != 1 || daily[0].SubjectID != subjectID || daily[0].Metrics.Sessions != 2 {
+		t.Fatalf("expected only the daily rollup, got %#v", daily)
+	}
+}
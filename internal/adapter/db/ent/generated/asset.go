@@ -3,6 +3,7 @@
 package generated
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/asset"
+	"github.com/eslsoft/lession/internal/core"
 	"github.com/google/uuid"
 )
 
@@ -18,6 +20,12 @@ type Asset struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// AssetKey holds the value of the "asset_key" field.
 	AssetKey string `json:"asset_key,omitempty"`
 	// Type holds the value of the "type" field.
@@ -34,10 +42,22 @@ type Asset struct {
 	DurationSeconds int `json:"duration_seconds,omitempty"`
 	// PlaybackURL holds the value of the "playback_url" field.
 	PlaybackURL string `json:"playback_url,omitempty"`
-	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt time.Time `json:"created_at,omitempty"`
-	// UpdatedAt holds the value of the "updated_at" field.
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// HlsManifestURL holds the value of the "hls_manifest_url" field.
+	HlsManifestURL string `json:"hls_manifest_url,omitempty"`
+	// HlsKeyID holds the value of the "hls_key_id" field.
+	HlsKeyID *uuid.UUID `json:"hls_key_id,omitempty"`
+	// Width holds the value of the "width" field.
+	Width int `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height int `json:"height,omitempty"`
+	// ImageVariants holds the value of the "image_variants" field.
+	ImageVariants []core.ImageVariant `json:"image_variants,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// FailureCode holds the value of the "failure_code" field.
+	FailureCode int `json:"failure_code,omitempty"`
+	// FailureReason holds the value of the "failure_reason" field.
+	FailureReason string `json:"failure_reason,omitempty"`
 	// ReadyAt holds the value of the "ready_at" field.
 	ReadyAt      *time.Time `json:"ready_at,omitempty"`
 	selectValues sql.SelectValues
@@ -48,11 +68,15 @@ func (*Asset) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case asset.FieldType, asset.FieldStatus, asset.FieldFilesize, asset.FieldDurationSeconds:
+		case asset.FieldHlsKeyID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case asset.FieldImageVariants:
+			values[i] = new([]byte)
+		case asset.FieldType, asset.FieldStatus, asset.FieldFilesize, asset.FieldDurationSeconds, asset.FieldWidth, asset.FieldHeight, asset.FieldFailureCode:
 			values[i] = new(sql.NullInt64)
-		case asset.FieldAssetKey, asset.FieldOriginalFilename, asset.FieldMimeType, asset.FieldPlaybackURL:
+		case asset.FieldAssetKey, asset.FieldOriginalFilename, asset.FieldMimeType, asset.FieldPlaybackURL, asset.FieldHlsManifestURL, asset.FieldProvider, asset.FieldFailureReason:
 			values[i] = new(sql.NullString)
-		case asset.FieldCreatedAt, asset.FieldUpdatedAt, asset.FieldReadyAt:
+		case asset.FieldCreatedAt, asset.FieldUpdatedAt, asset.FieldDeletedAt, asset.FieldReadyAt:
 			values[i] = new(sql.NullTime)
 		case asset.FieldID:
 			values[i] = new(uuid.UUID)
@@ -77,6 +101,25 @@ func (_m *Asset) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case asset.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case asset.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case asset.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case asset.FieldAssetKey:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field asset_key", values[i])
@@ -125,17 +168,56 @@ func (_m *Asset) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.PlaybackURL = value.String
 			}
-		case asset.FieldCreatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+		case asset.FieldHlsManifestURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hls_manifest_url", values[i])
 			} else if value.Valid {
-				_m.CreatedAt = value.Time
+				_m.HlsManifestURL = value.String
 			}
-		case asset.FieldUpdatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+		case asset.FieldHlsKeyID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field hls_key_id", values[i])
 			} else if value.Valid {
-				_m.UpdatedAt = value.Time
+				_m.HlsKeyID = new(uuid.UUID)
+				*_m.HlsKeyID = *value.S.(*uuid.UUID)
+			}
+		case asset.FieldWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				_m.Width = int(value.Int64)
+			}
+		case asset.FieldHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				_m.Height = int(value.Int64)
+			}
+		case asset.FieldImageVariants:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field image_variants", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ImageVariants); err != nil {
+					return fmt.Errorf("unmarshal field image_variants: %w", err)
+				}
+			}
+		case asset.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case asset.FieldFailureCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_code", values[i])
+			} else if value.Valid {
+				_m.FailureCode = int(value.Int64)
+			}
+		case asset.FieldFailureReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_reason", values[i])
+			} else if value.Valid {
+				_m.FailureReason = value.String
 			}
 		case asset.FieldReadyAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -180,6 +262,17 @@ func (_m *Asset) String() string {
 	var builder strings.Builder
 	builder.WriteString("Asset(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("asset_key=")
 	builder.WriteString(_m.AssetKey)
 	builder.WriteString(", ")
@@ -204,11 +297,31 @@ func (_m *Asset) String() string {
 	builder.WriteString("playback_url=")
 	builder.WriteString(_m.PlaybackURL)
 	builder.WriteString(", ")
-	builder.WriteString("created_at=")
-	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString("hls_manifest_url=")
+	builder.WriteString(_m.HlsManifestURL)
 	builder.WriteString(", ")
-	builder.WriteString("updated_at=")
-	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	if v := _m.HlsKeyID; v != nil {
+		builder.WriteString("hls_key_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("width=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Width))
+	builder.WriteString(", ")
+	builder.WriteString("height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Height))
+	builder.WriteString(", ")
+	builder.WriteString("image_variants=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ImageVariants))
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("failure_code=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FailureCode))
+	builder.WriteString(", ")
+	builder.WriteString("failure_reason=")
+	builder.WriteString(_m.FailureReason)
 	builder.WriteString(", ")
 	if v := _m.ReadyAt; v != nil {
 		builder.WriteString("ready_at=")
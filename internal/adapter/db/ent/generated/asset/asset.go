@@ -3,8 +3,7 @@
 package asset
 
 import (
-	"time"
-
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 )
@@ -14,6 +13,12 @@ const (
 	Label = "asset"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldAssetKey holds the string denoting the asset_key field in the database.
 	FieldAssetKey = "asset_key"
 	// FieldType holds the string denoting the type field in the database.
@@ -30,10 +35,22 @@ const (
 	FieldDurationSeconds = "duration_seconds"
 	// FieldPlaybackURL holds the string denoting the playback_url field in the database.
 	FieldPlaybackURL = "playback_url"
-	// FieldCreatedAt holds the string denoting the created_at field in the database.
-	FieldCreatedAt = "created_at"
-	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
-	FieldUpdatedAt = "updated_at"
+	// FieldHlsManifestURL holds the string denoting the hls_manifest_url field in the database.
+	FieldHlsManifestURL = "hls_manifest_url"
+	// FieldHlsKeyID holds the string denoting the hls_key_id field in the database.
+	FieldHlsKeyID = "hls_key_id"
+	// FieldWidth holds the string denoting the width field in the database.
+	FieldWidth = "width"
+	// FieldHeight holds the string denoting the height field in the database.
+	FieldHeight = "height"
+	// FieldImageVariants holds the string denoting the image_variants field in the database.
+	FieldImageVariants = "image_variants"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldFailureCode holds the string denoting the failure_code field in the database.
+	FieldFailureCode = "failure_code"
+	// FieldFailureReason holds the string denoting the failure_reason field in the database.
+	FieldFailureReason = "failure_reason"
 	// FieldReadyAt holds the string denoting the ready_at field in the database.
 	FieldReadyAt = "ready_at"
 	// Table holds the table name of the asset in the database.
@@ -43,6 +60,9 @@ const (
 // Columns holds all SQL columns for asset fields.
 var Columns = []string{
 	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
 	FieldAssetKey,
 	FieldType,
 	FieldStatus,
@@ -51,8 +71,14 @@ var Columns = []string{
 	FieldFilesize,
 	FieldDurationSeconds,
 	FieldPlaybackURL,
-	FieldCreatedAt,
-	FieldUpdatedAt,
+	FieldHlsManifestURL,
+	FieldHlsKeyID,
+	FieldWidth,
+	FieldHeight,
+	FieldImageVariants,
+	FieldProvider,
+	FieldFailureCode,
+	FieldFailureReason,
 	FieldReadyAt,
 }
 
@@ -66,7 +92,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eslsoft/lession/internal/adapter/db/ent/generated/runtime"
 var (
+	Hooks        [3]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// DefaultType holds the default value on creation for the "type" field.
 	DefaultType int
 	// DefaultStatus holds the default value on creation for the "status" field.
@@ -75,12 +108,18 @@ var (
 	DefaultFilesize int64
 	// DefaultDurationSeconds holds the default value on creation for the "duration_seconds" field.
 	DefaultDurationSeconds int
-	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
-	DefaultCreatedAt func() time.Time
-	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
-	DefaultUpdatedAt func() time.Time
-	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
-	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultHlsManifestURL holds the default value on creation for the "hls_manifest_url" field.
+	DefaultHlsManifestURL string
+	// DefaultWidth holds the default value on creation for the "width" field.
+	DefaultWidth int
+	// DefaultHeight holds the default value on creation for the "height" field.
+	DefaultHeight int
+	// DefaultProvider holds the default value on creation for the "provider" field.
+	DefaultProvider string
+	// DefaultFailureCode holds the default value on creation for the "failure_code" field.
+	DefaultFailureCode int
+	// DefaultFailureReason holds the default value on creation for the "failure_reason" field.
+	DefaultFailureReason string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -93,6 +132,21 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByAssetKey orders the results by the asset_key field.
 func ByAssetKey(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAssetKey, opts...).ToFunc()
@@ -133,14 +187,39 @@ func ByPlaybackURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPlaybackURL, opts...).ToFunc()
 }
 
-// ByCreatedAt orders the results by the created_at field.
-func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+// ByHlsManifestURL orders the results by the hls_manifest_url field.
+func ByHlsManifestURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHlsManifestURL, opts...).ToFunc()
 }
 
-// ByUpdatedAt orders the results by the updated_at field.
-func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+// ByHlsKeyID orders the results by the hls_key_id field.
+func ByHlsKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHlsKeyID, opts...).ToFunc()
+}
+
+// ByWidth orders the results by the width field.
+func ByWidth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWidth, opts...).ToFunc()
+}
+
+// ByHeight orders the results by the height field.
+func ByHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeight, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByFailureCode orders the results by the failure_code field.
+func ByFailureCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailureCode, opts...).ToFunc()
+}
+
+// ByFailureReason orders the results by the failure_reason field.
+func ByFailureReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailureReason, opts...).ToFunc()
 }
 
 // ByReadyAt orders the results by the ready_at field.
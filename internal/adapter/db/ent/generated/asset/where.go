@@ -55,6 +55,21 @@ func IDLTE(id uuid.UUID) predicate.Asset {
 	return predicate.Asset(sql.FieldLTE(FieldID, id))
 }
 
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // AssetKey applies equality check predicate on the "asset_key" field. It's identical to AssetKeyEQ.
 func AssetKey(v string) predicate.Asset {
 	return predicate.Asset(sql.FieldEQ(FieldAssetKey, v))
@@ -95,14 +110,39 @@ func PlaybackURL(v string) predicate.Asset {
 	return predicate.Asset(sql.FieldEQ(FieldPlaybackURL, v))
 }
 
-// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
-func CreatedAt(v time.Time) predicate.Asset {
-	return predicate.Asset(sql.FieldEQ(FieldCreatedAt, v))
+// HlsManifestURL applies equality check predicate on the "hls_manifest_url" field. It's identical to HlsManifestURLEQ.
+func HlsManifestURL(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldHlsManifestURL, v))
 }
 
-// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
-func UpdatedAt(v time.Time) predicate.Asset {
-	return predicate.Asset(sql.FieldEQ(FieldUpdatedAt, v))
+// HlsKeyID applies equality check predicate on the "hls_key_id" field. It's identical to HlsKeyIDEQ.
+func HlsKeyID(v uuid.UUID) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldHlsKeyID, v))
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldWidth, v))
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldHeight, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldProvider, v))
+}
+
+// FailureCode applies equality check predicate on the "failure_code" field. It's identical to FailureCodeEQ.
+func FailureCode(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldFailureCode, v))
+}
+
+// FailureReason applies equality check predicate on the "failure_reason" field. It's identical to FailureReasonEQ.
+func FailureReason(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldFailureReason, v))
 }
 
 // ReadyAt applies equality check predicate on the "ready_at" field. It's identical to ReadyAtEQ.
@@ -110,6 +150,136 @@ func ReadyAt(v time.Time) predicate.Asset {
 	return predicate.Asset(sql.FieldEQ(FieldReadyAt, v))
 }
 
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Asset {
+	return predicate.Asset(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Asset {
+	return predicate.Asset(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // AssetKeyEQ applies the EQ predicate on the "asset_key" field.
 func AssetKeyEQ(v string) predicate.Asset {
 	return predicate.Asset(sql.FieldEQ(FieldAssetKey, v))
@@ -540,84 +710,379 @@ func PlaybackURLContainsFold(v string) predicate.Asset {
 	return predicate.Asset(sql.FieldContainsFold(FieldPlaybackURL, v))
 }
 
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.Asset {
-	return predicate.Asset(sql.FieldEQ(FieldCreatedAt, v))
+// HlsManifestURLEQ applies the EQ predicate on the "hls_manifest_url" field.
+func HlsManifestURLEQ(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldHlsManifestURL, v))
 }
 
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.Asset {
-	return predicate.Asset(sql.FieldNEQ(FieldCreatedAt, v))
+// HlsManifestURLNEQ applies the NEQ predicate on the "hls_manifest_url" field.
+func HlsManifestURLNEQ(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldHlsManifestURL, v))
 }
 
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.Asset {
-	return predicate.Asset(sql.FieldIn(FieldCreatedAt, vs...))
+// HlsManifestURLIn applies the In predicate on the "hls_manifest_url" field.
+func HlsManifestURLIn(vs ...string) predicate.Asset {
+	return predicate.Asset(sql.FieldIn(FieldHlsManifestURL, vs...))
 }
 
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.Asset {
-	return predicate.Asset(sql.FieldNotIn(FieldCreatedAt, vs...))
+// HlsManifestURLNotIn applies the NotIn predicate on the "hls_manifest_url" field.
+func HlsManifestURLNotIn(vs ...string) predicate.Asset {
+	return predicate.Asset(sql.FieldNotIn(FieldHlsManifestURL, vs...))
 }
 
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.Asset {
-	return predicate.Asset(sql.FieldGT(FieldCreatedAt, v))
+// HlsManifestURLGT applies the GT predicate on the "hls_manifest_url" field.
+func HlsManifestURLGT(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldGT(FieldHlsManifestURL, v))
 }
 
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.Asset {
-	return predicate.Asset(sql.FieldGTE(FieldCreatedAt, v))
+// HlsManifestURLGTE applies the GTE predicate on the "hls_manifest_url" field.
+func HlsManifestURLGTE(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldGTE(FieldHlsManifestURL, v))
 }
 
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.Asset {
-	return predicate.Asset(sql.FieldLT(FieldCreatedAt, v))
+// HlsManifestURLLT applies the LT predicate on the "hls_manifest_url" field.
+func HlsManifestURLLT(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldLT(FieldHlsManifestURL, v))
 }
 
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.Asset {
-	return predicate.Asset(sql.FieldLTE(FieldCreatedAt, v))
+// HlsManifestURLLTE applies the LTE predicate on the "hls_manifest_url" field.
+func HlsManifestURLLTE(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldLTE(FieldHlsManifestURL, v))
 }
 
-// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
-func UpdatedAtEQ(v time.Time) predicate.Asset {
-	return predicate.Asset(sql.FieldEQ(FieldUpdatedAt, v))
+// HlsManifestURLContains applies the Contains predicate on the "hls_manifest_url" field.
+func HlsManifestURLContains(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldContains(FieldHlsManifestURL, v))
 }
 
-// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
-func UpdatedAtNEQ(v time.Time) predicate.Asset {
-	return predicate.Asset(sql.FieldNEQ(FieldUpdatedAt, v))
+// HlsManifestURLHasPrefix applies the HasPrefix predicate on the "hls_manifest_url" field.
+func HlsManifestURLHasPrefix(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldHasPrefix(FieldHlsManifestURL, v))
 }
 
-// UpdatedAtIn applies the In predicate on the "updated_at" field.
-func UpdatedAtIn(vs ...time.Time) predicate.Asset {
-	return predicate.Asset(sql.FieldIn(FieldUpdatedAt, vs...))
+// HlsManifestURLHasSuffix applies the HasSuffix predicate on the "hls_manifest_url" field.
+func HlsManifestURLHasSuffix(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldHasSuffix(FieldHlsManifestURL, v))
 }
 
-// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
-func UpdatedAtNotIn(vs ...time.Time) predicate.Asset {
-	return predicate.Asset(sql.FieldNotIn(FieldUpdatedAt, vs...))
+// HlsManifestURLEqualFold applies the EqualFold predicate on the "hls_manifest_url" field.
+func HlsManifestURLEqualFold(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEqualFold(FieldHlsManifestURL, v))
 }
 
-// UpdatedAtGT applies the GT predicate on the "updated_at" field.
-func UpdatedAtGT(v time.Time) predicate.Asset {
-	return predicate.Asset(sql.FieldGT(FieldUpdatedAt, v))
+// HlsManifestURLContainsFold applies the ContainsFold predicate on the "hls_manifest_url" field.
+func HlsManifestURLContainsFold(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldContainsFold(FieldHlsManifestURL, v))
 }
 
-// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
-func UpdatedAtGTE(v time.Time) predicate.Asset {
-	return predicate.Asset(sql.FieldGTE(FieldUpdatedAt, v))
+// HlsKeyIDEQ applies the EQ predicate on the "hls_key_id" field.
+func HlsKeyIDEQ(v uuid.UUID) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldHlsKeyID, v))
 }
 
-// UpdatedAtLT applies the LT predicate on the "updated_at" field.
-func UpdatedAtLT(v time.Time) predicate.Asset {
-	return predicate.Asset(sql.FieldLT(FieldUpdatedAt, v))
+// HlsKeyIDNEQ applies the NEQ predicate on the "hls_key_id" field.
+func HlsKeyIDNEQ(v uuid.UUID) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldHlsKeyID, v))
 }
 
-// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
-func UpdatedAtLTE(v time.Time) predicate.Asset {
-	return predicate.Asset(sql.FieldLTE(FieldUpdatedAt, v))
+// HlsKeyIDIn applies the In predicate on the "hls_key_id" field.
+func HlsKeyIDIn(vs ...uuid.UUID) predicate.Asset {
+	return predicate.Asset(sql.FieldIn(FieldHlsKeyID, vs...))
+}
+
+// HlsKeyIDNotIn applies the NotIn predicate on the "hls_key_id" field.
+func HlsKeyIDNotIn(vs ...uuid.UUID) predicate.Asset {
+	return predicate.Asset(sql.FieldNotIn(FieldHlsKeyID, vs...))
+}
+
+// HlsKeyIDGT applies the GT predicate on the "hls_key_id" field.
+func HlsKeyIDGT(v uuid.UUID) predicate.Asset {
+	return predicate.Asset(sql.FieldGT(FieldHlsKeyID, v))
+}
+
+// HlsKeyIDGTE applies the GTE predicate on the "hls_key_id" field.
+func HlsKeyIDGTE(v uuid.UUID) predicate.Asset {
+	return predicate.Asset(sql.FieldGTE(FieldHlsKeyID, v))
+}
+
+// HlsKeyIDLT applies the LT predicate on the "hls_key_id" field.
+func HlsKeyIDLT(v uuid.UUID) predicate.Asset {
+	return predicate.Asset(sql.FieldLT(FieldHlsKeyID, v))
+}
+
+// HlsKeyIDLTE applies the LTE predicate on the "hls_key_id" field.
+func HlsKeyIDLTE(v uuid.UUID) predicate.Asset {
+	return predicate.Asset(sql.FieldLTE(FieldHlsKeyID, v))
+}
+
+// HlsKeyIDIsNil applies the IsNil predicate on the "hls_key_id" field.
+func HlsKeyIDIsNil() predicate.Asset {
+	return predicate.Asset(sql.FieldIsNull(FieldHlsKeyID))
+}
+
+// HlsKeyIDNotNil applies the NotNil predicate on the "hls_key_id" field.
+func HlsKeyIDNotNil() predicate.Asset {
+	return predicate.Asset(sql.FieldNotNull(FieldHlsKeyID))
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldWidth, v))
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldWidth, v))
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int) predicate.Asset {
+	return predicate.Asset(sql.FieldIn(FieldWidth, vs...))
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int) predicate.Asset {
+	return predicate.Asset(sql.FieldNotIn(FieldWidth, vs...))
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldGT(FieldWidth, v))
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldGTE(FieldWidth, v))
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldLT(FieldWidth, v))
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldLTE(FieldWidth, v))
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldHeight, v))
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldHeight, v))
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...int) predicate.Asset {
+	return predicate.Asset(sql.FieldIn(FieldHeight, vs...))
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...int) predicate.Asset {
+	return predicate.Asset(sql.FieldNotIn(FieldHeight, vs...))
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldGT(FieldHeight, v))
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldGTE(FieldHeight, v))
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldLT(FieldHeight, v))
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldLTE(FieldHeight, v))
+}
+
+// ImageVariantsIsNil applies the IsNil predicate on the "image_variants" field.
+func ImageVariantsIsNil() predicate.Asset {
+	return predicate.Asset(sql.FieldIsNull(FieldImageVariants))
+}
+
+// ImageVariantsNotNil applies the NotNil predicate on the "image_variants" field.
+func ImageVariantsNotNil() predicate.Asset {
+	return predicate.Asset(sql.FieldNotNull(FieldImageVariants))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.Asset {
+	return predicate.Asset(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.Asset {
+	return predicate.Asset(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// FailureCodeEQ applies the EQ predicate on the "failure_code" field.
+func FailureCodeEQ(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldFailureCode, v))
+}
+
+// FailureCodeNEQ applies the NEQ predicate on the "failure_code" field.
+func FailureCodeNEQ(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldFailureCode, v))
+}
+
+// FailureCodeIn applies the In predicate on the "failure_code" field.
+func FailureCodeIn(vs ...int) predicate.Asset {
+	return predicate.Asset(sql.FieldIn(FieldFailureCode, vs...))
+}
+
+// FailureCodeNotIn applies the NotIn predicate on the "failure_code" field.
+func FailureCodeNotIn(vs ...int) predicate.Asset {
+	return predicate.Asset(sql.FieldNotIn(FieldFailureCode, vs...))
+}
+
+// FailureCodeGT applies the GT predicate on the "failure_code" field.
+func FailureCodeGT(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldGT(FieldFailureCode, v))
+}
+
+// FailureCodeGTE applies the GTE predicate on the "failure_code" field.
+func FailureCodeGTE(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldGTE(FieldFailureCode, v))
+}
+
+// FailureCodeLT applies the LT predicate on the "failure_code" field.
+func FailureCodeLT(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldLT(FieldFailureCode, v))
+}
+
+// FailureCodeLTE applies the LTE predicate on the "failure_code" field.
+func FailureCodeLTE(v int) predicate.Asset {
+	return predicate.Asset(sql.FieldLTE(FieldFailureCode, v))
+}
+
+// FailureReasonEQ applies the EQ predicate on the "failure_reason" field.
+func FailureReasonEQ(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldFailureReason, v))
+}
+
+// FailureReasonNEQ applies the NEQ predicate on the "failure_reason" field.
+func FailureReasonNEQ(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldFailureReason, v))
+}
+
+// FailureReasonIn applies the In predicate on the "failure_reason" field.
+func FailureReasonIn(vs ...string) predicate.Asset {
+	return predicate.Asset(sql.FieldIn(FieldFailureReason, vs...))
+}
+
+// FailureReasonNotIn applies the NotIn predicate on the "failure_reason" field.
+func FailureReasonNotIn(vs ...string) predicate.Asset {
+	return predicate.Asset(sql.FieldNotIn(FieldFailureReason, vs...))
+}
+
+// FailureReasonGT applies the GT predicate on the "failure_reason" field.
+func FailureReasonGT(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldGT(FieldFailureReason, v))
+}
+
+// FailureReasonGTE applies the GTE predicate on the "failure_reason" field.
+func FailureReasonGTE(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldGTE(FieldFailureReason, v))
+}
+
+// FailureReasonLT applies the LT predicate on the "failure_reason" field.
+func FailureReasonLT(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldLT(FieldFailureReason, v))
+}
+
+// FailureReasonLTE applies the LTE predicate on the "failure_reason" field.
+func FailureReasonLTE(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldLTE(FieldFailureReason, v))
+}
+
+// FailureReasonContains applies the Contains predicate on the "failure_reason" field.
+func FailureReasonContains(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldContains(FieldFailureReason, v))
+}
+
+// FailureReasonHasPrefix applies the HasPrefix predicate on the "failure_reason" field.
+func FailureReasonHasPrefix(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldHasPrefix(FieldFailureReason, v))
+}
+
+// FailureReasonHasSuffix applies the HasSuffix predicate on the "failure_reason" field.
+func FailureReasonHasSuffix(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldHasSuffix(FieldFailureReason, v))
+}
+
+// FailureReasonEqualFold applies the EqualFold predicate on the "failure_reason" field.
+func FailureReasonEqualFold(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEqualFold(FieldFailureReason, v))
+}
+
+// FailureReasonContainsFold applies the ContainsFold predicate on the "failure_reason" field.
+func FailureReasonContainsFold(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldContainsFold(FieldFailureReason, v))
 }
 
 // ReadyAtEQ applies the EQ predicate on the "ready_at" field.
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/asset"
+	"github.com/eslsoft/lession/internal/core"
 	"github.com/google/uuid"
 )
 
@@ -21,6 +22,32 @@ type AssetCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (_c *AssetCreate) SetCreatedAt(v time.Time) *AssetCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AssetCreate) SetUpdatedAt(v time.Time) *AssetCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *AssetCreate) SetDeletedAt(v time.Time) *AssetCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableDeletedAt(v *time.Time) *AssetCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetAssetKey sets the "asset_key" field.
 func (_c *AssetCreate) SetAssetKey(v string) *AssetCreate {
 	_c.mutation.SetAssetKey(v)
@@ -109,30 +136,106 @@ func (_c *AssetCreate) SetNillablePlaybackURL(v *string) *AssetCreate {
 	return _c
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_c *AssetCreate) SetCreatedAt(v time.Time) *AssetCreate {
-	_c.mutation.SetCreatedAt(v)
+// SetHlsManifestURL sets the "hls_manifest_url" field.
+func (_c *AssetCreate) SetHlsManifestURL(v string) *AssetCreate {
+	_c.mutation.SetHlsManifestURL(v)
 	return _c
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_c *AssetCreate) SetNillableCreatedAt(v *time.Time) *AssetCreate {
+// SetNillableHlsManifestURL sets the "hls_manifest_url" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableHlsManifestURL(v *string) *AssetCreate {
 	if v != nil {
-		_c.SetCreatedAt(*v)
+		_c.SetHlsManifestURL(*v)
 	}
 	return _c
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_c *AssetCreate) SetUpdatedAt(v time.Time) *AssetCreate {
-	_c.mutation.SetUpdatedAt(v)
+// SetHlsKeyID sets the "hls_key_id" field.
+func (_c *AssetCreate) SetHlsKeyID(v uuid.UUID) *AssetCreate {
+	_c.mutation.SetHlsKeyID(v)
+	return _c
+}
+
+// SetNillableHlsKeyID sets the "hls_key_id" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableHlsKeyID(v *uuid.UUID) *AssetCreate {
+	if v != nil {
+		_c.SetHlsKeyID(*v)
+	}
+	return _c
+}
+
+// SetWidth sets the "width" field.
+func (_c *AssetCreate) SetWidth(v int) *AssetCreate {
+	_c.mutation.SetWidth(v)
+	return _c
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableWidth(v *int) *AssetCreate {
+	if v != nil {
+		_c.SetWidth(*v)
+	}
+	return _c
+}
+
+// SetHeight sets the "height" field.
+func (_c *AssetCreate) SetHeight(v int) *AssetCreate {
+	_c.mutation.SetHeight(v)
+	return _c
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableHeight(v *int) *AssetCreate {
+	if v != nil {
+		_c.SetHeight(*v)
+	}
+	return _c
+}
+
+// SetImageVariants sets the "image_variants" field.
+func (_c *AssetCreate) SetImageVariants(v []core.ImageVariant) *AssetCreate {
+	_c.mutation.SetImageVariants(v)
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *AssetCreate) SetProvider(v string) *AssetCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableProvider(v *string) *AssetCreate {
+	if v != nil {
+		_c.SetProvider(*v)
+	}
+	return _c
+}
+
+// SetFailureCode sets the "failure_code" field.
+func (_c *AssetCreate) SetFailureCode(v int) *AssetCreate {
+	_c.mutation.SetFailureCode(v)
 	return _c
 }
 
-// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
-func (_c *AssetCreate) SetNillableUpdatedAt(v *time.Time) *AssetCreate {
+// SetNillableFailureCode sets the "failure_code" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableFailureCode(v *int) *AssetCreate {
 	if v != nil {
-		_c.SetUpdatedAt(*v)
+		_c.SetFailureCode(*v)
+	}
+	return _c
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_c *AssetCreate) SetFailureReason(v string) *AssetCreate {
+	_c.mutation.SetFailureReason(v)
+	return _c
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableFailureReason(v *string) *AssetCreate {
+	if v != nil {
+		_c.SetFailureReason(*v)
 	}
 	return _c
 }
@@ -172,7 +275,9 @@ func (_c *AssetCreate) Mutation() *AssetMutation {
 
 // Save creates the Asset in the database.
 func (_c *AssetCreate) Save(ctx context.Context) (*Asset, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -199,7 +304,7 @@ func (_c *AssetCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *AssetCreate) defaults() {
+func (_c *AssetCreate) defaults() error {
 	if _, ok := _c.mutation.GetType(); !ok {
 		v := asset.DefaultType
 		_c.mutation.SetType(v)
@@ -216,22 +321,48 @@ func (_c *AssetCreate) defaults() {
 		v := asset.DefaultDurationSeconds
 		_c.mutation.SetDurationSeconds(v)
 	}
-	if _, ok := _c.mutation.CreatedAt(); !ok {
-		v := asset.DefaultCreatedAt()
-		_c.mutation.SetCreatedAt(v)
+	if _, ok := _c.mutation.HlsManifestURL(); !ok {
+		v := asset.DefaultHlsManifestURL
+		_c.mutation.SetHlsManifestURL(v)
 	}
-	if _, ok := _c.mutation.UpdatedAt(); !ok {
-		v := asset.DefaultUpdatedAt()
-		_c.mutation.SetUpdatedAt(v)
+	if _, ok := _c.mutation.Width(); !ok {
+		v := asset.DefaultWidth
+		_c.mutation.SetWidth(v)
+	}
+	if _, ok := _c.mutation.Height(); !ok {
+		v := asset.DefaultHeight
+		_c.mutation.SetHeight(v)
+	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		v := asset.DefaultProvider
+		_c.mutation.SetProvider(v)
+	}
+	if _, ok := _c.mutation.FailureCode(); !ok {
+		v := asset.DefaultFailureCode
+		_c.mutation.SetFailureCode(v)
+	}
+	if _, ok := _c.mutation.FailureReason(); !ok {
+		v := asset.DefaultFailureReason
+		_c.mutation.SetFailureReason(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if asset.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized asset.DefaultID (forgotten import generated/runtime?)")
+		}
 		v := asset.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *AssetCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Asset.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Asset.updated_at"`)}
+	}
 	if _, ok := _c.mutation.AssetKey(); !ok {
 		return &ValidationError{Name: "asset_key", err: errors.New(`generated: missing required field "Asset.asset_key"`)}
 	}
@@ -253,11 +384,23 @@ func (_c *AssetCreate) check() error {
 	if _, ok := _c.mutation.DurationSeconds(); !ok {
 		return &ValidationError{Name: "duration_seconds", err: errors.New(`generated: missing required field "Asset.duration_seconds"`)}
 	}
-	if _, ok := _c.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Asset.created_at"`)}
+	if _, ok := _c.mutation.HlsManifestURL(); !ok {
+		return &ValidationError{Name: "hls_manifest_url", err: errors.New(`generated: missing required field "Asset.hls_manifest_url"`)}
 	}
-	if _, ok := _c.mutation.UpdatedAt(); !ok {
-		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Asset.updated_at"`)}
+	if _, ok := _c.mutation.Width(); !ok {
+		return &ValidationError{Name: "width", err: errors.New(`generated: missing required field "Asset.width"`)}
+	}
+	if _, ok := _c.mutation.Height(); !ok {
+		return &ValidationError{Name: "height", err: errors.New(`generated: missing required field "Asset.height"`)}
+	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`generated: missing required field "Asset.provider"`)}
+	}
+	if _, ok := _c.mutation.FailureCode(); !ok {
+		return &ValidationError{Name: "failure_code", err: errors.New(`generated: missing required field "Asset.failure_code"`)}
+	}
+	if _, ok := _c.mutation.FailureReason(); !ok {
+		return &ValidationError{Name: "failure_reason", err: errors.New(`generated: missing required field "Asset.failure_reason"`)}
 	}
 	return nil
 }
@@ -294,6 +437,18 @@ func (_c *AssetCreate) createSpec() (*Asset, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(asset.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(asset.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(asset.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.AssetKey(); ok {
 		_spec.SetField(asset.FieldAssetKey, field.TypeString, value)
 		_node.AssetKey = value
@@ -326,13 +481,37 @@ func (_c *AssetCreate) createSpec() (*Asset, *sqlgraph.CreateSpec) {
 		_spec.SetField(asset.FieldPlaybackURL, field.TypeString, value)
 		_node.PlaybackURL = value
 	}
-	if value, ok := _c.mutation.CreatedAt(); ok {
-		_spec.SetField(asset.FieldCreatedAt, field.TypeTime, value)
-		_node.CreatedAt = value
+	if value, ok := _c.mutation.HlsManifestURL(); ok {
+		_spec.SetField(asset.FieldHlsManifestURL, field.TypeString, value)
+		_node.HlsManifestURL = value
 	}
-	if value, ok := _c.mutation.UpdatedAt(); ok {
-		_spec.SetField(asset.FieldUpdatedAt, field.TypeTime, value)
-		_node.UpdatedAt = value
+	if value, ok := _c.mutation.HlsKeyID(); ok {
+		_spec.SetField(asset.FieldHlsKeyID, field.TypeUUID, value)
+		_node.HlsKeyID = &value
+	}
+	if value, ok := _c.mutation.Width(); ok {
+		_spec.SetField(asset.FieldWidth, field.TypeInt, value)
+		_node.Width = value
+	}
+	if value, ok := _c.mutation.Height(); ok {
+		_spec.SetField(asset.FieldHeight, field.TypeInt, value)
+		_node.Height = value
+	}
+	if value, ok := _c.mutation.ImageVariants(); ok {
+		_spec.SetField(asset.FieldImageVariants, field.TypeJSON, value)
+		_node.ImageVariants = value
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(asset.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.FailureCode(); ok {
+		_spec.SetField(asset.FieldFailureCode, field.TypeInt, value)
+		_node.FailureCode = value
+	}
+	if value, ok := _c.mutation.FailureReason(); ok {
+		_spec.SetField(asset.FieldFailureReason, field.TypeString, value)
+		_node.FailureReason = value
 	}
 	if value, ok := _c.mutation.ReadyAt(); ok {
 		_spec.SetField(asset.FieldReadyAt, field.TypeTime, value)
@@ -263,12 +263,12 @@ func (_q *AssetQuery) Clone() *AssetQuery {
 // Example:
 //
 //	var v []struct {
-//		AssetKey string `json:"asset_key,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Asset.Query().
-//		GroupBy(asset.FieldAssetKey).
+//		GroupBy(asset.FieldCreatedAt).
 //		Aggregate(generated.Count()).
 //		Scan(ctx, &v)
 func (_q *AssetQuery) GroupBy(field string, fields ...string) *AssetGroupBy {
@@ -286,11 +286,11 @@ func (_q *AssetQuery) GroupBy(field string, fields ...string) *AssetGroupBy {
 // Example:
 //
 //	var v []struct {
-//		AssetKey string `json:"asset_key,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.Asset.Query().
-//		Select(asset.FieldAssetKey).
+//		Select(asset.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (_q *AssetQuery) Select(fields ...string) *AssetSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
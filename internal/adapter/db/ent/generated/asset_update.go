@@ -10,9 +10,12 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/asset"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/eslsoft/lession/internal/core"
+	"github.com/google/uuid"
 )
 
 // AssetUpdate is the builder for updating Asset entities.
@@ -28,6 +31,40 @@ func (_u *AssetUpdate) Where(ps ...predicate.Asset) *AssetUpdate {
 	return _u
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AssetUpdate) SetUpdatedAt(v time.Time) *AssetUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableUpdatedAt(v *time.Time) *AssetUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AssetUpdate) SetDeletedAt(v time.Time) *AssetUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableDeletedAt(v *time.Time) *AssetUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AssetUpdate) ClearDeletedAt() *AssetUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetAssetKey sets the "asset_key" field.
 func (_u *AssetUpdate) SetAssetKey(v string) *AssetUpdate {
 	_u.mutation.SetAssetKey(v)
@@ -174,9 +211,146 @@ func (_u *AssetUpdate) ClearPlaybackURL() *AssetUpdate {
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *AssetUpdate) SetUpdatedAt(v time.Time) *AssetUpdate {
-	_u.mutation.SetUpdatedAt(v)
+// SetHlsManifestURL sets the "hls_manifest_url" field.
+func (_u *AssetUpdate) SetHlsManifestURL(v string) *AssetUpdate {
+	_u.mutation.SetHlsManifestURL(v)
+	return _u
+}
+
+// SetNillableHlsManifestURL sets the "hls_manifest_url" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableHlsManifestURL(v *string) *AssetUpdate {
+	if v != nil {
+		_u.SetHlsManifestURL(*v)
+	}
+	return _u
+}
+
+// SetHlsKeyID sets the "hls_key_id" field.
+func (_u *AssetUpdate) SetHlsKeyID(v uuid.UUID) *AssetUpdate {
+	_u.mutation.SetHlsKeyID(v)
+	return _u
+}
+
+// SetNillableHlsKeyID sets the "hls_key_id" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableHlsKeyID(v *uuid.UUID) *AssetUpdate {
+	if v != nil {
+		_u.SetHlsKeyID(*v)
+	}
+	return _u
+}
+
+// ClearHlsKeyID clears the value of the "hls_key_id" field.
+func (_u *AssetUpdate) ClearHlsKeyID() *AssetUpdate {
+	_u.mutation.ClearHlsKeyID()
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *AssetUpdate) SetWidth(v int) *AssetUpdate {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableWidth(v *int) *AssetUpdate {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *AssetUpdate) AddWidth(v int) *AssetUpdate {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *AssetUpdate) SetHeight(v int) *AssetUpdate {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableHeight(v *int) *AssetUpdate {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *AssetUpdate) AddHeight(v int) *AssetUpdate {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// SetImageVariants sets the "image_variants" field.
+func (_u *AssetUpdate) SetImageVariants(v []core.ImageVariant) *AssetUpdate {
+	_u.mutation.SetImageVariants(v)
+	return _u
+}
+
+// AppendImageVariants appends value to the "image_variants" field.
+func (_u *AssetUpdate) AppendImageVariants(v []core.ImageVariant) *AssetUpdate {
+	_u.mutation.AppendImageVariants(v)
+	return _u
+}
+
+// ClearImageVariants clears the value of the "image_variants" field.
+func (_u *AssetUpdate) ClearImageVariants() *AssetUpdate {
+	_u.mutation.ClearImageVariants()
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *AssetUpdate) SetProvider(v string) *AssetUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableProvider(v *string) *AssetUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetFailureCode sets the "failure_code" field.
+func (_u *AssetUpdate) SetFailureCode(v int) *AssetUpdate {
+	_u.mutation.ResetFailureCode()
+	_u.mutation.SetFailureCode(v)
+	return _u
+}
+
+// SetNillableFailureCode sets the "failure_code" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableFailureCode(v *int) *AssetUpdate {
+	if v != nil {
+		_u.SetFailureCode(*v)
+	}
+	return _u
+}
+
+// AddFailureCode adds value to the "failure_code" field.
+func (_u *AssetUpdate) AddFailureCode(v int) *AssetUpdate {
+	_u.mutation.AddFailureCode(v)
+	return _u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_u *AssetUpdate) SetFailureReason(v string) *AssetUpdate {
+	_u.mutation.SetFailureReason(v)
+	return _u
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableFailureReason(v *string) *AssetUpdate {
+	if v != nil {
+		_u.SetFailureReason(*v)
+	}
 	return _u
 }
 
@@ -207,7 +381,6 @@ func (_u *AssetUpdate) Mutation() *AssetMutation {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *AssetUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -233,14 +406,6 @@ func (_u *AssetUpdate) ExecX(ctx context.Context) {
 	}
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *AssetUpdate) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := asset.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
-	}
-}
-
 func (_u *AssetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(asset.Table, asset.Columns, sqlgraph.NewFieldSpec(asset.FieldID, field.TypeUUID))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -250,6 +415,15 @@ func (_u *AssetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(asset.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(asset.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(asset.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.AssetKey(); ok {
 		_spec.SetField(asset.FieldAssetKey, field.TypeString, value)
 	}
@@ -289,8 +463,49 @@ func (_u *AssetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.PlaybackURLCleared() {
 		_spec.ClearField(asset.FieldPlaybackURL, field.TypeString)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(asset.FieldUpdatedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.HlsManifestURL(); ok {
+		_spec.SetField(asset.FieldHlsManifestURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HlsKeyID(); ok {
+		_spec.SetField(asset.FieldHlsKeyID, field.TypeUUID, value)
+	}
+	if _u.mutation.HlsKeyIDCleared() {
+		_spec.ClearField(asset.FieldHlsKeyID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(asset.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(asset.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(asset.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(asset.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ImageVariants(); ok {
+		_spec.SetField(asset.FieldImageVariants, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImageVariants(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, asset.FieldImageVariants, value)
+		})
+	}
+	if _u.mutation.ImageVariantsCleared() {
+		_spec.ClearField(asset.FieldImageVariants, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(asset.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FailureCode(); ok {
+		_spec.SetField(asset.FieldFailureCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailureCode(); ok {
+		_spec.AddField(asset.FieldFailureCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailureReason(); ok {
+		_spec.SetField(asset.FieldFailureReason, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.ReadyAt(); ok {
 		_spec.SetField(asset.FieldReadyAt, field.TypeTime, value)
@@ -318,6 +533,40 @@ type AssetUpdateOne struct {
 	mutation *AssetMutation
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AssetUpdateOne) SetUpdatedAt(v time.Time) *AssetUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableUpdatedAt(v *time.Time) *AssetUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AssetUpdateOne) SetDeletedAt(v time.Time) *AssetUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableDeletedAt(v *time.Time) *AssetUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AssetUpdateOne) ClearDeletedAt() *AssetUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetAssetKey sets the "asset_key" field.
 func (_u *AssetUpdateOne) SetAssetKey(v string) *AssetUpdateOne {
 	_u.mutation.SetAssetKey(v)
@@ -464,9 +713,146 @@ func (_u *AssetUpdateOne) ClearPlaybackURL() *AssetUpdateOne {
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *AssetUpdateOne) SetUpdatedAt(v time.Time) *AssetUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
+// SetHlsManifestURL sets the "hls_manifest_url" field.
+func (_u *AssetUpdateOne) SetHlsManifestURL(v string) *AssetUpdateOne {
+	_u.mutation.SetHlsManifestURL(v)
+	return _u
+}
+
+// SetNillableHlsManifestURL sets the "hls_manifest_url" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableHlsManifestURL(v *string) *AssetUpdateOne {
+	if v != nil {
+		_u.SetHlsManifestURL(*v)
+	}
+	return _u
+}
+
+// SetHlsKeyID sets the "hls_key_id" field.
+func (_u *AssetUpdateOne) SetHlsKeyID(v uuid.UUID) *AssetUpdateOne {
+	_u.mutation.SetHlsKeyID(v)
+	return _u
+}
+
+// SetNillableHlsKeyID sets the "hls_key_id" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableHlsKeyID(v *uuid.UUID) *AssetUpdateOne {
+	if v != nil {
+		_u.SetHlsKeyID(*v)
+	}
+	return _u
+}
+
+// ClearHlsKeyID clears the value of the "hls_key_id" field.
+func (_u *AssetUpdateOne) ClearHlsKeyID() *AssetUpdateOne {
+	_u.mutation.ClearHlsKeyID()
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *AssetUpdateOne) SetWidth(v int) *AssetUpdateOne {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableWidth(v *int) *AssetUpdateOne {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *AssetUpdateOne) AddWidth(v int) *AssetUpdateOne {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *AssetUpdateOne) SetHeight(v int) *AssetUpdateOne {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableHeight(v *int) *AssetUpdateOne {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *AssetUpdateOne) AddHeight(v int) *AssetUpdateOne {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// SetImageVariants sets the "image_variants" field.
+func (_u *AssetUpdateOne) SetImageVariants(v []core.ImageVariant) *AssetUpdateOne {
+	_u.mutation.SetImageVariants(v)
+	return _u
+}
+
+// AppendImageVariants appends value to the "image_variants" field.
+func (_u *AssetUpdateOne) AppendImageVariants(v []core.ImageVariant) *AssetUpdateOne {
+	_u.mutation.AppendImageVariants(v)
+	return _u
+}
+
+// ClearImageVariants clears the value of the "image_variants" field.
+func (_u *AssetUpdateOne) ClearImageVariants() *AssetUpdateOne {
+	_u.mutation.ClearImageVariants()
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *AssetUpdateOne) SetProvider(v string) *AssetUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableProvider(v *string) *AssetUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetFailureCode sets the "failure_code" field.
+func (_u *AssetUpdateOne) SetFailureCode(v int) *AssetUpdateOne {
+	_u.mutation.ResetFailureCode()
+	_u.mutation.SetFailureCode(v)
+	return _u
+}
+
+// SetNillableFailureCode sets the "failure_code" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableFailureCode(v *int) *AssetUpdateOne {
+	if v != nil {
+		_u.SetFailureCode(*v)
+	}
+	return _u
+}
+
+// AddFailureCode adds value to the "failure_code" field.
+func (_u *AssetUpdateOne) AddFailureCode(v int) *AssetUpdateOne {
+	_u.mutation.AddFailureCode(v)
+	return _u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_u *AssetUpdateOne) SetFailureReason(v string) *AssetUpdateOne {
+	_u.mutation.SetFailureReason(v)
+	return _u
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableFailureReason(v *string) *AssetUpdateOne {
+	if v != nil {
+		_u.SetFailureReason(*v)
+	}
 	return _u
 }
 
@@ -510,7 +896,6 @@ func (_u *AssetUpdateOne) Select(field string, fields ...string) *AssetUpdateOne
 
 // Save executes the query and returns the updated Asset entity.
 func (_u *AssetUpdateOne) Save(ctx context.Context) (*Asset, error) {
-	_u.defaults()
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -536,14 +921,6 @@ func (_u *AssetUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *AssetUpdateOne) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := asset.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
-	}
-}
-
 func (_u *AssetUpdateOne) sqlSave(ctx context.Context) (_node *Asset, err error) {
 	_spec := sqlgraph.NewUpdateSpec(asset.Table, asset.Columns, sqlgraph.NewFieldSpec(asset.FieldID, field.TypeUUID))
 	id, ok := _u.mutation.ID()
@@ -570,6 +947,15 @@ func (_u *AssetUpdateOne) sqlSave(ctx context.Context) (_node *Asset, err error)
 			}
 		}
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(asset.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(asset.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(asset.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.AssetKey(); ok {
 		_spec.SetField(asset.FieldAssetKey, field.TypeString, value)
 	}
@@ -609,8 +995,49 @@ func (_u *AssetUpdateOne) sqlSave(ctx context.Context) (_node *Asset, err error)
 	if _u.mutation.PlaybackURLCleared() {
 		_spec.ClearField(asset.FieldPlaybackURL, field.TypeString)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(asset.FieldUpdatedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.HlsManifestURL(); ok {
+		_spec.SetField(asset.FieldHlsManifestURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HlsKeyID(); ok {
+		_spec.SetField(asset.FieldHlsKeyID, field.TypeUUID, value)
+	}
+	if _u.mutation.HlsKeyIDCleared() {
+		_spec.ClearField(asset.FieldHlsKeyID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(asset.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(asset.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(asset.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(asset.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ImageVariants(); ok {
+		_spec.SetField(asset.FieldImageVariants, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImageVariants(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, asset.FieldImageVariants, value)
+		})
+	}
+	if _u.mutation.ImageVariantsCleared() {
+		_spec.ClearField(asset.FieldImageVariants, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(asset.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FailureCode(); ok {
+		_spec.SetField(asset.FieldFailureCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailureCode(); ok {
+		_spec.AddField(asset.FieldFailureCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailureReason(); ok {
+		_spec.SetField(asset.FieldFailureReason, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.ReadyAt(); ok {
 		_spec.SetField(asset.FieldReadyAt, field.TypeTime, value)
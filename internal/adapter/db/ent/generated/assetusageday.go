@@ -0,0 +1,140 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/assetusageday"
+	"github.com/google/uuid"
+)
+
+// AssetUsageDay is the model entity for the AssetUsageDay schema.
+type AssetUsageDay struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// AssetID holds the value of the "asset_id" field.
+	AssetID uuid.UUID `json:"asset_id,omitempty"`
+	// Day holds the value of the "day" field.
+	Day time.Time `json:"day,omitempty"`
+	// PlaybackUrlsIssued holds the value of the "playback_urls_issued" field.
+	PlaybackUrlsIssued int64 `json:"playback_urls_issued,omitempty"`
+	// Downloads holds the value of the "downloads" field.
+	Downloads    int64 `json:"downloads,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AssetUsageDay) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case assetusageday.FieldPlaybackUrlsIssued, assetusageday.FieldDownloads:
+			values[i] = new(sql.NullInt64)
+		case assetusageday.FieldDay:
+			values[i] = new(sql.NullTime)
+		case assetusageday.FieldID, assetusageday.FieldAssetID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AssetUsageDay fields.
+func (_m *AssetUsageDay) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case assetusageday.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case assetusageday.FieldAssetID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_id", values[i])
+			} else if value != nil {
+				_m.AssetID = *value
+			}
+		case assetusageday.FieldDay:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				_m.Day = value.Time
+			}
+		case assetusageday.FieldPlaybackUrlsIssued:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field playback_urls_issued", values[i])
+			} else if value.Valid {
+				_m.PlaybackUrlsIssued = value.Int64
+			}
+		case assetusageday.FieldDownloads:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field downloads", values[i])
+			} else if value.Valid {
+				_m.Downloads = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AssetUsageDay.
+// This includes values selected through modifiers, order, etc.
+func (_m *AssetUsageDay) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AssetUsageDay.
+// Note that you need to call AssetUsageDay.Unwrap() before calling this method if this AssetUsageDay
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AssetUsageDay) Update() *AssetUsageDayUpdateOne {
+	return NewAssetUsageDayClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AssetUsageDay entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AssetUsageDay) Unwrap() *AssetUsageDay {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: AssetUsageDay is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AssetUsageDay) String() string {
+	var builder strings.Builder
+	builder.WriteString("AssetUsageDay(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("asset_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AssetID))
+	builder.WriteString(", ")
+	builder.WriteString("day=")
+	builder.WriteString(_m.Day.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("playback_urls_issued=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PlaybackUrlsIssued))
+	builder.WriteString(", ")
+	builder.WriteString("downloads=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Downloads))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AssetUsageDays is a parsable slice of AssetUsageDay.
+type AssetUsageDays []*AssetUsageDay
@@ -0,0 +1,81 @@
+// Code generated by ent, DO NOT EDIT.
+
+package assetusageday
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the assetusageday type in the database.
+	Label = "asset_usage_day"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAssetID holds the string denoting the asset_id field in the database.
+	FieldAssetID = "asset_id"
+	// FieldDay holds the string denoting the day field in the database.
+	FieldDay = "day"
+	// FieldPlaybackUrlsIssued holds the string denoting the playback_urls_issued field in the database.
+	FieldPlaybackUrlsIssued = "playback_urls_issued"
+	// FieldDownloads holds the string denoting the downloads field in the database.
+	FieldDownloads = "downloads"
+	// Table holds the table name of the assetusageday in the database.
+	Table = "asset_usage_days"
+)
+
+// Columns holds all SQL columns for assetusageday fields.
+var Columns = []string{
+	FieldID,
+	FieldAssetID,
+	FieldDay,
+	FieldPlaybackUrlsIssued,
+	FieldDownloads,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPlaybackUrlsIssued holds the default value on creation for the "playback_urls_issued" field.
+	DefaultPlaybackUrlsIssued int64
+	// DefaultDownloads holds the default value on creation for the "downloads" field.
+	DefaultDownloads int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the AssetUsageDay queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAssetID orders the results by the asset_id field.
+func ByAssetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssetID, opts...).ToFunc()
+}
+
+// ByDay orders the results by the day field.
+func ByDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDay, opts...).ToFunc()
+}
+
+// ByPlaybackUrlsIssued orders the results by the playback_urls_issued field.
+func ByPlaybackUrlsIssued(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlaybackUrlsIssued, opts...).ToFunc()
+}
+
+// ByDownloads orders the results by the downloads field.
+func ByDownloads(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDownloads, opts...).ToFunc()
+}
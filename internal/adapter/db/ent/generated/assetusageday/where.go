@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package assetusageday
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldLTE(FieldID, id))
+}
+
+// AssetID applies equality check predicate on the "asset_id" field. It's identical to AssetIDEQ.
+func AssetID(v uuid.UUID) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldEQ(FieldAssetID, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v time.Time) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldEQ(FieldDay, v))
+}
+
+// PlaybackUrlsIssued applies equality check predicate on the "playback_urls_issued" field. It's identical to PlaybackUrlsIssuedEQ.
+func PlaybackUrlsIssued(v int64) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldEQ(FieldPlaybackUrlsIssued, v))
+}
+
+// Downloads applies equality check predicate on the "downloads" field. It's identical to DownloadsEQ.
+func Downloads(v int64) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldEQ(FieldDownloads, v))
+}
+
+// AssetIDEQ applies the EQ predicate on the "asset_id" field.
+func AssetIDEQ(v uuid.UUID) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldEQ(FieldAssetID, v))
+}
+
+// AssetIDNEQ applies the NEQ predicate on the "asset_id" field.
+func AssetIDNEQ(v uuid.UUID) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldNEQ(FieldAssetID, v))
+}
+
+// AssetIDIn applies the In predicate on the "asset_id" field.
+func AssetIDIn(vs ...uuid.UUID) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldIn(FieldAssetID, vs...))
+}
+
+// AssetIDNotIn applies the NotIn predicate on the "asset_id" field.
+func AssetIDNotIn(vs ...uuid.UUID) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldNotIn(FieldAssetID, vs...))
+}
+
+// AssetIDGT applies the GT predicate on the "asset_id" field.
+func AssetIDGT(v uuid.UUID) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldGT(FieldAssetID, v))
+}
+
+// AssetIDGTE applies the GTE predicate on the "asset_id" field.
+func AssetIDGTE(v uuid.UUID) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldGTE(FieldAssetID, v))
+}
+
+// AssetIDLT applies the LT predicate on the "asset_id" field.
+func AssetIDLT(v uuid.UUID) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldLT(FieldAssetID, v))
+}
+
+// AssetIDLTE applies the LTE predicate on the "asset_id" field.
+func AssetIDLTE(v uuid.UUID) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldLTE(FieldAssetID, v))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v time.Time) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v time.Time) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...time.Time) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...time.Time) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v time.Time) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v time.Time) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v time.Time) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v time.Time) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldLTE(FieldDay, v))
+}
+
+// PlaybackUrlsIssuedEQ applies the EQ predicate on the "playback_urls_issued" field.
+func PlaybackUrlsIssuedEQ(v int64) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldEQ(FieldPlaybackUrlsIssued, v))
+}
+
+// PlaybackUrlsIssuedNEQ applies the NEQ predicate on the "playback_urls_issued" field.
+func PlaybackUrlsIssuedNEQ(v int64) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldNEQ(FieldPlaybackUrlsIssued, v))
+}
+
+// PlaybackUrlsIssuedIn applies the In predicate on the "playback_urls_issued" field.
+func PlaybackUrlsIssuedIn(vs ...int64) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldIn(FieldPlaybackUrlsIssued, vs...))
+}
+
+// PlaybackUrlsIssuedNotIn applies the NotIn predicate on the "playback_urls_issued" field.
+func PlaybackUrlsIssuedNotIn(vs ...int64) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldNotIn(FieldPlaybackUrlsIssued, vs...))
+}
+
+// PlaybackUrlsIssuedGT applies the GT predicate on the "playback_urls_issued" field.
+func PlaybackUrlsIssuedGT(v int64) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldGT(FieldPlaybackUrlsIssued, v))
+}
+
+// PlaybackUrlsIssuedGTE applies the GTE predicate on the "playback_urls_issued" field.
+func PlaybackUrlsIssuedGTE(v int64) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldGTE(FieldPlaybackUrlsIssued, v))
+}
+
+// PlaybackUrlsIssuedLT applies the LT predicate on the "playback_urls_issued" field.
+func PlaybackUrlsIssuedLT(v int64) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldLT(FieldPlaybackUrlsIssued, v))
+}
+
+// PlaybackUrlsIssuedLTE applies the LTE predicate on the "playback_urls_issued" field.
+func PlaybackUrlsIssuedLTE(v int64) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldLTE(FieldPlaybackUrlsIssued, v))
+}
+
+// DownloadsEQ applies the EQ predicate on the "downloads" field.
+func DownloadsEQ(v int64) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldEQ(FieldDownloads, v))
+}
+
+// DownloadsNEQ applies the NEQ predicate on the "downloads" field.
+func DownloadsNEQ(v int64) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldNEQ(FieldDownloads, v))
+}
+
+// DownloadsIn applies the In predicate on the "downloads" field.
+func DownloadsIn(vs ...int64) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldIn(FieldDownloads, vs...))
+}
+
+// DownloadsNotIn applies the NotIn predicate on the "downloads" field.
+func DownloadsNotIn(vs ...int64) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldNotIn(FieldDownloads, vs...))
+}
+
+// DownloadsGT applies the GT predicate on the "downloads" field.
+func DownloadsGT(v int64) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldGT(FieldDownloads, v))
+}
+
+// DownloadsGTE applies the GTE predicate on the "downloads" field.
+func DownloadsGTE(v int64) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldGTE(FieldDownloads, v))
+}
+
+// DownloadsLT applies the LT predicate on the "downloads" field.
+func DownloadsLT(v int64) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldLT(FieldDownloads, v))
+}
+
+// DownloadsLTE applies the LTE predicate on the "downloads" field.
+func DownloadsLTE(v int64) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.FieldLTE(FieldDownloads, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AssetUsageDay) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AssetUsageDay) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AssetUsageDay) predicate.AssetUsageDay {
+	return predicate.AssetUsageDay(sql.NotPredicates(p))
+}
@@ -0,0 +1,277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/assetusageday"
+	"github.com/google/uuid"
+)
+
+// AssetUsageDayCreate is the builder for creating a AssetUsageDay entity.
+type AssetUsageDayCreate struct {
+	config
+	mutation *AssetUsageDayMutation
+	hooks    []Hook
+}
+
+// SetAssetID sets the "asset_id" field.
+func (_c *AssetUsageDayCreate) SetAssetID(v uuid.UUID) *AssetUsageDayCreate {
+	_c.mutation.SetAssetID(v)
+	return _c
+}
+
+// SetDay sets the "day" field.
+func (_c *AssetUsageDayCreate) SetDay(v time.Time) *AssetUsageDayCreate {
+	_c.mutation.SetDay(v)
+	return _c
+}
+
+// SetPlaybackUrlsIssued sets the "playback_urls_issued" field.
+func (_c *AssetUsageDayCreate) SetPlaybackUrlsIssued(v int64) *AssetUsageDayCreate {
+	_c.mutation.SetPlaybackUrlsIssued(v)
+	return _c
+}
+
+// SetNillablePlaybackUrlsIssued sets the "playback_urls_issued" field if the given value is not nil.
+func (_c *AssetUsageDayCreate) SetNillablePlaybackUrlsIssued(v *int64) *AssetUsageDayCreate {
+	if v != nil {
+		_c.SetPlaybackUrlsIssued(*v)
+	}
+	return _c
+}
+
+// SetDownloads sets the "downloads" field.
+func (_c *AssetUsageDayCreate) SetDownloads(v int64) *AssetUsageDayCreate {
+	_c.mutation.SetDownloads(v)
+	return _c
+}
+
+// SetNillableDownloads sets the "downloads" field if the given value is not nil.
+func (_c *AssetUsageDayCreate) SetNillableDownloads(v *int64) *AssetUsageDayCreate {
+	if v != nil {
+		_c.SetDownloads(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AssetUsageDayCreate) SetID(v uuid.UUID) *AssetUsageDayCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AssetUsageDayCreate) SetNillableID(v *uuid.UUID) *AssetUsageDayCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AssetUsageDayMutation object of the builder.
+func (_c *AssetUsageDayCreate) Mutation() *AssetUsageDayMutation {
+	return _c.mutation
+}
+
+// Save creates the AssetUsageDay in the database.
+func (_c *AssetUsageDayCreate) Save(ctx context.Context) (*AssetUsageDay, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AssetUsageDayCreate) SaveX(ctx context.Context) *AssetUsageDay {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AssetUsageDayCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AssetUsageDayCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AssetUsageDayCreate) defaults() {
+	if _, ok := _c.mutation.PlaybackUrlsIssued(); !ok {
+		v := assetusageday.DefaultPlaybackUrlsIssued
+		_c.mutation.SetPlaybackUrlsIssued(v)
+	}
+	if _, ok := _c.mutation.Downloads(); !ok {
+		v := assetusageday.DefaultDownloads
+		_c.mutation.SetDownloads(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := assetusageday.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AssetUsageDayCreate) check() error {
+	if _, ok := _c.mutation.AssetID(); !ok {
+		return &ValidationError{Name: "asset_id", err: errors.New(`generated: missing required field "AssetUsageDay.asset_id"`)}
+	}
+	if _, ok := _c.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`generated: missing required field "AssetUsageDay.day"`)}
+	}
+	if _, ok := _c.mutation.PlaybackUrlsIssued(); !ok {
+		return &ValidationError{Name: "playback_urls_issued", err: errors.New(`generated: missing required field "AssetUsageDay.playback_urls_issued"`)}
+	}
+	if _, ok := _c.mutation.Downloads(); !ok {
+		return &ValidationError{Name: "downloads", err: errors.New(`generated: missing required field "AssetUsageDay.downloads"`)}
+	}
+	return nil
+}
+
+func (_c *AssetUsageDayCreate) sqlSave(ctx context.Context) (*AssetUsageDay, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AssetUsageDayCreate) createSpec() (*AssetUsageDay, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AssetUsageDay{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(assetusageday.Table, sqlgraph.NewFieldSpec(assetusageday.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.AssetID(); ok {
+		_spec.SetField(assetusageday.FieldAssetID, field.TypeUUID, value)
+		_node.AssetID = value
+	}
+	if value, ok := _c.mutation.Day(); ok {
+		_spec.SetField(assetusageday.FieldDay, field.TypeTime, value)
+		_node.Day = value
+	}
+	if value, ok := _c.mutation.PlaybackUrlsIssued(); ok {
+		_spec.SetField(assetusageday.FieldPlaybackUrlsIssued, field.TypeInt64, value)
+		_node.PlaybackUrlsIssued = value
+	}
+	if value, ok := _c.mutation.Downloads(); ok {
+		_spec.SetField(assetusageday.FieldDownloads, field.TypeInt64, value)
+		_node.Downloads = value
+	}
+	return _node, _spec
+}
+
+// AssetUsageDayCreateBulk is the builder for creating many AssetUsageDay entities in bulk.
+type AssetUsageDayCreateBulk struct {
+	config
+	err      error
+	builders []*AssetUsageDayCreate
+}
+
+// Save creates the AssetUsageDay entities in the database.
+func (_c *AssetUsageDayCreateBulk) Save(ctx context.Context) ([]*AssetUsageDay, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AssetUsageDay, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AssetUsageDayMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AssetUsageDayCreateBulk) SaveX(ctx context.Context) []*AssetUsageDay {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AssetUsageDayCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AssetUsageDayCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/assetusageday"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+)
+
+// AssetUsageDayDelete is the builder for deleting a AssetUsageDay entity.
+type AssetUsageDayDelete struct {
+	config
+	hooks    []Hook
+	mutation *AssetUsageDayMutation
+}
+
+// Where appends a list predicates to the AssetUsageDayDelete builder.
+func (_d *AssetUsageDayDelete) Where(ps ...predicate.AssetUsageDay) *AssetUsageDayDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AssetUsageDayDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AssetUsageDayDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AssetUsageDayDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(assetusageday.Table, sqlgraph.NewFieldSpec(assetusageday.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AssetUsageDayDeleteOne is the builder for deleting a single AssetUsageDay entity.
+type AssetUsageDayDeleteOne struct {
+	_d *AssetUsageDayDelete
+}
+
+// Where appends a list predicates to the AssetUsageDayDelete builder.
+func (_d *AssetUsageDayDeleteOne) Where(ps ...predicate.AssetUsageDay) *AssetUsageDayDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AssetUsageDayDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{assetusageday.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AssetUsageDayDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,283 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/assetusageday"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+)
+
+// AssetUsageDayUpdate is the builder for updating AssetUsageDay entities.
+type AssetUsageDayUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AssetUsageDayMutation
+}
+
+// Where appends a list predicates to the AssetUsageDayUpdate builder.
+func (_u *AssetUsageDayUpdate) Where(ps ...predicate.AssetUsageDay) *AssetUsageDayUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPlaybackUrlsIssued sets the "playback_urls_issued" field.
+func (_u *AssetUsageDayUpdate) SetPlaybackUrlsIssued(v int64) *AssetUsageDayUpdate {
+	_u.mutation.ResetPlaybackUrlsIssued()
+	_u.mutation.SetPlaybackUrlsIssued(v)
+	return _u
+}
+
+// SetNillablePlaybackUrlsIssued sets the "playback_urls_issued" field if the given value is not nil.
+func (_u *AssetUsageDayUpdate) SetNillablePlaybackUrlsIssued(v *int64) *AssetUsageDayUpdate {
+	if v != nil {
+		_u.SetPlaybackUrlsIssued(*v)
+	}
+	return _u
+}
+
+// AddPlaybackUrlsIssued adds value to the "playback_urls_issued" field.
+func (_u *AssetUsageDayUpdate) AddPlaybackUrlsIssued(v int64) *AssetUsageDayUpdate {
+	_u.mutation.AddPlaybackUrlsIssued(v)
+	return _u
+}
+
+// SetDownloads sets the "downloads" field.
+func (_u *AssetUsageDayUpdate) SetDownloads(v int64) *AssetUsageDayUpdate {
+	_u.mutation.ResetDownloads()
+	_u.mutation.SetDownloads(v)
+	return _u
+}
+
+// SetNillableDownloads sets the "downloads" field if the given value is not nil.
+func (_u *AssetUsageDayUpdate) SetNillableDownloads(v *int64) *AssetUsageDayUpdate {
+	if v != nil {
+		_u.SetDownloads(*v)
+	}
+	return _u
+}
+
+// AddDownloads adds value to the "downloads" field.
+func (_u *AssetUsageDayUpdate) AddDownloads(v int64) *AssetUsageDayUpdate {
+	_u.mutation.AddDownloads(v)
+	return _u
+}
+
+// Mutation returns the AssetUsageDayMutation object of the builder.
+func (_u *AssetUsageDayUpdate) Mutation() *AssetUsageDayMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AssetUsageDayUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AssetUsageDayUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AssetUsageDayUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AssetUsageDayUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AssetUsageDayUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(assetusageday.Table, assetusageday.Columns, sqlgraph.NewFieldSpec(assetusageday.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PlaybackUrlsIssued(); ok {
+		_spec.SetField(assetusageday.FieldPlaybackUrlsIssued, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPlaybackUrlsIssued(); ok {
+		_spec.AddField(assetusageday.FieldPlaybackUrlsIssued, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Downloads(); ok {
+		_spec.SetField(assetusageday.FieldDownloads, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDownloads(); ok {
+		_spec.AddField(assetusageday.FieldDownloads, field.TypeInt64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{assetusageday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AssetUsageDayUpdateOne is the builder for updating a single AssetUsageDay entity.
+type AssetUsageDayUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AssetUsageDayMutation
+}
+
+// SetPlaybackUrlsIssued sets the "playback_urls_issued" field.
+func (_u *AssetUsageDayUpdateOne) SetPlaybackUrlsIssued(v int64) *AssetUsageDayUpdateOne {
+	_u.mutation.ResetPlaybackUrlsIssued()
+	_u.mutation.SetPlaybackUrlsIssued(v)
+	return _u
+}
+
+// SetNillablePlaybackUrlsIssued sets the "playback_urls_issued" field if the given value is not nil.
+func (_u *AssetUsageDayUpdateOne) SetNillablePlaybackUrlsIssued(v *int64) *AssetUsageDayUpdateOne {
+	if v != nil {
+		_u.SetPlaybackUrlsIssued(*v)
+	}
+	return _u
+}
+
+// AddPlaybackUrlsIssued adds value to the "playback_urls_issued" field.
+func (_u *AssetUsageDayUpdateOne) AddPlaybackUrlsIssued(v int64) *AssetUsageDayUpdateOne {
+	_u.mutation.AddPlaybackUrlsIssued(v)
+	return _u
+}
+
+// SetDownloads sets the "downloads" field.
+func (_u *AssetUsageDayUpdateOne) SetDownloads(v int64) *AssetUsageDayUpdateOne {
+	_u.mutation.ResetDownloads()
+	_u.mutation.SetDownloads(v)
+	return _u
+}
+
+// SetNillableDownloads sets the "downloads" field if the given value is not nil.
+func (_u *AssetUsageDayUpdateOne) SetNillableDownloads(v *int64) *AssetUsageDayUpdateOne {
+	if v != nil {
+		_u.SetDownloads(*v)
+	}
+	return _u
+}
+
+// AddDownloads adds value to the "downloads" field.
+func (_u *AssetUsageDayUpdateOne) AddDownloads(v int64) *AssetUsageDayUpdateOne {
+	_u.mutation.AddDownloads(v)
+	return _u
+}
+
+// Mutation returns the AssetUsageDayMutation object of the builder.
+func (_u *AssetUsageDayUpdateOne) Mutation() *AssetUsageDayMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AssetUsageDayUpdate builder.
+func (_u *AssetUsageDayUpdateOne) Where(ps ...predicate.AssetUsageDay) *AssetUsageDayUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AssetUsageDayUpdateOne) Select(field string, fields ...string) *AssetUsageDayUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AssetUsageDay entity.
+func (_u *AssetUsageDayUpdateOne) Save(ctx context.Context) (*AssetUsageDay, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AssetUsageDayUpdateOne) SaveX(ctx context.Context) *AssetUsageDay {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AssetUsageDayUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AssetUsageDayUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AssetUsageDayUpdateOne) sqlSave(ctx context.Context) (_node *AssetUsageDay, err error) {
+	_spec := sqlgraph.NewUpdateSpec(assetusageday.Table, assetusageday.Columns, sqlgraph.NewFieldSpec(assetusageday.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "AssetUsageDay.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, assetusageday.FieldID)
+		for _, f := range fields {
+			if !assetusageday.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != assetusageday.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PlaybackUrlsIssued(); ok {
+		_spec.SetField(assetusageday.FieldPlaybackUrlsIssued, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPlaybackUrlsIssued(); ok {
+		_spec.AddField(assetusageday.FieldPlaybackUrlsIssued, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Downloads(); ok {
+		_spec.SetField(assetusageday.FieldDownloads, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDownloads(); ok {
+		_spec.AddField(assetusageday.FieldDownloads, field.TypeInt64, value)
+	}
+	_node = &AssetUsageDay{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{assetusageday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/assetversion"
+	"github.com/google/uuid"
+)
+
+// AssetVersion is the model entity for the AssetVersion schema.
+type AssetVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// AssetID holds the value of the "asset_id" field.
+	AssetID uuid.UUID `json:"asset_id,omitempty"`
+	// AssetKey holds the value of the "asset_key" field.
+	AssetKey string `json:"asset_key,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// OriginalFilename holds the value of the "original_filename" field.
+	OriginalFilename string `json:"original_filename,omitempty"`
+	// MimeType holds the value of the "mime_type" field.
+	MimeType string `json:"mime_type,omitempty"`
+	// Filesize holds the value of the "filesize" field.
+	Filesize int64 `json:"filesize,omitempty"`
+	// DurationSeconds holds the value of the "duration_seconds" field.
+	DurationSeconds int `json:"duration_seconds,omitempty"`
+	// PlaybackURL holds the value of the "playback_url" field.
+	PlaybackURL string `json:"playback_url,omitempty"`
+	// HlsManifestURL holds the value of the "hls_manifest_url" field.
+	HlsManifestURL string `json:"hls_manifest_url,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AssetVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case assetversion.FieldFilesize, assetversion.FieldDurationSeconds:
+			values[i] = new(sql.NullInt64)
+		case assetversion.FieldAssetKey, assetversion.FieldProvider, assetversion.FieldOriginalFilename, assetversion.FieldMimeType, assetversion.FieldPlaybackURL, assetversion.FieldHlsManifestURL:
+			values[i] = new(sql.NullString)
+		case assetversion.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case assetversion.FieldID, assetversion.FieldAssetID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AssetVersion fields.
+func (_m *AssetVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case assetversion.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case assetversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case assetversion.FieldAssetID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_id", values[i])
+			} else if value != nil {
+				_m.AssetID = *value
+			}
+		case assetversion.FieldAssetKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_key", values[i])
+			} else if value.Valid {
+				_m.AssetKey = value.String
+			}
+		case assetversion.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case assetversion.FieldOriginalFilename:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field original_filename", values[i])
+			} else if value.Valid {
+				_m.OriginalFilename = value.String
+			}
+		case assetversion.FieldMimeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mime_type", values[i])
+			} else if value.Valid {
+				_m.MimeType = value.String
+			}
+		case assetversion.FieldFilesize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field filesize", values[i])
+			} else if value.Valid {
+				_m.Filesize = value.Int64
+			}
+		case assetversion.FieldDurationSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_seconds", values[i])
+			} else if value.Valid {
+				_m.DurationSeconds = int(value.Int64)
+			}
+		case assetversion.FieldPlaybackURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field playback_url", values[i])
+			} else if value.Valid {
+				_m.PlaybackURL = value.String
+			}
+		case assetversion.FieldHlsManifestURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hls_manifest_url", values[i])
+			} else if value.Valid {
+				_m.HlsManifestURL = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AssetVersion.
+// This includes values selected through modifiers, order, etc.
+func (_m *AssetVersion) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AssetVersion.
+// Note that you need to call AssetVersion.Unwrap() before calling this method if this AssetVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AssetVersion) Update() *AssetVersionUpdateOne {
+	return NewAssetVersionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AssetVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AssetVersion) Unwrap() *AssetVersion {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: AssetVersion is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AssetVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("AssetVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("asset_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AssetID))
+	builder.WriteString(", ")
+	builder.WriteString("asset_key=")
+	builder.WriteString(_m.AssetKey)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("original_filename=")
+	builder.WriteString(_m.OriginalFilename)
+	builder.WriteString(", ")
+	builder.WriteString("mime_type=")
+	builder.WriteString(_m.MimeType)
+	builder.WriteString(", ")
+	builder.WriteString("filesize=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Filesize))
+	builder.WriteString(", ")
+	builder.WriteString("duration_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DurationSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("playback_url=")
+	builder.WriteString(_m.PlaybackURL)
+	builder.WriteString(", ")
+	builder.WriteString("hls_manifest_url=")
+	builder.WriteString(_m.HlsManifestURL)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AssetVersions is a parsable slice of AssetVersion.
+type AssetVersions []*AssetVersion
@@ -0,0 +1,142 @@
+// Code generated by ent, DO NOT EDIT.
+
+package assetversion
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the assetversion type in the database.
+	Label = "asset_version"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldAssetID holds the string denoting the asset_id field in the database.
+	FieldAssetID = "asset_id"
+	// FieldAssetKey holds the string denoting the asset_key field in the database.
+	FieldAssetKey = "asset_key"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldOriginalFilename holds the string denoting the original_filename field in the database.
+	FieldOriginalFilename = "original_filename"
+	// FieldMimeType holds the string denoting the mime_type field in the database.
+	FieldMimeType = "mime_type"
+	// FieldFilesize holds the string denoting the filesize field in the database.
+	FieldFilesize = "filesize"
+	// FieldDurationSeconds holds the string denoting the duration_seconds field in the database.
+	FieldDurationSeconds = "duration_seconds"
+	// FieldPlaybackURL holds the string denoting the playback_url field in the database.
+	FieldPlaybackURL = "playback_url"
+	// FieldHlsManifestURL holds the string denoting the hls_manifest_url field in the database.
+	FieldHlsManifestURL = "hls_manifest_url"
+	// Table holds the table name of the assetversion in the database.
+	Table = "asset_versions"
+)
+
+// Columns holds all SQL columns for assetversion fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldAssetID,
+	FieldAssetKey,
+	FieldProvider,
+	FieldOriginalFilename,
+	FieldMimeType,
+	FieldFilesize,
+	FieldDurationSeconds,
+	FieldPlaybackURL,
+	FieldHlsManifestURL,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eslsoft/lession/internal/adapter/db/ent/generated/runtime"
+var (
+	Hooks [1]ent.Hook
+	// DefaultProvider holds the default value on creation for the "provider" field.
+	DefaultProvider string
+	// DefaultFilesize holds the default value on creation for the "filesize" field.
+	DefaultFilesize int64
+	// DefaultDurationSeconds holds the default value on creation for the "duration_seconds" field.
+	DefaultDurationSeconds int
+	// DefaultPlaybackURL holds the default value on creation for the "playback_url" field.
+	DefaultPlaybackURL string
+	// DefaultHlsManifestURL holds the default value on creation for the "hls_manifest_url" field.
+	DefaultHlsManifestURL string
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the AssetVersion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByAssetID orders the results by the asset_id field.
+func ByAssetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssetID, opts...).ToFunc()
+}
+
+// ByAssetKey orders the results by the asset_key field.
+func ByAssetKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssetKey, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByOriginalFilename orders the results by the original_filename field.
+func ByOriginalFilename(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalFilename, opts...).ToFunc()
+}
+
+// ByMimeType orders the results by the mime_type field.
+func ByMimeType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMimeType, opts...).ToFunc()
+}
+
+// ByFilesize orders the results by the filesize field.
+func ByFilesize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFilesize, opts...).ToFunc()
+}
+
+// ByDurationSeconds orders the results by the duration_seconds field.
+func ByDurationSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationSeconds, opts...).ToFunc()
+}
+
+// ByPlaybackURL orders the results by the playback_url field.
+func ByPlaybackURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlaybackURL, opts...).ToFunc()
+}
+
+// ByHlsManifestURL orders the results by the hls_manifest_url field.
+func ByHlsManifestURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHlsManifestURL, opts...).ToFunc()
+}
@@ -0,0 +1,671 @@
+// Code generated by ent, DO NOT EDIT.
+
+package assetversion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AssetID applies equality check predicate on the "asset_id" field. It's identical to AssetIDEQ.
+func AssetID(v uuid.UUID) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldAssetID, v))
+}
+
+// AssetKey applies equality check predicate on the "asset_key" field. It's identical to AssetKeyEQ.
+func AssetKey(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldAssetKey, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldProvider, v))
+}
+
+// OriginalFilename applies equality check predicate on the "original_filename" field. It's identical to OriginalFilenameEQ.
+func OriginalFilename(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldOriginalFilename, v))
+}
+
+// MimeType applies equality check predicate on the "mime_type" field. It's identical to MimeTypeEQ.
+func MimeType(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldMimeType, v))
+}
+
+// Filesize applies equality check predicate on the "filesize" field. It's identical to FilesizeEQ.
+func Filesize(v int64) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldFilesize, v))
+}
+
+// DurationSeconds applies equality check predicate on the "duration_seconds" field. It's identical to DurationSecondsEQ.
+func DurationSeconds(v int) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldDurationSeconds, v))
+}
+
+// PlaybackURL applies equality check predicate on the "playback_url" field. It's identical to PlaybackURLEQ.
+func PlaybackURL(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldPlaybackURL, v))
+}
+
+// HlsManifestURL applies equality check predicate on the "hls_manifest_url" field. It's identical to HlsManifestURLEQ.
+func HlsManifestURL(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldHlsManifestURL, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// AssetIDEQ applies the EQ predicate on the "asset_id" field.
+func AssetIDEQ(v uuid.UUID) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldAssetID, v))
+}
+
+// AssetIDNEQ applies the NEQ predicate on the "asset_id" field.
+func AssetIDNEQ(v uuid.UUID) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNEQ(FieldAssetID, v))
+}
+
+// AssetIDIn applies the In predicate on the "asset_id" field.
+func AssetIDIn(vs ...uuid.UUID) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldIn(FieldAssetID, vs...))
+}
+
+// AssetIDNotIn applies the NotIn predicate on the "asset_id" field.
+func AssetIDNotIn(vs ...uuid.UUID) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNotIn(FieldAssetID, vs...))
+}
+
+// AssetIDGT applies the GT predicate on the "asset_id" field.
+func AssetIDGT(v uuid.UUID) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGT(FieldAssetID, v))
+}
+
+// AssetIDGTE applies the GTE predicate on the "asset_id" field.
+func AssetIDGTE(v uuid.UUID) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGTE(FieldAssetID, v))
+}
+
+// AssetIDLT applies the LT predicate on the "asset_id" field.
+func AssetIDLT(v uuid.UUID) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLT(FieldAssetID, v))
+}
+
+// AssetIDLTE applies the LTE predicate on the "asset_id" field.
+func AssetIDLTE(v uuid.UUID) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLTE(FieldAssetID, v))
+}
+
+// AssetKeyEQ applies the EQ predicate on the "asset_key" field.
+func AssetKeyEQ(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldAssetKey, v))
+}
+
+// AssetKeyNEQ applies the NEQ predicate on the "asset_key" field.
+func AssetKeyNEQ(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNEQ(FieldAssetKey, v))
+}
+
+// AssetKeyIn applies the In predicate on the "asset_key" field.
+func AssetKeyIn(vs ...string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldIn(FieldAssetKey, vs...))
+}
+
+// AssetKeyNotIn applies the NotIn predicate on the "asset_key" field.
+func AssetKeyNotIn(vs ...string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNotIn(FieldAssetKey, vs...))
+}
+
+// AssetKeyGT applies the GT predicate on the "asset_key" field.
+func AssetKeyGT(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGT(FieldAssetKey, v))
+}
+
+// AssetKeyGTE applies the GTE predicate on the "asset_key" field.
+func AssetKeyGTE(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGTE(FieldAssetKey, v))
+}
+
+// AssetKeyLT applies the LT predicate on the "asset_key" field.
+func AssetKeyLT(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLT(FieldAssetKey, v))
+}
+
+// AssetKeyLTE applies the LTE predicate on the "asset_key" field.
+func AssetKeyLTE(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLTE(FieldAssetKey, v))
+}
+
+// AssetKeyContains applies the Contains predicate on the "asset_key" field.
+func AssetKeyContains(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldContains(FieldAssetKey, v))
+}
+
+// AssetKeyHasPrefix applies the HasPrefix predicate on the "asset_key" field.
+func AssetKeyHasPrefix(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldHasPrefix(FieldAssetKey, v))
+}
+
+// AssetKeyHasSuffix applies the HasSuffix predicate on the "asset_key" field.
+func AssetKeyHasSuffix(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldHasSuffix(FieldAssetKey, v))
+}
+
+// AssetKeyEqualFold applies the EqualFold predicate on the "asset_key" field.
+func AssetKeyEqualFold(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEqualFold(FieldAssetKey, v))
+}
+
+// AssetKeyContainsFold applies the ContainsFold predicate on the "asset_key" field.
+func AssetKeyContainsFold(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldContainsFold(FieldAssetKey, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// OriginalFilenameEQ applies the EQ predicate on the "original_filename" field.
+func OriginalFilenameEQ(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameNEQ applies the NEQ predicate on the "original_filename" field.
+func OriginalFilenameNEQ(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNEQ(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameIn applies the In predicate on the "original_filename" field.
+func OriginalFilenameIn(vs ...string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldIn(FieldOriginalFilename, vs...))
+}
+
+// OriginalFilenameNotIn applies the NotIn predicate on the "original_filename" field.
+func OriginalFilenameNotIn(vs ...string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNotIn(FieldOriginalFilename, vs...))
+}
+
+// OriginalFilenameGT applies the GT predicate on the "original_filename" field.
+func OriginalFilenameGT(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGT(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameGTE applies the GTE predicate on the "original_filename" field.
+func OriginalFilenameGTE(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGTE(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameLT applies the LT predicate on the "original_filename" field.
+func OriginalFilenameLT(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLT(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameLTE applies the LTE predicate on the "original_filename" field.
+func OriginalFilenameLTE(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLTE(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameContains applies the Contains predicate on the "original_filename" field.
+func OriginalFilenameContains(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldContains(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameHasPrefix applies the HasPrefix predicate on the "original_filename" field.
+func OriginalFilenameHasPrefix(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldHasPrefix(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameHasSuffix applies the HasSuffix predicate on the "original_filename" field.
+func OriginalFilenameHasSuffix(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldHasSuffix(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameEqualFold applies the EqualFold predicate on the "original_filename" field.
+func OriginalFilenameEqualFold(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEqualFold(FieldOriginalFilename, v))
+}
+
+// OriginalFilenameContainsFold applies the ContainsFold predicate on the "original_filename" field.
+func OriginalFilenameContainsFold(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldContainsFold(FieldOriginalFilename, v))
+}
+
+// MimeTypeEQ applies the EQ predicate on the "mime_type" field.
+func MimeTypeEQ(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldMimeType, v))
+}
+
+// MimeTypeNEQ applies the NEQ predicate on the "mime_type" field.
+func MimeTypeNEQ(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNEQ(FieldMimeType, v))
+}
+
+// MimeTypeIn applies the In predicate on the "mime_type" field.
+func MimeTypeIn(vs ...string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldIn(FieldMimeType, vs...))
+}
+
+// MimeTypeNotIn applies the NotIn predicate on the "mime_type" field.
+func MimeTypeNotIn(vs ...string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNotIn(FieldMimeType, vs...))
+}
+
+// MimeTypeGT applies the GT predicate on the "mime_type" field.
+func MimeTypeGT(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGT(FieldMimeType, v))
+}
+
+// MimeTypeGTE applies the GTE predicate on the "mime_type" field.
+func MimeTypeGTE(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGTE(FieldMimeType, v))
+}
+
+// MimeTypeLT applies the LT predicate on the "mime_type" field.
+func MimeTypeLT(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLT(FieldMimeType, v))
+}
+
+// MimeTypeLTE applies the LTE predicate on the "mime_type" field.
+func MimeTypeLTE(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLTE(FieldMimeType, v))
+}
+
+// MimeTypeContains applies the Contains predicate on the "mime_type" field.
+func MimeTypeContains(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldContains(FieldMimeType, v))
+}
+
+// MimeTypeHasPrefix applies the HasPrefix predicate on the "mime_type" field.
+func MimeTypeHasPrefix(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldHasPrefix(FieldMimeType, v))
+}
+
+// MimeTypeHasSuffix applies the HasSuffix predicate on the "mime_type" field.
+func MimeTypeHasSuffix(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldHasSuffix(FieldMimeType, v))
+}
+
+// MimeTypeEqualFold applies the EqualFold predicate on the "mime_type" field.
+func MimeTypeEqualFold(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEqualFold(FieldMimeType, v))
+}
+
+// MimeTypeContainsFold applies the ContainsFold predicate on the "mime_type" field.
+func MimeTypeContainsFold(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldContainsFold(FieldMimeType, v))
+}
+
+// FilesizeEQ applies the EQ predicate on the "filesize" field.
+func FilesizeEQ(v int64) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldFilesize, v))
+}
+
+// FilesizeNEQ applies the NEQ predicate on the "filesize" field.
+func FilesizeNEQ(v int64) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNEQ(FieldFilesize, v))
+}
+
+// FilesizeIn applies the In predicate on the "filesize" field.
+func FilesizeIn(vs ...int64) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldIn(FieldFilesize, vs...))
+}
+
+// FilesizeNotIn applies the NotIn predicate on the "filesize" field.
+func FilesizeNotIn(vs ...int64) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNotIn(FieldFilesize, vs...))
+}
+
+// FilesizeGT applies the GT predicate on the "filesize" field.
+func FilesizeGT(v int64) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGT(FieldFilesize, v))
+}
+
+// FilesizeGTE applies the GTE predicate on the "filesize" field.
+func FilesizeGTE(v int64) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGTE(FieldFilesize, v))
+}
+
+// FilesizeLT applies the LT predicate on the "filesize" field.
+func FilesizeLT(v int64) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLT(FieldFilesize, v))
+}
+
+// FilesizeLTE applies the LTE predicate on the "filesize" field.
+func FilesizeLTE(v int64) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLTE(FieldFilesize, v))
+}
+
+// DurationSecondsEQ applies the EQ predicate on the "duration_seconds" field.
+func DurationSecondsEQ(v int) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldDurationSeconds, v))
+}
+
+// DurationSecondsNEQ applies the NEQ predicate on the "duration_seconds" field.
+func DurationSecondsNEQ(v int) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNEQ(FieldDurationSeconds, v))
+}
+
+// DurationSecondsIn applies the In predicate on the "duration_seconds" field.
+func DurationSecondsIn(vs ...int) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldIn(FieldDurationSeconds, vs...))
+}
+
+// DurationSecondsNotIn applies the NotIn predicate on the "duration_seconds" field.
+func DurationSecondsNotIn(vs ...int) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNotIn(FieldDurationSeconds, vs...))
+}
+
+// DurationSecondsGT applies the GT predicate on the "duration_seconds" field.
+func DurationSecondsGT(v int) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGT(FieldDurationSeconds, v))
+}
+
+// DurationSecondsGTE applies the GTE predicate on the "duration_seconds" field.
+func DurationSecondsGTE(v int) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGTE(FieldDurationSeconds, v))
+}
+
+// DurationSecondsLT applies the LT predicate on the "duration_seconds" field.
+func DurationSecondsLT(v int) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLT(FieldDurationSeconds, v))
+}
+
+// DurationSecondsLTE applies the LTE predicate on the "duration_seconds" field.
+func DurationSecondsLTE(v int) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLTE(FieldDurationSeconds, v))
+}
+
+// PlaybackURLEQ applies the EQ predicate on the "playback_url" field.
+func PlaybackURLEQ(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldPlaybackURL, v))
+}
+
+// PlaybackURLNEQ applies the NEQ predicate on the "playback_url" field.
+func PlaybackURLNEQ(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNEQ(FieldPlaybackURL, v))
+}
+
+// PlaybackURLIn applies the In predicate on the "playback_url" field.
+func PlaybackURLIn(vs ...string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldIn(FieldPlaybackURL, vs...))
+}
+
+// PlaybackURLNotIn applies the NotIn predicate on the "playback_url" field.
+func PlaybackURLNotIn(vs ...string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNotIn(FieldPlaybackURL, vs...))
+}
+
+// PlaybackURLGT applies the GT predicate on the "playback_url" field.
+func PlaybackURLGT(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGT(FieldPlaybackURL, v))
+}
+
+// PlaybackURLGTE applies the GTE predicate on the "playback_url" field.
+func PlaybackURLGTE(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGTE(FieldPlaybackURL, v))
+}
+
+// PlaybackURLLT applies the LT predicate on the "playback_url" field.
+func PlaybackURLLT(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLT(FieldPlaybackURL, v))
+}
+
+// PlaybackURLLTE applies the LTE predicate on the "playback_url" field.
+func PlaybackURLLTE(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLTE(FieldPlaybackURL, v))
+}
+
+// PlaybackURLContains applies the Contains predicate on the "playback_url" field.
+func PlaybackURLContains(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldContains(FieldPlaybackURL, v))
+}
+
+// PlaybackURLHasPrefix applies the HasPrefix predicate on the "playback_url" field.
+func PlaybackURLHasPrefix(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldHasPrefix(FieldPlaybackURL, v))
+}
+
+// PlaybackURLHasSuffix applies the HasSuffix predicate on the "playback_url" field.
+func PlaybackURLHasSuffix(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldHasSuffix(FieldPlaybackURL, v))
+}
+
+// PlaybackURLEqualFold applies the EqualFold predicate on the "playback_url" field.
+func PlaybackURLEqualFold(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEqualFold(FieldPlaybackURL, v))
+}
+
+// PlaybackURLContainsFold applies the ContainsFold predicate on the "playback_url" field.
+func PlaybackURLContainsFold(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldContainsFold(FieldPlaybackURL, v))
+}
+
+// HlsManifestURLEQ applies the EQ predicate on the "hls_manifest_url" field.
+func HlsManifestURLEQ(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEQ(FieldHlsManifestURL, v))
+}
+
+// HlsManifestURLNEQ applies the NEQ predicate on the "hls_manifest_url" field.
+func HlsManifestURLNEQ(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNEQ(FieldHlsManifestURL, v))
+}
+
+// HlsManifestURLIn applies the In predicate on the "hls_manifest_url" field.
+func HlsManifestURLIn(vs ...string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldIn(FieldHlsManifestURL, vs...))
+}
+
+// HlsManifestURLNotIn applies the NotIn predicate on the "hls_manifest_url" field.
+func HlsManifestURLNotIn(vs ...string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldNotIn(FieldHlsManifestURL, vs...))
+}
+
+// HlsManifestURLGT applies the GT predicate on the "hls_manifest_url" field.
+func HlsManifestURLGT(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGT(FieldHlsManifestURL, v))
+}
+
+// HlsManifestURLGTE applies the GTE predicate on the "hls_manifest_url" field.
+func HlsManifestURLGTE(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldGTE(FieldHlsManifestURL, v))
+}
+
+// HlsManifestURLLT applies the LT predicate on the "hls_manifest_url" field.
+func HlsManifestURLLT(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLT(FieldHlsManifestURL, v))
+}
+
+// HlsManifestURLLTE applies the LTE predicate on the "hls_manifest_url" field.
+func HlsManifestURLLTE(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldLTE(FieldHlsManifestURL, v))
+}
+
+// HlsManifestURLContains applies the Contains predicate on the "hls_manifest_url" field.
+func HlsManifestURLContains(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldContains(FieldHlsManifestURL, v))
+}
+
+// HlsManifestURLHasPrefix applies the HasPrefix predicate on the "hls_manifest_url" field.
+func HlsManifestURLHasPrefix(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldHasPrefix(FieldHlsManifestURL, v))
+}
+
+// HlsManifestURLHasSuffix applies the HasSuffix predicate on the "hls_manifest_url" field.
+func HlsManifestURLHasSuffix(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldHasSuffix(FieldHlsManifestURL, v))
+}
+
+// HlsManifestURLEqualFold applies the EqualFold predicate on the "hls_manifest_url" field.
+func HlsManifestURLEqualFold(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldEqualFold(FieldHlsManifestURL, v))
+}
+
+// HlsManifestURLContainsFold applies the ContainsFold predicate on the "hls_manifest_url" field.
+func HlsManifestURLContainsFold(v string) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.FieldContainsFold(FieldHlsManifestURL, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AssetVersion) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AssetVersion) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AssetVersion) predicate.AssetVersion {
+	return predicate.AssetVersion(sql.NotPredicates(p))
+}
@@ -0,0 +1,397 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/assetversion"
+	"github.com/google/uuid"
+)
+
+// AssetVersionCreate is the builder for creating a AssetVersion entity.
+type AssetVersionCreate struct {
+	config
+	mutation *AssetVersionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AssetVersionCreate) SetCreatedAt(v time.Time) *AssetVersionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetAssetID sets the "asset_id" field.
+func (_c *AssetVersionCreate) SetAssetID(v uuid.UUID) *AssetVersionCreate {
+	_c.mutation.SetAssetID(v)
+	return _c
+}
+
+// SetAssetKey sets the "asset_key" field.
+func (_c *AssetVersionCreate) SetAssetKey(v string) *AssetVersionCreate {
+	_c.mutation.SetAssetKey(v)
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *AssetVersionCreate) SetProvider(v string) *AssetVersionCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_c *AssetVersionCreate) SetNillableProvider(v *string) *AssetVersionCreate {
+	if v != nil {
+		_c.SetProvider(*v)
+	}
+	return _c
+}
+
+// SetOriginalFilename sets the "original_filename" field.
+func (_c *AssetVersionCreate) SetOriginalFilename(v string) *AssetVersionCreate {
+	_c.mutation.SetOriginalFilename(v)
+	return _c
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_c *AssetVersionCreate) SetMimeType(v string) *AssetVersionCreate {
+	_c.mutation.SetMimeType(v)
+	return _c
+}
+
+// SetFilesize sets the "filesize" field.
+func (_c *AssetVersionCreate) SetFilesize(v int64) *AssetVersionCreate {
+	_c.mutation.SetFilesize(v)
+	return _c
+}
+
+// SetNillableFilesize sets the "filesize" field if the given value is not nil.
+func (_c *AssetVersionCreate) SetNillableFilesize(v *int64) *AssetVersionCreate {
+	if v != nil {
+		_c.SetFilesize(*v)
+	}
+	return _c
+}
+
+// SetDurationSeconds sets the "duration_seconds" field.
+func (_c *AssetVersionCreate) SetDurationSeconds(v int) *AssetVersionCreate {
+	_c.mutation.SetDurationSeconds(v)
+	return _c
+}
+
+// SetNillableDurationSeconds sets the "duration_seconds" field if the given value is not nil.
+func (_c *AssetVersionCreate) SetNillableDurationSeconds(v *int) *AssetVersionCreate {
+	if v != nil {
+		_c.SetDurationSeconds(*v)
+	}
+	return _c
+}
+
+// SetPlaybackURL sets the "playback_url" field.
+func (_c *AssetVersionCreate) SetPlaybackURL(v string) *AssetVersionCreate {
+	_c.mutation.SetPlaybackURL(v)
+	return _c
+}
+
+// SetNillablePlaybackURL sets the "playback_url" field if the given value is not nil.
+func (_c *AssetVersionCreate) SetNillablePlaybackURL(v *string) *AssetVersionCreate {
+	if v != nil {
+		_c.SetPlaybackURL(*v)
+	}
+	return _c
+}
+
+// SetHlsManifestURL sets the "hls_manifest_url" field.
+func (_c *AssetVersionCreate) SetHlsManifestURL(v string) *AssetVersionCreate {
+	_c.mutation.SetHlsManifestURL(v)
+	return _c
+}
+
+// SetNillableHlsManifestURL sets the "hls_manifest_url" field if the given value is not nil.
+func (_c *AssetVersionCreate) SetNillableHlsManifestURL(v *string) *AssetVersionCreate {
+	if v != nil {
+		_c.SetHlsManifestURL(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AssetVersionCreate) SetID(v uuid.UUID) *AssetVersionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AssetVersionCreate) SetNillableID(v *uuid.UUID) *AssetVersionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AssetVersionMutation object of the builder.
+func (_c *AssetVersionCreate) Mutation() *AssetVersionMutation {
+	return _c.mutation
+}
+
+// Save creates the AssetVersion in the database.
+func (_c *AssetVersionCreate) Save(ctx context.Context) (*AssetVersion, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AssetVersionCreate) SaveX(ctx context.Context) *AssetVersion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AssetVersionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AssetVersionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AssetVersionCreate) defaults() error {
+	if _, ok := _c.mutation.Provider(); !ok {
+		v := assetversion.DefaultProvider
+		_c.mutation.SetProvider(v)
+	}
+	if _, ok := _c.mutation.Filesize(); !ok {
+		v := assetversion.DefaultFilesize
+		_c.mutation.SetFilesize(v)
+	}
+	if _, ok := _c.mutation.DurationSeconds(); !ok {
+		v := assetversion.DefaultDurationSeconds
+		_c.mutation.SetDurationSeconds(v)
+	}
+	if _, ok := _c.mutation.PlaybackURL(); !ok {
+		v := assetversion.DefaultPlaybackURL
+		_c.mutation.SetPlaybackURL(v)
+	}
+	if _, ok := _c.mutation.HlsManifestURL(); !ok {
+		v := assetversion.DefaultHlsManifestURL
+		_c.mutation.SetHlsManifestURL(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if assetversion.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized assetversion.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := assetversion.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AssetVersionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "AssetVersion.created_at"`)}
+	}
+	if _, ok := _c.mutation.AssetID(); !ok {
+		return &ValidationError{Name: "asset_id", err: errors.New(`generated: missing required field "AssetVersion.asset_id"`)}
+	}
+	if _, ok := _c.mutation.AssetKey(); !ok {
+		return &ValidationError{Name: "asset_key", err: errors.New(`generated: missing required field "AssetVersion.asset_key"`)}
+	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`generated: missing required field "AssetVersion.provider"`)}
+	}
+	if _, ok := _c.mutation.OriginalFilename(); !ok {
+		return &ValidationError{Name: "original_filename", err: errors.New(`generated: missing required field "AssetVersion.original_filename"`)}
+	}
+	if _, ok := _c.mutation.MimeType(); !ok {
+		return &ValidationError{Name: "mime_type", err: errors.New(`generated: missing required field "AssetVersion.mime_type"`)}
+	}
+	if _, ok := _c.mutation.Filesize(); !ok {
+		return &ValidationError{Name: "filesize", err: errors.New(`generated: missing required field "AssetVersion.filesize"`)}
+	}
+	if _, ok := _c.mutation.DurationSeconds(); !ok {
+		return &ValidationError{Name: "duration_seconds", err: errors.New(`generated: missing required field "AssetVersion.duration_seconds"`)}
+	}
+	if _, ok := _c.mutation.PlaybackURL(); !ok {
+		return &ValidationError{Name: "playback_url", err: errors.New(`generated: missing required field "AssetVersion.playback_url"`)}
+	}
+	if _, ok := _c.mutation.HlsManifestURL(); !ok {
+		return &ValidationError{Name: "hls_manifest_url", err: errors.New(`generated: missing required field "AssetVersion.hls_manifest_url"`)}
+	}
+	return nil
+}
+
+func (_c *AssetVersionCreate) sqlSave(ctx context.Context) (*AssetVersion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AssetVersionCreate) createSpec() (*AssetVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AssetVersion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(assetversion.Table, sqlgraph.NewFieldSpec(assetversion.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(assetversion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.AssetID(); ok {
+		_spec.SetField(assetversion.FieldAssetID, field.TypeUUID, value)
+		_node.AssetID = value
+	}
+	if value, ok := _c.mutation.AssetKey(); ok {
+		_spec.SetField(assetversion.FieldAssetKey, field.TypeString, value)
+		_node.AssetKey = value
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(assetversion.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.OriginalFilename(); ok {
+		_spec.SetField(assetversion.FieldOriginalFilename, field.TypeString, value)
+		_node.OriginalFilename = value
+	}
+	if value, ok := _c.mutation.MimeType(); ok {
+		_spec.SetField(assetversion.FieldMimeType, field.TypeString, value)
+		_node.MimeType = value
+	}
+	if value, ok := _c.mutation.Filesize(); ok {
+		_spec.SetField(assetversion.FieldFilesize, field.TypeInt64, value)
+		_node.Filesize = value
+	}
+	if value, ok := _c.mutation.DurationSeconds(); ok {
+		_spec.SetField(assetversion.FieldDurationSeconds, field.TypeInt, value)
+		_node.DurationSeconds = value
+	}
+	if value, ok := _c.mutation.PlaybackURL(); ok {
+		_spec.SetField(assetversion.FieldPlaybackURL, field.TypeString, value)
+		_node.PlaybackURL = value
+	}
+	if value, ok := _c.mutation.HlsManifestURL(); ok {
+		_spec.SetField(assetversion.FieldHlsManifestURL, field.TypeString, value)
+		_node.HlsManifestURL = value
+	}
+	return _node, _spec
+}
+
+// AssetVersionCreateBulk is the builder for creating many AssetVersion entities in bulk.
+type AssetVersionCreateBulk struct {
+	config
+	err      error
+	builders []*AssetVersionCreate
+}
+
+// Save creates the AssetVersion entities in the database.
+func (_c *AssetVersionCreateBulk) Save(ctx context.Context) ([]*AssetVersion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AssetVersion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AssetVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AssetVersionCreateBulk) SaveX(ctx context.Context) []*AssetVersion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AssetVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AssetVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/availabilityslot"
+	"github.com/google/uuid"
+)
+
+// AvailabilitySlot is the model entity for the AvailabilitySlot schema.
+type AvailabilitySlot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// TeacherID holds the value of the "teacher_id" field.
+	TeacherID string `json:"teacher_id,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// EndsAt holds the value of the "ends_at" field.
+	EndsAt time.Time `json:"ends_at,omitempty"`
+	// Booked holds the value of the "booked" field.
+	Booked bool `json:"booked,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AvailabilitySlotQuery when eager-loading is set.
+	Edges        AvailabilitySlotEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AvailabilitySlotEdges holds the relations/edges for other nodes in the graph.
+type AvailabilitySlotEdges struct {
+	// Bookings holds the value of the bookings edge.
+	Bookings []*Booking `json:"bookings,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BookingsOrErr returns the Bookings value or an error if the edge
+// was not loaded in eager-loading.
+func (e AvailabilitySlotEdges) BookingsOrErr() ([]*Booking, error) {
+	if e.loadedTypes[0] {
+		return e.Bookings, nil
+	}
+	return nil, &NotLoadedError{edge: "bookings"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AvailabilitySlot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case availabilityslot.FieldBooked:
+			values[i] = new(sql.NullBool)
+		case availabilityslot.FieldTeacherID:
+			values[i] = new(sql.NullString)
+		case availabilityslot.FieldCreatedAt, availabilityslot.FieldUpdatedAt, availabilityslot.FieldStartsAt, availabilityslot.FieldEndsAt:
+			values[i] = new(sql.NullTime)
+		case availabilityslot.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AvailabilitySlot fields.
+func (_m *AvailabilitySlot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case availabilityslot.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case availabilityslot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case availabilityslot.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case availabilityslot.FieldTeacherID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field teacher_id", values[i])
+			} else if value.Valid {
+				_m.TeacherID = value.String
+			}
+		case availabilityslot.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				_m.StartsAt = value.Time
+			}
+		case availabilityslot.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				_m.EndsAt = value.Time
+			}
+		case availabilityslot.FieldBooked:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field booked", values[i])
+			} else if value.Valid {
+				_m.Booked = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AvailabilitySlot.
+// This includes values selected through modifiers, order, etc.
+func (_m *AvailabilitySlot) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBookings queries the "bookings" edge of the AvailabilitySlot entity.
+func (_m *AvailabilitySlot) QueryBookings() *BookingQuery {
+	return NewAvailabilitySlotClient(_m.config).QueryBookings(_m)
+}
+
+// Update returns a builder for updating this AvailabilitySlot.
+// Note that you need to call AvailabilitySlot.Unwrap() before calling this method if this AvailabilitySlot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AvailabilitySlot) Update() *AvailabilitySlotUpdateOne {
+	return NewAvailabilitySlotClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AvailabilitySlot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AvailabilitySlot) Unwrap() *AvailabilitySlot {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: AvailabilitySlot is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AvailabilitySlot) String() string {
+	var builder strings.Builder
+	builder.WriteString("AvailabilitySlot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("teacher_id=")
+	builder.WriteString(_m.TeacherID)
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(_m.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ends_at=")
+	builder.WriteString(_m.EndsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("booked=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Booked))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AvailabilitySlots is a parsable slice of AvailabilitySlot.
+type AvailabilitySlots []*AvailabilitySlot
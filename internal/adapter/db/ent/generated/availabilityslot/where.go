@@ -0,0 +1,360 @@
+// Code generated by ent, DO NOT EDIT.
+
+package availabilityslot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TeacherID applies equality check predicate on the "teacher_id" field. It's identical to TeacherIDEQ.
+func TeacherID(v string) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldEQ(FieldTeacherID, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// Booked applies equality check predicate on the "booked" field. It's identical to BookedEQ.
+func Booked(v bool) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldEQ(FieldBooked, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TeacherIDEQ applies the EQ predicate on the "teacher_id" field.
+func TeacherIDEQ(v string) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldEQ(FieldTeacherID, v))
+}
+
+// TeacherIDNEQ applies the NEQ predicate on the "teacher_id" field.
+func TeacherIDNEQ(v string) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldNEQ(FieldTeacherID, v))
+}
+
+// TeacherIDIn applies the In predicate on the "teacher_id" field.
+func TeacherIDIn(vs ...string) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldIn(FieldTeacherID, vs...))
+}
+
+// TeacherIDNotIn applies the NotIn predicate on the "teacher_id" field.
+func TeacherIDNotIn(vs ...string) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldNotIn(FieldTeacherID, vs...))
+}
+
+// TeacherIDGT applies the GT predicate on the "teacher_id" field.
+func TeacherIDGT(v string) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldGT(FieldTeacherID, v))
+}
+
+// TeacherIDGTE applies the GTE predicate on the "teacher_id" field.
+func TeacherIDGTE(v string) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldGTE(FieldTeacherID, v))
+}
+
+// TeacherIDLT applies the LT predicate on the "teacher_id" field.
+func TeacherIDLT(v string) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldLT(FieldTeacherID, v))
+}
+
+// TeacherIDLTE applies the LTE predicate on the "teacher_id" field.
+func TeacherIDLTE(v string) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldLTE(FieldTeacherID, v))
+}
+
+// TeacherIDContains applies the Contains predicate on the "teacher_id" field.
+func TeacherIDContains(v string) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldContains(FieldTeacherID, v))
+}
+
+// TeacherIDHasPrefix applies the HasPrefix predicate on the "teacher_id" field.
+func TeacherIDHasPrefix(v string) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldHasPrefix(FieldTeacherID, v))
+}
+
+// TeacherIDHasSuffix applies the HasSuffix predicate on the "teacher_id" field.
+func TeacherIDHasSuffix(v string) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldHasSuffix(FieldTeacherID, v))
+}
+
+// TeacherIDEqualFold applies the EqualFold predicate on the "teacher_id" field.
+func TeacherIDEqualFold(v string) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldEqualFold(FieldTeacherID, v))
+}
+
+// TeacherIDContainsFold applies the ContainsFold predicate on the "teacher_id" field.
+func TeacherIDContainsFold(v string) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldContainsFold(FieldTeacherID, v))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldNEQ(FieldEndsAt, v))
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldIn(FieldEndsAt, vs...))
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldNotIn(FieldEndsAt, vs...))
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldGT(FieldEndsAt, v))
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldGTE(FieldEndsAt, v))
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldLT(FieldEndsAt, v))
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldLTE(FieldEndsAt, v))
+}
+
+// BookedEQ applies the EQ predicate on the "booked" field.
+func BookedEQ(v bool) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldEQ(FieldBooked, v))
+}
+
+// BookedNEQ applies the NEQ predicate on the "booked" field.
+func BookedNEQ(v bool) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.FieldNEQ(FieldBooked, v))
+}
+
+// HasBookings applies the HasEdge predicate on the "bookings" edge.
+func HasBookings() predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BookingsTable, BookingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBookingsWith applies the HasEdge predicate on the "bookings" edge with a given conditions (other predicates).
+func HasBookingsWith(preds ...predicate.Booking) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(func(s *sql.Selector) {
+		step := newBookingsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AvailabilitySlot) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AvailabilitySlot) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AvailabilitySlot) predicate.AvailabilitySlot {
+	return predicate.AvailabilitySlot(sql.NotPredicates(p))
+}
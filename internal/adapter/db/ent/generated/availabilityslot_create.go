@@ -0,0 +1,329 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/availabilityslot"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/booking"
+	"github.com/google/uuid"
+)
+
+// AvailabilitySlotCreate is the builder for creating a AvailabilitySlot entity.
+type AvailabilitySlotCreate struct {
+	config
+	mutation *AvailabilitySlotMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AvailabilitySlotCreate) SetCreatedAt(v time.Time) *AvailabilitySlotCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AvailabilitySlotCreate) SetUpdatedAt(v time.Time) *AvailabilitySlotCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetTeacherID sets the "teacher_id" field.
+func (_c *AvailabilitySlotCreate) SetTeacherID(v string) *AvailabilitySlotCreate {
+	_c.mutation.SetTeacherID(v)
+	return _c
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_c *AvailabilitySlotCreate) SetStartsAt(v time.Time) *AvailabilitySlotCreate {
+	_c.mutation.SetStartsAt(v)
+	return _c
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_c *AvailabilitySlotCreate) SetEndsAt(v time.Time) *AvailabilitySlotCreate {
+	_c.mutation.SetEndsAt(v)
+	return _c
+}
+
+// SetBooked sets the "booked" field.
+func (_c *AvailabilitySlotCreate) SetBooked(v bool) *AvailabilitySlotCreate {
+	_c.mutation.SetBooked(v)
+	return _c
+}
+
+// SetNillableBooked sets the "booked" field if the given value is not nil.
+func (_c *AvailabilitySlotCreate) SetNillableBooked(v *bool) *AvailabilitySlotCreate {
+	if v != nil {
+		_c.SetBooked(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AvailabilitySlotCreate) SetID(v uuid.UUID) *AvailabilitySlotCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AvailabilitySlotCreate) SetNillableID(v *uuid.UUID) *AvailabilitySlotCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddBookingIDs adds the "bookings" edge to the Booking entity by IDs.
+func (_c *AvailabilitySlotCreate) AddBookingIDs(ids ...uuid.UUID) *AvailabilitySlotCreate {
+	_c.mutation.AddBookingIDs(ids...)
+	return _c
+}
+
+// AddBookings adds the "bookings" edges to the Booking entity.
+func (_c *AvailabilitySlotCreate) AddBookings(v ...*Booking) *AvailabilitySlotCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBookingIDs(ids...)
+}
+
+// Mutation returns the AvailabilitySlotMutation object of the builder.
+func (_c *AvailabilitySlotCreate) Mutation() *AvailabilitySlotMutation {
+	return _c.mutation
+}
+
+// Save creates the AvailabilitySlot in the database.
+func (_c *AvailabilitySlotCreate) Save(ctx context.Context) (*AvailabilitySlot, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AvailabilitySlotCreate) SaveX(ctx context.Context) *AvailabilitySlot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AvailabilitySlotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AvailabilitySlotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AvailabilitySlotCreate) defaults() error {
+	if _, ok := _c.mutation.Booked(); !ok {
+		v := availabilityslot.DefaultBooked
+		_c.mutation.SetBooked(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if availabilityslot.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized availabilityslot.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := availabilityslot.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AvailabilitySlotCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "AvailabilitySlot.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "AvailabilitySlot.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TeacherID(); !ok {
+		return &ValidationError{Name: "teacher_id", err: errors.New(`generated: missing required field "AvailabilitySlot.teacher_id"`)}
+	}
+	if _, ok := _c.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`generated: missing required field "AvailabilitySlot.starts_at"`)}
+	}
+	if _, ok := _c.mutation.EndsAt(); !ok {
+		return &ValidationError{Name: "ends_at", err: errors.New(`generated: missing required field "AvailabilitySlot.ends_at"`)}
+	}
+	if _, ok := _c.mutation.Booked(); !ok {
+		return &ValidationError{Name: "booked", err: errors.New(`generated: missing required field "AvailabilitySlot.booked"`)}
+	}
+	return nil
+}
+
+func (_c *AvailabilitySlotCreate) sqlSave(ctx context.Context) (*AvailabilitySlot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AvailabilitySlotCreate) createSpec() (*AvailabilitySlot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AvailabilitySlot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(availabilityslot.Table, sqlgraph.NewFieldSpec(availabilityslot.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(availabilityslot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(availabilityslot.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.TeacherID(); ok {
+		_spec.SetField(availabilityslot.FieldTeacherID, field.TypeString, value)
+		_node.TeacherID = value
+	}
+	if value, ok := _c.mutation.StartsAt(); ok {
+		_spec.SetField(availabilityslot.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = value
+	}
+	if value, ok := _c.mutation.EndsAt(); ok {
+		_spec.SetField(availabilityslot.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = value
+	}
+	if value, ok := _c.mutation.Booked(); ok {
+		_spec.SetField(availabilityslot.FieldBooked, field.TypeBool, value)
+		_node.Booked = value
+	}
+	if nodes := _c.mutation.BookingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   availabilityslot.BookingsTable,
+			Columns: []string{availabilityslot.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booking.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AvailabilitySlotCreateBulk is the builder for creating many AvailabilitySlot entities in bulk.
+type AvailabilitySlotCreateBulk struct {
+	config
+	err      error
+	builders []*AvailabilitySlotCreate
+}
+
+// Save creates the AvailabilitySlot entities in the database.
+func (_c *AvailabilitySlotCreateBulk) Save(ctx context.Context) ([]*AvailabilitySlot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AvailabilitySlot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AvailabilitySlotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AvailabilitySlotCreateBulk) SaveX(ctx context.Context) []*AvailabilitySlot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AvailabilitySlotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AvailabilitySlotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,408 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/availabilityslot"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/booking"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// AvailabilitySlotUpdate is the builder for updating AvailabilitySlot entities.
+type AvailabilitySlotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AvailabilitySlotMutation
+}
+
+// Where appends a list predicates to the AvailabilitySlotUpdate builder.
+func (_u *AvailabilitySlotUpdate) Where(ps ...predicate.AvailabilitySlot) *AvailabilitySlotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AvailabilitySlotUpdate) SetUpdatedAt(v time.Time) *AvailabilitySlotUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *AvailabilitySlotUpdate) SetNillableUpdatedAt(v *time.Time) *AvailabilitySlotUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetBooked sets the "booked" field.
+func (_u *AvailabilitySlotUpdate) SetBooked(v bool) *AvailabilitySlotUpdate {
+	_u.mutation.SetBooked(v)
+	return _u
+}
+
+// SetNillableBooked sets the "booked" field if the given value is not nil.
+func (_u *AvailabilitySlotUpdate) SetNillableBooked(v *bool) *AvailabilitySlotUpdate {
+	if v != nil {
+		_u.SetBooked(*v)
+	}
+	return _u
+}
+
+// AddBookingIDs adds the "bookings" edge to the Booking entity by IDs.
+func (_u *AvailabilitySlotUpdate) AddBookingIDs(ids ...uuid.UUID) *AvailabilitySlotUpdate {
+	_u.mutation.AddBookingIDs(ids...)
+	return _u
+}
+
+// AddBookings adds the "bookings" edges to the Booking entity.
+func (_u *AvailabilitySlotUpdate) AddBookings(v ...*Booking) *AvailabilitySlotUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBookingIDs(ids...)
+}
+
+// Mutation returns the AvailabilitySlotMutation object of the builder.
+func (_u *AvailabilitySlotUpdate) Mutation() *AvailabilitySlotMutation {
+	return _u.mutation
+}
+
+// ClearBookings clears all "bookings" edges to the Booking entity.
+func (_u *AvailabilitySlotUpdate) ClearBookings() *AvailabilitySlotUpdate {
+	_u.mutation.ClearBookings()
+	return _u
+}
+
+// RemoveBookingIDs removes the "bookings" edge to Booking entities by IDs.
+func (_u *AvailabilitySlotUpdate) RemoveBookingIDs(ids ...uuid.UUID) *AvailabilitySlotUpdate {
+	_u.mutation.RemoveBookingIDs(ids...)
+	return _u
+}
+
+// RemoveBookings removes "bookings" edges to Booking entities.
+func (_u *AvailabilitySlotUpdate) RemoveBookings(v ...*Booking) *AvailabilitySlotUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBookingIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AvailabilitySlotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AvailabilitySlotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AvailabilitySlotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AvailabilitySlotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AvailabilitySlotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(availabilityslot.Table, availabilityslot.Columns, sqlgraph.NewFieldSpec(availabilityslot.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(availabilityslot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Booked(); ok {
+		_spec.SetField(availabilityslot.FieldBooked, field.TypeBool, value)
+	}
+	if _u.mutation.BookingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   availabilityslot.BookingsTable,
+			Columns: []string{availabilityslot.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booking.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBookingsIDs(); len(nodes) > 0 && !_u.mutation.BookingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   availabilityslot.BookingsTable,
+			Columns: []string{availabilityslot.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booking.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BookingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   availabilityslot.BookingsTable,
+			Columns: []string{availabilityslot.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booking.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{availabilityslot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AvailabilitySlotUpdateOne is the builder for updating a single AvailabilitySlot entity.
+type AvailabilitySlotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AvailabilitySlotMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AvailabilitySlotUpdateOne) SetUpdatedAt(v time.Time) *AvailabilitySlotUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *AvailabilitySlotUpdateOne) SetNillableUpdatedAt(v *time.Time) *AvailabilitySlotUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetBooked sets the "booked" field.
+func (_u *AvailabilitySlotUpdateOne) SetBooked(v bool) *AvailabilitySlotUpdateOne {
+	_u.mutation.SetBooked(v)
+	return _u
+}
+
+// SetNillableBooked sets the "booked" field if the given value is not nil.
+func (_u *AvailabilitySlotUpdateOne) SetNillableBooked(v *bool) *AvailabilitySlotUpdateOne {
+	if v != nil {
+		_u.SetBooked(*v)
+	}
+	return _u
+}
+
+// AddBookingIDs adds the "bookings" edge to the Booking entity by IDs.
+func (_u *AvailabilitySlotUpdateOne) AddBookingIDs(ids ...uuid.UUID) *AvailabilitySlotUpdateOne {
+	_u.mutation.AddBookingIDs(ids...)
+	return _u
+}
+
+// AddBookings adds the "bookings" edges to the Booking entity.
+func (_u *AvailabilitySlotUpdateOne) AddBookings(v ...*Booking) *AvailabilitySlotUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBookingIDs(ids...)
+}
+
+// Mutation returns the AvailabilitySlotMutation object of the builder.
+func (_u *AvailabilitySlotUpdateOne) Mutation() *AvailabilitySlotMutation {
+	return _u.mutation
+}
+
+// ClearBookings clears all "bookings" edges to the Booking entity.
+func (_u *AvailabilitySlotUpdateOne) ClearBookings() *AvailabilitySlotUpdateOne {
+	_u.mutation.ClearBookings()
+	return _u
+}
+
+// RemoveBookingIDs removes the "bookings" edge to Booking entities by IDs.
+func (_u *AvailabilitySlotUpdateOne) RemoveBookingIDs(ids ...uuid.UUID) *AvailabilitySlotUpdateOne {
+	_u.mutation.RemoveBookingIDs(ids...)
+	return _u
+}
+
+// RemoveBookings removes "bookings" edges to Booking entities.
+func (_u *AvailabilitySlotUpdateOne) RemoveBookings(v ...*Booking) *AvailabilitySlotUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBookingIDs(ids...)
+}
+
+// Where appends a list predicates to the AvailabilitySlotUpdate builder.
+func (_u *AvailabilitySlotUpdateOne) Where(ps ...predicate.AvailabilitySlot) *AvailabilitySlotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AvailabilitySlotUpdateOne) Select(field string, fields ...string) *AvailabilitySlotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AvailabilitySlot entity.
+func (_u *AvailabilitySlotUpdateOne) Save(ctx context.Context) (*AvailabilitySlot, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AvailabilitySlotUpdateOne) SaveX(ctx context.Context) *AvailabilitySlot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AvailabilitySlotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AvailabilitySlotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AvailabilitySlotUpdateOne) sqlSave(ctx context.Context) (_node *AvailabilitySlot, err error) {
+	_spec := sqlgraph.NewUpdateSpec(availabilityslot.Table, availabilityslot.Columns, sqlgraph.NewFieldSpec(availabilityslot.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "AvailabilitySlot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, availabilityslot.FieldID)
+		for _, f := range fields {
+			if !availabilityslot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != availabilityslot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(availabilityslot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Booked(); ok {
+		_spec.SetField(availabilityslot.FieldBooked, field.TypeBool, value)
+	}
+	if _u.mutation.BookingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   availabilityslot.BookingsTable,
+			Columns: []string{availabilityslot.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booking.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBookingsIDs(); len(nodes) > 0 && !_u.mutation.BookingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   availabilityslot.BookingsTable,
+			Columns: []string{availabilityslot.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booking.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BookingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   availabilityslot.BookingsTable,
+			Columns: []string{availabilityslot.BookingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booking.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AvailabilitySlot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{availabilityslot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
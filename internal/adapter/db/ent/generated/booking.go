@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/availabilityslot"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/booking"
+	"github.com/google/uuid"
+)
+
+// Booking is the model entity for the Booking schema.
+type Booking struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// SlotID holds the value of the "slot_id" field.
+	SlotID uuid.UUID `json:"slot_id,omitempty"`
+	// TeacherID holds the value of the "teacher_id" field.
+	TeacherID string `json:"teacher_id,omitempty"`
+	// LearnerID holds the value of the "learner_id" field.
+	LearnerID string `json:"learner_id,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// EndsAt holds the value of the "ends_at" field.
+	EndsAt time.Time `json:"ends_at,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// Status holds the value of the "status" field.
+	Status int `json:"status,omitempty"`
+	// CancelledAt holds the value of the "cancelled_at" field.
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// CancelledBy holds the value of the "cancelled_by" field.
+	CancelledBy string `json:"cancelled_by,omitempty"`
+	// CancelReason holds the value of the "cancel_reason" field.
+	CancelReason string `json:"cancel_reason,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BookingQuery when eager-loading is set.
+	Edges        BookingEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BookingEdges holds the relations/edges for other nodes in the graph.
+type BookingEdges struct {
+	// Slot holds the value of the slot edge.
+	Slot *AvailabilitySlot `json:"slot,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SlotOrErr returns the Slot value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BookingEdges) SlotOrErr() (*AvailabilitySlot, error) {
+	if e.Slot != nil {
+		return e.Slot, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: availabilityslot.Label}
+	}
+	return nil, &NotLoadedError{edge: "slot"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Booking) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case booking.FieldStatus:
+			values[i] = new(sql.NullInt64)
+		case booking.FieldTeacherID, booking.FieldLearnerID, booking.FieldNote, booking.FieldCancelledBy, booking.FieldCancelReason:
+			values[i] = new(sql.NullString)
+		case booking.FieldCreatedAt, booking.FieldUpdatedAt, booking.FieldStartsAt, booking.FieldEndsAt, booking.FieldCancelledAt:
+			values[i] = new(sql.NullTime)
+		case booking.FieldID, booking.FieldSlotID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Booking fields.
+func (_m *Booking) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case booking.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case booking.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case booking.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case booking.FieldSlotID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field slot_id", values[i])
+			} else if value != nil {
+				_m.SlotID = *value
+			}
+		case booking.FieldTeacherID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field teacher_id", values[i])
+			} else if value.Valid {
+				_m.TeacherID = value.String
+			}
+		case booking.FieldLearnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field learner_id", values[i])
+			} else if value.Valid {
+				_m.LearnerID = value.String
+			}
+		case booking.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				_m.StartsAt = value.Time
+			}
+		case booking.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				_m.EndsAt = value.Time
+			}
+		case booking.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case booking.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = int(value.Int64)
+			}
+		case booking.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				_m.CancelledAt = new(time.Time)
+				*_m.CancelledAt = value.Time
+			}
+		case booking.FieldCancelledBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_by", values[i])
+			} else if value.Valid {
+				_m.CancelledBy = value.String
+			}
+		case booking.FieldCancelReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancel_reason", values[i])
+			} else if value.Valid {
+				_m.CancelReason = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Booking.
+// This includes values selected through modifiers, order, etc.
+func (_m *Booking) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySlot queries the "slot" edge of the Booking entity.
+func (_m *Booking) QuerySlot() *AvailabilitySlotQuery {
+	return NewBookingClient(_m.config).QuerySlot(_m)
+}
+
+// Update returns a builder for updating this Booking.
+// Note that you need to call Booking.Unwrap() before calling this method if this Booking
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Booking) Update() *BookingUpdateOne {
+	return NewBookingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Booking entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Booking) Unwrap() *Booking {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: Booking is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Booking) String() string {
+	var builder strings.Builder
+	builder.WriteString("Booking(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("slot_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SlotID))
+	builder.WriteString(", ")
+	builder.WriteString("teacher_id=")
+	builder.WriteString(_m.TeacherID)
+	builder.WriteString(", ")
+	builder.WriteString("learner_id=")
+	builder.WriteString(_m.LearnerID)
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(_m.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ends_at=")
+	builder.WriteString(_m.EndsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cancelled_by=")
+	builder.WriteString(_m.CancelledBy)
+	builder.WriteString(", ")
+	builder.WriteString("cancel_reason=")
+	builder.WriteString(_m.CancelReason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Bookings is a parsable slice of Booking.
+type Bookings []*Booking
@@ -0,0 +1,426 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/availabilityslot"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/booking"
+	"github.com/google/uuid"
+)
+
+// BookingCreate is the builder for creating a Booking entity.
+type BookingCreate struct {
+	config
+	mutation *BookingMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BookingCreate) SetCreatedAt(v time.Time) *BookingCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BookingCreate) SetUpdatedAt(v time.Time) *BookingCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetSlotID sets the "slot_id" field.
+func (_c *BookingCreate) SetSlotID(v uuid.UUID) *BookingCreate {
+	_c.mutation.SetSlotID(v)
+	return _c
+}
+
+// SetTeacherID sets the "teacher_id" field.
+func (_c *BookingCreate) SetTeacherID(v string) *BookingCreate {
+	_c.mutation.SetTeacherID(v)
+	return _c
+}
+
+// SetLearnerID sets the "learner_id" field.
+func (_c *BookingCreate) SetLearnerID(v string) *BookingCreate {
+	_c.mutation.SetLearnerID(v)
+	return _c
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_c *BookingCreate) SetStartsAt(v time.Time) *BookingCreate {
+	_c.mutation.SetStartsAt(v)
+	return _c
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_c *BookingCreate) SetEndsAt(v time.Time) *BookingCreate {
+	_c.mutation.SetEndsAt(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *BookingCreate) SetNote(v string) *BookingCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *BookingCreate) SetNillableNote(v *string) *BookingCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *BookingCreate) SetStatus(v int) *BookingCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_c *BookingCreate) SetCancelledAt(v time.Time) *BookingCreate {
+	_c.mutation.SetCancelledAt(v)
+	return _c
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_c *BookingCreate) SetNillableCancelledAt(v *time.Time) *BookingCreate {
+	if v != nil {
+		_c.SetCancelledAt(*v)
+	}
+	return _c
+}
+
+// SetCancelledBy sets the "cancelled_by" field.
+func (_c *BookingCreate) SetCancelledBy(v string) *BookingCreate {
+	_c.mutation.SetCancelledBy(v)
+	return _c
+}
+
+// SetNillableCancelledBy sets the "cancelled_by" field if the given value is not nil.
+func (_c *BookingCreate) SetNillableCancelledBy(v *string) *BookingCreate {
+	if v != nil {
+		_c.SetCancelledBy(*v)
+	}
+	return _c
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (_c *BookingCreate) SetCancelReason(v string) *BookingCreate {
+	_c.mutation.SetCancelReason(v)
+	return _c
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (_c *BookingCreate) SetNillableCancelReason(v *string) *BookingCreate {
+	if v != nil {
+		_c.SetCancelReason(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BookingCreate) SetID(v uuid.UUID) *BookingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BookingCreate) SetNillableID(v *uuid.UUID) *BookingCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetSlot sets the "slot" edge to the AvailabilitySlot entity.
+func (_c *BookingCreate) SetSlot(v *AvailabilitySlot) *BookingCreate {
+	return _c.SetSlotID(v.ID)
+}
+
+// Mutation returns the BookingMutation object of the builder.
+func (_c *BookingCreate) Mutation() *BookingMutation {
+	return _c.mutation
+}
+
+// Save creates the Booking in the database.
+func (_c *BookingCreate) Save(ctx context.Context) (*Booking, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BookingCreate) SaveX(ctx context.Context) *Booking {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BookingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BookingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BookingCreate) defaults() error {
+	if _, ok := _c.mutation.Note(); !ok {
+		v := booking.DefaultNote
+		_c.mutation.SetNote(v)
+	}
+	if _, ok := _c.mutation.CancelledBy(); !ok {
+		v := booking.DefaultCancelledBy
+		_c.mutation.SetCancelledBy(v)
+	}
+	if _, ok := _c.mutation.CancelReason(); !ok {
+		v := booking.DefaultCancelReason
+		_c.mutation.SetCancelReason(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if booking.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized booking.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := booking.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BookingCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Booking.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Booking.updated_at"`)}
+	}
+	if _, ok := _c.mutation.SlotID(); !ok {
+		return &ValidationError{Name: "slot_id", err: errors.New(`generated: missing required field "Booking.slot_id"`)}
+	}
+	if _, ok := _c.mutation.TeacherID(); !ok {
+		return &ValidationError{Name: "teacher_id", err: errors.New(`generated: missing required field "Booking.teacher_id"`)}
+	}
+	if _, ok := _c.mutation.LearnerID(); !ok {
+		return &ValidationError{Name: "learner_id", err: errors.New(`generated: missing required field "Booking.learner_id"`)}
+	}
+	if _, ok := _c.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`generated: missing required field "Booking.starts_at"`)}
+	}
+	if _, ok := _c.mutation.EndsAt(); !ok {
+		return &ValidationError{Name: "ends_at", err: errors.New(`generated: missing required field "Booking.ends_at"`)}
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		return &ValidationError{Name: "note", err: errors.New(`generated: missing required field "Booking.note"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`generated: missing required field "Booking.status"`)}
+	}
+	if _, ok := _c.mutation.CancelledBy(); !ok {
+		return &ValidationError{Name: "cancelled_by", err: errors.New(`generated: missing required field "Booking.cancelled_by"`)}
+	}
+	if _, ok := _c.mutation.CancelReason(); !ok {
+		return &ValidationError{Name: "cancel_reason", err: errors.New(`generated: missing required field "Booking.cancel_reason"`)}
+	}
+	if len(_c.mutation.SlotIDs()) == 0 {
+		return &ValidationError{Name: "slot", err: errors.New(`generated: missing required edge "Booking.slot"`)}
+	}
+	return nil
+}
+
+func (_c *BookingCreate) sqlSave(ctx context.Context) (*Booking, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BookingCreate) createSpec() (*Booking, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Booking{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(booking.Table, sqlgraph.NewFieldSpec(booking.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(booking.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(booking.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.TeacherID(); ok {
+		_spec.SetField(booking.FieldTeacherID, field.TypeString, value)
+		_node.TeacherID = value
+	}
+	if value, ok := _c.mutation.LearnerID(); ok {
+		_spec.SetField(booking.FieldLearnerID, field.TypeString, value)
+		_node.LearnerID = value
+	}
+	if value, ok := _c.mutation.StartsAt(); ok {
+		_spec.SetField(booking.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = value
+	}
+	if value, ok := _c.mutation.EndsAt(); ok {
+		_spec.SetField(booking.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(booking.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(booking.FieldStatus, field.TypeInt, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CancelledAt(); ok {
+		_spec.SetField(booking.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := _c.mutation.CancelledBy(); ok {
+		_spec.SetField(booking.FieldCancelledBy, field.TypeString, value)
+		_node.CancelledBy = value
+	}
+	if value, ok := _c.mutation.CancelReason(); ok {
+		_spec.SetField(booking.FieldCancelReason, field.TypeString, value)
+		_node.CancelReason = value
+	}
+	if nodes := _c.mutation.SlotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.SlotTable,
+			Columns: []string{booking.SlotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(availabilityslot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SlotID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BookingCreateBulk is the builder for creating many Booking entities in bulk.
+type BookingCreateBulk struct {
+	config
+	err      error
+	builders []*BookingCreate
+}
+
+// Save creates the Booking entities in the database.
+func (_c *BookingCreateBulk) Save(ctx context.Context) ([]*Booking, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Booking, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BookingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BookingCreateBulk) SaveX(ctx context.Context) []*Booking {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BookingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BookingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/availabilityslot"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/booking"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// BookingUpdate is the builder for updating Booking entities.
+type BookingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BookingMutation
+}
+
+// Where appends a list predicates to the BookingUpdate builder.
+func (_u *BookingUpdate) Where(ps ...predicate.Booking) *BookingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BookingUpdate) SetUpdatedAt(v time.Time) *BookingUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillableUpdatedAt(v *time.Time) *BookingUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetSlotID sets the "slot_id" field.
+func (_u *BookingUpdate) SetSlotID(v uuid.UUID) *BookingUpdate {
+	_u.mutation.SetSlotID(v)
+	return _u
+}
+
+// SetNillableSlotID sets the "slot_id" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillableSlotID(v *uuid.UUID) *BookingUpdate {
+	if v != nil {
+		_u.SetSlotID(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *BookingUpdate) SetNote(v string) *BookingUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillableNote(v *string) *BookingUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BookingUpdate) SetStatus(v int) *BookingUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillableStatus(v *int) *BookingUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *BookingUpdate) AddStatus(v int) *BookingUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *BookingUpdate) SetCancelledAt(v time.Time) *BookingUpdate {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillableCancelledAt(v *time.Time) *BookingUpdate {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *BookingUpdate) ClearCancelledAt() *BookingUpdate {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetCancelledBy sets the "cancelled_by" field.
+func (_u *BookingUpdate) SetCancelledBy(v string) *BookingUpdate {
+	_u.mutation.SetCancelledBy(v)
+	return _u
+}
+
+// SetNillableCancelledBy sets the "cancelled_by" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillableCancelledBy(v *string) *BookingUpdate {
+	if v != nil {
+		_u.SetCancelledBy(*v)
+	}
+	return _u
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (_u *BookingUpdate) SetCancelReason(v string) *BookingUpdate {
+	_u.mutation.SetCancelReason(v)
+	return _u
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillableCancelReason(v *string) *BookingUpdate {
+	if v != nil {
+		_u.SetCancelReason(*v)
+	}
+	return _u
+}
+
+// SetSlot sets the "slot" edge to the AvailabilitySlot entity.
+func (_u *BookingUpdate) SetSlot(v *AvailabilitySlot) *BookingUpdate {
+	return _u.SetSlotID(v.ID)
+}
+
+// Mutation returns the BookingMutation object of the builder.
+func (_u *BookingUpdate) Mutation() *BookingMutation {
+	return _u.mutation
+}
+
+// ClearSlot clears the "slot" edge to the AvailabilitySlot entity.
+func (_u *BookingUpdate) ClearSlot() *BookingUpdate {
+	_u.mutation.ClearSlot()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BookingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BookingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BookingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BookingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BookingUpdate) check() error {
+	if _u.mutation.SlotCleared() && len(_u.mutation.SlotIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Booking.slot"`)
+	}
+	return nil
+}
+
+func (_u *BookingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(booking.Table, booking.Columns, sqlgraph.NewFieldSpec(booking.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(booking.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(booking.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(booking.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(booking.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(booking.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(booking.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelledBy(); ok {
+		_spec.SetField(booking.FieldCancelledBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CancelReason(); ok {
+		_spec.SetField(booking.FieldCancelReason, field.TypeString, value)
+	}
+	if _u.mutation.SlotCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.SlotTable,
+			Columns: []string{booking.SlotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(availabilityslot.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SlotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.SlotTable,
+			Columns: []string{booking.SlotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(availabilityslot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{booking.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BookingUpdateOne is the builder for updating a single Booking entity.
+type BookingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BookingMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BookingUpdateOne) SetUpdatedAt(v time.Time) *BookingUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillableUpdatedAt(v *time.Time) *BookingUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetSlotID sets the "slot_id" field.
+func (_u *BookingUpdateOne) SetSlotID(v uuid.UUID) *BookingUpdateOne {
+	_u.mutation.SetSlotID(v)
+	return _u
+}
+
+// SetNillableSlotID sets the "slot_id" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillableSlotID(v *uuid.UUID) *BookingUpdateOne {
+	if v != nil {
+		_u.SetSlotID(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *BookingUpdateOne) SetNote(v string) *BookingUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillableNote(v *string) *BookingUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BookingUpdateOne) SetStatus(v int) *BookingUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillableStatus(v *int) *BookingUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *BookingUpdateOne) AddStatus(v int) *BookingUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *BookingUpdateOne) SetCancelledAt(v time.Time) *BookingUpdateOne {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillableCancelledAt(v *time.Time) *BookingUpdateOne {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *BookingUpdateOne) ClearCancelledAt() *BookingUpdateOne {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetCancelledBy sets the "cancelled_by" field.
+func (_u *BookingUpdateOne) SetCancelledBy(v string) *BookingUpdateOne {
+	_u.mutation.SetCancelledBy(v)
+	return _u
+}
+
+// SetNillableCancelledBy sets the "cancelled_by" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillableCancelledBy(v *string) *BookingUpdateOne {
+	if v != nil {
+		_u.SetCancelledBy(*v)
+	}
+	return _u
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (_u *BookingUpdateOne) SetCancelReason(v string) *BookingUpdateOne {
+	_u.mutation.SetCancelReason(v)
+	return _u
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillableCancelReason(v *string) *BookingUpdateOne {
+	if v != nil {
+		_u.SetCancelReason(*v)
+	}
+	return _u
+}
+
+// SetSlot sets the "slot" edge to the AvailabilitySlot entity.
+func (_u *BookingUpdateOne) SetSlot(v *AvailabilitySlot) *BookingUpdateOne {
+	return _u.SetSlotID(v.ID)
+}
+
+// Mutation returns the BookingMutation object of the builder.
+func (_u *BookingUpdateOne) Mutation() *BookingMutation {
+	return _u.mutation
+}
+
+// ClearSlot clears the "slot" edge to the AvailabilitySlot entity.
+func (_u *BookingUpdateOne) ClearSlot() *BookingUpdateOne {
+	_u.mutation.ClearSlot()
+	return _u
+}
+
+// Where appends a list predicates to the BookingUpdate builder.
+func (_u *BookingUpdateOne) Where(ps ...predicate.Booking) *BookingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BookingUpdateOne) Select(field string, fields ...string) *BookingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Booking entity.
+func (_u *BookingUpdateOne) Save(ctx context.Context) (*Booking, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BookingUpdateOne) SaveX(ctx context.Context) *Booking {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BookingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BookingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BookingUpdateOne) check() error {
+	if _u.mutation.SlotCleared() && len(_u.mutation.SlotIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "Booking.slot"`)
+	}
+	return nil
+}
+
+func (_u *BookingUpdateOne) sqlSave(ctx context.Context) (_node *Booking, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(booking.Table, booking.Columns, sqlgraph.NewFieldSpec(booking.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "Booking.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, booking.FieldID)
+		for _, f := range fields {
+			if !booking.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != booking.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(booking.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(booking.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(booking.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(booking.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(booking.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(booking.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelledBy(); ok {
+		_spec.SetField(booking.FieldCancelledBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CancelReason(); ok {
+		_spec.SetField(booking.FieldCancelReason, field.TypeString, value)
+	}
+	if _u.mutation.SlotCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.SlotTable,
+			Columns: []string{booking.SlotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(availabilityslot.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SlotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booking.SlotTable,
+			Columns: []string{booking.SlotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(availabilityslot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Booking{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{booking.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroom"
+	"github.com/google/uuid"
+)
+
+// Classroom is the model entity for the Classroom schema.
+type Classroom struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// TeacherID holds the value of the "teacher_id" field.
+	TeacherID string `json:"teacher_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ClassroomQuery when eager-loading is set.
+	Edges        ClassroomEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ClassroomEdges holds the relations/edges for other nodes in the graph.
+type ClassroomEdges struct {
+	// Members holds the value of the members edge.
+	Members []*ClassroomMember `json:"members,omitempty"`
+	// Assignments holds the value of the assignments edge.
+	Assignments []*ClassroomAssignment `json:"assignments,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e ClassroomEdges) MembersOrErr() ([]*ClassroomMember, error) {
+	if e.loadedTypes[0] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// AssignmentsOrErr returns the Assignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e ClassroomEdges) AssignmentsOrErr() ([]*ClassroomAssignment, error) {
+	if e.loadedTypes[1] {
+		return e.Assignments, nil
+	}
+	return nil, &NotLoadedError{edge: "assignments"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Classroom) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case classroom.FieldTeacherID, classroom.FieldName, classroom.FieldDescription:
+			values[i] = new(sql.NullString)
+		case classroom.FieldCreatedAt, classroom.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case classroom.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Classroom fields.
+func (_m *Classroom) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case classroom.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case classroom.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case classroom.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case classroom.FieldTeacherID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field teacher_id", values[i])
+			} else if value.Valid {
+				_m.TeacherID = value.String
+			}
+		case classroom.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case classroom.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Classroom.
+// This includes values selected through modifiers, order, etc.
+func (_m *Classroom) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMembers queries the "members" edge of the Classroom entity.
+func (_m *Classroom) QueryMembers() *ClassroomMemberQuery {
+	return NewClassroomClient(_m.config).QueryMembers(_m)
+}
+
+// QueryAssignments queries the "assignments" edge of the Classroom entity.
+func (_m *Classroom) QueryAssignments() *ClassroomAssignmentQuery {
+	return NewClassroomClient(_m.config).QueryAssignments(_m)
+}
+
+// Update returns a builder for updating this Classroom.
+// Note that you need to call Classroom.Unwrap() before calling this method if this Classroom
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Classroom) Update() *ClassroomUpdateOne {
+	return NewClassroomClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Classroom entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Classroom) Unwrap() *Classroom {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: Classroom is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Classroom) String() string {
+	var builder strings.Builder
+	builder.WriteString("Classroom(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("teacher_id=")
+	builder.WriteString(_m.TeacherID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Classrooms is a parsable slice of Classroom.
+type Classrooms []*Classroom
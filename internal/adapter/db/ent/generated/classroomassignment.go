@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroom"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroomassignment"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/series"
+	"github.com/google/uuid"
+)
+
+// ClassroomAssignment is the model entity for the ClassroomAssignment schema.
+type ClassroomAssignment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ClassroomID holds the value of the "classroom_id" field.
+	ClassroomID uuid.UUID `json:"classroom_id,omitempty"`
+	// SeriesID holds the value of the "series_id" field.
+	SeriesID uuid.UUID `json:"series_id,omitempty"`
+	// DueAt holds the value of the "due_at" field.
+	DueAt time.Time `json:"due_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ClassroomAssignmentQuery when eager-loading is set.
+	Edges        ClassroomAssignmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ClassroomAssignmentEdges holds the relations/edges for other nodes in the graph.
+type ClassroomAssignmentEdges struct {
+	// Classroom holds the value of the classroom edge.
+	Classroom *Classroom `json:"classroom,omitempty"`
+	// Series holds the value of the series edge.
+	Series *Series `json:"series,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ClassroomOrErr returns the Classroom value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ClassroomAssignmentEdges) ClassroomOrErr() (*Classroom, error) {
+	if e.Classroom != nil {
+		return e.Classroom, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: classroom.Label}
+	}
+	return nil, &NotLoadedError{edge: "classroom"}
+}
+
+// SeriesOrErr returns the Series value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ClassroomAssignmentEdges) SeriesOrErr() (*Series, error) {
+	if e.Series != nil {
+		return e.Series, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: series.Label}
+	}
+	return nil, &NotLoadedError{edge: "series"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ClassroomAssignment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case classroomassignment.FieldCreatedAt, classroomassignment.FieldDueAt:
+			values[i] = new(sql.NullTime)
+		case classroomassignment.FieldID, classroomassignment.FieldClassroomID, classroomassignment.FieldSeriesID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ClassroomAssignment fields.
+func (_m *ClassroomAssignment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case classroomassignment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case classroomassignment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case classroomassignment.FieldClassroomID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field classroom_id", values[i])
+			} else if value != nil {
+				_m.ClassroomID = *value
+			}
+		case classroomassignment.FieldSeriesID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field series_id", values[i])
+			} else if value != nil {
+				_m.SeriesID = *value
+			}
+		case classroomassignment.FieldDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_at", values[i])
+			} else if value.Valid {
+				_m.DueAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ClassroomAssignment.
+// This includes values selected through modifiers, order, etc.
+func (_m *ClassroomAssignment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryClassroom queries the "classroom" edge of the ClassroomAssignment entity.
+func (_m *ClassroomAssignment) QueryClassroom() *ClassroomQuery {
+	return NewClassroomAssignmentClient(_m.config).QueryClassroom(_m)
+}
+
+// QuerySeries queries the "series" edge of the ClassroomAssignment entity.
+func (_m *ClassroomAssignment) QuerySeries() *SeriesQuery {
+	return NewClassroomAssignmentClient(_m.config).QuerySeries(_m)
+}
+
+// Update returns a builder for updating this ClassroomAssignment.
+// Note that you need to call ClassroomAssignment.Unwrap() before calling this method if this ClassroomAssignment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ClassroomAssignment) Update() *ClassroomAssignmentUpdateOne {
+	return NewClassroomAssignmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ClassroomAssignment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ClassroomAssignment) Unwrap() *ClassroomAssignment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: ClassroomAssignment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ClassroomAssignment) String() string {
+	var builder strings.Builder
+	builder.WriteString("ClassroomAssignment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("classroom_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClassroomID))
+	builder.WriteString(", ")
+	builder.WriteString("series_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SeriesID))
+	builder.WriteString(", ")
+	builder.WriteString("due_at=")
+	builder.WriteString(_m.DueAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ClassroomAssignments is a parsable slice of ClassroomAssignment.
+type ClassroomAssignments []*ClassroomAssignment
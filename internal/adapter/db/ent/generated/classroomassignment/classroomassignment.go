@@ -0,0 +1,131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package classroomassignment
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the classroomassignment type in the database.
+	Label = "classroom_assignment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldClassroomID holds the string denoting the classroom_id field in the database.
+	FieldClassroomID = "classroom_id"
+	// FieldSeriesID holds the string denoting the series_id field in the database.
+	FieldSeriesID = "series_id"
+	// FieldDueAt holds the string denoting the due_at field in the database.
+	FieldDueAt = "due_at"
+	// EdgeClassroom holds the string denoting the classroom edge name in mutations.
+	EdgeClassroom = "classroom"
+	// EdgeSeries holds the string denoting the series edge name in mutations.
+	EdgeSeries = "series"
+	// Table holds the table name of the classroomassignment in the database.
+	Table = "classroom_assignments"
+	// ClassroomTable is the table that holds the classroom relation/edge.
+	ClassroomTable = "classroom_assignments"
+	// ClassroomInverseTable is the table name for the Classroom entity.
+	// It exists in this package in order to avoid circular dependency with the "classroom" package.
+	ClassroomInverseTable = "classrooms"
+	// ClassroomColumn is the table column denoting the classroom relation/edge.
+	ClassroomColumn = "classroom_id"
+	// SeriesTable is the table that holds the series relation/edge.
+	SeriesTable = "classroom_assignments"
+	// SeriesInverseTable is the table name for the Series entity.
+	// It exists in this package in order to avoid circular dependency with the "series" package.
+	SeriesInverseTable = "series"
+	// SeriesColumn is the table column denoting the series relation/edge.
+	SeriesColumn = "series_id"
+)
+
+// Columns holds all SQL columns for classroomassignment fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldClassroomID,
+	FieldSeriesID,
+	FieldDueAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eslsoft/lession/internal/adapter/db/ent/generated/runtime"
+var (
+	Hooks [1]ent.Hook
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ClassroomAssignment queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByClassroomID orders the results by the classroom_id field.
+func ByClassroomID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClassroomID, opts...).ToFunc()
+}
+
+// BySeriesID orders the results by the series_id field.
+func BySeriesID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeriesID, opts...).ToFunc()
+}
+
+// ByDueAt orders the results by the due_at field.
+func ByDueAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueAt, opts...).ToFunc()
+}
+
+// ByClassroomField orders the results by classroom field.
+func ByClassroomField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newClassroomStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySeriesField orders the results by series field.
+func BySeriesField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSeriesStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newClassroomStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ClassroomInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ClassroomTable, ClassroomColumn),
+	)
+}
+func newSeriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SeriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, SeriesTable, SeriesColumn),
+	)
+}
@@ -0,0 +1,258 @@
+// Code generated by ent, DO NOT EDIT.
+
+package classroomassignment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ClassroomID applies equality check predicate on the "classroom_id" field. It's identical to ClassroomIDEQ.
+func ClassroomID(v uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldEQ(FieldClassroomID, v))
+}
+
+// SeriesID applies equality check predicate on the "series_id" field. It's identical to SeriesIDEQ.
+func SeriesID(v uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// DueAt applies equality check predicate on the "due_at" field. It's identical to DueAtEQ.
+func DueAt(v time.Time) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldEQ(FieldDueAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ClassroomIDEQ applies the EQ predicate on the "classroom_id" field.
+func ClassroomIDEQ(v uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldEQ(FieldClassroomID, v))
+}
+
+// ClassroomIDNEQ applies the NEQ predicate on the "classroom_id" field.
+func ClassroomIDNEQ(v uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldNEQ(FieldClassroomID, v))
+}
+
+// ClassroomIDIn applies the In predicate on the "classroom_id" field.
+func ClassroomIDIn(vs ...uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldIn(FieldClassroomID, vs...))
+}
+
+// ClassroomIDNotIn applies the NotIn predicate on the "classroom_id" field.
+func ClassroomIDNotIn(vs ...uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldNotIn(FieldClassroomID, vs...))
+}
+
+// SeriesIDEQ applies the EQ predicate on the "series_id" field.
+func SeriesIDEQ(v uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// SeriesIDNEQ applies the NEQ predicate on the "series_id" field.
+func SeriesIDNEQ(v uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldNEQ(FieldSeriesID, v))
+}
+
+// SeriesIDIn applies the In predicate on the "series_id" field.
+func SeriesIDIn(vs ...uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDNotIn applies the NotIn predicate on the "series_id" field.
+func SeriesIDNotIn(vs ...uuid.UUID) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldNotIn(FieldSeriesID, vs...))
+}
+
+// DueAtEQ applies the EQ predicate on the "due_at" field.
+func DueAtEQ(v time.Time) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldEQ(FieldDueAt, v))
+}
+
+// DueAtNEQ applies the NEQ predicate on the "due_at" field.
+func DueAtNEQ(v time.Time) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldNEQ(FieldDueAt, v))
+}
+
+// DueAtIn applies the In predicate on the "due_at" field.
+func DueAtIn(vs ...time.Time) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldIn(FieldDueAt, vs...))
+}
+
+// DueAtNotIn applies the NotIn predicate on the "due_at" field.
+func DueAtNotIn(vs ...time.Time) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldNotIn(FieldDueAt, vs...))
+}
+
+// DueAtGT applies the GT predicate on the "due_at" field.
+func DueAtGT(v time.Time) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldGT(FieldDueAt, v))
+}
+
+// DueAtGTE applies the GTE predicate on the "due_at" field.
+func DueAtGTE(v time.Time) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldGTE(FieldDueAt, v))
+}
+
+// DueAtLT applies the LT predicate on the "due_at" field.
+func DueAtLT(v time.Time) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldLT(FieldDueAt, v))
+}
+
+// DueAtLTE applies the LTE predicate on the "due_at" field.
+func DueAtLTE(v time.Time) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.FieldLTE(FieldDueAt, v))
+}
+
+// HasClassroom applies the HasEdge predicate on the "classroom" edge.
+func HasClassroom() predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ClassroomTable, ClassroomColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasClassroomWith applies the HasEdge predicate on the "classroom" edge with a given conditions (other predicates).
+func HasClassroomWith(preds ...predicate.Classroom) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(func(s *sql.Selector) {
+		step := newClassroomStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSeries applies the HasEdge predicate on the "series" edge.
+func HasSeries() predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, SeriesTable, SeriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSeriesWith applies the HasEdge predicate on the "series" edge with a given conditions (other predicates).
+func HasSeriesWith(preds ...predicate.Series) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(func(s *sql.Selector) {
+		step := newSeriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ClassroomAssignment) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ClassroomAssignment) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ClassroomAssignment) predicate.ClassroomAssignment {
+	return predicate.ClassroomAssignment(sql.NotPredicates(p))
+}
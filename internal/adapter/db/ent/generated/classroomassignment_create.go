@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroom"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroomassignment"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/series"
+	"github.com/google/uuid"
+)
+
+// ClassroomAssignmentCreate is the builder for creating a ClassroomAssignment entity.
+type ClassroomAssignmentCreate struct {
+	config
+	mutation *ClassroomAssignmentMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ClassroomAssignmentCreate) SetCreatedAt(v time.Time) *ClassroomAssignmentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetClassroomID sets the "classroom_id" field.
+func (_c *ClassroomAssignmentCreate) SetClassroomID(v uuid.UUID) *ClassroomAssignmentCreate {
+	_c.mutation.SetClassroomID(v)
+	return _c
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_c *ClassroomAssignmentCreate) SetSeriesID(v uuid.UUID) *ClassroomAssignmentCreate {
+	_c.mutation.SetSeriesID(v)
+	return _c
+}
+
+// SetDueAt sets the "due_at" field.
+func (_c *ClassroomAssignmentCreate) SetDueAt(v time.Time) *ClassroomAssignmentCreate {
+	_c.mutation.SetDueAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ClassroomAssignmentCreate) SetID(v uuid.UUID) *ClassroomAssignmentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ClassroomAssignmentCreate) SetNillableID(v *uuid.UUID) *ClassroomAssignmentCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetClassroom sets the "classroom" edge to the Classroom entity.
+func (_c *ClassroomAssignmentCreate) SetClassroom(v *Classroom) *ClassroomAssignmentCreate {
+	return _c.SetClassroomID(v.ID)
+}
+
+// SetSeries sets the "series" edge to the Series entity.
+func (_c *ClassroomAssignmentCreate) SetSeries(v *Series) *ClassroomAssignmentCreate {
+	return _c.SetSeriesID(v.ID)
+}
+
+// Mutation returns the ClassroomAssignmentMutation object of the builder.
+func (_c *ClassroomAssignmentCreate) Mutation() *ClassroomAssignmentMutation {
+	return _c.mutation
+}
+
+// Save creates the ClassroomAssignment in the database.
+func (_c *ClassroomAssignmentCreate) Save(ctx context.Context) (*ClassroomAssignment, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ClassroomAssignmentCreate) SaveX(ctx context.Context) *ClassroomAssignment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClassroomAssignmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClassroomAssignmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ClassroomAssignmentCreate) defaults() error {
+	if _, ok := _c.mutation.ID(); !ok {
+		if classroomassignment.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized classroomassignment.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := classroomassignment.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ClassroomAssignmentCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "ClassroomAssignment.created_at"`)}
+	}
+	if _, ok := _c.mutation.ClassroomID(); !ok {
+		return &ValidationError{Name: "classroom_id", err: errors.New(`generated: missing required field "ClassroomAssignment.classroom_id"`)}
+	}
+	if _, ok := _c.mutation.SeriesID(); !ok {
+		return &ValidationError{Name: "series_id", err: errors.New(`generated: missing required field "ClassroomAssignment.series_id"`)}
+	}
+	if _, ok := _c.mutation.DueAt(); !ok {
+		return &ValidationError{Name: "due_at", err: errors.New(`generated: missing required field "ClassroomAssignment.due_at"`)}
+	}
+	if len(_c.mutation.ClassroomIDs()) == 0 {
+		return &ValidationError{Name: "classroom", err: errors.New(`generated: missing required edge "ClassroomAssignment.classroom"`)}
+	}
+	if len(_c.mutation.SeriesIDs()) == 0 {
+		return &ValidationError{Name: "series", err: errors.New(`generated: missing required edge "ClassroomAssignment.series"`)}
+	}
+	return nil
+}
+
+func (_c *ClassroomAssignmentCreate) sqlSave(ctx context.Context) (*ClassroomAssignment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ClassroomAssignmentCreate) createSpec() (*ClassroomAssignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ClassroomAssignment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(classroomassignment.Table, sqlgraph.NewFieldSpec(classroomassignment.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(classroomassignment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.DueAt(); ok {
+		_spec.SetField(classroomassignment.FieldDueAt, field.TypeTime, value)
+		_node.DueAt = value
+	}
+	if nodes := _c.mutation.ClassroomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   classroomassignment.ClassroomTable,
+			Columns: []string{classroomassignment.ClassroomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(classroom.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ClassroomID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   classroomassignment.SeriesTable,
+			Columns: []string{classroomassignment.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SeriesID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ClassroomAssignmentCreateBulk is the builder for creating many ClassroomAssignment entities in bulk.
+type ClassroomAssignmentCreateBulk struct {
+	config
+	err      error
+	builders []*ClassroomAssignmentCreate
+}
+
+// Save creates the ClassroomAssignment entities in the database.
+func (_c *ClassroomAssignmentCreateBulk) Save(ctx context.Context) ([]*ClassroomAssignment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ClassroomAssignment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ClassroomAssignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ClassroomAssignmentCreateBulk) SaveX(ctx context.Context) []*ClassroomAssignment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClassroomAssignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClassroomAssignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
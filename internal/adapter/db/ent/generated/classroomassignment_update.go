@@ -0,0 +1,457 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroom"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroomassignment"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/series"
+	"github.com/google/uuid"
+)
+
+// ClassroomAssignmentUpdate is the builder for updating ClassroomAssignment entities.
+type ClassroomAssignmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ClassroomAssignmentMutation
+}
+
+// Where appends a list predicates to the ClassroomAssignmentUpdate builder.
+func (_u *ClassroomAssignmentUpdate) Where(ps ...predicate.ClassroomAssignment) *ClassroomAssignmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetClassroomID sets the "classroom_id" field.
+func (_u *ClassroomAssignmentUpdate) SetClassroomID(v uuid.UUID) *ClassroomAssignmentUpdate {
+	_u.mutation.SetClassroomID(v)
+	return _u
+}
+
+// SetNillableClassroomID sets the "classroom_id" field if the given value is not nil.
+func (_u *ClassroomAssignmentUpdate) SetNillableClassroomID(v *uuid.UUID) *ClassroomAssignmentUpdate {
+	if v != nil {
+		_u.SetClassroomID(*v)
+	}
+	return _u
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_u *ClassroomAssignmentUpdate) SetSeriesID(v uuid.UUID) *ClassroomAssignmentUpdate {
+	_u.mutation.SetSeriesID(v)
+	return _u
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (_u *ClassroomAssignmentUpdate) SetNillableSeriesID(v *uuid.UUID) *ClassroomAssignmentUpdate {
+	if v != nil {
+		_u.SetSeriesID(*v)
+	}
+	return _u
+}
+
+// SetDueAt sets the "due_at" field.
+func (_u *ClassroomAssignmentUpdate) SetDueAt(v time.Time) *ClassroomAssignmentUpdate {
+	_u.mutation.SetDueAt(v)
+	return _u
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_u *ClassroomAssignmentUpdate) SetNillableDueAt(v *time.Time) *ClassroomAssignmentUpdate {
+	if v != nil {
+		_u.SetDueAt(*v)
+	}
+	return _u
+}
+
+// SetClassroom sets the "classroom" edge to the Classroom entity.
+func (_u *ClassroomAssignmentUpdate) SetClassroom(v *Classroom) *ClassroomAssignmentUpdate {
+	return _u.SetClassroomID(v.ID)
+}
+
+// SetSeries sets the "series" edge to the Series entity.
+func (_u *ClassroomAssignmentUpdate) SetSeries(v *Series) *ClassroomAssignmentUpdate {
+	return _u.SetSeriesID(v.ID)
+}
+
+// Mutation returns the ClassroomAssignmentMutation object of the builder.
+func (_u *ClassroomAssignmentUpdate) Mutation() *ClassroomAssignmentMutation {
+	return _u.mutation
+}
+
+// ClearClassroom clears the "classroom" edge to the Classroom entity.
+func (_u *ClassroomAssignmentUpdate) ClearClassroom() *ClassroomAssignmentUpdate {
+	_u.mutation.ClearClassroom()
+	return _u
+}
+
+// ClearSeries clears the "series" edge to the Series entity.
+func (_u *ClassroomAssignmentUpdate) ClearSeries() *ClassroomAssignmentUpdate {
+	_u.mutation.ClearSeries()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ClassroomAssignmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClassroomAssignmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ClassroomAssignmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClassroomAssignmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ClassroomAssignmentUpdate) check() error {
+	if _u.mutation.ClassroomCleared() && len(_u.mutation.ClassroomIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "ClassroomAssignment.classroom"`)
+	}
+	if _u.mutation.SeriesCleared() && len(_u.mutation.SeriesIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "ClassroomAssignment.series"`)
+	}
+	return nil
+}
+
+func (_u *ClassroomAssignmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(classroomassignment.Table, classroomassignment.Columns, sqlgraph.NewFieldSpec(classroomassignment.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DueAt(); ok {
+		_spec.SetField(classroomassignment.FieldDueAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClassroomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   classroomassignment.ClassroomTable,
+			Columns: []string{classroomassignment.ClassroomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(classroom.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ClassroomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   classroomassignment.ClassroomTable,
+			Columns: []string{classroomassignment.ClassroomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(classroom.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SeriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   classroomassignment.SeriesTable,
+			Columns: []string{classroomassignment.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   classroomassignment.SeriesTable,
+			Columns: []string{classroomassignment.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{classroomassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ClassroomAssignmentUpdateOne is the builder for updating a single ClassroomAssignment entity.
+type ClassroomAssignmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ClassroomAssignmentMutation
+}
+
+// SetClassroomID sets the "classroom_id" field.
+func (_u *ClassroomAssignmentUpdateOne) SetClassroomID(v uuid.UUID) *ClassroomAssignmentUpdateOne {
+	_u.mutation.SetClassroomID(v)
+	return _u
+}
+
+// SetNillableClassroomID sets the "classroom_id" field if the given value is not nil.
+func (_u *ClassroomAssignmentUpdateOne) SetNillableClassroomID(v *uuid.UUID) *ClassroomAssignmentUpdateOne {
+	if v != nil {
+		_u.SetClassroomID(*v)
+	}
+	return _u
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_u *ClassroomAssignmentUpdateOne) SetSeriesID(v uuid.UUID) *ClassroomAssignmentUpdateOne {
+	_u.mutation.SetSeriesID(v)
+	return _u
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (_u *ClassroomAssignmentUpdateOne) SetNillableSeriesID(v *uuid.UUID) *ClassroomAssignmentUpdateOne {
+	if v != nil {
+		_u.SetSeriesID(*v)
+	}
+	return _u
+}
+
+// SetDueAt sets the "due_at" field.
+func (_u *ClassroomAssignmentUpdateOne) SetDueAt(v time.Time) *ClassroomAssignmentUpdateOne {
+	_u.mutation.SetDueAt(v)
+	return _u
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_u *ClassroomAssignmentUpdateOne) SetNillableDueAt(v *time.Time) *ClassroomAssignmentUpdateOne {
+	if v != nil {
+		_u.SetDueAt(*v)
+	}
+	return _u
+}
+
+// SetClassroom sets the "classroom" edge to the Classroom entity.
+func (_u *ClassroomAssignmentUpdateOne) SetClassroom(v *Classroom) *ClassroomAssignmentUpdateOne {
+	return _u.SetClassroomID(v.ID)
+}
+
+// SetSeries sets the "series" edge to the Series entity.
+func (_u *ClassroomAssignmentUpdateOne) SetSeries(v *Series) *ClassroomAssignmentUpdateOne {
+	return _u.SetSeriesID(v.ID)
+}
+
+// Mutation returns the ClassroomAssignmentMutation object of the builder.
+func (_u *ClassroomAssignmentUpdateOne) Mutation() *ClassroomAssignmentMutation {
+	return _u.mutation
+}
+
+// ClearClassroom clears the "classroom" edge to the Classroom entity.
+func (_u *ClassroomAssignmentUpdateOne) ClearClassroom() *ClassroomAssignmentUpdateOne {
+	_u.mutation.ClearClassroom()
+	return _u
+}
+
+// ClearSeries clears the "series" edge to the Series entity.
+func (_u *ClassroomAssignmentUpdateOne) ClearSeries() *ClassroomAssignmentUpdateOne {
+	_u.mutation.ClearSeries()
+	return _u
+}
+
+// Where appends a list predicates to the ClassroomAssignmentUpdate builder.
+func (_u *ClassroomAssignmentUpdateOne) Where(ps ...predicate.ClassroomAssignment) *ClassroomAssignmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ClassroomAssignmentUpdateOne) Select(field string, fields ...string) *ClassroomAssignmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ClassroomAssignment entity.
+func (_u *ClassroomAssignmentUpdateOne) Save(ctx context.Context) (*ClassroomAssignment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClassroomAssignmentUpdateOne) SaveX(ctx context.Context) *ClassroomAssignment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ClassroomAssignmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClassroomAssignmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ClassroomAssignmentUpdateOne) check() error {
+	if _u.mutation.ClassroomCleared() && len(_u.mutation.ClassroomIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "ClassroomAssignment.classroom"`)
+	}
+	if _u.mutation.SeriesCleared() && len(_u.mutation.SeriesIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "ClassroomAssignment.series"`)
+	}
+	return nil
+}
+
+func (_u *ClassroomAssignmentUpdateOne) sqlSave(ctx context.Context) (_node *ClassroomAssignment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(classroomassignment.Table, classroomassignment.Columns, sqlgraph.NewFieldSpec(classroomassignment.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "ClassroomAssignment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, classroomassignment.FieldID)
+		for _, f := range fields {
+			if !classroomassignment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != classroomassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DueAt(); ok {
+		_spec.SetField(classroomassignment.FieldDueAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClassroomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   classroomassignment.ClassroomTable,
+			Columns: []string{classroomassignment.ClassroomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(classroom.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ClassroomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   classroomassignment.ClassroomTable,
+			Columns: []string{classroomassignment.ClassroomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(classroom.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SeriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   classroomassignment.SeriesTable,
+			Columns: []string{classroomassignment.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   classroomassignment.SeriesTable,
+			Columns: []string{classroomassignment.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(series.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ClassroomAssignment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{classroomassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroom"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroommember"
+	"github.com/google/uuid"
+)
+
+// ClassroomMember is the model entity for the ClassroomMember schema.
+type ClassroomMember struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ClassroomID holds the value of the "classroom_id" field.
+	ClassroomID uuid.UUID `json:"classroom_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// JoinedAt holds the value of the "joined_at" field.
+	JoinedAt time.Time `json:"joined_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ClassroomMemberQuery when eager-loading is set.
+	Edges        ClassroomMemberEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ClassroomMemberEdges holds the relations/edges for other nodes in the graph.
+type ClassroomMemberEdges struct {
+	// Classroom holds the value of the classroom edge.
+	Classroom *Classroom `json:"classroom,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ClassroomOrErr returns the Classroom value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ClassroomMemberEdges) ClassroomOrErr() (*Classroom, error) {
+	if e.Classroom != nil {
+		return e.Classroom, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: classroom.Label}
+	}
+	return nil, &NotLoadedError{edge: "classroom"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ClassroomMember) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case classroommember.FieldUserID:
+			values[i] = new(sql.NullString)
+		case classroommember.FieldJoinedAt:
+			values[i] = new(sql.NullTime)
+		case classroommember.FieldID, classroommember.FieldClassroomID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ClassroomMember fields.
+func (_m *ClassroomMember) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case classroommember.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case classroommember.FieldClassroomID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field classroom_id", values[i])
+			} else if value != nil {
+				_m.ClassroomID = *value
+			}
+		case classroommember.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case classroommember.FieldJoinedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field joined_at", values[i])
+			} else if value.Valid {
+				_m.JoinedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ClassroomMember.
+// This includes values selected through modifiers, order, etc.
+func (_m *ClassroomMember) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryClassroom queries the "classroom" edge of the ClassroomMember entity.
+func (_m *ClassroomMember) QueryClassroom() *ClassroomQuery {
+	return NewClassroomMemberClient(_m.config).QueryClassroom(_m)
+}
+
+// Update returns a builder for updating this ClassroomMember.
+// Note that you need to call ClassroomMember.Unwrap() before calling this method if this ClassroomMember
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ClassroomMember) Update() *ClassroomMemberUpdateOne {
+	return NewClassroomMemberClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ClassroomMember entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ClassroomMember) Unwrap() *ClassroomMember {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: ClassroomMember is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ClassroomMember) String() string {
+	var builder strings.Builder
+	builder.WriteString("ClassroomMember(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("classroom_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClassroomID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("joined_at=")
+	builder.WriteString(_m.JoinedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ClassroomMembers is a parsable slice of ClassroomMember.
+type ClassroomMembers []*ClassroomMember
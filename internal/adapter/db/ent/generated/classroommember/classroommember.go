@@ -0,0 +1,97 @@
+// Code generated by ent, DO NOT EDIT.
+
+package classroommember
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the classroommember type in the database.
+	Label = "classroom_member"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldClassroomID holds the string denoting the classroom_id field in the database.
+	FieldClassroomID = "classroom_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldJoinedAt holds the string denoting the joined_at field in the database.
+	FieldJoinedAt = "joined_at"
+	// EdgeClassroom holds the string denoting the classroom edge name in mutations.
+	EdgeClassroom = "classroom"
+	// Table holds the table name of the classroommember in the database.
+	Table = "classroom_members"
+	// ClassroomTable is the table that holds the classroom relation/edge.
+	ClassroomTable = "classroom_members"
+	// ClassroomInverseTable is the table name for the Classroom entity.
+	// It exists in this package in order to avoid circular dependency with the "classroom" package.
+	ClassroomInverseTable = "classrooms"
+	// ClassroomColumn is the table column denoting the classroom relation/edge.
+	ClassroomColumn = "classroom_id"
+)
+
+// Columns holds all SQL columns for classroommember fields.
+var Columns = []string{
+	FieldID,
+	FieldClassroomID,
+	FieldUserID,
+	FieldJoinedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultJoinedAt holds the default value on creation for the "joined_at" field.
+	DefaultJoinedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ClassroomMember queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByClassroomID orders the results by the classroom_id field.
+func ByClassroomID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClassroomID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByJoinedAt orders the results by the joined_at field.
+func ByJoinedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJoinedAt, opts...).ToFunc()
+}
+
+// ByClassroomField orders the results by classroom field.
+func ByClassroomField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newClassroomStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newClassroomStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ClassroomInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ClassroomTable, ClassroomColumn),
+	)
+}
@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package classroommember
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldLTE(FieldID, id))
+}
+
+// ClassroomID applies equality check predicate on the "classroom_id" field. It's identical to ClassroomIDEQ.
+func ClassroomID(v uuid.UUID) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldEQ(FieldClassroomID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldEQ(FieldUserID, v))
+}
+
+// JoinedAt applies equality check predicate on the "joined_at" field. It's identical to JoinedAtEQ.
+func JoinedAt(v time.Time) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldEQ(FieldJoinedAt, v))
+}
+
+// ClassroomIDEQ applies the EQ predicate on the "classroom_id" field.
+func ClassroomIDEQ(v uuid.UUID) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldEQ(FieldClassroomID, v))
+}
+
+// ClassroomIDNEQ applies the NEQ predicate on the "classroom_id" field.
+func ClassroomIDNEQ(v uuid.UUID) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldNEQ(FieldClassroomID, v))
+}
+
+// ClassroomIDIn applies the In predicate on the "classroom_id" field.
+func ClassroomIDIn(vs ...uuid.UUID) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldIn(FieldClassroomID, vs...))
+}
+
+// ClassroomIDNotIn applies the NotIn predicate on the "classroom_id" field.
+func ClassroomIDNotIn(vs ...uuid.UUID) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldNotIn(FieldClassroomID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// JoinedAtEQ applies the EQ predicate on the "joined_at" field.
+func JoinedAtEQ(v time.Time) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldEQ(FieldJoinedAt, v))
+}
+
+// JoinedAtNEQ applies the NEQ predicate on the "joined_at" field.
+func JoinedAtNEQ(v time.Time) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldNEQ(FieldJoinedAt, v))
+}
+
+// JoinedAtIn applies the In predicate on the "joined_at" field.
+func JoinedAtIn(vs ...time.Time) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldIn(FieldJoinedAt, vs...))
+}
+
+// JoinedAtNotIn applies the NotIn predicate on the "joined_at" field.
+func JoinedAtNotIn(vs ...time.Time) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldNotIn(FieldJoinedAt, vs...))
+}
+
+// JoinedAtGT applies the GT predicate on the "joined_at" field.
+func JoinedAtGT(v time.Time) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldGT(FieldJoinedAt, v))
+}
+
+// JoinedAtGTE applies the GTE predicate on the "joined_at" field.
+func JoinedAtGTE(v time.Time) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldGTE(FieldJoinedAt, v))
+}
+
+// JoinedAtLT applies the LT predicate on the "joined_at" field.
+func JoinedAtLT(v time.Time) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldLT(FieldJoinedAt, v))
+}
+
+// JoinedAtLTE applies the LTE predicate on the "joined_at" field.
+func JoinedAtLTE(v time.Time) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.FieldLTE(FieldJoinedAt, v))
+}
+
+// HasClassroom applies the HasEdge predicate on the "classroom" edge.
+func HasClassroom() predicate.ClassroomMember {
+	return predicate.ClassroomMember(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ClassroomTable, ClassroomColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasClassroomWith applies the HasEdge predicate on the "classroom" edge with a given conditions (other predicates).
+func HasClassroomWith(preds ...predicate.Classroom) predicate.ClassroomMember {
+	return predicate.ClassroomMember(func(s *sql.Selector) {
+		step := newClassroomStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ClassroomMember) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ClassroomMember) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ClassroomMember) predicate.ClassroomMember {
+	return predicate.ClassroomMember(sql.NotPredicates(p))
+}
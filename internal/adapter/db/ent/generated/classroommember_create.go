@@ -0,0 +1,274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroom"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroommember"
+	"github.com/google/uuid"
+)
+
+// ClassroomMemberCreate is the builder for creating a ClassroomMember entity.
+type ClassroomMemberCreate struct {
+	config
+	mutation *ClassroomMemberMutation
+	hooks    []Hook
+}
+
+// SetClassroomID sets the "classroom_id" field.
+func (_c *ClassroomMemberCreate) SetClassroomID(v uuid.UUID) *ClassroomMemberCreate {
+	_c.mutation.SetClassroomID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ClassroomMemberCreate) SetUserID(v string) *ClassroomMemberCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (_c *ClassroomMemberCreate) SetJoinedAt(v time.Time) *ClassroomMemberCreate {
+	_c.mutation.SetJoinedAt(v)
+	return _c
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (_c *ClassroomMemberCreate) SetNillableJoinedAt(v *time.Time) *ClassroomMemberCreate {
+	if v != nil {
+		_c.SetJoinedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ClassroomMemberCreate) SetID(v uuid.UUID) *ClassroomMemberCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ClassroomMemberCreate) SetNillableID(v *uuid.UUID) *ClassroomMemberCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetClassroom sets the "classroom" edge to the Classroom entity.
+func (_c *ClassroomMemberCreate) SetClassroom(v *Classroom) *ClassroomMemberCreate {
+	return _c.SetClassroomID(v.ID)
+}
+
+// Mutation returns the ClassroomMemberMutation object of the builder.
+func (_c *ClassroomMemberCreate) Mutation() *ClassroomMemberMutation {
+	return _c.mutation
+}
+
+// Save creates the ClassroomMember in the database.
+func (_c *ClassroomMemberCreate) Save(ctx context.Context) (*ClassroomMember, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ClassroomMemberCreate) SaveX(ctx context.Context) *ClassroomMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClassroomMemberCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClassroomMemberCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ClassroomMemberCreate) defaults() {
+	if _, ok := _c.mutation.JoinedAt(); !ok {
+		v := classroommember.DefaultJoinedAt()
+		_c.mutation.SetJoinedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := classroommember.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ClassroomMemberCreate) check() error {
+	if _, ok := _c.mutation.ClassroomID(); !ok {
+		return &ValidationError{Name: "classroom_id", err: errors.New(`generated: missing required field "ClassroomMember.classroom_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "ClassroomMember.user_id"`)}
+	}
+	if _, ok := _c.mutation.JoinedAt(); !ok {
+		return &ValidationError{Name: "joined_at", err: errors.New(`generated: missing required field "ClassroomMember.joined_at"`)}
+	}
+	if len(_c.mutation.ClassroomIDs()) == 0 {
+		return &ValidationError{Name: "classroom", err: errors.New(`generated: missing required edge "ClassroomMember.classroom"`)}
+	}
+	return nil
+}
+
+func (_c *ClassroomMemberCreate) sqlSave(ctx context.Context) (*ClassroomMember, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ClassroomMemberCreate) createSpec() (*ClassroomMember, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ClassroomMember{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(classroommember.Table, sqlgraph.NewFieldSpec(classroommember.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(classroommember.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.JoinedAt(); ok {
+		_spec.SetField(classroommember.FieldJoinedAt, field.TypeTime, value)
+		_node.JoinedAt = value
+	}
+	if nodes := _c.mutation.ClassroomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   classroommember.ClassroomTable,
+			Columns: []string{classroommember.ClassroomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(classroom.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ClassroomID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ClassroomMemberCreateBulk is the builder for creating many ClassroomMember entities in bulk.
+type ClassroomMemberCreateBulk struct {
+	config
+	err      error
+	builders []*ClassroomMemberCreate
+}
+
+// Save creates the ClassroomMember entities in the database.
+func (_c *ClassroomMemberCreateBulk) Save(ctx context.Context) ([]*ClassroomMember, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ClassroomMember, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ClassroomMemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ClassroomMemberCreateBulk) SaveX(ctx context.Context) []*ClassroomMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ClassroomMemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ClassroomMemberCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
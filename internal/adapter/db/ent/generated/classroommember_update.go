@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroom"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroommember"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ClassroomMemberUpdate is the builder for updating ClassroomMember entities.
+type ClassroomMemberUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ClassroomMemberMutation
+}
+
+// Where appends a list predicates to the ClassroomMemberUpdate builder.
+func (_u *ClassroomMemberUpdate) Where(ps ...predicate.ClassroomMember) *ClassroomMemberUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetClassroomID sets the "classroom_id" field.
+func (_u *ClassroomMemberUpdate) SetClassroomID(v uuid.UUID) *ClassroomMemberUpdate {
+	_u.mutation.SetClassroomID(v)
+	return _u
+}
+
+// SetNillableClassroomID sets the "classroom_id" field if the given value is not nil.
+func (_u *ClassroomMemberUpdate) SetNillableClassroomID(v *uuid.UUID) *ClassroomMemberUpdate {
+	if v != nil {
+		_u.SetClassroomID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ClassroomMemberUpdate) SetUserID(v string) *ClassroomMemberUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ClassroomMemberUpdate) SetNillableUserID(v *string) *ClassroomMemberUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetClassroom sets the "classroom" edge to the Classroom entity.
+func (_u *ClassroomMemberUpdate) SetClassroom(v *Classroom) *ClassroomMemberUpdate {
+	return _u.SetClassroomID(v.ID)
+}
+
+// Mutation returns the ClassroomMemberMutation object of the builder.
+func (_u *ClassroomMemberUpdate) Mutation() *ClassroomMemberMutation {
+	return _u.mutation
+}
+
+// ClearClassroom clears the "classroom" edge to the Classroom entity.
+func (_u *ClassroomMemberUpdate) ClearClassroom() *ClassroomMemberUpdate {
+	_u.mutation.ClearClassroom()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ClassroomMemberUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClassroomMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ClassroomMemberUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClassroomMemberUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ClassroomMemberUpdate) check() error {
+	if _u.mutation.ClassroomCleared() && len(_u.mutation.ClassroomIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "ClassroomMember.classroom"`)
+	}
+	return nil
+}
+
+func (_u *ClassroomMemberUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(classroommember.Table, classroommember.Columns, sqlgraph.NewFieldSpec(classroommember.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(classroommember.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.ClassroomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   classroommember.ClassroomTable,
+			Columns: []string{classroommember.ClassroomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(classroom.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ClassroomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   classroommember.ClassroomTable,
+			Columns: []string{classroommember.ClassroomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(classroom.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{classroommember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ClassroomMemberUpdateOne is the builder for updating a single ClassroomMember entity.
+type ClassroomMemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ClassroomMemberMutation
+}
+
+// SetClassroomID sets the "classroom_id" field.
+func (_u *ClassroomMemberUpdateOne) SetClassroomID(v uuid.UUID) *ClassroomMemberUpdateOne {
+	_u.mutation.SetClassroomID(v)
+	return _u
+}
+
+// SetNillableClassroomID sets the "classroom_id" field if the given value is not nil.
+func (_u *ClassroomMemberUpdateOne) SetNillableClassroomID(v *uuid.UUID) *ClassroomMemberUpdateOne {
+	if v != nil {
+		_u.SetClassroomID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ClassroomMemberUpdateOne) SetUserID(v string) *ClassroomMemberUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ClassroomMemberUpdateOne) SetNillableUserID(v *string) *ClassroomMemberUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetClassroom sets the "classroom" edge to the Classroom entity.
+func (_u *ClassroomMemberUpdateOne) SetClassroom(v *Classroom) *ClassroomMemberUpdateOne {
+	return _u.SetClassroomID(v.ID)
+}
+
+// Mutation returns the ClassroomMemberMutation object of the builder.
+func (_u *ClassroomMemberUpdateOne) Mutation() *ClassroomMemberMutation {
+	return _u.mutation
+}
+
+// ClearClassroom clears the "classroom" edge to the Classroom entity.
+func (_u *ClassroomMemberUpdateOne) ClearClassroom() *ClassroomMemberUpdateOne {
+	_u.mutation.ClearClassroom()
+	return _u
+}
+
+// Where appends a list predicates to the ClassroomMemberUpdate builder.
+func (_u *ClassroomMemberUpdateOne) Where(ps ...predicate.ClassroomMember) *ClassroomMemberUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ClassroomMemberUpdateOne) Select(field string, fields ...string) *ClassroomMemberUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ClassroomMember entity.
+func (_u *ClassroomMemberUpdateOne) Save(ctx context.Context) (*ClassroomMember, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ClassroomMemberUpdateOne) SaveX(ctx context.Context) *ClassroomMember {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ClassroomMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ClassroomMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ClassroomMemberUpdateOne) check() error {
+	if _u.mutation.ClassroomCleared() && len(_u.mutation.ClassroomIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "ClassroomMember.classroom"`)
+	}
+	return nil
+}
+
+func (_u *ClassroomMemberUpdateOne) sqlSave(ctx context.Context) (_node *ClassroomMember, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(classroommember.Table, classroommember.Columns, sqlgraph.NewFieldSpec(classroommember.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "ClassroomMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, classroommember.FieldID)
+		for _, f := range fields {
+			if !classroommember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != classroommember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(classroommember.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.ClassroomCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   classroommember.ClassroomTable,
+			Columns: []string{classroommember.ClassroomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(classroom.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ClassroomIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   classroommember.ClassroomTable,
+			Columns: []string{classroommember.ClassroomColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(classroom.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ClassroomMember{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{classroommember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
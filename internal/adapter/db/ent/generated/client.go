@@ -16,10 +16,55 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/apikey"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/asset"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/assetusageday"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/assetversion"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/auditentry"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/availabilityslot"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/booking"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroom"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroomassignment"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroommember"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentembedding"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentkey"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentreassignment"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/devicetoken"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/dictationattempt"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/engagementrollup"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episode"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episodetranscript"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/event"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/feeditem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/feedsubscription"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/invoice"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/job"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/learneractivity"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltilaunch"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltiloginstate"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltiplatform"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/moderationitem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/notification"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/notificationpreference"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/outboxmessage"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/plan"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playbacksession"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playlist"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playlistitem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/quizitem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/scheduledtask"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/series"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/shadowingsubmission"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/subscription"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/transcriptreplacejob"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/transcriptrevision"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/uploadsession"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/usagerecord"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/usagesnapshot"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/vocabularyword"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/webhookdelivery"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/webhookendpoint"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/webhookevent"
 )
 
 // Client is the client that holds all ent builders.
@@ -27,14 +72,104 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
 	// Asset is the client for interacting with the Asset builders.
 	Asset *AssetClient
+	// AssetUsageDay is the client for interacting with the AssetUsageDay builders.
+	AssetUsageDay *AssetUsageDayClient
+	// AssetVersion is the client for interacting with the AssetVersion builders.
+	AssetVersion *AssetVersionClient
+	// AuditEntry is the client for interacting with the AuditEntry builders.
+	AuditEntry *AuditEntryClient
+	// AvailabilitySlot is the client for interacting with the AvailabilitySlot builders.
+	AvailabilitySlot *AvailabilitySlotClient
+	// Booking is the client for interacting with the Booking builders.
+	Booking *BookingClient
+	// Classroom is the client for interacting with the Classroom builders.
+	Classroom *ClassroomClient
+	// ClassroomAssignment is the client for interacting with the ClassroomAssignment builders.
+	ClassroomAssignment *ClassroomAssignmentClient
+	// ClassroomMember is the client for interacting with the ClassroomMember builders.
+	ClassroomMember *ClassroomMemberClient
+	// ContentEmbedding is the client for interacting with the ContentEmbedding builders.
+	ContentEmbedding *ContentEmbeddingClient
+	// ContentKey is the client for interacting with the ContentKey builders.
+	ContentKey *ContentKeyClient
+	// ContentReassignment is the client for interacting with the ContentReassignment builders.
+	ContentReassignment *ContentReassignmentClient
+	// DeviceToken is the client for interacting with the DeviceToken builders.
+	DeviceToken *DeviceTokenClient
+	// DictationAttempt is the client for interacting with the DictationAttempt builders.
+	DictationAttempt *DictationAttemptClient
+	// EngagementRollup is the client for interacting with the EngagementRollup builders.
+	EngagementRollup *EngagementRollupClient
 	// Episode is the client for interacting with the Episode builders.
 	Episode *EpisodeClient
+	// EpisodeTranscript is the client for interacting with the EpisodeTranscript builders.
+	EpisodeTranscript *EpisodeTranscriptClient
+	// Event is the client for interacting with the Event builders.
+	Event *EventClient
+	// FeedItem is the client for interacting with the FeedItem builders.
+	FeedItem *FeedItemClient
+	// FeedSubscription is the client for interacting with the FeedSubscription builders.
+	FeedSubscription *FeedSubscriptionClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
+	// LTILaunch is the client for interacting with the LTILaunch builders.
+	LTILaunch *LTILaunchClient
+	// LTILoginState is the client for interacting with the LTILoginState builders.
+	LTILoginState *LTILoginStateClient
+	// LTIPlatform is the client for interacting with the LTIPlatform builders.
+	LTIPlatform *LTIPlatformClient
+	// LearnerActivity is the client for interacting with the LearnerActivity builders.
+	LearnerActivity *LearnerActivityClient
+	// ModerationItem is the client for interacting with the ModerationItem builders.
+	ModerationItem *ModerationItemClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// OutboxMessage is the client for interacting with the OutboxMessage builders.
+	OutboxMessage *OutboxMessageClient
+	// Plan is the client for interacting with the Plan builders.
+	Plan *PlanClient
+	// PlaybackSession is the client for interacting with the PlaybackSession builders.
+	PlaybackSession *PlaybackSessionClient
+	// Playlist is the client for interacting with the Playlist builders.
+	Playlist *PlaylistClient
+	// PlaylistItem is the client for interacting with the PlaylistItem builders.
+	PlaylistItem *PlaylistItemClient
+	// QuizItem is the client for interacting with the QuizItem builders.
+	QuizItem *QuizItemClient
+	// ScheduledTask is the client for interacting with the ScheduledTask builders.
+	ScheduledTask *ScheduledTaskClient
 	// Series is the client for interacting with the Series builders.
 	Series *SeriesClient
+	// ShadowingSubmission is the client for interacting with the ShadowingSubmission builders.
+	ShadowingSubmission *ShadowingSubmissionClient
+	// Subscription is the client for interacting with the Subscription builders.
+	Subscription *SubscriptionClient
+	// TranscriptReplaceJob is the client for interacting with the TranscriptReplaceJob builders.
+	TranscriptReplaceJob *TranscriptReplaceJobClient
+	// TranscriptRevision is the client for interacting with the TranscriptRevision builders.
+	TranscriptRevision *TranscriptRevisionClient
 	// UploadSession is the client for interacting with the UploadSession builders.
 	UploadSession *UploadSessionClient
+	// UsageRecord is the client for interacting with the UsageRecord builders.
+	UsageRecord *UsageRecordClient
+	// UsageSnapshot is the client for interacting with the UsageSnapshot builders.
+	UsageSnapshot *UsageSnapshotClient
+	// VocabularyWord is the client for interacting with the VocabularyWord builders.
+	VocabularyWord *VocabularyWordClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookEndpoint is the client for interacting with the WebhookEndpoint builders.
+	WebhookEndpoint *WebhookEndpointClient
+	// WebhookEvent is the client for interacting with the WebhookEvent builders.
+	WebhookEvent *WebhookEventClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -46,10 +181,55 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APIKey = NewAPIKeyClient(c.config)
 	c.Asset = NewAssetClient(c.config)
+	c.AssetUsageDay = NewAssetUsageDayClient(c.config)
+	c.AssetVersion = NewAssetVersionClient(c.config)
+	c.AuditEntry = NewAuditEntryClient(c.config)
+	c.AvailabilitySlot = NewAvailabilitySlotClient(c.config)
+	c.Booking = NewBookingClient(c.config)
+	c.Classroom = NewClassroomClient(c.config)
+	c.ClassroomAssignment = NewClassroomAssignmentClient(c.config)
+	c.ClassroomMember = NewClassroomMemberClient(c.config)
+	c.ContentEmbedding = NewContentEmbeddingClient(c.config)
+	c.ContentKey = NewContentKeyClient(c.config)
+	c.ContentReassignment = NewContentReassignmentClient(c.config)
+	c.DeviceToken = NewDeviceTokenClient(c.config)
+	c.DictationAttempt = NewDictationAttemptClient(c.config)
+	c.EngagementRollup = NewEngagementRollupClient(c.config)
 	c.Episode = NewEpisodeClient(c.config)
+	c.EpisodeTranscript = NewEpisodeTranscriptClient(c.config)
+	c.Event = NewEventClient(c.config)
+	c.FeedItem = NewFeedItemClient(c.config)
+	c.FeedSubscription = NewFeedSubscriptionClient(c.config)
+	c.Invoice = NewInvoiceClient(c.config)
+	c.Job = NewJobClient(c.config)
+	c.LTILaunch = NewLTILaunchClient(c.config)
+	c.LTILoginState = NewLTILoginStateClient(c.config)
+	c.LTIPlatform = NewLTIPlatformClient(c.config)
+	c.LearnerActivity = NewLearnerActivityClient(c.config)
+	c.ModerationItem = NewModerationItemClient(c.config)
+	c.Notification = NewNotificationClient(c.config)
+	c.NotificationPreference = NewNotificationPreferenceClient(c.config)
+	c.OutboxMessage = NewOutboxMessageClient(c.config)
+	c.Plan = NewPlanClient(c.config)
+	c.PlaybackSession = NewPlaybackSessionClient(c.config)
+	c.Playlist = NewPlaylistClient(c.config)
+	c.PlaylistItem = NewPlaylistItemClient(c.config)
+	c.QuizItem = NewQuizItemClient(c.config)
+	c.ScheduledTask = NewScheduledTaskClient(c.config)
 	c.Series = NewSeriesClient(c.config)
+	c.ShadowingSubmission = NewShadowingSubmissionClient(c.config)
+	c.Subscription = NewSubscriptionClient(c.config)
+	c.TranscriptReplaceJob = NewTranscriptReplaceJobClient(c.config)
+	c.TranscriptRevision = NewTranscriptRevisionClient(c.config)
 	c.UploadSession = NewUploadSessionClient(c.config)
+	c.UsageRecord = NewUsageRecordClient(c.config)
+	c.UsageSnapshot = NewUsageSnapshotClient(c.config)
+	c.VocabularyWord = NewVocabularyWordClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
+	c.WebhookEndpoint = NewWebhookEndpointClient(c.config)
+	c.WebhookEvent = NewWebhookEventClient(c.config)
 }
 
 type (
@@ -140,12 +320,57 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		Asset:         NewAssetClient(cfg),
-		Episode:       NewEpisodeClient(cfg),
-		Series:        NewSeriesClient(cfg),
-		UploadSession: NewUploadSessionClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		APIKey:                 NewAPIKeyClient(cfg),
+		Asset:                  NewAssetClient(cfg),
+		AssetUsageDay:          NewAssetUsageDayClient(cfg),
+		AssetVersion:           NewAssetVersionClient(cfg),
+		AuditEntry:             NewAuditEntryClient(cfg),
+		AvailabilitySlot:       NewAvailabilitySlotClient(cfg),
+		Booking:                NewBookingClient(cfg),
+		Classroom:              NewClassroomClient(cfg),
+		ClassroomAssignment:    NewClassroomAssignmentClient(cfg),
+		ClassroomMember:        NewClassroomMemberClient(cfg),
+		ContentEmbedding:       NewContentEmbeddingClient(cfg),
+		ContentKey:             NewContentKeyClient(cfg),
+		ContentReassignment:    NewContentReassignmentClient(cfg),
+		DeviceToken:            NewDeviceTokenClient(cfg),
+		DictationAttempt:       NewDictationAttemptClient(cfg),
+		EngagementRollup:       NewEngagementRollupClient(cfg),
+		Episode:                NewEpisodeClient(cfg),
+		EpisodeTranscript:      NewEpisodeTranscriptClient(cfg),
+		Event:                  NewEventClient(cfg),
+		FeedItem:               NewFeedItemClient(cfg),
+		FeedSubscription:       NewFeedSubscriptionClient(cfg),
+		Invoice:                NewInvoiceClient(cfg),
+		Job:                    NewJobClient(cfg),
+		LTILaunch:              NewLTILaunchClient(cfg),
+		LTILoginState:          NewLTILoginStateClient(cfg),
+		LTIPlatform:            NewLTIPlatformClient(cfg),
+		LearnerActivity:        NewLearnerActivityClient(cfg),
+		ModerationItem:         NewModerationItemClient(cfg),
+		Notification:           NewNotificationClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		OutboxMessage:          NewOutboxMessageClient(cfg),
+		Plan:                   NewPlanClient(cfg),
+		PlaybackSession:        NewPlaybackSessionClient(cfg),
+		Playlist:               NewPlaylistClient(cfg),
+		PlaylistItem:           NewPlaylistItemClient(cfg),
+		QuizItem:               NewQuizItemClient(cfg),
+		ScheduledTask:          NewScheduledTaskClient(cfg),
+		Series:                 NewSeriesClient(cfg),
+		ShadowingSubmission:    NewShadowingSubmissionClient(cfg),
+		Subscription:           NewSubscriptionClient(cfg),
+		TranscriptReplaceJob:   NewTranscriptReplaceJobClient(cfg),
+		TranscriptRevision:     NewTranscriptRevisionClient(cfg),
+		UploadSession:          NewUploadSessionClient(cfg),
+		UsageRecord:            NewUsageRecordClient(cfg),
+		UsageSnapshot:          NewUsageSnapshotClient(cfg),
+		VocabularyWord:         NewVocabularyWordClient(cfg),
+		WebhookDelivery:        NewWebhookDeliveryClient(cfg),
+		WebhookEndpoint:        NewWebhookEndpointClient(cfg),
+		WebhookEvent:           NewWebhookEventClient(cfg),
 	}, nil
 }
 
@@ -163,19 +388,64 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		Asset:         NewAssetClient(cfg),
-		Episode:       NewEpisodeClient(cfg),
-		Series:        NewSeriesClient(cfg),
-		UploadSession: NewUploadSessionClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		APIKey:                 NewAPIKeyClient(cfg),
+		Asset:                  NewAssetClient(cfg),
+		AssetUsageDay:          NewAssetUsageDayClient(cfg),
+		AssetVersion:           NewAssetVersionClient(cfg),
+		AuditEntry:             NewAuditEntryClient(cfg),
+		AvailabilitySlot:       NewAvailabilitySlotClient(cfg),
+		Booking:                NewBookingClient(cfg),
+		Classroom:              NewClassroomClient(cfg),
+		ClassroomAssignment:    NewClassroomAssignmentClient(cfg),
+		ClassroomMember:        NewClassroomMemberClient(cfg),
+		ContentEmbedding:       NewContentEmbeddingClient(cfg),
+		ContentKey:             NewContentKeyClient(cfg),
+		ContentReassignment:    NewContentReassignmentClient(cfg),
+		DeviceToken:            NewDeviceTokenClient(cfg),
+		DictationAttempt:       NewDictationAttemptClient(cfg),
+		EngagementRollup:       NewEngagementRollupClient(cfg),
+		Episode:                NewEpisodeClient(cfg),
+		EpisodeTranscript:      NewEpisodeTranscriptClient(cfg),
+		Event:                  NewEventClient(cfg),
+		FeedItem:               NewFeedItemClient(cfg),
+		FeedSubscription:       NewFeedSubscriptionClient(cfg),
+		Invoice:                NewInvoiceClient(cfg),
+		Job:                    NewJobClient(cfg),
+		LTILaunch:              NewLTILaunchClient(cfg),
+		LTILoginState:          NewLTILoginStateClient(cfg),
+		LTIPlatform:            NewLTIPlatformClient(cfg),
+		LearnerActivity:        NewLearnerActivityClient(cfg),
+		ModerationItem:         NewModerationItemClient(cfg),
+		Notification:           NewNotificationClient(cfg),
+		NotificationPreference: NewNotificationPreferenceClient(cfg),
+		OutboxMessage:          NewOutboxMessageClient(cfg),
+		Plan:                   NewPlanClient(cfg),
+		PlaybackSession:        NewPlaybackSessionClient(cfg),
+		Playlist:               NewPlaylistClient(cfg),
+		PlaylistItem:           NewPlaylistItemClient(cfg),
+		QuizItem:               NewQuizItemClient(cfg),
+		ScheduledTask:          NewScheduledTaskClient(cfg),
+		Series:                 NewSeriesClient(cfg),
+		ShadowingSubmission:    NewShadowingSubmissionClient(cfg),
+		Subscription:           NewSubscriptionClient(cfg),
+		TranscriptReplaceJob:   NewTranscriptReplaceJobClient(cfg),
+		TranscriptRevision:     NewTranscriptRevisionClient(cfg),
+		UploadSession:          NewUploadSessionClient(cfg),
+		UsageRecord:            NewUsageRecordClient(cfg),
+		UsageSnapshot:          NewUsageSnapshotClient(cfg),
+		VocabularyWord:         NewVocabularyWordClient(cfg),
+		WebhookDelivery:        NewWebhookDeliveryClient(cfg),
+		WebhookEndpoint:        NewWebhookEndpointClient(cfg),
+		WebhookEvent:           NewWebhookEventClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Asset.
+//		APIKey.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -197,37 +467,285 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Asset.Use(hooks...)
-	c.Episode.Use(hooks...)
-	c.Series.Use(hooks...)
-	c.UploadSession.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.APIKey, c.Asset, c.AssetUsageDay, c.AssetVersion, c.AuditEntry,
+		c.AvailabilitySlot, c.Booking, c.Classroom, c.ClassroomAssignment,
+		c.ClassroomMember, c.ContentEmbedding, c.ContentKey, c.ContentReassignment,
+		c.DeviceToken, c.DictationAttempt, c.EngagementRollup, c.Episode,
+		c.EpisodeTranscript, c.Event, c.FeedItem, c.FeedSubscription, c.Invoice, c.Job,
+		c.LTILaunch, c.LTILoginState, c.LTIPlatform, c.LearnerActivity,
+		c.ModerationItem, c.Notification, c.NotificationPreference, c.OutboxMessage,
+		c.Plan, c.PlaybackSession, c.Playlist, c.PlaylistItem, c.QuizItem,
+		c.ScheduledTask, c.Series, c.ShadowingSubmission, c.Subscription,
+		c.TranscriptReplaceJob, c.TranscriptRevision, c.UploadSession, c.UsageRecord,
+		c.UsageSnapshot, c.VocabularyWord, c.WebhookDelivery, c.WebhookEndpoint,
+		c.WebhookEvent,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Asset.Intercept(interceptors...)
-	c.Episode.Intercept(interceptors...)
-	c.Series.Intercept(interceptors...)
-	c.UploadSession.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.APIKey, c.Asset, c.AssetUsageDay, c.AssetVersion, c.AuditEntry,
+		c.AvailabilitySlot, c.Booking, c.Classroom, c.ClassroomAssignment,
+		c.ClassroomMember, c.ContentEmbedding, c.ContentKey, c.ContentReassignment,
+		c.DeviceToken, c.DictationAttempt, c.EngagementRollup, c.Episode,
+		c.EpisodeTranscript, c.Event, c.FeedItem, c.FeedSubscription, c.Invoice, c.Job,
+		c.LTILaunch, c.LTILoginState, c.LTIPlatform, c.LearnerActivity,
+		c.ModerationItem, c.Notification, c.NotificationPreference, c.OutboxMessage,
+		c.Plan, c.PlaybackSession, c.Playlist, c.PlaylistItem, c.QuizItem,
+		c.ScheduledTask, c.Series, c.ShadowingSubmission, c.Subscription,
+		c.TranscriptReplaceJob, c.TranscriptRevision, c.UploadSession, c.UsageRecord,
+		c.UsageSnapshot, c.VocabularyWord, c.WebhookDelivery, c.WebhookEndpoint,
+		c.WebhookEvent,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APIKeyMutation:
+		return c.APIKey.mutate(ctx, m)
 	case *AssetMutation:
 		return c.Asset.mutate(ctx, m)
+	case *AssetUsageDayMutation:
+		return c.AssetUsageDay.mutate(ctx, m)
+	case *AssetVersionMutation:
+		return c.AssetVersion.mutate(ctx, m)
+	case *AuditEntryMutation:
+		return c.AuditEntry.mutate(ctx, m)
+	case *AvailabilitySlotMutation:
+		return c.AvailabilitySlot.mutate(ctx, m)
+	case *BookingMutation:
+		return c.Booking.mutate(ctx, m)
+	case *ClassroomMutation:
+		return c.Classroom.mutate(ctx, m)
+	case *ClassroomAssignmentMutation:
+		return c.ClassroomAssignment.mutate(ctx, m)
+	case *ClassroomMemberMutation:
+		return c.ClassroomMember.mutate(ctx, m)
+	case *ContentEmbeddingMutation:
+		return c.ContentEmbedding.mutate(ctx, m)
+	case *ContentKeyMutation:
+		return c.ContentKey.mutate(ctx, m)
+	case *ContentReassignmentMutation:
+		return c.ContentReassignment.mutate(ctx, m)
+	case *DeviceTokenMutation:
+		return c.DeviceToken.mutate(ctx, m)
+	case *DictationAttemptMutation:
+		return c.DictationAttempt.mutate(ctx, m)
+	case *EngagementRollupMutation:
+		return c.EngagementRollup.mutate(ctx, m)
 	case *EpisodeMutation:
 		return c.Episode.mutate(ctx, m)
+	case *EpisodeTranscriptMutation:
+		return c.EpisodeTranscript.mutate(ctx, m)
+	case *EventMutation:
+		return c.Event.mutate(ctx, m)
+	case *FeedItemMutation:
+		return c.FeedItem.mutate(ctx, m)
+	case *FeedSubscriptionMutation:
+		return c.FeedSubscription.mutate(ctx, m)
+	case *InvoiceMutation:
+		return c.Invoice.mutate(ctx, m)
+	case *JobMutation:
+		return c.Job.mutate(ctx, m)
+	case *LTILaunchMutation:
+		return c.LTILaunch.mutate(ctx, m)
+	case *LTILoginStateMutation:
+		return c.LTILoginState.mutate(ctx, m)
+	case *LTIPlatformMutation:
+		return c.LTIPlatform.mutate(ctx, m)
+	case *LearnerActivityMutation:
+		return c.LearnerActivity.mutate(ctx, m)
+	case *ModerationItemMutation:
+		return c.ModerationItem.mutate(ctx, m)
+	case *NotificationMutation:
+		return c.Notification.mutate(ctx, m)
+	case *NotificationPreferenceMutation:
+		return c.NotificationPreference.mutate(ctx, m)
+	case *OutboxMessageMutation:
+		return c.OutboxMessage.mutate(ctx, m)
+	case *PlanMutation:
+		return c.Plan.mutate(ctx, m)
+	case *PlaybackSessionMutation:
+		return c.PlaybackSession.mutate(ctx, m)
+	case *PlaylistMutation:
+		return c.Playlist.mutate(ctx, m)
+	case *PlaylistItemMutation:
+		return c.PlaylistItem.mutate(ctx, m)
+	case *QuizItemMutation:
+		return c.QuizItem.mutate(ctx, m)
+	case *ScheduledTaskMutation:
+		return c.ScheduledTask.mutate(ctx, m)
 	case *SeriesMutation:
 		return c.Series.mutate(ctx, m)
+	case *ShadowingSubmissionMutation:
+		return c.ShadowingSubmission.mutate(ctx, m)
+	case *SubscriptionMutation:
+		return c.Subscription.mutate(ctx, m)
+	case *TranscriptReplaceJobMutation:
+		return c.TranscriptReplaceJob.mutate(ctx, m)
+	case *TranscriptRevisionMutation:
+		return c.TranscriptRevision.mutate(ctx, m)
 	case *UploadSessionMutation:
 		return c.UploadSession.mutate(ctx, m)
+	case *UsageRecordMutation:
+		return c.UsageRecord.mutate(ctx, m)
+	case *UsageSnapshotMutation:
+		return c.UsageSnapshot.mutate(ctx, m)
+	case *VocabularyWordMutation:
+		return c.VocabularyWord.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
+	case *WebhookEndpointMutation:
+		return c.WebhookEndpoint.mutate(ctx, m)
+	case *WebhookEventMutation:
+		return c.WebhookEvent.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("generated: unknown mutation type %T", m)
 	}
 }
 
+// APIKeyClient is a client for the APIKey schema.
+type APIKeyClient struct {
+	config
+}
+
+// NewAPIKeyClient returns a client for the APIKey from the given config.
+func NewAPIKeyClient(c config) *APIKeyClient {
+	return &APIKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apikey.Hooks(f(g(h())))`.
+func (c *APIKeyClient) Use(hooks ...Hook) {
+	c.hooks.APIKey = append(c.hooks.APIKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apikey.Intercept(f(g(h())))`.
+func (c *APIKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIKey = append(c.inters.APIKey, interceptors...)
+}
+
+// Create returns a builder for creating a APIKey entity.
+func (c *APIKeyClient) Create() *APIKeyCreate {
+	mutation := newAPIKeyMutation(c.config, OpCreate)
+	return &APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of APIKey entities.
+func (c *APIKeyClient) CreateBulk(builders ...*APIKeyCreate) *APIKeyCreateBulk {
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *APIKeyClient) MapCreateBulk(slice any, setFunc func(*APIKeyCreate, int)) *APIKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &APIKeyCreateBulk{err: fmt.Errorf("calling to APIKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*APIKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for APIKey.
+func (c *APIKeyClient) Update() *APIKeyUpdate {
+	mutation := newAPIKeyMutation(c.config, OpUpdate)
+	return &APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *APIKeyClient) UpdateOne(_m *APIKey) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKey(_m))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *APIKeyClient) UpdateOneID(id uuid.UUID) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKeyID(id))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for APIKey.
+func (c *APIKeyClient) Delete() *APIKeyDelete {
+	mutation := newAPIKeyMutation(c.config, OpDelete)
+	return &APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *APIKeyClient) DeleteOne(_m *APIKey) *APIKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *APIKeyClient) DeleteOneID(id uuid.UUID) *APIKeyDeleteOne {
+	builder := c.Delete().Where(apikey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &APIKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for APIKey.
+func (c *APIKeyClient) Query() *APIKeyQuery {
+	return &APIKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAPIKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a APIKey entity by its id.
+func (c *APIKeyClient) Get(ctx context.Context, id uuid.UUID) (*APIKey, error) {
+	return c.Query().Where(apikey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *APIKeyClient) GetX(ctx context.Context, id uuid.UUID) *APIKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *APIKeyClient) Hooks() []Hook {
+	hooks := c.hooks.APIKey
+	return append(hooks[:len(hooks):len(hooks)], apikey.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *APIKeyClient) Interceptors() []Interceptor {
+	return c.inters.APIKey
+}
+
+func (c *APIKeyClient) mutate(ctx context.Context, m *APIKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown APIKey mutation op: %q", m.Op())
+	}
+}
+
 // AssetClient is a client for the Asset schema.
 type AssetClient struct {
 	config
@@ -338,12 +856,14 @@ func (c *AssetClient) GetX(ctx context.Context, id uuid.UUID) *Asset {
 
 // Hooks returns the client hooks.
 func (c *AssetClient) Hooks() []Hook {
-	return c.hooks.Asset
+	hooks := c.hooks.Asset
+	return append(hooks[:len(hooks):len(hooks)], asset.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *AssetClient) Interceptors() []Interceptor {
-	return c.inters.Asset
+	inters := c.inters.Asset
+	return append(inters[:len(inters):len(inters)], asset.Interceptors[:]...)
 }
 
 func (c *AssetClient) mutate(ctx context.Context, m *AssetMutation) (Value, error) {
@@ -361,107 +881,107 @@ func (c *AssetClient) mutate(ctx context.Context, m *AssetMutation) (Value, erro
 	}
 }
 
-// EpisodeClient is a client for the Episode schema.
-type EpisodeClient struct {
+// AssetUsageDayClient is a client for the AssetUsageDay schema.
+type AssetUsageDayClient struct {
 	config
 }
 
-// NewEpisodeClient returns a client for the Episode from the given config.
-func NewEpisodeClient(c config) *EpisodeClient {
-	return &EpisodeClient{config: c}
+// NewAssetUsageDayClient returns a client for the AssetUsageDay from the given config.
+func NewAssetUsageDayClient(c config) *AssetUsageDayClient {
+	return &AssetUsageDayClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `episode.Hooks(f(g(h())))`.
-func (c *EpisodeClient) Use(hooks ...Hook) {
-	c.hooks.Episode = append(c.hooks.Episode, hooks...)
+// A call to `Use(f, g, h)` equals to `assetusageday.Hooks(f(g(h())))`.
+func (c *AssetUsageDayClient) Use(hooks ...Hook) {
+	c.hooks.AssetUsageDay = append(c.hooks.AssetUsageDay, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `episode.Intercept(f(g(h())))`.
-func (c *EpisodeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Episode = append(c.inters.Episode, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `assetusageday.Intercept(f(g(h())))`.
+func (c *AssetUsageDayClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AssetUsageDay = append(c.inters.AssetUsageDay, interceptors...)
 }
 
-// Create returns a builder for creating a Episode entity.
-func (c *EpisodeClient) Create() *EpisodeCreate {
-	mutation := newEpisodeMutation(c.config, OpCreate)
-	return &EpisodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AssetUsageDay entity.
+func (c *AssetUsageDayClient) Create() *AssetUsageDayCreate {
+	mutation := newAssetUsageDayMutation(c.config, OpCreate)
+	return &AssetUsageDayCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Episode entities.
-func (c *EpisodeClient) CreateBulk(builders ...*EpisodeCreate) *EpisodeCreateBulk {
-	return &EpisodeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AssetUsageDay entities.
+func (c *AssetUsageDayClient) CreateBulk(builders ...*AssetUsageDayCreate) *AssetUsageDayCreateBulk {
+	return &AssetUsageDayCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EpisodeClient) MapCreateBulk(slice any, setFunc func(*EpisodeCreate, int)) *EpisodeCreateBulk {
+func (c *AssetUsageDayClient) MapCreateBulk(slice any, setFunc func(*AssetUsageDayCreate, int)) *AssetUsageDayCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EpisodeCreateBulk{err: fmt.Errorf("calling to EpisodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AssetUsageDayCreateBulk{err: fmt.Errorf("calling to AssetUsageDayClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EpisodeCreate, rv.Len())
+	builders := make([]*AssetUsageDayCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EpisodeCreateBulk{config: c.config, builders: builders}
+	return &AssetUsageDayCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Episode.
-func (c *EpisodeClient) Update() *EpisodeUpdate {
-	mutation := newEpisodeMutation(c.config, OpUpdate)
-	return &EpisodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AssetUsageDay.
+func (c *AssetUsageDayClient) Update() *AssetUsageDayUpdate {
+	mutation := newAssetUsageDayMutation(c.config, OpUpdate)
+	return &AssetUsageDayUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EpisodeClient) UpdateOne(_m *Episode) *EpisodeUpdateOne {
-	mutation := newEpisodeMutation(c.config, OpUpdateOne, withEpisode(_m))
-	return &EpisodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AssetUsageDayClient) UpdateOne(_m *AssetUsageDay) *AssetUsageDayUpdateOne {
+	mutation := newAssetUsageDayMutation(c.config, OpUpdateOne, withAssetUsageDay(_m))
+	return &AssetUsageDayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EpisodeClient) UpdateOneID(id uuid.UUID) *EpisodeUpdateOne {
-	mutation := newEpisodeMutation(c.config, OpUpdateOne, withEpisodeID(id))
-	return &EpisodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AssetUsageDayClient) UpdateOneID(id uuid.UUID) *AssetUsageDayUpdateOne {
+	mutation := newAssetUsageDayMutation(c.config, OpUpdateOne, withAssetUsageDayID(id))
+	return &AssetUsageDayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Episode.
-func (c *EpisodeClient) Delete() *EpisodeDelete {
-	mutation := newEpisodeMutation(c.config, OpDelete)
-	return &EpisodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AssetUsageDay.
+func (c *AssetUsageDayClient) Delete() *AssetUsageDayDelete {
+	mutation := newAssetUsageDayMutation(c.config, OpDelete)
+	return &AssetUsageDayDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EpisodeClient) DeleteOne(_m *Episode) *EpisodeDeleteOne {
+func (c *AssetUsageDayClient) DeleteOne(_m *AssetUsageDay) *AssetUsageDayDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EpisodeClient) DeleteOneID(id uuid.UUID) *EpisodeDeleteOne {
-	builder := c.Delete().Where(episode.ID(id))
+func (c *AssetUsageDayClient) DeleteOneID(id uuid.UUID) *AssetUsageDayDeleteOne {
+	builder := c.Delete().Where(assetusageday.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EpisodeDeleteOne{builder}
+	return &AssetUsageDayDeleteOne{builder}
 }
 
-// Query returns a query builder for Episode.
-func (c *EpisodeClient) Query() *EpisodeQuery {
-	return &EpisodeQuery{
+// Query returns a query builder for AssetUsageDay.
+func (c *AssetUsageDayClient) Query() *AssetUsageDayQuery {
+	return &AssetUsageDayQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEpisode},
+		ctx:    &QueryContext{Type: TypeAssetUsageDay},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Episode entity by its id.
-func (c *EpisodeClient) Get(ctx context.Context, id uuid.UUID) (*Episode, error) {
-	return c.Query().Where(episode.ID(id)).Only(ctx)
+// Get returns a AssetUsageDay entity by its id.
+func (c *AssetUsageDayClient) Get(ctx context.Context, id uuid.UUID) (*AssetUsageDay, error) {
+	return c.Query().Where(assetusageday.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EpisodeClient) GetX(ctx context.Context, id uuid.UUID) *Episode {
+func (c *AssetUsageDayClient) GetX(ctx context.Context, id uuid.UUID) *AssetUsageDay {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -469,148 +989,132 @@ func (c *EpisodeClient) GetX(ctx context.Context, id uuid.UUID) *Episode {
 	return obj
 }
 
-// QuerySeries queries the series edge of a Episode.
-func (c *EpisodeClient) QuerySeries(_m *Episode) *SeriesQuery {
-	query := (&SeriesClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(episode.Table, episode.FieldID, id),
-			sqlgraph.To(series.Table, series.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, episode.SeriesTable, episode.SeriesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *EpisodeClient) Hooks() []Hook {
-	return c.hooks.Episode
+func (c *AssetUsageDayClient) Hooks() []Hook {
+	return c.hooks.AssetUsageDay
 }
 
 // Interceptors returns the client interceptors.
-func (c *EpisodeClient) Interceptors() []Interceptor {
-	return c.inters.Episode
+func (c *AssetUsageDayClient) Interceptors() []Interceptor {
+	return c.inters.AssetUsageDay
 }
 
-func (c *EpisodeClient) mutate(ctx context.Context, m *EpisodeMutation) (Value, error) {
+func (c *AssetUsageDayClient) mutate(ctx context.Context, m *AssetUsageDayMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EpisodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AssetUsageDayCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EpisodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AssetUsageDayUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EpisodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AssetUsageDayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EpisodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AssetUsageDayDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown Episode mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown AssetUsageDay mutation op: %q", m.Op())
 	}
 }
 
-// SeriesClient is a client for the Series schema.
-type SeriesClient struct {
+// AssetVersionClient is a client for the AssetVersion schema.
+type AssetVersionClient struct {
 	config
 }
 
-// NewSeriesClient returns a client for the Series from the given config.
-func NewSeriesClient(c config) *SeriesClient {
-	return &SeriesClient{config: c}
+// NewAssetVersionClient returns a client for the AssetVersion from the given config.
+func NewAssetVersionClient(c config) *AssetVersionClient {
+	return &AssetVersionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `series.Hooks(f(g(h())))`.
-func (c *SeriesClient) Use(hooks ...Hook) {
-	c.hooks.Series = append(c.hooks.Series, hooks...)
+// A call to `Use(f, g, h)` equals to `assetversion.Hooks(f(g(h())))`.
+func (c *AssetVersionClient) Use(hooks ...Hook) {
+	c.hooks.AssetVersion = append(c.hooks.AssetVersion, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `series.Intercept(f(g(h())))`.
-func (c *SeriesClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Series = append(c.inters.Series, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `assetversion.Intercept(f(g(h())))`.
+func (c *AssetVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AssetVersion = append(c.inters.AssetVersion, interceptors...)
 }
 
-// Create returns a builder for creating a Series entity.
-func (c *SeriesClient) Create() *SeriesCreate {
-	mutation := newSeriesMutation(c.config, OpCreate)
-	return &SeriesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AssetVersion entity.
+func (c *AssetVersionClient) Create() *AssetVersionCreate {
+	mutation := newAssetVersionMutation(c.config, OpCreate)
+	return &AssetVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Series entities.
-func (c *SeriesClient) CreateBulk(builders ...*SeriesCreate) *SeriesCreateBulk {
-	return &SeriesCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AssetVersion entities.
+func (c *AssetVersionClient) CreateBulk(builders ...*AssetVersionCreate) *AssetVersionCreateBulk {
+	return &AssetVersionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SeriesClient) MapCreateBulk(slice any, setFunc func(*SeriesCreate, int)) *SeriesCreateBulk {
+func (c *AssetVersionClient) MapCreateBulk(slice any, setFunc func(*AssetVersionCreate, int)) *AssetVersionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SeriesCreateBulk{err: fmt.Errorf("calling to SeriesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AssetVersionCreateBulk{err: fmt.Errorf("calling to AssetVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SeriesCreate, rv.Len())
+	builders := make([]*AssetVersionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SeriesCreateBulk{config: c.config, builders: builders}
+	return &AssetVersionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Series.
-func (c *SeriesClient) Update() *SeriesUpdate {
-	mutation := newSeriesMutation(c.config, OpUpdate)
-	return &SeriesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AssetVersion.
+func (c *AssetVersionClient) Update() *AssetVersionUpdate {
+	mutation := newAssetVersionMutation(c.config, OpUpdate)
+	return &AssetVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SeriesClient) UpdateOne(_m *Series) *SeriesUpdateOne {
-	mutation := newSeriesMutation(c.config, OpUpdateOne, withSeries(_m))
-	return &SeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AssetVersionClient) UpdateOne(_m *AssetVersion) *AssetVersionUpdateOne {
+	mutation := newAssetVersionMutation(c.config, OpUpdateOne, withAssetVersion(_m))
+	return &AssetVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SeriesClient) UpdateOneID(id uuid.UUID) *SeriesUpdateOne {
-	mutation := newSeriesMutation(c.config, OpUpdateOne, withSeriesID(id))
-	return &SeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AssetVersionClient) UpdateOneID(id uuid.UUID) *AssetVersionUpdateOne {
+	mutation := newAssetVersionMutation(c.config, OpUpdateOne, withAssetVersionID(id))
+	return &AssetVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Series.
-func (c *SeriesClient) Delete() *SeriesDelete {
-	mutation := newSeriesMutation(c.config, OpDelete)
-	return &SeriesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AssetVersion.
+func (c *AssetVersionClient) Delete() *AssetVersionDelete {
+	mutation := newAssetVersionMutation(c.config, OpDelete)
+	return &AssetVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SeriesClient) DeleteOne(_m *Series) *SeriesDeleteOne {
+func (c *AssetVersionClient) DeleteOne(_m *AssetVersion) *AssetVersionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SeriesClient) DeleteOneID(id uuid.UUID) *SeriesDeleteOne {
-	builder := c.Delete().Where(series.ID(id))
+func (c *AssetVersionClient) DeleteOneID(id uuid.UUID) *AssetVersionDeleteOne {
+	builder := c.Delete().Where(assetversion.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SeriesDeleteOne{builder}
+	return &AssetVersionDeleteOne{builder}
 }
 
-// Query returns a query builder for Series.
-func (c *SeriesClient) Query() *SeriesQuery {
-	return &SeriesQuery{
+// Query returns a query builder for AssetVersion.
+func (c *AssetVersionClient) Query() *AssetVersionQuery {
+	return &AssetVersionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSeries},
+		ctx:    &QueryContext{Type: TypeAssetVersion},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Series entity by its id.
-func (c *SeriesClient) Get(ctx context.Context, id uuid.UUID) (*Series, error) {
-	return c.Query().Where(series.ID(id)).Only(ctx)
+// Get returns a AssetVersion entity by its id.
+func (c *AssetVersionClient) Get(ctx context.Context, id uuid.UUID) (*AssetVersion, error) {
+	return c.Query().Where(assetversion.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SeriesClient) GetX(ctx context.Context, id uuid.UUID) *Series {
+func (c *AssetVersionClient) GetX(ctx context.Context, id uuid.UUID) *AssetVersion {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -618,148 +1122,6012 @@ func (c *SeriesClient) GetX(ctx context.Context, id uuid.UUID) *Series {
 	return obj
 }
 
-// QueryEpisodes queries the episodes edge of a Series.
-func (c *SeriesClient) QueryEpisodes(_m *Series) *EpisodeQuery {
-	query := (&EpisodeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(series.Table, series.FieldID, id),
-			sqlgraph.To(episode.Table, episode.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, series.EpisodesTable, series.EpisodesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *SeriesClient) Hooks() []Hook {
-	return c.hooks.Series
+func (c *AssetVersionClient) Hooks() []Hook {
+	hooks := c.hooks.AssetVersion
+	return append(hooks[:len(hooks):len(hooks)], assetversion.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *SeriesClient) Interceptors() []Interceptor {
-	return c.inters.Series
+func (c *AssetVersionClient) Interceptors() []Interceptor {
+	return c.inters.AssetVersion
 }
 
-func (c *SeriesClient) mutate(ctx context.Context, m *SeriesMutation) (Value, error) {
+func (c *AssetVersionClient) mutate(ctx context.Context, m *AssetVersionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SeriesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AssetVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SeriesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AssetVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AssetVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SeriesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AssetVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown Series mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown AssetVersion mutation op: %q", m.Op())
 	}
 }
 
-// UploadSessionClient is a client for the UploadSession schema.
-type UploadSessionClient struct {
+// AuditEntryClient is a client for the AuditEntry schema.
+type AuditEntryClient struct {
 	config
 }
 
-// NewUploadSessionClient returns a client for the UploadSession from the given config.
-func NewUploadSessionClient(c config) *UploadSessionClient {
-	return &UploadSessionClient{config: c}
+// NewAuditEntryClient returns a client for the AuditEntry from the given config.
+func NewAuditEntryClient(c config) *AuditEntryClient {
+	return &AuditEntryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `uploadsession.Hooks(f(g(h())))`.
-func (c *UploadSessionClient) Use(hooks ...Hook) {
-	c.hooks.UploadSession = append(c.hooks.UploadSession, hooks...)
+// A call to `Use(f, g, h)` equals to `auditentry.Hooks(f(g(h())))`.
+func (c *AuditEntryClient) Use(hooks ...Hook) {
+	c.hooks.AuditEntry = append(c.hooks.AuditEntry, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `uploadsession.Intercept(f(g(h())))`.
-func (c *UploadSessionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.UploadSession = append(c.inters.UploadSession, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `auditentry.Intercept(f(g(h())))`.
+func (c *AuditEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditEntry = append(c.inters.AuditEntry, interceptors...)
 }
 
-// Create returns a builder for creating a UploadSession entity.
-func (c *UploadSessionClient) Create() *UploadSessionCreate {
-	mutation := newUploadSessionMutation(c.config, OpCreate)
-	return &UploadSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AuditEntry entity.
+func (c *AuditEntryClient) Create() *AuditEntryCreate {
+	mutation := newAuditEntryMutation(c.config, OpCreate)
+	return &AuditEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of UploadSession entities.
-func (c *UploadSessionClient) CreateBulk(builders ...*UploadSessionCreate) *UploadSessionCreateBulk {
-	return &UploadSessionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AuditEntry entities.
+func (c *AuditEntryClient) CreateBulk(builders ...*AuditEntryCreate) *AuditEntryCreateBulk {
+	return &AuditEntryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UploadSessionClient) MapCreateBulk(slice any, setFunc func(*UploadSessionCreate, int)) *UploadSessionCreateBulk {
+func (c *AuditEntryClient) MapCreateBulk(slice any, setFunc func(*AuditEntryCreate, int)) *AuditEntryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UploadSessionCreateBulk{err: fmt.Errorf("calling to UploadSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AuditEntryCreateBulk{err: fmt.Errorf("calling to AuditEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UploadSessionCreate, rv.Len())
+	builders := make([]*AuditEntryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UploadSessionCreateBulk{config: c.config, builders: builders}
+	return &AuditEntryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for UploadSession.
-func (c *UploadSessionClient) Update() *UploadSessionUpdate {
-	mutation := newUploadSessionMutation(c.config, OpUpdate)
-	return &UploadSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AuditEntry.
+func (c *AuditEntryClient) Update() *AuditEntryUpdate {
+	mutation := newAuditEntryMutation(c.config, OpUpdate)
+	return &AuditEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UploadSessionClient) UpdateOne(_m *UploadSession) *UploadSessionUpdateOne {
-	mutation := newUploadSessionMutation(c.config, OpUpdateOne, withUploadSession(_m))
-	return &UploadSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditEntryClient) UpdateOne(_m *AuditEntry) *AuditEntryUpdateOne {
+	mutation := newAuditEntryMutation(c.config, OpUpdateOne, withAuditEntry(_m))
+	return &AuditEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UploadSessionClient) UpdateOneID(id uuid.UUID) *UploadSessionUpdateOne {
-	mutation := newUploadSessionMutation(c.config, OpUpdateOne, withUploadSessionID(id))
-	return &UploadSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditEntryClient) UpdateOneID(id uuid.UUID) *AuditEntryUpdateOne {
+	mutation := newAuditEntryMutation(c.config, OpUpdateOne, withAuditEntryID(id))
+	return &AuditEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for UploadSession.
-func (c *UploadSessionClient) Delete() *UploadSessionDelete {
-	mutation := newUploadSessionMutation(c.config, OpDelete)
+// Delete returns a delete builder for AuditEntry.
+func (c *AuditEntryClient) Delete() *AuditEntryDelete {
+	mutation := newAuditEntryMutation(c.config, OpDelete)
+	return &AuditEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditEntryClient) DeleteOne(_m *AuditEntry) *AuditEntryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditEntryClient) DeleteOneID(id uuid.UUID) *AuditEntryDeleteOne {
+	builder := c.Delete().Where(auditentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditEntry.
+func (c *AuditEntryClient) Query() *AuditEntryQuery {
+	return &AuditEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditEntry entity by its id.
+func (c *AuditEntryClient) Get(ctx context.Context, id uuid.UUID) (*AuditEntry, error) {
+	return c.Query().Where(auditentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditEntryClient) GetX(ctx context.Context, id uuid.UUID) *AuditEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditEntryClient) Hooks() []Hook {
+	hooks := c.hooks.AuditEntry
+	return append(hooks[:len(hooks):len(hooks)], auditentry.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditEntryClient) Interceptors() []Interceptor {
+	return c.inters.AuditEntry
+}
+
+func (c *AuditEntryClient) mutate(ctx context.Context, m *AuditEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown AuditEntry mutation op: %q", m.Op())
+	}
+}
+
+// AvailabilitySlotClient is a client for the AvailabilitySlot schema.
+type AvailabilitySlotClient struct {
+	config
+}
+
+// NewAvailabilitySlotClient returns a client for the AvailabilitySlot from the given config.
+func NewAvailabilitySlotClient(c config) *AvailabilitySlotClient {
+	return &AvailabilitySlotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `availabilityslot.Hooks(f(g(h())))`.
+func (c *AvailabilitySlotClient) Use(hooks ...Hook) {
+	c.hooks.AvailabilitySlot = append(c.hooks.AvailabilitySlot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `availabilityslot.Intercept(f(g(h())))`.
+func (c *AvailabilitySlotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AvailabilitySlot = append(c.inters.AvailabilitySlot, interceptors...)
+}
+
+// Create returns a builder for creating a AvailabilitySlot entity.
+func (c *AvailabilitySlotClient) Create() *AvailabilitySlotCreate {
+	mutation := newAvailabilitySlotMutation(c.config, OpCreate)
+	return &AvailabilitySlotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AvailabilitySlot entities.
+func (c *AvailabilitySlotClient) CreateBulk(builders ...*AvailabilitySlotCreate) *AvailabilitySlotCreateBulk {
+	return &AvailabilitySlotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AvailabilitySlotClient) MapCreateBulk(slice any, setFunc func(*AvailabilitySlotCreate, int)) *AvailabilitySlotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AvailabilitySlotCreateBulk{err: fmt.Errorf("calling to AvailabilitySlotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AvailabilitySlotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AvailabilitySlotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AvailabilitySlot.
+func (c *AvailabilitySlotClient) Update() *AvailabilitySlotUpdate {
+	mutation := newAvailabilitySlotMutation(c.config, OpUpdate)
+	return &AvailabilitySlotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AvailabilitySlotClient) UpdateOne(_m *AvailabilitySlot) *AvailabilitySlotUpdateOne {
+	mutation := newAvailabilitySlotMutation(c.config, OpUpdateOne, withAvailabilitySlot(_m))
+	return &AvailabilitySlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AvailabilitySlotClient) UpdateOneID(id uuid.UUID) *AvailabilitySlotUpdateOne {
+	mutation := newAvailabilitySlotMutation(c.config, OpUpdateOne, withAvailabilitySlotID(id))
+	return &AvailabilitySlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AvailabilitySlot.
+func (c *AvailabilitySlotClient) Delete() *AvailabilitySlotDelete {
+	mutation := newAvailabilitySlotMutation(c.config, OpDelete)
+	return &AvailabilitySlotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AvailabilitySlotClient) DeleteOne(_m *AvailabilitySlot) *AvailabilitySlotDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AvailabilitySlotClient) DeleteOneID(id uuid.UUID) *AvailabilitySlotDeleteOne {
+	builder := c.Delete().Where(availabilityslot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AvailabilitySlotDeleteOne{builder}
+}
+
+// Query returns a query builder for AvailabilitySlot.
+func (c *AvailabilitySlotClient) Query() *AvailabilitySlotQuery {
+	return &AvailabilitySlotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAvailabilitySlot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AvailabilitySlot entity by its id.
+func (c *AvailabilitySlotClient) Get(ctx context.Context, id uuid.UUID) (*AvailabilitySlot, error) {
+	return c.Query().Where(availabilityslot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AvailabilitySlotClient) GetX(ctx context.Context, id uuid.UUID) *AvailabilitySlot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBookings queries the bookings edge of a AvailabilitySlot.
+func (c *AvailabilitySlotClient) QueryBookings(_m *AvailabilitySlot) *BookingQuery {
+	query := (&BookingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(availabilityslot.Table, availabilityslot.FieldID, id),
+			sqlgraph.To(booking.Table, booking.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, availabilityslot.BookingsTable, availabilityslot.BookingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AvailabilitySlotClient) Hooks() []Hook {
+	hooks := c.hooks.AvailabilitySlot
+	return append(hooks[:len(hooks):len(hooks)], availabilityslot.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AvailabilitySlotClient) Interceptors() []Interceptor {
+	return c.inters.AvailabilitySlot
+}
+
+func (c *AvailabilitySlotClient) mutate(ctx context.Context, m *AvailabilitySlotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AvailabilitySlotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AvailabilitySlotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AvailabilitySlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AvailabilitySlotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown AvailabilitySlot mutation op: %q", m.Op())
+	}
+}
+
+// BookingClient is a client for the Booking schema.
+type BookingClient struct {
+	config
+}
+
+// NewBookingClient returns a client for the Booking from the given config.
+func NewBookingClient(c config) *BookingClient {
+	return &BookingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `booking.Hooks(f(g(h())))`.
+func (c *BookingClient) Use(hooks ...Hook) {
+	c.hooks.Booking = append(c.hooks.Booking, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `booking.Intercept(f(g(h())))`.
+func (c *BookingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Booking = append(c.inters.Booking, interceptors...)
+}
+
+// Create returns a builder for creating a Booking entity.
+func (c *BookingClient) Create() *BookingCreate {
+	mutation := newBookingMutation(c.config, OpCreate)
+	return &BookingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Booking entities.
+func (c *BookingClient) CreateBulk(builders ...*BookingCreate) *BookingCreateBulk {
+	return &BookingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BookingClient) MapCreateBulk(slice any, setFunc func(*BookingCreate, int)) *BookingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BookingCreateBulk{err: fmt.Errorf("calling to BookingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BookingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BookingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Booking.
+func (c *BookingClient) Update() *BookingUpdate {
+	mutation := newBookingMutation(c.config, OpUpdate)
+	return &BookingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BookingClient) UpdateOne(_m *Booking) *BookingUpdateOne {
+	mutation := newBookingMutation(c.config, OpUpdateOne, withBooking(_m))
+	return &BookingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BookingClient) UpdateOneID(id uuid.UUID) *BookingUpdateOne {
+	mutation := newBookingMutation(c.config, OpUpdateOne, withBookingID(id))
+	return &BookingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Booking.
+func (c *BookingClient) Delete() *BookingDelete {
+	mutation := newBookingMutation(c.config, OpDelete)
+	return &BookingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BookingClient) DeleteOne(_m *Booking) *BookingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BookingClient) DeleteOneID(id uuid.UUID) *BookingDeleteOne {
+	builder := c.Delete().Where(booking.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BookingDeleteOne{builder}
+}
+
+// Query returns a query builder for Booking.
+func (c *BookingClient) Query() *BookingQuery {
+	return &BookingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBooking},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Booking entity by its id.
+func (c *BookingClient) Get(ctx context.Context, id uuid.UUID) (*Booking, error) {
+	return c.Query().Where(booking.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BookingClient) GetX(ctx context.Context, id uuid.UUID) *Booking {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySlot queries the slot edge of a Booking.
+func (c *BookingClient) QuerySlot(_m *Booking) *AvailabilitySlotQuery {
+	query := (&AvailabilitySlotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(booking.Table, booking.FieldID, id),
+			sqlgraph.To(availabilityslot.Table, availabilityslot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, booking.SlotTable, booking.SlotColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BookingClient) Hooks() []Hook {
+	hooks := c.hooks.Booking
+	return append(hooks[:len(hooks):len(hooks)], booking.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *BookingClient) Interceptors() []Interceptor {
+	return c.inters.Booking
+}
+
+func (c *BookingClient) mutate(ctx context.Context, m *BookingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BookingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BookingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BookingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BookingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Booking mutation op: %q", m.Op())
+	}
+}
+
+// ClassroomClient is a client for the Classroom schema.
+type ClassroomClient struct {
+	config
+}
+
+// NewClassroomClient returns a client for the Classroom from the given config.
+func NewClassroomClient(c config) *ClassroomClient {
+	return &ClassroomClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `classroom.Hooks(f(g(h())))`.
+func (c *ClassroomClient) Use(hooks ...Hook) {
+	c.hooks.Classroom = append(c.hooks.Classroom, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `classroom.Intercept(f(g(h())))`.
+func (c *ClassroomClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Classroom = append(c.inters.Classroom, interceptors...)
+}
+
+// Create returns a builder for creating a Classroom entity.
+func (c *ClassroomClient) Create() *ClassroomCreate {
+	mutation := newClassroomMutation(c.config, OpCreate)
+	return &ClassroomCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Classroom entities.
+func (c *ClassroomClient) CreateBulk(builders ...*ClassroomCreate) *ClassroomCreateBulk {
+	return &ClassroomCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ClassroomClient) MapCreateBulk(slice any, setFunc func(*ClassroomCreate, int)) *ClassroomCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ClassroomCreateBulk{err: fmt.Errorf("calling to ClassroomClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ClassroomCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ClassroomCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Classroom.
+func (c *ClassroomClient) Update() *ClassroomUpdate {
+	mutation := newClassroomMutation(c.config, OpUpdate)
+	return &ClassroomUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ClassroomClient) UpdateOne(_m *Classroom) *ClassroomUpdateOne {
+	mutation := newClassroomMutation(c.config, OpUpdateOne, withClassroom(_m))
+	return &ClassroomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ClassroomClient) UpdateOneID(id uuid.UUID) *ClassroomUpdateOne {
+	mutation := newClassroomMutation(c.config, OpUpdateOne, withClassroomID(id))
+	return &ClassroomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Classroom.
+func (c *ClassroomClient) Delete() *ClassroomDelete {
+	mutation := newClassroomMutation(c.config, OpDelete)
+	return &ClassroomDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ClassroomClient) DeleteOne(_m *Classroom) *ClassroomDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ClassroomClient) DeleteOneID(id uuid.UUID) *ClassroomDeleteOne {
+	builder := c.Delete().Where(classroom.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ClassroomDeleteOne{builder}
+}
+
+// Query returns a query builder for Classroom.
+func (c *ClassroomClient) Query() *ClassroomQuery {
+	return &ClassroomQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeClassroom},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Classroom entity by its id.
+func (c *ClassroomClient) Get(ctx context.Context, id uuid.UUID) (*Classroom, error) {
+	return c.Query().Where(classroom.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ClassroomClient) GetX(ctx context.Context, id uuid.UUID) *Classroom {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMembers queries the members edge of a Classroom.
+func (c *ClassroomClient) QueryMembers(_m *Classroom) *ClassroomMemberQuery {
+	query := (&ClassroomMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(classroom.Table, classroom.FieldID, id),
+			sqlgraph.To(classroommember.Table, classroommember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, classroom.MembersTable, classroom.MembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssignments queries the assignments edge of a Classroom.
+func (c *ClassroomClient) QueryAssignments(_m *Classroom) *ClassroomAssignmentQuery {
+	query := (&ClassroomAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(classroom.Table, classroom.FieldID, id),
+			sqlgraph.To(classroomassignment.Table, classroomassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, classroom.AssignmentsTable, classroom.AssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ClassroomClient) Hooks() []Hook {
+	hooks := c.hooks.Classroom
+	return append(hooks[:len(hooks):len(hooks)], classroom.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ClassroomClient) Interceptors() []Interceptor {
+	return c.inters.Classroom
+}
+
+func (c *ClassroomClient) mutate(ctx context.Context, m *ClassroomMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ClassroomCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ClassroomUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ClassroomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ClassroomDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Classroom mutation op: %q", m.Op())
+	}
+}
+
+// ClassroomAssignmentClient is a client for the ClassroomAssignment schema.
+type ClassroomAssignmentClient struct {
+	config
+}
+
+// NewClassroomAssignmentClient returns a client for the ClassroomAssignment from the given config.
+func NewClassroomAssignmentClient(c config) *ClassroomAssignmentClient {
+	return &ClassroomAssignmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `classroomassignment.Hooks(f(g(h())))`.
+func (c *ClassroomAssignmentClient) Use(hooks ...Hook) {
+	c.hooks.ClassroomAssignment = append(c.hooks.ClassroomAssignment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `classroomassignment.Intercept(f(g(h())))`.
+func (c *ClassroomAssignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ClassroomAssignment = append(c.inters.ClassroomAssignment, interceptors...)
+}
+
+// Create returns a builder for creating a ClassroomAssignment entity.
+func (c *ClassroomAssignmentClient) Create() *ClassroomAssignmentCreate {
+	mutation := newClassroomAssignmentMutation(c.config, OpCreate)
+	return &ClassroomAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ClassroomAssignment entities.
+func (c *ClassroomAssignmentClient) CreateBulk(builders ...*ClassroomAssignmentCreate) *ClassroomAssignmentCreateBulk {
+	return &ClassroomAssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ClassroomAssignmentClient) MapCreateBulk(slice any, setFunc func(*ClassroomAssignmentCreate, int)) *ClassroomAssignmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ClassroomAssignmentCreateBulk{err: fmt.Errorf("calling to ClassroomAssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ClassroomAssignmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ClassroomAssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ClassroomAssignment.
+func (c *ClassroomAssignmentClient) Update() *ClassroomAssignmentUpdate {
+	mutation := newClassroomAssignmentMutation(c.config, OpUpdate)
+	return &ClassroomAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ClassroomAssignmentClient) UpdateOne(_m *ClassroomAssignment) *ClassroomAssignmentUpdateOne {
+	mutation := newClassroomAssignmentMutation(c.config, OpUpdateOne, withClassroomAssignment(_m))
+	return &ClassroomAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ClassroomAssignmentClient) UpdateOneID(id uuid.UUID) *ClassroomAssignmentUpdateOne {
+	mutation := newClassroomAssignmentMutation(c.config, OpUpdateOne, withClassroomAssignmentID(id))
+	return &ClassroomAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ClassroomAssignment.
+func (c *ClassroomAssignmentClient) Delete() *ClassroomAssignmentDelete {
+	mutation := newClassroomAssignmentMutation(c.config, OpDelete)
+	return &ClassroomAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ClassroomAssignmentClient) DeleteOne(_m *ClassroomAssignment) *ClassroomAssignmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ClassroomAssignmentClient) DeleteOneID(id uuid.UUID) *ClassroomAssignmentDeleteOne {
+	builder := c.Delete().Where(classroomassignment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ClassroomAssignmentDeleteOne{builder}
+}
+
+// Query returns a query builder for ClassroomAssignment.
+func (c *ClassroomAssignmentClient) Query() *ClassroomAssignmentQuery {
+	return &ClassroomAssignmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeClassroomAssignment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ClassroomAssignment entity by its id.
+func (c *ClassroomAssignmentClient) Get(ctx context.Context, id uuid.UUID) (*ClassroomAssignment, error) {
+	return c.Query().Where(classroomassignment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ClassroomAssignmentClient) GetX(ctx context.Context, id uuid.UUID) *ClassroomAssignment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryClassroom queries the classroom edge of a ClassroomAssignment.
+func (c *ClassroomAssignmentClient) QueryClassroom(_m *ClassroomAssignment) *ClassroomQuery {
+	query := (&ClassroomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(classroomassignment.Table, classroomassignment.FieldID, id),
+			sqlgraph.To(classroom.Table, classroom.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, classroomassignment.ClassroomTable, classroomassignment.ClassroomColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySeries queries the series edge of a ClassroomAssignment.
+func (c *ClassroomAssignmentClient) QuerySeries(_m *ClassroomAssignment) *SeriesQuery {
+	query := (&SeriesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(classroomassignment.Table, classroomassignment.FieldID, id),
+			sqlgraph.To(series.Table, series.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, classroomassignment.SeriesTable, classroomassignment.SeriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ClassroomAssignmentClient) Hooks() []Hook {
+	hooks := c.hooks.ClassroomAssignment
+	return append(hooks[:len(hooks):len(hooks)], classroomassignment.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ClassroomAssignmentClient) Interceptors() []Interceptor {
+	return c.inters.ClassroomAssignment
+}
+
+func (c *ClassroomAssignmentClient) mutate(ctx context.Context, m *ClassroomAssignmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ClassroomAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ClassroomAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ClassroomAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ClassroomAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown ClassroomAssignment mutation op: %q", m.Op())
+	}
+}
+
+// ClassroomMemberClient is a client for the ClassroomMember schema.
+type ClassroomMemberClient struct {
+	config
+}
+
+// NewClassroomMemberClient returns a client for the ClassroomMember from the given config.
+func NewClassroomMemberClient(c config) *ClassroomMemberClient {
+	return &ClassroomMemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `classroommember.Hooks(f(g(h())))`.
+func (c *ClassroomMemberClient) Use(hooks ...Hook) {
+	c.hooks.ClassroomMember = append(c.hooks.ClassroomMember, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `classroommember.Intercept(f(g(h())))`.
+func (c *ClassroomMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ClassroomMember = append(c.inters.ClassroomMember, interceptors...)
+}
+
+// Create returns a builder for creating a ClassroomMember entity.
+func (c *ClassroomMemberClient) Create() *ClassroomMemberCreate {
+	mutation := newClassroomMemberMutation(c.config, OpCreate)
+	return &ClassroomMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ClassroomMember entities.
+func (c *ClassroomMemberClient) CreateBulk(builders ...*ClassroomMemberCreate) *ClassroomMemberCreateBulk {
+	return &ClassroomMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ClassroomMemberClient) MapCreateBulk(slice any, setFunc func(*ClassroomMemberCreate, int)) *ClassroomMemberCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ClassroomMemberCreateBulk{err: fmt.Errorf("calling to ClassroomMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ClassroomMemberCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ClassroomMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ClassroomMember.
+func (c *ClassroomMemberClient) Update() *ClassroomMemberUpdate {
+	mutation := newClassroomMemberMutation(c.config, OpUpdate)
+	return &ClassroomMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ClassroomMemberClient) UpdateOne(_m *ClassroomMember) *ClassroomMemberUpdateOne {
+	mutation := newClassroomMemberMutation(c.config, OpUpdateOne, withClassroomMember(_m))
+	return &ClassroomMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ClassroomMemberClient) UpdateOneID(id uuid.UUID) *ClassroomMemberUpdateOne {
+	mutation := newClassroomMemberMutation(c.config, OpUpdateOne, withClassroomMemberID(id))
+	return &ClassroomMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ClassroomMember.
+func (c *ClassroomMemberClient) Delete() *ClassroomMemberDelete {
+	mutation := newClassroomMemberMutation(c.config, OpDelete)
+	return &ClassroomMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ClassroomMemberClient) DeleteOne(_m *ClassroomMember) *ClassroomMemberDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ClassroomMemberClient) DeleteOneID(id uuid.UUID) *ClassroomMemberDeleteOne {
+	builder := c.Delete().Where(classroommember.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ClassroomMemberDeleteOne{builder}
+}
+
+// Query returns a query builder for ClassroomMember.
+func (c *ClassroomMemberClient) Query() *ClassroomMemberQuery {
+	return &ClassroomMemberQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeClassroomMember},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ClassroomMember entity by its id.
+func (c *ClassroomMemberClient) Get(ctx context.Context, id uuid.UUID) (*ClassroomMember, error) {
+	return c.Query().Where(classroommember.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ClassroomMemberClient) GetX(ctx context.Context, id uuid.UUID) *ClassroomMember {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryClassroom queries the classroom edge of a ClassroomMember.
+func (c *ClassroomMemberClient) QueryClassroom(_m *ClassroomMember) *ClassroomQuery {
+	query := (&ClassroomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(classroommember.Table, classroommember.FieldID, id),
+			sqlgraph.To(classroom.Table, classroom.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, classroommember.ClassroomTable, classroommember.ClassroomColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ClassroomMemberClient) Hooks() []Hook {
+	return c.hooks.ClassroomMember
+}
+
+// Interceptors returns the client interceptors.
+func (c *ClassroomMemberClient) Interceptors() []Interceptor {
+	return c.inters.ClassroomMember
+}
+
+func (c *ClassroomMemberClient) mutate(ctx context.Context, m *ClassroomMemberMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ClassroomMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ClassroomMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ClassroomMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ClassroomMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown ClassroomMember mutation op: %q", m.Op())
+	}
+}
+
+// ContentEmbeddingClient is a client for the ContentEmbedding schema.
+type ContentEmbeddingClient struct {
+	config
+}
+
+// NewContentEmbeddingClient returns a client for the ContentEmbedding from the given config.
+func NewContentEmbeddingClient(c config) *ContentEmbeddingClient {
+	return &ContentEmbeddingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `contentembedding.Hooks(f(g(h())))`.
+func (c *ContentEmbeddingClient) Use(hooks ...Hook) {
+	c.hooks.ContentEmbedding = append(c.hooks.ContentEmbedding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `contentembedding.Intercept(f(g(h())))`.
+func (c *ContentEmbeddingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ContentEmbedding = append(c.inters.ContentEmbedding, interceptors...)
+}
+
+// Create returns a builder for creating a ContentEmbedding entity.
+func (c *ContentEmbeddingClient) Create() *ContentEmbeddingCreate {
+	mutation := newContentEmbeddingMutation(c.config, OpCreate)
+	return &ContentEmbeddingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ContentEmbedding entities.
+func (c *ContentEmbeddingClient) CreateBulk(builders ...*ContentEmbeddingCreate) *ContentEmbeddingCreateBulk {
+	return &ContentEmbeddingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ContentEmbeddingClient) MapCreateBulk(slice any, setFunc func(*ContentEmbeddingCreate, int)) *ContentEmbeddingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ContentEmbeddingCreateBulk{err: fmt.Errorf("calling to ContentEmbeddingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ContentEmbeddingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ContentEmbeddingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ContentEmbedding.
+func (c *ContentEmbeddingClient) Update() *ContentEmbeddingUpdate {
+	mutation := newContentEmbeddingMutation(c.config, OpUpdate)
+	return &ContentEmbeddingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ContentEmbeddingClient) UpdateOne(_m *ContentEmbedding) *ContentEmbeddingUpdateOne {
+	mutation := newContentEmbeddingMutation(c.config, OpUpdateOne, withContentEmbedding(_m))
+	return &ContentEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ContentEmbeddingClient) UpdateOneID(id string) *ContentEmbeddingUpdateOne {
+	mutation := newContentEmbeddingMutation(c.config, OpUpdateOne, withContentEmbeddingID(id))
+	return &ContentEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ContentEmbedding.
+func (c *ContentEmbeddingClient) Delete() *ContentEmbeddingDelete {
+	mutation := newContentEmbeddingMutation(c.config, OpDelete)
+	return &ContentEmbeddingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ContentEmbeddingClient) DeleteOne(_m *ContentEmbedding) *ContentEmbeddingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ContentEmbeddingClient) DeleteOneID(id string) *ContentEmbeddingDeleteOne {
+	builder := c.Delete().Where(contentembedding.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ContentEmbeddingDeleteOne{builder}
+}
+
+// Query returns a query builder for ContentEmbedding.
+func (c *ContentEmbeddingClient) Query() *ContentEmbeddingQuery {
+	return &ContentEmbeddingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeContentEmbedding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ContentEmbedding entity by its id.
+func (c *ContentEmbeddingClient) Get(ctx context.Context, id string) (*ContentEmbedding, error) {
+	return c.Query().Where(contentembedding.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ContentEmbeddingClient) GetX(ctx context.Context, id string) *ContentEmbedding {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ContentEmbeddingClient) Hooks() []Hook {
+	hooks := c.hooks.ContentEmbedding
+	return append(hooks[:len(hooks):len(hooks)], contentembedding.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ContentEmbeddingClient) Interceptors() []Interceptor {
+	return c.inters.ContentEmbedding
+}
+
+func (c *ContentEmbeddingClient) mutate(ctx context.Context, m *ContentEmbeddingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ContentEmbeddingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ContentEmbeddingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ContentEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ContentEmbeddingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown ContentEmbedding mutation op: %q", m.Op())
+	}
+}
+
+// ContentKeyClient is a client for the ContentKey schema.
+type ContentKeyClient struct {
+	config
+}
+
+// NewContentKeyClient returns a client for the ContentKey from the given config.
+func NewContentKeyClient(c config) *ContentKeyClient {
+	return &ContentKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `contentkey.Hooks(f(g(h())))`.
+func (c *ContentKeyClient) Use(hooks ...Hook) {
+	c.hooks.ContentKey = append(c.hooks.ContentKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `contentkey.Intercept(f(g(h())))`.
+func (c *ContentKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ContentKey = append(c.inters.ContentKey, interceptors...)
+}
+
+// Create returns a builder for creating a ContentKey entity.
+func (c *ContentKeyClient) Create() *ContentKeyCreate {
+	mutation := newContentKeyMutation(c.config, OpCreate)
+	return &ContentKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ContentKey entities.
+func (c *ContentKeyClient) CreateBulk(builders ...*ContentKeyCreate) *ContentKeyCreateBulk {
+	return &ContentKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ContentKeyClient) MapCreateBulk(slice any, setFunc func(*ContentKeyCreate, int)) *ContentKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ContentKeyCreateBulk{err: fmt.Errorf("calling to ContentKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ContentKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ContentKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ContentKey.
+func (c *ContentKeyClient) Update() *ContentKeyUpdate {
+	mutation := newContentKeyMutation(c.config, OpUpdate)
+	return &ContentKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ContentKeyClient) UpdateOne(_m *ContentKey) *ContentKeyUpdateOne {
+	mutation := newContentKeyMutation(c.config, OpUpdateOne, withContentKey(_m))
+	return &ContentKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ContentKeyClient) UpdateOneID(id uuid.UUID) *ContentKeyUpdateOne {
+	mutation := newContentKeyMutation(c.config, OpUpdateOne, withContentKeyID(id))
+	return &ContentKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ContentKey.
+func (c *ContentKeyClient) Delete() *ContentKeyDelete {
+	mutation := newContentKeyMutation(c.config, OpDelete)
+	return &ContentKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ContentKeyClient) DeleteOne(_m *ContentKey) *ContentKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ContentKeyClient) DeleteOneID(id uuid.UUID) *ContentKeyDeleteOne {
+	builder := c.Delete().Where(contentkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ContentKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for ContentKey.
+func (c *ContentKeyClient) Query() *ContentKeyQuery {
+	return &ContentKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeContentKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ContentKey entity by its id.
+func (c *ContentKeyClient) Get(ctx context.Context, id uuid.UUID) (*ContentKey, error) {
+	return c.Query().Where(contentkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ContentKeyClient) GetX(ctx context.Context, id uuid.UUID) *ContentKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ContentKeyClient) Hooks() []Hook {
+	hooks := c.hooks.ContentKey
+	return append(hooks[:len(hooks):len(hooks)], contentkey.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ContentKeyClient) Interceptors() []Interceptor {
+	return c.inters.ContentKey
+}
+
+func (c *ContentKeyClient) mutate(ctx context.Context, m *ContentKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ContentKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ContentKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ContentKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ContentKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown ContentKey mutation op: %q", m.Op())
+	}
+}
+
+// ContentReassignmentClient is a client for the ContentReassignment schema.
+type ContentReassignmentClient struct {
+	config
+}
+
+// NewContentReassignmentClient returns a client for the ContentReassignment from the given config.
+func NewContentReassignmentClient(c config) *ContentReassignmentClient {
+	return &ContentReassignmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `contentreassignment.Hooks(f(g(h())))`.
+func (c *ContentReassignmentClient) Use(hooks ...Hook) {
+	c.hooks.ContentReassignment = append(c.hooks.ContentReassignment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `contentreassignment.Intercept(f(g(h())))`.
+func (c *ContentReassignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ContentReassignment = append(c.inters.ContentReassignment, interceptors...)
+}
+
+// Create returns a builder for creating a ContentReassignment entity.
+func (c *ContentReassignmentClient) Create() *ContentReassignmentCreate {
+	mutation := newContentReassignmentMutation(c.config, OpCreate)
+	return &ContentReassignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ContentReassignment entities.
+func (c *ContentReassignmentClient) CreateBulk(builders ...*ContentReassignmentCreate) *ContentReassignmentCreateBulk {
+	return &ContentReassignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ContentReassignmentClient) MapCreateBulk(slice any, setFunc func(*ContentReassignmentCreate, int)) *ContentReassignmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ContentReassignmentCreateBulk{err: fmt.Errorf("calling to ContentReassignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ContentReassignmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ContentReassignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ContentReassignment.
+func (c *ContentReassignmentClient) Update() *ContentReassignmentUpdate {
+	mutation := newContentReassignmentMutation(c.config, OpUpdate)
+	return &ContentReassignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ContentReassignmentClient) UpdateOne(_m *ContentReassignment) *ContentReassignmentUpdateOne {
+	mutation := newContentReassignmentMutation(c.config, OpUpdateOne, withContentReassignment(_m))
+	return &ContentReassignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ContentReassignmentClient) UpdateOneID(id uuid.UUID) *ContentReassignmentUpdateOne {
+	mutation := newContentReassignmentMutation(c.config, OpUpdateOne, withContentReassignmentID(id))
+	return &ContentReassignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ContentReassignment.
+func (c *ContentReassignmentClient) Delete() *ContentReassignmentDelete {
+	mutation := newContentReassignmentMutation(c.config, OpDelete)
+	return &ContentReassignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ContentReassignmentClient) DeleteOne(_m *ContentReassignment) *ContentReassignmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ContentReassignmentClient) DeleteOneID(id uuid.UUID) *ContentReassignmentDeleteOne {
+	builder := c.Delete().Where(contentreassignment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ContentReassignmentDeleteOne{builder}
+}
+
+// Query returns a query builder for ContentReassignment.
+func (c *ContentReassignmentClient) Query() *ContentReassignmentQuery {
+	return &ContentReassignmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeContentReassignment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ContentReassignment entity by its id.
+func (c *ContentReassignmentClient) Get(ctx context.Context, id uuid.UUID) (*ContentReassignment, error) {
+	return c.Query().Where(contentreassignment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ContentReassignmentClient) GetX(ctx context.Context, id uuid.UUID) *ContentReassignment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ContentReassignmentClient) Hooks() []Hook {
+	hooks := c.hooks.ContentReassignment
+	return append(hooks[:len(hooks):len(hooks)], contentreassignment.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ContentReassignmentClient) Interceptors() []Interceptor {
+	return c.inters.ContentReassignment
+}
+
+func (c *ContentReassignmentClient) mutate(ctx context.Context, m *ContentReassignmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ContentReassignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ContentReassignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ContentReassignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ContentReassignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown ContentReassignment mutation op: %q", m.Op())
+	}
+}
+
+// DeviceTokenClient is a client for the DeviceToken schema.
+type DeviceTokenClient struct {
+	config
+}
+
+// NewDeviceTokenClient returns a client for the DeviceToken from the given config.
+func NewDeviceTokenClient(c config) *DeviceTokenClient {
+	return &DeviceTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `devicetoken.Hooks(f(g(h())))`.
+func (c *DeviceTokenClient) Use(hooks ...Hook) {
+	c.hooks.DeviceToken = append(c.hooks.DeviceToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `devicetoken.Intercept(f(g(h())))`.
+func (c *DeviceTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeviceToken = append(c.inters.DeviceToken, interceptors...)
+}
+
+// Create returns a builder for creating a DeviceToken entity.
+func (c *DeviceTokenClient) Create() *DeviceTokenCreate {
+	mutation := newDeviceTokenMutation(c.config, OpCreate)
+	return &DeviceTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeviceToken entities.
+func (c *DeviceTokenClient) CreateBulk(builders ...*DeviceTokenCreate) *DeviceTokenCreateBulk {
+	return &DeviceTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeviceTokenClient) MapCreateBulk(slice any, setFunc func(*DeviceTokenCreate, int)) *DeviceTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeviceTokenCreateBulk{err: fmt.Errorf("calling to DeviceTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeviceTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeviceTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeviceToken.
+func (c *DeviceTokenClient) Update() *DeviceTokenUpdate {
+	mutation := newDeviceTokenMutation(c.config, OpUpdate)
+	return &DeviceTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeviceTokenClient) UpdateOne(_m *DeviceToken) *DeviceTokenUpdateOne {
+	mutation := newDeviceTokenMutation(c.config, OpUpdateOne, withDeviceToken(_m))
+	return &DeviceTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeviceTokenClient) UpdateOneID(id int) *DeviceTokenUpdateOne {
+	mutation := newDeviceTokenMutation(c.config, OpUpdateOne, withDeviceTokenID(id))
+	return &DeviceTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeviceToken.
+func (c *DeviceTokenClient) Delete() *DeviceTokenDelete {
+	mutation := newDeviceTokenMutation(c.config, OpDelete)
+	return &DeviceTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeviceTokenClient) DeleteOne(_m *DeviceToken) *DeviceTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeviceTokenClient) DeleteOneID(id int) *DeviceTokenDeleteOne {
+	builder := c.Delete().Where(devicetoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeviceTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for DeviceToken.
+func (c *DeviceTokenClient) Query() *DeviceTokenQuery {
+	return &DeviceTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeviceToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeviceToken entity by its id.
+func (c *DeviceTokenClient) Get(ctx context.Context, id int) (*DeviceToken, error) {
+	return c.Query().Where(devicetoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeviceTokenClient) GetX(ctx context.Context, id int) *DeviceToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DeviceTokenClient) Hooks() []Hook {
+	hooks := c.hooks.DeviceToken
+	return append(hooks[:len(hooks):len(hooks)], devicetoken.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeviceTokenClient) Interceptors() []Interceptor {
+	return c.inters.DeviceToken
+}
+
+func (c *DeviceTokenClient) mutate(ctx context.Context, m *DeviceTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeviceTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeviceTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeviceTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeviceTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown DeviceToken mutation op: %q", m.Op())
+	}
+}
+
+// DictationAttemptClient is a client for the DictationAttempt schema.
+type DictationAttemptClient struct {
+	config
+}
+
+// NewDictationAttemptClient returns a client for the DictationAttempt from the given config.
+func NewDictationAttemptClient(c config) *DictationAttemptClient {
+	return &DictationAttemptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `dictationattempt.Hooks(f(g(h())))`.
+func (c *DictationAttemptClient) Use(hooks ...Hook) {
+	c.hooks.DictationAttempt = append(c.hooks.DictationAttempt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `dictationattempt.Intercept(f(g(h())))`.
+func (c *DictationAttemptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DictationAttempt = append(c.inters.DictationAttempt, interceptors...)
+}
+
+// Create returns a builder for creating a DictationAttempt entity.
+func (c *DictationAttemptClient) Create() *DictationAttemptCreate {
+	mutation := newDictationAttemptMutation(c.config, OpCreate)
+	return &DictationAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DictationAttempt entities.
+func (c *DictationAttemptClient) CreateBulk(builders ...*DictationAttemptCreate) *DictationAttemptCreateBulk {
+	return &DictationAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DictationAttemptClient) MapCreateBulk(slice any, setFunc func(*DictationAttemptCreate, int)) *DictationAttemptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DictationAttemptCreateBulk{err: fmt.Errorf("calling to DictationAttemptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DictationAttemptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DictationAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DictationAttempt.
+func (c *DictationAttemptClient) Update() *DictationAttemptUpdate {
+	mutation := newDictationAttemptMutation(c.config, OpUpdate)
+	return &DictationAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DictationAttemptClient) UpdateOne(_m *DictationAttempt) *DictationAttemptUpdateOne {
+	mutation := newDictationAttemptMutation(c.config, OpUpdateOne, withDictationAttempt(_m))
+	return &DictationAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DictationAttemptClient) UpdateOneID(id uuid.UUID) *DictationAttemptUpdateOne {
+	mutation := newDictationAttemptMutation(c.config, OpUpdateOne, withDictationAttemptID(id))
+	return &DictationAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DictationAttempt.
+func (c *DictationAttemptClient) Delete() *DictationAttemptDelete {
+	mutation := newDictationAttemptMutation(c.config, OpDelete)
+	return &DictationAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DictationAttemptClient) DeleteOne(_m *DictationAttempt) *DictationAttemptDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DictationAttemptClient) DeleteOneID(id uuid.UUID) *DictationAttemptDeleteOne {
+	builder := c.Delete().Where(dictationattempt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DictationAttemptDeleteOne{builder}
+}
+
+// Query returns a query builder for DictationAttempt.
+func (c *DictationAttemptClient) Query() *DictationAttemptQuery {
+	return &DictationAttemptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDictationAttempt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DictationAttempt entity by its id.
+func (c *DictationAttemptClient) Get(ctx context.Context, id uuid.UUID) (*DictationAttempt, error) {
+	return c.Query().Where(dictationattempt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DictationAttemptClient) GetX(ctx context.Context, id uuid.UUID) *DictationAttempt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DictationAttemptClient) Hooks() []Hook {
+	hooks := c.hooks.DictationAttempt
+	return append(hooks[:len(hooks):len(hooks)], dictationattempt.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DictationAttemptClient) Interceptors() []Interceptor {
+	return c.inters.DictationAttempt
+}
+
+func (c *DictationAttemptClient) mutate(ctx context.Context, m *DictationAttemptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DictationAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DictationAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DictationAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DictationAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown DictationAttempt mutation op: %q", m.Op())
+	}
+}
+
+// EngagementRollupClient is a client for the EngagementRollup schema.
+type EngagementRollupClient struct {
+	config
+}
+
+// NewEngagementRollupClient returns a client for the EngagementRollup from the given config.
+func NewEngagementRollupClient(c config) *EngagementRollupClient {
+	return &EngagementRollupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `engagementrollup.Hooks(f(g(h())))`.
+func (c *EngagementRollupClient) Use(hooks ...Hook) {
+	c.hooks.EngagementRollup = append(c.hooks.EngagementRollup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `engagementrollup.Intercept(f(g(h())))`.
+func (c *EngagementRollupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EngagementRollup = append(c.inters.EngagementRollup, interceptors...)
+}
+
+// Create returns a builder for creating a EngagementRollup entity.
+func (c *EngagementRollupClient) Create() *EngagementRollupCreate {
+	mutation := newEngagementRollupMutation(c.config, OpCreate)
+	return &EngagementRollupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EngagementRollup entities.
+func (c *EngagementRollupClient) CreateBulk(builders ...*EngagementRollupCreate) *EngagementRollupCreateBulk {
+	return &EngagementRollupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EngagementRollupClient) MapCreateBulk(slice any, setFunc func(*EngagementRollupCreate, int)) *EngagementRollupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EngagementRollupCreateBulk{err: fmt.Errorf("calling to EngagementRollupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EngagementRollupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EngagementRollupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EngagementRollup.
+func (c *EngagementRollupClient) Update() *EngagementRollupUpdate {
+	mutation := newEngagementRollupMutation(c.config, OpUpdate)
+	return &EngagementRollupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EngagementRollupClient) UpdateOne(_m *EngagementRollup) *EngagementRollupUpdateOne {
+	mutation := newEngagementRollupMutation(c.config, OpUpdateOne, withEngagementRollup(_m))
+	return &EngagementRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EngagementRollupClient) UpdateOneID(id uuid.UUID) *EngagementRollupUpdateOne {
+	mutation := newEngagementRollupMutation(c.config, OpUpdateOne, withEngagementRollupID(id))
+	return &EngagementRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EngagementRollup.
+func (c *EngagementRollupClient) Delete() *EngagementRollupDelete {
+	mutation := newEngagementRollupMutation(c.config, OpDelete)
+	return &EngagementRollupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EngagementRollupClient) DeleteOne(_m *EngagementRollup) *EngagementRollupDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EngagementRollupClient) DeleteOneID(id uuid.UUID) *EngagementRollupDeleteOne {
+	builder := c.Delete().Where(engagementrollup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EngagementRollupDeleteOne{builder}
+}
+
+// Query returns a query builder for EngagementRollup.
+func (c *EngagementRollupClient) Query() *EngagementRollupQuery {
+	return &EngagementRollupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEngagementRollup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EngagementRollup entity by its id.
+func (c *EngagementRollupClient) Get(ctx context.Context, id uuid.UUID) (*EngagementRollup, error) {
+	return c.Query().Where(engagementrollup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EngagementRollupClient) GetX(ctx context.Context, id uuid.UUID) *EngagementRollup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EngagementRollupClient) Hooks() []Hook {
+	hooks := c.hooks.EngagementRollup
+	return append(hooks[:len(hooks):len(hooks)], engagementrollup.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *EngagementRollupClient) Interceptors() []Interceptor {
+	return c.inters.EngagementRollup
+}
+
+func (c *EngagementRollupClient) mutate(ctx context.Context, m *EngagementRollupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EngagementRollupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EngagementRollupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EngagementRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EngagementRollupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown EngagementRollup mutation op: %q", m.Op())
+	}
+}
+
+// EpisodeClient is a client for the Episode schema.
+type EpisodeClient struct {
+	config
+}
+
+// NewEpisodeClient returns a client for the Episode from the given config.
+func NewEpisodeClient(c config) *EpisodeClient {
+	return &EpisodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `episode.Hooks(f(g(h())))`.
+func (c *EpisodeClient) Use(hooks ...Hook) {
+	c.hooks.Episode = append(c.hooks.Episode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `episode.Intercept(f(g(h())))`.
+func (c *EpisodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Episode = append(c.inters.Episode, interceptors...)
+}
+
+// Create returns a builder for creating a Episode entity.
+func (c *EpisodeClient) Create() *EpisodeCreate {
+	mutation := newEpisodeMutation(c.config, OpCreate)
+	return &EpisodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Episode entities.
+func (c *EpisodeClient) CreateBulk(builders ...*EpisodeCreate) *EpisodeCreateBulk {
+	return &EpisodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EpisodeClient) MapCreateBulk(slice any, setFunc func(*EpisodeCreate, int)) *EpisodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EpisodeCreateBulk{err: fmt.Errorf("calling to EpisodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EpisodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EpisodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Episode.
+func (c *EpisodeClient) Update() *EpisodeUpdate {
+	mutation := newEpisodeMutation(c.config, OpUpdate)
+	return &EpisodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EpisodeClient) UpdateOne(_m *Episode) *EpisodeUpdateOne {
+	mutation := newEpisodeMutation(c.config, OpUpdateOne, withEpisode(_m))
+	return &EpisodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EpisodeClient) UpdateOneID(id uuid.UUID) *EpisodeUpdateOne {
+	mutation := newEpisodeMutation(c.config, OpUpdateOne, withEpisodeID(id))
+	return &EpisodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Episode.
+func (c *EpisodeClient) Delete() *EpisodeDelete {
+	mutation := newEpisodeMutation(c.config, OpDelete)
+	return &EpisodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EpisodeClient) DeleteOne(_m *Episode) *EpisodeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EpisodeClient) DeleteOneID(id uuid.UUID) *EpisodeDeleteOne {
+	builder := c.Delete().Where(episode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EpisodeDeleteOne{builder}
+}
+
+// Query returns a query builder for Episode.
+func (c *EpisodeClient) Query() *EpisodeQuery {
+	return &EpisodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEpisode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Episode entity by its id.
+func (c *EpisodeClient) Get(ctx context.Context, id uuid.UUID) (*Episode, error) {
+	return c.Query().Where(episode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EpisodeClient) GetX(ctx context.Context, id uuid.UUID) *Episode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySeries queries the series edge of a Episode.
+func (c *EpisodeClient) QuerySeries(_m *Episode) *SeriesQuery {
+	query := (&SeriesClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, id),
+			sqlgraph.To(series.Table, series.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, episode.SeriesTable, episode.SeriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTranscript queries the transcript edge of a Episode.
+func (c *EpisodeClient) QueryTranscript(_m *Episode) *EpisodeTranscriptQuery {
+	query := (&EpisodeTranscriptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, id),
+			sqlgraph.To(episodetranscript.Table, episodetranscript.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, episode.TranscriptTable, episode.TranscriptColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EpisodeClient) Hooks() []Hook {
+	hooks := c.hooks.Episode
+	return append(hooks[:len(hooks):len(hooks)], episode.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *EpisodeClient) Interceptors() []Interceptor {
+	inters := c.inters.Episode
+	return append(inters[:len(inters):len(inters)], episode.Interceptors[:]...)
+}
+
+func (c *EpisodeClient) mutate(ctx context.Context, m *EpisodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EpisodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EpisodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EpisodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EpisodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Episode mutation op: %q", m.Op())
+	}
+}
+
+// EpisodeTranscriptClient is a client for the EpisodeTranscript schema.
+type EpisodeTranscriptClient struct {
+	config
+}
+
+// NewEpisodeTranscriptClient returns a client for the EpisodeTranscript from the given config.
+func NewEpisodeTranscriptClient(c config) *EpisodeTranscriptClient {
+	return &EpisodeTranscriptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `episodetranscript.Hooks(f(g(h())))`.
+func (c *EpisodeTranscriptClient) Use(hooks ...Hook) {
+	c.hooks.EpisodeTranscript = append(c.hooks.EpisodeTranscript, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `episodetranscript.Intercept(f(g(h())))`.
+func (c *EpisodeTranscriptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EpisodeTranscript = append(c.inters.EpisodeTranscript, interceptors...)
+}
+
+// Create returns a builder for creating a EpisodeTranscript entity.
+func (c *EpisodeTranscriptClient) Create() *EpisodeTranscriptCreate {
+	mutation := newEpisodeTranscriptMutation(c.config, OpCreate)
+	return &EpisodeTranscriptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EpisodeTranscript entities.
+func (c *EpisodeTranscriptClient) CreateBulk(builders ...*EpisodeTranscriptCreate) *EpisodeTranscriptCreateBulk {
+	return &EpisodeTranscriptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EpisodeTranscriptClient) MapCreateBulk(slice any, setFunc func(*EpisodeTranscriptCreate, int)) *EpisodeTranscriptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EpisodeTranscriptCreateBulk{err: fmt.Errorf("calling to EpisodeTranscriptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EpisodeTranscriptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EpisodeTranscriptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EpisodeTranscript.
+func (c *EpisodeTranscriptClient) Update() *EpisodeTranscriptUpdate {
+	mutation := newEpisodeTranscriptMutation(c.config, OpUpdate)
+	return &EpisodeTranscriptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EpisodeTranscriptClient) UpdateOne(_m *EpisodeTranscript) *EpisodeTranscriptUpdateOne {
+	mutation := newEpisodeTranscriptMutation(c.config, OpUpdateOne, withEpisodeTranscript(_m))
+	return &EpisodeTranscriptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EpisodeTranscriptClient) UpdateOneID(id uuid.UUID) *EpisodeTranscriptUpdateOne {
+	mutation := newEpisodeTranscriptMutation(c.config, OpUpdateOne, withEpisodeTranscriptID(id))
+	return &EpisodeTranscriptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EpisodeTranscript.
+func (c *EpisodeTranscriptClient) Delete() *EpisodeTranscriptDelete {
+	mutation := newEpisodeTranscriptMutation(c.config, OpDelete)
+	return &EpisodeTranscriptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EpisodeTranscriptClient) DeleteOne(_m *EpisodeTranscript) *EpisodeTranscriptDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EpisodeTranscriptClient) DeleteOneID(id uuid.UUID) *EpisodeTranscriptDeleteOne {
+	builder := c.Delete().Where(episodetranscript.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EpisodeTranscriptDeleteOne{builder}
+}
+
+// Query returns a query builder for EpisodeTranscript.
+func (c *EpisodeTranscriptClient) Query() *EpisodeTranscriptQuery {
+	return &EpisodeTranscriptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEpisodeTranscript},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EpisodeTranscript entity by its id.
+func (c *EpisodeTranscriptClient) Get(ctx context.Context, id uuid.UUID) (*EpisodeTranscript, error) {
+	return c.Query().Where(episodetranscript.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EpisodeTranscriptClient) GetX(ctx context.Context, id uuid.UUID) *EpisodeTranscript {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEpisode queries the episode edge of a EpisodeTranscript.
+func (c *EpisodeTranscriptClient) QueryEpisode(_m *EpisodeTranscript) *EpisodeQuery {
+	query := (&EpisodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episodetranscript.Table, episodetranscript.FieldID, id),
+			sqlgraph.To(episode.Table, episode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, episodetranscript.EpisodeTable, episodetranscript.EpisodeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EpisodeTranscriptClient) Hooks() []Hook {
+	return c.hooks.EpisodeTranscript
+}
+
+// Interceptors returns the client interceptors.
+func (c *EpisodeTranscriptClient) Interceptors() []Interceptor {
+	return c.inters.EpisodeTranscript
+}
+
+func (c *EpisodeTranscriptClient) mutate(ctx context.Context, m *EpisodeTranscriptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EpisodeTranscriptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EpisodeTranscriptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EpisodeTranscriptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EpisodeTranscriptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown EpisodeTranscript mutation op: %q", m.Op())
+	}
+}
+
+// EventClient is a client for the Event schema.
+type EventClient struct {
+	config
+}
+
+// NewEventClient returns a client for the Event from the given config.
+func NewEventClient(c config) *EventClient {
+	return &EventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `event.Hooks(f(g(h())))`.
+func (c *EventClient) Use(hooks ...Hook) {
+	c.hooks.Event = append(c.hooks.Event, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `event.Intercept(f(g(h())))`.
+func (c *EventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Event = append(c.inters.Event, interceptors...)
+}
+
+// Create returns a builder for creating a Event entity.
+func (c *EventClient) Create() *EventCreate {
+	mutation := newEventMutation(c.config, OpCreate)
+	return &EventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Event entities.
+func (c *EventClient) CreateBulk(builders ...*EventCreate) *EventCreateBulk {
+	return &EventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EventClient) MapCreateBulk(slice any, setFunc func(*EventCreate, int)) *EventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EventCreateBulk{err: fmt.Errorf("calling to EventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Event.
+func (c *EventClient) Update() *EventUpdate {
+	mutation := newEventMutation(c.config, OpUpdate)
+	return &EventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EventClient) UpdateOne(_m *Event) *EventUpdateOne {
+	mutation := newEventMutation(c.config, OpUpdateOne, withEvent(_m))
+	return &EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EventClient) UpdateOneID(id uuid.UUID) *EventUpdateOne {
+	mutation := newEventMutation(c.config, OpUpdateOne, withEventID(id))
+	return &EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Event.
+func (c *EventClient) Delete() *EventDelete {
+	mutation := newEventMutation(c.config, OpDelete)
+	return &EventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EventClient) DeleteOne(_m *Event) *EventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EventClient) DeleteOneID(id uuid.UUID) *EventDeleteOne {
+	builder := c.Delete().Where(event.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EventDeleteOne{builder}
+}
+
+// Query returns a query builder for Event.
+func (c *EventClient) Query() *EventQuery {
+	return &EventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Event entity by its id.
+func (c *EventClient) Get(ctx context.Context, id uuid.UUID) (*Event, error) {
+	return c.Query().Where(event.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EventClient) GetX(ctx context.Context, id uuid.UUID) *Event {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EventClient) Hooks() []Hook {
+	return c.hooks.Event
+}
+
+// Interceptors returns the client interceptors.
+func (c *EventClient) Interceptors() []Interceptor {
+	return c.inters.Event
+}
+
+func (c *EventClient) mutate(ctx context.Context, m *EventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Event mutation op: %q", m.Op())
+	}
+}
+
+// FeedItemClient is a client for the FeedItem schema.
+type FeedItemClient struct {
+	config
+}
+
+// NewFeedItemClient returns a client for the FeedItem from the given config.
+func NewFeedItemClient(c config) *FeedItemClient {
+	return &FeedItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `feeditem.Hooks(f(g(h())))`.
+func (c *FeedItemClient) Use(hooks ...Hook) {
+	c.hooks.FeedItem = append(c.hooks.FeedItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `feeditem.Intercept(f(g(h())))`.
+func (c *FeedItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FeedItem = append(c.inters.FeedItem, interceptors...)
+}
+
+// Create returns a builder for creating a FeedItem entity.
+func (c *FeedItemClient) Create() *FeedItemCreate {
+	mutation := newFeedItemMutation(c.config, OpCreate)
+	return &FeedItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FeedItem entities.
+func (c *FeedItemClient) CreateBulk(builders ...*FeedItemCreate) *FeedItemCreateBulk {
+	return &FeedItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FeedItemClient) MapCreateBulk(slice any, setFunc func(*FeedItemCreate, int)) *FeedItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FeedItemCreateBulk{err: fmt.Errorf("calling to FeedItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FeedItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FeedItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FeedItem.
+func (c *FeedItemClient) Update() *FeedItemUpdate {
+	mutation := newFeedItemMutation(c.config, OpUpdate)
+	return &FeedItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FeedItemClient) UpdateOne(_m *FeedItem) *FeedItemUpdateOne {
+	mutation := newFeedItemMutation(c.config, OpUpdateOne, withFeedItem(_m))
+	return &FeedItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FeedItemClient) UpdateOneID(id uuid.UUID) *FeedItemUpdateOne {
+	mutation := newFeedItemMutation(c.config, OpUpdateOne, withFeedItemID(id))
+	return &FeedItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FeedItem.
+func (c *FeedItemClient) Delete() *FeedItemDelete {
+	mutation := newFeedItemMutation(c.config, OpDelete)
+	return &FeedItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FeedItemClient) DeleteOne(_m *FeedItem) *FeedItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FeedItemClient) DeleteOneID(id uuid.UUID) *FeedItemDeleteOne {
+	builder := c.Delete().Where(feeditem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FeedItemDeleteOne{builder}
+}
+
+// Query returns a query builder for FeedItem.
+func (c *FeedItemClient) Query() *FeedItemQuery {
+	return &FeedItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFeedItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FeedItem entity by its id.
+func (c *FeedItemClient) Get(ctx context.Context, id uuid.UUID) (*FeedItem, error) {
+	return c.Query().Where(feeditem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FeedItemClient) GetX(ctx context.Context, id uuid.UUID) *FeedItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySubscription queries the subscription edge of a FeedItem.
+func (c *FeedItemClient) QuerySubscription(_m *FeedItem) *FeedSubscriptionQuery {
+	query := (&FeedSubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(feeditem.Table, feeditem.FieldID, id),
+			sqlgraph.To(feedsubscription.Table, feedsubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, feeditem.SubscriptionTable, feeditem.SubscriptionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FeedItemClient) Hooks() []Hook {
+	hooks := c.hooks.FeedItem
+	return append(hooks[:len(hooks):len(hooks)], feeditem.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *FeedItemClient) Interceptors() []Interceptor {
+	return c.inters.FeedItem
+}
+
+func (c *FeedItemClient) mutate(ctx context.Context, m *FeedItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FeedItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FeedItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FeedItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FeedItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown FeedItem mutation op: %q", m.Op())
+	}
+}
+
+// FeedSubscriptionClient is a client for the FeedSubscription schema.
+type FeedSubscriptionClient struct {
+	config
+}
+
+// NewFeedSubscriptionClient returns a client for the FeedSubscription from the given config.
+func NewFeedSubscriptionClient(c config) *FeedSubscriptionClient {
+	return &FeedSubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `feedsubscription.Hooks(f(g(h())))`.
+func (c *FeedSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.FeedSubscription = append(c.hooks.FeedSubscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `feedsubscription.Intercept(f(g(h())))`.
+func (c *FeedSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FeedSubscription = append(c.inters.FeedSubscription, interceptors...)
+}
+
+// Create returns a builder for creating a FeedSubscription entity.
+func (c *FeedSubscriptionClient) Create() *FeedSubscriptionCreate {
+	mutation := newFeedSubscriptionMutation(c.config, OpCreate)
+	return &FeedSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FeedSubscription entities.
+func (c *FeedSubscriptionClient) CreateBulk(builders ...*FeedSubscriptionCreate) *FeedSubscriptionCreateBulk {
+	return &FeedSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FeedSubscriptionClient) MapCreateBulk(slice any, setFunc func(*FeedSubscriptionCreate, int)) *FeedSubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FeedSubscriptionCreateBulk{err: fmt.Errorf("calling to FeedSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FeedSubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FeedSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FeedSubscription.
+func (c *FeedSubscriptionClient) Update() *FeedSubscriptionUpdate {
+	mutation := newFeedSubscriptionMutation(c.config, OpUpdate)
+	return &FeedSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FeedSubscriptionClient) UpdateOne(_m *FeedSubscription) *FeedSubscriptionUpdateOne {
+	mutation := newFeedSubscriptionMutation(c.config, OpUpdateOne, withFeedSubscription(_m))
+	return &FeedSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FeedSubscriptionClient) UpdateOneID(id uuid.UUID) *FeedSubscriptionUpdateOne {
+	mutation := newFeedSubscriptionMutation(c.config, OpUpdateOne, withFeedSubscriptionID(id))
+	return &FeedSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FeedSubscription.
+func (c *FeedSubscriptionClient) Delete() *FeedSubscriptionDelete {
+	mutation := newFeedSubscriptionMutation(c.config, OpDelete)
+	return &FeedSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FeedSubscriptionClient) DeleteOne(_m *FeedSubscription) *FeedSubscriptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FeedSubscriptionClient) DeleteOneID(id uuid.UUID) *FeedSubscriptionDeleteOne {
+	builder := c.Delete().Where(feedsubscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FeedSubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for FeedSubscription.
+func (c *FeedSubscriptionClient) Query() *FeedSubscriptionQuery {
+	return &FeedSubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFeedSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FeedSubscription entity by its id.
+func (c *FeedSubscriptionClient) Get(ctx context.Context, id uuid.UUID) (*FeedSubscription, error) {
+	return c.Query().Where(feedsubscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FeedSubscriptionClient) GetX(ctx context.Context, id uuid.UUID) *FeedSubscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItems queries the items edge of a FeedSubscription.
+func (c *FeedSubscriptionClient) QueryItems(_m *FeedSubscription) *FeedItemQuery {
+	query := (&FeedItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(feedsubscription.Table, feedsubscription.FieldID, id),
+			sqlgraph.To(feeditem.Table, feeditem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, feedsubscription.ItemsTable, feedsubscription.ItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FeedSubscriptionClient) Hooks() []Hook {
+	hooks := c.hooks.FeedSubscription
+	return append(hooks[:len(hooks):len(hooks)], feedsubscription.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *FeedSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.FeedSubscription
+}
+
+func (c *FeedSubscriptionClient) mutate(ctx context.Context, m *FeedSubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FeedSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FeedSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FeedSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FeedSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown FeedSubscription mutation op: %q", m.Op())
+	}
+}
+
+// InvoiceClient is a client for the Invoice schema.
+type InvoiceClient struct {
+	config
+}
+
+// NewInvoiceClient returns a client for the Invoice from the given config.
+func NewInvoiceClient(c config) *InvoiceClient {
+	return &InvoiceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoice.Hooks(f(g(h())))`.
+func (c *InvoiceClient) Use(hooks ...Hook) {
+	c.hooks.Invoice = append(c.hooks.Invoice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invoice.Intercept(f(g(h())))`.
+func (c *InvoiceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invoice = append(c.inters.Invoice, interceptors...)
+}
+
+// Create returns a builder for creating a Invoice entity.
+func (c *InvoiceClient) Create() *InvoiceCreate {
+	mutation := newInvoiceMutation(c.config, OpCreate)
+	return &InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Invoice entities.
+func (c *InvoiceClient) CreateBulk(builders ...*InvoiceCreate) *InvoiceCreateBulk {
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvoiceClient) MapCreateBulk(slice any, setFunc func(*InvoiceCreate, int)) *InvoiceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvoiceCreateBulk{err: fmt.Errorf("calling to InvoiceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvoiceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Invoice.
+func (c *InvoiceClient) Update() *InvoiceUpdate {
+	mutation := newInvoiceMutation(c.config, OpUpdate)
+	return &InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoiceClient) UpdateOne(_m *Invoice) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoice(_m))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvoiceClient) UpdateOneID(id uuid.UUID) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoiceID(id))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Invoice.
+func (c *InvoiceClient) Delete() *InvoiceDelete {
+	mutation := newInvoiceMutation(c.config, OpDelete)
+	return &InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoiceClient) DeleteOne(_m *Invoice) *InvoiceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvoiceClient) DeleteOneID(id uuid.UUID) *InvoiceDeleteOne {
+	builder := c.Delete().Where(invoice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvoiceDeleteOne{builder}
+}
+
+// Query returns a query builder for Invoice.
+func (c *InvoiceClient) Query() *InvoiceQuery {
+	return &InvoiceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvoice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Invoice entity by its id.
+func (c *InvoiceClient) Get(ctx context.Context, id uuid.UUID) (*Invoice, error) {
+	return c.Query().Where(invoice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceClient) GetX(ctx context.Context, id uuid.UUID) *Invoice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceClient) Hooks() []Hook {
+	hooks := c.hooks.Invoice
+	return append(hooks[:len(hooks):len(hooks)], invoice.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvoiceClient) Interceptors() []Interceptor {
+	return c.inters.Invoice
+}
+
+func (c *InvoiceClient) mutate(ctx context.Context, m *InvoiceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Invoice mutation op: %q", m.Op())
+	}
+}
+
+// JobClient is a client for the Job schema.
+type JobClient struct {
+	config
+}
+
+// NewJobClient returns a client for the Job from the given config.
+func NewJobClient(c config) *JobClient {
+	return &JobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `job.Hooks(f(g(h())))`.
+func (c *JobClient) Use(hooks ...Hook) {
+	c.hooks.Job = append(c.hooks.Job, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `job.Intercept(f(g(h())))`.
+func (c *JobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Job = append(c.inters.Job, interceptors...)
+}
+
+// Create returns a builder for creating a Job entity.
+func (c *JobClient) Create() *JobCreate {
+	mutation := newJobMutation(c.config, OpCreate)
+	return &JobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Job entities.
+func (c *JobClient) CreateBulk(builders ...*JobCreate) *JobCreateBulk {
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobClient) MapCreateBulk(slice any, setFunc func(*JobCreate, int)) *JobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobCreateBulk{err: fmt.Errorf("calling to JobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Job.
+func (c *JobClient) Update() *JobUpdate {
+	mutation := newJobMutation(c.config, OpUpdate)
+	return &JobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobClient) UpdateOne(_m *Job) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJob(_m))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobClient) UpdateOneID(id uuid.UUID) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJobID(id))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Job.
+func (c *JobClient) Delete() *JobDelete {
+	mutation := newJobMutation(c.config, OpDelete)
+	return &JobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobClient) DeleteOne(_m *Job) *JobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobClient) DeleteOneID(id uuid.UUID) *JobDeleteOne {
+	builder := c.Delete().Where(job.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobDeleteOne{builder}
+}
+
+// Query returns a query builder for Job.
+func (c *JobClient) Query() *JobQuery {
+	return &JobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Job entity by its id.
+func (c *JobClient) Get(ctx context.Context, id uuid.UUID) (*Job, error) {
+	return c.Query().Where(job.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobClient) GetX(ctx context.Context, id uuid.UUID) *Job {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JobClient) Hooks() []Hook {
+	hooks := c.hooks.Job
+	return append(hooks[:len(hooks):len(hooks)], job.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobClient) Interceptors() []Interceptor {
+	return c.inters.Job
+}
+
+func (c *JobClient) mutate(ctx context.Context, m *JobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Job mutation op: %q", m.Op())
+	}
+}
+
+// LTILaunchClient is a client for the LTILaunch schema.
+type LTILaunchClient struct {
+	config
+}
+
+// NewLTILaunchClient returns a client for the LTILaunch from the given config.
+func NewLTILaunchClient(c config) *LTILaunchClient {
+	return &LTILaunchClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ltilaunch.Hooks(f(g(h())))`.
+func (c *LTILaunchClient) Use(hooks ...Hook) {
+	c.hooks.LTILaunch = append(c.hooks.LTILaunch, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ltilaunch.Intercept(f(g(h())))`.
+func (c *LTILaunchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LTILaunch = append(c.inters.LTILaunch, interceptors...)
+}
+
+// Create returns a builder for creating a LTILaunch entity.
+func (c *LTILaunchClient) Create() *LTILaunchCreate {
+	mutation := newLTILaunchMutation(c.config, OpCreate)
+	return &LTILaunchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LTILaunch entities.
+func (c *LTILaunchClient) CreateBulk(builders ...*LTILaunchCreate) *LTILaunchCreateBulk {
+	return &LTILaunchCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LTILaunchClient) MapCreateBulk(slice any, setFunc func(*LTILaunchCreate, int)) *LTILaunchCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LTILaunchCreateBulk{err: fmt.Errorf("calling to LTILaunchClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LTILaunchCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LTILaunchCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LTILaunch.
+func (c *LTILaunchClient) Update() *LTILaunchUpdate {
+	mutation := newLTILaunchMutation(c.config, OpUpdate)
+	return &LTILaunchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LTILaunchClient) UpdateOne(_m *LTILaunch) *LTILaunchUpdateOne {
+	mutation := newLTILaunchMutation(c.config, OpUpdateOne, withLTILaunch(_m))
+	return &LTILaunchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LTILaunchClient) UpdateOneID(id uuid.UUID) *LTILaunchUpdateOne {
+	mutation := newLTILaunchMutation(c.config, OpUpdateOne, withLTILaunchID(id))
+	return &LTILaunchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LTILaunch.
+func (c *LTILaunchClient) Delete() *LTILaunchDelete {
+	mutation := newLTILaunchMutation(c.config, OpDelete)
+	return &LTILaunchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LTILaunchClient) DeleteOne(_m *LTILaunch) *LTILaunchDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LTILaunchClient) DeleteOneID(id uuid.UUID) *LTILaunchDeleteOne {
+	builder := c.Delete().Where(ltilaunch.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LTILaunchDeleteOne{builder}
+}
+
+// Query returns a query builder for LTILaunch.
+func (c *LTILaunchClient) Query() *LTILaunchQuery {
+	return &LTILaunchQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLTILaunch},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LTILaunch entity by its id.
+func (c *LTILaunchClient) Get(ctx context.Context, id uuid.UUID) (*LTILaunch, error) {
+	return c.Query().Where(ltilaunch.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LTILaunchClient) GetX(ctx context.Context, id uuid.UUID) *LTILaunch {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LTILaunchClient) Hooks() []Hook {
+	hooks := c.hooks.LTILaunch
+	return append(hooks[:len(hooks):len(hooks)], ltilaunch.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LTILaunchClient) Interceptors() []Interceptor {
+	return c.inters.LTILaunch
+}
+
+func (c *LTILaunchClient) mutate(ctx context.Context, m *LTILaunchMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LTILaunchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LTILaunchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LTILaunchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LTILaunchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown LTILaunch mutation op: %q", m.Op())
+	}
+}
+
+// LTILoginStateClient is a client for the LTILoginState schema.
+type LTILoginStateClient struct {
+	config
+}
+
+// NewLTILoginStateClient returns a client for the LTILoginState from the given config.
+func NewLTILoginStateClient(c config) *LTILoginStateClient {
+	return &LTILoginStateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ltiloginstate.Hooks(f(g(h())))`.
+func (c *LTILoginStateClient) Use(hooks ...Hook) {
+	c.hooks.LTILoginState = append(c.hooks.LTILoginState, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ltiloginstate.Intercept(f(g(h())))`.
+func (c *LTILoginStateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LTILoginState = append(c.inters.LTILoginState, interceptors...)
+}
+
+// Create returns a builder for creating a LTILoginState entity.
+func (c *LTILoginStateClient) Create() *LTILoginStateCreate {
+	mutation := newLTILoginStateMutation(c.config, OpCreate)
+	return &LTILoginStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LTILoginState entities.
+func (c *LTILoginStateClient) CreateBulk(builders ...*LTILoginStateCreate) *LTILoginStateCreateBulk {
+	return &LTILoginStateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LTILoginStateClient) MapCreateBulk(slice any, setFunc func(*LTILoginStateCreate, int)) *LTILoginStateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LTILoginStateCreateBulk{err: fmt.Errorf("calling to LTILoginStateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LTILoginStateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LTILoginStateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LTILoginState.
+func (c *LTILoginStateClient) Update() *LTILoginStateUpdate {
+	mutation := newLTILoginStateMutation(c.config, OpUpdate)
+	return &LTILoginStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LTILoginStateClient) UpdateOne(_m *LTILoginState) *LTILoginStateUpdateOne {
+	mutation := newLTILoginStateMutation(c.config, OpUpdateOne, withLTILoginState(_m))
+	return &LTILoginStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LTILoginStateClient) UpdateOneID(id int) *LTILoginStateUpdateOne {
+	mutation := newLTILoginStateMutation(c.config, OpUpdateOne, withLTILoginStateID(id))
+	return &LTILoginStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LTILoginState.
+func (c *LTILoginStateClient) Delete() *LTILoginStateDelete {
+	mutation := newLTILoginStateMutation(c.config, OpDelete)
+	return &LTILoginStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LTILoginStateClient) DeleteOne(_m *LTILoginState) *LTILoginStateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LTILoginStateClient) DeleteOneID(id int) *LTILoginStateDeleteOne {
+	builder := c.Delete().Where(ltiloginstate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LTILoginStateDeleteOne{builder}
+}
+
+// Query returns a query builder for LTILoginState.
+func (c *LTILoginStateClient) Query() *LTILoginStateQuery {
+	return &LTILoginStateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLTILoginState},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LTILoginState entity by its id.
+func (c *LTILoginStateClient) Get(ctx context.Context, id int) (*LTILoginState, error) {
+	return c.Query().Where(ltiloginstate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LTILoginStateClient) GetX(ctx context.Context, id int) *LTILoginState {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LTILoginStateClient) Hooks() []Hook {
+	return c.hooks.LTILoginState
+}
+
+// Interceptors returns the client interceptors.
+func (c *LTILoginStateClient) Interceptors() []Interceptor {
+	return c.inters.LTILoginState
+}
+
+func (c *LTILoginStateClient) mutate(ctx context.Context, m *LTILoginStateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LTILoginStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LTILoginStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LTILoginStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LTILoginStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown LTILoginState mutation op: %q", m.Op())
+	}
+}
+
+// LTIPlatformClient is a client for the LTIPlatform schema.
+type LTIPlatformClient struct {
+	config
+}
+
+// NewLTIPlatformClient returns a client for the LTIPlatform from the given config.
+func NewLTIPlatformClient(c config) *LTIPlatformClient {
+	return &LTIPlatformClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ltiplatform.Hooks(f(g(h())))`.
+func (c *LTIPlatformClient) Use(hooks ...Hook) {
+	c.hooks.LTIPlatform = append(c.hooks.LTIPlatform, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ltiplatform.Intercept(f(g(h())))`.
+func (c *LTIPlatformClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LTIPlatform = append(c.inters.LTIPlatform, interceptors...)
+}
+
+// Create returns a builder for creating a LTIPlatform entity.
+func (c *LTIPlatformClient) Create() *LTIPlatformCreate {
+	mutation := newLTIPlatformMutation(c.config, OpCreate)
+	return &LTIPlatformCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LTIPlatform entities.
+func (c *LTIPlatformClient) CreateBulk(builders ...*LTIPlatformCreate) *LTIPlatformCreateBulk {
+	return &LTIPlatformCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LTIPlatformClient) MapCreateBulk(slice any, setFunc func(*LTIPlatformCreate, int)) *LTIPlatformCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LTIPlatformCreateBulk{err: fmt.Errorf("calling to LTIPlatformClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LTIPlatformCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LTIPlatformCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LTIPlatform.
+func (c *LTIPlatformClient) Update() *LTIPlatformUpdate {
+	mutation := newLTIPlatformMutation(c.config, OpUpdate)
+	return &LTIPlatformUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LTIPlatformClient) UpdateOne(_m *LTIPlatform) *LTIPlatformUpdateOne {
+	mutation := newLTIPlatformMutation(c.config, OpUpdateOne, withLTIPlatform(_m))
+	return &LTIPlatformUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LTIPlatformClient) UpdateOneID(id uuid.UUID) *LTIPlatformUpdateOne {
+	mutation := newLTIPlatformMutation(c.config, OpUpdateOne, withLTIPlatformID(id))
+	return &LTIPlatformUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LTIPlatform.
+func (c *LTIPlatformClient) Delete() *LTIPlatformDelete {
+	mutation := newLTIPlatformMutation(c.config, OpDelete)
+	return &LTIPlatformDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LTIPlatformClient) DeleteOne(_m *LTIPlatform) *LTIPlatformDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LTIPlatformClient) DeleteOneID(id uuid.UUID) *LTIPlatformDeleteOne {
+	builder := c.Delete().Where(ltiplatform.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LTIPlatformDeleteOne{builder}
+}
+
+// Query returns a query builder for LTIPlatform.
+func (c *LTIPlatformClient) Query() *LTIPlatformQuery {
+	return &LTIPlatformQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLTIPlatform},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LTIPlatform entity by its id.
+func (c *LTIPlatformClient) Get(ctx context.Context, id uuid.UUID) (*LTIPlatform, error) {
+	return c.Query().Where(ltiplatform.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LTIPlatformClient) GetX(ctx context.Context, id uuid.UUID) *LTIPlatform {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LTIPlatformClient) Hooks() []Hook {
+	hooks := c.hooks.LTIPlatform
+	return append(hooks[:len(hooks):len(hooks)], ltiplatform.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LTIPlatformClient) Interceptors() []Interceptor {
+	return c.inters.LTIPlatform
+}
+
+func (c *LTIPlatformClient) mutate(ctx context.Context, m *LTIPlatformMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LTIPlatformCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LTIPlatformUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LTIPlatformUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LTIPlatformDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown LTIPlatform mutation op: %q", m.Op())
+	}
+}
+
+// LearnerActivityClient is a client for the LearnerActivity schema.
+type LearnerActivityClient struct {
+	config
+}
+
+// NewLearnerActivityClient returns a client for the LearnerActivity from the given config.
+func NewLearnerActivityClient(c config) *LearnerActivityClient {
+	return &LearnerActivityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `learneractivity.Hooks(f(g(h())))`.
+func (c *LearnerActivityClient) Use(hooks ...Hook) {
+	c.hooks.LearnerActivity = append(c.hooks.LearnerActivity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `learneractivity.Intercept(f(g(h())))`.
+func (c *LearnerActivityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LearnerActivity = append(c.inters.LearnerActivity, interceptors...)
+}
+
+// Create returns a builder for creating a LearnerActivity entity.
+func (c *LearnerActivityClient) Create() *LearnerActivityCreate {
+	mutation := newLearnerActivityMutation(c.config, OpCreate)
+	return &LearnerActivityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LearnerActivity entities.
+func (c *LearnerActivityClient) CreateBulk(builders ...*LearnerActivityCreate) *LearnerActivityCreateBulk {
+	return &LearnerActivityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LearnerActivityClient) MapCreateBulk(slice any, setFunc func(*LearnerActivityCreate, int)) *LearnerActivityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LearnerActivityCreateBulk{err: fmt.Errorf("calling to LearnerActivityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LearnerActivityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LearnerActivityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LearnerActivity.
+func (c *LearnerActivityClient) Update() *LearnerActivityUpdate {
+	mutation := newLearnerActivityMutation(c.config, OpUpdate)
+	return &LearnerActivityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LearnerActivityClient) UpdateOne(_m *LearnerActivity) *LearnerActivityUpdateOne {
+	mutation := newLearnerActivityMutation(c.config, OpUpdateOne, withLearnerActivity(_m))
+	return &LearnerActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LearnerActivityClient) UpdateOneID(id uuid.UUID) *LearnerActivityUpdateOne {
+	mutation := newLearnerActivityMutation(c.config, OpUpdateOne, withLearnerActivityID(id))
+	return &LearnerActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LearnerActivity.
+func (c *LearnerActivityClient) Delete() *LearnerActivityDelete {
+	mutation := newLearnerActivityMutation(c.config, OpDelete)
+	return &LearnerActivityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LearnerActivityClient) DeleteOne(_m *LearnerActivity) *LearnerActivityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LearnerActivityClient) DeleteOneID(id uuid.UUID) *LearnerActivityDeleteOne {
+	builder := c.Delete().Where(learneractivity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LearnerActivityDeleteOne{builder}
+}
+
+// Query returns a query builder for LearnerActivity.
+func (c *LearnerActivityClient) Query() *LearnerActivityQuery {
+	return &LearnerActivityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLearnerActivity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LearnerActivity entity by its id.
+func (c *LearnerActivityClient) Get(ctx context.Context, id uuid.UUID) (*LearnerActivity, error) {
+	return c.Query().Where(learneractivity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LearnerActivityClient) GetX(ctx context.Context, id uuid.UUID) *LearnerActivity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LearnerActivityClient) Hooks() []Hook {
+	hooks := c.hooks.LearnerActivity
+	return append(hooks[:len(hooks):len(hooks)], learneractivity.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LearnerActivityClient) Interceptors() []Interceptor {
+	return c.inters.LearnerActivity
+}
+
+func (c *LearnerActivityClient) mutate(ctx context.Context, m *LearnerActivityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LearnerActivityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LearnerActivityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LearnerActivityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LearnerActivityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown LearnerActivity mutation op: %q", m.Op())
+	}
+}
+
+// ModerationItemClient is a client for the ModerationItem schema.
+type ModerationItemClient struct {
+	config
+}
+
+// NewModerationItemClient returns a client for the ModerationItem from the given config.
+func NewModerationItemClient(c config) *ModerationItemClient {
+	return &ModerationItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `moderationitem.Hooks(f(g(h())))`.
+func (c *ModerationItemClient) Use(hooks ...Hook) {
+	c.hooks.ModerationItem = append(c.hooks.ModerationItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `moderationitem.Intercept(f(g(h())))`.
+func (c *ModerationItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ModerationItem = append(c.inters.ModerationItem, interceptors...)
+}
+
+// Create returns a builder for creating a ModerationItem entity.
+func (c *ModerationItemClient) Create() *ModerationItemCreate {
+	mutation := newModerationItemMutation(c.config, OpCreate)
+	return &ModerationItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ModerationItem entities.
+func (c *ModerationItemClient) CreateBulk(builders ...*ModerationItemCreate) *ModerationItemCreateBulk {
+	return &ModerationItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ModerationItemClient) MapCreateBulk(slice any, setFunc func(*ModerationItemCreate, int)) *ModerationItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ModerationItemCreateBulk{err: fmt.Errorf("calling to ModerationItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ModerationItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ModerationItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ModerationItem.
+func (c *ModerationItemClient) Update() *ModerationItemUpdate {
+	mutation := newModerationItemMutation(c.config, OpUpdate)
+	return &ModerationItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ModerationItemClient) UpdateOne(_m *ModerationItem) *ModerationItemUpdateOne {
+	mutation := newModerationItemMutation(c.config, OpUpdateOne, withModerationItem(_m))
+	return &ModerationItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ModerationItemClient) UpdateOneID(id uuid.UUID) *ModerationItemUpdateOne {
+	mutation := newModerationItemMutation(c.config, OpUpdateOne, withModerationItemID(id))
+	return &ModerationItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ModerationItem.
+func (c *ModerationItemClient) Delete() *ModerationItemDelete {
+	mutation := newModerationItemMutation(c.config, OpDelete)
+	return &ModerationItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ModerationItemClient) DeleteOne(_m *ModerationItem) *ModerationItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ModerationItemClient) DeleteOneID(id uuid.UUID) *ModerationItemDeleteOne {
+	builder := c.Delete().Where(moderationitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ModerationItemDeleteOne{builder}
+}
+
+// Query returns a query builder for ModerationItem.
+func (c *ModerationItemClient) Query() *ModerationItemQuery {
+	return &ModerationItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeModerationItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ModerationItem entity by its id.
+func (c *ModerationItemClient) Get(ctx context.Context, id uuid.UUID) (*ModerationItem, error) {
+	return c.Query().Where(moderationitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ModerationItemClient) GetX(ctx context.Context, id uuid.UUID) *ModerationItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ModerationItemClient) Hooks() []Hook {
+	hooks := c.hooks.ModerationItem
+	return append(hooks[:len(hooks):len(hooks)], moderationitem.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ModerationItemClient) Interceptors() []Interceptor {
+	return c.inters.ModerationItem
+}
+
+func (c *ModerationItemClient) mutate(ctx context.Context, m *ModerationItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ModerationItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ModerationItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ModerationItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ModerationItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown ModerationItem mutation op: %q", m.Op())
+	}
+}
+
+// NotificationClient is a client for the Notification schema.
+type NotificationClient struct {
+	config
+}
+
+// NewNotificationClient returns a client for the Notification from the given config.
+func NewNotificationClient(c config) *NotificationClient {
+	return &NotificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
+func (c *NotificationClient) Use(hooks ...Hook) {
+	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
+func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notification = append(c.inters.Notification, interceptors...)
+}
+
+// Create returns a builder for creating a Notification entity.
+func (c *NotificationClient) Create() *NotificationCreate {
+	mutation := newNotificationMutation(c.config, OpCreate)
+	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Notification entities.
+func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Notification.
+func (c *NotificationClient) Update() *NotificationUpdate {
+	mutation := newNotificationMutation(c.config, OpUpdate)
+	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationClient) UpdateOne(_m *Notification) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(_m))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationClient) UpdateOneID(id uuid.UUID) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Notification.
+func (c *NotificationClient) Delete() *NotificationDelete {
+	mutation := newNotificationMutation(c.config, OpDelete)
+	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationClient) DeleteOne(_m *Notification) *NotificationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationClient) DeleteOneID(id uuid.UUID) *NotificationDeleteOne {
+	builder := c.Delete().Where(notification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationDeleteOne{builder}
+}
+
+// Query returns a query builder for Notification.
+func (c *NotificationClient) Query() *NotificationQuery {
+	return &NotificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Notification entity by its id.
+func (c *NotificationClient) Get(ctx context.Context, id uuid.UUID) (*Notification, error) {
+	return c.Query().Where(notification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationClient) GetX(ctx context.Context, id uuid.UUID) *Notification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationClient) Hooks() []Hook {
+	hooks := c.hooks.Notification
+	return append(hooks[:len(hooks):len(hooks)], notification.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationClient) Interceptors() []Interceptor {
+	return c.inters.Notification
+}
+
+func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Notification mutation op: %q", m.Op())
+	}
+}
+
+// NotificationPreferenceClient is a client for the NotificationPreference schema.
+type NotificationPreferenceClient struct {
+	config
+}
+
+// NewNotificationPreferenceClient returns a client for the NotificationPreference from the given config.
+func NewNotificationPreferenceClient(c config) *NotificationPreferenceClient {
+	return &NotificationPreferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationpreference.Hooks(f(g(h())))`.
+func (c *NotificationPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.NotificationPreference = append(c.hooks.NotificationPreference, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationpreference.Intercept(f(g(h())))`.
+func (c *NotificationPreferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationPreference = append(c.inters.NotificationPreference, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationPreference entity.
+func (c *NotificationPreferenceClient) Create() *NotificationPreferenceCreate {
+	mutation := newNotificationPreferenceMutation(c.config, OpCreate)
+	return &NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationPreference entities.
+func (c *NotificationPreferenceClient) CreateBulk(builders ...*NotificationPreferenceCreate) *NotificationPreferenceCreateBulk {
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationPreferenceClient) MapCreateBulk(slice any, setFunc func(*NotificationPreferenceCreate, int)) *NotificationPreferenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationPreferenceCreateBulk{err: fmt.Errorf("calling to NotificationPreferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationPreferenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Update() *NotificationPreferenceUpdate {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdate)
+	return &NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationPreferenceClient) UpdateOne(_m *NotificationPreference) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreference(_m))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationPreferenceClient) UpdateOneID(id int) *NotificationPreferenceUpdateOne {
+	mutation := newNotificationPreferenceMutation(c.config, OpUpdateOne, withNotificationPreferenceID(id))
+	return &NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Delete() *NotificationPreferenceDelete {
+	mutation := newNotificationPreferenceMutation(c.config, OpDelete)
+	return &NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationPreferenceClient) DeleteOne(_m *NotificationPreference) *NotificationPreferenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationPreferenceClient) DeleteOneID(id int) *NotificationPreferenceDeleteOne {
+	builder := c.Delete().Where(notificationpreference.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationPreferenceDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationPreference.
+func (c *NotificationPreferenceClient) Query() *NotificationPreferenceQuery {
+	return &NotificationPreferenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationPreference},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationPreference entity by its id.
+func (c *NotificationPreferenceClient) Get(ctx context.Context, id int) (*NotificationPreference, error) {
+	return c.Query().Where(notificationpreference.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationPreferenceClient) GetX(ctx context.Context, id int) *NotificationPreference {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationPreferenceClient) Hooks() []Hook {
+	hooks := c.hooks.NotificationPreference
+	return append(hooks[:len(hooks):len(hooks)], notificationpreference.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationPreferenceClient) Interceptors() []Interceptor {
+	return c.inters.NotificationPreference
+}
+
+func (c *NotificationPreferenceClient) mutate(ctx context.Context, m *NotificationPreferenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown NotificationPreference mutation op: %q", m.Op())
+	}
+}
+
+// OutboxMessageClient is a client for the OutboxMessage schema.
+type OutboxMessageClient struct {
+	config
+}
+
+// NewOutboxMessageClient returns a client for the OutboxMessage from the given config.
+func NewOutboxMessageClient(c config) *OutboxMessageClient {
+	return &OutboxMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outboxmessage.Hooks(f(g(h())))`.
+func (c *OutboxMessageClient) Use(hooks ...Hook) {
+	c.hooks.OutboxMessage = append(c.hooks.OutboxMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outboxmessage.Intercept(f(g(h())))`.
+func (c *OutboxMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutboxMessage = append(c.inters.OutboxMessage, interceptors...)
+}
+
+// Create returns a builder for creating a OutboxMessage entity.
+func (c *OutboxMessageClient) Create() *OutboxMessageCreate {
+	mutation := newOutboxMessageMutation(c.config, OpCreate)
+	return &OutboxMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutboxMessage entities.
+func (c *OutboxMessageClient) CreateBulk(builders ...*OutboxMessageCreate) *OutboxMessageCreateBulk {
+	return &OutboxMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutboxMessageClient) MapCreateBulk(slice any, setFunc func(*OutboxMessageCreate, int)) *OutboxMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutboxMessageCreateBulk{err: fmt.Errorf("calling to OutboxMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutboxMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutboxMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutboxMessage.
+func (c *OutboxMessageClient) Update() *OutboxMessageUpdate {
+	mutation := newOutboxMessageMutation(c.config, OpUpdate)
+	return &OutboxMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxMessageClient) UpdateOne(_m *OutboxMessage) *OutboxMessageUpdateOne {
+	mutation := newOutboxMessageMutation(c.config, OpUpdateOne, withOutboxMessage(_m))
+	return &OutboxMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxMessageClient) UpdateOneID(id uuid.UUID) *OutboxMessageUpdateOne {
+	mutation := newOutboxMessageMutation(c.config, OpUpdateOne, withOutboxMessageID(id))
+	return &OutboxMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutboxMessage.
+func (c *OutboxMessageClient) Delete() *OutboxMessageDelete {
+	mutation := newOutboxMessageMutation(c.config, OpDelete)
+	return &OutboxMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxMessageClient) DeleteOne(_m *OutboxMessage) *OutboxMessageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxMessageClient) DeleteOneID(id uuid.UUID) *OutboxMessageDeleteOne {
+	builder := c.Delete().Where(outboxmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for OutboxMessage.
+func (c *OutboxMessageClient) Query() *OutboxMessageQuery {
+	return &OutboxMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutboxMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OutboxMessage entity by its id.
+func (c *OutboxMessageClient) Get(ctx context.Context, id uuid.UUID) (*OutboxMessage, error) {
+	return c.Query().Where(outboxmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxMessageClient) GetX(ctx context.Context, id uuid.UUID) *OutboxMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxMessageClient) Hooks() []Hook {
+	return c.hooks.OutboxMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxMessageClient) Interceptors() []Interceptor {
+	return c.inters.OutboxMessage
+}
+
+func (c *OutboxMessageClient) mutate(ctx context.Context, m *OutboxMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown OutboxMessage mutation op: %q", m.Op())
+	}
+}
+
+// PlanClient is a client for the Plan schema.
+type PlanClient struct {
+	config
+}
+
+// NewPlanClient returns a client for the Plan from the given config.
+func NewPlanClient(c config) *PlanClient {
+	return &PlanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `plan.Hooks(f(g(h())))`.
+func (c *PlanClient) Use(hooks ...Hook) {
+	c.hooks.Plan = append(c.hooks.Plan, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `plan.Intercept(f(g(h())))`.
+func (c *PlanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Plan = append(c.inters.Plan, interceptors...)
+}
+
+// Create returns a builder for creating a Plan entity.
+func (c *PlanClient) Create() *PlanCreate {
+	mutation := newPlanMutation(c.config, OpCreate)
+	return &PlanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Plan entities.
+func (c *PlanClient) CreateBulk(builders ...*PlanCreate) *PlanCreateBulk {
+	return &PlanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlanClient) MapCreateBulk(slice any, setFunc func(*PlanCreate, int)) *PlanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlanCreateBulk{err: fmt.Errorf("calling to PlanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Plan.
+func (c *PlanClient) Update() *PlanUpdate {
+	mutation := newPlanMutation(c.config, OpUpdate)
+	return &PlanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlanClient) UpdateOne(_m *Plan) *PlanUpdateOne {
+	mutation := newPlanMutation(c.config, OpUpdateOne, withPlan(_m))
+	return &PlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlanClient) UpdateOneID(id uuid.UUID) *PlanUpdateOne {
+	mutation := newPlanMutation(c.config, OpUpdateOne, withPlanID(id))
+	return &PlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Plan.
+func (c *PlanClient) Delete() *PlanDelete {
+	mutation := newPlanMutation(c.config, OpDelete)
+	return &PlanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlanClient) DeleteOne(_m *Plan) *PlanDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlanClient) DeleteOneID(id uuid.UUID) *PlanDeleteOne {
+	builder := c.Delete().Where(plan.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlanDeleteOne{builder}
+}
+
+// Query returns a query builder for Plan.
+func (c *PlanClient) Query() *PlanQuery {
+	return &PlanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Plan entity by its id.
+func (c *PlanClient) Get(ctx context.Context, id uuid.UUID) (*Plan, error) {
+	return c.Query().Where(plan.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlanClient) GetX(ctx context.Context, id uuid.UUID) *Plan {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PlanClient) Hooks() []Hook {
+	hooks := c.hooks.Plan
+	return append(hooks[:len(hooks):len(hooks)], plan.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlanClient) Interceptors() []Interceptor {
+	return c.inters.Plan
+}
+
+func (c *PlanClient) mutate(ctx context.Context, m *PlanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Plan mutation op: %q", m.Op())
+	}
+}
+
+// PlaybackSessionClient is a client for the PlaybackSession schema.
+type PlaybackSessionClient struct {
+	config
+}
+
+// NewPlaybackSessionClient returns a client for the PlaybackSession from the given config.
+func NewPlaybackSessionClient(c config) *PlaybackSessionClient {
+	return &PlaybackSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `playbacksession.Hooks(f(g(h())))`.
+func (c *PlaybackSessionClient) Use(hooks ...Hook) {
+	c.hooks.PlaybackSession = append(c.hooks.PlaybackSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `playbacksession.Intercept(f(g(h())))`.
+func (c *PlaybackSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PlaybackSession = append(c.inters.PlaybackSession, interceptors...)
+}
+
+// Create returns a builder for creating a PlaybackSession entity.
+func (c *PlaybackSessionClient) Create() *PlaybackSessionCreate {
+	mutation := newPlaybackSessionMutation(c.config, OpCreate)
+	return &PlaybackSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PlaybackSession entities.
+func (c *PlaybackSessionClient) CreateBulk(builders ...*PlaybackSessionCreate) *PlaybackSessionCreateBulk {
+	return &PlaybackSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlaybackSessionClient) MapCreateBulk(slice any, setFunc func(*PlaybackSessionCreate, int)) *PlaybackSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlaybackSessionCreateBulk{err: fmt.Errorf("calling to PlaybackSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlaybackSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlaybackSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PlaybackSession.
+func (c *PlaybackSessionClient) Update() *PlaybackSessionUpdate {
+	mutation := newPlaybackSessionMutation(c.config, OpUpdate)
+	return &PlaybackSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlaybackSessionClient) UpdateOne(_m *PlaybackSession) *PlaybackSessionUpdateOne {
+	mutation := newPlaybackSessionMutation(c.config, OpUpdateOne, withPlaybackSession(_m))
+	return &PlaybackSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlaybackSessionClient) UpdateOneID(id uuid.UUID) *PlaybackSessionUpdateOne {
+	mutation := newPlaybackSessionMutation(c.config, OpUpdateOne, withPlaybackSessionID(id))
+	return &PlaybackSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PlaybackSession.
+func (c *PlaybackSessionClient) Delete() *PlaybackSessionDelete {
+	mutation := newPlaybackSessionMutation(c.config, OpDelete)
+	return &PlaybackSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlaybackSessionClient) DeleteOne(_m *PlaybackSession) *PlaybackSessionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlaybackSessionClient) DeleteOneID(id uuid.UUID) *PlaybackSessionDeleteOne {
+	builder := c.Delete().Where(playbacksession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlaybackSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for PlaybackSession.
+func (c *PlaybackSessionClient) Query() *PlaybackSessionQuery {
+	return &PlaybackSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlaybackSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PlaybackSession entity by its id.
+func (c *PlaybackSessionClient) Get(ctx context.Context, id uuid.UUID) (*PlaybackSession, error) {
+	return c.Query().Where(playbacksession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlaybackSessionClient) GetX(ctx context.Context, id uuid.UUID) *PlaybackSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PlaybackSessionClient) Hooks() []Hook {
+	hooks := c.hooks.PlaybackSession
+	return append(hooks[:len(hooks):len(hooks)], playbacksession.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlaybackSessionClient) Interceptors() []Interceptor {
+	return c.inters.PlaybackSession
+}
+
+func (c *PlaybackSessionClient) mutate(ctx context.Context, m *PlaybackSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlaybackSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlaybackSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlaybackSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlaybackSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown PlaybackSession mutation op: %q", m.Op())
+	}
+}
+
+// PlaylistClient is a client for the Playlist schema.
+type PlaylistClient struct {
+	config
+}
+
+// NewPlaylistClient returns a client for the Playlist from the given config.
+func NewPlaylistClient(c config) *PlaylistClient {
+	return &PlaylistClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `playlist.Hooks(f(g(h())))`.
+func (c *PlaylistClient) Use(hooks ...Hook) {
+	c.hooks.Playlist = append(c.hooks.Playlist, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `playlist.Intercept(f(g(h())))`.
+func (c *PlaylistClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Playlist = append(c.inters.Playlist, interceptors...)
+}
+
+// Create returns a builder for creating a Playlist entity.
+func (c *PlaylistClient) Create() *PlaylistCreate {
+	mutation := newPlaylistMutation(c.config, OpCreate)
+	return &PlaylistCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Playlist entities.
+func (c *PlaylistClient) CreateBulk(builders ...*PlaylistCreate) *PlaylistCreateBulk {
+	return &PlaylistCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlaylistClient) MapCreateBulk(slice any, setFunc func(*PlaylistCreate, int)) *PlaylistCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlaylistCreateBulk{err: fmt.Errorf("calling to PlaylistClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlaylistCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlaylistCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Playlist.
+func (c *PlaylistClient) Update() *PlaylistUpdate {
+	mutation := newPlaylistMutation(c.config, OpUpdate)
+	return &PlaylistUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlaylistClient) UpdateOne(_m *Playlist) *PlaylistUpdateOne {
+	mutation := newPlaylistMutation(c.config, OpUpdateOne, withPlaylist(_m))
+	return &PlaylistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlaylistClient) UpdateOneID(id uuid.UUID) *PlaylistUpdateOne {
+	mutation := newPlaylistMutation(c.config, OpUpdateOne, withPlaylistID(id))
+	return &PlaylistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Playlist.
+func (c *PlaylistClient) Delete() *PlaylistDelete {
+	mutation := newPlaylistMutation(c.config, OpDelete)
+	return &PlaylistDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlaylistClient) DeleteOne(_m *Playlist) *PlaylistDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlaylistClient) DeleteOneID(id uuid.UUID) *PlaylistDeleteOne {
+	builder := c.Delete().Where(playlist.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlaylistDeleteOne{builder}
+}
+
+// Query returns a query builder for Playlist.
+func (c *PlaylistClient) Query() *PlaylistQuery {
+	return &PlaylistQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlaylist},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Playlist entity by its id.
+func (c *PlaylistClient) Get(ctx context.Context, id uuid.UUID) (*Playlist, error) {
+	return c.Query().Where(playlist.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlaylistClient) GetX(ctx context.Context, id uuid.UUID) *Playlist {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItems queries the items edge of a Playlist.
+func (c *PlaylistClient) QueryItems(_m *Playlist) *PlaylistItemQuery {
+	query := (&PlaylistItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlist.Table, playlist.FieldID, id),
+			sqlgraph.To(playlistitem.Table, playlistitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, playlist.ItemsTable, playlist.ItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlaylistClient) Hooks() []Hook {
+	hooks := c.hooks.Playlist
+	return append(hooks[:len(hooks):len(hooks)], playlist.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlaylistClient) Interceptors() []Interceptor {
+	return c.inters.Playlist
+}
+
+func (c *PlaylistClient) mutate(ctx context.Context, m *PlaylistMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlaylistCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlaylistUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlaylistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlaylistDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Playlist mutation op: %q", m.Op())
+	}
+}
+
+// PlaylistItemClient is a client for the PlaylistItem schema.
+type PlaylistItemClient struct {
+	config
+}
+
+// NewPlaylistItemClient returns a client for the PlaylistItem from the given config.
+func NewPlaylistItemClient(c config) *PlaylistItemClient {
+	return &PlaylistItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `playlistitem.Hooks(f(g(h())))`.
+func (c *PlaylistItemClient) Use(hooks ...Hook) {
+	c.hooks.PlaylistItem = append(c.hooks.PlaylistItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `playlistitem.Intercept(f(g(h())))`.
+func (c *PlaylistItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PlaylistItem = append(c.inters.PlaylistItem, interceptors...)
+}
+
+// Create returns a builder for creating a PlaylistItem entity.
+func (c *PlaylistItemClient) Create() *PlaylistItemCreate {
+	mutation := newPlaylistItemMutation(c.config, OpCreate)
+	return &PlaylistItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PlaylistItem entities.
+func (c *PlaylistItemClient) CreateBulk(builders ...*PlaylistItemCreate) *PlaylistItemCreateBulk {
+	return &PlaylistItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlaylistItemClient) MapCreateBulk(slice any, setFunc func(*PlaylistItemCreate, int)) *PlaylistItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlaylistItemCreateBulk{err: fmt.Errorf("calling to PlaylistItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlaylistItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlaylistItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PlaylistItem.
+func (c *PlaylistItemClient) Update() *PlaylistItemUpdate {
+	mutation := newPlaylistItemMutation(c.config, OpUpdate)
+	return &PlaylistItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlaylistItemClient) UpdateOne(_m *PlaylistItem) *PlaylistItemUpdateOne {
+	mutation := newPlaylistItemMutation(c.config, OpUpdateOne, withPlaylistItem(_m))
+	return &PlaylistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlaylistItemClient) UpdateOneID(id uuid.UUID) *PlaylistItemUpdateOne {
+	mutation := newPlaylistItemMutation(c.config, OpUpdateOne, withPlaylistItemID(id))
+	return &PlaylistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PlaylistItem.
+func (c *PlaylistItemClient) Delete() *PlaylistItemDelete {
+	mutation := newPlaylistItemMutation(c.config, OpDelete)
+	return &PlaylistItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlaylistItemClient) DeleteOne(_m *PlaylistItem) *PlaylistItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlaylistItemClient) DeleteOneID(id uuid.UUID) *PlaylistItemDeleteOne {
+	builder := c.Delete().Where(playlistitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlaylistItemDeleteOne{builder}
+}
+
+// Query returns a query builder for PlaylistItem.
+func (c *PlaylistItemClient) Query() *PlaylistItemQuery {
+	return &PlaylistItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlaylistItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PlaylistItem entity by its id.
+func (c *PlaylistItemClient) Get(ctx context.Context, id uuid.UUID) (*PlaylistItem, error) {
+	return c.Query().Where(playlistitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlaylistItemClient) GetX(ctx context.Context, id uuid.UUID) *PlaylistItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlaylist queries the playlist edge of a PlaylistItem.
+func (c *PlaylistItemClient) QueryPlaylist(_m *PlaylistItem) *PlaylistQuery {
+	query := (&PlaylistClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlistitem.Table, playlistitem.FieldID, id),
+			sqlgraph.To(playlist.Table, playlist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, playlistitem.PlaylistTable, playlistitem.PlaylistColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEpisode queries the episode edge of a PlaylistItem.
+func (c *PlaylistItemClient) QueryEpisode(_m *PlaylistItem) *EpisodeQuery {
+	query := (&EpisodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(playlistitem.Table, playlistitem.FieldID, id),
+			sqlgraph.To(episode.Table, episode.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, playlistitem.EpisodeTable, playlistitem.EpisodeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlaylistItemClient) Hooks() []Hook {
+	return c.hooks.PlaylistItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlaylistItemClient) Interceptors() []Interceptor {
+	return c.inters.PlaylistItem
+}
+
+func (c *PlaylistItemClient) mutate(ctx context.Context, m *PlaylistItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlaylistItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlaylistItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlaylistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlaylistItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown PlaylistItem mutation op: %q", m.Op())
+	}
+}
+
+// QuizItemClient is a client for the QuizItem schema.
+type QuizItemClient struct {
+	config
+}
+
+// NewQuizItemClient returns a client for the QuizItem from the given config.
+func NewQuizItemClient(c config) *QuizItemClient {
+	return &QuizItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `quizitem.Hooks(f(g(h())))`.
+func (c *QuizItemClient) Use(hooks ...Hook) {
+	c.hooks.QuizItem = append(c.hooks.QuizItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `quizitem.Intercept(f(g(h())))`.
+func (c *QuizItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QuizItem = append(c.inters.QuizItem, interceptors...)
+}
+
+// Create returns a builder for creating a QuizItem entity.
+func (c *QuizItemClient) Create() *QuizItemCreate {
+	mutation := newQuizItemMutation(c.config, OpCreate)
+	return &QuizItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QuizItem entities.
+func (c *QuizItemClient) CreateBulk(builders ...*QuizItemCreate) *QuizItemCreateBulk {
+	return &QuizItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QuizItemClient) MapCreateBulk(slice any, setFunc func(*QuizItemCreate, int)) *QuizItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QuizItemCreateBulk{err: fmt.Errorf("calling to QuizItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QuizItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QuizItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QuizItem.
+func (c *QuizItemClient) Update() *QuizItemUpdate {
+	mutation := newQuizItemMutation(c.config, OpUpdate)
+	return &QuizItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QuizItemClient) UpdateOne(_m *QuizItem) *QuizItemUpdateOne {
+	mutation := newQuizItemMutation(c.config, OpUpdateOne, withQuizItem(_m))
+	return &QuizItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QuizItemClient) UpdateOneID(id uuid.UUID) *QuizItemUpdateOne {
+	mutation := newQuizItemMutation(c.config, OpUpdateOne, withQuizItemID(id))
+	return &QuizItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QuizItem.
+func (c *QuizItemClient) Delete() *QuizItemDelete {
+	mutation := newQuizItemMutation(c.config, OpDelete)
+	return &QuizItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QuizItemClient) DeleteOne(_m *QuizItem) *QuizItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuizItemClient) DeleteOneID(id uuid.UUID) *QuizItemDeleteOne {
+	builder := c.Delete().Where(quizitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuizItemDeleteOne{builder}
+}
+
+// Query returns a query builder for QuizItem.
+func (c *QuizItemClient) Query() *QuizItemQuery {
+	return &QuizItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuizItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a QuizItem entity by its id.
+func (c *QuizItemClient) Get(ctx context.Context, id uuid.UUID) (*QuizItem, error) {
+	return c.Query().Where(quizitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuizItemClient) GetX(ctx context.Context, id uuid.UUID) *QuizItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *QuizItemClient) Hooks() []Hook {
+	hooks := c.hooks.QuizItem
+	return append(hooks[:len(hooks):len(hooks)], quizitem.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuizItemClient) Interceptors() []Interceptor {
+	return c.inters.QuizItem
+}
+
+func (c *QuizItemClient) mutate(ctx context.Context, m *QuizItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuizItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuizItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuizItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuizItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown QuizItem mutation op: %q", m.Op())
+	}
+}
+
+// ScheduledTaskClient is a client for the ScheduledTask schema.
+type ScheduledTaskClient struct {
+	config
+}
+
+// NewScheduledTaskClient returns a client for the ScheduledTask from the given config.
+func NewScheduledTaskClient(c config) *ScheduledTaskClient {
+	return &ScheduledTaskClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scheduledtask.Hooks(f(g(h())))`.
+func (c *ScheduledTaskClient) Use(hooks ...Hook) {
+	c.hooks.ScheduledTask = append(c.hooks.ScheduledTask, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scheduledtask.Intercept(f(g(h())))`.
+func (c *ScheduledTaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScheduledTask = append(c.inters.ScheduledTask, interceptors...)
+}
+
+// Create returns a builder for creating a ScheduledTask entity.
+func (c *ScheduledTaskClient) Create() *ScheduledTaskCreate {
+	mutation := newScheduledTaskMutation(c.config, OpCreate)
+	return &ScheduledTaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScheduledTask entities.
+func (c *ScheduledTaskClient) CreateBulk(builders ...*ScheduledTaskCreate) *ScheduledTaskCreateBulk {
+	return &ScheduledTaskCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScheduledTaskClient) MapCreateBulk(slice any, setFunc func(*ScheduledTaskCreate, int)) *ScheduledTaskCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScheduledTaskCreateBulk{err: fmt.Errorf("calling to ScheduledTaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScheduledTaskCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScheduledTaskCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScheduledTask.
+func (c *ScheduledTaskClient) Update() *ScheduledTaskUpdate {
+	mutation := newScheduledTaskMutation(c.config, OpUpdate)
+	return &ScheduledTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScheduledTaskClient) UpdateOne(_m *ScheduledTask) *ScheduledTaskUpdateOne {
+	mutation := newScheduledTaskMutation(c.config, OpUpdateOne, withScheduledTask(_m))
+	return &ScheduledTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScheduledTaskClient) UpdateOneID(id uuid.UUID) *ScheduledTaskUpdateOne {
+	mutation := newScheduledTaskMutation(c.config, OpUpdateOne, withScheduledTaskID(id))
+	return &ScheduledTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScheduledTask.
+func (c *ScheduledTaskClient) Delete() *ScheduledTaskDelete {
+	mutation := newScheduledTaskMutation(c.config, OpDelete)
+	return &ScheduledTaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScheduledTaskClient) DeleteOne(_m *ScheduledTask) *ScheduledTaskDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScheduledTaskClient) DeleteOneID(id uuid.UUID) *ScheduledTaskDeleteOne {
+	builder := c.Delete().Where(scheduledtask.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScheduledTaskDeleteOne{builder}
+}
+
+// Query returns a query builder for ScheduledTask.
+func (c *ScheduledTaskClient) Query() *ScheduledTaskQuery {
+	return &ScheduledTaskQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScheduledTask},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScheduledTask entity by its id.
+func (c *ScheduledTaskClient) Get(ctx context.Context, id uuid.UUID) (*ScheduledTask, error) {
+	return c.Query().Where(scheduledtask.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScheduledTaskClient) GetX(ctx context.Context, id uuid.UUID) *ScheduledTask {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ScheduledTaskClient) Hooks() []Hook {
+	hooks := c.hooks.ScheduledTask
+	return append(hooks[:len(hooks):len(hooks)], scheduledtask.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScheduledTaskClient) Interceptors() []Interceptor {
+	return c.inters.ScheduledTask
+}
+
+func (c *ScheduledTaskClient) mutate(ctx context.Context, m *ScheduledTaskMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScheduledTaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScheduledTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScheduledTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScheduledTaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown ScheduledTask mutation op: %q", m.Op())
+	}
+}
+
+// SeriesClient is a client for the Series schema.
+type SeriesClient struct {
+	config
+}
+
+// NewSeriesClient returns a client for the Series from the given config.
+func NewSeriesClient(c config) *SeriesClient {
+	return &SeriesClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `series.Hooks(f(g(h())))`.
+func (c *SeriesClient) Use(hooks ...Hook) {
+	c.hooks.Series = append(c.hooks.Series, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `series.Intercept(f(g(h())))`.
+func (c *SeriesClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Series = append(c.inters.Series, interceptors...)
+}
+
+// Create returns a builder for creating a Series entity.
+func (c *SeriesClient) Create() *SeriesCreate {
+	mutation := newSeriesMutation(c.config, OpCreate)
+	return &SeriesCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Series entities.
+func (c *SeriesClient) CreateBulk(builders ...*SeriesCreate) *SeriesCreateBulk {
+	return &SeriesCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SeriesClient) MapCreateBulk(slice any, setFunc func(*SeriesCreate, int)) *SeriesCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SeriesCreateBulk{err: fmt.Errorf("calling to SeriesClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SeriesCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SeriesCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Series.
+func (c *SeriesClient) Update() *SeriesUpdate {
+	mutation := newSeriesMutation(c.config, OpUpdate)
+	return &SeriesUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SeriesClient) UpdateOne(_m *Series) *SeriesUpdateOne {
+	mutation := newSeriesMutation(c.config, OpUpdateOne, withSeries(_m))
+	return &SeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SeriesClient) UpdateOneID(id uuid.UUID) *SeriesUpdateOne {
+	mutation := newSeriesMutation(c.config, OpUpdateOne, withSeriesID(id))
+	return &SeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Series.
+func (c *SeriesClient) Delete() *SeriesDelete {
+	mutation := newSeriesMutation(c.config, OpDelete)
+	return &SeriesDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SeriesClient) DeleteOne(_m *Series) *SeriesDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SeriesClient) DeleteOneID(id uuid.UUID) *SeriesDeleteOne {
+	builder := c.Delete().Where(series.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SeriesDeleteOne{builder}
+}
+
+// Query returns a query builder for Series.
+func (c *SeriesClient) Query() *SeriesQuery {
+	return &SeriesQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSeries},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Series entity by its id.
+func (c *SeriesClient) Get(ctx context.Context, id uuid.UUID) (*Series, error) {
+	return c.Query().Where(series.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SeriesClient) GetX(ctx context.Context, id uuid.UUID) *Series {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEpisodes queries the episodes edge of a Series.
+func (c *SeriesClient) QueryEpisodes(_m *Series) *EpisodeQuery {
+	query := (&EpisodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(series.Table, series.FieldID, id),
+			sqlgraph.To(episode.Table, episode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, series.EpisodesTable, series.EpisodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SeriesClient) Hooks() []Hook {
+	hooks := c.hooks.Series
+	return append(hooks[:len(hooks):len(hooks)], series.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SeriesClient) Interceptors() []Interceptor {
+	inters := c.inters.Series
+	return append(inters[:len(inters):len(inters)], series.Interceptors[:]...)
+}
+
+func (c *SeriesClient) mutate(ctx context.Context, m *SeriesMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SeriesCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SeriesUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SeriesUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SeriesDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Series mutation op: %q", m.Op())
+	}
+}
+
+// ShadowingSubmissionClient is a client for the ShadowingSubmission schema.
+type ShadowingSubmissionClient struct {
+	config
+}
+
+// NewShadowingSubmissionClient returns a client for the ShadowingSubmission from the given config.
+func NewShadowingSubmissionClient(c config) *ShadowingSubmissionClient {
+	return &ShadowingSubmissionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `shadowingsubmission.Hooks(f(g(h())))`.
+func (c *ShadowingSubmissionClient) Use(hooks ...Hook) {
+	c.hooks.ShadowingSubmission = append(c.hooks.ShadowingSubmission, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `shadowingsubmission.Intercept(f(g(h())))`.
+func (c *ShadowingSubmissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ShadowingSubmission = append(c.inters.ShadowingSubmission, interceptors...)
+}
+
+// Create returns a builder for creating a ShadowingSubmission entity.
+func (c *ShadowingSubmissionClient) Create() *ShadowingSubmissionCreate {
+	mutation := newShadowingSubmissionMutation(c.config, OpCreate)
+	return &ShadowingSubmissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ShadowingSubmission entities.
+func (c *ShadowingSubmissionClient) CreateBulk(builders ...*ShadowingSubmissionCreate) *ShadowingSubmissionCreateBulk {
+	return &ShadowingSubmissionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ShadowingSubmissionClient) MapCreateBulk(slice any, setFunc func(*ShadowingSubmissionCreate, int)) *ShadowingSubmissionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ShadowingSubmissionCreateBulk{err: fmt.Errorf("calling to ShadowingSubmissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ShadowingSubmissionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ShadowingSubmissionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ShadowingSubmission.
+func (c *ShadowingSubmissionClient) Update() *ShadowingSubmissionUpdate {
+	mutation := newShadowingSubmissionMutation(c.config, OpUpdate)
+	return &ShadowingSubmissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShadowingSubmissionClient) UpdateOne(_m *ShadowingSubmission) *ShadowingSubmissionUpdateOne {
+	mutation := newShadowingSubmissionMutation(c.config, OpUpdateOne, withShadowingSubmission(_m))
+	return &ShadowingSubmissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShadowingSubmissionClient) UpdateOneID(id uuid.UUID) *ShadowingSubmissionUpdateOne {
+	mutation := newShadowingSubmissionMutation(c.config, OpUpdateOne, withShadowingSubmissionID(id))
+	return &ShadowingSubmissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ShadowingSubmission.
+func (c *ShadowingSubmissionClient) Delete() *ShadowingSubmissionDelete {
+	mutation := newShadowingSubmissionMutation(c.config, OpDelete)
+	return &ShadowingSubmissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ShadowingSubmissionClient) DeleteOne(_m *ShadowingSubmission) *ShadowingSubmissionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ShadowingSubmissionClient) DeleteOneID(id uuid.UUID) *ShadowingSubmissionDeleteOne {
+	builder := c.Delete().Where(shadowingsubmission.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShadowingSubmissionDeleteOne{builder}
+}
+
+// Query returns a query builder for ShadowingSubmission.
+func (c *ShadowingSubmissionClient) Query() *ShadowingSubmissionQuery {
+	return &ShadowingSubmissionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShadowingSubmission},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ShadowingSubmission entity by its id.
+func (c *ShadowingSubmissionClient) Get(ctx context.Context, id uuid.UUID) (*ShadowingSubmission, error) {
+	return c.Query().Where(shadowingsubmission.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShadowingSubmissionClient) GetX(ctx context.Context, id uuid.UUID) *ShadowingSubmission {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ShadowingSubmissionClient) Hooks() []Hook {
+	hooks := c.hooks.ShadowingSubmission
+	return append(hooks[:len(hooks):len(hooks)], shadowingsubmission.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ShadowingSubmissionClient) Interceptors() []Interceptor {
+	return c.inters.ShadowingSubmission
+}
+
+func (c *ShadowingSubmissionClient) mutate(ctx context.Context, m *ShadowingSubmissionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ShadowingSubmissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ShadowingSubmissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ShadowingSubmissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ShadowingSubmissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown ShadowingSubmission mutation op: %q", m.Op())
+	}
+}
+
+// SubscriptionClient is a client for the Subscription schema.
+type SubscriptionClient struct {
+	config
+}
+
+// NewSubscriptionClient returns a client for the Subscription from the given config.
+func NewSubscriptionClient(c config) *SubscriptionClient {
+	return &SubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `subscription.Hooks(f(g(h())))`.
+func (c *SubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.Subscription = append(c.hooks.Subscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `subscription.Intercept(f(g(h())))`.
+func (c *SubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Subscription = append(c.inters.Subscription, interceptors...)
+}
+
+// Create returns a builder for creating a Subscription entity.
+func (c *SubscriptionClient) Create() *SubscriptionCreate {
+	mutation := newSubscriptionMutation(c.config, OpCreate)
+	return &SubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Subscription entities.
+func (c *SubscriptionClient) CreateBulk(builders ...*SubscriptionCreate) *SubscriptionCreateBulk {
+	return &SubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SubscriptionClient) MapCreateBulk(slice any, setFunc func(*SubscriptionCreate, int)) *SubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SubscriptionCreateBulk{err: fmt.Errorf("calling to SubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Subscription.
+func (c *SubscriptionClient) Update() *SubscriptionUpdate {
+	mutation := newSubscriptionMutation(c.config, OpUpdate)
+	return &SubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SubscriptionClient) UpdateOne(_m *Subscription) *SubscriptionUpdateOne {
+	mutation := newSubscriptionMutation(c.config, OpUpdateOne, withSubscription(_m))
+	return &SubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SubscriptionClient) UpdateOneID(id uuid.UUID) *SubscriptionUpdateOne {
+	mutation := newSubscriptionMutation(c.config, OpUpdateOne, withSubscriptionID(id))
+	return &SubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Subscription.
+func (c *SubscriptionClient) Delete() *SubscriptionDelete {
+	mutation := newSubscriptionMutation(c.config, OpDelete)
+	return &SubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SubscriptionClient) DeleteOne(_m *Subscription) *SubscriptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SubscriptionClient) DeleteOneID(id uuid.UUID) *SubscriptionDeleteOne {
+	builder := c.Delete().Where(subscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for Subscription.
+func (c *SubscriptionClient) Query() *SubscriptionQuery {
+	return &SubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Subscription entity by its id.
+func (c *SubscriptionClient) Get(ctx context.Context, id uuid.UUID) (*Subscription, error) {
+	return c.Query().Where(subscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SubscriptionClient) GetX(ctx context.Context, id uuid.UUID) *Subscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SubscriptionClient) Hooks() []Hook {
+	hooks := c.hooks.Subscription
+	return append(hooks[:len(hooks):len(hooks)], subscription.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.Subscription
+}
+
+func (c *SubscriptionClient) mutate(ctx context.Context, m *SubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown Subscription mutation op: %q", m.Op())
+	}
+}
+
+// TranscriptReplaceJobClient is a client for the TranscriptReplaceJob schema.
+type TranscriptReplaceJobClient struct {
+	config
+}
+
+// NewTranscriptReplaceJobClient returns a client for the TranscriptReplaceJob from the given config.
+func NewTranscriptReplaceJobClient(c config) *TranscriptReplaceJobClient {
+	return &TranscriptReplaceJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `transcriptreplacejob.Hooks(f(g(h())))`.
+func (c *TranscriptReplaceJobClient) Use(hooks ...Hook) {
+	c.hooks.TranscriptReplaceJob = append(c.hooks.TranscriptReplaceJob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `transcriptreplacejob.Intercept(f(g(h())))`.
+func (c *TranscriptReplaceJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TranscriptReplaceJob = append(c.inters.TranscriptReplaceJob, interceptors...)
+}
+
+// Create returns a builder for creating a TranscriptReplaceJob entity.
+func (c *TranscriptReplaceJobClient) Create() *TranscriptReplaceJobCreate {
+	mutation := newTranscriptReplaceJobMutation(c.config, OpCreate)
+	return &TranscriptReplaceJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TranscriptReplaceJob entities.
+func (c *TranscriptReplaceJobClient) CreateBulk(builders ...*TranscriptReplaceJobCreate) *TranscriptReplaceJobCreateBulk {
+	return &TranscriptReplaceJobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TranscriptReplaceJobClient) MapCreateBulk(slice any, setFunc func(*TranscriptReplaceJobCreate, int)) *TranscriptReplaceJobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TranscriptReplaceJobCreateBulk{err: fmt.Errorf("calling to TranscriptReplaceJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TranscriptReplaceJobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TranscriptReplaceJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TranscriptReplaceJob.
+func (c *TranscriptReplaceJobClient) Update() *TranscriptReplaceJobUpdate {
+	mutation := newTranscriptReplaceJobMutation(c.config, OpUpdate)
+	return &TranscriptReplaceJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TranscriptReplaceJobClient) UpdateOne(_m *TranscriptReplaceJob) *TranscriptReplaceJobUpdateOne {
+	mutation := newTranscriptReplaceJobMutation(c.config, OpUpdateOne, withTranscriptReplaceJob(_m))
+	return &TranscriptReplaceJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TranscriptReplaceJobClient) UpdateOneID(id uuid.UUID) *TranscriptReplaceJobUpdateOne {
+	mutation := newTranscriptReplaceJobMutation(c.config, OpUpdateOne, withTranscriptReplaceJobID(id))
+	return &TranscriptReplaceJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TranscriptReplaceJob.
+func (c *TranscriptReplaceJobClient) Delete() *TranscriptReplaceJobDelete {
+	mutation := newTranscriptReplaceJobMutation(c.config, OpDelete)
+	return &TranscriptReplaceJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TranscriptReplaceJobClient) DeleteOne(_m *TranscriptReplaceJob) *TranscriptReplaceJobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TranscriptReplaceJobClient) DeleteOneID(id uuid.UUID) *TranscriptReplaceJobDeleteOne {
+	builder := c.Delete().Where(transcriptreplacejob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TranscriptReplaceJobDeleteOne{builder}
+}
+
+// Query returns a query builder for TranscriptReplaceJob.
+func (c *TranscriptReplaceJobClient) Query() *TranscriptReplaceJobQuery {
+	return &TranscriptReplaceJobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTranscriptReplaceJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TranscriptReplaceJob entity by its id.
+func (c *TranscriptReplaceJobClient) Get(ctx context.Context, id uuid.UUID) (*TranscriptReplaceJob, error) {
+	return c.Query().Where(transcriptreplacejob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TranscriptReplaceJobClient) GetX(ctx context.Context, id uuid.UUID) *TranscriptReplaceJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TranscriptReplaceJobClient) Hooks() []Hook {
+	hooks := c.hooks.TranscriptReplaceJob
+	return append(hooks[:len(hooks):len(hooks)], transcriptreplacejob.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TranscriptReplaceJobClient) Interceptors() []Interceptor {
+	return c.inters.TranscriptReplaceJob
+}
+
+func (c *TranscriptReplaceJobClient) mutate(ctx context.Context, m *TranscriptReplaceJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TranscriptReplaceJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TranscriptReplaceJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TranscriptReplaceJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TranscriptReplaceJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown TranscriptReplaceJob mutation op: %q", m.Op())
+	}
+}
+
+// TranscriptRevisionClient is a client for the TranscriptRevision schema.
+type TranscriptRevisionClient struct {
+	config
+}
+
+// NewTranscriptRevisionClient returns a client for the TranscriptRevision from the given config.
+func NewTranscriptRevisionClient(c config) *TranscriptRevisionClient {
+	return &TranscriptRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `transcriptrevision.Hooks(f(g(h())))`.
+func (c *TranscriptRevisionClient) Use(hooks ...Hook) {
+	c.hooks.TranscriptRevision = append(c.hooks.TranscriptRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `transcriptrevision.Intercept(f(g(h())))`.
+func (c *TranscriptRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TranscriptRevision = append(c.inters.TranscriptRevision, interceptors...)
+}
+
+// Create returns a builder for creating a TranscriptRevision entity.
+func (c *TranscriptRevisionClient) Create() *TranscriptRevisionCreate {
+	mutation := newTranscriptRevisionMutation(c.config, OpCreate)
+	return &TranscriptRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TranscriptRevision entities.
+func (c *TranscriptRevisionClient) CreateBulk(builders ...*TranscriptRevisionCreate) *TranscriptRevisionCreateBulk {
+	return &TranscriptRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TranscriptRevisionClient) MapCreateBulk(slice any, setFunc func(*TranscriptRevisionCreate, int)) *TranscriptRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TranscriptRevisionCreateBulk{err: fmt.Errorf("calling to TranscriptRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TranscriptRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TranscriptRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TranscriptRevision.
+func (c *TranscriptRevisionClient) Update() *TranscriptRevisionUpdate {
+	mutation := newTranscriptRevisionMutation(c.config, OpUpdate)
+	return &TranscriptRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TranscriptRevisionClient) UpdateOne(_m *TranscriptRevision) *TranscriptRevisionUpdateOne {
+	mutation := newTranscriptRevisionMutation(c.config, OpUpdateOne, withTranscriptRevision(_m))
+	return &TranscriptRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TranscriptRevisionClient) UpdateOneID(id uuid.UUID) *TranscriptRevisionUpdateOne {
+	mutation := newTranscriptRevisionMutation(c.config, OpUpdateOne, withTranscriptRevisionID(id))
+	return &TranscriptRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TranscriptRevision.
+func (c *TranscriptRevisionClient) Delete() *TranscriptRevisionDelete {
+	mutation := newTranscriptRevisionMutation(c.config, OpDelete)
+	return &TranscriptRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TranscriptRevisionClient) DeleteOne(_m *TranscriptRevision) *TranscriptRevisionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TranscriptRevisionClient) DeleteOneID(id uuid.UUID) *TranscriptRevisionDeleteOne {
+	builder := c.Delete().Where(transcriptrevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TranscriptRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for TranscriptRevision.
+func (c *TranscriptRevisionClient) Query() *TranscriptRevisionQuery {
+	return &TranscriptRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTranscriptRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TranscriptRevision entity by its id.
+func (c *TranscriptRevisionClient) Get(ctx context.Context, id uuid.UUID) (*TranscriptRevision, error) {
+	return c.Query().Where(transcriptrevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TranscriptRevisionClient) GetX(ctx context.Context, id uuid.UUID) *TranscriptRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TranscriptRevisionClient) Hooks() []Hook {
+	hooks := c.hooks.TranscriptRevision
+	return append(hooks[:len(hooks):len(hooks)], transcriptrevision.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TranscriptRevisionClient) Interceptors() []Interceptor {
+	return c.inters.TranscriptRevision
+}
+
+func (c *TranscriptRevisionClient) mutate(ctx context.Context, m *TranscriptRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TranscriptRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TranscriptRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TranscriptRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TranscriptRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown TranscriptRevision mutation op: %q", m.Op())
+	}
+}
+
+// UploadSessionClient is a client for the UploadSession schema.
+type UploadSessionClient struct {
+	config
+}
+
+// NewUploadSessionClient returns a client for the UploadSession from the given config.
+func NewUploadSessionClient(c config) *UploadSessionClient {
+	return &UploadSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `uploadsession.Hooks(f(g(h())))`.
+func (c *UploadSessionClient) Use(hooks ...Hook) {
+	c.hooks.UploadSession = append(c.hooks.UploadSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `uploadsession.Intercept(f(g(h())))`.
+func (c *UploadSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UploadSession = append(c.inters.UploadSession, interceptors...)
+}
+
+// Create returns a builder for creating a UploadSession entity.
+func (c *UploadSessionClient) Create() *UploadSessionCreate {
+	mutation := newUploadSessionMutation(c.config, OpCreate)
+	return &UploadSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UploadSession entities.
+func (c *UploadSessionClient) CreateBulk(builders ...*UploadSessionCreate) *UploadSessionCreateBulk {
+	return &UploadSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UploadSessionClient) MapCreateBulk(slice any, setFunc func(*UploadSessionCreate, int)) *UploadSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UploadSessionCreateBulk{err: fmt.Errorf("calling to UploadSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UploadSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UploadSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UploadSession.
+func (c *UploadSessionClient) Update() *UploadSessionUpdate {
+	mutation := newUploadSessionMutation(c.config, OpUpdate)
+	return &UploadSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UploadSessionClient) UpdateOne(_m *UploadSession) *UploadSessionUpdateOne {
+	mutation := newUploadSessionMutation(c.config, OpUpdateOne, withUploadSession(_m))
+	return &UploadSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UploadSessionClient) UpdateOneID(id uuid.UUID) *UploadSessionUpdateOne {
+	mutation := newUploadSessionMutation(c.config, OpUpdateOne, withUploadSessionID(id))
+	return &UploadSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UploadSession.
+func (c *UploadSessionClient) Delete() *UploadSessionDelete {
+	mutation := newUploadSessionMutation(c.config, OpDelete)
 	return &UploadSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UploadSessionClient) DeleteOne(_m *UploadSession) *UploadSessionDeleteOne {
+func (c *UploadSessionClient) DeleteOne(_m *UploadSession) *UploadSessionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UploadSessionClient) DeleteOneID(id uuid.UUID) *UploadSessionDeleteOne {
+	builder := c.Delete().Where(uploadsession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UploadSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for UploadSession.
+func (c *UploadSessionClient) Query() *UploadSessionQuery {
+	return &UploadSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUploadSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UploadSession entity by its id.
+func (c *UploadSessionClient) Get(ctx context.Context, id uuid.UUID) (*UploadSession, error) {
+	return c.Query().Where(uploadsession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UploadSessionClient) GetX(ctx context.Context, id uuid.UUID) *UploadSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UploadSessionClient) Hooks() []Hook {
+	hooks := c.hooks.UploadSession
+	return append(hooks[:len(hooks):len(hooks)], uploadsession.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *UploadSessionClient) Interceptors() []Interceptor {
+	return c.inters.UploadSession
+}
+
+func (c *UploadSessionClient) mutate(ctx context.Context, m *UploadSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UploadSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UploadSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UploadSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UploadSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown UploadSession mutation op: %q", m.Op())
+	}
+}
+
+// UsageRecordClient is a client for the UsageRecord schema.
+type UsageRecordClient struct {
+	config
+}
+
+// NewUsageRecordClient returns a client for the UsageRecord from the given config.
+func NewUsageRecordClient(c config) *UsageRecordClient {
+	return &UsageRecordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usagerecord.Hooks(f(g(h())))`.
+func (c *UsageRecordClient) Use(hooks ...Hook) {
+	c.hooks.UsageRecord = append(c.hooks.UsageRecord, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usagerecord.Intercept(f(g(h())))`.
+func (c *UsageRecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UsageRecord = append(c.inters.UsageRecord, interceptors...)
+}
+
+// Create returns a builder for creating a UsageRecord entity.
+func (c *UsageRecordClient) Create() *UsageRecordCreate {
+	mutation := newUsageRecordMutation(c.config, OpCreate)
+	return &UsageRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UsageRecord entities.
+func (c *UsageRecordClient) CreateBulk(builders ...*UsageRecordCreate) *UsageRecordCreateBulk {
+	return &UsageRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UsageRecordClient) MapCreateBulk(slice any, setFunc func(*UsageRecordCreate, int)) *UsageRecordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UsageRecordCreateBulk{err: fmt.Errorf("calling to UsageRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UsageRecordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UsageRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UsageRecord.
+func (c *UsageRecordClient) Update() *UsageRecordUpdate {
+	mutation := newUsageRecordMutation(c.config, OpUpdate)
+	return &UsageRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UsageRecordClient) UpdateOne(_m *UsageRecord) *UsageRecordUpdateOne {
+	mutation := newUsageRecordMutation(c.config, OpUpdateOne, withUsageRecord(_m))
+	return &UsageRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UsageRecordClient) UpdateOneID(id uuid.UUID) *UsageRecordUpdateOne {
+	mutation := newUsageRecordMutation(c.config, OpUpdateOne, withUsageRecordID(id))
+	return &UsageRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UsageRecord.
+func (c *UsageRecordClient) Delete() *UsageRecordDelete {
+	mutation := newUsageRecordMutation(c.config, OpDelete)
+	return &UsageRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UsageRecordClient) DeleteOne(_m *UsageRecord) *UsageRecordDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UsageRecordClient) DeleteOneID(id uuid.UUID) *UsageRecordDeleteOne {
+	builder := c.Delete().Where(usagerecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UsageRecordDeleteOne{builder}
+}
+
+// Query returns a query builder for UsageRecord.
+func (c *UsageRecordClient) Query() *UsageRecordQuery {
+	return &UsageRecordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUsageRecord},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UsageRecord entity by its id.
+func (c *UsageRecordClient) Get(ctx context.Context, id uuid.UUID) (*UsageRecord, error) {
+	return c.Query().Where(usagerecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UsageRecordClient) GetX(ctx context.Context, id uuid.UUID) *UsageRecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UsageRecordClient) Hooks() []Hook {
+	return c.hooks.UsageRecord
+}
+
+// Interceptors returns the client interceptors.
+func (c *UsageRecordClient) Interceptors() []Interceptor {
+	return c.inters.UsageRecord
+}
+
+func (c *UsageRecordClient) mutate(ctx context.Context, m *UsageRecordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UsageRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UsageRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UsageRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UsageRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown UsageRecord mutation op: %q", m.Op())
+	}
+}
+
+// UsageSnapshotClient is a client for the UsageSnapshot schema.
+type UsageSnapshotClient struct {
+	config
+}
+
+// NewUsageSnapshotClient returns a client for the UsageSnapshot from the given config.
+func NewUsageSnapshotClient(c config) *UsageSnapshotClient {
+	return &UsageSnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usagesnapshot.Hooks(f(g(h())))`.
+func (c *UsageSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.UsageSnapshot = append(c.hooks.UsageSnapshot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usagesnapshot.Intercept(f(g(h())))`.
+func (c *UsageSnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UsageSnapshot = append(c.inters.UsageSnapshot, interceptors...)
+}
+
+// Create returns a builder for creating a UsageSnapshot entity.
+func (c *UsageSnapshotClient) Create() *UsageSnapshotCreate {
+	mutation := newUsageSnapshotMutation(c.config, OpCreate)
+	return &UsageSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UsageSnapshot entities.
+func (c *UsageSnapshotClient) CreateBulk(builders ...*UsageSnapshotCreate) *UsageSnapshotCreateBulk {
+	return &UsageSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UsageSnapshotClient) MapCreateBulk(slice any, setFunc func(*UsageSnapshotCreate, int)) *UsageSnapshotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UsageSnapshotCreateBulk{err: fmt.Errorf("calling to UsageSnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UsageSnapshotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UsageSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UsageSnapshot.
+func (c *UsageSnapshotClient) Update() *UsageSnapshotUpdate {
+	mutation := newUsageSnapshotMutation(c.config, OpUpdate)
+	return &UsageSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UsageSnapshotClient) UpdateOne(_m *UsageSnapshot) *UsageSnapshotUpdateOne {
+	mutation := newUsageSnapshotMutation(c.config, OpUpdateOne, withUsageSnapshot(_m))
+	return &UsageSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UsageSnapshotClient) UpdateOneID(id uuid.UUID) *UsageSnapshotUpdateOne {
+	mutation := newUsageSnapshotMutation(c.config, OpUpdateOne, withUsageSnapshotID(id))
+	return &UsageSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UsageSnapshot.
+func (c *UsageSnapshotClient) Delete() *UsageSnapshotDelete {
+	mutation := newUsageSnapshotMutation(c.config, OpDelete)
+	return &UsageSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UsageSnapshotClient) DeleteOne(_m *UsageSnapshot) *UsageSnapshotDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UsageSnapshotClient) DeleteOneID(id uuid.UUID) *UsageSnapshotDeleteOne {
+	builder := c.Delete().Where(usagesnapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UsageSnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for UsageSnapshot.
+func (c *UsageSnapshotClient) Query() *UsageSnapshotQuery {
+	return &UsageSnapshotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUsageSnapshot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UsageSnapshot entity by its id.
+func (c *UsageSnapshotClient) Get(ctx context.Context, id uuid.UUID) (*UsageSnapshot, error) {
+	return c.Query().Where(usagesnapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UsageSnapshotClient) GetX(ctx context.Context, id uuid.UUID) *UsageSnapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UsageSnapshotClient) Hooks() []Hook {
+	hooks := c.hooks.UsageSnapshot
+	return append(hooks[:len(hooks):len(hooks)], usagesnapshot.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *UsageSnapshotClient) Interceptors() []Interceptor {
+	return c.inters.UsageSnapshot
+}
+
+func (c *UsageSnapshotClient) mutate(ctx context.Context, m *UsageSnapshotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UsageSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UsageSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UsageSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UsageSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown UsageSnapshot mutation op: %q", m.Op())
+	}
+}
+
+// VocabularyWordClient is a client for the VocabularyWord schema.
+type VocabularyWordClient struct {
+	config
+}
+
+// NewVocabularyWordClient returns a client for the VocabularyWord from the given config.
+func NewVocabularyWordClient(c config) *VocabularyWordClient {
+	return &VocabularyWordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vocabularyword.Hooks(f(g(h())))`.
+func (c *VocabularyWordClient) Use(hooks ...Hook) {
+	c.hooks.VocabularyWord = append(c.hooks.VocabularyWord, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vocabularyword.Intercept(f(g(h())))`.
+func (c *VocabularyWordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VocabularyWord = append(c.inters.VocabularyWord, interceptors...)
+}
+
+// Create returns a builder for creating a VocabularyWord entity.
+func (c *VocabularyWordClient) Create() *VocabularyWordCreate {
+	mutation := newVocabularyWordMutation(c.config, OpCreate)
+	return &VocabularyWordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VocabularyWord entities.
+func (c *VocabularyWordClient) CreateBulk(builders ...*VocabularyWordCreate) *VocabularyWordCreateBulk {
+	return &VocabularyWordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VocabularyWordClient) MapCreateBulk(slice any, setFunc func(*VocabularyWordCreate, int)) *VocabularyWordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VocabularyWordCreateBulk{err: fmt.Errorf("calling to VocabularyWordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VocabularyWordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VocabularyWordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VocabularyWord.
+func (c *VocabularyWordClient) Update() *VocabularyWordUpdate {
+	mutation := newVocabularyWordMutation(c.config, OpUpdate)
+	return &VocabularyWordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VocabularyWordClient) UpdateOne(_m *VocabularyWord) *VocabularyWordUpdateOne {
+	mutation := newVocabularyWordMutation(c.config, OpUpdateOne, withVocabularyWord(_m))
+	return &VocabularyWordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VocabularyWordClient) UpdateOneID(id uuid.UUID) *VocabularyWordUpdateOne {
+	mutation := newVocabularyWordMutation(c.config, OpUpdateOne, withVocabularyWordID(id))
+	return &VocabularyWordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VocabularyWord.
+func (c *VocabularyWordClient) Delete() *VocabularyWordDelete {
+	mutation := newVocabularyWordMutation(c.config, OpDelete)
+	return &VocabularyWordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VocabularyWordClient) DeleteOne(_m *VocabularyWord) *VocabularyWordDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VocabularyWordClient) DeleteOneID(id uuid.UUID) *VocabularyWordDeleteOne {
+	builder := c.Delete().Where(vocabularyword.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VocabularyWordDeleteOne{builder}
+}
+
+// Query returns a query builder for VocabularyWord.
+func (c *VocabularyWordClient) Query() *VocabularyWordQuery {
+	return &VocabularyWordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVocabularyWord},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VocabularyWord entity by its id.
+func (c *VocabularyWordClient) Get(ctx context.Context, id uuid.UUID) (*VocabularyWord, error) {
+	return c.Query().Where(vocabularyword.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VocabularyWordClient) GetX(ctx context.Context, id uuid.UUID) *VocabularyWord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *VocabularyWordClient) Hooks() []Hook {
+	hooks := c.hooks.VocabularyWord
+	return append(hooks[:len(hooks):len(hooks)], vocabularyword.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *VocabularyWordClient) Interceptors() []Interceptor {
+	return c.inters.VocabularyWord
+}
+
+func (c *VocabularyWordClient) mutate(ctx context.Context, m *VocabularyWordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VocabularyWordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VocabularyWordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VocabularyWordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VocabularyWordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown VocabularyWord mutation op: %q", m.Op())
+	}
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(_m *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(_m))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id uuid.UUID) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(_m *WebhookDelivery) *WebhookDeliveryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UploadSessionClient) DeleteOneID(id uuid.UUID) *UploadSessionDeleteOne {
-	builder := c.Delete().Where(uploadsession.ID(id))
+func (c *WebhookDeliveryClient) DeleteOneID(id uuid.UUID) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UploadSessionDeleteOne{builder}
+	return &WebhookDeliveryDeleteOne{builder}
 }
 
-// Query returns a query builder for UploadSession.
-func (c *UploadSessionClient) Query() *UploadSessionQuery {
-	return &UploadSessionQuery{
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUploadSession},
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a UploadSession entity by its id.
-func (c *UploadSessionClient) Get(ctx context.Context, id uuid.UUID) (*UploadSession, error) {
-	return c.Query().Where(uploadsession.ID(id)).Only(ctx)
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UploadSessionClient) GetX(ctx context.Context, id uuid.UUID) *UploadSession {
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id uuid.UUID) *WebhookDelivery {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -767,37 +7135,356 @@ func (c *UploadSessionClient) GetX(ctx context.Context, id uuid.UUID) *UploadSes
 	return obj
 }
 
+// QueryEndpoint queries the endpoint edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryEndpoint(_m *WebhookDelivery) *WebhookEndpointQuery {
+	query := (&WebhookEndpointClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhookendpoint.Table, webhookendpoint.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.EndpointTable, webhookdelivery.EndpointColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *UploadSessionClient) Hooks() []Hook {
-	return c.hooks.UploadSession
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	hooks := c.hooks.WebhookDelivery
+	return append(hooks[:len(hooks):len(hooks)], webhookdelivery.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *UploadSessionClient) Interceptors() []Interceptor {
-	return c.inters.UploadSession
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
 }
 
-func (c *UploadSessionClient) mutate(ctx context.Context, m *UploadSessionMutation) (Value, error) {
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UploadSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UploadSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UploadSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UploadSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("generated: unknown UploadSession mutation op: %q", m.Op())
+		return nil, fmt.Errorf("generated: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
+// WebhookEndpointClient is a client for the WebhookEndpoint schema.
+type WebhookEndpointClient struct {
+	config
+}
+
+// NewWebhookEndpointClient returns a client for the WebhookEndpoint from the given config.
+func NewWebhookEndpointClient(c config) *WebhookEndpointClient {
+	return &WebhookEndpointClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookendpoint.Hooks(f(g(h())))`.
+func (c *WebhookEndpointClient) Use(hooks ...Hook) {
+	c.hooks.WebhookEndpoint = append(c.hooks.WebhookEndpoint, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookendpoint.Intercept(f(g(h())))`.
+func (c *WebhookEndpointClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookEndpoint = append(c.inters.WebhookEndpoint, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookEndpoint entity.
+func (c *WebhookEndpointClient) Create() *WebhookEndpointCreate {
+	mutation := newWebhookEndpointMutation(c.config, OpCreate)
+	return &WebhookEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookEndpoint entities.
+func (c *WebhookEndpointClient) CreateBulk(builders ...*WebhookEndpointCreate) *WebhookEndpointCreateBulk {
+	return &WebhookEndpointCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookEndpointClient) MapCreateBulk(slice any, setFunc func(*WebhookEndpointCreate, int)) *WebhookEndpointCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookEndpointCreateBulk{err: fmt.Errorf("calling to WebhookEndpointClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookEndpointCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookEndpointCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Update() *WebhookEndpointUpdate {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdate)
+	return &WebhookEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookEndpointClient) UpdateOne(_m *WebhookEndpoint) *WebhookEndpointUpdateOne {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdateOne, withWebhookEndpoint(_m))
+	return &WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookEndpointClient) UpdateOneID(id uuid.UUID) *WebhookEndpointUpdateOne {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdateOne, withWebhookEndpointID(id))
+	return &WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Delete() *WebhookEndpointDelete {
+	mutation := newWebhookEndpointMutation(c.config, OpDelete)
+	return &WebhookEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookEndpointClient) DeleteOne(_m *WebhookEndpoint) *WebhookEndpointDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookEndpointClient) DeleteOneID(id uuid.UUID) *WebhookEndpointDeleteOne {
+	builder := c.Delete().Where(webhookendpoint.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookEndpointDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Query() *WebhookEndpointQuery {
+	return &WebhookEndpointQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookEndpoint},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookEndpoint entity by its id.
+func (c *WebhookEndpointClient) Get(ctx context.Context, id uuid.UUID) (*WebhookEndpoint, error) {
+	return c.Query().Where(webhookendpoint.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookEndpointClient) GetX(ctx context.Context, id uuid.UUID) *WebhookEndpoint {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDeliveries queries the deliveries edge of a WebhookEndpoint.
+func (c *WebhookEndpointClient) QueryDeliveries(_m *WebhookEndpoint) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookendpoint.Table, webhookendpoint.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhookendpoint.DeliveriesTable, webhookendpoint.DeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookEndpointClient) Hooks() []Hook {
+	hooks := c.hooks.WebhookEndpoint
+	return append(hooks[:len(hooks):len(hooks)], webhookendpoint.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookEndpointClient) Interceptors() []Interceptor {
+	return c.inters.WebhookEndpoint
+}
+
+func (c *WebhookEndpointClient) mutate(ctx context.Context, m *WebhookEndpointMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown WebhookEndpoint mutation op: %q", m.Op())
+	}
+}
+
+// WebhookEventClient is a client for the WebhookEvent schema.
+type WebhookEventClient struct {
+	config
+}
+
+// NewWebhookEventClient returns a client for the WebhookEvent from the given config.
+func NewWebhookEventClient(c config) *WebhookEventClient {
+	return &WebhookEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookevent.Hooks(f(g(h())))`.
+func (c *WebhookEventClient) Use(hooks ...Hook) {
+	c.hooks.WebhookEvent = append(c.hooks.WebhookEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookevent.Intercept(f(g(h())))`.
+func (c *WebhookEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookEvent = append(c.inters.WebhookEvent, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookEvent entity.
+func (c *WebhookEventClient) Create() *WebhookEventCreate {
+	mutation := newWebhookEventMutation(c.config, OpCreate)
+	return &WebhookEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookEvent entities.
+func (c *WebhookEventClient) CreateBulk(builders ...*WebhookEventCreate) *WebhookEventCreateBulk {
+	return &WebhookEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookEventClient) MapCreateBulk(slice any, setFunc func(*WebhookEventCreate, int)) *WebhookEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookEventCreateBulk{err: fmt.Errorf("calling to WebhookEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookEvent.
+func (c *WebhookEventClient) Update() *WebhookEventUpdate {
+	mutation := newWebhookEventMutation(c.config, OpUpdate)
+	return &WebhookEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookEventClient) UpdateOne(_m *WebhookEvent) *WebhookEventUpdateOne {
+	mutation := newWebhookEventMutation(c.config, OpUpdateOne, withWebhookEvent(_m))
+	return &WebhookEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookEventClient) UpdateOneID(id uuid.UUID) *WebhookEventUpdateOne {
+	mutation := newWebhookEventMutation(c.config, OpUpdateOne, withWebhookEventID(id))
+	return &WebhookEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookEvent.
+func (c *WebhookEventClient) Delete() *WebhookEventDelete {
+	mutation := newWebhookEventMutation(c.config, OpDelete)
+	return &WebhookEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookEventClient) DeleteOne(_m *WebhookEvent) *WebhookEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookEventClient) DeleteOneID(id uuid.UUID) *WebhookEventDeleteOne {
+	builder := c.Delete().Where(webhookevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookEventDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookEvent.
+func (c *WebhookEventClient) Query() *WebhookEventQuery {
+	return &WebhookEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookEvent entity by its id.
+func (c *WebhookEventClient) Get(ctx context.Context, id uuid.UUID) (*WebhookEvent, error) {
+	return c.Query().Where(webhookevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookEventClient) GetX(ctx context.Context, id uuid.UUID) *WebhookEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookEventClient) Hooks() []Hook {
+	hooks := c.hooks.WebhookEvent
+	return append(hooks[:len(hooks):len(hooks)], webhookevent.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookEventClient) Interceptors() []Interceptor {
+	return c.inters.WebhookEvent
+}
+
+func (c *WebhookEventClient) mutate(ctx context.Context, m *WebhookEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown WebhookEvent mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Asset, Episode, Series, UploadSession []ent.Hook
+		APIKey, Asset, AssetUsageDay, AssetVersion, AuditEntry, AvailabilitySlot,
+		Booking, Classroom, ClassroomAssignment, ClassroomMember, ContentEmbedding,
+		ContentKey, ContentReassignment, DeviceToken, DictationAttempt,
+		EngagementRollup, Episode, EpisodeTranscript, Event, FeedItem,
+		FeedSubscription, Invoice, Job, LTILaunch, LTILoginState, LTIPlatform,
+		LearnerActivity, ModerationItem, Notification, NotificationPreference,
+		OutboxMessage, Plan, PlaybackSession, Playlist, PlaylistItem, QuizItem,
+		ScheduledTask, Series, ShadowingSubmission, Subscription, TranscriptReplaceJob,
+		TranscriptRevision, UploadSession, UsageRecord, UsageSnapshot, VocabularyWord,
+		WebhookDelivery, WebhookEndpoint, WebhookEvent []ent.Hook
 	}
 	inters struct {
-		Asset, Episode, Series, UploadSession []ent.Interceptor
+		APIKey, Asset, AssetUsageDay, AssetVersion, AuditEntry, AvailabilitySlot,
+		Booking, Classroom, ClassroomAssignment, ClassroomMember, ContentEmbedding,
+		ContentKey, ContentReassignment, DeviceToken, DictationAttempt,
+		EngagementRollup, Episode, EpisodeTranscript, Event, FeedItem,
+		FeedSubscription, Invoice, Job, LTILaunch, LTILoginState, LTIPlatform,
+		LearnerActivity, ModerationItem, Notification, NotificationPreference,
+		OutboxMessage, Plan, PlaybackSession, Playlist, PlaylistItem, QuizItem,
+		ScheduledTask, Series, ShadowingSubmission, Subscription, TranscriptReplaceJob,
+		TranscriptRevision, UploadSession, UsageRecord, UsageSnapshot, VocabularyWord,
+		WebhookDelivery, WebhookEndpoint, WebhookEvent []ent.Interceptor
 	}
 )
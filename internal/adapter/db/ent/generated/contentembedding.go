@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentembedding"
+	"github.com/eslsoft/lession/internal/adapter/db/pgvector"
+	"github.com/google/uuid"
+)
+
+// ContentEmbedding is the model entity for the ContentEmbedding schema.
+type ContentEmbedding struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind int `json:"kind,omitempty"`
+	// SeriesID holds the value of the "series_id" field.
+	SeriesID uuid.UUID `json:"series_id,omitempty"`
+	// EpisodeID holds the value of the "episode_id" field.
+	EpisodeID uuid.UUID `json:"episode_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Snippet holds the value of the "snippet" field.
+	Snippet string `json:"snippet,omitempty"`
+	// Language holds the value of the "language" field.
+	Language string `json:"language,omitempty"`
+	// Level holds the value of the "level" field.
+	Level string `json:"level,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags []string `json:"tags,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// ContentHash holds the value of the "content_hash" field.
+	ContentHash string `json:"content_hash,omitempty"`
+	// Vector holds the value of the "vector" field.
+	Vector       pgvector.Vector `json:"vector,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ContentEmbedding) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case contentembedding.FieldTags:
+			values[i] = new([]byte)
+		case contentembedding.FieldVector:
+			values[i] = new(pgvector.Vector)
+		case contentembedding.FieldKind:
+			values[i] = new(sql.NullInt64)
+		case contentembedding.FieldID, contentembedding.FieldTitle, contentembedding.FieldSnippet, contentembedding.FieldLanguage, contentembedding.FieldLevel, contentembedding.FieldModel, contentembedding.FieldContentHash:
+			values[i] = new(sql.NullString)
+		case contentembedding.FieldCreatedAt, contentembedding.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case contentembedding.FieldSeriesID, contentembedding.FieldEpisodeID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ContentEmbedding fields.
+func (_m *ContentEmbedding) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case contentembedding.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case contentembedding.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case contentembedding.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case contentembedding.FieldKind:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = int(value.Int64)
+			}
+		case contentembedding.FieldSeriesID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field series_id", values[i])
+			} else if value != nil {
+				_m.SeriesID = *value
+			}
+		case contentembedding.FieldEpisodeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field episode_id", values[i])
+			} else if value != nil {
+				_m.EpisodeID = *value
+			}
+		case contentembedding.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case contentembedding.FieldSnippet:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field snippet", values[i])
+			} else if value.Valid {
+				_m.Snippet = value.String
+			}
+		case contentembedding.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				_m.Language = value.String
+			}
+		case contentembedding.FieldLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				_m.Level = value.String
+			}
+		case contentembedding.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case contentembedding.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				_m.Model = value.String
+			}
+		case contentembedding.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				_m.ContentHash = value.String
+			}
+		case contentembedding.FieldVector:
+			if value, ok := values[i].(*pgvector.Vector); !ok {
+				return fmt.Errorf("unexpected type %T for field vector", values[i])
+			} else if value != nil {
+				_m.Vector = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ContentEmbedding.
+// This includes values selected through modifiers, order, etc.
+func (_m *ContentEmbedding) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ContentEmbedding.
+// Note that you need to call ContentEmbedding.Unwrap() before calling this method if this ContentEmbedding
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ContentEmbedding) Update() *ContentEmbeddingUpdateOne {
+	return NewContentEmbeddingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ContentEmbedding entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ContentEmbedding) Unwrap() *ContentEmbedding {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: ContentEmbedding is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ContentEmbedding) String() string {
+	var builder strings.Builder
+	builder.WriteString("ContentEmbedding(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("series_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SeriesID))
+	builder.WriteString(", ")
+	builder.WriteString("episode_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EpisodeID))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("snippet=")
+	builder.WriteString(_m.Snippet)
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(_m.Language)
+	builder.WriteString(", ")
+	builder.WriteString("level=")
+	builder.WriteString(_m.Level)
+	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tags))
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(_m.Model)
+	builder.WriteString(", ")
+	builder.WriteString("content_hash=")
+	builder.WriteString(_m.ContentHash)
+	builder.WriteString(", ")
+	builder.WriteString("vector=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Vector))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ContentEmbeddings is a parsable slice of ContentEmbedding.
+type ContentEmbeddings []*ContentEmbedding
@@ -0,0 +1,792 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contentembedding
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/eslsoft/lession/internal/adapter/db/pgvector"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v int) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldKind, v))
+}
+
+// SeriesID applies equality check predicate on the "series_id" field. It's identical to SeriesIDEQ.
+func SeriesID(v uuid.UUID) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// EpisodeID applies equality check predicate on the "episode_id" field. It's identical to EpisodeIDEQ.
+func EpisodeID(v uuid.UUID) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldEpisodeID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldTitle, v))
+}
+
+// Snippet applies equality check predicate on the "snippet" field. It's identical to SnippetEQ.
+func Snippet(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldSnippet, v))
+}
+
+// Language applies equality check predicate on the "language" field. It's identical to LanguageEQ.
+func Language(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldLanguage, v))
+}
+
+// Level applies equality check predicate on the "level" field. It's identical to LevelEQ.
+func Level(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldLevel, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldModel, v))
+}
+
+// ContentHash applies equality check predicate on the "content_hash" field. It's identical to ContentHashEQ.
+func ContentHash(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldContentHash, v))
+}
+
+// Vector applies equality check predicate on the "vector" field. It's identical to VectorEQ.
+func Vector(v pgvector.Vector) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldVector, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v int) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v int) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...int) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...int) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v int) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v int) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v int) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v int) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLTE(FieldKind, v))
+}
+
+// SeriesIDEQ applies the EQ predicate on the "series_id" field.
+func SeriesIDEQ(v uuid.UUID) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// SeriesIDNEQ applies the NEQ predicate on the "series_id" field.
+func SeriesIDNEQ(v uuid.UUID) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNEQ(FieldSeriesID, v))
+}
+
+// SeriesIDIn applies the In predicate on the "series_id" field.
+func SeriesIDIn(vs ...uuid.UUID) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDNotIn applies the NotIn predicate on the "series_id" field.
+func SeriesIDNotIn(vs ...uuid.UUID) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNotIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDGT applies the GT predicate on the "series_id" field.
+func SeriesIDGT(v uuid.UUID) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGT(FieldSeriesID, v))
+}
+
+// SeriesIDGTE applies the GTE predicate on the "series_id" field.
+func SeriesIDGTE(v uuid.UUID) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGTE(FieldSeriesID, v))
+}
+
+// SeriesIDLT applies the LT predicate on the "series_id" field.
+func SeriesIDLT(v uuid.UUID) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLT(FieldSeriesID, v))
+}
+
+// SeriesIDLTE applies the LTE predicate on the "series_id" field.
+func SeriesIDLTE(v uuid.UUID) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLTE(FieldSeriesID, v))
+}
+
+// EpisodeIDEQ applies the EQ predicate on the "episode_id" field.
+func EpisodeIDEQ(v uuid.UUID) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldEpisodeID, v))
+}
+
+// EpisodeIDNEQ applies the NEQ predicate on the "episode_id" field.
+func EpisodeIDNEQ(v uuid.UUID) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNEQ(FieldEpisodeID, v))
+}
+
+// EpisodeIDIn applies the In predicate on the "episode_id" field.
+func EpisodeIDIn(vs ...uuid.UUID) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldIn(FieldEpisodeID, vs...))
+}
+
+// EpisodeIDNotIn applies the NotIn predicate on the "episode_id" field.
+func EpisodeIDNotIn(vs ...uuid.UUID) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNotIn(FieldEpisodeID, vs...))
+}
+
+// EpisodeIDGT applies the GT predicate on the "episode_id" field.
+func EpisodeIDGT(v uuid.UUID) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGT(FieldEpisodeID, v))
+}
+
+// EpisodeIDGTE applies the GTE predicate on the "episode_id" field.
+func EpisodeIDGTE(v uuid.UUID) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGTE(FieldEpisodeID, v))
+}
+
+// EpisodeIDLT applies the LT predicate on the "episode_id" field.
+func EpisodeIDLT(v uuid.UUID) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLT(FieldEpisodeID, v))
+}
+
+// EpisodeIDLTE applies the LTE predicate on the "episode_id" field.
+func EpisodeIDLTE(v uuid.UUID) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLTE(FieldEpisodeID, v))
+}
+
+// EpisodeIDIsNil applies the IsNil predicate on the "episode_id" field.
+func EpisodeIDIsNil() predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldIsNull(FieldEpisodeID))
+}
+
+// EpisodeIDNotNil applies the NotNil predicate on the "episode_id" field.
+func EpisodeIDNotNil() predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNotNull(FieldEpisodeID))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// SnippetEQ applies the EQ predicate on the "snippet" field.
+func SnippetEQ(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldSnippet, v))
+}
+
+// SnippetNEQ applies the NEQ predicate on the "snippet" field.
+func SnippetNEQ(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNEQ(FieldSnippet, v))
+}
+
+// SnippetIn applies the In predicate on the "snippet" field.
+func SnippetIn(vs ...string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldIn(FieldSnippet, vs...))
+}
+
+// SnippetNotIn applies the NotIn predicate on the "snippet" field.
+func SnippetNotIn(vs ...string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNotIn(FieldSnippet, vs...))
+}
+
+// SnippetGT applies the GT predicate on the "snippet" field.
+func SnippetGT(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGT(FieldSnippet, v))
+}
+
+// SnippetGTE applies the GTE predicate on the "snippet" field.
+func SnippetGTE(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGTE(FieldSnippet, v))
+}
+
+// SnippetLT applies the LT predicate on the "snippet" field.
+func SnippetLT(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLT(FieldSnippet, v))
+}
+
+// SnippetLTE applies the LTE predicate on the "snippet" field.
+func SnippetLTE(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLTE(FieldSnippet, v))
+}
+
+// SnippetContains applies the Contains predicate on the "snippet" field.
+func SnippetContains(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldContains(FieldSnippet, v))
+}
+
+// SnippetHasPrefix applies the HasPrefix predicate on the "snippet" field.
+func SnippetHasPrefix(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldHasPrefix(FieldSnippet, v))
+}
+
+// SnippetHasSuffix applies the HasSuffix predicate on the "snippet" field.
+func SnippetHasSuffix(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldHasSuffix(FieldSnippet, v))
+}
+
+// SnippetEqualFold applies the EqualFold predicate on the "snippet" field.
+func SnippetEqualFold(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEqualFold(FieldSnippet, v))
+}
+
+// SnippetContainsFold applies the ContainsFold predicate on the "snippet" field.
+func SnippetContainsFold(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldContainsFold(FieldSnippet, v))
+}
+
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldLanguage, v))
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNEQ(FieldLanguage, v))
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldIn(FieldLanguage, vs...))
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNotIn(FieldLanguage, vs...))
+}
+
+// LanguageGT applies the GT predicate on the "language" field.
+func LanguageGT(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGT(FieldLanguage, v))
+}
+
+// LanguageGTE applies the GTE predicate on the "language" field.
+func LanguageGTE(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGTE(FieldLanguage, v))
+}
+
+// LanguageLT applies the LT predicate on the "language" field.
+func LanguageLT(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLT(FieldLanguage, v))
+}
+
+// LanguageLTE applies the LTE predicate on the "language" field.
+func LanguageLTE(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLTE(FieldLanguage, v))
+}
+
+// LanguageContains applies the Contains predicate on the "language" field.
+func LanguageContains(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldContains(FieldLanguage, v))
+}
+
+// LanguageHasPrefix applies the HasPrefix predicate on the "language" field.
+func LanguageHasPrefix(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldHasPrefix(FieldLanguage, v))
+}
+
+// LanguageHasSuffix applies the HasSuffix predicate on the "language" field.
+func LanguageHasSuffix(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldHasSuffix(FieldLanguage, v))
+}
+
+// LanguageEqualFold applies the EqualFold predicate on the "language" field.
+func LanguageEqualFold(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEqualFold(FieldLanguage, v))
+}
+
+// LanguageContainsFold applies the ContainsFold predicate on the "language" field.
+func LanguageContainsFold(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldContainsFold(FieldLanguage, v))
+}
+
+// LevelEQ applies the EQ predicate on the "level" field.
+func LevelEQ(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldLevel, v))
+}
+
+// LevelNEQ applies the NEQ predicate on the "level" field.
+func LevelNEQ(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNEQ(FieldLevel, v))
+}
+
+// LevelIn applies the In predicate on the "level" field.
+func LevelIn(vs ...string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldIn(FieldLevel, vs...))
+}
+
+// LevelNotIn applies the NotIn predicate on the "level" field.
+func LevelNotIn(vs ...string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNotIn(FieldLevel, vs...))
+}
+
+// LevelGT applies the GT predicate on the "level" field.
+func LevelGT(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGT(FieldLevel, v))
+}
+
+// LevelGTE applies the GTE predicate on the "level" field.
+func LevelGTE(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGTE(FieldLevel, v))
+}
+
+// LevelLT applies the LT predicate on the "level" field.
+func LevelLT(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLT(FieldLevel, v))
+}
+
+// LevelLTE applies the LTE predicate on the "level" field.
+func LevelLTE(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLTE(FieldLevel, v))
+}
+
+// LevelContains applies the Contains predicate on the "level" field.
+func LevelContains(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldContains(FieldLevel, v))
+}
+
+// LevelHasPrefix applies the HasPrefix predicate on the "level" field.
+func LevelHasPrefix(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldHasPrefix(FieldLevel, v))
+}
+
+// LevelHasSuffix applies the HasSuffix predicate on the "level" field.
+func LevelHasSuffix(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldHasSuffix(FieldLevel, v))
+}
+
+// LevelEqualFold applies the EqualFold predicate on the "level" field.
+func LevelEqualFold(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEqualFold(FieldLevel, v))
+}
+
+// LevelContainsFold applies the ContainsFold predicate on the "level" field.
+func LevelContainsFold(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldContainsFold(FieldLevel, v))
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNotNull(FieldTags))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldContainsFold(FieldModel, v))
+}
+
+// ContentHashEQ applies the EQ predicate on the "content_hash" field.
+func ContentHashEQ(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "content_hash" field.
+func ContentHashNEQ(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "content_hash" field.
+func ContentHashIn(vs ...string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "content_hash" field.
+func ContentHashNotIn(vs ...string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// ContentHashGT applies the GT predicate on the "content_hash" field.
+func ContentHashGT(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGT(FieldContentHash, v))
+}
+
+// ContentHashGTE applies the GTE predicate on the "content_hash" field.
+func ContentHashGTE(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGTE(FieldContentHash, v))
+}
+
+// ContentHashLT applies the LT predicate on the "content_hash" field.
+func ContentHashLT(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLT(FieldContentHash, v))
+}
+
+// ContentHashLTE applies the LTE predicate on the "content_hash" field.
+func ContentHashLTE(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLTE(FieldContentHash, v))
+}
+
+// ContentHashContains applies the Contains predicate on the "content_hash" field.
+func ContentHashContains(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldContains(FieldContentHash, v))
+}
+
+// ContentHashHasPrefix applies the HasPrefix predicate on the "content_hash" field.
+func ContentHashHasPrefix(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldHasPrefix(FieldContentHash, v))
+}
+
+// ContentHashHasSuffix applies the HasSuffix predicate on the "content_hash" field.
+func ContentHashHasSuffix(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldHasSuffix(FieldContentHash, v))
+}
+
+// ContentHashEqualFold applies the EqualFold predicate on the "content_hash" field.
+func ContentHashEqualFold(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEqualFold(FieldContentHash, v))
+}
+
+// ContentHashContainsFold applies the ContainsFold predicate on the "content_hash" field.
+func ContentHashContainsFold(v string) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldContainsFold(FieldContentHash, v))
+}
+
+// VectorEQ applies the EQ predicate on the "vector" field.
+func VectorEQ(v pgvector.Vector) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldEQ(FieldVector, v))
+}
+
+// VectorNEQ applies the NEQ predicate on the "vector" field.
+func VectorNEQ(v pgvector.Vector) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNEQ(FieldVector, v))
+}
+
+// VectorIn applies the In predicate on the "vector" field.
+func VectorIn(vs ...pgvector.Vector) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldIn(FieldVector, vs...))
+}
+
+// VectorNotIn applies the NotIn predicate on the "vector" field.
+func VectorNotIn(vs ...pgvector.Vector) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldNotIn(FieldVector, vs...))
+}
+
+// VectorGT applies the GT predicate on the "vector" field.
+func VectorGT(v pgvector.Vector) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGT(FieldVector, v))
+}
+
+// VectorGTE applies the GTE predicate on the "vector" field.
+func VectorGTE(v pgvector.Vector) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldGTE(FieldVector, v))
+}
+
+// VectorLT applies the LT predicate on the "vector" field.
+func VectorLT(v pgvector.Vector) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLT(FieldVector, v))
+}
+
+// VectorLTE applies the LTE predicate on the "vector" field.
+func VectorLTE(v pgvector.Vector) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.FieldLTE(FieldVector, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ContentEmbedding) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ContentEmbedding) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ContentEmbedding) predicate.ContentEmbedding {
+	return predicate.ContentEmbedding(sql.NotPredicates(p))
+}
@@ -0,0 +1,400 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentembedding"
+	"github.com/eslsoft/lession/internal/adapter/db/pgvector"
+	"github.com/google/uuid"
+)
+
+// ContentEmbeddingCreate is the builder for creating a ContentEmbedding entity.
+type ContentEmbeddingCreate struct {
+	config
+	mutation *ContentEmbeddingMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ContentEmbeddingCreate) SetCreatedAt(v time.Time) *ContentEmbeddingCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ContentEmbeddingCreate) SetUpdatedAt(v time.Time) *ContentEmbeddingCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *ContentEmbeddingCreate) SetKind(v int) *ContentEmbeddingCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_c *ContentEmbeddingCreate) SetSeriesID(v uuid.UUID) *ContentEmbeddingCreate {
+	_c.mutation.SetSeriesID(v)
+	return _c
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_c *ContentEmbeddingCreate) SetEpisodeID(v uuid.UUID) *ContentEmbeddingCreate {
+	_c.mutation.SetEpisodeID(v)
+	return _c
+}
+
+// SetNillableEpisodeID sets the "episode_id" field if the given value is not nil.
+func (_c *ContentEmbeddingCreate) SetNillableEpisodeID(v *uuid.UUID) *ContentEmbeddingCreate {
+	if v != nil {
+		_c.SetEpisodeID(*v)
+	}
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *ContentEmbeddingCreate) SetTitle(v string) *ContentEmbeddingCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetSnippet sets the "snippet" field.
+func (_c *ContentEmbeddingCreate) SetSnippet(v string) *ContentEmbeddingCreate {
+	_c.mutation.SetSnippet(v)
+	return _c
+}
+
+// SetNillableSnippet sets the "snippet" field if the given value is not nil.
+func (_c *ContentEmbeddingCreate) SetNillableSnippet(v *string) *ContentEmbeddingCreate {
+	if v != nil {
+		_c.SetSnippet(*v)
+	}
+	return _c
+}
+
+// SetLanguage sets the "language" field.
+func (_c *ContentEmbeddingCreate) SetLanguage(v string) *ContentEmbeddingCreate {
+	_c.mutation.SetLanguage(v)
+	return _c
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_c *ContentEmbeddingCreate) SetNillableLanguage(v *string) *ContentEmbeddingCreate {
+	if v != nil {
+		_c.SetLanguage(*v)
+	}
+	return _c
+}
+
+// SetLevel sets the "level" field.
+func (_c *ContentEmbeddingCreate) SetLevel(v string) *ContentEmbeddingCreate {
+	_c.mutation.SetLevel(v)
+	return _c
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_c *ContentEmbeddingCreate) SetNillableLevel(v *string) *ContentEmbeddingCreate {
+	if v != nil {
+		_c.SetLevel(*v)
+	}
+	return _c
+}
+
+// SetTags sets the "tags" field.
+func (_c *ContentEmbeddingCreate) SetTags(v []string) *ContentEmbeddingCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *ContentEmbeddingCreate) SetModel(v string) *ContentEmbeddingCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_c *ContentEmbeddingCreate) SetContentHash(v string) *ContentEmbeddingCreate {
+	_c.mutation.SetContentHash(v)
+	return _c
+}
+
+// SetVector sets the "vector" field.
+func (_c *ContentEmbeddingCreate) SetVector(v pgvector.Vector) *ContentEmbeddingCreate {
+	_c.mutation.SetVector(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ContentEmbeddingCreate) SetID(v string) *ContentEmbeddingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ContentEmbeddingMutation object of the builder.
+func (_c *ContentEmbeddingCreate) Mutation() *ContentEmbeddingMutation {
+	return _c.mutation
+}
+
+// Save creates the ContentEmbedding in the database.
+func (_c *ContentEmbeddingCreate) Save(ctx context.Context) (*ContentEmbedding, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ContentEmbeddingCreate) SaveX(ctx context.Context) *ContentEmbedding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContentEmbeddingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContentEmbeddingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ContentEmbeddingCreate) defaults() error {
+	if _, ok := _c.mutation.Snippet(); !ok {
+		v := contentembedding.DefaultSnippet
+		_c.mutation.SetSnippet(v)
+	}
+	if _, ok := _c.mutation.Language(); !ok {
+		v := contentembedding.DefaultLanguage
+		_c.mutation.SetLanguage(v)
+	}
+	if _, ok := _c.mutation.Level(); !ok {
+		v := contentembedding.DefaultLevel
+		_c.mutation.SetLevel(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ContentEmbeddingCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "ContentEmbedding.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "ContentEmbedding.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`generated: missing required field "ContentEmbedding.kind"`)}
+	}
+	if _, ok := _c.mutation.SeriesID(); !ok {
+		return &ValidationError{Name: "series_id", err: errors.New(`generated: missing required field "ContentEmbedding.series_id"`)}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`generated: missing required field "ContentEmbedding.title"`)}
+	}
+	if _, ok := _c.mutation.Snippet(); !ok {
+		return &ValidationError{Name: "snippet", err: errors.New(`generated: missing required field "ContentEmbedding.snippet"`)}
+	}
+	if _, ok := _c.mutation.Language(); !ok {
+		return &ValidationError{Name: "language", err: errors.New(`generated: missing required field "ContentEmbedding.language"`)}
+	}
+	if _, ok := _c.mutation.Level(); !ok {
+		return &ValidationError{Name: "level", err: errors.New(`generated: missing required field "ContentEmbedding.level"`)}
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`generated: missing required field "ContentEmbedding.model"`)}
+	}
+	if _, ok := _c.mutation.ContentHash(); !ok {
+		return &ValidationError{Name: "content_hash", err: errors.New(`generated: missing required field "ContentEmbedding.content_hash"`)}
+	}
+	if _, ok := _c.mutation.Vector(); !ok {
+		return &ValidationError{Name: "vector", err: errors.New(`generated: missing required field "ContentEmbedding.vector"`)}
+	}
+	return nil
+}
+
+func (_c *ContentEmbeddingCreate) sqlSave(ctx context.Context) (*ContentEmbedding, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ContentEmbedding.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ContentEmbeddingCreate) createSpec() (*ContentEmbedding, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContentEmbedding{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(contentembedding.Table, sqlgraph.NewFieldSpec(contentembedding.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(contentembedding.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(contentembedding.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(contentembedding.FieldKind, field.TypeInt, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.SeriesID(); ok {
+		_spec.SetField(contentembedding.FieldSeriesID, field.TypeUUID, value)
+		_node.SeriesID = value
+	}
+	if value, ok := _c.mutation.EpisodeID(); ok {
+		_spec.SetField(contentembedding.FieldEpisodeID, field.TypeUUID, value)
+		_node.EpisodeID = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(contentembedding.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Snippet(); ok {
+		_spec.SetField(contentembedding.FieldSnippet, field.TypeString, value)
+		_node.Snippet = value
+	}
+	if value, ok := _c.mutation.Language(); ok {
+		_spec.SetField(contentembedding.FieldLanguage, field.TypeString, value)
+		_node.Language = value
+	}
+	if value, ok := _c.mutation.Level(); ok {
+		_spec.SetField(contentembedding.FieldLevel, field.TypeString, value)
+		_node.Level = value
+	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(contentembedding.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(contentembedding.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.ContentHash(); ok {
+		_spec.SetField(contentembedding.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = value
+	}
+	if value, ok := _c.mutation.Vector(); ok {
+		_spec.SetField(contentembedding.FieldVector, field.TypeOther, value)
+		_node.Vector = value
+	}
+	return _node, _spec
+}
+
+// ContentEmbeddingCreateBulk is the builder for creating many ContentEmbedding entities in bulk.
+type ContentEmbeddingCreateBulk struct {
+	config
+	err      error
+	builders []*ContentEmbeddingCreate
+}
+
+// Save creates the ContentEmbedding entities in the database.
+func (_c *ContentEmbeddingCreateBulk) Save(ctx context.Context) ([]*ContentEmbedding, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ContentEmbedding, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContentEmbeddingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ContentEmbeddingCreateBulk) SaveX(ctx context.Context) []*ContentEmbedding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContentEmbeddingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContentEmbeddingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,633 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentembedding"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/eslsoft/lession/internal/adapter/db/pgvector"
+	"github.com/google/uuid"
+)
+
+// ContentEmbeddingUpdate is the builder for updating ContentEmbedding entities.
+type ContentEmbeddingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ContentEmbeddingMutation
+}
+
+// Where appends a list predicates to the ContentEmbeddingUpdate builder.
+func (_u *ContentEmbeddingUpdate) Where(ps ...predicate.ContentEmbedding) *ContentEmbeddingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ContentEmbeddingUpdate) SetUpdatedAt(v time.Time) *ContentEmbeddingUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdate) SetNillableUpdatedAt(v *time.Time) *ContentEmbeddingUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ContentEmbeddingUpdate) SetKind(v int) *ContentEmbeddingUpdate {
+	_u.mutation.ResetKind()
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdate) SetNillableKind(v *int) *ContentEmbeddingUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// AddKind adds value to the "kind" field.
+func (_u *ContentEmbeddingUpdate) AddKind(v int) *ContentEmbeddingUpdate {
+	_u.mutation.AddKind(v)
+	return _u
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_u *ContentEmbeddingUpdate) SetSeriesID(v uuid.UUID) *ContentEmbeddingUpdate {
+	_u.mutation.SetSeriesID(v)
+	return _u
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdate) SetNillableSeriesID(v *uuid.UUID) *ContentEmbeddingUpdate {
+	if v != nil {
+		_u.SetSeriesID(*v)
+	}
+	return _u
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_u *ContentEmbeddingUpdate) SetEpisodeID(v uuid.UUID) *ContentEmbeddingUpdate {
+	_u.mutation.SetEpisodeID(v)
+	return _u
+}
+
+// SetNillableEpisodeID sets the "episode_id" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdate) SetNillableEpisodeID(v *uuid.UUID) *ContentEmbeddingUpdate {
+	if v != nil {
+		_u.SetEpisodeID(*v)
+	}
+	return _u
+}
+
+// ClearEpisodeID clears the value of the "episode_id" field.
+func (_u *ContentEmbeddingUpdate) ClearEpisodeID() *ContentEmbeddingUpdate {
+	_u.mutation.ClearEpisodeID()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *ContentEmbeddingUpdate) SetTitle(v string) *ContentEmbeddingUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdate) SetNillableTitle(v *string) *ContentEmbeddingUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetSnippet sets the "snippet" field.
+func (_u *ContentEmbeddingUpdate) SetSnippet(v string) *ContentEmbeddingUpdate {
+	_u.mutation.SetSnippet(v)
+	return _u
+}
+
+// SetNillableSnippet sets the "snippet" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdate) SetNillableSnippet(v *string) *ContentEmbeddingUpdate {
+	if v != nil {
+		_u.SetSnippet(*v)
+	}
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *ContentEmbeddingUpdate) SetLanguage(v string) *ContentEmbeddingUpdate {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdate) SetNillableLanguage(v *string) *ContentEmbeddingUpdate {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// SetLevel sets the "level" field.
+func (_u *ContentEmbeddingUpdate) SetLevel(v string) *ContentEmbeddingUpdate {
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdate) SetNillableLevel(v *string) *ContentEmbeddingUpdate {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *ContentEmbeddingUpdate) SetTags(v []string) *ContentEmbeddingUpdate {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *ContentEmbeddingUpdate) AppendTags(v []string) *ContentEmbeddingUpdate {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *ContentEmbeddingUpdate) ClearTags() *ContentEmbeddingUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *ContentEmbeddingUpdate) SetModel(v string) *ContentEmbeddingUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdate) SetNillableModel(v *string) *ContentEmbeddingUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_u *ContentEmbeddingUpdate) SetContentHash(v string) *ContentEmbeddingUpdate {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdate) SetNillableContentHash(v *string) *ContentEmbeddingUpdate {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// SetVector sets the "vector" field.
+func (_u *ContentEmbeddingUpdate) SetVector(v pgvector.Vector) *ContentEmbeddingUpdate {
+	_u.mutation.SetVector(v)
+	return _u
+}
+
+// Mutation returns the ContentEmbeddingMutation object of the builder.
+func (_u *ContentEmbeddingUpdate) Mutation() *ContentEmbeddingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ContentEmbeddingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContentEmbeddingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ContentEmbeddingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContentEmbeddingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ContentEmbeddingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(contentembedding.Table, contentembedding.Columns, sqlgraph.NewFieldSpec(contentembedding.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(contentembedding.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(contentembedding.FieldKind, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedKind(); ok {
+		_spec.AddField(contentembedding.FieldKind, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SeriesID(); ok {
+		_spec.SetField(contentembedding.FieldSeriesID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.EpisodeID(); ok {
+		_spec.SetField(contentembedding.FieldEpisodeID, field.TypeUUID, value)
+	}
+	if _u.mutation.EpisodeIDCleared() {
+		_spec.ClearField(contentembedding.FieldEpisodeID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(contentembedding.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Snippet(); ok {
+		_spec.SetField(contentembedding.FieldSnippet, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(contentembedding.FieldLanguage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(contentembedding.FieldLevel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(contentembedding.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, contentembedding.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(contentembedding.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(contentembedding.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(contentembedding.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Vector(); ok {
+		_spec.SetField(contentembedding.FieldVector, field.TypeOther, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentembedding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ContentEmbeddingUpdateOne is the builder for updating a single ContentEmbedding entity.
+type ContentEmbeddingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ContentEmbeddingMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ContentEmbeddingUpdateOne) SetUpdatedAt(v time.Time) *ContentEmbeddingUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdateOne) SetNillableUpdatedAt(v *time.Time) *ContentEmbeddingUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ContentEmbeddingUpdateOne) SetKind(v int) *ContentEmbeddingUpdateOne {
+	_u.mutation.ResetKind()
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdateOne) SetNillableKind(v *int) *ContentEmbeddingUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// AddKind adds value to the "kind" field.
+func (_u *ContentEmbeddingUpdateOne) AddKind(v int) *ContentEmbeddingUpdateOne {
+	_u.mutation.AddKind(v)
+	return _u
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_u *ContentEmbeddingUpdateOne) SetSeriesID(v uuid.UUID) *ContentEmbeddingUpdateOne {
+	_u.mutation.SetSeriesID(v)
+	return _u
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdateOne) SetNillableSeriesID(v *uuid.UUID) *ContentEmbeddingUpdateOne {
+	if v != nil {
+		_u.SetSeriesID(*v)
+	}
+	return _u
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_u *ContentEmbeddingUpdateOne) SetEpisodeID(v uuid.UUID) *ContentEmbeddingUpdateOne {
+	_u.mutation.SetEpisodeID(v)
+	return _u
+}
+
+// SetNillableEpisodeID sets the "episode_id" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdateOne) SetNillableEpisodeID(v *uuid.UUID) *ContentEmbeddingUpdateOne {
+	if v != nil {
+		_u.SetEpisodeID(*v)
+	}
+	return _u
+}
+
+// ClearEpisodeID clears the value of the "episode_id" field.
+func (_u *ContentEmbeddingUpdateOne) ClearEpisodeID() *ContentEmbeddingUpdateOne {
+	_u.mutation.ClearEpisodeID()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *ContentEmbeddingUpdateOne) SetTitle(v string) *ContentEmbeddingUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdateOne) SetNillableTitle(v *string) *ContentEmbeddingUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetSnippet sets the "snippet" field.
+func (_u *ContentEmbeddingUpdateOne) SetSnippet(v string) *ContentEmbeddingUpdateOne {
+	_u.mutation.SetSnippet(v)
+	return _u
+}
+
+// SetNillableSnippet sets the "snippet" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdateOne) SetNillableSnippet(v *string) *ContentEmbeddingUpdateOne {
+	if v != nil {
+		_u.SetSnippet(*v)
+	}
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *ContentEmbeddingUpdateOne) SetLanguage(v string) *ContentEmbeddingUpdateOne {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdateOne) SetNillableLanguage(v *string) *ContentEmbeddingUpdateOne {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// SetLevel sets the "level" field.
+func (_u *ContentEmbeddingUpdateOne) SetLevel(v string) *ContentEmbeddingUpdateOne {
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdateOne) SetNillableLevel(v *string) *ContentEmbeddingUpdateOne {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *ContentEmbeddingUpdateOne) SetTags(v []string) *ContentEmbeddingUpdateOne {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *ContentEmbeddingUpdateOne) AppendTags(v []string) *ContentEmbeddingUpdateOne {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *ContentEmbeddingUpdateOne) ClearTags() *ContentEmbeddingUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *ContentEmbeddingUpdateOne) SetModel(v string) *ContentEmbeddingUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdateOne) SetNillableModel(v *string) *ContentEmbeddingUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_u *ContentEmbeddingUpdateOne) SetContentHash(v string) *ContentEmbeddingUpdateOne {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *ContentEmbeddingUpdateOne) SetNillableContentHash(v *string) *ContentEmbeddingUpdateOne {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// SetVector sets the "vector" field.
+func (_u *ContentEmbeddingUpdateOne) SetVector(v pgvector.Vector) *ContentEmbeddingUpdateOne {
+	_u.mutation.SetVector(v)
+	return _u
+}
+
+// Mutation returns the ContentEmbeddingMutation object of the builder.
+func (_u *ContentEmbeddingUpdateOne) Mutation() *ContentEmbeddingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ContentEmbeddingUpdate builder.
+func (_u *ContentEmbeddingUpdateOne) Where(ps ...predicate.ContentEmbedding) *ContentEmbeddingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ContentEmbeddingUpdateOne) Select(field string, fields ...string) *ContentEmbeddingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ContentEmbedding entity.
+func (_u *ContentEmbeddingUpdateOne) Save(ctx context.Context) (*ContentEmbedding, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContentEmbeddingUpdateOne) SaveX(ctx context.Context) *ContentEmbedding {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ContentEmbeddingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContentEmbeddingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ContentEmbeddingUpdateOne) sqlSave(ctx context.Context) (_node *ContentEmbedding, err error) {
+	_spec := sqlgraph.NewUpdateSpec(contentembedding.Table, contentembedding.Columns, sqlgraph.NewFieldSpec(contentembedding.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "ContentEmbedding.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contentembedding.FieldID)
+		for _, f := range fields {
+			if !contentembedding.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != contentembedding.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(contentembedding.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(contentembedding.FieldKind, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedKind(); ok {
+		_spec.AddField(contentembedding.FieldKind, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SeriesID(); ok {
+		_spec.SetField(contentembedding.FieldSeriesID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.EpisodeID(); ok {
+		_spec.SetField(contentembedding.FieldEpisodeID, field.TypeUUID, value)
+	}
+	if _u.mutation.EpisodeIDCleared() {
+		_spec.ClearField(contentembedding.FieldEpisodeID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(contentembedding.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Snippet(); ok {
+		_spec.SetField(contentembedding.FieldSnippet, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(contentembedding.FieldLanguage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(contentembedding.FieldLevel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(contentembedding.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, contentembedding.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(contentembedding.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(contentembedding.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(contentembedding.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Vector(); ok {
+		_spec.SetField(contentembedding.FieldVector, field.TypeOther, value)
+	}
+	_node = &ContentEmbedding{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentembedding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
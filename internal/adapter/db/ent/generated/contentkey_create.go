@@ -0,0 +1,246 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentkey"
+	"github.com/google/uuid"
+)
+
+// ContentKeyCreate is the builder for creating a ContentKey entity.
+type ContentKeyCreate struct {
+	config
+	mutation *ContentKeyMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ContentKeyCreate) SetCreatedAt(v time.Time) *ContentKeyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetAssetID sets the "asset_id" field.
+func (_c *ContentKeyCreate) SetAssetID(v uuid.UUID) *ContentKeyCreate {
+	_c.mutation.SetAssetID(v)
+	return _c
+}
+
+// SetKey sets the "key" field.
+func (_c *ContentKeyCreate) SetKey(v []byte) *ContentKeyCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ContentKeyCreate) SetID(v uuid.UUID) *ContentKeyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ContentKeyCreate) SetNillableID(v *uuid.UUID) *ContentKeyCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ContentKeyMutation object of the builder.
+func (_c *ContentKeyCreate) Mutation() *ContentKeyMutation {
+	return _c.mutation
+}
+
+// Save creates the ContentKey in the database.
+func (_c *ContentKeyCreate) Save(ctx context.Context) (*ContentKey, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ContentKeyCreate) SaveX(ctx context.Context) *ContentKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContentKeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContentKeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ContentKeyCreate) defaults() error {
+	if _, ok := _c.mutation.ID(); !ok {
+		if contentkey.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized contentkey.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := contentkey.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ContentKeyCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "ContentKey.created_at"`)}
+	}
+	if _, ok := _c.mutation.AssetID(); !ok {
+		return &ValidationError{Name: "asset_id", err: errors.New(`generated: missing required field "ContentKey.asset_id"`)}
+	}
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`generated: missing required field "ContentKey.key"`)}
+	}
+	return nil
+}
+
+func (_c *ContentKeyCreate) sqlSave(ctx context.Context) (*ContentKey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ContentKeyCreate) createSpec() (*ContentKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContentKey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(contentkey.Table, sqlgraph.NewFieldSpec(contentkey.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(contentkey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.AssetID(); ok {
+		_spec.SetField(contentkey.FieldAssetID, field.TypeUUID, value)
+		_node.AssetID = value
+	}
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(contentkey.FieldKey, field.TypeBytes, value)
+		_node.Key = value
+	}
+	return _node, _spec
+}
+
+// ContentKeyCreateBulk is the builder for creating many ContentKey entities in bulk.
+type ContentKeyCreateBulk struct {
+	config
+	err      error
+	builders []*ContentKeyCreate
+}
+
+// Save creates the ContentKey entities in the database.
+func (_c *ContentKeyCreateBulk) Save(ctx context.Context) ([]*ContentKey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ContentKey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContentKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ContentKeyCreateBulk) SaveX(ctx context.Context) []*ContentKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContentKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContentKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
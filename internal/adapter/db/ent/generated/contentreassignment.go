@@ -0,0 +1,145 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentreassignment"
+	"github.com/google/uuid"
+)
+
+// ContentReassignment is the model entity for the ContentReassignment schema.
+type ContentReassignment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// FromAuthorID holds the value of the "from_author_id" field.
+	FromAuthorID string `json:"from_author_id,omitempty"`
+	// ToAuthorID holds the value of the "to_author_id" field.
+	ToAuthorID string `json:"to_author_id,omitempty"`
+	// SeriesIds holds the value of the "series_ids" field.
+	SeriesIds    []uuid.UUID `json:"series_ids,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ContentReassignment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case contentreassignment.FieldSeriesIds:
+			values[i] = new([]byte)
+		case contentreassignment.FieldFromAuthorID, contentreassignment.FieldToAuthorID:
+			values[i] = new(sql.NullString)
+		case contentreassignment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case contentreassignment.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ContentReassignment fields.
+func (_m *ContentReassignment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case contentreassignment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case contentreassignment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case contentreassignment.FieldFromAuthorID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_author_id", values[i])
+			} else if value.Valid {
+				_m.FromAuthorID = value.String
+			}
+		case contentreassignment.FieldToAuthorID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_author_id", values[i])
+			} else if value.Valid {
+				_m.ToAuthorID = value.String
+			}
+		case contentreassignment.FieldSeriesIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field series_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SeriesIds); err != nil {
+					return fmt.Errorf("unmarshal field series_ids: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ContentReassignment.
+// This includes values selected through modifiers, order, etc.
+func (_m *ContentReassignment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ContentReassignment.
+// Note that you need to call ContentReassignment.Unwrap() before calling this method if this ContentReassignment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ContentReassignment) Update() *ContentReassignmentUpdateOne {
+	return NewContentReassignmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ContentReassignment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ContentReassignment) Unwrap() *ContentReassignment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: ContentReassignment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ContentReassignment) String() string {
+	var builder strings.Builder
+	builder.WriteString("ContentReassignment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("from_author_id=")
+	builder.WriteString(_m.FromAuthorID)
+	builder.WriteString(", ")
+	builder.WriteString("to_author_id=")
+	builder.WriteString(_m.ToAuthorID)
+	builder.WriteString(", ")
+	builder.WriteString("series_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SeriesIds))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ContentReassignments is a parsable slice of ContentReassignment.
+type ContentReassignments []*ContentReassignment
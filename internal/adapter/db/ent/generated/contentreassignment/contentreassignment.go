@@ -0,0 +1,79 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contentreassignment
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the contentreassignment type in the database.
+	Label = "content_reassignment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldFromAuthorID holds the string denoting the from_author_id field in the database.
+	FieldFromAuthorID = "from_author_id"
+	// FieldToAuthorID holds the string denoting the to_author_id field in the database.
+	FieldToAuthorID = "to_author_id"
+	// FieldSeriesIds holds the string denoting the series_ids field in the database.
+	FieldSeriesIds = "series_ids"
+	// Table holds the table name of the contentreassignment in the database.
+	Table = "content_reassignments"
+)
+
+// Columns holds all SQL columns for contentreassignment fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldFromAuthorID,
+	FieldToAuthorID,
+	FieldSeriesIds,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eslsoft/lession/internal/adapter/db/ent/generated/runtime"
+var (
+	Hooks [1]ent.Hook
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ContentReassignment queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByFromAuthorID orders the results by the from_author_id field.
+func ByFromAuthorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromAuthorID, opts...).ToFunc()
+}
+
+// ByToAuthorID orders the results by the to_author_id field.
+func ByToAuthorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToAuthorID, opts...).ToFunc()
+}
@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contentreassignment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FromAuthorID applies equality check predicate on the "from_author_id" field. It's identical to FromAuthorIDEQ.
+func FromAuthorID(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldEQ(FieldFromAuthorID, v))
+}
+
+// ToAuthorID applies equality check predicate on the "to_author_id" field. It's identical to ToAuthorIDEQ.
+func ToAuthorID(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldEQ(FieldToAuthorID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// FromAuthorIDEQ applies the EQ predicate on the "from_author_id" field.
+func FromAuthorIDEQ(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldEQ(FieldFromAuthorID, v))
+}
+
+// FromAuthorIDNEQ applies the NEQ predicate on the "from_author_id" field.
+func FromAuthorIDNEQ(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldNEQ(FieldFromAuthorID, v))
+}
+
+// FromAuthorIDIn applies the In predicate on the "from_author_id" field.
+func FromAuthorIDIn(vs ...string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldIn(FieldFromAuthorID, vs...))
+}
+
+// FromAuthorIDNotIn applies the NotIn predicate on the "from_author_id" field.
+func FromAuthorIDNotIn(vs ...string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldNotIn(FieldFromAuthorID, vs...))
+}
+
+// FromAuthorIDGT applies the GT predicate on the "from_author_id" field.
+func FromAuthorIDGT(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldGT(FieldFromAuthorID, v))
+}
+
+// FromAuthorIDGTE applies the GTE predicate on the "from_author_id" field.
+func FromAuthorIDGTE(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldGTE(FieldFromAuthorID, v))
+}
+
+// FromAuthorIDLT applies the LT predicate on the "from_author_id" field.
+func FromAuthorIDLT(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldLT(FieldFromAuthorID, v))
+}
+
+// FromAuthorIDLTE applies the LTE predicate on the "from_author_id" field.
+func FromAuthorIDLTE(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldLTE(FieldFromAuthorID, v))
+}
+
+// FromAuthorIDContains applies the Contains predicate on the "from_author_id" field.
+func FromAuthorIDContains(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldContains(FieldFromAuthorID, v))
+}
+
+// FromAuthorIDHasPrefix applies the HasPrefix predicate on the "from_author_id" field.
+func FromAuthorIDHasPrefix(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldHasPrefix(FieldFromAuthorID, v))
+}
+
+// FromAuthorIDHasSuffix applies the HasSuffix predicate on the "from_author_id" field.
+func FromAuthorIDHasSuffix(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldHasSuffix(FieldFromAuthorID, v))
+}
+
+// FromAuthorIDEqualFold applies the EqualFold predicate on the "from_author_id" field.
+func FromAuthorIDEqualFold(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldEqualFold(FieldFromAuthorID, v))
+}
+
+// FromAuthorIDContainsFold applies the ContainsFold predicate on the "from_author_id" field.
+func FromAuthorIDContainsFold(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldContainsFold(FieldFromAuthorID, v))
+}
+
+// ToAuthorIDEQ applies the EQ predicate on the "to_author_id" field.
+func ToAuthorIDEQ(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldEQ(FieldToAuthorID, v))
+}
+
+// ToAuthorIDNEQ applies the NEQ predicate on the "to_author_id" field.
+func ToAuthorIDNEQ(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldNEQ(FieldToAuthorID, v))
+}
+
+// ToAuthorIDIn applies the In predicate on the "to_author_id" field.
+func ToAuthorIDIn(vs ...string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldIn(FieldToAuthorID, vs...))
+}
+
+// ToAuthorIDNotIn applies the NotIn predicate on the "to_author_id" field.
+func ToAuthorIDNotIn(vs ...string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldNotIn(FieldToAuthorID, vs...))
+}
+
+// ToAuthorIDGT applies the GT predicate on the "to_author_id" field.
+func ToAuthorIDGT(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldGT(FieldToAuthorID, v))
+}
+
+// ToAuthorIDGTE applies the GTE predicate on the "to_author_id" field.
+func ToAuthorIDGTE(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldGTE(FieldToAuthorID, v))
+}
+
+// ToAuthorIDLT applies the LT predicate on the "to_author_id" field.
+func ToAuthorIDLT(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldLT(FieldToAuthorID, v))
+}
+
+// ToAuthorIDLTE applies the LTE predicate on the "to_author_id" field.
+func ToAuthorIDLTE(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldLTE(FieldToAuthorID, v))
+}
+
+// ToAuthorIDContains applies the Contains predicate on the "to_author_id" field.
+func ToAuthorIDContains(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldContains(FieldToAuthorID, v))
+}
+
+// ToAuthorIDHasPrefix applies the HasPrefix predicate on the "to_author_id" field.
+func ToAuthorIDHasPrefix(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldHasPrefix(FieldToAuthorID, v))
+}
+
+// ToAuthorIDHasSuffix applies the HasSuffix predicate on the "to_author_id" field.
+func ToAuthorIDHasSuffix(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldHasSuffix(FieldToAuthorID, v))
+}
+
+// ToAuthorIDEqualFold applies the EqualFold predicate on the "to_author_id" field.
+func ToAuthorIDEqualFold(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldEqualFold(FieldToAuthorID, v))
+}
+
+// ToAuthorIDContainsFold applies the ContainsFold predicate on the "to_author_id" field.
+func ToAuthorIDContainsFold(v string) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldContainsFold(FieldToAuthorID, v))
+}
+
+// SeriesIdsIsNil applies the IsNil predicate on the "series_ids" field.
+func SeriesIdsIsNil() predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldIsNull(FieldSeriesIds))
+}
+
+// SeriesIdsNotNil applies the NotNil predicate on the "series_ids" field.
+func SeriesIdsNotNil() predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.FieldNotNull(FieldSeriesIds))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ContentReassignment) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ContentReassignment) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ContentReassignment) predicate.ContentReassignment {
+	return predicate.ContentReassignment(sql.NotPredicates(p))
+}
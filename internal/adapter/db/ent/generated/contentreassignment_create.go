@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentreassignment"
+	"github.com/google/uuid"
+)
+
+// ContentReassignmentCreate is the builder for creating a ContentReassignment entity.
+type ContentReassignmentCreate struct {
+	config
+	mutation *ContentReassignmentMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ContentReassignmentCreate) SetCreatedAt(v time.Time) *ContentReassignmentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetFromAuthorID sets the "from_author_id" field.
+func (_c *ContentReassignmentCreate) SetFromAuthorID(v string) *ContentReassignmentCreate {
+	_c.mutation.SetFromAuthorID(v)
+	return _c
+}
+
+// SetToAuthorID sets the "to_author_id" field.
+func (_c *ContentReassignmentCreate) SetToAuthorID(v string) *ContentReassignmentCreate {
+	_c.mutation.SetToAuthorID(v)
+	return _c
+}
+
+// SetSeriesIds sets the "series_ids" field.
+func (_c *ContentReassignmentCreate) SetSeriesIds(v []uuid.UUID) *ContentReassignmentCreate {
+	_c.mutation.SetSeriesIds(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ContentReassignmentCreate) SetID(v uuid.UUID) *ContentReassignmentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ContentReassignmentCreate) SetNillableID(v *uuid.UUID) *ContentReassignmentCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ContentReassignmentMutation object of the builder.
+func (_c *ContentReassignmentCreate) Mutation() *ContentReassignmentMutation {
+	return _c.mutation
+}
+
+// Save creates the ContentReassignment in the database.
+func (_c *ContentReassignmentCreate) Save(ctx context.Context) (*ContentReassignment, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ContentReassignmentCreate) SaveX(ctx context.Context) *ContentReassignment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContentReassignmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContentReassignmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ContentReassignmentCreate) defaults() error {
+	if _, ok := _c.mutation.ID(); !ok {
+		if contentreassignment.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized contentreassignment.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := contentreassignment.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ContentReassignmentCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "ContentReassignment.created_at"`)}
+	}
+	if _, ok := _c.mutation.FromAuthorID(); !ok {
+		return &ValidationError{Name: "from_author_id", err: errors.New(`generated: missing required field "ContentReassignment.from_author_id"`)}
+	}
+	if _, ok := _c.mutation.ToAuthorID(); !ok {
+		return &ValidationError{Name: "to_author_id", err: errors.New(`generated: missing required field "ContentReassignment.to_author_id"`)}
+	}
+	return nil
+}
+
+func (_c *ContentReassignmentCreate) sqlSave(ctx context.Context) (*ContentReassignment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ContentReassignmentCreate) createSpec() (*ContentReassignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContentReassignment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(contentreassignment.Table, sqlgraph.NewFieldSpec(contentreassignment.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(contentreassignment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.FromAuthorID(); ok {
+		_spec.SetField(contentreassignment.FieldFromAuthorID, field.TypeString, value)
+		_node.FromAuthorID = value
+	}
+	if value, ok := _c.mutation.ToAuthorID(); ok {
+		_spec.SetField(contentreassignment.FieldToAuthorID, field.TypeString, value)
+		_node.ToAuthorID = value
+	}
+	if value, ok := _c.mutation.SeriesIds(); ok {
+		_spec.SetField(contentreassignment.FieldSeriesIds, field.TypeJSON, value)
+		_node.SeriesIds = value
+	}
+	return _node, _spec
+}
+
+// ContentReassignmentCreateBulk is the builder for creating many ContentReassignment entities in bulk.
+type ContentReassignmentCreateBulk struct {
+	config
+	err      error
+	builders []*ContentReassignmentCreate
+}
+
+// Save creates the ContentReassignment entities in the database.
+func (_c *ContentReassignmentCreateBulk) Save(ctx context.Context) ([]*ContentReassignment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ContentReassignment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContentReassignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ContentReassignmentCreateBulk) SaveX(ctx context.Context) []*ContentReassignment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContentReassignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContentReassignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentreassignment"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ContentReassignmentUpdate is the builder for updating ContentReassignment entities.
+type ContentReassignmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ContentReassignmentMutation
+}
+
+// Where appends a list predicates to the ContentReassignmentUpdate builder.
+func (_u *ContentReassignmentUpdate) Where(ps ...predicate.ContentReassignment) *ContentReassignmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetFromAuthorID sets the "from_author_id" field.
+func (_u *ContentReassignmentUpdate) SetFromAuthorID(v string) *ContentReassignmentUpdate {
+	_u.mutation.SetFromAuthorID(v)
+	return _u
+}
+
+// SetNillableFromAuthorID sets the "from_author_id" field if the given value is not nil.
+func (_u *ContentReassignmentUpdate) SetNillableFromAuthorID(v *string) *ContentReassignmentUpdate {
+	if v != nil {
+		_u.SetFromAuthorID(*v)
+	}
+	return _u
+}
+
+// SetToAuthorID sets the "to_author_id" field.
+func (_u *ContentReassignmentUpdate) SetToAuthorID(v string) *ContentReassignmentUpdate {
+	_u.mutation.SetToAuthorID(v)
+	return _u
+}
+
+// SetNillableToAuthorID sets the "to_author_id" field if the given value is not nil.
+func (_u *ContentReassignmentUpdate) SetNillableToAuthorID(v *string) *ContentReassignmentUpdate {
+	if v != nil {
+		_u.SetToAuthorID(*v)
+	}
+	return _u
+}
+
+// SetSeriesIds sets the "series_ids" field.
+func (_u *ContentReassignmentUpdate) SetSeriesIds(v []uuid.UUID) *ContentReassignmentUpdate {
+	_u.mutation.SetSeriesIds(v)
+	return _u
+}
+
+// AppendSeriesIds appends value to the "series_ids" field.
+func (_u *ContentReassignmentUpdate) AppendSeriesIds(v []uuid.UUID) *ContentReassignmentUpdate {
+	_u.mutation.AppendSeriesIds(v)
+	return _u
+}
+
+// ClearSeriesIds clears the value of the "series_ids" field.
+func (_u *ContentReassignmentUpdate) ClearSeriesIds() *ContentReassignmentUpdate {
+	_u.mutation.ClearSeriesIds()
+	return _u
+}
+
+// Mutation returns the ContentReassignmentMutation object of the builder.
+func (_u *ContentReassignmentUpdate) Mutation() *ContentReassignmentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ContentReassignmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContentReassignmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ContentReassignmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContentReassignmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ContentReassignmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(contentreassignment.Table, contentreassignment.Columns, sqlgraph.NewFieldSpec(contentreassignment.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FromAuthorID(); ok {
+		_spec.SetField(contentreassignment.FieldFromAuthorID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ToAuthorID(); ok {
+		_spec.SetField(contentreassignment.FieldToAuthorID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SeriesIds(); ok {
+		_spec.SetField(contentreassignment.FieldSeriesIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSeriesIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, contentreassignment.FieldSeriesIds, value)
+		})
+	}
+	if _u.mutation.SeriesIdsCleared() {
+		_spec.ClearField(contentreassignment.FieldSeriesIds, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentreassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ContentReassignmentUpdateOne is the builder for updating a single ContentReassignment entity.
+type ContentReassignmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ContentReassignmentMutation
+}
+
+// SetFromAuthorID sets the "from_author_id" field.
+func (_u *ContentReassignmentUpdateOne) SetFromAuthorID(v string) *ContentReassignmentUpdateOne {
+	_u.mutation.SetFromAuthorID(v)
+	return _u
+}
+
+// SetNillableFromAuthorID sets the "from_author_id" field if the given value is not nil.
+func (_u *ContentReassignmentUpdateOne) SetNillableFromAuthorID(v *string) *ContentReassignmentUpdateOne {
+	if v != nil {
+		_u.SetFromAuthorID(*v)
+	}
+	return _u
+}
+
+// SetToAuthorID sets the "to_author_id" field.
+func (_u *ContentReassignmentUpdateOne) SetToAuthorID(v string) *ContentReassignmentUpdateOne {
+	_u.mutation.SetToAuthorID(v)
+	return _u
+}
+
+// SetNillableToAuthorID sets the "to_author_id" field if the given value is not nil.
+func (_u *ContentReassignmentUpdateOne) SetNillableToAuthorID(v *string) *ContentReassignmentUpdateOne {
+	if v != nil {
+		_u.SetToAuthorID(*v)
+	}
+	return _u
+}
+
+// SetSeriesIds sets the "series_ids" field.
+func (_u *ContentReassignmentUpdateOne) SetSeriesIds(v []uuid.UUID) *ContentReassignmentUpdateOne {
+	_u.mutation.SetSeriesIds(v)
+	return _u
+}
+
+// AppendSeriesIds appends value to the "series_ids" field.
+func (_u *ContentReassignmentUpdateOne) AppendSeriesIds(v []uuid.UUID) *ContentReassignmentUpdateOne {
+	_u.mutation.AppendSeriesIds(v)
+	return _u
+}
+
+// ClearSeriesIds clears the value of the "series_ids" field.
+func (_u *ContentReassignmentUpdateOne) ClearSeriesIds() *ContentReassignmentUpdateOne {
+	_u.mutation.ClearSeriesIds()
+	return _u
+}
+
+// Mutation returns the ContentReassignmentMutation object of the builder.
+func (_u *ContentReassignmentUpdateOne) Mutation() *ContentReassignmentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ContentReassignmentUpdate builder.
+func (_u *ContentReassignmentUpdateOne) Where(ps ...predicate.ContentReassignment) *ContentReassignmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ContentReassignmentUpdateOne) Select(field string, fields ...string) *ContentReassignmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ContentReassignment entity.
+func (_u *ContentReassignmentUpdateOne) Save(ctx context.Context) (*ContentReassignment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContentReassignmentUpdateOne) SaveX(ctx context.Context) *ContentReassignment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ContentReassignmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContentReassignmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ContentReassignmentUpdateOne) sqlSave(ctx context.Context) (_node *ContentReassignment, err error) {
+	_spec := sqlgraph.NewUpdateSpec(contentreassignment.Table, contentreassignment.Columns, sqlgraph.NewFieldSpec(contentreassignment.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "ContentReassignment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contentreassignment.FieldID)
+		for _, f := range fields {
+			if !contentreassignment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != contentreassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FromAuthorID(); ok {
+		_spec.SetField(contentreassignment.FieldFromAuthorID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ToAuthorID(); ok {
+		_spec.SetField(contentreassignment.FieldToAuthorID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SeriesIds(); ok {
+		_spec.SetField(contentreassignment.FieldSeriesIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSeriesIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, contentreassignment.FieldSeriesIds, value)
+		})
+	}
+	if _u.mutation.SeriesIdsCleared() {
+		_spec.ClearField(contentreassignment.FieldSeriesIds, field.TypeJSON)
+	}
+	_node = &ContentReassignment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentreassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
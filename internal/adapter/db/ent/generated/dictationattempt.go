@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/dictationattempt"
+	"github.com/eslsoft/lession/internal/core"
+	"github.com/google/uuid"
+)
+
+// DictationAttempt is the model entity for the DictationAttempt schema.
+type DictationAttempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// EpisodeID holds the value of the "episode_id" field.
+	EpisodeID uuid.UUID `json:"episode_id,omitempty"`
+	// ItemIndex holds the value of the "item_index" field.
+	ItemIndex int `json:"item_index,omitempty"`
+	// Answer holds the value of the "answer" field.
+	Answer string `json:"answer,omitempty"`
+	// Expected holds the value of the "expected" field.
+	Expected string `json:"expected,omitempty"`
+	// Score holds the value of the "score" field.
+	Score float64 `json:"score,omitempty"`
+	// Feedback holds the value of the "feedback" field.
+	Feedback     []core.DictationToken `json:"feedback,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DictationAttempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dictationattempt.FieldFeedback:
+			values[i] = new([]byte)
+		case dictationattempt.FieldScore:
+			values[i] = new(sql.NullFloat64)
+		case dictationattempt.FieldItemIndex:
+			values[i] = new(sql.NullInt64)
+		case dictationattempt.FieldUserID, dictationattempt.FieldAnswer, dictationattempt.FieldExpected:
+			values[i] = new(sql.NullString)
+		case dictationattempt.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case dictationattempt.FieldID, dictationattempt.FieldEpisodeID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DictationAttempt fields.
+func (_m *DictationAttempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dictationattempt.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case dictationattempt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case dictationattempt.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case dictationattempt.FieldEpisodeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field episode_id", values[i])
+			} else if value != nil {
+				_m.EpisodeID = *value
+			}
+		case dictationattempt.FieldItemIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field item_index", values[i])
+			} else if value.Valid {
+				_m.ItemIndex = int(value.Int64)
+			}
+		case dictationattempt.FieldAnswer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field answer", values[i])
+			} else if value.Valid {
+				_m.Answer = value.String
+			}
+		case dictationattempt.FieldExpected:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field expected", values[i])
+			} else if value.Valid {
+				_m.Expected = value.String
+			}
+		case dictationattempt.FieldScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = value.Float64
+			}
+		case dictationattempt.FieldFeedback:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field feedback", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Feedback); err != nil {
+					return fmt.Errorf("unmarshal field feedback: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DictationAttempt.
+// This includes values selected through modifiers, order, etc.
+func (_m *DictationAttempt) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DictationAttempt.
+// Note that you need to call DictationAttempt.Unwrap() before calling this method if this DictationAttempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DictationAttempt) Update() *DictationAttemptUpdateOne {
+	return NewDictationAttemptClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DictationAttempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DictationAttempt) Unwrap() *DictationAttempt {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: DictationAttempt is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DictationAttempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("DictationAttempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("episode_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EpisodeID))
+	builder.WriteString(", ")
+	builder.WriteString("item_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ItemIndex))
+	builder.WriteString(", ")
+	builder.WriteString("answer=")
+	builder.WriteString(_m.Answer)
+	builder.WriteString(", ")
+	builder.WriteString("expected=")
+	builder.WriteString(_m.Expected)
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Score))
+	builder.WriteString(", ")
+	builder.WriteString("feedback=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Feedback))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DictationAttempts is a parsable slice of DictationAttempt.
+type DictationAttempts []*DictationAttempt
@@ -0,0 +1,117 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dictationattempt
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the dictationattempt type in the database.
+	Label = "dictation_attempt"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldEpisodeID holds the string denoting the episode_id field in the database.
+	FieldEpisodeID = "episode_id"
+	// FieldItemIndex holds the string denoting the item_index field in the database.
+	FieldItemIndex = "item_index"
+	// FieldAnswer holds the string denoting the answer field in the database.
+	FieldAnswer = "answer"
+	// FieldExpected holds the string denoting the expected field in the database.
+	FieldExpected = "expected"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// FieldFeedback holds the string denoting the feedback field in the database.
+	FieldFeedback = "feedback"
+	// Table holds the table name of the dictationattempt in the database.
+	Table = "dictation_attempts"
+)
+
+// Columns holds all SQL columns for dictationattempt fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUserID,
+	FieldEpisodeID,
+	FieldItemIndex,
+	FieldAnswer,
+	FieldExpected,
+	FieldScore,
+	FieldFeedback,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eslsoft/lession/internal/adapter/db/ent/generated/runtime"
+var (
+	Hooks [1]ent.Hook
+	// DefaultAnswer holds the default value on creation for the "answer" field.
+	DefaultAnswer string
+	// DefaultExpected holds the default value on creation for the "expected" field.
+	DefaultExpected string
+	// DefaultScore holds the default value on creation for the "score" field.
+	DefaultScore float64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the DictationAttempt queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByEpisodeID orders the results by the episode_id field.
+func ByEpisodeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEpisodeID, opts...).ToFunc()
+}
+
+// ByItemIndex orders the results by the item_index field.
+func ByItemIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemIndex, opts...).ToFunc()
+}
+
+// ByAnswer orders the results by the answer field.
+func ByAnswer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswer, opts...).ToFunc()
+}
+
+// ByExpected orders the results by the expected field.
+func ByExpected(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpected, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
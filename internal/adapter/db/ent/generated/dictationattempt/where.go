@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dictationattempt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEQ(FieldUserID, v))
+}
+
+// EpisodeID applies equality check predicate on the "episode_id" field. It's identical to EpisodeIDEQ.
+func EpisodeID(v uuid.UUID) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEQ(FieldEpisodeID, v))
+}
+
+// ItemIndex applies equality check predicate on the "item_index" field. It's identical to ItemIndexEQ.
+func ItemIndex(v int) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEQ(FieldItemIndex, v))
+}
+
+// Answer applies equality check predicate on the "answer" field. It's identical to AnswerEQ.
+func Answer(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEQ(FieldAnswer, v))
+}
+
+// Expected applies equality check predicate on the "expected" field. It's identical to ExpectedEQ.
+func Expected(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEQ(FieldExpected, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v float64) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEQ(FieldScore, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// EpisodeIDEQ applies the EQ predicate on the "episode_id" field.
+func EpisodeIDEQ(v uuid.UUID) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEQ(FieldEpisodeID, v))
+}
+
+// EpisodeIDNEQ applies the NEQ predicate on the "episode_id" field.
+func EpisodeIDNEQ(v uuid.UUID) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldNEQ(FieldEpisodeID, v))
+}
+
+// EpisodeIDIn applies the In predicate on the "episode_id" field.
+func EpisodeIDIn(vs ...uuid.UUID) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldIn(FieldEpisodeID, vs...))
+}
+
+// EpisodeIDNotIn applies the NotIn predicate on the "episode_id" field.
+func EpisodeIDNotIn(vs ...uuid.UUID) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldNotIn(FieldEpisodeID, vs...))
+}
+
+// EpisodeIDGT applies the GT predicate on the "episode_id" field.
+func EpisodeIDGT(v uuid.UUID) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldGT(FieldEpisodeID, v))
+}
+
+// EpisodeIDGTE applies the GTE predicate on the "episode_id" field.
+func EpisodeIDGTE(v uuid.UUID) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldGTE(FieldEpisodeID, v))
+}
+
+// EpisodeIDLT applies the LT predicate on the "episode_id" field.
+func EpisodeIDLT(v uuid.UUID) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldLT(FieldEpisodeID, v))
+}
+
+// EpisodeIDLTE applies the LTE predicate on the "episode_id" field.
+func EpisodeIDLTE(v uuid.UUID) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldLTE(FieldEpisodeID, v))
+}
+
+// ItemIndexEQ applies the EQ predicate on the "item_index" field.
+func ItemIndexEQ(v int) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEQ(FieldItemIndex, v))
+}
+
+// ItemIndexNEQ applies the NEQ predicate on the "item_index" field.
+func ItemIndexNEQ(v int) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldNEQ(FieldItemIndex, v))
+}
+
+// ItemIndexIn applies the In predicate on the "item_index" field.
+func ItemIndexIn(vs ...int) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldIn(FieldItemIndex, vs...))
+}
+
+// ItemIndexNotIn applies the NotIn predicate on the "item_index" field.
+func ItemIndexNotIn(vs ...int) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldNotIn(FieldItemIndex, vs...))
+}
+
+// ItemIndexGT applies the GT predicate on the "item_index" field.
+func ItemIndexGT(v int) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldGT(FieldItemIndex, v))
+}
+
+// ItemIndexGTE applies the GTE predicate on the "item_index" field.
+func ItemIndexGTE(v int) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldGTE(FieldItemIndex, v))
+}
+
+// ItemIndexLT applies the LT predicate on the "item_index" field.
+func ItemIndexLT(v int) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldLT(FieldItemIndex, v))
+}
+
+// ItemIndexLTE applies the LTE predicate on the "item_index" field.
+func ItemIndexLTE(v int) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldLTE(FieldItemIndex, v))
+}
+
+// AnswerEQ applies the EQ predicate on the "answer" field.
+func AnswerEQ(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEQ(FieldAnswer, v))
+}
+
+// AnswerNEQ applies the NEQ predicate on the "answer" field.
+func AnswerNEQ(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldNEQ(FieldAnswer, v))
+}
+
+// AnswerIn applies the In predicate on the "answer" field.
+func AnswerIn(vs ...string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldIn(FieldAnswer, vs...))
+}
+
+// AnswerNotIn applies the NotIn predicate on the "answer" field.
+func AnswerNotIn(vs ...string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldNotIn(FieldAnswer, vs...))
+}
+
+// AnswerGT applies the GT predicate on the "answer" field.
+func AnswerGT(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldGT(FieldAnswer, v))
+}
+
+// AnswerGTE applies the GTE predicate on the "answer" field.
+func AnswerGTE(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldGTE(FieldAnswer, v))
+}
+
+// AnswerLT applies the LT predicate on the "answer" field.
+func AnswerLT(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldLT(FieldAnswer, v))
+}
+
+// AnswerLTE applies the LTE predicate on the "answer" field.
+func AnswerLTE(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldLTE(FieldAnswer, v))
+}
+
+// AnswerContains applies the Contains predicate on the "answer" field.
+func AnswerContains(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldContains(FieldAnswer, v))
+}
+
+// AnswerHasPrefix applies the HasPrefix predicate on the "answer" field.
+func AnswerHasPrefix(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldHasPrefix(FieldAnswer, v))
+}
+
+// AnswerHasSuffix applies the HasSuffix predicate on the "answer" field.
+func AnswerHasSuffix(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldHasSuffix(FieldAnswer, v))
+}
+
+// AnswerEqualFold applies the EqualFold predicate on the "answer" field.
+func AnswerEqualFold(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEqualFold(FieldAnswer, v))
+}
+
+// AnswerContainsFold applies the ContainsFold predicate on the "answer" field.
+func AnswerContainsFold(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldContainsFold(FieldAnswer, v))
+}
+
+// ExpectedEQ applies the EQ predicate on the "expected" field.
+func ExpectedEQ(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEQ(FieldExpected, v))
+}
+
+// ExpectedNEQ applies the NEQ predicate on the "expected" field.
+func ExpectedNEQ(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldNEQ(FieldExpected, v))
+}
+
+// ExpectedIn applies the In predicate on the "expected" field.
+func ExpectedIn(vs ...string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldIn(FieldExpected, vs...))
+}
+
+// ExpectedNotIn applies the NotIn predicate on the "expected" field.
+func ExpectedNotIn(vs ...string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldNotIn(FieldExpected, vs...))
+}
+
+// ExpectedGT applies the GT predicate on the "expected" field.
+func ExpectedGT(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldGT(FieldExpected, v))
+}
+
+// ExpectedGTE applies the GTE predicate on the "expected" field.
+func ExpectedGTE(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldGTE(FieldExpected, v))
+}
+
+// ExpectedLT applies the LT predicate on the "expected" field.
+func ExpectedLT(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldLT(FieldExpected, v))
+}
+
+// ExpectedLTE applies the LTE predicate on the "expected" field.
+func ExpectedLTE(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldLTE(FieldExpected, v))
+}
+
+// ExpectedContains applies the Contains predicate on the "expected" field.
+func ExpectedContains(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldContains(FieldExpected, v))
+}
+
+// ExpectedHasPrefix applies the HasPrefix predicate on the "expected" field.
+func ExpectedHasPrefix(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldHasPrefix(FieldExpected, v))
+}
+
+// ExpectedHasSuffix applies the HasSuffix predicate on the "expected" field.
+func ExpectedHasSuffix(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldHasSuffix(FieldExpected, v))
+}
+
+// ExpectedEqualFold applies the EqualFold predicate on the "expected" field.
+func ExpectedEqualFold(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEqualFold(FieldExpected, v))
+}
+
+// ExpectedContainsFold applies the ContainsFold predicate on the "expected" field.
+func ExpectedContainsFold(v string) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldContainsFold(FieldExpected, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v float64) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v float64) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...float64) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...float64) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v float64) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v float64) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v float64) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v float64) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldLTE(FieldScore, v))
+}
+
+// FeedbackIsNil applies the IsNil predicate on the "feedback" field.
+func FeedbackIsNil() predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldIsNull(FieldFeedback))
+}
+
+// FeedbackNotNil applies the NotNil predicate on the "feedback" field.
+func FeedbackNotNil() predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.FieldNotNull(FieldFeedback))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DictationAttempt) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DictationAttempt) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DictationAttempt) predicate.DictationAttempt {
+	return predicate.DictationAttempt(sql.NotPredicates(p))
+}
@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/dictationattempt"
+	"github.com/eslsoft/lession/internal/core"
+	"github.com/google/uuid"
+)
+
+// DictationAttemptCreate is the builder for creating a DictationAttempt entity.
+type DictationAttemptCreate struct {
+	config
+	mutation *DictationAttemptMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DictationAttemptCreate) SetCreatedAt(v time.Time) *DictationAttemptCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *DictationAttemptCreate) SetUserID(v string) *DictationAttemptCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_c *DictationAttemptCreate) SetEpisodeID(v uuid.UUID) *DictationAttemptCreate {
+	_c.mutation.SetEpisodeID(v)
+	return _c
+}
+
+// SetItemIndex sets the "item_index" field.
+func (_c *DictationAttemptCreate) SetItemIndex(v int) *DictationAttemptCreate {
+	_c.mutation.SetItemIndex(v)
+	return _c
+}
+
+// SetAnswer sets the "answer" field.
+func (_c *DictationAttemptCreate) SetAnswer(v string) *DictationAttemptCreate {
+	_c.mutation.SetAnswer(v)
+	return _c
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_c *DictationAttemptCreate) SetNillableAnswer(v *string) *DictationAttemptCreate {
+	if v != nil {
+		_c.SetAnswer(*v)
+	}
+	return _c
+}
+
+// SetExpected sets the "expected" field.
+func (_c *DictationAttemptCreate) SetExpected(v string) *DictationAttemptCreate {
+	_c.mutation.SetExpected(v)
+	return _c
+}
+
+// SetNillableExpected sets the "expected" field if the given value is not nil.
+func (_c *DictationAttemptCreate) SetNillableExpected(v *string) *DictationAttemptCreate {
+	if v != nil {
+		_c.SetExpected(*v)
+	}
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *DictationAttemptCreate) SetScore(v float64) *DictationAttemptCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_c *DictationAttemptCreate) SetNillableScore(v *float64) *DictationAttemptCreate {
+	if v != nil {
+		_c.SetScore(*v)
+	}
+	return _c
+}
+
+// SetFeedback sets the "feedback" field.
+func (_c *DictationAttemptCreate) SetFeedback(v []core.DictationToken) *DictationAttemptCreate {
+	_c.mutation.SetFeedback(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DictationAttemptCreate) SetID(v uuid.UUID) *DictationAttemptCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DictationAttemptCreate) SetNillableID(v *uuid.UUID) *DictationAttemptCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DictationAttemptMutation object of the builder.
+func (_c *DictationAttemptCreate) Mutation() *DictationAttemptMutation {
+	return _c.mutation
+}
+
+// Save creates the DictationAttempt in the database.
+func (_c *DictationAttemptCreate) Save(ctx context.Context) (*DictationAttempt, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DictationAttemptCreate) SaveX(ctx context.Context) *DictationAttempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DictationAttemptCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DictationAttemptCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DictationAttemptCreate) defaults() error {
+	if _, ok := _c.mutation.Answer(); !ok {
+		v := dictationattempt.DefaultAnswer
+		_c.mutation.SetAnswer(v)
+	}
+	if _, ok := _c.mutation.Expected(); !ok {
+		v := dictationattempt.DefaultExpected
+		_c.mutation.SetExpected(v)
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		v := dictationattempt.DefaultScore
+		_c.mutation.SetScore(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if dictationattempt.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized dictationattempt.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := dictationattempt.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DictationAttemptCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "DictationAttempt.created_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "DictationAttempt.user_id"`)}
+	}
+	if _, ok := _c.mutation.EpisodeID(); !ok {
+		return &ValidationError{Name: "episode_id", err: errors.New(`generated: missing required field "DictationAttempt.episode_id"`)}
+	}
+	if _, ok := _c.mutation.ItemIndex(); !ok {
+		return &ValidationError{Name: "item_index", err: errors.New(`generated: missing required field "DictationAttempt.item_index"`)}
+	}
+	if _, ok := _c.mutation.Answer(); !ok {
+		return &ValidationError{Name: "answer", err: errors.New(`generated: missing required field "DictationAttempt.answer"`)}
+	}
+	if _, ok := _c.mutation.Expected(); !ok {
+		return &ValidationError{Name: "expected", err: errors.New(`generated: missing required field "DictationAttempt.expected"`)}
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`generated: missing required field "DictationAttempt.score"`)}
+	}
+	return nil
+}
+
+func (_c *DictationAttemptCreate) sqlSave(ctx context.Context) (*DictationAttempt, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DictationAttemptCreate) createSpec() (*DictationAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DictationAttempt{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(dictationattempt.Table, sqlgraph.NewFieldSpec(dictationattempt.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(dictationattempt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(dictationattempt.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.EpisodeID(); ok {
+		_spec.SetField(dictationattempt.FieldEpisodeID, field.TypeUUID, value)
+		_node.EpisodeID = value
+	}
+	if value, ok := _c.mutation.ItemIndex(); ok {
+		_spec.SetField(dictationattempt.FieldItemIndex, field.TypeInt, value)
+		_node.ItemIndex = value
+	}
+	if value, ok := _c.mutation.Answer(); ok {
+		_spec.SetField(dictationattempt.FieldAnswer, field.TypeString, value)
+		_node.Answer = value
+	}
+	if value, ok := _c.mutation.Expected(); ok {
+		_spec.SetField(dictationattempt.FieldExpected, field.TypeString, value)
+		_node.Expected = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(dictationattempt.FieldScore, field.TypeFloat64, value)
+		_node.Score = value
+	}
+	if value, ok := _c.mutation.Feedback(); ok {
+		_spec.SetField(dictationattempt.FieldFeedback, field.TypeJSON, value)
+		_node.Feedback = value
+	}
+	return _node, _spec
+}
+
+// DictationAttemptCreateBulk is the builder for creating many DictationAttempt entities in bulk.
+type DictationAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*DictationAttemptCreate
+}
+
+// Save creates the DictationAttempt entities in the database.
+func (_c *DictationAttemptCreateBulk) Save(ctx context.Context) ([]*DictationAttempt, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DictationAttempt, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DictationAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DictationAttemptCreateBulk) SaveX(ctx context.Context) []*DictationAttempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DictationAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DictationAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
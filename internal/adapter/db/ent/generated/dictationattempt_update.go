@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/dictationattempt"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/eslsoft/lession/internal/core"
+	"github.com/google/uuid"
+)
+
+// DictationAttemptUpdate is the builder for updating DictationAttempt entities.
+type DictationAttemptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DictationAttemptMutation
+}
+
+// Where appends a list predicates to the DictationAttemptUpdate builder.
+func (_u *DictationAttemptUpdate) Where(ps ...predicate.DictationAttempt) *DictationAttemptUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *DictationAttemptUpdate) SetUserID(v string) *DictationAttemptUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *DictationAttemptUpdate) SetNillableUserID(v *string) *DictationAttemptUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_u *DictationAttemptUpdate) SetEpisodeID(v uuid.UUID) *DictationAttemptUpdate {
+	_u.mutation.SetEpisodeID(v)
+	return _u
+}
+
+// SetNillableEpisodeID sets the "episode_id" field if the given value is not nil.
+func (_u *DictationAttemptUpdate) SetNillableEpisodeID(v *uuid.UUID) *DictationAttemptUpdate {
+	if v != nil {
+		_u.SetEpisodeID(*v)
+	}
+	return _u
+}
+
+// SetItemIndex sets the "item_index" field.
+func (_u *DictationAttemptUpdate) SetItemIndex(v int) *DictationAttemptUpdate {
+	_u.mutation.ResetItemIndex()
+	_u.mutation.SetItemIndex(v)
+	return _u
+}
+
+// SetNillableItemIndex sets the "item_index" field if the given value is not nil.
+func (_u *DictationAttemptUpdate) SetNillableItemIndex(v *int) *DictationAttemptUpdate {
+	if v != nil {
+		_u.SetItemIndex(*v)
+	}
+	return _u
+}
+
+// AddItemIndex adds value to the "item_index" field.
+func (_u *DictationAttemptUpdate) AddItemIndex(v int) *DictationAttemptUpdate {
+	_u.mutation.AddItemIndex(v)
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *DictationAttemptUpdate) SetAnswer(v string) *DictationAttemptUpdate {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *DictationAttemptUpdate) SetNillableAnswer(v *string) *DictationAttemptUpdate {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// SetExpected sets the "expected" field.
+func (_u *DictationAttemptUpdate) SetExpected(v string) *DictationAttemptUpdate {
+	_u.mutation.SetExpected(v)
+	return _u
+}
+
+// SetNillableExpected sets the "expected" field if the given value is not nil.
+func (_u *DictationAttemptUpdate) SetNillableExpected(v *string) *DictationAttemptUpdate {
+	if v != nil {
+		_u.SetExpected(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *DictationAttemptUpdate) SetScore(v float64) *DictationAttemptUpdate {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *DictationAttemptUpdate) SetNillableScore(v *float64) *DictationAttemptUpdate {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *DictationAttemptUpdate) AddScore(v float64) *DictationAttemptUpdate {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetFeedback sets the "feedback" field.
+func (_u *DictationAttemptUpdate) SetFeedback(v []core.DictationToken) *DictationAttemptUpdate {
+	_u.mutation.SetFeedback(v)
+	return _u
+}
+
+// AppendFeedback appends value to the "feedback" field.
+func (_u *DictationAttemptUpdate) AppendFeedback(v []core.DictationToken) *DictationAttemptUpdate {
+	_u.mutation.AppendFeedback(v)
+	return _u
+}
+
+// ClearFeedback clears the value of the "feedback" field.
+func (_u *DictationAttemptUpdate) ClearFeedback() *DictationAttemptUpdate {
+	_u.mutation.ClearFeedback()
+	return _u
+}
+
+// Mutation returns the DictationAttemptMutation object of the builder.
+func (_u *DictationAttemptUpdate) Mutation() *DictationAttemptMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DictationAttemptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DictationAttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DictationAttemptUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DictationAttemptUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DictationAttemptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dictationattempt.Table, dictationattempt.Columns, sqlgraph.NewFieldSpec(dictationattempt.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(dictationattempt.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EpisodeID(); ok {
+		_spec.SetField(dictationattempt.FieldEpisodeID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ItemIndex(); ok {
+		_spec.SetField(dictationattempt.FieldItemIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedItemIndex(); ok {
+		_spec.AddField(dictationattempt.FieldItemIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(dictationattempt.FieldAnswer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Expected(); ok {
+		_spec.SetField(dictationattempt.FieldExpected, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(dictationattempt.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(dictationattempt.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Feedback(); ok {
+		_spec.SetField(dictationattempt.FieldFeedback, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFeedback(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, dictationattempt.FieldFeedback, value)
+		})
+	}
+	if _u.mutation.FeedbackCleared() {
+		_spec.ClearField(dictationattempt.FieldFeedback, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dictationattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DictationAttemptUpdateOne is the builder for updating a single DictationAttempt entity.
+type DictationAttemptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DictationAttemptMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *DictationAttemptUpdateOne) SetUserID(v string) *DictationAttemptUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *DictationAttemptUpdateOne) SetNillableUserID(v *string) *DictationAttemptUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_u *DictationAttemptUpdateOne) SetEpisodeID(v uuid.UUID) *DictationAttemptUpdateOne {
+	_u.mutation.SetEpisodeID(v)
+	return _u
+}
+
+// SetNillableEpisodeID sets the "episode_id" field if the given value is not nil.
+func (_u *DictationAttemptUpdateOne) SetNillableEpisodeID(v *uuid.UUID) *DictationAttemptUpdateOne {
+	if v != nil {
+		_u.SetEpisodeID(*v)
+	}
+	return _u
+}
+
+// SetItemIndex sets the "item_index" field.
+func (_u *DictationAttemptUpdateOne) SetItemIndex(v int) *DictationAttemptUpdateOne {
+	_u.mutation.ResetItemIndex()
+	_u.mutation.SetItemIndex(v)
+	return _u
+}
+
+// SetNillableItemIndex sets the "item_index" field if the given value is not nil.
+func (_u *DictationAttemptUpdateOne) SetNillableItemIndex(v *int) *DictationAttemptUpdateOne {
+	if v != nil {
+		_u.SetItemIndex(*v)
+	}
+	return _u
+}
+
+// AddItemIndex adds value to the "item_index" field.
+func (_u *DictationAttemptUpdateOne) AddItemIndex(v int) *DictationAttemptUpdateOne {
+	_u.mutation.AddItemIndex(v)
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *DictationAttemptUpdateOne) SetAnswer(v string) *DictationAttemptUpdateOne {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *DictationAttemptUpdateOne) SetNillableAnswer(v *string) *DictationAttemptUpdateOne {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// SetExpected sets the "expected" field.
+func (_u *DictationAttemptUpdateOne) SetExpected(v string) *DictationAttemptUpdateOne {
+	_u.mutation.SetExpected(v)
+	return _u
+}
+
+// SetNillableExpected sets the "expected" field if the given value is not nil.
+func (_u *DictationAttemptUpdateOne) SetNillableExpected(v *string) *DictationAttemptUpdateOne {
+	if v != nil {
+		_u.SetExpected(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *DictationAttemptUpdateOne) SetScore(v float64) *DictationAttemptUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *DictationAttemptUpdateOne) SetNillableScore(v *float64) *DictationAttemptUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *DictationAttemptUpdateOne) AddScore(v float64) *DictationAttemptUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetFeedback sets the "feedback" field.
+func (_u *DictationAttemptUpdateOne) SetFeedback(v []core.DictationToken) *DictationAttemptUpdateOne {
+	_u.mutation.SetFeedback(v)
+	return _u
+}
+
+// AppendFeedback appends value to the "feedback" field.
+func (_u *DictationAttemptUpdateOne) AppendFeedback(v []core.DictationToken) *DictationAttemptUpdateOne {
+	_u.mutation.AppendFeedback(v)
+	return _u
+}
+
+// ClearFeedback clears the value of the "feedback" field.
+func (_u *DictationAttemptUpdateOne) ClearFeedback() *DictationAttemptUpdateOne {
+	_u.mutation.ClearFeedback()
+	return _u
+}
+
+// Mutation returns the DictationAttemptMutation object of the builder.
+func (_u *DictationAttemptUpdateOne) Mutation() *DictationAttemptMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DictationAttemptUpdate builder.
+func (_u *DictationAttemptUpdateOne) Where(ps ...predicate.DictationAttempt) *DictationAttemptUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DictationAttemptUpdateOne) Select(field string, fields ...string) *DictationAttemptUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DictationAttempt entity.
+func (_u *DictationAttemptUpdateOne) Save(ctx context.Context) (*DictationAttempt, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DictationAttemptUpdateOne) SaveX(ctx context.Context) *DictationAttempt {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DictationAttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DictationAttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DictationAttemptUpdateOne) sqlSave(ctx context.Context) (_node *DictationAttempt, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dictationattempt.Table, dictationattempt.Columns, sqlgraph.NewFieldSpec(dictationattempt.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "DictationAttempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dictationattempt.FieldID)
+		for _, f := range fields {
+			if !dictationattempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != dictationattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(dictationattempt.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EpisodeID(); ok {
+		_spec.SetField(dictationattempt.FieldEpisodeID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ItemIndex(); ok {
+		_spec.SetField(dictationattempt.FieldItemIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedItemIndex(); ok {
+		_spec.AddField(dictationattempt.FieldItemIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(dictationattempt.FieldAnswer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Expected(); ok {
+		_spec.SetField(dictationattempt.FieldExpected, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(dictationattempt.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(dictationattempt.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Feedback(); ok {
+		_spec.SetField(dictationattempt.FieldFeedback, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFeedback(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, dictationattempt.FieldFeedback, value)
+		})
+	}
+	if _u.mutation.FeedbackCleared() {
+		_spec.ClearField(dictationattempt.FieldFeedback, field.TypeJSON)
+	}
+	_node = &DictationAttempt{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dictationattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
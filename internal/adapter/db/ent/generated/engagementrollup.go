@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/engagementrollup"
+	"github.com/google/uuid"
+)
+
+// EngagementRollup is the model entity for the EngagementRollup schema.
+type EngagementRollup struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// SubjectID holds the value of the "subject_id" field.
+	SubjectID uuid.UUID `json:"subject_id,omitempty"`
+	// SeriesID holds the value of the "series_id" field.
+	SeriesID uuid.UUID `json:"series_id,omitempty"`
+	// Day holds the value of the "day" field.
+	Day *time.Time `json:"day,omitempty"`
+	// Sessions holds the value of the "sessions" field.
+	Sessions int `json:"sessions,omitempty"`
+	// FinishedSessions holds the value of the "finished_sessions" field.
+	FinishedSessions int `json:"finished_sessions,omitempty"`
+	// UniqueListeners holds the value of the "unique_listeners" field.
+	UniqueListeners int `json:"unique_listeners,omitempty"`
+	// Completers holds the value of the "completers" field.
+	Completers   int `json:"completers,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EngagementRollup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case engagementrollup.FieldSessions, engagementrollup.FieldFinishedSessions, engagementrollup.FieldUniqueListeners, engagementrollup.FieldCompleters:
+			values[i] = new(sql.NullInt64)
+		case engagementrollup.FieldCreatedAt, engagementrollup.FieldUpdatedAt, engagementrollup.FieldDay:
+			values[i] = new(sql.NullTime)
+		case engagementrollup.FieldID, engagementrollup.FieldSubjectID, engagementrollup.FieldSeriesID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EngagementRollup fields.
+func (_m *EngagementRollup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case engagementrollup.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case engagementrollup.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case engagementrollup.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case engagementrollup.FieldSubjectID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_id", values[i])
+			} else if value != nil {
+				_m.SubjectID = *value
+			}
+		case engagementrollup.FieldSeriesID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field series_id", values[i])
+			} else if value != nil {
+				_m.SeriesID = *value
+			}
+		case engagementrollup.FieldDay:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				_m.Day = new(time.Time)
+				*_m.Day = value.Time
+			}
+		case engagementrollup.FieldSessions:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sessions", values[i])
+			} else if value.Valid {
+				_m.Sessions = int(value.Int64)
+			}
+		case engagementrollup.FieldFinishedSessions:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_sessions", values[i])
+			} else if value.Valid {
+				_m.FinishedSessions = int(value.Int64)
+			}
+		case engagementrollup.FieldUniqueListeners:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field unique_listeners", values[i])
+			} else if value.Valid {
+				_m.UniqueListeners = int(value.Int64)
+			}
+		case engagementrollup.FieldCompleters:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field completers", values[i])
+			} else if value.Valid {
+				_m.Completers = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EngagementRollup.
+// This includes values selected through modifiers, order, etc.
+func (_m *EngagementRollup) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EngagementRollup.
+// Note that you need to call EngagementRollup.Unwrap() before calling this method if this EngagementRollup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EngagementRollup) Update() *EngagementRollupUpdateOne {
+	return NewEngagementRollupClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EngagementRollup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EngagementRollup) Unwrap() *EngagementRollup {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: EngagementRollup is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EngagementRollup) String() string {
+	var builder strings.Builder
+	builder.WriteString("EngagementRollup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("subject_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SubjectID))
+	builder.WriteString(", ")
+	builder.WriteString("series_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SeriesID))
+	builder.WriteString(", ")
+	if v := _m.Day; v != nil {
+		builder.WriteString("day=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("sessions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sessions))
+	builder.WriteString(", ")
+	builder.WriteString("finished_sessions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FinishedSessions))
+	builder.WriteString(", ")
+	builder.WriteString("unique_listeners=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UniqueListeners))
+	builder.WriteString(", ")
+	builder.WriteString("completers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Completers))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EngagementRollups is a parsable slice of EngagementRollup.
+type EngagementRollups []*EngagementRollup
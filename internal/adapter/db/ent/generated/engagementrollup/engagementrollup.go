@@ -0,0 +1,132 @@
+// Code generated by ent, DO NOT EDIT.
+
+package engagementrollup
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the engagementrollup type in the database.
+	Label = "engagement_rollup"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldSubjectID holds the string denoting the subject_id field in the database.
+	FieldSubjectID = "subject_id"
+	// FieldSeriesID holds the string denoting the series_id field in the database.
+	FieldSeriesID = "series_id"
+	// FieldDay holds the string denoting the day field in the database.
+	FieldDay = "day"
+	// FieldSessions holds the string denoting the sessions field in the database.
+	FieldSessions = "sessions"
+	// FieldFinishedSessions holds the string denoting the finished_sessions field in the database.
+	FieldFinishedSessions = "finished_sessions"
+	// FieldUniqueListeners holds the string denoting the unique_listeners field in the database.
+	FieldUniqueListeners = "unique_listeners"
+	// FieldCompleters holds the string denoting the completers field in the database.
+	FieldCompleters = "completers"
+	// Table holds the table name of the engagementrollup in the database.
+	Table = "engagement_rollups"
+)
+
+// Columns holds all SQL columns for engagementrollup fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldSubjectID,
+	FieldSeriesID,
+	FieldDay,
+	FieldSessions,
+	FieldFinishedSessions,
+	FieldUniqueListeners,
+	FieldCompleters,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eslsoft/lession/internal/adapter/db/ent/generated/runtime"
+var (
+	Hooks [2]ent.Hook
+	// DefaultSessions holds the default value on creation for the "sessions" field.
+	DefaultSessions int
+	// DefaultFinishedSessions holds the default value on creation for the "finished_sessions" field.
+	DefaultFinishedSessions int
+	// DefaultUniqueListeners holds the default value on creation for the "unique_listeners" field.
+	DefaultUniqueListeners int
+	// DefaultCompleters holds the default value on creation for the "completers" field.
+	DefaultCompleters int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the EngagementRollup queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// BySubjectID orders the results by the subject_id field.
+func BySubjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubjectID, opts...).ToFunc()
+}
+
+// BySeriesID orders the results by the series_id field.
+func BySeriesID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeriesID, opts...).ToFunc()
+}
+
+// ByDay orders the results by the day field.
+func ByDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDay, opts...).ToFunc()
+}
+
+// BySessions orders the results by the sessions field.
+func BySessions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessions, opts...).ToFunc()
+}
+
+// ByFinishedSessions orders the results by the finished_sessions field.
+func ByFinishedSessions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedSessions, opts...).ToFunc()
+}
+
+// ByUniqueListeners orders the results by the unique_listeners field.
+func ByUniqueListeners(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUniqueListeners, opts...).ToFunc()
+}
+
+// ByCompleters orders the results by the completers field.
+func ByCompleters(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompleters, opts...).ToFunc()
+}
@@ -0,0 +1,486 @@
+// Code generated by ent, DO NOT EDIT.
+
+package engagementrollup
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// SubjectID applies equality check predicate on the "subject_id" field. It's identical to SubjectIDEQ.
+func SubjectID(v uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldSubjectID, v))
+}
+
+// SeriesID applies equality check predicate on the "series_id" field. It's identical to SeriesIDEQ.
+func SeriesID(v uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldDay, v))
+}
+
+// Sessions applies equality check predicate on the "sessions" field. It's identical to SessionsEQ.
+func Sessions(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldSessions, v))
+}
+
+// FinishedSessions applies equality check predicate on the "finished_sessions" field. It's identical to FinishedSessionsEQ.
+func FinishedSessions(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldFinishedSessions, v))
+}
+
+// UniqueListeners applies equality check predicate on the "unique_listeners" field. It's identical to UniqueListenersEQ.
+func UniqueListeners(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldUniqueListeners, v))
+}
+
+// Completers applies equality check predicate on the "completers" field. It's identical to CompletersEQ.
+func Completers(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldCompleters, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// SubjectIDEQ applies the EQ predicate on the "subject_id" field.
+func SubjectIDEQ(v uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldSubjectID, v))
+}
+
+// SubjectIDNEQ applies the NEQ predicate on the "subject_id" field.
+func SubjectIDNEQ(v uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNEQ(FieldSubjectID, v))
+}
+
+// SubjectIDIn applies the In predicate on the "subject_id" field.
+func SubjectIDIn(vs ...uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldIn(FieldSubjectID, vs...))
+}
+
+// SubjectIDNotIn applies the NotIn predicate on the "subject_id" field.
+func SubjectIDNotIn(vs ...uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNotIn(FieldSubjectID, vs...))
+}
+
+// SubjectIDGT applies the GT predicate on the "subject_id" field.
+func SubjectIDGT(v uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGT(FieldSubjectID, v))
+}
+
+// SubjectIDGTE applies the GTE predicate on the "subject_id" field.
+func SubjectIDGTE(v uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGTE(FieldSubjectID, v))
+}
+
+// SubjectIDLT applies the LT predicate on the "subject_id" field.
+func SubjectIDLT(v uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLT(FieldSubjectID, v))
+}
+
+// SubjectIDLTE applies the LTE predicate on the "subject_id" field.
+func SubjectIDLTE(v uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLTE(FieldSubjectID, v))
+}
+
+// SeriesIDEQ applies the EQ predicate on the "series_id" field.
+func SeriesIDEQ(v uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// SeriesIDNEQ applies the NEQ predicate on the "series_id" field.
+func SeriesIDNEQ(v uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNEQ(FieldSeriesID, v))
+}
+
+// SeriesIDIn applies the In predicate on the "series_id" field.
+func SeriesIDIn(vs ...uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDNotIn applies the NotIn predicate on the "series_id" field.
+func SeriesIDNotIn(vs ...uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNotIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDGT applies the GT predicate on the "series_id" field.
+func SeriesIDGT(v uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGT(FieldSeriesID, v))
+}
+
+// SeriesIDGTE applies the GTE predicate on the "series_id" field.
+func SeriesIDGTE(v uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGTE(FieldSeriesID, v))
+}
+
+// SeriesIDLT applies the LT predicate on the "series_id" field.
+func SeriesIDLT(v uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLT(FieldSeriesID, v))
+}
+
+// SeriesIDLTE applies the LTE predicate on the "series_id" field.
+func SeriesIDLTE(v uuid.UUID) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLTE(FieldSeriesID, v))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v time.Time) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLTE(FieldDay, v))
+}
+
+// DayIsNil applies the IsNil predicate on the "day" field.
+func DayIsNil() predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldIsNull(FieldDay))
+}
+
+// DayNotNil applies the NotNil predicate on the "day" field.
+func DayNotNil() predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNotNull(FieldDay))
+}
+
+// SessionsEQ applies the EQ predicate on the "sessions" field.
+func SessionsEQ(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldSessions, v))
+}
+
+// SessionsNEQ applies the NEQ predicate on the "sessions" field.
+func SessionsNEQ(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNEQ(FieldSessions, v))
+}
+
+// SessionsIn applies the In predicate on the "sessions" field.
+func SessionsIn(vs ...int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldIn(FieldSessions, vs...))
+}
+
+// SessionsNotIn applies the NotIn predicate on the "sessions" field.
+func SessionsNotIn(vs ...int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNotIn(FieldSessions, vs...))
+}
+
+// SessionsGT applies the GT predicate on the "sessions" field.
+func SessionsGT(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGT(FieldSessions, v))
+}
+
+// SessionsGTE applies the GTE predicate on the "sessions" field.
+func SessionsGTE(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGTE(FieldSessions, v))
+}
+
+// SessionsLT applies the LT predicate on the "sessions" field.
+func SessionsLT(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLT(FieldSessions, v))
+}
+
+// SessionsLTE applies the LTE predicate on the "sessions" field.
+func SessionsLTE(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLTE(FieldSessions, v))
+}
+
+// FinishedSessionsEQ applies the EQ predicate on the "finished_sessions" field.
+func FinishedSessionsEQ(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldFinishedSessions, v))
+}
+
+// FinishedSessionsNEQ applies the NEQ predicate on the "finished_sessions" field.
+func FinishedSessionsNEQ(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNEQ(FieldFinishedSessions, v))
+}
+
+// FinishedSessionsIn applies the In predicate on the "finished_sessions" field.
+func FinishedSessionsIn(vs ...int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldIn(FieldFinishedSessions, vs...))
+}
+
+// FinishedSessionsNotIn applies the NotIn predicate on the "finished_sessions" field.
+func FinishedSessionsNotIn(vs ...int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNotIn(FieldFinishedSessions, vs...))
+}
+
+// FinishedSessionsGT applies the GT predicate on the "finished_sessions" field.
+func FinishedSessionsGT(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGT(FieldFinishedSessions, v))
+}
+
+// FinishedSessionsGTE applies the GTE predicate on the "finished_sessions" field.
+func FinishedSessionsGTE(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGTE(FieldFinishedSessions, v))
+}
+
+// FinishedSessionsLT applies the LT predicate on the "finished_sessions" field.
+func FinishedSessionsLT(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLT(FieldFinishedSessions, v))
+}
+
+// FinishedSessionsLTE applies the LTE predicate on the "finished_sessions" field.
+func FinishedSessionsLTE(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLTE(FieldFinishedSessions, v))
+}
+
+// UniqueListenersEQ applies the EQ predicate on the "unique_listeners" field.
+func UniqueListenersEQ(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldUniqueListeners, v))
+}
+
+// UniqueListenersNEQ applies the NEQ predicate on the "unique_listeners" field.
+func UniqueListenersNEQ(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNEQ(FieldUniqueListeners, v))
+}
+
+// UniqueListenersIn applies the In predicate on the "unique_listeners" field.
+func UniqueListenersIn(vs ...int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldIn(FieldUniqueListeners, vs...))
+}
+
+// UniqueListenersNotIn applies the NotIn predicate on the "unique_listeners" field.
+func UniqueListenersNotIn(vs ...int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNotIn(FieldUniqueListeners, vs...))
+}
+
+// UniqueListenersGT applies the GT predicate on the "unique_listeners" field.
+func UniqueListenersGT(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGT(FieldUniqueListeners, v))
+}
+
+// UniqueListenersGTE applies the GTE predicate on the "unique_listeners" field.
+func UniqueListenersGTE(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGTE(FieldUniqueListeners, v))
+}
+
+// UniqueListenersLT applies the LT predicate on the "unique_listeners" field.
+func UniqueListenersLT(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLT(FieldUniqueListeners, v))
+}
+
+// UniqueListenersLTE applies the LTE predicate on the "unique_listeners" field.
+func UniqueListenersLTE(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLTE(FieldUniqueListeners, v))
+}
+
+// CompletersEQ applies the EQ predicate on the "completers" field.
+func CompletersEQ(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldEQ(FieldCompleters, v))
+}
+
+// CompletersNEQ applies the NEQ predicate on the "completers" field.
+func CompletersNEQ(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNEQ(FieldCompleters, v))
+}
+
+// CompletersIn applies the In predicate on the "completers" field.
+func CompletersIn(vs ...int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldIn(FieldCompleters, vs...))
+}
+
+// CompletersNotIn applies the NotIn predicate on the "completers" field.
+func CompletersNotIn(vs ...int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldNotIn(FieldCompleters, vs...))
+}
+
+// CompletersGT applies the GT predicate on the "completers" field.
+func CompletersGT(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGT(FieldCompleters, v))
+}
+
+// CompletersGTE applies the GTE predicate on the "completers" field.
+func CompletersGTE(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldGTE(FieldCompleters, v))
+}
+
+// CompletersLT applies the LT predicate on the "completers" field.
+func CompletersLT(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLT(FieldCompleters, v))
+}
+
+// CompletersLTE applies the LTE predicate on the "completers" field.
+func CompletersLTE(v int) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.FieldLTE(FieldCompleters, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EngagementRollup) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EngagementRollup) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EngagementRollup) predicate.EngagementRollup {
+	return predicate.EngagementRollup(sql.NotPredicates(p))
+}
@@ -0,0 +1,377 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/engagementrollup"
+	"github.com/google/uuid"
+)
+
+// EngagementRollupCreate is the builder for creating a EngagementRollup entity.
+type EngagementRollupCreate struct {
+	config
+	mutation *EngagementRollupMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EngagementRollupCreate) SetCreatedAt(v time.Time) *EngagementRollupCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EngagementRollupCreate) SetUpdatedAt(v time.Time) *EngagementRollupCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (_c *EngagementRollupCreate) SetSubjectID(v uuid.UUID) *EngagementRollupCreate {
+	_c.mutation.SetSubjectID(v)
+	return _c
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_c *EngagementRollupCreate) SetSeriesID(v uuid.UUID) *EngagementRollupCreate {
+	_c.mutation.SetSeriesID(v)
+	return _c
+}
+
+// SetDay sets the "day" field.
+func (_c *EngagementRollupCreate) SetDay(v time.Time) *EngagementRollupCreate {
+	_c.mutation.SetDay(v)
+	return _c
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (_c *EngagementRollupCreate) SetNillableDay(v *time.Time) *EngagementRollupCreate {
+	if v != nil {
+		_c.SetDay(*v)
+	}
+	return _c
+}
+
+// SetSessions sets the "sessions" field.
+func (_c *EngagementRollupCreate) SetSessions(v int) *EngagementRollupCreate {
+	_c.mutation.SetSessions(v)
+	return _c
+}
+
+// SetNillableSessions sets the "sessions" field if the given value is not nil.
+func (_c *EngagementRollupCreate) SetNillableSessions(v *int) *EngagementRollupCreate {
+	if v != nil {
+		_c.SetSessions(*v)
+	}
+	return _c
+}
+
+// SetFinishedSessions sets the "finished_sessions" field.
+func (_c *EngagementRollupCreate) SetFinishedSessions(v int) *EngagementRollupCreate {
+	_c.mutation.SetFinishedSessions(v)
+	return _c
+}
+
+// SetNillableFinishedSessions sets the "finished_sessions" field if the given value is not nil.
+func (_c *EngagementRollupCreate) SetNillableFinishedSessions(v *int) *EngagementRollupCreate {
+	if v != nil {
+		_c.SetFinishedSessions(*v)
+	}
+	return _c
+}
+
+// SetUniqueListeners sets the "unique_listeners" field.
+func (_c *EngagementRollupCreate) SetUniqueListeners(v int) *EngagementRollupCreate {
+	_c.mutation.SetUniqueListeners(v)
+	return _c
+}
+
+// SetNillableUniqueListeners sets the "unique_listeners" field if the given value is not nil.
+func (_c *EngagementRollupCreate) SetNillableUniqueListeners(v *int) *EngagementRollupCreate {
+	if v != nil {
+		_c.SetUniqueListeners(*v)
+	}
+	return _c
+}
+
+// SetCompleters sets the "completers" field.
+func (_c *EngagementRollupCreate) SetCompleters(v int) *EngagementRollupCreate {
+	_c.mutation.SetCompleters(v)
+	return _c
+}
+
+// SetNillableCompleters sets the "completers" field if the given value is not nil.
+func (_c *EngagementRollupCreate) SetNillableCompleters(v *int) *EngagementRollupCreate {
+	if v != nil {
+		_c.SetCompleters(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EngagementRollupCreate) SetID(v uuid.UUID) *EngagementRollupCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *EngagementRollupCreate) SetNillableID(v *uuid.UUID) *EngagementRollupCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EngagementRollupMutation object of the builder.
+func (_c *EngagementRollupCreate) Mutation() *EngagementRollupMutation {
+	return _c.mutation
+}
+
+// Save creates the EngagementRollup in the database.
+func (_c *EngagementRollupCreate) Save(ctx context.Context) (*EngagementRollup, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EngagementRollupCreate) SaveX(ctx context.Context) *EngagementRollup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EngagementRollupCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EngagementRollupCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EngagementRollupCreate) defaults() error {
+	if _, ok := _c.mutation.Sessions(); !ok {
+		v := engagementrollup.DefaultSessions
+		_c.mutation.SetSessions(v)
+	}
+	if _, ok := _c.mutation.FinishedSessions(); !ok {
+		v := engagementrollup.DefaultFinishedSessions
+		_c.mutation.SetFinishedSessions(v)
+	}
+	if _, ok := _c.mutation.UniqueListeners(); !ok {
+		v := engagementrollup.DefaultUniqueListeners
+		_c.mutation.SetUniqueListeners(v)
+	}
+	if _, ok := _c.mutation.Completers(); !ok {
+		v := engagementrollup.DefaultCompleters
+		_c.mutation.SetCompleters(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if engagementrollup.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized engagementrollup.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := engagementrollup.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EngagementRollupCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "EngagementRollup.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "EngagementRollup.updated_at"`)}
+	}
+	if _, ok := _c.mutation.SubjectID(); !ok {
+		return &ValidationError{Name: "subject_id", err: errors.New(`generated: missing required field "EngagementRollup.subject_id"`)}
+	}
+	if _, ok := _c.mutation.SeriesID(); !ok {
+		return &ValidationError{Name: "series_id", err: errors.New(`generated: missing required field "EngagementRollup.series_id"`)}
+	}
+	if _, ok := _c.mutation.Sessions(); !ok {
+		return &ValidationError{Name: "sessions", err: errors.New(`generated: missing required field "EngagementRollup.sessions"`)}
+	}
+	if _, ok := _c.mutation.FinishedSessions(); !ok {
+		return &ValidationError{Name: "finished_sessions", err: errors.New(`generated: missing required field "EngagementRollup.finished_sessions"`)}
+	}
+	if _, ok := _c.mutation.UniqueListeners(); !ok {
+		return &ValidationError{Name: "unique_listeners", err: errors.New(`generated: missing required field "EngagementRollup.unique_listeners"`)}
+	}
+	if _, ok := _c.mutation.Completers(); !ok {
+		return &ValidationError{Name: "completers", err: errors.New(`generated: missing required field "EngagementRollup.completers"`)}
+	}
+	return nil
+}
+
+func (_c *EngagementRollupCreate) sqlSave(ctx context.Context) (*EngagementRollup, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EngagementRollupCreate) createSpec() (*EngagementRollup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EngagementRollup{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(engagementrollup.Table, sqlgraph.NewFieldSpec(engagementrollup.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(engagementrollup.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(engagementrollup.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.SubjectID(); ok {
+		_spec.SetField(engagementrollup.FieldSubjectID, field.TypeUUID, value)
+		_node.SubjectID = value
+	}
+	if value, ok := _c.mutation.SeriesID(); ok {
+		_spec.SetField(engagementrollup.FieldSeriesID, field.TypeUUID, value)
+		_node.SeriesID = value
+	}
+	if value, ok := _c.mutation.Day(); ok {
+		_spec.SetField(engagementrollup.FieldDay, field.TypeTime, value)
+		_node.Day = &value
+	}
+	if value, ok := _c.mutation.Sessions(); ok {
+		_spec.SetField(engagementrollup.FieldSessions, field.TypeInt, value)
+		_node.Sessions = value
+	}
+	if value, ok := _c.mutation.FinishedSessions(); ok {
+		_spec.SetField(engagementrollup.FieldFinishedSessions, field.TypeInt, value)
+		_node.FinishedSessions = value
+	}
+	if value, ok := _c.mutation.UniqueListeners(); ok {
+		_spec.SetField(engagementrollup.FieldUniqueListeners, field.TypeInt, value)
+		_node.UniqueListeners = value
+	}
+	if value, ok := _c.mutation.Completers(); ok {
+		_spec.SetField(engagementrollup.FieldCompleters, field.TypeInt, value)
+		_node.Completers = value
+	}
+	return _node, _spec
+}
+
+// EngagementRollupCreateBulk is the builder for creating many EngagementRollup entities in bulk.
+type EngagementRollupCreateBulk struct {
+	config
+	err      error
+	builders []*EngagementRollupCreate
+}
+
+// Save creates the EngagementRollup entities in the database.
+func (_c *EngagementRollupCreateBulk) Save(ctx context.Context) ([]*EngagementRollup, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EngagementRollup, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EngagementRollupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EngagementRollupCreateBulk) SaveX(ctx context.Context) []*EngagementRollup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EngagementRollupCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EngagementRollupCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,432 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/engagementrollup"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+)
+
+// EngagementRollupUpdate is the builder for updating EngagementRollup entities.
+type EngagementRollupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EngagementRollupMutation
+}
+
+// Where appends a list predicates to the EngagementRollupUpdate builder.
+func (_u *EngagementRollupUpdate) Where(ps ...predicate.EngagementRollup) *EngagementRollupUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EngagementRollupUpdate) SetUpdatedAt(v time.Time) *EngagementRollupUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *EngagementRollupUpdate) SetNillableUpdatedAt(v *time.Time) *EngagementRollupUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetSessions sets the "sessions" field.
+func (_u *EngagementRollupUpdate) SetSessions(v int) *EngagementRollupUpdate {
+	_u.mutation.ResetSessions()
+	_u.mutation.SetSessions(v)
+	return _u
+}
+
+// SetNillableSessions sets the "sessions" field if the given value is not nil.
+func (_u *EngagementRollupUpdate) SetNillableSessions(v *int) *EngagementRollupUpdate {
+	if v != nil {
+		_u.SetSessions(*v)
+	}
+	return _u
+}
+
+// AddSessions adds value to the "sessions" field.
+func (_u *EngagementRollupUpdate) AddSessions(v int) *EngagementRollupUpdate {
+	_u.mutation.AddSessions(v)
+	return _u
+}
+
+// SetFinishedSessions sets the "finished_sessions" field.
+func (_u *EngagementRollupUpdate) SetFinishedSessions(v int) *EngagementRollupUpdate {
+	_u.mutation.ResetFinishedSessions()
+	_u.mutation.SetFinishedSessions(v)
+	return _u
+}
+
+// SetNillableFinishedSessions sets the "finished_sessions" field if the given value is not nil.
+func (_u *EngagementRollupUpdate) SetNillableFinishedSessions(v *int) *EngagementRollupUpdate {
+	if v != nil {
+		_u.SetFinishedSessions(*v)
+	}
+	return _u
+}
+
+// AddFinishedSessions adds value to the "finished_sessions" field.
+func (_u *EngagementRollupUpdate) AddFinishedSessions(v int) *EngagementRollupUpdate {
+	_u.mutation.AddFinishedSessions(v)
+	return _u
+}
+
+// SetUniqueListeners sets the "unique_listeners" field.
+func (_u *EngagementRollupUpdate) SetUniqueListeners(v int) *EngagementRollupUpdate {
+	_u.mutation.ResetUniqueListeners()
+	_u.mutation.SetUniqueListeners(v)
+	return _u
+}
+
+// SetNillableUniqueListeners sets the "unique_listeners" field if the given value is not nil.
+func (_u *EngagementRollupUpdate) SetNillableUniqueListeners(v *int) *EngagementRollupUpdate {
+	if v != nil {
+		_u.SetUniqueListeners(*v)
+	}
+	return _u
+}
+
+// AddUniqueListeners adds value to the "unique_listeners" field.
+func (_u *EngagementRollupUpdate) AddUniqueListeners(v int) *EngagementRollupUpdate {
+	_u.mutation.AddUniqueListeners(v)
+	return _u
+}
+
+// SetCompleters sets the "completers" field.
+func (_u *EngagementRollupUpdate) SetCompleters(v int) *EngagementRollupUpdate {
+	_u.mutation.ResetCompleters()
+	_u.mutation.SetCompleters(v)
+	return _u
+}
+
+// SetNillableCompleters sets the "completers" field if the given value is not nil.
+func (_u *EngagementRollupUpdate) SetNillableCompleters(v *int) *EngagementRollupUpdate {
+	if v != nil {
+		_u.SetCompleters(*v)
+	}
+	return _u
+}
+
+// AddCompleters adds value to the "completers" field.
+func (_u *EngagementRollupUpdate) AddCompleters(v int) *EngagementRollupUpdate {
+	_u.mutation.AddCompleters(v)
+	return _u
+}
+
+// Mutation returns the EngagementRollupMutation object of the builder.
+func (_u *EngagementRollupUpdate) Mutation() *EngagementRollupMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EngagementRollupUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EngagementRollupUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EngagementRollupUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EngagementRollupUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *EngagementRollupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(engagementrollup.Table, engagementrollup.Columns, sqlgraph.NewFieldSpec(engagementrollup.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(engagementrollup.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DayCleared() {
+		_spec.ClearField(engagementrollup.FieldDay, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Sessions(); ok {
+		_spec.SetField(engagementrollup.FieldSessions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSessions(); ok {
+		_spec.AddField(engagementrollup.FieldSessions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FinishedSessions(); ok {
+		_spec.SetField(engagementrollup.FieldFinishedSessions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFinishedSessions(); ok {
+		_spec.AddField(engagementrollup.FieldFinishedSessions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UniqueListeners(); ok {
+		_spec.SetField(engagementrollup.FieldUniqueListeners, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUniqueListeners(); ok {
+		_spec.AddField(engagementrollup.FieldUniqueListeners, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Completers(); ok {
+		_spec.SetField(engagementrollup.FieldCompleters, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompleters(); ok {
+		_spec.AddField(engagementrollup.FieldCompleters, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{engagementrollup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EngagementRollupUpdateOne is the builder for updating a single EngagementRollup entity.
+type EngagementRollupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EngagementRollupMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EngagementRollupUpdateOne) SetUpdatedAt(v time.Time) *EngagementRollupUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *EngagementRollupUpdateOne) SetNillableUpdatedAt(v *time.Time) *EngagementRollupUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetSessions sets the "sessions" field.
+func (_u *EngagementRollupUpdateOne) SetSessions(v int) *EngagementRollupUpdateOne {
+	_u.mutation.ResetSessions()
+	_u.mutation.SetSessions(v)
+	return _u
+}
+
+// SetNillableSessions sets the "sessions" field if the given value is not nil.
+func (_u *EngagementRollupUpdateOne) SetNillableSessions(v *int) *EngagementRollupUpdateOne {
+	if v != nil {
+		_u.SetSessions(*v)
+	}
+	return _u
+}
+
+// AddSessions adds value to the "sessions" field.
+func (_u *EngagementRollupUpdateOne) AddSessions(v int) *EngagementRollupUpdateOne {
+	_u.mutation.AddSessions(v)
+	return _u
+}
+
+// SetFinishedSessions sets the "finished_sessions" field.
+func (_u *EngagementRollupUpdateOne) SetFinishedSessions(v int) *EngagementRollupUpdateOne {
+	_u.mutation.ResetFinishedSessions()
+	_u.mutation.SetFinishedSessions(v)
+	return _u
+}
+
+// SetNillableFinishedSessions sets the "finished_sessions" field if the given value is not nil.
+func (_u *EngagementRollupUpdateOne) SetNillableFinishedSessions(v *int) *EngagementRollupUpdateOne {
+	if v != nil {
+		_u.SetFinishedSessions(*v)
+	}
+	return _u
+}
+
+// AddFinishedSessions adds value to the "finished_sessions" field.
+func (_u *EngagementRollupUpdateOne) AddFinishedSessions(v int) *EngagementRollupUpdateOne {
+	_u.mutation.AddFinishedSessions(v)
+	return _u
+}
+
+// SetUniqueListeners sets the "unique_listeners" field.
+func (_u *EngagementRollupUpdateOne) SetUniqueListeners(v int) *EngagementRollupUpdateOne {
+	_u.mutation.ResetUniqueListeners()
+	_u.mutation.SetUniqueListeners(v)
+	return _u
+}
+
+// SetNillableUniqueListeners sets the "unique_listeners" field if the given value is not nil.
+func (_u *EngagementRollupUpdateOne) SetNillableUniqueListeners(v *int) *EngagementRollupUpdateOne {
+	if v != nil {
+		_u.SetUniqueListeners(*v)
+	}
+	return _u
+}
+
+// AddUniqueListeners adds value to the "unique_listeners" field.
+func (_u *EngagementRollupUpdateOne) AddUniqueListeners(v int) *EngagementRollupUpdateOne {
+	_u.mutation.AddUniqueListeners(v)
+	return _u
+}
+
+// SetCompleters sets the "completers" field.
+func (_u *EngagementRollupUpdateOne) SetCompleters(v int) *EngagementRollupUpdateOne {
+	_u.mutation.ResetCompleters()
+	_u.mutation.SetCompleters(v)
+	return _u
+}
+
+// SetNillableCompleters sets the "completers" field if the given value is not nil.
+func (_u *EngagementRollupUpdateOne) SetNillableCompleters(v *int) *EngagementRollupUpdateOne {
+	if v != nil {
+		_u.SetCompleters(*v)
+	}
+	return _u
+}
+
+// AddCompleters adds value to the "completers" field.
+func (_u *EngagementRollupUpdateOne) AddCompleters(v int) *EngagementRollupUpdateOne {
+	_u.mutation.AddCompleters(v)
+	return _u
+}
+
+// Mutation returns the EngagementRollupMutation object of the builder.
+func (_u *EngagementRollupUpdateOne) Mutation() *EngagementRollupMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EngagementRollupUpdate builder.
+func (_u *EngagementRollupUpdateOne) Where(ps ...predicate.EngagementRollup) *EngagementRollupUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EngagementRollupUpdateOne) Select(field string, fields ...string) *EngagementRollupUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EngagementRollup entity.
+func (_u *EngagementRollupUpdateOne) Save(ctx context.Context) (*EngagementRollup, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EngagementRollupUpdateOne) SaveX(ctx context.Context) *EngagementRollup {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EngagementRollupUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EngagementRollupUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *EngagementRollupUpdateOne) sqlSave(ctx context.Context) (_node *EngagementRollup, err error) {
+	_spec := sqlgraph.NewUpdateSpec(engagementrollup.Table, engagementrollup.Columns, sqlgraph.NewFieldSpec(engagementrollup.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "EngagementRollup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, engagementrollup.FieldID)
+		for _, f := range fields {
+			if !engagementrollup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != engagementrollup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(engagementrollup.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DayCleared() {
+		_spec.ClearField(engagementrollup.FieldDay, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Sessions(); ok {
+		_spec.SetField(engagementrollup.FieldSessions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSessions(); ok {
+		_spec.AddField(engagementrollup.FieldSessions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FinishedSessions(); ok {
+		_spec.SetField(engagementrollup.FieldFinishedSessions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFinishedSessions(); ok {
+		_spec.AddField(engagementrollup.FieldFinishedSessions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UniqueListeners(); ok {
+		_spec.SetField(engagementrollup.FieldUniqueListeners, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUniqueListeners(); ok {
+		_spec.AddField(engagementrollup.FieldUniqueListeners, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Completers(); ok {
+		_spec.SetField(engagementrollup.FieldCompleters, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompleters(); ok {
+		_spec.AddField(engagementrollup.FieldCompleters, field.TypeInt, value)
+	}
+	_node = &EngagementRollup{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{engagementrollup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
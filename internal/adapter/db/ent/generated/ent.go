@@ -12,10 +12,55 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/apikey"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/asset"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/assetusageday"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/assetversion"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/auditentry"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/availabilityslot"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/booking"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroom"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroomassignment"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroommember"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentembedding"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentkey"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentreassignment"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/devicetoken"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/dictationattempt"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/engagementrollup"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episode"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episodetranscript"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/event"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/feeditem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/feedsubscription"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/invoice"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/job"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/learneractivity"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltilaunch"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltiloginstate"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltiplatform"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/moderationitem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/notification"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/notificationpreference"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/outboxmessage"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/plan"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playbacksession"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playlist"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playlistitem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/quizitem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/scheduledtask"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/series"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/shadowingsubmission"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/subscription"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/transcriptreplacejob"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/transcriptrevision"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/uploadsession"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/usagerecord"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/usagesnapshot"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/vocabularyword"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/webhookdelivery"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/webhookendpoint"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/webhookevent"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -76,10 +121,55 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			asset.Table:         asset.ValidColumn,
-			episode.Table:       episode.ValidColumn,
-			series.Table:        series.ValidColumn,
-			uploadsession.Table: uploadsession.ValidColumn,
+			apikey.Table:                 apikey.ValidColumn,
+			asset.Table:                  asset.ValidColumn,
+			assetusageday.Table:          assetusageday.ValidColumn,
+			assetversion.Table:           assetversion.ValidColumn,
+			auditentry.Table:             auditentry.ValidColumn,
+			availabilityslot.Table:       availabilityslot.ValidColumn,
+			booking.Table:                booking.ValidColumn,
+			classroom.Table:              classroom.ValidColumn,
+			classroomassignment.Table:    classroomassignment.ValidColumn,
+			classroommember.Table:        classroommember.ValidColumn,
+			contentembedding.Table:       contentembedding.ValidColumn,
+			contentkey.Table:             contentkey.ValidColumn,
+			contentreassignment.Table:    contentreassignment.ValidColumn,
+			devicetoken.Table:            devicetoken.ValidColumn,
+			dictationattempt.Table:       dictationattempt.ValidColumn,
+			engagementrollup.Table:       engagementrollup.ValidColumn,
+			episode.Table:                episode.ValidColumn,
+			episodetranscript.Table:      episodetranscript.ValidColumn,
+			event.Table:                  event.ValidColumn,
+			feeditem.Table:               feeditem.ValidColumn,
+			feedsubscription.Table:       feedsubscription.ValidColumn,
+			invoice.Table:                invoice.ValidColumn,
+			job.Table:                    job.ValidColumn,
+			ltilaunch.Table:              ltilaunch.ValidColumn,
+			ltiloginstate.Table:          ltiloginstate.ValidColumn,
+			ltiplatform.Table:            ltiplatform.ValidColumn,
+			learneractivity.Table:        learneractivity.ValidColumn,
+			moderationitem.Table:         moderationitem.ValidColumn,
+			notification.Table:           notification.ValidColumn,
+			notificationpreference.Table: notificationpreference.ValidColumn,
+			outboxmessage.Table:          outboxmessage.ValidColumn,
+			plan.Table:                   plan.ValidColumn,
+			playbacksession.Table:        playbacksession.ValidColumn,
+			playlist.Table:               playlist.ValidColumn,
+			playlistitem.Table:           playlistitem.ValidColumn,
+			quizitem.Table:               quizitem.ValidColumn,
+			scheduledtask.Table:          scheduledtask.ValidColumn,
+			series.Table:                 series.ValidColumn,
+			shadowingsubmission.Table:    shadowingsubmission.ValidColumn,
+			subscription.Table:           subscription.ValidColumn,
+			transcriptreplacejob.Table:   transcriptreplacejob.ValidColumn,
+			transcriptrevision.Table:     transcriptrevision.ValidColumn,
+			uploadsession.Table:          uploadsession.ValidColumn,
+			usagerecord.Table:            usagerecord.ValidColumn,
+			usagesnapshot.Table:          usagesnapshot.ValidColumn,
+			vocabularyword.Table:         vocabularyword.ValidColumn,
+			webhookdelivery.Table:        webhookdelivery.ValidColumn,
+			webhookendpoint.Table:        webhookendpoint.ValidColumn,
+			webhookevent.Table:           webhookevent.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -3,6 +3,7 @@
 package generated
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -10,7 +11,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episode"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episodetranscript"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/series"
+	"github.com/eslsoft/lession/internal/core"
 	"github.com/google/uuid"
 )
 
@@ -19,6 +22,12 @@ type Episode struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// SeriesID holds the value of the "series_id" field.
 	SeriesID uuid.UUID `json:"series_id,omitempty"`
 	// Seq holds the value of the "seq" field.
@@ -29,8 +38,12 @@ type Episode struct {
 	Description string `json:"description,omitempty"`
 	// DurationSeconds holds the value of the "duration_seconds" field.
 	DurationSeconds int `json:"duration_seconds,omitempty"`
+	// DurationFromAsset holds the value of the "duration_from_asset" field.
+	DurationFromAsset bool `json:"duration_from_asset,omitempty"`
 	// Status holds the value of the "status" field.
 	Status int `json:"status,omitempty"`
+	// Preview holds the value of the "preview" field.
+	Preview bool `json:"preview,omitempty"`
 	// ResourceAssetID holds the value of the "resource_asset_id" field.
 	ResourceAssetID *uuid.UUID `json:"resource_asset_id,omitempty"`
 	// ResourceType holds the value of the "resource_type" field.
@@ -45,14 +58,14 @@ type Episode struct {
 	TranscriptFormat int `json:"transcript_format,omitempty"`
 	// TranscriptContent holds the value of the "transcript_content" field.
 	TranscriptContent string `json:"transcript_content,omitempty"`
-	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt time.Time `json:"created_at,omitempty"`
-	// UpdatedAt holds the value of the "updated_at" field.
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// TranscriptFindings holds the value of the "transcript_findings" field.
+	TranscriptFindings []core.TranscriptFinding `json:"transcript_findings,omitempty"`
+	// TranscriptOffloaded holds the value of the "transcript_offloaded" field.
+	TranscriptOffloaded bool `json:"transcript_offloaded,omitempty"`
+	// EstimatedLevel holds the value of the "estimated_level" field.
+	EstimatedLevel string `json:"estimated_level,omitempty"`
 	// PublishedAt holds the value of the "published_at" field.
 	PublishedAt *time.Time `json:"published_at,omitempty"`
-	// DeletedAt holds the value of the "deleted_at" field.
-	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EpisodeQuery when eager-loading is set.
 	Edges        EpisodeEdges `json:"edges"`
@@ -63,9 +76,11 @@ type Episode struct {
 type EpisodeEdges struct {
 	// Series holds the value of the series edge.
 	Series *Series `json:"series,omitempty"`
+	// Transcript holds the value of the transcript edge.
+	Transcript *EpisodeTranscript `json:"transcript,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // SeriesOrErr returns the Series value or an error if the edge
@@ -79,6 +94,17 @@ func (e EpisodeEdges) SeriesOrErr() (*Series, error) {
 	return nil, &NotLoadedError{edge: "series"}
 }
 
+// TranscriptOrErr returns the Transcript value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EpisodeEdges) TranscriptOrErr() (*EpisodeTranscript, error) {
+	if e.Transcript != nil {
+		return e.Transcript, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: episodetranscript.Label}
+	}
+	return nil, &NotLoadedError{edge: "transcript"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Episode) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -86,11 +112,15 @@ func (*Episode) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case episode.FieldResourceAssetID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case episode.FieldTranscriptFindings:
+			values[i] = new([]byte)
+		case episode.FieldDurationFromAsset, episode.FieldPreview, episode.FieldTranscriptOffloaded:
+			values[i] = new(sql.NullBool)
 		case episode.FieldSeq, episode.FieldDurationSeconds, episode.FieldStatus, episode.FieldResourceType, episode.FieldTranscriptFormat:
 			values[i] = new(sql.NullInt64)
-		case episode.FieldTitle, episode.FieldDescription, episode.FieldResourcePlaybackURL, episode.FieldResourceMimeType, episode.FieldTranscriptLanguage, episode.FieldTranscriptContent:
+		case episode.FieldTitle, episode.FieldDescription, episode.FieldResourcePlaybackURL, episode.FieldResourceMimeType, episode.FieldTranscriptLanguage, episode.FieldTranscriptContent, episode.FieldEstimatedLevel:
 			values[i] = new(sql.NullString)
-		case episode.FieldCreatedAt, episode.FieldUpdatedAt, episode.FieldPublishedAt, episode.FieldDeletedAt:
+		case episode.FieldCreatedAt, episode.FieldUpdatedAt, episode.FieldDeletedAt, episode.FieldPublishedAt:
 			values[i] = new(sql.NullTime)
 		case episode.FieldID, episode.FieldSeriesID:
 			values[i] = new(uuid.UUID)
@@ -115,6 +145,25 @@ func (_m *Episode) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case episode.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case episode.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case episode.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case episode.FieldSeriesID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field series_id", values[i])
@@ -145,12 +194,24 @@ func (_m *Episode) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.DurationSeconds = int(value.Int64)
 			}
+		case episode.FieldDurationFromAsset:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_from_asset", values[i])
+			} else if value.Valid {
+				_m.DurationFromAsset = value.Bool
+			}
 		case episode.FieldStatus:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
 			} else if value.Valid {
 				_m.Status = int(value.Int64)
 			}
+		case episode.FieldPreview:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field preview", values[i])
+			} else if value.Valid {
+				_m.Preview = value.Bool
+			}
 		case episode.FieldResourceAssetID:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field resource_asset_id", values[i])
@@ -194,17 +255,25 @@ func (_m *Episode) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.TranscriptContent = value.String
 			}
-		case episode.FieldCreatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+		case episode.FieldTranscriptFindings:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field transcript_findings", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TranscriptFindings); err != nil {
+					return fmt.Errorf("unmarshal field transcript_findings: %w", err)
+				}
+			}
+		case episode.FieldTranscriptOffloaded:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field transcript_offloaded", values[i])
 			} else if value.Valid {
-				_m.CreatedAt = value.Time
+				_m.TranscriptOffloaded = value.Bool
 			}
-		case episode.FieldUpdatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+		case episode.FieldEstimatedLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field estimated_level", values[i])
 			} else if value.Valid {
-				_m.UpdatedAt = value.Time
+				_m.EstimatedLevel = value.String
 			}
 		case episode.FieldPublishedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -213,13 +282,6 @@ func (_m *Episode) assignValues(columns []string, values []any) error {
 				_m.PublishedAt = new(time.Time)
 				*_m.PublishedAt = value.Time
 			}
-		case episode.FieldDeletedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
-			} else if value.Valid {
-				_m.DeletedAt = new(time.Time)
-				*_m.DeletedAt = value.Time
-			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -238,6 +300,11 @@ func (_m *Episode) QuerySeries() *SeriesQuery {
 	return NewEpisodeClient(_m.config).QuerySeries(_m)
 }
 
+// QueryTranscript queries the "transcript" edge of the Episode entity.
+func (_m *Episode) QueryTranscript() *EpisodeTranscriptQuery {
+	return NewEpisodeClient(_m.config).QueryTranscript(_m)
+}
+
 // Update returns a builder for updating this Episode.
 // Note that you need to call Episode.Unwrap() before calling this method if this Episode
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -261,6 +328,17 @@ func (_m *Episode) String() string {
 	var builder strings.Builder
 	builder.WriteString("Episode(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("series_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.SeriesID))
 	builder.WriteString(", ")
@@ -276,9 +354,15 @@ func (_m *Episode) String() string {
 	builder.WriteString("duration_seconds=")
 	builder.WriteString(fmt.Sprintf("%v", _m.DurationSeconds))
 	builder.WriteString(", ")
+	builder.WriteString("duration_from_asset=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DurationFromAsset))
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	builder.WriteString("preview=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Preview))
+	builder.WriteString(", ")
 	if v := _m.ResourceAssetID; v != nil {
 		builder.WriteString("resource_asset_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -302,21 +386,19 @@ func (_m *Episode) String() string {
 	builder.WriteString("transcript_content=")
 	builder.WriteString(_m.TranscriptContent)
 	builder.WriteString(", ")
-	builder.WriteString("created_at=")
-	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString("transcript_findings=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TranscriptFindings))
 	builder.WriteString(", ")
-	builder.WriteString("updated_at=")
-	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString("transcript_offloaded=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TranscriptOffloaded))
+	builder.WriteString(", ")
+	builder.WriteString("estimated_level=")
+	builder.WriteString(_m.EstimatedLevel)
 	builder.WriteString(", ")
 	if v := _m.PublishedAt; v != nil {
 		builder.WriteString("published_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
-	builder.WriteString(", ")
-	if v := _m.DeletedAt; v != nil {
-		builder.WriteString("deleted_at=")
-		builder.WriteString(v.Format(time.ANSIC))
-	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,8 +3,7 @@
 package episode
 
 import (
-	"time"
-
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -15,6 +14,12 @@ const (
 	Label = "episode"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldSeriesID holds the string denoting the series_id field in the database.
 	FieldSeriesID = "series_id"
 	// FieldSeq holds the string denoting the seq field in the database.
@@ -25,8 +30,12 @@ const (
 	FieldDescription = "description"
 	// FieldDurationSeconds holds the string denoting the duration_seconds field in the database.
 	FieldDurationSeconds = "duration_seconds"
+	// FieldDurationFromAsset holds the string denoting the duration_from_asset field in the database.
+	FieldDurationFromAsset = "duration_from_asset"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldPreview holds the string denoting the preview field in the database.
+	FieldPreview = "preview"
 	// FieldResourceAssetID holds the string denoting the resource_asset_id field in the database.
 	FieldResourceAssetID = "resource_asset_id"
 	// FieldResourceType holds the string denoting the resource_type field in the database.
@@ -41,16 +50,18 @@ const (
 	FieldTranscriptFormat = "transcript_format"
 	// FieldTranscriptContent holds the string denoting the transcript_content field in the database.
 	FieldTranscriptContent = "transcript_content"
-	// FieldCreatedAt holds the string denoting the created_at field in the database.
-	FieldCreatedAt = "created_at"
-	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
-	FieldUpdatedAt = "updated_at"
+	// FieldTranscriptFindings holds the string denoting the transcript_findings field in the database.
+	FieldTranscriptFindings = "transcript_findings"
+	// FieldTranscriptOffloaded holds the string denoting the transcript_offloaded field in the database.
+	FieldTranscriptOffloaded = "transcript_offloaded"
+	// FieldEstimatedLevel holds the string denoting the estimated_level field in the database.
+	FieldEstimatedLevel = "estimated_level"
 	// FieldPublishedAt holds the string denoting the published_at field in the database.
 	FieldPublishedAt = "published_at"
-	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
-	FieldDeletedAt = "deleted_at"
 	// EdgeSeries holds the string denoting the series edge name in mutations.
 	EdgeSeries = "series"
+	// EdgeTranscript holds the string denoting the transcript edge name in mutations.
+	EdgeTranscript = "transcript"
 	// Table holds the table name of the episode in the database.
 	Table = "episodes"
 	// SeriesTable is the table that holds the series relation/edge.
@@ -60,17 +71,29 @@ const (
 	SeriesInverseTable = "series"
 	// SeriesColumn is the table column denoting the series relation/edge.
 	SeriesColumn = "series_id"
+	// TranscriptTable is the table that holds the transcript relation/edge.
+	TranscriptTable = "episode_transcripts"
+	// TranscriptInverseTable is the table name for the EpisodeTranscript entity.
+	// It exists in this package in order to avoid circular dependency with the "episodetranscript" package.
+	TranscriptInverseTable = "episode_transcripts"
+	// TranscriptColumn is the table column denoting the transcript relation/edge.
+	TranscriptColumn = "episode_id"
 )
 
 // Columns holds all SQL columns for episode fields.
 var Columns = []string{
 	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
 	FieldSeriesID,
 	FieldSeq,
 	FieldTitle,
 	FieldDescription,
 	FieldDurationSeconds,
+	FieldDurationFromAsset,
 	FieldStatus,
+	FieldPreview,
 	FieldResourceAssetID,
 	FieldResourceType,
 	FieldResourcePlaybackURL,
@@ -78,10 +101,10 @@ var Columns = []string{
 	FieldTranscriptLanguage,
 	FieldTranscriptFormat,
 	FieldTranscriptContent,
-	FieldCreatedAt,
-	FieldUpdatedAt,
+	FieldTranscriptFindings,
+	FieldTranscriptOffloaded,
+	FieldEstimatedLevel,
 	FieldPublishedAt,
-	FieldDeletedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -94,13 +117,24 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eslsoft/lession/internal/adapter/db/ent/generated/runtime"
 var (
+	Hooks        [3]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// DefaultDescription holds the default value on creation for the "description" field.
 	DefaultDescription string
 	// DefaultDurationSeconds holds the default value on creation for the "duration_seconds" field.
 	DefaultDurationSeconds int
+	// DefaultDurationFromAsset holds the default value on creation for the "duration_from_asset" field.
+	DefaultDurationFromAsset bool
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus int
+	// DefaultPreview holds the default value on creation for the "preview" field.
+	DefaultPreview bool
 	// DefaultResourceType holds the default value on creation for the "resource_type" field.
 	DefaultResourceType int
 	// DefaultResourcePlaybackURL holds the default value on creation for the "resource_playback_url" field.
@@ -113,12 +147,10 @@ var (
 	DefaultTranscriptFormat int
 	// DefaultTranscriptContent holds the default value on creation for the "transcript_content" field.
 	DefaultTranscriptContent string
-	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
-	DefaultCreatedAt func() time.Time
-	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
-	DefaultUpdatedAt func() time.Time
-	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
-	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultTranscriptOffloaded holds the default value on creation for the "transcript_offloaded" field.
+	DefaultTranscriptOffloaded bool
+	// DefaultEstimatedLevel holds the default value on creation for the "estimated_level" field.
+	DefaultEstimatedLevel string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -131,6 +163,21 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // BySeriesID orders the results by the series_id field.
 func BySeriesID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSeriesID, opts...).ToFunc()
@@ -156,11 +203,21 @@ func ByDurationSeconds(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDurationSeconds, opts...).ToFunc()
 }
 
+// ByDurationFromAsset orders the results by the duration_from_asset field.
+func ByDurationFromAsset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationFromAsset, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByPreview orders the results by the preview field.
+func ByPreview(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreview, opts...).ToFunc()
+}
+
 // ByResourceAssetID orders the results by the resource_asset_id field.
 func ByResourceAssetID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldResourceAssetID, opts...).ToFunc()
@@ -196,14 +253,14 @@ func ByTranscriptContent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTranscriptContent, opts...).ToFunc()
 }
 
-// ByCreatedAt orders the results by the created_at field.
-func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+// ByTranscriptOffloaded orders the results by the transcript_offloaded field.
+func ByTranscriptOffloaded(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTranscriptOffloaded, opts...).ToFunc()
 }
 
-// ByUpdatedAt orders the results by the updated_at field.
-func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+// ByEstimatedLevel orders the results by the estimated_level field.
+func ByEstimatedLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEstimatedLevel, opts...).ToFunc()
 }
 
 // ByPublishedAt orders the results by the published_at field.
@@ -211,17 +268,19 @@ func ByPublishedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPublishedAt, opts...).ToFunc()
 }
 
-// ByDeletedAt orders the results by the deleted_at field.
-func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
-}
-
 // BySeriesField orders the results by series field.
 func BySeriesField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newSeriesStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByTranscriptField orders the results by transcript field.
+func ByTranscriptField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTranscriptStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newSeriesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -229,3 +288,10 @@ func newSeriesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, SeriesTable, SeriesColumn),
 	)
 }
+func newTranscriptStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TranscriptInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, TranscriptTable, TranscriptColumn),
+	)
+}
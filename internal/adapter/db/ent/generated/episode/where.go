@@ -56,6 +56,21 @@ func IDLTE(id uuid.UUID) predicate.Episode {
 	return predicate.Episode(sql.FieldLTE(FieldID, id))
 }
 
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // SeriesID applies equality check predicate on the "series_id" field. It's identical to SeriesIDEQ.
 func SeriesID(v uuid.UUID) predicate.Episode {
 	return predicate.Episode(sql.FieldEQ(FieldSeriesID, v))
@@ -81,11 +96,21 @@ func DurationSeconds(v int) predicate.Episode {
 	return predicate.Episode(sql.FieldEQ(FieldDurationSeconds, v))
 }
 
+// DurationFromAsset applies equality check predicate on the "duration_from_asset" field. It's identical to DurationFromAssetEQ.
+func DurationFromAsset(v bool) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldDurationFromAsset, v))
+}
+
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
 func Status(v int) predicate.Episode {
 	return predicate.Episode(sql.FieldEQ(FieldStatus, v))
 }
 
+// Preview applies equality check predicate on the "preview" field. It's identical to PreviewEQ.
+func Preview(v bool) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldPreview, v))
+}
+
 // ResourceAssetID applies equality check predicate on the "resource_asset_id" field. It's identical to ResourceAssetIDEQ.
 func ResourceAssetID(v uuid.UUID) predicate.Episode {
 	return predicate.Episode(sql.FieldEQ(FieldResourceAssetID, v))
@@ -121,14 +146,14 @@ func TranscriptContent(v string) predicate.Episode {
 	return predicate.Episode(sql.FieldEQ(FieldTranscriptContent, v))
 }
 
-// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
-func CreatedAt(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldEQ(FieldCreatedAt, v))
+// TranscriptOffloaded applies equality check predicate on the "transcript_offloaded" field. It's identical to TranscriptOffloadedEQ.
+func TranscriptOffloaded(v bool) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldTranscriptOffloaded, v))
 }
 
-// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
-func UpdatedAt(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldEQ(FieldUpdatedAt, v))
+// EstimatedLevel applies equality check predicate on the "estimated_level" field. It's identical to EstimatedLevelEQ.
+func EstimatedLevel(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldEstimatedLevel, v))
 }
 
 // PublishedAt applies equality check predicate on the "published_at" field. It's identical to PublishedAtEQ.
@@ -136,11 +161,136 @@ func PublishedAt(v time.Time) predicate.Episode {
 	return predicate.Episode(sql.FieldEQ(FieldPublishedAt, v))
 }
 
-// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
-func DeletedAt(v time.Time) predicate.Episode {
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Episode {
 	return predicate.Episode(sql.FieldEQ(FieldDeletedAt, v))
 }
 
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Episode {
+	return predicate.Episode(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Episode {
+	return predicate.Episode(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Episode {
+	return predicate.Episode(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // SeriesIDEQ applies the EQ predicate on the "series_id" field.
 func SeriesIDEQ(v uuid.UUID) predicate.Episode {
 	return predicate.Episode(sql.FieldEQ(FieldSeriesID, v))
@@ -371,6 +521,16 @@ func DurationSecondsLTE(v int) predicate.Episode {
 	return predicate.Episode(sql.FieldLTE(FieldDurationSeconds, v))
 }
 
+// DurationFromAssetEQ applies the EQ predicate on the "duration_from_asset" field.
+func DurationFromAssetEQ(v bool) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldDurationFromAsset, v))
+}
+
+// DurationFromAssetNEQ applies the NEQ predicate on the "duration_from_asset" field.
+func DurationFromAssetNEQ(v bool) predicate.Episode {
+	return predicate.Episode(sql.FieldNEQ(FieldDurationFromAsset, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v int) predicate.Episode {
 	return predicate.Episode(sql.FieldEQ(FieldStatus, v))
@@ -411,6 +571,16 @@ func StatusLTE(v int) predicate.Episode {
 	return predicate.Episode(sql.FieldLTE(FieldStatus, v))
 }
 
+// PreviewEQ applies the EQ predicate on the "preview" field.
+func PreviewEQ(v bool) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldPreview, v))
+}
+
+// PreviewNEQ applies the NEQ predicate on the "preview" field.
+func PreviewNEQ(v bool) predicate.Episode {
+	return predicate.Episode(sql.FieldNEQ(FieldPreview, v))
+}
+
 // ResourceAssetIDEQ applies the EQ predicate on the "resource_asset_id" field.
 func ResourceAssetIDEQ(v uuid.UUID) predicate.Episode {
 	return predicate.Episode(sql.FieldEQ(FieldResourceAssetID, v))
@@ -801,84 +971,89 @@ func TranscriptContentContainsFold(v string) predicate.Episode {
 	return predicate.Episode(sql.FieldContainsFold(FieldTranscriptContent, v))
 }
 
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldEQ(FieldCreatedAt, v))
+// TranscriptFindingsIsNil applies the IsNil predicate on the "transcript_findings" field.
+func TranscriptFindingsIsNil() predicate.Episode {
+	return predicate.Episode(sql.FieldIsNull(FieldTranscriptFindings))
 }
 
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldNEQ(FieldCreatedAt, v))
+// TranscriptFindingsNotNil applies the NotNil predicate on the "transcript_findings" field.
+func TranscriptFindingsNotNil() predicate.Episode {
+	return predicate.Episode(sql.FieldNotNull(FieldTranscriptFindings))
 }
 
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldIn(FieldCreatedAt, vs...))
+// TranscriptOffloadedEQ applies the EQ predicate on the "transcript_offloaded" field.
+func TranscriptOffloadedEQ(v bool) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldTranscriptOffloaded, v))
 }
 
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldNotIn(FieldCreatedAt, vs...))
+// TranscriptOffloadedNEQ applies the NEQ predicate on the "transcript_offloaded" field.
+func TranscriptOffloadedNEQ(v bool) predicate.Episode {
+	return predicate.Episode(sql.FieldNEQ(FieldTranscriptOffloaded, v))
 }
 
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldGT(FieldCreatedAt, v))
+// EstimatedLevelEQ applies the EQ predicate on the "estimated_level" field.
+func EstimatedLevelEQ(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldEQ(FieldEstimatedLevel, v))
 }
 
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldGTE(FieldCreatedAt, v))
+// EstimatedLevelNEQ applies the NEQ predicate on the "estimated_level" field.
+func EstimatedLevelNEQ(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldNEQ(FieldEstimatedLevel, v))
 }
 
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldLT(FieldCreatedAt, v))
+// EstimatedLevelIn applies the In predicate on the "estimated_level" field.
+func EstimatedLevelIn(vs ...string) predicate.Episode {
+	return predicate.Episode(sql.FieldIn(FieldEstimatedLevel, vs...))
 }
 
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldLTE(FieldCreatedAt, v))
+// EstimatedLevelNotIn applies the NotIn predicate on the "estimated_level" field.
+func EstimatedLevelNotIn(vs ...string) predicate.Episode {
+	return predicate.Episode(sql.FieldNotIn(FieldEstimatedLevel, vs...))
 }
 
-// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
-func UpdatedAtEQ(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldEQ(FieldUpdatedAt, v))
+// EstimatedLevelGT applies the GT predicate on the "estimated_level" field.
+func EstimatedLevelGT(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldGT(FieldEstimatedLevel, v))
 }
 
-// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
-func UpdatedAtNEQ(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldNEQ(FieldUpdatedAt, v))
+// EstimatedLevelGTE applies the GTE predicate on the "estimated_level" field.
+func EstimatedLevelGTE(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldGTE(FieldEstimatedLevel, v))
 }
 
-// UpdatedAtIn applies the In predicate on the "updated_at" field.
-func UpdatedAtIn(vs ...time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldIn(FieldUpdatedAt, vs...))
+// EstimatedLevelLT applies the LT predicate on the "estimated_level" field.
+func EstimatedLevelLT(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldLT(FieldEstimatedLevel, v))
 }
 
-// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
-func UpdatedAtNotIn(vs ...time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldNotIn(FieldUpdatedAt, vs...))
+// EstimatedLevelLTE applies the LTE predicate on the "estimated_level" field.
+func EstimatedLevelLTE(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldLTE(FieldEstimatedLevel, v))
 }
 
-// UpdatedAtGT applies the GT predicate on the "updated_at" field.
-func UpdatedAtGT(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldGT(FieldUpdatedAt, v))
+// EstimatedLevelContains applies the Contains predicate on the "estimated_level" field.
+func EstimatedLevelContains(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldContains(FieldEstimatedLevel, v))
 }
 
-// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
-func UpdatedAtGTE(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldGTE(FieldUpdatedAt, v))
+// EstimatedLevelHasPrefix applies the HasPrefix predicate on the "estimated_level" field.
+func EstimatedLevelHasPrefix(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldHasPrefix(FieldEstimatedLevel, v))
 }
 
-// UpdatedAtLT applies the LT predicate on the "updated_at" field.
-func UpdatedAtLT(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldLT(FieldUpdatedAt, v))
+// EstimatedLevelHasSuffix applies the HasSuffix predicate on the "estimated_level" field.
+func EstimatedLevelHasSuffix(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldHasSuffix(FieldEstimatedLevel, v))
 }
 
-// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
-func UpdatedAtLTE(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldLTE(FieldUpdatedAt, v))
+// EstimatedLevelEqualFold applies the EqualFold predicate on the "estimated_level" field.
+func EstimatedLevelEqualFold(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldEqualFold(FieldEstimatedLevel, v))
+}
+
+// EstimatedLevelContainsFold applies the ContainsFold predicate on the "estimated_level" field.
+func EstimatedLevelContainsFold(v string) predicate.Episode {
+	return predicate.Episode(sql.FieldContainsFold(FieldEstimatedLevel, v))
 }
 
 // PublishedAtEQ applies the EQ predicate on the "published_at" field.
@@ -931,56 +1106,6 @@ func PublishedAtNotNil() predicate.Episode {
 	return predicate.Episode(sql.FieldNotNull(FieldPublishedAt))
 }
 
-// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
-func DeletedAtEQ(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldEQ(FieldDeletedAt, v))
-}
-
-// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
-func DeletedAtNEQ(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldNEQ(FieldDeletedAt, v))
-}
-
-// DeletedAtIn applies the In predicate on the "deleted_at" field.
-func DeletedAtIn(vs ...time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldIn(FieldDeletedAt, vs...))
-}
-
-// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
-func DeletedAtNotIn(vs ...time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldNotIn(FieldDeletedAt, vs...))
-}
-
-// DeletedAtGT applies the GT predicate on the "deleted_at" field.
-func DeletedAtGT(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldGT(FieldDeletedAt, v))
-}
-
-// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
-func DeletedAtGTE(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldGTE(FieldDeletedAt, v))
-}
-
-// DeletedAtLT applies the LT predicate on the "deleted_at" field.
-func DeletedAtLT(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldLT(FieldDeletedAt, v))
-}
-
-// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
-func DeletedAtLTE(v time.Time) predicate.Episode {
-	return predicate.Episode(sql.FieldLTE(FieldDeletedAt, v))
-}
-
-// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
-func DeletedAtIsNil() predicate.Episode {
-	return predicate.Episode(sql.FieldIsNull(FieldDeletedAt))
-}
-
-// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
-func DeletedAtNotNil() predicate.Episode {
-	return predicate.Episode(sql.FieldNotNull(FieldDeletedAt))
-}
-
 // HasSeries applies the HasEdge predicate on the "series" edge.
 func HasSeries() predicate.Episode {
 	return predicate.Episode(func(s *sql.Selector) {
@@ -1004,6 +1129,29 @@ func HasSeriesWith(preds ...predicate.Series) predicate.Episode {
 	})
 }
 
+// HasTranscript applies the HasEdge predicate on the "transcript" edge.
+func HasTranscript() predicate.Episode {
+	return predicate.Episode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, TranscriptTable, TranscriptColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTranscriptWith applies the HasEdge predicate on the "transcript" edge with a given conditions (other predicates).
+func HasTranscriptWith(preds ...predicate.EpisodeTranscript) predicate.Episode {
+	return predicate.Episode(func(s *sql.Selector) {
+		step := newTranscriptStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Episode) predicate.Episode {
 	return predicate.Episode(sql.AndPredicates(predicates...))
@@ -11,7 +11,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episode"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episodetranscript"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/series"
+	"github.com/eslsoft/lession/internal/core"
 	"github.com/google/uuid"
 )
 
@@ -22,6 +24,32 @@ type EpisodeCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (_c *EpisodeCreate) SetCreatedAt(v time.Time) *EpisodeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EpisodeCreate) SetUpdatedAt(v time.Time) *EpisodeCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *EpisodeCreate) SetDeletedAt(v time.Time) *EpisodeCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *EpisodeCreate) SetNillableDeletedAt(v *time.Time) *EpisodeCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetSeriesID sets the "series_id" field.
 func (_c *EpisodeCreate) SetSeriesID(v uuid.UUID) *EpisodeCreate {
 	_c.mutation.SetSeriesID(v)
@@ -68,6 +96,20 @@ func (_c *EpisodeCreate) SetNillableDurationSeconds(v *int) *EpisodeCreate {
 	return _c
 }
 
+// SetDurationFromAsset sets the "duration_from_asset" field.
+func (_c *EpisodeCreate) SetDurationFromAsset(v bool) *EpisodeCreate {
+	_c.mutation.SetDurationFromAsset(v)
+	return _c
+}
+
+// SetNillableDurationFromAsset sets the "duration_from_asset" field if the given value is not nil.
+func (_c *EpisodeCreate) SetNillableDurationFromAsset(v *bool) *EpisodeCreate {
+	if v != nil {
+		_c.SetDurationFromAsset(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *EpisodeCreate) SetStatus(v int) *EpisodeCreate {
 	_c.mutation.SetStatus(v)
@@ -82,6 +124,20 @@ func (_c *EpisodeCreate) SetNillableStatus(v *int) *EpisodeCreate {
 	return _c
 }
 
+// SetPreview sets the "preview" field.
+func (_c *EpisodeCreate) SetPreview(v bool) *EpisodeCreate {
+	_c.mutation.SetPreview(v)
+	return _c
+}
+
+// SetNillablePreview sets the "preview" field if the given value is not nil.
+func (_c *EpisodeCreate) SetNillablePreview(v *bool) *EpisodeCreate {
+	if v != nil {
+		_c.SetPreview(*v)
+	}
+	return _c
+}
+
 // SetResourceAssetID sets the "resource_asset_id" field.
 func (_c *EpisodeCreate) SetResourceAssetID(v uuid.UUID) *EpisodeCreate {
 	_c.mutation.SetResourceAssetID(v)
@@ -180,30 +236,36 @@ func (_c *EpisodeCreate) SetNillableTranscriptContent(v *string) *EpisodeCreate
 	return _c
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_c *EpisodeCreate) SetCreatedAt(v time.Time) *EpisodeCreate {
-	_c.mutation.SetCreatedAt(v)
+// SetTranscriptFindings sets the "transcript_findings" field.
+func (_c *EpisodeCreate) SetTranscriptFindings(v []core.TranscriptFinding) *EpisodeCreate {
+	_c.mutation.SetTranscriptFindings(v)
 	return _c
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_c *EpisodeCreate) SetNillableCreatedAt(v *time.Time) *EpisodeCreate {
+// SetTranscriptOffloaded sets the "transcript_offloaded" field.
+func (_c *EpisodeCreate) SetTranscriptOffloaded(v bool) *EpisodeCreate {
+	_c.mutation.SetTranscriptOffloaded(v)
+	return _c
+}
+
+// SetNillableTranscriptOffloaded sets the "transcript_offloaded" field if the given value is not nil.
+func (_c *EpisodeCreate) SetNillableTranscriptOffloaded(v *bool) *EpisodeCreate {
 	if v != nil {
-		_c.SetCreatedAt(*v)
+		_c.SetTranscriptOffloaded(*v)
 	}
 	return _c
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_c *EpisodeCreate) SetUpdatedAt(v time.Time) *EpisodeCreate {
-	_c.mutation.SetUpdatedAt(v)
+// SetEstimatedLevel sets the "estimated_level" field.
+func (_c *EpisodeCreate) SetEstimatedLevel(v string) *EpisodeCreate {
+	_c.mutation.SetEstimatedLevel(v)
 	return _c
 }
 
-// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
-func (_c *EpisodeCreate) SetNillableUpdatedAt(v *time.Time) *EpisodeCreate {
+// SetNillableEstimatedLevel sets the "estimated_level" field if the given value is not nil.
+func (_c *EpisodeCreate) SetNillableEstimatedLevel(v *string) *EpisodeCreate {
 	if v != nil {
-		_c.SetUpdatedAt(*v)
+		_c.SetEstimatedLevel(*v)
 	}
 	return _c
 }
@@ -222,20 +284,6 @@ func (_c *EpisodeCreate) SetNillablePublishedAt(v *time.Time) *EpisodeCreate {
 	return _c
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_c *EpisodeCreate) SetDeletedAt(v time.Time) *EpisodeCreate {
-	_c.mutation.SetDeletedAt(v)
-	return _c
-}
-
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_c *EpisodeCreate) SetNillableDeletedAt(v *time.Time) *EpisodeCreate {
-	if v != nil {
-		_c.SetDeletedAt(*v)
-	}
-	return _c
-}
-
 // SetID sets the "id" field.
 func (_c *EpisodeCreate) SetID(v uuid.UUID) *EpisodeCreate {
 	_c.mutation.SetID(v)
@@ -255,6 +303,25 @@ func (_c *EpisodeCreate) SetSeries(v *Series) *EpisodeCreate {
 	return _c.SetSeriesID(v.ID)
 }
 
+// SetTranscriptID sets the "transcript" edge to the EpisodeTranscript entity by ID.
+func (_c *EpisodeCreate) SetTranscriptID(id uuid.UUID) *EpisodeCreate {
+	_c.mutation.SetTranscriptID(id)
+	return _c
+}
+
+// SetNillableTranscriptID sets the "transcript" edge to the EpisodeTranscript entity by ID if the given value is not nil.
+func (_c *EpisodeCreate) SetNillableTranscriptID(id *uuid.UUID) *EpisodeCreate {
+	if id != nil {
+		_c = _c.SetTranscriptID(*id)
+	}
+	return _c
+}
+
+// SetTranscript sets the "transcript" edge to the EpisodeTranscript entity.
+func (_c *EpisodeCreate) SetTranscript(v *EpisodeTranscript) *EpisodeCreate {
+	return _c.SetTranscriptID(v.ID)
+}
+
 // Mutation returns the EpisodeMutation object of the builder.
 func (_c *EpisodeCreate) Mutation() *EpisodeMutation {
 	return _c.mutation
@@ -262,7 +329,9 @@ func (_c *EpisodeCreate) Mutation() *EpisodeMutation {
 
 // Save creates the Episode in the database.
 func (_c *EpisodeCreate) Save(ctx context.Context) (*Episode, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -289,7 +358,7 @@ func (_c *EpisodeCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *EpisodeCreate) defaults() {
+func (_c *EpisodeCreate) defaults() error {
 	if _, ok := _c.mutation.Description(); !ok {
 		v := episode.DefaultDescription
 		_c.mutation.SetDescription(v)
@@ -298,10 +367,18 @@ func (_c *EpisodeCreate) defaults() {
 		v := episode.DefaultDurationSeconds
 		_c.mutation.SetDurationSeconds(v)
 	}
+	if _, ok := _c.mutation.DurationFromAsset(); !ok {
+		v := episode.DefaultDurationFromAsset
+		_c.mutation.SetDurationFromAsset(v)
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		v := episode.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.Preview(); !ok {
+		v := episode.DefaultPreview
+		_c.mutation.SetPreview(v)
+	}
 	if _, ok := _c.mutation.ResourceType(); !ok {
 		v := episode.DefaultResourceType
 		_c.mutation.SetResourceType(v)
@@ -326,22 +403,32 @@ func (_c *EpisodeCreate) defaults() {
 		v := episode.DefaultTranscriptContent
 		_c.mutation.SetTranscriptContent(v)
 	}
-	if _, ok := _c.mutation.CreatedAt(); !ok {
-		v := episode.DefaultCreatedAt()
-		_c.mutation.SetCreatedAt(v)
+	if _, ok := _c.mutation.TranscriptOffloaded(); !ok {
+		v := episode.DefaultTranscriptOffloaded
+		_c.mutation.SetTranscriptOffloaded(v)
 	}
-	if _, ok := _c.mutation.UpdatedAt(); !ok {
-		v := episode.DefaultUpdatedAt()
-		_c.mutation.SetUpdatedAt(v)
+	if _, ok := _c.mutation.EstimatedLevel(); !ok {
+		v := episode.DefaultEstimatedLevel
+		_c.mutation.SetEstimatedLevel(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if episode.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized episode.DefaultID (forgotten import generated/runtime?)")
+		}
 		v := episode.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *EpisodeCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Episode.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Episode.updated_at"`)}
+	}
 	if _, ok := _c.mutation.SeriesID(); !ok {
 		return &ValidationError{Name: "series_id", err: errors.New(`generated: missing required field "Episode.series_id"`)}
 	}
@@ -357,9 +444,15 @@ func (_c *EpisodeCreate) check() error {
 	if _, ok := _c.mutation.DurationSeconds(); !ok {
 		return &ValidationError{Name: "duration_seconds", err: errors.New(`generated: missing required field "Episode.duration_seconds"`)}
 	}
+	if _, ok := _c.mutation.DurationFromAsset(); !ok {
+		return &ValidationError{Name: "duration_from_asset", err: errors.New(`generated: missing required field "Episode.duration_from_asset"`)}
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`generated: missing required field "Episode.status"`)}
 	}
+	if _, ok := _c.mutation.Preview(); !ok {
+		return &ValidationError{Name: "preview", err: errors.New(`generated: missing required field "Episode.preview"`)}
+	}
 	if _, ok := _c.mutation.ResourceType(); !ok {
 		return &ValidationError{Name: "resource_type", err: errors.New(`generated: missing required field "Episode.resource_type"`)}
 	}
@@ -378,11 +471,11 @@ func (_c *EpisodeCreate) check() error {
 	if _, ok := _c.mutation.TranscriptContent(); !ok {
 		return &ValidationError{Name: "transcript_content", err: errors.New(`generated: missing required field "Episode.transcript_content"`)}
 	}
-	if _, ok := _c.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Episode.created_at"`)}
+	if _, ok := _c.mutation.TranscriptOffloaded(); !ok {
+		return &ValidationError{Name: "transcript_offloaded", err: errors.New(`generated: missing required field "Episode.transcript_offloaded"`)}
 	}
-	if _, ok := _c.mutation.UpdatedAt(); !ok {
-		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Episode.updated_at"`)}
+	if _, ok := _c.mutation.EstimatedLevel(); !ok {
+		return &ValidationError{Name: "estimated_level", err: errors.New(`generated: missing required field "Episode.estimated_level"`)}
 	}
 	if len(_c.mutation.SeriesIDs()) == 0 {
 		return &ValidationError{Name: "series", err: errors.New(`generated: missing required edge "Episode.series"`)}
@@ -422,6 +515,18 @@ func (_c *EpisodeCreate) createSpec() (*Episode, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(episode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(episode.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(episode.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.Seq(); ok {
 		_spec.SetField(episode.FieldSeq, field.TypeUint32, value)
 		_node.Seq = value
@@ -438,10 +543,18 @@ func (_c *EpisodeCreate) createSpec() (*Episode, *sqlgraph.CreateSpec) {
 		_spec.SetField(episode.FieldDurationSeconds, field.TypeInt, value)
 		_node.DurationSeconds = value
 	}
+	if value, ok := _c.mutation.DurationFromAsset(); ok {
+		_spec.SetField(episode.FieldDurationFromAsset, field.TypeBool, value)
+		_node.DurationFromAsset = value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(episode.FieldStatus, field.TypeInt, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.Preview(); ok {
+		_spec.SetField(episode.FieldPreview, field.TypeBool, value)
+		_node.Preview = value
+	}
 	if value, ok := _c.mutation.ResourceAssetID(); ok {
 		_spec.SetField(episode.FieldResourceAssetID, field.TypeUUID, value)
 		_node.ResourceAssetID = &value
@@ -470,22 +583,22 @@ func (_c *EpisodeCreate) createSpec() (*Episode, *sqlgraph.CreateSpec) {
 		_spec.SetField(episode.FieldTranscriptContent, field.TypeString, value)
 		_node.TranscriptContent = value
 	}
-	if value, ok := _c.mutation.CreatedAt(); ok {
-		_spec.SetField(episode.FieldCreatedAt, field.TypeTime, value)
-		_node.CreatedAt = value
+	if value, ok := _c.mutation.TranscriptFindings(); ok {
+		_spec.SetField(episode.FieldTranscriptFindings, field.TypeJSON, value)
+		_node.TranscriptFindings = value
 	}
-	if value, ok := _c.mutation.UpdatedAt(); ok {
-		_spec.SetField(episode.FieldUpdatedAt, field.TypeTime, value)
-		_node.UpdatedAt = value
+	if value, ok := _c.mutation.TranscriptOffloaded(); ok {
+		_spec.SetField(episode.FieldTranscriptOffloaded, field.TypeBool, value)
+		_node.TranscriptOffloaded = value
+	}
+	if value, ok := _c.mutation.EstimatedLevel(); ok {
+		_spec.SetField(episode.FieldEstimatedLevel, field.TypeString, value)
+		_node.EstimatedLevel = value
 	}
 	if value, ok := _c.mutation.PublishedAt(); ok {
 		_spec.SetField(episode.FieldPublishedAt, field.TypeTime, value)
 		_node.PublishedAt = &value
 	}
-	if value, ok := _c.mutation.DeletedAt(); ok {
-		_spec.SetField(episode.FieldDeletedAt, field.TypeTime, value)
-		_node.DeletedAt = &value
-	}
 	if nodes := _c.mutation.SeriesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -503,6 +616,22 @@ func (_c *EpisodeCreate) createSpec() (*Episode, *sqlgraph.CreateSpec) {
 		_node.SeriesID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.TranscriptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   episode.TranscriptTable,
+			Columns: []string{episode.TranscriptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episodetranscript.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,6 +4,7 @@ package generated
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -12,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episode"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episodetranscript"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/series"
 	"github.com/google/uuid"
@@ -20,11 +22,12 @@ import (
 // EpisodeQuery is the builder for querying Episode entities.
 type EpisodeQuery struct {
 	config
-	ctx        *QueryContext
-	order      []episode.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Episode
-	withSeries *SeriesQuery
+	ctx            *QueryContext
+	order          []episode.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.Episode
+	withSeries     *SeriesQuery
+	withTranscript *EpisodeTranscriptQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +86,28 @@ func (_q *EpisodeQuery) QuerySeries() *SeriesQuery {
 	return query
 }
 
+// QueryTranscript chains the current query on the "transcript" edge.
+func (_q *EpisodeQuery) QueryTranscript() *EpisodeTranscriptQuery {
+	query := (&EpisodeTranscriptClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episode.Table, episode.FieldID, selector),
+			sqlgraph.To(episodetranscript.Table, episodetranscript.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, episode.TranscriptTable, episode.TranscriptColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Episode entity from the query.
 // Returns a *NotFoundError when no Episode was found.
 func (_q *EpisodeQuery) First(ctx context.Context) (*Episode, error) {
@@ -270,12 +295,13 @@ func (_q *EpisodeQuery) Clone() *EpisodeQuery {
 		return nil
 	}
 	return &EpisodeQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]episode.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Episode{}, _q.predicates...),
-		withSeries: _q.withSeries.Clone(),
+		config:         _q.config,
+		ctx:            _q.ctx.Clone(),
+		order:          append([]episode.OrderOption{}, _q.order...),
+		inters:         append([]Interceptor{}, _q.inters...),
+		predicates:     append([]predicate.Episode{}, _q.predicates...),
+		withSeries:     _q.withSeries.Clone(),
+		withTranscript: _q.withTranscript.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -293,18 +319,29 @@ func (_q *EpisodeQuery) WithSeries(opts ...func(*SeriesQuery)) *EpisodeQuery {
 	return _q
 }
 
+// WithTranscript tells the query-builder to eager-load the nodes that are connected to
+// the "transcript" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EpisodeQuery) WithTranscript(opts ...func(*EpisodeTranscriptQuery)) *EpisodeQuery {
+	query := (&EpisodeTranscriptClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTranscript = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		SeriesID uuid.UUID `json:"series_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Episode.Query().
-//		GroupBy(episode.FieldSeriesID).
+//		GroupBy(episode.FieldCreatedAt).
 //		Aggregate(generated.Count()).
 //		Scan(ctx, &v)
 func (_q *EpisodeQuery) GroupBy(field string, fields ...string) *EpisodeGroupBy {
@@ -322,11 +359,11 @@ func (_q *EpisodeQuery) GroupBy(field string, fields ...string) *EpisodeGroupBy
 // Example:
 //
 //	var v []struct {
-//		SeriesID uuid.UUID `json:"series_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.Episode.Query().
-//		Select(episode.FieldSeriesID).
+//		Select(episode.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (_q *EpisodeQuery) Select(fields ...string) *EpisodeSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -371,8 +408,9 @@ func (_q *EpisodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Epis
 	var (
 		nodes       = []*Episode{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withSeries != nil,
+			_q.withTranscript != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -399,6 +437,12 @@ func (_q *EpisodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Epis
 			return nil, err
 		}
 	}
+	if query := _q.withTranscript; query != nil {
+		if err := _q.loadTranscript(ctx, query, nodes, nil,
+			func(n *Episode, e *EpisodeTranscript) { n.Edges.Transcript = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -431,6 +475,33 @@ func (_q *EpisodeQuery) loadSeries(ctx context.Context, query *SeriesQuery, node
 	}
 	return nil
 }
+func (_q *EpisodeQuery) loadTranscript(ctx context.Context, query *EpisodeTranscriptQuery, nodes []*Episode, init func(*Episode), assign func(*Episode, *EpisodeTranscript)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Episode)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(episodetranscript.FieldEpisodeID)
+	}
+	query.Where(predicate.EpisodeTranscript(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(episode.TranscriptColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EpisodeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "episode_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *EpisodeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -10,10 +10,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episode"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episodetranscript"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/series"
+	"github.com/eslsoft/lession/internal/core"
 	"github.com/google/uuid"
 )
 
@@ -30,6 +33,40 @@ func (_u *EpisodeUpdate) Where(ps ...predicate.Episode) *EpisodeUpdate {
 	return _u
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EpisodeUpdate) SetUpdatedAt(v time.Time) *EpisodeUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *EpisodeUpdate) SetNillableUpdatedAt(v *time.Time) *EpisodeUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *EpisodeUpdate) SetDeletedAt(v time.Time) *EpisodeUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *EpisodeUpdate) SetNillableDeletedAt(v *time.Time) *EpisodeUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *EpisodeUpdate) ClearDeletedAt() *EpisodeUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetSeriesID sets the "series_id" field.
 func (_u *EpisodeUpdate) SetSeriesID(v uuid.UUID) *EpisodeUpdate {
 	_u.mutation.SetSeriesID(v)
@@ -114,6 +151,20 @@ func (_u *EpisodeUpdate) AddDurationSeconds(v int) *EpisodeUpdate {
 	return _u
 }
 
+// SetDurationFromAsset sets the "duration_from_asset" field.
+func (_u *EpisodeUpdate) SetDurationFromAsset(v bool) *EpisodeUpdate {
+	_u.mutation.SetDurationFromAsset(v)
+	return _u
+}
+
+// SetNillableDurationFromAsset sets the "duration_from_asset" field if the given value is not nil.
+func (_u *EpisodeUpdate) SetNillableDurationFromAsset(v *bool) *EpisodeUpdate {
+	if v != nil {
+		_u.SetDurationFromAsset(*v)
+	}
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *EpisodeUpdate) SetStatus(v int) *EpisodeUpdate {
 	_u.mutation.ResetStatus()
@@ -135,6 +186,20 @@ func (_u *EpisodeUpdate) AddStatus(v int) *EpisodeUpdate {
 	return _u
 }
 
+// SetPreview sets the "preview" field.
+func (_u *EpisodeUpdate) SetPreview(v bool) *EpisodeUpdate {
+	_u.mutation.SetPreview(v)
+	return _u
+}
+
+// SetNillablePreview sets the "preview" field if the given value is not nil.
+func (_u *EpisodeUpdate) SetNillablePreview(v *bool) *EpisodeUpdate {
+	if v != nil {
+		_u.SetPreview(*v)
+	}
+	return _u
+}
+
 // SetResourceAssetID sets the "resource_asset_id" field.
 func (_u *EpisodeUpdate) SetResourceAssetID(v uuid.UUID) *EpisodeUpdate {
 	_u.mutation.SetResourceAssetID(v)
@@ -253,9 +318,49 @@ func (_u *EpisodeUpdate) SetNillableTranscriptContent(v *string) *EpisodeUpdate
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *EpisodeUpdate) SetUpdatedAt(v time.Time) *EpisodeUpdate {
-	_u.mutation.SetUpdatedAt(v)
+// SetTranscriptFindings sets the "transcript_findings" field.
+func (_u *EpisodeUpdate) SetTranscriptFindings(v []core.TranscriptFinding) *EpisodeUpdate {
+	_u.mutation.SetTranscriptFindings(v)
+	return _u
+}
+
+// AppendTranscriptFindings appends value to the "transcript_findings" field.
+func (_u *EpisodeUpdate) AppendTranscriptFindings(v []core.TranscriptFinding) *EpisodeUpdate {
+	_u.mutation.AppendTranscriptFindings(v)
+	return _u
+}
+
+// ClearTranscriptFindings clears the value of the "transcript_findings" field.
+func (_u *EpisodeUpdate) ClearTranscriptFindings() *EpisodeUpdate {
+	_u.mutation.ClearTranscriptFindings()
+	return _u
+}
+
+// SetTranscriptOffloaded sets the "transcript_offloaded" field.
+func (_u *EpisodeUpdate) SetTranscriptOffloaded(v bool) *EpisodeUpdate {
+	_u.mutation.SetTranscriptOffloaded(v)
+	return _u
+}
+
+// SetNillableTranscriptOffloaded sets the "transcript_offloaded" field if the given value is not nil.
+func (_u *EpisodeUpdate) SetNillableTranscriptOffloaded(v *bool) *EpisodeUpdate {
+	if v != nil {
+		_u.SetTranscriptOffloaded(*v)
+	}
+	return _u
+}
+
+// SetEstimatedLevel sets the "estimated_level" field.
+func (_u *EpisodeUpdate) SetEstimatedLevel(v string) *EpisodeUpdate {
+	_u.mutation.SetEstimatedLevel(v)
+	return _u
+}
+
+// SetNillableEstimatedLevel sets the "estimated_level" field if the given value is not nil.
+func (_u *EpisodeUpdate) SetNillableEstimatedLevel(v *string) *EpisodeUpdate {
+	if v != nil {
+		_u.SetEstimatedLevel(*v)
+	}
 	return _u
 }
 
@@ -279,29 +384,28 @@ func (_u *EpisodeUpdate) ClearPublishedAt() *EpisodeUpdate {
 	return _u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *EpisodeUpdate) SetDeletedAt(v time.Time) *EpisodeUpdate {
-	_u.mutation.SetDeletedAt(v)
-	return _u
+// SetSeries sets the "series" edge to the Series entity.
+func (_u *EpisodeUpdate) SetSeries(v *Series) *EpisodeUpdate {
+	return _u.SetSeriesID(v.ID)
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *EpisodeUpdate) SetNillableDeletedAt(v *time.Time) *EpisodeUpdate {
-	if v != nil {
-		_u.SetDeletedAt(*v)
-	}
+// SetTranscriptID sets the "transcript" edge to the EpisodeTranscript entity by ID.
+func (_u *EpisodeUpdate) SetTranscriptID(id uuid.UUID) *EpisodeUpdate {
+	_u.mutation.SetTranscriptID(id)
 	return _u
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *EpisodeUpdate) ClearDeletedAt() *EpisodeUpdate {
-	_u.mutation.ClearDeletedAt()
+// SetNillableTranscriptID sets the "transcript" edge to the EpisodeTranscript entity by ID if the given value is not nil.
+func (_u *EpisodeUpdate) SetNillableTranscriptID(id *uuid.UUID) *EpisodeUpdate {
+	if id != nil {
+		_u = _u.SetTranscriptID(*id)
+	}
 	return _u
 }
 
-// SetSeries sets the "series" edge to the Series entity.
-func (_u *EpisodeUpdate) SetSeries(v *Series) *EpisodeUpdate {
-	return _u.SetSeriesID(v.ID)
+// SetTranscript sets the "transcript" edge to the EpisodeTranscript entity.
+func (_u *EpisodeUpdate) SetTranscript(v *EpisodeTranscript) *EpisodeUpdate {
+	return _u.SetTranscriptID(v.ID)
 }
 
 // Mutation returns the EpisodeMutation object of the builder.
@@ -315,9 +419,14 @@ func (_u *EpisodeUpdate) ClearSeries() *EpisodeUpdate {
 	return _u
 }
 
+// ClearTranscript clears the "transcript" edge to the EpisodeTranscript entity.
+func (_u *EpisodeUpdate) ClearTranscript() *EpisodeUpdate {
+	_u.mutation.ClearTranscript()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *EpisodeUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -343,14 +452,6 @@ func (_u *EpisodeUpdate) ExecX(ctx context.Context) {
 	}
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *EpisodeUpdate) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := episode.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
-	}
-}
-
 // check runs all checks and user-defined validators on the builder.
 func (_u *EpisodeUpdate) check() error {
 	if _u.mutation.SeriesCleared() && len(_u.mutation.SeriesIDs()) > 0 {
@@ -371,6 +472,15 @@ func (_u *EpisodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(episode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(episode.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(episode.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Seq(); ok {
 		_spec.SetField(episode.FieldSeq, field.TypeUint32, value)
 	}
@@ -389,12 +499,18 @@ func (_u *EpisodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedDurationSeconds(); ok {
 		_spec.AddField(episode.FieldDurationSeconds, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.DurationFromAsset(); ok {
+		_spec.SetField(episode.FieldDurationFromAsset, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(episode.FieldStatus, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.AddedStatus(); ok {
 		_spec.AddField(episode.FieldStatus, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Preview(); ok {
+		_spec.SetField(episode.FieldPreview, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.ResourceAssetID(); ok {
 		_spec.SetField(episode.FieldResourceAssetID, field.TypeUUID, value)
 	}
@@ -425,8 +541,22 @@ func (_u *EpisodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.TranscriptContent(); ok {
 		_spec.SetField(episode.FieldTranscriptContent, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(episode.FieldUpdatedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.TranscriptFindings(); ok {
+		_spec.SetField(episode.FieldTranscriptFindings, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTranscriptFindings(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, episode.FieldTranscriptFindings, value)
+		})
+	}
+	if _u.mutation.TranscriptFindingsCleared() {
+		_spec.ClearField(episode.FieldTranscriptFindings, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TranscriptOffloaded(); ok {
+		_spec.SetField(episode.FieldTranscriptOffloaded, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EstimatedLevel(); ok {
+		_spec.SetField(episode.FieldEstimatedLevel, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.PublishedAt(); ok {
 		_spec.SetField(episode.FieldPublishedAt, field.TypeTime, value)
@@ -434,12 +564,6 @@ func (_u *EpisodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.PublishedAtCleared() {
 		_spec.ClearField(episode.FieldPublishedAt, field.TypeTime)
 	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(episode.FieldDeletedAt, field.TypeTime, value)
-	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(episode.FieldDeletedAt, field.TypeTime)
-	}
 	if _u.mutation.SeriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -469,6 +593,35 @@ func (_u *EpisodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TranscriptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   episode.TranscriptTable,
+			Columns: []string{episode.TranscriptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episodetranscript.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TranscriptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   episode.TranscriptTable,
+			Columns: []string{episode.TranscriptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episodetranscript.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{episode.Label}
@@ -489,6 +642,40 @@ type EpisodeUpdateOne struct {
 	mutation *EpisodeMutation
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EpisodeUpdateOne) SetUpdatedAt(v time.Time) *EpisodeUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *EpisodeUpdateOne) SetNillableUpdatedAt(v *time.Time) *EpisodeUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *EpisodeUpdateOne) SetDeletedAt(v time.Time) *EpisodeUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *EpisodeUpdateOne) SetNillableDeletedAt(v *time.Time) *EpisodeUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *EpisodeUpdateOne) ClearDeletedAt() *EpisodeUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetSeriesID sets the "series_id" field.
 func (_u *EpisodeUpdateOne) SetSeriesID(v uuid.UUID) *EpisodeUpdateOne {
 	_u.mutation.SetSeriesID(v)
@@ -573,6 +760,20 @@ func (_u *EpisodeUpdateOne) AddDurationSeconds(v int) *EpisodeUpdateOne {
 	return _u
 }
 
+// SetDurationFromAsset sets the "duration_from_asset" field.
+func (_u *EpisodeUpdateOne) SetDurationFromAsset(v bool) *EpisodeUpdateOne {
+	_u.mutation.SetDurationFromAsset(v)
+	return _u
+}
+
+// SetNillableDurationFromAsset sets the "duration_from_asset" field if the given value is not nil.
+func (_u *EpisodeUpdateOne) SetNillableDurationFromAsset(v *bool) *EpisodeUpdateOne {
+	if v != nil {
+		_u.SetDurationFromAsset(*v)
+	}
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *EpisodeUpdateOne) SetStatus(v int) *EpisodeUpdateOne {
 	_u.mutation.ResetStatus()
@@ -594,6 +795,20 @@ func (_u *EpisodeUpdateOne) AddStatus(v int) *EpisodeUpdateOne {
 	return _u
 }
 
+// SetPreview sets the "preview" field.
+func (_u *EpisodeUpdateOne) SetPreview(v bool) *EpisodeUpdateOne {
+	_u.mutation.SetPreview(v)
+	return _u
+}
+
+// SetNillablePreview sets the "preview" field if the given value is not nil.
+func (_u *EpisodeUpdateOne) SetNillablePreview(v *bool) *EpisodeUpdateOne {
+	if v != nil {
+		_u.SetPreview(*v)
+	}
+	return _u
+}
+
 // SetResourceAssetID sets the "resource_asset_id" field.
 func (_u *EpisodeUpdateOne) SetResourceAssetID(v uuid.UUID) *EpisodeUpdateOne {
 	_u.mutation.SetResourceAssetID(v)
@@ -712,9 +927,49 @@ func (_u *EpisodeUpdateOne) SetNillableTranscriptContent(v *string) *EpisodeUpda
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *EpisodeUpdateOne) SetUpdatedAt(v time.Time) *EpisodeUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
+// SetTranscriptFindings sets the "transcript_findings" field.
+func (_u *EpisodeUpdateOne) SetTranscriptFindings(v []core.TranscriptFinding) *EpisodeUpdateOne {
+	_u.mutation.SetTranscriptFindings(v)
+	return _u
+}
+
+// AppendTranscriptFindings appends value to the "transcript_findings" field.
+func (_u *EpisodeUpdateOne) AppendTranscriptFindings(v []core.TranscriptFinding) *EpisodeUpdateOne {
+	_u.mutation.AppendTranscriptFindings(v)
+	return _u
+}
+
+// ClearTranscriptFindings clears the value of the "transcript_findings" field.
+func (_u *EpisodeUpdateOne) ClearTranscriptFindings() *EpisodeUpdateOne {
+	_u.mutation.ClearTranscriptFindings()
+	return _u
+}
+
+// SetTranscriptOffloaded sets the "transcript_offloaded" field.
+func (_u *EpisodeUpdateOne) SetTranscriptOffloaded(v bool) *EpisodeUpdateOne {
+	_u.mutation.SetTranscriptOffloaded(v)
+	return _u
+}
+
+// SetNillableTranscriptOffloaded sets the "transcript_offloaded" field if the given value is not nil.
+func (_u *EpisodeUpdateOne) SetNillableTranscriptOffloaded(v *bool) *EpisodeUpdateOne {
+	if v != nil {
+		_u.SetTranscriptOffloaded(*v)
+	}
+	return _u
+}
+
+// SetEstimatedLevel sets the "estimated_level" field.
+func (_u *EpisodeUpdateOne) SetEstimatedLevel(v string) *EpisodeUpdateOne {
+	_u.mutation.SetEstimatedLevel(v)
+	return _u
+}
+
+// SetNillableEstimatedLevel sets the "estimated_level" field if the given value is not nil.
+func (_u *EpisodeUpdateOne) SetNillableEstimatedLevel(v *string) *EpisodeUpdateOne {
+	if v != nil {
+		_u.SetEstimatedLevel(*v)
+	}
 	return _u
 }
 
@@ -738,29 +993,28 @@ func (_u *EpisodeUpdateOne) ClearPublishedAt() *EpisodeUpdateOne {
 	return _u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *EpisodeUpdateOne) SetDeletedAt(v time.Time) *EpisodeUpdateOne {
-	_u.mutation.SetDeletedAt(v)
-	return _u
+// SetSeries sets the "series" edge to the Series entity.
+func (_u *EpisodeUpdateOne) SetSeries(v *Series) *EpisodeUpdateOne {
+	return _u.SetSeriesID(v.ID)
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *EpisodeUpdateOne) SetNillableDeletedAt(v *time.Time) *EpisodeUpdateOne {
-	if v != nil {
-		_u.SetDeletedAt(*v)
-	}
+// SetTranscriptID sets the "transcript" edge to the EpisodeTranscript entity by ID.
+func (_u *EpisodeUpdateOne) SetTranscriptID(id uuid.UUID) *EpisodeUpdateOne {
+	_u.mutation.SetTranscriptID(id)
 	return _u
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *EpisodeUpdateOne) ClearDeletedAt() *EpisodeUpdateOne {
-	_u.mutation.ClearDeletedAt()
+// SetNillableTranscriptID sets the "transcript" edge to the EpisodeTranscript entity by ID if the given value is not nil.
+func (_u *EpisodeUpdateOne) SetNillableTranscriptID(id *uuid.UUID) *EpisodeUpdateOne {
+	if id != nil {
+		_u = _u.SetTranscriptID(*id)
+	}
 	return _u
 }
 
-// SetSeries sets the "series" edge to the Series entity.
-func (_u *EpisodeUpdateOne) SetSeries(v *Series) *EpisodeUpdateOne {
-	return _u.SetSeriesID(v.ID)
+// SetTranscript sets the "transcript" edge to the EpisodeTranscript entity.
+func (_u *EpisodeUpdateOne) SetTranscript(v *EpisodeTranscript) *EpisodeUpdateOne {
+	return _u.SetTranscriptID(v.ID)
 }
 
 // Mutation returns the EpisodeMutation object of the builder.
@@ -774,6 +1028,12 @@ func (_u *EpisodeUpdateOne) ClearSeries() *EpisodeUpdateOne {
 	return _u
 }
 
+// ClearTranscript clears the "transcript" edge to the EpisodeTranscript entity.
+func (_u *EpisodeUpdateOne) ClearTranscript() *EpisodeUpdateOne {
+	_u.mutation.ClearTranscript()
+	return _u
+}
+
 // Where appends a list predicates to the EpisodeUpdate builder.
 func (_u *EpisodeUpdateOne) Where(ps ...predicate.Episode) *EpisodeUpdateOne {
 	_u.mutation.Where(ps...)
@@ -789,7 +1049,6 @@ func (_u *EpisodeUpdateOne) Select(field string, fields ...string) *EpisodeUpdat
 
 // Save executes the query and returns the updated Episode entity.
 func (_u *EpisodeUpdateOne) Save(ctx context.Context) (*Episode, error) {
-	_u.defaults()
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -815,14 +1074,6 @@ func (_u *EpisodeUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *EpisodeUpdateOne) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := episode.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
-	}
-}
-
 // check runs all checks and user-defined validators on the builder.
 func (_u *EpisodeUpdateOne) check() error {
 	if _u.mutation.SeriesCleared() && len(_u.mutation.SeriesIDs()) > 0 {
@@ -860,6 +1111,15 @@ func (_u *EpisodeUpdateOne) sqlSave(ctx context.Context) (_node *Episode, err er
 			}
 		}
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(episode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(episode.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(episode.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Seq(); ok {
 		_spec.SetField(episode.FieldSeq, field.TypeUint32, value)
 	}
@@ -878,12 +1138,18 @@ func (_u *EpisodeUpdateOne) sqlSave(ctx context.Context) (_node *Episode, err er
 	if value, ok := _u.mutation.AddedDurationSeconds(); ok {
 		_spec.AddField(episode.FieldDurationSeconds, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.DurationFromAsset(); ok {
+		_spec.SetField(episode.FieldDurationFromAsset, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(episode.FieldStatus, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.AddedStatus(); ok {
 		_spec.AddField(episode.FieldStatus, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Preview(); ok {
+		_spec.SetField(episode.FieldPreview, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.ResourceAssetID(); ok {
 		_spec.SetField(episode.FieldResourceAssetID, field.TypeUUID, value)
 	}
@@ -914,8 +1180,22 @@ func (_u *EpisodeUpdateOne) sqlSave(ctx context.Context) (_node *Episode, err er
 	if value, ok := _u.mutation.TranscriptContent(); ok {
 		_spec.SetField(episode.FieldTranscriptContent, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(episode.FieldUpdatedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.TranscriptFindings(); ok {
+		_spec.SetField(episode.FieldTranscriptFindings, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTranscriptFindings(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, episode.FieldTranscriptFindings, value)
+		})
+	}
+	if _u.mutation.TranscriptFindingsCleared() {
+		_spec.ClearField(episode.FieldTranscriptFindings, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TranscriptOffloaded(); ok {
+		_spec.SetField(episode.FieldTranscriptOffloaded, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EstimatedLevel(); ok {
+		_spec.SetField(episode.FieldEstimatedLevel, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.PublishedAt(); ok {
 		_spec.SetField(episode.FieldPublishedAt, field.TypeTime, value)
@@ -923,12 +1203,6 @@ func (_u *EpisodeUpdateOne) sqlSave(ctx context.Context) (_node *Episode, err er
 	if _u.mutation.PublishedAtCleared() {
 		_spec.ClearField(episode.FieldPublishedAt, field.TypeTime)
 	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(episode.FieldDeletedAt, field.TypeTime, value)
-	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(episode.FieldDeletedAt, field.TypeTime)
-	}
 	if _u.mutation.SeriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -958,6 +1232,35 @@ func (_u *EpisodeUpdateOne) sqlSave(ctx context.Context) (_node *Episode, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TranscriptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   episode.TranscriptTable,
+			Columns: []string{episode.TranscriptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episodetranscript.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TranscriptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   episode.TranscriptTable,
+			Columns: []string{episode.TranscriptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episodetranscript.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Episode{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
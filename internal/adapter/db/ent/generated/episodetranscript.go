@@ -0,0 +1,144 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episode"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episodetranscript"
+	"github.com/google/uuid"
+)
+
+// EpisodeTranscript is the model entity for the EpisodeTranscript schema.
+type EpisodeTranscript struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// EpisodeID holds the value of the "episode_id" field.
+	EpisodeID uuid.UUID `json:"episode_id,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EpisodeTranscriptQuery when eager-loading is set.
+	Edges        EpisodeTranscriptEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EpisodeTranscriptEdges holds the relations/edges for other nodes in the graph.
+type EpisodeTranscriptEdges struct {
+	// Episode holds the value of the episode edge.
+	Episode *Episode `json:"episode,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EpisodeOrErr returns the Episode value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EpisodeTranscriptEdges) EpisodeOrErr() (*Episode, error) {
+	if e.Episode != nil {
+		return e.Episode, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: episode.Label}
+	}
+	return nil, &NotLoadedError{edge: "episode"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EpisodeTranscript) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case episodetranscript.FieldContent:
+			values[i] = new(sql.NullString)
+		case episodetranscript.FieldID, episodetranscript.FieldEpisodeID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EpisodeTranscript fields.
+func (_m *EpisodeTranscript) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case episodetranscript.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case episodetranscript.FieldEpisodeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field episode_id", values[i])
+			} else if value != nil {
+				_m.EpisodeID = *value
+			}
+		case episodetranscript.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EpisodeTranscript.
+// This includes values selected through modifiers, order, etc.
+func (_m *EpisodeTranscript) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryEpisode queries the "episode" edge of the EpisodeTranscript entity.
+func (_m *EpisodeTranscript) QueryEpisode() *EpisodeQuery {
+	return NewEpisodeTranscriptClient(_m.config).QueryEpisode(_m)
+}
+
+// Update returns a builder for updating this EpisodeTranscript.
+// Note that you need to call EpisodeTranscript.Unwrap() before calling this method if this EpisodeTranscript
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EpisodeTranscript) Update() *EpisodeTranscriptUpdateOne {
+	return NewEpisodeTranscriptClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EpisodeTranscript entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EpisodeTranscript) Unwrap() *EpisodeTranscript {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: EpisodeTranscript is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EpisodeTranscript) String() string {
+	var builder strings.Builder
+	builder.WriteString("EpisodeTranscript(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("episode_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EpisodeID))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EpisodeTranscripts is a parsable slice of EpisodeTranscript.
+type EpisodeTranscripts []*EpisodeTranscript
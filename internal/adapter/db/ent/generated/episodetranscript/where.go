@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package episodetranscript
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldLTE(FieldID, id))
+}
+
+// EpisodeID applies equality check predicate on the "episode_id" field. It's identical to EpisodeIDEQ.
+func EpisodeID(v uuid.UUID) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldEQ(FieldEpisodeID, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldEQ(FieldContent, v))
+}
+
+// EpisodeIDEQ applies the EQ predicate on the "episode_id" field.
+func EpisodeIDEQ(v uuid.UUID) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldEQ(FieldEpisodeID, v))
+}
+
+// EpisodeIDNEQ applies the NEQ predicate on the "episode_id" field.
+func EpisodeIDNEQ(v uuid.UUID) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldNEQ(FieldEpisodeID, v))
+}
+
+// EpisodeIDIn applies the In predicate on the "episode_id" field.
+func EpisodeIDIn(vs ...uuid.UUID) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldIn(FieldEpisodeID, vs...))
+}
+
+// EpisodeIDNotIn applies the NotIn predicate on the "episode_id" field.
+func EpisodeIDNotIn(vs ...uuid.UUID) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldNotIn(FieldEpisodeID, vs...))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.FieldContainsFold(FieldContent, v))
+}
+
+// HasEpisode applies the HasEdge predicate on the "episode" edge.
+func HasEpisode() predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, EpisodeTable, EpisodeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEpisodeWith applies the HasEdge predicate on the "episode" edge with a given conditions (other predicates).
+func HasEpisodeWith(preds ...predicate.Episode) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(func(s *sql.Selector) {
+		step := newEpisodeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EpisodeTranscript) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EpisodeTranscript) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EpisodeTranscript) predicate.EpisodeTranscript {
+	return predicate.EpisodeTranscript(sql.NotPredicates(p))
+}
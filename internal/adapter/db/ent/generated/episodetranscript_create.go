@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episode"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episodetranscript"
+	"github.com/google/uuid"
+)
+
+// EpisodeTranscriptCreate is the builder for creating a EpisodeTranscript entity.
+type EpisodeTranscriptCreate struct {
+	config
+	mutation *EpisodeTranscriptMutation
+	hooks    []Hook
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_c *EpisodeTranscriptCreate) SetEpisodeID(v uuid.UUID) *EpisodeTranscriptCreate {
+	_c.mutation.SetEpisodeID(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *EpisodeTranscriptCreate) SetContent(v string) *EpisodeTranscriptCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_c *EpisodeTranscriptCreate) SetNillableContent(v *string) *EpisodeTranscriptCreate {
+	if v != nil {
+		_c.SetContent(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EpisodeTranscriptCreate) SetID(v uuid.UUID) *EpisodeTranscriptCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *EpisodeTranscriptCreate) SetNillableID(v *uuid.UUID) *EpisodeTranscriptCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (_c *EpisodeTranscriptCreate) SetEpisode(v *Episode) *EpisodeTranscriptCreate {
+	return _c.SetEpisodeID(v.ID)
+}
+
+// Mutation returns the EpisodeTranscriptMutation object of the builder.
+func (_c *EpisodeTranscriptCreate) Mutation() *EpisodeTranscriptMutation {
+	return _c.mutation
+}
+
+// Save creates the EpisodeTranscript in the database.
+func (_c *EpisodeTranscriptCreate) Save(ctx context.Context) (*EpisodeTranscript, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EpisodeTranscriptCreate) SaveX(ctx context.Context) *EpisodeTranscript {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EpisodeTranscriptCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EpisodeTranscriptCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EpisodeTranscriptCreate) defaults() {
+	if _, ok := _c.mutation.Content(); !ok {
+		v := episodetranscript.DefaultContent
+		_c.mutation.SetContent(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := episodetranscript.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EpisodeTranscriptCreate) check() error {
+	if _, ok := _c.mutation.EpisodeID(); !ok {
+		return &ValidationError{Name: "episode_id", err: errors.New(`generated: missing required field "EpisodeTranscript.episode_id"`)}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`generated: missing required field "EpisodeTranscript.content"`)}
+	}
+	if len(_c.mutation.EpisodeIDs()) == 0 {
+		return &ValidationError{Name: "episode", err: errors.New(`generated: missing required edge "EpisodeTranscript.episode"`)}
+	}
+	return nil
+}
+
+func (_c *EpisodeTranscriptCreate) sqlSave(ctx context.Context) (*EpisodeTranscript, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EpisodeTranscriptCreate) createSpec() (*EpisodeTranscript, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EpisodeTranscript{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(episodetranscript.Table, sqlgraph.NewFieldSpec(episodetranscript.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(episodetranscript.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if nodes := _c.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   episodetranscript.EpisodeTable,
+			Columns: []string{episodetranscript.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EpisodeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EpisodeTranscriptCreateBulk is the builder for creating many EpisodeTranscript entities in bulk.
+type EpisodeTranscriptCreateBulk struct {
+	config
+	err      error
+	builders []*EpisodeTranscriptCreate
+}
+
+// Save creates the EpisodeTranscript entities in the database.
+func (_c *EpisodeTranscriptCreateBulk) Save(ctx context.Context) ([]*EpisodeTranscript, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EpisodeTranscript, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EpisodeTranscriptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EpisodeTranscriptCreateBulk) SaveX(ctx context.Context) []*EpisodeTranscript {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EpisodeTranscriptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EpisodeTranscriptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episodetranscript"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+)
+
+// EpisodeTranscriptDelete is the builder for deleting a EpisodeTranscript entity.
+type EpisodeTranscriptDelete struct {
+	config
+	hooks    []Hook
+	mutation *EpisodeTranscriptMutation
+}
+
+// Where appends a list predicates to the EpisodeTranscriptDelete builder.
+func (_d *EpisodeTranscriptDelete) Where(ps ...predicate.EpisodeTranscript) *EpisodeTranscriptDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *EpisodeTranscriptDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EpisodeTranscriptDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *EpisodeTranscriptDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(episodetranscript.Table, sqlgraph.NewFieldSpec(episodetranscript.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// EpisodeTranscriptDeleteOne is the builder for deleting a single EpisodeTranscript entity.
+type EpisodeTranscriptDeleteOne struct {
+	_d *EpisodeTranscriptDelete
+}
+
+// Where appends a list predicates to the EpisodeTranscriptDelete builder.
+func (_d *EpisodeTranscriptDeleteOne) Where(ps ...predicate.EpisodeTranscript) *EpisodeTranscriptDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *EpisodeTranscriptDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{episodetranscript.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EpisodeTranscriptDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,607 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episode"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episodetranscript"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// EpisodeTranscriptQuery is the builder for querying EpisodeTranscript entities.
+type EpisodeTranscriptQuery struct {
+	config
+	ctx         *QueryContext
+	order       []episodetranscript.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.EpisodeTranscript
+	withEpisode *EpisodeQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EpisodeTranscriptQuery builder.
+func (_q *EpisodeTranscriptQuery) Where(ps ...predicate.EpisodeTranscript) *EpisodeTranscriptQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *EpisodeTranscriptQuery) Limit(limit int) *EpisodeTranscriptQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *EpisodeTranscriptQuery) Offset(offset int) *EpisodeTranscriptQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *EpisodeTranscriptQuery) Unique(unique bool) *EpisodeTranscriptQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *EpisodeTranscriptQuery) Order(o ...episodetranscript.OrderOption) *EpisodeTranscriptQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryEpisode chains the current query on the "episode" edge.
+func (_q *EpisodeTranscriptQuery) QueryEpisode() *EpisodeQuery {
+	query := (&EpisodeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(episodetranscript.Table, episodetranscript.FieldID, selector),
+			sqlgraph.To(episode.Table, episode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, episodetranscript.EpisodeTable, episodetranscript.EpisodeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first EpisodeTranscript entity from the query.
+// Returns a *NotFoundError when no EpisodeTranscript was found.
+func (_q *EpisodeTranscriptQuery) First(ctx context.Context) (*EpisodeTranscript, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{episodetranscript.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *EpisodeTranscriptQuery) FirstX(ctx context.Context) *EpisodeTranscript {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EpisodeTranscript ID from the query.
+// Returns a *NotFoundError when no EpisodeTranscript ID was found.
+func (_q *EpisodeTranscriptQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{episodetranscript.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *EpisodeTranscriptQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EpisodeTranscript entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EpisodeTranscript entity is found.
+// Returns a *NotFoundError when no EpisodeTranscript entities are found.
+func (_q *EpisodeTranscriptQuery) Only(ctx context.Context) (*EpisodeTranscript, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{episodetranscript.Label}
+	default:
+		return nil, &NotSingularError{episodetranscript.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *EpisodeTranscriptQuery) OnlyX(ctx context.Context) *EpisodeTranscript {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EpisodeTranscript ID in the query.
+// Returns a *NotSingularError when more than one EpisodeTranscript ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *EpisodeTranscriptQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{episodetranscript.Label}
+	default:
+		err = &NotSingularError{episodetranscript.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *EpisodeTranscriptQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EpisodeTranscripts.
+func (_q *EpisodeTranscriptQuery) All(ctx context.Context) ([]*EpisodeTranscript, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EpisodeTranscript, *EpisodeTranscriptQuery]()
+	return withInterceptors[[]*EpisodeTranscript](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *EpisodeTranscriptQuery) AllX(ctx context.Context) []*EpisodeTranscript {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EpisodeTranscript IDs.
+func (_q *EpisodeTranscriptQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(episodetranscript.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *EpisodeTranscriptQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *EpisodeTranscriptQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*EpisodeTranscriptQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *EpisodeTranscriptQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *EpisodeTranscriptQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("generated: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *EpisodeTranscriptQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EpisodeTranscriptQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *EpisodeTranscriptQuery) Clone() *EpisodeTranscriptQuery {
+	if _q == nil {
+		return nil
+	}
+	return &EpisodeTranscriptQuery{
+		config:      _q.config,
+		ctx:         _q.ctx.Clone(),
+		order:       append([]episodetranscript.OrderOption{}, _q.order...),
+		inters:      append([]Interceptor{}, _q.inters...),
+		predicates:  append([]predicate.EpisodeTranscript{}, _q.predicates...),
+		withEpisode: _q.withEpisode.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithEpisode tells the query-builder to eager-load the nodes that are connected to
+// the "episode" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EpisodeTranscriptQuery) WithEpisode(opts ...func(*EpisodeQuery)) *EpisodeTranscriptQuery {
+	query := (&EpisodeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEpisode = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		EpisodeID uuid.UUID `json:"episode_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EpisodeTranscript.Query().
+//		GroupBy(episodetranscript.FieldEpisodeID).
+//		Aggregate(generated.Count()).
+//		Scan(ctx, &v)
+func (_q *EpisodeTranscriptQuery) GroupBy(field string, fields ...string) *EpisodeTranscriptGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EpisodeTranscriptGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = episodetranscript.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		EpisodeID uuid.UUID `json:"episode_id,omitempty"`
+//	}
+//
+//	client.EpisodeTranscript.Query().
+//		Select(episodetranscript.FieldEpisodeID).
+//		Scan(ctx, &v)
+func (_q *EpisodeTranscriptQuery) Select(fields ...string) *EpisodeTranscriptSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &EpisodeTranscriptSelect{EpisodeTranscriptQuery: _q}
+	sbuild.label = episodetranscript.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EpisodeTranscriptSelect configured with the given aggregations.
+func (_q *EpisodeTranscriptQuery) Aggregate(fns ...AggregateFunc) *EpisodeTranscriptSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *EpisodeTranscriptQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("generated: uninitialized interceptor (forgotten import generated/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !episodetranscript.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *EpisodeTranscriptQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EpisodeTranscript, error) {
+	var (
+		nodes       = []*EpisodeTranscript{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withEpisode != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EpisodeTranscript).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EpisodeTranscript{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withEpisode; query != nil {
+		if err := _q.loadEpisode(ctx, query, nodes, nil,
+			func(n *EpisodeTranscript, e *Episode) { n.Edges.Episode = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *EpisodeTranscriptQuery) loadEpisode(ctx context.Context, query *EpisodeQuery, nodes []*EpisodeTranscript, init func(*EpisodeTranscript), assign func(*EpisodeTranscript, *Episode)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*EpisodeTranscript)
+	for i := range nodes {
+		fk := nodes[i].EpisodeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(episode.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "episode_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *EpisodeTranscriptQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *EpisodeTranscriptQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(episodetranscript.Table, episodetranscript.Columns, sqlgraph.NewFieldSpec(episodetranscript.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, episodetranscript.FieldID)
+		for i := range fields {
+			if fields[i] != episodetranscript.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withEpisode != nil {
+			_spec.Node.AddColumnOnce(episodetranscript.FieldEpisodeID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *EpisodeTranscriptQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(episodetranscript.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = episodetranscript.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// EpisodeTranscriptGroupBy is the group-by builder for EpisodeTranscript entities.
+type EpisodeTranscriptGroupBy struct {
+	selector
+	build *EpisodeTranscriptQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *EpisodeTranscriptGroupBy) Aggregate(fns ...AggregateFunc) *EpisodeTranscriptGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *EpisodeTranscriptGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EpisodeTranscriptQuery, *EpisodeTranscriptGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *EpisodeTranscriptGroupBy) sqlScan(ctx context.Context, root *EpisodeTranscriptQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EpisodeTranscriptSelect is the builder for selecting fields of EpisodeTranscript entities.
+type EpisodeTranscriptSelect struct {
+	*EpisodeTranscriptQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *EpisodeTranscriptSelect) Aggregate(fns ...AggregateFunc) *EpisodeTranscriptSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *EpisodeTranscriptSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EpisodeTranscriptQuery, *EpisodeTranscriptSelect](ctx, _s.EpisodeTranscriptQuery, _s, _s.inters, v)
+}
+
+func (_s *EpisodeTranscriptSelect) sqlScan(ctx context.Context, root *EpisodeTranscriptQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
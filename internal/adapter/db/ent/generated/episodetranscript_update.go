@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episodetranscript"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+)
+
+// EpisodeTranscriptUpdate is the builder for updating EpisodeTranscript entities.
+type EpisodeTranscriptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EpisodeTranscriptMutation
+}
+
+// Where appends a list predicates to the EpisodeTranscriptUpdate builder.
+func (_u *EpisodeTranscriptUpdate) Where(ps ...predicate.EpisodeTranscript) *EpisodeTranscriptUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *EpisodeTranscriptUpdate) SetContent(v string) *EpisodeTranscriptUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *EpisodeTranscriptUpdate) SetNillableContent(v *string) *EpisodeTranscriptUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// Mutation returns the EpisodeTranscriptMutation object of the builder.
+func (_u *EpisodeTranscriptUpdate) Mutation() *EpisodeTranscriptMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EpisodeTranscriptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EpisodeTranscriptUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EpisodeTranscriptUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EpisodeTranscriptUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EpisodeTranscriptUpdate) check() error {
+	if _u.mutation.EpisodeCleared() && len(_u.mutation.EpisodeIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "EpisodeTranscript.episode"`)
+	}
+	return nil
+}
+
+func (_u *EpisodeTranscriptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(episodetranscript.Table, episodetranscript.Columns, sqlgraph.NewFieldSpec(episodetranscript.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(episodetranscript.FieldContent, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{episodetranscript.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EpisodeTranscriptUpdateOne is the builder for updating a single EpisodeTranscript entity.
+type EpisodeTranscriptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EpisodeTranscriptMutation
+}
+
+// SetContent sets the "content" field.
+func (_u *EpisodeTranscriptUpdateOne) SetContent(v string) *EpisodeTranscriptUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *EpisodeTranscriptUpdateOne) SetNillableContent(v *string) *EpisodeTranscriptUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// Mutation returns the EpisodeTranscriptMutation object of the builder.
+func (_u *EpisodeTranscriptUpdateOne) Mutation() *EpisodeTranscriptMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EpisodeTranscriptUpdate builder.
+func (_u *EpisodeTranscriptUpdateOne) Where(ps ...predicate.EpisodeTranscript) *EpisodeTranscriptUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EpisodeTranscriptUpdateOne) Select(field string, fields ...string) *EpisodeTranscriptUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EpisodeTranscript entity.
+func (_u *EpisodeTranscriptUpdateOne) Save(ctx context.Context) (*EpisodeTranscript, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EpisodeTranscriptUpdateOne) SaveX(ctx context.Context) *EpisodeTranscript {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EpisodeTranscriptUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EpisodeTranscriptUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EpisodeTranscriptUpdateOne) check() error {
+	if _u.mutation.EpisodeCleared() && len(_u.mutation.EpisodeIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "EpisodeTranscript.episode"`)
+	}
+	return nil
+}
+
+func (_u *EpisodeTranscriptUpdateOne) sqlSave(ctx context.Context) (_node *EpisodeTranscript, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(episodetranscript.Table, episodetranscript.Columns, sqlgraph.NewFieldSpec(episodetranscript.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "EpisodeTranscript.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, episodetranscript.FieldID)
+		for _, f := range fields {
+			if !episodetranscript.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != episodetranscript.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(episodetranscript.FieldContent, field.TypeString, value)
+	}
+	_node = &EpisodeTranscript{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{episodetranscript.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
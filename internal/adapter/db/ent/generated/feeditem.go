@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/feeditem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/feedsubscription"
+	"github.com/google/uuid"
+)
+
+// FeedItem is the model entity for the FeedItem schema.
+type FeedItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// SubscriptionID holds the value of the "subscription_id" field.
+	SubscriptionID uuid.UUID `json:"subscription_id,omitempty"`
+	// GUID holds the value of the "guid" field.
+	GUID string `json:"guid,omitempty"`
+	// EpisodeID holds the value of the "episode_id" field.
+	EpisodeID uuid.UUID `json:"episode_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FeedItemQuery when eager-loading is set.
+	Edges        FeedItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FeedItemEdges holds the relations/edges for other nodes in the graph.
+type FeedItemEdges struct {
+	// Subscription holds the value of the subscription edge.
+	Subscription *FeedSubscription `json:"subscription,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SubscriptionOrErr returns the Subscription value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FeedItemEdges) SubscriptionOrErr() (*FeedSubscription, error) {
+	if e.Subscription != nil {
+		return e.Subscription, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: feedsubscription.Label}
+	}
+	return nil, &NotLoadedError{edge: "subscription"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FeedItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case feeditem.FieldGUID:
+			values[i] = new(sql.NullString)
+		case feeditem.FieldCreatedAt, feeditem.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case feeditem.FieldID, feeditem.FieldSubscriptionID, feeditem.FieldEpisodeID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FeedItem fields.
+func (_m *FeedItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case feeditem.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case feeditem.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case feeditem.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case feeditem.FieldSubscriptionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value != nil {
+				_m.SubscriptionID = *value
+			}
+		case feeditem.FieldGUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guid", values[i])
+			} else if value.Valid {
+				_m.GUID = value.String
+			}
+		case feeditem.FieldEpisodeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field episode_id", values[i])
+			} else if value != nil {
+				_m.EpisodeID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FeedItem.
+// This includes values selected through modifiers, order, etc.
+func (_m *FeedItem) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySubscription queries the "subscription" edge of the FeedItem entity.
+func (_m *FeedItem) QuerySubscription() *FeedSubscriptionQuery {
+	return NewFeedItemClient(_m.config).QuerySubscription(_m)
+}
+
+// Update returns a builder for updating this FeedItem.
+// Note that you need to call FeedItem.Unwrap() before calling this method if this FeedItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FeedItem) Update() *FeedItemUpdateOne {
+	return NewFeedItemClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FeedItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FeedItem) Unwrap() *FeedItem {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: FeedItem is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FeedItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("FeedItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("subscription_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SubscriptionID))
+	builder.WriteString(", ")
+	builder.WriteString("guid=")
+	builder.WriteString(_m.GUID)
+	builder.WriteString(", ")
+	builder.WriteString("episode_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EpisodeID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FeedItems is a parsable slice of FeedItem.
+type FeedItems []*FeedItem
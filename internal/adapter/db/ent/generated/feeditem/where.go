@@ -0,0 +1,325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package feeditem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// GUID applies equality check predicate on the "guid" field. It's identical to GUIDEQ.
+func GUID(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldGUID, v))
+}
+
+// EpisodeID applies equality check predicate on the "episode_id" field. It's identical to EpisodeIDEQ.
+func EpisodeID(v uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldEpisodeID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// GUIDEQ applies the EQ predicate on the "guid" field.
+func GUIDEQ(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldGUID, v))
+}
+
+// GUIDNEQ applies the NEQ predicate on the "guid" field.
+func GUIDNEQ(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNEQ(FieldGUID, v))
+}
+
+// GUIDIn applies the In predicate on the "guid" field.
+func GUIDIn(vs ...string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldIn(FieldGUID, vs...))
+}
+
+// GUIDNotIn applies the NotIn predicate on the "guid" field.
+func GUIDNotIn(vs ...string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNotIn(FieldGUID, vs...))
+}
+
+// GUIDGT applies the GT predicate on the "guid" field.
+func GUIDGT(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGT(FieldGUID, v))
+}
+
+// GUIDGTE applies the GTE predicate on the "guid" field.
+func GUIDGTE(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGTE(FieldGUID, v))
+}
+
+// GUIDLT applies the LT predicate on the "guid" field.
+func GUIDLT(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLT(FieldGUID, v))
+}
+
+// GUIDLTE applies the LTE predicate on the "guid" field.
+func GUIDLTE(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLTE(FieldGUID, v))
+}
+
+// GUIDContains applies the Contains predicate on the "guid" field.
+func GUIDContains(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldContains(FieldGUID, v))
+}
+
+// GUIDHasPrefix applies the HasPrefix predicate on the "guid" field.
+func GUIDHasPrefix(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldHasPrefix(FieldGUID, v))
+}
+
+// GUIDHasSuffix applies the HasSuffix predicate on the "guid" field.
+func GUIDHasSuffix(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldHasSuffix(FieldGUID, v))
+}
+
+// GUIDEqualFold applies the EqualFold predicate on the "guid" field.
+func GUIDEqualFold(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEqualFold(FieldGUID, v))
+}
+
+// GUIDContainsFold applies the ContainsFold predicate on the "guid" field.
+func GUIDContainsFold(v string) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldContainsFold(FieldGUID, v))
+}
+
+// EpisodeIDEQ applies the EQ predicate on the "episode_id" field.
+func EpisodeIDEQ(v uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldEQ(FieldEpisodeID, v))
+}
+
+// EpisodeIDNEQ applies the NEQ predicate on the "episode_id" field.
+func EpisodeIDNEQ(v uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNEQ(FieldEpisodeID, v))
+}
+
+// EpisodeIDIn applies the In predicate on the "episode_id" field.
+func EpisodeIDIn(vs ...uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldIn(FieldEpisodeID, vs...))
+}
+
+// EpisodeIDNotIn applies the NotIn predicate on the "episode_id" field.
+func EpisodeIDNotIn(vs ...uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldNotIn(FieldEpisodeID, vs...))
+}
+
+// EpisodeIDGT applies the GT predicate on the "episode_id" field.
+func EpisodeIDGT(v uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGT(FieldEpisodeID, v))
+}
+
+// EpisodeIDGTE applies the GTE predicate on the "episode_id" field.
+func EpisodeIDGTE(v uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldGTE(FieldEpisodeID, v))
+}
+
+// EpisodeIDLT applies the LT predicate on the "episode_id" field.
+func EpisodeIDLT(v uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLT(FieldEpisodeID, v))
+}
+
+// EpisodeIDLTE applies the LTE predicate on the "episode_id" field.
+func EpisodeIDLTE(v uuid.UUID) predicate.FeedItem {
+	return predicate.FeedItem(sql.FieldLTE(FieldEpisodeID, v))
+}
+
+// HasSubscription applies the HasEdge predicate on the "subscription" edge.
+func HasSubscription() predicate.FeedItem {
+	return predicate.FeedItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SubscriptionTable, SubscriptionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubscriptionWith applies the HasEdge predicate on the "subscription" edge with a given conditions (other predicates).
+func HasSubscriptionWith(preds ...predicate.FeedSubscription) predicate.FeedItem {
+	return predicate.FeedItem(func(s *sql.Selector) {
+		step := newSubscriptionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FeedItem) predicate.FeedItem {
+	return predicate.FeedItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FeedItem) predicate.FeedItem {
+	return predicate.FeedItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FeedItem) predicate.FeedItem {
+	return predicate.FeedItem(sql.NotPredicates(p))
+}
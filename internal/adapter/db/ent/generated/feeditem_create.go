@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/feeditem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/feedsubscription"
+	"github.com/google/uuid"
+)
+
+// FeedItemCreate is the builder for creating a FeedItem entity.
+type FeedItemCreate struct {
+	config
+	mutation *FeedItemMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FeedItemCreate) SetCreatedAt(v time.Time) *FeedItemCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *FeedItemCreate) SetUpdatedAt(v time.Time) *FeedItemCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_c *FeedItemCreate) SetSubscriptionID(v uuid.UUID) *FeedItemCreate {
+	_c.mutation.SetSubscriptionID(v)
+	return _c
+}
+
+// SetGUID sets the "guid" field.
+func (_c *FeedItemCreate) SetGUID(v string) *FeedItemCreate {
+	_c.mutation.SetGUID(v)
+	return _c
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_c *FeedItemCreate) SetEpisodeID(v uuid.UUID) *FeedItemCreate {
+	_c.mutation.SetEpisodeID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *FeedItemCreate) SetID(v uuid.UUID) *FeedItemCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *FeedItemCreate) SetNillableID(v *uuid.UUID) *FeedItemCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetSubscription sets the "subscription" edge to the FeedSubscription entity.
+func (_c *FeedItemCreate) SetSubscription(v *FeedSubscription) *FeedItemCreate {
+	return _c.SetSubscriptionID(v.ID)
+}
+
+// Mutation returns the FeedItemMutation object of the builder.
+func (_c *FeedItemCreate) Mutation() *FeedItemMutation {
+	return _c.mutation
+}
+
+// Save creates the FeedItem in the database.
+func (_c *FeedItemCreate) Save(ctx context.Context) (*FeedItem, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FeedItemCreate) SaveX(ctx context.Context) *FeedItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FeedItemCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FeedItemCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FeedItemCreate) defaults() error {
+	if _, ok := _c.mutation.ID(); !ok {
+		if feeditem.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized feeditem.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := feeditem.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FeedItemCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "FeedItem.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "FeedItem.updated_at"`)}
+	}
+	if _, ok := _c.mutation.SubscriptionID(); !ok {
+		return &ValidationError{Name: "subscription_id", err: errors.New(`generated: missing required field "FeedItem.subscription_id"`)}
+	}
+	if _, ok := _c.mutation.GUID(); !ok {
+		return &ValidationError{Name: "guid", err: errors.New(`generated: missing required field "FeedItem.guid"`)}
+	}
+	if _, ok := _c.mutation.EpisodeID(); !ok {
+		return &ValidationError{Name: "episode_id", err: errors.New(`generated: missing required field "FeedItem.episode_id"`)}
+	}
+	if len(_c.mutation.SubscriptionIDs()) == 0 {
+		return &ValidationError{Name: "subscription", err: errors.New(`generated: missing required edge "FeedItem.subscription"`)}
+	}
+	return nil
+}
+
+func (_c *FeedItemCreate) sqlSave(ctx context.Context) (*FeedItem, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FeedItemCreate) createSpec() (*FeedItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FeedItem{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(feeditem.Table, sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(feeditem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(feeditem.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.GUID(); ok {
+		_spec.SetField(feeditem.FieldGUID, field.TypeString, value)
+		_node.GUID = value
+	}
+	if value, ok := _c.mutation.EpisodeID(); ok {
+		_spec.SetField(feeditem.FieldEpisodeID, field.TypeUUID, value)
+		_node.EpisodeID = value
+	}
+	if nodes := _c.mutation.SubscriptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feeditem.SubscriptionTable,
+			Columns: []string{feeditem.SubscriptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SubscriptionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FeedItemCreateBulk is the builder for creating many FeedItem entities in bulk.
+type FeedItemCreateBulk struct {
+	config
+	err      error
+	builders []*FeedItemCreate
+}
+
+// Save creates the FeedItem entities in the database.
+func (_c *FeedItemCreateBulk) Save(ctx context.Context) ([]*FeedItem, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FeedItem, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FeedItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FeedItemCreateBulk) SaveX(ctx context.Context) []*FeedItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FeedItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FeedItemCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/feedsubscription"
+	"github.com/google/uuid"
+)
+
+// FeedSubscription is the model entity for the FeedSubscription schema.
+type FeedSubscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// SeriesID holds the value of the "series_id" field.
+	SeriesID uuid.UUID `json:"series_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind int `json:"kind,omitempty"`
+	// SourceURL holds the value of the "source_url" field.
+	SourceURL string `json:"source_url,omitempty"`
+	// DownloadMedia holds the value of the "download_media" field.
+	DownloadMedia bool `json:"download_media,omitempty"`
+	// AutoPublish holds the value of the "auto_publish" field.
+	AutoPublish bool `json:"auto_publish,omitempty"`
+	// LastSyncedAt holds the value of the "last_synced_at" field.
+	LastSyncedAt *time.Time `json:"last_synced_at,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FeedSubscriptionQuery when eager-loading is set.
+	Edges        FeedSubscriptionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FeedSubscriptionEdges holds the relations/edges for other nodes in the graph.
+type FeedSubscriptionEdges struct {
+	// Items holds the value of the items edge.
+	Items []*FeedItem `json:"items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ItemsOrErr returns the Items value or an error if the edge
+// was not loaded in eager-loading.
+func (e FeedSubscriptionEdges) ItemsOrErr() ([]*FeedItem, error) {
+	if e.loadedTypes[0] {
+		return e.Items, nil
+	}
+	return nil, &NotLoadedError{edge: "items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FeedSubscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case feedsubscription.FieldDownloadMedia, feedsubscription.FieldAutoPublish:
+			values[i] = new(sql.NullBool)
+		case feedsubscription.FieldKind:
+			values[i] = new(sql.NullInt64)
+		case feedsubscription.FieldSourceURL, feedsubscription.FieldLastError:
+			values[i] = new(sql.NullString)
+		case feedsubscription.FieldCreatedAt, feedsubscription.FieldUpdatedAt, feedsubscription.FieldLastSyncedAt:
+			values[i] = new(sql.NullTime)
+		case feedsubscription.FieldID, feedsubscription.FieldSeriesID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FeedSubscription fields.
+func (_m *FeedSubscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case feedsubscription.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case feedsubscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case feedsubscription.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case feedsubscription.FieldSeriesID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field series_id", values[i])
+			} else if value != nil {
+				_m.SeriesID = *value
+			}
+		case feedsubscription.FieldKind:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = int(value.Int64)
+			}
+		case feedsubscription.FieldSourceURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_url", values[i])
+			} else if value.Valid {
+				_m.SourceURL = value.String
+			}
+		case feedsubscription.FieldDownloadMedia:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field download_media", values[i])
+			} else if value.Valid {
+				_m.DownloadMedia = value.Bool
+			}
+		case feedsubscription.FieldAutoPublish:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_publish", values[i])
+			} else if value.Valid {
+				_m.AutoPublish = value.Bool
+			}
+		case feedsubscription.FieldLastSyncedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_synced_at", values[i])
+			} else if value.Valid {
+				_m.LastSyncedAt = new(time.Time)
+				*_m.LastSyncedAt = value.Time
+			}
+		case feedsubscription.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FeedSubscription.
+// This includes values selected through modifiers, order, etc.
+func (_m *FeedSubscription) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryItems queries the "items" edge of the FeedSubscription entity.
+func (_m *FeedSubscription) QueryItems() *FeedItemQuery {
+	return NewFeedSubscriptionClient(_m.config).QueryItems(_m)
+}
+
+// Update returns a builder for updating this FeedSubscription.
+// Note that you need to call FeedSubscription.Unwrap() before calling this method if this FeedSubscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FeedSubscription) Update() *FeedSubscriptionUpdateOne {
+	return NewFeedSubscriptionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FeedSubscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FeedSubscription) Unwrap() *FeedSubscription {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: FeedSubscription is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FeedSubscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("FeedSubscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("series_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SeriesID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("source_url=")
+	builder.WriteString(_m.SourceURL)
+	builder.WriteString(", ")
+	builder.WriteString("download_media=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DownloadMedia))
+	builder.WriteString(", ")
+	builder.WriteString("auto_publish=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoPublish))
+	builder.WriteString(", ")
+	if v := _m.LastSyncedAt; v != nil {
+		builder.WriteString("last_synced_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FeedSubscriptions is a parsable slice of FeedSubscription.
+type FeedSubscriptions []*FeedSubscription
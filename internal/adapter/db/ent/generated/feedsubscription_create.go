@@ -0,0 +1,397 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/feeditem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/feedsubscription"
+	"github.com/google/uuid"
+)
+
+// FeedSubscriptionCreate is the builder for creating a FeedSubscription entity.
+type FeedSubscriptionCreate struct {
+	config
+	mutation *FeedSubscriptionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FeedSubscriptionCreate) SetCreatedAt(v time.Time) *FeedSubscriptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *FeedSubscriptionCreate) SetUpdatedAt(v time.Time) *FeedSubscriptionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_c *FeedSubscriptionCreate) SetSeriesID(v uuid.UUID) *FeedSubscriptionCreate {
+	_c.mutation.SetSeriesID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *FeedSubscriptionCreate) SetKind(v int) *FeedSubscriptionCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetSourceURL sets the "source_url" field.
+func (_c *FeedSubscriptionCreate) SetSourceURL(v string) *FeedSubscriptionCreate {
+	_c.mutation.SetSourceURL(v)
+	return _c
+}
+
+// SetDownloadMedia sets the "download_media" field.
+func (_c *FeedSubscriptionCreate) SetDownloadMedia(v bool) *FeedSubscriptionCreate {
+	_c.mutation.SetDownloadMedia(v)
+	return _c
+}
+
+// SetNillableDownloadMedia sets the "download_media" field if the given value is not nil.
+func (_c *FeedSubscriptionCreate) SetNillableDownloadMedia(v *bool) *FeedSubscriptionCreate {
+	if v != nil {
+		_c.SetDownloadMedia(*v)
+	}
+	return _c
+}
+
+// SetAutoPublish sets the "auto_publish" field.
+func (_c *FeedSubscriptionCreate) SetAutoPublish(v bool) *FeedSubscriptionCreate {
+	_c.mutation.SetAutoPublish(v)
+	return _c
+}
+
+// SetNillableAutoPublish sets the "auto_publish" field if the given value is not nil.
+func (_c *FeedSubscriptionCreate) SetNillableAutoPublish(v *bool) *FeedSubscriptionCreate {
+	if v != nil {
+		_c.SetAutoPublish(*v)
+	}
+	return _c
+}
+
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (_c *FeedSubscriptionCreate) SetLastSyncedAt(v time.Time) *FeedSubscriptionCreate {
+	_c.mutation.SetLastSyncedAt(v)
+	return _c
+}
+
+// SetNillableLastSyncedAt sets the "last_synced_at" field if the given value is not nil.
+func (_c *FeedSubscriptionCreate) SetNillableLastSyncedAt(v *time.Time) *FeedSubscriptionCreate {
+	if v != nil {
+		_c.SetLastSyncedAt(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *FeedSubscriptionCreate) SetLastError(v string) *FeedSubscriptionCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *FeedSubscriptionCreate) SetNillableLastError(v *string) *FeedSubscriptionCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *FeedSubscriptionCreate) SetID(v uuid.UUID) *FeedSubscriptionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *FeedSubscriptionCreate) SetNillableID(v *uuid.UUID) *FeedSubscriptionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddItemIDs adds the "items" edge to the FeedItem entity by IDs.
+func (_c *FeedSubscriptionCreate) AddItemIDs(ids ...uuid.UUID) *FeedSubscriptionCreate {
+	_c.mutation.AddItemIDs(ids...)
+	return _c
+}
+
+// AddItems adds the "items" edges to the FeedItem entity.
+func (_c *FeedSubscriptionCreate) AddItems(v ...*FeedItem) *FeedSubscriptionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddItemIDs(ids...)
+}
+
+// Mutation returns the FeedSubscriptionMutation object of the builder.
+func (_c *FeedSubscriptionCreate) Mutation() *FeedSubscriptionMutation {
+	return _c.mutation
+}
+
+// Save creates the FeedSubscription in the database.
+func (_c *FeedSubscriptionCreate) Save(ctx context.Context) (*FeedSubscription, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FeedSubscriptionCreate) SaveX(ctx context.Context) *FeedSubscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FeedSubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FeedSubscriptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FeedSubscriptionCreate) defaults() error {
+	if _, ok := _c.mutation.DownloadMedia(); !ok {
+		v := feedsubscription.DefaultDownloadMedia
+		_c.mutation.SetDownloadMedia(v)
+	}
+	if _, ok := _c.mutation.AutoPublish(); !ok {
+		v := feedsubscription.DefaultAutoPublish
+		_c.mutation.SetAutoPublish(v)
+	}
+	if _, ok := _c.mutation.LastError(); !ok {
+		v := feedsubscription.DefaultLastError
+		_c.mutation.SetLastError(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if feedsubscription.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized feedsubscription.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := feedsubscription.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FeedSubscriptionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "FeedSubscription.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "FeedSubscription.updated_at"`)}
+	}
+	if _, ok := _c.mutation.SeriesID(); !ok {
+		return &ValidationError{Name: "series_id", err: errors.New(`generated: missing required field "FeedSubscription.series_id"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`generated: missing required field "FeedSubscription.kind"`)}
+	}
+	if _, ok := _c.mutation.SourceURL(); !ok {
+		return &ValidationError{Name: "source_url", err: errors.New(`generated: missing required field "FeedSubscription.source_url"`)}
+	}
+	if _, ok := _c.mutation.DownloadMedia(); !ok {
+		return &ValidationError{Name: "download_media", err: errors.New(`generated: missing required field "FeedSubscription.download_media"`)}
+	}
+	if _, ok := _c.mutation.AutoPublish(); !ok {
+		return &ValidationError{Name: "auto_publish", err: errors.New(`generated: missing required field "FeedSubscription.auto_publish"`)}
+	}
+	if _, ok := _c.mutation.LastError(); !ok {
+		return &ValidationError{Name: "last_error", err: errors.New(`generated: missing required field "FeedSubscription.last_error"`)}
+	}
+	return nil
+}
+
+func (_c *FeedSubscriptionCreate) sqlSave(ctx context.Context) (*FeedSubscription, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FeedSubscriptionCreate) createSpec() (*FeedSubscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FeedSubscription{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(feedsubscription.Table, sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(feedsubscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(feedsubscription.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.SeriesID(); ok {
+		_spec.SetField(feedsubscription.FieldSeriesID, field.TypeUUID, value)
+		_node.SeriesID = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(feedsubscription.FieldKind, field.TypeInt, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.SourceURL(); ok {
+		_spec.SetField(feedsubscription.FieldSourceURL, field.TypeString, value)
+		_node.SourceURL = value
+	}
+	if value, ok := _c.mutation.DownloadMedia(); ok {
+		_spec.SetField(feedsubscription.FieldDownloadMedia, field.TypeBool, value)
+		_node.DownloadMedia = value
+	}
+	if value, ok := _c.mutation.AutoPublish(); ok {
+		_spec.SetField(feedsubscription.FieldAutoPublish, field.TypeBool, value)
+		_node.AutoPublish = value
+	}
+	if value, ok := _c.mutation.LastSyncedAt(); ok {
+		_spec.SetField(feedsubscription.FieldLastSyncedAt, field.TypeTime, value)
+		_node.LastSyncedAt = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(feedsubscription.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if nodes := _c.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   feedsubscription.ItemsTable,
+			Columns: []string{feedsubscription.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FeedSubscriptionCreateBulk is the builder for creating many FeedSubscription entities in bulk.
+type FeedSubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*FeedSubscriptionCreate
+}
+
+// Save creates the FeedSubscription entities in the database.
+func (_c *FeedSubscriptionCreateBulk) Save(ctx context.Context) ([]*FeedSubscription, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FeedSubscription, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FeedSubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FeedSubscriptionCreateBulk) SaveX(ctx context.Context) []*FeedSubscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FeedSubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FeedSubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
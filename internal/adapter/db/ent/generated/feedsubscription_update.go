@@ -0,0 +1,528 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/feeditem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/feedsubscription"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// FeedSubscriptionUpdate is the builder for updating FeedSubscription entities.
+type FeedSubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FeedSubscriptionMutation
+}
+
+// Where appends a list predicates to the FeedSubscriptionUpdate builder.
+func (_u *FeedSubscriptionUpdate) Where(ps ...predicate.FeedSubscription) *FeedSubscriptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FeedSubscriptionUpdate) SetUpdatedAt(v time.Time) *FeedSubscriptionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *FeedSubscriptionUpdate) SetNillableUpdatedAt(v *time.Time) *FeedSubscriptionUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetDownloadMedia sets the "download_media" field.
+func (_u *FeedSubscriptionUpdate) SetDownloadMedia(v bool) *FeedSubscriptionUpdate {
+	_u.mutation.SetDownloadMedia(v)
+	return _u
+}
+
+// SetNillableDownloadMedia sets the "download_media" field if the given value is not nil.
+func (_u *FeedSubscriptionUpdate) SetNillableDownloadMedia(v *bool) *FeedSubscriptionUpdate {
+	if v != nil {
+		_u.SetDownloadMedia(*v)
+	}
+	return _u
+}
+
+// SetAutoPublish sets the "auto_publish" field.
+func (_u *FeedSubscriptionUpdate) SetAutoPublish(v bool) *FeedSubscriptionUpdate {
+	_u.mutation.SetAutoPublish(v)
+	return _u
+}
+
+// SetNillableAutoPublish sets the "auto_publish" field if the given value is not nil.
+func (_u *FeedSubscriptionUpdate) SetNillableAutoPublish(v *bool) *FeedSubscriptionUpdate {
+	if v != nil {
+		_u.SetAutoPublish(*v)
+	}
+	return _u
+}
+
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (_u *FeedSubscriptionUpdate) SetLastSyncedAt(v time.Time) *FeedSubscriptionUpdate {
+	_u.mutation.SetLastSyncedAt(v)
+	return _u
+}
+
+// SetNillableLastSyncedAt sets the "last_synced_at" field if the given value is not nil.
+func (_u *FeedSubscriptionUpdate) SetNillableLastSyncedAt(v *time.Time) *FeedSubscriptionUpdate {
+	if v != nil {
+		_u.SetLastSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncedAt clears the value of the "last_synced_at" field.
+func (_u *FeedSubscriptionUpdate) ClearLastSyncedAt() *FeedSubscriptionUpdate {
+	_u.mutation.ClearLastSyncedAt()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *FeedSubscriptionUpdate) SetLastError(v string) *FeedSubscriptionUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *FeedSubscriptionUpdate) SetNillableLastError(v *string) *FeedSubscriptionUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// AddItemIDs adds the "items" edge to the FeedItem entity by IDs.
+func (_u *FeedSubscriptionUpdate) AddItemIDs(ids ...uuid.UUID) *FeedSubscriptionUpdate {
+	_u.mutation.AddItemIDs(ids...)
+	return _u
+}
+
+// AddItems adds the "items" edges to the FeedItem entity.
+func (_u *FeedSubscriptionUpdate) AddItems(v ...*FeedItem) *FeedSubscriptionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemIDs(ids...)
+}
+
+// Mutation returns the FeedSubscriptionMutation object of the builder.
+func (_u *FeedSubscriptionUpdate) Mutation() *FeedSubscriptionMutation {
+	return _u.mutation
+}
+
+// ClearItems clears all "items" edges to the FeedItem entity.
+func (_u *FeedSubscriptionUpdate) ClearItems() *FeedSubscriptionUpdate {
+	_u.mutation.ClearItems()
+	return _u
+}
+
+// RemoveItemIDs removes the "items" edge to FeedItem entities by IDs.
+func (_u *FeedSubscriptionUpdate) RemoveItemIDs(ids ...uuid.UUID) *FeedSubscriptionUpdate {
+	_u.mutation.RemoveItemIDs(ids...)
+	return _u
+}
+
+// RemoveItems removes "items" edges to FeedItem entities.
+func (_u *FeedSubscriptionUpdate) RemoveItems(v ...*FeedItem) *FeedSubscriptionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FeedSubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FeedSubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FeedSubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FeedSubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *FeedSubscriptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(feedsubscription.Table, feedsubscription.Columns, sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(feedsubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DownloadMedia(); ok {
+		_spec.SetField(feedsubscription.FieldDownloadMedia, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AutoPublish(); ok {
+		_spec.SetField(feedsubscription.FieldAutoPublish, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastSyncedAt(); ok {
+		_spec.SetField(feedsubscription.FieldLastSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSyncedAtCleared() {
+		_spec.ClearField(feedsubscription.FieldLastSyncedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(feedsubscription.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   feedsubscription.ItemsTable,
+			Columns: []string{feedsubscription.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemsIDs(); len(nodes) > 0 && !_u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   feedsubscription.ItemsTable,
+			Columns: []string{feedsubscription.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   feedsubscription.ItemsTable,
+			Columns: []string{feedsubscription.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{feedsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FeedSubscriptionUpdateOne is the builder for updating a single FeedSubscription entity.
+type FeedSubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FeedSubscriptionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FeedSubscriptionUpdateOne) SetUpdatedAt(v time.Time) *FeedSubscriptionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *FeedSubscriptionUpdateOne) SetNillableUpdatedAt(v *time.Time) *FeedSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetDownloadMedia sets the "download_media" field.
+func (_u *FeedSubscriptionUpdateOne) SetDownloadMedia(v bool) *FeedSubscriptionUpdateOne {
+	_u.mutation.SetDownloadMedia(v)
+	return _u
+}
+
+// SetNillableDownloadMedia sets the "download_media" field if the given value is not nil.
+func (_u *FeedSubscriptionUpdateOne) SetNillableDownloadMedia(v *bool) *FeedSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetDownloadMedia(*v)
+	}
+	return _u
+}
+
+// SetAutoPublish sets the "auto_publish" field.
+func (_u *FeedSubscriptionUpdateOne) SetAutoPublish(v bool) *FeedSubscriptionUpdateOne {
+	_u.mutation.SetAutoPublish(v)
+	return _u
+}
+
+// SetNillableAutoPublish sets the "auto_publish" field if the given value is not nil.
+func (_u *FeedSubscriptionUpdateOne) SetNillableAutoPublish(v *bool) *FeedSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetAutoPublish(*v)
+	}
+	return _u
+}
+
+// SetLastSyncedAt sets the "last_synced_at" field.
+func (_u *FeedSubscriptionUpdateOne) SetLastSyncedAt(v time.Time) *FeedSubscriptionUpdateOne {
+	_u.mutation.SetLastSyncedAt(v)
+	return _u
+}
+
+// SetNillableLastSyncedAt sets the "last_synced_at" field if the given value is not nil.
+func (_u *FeedSubscriptionUpdateOne) SetNillableLastSyncedAt(v *time.Time) *FeedSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetLastSyncedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSyncedAt clears the value of the "last_synced_at" field.
+func (_u *FeedSubscriptionUpdateOne) ClearLastSyncedAt() *FeedSubscriptionUpdateOne {
+	_u.mutation.ClearLastSyncedAt()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *FeedSubscriptionUpdateOne) SetLastError(v string) *FeedSubscriptionUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *FeedSubscriptionUpdateOne) SetNillableLastError(v *string) *FeedSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// AddItemIDs adds the "items" edge to the FeedItem entity by IDs.
+func (_u *FeedSubscriptionUpdateOne) AddItemIDs(ids ...uuid.UUID) *FeedSubscriptionUpdateOne {
+	_u.mutation.AddItemIDs(ids...)
+	return _u
+}
+
+// AddItems adds the "items" edges to the FeedItem entity.
+func (_u *FeedSubscriptionUpdateOne) AddItems(v ...*FeedItem) *FeedSubscriptionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemIDs(ids...)
+}
+
+// Mutation returns the FeedSubscriptionMutation object of the builder.
+func (_u *FeedSubscriptionUpdateOne) Mutation() *FeedSubscriptionMutation {
+	return _u.mutation
+}
+
+// ClearItems clears all "items" edges to the FeedItem entity.
+func (_u *FeedSubscriptionUpdateOne) ClearItems() *FeedSubscriptionUpdateOne {
+	_u.mutation.ClearItems()
+	return _u
+}
+
+// RemoveItemIDs removes the "items" edge to FeedItem entities by IDs.
+func (_u *FeedSubscriptionUpdateOne) RemoveItemIDs(ids ...uuid.UUID) *FeedSubscriptionUpdateOne {
+	_u.mutation.RemoveItemIDs(ids...)
+	return _u
+}
+
+// RemoveItems removes "items" edges to FeedItem entities.
+func (_u *FeedSubscriptionUpdateOne) RemoveItems(v ...*FeedItem) *FeedSubscriptionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemIDs(ids...)
+}
+
+// Where appends a list predicates to the FeedSubscriptionUpdate builder.
+func (_u *FeedSubscriptionUpdateOne) Where(ps ...predicate.FeedSubscription) *FeedSubscriptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FeedSubscriptionUpdateOne) Select(field string, fields ...string) *FeedSubscriptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FeedSubscription entity.
+func (_u *FeedSubscriptionUpdateOne) Save(ctx context.Context) (*FeedSubscription, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FeedSubscriptionUpdateOne) SaveX(ctx context.Context) *FeedSubscription {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FeedSubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FeedSubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *FeedSubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *FeedSubscription, err error) {
+	_spec := sqlgraph.NewUpdateSpec(feedsubscription.Table, feedsubscription.Columns, sqlgraph.NewFieldSpec(feedsubscription.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "FeedSubscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, feedsubscription.FieldID)
+		for _, f := range fields {
+			if !feedsubscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != feedsubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(feedsubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DownloadMedia(); ok {
+		_spec.SetField(feedsubscription.FieldDownloadMedia, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AutoPublish(); ok {
+		_spec.SetField(feedsubscription.FieldAutoPublish, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastSyncedAt(); ok {
+		_spec.SetField(feedsubscription.FieldLastSyncedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSyncedAtCleared() {
+		_spec.ClearField(feedsubscription.FieldLastSyncedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(feedsubscription.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   feedsubscription.ItemsTable,
+			Columns: []string{feedsubscription.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemsIDs(); len(nodes) > 0 && !_u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   feedsubscription.ItemsTable,
+			Columns: []string{feedsubscription.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   feedsubscription.ItemsTable,
+			Columns: []string{feedsubscription.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(feeditem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &FeedSubscription{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{feedsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
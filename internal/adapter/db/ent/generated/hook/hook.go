@@ -9,6 +9,18 @@ import (
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated"
 )
 
+// The APIKeyFunc type is an adapter to allow the use of ordinary
+// function as APIKey mutator.
+type APIKeyFunc func(context.Context, *generated.APIKeyMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f APIKeyFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.APIKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.APIKeyMutation", m)
+}
+
 // The AssetFunc type is an adapter to allow the use of ordinary
 // function as Asset mutator.
 type AssetFunc func(context.Context, *generated.AssetMutation) (generated.Value, error)
@@ -21,6 +33,174 @@ func (f AssetFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.AssetMutation", m)
 }
 
+// The AssetUsageDayFunc type is an adapter to allow the use of ordinary
+// function as AssetUsageDay mutator.
+type AssetUsageDayFunc func(context.Context, *generated.AssetUsageDayMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AssetUsageDayFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.AssetUsageDayMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.AssetUsageDayMutation", m)
+}
+
+// The AssetVersionFunc type is an adapter to allow the use of ordinary
+// function as AssetVersion mutator.
+type AssetVersionFunc func(context.Context, *generated.AssetVersionMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AssetVersionFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.AssetVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.AssetVersionMutation", m)
+}
+
+// The AuditEntryFunc type is an adapter to allow the use of ordinary
+// function as AuditEntry mutator.
+type AuditEntryFunc func(context.Context, *generated.AuditEntryMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditEntryFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.AuditEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.AuditEntryMutation", m)
+}
+
+// The AvailabilitySlotFunc type is an adapter to allow the use of ordinary
+// function as AvailabilitySlot mutator.
+type AvailabilitySlotFunc func(context.Context, *generated.AvailabilitySlotMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AvailabilitySlotFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.AvailabilitySlotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.AvailabilitySlotMutation", m)
+}
+
+// The BookingFunc type is an adapter to allow the use of ordinary
+// function as Booking mutator.
+type BookingFunc func(context.Context, *generated.BookingMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BookingFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.BookingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.BookingMutation", m)
+}
+
+// The ClassroomFunc type is an adapter to allow the use of ordinary
+// function as Classroom mutator.
+type ClassroomFunc func(context.Context, *generated.ClassroomMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ClassroomFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.ClassroomMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.ClassroomMutation", m)
+}
+
+// The ClassroomAssignmentFunc type is an adapter to allow the use of ordinary
+// function as ClassroomAssignment mutator.
+type ClassroomAssignmentFunc func(context.Context, *generated.ClassroomAssignmentMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ClassroomAssignmentFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.ClassroomAssignmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.ClassroomAssignmentMutation", m)
+}
+
+// The ClassroomMemberFunc type is an adapter to allow the use of ordinary
+// function as ClassroomMember mutator.
+type ClassroomMemberFunc func(context.Context, *generated.ClassroomMemberMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ClassroomMemberFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.ClassroomMemberMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.ClassroomMemberMutation", m)
+}
+
+// The ContentEmbeddingFunc type is an adapter to allow the use of ordinary
+// function as ContentEmbedding mutator.
+type ContentEmbeddingFunc func(context.Context, *generated.ContentEmbeddingMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ContentEmbeddingFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.ContentEmbeddingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.ContentEmbeddingMutation", m)
+}
+
+// The ContentKeyFunc type is an adapter to allow the use of ordinary
+// function as ContentKey mutator.
+type ContentKeyFunc func(context.Context, *generated.ContentKeyMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ContentKeyFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.ContentKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.ContentKeyMutation", m)
+}
+
+// The ContentReassignmentFunc type is an adapter to allow the use of ordinary
+// function as ContentReassignment mutator.
+type ContentReassignmentFunc func(context.Context, *generated.ContentReassignmentMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ContentReassignmentFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.ContentReassignmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.ContentReassignmentMutation", m)
+}
+
+// The DeviceTokenFunc type is an adapter to allow the use of ordinary
+// function as DeviceToken mutator.
+type DeviceTokenFunc func(context.Context, *generated.DeviceTokenMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeviceTokenFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.DeviceTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.DeviceTokenMutation", m)
+}
+
+// The DictationAttemptFunc type is an adapter to allow the use of ordinary
+// function as DictationAttempt mutator.
+type DictationAttemptFunc func(context.Context, *generated.DictationAttemptMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DictationAttemptFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.DictationAttemptMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.DictationAttemptMutation", m)
+}
+
+// The EngagementRollupFunc type is an adapter to allow the use of ordinary
+// function as EngagementRollup mutator.
+type EngagementRollupFunc func(context.Context, *generated.EngagementRollupMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EngagementRollupFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.EngagementRollupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.EngagementRollupMutation", m)
+}
+
 // The EpisodeFunc type is an adapter to allow the use of ordinary
 // function as Episode mutator.
 type EpisodeFunc func(context.Context, *generated.EpisodeMutation) (generated.Value, error)
@@ -33,6 +213,246 @@ func (f EpisodeFunc) Mutate(ctx context.Context, m generated.Mutation) (generate
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.EpisodeMutation", m)
 }
 
+// The EpisodeTranscriptFunc type is an adapter to allow the use of ordinary
+// function as EpisodeTranscript mutator.
+type EpisodeTranscriptFunc func(context.Context, *generated.EpisodeTranscriptMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EpisodeTranscriptFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.EpisodeTranscriptMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.EpisodeTranscriptMutation", m)
+}
+
+// The EventFunc type is an adapter to allow the use of ordinary
+// function as Event mutator.
+type EventFunc func(context.Context, *generated.EventMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EventFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.EventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.EventMutation", m)
+}
+
+// The FeedItemFunc type is an adapter to allow the use of ordinary
+// function as FeedItem mutator.
+type FeedItemFunc func(context.Context, *generated.FeedItemMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FeedItemFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.FeedItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.FeedItemMutation", m)
+}
+
+// The FeedSubscriptionFunc type is an adapter to allow the use of ordinary
+// function as FeedSubscription mutator.
+type FeedSubscriptionFunc func(context.Context, *generated.FeedSubscriptionMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FeedSubscriptionFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.FeedSubscriptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.FeedSubscriptionMutation", m)
+}
+
+// The InvoiceFunc type is an adapter to allow the use of ordinary
+// function as Invoice mutator.
+type InvoiceFunc func(context.Context, *generated.InvoiceMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoiceFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.InvoiceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.InvoiceMutation", m)
+}
+
+// The JobFunc type is an adapter to allow the use of ordinary
+// function as Job mutator.
+type JobFunc func(context.Context, *generated.JobMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JobFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.JobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.JobMutation", m)
+}
+
+// The LTILaunchFunc type is an adapter to allow the use of ordinary
+// function as LTILaunch mutator.
+type LTILaunchFunc func(context.Context, *generated.LTILaunchMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LTILaunchFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.LTILaunchMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.LTILaunchMutation", m)
+}
+
+// The LTILoginStateFunc type is an adapter to allow the use of ordinary
+// function as LTILoginState mutator.
+type LTILoginStateFunc func(context.Context, *generated.LTILoginStateMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LTILoginStateFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.LTILoginStateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.LTILoginStateMutation", m)
+}
+
+// The LTIPlatformFunc type is an adapter to allow the use of ordinary
+// function as LTIPlatform mutator.
+type LTIPlatformFunc func(context.Context, *generated.LTIPlatformMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LTIPlatformFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.LTIPlatformMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.LTIPlatformMutation", m)
+}
+
+// The LearnerActivityFunc type is an adapter to allow the use of ordinary
+// function as LearnerActivity mutator.
+type LearnerActivityFunc func(context.Context, *generated.LearnerActivityMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LearnerActivityFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.LearnerActivityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.LearnerActivityMutation", m)
+}
+
+// The ModerationItemFunc type is an adapter to allow the use of ordinary
+// function as ModerationItem mutator.
+type ModerationItemFunc func(context.Context, *generated.ModerationItemMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ModerationItemFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.ModerationItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.ModerationItemMutation", m)
+}
+
+// The NotificationFunc type is an adapter to allow the use of ordinary
+// function as Notification mutator.
+type NotificationFunc func(context.Context, *generated.NotificationMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.NotificationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.NotificationMutation", m)
+}
+
+// The NotificationPreferenceFunc type is an adapter to allow the use of ordinary
+// function as NotificationPreference mutator.
+type NotificationPreferenceFunc func(context.Context, *generated.NotificationPreferenceMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationPreferenceFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.NotificationPreferenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.NotificationPreferenceMutation", m)
+}
+
+// The OutboxMessageFunc type is an adapter to allow the use of ordinary
+// function as OutboxMessage mutator.
+type OutboxMessageFunc func(context.Context, *generated.OutboxMessageMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OutboxMessageFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.OutboxMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.OutboxMessageMutation", m)
+}
+
+// The PlanFunc type is an adapter to allow the use of ordinary
+// function as Plan mutator.
+type PlanFunc func(context.Context, *generated.PlanMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PlanFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.PlanMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.PlanMutation", m)
+}
+
+// The PlaybackSessionFunc type is an adapter to allow the use of ordinary
+// function as PlaybackSession mutator.
+type PlaybackSessionFunc func(context.Context, *generated.PlaybackSessionMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PlaybackSessionFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.PlaybackSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.PlaybackSessionMutation", m)
+}
+
+// The PlaylistFunc type is an adapter to allow the use of ordinary
+// function as Playlist mutator.
+type PlaylistFunc func(context.Context, *generated.PlaylistMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PlaylistFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.PlaylistMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.PlaylistMutation", m)
+}
+
+// The PlaylistItemFunc type is an adapter to allow the use of ordinary
+// function as PlaylistItem mutator.
+type PlaylistItemFunc func(context.Context, *generated.PlaylistItemMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PlaylistItemFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.PlaylistItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.PlaylistItemMutation", m)
+}
+
+// The QuizItemFunc type is an adapter to allow the use of ordinary
+// function as QuizItem mutator.
+type QuizItemFunc func(context.Context, *generated.QuizItemMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QuizItemFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.QuizItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.QuizItemMutation", m)
+}
+
+// The ScheduledTaskFunc type is an adapter to allow the use of ordinary
+// function as ScheduledTask mutator.
+type ScheduledTaskFunc func(context.Context, *generated.ScheduledTaskMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScheduledTaskFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.ScheduledTaskMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.ScheduledTaskMutation", m)
+}
+
 // The SeriesFunc type is an adapter to allow the use of ordinary
 // function as Series mutator.
 type SeriesFunc func(context.Context, *generated.SeriesMutation) (generated.Value, error)
@@ -45,6 +465,54 @@ func (f SeriesFunc) Mutate(ctx context.Context, m generated.Mutation) (generated
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.SeriesMutation", m)
 }
 
+// The ShadowingSubmissionFunc type is an adapter to allow the use of ordinary
+// function as ShadowingSubmission mutator.
+type ShadowingSubmissionFunc func(context.Context, *generated.ShadowingSubmissionMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShadowingSubmissionFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.ShadowingSubmissionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.ShadowingSubmissionMutation", m)
+}
+
+// The SubscriptionFunc type is an adapter to allow the use of ordinary
+// function as Subscription mutator.
+type SubscriptionFunc func(context.Context, *generated.SubscriptionMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SubscriptionFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.SubscriptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.SubscriptionMutation", m)
+}
+
+// The TranscriptReplaceJobFunc type is an adapter to allow the use of ordinary
+// function as TranscriptReplaceJob mutator.
+type TranscriptReplaceJobFunc func(context.Context, *generated.TranscriptReplaceJobMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TranscriptReplaceJobFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.TranscriptReplaceJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.TranscriptReplaceJobMutation", m)
+}
+
+// The TranscriptRevisionFunc type is an adapter to allow the use of ordinary
+// function as TranscriptRevision mutator.
+type TranscriptRevisionFunc func(context.Context, *generated.TranscriptRevisionMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TranscriptRevisionFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.TranscriptRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.TranscriptRevisionMutation", m)
+}
+
 // The UploadSessionFunc type is an adapter to allow the use of ordinary
 // function as UploadSession mutator.
 type UploadSessionFunc func(context.Context, *generated.UploadSessionMutation) (generated.Value, error)
@@ -57,6 +525,78 @@ func (f UploadSessionFunc) Mutate(ctx context.Context, m generated.Mutation) (ge
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.UploadSessionMutation", m)
 }
 
+// The UsageRecordFunc type is an adapter to allow the use of ordinary
+// function as UsageRecord mutator.
+type UsageRecordFunc func(context.Context, *generated.UsageRecordMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UsageRecordFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.UsageRecordMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.UsageRecordMutation", m)
+}
+
+// The UsageSnapshotFunc type is an adapter to allow the use of ordinary
+// function as UsageSnapshot mutator.
+type UsageSnapshotFunc func(context.Context, *generated.UsageSnapshotMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UsageSnapshotFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.UsageSnapshotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.UsageSnapshotMutation", m)
+}
+
+// The VocabularyWordFunc type is an adapter to allow the use of ordinary
+// function as VocabularyWord mutator.
+type VocabularyWordFunc func(context.Context, *generated.VocabularyWordMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VocabularyWordFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.VocabularyWordMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.VocabularyWordMutation", m)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *generated.WebhookDeliveryMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.WebhookDeliveryMutation", m)
+}
+
+// The WebhookEndpointFunc type is an adapter to allow the use of ordinary
+// function as WebhookEndpoint mutator.
+type WebhookEndpointFunc func(context.Context, *generated.WebhookEndpointMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookEndpointFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.WebhookEndpointMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.WebhookEndpointMutation", m)
+}
+
+// The WebhookEventFunc type is an adapter to allow the use of ordinary
+// function as WebhookEvent mutator.
+type WebhookEventFunc func(context.Context, *generated.WebhookEventMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookEventFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.WebhookEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.WebhookEventMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, generated.Mutation) bool
 
@@ -0,0 +1,1589 @@
+// Code generated by ent, DO NOT EDIT.
+
+package intercept
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/apikey"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/asset"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/assetusageday"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/assetversion"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/auditentry"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/availabilityslot"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/booking"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroom"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroomassignment"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroommember"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentembedding"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentkey"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentreassignment"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/devicetoken"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/dictationattempt"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/engagementrollup"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episode"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episodetranscript"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/event"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/feeditem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/feedsubscription"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/invoice"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/job"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/learneractivity"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltilaunch"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltiloginstate"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltiplatform"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/moderationitem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/notification"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/notificationpreference"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/outboxmessage"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/plan"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playbacksession"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playlist"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playlistitem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/quizitem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/scheduledtask"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/series"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/shadowingsubmission"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/subscription"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/transcriptreplacejob"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/transcriptrevision"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/uploadsession"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/usagerecord"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/usagesnapshot"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/vocabularyword"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/webhookdelivery"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/webhookendpoint"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/webhookevent"
+)
+
+// The Query interface represents an operation that queries a graph.
+// By using this interface, users can write generic code that manipulates
+// query builders of different types.
+type Query interface {
+	// Type returns the string representation of the query type.
+	Type() string
+	// Limit the number of records to be returned by this query.
+	Limit(int)
+	// Offset to start from.
+	Offset(int)
+	// Unique configures the query builder to filter duplicate records.
+	Unique(bool)
+	// Order specifies how the records should be ordered.
+	Order(...func(*sql.Selector))
+	// WhereP appends storage-level predicates to the query builder. Using this method, users
+	// can use type-assertion to append predicates that do not depend on any generated package.
+	WhereP(...func(*sql.Selector))
+}
+
+// The Func type is an adapter that allows ordinary functions to be used as interceptors.
+// Unlike traversal functions, interceptors are skipped during graph traversals. Note that the
+// implementation of Func is different from the one defined in entgo.io/ent.InterceptFunc.
+type Func func(context.Context, Query) error
+
+// Intercept calls f(ctx, q) and then applied the next Querier.
+func (f Func) Intercept(next generated.Querier) generated.Querier {
+	return generated.QuerierFunc(func(ctx context.Context, q generated.Query) (generated.Value, error) {
+		query, err := NewQuery(q)
+		if err != nil {
+			return nil, err
+		}
+		if err := f(ctx, query); err != nil {
+			return nil, err
+		}
+		return next.Query(ctx, q)
+	})
+}
+
+// The TraverseFunc type is an adapter to allow the use of ordinary function as Traverser.
+// If f is a function with the appropriate signature, TraverseFunc(f) is a Traverser that calls f.
+type TraverseFunc func(context.Context, Query) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFunc) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFunc) Traverse(ctx context.Context, q generated.Query) error {
+	query, err := NewQuery(q)
+	if err != nil {
+		return err
+	}
+	return f(ctx, query)
+}
+
+// The APIKeyFunc type is an adapter to allow the use of ordinary function as a Querier.
+type APIKeyFunc func(context.Context, *generated.APIKeyQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f APIKeyFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.APIKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.APIKeyQuery", q)
+}
+
+// The TraverseAPIKey type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAPIKey func(context.Context, *generated.APIKeyQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAPIKey) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAPIKey) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.APIKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.APIKeyQuery", q)
+}
+
+// The AssetFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AssetFunc func(context.Context, *generated.AssetQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f AssetFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.AssetQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.AssetQuery", q)
+}
+
+// The TraverseAsset type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAsset func(context.Context, *generated.AssetQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAsset) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAsset) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.AssetQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.AssetQuery", q)
+}
+
+// The AssetUsageDayFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AssetUsageDayFunc func(context.Context, *generated.AssetUsageDayQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f AssetUsageDayFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.AssetUsageDayQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.AssetUsageDayQuery", q)
+}
+
+// The TraverseAssetUsageDay type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAssetUsageDay func(context.Context, *generated.AssetUsageDayQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAssetUsageDay) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAssetUsageDay) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.AssetUsageDayQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.AssetUsageDayQuery", q)
+}
+
+// The AssetVersionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AssetVersionFunc func(context.Context, *generated.AssetVersionQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f AssetVersionFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.AssetVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.AssetVersionQuery", q)
+}
+
+// The TraverseAssetVersion type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAssetVersion func(context.Context, *generated.AssetVersionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAssetVersion) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAssetVersion) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.AssetVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.AssetVersionQuery", q)
+}
+
+// The AuditEntryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AuditEntryFunc func(context.Context, *generated.AuditEntryQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f AuditEntryFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.AuditEntryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.AuditEntryQuery", q)
+}
+
+// The TraverseAuditEntry type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAuditEntry func(context.Context, *generated.AuditEntryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAuditEntry) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAuditEntry) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.AuditEntryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.AuditEntryQuery", q)
+}
+
+// The AvailabilitySlotFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AvailabilitySlotFunc func(context.Context, *generated.AvailabilitySlotQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f AvailabilitySlotFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.AvailabilitySlotQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.AvailabilitySlotQuery", q)
+}
+
+// The TraverseAvailabilitySlot type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAvailabilitySlot func(context.Context, *generated.AvailabilitySlotQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAvailabilitySlot) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAvailabilitySlot) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.AvailabilitySlotQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.AvailabilitySlotQuery", q)
+}
+
+// The BookingFunc type is an adapter to allow the use of ordinary function as a Querier.
+type BookingFunc func(context.Context, *generated.BookingQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f BookingFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.BookingQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.BookingQuery", q)
+}
+
+// The TraverseBooking type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseBooking func(context.Context, *generated.BookingQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseBooking) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseBooking) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.BookingQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.BookingQuery", q)
+}
+
+// The ClassroomFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ClassroomFunc func(context.Context, *generated.ClassroomQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f ClassroomFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.ClassroomQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.ClassroomQuery", q)
+}
+
+// The TraverseClassroom type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseClassroom func(context.Context, *generated.ClassroomQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseClassroom) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseClassroom) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.ClassroomQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.ClassroomQuery", q)
+}
+
+// The ClassroomAssignmentFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ClassroomAssignmentFunc func(context.Context, *generated.ClassroomAssignmentQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f ClassroomAssignmentFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.ClassroomAssignmentQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.ClassroomAssignmentQuery", q)
+}
+
+// The TraverseClassroomAssignment type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseClassroomAssignment func(context.Context, *generated.ClassroomAssignmentQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseClassroomAssignment) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseClassroomAssignment) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.ClassroomAssignmentQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.ClassroomAssignmentQuery", q)
+}
+
+// The ClassroomMemberFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ClassroomMemberFunc func(context.Context, *generated.ClassroomMemberQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f ClassroomMemberFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.ClassroomMemberQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.ClassroomMemberQuery", q)
+}
+
+// The TraverseClassroomMember type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseClassroomMember func(context.Context, *generated.ClassroomMemberQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseClassroomMember) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseClassroomMember) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.ClassroomMemberQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.ClassroomMemberQuery", q)
+}
+
+// The ContentEmbeddingFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ContentEmbeddingFunc func(context.Context, *generated.ContentEmbeddingQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f ContentEmbeddingFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.ContentEmbeddingQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.ContentEmbeddingQuery", q)
+}
+
+// The TraverseContentEmbedding type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseContentEmbedding func(context.Context, *generated.ContentEmbeddingQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseContentEmbedding) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseContentEmbedding) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.ContentEmbeddingQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.ContentEmbeddingQuery", q)
+}
+
+// The ContentKeyFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ContentKeyFunc func(context.Context, *generated.ContentKeyQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f ContentKeyFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.ContentKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.ContentKeyQuery", q)
+}
+
+// The TraverseContentKey type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseContentKey func(context.Context, *generated.ContentKeyQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseContentKey) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseContentKey) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.ContentKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.ContentKeyQuery", q)
+}
+
+// The ContentReassignmentFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ContentReassignmentFunc func(context.Context, *generated.ContentReassignmentQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f ContentReassignmentFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.ContentReassignmentQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.ContentReassignmentQuery", q)
+}
+
+// The TraverseContentReassignment type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseContentReassignment func(context.Context, *generated.ContentReassignmentQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseContentReassignment) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseContentReassignment) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.ContentReassignmentQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.ContentReassignmentQuery", q)
+}
+
+// The DeviceTokenFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DeviceTokenFunc func(context.Context, *generated.DeviceTokenQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f DeviceTokenFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.DeviceTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.DeviceTokenQuery", q)
+}
+
+// The TraverseDeviceToken type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDeviceToken func(context.Context, *generated.DeviceTokenQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDeviceToken) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDeviceToken) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.DeviceTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.DeviceTokenQuery", q)
+}
+
+// The DictationAttemptFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DictationAttemptFunc func(context.Context, *generated.DictationAttemptQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f DictationAttemptFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.DictationAttemptQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.DictationAttemptQuery", q)
+}
+
+// The TraverseDictationAttempt type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDictationAttempt func(context.Context, *generated.DictationAttemptQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDictationAttempt) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDictationAttempt) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.DictationAttemptQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.DictationAttemptQuery", q)
+}
+
+// The EngagementRollupFunc type is an adapter to allow the use of ordinary function as a Querier.
+type EngagementRollupFunc func(context.Context, *generated.EngagementRollupQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f EngagementRollupFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.EngagementRollupQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.EngagementRollupQuery", q)
+}
+
+// The TraverseEngagementRollup type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseEngagementRollup func(context.Context, *generated.EngagementRollupQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseEngagementRollup) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseEngagementRollup) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.EngagementRollupQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.EngagementRollupQuery", q)
+}
+
+// The EpisodeFunc type is an adapter to allow the use of ordinary function as a Querier.
+type EpisodeFunc func(context.Context, *generated.EpisodeQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f EpisodeFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.EpisodeQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.EpisodeQuery", q)
+}
+
+// The TraverseEpisode type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseEpisode func(context.Context, *generated.EpisodeQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseEpisode) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseEpisode) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.EpisodeQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.EpisodeQuery", q)
+}
+
+// The EpisodeTranscriptFunc type is an adapter to allow the use of ordinary function as a Querier.
+type EpisodeTranscriptFunc func(context.Context, *generated.EpisodeTranscriptQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f EpisodeTranscriptFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.EpisodeTranscriptQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.EpisodeTranscriptQuery", q)
+}
+
+// The TraverseEpisodeTranscript type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseEpisodeTranscript func(context.Context, *generated.EpisodeTranscriptQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseEpisodeTranscript) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseEpisodeTranscript) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.EpisodeTranscriptQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.EpisodeTranscriptQuery", q)
+}
+
+// The EventFunc type is an adapter to allow the use of ordinary function as a Querier.
+type EventFunc func(context.Context, *generated.EventQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f EventFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.EventQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.EventQuery", q)
+}
+
+// The TraverseEvent type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseEvent func(context.Context, *generated.EventQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseEvent) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseEvent) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.EventQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.EventQuery", q)
+}
+
+// The FeedItemFunc type is an adapter to allow the use of ordinary function as a Querier.
+type FeedItemFunc func(context.Context, *generated.FeedItemQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f FeedItemFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.FeedItemQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.FeedItemQuery", q)
+}
+
+// The TraverseFeedItem type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseFeedItem func(context.Context, *generated.FeedItemQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFeedItem) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFeedItem) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.FeedItemQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.FeedItemQuery", q)
+}
+
+// The FeedSubscriptionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type FeedSubscriptionFunc func(context.Context, *generated.FeedSubscriptionQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f FeedSubscriptionFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.FeedSubscriptionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.FeedSubscriptionQuery", q)
+}
+
+// The TraverseFeedSubscription type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseFeedSubscription func(context.Context, *generated.FeedSubscriptionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFeedSubscription) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFeedSubscription) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.FeedSubscriptionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.FeedSubscriptionQuery", q)
+}
+
+// The InvoiceFunc type is an adapter to allow the use of ordinary function as a Querier.
+type InvoiceFunc func(context.Context, *generated.InvoiceQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f InvoiceFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.InvoiceQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.InvoiceQuery", q)
+}
+
+// The TraverseInvoice type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseInvoice func(context.Context, *generated.InvoiceQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseInvoice) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseInvoice) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.InvoiceQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.InvoiceQuery", q)
+}
+
+// The JobFunc type is an adapter to allow the use of ordinary function as a Querier.
+type JobFunc func(context.Context, *generated.JobQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f JobFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.JobQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.JobQuery", q)
+}
+
+// The TraverseJob type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseJob func(context.Context, *generated.JobQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseJob) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseJob) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.JobQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.JobQuery", q)
+}
+
+// The LTILaunchFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LTILaunchFunc func(context.Context, *generated.LTILaunchQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f LTILaunchFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.LTILaunchQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.LTILaunchQuery", q)
+}
+
+// The TraverseLTILaunch type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLTILaunch func(context.Context, *generated.LTILaunchQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLTILaunch) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLTILaunch) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.LTILaunchQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.LTILaunchQuery", q)
+}
+
+// The LTILoginStateFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LTILoginStateFunc func(context.Context, *generated.LTILoginStateQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f LTILoginStateFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.LTILoginStateQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.LTILoginStateQuery", q)
+}
+
+// The TraverseLTILoginState type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLTILoginState func(context.Context, *generated.LTILoginStateQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLTILoginState) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLTILoginState) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.LTILoginStateQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.LTILoginStateQuery", q)
+}
+
+// The LTIPlatformFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LTIPlatformFunc func(context.Context, *generated.LTIPlatformQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f LTIPlatformFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.LTIPlatformQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.LTIPlatformQuery", q)
+}
+
+// The TraverseLTIPlatform type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLTIPlatform func(context.Context, *generated.LTIPlatformQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLTIPlatform) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLTIPlatform) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.LTIPlatformQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.LTIPlatformQuery", q)
+}
+
+// The LearnerActivityFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LearnerActivityFunc func(context.Context, *generated.LearnerActivityQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f LearnerActivityFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.LearnerActivityQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.LearnerActivityQuery", q)
+}
+
+// The TraverseLearnerActivity type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLearnerActivity func(context.Context, *generated.LearnerActivityQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLearnerActivity) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLearnerActivity) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.LearnerActivityQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.LearnerActivityQuery", q)
+}
+
+// The ModerationItemFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ModerationItemFunc func(context.Context, *generated.ModerationItemQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f ModerationItemFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.ModerationItemQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.ModerationItemQuery", q)
+}
+
+// The TraverseModerationItem type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseModerationItem func(context.Context, *generated.ModerationItemQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseModerationItem) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseModerationItem) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.ModerationItemQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.ModerationItemQuery", q)
+}
+
+// The NotificationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type NotificationFunc func(context.Context, *generated.NotificationQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f NotificationFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.NotificationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.NotificationQuery", q)
+}
+
+// The TraverseNotification type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseNotification func(context.Context, *generated.NotificationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseNotification) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseNotification) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.NotificationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.NotificationQuery", q)
+}
+
+// The NotificationPreferenceFunc type is an adapter to allow the use of ordinary function as a Querier.
+type NotificationPreferenceFunc func(context.Context, *generated.NotificationPreferenceQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f NotificationPreferenceFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.NotificationPreferenceQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.NotificationPreferenceQuery", q)
+}
+
+// The TraverseNotificationPreference type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseNotificationPreference func(context.Context, *generated.NotificationPreferenceQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseNotificationPreference) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseNotificationPreference) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.NotificationPreferenceQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.NotificationPreferenceQuery", q)
+}
+
+// The OutboxMessageFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OutboxMessageFunc func(context.Context, *generated.OutboxMessageQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f OutboxMessageFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.OutboxMessageQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.OutboxMessageQuery", q)
+}
+
+// The TraverseOutboxMessage type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOutboxMessage func(context.Context, *generated.OutboxMessageQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOutboxMessage) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOutboxMessage) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.OutboxMessageQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.OutboxMessageQuery", q)
+}
+
+// The PlanFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PlanFunc func(context.Context, *generated.PlanQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f PlanFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.PlanQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.PlanQuery", q)
+}
+
+// The TraversePlan type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePlan func(context.Context, *generated.PlanQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePlan) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePlan) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.PlanQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.PlanQuery", q)
+}
+
+// The PlaybackSessionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PlaybackSessionFunc func(context.Context, *generated.PlaybackSessionQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f PlaybackSessionFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.PlaybackSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.PlaybackSessionQuery", q)
+}
+
+// The TraversePlaybackSession type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePlaybackSession func(context.Context, *generated.PlaybackSessionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePlaybackSession) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePlaybackSession) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.PlaybackSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.PlaybackSessionQuery", q)
+}
+
+// The PlaylistFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PlaylistFunc func(context.Context, *generated.PlaylistQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f PlaylistFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.PlaylistQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.PlaylistQuery", q)
+}
+
+// The TraversePlaylist type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePlaylist func(context.Context, *generated.PlaylistQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePlaylist) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePlaylist) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.PlaylistQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.PlaylistQuery", q)
+}
+
+// The PlaylistItemFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PlaylistItemFunc func(context.Context, *generated.PlaylistItemQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f PlaylistItemFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.PlaylistItemQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.PlaylistItemQuery", q)
+}
+
+// The TraversePlaylistItem type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePlaylistItem func(context.Context, *generated.PlaylistItemQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePlaylistItem) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePlaylistItem) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.PlaylistItemQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.PlaylistItemQuery", q)
+}
+
+// The QuizItemFunc type is an adapter to allow the use of ordinary function as a Querier.
+type QuizItemFunc func(context.Context, *generated.QuizItemQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f QuizItemFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.QuizItemQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.QuizItemQuery", q)
+}
+
+// The TraverseQuizItem type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseQuizItem func(context.Context, *generated.QuizItemQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseQuizItem) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseQuizItem) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.QuizItemQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.QuizItemQuery", q)
+}
+
+// The ScheduledTaskFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ScheduledTaskFunc func(context.Context, *generated.ScheduledTaskQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f ScheduledTaskFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.ScheduledTaskQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.ScheduledTaskQuery", q)
+}
+
+// The TraverseScheduledTask type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseScheduledTask func(context.Context, *generated.ScheduledTaskQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseScheduledTask) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseScheduledTask) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.ScheduledTaskQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.ScheduledTaskQuery", q)
+}
+
+// The SeriesFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SeriesFunc func(context.Context, *generated.SeriesQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f SeriesFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.SeriesQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.SeriesQuery", q)
+}
+
+// The TraverseSeries type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSeries func(context.Context, *generated.SeriesQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSeries) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSeries) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.SeriesQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.SeriesQuery", q)
+}
+
+// The ShadowingSubmissionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ShadowingSubmissionFunc func(context.Context, *generated.ShadowingSubmissionQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f ShadowingSubmissionFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.ShadowingSubmissionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.ShadowingSubmissionQuery", q)
+}
+
+// The TraverseShadowingSubmission type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseShadowingSubmission func(context.Context, *generated.ShadowingSubmissionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseShadowingSubmission) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseShadowingSubmission) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.ShadowingSubmissionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.ShadowingSubmissionQuery", q)
+}
+
+// The SubscriptionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SubscriptionFunc func(context.Context, *generated.SubscriptionQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f SubscriptionFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.SubscriptionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.SubscriptionQuery", q)
+}
+
+// The TraverseSubscription type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSubscription func(context.Context, *generated.SubscriptionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSubscription) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSubscription) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.SubscriptionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.SubscriptionQuery", q)
+}
+
+// The TranscriptReplaceJobFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TranscriptReplaceJobFunc func(context.Context, *generated.TranscriptReplaceJobQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f TranscriptReplaceJobFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.TranscriptReplaceJobQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.TranscriptReplaceJobQuery", q)
+}
+
+// The TraverseTranscriptReplaceJob type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTranscriptReplaceJob func(context.Context, *generated.TranscriptReplaceJobQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTranscriptReplaceJob) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTranscriptReplaceJob) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.TranscriptReplaceJobQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.TranscriptReplaceJobQuery", q)
+}
+
+// The TranscriptRevisionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TranscriptRevisionFunc func(context.Context, *generated.TranscriptRevisionQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f TranscriptRevisionFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.TranscriptRevisionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.TranscriptRevisionQuery", q)
+}
+
+// The TraverseTranscriptRevision type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTranscriptRevision func(context.Context, *generated.TranscriptRevisionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTranscriptRevision) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTranscriptRevision) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.TranscriptRevisionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.TranscriptRevisionQuery", q)
+}
+
+// The UploadSessionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UploadSessionFunc func(context.Context, *generated.UploadSessionQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f UploadSessionFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.UploadSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.UploadSessionQuery", q)
+}
+
+// The TraverseUploadSession type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUploadSession func(context.Context, *generated.UploadSessionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUploadSession) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUploadSession) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.UploadSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.UploadSessionQuery", q)
+}
+
+// The UsageRecordFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UsageRecordFunc func(context.Context, *generated.UsageRecordQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f UsageRecordFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.UsageRecordQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.UsageRecordQuery", q)
+}
+
+// The TraverseUsageRecord type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUsageRecord func(context.Context, *generated.UsageRecordQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUsageRecord) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUsageRecord) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.UsageRecordQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.UsageRecordQuery", q)
+}
+
+// The UsageSnapshotFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UsageSnapshotFunc func(context.Context, *generated.UsageSnapshotQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f UsageSnapshotFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.UsageSnapshotQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.UsageSnapshotQuery", q)
+}
+
+// The TraverseUsageSnapshot type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUsageSnapshot func(context.Context, *generated.UsageSnapshotQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUsageSnapshot) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUsageSnapshot) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.UsageSnapshotQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.UsageSnapshotQuery", q)
+}
+
+// The VocabularyWordFunc type is an adapter to allow the use of ordinary function as a Querier.
+type VocabularyWordFunc func(context.Context, *generated.VocabularyWordQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f VocabularyWordFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.VocabularyWordQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.VocabularyWordQuery", q)
+}
+
+// The TraverseVocabularyWord type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseVocabularyWord func(context.Context, *generated.VocabularyWordQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseVocabularyWord) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseVocabularyWord) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.VocabularyWordQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.VocabularyWordQuery", q)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type WebhookDeliveryFunc func(context.Context, *generated.WebhookDeliveryQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f WebhookDeliveryFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.WebhookDeliveryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.WebhookDeliveryQuery", q)
+}
+
+// The TraverseWebhookDelivery type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseWebhookDelivery func(context.Context, *generated.WebhookDeliveryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseWebhookDelivery) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseWebhookDelivery) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.WebhookDeliveryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.WebhookDeliveryQuery", q)
+}
+
+// The WebhookEndpointFunc type is an adapter to allow the use of ordinary function as a Querier.
+type WebhookEndpointFunc func(context.Context, *generated.WebhookEndpointQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f WebhookEndpointFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.WebhookEndpointQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.WebhookEndpointQuery", q)
+}
+
+// The TraverseWebhookEndpoint type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseWebhookEndpoint func(context.Context, *generated.WebhookEndpointQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseWebhookEndpoint) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseWebhookEndpoint) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.WebhookEndpointQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.WebhookEndpointQuery", q)
+}
+
+// The WebhookEventFunc type is an adapter to allow the use of ordinary function as a Querier.
+type WebhookEventFunc func(context.Context, *generated.WebhookEventQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f WebhookEventFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.WebhookEventQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.WebhookEventQuery", q)
+}
+
+// The TraverseWebhookEvent type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseWebhookEvent func(context.Context, *generated.WebhookEventQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseWebhookEvent) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseWebhookEvent) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.WebhookEventQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.WebhookEventQuery", q)
+}
+
+// NewQuery returns the generic Query interface for the given typed query.
+func NewQuery(q generated.Query) (Query, error) {
+	switch q := q.(type) {
+	case *generated.APIKeyQuery:
+		return &query[*generated.APIKeyQuery, predicate.APIKey, apikey.OrderOption]{typ: generated.TypeAPIKey, tq: q}, nil
+	case *generated.AssetQuery:
+		return &query[*generated.AssetQuery, predicate.Asset, asset.OrderOption]{typ: generated.TypeAsset, tq: q}, nil
+	case *generated.AssetUsageDayQuery:
+		return &query[*generated.AssetUsageDayQuery, predicate.AssetUsageDay, assetusageday.OrderOption]{typ: generated.TypeAssetUsageDay, tq: q}, nil
+	case *generated.AssetVersionQuery:
+		return &query[*generated.AssetVersionQuery, predicate.AssetVersion, assetversion.OrderOption]{typ: generated.TypeAssetVersion, tq: q}, nil
+	case *generated.AuditEntryQuery:
+		return &query[*generated.AuditEntryQuery, predicate.AuditEntry, auditentry.OrderOption]{typ: generated.TypeAuditEntry, tq: q}, nil
+	case *generated.AvailabilitySlotQuery:
+		return &query[*generated.AvailabilitySlotQuery, predicate.AvailabilitySlot, availabilityslot.OrderOption]{typ: generated.TypeAvailabilitySlot, tq: q}, nil
+	case *generated.BookingQuery:
+		return &query[*generated.BookingQuery, predicate.Booking, booking.OrderOption]{typ: generated.TypeBooking, tq: q}, nil
+	case *generated.ClassroomQuery:
+		return &query[*generated.ClassroomQuery, predicate.Classroom, classroom.OrderOption]{typ: generated.TypeClassroom, tq: q}, nil
+	case *generated.ClassroomAssignmentQuery:
+		return &query[*generated.ClassroomAssignmentQuery, predicate.ClassroomAssignment, classroomassignment.OrderOption]{typ: generated.TypeClassroomAssignment, tq: q}, nil
+	case *generated.ClassroomMemberQuery:
+		return &query[*generated.ClassroomMemberQuery, predicate.ClassroomMember, classroommember.OrderOption]{typ: generated.TypeClassroomMember, tq: q}, nil
+	case *generated.ContentEmbeddingQuery:
+		return &query[*generated.ContentEmbeddingQuery, predicate.ContentEmbedding, contentembedding.OrderOption]{typ: generated.TypeContentEmbedding, tq: q}, nil
+	case *generated.ContentKeyQuery:
+		return &query[*generated.ContentKeyQuery, predicate.ContentKey, contentkey.OrderOption]{typ: generated.TypeContentKey, tq: q}, nil
+	case *generated.ContentReassignmentQuery:
+		return &query[*generated.ContentReassignmentQuery, predicate.ContentReassignment, contentreassignment.OrderOption]{typ: generated.TypeContentReassignment, tq: q}, nil
+	case *generated.DeviceTokenQuery:
+		return &query[*generated.DeviceTokenQuery, predicate.DeviceToken, devicetoken.OrderOption]{typ: generated.TypeDeviceToken, tq: q}, nil
+	case *generated.DictationAttemptQuery:
+		return &query[*generated.DictationAttemptQuery, predicate.DictationAttempt, dictationattempt.OrderOption]{typ: generated.TypeDictationAttempt, tq: q}, nil
+	case *generated.EngagementRollupQuery:
+		return &query[*generated.EngagementRollupQuery, predicate.EngagementRollup, engagementrollup.OrderOption]{typ: generated.TypeEngagementRollup, tq: q}, nil
+	case *generated.EpisodeQuery:
+		return &query[*generated.EpisodeQuery, predicate.Episode, episode.OrderOption]{typ: generated.TypeEpisode, tq: q}, nil
+	case *generated.EpisodeTranscriptQuery:
+		return &query[*generated.EpisodeTranscriptQuery, predicate.EpisodeTranscript, episodetranscript.OrderOption]{typ: generated.TypeEpisodeTranscript, tq: q}, nil
+	case *generated.EventQuery:
+		return &query[*generated.EventQuery, predicate.Event, event.OrderOption]{typ: generated.TypeEvent, tq: q}, nil
+	case *generated.FeedItemQuery:
+		return &query[*generated.FeedItemQuery, predicate.FeedItem, feeditem.OrderOption]{typ: generated.TypeFeedItem, tq: q}, nil
+	case *generated.FeedSubscriptionQuery:
+		return &query[*generated.FeedSubscriptionQuery, predicate.FeedSubscription, feedsubscription.OrderOption]{typ: generated.TypeFeedSubscription, tq: q}, nil
+	case *generated.InvoiceQuery:
+		return &query[*generated.InvoiceQuery, predicate.Invoice, invoice.OrderOption]{typ: generated.TypeInvoice, tq: q}, nil
+	case *generated.JobQuery:
+		return &query[*generated.JobQuery, predicate.Job, job.OrderOption]{typ: generated.TypeJob, tq: q}, nil
+	case *generated.LTILaunchQuery:
+		return &query[*generated.LTILaunchQuery, predicate.LTILaunch, ltilaunch.OrderOption]{typ: generated.TypeLTILaunch, tq: q}, nil
+	case *generated.LTILoginStateQuery:
+		return &query[*generated.LTILoginStateQuery, predicate.LTILoginState, ltiloginstate.OrderOption]{typ: generated.TypeLTILoginState, tq: q}, nil
+	case *generated.LTIPlatformQuery:
+		return &query[*generated.LTIPlatformQuery, predicate.LTIPlatform, ltiplatform.OrderOption]{typ: generated.TypeLTIPlatform, tq: q}, nil
+	case *generated.LearnerActivityQuery:
+		return &query[*generated.LearnerActivityQuery, predicate.LearnerActivity, learneractivity.OrderOption]{typ: generated.TypeLearnerActivity, tq: q}, nil
+	case *generated.ModerationItemQuery:
+		return &query[*generated.ModerationItemQuery, predicate.ModerationItem, moderationitem.OrderOption]{typ: generated.TypeModerationItem, tq: q}, nil
+	case *generated.NotificationQuery:
+		return &query[*generated.NotificationQuery, predicate.Notification, notification.OrderOption]{typ: generated.TypeNotification, tq: q}, nil
+	case *generated.NotificationPreferenceQuery:
+		return &query[*generated.NotificationPreferenceQuery, predicate.NotificationPreference, notificationpreference.OrderOption]{typ: generated.TypeNotificationPreference, tq: q}, nil
+	case *generated.OutboxMessageQuery:
+		return &query[*generated.OutboxMessageQuery, predicate.OutboxMessage, outboxmessage.OrderOption]{typ: generated.TypeOutboxMessage, tq: q}, nil
+	case *generated.PlanQuery:
+		return &query[*generated.PlanQuery, predicate.Plan, plan.OrderOption]{typ: generated.TypePlan, tq: q}, nil
+	case *generated.PlaybackSessionQuery:
+		return &query[*generated.PlaybackSessionQuery, predicate.PlaybackSession, playbacksession.OrderOption]{typ: generated.TypePlaybackSession, tq: q}, nil
+	case *generated.PlaylistQuery:
+		return &query[*generated.PlaylistQuery, predicate.Playlist, playlist.OrderOption]{typ: generated.TypePlaylist, tq: q}, nil
+	case *generated.PlaylistItemQuery:
+		return &query[*generated.PlaylistItemQuery, predicate.PlaylistItem, playlistitem.OrderOption]{typ: generated.TypePlaylistItem, tq: q}, nil
+	case *generated.QuizItemQuery:
+		return &query[*generated.QuizItemQuery, predicate.QuizItem, quizitem.OrderOption]{typ: generated.TypeQuizItem, tq: q}, nil
+	case *generated.ScheduledTaskQuery:
+		return &query[*generated.ScheduledTaskQuery, predicate.ScheduledTask, scheduledtask.OrderOption]{typ: generated.TypeScheduledTask, tq: q}, nil
+	case *generated.SeriesQuery:
+		return &query[*generated.SeriesQuery, predicate.Series, series.OrderOption]{typ: generated.TypeSeries, tq: q}, nil
+	case *generated.ShadowingSubmissionQuery:
+		return &query[*generated.ShadowingSubmissionQuery, predicate.ShadowingSubmission, shadowingsubmission.OrderOption]{typ: generated.TypeShadowingSubmission, tq: q}, nil
+	case *generated.SubscriptionQuery:
+		return &query[*generated.SubscriptionQuery, predicate.Subscription, subscription.OrderOption]{typ: generated.TypeSubscription, tq: q}, nil
+	case *generated.TranscriptReplaceJobQuery:
+		return &query[*generated.TranscriptReplaceJobQuery, predicate.TranscriptReplaceJob, transcriptreplacejob.OrderOption]{typ: generated.TypeTranscriptReplaceJob, tq: q}, nil
+	case *generated.TranscriptRevisionQuery:
+		return &query[*generated.TranscriptRevisionQuery, predicate.TranscriptRevision, transcriptrevision.OrderOption]{typ: generated.TypeTranscriptRevision, tq: q}, nil
+	case *generated.UploadSessionQuery:
+		return &query[*generated.UploadSessionQuery, predicate.UploadSession, uploadsession.OrderOption]{typ: generated.TypeUploadSession, tq: q}, nil
+	case *generated.UsageRecordQuery:
+		return &query[*generated.UsageRecordQuery, predicate.UsageRecord, usagerecord.OrderOption]{typ: generated.TypeUsageRecord, tq: q}, nil
+	case *generated.UsageSnapshotQuery:
+		return &query[*generated.UsageSnapshotQuery, predicate.UsageSnapshot, usagesnapshot.OrderOption]{typ: generated.TypeUsageSnapshot, tq: q}, nil
+	case *generated.VocabularyWordQuery:
+		return &query[*generated.VocabularyWordQuery, predicate.VocabularyWord, vocabularyword.OrderOption]{typ: generated.TypeVocabularyWord, tq: q}, nil
+	case *generated.WebhookDeliveryQuery:
+		return &query[*generated.WebhookDeliveryQuery, predicate.WebhookDelivery, webhookdelivery.OrderOption]{typ: generated.TypeWebhookDelivery, tq: q}, nil
+	case *generated.WebhookEndpointQuery:
+		return &query[*generated.WebhookEndpointQuery, predicate.WebhookEndpoint, webhookendpoint.OrderOption]{typ: generated.TypeWebhookEndpoint, tq: q}, nil
+	case *generated.WebhookEventQuery:
+		return &query[*generated.WebhookEventQuery, predicate.WebhookEvent, webhookevent.OrderOption]{typ: generated.TypeWebhookEvent, tq: q}, nil
+	default:
+		return nil, fmt.Errorf("unknown query type %T", q)
+	}
+}
+
+type query[T any, P ~func(*sql.Selector), R ~func(*sql.Selector)] struct {
+	typ string
+	tq  interface {
+		Limit(int) T
+		Offset(int) T
+		Unique(bool) T
+		Order(...R) T
+		Where(...P) T
+	}
+}
+
+func (q query[T, P, R]) Type() string {
+	return q.typ
+}
+
+func (q query[T, P, R]) Limit(limit int) {
+	q.tq.Limit(limit)
+}
+
+func (q query[T, P, R]) Offset(offset int) {
+	q.tq.Offset(offset)
+}
+
+func (q query[T, P, R]) Unique(unique bool) {
+	q.tq.Unique(unique)
+}
+
+func (q query[T, P, R]) Order(orders ...func(*sql.Selector)) {
+	rs := make([]R, len(orders))
+	for i := range orders {
+		rs[i] = orders[i]
+	}
+	q.tq.Order(rs...)
+}
+
+func (q query[T, P, R]) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]P, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	q.tq.Where(p...)
+}
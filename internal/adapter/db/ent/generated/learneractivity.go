@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/learneractivity"
+	"github.com/google/uuid"
+)
+
+// LearnerActivity is the model entity for the LearnerActivity schema.
+type LearnerActivity struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Day holds the value of the "day" field.
+	Day time.Time `json:"day,omitempty"`
+	// MinutesListened holds the value of the "minutes_listened" field.
+	MinutesListened int `json:"minutes_listened,omitempty"`
+	// EpisodesCompleted holds the value of the "episodes_completed" field.
+	EpisodesCompleted int `json:"episodes_completed,omitempty"`
+	selectValues      sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LearnerActivity) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case learneractivity.FieldMinutesListened, learneractivity.FieldEpisodesCompleted:
+			values[i] = new(sql.NullInt64)
+		case learneractivity.FieldUserID:
+			values[i] = new(sql.NullString)
+		case learneractivity.FieldCreatedAt, learneractivity.FieldUpdatedAt, learneractivity.FieldDay:
+			values[i] = new(sql.NullTime)
+		case learneractivity.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LearnerActivity fields.
+func (_m *LearnerActivity) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case learneractivity.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case learneractivity.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case learneractivity.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case learneractivity.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case learneractivity.FieldDay:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				_m.Day = value.Time
+			}
+		case learneractivity.FieldMinutesListened:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field minutes_listened", values[i])
+			} else if value.Valid {
+				_m.MinutesListened = int(value.Int64)
+			}
+		case learneractivity.FieldEpisodesCompleted:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field episodes_completed", values[i])
+			} else if value.Valid {
+				_m.EpisodesCompleted = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LearnerActivity.
+// This includes values selected through modifiers, order, etc.
+func (_m *LearnerActivity) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LearnerActivity.
+// Note that you need to call LearnerActivity.Unwrap() before calling this method if this LearnerActivity
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LearnerActivity) Update() *LearnerActivityUpdateOne {
+	return NewLearnerActivityClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LearnerActivity entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LearnerActivity) Unwrap() *LearnerActivity {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: LearnerActivity is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LearnerActivity) String() string {
+	var builder strings.Builder
+	builder.WriteString("LearnerActivity(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("day=")
+	builder.WriteString(_m.Day.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("minutes_listened=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinutesListened))
+	builder.WriteString(", ")
+	builder.WriteString("episodes_completed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EpisodesCompleted))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LearnerActivities is a parsable slice of LearnerActivity.
+type LearnerActivities []*LearnerActivity
@@ -0,0 +1,104 @@
+// Code generated by ent, DO NOT EDIT.
+
+package learneractivity
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the learneractivity type in the database.
+	Label = "learner_activity"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldDay holds the string denoting the day field in the database.
+	FieldDay = "day"
+	// FieldMinutesListened holds the string denoting the minutes_listened field in the database.
+	FieldMinutesListened = "minutes_listened"
+	// FieldEpisodesCompleted holds the string denoting the episodes_completed field in the database.
+	FieldEpisodesCompleted = "episodes_completed"
+	// Table holds the table name of the learneractivity in the database.
+	Table = "learner_activities"
+)
+
+// Columns holds all SQL columns for learneractivity fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldUserID,
+	FieldDay,
+	FieldMinutesListened,
+	FieldEpisodesCompleted,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eslsoft/lession/internal/adapter/db/ent/generated/runtime"
+var (
+	Hooks [2]ent.Hook
+	// DefaultMinutesListened holds the default value on creation for the "minutes_listened" field.
+	DefaultMinutesListened int
+	// DefaultEpisodesCompleted holds the default value on creation for the "episodes_completed" field.
+	DefaultEpisodesCompleted int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the LearnerActivity queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByDay orders the results by the day field.
+func ByDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDay, opts...).ToFunc()
+}
+
+// ByMinutesListened orders the results by the minutes_listened field.
+func ByMinutesListened(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinutesListened, opts...).ToFunc()
+}
+
+// ByEpisodesCompleted orders the results by the episodes_completed field.
+func ByEpisodesCompleted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEpisodesCompleted, opts...).ToFunc()
+}
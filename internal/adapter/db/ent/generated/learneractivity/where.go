@@ -0,0 +1,366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package learneractivity
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldEQ(FieldUserID, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldEQ(FieldDay, v))
+}
+
+// MinutesListened applies equality check predicate on the "minutes_listened" field. It's identical to MinutesListenedEQ.
+func MinutesListened(v int) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldEQ(FieldMinutesListened, v))
+}
+
+// EpisodesCompleted applies equality check predicate on the "episodes_completed" field. It's identical to EpisodesCompletedEQ.
+func EpisodesCompleted(v int) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldEQ(FieldEpisodesCompleted, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v time.Time) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldLTE(FieldDay, v))
+}
+
+// MinutesListenedEQ applies the EQ predicate on the "minutes_listened" field.
+func MinutesListenedEQ(v int) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldEQ(FieldMinutesListened, v))
+}
+
+// MinutesListenedNEQ applies the NEQ predicate on the "minutes_listened" field.
+func MinutesListenedNEQ(v int) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldNEQ(FieldMinutesListened, v))
+}
+
+// MinutesListenedIn applies the In predicate on the "minutes_listened" field.
+func MinutesListenedIn(vs ...int) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldIn(FieldMinutesListened, vs...))
+}
+
+// MinutesListenedNotIn applies the NotIn predicate on the "minutes_listened" field.
+func MinutesListenedNotIn(vs ...int) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldNotIn(FieldMinutesListened, vs...))
+}
+
+// MinutesListenedGT applies the GT predicate on the "minutes_listened" field.
+func MinutesListenedGT(v int) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldGT(FieldMinutesListened, v))
+}
+
+// MinutesListenedGTE applies the GTE predicate on the "minutes_listened" field.
+func MinutesListenedGTE(v int) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldGTE(FieldMinutesListened, v))
+}
+
+// MinutesListenedLT applies the LT predicate on the "minutes_listened" field.
+func MinutesListenedLT(v int) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldLT(FieldMinutesListened, v))
+}
+
+// MinutesListenedLTE applies the LTE predicate on the "minutes_listened" field.
+func MinutesListenedLTE(v int) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldLTE(FieldMinutesListened, v))
+}
+
+// EpisodesCompletedEQ applies the EQ predicate on the "episodes_completed" field.
+func EpisodesCompletedEQ(v int) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldEQ(FieldEpisodesCompleted, v))
+}
+
+// EpisodesCompletedNEQ applies the NEQ predicate on the "episodes_completed" field.
+func EpisodesCompletedNEQ(v int) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldNEQ(FieldEpisodesCompleted, v))
+}
+
+// EpisodesCompletedIn applies the In predicate on the "episodes_completed" field.
+func EpisodesCompletedIn(vs ...int) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldIn(FieldEpisodesCompleted, vs...))
+}
+
+// EpisodesCompletedNotIn applies the NotIn predicate on the "episodes_completed" field.
+func EpisodesCompletedNotIn(vs ...int) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldNotIn(FieldEpisodesCompleted, vs...))
+}
+
+// EpisodesCompletedGT applies the GT predicate on the "episodes_completed" field.
+func EpisodesCompletedGT(v int) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldGT(FieldEpisodesCompleted, v))
+}
+
+// EpisodesCompletedGTE applies the GTE predicate on the "episodes_completed" field.
+func EpisodesCompletedGTE(v int) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldGTE(FieldEpisodesCompleted, v))
+}
+
+// EpisodesCompletedLT applies the LT predicate on the "episodes_completed" field.
+func EpisodesCompletedLT(v int) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldLT(FieldEpisodesCompleted, v))
+}
+
+// EpisodesCompletedLTE applies the LTE predicate on the "episodes_completed" field.
+func EpisodesCompletedLTE(v int) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.FieldLTE(FieldEpisodesCompleted, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LearnerActivity) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LearnerActivity) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LearnerActivity) predicate.LearnerActivity {
+	return predicate.LearnerActivity(sql.NotPredicates(p))
+}
@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/learneractivity"
+	"github.com/google/uuid"
+)
+
+// LearnerActivityCreate is the builder for creating a LearnerActivity entity.
+type LearnerActivityCreate struct {
+	config
+	mutation *LearnerActivityMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LearnerActivityCreate) SetCreatedAt(v time.Time) *LearnerActivityCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LearnerActivityCreate) SetUpdatedAt(v time.Time) *LearnerActivityCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LearnerActivityCreate) SetUserID(v string) *LearnerActivityCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetDay sets the "day" field.
+func (_c *LearnerActivityCreate) SetDay(v time.Time) *LearnerActivityCreate {
+	_c.mutation.SetDay(v)
+	return _c
+}
+
+// SetMinutesListened sets the "minutes_listened" field.
+func (_c *LearnerActivityCreate) SetMinutesListened(v int) *LearnerActivityCreate {
+	_c.mutation.SetMinutesListened(v)
+	return _c
+}
+
+// SetNillableMinutesListened sets the "minutes_listened" field if the given value is not nil.
+func (_c *LearnerActivityCreate) SetNillableMinutesListened(v *int) *LearnerActivityCreate {
+	if v != nil {
+		_c.SetMinutesListened(*v)
+	}
+	return _c
+}
+
+// SetEpisodesCompleted sets the "episodes_completed" field.
+func (_c *LearnerActivityCreate) SetEpisodesCompleted(v int) *LearnerActivityCreate {
+	_c.mutation.SetEpisodesCompleted(v)
+	return _c
+}
+
+// SetNillableEpisodesCompleted sets the "episodes_completed" field if the given value is not nil.
+func (_c *LearnerActivityCreate) SetNillableEpisodesCompleted(v *int) *LearnerActivityCreate {
+	if v != nil {
+		_c.SetEpisodesCompleted(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LearnerActivityCreate) SetID(v uuid.UUID) *LearnerActivityCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LearnerActivityCreate) SetNillableID(v *uuid.UUID) *LearnerActivityCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the LearnerActivityMutation object of the builder.
+func (_c *LearnerActivityCreate) Mutation() *LearnerActivityMutation {
+	return _c.mutation
+}
+
+// Save creates the LearnerActivity in the database.
+func (_c *LearnerActivityCreate) Save(ctx context.Context) (*LearnerActivity, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LearnerActivityCreate) SaveX(ctx context.Context) *LearnerActivity {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LearnerActivityCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LearnerActivityCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LearnerActivityCreate) defaults() error {
+	if _, ok := _c.mutation.MinutesListened(); !ok {
+		v := learneractivity.DefaultMinutesListened
+		_c.mutation.SetMinutesListened(v)
+	}
+	if _, ok := _c.mutation.EpisodesCompleted(); !ok {
+		v := learneractivity.DefaultEpisodesCompleted
+		_c.mutation.SetEpisodesCompleted(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if learneractivity.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized learneractivity.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := learneractivity.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LearnerActivityCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "LearnerActivity.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "LearnerActivity.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "LearnerActivity.user_id"`)}
+	}
+	if _, ok := _c.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`generated: missing required field "LearnerActivity.day"`)}
+	}
+	if _, ok := _c.mutation.MinutesListened(); !ok {
+		return &ValidationError{Name: "minutes_listened", err: errors.New(`generated: missing required field "LearnerActivity.minutes_listened"`)}
+	}
+	if _, ok := _c.mutation.EpisodesCompleted(); !ok {
+		return &ValidationError{Name: "episodes_completed", err: errors.New(`generated: missing required field "LearnerActivity.episodes_completed"`)}
+	}
+	return nil
+}
+
+func (_c *LearnerActivityCreate) sqlSave(ctx context.Context) (*LearnerActivity, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LearnerActivityCreate) createSpec() (*LearnerActivity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LearnerActivity{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(learneractivity.Table, sqlgraph.NewFieldSpec(learneractivity.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(learneractivity.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(learneractivity.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(learneractivity.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Day(); ok {
+		_spec.SetField(learneractivity.FieldDay, field.TypeTime, value)
+		_node.Day = value
+	}
+	if value, ok := _c.mutation.MinutesListened(); ok {
+		_spec.SetField(learneractivity.FieldMinutesListened, field.TypeInt, value)
+		_node.MinutesListened = value
+	}
+	if value, ok := _c.mutation.EpisodesCompleted(); ok {
+		_spec.SetField(learneractivity.FieldEpisodesCompleted, field.TypeInt, value)
+		_node.EpisodesCompleted = value
+	}
+	return _node, _spec
+}
+
+// LearnerActivityCreateBulk is the builder for creating many LearnerActivity entities in bulk.
+type LearnerActivityCreateBulk struct {
+	config
+	err      error
+	builders []*LearnerActivityCreate
+}
+
+// Save creates the LearnerActivity entities in the database.
+func (_c *LearnerActivityCreateBulk) Save(ctx context.Context) ([]*LearnerActivity, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LearnerActivity, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LearnerActivityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LearnerActivityCreateBulk) SaveX(ctx context.Context) []*LearnerActivity {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LearnerActivityCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LearnerActivityCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
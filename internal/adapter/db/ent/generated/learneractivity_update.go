@@ -0,0 +1,386 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/learneractivity"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+)
+
+// LearnerActivityUpdate is the builder for updating LearnerActivity entities.
+type LearnerActivityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LearnerActivityMutation
+}
+
+// Where appends a list predicates to the LearnerActivityUpdate builder.
+func (_u *LearnerActivityUpdate) Where(ps ...predicate.LearnerActivity) *LearnerActivityUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LearnerActivityUpdate) SetUpdatedAt(v time.Time) *LearnerActivityUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *LearnerActivityUpdate) SetNillableUpdatedAt(v *time.Time) *LearnerActivityUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LearnerActivityUpdate) SetUserID(v string) *LearnerActivityUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LearnerActivityUpdate) SetNillableUserID(v *string) *LearnerActivityUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetDay sets the "day" field.
+func (_u *LearnerActivityUpdate) SetDay(v time.Time) *LearnerActivityUpdate {
+	_u.mutation.SetDay(v)
+	return _u
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (_u *LearnerActivityUpdate) SetNillableDay(v *time.Time) *LearnerActivityUpdate {
+	if v != nil {
+		_u.SetDay(*v)
+	}
+	return _u
+}
+
+// SetMinutesListened sets the "minutes_listened" field.
+func (_u *LearnerActivityUpdate) SetMinutesListened(v int) *LearnerActivityUpdate {
+	_u.mutation.ResetMinutesListened()
+	_u.mutation.SetMinutesListened(v)
+	return _u
+}
+
+// SetNillableMinutesListened sets the "minutes_listened" field if the given value is not nil.
+func (_u *LearnerActivityUpdate) SetNillableMinutesListened(v *int) *LearnerActivityUpdate {
+	if v != nil {
+		_u.SetMinutesListened(*v)
+	}
+	return _u
+}
+
+// AddMinutesListened adds value to the "minutes_listened" field.
+func (_u *LearnerActivityUpdate) AddMinutesListened(v int) *LearnerActivityUpdate {
+	_u.mutation.AddMinutesListened(v)
+	return _u
+}
+
+// SetEpisodesCompleted sets the "episodes_completed" field.
+func (_u *LearnerActivityUpdate) SetEpisodesCompleted(v int) *LearnerActivityUpdate {
+	_u.mutation.ResetEpisodesCompleted()
+	_u.mutation.SetEpisodesCompleted(v)
+	return _u
+}
+
+// SetNillableEpisodesCompleted sets the "episodes_completed" field if the given value is not nil.
+func (_u *LearnerActivityUpdate) SetNillableEpisodesCompleted(v *int) *LearnerActivityUpdate {
+	if v != nil {
+		_u.SetEpisodesCompleted(*v)
+	}
+	return _u
+}
+
+// AddEpisodesCompleted adds value to the "episodes_completed" field.
+func (_u *LearnerActivityUpdate) AddEpisodesCompleted(v int) *LearnerActivityUpdate {
+	_u.mutation.AddEpisodesCompleted(v)
+	return _u
+}
+
+// Mutation returns the LearnerActivityMutation object of the builder.
+func (_u *LearnerActivityUpdate) Mutation() *LearnerActivityMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LearnerActivityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LearnerActivityUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LearnerActivityUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LearnerActivityUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *LearnerActivityUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(learneractivity.Table, learneractivity.Columns, sqlgraph.NewFieldSpec(learneractivity.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(learneractivity.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(learneractivity.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Day(); ok {
+		_spec.SetField(learneractivity.FieldDay, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.MinutesListened(); ok {
+		_spec.SetField(learneractivity.FieldMinutesListened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinutesListened(); ok {
+		_spec.AddField(learneractivity.FieldMinutesListened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EpisodesCompleted(); ok {
+		_spec.SetField(learneractivity.FieldEpisodesCompleted, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEpisodesCompleted(); ok {
+		_spec.AddField(learneractivity.FieldEpisodesCompleted, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{learneractivity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LearnerActivityUpdateOne is the builder for updating a single LearnerActivity entity.
+type LearnerActivityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LearnerActivityMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LearnerActivityUpdateOne) SetUpdatedAt(v time.Time) *LearnerActivityUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *LearnerActivityUpdateOne) SetNillableUpdatedAt(v *time.Time) *LearnerActivityUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LearnerActivityUpdateOne) SetUserID(v string) *LearnerActivityUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LearnerActivityUpdateOne) SetNillableUserID(v *string) *LearnerActivityUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetDay sets the "day" field.
+func (_u *LearnerActivityUpdateOne) SetDay(v time.Time) *LearnerActivityUpdateOne {
+	_u.mutation.SetDay(v)
+	return _u
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (_u *LearnerActivityUpdateOne) SetNillableDay(v *time.Time) *LearnerActivityUpdateOne {
+	if v != nil {
+		_u.SetDay(*v)
+	}
+	return _u
+}
+
+// SetMinutesListened sets the "minutes_listened" field.
+func (_u *LearnerActivityUpdateOne) SetMinutesListened(v int) *LearnerActivityUpdateOne {
+	_u.mutation.ResetMinutesListened()
+	_u.mutation.SetMinutesListened(v)
+	return _u
+}
+
+// SetNillableMinutesListened sets the "minutes_listened" field if the given value is not nil.
+func (_u *LearnerActivityUpdateOne) SetNillableMinutesListened(v *int) *LearnerActivityUpdateOne {
+	if v != nil {
+		_u.SetMinutesListened(*v)
+	}
+	return _u
+}
+
+// AddMinutesListened adds value to the "minutes_listened" field.
+func (_u *LearnerActivityUpdateOne) AddMinutesListened(v int) *LearnerActivityUpdateOne {
+	_u.mutation.AddMinutesListened(v)
+	return _u
+}
+
+// SetEpisodesCompleted sets the "episodes_completed" field.
+func (_u *LearnerActivityUpdateOne) SetEpisodesCompleted(v int) *LearnerActivityUpdateOne {
+	_u.mutation.ResetEpisodesCompleted()
+	_u.mutation.SetEpisodesCompleted(v)
+	return _u
+}
+
+// SetNillableEpisodesCompleted sets the "episodes_completed" field if the given value is not nil.
+func (_u *LearnerActivityUpdateOne) SetNillableEpisodesCompleted(v *int) *LearnerActivityUpdateOne {
+	if v != nil {
+		_u.SetEpisodesCompleted(*v)
+	}
+	return _u
+}
+
+// AddEpisodesCompleted adds value to the "episodes_completed" field.
+func (_u *LearnerActivityUpdateOne) AddEpisodesCompleted(v int) *LearnerActivityUpdateOne {
+	_u.mutation.AddEpisodesCompleted(v)
+	return _u
+}
+
+// Mutation returns the LearnerActivityMutation object of the builder.
+func (_u *LearnerActivityUpdateOne) Mutation() *LearnerActivityMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LearnerActivityUpdate builder.
+func (_u *LearnerActivityUpdateOne) Where(ps ...predicate.LearnerActivity) *LearnerActivityUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LearnerActivityUpdateOne) Select(field string, fields ...string) *LearnerActivityUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LearnerActivity entity.
+func (_u *LearnerActivityUpdateOne) Save(ctx context.Context) (*LearnerActivity, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LearnerActivityUpdateOne) SaveX(ctx context.Context) *LearnerActivity {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LearnerActivityUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LearnerActivityUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *LearnerActivityUpdateOne) sqlSave(ctx context.Context) (_node *LearnerActivity, err error) {
+	_spec := sqlgraph.NewUpdateSpec(learneractivity.Table, learneractivity.Columns, sqlgraph.NewFieldSpec(learneractivity.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "LearnerActivity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, learneractivity.FieldID)
+		for _, f := range fields {
+			if !learneractivity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != learneractivity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(learneractivity.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(learneractivity.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Day(); ok {
+		_spec.SetField(learneractivity.FieldDay, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.MinutesListened(); ok {
+		_spec.SetField(learneractivity.FieldMinutesListened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinutesListened(); ok {
+		_spec.AddField(learneractivity.FieldMinutesListened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EpisodesCompleted(); ok {
+		_spec.SetField(learneractivity.FieldEpisodesCompleted, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEpisodesCompleted(); ok {
+		_spec.AddField(learneractivity.FieldEpisodesCompleted, field.TypeInt, value)
+	}
+	_node = &LearnerActivity{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{learneractivity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltilaunch"
+	"github.com/google/uuid"
+)
+
+// LTILaunch is the model entity for the LTILaunch schema.
+type LTILaunch struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// PlatformID holds the value of the "platform_id" field.
+	PlatformID uuid.UUID `json:"platform_id,omitempty"`
+	// MessageType holds the value of the "message_type" field.
+	MessageType int `json:"message_type,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// EpisodeID holds the value of the "episode_id" field.
+	EpisodeID uuid.UUID `json:"episode_id,omitempty"`
+	// ResourceLinkID holds the value of the "resource_link_id" field.
+	ResourceLinkID string `json:"resource_link_id,omitempty"`
+	// LineItemURL holds the value of the "line_item_url" field.
+	LineItemURL string `json:"line_item_url,omitempty"`
+	// DeepLinkReturnURL holds the value of the "deep_link_return_url" field.
+	DeepLinkReturnURL string `json:"deep_link_return_url,omitempty"`
+	// DeepLinkData holds the value of the "deep_link_data" field.
+	DeepLinkData string `json:"deep_link_data,omitempty"`
+	// ScoreSubmittedAt holds the value of the "score_submitted_at" field.
+	ScoreSubmittedAt *time.Time `json:"score_submitted_at,omitempty"`
+	selectValues     sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LTILaunch) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ltilaunch.FieldMessageType:
+			values[i] = new(sql.NullInt64)
+		case ltilaunch.FieldSubject, ltilaunch.FieldUserID, ltilaunch.FieldResourceLinkID, ltilaunch.FieldLineItemURL, ltilaunch.FieldDeepLinkReturnURL, ltilaunch.FieldDeepLinkData:
+			values[i] = new(sql.NullString)
+		case ltilaunch.FieldCreatedAt, ltilaunch.FieldScoreSubmittedAt:
+			values[i] = new(sql.NullTime)
+		case ltilaunch.FieldID, ltilaunch.FieldPlatformID, ltilaunch.FieldEpisodeID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LTILaunch fields.
+func (_m *LTILaunch) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ltilaunch.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case ltilaunch.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case ltilaunch.FieldPlatformID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field platform_id", values[i])
+			} else if value != nil {
+				_m.PlatformID = *value
+			}
+		case ltilaunch.FieldMessageType:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_type", values[i])
+			} else if value.Valid {
+				_m.MessageType = int(value.Int64)
+			}
+		case ltilaunch.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				_m.Subject = value.String
+			}
+		case ltilaunch.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case ltilaunch.FieldEpisodeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field episode_id", values[i])
+			} else if value != nil {
+				_m.EpisodeID = *value
+			}
+		case ltilaunch.FieldResourceLinkID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_link_id", values[i])
+			} else if value.Valid {
+				_m.ResourceLinkID = value.String
+			}
+		case ltilaunch.FieldLineItemURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field line_item_url", values[i])
+			} else if value.Valid {
+				_m.LineItemURL = value.String
+			}
+		case ltilaunch.FieldDeepLinkReturnURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deep_link_return_url", values[i])
+			} else if value.Valid {
+				_m.DeepLinkReturnURL = value.String
+			}
+		case ltilaunch.FieldDeepLinkData:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deep_link_data", values[i])
+			} else if value.Valid {
+				_m.DeepLinkData = value.String
+			}
+		case ltilaunch.FieldScoreSubmittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field score_submitted_at", values[i])
+			} else if value.Valid {
+				_m.ScoreSubmittedAt = new(time.Time)
+				*_m.ScoreSubmittedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LTILaunch.
+// This includes values selected through modifiers, order, etc.
+func (_m *LTILaunch) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LTILaunch.
+// Note that you need to call LTILaunch.Unwrap() before calling this method if this LTILaunch
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LTILaunch) Update() *LTILaunchUpdateOne {
+	return NewLTILaunchClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LTILaunch entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LTILaunch) Unwrap() *LTILaunch {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: LTILaunch is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LTILaunch) String() string {
+	var builder strings.Builder
+	builder.WriteString("LTILaunch(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("platform_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PlatformID))
+	builder.WriteString(", ")
+	builder.WriteString("message_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MessageType))
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(_m.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("episode_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EpisodeID))
+	builder.WriteString(", ")
+	builder.WriteString("resource_link_id=")
+	builder.WriteString(_m.ResourceLinkID)
+	builder.WriteString(", ")
+	builder.WriteString("line_item_url=")
+	builder.WriteString(_m.LineItemURL)
+	builder.WriteString(", ")
+	builder.WriteString("deep_link_return_url=")
+	builder.WriteString(_m.DeepLinkReturnURL)
+	builder.WriteString(", ")
+	builder.WriteString("deep_link_data=")
+	builder.WriteString(_m.DeepLinkData)
+	builder.WriteString(", ")
+	if v := _m.ScoreSubmittedAt; v != nil {
+		builder.WriteString("score_submitted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LTILaunches is a parsable slice of LTILaunch.
+type LTILaunches []*LTILaunch
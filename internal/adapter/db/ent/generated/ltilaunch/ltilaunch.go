@@ -0,0 +1,148 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ltilaunch
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the ltilaunch type in the database.
+	Label = "lti_launch"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldPlatformID holds the string denoting the platform_id field in the database.
+	FieldPlatformID = "platform_id"
+	// FieldMessageType holds the string denoting the message_type field in the database.
+	FieldMessageType = "message_type"
+	// FieldSubject holds the string denoting the subject field in the database.
+	FieldSubject = "subject"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldEpisodeID holds the string denoting the episode_id field in the database.
+	FieldEpisodeID = "episode_id"
+	// FieldResourceLinkID holds the string denoting the resource_link_id field in the database.
+	FieldResourceLinkID = "resource_link_id"
+	// FieldLineItemURL holds the string denoting the line_item_url field in the database.
+	FieldLineItemURL = "line_item_url"
+	// FieldDeepLinkReturnURL holds the string denoting the deep_link_return_url field in the database.
+	FieldDeepLinkReturnURL = "deep_link_return_url"
+	// FieldDeepLinkData holds the string denoting the deep_link_data field in the database.
+	FieldDeepLinkData = "deep_link_data"
+	// FieldScoreSubmittedAt holds the string denoting the score_submitted_at field in the database.
+	FieldScoreSubmittedAt = "score_submitted_at"
+	// Table holds the table name of the ltilaunch in the database.
+	Table = "lti_launches"
+)
+
+// Columns holds all SQL columns for ltilaunch fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldPlatformID,
+	FieldMessageType,
+	FieldSubject,
+	FieldUserID,
+	FieldEpisodeID,
+	FieldResourceLinkID,
+	FieldLineItemURL,
+	FieldDeepLinkReturnURL,
+	FieldDeepLinkData,
+	FieldScoreSubmittedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eslsoft/lession/internal/adapter/db/ent/generated/runtime"
+var (
+	Hooks [1]ent.Hook
+	// DefaultResourceLinkID holds the default value on creation for the "resource_link_id" field.
+	DefaultResourceLinkID string
+	// DefaultLineItemURL holds the default value on creation for the "line_item_url" field.
+	DefaultLineItemURL string
+	// DefaultDeepLinkReturnURL holds the default value on creation for the "deep_link_return_url" field.
+	DefaultDeepLinkReturnURL string
+	// DefaultDeepLinkData holds the default value on creation for the "deep_link_data" field.
+	DefaultDeepLinkData string
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the LTILaunch queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByPlatformID orders the results by the platform_id field.
+func ByPlatformID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlatformID, opts...).ToFunc()
+}
+
+// ByMessageType orders the results by the message_type field.
+func ByMessageType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageType, opts...).ToFunc()
+}
+
+// BySubject orders the results by the subject field.
+func BySubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubject, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByEpisodeID orders the results by the episode_id field.
+func ByEpisodeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEpisodeID, opts...).ToFunc()
+}
+
+// ByResourceLinkID orders the results by the resource_link_id field.
+func ByResourceLinkID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResourceLinkID, opts...).ToFunc()
+}
+
+// ByLineItemURL orders the results by the line_item_url field.
+func ByLineItemURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLineItemURL, opts...).ToFunc()
+}
+
+// ByDeepLinkReturnURL orders the results by the deep_link_return_url field.
+func ByDeepLinkReturnURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeepLinkReturnURL, opts...).ToFunc()
+}
+
+// ByDeepLinkData orders the results by the deep_link_data field.
+func ByDeepLinkData(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeepLinkData, opts...).ToFunc()
+}
+
+// ByScoreSubmittedAt orders the results by the score_submitted_at field.
+func ByScoreSubmittedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScoreSubmittedAt, opts...).ToFunc()
+}
@@ -0,0 +1,736 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ltilaunch
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PlatformID applies equality check predicate on the "platform_id" field. It's identical to PlatformIDEQ.
+func PlatformID(v uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldPlatformID, v))
+}
+
+// MessageType applies equality check predicate on the "message_type" field. It's identical to MessageTypeEQ.
+func MessageType(v int) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldMessageType, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldSubject, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldUserID, v))
+}
+
+// EpisodeID applies equality check predicate on the "episode_id" field. It's identical to EpisodeIDEQ.
+func EpisodeID(v uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldEpisodeID, v))
+}
+
+// ResourceLinkID applies equality check predicate on the "resource_link_id" field. It's identical to ResourceLinkIDEQ.
+func ResourceLinkID(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldResourceLinkID, v))
+}
+
+// LineItemURL applies equality check predicate on the "line_item_url" field. It's identical to LineItemURLEQ.
+func LineItemURL(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldLineItemURL, v))
+}
+
+// DeepLinkReturnURL applies equality check predicate on the "deep_link_return_url" field. It's identical to DeepLinkReturnURLEQ.
+func DeepLinkReturnURL(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldDeepLinkReturnURL, v))
+}
+
+// DeepLinkData applies equality check predicate on the "deep_link_data" field. It's identical to DeepLinkDataEQ.
+func DeepLinkData(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldDeepLinkData, v))
+}
+
+// ScoreSubmittedAt applies equality check predicate on the "score_submitted_at" field. It's identical to ScoreSubmittedAtEQ.
+func ScoreSubmittedAt(v time.Time) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldScoreSubmittedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// PlatformIDEQ applies the EQ predicate on the "platform_id" field.
+func PlatformIDEQ(v uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldPlatformID, v))
+}
+
+// PlatformIDNEQ applies the NEQ predicate on the "platform_id" field.
+func PlatformIDNEQ(v uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNEQ(FieldPlatformID, v))
+}
+
+// PlatformIDIn applies the In predicate on the "platform_id" field.
+func PlatformIDIn(vs ...uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldIn(FieldPlatformID, vs...))
+}
+
+// PlatformIDNotIn applies the NotIn predicate on the "platform_id" field.
+func PlatformIDNotIn(vs ...uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNotIn(FieldPlatformID, vs...))
+}
+
+// PlatformIDGT applies the GT predicate on the "platform_id" field.
+func PlatformIDGT(v uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGT(FieldPlatformID, v))
+}
+
+// PlatformIDGTE applies the GTE predicate on the "platform_id" field.
+func PlatformIDGTE(v uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGTE(FieldPlatformID, v))
+}
+
+// PlatformIDLT applies the LT predicate on the "platform_id" field.
+func PlatformIDLT(v uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLT(FieldPlatformID, v))
+}
+
+// PlatformIDLTE applies the LTE predicate on the "platform_id" field.
+func PlatformIDLTE(v uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLTE(FieldPlatformID, v))
+}
+
+// MessageTypeEQ applies the EQ predicate on the "message_type" field.
+func MessageTypeEQ(v int) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldMessageType, v))
+}
+
+// MessageTypeNEQ applies the NEQ predicate on the "message_type" field.
+func MessageTypeNEQ(v int) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNEQ(FieldMessageType, v))
+}
+
+// MessageTypeIn applies the In predicate on the "message_type" field.
+func MessageTypeIn(vs ...int) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldIn(FieldMessageType, vs...))
+}
+
+// MessageTypeNotIn applies the NotIn predicate on the "message_type" field.
+func MessageTypeNotIn(vs ...int) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNotIn(FieldMessageType, vs...))
+}
+
+// MessageTypeGT applies the GT predicate on the "message_type" field.
+func MessageTypeGT(v int) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGT(FieldMessageType, v))
+}
+
+// MessageTypeGTE applies the GTE predicate on the "message_type" field.
+func MessageTypeGTE(v int) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGTE(FieldMessageType, v))
+}
+
+// MessageTypeLT applies the LT predicate on the "message_type" field.
+func MessageTypeLT(v int) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLT(FieldMessageType, v))
+}
+
+// MessageTypeLTE applies the LTE predicate on the "message_type" field.
+func MessageTypeLTE(v int) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLTE(FieldMessageType, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// EpisodeIDEQ applies the EQ predicate on the "episode_id" field.
+func EpisodeIDEQ(v uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldEpisodeID, v))
+}
+
+// EpisodeIDNEQ applies the NEQ predicate on the "episode_id" field.
+func EpisodeIDNEQ(v uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNEQ(FieldEpisodeID, v))
+}
+
+// EpisodeIDIn applies the In predicate on the "episode_id" field.
+func EpisodeIDIn(vs ...uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldIn(FieldEpisodeID, vs...))
+}
+
+// EpisodeIDNotIn applies the NotIn predicate on the "episode_id" field.
+func EpisodeIDNotIn(vs ...uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNotIn(FieldEpisodeID, vs...))
+}
+
+// EpisodeIDGT applies the GT predicate on the "episode_id" field.
+func EpisodeIDGT(v uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGT(FieldEpisodeID, v))
+}
+
+// EpisodeIDGTE applies the GTE predicate on the "episode_id" field.
+func EpisodeIDGTE(v uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGTE(FieldEpisodeID, v))
+}
+
+// EpisodeIDLT applies the LT predicate on the "episode_id" field.
+func EpisodeIDLT(v uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLT(FieldEpisodeID, v))
+}
+
+// EpisodeIDLTE applies the LTE predicate on the "episode_id" field.
+func EpisodeIDLTE(v uuid.UUID) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLTE(FieldEpisodeID, v))
+}
+
+// EpisodeIDIsNil applies the IsNil predicate on the "episode_id" field.
+func EpisodeIDIsNil() predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldIsNull(FieldEpisodeID))
+}
+
+// EpisodeIDNotNil applies the NotNil predicate on the "episode_id" field.
+func EpisodeIDNotNil() predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNotNull(FieldEpisodeID))
+}
+
+// ResourceLinkIDEQ applies the EQ predicate on the "resource_link_id" field.
+func ResourceLinkIDEQ(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldResourceLinkID, v))
+}
+
+// ResourceLinkIDNEQ applies the NEQ predicate on the "resource_link_id" field.
+func ResourceLinkIDNEQ(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNEQ(FieldResourceLinkID, v))
+}
+
+// ResourceLinkIDIn applies the In predicate on the "resource_link_id" field.
+func ResourceLinkIDIn(vs ...string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldIn(FieldResourceLinkID, vs...))
+}
+
+// ResourceLinkIDNotIn applies the NotIn predicate on the "resource_link_id" field.
+func ResourceLinkIDNotIn(vs ...string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNotIn(FieldResourceLinkID, vs...))
+}
+
+// ResourceLinkIDGT applies the GT predicate on the "resource_link_id" field.
+func ResourceLinkIDGT(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGT(FieldResourceLinkID, v))
+}
+
+// ResourceLinkIDGTE applies the GTE predicate on the "resource_link_id" field.
+func ResourceLinkIDGTE(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGTE(FieldResourceLinkID, v))
+}
+
+// ResourceLinkIDLT applies the LT predicate on the "resource_link_id" field.
+func ResourceLinkIDLT(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLT(FieldResourceLinkID, v))
+}
+
+// ResourceLinkIDLTE applies the LTE predicate on the "resource_link_id" field.
+func ResourceLinkIDLTE(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLTE(FieldResourceLinkID, v))
+}
+
+// ResourceLinkIDContains applies the Contains predicate on the "resource_link_id" field.
+func ResourceLinkIDContains(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldContains(FieldResourceLinkID, v))
+}
+
+// ResourceLinkIDHasPrefix applies the HasPrefix predicate on the "resource_link_id" field.
+func ResourceLinkIDHasPrefix(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldHasPrefix(FieldResourceLinkID, v))
+}
+
+// ResourceLinkIDHasSuffix applies the HasSuffix predicate on the "resource_link_id" field.
+func ResourceLinkIDHasSuffix(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldHasSuffix(FieldResourceLinkID, v))
+}
+
+// ResourceLinkIDEqualFold applies the EqualFold predicate on the "resource_link_id" field.
+func ResourceLinkIDEqualFold(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEqualFold(FieldResourceLinkID, v))
+}
+
+// ResourceLinkIDContainsFold applies the ContainsFold predicate on the "resource_link_id" field.
+func ResourceLinkIDContainsFold(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldContainsFold(FieldResourceLinkID, v))
+}
+
+// LineItemURLEQ applies the EQ predicate on the "line_item_url" field.
+func LineItemURLEQ(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldLineItemURL, v))
+}
+
+// LineItemURLNEQ applies the NEQ predicate on the "line_item_url" field.
+func LineItemURLNEQ(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNEQ(FieldLineItemURL, v))
+}
+
+// LineItemURLIn applies the In predicate on the "line_item_url" field.
+func LineItemURLIn(vs ...string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldIn(FieldLineItemURL, vs...))
+}
+
+// LineItemURLNotIn applies the NotIn predicate on the "line_item_url" field.
+func LineItemURLNotIn(vs ...string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNotIn(FieldLineItemURL, vs...))
+}
+
+// LineItemURLGT applies the GT predicate on the "line_item_url" field.
+func LineItemURLGT(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGT(FieldLineItemURL, v))
+}
+
+// LineItemURLGTE applies the GTE predicate on the "line_item_url" field.
+func LineItemURLGTE(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGTE(FieldLineItemURL, v))
+}
+
+// LineItemURLLT applies the LT predicate on the "line_item_url" field.
+func LineItemURLLT(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLT(FieldLineItemURL, v))
+}
+
+// LineItemURLLTE applies the LTE predicate on the "line_item_url" field.
+func LineItemURLLTE(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLTE(FieldLineItemURL, v))
+}
+
+// LineItemURLContains applies the Contains predicate on the "line_item_url" field.
+func LineItemURLContains(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldContains(FieldLineItemURL, v))
+}
+
+// LineItemURLHasPrefix applies the HasPrefix predicate on the "line_item_url" field.
+func LineItemURLHasPrefix(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldHasPrefix(FieldLineItemURL, v))
+}
+
+// LineItemURLHasSuffix applies the HasSuffix predicate on the "line_item_url" field.
+func LineItemURLHasSuffix(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldHasSuffix(FieldLineItemURL, v))
+}
+
+// LineItemURLEqualFold applies the EqualFold predicate on the "line_item_url" field.
+func LineItemURLEqualFold(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEqualFold(FieldLineItemURL, v))
+}
+
+// LineItemURLContainsFold applies the ContainsFold predicate on the "line_item_url" field.
+func LineItemURLContainsFold(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldContainsFold(FieldLineItemURL, v))
+}
+
+// DeepLinkReturnURLEQ applies the EQ predicate on the "deep_link_return_url" field.
+func DeepLinkReturnURLEQ(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldDeepLinkReturnURL, v))
+}
+
+// DeepLinkReturnURLNEQ applies the NEQ predicate on the "deep_link_return_url" field.
+func DeepLinkReturnURLNEQ(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNEQ(FieldDeepLinkReturnURL, v))
+}
+
+// DeepLinkReturnURLIn applies the In predicate on the "deep_link_return_url" field.
+func DeepLinkReturnURLIn(vs ...string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldIn(FieldDeepLinkReturnURL, vs...))
+}
+
+// DeepLinkReturnURLNotIn applies the NotIn predicate on the "deep_link_return_url" field.
+func DeepLinkReturnURLNotIn(vs ...string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNotIn(FieldDeepLinkReturnURL, vs...))
+}
+
+// DeepLinkReturnURLGT applies the GT predicate on the "deep_link_return_url" field.
+func DeepLinkReturnURLGT(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGT(FieldDeepLinkReturnURL, v))
+}
+
+// DeepLinkReturnURLGTE applies the GTE predicate on the "deep_link_return_url" field.
+func DeepLinkReturnURLGTE(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGTE(FieldDeepLinkReturnURL, v))
+}
+
+// DeepLinkReturnURLLT applies the LT predicate on the "deep_link_return_url" field.
+func DeepLinkReturnURLLT(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLT(FieldDeepLinkReturnURL, v))
+}
+
+// DeepLinkReturnURLLTE applies the LTE predicate on the "deep_link_return_url" field.
+func DeepLinkReturnURLLTE(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLTE(FieldDeepLinkReturnURL, v))
+}
+
+// DeepLinkReturnURLContains applies the Contains predicate on the "deep_link_return_url" field.
+func DeepLinkReturnURLContains(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldContains(FieldDeepLinkReturnURL, v))
+}
+
+// DeepLinkReturnURLHasPrefix applies the HasPrefix predicate on the "deep_link_return_url" field.
+func DeepLinkReturnURLHasPrefix(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldHasPrefix(FieldDeepLinkReturnURL, v))
+}
+
+// DeepLinkReturnURLHasSuffix applies the HasSuffix predicate on the "deep_link_return_url" field.
+func DeepLinkReturnURLHasSuffix(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldHasSuffix(FieldDeepLinkReturnURL, v))
+}
+
+// DeepLinkReturnURLEqualFold applies the EqualFold predicate on the "deep_link_return_url" field.
+func DeepLinkReturnURLEqualFold(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEqualFold(FieldDeepLinkReturnURL, v))
+}
+
+// DeepLinkReturnURLContainsFold applies the ContainsFold predicate on the "deep_link_return_url" field.
+func DeepLinkReturnURLContainsFold(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldContainsFold(FieldDeepLinkReturnURL, v))
+}
+
+// DeepLinkDataEQ applies the EQ predicate on the "deep_link_data" field.
+func DeepLinkDataEQ(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldDeepLinkData, v))
+}
+
+// DeepLinkDataNEQ applies the NEQ predicate on the "deep_link_data" field.
+func DeepLinkDataNEQ(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNEQ(FieldDeepLinkData, v))
+}
+
+// DeepLinkDataIn applies the In predicate on the "deep_link_data" field.
+func DeepLinkDataIn(vs ...string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldIn(FieldDeepLinkData, vs...))
+}
+
+// DeepLinkDataNotIn applies the NotIn predicate on the "deep_link_data" field.
+func DeepLinkDataNotIn(vs ...string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNotIn(FieldDeepLinkData, vs...))
+}
+
+// DeepLinkDataGT applies the GT predicate on the "deep_link_data" field.
+func DeepLinkDataGT(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGT(FieldDeepLinkData, v))
+}
+
+// DeepLinkDataGTE applies the GTE predicate on the "deep_link_data" field.
+func DeepLinkDataGTE(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGTE(FieldDeepLinkData, v))
+}
+
+// DeepLinkDataLT applies the LT predicate on the "deep_link_data" field.
+func DeepLinkDataLT(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLT(FieldDeepLinkData, v))
+}
+
+// DeepLinkDataLTE applies the LTE predicate on the "deep_link_data" field.
+func DeepLinkDataLTE(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLTE(FieldDeepLinkData, v))
+}
+
+// DeepLinkDataContains applies the Contains predicate on the "deep_link_data" field.
+func DeepLinkDataContains(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldContains(FieldDeepLinkData, v))
+}
+
+// DeepLinkDataHasPrefix applies the HasPrefix predicate on the "deep_link_data" field.
+func DeepLinkDataHasPrefix(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldHasPrefix(FieldDeepLinkData, v))
+}
+
+// DeepLinkDataHasSuffix applies the HasSuffix predicate on the "deep_link_data" field.
+func DeepLinkDataHasSuffix(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldHasSuffix(FieldDeepLinkData, v))
+}
+
+// DeepLinkDataEqualFold applies the EqualFold predicate on the "deep_link_data" field.
+func DeepLinkDataEqualFold(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEqualFold(FieldDeepLinkData, v))
+}
+
+// DeepLinkDataContainsFold applies the ContainsFold predicate on the "deep_link_data" field.
+func DeepLinkDataContainsFold(v string) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldContainsFold(FieldDeepLinkData, v))
+}
+
+// ScoreSubmittedAtEQ applies the EQ predicate on the "score_submitted_at" field.
+func ScoreSubmittedAtEQ(v time.Time) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldEQ(FieldScoreSubmittedAt, v))
+}
+
+// ScoreSubmittedAtNEQ applies the NEQ predicate on the "score_submitted_at" field.
+func ScoreSubmittedAtNEQ(v time.Time) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNEQ(FieldScoreSubmittedAt, v))
+}
+
+// ScoreSubmittedAtIn applies the In predicate on the "score_submitted_at" field.
+func ScoreSubmittedAtIn(vs ...time.Time) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldIn(FieldScoreSubmittedAt, vs...))
+}
+
+// ScoreSubmittedAtNotIn applies the NotIn predicate on the "score_submitted_at" field.
+func ScoreSubmittedAtNotIn(vs ...time.Time) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNotIn(FieldScoreSubmittedAt, vs...))
+}
+
+// ScoreSubmittedAtGT applies the GT predicate on the "score_submitted_at" field.
+func ScoreSubmittedAtGT(v time.Time) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGT(FieldScoreSubmittedAt, v))
+}
+
+// ScoreSubmittedAtGTE applies the GTE predicate on the "score_submitted_at" field.
+func ScoreSubmittedAtGTE(v time.Time) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldGTE(FieldScoreSubmittedAt, v))
+}
+
+// ScoreSubmittedAtLT applies the LT predicate on the "score_submitted_at" field.
+func ScoreSubmittedAtLT(v time.Time) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLT(FieldScoreSubmittedAt, v))
+}
+
+// ScoreSubmittedAtLTE applies the LTE predicate on the "score_submitted_at" field.
+func ScoreSubmittedAtLTE(v time.Time) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldLTE(FieldScoreSubmittedAt, v))
+}
+
+// ScoreSubmittedAtIsNil applies the IsNil predicate on the "score_submitted_at" field.
+func ScoreSubmittedAtIsNil() predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldIsNull(FieldScoreSubmittedAt))
+}
+
+// ScoreSubmittedAtNotNil applies the NotNil predicate on the "score_submitted_at" field.
+func ScoreSubmittedAtNotNil() predicate.LTILaunch {
+	return predicate.LTILaunch(sql.FieldNotNull(FieldScoreSubmittedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LTILaunch) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LTILaunch) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LTILaunch) predicate.LTILaunch {
+	return predicate.LTILaunch(sql.NotPredicates(p))
+}
@@ -0,0 +1,408 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltilaunch"
+	"github.com/google/uuid"
+)
+
+// LTILaunchCreate is the builder for creating a LTILaunch entity.
+type LTILaunchCreate struct {
+	config
+	mutation *LTILaunchMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LTILaunchCreate) SetCreatedAt(v time.Time) *LTILaunchCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetPlatformID sets the "platform_id" field.
+func (_c *LTILaunchCreate) SetPlatformID(v uuid.UUID) *LTILaunchCreate {
+	_c.mutation.SetPlatformID(v)
+	return _c
+}
+
+// SetMessageType sets the "message_type" field.
+func (_c *LTILaunchCreate) SetMessageType(v int) *LTILaunchCreate {
+	_c.mutation.SetMessageType(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *LTILaunchCreate) SetSubject(v string) *LTILaunchCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LTILaunchCreate) SetUserID(v string) *LTILaunchCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_c *LTILaunchCreate) SetEpisodeID(v uuid.UUID) *LTILaunchCreate {
+	_c.mutation.SetEpisodeID(v)
+	return _c
+}
+
+// SetNillableEpisodeID sets the "episode_id" field if the given value is not nil.
+func (_c *LTILaunchCreate) SetNillableEpisodeID(v *uuid.UUID) *LTILaunchCreate {
+	if v != nil {
+		_c.SetEpisodeID(*v)
+	}
+	return _c
+}
+
+// SetResourceLinkID sets the "resource_link_id" field.
+func (_c *LTILaunchCreate) SetResourceLinkID(v string) *LTILaunchCreate {
+	_c.mutation.SetResourceLinkID(v)
+	return _c
+}
+
+// SetNillableResourceLinkID sets the "resource_link_id" field if the given value is not nil.
+func (_c *LTILaunchCreate) SetNillableResourceLinkID(v *string) *LTILaunchCreate {
+	if v != nil {
+		_c.SetResourceLinkID(*v)
+	}
+	return _c
+}
+
+// SetLineItemURL sets the "line_item_url" field.
+func (_c *LTILaunchCreate) SetLineItemURL(v string) *LTILaunchCreate {
+	_c.mutation.SetLineItemURL(v)
+	return _c
+}
+
+// SetNillableLineItemURL sets the "line_item_url" field if the given value is not nil.
+func (_c *LTILaunchCreate) SetNillableLineItemURL(v *string) *LTILaunchCreate {
+	if v != nil {
+		_c.SetLineItemURL(*v)
+	}
+	return _c
+}
+
+// SetDeepLinkReturnURL sets the "deep_link_return_url" field.
+func (_c *LTILaunchCreate) SetDeepLinkReturnURL(v string) *LTILaunchCreate {
+	_c.mutation.SetDeepLinkReturnURL(v)
+	return _c
+}
+
+// SetNillableDeepLinkReturnURL sets the "deep_link_return_url" field if the given value is not nil.
+func (_c *LTILaunchCreate) SetNillableDeepLinkReturnURL(v *string) *LTILaunchCreate {
+	if v != nil {
+		_c.SetDeepLinkReturnURL(*v)
+	}
+	return _c
+}
+
+// SetDeepLinkData sets the "deep_link_data" field.
+func (_c *LTILaunchCreate) SetDeepLinkData(v string) *LTILaunchCreate {
+	_c.mutation.SetDeepLinkData(v)
+	return _c
+}
+
+// SetNillableDeepLinkData sets the "deep_link_data" field if the given value is not nil.
+func (_c *LTILaunchCreate) SetNillableDeepLinkData(v *string) *LTILaunchCreate {
+	if v != nil {
+		_c.SetDeepLinkData(*v)
+	}
+	return _c
+}
+
+// SetScoreSubmittedAt sets the "score_submitted_at" field.
+func (_c *LTILaunchCreate) SetScoreSubmittedAt(v time.Time) *LTILaunchCreate {
+	_c.mutation.SetScoreSubmittedAt(v)
+	return _c
+}
+
+// SetNillableScoreSubmittedAt sets the "score_submitted_at" field if the given value is not nil.
+func (_c *LTILaunchCreate) SetNillableScoreSubmittedAt(v *time.Time) *LTILaunchCreate {
+	if v != nil {
+		_c.SetScoreSubmittedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LTILaunchCreate) SetID(v uuid.UUID) *LTILaunchCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LTILaunchCreate) SetNillableID(v *uuid.UUID) *LTILaunchCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the LTILaunchMutation object of the builder.
+func (_c *LTILaunchCreate) Mutation() *LTILaunchMutation {
+	return _c.mutation
+}
+
+// Save creates the LTILaunch in the database.
+func (_c *LTILaunchCreate) Save(ctx context.Context) (*LTILaunch, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LTILaunchCreate) SaveX(ctx context.Context) *LTILaunch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LTILaunchCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LTILaunchCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LTILaunchCreate) defaults() error {
+	if _, ok := _c.mutation.ResourceLinkID(); !ok {
+		v := ltilaunch.DefaultResourceLinkID
+		_c.mutation.SetResourceLinkID(v)
+	}
+	if _, ok := _c.mutation.LineItemURL(); !ok {
+		v := ltilaunch.DefaultLineItemURL
+		_c.mutation.SetLineItemURL(v)
+	}
+	if _, ok := _c.mutation.DeepLinkReturnURL(); !ok {
+		v := ltilaunch.DefaultDeepLinkReturnURL
+		_c.mutation.SetDeepLinkReturnURL(v)
+	}
+	if _, ok := _c.mutation.DeepLinkData(); !ok {
+		v := ltilaunch.DefaultDeepLinkData
+		_c.mutation.SetDeepLinkData(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if ltilaunch.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized ltilaunch.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := ltilaunch.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LTILaunchCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "LTILaunch.created_at"`)}
+	}
+	if _, ok := _c.mutation.PlatformID(); !ok {
+		return &ValidationError{Name: "platform_id", err: errors.New(`generated: missing required field "LTILaunch.platform_id"`)}
+	}
+	if _, ok := _c.mutation.MessageType(); !ok {
+		return &ValidationError{Name: "message_type", err: errors.New(`generated: missing required field "LTILaunch.message_type"`)}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`generated: missing required field "LTILaunch.subject"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "LTILaunch.user_id"`)}
+	}
+	if _, ok := _c.mutation.ResourceLinkID(); !ok {
+		return &ValidationError{Name: "resource_link_id", err: errors.New(`generated: missing required field "LTILaunch.resource_link_id"`)}
+	}
+	if _, ok := _c.mutation.LineItemURL(); !ok {
+		return &ValidationError{Name: "line_item_url", err: errors.New(`generated: missing required field "LTILaunch.line_item_url"`)}
+	}
+	if _, ok := _c.mutation.DeepLinkReturnURL(); !ok {
+		return &ValidationError{Name: "deep_link_return_url", err: errors.New(`generated: missing required field "LTILaunch.deep_link_return_url"`)}
+	}
+	if _, ok := _c.mutation.DeepLinkData(); !ok {
+		return &ValidationError{Name: "deep_link_data", err: errors.New(`generated: missing required field "LTILaunch.deep_link_data"`)}
+	}
+	return nil
+}
+
+func (_c *LTILaunchCreate) sqlSave(ctx context.Context) (*LTILaunch, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LTILaunchCreate) createSpec() (*LTILaunch, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LTILaunch{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ltilaunch.Table, sqlgraph.NewFieldSpec(ltilaunch.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ltilaunch.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.PlatformID(); ok {
+		_spec.SetField(ltilaunch.FieldPlatformID, field.TypeUUID, value)
+		_node.PlatformID = value
+	}
+	if value, ok := _c.mutation.MessageType(); ok {
+		_spec.SetField(ltilaunch.FieldMessageType, field.TypeInt, value)
+		_node.MessageType = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(ltilaunch.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(ltilaunch.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.EpisodeID(); ok {
+		_spec.SetField(ltilaunch.FieldEpisodeID, field.TypeUUID, value)
+		_node.EpisodeID = value
+	}
+	if value, ok := _c.mutation.ResourceLinkID(); ok {
+		_spec.SetField(ltilaunch.FieldResourceLinkID, field.TypeString, value)
+		_node.ResourceLinkID = value
+	}
+	if value, ok := _c.mutation.LineItemURL(); ok {
+		_spec.SetField(ltilaunch.FieldLineItemURL, field.TypeString, value)
+		_node.LineItemURL = value
+	}
+	if value, ok := _c.mutation.DeepLinkReturnURL(); ok {
+		_spec.SetField(ltilaunch.FieldDeepLinkReturnURL, field.TypeString, value)
+		_node.DeepLinkReturnURL = value
+	}
+	if value, ok := _c.mutation.DeepLinkData(); ok {
+		_spec.SetField(ltilaunch.FieldDeepLinkData, field.TypeString, value)
+		_node.DeepLinkData = value
+	}
+	if value, ok := _c.mutation.ScoreSubmittedAt(); ok {
+		_spec.SetField(ltilaunch.FieldScoreSubmittedAt, field.TypeTime, value)
+		_node.ScoreSubmittedAt = &value
+	}
+	return _node, _spec
+}
+
+// LTILaunchCreateBulk is the builder for creating many LTILaunch entities in bulk.
+type LTILaunchCreateBulk struct {
+	config
+	err      error
+	builders []*LTILaunchCreate
+}
+
+// Save creates the LTILaunch entities in the database.
+func (_c *LTILaunchCreateBulk) Save(ctx context.Context) ([]*LTILaunch, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LTILaunch, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LTILaunchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LTILaunchCreateBulk) SaveX(ctx context.Context) []*LTILaunch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LTILaunchCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LTILaunchCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
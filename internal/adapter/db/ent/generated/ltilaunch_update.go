@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltilaunch"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+)
+
+// LTILaunchUpdate is the builder for updating LTILaunch entities.
+type LTILaunchUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LTILaunchMutation
+}
+
+// Where appends a list predicates to the LTILaunchUpdate builder.
+func (_u *LTILaunchUpdate) Where(ps ...predicate.LTILaunch) *LTILaunchUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetScoreSubmittedAt sets the "score_submitted_at" field.
+func (_u *LTILaunchUpdate) SetScoreSubmittedAt(v time.Time) *LTILaunchUpdate {
+	_u.mutation.SetScoreSubmittedAt(v)
+	return _u
+}
+
+// SetNillableScoreSubmittedAt sets the "score_submitted_at" field if the given value is not nil.
+func (_u *LTILaunchUpdate) SetNillableScoreSubmittedAt(v *time.Time) *LTILaunchUpdate {
+	if v != nil {
+		_u.SetScoreSubmittedAt(*v)
+	}
+	return _u
+}
+
+// ClearScoreSubmittedAt clears the value of the "score_submitted_at" field.
+func (_u *LTILaunchUpdate) ClearScoreSubmittedAt() *LTILaunchUpdate {
+	_u.mutation.ClearScoreSubmittedAt()
+	return _u
+}
+
+// Mutation returns the LTILaunchMutation object of the builder.
+func (_u *LTILaunchUpdate) Mutation() *LTILaunchMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LTILaunchUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LTILaunchUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LTILaunchUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LTILaunchUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *LTILaunchUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ltilaunch.Table, ltilaunch.Columns, sqlgraph.NewFieldSpec(ltilaunch.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.EpisodeIDCleared() {
+		_spec.ClearField(ltilaunch.FieldEpisodeID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ScoreSubmittedAt(); ok {
+		_spec.SetField(ltilaunch.FieldScoreSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ScoreSubmittedAtCleared() {
+		_spec.ClearField(ltilaunch.FieldScoreSubmittedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ltilaunch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LTILaunchUpdateOne is the builder for updating a single LTILaunch entity.
+type LTILaunchUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LTILaunchMutation
+}
+
+// SetScoreSubmittedAt sets the "score_submitted_at" field.
+func (_u *LTILaunchUpdateOne) SetScoreSubmittedAt(v time.Time) *LTILaunchUpdateOne {
+	_u.mutation.SetScoreSubmittedAt(v)
+	return _u
+}
+
+// SetNillableScoreSubmittedAt sets the "score_submitted_at" field if the given value is not nil.
+func (_u *LTILaunchUpdateOne) SetNillableScoreSubmittedAt(v *time.Time) *LTILaunchUpdateOne {
+	if v != nil {
+		_u.SetScoreSubmittedAt(*v)
+	}
+	return _u
+}
+
+// ClearScoreSubmittedAt clears the value of the "score_submitted_at" field.
+func (_u *LTILaunchUpdateOne) ClearScoreSubmittedAt() *LTILaunchUpdateOne {
+	_u.mutation.ClearScoreSubmittedAt()
+	return _u
+}
+
+// Mutation returns the LTILaunchMutation object of the builder.
+func (_u *LTILaunchUpdateOne) Mutation() *LTILaunchMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LTILaunchUpdate builder.
+func (_u *LTILaunchUpdateOne) Where(ps ...predicate.LTILaunch) *LTILaunchUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LTILaunchUpdateOne) Select(field string, fields ...string) *LTILaunchUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LTILaunch entity.
+func (_u *LTILaunchUpdateOne) Save(ctx context.Context) (*LTILaunch, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LTILaunchUpdateOne) SaveX(ctx context.Context) *LTILaunch {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LTILaunchUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LTILaunchUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *LTILaunchUpdateOne) sqlSave(ctx context.Context) (_node *LTILaunch, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ltilaunch.Table, ltilaunch.Columns, sqlgraph.NewFieldSpec(ltilaunch.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "LTILaunch.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ltilaunch.FieldID)
+		for _, f := range fields {
+			if !ltilaunch.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != ltilaunch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.EpisodeIDCleared() {
+		_spec.ClearField(ltilaunch.FieldEpisodeID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ScoreSubmittedAt(); ok {
+		_spec.SetField(ltilaunch.FieldScoreSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ScoreSubmittedAtCleared() {
+		_spec.ClearField(ltilaunch.FieldScoreSubmittedAt, field.TypeTime)
+	}
+	_node = &LTILaunch{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ltilaunch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,142 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltiloginstate"
+	"github.com/google/uuid"
+)
+
+// LTILoginState is the model entity for the LTILoginState schema.
+type LTILoginState struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// State holds the value of the "state" field.
+	State string `json:"state,omitempty"`
+	// Nonce holds the value of the "nonce" field.
+	Nonce string `json:"nonce,omitempty"`
+	// PlatformID holds the value of the "platform_id" field.
+	PlatformID uuid.UUID `json:"platform_id,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LTILoginState) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ltiloginstate.FieldID:
+			values[i] = new(sql.NullInt64)
+		case ltiloginstate.FieldState, ltiloginstate.FieldNonce:
+			values[i] = new(sql.NullString)
+		case ltiloginstate.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case ltiloginstate.FieldPlatformID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LTILoginState fields.
+func (_m *LTILoginState) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ltiloginstate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case ltiloginstate.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = value.String
+			}
+		case ltiloginstate.FieldNonce:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nonce", values[i])
+			} else if value.Valid {
+				_m.Nonce = value.String
+			}
+		case ltiloginstate.FieldPlatformID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field platform_id", values[i])
+			} else if value != nil {
+				_m.PlatformID = *value
+			}
+		case ltiloginstate.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LTILoginState.
+// This includes values selected through modifiers, order, etc.
+func (_m *LTILoginState) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LTILoginState.
+// Note that you need to call LTILoginState.Unwrap() before calling this method if this LTILoginState
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LTILoginState) Update() *LTILoginStateUpdateOne {
+	return NewLTILoginStateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LTILoginState entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LTILoginState) Unwrap() *LTILoginState {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: LTILoginState is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LTILoginState) String() string {
+	var builder strings.Builder
+	builder.WriteString("LTILoginState(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("state=")
+	builder.WriteString(_m.State)
+	builder.WriteString(", ")
+	builder.WriteString("nonce=")
+	builder.WriteString(_m.Nonce)
+	builder.WriteString(", ")
+	builder.WriteString("platform_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PlatformID))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LTILoginStates is a parsable slice of LTILoginState.
+type LTILoginStates []*LTILoginState
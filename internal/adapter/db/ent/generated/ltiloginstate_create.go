@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltiloginstate"
+	"github.com/google/uuid"
+)
+
+// LTILoginStateCreate is the builder for creating a LTILoginState entity.
+type LTILoginStateCreate struct {
+	config
+	mutation *LTILoginStateMutation
+	hooks    []Hook
+}
+
+// SetState sets the "state" field.
+func (_c *LTILoginStateCreate) SetState(v string) *LTILoginStateCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetNonce sets the "nonce" field.
+func (_c *LTILoginStateCreate) SetNonce(v string) *LTILoginStateCreate {
+	_c.mutation.SetNonce(v)
+	return _c
+}
+
+// SetPlatformID sets the "platform_id" field.
+func (_c *LTILoginStateCreate) SetPlatformID(v uuid.UUID) *LTILoginStateCreate {
+	_c.mutation.SetPlatformID(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *LTILoginStateCreate) SetExpiresAt(v time.Time) *LTILoginStateCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// Mutation returns the LTILoginStateMutation object of the builder.
+func (_c *LTILoginStateCreate) Mutation() *LTILoginStateMutation {
+	return _c.mutation
+}
+
+// Save creates the LTILoginState in the database.
+func (_c *LTILoginStateCreate) Save(ctx context.Context) (*LTILoginState, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LTILoginStateCreate) SaveX(ctx context.Context) *LTILoginState {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LTILoginStateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LTILoginStateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LTILoginStateCreate) check() error {
+	if _, ok := _c.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`generated: missing required field "LTILoginState.state"`)}
+	}
+	if _, ok := _c.mutation.Nonce(); !ok {
+		return &ValidationError{Name: "nonce", err: errors.New(`generated: missing required field "LTILoginState.nonce"`)}
+	}
+	if _, ok := _c.mutation.PlatformID(); !ok {
+		return &ValidationError{Name: "platform_id", err: errors.New(`generated: missing required field "LTILoginState.platform_id"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`generated: missing required field "LTILoginState.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *LTILoginStateCreate) sqlSave(ctx context.Context) (*LTILoginState, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LTILoginStateCreate) createSpec() (*LTILoginState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LTILoginState{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ltiloginstate.Table, sqlgraph.NewFieldSpec(ltiloginstate.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(ltiloginstate.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.Nonce(); ok {
+		_spec.SetField(ltiloginstate.FieldNonce, field.TypeString, value)
+		_node.Nonce = value
+	}
+	if value, ok := _c.mutation.PlatformID(); ok {
+		_spec.SetField(ltiloginstate.FieldPlatformID, field.TypeUUID, value)
+		_node.PlatformID = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(ltiloginstate.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// LTILoginStateCreateBulk is the builder for creating many LTILoginState entities in bulk.
+type LTILoginStateCreateBulk struct {
+	config
+	err      error
+	builders []*LTILoginStateCreate
+}
+
+// Save creates the LTILoginState entities in the database.
+func (_c *LTILoginStateCreateBulk) Save(ctx context.Context) ([]*LTILoginState, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LTILoginState, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LTILoginStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LTILoginStateCreateBulk) SaveX(ctx context.Context) []*LTILoginState {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LTILoginStateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LTILoginStateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltiplatform"
+	"github.com/google/uuid"
+)
+
+// LTIPlatform is the model entity for the LTIPlatform schema.
+type LTIPlatform struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Issuer holds the value of the "issuer" field.
+	Issuer string `json:"issuer,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID string `json:"client_id,omitempty"`
+	// DeploymentID holds the value of the "deployment_id" field.
+	DeploymentID string `json:"deployment_id,omitempty"`
+	// AuthLoginURL holds the value of the "auth_login_url" field.
+	AuthLoginURL string `json:"auth_login_url,omitempty"`
+	// AuthTokenURL holds the value of the "auth_token_url" field.
+	AuthTokenURL string `json:"auth_token_url,omitempty"`
+	// JwksURL holds the value of the "jwks_url" field.
+	JwksURL      string `json:"jwks_url,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LTIPlatform) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ltiplatform.FieldIssuer, ltiplatform.FieldClientID, ltiplatform.FieldDeploymentID, ltiplatform.FieldAuthLoginURL, ltiplatform.FieldAuthTokenURL, ltiplatform.FieldJwksURL:
+			values[i] = new(sql.NullString)
+		case ltiplatform.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case ltiplatform.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LTIPlatform fields.
+func (_m *LTIPlatform) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ltiplatform.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case ltiplatform.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case ltiplatform.FieldIssuer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field issuer", values[i])
+			} else if value.Valid {
+				_m.Issuer = value.String
+			}
+		case ltiplatform.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				_m.ClientID = value.String
+			}
+		case ltiplatform.FieldDeploymentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deployment_id", values[i])
+			} else if value.Valid {
+				_m.DeploymentID = value.String
+			}
+		case ltiplatform.FieldAuthLoginURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_login_url", values[i])
+			} else if value.Valid {
+				_m.AuthLoginURL = value.String
+			}
+		case ltiplatform.FieldAuthTokenURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_token_url", values[i])
+			} else if value.Valid {
+				_m.AuthTokenURL = value.String
+			}
+		case ltiplatform.FieldJwksURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field jwks_url", values[i])
+			} else if value.Valid {
+				_m.JwksURL = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LTIPlatform.
+// This includes values selected through modifiers, order, etc.
+func (_m *LTIPlatform) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LTIPlatform.
+// Note that you need to call LTIPlatform.Unwrap() before calling this method if this LTIPlatform
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LTIPlatform) Update() *LTIPlatformUpdateOne {
+	return NewLTIPlatformClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LTIPlatform entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LTIPlatform) Unwrap() *LTIPlatform {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: LTIPlatform is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LTIPlatform) String() string {
+	var builder strings.Builder
+	builder.WriteString("LTIPlatform(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("issuer=")
+	builder.WriteString(_m.Issuer)
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(_m.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("deployment_id=")
+	builder.WriteString(_m.DeploymentID)
+	builder.WriteString(", ")
+	builder.WriteString("auth_login_url=")
+	builder.WriteString(_m.AuthLoginURL)
+	builder.WriteString(", ")
+	builder.WriteString("auth_token_url=")
+	builder.WriteString(_m.AuthTokenURL)
+	builder.WriteString(", ")
+	builder.WriteString("jwks_url=")
+	builder.WriteString(_m.JwksURL)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LTIPlatforms is a parsable slice of LTIPlatform.
+type LTIPlatforms []*LTIPlatform
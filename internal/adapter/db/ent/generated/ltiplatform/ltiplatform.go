@@ -0,0 +1,110 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ltiplatform
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the ltiplatform type in the database.
+	Label = "lti_platform"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldIssuer holds the string denoting the issuer field in the database.
+	FieldIssuer = "issuer"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldDeploymentID holds the string denoting the deployment_id field in the database.
+	FieldDeploymentID = "deployment_id"
+	// FieldAuthLoginURL holds the string denoting the auth_login_url field in the database.
+	FieldAuthLoginURL = "auth_login_url"
+	// FieldAuthTokenURL holds the string denoting the auth_token_url field in the database.
+	FieldAuthTokenURL = "auth_token_url"
+	// FieldJwksURL holds the string denoting the jwks_url field in the database.
+	FieldJwksURL = "jwks_url"
+	// Table holds the table name of the ltiplatform in the database.
+	Table = "lti_platforms"
+)
+
+// Columns holds all SQL columns for ltiplatform fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldIssuer,
+	FieldClientID,
+	FieldDeploymentID,
+	FieldAuthLoginURL,
+	FieldAuthTokenURL,
+	FieldJwksURL,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eslsoft/lession/internal/adapter/db/ent/generated/runtime"
+var (
+	Hooks [1]ent.Hook
+	// DefaultDeploymentID holds the default value on creation for the "deployment_id" field.
+	DefaultDeploymentID string
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the LTIPlatform queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByIssuer orders the results by the issuer field.
+func ByIssuer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssuer, opts...).ToFunc()
+}
+
+// ByClientID orders the results by the client_id field.
+func ByClientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientID, opts...).ToFunc()
+}
+
+// ByDeploymentID orders the results by the deployment_id field.
+func ByDeploymentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeploymentID, opts...).ToFunc()
+}
+
+// ByAuthLoginURL orders the results by the auth_login_url field.
+func ByAuthLoginURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthLoginURL, opts...).ToFunc()
+}
+
+// ByAuthTokenURL orders the results by the auth_token_url field.
+func ByAuthTokenURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthTokenURL, opts...).ToFunc()
+}
+
+// ByJwksURL orders the results by the jwks_url field.
+func ByJwksURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJwksURL, opts...).ToFunc()
+}
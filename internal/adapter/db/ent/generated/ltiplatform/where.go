@@ -0,0 +1,536 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ltiplatform
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// Issuer applies equality check predicate on the "issuer" field. It's identical to IssuerEQ.
+func Issuer(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEQ(FieldIssuer, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEQ(FieldClientID, v))
+}
+
+// DeploymentID applies equality check predicate on the "deployment_id" field. It's identical to DeploymentIDEQ.
+func DeploymentID(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEQ(FieldDeploymentID, v))
+}
+
+// AuthLoginURL applies equality check predicate on the "auth_login_url" field. It's identical to AuthLoginURLEQ.
+func AuthLoginURL(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEQ(FieldAuthLoginURL, v))
+}
+
+// AuthTokenURL applies equality check predicate on the "auth_token_url" field. It's identical to AuthTokenURLEQ.
+func AuthTokenURL(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEQ(FieldAuthTokenURL, v))
+}
+
+// JwksURL applies equality check predicate on the "jwks_url" field. It's identical to JwksURLEQ.
+func JwksURL(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEQ(FieldJwksURL, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// IssuerEQ applies the EQ predicate on the "issuer" field.
+func IssuerEQ(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEQ(FieldIssuer, v))
+}
+
+// IssuerNEQ applies the NEQ predicate on the "issuer" field.
+func IssuerNEQ(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldNEQ(FieldIssuer, v))
+}
+
+// IssuerIn applies the In predicate on the "issuer" field.
+func IssuerIn(vs ...string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldIn(FieldIssuer, vs...))
+}
+
+// IssuerNotIn applies the NotIn predicate on the "issuer" field.
+func IssuerNotIn(vs ...string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldNotIn(FieldIssuer, vs...))
+}
+
+// IssuerGT applies the GT predicate on the "issuer" field.
+func IssuerGT(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldGT(FieldIssuer, v))
+}
+
+// IssuerGTE applies the GTE predicate on the "issuer" field.
+func IssuerGTE(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldGTE(FieldIssuer, v))
+}
+
+// IssuerLT applies the LT predicate on the "issuer" field.
+func IssuerLT(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldLT(FieldIssuer, v))
+}
+
+// IssuerLTE applies the LTE predicate on the "issuer" field.
+func IssuerLTE(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldLTE(FieldIssuer, v))
+}
+
+// IssuerContains applies the Contains predicate on the "issuer" field.
+func IssuerContains(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldContains(FieldIssuer, v))
+}
+
+// IssuerHasPrefix applies the HasPrefix predicate on the "issuer" field.
+func IssuerHasPrefix(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldHasPrefix(FieldIssuer, v))
+}
+
+// IssuerHasSuffix applies the HasSuffix predicate on the "issuer" field.
+func IssuerHasSuffix(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldHasSuffix(FieldIssuer, v))
+}
+
+// IssuerEqualFold applies the EqualFold predicate on the "issuer" field.
+func IssuerEqualFold(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEqualFold(FieldIssuer, v))
+}
+
+// IssuerContainsFold applies the ContainsFold predicate on the "issuer" field.
+func IssuerContainsFold(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldContainsFold(FieldIssuer, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// DeploymentIDEQ applies the EQ predicate on the "deployment_id" field.
+func DeploymentIDEQ(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEQ(FieldDeploymentID, v))
+}
+
+// DeploymentIDNEQ applies the NEQ predicate on the "deployment_id" field.
+func DeploymentIDNEQ(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldNEQ(FieldDeploymentID, v))
+}
+
+// DeploymentIDIn applies the In predicate on the "deployment_id" field.
+func DeploymentIDIn(vs ...string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldIn(FieldDeploymentID, vs...))
+}
+
+// DeploymentIDNotIn applies the NotIn predicate on the "deployment_id" field.
+func DeploymentIDNotIn(vs ...string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldNotIn(FieldDeploymentID, vs...))
+}
+
+// DeploymentIDGT applies the GT predicate on the "deployment_id" field.
+func DeploymentIDGT(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldGT(FieldDeploymentID, v))
+}
+
+// DeploymentIDGTE applies the GTE predicate on the "deployment_id" field.
+func DeploymentIDGTE(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldGTE(FieldDeploymentID, v))
+}
+
+// DeploymentIDLT applies the LT predicate on the "deployment_id" field.
+func DeploymentIDLT(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldLT(FieldDeploymentID, v))
+}
+
+// DeploymentIDLTE applies the LTE predicate on the "deployment_id" field.
+func DeploymentIDLTE(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldLTE(FieldDeploymentID, v))
+}
+
+// DeploymentIDContains applies the Contains predicate on the "deployment_id" field.
+func DeploymentIDContains(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldContains(FieldDeploymentID, v))
+}
+
+// DeploymentIDHasPrefix applies the HasPrefix predicate on the "deployment_id" field.
+func DeploymentIDHasPrefix(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldHasPrefix(FieldDeploymentID, v))
+}
+
+// DeploymentIDHasSuffix applies the HasSuffix predicate on the "deployment_id" field.
+func DeploymentIDHasSuffix(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldHasSuffix(FieldDeploymentID, v))
+}
+
+// DeploymentIDEqualFold applies the EqualFold predicate on the "deployment_id" field.
+func DeploymentIDEqualFold(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEqualFold(FieldDeploymentID, v))
+}
+
+// DeploymentIDContainsFold applies the ContainsFold predicate on the "deployment_id" field.
+func DeploymentIDContainsFold(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldContainsFold(FieldDeploymentID, v))
+}
+
+// AuthLoginURLEQ applies the EQ predicate on the "auth_login_url" field.
+func AuthLoginURLEQ(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEQ(FieldAuthLoginURL, v))
+}
+
+// AuthLoginURLNEQ applies the NEQ predicate on the "auth_login_url" field.
+func AuthLoginURLNEQ(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldNEQ(FieldAuthLoginURL, v))
+}
+
+// AuthLoginURLIn applies the In predicate on the "auth_login_url" field.
+func AuthLoginURLIn(vs ...string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldIn(FieldAuthLoginURL, vs...))
+}
+
+// AuthLoginURLNotIn applies the NotIn predicate on the "auth_login_url" field.
+func AuthLoginURLNotIn(vs ...string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldNotIn(FieldAuthLoginURL, vs...))
+}
+
+// AuthLoginURLGT applies the GT predicate on the "auth_login_url" field.
+func AuthLoginURLGT(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldGT(FieldAuthLoginURL, v))
+}
+
+// AuthLoginURLGTE applies the GTE predicate on the "auth_login_url" field.
+func AuthLoginURLGTE(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldGTE(FieldAuthLoginURL, v))
+}
+
+// AuthLoginURLLT applies the LT predicate on the "auth_login_url" field.
+func AuthLoginURLLT(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldLT(FieldAuthLoginURL, v))
+}
+
+// AuthLoginURLLTE applies the LTE predicate on the "auth_login_url" field.
+func AuthLoginURLLTE(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldLTE(FieldAuthLoginURL, v))
+}
+
+// AuthLoginURLContains applies the Contains predicate on the "auth_login_url" field.
+func AuthLoginURLContains(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldContains(FieldAuthLoginURL, v))
+}
+
+// AuthLoginURLHasPrefix applies the HasPrefix predicate on the "auth_login_url" field.
+func AuthLoginURLHasPrefix(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldHasPrefix(FieldAuthLoginURL, v))
+}
+
+// AuthLoginURLHasSuffix applies the HasSuffix predicate on the "auth_login_url" field.
+func AuthLoginURLHasSuffix(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldHasSuffix(FieldAuthLoginURL, v))
+}
+
+// AuthLoginURLEqualFold applies the EqualFold predicate on the "auth_login_url" field.
+func AuthLoginURLEqualFold(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEqualFold(FieldAuthLoginURL, v))
+}
+
+// AuthLoginURLContainsFold applies the ContainsFold predicate on the "auth_login_url" field.
+func AuthLoginURLContainsFold(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldContainsFold(FieldAuthLoginURL, v))
+}
+
+// AuthTokenURLEQ applies the EQ predicate on the "auth_token_url" field.
+func AuthTokenURLEQ(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEQ(FieldAuthTokenURL, v))
+}
+
+// AuthTokenURLNEQ applies the NEQ predicate on the "auth_token_url" field.
+func AuthTokenURLNEQ(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldNEQ(FieldAuthTokenURL, v))
+}
+
+// AuthTokenURLIn applies the In predicate on the "auth_token_url" field.
+func AuthTokenURLIn(vs ...string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldIn(FieldAuthTokenURL, vs...))
+}
+
+// AuthTokenURLNotIn applies the NotIn predicate on the "auth_token_url" field.
+func AuthTokenURLNotIn(vs ...string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldNotIn(FieldAuthTokenURL, vs...))
+}
+
+// AuthTokenURLGT applies the GT predicate on the "auth_token_url" field.
+func AuthTokenURLGT(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldGT(FieldAuthTokenURL, v))
+}
+
+// AuthTokenURLGTE applies the GTE predicate on the "auth_token_url" field.
+func AuthTokenURLGTE(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldGTE(FieldAuthTokenURL, v))
+}
+
+// AuthTokenURLLT applies the LT predicate on the "auth_token_url" field.
+func AuthTokenURLLT(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldLT(FieldAuthTokenURL, v))
+}
+
+// AuthTokenURLLTE applies the LTE predicate on the "auth_token_url" field.
+func AuthTokenURLLTE(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldLTE(FieldAuthTokenURL, v))
+}
+
+// AuthTokenURLContains applies the Contains predicate on the "auth_token_url" field.
+func AuthTokenURLContains(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldContains(FieldAuthTokenURL, v))
+}
+
+// AuthTokenURLHasPrefix applies the HasPrefix predicate on the "auth_token_url" field.
+func AuthTokenURLHasPrefix(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldHasPrefix(FieldAuthTokenURL, v))
+}
+
+// AuthTokenURLHasSuffix applies the HasSuffix predicate on the "auth_token_url" field.
+func AuthTokenURLHasSuffix(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldHasSuffix(FieldAuthTokenURL, v))
+}
+
+// AuthTokenURLEqualFold applies the EqualFold predicate on the "auth_token_url" field.
+func AuthTokenURLEqualFold(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEqualFold(FieldAuthTokenURL, v))
+}
+
+// AuthTokenURLContainsFold applies the ContainsFold predicate on the "auth_token_url" field.
+func AuthTokenURLContainsFold(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldContainsFold(FieldAuthTokenURL, v))
+}
+
+// JwksURLEQ applies the EQ predicate on the "jwks_url" field.
+func JwksURLEQ(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEQ(FieldJwksURL, v))
+}
+
+// JwksURLNEQ applies the NEQ predicate on the "jwks_url" field.
+func JwksURLNEQ(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldNEQ(FieldJwksURL, v))
+}
+
+// JwksURLIn applies the In predicate on the "jwks_url" field.
+func JwksURLIn(vs ...string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldIn(FieldJwksURL, vs...))
+}
+
+// JwksURLNotIn applies the NotIn predicate on the "jwks_url" field.
+func JwksURLNotIn(vs ...string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldNotIn(FieldJwksURL, vs...))
+}
+
+// JwksURLGT applies the GT predicate on the "jwks_url" field.
+func JwksURLGT(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldGT(FieldJwksURL, v))
+}
+
+// JwksURLGTE applies the GTE predicate on the "jwks_url" field.
+func JwksURLGTE(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldGTE(FieldJwksURL, v))
+}
+
+// JwksURLLT applies the LT predicate on the "jwks_url" field.
+func JwksURLLT(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldLT(FieldJwksURL, v))
+}
+
+// JwksURLLTE applies the LTE predicate on the "jwks_url" field.
+func JwksURLLTE(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldLTE(FieldJwksURL, v))
+}
+
+// JwksURLContains applies the Contains predicate on the "jwks_url" field.
+func JwksURLContains(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldContains(FieldJwksURL, v))
+}
+
+// JwksURLHasPrefix applies the HasPrefix predicate on the "jwks_url" field.
+func JwksURLHasPrefix(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldHasPrefix(FieldJwksURL, v))
+}
+
+// JwksURLHasSuffix applies the HasSuffix predicate on the "jwks_url" field.
+func JwksURLHasSuffix(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldHasSuffix(FieldJwksURL, v))
+}
+
+// JwksURLEqualFold applies the EqualFold predicate on the "jwks_url" field.
+func JwksURLEqualFold(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldEqualFold(FieldJwksURL, v))
+}
+
+// JwksURLContainsFold applies the ContainsFold predicate on the "jwks_url" field.
+func JwksURLContainsFold(v string) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.FieldContainsFold(FieldJwksURL, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LTIPlatform) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LTIPlatform) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LTIPlatform) predicate.LTIPlatform {
+	return predicate.LTIPlatform(sql.NotPredicates(p))
+}
@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltiplatform"
+	"github.com/google/uuid"
+)
+
+// LTIPlatformCreate is the builder for creating a LTIPlatform entity.
+type LTIPlatformCreate struct {
+	config
+	mutation *LTIPlatformMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LTIPlatformCreate) SetCreatedAt(v time.Time) *LTIPlatformCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetIssuer sets the "issuer" field.
+func (_c *LTIPlatformCreate) SetIssuer(v string) *LTIPlatformCreate {
+	_c.mutation.SetIssuer(v)
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *LTIPlatformCreate) SetClientID(v string) *LTIPlatformCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetDeploymentID sets the "deployment_id" field.
+func (_c *LTIPlatformCreate) SetDeploymentID(v string) *LTIPlatformCreate {
+	_c.mutation.SetDeploymentID(v)
+	return _c
+}
+
+// SetNillableDeploymentID sets the "deployment_id" field if the given value is not nil.
+func (_c *LTIPlatformCreate) SetNillableDeploymentID(v *string) *LTIPlatformCreate {
+	if v != nil {
+		_c.SetDeploymentID(*v)
+	}
+	return _c
+}
+
+// SetAuthLoginURL sets the "auth_login_url" field.
+func (_c *LTIPlatformCreate) SetAuthLoginURL(v string) *LTIPlatformCreate {
+	_c.mutation.SetAuthLoginURL(v)
+	return _c
+}
+
+// SetAuthTokenURL sets the "auth_token_url" field.
+func (_c *LTIPlatformCreate) SetAuthTokenURL(v string) *LTIPlatformCreate {
+	_c.mutation.SetAuthTokenURL(v)
+	return _c
+}
+
+// SetJwksURL sets the "jwks_url" field.
+func (_c *LTIPlatformCreate) SetJwksURL(v string) *LTIPlatformCreate {
+	_c.mutation.SetJwksURL(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LTIPlatformCreate) SetID(v uuid.UUID) *LTIPlatformCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LTIPlatformCreate) SetNillableID(v *uuid.UUID) *LTIPlatformCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the LTIPlatformMutation object of the builder.
+func (_c *LTIPlatformCreate) Mutation() *LTIPlatformMutation {
+	return _c.mutation
+}
+
+// Save creates the LTIPlatform in the database.
+func (_c *LTIPlatformCreate) Save(ctx context.Context) (*LTIPlatform, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LTIPlatformCreate) SaveX(ctx context.Context) *LTIPlatform {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LTIPlatformCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LTIPlatformCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LTIPlatformCreate) defaults() error {
+	if _, ok := _c.mutation.DeploymentID(); !ok {
+		v := ltiplatform.DefaultDeploymentID
+		_c.mutation.SetDeploymentID(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if ltiplatform.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized ltiplatform.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := ltiplatform.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LTIPlatformCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "LTIPlatform.created_at"`)}
+	}
+	if _, ok := _c.mutation.Issuer(); !ok {
+		return &ValidationError{Name: "issuer", err: errors.New(`generated: missing required field "LTIPlatform.issuer"`)}
+	}
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`generated: missing required field "LTIPlatform.client_id"`)}
+	}
+	if _, ok := _c.mutation.DeploymentID(); !ok {
+		return &ValidationError{Name: "deployment_id", err: errors.New(`generated: missing required field "LTIPlatform.deployment_id"`)}
+	}
+	if _, ok := _c.mutation.AuthLoginURL(); !ok {
+		return &ValidationError{Name: "auth_login_url", err: errors.New(`generated: missing required field "LTIPlatform.auth_login_url"`)}
+	}
+	if _, ok := _c.mutation.AuthTokenURL(); !ok {
+		return &ValidationError{Name: "auth_token_url", err: errors.New(`generated: missing required field "LTIPlatform.auth_token_url"`)}
+	}
+	if _, ok := _c.mutation.JwksURL(); !ok {
+		return &ValidationError{Name: "jwks_url", err: errors.New(`generated: missing required field "LTIPlatform.jwks_url"`)}
+	}
+	return nil
+}
+
+func (_c *LTIPlatformCreate) sqlSave(ctx context.Context) (*LTIPlatform, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LTIPlatformCreate) createSpec() (*LTIPlatform, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LTIPlatform{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ltiplatform.Table, sqlgraph.NewFieldSpec(ltiplatform.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ltiplatform.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Issuer(); ok {
+		_spec.SetField(ltiplatform.FieldIssuer, field.TypeString, value)
+		_node.Issuer = value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(ltiplatform.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.DeploymentID(); ok {
+		_spec.SetField(ltiplatform.FieldDeploymentID, field.TypeString, value)
+		_node.DeploymentID = value
+	}
+	if value, ok := _c.mutation.AuthLoginURL(); ok {
+		_spec.SetField(ltiplatform.FieldAuthLoginURL, field.TypeString, value)
+		_node.AuthLoginURL = value
+	}
+	if value, ok := _c.mutation.AuthTokenURL(); ok {
+		_spec.SetField(ltiplatform.FieldAuthTokenURL, field.TypeString, value)
+		_node.AuthTokenURL = value
+	}
+	if value, ok := _c.mutation.JwksURL(); ok {
+		_spec.SetField(ltiplatform.FieldJwksURL, field.TypeString, value)
+		_node.JwksURL = value
+	}
+	return _node, _spec
+}
+
+// LTIPlatformCreateBulk is the builder for creating many LTIPlatform entities in bulk.
+type LTIPlatformCreateBulk struct {
+	config
+	err      error
+	builders []*LTIPlatformCreate
+}
+
+// Save creates the LTIPlatform entities in the database.
+func (_c *LTIPlatformCreateBulk) Save(ctx context.Context) ([]*LTIPlatform, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LTIPlatform, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LTIPlatformMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LTIPlatformCreateBulk) SaveX(ctx context.Context) []*LTIPlatform {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LTIPlatformCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LTIPlatformCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,311 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltiplatform"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+)
+
+// LTIPlatformUpdate is the builder for updating LTIPlatform entities.
+type LTIPlatformUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LTIPlatformMutation
+}
+
+// Where appends a list predicates to the LTIPlatformUpdate builder.
+func (_u *LTIPlatformUpdate) Where(ps ...predicate.LTIPlatform) *LTIPlatformUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDeploymentID sets the "deployment_id" field.
+func (_u *LTIPlatformUpdate) SetDeploymentID(v string) *LTIPlatformUpdate {
+	_u.mutation.SetDeploymentID(v)
+	return _u
+}
+
+// SetNillableDeploymentID sets the "deployment_id" field if the given value is not nil.
+func (_u *LTIPlatformUpdate) SetNillableDeploymentID(v *string) *LTIPlatformUpdate {
+	if v != nil {
+		_u.SetDeploymentID(*v)
+	}
+	return _u
+}
+
+// SetAuthLoginURL sets the "auth_login_url" field.
+func (_u *LTIPlatformUpdate) SetAuthLoginURL(v string) *LTIPlatformUpdate {
+	_u.mutation.SetAuthLoginURL(v)
+	return _u
+}
+
+// SetNillableAuthLoginURL sets the "auth_login_url" field if the given value is not nil.
+func (_u *LTIPlatformUpdate) SetNillableAuthLoginURL(v *string) *LTIPlatformUpdate {
+	if v != nil {
+		_u.SetAuthLoginURL(*v)
+	}
+	return _u
+}
+
+// SetAuthTokenURL sets the "auth_token_url" field.
+func (_u *LTIPlatformUpdate) SetAuthTokenURL(v string) *LTIPlatformUpdate {
+	_u.mutation.SetAuthTokenURL(v)
+	return _u
+}
+
+// SetNillableAuthTokenURL sets the "auth_token_url" field if the given value is not nil.
+func (_u *LTIPlatformUpdate) SetNillableAuthTokenURL(v *string) *LTIPlatformUpdate {
+	if v != nil {
+		_u.SetAuthTokenURL(*v)
+	}
+	return _u
+}
+
+// SetJwksURL sets the "jwks_url" field.
+func (_u *LTIPlatformUpdate) SetJwksURL(v string) *LTIPlatformUpdate {
+	_u.mutation.SetJwksURL(v)
+	return _u
+}
+
+// SetNillableJwksURL sets the "jwks_url" field if the given value is not nil.
+func (_u *LTIPlatformUpdate) SetNillableJwksURL(v *string) *LTIPlatformUpdate {
+	if v != nil {
+		_u.SetJwksURL(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LTIPlatformMutation object of the builder.
+func (_u *LTIPlatformUpdate) Mutation() *LTIPlatformMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LTIPlatformUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LTIPlatformUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LTIPlatformUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LTIPlatformUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *LTIPlatformUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ltiplatform.Table, ltiplatform.Columns, sqlgraph.NewFieldSpec(ltiplatform.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeploymentID(); ok {
+		_spec.SetField(ltiplatform.FieldDeploymentID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AuthLoginURL(); ok {
+		_spec.SetField(ltiplatform.FieldAuthLoginURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AuthTokenURL(); ok {
+		_spec.SetField(ltiplatform.FieldAuthTokenURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.JwksURL(); ok {
+		_spec.SetField(ltiplatform.FieldJwksURL, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ltiplatform.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LTIPlatformUpdateOne is the builder for updating a single LTIPlatform entity.
+type LTIPlatformUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LTIPlatformMutation
+}
+
+// SetDeploymentID sets the "deployment_id" field.
+func (_u *LTIPlatformUpdateOne) SetDeploymentID(v string) *LTIPlatformUpdateOne {
+	_u.mutation.SetDeploymentID(v)
+	return _u
+}
+
+// SetNillableDeploymentID sets the "deployment_id" field if the given value is not nil.
+func (_u *LTIPlatformUpdateOne) SetNillableDeploymentID(v *string) *LTIPlatformUpdateOne {
+	if v != nil {
+		_u.SetDeploymentID(*v)
+	}
+	return _u
+}
+
+// SetAuthLoginURL sets the "auth_login_url" field.
+func (_u *LTIPlatformUpdateOne) SetAuthLoginURL(v string) *LTIPlatformUpdateOne {
+	_u.mutation.SetAuthLoginURL(v)
+	return _u
+}
+
+// SetNillableAuthLoginURL sets the "auth_login_url" field if the given value is not nil.
+func (_u *LTIPlatformUpdateOne) SetNillableAuthLoginURL(v *string) *LTIPlatformUpdateOne {
+	if v != nil {
+		_u.SetAuthLoginURL(*v)
+	}
+	return _u
+}
+
+// SetAuthTokenURL sets the "auth_token_url" field.
+func (_u *LTIPlatformUpdateOne) SetAuthTokenURL(v string) *LTIPlatformUpdateOne {
+	_u.mutation.SetAuthTokenURL(v)
+	return _u
+}
+
+// SetNillableAuthTokenURL sets the "auth_token_url" field if the given value is not nil.
+func (_u *LTIPlatformUpdateOne) SetNillableAuthTokenURL(v *string) *LTIPlatformUpdateOne {
+	if v != nil {
+		_u.SetAuthTokenURL(*v)
+	}
+	return _u
+}
+
+// SetJwksURL sets the "jwks_url" field.
+func (_u *LTIPlatformUpdateOne) SetJwksURL(v string) *LTIPlatformUpdateOne {
+	_u.mutation.SetJwksURL(v)
+	return _u
+}
+
+// SetNillableJwksURL sets the "jwks_url" field if the given value is not nil.
+func (_u *LTIPlatformUpdateOne) SetNillableJwksURL(v *string) *LTIPlatformUpdateOne {
+	if v != nil {
+		_u.SetJwksURL(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LTIPlatformMutation object of the builder.
+func (_u *LTIPlatformUpdateOne) Mutation() *LTIPlatformMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LTIPlatformUpdate builder.
+func (_u *LTIPlatformUpdateOne) Where(ps ...predicate.LTIPlatform) *LTIPlatformUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LTIPlatformUpdateOne) Select(field string, fields ...string) *LTIPlatformUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LTIPlatform entity.
+func (_u *LTIPlatformUpdateOne) Save(ctx context.Context) (*LTIPlatform, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LTIPlatformUpdateOne) SaveX(ctx context.Context) *LTIPlatform {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LTIPlatformUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LTIPlatformUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *LTIPlatformUpdateOne) sqlSave(ctx context.Context) (_node *LTIPlatform, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ltiplatform.Table, ltiplatform.Columns, sqlgraph.NewFieldSpec(ltiplatform.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "LTIPlatform.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ltiplatform.FieldID)
+		for _, f := range fields {
+			if !ltiplatform.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != ltiplatform.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DeploymentID(); ok {
+		_spec.SetField(ltiplatform.FieldDeploymentID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AuthLoginURL(); ok {
+		_spec.SetField(ltiplatform.FieldAuthLoginURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AuthTokenURL(); ok {
+		_spec.SetField(ltiplatform.FieldAuthTokenURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.JwksURL(); ok {
+		_spec.SetField(ltiplatform.FieldJwksURL, field.TypeString, value)
+	}
+	_node = &LTIPlatform{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ltiplatform.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
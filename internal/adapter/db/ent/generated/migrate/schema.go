@@ -3,93 +3,1081 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "hash", Type: field.TypeString, Unique: true},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikey_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[1]},
+			},
+		},
+	}
 	// AssetsColumns holds the columns for the "assets" table.
 	AssetsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
-		{Name: "asset_key", Type: field.TypeString, Unique: true},
-		{Name: "type", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "asset_key", Type: field.TypeString, Unique: true},
+		{Name: "type", Type: field.TypeInt, Default: 0},
+		{Name: "status", Type: field.TypeInt, Default: 0},
+		{Name: "original_filename", Type: field.TypeString},
+		{Name: "mime_type", Type: field.TypeString},
+		{Name: "filesize", Type: field.TypeInt64, Default: 0},
+		{Name: "duration_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "playback_url", Type: field.TypeString, Nullable: true},
+		{Name: "hls_manifest_url", Type: field.TypeString, Default: ""},
+		{Name: "hls_key_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "width", Type: field.TypeInt, Default: 0},
+		{Name: "height", Type: field.TypeInt, Default: 0},
+		{Name: "image_variants", Type: field.TypeJSON, Nullable: true},
+		{Name: "provider", Type: field.TypeString, Default: ""},
+		{Name: "failure_code", Type: field.TypeInt, Default: 0},
+		{Name: "failure_reason", Type: field.TypeString, Default: ""},
+		{Name: "ready_at", Type: field.TypeTime, Nullable: true},
+	}
+	// AssetsTable holds the schema information for the "assets" table.
+	AssetsTable = &schema.Table{
+		Name:       "assets",
+		Columns:    AssetsColumns,
+		PrimaryKey: []*schema.Column{AssetsColumns[0]},
+	}
+	// AssetUsageDaysColumns holds the columns for the "asset_usage_days" table.
+	AssetUsageDaysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "asset_id", Type: field.TypeUUID},
+		{Name: "day", Type: field.TypeTime},
+		{Name: "playback_urls_issued", Type: field.TypeInt64, Default: 0},
+		{Name: "downloads", Type: field.TypeInt64, Default: 0},
+	}
+	// AssetUsageDaysTable holds the schema information for the "asset_usage_days" table.
+	AssetUsageDaysTable = &schema.Table{
+		Name:       "asset_usage_days",
+		Columns:    AssetUsageDaysColumns,
+		PrimaryKey: []*schema.Column{AssetUsageDaysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "assetusageday_asset_id_day",
+				Unique:  true,
+				Columns: []*schema.Column{AssetUsageDaysColumns[1], AssetUsageDaysColumns[2]},
+			},
+		},
+	}
+	// AssetVersionsColumns holds the columns for the "asset_versions" table.
+	AssetVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "asset_id", Type: field.TypeUUID},
+		{Name: "asset_key", Type: field.TypeString},
+		{Name: "provider", Type: field.TypeString, Default: ""},
+		{Name: "original_filename", Type: field.TypeString},
+		{Name: "mime_type", Type: field.TypeString},
+		{Name: "filesize", Type: field.TypeInt64, Default: 0},
+		{Name: "duration_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "playback_url", Type: field.TypeString, Default: ""},
+		{Name: "hls_manifest_url", Type: field.TypeString, Default: ""},
+	}
+	// AssetVersionsTable holds the schema information for the "asset_versions" table.
+	AssetVersionsTable = &schema.Table{
+		Name:       "asset_versions",
+		Columns:    AssetVersionsColumns,
+		PrimaryKey: []*schema.Column{AssetVersionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "assetversion_asset_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AssetVersionsColumns[2], AssetVersionsColumns[1]},
+			},
+		},
+	}
+	// AuditEntriesColumns holds the columns for the "audit_entries" table.
+	AuditEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "actor_id", Type: field.TypeString, Default: ""},
+		{Name: "procedure", Type: field.TypeString, Default: ""},
+		{Name: "entity_type", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeString},
+		{Name: "action", Type: field.TypeInt},
+		{Name: "changes", Type: field.TypeBytes, Nullable: true},
+	}
+	// AuditEntriesTable holds the schema information for the "audit_entries" table.
+	AuditEntriesTable = &schema.Table{
+		Name:       "audit_entries",
+		Columns:    AuditEntriesColumns,
+		PrimaryKey: []*schema.Column{AuditEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditentry_entity_type_entity_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[4], AuditEntriesColumns[5], AuditEntriesColumns[1]},
+			},
+			{
+				Name:    "auditentry_actor_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[2], AuditEntriesColumns[1]},
+			},
+			{
+				Name:    "auditentry_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[1]},
+			},
+		},
+	}
+	// AvailabilitySlotsColumns holds the columns for the "availability_slots" table.
+	AvailabilitySlotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "teacher_id", Type: field.TypeString},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime},
+		{Name: "booked", Type: field.TypeBool, Default: false},
+	}
+	// AvailabilitySlotsTable holds the schema information for the "availability_slots" table.
+	AvailabilitySlotsTable = &schema.Table{
+		Name:       "availability_slots",
+		Columns:    AvailabilitySlotsColumns,
+		PrimaryKey: []*schema.Column{AvailabilitySlotsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "availabilityslot_teacher_id_starts_at",
+				Unique:  false,
+				Columns: []*schema.Column{AvailabilitySlotsColumns[3], AvailabilitySlotsColumns[4]},
+			},
+			{
+				Name:    "availabilityslot_starts_at",
+				Unique:  false,
+				Columns: []*schema.Column{AvailabilitySlotsColumns[4]},
+			},
+		},
+	}
+	// BookingsColumns holds the columns for the "bookings" table.
+	BookingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "teacher_id", Type: field.TypeString},
+		{Name: "learner_id", Type: field.TypeString},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime},
+		{Name: "note", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "status", Type: field.TypeInt},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancelled_by", Type: field.TypeString, Default: ""},
+		{Name: "cancel_reason", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "slot_id", Type: field.TypeUUID},
+	}
+	// BookingsTable holds the schema information for the "bookings" table.
+	BookingsTable = &schema.Table{
+		Name:       "bookings",
+		Columns:    BookingsColumns,
+		PrimaryKey: []*schema.Column{BookingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "bookings_availability_slots_bookings",
+				Columns:    []*schema.Column{BookingsColumns[12]},
+				RefColumns: []*schema.Column{AvailabilitySlotsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "booking_learner_id_starts_at",
+				Unique:  false,
+				Columns: []*schema.Column{BookingsColumns[4], BookingsColumns[5]},
+			},
+			{
+				Name:    "booking_teacher_id_starts_at",
+				Unique:  false,
+				Columns: []*schema.Column{BookingsColumns[3], BookingsColumns[5]},
+			},
+			{
+				Name:    "booking_slot_id",
+				Unique:  false,
+				Columns: []*schema.Column{BookingsColumns[12]},
+			},
+		},
+	}
+	// ClassroomsColumns holds the columns for the "classrooms" table.
+	ClassroomsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "teacher_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Size: 2147483647, Default: ""},
+	}
+	// ClassroomsTable holds the schema information for the "classrooms" table.
+	ClassroomsTable = &schema.Table{
+		Name:       "classrooms",
+		Columns:    ClassroomsColumns,
+		PrimaryKey: []*schema.Column{ClassroomsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "classroom_teacher_id_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{ClassroomsColumns[3], ClassroomsColumns[2]},
+			},
+		},
+	}
+	// ClassroomAssignmentsColumns holds the columns for the "classroom_assignments" table.
+	ClassroomAssignmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "due_at", Type: field.TypeTime},
+		{Name: "classroom_id", Type: field.TypeUUID},
+		{Name: "series_id", Type: field.TypeUUID},
+	}
+	// ClassroomAssignmentsTable holds the schema information for the "classroom_assignments" table.
+	ClassroomAssignmentsTable = &schema.Table{
+		Name:       "classroom_assignments",
+		Columns:    ClassroomAssignmentsColumns,
+		PrimaryKey: []*schema.Column{ClassroomAssignmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "classroom_assignments_classrooms_assignments",
+				Columns:    []*schema.Column{ClassroomAssignmentsColumns[3]},
+				RefColumns: []*schema.Column{ClassroomsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "classroom_assignments_series_series",
+				Columns:    []*schema.Column{ClassroomAssignmentsColumns[4]},
+				RefColumns: []*schema.Column{SeriesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "classroomassignment_classroom_id_series_id",
+				Unique:  true,
+				Columns: []*schema.Column{ClassroomAssignmentsColumns[3], ClassroomAssignmentsColumns[4]},
+			},
+		},
+	}
+	// ClassroomMembersColumns holds the columns for the "classroom_members" table.
+	ClassroomMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "joined_at", Type: field.TypeTime},
+		{Name: "classroom_id", Type: field.TypeUUID},
+	}
+	// ClassroomMembersTable holds the schema information for the "classroom_members" table.
+	ClassroomMembersTable = &schema.Table{
+		Name:       "classroom_members",
+		Columns:    ClassroomMembersColumns,
+		PrimaryKey: []*schema.Column{ClassroomMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "classroom_members_classrooms_members",
+				Columns:    []*schema.Column{ClassroomMembersColumns[3]},
+				RefColumns: []*schema.Column{ClassroomsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "classroommember_classroom_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{ClassroomMembersColumns[3], ClassroomMembersColumns[1]},
+			},
+			{
+				Name:    "classroommember_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ClassroomMembersColumns[1]},
+			},
+		},
+	}
+	// ContentEmbeddingsColumns holds the columns for the "content_embeddings" table.
+	ContentEmbeddingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeInt},
+		{Name: "series_id", Type: field.TypeUUID},
+		{Name: "episode_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "snippet", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "language", Type: field.TypeString, Default: ""},
+		{Name: "level", Type: field.TypeString, Default: ""},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "model", Type: field.TypeString},
+		{Name: "content_hash", Type: field.TypeString},
+		{Name: "vector", Type: field.TypeOther, SchemaType: map[string]string{"mysql": "json", "postgres": "vector", "sqlite3": "text"}},
+	}
+	// ContentEmbeddingsTable holds the schema information for the "content_embeddings" table.
+	ContentEmbeddingsTable = &schema.Table{
+		Name:       "content_embeddings",
+		Columns:    ContentEmbeddingsColumns,
+		PrimaryKey: []*schema.Column{ContentEmbeddingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "contentembedding_kind_language",
+				Unique:  false,
+				Columns: []*schema.Column{ContentEmbeddingsColumns[3], ContentEmbeddingsColumns[8]},
+			},
+			{
+				Name:    "contentembedding_series_id",
+				Unique:  false,
+				Columns: []*schema.Column{ContentEmbeddingsColumns[4]},
+			},
+		},
+	}
+	// ContentKeysColumns holds the columns for the "content_keys" table.
+	ContentKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "asset_id", Type: field.TypeUUID},
+		{Name: "key", Type: field.TypeBytes},
+	}
+	// ContentKeysTable holds the schema information for the "content_keys" table.
+	ContentKeysTable = &schema.Table{
+		Name:       "content_keys",
+		Columns:    ContentKeysColumns,
+		PrimaryKey: []*schema.Column{ContentKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "contentkey_asset_id",
+				Unique:  false,
+				Columns: []*schema.Column{ContentKeysColumns[2]},
+			},
+		},
+	}
+	// ContentReassignmentsColumns holds the columns for the "content_reassignments" table.
+	ContentReassignmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "from_author_id", Type: field.TypeString},
+		{Name: "to_author_id", Type: field.TypeString},
+		{Name: "series_ids", Type: field.TypeJSON, Nullable: true},
+	}
+	// ContentReassignmentsTable holds the schema information for the "content_reassignments" table.
+	ContentReassignmentsTable = &schema.Table{
+		Name:       "content_reassignments",
+		Columns:    ContentReassignmentsColumns,
+		PrimaryKey: []*schema.Column{ContentReassignmentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "contentreassignment_from_author_id",
+				Unique:  false,
+				Columns: []*schema.Column{ContentReassignmentsColumns[2]},
+			},
+			{
+				Name:    "contentreassignment_to_author_id",
+				Unique:  false,
+				Columns: []*schema.Column{ContentReassignmentsColumns[3]},
+			},
+		},
+	}
+	// DeviceTokensColumns holds the columns for the "device_tokens" table.
+	DeviceTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "platform", Type: field.TypeInt, Default: 0},
+	}
+	// DeviceTokensTable holds the schema information for the "device_tokens" table.
+	DeviceTokensTable = &schema.Table{
+		Name:       "device_tokens",
+		Columns:    DeviceTokensColumns,
+		PrimaryKey: []*schema.Column{DeviceTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "devicetoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceTokensColumns[4]},
+			},
+		},
+	}
+	// DictationAttemptsColumns holds the columns for the "dictation_attempts" table.
+	DictationAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "episode_id", Type: field.TypeUUID},
+		{Name: "item_index", Type: field.TypeInt},
+		{Name: "answer", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "expected", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "score", Type: field.TypeFloat64, Default: 0},
+		{Name: "feedback", Type: field.TypeJSON, Nullable: true},
+	}
+	// DictationAttemptsTable holds the schema information for the "dictation_attempts" table.
+	DictationAttemptsTable = &schema.Table{
+		Name:       "dictation_attempts",
+		Columns:    DictationAttemptsColumns,
+		PrimaryKey: []*schema.Column{DictationAttemptsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dictationattempt_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DictationAttemptsColumns[2], DictationAttemptsColumns[1]},
+			},
+			{
+				Name:    "dictationattempt_episode_id",
+				Unique:  false,
+				Columns: []*schema.Column{DictationAttemptsColumns[3]},
+			},
+		},
+	}
+	// EngagementRollupsColumns holds the columns for the "engagement_rollups" table.
+	EngagementRollupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "subject_id", Type: field.TypeUUID},
+		{Name: "series_id", Type: field.TypeUUID},
+		{Name: "day", Type: field.TypeTime, Nullable: true},
+		{Name: "sessions", Type: field.TypeInt, Default: 0},
+		{Name: "finished_sessions", Type: field.TypeInt, Default: 0},
+		{Name: "unique_listeners", Type: field.TypeInt, Default: 0},
+		{Name: "completers", Type: field.TypeInt, Default: 0},
+	}
+	// EngagementRollupsTable holds the schema information for the "engagement_rollups" table.
+	EngagementRollupsTable = &schema.Table{
+		Name:       "engagement_rollups",
+		Columns:    EngagementRollupsColumns,
+		PrimaryKey: []*schema.Column{EngagementRollupsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "engagementrollup_subject_id_day",
+				Unique:  false,
+				Columns: []*schema.Column{EngagementRollupsColumns[3], EngagementRollupsColumns[5]},
+			},
+		},
+	}
+	// EpisodesColumns holds the columns for the "episodes" table.
+	EpisodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "seq", Type: field.TypeUint32},
+		{Name: "title", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "duration_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "duration_from_asset", Type: field.TypeBool, Default: false},
+		{Name: "status", Type: field.TypeInt, Default: 0},
+		{Name: "preview", Type: field.TypeBool, Default: false},
+		{Name: "resource_asset_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "resource_type", Type: field.TypeInt, Default: 0},
+		{Name: "resource_playback_url", Type: field.TypeString, Default: ""},
+		{Name: "resource_mime_type", Type: field.TypeString, Default: ""},
+		{Name: "transcript_language", Type: field.TypeString, Default: ""},
+		{Name: "transcript_format", Type: field.TypeInt, Default: 0},
+		{Name: "transcript_content", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "transcript_findings", Type: field.TypeJSON, Nullable: true},
+		{Name: "transcript_offloaded", Type: field.TypeBool, Default: false},
+		{Name: "estimated_level", Type: field.TypeString, Default: ""},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "series_id", Type: field.TypeUUID},
+	}
+	// EpisodesTable holds the schema information for the "episodes" table.
+	EpisodesTable = &schema.Table{
+		Name:       "episodes",
+		Columns:    EpisodesColumns,
+		PrimaryKey: []*schema.Column{EpisodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "episodes_series_episodes",
+				Columns:    []*schema.Column{EpisodesColumns[22]},
+				RefColumns: []*schema.Column{SeriesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "episode_series_id_seq",
+				Unique:  true,
+				Columns: []*schema.Column{EpisodesColumns[22], EpisodesColumns[4]},
+			},
+			{
+				Name:    "episode_series_id",
+				Unique:  false,
+				Columns: []*schema.Column{EpisodesColumns[22]},
+			},
+		},
+	}
+	// EpisodeTranscriptsColumns holds the columns for the "episode_transcripts" table.
+	EpisodeTranscriptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "content", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "episode_id", Type: field.TypeUUID, Unique: true},
+	}
+	// EpisodeTranscriptsTable holds the schema information for the "episode_transcripts" table.
+	EpisodeTranscriptsTable = &schema.Table{
+		Name:       "episode_transcripts",
+		Columns:    EpisodeTranscriptsColumns,
+		PrimaryKey: []*schema.Column{EpisodeTranscriptsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "episode_transcripts_episodes_transcript",
+				Columns:    []*schema.Column{EpisodeTranscriptsColumns[2]},
+				RefColumns: []*schema.Column{EpisodesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// EventsColumns holds the columns for the "events" table.
+	EventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "aggregate_id", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "occurred_at", Type: field.TypeTime},
+	}
+	// EventsTable holds the schema information for the "events" table.
+	EventsTable = &schema.Table{
+		Name:       "events",
+		Columns:    EventsColumns,
+		PrimaryKey: []*schema.Column{EventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "event_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[4]},
+			},
+			{
+				Name:    "event_aggregate_id_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[2], EventsColumns[4]},
+			},
+			{
+				Name:    "event_type_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[1], EventsColumns[4]},
+			},
+		},
+	}
+	// FeedItemsColumns holds the columns for the "feed_items" table.
+	FeedItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "guid", Type: field.TypeString},
+		{Name: "episode_id", Type: field.TypeUUID},
+		{Name: "subscription_id", Type: field.TypeUUID},
+	}
+	// FeedItemsTable holds the schema information for the "feed_items" table.
+	FeedItemsTable = &schema.Table{
+		Name:       "feed_items",
+		Columns:    FeedItemsColumns,
+		PrimaryKey: []*schema.Column{FeedItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "feed_items_feed_subscriptions_items",
+				Columns:    []*schema.Column{FeedItemsColumns[5]},
+				RefColumns: []*schema.Column{FeedSubscriptionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "feeditem_subscription_id_guid",
+				Unique:  true,
+				Columns: []*schema.Column{FeedItemsColumns[5], FeedItemsColumns[3]},
+			},
+		},
+	}
+	// FeedSubscriptionsColumns holds the columns for the "feed_subscriptions" table.
+	FeedSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "series_id", Type: field.TypeUUID, Unique: true},
+		{Name: "kind", Type: field.TypeInt},
+		{Name: "source_url", Type: field.TypeString},
+		{Name: "download_media", Type: field.TypeBool, Default: false},
+		{Name: "auto_publish", Type: field.TypeBool, Default: false},
+		{Name: "last_synced_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+	}
+	// FeedSubscriptionsTable holds the schema information for the "feed_subscriptions" table.
+	FeedSubscriptionsTable = &schema.Table{
+		Name:       "feed_subscriptions",
+		Columns:    FeedSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{FeedSubscriptionsColumns[0]},
+	}
+	// InvoicesColumns holds the columns for the "invoices" table.
+	InvoicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "subscription_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "billing_provider", Type: field.TypeString},
+		{Name: "external_id", Type: field.TypeString},
+		{Name: "amount_cents", Type: field.TypeInt64, Default: 0},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "status", Type: field.TypeInt, Default: 0},
+		{Name: "period_start", Type: field.TypeTime},
+		{Name: "period_end", Type: field.TypeTime},
+		{Name: "hosted_url", Type: field.TypeString, Default: ""},
+		{Name: "paid_at", Type: field.TypeTime, Nullable: true},
+	}
+	// InvoicesTable holds the schema information for the "invoices" table.
+	InvoicesTable = &schema.Table{
+		Name:       "invoices",
+		Columns:    InvoicesColumns,
+		PrimaryKey: []*schema.Column{InvoicesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoice_billing_provider_external_id",
+				Unique:  true,
+				Columns: []*schema.Column{InvoicesColumns[5], InvoicesColumns[6]},
+			},
+			{
+				Name:    "invoice_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[4], InvoicesColumns[1]},
+			},
+		},
+	}
+	// JobsColumns holds the columns for the "jobs" table.
+	JobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeBytes, Nullable: true},
+		{Name: "status", Type: field.TypeInt},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "dedupe_key", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "run_at", Type: field.TypeTime},
+		{Name: "locked_by", Type: field.TypeString, Default: ""},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+	}
+	// JobsTable holds the schema information for the "jobs" table.
+	JobsTable = &schema.Table{
+		Name:       "jobs",
+		Columns:    JobsColumns,
+		PrimaryKey: []*schema.Column{JobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "job_status_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[5], JobsColumns[9]},
+			},
+			{
+				Name:    "job_kind_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[3], JobsColumns[1]},
+			},
+			{
+				Name:    "job_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[1]},
+			},
+		},
+	}
+	// LtiLaunchesColumns holds the columns for the "lti_launches" table.
+	LtiLaunchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "platform_id", Type: field.TypeUUID},
+		{Name: "message_type", Type: field.TypeInt},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "episode_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "resource_link_id", Type: field.TypeString, Default: ""},
+		{Name: "line_item_url", Type: field.TypeString, Default: ""},
+		{Name: "deep_link_return_url", Type: field.TypeString, Default: ""},
+		{Name: "deep_link_data", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "score_submitted_at", Type: field.TypeTime, Nullable: true},
+	}
+	// LtiLaunchesTable holds the schema information for the "lti_launches" table.
+	LtiLaunchesTable = &schema.Table{
+		Name:       "lti_launches",
+		Columns:    LtiLaunchesColumns,
+		PrimaryKey: []*schema.Column{LtiLaunchesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ltilaunch_platform_id_subject",
+				Unique:  false,
+				Columns: []*schema.Column{LtiLaunchesColumns[2], LtiLaunchesColumns[4]},
+			},
+		},
+	}
+	// LtiLoginStatesColumns holds the columns for the "lti_login_states" table.
+	LtiLoginStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "state", Type: field.TypeString, Unique: true},
+		{Name: "nonce", Type: field.TypeString},
+		{Name: "platform_id", Type: field.TypeUUID},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// LtiLoginStatesTable holds the schema information for the "lti_login_states" table.
+	LtiLoginStatesTable = &schema.Table{
+		Name:       "lti_login_states",
+		Columns:    LtiLoginStatesColumns,
+		PrimaryKey: []*schema.Column{LtiLoginStatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ltiloginstate_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{LtiLoginStatesColumns[4]},
+			},
+		},
+	}
+	// LtiPlatformsColumns holds the columns for the "lti_platforms" table.
+	LtiPlatformsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "issuer", Type: field.TypeString},
+		{Name: "client_id", Type: field.TypeString},
+		{Name: "deployment_id", Type: field.TypeString, Default: ""},
+		{Name: "auth_login_url", Type: field.TypeString},
+		{Name: "auth_token_url", Type: field.TypeString},
+		{Name: "jwks_url", Type: field.TypeString},
+	}
+	// LtiPlatformsTable holds the schema information for the "lti_platforms" table.
+	LtiPlatformsTable = &schema.Table{
+		Name:       "lti_platforms",
+		Columns:    LtiPlatformsColumns,
+		PrimaryKey: []*schema.Column{LtiPlatformsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ltiplatform_issuer_client_id",
+				Unique:  true,
+				Columns: []*schema.Column{LtiPlatformsColumns[2], LtiPlatformsColumns[3]},
+			},
+		},
+	}
+	// LearnerActivitiesColumns holds the columns for the "learner_activities" table.
+	LearnerActivitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "day", Type: field.TypeTime},
+		{Name: "minutes_listened", Type: field.TypeInt, Default: 0},
+		{Name: "episodes_completed", Type: field.TypeInt, Default: 0},
+	}
+	// LearnerActivitiesTable holds the schema information for the "learner_activities" table.
+	LearnerActivitiesTable = &schema.Table{
+		Name:       "learner_activities",
+		Columns:    LearnerActivitiesColumns,
+		PrimaryKey: []*schema.Column{LearnerActivitiesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "learneractivity_user_id_day",
+				Unique:  true,
+				Columns: []*schema.Column{LearnerActivitiesColumns[3], LearnerActivitiesColumns[4]},
+			},
+			{
+				Name:    "learneractivity_day",
+				Unique:  false,
+				Columns: []*schema.Column{LearnerActivitiesColumns[4]},
+			},
+		},
+	}
+	// ModerationItemsColumns holds the columns for the "moderation_items" table.
+	ModerationItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "subject_type", Type: field.TypeString},
+		{Name: "subject_id", Type: field.TypeUUID},
+		{Name: "author_id", Type: field.TypeString, Default: ""},
+		{Name: "text", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeInt},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "score", Type: field.TypeFloat64, Default: 0},
+		{Name: "reviewer_id", Type: field.TypeString, Default: ""},
+		{Name: "review_note", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// ModerationItemsTable holds the schema information for the "moderation_items" table.
+	ModerationItemsTable = &schema.Table{
+		Name:       "moderation_items",
+		Columns:    ModerationItemsColumns,
+		PrimaryKey: []*schema.Column{ModerationItemsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "moderationitem_subject_type_subject_id",
+				Unique:  true,
+				Columns: []*schema.Column{ModerationItemsColumns[3], ModerationItemsColumns[4]},
+			},
+			{
+				Name:    "moderationitem_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ModerationItemsColumns[7], ModerationItemsColumns[1]},
+			},
+		},
+	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeInt},
+		{Name: "channel", Type: field.TypeInt},
+		{Name: "dedupe_key", Type: field.TypeString, Unique: true},
+		{Name: "subject", Type: field.TypeString, Default: ""},
+		{Name: "body", Type: field.TypeString, Size: 2147483647, Default: ""},
 		{Name: "status", Type: field.TypeInt, Default: 0},
-		{Name: "original_filename", Type: field.TypeString},
-		{Name: "mime_type", Type: field.TypeString},
-		{Name: "filesize", Type: field.TypeInt64, Default: 0},
-		{Name: "duration_seconds", Type: field.TypeInt, Default: 0},
-		{Name: "playback_url", Type: field.TypeString, Nullable: true},
+		{Name: "error", Type: field.TypeString, Default: ""},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[2], NotificationsColumns[1]},
+			},
+		},
+	}
+	// NotificationPreferencesColumns holds the columns for the "notification_preferences" table.
+	NotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString, Unique: true},
+		{Name: "email", Type: field.TypeString, Default: ""},
+		{Name: "locale", Type: field.TypeString, Default: ""},
+		{Name: "opt_outs", Type: field.TypeJSON, Nullable: true},
+	}
+	// NotificationPreferencesTable holds the schema information for the "notification_preferences" table.
+	NotificationPreferencesTable = &schema.Table{
+		Name:       "notification_preferences",
+		Columns:    NotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{NotificationPreferencesColumns[0]},
+	}
+	// OutboxMessagesColumns holds the columns for the "outbox_messages" table.
+	OutboxMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "aggregate_id", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "occurred_at", Type: field.TypeTime},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "dispatched_at", Type: field.TypeTime, Nullable: true},
+	}
+	// OutboxMessagesTable holds the schema information for the "outbox_messages" table.
+	OutboxMessagesTable = &schema.Table{
+		Name:       "outbox_messages",
+		Columns:    OutboxMessagesColumns,
+		PrimaryKey: []*schema.Column{OutboxMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxmessage_dispatched_at_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxMessagesColumns[8], OutboxMessagesColumns[7]},
+			},
+		},
+	}
+	// PlansColumns holds the columns for the "plans" table.
+	PlansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "ready_at", Type: field.TypeTime, Nullable: true},
+		{Name: "code", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "price_cents", Type: field.TypeInt64, Default: 0},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "interval", Type: field.TypeInt, Default: 0},
+		{Name: "active", Type: field.TypeBool, Default: true},
 	}
-	// AssetsTable holds the schema information for the "assets" table.
-	AssetsTable = &schema.Table{
-		Name:       "assets",
-		Columns:    AssetsColumns,
-		PrimaryKey: []*schema.Column{AssetsColumns[0]},
+	// PlansTable holds the schema information for the "plans" table.
+	PlansTable = &schema.Table{
+		Name:       "plans",
+		Columns:    PlansColumns,
+		PrimaryKey: []*schema.Column{PlansColumns[0]},
 	}
-	// EpisodesColumns holds the columns for the "episodes" table.
-	EpisodesColumns = []*schema.Column{
+	// PlaybackSessionsColumns holds the columns for the "playback_sessions" table.
+	PlaybackSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "episode_id", Type: field.TypeUUID},
+		{Name: "device", Type: field.TypeString, Default: ""},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+	}
+	// PlaybackSessionsTable holds the schema information for the "playback_sessions" table.
+	PlaybackSessionsTable = &schema.Table{
+		Name:       "playback_sessions",
+		Columns:    PlaybackSessionsColumns,
+		PrimaryKey: []*schema.Column{PlaybackSessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "playbacksession_user_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{PlaybackSessionsColumns[2], PlaybackSessionsColumns[5]},
+			},
+			{
+				Name:    "playbacksession_episode_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{PlaybackSessionsColumns[3], PlaybackSessionsColumns[5]},
+			},
+		},
+	}
+	// PlaylistsColumns holds the columns for the "playlists" table.
+	PlaylistsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
-		{Name: "seq", Type: field.TypeUint32},
-		{Name: "title", Type: field.TypeString},
-		{Name: "description", Type: field.TypeString, Default: ""},
-		{Name: "duration_seconds", Type: field.TypeInt, Default: 0},
-		{Name: "status", Type: field.TypeInt, Default: 0},
-		{Name: "resource_asset_id", Type: field.TypeUUID, Nullable: true},
-		{Name: "resource_type", Type: field.TypeInt, Default: 0},
-		{Name: "resource_playback_url", Type: field.TypeString, Default: ""},
-		{Name: "resource_mime_type", Type: field.TypeString, Default: ""},
-		{Name: "transcript_language", Type: field.TypeString, Default: ""},
-		{Name: "transcript_format", Type: field.TypeInt, Default: 0},
-		{Name: "transcript_content", Type: field.TypeString, Size: 2147483647, Default: ""},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "published_at", Type: field.TypeTime, Nullable: true},
-		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
-		{Name: "series_id", Type: field.TypeUUID},
+		{Name: "owner_id", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "public", Type: field.TypeBool, Default: false},
+		{Name: "slug", Type: field.TypeString, Unique: true, Nullable: true},
 	}
-	// EpisodesTable holds the schema information for the "episodes" table.
-	EpisodesTable = &schema.Table{
-		Name:       "episodes",
-		Columns:    EpisodesColumns,
-		PrimaryKey: []*schema.Column{EpisodesColumns[0]},
+	// PlaylistsTable holds the schema information for the "playlists" table.
+	PlaylistsTable = &schema.Table{
+		Name:       "playlists",
+		Columns:    PlaylistsColumns,
+		PrimaryKey: []*schema.Column{PlaylistsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "playlist_owner_id_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{PlaylistsColumns[3], PlaylistsColumns[2]},
+			},
+		},
+	}
+	// PlaylistItemsColumns holds the columns for the "playlist_items" table.
+	PlaylistItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "playlist_id", Type: field.TypeUUID},
+		{Name: "episode_id", Type: field.TypeUUID},
+	}
+	// PlaylistItemsTable holds the schema information for the "playlist_items" table.
+	PlaylistItemsTable = &schema.Table{
+		Name:       "playlist_items",
+		Columns:    PlaylistItemsColumns,
+		PrimaryKey: []*schema.Column{PlaylistItemsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "episodes_series_episodes",
-				Columns:    []*schema.Column{EpisodesColumns[17]},
-				RefColumns: []*schema.Column{SeriesColumns[0]},
+				Symbol:     "playlist_items_playlists_items",
+				Columns:    []*schema.Column{PlaylistItemsColumns[2]},
+				RefColumns: []*schema.Column{PlaylistsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "playlist_items_episodes_episode",
+				Columns:    []*schema.Column{PlaylistItemsColumns[3]},
+				RefColumns: []*schema.Column{EpisodesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "episode_series_id_seq",
+				Name:    "playlistitem_playlist_id_position",
 				Unique:  true,
-				Columns: []*schema.Column{EpisodesColumns[17], EpisodesColumns[1]},
+				Columns: []*schema.Column{PlaylistItemsColumns[2], PlaylistItemsColumns[1]},
 			},
 			{
-				Name:    "episode_series_id",
+				Name:    "playlistitem_playlist_id_episode_id",
+				Unique:  true,
+				Columns: []*schema.Column{PlaylistItemsColumns[2], PlaylistItemsColumns[3]},
+			},
+		},
+	}
+	// QuizItemsColumns holds the columns for the "quiz_items" table.
+	QuizItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "episode_id", Type: field.TypeUUID},
+		{Name: "kind", Type: field.TypeInt},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "prompt", Type: field.TypeString, Size: 2147483647},
+		{Name: "answer", Type: field.TypeString},
+		{Name: "sentence", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "needs_review", Type: field.TypeBool, Default: true},
+	}
+	// QuizItemsTable holds the schema information for the "quiz_items" table.
+	QuizItemsTable = &schema.Table{
+		Name:       "quiz_items",
+		Columns:    QuizItemsColumns,
+		PrimaryKey: []*schema.Column{QuizItemsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "quizitem_episode_id_kind_position",
 				Unique:  false,
-				Columns: []*schema.Column{EpisodesColumns[17]},
+				Columns: []*schema.Column{QuizItemsColumns[3], QuizItemsColumns[4], QuizItemsColumns[5]},
 			},
 		},
 	}
+	// ScheduledTasksColumns holds the columns for the "scheduled_tasks" table.
+	ScheduledTasksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "interval_seconds", Type: field.TypeInt64},
+		{Name: "next_run_at", Type: field.TypeTime},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_status", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "last_duration_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "locked_by", Type: field.TypeString, Default: ""},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+	}
+	// ScheduledTasksTable holds the schema information for the "scheduled_tasks" table.
+	ScheduledTasksTable = &schema.Table{
+		Name:       "scheduled_tasks",
+		Columns:    ScheduledTasksColumns,
+		PrimaryKey: []*schema.Column{ScheduledTasksColumns[0]},
+	}
 	// SeriesColumns holds the columns for the "series" table.
 	SeriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "slug", Type: field.TypeString, Unique: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "summary", Type: field.TypeString, Default: ""},
 		{Name: "language", Type: field.TypeString, Default: ""},
 		{Name: "level", Type: field.TypeString, Default: ""},
+		{Name: "estimated_level", Type: field.TypeString, Default: ""},
 		{Name: "tags", Type: field.TypeJSON, Nullable: true},
 		{Name: "cover_url", Type: field.TypeString, Default: ""},
+		{Name: "cover_asset_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "status", Type: field.TypeInt, Default: 0},
+		{Name: "drm_enabled", Type: field.TypeBool, Default: false},
+		{Name: "accent_color", Type: field.TypeString, Default: ""},
+		{Name: "hero_asset_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "hero_image_url", Type: field.TypeString, Default: ""},
+		{Name: "layout", Type: field.TypeInt, Default: 0},
 		{Name: "episode_count", Type: field.TypeInt, Default: 0},
-		{Name: "created_at", Type: field.TypeTime},
-		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "published_at", Type: field.TypeTime, Nullable: true},
 		{Name: "author_ids", Type: field.TypeJSON, Nullable: true},
 	}
@@ -98,10 +1086,189 @@ var (
 		Name:       "series",
 		Columns:    SeriesColumns,
 		PrimaryKey: []*schema.Column{SeriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "series_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SeriesColumns[1]},
+			},
+			{
+				Name:    "series_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SeriesColumns[13], SeriesColumns[1]},
+			},
+			{
+				Name:    "series_language_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SeriesColumns[7], SeriesColumns[1]},
+			},
+			{
+				Name:    "series_level_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SeriesColumns[8], SeriesColumns[1]},
+			},
+			{
+				Name:    "series_estimated_level_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SeriesColumns[9], SeriesColumns[1]},
+			},
+			{
+				Name:    "series_tags",
+				Unique:  false,
+				Columns: []*schema.Column{SeriesColumns[10]},
+				Annotation: &entsql.IndexAnnotation{
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
+			},
+			{
+				Name:    "series_author_ids",
+				Unique:  false,
+				Columns: []*schema.Column{SeriesColumns[21]},
+				Annotation: &entsql.IndexAnnotation{
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
+			},
+		},
+	}
+	// ShadowingSubmissionsColumns holds the columns for the "shadowing_submissions" table.
+	ShadowingSubmissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "episode_id", Type: field.TypeUUID},
+		{Name: "segment_index", Type: field.TypeInt},
+		{Name: "asset_id", Type: field.TypeUUID},
+		{Name: "status", Type: field.TypeInt, Default: 0},
+		{Name: "auto_score", Type: field.TypeFloat64, Nullable: true},
+		{Name: "auto_feedback", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "reviewer_id", Type: field.TypeString, Default: ""},
+		{Name: "review_score", Type: field.TypeInt, Nullable: true},
+		{Name: "review_comment", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// ShadowingSubmissionsTable holds the schema information for the "shadowing_submissions" table.
+	ShadowingSubmissionsTable = &schema.Table{
+		Name:       "shadowing_submissions",
+		Columns:    ShadowingSubmissionsColumns,
+		PrimaryKey: []*schema.Column{ShadowingSubmissionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "shadowingsubmission_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ShadowingSubmissionsColumns[3], ShadowingSubmissionsColumns[1]},
+			},
+			{
+				Name:    "shadowingsubmission_episode_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ShadowingSubmissionsColumns[4], ShadowingSubmissionsColumns[7]},
+			},
+		},
+	}
+	// SubscriptionsColumns holds the columns for the "subscriptions" table.
+	SubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "plan_id", Type: field.TypeUUID},
+		{Name: "status", Type: field.TypeInt, Default: 0},
+		{Name: "current_period_start", Type: field.TypeTime},
+		{Name: "current_period_end", Type: field.TypeTime},
+		{Name: "billing_provider", Type: field.TypeString, Default: ""},
+		{Name: "external_id", Type: field.TypeString, Default: ""},
+		{Name: "canceled_at", Type: field.TypeTime, Nullable: true},
+	}
+	// SubscriptionsTable holds the schema information for the "subscriptions" table.
+	SubscriptionsTable = &schema.Table{
+		Name:       "subscriptions",
+		Columns:    SubscriptionsColumns,
+		PrimaryKey: []*schema.Column{SubscriptionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "subscription_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionsColumns[3], SubscriptionsColumns[1]},
+			},
+			{
+				Name:    "subscription_billing_provider_external_id",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionsColumns[8], SubscriptionsColumns[9]},
+			},
+		},
+	}
+	// TranscriptReplaceJobsColumns holds the columns for the "transcript_replace_jobs" table.
+	TranscriptReplaceJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "pattern", Type: field.TypeString, Size: 2147483647},
+		{Name: "replacement", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "regex", Type: field.TypeBool, Default: false},
+		{Name: "case_insensitive", Type: field.TypeBool, Default: false},
+		{Name: "scope_series_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "scope_language", Type: field.TypeString, Default: ""},
+		{Name: "dry_run", Type: field.TypeBool, Default: false},
+		{Name: "requested_by", Type: field.TypeString, Default: ""},
+		{Name: "status", Type: field.TypeInt, Default: 0},
+		{Name: "episodes_scanned", Type: field.TypeInt, Default: 0},
+		{Name: "episodes_changed", Type: field.TypeInt, Default: 0},
+		{Name: "matches", Type: field.TypeInt, Default: 0},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "rolled_back_at", Type: field.TypeTime, Nullable: true},
+	}
+	// TranscriptReplaceJobsTable holds the schema information for the "transcript_replace_jobs" table.
+	TranscriptReplaceJobsTable = &schema.Table{
+		Name:       "transcript_replace_jobs",
+		Columns:    TranscriptReplaceJobsColumns,
+		PrimaryKey: []*schema.Column{TranscriptReplaceJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "transcriptreplacejob_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TranscriptReplaceJobsColumns[1]},
+			},
+		},
+	}
+	// TranscriptRevisionsColumns holds the columns for the "transcript_revisions" table.
+	TranscriptRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "job_id", Type: field.TypeUUID},
+		{Name: "episode_id", Type: field.TypeUUID},
+		{Name: "before", Type: field.TypeString, Size: 2147483647},
+		{Name: "after", Type: field.TypeString, Size: 2147483647},
+		{Name: "rolled_back_at", Type: field.TypeTime, Nullable: true},
+	}
+	// TranscriptRevisionsTable holds the schema information for the "transcript_revisions" table.
+	TranscriptRevisionsTable = &schema.Table{
+		Name:       "transcript_revisions",
+		Columns:    TranscriptRevisionsColumns,
+		PrimaryKey: []*schema.Column{TranscriptRevisionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "transcriptrevision_job_id",
+				Unique:  false,
+				Columns: []*schema.Column{TranscriptRevisionsColumns[2]},
+			},
+			{
+				Name:    "transcriptrevision_episode_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TranscriptRevisionsColumns[3], TranscriptRevisionsColumns[1]},
+			},
+		},
 	}
 	// UploadSessionsColumns holds the columns for the "upload_sessions" table.
 	UploadSessionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "asset_key", Type: field.TypeString, Unique: true},
 		{Name: "type", Type: field.TypeInt, Default: 0},
 		{Name: "protocol", Type: field.TypeInt, Default: 0},
@@ -114,8 +1281,8 @@ var (
 		{Name: "mime_type", Type: field.TypeString},
 		{Name: "content_length", Type: field.TypeInt64, Default: 0},
 		{Name: "expires_at", Type: field.TypeTime},
-		{Name: "created_at", Type: field.TypeTime},
-		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "provider", Type: field.TypeString, Default: ""},
+		{Name: "replaces_asset_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// UploadSessionsTable holds the schema information for the "upload_sessions" table.
 	UploadSessionsTable = &schema.Table{
@@ -123,15 +1290,229 @@ var (
 		Columns:    UploadSessionsColumns,
 		PrimaryKey: []*schema.Column{UploadSessionsColumns[0]},
 	}
+	// UsageRecordsColumns holds the columns for the "usage_records" table.
+	UsageRecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "metric", Type: field.TypeInt},
+		{Name: "quantity", Type: field.TypeFloat64},
+		{Name: "occurred_at", Type: field.TypeTime},
+	}
+	// UsageRecordsTable holds the schema information for the "usage_records" table.
+	UsageRecordsTable = &schema.Table{
+		Name:       "usage_records",
+		Columns:    UsageRecordsColumns,
+		PrimaryKey: []*schema.Column{UsageRecordsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usagerecord_tenant_id_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsageRecordsColumns[1], UsageRecordsColumns[4]},
+			},
+		},
+	}
+	// UsageSnapshotsColumns holds the columns for the "usage_snapshots" table.
+	UsageSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "period_start", Type: field.TypeTime},
+		{Name: "period_end", Type: field.TypeTime},
+		{Name: "lines", Type: field.TypeJSON, Nullable: true},
+	}
+	// UsageSnapshotsTable holds the schema information for the "usage_snapshots" table.
+	UsageSnapshotsTable = &schema.Table{
+		Name:       "usage_snapshots",
+		Columns:    UsageSnapshotsColumns,
+		PrimaryKey: []*schema.Column{UsageSnapshotsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usagesnapshot_tenant_id_period_start",
+				Unique:  true,
+				Columns: []*schema.Column{UsageSnapshotsColumns[2], UsageSnapshotsColumns[3]},
+			},
+		},
+	}
+	// VocabularyWordsColumns holds the columns for the "vocabulary_words" table.
+	VocabularyWordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "word", Type: field.TypeString},
+		{Name: "status", Type: field.TypeInt},
+		{Name: "episode_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// VocabularyWordsTable holds the schema information for the "vocabulary_words" table.
+	VocabularyWordsTable = &schema.Table{
+		Name:       "vocabulary_words",
+		Columns:    VocabularyWordsColumns,
+		PrimaryKey: []*schema.Column{VocabularyWordsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vocabularyword_user_id_word",
+				Unique:  true,
+				Columns: []*schema.Column{VocabularyWordsColumns[3], VocabularyWordsColumns[4]},
+			},
+			{
+				Name:    "vocabularyword_user_id_status_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{VocabularyWordsColumns[3], VocabularyWordsColumns[5], VocabularyWordsColumns[2]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event_id", Type: field.TypeUUID},
+		{Name: "event_type", Type: field.TypeInt},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "status", Type: field.TypeInt, Default: 0},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "response_status", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, Default: ""},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "endpoint_id", Type: field.TypeUUID},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhook_endpoints_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[12]},
+				RefColumns: []*schema.Column{WebhookEndpointsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_endpoint_id_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[12], WebhookDeliveriesColumns[3]},
+			},
+			{
+				Name:    "webhookdelivery_endpoint_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[12], WebhookDeliveriesColumns[1]},
+			},
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[6], WebhookDeliveriesColumns[10]},
+			},
+		},
+	}
+	// WebhookEndpointsColumns holds the columns for the "webhook_endpoints" table.
+	WebhookEndpointsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "url", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "event_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+	}
+	// WebhookEndpointsTable holds the schema information for the "webhook_endpoints" table.
+	WebhookEndpointsTable = &schema.Table{
+		Name:       "webhook_endpoints",
+		Columns:    WebhookEndpointsColumns,
+		PrimaryKey: []*schema.Column{WebhookEndpointsColumns[0]},
+	}
+	// WebhookEventsColumns holds the columns for the "webhook_events" table.
+	WebhookEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "event_type", Type: field.TypeInt},
+		{Name: "payload", Type: field.TypeBytes},
+	}
+	// WebhookEventsTable holds the schema information for the "webhook_events" table.
+	WebhookEventsTable = &schema.Table{
+		Name:       "webhook_events",
+		Columns:    WebhookEventsColumns,
+		PrimaryKey: []*schema.Column{WebhookEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookEventsColumns[1]},
+			},
+			{
+				Name:    "webhookevent_event_type_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookEventsColumns[2], WebhookEventsColumns[1]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
 		AssetsTable,
+		AssetUsageDaysTable,
+		AssetVersionsTable,
+		AuditEntriesTable,
+		AvailabilitySlotsTable,
+		BookingsTable,
+		ClassroomsTable,
+		ClassroomAssignmentsTable,
+		ClassroomMembersTable,
+		ContentEmbeddingsTable,
+		ContentKeysTable,
+		ContentReassignmentsTable,
+		DeviceTokensTable,
+		DictationAttemptsTable,
+		EngagementRollupsTable,
 		EpisodesTable,
+		EpisodeTranscriptsTable,
+		EventsTable,
+		FeedItemsTable,
+		FeedSubscriptionsTable,
+		InvoicesTable,
+		JobsTable,
+		LtiLaunchesTable,
+		LtiLoginStatesTable,
+		LtiPlatformsTable,
+		LearnerActivitiesTable,
+		ModerationItemsTable,
+		NotificationsTable,
+		NotificationPreferencesTable,
+		OutboxMessagesTable,
+		PlansTable,
+		PlaybackSessionsTable,
+		PlaylistsTable,
+		PlaylistItemsTable,
+		QuizItemsTable,
+		ScheduledTasksTable,
 		SeriesTable,
+		ShadowingSubmissionsTable,
+		SubscriptionsTable,
+		TranscriptReplaceJobsTable,
+		TranscriptRevisionsTable,
 		UploadSessionsTable,
+		UsageRecordsTable,
+		UsageSnapshotsTable,
+		VocabularyWordsTable,
+		WebhookDeliveriesTable,
+		WebhookEndpointsTable,
+		WebhookEventsTable,
 	}
 )
 
 func init() {
+	BookingsTable.ForeignKeys[0].RefTable = AvailabilitySlotsTable
+	ClassroomAssignmentsTable.ForeignKeys[0].RefTable = ClassroomsTable
+	ClassroomAssignmentsTable.ForeignKeys[1].RefTable = SeriesTable
+	ClassroomMembersTable.ForeignKeys[0].RefTable = ClassroomsTable
 	EpisodesTable.ForeignKeys[0].RefTable = SeriesTable
+	EpisodeTranscriptsTable.ForeignKeys[0].RefTable = EpisodesTable
+	FeedItemsTable.ForeignKeys[0].RefTable = FeedSubscriptionsTable
+	PlaylistItemsTable.ForeignKeys[0].RefTable = PlaylistsTable
+	PlaylistItemsTable.ForeignKeys[1].RefTable = EpisodesTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhookEndpointsTable
 }
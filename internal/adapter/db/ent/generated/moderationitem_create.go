@@ -0,0 +1,413 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/moderationitem"
+	"github.com/google/uuid"
+)
+
+// ModerationItemCreate is the builder for creating a ModerationItem entity.
+type ModerationItemCreate struct {
+	config
+	mutation *ModerationItemMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ModerationItemCreate) SetCreatedAt(v time.Time) *ModerationItemCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ModerationItemCreate) SetUpdatedAt(v time.Time) *ModerationItemCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetSubjectType sets the "subject_type" field.
+func (_c *ModerationItemCreate) SetSubjectType(v string) *ModerationItemCreate {
+	_c.mutation.SetSubjectType(v)
+	return _c
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (_c *ModerationItemCreate) SetSubjectID(v uuid.UUID) *ModerationItemCreate {
+	_c.mutation.SetSubjectID(v)
+	return _c
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_c *ModerationItemCreate) SetAuthorID(v string) *ModerationItemCreate {
+	_c.mutation.SetAuthorID(v)
+	return _c
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_c *ModerationItemCreate) SetNillableAuthorID(v *string) *ModerationItemCreate {
+	if v != nil {
+		_c.SetAuthorID(*v)
+	}
+	return _c
+}
+
+// SetText sets the "text" field.
+func (_c *ModerationItemCreate) SetText(v string) *ModerationItemCreate {
+	_c.mutation.SetText(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ModerationItemCreate) SetStatus(v int) *ModerationItemCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetLabels sets the "labels" field.
+func (_c *ModerationItemCreate) SetLabels(v []string) *ModerationItemCreate {
+	_c.mutation.SetLabels(v)
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *ModerationItemCreate) SetScore(v float64) *ModerationItemCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_c *ModerationItemCreate) SetNillableScore(v *float64) *ModerationItemCreate {
+	if v != nil {
+		_c.SetScore(*v)
+	}
+	return _c
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (_c *ModerationItemCreate) SetReviewerID(v string) *ModerationItemCreate {
+	_c.mutation.SetReviewerID(v)
+	return _c
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (_c *ModerationItemCreate) SetNillableReviewerID(v *string) *ModerationItemCreate {
+	if v != nil {
+		_c.SetReviewerID(*v)
+	}
+	return _c
+}
+
+// SetReviewNote sets the "review_note" field.
+func (_c *ModerationItemCreate) SetReviewNote(v string) *ModerationItemCreate {
+	_c.mutation.SetReviewNote(v)
+	return _c
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (_c *ModerationItemCreate) SetNillableReviewNote(v *string) *ModerationItemCreate {
+	if v != nil {
+		_c.SetReviewNote(*v)
+	}
+	return _c
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *ModerationItemCreate) SetReviewedAt(v time.Time) *ModerationItemCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_c *ModerationItemCreate) SetNillableReviewedAt(v *time.Time) *ModerationItemCreate {
+	if v != nil {
+		_c.SetReviewedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ModerationItemCreate) SetID(v uuid.UUID) *ModerationItemCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ModerationItemCreate) SetNillableID(v *uuid.UUID) *ModerationItemCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ModerationItemMutation object of the builder.
+func (_c *ModerationItemCreate) Mutation() *ModerationItemMutation {
+	return _c.mutation
+}
+
+// Save creates the ModerationItem in the database.
+func (_c *ModerationItemCreate) Save(ctx context.Context) (*ModerationItem, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ModerationItemCreate) SaveX(ctx context.Context) *ModerationItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModerationItemCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModerationItemCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ModerationItemCreate) defaults() error {
+	if _, ok := _c.mutation.AuthorID(); !ok {
+		v := moderationitem.DefaultAuthorID
+		_c.mutation.SetAuthorID(v)
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		v := moderationitem.DefaultScore
+		_c.mutation.SetScore(v)
+	}
+	if _, ok := _c.mutation.ReviewerID(); !ok {
+		v := moderationitem.DefaultReviewerID
+		_c.mutation.SetReviewerID(v)
+	}
+	if _, ok := _c.mutation.ReviewNote(); !ok {
+		v := moderationitem.DefaultReviewNote
+		_c.mutation.SetReviewNote(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if moderationitem.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized moderationitem.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := moderationitem.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ModerationItemCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "ModerationItem.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "ModerationItem.updated_at"`)}
+	}
+	if _, ok := _c.mutation.SubjectType(); !ok {
+		return &ValidationError{Name: "subject_type", err: errors.New(`generated: missing required field "ModerationItem.subject_type"`)}
+	}
+	if _, ok := _c.mutation.SubjectID(); !ok {
+		return &ValidationError{Name: "subject_id", err: errors.New(`generated: missing required field "ModerationItem.subject_id"`)}
+	}
+	if _, ok := _c.mutation.AuthorID(); !ok {
+		return &ValidationError{Name: "author_id", err: errors.New(`generated: missing required field "ModerationItem.author_id"`)}
+	}
+	if _, ok := _c.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`generated: missing required field "ModerationItem.text"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`generated: missing required field "ModerationItem.status"`)}
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`generated: missing required field "ModerationItem.score"`)}
+	}
+	if _, ok := _c.mutation.ReviewerID(); !ok {
+		return &ValidationError{Name: "reviewer_id", err: errors.New(`generated: missing required field "ModerationItem.reviewer_id"`)}
+	}
+	if _, ok := _c.mutation.ReviewNote(); !ok {
+		return &ValidationError{Name: "review_note", err: errors.New(`generated: missing required field "ModerationItem.review_note"`)}
+	}
+	return nil
+}
+
+func (_c *ModerationItemCreate) sqlSave(ctx context.Context) (*ModerationItem, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ModerationItemCreate) createSpec() (*ModerationItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ModerationItem{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(moderationitem.Table, sqlgraph.NewFieldSpec(moderationitem.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(moderationitem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(moderationitem.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.SubjectType(); ok {
+		_spec.SetField(moderationitem.FieldSubjectType, field.TypeString, value)
+		_node.SubjectType = value
+	}
+	if value, ok := _c.mutation.SubjectID(); ok {
+		_spec.SetField(moderationitem.FieldSubjectID, field.TypeUUID, value)
+		_node.SubjectID = value
+	}
+	if value, ok := _c.mutation.AuthorID(); ok {
+		_spec.SetField(moderationitem.FieldAuthorID, field.TypeString, value)
+		_node.AuthorID = value
+	}
+	if value, ok := _c.mutation.Text(); ok {
+		_spec.SetField(moderationitem.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(moderationitem.FieldStatus, field.TypeInt, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Labels(); ok {
+		_spec.SetField(moderationitem.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(moderationitem.FieldScore, field.TypeFloat64, value)
+		_node.Score = value
+	}
+	if value, ok := _c.mutation.ReviewerID(); ok {
+		_spec.SetField(moderationitem.FieldReviewerID, field.TypeString, value)
+		_node.ReviewerID = value
+	}
+	if value, ok := _c.mutation.ReviewNote(); ok {
+		_spec.SetField(moderationitem.FieldReviewNote, field.TypeString, value)
+		_node.ReviewNote = value
+	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(moderationitem.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	return _node, _spec
+}
+
+// ModerationItemCreateBulk is the builder for creating many ModerationItem entities in bulk.
+type ModerationItemCreateBulk struct {
+	config
+	err      error
+	builders []*ModerationItemCreate
+}
+
+// Save creates the ModerationItem entities in the database.
+func (_c *ModerationItemCreateBulk) Save(ctx context.Context) ([]*ModerationItem, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ModerationItem, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModerationItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ModerationItemCreateBulk) SaveX(ctx context.Context) []*ModerationItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModerationItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModerationItemCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/moderationitem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+)
+
+// ModerationItemUpdate is the builder for updating ModerationItem entities.
+type ModerationItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ModerationItemMutation
+}
+
+// Where appends a list predicates to the ModerationItemUpdate builder.
+func (_u *ModerationItemUpdate) Where(ps ...predicate.ModerationItem) *ModerationItemUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ModerationItemUpdate) SetUpdatedAt(v time.Time) *ModerationItemUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ModerationItemUpdate) SetNillableUpdatedAt(v *time.Time) *ModerationItemUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_u *ModerationItemUpdate) SetAuthorID(v string) *ModerationItemUpdate {
+	_u.mutation.SetAuthorID(v)
+	return _u
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_u *ModerationItemUpdate) SetNillableAuthorID(v *string) *ModerationItemUpdate {
+	if v != nil {
+		_u.SetAuthorID(*v)
+	}
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *ModerationItemUpdate) SetText(v string) *ModerationItemUpdate {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *ModerationItemUpdate) SetNillableText(v *string) *ModerationItemUpdate {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ModerationItemUpdate) SetStatus(v int) *ModerationItemUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ModerationItemUpdate) SetNillableStatus(v *int) *ModerationItemUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *ModerationItemUpdate) AddStatus(v int) *ModerationItemUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetLabels sets the "labels" field.
+func (_u *ModerationItemUpdate) SetLabels(v []string) *ModerationItemUpdate {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// AppendLabels appends value to the "labels" field.
+func (_u *ModerationItemUpdate) AppendLabels(v []string) *ModerationItemUpdate {
+	_u.mutation.AppendLabels(v)
+	return _u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (_u *ModerationItemUpdate) ClearLabels() *ModerationItemUpdate {
+	_u.mutation.ClearLabels()
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *ModerationItemUpdate) SetScore(v float64) *ModerationItemUpdate {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *ModerationItemUpdate) SetNillableScore(v *float64) *ModerationItemUpdate {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *ModerationItemUpdate) AddScore(v float64) *ModerationItemUpdate {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (_u *ModerationItemUpdate) SetReviewerID(v string) *ModerationItemUpdate {
+	_u.mutation.SetReviewerID(v)
+	return _u
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (_u *ModerationItemUpdate) SetNillableReviewerID(v *string) *ModerationItemUpdate {
+	if v != nil {
+		_u.SetReviewerID(*v)
+	}
+	return _u
+}
+
+// SetReviewNote sets the "review_note" field.
+func (_u *ModerationItemUpdate) SetReviewNote(v string) *ModerationItemUpdate {
+	_u.mutation.SetReviewNote(v)
+	return _u
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (_u *ModerationItemUpdate) SetNillableReviewNote(v *string) *ModerationItemUpdate {
+	if v != nil {
+		_u.SetReviewNote(*v)
+	}
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *ModerationItemUpdate) SetReviewedAt(v time.Time) *ModerationItemUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *ModerationItemUpdate) SetNillableReviewedAt(v *time.Time) *ModerationItemUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *ModerationItemUpdate) ClearReviewedAt() *ModerationItemUpdate {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// Mutation returns the ModerationItemMutation object of the builder.
+func (_u *ModerationItemUpdate) Mutation() *ModerationItemMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ModerationItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModerationItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ModerationItemUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModerationItemUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ModerationItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(moderationitem.Table, moderationitem.Columns, sqlgraph.NewFieldSpec(moderationitem.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(moderationitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.AuthorID(); ok {
+		_spec.SetField(moderationitem.FieldAuthorID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(moderationitem.FieldText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(moderationitem.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(moderationitem.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(moderationitem.FieldLabels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, moderationitem.FieldLabels, value)
+		})
+	}
+	if _u.mutation.LabelsCleared() {
+		_spec.ClearField(moderationitem.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(moderationitem.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(moderationitem.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ReviewerID(); ok {
+		_spec.SetField(moderationitem.FieldReviewerID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewNote(); ok {
+		_spec.SetField(moderationitem.FieldReviewNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(moderationitem.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(moderationitem.FieldReviewedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ModerationItemUpdateOne is the builder for updating a single ModerationItem entity.
+type ModerationItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ModerationItemMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ModerationItemUpdateOne) SetUpdatedAt(v time.Time) *ModerationItemUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ModerationItemUpdateOne) SetNillableUpdatedAt(v *time.Time) *ModerationItemUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_u *ModerationItemUpdateOne) SetAuthorID(v string) *ModerationItemUpdateOne {
+	_u.mutation.SetAuthorID(v)
+	return _u
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_u *ModerationItemUpdateOne) SetNillableAuthorID(v *string) *ModerationItemUpdateOne {
+	if v != nil {
+		_u.SetAuthorID(*v)
+	}
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *ModerationItemUpdateOne) SetText(v string) *ModerationItemUpdateOne {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *ModerationItemUpdateOne) SetNillableText(v *string) *ModerationItemUpdateOne {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ModerationItemUpdateOne) SetStatus(v int) *ModerationItemUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ModerationItemUpdateOne) SetNillableStatus(v *int) *ModerationItemUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *ModerationItemUpdateOne) AddStatus(v int) *ModerationItemUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetLabels sets the "labels" field.
+func (_u *ModerationItemUpdateOne) SetLabels(v []string) *ModerationItemUpdateOne {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// AppendLabels appends value to the "labels" field.
+func (_u *ModerationItemUpdateOne) AppendLabels(v []string) *ModerationItemUpdateOne {
+	_u.mutation.AppendLabels(v)
+	return _u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (_u *ModerationItemUpdateOne) ClearLabels() *ModerationItemUpdateOne {
+	_u.mutation.ClearLabels()
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *ModerationItemUpdateOne) SetScore(v float64) *ModerationItemUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *ModerationItemUpdateOne) SetNillableScore(v *float64) *ModerationItemUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *ModerationItemUpdateOne) AddScore(v float64) *ModerationItemUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (_u *ModerationItemUpdateOne) SetReviewerID(v string) *ModerationItemUpdateOne {
+	_u.mutation.SetReviewerID(v)
+	return _u
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (_u *ModerationItemUpdateOne) SetNillableReviewerID(v *string) *ModerationItemUpdateOne {
+	if v != nil {
+		_u.SetReviewerID(*v)
+	}
+	return _u
+}
+
+// SetReviewNote sets the "review_note" field.
+func (_u *ModerationItemUpdateOne) SetReviewNote(v string) *ModerationItemUpdateOne {
+	_u.mutation.SetReviewNote(v)
+	return _u
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (_u *ModerationItemUpdateOne) SetNillableReviewNote(v *string) *ModerationItemUpdateOne {
+	if v != nil {
+		_u.SetReviewNote(*v)
+	}
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *ModerationItemUpdateOne) SetReviewedAt(v time.Time) *ModerationItemUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *ModerationItemUpdateOne) SetNillableReviewedAt(v *time.Time) *ModerationItemUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *ModerationItemUpdateOne) ClearReviewedAt() *ModerationItemUpdateOne {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// Mutation returns the ModerationItemMutation object of the builder.
+func (_u *ModerationItemUpdateOne) Mutation() *ModerationItemMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ModerationItemUpdate builder.
+func (_u *ModerationItemUpdateOne) Where(ps ...predicate.ModerationItem) *ModerationItemUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ModerationItemUpdateOne) Select(field string, fields ...string) *ModerationItemUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ModerationItem entity.
+func (_u *ModerationItemUpdateOne) Save(ctx context.Context) (*ModerationItem, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModerationItemUpdateOne) SaveX(ctx context.Context) *ModerationItem {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ModerationItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModerationItemUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ModerationItemUpdateOne) sqlSave(ctx context.Context) (_node *ModerationItem, err error) {
+	_spec := sqlgraph.NewUpdateSpec(moderationitem.Table, moderationitem.Columns, sqlgraph.NewFieldSpec(moderationitem.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "ModerationItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, moderationitem.FieldID)
+		for _, f := range fields {
+			if !moderationitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != moderationitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(moderationitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.AuthorID(); ok {
+		_spec.SetField(moderationitem.FieldAuthorID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(moderationitem.FieldText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(moderationitem.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(moderationitem.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(moderationitem.FieldLabels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLabels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, moderationitem.FieldLabels, value)
+		})
+	}
+	if _u.mutation.LabelsCleared() {
+		_spec.ClearField(moderationitem.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(moderationitem.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(moderationitem.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ReviewerID(); ok {
+		_spec.SetField(moderationitem.FieldReviewerID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewNote(); ok {
+		_spec.SetField(moderationitem.FieldReviewNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(moderationitem.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(moderationitem.FieldReviewedAt, field.TypeTime)
+	}
+	_node = &ModerationItem{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
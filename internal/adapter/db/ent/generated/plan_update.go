@@ -0,0 +1,488 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/plan"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+)
+
+// PlanUpdate is the builder for updating Plan entities.
+type PlanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlanMutation
+}
+
+// Where appends a list predicates to the PlanUpdate builder.
+func (_u *PlanUpdate) Where(ps ...predicate.Plan) *PlanUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PlanUpdate) SetUpdatedAt(v time.Time) *PlanUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillableUpdatedAt(v *time.Time) *PlanUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *PlanUpdate) SetCode(v string) *PlanUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillableCode(v *string) *PlanUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PlanUpdate) SetName(v string) *PlanUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillableName(v *string) *PlanUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *PlanUpdate) SetDescription(v string) *PlanUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillableDescription(v *string) *PlanUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetPriceCents sets the "price_cents" field.
+func (_u *PlanUpdate) SetPriceCents(v int64) *PlanUpdate {
+	_u.mutation.ResetPriceCents()
+	_u.mutation.SetPriceCents(v)
+	return _u
+}
+
+// SetNillablePriceCents sets the "price_cents" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillablePriceCents(v *int64) *PlanUpdate {
+	if v != nil {
+		_u.SetPriceCents(*v)
+	}
+	return _u
+}
+
+// AddPriceCents adds value to the "price_cents" field.
+func (_u *PlanUpdate) AddPriceCents(v int64) *PlanUpdate {
+	_u.mutation.AddPriceCents(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PlanUpdate) SetCurrency(v string) *PlanUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillableCurrency(v *string) *PlanUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetInterval sets the "interval" field.
+func (_u *PlanUpdate) SetInterval(v int) *PlanUpdate {
+	_u.mutation.ResetInterval()
+	_u.mutation.SetInterval(v)
+	return _u
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillableInterval(v *int) *PlanUpdate {
+	if v != nil {
+		_u.SetInterval(*v)
+	}
+	return _u
+}
+
+// AddInterval adds value to the "interval" field.
+func (_u *PlanUpdate) AddInterval(v int) *PlanUpdate {
+	_u.mutation.AddInterval(v)
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *PlanUpdate) SetActive(v bool) *PlanUpdate {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillableActive(v *bool) *PlanUpdate {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PlanMutation object of the builder.
+func (_u *PlanUpdate) Mutation() *PlanMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PlanUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlanUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PlanUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlanUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PlanUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(plan.Table, plan.Columns, sqlgraph.NewFieldSpec(plan.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(plan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(plan.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(plan.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(plan.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PriceCents(); ok {
+		_spec.SetField(plan.FieldPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPriceCents(); ok {
+		_spec.AddField(plan.FieldPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(plan.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Interval(); ok {
+		_spec.SetField(plan.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInterval(); ok {
+		_spec.AddField(plan.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(plan.FieldActive, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{plan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PlanUpdateOne is the builder for updating a single Plan entity.
+type PlanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlanMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PlanUpdateOne) SetUpdatedAt(v time.Time) *PlanUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillableUpdatedAt(v *time.Time) *PlanUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *PlanUpdateOne) SetCode(v string) *PlanUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillableCode(v *string) *PlanUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PlanUpdateOne) SetName(v string) *PlanUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillableName(v *string) *PlanUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *PlanUpdateOne) SetDescription(v string) *PlanUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillableDescription(v *string) *PlanUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetPriceCents sets the "price_cents" field.
+func (_u *PlanUpdateOne) SetPriceCents(v int64) *PlanUpdateOne {
+	_u.mutation.ResetPriceCents()
+	_u.mutation.SetPriceCents(v)
+	return _u
+}
+
+// SetNillablePriceCents sets the "price_cents" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillablePriceCents(v *int64) *PlanUpdateOne {
+	if v != nil {
+		_u.SetPriceCents(*v)
+	}
+	return _u
+}
+
+// AddPriceCents adds value to the "price_cents" field.
+func (_u *PlanUpdateOne) AddPriceCents(v int64) *PlanUpdateOne {
+	_u.mutation.AddPriceCents(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PlanUpdateOne) SetCurrency(v string) *PlanUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillableCurrency(v *string) *PlanUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetInterval sets the "interval" field.
+func (_u *PlanUpdateOne) SetInterval(v int) *PlanUpdateOne {
+	_u.mutation.ResetInterval()
+	_u.mutation.SetInterval(v)
+	return _u
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillableInterval(v *int) *PlanUpdateOne {
+	if v != nil {
+		_u.SetInterval(*v)
+	}
+	return _u
+}
+
+// AddInterval adds value to the "interval" field.
+func (_u *PlanUpdateOne) AddInterval(v int) *PlanUpdateOne {
+	_u.mutation.AddInterval(v)
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *PlanUpdateOne) SetActive(v bool) *PlanUpdateOne {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillableActive(v *bool) *PlanUpdateOne {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PlanMutation object of the builder.
+func (_u *PlanUpdateOne) Mutation() *PlanMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PlanUpdate builder.
+func (_u *PlanUpdateOne) Where(ps ...predicate.Plan) *PlanUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PlanUpdateOne) Select(field string, fields ...string) *PlanUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Plan entity.
+func (_u *PlanUpdateOne) Save(ctx context.Context) (*Plan, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlanUpdateOne) SaveX(ctx context.Context) *Plan {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PlanUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlanUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PlanUpdateOne) sqlSave(ctx context.Context) (_node *Plan, err error) {
+	_spec := sqlgraph.NewUpdateSpec(plan.Table, plan.Columns, sqlgraph.NewFieldSpec(plan.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "Plan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, plan.FieldID)
+		for _, f := range fields {
+			if !plan.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != plan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(plan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(plan.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(plan.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(plan.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PriceCents(); ok {
+		_spec.SetField(plan.FieldPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPriceCents(); ok {
+		_spec.AddField(plan.FieldPriceCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(plan.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Interval(); ok {
+		_spec.SetField(plan.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInterval(); ok {
+		_spec.AddField(plan.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(plan.FieldActive, field.TypeBool, value)
+	}
+	_node = &Plan{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{plan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
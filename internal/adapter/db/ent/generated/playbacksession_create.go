@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playbacksession"
+	"github.com/google/uuid"
+)
+
+// PlaybackSessionCreate is the builder for creating a PlaybackSession entity.
+type PlaybackSessionCreate struct {
+	config
+	mutation *PlaybackSessionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PlaybackSessionCreate) SetCreatedAt(v time.Time) *PlaybackSessionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PlaybackSessionCreate) SetUserID(v string) *PlaybackSessionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_c *PlaybackSessionCreate) SetEpisodeID(v uuid.UUID) *PlaybackSessionCreate {
+	_c.mutation.SetEpisodeID(v)
+	return _c
+}
+
+// SetDevice sets the "device" field.
+func (_c *PlaybackSessionCreate) SetDevice(v string) *PlaybackSessionCreate {
+	_c.mutation.SetDevice(v)
+	return _c
+}
+
+// SetNillableDevice sets the "device" field if the given value is not nil.
+func (_c *PlaybackSessionCreate) SetNillableDevice(v *string) *PlaybackSessionCreate {
+	if v != nil {
+		_c.SetDevice(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *PlaybackSessionCreate) SetStartedAt(v time.Time) *PlaybackSessionCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *PlaybackSessionCreate) SetFinishedAt(v time.Time) *PlaybackSessionCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *PlaybackSessionCreate) SetNillableFinishedAt(v *time.Time) *PlaybackSessionCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PlaybackSessionCreate) SetID(v uuid.UUID) *PlaybackSessionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PlaybackSessionCreate) SetNillableID(v *uuid.UUID) *PlaybackSessionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PlaybackSessionMutation object of the builder.
+func (_c *PlaybackSessionCreate) Mutation() *PlaybackSessionMutation {
+	return _c.mutation
+}
+
+// Save creates the PlaybackSession in the database.
+func (_c *PlaybackSessionCreate) Save(ctx context.Context) (*PlaybackSession, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PlaybackSessionCreate) SaveX(ctx context.Context) *PlaybackSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlaybackSessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlaybackSessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PlaybackSessionCreate) defaults() error {
+	if _, ok := _c.mutation.Device(); !ok {
+		v := playbacksession.DefaultDevice
+		_c.mutation.SetDevice(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if playbacksession.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized playbacksession.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := playbacksession.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PlaybackSessionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "PlaybackSession.created_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "PlaybackSession.user_id"`)}
+	}
+	if _, ok := _c.mutation.EpisodeID(); !ok {
+		return &ValidationError{Name: "episode_id", err: errors.New(`generated: missing required field "PlaybackSession.episode_id"`)}
+	}
+	if _, ok := _c.mutation.Device(); !ok {
+		return &ValidationError{Name: "device", err: errors.New(`generated: missing required field "PlaybackSession.device"`)}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`generated: missing required field "PlaybackSession.started_at"`)}
+	}
+	return nil
+}
+
+func (_c *PlaybackSessionCreate) sqlSave(ctx context.Context) (*PlaybackSession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PlaybackSessionCreate) createSpec() (*PlaybackSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PlaybackSession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(playbacksession.Table, sqlgraph.NewFieldSpec(playbacksession.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(playbacksession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(playbacksession.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.EpisodeID(); ok {
+		_spec.SetField(playbacksession.FieldEpisodeID, field.TypeUUID, value)
+		_node.EpisodeID = value
+	}
+	if value, ok := _c.mutation.Device(); ok {
+		_spec.SetField(playbacksession.FieldDevice, field.TypeString, value)
+		_node.Device = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(playbacksession.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(playbacksession.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	return _node, _spec
+}
+
+// PlaybackSessionCreateBulk is the builder for creating many PlaybackSession entities in bulk.
+type PlaybackSessionCreateBulk struct {
+	config
+	err      error
+	builders []*PlaybackSessionCreate
+}
+
+// Save creates the PlaybackSession entities in the database.
+func (_c *PlaybackSessionCreateBulk) Save(ctx context.Context) ([]*PlaybackSession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PlaybackSession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlaybackSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PlaybackSessionCreateBulk) SaveX(ctx context.Context) []*PlaybackSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlaybackSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlaybackSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playbacksession"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+)
+
+// PlaybackSessionUpdate is the builder for updating PlaybackSession entities.
+type PlaybackSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlaybackSessionMutation
+}
+
+// Where appends a list predicates to the PlaybackSessionUpdate builder.
+func (_u *PlaybackSessionUpdate) Where(ps ...predicate.PlaybackSession) *PlaybackSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *PlaybackSessionUpdate) SetFinishedAt(v time.Time) *PlaybackSessionUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *PlaybackSessionUpdate) SetNillableFinishedAt(v *time.Time) *PlaybackSessionUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *PlaybackSessionUpdate) ClearFinishedAt() *PlaybackSessionUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the PlaybackSessionMutation object of the builder.
+func (_u *PlaybackSessionUpdate) Mutation() *PlaybackSessionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PlaybackSessionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlaybackSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PlaybackSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlaybackSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PlaybackSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(playbacksession.Table, playbacksession.Columns, sqlgraph.NewFieldSpec(playbacksession.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(playbacksession.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(playbacksession.FieldFinishedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playbacksession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PlaybackSessionUpdateOne is the builder for updating a single PlaybackSession entity.
+type PlaybackSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlaybackSessionMutation
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *PlaybackSessionUpdateOne) SetFinishedAt(v time.Time) *PlaybackSessionUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *PlaybackSessionUpdateOne) SetNillableFinishedAt(v *time.Time) *PlaybackSessionUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *PlaybackSessionUpdateOne) ClearFinishedAt() *PlaybackSessionUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the PlaybackSessionMutation object of the builder.
+func (_u *PlaybackSessionUpdateOne) Mutation() *PlaybackSessionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PlaybackSessionUpdate builder.
+func (_u *PlaybackSessionUpdateOne) Where(ps ...predicate.PlaybackSession) *PlaybackSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PlaybackSessionUpdateOne) Select(field string, fields ...string) *PlaybackSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PlaybackSession entity.
+func (_u *PlaybackSessionUpdateOne) Save(ctx context.Context) (*PlaybackSession, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlaybackSessionUpdateOne) SaveX(ctx context.Context) *PlaybackSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PlaybackSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlaybackSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PlaybackSessionUpdateOne) sqlSave(ctx context.Context) (_node *PlaybackSession, err error) {
+	_spec := sqlgraph.NewUpdateSpec(playbacksession.Table, playbacksession.Columns, sqlgraph.NewFieldSpec(playbacksession.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "PlaybackSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, playbacksession.FieldID)
+		for _, f := range fields {
+			if !playbacksession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != playbacksession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(playbacksession.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(playbacksession.FieldFinishedAt, field.TypeTime)
+	}
+	_node = &PlaybackSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playbacksession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
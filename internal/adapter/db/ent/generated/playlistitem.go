@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episode"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playlist"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playlistitem"
+	"github.com/google/uuid"
+)
+
+// PlaylistItem is the model entity for the PlaylistItem schema.
+type PlaylistItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// PlaylistID holds the value of the "playlist_id" field.
+	PlaylistID uuid.UUID `json:"playlist_id,omitempty"`
+	// EpisodeID holds the value of the "episode_id" field.
+	EpisodeID uuid.UUID `json:"episode_id,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PlaylistItemQuery when eager-loading is set.
+	Edges        PlaylistItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PlaylistItemEdges holds the relations/edges for other nodes in the graph.
+type PlaylistItemEdges struct {
+	// Playlist holds the value of the playlist edge.
+	Playlist *Playlist `json:"playlist,omitempty"`
+	// Episode holds the value of the episode edge.
+	Episode *Episode `json:"episode,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PlaylistOrErr returns the Playlist value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlaylistItemEdges) PlaylistOrErr() (*Playlist, error) {
+	if e.Playlist != nil {
+		return e.Playlist, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: playlist.Label}
+	}
+	return nil, &NotLoadedError{edge: "playlist"}
+}
+
+// EpisodeOrErr returns the Episode value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlaylistItemEdges) EpisodeOrErr() (*Episode, error) {
+	if e.Episode != nil {
+		return e.Episode, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: episode.Label}
+	}
+	return nil, &NotLoadedError{edge: "episode"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PlaylistItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case playlistitem.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case playlistitem.FieldID, playlistitem.FieldPlaylistID, playlistitem.FieldEpisodeID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PlaylistItem fields.
+func (_m *PlaylistItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case playlistitem.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case playlistitem.FieldPlaylistID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field playlist_id", values[i])
+			} else if value != nil {
+				_m.PlaylistID = *value
+			}
+		case playlistitem.FieldEpisodeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field episode_id", values[i])
+			} else if value != nil {
+				_m.EpisodeID = *value
+			}
+		case playlistitem.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PlaylistItem.
+// This includes values selected through modifiers, order, etc.
+func (_m *PlaylistItem) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPlaylist queries the "playlist" edge of the PlaylistItem entity.
+func (_m *PlaylistItem) QueryPlaylist() *PlaylistQuery {
+	return NewPlaylistItemClient(_m.config).QueryPlaylist(_m)
+}
+
+// QueryEpisode queries the "episode" edge of the PlaylistItem entity.
+func (_m *PlaylistItem) QueryEpisode() *EpisodeQuery {
+	return NewPlaylistItemClient(_m.config).QueryEpisode(_m)
+}
+
+// Update returns a builder for updating this PlaylistItem.
+// Note that you need to call PlaylistItem.Unwrap() before calling this method if this PlaylistItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PlaylistItem) Update() *PlaylistItemUpdateOne {
+	return NewPlaylistItemClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PlaylistItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PlaylistItem) Unwrap() *PlaylistItem {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: PlaylistItem is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PlaylistItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("PlaylistItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("playlist_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PlaylistID))
+	builder.WriteString(", ")
+	builder.WriteString("episode_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EpisodeID))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PlaylistItems is a parsable slice of PlaylistItem.
+type PlaylistItems []*PlaylistItem
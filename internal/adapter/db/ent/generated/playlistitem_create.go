@@ -0,0 +1,283 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episode"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playlist"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playlistitem"
+	"github.com/google/uuid"
+)
+
+// PlaylistItemCreate is the builder for creating a PlaylistItem entity.
+type PlaylistItemCreate struct {
+	config
+	mutation *PlaylistItemMutation
+	hooks    []Hook
+}
+
+// SetPlaylistID sets the "playlist_id" field.
+func (_c *PlaylistItemCreate) SetPlaylistID(v uuid.UUID) *PlaylistItemCreate {
+	_c.mutation.SetPlaylistID(v)
+	return _c
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_c *PlaylistItemCreate) SetEpisodeID(v uuid.UUID) *PlaylistItemCreate {
+	_c.mutation.SetEpisodeID(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *PlaylistItemCreate) SetPosition(v int) *PlaylistItemCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PlaylistItemCreate) SetID(v uuid.UUID) *PlaylistItemCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PlaylistItemCreate) SetNillableID(v *uuid.UUID) *PlaylistItemCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPlaylist sets the "playlist" edge to the Playlist entity.
+func (_c *PlaylistItemCreate) SetPlaylist(v *Playlist) *PlaylistItemCreate {
+	return _c.SetPlaylistID(v.ID)
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (_c *PlaylistItemCreate) SetEpisode(v *Episode) *PlaylistItemCreate {
+	return _c.SetEpisodeID(v.ID)
+}
+
+// Mutation returns the PlaylistItemMutation object of the builder.
+func (_c *PlaylistItemCreate) Mutation() *PlaylistItemMutation {
+	return _c.mutation
+}
+
+// Save creates the PlaylistItem in the database.
+func (_c *PlaylistItemCreate) Save(ctx context.Context) (*PlaylistItem, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PlaylistItemCreate) SaveX(ctx context.Context) *PlaylistItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlaylistItemCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlaylistItemCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PlaylistItemCreate) defaults() {
+	if _, ok := _c.mutation.ID(); !ok {
+		v := playlistitem.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PlaylistItemCreate) check() error {
+	if _, ok := _c.mutation.PlaylistID(); !ok {
+		return &ValidationError{Name: "playlist_id", err: errors.New(`generated: missing required field "PlaylistItem.playlist_id"`)}
+	}
+	if _, ok := _c.mutation.EpisodeID(); !ok {
+		return &ValidationError{Name: "episode_id", err: errors.New(`generated: missing required field "PlaylistItem.episode_id"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`generated: missing required field "PlaylistItem.position"`)}
+	}
+	if len(_c.mutation.PlaylistIDs()) == 0 {
+		return &ValidationError{Name: "playlist", err: errors.New(`generated: missing required edge "PlaylistItem.playlist"`)}
+	}
+	if len(_c.mutation.EpisodeIDs()) == 0 {
+		return &ValidationError{Name: "episode", err: errors.New(`generated: missing required edge "PlaylistItem.episode"`)}
+	}
+	return nil
+}
+
+func (_c *PlaylistItemCreate) sqlSave(ctx context.Context) (*PlaylistItem, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PlaylistItemCreate) createSpec() (*PlaylistItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PlaylistItem{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(playlistitem.Table, sqlgraph.NewFieldSpec(playlistitem.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(playlistitem.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if nodes := _c.mutation.PlaylistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistitem.PlaylistTable,
+			Columns: []string{playlistitem.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlaylistID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlistitem.EpisodeTable,
+			Columns: []string{playlistitem.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EpisodeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PlaylistItemCreateBulk is the builder for creating many PlaylistItem entities in bulk.
+type PlaylistItemCreateBulk struct {
+	config
+	err      error
+	builders []*PlaylistItemCreate
+}
+
+// Save creates the PlaylistItem entities in the database.
+func (_c *PlaylistItemCreateBulk) Save(ctx context.Context) ([]*PlaylistItem, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PlaylistItem, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlaylistItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PlaylistItemCreateBulk) SaveX(ctx context.Context) []*PlaylistItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlaylistItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlaylistItemCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
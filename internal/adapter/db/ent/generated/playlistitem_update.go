@@ -0,0 +1,476 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episode"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playlist"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playlistitem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// PlaylistItemUpdate is the builder for updating PlaylistItem entities.
+type PlaylistItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlaylistItemMutation
+}
+
+// Where appends a list predicates to the PlaylistItemUpdate builder.
+func (_u *PlaylistItemUpdate) Where(ps ...predicate.PlaylistItem) *PlaylistItemUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPlaylistID sets the "playlist_id" field.
+func (_u *PlaylistItemUpdate) SetPlaylistID(v uuid.UUID) *PlaylistItemUpdate {
+	_u.mutation.SetPlaylistID(v)
+	return _u
+}
+
+// SetNillablePlaylistID sets the "playlist_id" field if the given value is not nil.
+func (_u *PlaylistItemUpdate) SetNillablePlaylistID(v *uuid.UUID) *PlaylistItemUpdate {
+	if v != nil {
+		_u.SetPlaylistID(*v)
+	}
+	return _u
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_u *PlaylistItemUpdate) SetEpisodeID(v uuid.UUID) *PlaylistItemUpdate {
+	_u.mutation.SetEpisodeID(v)
+	return _u
+}
+
+// SetNillableEpisodeID sets the "episode_id" field if the given value is not nil.
+func (_u *PlaylistItemUpdate) SetNillableEpisodeID(v *uuid.UUID) *PlaylistItemUpdate {
+	if v != nil {
+		_u.SetEpisodeID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *PlaylistItemUpdate) SetPosition(v int) *PlaylistItemUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *PlaylistItemUpdate) SetNillablePosition(v *int) *PlaylistItemUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *PlaylistItemUpdate) AddPosition(v int) *PlaylistItemUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetPlaylist sets the "playlist" edge to the Playlist entity.
+func (_u *PlaylistItemUpdate) SetPlaylist(v *Playlist) *PlaylistItemUpdate {
+	return _u.SetPlaylistID(v.ID)
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (_u *PlaylistItemUpdate) SetEpisode(v *Episode) *PlaylistItemUpdate {
+	return _u.SetEpisodeID(v.ID)
+}
+
+// Mutation returns the PlaylistItemMutation object of the builder.
+func (_u *PlaylistItemUpdate) Mutation() *PlaylistItemMutation {
+	return _u.mutation
+}
+
+// ClearPlaylist clears the "playlist" edge to the Playlist entity.
+func (_u *PlaylistItemUpdate) ClearPlaylist() *PlaylistItemUpdate {
+	_u.mutation.ClearPlaylist()
+	return _u
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (_u *PlaylistItemUpdate) ClearEpisode() *PlaylistItemUpdate {
+	_u.mutation.ClearEpisode()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PlaylistItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlaylistItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PlaylistItemUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlaylistItemUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlaylistItemUpdate) check() error {
+	if _u.mutation.PlaylistCleared() && len(_u.mutation.PlaylistIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "PlaylistItem.playlist"`)
+	}
+	if _u.mutation.EpisodeCleared() && len(_u.mutation.EpisodeIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "PlaylistItem.episode"`)
+	}
+	return nil
+}
+
+func (_u *PlaylistItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playlistitem.Table, playlistitem.Columns, sqlgraph.NewFieldSpec(playlistitem.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(playlistitem.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(playlistitem.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.PlaylistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistitem.PlaylistTable,
+			Columns: []string{playlistitem.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlaylistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistitem.PlaylistTable,
+			Columns: []string{playlistitem.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EpisodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlistitem.EpisodeTable,
+			Columns: []string{playlistitem.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlistitem.EpisodeTable,
+			Columns: []string{playlistitem.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playlistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PlaylistItemUpdateOne is the builder for updating a single PlaylistItem entity.
+type PlaylistItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlaylistItemMutation
+}
+
+// SetPlaylistID sets the "playlist_id" field.
+func (_u *PlaylistItemUpdateOne) SetPlaylistID(v uuid.UUID) *PlaylistItemUpdateOne {
+	_u.mutation.SetPlaylistID(v)
+	return _u
+}
+
+// SetNillablePlaylistID sets the "playlist_id" field if the given value is not nil.
+func (_u *PlaylistItemUpdateOne) SetNillablePlaylistID(v *uuid.UUID) *PlaylistItemUpdateOne {
+	if v != nil {
+		_u.SetPlaylistID(*v)
+	}
+	return _u
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_u *PlaylistItemUpdateOne) SetEpisodeID(v uuid.UUID) *PlaylistItemUpdateOne {
+	_u.mutation.SetEpisodeID(v)
+	return _u
+}
+
+// SetNillableEpisodeID sets the "episode_id" field if the given value is not nil.
+func (_u *PlaylistItemUpdateOne) SetNillableEpisodeID(v *uuid.UUID) *PlaylistItemUpdateOne {
+	if v != nil {
+		_u.SetEpisodeID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *PlaylistItemUpdateOne) SetPosition(v int) *PlaylistItemUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *PlaylistItemUpdateOne) SetNillablePosition(v *int) *PlaylistItemUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *PlaylistItemUpdateOne) AddPosition(v int) *PlaylistItemUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetPlaylist sets the "playlist" edge to the Playlist entity.
+func (_u *PlaylistItemUpdateOne) SetPlaylist(v *Playlist) *PlaylistItemUpdateOne {
+	return _u.SetPlaylistID(v.ID)
+}
+
+// SetEpisode sets the "episode" edge to the Episode entity.
+func (_u *PlaylistItemUpdateOne) SetEpisode(v *Episode) *PlaylistItemUpdateOne {
+	return _u.SetEpisodeID(v.ID)
+}
+
+// Mutation returns the PlaylistItemMutation object of the builder.
+func (_u *PlaylistItemUpdateOne) Mutation() *PlaylistItemMutation {
+	return _u.mutation
+}
+
+// ClearPlaylist clears the "playlist" edge to the Playlist entity.
+func (_u *PlaylistItemUpdateOne) ClearPlaylist() *PlaylistItemUpdateOne {
+	_u.mutation.ClearPlaylist()
+	return _u
+}
+
+// ClearEpisode clears the "episode" edge to the Episode entity.
+func (_u *PlaylistItemUpdateOne) ClearEpisode() *PlaylistItemUpdateOne {
+	_u.mutation.ClearEpisode()
+	return _u
+}
+
+// Where appends a list predicates to the PlaylistItemUpdate builder.
+func (_u *PlaylistItemUpdateOne) Where(ps ...predicate.PlaylistItem) *PlaylistItemUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PlaylistItemUpdateOne) Select(field string, fields ...string) *PlaylistItemUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PlaylistItem entity.
+func (_u *PlaylistItemUpdateOne) Save(ctx context.Context) (*PlaylistItem, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlaylistItemUpdateOne) SaveX(ctx context.Context) *PlaylistItem {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PlaylistItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlaylistItemUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlaylistItemUpdateOne) check() error {
+	if _u.mutation.PlaylistCleared() && len(_u.mutation.PlaylistIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "PlaylistItem.playlist"`)
+	}
+	if _u.mutation.EpisodeCleared() && len(_u.mutation.EpisodeIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "PlaylistItem.episode"`)
+	}
+	return nil
+}
+
+func (_u *PlaylistItemUpdateOne) sqlSave(ctx context.Context) (_node *PlaylistItem, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(playlistitem.Table, playlistitem.Columns, sqlgraph.NewFieldSpec(playlistitem.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "PlaylistItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, playlistitem.FieldID)
+		for _, f := range fields {
+			if !playlistitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != playlistitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(playlistitem.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(playlistitem.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.PlaylistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistitem.PlaylistTable,
+			Columns: []string{playlistitem.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlaylistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   playlistitem.PlaylistTable,
+			Columns: []string{playlistitem.PlaylistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(playlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EpisodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlistitem.EpisodeTable,
+			Columns: []string{playlistitem.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EpisodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   playlistitem.EpisodeTable,
+			Columns: []string{playlistitem.EpisodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(episode.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PlaylistItem{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{playlistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
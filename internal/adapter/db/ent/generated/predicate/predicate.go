@@ -6,14 +6,149 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
 // Asset is the predicate function for asset builders.
 type Asset func(*sql.Selector)
 
+// AssetUsageDay is the predicate function for assetusageday builders.
+type AssetUsageDay func(*sql.Selector)
+
+// AssetVersion is the predicate function for assetversion builders.
+type AssetVersion func(*sql.Selector)
+
+// AuditEntry is the predicate function for auditentry builders.
+type AuditEntry func(*sql.Selector)
+
+// AvailabilitySlot is the predicate function for availabilityslot builders.
+type AvailabilitySlot func(*sql.Selector)
+
+// Booking is the predicate function for booking builders.
+type Booking func(*sql.Selector)
+
+// Classroom is the predicate function for classroom builders.
+type Classroom func(*sql.Selector)
+
+// ClassroomAssignment is the predicate function for classroomassignment builders.
+type ClassroomAssignment func(*sql.Selector)
+
+// ClassroomMember is the predicate function for classroommember builders.
+type ClassroomMember func(*sql.Selector)
+
+// ContentEmbedding is the predicate function for contentembedding builders.
+type ContentEmbedding func(*sql.Selector)
+
+// ContentKey is the predicate function for contentkey builders.
+type ContentKey func(*sql.Selector)
+
+// ContentReassignment is the predicate function for contentreassignment builders.
+type ContentReassignment func(*sql.Selector)
+
+// DeviceToken is the predicate function for devicetoken builders.
+type DeviceToken func(*sql.Selector)
+
+// DictationAttempt is the predicate function for dictationattempt builders.
+type DictationAttempt func(*sql.Selector)
+
+// EngagementRollup is the predicate function for engagementrollup builders.
+type EngagementRollup func(*sql.Selector)
+
 // Episode is the predicate function for episode builders.
 type Episode func(*sql.Selector)
 
+// EpisodeTranscript is the predicate function for episodetranscript builders.
+type EpisodeTranscript func(*sql.Selector)
+
+// Event is the predicate function for event builders.
+type Event func(*sql.Selector)
+
+// FeedItem is the predicate function for feeditem builders.
+type FeedItem func(*sql.Selector)
+
+// FeedSubscription is the predicate function for feedsubscription builders.
+type FeedSubscription func(*sql.Selector)
+
+// Invoice is the predicate function for invoice builders.
+type Invoice func(*sql.Selector)
+
+// Job is the predicate function for job builders.
+type Job func(*sql.Selector)
+
+// LTILaunch is the predicate function for ltilaunch builders.
+type LTILaunch func(*sql.Selector)
+
+// LTILoginState is the predicate function for ltiloginstate builders.
+type LTILoginState func(*sql.Selector)
+
+// LTIPlatform is the predicate function for ltiplatform builders.
+type LTIPlatform func(*sql.Selector)
+
+// LearnerActivity is the predicate function for learneractivity builders.
+type LearnerActivity func(*sql.Selector)
+
+// ModerationItem is the predicate function for moderationitem builders.
+type ModerationItem func(*sql.Selector)
+
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
+// NotificationPreference is the predicate function for notificationpreference builders.
+type NotificationPreference func(*sql.Selector)
+
+// OutboxMessage is the predicate function for outboxmessage builders.
+type OutboxMessage func(*sql.Selector)
+
+// Plan is the predicate function for plan builders.
+type Plan func(*sql.Selector)
+
+// PlaybackSession is the predicate function for playbacksession builders.
+type PlaybackSession func(*sql.Selector)
+
+// Playlist is the predicate function for playlist builders.
+type Playlist func(*sql.Selector)
+
+// PlaylistItem is the predicate function for playlistitem builders.
+type PlaylistItem func(*sql.Selector)
+
+// QuizItem is the predicate function for quizitem builders.
+type QuizItem func(*sql.Selector)
+
+// ScheduledTask is the predicate function for scheduledtask builders.
+type ScheduledTask func(*sql.Selector)
+
 // Series is the predicate function for series builders.
 type Series func(*sql.Selector)
 
+// ShadowingSubmission is the predicate function for shadowingsubmission builders.
+type ShadowingSubmission func(*sql.Selector)
+
+// Subscription is the predicate function for subscription builders.
+type Subscription func(*sql.Selector)
+
+// TranscriptReplaceJob is the predicate function for transcriptreplacejob builders.
+type TranscriptReplaceJob func(*sql.Selector)
+
+// TranscriptRevision is the predicate function for transcriptrevision builders.
+type TranscriptRevision func(*sql.Selector)
+
 // UploadSession is the predicate function for uploadsession builders.
 type UploadSession func(*sql.Selector)
+
+// UsageRecord is the predicate function for usagerecord builders.
+type UsageRecord func(*sql.Selector)
+
+// UsageSnapshot is the predicate function for usagesnapshot builders.
+type UsageSnapshot func(*sql.Selector)
+
+// VocabularyWord is the predicate function for vocabularyword builders.
+type VocabularyWord func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookEndpoint is the predicate function for webhookendpoint builders.
+type WebhookEndpoint func(*sql.Selector)
+
+// WebhookEvent is the predicate function for webhookevent builders.
+type WebhookEvent func(*sql.Selector)
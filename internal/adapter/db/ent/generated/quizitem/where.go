@@ -0,0 +1,521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quizitem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EpisodeID applies equality check predicate on the "episode_id" field. It's identical to EpisodeIDEQ.
+func EpisodeID(v uuid.UUID) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldEpisodeID, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v int) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldKind, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldPosition, v))
+}
+
+// Prompt applies equality check predicate on the "prompt" field. It's identical to PromptEQ.
+func Prompt(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldPrompt, v))
+}
+
+// Answer applies equality check predicate on the "answer" field. It's identical to AnswerEQ.
+func Answer(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldAnswer, v))
+}
+
+// Sentence applies equality check predicate on the "sentence" field. It's identical to SentenceEQ.
+func Sentence(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldSentence, v))
+}
+
+// NeedsReview applies equality check predicate on the "needs_review" field. It's identical to NeedsReviewEQ.
+func NeedsReview(v bool) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldNeedsReview, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// EpisodeIDEQ applies the EQ predicate on the "episode_id" field.
+func EpisodeIDEQ(v uuid.UUID) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldEpisodeID, v))
+}
+
+// EpisodeIDNEQ applies the NEQ predicate on the "episode_id" field.
+func EpisodeIDNEQ(v uuid.UUID) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNEQ(FieldEpisodeID, v))
+}
+
+// EpisodeIDIn applies the In predicate on the "episode_id" field.
+func EpisodeIDIn(vs ...uuid.UUID) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldIn(FieldEpisodeID, vs...))
+}
+
+// EpisodeIDNotIn applies the NotIn predicate on the "episode_id" field.
+func EpisodeIDNotIn(vs ...uuid.UUID) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNotIn(FieldEpisodeID, vs...))
+}
+
+// EpisodeIDGT applies the GT predicate on the "episode_id" field.
+func EpisodeIDGT(v uuid.UUID) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldGT(FieldEpisodeID, v))
+}
+
+// EpisodeIDGTE applies the GTE predicate on the "episode_id" field.
+func EpisodeIDGTE(v uuid.UUID) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldGTE(FieldEpisodeID, v))
+}
+
+// EpisodeIDLT applies the LT predicate on the "episode_id" field.
+func EpisodeIDLT(v uuid.UUID) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldLT(FieldEpisodeID, v))
+}
+
+// EpisodeIDLTE applies the LTE predicate on the "episode_id" field.
+func EpisodeIDLTE(v uuid.UUID) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldLTE(FieldEpisodeID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v int) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v int) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...int) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...int) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v int) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v int) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v int) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v int) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldLTE(FieldKind, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldLTE(FieldPosition, v))
+}
+
+// PromptEQ applies the EQ predicate on the "prompt" field.
+func PromptEQ(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldPrompt, v))
+}
+
+// PromptNEQ applies the NEQ predicate on the "prompt" field.
+func PromptNEQ(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNEQ(FieldPrompt, v))
+}
+
+// PromptIn applies the In predicate on the "prompt" field.
+func PromptIn(vs ...string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldIn(FieldPrompt, vs...))
+}
+
+// PromptNotIn applies the NotIn predicate on the "prompt" field.
+func PromptNotIn(vs ...string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNotIn(FieldPrompt, vs...))
+}
+
+// PromptGT applies the GT predicate on the "prompt" field.
+func PromptGT(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldGT(FieldPrompt, v))
+}
+
+// PromptGTE applies the GTE predicate on the "prompt" field.
+func PromptGTE(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldGTE(FieldPrompt, v))
+}
+
+// PromptLT applies the LT predicate on the "prompt" field.
+func PromptLT(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldLT(FieldPrompt, v))
+}
+
+// PromptLTE applies the LTE predicate on the "prompt" field.
+func PromptLTE(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldLTE(FieldPrompt, v))
+}
+
+// PromptContains applies the Contains predicate on the "prompt" field.
+func PromptContains(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldContains(FieldPrompt, v))
+}
+
+// PromptHasPrefix applies the HasPrefix predicate on the "prompt" field.
+func PromptHasPrefix(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldHasPrefix(FieldPrompt, v))
+}
+
+// PromptHasSuffix applies the HasSuffix predicate on the "prompt" field.
+func PromptHasSuffix(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldHasSuffix(FieldPrompt, v))
+}
+
+// PromptEqualFold applies the EqualFold predicate on the "prompt" field.
+func PromptEqualFold(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEqualFold(FieldPrompt, v))
+}
+
+// PromptContainsFold applies the ContainsFold predicate on the "prompt" field.
+func PromptContainsFold(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldContainsFold(FieldPrompt, v))
+}
+
+// AnswerEQ applies the EQ predicate on the "answer" field.
+func AnswerEQ(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldAnswer, v))
+}
+
+// AnswerNEQ applies the NEQ predicate on the "answer" field.
+func AnswerNEQ(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNEQ(FieldAnswer, v))
+}
+
+// AnswerIn applies the In predicate on the "answer" field.
+func AnswerIn(vs ...string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldIn(FieldAnswer, vs...))
+}
+
+// AnswerNotIn applies the NotIn predicate on the "answer" field.
+func AnswerNotIn(vs ...string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNotIn(FieldAnswer, vs...))
+}
+
+// AnswerGT applies the GT predicate on the "answer" field.
+func AnswerGT(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldGT(FieldAnswer, v))
+}
+
+// AnswerGTE applies the GTE predicate on the "answer" field.
+func AnswerGTE(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldGTE(FieldAnswer, v))
+}
+
+// AnswerLT applies the LT predicate on the "answer" field.
+func AnswerLT(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldLT(FieldAnswer, v))
+}
+
+// AnswerLTE applies the LTE predicate on the "answer" field.
+func AnswerLTE(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldLTE(FieldAnswer, v))
+}
+
+// AnswerContains applies the Contains predicate on the "answer" field.
+func AnswerContains(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldContains(FieldAnswer, v))
+}
+
+// AnswerHasPrefix applies the HasPrefix predicate on the "answer" field.
+func AnswerHasPrefix(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldHasPrefix(FieldAnswer, v))
+}
+
+// AnswerHasSuffix applies the HasSuffix predicate on the "answer" field.
+func AnswerHasSuffix(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldHasSuffix(FieldAnswer, v))
+}
+
+// AnswerEqualFold applies the EqualFold predicate on the "answer" field.
+func AnswerEqualFold(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEqualFold(FieldAnswer, v))
+}
+
+// AnswerContainsFold applies the ContainsFold predicate on the "answer" field.
+func AnswerContainsFold(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldContainsFold(FieldAnswer, v))
+}
+
+// SentenceEQ applies the EQ predicate on the "sentence" field.
+func SentenceEQ(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldSentence, v))
+}
+
+// SentenceNEQ applies the NEQ predicate on the "sentence" field.
+func SentenceNEQ(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNEQ(FieldSentence, v))
+}
+
+// SentenceIn applies the In predicate on the "sentence" field.
+func SentenceIn(vs ...string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldIn(FieldSentence, vs...))
+}
+
+// SentenceNotIn applies the NotIn predicate on the "sentence" field.
+func SentenceNotIn(vs ...string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNotIn(FieldSentence, vs...))
+}
+
+// SentenceGT applies the GT predicate on the "sentence" field.
+func SentenceGT(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldGT(FieldSentence, v))
+}
+
+// SentenceGTE applies the GTE predicate on the "sentence" field.
+func SentenceGTE(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldGTE(FieldSentence, v))
+}
+
+// SentenceLT applies the LT predicate on the "sentence" field.
+func SentenceLT(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldLT(FieldSentence, v))
+}
+
+// SentenceLTE applies the LTE predicate on the "sentence" field.
+func SentenceLTE(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldLTE(FieldSentence, v))
+}
+
+// SentenceContains applies the Contains predicate on the "sentence" field.
+func SentenceContains(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldContains(FieldSentence, v))
+}
+
+// SentenceHasPrefix applies the HasPrefix predicate on the "sentence" field.
+func SentenceHasPrefix(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldHasPrefix(FieldSentence, v))
+}
+
+// SentenceHasSuffix applies the HasSuffix predicate on the "sentence" field.
+func SentenceHasSuffix(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldHasSuffix(FieldSentence, v))
+}
+
+// SentenceEqualFold applies the EqualFold predicate on the "sentence" field.
+func SentenceEqualFold(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEqualFold(FieldSentence, v))
+}
+
+// SentenceContainsFold applies the ContainsFold predicate on the "sentence" field.
+func SentenceContainsFold(v string) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldContainsFold(FieldSentence, v))
+}
+
+// NeedsReviewEQ applies the EQ predicate on the "needs_review" field.
+func NeedsReviewEQ(v bool) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldEQ(FieldNeedsReview, v))
+}
+
+// NeedsReviewNEQ applies the NEQ predicate on the "needs_review" field.
+func NeedsReviewNEQ(v bool) predicate.QuizItem {
+	return predicate.QuizItem(sql.FieldNEQ(FieldNeedsReview, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QuizItem) predicate.QuizItem {
+	return predicate.QuizItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QuizItem) predicate.QuizItem {
+	return predicate.QuizItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QuizItem) predicate.QuizItem {
+	return predicate.QuizItem(sql.NotPredicates(p))
+}
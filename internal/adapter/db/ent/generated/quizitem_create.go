@@ -0,0 +1,360 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/quizitem"
+	"github.com/google/uuid"
+)
+
+// QuizItemCreate is the builder for creating a QuizItem entity.
+type QuizItemCreate struct {
+	config
+	mutation *QuizItemMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *QuizItemCreate) SetCreatedAt(v time.Time) *QuizItemCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *QuizItemCreate) SetUpdatedAt(v time.Time) *QuizItemCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_c *QuizItemCreate) SetEpisodeID(v uuid.UUID) *QuizItemCreate {
+	_c.mutation.SetEpisodeID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *QuizItemCreate) SetKind(v int) *QuizItemCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *QuizItemCreate) SetPosition(v int) *QuizItemCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *QuizItemCreate) SetNillablePosition(v *int) *QuizItemCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetPrompt sets the "prompt" field.
+func (_c *QuizItemCreate) SetPrompt(v string) *QuizItemCreate {
+	_c.mutation.SetPrompt(v)
+	return _c
+}
+
+// SetAnswer sets the "answer" field.
+func (_c *QuizItemCreate) SetAnswer(v string) *QuizItemCreate {
+	_c.mutation.SetAnswer(v)
+	return _c
+}
+
+// SetSentence sets the "sentence" field.
+func (_c *QuizItemCreate) SetSentence(v string) *QuizItemCreate {
+	_c.mutation.SetSentence(v)
+	return _c
+}
+
+// SetNillableSentence sets the "sentence" field if the given value is not nil.
+func (_c *QuizItemCreate) SetNillableSentence(v *string) *QuizItemCreate {
+	if v != nil {
+		_c.SetSentence(*v)
+	}
+	return _c
+}
+
+// SetNeedsReview sets the "needs_review" field.
+func (_c *QuizItemCreate) SetNeedsReview(v bool) *QuizItemCreate {
+	_c.mutation.SetNeedsReview(v)
+	return _c
+}
+
+// SetNillableNeedsReview sets the "needs_review" field if the given value is not nil.
+func (_c *QuizItemCreate) SetNillableNeedsReview(v *bool) *QuizItemCreate {
+	if v != nil {
+		_c.SetNeedsReview(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *QuizItemCreate) SetID(v uuid.UUID) *QuizItemCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *QuizItemCreate) SetNillableID(v *uuid.UUID) *QuizItemCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the QuizItemMutation object of the builder.
+func (_c *QuizItemCreate) Mutation() *QuizItemMutation {
+	return _c.mutation
+}
+
+// Save creates the QuizItem in the database.
+func (_c *QuizItemCreate) Save(ctx context.Context) (*QuizItem, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *QuizItemCreate) SaveX(ctx context.Context) *QuizItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuizItemCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuizItemCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *QuizItemCreate) defaults() error {
+	if _, ok := _c.mutation.Position(); !ok {
+		v := quizitem.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+	if _, ok := _c.mutation.Sentence(); !ok {
+		v := quizitem.DefaultSentence
+		_c.mutation.SetSentence(v)
+	}
+	if _, ok := _c.mutation.NeedsReview(); !ok {
+		v := quizitem.DefaultNeedsReview
+		_c.mutation.SetNeedsReview(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if quizitem.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized quizitem.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := quizitem.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *QuizItemCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "QuizItem.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "QuizItem.updated_at"`)}
+	}
+	if _, ok := _c.mutation.EpisodeID(); !ok {
+		return &ValidationError{Name: "episode_id", err: errors.New(`generated: missing required field "QuizItem.episode_id"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`generated: missing required field "QuizItem.kind"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`generated: missing required field "QuizItem.position"`)}
+	}
+	if _, ok := _c.mutation.Prompt(); !ok {
+		return &ValidationError{Name: "prompt", err: errors.New(`generated: missing required field "QuizItem.prompt"`)}
+	}
+	if _, ok := _c.mutation.Answer(); !ok {
+		return &ValidationError{Name: "answer", err: errors.New(`generated: missing required field "QuizItem.answer"`)}
+	}
+	if _, ok := _c.mutation.Sentence(); !ok {
+		return &ValidationError{Name: "sentence", err: errors.New(`generated: missing required field "QuizItem.sentence"`)}
+	}
+	if _, ok := _c.mutation.NeedsReview(); !ok {
+		return &ValidationError{Name: "needs_review", err: errors.New(`generated: missing required field "QuizItem.needs_review"`)}
+	}
+	return nil
+}
+
+func (_c *QuizItemCreate) sqlSave(ctx context.Context) (*QuizItem, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *QuizItemCreate) createSpec() (*QuizItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QuizItem{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(quizitem.Table, sqlgraph.NewFieldSpec(quizitem.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(quizitem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(quizitem.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.EpisodeID(); ok {
+		_spec.SetField(quizitem.FieldEpisodeID, field.TypeUUID, value)
+		_node.EpisodeID = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(quizitem.FieldKind, field.TypeInt, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(quizitem.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.Prompt(); ok {
+		_spec.SetField(quizitem.FieldPrompt, field.TypeString, value)
+		_node.Prompt = value
+	}
+	if value, ok := _c.mutation.Answer(); ok {
+		_spec.SetField(quizitem.FieldAnswer, field.TypeString, value)
+		_node.Answer = value
+	}
+	if value, ok := _c.mutation.Sentence(); ok {
+		_spec.SetField(quizitem.FieldSentence, field.TypeString, value)
+		_node.Sentence = value
+	}
+	if value, ok := _c.mutation.NeedsReview(); ok {
+		_spec.SetField(quizitem.FieldNeedsReview, field.TypeBool, value)
+		_node.NeedsReview = value
+	}
+	return _node, _spec
+}
+
+// QuizItemCreateBulk is the builder for creating many QuizItem entities in bulk.
+type QuizItemCreateBulk struct {
+	config
+	err      error
+	builders []*QuizItemCreate
+}
+
+// Save creates the QuizItem entities in the database.
+func (_c *QuizItemCreateBulk) Save(ctx context.Context) ([]*QuizItem, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*QuizItem, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuizItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *QuizItemCreateBulk) SaveX(ctx context.Context) []*QuizItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuizItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuizItemCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,400 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/quizitem"
+)
+
+// QuizItemUpdate is the builder for updating QuizItem entities.
+type QuizItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuizItemMutation
+}
+
+// Where appends a list predicates to the QuizItemUpdate builder.
+func (_u *QuizItemUpdate) Where(ps ...predicate.QuizItem) *QuizItemUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *QuizItemUpdate) SetUpdatedAt(v time.Time) *QuizItemUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *QuizItemUpdate) SetNillableUpdatedAt(v *time.Time) *QuizItemUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *QuizItemUpdate) SetPosition(v int) *QuizItemUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *QuizItemUpdate) SetNillablePosition(v *int) *QuizItemUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *QuizItemUpdate) AddPosition(v int) *QuizItemUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetPrompt sets the "prompt" field.
+func (_u *QuizItemUpdate) SetPrompt(v string) *QuizItemUpdate {
+	_u.mutation.SetPrompt(v)
+	return _u
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (_u *QuizItemUpdate) SetNillablePrompt(v *string) *QuizItemUpdate {
+	if v != nil {
+		_u.SetPrompt(*v)
+	}
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *QuizItemUpdate) SetAnswer(v string) *QuizItemUpdate {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *QuizItemUpdate) SetNillableAnswer(v *string) *QuizItemUpdate {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// SetSentence sets the "sentence" field.
+func (_u *QuizItemUpdate) SetSentence(v string) *QuizItemUpdate {
+	_u.mutation.SetSentence(v)
+	return _u
+}
+
+// SetNillableSentence sets the "sentence" field if the given value is not nil.
+func (_u *QuizItemUpdate) SetNillableSentence(v *string) *QuizItemUpdate {
+	if v != nil {
+		_u.SetSentence(*v)
+	}
+	return _u
+}
+
+// SetNeedsReview sets the "needs_review" field.
+func (_u *QuizItemUpdate) SetNeedsReview(v bool) *QuizItemUpdate {
+	_u.mutation.SetNeedsReview(v)
+	return _u
+}
+
+// SetNillableNeedsReview sets the "needs_review" field if the given value is not nil.
+func (_u *QuizItemUpdate) SetNillableNeedsReview(v *bool) *QuizItemUpdate {
+	if v != nil {
+		_u.SetNeedsReview(*v)
+	}
+	return _u
+}
+
+// Mutation returns the QuizItemMutation object of the builder.
+func (_u *QuizItemUpdate) Mutation() *QuizItemMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *QuizItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuizItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *QuizItemUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuizItemUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *QuizItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(quizitem.Table, quizitem.Columns, sqlgraph.NewFieldSpec(quizitem.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(quizitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(quizitem.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(quizitem.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Prompt(); ok {
+		_spec.SetField(quizitem.FieldPrompt, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(quizitem.FieldAnswer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Sentence(); ok {
+		_spec.SetField(quizitem.FieldSentence, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NeedsReview(); ok {
+		_spec.SetField(quizitem.FieldNeedsReview, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quizitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// QuizItemUpdateOne is the builder for updating a single QuizItem entity.
+type QuizItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuizItemMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *QuizItemUpdateOne) SetUpdatedAt(v time.Time) *QuizItemUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *QuizItemUpdateOne) SetNillableUpdatedAt(v *time.Time) *QuizItemUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *QuizItemUpdateOne) SetPosition(v int) *QuizItemUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *QuizItemUpdateOne) SetNillablePosition(v *int) *QuizItemUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *QuizItemUpdateOne) AddPosition(v int) *QuizItemUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetPrompt sets the "prompt" field.
+func (_u *QuizItemUpdateOne) SetPrompt(v string) *QuizItemUpdateOne {
+	_u.mutation.SetPrompt(v)
+	return _u
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (_u *QuizItemUpdateOne) SetNillablePrompt(v *string) *QuizItemUpdateOne {
+	if v != nil {
+		_u.SetPrompt(*v)
+	}
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *QuizItemUpdateOne) SetAnswer(v string) *QuizItemUpdateOne {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *QuizItemUpdateOne) SetNillableAnswer(v *string) *QuizItemUpdateOne {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// SetSentence sets the "sentence" field.
+func (_u *QuizItemUpdateOne) SetSentence(v string) *QuizItemUpdateOne {
+	_u.mutation.SetSentence(v)
+	return _u
+}
+
+// SetNillableSentence sets the "sentence" field if the given value is not nil.
+func (_u *QuizItemUpdateOne) SetNillableSentence(v *string) *QuizItemUpdateOne {
+	if v != nil {
+		_u.SetSentence(*v)
+	}
+	return _u
+}
+
+// SetNeedsReview sets the "needs_review" field.
+func (_u *QuizItemUpdateOne) SetNeedsReview(v bool) *QuizItemUpdateOne {
+	_u.mutation.SetNeedsReview(v)
+	return _u
+}
+
+// SetNillableNeedsReview sets the "needs_review" field if the given value is not nil.
+func (_u *QuizItemUpdateOne) SetNillableNeedsReview(v *bool) *QuizItemUpdateOne {
+	if v != nil {
+		_u.SetNeedsReview(*v)
+	}
+	return _u
+}
+
+// Mutation returns the QuizItemMutation object of the builder.
+func (_u *QuizItemUpdateOne) Mutation() *QuizItemMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the QuizItemUpdate builder.
+func (_u *QuizItemUpdateOne) Where(ps ...predicate.QuizItem) *QuizItemUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *QuizItemUpdateOne) Select(field string, fields ...string) *QuizItemUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated QuizItem entity.
+func (_u *QuizItemUpdateOne) Save(ctx context.Context) (*QuizItem, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuizItemUpdateOne) SaveX(ctx context.Context) *QuizItem {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *QuizItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuizItemUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *QuizItemUpdateOne) sqlSave(ctx context.Context) (_node *QuizItem, err error) {
+	_spec := sqlgraph.NewUpdateSpec(quizitem.Table, quizitem.Columns, sqlgraph.NewFieldSpec(quizitem.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "QuizItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quizitem.FieldID)
+		for _, f := range fields {
+			if !quizitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != quizitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(quizitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(quizitem.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(quizitem.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Prompt(); ok {
+		_spec.SetField(quizitem.FieldPrompt, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Answer(); ok {
+		_spec.SetField(quizitem.FieldAnswer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Sentence(); ok {
+		_spec.SetField(quizitem.FieldSentence, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NeedsReview(); ok {
+		_spec.SetField(quizitem.FieldNeedsReview, field.TypeBool, value)
+	}
+	_node = &QuizItem{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quizitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
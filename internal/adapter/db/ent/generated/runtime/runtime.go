@@ -2,7 +2,1051 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/eslsoft/lession/internal/adapter/db/ent/generated/runtime.go
+import (
+	"time"
+
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/apikey"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/asset"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/assetusageday"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/assetversion"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/auditentry"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/availabilityslot"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/booking"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroom"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroomassignment"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/classroommember"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentembedding"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentkey"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/contentreassignment"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/devicetoken"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/dictationattempt"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/engagementrollup"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episode"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/episodetranscript"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/event"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/feeditem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/feedsubscription"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/invoice"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/job"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/learneractivity"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltilaunch"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltiplatform"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/moderationitem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/notification"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/notificationpreference"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/outboxmessage"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/plan"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playbacksession"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playlist"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/playlistitem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/quizitem"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/scheduledtask"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/series"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/shadowingsubmission"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/subscription"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/transcriptreplacejob"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/transcriptrevision"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/uploadsession"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/usagerecord"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/usagesnapshot"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/vocabularyword"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/webhookdelivery"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/webhookendpoint"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/webhookevent"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/schema"
+	"github.com/google/uuid"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	apikeyMixin := schema.APIKey{}.Mixin()
+	apikeyMixinHooks0 := apikeyMixin[0].Hooks()
+	apikey.Hooks[0] = apikeyMixinHooks0[0]
+	apikey.Hooks[1] = apikeyMixinHooks0[1]
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyFields[0].Descriptor()
+	// apikey.DefaultID holds the default value on creation for the id field.
+	apikey.DefaultID = apikeyDescID.Default.(func() uuid.UUID)
+	assetMixin := schema.Asset{}.Mixin()
+	assetMixinHooks0 := assetMixin[0].Hooks()
+	assetMixinHooks1 := assetMixin[1].Hooks()
+	asset.Hooks[0] = assetMixinHooks0[0]
+	asset.Hooks[1] = assetMixinHooks0[1]
+	asset.Hooks[2] = assetMixinHooks1[0]
+	assetMixinInters1 := assetMixin[1].Interceptors()
+	asset.Interceptors[0] = assetMixinInters1[0]
+	assetFields := schema.Asset{}.Fields()
+	_ = assetFields
+	// assetDescType is the schema descriptor for type field.
+	assetDescType := assetFields[2].Descriptor()
+	// asset.DefaultType holds the default value on creation for the type field.
+	asset.DefaultType = assetDescType.Default.(int)
+	// assetDescStatus is the schema descriptor for status field.
+	assetDescStatus := assetFields[3].Descriptor()
+	// asset.DefaultStatus holds the default value on creation for the status field.
+	asset.DefaultStatus = assetDescStatus.Default.(int)
+	// assetDescFilesize is the schema descriptor for filesize field.
+	assetDescFilesize := assetFields[6].Descriptor()
+	// asset.DefaultFilesize holds the default value on creation for the filesize field.
+	asset.DefaultFilesize = assetDescFilesize.Default.(int64)
+	// assetDescDurationSeconds is the schema descriptor for duration_seconds field.
+	assetDescDurationSeconds := assetFields[7].Descriptor()
+	// asset.DefaultDurationSeconds holds the default value on creation for the duration_seconds field.
+	asset.DefaultDurationSeconds = assetDescDurationSeconds.Default.(int)
+	// assetDescHlsManifestURL is the schema descriptor for hls_manifest_url field.
+	assetDescHlsManifestURL := assetFields[9].Descriptor()
+	// asset.DefaultHlsManifestURL holds the default value on creation for the hls_manifest_url field.
+	asset.DefaultHlsManifestURL = assetDescHlsManifestURL.Default.(string)
+	// assetDescWidth is the schema descriptor for width field.
+	assetDescWidth := assetFields[11].Descriptor()
+	// asset.DefaultWidth holds the default value on creation for the width field.
+	asset.DefaultWidth = assetDescWidth.Default.(int)
+	// assetDescHeight is the schema descriptor for height field.
+	assetDescHeight := assetFields[12].Descriptor()
+	// asset.DefaultHeight holds the default value on creation for the height field.
+	asset.DefaultHeight = assetDescHeight.Default.(int)
+	// assetDescProvider is the schema descriptor for provider field.
+	assetDescProvider := assetFields[14].Descriptor()
+	// asset.DefaultProvider holds the default value on creation for the provider field.
+	asset.DefaultProvider = assetDescProvider.Default.(string)
+	// assetDescFailureCode is the schema descriptor for failure_code field.
+	assetDescFailureCode := assetFields[15].Descriptor()
+	// asset.DefaultFailureCode holds the default value on creation for the failure_code field.
+	asset.DefaultFailureCode = assetDescFailureCode.Default.(int)
+	// assetDescFailureReason is the schema descriptor for failure_reason field.
+	assetDescFailureReason := assetFields[16].Descriptor()
+	// asset.DefaultFailureReason holds the default value on creation for the failure_reason field.
+	asset.DefaultFailureReason = assetDescFailureReason.Default.(string)
+	// assetDescID is the schema descriptor for id field.
+	assetDescID := assetFields[0].Descriptor()
+	// asset.DefaultID holds the default value on creation for the id field.
+	asset.DefaultID = assetDescID.Default.(func() uuid.UUID)
+	assetusagedayFields := schema.AssetUsageDay{}.Fields()
+	_ = assetusagedayFields
+	// assetusagedayDescPlaybackUrlsIssued is the schema descriptor for playback_urls_issued field.
+	assetusagedayDescPlaybackUrlsIssued := assetusagedayFields[3].Descriptor()
+	// assetusageday.DefaultPlaybackUrlsIssued holds the default value on creation for the playback_urls_issued field.
+	assetusageday.DefaultPlaybackUrlsIssued = assetusagedayDescPlaybackUrlsIssued.Default.(int64)
+	// assetusagedayDescDownloads is the schema descriptor for downloads field.
+	assetusagedayDescDownloads := assetusagedayFields[4].Descriptor()
+	// assetusageday.DefaultDownloads holds the default value on creation for the downloads field.
+	assetusageday.DefaultDownloads = assetusagedayDescDownloads.Default.(int64)
+	// assetusagedayDescID is the schema descriptor for id field.
+	assetusagedayDescID := assetusagedayFields[0].Descriptor()
+	// assetusageday.DefaultID holds the default value on creation for the id field.
+	assetusageday.DefaultID = assetusagedayDescID.Default.(func() uuid.UUID)
+	assetversionMixin := schema.AssetVersion{}.Mixin()
+	assetversionMixinHooks0 := assetversionMixin[0].Hooks()
+	assetversion.Hooks[0] = assetversionMixinHooks0[0]
+	assetversionFields := schema.AssetVersion{}.Fields()
+	_ = assetversionFields
+	// assetversionDescProvider is the schema descriptor for provider field.
+	assetversionDescProvider := assetversionFields[3].Descriptor()
+	// assetversion.DefaultProvider holds the default value on creation for the provider field.
+	assetversion.DefaultProvider = assetversionDescProvider.Default.(string)
+	// assetversionDescFilesize is the schema descriptor for filesize field.
+	assetversionDescFilesize := assetversionFields[6].Descriptor()
+	// assetversion.DefaultFilesize holds the default value on creation for the filesize field.
+	assetversion.DefaultFilesize = assetversionDescFilesize.Default.(int64)
+	// assetversionDescDurationSeconds is the schema descriptor for duration_seconds field.
+	assetversionDescDurationSeconds := assetversionFields[7].Descriptor()
+	// assetversion.DefaultDurationSeconds holds the default value on creation for the duration_seconds field.
+	assetversion.DefaultDurationSeconds = assetversionDescDurationSeconds.Default.(int)
+	// assetversionDescPlaybackURL is the schema descriptor for playback_url field.
+	assetversionDescPlaybackURL := assetversionFields[8].Descriptor()
+	// assetversion.DefaultPlaybackURL holds the default value on creation for the playback_url field.
+	assetversion.DefaultPlaybackURL = assetversionDescPlaybackURL.Default.(string)
+	// assetversionDescHlsManifestURL is the schema descriptor for hls_manifest_url field.
+	assetversionDescHlsManifestURL := assetversionFields[9].Descriptor()
+	// assetversion.DefaultHlsManifestURL holds the default value on creation for the hls_manifest_url field.
+	assetversion.DefaultHlsManifestURL = assetversionDescHlsManifestURL.Default.(string)
+	// assetversionDescID is the schema descriptor for id field.
+	assetversionDescID := assetversionFields[0].Descriptor()
+	// assetversion.DefaultID holds the default value on creation for the id field.
+	assetversion.DefaultID = assetversionDescID.Default.(func() uuid.UUID)
+	auditentryMixin := schema.AuditEntry{}.Mixin()
+	auditentryMixinHooks0 := auditentryMixin[0].Hooks()
+	auditentry.Hooks[0] = auditentryMixinHooks0[0]
+	auditentryFields := schema.AuditEntry{}.Fields()
+	_ = auditentryFields
+	// auditentryDescActorID is the schema descriptor for actor_id field.
+	auditentryDescActorID := auditentryFields[1].Descriptor()
+	// auditentry.DefaultActorID holds the default value on creation for the actor_id field.
+	auditentry.DefaultActorID = auditentryDescActorID.Default.(string)
+	// auditentryDescProcedure is the schema descriptor for procedure field.
+	auditentryDescProcedure := auditentryFields[2].Descriptor()
+	// auditentry.DefaultProcedure holds the default value on creation for the procedure field.
+	auditentry.DefaultProcedure = auditentryDescProcedure.Default.(string)
+	// auditentryDescID is the schema descriptor for id field.
+	auditentryDescID := auditentryFields[0].Descriptor()
+	// auditentry.DefaultID holds the default value on creation for the id field.
+	auditentry.DefaultID = auditentryDescID.Default.(func() uuid.UUID)
+	availabilityslotMixin := schema.AvailabilitySlot{}.Mixin()
+	availabilityslotMixinHooks0 := availabilityslotMixin[0].Hooks()
+	availabilityslot.Hooks[0] = availabilityslotMixinHooks0[0]
+	availabilityslot.Hooks[1] = availabilityslotMixinHooks0[1]
+	availabilityslotFields := schema.AvailabilitySlot{}.Fields()
+	_ = availabilityslotFields
+	// availabilityslotDescBooked is the schema descriptor for booked field.
+	availabilityslotDescBooked := availabilityslotFields[4].Descriptor()
+	// availabilityslot.DefaultBooked holds the default value on creation for the booked field.
+	availabilityslot.DefaultBooked = availabilityslotDescBooked.Default.(bool)
+	// availabilityslotDescID is the schema descriptor for id field.
+	availabilityslotDescID := availabilityslotFields[0].Descriptor()
+	// availabilityslot.DefaultID holds the default value on creation for the id field.
+	availabilityslot.DefaultID = availabilityslotDescID.Default.(func() uuid.UUID)
+	bookingMixin := schema.Booking{}.Mixin()
+	bookingMixinHooks0 := bookingMixin[0].Hooks()
+	booking.Hooks[0] = bookingMixinHooks0[0]
+	booking.Hooks[1] = bookingMixinHooks0[1]
+	bookingFields := schema.Booking{}.Fields()
+	_ = bookingFields
+	// bookingDescNote is the schema descriptor for note field.
+	bookingDescNote := bookingFields[6].Descriptor()
+	// booking.DefaultNote holds the default value on creation for the note field.
+	booking.DefaultNote = bookingDescNote.Default.(string)
+	// bookingDescCancelledBy is the schema descriptor for cancelled_by field.
+	bookingDescCancelledBy := bookingFields[9].Descriptor()
+	// booking.DefaultCancelledBy holds the default value on creation for the cancelled_by field.
+	booking.DefaultCancelledBy = bookingDescCancelledBy.Default.(string)
+	// bookingDescCancelReason is the schema descriptor for cancel_reason field.
+	bookingDescCancelReason := bookingFields[10].Descriptor()
+	// booking.DefaultCancelReason holds the default value on creation for the cancel_reason field.
+	booking.DefaultCancelReason = bookingDescCancelReason.Default.(string)
+	// bookingDescID is the schema descriptor for id field.
+	bookingDescID := bookingFields[0].Descriptor()
+	// booking.DefaultID holds the default value on creation for the id field.
+	booking.DefaultID = bookingDescID.Default.(func() uuid.UUID)
+	classroomMixin := schema.Classroom{}.Mixin()
+	classroomMixinHooks0 := classroomMixin[0].Hooks()
+	classroom.Hooks[0] = classroomMixinHooks0[0]
+	classroom.Hooks[1] = classroomMixinHooks0[1]
+	classroomFields := schema.Classroom{}.Fields()
+	_ = classroomFields
+	// classroomDescDescription is the schema descriptor for description field.
+	classroomDescDescription := classroomFields[3].Descriptor()
+	// classroom.DefaultDescription holds the default value on creation for the description field.
+	classroom.DefaultDescription = classroomDescDescription.Default.(string)
+	// classroomDescID is the schema descriptor for id field.
+	classroomDescID := classroomFields[0].Descriptor()
+	// classroom.DefaultID holds the default value on creation for the id field.
+	classroom.DefaultID = classroomDescID.Default.(func() uuid.UUID)
+	classroomassignmentMixin := schema.ClassroomAssignment{}.Mixin()
+	classroomassignmentMixinHooks0 := classroomassignmentMixin[0].Hooks()
+	classroomassignment.Hooks[0] = classroomassignmentMixinHooks0[0]
+	classroomassignmentFields := schema.ClassroomAssignment{}.Fields()
+	_ = classroomassignmentFields
+	// classroomassignmentDescID is the schema descriptor for id field.
+	classroomassignmentDescID := classroomassignmentFields[0].Descriptor()
+	// classroomassignment.DefaultID holds the default value on creation for the id field.
+	classroomassignment.DefaultID = classroomassignmentDescID.Default.(func() uuid.UUID)
+	classroommemberFields := schema.ClassroomMember{}.Fields()
+	_ = classroommemberFields
+	// classroommemberDescJoinedAt is the schema descriptor for joined_at field.
+	classroommemberDescJoinedAt := classroommemberFields[3].Descriptor()
+	// classroommember.DefaultJoinedAt holds the default value on creation for the joined_at field.
+	classroommember.DefaultJoinedAt = classroommemberDescJoinedAt.Default.(func() time.Time)
+	// classroommemberDescID is the schema descriptor for id field.
+	classroommemberDescID := classroommemberFields[0].Descriptor()
+	// classroommember.DefaultID holds the default value on creation for the id field.
+	classroommember.DefaultID = classroommemberDescID.Default.(func() uuid.UUID)
+	contentembeddingMixin := schema.ContentEmbedding{}.Mixin()
+	contentembeddingMixinHooks0 := contentembeddingMixin[0].Hooks()
+	contentembedding.Hooks[0] = contentembeddingMixinHooks0[0]
+	contentembedding.Hooks[1] = contentembeddingMixinHooks0[1]
+	contentembeddingFields := schema.ContentEmbedding{}.Fields()
+	_ = contentembeddingFields
+	// contentembeddingDescSnippet is the schema descriptor for snippet field.
+	contentembeddingDescSnippet := contentembeddingFields[5].Descriptor()
+	// contentembedding.DefaultSnippet holds the default value on creation for the snippet field.
+	contentembedding.DefaultSnippet = contentembeddingDescSnippet.Default.(string)
+	// contentembeddingDescLanguage is the schema descriptor for language field.
+	contentembeddingDescLanguage := contentembeddingFields[6].Descriptor()
+	// contentembedding.DefaultLanguage holds the default value on creation for the language field.
+	contentembedding.DefaultLanguage = contentembeddingDescLanguage.Default.(string)
+	// contentembeddingDescLevel is the schema descriptor for level field.
+	contentembeddingDescLevel := contentembeddingFields[7].Descriptor()
+	// contentembedding.DefaultLevel holds the default value on creation for the level field.
+	contentembedding.DefaultLevel = contentembeddingDescLevel.Default.(string)
+	contentkeyMixin := schema.ContentKey{}.Mixin()
+	contentkeyMixinHooks0 := contentkeyMixin[0].Hooks()
+	contentkey.Hooks[0] = contentkeyMixinHooks0[0]
+	contentkeyFields := schema.ContentKey{}.Fields()
+	_ = contentkeyFields
+	// contentkeyDescID is the schema descriptor for id field.
+	contentkeyDescID := contentkeyFields[0].Descriptor()
+	// contentkey.DefaultID holds the default value on creation for the id field.
+	contentkey.DefaultID = contentkeyDescID.Default.(func() uuid.UUID)
+	contentreassignmentMixin := schema.ContentReassignment{}.Mixin()
+	contentreassignmentMixinHooks0 := contentreassignmentMixin[0].Hooks()
+	contentreassignment.Hooks[0] = contentreassignmentMixinHooks0[0]
+	contentreassignmentFields := schema.ContentReassignment{}.Fields()
+	_ = contentreassignmentFields
+	// contentreassignmentDescID is the schema descriptor for id field.
+	contentreassignmentDescID := contentreassignmentFields[0].Descriptor()
+	// contentreassignment.DefaultID holds the default value on creation for the id field.
+	contentreassignment.DefaultID = contentreassignmentDescID.Default.(func() uuid.UUID)
+	devicetokenMixin := schema.DeviceToken{}.Mixin()
+	devicetokenMixinHooks0 := devicetokenMixin[0].Hooks()
+	devicetoken.Hooks[0] = devicetokenMixinHooks0[0]
+	devicetoken.Hooks[1] = devicetokenMixinHooks0[1]
+	devicetokenFields := schema.DeviceToken{}.Fields()
+	_ = devicetokenFields
+	// devicetokenDescPlatform is the schema descriptor for platform field.
+	devicetokenDescPlatform := devicetokenFields[2].Descriptor()
+	// devicetoken.DefaultPlatform holds the default value on creation for the platform field.
+	devicetoken.DefaultPlatform = devicetokenDescPlatform.Default.(int)
+	dictationattemptMixin := schema.DictationAttempt{}.Mixin()
+	dictationattemptMixinHooks0 := dictationattemptMixin[0].Hooks()
+	dictationattempt.Hooks[0] = dictationattemptMixinHooks0[0]
+	dictationattemptFields := schema.DictationAttempt{}.Fields()
+	_ = dictationattemptFields
+	// dictationattemptDescAnswer is the schema descriptor for answer field.
+	dictationattemptDescAnswer := dictationattemptFields[4].Descriptor()
+	// dictationattempt.DefaultAnswer holds the default value on creation for the answer field.
+	dictationattempt.DefaultAnswer = dictationattemptDescAnswer.Default.(string)
+	// dictationattemptDescExpected is the schema descriptor for expected field.
+	dictationattemptDescExpected := dictationattemptFields[5].Descriptor()
+	// dictationattempt.DefaultExpected holds the default value on creation for the expected field.
+	dictationattempt.DefaultExpected = dictationattemptDescExpected.Default.(string)
+	// dictationattemptDescScore is the schema descriptor for score field.
+	dictationattemptDescScore := dictationattemptFields[6].Descriptor()
+	// dictationattempt.DefaultScore holds the default value on creation for the score field.
+	dictationattempt.DefaultScore = dictationattemptDescScore.Default.(float64)
+	// dictationattemptDescID is the schema descriptor for id field.
+	dictationattemptDescID := dictationattemptFields[0].Descriptor()
+	// dictationattempt.DefaultID holds the default value on creation for the id field.
+	dictationattempt.DefaultID = dictationattemptDescID.Default.(func() uuid.UUID)
+	engagementrollupMixin := schema.EngagementRollup{}.Mixin()
+	engagementrollupMixinHooks0 := engagementrollupMixin[0].Hooks()
+	engagementrollup.Hooks[0] = engagementrollupMixinHooks0[0]
+	engagementrollup.Hooks[1] = engagementrollupMixinHooks0[1]
+	engagementrollupFields := schema.EngagementRollup{}.Fields()
+	_ = engagementrollupFields
+	// engagementrollupDescSessions is the schema descriptor for sessions field.
+	engagementrollupDescSessions := engagementrollupFields[4].Descriptor()
+	// engagementrollup.DefaultSessions holds the default value on creation for the sessions field.
+	engagementrollup.DefaultSessions = engagementrollupDescSessions.Default.(int)
+	// engagementrollupDescFinishedSessions is the schema descriptor for finished_sessions field.
+	engagementrollupDescFinishedSessions := engagementrollupFields[5].Descriptor()
+	// engagementrollup.DefaultFinishedSessions holds the default value on creation for the finished_sessions field.
+	engagementrollup.DefaultFinishedSessions = engagementrollupDescFinishedSessions.Default.(int)
+	// engagementrollupDescUniqueListeners is the schema descriptor for unique_listeners field.
+	engagementrollupDescUniqueListeners := engagementrollupFields[6].Descriptor()
+	// engagementrollup.DefaultUniqueListeners holds the default value on creation for the unique_listeners field.
+	engagementrollup.DefaultUniqueListeners = engagementrollupDescUniqueListeners.Default.(int)
+	// engagementrollupDescCompleters is the schema descriptor for completers field.
+	engagementrollupDescCompleters := engagementrollupFields[7].Descriptor()
+	// engagementrollup.DefaultCompleters holds the default value on creation for the completers field.
+	engagementrollup.DefaultCompleters = engagementrollupDescCompleters.Default.(int)
+	// engagementrollupDescID is the schema descriptor for id field.
+	engagementrollupDescID := engagementrollupFields[0].Descriptor()
+	// engagementrollup.DefaultID holds the default value on creation for the id field.
+	engagementrollup.DefaultID = engagementrollupDescID.Default.(func() uuid.UUID)
+	episodeMixin := schema.Episode{}.Mixin()
+	episodeMixinHooks0 := episodeMixin[0].Hooks()
+	episodeMixinHooks1 := episodeMixin[1].Hooks()
+	episode.Hooks[0] = episodeMixinHooks0[0]
+	episode.Hooks[1] = episodeMixinHooks0[1]
+	episode.Hooks[2] = episodeMixinHooks1[0]
+	episodeMixinInters1 := episodeMixin[1].Interceptors()
+	episode.Interceptors[0] = episodeMixinInters1[0]
+	episodeFields := schema.Episode{}.Fields()
+	_ = episodeFields
+	// episodeDescDescription is the schema descriptor for description field.
+	episodeDescDescription := episodeFields[4].Descriptor()
+	// episode.DefaultDescription holds the default value on creation for the description field.
+	episode.DefaultDescription = episodeDescDescription.Default.(string)
+	// episodeDescDurationSeconds is the schema descriptor for duration_seconds field.
+	episodeDescDurationSeconds := episodeFields[5].Descriptor()
+	// episode.DefaultDurationSeconds holds the default value on creation for the duration_seconds field.
+	episode.DefaultDurationSeconds = episodeDescDurationSeconds.Default.(int)
+	// episodeDescDurationFromAsset is the schema descriptor for duration_from_asset field.
+	episodeDescDurationFromAsset := episodeFields[6].Descriptor()
+	// episode.DefaultDurationFromAsset holds the default value on creation for the duration_from_asset field.
+	episode.DefaultDurationFromAsset = episodeDescDurationFromAsset.Default.(bool)
+	// episodeDescStatus is the schema descriptor for status field.
+	episodeDescStatus := episodeFields[7].Descriptor()
+	// episode.DefaultStatus holds the default value on creation for the status field.
+	episode.DefaultStatus = episodeDescStatus.Default.(int)
+	// episodeDescPreview is the schema descriptor for preview field.
+	episodeDescPreview := episodeFields[8].Descriptor()
+	// episode.DefaultPreview holds the default value on creation for the preview field.
+	episode.DefaultPreview = episodeDescPreview.Default.(bool)
+	// episodeDescResourceType is the schema descriptor for resource_type field.
+	episodeDescResourceType := episodeFields[10].Descriptor()
+	// episode.DefaultResourceType holds the default value on creation for the resource_type field.
+	episode.DefaultResourceType = episodeDescResourceType.Default.(int)
+	// episodeDescResourcePlaybackURL is the schema descriptor for resource_playback_url field.
+	episodeDescResourcePlaybackURL := episodeFields[11].Descriptor()
+	// episode.DefaultResourcePlaybackURL holds the default value on creation for the resource_playback_url field.
+	episode.DefaultResourcePlaybackURL = episodeDescResourcePlaybackURL.Default.(string)
+	// episodeDescResourceMimeType is the schema descriptor for resource_mime_type field.
+	episodeDescResourceMimeType := episodeFields[12].Descriptor()
+	// episode.DefaultResourceMimeType holds the default value on creation for the resource_mime_type field.
+	episode.DefaultResourceMimeType = episodeDescResourceMimeType.Default.(string)
+	// episodeDescTranscriptLanguage is the schema descriptor for transcript_language field.
+	episodeDescTranscriptLanguage := episodeFields[13].Descriptor()
+	// episode.DefaultTranscriptLanguage holds the default value on creation for the transcript_language field.
+	episode.DefaultTranscriptLanguage = episodeDescTranscriptLanguage.Default.(string)
+	// episodeDescTranscriptFormat is the schema descriptor for transcript_format field.
+	episodeDescTranscriptFormat := episodeFields[14].Descriptor()
+	// episode.DefaultTranscriptFormat holds the default value on creation for the transcript_format field.
+	episode.DefaultTranscriptFormat = episodeDescTranscriptFormat.Default.(int)
+	// episodeDescTranscriptContent is the schema descriptor for transcript_content field.
+	episodeDescTranscriptContent := episodeFields[15].Descriptor()
+	// episode.DefaultTranscriptContent holds the default value on creation for the transcript_content field.
+	episode.DefaultTranscriptContent = episodeDescTranscriptContent.Default.(string)
+	// episodeDescTranscriptOffloaded is the schema descriptor for transcript_offloaded field.
+	episodeDescTranscriptOffloaded := episodeFields[17].Descriptor()
+	// episode.DefaultTranscriptOffloaded holds the default value on creation for the transcript_offloaded field.
+	episode.DefaultTranscriptOffloaded = episodeDescTranscriptOffloaded.Default.(bool)
+	// episodeDescEstimatedLevel is the schema descriptor for estimated_level field.
+	episodeDescEstimatedLevel := episodeFields[18].Descriptor()
+	// episode.DefaultEstimatedLevel holds the default value on creation for the estimated_level field.
+	episode.DefaultEstimatedLevel = episodeDescEstimatedLevel.Default.(string)
+	// episodeDescID is the schema descriptor for id field.
+	episodeDescID := episodeFields[0].Descriptor()
+	// episode.DefaultID holds the default value on creation for the id field.
+	episode.DefaultID = episodeDescID.Default.(func() uuid.UUID)
+	episodetranscriptFields := schema.EpisodeTranscript{}.Fields()
+	_ = episodetranscriptFields
+	// episodetranscriptDescContent is the schema descriptor for content field.
+	episodetranscriptDescContent := episodetranscriptFields[2].Descriptor()
+	// episodetranscript.DefaultContent holds the default value on creation for the content field.
+	episodetranscript.DefaultContent = episodetranscriptDescContent.Default.(string)
+	// episodetranscriptDescID is the schema descriptor for id field.
+	episodetranscriptDescID := episodetranscriptFields[0].Descriptor()
+	// episodetranscript.DefaultID holds the default value on creation for the id field.
+	episodetranscript.DefaultID = episodetranscriptDescID.Default.(func() uuid.UUID)
+	eventFields := schema.Event{}.Fields()
+	_ = eventFields
+	// eventDescOccurredAt is the schema descriptor for occurred_at field.
+	eventDescOccurredAt := eventFields[4].Descriptor()
+	// event.DefaultOccurredAt holds the default value on creation for the occurred_at field.
+	event.DefaultOccurredAt = eventDescOccurredAt.Default.(func() time.Time)
+	// eventDescID is the schema descriptor for id field.
+	eventDescID := eventFields[0].Descriptor()
+	// event.DefaultID holds the default value on creation for the id field.
+	event.DefaultID = eventDescID.Default.(func() uuid.UUID)
+	feeditemMixin := schema.FeedItem{}.Mixin()
+	feeditemMixinHooks0 := feeditemMixin[0].Hooks()
+	feeditem.Hooks[0] = feeditemMixinHooks0[0]
+	feeditem.Hooks[1] = feeditemMixinHooks0[1]
+	feeditemFields := schema.FeedItem{}.Fields()
+	_ = feeditemFields
+	// feeditemDescID is the schema descriptor for id field.
+	feeditemDescID := feeditemFields[0].Descriptor()
+	// feeditem.DefaultID holds the default value on creation for the id field.
+	feeditem.DefaultID = feeditemDescID.Default.(func() uuid.UUID)
+	feedsubscriptionMixin := schema.FeedSubscription{}.Mixin()
+	feedsubscriptionMixinHooks0 := feedsubscriptionMixin[0].Hooks()
+	feedsubscription.Hooks[0] = feedsubscriptionMixinHooks0[0]
+	feedsubscription.Hooks[1] = feedsubscriptionMixinHooks0[1]
+	feedsubscriptionFields := schema.FeedSubscription{}.Fields()
+	_ = feedsubscriptionFields
+	// feedsubscriptionDescDownloadMedia is the schema descriptor for download_media field.
+	feedsubscriptionDescDownloadMedia := feedsubscriptionFields[4].Descriptor()
+	// feedsubscription.DefaultDownloadMedia holds the default value on creation for the download_media field.
+	feedsubscription.DefaultDownloadMedia = feedsubscriptionDescDownloadMedia.Default.(bool)
+	// feedsubscriptionDescAutoPublish is the schema descriptor for auto_publish field.
+	feedsubscriptionDescAutoPublish := feedsubscriptionFields[5].Descriptor()
+	// feedsubscription.DefaultAutoPublish holds the default value on creation for the auto_publish field.
+	feedsubscription.DefaultAutoPublish = feedsubscriptionDescAutoPublish.Default.(bool)
+	// feedsubscriptionDescLastError is the schema descriptor for last_error field.
+	feedsubscriptionDescLastError := feedsubscriptionFields[7].Descriptor()
+	// feedsubscription.DefaultLastError holds the default value on creation for the last_error field.
+	feedsubscription.DefaultLastError = feedsubscriptionDescLastError.Default.(string)
+	// feedsubscriptionDescID is the schema descriptor for id field.
+	feedsubscriptionDescID := feedsubscriptionFields[0].Descriptor()
+	// feedsubscription.DefaultID holds the default value on creation for the id field.
+	feedsubscription.DefaultID = feedsubscriptionDescID.Default.(func() uuid.UUID)
+	invoiceMixin := schema.Invoice{}.Mixin()
+	invoiceMixinHooks0 := invoiceMixin[0].Hooks()
+	invoice.Hooks[0] = invoiceMixinHooks0[0]
+	invoice.Hooks[1] = invoiceMixinHooks0[1]
+	invoiceFields := schema.Invoice{}.Fields()
+	_ = invoiceFields
+	// invoiceDescAmountCents is the schema descriptor for amount_cents field.
+	invoiceDescAmountCents := invoiceFields[5].Descriptor()
+	// invoice.DefaultAmountCents holds the default value on creation for the amount_cents field.
+	invoice.DefaultAmountCents = invoiceDescAmountCents.Default.(int64)
+	// invoiceDescStatus is the schema descriptor for status field.
+	invoiceDescStatus := invoiceFields[7].Descriptor()
+	// invoice.DefaultStatus holds the default value on creation for the status field.
+	invoice.DefaultStatus = invoiceDescStatus.Default.(int)
+	// invoiceDescHostedURL is the schema descriptor for hosted_url field.
+	invoiceDescHostedURL := invoiceFields[10].Descriptor()
+	// invoice.DefaultHostedURL holds the default value on creation for the hosted_url field.
+	invoice.DefaultHostedURL = invoiceDescHostedURL.Default.(string)
+	// invoiceDescID is the schema descriptor for id field.
+	invoiceDescID := invoiceFields[0].Descriptor()
+	// invoice.DefaultID holds the default value on creation for the id field.
+	invoice.DefaultID = invoiceDescID.Default.(func() uuid.UUID)
+	jobMixin := schema.Job{}.Mixin()
+	jobMixinHooks0 := jobMixin[0].Hooks()
+	job.Hooks[0] = jobMixinHooks0[0]
+	job.Hooks[1] = jobMixinHooks0[1]
+	jobFields := schema.Job{}.Fields()
+	_ = jobFields
+	// jobDescAttempts is the schema descriptor for attempts field.
+	jobDescAttempts := jobFields[4].Descriptor()
+	// job.DefaultAttempts holds the default value on creation for the attempts field.
+	job.DefaultAttempts = jobDescAttempts.Default.(int)
+	// jobDescLastError is the schema descriptor for last_error field.
+	jobDescLastError := jobFields[5].Descriptor()
+	// job.DefaultLastError holds the default value on creation for the last_error field.
+	job.DefaultLastError = jobDescLastError.Default.(string)
+	// jobDescLockedBy is the schema descriptor for locked_by field.
+	jobDescLockedBy := jobFields[8].Descriptor()
+	// job.DefaultLockedBy holds the default value on creation for the locked_by field.
+	job.DefaultLockedBy = jobDescLockedBy.Default.(string)
+	// jobDescID is the schema descriptor for id field.
+	jobDescID := jobFields[0].Descriptor()
+	// job.DefaultID holds the default value on creation for the id field.
+	job.DefaultID = jobDescID.Default.(func() uuid.UUID)
+	ltilaunchMixin := schema.LTILaunch{}.Mixin()
+	ltilaunchMixinHooks0 := ltilaunchMixin[0].Hooks()
+	ltilaunch.Hooks[0] = ltilaunchMixinHooks0[0]
+	ltilaunchFields := schema.LTILaunch{}.Fields()
+	_ = ltilaunchFields
+	// ltilaunchDescResourceLinkID is the schema descriptor for resource_link_id field.
+	ltilaunchDescResourceLinkID := ltilaunchFields[6].Descriptor()
+	// ltilaunch.DefaultResourceLinkID holds the default value on creation for the resource_link_id field.
+	ltilaunch.DefaultResourceLinkID = ltilaunchDescResourceLinkID.Default.(string)
+	// ltilaunchDescLineItemURL is the schema descriptor for line_item_url field.
+	ltilaunchDescLineItemURL := ltilaunchFields[7].Descriptor()
+	// ltilaunch.DefaultLineItemURL holds the default value on creation for the line_item_url field.
+	ltilaunch.DefaultLineItemURL = ltilaunchDescLineItemURL.Default.(string)
+	// ltilaunchDescDeepLinkReturnURL is the schema descriptor for deep_link_return_url field.
+	ltilaunchDescDeepLinkReturnURL := ltilaunchFields[8].Descriptor()
+	// ltilaunch.DefaultDeepLinkReturnURL holds the default value on creation for the deep_link_return_url field.
+	ltilaunch.DefaultDeepLinkReturnURL = ltilaunchDescDeepLinkReturnURL.Default.(string)
+	// ltilaunchDescDeepLinkData is the schema descriptor for deep_link_data field.
+	ltilaunchDescDeepLinkData := ltilaunchFields[9].Descriptor()
+	// ltilaunch.DefaultDeepLinkData holds the default value on creation for the deep_link_data field.
+	ltilaunch.DefaultDeepLinkData = ltilaunchDescDeepLinkData.Default.(string)
+	// ltilaunchDescID is the schema descriptor for id field.
+	ltilaunchDescID := ltilaunchFields[0].Descriptor()
+	// ltilaunch.DefaultID holds the default value on creation for the id field.
+	ltilaunch.DefaultID = ltilaunchDescID.Default.(func() uuid.UUID)
+	ltiplatformMixin := schema.LTIPlatform{}.Mixin()
+	ltiplatformMixinHooks0 := ltiplatformMixin[0].Hooks()
+	ltiplatform.Hooks[0] = ltiplatformMixinHooks0[0]
+	ltiplatformFields := schema.LTIPlatform{}.Fields()
+	_ = ltiplatformFields
+	// ltiplatformDescDeploymentID is the schema descriptor for deployment_id field.
+	ltiplatformDescDeploymentID := ltiplatformFields[3].Descriptor()
+	// ltiplatform.DefaultDeploymentID holds the default value on creation for the deployment_id field.
+	ltiplatform.DefaultDeploymentID = ltiplatformDescDeploymentID.Default.(string)
+	// ltiplatformDescID is the schema descriptor for id field.
+	ltiplatformDescID := ltiplatformFields[0].Descriptor()
+	// ltiplatform.DefaultID holds the default value on creation for the id field.
+	ltiplatform.DefaultID = ltiplatformDescID.Default.(func() uuid.UUID)
+	learneractivityMixin := schema.LearnerActivity{}.Mixin()
+	learneractivityMixinHooks0 := learneractivityMixin[0].Hooks()
+	learneractivity.Hooks[0] = learneractivityMixinHooks0[0]
+	learneractivity.Hooks[1] = learneractivityMixinHooks0[1]
+	learneractivityFields := schema.LearnerActivity{}.Fields()
+	_ = learneractivityFields
+	// learneractivityDescMinutesListened is the schema descriptor for minutes_listened field.
+	learneractivityDescMinutesListened := learneractivityFields[3].Descriptor()
+	// learneractivity.DefaultMinutesListened holds the default value on creation for the minutes_listened field.
+	learneractivity.DefaultMinutesListened = learneractivityDescMinutesListened.Default.(int)
+	// learneractivityDescEpisodesCompleted is the schema descriptor for episodes_completed field.
+	learneractivityDescEpisodesCompleted := learneractivityFields[4].Descriptor()
+	// learneractivity.DefaultEpisodesCompleted holds the default value on creation for the episodes_completed field.
+	learneractivity.DefaultEpisodesCompleted = learneractivityDescEpisodesCompleted.Default.(int)
+	// learneractivityDescID is the schema descriptor for id field.
+	learneractivityDescID := learneractivityFields[0].Descriptor()
+	// learneractivity.DefaultID holds the default value on creation for the id field.
+	learneractivity.DefaultID = learneractivityDescID.Default.(func() uuid.UUID)
+	moderationitemMixin := schema.ModerationItem{}.Mixin()
+	moderationitemMixinHooks0 := moderationitemMixin[0].Hooks()
+	moderationitem.Hooks[0] = moderationitemMixinHooks0[0]
+	moderationitem.Hooks[1] = moderationitemMixinHooks0[1]
+	moderationitemFields := schema.ModerationItem{}.Fields()
+	_ = moderationitemFields
+	// moderationitemDescAuthorID is the schema descriptor for author_id field.
+	moderationitemDescAuthorID := moderationitemFields[3].Descriptor()
+	// moderationitem.DefaultAuthorID holds the default value on creation for the author_id field.
+	moderationitem.DefaultAuthorID = moderationitemDescAuthorID.Default.(string)
+	// moderationitemDescScore is the schema descriptor for score field.
+	moderationitemDescScore := moderationitemFields[7].Descriptor()
+	// moderationitem.DefaultScore holds the default value on creation for the score field.
+	moderationitem.DefaultScore = moderationitemDescScore.Default.(float64)
+	// moderationitemDescReviewerID is the schema descriptor for reviewer_id field.
+	moderationitemDescReviewerID := moderationitemFields[8].Descriptor()
+	// moderationitem.DefaultReviewerID holds the default value on creation for the reviewer_id field.
+	moderationitem.DefaultReviewerID = moderationitemDescReviewerID.Default.(string)
+	// moderationitemDescReviewNote is the schema descriptor for review_note field.
+	moderationitemDescReviewNote := moderationitemFields[9].Descriptor()
+	// moderationitem.DefaultReviewNote holds the default value on creation for the review_note field.
+	moderationitem.DefaultReviewNote = moderationitemDescReviewNote.Default.(string)
+	// moderationitemDescID is the schema descriptor for id field.
+	moderationitemDescID := moderationitemFields[0].Descriptor()
+	// moderationitem.DefaultID holds the default value on creation for the id field.
+	moderationitem.DefaultID = moderationitemDescID.Default.(func() uuid.UUID)
+	notificationMixin := schema.Notification{}.Mixin()
+	notificationMixinHooks0 := notificationMixin[0].Hooks()
+	notification.Hooks[0] = notificationMixinHooks0[0]
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescSubject is the schema descriptor for subject field.
+	notificationDescSubject := notificationFields[5].Descriptor()
+	// notification.DefaultSubject holds the default value on creation for the subject field.
+	notification.DefaultSubject = notificationDescSubject.Default.(string)
+	// notificationDescBody is the schema descriptor for body field.
+	notificationDescBody := notificationFields[6].Descriptor()
+	// notification.DefaultBody holds the default value on creation for the body field.
+	notification.DefaultBody = notificationDescBody.Default.(string)
+	// notificationDescStatus is the schema descriptor for status field.
+	notificationDescStatus := notificationFields[7].Descriptor()
+	// notification.DefaultStatus holds the default value on creation for the status field.
+	notification.DefaultStatus = notificationDescStatus.Default.(int)
+	// notificationDescError is the schema descriptor for error field.
+	notificationDescError := notificationFields[8].Descriptor()
+	// notification.DefaultError holds the default value on creation for the error field.
+	notification.DefaultError = notificationDescError.Default.(string)
+	// notificationDescID is the schema descriptor for id field.
+	notificationDescID := notificationFields[0].Descriptor()
+	// notification.DefaultID holds the default value on creation for the id field.
+	notification.DefaultID = notificationDescID.Default.(func() uuid.UUID)
+	notificationpreferenceMixin := schema.NotificationPreference{}.Mixin()
+	notificationpreferenceMixinHooks0 := notificationpreferenceMixin[0].Hooks()
+	notificationpreference.Hooks[0] = notificationpreferenceMixinHooks0[0]
+	notificationpreferenceFields := schema.NotificationPreference{}.Fields()
+	_ = notificationpreferenceFields
+	// notificationpreferenceDescEmail is the schema descriptor for email field.
+	notificationpreferenceDescEmail := notificationpreferenceFields[1].Descriptor()
+	// notificationpreference.DefaultEmail holds the default value on creation for the email field.
+	notificationpreference.DefaultEmail = notificationpreferenceDescEmail.Default.(string)
+	// notificationpreferenceDescLocale is the schema descriptor for locale field.
+	notificationpreferenceDescLocale := notificationpreferenceFields[2].Descriptor()
+	// notificationpreference.DefaultLocale holds the default value on creation for the locale field.
+	notificationpreference.DefaultLocale = notificationpreferenceDescLocale.Default.(string)
+	outboxmessageFields := schema.OutboxMessage{}.Fields()
+	_ = outboxmessageFields
+	// outboxmessageDescOccurredAt is the schema descriptor for occurred_at field.
+	outboxmessageDescOccurredAt := outboxmessageFields[4].Descriptor()
+	// outboxmessage.DefaultOccurredAt holds the default value on creation for the occurred_at field.
+	outboxmessage.DefaultOccurredAt = outboxmessageDescOccurredAt.Default.(func() time.Time)
+	// outboxmessageDescAttempts is the schema descriptor for attempts field.
+	outboxmessageDescAttempts := outboxmessageFields[5].Descriptor()
+	// outboxmessage.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxmessage.DefaultAttempts = outboxmessageDescAttempts.Default.(int)
+	// outboxmessageDescLastError is the schema descriptor for last_error field.
+	outboxmessageDescLastError := outboxmessageFields[6].Descriptor()
+	// outboxmessage.DefaultLastError holds the default value on creation for the last_error field.
+	outboxmessage.DefaultLastError = outboxmessageDescLastError.Default.(string)
+	// outboxmessageDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	outboxmessageDescNextAttemptAt := outboxmessageFields[7].Descriptor()
+	// outboxmessage.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	outboxmessage.DefaultNextAttemptAt = outboxmessageDescNextAttemptAt.Default.(func() time.Time)
+	// outboxmessageDescID is the schema descriptor for id field.
+	outboxmessageDescID := outboxmessageFields[0].Descriptor()
+	// outboxmessage.DefaultID holds the default value on creation for the id field.
+	outboxmessage.DefaultID = outboxmessageDescID.Default.(func() uuid.UUID)
+	planMixin := schema.Plan{}.Mixin()
+	planMixinHooks0 := planMixin[0].Hooks()
+	plan.Hooks[0] = planMixinHooks0[0]
+	plan.Hooks[1] = planMixinHooks0[1]
+	planFields := schema.Plan{}.Fields()
+	_ = planFields
+	// planDescDescription is the schema descriptor for description field.
+	planDescDescription := planFields[3].Descriptor()
+	// plan.DefaultDescription holds the default value on creation for the description field.
+	plan.DefaultDescription = planDescDescription.Default.(string)
+	// planDescPriceCents is the schema descriptor for price_cents field.
+	planDescPriceCents := planFields[4].Descriptor()
+	// plan.DefaultPriceCents holds the default value on creation for the price_cents field.
+	plan.DefaultPriceCents = planDescPriceCents.Default.(int64)
+	// planDescInterval is the schema descriptor for interval field.
+	planDescInterval := planFields[6].Descriptor()
+	// plan.DefaultInterval holds the default value on creation for the interval field.
+	plan.DefaultInterval = planDescInterval.Default.(int)
+	// planDescActive is the schema descriptor for active field.
+	planDescActive := planFields[7].Descriptor()
+	// plan.DefaultActive holds the default value on creation for the active field.
+	plan.DefaultActive = planDescActive.Default.(bool)
+	// planDescID is the schema descriptor for id field.
+	planDescID := planFields[0].Descriptor()
+	// plan.DefaultID holds the default value on creation for the id field.
+	plan.DefaultID = planDescID.Default.(func() uuid.UUID)
+	playbacksessionMixin := schema.PlaybackSession{}.Mixin()
+	playbacksessionMixinHooks0 := playbacksessionMixin[0].Hooks()
+	playbacksession.Hooks[0] = playbacksessionMixinHooks0[0]
+	playbacksessionFields := schema.PlaybackSession{}.Fields()
+	_ = playbacksessionFields
+	// playbacksessionDescDevice is the schema descriptor for device field.
+	playbacksessionDescDevice := playbacksessionFields[3].Descriptor()
+	// playbacksession.DefaultDevice holds the default value on creation for the device field.
+	playbacksession.DefaultDevice = playbacksessionDescDevice.Default.(string)
+	// playbacksessionDescID is the schema descriptor for id field.
+	playbacksessionDescID := playbacksessionFields[0].Descriptor()
+	// playbacksession.DefaultID holds the default value on creation for the id field.
+	playbacksession.DefaultID = playbacksessionDescID.Default.(func() uuid.UUID)
+	playlistMixin := schema.Playlist{}.Mixin()
+	playlistMixinHooks0 := playlistMixin[0].Hooks()
+	playlist.Hooks[0] = playlistMixinHooks0[0]
+	playlist.Hooks[1] = playlistMixinHooks0[1]
+	playlistFields := schema.Playlist{}.Fields()
+	_ = playlistFields
+	// playlistDescDescription is the schema descriptor for description field.
+	playlistDescDescription := playlistFields[3].Descriptor()
+	// playlist.DefaultDescription holds the default value on creation for the description field.
+	playlist.DefaultDescription = playlistDescDescription.Default.(string)
+	// playlistDescPublic is the schema descriptor for public field.
+	playlistDescPublic := playlistFields[4].Descriptor()
+	// playlist.DefaultPublic holds the default value on creation for the public field.
+	playlist.DefaultPublic = playlistDescPublic.Default.(bool)
+	// playlistDescID is the schema descriptor for id field.
+	playlistDescID := playlistFields[0].Descriptor()
+	// playlist.DefaultID holds the default value on creation for the id field.
+	playlist.DefaultID = playlistDescID.Default.(func() uuid.UUID)
+	playlistitemFields := schema.PlaylistItem{}.Fields()
+	_ = playlistitemFields
+	// playlistitemDescID is the schema descriptor for id field.
+	playlistitemDescID := playlistitemFields[0].Descriptor()
+	// playlistitem.DefaultID holds the default value on creation for the id field.
+	playlistitem.DefaultID = playlistitemDescID.Default.(func() uuid.UUID)
+	quizitemMixin := schema.QuizItem{}.Mixin()
+	quizitemMixinHooks0 := quizitemMixin[0].Hooks()
+	quizitem.Hooks[0] = quizitemMixinHooks0[0]
+	quizitem.Hooks[1] = quizitemMixinHooks0[1]
+	quizitemFields := schema.QuizItem{}.Fields()
+	_ = quizitemFields
+	// quizitemDescPosition is the schema descriptor for position field.
+	quizitemDescPosition := quizitemFields[3].Descriptor()
+	// quizitem.DefaultPosition holds the default value on creation for the position field.
+	quizitem.DefaultPosition = quizitemDescPosition.Default.(int)
+	// quizitemDescSentence is the schema descriptor for sentence field.
+	quizitemDescSentence := quizitemFields[6].Descriptor()
+	// quizitem.DefaultSentence holds the default value on creation for the sentence field.
+	quizitem.DefaultSentence = quizitemDescSentence.Default.(string)
+	// quizitemDescNeedsReview is the schema descriptor for needs_review field.
+	quizitemDescNeedsReview := quizitemFields[7].Descriptor()
+	// quizitem.DefaultNeedsReview holds the default value on creation for the needs_review field.
+	quizitem.DefaultNeedsReview = quizitemDescNeedsReview.Default.(bool)
+	// quizitemDescID is the schema descriptor for id field.
+	quizitemDescID := quizitemFields[0].Descriptor()
+	// quizitem.DefaultID holds the default value on creation for the id field.
+	quizitem.DefaultID = quizitemDescID.Default.(func() uuid.UUID)
+	scheduledtaskMixin := schema.ScheduledTask{}.Mixin()
+	scheduledtaskMixinHooks0 := scheduledtaskMixin[0].Hooks()
+	scheduledtask.Hooks[0] = scheduledtaskMixinHooks0[0]
+	scheduledtask.Hooks[1] = scheduledtaskMixinHooks0[1]
+	scheduledtaskFields := schema.ScheduledTask{}.Fields()
+	_ = scheduledtaskFields
+	// scheduledtaskDescLastStatus is the schema descriptor for last_status field.
+	scheduledtaskDescLastStatus := scheduledtaskFields[5].Descriptor()
+	// scheduledtask.DefaultLastStatus holds the default value on creation for the last_status field.
+	scheduledtask.DefaultLastStatus = scheduledtaskDescLastStatus.Default.(int)
+	// scheduledtaskDescLastError is the schema descriptor for last_error field.
+	scheduledtaskDescLastError := scheduledtaskFields[6].Descriptor()
+	// scheduledtask.DefaultLastError holds the default value on creation for the last_error field.
+	scheduledtask.DefaultLastError = scheduledtaskDescLastError.Default.(string)
+	// scheduledtaskDescLastDurationMs is the schema descriptor for last_duration_ms field.
+	scheduledtaskDescLastDurationMs := scheduledtaskFields[7].Descriptor()
+	// scheduledtask.DefaultLastDurationMs holds the default value on creation for the last_duration_ms field.
+	scheduledtask.DefaultLastDurationMs = scheduledtaskDescLastDurationMs.Default.(int64)
+	// scheduledtaskDescLockedBy is the schema descriptor for locked_by field.
+	scheduledtaskDescLockedBy := scheduledtaskFields[8].Descriptor()
+	// scheduledtask.DefaultLockedBy holds the default value on creation for the locked_by field.
+	scheduledtask.DefaultLockedBy = scheduledtaskDescLockedBy.Default.(string)
+	// scheduledtaskDescID is the schema descriptor for id field.
+	scheduledtaskDescID := scheduledtaskFields[0].Descriptor()
+	// scheduledtask.DefaultID holds the default value on creation for the id field.
+	scheduledtask.DefaultID = scheduledtaskDescID.Default.(func() uuid.UUID)
+	seriesMixin := schema.Series{}.Mixin()
+	seriesMixinHooks0 := seriesMixin[0].Hooks()
+	seriesMixinHooks1 := seriesMixin[1].Hooks()
+	series.Hooks[0] = seriesMixinHooks0[0]
+	series.Hooks[1] = seriesMixinHooks0[1]
+	series.Hooks[2] = seriesMixinHooks1[0]
+	seriesMixinInters1 := seriesMixin[1].Interceptors()
+	series.Interceptors[0] = seriesMixinInters1[0]
+	seriesFields := schema.Series{}.Fields()
+	_ = seriesFields
+	// seriesDescSummary is the schema descriptor for summary field.
+	seriesDescSummary := seriesFields[3].Descriptor()
+	// series.DefaultSummary holds the default value on creation for the summary field.
+	series.DefaultSummary = seriesDescSummary.Default.(string)
+	// seriesDescLanguage is the schema descriptor for language field.
+	seriesDescLanguage := seriesFields[4].Descriptor()
+	// series.DefaultLanguage holds the default value on creation for the language field.
+	series.DefaultLanguage = seriesDescLanguage.Default.(string)
+	// seriesDescLevel is the schema descriptor for level field.
+	seriesDescLevel := seriesFields[5].Descriptor()
+	// series.DefaultLevel holds the default value on creation for the level field.
+	series.DefaultLevel = seriesDescLevel.Default.(string)
+	// seriesDescEstimatedLevel is the schema descriptor for estimated_level field.
+	seriesDescEstimatedLevel := seriesFields[6].Descriptor()
+	// series.DefaultEstimatedLevel holds the default value on creation for the estimated_level field.
+	series.DefaultEstimatedLevel = seriesDescEstimatedLevel.Default.(string)
+	// seriesDescCoverURL is the schema descriptor for cover_url field.
+	seriesDescCoverURL := seriesFields[8].Descriptor()
+	// series.DefaultCoverURL holds the default value on creation for the cover_url field.
+	series.DefaultCoverURL = seriesDescCoverURL.Default.(string)
+	// seriesDescStatus is the schema descriptor for status field.
+	seriesDescStatus := seriesFields[10].Descriptor()
+	// series.DefaultStatus holds the default value on creation for the status field.
+	series.DefaultStatus = seriesDescStatus.Default.(int)
+	// seriesDescDrmEnabled is the schema descriptor for drm_enabled field.
+	seriesDescDrmEnabled := seriesFields[11].Descriptor()
+	// series.DefaultDrmEnabled holds the default value on creation for the drm_enabled field.
+	series.DefaultDrmEnabled = seriesDescDrmEnabled.Default.(bool)
+	// seriesDescAccentColor is the schema descriptor for accent_color field.
+	seriesDescAccentColor := seriesFields[12].Descriptor()
+	// series.DefaultAccentColor holds the default value on creation for the accent_color field.
+	series.DefaultAccentColor = seriesDescAccentColor.Default.(string)
+	// seriesDescHeroImageURL is the schema descriptor for hero_image_url field.
+	seriesDescHeroImageURL := seriesFields[14].Descriptor()
+	// series.DefaultHeroImageURL holds the default value on creation for the hero_image_url field.
+	series.DefaultHeroImageURL = seriesDescHeroImageURL.Default.(string)
+	// seriesDescLayout is the schema descriptor for layout field.
+	seriesDescLayout := seriesFields[15].Descriptor()
+	// series.DefaultLayout holds the default value on creation for the layout field.
+	series.DefaultLayout = seriesDescLayout.Default.(int)
+	// seriesDescEpisodeCount is the schema descriptor for episode_count field.
+	seriesDescEpisodeCount := seriesFields[16].Descriptor()
+	// series.DefaultEpisodeCount holds the default value on creation for the episode_count field.
+	series.DefaultEpisodeCount = seriesDescEpisodeCount.Default.(int)
+	// seriesDescID is the schema descriptor for id field.
+	seriesDescID := seriesFields[0].Descriptor()
+	// series.DefaultID holds the default value on creation for the id field.
+	series.DefaultID = seriesDescID.Default.(func() uuid.UUID)
+	shadowingsubmissionMixin := schema.ShadowingSubmission{}.Mixin()
+	shadowingsubmissionMixinHooks0 := shadowingsubmissionMixin[0].Hooks()
+	shadowingsubmission.Hooks[0] = shadowingsubmissionMixinHooks0[0]
+	shadowingsubmission.Hooks[1] = shadowingsubmissionMixinHooks0[1]
+	shadowingsubmissionFields := schema.ShadowingSubmission{}.Fields()
+	_ = shadowingsubmissionFields
+	// shadowingsubmissionDescStatus is the schema descriptor for status field.
+	shadowingsubmissionDescStatus := shadowingsubmissionFields[5].Descriptor()
+	// shadowingsubmission.DefaultStatus holds the default value on creation for the status field.
+	shadowingsubmission.DefaultStatus = shadowingsubmissionDescStatus.Default.(int)
+	// shadowingsubmissionDescAutoFeedback is the schema descriptor for auto_feedback field.
+	shadowingsubmissionDescAutoFeedback := shadowingsubmissionFields[7].Descriptor()
+	// shadowingsubmission.DefaultAutoFeedback holds the default value on creation for the auto_feedback field.
+	shadowingsubmission.DefaultAutoFeedback = shadowingsubmissionDescAutoFeedback.Default.(string)
+	// shadowingsubmissionDescReviewerID is the schema descriptor for reviewer_id field.
+	shadowingsubmissionDescReviewerID := shadowingsubmissionFields[8].Descriptor()
+	// shadowingsubmission.DefaultReviewerID holds the default value on creation for the reviewer_id field.
+	shadowingsubmission.DefaultReviewerID = shadowingsubmissionDescReviewerID.Default.(string)
+	// shadowingsubmissionDescReviewComment is the schema descriptor for review_comment field.
+	shadowingsubmissionDescReviewComment := shadowingsubmissionFields[10].Descriptor()
+	// shadowingsubmission.DefaultReviewComment holds the default value on creation for the review_comment field.
+	shadowingsubmission.DefaultReviewComment = shadowingsubmissionDescReviewComment.Default.(string)
+	// shadowingsubmissionDescID is the schema descriptor for id field.
+	shadowingsubmissionDescID := shadowingsubmissionFields[0].Descriptor()
+	// shadowingsubmission.DefaultID holds the default value on creation for the id field.
+	shadowingsubmission.DefaultID = shadowingsubmissionDescID.Default.(func() uuid.UUID)
+	subscriptionMixin := schema.Subscription{}.Mixin()
+	subscriptionMixinHooks0 := subscriptionMixin[0].Hooks()
+	subscription.Hooks[0] = subscriptionMixinHooks0[0]
+	subscription.Hooks[1] = subscriptionMixinHooks0[1]
+	subscriptionFields := schema.Subscription{}.Fields()
+	_ = subscriptionFields
+	// subscriptionDescStatus is the schema descriptor for status field.
+	subscriptionDescStatus := subscriptionFields[3].Descriptor()
+	// subscription.DefaultStatus holds the default value on creation for the status field.
+	subscription.DefaultStatus = subscriptionDescStatus.Default.(int)
+	// subscriptionDescBillingProvider is the schema descriptor for billing_provider field.
+	subscriptionDescBillingProvider := subscriptionFields[6].Descriptor()
+	// subscription.DefaultBillingProvider holds the default value on creation for the billing_provider field.
+	subscription.DefaultBillingProvider = subscriptionDescBillingProvider.Default.(string)
+	// subscriptionDescExternalID is the schema descriptor for external_id field.
+	subscriptionDescExternalID := subscriptionFields[7].Descriptor()
+	// subscription.DefaultExternalID holds the default value on creation for the external_id field.
+	subscription.DefaultExternalID = subscriptionDescExternalID.Default.(string)
+	// subscriptionDescID is the schema descriptor for id field.
+	subscriptionDescID := subscriptionFields[0].Descriptor()
+	// subscription.DefaultID holds the default value on creation for the id field.
+	subscription.DefaultID = subscriptionDescID.Default.(func() uuid.UUID)
+	transcriptreplacejobMixin := schema.TranscriptReplaceJob{}.Mixin()
+	transcriptreplacejobMixinHooks0 := transcriptreplacejobMixin[0].Hooks()
+	transcriptreplacejob.Hooks[0] = transcriptreplacejobMixinHooks0[0]
+	transcriptreplacejobFields := schema.TranscriptReplaceJob{}.Fields()
+	_ = transcriptreplacejobFields
+	// transcriptreplacejobDescReplacement is the schema descriptor for replacement field.
+	transcriptreplacejobDescReplacement := transcriptreplacejobFields[2].Descriptor()
+	// transcriptreplacejob.DefaultReplacement holds the default value on creation for the replacement field.
+	transcriptreplacejob.DefaultReplacement = transcriptreplacejobDescReplacement.Default.(string)
+	// transcriptreplacejobDescRegex is the schema descriptor for regex field.
+	transcriptreplacejobDescRegex := transcriptreplacejobFields[3].Descriptor()
+	// transcriptreplacejob.DefaultRegex holds the default value on creation for the regex field.
+	transcriptreplacejob.DefaultRegex = transcriptreplacejobDescRegex.Default.(bool)
+	// transcriptreplacejobDescCaseInsensitive is the schema descriptor for case_insensitive field.
+	transcriptreplacejobDescCaseInsensitive := transcriptreplacejobFields[4].Descriptor()
+	// transcriptreplacejob.DefaultCaseInsensitive holds the default value on creation for the case_insensitive field.
+	transcriptreplacejob.DefaultCaseInsensitive = transcriptreplacejobDescCaseInsensitive.Default.(bool)
+	// transcriptreplacejobDescScopeLanguage is the schema descriptor for scope_language field.
+	transcriptreplacejobDescScopeLanguage := transcriptreplacejobFields[6].Descriptor()
+	// transcriptreplacejob.DefaultScopeLanguage holds the default value on creation for the scope_language field.
+	transcriptreplacejob.DefaultScopeLanguage = transcriptreplacejobDescScopeLanguage.Default.(string)
+	// transcriptreplacejobDescDryRun is the schema descriptor for dry_run field.
+	transcriptreplacejobDescDryRun := transcriptreplacejobFields[7].Descriptor()
+	// transcriptreplacejob.DefaultDryRun holds the default value on creation for the dry_run field.
+	transcriptreplacejob.DefaultDryRun = transcriptreplacejobDescDryRun.Default.(bool)
+	// transcriptreplacejobDescRequestedBy is the schema descriptor for requested_by field.
+	transcriptreplacejobDescRequestedBy := transcriptreplacejobFields[8].Descriptor()
+	// transcriptreplacejob.DefaultRequestedBy holds the default value on creation for the requested_by field.
+	transcriptreplacejob.DefaultRequestedBy = transcriptreplacejobDescRequestedBy.Default.(string)
+	// transcriptreplacejobDescStatus is the schema descriptor for status field.
+	transcriptreplacejobDescStatus := transcriptreplacejobFields[9].Descriptor()
+	// transcriptreplacejob.DefaultStatus holds the default value on creation for the status field.
+	transcriptreplacejob.DefaultStatus = transcriptreplacejobDescStatus.Default.(int)
+	// transcriptreplacejobDescEpisodesScanned is the schema descriptor for episodes_scanned field.
+	transcriptreplacejobDescEpisodesScanned := transcriptreplacejobFields[10].Descriptor()
+	// transcriptreplacejob.DefaultEpisodesScanned holds the default value on creation for the episodes_scanned field.
+	transcriptreplacejob.DefaultEpisodesScanned = transcriptreplacejobDescEpisodesScanned.Default.(int)
+	// transcriptreplacejobDescEpisodesChanged is the schema descriptor for episodes_changed field.
+	transcriptreplacejobDescEpisodesChanged := transcriptreplacejobFields[11].Descriptor()
+	// transcriptreplacejob.DefaultEpisodesChanged holds the default value on creation for the episodes_changed field.
+	transcriptreplacejob.DefaultEpisodesChanged = transcriptreplacejobDescEpisodesChanged.Default.(int)
+	// transcriptreplacejobDescMatches is the schema descriptor for matches field.
+	transcriptreplacejobDescMatches := transcriptreplacejobFields[12].Descriptor()
+	// transcriptreplacejob.DefaultMatches holds the default value on creation for the matches field.
+	transcriptreplacejob.DefaultMatches = transcriptreplacejobDescMatches.Default.(int)
+	// transcriptreplacejobDescError is the schema descriptor for error field.
+	transcriptreplacejobDescError := transcriptreplacejobFields[14].Descriptor()
+	// transcriptreplacejob.DefaultError holds the default value on creation for the error field.
+	transcriptreplacejob.DefaultError = transcriptreplacejobDescError.Default.(string)
+	// transcriptreplacejobDescID is the schema descriptor for id field.
+	transcriptreplacejobDescID := transcriptreplacejobFields[0].Descriptor()
+	// transcriptreplacejob.DefaultID holds the default value on creation for the id field.
+	transcriptreplacejob.DefaultID = transcriptreplacejobDescID.Default.(func() uuid.UUID)
+	transcriptrevisionMixin := schema.TranscriptRevision{}.Mixin()
+	transcriptrevisionMixinHooks0 := transcriptrevisionMixin[0].Hooks()
+	transcriptrevision.Hooks[0] = transcriptrevisionMixinHooks0[0]
+	transcriptrevisionFields := schema.TranscriptRevision{}.Fields()
+	_ = transcriptrevisionFields
+	// transcriptrevisionDescID is the schema descriptor for id field.
+	transcriptrevisionDescID := transcriptrevisionFields[0].Descriptor()
+	// transcriptrevision.DefaultID holds the default value on creation for the id field.
+	transcriptrevision.DefaultID = transcriptrevisionDescID.Default.(func() uuid.UUID)
+	uploadsessionMixin := schema.UploadSession{}.Mixin()
+	uploadsessionMixinHooks0 := uploadsessionMixin[0].Hooks()
+	uploadsession.Hooks[0] = uploadsessionMixinHooks0[0]
+	uploadsession.Hooks[1] = uploadsessionMixinHooks0[1]
+	uploadsessionFields := schema.UploadSession{}.Fields()
+	_ = uploadsessionFields
+	// uploadsessionDescType is the schema descriptor for type field.
+	uploadsessionDescType := uploadsessionFields[2].Descriptor()
+	// uploadsession.DefaultType holds the default value on creation for the type field.
+	uploadsession.DefaultType = uploadsessionDescType.Default.(int)
+	// uploadsessionDescProtocol is the schema descriptor for protocol field.
+	uploadsessionDescProtocol := uploadsessionFields[3].Descriptor()
+	// uploadsession.DefaultProtocol holds the default value on creation for the protocol field.
+	uploadsession.DefaultProtocol = uploadsessionDescProtocol.Default.(int)
+	// uploadsessionDescStatus is the schema descriptor for status field.
+	uploadsessionDescStatus := uploadsessionFields[4].Descriptor()
+	// uploadsession.DefaultStatus holds the default value on creation for the status field.
+	uploadsession.DefaultStatus = uploadsessionDescStatus.Default.(int)
+	// uploadsessionDescTargetHeaders is the schema descriptor for target_headers field.
+	uploadsessionDescTargetHeaders := uploadsessionFields[7].Descriptor()
+	// uploadsession.DefaultTargetHeaders holds the default value on creation for the target_headers field.
+	uploadsession.DefaultTargetHeaders = uploadsessionDescTargetHeaders.Default.(func() map[string]string)
+	// uploadsessionDescTargetFormFields is the schema descriptor for target_form_fields field.
+	uploadsessionDescTargetFormFields := uploadsessionFields[8].Descriptor()
+	// uploadsession.DefaultTargetFormFields holds the default value on creation for the target_form_fields field.
+	uploadsession.DefaultTargetFormFields = uploadsessionDescTargetFormFields.Default.(func() map[string]string)
+	// uploadsessionDescContentLength is the schema descriptor for content_length field.
+	uploadsessionDescContentLength := uploadsessionFields[11].Descriptor()
+	// uploadsession.DefaultContentLength holds the default value on creation for the content_length field.
+	uploadsession.DefaultContentLength = uploadsessionDescContentLength.Default.(int64)
+	// uploadsessionDescProvider is the schema descriptor for provider field.
+	uploadsessionDescProvider := uploadsessionFields[13].Descriptor()
+	// uploadsession.DefaultProvider holds the default value on creation for the provider field.
+	uploadsession.DefaultProvider = uploadsessionDescProvider.Default.(string)
+	// uploadsessionDescID is the schema descriptor for id field.
+	uploadsessionDescID := uploadsessionFields[0].Descriptor()
+	// uploadsession.DefaultID holds the default value on creation for the id field.
+	uploadsession.DefaultID = uploadsessionDescID.Default.(func() uuid.UUID)
+	usagerecordFields := schema.UsageRecord{}.Fields()
+	_ = usagerecordFields
+	// usagerecordDescID is the schema descriptor for id field.
+	usagerecordDescID := usagerecordFields[0].Descriptor()
+	// usagerecord.DefaultID holds the default value on creation for the id field.
+	usagerecord.DefaultID = usagerecordDescID.Default.(func() uuid.UUID)
+	usagesnapshotMixin := schema.UsageSnapshot{}.Mixin()
+	usagesnapshotMixinHooks0 := usagesnapshotMixin[0].Hooks()
+	usagesnapshot.Hooks[0] = usagesnapshotMixinHooks0[0]
+	usagesnapshotFields := schema.UsageSnapshot{}.Fields()
+	_ = usagesnapshotFields
+	// usagesnapshotDescID is the schema descriptor for id field.
+	usagesnapshotDescID := usagesnapshotFields[0].Descriptor()
+	// usagesnapshot.DefaultID holds the default value on creation for the id field.
+	usagesnapshot.DefaultID = usagesnapshotDescID.Default.(func() uuid.UUID)
+	vocabularywordMixin := schema.VocabularyWord{}.Mixin()
+	vocabularywordMixinHooks0 := vocabularywordMixin[0].Hooks()
+	vocabularyword.Hooks[0] = vocabularywordMixinHooks0[0]
+	vocabularyword.Hooks[1] = vocabularywordMixinHooks0[1]
+	vocabularywordFields := schema.VocabularyWord{}.Fields()
+	_ = vocabularywordFields
+	// vocabularywordDescID is the schema descriptor for id field.
+	vocabularywordDescID := vocabularywordFields[0].Descriptor()
+	// vocabularyword.DefaultID holds the default value on creation for the id field.
+	vocabularyword.DefaultID = vocabularywordDescID.Default.(func() uuid.UUID)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdeliveryMixinHooks0 := webhookdeliveryMixin[0].Hooks()
+	webhookdelivery.Hooks[0] = webhookdeliveryMixinHooks0[0]
+	webhookdelivery.Hooks[1] = webhookdeliveryMixinHooks0[1]
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescStatus is the schema descriptor for status field.
+	webhookdeliveryDescStatus := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultStatus holds the default value on creation for the status field.
+	webhookdelivery.DefaultStatus = webhookdeliveryDescStatus.Default.(int)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescResponseStatus is the schema descriptor for response_status field.
+	webhookdeliveryDescResponseStatus := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultResponseStatus holds the default value on creation for the response_status field.
+	webhookdelivery.DefaultResponseStatus = webhookdeliveryDescResponseStatus.Default.(int)
+	// webhookdeliveryDescError is the schema descriptor for error field.
+	webhookdeliveryDescError := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.DefaultError holds the default value on creation for the error field.
+	webhookdelivery.DefaultError = webhookdeliveryDescError.Default.(string)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() uuid.UUID)
+	webhookendpointMixin := schema.WebhookEndpoint{}.Mixin()
+	webhookendpointMixinHooks0 := webhookendpointMixin[0].Hooks()
+	webhookendpoint.Hooks[0] = webhookendpointMixinHooks0[0]
+	webhookendpoint.Hooks[1] = webhookendpointMixinHooks0[1]
+	webhookendpointFields := schema.WebhookEndpoint{}.Fields()
+	_ = webhookendpointFields
+	// webhookendpointDescDescription is the schema descriptor for description field.
+	webhookendpointDescDescription := webhookendpointFields[2].Descriptor()
+	// webhookendpoint.DefaultDescription holds the default value on creation for the description field.
+	webhookendpoint.DefaultDescription = webhookendpointDescDescription.Default.(string)
+	// webhookendpointDescEnabled is the schema descriptor for enabled field.
+	webhookendpointDescEnabled := webhookendpointFields[5].Descriptor()
+	// webhookendpoint.DefaultEnabled holds the default value on creation for the enabled field.
+	webhookendpoint.DefaultEnabled = webhookendpointDescEnabled.Default.(bool)
+	// webhookendpointDescID is the schema descriptor for id field.
+	webhookendpointDescID := webhookendpointFields[0].Descriptor()
+	// webhookendpoint.DefaultID holds the default value on creation for the id field.
+	webhookendpoint.DefaultID = webhookendpointDescID.Default.(func() uuid.UUID)
+	webhookeventMixin := schema.WebhookEvent{}.Mixin()
+	webhookeventMixinHooks0 := webhookeventMixin[0].Hooks()
+	webhookevent.Hooks[0] = webhookeventMixinHooks0[0]
+	webhookeventFields := schema.WebhookEvent{}.Fields()
+	_ = webhookeventFields
+	// webhookeventDescID is the schema descriptor for id field.
+	webhookeventDescID := webhookeventFields[0].Descriptor()
+	// webhookevent.DefaultID holds the default value on creation for the id field.
+	webhookevent.DefaultID = webhookeventDescID.Default.(func() uuid.UUID)
+}
 
 const (
 	Version = "v0.14.5"                                         // Version of ent codegen.
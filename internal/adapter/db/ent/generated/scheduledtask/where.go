@@ -0,0 +1,661 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scheduledtask
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldName, v))
+}
+
+// IntervalSeconds applies equality check predicate on the "interval_seconds" field. It's identical to IntervalSecondsEQ.
+func IntervalSeconds(v int64) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldIntervalSeconds, v))
+}
+
+// NextRunAt applies equality check predicate on the "next_run_at" field. It's identical to NextRunAtEQ.
+func NextRunAt(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// LastRunAt applies equality check predicate on the "last_run_at" field. It's identical to LastRunAtEQ.
+func LastRunAt(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastStatus applies equality check predicate on the "last_status" field. It's identical to LastStatusEQ.
+func LastStatus(v int) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLastStatus, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastDurationMs applies equality check predicate on the "last_duration_ms" field. It's identical to LastDurationMsEQ.
+func LastDurationMs(v int64) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLastDurationMs, v))
+}
+
+// LockedBy applies equality check predicate on the "locked_by" field. It's identical to LockedByEQ.
+func LockedBy(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLockedBy, v))
+}
+
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldContainsFold(FieldName, v))
+}
+
+// IntervalSecondsEQ applies the EQ predicate on the "interval_seconds" field.
+func IntervalSecondsEQ(v int64) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldIntervalSeconds, v))
+}
+
+// IntervalSecondsNEQ applies the NEQ predicate on the "interval_seconds" field.
+func IntervalSecondsNEQ(v int64) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldIntervalSeconds, v))
+}
+
+// IntervalSecondsIn applies the In predicate on the "interval_seconds" field.
+func IntervalSecondsIn(vs ...int64) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldIntervalSeconds, vs...))
+}
+
+// IntervalSecondsNotIn applies the NotIn predicate on the "interval_seconds" field.
+func IntervalSecondsNotIn(vs ...int64) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldIntervalSeconds, vs...))
+}
+
+// IntervalSecondsGT applies the GT predicate on the "interval_seconds" field.
+func IntervalSecondsGT(v int64) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldIntervalSeconds, v))
+}
+
+// IntervalSecondsGTE applies the GTE predicate on the "interval_seconds" field.
+func IntervalSecondsGTE(v int64) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldIntervalSeconds, v))
+}
+
+// IntervalSecondsLT applies the LT predicate on the "interval_seconds" field.
+func IntervalSecondsLT(v int64) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldIntervalSeconds, v))
+}
+
+// IntervalSecondsLTE applies the LTE predicate on the "interval_seconds" field.
+func IntervalSecondsLTE(v int64) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldIntervalSeconds, v))
+}
+
+// NextRunAtEQ applies the EQ predicate on the "next_run_at" field.
+func NextRunAtEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtNEQ applies the NEQ predicate on the "next_run_at" field.
+func NextRunAtNEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtIn applies the In predicate on the "next_run_at" field.
+func NextRunAtIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtNotIn applies the NotIn predicate on the "next_run_at" field.
+func NextRunAtNotIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtGT applies the GT predicate on the "next_run_at" field.
+func NextRunAtGT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldNextRunAt, v))
+}
+
+// NextRunAtGTE applies the GTE predicate on the "next_run_at" field.
+func NextRunAtGTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldNextRunAt, v))
+}
+
+// NextRunAtLT applies the LT predicate on the "next_run_at" field.
+func NextRunAtLT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldNextRunAt, v))
+}
+
+// NextRunAtLTE applies the LTE predicate on the "next_run_at" field.
+func NextRunAtLTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldNextRunAt, v))
+}
+
+// LastRunAtEQ applies the EQ predicate on the "last_run_at" field.
+func LastRunAtEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtNEQ applies the NEQ predicate on the "last_run_at" field.
+func LastRunAtNEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtIn applies the In predicate on the "last_run_at" field.
+func LastRunAtIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtNotIn applies the NotIn predicate on the "last_run_at" field.
+func LastRunAtNotIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtGT applies the GT predicate on the "last_run_at" field.
+func LastRunAtGT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldLastRunAt, v))
+}
+
+// LastRunAtGTE applies the GTE predicate on the "last_run_at" field.
+func LastRunAtGTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldLastRunAt, v))
+}
+
+// LastRunAtLT applies the LT predicate on the "last_run_at" field.
+func LastRunAtLT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldLastRunAt, v))
+}
+
+// LastRunAtLTE applies the LTE predicate on the "last_run_at" field.
+func LastRunAtLTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldLastRunAt, v))
+}
+
+// LastRunAtIsNil applies the IsNil predicate on the "last_run_at" field.
+func LastRunAtIsNil() predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIsNull(FieldLastRunAt))
+}
+
+// LastRunAtNotNil applies the NotNil predicate on the "last_run_at" field.
+func LastRunAtNotNil() predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotNull(FieldLastRunAt))
+}
+
+// LastStatusEQ applies the EQ predicate on the "last_status" field.
+func LastStatusEQ(v int) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLastStatus, v))
+}
+
+// LastStatusNEQ applies the NEQ predicate on the "last_status" field.
+func LastStatusNEQ(v int) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldLastStatus, v))
+}
+
+// LastStatusIn applies the In predicate on the "last_status" field.
+func LastStatusIn(vs ...int) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldLastStatus, vs...))
+}
+
+// LastStatusNotIn applies the NotIn predicate on the "last_status" field.
+func LastStatusNotIn(vs ...int) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldLastStatus, vs...))
+}
+
+// LastStatusGT applies the GT predicate on the "last_status" field.
+func LastStatusGT(v int) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldLastStatus, v))
+}
+
+// LastStatusGTE applies the GTE predicate on the "last_status" field.
+func LastStatusGTE(v int) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldLastStatus, v))
+}
+
+// LastStatusLT applies the LT predicate on the "last_status" field.
+func LastStatusLT(v int) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldLastStatus, v))
+}
+
+// LastStatusLTE applies the LTE predicate on the "last_status" field.
+func LastStatusLTE(v int) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldLastStatus, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// LastDurationMsEQ applies the EQ predicate on the "last_duration_ms" field.
+func LastDurationMsEQ(v int64) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLastDurationMs, v))
+}
+
+// LastDurationMsNEQ applies the NEQ predicate on the "last_duration_ms" field.
+func LastDurationMsNEQ(v int64) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldLastDurationMs, v))
+}
+
+// LastDurationMsIn applies the In predicate on the "last_duration_ms" field.
+func LastDurationMsIn(vs ...int64) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldLastDurationMs, vs...))
+}
+
+// LastDurationMsNotIn applies the NotIn predicate on the "last_duration_ms" field.
+func LastDurationMsNotIn(vs ...int64) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldLastDurationMs, vs...))
+}
+
+// LastDurationMsGT applies the GT predicate on the "last_duration_ms" field.
+func LastDurationMsGT(v int64) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldLastDurationMs, v))
+}
+
+// LastDurationMsGTE applies the GTE predicate on the "last_duration_ms" field.
+func LastDurationMsGTE(v int64) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldLastDurationMs, v))
+}
+
+// LastDurationMsLT applies the LT predicate on the "last_duration_ms" field.
+func LastDurationMsLT(v int64) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldLastDurationMs, v))
+}
+
+// LastDurationMsLTE applies the LTE predicate on the "last_duration_ms" field.
+func LastDurationMsLTE(v int64) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldLastDurationMs, v))
+}
+
+// LockedByEQ applies the EQ predicate on the "locked_by" field.
+func LockedByEQ(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLockedBy, v))
+}
+
+// LockedByNEQ applies the NEQ predicate on the "locked_by" field.
+func LockedByNEQ(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldLockedBy, v))
+}
+
+// LockedByIn applies the In predicate on the "locked_by" field.
+func LockedByIn(vs ...string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldLockedBy, vs...))
+}
+
+// LockedByNotIn applies the NotIn predicate on the "locked_by" field.
+func LockedByNotIn(vs ...string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldLockedBy, vs...))
+}
+
+// LockedByGT applies the GT predicate on the "locked_by" field.
+func LockedByGT(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldLockedBy, v))
+}
+
+// LockedByGTE applies the GTE predicate on the "locked_by" field.
+func LockedByGTE(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldLockedBy, v))
+}
+
+// LockedByLT applies the LT predicate on the "locked_by" field.
+func LockedByLT(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldLockedBy, v))
+}
+
+// LockedByLTE applies the LTE predicate on the "locked_by" field.
+func LockedByLTE(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldLockedBy, v))
+}
+
+// LockedByContains applies the Contains predicate on the "locked_by" field.
+func LockedByContains(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldContains(FieldLockedBy, v))
+}
+
+// LockedByHasPrefix applies the HasPrefix predicate on the "locked_by" field.
+func LockedByHasPrefix(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldHasPrefix(FieldLockedBy, v))
+}
+
+// LockedByHasSuffix applies the HasSuffix predicate on the "locked_by" field.
+func LockedByHasSuffix(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldHasSuffix(FieldLockedBy, v))
+}
+
+// LockedByEqualFold applies the EqualFold predicate on the "locked_by" field.
+func LockedByEqualFold(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEqualFold(FieldLockedBy, v))
+}
+
+// LockedByContainsFold applies the ContainsFold predicate on the "locked_by" field.
+func LockedByContainsFold(v string) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldContainsFold(FieldLockedBy, v))
+}
+
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldIsNull(FieldLockedUntil))
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.FieldNotNull(FieldLockedUntil))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScheduledTask) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScheduledTask) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScheduledTask) predicate.ScheduledTask {
+	return predicate.ScheduledTask(sql.NotPredicates(p))
+}
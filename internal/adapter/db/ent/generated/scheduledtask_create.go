@@ -0,0 +1,408 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/scheduledtask"
+	"github.com/google/uuid"
+)
+
+// ScheduledTaskCreate is the builder for creating a ScheduledTask entity.
+type ScheduledTaskCreate struct {
+	config
+	mutation *ScheduledTaskMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ScheduledTaskCreate) SetCreatedAt(v time.Time) *ScheduledTaskCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ScheduledTaskCreate) SetUpdatedAt(v time.Time) *ScheduledTaskCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ScheduledTaskCreate) SetName(v string) *ScheduledTaskCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetIntervalSeconds sets the "interval_seconds" field.
+func (_c *ScheduledTaskCreate) SetIntervalSeconds(v int64) *ScheduledTaskCreate {
+	_c.mutation.SetIntervalSeconds(v)
+	return _c
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_c *ScheduledTaskCreate) SetNextRunAt(v time.Time) *ScheduledTaskCreate {
+	_c.mutation.SetNextRunAt(v)
+	return _c
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_c *ScheduledTaskCreate) SetLastRunAt(v time.Time) *ScheduledTaskCreate {
+	_c.mutation.SetLastRunAt(v)
+	return _c
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_c *ScheduledTaskCreate) SetNillableLastRunAt(v *time.Time) *ScheduledTaskCreate {
+	if v != nil {
+		_c.SetLastRunAt(*v)
+	}
+	return _c
+}
+
+// SetLastStatus sets the "last_status" field.
+func (_c *ScheduledTaskCreate) SetLastStatus(v int) *ScheduledTaskCreate {
+	_c.mutation.SetLastStatus(v)
+	return _c
+}
+
+// SetNillableLastStatus sets the "last_status" field if the given value is not nil.
+func (_c *ScheduledTaskCreate) SetNillableLastStatus(v *int) *ScheduledTaskCreate {
+	if v != nil {
+		_c.SetLastStatus(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *ScheduledTaskCreate) SetLastError(v string) *ScheduledTaskCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *ScheduledTaskCreate) SetNillableLastError(v *string) *ScheduledTaskCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetLastDurationMs sets the "last_duration_ms" field.
+func (_c *ScheduledTaskCreate) SetLastDurationMs(v int64) *ScheduledTaskCreate {
+	_c.mutation.SetLastDurationMs(v)
+	return _c
+}
+
+// SetNillableLastDurationMs sets the "last_duration_ms" field if the given value is not nil.
+func (_c *ScheduledTaskCreate) SetNillableLastDurationMs(v *int64) *ScheduledTaskCreate {
+	if v != nil {
+		_c.SetLastDurationMs(*v)
+	}
+	return _c
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (_c *ScheduledTaskCreate) SetLockedBy(v string) *ScheduledTaskCreate {
+	_c.mutation.SetLockedBy(v)
+	return _c
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (_c *ScheduledTaskCreate) SetNillableLockedBy(v *string) *ScheduledTaskCreate {
+	if v != nil {
+		_c.SetLockedBy(*v)
+	}
+	return _c
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_c *ScheduledTaskCreate) SetLockedUntil(v time.Time) *ScheduledTaskCreate {
+	_c.mutation.SetLockedUntil(v)
+	return _c
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_c *ScheduledTaskCreate) SetNillableLockedUntil(v *time.Time) *ScheduledTaskCreate {
+	if v != nil {
+		_c.SetLockedUntil(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScheduledTaskCreate) SetID(v uuid.UUID) *ScheduledTaskCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ScheduledTaskCreate) SetNillableID(v *uuid.UUID) *ScheduledTaskCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ScheduledTaskMutation object of the builder.
+func (_c *ScheduledTaskCreate) Mutation() *ScheduledTaskMutation {
+	return _c.mutation
+}
+
+// Save creates the ScheduledTask in the database.
+func (_c *ScheduledTaskCreate) Save(ctx context.Context) (*ScheduledTask, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScheduledTaskCreate) SaveX(ctx context.Context) *ScheduledTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScheduledTaskCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScheduledTaskCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScheduledTaskCreate) defaults() error {
+	if _, ok := _c.mutation.LastStatus(); !ok {
+		v := scheduledtask.DefaultLastStatus
+		_c.mutation.SetLastStatus(v)
+	}
+	if _, ok := _c.mutation.LastError(); !ok {
+		v := scheduledtask.DefaultLastError
+		_c.mutation.SetLastError(v)
+	}
+	if _, ok := _c.mutation.LastDurationMs(); !ok {
+		v := scheduledtask.DefaultLastDurationMs
+		_c.mutation.SetLastDurationMs(v)
+	}
+	if _, ok := _c.mutation.LockedBy(); !ok {
+		v := scheduledtask.DefaultLockedBy
+		_c.mutation.SetLockedBy(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if scheduledtask.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized scheduledtask.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := scheduledtask.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScheduledTaskCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "ScheduledTask.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "ScheduledTask.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`generated: missing required field "ScheduledTask.name"`)}
+	}
+	if _, ok := _c.mutation.IntervalSeconds(); !ok {
+		return &ValidationError{Name: "interval_seconds", err: errors.New(`generated: missing required field "ScheduledTask.interval_seconds"`)}
+	}
+	if _, ok := _c.mutation.NextRunAt(); !ok {
+		return &ValidationError{Name: "next_run_at", err: errors.New(`generated: missing required field "ScheduledTask.next_run_at"`)}
+	}
+	if _, ok := _c.mutation.LastStatus(); !ok {
+		return &ValidationError{Name: "last_status", err: errors.New(`generated: missing required field "ScheduledTask.last_status"`)}
+	}
+	if _, ok := _c.mutation.LastError(); !ok {
+		return &ValidationError{Name: "last_error", err: errors.New(`generated: missing required field "ScheduledTask.last_error"`)}
+	}
+	if _, ok := _c.mutation.LastDurationMs(); !ok {
+		return &ValidationError{Name: "last_duration_ms", err: errors.New(`generated: missing required field "ScheduledTask.last_duration_ms"`)}
+	}
+	if _, ok := _c.mutation.LockedBy(); !ok {
+		return &ValidationError{Name: "locked_by", err: errors.New(`generated: missing required field "ScheduledTask.locked_by"`)}
+	}
+	return nil
+}
+
+func (_c *ScheduledTaskCreate) sqlSave(ctx context.Context) (*ScheduledTask, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScheduledTaskCreate) createSpec() (*ScheduledTask, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduledTask{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scheduledtask.Table, sqlgraph.NewFieldSpec(scheduledtask.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(scheduledtask.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledtask.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(scheduledtask.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.IntervalSeconds(); ok {
+		_spec.SetField(scheduledtask.FieldIntervalSeconds, field.TypeInt64, value)
+		_node.IntervalSeconds = value
+	}
+	if value, ok := _c.mutation.NextRunAt(); ok {
+		_spec.SetField(scheduledtask.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = value
+	}
+	if value, ok := _c.mutation.LastRunAt(); ok {
+		_spec.SetField(scheduledtask.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	if value, ok := _c.mutation.LastStatus(); ok {
+		_spec.SetField(scheduledtask.FieldLastStatus, field.TypeInt, value)
+		_node.LastStatus = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(scheduledtask.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.LastDurationMs(); ok {
+		_spec.SetField(scheduledtask.FieldLastDurationMs, field.TypeInt64, value)
+		_node.LastDurationMs = value
+	}
+	if value, ok := _c.mutation.LockedBy(); ok {
+		_spec.SetField(scheduledtask.FieldLockedBy, field.TypeString, value)
+		_node.LockedBy = value
+	}
+	if value, ok := _c.mutation.LockedUntil(); ok {
+		_spec.SetField(scheduledtask.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	return _node, _spec
+}
+
+// ScheduledTaskCreateBulk is the builder for creating many ScheduledTask entities in bulk.
+type ScheduledTaskCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduledTaskCreate
+}
+
+// Save creates the ScheduledTask entities in the database.
+func (_c *ScheduledTaskCreateBulk) Save(ctx context.Context) ([]*ScheduledTask, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScheduledTask, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduledTaskMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScheduledTaskCreateBulk) SaveX(ctx context.Context) []*ScheduledTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScheduledTaskCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScheduledTaskCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
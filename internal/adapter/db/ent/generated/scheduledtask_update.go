@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/scheduledtask"
+)
+
+// ScheduledTaskUpdate is the builder for updating ScheduledTask entities.
+type ScheduledTaskUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScheduledTaskMutation
+}
+
+// Where appends a list predicates to the ScheduledTaskUpdate builder.
+func (_u *ScheduledTaskUpdate) Where(ps ...predicate.ScheduledTask) *ScheduledTaskUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScheduledTaskUpdate) SetUpdatedAt(v time.Time) *ScheduledTaskUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ScheduledTaskUpdate) SetNillableUpdatedAt(v *time.Time) *ScheduledTaskUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetIntervalSeconds sets the "interval_seconds" field.
+func (_u *ScheduledTaskUpdate) SetIntervalSeconds(v int64) *ScheduledTaskUpdate {
+	_u.mutation.ResetIntervalSeconds()
+	_u.mutation.SetIntervalSeconds(v)
+	return _u
+}
+
+// SetNillableIntervalSeconds sets the "interval_seconds" field if the given value is not nil.
+func (_u *ScheduledTaskUpdate) SetNillableIntervalSeconds(v *int64) *ScheduledTaskUpdate {
+	if v != nil {
+		_u.SetIntervalSeconds(*v)
+	}
+	return _u
+}
+
+// AddIntervalSeconds adds value to the "interval_seconds" field.
+func (_u *ScheduledTaskUpdate) AddIntervalSeconds(v int64) *ScheduledTaskUpdate {
+	_u.mutation.AddIntervalSeconds(v)
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *ScheduledTaskUpdate) SetNextRunAt(v time.Time) *ScheduledTaskUpdate {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *ScheduledTaskUpdate) SetNillableNextRunAt(v *time.Time) *ScheduledTaskUpdate {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *ScheduledTaskUpdate) SetLastRunAt(v time.Time) *ScheduledTaskUpdate {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *ScheduledTaskUpdate) SetNillableLastRunAt(v *time.Time) *ScheduledTaskUpdate {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *ScheduledTaskUpdate) ClearLastRunAt() *ScheduledTaskUpdate {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastStatus sets the "last_status" field.
+func (_u *ScheduledTaskUpdate) SetLastStatus(v int) *ScheduledTaskUpdate {
+	_u.mutation.ResetLastStatus()
+	_u.mutation.SetLastStatus(v)
+	return _u
+}
+
+// SetNillableLastStatus sets the "last_status" field if the given value is not nil.
+func (_u *ScheduledTaskUpdate) SetNillableLastStatus(v *int) *ScheduledTaskUpdate {
+	if v != nil {
+		_u.SetLastStatus(*v)
+	}
+	return _u
+}
+
+// AddLastStatus adds value to the "last_status" field.
+func (_u *ScheduledTaskUpdate) AddLastStatus(v int) *ScheduledTaskUpdate {
+	_u.mutation.AddLastStatus(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ScheduledTaskUpdate) SetLastError(v string) *ScheduledTaskUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ScheduledTaskUpdate) SetNillableLastError(v *string) *ScheduledTaskUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// SetLastDurationMs sets the "last_duration_ms" field.
+func (_u *ScheduledTaskUpdate) SetLastDurationMs(v int64) *ScheduledTaskUpdate {
+	_u.mutation.ResetLastDurationMs()
+	_u.mutation.SetLastDurationMs(v)
+	return _u
+}
+
+// SetNillableLastDurationMs sets the "last_duration_ms" field if the given value is not nil.
+func (_u *ScheduledTaskUpdate) SetNillableLastDurationMs(v *int64) *ScheduledTaskUpdate {
+	if v != nil {
+		_u.SetLastDurationMs(*v)
+	}
+	return _u
+}
+
+// AddLastDurationMs adds value to the "last_duration_ms" field.
+func (_u *ScheduledTaskUpdate) AddLastDurationMs(v int64) *ScheduledTaskUpdate {
+	_u.mutation.AddLastDurationMs(v)
+	return _u
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (_u *ScheduledTaskUpdate) SetLockedBy(v string) *ScheduledTaskUpdate {
+	_u.mutation.SetLockedBy(v)
+	return _u
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (_u *ScheduledTaskUpdate) SetNillableLockedBy(v *string) *ScheduledTaskUpdate {
+	if v != nil {
+		_u.SetLockedBy(*v)
+	}
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *ScheduledTaskUpdate) SetLockedUntil(v time.Time) *ScheduledTaskUpdate {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *ScheduledTaskUpdate) SetNillableLockedUntil(v *time.Time) *ScheduledTaskUpdate {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *ScheduledTaskUpdate) ClearLockedUntil() *ScheduledTaskUpdate {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// Mutation returns the ScheduledTaskMutation object of the builder.
+func (_u *ScheduledTaskUpdate) Mutation() *ScheduledTaskMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ScheduledTaskUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScheduledTaskUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ScheduledTaskUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScheduledTaskUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ScheduledTaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(scheduledtask.Table, scheduledtask.Columns, sqlgraph.NewFieldSpec(scheduledtask.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledtask.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.IntervalSeconds(); ok {
+		_spec.SetField(scheduledtask.FieldIntervalSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedIntervalSeconds(); ok {
+		_spec.AddField(scheduledtask.FieldIntervalSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(scheduledtask.FieldNextRunAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(scheduledtask.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(scheduledtask.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastStatus(); ok {
+		_spec.SetField(scheduledtask.FieldLastStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastStatus(); ok {
+		_spec.AddField(scheduledtask.FieldLastStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(scheduledtask.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastDurationMs(); ok {
+		_spec.SetField(scheduledtask.FieldLastDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastDurationMs(); ok {
+		_spec.AddField(scheduledtask.FieldLastDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LockedBy(); ok {
+		_spec.SetField(scheduledtask.FieldLockedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(scheduledtask.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(scheduledtask.FieldLockedUntil, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledtask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ScheduledTaskUpdateOne is the builder for updating a single ScheduledTask entity.
+type ScheduledTaskUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScheduledTaskMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScheduledTaskUpdateOne) SetUpdatedAt(v time.Time) *ScheduledTaskUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ScheduledTaskUpdateOne) SetNillableUpdatedAt(v *time.Time) *ScheduledTaskUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetIntervalSeconds sets the "interval_seconds" field.
+func (_u *ScheduledTaskUpdateOne) SetIntervalSeconds(v int64) *ScheduledTaskUpdateOne {
+	_u.mutation.ResetIntervalSeconds()
+	_u.mutation.SetIntervalSeconds(v)
+	return _u
+}
+
+// SetNillableIntervalSeconds sets the "interval_seconds" field if the given value is not nil.
+func (_u *ScheduledTaskUpdateOne) SetNillableIntervalSeconds(v *int64) *ScheduledTaskUpdateOne {
+	if v != nil {
+		_u.SetIntervalSeconds(*v)
+	}
+	return _u
+}
+
+// AddIntervalSeconds adds value to the "interval_seconds" field.
+func (_u *ScheduledTaskUpdateOne) AddIntervalSeconds(v int64) *ScheduledTaskUpdateOne {
+	_u.mutation.AddIntervalSeconds(v)
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *ScheduledTaskUpdateOne) SetNextRunAt(v time.Time) *ScheduledTaskUpdateOne {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *ScheduledTaskUpdateOne) SetNillableNextRunAt(v *time.Time) *ScheduledTaskUpdateOne {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *ScheduledTaskUpdateOne) SetLastRunAt(v time.Time) *ScheduledTaskUpdateOne {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *ScheduledTaskUpdateOne) SetNillableLastRunAt(v *time.Time) *ScheduledTaskUpdateOne {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *ScheduledTaskUpdateOne) ClearLastRunAt() *ScheduledTaskUpdateOne {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastStatus sets the "last_status" field.
+func (_u *ScheduledTaskUpdateOne) SetLastStatus(v int) *ScheduledTaskUpdateOne {
+	_u.mutation.ResetLastStatus()
+	_u.mutation.SetLastStatus(v)
+	return _u
+}
+
+// SetNillableLastStatus sets the "last_status" field if the given value is not nil.
+func (_u *ScheduledTaskUpdateOne) SetNillableLastStatus(v *int) *ScheduledTaskUpdateOne {
+	if v != nil {
+		_u.SetLastStatus(*v)
+	}
+	return _u
+}
+
+// AddLastStatus adds value to the "last_status" field.
+func (_u *ScheduledTaskUpdateOne) AddLastStatus(v int) *ScheduledTaskUpdateOne {
+	_u.mutation.AddLastStatus(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *ScheduledTaskUpdateOne) SetLastError(v string) *ScheduledTaskUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *ScheduledTaskUpdateOne) SetNillableLastError(v *string) *ScheduledTaskUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// SetLastDurationMs sets the "last_duration_ms" field.
+func (_u *ScheduledTaskUpdateOne) SetLastDurationMs(v int64) *ScheduledTaskUpdateOne {
+	_u.mutation.ResetLastDurationMs()
+	_u.mutation.SetLastDurationMs(v)
+	return _u
+}
+
+// SetNillableLastDurationMs sets the "last_duration_ms" field if the given value is not nil.
+func (_u *ScheduledTaskUpdateOne) SetNillableLastDurationMs(v *int64) *ScheduledTaskUpdateOne {
+	if v != nil {
+		_u.SetLastDurationMs(*v)
+	}
+	return _u
+}
+
+// AddLastDurationMs adds value to the "last_duration_ms" field.
+func (_u *ScheduledTaskUpdateOne) AddLastDurationMs(v int64) *ScheduledTaskUpdateOne {
+	_u.mutation.AddLastDurationMs(v)
+	return _u
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (_u *ScheduledTaskUpdateOne) SetLockedBy(v string) *ScheduledTaskUpdateOne {
+	_u.mutation.SetLockedBy(v)
+	return _u
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (_u *ScheduledTaskUpdateOne) SetNillableLockedBy(v *string) *ScheduledTaskUpdateOne {
+	if v != nil {
+		_u.SetLockedBy(*v)
+	}
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *ScheduledTaskUpdateOne) SetLockedUntil(v time.Time) *ScheduledTaskUpdateOne {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *ScheduledTaskUpdateOne) SetNillableLockedUntil(v *time.Time) *ScheduledTaskUpdateOne {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *ScheduledTaskUpdateOne) ClearLockedUntil() *ScheduledTaskUpdateOne {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// Mutation returns the ScheduledTaskMutation object of the builder.
+func (_u *ScheduledTaskUpdateOne) Mutation() *ScheduledTaskMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ScheduledTaskUpdate builder.
+func (_u *ScheduledTaskUpdateOne) Where(ps ...predicate.ScheduledTask) *ScheduledTaskUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ScheduledTaskUpdateOne) Select(field string, fields ...string) *ScheduledTaskUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ScheduledTask entity.
+func (_u *ScheduledTaskUpdateOne) Save(ctx context.Context) (*ScheduledTask, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScheduledTaskUpdateOne) SaveX(ctx context.Context) *ScheduledTask {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ScheduledTaskUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScheduledTaskUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ScheduledTaskUpdateOne) sqlSave(ctx context.Context) (_node *ScheduledTask, err error) {
+	_spec := sqlgraph.NewUpdateSpec(scheduledtask.Table, scheduledtask.Columns, sqlgraph.NewFieldSpec(scheduledtask.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "ScheduledTask.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledtask.FieldID)
+		for _, f := range fields {
+			if !scheduledtask.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != scheduledtask.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledtask.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.IntervalSeconds(); ok {
+		_spec.SetField(scheduledtask.FieldIntervalSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedIntervalSeconds(); ok {
+		_spec.AddField(scheduledtask.FieldIntervalSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(scheduledtask.FieldNextRunAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(scheduledtask.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(scheduledtask.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastStatus(); ok {
+		_spec.SetField(scheduledtask.FieldLastStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastStatus(); ok {
+		_spec.AddField(scheduledtask.FieldLastStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(scheduledtask.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastDurationMs(); ok {
+		_spec.SetField(scheduledtask.FieldLastDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastDurationMs(); ok {
+		_spec.AddField(scheduledtask.FieldLastDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LockedBy(); ok {
+		_spec.SetField(scheduledtask.FieldLockedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(scheduledtask.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(scheduledtask.FieldLockedUntil, field.TypeTime)
+	}
+	_node = &ScheduledTask{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledtask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
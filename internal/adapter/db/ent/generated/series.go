@@ -19,6 +19,12 @@ type Series struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Slug holds the value of the "slug" field.
 	Slug string `json:"slug,omitempty"`
 	// Title holds the value of the "title" field.
@@ -29,18 +35,28 @@ type Series struct {
 	Language string `json:"language,omitempty"`
 	// Level holds the value of the "level" field.
 	Level string `json:"level,omitempty"`
+	// EstimatedLevel holds the value of the "estimated_level" field.
+	EstimatedLevel string `json:"estimated_level,omitempty"`
 	// Tags holds the value of the "tags" field.
 	Tags []string `json:"tags,omitempty"`
 	// CoverURL holds the value of the "cover_url" field.
 	CoverURL string `json:"cover_url,omitempty"`
+	// CoverAssetID holds the value of the "cover_asset_id" field.
+	CoverAssetID *uuid.UUID `json:"cover_asset_id,omitempty"`
 	// Status holds the value of the "status" field.
 	Status int `json:"status,omitempty"`
+	// DrmEnabled holds the value of the "drm_enabled" field.
+	DrmEnabled bool `json:"drm_enabled,omitempty"`
+	// AccentColor holds the value of the "accent_color" field.
+	AccentColor string `json:"accent_color,omitempty"`
+	// HeroAssetID holds the value of the "hero_asset_id" field.
+	HeroAssetID *uuid.UUID `json:"hero_asset_id,omitempty"`
+	// HeroImageURL holds the value of the "hero_image_url" field.
+	HeroImageURL string `json:"hero_image_url,omitempty"`
+	// Layout holds the value of the "layout" field.
+	Layout int `json:"layout,omitempty"`
 	// EpisodeCount holds the value of the "episode_count" field.
 	EpisodeCount int `json:"episode_count,omitempty"`
-	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt time.Time `json:"created_at,omitempty"`
-	// UpdatedAt holds the value of the "updated_at" field.
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// PublishedAt holds the value of the "published_at" field.
 	PublishedAt *time.Time `json:"published_at,omitempty"`
 	// AuthorIds holds the value of the "author_ids" field.
@@ -74,13 +90,17 @@ func (*Series) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case series.FieldCoverAssetID, series.FieldHeroAssetID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case series.FieldTags, series.FieldAuthorIds:
 			values[i] = new([]byte)
-		case series.FieldStatus, series.FieldEpisodeCount:
+		case series.FieldDrmEnabled:
+			values[i] = new(sql.NullBool)
+		case series.FieldStatus, series.FieldLayout, series.FieldEpisodeCount:
 			values[i] = new(sql.NullInt64)
-		case series.FieldSlug, series.FieldTitle, series.FieldSummary, series.FieldLanguage, series.FieldLevel, series.FieldCoverURL:
+		case series.FieldSlug, series.FieldTitle, series.FieldSummary, series.FieldLanguage, series.FieldLevel, series.FieldEstimatedLevel, series.FieldCoverURL, series.FieldAccentColor, series.FieldHeroImageURL:
 			values[i] = new(sql.NullString)
-		case series.FieldCreatedAt, series.FieldUpdatedAt, series.FieldPublishedAt:
+		case series.FieldCreatedAt, series.FieldUpdatedAt, series.FieldDeletedAt, series.FieldPublishedAt:
 			values[i] = new(sql.NullTime)
 		case series.FieldID:
 			values[i] = new(uuid.UUID)
@@ -105,6 +125,25 @@ func (_m *Series) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case series.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case series.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case series.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case series.FieldSlug:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field slug", values[i])
@@ -135,6 +174,12 @@ func (_m *Series) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Level = value.String
 			}
+		case series.FieldEstimatedLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field estimated_level", values[i])
+			} else if value.Valid {
+				_m.EstimatedLevel = value.String
+			}
 		case series.FieldTags:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field tags", values[i])
@@ -149,29 +194,55 @@ func (_m *Series) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CoverURL = value.String
 			}
+		case series.FieldCoverAssetID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field cover_asset_id", values[i])
+			} else if value.Valid {
+				_m.CoverAssetID = new(uuid.UUID)
+				*_m.CoverAssetID = *value.S.(*uuid.UUID)
+			}
 		case series.FieldStatus:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
 			} else if value.Valid {
 				_m.Status = int(value.Int64)
 			}
-		case series.FieldEpisodeCount:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for field episode_count", values[i])
+		case series.FieldDrmEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field drm_enabled", values[i])
 			} else if value.Valid {
-				_m.EpisodeCount = int(value.Int64)
+				_m.DrmEnabled = value.Bool
 			}
-		case series.FieldCreatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+		case series.FieldAccentColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field accent_color", values[i])
 			} else if value.Valid {
-				_m.CreatedAt = value.Time
+				_m.AccentColor = value.String
 			}
-		case series.FieldUpdatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+		case series.FieldHeroAssetID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field hero_asset_id", values[i])
 			} else if value.Valid {
-				_m.UpdatedAt = value.Time
+				_m.HeroAssetID = new(uuid.UUID)
+				*_m.HeroAssetID = *value.S.(*uuid.UUID)
+			}
+		case series.FieldHeroImageURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hero_image_url", values[i])
+			} else if value.Valid {
+				_m.HeroImageURL = value.String
+			}
+		case series.FieldLayout:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field layout", values[i])
+			} else if value.Valid {
+				_m.Layout = int(value.Int64)
+			}
+		case series.FieldEpisodeCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field episode_count", values[i])
+			} else if value.Valid {
+				_m.EpisodeCount = int(value.Int64)
 			}
 		case series.FieldPublishedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -229,6 +300,17 @@ func (_m *Series) String() string {
 	var builder strings.Builder
 	builder.WriteString("Series(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("slug=")
 	builder.WriteString(_m.Slug)
 	builder.WriteString(", ")
@@ -244,23 +326,42 @@ func (_m *Series) String() string {
 	builder.WriteString("level=")
 	builder.WriteString(_m.Level)
 	builder.WriteString(", ")
+	builder.WriteString("estimated_level=")
+	builder.WriteString(_m.EstimatedLevel)
+	builder.WriteString(", ")
 	builder.WriteString("tags=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Tags))
 	builder.WriteString(", ")
 	builder.WriteString("cover_url=")
 	builder.WriteString(_m.CoverURL)
 	builder.WriteString(", ")
+	if v := _m.CoverAssetID; v != nil {
+		builder.WriteString("cover_asset_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
-	builder.WriteString("episode_count=")
-	builder.WriteString(fmt.Sprintf("%v", _m.EpisodeCount))
+	builder.WriteString("drm_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DrmEnabled))
 	builder.WriteString(", ")
-	builder.WriteString("created_at=")
-	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString("accent_color=")
+	builder.WriteString(_m.AccentColor)
 	builder.WriteString(", ")
-	builder.WriteString("updated_at=")
-	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	if v := _m.HeroAssetID; v != nil {
+		builder.WriteString("hero_asset_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("hero_image_url=")
+	builder.WriteString(_m.HeroImageURL)
+	builder.WriteString(", ")
+	builder.WriteString("layout=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Layout))
+	builder.WriteString(", ")
+	builder.WriteString("episode_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EpisodeCount))
 	builder.WriteString(", ")
 	if v := _m.PublishedAt; v != nil {
 		builder.WriteString("published_at=")
@@ -3,8 +3,7 @@
 package series
 
 import (
-	"time"
-
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -15,6 +14,12 @@ const (
 	Label = "series"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldSlug holds the string denoting the slug field in the database.
 	FieldSlug = "slug"
 	// FieldTitle holds the string denoting the title field in the database.
@@ -25,18 +30,28 @@ const (
 	FieldLanguage = "language"
 	// FieldLevel holds the string denoting the level field in the database.
 	FieldLevel = "level"
+	// FieldEstimatedLevel holds the string denoting the estimated_level field in the database.
+	FieldEstimatedLevel = "estimated_level"
 	// FieldTags holds the string denoting the tags field in the database.
 	FieldTags = "tags"
 	// FieldCoverURL holds the string denoting the cover_url field in the database.
 	FieldCoverURL = "cover_url"
+	// FieldCoverAssetID holds the string denoting the cover_asset_id field in the database.
+	FieldCoverAssetID = "cover_asset_id"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldDrmEnabled holds the string denoting the drm_enabled field in the database.
+	FieldDrmEnabled = "drm_enabled"
+	// FieldAccentColor holds the string denoting the accent_color field in the database.
+	FieldAccentColor = "accent_color"
+	// FieldHeroAssetID holds the string denoting the hero_asset_id field in the database.
+	FieldHeroAssetID = "hero_asset_id"
+	// FieldHeroImageURL holds the string denoting the hero_image_url field in the database.
+	FieldHeroImageURL = "hero_image_url"
+	// FieldLayout holds the string denoting the layout field in the database.
+	FieldLayout = "layout"
 	// FieldEpisodeCount holds the string denoting the episode_count field in the database.
 	FieldEpisodeCount = "episode_count"
-	// FieldCreatedAt holds the string denoting the created_at field in the database.
-	FieldCreatedAt = "created_at"
-	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
-	FieldUpdatedAt = "updated_at"
 	// FieldPublishedAt holds the string denoting the published_at field in the database.
 	FieldPublishedAt = "published_at"
 	// FieldAuthorIds holds the string denoting the author_ids field in the database.
@@ -57,17 +72,25 @@ const (
 // Columns holds all SQL columns for series fields.
 var Columns = []string{
 	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
 	FieldSlug,
 	FieldTitle,
 	FieldSummary,
 	FieldLanguage,
 	FieldLevel,
+	FieldEstimatedLevel,
 	FieldTags,
 	FieldCoverURL,
+	FieldCoverAssetID,
 	FieldStatus,
+	FieldDrmEnabled,
+	FieldAccentColor,
+	FieldHeroAssetID,
+	FieldHeroImageURL,
+	FieldLayout,
 	FieldEpisodeCount,
-	FieldCreatedAt,
-	FieldUpdatedAt,
 	FieldPublishedAt,
 	FieldAuthorIds,
 }
@@ -82,25 +105,36 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eslsoft/lession/internal/adapter/db/ent/generated/runtime"
 var (
+	Hooks        [3]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// DefaultSummary holds the default value on creation for the "summary" field.
 	DefaultSummary string
 	// DefaultLanguage holds the default value on creation for the "language" field.
 	DefaultLanguage string
 	// DefaultLevel holds the default value on creation for the "level" field.
 	DefaultLevel string
+	// DefaultEstimatedLevel holds the default value on creation for the "estimated_level" field.
+	DefaultEstimatedLevel string
 	// DefaultCoverURL holds the default value on creation for the "cover_url" field.
 	DefaultCoverURL string
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus int
+	// DefaultDrmEnabled holds the default value on creation for the "drm_enabled" field.
+	DefaultDrmEnabled bool
+	// DefaultAccentColor holds the default value on creation for the "accent_color" field.
+	DefaultAccentColor string
+	// DefaultHeroImageURL holds the default value on creation for the "hero_image_url" field.
+	DefaultHeroImageURL string
+	// DefaultLayout holds the default value on creation for the "layout" field.
+	DefaultLayout int
 	// DefaultEpisodeCount holds the default value on creation for the "episode_count" field.
 	DefaultEpisodeCount int
-	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
-	DefaultCreatedAt func() time.Time
-	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
-	DefaultUpdatedAt func() time.Time
-	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
-	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -113,6 +147,21 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // BySlug orders the results by the slug field.
 func BySlug(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSlug, opts...).ToFunc()
@@ -138,29 +187,54 @@ func ByLevel(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLevel, opts...).ToFunc()
 }
 
+// ByEstimatedLevel orders the results by the estimated_level field.
+func ByEstimatedLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEstimatedLevel, opts...).ToFunc()
+}
+
 // ByCoverURL orders the results by the cover_url field.
 func ByCoverURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCoverURL, opts...).ToFunc()
 }
 
+// ByCoverAssetID orders the results by the cover_asset_id field.
+func ByCoverAssetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCoverAssetID, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
-// ByEpisodeCount orders the results by the episode_count field.
-func ByEpisodeCount(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldEpisodeCount, opts...).ToFunc()
+// ByDrmEnabled orders the results by the drm_enabled field.
+func ByDrmEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDrmEnabled, opts...).ToFunc()
 }
 
-// ByCreatedAt orders the results by the created_at field.
-func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+// ByAccentColor orders the results by the accent_color field.
+func ByAccentColor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccentColor, opts...).ToFunc()
 }
 
-// ByUpdatedAt orders the results by the updated_at field.
-func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+// ByHeroAssetID orders the results by the hero_asset_id field.
+func ByHeroAssetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeroAssetID, opts...).ToFunc()
+}
+
+// ByHeroImageURL orders the results by the hero_image_url field.
+func ByHeroImageURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeroImageURL, opts...).ToFunc()
+}
+
+// ByLayout orders the results by the layout field.
+func ByLayout(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLayout, opts...).ToFunc()
+}
+
+// ByEpisodeCount orders the results by the episode_count field.
+func ByEpisodeCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEpisodeCount, opts...).ToFunc()
 }
 
 // ByPublishedAt orders the results by the published_at field.
@@ -56,6 +56,21 @@ func IDLTE(id uuid.UUID) predicate.Series {
 	return predicate.Series(sql.FieldLTE(FieldID, id))
 }
 
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
 func Slug(v string) predicate.Series {
 	return predicate.Series(sql.FieldEQ(FieldSlug, v))
@@ -81,34 +96,189 @@ func Level(v string) predicate.Series {
 	return predicate.Series(sql.FieldEQ(FieldLevel, v))
 }
 
+// EstimatedLevel applies equality check predicate on the "estimated_level" field. It's identical to EstimatedLevelEQ.
+func EstimatedLevel(v string) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldEstimatedLevel, v))
+}
+
 // CoverURL applies equality check predicate on the "cover_url" field. It's identical to CoverURLEQ.
 func CoverURL(v string) predicate.Series {
 	return predicate.Series(sql.FieldEQ(FieldCoverURL, v))
 }
 
+// CoverAssetID applies equality check predicate on the "cover_asset_id" field. It's identical to CoverAssetIDEQ.
+func CoverAssetID(v uuid.UUID) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldCoverAssetID, v))
+}
+
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
 func Status(v int) predicate.Series {
 	return predicate.Series(sql.FieldEQ(FieldStatus, v))
 }
 
+// DrmEnabled applies equality check predicate on the "drm_enabled" field. It's identical to DrmEnabledEQ.
+func DrmEnabled(v bool) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldDrmEnabled, v))
+}
+
+// AccentColor applies equality check predicate on the "accent_color" field. It's identical to AccentColorEQ.
+func AccentColor(v string) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldAccentColor, v))
+}
+
+// HeroAssetID applies equality check predicate on the "hero_asset_id" field. It's identical to HeroAssetIDEQ.
+func HeroAssetID(v uuid.UUID) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldHeroAssetID, v))
+}
+
+// HeroImageURL applies equality check predicate on the "hero_image_url" field. It's identical to HeroImageURLEQ.
+func HeroImageURL(v string) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldHeroImageURL, v))
+}
+
+// Layout applies equality check predicate on the "layout" field. It's identical to LayoutEQ.
+func Layout(v int) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldLayout, v))
+}
+
 // EpisodeCount applies equality check predicate on the "episode_count" field. It's identical to EpisodeCountEQ.
 func EpisodeCount(v int) predicate.Series {
 	return predicate.Series(sql.FieldEQ(FieldEpisodeCount, v))
 }
 
-// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
-func CreatedAt(v time.Time) predicate.Series {
+// PublishedAt applies equality check predicate on the "published_at" field. It's identical to PublishedAtEQ.
+func PublishedAt(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldPublishedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Series {
 	return predicate.Series(sql.FieldEQ(FieldCreatedAt, v))
 }
 
-// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
-func UpdatedAt(v time.Time) predicate.Series {
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Series {
+	return predicate.Series(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Series {
+	return predicate.Series(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Series {
 	return predicate.Series(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
-// PublishedAt applies equality check predicate on the "published_at" field. It's identical to PublishedAtEQ.
-func PublishedAt(v time.Time) predicate.Series {
-	return predicate.Series(sql.FieldEQ(FieldPublishedAt, v))
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Series {
+	return predicate.Series(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Series {
+	return predicate.Series(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Series {
+	return predicate.Series(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Series {
+	return predicate.Series(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Series {
+	return predicate.Series(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Series {
+	return predicate.Series(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Series {
+	return predicate.Series(sql.FieldNotNull(FieldDeletedAt))
 }
 
 // SlugEQ applies the EQ predicate on the "slug" field.
@@ -436,6 +606,71 @@ func LevelContainsFold(v string) predicate.Series {
 	return predicate.Series(sql.FieldContainsFold(FieldLevel, v))
 }
 
+// EstimatedLevelEQ applies the EQ predicate on the "estimated_level" field.
+func EstimatedLevelEQ(v string) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldEstimatedLevel, v))
+}
+
+// EstimatedLevelNEQ applies the NEQ predicate on the "estimated_level" field.
+func EstimatedLevelNEQ(v string) predicate.Series {
+	return predicate.Series(sql.FieldNEQ(FieldEstimatedLevel, v))
+}
+
+// EstimatedLevelIn applies the In predicate on the "estimated_level" field.
+func EstimatedLevelIn(vs ...string) predicate.Series {
+	return predicate.Series(sql.FieldIn(FieldEstimatedLevel, vs...))
+}
+
+// EstimatedLevelNotIn applies the NotIn predicate on the "estimated_level" field.
+func EstimatedLevelNotIn(vs ...string) predicate.Series {
+	return predicate.Series(sql.FieldNotIn(FieldEstimatedLevel, vs...))
+}
+
+// EstimatedLevelGT applies the GT predicate on the "estimated_level" field.
+func EstimatedLevelGT(v string) predicate.Series {
+	return predicate.Series(sql.FieldGT(FieldEstimatedLevel, v))
+}
+
+// EstimatedLevelGTE applies the GTE predicate on the "estimated_level" field.
+func EstimatedLevelGTE(v string) predicate.Series {
+	return predicate.Series(sql.FieldGTE(FieldEstimatedLevel, v))
+}
+
+// EstimatedLevelLT applies the LT predicate on the "estimated_level" field.
+func EstimatedLevelLT(v string) predicate.Series {
+	return predicate.Series(sql.FieldLT(FieldEstimatedLevel, v))
+}
+
+// EstimatedLevelLTE applies the LTE predicate on the "estimated_level" field.
+func EstimatedLevelLTE(v string) predicate.Series {
+	return predicate.Series(sql.FieldLTE(FieldEstimatedLevel, v))
+}
+
+// EstimatedLevelContains applies the Contains predicate on the "estimated_level" field.
+func EstimatedLevelContains(v string) predicate.Series {
+	return predicate.Series(sql.FieldContains(FieldEstimatedLevel, v))
+}
+
+// EstimatedLevelHasPrefix applies the HasPrefix predicate on the "estimated_level" field.
+func EstimatedLevelHasPrefix(v string) predicate.Series {
+	return predicate.Series(sql.FieldHasPrefix(FieldEstimatedLevel, v))
+}
+
+// EstimatedLevelHasSuffix applies the HasSuffix predicate on the "estimated_level" field.
+func EstimatedLevelHasSuffix(v string) predicate.Series {
+	return predicate.Series(sql.FieldHasSuffix(FieldEstimatedLevel, v))
+}
+
+// EstimatedLevelEqualFold applies the EqualFold predicate on the "estimated_level" field.
+func EstimatedLevelEqualFold(v string) predicate.Series {
+	return predicate.Series(sql.FieldEqualFold(FieldEstimatedLevel, v))
+}
+
+// EstimatedLevelContainsFold applies the ContainsFold predicate on the "estimated_level" field.
+func EstimatedLevelContainsFold(v string) predicate.Series {
+	return predicate.Series(sql.FieldContainsFold(FieldEstimatedLevel, v))
+}
+
 // TagsIsNil applies the IsNil predicate on the "tags" field.
 func TagsIsNil() predicate.Series {
 	return predicate.Series(sql.FieldIsNull(FieldTags))
@@ -511,6 +746,56 @@ func CoverURLContainsFold(v string) predicate.Series {
 	return predicate.Series(sql.FieldContainsFold(FieldCoverURL, v))
 }
 
+// CoverAssetIDEQ applies the EQ predicate on the "cover_asset_id" field.
+func CoverAssetIDEQ(v uuid.UUID) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldCoverAssetID, v))
+}
+
+// CoverAssetIDNEQ applies the NEQ predicate on the "cover_asset_id" field.
+func CoverAssetIDNEQ(v uuid.UUID) predicate.Series {
+	return predicate.Series(sql.FieldNEQ(FieldCoverAssetID, v))
+}
+
+// CoverAssetIDIn applies the In predicate on the "cover_asset_id" field.
+func CoverAssetIDIn(vs ...uuid.UUID) predicate.Series {
+	return predicate.Series(sql.FieldIn(FieldCoverAssetID, vs...))
+}
+
+// CoverAssetIDNotIn applies the NotIn predicate on the "cover_asset_id" field.
+func CoverAssetIDNotIn(vs ...uuid.UUID) predicate.Series {
+	return predicate.Series(sql.FieldNotIn(FieldCoverAssetID, vs...))
+}
+
+// CoverAssetIDGT applies the GT predicate on the "cover_asset_id" field.
+func CoverAssetIDGT(v uuid.UUID) predicate.Series {
+	return predicate.Series(sql.FieldGT(FieldCoverAssetID, v))
+}
+
+// CoverAssetIDGTE applies the GTE predicate on the "cover_asset_id" field.
+func CoverAssetIDGTE(v uuid.UUID) predicate.Series {
+	return predicate.Series(sql.FieldGTE(FieldCoverAssetID, v))
+}
+
+// CoverAssetIDLT applies the LT predicate on the "cover_asset_id" field.
+func CoverAssetIDLT(v uuid.UUID) predicate.Series {
+	return predicate.Series(sql.FieldLT(FieldCoverAssetID, v))
+}
+
+// CoverAssetIDLTE applies the LTE predicate on the "cover_asset_id" field.
+func CoverAssetIDLTE(v uuid.UUID) predicate.Series {
+	return predicate.Series(sql.FieldLTE(FieldCoverAssetID, v))
+}
+
+// CoverAssetIDIsNil applies the IsNil predicate on the "cover_asset_id" field.
+func CoverAssetIDIsNil() predicate.Series {
+	return predicate.Series(sql.FieldIsNull(FieldCoverAssetID))
+}
+
+// CoverAssetIDNotNil applies the NotNil predicate on the "cover_asset_id" field.
+func CoverAssetIDNotNil() predicate.Series {
+	return predicate.Series(sql.FieldNotNull(FieldCoverAssetID))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v int) predicate.Series {
 	return predicate.Series(sql.FieldEQ(FieldStatus, v))
@@ -551,124 +836,274 @@ func StatusLTE(v int) predicate.Series {
 	return predicate.Series(sql.FieldLTE(FieldStatus, v))
 }
 
-// EpisodeCountEQ applies the EQ predicate on the "episode_count" field.
-func EpisodeCountEQ(v int) predicate.Series {
-	return predicate.Series(sql.FieldEQ(FieldEpisodeCount, v))
+// DrmEnabledEQ applies the EQ predicate on the "drm_enabled" field.
+func DrmEnabledEQ(v bool) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldDrmEnabled, v))
 }
 
-// EpisodeCountNEQ applies the NEQ predicate on the "episode_count" field.
-func EpisodeCountNEQ(v int) predicate.Series {
-	return predicate.Series(sql.FieldNEQ(FieldEpisodeCount, v))
+// DrmEnabledNEQ applies the NEQ predicate on the "drm_enabled" field.
+func DrmEnabledNEQ(v bool) predicate.Series {
+	return predicate.Series(sql.FieldNEQ(FieldDrmEnabled, v))
 }
 
-// EpisodeCountIn applies the In predicate on the "episode_count" field.
-func EpisodeCountIn(vs ...int) predicate.Series {
-	return predicate.Series(sql.FieldIn(FieldEpisodeCount, vs...))
+// AccentColorEQ applies the EQ predicate on the "accent_color" field.
+func AccentColorEQ(v string) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldAccentColor, v))
 }
 
-// EpisodeCountNotIn applies the NotIn predicate on the "episode_count" field.
-func EpisodeCountNotIn(vs ...int) predicate.Series {
-	return predicate.Series(sql.FieldNotIn(FieldEpisodeCount, vs...))
+// AccentColorNEQ applies the NEQ predicate on the "accent_color" field.
+func AccentColorNEQ(v string) predicate.Series {
+	return predicate.Series(sql.FieldNEQ(FieldAccentColor, v))
 }
 
-// EpisodeCountGT applies the GT predicate on the "episode_count" field.
-func EpisodeCountGT(v int) predicate.Series {
-	return predicate.Series(sql.FieldGT(FieldEpisodeCount, v))
+// AccentColorIn applies the In predicate on the "accent_color" field.
+func AccentColorIn(vs ...string) predicate.Series {
+	return predicate.Series(sql.FieldIn(FieldAccentColor, vs...))
 }
 
-// EpisodeCountGTE applies the GTE predicate on the "episode_count" field.
-func EpisodeCountGTE(v int) predicate.Series {
-	return predicate.Series(sql.FieldGTE(FieldEpisodeCount, v))
+// AccentColorNotIn applies the NotIn predicate on the "accent_color" field.
+func AccentColorNotIn(vs ...string) predicate.Series {
+	return predicate.Series(sql.FieldNotIn(FieldAccentColor, vs...))
 }
 
-// EpisodeCountLT applies the LT predicate on the "episode_count" field.
-func EpisodeCountLT(v int) predicate.Series {
-	return predicate.Series(sql.FieldLT(FieldEpisodeCount, v))
+// AccentColorGT applies the GT predicate on the "accent_color" field.
+func AccentColorGT(v string) predicate.Series {
+	return predicate.Series(sql.FieldGT(FieldAccentColor, v))
 }
 
-// EpisodeCountLTE applies the LTE predicate on the "episode_count" field.
-func EpisodeCountLTE(v int) predicate.Series {
-	return predicate.Series(sql.FieldLTE(FieldEpisodeCount, v))
+// AccentColorGTE applies the GTE predicate on the "accent_color" field.
+func AccentColorGTE(v string) predicate.Series {
+	return predicate.Series(sql.FieldGTE(FieldAccentColor, v))
 }
 
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.Series {
-	return predicate.Series(sql.FieldEQ(FieldCreatedAt, v))
+// AccentColorLT applies the LT predicate on the "accent_color" field.
+func AccentColorLT(v string) predicate.Series {
+	return predicate.Series(sql.FieldLT(FieldAccentColor, v))
 }
 
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.Series {
-	return predicate.Series(sql.FieldNEQ(FieldCreatedAt, v))
+// AccentColorLTE applies the LTE predicate on the "accent_color" field.
+func AccentColorLTE(v string) predicate.Series {
+	return predicate.Series(sql.FieldLTE(FieldAccentColor, v))
 }
 
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.Series {
-	return predicate.Series(sql.FieldIn(FieldCreatedAt, vs...))
+// AccentColorContains applies the Contains predicate on the "accent_color" field.
+func AccentColorContains(v string) predicate.Series {
+	return predicate.Series(sql.FieldContains(FieldAccentColor, v))
 }
 
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.Series {
-	return predicate.Series(sql.FieldNotIn(FieldCreatedAt, vs...))
+// AccentColorHasPrefix applies the HasPrefix predicate on the "accent_color" field.
+func AccentColorHasPrefix(v string) predicate.Series {
+	return predicate.Series(sql.FieldHasPrefix(FieldAccentColor, v))
 }
 
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.Series {
-	return predicate.Series(sql.FieldGT(FieldCreatedAt, v))
+// AccentColorHasSuffix applies the HasSuffix predicate on the "accent_color" field.
+func AccentColorHasSuffix(v string) predicate.Series {
+	return predicate.Series(sql.FieldHasSuffix(FieldAccentColor, v))
 }
 
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.Series {
-	return predicate.Series(sql.FieldGTE(FieldCreatedAt, v))
+// AccentColorEqualFold applies the EqualFold predicate on the "accent_color" field.
+func AccentColorEqualFold(v string) predicate.Series {
+	return predicate.Series(sql.FieldEqualFold(FieldAccentColor, v))
 }
 
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.Series {
-	return predicate.Series(sql.FieldLT(FieldCreatedAt, v))
+// AccentColorContainsFold applies the ContainsFold predicate on the "accent_color" field.
+func AccentColorContainsFold(v string) predicate.Series {
+	return predicate.Series(sql.FieldContainsFold(FieldAccentColor, v))
 }
 
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.Series {
-	return predicate.Series(sql.FieldLTE(FieldCreatedAt, v))
+// HeroAssetIDEQ applies the EQ predicate on the "hero_asset_id" field.
+func HeroAssetIDEQ(v uuid.UUID) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldHeroAssetID, v))
 }
 
-// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
-func UpdatedAtEQ(v time.Time) predicate.Series {
-	return predicate.Series(sql.FieldEQ(FieldUpdatedAt, v))
+// HeroAssetIDNEQ applies the NEQ predicate on the "hero_asset_id" field.
+func HeroAssetIDNEQ(v uuid.UUID) predicate.Series {
+	return predicate.Series(sql.FieldNEQ(FieldHeroAssetID, v))
 }
 
-// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
-func UpdatedAtNEQ(v time.Time) predicate.Series {
-	return predicate.Series(sql.FieldNEQ(FieldUpdatedAt, v))
+// HeroAssetIDIn applies the In predicate on the "hero_asset_id" field.
+func HeroAssetIDIn(vs ...uuid.UUID) predicate.Series {
+	return predicate.Series(sql.FieldIn(FieldHeroAssetID, vs...))
 }
 
-// UpdatedAtIn applies the In predicate on the "updated_at" field.
-func UpdatedAtIn(vs ...time.Time) predicate.Series {
-	return predicate.Series(sql.FieldIn(FieldUpdatedAt, vs...))
+// HeroAssetIDNotIn applies the NotIn predicate on the "hero_asset_id" field.
+func HeroAssetIDNotIn(vs ...uuid.UUID) predicate.Series {
+	return predicate.Series(sql.FieldNotIn(FieldHeroAssetID, vs...))
 }
 
-// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
-func UpdatedAtNotIn(vs ...time.Time) predicate.Series {
-	return predicate.Series(sql.FieldNotIn(FieldUpdatedAt, vs...))
+// HeroAssetIDGT applies the GT predicate on the "hero_asset_id" field.
+func HeroAssetIDGT(v uuid.UUID) predicate.Series {
+	return predicate.Series(sql.FieldGT(FieldHeroAssetID, v))
 }
 
-// UpdatedAtGT applies the GT predicate on the "updated_at" field.
-func UpdatedAtGT(v time.Time) predicate.Series {
-	return predicate.Series(sql.FieldGT(FieldUpdatedAt, v))
+// HeroAssetIDGTE applies the GTE predicate on the "hero_asset_id" field.
+func HeroAssetIDGTE(v uuid.UUID) predicate.Series {
+	return predicate.Series(sql.FieldGTE(FieldHeroAssetID, v))
 }
 
-// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
-func UpdatedAtGTE(v time.Time) predicate.Series {
-	return predicate.Series(sql.FieldGTE(FieldUpdatedAt, v))
+// HeroAssetIDLT applies the LT predicate on the "hero_asset_id" field.
+func HeroAssetIDLT(v uuid.UUID) predicate.Series {
+	return predicate.Series(sql.FieldLT(FieldHeroAssetID, v))
 }
 
-// UpdatedAtLT applies the LT predicate on the "updated_at" field.
-func UpdatedAtLT(v time.Time) predicate.Series {
-	return predicate.Series(sql.FieldLT(FieldUpdatedAt, v))
+// HeroAssetIDLTE applies the LTE predicate on the "hero_asset_id" field.
+func HeroAssetIDLTE(v uuid.UUID) predicate.Series {
+	return predicate.Series(sql.FieldLTE(FieldHeroAssetID, v))
 }
 
-// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
-func UpdatedAtLTE(v time.Time) predicate.Series {
-	return predicate.Series(sql.FieldLTE(FieldUpdatedAt, v))
+// HeroAssetIDIsNil applies the IsNil predicate on the "hero_asset_id" field.
+func HeroAssetIDIsNil() predicate.Series {
+	return predicate.Series(sql.FieldIsNull(FieldHeroAssetID))
+}
+
+// HeroAssetIDNotNil applies the NotNil predicate on the "hero_asset_id" field.
+func HeroAssetIDNotNil() predicate.Series {
+	return predicate.Series(sql.FieldNotNull(FieldHeroAssetID))
+}
+
+// HeroImageURLEQ applies the EQ predicate on the "hero_image_url" field.
+func HeroImageURLEQ(v string) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldHeroImageURL, v))
+}
+
+// HeroImageURLNEQ applies the NEQ predicate on the "hero_image_url" field.
+func HeroImageURLNEQ(v string) predicate.Series {
+	return predicate.Series(sql.FieldNEQ(FieldHeroImageURL, v))
+}
+
+// HeroImageURLIn applies the In predicate on the "hero_image_url" field.
+func HeroImageURLIn(vs ...string) predicate.Series {
+	return predicate.Series(sql.FieldIn(FieldHeroImageURL, vs...))
+}
+
+// HeroImageURLNotIn applies the NotIn predicate on the "hero_image_url" field.
+func HeroImageURLNotIn(vs ...string) predicate.Series {
+	return predicate.Series(sql.FieldNotIn(FieldHeroImageURL, vs...))
+}
+
+// HeroImageURLGT applies the GT predicate on the "hero_image_url" field.
+func HeroImageURLGT(v string) predicate.Series {
+	return predicate.Series(sql.FieldGT(FieldHeroImageURL, v))
+}
+
+// HeroImageURLGTE applies the GTE predicate on the "hero_image_url" field.
+func HeroImageURLGTE(v string) predicate.Series {
+	return predicate.Series(sql.FieldGTE(FieldHeroImageURL, v))
+}
+
+// HeroImageURLLT applies the LT predicate on the "hero_image_url" field.
+func HeroImageURLLT(v string) predicate.Series {
+	return predicate.Series(sql.FieldLT(FieldHeroImageURL, v))
+}
+
+// HeroImageURLLTE applies the LTE predicate on the "hero_image_url" field.
+func HeroImageURLLTE(v string) predicate.Series {
+	return predicate.Series(sql.FieldLTE(FieldHeroImageURL, v))
+}
+
+// HeroImageURLContains applies the Contains predicate on the "hero_image_url" field.
+func HeroImageURLContains(v string) predicate.Series {
+	return predicate.Series(sql.FieldContains(FieldHeroImageURL, v))
+}
+
+// HeroImageURLHasPrefix applies the HasPrefix predicate on the "hero_image_url" field.
+func HeroImageURLHasPrefix(v string) predicate.Series {
+	return predicate.Series(sql.FieldHasPrefix(FieldHeroImageURL, v))
+}
+
+// HeroImageURLHasSuffix applies the HasSuffix predicate on the "hero_image_url" field.
+func HeroImageURLHasSuffix(v string) predicate.Series {
+	return predicate.Series(sql.FieldHasSuffix(FieldHeroImageURL, v))
+}
+
+// HeroImageURLEqualFold applies the EqualFold predicate on the "hero_image_url" field.
+func HeroImageURLEqualFold(v string) predicate.Series {
+	return predicate.Series(sql.FieldEqualFold(FieldHeroImageURL, v))
+}
+
+// HeroImageURLContainsFold applies the ContainsFold predicate on the "hero_image_url" field.
+func HeroImageURLContainsFold(v string) predicate.Series {
+	return predicate.Series(sql.FieldContainsFold(FieldHeroImageURL, v))
+}
+
+// LayoutEQ applies the EQ predicate on the "layout" field.
+func LayoutEQ(v int) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldLayout, v))
+}
+
+// LayoutNEQ applies the NEQ predicate on the "layout" field.
+func LayoutNEQ(v int) predicate.Series {
+	return predicate.Series(sql.FieldNEQ(FieldLayout, v))
+}
+
+// LayoutIn applies the In predicate on the "layout" field.
+func LayoutIn(vs ...int) predicate.Series {
+	return predicate.Series(sql.FieldIn(FieldLayout, vs...))
+}
+
+// LayoutNotIn applies the NotIn predicate on the "layout" field.
+func LayoutNotIn(vs ...int) predicate.Series {
+	return predicate.Series(sql.FieldNotIn(FieldLayout, vs...))
+}
+
+// LayoutGT applies the GT predicate on the "layout" field.
+func LayoutGT(v int) predicate.Series {
+	return predicate.Series(sql.FieldGT(FieldLayout, v))
+}
+
+// LayoutGTE applies the GTE predicate on the "layout" field.
+func LayoutGTE(v int) predicate.Series {
+	return predicate.Series(sql.FieldGTE(FieldLayout, v))
+}
+
+// LayoutLT applies the LT predicate on the "layout" field.
+func LayoutLT(v int) predicate.Series {
+	return predicate.Series(sql.FieldLT(FieldLayout, v))
+}
+
+// LayoutLTE applies the LTE predicate on the "layout" field.
+func LayoutLTE(v int) predicate.Series {
+	return predicate.Series(sql.FieldLTE(FieldLayout, v))
+}
+
+// EpisodeCountEQ applies the EQ predicate on the "episode_count" field.
+func EpisodeCountEQ(v int) predicate.Series {
+	return predicate.Series(sql.FieldEQ(FieldEpisodeCount, v))
+}
+
+// EpisodeCountNEQ applies the NEQ predicate on the "episode_count" field.
+func EpisodeCountNEQ(v int) predicate.Series {
+	return predicate.Series(sql.FieldNEQ(FieldEpisodeCount, v))
+}
+
+// EpisodeCountIn applies the In predicate on the "episode_count" field.
+func EpisodeCountIn(vs ...int) predicate.Series {
+	return predicate.Series(sql.FieldIn(FieldEpisodeCount, vs...))
+}
+
+// EpisodeCountNotIn applies the NotIn predicate on the "episode_count" field.
+func EpisodeCountNotIn(vs ...int) predicate.Series {
+	return predicate.Series(sql.FieldNotIn(FieldEpisodeCount, vs...))
+}
+
+// EpisodeCountGT applies the GT predicate on the "episode_count" field.
+func EpisodeCountGT(v int) predicate.Series {
+	return predicate.Series(sql.FieldGT(FieldEpisodeCount, v))
+}
+
+// EpisodeCountGTE applies the GTE predicate on the "episode_count" field.
+func EpisodeCountGTE(v int) predicate.Series {
+	return predicate.Series(sql.FieldGTE(FieldEpisodeCount, v))
+}
+
+// EpisodeCountLT applies the LT predicate on the "episode_count" field.
+func EpisodeCountLT(v int) predicate.Series {
+	return predicate.Series(sql.FieldLT(FieldEpisodeCount, v))
+}
+
+// EpisodeCountLTE applies the LTE predicate on the "episode_count" field.
+func EpisodeCountLTE(v int) predicate.Series {
+	return predicate.Series(sql.FieldLTE(FieldEpisodeCount, v))
 }
 
 // PublishedAtEQ applies the EQ predicate on the "published_at" field.
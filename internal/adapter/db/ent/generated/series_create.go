@@ -22,6 +22,32 @@ type SeriesCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (_c *SeriesCreate) SetCreatedAt(v time.Time) *SeriesCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SeriesCreate) SetUpdatedAt(v time.Time) *SeriesCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *SeriesCreate) SetDeletedAt(v time.Time) *SeriesCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *SeriesCreate) SetNillableDeletedAt(v *time.Time) *SeriesCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetSlug sets the "slug" field.
 func (_c *SeriesCreate) SetSlug(v string) *SeriesCreate {
 	_c.mutation.SetSlug(v)
@@ -76,6 +102,20 @@ func (_c *SeriesCreate) SetNillableLevel(v *string) *SeriesCreate {
 	return _c
 }
 
+// SetEstimatedLevel sets the "estimated_level" field.
+func (_c *SeriesCreate) SetEstimatedLevel(v string) *SeriesCreate {
+	_c.mutation.SetEstimatedLevel(v)
+	return _c
+}
+
+// SetNillableEstimatedLevel sets the "estimated_level" field if the given value is not nil.
+func (_c *SeriesCreate) SetNillableEstimatedLevel(v *string) *SeriesCreate {
+	if v != nil {
+		_c.SetEstimatedLevel(*v)
+	}
+	return _c
+}
+
 // SetTags sets the "tags" field.
 func (_c *SeriesCreate) SetTags(v []string) *SeriesCreate {
 	_c.mutation.SetTags(v)
@@ -96,6 +136,20 @@ func (_c *SeriesCreate) SetNillableCoverURL(v *string) *SeriesCreate {
 	return _c
 }
 
+// SetCoverAssetID sets the "cover_asset_id" field.
+func (_c *SeriesCreate) SetCoverAssetID(v uuid.UUID) *SeriesCreate {
+	_c.mutation.SetCoverAssetID(v)
+	return _c
+}
+
+// SetNillableCoverAssetID sets the "cover_asset_id" field if the given value is not nil.
+func (_c *SeriesCreate) SetNillableCoverAssetID(v *uuid.UUID) *SeriesCreate {
+	if v != nil {
+		_c.SetCoverAssetID(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *SeriesCreate) SetStatus(v int) *SeriesCreate {
 	_c.mutation.SetStatus(v)
@@ -110,44 +164,86 @@ func (_c *SeriesCreate) SetNillableStatus(v *int) *SeriesCreate {
 	return _c
 }
 
-// SetEpisodeCount sets the "episode_count" field.
-func (_c *SeriesCreate) SetEpisodeCount(v int) *SeriesCreate {
-	_c.mutation.SetEpisodeCount(v)
+// SetDrmEnabled sets the "drm_enabled" field.
+func (_c *SeriesCreate) SetDrmEnabled(v bool) *SeriesCreate {
+	_c.mutation.SetDrmEnabled(v)
 	return _c
 }
 
-// SetNillableEpisodeCount sets the "episode_count" field if the given value is not nil.
-func (_c *SeriesCreate) SetNillableEpisodeCount(v *int) *SeriesCreate {
+// SetNillableDrmEnabled sets the "drm_enabled" field if the given value is not nil.
+func (_c *SeriesCreate) SetNillableDrmEnabled(v *bool) *SeriesCreate {
 	if v != nil {
-		_c.SetEpisodeCount(*v)
+		_c.SetDrmEnabled(*v)
 	}
 	return _c
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_c *SeriesCreate) SetCreatedAt(v time.Time) *SeriesCreate {
-	_c.mutation.SetCreatedAt(v)
+// SetAccentColor sets the "accent_color" field.
+func (_c *SeriesCreate) SetAccentColor(v string) *SeriesCreate {
+	_c.mutation.SetAccentColor(v)
 	return _c
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_c *SeriesCreate) SetNillableCreatedAt(v *time.Time) *SeriesCreate {
+// SetNillableAccentColor sets the "accent_color" field if the given value is not nil.
+func (_c *SeriesCreate) SetNillableAccentColor(v *string) *SeriesCreate {
 	if v != nil {
-		_c.SetCreatedAt(*v)
+		_c.SetAccentColor(*v)
 	}
 	return _c
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_c *SeriesCreate) SetUpdatedAt(v time.Time) *SeriesCreate {
-	_c.mutation.SetUpdatedAt(v)
+// SetHeroAssetID sets the "hero_asset_id" field.
+func (_c *SeriesCreate) SetHeroAssetID(v uuid.UUID) *SeriesCreate {
+	_c.mutation.SetHeroAssetID(v)
+	return _c
+}
+
+// SetNillableHeroAssetID sets the "hero_asset_id" field if the given value is not nil.
+func (_c *SeriesCreate) SetNillableHeroAssetID(v *uuid.UUID) *SeriesCreate {
+	if v != nil {
+		_c.SetHeroAssetID(*v)
+	}
+	return _c
+}
+
+// SetHeroImageURL sets the "hero_image_url" field.
+func (_c *SeriesCreate) SetHeroImageURL(v string) *SeriesCreate {
+	_c.mutation.SetHeroImageURL(v)
+	return _c
+}
+
+// SetNillableHeroImageURL sets the "hero_image_url" field if the given value is not nil.
+func (_c *SeriesCreate) SetNillableHeroImageURL(v *string) *SeriesCreate {
+	if v != nil {
+		_c.SetHeroImageURL(*v)
+	}
+	return _c
+}
+
+// SetLayout sets the "layout" field.
+func (_c *SeriesCreate) SetLayout(v int) *SeriesCreate {
+	_c.mutation.SetLayout(v)
+	return _c
+}
+
+// SetNillableLayout sets the "layout" field if the given value is not nil.
+func (_c *SeriesCreate) SetNillableLayout(v *int) *SeriesCreate {
+	if v != nil {
+		_c.SetLayout(*v)
+	}
+	return _c
+}
+
+// SetEpisodeCount sets the "episode_count" field.
+func (_c *SeriesCreate) SetEpisodeCount(v int) *SeriesCreate {
+	_c.mutation.SetEpisodeCount(v)
 	return _c
 }
 
-// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
-func (_c *SeriesCreate) SetNillableUpdatedAt(v *time.Time) *SeriesCreate {
+// SetNillableEpisodeCount sets the "episode_count" field if the given value is not nil.
+func (_c *SeriesCreate) SetNillableEpisodeCount(v *int) *SeriesCreate {
 	if v != nil {
-		_c.SetUpdatedAt(*v)
+		_c.SetEpisodeCount(*v)
 	}
 	return _c
 }
@@ -208,7 +304,9 @@ func (_c *SeriesCreate) Mutation() *SeriesMutation {
 
 // Save creates the Series in the database.
 func (_c *SeriesCreate) Save(ctx context.Context) (*Series, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -235,7 +333,7 @@ func (_c *SeriesCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *SeriesCreate) defaults() {
+func (_c *SeriesCreate) defaults() error {
 	if _, ok := _c.mutation.Summary(); !ok {
 		v := series.DefaultSummary
 		_c.mutation.SetSummary(v)
@@ -248,6 +346,10 @@ func (_c *SeriesCreate) defaults() {
 		v := series.DefaultLevel
 		_c.mutation.SetLevel(v)
 	}
+	if _, ok := _c.mutation.EstimatedLevel(); !ok {
+		v := series.DefaultEstimatedLevel
+		_c.mutation.SetEstimatedLevel(v)
+	}
 	if _, ok := _c.mutation.CoverURL(); !ok {
 		v := series.DefaultCoverURL
 		_c.mutation.SetCoverURL(v)
@@ -256,26 +358,44 @@ func (_c *SeriesCreate) defaults() {
 		v := series.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.DrmEnabled(); !ok {
+		v := series.DefaultDrmEnabled
+		_c.mutation.SetDrmEnabled(v)
+	}
+	if _, ok := _c.mutation.AccentColor(); !ok {
+		v := series.DefaultAccentColor
+		_c.mutation.SetAccentColor(v)
+	}
+	if _, ok := _c.mutation.HeroImageURL(); !ok {
+		v := series.DefaultHeroImageURL
+		_c.mutation.SetHeroImageURL(v)
+	}
+	if _, ok := _c.mutation.Layout(); !ok {
+		v := series.DefaultLayout
+		_c.mutation.SetLayout(v)
+	}
 	if _, ok := _c.mutation.EpisodeCount(); !ok {
 		v := series.DefaultEpisodeCount
 		_c.mutation.SetEpisodeCount(v)
 	}
-	if _, ok := _c.mutation.CreatedAt(); !ok {
-		v := series.DefaultCreatedAt()
-		_c.mutation.SetCreatedAt(v)
-	}
-	if _, ok := _c.mutation.UpdatedAt(); !ok {
-		v := series.DefaultUpdatedAt()
-		_c.mutation.SetUpdatedAt(v)
-	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if series.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized series.DefaultID (forgotten import generated/runtime?)")
+		}
 		v := series.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *SeriesCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Series.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Series.updated_at"`)}
+	}
 	if _, ok := _c.mutation.Slug(); !ok {
 		return &ValidationError{Name: "slug", err: errors.New(`generated: missing required field "Series.slug"`)}
 	}
@@ -291,20 +411,29 @@ func (_c *SeriesCreate) check() error {
 	if _, ok := _c.mutation.Level(); !ok {
 		return &ValidationError{Name: "level", err: errors.New(`generated: missing required field "Series.level"`)}
 	}
+	if _, ok := _c.mutation.EstimatedLevel(); !ok {
+		return &ValidationError{Name: "estimated_level", err: errors.New(`generated: missing required field "Series.estimated_level"`)}
+	}
 	if _, ok := _c.mutation.CoverURL(); !ok {
 		return &ValidationError{Name: "cover_url", err: errors.New(`generated: missing required field "Series.cover_url"`)}
 	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`generated: missing required field "Series.status"`)}
 	}
-	if _, ok := _c.mutation.EpisodeCount(); !ok {
-		return &ValidationError{Name: "episode_count", err: errors.New(`generated: missing required field "Series.episode_count"`)}
+	if _, ok := _c.mutation.DrmEnabled(); !ok {
+		return &ValidationError{Name: "drm_enabled", err: errors.New(`generated: missing required field "Series.drm_enabled"`)}
 	}
-	if _, ok := _c.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Series.created_at"`)}
+	if _, ok := _c.mutation.AccentColor(); !ok {
+		return &ValidationError{Name: "accent_color", err: errors.New(`generated: missing required field "Series.accent_color"`)}
 	}
-	if _, ok := _c.mutation.UpdatedAt(); !ok {
-		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Series.updated_at"`)}
+	if _, ok := _c.mutation.HeroImageURL(); !ok {
+		return &ValidationError{Name: "hero_image_url", err: errors.New(`generated: missing required field "Series.hero_image_url"`)}
+	}
+	if _, ok := _c.mutation.Layout(); !ok {
+		return &ValidationError{Name: "layout", err: errors.New(`generated: missing required field "Series.layout"`)}
+	}
+	if _, ok := _c.mutation.EpisodeCount(); !ok {
+		return &ValidationError{Name: "episode_count", err: errors.New(`generated: missing required field "Series.episode_count"`)}
 	}
 	return nil
 }
@@ -341,6 +470,18 @@ func (_c *SeriesCreate) createSpec() (*Series, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(series.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(series.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(series.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.Slug(); ok {
 		_spec.SetField(series.FieldSlug, field.TypeString, value)
 		_node.Slug = value
@@ -361,6 +502,10 @@ func (_c *SeriesCreate) createSpec() (*Series, *sqlgraph.CreateSpec) {
 		_spec.SetField(series.FieldLevel, field.TypeString, value)
 		_node.Level = value
 	}
+	if value, ok := _c.mutation.EstimatedLevel(); ok {
+		_spec.SetField(series.FieldEstimatedLevel, field.TypeString, value)
+		_node.EstimatedLevel = value
+	}
 	if value, ok := _c.mutation.Tags(); ok {
 		_spec.SetField(series.FieldTags, field.TypeJSON, value)
 		_node.Tags = value
@@ -369,22 +514,38 @@ func (_c *SeriesCreate) createSpec() (*Series, *sqlgraph.CreateSpec) {
 		_spec.SetField(series.FieldCoverURL, field.TypeString, value)
 		_node.CoverURL = value
 	}
+	if value, ok := _c.mutation.CoverAssetID(); ok {
+		_spec.SetField(series.FieldCoverAssetID, field.TypeUUID, value)
+		_node.CoverAssetID = &value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(series.FieldStatus, field.TypeInt, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.DrmEnabled(); ok {
+		_spec.SetField(series.FieldDrmEnabled, field.TypeBool, value)
+		_node.DrmEnabled = value
+	}
+	if value, ok := _c.mutation.AccentColor(); ok {
+		_spec.SetField(series.FieldAccentColor, field.TypeString, value)
+		_node.AccentColor = value
+	}
+	if value, ok := _c.mutation.HeroAssetID(); ok {
+		_spec.SetField(series.FieldHeroAssetID, field.TypeUUID, value)
+		_node.HeroAssetID = &value
+	}
+	if value, ok := _c.mutation.HeroImageURL(); ok {
+		_spec.SetField(series.FieldHeroImageURL, field.TypeString, value)
+		_node.HeroImageURL = value
+	}
+	if value, ok := _c.mutation.Layout(); ok {
+		_spec.SetField(series.FieldLayout, field.TypeInt, value)
+		_node.Layout = value
+	}
 	if value, ok := _c.mutation.EpisodeCount(); ok {
 		_spec.SetField(series.FieldEpisodeCount, field.TypeInt, value)
 		_node.EpisodeCount = value
 	}
-	if value, ok := _c.mutation.CreatedAt(); ok {
-		_spec.SetField(series.FieldCreatedAt, field.TypeTime, value)
-		_node.CreatedAt = value
-	}
-	if value, ok := _c.mutation.UpdatedAt(); ok {
-		_spec.SetField(series.FieldUpdatedAt, field.TypeTime, value)
-		_node.UpdatedAt = value
-	}
 	if value, ok := _c.mutation.PublishedAt(); ok {
 		_spec.SetField(series.FieldPublishedAt, field.TypeTime, value)
 		_node.PublishedAt = &value
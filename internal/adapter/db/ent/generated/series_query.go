@@ -300,12 +300,12 @@ func (_q *SeriesQuery) WithEpisodes(opts ...func(*EpisodeQuery)) *SeriesQuery {
 // Example:
 //
 //	var v []struct {
-//		Slug string `json:"slug,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Series.Query().
-//		GroupBy(series.FieldSlug).
+//		GroupBy(series.FieldCreatedAt).
 //		Aggregate(generated.Count()).
 //		Scan(ctx, &v)
 func (_q *SeriesQuery) GroupBy(field string, fields ...string) *SeriesGroupBy {
@@ -323,11 +323,11 @@ func (_q *SeriesQuery) GroupBy(field string, fields ...string) *SeriesGroupBy {
 // Example:
 //
 //	var v []struct {
-//		Slug string `json:"slug,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.Series.Query().
-//		Select(series.FieldSlug).
+//		Select(series.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (_q *SeriesQuery) Select(fields ...string) *SeriesSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
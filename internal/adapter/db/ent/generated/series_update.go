@@ -31,6 +31,40 @@ func (_u *SeriesUpdate) Where(ps ...predicate.Series) *SeriesUpdate {
 	return _u
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SeriesUpdate) SetUpdatedAt(v time.Time) *SeriesUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *SeriesUpdate) SetNillableUpdatedAt(v *time.Time) *SeriesUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *SeriesUpdate) SetDeletedAt(v time.Time) *SeriesUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *SeriesUpdate) SetNillableDeletedAt(v *time.Time) *SeriesUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *SeriesUpdate) ClearDeletedAt() *SeriesUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetSlug sets the "slug" field.
 func (_u *SeriesUpdate) SetSlug(v string) *SeriesUpdate {
 	_u.mutation.SetSlug(v)
@@ -101,6 +135,20 @@ func (_u *SeriesUpdate) SetNillableLevel(v *string) *SeriesUpdate {
 	return _u
 }
 
+// SetEstimatedLevel sets the "estimated_level" field.
+func (_u *SeriesUpdate) SetEstimatedLevel(v string) *SeriesUpdate {
+	_u.mutation.SetEstimatedLevel(v)
+	return _u
+}
+
+// SetNillableEstimatedLevel sets the "estimated_level" field if the given value is not nil.
+func (_u *SeriesUpdate) SetNillableEstimatedLevel(v *string) *SeriesUpdate {
+	if v != nil {
+		_u.SetEstimatedLevel(*v)
+	}
+	return _u
+}
+
 // SetTags sets the "tags" field.
 func (_u *SeriesUpdate) SetTags(v []string) *SeriesUpdate {
 	_u.mutation.SetTags(v)
@@ -133,6 +181,26 @@ func (_u *SeriesUpdate) SetNillableCoverURL(v *string) *SeriesUpdate {
 	return _u
 }
 
+// SetCoverAssetID sets the "cover_asset_id" field.
+func (_u *SeriesUpdate) SetCoverAssetID(v uuid.UUID) *SeriesUpdate {
+	_u.mutation.SetCoverAssetID(v)
+	return _u
+}
+
+// SetNillableCoverAssetID sets the "cover_asset_id" field if the given value is not nil.
+func (_u *SeriesUpdate) SetNillableCoverAssetID(v *uuid.UUID) *SeriesUpdate {
+	if v != nil {
+		_u.SetCoverAssetID(*v)
+	}
+	return _u
+}
+
+// ClearCoverAssetID clears the value of the "cover_asset_id" field.
+func (_u *SeriesUpdate) ClearCoverAssetID() *SeriesUpdate {
+	_u.mutation.ClearCoverAssetID()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *SeriesUpdate) SetStatus(v int) *SeriesUpdate {
 	_u.mutation.ResetStatus()
@@ -154,6 +222,89 @@ func (_u *SeriesUpdate) AddStatus(v int) *SeriesUpdate {
 	return _u
 }
 
+// SetDrmEnabled sets the "drm_enabled" field.
+func (_u *SeriesUpdate) SetDrmEnabled(v bool) *SeriesUpdate {
+	_u.mutation.SetDrmEnabled(v)
+	return _u
+}
+
+// SetNillableDrmEnabled sets the "drm_enabled" field if the given value is not nil.
+func (_u *SeriesUpdate) SetNillableDrmEnabled(v *bool) *SeriesUpdate {
+	if v != nil {
+		_u.SetDrmEnabled(*v)
+	}
+	return _u
+}
+
+// SetAccentColor sets the "accent_color" field.
+func (_u *SeriesUpdate) SetAccentColor(v string) *SeriesUpdate {
+	_u.mutation.SetAccentColor(v)
+	return _u
+}
+
+// SetNillableAccentColor sets the "accent_color" field if the given value is not nil.
+func (_u *SeriesUpdate) SetNillableAccentColor(v *string) *SeriesUpdate {
+	if v != nil {
+		_u.SetAccentColor(*v)
+	}
+	return _u
+}
+
+// SetHeroAssetID sets the "hero_asset_id" field.
+func (_u *SeriesUpdate) SetHeroAssetID(v uuid.UUID) *SeriesUpdate {
+	_u.mutation.SetHeroAssetID(v)
+	return _u
+}
+
+// SetNillableHeroAssetID sets the "hero_asset_id" field if the given value is not nil.
+func (_u *SeriesUpdate) SetNillableHeroAssetID(v *uuid.UUID) *SeriesUpdate {
+	if v != nil {
+		_u.SetHeroAssetID(*v)
+	}
+	return _u
+}
+
+// ClearHeroAssetID clears the value of the "hero_asset_id" field.
+func (_u *SeriesUpdate) ClearHeroAssetID() *SeriesUpdate {
+	_u.mutation.ClearHeroAssetID()
+	return _u
+}
+
+// SetHeroImageURL sets the "hero_image_url" field.
+func (_u *SeriesUpdate) SetHeroImageURL(v string) *SeriesUpdate {
+	_u.mutation.SetHeroImageURL(v)
+	return _u
+}
+
+// SetNillableHeroImageURL sets the "hero_image_url" field if the given value is not nil.
+func (_u *SeriesUpdate) SetNillableHeroImageURL(v *string) *SeriesUpdate {
+	if v != nil {
+		_u.SetHeroImageURL(*v)
+	}
+	return _u
+}
+
+// SetLayout sets the "layout" field.
+func (_u *SeriesUpdate) SetLayout(v int) *SeriesUpdate {
+	_u.mutation.ResetLayout()
+	_u.mutation.SetLayout(v)
+	return _u
+}
+
+// SetNillableLayout sets the "layout" field if the given value is not nil.
+func (_u *SeriesUpdate) SetNillableLayout(v *int) *SeriesUpdate {
+	if v != nil {
+		_u.SetLayout(*v)
+	}
+	return _u
+}
+
+// AddLayout adds value to the "layout" field.
+func (_u *SeriesUpdate) AddLayout(v int) *SeriesUpdate {
+	_u.mutation.AddLayout(v)
+	return _u
+}
+
 // SetEpisodeCount sets the "episode_count" field.
 func (_u *SeriesUpdate) SetEpisodeCount(v int) *SeriesUpdate {
 	_u.mutation.ResetEpisodeCount()
@@ -175,12 +326,6 @@ func (_u *SeriesUpdate) AddEpisodeCount(v int) *SeriesUpdate {
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *SeriesUpdate) SetUpdatedAt(v time.Time) *SeriesUpdate {
-	_u.mutation.SetUpdatedAt(v)
-	return _u
-}
-
 // SetPublishedAt sets the "published_at" field.
 func (_u *SeriesUpdate) SetPublishedAt(v time.Time) *SeriesUpdate {
 	_u.mutation.SetPublishedAt(v)
@@ -262,7 +407,6 @@ func (_u *SeriesUpdate) RemoveEpisodes(v ...*Episode) *SeriesUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *SeriesUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -288,14 +432,6 @@ func (_u *SeriesUpdate) ExecX(ctx context.Context) {
 	}
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *SeriesUpdate) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := series.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
-	}
-}
-
 func (_u *SeriesUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(series.Table, series.Columns, sqlgraph.NewFieldSpec(series.FieldID, field.TypeUUID))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -305,6 +441,15 @@ func (_u *SeriesUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(series.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(series.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(series.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Slug(); ok {
 		_spec.SetField(series.FieldSlug, field.TypeString, value)
 	}
@@ -320,6 +465,9 @@ func (_u *SeriesUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Level(); ok {
 		_spec.SetField(series.FieldLevel, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.EstimatedLevel(); ok {
+		_spec.SetField(series.FieldEstimatedLevel, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Tags(); ok {
 		_spec.SetField(series.FieldTags, field.TypeJSON, value)
 	}
@@ -334,21 +482,45 @@ func (_u *SeriesUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.CoverURL(); ok {
 		_spec.SetField(series.FieldCoverURL, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.CoverAssetID(); ok {
+		_spec.SetField(series.FieldCoverAssetID, field.TypeUUID, value)
+	}
+	if _u.mutation.CoverAssetIDCleared() {
+		_spec.ClearField(series.FieldCoverAssetID, field.TypeUUID)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(series.FieldStatus, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.AddedStatus(); ok {
 		_spec.AddField(series.FieldStatus, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.DrmEnabled(); ok {
+		_spec.SetField(series.FieldDrmEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccentColor(); ok {
+		_spec.SetField(series.FieldAccentColor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HeroAssetID(); ok {
+		_spec.SetField(series.FieldHeroAssetID, field.TypeUUID, value)
+	}
+	if _u.mutation.HeroAssetIDCleared() {
+		_spec.ClearField(series.FieldHeroAssetID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.HeroImageURL(); ok {
+		_spec.SetField(series.FieldHeroImageURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Layout(); ok {
+		_spec.SetField(series.FieldLayout, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLayout(); ok {
+		_spec.AddField(series.FieldLayout, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.EpisodeCount(); ok {
 		_spec.SetField(series.FieldEpisodeCount, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.AddedEpisodeCount(); ok {
 		_spec.AddField(series.FieldEpisodeCount, field.TypeInt, value)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(series.FieldUpdatedAt, field.TypeTime, value)
-	}
 	if value, ok := _u.mutation.PublishedAt(); ok {
 		_spec.SetField(series.FieldPublishedAt, field.TypeTime, value)
 	}
@@ -431,6 +603,40 @@ type SeriesUpdateOne struct {
 	mutation *SeriesMutation
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SeriesUpdateOne) SetUpdatedAt(v time.Time) *SeriesUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *SeriesUpdateOne) SetNillableUpdatedAt(v *time.Time) *SeriesUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *SeriesUpdateOne) SetDeletedAt(v time.Time) *SeriesUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *SeriesUpdateOne) SetNillableDeletedAt(v *time.Time) *SeriesUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *SeriesUpdateOne) ClearDeletedAt() *SeriesUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetSlug sets the "slug" field.
 func (_u *SeriesUpdateOne) SetSlug(v string) *SeriesUpdateOne {
 	_u.mutation.SetSlug(v)
@@ -501,6 +707,20 @@ func (_u *SeriesUpdateOne) SetNillableLevel(v *string) *SeriesUpdateOne {
 	return _u
 }
 
+// SetEstimatedLevel sets the "estimated_level" field.
+func (_u *SeriesUpdateOne) SetEstimatedLevel(v string) *SeriesUpdateOne {
+	_u.mutation.SetEstimatedLevel(v)
+	return _u
+}
+
+// SetNillableEstimatedLevel sets the "estimated_level" field if the given value is not nil.
+func (_u *SeriesUpdateOne) SetNillableEstimatedLevel(v *string) *SeriesUpdateOne {
+	if v != nil {
+		_u.SetEstimatedLevel(*v)
+	}
+	return _u
+}
+
 // SetTags sets the "tags" field.
 func (_u *SeriesUpdateOne) SetTags(v []string) *SeriesUpdateOne {
 	_u.mutation.SetTags(v)
@@ -533,6 +753,26 @@ func (_u *SeriesUpdateOne) SetNillableCoverURL(v *string) *SeriesUpdateOne {
 	return _u
 }
 
+// SetCoverAssetID sets the "cover_asset_id" field.
+func (_u *SeriesUpdateOne) SetCoverAssetID(v uuid.UUID) *SeriesUpdateOne {
+	_u.mutation.SetCoverAssetID(v)
+	return _u
+}
+
+// SetNillableCoverAssetID sets the "cover_asset_id" field if the given value is not nil.
+func (_u *SeriesUpdateOne) SetNillableCoverAssetID(v *uuid.UUID) *SeriesUpdateOne {
+	if v != nil {
+		_u.SetCoverAssetID(*v)
+	}
+	return _u
+}
+
+// ClearCoverAssetID clears the value of the "cover_asset_id" field.
+func (_u *SeriesUpdateOne) ClearCoverAssetID() *SeriesUpdateOne {
+	_u.mutation.ClearCoverAssetID()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *SeriesUpdateOne) SetStatus(v int) *SeriesUpdateOne {
 	_u.mutation.ResetStatus()
@@ -554,6 +794,89 @@ func (_u *SeriesUpdateOne) AddStatus(v int) *SeriesUpdateOne {
 	return _u
 }
 
+// SetDrmEnabled sets the "drm_enabled" field.
+func (_u *SeriesUpdateOne) SetDrmEnabled(v bool) *SeriesUpdateOne {
+	_u.mutation.SetDrmEnabled(v)
+	return _u
+}
+
+// SetNillableDrmEnabled sets the "drm_enabled" field if the given value is not nil.
+func (_u *SeriesUpdateOne) SetNillableDrmEnabled(v *bool) *SeriesUpdateOne {
+	if v != nil {
+		_u.SetDrmEnabled(*v)
+	}
+	return _u
+}
+
+// SetAccentColor sets the "accent_color" field.
+func (_u *SeriesUpdateOne) SetAccentColor(v string) *SeriesUpdateOne {
+	_u.mutation.SetAccentColor(v)
+	return _u
+}
+
+// SetNillableAccentColor sets the "accent_color" field if the given value is not nil.
+func (_u *SeriesUpdateOne) SetNillableAccentColor(v *string) *SeriesUpdateOne {
+	if v != nil {
+		_u.SetAccentColor(*v)
+	}
+	return _u
+}
+
+// SetHeroAssetID sets the "hero_asset_id" field.
+func (_u *SeriesUpdateOne) SetHeroAssetID(v uuid.UUID) *SeriesUpdateOne {
+	_u.mutation.SetHeroAssetID(v)
+	return _u
+}
+
+// SetNillableHeroAssetID sets the "hero_asset_id" field if the given value is not nil.
+func (_u *SeriesUpdateOne) SetNillableHeroAssetID(v *uuid.UUID) *SeriesUpdateOne {
+	if v != nil {
+		_u.SetHeroAssetID(*v)
+	}
+	return _u
+}
+
+// ClearHeroAssetID clears the value of the "hero_asset_id" field.
+func (_u *SeriesUpdateOne) ClearHeroAssetID() *SeriesUpdateOne {
+	_u.mutation.ClearHeroAssetID()
+	return _u
+}
+
+// SetHeroImageURL sets the "hero_image_url" field.
+func (_u *SeriesUpdateOne) SetHeroImageURL(v string) *SeriesUpdateOne {
+	_u.mutation.SetHeroImageURL(v)
+	return _u
+}
+
+// SetNillableHeroImageURL sets the "hero_image_url" field if the given value is not nil.
+func (_u *SeriesUpdateOne) SetNillableHeroImageURL(v *string) *SeriesUpdateOne {
+	if v != nil {
+		_u.SetHeroImageURL(*v)
+	}
+	return _u
+}
+
+// SetLayout sets the "layout" field.
+func (_u *SeriesUpdateOne) SetLayout(v int) *SeriesUpdateOne {
+	_u.mutation.ResetLayout()
+	_u.mutation.SetLayout(v)
+	return _u
+}
+
+// SetNillableLayout sets the "layout" field if the given value is not nil.
+func (_u *SeriesUpdateOne) SetNillableLayout(v *int) *SeriesUpdateOne {
+	if v != nil {
+		_u.SetLayout(*v)
+	}
+	return _u
+}
+
+// AddLayout adds value to the "layout" field.
+func (_u *SeriesUpdateOne) AddLayout(v int) *SeriesUpdateOne {
+	_u.mutation.AddLayout(v)
+	return _u
+}
+
 // SetEpisodeCount sets the "episode_count" field.
 func (_u *SeriesUpdateOne) SetEpisodeCount(v int) *SeriesUpdateOne {
 	_u.mutation.ResetEpisodeCount()
@@ -575,12 +898,6 @@ func (_u *SeriesUpdateOne) AddEpisodeCount(v int) *SeriesUpdateOne {
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *SeriesUpdateOne) SetUpdatedAt(v time.Time) *SeriesUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
-	return _u
-}
-
 // SetPublishedAt sets the "published_at" field.
 func (_u *SeriesUpdateOne) SetPublishedAt(v time.Time) *SeriesUpdateOne {
 	_u.mutation.SetPublishedAt(v)
@@ -675,7 +992,6 @@ func (_u *SeriesUpdateOne) Select(field string, fields ...string) *SeriesUpdateO
 
 // Save executes the query and returns the updated Series entity.
 func (_u *SeriesUpdateOne) Save(ctx context.Context) (*Series, error) {
-	_u.defaults()
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -701,14 +1017,6 @@ func (_u *SeriesUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *SeriesUpdateOne) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := series.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
-	}
-}
-
 func (_u *SeriesUpdateOne) sqlSave(ctx context.Context) (_node *Series, err error) {
 	_spec := sqlgraph.NewUpdateSpec(series.Table, series.Columns, sqlgraph.NewFieldSpec(series.FieldID, field.TypeUUID))
 	id, ok := _u.mutation.ID()
@@ -735,6 +1043,15 @@ func (_u *SeriesUpdateOne) sqlSave(ctx context.Context) (_node *Series, err erro
 			}
 		}
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(series.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(series.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(series.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Slug(); ok {
 		_spec.SetField(series.FieldSlug, field.TypeString, value)
 	}
@@ -750,6 +1067,9 @@ func (_u *SeriesUpdateOne) sqlSave(ctx context.Context) (_node *Series, err erro
 	if value, ok := _u.mutation.Level(); ok {
 		_spec.SetField(series.FieldLevel, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.EstimatedLevel(); ok {
+		_spec.SetField(series.FieldEstimatedLevel, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Tags(); ok {
 		_spec.SetField(series.FieldTags, field.TypeJSON, value)
 	}
@@ -764,21 +1084,45 @@ func (_u *SeriesUpdateOne) sqlSave(ctx context.Context) (_node *Series, err erro
 	if value, ok := _u.mutation.CoverURL(); ok {
 		_spec.SetField(series.FieldCoverURL, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.CoverAssetID(); ok {
+		_spec.SetField(series.FieldCoverAssetID, field.TypeUUID, value)
+	}
+	if _u.mutation.CoverAssetIDCleared() {
+		_spec.ClearField(series.FieldCoverAssetID, field.TypeUUID)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(series.FieldStatus, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.AddedStatus(); ok {
 		_spec.AddField(series.FieldStatus, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.DrmEnabled(); ok {
+		_spec.SetField(series.FieldDrmEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AccentColor(); ok {
+		_spec.SetField(series.FieldAccentColor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HeroAssetID(); ok {
+		_spec.SetField(series.FieldHeroAssetID, field.TypeUUID, value)
+	}
+	if _u.mutation.HeroAssetIDCleared() {
+		_spec.ClearField(series.FieldHeroAssetID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.HeroImageURL(); ok {
+		_spec.SetField(series.FieldHeroImageURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Layout(); ok {
+		_spec.SetField(series.FieldLayout, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLayout(); ok {
+		_spec.AddField(series.FieldLayout, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.EpisodeCount(); ok {
 		_spec.SetField(series.FieldEpisodeCount, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.AddedEpisodeCount(); ok {
 		_spec.AddField(series.FieldEpisodeCount, field.TypeInt, value)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(series.FieldUpdatedAt, field.TypeTime, value)
-	}
 	if value, ok := _u.mutation.PublishedAt(); ok {
 		_spec.SetField(series.FieldPublishedAt, field.TypeTime, value)
 	}
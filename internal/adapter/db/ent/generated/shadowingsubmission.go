@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/shadowingsubmission"
+	"github.com/google/uuid"
+)
+
+// ShadowingSubmission is the model entity for the ShadowingSubmission schema.
+type ShadowingSubmission struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// EpisodeID holds the value of the "episode_id" field.
+	EpisodeID uuid.UUID `json:"episode_id,omitempty"`
+	// SegmentIndex holds the value of the "segment_index" field.
+	SegmentIndex int `json:"segment_index,omitempty"`
+	// AssetID holds the value of the "asset_id" field.
+	AssetID uuid.UUID `json:"asset_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status int `json:"status,omitempty"`
+	// AutoScore holds the value of the "auto_score" field.
+	AutoScore *float64 `json:"auto_score,omitempty"`
+	// AutoFeedback holds the value of the "auto_feedback" field.
+	AutoFeedback string `json:"auto_feedback,omitempty"`
+	// ReviewerID holds the value of the "reviewer_id" field.
+	ReviewerID string `json:"reviewer_id,omitempty"`
+	// ReviewScore holds the value of the "review_score" field.
+	ReviewScore *int `json:"review_score,omitempty"`
+	// ReviewComment holds the value of the "review_comment" field.
+	ReviewComment string `json:"review_comment,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt   *time.Time `json:"reviewed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShadowingSubmission) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case shadowingsubmission.FieldAutoScore:
+			values[i] = new(sql.NullFloat64)
+		case shadowingsubmission.FieldSegmentIndex, shadowingsubmission.FieldStatus, shadowingsubmission.FieldReviewScore:
+			values[i] = new(sql.NullInt64)
+		case shadowingsubmission.FieldUserID, shadowingsubmission.FieldAutoFeedback, shadowingsubmission.FieldReviewerID, shadowingsubmission.FieldReviewComment:
+			values[i] = new(sql.NullString)
+		case shadowingsubmission.FieldCreatedAt, shadowingsubmission.FieldUpdatedAt, shadowingsubmission.FieldReviewedAt:
+			values[i] = new(sql.NullTime)
+		case shadowingsubmission.FieldID, shadowingsubmission.FieldEpisodeID, shadowingsubmission.FieldAssetID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShadowingSubmission fields.
+func (_m *ShadowingSubmission) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case shadowingsubmission.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case shadowingsubmission.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case shadowingsubmission.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case shadowingsubmission.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case shadowingsubmission.FieldEpisodeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field episode_id", values[i])
+			} else if value != nil {
+				_m.EpisodeID = *value
+			}
+		case shadowingsubmission.FieldSegmentIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field segment_index", values[i])
+			} else if value.Valid {
+				_m.SegmentIndex = int(value.Int64)
+			}
+		case shadowingsubmission.FieldAssetID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_id", values[i])
+			} else if value != nil {
+				_m.AssetID = *value
+			}
+		case shadowingsubmission.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = int(value.Int64)
+			}
+		case shadowingsubmission.FieldAutoScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_score", values[i])
+			} else if value.Valid {
+				_m.AutoScore = new(float64)
+				*_m.AutoScore = value.Float64
+			}
+		case shadowingsubmission.FieldAutoFeedback:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_feedback", values[i])
+			} else if value.Valid {
+				_m.AutoFeedback = value.String
+			}
+		case shadowingsubmission.FieldReviewerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_id", values[i])
+			} else if value.Valid {
+				_m.ReviewerID = value.String
+			}
+		case shadowingsubmission.FieldReviewScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field review_score", values[i])
+			} else if value.Valid {
+				_m.ReviewScore = new(int)
+				*_m.ReviewScore = int(value.Int64)
+			}
+		case shadowingsubmission.FieldReviewComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_comment", values[i])
+			} else if value.Valid {
+				_m.ReviewComment = value.String
+			}
+		case shadowingsubmission.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				_m.ReviewedAt = new(time.Time)
+				*_m.ReviewedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ShadowingSubmission.
+// This includes values selected through modifiers, order, etc.
+func (_m *ShadowingSubmission) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ShadowingSubmission.
+// Note that you need to call ShadowingSubmission.Unwrap() before calling this method if this ShadowingSubmission
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ShadowingSubmission) Update() *ShadowingSubmissionUpdateOne {
+	return NewShadowingSubmissionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ShadowingSubmission entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ShadowingSubmission) Unwrap() *ShadowingSubmission {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: ShadowingSubmission is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ShadowingSubmission) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShadowingSubmission(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("episode_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EpisodeID))
+	builder.WriteString(", ")
+	builder.WriteString("segment_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SegmentIndex))
+	builder.WriteString(", ")
+	builder.WriteString("asset_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AssetID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.AutoScore; v != nil {
+		builder.WriteString("auto_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("auto_feedback=")
+	builder.WriteString(_m.AutoFeedback)
+	builder.WriteString(", ")
+	builder.WriteString("reviewer_id=")
+	builder.WriteString(_m.ReviewerID)
+	builder.WriteString(", ")
+	if v := _m.ReviewScore; v != nil {
+		builder.WriteString("review_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("review_comment=")
+	builder.WriteString(_m.ReviewComment)
+	builder.WriteString(", ")
+	if v := _m.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShadowingSubmissions is a parsable slice of ShadowingSubmission.
+type ShadowingSubmissions []*ShadowingSubmission
@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package shadowingsubmission
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the shadowingsubmission type in the database.
+	Label = "shadowing_submission"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldEpisodeID holds the string denoting the episode_id field in the database.
+	FieldEpisodeID = "episode_id"
+	// FieldSegmentIndex holds the string denoting the segment_index field in the database.
+	FieldSegmentIndex = "segment_index"
+	// FieldAssetID holds the string denoting the asset_id field in the database.
+	FieldAssetID = "asset_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAutoScore holds the string denoting the auto_score field in the database.
+	FieldAutoScore = "auto_score"
+	// FieldAutoFeedback holds the string denoting the auto_feedback field in the database.
+	FieldAutoFeedback = "auto_feedback"
+	// FieldReviewerID holds the string denoting the reviewer_id field in the database.
+	FieldReviewerID = "reviewer_id"
+	// FieldReviewScore holds the string denoting the review_score field in the database.
+	FieldReviewScore = "review_score"
+	// FieldReviewComment holds the string denoting the review_comment field in the database.
+	FieldReviewComment = "review_comment"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// Table holds the table name of the shadowingsubmission in the database.
+	Table = "shadowing_submissions"
+)
+
+// Columns holds all SQL columns for shadowingsubmission fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldUserID,
+	FieldEpisodeID,
+	FieldSegmentIndex,
+	FieldAssetID,
+	FieldStatus,
+	FieldAutoScore,
+	FieldAutoFeedback,
+	FieldReviewerID,
+	FieldReviewScore,
+	FieldReviewComment,
+	FieldReviewedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eslsoft/lession/internal/adapter/db/ent/generated/runtime"
+var (
+	Hooks [2]ent.Hook
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus int
+	// DefaultAutoFeedback holds the default value on creation for the "auto_feedback" field.
+	DefaultAutoFeedback string
+	// DefaultReviewerID holds the default value on creation for the "reviewer_id" field.
+	DefaultReviewerID string
+	// DefaultReviewComment holds the default value on creation for the "review_comment" field.
+	DefaultReviewComment string
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ShadowingSubmission queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByEpisodeID orders the results by the episode_id field.
+func ByEpisodeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEpisodeID, opts...).ToFunc()
+}
+
+// BySegmentIndex orders the results by the segment_index field.
+func BySegmentIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSegmentIndex, opts...).ToFunc()
+}
+
+// ByAssetID orders the results by the asset_id field.
+func ByAssetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssetID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAutoScore orders the results by the auto_score field.
+func ByAutoScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoScore, opts...).ToFunc()
+}
+
+// ByAutoFeedback orders the results by the auto_feedback field.
+func ByAutoFeedback(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoFeedback, opts...).ToFunc()
+}
+
+// ByReviewerID orders the results by the reviewer_id field.
+func ByReviewerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewerID, opts...).ToFunc()
+}
+
+// ByReviewScore orders the results by the review_score field.
+func ByReviewScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewScore, opts...).ToFunc()
+}
+
+// ByReviewComment orders the results by the review_comment field.
+func ByReviewComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewComment, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
@@ -0,0 +1,786 @@
+// Code generated by ent, DO NOT EDIT.
+
+package shadowingsubmission
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldUserID, v))
+}
+
+// EpisodeID applies equality check predicate on the "episode_id" field. It's identical to EpisodeIDEQ.
+func EpisodeID(v uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldEpisodeID, v))
+}
+
+// SegmentIndex applies equality check predicate on the "segment_index" field. It's identical to SegmentIndexEQ.
+func SegmentIndex(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldSegmentIndex, v))
+}
+
+// AssetID applies equality check predicate on the "asset_id" field. It's identical to AssetIDEQ.
+func AssetID(v uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldAssetID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldStatus, v))
+}
+
+// AutoScore applies equality check predicate on the "auto_score" field. It's identical to AutoScoreEQ.
+func AutoScore(v float64) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldAutoScore, v))
+}
+
+// AutoFeedback applies equality check predicate on the "auto_feedback" field. It's identical to AutoFeedbackEQ.
+func AutoFeedback(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldAutoFeedback, v))
+}
+
+// ReviewerID applies equality check predicate on the "reviewer_id" field. It's identical to ReviewerIDEQ.
+func ReviewerID(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewScore applies equality check predicate on the "review_score" field. It's identical to ReviewScoreEQ.
+func ReviewScore(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldReviewScore, v))
+}
+
+// ReviewComment applies equality check predicate on the "review_comment" field. It's identical to ReviewCommentEQ.
+func ReviewComment(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldReviewComment, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// EpisodeIDEQ applies the EQ predicate on the "episode_id" field.
+func EpisodeIDEQ(v uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldEpisodeID, v))
+}
+
+// EpisodeIDNEQ applies the NEQ predicate on the "episode_id" field.
+func EpisodeIDNEQ(v uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNEQ(FieldEpisodeID, v))
+}
+
+// EpisodeIDIn applies the In predicate on the "episode_id" field.
+func EpisodeIDIn(vs ...uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldIn(FieldEpisodeID, vs...))
+}
+
+// EpisodeIDNotIn applies the NotIn predicate on the "episode_id" field.
+func EpisodeIDNotIn(vs ...uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNotIn(FieldEpisodeID, vs...))
+}
+
+// EpisodeIDGT applies the GT predicate on the "episode_id" field.
+func EpisodeIDGT(v uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGT(FieldEpisodeID, v))
+}
+
+// EpisodeIDGTE applies the GTE predicate on the "episode_id" field.
+func EpisodeIDGTE(v uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGTE(FieldEpisodeID, v))
+}
+
+// EpisodeIDLT applies the LT predicate on the "episode_id" field.
+func EpisodeIDLT(v uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLT(FieldEpisodeID, v))
+}
+
+// EpisodeIDLTE applies the LTE predicate on the "episode_id" field.
+func EpisodeIDLTE(v uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLTE(FieldEpisodeID, v))
+}
+
+// SegmentIndexEQ applies the EQ predicate on the "segment_index" field.
+func SegmentIndexEQ(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldSegmentIndex, v))
+}
+
+// SegmentIndexNEQ applies the NEQ predicate on the "segment_index" field.
+func SegmentIndexNEQ(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNEQ(FieldSegmentIndex, v))
+}
+
+// SegmentIndexIn applies the In predicate on the "segment_index" field.
+func SegmentIndexIn(vs ...int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldIn(FieldSegmentIndex, vs...))
+}
+
+// SegmentIndexNotIn applies the NotIn predicate on the "segment_index" field.
+func SegmentIndexNotIn(vs ...int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNotIn(FieldSegmentIndex, vs...))
+}
+
+// SegmentIndexGT applies the GT predicate on the "segment_index" field.
+func SegmentIndexGT(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGT(FieldSegmentIndex, v))
+}
+
+// SegmentIndexGTE applies the GTE predicate on the "segment_index" field.
+func SegmentIndexGTE(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGTE(FieldSegmentIndex, v))
+}
+
+// SegmentIndexLT applies the LT predicate on the "segment_index" field.
+func SegmentIndexLT(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLT(FieldSegmentIndex, v))
+}
+
+// SegmentIndexLTE applies the LTE predicate on the "segment_index" field.
+func SegmentIndexLTE(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLTE(FieldSegmentIndex, v))
+}
+
+// AssetIDEQ applies the EQ predicate on the "asset_id" field.
+func AssetIDEQ(v uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldAssetID, v))
+}
+
+// AssetIDNEQ applies the NEQ predicate on the "asset_id" field.
+func AssetIDNEQ(v uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNEQ(FieldAssetID, v))
+}
+
+// AssetIDIn applies the In predicate on the "asset_id" field.
+func AssetIDIn(vs ...uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldIn(FieldAssetID, vs...))
+}
+
+// AssetIDNotIn applies the NotIn predicate on the "asset_id" field.
+func AssetIDNotIn(vs ...uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNotIn(FieldAssetID, vs...))
+}
+
+// AssetIDGT applies the GT predicate on the "asset_id" field.
+func AssetIDGT(v uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGT(FieldAssetID, v))
+}
+
+// AssetIDGTE applies the GTE predicate on the "asset_id" field.
+func AssetIDGTE(v uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGTE(FieldAssetID, v))
+}
+
+// AssetIDLT applies the LT predicate on the "asset_id" field.
+func AssetIDLT(v uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLT(FieldAssetID, v))
+}
+
+// AssetIDLTE applies the LTE predicate on the "asset_id" field.
+func AssetIDLTE(v uuid.UUID) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLTE(FieldAssetID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLTE(FieldStatus, v))
+}
+
+// AutoScoreEQ applies the EQ predicate on the "auto_score" field.
+func AutoScoreEQ(v float64) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldAutoScore, v))
+}
+
+// AutoScoreNEQ applies the NEQ predicate on the "auto_score" field.
+func AutoScoreNEQ(v float64) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNEQ(FieldAutoScore, v))
+}
+
+// AutoScoreIn applies the In predicate on the "auto_score" field.
+func AutoScoreIn(vs ...float64) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldIn(FieldAutoScore, vs...))
+}
+
+// AutoScoreNotIn applies the NotIn predicate on the "auto_score" field.
+func AutoScoreNotIn(vs ...float64) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNotIn(FieldAutoScore, vs...))
+}
+
+// AutoScoreGT applies the GT predicate on the "auto_score" field.
+func AutoScoreGT(v float64) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGT(FieldAutoScore, v))
+}
+
+// AutoScoreGTE applies the GTE predicate on the "auto_score" field.
+func AutoScoreGTE(v float64) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGTE(FieldAutoScore, v))
+}
+
+// AutoScoreLT applies the LT predicate on the "auto_score" field.
+func AutoScoreLT(v float64) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLT(FieldAutoScore, v))
+}
+
+// AutoScoreLTE applies the LTE predicate on the "auto_score" field.
+func AutoScoreLTE(v float64) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLTE(FieldAutoScore, v))
+}
+
+// AutoScoreIsNil applies the IsNil predicate on the "auto_score" field.
+func AutoScoreIsNil() predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldIsNull(FieldAutoScore))
+}
+
+// AutoScoreNotNil applies the NotNil predicate on the "auto_score" field.
+func AutoScoreNotNil() predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNotNull(FieldAutoScore))
+}
+
+// AutoFeedbackEQ applies the EQ predicate on the "auto_feedback" field.
+func AutoFeedbackEQ(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldAutoFeedback, v))
+}
+
+// AutoFeedbackNEQ applies the NEQ predicate on the "auto_feedback" field.
+func AutoFeedbackNEQ(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNEQ(FieldAutoFeedback, v))
+}
+
+// AutoFeedbackIn applies the In predicate on the "auto_feedback" field.
+func AutoFeedbackIn(vs ...string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldIn(FieldAutoFeedback, vs...))
+}
+
+// AutoFeedbackNotIn applies the NotIn predicate on the "auto_feedback" field.
+func AutoFeedbackNotIn(vs ...string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNotIn(FieldAutoFeedback, vs...))
+}
+
+// AutoFeedbackGT applies the GT predicate on the "auto_feedback" field.
+func AutoFeedbackGT(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGT(FieldAutoFeedback, v))
+}
+
+// AutoFeedbackGTE applies the GTE predicate on the "auto_feedback" field.
+func AutoFeedbackGTE(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGTE(FieldAutoFeedback, v))
+}
+
+// AutoFeedbackLT applies the LT predicate on the "auto_feedback" field.
+func AutoFeedbackLT(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLT(FieldAutoFeedback, v))
+}
+
+// AutoFeedbackLTE applies the LTE predicate on the "auto_feedback" field.
+func AutoFeedbackLTE(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLTE(FieldAutoFeedback, v))
+}
+
+// AutoFeedbackContains applies the Contains predicate on the "auto_feedback" field.
+func AutoFeedbackContains(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldContains(FieldAutoFeedback, v))
+}
+
+// AutoFeedbackHasPrefix applies the HasPrefix predicate on the "auto_feedback" field.
+func AutoFeedbackHasPrefix(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldHasPrefix(FieldAutoFeedback, v))
+}
+
+// AutoFeedbackHasSuffix applies the HasSuffix predicate on the "auto_feedback" field.
+func AutoFeedbackHasSuffix(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldHasSuffix(FieldAutoFeedback, v))
+}
+
+// AutoFeedbackEqualFold applies the EqualFold predicate on the "auto_feedback" field.
+func AutoFeedbackEqualFold(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEqualFold(FieldAutoFeedback, v))
+}
+
+// AutoFeedbackContainsFold applies the ContainsFold predicate on the "auto_feedback" field.
+func AutoFeedbackContainsFold(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldContainsFold(FieldAutoFeedback, v))
+}
+
+// ReviewerIDEQ applies the EQ predicate on the "reviewer_id" field.
+func ReviewerIDEQ(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDNEQ applies the NEQ predicate on the "reviewer_id" field.
+func ReviewerIDNEQ(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDIn applies the In predicate on the "reviewer_id" field.
+func ReviewerIDIn(vs ...string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDNotIn applies the NotIn predicate on the "reviewer_id" field.
+func ReviewerIDNotIn(vs ...string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNotIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDGT applies the GT predicate on the "reviewer_id" field.
+func ReviewerIDGT(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGT(FieldReviewerID, v))
+}
+
+// ReviewerIDGTE applies the GTE predicate on the "reviewer_id" field.
+func ReviewerIDGTE(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGTE(FieldReviewerID, v))
+}
+
+// ReviewerIDLT applies the LT predicate on the "reviewer_id" field.
+func ReviewerIDLT(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLT(FieldReviewerID, v))
+}
+
+// ReviewerIDLTE applies the LTE predicate on the "reviewer_id" field.
+func ReviewerIDLTE(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLTE(FieldReviewerID, v))
+}
+
+// ReviewerIDContains applies the Contains predicate on the "reviewer_id" field.
+func ReviewerIDContains(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldContains(FieldReviewerID, v))
+}
+
+// ReviewerIDHasPrefix applies the HasPrefix predicate on the "reviewer_id" field.
+func ReviewerIDHasPrefix(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldHasPrefix(FieldReviewerID, v))
+}
+
+// ReviewerIDHasSuffix applies the HasSuffix predicate on the "reviewer_id" field.
+func ReviewerIDHasSuffix(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldHasSuffix(FieldReviewerID, v))
+}
+
+// ReviewerIDEqualFold applies the EqualFold predicate on the "reviewer_id" field.
+func ReviewerIDEqualFold(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEqualFold(FieldReviewerID, v))
+}
+
+// ReviewerIDContainsFold applies the ContainsFold predicate on the "reviewer_id" field.
+func ReviewerIDContainsFold(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldContainsFold(FieldReviewerID, v))
+}
+
+// ReviewScoreEQ applies the EQ predicate on the "review_score" field.
+func ReviewScoreEQ(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldReviewScore, v))
+}
+
+// ReviewScoreNEQ applies the NEQ predicate on the "review_score" field.
+func ReviewScoreNEQ(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNEQ(FieldReviewScore, v))
+}
+
+// ReviewScoreIn applies the In predicate on the "review_score" field.
+func ReviewScoreIn(vs ...int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldIn(FieldReviewScore, vs...))
+}
+
+// ReviewScoreNotIn applies the NotIn predicate on the "review_score" field.
+func ReviewScoreNotIn(vs ...int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNotIn(FieldReviewScore, vs...))
+}
+
+// ReviewScoreGT applies the GT predicate on the "review_score" field.
+func ReviewScoreGT(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGT(FieldReviewScore, v))
+}
+
+// ReviewScoreGTE applies the GTE predicate on the "review_score" field.
+func ReviewScoreGTE(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGTE(FieldReviewScore, v))
+}
+
+// ReviewScoreLT applies the LT predicate on the "review_score" field.
+func ReviewScoreLT(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLT(FieldReviewScore, v))
+}
+
+// ReviewScoreLTE applies the LTE predicate on the "review_score" field.
+func ReviewScoreLTE(v int) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLTE(FieldReviewScore, v))
+}
+
+// ReviewScoreIsNil applies the IsNil predicate on the "review_score" field.
+func ReviewScoreIsNil() predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldIsNull(FieldReviewScore))
+}
+
+// ReviewScoreNotNil applies the NotNil predicate on the "review_score" field.
+func ReviewScoreNotNil() predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNotNull(FieldReviewScore))
+}
+
+// ReviewCommentEQ applies the EQ predicate on the "review_comment" field.
+func ReviewCommentEQ(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldReviewComment, v))
+}
+
+// ReviewCommentNEQ applies the NEQ predicate on the "review_comment" field.
+func ReviewCommentNEQ(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNEQ(FieldReviewComment, v))
+}
+
+// ReviewCommentIn applies the In predicate on the "review_comment" field.
+func ReviewCommentIn(vs ...string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldIn(FieldReviewComment, vs...))
+}
+
+// ReviewCommentNotIn applies the NotIn predicate on the "review_comment" field.
+func ReviewCommentNotIn(vs ...string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNotIn(FieldReviewComment, vs...))
+}
+
+// ReviewCommentGT applies the GT predicate on the "review_comment" field.
+func ReviewCommentGT(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGT(FieldReviewComment, v))
+}
+
+// ReviewCommentGTE applies the GTE predicate on the "review_comment" field.
+func ReviewCommentGTE(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGTE(FieldReviewComment, v))
+}
+
+// ReviewCommentLT applies the LT predicate on the "review_comment" field.
+func ReviewCommentLT(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLT(FieldReviewComment, v))
+}
+
+// ReviewCommentLTE applies the LTE predicate on the "review_comment" field.
+func ReviewCommentLTE(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLTE(FieldReviewComment, v))
+}
+
+// ReviewCommentContains applies the Contains predicate on the "review_comment" field.
+func ReviewCommentContains(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldContains(FieldReviewComment, v))
+}
+
+// ReviewCommentHasPrefix applies the HasPrefix predicate on the "review_comment" field.
+func ReviewCommentHasPrefix(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldHasPrefix(FieldReviewComment, v))
+}
+
+// ReviewCommentHasSuffix applies the HasSuffix predicate on the "review_comment" field.
+func ReviewCommentHasSuffix(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldHasSuffix(FieldReviewComment, v))
+}
+
+// ReviewCommentEqualFold applies the EqualFold predicate on the "review_comment" field.
+func ReviewCommentEqualFold(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEqualFold(FieldReviewComment, v))
+}
+
+// ReviewCommentContainsFold applies the ContainsFold predicate on the "review_comment" field.
+func ReviewCommentContainsFold(v string) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldContainsFold(FieldReviewComment, v))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ShadowingSubmission) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ShadowingSubmission) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ShadowingSubmission) predicate.ShadowingSubmission {
+	return predicate.ShadowingSubmission(sql.NotPredicates(p))
+}
@@ -0,0 +1,439 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/shadowingsubmission"
+	"github.com/google/uuid"
+)
+
+// ShadowingSubmissionCreate is the builder for creating a ShadowingSubmission entity.
+type ShadowingSubmissionCreate struct {
+	config
+	mutation *ShadowingSubmissionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ShadowingSubmissionCreate) SetCreatedAt(v time.Time) *ShadowingSubmissionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ShadowingSubmissionCreate) SetUpdatedAt(v time.Time) *ShadowingSubmissionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ShadowingSubmissionCreate) SetUserID(v string) *ShadowingSubmissionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_c *ShadowingSubmissionCreate) SetEpisodeID(v uuid.UUID) *ShadowingSubmissionCreate {
+	_c.mutation.SetEpisodeID(v)
+	return _c
+}
+
+// SetSegmentIndex sets the "segment_index" field.
+func (_c *ShadowingSubmissionCreate) SetSegmentIndex(v int) *ShadowingSubmissionCreate {
+	_c.mutation.SetSegmentIndex(v)
+	return _c
+}
+
+// SetAssetID sets the "asset_id" field.
+func (_c *ShadowingSubmissionCreate) SetAssetID(v uuid.UUID) *ShadowingSubmissionCreate {
+	_c.mutation.SetAssetID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ShadowingSubmissionCreate) SetStatus(v int) *ShadowingSubmissionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ShadowingSubmissionCreate) SetNillableStatus(v *int) *ShadowingSubmissionCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAutoScore sets the "auto_score" field.
+func (_c *ShadowingSubmissionCreate) SetAutoScore(v float64) *ShadowingSubmissionCreate {
+	_c.mutation.SetAutoScore(v)
+	return _c
+}
+
+// SetNillableAutoScore sets the "auto_score" field if the given value is not nil.
+func (_c *ShadowingSubmissionCreate) SetNillableAutoScore(v *float64) *ShadowingSubmissionCreate {
+	if v != nil {
+		_c.SetAutoScore(*v)
+	}
+	return _c
+}
+
+// SetAutoFeedback sets the "auto_feedback" field.
+func (_c *ShadowingSubmissionCreate) SetAutoFeedback(v string) *ShadowingSubmissionCreate {
+	_c.mutation.SetAutoFeedback(v)
+	return _c
+}
+
+// SetNillableAutoFeedback sets the "auto_feedback" field if the given value is not nil.
+func (_c *ShadowingSubmissionCreate) SetNillableAutoFeedback(v *string) *ShadowingSubmissionCreate {
+	if v != nil {
+		_c.SetAutoFeedback(*v)
+	}
+	return _c
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (_c *ShadowingSubmissionCreate) SetReviewerID(v string) *ShadowingSubmissionCreate {
+	_c.mutation.SetReviewerID(v)
+	return _c
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (_c *ShadowingSubmissionCreate) SetNillableReviewerID(v *string) *ShadowingSubmissionCreate {
+	if v != nil {
+		_c.SetReviewerID(*v)
+	}
+	return _c
+}
+
+// SetReviewScore sets the "review_score" field.
+func (_c *ShadowingSubmissionCreate) SetReviewScore(v int) *ShadowingSubmissionCreate {
+	_c.mutation.SetReviewScore(v)
+	return _c
+}
+
+// SetNillableReviewScore sets the "review_score" field if the given value is not nil.
+func (_c *ShadowingSubmissionCreate) SetNillableReviewScore(v *int) *ShadowingSubmissionCreate {
+	if v != nil {
+		_c.SetReviewScore(*v)
+	}
+	return _c
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (_c *ShadowingSubmissionCreate) SetReviewComment(v string) *ShadowingSubmissionCreate {
+	_c.mutation.SetReviewComment(v)
+	return _c
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (_c *ShadowingSubmissionCreate) SetNillableReviewComment(v *string) *ShadowingSubmissionCreate {
+	if v != nil {
+		_c.SetReviewComment(*v)
+	}
+	return _c
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *ShadowingSubmissionCreate) SetReviewedAt(v time.Time) *ShadowingSubmissionCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_c *ShadowingSubmissionCreate) SetNillableReviewedAt(v *time.Time) *ShadowingSubmissionCreate {
+	if v != nil {
+		_c.SetReviewedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ShadowingSubmissionCreate) SetID(v uuid.UUID) *ShadowingSubmissionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ShadowingSubmissionCreate) SetNillableID(v *uuid.UUID) *ShadowingSubmissionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ShadowingSubmissionMutation object of the builder.
+func (_c *ShadowingSubmissionCreate) Mutation() *ShadowingSubmissionMutation {
+	return _c.mutation
+}
+
+// Save creates the ShadowingSubmission in the database.
+func (_c *ShadowingSubmissionCreate) Save(ctx context.Context) (*ShadowingSubmission, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ShadowingSubmissionCreate) SaveX(ctx context.Context) *ShadowingSubmission {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ShadowingSubmissionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ShadowingSubmissionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ShadowingSubmissionCreate) defaults() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := shadowingsubmission.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.AutoFeedback(); !ok {
+		v := shadowingsubmission.DefaultAutoFeedback
+		_c.mutation.SetAutoFeedback(v)
+	}
+	if _, ok := _c.mutation.ReviewerID(); !ok {
+		v := shadowingsubmission.DefaultReviewerID
+		_c.mutation.SetReviewerID(v)
+	}
+	if _, ok := _c.mutation.ReviewComment(); !ok {
+		v := shadowingsubmission.DefaultReviewComment
+		_c.mutation.SetReviewComment(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if shadowingsubmission.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized shadowingsubmission.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := shadowingsubmission.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ShadowingSubmissionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "ShadowingSubmission.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "ShadowingSubmission.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "ShadowingSubmission.user_id"`)}
+	}
+	if _, ok := _c.mutation.EpisodeID(); !ok {
+		return &ValidationError{Name: "episode_id", err: errors.New(`generated: missing required field "ShadowingSubmission.episode_id"`)}
+	}
+	if _, ok := _c.mutation.SegmentIndex(); !ok {
+		return &ValidationError{Name: "segment_index", err: errors.New(`generated: missing required field "ShadowingSubmission.segment_index"`)}
+	}
+	if _, ok := _c.mutation.AssetID(); !ok {
+		return &ValidationError{Name: "asset_id", err: errors.New(`generated: missing required field "ShadowingSubmission.asset_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`generated: missing required field "ShadowingSubmission.status"`)}
+	}
+	if _, ok := _c.mutation.AutoFeedback(); !ok {
+		return &ValidationError{Name: "auto_feedback", err: errors.New(`generated: missing required field "ShadowingSubmission.auto_feedback"`)}
+	}
+	if _, ok := _c.mutation.ReviewerID(); !ok {
+		return &ValidationError{Name: "reviewer_id", err: errors.New(`generated: missing required field "ShadowingSubmission.reviewer_id"`)}
+	}
+	if _, ok := _c.mutation.ReviewComment(); !ok {
+		return &ValidationError{Name: "review_comment", err: errors.New(`generated: missing required field "ShadowingSubmission.review_comment"`)}
+	}
+	return nil
+}
+
+func (_c *ShadowingSubmissionCreate) sqlSave(ctx context.Context) (*ShadowingSubmission, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ShadowingSubmissionCreate) createSpec() (*ShadowingSubmission, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShadowingSubmission{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(shadowingsubmission.Table, sqlgraph.NewFieldSpec(shadowingsubmission.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(shadowingsubmission.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(shadowingsubmission.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(shadowingsubmission.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.EpisodeID(); ok {
+		_spec.SetField(shadowingsubmission.FieldEpisodeID, field.TypeUUID, value)
+		_node.EpisodeID = value
+	}
+	if value, ok := _c.mutation.SegmentIndex(); ok {
+		_spec.SetField(shadowingsubmission.FieldSegmentIndex, field.TypeInt, value)
+		_node.SegmentIndex = value
+	}
+	if value, ok := _c.mutation.AssetID(); ok {
+		_spec.SetField(shadowingsubmission.FieldAssetID, field.TypeUUID, value)
+		_node.AssetID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(shadowingsubmission.FieldStatus, field.TypeInt, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.AutoScore(); ok {
+		_spec.SetField(shadowingsubmission.FieldAutoScore, field.TypeFloat64, value)
+		_node.AutoScore = &value
+	}
+	if value, ok := _c.mutation.AutoFeedback(); ok {
+		_spec.SetField(shadowingsubmission.FieldAutoFeedback, field.TypeString, value)
+		_node.AutoFeedback = value
+	}
+	if value, ok := _c.mutation.ReviewerID(); ok {
+		_spec.SetField(shadowingsubmission.FieldReviewerID, field.TypeString, value)
+		_node.ReviewerID = value
+	}
+	if value, ok := _c.mutation.ReviewScore(); ok {
+		_spec.SetField(shadowingsubmission.FieldReviewScore, field.TypeInt, value)
+		_node.ReviewScore = &value
+	}
+	if value, ok := _c.mutation.ReviewComment(); ok {
+		_spec.SetField(shadowingsubmission.FieldReviewComment, field.TypeString, value)
+		_node.ReviewComment = value
+	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(shadowingsubmission.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	return _node, _spec
+}
+
+// ShadowingSubmissionCreateBulk is the builder for creating many ShadowingSubmission entities in bulk.
+type ShadowingSubmissionCreateBulk struct {
+	config
+	err      error
+	builders []*ShadowingSubmissionCreate
+}
+
+// Save creates the ShadowingSubmission entities in the database.
+func (_c *ShadowingSubmissionCreateBulk) Save(ctx context.Context) ([]*ShadowingSubmission, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ShadowingSubmission, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShadowingSubmissionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ShadowingSubmissionCreateBulk) SaveX(ctx context.Context) []*ShadowingSubmission {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ShadowingSubmissionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ShadowingSubmissionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
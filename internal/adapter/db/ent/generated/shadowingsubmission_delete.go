@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/shadowingsubmission"
+)
+
+// ShadowingSubmissionDelete is the builder for deleting a ShadowingSubmission entity.
+type ShadowingSubmissionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ShadowingSubmissionMutation
+}
+
+// Where appends a list predicates to the ShadowingSubmissionDelete builder.
+func (_d *ShadowingSubmissionDelete) Where(ps ...predicate.ShadowingSubmission) *ShadowingSubmissionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ShadowingSubmissionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ShadowingSubmissionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ShadowingSubmissionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(shadowingsubmission.Table, sqlgraph.NewFieldSpec(shadowingsubmission.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ShadowingSubmissionDeleteOne is the builder for deleting a single ShadowingSubmission entity.
+type ShadowingSubmissionDeleteOne struct {
+	_d *ShadowingSubmissionDelete
+}
+
+// Where appends a list predicates to the ShadowingSubmissionDelete builder.
+func (_d *ShadowingSubmissionDeleteOne) Where(ps ...predicate.ShadowingSubmission) *ShadowingSubmissionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ShadowingSubmissionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{shadowingsubmission.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ShadowingSubmissionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,719 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/shadowingsubmission"
+	"github.com/google/uuid"
+)
+
+// ShadowingSubmissionUpdate is the builder for updating ShadowingSubmission entities.
+type ShadowingSubmissionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShadowingSubmissionMutation
+}
+
+// Where appends a list predicates to the ShadowingSubmissionUpdate builder.
+func (_u *ShadowingSubmissionUpdate) Where(ps ...predicate.ShadowingSubmission) *ShadowingSubmissionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ShadowingSubmissionUpdate) SetUpdatedAt(v time.Time) *ShadowingSubmissionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdate) SetNillableUpdatedAt(v *time.Time) *ShadowingSubmissionUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ShadowingSubmissionUpdate) SetUserID(v string) *ShadowingSubmissionUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdate) SetNillableUserID(v *string) *ShadowingSubmissionUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_u *ShadowingSubmissionUpdate) SetEpisodeID(v uuid.UUID) *ShadowingSubmissionUpdate {
+	_u.mutation.SetEpisodeID(v)
+	return _u
+}
+
+// SetNillableEpisodeID sets the "episode_id" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdate) SetNillableEpisodeID(v *uuid.UUID) *ShadowingSubmissionUpdate {
+	if v != nil {
+		_u.SetEpisodeID(*v)
+	}
+	return _u
+}
+
+// SetSegmentIndex sets the "segment_index" field.
+func (_u *ShadowingSubmissionUpdate) SetSegmentIndex(v int) *ShadowingSubmissionUpdate {
+	_u.mutation.ResetSegmentIndex()
+	_u.mutation.SetSegmentIndex(v)
+	return _u
+}
+
+// SetNillableSegmentIndex sets the "segment_index" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdate) SetNillableSegmentIndex(v *int) *ShadowingSubmissionUpdate {
+	if v != nil {
+		_u.SetSegmentIndex(*v)
+	}
+	return _u
+}
+
+// AddSegmentIndex adds value to the "segment_index" field.
+func (_u *ShadowingSubmissionUpdate) AddSegmentIndex(v int) *ShadowingSubmissionUpdate {
+	_u.mutation.AddSegmentIndex(v)
+	return _u
+}
+
+// SetAssetID sets the "asset_id" field.
+func (_u *ShadowingSubmissionUpdate) SetAssetID(v uuid.UUID) *ShadowingSubmissionUpdate {
+	_u.mutation.SetAssetID(v)
+	return _u
+}
+
+// SetNillableAssetID sets the "asset_id" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdate) SetNillableAssetID(v *uuid.UUID) *ShadowingSubmissionUpdate {
+	if v != nil {
+		_u.SetAssetID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ShadowingSubmissionUpdate) SetStatus(v int) *ShadowingSubmissionUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdate) SetNillableStatus(v *int) *ShadowingSubmissionUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *ShadowingSubmissionUpdate) AddStatus(v int) *ShadowingSubmissionUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetAutoScore sets the "auto_score" field.
+func (_u *ShadowingSubmissionUpdate) SetAutoScore(v float64) *ShadowingSubmissionUpdate {
+	_u.mutation.ResetAutoScore()
+	_u.mutation.SetAutoScore(v)
+	return _u
+}
+
+// SetNillableAutoScore sets the "auto_score" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdate) SetNillableAutoScore(v *float64) *ShadowingSubmissionUpdate {
+	if v != nil {
+		_u.SetAutoScore(*v)
+	}
+	return _u
+}
+
+// AddAutoScore adds value to the "auto_score" field.
+func (_u *ShadowingSubmissionUpdate) AddAutoScore(v float64) *ShadowingSubmissionUpdate {
+	_u.mutation.AddAutoScore(v)
+	return _u
+}
+
+// ClearAutoScore clears the value of the "auto_score" field.
+func (_u *ShadowingSubmissionUpdate) ClearAutoScore() *ShadowingSubmissionUpdate {
+	_u.mutation.ClearAutoScore()
+	return _u
+}
+
+// SetAutoFeedback sets the "auto_feedback" field.
+func (_u *ShadowingSubmissionUpdate) SetAutoFeedback(v string) *ShadowingSubmissionUpdate {
+	_u.mutation.SetAutoFeedback(v)
+	return _u
+}
+
+// SetNillableAutoFeedback sets the "auto_feedback" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdate) SetNillableAutoFeedback(v *string) *ShadowingSubmissionUpdate {
+	if v != nil {
+		_u.SetAutoFeedback(*v)
+	}
+	return _u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (_u *ShadowingSubmissionUpdate) SetReviewerID(v string) *ShadowingSubmissionUpdate {
+	_u.mutation.SetReviewerID(v)
+	return _u
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdate) SetNillableReviewerID(v *string) *ShadowingSubmissionUpdate {
+	if v != nil {
+		_u.SetReviewerID(*v)
+	}
+	return _u
+}
+
+// SetReviewScore sets the "review_score" field.
+func (_u *ShadowingSubmissionUpdate) SetReviewScore(v int) *ShadowingSubmissionUpdate {
+	_u.mutation.ResetReviewScore()
+	_u.mutation.SetReviewScore(v)
+	return _u
+}
+
+// SetNillableReviewScore sets the "review_score" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdate) SetNillableReviewScore(v *int) *ShadowingSubmissionUpdate {
+	if v != nil {
+		_u.SetReviewScore(*v)
+	}
+	return _u
+}
+
+// AddReviewScore adds value to the "review_score" field.
+func (_u *ShadowingSubmissionUpdate) AddReviewScore(v int) *ShadowingSubmissionUpdate {
+	_u.mutation.AddReviewScore(v)
+	return _u
+}
+
+// ClearReviewScore clears the value of the "review_score" field.
+func (_u *ShadowingSubmissionUpdate) ClearReviewScore() *ShadowingSubmissionUpdate {
+	_u.mutation.ClearReviewScore()
+	return _u
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (_u *ShadowingSubmissionUpdate) SetReviewComment(v string) *ShadowingSubmissionUpdate {
+	_u.mutation.SetReviewComment(v)
+	return _u
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdate) SetNillableReviewComment(v *string) *ShadowingSubmissionUpdate {
+	if v != nil {
+		_u.SetReviewComment(*v)
+	}
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *ShadowingSubmissionUpdate) SetReviewedAt(v time.Time) *ShadowingSubmissionUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdate) SetNillableReviewedAt(v *time.Time) *ShadowingSubmissionUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *ShadowingSubmissionUpdate) ClearReviewedAt() *ShadowingSubmissionUpdate {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// Mutation returns the ShadowingSubmissionMutation object of the builder.
+func (_u *ShadowingSubmissionUpdate) Mutation() *ShadowingSubmissionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ShadowingSubmissionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ShadowingSubmissionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ShadowingSubmissionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ShadowingSubmissionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ShadowingSubmissionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(shadowingsubmission.Table, shadowingsubmission.Columns, sqlgraph.NewFieldSpec(shadowingsubmission.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(shadowingsubmission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(shadowingsubmission.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EpisodeID(); ok {
+		_spec.SetField(shadowingsubmission.FieldEpisodeID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.SegmentIndex(); ok {
+		_spec.SetField(shadowingsubmission.FieldSegmentIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSegmentIndex(); ok {
+		_spec.AddField(shadowingsubmission.FieldSegmentIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AssetID(); ok {
+		_spec.SetField(shadowingsubmission.FieldAssetID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(shadowingsubmission.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(shadowingsubmission.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AutoScore(); ok {
+		_spec.SetField(shadowingsubmission.FieldAutoScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAutoScore(); ok {
+		_spec.AddField(shadowingsubmission.FieldAutoScore, field.TypeFloat64, value)
+	}
+	if _u.mutation.AutoScoreCleared() {
+		_spec.ClearField(shadowingsubmission.FieldAutoScore, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.AutoFeedback(); ok {
+		_spec.SetField(shadowingsubmission.FieldAutoFeedback, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewerID(); ok {
+		_spec.SetField(shadowingsubmission.FieldReviewerID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewScore(); ok {
+		_spec.SetField(shadowingsubmission.FieldReviewScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReviewScore(); ok {
+		_spec.AddField(shadowingsubmission.FieldReviewScore, field.TypeInt, value)
+	}
+	if _u.mutation.ReviewScoreCleared() {
+		_spec.ClearField(shadowingsubmission.FieldReviewScore, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ReviewComment(); ok {
+		_spec.SetField(shadowingsubmission.FieldReviewComment, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(shadowingsubmission.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(shadowingsubmission.FieldReviewedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shadowingsubmission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ShadowingSubmissionUpdateOne is the builder for updating a single ShadowingSubmission entity.
+type ShadowingSubmissionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShadowingSubmissionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ShadowingSubmissionUpdateOne) SetUpdatedAt(v time.Time) *ShadowingSubmissionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdateOne) SetNillableUpdatedAt(v *time.Time) *ShadowingSubmissionUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ShadowingSubmissionUpdateOne) SetUserID(v string) *ShadowingSubmissionUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdateOne) SetNillableUserID(v *string) *ShadowingSubmissionUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_u *ShadowingSubmissionUpdateOne) SetEpisodeID(v uuid.UUID) *ShadowingSubmissionUpdateOne {
+	_u.mutation.SetEpisodeID(v)
+	return _u
+}
+
+// SetNillableEpisodeID sets the "episode_id" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdateOne) SetNillableEpisodeID(v *uuid.UUID) *ShadowingSubmissionUpdateOne {
+	if v != nil {
+		_u.SetEpisodeID(*v)
+	}
+	return _u
+}
+
+// SetSegmentIndex sets the "segment_index" field.
+func (_u *ShadowingSubmissionUpdateOne) SetSegmentIndex(v int) *ShadowingSubmissionUpdateOne {
+	_u.mutation.ResetSegmentIndex()
+	_u.mutation.SetSegmentIndex(v)
+	return _u
+}
+
+// SetNillableSegmentIndex sets the "segment_index" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdateOne) SetNillableSegmentIndex(v *int) *ShadowingSubmissionUpdateOne {
+	if v != nil {
+		_u.SetSegmentIndex(*v)
+	}
+	return _u
+}
+
+// AddSegmentIndex adds value to the "segment_index" field.
+func (_u *ShadowingSubmissionUpdateOne) AddSegmentIndex(v int) *ShadowingSubmissionUpdateOne {
+	_u.mutation.AddSegmentIndex(v)
+	return _u
+}
+
+// SetAssetID sets the "asset_id" field.
+func (_u *ShadowingSubmissionUpdateOne) SetAssetID(v uuid.UUID) *ShadowingSubmissionUpdateOne {
+	_u.mutation.SetAssetID(v)
+	return _u
+}
+
+// SetNillableAssetID sets the "asset_id" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdateOne) SetNillableAssetID(v *uuid.UUID) *ShadowingSubmissionUpdateOne {
+	if v != nil {
+		_u.SetAssetID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ShadowingSubmissionUpdateOne) SetStatus(v int) *ShadowingSubmissionUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdateOne) SetNillableStatus(v *int) *ShadowingSubmissionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *ShadowingSubmissionUpdateOne) AddStatus(v int) *ShadowingSubmissionUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetAutoScore sets the "auto_score" field.
+func (_u *ShadowingSubmissionUpdateOne) SetAutoScore(v float64) *ShadowingSubmissionUpdateOne {
+	_u.mutation.ResetAutoScore()
+	_u.mutation.SetAutoScore(v)
+	return _u
+}
+
+// SetNillableAutoScore sets the "auto_score" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdateOne) SetNillableAutoScore(v *float64) *ShadowingSubmissionUpdateOne {
+	if v != nil {
+		_u.SetAutoScore(*v)
+	}
+	return _u
+}
+
+// AddAutoScore adds value to the "auto_score" field.
+func (_u *ShadowingSubmissionUpdateOne) AddAutoScore(v float64) *ShadowingSubmissionUpdateOne {
+	_u.mutation.AddAutoScore(v)
+	return _u
+}
+
+// ClearAutoScore clears the value of the "auto_score" field.
+func (_u *ShadowingSubmissionUpdateOne) ClearAutoScore() *ShadowingSubmissionUpdateOne {
+	_u.mutation.ClearAutoScore()
+	return _u
+}
+
+// SetAutoFeedback sets the "auto_feedback" field.
+func (_u *ShadowingSubmissionUpdateOne) SetAutoFeedback(v string) *ShadowingSubmissionUpdateOne {
+	_u.mutation.SetAutoFeedback(v)
+	return _u
+}
+
+// SetNillableAutoFeedback sets the "auto_feedback" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdateOne) SetNillableAutoFeedback(v *string) *ShadowingSubmissionUpdateOne {
+	if v != nil {
+		_u.SetAutoFeedback(*v)
+	}
+	return _u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (_u *ShadowingSubmissionUpdateOne) SetReviewerID(v string) *ShadowingSubmissionUpdateOne {
+	_u.mutation.SetReviewerID(v)
+	return _u
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdateOne) SetNillableReviewerID(v *string) *ShadowingSubmissionUpdateOne {
+	if v != nil {
+		_u.SetReviewerID(*v)
+	}
+	return _u
+}
+
+// SetReviewScore sets the "review_score" field.
+func (_u *ShadowingSubmissionUpdateOne) SetReviewScore(v int) *ShadowingSubmissionUpdateOne {
+	_u.mutation.ResetReviewScore()
+	_u.mutation.SetReviewScore(v)
+	return _u
+}
+
+// SetNillableReviewScore sets the "review_score" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdateOne) SetNillableReviewScore(v *int) *ShadowingSubmissionUpdateOne {
+	if v != nil {
+		_u.SetReviewScore(*v)
+	}
+	return _u
+}
+
+// AddReviewScore adds value to the "review_score" field.
+func (_u *ShadowingSubmissionUpdateOne) AddReviewScore(v int) *ShadowingSubmissionUpdateOne {
+	_u.mutation.AddReviewScore(v)
+	return _u
+}
+
+// ClearReviewScore clears the value of the "review_score" field.
+func (_u *ShadowingSubmissionUpdateOne) ClearReviewScore() *ShadowingSubmissionUpdateOne {
+	_u.mutation.ClearReviewScore()
+	return _u
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (_u *ShadowingSubmissionUpdateOne) SetReviewComment(v string) *ShadowingSubmissionUpdateOne {
+	_u.mutation.SetReviewComment(v)
+	return _u
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdateOne) SetNillableReviewComment(v *string) *ShadowingSubmissionUpdateOne {
+	if v != nil {
+		_u.SetReviewComment(*v)
+	}
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *ShadowingSubmissionUpdateOne) SetReviewedAt(v time.Time) *ShadowingSubmissionUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *ShadowingSubmissionUpdateOne) SetNillableReviewedAt(v *time.Time) *ShadowingSubmissionUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *ShadowingSubmissionUpdateOne) ClearReviewedAt() *ShadowingSubmissionUpdateOne {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// Mutation returns the ShadowingSubmissionMutation object of the builder.
+func (_u *ShadowingSubmissionUpdateOne) Mutation() *ShadowingSubmissionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ShadowingSubmissionUpdate builder.
+func (_u *ShadowingSubmissionUpdateOne) Where(ps ...predicate.ShadowingSubmission) *ShadowingSubmissionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ShadowingSubmissionUpdateOne) Select(field string, fields ...string) *ShadowingSubmissionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ShadowingSubmission entity.
+func (_u *ShadowingSubmissionUpdateOne) Save(ctx context.Context) (*ShadowingSubmission, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ShadowingSubmissionUpdateOne) SaveX(ctx context.Context) *ShadowingSubmission {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ShadowingSubmissionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ShadowingSubmissionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ShadowingSubmissionUpdateOne) sqlSave(ctx context.Context) (_node *ShadowingSubmission, err error) {
+	_spec := sqlgraph.NewUpdateSpec(shadowingsubmission.Table, shadowingsubmission.Columns, sqlgraph.NewFieldSpec(shadowingsubmission.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "ShadowingSubmission.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shadowingsubmission.FieldID)
+		for _, f := range fields {
+			if !shadowingsubmission.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != shadowingsubmission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(shadowingsubmission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(shadowingsubmission.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EpisodeID(); ok {
+		_spec.SetField(shadowingsubmission.FieldEpisodeID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.SegmentIndex(); ok {
+		_spec.SetField(shadowingsubmission.FieldSegmentIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSegmentIndex(); ok {
+		_spec.AddField(shadowingsubmission.FieldSegmentIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AssetID(); ok {
+		_spec.SetField(shadowingsubmission.FieldAssetID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(shadowingsubmission.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(shadowingsubmission.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AutoScore(); ok {
+		_spec.SetField(shadowingsubmission.FieldAutoScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAutoScore(); ok {
+		_spec.AddField(shadowingsubmission.FieldAutoScore, field.TypeFloat64, value)
+	}
+	if _u.mutation.AutoScoreCleared() {
+		_spec.ClearField(shadowingsubmission.FieldAutoScore, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.AutoFeedback(); ok {
+		_spec.SetField(shadowingsubmission.FieldAutoFeedback, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewerID(); ok {
+		_spec.SetField(shadowingsubmission.FieldReviewerID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewScore(); ok {
+		_spec.SetField(shadowingsubmission.FieldReviewScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReviewScore(); ok {
+		_spec.AddField(shadowingsubmission.FieldReviewScore, field.TypeInt, value)
+	}
+	if _u.mutation.ReviewScoreCleared() {
+		_spec.ClearField(shadowingsubmission.FieldReviewScore, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ReviewComment(); ok {
+		_spec.SetField(shadowingsubmission.FieldReviewComment, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(shadowingsubmission.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(shadowingsubmission.FieldReviewedAt, field.TypeTime)
+	}
+	_node = &ShadowingSubmission{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shadowingsubmission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/subscription"
+	"github.com/google/uuid"
+)
+
+// SubscriptionCreate is the builder for creating a Subscription entity.
+type SubscriptionCreate struct {
+	config
+	mutation *SubscriptionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SubscriptionCreate) SetCreatedAt(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SubscriptionCreate) SetUpdatedAt(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *SubscriptionCreate) SetUserID(v string) *SubscriptionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetPlanID sets the "plan_id" field.
+func (_c *SubscriptionCreate) SetPlanID(v uuid.UUID) *SubscriptionCreate {
+	_c.mutation.SetPlanID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *SubscriptionCreate) SetStatus(v int) *SubscriptionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableStatus(v *int) *SubscriptionCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCurrentPeriodStart sets the "current_period_start" field.
+func (_c *SubscriptionCreate) SetCurrentPeriodStart(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetCurrentPeriodStart(v)
+	return _c
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (_c *SubscriptionCreate) SetCurrentPeriodEnd(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetCurrentPeriodEnd(v)
+	return _c
+}
+
+// SetBillingProvider sets the "billing_provider" field.
+func (_c *SubscriptionCreate) SetBillingProvider(v string) *SubscriptionCreate {
+	_c.mutation.SetBillingProvider(v)
+	return _c
+}
+
+// SetNillableBillingProvider sets the "billing_provider" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableBillingProvider(v *string) *SubscriptionCreate {
+	if v != nil {
+		_c.SetBillingProvider(*v)
+	}
+	return _c
+}
+
+// SetExternalID sets the "external_id" field.
+func (_c *SubscriptionCreate) SetExternalID(v string) *SubscriptionCreate {
+	_c.mutation.SetExternalID(v)
+	return _c
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableExternalID(v *string) *SubscriptionCreate {
+	if v != nil {
+		_c.SetExternalID(*v)
+	}
+	return _c
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (_c *SubscriptionCreate) SetCanceledAt(v time.Time) *SubscriptionCreate {
+	_c.mutation.SetCanceledAt(v)
+	return _c
+}
+
+// SetNillableCanceledAt sets the "canceled_at" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableCanceledAt(v *time.Time) *SubscriptionCreate {
+	if v != nil {
+		_c.SetCanceledAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SubscriptionCreate) SetID(v uuid.UUID) *SubscriptionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableID(v *uuid.UUID) *SubscriptionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SubscriptionMutation object of the builder.
+func (_c *SubscriptionCreate) Mutation() *SubscriptionMutation {
+	return _c.mutation
+}
+
+// Save creates the Subscription in the database.
+func (_c *SubscriptionCreate) Save(ctx context.Context) (*Subscription, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SubscriptionCreate) SaveX(ctx context.Context) *Subscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SubscriptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SubscriptionCreate) defaults() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := subscription.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.BillingProvider(); !ok {
+		v := subscription.DefaultBillingProvider
+		_c.mutation.SetBillingProvider(v)
+	}
+	if _, ok := _c.mutation.ExternalID(); !ok {
+		v := subscription.DefaultExternalID
+		_c.mutation.SetExternalID(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if subscription.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized subscription.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := subscription.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SubscriptionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "Subscription.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "Subscription.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "Subscription.user_id"`)}
+	}
+	if _, ok := _c.mutation.PlanID(); !ok {
+		return &ValidationError{Name: "plan_id", err: errors.New(`generated: missing required field "Subscription.plan_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`generated: missing required field "Subscription.status"`)}
+	}
+	if _, ok := _c.mutation.CurrentPeriodStart(); !ok {
+		return &ValidationError{Name: "current_period_start", err: errors.New(`generated: missing required field "Subscription.current_period_start"`)}
+	}
+	if _, ok := _c.mutation.CurrentPeriodEnd(); !ok {
+		return &ValidationError{Name: "current_period_end", err: errors.New(`generated: missing required field "Subscription.current_period_end"`)}
+	}
+	if _, ok := _c.mutation.BillingProvider(); !ok {
+		return &ValidationError{Name: "billing_provider", err: errors.New(`generated: missing required field "Subscription.billing_provider"`)}
+	}
+	if _, ok := _c.mutation.ExternalID(); !ok {
+		return &ValidationError{Name: "external_id", err: errors.New(`generated: missing required field "Subscription.external_id"`)}
+	}
+	return nil
+}
+
+func (_c *SubscriptionCreate) sqlSave(ctx context.Context) (*Subscription, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Subscription{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(subscription.Table, sqlgraph.NewFieldSpec(subscription.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(subscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(subscription.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.PlanID(); ok {
+		_spec.SetField(subscription.FieldPlanID, field.TypeUUID, value)
+		_node.PlanID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(subscription.FieldStatus, field.TypeInt, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CurrentPeriodStart(); ok {
+		_spec.SetField(subscription.FieldCurrentPeriodStart, field.TypeTime, value)
+		_node.CurrentPeriodStart = value
+	}
+	if value, ok := _c.mutation.CurrentPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCurrentPeriodEnd, field.TypeTime, value)
+		_node.CurrentPeriodEnd = value
+	}
+	if value, ok := _c.mutation.BillingProvider(); ok {
+		_spec.SetField(subscription.FieldBillingProvider, field.TypeString, value)
+		_node.BillingProvider = value
+	}
+	if value, ok := _c.mutation.ExternalID(); ok {
+		_spec.SetField(subscription.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = value
+	}
+	if value, ok := _c.mutation.CanceledAt(); ok {
+		_spec.SetField(subscription.FieldCanceledAt, field.TypeTime, value)
+		_node.CanceledAt = &value
+	}
+	return _node, _spec
+}
+
+// SubscriptionCreateBulk is the builder for creating many Subscription entities in bulk.
+type SubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*SubscriptionCreate
+}
+
+// Save creates the Subscription entities in the database.
+func (_c *SubscriptionCreateBulk) Save(ctx context.Context) ([]*Subscription, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Subscription, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SubscriptionCreateBulk) SaveX(ctx context.Context) []*Subscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
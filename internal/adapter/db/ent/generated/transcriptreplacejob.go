@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/transcriptreplacejob"
+	"github.com/eslsoft/lession/internal/core"
+	"github.com/google/uuid"
+)
+
+// TranscriptReplaceJob is the model entity for the TranscriptReplaceJob schema.
+type TranscriptReplaceJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Pattern holds the value of the "pattern" field.
+	Pattern string `json:"pattern,omitempty"`
+	// Replacement holds the value of the "replacement" field.
+	Replacement string `json:"replacement,omitempty"`
+	// Regex holds the value of the "regex" field.
+	Regex bool `json:"regex,omitempty"`
+	// CaseInsensitive holds the value of the "case_insensitive" field.
+	CaseInsensitive bool `json:"case_insensitive,omitempty"`
+	// ScopeSeriesID holds the value of the "scope_series_id" field.
+	ScopeSeriesID *uuid.UUID `json:"scope_series_id,omitempty"`
+	// ScopeLanguage holds the value of the "scope_language" field.
+	ScopeLanguage string `json:"scope_language,omitempty"`
+	// DryRun holds the value of the "dry_run" field.
+	DryRun bool `json:"dry_run,omitempty"`
+	// RequestedBy holds the value of the "requested_by" field.
+	RequestedBy string `json:"requested_by,omitempty"`
+	// Status holds the value of the "status" field.
+	Status int `json:"status,omitempty"`
+	// EpisodesScanned holds the value of the "episodes_scanned" field.
+	EpisodesScanned int `json:"episodes_scanned,omitempty"`
+	// EpisodesChanged holds the value of the "episodes_changed" field.
+	EpisodesChanged int `json:"episodes_changed,omitempty"`
+	// Matches holds the value of the "matches" field.
+	Matches int `json:"matches,omitempty"`
+	// Changes holds the value of the "changes" field.
+	Changes []core.TranscriptChange `json:"changes,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// RolledBackAt holds the value of the "rolled_back_at" field.
+	RolledBackAt *time.Time `json:"rolled_back_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TranscriptReplaceJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case transcriptreplacejob.FieldScopeSeriesID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case transcriptreplacejob.FieldChanges:
+			values[i] = new([]byte)
+		case transcriptreplacejob.FieldRegex, transcriptreplacejob.FieldCaseInsensitive, transcriptreplacejob.FieldDryRun:
+			values[i] = new(sql.NullBool)
+		case transcriptreplacejob.FieldStatus, transcriptreplacejob.FieldEpisodesScanned, transcriptreplacejob.FieldEpisodesChanged, transcriptreplacejob.FieldMatches:
+			values[i] = new(sql.NullInt64)
+		case transcriptreplacejob.FieldPattern, transcriptreplacejob.FieldReplacement, transcriptreplacejob.FieldScopeLanguage, transcriptreplacejob.FieldRequestedBy, transcriptreplacejob.FieldError:
+			values[i] = new(sql.NullString)
+		case transcriptreplacejob.FieldCreatedAt, transcriptreplacejob.FieldStartedAt, transcriptreplacejob.FieldFinishedAt, transcriptreplacejob.FieldRolledBackAt:
+			values[i] = new(sql.NullTime)
+		case transcriptreplacejob.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TranscriptReplaceJob fields.
+func (_m *TranscriptReplaceJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case transcriptreplacejob.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case transcriptreplacejob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case transcriptreplacejob.FieldPattern:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pattern", values[i])
+			} else if value.Valid {
+				_m.Pattern = value.String
+			}
+		case transcriptreplacejob.FieldReplacement:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field replacement", values[i])
+			} else if value.Valid {
+				_m.Replacement = value.String
+			}
+		case transcriptreplacejob.FieldRegex:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field regex", values[i])
+			} else if value.Valid {
+				_m.Regex = value.Bool
+			}
+		case transcriptreplacejob.FieldCaseInsensitive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field case_insensitive", values[i])
+			} else if value.Valid {
+				_m.CaseInsensitive = value.Bool
+			}
+		case transcriptreplacejob.FieldScopeSeriesID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field scope_series_id", values[i])
+			} else if value.Valid {
+				_m.ScopeSeriesID = new(uuid.UUID)
+				*_m.ScopeSeriesID = *value.S.(*uuid.UUID)
+			}
+		case transcriptreplacejob.FieldScopeLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope_language", values[i])
+			} else if value.Valid {
+				_m.ScopeLanguage = value.String
+			}
+		case transcriptreplacejob.FieldDryRun:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field dry_run", values[i])
+			} else if value.Valid {
+				_m.DryRun = value.Bool
+			}
+		case transcriptreplacejob.FieldRequestedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_by", values[i])
+			} else if value.Valid {
+				_m.RequestedBy = value.String
+			}
+		case transcriptreplacejob.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = int(value.Int64)
+			}
+		case transcriptreplacejob.FieldEpisodesScanned:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field episodes_scanned", values[i])
+			} else if value.Valid {
+				_m.EpisodesScanned = int(value.Int64)
+			}
+		case transcriptreplacejob.FieldEpisodesChanged:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field episodes_changed", values[i])
+			} else if value.Valid {
+				_m.EpisodesChanged = int(value.Int64)
+			}
+		case transcriptreplacejob.FieldMatches:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field matches", values[i])
+			} else if value.Valid {
+				_m.Matches = int(value.Int64)
+			}
+		case transcriptreplacejob.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		case transcriptreplacejob.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case transcriptreplacejob.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = new(time.Time)
+				*_m.StartedAt = value.Time
+			}
+		case transcriptreplacejob.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = new(time.Time)
+				*_m.FinishedAt = value.Time
+			}
+		case transcriptreplacejob.FieldRolledBackAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rolled_back_at", values[i])
+			} else if value.Valid {
+				_m.RolledBackAt = new(time.Time)
+				*_m.RolledBackAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TranscriptReplaceJob.
+// This includes values selected through modifiers, order, etc.
+func (_m *TranscriptReplaceJob) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TranscriptReplaceJob.
+// Note that you need to call TranscriptReplaceJob.Unwrap() before calling this method if this TranscriptReplaceJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TranscriptReplaceJob) Update() *TranscriptReplaceJobUpdateOne {
+	return NewTranscriptReplaceJobClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TranscriptReplaceJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TranscriptReplaceJob) Unwrap() *TranscriptReplaceJob {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: TranscriptReplaceJob is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TranscriptReplaceJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("TranscriptReplaceJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("pattern=")
+	builder.WriteString(_m.Pattern)
+	builder.WriteString(", ")
+	builder.WriteString("replacement=")
+	builder.WriteString(_m.Replacement)
+	builder.WriteString(", ")
+	builder.WriteString("regex=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Regex))
+	builder.WriteString(", ")
+	builder.WriteString("case_insensitive=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CaseInsensitive))
+	builder.WriteString(", ")
+	if v := _m.ScopeSeriesID; v != nil {
+		builder.WriteString("scope_series_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("scope_language=")
+	builder.WriteString(_m.ScopeLanguage)
+	builder.WriteString(", ")
+	builder.WriteString("dry_run=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DryRun))
+	builder.WriteString(", ")
+	builder.WriteString("requested_by=")
+	builder.WriteString(_m.RequestedBy)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("episodes_scanned=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EpisodesScanned))
+	builder.WriteString(", ")
+	builder.WriteString("episodes_changed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EpisodesChanged))
+	builder.WriteString(", ")
+	builder.WriteString("matches=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Matches))
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Changes))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	if v := _m.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RolledBackAt; v != nil {
+		builder.WriteString("rolled_back_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TranscriptReplaceJobs is a parsable slice of TranscriptReplaceJob.
+type TranscriptReplaceJobs []*TranscriptReplaceJob
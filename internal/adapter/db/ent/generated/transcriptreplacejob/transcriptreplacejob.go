@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transcriptreplacejob
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the transcriptreplacejob type in the database.
+	Label = "transcript_replace_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldPattern holds the string denoting the pattern field in the database.
+	FieldPattern = "pattern"
+	// FieldReplacement holds the string denoting the replacement field in the database.
+	FieldReplacement = "replacement"
+	// FieldRegex holds the string denoting the regex field in the database.
+	FieldRegex = "regex"
+	// FieldCaseInsensitive holds the string denoting the case_insensitive field in the database.
+	FieldCaseInsensitive = "case_insensitive"
+	// FieldScopeSeriesID holds the string denoting the scope_series_id field in the database.
+	FieldScopeSeriesID = "scope_series_id"
+	// FieldScopeLanguage holds the string denoting the scope_language field in the database.
+	FieldScopeLanguage = "scope_language"
+	// FieldDryRun holds the string denoting the dry_run field in the database.
+	FieldDryRun = "dry_run"
+	// FieldRequestedBy holds the string denoting the requested_by field in the database.
+	FieldRequestedBy = "requested_by"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldEpisodesScanned holds the string denoting the episodes_scanned field in the database.
+	FieldEpisodesScanned = "episodes_scanned"
+	// FieldEpisodesChanged holds the string denoting the episodes_changed field in the database.
+	FieldEpisodesChanged = "episodes_changed"
+	// FieldMatches holds the string denoting the matches field in the database.
+	FieldMatches = "matches"
+	// FieldChanges holds the string denoting the changes field in the database.
+	FieldChanges = "changes"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// FieldRolledBackAt holds the string denoting the rolled_back_at field in the database.
+	FieldRolledBackAt = "rolled_back_at"
+	// Table holds the table name of the transcriptreplacejob in the database.
+	Table = "transcript_replace_jobs"
+)
+
+// Columns holds all SQL columns for transcriptreplacejob fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldPattern,
+	FieldReplacement,
+	FieldRegex,
+	FieldCaseInsensitive,
+	FieldScopeSeriesID,
+	FieldScopeLanguage,
+	FieldDryRun,
+	FieldRequestedBy,
+	FieldStatus,
+	FieldEpisodesScanned,
+	FieldEpisodesChanged,
+	FieldMatches,
+	FieldChanges,
+	FieldError,
+	FieldStartedAt,
+	FieldFinishedAt,
+	FieldRolledBackAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eslsoft/lession/internal/adapter/db/ent/generated/runtime"
+var (
+	Hooks [1]ent.Hook
+	// DefaultReplacement holds the default value on creation for the "replacement" field.
+	DefaultReplacement string
+	// DefaultRegex holds the default value on creation for the "regex" field.
+	DefaultRegex bool
+	// DefaultCaseInsensitive holds the default value on creation for the "case_insensitive" field.
+	DefaultCaseInsensitive bool
+	// DefaultScopeLanguage holds the default value on creation for the "scope_language" field.
+	DefaultScopeLanguage string
+	// DefaultDryRun holds the default value on creation for the "dry_run" field.
+	DefaultDryRun bool
+	// DefaultRequestedBy holds the default value on creation for the "requested_by" field.
+	DefaultRequestedBy string
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus int
+	// DefaultEpisodesScanned holds the default value on creation for the "episodes_scanned" field.
+	DefaultEpisodesScanned int
+	// DefaultEpisodesChanged holds the default value on creation for the "episodes_changed" field.
+	DefaultEpisodesChanged int
+	// DefaultMatches holds the default value on creation for the "matches" field.
+	DefaultMatches int
+	// DefaultError holds the default value on creation for the "error" field.
+	DefaultError string
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the TranscriptReplaceJob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByPattern orders the results by the pattern field.
+func ByPattern(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPattern, opts...).ToFunc()
+}
+
+// ByReplacement orders the results by the replacement field.
+func ByReplacement(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplacement, opts...).ToFunc()
+}
+
+// ByRegex orders the results by the regex field.
+func ByRegex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegex, opts...).ToFunc()
+}
+
+// ByCaseInsensitive orders the results by the case_insensitive field.
+func ByCaseInsensitive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCaseInsensitive, opts...).ToFunc()
+}
+
+// ByScopeSeriesID orders the results by the scope_series_id field.
+func ByScopeSeriesID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScopeSeriesID, opts...).ToFunc()
+}
+
+// ByScopeLanguage orders the results by the scope_language field.
+func ByScopeLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScopeLanguage, opts...).ToFunc()
+}
+
+// ByDryRun orders the results by the dry_run field.
+func ByDryRun(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDryRun, opts...).ToFunc()
+}
+
+// ByRequestedBy orders the results by the requested_by field.
+func ByRequestedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestedBy, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByEpisodesScanned orders the results by the episodes_scanned field.
+func ByEpisodesScanned(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEpisodesScanned, opts...).ToFunc()
+}
+
+// ByEpisodesChanged orders the results by the episodes_changed field.
+func ByEpisodesChanged(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEpisodesChanged, opts...).ToFunc()
+}
+
+// ByMatches orders the results by the matches field.
+func ByMatches(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatches, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByRolledBackAt orders the results by the rolled_back_at field.
+func ByRolledBackAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRolledBackAt, opts...).ToFunc()
+}
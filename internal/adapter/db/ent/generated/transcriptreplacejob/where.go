@@ -0,0 +1,921 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transcriptreplacejob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// Pattern applies equality check predicate on the "pattern" field. It's identical to PatternEQ.
+func Pattern(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldPattern, v))
+}
+
+// Replacement applies equality check predicate on the "replacement" field. It's identical to ReplacementEQ.
+func Replacement(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldReplacement, v))
+}
+
+// Regex applies equality check predicate on the "regex" field. It's identical to RegexEQ.
+func Regex(v bool) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldRegex, v))
+}
+
+// CaseInsensitive applies equality check predicate on the "case_insensitive" field. It's identical to CaseInsensitiveEQ.
+func CaseInsensitive(v bool) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldCaseInsensitive, v))
+}
+
+// ScopeSeriesID applies equality check predicate on the "scope_series_id" field. It's identical to ScopeSeriesIDEQ.
+func ScopeSeriesID(v uuid.UUID) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldScopeSeriesID, v))
+}
+
+// ScopeLanguage applies equality check predicate on the "scope_language" field. It's identical to ScopeLanguageEQ.
+func ScopeLanguage(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldScopeLanguage, v))
+}
+
+// DryRun applies equality check predicate on the "dry_run" field. It's identical to DryRunEQ.
+func DryRun(v bool) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldDryRun, v))
+}
+
+// RequestedBy applies equality check predicate on the "requested_by" field. It's identical to RequestedByEQ.
+func RequestedBy(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// EpisodesScanned applies equality check predicate on the "episodes_scanned" field. It's identical to EpisodesScannedEQ.
+func EpisodesScanned(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldEpisodesScanned, v))
+}
+
+// EpisodesChanged applies equality check predicate on the "episodes_changed" field. It's identical to EpisodesChangedEQ.
+func EpisodesChanged(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldEpisodesChanged, v))
+}
+
+// Matches applies equality check predicate on the "matches" field. It's identical to MatchesEQ.
+func Matches(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldMatches, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldError, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// RolledBackAt applies equality check predicate on the "rolled_back_at" field. It's identical to RolledBackAtEQ.
+func RolledBackAt(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldRolledBackAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// PatternEQ applies the EQ predicate on the "pattern" field.
+func PatternEQ(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldPattern, v))
+}
+
+// PatternNEQ applies the NEQ predicate on the "pattern" field.
+func PatternNEQ(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNEQ(FieldPattern, v))
+}
+
+// PatternIn applies the In predicate on the "pattern" field.
+func PatternIn(vs ...string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIn(FieldPattern, vs...))
+}
+
+// PatternNotIn applies the NotIn predicate on the "pattern" field.
+func PatternNotIn(vs ...string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotIn(FieldPattern, vs...))
+}
+
+// PatternGT applies the GT predicate on the "pattern" field.
+func PatternGT(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGT(FieldPattern, v))
+}
+
+// PatternGTE applies the GTE predicate on the "pattern" field.
+func PatternGTE(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGTE(FieldPattern, v))
+}
+
+// PatternLT applies the LT predicate on the "pattern" field.
+func PatternLT(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLT(FieldPattern, v))
+}
+
+// PatternLTE applies the LTE predicate on the "pattern" field.
+func PatternLTE(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLTE(FieldPattern, v))
+}
+
+// PatternContains applies the Contains predicate on the "pattern" field.
+func PatternContains(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldContains(FieldPattern, v))
+}
+
+// PatternHasPrefix applies the HasPrefix predicate on the "pattern" field.
+func PatternHasPrefix(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldHasPrefix(FieldPattern, v))
+}
+
+// PatternHasSuffix applies the HasSuffix predicate on the "pattern" field.
+func PatternHasSuffix(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldHasSuffix(FieldPattern, v))
+}
+
+// PatternEqualFold applies the EqualFold predicate on the "pattern" field.
+func PatternEqualFold(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEqualFold(FieldPattern, v))
+}
+
+// PatternContainsFold applies the ContainsFold predicate on the "pattern" field.
+func PatternContainsFold(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldContainsFold(FieldPattern, v))
+}
+
+// ReplacementEQ applies the EQ predicate on the "replacement" field.
+func ReplacementEQ(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldReplacement, v))
+}
+
+// ReplacementNEQ applies the NEQ predicate on the "replacement" field.
+func ReplacementNEQ(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNEQ(FieldReplacement, v))
+}
+
+// ReplacementIn applies the In predicate on the "replacement" field.
+func ReplacementIn(vs ...string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIn(FieldReplacement, vs...))
+}
+
+// ReplacementNotIn applies the NotIn predicate on the "replacement" field.
+func ReplacementNotIn(vs ...string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotIn(FieldReplacement, vs...))
+}
+
+// ReplacementGT applies the GT predicate on the "replacement" field.
+func ReplacementGT(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGT(FieldReplacement, v))
+}
+
+// ReplacementGTE applies the GTE predicate on the "replacement" field.
+func ReplacementGTE(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGTE(FieldReplacement, v))
+}
+
+// ReplacementLT applies the LT predicate on the "replacement" field.
+func ReplacementLT(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLT(FieldReplacement, v))
+}
+
+// ReplacementLTE applies the LTE predicate on the "replacement" field.
+func ReplacementLTE(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLTE(FieldReplacement, v))
+}
+
+// ReplacementContains applies the Contains predicate on the "replacement" field.
+func ReplacementContains(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldContains(FieldReplacement, v))
+}
+
+// ReplacementHasPrefix applies the HasPrefix predicate on the "replacement" field.
+func ReplacementHasPrefix(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldHasPrefix(FieldReplacement, v))
+}
+
+// ReplacementHasSuffix applies the HasSuffix predicate on the "replacement" field.
+func ReplacementHasSuffix(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldHasSuffix(FieldReplacement, v))
+}
+
+// ReplacementEqualFold applies the EqualFold predicate on the "replacement" field.
+func ReplacementEqualFold(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEqualFold(FieldReplacement, v))
+}
+
+// ReplacementContainsFold applies the ContainsFold predicate on the "replacement" field.
+func ReplacementContainsFold(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldContainsFold(FieldReplacement, v))
+}
+
+// RegexEQ applies the EQ predicate on the "regex" field.
+func RegexEQ(v bool) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldRegex, v))
+}
+
+// RegexNEQ applies the NEQ predicate on the "regex" field.
+func RegexNEQ(v bool) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNEQ(FieldRegex, v))
+}
+
+// CaseInsensitiveEQ applies the EQ predicate on the "case_insensitive" field.
+func CaseInsensitiveEQ(v bool) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldCaseInsensitive, v))
+}
+
+// CaseInsensitiveNEQ applies the NEQ predicate on the "case_insensitive" field.
+func CaseInsensitiveNEQ(v bool) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNEQ(FieldCaseInsensitive, v))
+}
+
+// ScopeSeriesIDEQ applies the EQ predicate on the "scope_series_id" field.
+func ScopeSeriesIDEQ(v uuid.UUID) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldScopeSeriesID, v))
+}
+
+// ScopeSeriesIDNEQ applies the NEQ predicate on the "scope_series_id" field.
+func ScopeSeriesIDNEQ(v uuid.UUID) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNEQ(FieldScopeSeriesID, v))
+}
+
+// ScopeSeriesIDIn applies the In predicate on the "scope_series_id" field.
+func ScopeSeriesIDIn(vs ...uuid.UUID) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIn(FieldScopeSeriesID, vs...))
+}
+
+// ScopeSeriesIDNotIn applies the NotIn predicate on the "scope_series_id" field.
+func ScopeSeriesIDNotIn(vs ...uuid.UUID) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotIn(FieldScopeSeriesID, vs...))
+}
+
+// ScopeSeriesIDGT applies the GT predicate on the "scope_series_id" field.
+func ScopeSeriesIDGT(v uuid.UUID) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGT(FieldScopeSeriesID, v))
+}
+
+// ScopeSeriesIDGTE applies the GTE predicate on the "scope_series_id" field.
+func ScopeSeriesIDGTE(v uuid.UUID) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGTE(FieldScopeSeriesID, v))
+}
+
+// ScopeSeriesIDLT applies the LT predicate on the "scope_series_id" field.
+func ScopeSeriesIDLT(v uuid.UUID) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLT(FieldScopeSeriesID, v))
+}
+
+// ScopeSeriesIDLTE applies the LTE predicate on the "scope_series_id" field.
+func ScopeSeriesIDLTE(v uuid.UUID) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLTE(FieldScopeSeriesID, v))
+}
+
+// ScopeSeriesIDIsNil applies the IsNil predicate on the "scope_series_id" field.
+func ScopeSeriesIDIsNil() predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIsNull(FieldScopeSeriesID))
+}
+
+// ScopeSeriesIDNotNil applies the NotNil predicate on the "scope_series_id" field.
+func ScopeSeriesIDNotNil() predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotNull(FieldScopeSeriesID))
+}
+
+// ScopeLanguageEQ applies the EQ predicate on the "scope_language" field.
+func ScopeLanguageEQ(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldScopeLanguage, v))
+}
+
+// ScopeLanguageNEQ applies the NEQ predicate on the "scope_language" field.
+func ScopeLanguageNEQ(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNEQ(FieldScopeLanguage, v))
+}
+
+// ScopeLanguageIn applies the In predicate on the "scope_language" field.
+func ScopeLanguageIn(vs ...string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIn(FieldScopeLanguage, vs...))
+}
+
+// ScopeLanguageNotIn applies the NotIn predicate on the "scope_language" field.
+func ScopeLanguageNotIn(vs ...string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotIn(FieldScopeLanguage, vs...))
+}
+
+// ScopeLanguageGT applies the GT predicate on the "scope_language" field.
+func ScopeLanguageGT(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGT(FieldScopeLanguage, v))
+}
+
+// ScopeLanguageGTE applies the GTE predicate on the "scope_language" field.
+func ScopeLanguageGTE(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGTE(FieldScopeLanguage, v))
+}
+
+// ScopeLanguageLT applies the LT predicate on the "scope_language" field.
+func ScopeLanguageLT(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLT(FieldScopeLanguage, v))
+}
+
+// ScopeLanguageLTE applies the LTE predicate on the "scope_language" field.
+func ScopeLanguageLTE(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLTE(FieldScopeLanguage, v))
+}
+
+// ScopeLanguageContains applies the Contains predicate on the "scope_language" field.
+func ScopeLanguageContains(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldContains(FieldScopeLanguage, v))
+}
+
+// ScopeLanguageHasPrefix applies the HasPrefix predicate on the "scope_language" field.
+func ScopeLanguageHasPrefix(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldHasPrefix(FieldScopeLanguage, v))
+}
+
+// ScopeLanguageHasSuffix applies the HasSuffix predicate on the "scope_language" field.
+func ScopeLanguageHasSuffix(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldHasSuffix(FieldScopeLanguage, v))
+}
+
+// ScopeLanguageEqualFold applies the EqualFold predicate on the "scope_language" field.
+func ScopeLanguageEqualFold(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEqualFold(FieldScopeLanguage, v))
+}
+
+// ScopeLanguageContainsFold applies the ContainsFold predicate on the "scope_language" field.
+func ScopeLanguageContainsFold(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldContainsFold(FieldScopeLanguage, v))
+}
+
+// DryRunEQ applies the EQ predicate on the "dry_run" field.
+func DryRunEQ(v bool) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldDryRun, v))
+}
+
+// DryRunNEQ applies the NEQ predicate on the "dry_run" field.
+func DryRunNEQ(v bool) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNEQ(FieldDryRun, v))
+}
+
+// RequestedByEQ applies the EQ predicate on the "requested_by" field.
+func RequestedByEQ(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// RequestedByNEQ applies the NEQ predicate on the "requested_by" field.
+func RequestedByNEQ(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNEQ(FieldRequestedBy, v))
+}
+
+// RequestedByIn applies the In predicate on the "requested_by" field.
+func RequestedByIn(vs ...string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByNotIn applies the NotIn predicate on the "requested_by" field.
+func RequestedByNotIn(vs ...string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByGT applies the GT predicate on the "requested_by" field.
+func RequestedByGT(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGT(FieldRequestedBy, v))
+}
+
+// RequestedByGTE applies the GTE predicate on the "requested_by" field.
+func RequestedByGTE(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGTE(FieldRequestedBy, v))
+}
+
+// RequestedByLT applies the LT predicate on the "requested_by" field.
+func RequestedByLT(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLT(FieldRequestedBy, v))
+}
+
+// RequestedByLTE applies the LTE predicate on the "requested_by" field.
+func RequestedByLTE(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLTE(FieldRequestedBy, v))
+}
+
+// RequestedByContains applies the Contains predicate on the "requested_by" field.
+func RequestedByContains(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldContains(FieldRequestedBy, v))
+}
+
+// RequestedByHasPrefix applies the HasPrefix predicate on the "requested_by" field.
+func RequestedByHasPrefix(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldHasPrefix(FieldRequestedBy, v))
+}
+
+// RequestedByHasSuffix applies the HasSuffix predicate on the "requested_by" field.
+func RequestedByHasSuffix(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldHasSuffix(FieldRequestedBy, v))
+}
+
+// RequestedByEqualFold applies the EqualFold predicate on the "requested_by" field.
+func RequestedByEqualFold(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEqualFold(FieldRequestedBy, v))
+}
+
+// RequestedByContainsFold applies the ContainsFold predicate on the "requested_by" field.
+func RequestedByContainsFold(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldContainsFold(FieldRequestedBy, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLTE(FieldStatus, v))
+}
+
+// EpisodesScannedEQ applies the EQ predicate on the "episodes_scanned" field.
+func EpisodesScannedEQ(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldEpisodesScanned, v))
+}
+
+// EpisodesScannedNEQ applies the NEQ predicate on the "episodes_scanned" field.
+func EpisodesScannedNEQ(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNEQ(FieldEpisodesScanned, v))
+}
+
+// EpisodesScannedIn applies the In predicate on the "episodes_scanned" field.
+func EpisodesScannedIn(vs ...int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIn(FieldEpisodesScanned, vs...))
+}
+
+// EpisodesScannedNotIn applies the NotIn predicate on the "episodes_scanned" field.
+func EpisodesScannedNotIn(vs ...int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotIn(FieldEpisodesScanned, vs...))
+}
+
+// EpisodesScannedGT applies the GT predicate on the "episodes_scanned" field.
+func EpisodesScannedGT(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGT(FieldEpisodesScanned, v))
+}
+
+// EpisodesScannedGTE applies the GTE predicate on the "episodes_scanned" field.
+func EpisodesScannedGTE(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGTE(FieldEpisodesScanned, v))
+}
+
+// EpisodesScannedLT applies the LT predicate on the "episodes_scanned" field.
+func EpisodesScannedLT(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLT(FieldEpisodesScanned, v))
+}
+
+// EpisodesScannedLTE applies the LTE predicate on the "episodes_scanned" field.
+func EpisodesScannedLTE(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLTE(FieldEpisodesScanned, v))
+}
+
+// EpisodesChangedEQ applies the EQ predicate on the "episodes_changed" field.
+func EpisodesChangedEQ(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldEpisodesChanged, v))
+}
+
+// EpisodesChangedNEQ applies the NEQ predicate on the "episodes_changed" field.
+func EpisodesChangedNEQ(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNEQ(FieldEpisodesChanged, v))
+}
+
+// EpisodesChangedIn applies the In predicate on the "episodes_changed" field.
+func EpisodesChangedIn(vs ...int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIn(FieldEpisodesChanged, vs...))
+}
+
+// EpisodesChangedNotIn applies the NotIn predicate on the "episodes_changed" field.
+func EpisodesChangedNotIn(vs ...int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotIn(FieldEpisodesChanged, vs...))
+}
+
+// EpisodesChangedGT applies the GT predicate on the "episodes_changed" field.
+func EpisodesChangedGT(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGT(FieldEpisodesChanged, v))
+}
+
+// EpisodesChangedGTE applies the GTE predicate on the "episodes_changed" field.
+func EpisodesChangedGTE(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGTE(FieldEpisodesChanged, v))
+}
+
+// EpisodesChangedLT applies the LT predicate on the "episodes_changed" field.
+func EpisodesChangedLT(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLT(FieldEpisodesChanged, v))
+}
+
+// EpisodesChangedLTE applies the LTE predicate on the "episodes_changed" field.
+func EpisodesChangedLTE(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLTE(FieldEpisodesChanged, v))
+}
+
+// MatchesEQ applies the EQ predicate on the "matches" field.
+func MatchesEQ(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldMatches, v))
+}
+
+// MatchesNEQ applies the NEQ predicate on the "matches" field.
+func MatchesNEQ(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNEQ(FieldMatches, v))
+}
+
+// MatchesIn applies the In predicate on the "matches" field.
+func MatchesIn(vs ...int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIn(FieldMatches, vs...))
+}
+
+// MatchesNotIn applies the NotIn predicate on the "matches" field.
+func MatchesNotIn(vs ...int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotIn(FieldMatches, vs...))
+}
+
+// MatchesGT applies the GT predicate on the "matches" field.
+func MatchesGT(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGT(FieldMatches, v))
+}
+
+// MatchesGTE applies the GTE predicate on the "matches" field.
+func MatchesGTE(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGTE(FieldMatches, v))
+}
+
+// MatchesLT applies the LT predicate on the "matches" field.
+func MatchesLT(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLT(FieldMatches, v))
+}
+
+// MatchesLTE applies the LTE predicate on the "matches" field.
+func MatchesLTE(v int) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLTE(FieldMatches, v))
+}
+
+// ChangesIsNil applies the IsNil predicate on the "changes" field.
+func ChangesIsNil() predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIsNull(FieldChanges))
+}
+
+// ChangesNotNil applies the NotNil predicate on the "changes" field.
+func ChangesNotNil() predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotNull(FieldChanges))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldContainsFold(FieldError, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotNull(FieldStartedAt))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// RolledBackAtEQ applies the EQ predicate on the "rolled_back_at" field.
+func RolledBackAtEQ(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldEQ(FieldRolledBackAt, v))
+}
+
+// RolledBackAtNEQ applies the NEQ predicate on the "rolled_back_at" field.
+func RolledBackAtNEQ(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNEQ(FieldRolledBackAt, v))
+}
+
+// RolledBackAtIn applies the In predicate on the "rolled_back_at" field.
+func RolledBackAtIn(vs ...time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIn(FieldRolledBackAt, vs...))
+}
+
+// RolledBackAtNotIn applies the NotIn predicate on the "rolled_back_at" field.
+func RolledBackAtNotIn(vs ...time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotIn(FieldRolledBackAt, vs...))
+}
+
+// RolledBackAtGT applies the GT predicate on the "rolled_back_at" field.
+func RolledBackAtGT(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGT(FieldRolledBackAt, v))
+}
+
+// RolledBackAtGTE applies the GTE predicate on the "rolled_back_at" field.
+func RolledBackAtGTE(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldGTE(FieldRolledBackAt, v))
+}
+
+// RolledBackAtLT applies the LT predicate on the "rolled_back_at" field.
+func RolledBackAtLT(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLT(FieldRolledBackAt, v))
+}
+
+// RolledBackAtLTE applies the LTE predicate on the "rolled_back_at" field.
+func RolledBackAtLTE(v time.Time) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldLTE(FieldRolledBackAt, v))
+}
+
+// RolledBackAtIsNil applies the IsNil predicate on the "rolled_back_at" field.
+func RolledBackAtIsNil() predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldIsNull(FieldRolledBackAt))
+}
+
+// RolledBackAtNotNil applies the NotNil predicate on the "rolled_back_at" field.
+func RolledBackAtNotNil() predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.FieldNotNull(FieldRolledBackAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TranscriptReplaceJob) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TranscriptReplaceJob) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TranscriptReplaceJob) predicate.TranscriptReplaceJob {
+	return predicate.TranscriptReplaceJob(sql.NotPredicates(p))
+}
@@ -0,0 +1,591 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/transcriptreplacejob"
+	"github.com/eslsoft/lession/internal/core"
+	"github.com/google/uuid"
+)
+
+// TranscriptReplaceJobCreate is the builder for creating a TranscriptReplaceJob entity.
+type TranscriptReplaceJobCreate struct {
+	config
+	mutation *TranscriptReplaceJobMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TranscriptReplaceJobCreate) SetCreatedAt(v time.Time) *TranscriptReplaceJobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetPattern sets the "pattern" field.
+func (_c *TranscriptReplaceJobCreate) SetPattern(v string) *TranscriptReplaceJobCreate {
+	_c.mutation.SetPattern(v)
+	return _c
+}
+
+// SetReplacement sets the "replacement" field.
+func (_c *TranscriptReplaceJobCreate) SetReplacement(v string) *TranscriptReplaceJobCreate {
+	_c.mutation.SetReplacement(v)
+	return _c
+}
+
+// SetNillableReplacement sets the "replacement" field if the given value is not nil.
+func (_c *TranscriptReplaceJobCreate) SetNillableReplacement(v *string) *TranscriptReplaceJobCreate {
+	if v != nil {
+		_c.SetReplacement(*v)
+	}
+	return _c
+}
+
+// SetRegex sets the "regex" field.
+func (_c *TranscriptReplaceJobCreate) SetRegex(v bool) *TranscriptReplaceJobCreate {
+	_c.mutation.SetRegex(v)
+	return _c
+}
+
+// SetNillableRegex sets the "regex" field if the given value is not nil.
+func (_c *TranscriptReplaceJobCreate) SetNillableRegex(v *bool) *TranscriptReplaceJobCreate {
+	if v != nil {
+		_c.SetRegex(*v)
+	}
+	return _c
+}
+
+// SetCaseInsensitive sets the "case_insensitive" field.
+func (_c *TranscriptReplaceJobCreate) SetCaseInsensitive(v bool) *TranscriptReplaceJobCreate {
+	_c.mutation.SetCaseInsensitive(v)
+	return _c
+}
+
+// SetNillableCaseInsensitive sets the "case_insensitive" field if the given value is not nil.
+func (_c *TranscriptReplaceJobCreate) SetNillableCaseInsensitive(v *bool) *TranscriptReplaceJobCreate {
+	if v != nil {
+		_c.SetCaseInsensitive(*v)
+	}
+	return _c
+}
+
+// SetScopeSeriesID sets the "scope_series_id" field.
+func (_c *TranscriptReplaceJobCreate) SetScopeSeriesID(v uuid.UUID) *TranscriptReplaceJobCreate {
+	_c.mutation.SetScopeSeriesID(v)
+	return _c
+}
+
+// SetNillableScopeSeriesID sets the "scope_series_id" field if the given value is not nil.
+func (_c *TranscriptReplaceJobCreate) SetNillableScopeSeriesID(v *uuid.UUID) *TranscriptReplaceJobCreate {
+	if v != nil {
+		_c.SetScopeSeriesID(*v)
+	}
+	return _c
+}
+
+// SetScopeLanguage sets the "scope_language" field.
+func (_c *TranscriptReplaceJobCreate) SetScopeLanguage(v string) *TranscriptReplaceJobCreate {
+	_c.mutation.SetScopeLanguage(v)
+	return _c
+}
+
+// SetNillableScopeLanguage sets the "scope_language" field if the given value is not nil.
+func (_c *TranscriptReplaceJobCreate) SetNillableScopeLanguage(v *string) *TranscriptReplaceJobCreate {
+	if v != nil {
+		_c.SetScopeLanguage(*v)
+	}
+	return _c
+}
+
+// SetDryRun sets the "dry_run" field.
+func (_c *TranscriptReplaceJobCreate) SetDryRun(v bool) *TranscriptReplaceJobCreate {
+	_c.mutation.SetDryRun(v)
+	return _c
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (_c *TranscriptReplaceJobCreate) SetNillableDryRun(v *bool) *TranscriptReplaceJobCreate {
+	if v != nil {
+		_c.SetDryRun(*v)
+	}
+	return _c
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (_c *TranscriptReplaceJobCreate) SetRequestedBy(v string) *TranscriptReplaceJobCreate {
+	_c.mutation.SetRequestedBy(v)
+	return _c
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (_c *TranscriptReplaceJobCreate) SetNillableRequestedBy(v *string) *TranscriptReplaceJobCreate {
+	if v != nil {
+		_c.SetRequestedBy(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *TranscriptReplaceJobCreate) SetStatus(v int) *TranscriptReplaceJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *TranscriptReplaceJobCreate) SetNillableStatus(v *int) *TranscriptReplaceJobCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetEpisodesScanned sets the "episodes_scanned" field.
+func (_c *TranscriptReplaceJobCreate) SetEpisodesScanned(v int) *TranscriptReplaceJobCreate {
+	_c.mutation.SetEpisodesScanned(v)
+	return _c
+}
+
+// SetNillableEpisodesScanned sets the "episodes_scanned" field if the given value is not nil.
+func (_c *TranscriptReplaceJobCreate) SetNillableEpisodesScanned(v *int) *TranscriptReplaceJobCreate {
+	if v != nil {
+		_c.SetEpisodesScanned(*v)
+	}
+	return _c
+}
+
+// SetEpisodesChanged sets the "episodes_changed" field.
+func (_c *TranscriptReplaceJobCreate) SetEpisodesChanged(v int) *TranscriptReplaceJobCreate {
+	_c.mutation.SetEpisodesChanged(v)
+	return _c
+}
+
+// SetNillableEpisodesChanged sets the "episodes_changed" field if the given value is not nil.
+func (_c *TranscriptReplaceJobCreate) SetNillableEpisodesChanged(v *int) *TranscriptReplaceJobCreate {
+	if v != nil {
+		_c.SetEpisodesChanged(*v)
+	}
+	return _c
+}
+
+// SetMatches sets the "matches" field.
+func (_c *TranscriptReplaceJobCreate) SetMatches(v int) *TranscriptReplaceJobCreate {
+	_c.mutation.SetMatches(v)
+	return _c
+}
+
+// SetNillableMatches sets the "matches" field if the given value is not nil.
+func (_c *TranscriptReplaceJobCreate) SetNillableMatches(v *int) *TranscriptReplaceJobCreate {
+	if v != nil {
+		_c.SetMatches(*v)
+	}
+	return _c
+}
+
+// SetChanges sets the "changes" field.
+func (_c *TranscriptReplaceJobCreate) SetChanges(v []core.TranscriptChange) *TranscriptReplaceJobCreate {
+	_c.mutation.SetChanges(v)
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *TranscriptReplaceJobCreate) SetError(v string) *TranscriptReplaceJobCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *TranscriptReplaceJobCreate) SetNillableError(v *string) *TranscriptReplaceJobCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *TranscriptReplaceJobCreate) SetStartedAt(v time.Time) *TranscriptReplaceJobCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *TranscriptReplaceJobCreate) SetNillableStartedAt(v *time.Time) *TranscriptReplaceJobCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *TranscriptReplaceJobCreate) SetFinishedAt(v time.Time) *TranscriptReplaceJobCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *TranscriptReplaceJobCreate) SetNillableFinishedAt(v *time.Time) *TranscriptReplaceJobCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetRolledBackAt sets the "rolled_back_at" field.
+func (_c *TranscriptReplaceJobCreate) SetRolledBackAt(v time.Time) *TranscriptReplaceJobCreate {
+	_c.mutation.SetRolledBackAt(v)
+	return _c
+}
+
+// SetNillableRolledBackAt sets the "rolled_back_at" field if the given value is not nil.
+func (_c *TranscriptReplaceJobCreate) SetNillableRolledBackAt(v *time.Time) *TranscriptReplaceJobCreate {
+	if v != nil {
+		_c.SetRolledBackAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TranscriptReplaceJobCreate) SetID(v uuid.UUID) *TranscriptReplaceJobCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TranscriptReplaceJobCreate) SetNillableID(v *uuid.UUID) *TranscriptReplaceJobCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TranscriptReplaceJobMutation object of the builder.
+func (_c *TranscriptReplaceJobCreate) Mutation() *TranscriptReplaceJobMutation {
+	return _c.mutation
+}
+
+// Save creates the TranscriptReplaceJob in the database.
+func (_c *TranscriptReplaceJobCreate) Save(ctx context.Context) (*TranscriptReplaceJob, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TranscriptReplaceJobCreate) SaveX(ctx context.Context) *TranscriptReplaceJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TranscriptReplaceJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TranscriptReplaceJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TranscriptReplaceJobCreate) defaults() error {
+	if _, ok := _c.mutation.Replacement(); !ok {
+		v := transcriptreplacejob.DefaultReplacement
+		_c.mutation.SetReplacement(v)
+	}
+	if _, ok := _c.mutation.Regex(); !ok {
+		v := transcriptreplacejob.DefaultRegex
+		_c.mutation.SetRegex(v)
+	}
+	if _, ok := _c.mutation.CaseInsensitive(); !ok {
+		v := transcriptreplacejob.DefaultCaseInsensitive
+		_c.mutation.SetCaseInsensitive(v)
+	}
+	if _, ok := _c.mutation.ScopeLanguage(); !ok {
+		v := transcriptreplacejob.DefaultScopeLanguage
+		_c.mutation.SetScopeLanguage(v)
+	}
+	if _, ok := _c.mutation.DryRun(); !ok {
+		v := transcriptreplacejob.DefaultDryRun
+		_c.mutation.SetDryRun(v)
+	}
+	if _, ok := _c.mutation.RequestedBy(); !ok {
+		v := transcriptreplacejob.DefaultRequestedBy
+		_c.mutation.SetRequestedBy(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := transcriptreplacejob.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.EpisodesScanned(); !ok {
+		v := transcriptreplacejob.DefaultEpisodesScanned
+		_c.mutation.SetEpisodesScanned(v)
+	}
+	if _, ok := _c.mutation.EpisodesChanged(); !ok {
+		v := transcriptreplacejob.DefaultEpisodesChanged
+		_c.mutation.SetEpisodesChanged(v)
+	}
+	if _, ok := _c.mutation.Matches(); !ok {
+		v := transcriptreplacejob.DefaultMatches
+		_c.mutation.SetMatches(v)
+	}
+	if _, ok := _c.mutation.Error(); !ok {
+		v := transcriptreplacejob.DefaultError
+		_c.mutation.SetError(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if transcriptreplacejob.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized transcriptreplacejob.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := transcriptreplacejob.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TranscriptReplaceJobCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "TranscriptReplaceJob.created_at"`)}
+	}
+	if _, ok := _c.mutation.Pattern(); !ok {
+		return &ValidationError{Name: "pattern", err: errors.New(`generated: missing required field "TranscriptReplaceJob.pattern"`)}
+	}
+	if _, ok := _c.mutation.Replacement(); !ok {
+		return &ValidationError{Name: "replacement", err: errors.New(`generated: missing required field "TranscriptReplaceJob.replacement"`)}
+	}
+	if _, ok := _c.mutation.Regex(); !ok {
+		return &ValidationError{Name: "regex", err: errors.New(`generated: missing required field "TranscriptReplaceJob.regex"`)}
+	}
+	if _, ok := _c.mutation.CaseInsensitive(); !ok {
+		return &ValidationError{Name: "case_insensitive", err: errors.New(`generated: missing required field "TranscriptReplaceJob.case_insensitive"`)}
+	}
+	if _, ok := _c.mutation.ScopeLanguage(); !ok {
+		return &ValidationError{Name: "scope_language", err: errors.New(`generated: missing required field "TranscriptReplaceJob.scope_language"`)}
+	}
+	if _, ok := _c.mutation.DryRun(); !ok {
+		return &ValidationError{Name: "dry_run", err: errors.New(`generated: missing required field "TranscriptReplaceJob.dry_run"`)}
+	}
+	if _, ok := _c.mutation.RequestedBy(); !ok {
+		return &ValidationError{Name: "requested_by", err: errors.New(`generated: missing required field "TranscriptReplaceJob.requested_by"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`generated: missing required field "TranscriptReplaceJob.status"`)}
+	}
+	if _, ok := _c.mutation.EpisodesScanned(); !ok {
+		return &ValidationError{Name: "episodes_scanned", err: errors.New(`generated: missing required field "TranscriptReplaceJob.episodes_scanned"`)}
+	}
+	if _, ok := _c.mutation.EpisodesChanged(); !ok {
+		return &ValidationError{Name: "episodes_changed", err: errors.New(`generated: missing required field "TranscriptReplaceJob.episodes_changed"`)}
+	}
+	if _, ok := _c.mutation.Matches(); !ok {
+		return &ValidationError{Name: "matches", err: errors.New(`generated: missing required field "TranscriptReplaceJob.matches"`)}
+	}
+	if _, ok := _c.mutation.Error(); !ok {
+		return &ValidationError{Name: "error", err: errors.New(`generated: missing required field "TranscriptReplaceJob.error"`)}
+	}
+	return nil
+}
+
+func (_c *TranscriptReplaceJobCreate) sqlSave(ctx context.Context) (*TranscriptReplaceJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TranscriptReplaceJobCreate) createSpec() (*TranscriptReplaceJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TranscriptReplaceJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(transcriptreplacejob.Table, sqlgraph.NewFieldSpec(transcriptreplacejob.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(transcriptreplacejob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Pattern(); ok {
+		_spec.SetField(transcriptreplacejob.FieldPattern, field.TypeString, value)
+		_node.Pattern = value
+	}
+	if value, ok := _c.mutation.Replacement(); ok {
+		_spec.SetField(transcriptreplacejob.FieldReplacement, field.TypeString, value)
+		_node.Replacement = value
+	}
+	if value, ok := _c.mutation.Regex(); ok {
+		_spec.SetField(transcriptreplacejob.FieldRegex, field.TypeBool, value)
+		_node.Regex = value
+	}
+	if value, ok := _c.mutation.CaseInsensitive(); ok {
+		_spec.SetField(transcriptreplacejob.FieldCaseInsensitive, field.TypeBool, value)
+		_node.CaseInsensitive = value
+	}
+	if value, ok := _c.mutation.ScopeSeriesID(); ok {
+		_spec.SetField(transcriptreplacejob.FieldScopeSeriesID, field.TypeUUID, value)
+		_node.ScopeSeriesID = &value
+	}
+	if value, ok := _c.mutation.ScopeLanguage(); ok {
+		_spec.SetField(transcriptreplacejob.FieldScopeLanguage, field.TypeString, value)
+		_node.ScopeLanguage = value
+	}
+	if value, ok := _c.mutation.DryRun(); ok {
+		_spec.SetField(transcriptreplacejob.FieldDryRun, field.TypeBool, value)
+		_node.DryRun = value
+	}
+	if value, ok := _c.mutation.RequestedBy(); ok {
+		_spec.SetField(transcriptreplacejob.FieldRequestedBy, field.TypeString, value)
+		_node.RequestedBy = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(transcriptreplacejob.FieldStatus, field.TypeInt, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.EpisodesScanned(); ok {
+		_spec.SetField(transcriptreplacejob.FieldEpisodesScanned, field.TypeInt, value)
+		_node.EpisodesScanned = value
+	}
+	if value, ok := _c.mutation.EpisodesChanged(); ok {
+		_spec.SetField(transcriptreplacejob.FieldEpisodesChanged, field.TypeInt, value)
+		_node.EpisodesChanged = value
+	}
+	if value, ok := _c.mutation.Matches(); ok {
+		_spec.SetField(transcriptreplacejob.FieldMatches, field.TypeInt, value)
+		_node.Matches = value
+	}
+	if value, ok := _c.mutation.Changes(); ok {
+		_spec.SetField(transcriptreplacejob.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(transcriptreplacejob.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(transcriptreplacejob.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(transcriptreplacejob.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if value, ok := _c.mutation.RolledBackAt(); ok {
+		_spec.SetField(transcriptreplacejob.FieldRolledBackAt, field.TypeTime, value)
+		_node.RolledBackAt = &value
+	}
+	return _node, _spec
+}
+
+// TranscriptReplaceJobCreateBulk is the builder for creating many TranscriptReplaceJob entities in bulk.
+type TranscriptReplaceJobCreateBulk struct {
+	config
+	err      error
+	builders []*TranscriptReplaceJobCreate
+}
+
+// Save creates the TranscriptReplaceJob entities in the database.
+func (_c *TranscriptReplaceJobCreateBulk) Save(ctx context.Context) ([]*TranscriptReplaceJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TranscriptReplaceJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TranscriptReplaceJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TranscriptReplaceJobCreateBulk) SaveX(ctx context.Context) []*TranscriptReplaceJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TranscriptReplaceJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TranscriptReplaceJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
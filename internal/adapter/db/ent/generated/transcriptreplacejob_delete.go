@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/transcriptreplacejob"
+)
+
+// TranscriptReplaceJobDelete is the builder for deleting a TranscriptReplaceJob entity.
+type TranscriptReplaceJobDelete struct {
+	config
+	hooks    []Hook
+	mutation *TranscriptReplaceJobMutation
+}
+
+// Where appends a list predicates to the TranscriptReplaceJobDelete builder.
+func (_d *TranscriptReplaceJobDelete) Where(ps ...predicate.TranscriptReplaceJob) *TranscriptReplaceJobDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *TranscriptReplaceJobDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TranscriptReplaceJobDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *TranscriptReplaceJobDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(transcriptreplacejob.Table, sqlgraph.NewFieldSpec(transcriptreplacejob.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// TranscriptReplaceJobDeleteOne is the builder for deleting a single TranscriptReplaceJob entity.
+type TranscriptReplaceJobDeleteOne struct {
+	_d *TranscriptReplaceJobDelete
+}
+
+// Where appends a list predicates to the TranscriptReplaceJobDelete builder.
+func (_d *TranscriptReplaceJobDeleteOne) Where(ps ...predicate.TranscriptReplaceJob) *TranscriptReplaceJobDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *TranscriptReplaceJobDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{transcriptreplacejob.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TranscriptReplaceJobDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,933 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/transcriptreplacejob"
+	"github.com/eslsoft/lession/internal/core"
+	"github.com/google/uuid"
+)
+
+// TranscriptReplaceJobUpdate is the builder for updating TranscriptReplaceJob entities.
+type TranscriptReplaceJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TranscriptReplaceJobMutation
+}
+
+// Where appends a list predicates to the TranscriptReplaceJobUpdate builder.
+func (_u *TranscriptReplaceJobUpdate) Where(ps ...predicate.TranscriptReplaceJob) *TranscriptReplaceJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPattern sets the "pattern" field.
+func (_u *TranscriptReplaceJobUpdate) SetPattern(v string) *TranscriptReplaceJobUpdate {
+	_u.mutation.SetPattern(v)
+	return _u
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdate) SetNillablePattern(v *string) *TranscriptReplaceJobUpdate {
+	if v != nil {
+		_u.SetPattern(*v)
+	}
+	return _u
+}
+
+// SetReplacement sets the "replacement" field.
+func (_u *TranscriptReplaceJobUpdate) SetReplacement(v string) *TranscriptReplaceJobUpdate {
+	_u.mutation.SetReplacement(v)
+	return _u
+}
+
+// SetNillableReplacement sets the "replacement" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdate) SetNillableReplacement(v *string) *TranscriptReplaceJobUpdate {
+	if v != nil {
+		_u.SetReplacement(*v)
+	}
+	return _u
+}
+
+// SetRegex sets the "regex" field.
+func (_u *TranscriptReplaceJobUpdate) SetRegex(v bool) *TranscriptReplaceJobUpdate {
+	_u.mutation.SetRegex(v)
+	return _u
+}
+
+// SetNillableRegex sets the "regex" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdate) SetNillableRegex(v *bool) *TranscriptReplaceJobUpdate {
+	if v != nil {
+		_u.SetRegex(*v)
+	}
+	return _u
+}
+
+// SetCaseInsensitive sets the "case_insensitive" field.
+func (_u *TranscriptReplaceJobUpdate) SetCaseInsensitive(v bool) *TranscriptReplaceJobUpdate {
+	_u.mutation.SetCaseInsensitive(v)
+	return _u
+}
+
+// SetNillableCaseInsensitive sets the "case_insensitive" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdate) SetNillableCaseInsensitive(v *bool) *TranscriptReplaceJobUpdate {
+	if v != nil {
+		_u.SetCaseInsensitive(*v)
+	}
+	return _u
+}
+
+// SetScopeSeriesID sets the "scope_series_id" field.
+func (_u *TranscriptReplaceJobUpdate) SetScopeSeriesID(v uuid.UUID) *TranscriptReplaceJobUpdate {
+	_u.mutation.SetScopeSeriesID(v)
+	return _u
+}
+
+// SetNillableScopeSeriesID sets the "scope_series_id" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdate) SetNillableScopeSeriesID(v *uuid.UUID) *TranscriptReplaceJobUpdate {
+	if v != nil {
+		_u.SetScopeSeriesID(*v)
+	}
+	return _u
+}
+
+// ClearScopeSeriesID clears the value of the "scope_series_id" field.
+func (_u *TranscriptReplaceJobUpdate) ClearScopeSeriesID() *TranscriptReplaceJobUpdate {
+	_u.mutation.ClearScopeSeriesID()
+	return _u
+}
+
+// SetScopeLanguage sets the "scope_language" field.
+func (_u *TranscriptReplaceJobUpdate) SetScopeLanguage(v string) *TranscriptReplaceJobUpdate {
+	_u.mutation.SetScopeLanguage(v)
+	return _u
+}
+
+// SetNillableScopeLanguage sets the "scope_language" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdate) SetNillableScopeLanguage(v *string) *TranscriptReplaceJobUpdate {
+	if v != nil {
+		_u.SetScopeLanguage(*v)
+	}
+	return _u
+}
+
+// SetDryRun sets the "dry_run" field.
+func (_u *TranscriptReplaceJobUpdate) SetDryRun(v bool) *TranscriptReplaceJobUpdate {
+	_u.mutation.SetDryRun(v)
+	return _u
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdate) SetNillableDryRun(v *bool) *TranscriptReplaceJobUpdate {
+	if v != nil {
+		_u.SetDryRun(*v)
+	}
+	return _u
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (_u *TranscriptReplaceJobUpdate) SetRequestedBy(v string) *TranscriptReplaceJobUpdate {
+	_u.mutation.SetRequestedBy(v)
+	return _u
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdate) SetNillableRequestedBy(v *string) *TranscriptReplaceJobUpdate {
+	if v != nil {
+		_u.SetRequestedBy(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TranscriptReplaceJobUpdate) SetStatus(v int) *TranscriptReplaceJobUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdate) SetNillableStatus(v *int) *TranscriptReplaceJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *TranscriptReplaceJobUpdate) AddStatus(v int) *TranscriptReplaceJobUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetEpisodesScanned sets the "episodes_scanned" field.
+func (_u *TranscriptReplaceJobUpdate) SetEpisodesScanned(v int) *TranscriptReplaceJobUpdate {
+	_u.mutation.ResetEpisodesScanned()
+	_u.mutation.SetEpisodesScanned(v)
+	return _u
+}
+
+// SetNillableEpisodesScanned sets the "episodes_scanned" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdate) SetNillableEpisodesScanned(v *int) *TranscriptReplaceJobUpdate {
+	if v != nil {
+		_u.SetEpisodesScanned(*v)
+	}
+	return _u
+}
+
+// AddEpisodesScanned adds value to the "episodes_scanned" field.
+func (_u *TranscriptReplaceJobUpdate) AddEpisodesScanned(v int) *TranscriptReplaceJobUpdate {
+	_u.mutation.AddEpisodesScanned(v)
+	return _u
+}
+
+// SetEpisodesChanged sets the "episodes_changed" field.
+func (_u *TranscriptReplaceJobUpdate) SetEpisodesChanged(v int) *TranscriptReplaceJobUpdate {
+	_u.mutation.ResetEpisodesChanged()
+	_u.mutation.SetEpisodesChanged(v)
+	return _u
+}
+
+// SetNillableEpisodesChanged sets the "episodes_changed" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdate) SetNillableEpisodesChanged(v *int) *TranscriptReplaceJobUpdate {
+	if v != nil {
+		_u.SetEpisodesChanged(*v)
+	}
+	return _u
+}
+
+// AddEpisodesChanged adds value to the "episodes_changed" field.
+func (_u *TranscriptReplaceJobUpdate) AddEpisodesChanged(v int) *TranscriptReplaceJobUpdate {
+	_u.mutation.AddEpisodesChanged(v)
+	return _u
+}
+
+// SetMatches sets the "matches" field.
+func (_u *TranscriptReplaceJobUpdate) SetMatches(v int) *TranscriptReplaceJobUpdate {
+	_u.mutation.ResetMatches()
+	_u.mutation.SetMatches(v)
+	return _u
+}
+
+// SetNillableMatches sets the "matches" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdate) SetNillableMatches(v *int) *TranscriptReplaceJobUpdate {
+	if v != nil {
+		_u.SetMatches(*v)
+	}
+	return _u
+}
+
+// AddMatches adds value to the "matches" field.
+func (_u *TranscriptReplaceJobUpdate) AddMatches(v int) *TranscriptReplaceJobUpdate {
+	_u.mutation.AddMatches(v)
+	return _u
+}
+
+// SetChanges sets the "changes" field.
+func (_u *TranscriptReplaceJobUpdate) SetChanges(v []core.TranscriptChange) *TranscriptReplaceJobUpdate {
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// AppendChanges appends value to the "changes" field.
+func (_u *TranscriptReplaceJobUpdate) AppendChanges(v []core.TranscriptChange) *TranscriptReplaceJobUpdate {
+	_u.mutation.AppendChanges(v)
+	return _u
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (_u *TranscriptReplaceJobUpdate) ClearChanges() *TranscriptReplaceJobUpdate {
+	_u.mutation.ClearChanges()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *TranscriptReplaceJobUpdate) SetError(v string) *TranscriptReplaceJobUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdate) SetNillableError(v *string) *TranscriptReplaceJobUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *TranscriptReplaceJobUpdate) SetStartedAt(v time.Time) *TranscriptReplaceJobUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdate) SetNillableStartedAt(v *time.Time) *TranscriptReplaceJobUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *TranscriptReplaceJobUpdate) ClearStartedAt() *TranscriptReplaceJobUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *TranscriptReplaceJobUpdate) SetFinishedAt(v time.Time) *TranscriptReplaceJobUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdate) SetNillableFinishedAt(v *time.Time) *TranscriptReplaceJobUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *TranscriptReplaceJobUpdate) ClearFinishedAt() *TranscriptReplaceJobUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetRolledBackAt sets the "rolled_back_at" field.
+func (_u *TranscriptReplaceJobUpdate) SetRolledBackAt(v time.Time) *TranscriptReplaceJobUpdate {
+	_u.mutation.SetRolledBackAt(v)
+	return _u
+}
+
+// SetNillableRolledBackAt sets the "rolled_back_at" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdate) SetNillableRolledBackAt(v *time.Time) *TranscriptReplaceJobUpdate {
+	if v != nil {
+		_u.SetRolledBackAt(*v)
+	}
+	return _u
+}
+
+// ClearRolledBackAt clears the value of the "rolled_back_at" field.
+func (_u *TranscriptReplaceJobUpdate) ClearRolledBackAt() *TranscriptReplaceJobUpdate {
+	_u.mutation.ClearRolledBackAt()
+	return _u
+}
+
+// Mutation returns the TranscriptReplaceJobMutation object of the builder.
+func (_u *TranscriptReplaceJobUpdate) Mutation() *TranscriptReplaceJobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TranscriptReplaceJobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TranscriptReplaceJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TranscriptReplaceJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TranscriptReplaceJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TranscriptReplaceJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(transcriptreplacejob.Table, transcriptreplacejob.Columns, sqlgraph.NewFieldSpec(transcriptreplacejob.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Pattern(); ok {
+		_spec.SetField(transcriptreplacejob.FieldPattern, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Replacement(); ok {
+		_spec.SetField(transcriptreplacejob.FieldReplacement, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Regex(); ok {
+		_spec.SetField(transcriptreplacejob.FieldRegex, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CaseInsensitive(); ok {
+		_spec.SetField(transcriptreplacejob.FieldCaseInsensitive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ScopeSeriesID(); ok {
+		_spec.SetField(transcriptreplacejob.FieldScopeSeriesID, field.TypeUUID, value)
+	}
+	if _u.mutation.ScopeSeriesIDCleared() {
+		_spec.ClearField(transcriptreplacejob.FieldScopeSeriesID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ScopeLanguage(); ok {
+		_spec.SetField(transcriptreplacejob.FieldScopeLanguage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DryRun(); ok {
+		_spec.SetField(transcriptreplacejob.FieldDryRun, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RequestedBy(); ok {
+		_spec.SetField(transcriptreplacejob.FieldRequestedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(transcriptreplacejob.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(transcriptreplacejob.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EpisodesScanned(); ok {
+		_spec.SetField(transcriptreplacejob.FieldEpisodesScanned, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEpisodesScanned(); ok {
+		_spec.AddField(transcriptreplacejob.FieldEpisodesScanned, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EpisodesChanged(); ok {
+		_spec.SetField(transcriptreplacejob.FieldEpisodesChanged, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEpisodesChanged(); ok {
+		_spec.AddField(transcriptreplacejob.FieldEpisodesChanged, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Matches(); ok {
+		_spec.SetField(transcriptreplacejob.FieldMatches, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMatches(); ok {
+		_spec.AddField(transcriptreplacejob.FieldMatches, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(transcriptreplacejob.FieldChanges, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChanges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, transcriptreplacejob.FieldChanges, value)
+		})
+	}
+	if _u.mutation.ChangesCleared() {
+		_spec.ClearField(transcriptreplacejob.FieldChanges, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(transcriptreplacejob.FieldError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(transcriptreplacejob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(transcriptreplacejob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(transcriptreplacejob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(transcriptreplacejob.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RolledBackAt(); ok {
+		_spec.SetField(transcriptreplacejob.FieldRolledBackAt, field.TypeTime, value)
+	}
+	if _u.mutation.RolledBackAtCleared() {
+		_spec.ClearField(transcriptreplacejob.FieldRolledBackAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transcriptreplacejob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TranscriptReplaceJobUpdateOne is the builder for updating a single TranscriptReplaceJob entity.
+type TranscriptReplaceJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TranscriptReplaceJobMutation
+}
+
+// SetPattern sets the "pattern" field.
+func (_u *TranscriptReplaceJobUpdateOne) SetPattern(v string) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.SetPattern(v)
+	return _u
+}
+
+// SetNillablePattern sets the "pattern" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdateOne) SetNillablePattern(v *string) *TranscriptReplaceJobUpdateOne {
+	if v != nil {
+		_u.SetPattern(*v)
+	}
+	return _u
+}
+
+// SetReplacement sets the "replacement" field.
+func (_u *TranscriptReplaceJobUpdateOne) SetReplacement(v string) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.SetReplacement(v)
+	return _u
+}
+
+// SetNillableReplacement sets the "replacement" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdateOne) SetNillableReplacement(v *string) *TranscriptReplaceJobUpdateOne {
+	if v != nil {
+		_u.SetReplacement(*v)
+	}
+	return _u
+}
+
+// SetRegex sets the "regex" field.
+func (_u *TranscriptReplaceJobUpdateOne) SetRegex(v bool) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.SetRegex(v)
+	return _u
+}
+
+// SetNillableRegex sets the "regex" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdateOne) SetNillableRegex(v *bool) *TranscriptReplaceJobUpdateOne {
+	if v != nil {
+		_u.SetRegex(*v)
+	}
+	return _u
+}
+
+// SetCaseInsensitive sets the "case_insensitive" field.
+func (_u *TranscriptReplaceJobUpdateOne) SetCaseInsensitive(v bool) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.SetCaseInsensitive(v)
+	return _u
+}
+
+// SetNillableCaseInsensitive sets the "case_insensitive" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdateOne) SetNillableCaseInsensitive(v *bool) *TranscriptReplaceJobUpdateOne {
+	if v != nil {
+		_u.SetCaseInsensitive(*v)
+	}
+	return _u
+}
+
+// SetScopeSeriesID sets the "scope_series_id" field.
+func (_u *TranscriptReplaceJobUpdateOne) SetScopeSeriesID(v uuid.UUID) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.SetScopeSeriesID(v)
+	return _u
+}
+
+// SetNillableScopeSeriesID sets the "scope_series_id" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdateOne) SetNillableScopeSeriesID(v *uuid.UUID) *TranscriptReplaceJobUpdateOne {
+	if v != nil {
+		_u.SetScopeSeriesID(*v)
+	}
+	return _u
+}
+
+// ClearScopeSeriesID clears the value of the "scope_series_id" field.
+func (_u *TranscriptReplaceJobUpdateOne) ClearScopeSeriesID() *TranscriptReplaceJobUpdateOne {
+	_u.mutation.ClearScopeSeriesID()
+	return _u
+}
+
+// SetScopeLanguage sets the "scope_language" field.
+func (_u *TranscriptReplaceJobUpdateOne) SetScopeLanguage(v string) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.SetScopeLanguage(v)
+	return _u
+}
+
+// SetNillableScopeLanguage sets the "scope_language" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdateOne) SetNillableScopeLanguage(v *string) *TranscriptReplaceJobUpdateOne {
+	if v != nil {
+		_u.SetScopeLanguage(*v)
+	}
+	return _u
+}
+
+// SetDryRun sets the "dry_run" field.
+func (_u *TranscriptReplaceJobUpdateOne) SetDryRun(v bool) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.SetDryRun(v)
+	return _u
+}
+
+// SetNillableDryRun sets the "dry_run" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdateOne) SetNillableDryRun(v *bool) *TranscriptReplaceJobUpdateOne {
+	if v != nil {
+		_u.SetDryRun(*v)
+	}
+	return _u
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (_u *TranscriptReplaceJobUpdateOne) SetRequestedBy(v string) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.SetRequestedBy(v)
+	return _u
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdateOne) SetNillableRequestedBy(v *string) *TranscriptReplaceJobUpdateOne {
+	if v != nil {
+		_u.SetRequestedBy(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TranscriptReplaceJobUpdateOne) SetStatus(v int) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdateOne) SetNillableStatus(v *int) *TranscriptReplaceJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *TranscriptReplaceJobUpdateOne) AddStatus(v int) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetEpisodesScanned sets the "episodes_scanned" field.
+func (_u *TranscriptReplaceJobUpdateOne) SetEpisodesScanned(v int) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.ResetEpisodesScanned()
+	_u.mutation.SetEpisodesScanned(v)
+	return _u
+}
+
+// SetNillableEpisodesScanned sets the "episodes_scanned" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdateOne) SetNillableEpisodesScanned(v *int) *TranscriptReplaceJobUpdateOne {
+	if v != nil {
+		_u.SetEpisodesScanned(*v)
+	}
+	return _u
+}
+
+// AddEpisodesScanned adds value to the "episodes_scanned" field.
+func (_u *TranscriptReplaceJobUpdateOne) AddEpisodesScanned(v int) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.AddEpisodesScanned(v)
+	return _u
+}
+
+// SetEpisodesChanged sets the "episodes_changed" field.
+func (_u *TranscriptReplaceJobUpdateOne) SetEpisodesChanged(v int) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.ResetEpisodesChanged()
+	_u.mutation.SetEpisodesChanged(v)
+	return _u
+}
+
+// SetNillableEpisodesChanged sets the "episodes_changed" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdateOne) SetNillableEpisodesChanged(v *int) *TranscriptReplaceJobUpdateOne {
+	if v != nil {
+		_u.SetEpisodesChanged(*v)
+	}
+	return _u
+}
+
+// AddEpisodesChanged adds value to the "episodes_changed" field.
+func (_u *TranscriptReplaceJobUpdateOne) AddEpisodesChanged(v int) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.AddEpisodesChanged(v)
+	return _u
+}
+
+// SetMatches sets the "matches" field.
+func (_u *TranscriptReplaceJobUpdateOne) SetMatches(v int) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.ResetMatches()
+	_u.mutation.SetMatches(v)
+	return _u
+}
+
+// SetNillableMatches sets the "matches" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdateOne) SetNillableMatches(v *int) *TranscriptReplaceJobUpdateOne {
+	if v != nil {
+		_u.SetMatches(*v)
+	}
+	return _u
+}
+
+// AddMatches adds value to the "matches" field.
+func (_u *TranscriptReplaceJobUpdateOne) AddMatches(v int) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.AddMatches(v)
+	return _u
+}
+
+// SetChanges sets the "changes" field.
+func (_u *TranscriptReplaceJobUpdateOne) SetChanges(v []core.TranscriptChange) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// AppendChanges appends value to the "changes" field.
+func (_u *TranscriptReplaceJobUpdateOne) AppendChanges(v []core.TranscriptChange) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.AppendChanges(v)
+	return _u
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (_u *TranscriptReplaceJobUpdateOne) ClearChanges() *TranscriptReplaceJobUpdateOne {
+	_u.mutation.ClearChanges()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *TranscriptReplaceJobUpdateOne) SetError(v string) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdateOne) SetNillableError(v *string) *TranscriptReplaceJobUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *TranscriptReplaceJobUpdateOne) SetStartedAt(v time.Time) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdateOne) SetNillableStartedAt(v *time.Time) *TranscriptReplaceJobUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *TranscriptReplaceJobUpdateOne) ClearStartedAt() *TranscriptReplaceJobUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *TranscriptReplaceJobUpdateOne) SetFinishedAt(v time.Time) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdateOne) SetNillableFinishedAt(v *time.Time) *TranscriptReplaceJobUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *TranscriptReplaceJobUpdateOne) ClearFinishedAt() *TranscriptReplaceJobUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetRolledBackAt sets the "rolled_back_at" field.
+func (_u *TranscriptReplaceJobUpdateOne) SetRolledBackAt(v time.Time) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.SetRolledBackAt(v)
+	return _u
+}
+
+// SetNillableRolledBackAt sets the "rolled_back_at" field if the given value is not nil.
+func (_u *TranscriptReplaceJobUpdateOne) SetNillableRolledBackAt(v *time.Time) *TranscriptReplaceJobUpdateOne {
+	if v != nil {
+		_u.SetRolledBackAt(*v)
+	}
+	return _u
+}
+
+// ClearRolledBackAt clears the value of the "rolled_back_at" field.
+func (_u *TranscriptReplaceJobUpdateOne) ClearRolledBackAt() *TranscriptReplaceJobUpdateOne {
+	_u.mutation.ClearRolledBackAt()
+	return _u
+}
+
+// Mutation returns the TranscriptReplaceJobMutation object of the builder.
+func (_u *TranscriptReplaceJobUpdateOne) Mutation() *TranscriptReplaceJobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TranscriptReplaceJobUpdate builder.
+func (_u *TranscriptReplaceJobUpdateOne) Where(ps ...predicate.TranscriptReplaceJob) *TranscriptReplaceJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TranscriptReplaceJobUpdateOne) Select(field string, fields ...string) *TranscriptReplaceJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TranscriptReplaceJob entity.
+func (_u *TranscriptReplaceJobUpdateOne) Save(ctx context.Context) (*TranscriptReplaceJob, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TranscriptReplaceJobUpdateOne) SaveX(ctx context.Context) *TranscriptReplaceJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TranscriptReplaceJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TranscriptReplaceJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TranscriptReplaceJobUpdateOne) sqlSave(ctx context.Context) (_node *TranscriptReplaceJob, err error) {
+	_spec := sqlgraph.NewUpdateSpec(transcriptreplacejob.Table, transcriptreplacejob.Columns, sqlgraph.NewFieldSpec(transcriptreplacejob.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "TranscriptReplaceJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transcriptreplacejob.FieldID)
+		for _, f := range fields {
+			if !transcriptreplacejob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != transcriptreplacejob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Pattern(); ok {
+		_spec.SetField(transcriptreplacejob.FieldPattern, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Replacement(); ok {
+		_spec.SetField(transcriptreplacejob.FieldReplacement, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Regex(); ok {
+		_spec.SetField(transcriptreplacejob.FieldRegex, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CaseInsensitive(); ok {
+		_spec.SetField(transcriptreplacejob.FieldCaseInsensitive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ScopeSeriesID(); ok {
+		_spec.SetField(transcriptreplacejob.FieldScopeSeriesID, field.TypeUUID, value)
+	}
+	if _u.mutation.ScopeSeriesIDCleared() {
+		_spec.ClearField(transcriptreplacejob.FieldScopeSeriesID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ScopeLanguage(); ok {
+		_spec.SetField(transcriptreplacejob.FieldScopeLanguage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DryRun(); ok {
+		_spec.SetField(transcriptreplacejob.FieldDryRun, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RequestedBy(); ok {
+		_spec.SetField(transcriptreplacejob.FieldRequestedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(transcriptreplacejob.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(transcriptreplacejob.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EpisodesScanned(); ok {
+		_spec.SetField(transcriptreplacejob.FieldEpisodesScanned, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEpisodesScanned(); ok {
+		_spec.AddField(transcriptreplacejob.FieldEpisodesScanned, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EpisodesChanged(); ok {
+		_spec.SetField(transcriptreplacejob.FieldEpisodesChanged, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEpisodesChanged(); ok {
+		_spec.AddField(transcriptreplacejob.FieldEpisodesChanged, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Matches(); ok {
+		_spec.SetField(transcriptreplacejob.FieldMatches, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMatches(); ok {
+		_spec.AddField(transcriptreplacejob.FieldMatches, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(transcriptreplacejob.FieldChanges, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChanges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, transcriptreplacejob.FieldChanges, value)
+		})
+	}
+	if _u.mutation.ChangesCleared() {
+		_spec.ClearField(transcriptreplacejob.FieldChanges, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(transcriptreplacejob.FieldError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(transcriptreplacejob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(transcriptreplacejob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(transcriptreplacejob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(transcriptreplacejob.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RolledBackAt(); ok {
+		_spec.SetField(transcriptreplacejob.FieldRolledBackAt, field.TypeTime, value)
+	}
+	if _u.mutation.RolledBackAtCleared() {
+		_spec.ClearField(transcriptreplacejob.FieldRolledBackAt, field.TypeTime)
+	}
+	_node = &TranscriptReplaceJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transcriptreplacejob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/transcriptrevision"
+	"github.com/google/uuid"
+)
+
+// TranscriptRevision is the model entity for the TranscriptRevision schema.
+type TranscriptRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// JobID holds the value of the "job_id" field.
+	JobID uuid.UUID `json:"job_id,omitempty"`
+	// EpisodeID holds the value of the "episode_id" field.
+	EpisodeID uuid.UUID `json:"episode_id,omitempty"`
+	// Before holds the value of the "before" field.
+	Before string `json:"before,omitempty"`
+	// After holds the value of the "after" field.
+	After string `json:"after,omitempty"`
+	// RolledBackAt holds the value of the "rolled_back_at" field.
+	RolledBackAt *time.Time `json:"rolled_back_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TranscriptRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case transcriptrevision.FieldBefore, transcriptrevision.FieldAfter:
+			values[i] = new(sql.NullString)
+		case transcriptrevision.FieldCreatedAt, transcriptrevision.FieldRolledBackAt:
+			values[i] = new(sql.NullTime)
+		case transcriptrevision.FieldID, transcriptrevision.FieldJobID, transcriptrevision.FieldEpisodeID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TranscriptRevision fields.
+func (_m *TranscriptRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case transcriptrevision.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case transcriptrevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case transcriptrevision.FieldJobID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field job_id", values[i])
+			} else if value != nil {
+				_m.JobID = *value
+			}
+		case transcriptrevision.FieldEpisodeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field episode_id", values[i])
+			} else if value != nil {
+				_m.EpisodeID = *value
+			}
+		case transcriptrevision.FieldBefore:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field before", values[i])
+			} else if value.Valid {
+				_m.Before = value.String
+			}
+		case transcriptrevision.FieldAfter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field after", values[i])
+			} else if value.Valid {
+				_m.After = value.String
+			}
+		case transcriptrevision.FieldRolledBackAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rolled_back_at", values[i])
+			} else if value.Valid {
+				_m.RolledBackAt = new(time.Time)
+				*_m.RolledBackAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TranscriptRevision.
+// This includes values selected through modifiers, order, etc.
+func (_m *TranscriptRevision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TranscriptRevision.
+// Note that you need to call TranscriptRevision.Unwrap() before calling this method if this TranscriptRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TranscriptRevision) Update() *TranscriptRevisionUpdateOne {
+	return NewTranscriptRevisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TranscriptRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TranscriptRevision) Unwrap() *TranscriptRevision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: TranscriptRevision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TranscriptRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("TranscriptRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("job_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JobID))
+	builder.WriteString(", ")
+	builder.WriteString("episode_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EpisodeID))
+	builder.WriteString(", ")
+	builder.WriteString("before=")
+	builder.WriteString(_m.Before)
+	builder.WriteString(", ")
+	builder.WriteString("after=")
+	builder.WriteString(_m.After)
+	builder.WriteString(", ")
+	if v := _m.RolledBackAt; v != nil {
+		builder.WriteString("rolled_back_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TranscriptRevisions is a parsable slice of TranscriptRevision.
+type TranscriptRevisions []*TranscriptRevision
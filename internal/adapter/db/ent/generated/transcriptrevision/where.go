@@ -0,0 +1,401 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transcriptrevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// JobID applies equality check predicate on the "job_id" field. It's identical to JobIDEQ.
+func JobID(v uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldEQ(FieldJobID, v))
+}
+
+// EpisodeID applies equality check predicate on the "episode_id" field. It's identical to EpisodeIDEQ.
+func EpisodeID(v uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldEQ(FieldEpisodeID, v))
+}
+
+// Before applies equality check predicate on the "before" field. It's identical to BeforeEQ.
+func Before(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldEQ(FieldBefore, v))
+}
+
+// After applies equality check predicate on the "after" field. It's identical to AfterEQ.
+func After(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldEQ(FieldAfter, v))
+}
+
+// RolledBackAt applies equality check predicate on the "rolled_back_at" field. It's identical to RolledBackAtEQ.
+func RolledBackAt(v time.Time) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldEQ(FieldRolledBackAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// JobIDEQ applies the EQ predicate on the "job_id" field.
+func JobIDEQ(v uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldEQ(FieldJobID, v))
+}
+
+// JobIDNEQ applies the NEQ predicate on the "job_id" field.
+func JobIDNEQ(v uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldNEQ(FieldJobID, v))
+}
+
+// JobIDIn applies the In predicate on the "job_id" field.
+func JobIDIn(vs ...uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldIn(FieldJobID, vs...))
+}
+
+// JobIDNotIn applies the NotIn predicate on the "job_id" field.
+func JobIDNotIn(vs ...uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldNotIn(FieldJobID, vs...))
+}
+
+// JobIDGT applies the GT predicate on the "job_id" field.
+func JobIDGT(v uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldGT(FieldJobID, v))
+}
+
+// JobIDGTE applies the GTE predicate on the "job_id" field.
+func JobIDGTE(v uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldGTE(FieldJobID, v))
+}
+
+// JobIDLT applies the LT predicate on the "job_id" field.
+func JobIDLT(v uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldLT(FieldJobID, v))
+}
+
+// JobIDLTE applies the LTE predicate on the "job_id" field.
+func JobIDLTE(v uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldLTE(FieldJobID, v))
+}
+
+// EpisodeIDEQ applies the EQ predicate on the "episode_id" field.
+func EpisodeIDEQ(v uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldEQ(FieldEpisodeID, v))
+}
+
+// EpisodeIDNEQ applies the NEQ predicate on the "episode_id" field.
+func EpisodeIDNEQ(v uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldNEQ(FieldEpisodeID, v))
+}
+
+// EpisodeIDIn applies the In predicate on the "episode_id" field.
+func EpisodeIDIn(vs ...uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldIn(FieldEpisodeID, vs...))
+}
+
+// EpisodeIDNotIn applies the NotIn predicate on the "episode_id" field.
+func EpisodeIDNotIn(vs ...uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldNotIn(FieldEpisodeID, vs...))
+}
+
+// EpisodeIDGT applies the GT predicate on the "episode_id" field.
+func EpisodeIDGT(v uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldGT(FieldEpisodeID, v))
+}
+
+// EpisodeIDGTE applies the GTE predicate on the "episode_id" field.
+func EpisodeIDGTE(v uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldGTE(FieldEpisodeID, v))
+}
+
+// EpisodeIDLT applies the LT predicate on the "episode_id" field.
+func EpisodeIDLT(v uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldLT(FieldEpisodeID, v))
+}
+
+// EpisodeIDLTE applies the LTE predicate on the "episode_id" field.
+func EpisodeIDLTE(v uuid.UUID) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldLTE(FieldEpisodeID, v))
+}
+
+// BeforeEQ applies the EQ predicate on the "before" field.
+func BeforeEQ(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldEQ(FieldBefore, v))
+}
+
+// BeforeNEQ applies the NEQ predicate on the "before" field.
+func BeforeNEQ(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldNEQ(FieldBefore, v))
+}
+
+// BeforeIn applies the In predicate on the "before" field.
+func BeforeIn(vs ...string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldIn(FieldBefore, vs...))
+}
+
+// BeforeNotIn applies the NotIn predicate on the "before" field.
+func BeforeNotIn(vs ...string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldNotIn(FieldBefore, vs...))
+}
+
+// BeforeGT applies the GT predicate on the "before" field.
+func BeforeGT(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldGT(FieldBefore, v))
+}
+
+// BeforeGTE applies the GTE predicate on the "before" field.
+func BeforeGTE(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldGTE(FieldBefore, v))
+}
+
+// BeforeLT applies the LT predicate on the "before" field.
+func BeforeLT(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldLT(FieldBefore, v))
+}
+
+// BeforeLTE applies the LTE predicate on the "before" field.
+func BeforeLTE(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldLTE(FieldBefore, v))
+}
+
+// BeforeContains applies the Contains predicate on the "before" field.
+func BeforeContains(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldContains(FieldBefore, v))
+}
+
+// BeforeHasPrefix applies the HasPrefix predicate on the "before" field.
+func BeforeHasPrefix(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldHasPrefix(FieldBefore, v))
+}
+
+// BeforeHasSuffix applies the HasSuffix predicate on the "before" field.
+func BeforeHasSuffix(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldHasSuffix(FieldBefore, v))
+}
+
+// BeforeEqualFold applies the EqualFold predicate on the "before" field.
+func BeforeEqualFold(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldEqualFold(FieldBefore, v))
+}
+
+// BeforeContainsFold applies the ContainsFold predicate on the "before" field.
+func BeforeContainsFold(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldContainsFold(FieldBefore, v))
+}
+
+// AfterEQ applies the EQ predicate on the "after" field.
+func AfterEQ(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldEQ(FieldAfter, v))
+}
+
+// AfterNEQ applies the NEQ predicate on the "after" field.
+func AfterNEQ(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldNEQ(FieldAfter, v))
+}
+
+// AfterIn applies the In predicate on the "after" field.
+func AfterIn(vs ...string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldIn(FieldAfter, vs...))
+}
+
+// AfterNotIn applies the NotIn predicate on the "after" field.
+func AfterNotIn(vs ...string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldNotIn(FieldAfter, vs...))
+}
+
+// AfterGT applies the GT predicate on the "after" field.
+func AfterGT(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldGT(FieldAfter, v))
+}
+
+// AfterGTE applies the GTE predicate on the "after" field.
+func AfterGTE(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldGTE(FieldAfter, v))
+}
+
+// AfterLT applies the LT predicate on the "after" field.
+func AfterLT(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldLT(FieldAfter, v))
+}
+
+// AfterLTE applies the LTE predicate on the "after" field.
+func AfterLTE(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldLTE(FieldAfter, v))
+}
+
+// AfterContains applies the Contains predicate on the "after" field.
+func AfterContains(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldContains(FieldAfter, v))
+}
+
+// AfterHasPrefix applies the HasPrefix predicate on the "after" field.
+func AfterHasPrefix(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldHasPrefix(FieldAfter, v))
+}
+
+// AfterHasSuffix applies the HasSuffix predicate on the "after" field.
+func AfterHasSuffix(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldHasSuffix(FieldAfter, v))
+}
+
+// AfterEqualFold applies the EqualFold predicate on the "after" field.
+func AfterEqualFold(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldEqualFold(FieldAfter, v))
+}
+
+// AfterContainsFold applies the ContainsFold predicate on the "after" field.
+func AfterContainsFold(v string) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldContainsFold(FieldAfter, v))
+}
+
+// RolledBackAtEQ applies the EQ predicate on the "rolled_back_at" field.
+func RolledBackAtEQ(v time.Time) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldEQ(FieldRolledBackAt, v))
+}
+
+// RolledBackAtNEQ applies the NEQ predicate on the "rolled_back_at" field.
+func RolledBackAtNEQ(v time.Time) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldNEQ(FieldRolledBackAt, v))
+}
+
+// RolledBackAtIn applies the In predicate on the "rolled_back_at" field.
+func RolledBackAtIn(vs ...time.Time) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldIn(FieldRolledBackAt, vs...))
+}
+
+// RolledBackAtNotIn applies the NotIn predicate on the "rolled_back_at" field.
+func RolledBackAtNotIn(vs ...time.Time) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldNotIn(FieldRolledBackAt, vs...))
+}
+
+// RolledBackAtGT applies the GT predicate on the "rolled_back_at" field.
+func RolledBackAtGT(v time.Time) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldGT(FieldRolledBackAt, v))
+}
+
+// RolledBackAtGTE applies the GTE predicate on the "rolled_back_at" field.
+func RolledBackAtGTE(v time.Time) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldGTE(FieldRolledBackAt, v))
+}
+
+// RolledBackAtLT applies the LT predicate on the "rolled_back_at" field.
+func RolledBackAtLT(v time.Time) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldLT(FieldRolledBackAt, v))
+}
+
+// RolledBackAtLTE applies the LTE predicate on the "rolled_back_at" field.
+func RolledBackAtLTE(v time.Time) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldLTE(FieldRolledBackAt, v))
+}
+
+// RolledBackAtIsNil applies the IsNil predicate on the "rolled_back_at" field.
+func RolledBackAtIsNil() predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldIsNull(FieldRolledBackAt))
+}
+
+// RolledBackAtNotNil applies the NotNil predicate on the "rolled_back_at" field.
+func RolledBackAtNotNil() predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.FieldNotNull(FieldRolledBackAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TranscriptRevision) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TranscriptRevision) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TranscriptRevision) predicate.TranscriptRevision {
+	return predicate.TranscriptRevision(sql.NotPredicates(p))
+}
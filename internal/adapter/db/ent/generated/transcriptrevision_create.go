@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/transcriptrevision"
+	"github.com/google/uuid"
+)
+
+// TranscriptRevisionCreate is the builder for creating a TranscriptRevision entity.
+type TranscriptRevisionCreate struct {
+	config
+	mutation *TranscriptRevisionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TranscriptRevisionCreate) SetCreatedAt(v time.Time) *TranscriptRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetJobID sets the "job_id" field.
+func (_c *TranscriptRevisionCreate) SetJobID(v uuid.UUID) *TranscriptRevisionCreate {
+	_c.mutation.SetJobID(v)
+	return _c
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_c *TranscriptRevisionCreate) SetEpisodeID(v uuid.UUID) *TranscriptRevisionCreate {
+	_c.mutation.SetEpisodeID(v)
+	return _c
+}
+
+// SetBefore sets the "before" field.
+func (_c *TranscriptRevisionCreate) SetBefore(v string) *TranscriptRevisionCreate {
+	_c.mutation.SetBefore(v)
+	return _c
+}
+
+// SetAfter sets the "after" field.
+func (_c *TranscriptRevisionCreate) SetAfter(v string) *TranscriptRevisionCreate {
+	_c.mutation.SetAfter(v)
+	return _c
+}
+
+// SetRolledBackAt sets the "rolled_back_at" field.
+func (_c *TranscriptRevisionCreate) SetRolledBackAt(v time.Time) *TranscriptRevisionCreate {
+	_c.mutation.SetRolledBackAt(v)
+	return _c
+}
+
+// SetNillableRolledBackAt sets the "rolled_back_at" field if the given value is not nil.
+func (_c *TranscriptRevisionCreate) SetNillableRolledBackAt(v *time.Time) *TranscriptRevisionCreate {
+	if v != nil {
+		_c.SetRolledBackAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TranscriptRevisionCreate) SetID(v uuid.UUID) *TranscriptRevisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TranscriptRevisionCreate) SetNillableID(v *uuid.UUID) *TranscriptRevisionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TranscriptRevisionMutation object of the builder.
+func (_c *TranscriptRevisionCreate) Mutation() *TranscriptRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the TranscriptRevision in the database.
+func (_c *TranscriptRevisionCreate) Save(ctx context.Context) (*TranscriptRevision, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TranscriptRevisionCreate) SaveX(ctx context.Context) *TranscriptRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TranscriptRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TranscriptRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TranscriptRevisionCreate) defaults() error {
+	if _, ok := _c.mutation.ID(); !ok {
+		if transcriptrevision.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized transcriptrevision.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := transcriptrevision.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TranscriptRevisionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "TranscriptRevision.created_at"`)}
+	}
+	if _, ok := _c.mutation.JobID(); !ok {
+		return &ValidationError{Name: "job_id", err: errors.New(`generated: missing required field "TranscriptRevision.job_id"`)}
+	}
+	if _, ok := _c.mutation.EpisodeID(); !ok {
+		return &ValidationError{Name: "episode_id", err: errors.New(`generated: missing required field "TranscriptRevision.episode_id"`)}
+	}
+	if _, ok := _c.mutation.Before(); !ok {
+		return &ValidationError{Name: "before", err: errors.New(`generated: missing required field "TranscriptRevision.before"`)}
+	}
+	if _, ok := _c.mutation.After(); !ok {
+		return &ValidationError{Name: "after", err: errors.New(`generated: missing required field "TranscriptRevision.after"`)}
+	}
+	return nil
+}
+
+func (_c *TranscriptRevisionCreate) sqlSave(ctx context.Context) (*TranscriptRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TranscriptRevisionCreate) createSpec() (*TranscriptRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TranscriptRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(transcriptrevision.Table, sqlgraph.NewFieldSpec(transcriptrevision.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(transcriptrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.JobID(); ok {
+		_spec.SetField(transcriptrevision.FieldJobID, field.TypeUUID, value)
+		_node.JobID = value
+	}
+	if value, ok := _c.mutation.EpisodeID(); ok {
+		_spec.SetField(transcriptrevision.FieldEpisodeID, field.TypeUUID, value)
+		_node.EpisodeID = value
+	}
+	if value, ok := _c.mutation.Before(); ok {
+		_spec.SetField(transcriptrevision.FieldBefore, field.TypeString, value)
+		_node.Before = value
+	}
+	if value, ok := _c.mutation.After(); ok {
+		_spec.SetField(transcriptrevision.FieldAfter, field.TypeString, value)
+		_node.After = value
+	}
+	if value, ok := _c.mutation.RolledBackAt(); ok {
+		_spec.SetField(transcriptrevision.FieldRolledBackAt, field.TypeTime, value)
+		_node.RolledBackAt = &value
+	}
+	return _node, _spec
+}
+
+// TranscriptRevisionCreateBulk is the builder for creating many TranscriptRevision entities in bulk.
+type TranscriptRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*TranscriptRevisionCreate
+}
+
+// Save creates the TranscriptRevision entities in the database.
+func (_c *TranscriptRevisionCreateBulk) Save(ctx context.Context) ([]*TranscriptRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TranscriptRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TranscriptRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TranscriptRevisionCreateBulk) SaveX(ctx context.Context) []*TranscriptRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TranscriptRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TranscriptRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
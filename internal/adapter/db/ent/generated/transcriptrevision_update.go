@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/transcriptrevision"
+)
+
+// TranscriptRevisionUpdate is the builder for updating TranscriptRevision entities.
+type TranscriptRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TranscriptRevisionMutation
+}
+
+// Where appends a list predicates to the TranscriptRevisionUpdate builder.
+func (_u *TranscriptRevisionUpdate) Where(ps ...predicate.TranscriptRevision) *TranscriptRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRolledBackAt sets the "rolled_back_at" field.
+func (_u *TranscriptRevisionUpdate) SetRolledBackAt(v time.Time) *TranscriptRevisionUpdate {
+	_u.mutation.SetRolledBackAt(v)
+	return _u
+}
+
+// SetNillableRolledBackAt sets the "rolled_back_at" field if the given value is not nil.
+func (_u *TranscriptRevisionUpdate) SetNillableRolledBackAt(v *time.Time) *TranscriptRevisionUpdate {
+	if v != nil {
+		_u.SetRolledBackAt(*v)
+	}
+	return _u
+}
+
+// ClearRolledBackAt clears the value of the "rolled_back_at" field.
+func (_u *TranscriptRevisionUpdate) ClearRolledBackAt() *TranscriptRevisionUpdate {
+	_u.mutation.ClearRolledBackAt()
+	return _u
+}
+
+// Mutation returns the TranscriptRevisionMutation object of the builder.
+func (_u *TranscriptRevisionUpdate) Mutation() *TranscriptRevisionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TranscriptRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TranscriptRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TranscriptRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TranscriptRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TranscriptRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(transcriptrevision.Table, transcriptrevision.Columns, sqlgraph.NewFieldSpec(transcriptrevision.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RolledBackAt(); ok {
+		_spec.SetField(transcriptrevision.FieldRolledBackAt, field.TypeTime, value)
+	}
+	if _u.mutation.RolledBackAtCleared() {
+		_spec.ClearField(transcriptrevision.FieldRolledBackAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transcriptrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TranscriptRevisionUpdateOne is the builder for updating a single TranscriptRevision entity.
+type TranscriptRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TranscriptRevisionMutation
+}
+
+// SetRolledBackAt sets the "rolled_back_at" field.
+func (_u *TranscriptRevisionUpdateOne) SetRolledBackAt(v time.Time) *TranscriptRevisionUpdateOne {
+	_u.mutation.SetRolledBackAt(v)
+	return _u
+}
+
+// SetNillableRolledBackAt sets the "rolled_back_at" field if the given value is not nil.
+func (_u *TranscriptRevisionUpdateOne) SetNillableRolledBackAt(v *time.Time) *TranscriptRevisionUpdateOne {
+	if v != nil {
+		_u.SetRolledBackAt(*v)
+	}
+	return _u
+}
+
+// ClearRolledBackAt clears the value of the "rolled_back_at" field.
+func (_u *TranscriptRevisionUpdateOne) ClearRolledBackAt() *TranscriptRevisionUpdateOne {
+	_u.mutation.ClearRolledBackAt()
+	return _u
+}
+
+// Mutation returns the TranscriptRevisionMutation object of the builder.
+func (_u *TranscriptRevisionUpdateOne) Mutation() *TranscriptRevisionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TranscriptRevisionUpdate builder.
+func (_u *TranscriptRevisionUpdateOne) Where(ps ...predicate.TranscriptRevision) *TranscriptRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TranscriptRevisionUpdateOne) Select(field string, fields ...string) *TranscriptRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TranscriptRevision entity.
+func (_u *TranscriptRevisionUpdateOne) Save(ctx context.Context) (*TranscriptRevision, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TranscriptRevisionUpdateOne) SaveX(ctx context.Context) *TranscriptRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TranscriptRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TranscriptRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TranscriptRevisionUpdateOne) sqlSave(ctx context.Context) (_node *TranscriptRevision, err error) {
+	_spec := sqlgraph.NewUpdateSpec(transcriptrevision.Table, transcriptrevision.Columns, sqlgraph.NewFieldSpec(transcriptrevision.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "TranscriptRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transcriptrevision.FieldID)
+		for _, f := range fields {
+			if !transcriptrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != transcriptrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RolledBackAt(); ok {
+		_spec.SetField(transcriptrevision.FieldRolledBackAt, field.TypeTime, value)
+	}
+	if _u.mutation.RolledBackAtCleared() {
+		_spec.ClearField(transcriptrevision.FieldRolledBackAt, field.TypeTime)
+	}
+	_node = &TranscriptRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transcriptrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
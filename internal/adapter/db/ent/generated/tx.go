@@ -12,14 +12,104 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
 	// Asset is the client for interacting with the Asset builders.
 	Asset *AssetClient
+	// AssetUsageDay is the client for interacting with the AssetUsageDay builders.
+	AssetUsageDay *AssetUsageDayClient
+	// AssetVersion is the client for interacting with the AssetVersion builders.
+	AssetVersion *AssetVersionClient
+	// AuditEntry is the client for interacting with the AuditEntry builders.
+	AuditEntry *AuditEntryClient
+	// AvailabilitySlot is the client for interacting with the AvailabilitySlot builders.
+	AvailabilitySlot *AvailabilitySlotClient
+	// Booking is the client for interacting with the Booking builders.
+	Booking *BookingClient
+	// Classroom is the client for interacting with the Classroom builders.
+	Classroom *ClassroomClient
+	// ClassroomAssignment is the client for interacting with the ClassroomAssignment builders.
+	ClassroomAssignment *ClassroomAssignmentClient
+	// ClassroomMember is the client for interacting with the ClassroomMember builders.
+	ClassroomMember *ClassroomMemberClient
+	// ContentEmbedding is the client for interacting with the ContentEmbedding builders.
+	ContentEmbedding *ContentEmbeddingClient
+	// ContentKey is the client for interacting with the ContentKey builders.
+	ContentKey *ContentKeyClient
+	// ContentReassignment is the client for interacting with the ContentReassignment builders.
+	ContentReassignment *ContentReassignmentClient
+	// DeviceToken is the client for interacting with the DeviceToken builders.
+	DeviceToken *DeviceTokenClient
+	// DictationAttempt is the client for interacting with the DictationAttempt builders.
+	DictationAttempt *DictationAttemptClient
+	// EngagementRollup is the client for interacting with the EngagementRollup builders.
+	EngagementRollup *EngagementRollupClient
 	// Episode is the client for interacting with the Episode builders.
 	Episode *EpisodeClient
+	// EpisodeTranscript is the client for interacting with the EpisodeTranscript builders.
+	EpisodeTranscript *EpisodeTranscriptClient
+	// Event is the client for interacting with the Event builders.
+	Event *EventClient
+	// FeedItem is the client for interacting with the FeedItem builders.
+	FeedItem *FeedItemClient
+	// FeedSubscription is the client for interacting with the FeedSubscription builders.
+	FeedSubscription *FeedSubscriptionClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
+	// LTILaunch is the client for interacting with the LTILaunch builders.
+	LTILaunch *LTILaunchClient
+	// LTILoginState is the client for interacting with the LTILoginState builders.
+	LTILoginState *LTILoginStateClient
+	// LTIPlatform is the client for interacting with the LTIPlatform builders.
+	LTIPlatform *LTIPlatformClient
+	// LearnerActivity is the client for interacting with the LearnerActivity builders.
+	LearnerActivity *LearnerActivityClient
+	// ModerationItem is the client for interacting with the ModerationItem builders.
+	ModerationItem *ModerationItemClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
+	// OutboxMessage is the client for interacting with the OutboxMessage builders.
+	OutboxMessage *OutboxMessageClient
+	// Plan is the client for interacting with the Plan builders.
+	Plan *PlanClient
+	// PlaybackSession is the client for interacting with the PlaybackSession builders.
+	PlaybackSession *PlaybackSessionClient
+	// Playlist is the client for interacting with the Playlist builders.
+	Playlist *PlaylistClient
+	// PlaylistItem is the client for interacting with the PlaylistItem builders.
+	PlaylistItem *PlaylistItemClient
+	// QuizItem is the client for interacting with the QuizItem builders.
+	QuizItem *QuizItemClient
+	// ScheduledTask is the client for interacting with the ScheduledTask builders.
+	ScheduledTask *ScheduledTaskClient
 	// Series is the client for interacting with the Series builders.
 	Series *SeriesClient
+	// ShadowingSubmission is the client for interacting with the ShadowingSubmission builders.
+	ShadowingSubmission *ShadowingSubmissionClient
+	// Subscription is the client for interacting with the Subscription builders.
+	Subscription *SubscriptionClient
+	// TranscriptReplaceJob is the client for interacting with the TranscriptReplaceJob builders.
+	TranscriptReplaceJob *TranscriptReplaceJobClient
+	// TranscriptRevision is the client for interacting with the TranscriptRevision builders.
+	TranscriptRevision *TranscriptRevisionClient
 	// UploadSession is the client for interacting with the UploadSession builders.
 	UploadSession *UploadSessionClient
+	// UsageRecord is the client for interacting with the UsageRecord builders.
+	UsageRecord *UsageRecordClient
+	// UsageSnapshot is the client for interacting with the UsageSnapshot builders.
+	UsageSnapshot *UsageSnapshotClient
+	// VocabularyWord is the client for interacting with the VocabularyWord builders.
+	VocabularyWord *VocabularyWordClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookEndpoint is the client for interacting with the WebhookEndpoint builders.
+	WebhookEndpoint *WebhookEndpointClient
+	// WebhookEvent is the client for interacting with the WebhookEvent builders.
+	WebhookEvent *WebhookEventClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,10 +241,55 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIKey = NewAPIKeyClient(tx.config)
 	tx.Asset = NewAssetClient(tx.config)
+	tx.AssetUsageDay = NewAssetUsageDayClient(tx.config)
+	tx.AssetVersion = NewAssetVersionClient(tx.config)
+	tx.AuditEntry = NewAuditEntryClient(tx.config)
+	tx.AvailabilitySlot = NewAvailabilitySlotClient(tx.config)
+	tx.Booking = NewBookingClient(tx.config)
+	tx.Classroom = NewClassroomClient(tx.config)
+	tx.ClassroomAssignment = NewClassroomAssignmentClient(tx.config)
+	tx.ClassroomMember = NewClassroomMemberClient(tx.config)
+	tx.ContentEmbedding = NewContentEmbeddingClient(tx.config)
+	tx.ContentKey = NewContentKeyClient(tx.config)
+	tx.ContentReassignment = NewContentReassignmentClient(tx.config)
+	tx.DeviceToken = NewDeviceTokenClient(tx.config)
+	tx.DictationAttempt = NewDictationAttemptClient(tx.config)
+	tx.EngagementRollup = NewEngagementRollupClient(tx.config)
 	tx.Episode = NewEpisodeClient(tx.config)
+	tx.EpisodeTranscript = NewEpisodeTranscriptClient(tx.config)
+	tx.Event = NewEventClient(tx.config)
+	tx.FeedItem = NewFeedItemClient(tx.config)
+	tx.FeedSubscription = NewFeedSubscriptionClient(tx.config)
+	tx.Invoice = NewInvoiceClient(tx.config)
+	tx.Job = NewJobClient(tx.config)
+	tx.LTILaunch = NewLTILaunchClient(tx.config)
+	tx.LTILoginState = NewLTILoginStateClient(tx.config)
+	tx.LTIPlatform = NewLTIPlatformClient(tx.config)
+	tx.LearnerActivity = NewLearnerActivityClient(tx.config)
+	tx.ModerationItem = NewModerationItemClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.NotificationPreference = NewNotificationPreferenceClient(tx.config)
+	tx.OutboxMessage = NewOutboxMessageClient(tx.config)
+	tx.Plan = NewPlanClient(tx.config)
+	tx.PlaybackSession = NewPlaybackSessionClient(tx.config)
+	tx.Playlist = NewPlaylistClient(tx.config)
+	tx.PlaylistItem = NewPlaylistItemClient(tx.config)
+	tx.QuizItem = NewQuizItemClient(tx.config)
+	tx.ScheduledTask = NewScheduledTaskClient(tx.config)
 	tx.Series = NewSeriesClient(tx.config)
+	tx.ShadowingSubmission = NewShadowingSubmissionClient(tx.config)
+	tx.Subscription = NewSubscriptionClient(tx.config)
+	tx.TranscriptReplaceJob = NewTranscriptReplaceJobClient(tx.config)
+	tx.TranscriptRevision = NewTranscriptRevisionClient(tx.config)
 	tx.UploadSession = NewUploadSessionClient(tx.config)
+	tx.UsageRecord = NewUsageRecordClient(tx.config)
+	tx.UsageSnapshot = NewUsageSnapshotClient(tx.config)
+	tx.VocabularyWord = NewVocabularyWordClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookEndpoint = NewWebhookEndpointClient(tx.config)
+	tx.WebhookEvent = NewWebhookEventClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -164,7 +299,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Asset.QueryXXX(), the query will be executed
+// applies a query, for example: APIKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -19,6 +19,10 @@ type UploadSession struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// AssetKey holds the value of the "asset_key" field.
 	AssetKey string `json:"asset_key,omitempty"`
 	// Type holds the value of the "type" field.
@@ -43,11 +47,11 @@ type UploadSession struct {
 	ContentLength int64 `json:"content_length,omitempty"`
 	// ExpiresAt holds the value of the "expires_at" field.
 	ExpiresAt time.Time `json:"expires_at,omitempty"`
-	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt time.Time `json:"created_at,omitempty"`
-	// UpdatedAt holds the value of the "updated_at" field.
-	UpdatedAt    time.Time `json:"updated_at,omitempty"`
-	selectValues sql.SelectValues
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// ReplacesAssetID holds the value of the "replaces_asset_id" field.
+	ReplacesAssetID *uuid.UUID `json:"replaces_asset_id,omitempty"`
+	selectValues    sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -55,13 +59,15 @@ func (*UploadSession) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case uploadsession.FieldReplacesAssetID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case uploadsession.FieldTargetHeaders, uploadsession.FieldTargetFormFields:
 			values[i] = new([]byte)
 		case uploadsession.FieldType, uploadsession.FieldProtocol, uploadsession.FieldStatus, uploadsession.FieldContentLength:
 			values[i] = new(sql.NullInt64)
-		case uploadsession.FieldAssetKey, uploadsession.FieldTargetMethod, uploadsession.FieldTargetURL, uploadsession.FieldOriginalFilename, uploadsession.FieldMimeType:
+		case uploadsession.FieldAssetKey, uploadsession.FieldTargetMethod, uploadsession.FieldTargetURL, uploadsession.FieldOriginalFilename, uploadsession.FieldMimeType, uploadsession.FieldProvider:
 			values[i] = new(sql.NullString)
-		case uploadsession.FieldExpiresAt, uploadsession.FieldCreatedAt, uploadsession.FieldUpdatedAt:
+		case uploadsession.FieldCreatedAt, uploadsession.FieldUpdatedAt, uploadsession.FieldExpiresAt:
 			values[i] = new(sql.NullTime)
 		case uploadsession.FieldID:
 			values[i] = new(uuid.UUID)
@@ -86,6 +92,18 @@ func (_m *UploadSession) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
+		case uploadsession.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case uploadsession.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
 		case uploadsession.FieldAssetKey:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field asset_key", values[i])
@@ -162,17 +180,18 @@ func (_m *UploadSession) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ExpiresAt = value.Time
 			}
-		case uploadsession.FieldCreatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+		case uploadsession.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
 			} else if value.Valid {
-				_m.CreatedAt = value.Time
+				_m.Provider = value.String
 			}
-		case uploadsession.FieldUpdatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+		case uploadsession.FieldReplacesAssetID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field replaces_asset_id", values[i])
 			} else if value.Valid {
-				_m.UpdatedAt = value.Time
+				_m.ReplacesAssetID = new(uuid.UUID)
+				*_m.ReplacesAssetID = *value.S.(*uuid.UUID)
 			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
@@ -210,6 +229,12 @@ func (_m *UploadSession) String() string {
 	var builder strings.Builder
 	builder.WriteString("UploadSession(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("asset_key=")
 	builder.WriteString(_m.AssetKey)
 	builder.WriteString(", ")
@@ -246,11 +271,13 @@ func (_m *UploadSession) String() string {
 	builder.WriteString("expires_at=")
 	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
 	builder.WriteString(", ")
-	builder.WriteString("created_at=")
-	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
 	builder.WriteString(", ")
-	builder.WriteString("updated_at=")
-	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	if v := _m.ReplacesAssetID; v != nil {
+		builder.WriteString("replaces_asset_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
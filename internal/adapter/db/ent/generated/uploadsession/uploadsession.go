@@ -3,8 +3,7 @@
 package uploadsession
 
 import (
-	"time"
-
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 )
@@ -14,6 +13,10 @@ const (
 	Label = "upload_session"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
 	// FieldAssetKey holds the string denoting the asset_key field in the database.
 	FieldAssetKey = "asset_key"
 	// FieldType holds the string denoting the type field in the database.
@@ -38,10 +41,10 @@ const (
 	FieldContentLength = "content_length"
 	// FieldExpiresAt holds the string denoting the expires_at field in the database.
 	FieldExpiresAt = "expires_at"
-	// FieldCreatedAt holds the string denoting the created_at field in the database.
-	FieldCreatedAt = "created_at"
-	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
-	FieldUpdatedAt = "updated_at"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldReplacesAssetID holds the string denoting the replaces_asset_id field in the database.
+	FieldReplacesAssetID = "replaces_asset_id"
 	// Table holds the table name of the uploadsession in the database.
 	Table = "upload_sessions"
 )
@@ -49,6 +52,8 @@ const (
 // Columns holds all SQL columns for uploadsession fields.
 var Columns = []string{
 	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
 	FieldAssetKey,
 	FieldType,
 	FieldProtocol,
@@ -61,8 +66,8 @@ var Columns = []string{
 	FieldMimeType,
 	FieldContentLength,
 	FieldExpiresAt,
-	FieldCreatedAt,
-	FieldUpdatedAt,
+	FieldProvider,
+	FieldReplacesAssetID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -75,7 +80,13 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/eslsoft/lession/internal/adapter/db/ent/generated/runtime"
 var (
+	Hooks [2]ent.Hook
 	// DefaultType holds the default value on creation for the "type" field.
 	DefaultType int
 	// DefaultProtocol holds the default value on creation for the "protocol" field.
@@ -88,12 +99,8 @@ var (
 	DefaultTargetFormFields func() map[string]string
 	// DefaultContentLength holds the default value on creation for the "content_length" field.
 	DefaultContentLength int64
-	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
-	DefaultCreatedAt func() time.Time
-	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
-	DefaultUpdatedAt func() time.Time
-	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
-	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultProvider holds the default value on creation for the "provider" field.
+	DefaultProvider string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -106,6 +113,16 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
 // ByAssetKey orders the results by the asset_key field.
 func ByAssetKey(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAssetKey, opts...).ToFunc()
@@ -156,12 +173,12 @@ func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
 }
 
-// ByCreatedAt orders the results by the created_at field.
-func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
 }
 
-// ByUpdatedAt orders the results by the updated_at field.
-func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+// ByReplacesAssetID orders the results by the replaces_asset_id field.
+func ByReplacesAssetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplacesAssetID, opts...).ToFunc()
 }
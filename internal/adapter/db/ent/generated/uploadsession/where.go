@@ -55,6 +55,16 @@ func IDLTE(id uuid.UUID) predicate.UploadSession {
 	return predicate.UploadSession(sql.FieldLTE(FieldID, id))
 }
 
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
 // AssetKey applies equality check predicate on the "asset_key" field. It's identical to AssetKeyEQ.
 func AssetKey(v string) predicate.UploadSession {
 	return predicate.UploadSession(sql.FieldEQ(FieldAssetKey, v))
@@ -105,16 +115,96 @@ func ExpiresAt(v time.Time) predicate.UploadSession {
 	return predicate.UploadSession(sql.FieldEQ(FieldExpiresAt, v))
 }
 
-// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
-func CreatedAt(v time.Time) predicate.UploadSession {
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldProvider, v))
+}
+
+// ReplacesAssetID applies equality check predicate on the "replaces_asset_id" field. It's identical to ReplacesAssetIDEQ.
+func ReplacesAssetID(v uuid.UUID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldReplacesAssetID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UploadSession {
 	return predicate.UploadSession(sql.FieldEQ(FieldCreatedAt, v))
 }
 
-// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
-func UpdatedAt(v time.Time) predicate.UploadSession {
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UploadSession {
 	return predicate.UploadSession(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
 // AssetKeyEQ applies the EQ predicate on the "asset_key" field.
 func AssetKeyEQ(v string) predicate.UploadSession {
 	return predicate.UploadSession(sql.FieldEQ(FieldAssetKey, v))
@@ -660,84 +750,119 @@ func ExpiresAtLTE(v time.Time) predicate.UploadSession {
 	return predicate.UploadSession(sql.FieldLTE(FieldExpiresAt, v))
 }
 
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.UploadSession {
-	return predicate.UploadSession(sql.FieldEQ(FieldCreatedAt, v))
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldProvider, v))
 }
 
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.UploadSession {
-	return predicate.UploadSession(sql.FieldNEQ(FieldCreatedAt, v))
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNEQ(FieldProvider, v))
 }
 
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.UploadSession {
-	return predicate.UploadSession(sql.FieldIn(FieldCreatedAt, vs...))
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldIn(FieldProvider, vs...))
 }
 
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.UploadSession {
-	return predicate.UploadSession(sql.FieldNotIn(FieldCreatedAt, vs...))
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNotIn(FieldProvider, vs...))
 }
 
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.UploadSession {
-	return predicate.UploadSession(sql.FieldGT(FieldCreatedAt, v))
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGT(FieldProvider, v))
 }
 
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.UploadSession {
-	return predicate.UploadSession(sql.FieldGTE(FieldCreatedAt, v))
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGTE(FieldProvider, v))
 }
 
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.UploadSession {
-	return predicate.UploadSession(sql.FieldLT(FieldCreatedAt, v))
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLT(FieldProvider, v))
 }
 
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.UploadSession {
-	return predicate.UploadSession(sql.FieldLTE(FieldCreatedAt, v))
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLTE(FieldProvider, v))
 }
 
-// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
-func UpdatedAtEQ(v time.Time) predicate.UploadSession {
-	return predicate.UploadSession(sql.FieldEQ(FieldUpdatedAt, v))
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldContains(FieldProvider, v))
 }
 
-// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
-func UpdatedAtNEQ(v time.Time) predicate.UploadSession {
-	return predicate.UploadSession(sql.FieldNEQ(FieldUpdatedAt, v))
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldHasPrefix(FieldProvider, v))
 }
 
-// UpdatedAtIn applies the In predicate on the "updated_at" field.
-func UpdatedAtIn(vs ...time.Time) predicate.UploadSession {
-	return predicate.UploadSession(sql.FieldIn(FieldUpdatedAt, vs...))
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldHasSuffix(FieldProvider, v))
 }
 
-// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
-func UpdatedAtNotIn(vs ...time.Time) predicate.UploadSession {
-	return predicate.UploadSession(sql.FieldNotIn(FieldUpdatedAt, vs...))
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEqualFold(FieldProvider, v))
 }
 
-// UpdatedAtGT applies the GT predicate on the "updated_at" field.
-func UpdatedAtGT(v time.Time) predicate.UploadSession {
-	return predicate.UploadSession(sql.FieldGT(FieldUpdatedAt, v))
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldContainsFold(FieldProvider, v))
 }
 
-// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
-func UpdatedAtGTE(v time.Time) predicate.UploadSession {
-	return predicate.UploadSession(sql.FieldGTE(FieldUpdatedAt, v))
+// ReplacesAssetIDEQ applies the EQ predicate on the "replaces_asset_id" field.
+func ReplacesAssetIDEQ(v uuid.UUID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldEQ(FieldReplacesAssetID, v))
 }
 
-// UpdatedAtLT applies the LT predicate on the "updated_at" field.
-func UpdatedAtLT(v time.Time) predicate.UploadSession {
-	return predicate.UploadSession(sql.FieldLT(FieldUpdatedAt, v))
+// ReplacesAssetIDNEQ applies the NEQ predicate on the "replaces_asset_id" field.
+func ReplacesAssetIDNEQ(v uuid.UUID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNEQ(FieldReplacesAssetID, v))
 }
 
-// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
-func UpdatedAtLTE(v time.Time) predicate.UploadSession {
-	return predicate.UploadSession(sql.FieldLTE(FieldUpdatedAt, v))
+// ReplacesAssetIDIn applies the In predicate on the "replaces_asset_id" field.
+func ReplacesAssetIDIn(vs ...uuid.UUID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldIn(FieldReplacesAssetID, vs...))
+}
+
+// ReplacesAssetIDNotIn applies the NotIn predicate on the "replaces_asset_id" field.
+func ReplacesAssetIDNotIn(vs ...uuid.UUID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNotIn(FieldReplacesAssetID, vs...))
+}
+
+// ReplacesAssetIDGT applies the GT predicate on the "replaces_asset_id" field.
+func ReplacesAssetIDGT(v uuid.UUID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGT(FieldReplacesAssetID, v))
+}
+
+// ReplacesAssetIDGTE applies the GTE predicate on the "replaces_asset_id" field.
+func ReplacesAssetIDGTE(v uuid.UUID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldGTE(FieldReplacesAssetID, v))
+}
+
+// ReplacesAssetIDLT applies the LT predicate on the "replaces_asset_id" field.
+func ReplacesAssetIDLT(v uuid.UUID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLT(FieldReplacesAssetID, v))
+}
+
+// ReplacesAssetIDLTE applies the LTE predicate on the "replaces_asset_id" field.
+func ReplacesAssetIDLTE(v uuid.UUID) predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldLTE(FieldReplacesAssetID, v))
+}
+
+// ReplacesAssetIDIsNil applies the IsNil predicate on the "replaces_asset_id" field.
+func ReplacesAssetIDIsNil() predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldIsNull(FieldReplacesAssetID))
+}
+
+// ReplacesAssetIDNotNil applies the NotNil predicate on the "replaces_asset_id" field.
+func ReplacesAssetIDNotNil() predicate.UploadSession {
+	return predicate.UploadSession(sql.FieldNotNull(FieldReplacesAssetID))
 }
 
 // And groups predicates with the AND operator between them.
@@ -21,6 +21,18 @@ type UploadSessionCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (_c *UploadSessionCreate) SetCreatedAt(v time.Time) *UploadSessionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UploadSessionCreate) SetUpdatedAt(v time.Time) *UploadSessionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
 // SetAssetKey sets the "asset_key" field.
 func (_c *UploadSessionCreate) SetAssetKey(v string) *UploadSessionCreate {
 	_c.mutation.SetAssetKey(v)
@@ -125,30 +137,30 @@ func (_c *UploadSessionCreate) SetExpiresAt(v time.Time) *UploadSessionCreate {
 	return _c
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_c *UploadSessionCreate) SetCreatedAt(v time.Time) *UploadSessionCreate {
-	_c.mutation.SetCreatedAt(v)
+// SetProvider sets the "provider" field.
+func (_c *UploadSessionCreate) SetProvider(v string) *UploadSessionCreate {
+	_c.mutation.SetProvider(v)
 	return _c
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_c *UploadSessionCreate) SetNillableCreatedAt(v *time.Time) *UploadSessionCreate {
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_c *UploadSessionCreate) SetNillableProvider(v *string) *UploadSessionCreate {
 	if v != nil {
-		_c.SetCreatedAt(*v)
+		_c.SetProvider(*v)
 	}
 	return _c
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_c *UploadSessionCreate) SetUpdatedAt(v time.Time) *UploadSessionCreate {
-	_c.mutation.SetUpdatedAt(v)
+// SetReplacesAssetID sets the "replaces_asset_id" field.
+func (_c *UploadSessionCreate) SetReplacesAssetID(v uuid.UUID) *UploadSessionCreate {
+	_c.mutation.SetReplacesAssetID(v)
 	return _c
 }
 
-// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
-func (_c *UploadSessionCreate) SetNillableUpdatedAt(v *time.Time) *UploadSessionCreate {
+// SetNillableReplacesAssetID sets the "replaces_asset_id" field if the given value is not nil.
+func (_c *UploadSessionCreate) SetNillableReplacesAssetID(v *uuid.UUID) *UploadSessionCreate {
 	if v != nil {
-		_c.SetUpdatedAt(*v)
+		_c.SetReplacesAssetID(*v)
 	}
 	return _c
 }
@@ -174,7 +186,9 @@ func (_c *UploadSessionCreate) Mutation() *UploadSessionMutation {
 
 // Save creates the UploadSession in the database.
 func (_c *UploadSessionCreate) Save(ctx context.Context) (*UploadSession, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -201,7 +215,7 @@ func (_c *UploadSessionCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *UploadSessionCreate) defaults() {
+func (_c *UploadSessionCreate) defaults() error {
 	if _, ok := _c.mutation.GetType(); !ok {
 		v := uploadsession.DefaultType
 		_c.mutation.SetType(v)
@@ -215,10 +229,16 @@ func (_c *UploadSessionCreate) defaults() {
 		_c.mutation.SetStatus(v)
 	}
 	if _, ok := _c.mutation.TargetHeaders(); !ok {
+		if uploadsession.DefaultTargetHeaders == nil {
+			return fmt.Errorf("generated: uninitialized uploadsession.DefaultTargetHeaders (forgotten import generated/runtime?)")
+		}
 		v := uploadsession.DefaultTargetHeaders()
 		_c.mutation.SetTargetHeaders(v)
 	}
 	if _, ok := _c.mutation.TargetFormFields(); !ok {
+		if uploadsession.DefaultTargetFormFields == nil {
+			return fmt.Errorf("generated: uninitialized uploadsession.DefaultTargetFormFields (forgotten import generated/runtime?)")
+		}
 		v := uploadsession.DefaultTargetFormFields()
 		_c.mutation.SetTargetFormFields(v)
 	}
@@ -226,22 +246,28 @@ func (_c *UploadSessionCreate) defaults() {
 		v := uploadsession.DefaultContentLength
 		_c.mutation.SetContentLength(v)
 	}
-	if _, ok := _c.mutation.CreatedAt(); !ok {
-		v := uploadsession.DefaultCreatedAt()
-		_c.mutation.SetCreatedAt(v)
-	}
-	if _, ok := _c.mutation.UpdatedAt(); !ok {
-		v := uploadsession.DefaultUpdatedAt()
-		_c.mutation.SetUpdatedAt(v)
+	if _, ok := _c.mutation.Provider(); !ok {
+		v := uploadsession.DefaultProvider
+		_c.mutation.SetProvider(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if uploadsession.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized uploadsession.DefaultID (forgotten import generated/runtime?)")
+		}
 		v := uploadsession.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *UploadSessionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "UploadSession.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "UploadSession.updated_at"`)}
+	}
 	if _, ok := _c.mutation.AssetKey(); !ok {
 		return &ValidationError{Name: "asset_key", err: errors.New(`generated: missing required field "UploadSession.asset_key"`)}
 	}
@@ -272,11 +298,8 @@ func (_c *UploadSessionCreate) check() error {
 	if _, ok := _c.mutation.ExpiresAt(); !ok {
 		return &ValidationError{Name: "expires_at", err: errors.New(`generated: missing required field "UploadSession.expires_at"`)}
 	}
-	if _, ok := _c.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "UploadSession.created_at"`)}
-	}
-	if _, ok := _c.mutation.UpdatedAt(); !ok {
-		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "UploadSession.updated_at"`)}
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`generated: missing required field "UploadSession.provider"`)}
 	}
 	return nil
 }
@@ -313,6 +336,14 @@ func (_c *UploadSessionCreate) createSpec() (*UploadSession, *sqlgraph.CreateSpe
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(uploadsession.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(uploadsession.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
 	if value, ok := _c.mutation.AssetKey(); ok {
 		_spec.SetField(uploadsession.FieldAssetKey, field.TypeString, value)
 		_node.AssetKey = value
@@ -361,13 +392,13 @@ func (_c *UploadSessionCreate) createSpec() (*UploadSession, *sqlgraph.CreateSpe
 		_spec.SetField(uploadsession.FieldExpiresAt, field.TypeTime, value)
 		_node.ExpiresAt = value
 	}
-	if value, ok := _c.mutation.CreatedAt(); ok {
-		_spec.SetField(uploadsession.FieldCreatedAt, field.TypeTime, value)
-		_node.CreatedAt = value
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(uploadsession.FieldProvider, field.TypeString, value)
+		_node.Provider = value
 	}
-	if value, ok := _c.mutation.UpdatedAt(); ok {
-		_spec.SetField(uploadsession.FieldUpdatedAt, field.TypeTime, value)
-		_node.UpdatedAt = value
+	if value, ok := _c.mutation.ReplacesAssetID(); ok {
+		_spec.SetField(uploadsession.FieldReplacesAssetID, field.TypeUUID, value)
+		_node.ReplacesAssetID = &value
 	}
 	return _node, _spec
 }
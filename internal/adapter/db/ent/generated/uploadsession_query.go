@@ -263,12 +263,12 @@ func (_q *UploadSessionQuery) Clone() *UploadSessionQuery {
 // Example:
 //
 //	var v []struct {
-//		AssetKey string `json:"asset_key,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.UploadSession.Query().
-//		GroupBy(uploadsession.FieldAssetKey).
+//		GroupBy(uploadsession.FieldCreatedAt).
 //		Aggregate(generated.Count()).
 //		Scan(ctx, &v)
 func (_q *UploadSessionQuery) GroupBy(field string, fields ...string) *UploadSessionGroupBy {
@@ -286,11 +286,11 @@ func (_q *UploadSessionQuery) GroupBy(field string, fields ...string) *UploadSes
 // Example:
 //
 //	var v []struct {
-//		AssetKey string `json:"asset_key,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.UploadSession.Query().
-//		Select(uploadsession.FieldAssetKey).
+//		Select(uploadsession.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (_q *UploadSessionQuery) Select(fields ...string) *UploadSessionSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
 	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/uploadsession"
+	"github.com/google/uuid"
 )
 
 // UploadSessionUpdate is the builder for updating UploadSession entities.
@@ -28,6 +29,20 @@ func (_u *UploadSessionUpdate) Where(ps ...predicate.UploadSession) *UploadSessi
 	return _u
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UploadSessionUpdate) SetUpdatedAt(v time.Time) *UploadSessionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *UploadSessionUpdate) SetNillableUpdatedAt(v *time.Time) *UploadSessionUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
 // SetAssetKey sets the "asset_key" field.
 func (_u *UploadSessionUpdate) SetAssetKey(v string) *UploadSessionUpdate {
 	_u.mutation.SetAssetKey(v)
@@ -220,9 +235,37 @@ func (_u *UploadSessionUpdate) SetNillableExpiresAt(v *time.Time) *UploadSession
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *UploadSessionUpdate) SetUpdatedAt(v time.Time) *UploadSessionUpdate {
-	_u.mutation.SetUpdatedAt(v)
+// SetProvider sets the "provider" field.
+func (_u *UploadSessionUpdate) SetProvider(v string) *UploadSessionUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *UploadSessionUpdate) SetNillableProvider(v *string) *UploadSessionUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetReplacesAssetID sets the "replaces_asset_id" field.
+func (_u *UploadSessionUpdate) SetReplacesAssetID(v uuid.UUID) *UploadSessionUpdate {
+	_u.mutation.SetReplacesAssetID(v)
+	return _u
+}
+
+// SetNillableReplacesAssetID sets the "replaces_asset_id" field if the given value is not nil.
+func (_u *UploadSessionUpdate) SetNillableReplacesAssetID(v *uuid.UUID) *UploadSessionUpdate {
+	if v != nil {
+		_u.SetReplacesAssetID(*v)
+	}
+	return _u
+}
+
+// ClearReplacesAssetID clears the value of the "replaces_asset_id" field.
+func (_u *UploadSessionUpdate) ClearReplacesAssetID() *UploadSessionUpdate {
+	_u.mutation.ClearReplacesAssetID()
 	return _u
 }
 
@@ -233,7 +276,6 @@ func (_u *UploadSessionUpdate) Mutation() *UploadSessionMutation {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UploadSessionUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -259,14 +301,6 @@ func (_u *UploadSessionUpdate) ExecX(ctx context.Context) {
 	}
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *UploadSessionUpdate) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := uploadsession.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
-	}
-}
-
 func (_u *UploadSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	_spec := sqlgraph.NewUpdateSpec(uploadsession.Table, uploadsession.Columns, sqlgraph.NewFieldSpec(uploadsession.FieldID, field.TypeUUID))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -276,6 +310,9 @@ func (_u *UploadSessionUpdate) sqlSave(ctx context.Context) (_node int, err erro
 			}
 		}
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(uploadsession.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if value, ok := _u.mutation.AssetKey(); ok {
 		_spec.SetField(uploadsession.FieldAssetKey, field.TypeString, value)
 	}
@@ -330,8 +367,14 @@ func (_u *UploadSessionUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if value, ok := _u.mutation.ExpiresAt(); ok {
 		_spec.SetField(uploadsession.FieldExpiresAt, field.TypeTime, value)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(uploadsession.FieldUpdatedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(uploadsession.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReplacesAssetID(); ok {
+		_spec.SetField(uploadsession.FieldReplacesAssetID, field.TypeUUID, value)
+	}
+	if _u.mutation.ReplacesAssetIDCleared() {
+		_spec.ClearField(uploadsession.FieldReplacesAssetID, field.TypeUUID)
 	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -353,6 +396,20 @@ type UploadSessionUpdateOne struct {
 	mutation *UploadSessionMutation
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UploadSessionUpdateOne) SetUpdatedAt(v time.Time) *UploadSessionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *UploadSessionUpdateOne) SetNillableUpdatedAt(v *time.Time) *UploadSessionUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
 // SetAssetKey sets the "asset_key" field.
 func (_u *UploadSessionUpdateOne) SetAssetKey(v string) *UploadSessionUpdateOne {
 	_u.mutation.SetAssetKey(v)
@@ -545,9 +602,37 @@ func (_u *UploadSessionUpdateOne) SetNillableExpiresAt(v *time.Time) *UploadSess
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *UploadSessionUpdateOne) SetUpdatedAt(v time.Time) *UploadSessionUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
+// SetProvider sets the "provider" field.
+func (_u *UploadSessionUpdateOne) SetProvider(v string) *UploadSessionUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *UploadSessionUpdateOne) SetNillableProvider(v *string) *UploadSessionUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetReplacesAssetID sets the "replaces_asset_id" field.
+func (_u *UploadSessionUpdateOne) SetReplacesAssetID(v uuid.UUID) *UploadSessionUpdateOne {
+	_u.mutation.SetReplacesAssetID(v)
+	return _u
+}
+
+// SetNillableReplacesAssetID sets the "replaces_asset_id" field if the given value is not nil.
+func (_u *UploadSessionUpdateOne) SetNillableReplacesAssetID(v *uuid.UUID) *UploadSessionUpdateOne {
+	if v != nil {
+		_u.SetReplacesAssetID(*v)
+	}
+	return _u
+}
+
+// ClearReplacesAssetID clears the value of the "replaces_asset_id" field.
+func (_u *UploadSessionUpdateOne) ClearReplacesAssetID() *UploadSessionUpdateOne {
+	_u.mutation.ClearReplacesAssetID()
 	return _u
 }
 
@@ -571,7 +656,6 @@ func (_u *UploadSessionUpdateOne) Select(field string, fields ...string) *Upload
 
 // Save executes the query and returns the updated UploadSession entity.
 func (_u *UploadSessionUpdateOne) Save(ctx context.Context) (*UploadSession, error) {
-	_u.defaults()
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -597,14 +681,6 @@ func (_u *UploadSessionUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *UploadSessionUpdateOne) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := uploadsession.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
-	}
-}
-
 func (_u *UploadSessionUpdateOne) sqlSave(ctx context.Context) (_node *UploadSession, err error) {
 	_spec := sqlgraph.NewUpdateSpec(uploadsession.Table, uploadsession.Columns, sqlgraph.NewFieldSpec(uploadsession.FieldID, field.TypeUUID))
 	id, ok := _u.mutation.ID()
@@ -631,6 +707,9 @@ func (_u *UploadSessionUpdateOne) sqlSave(ctx context.Context) (_node *UploadSes
 			}
 		}
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(uploadsession.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if value, ok := _u.mutation.AssetKey(); ok {
 		_spec.SetField(uploadsession.FieldAssetKey, field.TypeString, value)
 	}
@@ -685,8 +764,14 @@ func (_u *UploadSessionUpdateOne) sqlSave(ctx context.Context) (_node *UploadSes
 	if value, ok := _u.mutation.ExpiresAt(); ok {
 		_spec.SetField(uploadsession.FieldExpiresAt, field.TypeTime, value)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(uploadsession.FieldUpdatedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(uploadsession.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReplacesAssetID(); ok {
+		_spec.SetField(uploadsession.FieldReplacesAssetID, field.TypeUUID, value)
+	}
+	if _u.mutation.ReplacesAssetIDCleared() {
+		_spec.ClearField(uploadsession.FieldReplacesAssetID, field.TypeUUID)
 	}
 	_node = &UploadSession{config: _u.config}
 	_spec.Assign = _node.assignValues
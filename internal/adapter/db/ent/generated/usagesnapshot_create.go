@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/usagesnapshot"
+	"github.com/eslsoft/lession/internal/core"
+	"github.com/google/uuid"
+)
+
+// UsageSnapshotCreate is the builder for creating a UsageSnapshot entity.
+type UsageSnapshotCreate struct {
+	config
+	mutation *UsageSnapshotMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UsageSnapshotCreate) SetCreatedAt(v time.Time) *UsageSnapshotCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *UsageSnapshotCreate) SetTenantID(v string) *UsageSnapshotCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_c *UsageSnapshotCreate) SetPeriodStart(v time.Time) *UsageSnapshotCreate {
+	_c.mutation.SetPeriodStart(v)
+	return _c
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_c *UsageSnapshotCreate) SetPeriodEnd(v time.Time) *UsageSnapshotCreate {
+	_c.mutation.SetPeriodEnd(v)
+	return _c
+}
+
+// SetLines sets the "lines" field.
+func (_c *UsageSnapshotCreate) SetLines(v []core.UsageLine) *UsageSnapshotCreate {
+	_c.mutation.SetLines(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UsageSnapshotCreate) SetID(v uuid.UUID) *UsageSnapshotCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *UsageSnapshotCreate) SetNillableID(v *uuid.UUID) *UsageSnapshotCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the UsageSnapshotMutation object of the builder.
+func (_c *UsageSnapshotCreate) Mutation() *UsageSnapshotMutation {
+	return _c.mutation
+}
+
+// Save creates the UsageSnapshot in the database.
+func (_c *UsageSnapshotCreate) Save(ctx context.Context) (*UsageSnapshot, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UsageSnapshotCreate) SaveX(ctx context.Context) *UsageSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UsageSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UsageSnapshotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UsageSnapshotCreate) defaults() error {
+	if _, ok := _c.mutation.ID(); !ok {
+		if usagesnapshot.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized usagesnapshot.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := usagesnapshot.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UsageSnapshotCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "UsageSnapshot.created_at"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`generated: missing required field "UsageSnapshot.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`generated: missing required field "UsageSnapshot.period_start"`)}
+	}
+	if _, ok := _c.mutation.PeriodEnd(); !ok {
+		return &ValidationError{Name: "period_end", err: errors.New(`generated: missing required field "UsageSnapshot.period_end"`)}
+	}
+	return nil
+}
+
+func (_c *UsageSnapshotCreate) sqlSave(ctx context.Context) (*UsageSnapshot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UsageSnapshotCreate) createSpec() (*UsageSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UsageSnapshot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(usagesnapshot.Table, sqlgraph.NewFieldSpec(usagesnapshot.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(usagesnapshot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(usagesnapshot.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.PeriodStart(); ok {
+		_spec.SetField(usagesnapshot.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := _c.mutation.PeriodEnd(); ok {
+		_spec.SetField(usagesnapshot.FieldPeriodEnd, field.TypeTime, value)
+		_node.PeriodEnd = value
+	}
+	if value, ok := _c.mutation.Lines(); ok {
+		_spec.SetField(usagesnapshot.FieldLines, field.TypeJSON, value)
+		_node.Lines = value
+	}
+	return _node, _spec
+}
+
+// UsageSnapshotCreateBulk is the builder for creating many UsageSnapshot entities in bulk.
+type UsageSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*UsageSnapshotCreate
+}
+
+// Save creates the UsageSnapshot entities in the database.
+func (_c *UsageSnapshotCreateBulk) Save(ctx context.Context) ([]*UsageSnapshot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UsageSnapshot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsageSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UsageSnapshotCreateBulk) SaveX(ctx context.Context) []*UsageSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UsageSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UsageSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
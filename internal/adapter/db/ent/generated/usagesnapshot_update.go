@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/usagesnapshot"
+)
+
+// UsageSnapshotUpdate is the builder for updating UsageSnapshot entities.
+type UsageSnapshotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UsageSnapshotMutation
+}
+
+// Where appends a list predicates to the UsageSnapshotUpdate builder.
+func (_u *UsageSnapshotUpdate) Where(ps ...predicate.UsageSnapshot) *UsageSnapshotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the UsageSnapshotMutation object of the builder.
+func (_u *UsageSnapshotUpdate) Mutation() *UsageSnapshotMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UsageSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UsageSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *UsageSnapshotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(usagesnapshot.Table, usagesnapshot.Columns, sqlgraph.NewFieldSpec(usagesnapshot.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.LinesCleared() {
+		_spec.ClearField(usagesnapshot.FieldLines, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagesnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UsageSnapshotUpdateOne is the builder for updating a single UsageSnapshot entity.
+type UsageSnapshotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UsageSnapshotMutation
+}
+
+// Mutation returns the UsageSnapshotMutation object of the builder.
+func (_u *UsageSnapshotUpdateOne) Mutation() *UsageSnapshotMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UsageSnapshotUpdate builder.
+func (_u *UsageSnapshotUpdateOne) Where(ps ...predicate.UsageSnapshot) *UsageSnapshotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UsageSnapshotUpdateOne) Select(field string, fields ...string) *UsageSnapshotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UsageSnapshot entity.
+func (_u *UsageSnapshotUpdateOne) Save(ctx context.Context) (*UsageSnapshot, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageSnapshotUpdateOne) SaveX(ctx context.Context) *UsageSnapshot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UsageSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *UsageSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *UsageSnapshot, err error) {
+	_spec := sqlgraph.NewUpdateSpec(usagesnapshot.Table, usagesnapshot.Columns, sqlgraph.NewFieldSpec(usagesnapshot.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "UsageSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usagesnapshot.FieldID)
+		for _, f := range fields {
+			if !usagesnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != usagesnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.LinesCleared() {
+		_spec.ClearField(usagesnapshot.FieldLines, field.TypeJSON)
+	}
+	_node = &UsageSnapshot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagesnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
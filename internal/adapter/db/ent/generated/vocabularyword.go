@@ -0,0 +1,169 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/vocabularyword"
+	"github.com/google/uuid"
+)
+
+// VocabularyWord is the model entity for the VocabularyWord schema.
+type VocabularyWord struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Word holds the value of the "word" field.
+	Word string `json:"word,omitempty"`
+	// Status holds the value of the "status" field.
+	Status int `json:"status,omitempty"`
+	// EpisodeID holds the value of the "episode_id" field.
+	EpisodeID    *uuid.UUID `json:"episode_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VocabularyWord) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vocabularyword.FieldEpisodeID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case vocabularyword.FieldStatus:
+			values[i] = new(sql.NullInt64)
+		case vocabularyword.FieldUserID, vocabularyword.FieldWord:
+			values[i] = new(sql.NullString)
+		case vocabularyword.FieldCreatedAt, vocabularyword.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case vocabularyword.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VocabularyWord fields.
+func (_m *VocabularyWord) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vocabularyword.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case vocabularyword.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case vocabularyword.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case vocabularyword.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case vocabularyword.FieldWord:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field word", values[i])
+			} else if value.Valid {
+				_m.Word = value.String
+			}
+		case vocabularyword.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = int(value.Int64)
+			}
+		case vocabularyword.FieldEpisodeID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field episode_id", values[i])
+			} else if value.Valid {
+				_m.EpisodeID = new(uuid.UUID)
+				*_m.EpisodeID = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VocabularyWord.
+// This includes values selected through modifiers, order, etc.
+func (_m *VocabularyWord) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this VocabularyWord.
+// Note that you need to call VocabularyWord.Unwrap() before calling this method if this VocabularyWord
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *VocabularyWord) Update() *VocabularyWordUpdateOne {
+	return NewVocabularyWordClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the VocabularyWord entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *VocabularyWord) Unwrap() *VocabularyWord {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: VocabularyWord is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *VocabularyWord) String() string {
+	var builder strings.Builder
+	builder.WriteString("VocabularyWord(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("word=")
+	builder.WriteString(_m.Word)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.EpisodeID; v != nil {
+		builder.WriteString("episode_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VocabularyWords is a parsable slice of VocabularyWord.
+type VocabularyWords []*VocabularyWord
@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/vocabularyword"
+	"github.com/google/uuid"
+)
+
+// VocabularyWordCreate is the builder for creating a VocabularyWord entity.
+type VocabularyWordCreate struct {
+	config
+	mutation *VocabularyWordMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VocabularyWordCreate) SetCreatedAt(v time.Time) *VocabularyWordCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *VocabularyWordCreate) SetUpdatedAt(v time.Time) *VocabularyWordCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *VocabularyWordCreate) SetUserID(v string) *VocabularyWordCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetWord sets the "word" field.
+func (_c *VocabularyWordCreate) SetWord(v string) *VocabularyWordCreate {
+	_c.mutation.SetWord(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *VocabularyWordCreate) SetStatus(v int) *VocabularyWordCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_c *VocabularyWordCreate) SetEpisodeID(v uuid.UUID) *VocabularyWordCreate {
+	_c.mutation.SetEpisodeID(v)
+	return _c
+}
+
+// SetNillableEpisodeID sets the "episode_id" field if the given value is not nil.
+func (_c *VocabularyWordCreate) SetNillableEpisodeID(v *uuid.UUID) *VocabularyWordCreate {
+	if v != nil {
+		_c.SetEpisodeID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *VocabularyWordCreate) SetID(v uuid.UUID) *VocabularyWordCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *VocabularyWordCreate) SetNillableID(v *uuid.UUID) *VocabularyWordCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the VocabularyWordMutation object of the builder.
+func (_c *VocabularyWordCreate) Mutation() *VocabularyWordMutation {
+	return _c.mutation
+}
+
+// Save creates the VocabularyWord in the database.
+func (_c *VocabularyWordCreate) Save(ctx context.Context) (*VocabularyWord, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VocabularyWordCreate) SaveX(ctx context.Context) *VocabularyWord {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VocabularyWordCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VocabularyWordCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VocabularyWordCreate) defaults() error {
+	if _, ok := _c.mutation.ID(); !ok {
+		if vocabularyword.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized vocabularyword.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := vocabularyword.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VocabularyWordCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "VocabularyWord.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "VocabularyWord.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "VocabularyWord.user_id"`)}
+	}
+	if _, ok := _c.mutation.Word(); !ok {
+		return &ValidationError{Name: "word", err: errors.New(`generated: missing required field "VocabularyWord.word"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`generated: missing required field "VocabularyWord.status"`)}
+	}
+	return nil
+}
+
+func (_c *VocabularyWordCreate) sqlSave(ctx context.Context) (*VocabularyWord, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VocabularyWordCreate) createSpec() (*VocabularyWord, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VocabularyWord{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(vocabularyword.Table, sqlgraph.NewFieldSpec(vocabularyword.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(vocabularyword.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(vocabularyword.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(vocabularyword.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Word(); ok {
+		_spec.SetField(vocabularyword.FieldWord, field.TypeString, value)
+		_node.Word = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(vocabularyword.FieldStatus, field.TypeInt, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.EpisodeID(); ok {
+		_spec.SetField(vocabularyword.FieldEpisodeID, field.TypeUUID, value)
+		_node.EpisodeID = &value
+	}
+	return _node, _spec
+}
+
+// VocabularyWordCreateBulk is the builder for creating many VocabularyWord entities in bulk.
+type VocabularyWordCreateBulk struct {
+	config
+	err      error
+	builders []*VocabularyWordCreate
+}
+
+// Save creates the VocabularyWord entities in the database.
+func (_c *VocabularyWordCreateBulk) Save(ctx context.Context) ([]*VocabularyWord, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*VocabularyWord, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VocabularyWordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VocabularyWordCreateBulk) SaveX(ctx context.Context) []*VocabularyWord {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VocabularyWordCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VocabularyWordCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/vocabularyword"
+	"github.com/google/uuid"
+)
+
+// VocabularyWordUpdate is the builder for updating VocabularyWord entities.
+type VocabularyWordUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VocabularyWordMutation
+}
+
+// Where appends a list predicates to the VocabularyWordUpdate builder.
+func (_u *VocabularyWordUpdate) Where(ps ...predicate.VocabularyWord) *VocabularyWordUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VocabularyWordUpdate) SetUpdatedAt(v time.Time) *VocabularyWordUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *VocabularyWordUpdate) SetNillableUpdatedAt(v *time.Time) *VocabularyWordUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *VocabularyWordUpdate) SetStatus(v int) *VocabularyWordUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *VocabularyWordUpdate) SetNillableStatus(v *int) *VocabularyWordUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *VocabularyWordUpdate) AddStatus(v int) *VocabularyWordUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_u *VocabularyWordUpdate) SetEpisodeID(v uuid.UUID) *VocabularyWordUpdate {
+	_u.mutation.SetEpisodeID(v)
+	return _u
+}
+
+// SetNillableEpisodeID sets the "episode_id" field if the given value is not nil.
+func (_u *VocabularyWordUpdate) SetNillableEpisodeID(v *uuid.UUID) *VocabularyWordUpdate {
+	if v != nil {
+		_u.SetEpisodeID(*v)
+	}
+	return _u
+}
+
+// ClearEpisodeID clears the value of the "episode_id" field.
+func (_u *VocabularyWordUpdate) ClearEpisodeID() *VocabularyWordUpdate {
+	_u.mutation.ClearEpisodeID()
+	return _u
+}
+
+// Mutation returns the VocabularyWordMutation object of the builder.
+func (_u *VocabularyWordUpdate) Mutation() *VocabularyWordMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VocabularyWordUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VocabularyWordUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VocabularyWordUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VocabularyWordUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *VocabularyWordUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(vocabularyword.Table, vocabularyword.Columns, sqlgraph.NewFieldSpec(vocabularyword.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(vocabularyword.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(vocabularyword.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(vocabularyword.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EpisodeID(); ok {
+		_spec.SetField(vocabularyword.FieldEpisodeID, field.TypeUUID, value)
+	}
+	if _u.mutation.EpisodeIDCleared() {
+		_spec.ClearField(vocabularyword.FieldEpisodeID, field.TypeUUID)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vocabularyword.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VocabularyWordUpdateOne is the builder for updating a single VocabularyWord entity.
+type VocabularyWordUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VocabularyWordMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VocabularyWordUpdateOne) SetUpdatedAt(v time.Time) *VocabularyWordUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *VocabularyWordUpdateOne) SetNillableUpdatedAt(v *time.Time) *VocabularyWordUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *VocabularyWordUpdateOne) SetStatus(v int) *VocabularyWordUpdateOne {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *VocabularyWordUpdateOne) SetNillableStatus(v *int) *VocabularyWordUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *VocabularyWordUpdateOne) AddStatus(v int) *VocabularyWordUpdateOne {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetEpisodeID sets the "episode_id" field.
+func (_u *VocabularyWordUpdateOne) SetEpisodeID(v uuid.UUID) *VocabularyWordUpdateOne {
+	_u.mutation.SetEpisodeID(v)
+	return _u
+}
+
+// SetNillableEpisodeID sets the "episode_id" field if the given value is not nil.
+func (_u *VocabularyWordUpdateOne) SetNillableEpisodeID(v *uuid.UUID) *VocabularyWordUpdateOne {
+	if v != nil {
+		_u.SetEpisodeID(*v)
+	}
+	return _u
+}
+
+// ClearEpisodeID clears the value of the "episode_id" field.
+func (_u *VocabularyWordUpdateOne) ClearEpisodeID() *VocabularyWordUpdateOne {
+	_u.mutation.ClearEpisodeID()
+	return _u
+}
+
+// Mutation returns the VocabularyWordMutation object of the builder.
+func (_u *VocabularyWordUpdateOne) Mutation() *VocabularyWordMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the VocabularyWordUpdate builder.
+func (_u *VocabularyWordUpdateOne) Where(ps ...predicate.VocabularyWord) *VocabularyWordUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VocabularyWordUpdateOne) Select(field string, fields ...string) *VocabularyWordUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated VocabularyWord entity.
+func (_u *VocabularyWordUpdateOne) Save(ctx context.Context) (*VocabularyWord, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VocabularyWordUpdateOne) SaveX(ctx context.Context) *VocabularyWord {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VocabularyWordUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VocabularyWordUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *VocabularyWordUpdateOne) sqlSave(ctx context.Context) (_node *VocabularyWord, err error) {
+	_spec := sqlgraph.NewUpdateSpec(vocabularyword.Table, vocabularyword.Columns, sqlgraph.NewFieldSpec(vocabularyword.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "VocabularyWord.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vocabularyword.FieldID)
+		for _, f := range fields {
+			if !vocabularyword.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != vocabularyword.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(vocabularyword.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(vocabularyword.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(vocabularyword.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EpisodeID(); ok {
+		_spec.SetField(vocabularyword.FieldEpisodeID, field.TypeUUID, value)
+	}
+	if _u.mutation.EpisodeIDCleared() {
+		_spec.ClearField(vocabularyword.FieldEpisodeID, field.TypeUUID)
+	}
+	_node = &VocabularyWord{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vocabularyword.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
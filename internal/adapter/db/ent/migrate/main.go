@@ -0,0 +1,53 @@
+//go:build ignore
+
+// Command migrate writes the next versioned migration, the changes between
+// internal/adapter/db/migrations and the Ent schema. It replays the existing
+// migrations on the empty PostgreSQL database at ATLAS_DEV_URL to compute
+// them:
+//
+//	ATLAS_DEV_URL=postgres://localhost:5432/lession_dev?sslmode=disable \
+//		go run -mod=mod ./internal/adapter/db/ent/migrate/main.go add_series_tags
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	atlasmigrate "ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/sqltool"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+	_ "github.com/lib/pq"
+
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/migrate"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatalln("usage: migrate <name>")
+	}
+	devURL := os.Getenv("ATLAS_DEV_URL")
+	if devURL == "" {
+		log.Fatalln("ATLAS_DEV_URL must point to an empty PostgreSQL database")
+	}
+
+	dir, err := atlasmigrate.NewLocalDir("internal/adapter/db/migrations")
+	if err != nil {
+		log.Fatalf("open migration directory: %v", err)
+	}
+	m, err := schema.NewMigrateURL(devURL,
+		schema.WithDir(&sqltool.GolangMigrateDir{FS: dir}),
+		schema.WithMigrationMode(schema.ModeReplay),
+		schema.WithDialect(dialect.Postgres),
+		schema.WithFormatter(sqltool.GolangMigrateFormatter),
+		schema.WithDropColumn(true),
+		schema.WithDropIndex(true),
+	)
+	if err != nil {
+		log.Fatalf("connect to dev database: %v", err)
+	}
+	if err := m.NamedDiff(context.Background(), os.Args[1], migrate.Tables...); err != nil {
+		log.Fatalf("write migration: %v", err)
+	}
+}
@@ -1,11 +1,11 @@
 package schema
 
 import (
-	"time"
-
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
 )
 
 // Asset holds the schema definition for the Asset entity.
@@ -13,6 +13,14 @@ type Asset struct {
 	ent.Schema
 }
 
+// Mixin of the Asset.
+func (Asset) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+		SoftDeleteMixin{},
+	}
+}
+
 // Fields of the Asset.
 func (Asset) Fields() []ent.Field {
 	return []ent.Field{
@@ -33,12 +41,23 @@ func (Asset) Fields() []ent.Field {
 			Default(0),
 		field.String("playback_url").
 			Optional(),
-		field.Time("created_at").
-			Immutable().
-			Default(time.Now),
-		field.Time("updated_at").
-			Default(time.Now).
-			UpdateDefault(time.Now),
+		field.String("hls_manifest_url").
+			Default(""),
+		field.UUID("hls_key_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Int("width").
+			Default(0),
+		field.Int("height").
+			Default(0),
+		field.JSON("image_variants", []core.ImageVariant{}).
+			Optional(),
+		field.String("provider").
+			Default(""),
+		field.Int("failure_code").
+			Default(0),
+		field.String("failure_reason").
+			Default(""),
 		field.Time("ready_at").
 			Optional().
 			Nillable(),
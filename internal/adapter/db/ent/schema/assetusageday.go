@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AssetUsageDay holds the schema definition for the AssetUsageDay entity.
+type AssetUsageDay struct {
+	ent.Schema
+}
+
+// Fields of the AssetUsageDay.
+func (AssetUsageDay) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("asset_id", uuid.UUID{}).
+			Immutable(),
+		field.Time("day").
+			Immutable(),
+		field.Int64("playback_urls_issued").
+			Default(0),
+		field.Int64("downloads").
+			Default(0),
+	}
+}
+
+// Edges of the AssetUsageDay.
+func (AssetUsageDay) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AssetUsageDay.
+func (AssetUsageDay) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("asset_id", "day").
+			Unique(),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AssetVersion holds the schema definition for the AssetVersion entity.
+type AssetVersion struct {
+	ent.Schema
+}
+
+// Mixin of the AssetVersion.
+func (AssetVersion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the AssetVersion.
+func (AssetVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("asset_id", uuid.UUID{}).
+			Immutable(),
+		field.String("asset_key").
+			Immutable(),
+		field.String("provider").
+			Default("").
+			Immutable(),
+		field.String("original_filename").
+			Immutable(),
+		field.String("mime_type").
+			Immutable(),
+		field.Int64("filesize").
+			Default(0).
+			Immutable(),
+		field.Int("duration_seconds").
+			Default(0).
+			Immutable(),
+		field.String("playback_url").
+			Default("").
+			Immutable(),
+		field.String("hls_manifest_url").
+			Default("").
+			Immutable(),
+	}
+}
+
+// Edges of the AssetVersion.
+func (AssetVersion) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AssetVersion.
+func (AssetVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("asset_id", "created_at"),
+	}
+}
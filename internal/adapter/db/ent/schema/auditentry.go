@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AuditEntry holds the schema definition for the AuditEntry entity, the
+// append-only log of changes to other entities.
+type AuditEntry struct {
+	ent.Schema
+}
+
+// Mixin of the AuditEntry.
+func (AuditEntry) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the AuditEntry.
+func (AuditEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.String("actor_id").
+			Default("").
+			Immutable(),
+		field.String("procedure").
+			Default("").
+			Immutable(),
+		field.String("entity_type").
+			Immutable(),
+		field.String("entity_id").
+			Immutable(),
+		field.Int("action").
+			Immutable(),
+		field.Bytes("changes").
+			Optional().
+			Immutable(),
+	}
+}
+
+// Edges of the AuditEntry.
+func (AuditEntry) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AuditEntry.
+func (AuditEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_type", "entity_id", "created_at"),
+		index.Fields("actor_id", "created_at"),
+		index.Fields("created_at"),
+	}
+}
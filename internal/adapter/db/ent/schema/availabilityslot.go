@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AvailabilitySlot holds the schema definition for the AvailabilitySlot entity.
+type AvailabilitySlot struct {
+	ent.Schema
+}
+
+// Mixin of the AvailabilitySlot.
+func (AvailabilitySlot) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the AvailabilitySlot. booked is claimed with a conditional
+// update, so two learners can never book the same slot.
+func (AvailabilitySlot) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("teacher_id").
+			Immutable(),
+		field.Time("starts_at").
+			Immutable(),
+		field.Time("ends_at").
+			Immutable(),
+		field.Bool("booked").
+			Default(false),
+	}
+}
+
+// Edges of the AvailabilitySlot.
+func (AvailabilitySlot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("bookings", Booking.Type),
+	}
+}
+
+// Indexes of the AvailabilitySlot.
+func (AvailabilitySlot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("teacher_id", "starts_at"),
+		index.Fields("starts_at"),
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Booking holds the schema definition for the Booking entity.
+type Booking struct {
+	ent.Schema
+}
+
+// Mixin of the Booking.
+func (Booking) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Booking. The teacher and times are copied from the slot so
+// bookings can be listed and checked for overlaps without a join.
+func (Booking) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("slot_id", uuid.UUID{}),
+		field.String("teacher_id").
+			Immutable(),
+		field.String("learner_id").
+			Immutable(),
+		field.Time("starts_at").
+			Immutable(),
+		field.Time("ends_at").
+			Immutable(),
+		field.Text("note").
+			Default(""),
+		field.Int("status"),
+		field.Time("cancelled_at").
+			Optional().
+			Nillable(),
+		field.String("cancelled_by").
+			Default(""),
+		field.Text("cancel_reason").
+			Default(""),
+	}
+}
+
+// Edges of the Booking.
+func (Booking) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("slot", AvailabilitySlot.Type).
+			Ref("bookings").
+			Field("slot_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Booking.
+func (Booking) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("learner_id", "starts_at"),
+		index.Fields("teacher_id", "starts_at"),
+		index.Fields("slot_id"),
+	}
+}
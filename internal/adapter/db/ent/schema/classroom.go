@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Classroom holds the schema definition for the Classroom entity.
+type Classroom struct {
+	ent.Schema
+}
+
+// Mixin of the Classroom.
+func (Classroom) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Classroom.
+func (Classroom) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("teacher_id").
+			Immutable(),
+		field.String("name"),
+		field.Text("description").
+			Default(""),
+	}
+}
+
+// Edges of the Classroom.
+func (Classroom) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("members", ClassroomMember.Type),
+		edge.To("assignments", ClassroomAssignment.Type),
+	}
+}
+
+// Indexes of the Classroom.
+func (Classroom) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("teacher_id", "updated_at"),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ClassroomAssignment holds the schema definition for the ClassroomAssignment entity.
+type ClassroomAssignment struct {
+	ent.Schema
+}
+
+// Mixin of the ClassroomAssignment.
+func (ClassroomAssignment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the ClassroomAssignment.
+func (ClassroomAssignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("classroom_id", uuid.UUID{}),
+		field.UUID("series_id", uuid.UUID{}),
+		field.Time("due_at"),
+	}
+}
+
+// Edges of the ClassroomAssignment.
+func (ClassroomAssignment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("classroom", Classroom.Type).
+			Ref("assignments").
+			Field("classroom_id").
+			Unique().
+			Required(),
+		edge.To("series", Series.Type).
+			Field("series_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ClassroomAssignment.
+func (ClassroomAssignment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("classroom_id", "series_id").
+			Unique(),
+	}
+}
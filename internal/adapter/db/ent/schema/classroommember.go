@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ClassroomMember holds the schema definition for the ClassroomMember entity.
+type ClassroomMember struct {
+	ent.Schema
+}
+
+// Fields of the ClassroomMember.
+func (ClassroomMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("classroom_id", uuid.UUID{}),
+		field.String("user_id"),
+		field.Time("joined_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the ClassroomMember.
+func (ClassroomMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("classroom", Classroom.Type).
+			Ref("members").
+			Field("classroom_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ClassroomMember.
+func (ClassroomMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("classroom_id", "user_id").
+			Unique(),
+		index.Fields("user_id"),
+	}
+}
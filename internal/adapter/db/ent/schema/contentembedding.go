@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/adapter/db/pgvector"
+)
+
+// ContentEmbedding holds the schema definition for the ContentEmbedding
+// entity: the embedding of a published series or episode, keyed by its
+// search document id.
+type ContentEmbedding struct {
+	ent.Schema
+}
+
+// Mixin of the ContentEmbedding.
+func (ContentEmbedding) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ContentEmbedding. The filters and display fields of the
+// document are copied so similarity queries need no joins.
+func (ContentEmbedding) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.Int("kind"),
+		field.UUID("series_id", uuid.UUID{}),
+		field.UUID("episode_id", uuid.UUID{}).
+			Optional(),
+		field.String("title"),
+		field.Text("snippet").
+			Default(""),
+		field.String("language").
+			Default(""),
+		field.String("level").
+			Default(""),
+		field.Strings("tags").
+			Optional(),
+		field.String("model"),
+		field.String("content_hash"),
+		field.Other("vector", pgvector.Vector{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "vector",
+				dialect.MySQL:    "json",
+				dialect.SQLite:   "text",
+			}),
+	}
+}
+
+// Indexes of the ContentEmbedding.
+func (ContentEmbedding) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "language"),
+		index.Fields("series_id"),
+	}
+}
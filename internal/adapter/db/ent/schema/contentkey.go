@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ContentKey holds the schema definition for the ContentKey entity.
+type ContentKey struct {
+	ent.Schema
+}
+
+// Mixin of the ContentKey.
+func (ContentKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the ContentKey.
+func (ContentKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("asset_id", uuid.UUID{}).
+			Immutable(),
+		field.Bytes("key").
+			Immutable().
+			Sensitive(),
+	}
+}
+
+// Edges of the ContentKey.
+func (ContentKey) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ContentKey.
+func (ContentKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("asset_id"),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ContentReassignment holds the schema definition for the ContentReassignment entity.
+type ContentReassignment struct {
+	ent.Schema
+}
+
+// Mixin of the ContentReassignment.
+func (ContentReassignment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the ContentReassignment.
+func (ContentReassignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("from_author_id"),
+		field.String("to_author_id"),
+		field.JSON("series_ids", []uuid.UUID{}).
+			Optional(),
+	}
+}
+
+// Edges of the ContentReassignment.
+func (ContentReassignment) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ContentReassignment.
+func (ContentReassignment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("from_author_id"),
+		index.Fields("to_author_id"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DeviceToken holds the schema definition for the DeviceToken entity.
+type DeviceToken struct {
+	ent.Schema
+}
+
+// Mixin of the DeviceToken.
+func (DeviceToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the DeviceToken.
+func (DeviceToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token").
+			Unique().
+			Immutable(),
+		field.String("user_id"),
+		field.Int("platform").
+			Default(0),
+	}
+}
+
+// Edges of the DeviceToken.
+func (DeviceToken) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the DeviceToken.
+func (DeviceToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
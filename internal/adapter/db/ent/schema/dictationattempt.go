@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// DictationAttempt holds the schema definition for the DictationAttempt entity.
+type DictationAttempt struct {
+	ent.Schema
+}
+
+// Mixin of the DictationAttempt.
+func (DictationAttempt) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the DictationAttempt.
+func (DictationAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("user_id"),
+		field.UUID("episode_id", uuid.UUID{}),
+		field.Int("item_index"),
+		field.Text("answer").
+			Default(""),
+		field.Text("expected").
+			Default(""),
+		field.Float("score").
+			Default(0),
+		field.JSON("feedback", []core.DictationToken{}).
+			Optional(),
+	}
+}
+
+// Edges of the DictationAttempt.
+func (DictationAttempt) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the DictationAttempt.
+func (DictationAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("episode_id"),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// EngagementRollup holds the schema definition for the EngagementRollup
+// entity. Rows aggregate the playback sessions of an episode or series for
+// one day, or for all time when day is null.
+type EngagementRollup struct {
+	ent.Schema
+}
+
+// Mixin of the EngagementRollup.
+func (EngagementRollup) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the EngagementRollup.
+func (EngagementRollup) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("subject_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("series_id", uuid.UUID{}).
+			Immutable(),
+		field.Time("day").
+			Immutable().
+			Optional().
+			Nillable(),
+		field.Int("sessions").
+			Default(0),
+		field.Int("finished_sessions").
+			Default(0),
+		field.Int("unique_listeners").
+			Default(0),
+		field.Int("completers").
+			Default(0),
+	}
+}
+
+// Edges of the EngagementRollup.
+func (EngagementRollup) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the EngagementRollup.
+func (EngagementRollup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("subject_id", "day"),
+	}
+}
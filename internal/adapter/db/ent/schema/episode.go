@@ -1,13 +1,13 @@
 package schema
 
 import (
-	"time"
-
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
 )
 
 // Episode holds the schema definition for the Episode entity.
@@ -15,6 +15,14 @@ type Episode struct {
 	ent.Schema
 }
 
+// Mixin of the Episode.
+func (Episode) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+		SoftDeleteMixin{},
+	}
+}
+
 // Fields of the Episode.
 func (Episode) Fields() []ent.Field {
 	return []ent.Field{
@@ -28,8 +36,12 @@ func (Episode) Fields() []ent.Field {
 			Default(""),
 		field.Int("duration_seconds").
 			Default(0),
+		field.Bool("duration_from_asset").
+			Default(false),
 		field.Int("status").
 			Default(0),
+		field.Bool("preview").
+			Default(false),
 		field.UUID("resource_asset_id", uuid.UUID{}).
 			Optional().
 			Nillable(),
@@ -45,18 +57,15 @@ func (Episode) Fields() []ent.Field {
 			Default(0),
 		field.Text("transcript_content").
 			Default(""),
-		field.Time("created_at").
-			Immutable().
-			Default(time.Now),
-		field.Time("updated_at").
-			Default(time.Now).
-			UpdateDefault(time.Now),
+		field.JSON("transcript_findings", []core.TranscriptFinding{}).
+			Optional(),
+		field.Bool("transcript_offloaded").
+			Default(false),
+		field.String("estimated_level").
+			Default(""),
 		field.Time("published_at").
 			Optional().
 			Nillable(),
-		field.Time("deleted_at").
-			Optional().
-			Nillable(),
 	}
 }
 
@@ -68,6 +77,8 @@ func (Episode) Edges() []ent.Edge {
 			Field("series_id").
 			Unique().
 			Required(),
+		edge.To("transcript", EpisodeTranscript.Type).
+			Unique(),
 	}
 }
 
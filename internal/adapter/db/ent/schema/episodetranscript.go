@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// EpisodeTranscript holds the schema definition for the EpisodeTranscript
+// entity: transcripts too large to keep in the episodes table.
+type EpisodeTranscript struct {
+	ent.Schema
+}
+
+// Fields of the EpisodeTranscript.
+func (EpisodeTranscript) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("episode_id", uuid.UUID{}).
+			Unique().
+			Immutable(),
+		field.Text("content").
+			Default(""),
+	}
+}
+
+// Edges of the EpisodeTranscript.
+func (EpisodeTranscript) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("episode", Episode.Type).
+			Ref("transcript").
+			Field("episode_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
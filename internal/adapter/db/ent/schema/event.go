@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Event holds the schema definition for the Event entity, the append-only
+// log of domain events.
+type Event struct {
+	ent.Schema
+}
+
+// Fields of the Event.
+func (Event) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.String("type").
+			Immutable(),
+		field.String("aggregate_id").
+			Immutable(),
+		field.Bytes("payload").
+			Immutable(),
+		field.Time("occurred_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the Event.
+func (Event) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Event.
+func (Event) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("occurred_at"),
+		index.Fields("aggregate_id", "occurred_at"),
+		index.Fields("type", "occurred_at"),
+	}
+}
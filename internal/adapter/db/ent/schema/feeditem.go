@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// FeedItem holds the schema definition for the FeedItem entity, an item of
+// an external feed ingested as an episode.
+type FeedItem struct {
+	ent.Schema
+}
+
+// Mixin of the FeedItem.
+func (FeedItem) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the FeedItem.
+func (FeedItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("subscription_id", uuid.UUID{}).
+			Immutable(),
+		field.String("guid").
+			Immutable(),
+		field.UUID("episode_id", uuid.UUID{}).
+			Immutable(),
+	}
+}
+
+// Edges of the FeedItem.
+func (FeedItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("subscription", FeedSubscription.Type).
+			Ref("items").
+			Field("subscription_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the FeedItem.
+func (FeedItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("subscription_id", "guid").
+			Unique(),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// FeedSubscription holds the schema definition for the FeedSubscription entity.
+type FeedSubscription struct {
+	ent.Schema
+}
+
+// Mixin of the FeedSubscription.
+func (FeedSubscription) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the FeedSubscription.
+func (FeedSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("series_id", uuid.UUID{}).
+			Unique().
+			Immutable(),
+		field.Int("kind").
+			Immutable(),
+		field.String("source_url").
+			Immutable(),
+		field.Bool("download_media").
+			Default(false),
+		field.Bool("auto_publish").
+			Default(false),
+		field.Time("last_synced_at").
+			Optional().
+			Nillable(),
+		field.String("last_error").
+			Default(""),
+	}
+}
+
+// Edges of the FeedSubscription.
+func (FeedSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("items", FeedItem.Type),
+	}
+}
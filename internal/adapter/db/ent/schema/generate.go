@@ -1,3 +1,3 @@
 package schema
 
-//go:generate go run entgo.io/ent/cmd/ent generate --target=../generated ./
+//go:generate go run entgo.io/ent/cmd/ent generate --feature intercept --target=../generated ./
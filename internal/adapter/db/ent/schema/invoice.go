@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Invoice holds the schema definition for the Invoice entity.
+type Invoice struct {
+	ent.Schema
+}
+
+// Mixin of the Invoice.
+func (Invoice) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Invoice.
+func (Invoice) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("subscription_id", uuid.UUID{}),
+		field.String("user_id").
+			Immutable(),
+		field.String("billing_provider").
+			Immutable(),
+		field.String("external_id").
+			Immutable(),
+		field.Int64("amount_cents").
+			Default(0),
+		field.String("currency"),
+		field.Int("status").
+			Default(0),
+		field.Time("period_start"),
+		field.Time("period_end"),
+		field.String("hosted_url").
+			Default(""),
+		field.Time("paid_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Invoice.
+func (Invoice) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Invoice.
+func (Invoice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("billing_provider", "external_id").
+			Unique(),
+		index.Fields("user_id", "created_at"),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Job holds the schema definition for the Job entity, the background job queue.
+type Job struct {
+	ent.Schema
+}
+
+// Mixin of the Job.
+func (Job) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Job.
+func (Job) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.String("kind").
+			Immutable(),
+		field.Bytes("payload").
+			Optional().
+			Immutable(),
+		field.Int("status"),
+		field.Int("attempts").
+			Default(0),
+		field.String("last_error").
+			Default(""),
+		field.String("dedupe_key").
+			Optional().
+			Nillable().
+			Unique().
+			Immutable(),
+		field.Time("run_at"),
+		field.String("locked_by").
+			Default(""),
+		field.Time("locked_until").
+			Optional().
+			Nillable(),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Job.
+func (Job) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Job.
+func (Job) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "run_at"),
+		index.Fields("kind", "created_at"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LearnerActivity holds the schema definition for the LearnerActivity entity.
+type LearnerActivity struct {
+	ent.Schema
+}
+
+// Mixin of the LearnerActivity.
+func (LearnerActivity) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the LearnerActivity.
+func (LearnerActivity) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("user_id"),
+		field.Time("day"),
+		field.Int("minutes_listened").
+			Default(0),
+		field.Int("episodes_completed").
+			Default(0),
+	}
+}
+
+// Edges of the LearnerActivity.
+func (LearnerActivity) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the LearnerActivity.
+func (LearnerActivity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "day").
+			Unique(),
+		index.Fields("day"),
+	}
+}
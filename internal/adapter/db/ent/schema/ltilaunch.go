@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LTILaunch holds the schema definition for the LTILaunch entity.
+type LTILaunch struct {
+	ent.Schema
+}
+
+// Mixin of the LTILaunch.
+func (LTILaunch) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the LTILaunch.
+func (LTILaunch) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("platform_id", uuid.UUID{}).
+			Immutable(),
+		field.Int("message_type").
+			Immutable(),
+		field.String("subject").
+			Immutable(),
+		field.String("user_id").
+			Immutable(),
+		field.UUID("episode_id", uuid.UUID{}).
+			Optional().
+			Immutable(),
+		field.String("resource_link_id").
+			Default("").
+			Immutable(),
+		field.String("line_item_url").
+			Default("").
+			Immutable(),
+		field.String("deep_link_return_url").
+			Default("").
+			Immutable(),
+		field.Text("deep_link_data").
+			Default("").
+			Immutable(),
+		field.Time("score_submitted_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the LTILaunch.
+func (LTILaunch) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the LTILaunch.
+func (LTILaunch) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("platform_id", "subject"),
+	}
+}
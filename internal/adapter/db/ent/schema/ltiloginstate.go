@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LTILoginState holds the schema definition for the LTILoginState entity.
+type LTILoginState struct {
+	ent.Schema
+}
+
+// Fields of the LTILoginState.
+func (LTILoginState) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("state").
+			Unique().
+			Immutable(),
+		field.String("nonce").
+			Immutable(),
+		field.UUID("platform_id", uuid.UUID{}).
+			Immutable(),
+		field.Time("expires_at").
+			Immutable(),
+	}
+}
+
+// Edges of the LTILoginState.
+func (LTILoginState) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the LTILoginState.
+func (LTILoginState) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LTIPlatform holds the schema definition for the LTIPlatform entity.
+type LTIPlatform struct {
+	ent.Schema
+}
+
+// Mixin of the LTIPlatform.
+func (LTIPlatform) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the LTIPlatform.
+func (LTIPlatform) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("issuer").
+			Immutable(),
+		field.String("client_id").
+			Immutable(),
+		field.String("deployment_id").
+			Default(""),
+		field.String("auth_login_url"),
+		field.String("auth_token_url"),
+		field.String("jwks_url"),
+	}
+}
+
+// Edges of the LTIPlatform.
+func (LTIPlatform) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the LTIPlatform.
+func (LTIPlatform) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("issuer", "client_id").
+			Unique(),
+	}
+}
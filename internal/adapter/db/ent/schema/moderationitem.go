@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ModerationItem holds the schema definition for the ModerationItem entity.
+type ModerationItem struct {
+	ent.Schema
+}
+
+// Mixin of the ModerationItem.
+func (ModerationItem) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ModerationItem. Subjects are referenced by type and id
+// rather than edges so any kind of user-generated text can be moderated.
+func (ModerationItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("subject_type").
+			Immutable(),
+		field.UUID("subject_id", uuid.UUID{}).
+			Immutable(),
+		field.String("author_id").
+			Default(""),
+		field.Text("text"),
+		field.Int("status"),
+		field.Strings("labels").
+			Optional(),
+		field.Float("score").
+			Default(0),
+		field.String("reviewer_id").
+			Default(""),
+		field.Text("review_note").
+			Default(""),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the ModerationItem.
+func (ModerationItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("subject_type", "subject_id").
+			Unique(),
+		index.Fields("status", "created_at"),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// NotificationPreference holds the schema definition for the NotificationPreference entity.
+type NotificationPreference struct {
+	ent.Schema
+}
+
+// Mixin of the NotificationPreference.
+func (NotificationPreference) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UpdateTimeMixin{},
+	}
+}
+
+// Fields of the NotificationPreference.
+func (NotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").
+			Unique().
+			Immutable(),
+		field.String("email").
+			Default(""),
+		field.String("locale").
+			Default(""),
+		field.JSON("opt_outs", []core.NotificationOptOut{}).
+			Optional(),
+	}
+}
+
+// Edges of the NotificationPreference.
+func (NotificationPreference) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OutboxMessage holds the schema definition for the OutboxMessage entity, the
+// transactional outbox of domain events awaiting relay.
+type OutboxMessage struct {
+	ent.Schema
+}
+
+// Fields of the OutboxMessage.
+func (OutboxMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.String("event_type").
+			Immutable(),
+		field.String("aggregate_id").
+			Immutable(),
+		field.Bytes("payload").
+			Immutable(),
+		field.Time("occurred_at").
+			Immutable().
+			Default(time.Now),
+		field.Int("attempts").
+			Default(0),
+		field.String("last_error").
+			Default(""),
+		field.Time("next_attempt_at").
+			Default(time.Now),
+		field.Time("dispatched_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the OutboxMessage.
+func (OutboxMessage) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the OutboxMessage.
+func (OutboxMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("dispatched_at", "next_attempt_at"),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Plan holds the schema definition for the Plan entity.
+type Plan struct {
+	ent.Schema
+}
+
+// Mixin of the Plan.
+func (Plan) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Plan.
+func (Plan) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("code").
+			Unique(),
+		field.String("name"),
+		field.Text("description").
+			Default(""),
+		field.Int64("price_cents").
+			Default(0),
+		field.String("currency"),
+		field.Int("interval").
+			Default(0),
+		field.Bool("active").
+			Default(true),
+	}
+}
+
+// Edges of the Plan.
+func (Plan) Edges() []ent.Edge {
+	return nil
+}
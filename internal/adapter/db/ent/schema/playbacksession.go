@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PlaybackSession holds the schema definition for the PlaybackSession entity.
+type PlaybackSession struct {
+	ent.Schema
+}
+
+// Mixin of the PlaybackSession.
+func (PlaybackSession) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the PlaybackSession.
+func (PlaybackSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("user_id").
+			Immutable(),
+		field.UUID("episode_id", uuid.UUID{}).
+			Immutable(),
+		field.String("device").
+			Immutable().
+			Default(""),
+		field.Time("started_at").
+			Immutable(),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the PlaybackSession.
+func (PlaybackSession) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the PlaybackSession.
+func (PlaybackSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "started_at"),
+		index.Fields("episode_id", "started_at"),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Playlist holds the schema definition for the Playlist entity.
+type Playlist struct {
+	ent.Schema
+}
+
+// Mixin of the Playlist.
+func (Playlist) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Playlist.
+func (Playlist) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("owner_id"),
+		field.String("title"),
+		field.Text("description").
+			Default(""),
+		field.Bool("public").
+			Default(false),
+		field.String("slug").
+			Optional().
+			Nillable().
+			Unique(),
+	}
+}
+
+// Edges of the Playlist.
+func (Playlist) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("items", PlaylistItem.Type),
+	}
+}
+
+// Indexes of the Playlist.
+func (Playlist) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id", "updated_at"),
+	}
+}
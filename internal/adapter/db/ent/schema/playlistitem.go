@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PlaylistItem holds the schema definition for the PlaylistItem entity.
+type PlaylistItem struct {
+	ent.Schema
+}
+
+// Fields of the PlaylistItem.
+func (PlaylistItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("playlist_id", uuid.UUID{}),
+		field.UUID("episode_id", uuid.UUID{}),
+		field.Int("position"),
+	}
+}
+
+// Edges of the PlaylistItem.
+func (PlaylistItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("playlist", Playlist.Type).
+			Ref("items").
+			Field("playlist_id").
+			Unique().
+			Required(),
+		edge.To("episode", Episode.Type).
+			Field("episode_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the PlaylistItem.
+func (PlaylistItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("playlist_id", "position").
+			Unique(),
+		index.Fields("playlist_id", "episode_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// QuizItem holds the schema definition for the QuizItem entity.
+type QuizItem struct {
+	ent.Schema
+}
+
+// Mixin of the QuizItem.
+func (QuizItem) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the QuizItem.
+func (QuizItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("episode_id", uuid.UUID{}).
+			Immutable(),
+		field.Int("kind").
+			Immutable(),
+		field.Int("position").
+			Default(0),
+		field.Text("prompt"),
+		field.String("answer"),
+		field.Text("sentence").
+			Default(""),
+		field.Bool("needs_review").
+			Default(true),
+	}
+}
+
+// Edges of the QuizItem.
+func (QuizItem) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the QuizItem.
+func (QuizItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("episode_id", "kind", "position"),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ScheduledTask holds the schema definition for the ScheduledTask entity, a
+// periodic maintenance task shared by the worker processes.
+type ScheduledTask struct {
+	ent.Schema
+}
+
+// Mixin of the ScheduledTask.
+func (ScheduledTask) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ScheduledTask.
+func (ScheduledTask) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.String("name").
+			Unique().
+			Immutable(),
+		field.Int64("interval_seconds"),
+		field.Time("next_run_at"),
+		field.Time("last_run_at").
+			Optional().
+			Nillable(),
+		field.Int("last_status").
+			Default(0),
+		field.String("last_error").
+			Default(""),
+		field.Int64("last_duration_ms").
+			Default(0),
+		field.String("locked_by").
+			Default(""),
+		field.Time("locked_until").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the ScheduledTask.
+func (ScheduledTask) Edges() []ent.Edge {
+	return nil
+}
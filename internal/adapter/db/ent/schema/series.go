@@ -1,11 +1,12 @@
 package schema
 
 import (
-	"time"
-
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -14,6 +15,14 @@ type Series struct {
 	ent.Schema
 }
 
+// Mixin of the Series.
+func (Series) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+		SoftDeleteMixin{},
+	}
+}
+
 // Fields of the Series.
 func (Series) Fields() []ent.Field {
 	return []ent.Field{
@@ -29,20 +38,30 @@ func (Series) Fields() []ent.Field {
 			Default(""),
 		field.String("level").
 			Default(""),
+		field.String("estimated_level").
+			Default(""),
 		field.Strings("tags").
 			Optional(),
 		field.String("cover_url").
 			Default(""),
+		field.UUID("cover_asset_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
 		field.Int("status").
 			Default(0),
+		field.Bool("drm_enabled").
+			Default(false),
+		field.String("accent_color").
+			Default(""),
+		field.UUID("hero_asset_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.String("hero_image_url").
+			Default(""),
+		field.Int("layout").
+			Default(0),
 		field.Int("episode_count").
 			Default(0),
-		field.Time("created_at").
-			Immutable().
-			Default(time.Now),
-		field.Time("updated_at").
-			Default(time.Now).
-			UpdateDefault(time.Now),
 		field.Time("published_at").
 			Optional().
 			Nillable(),
@@ -57,3 +76,21 @@ func (Series) Edges() []ent.Edge {
 		edge.To("episodes", Episode.Type),
 	}
 }
+
+// Indexes of the Series. Listings filter on status, language, level, tags
+// and authors and order by creation time; the tag and author filters are
+// JSON containment checks, which PostgreSQL serves from GIN indexes.
+func (Series) Indexes() []ent.Index {
+	gin := entsql.IndexTypes(map[string]string{dialect.Postgres: "GIN"})
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("status", "created_at"),
+		index.Fields("language", "created_at"),
+		index.Fields("level", "created_at"),
+		index.Fields("estimated_level", "created_at"),
+		index.Fields("tags").
+			Annotations(gin),
+		index.Fields("author_ids").
+			Annotations(gin),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ShadowingSubmission holds the schema definition for the ShadowingSubmission entity.
+type ShadowingSubmission struct {
+	ent.Schema
+}
+
+// Mixin of the ShadowingSubmission.
+func (ShadowingSubmission) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ShadowingSubmission.
+func (ShadowingSubmission) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("user_id"),
+		field.UUID("episode_id", uuid.UUID{}),
+		field.Int("segment_index"),
+		field.UUID("asset_id", uuid.UUID{}),
+		field.Int("status").
+			Default(0),
+		field.Float("auto_score").
+			Optional().
+			Nillable(),
+		field.Text("auto_feedback").
+			Default(""),
+		field.String("reviewer_id").
+			Default(""),
+		field.Int("review_score").
+			Optional().
+			Nillable(),
+		field.Text("review_comment").
+			Default(""),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the ShadowingSubmission.
+func (ShadowingSubmission) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ShadowingSubmission.
+func (ShadowingSubmission) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("episode_id", "status"),
+	}
+}
@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+
+	gen "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/hook"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/intercept"
+)
+
+// SoftDeleteMixin adds a deleted_at timestamp to a schema. Queries leave out
+// rows that have one, eager loads and aggregates included, and deleting a
+// row sets it instead of removing the row.
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+type includeDeletedKey struct{}
+
+// IncludeDeleted returns a context in which soft deletion is off: queries
+// also return deleted rows and deletes remove rows for good.
+func IncludeDeleted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, includeDeletedKey{}, true)
+}
+
+func includesDeleted(ctx context.Context) bool {
+	included, _ := ctx.Value(includeDeletedKey{}).(bool)
+	return included
+}
+
+// Fields of the SoftDeleteMixin.
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Interceptors of the SoftDeleteMixin.
+func (SoftDeleteMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if !includesDeleted(ctx) {
+				q.WhereP(notDeleted)
+			}
+			return nil
+		}),
+	}
+}
+
+// Hooks of the SoftDeleteMixin.
+func (SoftDeleteMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				if includesDeleted(ctx) {
+					return next.Mutate(ctx, m)
+				}
+				mx, ok := m.(interface {
+					SetOp(ent.Op)
+					SetDeletedAt(time.Time)
+					WhereP(...func(*sql.Selector))
+					Client() *gen.Client
+				})
+				if !ok {
+					return nil, fmt.Errorf("soft delete: unexpected mutation type %T", m)
+				}
+				// Deleting a deleted row finds nothing, like deleting a
+				// removed one.
+				mx.WhereP(notDeleted)
+				mx.SetOp(ent.OpUpdate)
+				mx.SetDeletedAt(time.Now().UTC())
+				return mx.Client().Mutate(ctx, m)
+			})
+		}, ent.OpDelete|ent.OpDeleteOne),
+	}
+}
+
+func notDeleted(s *sql.Selector) {
+	s.Where(sql.IsNull(s.C("deleted_at")))
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Subscription holds the schema definition for the Subscription entity.
+type Subscription struct {
+	ent.Schema
+}
+
+// Mixin of the Subscription.
+func (Subscription) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Subscription.
+func (Subscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("user_id").
+			Immutable(),
+		field.UUID("plan_id", uuid.UUID{}),
+		field.Int("status").
+			Default(0),
+		field.Time("current_period_start"),
+		field.Time("current_period_end"),
+		field.String("billing_provider").
+			Default(""),
+		field.String("external_id").
+			Default(""),
+		field.Time("canceled_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Subscription.
+func (Subscription) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Subscription.
+func (Subscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("billing_provider", "external_id"),
+	}
+}
@@ -0,0 +1,109 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/hook"
+)
+
+// TimeMixin adds created_at and updated_at timestamps to a schema, managed
+// by CreateTimeMixin and UpdateTimeMixin.
+type TimeMixin struct {
+	mixin.Schema
+}
+
+// Fields of the TimeMixin.
+func (TimeMixin) Fields() []ent.Field {
+	return append(CreateTimeMixin{}.Fields(), UpdateTimeMixin{}.Fields()...)
+}
+
+// Hooks of the TimeMixin.
+func (TimeMixin) Hooks() []ent.Hook {
+	return append(CreateTimeMixin{}.Hooks(), UpdateTimeMixin{}.Hooks()...)
+}
+
+// CreateTimeMixin adds an immutable created_at timestamp, set when a row is
+// created without one. A zero time counts as none.
+type CreateTimeMixin struct {
+	mixin.Schema
+}
+
+// Fields of the CreateTimeMixin.
+func (CreateTimeMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").
+			Immutable(),
+	}
+}
+
+// Hooks of the CreateTimeMixin.
+func (CreateTimeMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				mx, ok := m.(interface {
+					CreatedAt() (time.Time, bool)
+					SetCreatedAt(time.Time)
+				})
+				if !ok {
+					return nil, fmt.Errorf("create time: unexpected mutation type %T", m)
+				}
+				if createdAt, set := mx.CreatedAt(); !set || createdAt.IsZero() {
+					mx.SetCreatedAt(mutationTime(m))
+				}
+				return next.Mutate(ctx, m)
+			})
+		}, ent.OpCreate),
+	}
+}
+
+// UpdateTimeMixin adds an updated_at timestamp, set whenever a row is
+// created or updated without one. A zero time counts as none.
+type UpdateTimeMixin struct {
+	mixin.Schema
+}
+
+// Fields of the UpdateTimeMixin.
+func (UpdateTimeMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("updated_at"),
+	}
+}
+
+// Hooks of the UpdateTimeMixin.
+func (UpdateTimeMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				mx, ok := m.(interface {
+					UpdatedAt() (time.Time, bool)
+					SetUpdatedAt(time.Time)
+				})
+				if !ok {
+					return nil, fmt.Errorf("update time: unexpected mutation type %T", m)
+				}
+				if updatedAt, set := mx.UpdatedAt(); !set || updatedAt.IsZero() {
+					mx.SetUpdatedAt(mutationTime(m))
+				}
+				return next.Mutate(ctx, m)
+			})
+		}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+	}
+}
+
+// mutationTime is the time a mutation is stamped with. A new row gets the
+// same created_at and updated_at.
+func mutationTime(m ent.Mutation) time.Time {
+	if m.Op().Is(ent.OpCreate) {
+		if createdAt, ok := m.Field("created_at"); ok && !createdAt.(time.Time).IsZero() {
+			return createdAt.(time.Time)
+		}
+	}
+	return time.Now().UTC()
+}
@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// TranscriptReplaceJob holds the schema definition for the TranscriptReplaceJob entity.
+type TranscriptReplaceJob struct {
+	ent.Schema
+}
+
+// Mixin of the TranscriptReplaceJob.
+func (TranscriptReplaceJob) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the TranscriptReplaceJob.
+func (TranscriptReplaceJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.Text("pattern"),
+		field.Text("replacement").
+			Default(""),
+		field.Bool("regex").
+			Default(false),
+		field.Bool("case_insensitive").
+			Default(false),
+		field.UUID("scope_series_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.String("scope_language").
+			Default(""),
+		field.Bool("dry_run").
+			Default(false),
+		field.String("requested_by").
+			Default(""),
+		field.Int("status").
+			Default(0),
+		field.Int("episodes_scanned").
+			Default(0),
+		field.Int("episodes_changed").
+			Default(0),
+		field.Int("matches").
+			Default(0),
+		field.JSON("changes", []core.TranscriptChange{}).
+			Optional(),
+		field.Text("error").
+			Default(""),
+		field.Time("started_at").
+			Optional().
+			Nillable(),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+		field.Time("rolled_back_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the TranscriptReplaceJob.
+func (TranscriptReplaceJob) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the TranscriptReplaceJob.
+func (TranscriptReplaceJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
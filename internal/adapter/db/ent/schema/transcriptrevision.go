@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TranscriptRevision holds the schema definition for the TranscriptRevision entity.
+type TranscriptRevision struct {
+	ent.Schema
+}
+
+// Mixin of the TranscriptRevision.
+func (TranscriptRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the TranscriptRevision.
+func (TranscriptRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("job_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("episode_id", uuid.UUID{}).
+			Immutable(),
+		field.Text("before").
+			Immutable(),
+		field.Text("after").
+			Immutable(),
+		field.Time("rolled_back_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the TranscriptRevision.
+func (TranscriptRevision) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the TranscriptRevision.
+func (TranscriptRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("job_id"),
+		index.Fields("episode_id", "created_at"),
+	}
+}
@@ -1,8 +1,6 @@
 package schema
 
 import (
-	"time"
-
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -13,6 +11,13 @@ type UploadSession struct {
 	ent.Schema
 }
 
+// Mixin of the UploadSession.
+func (UploadSession) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
 // Fields of the UploadSession.
 func (UploadSession) Fields() []ent.Field {
 	return []ent.Field{
@@ -40,12 +45,11 @@ func (UploadSession) Fields() []ent.Field {
 		field.Int64("content_length").
 			Default(0),
 		field.Time("expires_at"),
-		field.Time("created_at").
-			Immutable().
-			Default(time.Now),
-		field.Time("updated_at").
-			Default(time.Now).
-			UpdateDefault(time.Now),
+		field.String("provider").
+			Default(""),
+		field.UUID("replaces_asset_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
 	}
 }
 
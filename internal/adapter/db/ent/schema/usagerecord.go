@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// UsageRecord holds the schema definition for the UsageRecord entity.
+type UsageRecord struct {
+	ent.Schema
+}
+
+// Fields of the UsageRecord.
+func (UsageRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("tenant_id").
+			Immutable(),
+		field.Int("metric").
+			Immutable(),
+		field.Float("quantity").
+			Immutable(),
+		field.Time("occurred_at").
+			Immutable(),
+	}
+}
+
+// Edges of the UsageRecord.
+func (UsageRecord) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the UsageRecord.
+func (UsageRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "occurred_at"),
+	}
+}
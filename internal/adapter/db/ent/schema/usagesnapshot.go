@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// UsageSnapshot holds the schema definition for the UsageSnapshot entity.
+// Snapshots back invoices, so every field is immutable once written.
+type UsageSnapshot struct {
+	ent.Schema
+}
+
+// Mixin of the UsageSnapshot.
+func (UsageSnapshot) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the UsageSnapshot.
+func (UsageSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("tenant_id").
+			Immutable(),
+		field.Time("period_start").
+			Immutable(),
+		field.Time("period_end").
+			Immutable(),
+		field.JSON("lines", []core.UsageLine{}).
+			Immutable().
+			Optional(),
+	}
+}
+
+// Edges of the UsageSnapshot.
+func (UsageSnapshot) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the UsageSnapshot.
+func (UsageSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "period_start").
+			Unique(),
+	}
+}
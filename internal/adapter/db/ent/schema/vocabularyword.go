@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// VocabularyWord holds the schema definition for the VocabularyWord entity.
+type VocabularyWord struct {
+	ent.Schema
+}
+
+// Mixin of the VocabularyWord.
+func (VocabularyWord) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the VocabularyWord.
+func (VocabularyWord) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("user_id").
+			Immutable(),
+		field.String("word").
+			Immutable(),
+		field.Int("status"),
+		field.UUID("episode_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the VocabularyWord.
+func (VocabularyWord) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the VocabularyWord.
+func (VocabularyWord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "word").
+			Unique(),
+		index.Fields("user_id", "status", "updated_at"),
+	}
+}
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"context"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entevent "github.com/eslsoft/lession/internal/adapter/db/ent/generated/event"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// EventRepository persists the domain event log using Ent.
+type EventRepository struct {
+	client *entgenerated.Client
+}
+
+// NewEventRepository constructs an Ent-backed event repository.
+func NewEventRepository(client *entgenerated.Client) *EventRepository {
+	return &EventRepository{client: client}
+}
+
+var _ core.EventRepository = (*EventRepository)(nil)
+
+// AppendEvent stores an event in the log.
+func (r *EventRepository) AppendEvent(ctx context.Context, event core.StoredEvent) error {
+	err := r.client.Event.Create().
+		SetID(event.ID).
+		SetType(string(event.Type)).
+		SetAggregateID(event.AggregateID).
+		SetPayload(event.Payload).
+		SetOccurredAt(event.OccurredAt).
+		Exec(ctx)
+	if err != nil {
+		if entgenerated.IsConstraintError(err) {
+			return core.ErrAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+// ListEvents returns events matching the filter, newest first.
+func (r *EventRepository) ListEvents(ctx context.Context, filter core.EventListFilter) ([]core.StoredEvent, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	q := r.client.Event.Query()
+	if len(filter.Types) > 0 {
+		q = q.Where(entevent.TypeIn(lo.Map(filter.Types, func(eventType core.EventType, _ int) string {
+			return string(eventType)
+		})...))
+	}
+	if filter.AggregateID != "" {
+		q = q.Where(entevent.AggregateID(filter.AggregateID))
+	}
+
+	rows, err := q.
+		Order(entevent.ByOccurredAt(sql.OrderDesc()), entevent.ByID()).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return lo.Map(rows, func(row *entgenerated.Event, _ int) core.StoredEvent {
+		return *toDomainStoredEvent(row)
+	}), nextToken, nil
+}
+
+func toDomainStoredEvent(row *entgenerated.Event) *core.StoredEvent {
+	return &core.StoredEvent{
+		ID:          row.ID,
+		Type:        core.EventType(row.Type),
+		AggregateID: row.AggregateID,
+		Payload:     row.Payload,
+		OccurredAt:  row.OccurredAt,
+	}
+}
@@ -0,0 +1,50 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestEventRepository_AppendAndList(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "event_repo")
+	repo := NewEventRepository(client)
+
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	episodeID := uuid.NewString()
+	events := []core.StoredEvent{
+		{ID: uuid.New(), Type: core.EventTypeEpisodeCreated, AggregateID: episodeID, Payload: []byte(`{}`), OccurredAt: now},
+		{ID: uuid.New(), Type: core.EventTypeAssetReady, AggregateID: uuid.NewString(), Payload: []byte(`{}`), OccurredAt: now.Add(time.Second)},
+		{ID: uuid.New(), Type: core.EventTypeEpisodeDeleted, AggregateID: episodeID, Payload: []byte(`{}`), OccurredAt: now.Add(2 * time.Second)},
+	}
+	for _, event := range events {
+		if err := repo.AppendEvent(ctx, event); err != nil {
+			t.Fatalf("AppendEvent() error = %v", err)
+		}
+	}
+	if err := repo.AppendEvent(ctx, events[0]); !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected ErrAlreadyExists for a repeated event id, got %v", err)
+	}
+
+	history, next, err := repo.ListEvents(ctx, core.EventListFilter{AggregateID: episodeID})
+	if err != nil {
+		t.Fatalf("ListEvents() error = %v", err)
+	}
+	if len(history) != 2 || next != "" || history[0].Type != core.EventTypeEpisodeDeleted || history[1].Type != core.EventTypeEpisodeCreated {
+		t.Fatalf("unexpected episode history %+v", history)
+	}
+
+	ready, _, err := repo.ListEvents(ctx, core.EventListFilter{Types: []core.EventType{core.EventTypeAssetReady}})
+	if err != nil {
+		t.Fatalf("ListEvents() error = %v", err)
+	}
+	if len(ready) != 1 || ready[0].ID != events[1].ID {
+		t.Fatalf("unexpected asset events %+v", ready)
+	}
+}
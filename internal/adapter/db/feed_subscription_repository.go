@@ -0,0 +1,175 @@
+package db
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entfeeditem "github.com/eslsoft/lession/internal/adapter/db/ent/generated/feeditem"
+	entfeedsubscription "github.com/eslsoft/lession/internal/adapter/db/ent/generated/feedsubscription"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// FeedSubscriptionRepository persists feed subscriptions and their ingested items using Ent.
+type FeedSubscriptionRepository struct {
+	client *entgenerated.Client
+}
+
+// NewFeedSubscriptionRepository constructs an Ent-backed feed subscription repository.
+func NewFeedSubscriptionRepository(client *entgenerated.Client) *FeedSubscriptionRepository {
+	return &FeedSubscriptionRepository{client: client}
+}
+
+var _ core.FeedSubscriptionRepository = (*FeedSubscriptionRepository)(nil)
+
+// CreateFeedSubscription stores a new subscription.
+func (r *FeedSubscriptionRepository) CreateFeedSubscription(ctx context.Context, subscription core.FeedSubscription) (*core.FeedSubscription, error) {
+	row, err := r.client.FeedSubscription.Create().
+		SetID(subscription.ID).
+		SetSeriesID(subscription.SeriesID).
+		SetKind(int(subscription.Kind)).
+		SetSourceURL(subscription.SourceURL).
+		SetDownloadMedia(subscription.DownloadMedia).
+		SetAutoPublish(subscription.AutoPublish).
+		SetNillableLastSyncedAt(subscription.LastSyncedAt).
+		SetLastError(subscription.LastError).
+		Save(ctx)
+	if err != nil {
+		if entgenerated.IsConstraintError(err) {
+			return nil, core.ErrAlreadyExists
+		}
+		return nil, err
+	}
+	return toDomainFeedSubscription(row), nil
+}
+
+// GetFeedSubscription loads a subscription by identifier.
+func (r *FeedSubscriptionRepository) GetFeedSubscription(ctx context.Context, id uuid.UUID) (*core.FeedSubscription, error) {
+	row, err := r.client.FeedSubscription.Get(ctx, id)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainFeedSubscription(row), nil
+}
+
+// UpdateFeedSubscription records the outcome of a sync.
+func (r *FeedSubscriptionRepository) UpdateFeedSubscription(ctx context.Context, subscription core.FeedSubscription) (*core.FeedSubscription, error) {
+	builder := r.client.FeedSubscription.UpdateOneID(subscription.ID).
+		SetDownloadMedia(subscription.DownloadMedia).
+		SetAutoPublish(subscription.AutoPublish).
+		SetLastError(subscription.LastError)
+	if subscription.LastSyncedAt != nil {
+		builder.SetLastSyncedAt(*subscription.LastSyncedAt)
+	} else {
+		builder.ClearLastSyncedAt()
+	}
+
+	row, err := builder.Save(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainFeedSubscription(row), nil
+}
+
+// DeleteFeedSubscription removes a subscription and its item log.
+func (r *FeedSubscriptionRepository) DeleteFeedSubscription(ctx context.Context, id uuid.UUID) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.FeedItem.Delete().Where(entfeeditem.SubscriptionID(id)).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.FeedSubscription.DeleteOneID(id).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		if entgenerated.IsNotFound(err) {
+			return core.ErrNotFound
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ListFeedSubscriptions returns subscriptions in creation order.
+func (r *FeedSubscriptionRepository) ListFeedSubscriptions(ctx context.Context, filter core.FeedSubscriptionListFilter) ([]core.FeedSubscription, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	rows, err := r.client.FeedSubscription.Query().
+		Order(entfeedsubscription.ByCreatedAt(), entfeedsubscription.ByID()).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return lo.Map(rows, func(row *entgenerated.FeedSubscription, _ int) core.FeedSubscription {
+		return *toDomainFeedSubscription(row)
+	}), nextToken, nil
+}
+
+// ListFeedItemGUIDs returns the GUIDs of the items ingested from a subscription.
+func (r *FeedSubscriptionRepository) ListFeedItemGUIDs(ctx context.Context, subscriptionID uuid.UUID) ([]string, error) {
+	return r.client.FeedItem.Query().
+		Where(entfeeditem.SubscriptionID(subscriptionID)).
+		Select(entfeeditem.FieldGUID).
+		Strings(ctx)
+}
+
+// RecordFeedItem stores the episode an item was ingested as.
+func (r *FeedSubscriptionRepository) RecordFeedItem(ctx context.Context, subscriptionID uuid.UUID, guid string, episodeID uuid.UUID) error {
+	err := r.client.FeedItem.Create().
+		SetSubscriptionID(subscriptionID).
+		SetGUID(guid).
+		SetEpisodeID(episodeID).
+		Exec(ctx)
+	if err != nil {
+		if entgenerated.IsConstraintError(err) {
+			return core.ErrAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+func toDomainFeedSubscription(row *entgenerated.FeedSubscription) *core.FeedSubscription {
+	return &core.FeedSubscription{
+		ID:            row.ID,
+		SeriesID:      row.SeriesID,
+		Kind:          core.FeedKind(row.Kind),
+		SourceURL:     row.SourceURL,
+		DownloadMedia: row.DownloadMedia,
+		AutoPublish:   row.AutoPublish,
+		LastSyncedAt:  row.LastSyncedAt,
+		LastError:     row.LastError,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+	}
+}
@@ -0,0 +1,74 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestFeedSubscriptionRepository(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "feed_subscription_repo")
+	repo := NewFeedSubscriptionRepository(client)
+
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	subscription, err := repo.CreateFeedSubscription(ctx, core.FeedSubscription{
+		ID:        uuid.New(),
+		SeriesID:  uuid.New(),
+		Kind:      core.FeedKindPodcast,
+		SourceURL: "https://podcasts.example.com/feed.xml",
+	})
+	if err != nil {
+		t.Fatalf("CreateFeedSubscription() error = %v", err)
+	}
+	if _, err := repo.CreateFeedSubscription(ctx, core.FeedSubscription{
+		ID: uuid.New(), SeriesID: subscription.SeriesID, Kind: core.FeedKindPodcast, SourceURL: "https://other.example.com/feed.xml",
+	}); !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected a second subscription of the series to be rejected, got %v", err)
+	}
+
+	syncedAt := now.Add(time.Hour)
+	subscription.LastSyncedAt = &syncedAt
+	subscription.LastError = "feed: get: 503 Service Unavailable"
+	if _, err := repo.UpdateFeedSubscription(ctx, *subscription); err != nil {
+		t.Fatalf("UpdateFeedSubscription() error = %v", err)
+	}
+	got, err := repo.GetFeedSubscription(ctx, subscription.ID)
+	if err != nil {
+		t.Fatalf("GetFeedSubscription() error = %v", err)
+	}
+	if got.LastSyncedAt == nil || !got.LastSyncedAt.Equal(syncedAt) || got.LastError == "" || got.Kind != core.FeedKindPodcast {
+		t.Fatalf("unexpected subscription %+v", got)
+	}
+
+	if err := repo.RecordFeedItem(ctx, subscription.ID, "ep-1", uuid.New()); err != nil {
+		t.Fatalf("RecordFeedItem() error = %v", err)
+	}
+	if err := repo.RecordFeedItem(ctx, subscription.ID, "ep-1", uuid.New()); !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected duplicate item to be rejected, got %v", err)
+	}
+	guids, err := repo.ListFeedItemGUIDs(ctx, subscription.ID)
+	if err != nil || len(guids) != 1 || guids[0] != "ep-1" {
+		t.Fatalf("ListFeedItemGUIDs() = %v, %v", guids, err)
+	}
+
+	listed, next, err := repo.ListFeedSubscriptions(ctx, core.FeedSubscriptionListFilter{})
+	if err != nil || len(listed) != 1 || next != "" {
+		t.Fatalf("ListFeedSubscriptions() = %v, %q, %v", listed, next, err)
+	}
+
+	if err := repo.DeleteFeedSubscription(ctx, subscription.ID); err != nil {
+		t.Fatalf("DeleteFeedSubscription() error = %v", err)
+	}
+	if _, err := repo.GetFeedSubscription(ctx, subscription.ID); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected not found after delete, got %v", err)
+	}
+	if err := repo.DeleteFeedSubscription(ctx, subscription.ID); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected not found deleting again, got %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entinvoice "github.com/eslsoft/lession/internal/adapter/db/ent/generated/invoice"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// InvoiceRepository persists billing provider invoices using Ent.
+type InvoiceRepository struct {
+	client *entgenerated.Client
+}
+
+// NewInvoiceRepository constructs an Ent-backed invoice repository.
+func NewInvoiceRepository(client *entgenerated.Client) *InvoiceRepository {
+	return &InvoiceRepository{client: client}
+}
+
+var _ core.InvoiceRepository = (*InvoiceRepository)(nil)
+
+// UpsertInvoice stores the invoice keyed by provider and external ID so that
+// redelivered webhooks update the existing row.
+func (r *InvoiceRepository) UpsertInvoice(ctx context.Context, invoice core.Invoice) (*core.Invoice, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := tx.Invoice.Query().
+		Where(
+			entinvoice.BillingProvider(invoice.BillingProvider),
+			entinvoice.ExternalID(invoice.ExternalID),
+		).
+		Only(ctx)
+	if err != nil && !entgenerated.IsNotFound(err) {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	var row *entgenerated.Invoice
+	if existing == nil {
+		row, err = tx.Invoice.Create().
+			SetID(invoice.ID).
+			SetSubscriptionID(invoice.SubscriptionID).
+			SetUserID(invoice.UserID).
+			SetBillingProvider(invoice.BillingProvider).
+			SetExternalID(invoice.ExternalID).
+			SetAmountCents(invoice.AmountCents).
+			SetCurrency(invoice.Currency).
+			SetStatus(int(invoice.Status)).
+			SetPeriodStart(invoice.PeriodStart).
+			SetPeriodEnd(invoice.PeriodEnd).
+			SetHostedURL(invoice.HostedURL).
+			SetNillablePaidAt(invoice.PaidAt).
+			Save(ctx)
+	} else {
+		builder := tx.Invoice.UpdateOne(existing).
+			SetAmountCents(invoice.AmountCents).
+			SetCurrency(invoice.Currency).
+			SetStatus(int(invoice.Status)).
+			SetPeriodStart(invoice.PeriodStart).
+			SetPeriodEnd(invoice.PeriodEnd).
+			SetHostedURL(invoice.HostedURL)
+		if invoice.PaidAt != nil {
+			builder.SetPaidAt(*invoice.PaidAt)
+		}
+		row, err = builder.Save(ctx)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toDomainInvoice(row), nil
+}
+
+// ListInvoices returns the learner's invoices, newest first.
+func (r *InvoiceRepository) ListInvoices(ctx context.Context, userID string) ([]core.Invoice, error) {
+	rows, err := r.client.Invoice.Query().
+		Where(entinvoice.UserID(userID)).
+		Order(entinvoice.ByCreatedAt(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row *entgenerated.Invoice, _ int) core.Invoice {
+		return *toDomainInvoice(row)
+	}), nil
+}
+
+func toDomainInvoice(row *entgenerated.Invoice) *core.Invoice {
+	return &core.Invoice{
+		ID:              row.ID,
+		SubscriptionID:  row.SubscriptionID,
+		UserID:          row.UserID,
+		BillingProvider: row.BillingProvider,
+		ExternalID:      row.ExternalID,
+		AmountCents:     row.AmountCents,
+		Currency:        row.Currency,
+		Status:          core.InvoiceStatus(row.Status),
+		PeriodStart:     row.PeriodStart.UTC(),
+		PeriodEnd:       row.PeriodEnd.UTC(),
+		HostedURL:       row.HostedURL,
+		PaidAt:          row.PaidAt,
+		CreatedAt:       row.CreatedAt,
+		UpdatedAt:       row.UpdatedAt,
+	}
+}
@@ -0,0 +1,73 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestInvoiceRepository_UpsertKeepsOneRowPerExternalID(t *testing.T) {
+	ctx := context.Background()
+	repo := NewInvoiceRepository(newTestClient(t, "invoice_repo"))
+
+	now := time.Date(2024, 8, 6, 9, 0, 0, 0, time.UTC)
+	subscriptionID := uuid.New()
+	open := core.Invoice{
+		ID:              uuid.New(),
+		SubscriptionID:  subscriptionID,
+		UserID:          "learner",
+		BillingProvider: "stripe",
+		ExternalID:      "in_123",
+		AmountCents:     999,
+		Currency:        "usd",
+		Status:          core.InvoiceStatusOpen,
+		PeriodStart:     now,
+		PeriodEnd:       now.AddDate(0, 1, 0),
+		HostedURL:       "https://pay.example.com/in_123",
+	}
+	if _, err := repo.UpsertInvoice(ctx, open); err != nil {
+		t.Fatalf("UpsertInvoice() error = %v", err)
+	}
+
+	// A redelivered webhook carries a new id for the same provider invoice.
+	paidAt := now.Add(time.Hour)
+	paid := open
+	paid.ID = uuid.New()
+	paid.Status = core.InvoiceStatusPaid
+	paid.PaidAt = &paidAt
+	stored, err := repo.UpsertInvoice(ctx, paid)
+	if err != nil {
+		t.Fatalf("UpsertInvoice() error = %v", err)
+	}
+	if stored.ID != open.ID || stored.Status != core.InvoiceStatusPaid || stored.PaidAt == nil || !stored.PaidAt.Equal(paidAt) {
+		t.Fatalf("expected the existing invoice to be updated, got %+v", stored)
+	}
+
+	// A late open event does not clear the payment.
+	if stored, err := repo.UpsertInvoice(ctx, open); err != nil || stored.PaidAt == nil {
+		t.Fatalf("expected the payment time to be kept, got %+v, %v", stored, err)
+	}
+
+	next := open
+	next.ID = uuid.New()
+	next.ExternalID = "in_124"
+	next.PeriodStart, next.PeriodEnd = open.PeriodEnd, open.PeriodEnd.AddDate(0, 1, 0)
+	if _, err := repo.UpsertInvoice(ctx, next); err != nil {
+		t.Fatalf("UpsertInvoice() error = %v", err)
+	}
+
+	invoices, err := repo.ListInvoices(ctx, "learner")
+	if err != nil {
+		t.Fatalf("ListInvoices() error = %v", err)
+	}
+	if len(invoices) != 2 || invoices[0].ExternalID != "in_124" || invoices[1].SubscriptionID != subscriptionID {
+		t.Fatalf("expected one invoice per external id, newest first, got %+v", invoices)
+	}
+	if others, err := repo.ListInvoices(ctx, "someone"); err != nil || len(others) != 0 {
+		t.Fatalf("expected no invoices for another learner, got %+v, %v", others, err)
+	}
+}
@@ -0,0 +1,214 @@
+package db
+
+import (
+	"context"
+	"strconv"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entjob "github.com/eslsoft/lession/internal/adapter/db/ent/generated/job"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// JobRepository persists the background job queue using Ent.
+type JobRepository struct {
+	client *entgenerated.Client
+}
+
+// NewJobRepository constructs an Ent-backed job repository.
+func NewJobRepository(client *entgenerated.Client) *JobRepository {
+	return &JobRepository{client: client}
+}
+
+var _ core.JobRepository = (*JobRepository)(nil)
+
+// CreateJob enqueues a job.
+func (r *JobRepository) CreateJob(ctx context.Context, job core.Job) (*core.Job, error) {
+	builder := r.client.Job.Create().
+		SetID(job.ID).
+		SetKind(job.Kind).
+		SetPayload(job.Payload).
+		SetStatus(int(job.Status)).
+		SetAttempts(job.Attempts).
+		SetRunAt(job.RunAt)
+	if job.DedupeKey != "" {
+		builder.SetDedupeKey(job.DedupeKey)
+	}
+
+	row, err := builder.Save(ctx)
+	if err != nil {
+		if entgenerated.IsConstraintError(err) {
+			return nil, core.ErrAlreadyExists
+		}
+		return nil, err
+	}
+	return toDomainJob(row), nil
+}
+
+// GetJob fetches a job by id.
+func (r *JobRepository) GetJob(ctx context.Context, id uuid.UUID) (*core.Job, error) {
+	row, err := r.client.Job.Get(ctx, id)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainJob(row), nil
+}
+
+// UpdateJob records the progress of a job.
+func (r *JobRepository) UpdateJob(ctx context.Context, job core.Job) (*core.Job, error) {
+	builder := r.client.Job.UpdateOneID(job.ID).
+		SetStatus(int(job.Status)).
+		SetAttempts(job.Attempts).
+		SetLastError(job.LastError).
+		SetRunAt(job.RunAt).
+		SetLockedBy(job.LockedBy)
+	if job.LockedUntil != nil {
+		builder.SetLockedUntil(*job.LockedUntil)
+	} else {
+		builder.ClearLockedUntil()
+	}
+	if job.FinishedAt != nil {
+		builder.SetFinishedAt(*job.FinishedAt)
+	} else {
+		builder.ClearFinishedAt()
+	}
+
+	row, err := builder.Save(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainJob(row), nil
+}
+
+// ListJobs returns jobs matching the filter, newest first.
+func (r *JobRepository) ListJobs(ctx context.Context, filter core.JobListFilter) ([]core.Job, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	q := r.client.Job.Query()
+	if len(filter.Kinds) > 0 {
+		q = q.Where(entjob.KindIn(filter.Kinds...))
+	}
+	if len(filter.Statuses) > 0 {
+		q = q.Where(entjob.StatusIn(lo.Map(filter.Statuses, func(status core.JobStatus, _ int) int {
+			return int(status)
+		})...))
+	}
+
+	rows, err := q.
+		Order(entjob.ByCreatedAt(sql.OrderDesc()), entjob.ByID()).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return lo.Map(rows, func(row *entgenerated.Job, _ int) core.Job {
+		return *toDomainJob(row)
+	}), nextToken, nil
+}
+
+// ClaimJobs leases due jobs in one transaction. On PostgreSQL and MySQL the
+// candidate rows are locked with FOR UPDATE SKIP LOCKED, so concurrent
+// workers never wait on or double-claim each other's jobs; SQLite has a
+// single writer anyway.
+func (r *JobRepository) ClaimJobs(ctx context.Context, claim core.JobClaim) ([]core.Job, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	q := tx.Job.Query().
+		Where(
+			entjob.Or(
+				entjob.And(
+					entjob.Status(int(core.JobStatusPending)),
+					entjob.RunAtLTE(claim.Now),
+				),
+				entjob.And(
+					entjob.Status(int(core.JobStatusRunning)),
+					entjob.LockedUntilLT(claim.Now),
+				),
+			),
+			func(s *sql.Selector) {
+				if s.Dialect() != dialect.SQLite {
+					s.ForUpdate(sql.WithLockAction(sql.SkipLocked))
+				}
+			},
+		)
+	if len(claim.Kinds) > 0 {
+		q = q.Where(entjob.KindIn(claim.Kinds...))
+	}
+
+	rows, err := q.
+		Order(entjob.ByRunAt(), entjob.ByID()).
+		Limit(claim.Limit).
+		All(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	lockedUntil := claim.Now.Add(claim.Lease)
+	claimed := make([]core.Job, 0, len(rows))
+	for _, row := range rows {
+		updated, err := tx.Job.UpdateOneID(row.ID).
+			SetStatus(int(core.JobStatusRunning)).
+			AddAttempts(1).
+			SetLockedBy(claim.Worker).
+			SetLockedUntil(lockedUntil).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		claimed = append(claimed, *toDomainJob(updated))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+func toDomainJob(row *entgenerated.Job) *core.Job {
+	return &core.Job{
+		ID:          row.ID,
+		Kind:        row.Kind,
+		Payload:     row.Payload,
+		Status:      core.JobStatus(row.Status),
+		Attempts:    row.Attempts,
+		LastError:   row.LastError,
+		DedupeKey:   lo.FromPtr(row.DedupeKey),
+		RunAt:       row.RunAt,
+		LockedBy:    row.LockedBy,
+		LockedUntil: row.LockedUntil,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+		FinishedAt:  row.FinishedAt,
+	}
+}
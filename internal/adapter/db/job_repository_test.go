@@ -0,0 +1,87 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestJobRepository_ClaimJobs(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "job_repo")
+	repo := NewJobRepository(client)
+
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	newJob := func(kind string, runAt time.Time, dedupeKey string) core.Job {
+		return core.Job{
+			ID:        uuid.New(),
+			Kind:      kind,
+			Payload:   []byte(`{}`),
+			Status:    core.JobStatusPending,
+			DedupeKey: dedupeKey,
+			RunAt:     runAt,
+		}
+	}
+	due := newJob("webhook.asset_ready", now.Add(-time.Minute), "webhook.asset_ready:1")
+	later := newJob("webhook.asset_ready", now.Add(time.Hour), "")
+	other := newJob("search.reindex", now.Add(-time.Minute), "")
+	for _, job := range []core.Job{due, later, other} {
+		if _, err := repo.CreateJob(ctx, job); err != nil {
+			t.Fatalf("CreateJob() error = %v", err)
+		}
+	}
+	if _, err := repo.CreateJob(ctx, newJob("webhook.asset_ready", now, "webhook.asset_ready:1")); !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected ErrAlreadyExists for a repeated dedupe key, got %v", err)
+	}
+
+	claim := core.JobClaim{Worker: "worker-a", Kinds: []string{"webhook.asset_ready"}, Now: now, Lease: time.Minute, Limit: 10}
+	claimed, err := repo.ClaimJobs(ctx, claim)
+	if err != nil {
+		t.Fatalf("ClaimJobs() error = %v", err)
+	}
+	if len(claimed) != 1 || claimed[0].ID != due.ID {
+		t.Fatalf("expected only the due job of the claimed kind, got %+v", claimed)
+	}
+	leased := claimed[0]
+	if leased.Status != core.JobStatusRunning || leased.Attempts != 1 || leased.LockedBy != "worker-a" || !leased.LockedUntil.Equal(now.Add(time.Minute)) {
+		t.Fatalf("unexpected lease %+v", leased)
+	}
+
+	if again, _ := repo.ClaimJobs(ctx, claim); len(again) != 0 {
+		t.Fatalf("expected a leased job not to be claimed twice, got %+v", again)
+	}
+
+	// Once the lease expires, another worker takes the job over.
+	claim.Worker = "worker-b"
+	claim.Now = now.Add(2 * time.Minute)
+	takenOver, err := repo.ClaimJobs(ctx, claim)
+	if err != nil {
+		t.Fatalf("ClaimJobs() error = %v", err)
+	}
+	if len(takenOver) != 1 || takenOver[0].ID != due.ID || takenOver[0].Attempts != 2 || takenOver[0].LockedBy != "worker-b" {
+		t.Fatalf("expected the expired lease to be taken over, got %+v", takenOver)
+	}
+
+	finished := takenOver[0]
+	finishedAt := claim.Now
+	finished.Status = core.JobStatusSucceeded
+	finished.LockedBy = ""
+	finished.LockedUntil = nil
+	finished.FinishedAt = &finishedAt
+	if _, err := repo.UpdateJob(ctx, finished); err != nil {
+		t.Fatalf("UpdateJob() error = %v", err)
+	}
+
+	jobs, next, err := repo.ListJobs(ctx, core.JobListFilter{Statuses: []core.JobStatus{core.JobStatusSucceeded}})
+	if err != nil {
+		t.Fatalf("ListJobs() error = %v", err)
+	}
+	if len(jobs) != 1 || next != "" || jobs[0].ID != due.ID || jobs[0].LockedUntil != nil || jobs[0].DedupeKey != "webhook.asset_ready:1" {
+		t.Fatalf("unexpected succeeded jobs %+v", jobs)
+	}
+}
@@ -0,0 +1,112 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entactivity "github.com/eslsoft/lession/internal/adapter/db/ent/generated/learneractivity"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// LearnerActivityRepository persists daily learner activity using Ent.
+type LearnerActivityRepository struct {
+	client *entgenerated.Client
+}
+
+// NewLearnerActivityRepository constructs an Ent-backed learner activity repository.
+func NewLearnerActivityRepository(client *entgenerated.Client) *LearnerActivityRepository {
+	return &LearnerActivityRepository{client: client}
+}
+
+var _ core.LearnerActivityRepository = (*LearnerActivityRepository)(nil)
+
+// RecordActivity adds the supplied counters to the learner's row for the day, creating it when absent.
+func (r *LearnerActivityRepository) RecordActivity(ctx context.Context, activity core.LearnerActivity) (*core.LearnerActivity, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := tx.LearnerActivity.Query().
+		Where(
+			entactivity.UserID(activity.UserID),
+			entactivity.Day(activity.Day),
+		).
+		Only(ctx)
+
+	var record *entgenerated.LearnerActivity
+	switch {
+	case entgenerated.IsNotFound(err):
+		record, err = tx.LearnerActivity.Create().
+			SetID(uuid.New()).
+			SetUserID(activity.UserID).
+			SetDay(activity.Day).
+			SetMinutesListened(activity.MinutesListened).
+			SetEpisodesCompleted(activity.EpisodesCompleted).
+			Save(ctx)
+	case err == nil:
+		record, err = tx.LearnerActivity.UpdateOneID(existing.ID).
+			AddMinutesListened(activity.MinutesListened).
+			AddEpisodesCompleted(activity.EpisodesCompleted).
+			Save(ctx)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toDomainLearnerActivity(record), nil
+}
+
+// ListActivity returns the learner's recorded days on or after since in ascending order.
+func (r *LearnerActivityRepository) ListActivity(ctx context.Context, userID string, since time.Time) ([]core.LearnerActivity, error) {
+	query := r.client.LearnerActivity.Query().
+		Where(entactivity.UserID(userID))
+	if !since.IsZero() {
+		query = query.Where(entactivity.DayGTE(since))
+	}
+
+	rows, err := query.
+		Order(entgenerated.Asc(entactivity.FieldDay)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row *entgenerated.LearnerActivity, _ int) core.LearnerActivity {
+		return *toDomainLearnerActivity(row)
+	}), nil
+}
+
+// ListActiveUsers returns learners who listened to or completed anything on day.
+func (r *LearnerActivityRepository) ListActiveUsers(ctx context.Context, day time.Time) ([]string, error) {
+	return r.client.LearnerActivity.Query().
+		Where(
+			entactivity.Day(day),
+			entactivity.Or(
+				entactivity.MinutesListenedGT(0),
+				entactivity.EpisodesCompletedGT(0),
+			),
+		).
+		Unique(true).
+		Order(entactivity.ByUserID()).
+		Select(entactivity.FieldUserID).
+		Strings(ctx)
+}
+
+func toDomainLearnerActivity(row *entgenerated.LearnerActivity) *core.LearnerActivity {
+	return &core.LearnerActivity{
+		UserID:            row.UserID,
+		Day:               row.Day.UTC(),
+		MinutesListened:   row.MinutesListened,
+		EpisodesCompleted: row.EpisodesCompleted,
+	}
+}
@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestLearnerActivityRepository_AccumulatesDays(t *testing.T) {
+	ctx := context.Background()
+	repo := NewLearnerActivityRepository(newTestClient(t, "learner_activity_repo"))
+
+	day := time.Date(2024, 8, 3, 0, 0, 0, 0, time.UTC)
+	for _, activity := range []core.LearnerActivity{
+		{UserID: "learner", Day: day.AddDate(0, 0, -2), MinutesListened: 5},
+		{UserID: "learner", Day: day, MinutesListened: 10},
+		{UserID: "learner", Day: day, MinutesListened: 4, EpisodesCompleted: 1},
+		{UserID: "another", Day: day, EpisodesCompleted: 2},
+		{UserID: "idle", Day: day},
+	} {
+		if _, err := repo.RecordActivity(ctx, activity); err != nil {
+			t.Fatalf("RecordActivity() error = %v", err)
+		}
+	}
+
+	days, err := repo.ListActivity(ctx, "learner", time.Time{})
+	if err != nil {
+		t.Fatalf("ListActivity() error = %v", err)
+	}
+	if len(days) != 2 || !days[0].Day.Equal(day.AddDate(0, 0, -2)) {
+		t.Fatalf("expected two days in ascending order, got %+v", days)
+	}
+	if today := days[1]; today.MinutesListened != 14 || today.EpisodesCompleted != 1 {
+		t.Fatalf("expected the counters of the day to add up, got %+v", today)
+	}
+
+	recent, err := repo.ListActivity(ctx, "learner", day.AddDate(0, 0, -1))
+	if err != nil {
+		t.Fatalf("ListActivity() error = %v", err)
+	}
+	if len(recent) != 1 || !recent[0].Day.Equal(day) {
+		t.Fatalf("expected only the days since the cutoff, got %+v", recent)
+	}
+
+	active, err := repo.ListActiveUsers(ctx, day)
+	if err != nil {
+		t.Fatalf("ListActiveUsers() error = %v", err)
+	}
+	if !slices.Equal(active, []string{"another", "learner"}) {
+		t.Fatalf("expected learners with activity on the day, got %v", active)
+	}
+}
@@ -0,0 +1,210 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entltiloginstate "github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltiloginstate"
+	entltiplatform "github.com/eslsoft/lession/internal/adapter/db/ent/generated/ltiplatform"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// LTIRepository persists LTI platform registrations and launches using Ent.
+type LTIRepository struct {
+	client *entgenerated.Client
+}
+
+// NewLTIRepository constructs an Ent-backed LTI repository.
+func NewLTIRepository(client *entgenerated.Client) *LTIRepository {
+	return &LTIRepository{client: client}
+}
+
+var _ core.LTIRepository = (*LTIRepository)(nil)
+
+// CreatePlatform registers an LMS platform.
+func (r *LTIRepository) CreatePlatform(ctx context.Context, platform core.LTIPlatform) (*core.LTIPlatform, error) {
+	row, err := r.client.LTIPlatform.Create().
+		SetID(platform.ID).
+		SetIssuer(platform.Issuer).
+		SetClientID(platform.ClientID).
+		SetDeploymentID(platform.DeploymentID).
+		SetAuthLoginURL(platform.AuthLoginURL).
+		SetAuthTokenURL(platform.AuthTokenURL).
+		SetJwksURL(platform.JWKSURL).
+		Save(ctx)
+	if err != nil {
+		if entgenerated.IsConstraintError(err) {
+			return nil, core.ErrAlreadyExists
+		}
+		return nil, err
+	}
+	return toDomainLTIPlatform(row), nil
+}
+
+// GetPlatform loads a platform registration by identifier.
+func (r *LTIRepository) GetPlatform(ctx context.Context, id uuid.UUID) (*core.LTIPlatform, error) {
+	row, err := r.client.LTIPlatform.Get(ctx, id)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainLTIPlatform(row), nil
+}
+
+// FindPlatform loads the registration for an issuer and client ID.
+func (r *LTIRepository) FindPlatform(ctx context.Context, issuer, clientID string) (*core.LTIPlatform, error) {
+	row, err := r.client.LTIPlatform.Query().
+		Where(
+			entltiplatform.Issuer(issuer),
+			entltiplatform.ClientID(clientID),
+		).
+		Only(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainLTIPlatform(row), nil
+}
+
+// ListPlatforms returns every registered platform, oldest first.
+func (r *LTIRepository) ListPlatforms(ctx context.Context) ([]core.LTIPlatform, error) {
+	rows, err := r.client.LTIPlatform.Query().
+		Order(entgenerated.Asc(entltiplatform.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(row *entgenerated.LTIPlatform, _ int) core.LTIPlatform {
+		return *toDomainLTIPlatform(row)
+	}), nil
+}
+
+// SaveLoginState stores an OIDC login state until the launch consumes it.
+func (r *LTIRepository) SaveLoginState(ctx context.Context, state core.LTILoginState) error {
+	return r.client.LTILoginState.Create().
+		SetState(state.State).
+		SetNonce(state.Nonce).
+		SetPlatformID(state.PlatformID).
+		SetExpiresAt(state.ExpiresAt).
+		Exec(ctx)
+}
+
+// ConsumeLoginState deletes and returns a login state so it is used at most once.
+func (r *LTIRepository) ConsumeLoginState(ctx context.Context, state string) (*core.LTILoginState, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := tx.LTILoginState.Query().
+		Where(entltiloginstate.State(state)).
+		Only(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	if err := tx.LTILoginState.DeleteOne(row).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &core.LTILoginState{
+		State:      row.State,
+		Nonce:      row.Nonce,
+		PlatformID: row.PlatformID,
+		ExpiresAt:  row.ExpiresAt,
+	}, nil
+}
+
+// CreateLaunch records a verified launch.
+func (r *LTIRepository) CreateLaunch(ctx context.Context, launch core.LTILaunch) (*core.LTILaunch, error) {
+	builder := r.client.LTILaunch.Create().
+		SetID(launch.ID).
+		SetPlatformID(launch.PlatformID).
+		SetMessageType(int(launch.Type)).
+		SetSubject(launch.Subject).
+		SetUserID(launch.UserID).
+		SetResourceLinkID(launch.ResourceLinkID).
+		SetLineItemURL(launch.LineItemURL).
+		SetDeepLinkReturnURL(launch.DeepLinkReturnURL).
+		SetDeepLinkData(launch.DeepLinkData)
+	if launch.EpisodeID != uuid.Nil {
+		builder.SetEpisodeID(launch.EpisodeID)
+	}
+
+	row, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainLTILaunch(row), nil
+}
+
+// GetLaunch loads a launch by identifier.
+func (r *LTIRepository) GetLaunch(ctx context.Context, id uuid.UUID) (*core.LTILaunch, error) {
+	row, err := r.client.LTILaunch.Get(ctx, id)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainLTILaunch(row), nil
+}
+
+// MarkScoreSubmitted records when the launch's score was last posted.
+func (r *LTIRepository) MarkScoreSubmitted(ctx context.Context, id uuid.UUID, at time.Time) (*core.LTILaunch, error) {
+	row, err := r.client.LTILaunch.UpdateOneID(id).
+		SetScoreSubmittedAt(at).
+		Save(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainLTILaunch(row), nil
+}
+
+func toDomainLTIPlatform(row *entgenerated.LTIPlatform) *core.LTIPlatform {
+	return &core.LTIPlatform{
+		ID:           row.ID,
+		Issuer:       row.Issuer,
+		ClientID:     row.ClientID,
+		DeploymentID: row.DeploymentID,
+		AuthLoginURL: row.AuthLoginURL,
+		AuthTokenURL: row.AuthTokenURL,
+		JWKSURL:      row.JwksURL,
+		CreatedAt:    row.CreatedAt,
+	}
+}
+
+func toDomainLTILaunch(row *entgenerated.LTILaunch) *core.LTILaunch {
+	return &core.LTILaunch{
+		ID:                row.ID,
+		PlatformID:        row.PlatformID,
+		Type:              core.LTIMessageType(row.MessageType),
+		Subject:           row.Subject,
+		UserID:            row.UserID,
+		EpisodeID:         row.EpisodeID,
+		ResourceLinkID:    row.ResourceLinkID,
+		LineItemURL:       row.LineItemURL,
+		DeepLinkReturnURL: row.DeepLinkReturnURL,
+		DeepLinkData:      row.DeepLinkData,
+		ScoreSubmittedAt:  row.ScoreSubmittedAt,
+		CreatedAt:         row.CreatedAt,
+	}
+}
@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestLTIRepository_PlatformsAndLaunches(t *testing.T) {
+	ctx := context.Background()
+	repo := NewLTIRepository(newTestClient(t, "lti_repo"))
+
+	now := time.Date(2024, 8, 4, 9, 0, 0, 0, time.UTC)
+	platform := core.LTIPlatform{
+		ID:           uuid.New(),
+		Issuer:       "https://lms.example.edu",
+		ClientID:     "lession",
+		DeploymentID: "deployment-1",
+		AuthLoginURL: "https://lms.example.edu/auth",
+		AuthTokenURL: "https://lms.example.edu/token",
+		JWKSURL:      "https://lms.example.edu/jwks",
+	}
+	if _, err := repo.CreatePlatform(ctx, platform); err != nil {
+		t.Fatalf("CreatePlatform() error = %v", err)
+	}
+	duplicate := platform
+	duplicate.ID = uuid.New()
+	if _, err := repo.CreatePlatform(ctx, duplicate); !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected ErrAlreadyExists registering the issuer and client twice, got %v", err)
+	}
+
+	found, err := repo.FindPlatform(ctx, platform.Issuer, platform.ClientID)
+	if err != nil {
+		t.Fatalf("FindPlatform() error = %v", err)
+	}
+	if found.ID != platform.ID || found.JWKSURL != platform.JWKSURL {
+		t.Fatalf("unexpected platform %+v", found)
+	}
+	if _, err := repo.FindPlatform(ctx, platform.Issuer, "someone-else"); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for an unknown client, got %v", err)
+	}
+	if platforms, err := repo.ListPlatforms(ctx); err != nil || len(platforms) != 1 {
+		t.Fatalf("ListPlatforms() = %+v, %v", platforms, err)
+	}
+
+	state := core.LTILoginState{State: "state-1", Nonce: "nonce-1", PlatformID: platform.ID, ExpiresAt: now.Add(5 * time.Minute)}
+	if err := repo.SaveLoginState(ctx, state); err != nil {
+		t.Fatalf("SaveLoginState() error = %v", err)
+	}
+	consumed, err := repo.ConsumeLoginState(ctx, state.State)
+	if err != nil {
+		t.Fatalf("ConsumeLoginState() error = %v", err)
+	}
+	if consumed.Nonce != state.Nonce || consumed.PlatformID != platform.ID {
+		t.Fatalf("unexpected login state %+v", consumed)
+	}
+	if _, err := repo.ConsumeLoginState(ctx, state.State); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected a login state to be usable once, got %v", err)
+	}
+
+	launch := core.LTILaunch{
+		ID:             uuid.New(),
+		PlatformID:     platform.ID,
+		Type:           core.LTIMessageTypeResourceLink,
+		Subject:        "student-7",
+		UserID:         "learner",
+		EpisodeID:      uuid.New(),
+		ResourceLinkID: "link-1",
+		LineItemURL:    "https://lms.example.edu/lineitems/1",
+	}
+	if _, err := repo.CreateLaunch(ctx, launch); err != nil {
+		t.Fatalf("CreateLaunch() error = %v", err)
+	}
+	submittedAt := now.Add(time.Hour)
+	if _, err := repo.MarkScoreSubmitted(ctx, launch.ID, submittedAt); err != nil {
+		t.Fatalf("MarkScoreSubmitted() error = %v", err)
+	}
+	stored, err := repo.GetLaunch(ctx, launch.ID)
+	if err != nil {
+		t.Fatalf("GetLaunch() error = %v", err)
+	}
+	if stored.EpisodeID != launch.EpisodeID || stored.Type != core.LTIMessageTypeResourceLink || stored.ScoreSubmittedAt == nil || !stored.ScoreSubmittedAt.Equal(submittedAt) {
+		t.Fatalf("unexpected launch %+v", stored)
+	}
+	if _, err := repo.MarkScoreSubmitted(ctx, uuid.New(), submittedAt); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for an unknown launch, got %v", err)
+	}
+}
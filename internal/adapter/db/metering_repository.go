@@ -0,0 +1,150 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entusagerecord "github.com/eslsoft/lession/internal/adapter/db/ent/generated/usagerecord"
+	entusagesnapshot "github.com/eslsoft/lession/internal/adapter/db/ent/generated/usagesnapshot"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// MeteringRepository persists usage records and snapshots using Ent.
+type MeteringRepository struct {
+	client *entgenerated.Client
+}
+
+// NewMeteringRepository constructs an Ent-backed metering repository.
+func NewMeteringRepository(client *entgenerated.Client) *MeteringRepository {
+	return &MeteringRepository{client: client}
+}
+
+var _ core.MeteringRepository = (*MeteringRepository)(nil)
+
+// RecordUsage stores a single usage record.
+func (r *MeteringRepository) RecordUsage(ctx context.Context, record core.UsageRecord) error {
+	return r.client.UsageRecord.Create().
+		SetID(record.ID).
+		SetTenantID(record.TenantID).
+		SetMetric(int(record.Metric)).
+		SetQuantity(record.Quantity).
+		SetOccurredAt(record.OccurredAt).
+		Exec(ctx)
+}
+
+// SumUsage totals recorded usage per metric within [from, to).
+func (r *MeteringRepository) SumUsage(ctx context.Context, tenantID string, from, to time.Time) ([]core.UsageLine, error) {
+	var rows []usageSum
+	err := r.client.UsageRecord.Query().
+		Where(
+			entusagerecord.TenantID(tenantID),
+			entusagerecord.OccurredAtGTE(from),
+			entusagerecord.OccurredAtLT(to),
+		).
+		GroupBy(entusagerecord.FieldMetric).
+		Aggregate(entgenerated.Sum(entusagerecord.FieldQuantity)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := lo.Map(rows, func(row usageSum, _ int) core.UsageLine {
+		return core.UsageLine{Metric: core.UsageMetric(row.Metric), Quantity: row.Sum}
+	})
+	slices.SortFunc(lines, func(a, b core.UsageLine) int { return int(a.Metric) - int(b.Metric) })
+	return lines, nil
+}
+
+type usageSum struct {
+	Metric int     `json:"metric"`
+	Sum    float64 `json:"sum"`
+}
+
+// CreateUsageSnapshot stores an immutable snapshot for a tenant period.
+func (r *MeteringRepository) CreateUsageSnapshot(ctx context.Context, snapshot core.UsageSnapshot) (*core.UsageSnapshot, error) {
+	row, err := r.client.UsageSnapshot.Create().
+		SetID(snapshot.ID).
+		SetTenantID(snapshot.TenantID).
+		SetPeriodStart(snapshot.PeriodStart).
+		SetPeriodEnd(snapshot.PeriodEnd).
+		SetLines(snapshot.Lines).
+		Save(ctx)
+	if err != nil {
+		if entgenerated.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: usage snapshot for %s %s", core.ErrAlreadyExists, snapshot.TenantID, snapshot.PeriodStart.Format("2006-01"))
+		}
+		return nil, err
+	}
+	return toDomainUsageSnapshot(row), nil
+}
+
+// GetUsageSnapshot loads a snapshot by identifier.
+func (r *MeteringRepository) GetUsageSnapshot(ctx context.Context, id uuid.UUID) (*core.UsageSnapshot, error) {
+	row, err := r.client.UsageSnapshot.Get(ctx, id)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainUsageSnapshot(row), nil
+}
+
+// ListUsageSnapshots returns snapshots matching the filter, newest period first.
+func (r *MeteringRepository) ListUsageSnapshots(ctx context.Context, filter core.UsageSnapshotFilter) ([]core.UsageSnapshot, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	q := r.client.UsageSnapshot.Query()
+	if filter.TenantID != "" {
+		q = q.Where(entusagesnapshot.TenantID(filter.TenantID))
+	}
+
+	rows, err := q.
+		Order(
+			entusagesnapshot.ByPeriodStart(sql.OrderDesc()),
+			entusagesnapshot.ByTenantID(),
+		).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return lo.Map(rows, func(row *entgenerated.UsageSnapshot, _ int) core.UsageSnapshot {
+		return *toDomainUsageSnapshot(row)
+	}), nextToken, nil
+}
+
+func toDomainUsageSnapshot(row *entgenerated.UsageSnapshot) *core.UsageSnapshot {
+	return &core.UsageSnapshot{
+		ID:          row.ID,
+		TenantID:    row.TenantID,
+		PeriodStart: row.PeriodStart.UTC(),
+		PeriodEnd:   row.PeriodEnd.UTC(),
+		Lines:       row.Lines,
+		CreatedAt:   row.CreatedAt,
+	}
+}
@@ -0,0 +1,71 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestMeteringRepository_SumUsageAndSnapshots(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newTestClient(t, "metering_repo")
+	repo := NewMeteringRepository(client)
+
+	may := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	records := []core.UsageRecord{
+		{TenantID: "acme", Metric: core.UsageMetricAPICalls, Quantity: 1, OccurredAt: may.Add(time.Hour)},
+		{TenantID: "acme", Metric: core.UsageMetricAPICalls, Quantity: 1, OccurredAt: may.Add(2 * time.Hour)},
+		{TenantID: "acme", Metric: core.UsageMetricPlaybackMinutes, Quantity: 12.5, OccurredAt: may.AddDate(0, 0, 10)},
+		{TenantID: "acme", Metric: core.UsageMetricPlaybackMinutes, Quantity: 99, OccurredAt: may.AddDate(0, 1, 0)},
+		{TenantID: "other", Metric: core.UsageMetricAPICalls, Quantity: 7, OccurredAt: may.Add(time.Hour)},
+	}
+	for _, record := range records {
+		record.ID = uuid.New()
+		if err := repo.RecordUsage(ctx, record); err != nil {
+			t.Fatalf("RecordUsage() error = %v", err)
+		}
+	}
+
+	lines, err := repo.SumUsage(ctx, "acme", may, may.AddDate(0, 1, 0))
+	if err != nil {
+		t.Fatalf("SumUsage() error = %v", err)
+	}
+	want := []core.UsageLine{
+		{Metric: core.UsageMetricPlaybackMinutes, Quantity: 12.5},
+		{Metric: core.UsageMetricAPICalls, Quantity: 2},
+	}
+	if len(lines) != len(want) || lines[0] != want[0] || lines[1] != want[1] {
+		t.Fatalf("SumUsage() = %#v, want %#v", lines, want)
+	}
+
+	snapshot := core.UsageSnapshot{
+		ID:          uuid.New(),
+		TenantID:    "acme",
+		PeriodStart: may,
+		PeriodEnd:   may.AddDate(0, 1, 0),
+		Lines:       lines,
+	}
+	if _, err := repo.CreateUsageSnapshot(ctx, snapshot); err != nil {
+		t.Fatalf("CreateUsageSnapshot() error = %v", err)
+	}
+
+	snapshot.ID = uuid.New()
+	if _, err := repo.CreateUsageSnapshot(ctx, snapshot); !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected ErrAlreadyExists for duplicate period, got %v", err)
+	}
+
+	listed, _, err := repo.ListUsageSnapshots(ctx, core.UsageSnapshotFilter{TenantID: "acme"})
+	if err != nil {
+		t.Fatalf("ListUsageSnapshots() error = %v", err)
+	}
+	if len(listed) != 1 || len(listed[0].Lines) != 2 {
+		t.Fatalf("unexpected snapshots %#v", listed)
+	}
+}
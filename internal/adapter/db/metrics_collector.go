@@ -0,0 +1,131 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entjob "github.com/eslsoft/lession/internal/adapter/db/ent/generated/job"
+	entuploadsession "github.com/eslsoft/lession/internal/adapter/db/ent/generated/uploadsession"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// metricsCollectTimeout bounds the queries run on every scrape.
+const metricsCollectTimeout = 5 * time.Second
+
+// uploadStatusLabels names the open upload session states that are reported.
+// Completed and expired sessions only grow and are left out.
+var uploadStatusLabels = map[core.UploadStatus]string{
+	core.UploadStatusAwaitingUpload: "awaiting_upload",
+	core.UploadStatusUploading:      "uploading",
+}
+
+// jobStatusLabels names the job states that are reported; succeeded jobs
+// only grow and are left out.
+var jobStatusLabels = map[core.JobStatus]string{
+	core.JobStatusPending: "pending",
+	core.JobStatusRunning: "running",
+	core.JobStatusFailed:  "failed",
+}
+
+// MetricsCollector reports open upload sessions and job queue depth,
+// counted in the database on every scrape.
+type MetricsCollector struct {
+	client         *entgenerated.Client
+	uploadSessions *prometheus.Desc
+	jobs           *prometheus.Desc
+	scrapeErrors   *prometheus.Desc
+}
+
+// NewMetricsCollector constructs a collector counting rows through client.
+func NewMetricsCollector(client *entgenerated.Client) *MetricsCollector {
+	return &MetricsCollector{
+		client: client,
+		uploadSessions: prometheus.NewDesc("lession_upload_sessions",
+			"Open upload sessions, by status.", []string{"status"}, nil),
+		jobs: prometheus.NewDesc("lession_jobs",
+			"Background jobs waiting, running or failed, by status.", []string{"status"}, nil),
+		scrapeErrors: prometheus.NewDesc("lession_metrics_scrape_error",
+			"1 when counting upload sessions or jobs failed during the scrape.", nil, nil),
+	}
+}
+
+var _ prometheus.Collector = (*MetricsCollector)(nil)
+
+// Describe sends the descriptors of the reported metrics.
+func (c *MetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.uploadSessions
+	ch <- c.jobs
+	ch <- c.scrapeErrors
+}
+
+// Collect counts upload sessions and jobs by status. Failed queries are
+// reported through lession_metrics_scrape_error instead of failing the scrape.
+func (c *MetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), metricsCollectTimeout)
+	defer cancel()
+
+	var failed float64
+	uploads, err := c.countUploadSessions(ctx)
+	if err != nil {
+		failed = 1
+	}
+	for status, label := range uploadStatusLabels {
+		ch <- prometheus.MustNewConstMetric(c.uploadSessions, prometheus.GaugeValue, float64(uploads[int(status)]), label)
+	}
+
+	jobs, err := c.countJobs(ctx)
+	if err != nil {
+		failed = 1
+	}
+	for status, label := range jobStatusLabels {
+		ch <- prometheus.MustNewConstMetric(c.jobs, prometheus.GaugeValue, float64(jobs[int(status)]), label)
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.scrapeErrors, prometheus.GaugeValue, failed)
+}
+
+type statusCount struct {
+	Status int `json:"status"`
+	Count  int `json:"count"`
+}
+
+func (c *MetricsCollector) countUploadSessions(ctx context.Context) (map[int]int, error) {
+	statuses := make([]int, 0, len(uploadStatusLabels))
+	for status := range uploadStatusLabels {
+		statuses = append(statuses, int(status))
+	}
+
+	var rows []statusCount
+	err := c.client.UploadSession.Query().
+		Where(entuploadsession.StatusIn(statuses...)).
+		GroupBy(entuploadsession.FieldStatus).
+		Aggregate(entgenerated.Count()).
+		Scan(ctx, &rows)
+	return countsByStatus(rows), err
+}
+
+func (c *MetricsCollector) countJobs(ctx context.Context) (map[int]int, error) {
+	statuses := make([]int, 0, len(jobStatusLabels))
+	for status := range jobStatusLabels {
+		statuses = append(statuses, int(status))
+	}
+
+	var rows []statusCount
+	err := c.client.Job.Query().
+		Where(entjob.StatusIn(statuses...)).
+		GroupBy(entjob.FieldStatus).
+		Aggregate(entgenerated.Count()).
+		Scan(ctx, &rows)
+	return countsByStatus(rows), err
+}
+
+func countsByStatus(rows []statusCount) map[int]int {
+	counts := make(map[int]int, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts
+}
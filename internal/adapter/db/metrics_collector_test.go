@@ -0,0 +1,50 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestMetricsCollector_CountsJobsByStatus(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "metrics_collector")
+
+	jobs := NewJobRepository(client)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	for _, status := range []core.JobStatus{core.JobStatusPending, core.JobStatusPending, core.JobStatusFailed, core.JobStatusSucceeded} {
+		if _, err := jobs.CreateJob(ctx, core.Job{
+			ID:      uuid.New(),
+			Kind:    "search.reindex",
+			Payload: []byte(`{}`),
+			Status:  status,
+			RunAt:   now,
+		}); err != nil {
+			t.Fatalf("CreateJob() error = %v", err)
+		}
+	}
+
+	expected := `
+# HELP lession_jobs Background jobs waiting, running or failed, by status.
+# TYPE lession_jobs gauge
+lession_jobs{status="failed"} 1
+lession_jobs{status="pending"} 2
+lession_jobs{status="running"} 0
+# HELP lession_upload_sessions Open upload sessions, by status.
+# TYPE lession_upload_sessions gauge
+lession_upload_sessions{status="awaiting_upload"} 0
+lession_upload_sessions{status="uploading"} 0
+# HELP lession_metrics_scrape_error 1 when counting upload sessions or jobs failed during the scrape.
+# TYPE lession_metrics_scrape_error gauge
+lession_metrics_scrape_error 0
+`
+	if err := testutil.CollectAndCompare(NewMetricsCollector(client), strings.NewReader(expected)); err != nil {
+		t.Fatal(err)
+	}
+}
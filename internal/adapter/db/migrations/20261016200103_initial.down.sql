@@ -0,0 +1,186 @@
+-- reverse: create index "webhookdelivery_status_next_attempt_at" to table: "webhook_deliveries"
+DROP INDEX "webhookdelivery_status_next_attempt_at";
+-- reverse: create index "webhookdelivery_endpoint_id_created_at" to table: "webhook_deliveries"
+DROP INDEX "webhookdelivery_endpoint_id_created_at";
+-- reverse: create index "webhookdelivery_endpoint_id_event_id" to table: "webhook_deliveries"
+DROP INDEX "webhookdelivery_endpoint_id_event_id";
+-- reverse: create "webhook_deliveries" table
+DROP TABLE "webhook_deliveries";
+-- reverse: create "webhook_endpoints" table
+DROP TABLE "webhook_endpoints";
+-- reverse: create index "playlistitem_playlist_id_episode_id" to table: "playlist_items"
+DROP INDEX "playlistitem_playlist_id_episode_id";
+-- reverse: create index "playlistitem_playlist_id_position" to table: "playlist_items"
+DROP INDEX "playlistitem_playlist_id_position";
+-- reverse: create "playlist_items" table
+DROP TABLE "playlist_items";
+-- reverse: create index "playlist_owner_id_updated_at" to table: "playlists"
+DROP INDEX "playlist_owner_id_updated_at";
+-- reverse: create index "playlists_slug_key" to table: "playlists"
+DROP INDEX "playlists_slug_key";
+-- reverse: create "playlists" table
+DROP TABLE "playlists";
+-- reverse: create index "episode_series_id" to table: "episodes"
+DROP INDEX "episode_series_id";
+-- reverse: create index "episode_series_id_seq" to table: "episodes"
+DROP INDEX "episode_series_id_seq";
+-- reverse: create "episodes" table
+DROP TABLE "episodes";
+-- reverse: create index "classroommember_user_id" to table: "classroom_members"
+DROP INDEX "classroommember_user_id";
+-- reverse: create index "classroommember_classroom_id_user_id" to table: "classroom_members"
+DROP INDEX "classroommember_classroom_id_user_id";
+-- reverse: create "classroom_members" table
+DROP TABLE "classroom_members";
+-- reverse: create index "classroomassignment_classroom_id_series_id" to table: "classroom_assignments"
+DROP INDEX "classroomassignment_classroom_id_series_id";
+-- reverse: create "classroom_assignments" table
+DROP TABLE "classroom_assignments";
+-- reverse: create index "series_slug_key" to table: "series"
+DROP INDEX "series_slug_key";
+-- reverse: create "series" table
+DROP TABLE "series";
+-- reverse: create index "shadowingsubmission_episode_id_status" to table: "shadowing_submissions"
+DROP INDEX "shadowingsubmission_episode_id_status";
+-- reverse: create index "shadowingsubmission_user_id_created_at" to table: "shadowing_submissions"
+DROP INDEX "shadowingsubmission_user_id_created_at";
+-- reverse: create "shadowing_submissions" table
+DROP TABLE "shadowing_submissions";
+-- reverse: create index "apikey_created_at" to table: "api_keys"
+DROP INDEX "apikey_created_at";
+-- reverse: create index "api_keys_hash_key" to table: "api_keys"
+DROP INDEX "api_keys_hash_key";
+-- reverse: create "api_keys" table
+DROP TABLE "api_keys";
+-- reverse: create index "scheduled_tasks_name_key" to table: "scheduled_tasks"
+DROP INDEX "scheduled_tasks_name_key";
+-- reverse: create "scheduled_tasks" table
+DROP TABLE "scheduled_tasks";
+-- reverse: create index "transcriptreplacejob_created_at" to table: "transcript_replace_jobs"
+DROP INDEX "transcriptreplacejob_created_at";
+-- reverse: create "transcript_replace_jobs" table
+DROP TABLE "transcript_replace_jobs";
+-- reverse: create index "transcriptrevision_episode_id_created_at" to table: "transcript_revisions"
+DROP INDEX "transcriptrevision_episode_id_created_at";
+-- reverse: create index "transcriptrevision_job_id" to table: "transcript_revisions"
+DROP INDEX "transcriptrevision_job_id";
+-- reverse: create "transcript_revisions" table
+DROP TABLE "transcript_revisions";
+-- reverse: create index "playbacksession_user_id_started_at" to table: "playback_sessions"
+DROP INDEX "playbacksession_user_id_started_at";
+-- reverse: create "playback_sessions" table
+DROP TABLE "playback_sessions";
+-- reverse: create index "plans_code_key" to table: "plans"
+DROP INDEX "plans_code_key";
+-- reverse: create "plans" table
+DROP TABLE "plans";
+-- reverse: create index "outboxmessage_dispatched_at_next_attempt_at" to table: "outbox_messages"
+DROP INDEX "outboxmessage_dispatched_at_next_attempt_at";
+-- reverse: create "outbox_messages" table
+DROP TABLE "outbox_messages";
+-- reverse: create index "notification_preferences_user_id_key" to table: "notification_preferences"
+DROP INDEX "notification_preferences_user_id_key";
+-- reverse: create "notification_preferences" table
+DROP TABLE "notification_preferences";
+-- reverse: create index "notification_user_id_created_at" to table: "notifications"
+DROP INDEX "notification_user_id_created_at";
+-- reverse: create index "notifications_dedupe_key_key" to table: "notifications"
+DROP INDEX "notifications_dedupe_key_key";
+-- reverse: create "notifications" table
+DROP TABLE "notifications";
+-- reverse: create index "assets_asset_key_key" to table: "assets"
+DROP INDEX "assets_asset_key_key";
+-- reverse: create "assets" table
+DROP TABLE "assets";
+-- reverse: create index "ltiplatform_issuer_client_id" to table: "lti_platforms"
+DROP INDEX "ltiplatform_issuer_client_id";
+-- reverse: create "lti_platforms" table
+DROP TABLE "lti_platforms";
+-- reverse: create index "ltiloginstate_expires_at" to table: "lti_login_states"
+DROP INDEX "ltiloginstate_expires_at";
+-- reverse: create index "lti_login_states_state_key" to table: "lti_login_states"
+DROP INDEX "lti_login_states_state_key";
+-- reverse: create "lti_login_states" table
+DROP TABLE "lti_login_states";
+-- reverse: create index "subscription_billing_provider_external_id" to table: "subscriptions"
+DROP INDEX "subscription_billing_provider_external_id";
+-- reverse: create index "subscription_user_id_created_at" to table: "subscriptions"
+DROP INDEX "subscription_user_id_created_at";
+-- reverse: create "subscriptions" table
+DROP TABLE "subscriptions";
+-- reverse: create index "job_created_at" to table: "jobs"
+DROP INDEX "job_created_at";
+-- reverse: create index "job_kind_created_at" to table: "jobs"
+DROP INDEX "job_kind_created_at";
+-- reverse: create index "job_status_run_at" to table: "jobs"
+DROP INDEX "job_status_run_at";
+-- reverse: create index "jobs_dedupe_key_key" to table: "jobs"
+DROP INDEX "jobs_dedupe_key_key";
+-- reverse: create "jobs" table
+DROP TABLE "jobs";
+-- reverse: create index "invoice_user_id_created_at" to table: "invoices"
+DROP INDEX "invoice_user_id_created_at";
+-- reverse: create index "invoice_billing_provider_external_id" to table: "invoices"
+DROP INDEX "invoice_billing_provider_external_id";
+-- reverse: create "invoices" table
+DROP TABLE "invoices";
+-- reverse: create index "event_type_occurred_at" to table: "events"
+DROP INDEX "event_type_occurred_at";
+-- reverse: create index "event_aggregate_id_occurred_at" to table: "events"
+DROP INDEX "event_aggregate_id_occurred_at";
+-- reverse: create index "event_occurred_at" to table: "events"
+DROP INDEX "event_occurred_at";
+-- reverse: create "events" table
+DROP TABLE "events";
+-- reverse: create index "upload_sessions_asset_key_key" to table: "upload_sessions"
+DROP INDEX "upload_sessions_asset_key_key";
+-- reverse: create "upload_sessions" table
+DROP TABLE "upload_sessions";
+-- reverse: create index "dictationattempt_episode_id" to table: "dictation_attempts"
+DROP INDEX "dictationattempt_episode_id";
+-- reverse: create index "dictationattempt_user_id_created_at" to table: "dictation_attempts"
+DROP INDEX "dictationattempt_user_id_created_at";
+-- reverse: create "dictation_attempts" table
+DROP TABLE "dictation_attempts";
+-- reverse: create index "devicetoken_user_id" to table: "device_tokens"
+DROP INDEX "devicetoken_user_id";
+-- reverse: create index "device_tokens_token_key" to table: "device_tokens"
+DROP INDEX "device_tokens_token_key";
+-- reverse: create "device_tokens" table
+DROP TABLE "device_tokens";
+-- reverse: create index "contentreassignment_to_author_id" to table: "content_reassignments"
+DROP INDEX "contentreassignment_to_author_id";
+-- reverse: create index "contentreassignment_from_author_id" to table: "content_reassignments"
+DROP INDEX "contentreassignment_from_author_id";
+-- reverse: create "content_reassignments" table
+DROP TABLE "content_reassignments";
+-- reverse: create index "usagerecord_tenant_id_occurred_at" to table: "usage_records"
+DROP INDEX "usagerecord_tenant_id_occurred_at";
+-- reverse: create "usage_records" table
+DROP TABLE "usage_records";
+-- reverse: create index "usagesnapshot_tenant_id_period_start" to table: "usage_snapshots"
+DROP INDEX "usagesnapshot_tenant_id_period_start";
+-- reverse: create "usage_snapshots" table
+DROP TABLE "usage_snapshots";
+-- reverse: create index "classroom_teacher_id_updated_at" to table: "classrooms"
+DROP INDEX "classroom_teacher_id_updated_at";
+-- reverse: create "classrooms" table
+DROP TABLE "classrooms";
+-- reverse: create index "auditentry_created_at" to table: "audit_entries"
+DROP INDEX "auditentry_created_at";
+-- reverse: create index "auditentry_actor_id_created_at" to table: "audit_entries"
+DROP INDEX "auditentry_actor_id_created_at";
+-- reverse: create index "auditentry_entity_type_entity_id_created_at" to table: "audit_entries"
+DROP INDEX "auditentry_entity_type_entity_id_created_at";
+-- reverse: create "audit_entries" table
+DROP TABLE "audit_entries";
+-- reverse: create index "ltilaunch_platform_id_subject" to table: "lti_launches"
+DROP INDEX "ltilaunch_platform_id_subject";
+-- reverse: create "lti_launches" table
+DROP TABLE "lti_launches";
+-- reverse: create index "learneractivity_day" to table: "learner_activities"
+DROP INDEX "learneractivity_day";
+-- reverse: create index "learneractivity_user_id_day" to table: "learner_activities"
+DROP INDEX "learneractivity_user_id_day";
+-- reverse: create "learner_activities" table
+DROP TABLE "learner_activities";
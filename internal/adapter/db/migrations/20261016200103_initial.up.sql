@@ -0,0 +1,186 @@
+-- create "learner_activities" table
+CREATE TABLE "learner_activities" ("id" uuid NOT NULL, "user_id" character varying NOT NULL, "day" timestamptz NOT NULL, "minutes_listened" bigint NOT NULL DEFAULT 0, "episodes_completed" bigint NOT NULL DEFAULT 0, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "learneractivity_user_id_day" to table: "learner_activities"
+CREATE UNIQUE INDEX "learneractivity_user_id_day" ON "learner_activities" ("user_id", "day");
+-- create index "learneractivity_day" to table: "learner_activities"
+CREATE INDEX "learneractivity_day" ON "learner_activities" ("day");
+-- create "lti_launches" table
+CREATE TABLE "lti_launches" ("id" uuid NOT NULL, "platform_id" uuid NOT NULL, "message_type" bigint NOT NULL, "subject" character varying NOT NULL, "user_id" character varying NOT NULL, "episode_id" uuid NULL, "resource_link_id" character varying NOT NULL DEFAULT '', "line_item_url" character varying NOT NULL DEFAULT '', "deep_link_return_url" character varying NOT NULL DEFAULT '', "deep_link_data" text NOT NULL DEFAULT '', "score_submitted_at" timestamptz NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "ltilaunch_platform_id_subject" to table: "lti_launches"
+CREATE INDEX "ltilaunch_platform_id_subject" ON "lti_launches" ("platform_id", "subject");
+-- create "audit_entries" table
+CREATE TABLE "audit_entries" ("id" uuid NOT NULL, "actor_id" character varying NOT NULL DEFAULT '', "procedure" character varying NOT NULL DEFAULT '', "entity_type" character varying NOT NULL, "entity_id" character varying NOT NULL, "action" bigint NOT NULL, "changes" bytea NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "auditentry_entity_type_entity_id_created_at" to table: "audit_entries"
+CREATE INDEX "auditentry_entity_type_entity_id_created_at" ON "audit_entries" ("entity_type", "entity_id", "created_at");
+-- create index "auditentry_actor_id_created_at" to table: "audit_entries"
+CREATE INDEX "auditentry_actor_id_created_at" ON "audit_entries" ("actor_id", "created_at");
+-- create index "auditentry_created_at" to table: "audit_entries"
+CREATE INDEX "auditentry_created_at" ON "audit_entries" ("created_at");
+-- create "classrooms" table
+CREATE TABLE "classrooms" ("id" uuid NOT NULL, "teacher_id" character varying NOT NULL, "name" character varying NOT NULL, "description" text NOT NULL DEFAULT '', "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "classroom_teacher_id_updated_at" to table: "classrooms"
+CREATE INDEX "classroom_teacher_id_updated_at" ON "classrooms" ("teacher_id", "updated_at");
+-- create "usage_snapshots" table
+CREATE TABLE "usage_snapshots" ("id" uuid NOT NULL, "tenant_id" character varying NOT NULL, "period_start" timestamptz NOT NULL, "period_end" timestamptz NOT NULL, "lines" jsonb NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "usagesnapshot_tenant_id_period_start" to table: "usage_snapshots"
+CREATE UNIQUE INDEX "usagesnapshot_tenant_id_period_start" ON "usage_snapshots" ("tenant_id", "period_start");
+-- create "usage_records" table
+CREATE TABLE "usage_records" ("id" uuid NOT NULL, "tenant_id" character varying NOT NULL, "metric" bigint NOT NULL, "quantity" double precision NOT NULL, "occurred_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "usagerecord_tenant_id_occurred_at" to table: "usage_records"
+CREATE INDEX "usagerecord_tenant_id_occurred_at" ON "usage_records" ("tenant_id", "occurred_at");
+-- create "content_reassignments" table
+CREATE TABLE "content_reassignments" ("id" uuid NOT NULL, "from_author_id" character varying NOT NULL, "to_author_id" character varying NOT NULL, "series_ids" jsonb NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "contentreassignment_from_author_id" to table: "content_reassignments"
+CREATE INDEX "contentreassignment_from_author_id" ON "content_reassignments" ("from_author_id");
+-- create index "contentreassignment_to_author_id" to table: "content_reassignments"
+CREATE INDEX "contentreassignment_to_author_id" ON "content_reassignments" ("to_author_id");
+-- create "device_tokens" table
+CREATE TABLE "device_tokens" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "token" character varying NOT NULL, "user_id" character varying NOT NULL, "platform" bigint NOT NULL DEFAULT 0, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "device_tokens_token_key" to table: "device_tokens"
+CREATE UNIQUE INDEX "device_tokens_token_key" ON "device_tokens" ("token");
+-- create index "devicetoken_user_id" to table: "device_tokens"
+CREATE INDEX "devicetoken_user_id" ON "device_tokens" ("user_id");
+-- create "dictation_attempts" table
+CREATE TABLE "dictation_attempts" ("id" uuid NOT NULL, "user_id" character varying NOT NULL, "episode_id" uuid NOT NULL, "item_index" bigint NOT NULL, "answer" text NOT NULL DEFAULT '', "expected" text NOT NULL DEFAULT '', "score" double precision NOT NULL DEFAULT 0, "feedback" jsonb NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "dictationattempt_user_id_created_at" to table: "dictation_attempts"
+CREATE INDEX "dictationattempt_user_id_created_at" ON "dictation_attempts" ("user_id", "created_at");
+-- create index "dictationattempt_episode_id" to table: "dictation_attempts"
+CREATE INDEX "dictationattempt_episode_id" ON "dictation_attempts" ("episode_id");
+-- create "upload_sessions" table
+CREATE TABLE "upload_sessions" ("id" uuid NOT NULL, "asset_key" character varying NOT NULL, "type" bigint NOT NULL DEFAULT 0, "protocol" bigint NOT NULL DEFAULT 0, "status" bigint NOT NULL DEFAULT 0, "target_method" character varying NOT NULL, "target_url" character varying NOT NULL, "target_headers" jsonb NULL, "target_form_fields" jsonb NULL, "original_filename" character varying NOT NULL, "mime_type" character varying NOT NULL, "content_length" bigint NOT NULL DEFAULT 0, "expires_at" timestamptz NOT NULL, "provider" character varying NOT NULL DEFAULT '', "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "upload_sessions_asset_key_key" to table: "upload_sessions"
+CREATE UNIQUE INDEX "upload_sessions_asset_key_key" ON "upload_sessions" ("asset_key");
+-- create "events" table
+CREATE TABLE "events" ("id" uuid NOT NULL, "type" character varying NOT NULL, "aggregate_id" character varying NOT NULL, "payload" bytea NOT NULL, "occurred_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "event_occurred_at" to table: "events"
+CREATE INDEX "event_occurred_at" ON "events" ("occurred_at");
+-- create index "event_aggregate_id_occurred_at" to table: "events"
+CREATE INDEX "event_aggregate_id_occurred_at" ON "events" ("aggregate_id", "occurred_at");
+-- create index "event_type_occurred_at" to table: "events"
+CREATE INDEX "event_type_occurred_at" ON "events" ("type", "occurred_at");
+-- create "invoices" table
+CREATE TABLE "invoices" ("id" uuid NOT NULL, "subscription_id" uuid NOT NULL, "user_id" character varying NOT NULL, "billing_provider" character varying NOT NULL, "external_id" character varying NOT NULL, "amount_cents" bigint NOT NULL DEFAULT 0, "currency" character varying NOT NULL, "status" bigint NOT NULL DEFAULT 0, "period_start" timestamptz NOT NULL, "period_end" timestamptz NOT NULL, "hosted_url" character varying NOT NULL DEFAULT '', "paid_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "invoice_billing_provider_external_id" to table: "invoices"
+CREATE UNIQUE INDEX "invoice_billing_provider_external_id" ON "invoices" ("billing_provider", "external_id");
+-- create index "invoice_user_id_created_at" to table: "invoices"
+CREATE INDEX "invoice_user_id_created_at" ON "invoices" ("user_id", "created_at");
+-- create "jobs" table
+CREATE TABLE "jobs" ("id" uuid NOT NULL, "kind" character varying NOT NULL, "payload" bytea NULL, "status" bigint NOT NULL, "attempts" bigint NOT NULL DEFAULT 0, "last_error" character varying NOT NULL DEFAULT '', "dedupe_key" character varying NULL, "run_at" timestamptz NOT NULL, "locked_by" character varying NOT NULL DEFAULT '', "locked_until" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "finished_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "jobs_dedupe_key_key" to table: "jobs"
+CREATE UNIQUE INDEX "jobs_dedupe_key_key" ON "jobs" ("dedupe_key");
+-- create index "job_status_run_at" to table: "jobs"
+CREATE INDEX "job_status_run_at" ON "jobs" ("status", "run_at");
+-- create index "job_kind_created_at" to table: "jobs"
+CREATE INDEX "job_kind_created_at" ON "jobs" ("kind", "created_at");
+-- create index "job_created_at" to table: "jobs"
+CREATE INDEX "job_created_at" ON "jobs" ("created_at");
+-- create "subscriptions" table
+CREATE TABLE "subscriptions" ("id" uuid NOT NULL, "user_id" character varying NOT NULL, "plan_id" uuid NOT NULL, "status" bigint NOT NULL DEFAULT 0, "current_period_start" timestamptz NOT NULL, "current_period_end" timestamptz NOT NULL, "billing_provider" character varying NOT NULL DEFAULT '', "external_id" character varying NOT NULL DEFAULT '', "canceled_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "subscription_user_id_created_at" to table: "subscriptions"
+CREATE INDEX "subscription_user_id_created_at" ON "subscriptions" ("user_id", "created_at");
+-- create index "subscription_billing_provider_external_id" to table: "subscriptions"
+CREATE INDEX "subscription_billing_provider_external_id" ON "subscriptions" ("billing_provider", "external_id");
+-- create "lti_login_states" table
+CREATE TABLE "lti_login_states" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "state" character varying NOT NULL, "nonce" character varying NOT NULL, "platform_id" uuid NOT NULL, "expires_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "lti_login_states_state_key" to table: "lti_login_states"
+CREATE UNIQUE INDEX "lti_login_states_state_key" ON "lti_login_states" ("state");
+-- create index "ltiloginstate_expires_at" to table: "lti_login_states"
+CREATE INDEX "ltiloginstate_expires_at" ON "lti_login_states" ("expires_at");
+-- create "lti_platforms" table
+CREATE TABLE "lti_platforms" ("id" uuid NOT NULL, "issuer" character varying NOT NULL, "client_id" character varying NOT NULL, "deployment_id" character varying NOT NULL DEFAULT '', "auth_login_url" character varying NOT NULL, "auth_token_url" character varying NOT NULL, "jwks_url" character varying NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "ltiplatform_issuer_client_id" to table: "lti_platforms"
+CREATE UNIQUE INDEX "ltiplatform_issuer_client_id" ON "lti_platforms" ("issuer", "client_id");
+-- create "assets" table
+CREATE TABLE "assets" ("id" uuid NOT NULL, "asset_key" character varying NOT NULL, "type" bigint NOT NULL DEFAULT 0, "status" bigint NOT NULL DEFAULT 0, "original_filename" character varying NOT NULL, "mime_type" character varying NOT NULL, "filesize" bigint NOT NULL DEFAULT 0, "duration_seconds" bigint NOT NULL DEFAULT 0, "playback_url" character varying NULL, "provider" character varying NOT NULL DEFAULT '', "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "ready_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "assets_asset_key_key" to table: "assets"
+CREATE UNIQUE INDEX "assets_asset_key_key" ON "assets" ("asset_key");
+-- create "notifications" table
+CREATE TABLE "notifications" ("id" uuid NOT NULL, "user_id" character varying NOT NULL, "kind" bigint NOT NULL, "channel" bigint NOT NULL, "dedupe_key" character varying NOT NULL, "subject" character varying NOT NULL DEFAULT '', "body" text NOT NULL DEFAULT '', "status" bigint NOT NULL DEFAULT 0, "error" character varying NOT NULL DEFAULT '', "created_at" timestamptz NOT NULL, "sent_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "notifications_dedupe_key_key" to table: "notifications"
+CREATE UNIQUE INDEX "notifications_dedupe_key_key" ON "notifications" ("dedupe_key");
+-- create index "notification_user_id_created_at" to table: "notifications"
+CREATE INDEX "notification_user_id_created_at" ON "notifications" ("user_id", "created_at");
+-- create "notification_preferences" table
+CREATE TABLE "notification_preferences" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "user_id" character varying NOT NULL, "email" character varying NOT NULL DEFAULT '', "locale" character varying NOT NULL DEFAULT '', "opt_outs" jsonb NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "notification_preferences_user_id_key" to table: "notification_preferences"
+CREATE UNIQUE INDEX "notification_preferences_user_id_key" ON "notification_preferences" ("user_id");
+-- create "outbox_messages" table
+CREATE TABLE "outbox_messages" ("id" uuid NOT NULL, "event_type" character varying NOT NULL, "aggregate_id" character varying NOT NULL, "payload" bytea NOT NULL, "occurred_at" timestamptz NOT NULL, "attempts" bigint NOT NULL DEFAULT 0, "last_error" character varying NOT NULL DEFAULT '', "next_attempt_at" timestamptz NOT NULL, "dispatched_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "outboxmessage_dispatched_at_next_attempt_at" to table: "outbox_messages"
+CREATE INDEX "outboxmessage_dispatched_at_next_attempt_at" ON "outbox_messages" ("dispatched_at", "next_attempt_at");
+-- create "plans" table
+CREATE TABLE "plans" ("id" uuid NOT NULL, "code" character varying NOT NULL, "name" character varying NOT NULL, "description" text NOT NULL DEFAULT '', "price_cents" bigint NOT NULL DEFAULT 0, "currency" character varying NOT NULL, "interval" bigint NOT NULL DEFAULT 0, "active" boolean NOT NULL DEFAULT true, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "plans_code_key" to table: "plans"
+CREATE UNIQUE INDEX "plans_code_key" ON "plans" ("code");
+-- create "playback_sessions" table
+CREATE TABLE "playback_sessions" ("id" uuid NOT NULL, "user_id" character varying NOT NULL, "episode_id" uuid NOT NULL, "device" character varying NOT NULL DEFAULT '', "started_at" timestamptz NOT NULL, "finished_at" timestamptz NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "playbacksession_user_id_started_at" to table: "playback_sessions"
+CREATE INDEX "playbacksession_user_id_started_at" ON "playback_sessions" ("user_id", "started_at");
+-- create "transcript_revisions" table
+CREATE TABLE "transcript_revisions" ("id" uuid NOT NULL, "job_id" uuid NOT NULL, "episode_id" uuid NOT NULL, "before" text NOT NULL, "after" text NOT NULL, "created_at" timestamptz NOT NULL, "rolled_back_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "transcriptrevision_job_id" to table: "transcript_revisions"
+CREATE INDEX "transcriptrevision_job_id" ON "transcript_revisions" ("job_id");
+-- create index "transcriptrevision_episode_id_created_at" to table: "transcript_revisions"
+CREATE INDEX "transcriptrevision_episode_id_created_at" ON "transcript_revisions" ("episode_id", "created_at");
+-- create "transcript_replace_jobs" table
+CREATE TABLE "transcript_replace_jobs" ("id" uuid NOT NULL, "pattern" text NOT NULL, "replacement" text NOT NULL DEFAULT '', "regex" boolean NOT NULL DEFAULT false, "case_insensitive" boolean NOT NULL DEFAULT false, "scope_series_id" uuid NULL, "scope_language" character varying NOT NULL DEFAULT '', "dry_run" boolean NOT NULL DEFAULT false, "requested_by" character varying NOT NULL DEFAULT '', "status" bigint NOT NULL DEFAULT 0, "episodes_scanned" bigint NOT NULL DEFAULT 0, "episodes_changed" bigint NOT NULL DEFAULT 0, "matches" bigint NOT NULL DEFAULT 0, "changes" jsonb NULL, "error" text NOT NULL DEFAULT '', "created_at" timestamptz NOT NULL, "started_at" timestamptz NULL, "finished_at" timestamptz NULL, "rolled_back_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "transcriptreplacejob_created_at" to table: "transcript_replace_jobs"
+CREATE INDEX "transcriptreplacejob_created_at" ON "transcript_replace_jobs" ("created_at");
+-- create "scheduled_tasks" table
+CREATE TABLE "scheduled_tasks" ("id" uuid NOT NULL, "name" character varying NOT NULL, "interval_seconds" bigint NOT NULL, "next_run_at" timestamptz NOT NULL, "last_run_at" timestamptz NULL, "last_status" bigint NOT NULL DEFAULT 0, "last_error" character varying NOT NULL DEFAULT '', "last_duration_ms" bigint NOT NULL DEFAULT 0, "locked_by" character varying NOT NULL DEFAULT '', "locked_until" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "scheduled_tasks_name_key" to table: "scheduled_tasks"
+CREATE UNIQUE INDEX "scheduled_tasks_name_key" ON "scheduled_tasks" ("name");
+-- create "api_keys" table
+CREATE TABLE "api_keys" ("id" uuid NOT NULL, "name" character varying NOT NULL, "prefix" character varying NOT NULL, "hash" character varying NOT NULL, "scopes" jsonb NOT NULL, "last_used_at" timestamptz NULL, "expires_at" timestamptz NULL, "revoked_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "api_keys_hash_key" to table: "api_keys"
+CREATE UNIQUE INDEX "api_keys_hash_key" ON "api_keys" ("hash");
+-- create index "apikey_created_at" to table: "api_keys"
+CREATE INDEX "apikey_created_at" ON "api_keys" ("created_at");
+-- create "shadowing_submissions" table
+CREATE TABLE "shadowing_submissions" ("id" uuid NOT NULL, "user_id" character varying NOT NULL, "episode_id" uuid NOT NULL, "segment_index" bigint NOT NULL, "asset_id" uuid NOT NULL, "status" bigint NOT NULL DEFAULT 0, "auto_score" double precision NULL, "auto_feedback" text NOT NULL DEFAULT '', "reviewer_id" character varying NOT NULL DEFAULT '', "review_score" bigint NULL, "review_comment" text NOT NULL DEFAULT '', "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "reviewed_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "shadowingsubmission_user_id_created_at" to table: "shadowing_submissions"
+CREATE INDEX "shadowingsubmission_user_id_created_at" ON "shadowing_submissions" ("user_id", "created_at");
+-- create index "shadowingsubmission_episode_id_status" to table: "shadowing_submissions"
+CREATE INDEX "shadowingsubmission_episode_id_status" ON "shadowing_submissions" ("episode_id", "status");
+-- create "series" table
+CREATE TABLE "series" ("id" uuid NOT NULL, "slug" character varying NOT NULL, "title" character varying NOT NULL, "summary" character varying NOT NULL DEFAULT '', "language" character varying NOT NULL DEFAULT '', "level" character varying NOT NULL DEFAULT '', "tags" jsonb NULL, "cover_url" character varying NOT NULL DEFAULT '', "status" bigint NOT NULL DEFAULT 0, "episode_count" bigint NOT NULL DEFAULT 0, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "published_at" timestamptz NULL, "author_ids" jsonb NULL, PRIMARY KEY ("id"));
+-- create index "series_slug_key" to table: "series"
+CREATE UNIQUE INDEX "series_slug_key" ON "series" ("slug");
+-- create "classroom_assignments" table
+CREATE TABLE "classroom_assignments" ("id" uuid NOT NULL, "due_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, "classroom_id" uuid NOT NULL, "series_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "classroom_assignments_classrooms_assignments" FOREIGN KEY ("classroom_id") REFERENCES "classrooms" ("id") ON DELETE NO ACTION, CONSTRAINT "classroom_assignments_series_series" FOREIGN KEY ("series_id") REFERENCES "series" ("id") ON DELETE NO ACTION);
+-- create index "classroomassignment_classroom_id_series_id" to table: "classroom_assignments"
+CREATE UNIQUE INDEX "classroomassignment_classroom_id_series_id" ON "classroom_assignments" ("classroom_id", "series_id");
+-- create "classroom_members" table
+CREATE TABLE "classroom_members" ("id" uuid NOT NULL, "user_id" character varying NOT NULL, "joined_at" timestamptz NOT NULL, "classroom_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "classroom_members_classrooms_members" FOREIGN KEY ("classroom_id") REFERENCES "classrooms" ("id") ON DELETE NO ACTION);
+-- create index "classroommember_classroom_id_user_id" to table: "classroom_members"
+CREATE UNIQUE INDEX "classroommember_classroom_id_user_id" ON "classroom_members" ("classroom_id", "user_id");
+-- create index "classroommember_user_id" to table: "classroom_members"
+CREATE INDEX "classroommember_user_id" ON "classroom_members" ("user_id");
+-- create "episodes" table
+CREATE TABLE "episodes" ("id" uuid NOT NULL, "seq" bigint NOT NULL, "title" character varying NOT NULL, "description" character varying NOT NULL DEFAULT '', "duration_seconds" bigint NOT NULL DEFAULT 0, "status" bigint NOT NULL DEFAULT 0, "preview" boolean NOT NULL DEFAULT false, "resource_asset_id" uuid NULL, "resource_type" bigint NOT NULL DEFAULT 0, "resource_playback_url" character varying NOT NULL DEFAULT '', "resource_mime_type" character varying NOT NULL DEFAULT '', "transcript_language" character varying NOT NULL DEFAULT '', "transcript_format" bigint NOT NULL DEFAULT 0, "transcript_content" text NOT NULL DEFAULT '', "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "published_at" timestamptz NULL, "deleted_at" timestamptz NULL, "series_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "episodes_series_episodes" FOREIGN KEY ("series_id") REFERENCES "series" ("id") ON DELETE NO ACTION);
+-- create index "episode_series_id_seq" to table: "episodes"
+CREATE UNIQUE INDEX "episode_series_id_seq" ON "episodes" ("series_id", "seq");
+-- create index "episode_series_id" to table: "episodes"
+CREATE INDEX "episode_series_id" ON "episodes" ("series_id");
+-- create "playlists" table
+CREATE TABLE "playlists" ("id" uuid NOT NULL, "owner_id" character varying NOT NULL, "title" character varying NOT NULL, "description" text NOT NULL DEFAULT '', "public" boolean NOT NULL DEFAULT false, "slug" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "playlists_slug_key" to table: "playlists"
+CREATE UNIQUE INDEX "playlists_slug_key" ON "playlists" ("slug");
+-- create index "playlist_owner_id_updated_at" to table: "playlists"
+CREATE INDEX "playlist_owner_id_updated_at" ON "playlists" ("owner_id", "updated_at");
+-- create "playlist_items" table
+CREATE TABLE "playlist_items" ("id" uuid NOT NULL, "position" bigint NOT NULL, "playlist_id" uuid NOT NULL, "episode_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "playlist_items_playlists_items" FOREIGN KEY ("playlist_id") REFERENCES "playlists" ("id") ON DELETE NO ACTION, CONSTRAINT "playlist_items_episodes_episode" FOREIGN KEY ("episode_id") REFERENCES "episodes" ("id") ON DELETE NO ACTION);
+-- create index "playlistitem_playlist_id_position" to table: "playlist_items"
+CREATE UNIQUE INDEX "playlistitem_playlist_id_position" ON "playlist_items" ("playlist_id", "position");
+-- create index "playlistitem_playlist_id_episode_id" to table: "playlist_items"
+CREATE UNIQUE INDEX "playlistitem_playlist_id_episode_id" ON "playlist_items" ("playlist_id", "episode_id");
+-- create "webhook_endpoints" table
+CREATE TABLE "webhook_endpoints" ("id" uuid NOT NULL, "url" character varying NOT NULL, "description" character varying NOT NULL DEFAULT '', "event_types" jsonb NULL, "secret" character varying NOT NULL, "enabled" boolean NOT NULL DEFAULT true, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create "webhook_deliveries" table
+CREATE TABLE "webhook_deliveries" ("id" uuid NOT NULL, "event_id" uuid NOT NULL, "event_type" bigint NOT NULL, "payload" bytea NOT NULL, "status" bigint NOT NULL DEFAULT 0, "attempts" bigint NOT NULL DEFAULT 0, "response_status" bigint NOT NULL DEFAULT 0, "error" character varying NOT NULL DEFAULT '', "next_attempt_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "delivered_at" timestamptz NULL, "endpoint_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "webhook_deliveries_webhook_endpoints_deliveries" FOREIGN KEY ("endpoint_id") REFERENCES "webhook_endpoints" ("id") ON DELETE NO ACTION);
+-- create index "webhookdelivery_endpoint_id_event_id" to table: "webhook_deliveries"
+CREATE UNIQUE INDEX "webhookdelivery_endpoint_id_event_id" ON "webhook_deliveries" ("endpoint_id", "event_id");
+-- create index "webhookdelivery_endpoint_id_created_at" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_endpoint_id_created_at" ON "webhook_deliveries" ("endpoint_id", "created_at");
+-- create index "webhookdelivery_status_next_attempt_at" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_status_next_attempt_at" ON "webhook_deliveries" ("status", "next_attempt_at");
@@ -0,0 +1,12 @@
+-- reverse: create index "series_author_ids" to table: "series"
+DROP INDEX "series_author_ids";
+-- reverse: create index "series_tags" to table: "series"
+DROP INDEX "series_tags";
+-- reverse: create index "series_level_created_at" to table: "series"
+DROP INDEX "series_level_created_at";
+-- reverse: create index "series_language_created_at" to table: "series"
+DROP INDEX "series_language_created_at";
+-- reverse: create index "series_status_created_at" to table: "series"
+DROP INDEX "series_status_created_at";
+-- reverse: create index "series_created_at" to table: "series"
+DROP INDEX "series_created_at";
@@ -0,0 +1,12 @@
+-- create index "series_created_at" to table: "series"
+CREATE INDEX "series_created_at" ON "series" ("created_at");
+-- create index "series_status_created_at" to table: "series"
+CREATE INDEX "series_status_created_at" ON "series" ("status", "created_at");
+-- create index "series_language_created_at" to table: "series"
+CREATE INDEX "series_language_created_at" ON "series" ("language", "created_at");
+-- create index "series_level_created_at" to table: "series"
+CREATE INDEX "series_level_created_at" ON "series" ("level", "created_at");
+-- create index "series_tags" to table: "series"
+CREATE INDEX "series_tags" ON "series" USING GIN ("tags");
+-- create index "series_author_ids" to table: "series"
+CREATE INDEX "series_author_ids" ON "series" USING GIN ("author_ids");
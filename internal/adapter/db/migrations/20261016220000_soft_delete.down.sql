@@ -0,0 +1,4 @@
+-- reverse: modify "series" table
+ALTER TABLE "series" DROP COLUMN "deleted_at";
+-- reverse: modify "assets" table
+ALTER TABLE "assets" DROP COLUMN "deleted_at";
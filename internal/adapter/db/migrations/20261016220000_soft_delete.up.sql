@@ -0,0 +1,4 @@
+-- modify "assets" table
+ALTER TABLE "assets" ADD COLUMN "deleted_at" timestamptz NULL;
+-- modify "series" table
+ALTER TABLE "series" ADD COLUMN "deleted_at" timestamptz NULL;
@@ -0,0 +1,4 @@
+-- reverse: create index "episodes_search" to table: "episodes"
+DROP INDEX "episodes_search";
+-- reverse: create index "series_search" to table: "series"
+DROP INDEX "series_search";
@@ -0,0 +1,4 @@
+-- create index "series_search" to table: "series"
+CREATE INDEX "series_search" ON "series" USING GIN (to_tsvector('simple', "title" || ' ' || "summary"));
+-- create index "episodes_search" to table: "episodes"
+CREATE INDEX "episodes_search" ON "episodes" USING GIN (to_tsvector('simple', "title" || ' ' || "description" || ' ' || "transcript_content"));
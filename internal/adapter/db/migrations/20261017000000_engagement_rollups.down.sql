@@ -0,0 +1,6 @@
+-- reverse: create index "playbacksession_episode_id_started_at" to table: "playback_sessions"
+DROP INDEX "playbacksession_episode_id_started_at";
+-- reverse: create index "engagementrollup_subject_id_day" to table: "engagement_rollups"
+DROP INDEX "engagementrollup_subject_id_day";
+-- reverse: create "engagement_rollups" table
+DROP TABLE "engagement_rollups";
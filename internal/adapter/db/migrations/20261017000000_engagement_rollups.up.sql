@@ -0,0 +1,6 @@
+-- create "engagement_rollups" table
+CREATE TABLE "engagement_rollups" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "subject_id" uuid NOT NULL, "series_id" uuid NOT NULL, "day" timestamptz NULL, "sessions" bigint NOT NULL DEFAULT 0, "finished_sessions" bigint NOT NULL DEFAULT 0, "unique_listeners" bigint NOT NULL DEFAULT 0, "completers" bigint NOT NULL DEFAULT 0, PRIMARY KEY ("id"));
+-- create index "engagementrollup_subject_id_day" to table: "engagement_rollups"
+CREATE INDEX "engagementrollup_subject_id_day" ON "engagement_rollups" ("subject_id", "day");
+-- create index "playbacksession_episode_id_started_at" to table: "playback_sessions"
+CREATE INDEX "playbacksession_episode_id_started_at" ON "playback_sessions" ("episode_id", "started_at");
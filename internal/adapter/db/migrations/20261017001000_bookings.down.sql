@@ -0,0 +1,14 @@
+-- reverse: create index "booking_teacher_id_starts_at" to table: "bookings"
+DROP INDEX "booking_teacher_id_starts_at";
+-- reverse: create index "booking_slot_id" to table: "bookings"
+DROP INDEX "booking_slot_id";
+-- reverse: create index "booking_learner_id_starts_at" to table: "bookings"
+DROP INDEX "booking_learner_id_starts_at";
+-- reverse: create "bookings" table
+DROP TABLE "bookings";
+-- reverse: create index "availabilityslot_teacher_id_starts_at" to table: "availability_slots"
+DROP INDEX "availabilityslot_teacher_id_starts_at";
+-- reverse: create index "availabilityslot_starts_at" to table: "availability_slots"
+DROP INDEX "availabilityslot_starts_at";
+-- reverse: create "availability_slots" table
+DROP TABLE "availability_slots";
@@ -0,0 +1,14 @@
+-- create "availability_slots" table
+CREATE TABLE "availability_slots" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "teacher_id" character varying NOT NULL, "starts_at" timestamptz NOT NULL, "ends_at" timestamptz NOT NULL, "booked" boolean NOT NULL DEFAULT false, PRIMARY KEY ("id"));
+-- create index "availabilityslot_starts_at" to table: "availability_slots"
+CREATE INDEX "availabilityslot_starts_at" ON "availability_slots" ("starts_at");
+-- create index "availabilityslot_teacher_id_starts_at" to table: "availability_slots"
+CREATE INDEX "availabilityslot_teacher_id_starts_at" ON "availability_slots" ("teacher_id", "starts_at");
+-- create "bookings" table
+CREATE TABLE "bookings" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "teacher_id" character varying NOT NULL, "learner_id" character varying NOT NULL, "starts_at" timestamptz NOT NULL, "ends_at" timestamptz NOT NULL, "note" text NOT NULL DEFAULT '', "status" bigint NOT NULL, "cancelled_at" timestamptz NULL, "cancelled_by" character varying NOT NULL DEFAULT '', "cancel_reason" text NOT NULL DEFAULT '', "slot_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "bookings_availability_slots_bookings" FOREIGN KEY ("slot_id") REFERENCES "availability_slots" ("id") ON DELETE NO ACTION);
+-- create index "booking_learner_id_starts_at" to table: "bookings"
+CREATE INDEX "booking_learner_id_starts_at" ON "bookings" ("learner_id", "starts_at");
+-- create index "booking_slot_id" to table: "bookings"
+CREATE INDEX "booking_slot_id" ON "bookings" ("slot_id");
+-- create index "booking_teacher_id_starts_at" to table: "bookings"
+CREATE INDEX "booking_teacher_id_starts_at" ON "bookings" ("teacher_id", "starts_at");
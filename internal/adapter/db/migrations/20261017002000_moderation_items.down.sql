@@ -0,0 +1,6 @@
+-- reverse: create index "moderationitem_subject_type_subject_id" to table: "moderation_items"
+DROP INDEX "moderationitem_subject_type_subject_id";
+-- reverse: create index "moderationitem_status_created_at" to table: "moderation_items"
+DROP INDEX "moderationitem_status_created_at";
+-- reverse: create "moderation_items" table
+DROP TABLE "moderation_items";
@@ -0,0 +1,6 @@
+-- create "moderation_items" table
+CREATE TABLE "moderation_items" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "subject_type" character varying NOT NULL, "subject_id" uuid NOT NULL, "author_id" character varying NOT NULL DEFAULT '', "text" text NOT NULL, "status" bigint NOT NULL, "labels" jsonb NULL, "score" double precision NOT NULL DEFAULT 0, "reviewer_id" character varying NOT NULL DEFAULT '', "review_note" text NOT NULL DEFAULT '', "reviewed_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "moderationitem_status_created_at" to table: "moderation_items"
+CREATE INDEX "moderationitem_status_created_at" ON "moderation_items" ("status", "created_at");
+-- create index "moderationitem_subject_type_subject_id" to table: "moderation_items"
+CREATE UNIQUE INDEX "moderationitem_subject_type_subject_id" ON "moderation_items" ("subject_type", "subject_id");
@@ -0,0 +1,2 @@
+-- reverse: modify "episodes" table
+ALTER TABLE "episodes" DROP COLUMN "transcript_findings";
@@ -0,0 +1,2 @@
+-- modify "episodes" table
+ALTER TABLE "episodes" ADD COLUMN "transcript_findings" jsonb NULL;
@@ -0,0 +1,6 @@
+-- reverse: create index "contentembedding_series_id" to table: "content_embeddings"
+DROP INDEX "contentembedding_series_id";
+-- reverse: create index "contentembedding_kind_language" to table: "content_embeddings"
+DROP INDEX "contentembedding_kind_language";
+-- reverse: create "content_embeddings" table
+DROP TABLE "content_embeddings";
@@ -0,0 +1,8 @@
+-- add extension "vector"
+CREATE EXTENSION IF NOT EXISTS "vector";
+-- create "content_embeddings" table
+CREATE TABLE "content_embeddings" ("id" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "kind" bigint NOT NULL, "series_id" uuid NOT NULL, "episode_id" uuid NULL, "title" character varying NOT NULL, "snippet" text NOT NULL DEFAULT '', "language" character varying NOT NULL DEFAULT '', "level" character varying NOT NULL DEFAULT '', "tags" jsonb NULL, "model" character varying NOT NULL, "content_hash" character varying NOT NULL, "vector" vector NOT NULL, PRIMARY KEY ("id"));
+-- create index "contentembedding_kind_language" to table: "content_embeddings"
+CREATE INDEX "contentembedding_kind_language" ON "content_embeddings" ("kind", "language");
+-- create index "contentembedding_series_id" to table: "content_embeddings"
+CREATE INDEX "contentembedding_series_id" ON "content_embeddings" ("series_id");
@@ -0,0 +1,6 @@
+-- reverse: create index "series_estimated_level_created_at" to table: "series"
+DROP INDEX "series_estimated_level_created_at";
+-- reverse: modify "series" table
+ALTER TABLE "series" DROP COLUMN "estimated_level";
+-- reverse: modify "episodes" table
+ALTER TABLE "episodes" DROP COLUMN "estimated_level";
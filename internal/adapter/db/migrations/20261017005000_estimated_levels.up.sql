@@ -0,0 +1,6 @@
+-- modify "episodes" table
+ALTER TABLE "episodes" ADD COLUMN "estimated_level" character varying NOT NULL DEFAULT '';
+-- modify "series" table
+ALTER TABLE "series" ADD COLUMN "estimated_level" character varying NOT NULL DEFAULT '';
+-- create index "series_estimated_level_created_at" to table: "series"
+CREATE INDEX "series_estimated_level_created_at" ON "series" ("estimated_level", "created_at");
@@ -0,0 +1,6 @@
+-- reverse: create index "vocabularyword_user_id_status_updated_at" to table: "vocabulary_words"
+DROP INDEX "vocabularyword_user_id_status_updated_at";
+-- reverse: create index "vocabularyword_user_id_word" to table: "vocabulary_words"
+DROP INDEX "vocabularyword_user_id_word";
+-- reverse: create "vocabulary_words" table
+DROP TABLE "vocabulary_words";
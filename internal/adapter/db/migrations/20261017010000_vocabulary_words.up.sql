@@ -0,0 +1,6 @@
+-- create "vocabulary_words" table
+CREATE TABLE "vocabulary_words" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "user_id" character varying NOT NULL, "word" character varying NOT NULL, "status" bigint NOT NULL, "episode_id" uuid NULL, PRIMARY KEY ("id"));
+-- create index "vocabularyword_user_id_word" to table: "vocabulary_words"
+CREATE UNIQUE INDEX "vocabularyword_user_id_word" ON "vocabulary_words" ("user_id", "word");
+-- create index "vocabularyword_user_id_status_updated_at" to table: "vocabulary_words"
+CREATE INDEX "vocabularyword_user_id_status_updated_at" ON "vocabulary_words" ("user_id", "status", "updated_at");
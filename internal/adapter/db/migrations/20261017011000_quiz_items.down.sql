@@ -0,0 +1,4 @@
+-- reverse: create index "quizitem_episode_id_kind_position" to table: "quiz_items"
+DROP INDEX "quizitem_episode_id_kind_position";
+-- reverse: create "quiz_items" table
+DROP TABLE "quiz_items";
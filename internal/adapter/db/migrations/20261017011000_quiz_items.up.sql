@@ -0,0 +1,4 @@
+-- create "quiz_items" table
+CREATE TABLE "quiz_items" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "episode_id" uuid NOT NULL, "kind" bigint NOT NULL, "position" bigint NOT NULL DEFAULT 0, "prompt" text NOT NULL, "answer" character varying NOT NULL, "sentence" text NOT NULL DEFAULT '', "needs_review" boolean NOT NULL DEFAULT true, PRIMARY KEY ("id"));
+-- create index "quizitem_episode_id_kind_position" to table: "quiz_items"
+CREATE INDEX "quizitem_episode_id_kind_position" ON "quiz_items" ("episode_id", "kind", "position");
@@ -0,0 +1,2 @@
+-- reverse: modify "assets" table
+ALTER TABLE "assets" DROP COLUMN "hls_manifest_url";
@@ -0,0 +1,2 @@
+-- modify "assets" table
+ALTER TABLE "assets" ADD COLUMN "hls_manifest_url" character varying NOT NULL DEFAULT '';
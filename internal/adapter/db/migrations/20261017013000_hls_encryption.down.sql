@@ -0,0 +1,8 @@
+-- reverse: create index "contentkey_asset_id" to table: "content_keys"
+DROP INDEX "contentkey_asset_id";
+-- reverse: create "content_keys" table
+DROP TABLE "content_keys";
+-- reverse: modify "series" table
+ALTER TABLE "series" DROP COLUMN "drm_enabled";
+-- reverse: modify "assets" table
+ALTER TABLE "assets" DROP COLUMN "hls_key_id";
@@ -0,0 +1,8 @@
+-- modify "assets" table
+ALTER TABLE "assets" ADD COLUMN "hls_key_id" uuid NULL;
+-- modify "series" table
+ALTER TABLE "series" ADD COLUMN "drm_enabled" boolean NOT NULL DEFAULT false;
+-- create "content_keys" table
+CREATE TABLE "content_keys" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "asset_id" uuid NOT NULL, "key" bytea NOT NULL, PRIMARY KEY ("id"));
+-- create index "contentkey_asset_id" to table: "content_keys"
+CREATE INDEX "contentkey_asset_id" ON "content_keys" ("asset_id");
@@ -0,0 +1,4 @@
+-- reverse: modify "series" table
+ALTER TABLE "series" DROP COLUMN "cover_asset_id";
+-- reverse: modify "assets" table
+ALTER TABLE "assets" DROP COLUMN "image_variants", DROP COLUMN "height", DROP COLUMN "width";
@@ -0,0 +1,4 @@
+-- modify "assets" table
+ALTER TABLE "assets" ADD COLUMN "width" bigint NOT NULL DEFAULT 0, ADD COLUMN "height" bigint NOT NULL DEFAULT 0, ADD COLUMN "image_variants" jsonb NULL;
+-- modify "series" table
+ALTER TABLE "series" ADD COLUMN "cover_asset_id" uuid NULL;
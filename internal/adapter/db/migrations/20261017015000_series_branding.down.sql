@@ -0,0 +1,2 @@
+-- reverse: modify "series" table
+ALTER TABLE "series" DROP COLUMN "layout", DROP COLUMN "hero_image_url", DROP COLUMN "hero_asset_id", DROP COLUMN "accent_color";
@@ -0,0 +1,2 @@
+-- modify "series" table
+ALTER TABLE "series" ADD COLUMN "accent_color" character varying NOT NULL DEFAULT '', ADD COLUMN "hero_asset_id" uuid NULL, ADD COLUMN "hero_image_url" character varying NOT NULL DEFAULT '', ADD COLUMN "layout" bigint NOT NULL DEFAULT 0;
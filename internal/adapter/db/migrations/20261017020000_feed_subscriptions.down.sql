@@ -0,0 +1,8 @@
+-- reverse: create index "feeditem_subscription_id_guid" to table: "feed_items"
+DROP INDEX "feeditem_subscription_id_guid";
+-- reverse: create "feed_items" table
+DROP TABLE "feed_items";
+-- reverse: create index "feed_subscriptions_series_id_key" to table: "feed_subscriptions"
+DROP INDEX "feed_subscriptions_series_id_key";
+-- reverse: create "feed_subscriptions" table
+DROP TABLE "feed_subscriptions";
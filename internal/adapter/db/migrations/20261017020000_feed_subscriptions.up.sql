@@ -0,0 +1,8 @@
+-- create "feed_subscriptions" table
+CREATE TABLE "feed_subscriptions" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "series_id" uuid NOT NULL, "kind" bigint NOT NULL, "source_url" character varying NOT NULL, "download_media" boolean NOT NULL DEFAULT false, "auto_publish" boolean NOT NULL DEFAULT false, "last_synced_at" timestamptz NULL, "last_error" character varying NOT NULL DEFAULT '', PRIMARY KEY ("id"));
+-- create index "feed_subscriptions_series_id_key" to table: "feed_subscriptions"
+CREATE UNIQUE INDEX "feed_subscriptions_series_id_key" ON "feed_subscriptions" ("series_id");
+-- create "feed_items" table
+CREATE TABLE "feed_items" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "guid" character varying NOT NULL, "episode_id" uuid NOT NULL, "subscription_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "feed_items_feed_subscriptions_items" FOREIGN KEY ("subscription_id") REFERENCES "feed_subscriptions" ("id") ON DELETE NO ACTION);
+-- create index "feeditem_subscription_id_guid" to table: "feed_items"
+CREATE UNIQUE INDEX "feeditem_subscription_id_guid" ON "feed_items" ("subscription_id", "guid");
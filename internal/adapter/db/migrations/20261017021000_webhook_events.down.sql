@@ -0,0 +1,6 @@
+-- reverse: create index "webhookevent_event_type_created_at" to table: "webhook_events"
+DROP INDEX "webhookevent_event_type_created_at";
+-- reverse: create index "webhookevent_created_at" to table: "webhook_events"
+DROP INDEX "webhookevent_created_at";
+-- reverse: create "webhook_events" table
+DROP TABLE "webhook_events";
@@ -0,0 +1,6 @@
+-- create "webhook_events" table
+CREATE TABLE "webhook_events" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "event_type" bigint NOT NULL, "payload" bytea NOT NULL, PRIMARY KEY ("id"));
+-- create index "webhookevent_created_at" to table: "webhook_events"
+CREATE INDEX "webhookevent_created_at" ON "webhook_events" ("created_at");
+-- create index "webhookevent_event_type_created_at" to table: "webhook_events"
+CREATE INDEX "webhookevent_event_type_created_at" ON "webhook_events" ("event_type", "created_at");
@@ -0,0 +1,2 @@
+-- reverse: modify "assets" table
+ALTER TABLE "assets" DROP COLUMN "failure_reason", DROP COLUMN "failure_code";
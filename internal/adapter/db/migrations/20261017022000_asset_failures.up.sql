@@ -0,0 +1,2 @@
+-- modify "assets" table
+ALTER TABLE "assets" ADD COLUMN "failure_code" bigint NOT NULL DEFAULT 0, ADD COLUMN "failure_reason" character varying NOT NULL DEFAULT '';
@@ -0,0 +1,6 @@
+-- reverse: create index "assetversion_asset_id_created_at" to table: "asset_versions"
+DROP INDEX "assetversion_asset_id_created_at";
+-- reverse: create "asset_versions" table
+DROP TABLE "asset_versions";
+-- reverse: modify "upload_sessions" table
+ALTER TABLE "upload_sessions" DROP COLUMN "replaces_asset_id";
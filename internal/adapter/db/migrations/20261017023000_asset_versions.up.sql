@@ -0,0 +1,6 @@
+-- modify "upload_sessions" table
+ALTER TABLE "upload_sessions" ADD COLUMN "replaces_asset_id" uuid NULL;
+-- create "asset_versions" table
+CREATE TABLE "asset_versions" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "asset_id" uuid NOT NULL, "asset_key" character varying NOT NULL, "provider" character varying NOT NULL DEFAULT '', "original_filename" character varying NOT NULL, "mime_type" character varying NOT NULL, "filesize" bigint NOT NULL DEFAULT 0, "duration_seconds" bigint NOT NULL DEFAULT 0, "playback_url" character varying NOT NULL DEFAULT '', "hls_manifest_url" character varying NOT NULL DEFAULT '', PRIMARY KEY ("id"));
+-- create index "assetversion_asset_id_created_at" to table: "asset_versions"
+CREATE INDEX "assetversion_asset_id_created_at" ON "asset_versions" ("asset_id", "created_at");
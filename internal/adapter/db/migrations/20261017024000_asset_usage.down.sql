@@ -0,0 +1,4 @@
+-- reverse: create index "assetusageday_asset_id_day" to table: "asset_usage_days"
+DROP INDEX "assetusageday_asset_id_day";
+-- reverse: create "asset_usage_days" table
+DROP TABLE "asset_usage_days";
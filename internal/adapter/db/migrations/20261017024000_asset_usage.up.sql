@@ -0,0 +1,4 @@
+-- create "asset_usage_days" table
+CREATE TABLE "asset_usage_days" ("id" uuid NOT NULL, "asset_id" uuid NOT NULL, "day" timestamptz NOT NULL, "playback_urls_issued" bigint NOT NULL DEFAULT 0, "downloads" bigint NOT NULL DEFAULT 0, PRIMARY KEY ("id"));
+-- create index "assetusageday_asset_id_day" to table: "asset_usage_days"
+CREATE UNIQUE INDEX "assetusageday_asset_id_day" ON "asset_usage_days" ("asset_id", "day");
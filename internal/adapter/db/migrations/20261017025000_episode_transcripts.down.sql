@@ -0,0 +1,6 @@
+-- reverse: create index "episode_transcripts_episode_id_key" to table: "episode_transcripts"
+DROP INDEX "episode_transcripts_episode_id_key";
+-- reverse: create "episode_transcripts" table
+DROP TABLE "episode_transcripts";
+-- reverse: modify "episodes" table
+ALTER TABLE "episodes" DROP COLUMN "transcript_offloaded";
@@ -0,0 +1,6 @@
+-- modify "episodes" table
+ALTER TABLE "episodes" ADD COLUMN "transcript_offloaded" boolean NOT NULL DEFAULT false;
+-- create "episode_transcripts" table
+CREATE TABLE "episode_transcripts" ("id" uuid NOT NULL, "content" text NOT NULL DEFAULT '', "episode_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "episode_transcripts_episodes_transcript" FOREIGN KEY ("episode_id") REFERENCES "episodes" ("id") ON DELETE NO ACTION);
+-- create index "episode_transcripts_episode_id_key" to table: "episode_transcripts"
+CREATE UNIQUE INDEX "episode_transcripts_episode_id_key" ON "episode_transcripts" ("episode_id");
@@ -0,0 +1,2 @@
+-- reverse: modify "episodes" table
+ALTER TABLE "episodes" DROP COLUMN "duration_from_asset";
@@ -0,0 +1,2 @@
+-- modify "episodes" table
+ALTER TABLE "episodes" ADD COLUMN "duration_from_asset" boolean NOT NULL DEFAULT false;
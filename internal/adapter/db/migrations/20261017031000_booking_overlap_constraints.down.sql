@@ -0,0 +1,6 @@
+-- reverse: create exclusion constraint "booking_learner_id_overlap" to table: "bookings"
+ALTER TABLE "bookings" DROP CONSTRAINT "booking_learner_id_overlap";
+-- reverse: create exclusion constraint "availabilityslot_teacher_id_overlap" to table: "availability_slots"
+ALTER TABLE "availability_slots" DROP CONSTRAINT "availabilityslot_teacher_id_overlap";
+-- reverse: create extension "btree_gist"
+DROP EXTENSION IF EXISTS "btree_gist";
@@ -0,0 +1,6 @@
+-- create extension "btree_gist"
+CREATE EXTENSION IF NOT EXISTS "btree_gist";
+-- create exclusion constraint "availabilityslot_teacher_id_overlap" to table: "availability_slots"
+ALTER TABLE "availability_slots" ADD CONSTRAINT "availabilityslot_teacher_id_overlap" EXCLUDE USING gist ("teacher_id" WITH =, tstzrange("starts_at", "ends_at") WITH &&);
+-- create exclusion constraint "booking_learner_id_overlap" to table: "bookings"
+ALTER TABLE "bookings" ADD CONSTRAINT "booking_learner_id_overlap" EXCLUDE USING gist ("learner_id" WITH =, tstzrange("starts_at", "ends_at") WITH &&) WHERE ("status" = 1);
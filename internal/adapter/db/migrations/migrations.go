@@ -0,0 +1,12 @@
+// Package migrations embeds the versioned PostgreSQL migrations generated by
+// Atlas from the Ent schema, in golang-migrate layout. New migrations are
+// written with internal/adapter/db/ent/migrate/main.go and must not be edited
+// once released; atlas.sum guards against that.
+package migrations
+
+import "embed"
+
+// FS holds the migration files and their atlas.sum.
+//
+//go:embed *.sql atlas.sum
+var FS embed.FS
@@ -0,0 +1,471 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/postgres"
+	"ariga.io/atlas/sql/schema"
+	"ariga.io/atlas/sql/sqlite"
+	"ariga.io/atlas/sql/sqltool"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+const (
+	// revisionsTable records which migrations have been applied.
+	revisionsTable = "schema_revisions"
+	// migrationLockName names the lock that keeps two processes from
+	// migrating the same database at once.
+	migrationLockName = "lession_migrate"
+	// migrationLockTimeout is how long a process waits for another one to
+	// finish migrating.
+	migrationLockTimeout = 5 * time.Minute
+)
+
+// MigrationStatus reports whether a versioned migration has been applied.
+type MigrationStatus struct {
+	Version     string
+	Description string
+	// AppliedAt is when the migration was applied; nil while it is pending.
+	AppliedAt *time.Time
+	// Baseline reports that the migration was marked as applied without
+	// running it.
+	Baseline bool
+}
+
+// Migrator applies and reverts the versioned migrations of a directory in
+// golang-migrate layout, as written by Atlas, and tracks them in the
+// schema_revisions table. Every migration runs in its own transaction.
+type Migrator struct {
+	db      *sql.DB
+	dialect string
+	files   fs.FS
+}
+
+// NewMigrator constructs a migrator for the database of the given Ent
+// dialect, which must be PostgreSQL or SQLite.
+func NewMigrator(database *sql.DB, dialect string, files fs.FS) *Migrator {
+	return &Migrator{db: database, dialect: dialect, files: files}
+}
+
+// Up applies pending migrations in version order and returns the versions
+// it applied. A positive limit caps how many migrations are applied.
+func (m *Migrator) Up(ctx context.Context, limit int) ([]string, error) {
+	dir, err := m.dir()
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []string
+	err = m.locked(ctx, func(drv migrate.Driver, revisions *revisionTable) error {
+		executor, err := migrate.NewExecutor(drv, dir, revisions)
+		if err != nil {
+			return err
+		}
+		pending, err := executor.Pending(ctx)
+		var notClean *migrate.NotCleanError
+		switch {
+		case errors.Is(err, migrate.ErrNoPendingFiles):
+			return nil
+		case errors.As(err, &notClean):
+			return fmt.Errorf("database has tables but no migration history, baseline it first: %w", notClean)
+		case err != nil:
+			return err
+		}
+		if limit > 0 && len(pending) > limit {
+			pending = pending[:limit]
+		}
+
+		for _, file := range pending {
+			err := m.inTx(ctx, func(tx *sql.Tx) error {
+				drv, err := m.driver(tx)
+				if err != nil {
+					return err
+				}
+				executor, err := migrate.NewExecutor(drv, dir, revisions.withConn(tx))
+				if err != nil {
+					return err
+				}
+				return executor.Execute(ctx, file)
+			})
+			if err != nil {
+				return fmt.Errorf("apply migration %s: %w", file.Name(), err)
+			}
+			applied = append(applied, file.Version())
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down reverts the latest applied migrations, newest first, using their
+// down files, and returns the versions it reverted.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]string, error) {
+	if steps <= 0 {
+		return nil, fmt.Errorf("steps must be positive")
+	}
+	dir, err := m.dir()
+	if err != nil {
+		return nil, err
+	}
+	files, err := dir.Files()
+	if err != nil {
+		return nil, err
+	}
+
+	var reverted []string
+	err = m.locked(ctx, func(_ migrate.Driver, revisions *revisionTable) error {
+		revs, err := revisions.ReadRevisions(ctx)
+		if err != nil {
+			return err
+		}
+		for i := len(revs) - 1; i >= 0 && len(reverted) < steps; i-- {
+			rev := revs[i]
+			if rev.Type.Has(migrate.RevisionTypeBaseline) {
+				return fmt.Errorf("migration %s is a baseline and cannot be reverted", rev.Version)
+			}
+			stmts, err := downStatements(dir, files, rev.Version)
+			if err != nil {
+				return err
+			}
+			err = m.inTx(ctx, func(tx *sql.Tx) error {
+				for _, stmt := range stmts {
+					if _, err := tx.ExecContext(ctx, stmt); err != nil {
+						return err
+					}
+				}
+				return revisions.withConn(tx).DeleteRevision(ctx, rev.Version)
+			})
+			if err != nil {
+				return fmt.Errorf("revert migration %s: %w", rev.Version, err)
+			}
+			reverted = append(reverted, rev.Version)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Status lists every migration of the directory with when it was applied.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	dir, err := m.dir()
+	if err != nil {
+		return nil, err
+	}
+	files, err := dir.Files()
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := m.revisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	revs, err := revisions.ReadRevisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make(map[string]*migrate.Revision, len(revs))
+	for _, rev := range revs {
+		applied[rev.Version] = rev
+	}
+	// A baseline stands for every migration up to its version.
+	var baseline string
+	if len(revs) > 0 && revs[0].Type.Has(migrate.RevisionTypeBaseline) {
+		baseline = revs[0].Version
+	}
+
+	statuses := make([]MigrationStatus, 0, len(files))
+	for _, file := range files {
+		status := MigrationStatus{Version: file.Version(), Description: file.Desc()}
+		if rev, ok := applied[file.Version()]; ok && rev.Applied == rev.Total {
+			executedAt := rev.ExecutedAt
+			status.AppliedAt = &executedAt
+			status.Baseline = rev.Type.Has(migrate.RevisionTypeBaseline)
+		} else if file.Version() < baseline {
+			status.AppliedAt = &revs[0].ExecutedAt
+			status.Baseline = true
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Baseline marks the migrations up to and including version as applied
+// without running them, for a database whose schema was created before
+// versioned migrations. It refuses a database that already has history.
+func (m *Migrator) Baseline(ctx context.Context, version string) error {
+	dir, err := m.dir()
+	if err != nil {
+		return err
+	}
+	files, err := dir.Files()
+	if err != nil {
+		return err
+	}
+	var file migrate.File
+	for _, candidate := range files {
+		if candidate.Version() == version {
+			file = candidate
+		}
+	}
+	if file == nil {
+		return fmt.Errorf("migration version %q not found", version)
+	}
+
+	return m.locked(ctx, func(_ migrate.Driver, revisions *revisionTable) error {
+		revs, err := revisions.ReadRevisions(ctx)
+		if err != nil {
+			return err
+		}
+		if len(revs) > 0 {
+			return fmt.Errorf("database already has migration history up to %s", revs[len(revs)-1].Version)
+		}
+		return revisions.WriteRevision(ctx, &migrate.Revision{
+			Version:     file.Version(),
+			Description: file.Desc(),
+			Type:        migrate.RevisionTypeBaseline,
+			ExecutedAt:  time.Now(),
+		})
+	})
+}
+
+// dir loads the migration files and checks them against atlas.sum, so an
+// edited or missing migration is never applied.
+func (m *Migrator) dir() (*migrationDir, error) {
+	dir := &migrationDir{GolangMigrateDir: &sqltool.GolangMigrateDir{FS: m.files}}
+	if err := migrate.Validate(dir); err != nil {
+		return nil, fmt.Errorf("validate migrations: %w", err)
+	}
+	return dir, nil
+}
+
+// locked runs fn while holding the migration lock.
+func (m *Migrator) locked(ctx context.Context, fn func(migrate.Driver, *revisionTable) error) (err error) {
+	revisions, err := m.revisions(ctx)
+	if err != nil {
+		return err
+	}
+	drv, err := m.driver(m.db)
+	if err != nil {
+		return err
+	}
+	unlock, err := drv.Lock(ctx, migrationLockName, migrationLockTimeout)
+	if err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	defer func() {
+		err = errors.Join(err, unlock())
+	}()
+	return fn(drv, revisions)
+}
+
+// revisions creates the revisions table when missing.
+func (m *Migrator) revisions(ctx context.Context) (*revisionTable, error) {
+	revisions := &revisionTable{conn: m.db, dialect: m.dialect, schema: "main"}
+	timestamp := "datetime"
+	if m.dialect == dialect.Postgres {
+		timestamp = "timestamp with time zone"
+		if err := m.db.QueryRowContext(ctx, "SELECT current_schema()").Scan(&revisions.schema); err != nil {
+			return nil, err
+		}
+	}
+	_, err := m.db.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	version varchar(255) NOT NULL PRIMARY KEY,
+	description varchar(255) NOT NULL,
+	type bigint NOT NULL,
+	applied bigint NOT NULL,
+	total bigint NOT NULL,
+	executed_at %s NOT NULL,
+	execution_time bigint NOT NULL,
+	error text NOT NULL,
+	error_stmt text NOT NULL,
+	hash varchar(255) NOT NULL,
+	partial_hashes text NOT NULL,
+	operator_version varchar(255) NOT NULL
+)`, revisionsTable, timestamp))
+	if err != nil {
+		return nil, fmt.Errorf("create %s: %w", revisionsTable, err)
+	}
+	return revisions, nil
+}
+
+func (m *Migrator) driver(conn schema.ExecQuerier) (migrate.Driver, error) {
+	switch m.dialect {
+	case dialect.Postgres:
+		return postgres.Open(conn)
+	case dialect.SQLite:
+		return sqlite.Open(conn)
+	default:
+		return nil, fmt.Errorf("migrations are not supported for dialect %q", m.dialect)
+	}
+}
+
+func (m *Migrator) inTx(ctx context.Context, fn func(*sql.Tx) error) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	return tx.Commit()
+}
+
+// downStatements reads the statements of the down file paired with the up
+// file of version.
+func downStatements(dir fs.FS, files []migrate.File, version string) ([]string, error) {
+	for _, file := range files {
+		if file.Version() != version {
+			continue
+		}
+		name := strings.TrimSuffix(file.Name(), ".up.sql") + ".down.sql"
+		data, err := fs.ReadFile(dir, name)
+		if err != nil {
+			return nil, fmt.Errorf("read down migration of %s: %w", version, err)
+		}
+		return migrate.NewLocalFile(name, data).Stmts()
+	}
+	return nil, fmt.Errorf("migration version %q not found", version)
+}
+
+// migrationDir is a read-only golang-migrate directory. Like atlas.sum, its
+// checksum covers the down files along with the up files.
+type migrationDir struct {
+	*sqltool.GolangMigrateDir
+}
+
+// Checksum implements migrate.Dir.
+func (d *migrationDir) Checksum() (migrate.HashFile, error) {
+	names, err := fs.Glob(d, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+	files := make([]migrate.File, len(names))
+	for i, name := range names {
+		data, err := fs.ReadFile(d, name)
+		if err != nil {
+			return nil, err
+		}
+		files[i] = migrate.NewLocalFile(name, data)
+	}
+	return migrate.NewHashFile(files)
+}
+
+// revisionTable stores revisions in the schema_revisions table.
+type revisionTable struct {
+	conn    schema.ExecQuerier
+	dialect string
+	schema  string
+}
+
+var _ migrate.RevisionReadWriter = (*revisionTable)(nil)
+
+var revisionColumns = []string{
+	"version", "description", "type", "applied", "total", "executed_at", "execution_time",
+	"error", "error_stmt", "hash", "partial_hashes", "operator_version",
+}
+
+func (r *revisionTable) withConn(conn schema.ExecQuerier) *revisionTable {
+	return &revisionTable{conn: conn, dialect: r.dialect, schema: r.schema}
+}
+
+// Ident implements migrate.RevisionReadWriter.
+func (r *revisionTable) Ident() *migrate.TableIdent {
+	return &migrate.TableIdent{Name: revisionsTable, Schema: r.schema}
+}
+
+// ReadRevisions implements migrate.RevisionReadWriter.
+func (r *revisionTable) ReadRevisions(ctx context.Context) ([]*migrate.Revision, error) {
+	builder := entsql.Dialect(r.dialect)
+	query, args := builder.Select(revisionColumns...).
+		From(builder.Table(revisionsTable)).
+		OrderBy("version").
+		Query()
+	return r.query(ctx, query, args...)
+}
+
+// ReadRevision implements migrate.RevisionReadWriter.
+func (r *revisionTable) ReadRevision(ctx context.Context, version string) (*migrate.Revision, error) {
+	builder := entsql.Dialect(r.dialect)
+	query, args := builder.Select(revisionColumns...).
+		From(builder.Table(revisionsTable)).
+		Where(entsql.EQ("version", version)).
+		Query()
+	revs, err := r.query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(revs) == 0 {
+		return nil, migrate.ErrRevisionNotExist
+	}
+	return revs[0], nil
+}
+
+// WriteRevision implements migrate.RevisionReadWriter.
+func (r *revisionTable) WriteRevision(ctx context.Context, rev *migrate.Revision) error {
+	partialHashes, err := json.Marshal(rev.PartialHashes)
+	if err != nil {
+		return err
+	}
+	if err := r.DeleteRevision(ctx, rev.Version); err != nil {
+		return err
+	}
+	query, args := entsql.Dialect(r.dialect).Insert(revisionsTable).
+		Columns(revisionColumns...).
+		Values(
+			rev.Version, rev.Description, int64(rev.Type), rev.Applied, rev.Total, rev.ExecutedAt, int64(rev.ExecutionTime),
+			rev.Error, rev.ErrorStmt, rev.Hash, string(partialHashes), rev.OperatorVersion,
+		).
+		Query()
+	_, err = r.conn.ExecContext(ctx, query, args...)
+	return err
+}
+
+// DeleteRevision implements migrate.RevisionReadWriter.
+func (r *revisionTable) DeleteRevision(ctx context.Context, version string) error {
+	query, args := entsql.Dialect(r.dialect).Delete(revisionsTable).
+		Where(entsql.EQ("version", version)).
+		Query()
+	_, err := r.conn.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *revisionTable) query(ctx context.Context, query string, args ...any) ([]*migrate.Revision, error) {
+	rows, err := r.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revs []*migrate.Revision
+	for rows.Next() {
+		var (
+			rev           migrate.Revision
+			revType       int64
+			executionTime int64
+			partialHashes string
+		)
+		if err := rows.Scan(
+			&rev.Version, &rev.Description, &revType, &rev.Applied, &rev.Total, &rev.ExecutedAt, &executionTime,
+			&rev.Error, &rev.ErrorStmt, &rev.Hash, &partialHashes, &rev.OperatorVersion,
+		); err != nil {
+			return nil, err
+		}
+		rev.Type = migrate.RevisionType(revType)
+		rev.ExecutionTime = time.Duration(executionTime)
+		if err := json.Unmarshal([]byte(partialHashes), &rev.PartialHashes); err != nil {
+			return nil, fmt.Errorf("decode partial hashes of %s: %w", rev.Version, err)
+		}
+		revs = append(revs, &rev)
+	}
+	return revs, rows.Err()
+}
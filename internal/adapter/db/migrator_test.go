@@ -0,0 +1,139 @@
+package db
+
+import (
+	"context"
+	stdsql "database/sql"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"ariga.io/atlas/sql/migrate"
+	"entgo.io/ent/dialect"
+
+	"github.com/eslsoft/lession/internal/adapter/db/migrations"
+)
+
+func TestMigrator_UpDownStatus(t *testing.T) {
+	ctx := context.Background()
+	database := openMigratorDB(t, "migrator_up_down")
+	migrator := NewMigrator(database, dialect.SQLite, migrationFiles(t))
+
+	applied, err := migrator.Up(ctx, 1)
+	if err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	if strings.Join(applied, ",") != "1" {
+		t.Fatalf("expected to apply only version 1, got %v", applied)
+	}
+	if applied, err = migrator.Up(ctx, 0); err != nil || strings.Join(applied, ",") != "2" {
+		t.Fatalf("expected to apply version 2, got %v, %v", applied, err)
+	}
+	if _, err := database.ExecContext(ctx, "INSERT INTO notes (id, body) VALUES (1, 'hello')"); err != nil {
+		t.Fatalf("expected migrated table, got %v", err)
+	}
+	if applied, err = migrator.Up(ctx, 0); err != nil || len(applied) != 0 {
+		t.Fatalf("expected nothing pending, got %v, %v", applied, err)
+	}
+
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		t.Fatalf("Status() error = %v", err)
+	}
+	if len(statuses) != 2 || statuses[0].Description != "notes" || statuses[0].AppliedAt == nil || statuses[1].AppliedAt == nil {
+		t.Fatalf("expected both migrations applied, got %+v", statuses)
+	}
+
+	reverted, err := migrator.Down(ctx, 1)
+	if err != nil || strings.Join(reverted, ",") != "2" {
+		t.Fatalf("expected to revert version 2, got %v, %v", reverted, err)
+	}
+	if _, err := database.ExecContext(ctx, "INSERT INTO notes (id, body) VALUES (2, 'bye')"); err == nil {
+		t.Fatal("expected the body column to be dropped")
+	}
+	if statuses, _ = migrator.Status(ctx); statuses[1].AppliedAt != nil {
+		t.Fatalf("expected version 2 to be pending, got %+v", statuses[1])
+	}
+}
+
+func TestMigrator_BaselineExistingSchema(t *testing.T) {
+	ctx := context.Background()
+	database := openMigratorDB(t, "migrator_baseline")
+	if _, err := database.ExecContext(ctx, "CREATE TABLE notes (id integer PRIMARY KEY)"); err != nil {
+		t.Fatalf("create table error = %v", err)
+	}
+	migrator := NewMigrator(database, dialect.SQLite, migrationFiles(t))
+
+	if _, err := migrator.Up(ctx, 0); err == nil {
+		t.Fatal("expected Up to refuse a database without history")
+	}
+	if err := migrator.Baseline(ctx, "1"); err != nil {
+		t.Fatalf("Baseline() error = %v", err)
+	}
+	applied, err := migrator.Up(ctx, 0)
+	if err != nil || strings.Join(applied, ",") != "2" {
+		t.Fatalf("expected to apply version 2 after the baseline, got %v, %v", applied, err)
+	}
+	if err := migrator.Baseline(ctx, "1"); err == nil {
+		t.Fatal("expected a second baseline to be refused")
+	}
+	if _, err := migrator.Down(ctx, 2); err == nil {
+		t.Fatal("expected reverting the baseline to be refused")
+	}
+}
+
+func TestMigrator_RejectsEditedMigration(t *testing.T) {
+	files := migrationFiles(t)
+	files["1_notes.up.sql"] = &fstest.MapFile{Data: []byte("CREATE TABLE notes (id integer PRIMARY KEY, extra text);\n")}
+	migrator := NewMigrator(openMigratorDB(t, "migrator_edited"), dialect.SQLite, files)
+
+	if _, err := migrator.Up(context.Background(), 0); err == nil || !strings.Contains(err.Error(), "checksum") {
+		t.Fatalf("expected a checksum error, got %v", err)
+	}
+}
+
+func TestMigrator_EmbeddedMigrationsMatchSum(t *testing.T) {
+	migrator := NewMigrator(openMigratorDB(t, "migrator_embedded"), dialect.SQLite, migrations.FS)
+	statuses, err := migrator.Status(context.Background())
+	if err != nil {
+		t.Fatalf("Status() error = %v", err)
+	}
+	if len(statuses) == 0 || statuses[0].AppliedAt != nil {
+		t.Fatalf("expected pending migrations, got %+v", statuses)
+	}
+}
+
+func openMigratorDB(t *testing.T, name string) *stdsql.DB {
+	t.Helper()
+	database, err := stdsql.Open("sqlite", "file:"+name+"?mode=memory&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed opening sqlite driver: %v", err)
+	}
+	// Every connection to an in-memory database sees its own database.
+	database.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = database.Close() })
+	return database
+}
+
+func migrationFiles(t *testing.T) fstest.MapFS {
+	t.Helper()
+	files := fstest.MapFS{
+		"1_notes.up.sql":   {Data: []byte("CREATE TABLE notes (id integer PRIMARY KEY);\n")},
+		"1_notes.down.sql": {Data: []byte("DROP TABLE notes;\n")},
+		"2_body.up.sql":    {Data: []byte("ALTER TABLE notes ADD COLUMN body text NOT NULL DEFAULT '';\n")},
+		"2_body.down.sql":  {Data: []byte("ALTER TABLE notes DROP COLUMN body;\n")},
+	}
+	var local []migrate.File
+	for _, name := range []string{"1_notes.down.sql", "1_notes.up.sql", "2_body.down.sql", "2_body.up.sql"} {
+		local = append(local, migrate.NewLocalFile(name, files[name].Data))
+	}
+	sum, err := migrate.NewHashFile(local)
+	if err != nil {
+		t.Fatalf("NewHashFile() error = %v", err)
+	}
+	data, err := sum.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error = %v", err)
+	}
+	files[migrate.HashFileName] = &fstest.MapFile{Data: data}
+	return files
+}
@@ -0,0 +1,234 @@
+package db
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entmoderation "github.com/eslsoft/lession/internal/adapter/db/ent/generated/moderationitem"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// ModerationRepository persists the moderation queue using Ent.
+type ModerationRepository struct {
+	client *entgenerated.Client
+}
+
+// NewModerationRepository constructs an Ent-backed moderation repository.
+func NewModerationRepository(client *entgenerated.Client) *ModerationRepository {
+	return &ModerationRepository{client: client}
+}
+
+var _ core.ModerationRepository = (*ModerationRepository)(nil)
+
+// SaveModerationItem creates the subject's item or, when one exists,
+// replaces its text, verdict and review in place.
+func (r *ModerationRepository) SaveModerationItem(ctx context.Context, item core.ModerationItem, events ...core.Event) (*core.ModerationItem, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := tx.ModerationItem.Query().
+		Where(
+			entmoderation.SubjectType(item.SubjectType),
+			entmoderation.SubjectID(item.SubjectID),
+		).
+		Only(ctx)
+	if err != nil && !entgenerated.IsNotFound(err) {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	var row *entgenerated.ModerationItem
+	if existing == nil {
+		create := tx.ModerationItem.Create().
+			SetID(item.ID).
+			SetSubjectType(item.SubjectType).
+			SetSubjectID(item.SubjectID).
+			SetAuthorID(item.AuthorID).
+			SetText(item.Text).
+			SetStatus(int(item.Status)).
+			SetLabels(item.Labels).
+			SetScore(item.Score).
+			SetReviewerID(item.ReviewerID).
+			SetReviewNote(item.ReviewNote).
+			SetNillableReviewedAt(item.ReviewedAt)
+		row, err = create.Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			if entgenerated.IsConstraintError(err) {
+				return nil, core.ErrAlreadyExists
+			}
+			return nil, err
+		}
+	} else {
+		update := tx.ModerationItem.UpdateOne(existing).
+			SetAuthorID(item.AuthorID).
+			SetText(item.Text).
+			SetStatus(int(item.Status)).
+			SetLabels(item.Labels).
+			SetScore(item.Score).
+			SetReviewerID(item.ReviewerID).
+			SetReviewNote(item.ReviewNote)
+		if item.ReviewedAt != nil {
+			update.SetReviewedAt(*item.ReviewedAt)
+		} else {
+			update.ClearReviewedAt()
+		}
+		row, err = update.Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+
+	stampEvents(events, row.ID, row.CreatedAt, row.UpdatedAt)
+	if err := writeOutbox(ctx, tx, row.UpdatedAt, events); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toDomainModerationItem(row), nil
+}
+
+// GetModerationItem loads an item by identifier.
+func (r *ModerationRepository) GetModerationItem(ctx context.Context, id uuid.UUID) (*core.ModerationItem, error) {
+	row, err := r.client.ModerationItem.Get(ctx, id)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainModerationItem(row), nil
+}
+
+// GetModerationItemBySubject loads the item of a subject.
+func (r *ModerationRepository) GetModerationItemBySubject(ctx context.Context, subjectType string, subjectID uuid.UUID) (*core.ModerationItem, error) {
+	row, err := r.client.ModerationItem.Query().
+		Where(
+			entmoderation.SubjectType(subjectType),
+			entmoderation.SubjectID(subjectID),
+		).
+		Only(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainModerationItem(row), nil
+}
+
+// UpdateModerationReview records a moderator's decision on an item.
+func (r *ModerationRepository) UpdateModerationReview(ctx context.Context, item core.ModerationItem) (*core.ModerationItem, error) {
+	update := r.client.ModerationItem.UpdateOneID(item.ID).
+		SetStatus(int(item.Status)).
+		SetReviewerID(item.ReviewerID).
+		SetReviewNote(item.ReviewNote)
+	if item.ReviewedAt != nil {
+		update.SetReviewedAt(*item.ReviewedAt)
+	}
+	row, err := update.Save(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainModerationItem(row), nil
+}
+
+// ListModerationItems returns items matching the filter, oldest first so
+// the queue is worked through in submission order.
+func (r *ModerationRepository) ListModerationItems(ctx context.Context, filter core.ModerationListFilter) ([]core.ModerationItem, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	q := r.client.ModerationItem.Query()
+	if filter.SubjectType != "" {
+		q = q.Where(entmoderation.SubjectType(filter.SubjectType))
+	}
+	if len(filter.Statuses) > 0 {
+		q = q.Where(entmoderation.StatusIn(lo.Map(filter.Statuses, func(status core.ModerationStatus, _ int) int {
+			return int(status)
+		})...))
+	}
+
+	rows, err := q.
+		Order(entmoderation.ByCreatedAt(), entmoderation.ByID()).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return lo.Map(rows, func(row *entgenerated.ModerationItem, _ int) core.ModerationItem {
+		return *toDomainModerationItem(row)
+	}), nextToken, nil
+}
+
+// ListModerationStatuses returns the status of every listed subject that has an item.
+func (r *ModerationRepository) ListModerationStatuses(ctx context.Context, subjectType string, subjectIDs []uuid.UUID) (map[uuid.UUID]core.ModerationStatus, error) {
+	statuses := make(map[uuid.UUID]core.ModerationStatus, len(subjectIDs))
+	if len(subjectIDs) == 0 {
+		return statuses, nil
+	}
+
+	rows, err := r.client.ModerationItem.Query().
+		Where(
+			entmoderation.SubjectType(subjectType),
+			entmoderation.SubjectIDIn(subjectIDs...),
+		).
+		Select(entmoderation.FieldSubjectID, entmoderation.FieldStatus).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		statuses[row.SubjectID] = core.ModerationStatus(row.Status)
+	}
+	return statuses, nil
+}
+
+func toDomainModerationItem(row *entgenerated.ModerationItem) *core.ModerationItem {
+	item := &core.ModerationItem{
+		ID:          row.ID,
+		SubjectType: row.SubjectType,
+		SubjectID:   row.SubjectID,
+		AuthorID:    row.AuthorID,
+		Text:        row.Text,
+		Status:      core.ModerationStatus(row.Status),
+		Labels:      row.Labels,
+		Score:       row.Score,
+		ReviewerID:  row.ReviewerID,
+		ReviewNote:  row.ReviewNote,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+	if row.ReviewedAt != nil {
+		reviewedAt := row.ReviewedAt.UTC()
+		item.ReviewedAt = &reviewedAt
+	}
+	return item
+}
@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestModerationRepository_SaveAndReview(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "moderation_repo")
+	repo := NewModerationRepository(client)
+
+	now := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	subjectID := uuid.New()
+	item := core.ModerationItem{
+		ID:          uuid.New(),
+		SubjectType: core.ModerationSubjectPlaylist,
+		SubjectID:   subjectID,
+		AuthorID:    "user-1",
+		Text:        "Darn good picks",
+		Status:      core.ModerationStatusPending,
+		Labels:      []string{"profanity"},
+		Score:       1,
+	}
+	saved, err := repo.SaveModerationItem(ctx, item)
+	if err != nil {
+		t.Fatalf("SaveModerationItem() error = %v", err)
+	}
+	if !reflect.DeepEqual(saved.Labels, []string{"profanity"}) || saved.Score != 1 {
+		t.Fatalf("unexpected item %+v", saved)
+	}
+
+	reviewedAt := now.Add(time.Hour)
+	saved.Status = core.ModerationStatusRejected
+	saved.ReviewerID = "mod-1"
+	saved.ReviewNote = "language"
+	saved.ReviewedAt = &reviewedAt
+	reviewed, err := repo.UpdateModerationReview(ctx, *saved)
+	if err != nil {
+		t.Fatalf("UpdateModerationReview() error = %v", err)
+	}
+	if reviewed.Status != core.ModerationStatusRejected || reviewed.ReviewedAt == nil || !reviewed.ReviewedAt.Equal(reviewedAt) {
+		t.Fatalf("unexpected review %+v", reviewed)
+	}
+
+	// Saving the subject again replaces the item in place and clears the review.
+	item.ID = uuid.New()
+	item.Text = "Good picks"
+	item.Status = core.ModerationStatusApproved
+	item.Labels = nil
+	item.Score = 0
+	replaced, err := repo.SaveModerationItem(ctx, item)
+	if err != nil {
+		t.Fatalf("SaveModerationItem() error = %v", err)
+	}
+	if replaced.ID != saved.ID || replaced.Text != "Good picks" || replaced.ReviewerID != "" || replaced.ReviewedAt != nil {
+		t.Fatalf("expected the item to be replaced in place, got %+v", replaced)
+	}
+
+	bySubject, err := repo.GetModerationItemBySubject(ctx, core.ModerationSubjectPlaylist, subjectID)
+	if err != nil || bySubject.ID != saved.ID {
+		t.Fatalf("GetModerationItemBySubject() = %+v, %v", bySubject, err)
+	}
+	if _, err := repo.GetModerationItem(ctx, uuid.New()); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if _, err := repo.UpdateModerationReview(ctx, core.ModerationItem{ID: uuid.New()}); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+func TestModerationRepository_ListModerationItems(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "moderation_repo")
+	repo := NewModerationRepository(client)
+
+	statuses := []core.ModerationStatus{core.ModerationStatusPending, core.ModerationStatusApproved, core.ModerationStatusFlagged, core.ModerationStatusPending}
+	subjectIDs := make([]uuid.UUID, len(statuses))
+	for i, status := range statuses {
+		subjectIDs[i] = uuid.New()
+		if _, err := repo.SaveModerationItem(ctx, core.ModerationItem{
+			ID:          uuid.New(),
+			SubjectType: core.ModerationSubjectPlaylist,
+			SubjectID:   subjectIDs[i],
+			Text:        "text",
+			Status:      status,
+		}); err != nil {
+			t.Fatalf("SaveModerationItem() error = %v", err)
+		}
+	}
+
+	filter := core.ModerationListFilter{PageSize: 2, Statuses: []core.ModerationStatus{core.ModerationStatusPending, core.ModerationStatusFlagged}}
+	page, nextToken, err := repo.ListModerationItems(ctx, filter)
+	if err != nil {
+		t.Fatalf("ListModerationItems() error = %v", err)
+	}
+	if len(page) != 2 || nextToken == "" || page[0].SubjectID != subjectIDs[0] || page[1].SubjectID != subjectIDs[2] {
+		t.Fatalf("unexpected first page %+v (next %q)", page, nextToken)
+	}
+	filter.PageToken = nextToken
+	page, nextToken, err = repo.ListModerationItems(ctx, filter)
+	if err != nil {
+		t.Fatalf("ListModerationItems() error = %v", err)
+	}
+	if len(page) != 1 || nextToken != "" || page[0].SubjectID != subjectIDs[3] {
+		t.Fatalf("unexpected second page %+v (next %q)", page, nextToken)
+	}
+
+	got, err := repo.ListModerationStatuses(ctx, core.ModerationSubjectPlaylist, []uuid.UUID{subjectIDs[1], subjectIDs[2], uuid.New()})
+	if err != nil {
+		t.Fatalf("ListModerationStatuses() error = %v", err)
+	}
+	want := map[uuid.UUID]core.ModerationStatus{subjectIDs[1]: core.ModerationStatusApproved, subjectIDs[2]: core.ModerationStatusFlagged}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ListModerationStatuses() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,282 @@
+package db
+
+import (
+	"context"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entdevicetoken "github.com/eslsoft/lession/internal/adapter/db/ent/generated/devicetoken"
+	entnotification "github.com/eslsoft/lession/internal/adapter/db/ent/generated/notification"
+	entnotificationpreference "github.com/eslsoft/lession/internal/adapter/db/ent/generated/notificationpreference"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// NotificationRepository persists notification preferences and deliveries using Ent.
+type NotificationRepository struct {
+	client *entgenerated.Client
+}
+
+// NewNotificationRepository constructs an Ent-backed notification repository.
+func NewNotificationRepository(client *entgenerated.Client) *NotificationRepository {
+	return &NotificationRepository{client: client}
+}
+
+var _ core.NotificationRepository = (*NotificationRepository)(nil)
+
+// GetNotificationPreferences loads a user's stored preferences.
+func (r *NotificationRepository) GetNotificationPreferences(ctx context.Context, userID string) (*core.NotificationPreferences, error) {
+	row, err := r.client.NotificationPreference.Query().
+		Where(entnotificationpreference.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainNotificationPreferences(row), nil
+}
+
+// ListNotificationPreferences loads the stored preferences of the given users.
+func (r *NotificationRepository) ListNotificationPreferences(ctx context.Context, userIDs []string) ([]core.NotificationPreferences, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	rows, err := r.client.NotificationPreference.Query().
+		Where(entnotificationpreference.UserIDIn(userIDs...)).
+		Order(entnotificationpreference.ByUserID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row *entgenerated.NotificationPreference, _ int) core.NotificationPreferences {
+		return *toDomainNotificationPreferences(row)
+	}), nil
+}
+
+// SaveNotificationPreferences creates or replaces a user's preferences.
+func (r *NotificationRepository) SaveNotificationPreferences(ctx context.Context, preferences core.NotificationPreferences) (*core.NotificationPreferences, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := tx.NotificationPreference.Update().
+		Where(entnotificationpreference.UserID(preferences.UserID)).
+		SetEmail(preferences.Email).
+		SetLocale(preferences.Locale).
+		SetOptOuts(preferences.OptOuts).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if updated == 0 {
+		err = tx.NotificationPreference.Create().
+			SetUserID(preferences.UserID).
+			SetEmail(preferences.Email).
+			SetLocale(preferences.Locale).
+			SetOptOuts(preferences.OptOuts).
+			Exec(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.GetNotificationPreferences(core.NewPrimaryReadContext(ctx), preferences.UserID)
+}
+
+// CreateNotification stores a pending notification.
+func (r *NotificationRepository) CreateNotification(ctx context.Context, notification core.Notification) (*core.Notification, error) {
+	row, err := r.client.Notification.Create().
+		SetID(notification.ID).
+		SetUserID(notification.UserID).
+		SetKind(int(notification.Kind)).
+		SetChannel(int(notification.Channel)).
+		SetDedupeKey(notification.DedupeKey).
+		SetSubject(notification.Subject).
+		SetBody(notification.Body).
+		SetStatus(int(notification.Status)).
+		SetError(notification.Error).
+		SetNillableSentAt(notification.SentAt).
+		Save(ctx)
+	if err != nil {
+		if entgenerated.IsConstraintError(err) {
+			return nil, core.ErrAlreadyExists
+		}
+		return nil, err
+	}
+	return toDomainNotification(row), nil
+}
+
+// UpdateNotification records the delivery outcome of a notification.
+func (r *NotificationRepository) UpdateNotification(ctx context.Context, notification core.Notification) (*core.Notification, error) {
+	row, err := r.client.Notification.UpdateOneID(notification.ID).
+		SetStatus(int(notification.Status)).
+		SetError(notification.Error).
+		SetNillableSentAt(notification.SentAt).
+		Save(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainNotification(row), nil
+}
+
+// ListNotifications returns a user's notifications, most recent first.
+func (r *NotificationRepository) ListNotifications(ctx context.Context, filter core.NotificationListFilter) ([]core.Notification, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	rows, err := r.client.Notification.Query().
+		Where(entnotification.UserID(filter.UserID)).
+		Order(entnotification.ByCreatedAt(sql.OrderDesc()), entnotification.ByID()).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return lo.Map(rows, func(row *entgenerated.Notification, _ int) core.Notification {
+		return *toDomainNotification(row)
+	}), nextToken, nil
+}
+
+// SaveDeviceToken registers a device token, moving it to the given user when
+// another user registered it before.
+func (r *NotificationRepository) SaveDeviceToken(ctx context.Context, token core.DeviceToken) (*core.DeviceToken, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := tx.DeviceToken.Update().
+		Where(entdevicetoken.Token(token.Token)).
+		SetUserID(token.UserID).
+		SetPlatform(int(token.Platform)).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if updated == 0 {
+		err = tx.DeviceToken.Create().
+			SetToken(token.Token).
+			SetUserID(token.UserID).
+			SetPlatform(int(token.Platform)).
+			Exec(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+
+	row, err := tx.DeviceToken.Query().
+		Where(entdevicetoken.Token(token.Token)).
+		Only(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toDomainDeviceToken(row), nil
+}
+
+// ListDeviceTokens returns the devices registered by the given users.
+func (r *NotificationRepository) ListDeviceTokens(ctx context.Context, userIDs []string) ([]core.DeviceToken, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	rows, err := r.client.DeviceToken.Query().
+		Where(entdevicetoken.UserIDIn(userIDs...)).
+		Order(entdevicetoken.ByUserID(), entdevicetoken.ByCreatedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row *entgenerated.DeviceToken, _ int) core.DeviceToken {
+		return *toDomainDeviceToken(row)
+	}), nil
+}
+
+// DeleteDeviceToken removes a device token, optionally only when owned by userID.
+func (r *NotificationRepository) DeleteDeviceToken(ctx context.Context, userID, token string) error {
+	q := r.client.DeviceToken.Delete().
+		Where(entdevicetoken.Token(token))
+	if userID != "" {
+		q = q.Where(entdevicetoken.UserID(userID))
+	}
+	deleted, err := q.Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return core.ErrNotFound
+	}
+	return nil
+}
+
+func toDomainNotificationPreferences(row *entgenerated.NotificationPreference) *core.NotificationPreferences {
+	return &core.NotificationPreferences{
+		UserID:    row.UserID,
+		Email:     row.Email,
+		Locale:    row.Locale,
+		OptOuts:   row.OptOuts,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
+
+func toDomainNotification(row *entgenerated.Notification) *core.Notification {
+	return &core.Notification{
+		ID:        row.ID,
+		UserID:    row.UserID,
+		Kind:      core.NotificationKind(row.Kind),
+		Channel:   core.NotificationChannel(row.Channel),
+		DedupeKey: row.DedupeKey,
+		Subject:   row.Subject,
+		Body:      row.Body,
+		Status:    core.NotificationStatus(row.Status),
+		Error:     row.Error,
+		CreatedAt: row.CreatedAt,
+		SentAt:    row.SentAt,
+	}
+}
+
+func toDomainDeviceToken(row *entgenerated.DeviceToken) *core.DeviceToken {
+	return &core.DeviceToken{
+		Token:     row.Token,
+		UserID:    row.UserID,
+		Platform:  core.DevicePlatform(row.Platform),
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
@@ -0,0 +1,126 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestNotificationRepository_Preferences(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "notification_repo")
+	repo := NewNotificationRepository(client)
+
+	if _, err := repo.GetNotificationPreferences(ctx, "alice"); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	for _, email := range []string{"alice@example.com", "alice@example.org"} {
+		_, err := repo.SaveNotificationPreferences(ctx, core.NotificationPreferences{
+			UserID:  "alice",
+			Email:   email,
+			Locale:  "zh",
+			OptOuts: []core.NotificationOptOut{{Kind: core.NotificationKindAssignmentDue, Channel: core.NotificationChannelEmail}},
+		})
+		if err != nil {
+			t.Fatalf("SaveNotificationPreferences() error = %v", err)
+		}
+	}
+
+	stored, err := repo.ListNotificationPreferences(ctx, []string{"alice", "bob"})
+	if err != nil {
+		t.Fatalf("ListNotificationPreferences() error = %v", err)
+	}
+	if len(stored) != 1 || stored[0].Email != "alice@example.org" || stored[0].Locale != "zh" || len(stored[0].OptOuts) != 1 {
+		t.Fatalf("unexpected preferences %+v", stored)
+	}
+}
+
+func TestNotificationRepository_Notifications(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "notification_repo")
+	repo := NewNotificationRepository(client)
+
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	notification := core.Notification{
+		ID:        uuid.New(),
+		UserID:    "alice",
+		Kind:      core.NotificationKindEpisodePublished,
+		Channel:   core.NotificationChannelEmail,
+		DedupeKey: "episode_published:1:alice:1",
+		Subject:   "New episode",
+		Status:    core.NotificationStatusPending,
+	}
+	created, err := repo.CreateNotification(ctx, notification)
+	if err != nil {
+		t.Fatalf("CreateNotification() error = %v", err)
+	}
+
+	duplicate := notification
+	duplicate.ID = uuid.New()
+	if _, err := repo.CreateNotification(ctx, duplicate); !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected ErrAlreadyExists for reused dedupe key, got %v", err)
+	}
+
+	created.Status = core.NotificationStatusSent
+	created.SentAt = &now
+	if _, err := repo.UpdateNotification(ctx, *created); err != nil {
+		t.Fatalf("UpdateNotification() error = %v", err)
+	}
+
+	listed, next, err := repo.ListNotifications(ctx, core.NotificationListFilter{UserID: "alice"})
+	if err != nil {
+		t.Fatalf("ListNotifications() error = %v", err)
+	}
+	if len(listed) != 1 || next != "" || listed[0].Status != core.NotificationStatusSent || listed[0].SentAt == nil {
+		t.Fatalf("unexpected notifications %+v (next %q)", listed, next)
+	}
+}
+
+func TestNotificationRepository_DeviceTokens(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "notification_repo")
+	repo := NewNotificationRepository(client)
+
+	var registered []*core.DeviceToken
+	for _, token := range []core.DeviceToken{
+		{Token: "phone", UserID: "alice", Platform: core.DevicePlatformAndroid},
+		{Token: "browser", UserID: "alice", Platform: core.DevicePlatformWeb},
+		{Token: "phone", UserID: "bob", Platform: core.DevicePlatformIOS},
+	} {
+		saved, err := repo.SaveDeviceToken(ctx, token)
+		if err != nil {
+			t.Fatalf("SaveDeviceToken(%s) error = %v", token.Token, err)
+		}
+		registered = append(registered, saved)
+	}
+
+	devices, err := repo.ListDeviceTokens(ctx, []string{"bob"})
+	if err != nil {
+		t.Fatalf("ListDeviceTokens() error = %v", err)
+	}
+	if len(devices) != 1 || devices[0].Token != "phone" || devices[0].Platform != core.DevicePlatformIOS ||
+		!devices[0].CreatedAt.Equal(registered[0].CreatedAt) || !devices[0].UpdatedAt.After(registered[0].UpdatedAt) {
+		t.Fatalf("expected re-registered token to move to bob, got %+v", devices)
+	}
+
+	if err := repo.DeleteDeviceToken(ctx, "alice", "phone"); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound deleting another user's token, got %v", err)
+	}
+	if err := repo.DeleteDeviceToken(ctx, "", "phone"); err != nil {
+		t.Fatalf("DeleteDeviceToken() error = %v", err)
+	}
+
+	devices, err = repo.ListDeviceTokens(ctx, []string{"alice", "bob"})
+	if err != nil {
+		t.Fatalf("ListDeviceTokens() error = %v", err)
+	}
+	if len(devices) != 1 || devices[0].Token != "browser" {
+		t.Fatalf("unexpected devices %+v", devices)
+	}
+}
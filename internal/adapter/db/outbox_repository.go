@@ -0,0 +1,171 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entoutbox "github.com/eslsoft/lession/internal/adapter/db/ent/generated/outboxmessage"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// OutboxRepository relays the transactional outbox using Ent.
+type OutboxRepository struct {
+	client *entgenerated.Client
+}
+
+// NewOutboxRepository constructs an Ent-backed outbox repository.
+func NewOutboxRepository(client *entgenerated.Client) *OutboxRepository {
+	return &OutboxRepository{client: client}
+}
+
+var _ core.OutboxRepository = (*OutboxRepository)(nil)
+
+// ListPendingOutboxMessages returns undispatched messages due at now, oldest first.
+func (r *OutboxRepository) ListPendingOutboxMessages(ctx context.Context, now time.Time, limit int) ([]core.OutboxMessage, error) {
+	rows, err := r.client.OutboxMessage.Query().
+		Where(
+			entoutbox.DispatchedAtIsNil(),
+			entoutbox.NextAttemptAtLTE(now),
+		).
+		Order(entoutbox.ByOccurredAt(), entoutbox.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(row *entgenerated.OutboxMessage, _ int) core.OutboxMessage {
+		return *toDomainOutboxMessage(row)
+	}), nil
+}
+
+// UpdateOutboxMessage records a relay attempt.
+func (r *OutboxRepository) UpdateOutboxMessage(ctx context.Context, message core.OutboxMessage) error {
+	builder := r.client.OutboxMessage.UpdateOneID(message.ID).
+		SetAttempts(message.Attempts).
+		SetLastError(message.LastError).
+		SetNextAttemptAt(message.NextAttemptAt)
+	if message.DispatchedAt != nil {
+		builder.SetDispatchedAt(*message.DispatchedAt)
+	} else {
+		builder.ClearDispatchedAt()
+	}
+
+	err := builder.Exec(ctx)
+	if entgenerated.IsNotFound(err) {
+		return core.ErrNotFound
+	}
+	return err
+}
+
+// writeOutbox records events in the outbox as part of tx, so they are
+// committed or rolled back together with the change that produced them.
+func writeOutbox(ctx context.Context, tx *entgenerated.Tx, occurredAt time.Time, events []core.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	builders := make([]*entgenerated.OutboxMessageCreate, 0, len(events))
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("encode %s event: %w", event.EventType(), err)
+		}
+		builders = append(builders, tx.OutboxMessage.Create().
+			SetID(uuid.New()).
+			SetEventType(string(event.EventType())).
+			SetAggregateID(event.AggregateID()).
+			SetPayload(payload).
+			SetOccurredAt(occurredAt).
+			SetNextAttemptAt(occurredAt))
+	}
+	return tx.OutboxMessage.CreateBulk(builders...).Exec(ctx)
+}
+
+// stampEvents copies the timestamps the time mixin gave the row identified
+// by id into the events about it, which use cases build before the row is
+// written.
+func stampEvents(events []core.Event, id uuid.UUID, createdAt, updatedAt time.Time) {
+	for i, event := range events {
+		switch e := event.(type) {
+		case core.SeriesCreated:
+			stampSeries(&e.Series, id, createdAt, updatedAt)
+			events[i] = e
+		case core.SeriesUpdated:
+			stampSeries(&e.Series, id, createdAt, updatedAt)
+			events[i] = e
+		case core.SeriesPublished:
+			stampSeries(&e.Series, id, createdAt, updatedAt)
+			events[i] = e
+		case core.EpisodeCreated:
+			stampEpisode(&e.Episode, id, createdAt, updatedAt)
+			events[i] = e
+		case core.EpisodeUpdated:
+			stampEpisode(&e.Episode, id, createdAt, updatedAt)
+			events[i] = e
+		case core.EpisodePublished:
+			stampEpisode(&e.Episode, id, createdAt, updatedAt)
+			events[i] = e
+		case core.EpisodeUnpublished:
+			stampEpisode(&e.Episode, id, createdAt, updatedAt)
+			events[i] = e
+		case core.EpisodeDeleted:
+			stampEpisode(&e.Episode, id, createdAt, updatedAt)
+			events[i] = e
+		case core.AssetReady:
+			stampAsset(&e.Asset, id, createdAt, updatedAt)
+			events[i] = e
+		case core.AssetStatusChanged:
+			stampAsset(&e.Asset, id, createdAt, updatedAt)
+			events[i] = e
+		case core.AssetRenditionReplaced:
+			stampAsset(&e.Asset, id, createdAt, updatedAt)
+			events[i] = e
+		case core.AssetDurationChanged:
+			stampAsset(&e.Asset, id, createdAt, updatedAt)
+			events[i] = e
+		case core.ModerationReviewRequested:
+			if e.Item.ID == id {
+				e.Item.CreatedAt, e.Item.UpdatedAt = createdAt, updatedAt
+				events[i] = e
+			}
+		}
+	}
+}
+
+func stampSeries(series *core.Series, id uuid.UUID, createdAt, updatedAt time.Time) {
+	if series.ID == id {
+		series.CreatedAt, series.UpdatedAt = createdAt, updatedAt
+	}
+}
+
+func stampEpisode(episode *core.Episode, id uuid.UUID, createdAt, updatedAt time.Time) {
+	if episode.ID == id {
+		episode.CreatedAt, episode.UpdatedAt = createdAt, updatedAt
+	}
+}
+
+func stampAsset(asset *core.Asset, id uuid.UUID, createdAt, updatedAt time.Time) {
+	if asset.ID == id {
+		asset.CreatedAt, asset.UpdatedAt = createdAt, updatedAt
+	}
+}
+
+func toDomainOutboxMessage(row *entgenerated.OutboxMessage) *core.OutboxMessage {
+	return &core.OutboxMessage{
+		ID:            row.ID,
+		EventType:     core.EventType(row.EventType),
+		AggregateID:   row.AggregateID,
+		Payload:       row.Payload,
+		OccurredAt:    row.OccurredAt,
+		Attempts:      row.Attempts,
+		LastError:     row.LastError,
+		NextAttemptAt: row.NextAttemptAt,
+		DispatchedAt:  row.DispatchedAt,
+	}
+}
@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestOutboxRepository_WritesWithChanges(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "outbox_repo")
+	repo := NewOutboxRepository(client)
+	seriesRepo := NewSeriesRepository(client)
+
+	series := core.Series{
+		ID:       uuid.New(),
+		Slug:     "travel-english",
+		Title:    "Travel English",
+		Language: "en",
+		Status:   core.SeriesStatusPublished,
+	}
+	created, err := seriesRepo.CreateSeries(ctx, series, core.SeriesPublished{Series: series})
+	if err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+
+	// A failed change must not leave its events behind.
+	missing := core.Episode{ID: uuid.New(), Status: core.EpisodeStatusPublished}
+	if _, err := seriesRepo.UpdateEpisode(ctx, missing, core.EpisodePublished{Episode: missing}); err == nil {
+		t.Fatal("expected updating a missing episode to fail")
+	}
+
+	now := time.Now().UTC()
+	pending, err := repo.ListPendingOutboxMessages(ctx, now, 10)
+	if err != nil {
+		t.Fatalf("ListPendingOutboxMessages() error = %v", err)
+	}
+	if len(pending) != 1 || pending[0].EventType != core.EventTypeSeriesPublished || pending[0].AggregateID != series.ID.String() {
+		t.Fatalf("unexpected pending messages %+v", pending)
+	}
+	event, err := core.DecodeEvent(pending[0].EventType, pending[0].Payload)
+	if err != nil || event.(core.SeriesPublished).Series.Slug != "travel-english" || !event.(core.SeriesPublished).Series.CreatedAt.Equal(created.CreatedAt) {
+		t.Fatalf("unexpected event %+v (%v)", event, err)
+	}
+
+	message := pending[0]
+	message.Attempts = 1
+	message.LastError = "subscriber unavailable"
+	message.NextAttemptAt = now.Add(time.Minute)
+	if err := repo.UpdateOutboxMessage(ctx, message); err != nil {
+		t.Fatalf("UpdateOutboxMessage() error = %v", err)
+	}
+	if pending, _ := repo.ListPendingOutboxMessages(ctx, now, 10); len(pending) != 0 {
+		t.Fatalf("expected the message to wait for its next attempt, got %+v", pending)
+	}
+
+	later := now.Add(time.Minute)
+	message.DispatchedAt = &later
+	if err := repo.UpdateOutboxMessage(ctx, message); err != nil {
+		t.Fatalf("UpdateOutboxMessage() error = %v", err)
+	}
+	if pending, _ := repo.ListPendingOutboxMessages(ctx, later.Add(time.Hour), 10); len(pending) != 0 {
+		t.Fatalf("expected no pending messages after dispatch, got %+v", pending)
+	}
+}
@@ -0,0 +1,45 @@
+package pgvector
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestVector_RoundTrip(t *testing.T) {
+	value, err := Vector{1, -2.5, 0.125}.Value()
+	if err != nil || value != "[1,-2.5,0.125]" {
+		t.Fatalf("Value() = %v, %v", value, err)
+	}
+
+	var v Vector
+	if err := v.Scan([]byte(" [1, -2.5,0.125] ")); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if !reflect.DeepEqual(v, Vector{1, -2.5, 0.125}) {
+		t.Fatalf("Scan() = %v", v)
+	}
+	if err := v.Scan("1,2"); err == nil {
+		t.Fatal("expected a vector without brackets to be rejected")
+	}
+}
+
+func TestCosineSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []float32
+		want float64
+	}{
+		{name: "same direction", a: []float32{1, 2}, b: []float32{2, 4}, want: 1},
+		{name: "orthogonal", a: []float32{1, 0}, b: []float32{0, 3}, want: 0},
+		{name: "opposite", a: []float32{1, 1}, b: []float32{-1, -1}, want: -1},
+		{name: "dimension mismatch", a: []float32{1}, b: []float32{1, 0}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CosineSimilarity(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+				t.Fatalf("CosineSimilarity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package db
+
+import (
+	"context"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entplaylist "github.com/eslsoft/lession/internal/adapter/db/ent/generated/playlist"
+	entplaylistitem "github.com/eslsoft/lession/internal/adapter/db/ent/generated/playlistitem"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// PlaylistRepository persists playlists and their items using Ent.
+type PlaylistRepository struct {
+	client *entgenerated.Client
+}
+
+// NewPlaylistRepository constructs an Ent-backed playlist repository.
+func NewPlaylistRepository(client *entgenerated.Client) *PlaylistRepository {
+	return &PlaylistRepository{client: client}
+}
+
+var _ core.PlaylistRepository = (*PlaylistRepository)(nil)
+
+// CreatePlaylist stores a playlist together with its ordered items.
+func (r *PlaylistRepository) CreatePlaylist(ctx context.Context, playlist core.Playlist) (*core.Playlist, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Playlist.Create().
+		SetID(playlist.ID).
+		SetOwnerID(playlist.OwnerID).
+		SetTitle(playlist.Title).
+		SetDescription(playlist.Description).
+		SetPublic(playlist.Public).
+		SetNillableSlug(lo.EmptyableToPtr(playlist.Slug)).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if entgenerated.IsConstraintError(err) {
+			return nil, core.ErrAlreadyExists
+		}
+		return nil, err
+	}
+
+	episodeIDs := lo.Map(playlist.Items, func(item core.PlaylistItem, _ int) uuid.UUID {
+		return item.EpisodeID
+	})
+	if err := createPlaylistItems(ctx, tx, playlist.ID, episodeIDs); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.GetPlaylist(core.NewPrimaryReadContext(ctx), playlist.ID, core.PlaylistQueryOptions{})
+}
+
+// GetPlaylist loads a playlist by identifier.
+func (r *PlaylistRepository) GetPlaylist(ctx context.Context, id uuid.UUID, opts core.PlaylistQueryOptions) (*core.Playlist, error) {
+	row, err := r.playlistQuery(opts).
+		Where(entplaylist.ID(id)).
+		Only(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainPlaylist(row), nil
+}
+
+// GetPlaylistBySlug loads a playlist by its share slug.
+func (r *PlaylistRepository) GetPlaylistBySlug(ctx context.Context, slug string, opts core.PlaylistQueryOptions) (*core.Playlist, error) {
+	row, err := r.playlistQuery(opts).
+		Where(entplaylist.Slug(slug)).
+		Only(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainPlaylist(row), nil
+}
+
+// UpdatePlaylist persists playlist metadata and sharing settings.
+func (r *PlaylistRepository) UpdatePlaylist(ctx context.Context, playlist core.Playlist) (*core.Playlist, error) {
+	builder := r.client.Playlist.UpdateOneID(playlist.ID).
+		SetTitle(playlist.Title).
+		SetDescription(playlist.Description).
+		SetPublic(playlist.Public)
+	if playlist.Slug != "" {
+		builder.SetSlug(playlist.Slug)
+	} else {
+		builder.ClearSlug()
+	}
+
+	if _, err := builder.Save(ctx); err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		if entgenerated.IsConstraintError(err) {
+			return nil, core.ErrAlreadyExists
+		}
+		return nil, err
+	}
+
+	return r.GetPlaylist(core.NewPrimaryReadContext(ctx), playlist.ID, core.PlaylistQueryOptions{})
+}
+
+// ReplacePlaylistItems rewrites the ordered items of a playlist in a single transaction.
+func (r *PlaylistRepository) ReplacePlaylistItems(ctx context.Context, id uuid.UUID, episodeIDs []uuid.UUID) (*core.Playlist, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Playlist.UpdateOneID(id).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+
+	if _, err := tx.PlaylistItem.Delete().Where(entplaylistitem.PlaylistID(id)).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := createPlaylistItems(ctx, tx, id, episodeIDs); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.GetPlaylist(core.NewPrimaryReadContext(ctx), id, core.PlaylistQueryOptions{})
+}
+
+// DeletePlaylist removes a playlist and its items.
+func (r *PlaylistRepository) DeletePlaylist(ctx context.Context, id uuid.UUID) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.PlaylistItem.Delete().Where(entplaylistitem.PlaylistID(id)).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Playlist.DeleteOneID(id).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		if entgenerated.IsNotFound(err) {
+			return core.ErrNotFound
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ListPlaylists returns playlists matching the filter, most recently updated first.
+func (r *PlaylistRepository) ListPlaylists(ctx context.Context, filter core.PlaylistListFilter) ([]core.Playlist, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	q := r.playlistQuery(core.PlaylistQueryOptions{IncludeEpisodes: filter.IncludeEpisodes})
+	if filter.OwnerID != "" {
+		q = q.Where(entplaylist.OwnerID(filter.OwnerID))
+	}
+
+	rows, err := q.
+		Order(entplaylist.ByUpdatedAt(sql.OrderDesc()), entplaylist.ByID()).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return lo.Map(rows, func(row *entgenerated.Playlist, _ int) core.Playlist {
+		return *toDomainPlaylist(row)
+	}), nextToken, nil
+}
+
+func (r *PlaylistRepository) playlistQuery(opts core.PlaylistQueryOptions) *entgenerated.PlaylistQuery {
+	return r.client.Playlist.Query().
+		WithItems(func(q *entgenerated.PlaylistItemQuery) {
+			q.Order(entplaylistitem.ByPosition())
+			if opts.IncludeEpisodes {
+				q.WithEpisode()
+			}
+		})
+}
+
+func createPlaylistItems(ctx context.Context, tx *entgenerated.Tx, playlistID uuid.UUID, episodeIDs []uuid.UUID) error {
+	if len(episodeIDs) == 0 {
+		return nil
+	}
+	builders := lo.Map(episodeIDs, func(episodeID uuid.UUID, i int) *entgenerated.PlaylistItemCreate {
+		return tx.PlaylistItem.Create().
+			SetPlaylistID(playlistID).
+			SetEpisodeID(episodeID).
+			SetPosition(i)
+	})
+	if err := tx.PlaylistItem.CreateBulk(builders...).Exec(ctx); err != nil {
+		// Episodes are validated by the service; a foreign key violation means
+		// one was removed in the meantime.
+		if entgenerated.IsConstraintError(err) {
+			return core.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func toDomainPlaylist(row *entgenerated.Playlist) *core.Playlist {
+	playlist := &core.Playlist{
+		ID:          row.ID,
+		OwnerID:     row.OwnerID,
+		Title:       row.Title,
+		Description: row.Description,
+		Public:      row.Public,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+	if row.Slug != nil {
+		playlist.Slug = *row.Slug
+	}
+	playlist.Items = lo.Map(row.Edges.Items, func(item *entgenerated.PlaylistItem, _ int) core.PlaylistItem {
+		return core.PlaylistItem{
+			EpisodeID: item.EpisodeID,
+			Position:  item.Position,
+			Episode:   toDomainEpisode(item.Edges.Episode),
+		}
+	})
+	return playlist
+}
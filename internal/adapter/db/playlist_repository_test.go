@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestPlaylistRepository_ItemsAcrossSeries(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "playlist_repo")
+	repo := NewPlaylistRepository(client)
+
+	seriesRepo := NewSeriesRepository(client)
+	var episodeIDs []uuid.UUID
+	for _, slug := range []string{"travel", "business"} {
+		seriesID := uuid.New()
+		episodeID := uuid.New()
+		episodeIDs = append(episodeIDs, episodeID)
+		createSeriesForTest(t, seriesRepo, ctx, core.Series{
+			ID:     seriesID,
+			Slug:   slug,
+			Title:  slug,
+			Status: core.SeriesStatusPublished,
+			Episodes: []core.Episode{{
+				ID:       episodeID,
+				SeriesID: seriesID,
+				Seq:      1,
+				Title:    slug + " 1",
+				Duration: 3 * time.Minute,
+				Status:   core.EpisodeStatusPublished,
+				Resource: core.MediaResource{Type: core.MediaTypeAudio, PlaybackURL: "https://cdn.local/" + slug + ".mp3"},
+			}},
+		})
+	}
+
+	playlistID := uuid.New()
+	_, err := repo.CreatePlaylist(ctx, core.Playlist{
+		ID:      playlistID,
+		OwnerID: "learner",
+		Title:   "Mixed",
+		Public:  true,
+		Slug:    "mixed",
+		Items:   []core.PlaylistItem{{EpisodeID: episodeIDs[0]}, {EpisodeID: episodeIDs[1]}},
+	})
+	if err != nil {
+		t.Fatalf("CreatePlaylist() error = %v", err)
+	}
+
+	reordered, err := repo.ReplacePlaylistItems(ctx, playlistID, []uuid.UUID{episodeIDs[1], episodeIDs[0]})
+	if err != nil {
+		t.Fatalf("ReplacePlaylistItems() error = %v", err)
+	}
+	if reordered.Items[0].EpisodeID != episodeIDs[1] || reordered.Items[1].Position != 1 {
+		t.Fatalf("unexpected order %#v", reordered.Items)
+	}
+
+	shared, err := repo.GetPlaylistBySlug(ctx, "mixed", core.PlaylistQueryOptions{IncludeEpisodes: true})
+	if err != nil {
+		t.Fatalf("GetPlaylistBySlug() error = %v", err)
+	}
+	if len(shared.Items) != 2 || shared.Items[0].Episode == nil || shared.Items[0].Episode.Resource.PlaybackURL != "https://cdn.local/business.mp3" {
+		t.Fatalf("expected playback metadata in playlist order, got %#v", shared.Items)
+	}
+
+	_, err = repo.CreatePlaylist(ctx, core.Playlist{ID: uuid.New(), OwnerID: "other", Title: "Copy", Slug: "mixed"})
+	if !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected duplicate slug to be rejected, got %v", err)
+	}
+
+	if err := repo.DeletePlaylist(ctx, playlistID); err != nil {
+		t.Fatalf("DeletePlaylist() error = %v", err)
+	}
+	if _, err := repo.GetPlaylist(ctx, playlistID, core.PlaylistQueryOptions{}); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected deleted playlist to be gone, got %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// queryCanceledState is the PostgreSQL error code of a statement cancelled
+// by statement_timeout.
+const queryCanceledState = "57014"
+
+// QueryTimeoutInterceptor bounds every query of a client, eager loads
+// included, to timeout so an expensive predicate cannot hold a connection
+// indefinitely. Queries that run out of time fail with core.ErrTimeout.
+func QueryTimeoutInterceptor(timeout time.Duration) ent.Interceptor {
+	return ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, query ent.Query) (ent.Value, error) {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			value, err := next.Query(ctx, query)
+			return value, timeoutError(ctx, err)
+		})
+	})
+}
+
+// QueryTimeoutHook bounds every mutation of a client to timeout, failing
+// the ones that run out of time with core.ErrTimeout.
+func QueryTimeoutHook(timeout time.Duration) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			value, err := next.Mutate(ctx, m)
+			return value, timeoutError(ctx, err)
+		})
+	}
+}
+
+// timeoutError marks err as core.ErrTimeout when the deadline of ctx or the
+// statement_timeout of the server cut the statement short.
+func timeoutError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	var state interface{ SQLState() string }
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) || (errors.As(err, &state) && state.SQLState() == queryCanceledState) {
+		return fmt.Errorf("%w: %w", core.ErrTimeout, err)
+	}
+	return err
+}
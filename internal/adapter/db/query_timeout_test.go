@@ -0,0 +1,46 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestQueryTimeout_FailsStatementsRunningOutOfTime(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "series_repo")
+	repo := NewSeriesRepository(client)
+	client.Intercept(QueryTimeoutInterceptor(time.Nanosecond))
+	client.Use(QueryTimeoutHook(time.Nanosecond))
+
+	if _, _, err := repo.ListSeries(ctx, core.SeriesListFilter{PageSize: 10}); !errors.Is(err, core.ErrTimeout) {
+		t.Fatalf("expected ListSeries() to time out, got %v", err)
+	}
+	series := core.Series{ID: uuid.New(), Slug: "slow", Title: "Slow", Status: core.SeriesStatusDraft}
+	if _, err := repo.CreateSeries(ctx, series); !errors.Is(err, core.ErrTimeout) {
+		t.Fatalf("expected CreateSeries() to time out, got %v", err)
+	}
+}
+
+type sqlStateError string
+
+func (e sqlStateError) Error() string    { return "pq: " + string(e) }
+func (e sqlStateError) SQLState() string { return string(e) }
+
+func TestTimeoutError(t *testing.T) {
+	ctx := context.Background()
+	if err := timeoutError(ctx, sqlStateError(queryCanceledState)); !errors.Is(err, core.ErrTimeout) {
+		t.Fatalf("expected statement_timeout cancellations to time out, got %v", err)
+	}
+	if err := timeoutError(ctx, sqlStateError("23505")); errors.Is(err, core.ErrTimeout) {
+		t.Fatalf("expected other errors unchanged, got %v", err)
+	}
+	if err := timeoutError(ctx, nil); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+}
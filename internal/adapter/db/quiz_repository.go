@@ -0,0 +1,129 @@
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entquizitem "github.com/eslsoft/lession/internal/adapter/db/ent/generated/quizitem"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// QuizRepository persists quiz items using Ent.
+type QuizRepository struct {
+	client *entgenerated.Client
+}
+
+// NewQuizRepository constructs an Ent-backed quiz repository.
+func NewQuizRepository(client *entgenerated.Client) *QuizRepository {
+	return &QuizRepository{client: client}
+}
+
+var _ core.QuizItemRepository = (*QuizRepository)(nil)
+
+// ReplaceQuizItems deletes the episode's items of a kind and creates the
+// given ones in a single transaction.
+func (r *QuizRepository) ReplaceQuizItems(ctx context.Context, episodeID uuid.UUID, kind core.QuizItemKind, items []core.QuizItem) ([]core.QuizItem, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.QuizItem.Delete().
+		Where(entquizitem.EpisodeID(episodeID), entquizitem.Kind(int(kind))).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	rows, err := tx.QuizItem.CreateBulk(lo.Map(items, func(item core.QuizItem, _ int) *entgenerated.QuizItemCreate {
+		return tx.QuizItem.Create().
+			SetID(item.ID).
+			SetEpisodeID(episodeID).
+			SetKind(int(kind)).
+			SetPosition(item.Position).
+			SetPrompt(item.Prompt).
+			SetAnswer(item.Answer).
+			SetSentence(item.Sentence).
+			SetNeedsReview(item.NeedsReview)
+	})...).Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(row *entgenerated.QuizItem, _ int) core.QuizItem {
+		return *toDomainQuizItem(row)
+	}), nil
+}
+
+// ListQuizItems returns the items matching the filter, ordered by position.
+func (r *QuizRepository) ListQuizItems(ctx context.Context, filter core.QuizItemFilter) ([]core.QuizItem, error) {
+	q := r.client.QuizItem.Query().
+		Where(entquizitem.EpisodeID(filter.EpisodeID))
+	if filter.Kind != core.QuizItemKindUnspecified {
+		q = q.Where(entquizitem.Kind(int(filter.Kind)))
+	}
+	if filter.NeedsReview != nil {
+		q = q.Where(entquizitem.NeedsReview(*filter.NeedsReview))
+	}
+
+	rows, err := q.
+		Order(entquizitem.ByKind(), entquizitem.ByPosition()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(row *entgenerated.QuizItem, _ int) core.QuizItem {
+		return *toDomainQuizItem(row)
+	}), nil
+}
+
+// GetQuizItem fetches a quiz item by identifier.
+func (r *QuizRepository) GetQuizItem(ctx context.Context, id uuid.UUID) (*core.QuizItem, error) {
+	row, err := r.client.QuizItem.Get(ctx, id)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainQuizItem(row), nil
+}
+
+// UpdateQuizItem writes the editable fields of a quiz item.
+func (r *QuizRepository) UpdateQuizItem(ctx context.Context, item core.QuizItem) (*core.QuizItem, error) {
+	row, err := r.client.QuizItem.UpdateOneID(item.ID).
+		SetPosition(item.Position).
+		SetPrompt(item.Prompt).
+		SetAnswer(item.Answer).
+		SetNeedsReview(item.NeedsReview).
+		Save(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainQuizItem(row), nil
+}
+
+func toDomainQuizItem(row *entgenerated.QuizItem) *core.QuizItem {
+	return &core.QuizItem{
+		ID:          row.ID,
+		EpisodeID:   row.EpisodeID,
+		Kind:        core.QuizItemKind(row.Kind),
+		Position:    row.Position,
+		Prompt:      row.Prompt,
+		Answer:      row.Answer,
+		Sentence:    row.Sentence,
+		NeedsReview: row.NeedsReview,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
@@ -0,0 +1,70 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestQuizRepository_ReplaceAndUpdate(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "quiz_repo")
+	repo := NewQuizRepository(client)
+
+	episodeID := uuid.New()
+	cloze := func(position int, answer string) core.QuizItem {
+		return core.QuizItem{
+			ID:          uuid.New(),
+			EpisodeID:   episodeID,
+			Kind:        core.QuizItemKindCloze,
+			Position:    position,
+			Prompt:      "The " + core.ClozeBlank + " poured the milk.",
+			Answer:      answer,
+			Sentence:    "The " + answer + " poured the milk.",
+			NeedsReview: true,
+		}
+	}
+
+	if _, err := repo.ReplaceQuizItems(ctx, episodeID, core.QuizItemKindCloze, []core.QuizItem{cloze(0, "waiter"), cloze(1, "chef")}); err != nil {
+		t.Fatalf("ReplaceQuizItems() error = %v", err)
+	}
+	replaced, err := repo.ReplaceQuizItems(ctx, episodeID, core.QuizItemKindCloze, []core.QuizItem{cloze(1, "barista"), cloze(0, "owner")})
+	if err != nil {
+		t.Fatalf("ReplaceQuizItems() error = %v", err)
+	}
+
+	items, err := repo.ListQuizItems(ctx, core.QuizItemFilter{EpisodeID: episodeID})
+	if err != nil {
+		t.Fatalf("ListQuizItems() error = %v", err)
+	}
+	if len(items) != 2 || items[0].Answer != "owner" || items[1].Answer != "barista" {
+		t.Fatalf("expected the replaced items in position order, got %#v", items)
+	}
+
+	reviewed := replaced[0]
+	reviewed.NeedsReview = false
+	reviewed.Answer = "baristas"
+	if _, err := repo.UpdateQuizItem(ctx, reviewed); err != nil {
+		t.Fatalf("UpdateQuizItem() error = %v", err)
+	}
+	pending, err := repo.ListQuizItems(ctx, core.QuizItemFilter{EpisodeID: episodeID, NeedsReview: lo.ToPtr(true)})
+	if err != nil {
+		t.Fatalf("ListQuizItems() error = %v", err)
+	}
+	if len(pending) != 1 || pending[0].Answer != "owner" {
+		t.Fatalf("expected only the unreviewed item, got %#v", pending)
+	}
+	got, err := repo.GetQuizItem(ctx, reviewed.ID)
+	if err != nil || got.Answer != "baristas" || got.NeedsReview || !got.UpdatedAt.After(replaced[0].UpdatedAt) {
+		t.Fatalf("GetQuizItem() = %#v, %v", got, err)
+	}
+
+	if _, err := repo.GetQuizItem(ctx, uuid.New()); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
@@ -0,0 +1,71 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// ReplicaDriver wraps the Ent driver of the primary database, sending plain
+// SELECT queries to a read replica when their context allows it (see
+// core.NewReplicaReadContext). Statements that write or lock rows and every
+// transaction stay on the primary.
+type ReplicaDriver struct {
+	dialect.Driver
+	replica dialect.Driver
+}
+
+// NewReplicaDriver routes the replica reads of primary to replica.
+func NewReplicaDriver(primary, replica dialect.Driver) *ReplicaDriver {
+	return &ReplicaDriver{Driver: primary, replica: replica}
+}
+
+var _ dialect.Driver = (*ReplicaDriver)(nil)
+
+// Query runs the query on the replica when allowed and on the primary
+// otherwise.
+func (d *ReplicaDriver) Query(ctx context.Context, query string, args, v any) error {
+	if core.ReplicaReadsAllowed(ctx) && isReplicaQuery(query) {
+		return d.replica.Query(ctx, query, args, v)
+	}
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+// BeginTx starts a transaction with options on the primary.
+func (d *ReplicaDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	if beginner, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}); ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+	return d.Driver.Tx(ctx)
+}
+
+// Close closes both databases.
+func (d *ReplicaDriver) Close() error {
+	return errors.Join(d.Driver.Close(), d.replica.Close())
+}
+
+// rowLockClauses make a SELECT take row locks, which only the primary can.
+var rowLockClauses = []string{" FOR UPDATE", " FOR NO KEY UPDATE", " FOR SHARE", " FOR KEY SHARE"}
+
+// isReplicaQuery reports whether query only reads. PostgreSQL inserts and
+// updates with RETURNING are queries too, so anything but a plain SELECT goes
+// to the primary.
+func isReplicaQuery(query string) bool {
+	query = strings.ToUpper(strings.TrimSpace(query))
+	if !strings.HasPrefix(query, "SELECT") {
+		return false
+	}
+	for _, clause := range rowLockClauses {
+		if strings.Contains(query, clause) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,64 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/dialect"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/enttest"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestReplicaDriver_RoutesReplicaReads(t *testing.T) {
+	ctx := context.Background()
+	primary := openSQLiteDriver(t, "replica_driver_primary")
+	replica := openSQLiteDriver(t, "replica_driver_replica")
+	client := enttest.NewClient(t, enttest.WithOptions(entgenerated.Driver(NewReplicaDriver(primary, replica))))
+	defer client.Close()
+	for _, drv := range []dialect.Driver{primary, replica} {
+		if err := entgenerated.NewClient(entgenerated.Driver(drv)).Schema.Create(ctx); err != nil {
+			t.Fatalf("failed creating schema: %v", err)
+		}
+	}
+
+	// The replica has yet to receive the series written to the primary.
+	repo := NewSeriesRepository(client)
+	created, err := repo.CreateSeries(ctx, core.Series{
+		Slug:   "lagging",
+		Title:  "Lagging",
+		Status: core.SeriesStatusDraft,
+	})
+	if err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+
+	if _, err := repo.GetSeries(ctx, created.ID, core.SeriesQueryOptions{}); err != nil {
+		t.Fatalf("expected reads to use the primary by default, got %v", err)
+	}
+	replicaCtx := core.NewReplicaReadContext(ctx)
+	if _, err := repo.GetSeries(replicaCtx, created.ID, core.SeriesQueryOptions{}); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected a replica read to miss the series, got %v", err)
+	}
+	if _, err := repo.GetSeries(core.NewPrimaryReadContext(replicaCtx), created.ID, core.SeriesQueryOptions{}); err != nil {
+		t.Fatalf("expected the escape hatch to read the primary, got %v", err)
+	}
+}
+
+func TestIsReplicaQuery(t *testing.T) {
+	tests := map[string]bool{
+		`SELECT "series"."id" FROM "series"`: true,
+		` select 1`:                          true,
+		`SELECT "jobs"."id" FROM "jobs" FOR UPDATE SKIP LOCKED`:        false,
+		`SELECT "id" FROM "plans" FOR SHARE`:                           false,
+		`INSERT INTO "series" ("id") VALUES ($1) RETURNING "id"`:       false,
+		`UPDATE "series" SET "title" = $1 WHERE "id" = $2 RETURNING *`: false,
+	}
+	for query, want := range tests {
+		if got := isReplicaQuery(query); got != want {
+			t.Errorf("isReplicaQuery(%q) = %v, want %v", query, got, want)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entscheduledtask "github.com/eslsoft/lession/internal/adapter/db/ent/generated/scheduledtask"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// ScheduledTaskRepository persists scheduled tasks using Ent.
+type ScheduledTaskRepository struct {
+	client *entgenerated.Client
+}
+
+// NewScheduledTaskRepository constructs an Ent-backed scheduled task repository.
+func NewScheduledTaskRepository(client *entgenerated.Client) *ScheduledTaskRepository {
+	return &ScheduledTaskRepository{client: client}
+}
+
+var _ core.ScheduledTaskRepository = (*ScheduledTaskRepository)(nil)
+
+// EnsureScheduledTask registers the task, or updates the interval of an
+// already registered task while keeping its run state.
+func (r *ScheduledTaskRepository) EnsureScheduledTask(ctx context.Context, task core.ScheduledTask) (*core.ScheduledTask, error) {
+	updated, err := r.client.ScheduledTask.Update().
+		Where(entscheduledtask.Name(task.Name)).
+		SetIntervalSeconds(int64(task.Interval / time.Second)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		_, err = r.client.ScheduledTask.Create().
+			SetName(task.Name).
+			SetIntervalSeconds(int64(task.Interval / time.Second)).
+			SetNextRunAt(task.NextRunAt).
+			Save(ctx)
+		// Another worker registering the task concurrently is fine.
+		if err != nil && !entgenerated.IsConstraintError(err) {
+			return nil, err
+		}
+	}
+
+	row, err := r.client.ScheduledTask.Query().
+		Where(entscheduledtask.Name(task.Name)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainScheduledTask(row), nil
+}
+
+// ClaimScheduledTask leases the task with a single conditional update, so
+// only one of several workers racing for a due task wins it.
+func (r *ScheduledTaskRepository) ClaimScheduledTask(ctx context.Context, claim core.ScheduledTaskClaim) (bool, error) {
+	updated, err := r.client.ScheduledTask.Update().
+		Where(
+			entscheduledtask.Name(claim.Name),
+			entscheduledtask.NextRunAtLTE(claim.Now),
+			entscheduledtask.Or(
+				entscheduledtask.LockedUntilIsNil(),
+				entscheduledtask.LockedUntilLT(claim.Now),
+			),
+		).
+		SetLockedBy(claim.Worker).
+		SetLockedUntil(claim.Now.Add(claim.Lease)).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return updated > 0, nil
+}
+
+// UpdateScheduledTask records the outcome of a run and the lease state.
+func (r *ScheduledTaskRepository) UpdateScheduledTask(ctx context.Context, task core.ScheduledTask) (*core.ScheduledTask, error) {
+	row, err := r.client.ScheduledTask.Query().
+		Where(entscheduledtask.Name(task.Name)).
+		Only(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+
+	builder := row.Update().
+		SetNextRunAt(task.NextRunAt).
+		SetLastStatus(int(task.LastStatus)).
+		SetLastError(task.LastError).
+		SetLastDurationMs(task.LastDuration.Milliseconds()).
+		SetLockedBy(task.LockedBy)
+	if task.LastRunAt != nil {
+		builder.SetLastRunAt(*task.LastRunAt)
+	} else {
+		builder.ClearLastRunAt()
+	}
+	if task.LockedUntil != nil {
+		builder.SetLockedUntil(*task.LockedUntil)
+	} else {
+		builder.ClearLockedUntil()
+	}
+
+	row, err = builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainScheduledTask(row), nil
+}
+
+// ListScheduledTasks returns every registered task ordered by name.
+func (r *ScheduledTaskRepository) ListScheduledTasks(ctx context.Context) ([]core.ScheduledTask, error) {
+	rows, err := r.client.ScheduledTask.Query().
+		Order(entscheduledtask.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(row *entgenerated.ScheduledTask, _ int) core.ScheduledTask {
+		return *toDomainScheduledTask(row)
+	}), nil
+}
+
+func toDomainScheduledTask(row *entgenerated.ScheduledTask) *core.ScheduledTask {
+	return &core.ScheduledTask{
+		Name:         row.Name,
+		Interval:     time.Duration(row.IntervalSeconds) * time.Second,
+		NextRunAt:    row.NextRunAt,
+		LastRunAt:    row.LastRunAt,
+		LastStatus:   core.ScheduledTaskStatus(row.LastStatus),
+		LastError:    row.LastError,
+		LastDuration: time.Duration(row.LastDurationMs) * time.Millisecond,
+		LockedBy:     row.LockedBy,
+		LockedUntil:  row.LockedUntil,
+		UpdatedAt:    row.UpdatedAt,
+	}
+}
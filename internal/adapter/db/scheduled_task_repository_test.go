@@ -0,0 +1,61 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestScheduledTaskRepository_ClaimScheduledTask(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "scheduled_task_repo")
+	repo := NewScheduledTaskRepository(client)
+
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	task := core.ScheduledTask{Name: "asset_gc", Interval: time.Hour, NextRunAt: now}
+	if _, err := repo.EnsureScheduledTask(ctx, task); err != nil {
+		t.Fatalf("EnsureScheduledTask() error = %v", err)
+	}
+
+	claim := core.ScheduledTaskClaim{Name: "asset_gc", Worker: "worker-a", Now: now, Lease: time.Minute}
+	if claimed, err := repo.ClaimScheduledTask(ctx, claim); err != nil || !claimed {
+		t.Fatalf("expected the due task to be claimed, got %v, %v", claimed, err)
+	}
+	claim.Worker = "worker-b"
+	if claimed, _ := repo.ClaimScheduledTask(ctx, claim); claimed {
+		t.Fatal("expected a leased task not to be claimed twice")
+	}
+
+	lastRunAt := now
+	task.NextRunAt = now.Add(time.Hour)
+	task.LastRunAt = &lastRunAt
+	task.LastStatus = core.ScheduledTaskStatusSucceeded
+	task.LastDuration = 1500 * time.Millisecond
+	if _, err := repo.UpdateScheduledTask(ctx, task); err != nil {
+		t.Fatalf("UpdateScheduledTask() error = %v", err)
+	}
+
+	claim.Now = now.Add(30 * time.Minute)
+	if claimed, _ := repo.ClaimScheduledTask(ctx, claim); claimed {
+		t.Fatal("expected a task that is not due not to be claimed")
+	}
+
+	// Registering the task again changes its interval but keeps its run state.
+	ensured, err := repo.EnsureScheduledTask(ctx, core.ScheduledTask{Name: "asset_gc", Interval: 2 * time.Hour, NextRunAt: now})
+	if err != nil {
+		t.Fatalf("EnsureScheduledTask() error = %v", err)
+	}
+	if ensured.Interval != 2*time.Hour || !ensured.NextRunAt.Equal(now.Add(time.Hour)) || ensured.LastStatus != core.ScheduledTaskStatusSucceeded {
+		t.Fatalf("unexpected task after re-registering %+v", ensured)
+	}
+
+	tasks, err := repo.ListScheduledTasks(ctx)
+	if err != nil {
+		t.Fatalf("ListScheduledTasks() error = %v", err)
+	}
+	if len(tasks) != 1 || tasks[0].LastDuration != 1500*time.Millisecond || tasks[0].LockedUntil != nil {
+		t.Fatalf("unexpected tasks %+v", tasks)
+	}
+}
@@ -0,0 +1,50 @@
+package db
+
+import (
+	"context"
+
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect/sql/schema"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	// The runtime registers the defaults, hooks and interceptors of the Ent
+	// schema with the generated client.
+	_ "github.com/eslsoft/lession/internal/adapter/db/ent/generated/runtime"
+)
+
+// CreateSchema brings a MySQL or SQLite database in line with the Ent
+// schema. The versioned migrations are written for PostgreSQL, so databases
+// of the other dialects are migrated from the schema itself.
+func CreateSchema(ctx context.Context, client *entgenerated.Client) error {
+	return client.Schema.Create(ctx, schema.WithDiffHook(withoutJSONIndexes))
+}
+
+// withoutJSONIndexes leaves out the indexes on JSON columns. They exist for
+// PostgreSQL's GIN containment lookups; MySQL cannot index JSON columns and
+// SQLite gains nothing from them.
+func withoutJSONIndexes(next schema.Differ) schema.Differ {
+	return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+		for _, table := range desired.Tables {
+			indexes := table.Indexes[:0]
+			for _, index := range table.Indexes {
+				if !indexesJSON(index) {
+					indexes = append(indexes, index)
+				}
+			}
+			table.Indexes = indexes
+		}
+		return next.Diff(current, desired)
+	})
+}
+
+func indexesJSON(index *atlas.Index) bool {
+	for _, part := range index.Parts {
+		if part.C == nil || part.C.Type == nil {
+			continue
+		}
+		if _, ok := part.C.Type.Type.(*atlas.JSONType); ok {
+			return true
+		}
+	}
+	return false
+}
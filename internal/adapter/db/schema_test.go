@@ -0,0 +1,49 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+)
+
+func TestCreateSchema_SkipsJSONIndexes(t *testing.T) {
+	ctx := context.Background()
+	drv := openSQLiteDriver(t, "create_schema")
+	client := entgenerated.NewClient(entgenerated.Driver(drv))
+	t.Cleanup(func() { _ = client.Close() })
+
+	if err := CreateSchema(ctx, client); err != nil {
+		t.Fatalf("CreateSchema() error = %v", err)
+	}
+	// A second run finds nothing to change.
+	if err := CreateSchema(ctx, client); err != nil {
+		t.Fatalf("CreateSchema() rerun error = %v", err)
+	}
+
+	rows, err := drv.(*entsql.Driver).DB().QueryContext(ctx, `SELECT name FROM sqlite_master WHERE type = 'index' AND tbl_name = 'series'`)
+	if err != nil {
+		t.Fatalf("list indexes: %v", err)
+	}
+	defer rows.Close()
+	indexes := map[string]bool{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			t.Fatalf("scan index: %v", err)
+		}
+		indexes[name] = true
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("list indexes: %v", err)
+	}
+
+	if !indexes["series_status_created_at"] {
+		t.Fatalf("expected the listing indexes to be created, got %v", indexes)
+	}
+	if indexes["series_tags"] || indexes["series_author_ids"] {
+		t.Fatalf("expected no indexes on JSON columns, got %v", indexes)
+	}
+}
@@ -0,0 +1,210 @@
+package db
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entepisode "github.com/eslsoft/lession/internal/adapter/db/ent/generated/episode"
+	entepisodetranscript "github.com/eslsoft/lession/internal/adapter/db/ent/generated/episodetranscript"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	entseries "github.com/eslsoft/lession/internal/adapter/db/ent/generated/series"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// searchCandidateLimit bounds how many matches of each kind are ranked. The
+// newest matches win when more match.
+const searchCandidateLimit = 500
+
+// searchSnippetRunes is the length of hit snippets.
+const searchSnippetRunes = 160
+
+// SearchRepository searches published series and episodes in the database.
+// On PostgreSQL documents match through full-text search on the
+// series_search and episodes_search indexes; other databases match
+// substrings. Matches are ranked by how often the query terms occur, title
+// matches counting double.
+type SearchRepository struct {
+	client *entgenerated.Client
+}
+
+// NewSearchRepository constructs a database search index.
+func NewSearchRepository(client *entgenerated.Client) *SearchRepository {
+	return &SearchRepository{client: client}
+}
+
+var _ core.SearchIndex = (*SearchRepository)(nil)
+
+// IndexDocuments does nothing; the content tables are the index.
+func (r *SearchRepository) IndexDocuments(ctx context.Context, docs ...core.SearchDocument) error {
+	return nil
+}
+
+// DeleteDocuments does nothing; the content tables are the index.
+func (r *SearchRepository) DeleteDocuments(ctx context.Context, ids ...string) error {
+	return nil
+}
+
+// Search ranks the published series and episodes matching the query.
+func (r *SearchRepository) Search(ctx context.Context, query core.SearchQuery) ([]core.SearchHit, string, error) {
+	offset, err := parseOffsetToken(query.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	pageSize := query.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	terms := strings.Fields(strings.ToLower(query.Query))
+
+	var hits []core.SearchHit
+	if searchesKind(query, core.SearchKindSeries) {
+		rows, err := r.client.Series.Query().
+			Where(seriesFilters(query)...).
+			Where(textMatch(query.Query, entseries.FieldTitle, entseries.FieldSummary)).
+			Order(entseries.ByPublishedAt(sql.OrderDesc())).
+			Limit(searchCandidateLimit).
+			All(ctx)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, row := range rows {
+			hits = append(hits, scoreHit(core.SearchHit{
+				Kind:     core.SearchKindSeries,
+				SeriesID: row.ID,
+				Title:    row.Title,
+			}, terms, row.Summary))
+		}
+	}
+	if searchesKind(query, core.SearchKindEpisode) {
+		rows, err := r.client.Episode.Query().
+			Where(
+				entepisode.StatusEQ(int(core.EpisodeStatusPublished)),
+				entepisode.HasSeriesWith(seriesFilters(query)...),
+				entepisode.Or(
+					textMatch(query.Query, entepisode.FieldTitle, entepisode.FieldDescription, entepisode.FieldTranscriptContent),
+					entepisode.HasTranscriptWith(textMatch(query.Query, entepisodetranscript.FieldContent)),
+				),
+			).
+			WithTranscript().
+			Order(entepisode.ByPublishedAt(sql.OrderDesc())).
+			Limit(searchCandidateLimit).
+			All(ctx)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, row := range rows {
+			hits = append(hits, scoreHit(core.SearchHit{
+				Kind:      core.SearchKindEpisode,
+				SeriesID:  row.SeriesID,
+				EpisodeID: row.ID,
+				Title:     row.Title,
+			}, terms, row.Description, transcriptContent(row)))
+		}
+	}
+
+	slices.SortStableFunc(hits, func(a, b core.SearchHit) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+	if offset >= len(hits) {
+		return []core.SearchHit{}, "", nil
+	}
+	hits = hits[offset:]
+	nextToken := ""
+	if len(hits) > pageSize {
+		hits = hits[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+	return hits, nextToken, nil
+}
+
+func searchesKind(query core.SearchQuery, kind core.SearchKind) bool {
+	return len(query.Kinds) == 0 || slices.Contains(query.Kinds, kind)
+}
+
+// seriesFilters selects the published series matching the filters of query.
+func seriesFilters(query core.SearchQuery) []predicate.Series {
+	preds := []predicate.Series{entseries.StatusEQ(int(core.SeriesStatusPublished))}
+	if query.Language != "" {
+		preds = append(preds, entseries.LanguageEQ(query.Language))
+	}
+	if query.Level != "" {
+		preds = append(preds, entseries.LevelEQ(query.Level))
+	}
+	if len(query.Tags) > 0 {
+		preds = append(preds, func(s *sql.Selector) {
+			s.Where(sql.Or(lo.Map(query.Tags, func(tag string, _ int) *sql.Predicate {
+				return sqljson.ValueContains(entseries.FieldTags, tag)
+			})...))
+		})
+	}
+	return preds
+}
+
+// textMatch matches rows whose columns contain the text: every word of it
+// through full-text search on PostgreSQL, the whole text elsewhere. The
+// PostgreSQL expression is the one the search indexes are built on.
+func textMatch(text string, columns ...string) func(*sql.Selector) {
+	text = strings.TrimSpace(text)
+	return func(s *sql.Selector) {
+		if s.Dialect() == dialect.Postgres {
+			document := strings.Join(lo.Map(columns, func(column string, _ int) string {
+				return s.C(column)
+			}), " || ' ' || ")
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.WriteString("to_tsvector('simple', " + document + ") @@ plainto_tsquery('simple', ").Arg(text).WriteString(")")
+			}))
+			return
+		}
+		s.Where(sql.Or(lo.Map(columns, func(column string, _ int) *sql.Predicate {
+			return sql.ContainsFold(s.C(column), text)
+		})...))
+	}
+}
+
+// scoreHit scores hit by the occurrences of terms in its title, counting
+// double, and body, and cuts a snippet around the first term in the body.
+func scoreHit(hit core.SearchHit, terms []string, body ...string) core.SearchHit {
+	title := strings.ToLower(hit.Title)
+	text := strings.Join(lo.Compact(body), " ")
+	lower := strings.ToLower(text)
+	first := -1
+	for _, term := range terms {
+		hit.Score += 2*float64(strings.Count(title, term)) + float64(strings.Count(lower, term))
+		if i := strings.Index(lower, term); i >= 0 && (first < 0 || i < first) {
+			first = i
+		}
+	}
+	hit.Snippet = snippet(text, max(first, 0))
+	return hit
+}
+
+// snippet cuts searchSnippetRunes runes of text starting a little before the
+// byte offset at. Lower-casing can shift offsets, so at is clamped and
+// moved to a rune boundary.
+func snippet(text string, at int) string {
+	at = min(at, len(text))
+	for at > 0 && at < len(text) && !utf8.RuneStart(text[at]) {
+		at--
+	}
+	runes := []rune(text)
+	start := max(utf8.RuneCountInString(text[:at])-searchSnippetRunes/4, 0)
+	end := min(start+searchSnippetRunes, len(runes))
+	out := strings.TrimSpace(string(runes[start:end]))
+	if start > 0 {
+		out = "…" + out
+	}
+	if end < len(runes) {
+		out += "…"
+	}
+	return out
+}
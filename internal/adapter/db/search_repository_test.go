@@ -0,0 +1,140 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestSearchRepository_SearchMatchesPublishedContent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newTestClient(t, "series_repo")
+	repo := NewSeriesRepository(client)
+
+	now := time.Date(2024, 5, 6, 9, 0, 0, 0, time.UTC)
+	published := core.Series{
+		ID: uuid.New(), Slug: "travel", Title: "Travel English", Summary: "Phrases for airports and hotels.",
+		Language: "en", Level: "A2", Tags: []string{"travel"}, Status: core.SeriesStatusPublished,
+		PublishedAt: &now,
+	}
+	draft := core.Series{
+		ID: uuid.New(), Slug: "draft", Title: "Hotel Drafts", Summary: "Not yet out.",
+		Language: "en", Level: "A2", Status: core.SeriesStatusDraft,
+	}
+	createSeriesForTest(t, repo, ctx, published)
+	createSeriesForTest(t, repo, ctx, draft)
+
+	checkIn := core.Episode{
+		ID: uuid.New(), SeriesID: published.ID, Seq: 1, Title: "Checking in", Description: "At the hotel desk.",
+		Status: core.EpisodeStatusPublished, Transcript: core.Transcript{Content: "I booked a room at this hotel."},
+		PublishedAt: &now,
+	}
+	unpublished := core.Episode{
+		ID: uuid.New(), SeriesID: published.ID, Seq: 2, Title: "Hotel breakfast", Status: core.EpisodeStatusDraft,
+	}
+	inDraft := core.Episode{
+		ID: uuid.New(), SeriesID: draft.ID, Seq: 1, Title: "Hotel lobby", Status: core.EpisodeStatusPublished,
+		PublishedAt: &now,
+	}
+	for _, episode := range []core.Episode{checkIn, unpublished, inDraft} {
+		if _, err := repo.CreateEpisode(ctx, episode); err != nil {
+			t.Fatalf("CreateEpisode() error = %v", err)
+		}
+	}
+
+	search := NewSearchRepository(client)
+	hits, next, err := search.Search(ctx, core.SearchQuery{Query: "hotel", PageSize: 10})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if next != "" {
+		t.Fatalf("expected no next page token, got %q", next)
+	}
+	if len(hits) != 2 {
+		t.Fatalf("expected 2 hits, got %+v", hits)
+	}
+	// The episode mentions the term twice, the series once.
+	if hits[0].Kind != core.SearchKindEpisode || hits[0].EpisodeID != checkIn.ID || hits[0].SeriesID != published.ID {
+		t.Fatalf("unexpected first hit: %+v", hits[0])
+	}
+	if hits[1].Kind != core.SearchKindSeries || hits[1].SeriesID != published.ID {
+		t.Fatalf("unexpected second hit: %+v", hits[1])
+	}
+	if hits[1].Snippet != published.Summary {
+		t.Fatalf("expected snippet %q, got %q", published.Summary, hits[1].Snippet)
+	}
+
+	hits, _, err = search.Search(ctx, core.SearchQuery{Query: "hotel", Kinds: []core.SearchKind{core.SearchKindSeries}, PageSize: 10})
+	if err != nil {
+		t.Fatalf("Search(series) error = %v", err)
+	}
+	if len(hits) != 1 || hits[0].Kind != core.SearchKindSeries {
+		t.Fatalf("expected only the series, got %+v", hits)
+	}
+
+	hits, _, err = search.Search(ctx, core.SearchQuery{Query: "hotel", Tags: []string{"business"}, PageSize: 10})
+	if err != nil {
+		t.Fatalf("Search(tags) error = %v", err)
+	}
+	if len(hits) != 0 {
+		t.Fatalf("expected no hits for other tags, got %+v", hits)
+	}
+
+	hits, next, err = search.Search(ctx, core.SearchQuery{Query: "hotel", Level: "A2", PageSize: 1})
+	if err != nil {
+		t.Fatalf("Search(page 1) error = %v", err)
+	}
+	if len(hits) != 1 || next == "" {
+		t.Fatalf("expected one hit and a next page, got %+v, %q", hits, next)
+	}
+	hits, next, err = search.Search(ctx, core.SearchQuery{Query: "hotel", Level: "A2", PageSize: 1, PageToken: next})
+	if err != nil {
+		t.Fatalf("Search(page 2) error = %v", err)
+	}
+	if len(hits) != 1 || hits[0].Kind != core.SearchKindSeries || next != "" {
+		t.Fatalf("unexpected second page: %+v, %q", hits, next)
+	}
+
+	if _, _, err := search.Search(ctx, core.SearchQuery{Query: "hotel", PageToken: "bogus"}); !errors.Is(err, core.ErrInvalidPageToken) {
+		t.Fatalf("expected ErrInvalidPageToken, got %v", err)
+	}
+}
+
+func TestSearchRepository_SearchMatchesOffloadedTranscripts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newTestClient(t, "series_repo")
+	repo := NewSeriesRepository(client)
+	repo.WithTranscriptOffload(8)
+
+	now := time.Date(2024, 5, 6, 9, 0, 0, 0, time.UTC)
+	series := core.Series{
+		ID: uuid.New(), Slug: "lectures", Title: "Lectures", Status: core.SeriesStatusPublished,
+		PublishedAt: &now,
+	}
+	createSeriesForTest(t, repo, ctx, series)
+	lecture := core.Episode{
+		ID: uuid.New(), SeriesID: series.ID, Seq: 1, Title: "Week one", Status: core.EpisodeStatusPublished,
+		Transcript:  core.Transcript{Content: "Today we discuss photosynthesis in detail."},
+		PublishedAt: &now,
+	}
+	if _, err := repo.CreateEpisode(ctx, lecture); err != nil {
+		t.Fatalf("CreateEpisode() error = %v", err)
+	}
+
+	hits, _, err := NewSearchRepository(client).Search(ctx, core.SearchQuery{Query: "photosynthesis", PageSize: 10})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(hits) != 1 || hits[0].EpisodeID != lecture.ID || hits[0].Snippet == "" {
+		t.Fatalf("expected the offloaded transcript matched, got %+v", hits)
+	}
+}
@@ -1,8 +1,10 @@
 package db
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -14,13 +16,18 @@ import (
 
 	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
 	entepisode "github.com/eslsoft/lession/internal/adapter/db/ent/generated/episode"
+	entepisodetranscript "github.com/eslsoft/lession/internal/adapter/db/ent/generated/episodetranscript"
 	entseries "github.com/eslsoft/lession/internal/adapter/db/ent/generated/series"
+	entschema "github.com/eslsoft/lession/internal/adapter/db/ent/schema"
 	"github.com/eslsoft/lession/internal/core"
 )
 
 // SeriesRepository persists series and episodes using Ent.
 type SeriesRepository struct {
 	client *entgenerated.Client
+	// transcriptOffloadBytes is the size above which transcripts are stored
+	// in the episode_transcripts table; zero keeps them all inline.
+	transcriptOffloadBytes int
 }
 
 // NewSeriesRepository constructs an Ent-backed series repository.
@@ -28,6 +35,13 @@ func NewSeriesRepository(client *entgenerated.Client) *SeriesRepository {
 	return &SeriesRepository{client: client}
 }
 
+// WithTranscriptOffload stores transcripts larger than maxInlineBytes apart
+// from the episodes table, so that queries leaving transcripts out do not
+// carry them. Zero keeps every transcript inline.
+func (r *SeriesRepository) WithTranscriptOffload(maxInlineBytes int) {
+	r.transcriptOffloadBytes = max(maxInlineBytes, 0)
+}
+
 var _ core.SeriesRepository = (*SeriesRepository)(nil)
 
 // ListSeries retrieves series matching the supplied filter.
@@ -59,6 +73,10 @@ func (r *SeriesRepository) ListSeries(ctx context.Context, filter core.SeriesLis
 		q = q.Where(entseries.LevelEQ(filter.Level))
 	}
 
+	if filter.EstimatedLevel != "" {
+		q = q.Where(entseries.EstimatedLevelEQ(filter.EstimatedLevel))
+	}
+
 	if len(filter.AuthorIDs) > 0 {
 		q = q.Where(func(s *sql.Selector) {
 			ors := lo.Map(filter.AuthorIDs, func(authorID string, _ int) *sql.Predicate {
@@ -87,10 +105,7 @@ func (r *SeriesRepository) ListSeries(ctx context.Context, filter core.SeriesLis
 	}
 
 	if filter.IncludeEpisodes {
-		q = q.WithEpisodes(func(eq *entgenerated.EpisodeQuery) {
-			eq.Where(entepisode.DeletedAtIsNil()).
-				Order(entepisode.BySeq())
-		})
+		q = q.WithEpisodes(episodeLoader(filter.OmitTranscripts))
 	}
 
 	rows, err := q.
@@ -115,13 +130,18 @@ func (r *SeriesRepository) ListSeries(ctx context.Context, filter core.SeriesLis
 	return series, nextToken, nil
 }
 
-// CreateSeries persists a new series with optional initial episodes.
-func (r *SeriesRepository) CreateSeries(ctx context.Context, series core.Series) (*core.Series, error) {
+// CreateSeries persists a new series with optional initial episodes, recording
+// events in the outbox in the same transaction. The created series is built
+// from the rows the transaction wrote rather than read back.
+func (r *SeriesRepository) CreateSeries(ctx context.Context, series core.Series, events ...core.Event) (*core.Series, error) {
 	tx, err := r.client.Tx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	episodeCount := lo.CountBy(series.Episodes, func(episode core.Episode) bool {
+		return episode.DeletedAt == nil
+	})
 	builder := tx.Series.Create().
 		SetID(series.ID).
 		SetSlug(series.Slug).
@@ -131,9 +151,13 @@ func (r *SeriesRepository) CreateSeries(ctx context.Context, series core.Series)
 		SetLevel(series.Level).
 		SetStatus(int(series.Status)).
 		SetCoverURL(series.CoverURL).
-		SetEpisodeCount(series.EpisodeCount).
-		SetCreatedAt(series.CreatedAt).
-		SetUpdatedAt(series.UpdatedAt).
+		SetNillableCoverAssetID(lo.EmptyableToPtr(series.CoverAssetID)).
+		SetDrmEnabled(series.DRMEnabled).
+		SetAccentColor(series.Branding.AccentColor).
+		SetNillableHeroAssetID(lo.EmptyableToPtr(series.Branding.HeroAssetID)).
+		SetHeroImageURL(series.Branding.HeroImageURL).
+		SetLayout(int(series.Branding.Layout)).
+		SetEpisodeCount(episodeCount).
 		SetAuthorIds(series.AuthorIDs)
 
 	if len(series.Tags) > 0 {
@@ -146,19 +170,28 @@ func (r *SeriesRepository) CreateSeries(ctx context.Context, series core.Series)
 		builder.SetPublishedAt(*series.PublishedAt)
 	}
 
-	if _, err := builder.Save(ctx); err != nil {
+	row, err := builder.Save(ctx)
+	if err != nil {
 		_ = tx.Rollback()
+		if entgenerated.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: series slug %q is taken", core.ErrAlreadyExists, series.Slug)
+		}
 		return nil, err
 	}
 
 	for _, episode := range series.Episodes {
-		if err := saveEpisodeFromDomain(ctx, tx.Episode.Create(), series.ID, episode); err != nil {
+		episodeRow, err := r.saveEpisodeFromDomain(ctx, tx, series.ID, episode)
+		if err != nil {
 			_ = tx.Rollback()
 			return nil, err
 		}
+		if episodeRow.DeletedAt == nil {
+			row.Edges.Episodes = append(row.Edges.Episodes, episodeRow)
+		}
 	}
 
-	if err := recalcSeriesEpisodeCount(ctx, tx.Episode, tx.Series, series.ID); err != nil {
+	stampEvents(events, row.ID, row.CreatedAt, row.UpdatedAt)
+	if err := writeOutbox(ctx, tx, row.UpdatedAt, events); err != nil {
 		_ = tx.Rollback()
 		return nil, err
 	}
@@ -167,10 +200,11 @@ func (r *SeriesRepository) CreateSeries(ctx context.Context, series core.Series)
 		return nil, err
 	}
 
-	return r.GetSeries(ctx, series.ID, core.SeriesQueryOptions{
-		IncludeEpisodes: len(series.Episodes) > 0,
-		IncludeMetadata: true,
+	// Match GetSeries, which lists live episodes in sequence order.
+	slices.SortStableFunc(row.Edges.Episodes, func(a, b *entgenerated.Episode) int {
+		return cmp.Compare(a.Seq, b.Seq)
 	})
+	return toDomainSeries(row, len(series.Episodes) > 0), nil
 }
 
 // GetSeries fetches a series by id with optional expansions.
@@ -187,9 +221,30 @@ func (r *SeriesRepository) GetSeries(ctx context.Context, id uuid.UUID, opts cor
 	return toDomainSeries(row, opts.IncludeEpisodes), nil
 }
 
-// UpdateSeries mutates an existing series record.
-func (r *SeriesRepository) UpdateSeries(ctx context.Context, series core.Series) (*core.Series, error) {
-	builder := r.client.Series.UpdateOneID(series.ID).
+// GetSeriesBySlug fetches a series by its slug with optional expansions.
+func (r *SeriesRepository) GetSeriesBySlug(ctx context.Context, slug string, opts core.SeriesQueryOptions) (*core.Series, error) {
+	row, err := r.seriesQuery(opts).
+		Where(entseries.Slug(slug)).
+		Only(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainSeries(row, opts.IncludeEpisodes), nil
+}
+
+// UpdateSeries mutates an existing series record, recording events in the
+// outbox in the same transaction. The episode count is left alone; episode
+// writes maintain it.
+func (r *SeriesRepository) UpdateSeries(ctx context.Context, series core.Series, events ...core.Event) (*core.Series, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := tx.Series.UpdateOneID(series.ID).
 		SetSlug(series.Slug).
 		SetTitle(series.Title).
 		SetSummary(series.Summary).
@@ -197,8 +252,10 @@ func (r *SeriesRepository) UpdateSeries(ctx context.Context, series core.Series)
 		SetLevel(series.Level).
 		SetStatus(int(series.Status)).
 		SetCoverURL(series.CoverURL).
-		SetEpisodeCount(series.EpisodeCount).
-		SetUpdatedAt(series.UpdatedAt).
+		SetDrmEnabled(series.DRMEnabled).
+		SetAccentColor(series.Branding.AccentColor).
+		SetHeroImageURL(series.Branding.HeroImageURL).
+		SetLayout(int(series.Branding.Layout)).
 		SetAuthorIds(series.AuthorIDs)
 
 	if len(series.Tags) > 0 {
@@ -213,29 +270,60 @@ func (r *SeriesRepository) UpdateSeries(ctx context.Context, series core.Series)
 		builder.ClearPublishedAt()
 	}
 
+	if series.CoverAssetID != uuid.Nil {
+		builder.SetCoverAssetID(series.CoverAssetID)
+	} else {
+		builder.ClearCoverAssetID()
+	}
+
+	if series.Branding.HeroAssetID != uuid.Nil {
+		builder.SetHeroAssetID(series.Branding.HeroAssetID)
+	} else {
+		builder.ClearHeroAssetID()
+	}
+
 	row, err := builder.Save(ctx)
 	if err != nil {
+		_ = tx.Rollback()
 		if entgenerated.IsNotFound(err) {
 			return nil, core.ErrNotFound
 		}
 		return nil, err
 	}
+
+	stampEvents(events, row.ID, row.CreatedAt, row.UpdatedAt)
+	if err := writeOutbox(ctx, tx, row.UpdatedAt, events); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 	return toDomainSeries(row, false), nil
 }
 
-// CreateEpisode inserts a new episode for a series.
-func (r *SeriesRepository) CreateEpisode(ctx context.Context, episode core.Episode) (*core.Episode, error) {
+// CreateEpisode inserts a new episode for a series, recording events in the
+// outbox in the same transaction.
+func (r *SeriesRepository) CreateEpisode(ctx context.Context, episode core.Episode, events ...core.Event) (*core.Episode, error) {
 	tx, err := r.client.Tx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := saveEpisodeFromDomain(ctx, tx.Episode.Create(), episode.SeriesID, episode); err != nil {
+	row, err := r.saveEpisodeFromDomain(ctx, tx, episode.SeriesID, episode)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := adjustSeriesEpisodeCount(ctx, tx.Series, episode.SeriesID, lo.Ternary(episode.DeletedAt == nil, 1, 0)); err != nil {
 		_ = tx.Rollback()
 		return nil, err
 	}
 
-	if err := recalcSeriesEpisodeCount(ctx, tx.Episode, tx.Series, episode.SeriesID); err != nil {
+	stampEvents(events, row.ID, row.CreatedAt, row.UpdatedAt)
+	if err := writeOutbox(ctx, tx, row.UpdatedAt, events); err != nil {
 		_ = tx.Rollback()
 		return nil, err
 	}
@@ -244,12 +332,16 @@ func (r *SeriesRepository) CreateEpisode(ctx context.Context, episode core.Episo
 		return nil, err
 	}
 
-	return r.GetEpisode(ctx, episode.ID)
+	// The episode may have been created deleted.
+	return r.GetEpisode(entschema.IncludeDeleted(core.NewPrimaryReadContext(ctx)), episode.ID)
 }
 
-// GetEpisode fetches an episode by id.
+// GetEpisode fetches an episode by id. Deleted episodes are not found.
 func (r *SeriesRepository) GetEpisode(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
-	row, err := r.client.Episode.Get(ctx, id)
+	row, err := r.client.Episode.Query().
+		Where(entepisode.IDEQ(id)).
+		WithTranscript().
+		Only(ctx)
 	if err != nil {
 		if entgenerated.IsNotFound(err) {
 			return nil, core.ErrNotFound
@@ -259,31 +351,72 @@ func (r *SeriesRepository) GetEpisode(ctx context.Context, id uuid.UUID) (*core.
 	return toDomainEpisode(row), nil
 }
 
-// UpdateEpisode mutates an existing episode.
-func (r *SeriesRepository) UpdateEpisode(ctx context.Context, episode core.Episode) (*core.Episode, error) {
-	row, err := applyEpisodeUpdate(r.client.Episode.UpdateOneID(episode.ID), episode).Save(ctx)
+// UpdateEpisode mutates an existing episode, recording events in the outbox
+// in the same transaction.
+func (r *SeriesRepository) UpdateEpisode(ctx context.Context, episode core.Episode, events ...core.Event) (*core.Episode, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Updating a deleted episode restores it unless DeletedAt is kept.
+	existing, err := tx.Episode.Query().
+		Where(entepisode.IDEQ(episode.ID)).
+		Select(entepisode.FieldSeriesID, entepisode.FieldDeletedAt, entepisode.FieldTranscriptOffloaded).
+		Only(entschema.IncludeDeleted(ctx))
 	if err != nil {
+		_ = tx.Rollback()
 		if entgenerated.IsNotFound(err) {
 			return nil, core.ErrNotFound
 		}
 		return nil, err
 	}
 
-	if err := r.updateSeriesCountIfNeeded(ctx, episode.SeriesID); err != nil {
+	offloaded := r.offloadsTranscript(episode.Transcript.Content)
+	row, err := applyEpisodeUpdate(tx.Episode.UpdateOneID(episode.ID), episode, offloaded).Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	if offloaded || existing.TranscriptOffloaded {
+		if err := saveOffloadedTranscript(ctx, tx, row, episode.Transcript.Content); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+
+	// Deleting or restoring an episode through an update changes the count.
+	delta := lo.Ternary(row.DeletedAt == nil, 1, 0) - lo.Ternary(existing.DeletedAt == nil, 1, 0)
+	if err := adjustSeriesEpisodeCount(ctx, tx.Series, existing.SeriesID, delta); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	stampEvents(events, row.ID, row.CreatedAt, row.UpdatedAt)
+	if err := writeOutbox(ctx, tx, row.UpdatedAt, events); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
 	return toDomainEpisode(row), nil
 }
 
-// DeleteEpisode performs a soft delete on an episode.
-func (r *SeriesRepository) DeleteEpisode(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+// DeleteEpisode performs a soft delete on an episode. Events are recorded in
+// the outbox only when the episode was not already deleted.
+func (r *SeriesRepository) DeleteEpisode(ctx context.Context, id uuid.UUID, events ...core.Event) (*core.Episode, error) {
 	tx, err := r.client.Tx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	existing, err := tx.Episode.Get(ctx, id)
+	existing, err := tx.Episode.Get(entschema.IncludeDeleted(ctx), id)
 	if err != nil {
 		_ = tx.Rollback()
 		if entgenerated.IsNotFound(err) {
@@ -293,7 +426,11 @@ func (r *SeriesRepository) DeleteEpisode(ctx context.Context, id uuid.UUID) (*co
 	}
 
 	if existing.DeletedAt != nil {
+		err := loadOffloadedTranscript(ctx, existing)
 		_ = tx.Rollback()
+		if err != nil {
+			return nil, err
+		}
 		return toDomainEpisode(existing), nil
 	}
 
@@ -301,7 +438,6 @@ func (r *SeriesRepository) DeleteEpisode(ctx context.Context, id uuid.UUID) (*co
 	row, err := tx.Episode.UpdateOneID(id).
 		SetStatus(int(core.EpisodeStatusArchived)).
 		SetDeletedAt(now).
-		SetUpdatedAt(now).
 		Save(ctx)
 	if err != nil {
 		_ = tx.Rollback()
@@ -311,7 +447,18 @@ func (r *SeriesRepository) DeleteEpisode(ctx context.Context, id uuid.UUID) (*co
 		return nil, err
 	}
 
-	if err := recalcSeriesEpisodeCount(ctx, tx.Episode, tx.Series, existing.SeriesID); err != nil {
+	if err := adjustSeriesEpisodeCount(ctx, tx.Series, existing.SeriesID, -1); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := loadOffloadedTranscript(ctx, row); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	stampEvents(events, row.ID, row.CreatedAt, row.UpdatedAt)
+	if err := writeOutbox(ctx, tx, now, events); err != nil {
 		_ = tx.Rollback()
 		return nil, err
 	}
@@ -323,52 +470,354 @@ func (r *SeriesRepository) DeleteEpisode(ctx context.Context, id uuid.UUID) (*co
 	return toDomainEpisode(row), nil
 }
 
+// ReconcileEpisodeCounts recounts the live episodes of every series and
+// corrects the counts that drifted. A count changed by a concurrent write
+// since it was read is left for the next run.
+func (r *SeriesRepository) ReconcileEpisodeCounts(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := r.client.Series.Query().
+		Select(entseries.FieldID, entseries.FieldEpisodeCount).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type seriesEpisodeCount struct {
+		SeriesID uuid.UUID `json:"series_id"`
+		Count    int       `json:"count"`
+	}
+	var counts []seriesEpisodeCount
+	if err := r.client.Episode.Query().
+		GroupBy(entepisode.FieldSeriesID).
+		Aggregate(entgenerated.Count()).
+		Scan(ctx, &counts); err != nil {
+		return nil, err
+	}
+	actual := lo.SliceToMap(counts, func(count seriesEpisodeCount) (uuid.UUID, int) {
+		return count.SeriesID, count.Count
+	})
+
+	var corrected []uuid.UUID
+	for _, row := range rows {
+		count := actual[row.ID]
+		if count == row.EpisodeCount {
+			continue
+		}
+		affected, err := r.client.Series.Update().
+			Where(entseries.IDEQ(row.ID), entseries.EpisodeCountEQ(row.EpisodeCount)).
+			SetEpisodeCount(count).
+			Save(ctx)
+		if err != nil {
+			return corrected, err
+		}
+		if affected > 0 {
+			corrected = append(corrected, row.ID)
+		}
+	}
+	return corrected, nil
+}
+
+// SetEpisodeEstimatedLevel stores the estimated level of an episode and, in
+// the same transaction, the summary of its live episodes' levels on the
+// series. Only the estimate columns are written, so it cannot undo an edit
+// made since the episode was read.
+func (r *SeriesRepository) SetEpisodeEstimatedLevel(ctx context.Context, id uuid.UUID, level string) (*core.Episode, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := tx.Episode.UpdateOneID(id).
+		SetEstimatedLevel(level).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+
+	levels, err := tx.Episode.Query().
+		Where(entepisode.SeriesID(row.SeriesID)).
+		Select(entepisode.FieldEstimatedLevel).
+		Strings(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Series.UpdateOneID(row.SeriesID).
+		SetEstimatedLevel(core.SeriesEstimatedLevel(levels)).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := loadOffloadedTranscript(ctx, row); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toDomainEpisode(row), nil
+}
+
+// ListEpisodesByAsset returns the episodes whose resource is the asset.
+// Deleted episodes are left out.
+func (r *SeriesRepository) ListEpisodesByAsset(ctx context.Context, assetID uuid.UUID) ([]core.Episode, error) {
+	rows, err := r.client.Episode.Query().
+		Where(entepisode.ResourceAssetID(assetID)).
+		WithTranscript().
+		Order(entepisode.ByCreatedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(row *entgenerated.Episode, _ int) core.Episode {
+		return *toDomainEpisode(row)
+	}), nil
+}
+
+// ReplaceTags rewrites the tags of every series carrying any source tag in a
+// single transaction, recording a SeriesUpdated event for each in the outbox.
+func (r *SeriesRepository) ReplaceTags(ctx context.Context, replacement core.TagReplacement) ([]uuid.UUID, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Series.Query().
+		Where(func(s *sql.Selector) {
+			ors := lo.Map(replacement.Sources, func(tag string, _ int) *sql.Predicate {
+				return sqljson.ValueContains(entseries.FieldTags, tag)
+			})
+			s.Where(sql.Or(ors...))
+		}).
+		Select(entseries.FieldID, entseries.FieldTags).
+		All(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	affected := make([]uuid.UUID, 0, len(rows))
+	events := make([]core.Event, 0, len(rows))
+	for _, row := range rows {
+		tags := lo.Uniq(lo.Map(row.Tags, func(tag string, _ int) string {
+			return lo.Ternary(lo.Contains(replacement.Sources, tag), replacement.Target, tag)
+		}))
+		updated, err := tx.Series.UpdateOneID(row.ID).
+			SetTags(tags).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		affected = append(affected, row.ID)
+		events = append(events, core.SeriesUpdated{Series: *toDomainSeries(updated, false)})
+	}
+
+	if err := writeOutbox(ctx, tx, time.Now().UTC(), events); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return affected, nil
+}
+
+// ReassignAuthor moves series ownership between authors and records the
+// transfer, along with a SeriesUpdated event for each moved series, in one
+// transaction.
+func (r *SeriesRepository) ReassignAuthor(ctx context.Context, reassignment core.ContentReassignment) (*core.ContentReassignment, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Series.Query().
+		Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(entseries.FieldAuthorIds, reassignment.FromAuthorID))
+		}).
+		Select(entseries.FieldID, entseries.FieldAuthorIds).
+		All(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	seriesIDs := make([]uuid.UUID, 0, len(rows))
+	events := make([]core.Event, 0, len(rows))
+	for _, row := range rows {
+		authorIDs := lo.Uniq(lo.Map(row.AuthorIds, func(id string, _ int) string {
+			return lo.Ternary(id == reassignment.FromAuthorID, reassignment.ToAuthorID, id)
+		}))
+		updated, err := tx.Series.UpdateOneID(row.ID).
+			SetAuthorIds(authorIDs).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		seriesIDs = append(seriesIDs, row.ID)
+		events = append(events, core.SeriesUpdated{Series: *toDomainSeries(updated, false)})
+	}
+
+	record, err := tx.ContentReassignment.Create().
+		SetID(reassignment.ID).
+		SetFromAuthorID(reassignment.FromAuthorID).
+		SetToAuthorID(reassignment.ToAuthorID).
+		SetSeriesIds(seriesIDs).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := writeOutbox(ctx, tx, record.CreatedAt, events); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &core.ContentReassignment{
+		ID:           record.ID,
+		FromAuthorID: record.FromAuthorID,
+		ToAuthorID:   record.ToAuthorID,
+		SeriesIDs:    record.SeriesIds,
+		CreatedAt:    record.CreatedAt,
+	}, nil
+}
+
 func (r *SeriesRepository) seriesQuery(opts core.SeriesQueryOptions) *entgenerated.SeriesQuery {
 	q := r.client.Series.Query()
 	if opts.IncludeEpisodes {
-		q = q.WithEpisodes(func(eq *entgenerated.EpisodeQuery) {
-			eq.Where(entepisode.DeletedAtIsNil()).
-				Order(entepisode.BySeq())
-		})
+		q = q.WithEpisodes(episodeLoader(opts.OmitTranscripts))
 	}
 	return q
 }
 
-func (r *SeriesRepository) updateSeriesCountIfNeeded(ctx context.Context, seriesID uuid.UUID) error {
-	if seriesID == uuid.Nil {
-		return nil
+// episodeLoader loads the episodes of a series in order, leaving out the
+// transcript columns, which can be large, when omitTranscripts is set.
+// Offloaded transcripts are only read when transcripts are wanted.
+func episodeLoader(omitTranscripts bool) func(*entgenerated.EpisodeQuery) {
+	return func(eq *entgenerated.EpisodeQuery) {
+		eq.Order(entepisode.BySeq())
+		if omitTranscripts {
+			eq.Select(lo.Without(entepisode.Columns,
+				entepisode.FieldTranscriptLanguage,
+				entepisode.FieldTranscriptFormat,
+				entepisode.FieldTranscriptContent,
+				entepisode.FieldTranscriptFindings,
+			)...)
+		} else {
+			eq.WithTranscript()
+		}
 	}
-	return recalcSeriesEpisodeCount(ctx, r.client.Episode, r.client.Series, seriesID)
 }
 
-func saveEpisodeFromDomain(ctx context.Context, builder *entgenerated.EpisodeCreate, seriesID uuid.UUID, episode core.Episode) error {
-	builder = builder.
+func (r *SeriesRepository) saveEpisodeFromDomain(ctx context.Context, tx *entgenerated.Tx, seriesID uuid.UUID, episode core.Episode) (*entgenerated.Episode, error) {
+	offloaded := r.offloadsTranscript(episode.Transcript.Content)
+	builder := tx.Episode.Create().
 		SetID(episode.ID).
 		SetSeriesID(seriesID)
-	builder = applyEpisodeCreate(builder, episode)
+	builder = applyEpisodeCreate(builder, episode, offloaded)
 
-	_, err := builder.Save(ctx)
+	row, err := builder.Save(ctx)
 	if entgenerated.IsNotFound(err) {
-		return core.ErrNotFound
+		return nil, core.ErrNotFound
 	}
-	return err
+	if err != nil || !offloaded {
+		return row, err
+	}
+	if err := saveOffloadedTranscript(ctx, tx, row, episode.Transcript.Content); err != nil {
+		return nil, err
+	}
+	return row, nil
 }
 
-func applyEpisodeCreate(builder *entgenerated.EpisodeCreate, episode core.Episode) *entgenerated.EpisodeCreate {
+// offloadsTranscript reports whether a transcript is stored apart from its
+// episode.
+func (r *SeriesRepository) offloadsTranscript(content string) bool {
+	return r.transcriptOffloadBytes > 0 && len(content) > r.transcriptOffloadBytes
+}
+
+// saveOffloadedTranscript writes the transcript of a saved episode row to
+// episode_transcripts, or removes it from there once the transcript is
+// stored inline again.
+func saveOffloadedTranscript(ctx context.Context, tx *entgenerated.Tx, row *entgenerated.Episode, content string) error {
+	if !row.TranscriptOffloaded {
+		_, err := tx.EpisodeTranscript.Delete().
+			Where(entepisodetranscript.EpisodeID(row.ID)).
+			Exec(ctx)
+		return err
+	}
+
+	updated, err := tx.EpisodeTranscript.Update().
+		Where(entepisodetranscript.EpisodeID(row.ID)).
+		SetContent(content).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		if err := tx.EpisodeTranscript.Create().SetEpisodeID(row.ID).SetContent(content).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	row.Edges.Transcript = &entgenerated.EpisodeTranscript{EpisodeID: row.ID, Content: content}
+	return nil
+}
+
+// loadOffloadedTranscript reads the offloaded transcript of an episode row
+// that was queried or saved without it.
+func loadOffloadedTranscript(ctx context.Context, row *entgenerated.Episode) error {
+	if !row.TranscriptOffloaded || row.Edges.Transcript != nil {
+		return nil
+	}
+	transcript, err := row.QueryTranscript().Only(ctx)
+	if err != nil {
+		return err
+	}
+	row.Edges.Transcript = transcript
+	return nil
+}
+
+// transcriptContent returns the transcript of an episode row, wherever it is
+// stored.
+func transcriptContent(row *entgenerated.Episode) string {
+	if row.Edges.Transcript != nil {
+		return row.Edges.Transcript.Content
+	}
+	return row.TranscriptContent
+}
+
+func applyEpisodeCreate(builder *entgenerated.EpisodeCreate, episode core.Episode, offloaded bool) *entgenerated.EpisodeCreate {
 	builder = builder.
 		SetSeq(episode.Seq).
 		SetTitle(episode.Title).
 		SetDescription(episode.Description).
 		SetDurationSeconds(int(episode.Duration / time.Second)).
+		SetDurationFromAsset(episode.DurationFromAsset).
 		SetStatus(int(episode.Status)).
+		SetPreview(episode.Preview).
 		SetResourceType(int(episode.Resource.Type)).
 		SetResourcePlaybackURL(episode.Resource.PlaybackURL).
 		SetResourceMimeType(episode.Resource.MimeType).
 		SetTranscriptLanguage(episode.Transcript.Language).
 		SetTranscriptFormat(int(episode.Transcript.Format)).
-		SetTranscriptContent(episode.Transcript.Content).
-		SetCreatedAt(episode.CreatedAt).
-		SetUpdatedAt(episode.UpdatedAt)
+		SetTranscriptContent(lo.Ternary(offloaded, "", episode.Transcript.Content)).
+		SetTranscriptOffloaded(offloaded)
+
+	if len(episode.Transcript.Findings) > 0 {
+		builder.SetTranscriptFindings(episode.Transcript.Findings)
+	}
 
 	if episode.Resource.AssetID != uuid.Nil {
 		builder.SetResourceAssetID(episode.Resource.AssetID)
@@ -385,20 +834,28 @@ func applyEpisodeCreate(builder *entgenerated.EpisodeCreate, episode core.Episod
 	return builder
 }
 
-func applyEpisodeUpdate(builder *entgenerated.EpisodeUpdateOne, episode core.Episode) *entgenerated.EpisodeUpdateOne {
+func applyEpisodeUpdate(builder *entgenerated.EpisodeUpdateOne, episode core.Episode, offloaded bool) *entgenerated.EpisodeUpdateOne {
 	builder = builder.
 		SetSeq(episode.Seq).
 		SetTitle(episode.Title).
 		SetDescription(episode.Description).
 		SetDurationSeconds(int(episode.Duration / time.Second)).
+		SetDurationFromAsset(episode.DurationFromAsset).
 		SetStatus(int(episode.Status)).
+		SetPreview(episode.Preview).
 		SetResourceType(int(episode.Resource.Type)).
 		SetResourcePlaybackURL(episode.Resource.PlaybackURL).
 		SetResourceMimeType(episode.Resource.MimeType).
 		SetTranscriptLanguage(episode.Transcript.Language).
 		SetTranscriptFormat(int(episode.Transcript.Format)).
-		SetTranscriptContent(episode.Transcript.Content).
-		SetUpdatedAt(episode.UpdatedAt)
+		SetTranscriptContent(lo.Ternary(offloaded, "", episode.Transcript.Content)).
+		SetTranscriptOffloaded(offloaded)
+
+	if len(episode.Transcript.Findings) > 0 {
+		builder.SetTranscriptFindings(episode.Transcript.Findings)
+	} else {
+		builder.ClearTranscriptFindings()
+	}
 
 	if episode.Resource.AssetID != uuid.Nil {
 		builder.SetResourceAssetID(episode.Resource.AssetID)
@@ -421,21 +878,17 @@ func applyEpisodeUpdate(builder *entgenerated.EpisodeUpdateOne, episode core.Epi
 	return builder
 }
 
-func recalcSeriesEpisodeCount(ctx context.Context, episodeClient *entgenerated.EpisodeClient, seriesClient *entgenerated.SeriesClient, seriesID uuid.UUID) error {
-	count, err := episodeClient.Query().
-		Where(
-			entepisode.SeriesIDEQ(seriesID),
-			entepisode.DeletedAtIsNil(),
-		).
-		Count(ctx)
-	if err != nil {
-		return err
-	}
-
-	return seriesClient.UpdateOneID(seriesID).
-		SetEpisodeCount(count).
-		SetUpdatedAt(time.Now().UTC()).
+// adjustSeriesEpisodeCount adds delta to the episode count of a series in a
+// single UPDATE, which also marks the series as updated. Concurrent writers
+// cannot lose each other's changes; ReconcileEpisodeCounts repairs any drift.
+func adjustSeriesEpisodeCount(ctx context.Context, seriesClient *entgenerated.SeriesClient, seriesID uuid.UUID, delta int) error {
+	err := seriesClient.UpdateOneID(seriesID).
+		AddEpisodeCount(delta).
 		Exec(ctx)
+	if entgenerated.IsNotFound(err) {
+		return core.ErrNotFound
+	}
+	return err
 }
 
 func toDomainSeries(row *entgenerated.Series, includeEpisodes bool) *core.Series {
@@ -447,19 +900,28 @@ func toDomainSeries(row *entgenerated.Series, includeEpisodes bool) *core.Series
 	authorIDs := lo.Map(row.AuthorIds, func(id string, _ int) string { return id })
 
 	series := &core.Series{
-		ID:           row.ID,
-		Slug:         row.Slug,
-		Title:        row.Title,
-		Summary:      row.Summary,
-		Language:     row.Language,
-		Level:        row.Level,
-		Tags:         lo.Ternary(len(tags) > 0, tags, []string(nil)),
-		CoverURL:     row.CoverURL,
-		Status:       core.SeriesStatus(row.Status),
-		EpisodeCount: row.EpisodeCount,
-		CreatedAt:    row.CreatedAt,
-		UpdatedAt:    row.UpdatedAt,
-		AuthorIDs:    lo.Ternary(len(authorIDs) > 0, authorIDs, []string(nil)),
+		ID:             row.ID,
+		Slug:           row.Slug,
+		Title:          row.Title,
+		Summary:        row.Summary,
+		Language:       row.Language,
+		Level:          row.Level,
+		EstimatedLevel: row.EstimatedLevel,
+		Tags:           lo.Ternary(len(tags) > 0, tags, []string(nil)),
+		CoverURL:       row.CoverURL,
+		CoverAssetID:   lo.FromPtr(row.CoverAssetID),
+		Status:         core.SeriesStatus(row.Status),
+		DRMEnabled:     row.DrmEnabled,
+		EpisodeCount:   row.EpisodeCount,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+		AuthorIDs:      lo.Ternary(len(authorIDs) > 0, authorIDs, []string(nil)),
+		Branding: core.SeriesBranding{
+			AccentColor:  row.AccentColor,
+			HeroAssetID:  lo.FromPtr(row.HeroAssetID),
+			HeroImageURL: row.HeroImageURL,
+			Layout:       core.SeriesLayout(row.Layout),
+		},
 	}
 
 	if row.PublishedAt != nil {
@@ -482,13 +944,15 @@ func toDomainEpisode(row *entgenerated.Episode) *core.Episode {
 	}
 
 	episode := &core.Episode{
-		ID:          row.ID,
-		SeriesID:    row.SeriesID,
-		Seq:         row.Seq,
-		Title:       row.Title,
-		Description: row.Description,
-		Duration:    time.Duration(row.DurationSeconds) * time.Second,
-		Status:      core.EpisodeStatus(row.Status),
+		ID:                row.ID,
+		SeriesID:          row.SeriesID,
+		Seq:               row.Seq,
+		Title:             row.Title,
+		Description:       row.Description,
+		Duration:          time.Duration(row.DurationSeconds) * time.Second,
+		DurationFromAsset: row.DurationFromAsset,
+		Status:            core.EpisodeStatus(row.Status),
+		Preview:           row.Preview,
 		Resource: core.MediaResource{
 			Type:        core.MediaType(row.ResourceType),
 			PlaybackURL: row.ResourcePlaybackURL,
@@ -497,10 +961,12 @@ func toDomainEpisode(row *entgenerated.Episode) *core.Episode {
 		Transcript: core.Transcript{
 			Language: row.TranscriptLanguage,
 			Format:   core.TranscriptFormat(row.TranscriptFormat),
-			Content:  row.TranscriptContent,
+			Content:  transcriptContent(row),
+			Findings: row.TranscriptFindings,
 		},
-		CreatedAt: row.CreatedAt,
-		UpdatedAt: row.UpdatedAt,
+		EstimatedLevel: row.EstimatedLevel,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
 	}
 
 	if row.ResourceAssetID != nil {
@@ -2,17 +2,15 @@ package db
 
 import (
 	"context"
-	stdsql "database/sql"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
-	"entgo.io/ent/dialect"
-	entsql "entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
-	_ "modernc.org/sqlite"
 
 	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
-	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/enttest"
+	entschema "github.com/eslsoft/lession/internal/adapter/db/ent/schema"
 	"github.com/eslsoft/lession/internal/core"
 )
 
@@ -20,8 +18,8 @@ func TestSeriesRepository_CreateAndGetSeries(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
-	repo, client := setupSeriesRepo(t, ctx)
-	defer client.Close()
+	client := newTestClient(t, "series_repo")
+	repo := NewSeriesRepository(client)
 
 	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
 	seriesID := uuid.New()
@@ -38,8 +36,6 @@ func TestSeriesRepository_CreateAndGetSeries(t *testing.T) {
 		CoverURL:     "https://cdn.local/cover.png",
 		Status:       core.SeriesStatusPublished,
 		EpisodeCount: 1,
-		CreatedAt:    now,
-		UpdatedAt:    now,
 		PublishedAt:  &now,
 		AuthorIDs:    []string{"author-1"},
 		Episodes: []core.Episode{
@@ -56,8 +52,6 @@ func TestSeriesRepository_CreateAndGetSeries(t *testing.T) {
 					Format:   core.TranscriptFormatPlain,
 					Content:  "Hello world",
 				},
-				CreatedAt:   now,
-				UpdatedAt:   now,
 				PublishedAt: &now,
 			},
 		},
@@ -96,16 +90,38 @@ func TestSeriesRepository_CreateAndGetSeries(t *testing.T) {
 	if got.Episodes[0].Resource.PlaybackURL != "https://cdn.local/audio.mp3" {
 		t.Fatalf("unexpected playback url %q", got.Episodes[0].Resource.PlaybackURL)
 	}
+	if created.Episodes[0].Resource != got.Episodes[0].Resource || created.Episodes[0].Transcript.Content != got.Episodes[0].Transcript.Content {
+		t.Fatalf("expected the created series to match a read, got %+v, want %+v", created.Episodes[0], got.Episodes[0])
+	}
+
+	lean, err := repo.GetSeries(ctx, seriesID, core.SeriesQueryOptions{IncludeEpisodes: true, OmitTranscripts: true})
+	if err != nil {
+		t.Fatalf("GetSeries() without transcripts error = %v", err)
+	}
+	if len(lean.Episodes) != 1 || lean.Episodes[0].Title != "Episode 1" || lean.Episodes[0].Transcript.Content != "" {
+		t.Fatalf("expected the episodes without transcripts, got %+v", lean.Episodes)
+	}
+
+	bySlug, err := repo.GetSeriesBySlug(ctx, "intro-series", core.SeriesQueryOptions{})
+	if err != nil || bySlug.ID != seriesID {
+		t.Fatalf("GetSeriesBySlug() = %+v, %v", bySlug, err)
+	}
+	if _, err := repo.GetSeriesBySlug(ctx, "missing", core.SeriesQueryOptions{}); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for an unknown slug, got %v", err)
+	}
+
+	duplicate := core.Series{ID: uuid.New(), Slug: "intro-series", Title: "Again", Status: core.SeriesStatusDraft}
+	if _, err := repo.CreateSeries(ctx, duplicate); !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected ErrAlreadyExists for a taken slug, got %v", err)
+	}
 }
 
 func TestSeriesRepository_ListSeriesFilters(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
-	repo, client := setupSeriesRepo(t, ctx)
-	defer client.Close()
-
-	now := time.Date(2024, 2, 2, 10, 0, 0, 0, time.UTC)
+	client := newTestClient(t, "series_repo")
+	repo := NewSeriesRepository(client)
 
 	createSeriesForTest(t, repo, ctx, core.Series{
 		ID:           uuid.New(),
@@ -115,8 +131,6 @@ func TestSeriesRepository_ListSeriesFilters(t *testing.T) {
 		Level:        "beginner",
 		Tags:         []string{"english"},
 		Status:       core.SeriesStatusPublished,
-		CreatedAt:    now,
-		UpdatedAt:    now,
 		EpisodeCount: 0,
 	})
 
@@ -128,8 +142,6 @@ func TestSeriesRepository_ListSeriesFilters(t *testing.T) {
 		Level:        "beginner",
 		Tags:         []string{"chinese"},
 		Status:       core.SeriesStatusDraft,
-		CreatedAt:    now,
-		UpdatedAt:    now,
 		EpisodeCount: 0,
 	})
 
@@ -164,8 +176,8 @@ func TestSeriesRepository_EpisodeLifecycle(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
-	repo, client := setupSeriesRepo(t, ctx)
-	defer client.Close()
+	client := newTestClient(t, "series_repo")
+	repo := NewSeriesRepository(client)
 
 	now := time.Date(2024, 3, 3, 10, 0, 0, 0, time.UTC)
 	series := core.Series{
@@ -173,8 +185,6 @@ func TestSeriesRepository_EpisodeLifecycle(t *testing.T) {
 		Slug:         "series-one",
 		Title:        "Series One",
 		Status:       core.SeriesStatusDraft,
-		CreatedAt:    now,
-		UpdatedAt:    now,
 		EpisodeCount: 0,
 	}
 
@@ -184,14 +194,12 @@ func TestSeriesRepository_EpisodeLifecycle(t *testing.T) {
 
 	episodeID := uuid.New()
 	episode := core.Episode{
-		ID:        episodeID,
-		SeriesID:  series.ID,
-		Seq:       1,
-		Title:     "Episode 1",
-		Duration:  time.Minute,
-		Status:    core.EpisodeStatusDraft,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:       episodeID,
+		SeriesID: series.ID,
+		Seq:      1,
+		Title:    "Episode 1",
+		Duration: time.Minute,
+		Status:   core.EpisodeStatusDraft,
 	}
 
 	createdEpisode, err := repo.CreateEpisode(ctx, episode)
@@ -222,7 +230,8 @@ func TestSeriesRepository_EpisodeLifecycle(t *testing.T) {
 		Duration:    time.Minute * 2,
 		Status:      core.EpisodeStatusPublished,
 		PublishedAt: &updateTime,
-		UpdatedAt:   updateTime,
+		// The duration was copied from the resource asset.
+		DurationFromAsset: true,
 		Resource: core.MediaResource{
 			Type: core.MediaTypeVideo,
 		},
@@ -243,6 +252,9 @@ func TestSeriesRepository_EpisodeLifecycle(t *testing.T) {
 	if updatedEpisode.Status != core.EpisodeStatusPublished {
 		t.Fatalf("expected status published, got %v", updatedEpisode.Status)
 	}
+	if !updatedEpisode.DurationFromAsset {
+		t.Fatal("expected the duration to be marked as copied from the asset")
+	}
 	if updatedEpisode.PublishedAt == nil {
 		t.Fatalf("expected published at set")
 	}
@@ -267,18 +279,429 @@ func TestSeriesRepository_EpisodeLifecycle(t *testing.T) {
 	}
 }
 
-func setupSeriesRepo(t *testing.T, ctx context.Context) (*SeriesRepository, *entgenerated.Client) {
-	t.Helper()
-	drv, err := stdsql.Open("sqlite", "file:series_repo?mode=memory&_pragma=foreign_keys(1)")
+func TestSeriesRepository_OffloadsLargeTranscripts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newTestClient(t, "series_repo")
+	repo := NewSeriesRepository(client)
+	repo.WithTranscriptOffload(16)
+
+	long := strings.Repeat("Hello there. ", 4)
+	seriesID, short, large := uuid.New(), uuid.New(), uuid.New()
+	episode := func(id uuid.UUID, seq uint32, content string) core.Episode {
+		return core.Episode{
+			ID:         id,
+			SeriesID:   seriesID,
+			Seq:        seq,
+			Title:      "Episode",
+			Transcript: core.Transcript{Language: "en", Format: core.TranscriptFormatPlain, Content: content},
+		}
+	}
+	created, err := repo.CreateSeries(ctx, core.Series{
+		ID:       seriesID,
+		Slug:     "long-talks",
+		Title:    "Long talks",
+		Episodes: []core.Episode{episode(short, 1, "Hello."), episode(large, 2, long)},
+	})
+	if err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+	if created.Episodes[1].Transcript.Content != long {
+		t.Fatalf("created transcript = %q, want %q", created.Episodes[1].Transcript.Content, long)
+	}
+
+	row, err := client.Episode.Get(ctx, large)
+	if err != nil {
+		t.Fatalf("get episode row: %v", err)
+	}
+	if !row.TranscriptOffloaded || row.TranscriptContent != "" {
+		t.Fatalf("expected the large transcript offloaded, got offloaded=%v content=%q", row.TranscriptOffloaded, row.TranscriptContent)
+	}
+
+	got, err := repo.GetEpisode(ctx, large)
+	if err != nil {
+		t.Fatalf("GetEpisode() error = %v", err)
+	}
+	if got.Transcript.Content != long {
+		t.Fatalf("GetEpisode() transcript = %q, want %q", got.Transcript.Content, long)
+	}
+	withTranscripts, err := repo.GetSeries(ctx, seriesID, core.SeriesQueryOptions{IncludeEpisodes: true})
+	if err != nil {
+		t.Fatalf("GetSeries() error = %v", err)
+	}
+	if withTranscripts.Episodes[0].Transcript.Content != "Hello." || withTranscripts.Episodes[1].Transcript.Content != long {
+		t.Fatalf("expected both transcripts loaded, got %+v", withTranscripts.Episodes)
+	}
+	withoutTranscripts, err := repo.GetSeries(ctx, seriesID, core.SeriesQueryOptions{IncludeEpisodes: true, OmitTranscripts: true})
+	if err != nil {
+		t.Fatalf("GetSeries() error = %v", err)
+	}
+	if withoutTranscripts.Episodes[1].Transcript.Content != "" {
+		t.Fatalf("expected transcripts left out, got %q", withoutTranscripts.Episodes[1].Transcript.Content)
+	}
+
+	// Shortening the transcript moves it back inline.
+	got.Transcript.Content = "Hi."
+	if _, err := repo.UpdateEpisode(ctx, *got); err != nil {
+		t.Fatalf("UpdateEpisode() error = %v", err)
+	}
+	if n := client.EpisodeTranscript.Query().CountX(ctx); n != 0 {
+		t.Fatalf("expected the offloaded transcript removed, %d left", n)
+	}
+	got.Transcript.Content = long + long
+	updated, err := repo.UpdateEpisode(ctx, *got)
+	if err != nil {
+		t.Fatalf("UpdateEpisode() error = %v", err)
+	}
+	if updated.Transcript.Content != long+long {
+		t.Fatalf("updated transcript = %q, want %q", updated.Transcript.Content, long+long)
+	}
+
+	deleted, err := repo.DeleteEpisode(ctx, large)
+	if err != nil {
+		t.Fatalf("DeleteEpisode() error = %v", err)
+	}
+	if deleted.Transcript.Content != long+long {
+		t.Fatalf("deleted transcript = %q, want %q", deleted.Transcript.Content, long+long)
+	}
+}
+
+func TestSeriesRepository_EpisodeCountTracksRestoreAndReconciles(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newTestClient(t, "series_repo")
+	repo := NewSeriesRepository(client)
+
+	series := core.Series{ID: uuid.New(), Slug: "counted", Title: "Counted", Status: core.SeriesStatusDraft}
+	if _, err := repo.CreateSeries(ctx, series); err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+	episode := core.Episode{ID: uuid.New(), SeriesID: series.ID, Seq: 1, Title: "Episode 1", Status: core.EpisodeStatusDraft}
+	if _, err := repo.CreateEpisode(ctx, episode); err != nil {
+		t.Fatalf("CreateEpisode() error = %v", err)
+	}
+	if _, err := repo.DeleteEpisode(ctx, episode.ID); err != nil {
+		t.Fatalf("DeleteEpisode() error = %v", err)
+	}
+
+	countOf := func() int {
+		t.Helper()
+		got, err := repo.GetSeries(ctx, series.ID, core.SeriesQueryOptions{})
+		if err != nil {
+			t.Fatalf("GetSeries() error = %v", err)
+		}
+		return got.EpisodeCount
+	}
+
+	// Updating a deleted episode without DeletedAt restores it.
+	if _, err := repo.UpdateEpisode(ctx, episode); err != nil {
+		t.Fatalf("UpdateEpisode() error = %v", err)
+	}
+	if count := countOf(); count != 1 {
+		t.Fatalf("expected episode count 1 after restore, got %d", count)
+	}
+	if _, err := repo.UpdateEpisode(ctx, episode); err != nil {
+		t.Fatalf("UpdateEpisode() error = %v", err)
+	}
+	if count := countOf(); count != 1 {
+		t.Fatalf("expected an update of a live episode to keep the count, got %d", count)
+	}
+
+	// A series read before the restore carries a stale count, which updating
+	// the series must not write back.
+	stale := series
+	stale.Title = "Counted again"
+	if updated, err := repo.UpdateSeries(ctx, stale); err != nil || updated.EpisodeCount != 1 {
+		t.Fatalf("UpdateSeries() = %+v, %v", updated, err)
+	}
+	if count := countOf(); count != 1 {
+		t.Fatalf("expected a series update to keep the count, got %d", count)
+	}
+
+	if err := client.Series.UpdateOneID(series.ID).SetEpisodeCount(7).Exec(ctx); err != nil {
+		t.Fatalf("drift episode count: %v", err)
+	}
+	corrected, err := repo.ReconcileEpisodeCounts(ctx)
+	if err != nil {
+		t.Fatalf("ReconcileEpisodeCounts() error = %v", err)
+	}
+	if len(corrected) != 1 || corrected[0] != series.ID {
+		t.Fatalf("expected series %s corrected, got %v", series.ID, corrected)
+	}
+	if count := countOf(); count != 1 {
+		t.Fatalf("expected episode count 1 after reconciling, got %d", count)
+	}
+	if corrected, err := repo.ReconcileEpisodeCounts(ctx); err != nil || len(corrected) != 0 {
+		t.Fatalf("expected nothing left to correct, got %v, %v", corrected, err)
+	}
+}
+
+func TestSeriesRepository_SetEpisodeEstimatedLevel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newTestClient(t, "series_repo")
+	repo := NewSeriesRepository(client)
+
+	series := core.Series{ID: uuid.New(), Slug: "estimated", Title: "Estimated", Status: core.SeriesStatusDraft}
+	createSeriesForTest(t, repo, ctx, series)
+	createSeriesForTest(t, repo, ctx, core.Series{Slug: "unestimated", Title: "Unestimated", Status: core.SeriesStatusDraft})
+
+	var episodes []core.Episode
+	for seq, level := range []string{"A2", "B1", "C2"} {
+		episode := core.Episode{ID: uuid.New(), SeriesID: series.ID, Seq: uint32(seq + 1), Title: level, Status: core.EpisodeStatusDraft}
+		if _, err := repo.CreateEpisode(ctx, episode); err != nil {
+			t.Fatalf("CreateEpisode() error = %v", err)
+		}
+		if _, err := repo.SetEpisodeEstimatedLevel(ctx, episode.ID, level); err != nil {
+			t.Fatalf("SetEpisodeEstimatedLevel() error = %v", err)
+		}
+		episodes = append(episodes, episode)
+	}
+	if _, err := repo.DeleteEpisode(ctx, episodes[1].ID); err != nil {
+		t.Fatalf("DeleteEpisode() error = %v", err)
+	}
+	// The series is summarised from its live episodes only.
+	updated, err := repo.SetEpisodeEstimatedLevel(ctx, episodes[0].ID, "A2")
+	if err != nil {
+		t.Fatalf("SetEpisodeEstimatedLevel() error = %v", err)
+	}
+	if updated.EstimatedLevel != "A2" || updated.SeriesID != series.ID {
+		t.Fatalf("unexpected episode %+v", updated)
+	}
+
+	got, err := repo.GetSeries(ctx, series.ID, core.SeriesQueryOptions{})
+	if err != nil {
+		t.Fatalf("GetSeries() error = %v", err)
+	}
+	if got.EstimatedLevel != "C2" {
+		t.Fatalf("expected the series estimate C2, got %q", got.EstimatedLevel)
+	}
+
+	// Editing an episode leaves its estimate to the estimator.
+	if _, err := repo.UpdateEpisode(ctx, episodes[0]); err != nil {
+		t.Fatalf("UpdateEpisode() error = %v", err)
+	}
+	if episode, err := repo.GetEpisode(ctx, episodes[0].ID); err != nil || episode.EstimatedLevel != "A2" {
+		t.Fatalf("expected the estimate to survive an update, got %+v, %v", episode, err)
+	}
+
+	list, _, err := repo.ListSeries(ctx, core.SeriesListFilter{EstimatedLevel: "C2"})
+	if err != nil {
+		t.Fatalf("ListSeries() error = %v", err)
+	}
+	if len(list) != 1 || list[0].ID != series.ID {
+		t.Fatalf("expected only the estimated series, got %+v", list)
+	}
+
+	if _, err := repo.SetEpisodeEstimatedLevel(ctx, uuid.New(), "B1"); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestSeriesRepository_ReplaceTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newTestClient(t, "series_repo")
+	repo := NewSeriesRepository(client)
+
+	grammar := core.Series{ID: uuid.New(), Slug: "grammar", Title: "Grammar", Tags: []string{"grammer", "english"}, Status: core.SeriesStatusDraft}
+	listening := core.Series{ID: uuid.New(), Slug: "listening", Title: "Listening", Tags: []string{"listening"}, Status: core.SeriesStatusDraft}
+	createSeriesForTest(t, repo, ctx, grammar)
+	createSeriesForTest(t, repo, ctx, listening)
+
+	affected, err := repo.ReplaceTags(ctx, core.TagReplacement{
+		Sources: []string{"grammer", "listening"},
+		Target:  "english",
+	})
+	if err != nil {
+		t.Fatalf("ReplaceTags() error = %v", err)
+	}
+	if len(affected) != 2 {
+		t.Fatalf("expected 2 affected series, got %d", len(affected))
+	}
+
+	got, err := repo.GetSeries(ctx, grammar.ID, core.SeriesQueryOptions{})
+	if err != nil {
+		t.Fatalf("GetSeries() error = %v", err)
+	}
+	if len(got.Tags) != 1 || got.Tags[0] != "english" {
+		t.Fatalf("expected tags deduplicated to [english], got %#v", got.Tags)
+	}
+	if !got.UpdatedAt.After(got.CreatedAt) {
+		t.Fatalf("expected UpdatedAt to move past %v, got %v", got.CreatedAt, got.UpdatedAt)
+	}
+
+	got, err = repo.GetSeries(ctx, listening.ID, core.SeriesQueryOptions{})
+	if err != nil {
+		t.Fatalf("GetSeries() error = %v", err)
+	}
+	if len(got.Tags) != 1 || got.Tags[0] != "english" {
+		t.Fatalf("expected tags [english], got %#v", got.Tags)
+	}
+
+	updated := seriesUpdatedEvents(t, ctx, client, time.Now())
+	if len(updated) != 2 {
+		t.Fatalf("expected a SeriesUpdated event per affected series, got %d", len(updated))
+	}
+	for _, id := range []uuid.UUID{grammar.ID, listening.ID} {
+		if series, ok := updated[id]; !ok || len(series.Tags) != 1 || series.Tags[0] != "english" {
+			t.Fatalf("expected an event carrying the new tags for %s, got %+v", id, series)
+		}
+	}
+}
+
+func TestSeriesRepository_ReassignAuthor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newTestClient(t, "series_repo")
+	repo := NewSeriesRepository(client)
+
+	shared := core.Series{ID: uuid.New(), Slug: "shared", Title: "Shared", AuthorIDs: []string{"leaver", "stayer"}, Status: core.SeriesStatusDraft}
+	other := core.Series{ID: uuid.New(), Slug: "other", Title: "Other", AuthorIDs: []string{"someone"}, Status: core.SeriesStatusDraft}
+	createSeriesForTest(t, repo, ctx, shared)
+	createSeriesForTest(t, repo, ctx, other)
+
+	record, err := repo.ReassignAuthor(ctx, core.ContentReassignment{
+		ID:           uuid.New(),
+		FromAuthorID: "leaver",
+		ToAuthorID:   "stayer",
+	})
+	if err != nil {
+		t.Fatalf("ReassignAuthor() error = %v", err)
+	}
+	if len(record.SeriesIDs) != 1 || record.SeriesIDs[0] != shared.ID {
+		t.Fatalf("expected only the shared series to be reassigned, got %#v", record.SeriesIDs)
+	}
+
+	got, err := repo.GetSeries(ctx, shared.ID, core.SeriesQueryOptions{})
+	if err != nil {
+		t.Fatalf("GetSeries() error = %v", err)
+	}
+	if len(got.AuthorIDs) != 1 || got.AuthorIDs[0] != "stayer" {
+		t.Fatalf("expected author ids [stayer], got %#v", got.AuthorIDs)
+	}
+
+	stored, err := client.ContentReassignment.Get(ctx, record.ID)
 	if err != nil {
-		t.Fatalf("failed opening sqlite driver: %v", err)
+		t.Fatalf("expected reassignment audit record, got error %v", err)
+	}
+	if stored.FromAuthorID != "leaver" || stored.ToAuthorID != "stayer" {
+		t.Fatalf("unexpected audit record %#v", stored)
+	}
+
+	updated := seriesUpdatedEvents(t, ctx, client, time.Now())
+	if len(updated) != 1 {
+		t.Fatalf("expected a SeriesUpdated event for the moved series only, got %d", len(updated))
 	}
-	driver := entsql.OpenDB(dialect.SQLite, drv)
-	client := enttest.NewClient(t, enttest.WithOptions(entgenerated.Driver(driver)))
-	if err := client.Schema.Create(ctx); err != nil {
-		t.Fatalf("failed creating schema: %v", err)
+	if series := updated[shared.ID]; len(series.AuthorIDs) != 1 || series.AuthorIDs[0] != "stayer" {
+		t.Fatalf("expected an event carrying the new authors, got %+v", series)
 	}
-	return NewSeriesRepository(client), client
+}
+
+func TestSoftDeleteMixin_TurnsDeletesIntoUpdates(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newTestClient(t, "series_repo")
+	repo := NewSeriesRepository(client)
+
+	series := core.Series{ID: uuid.New(), Slug: "soft", Title: "Soft", Status: core.SeriesStatusDraft}
+	if _, err := repo.CreateSeries(ctx, series); err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+	episode := core.Episode{ID: uuid.New(), SeriesID: series.ID, Seq: 1, Title: "Episode 1"}
+	if _, err := repo.CreateEpisode(ctx, episode); err != nil {
+		t.Fatalf("CreateEpisode() error = %v", err)
+	}
+
+	if err := client.Episode.DeleteOneID(episode.ID).Exec(ctx); err != nil {
+		t.Fatalf("DeleteOneID() error = %v", err)
+	}
+	if err := client.Episode.DeleteOneID(episode.ID).Exec(ctx); !entgenerated.IsNotFound(err) {
+		t.Fatalf("expected deleting a deleted episode to find nothing, got %v", err)
+	}
+
+	if _, err := repo.GetEpisode(ctx, episode.ID); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected a deleted episode not to be found, got %v", err)
+	}
+	got, err := repo.GetSeries(ctx, series.ID, core.SeriesQueryOptions{IncludeEpisodes: true})
+	if err != nil {
+		t.Fatalf("GetSeries() error = %v", err)
+	}
+	if len(got.Episodes) != 0 {
+		t.Fatalf("expected deleted episodes left out of eager loads, got %d", len(got.Episodes))
+	}
+
+	row, err := client.Episode.Get(entschema.IncludeDeleted(ctx), episode.ID)
+	if err != nil {
+		t.Fatalf("Get(IncludeDeleted) error = %v", err)
+	}
+	if row.DeletedAt == nil {
+		t.Fatalf("expected deleted_at set on the kept row")
+	}
+}
+
+func TestTimeMixin_StampsTimestamps(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newTestClient(t, "series_repo")
+
+	created, err := client.Series.Create().SetSlug("stamped").SetTitle("Stamped").Save(ctx)
+	if err != nil {
+		t.Fatalf("create series: %v", err)
+	}
+	if created.CreatedAt.IsZero() || !created.UpdatedAt.Equal(created.CreatedAt) {
+		t.Fatalf("expected matching timestamps on create, got %v and %v", created.CreatedAt, created.UpdatedAt)
+	}
+
+	updated, err := client.Series.UpdateOneID(created.ID).SetTitle("Restamped").Save(ctx)
+	if err != nil {
+		t.Fatalf("update series: %v", err)
+	}
+	if !updated.UpdatedAt.After(created.UpdatedAt) {
+		t.Fatalf("expected updated_at bumped, got %v after %v", updated.UpdatedAt, created.UpdatedAt)
+	}
+
+	at := time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)
+	explicit, err := client.Series.UpdateOneID(created.ID).SetUpdatedAt(at).Save(ctx)
+	if err != nil {
+		t.Fatalf("update series: %v", err)
+	}
+	if !explicit.UpdatedAt.Equal(at) {
+		t.Fatalf("expected an explicit updated_at kept, got %v", explicit.UpdatedAt)
+	}
+}
+
+// seriesUpdatedEvents decodes the SeriesUpdated events pending in the outbox
+// at the given time, keyed by series id.
+func seriesUpdatedEvents(t *testing.T, ctx context.Context, client *entgenerated.Client, at time.Time) map[uuid.UUID]core.Series {
+	t.Helper()
+	messages, err := NewOutboxRepository(client).ListPendingOutboxMessages(ctx, at, 100)
+	if err != nil {
+		t.Fatalf("ListPendingOutboxMessages() error = %v", err)
+	}
+	updated := make(map[uuid.UUID]core.Series)
+	for _, message := range messages {
+		if message.EventType != core.EventTypeSeriesUpdated {
+			continue
+		}
+		event, err := core.DecodeEvent(message.EventType, message.Payload)
+		if err != nil {
+			t.Fatalf("DecodeEvent() error = %v", err)
+		}
+		series := event.(core.SeriesUpdated).Series
+		if message.AggregateID != series.ID.String() {
+			t.Fatalf("aggregate id %s does not match series %s", message.AggregateID, series.ID)
+		}
+		updated[series.ID] = series
+	}
+	return updated
 }
 
 func createSeriesForTest(t *testing.T, repo *SeriesRepository, ctx context.Context, series core.Series) {
@@ -286,12 +709,6 @@ func createSeriesForTest(t *testing.T, repo *SeriesRepository, ctx context.Conte
 	if series.ID == uuid.Nil {
 		series.ID = uuid.New()
 	}
-	if series.CreatedAt.IsZero() {
-		series.CreatedAt = time.Now().UTC()
-	}
-	if series.UpdatedAt.IsZero() {
-		series.UpdatedAt = series.CreatedAt
-	}
 	if _, err := repo.CreateSeries(ctx, series); err != nil {
 		t.Fatalf("CreateSeries() error = %v", err)
 	}
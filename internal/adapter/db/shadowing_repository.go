@@ -0,0 +1,159 @@
+package db
+
+import (
+	"context"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entshadowing "github.com/eslsoft/lession/internal/adapter/db/ent/generated/shadowingsubmission"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// ShadowingRepository persists shadowing submissions using Ent.
+type ShadowingRepository struct {
+	client *entgenerated.Client
+}
+
+// NewShadowingRepository constructs an Ent-backed shadowing repository.
+func NewShadowingRepository(client *entgenerated.Client) *ShadowingRepository {
+	return &ShadowingRepository{client: client}
+}
+
+var _ core.ShadowingRepository = (*ShadowingRepository)(nil)
+
+// CreateShadowingSubmission stores a new submission.
+func (r *ShadowingRepository) CreateShadowingSubmission(ctx context.Context, submission core.ShadowingSubmission) (*core.ShadowingSubmission, error) {
+	row, err := r.client.ShadowingSubmission.Create().
+		SetID(submission.ID).
+		SetUserID(submission.UserID).
+		SetEpisodeID(submission.EpisodeID).
+		SetSegmentIndex(submission.SegmentIndex).
+		SetAssetID(submission.AssetID).
+		SetStatus(int(submission.Status)).
+		SetNillableAutoScore(submission.AutoScore).
+		SetAutoFeedback(submission.AutoFeedback).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainShadowingSubmission(row), nil
+}
+
+// GetShadowingSubmission loads a submission by identifier.
+func (r *ShadowingRepository) GetShadowingSubmission(ctx context.Context, id uuid.UUID) (*core.ShadowingSubmission, error) {
+	row, err := r.client.ShadowingSubmission.Get(ctx, id)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainShadowingSubmission(row), nil
+}
+
+// UpdateShadowingSubmission persists review state for a submission.
+func (r *ShadowingRepository) UpdateShadowingSubmission(ctx context.Context, submission core.ShadowingSubmission) (*core.ShadowingSubmission, error) {
+	builder := r.client.ShadowingSubmission.UpdateOneID(submission.ID).
+		SetStatus(int(submission.Status)).
+		SetReviewerID(submission.ReviewerID).
+		SetReviewComment(submission.ReviewComment)
+
+	if submission.ReviewScore != nil {
+		builder.SetReviewScore(*submission.ReviewScore)
+	} else {
+		builder.ClearReviewScore()
+	}
+	if submission.ReviewedAt != nil {
+		builder.SetReviewedAt(*submission.ReviewedAt)
+	} else {
+		builder.ClearReviewedAt()
+	}
+
+	row, err := builder.Save(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainShadowingSubmission(row), nil
+}
+
+// ListShadowingSubmissions returns submissions matching the filter, newest first.
+func (r *ShadowingRepository) ListShadowingSubmissions(ctx context.Context, filter core.ShadowingSubmissionFilter) ([]core.ShadowingSubmission, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	q := r.client.ShadowingSubmission.Query()
+	if filter.UserID != "" {
+		q = q.Where(entshadowing.UserID(filter.UserID))
+	}
+	if filter.EpisodeID != uuid.Nil {
+		q = q.Where(entshadowing.EpisodeID(filter.EpisodeID))
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := lo.Map(filter.Statuses, func(s core.ShadowingSubmissionStatus, _ int) int {
+			return int(s)
+		})
+		q = q.Where(entshadowing.StatusIn(statuses...))
+	}
+
+	rows, err := q.
+		Order(entshadowing.ByCreatedAt(sql.OrderDesc())).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return lo.Map(rows, func(row *entgenerated.ShadowingSubmission, _ int) core.ShadowingSubmission {
+		return *toDomainShadowingSubmission(row)
+	}), nextToken, nil
+}
+
+func toDomainShadowingSubmission(row *entgenerated.ShadowingSubmission) *core.ShadowingSubmission {
+	submission := &core.ShadowingSubmission{
+		ID:            row.ID,
+		UserID:        row.UserID,
+		EpisodeID:     row.EpisodeID,
+		SegmentIndex:  row.SegmentIndex,
+		AssetID:       row.AssetID,
+		Status:        core.ShadowingSubmissionStatus(row.Status),
+		AutoFeedback:  row.AutoFeedback,
+		ReviewerID:    row.ReviewerID,
+		ReviewComment: row.ReviewComment,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+	}
+	if row.AutoScore != nil {
+		score := *row.AutoScore
+		submission.AutoScore = &score
+	}
+	if row.ReviewScore != nil {
+		score := *row.ReviewScore
+		submission.ReviewScore = &score
+	}
+	if row.ReviewedAt != nil {
+		t := *row.ReviewedAt
+		submission.ReviewedAt = &t
+	}
+	return submission
+}
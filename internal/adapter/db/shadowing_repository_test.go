@@ -0,0 +1,73 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestShadowingRepository_ReviewLifecycle(t *testing.T) {
+	ctx := context.Background()
+	repo := NewShadowingRepository(newTestClient(t, "shadowing_repo"))
+
+	now := time.Date(2024, 8, 2, 9, 0, 0, 0, time.UTC)
+	episodeID := uuid.New()
+	autoScore := 0.82
+	pending := core.ShadowingSubmission{ID: uuid.New(), UserID: "learner", EpisodeID: episodeID, SegmentIndex: 3, AssetID: uuid.New(), Status: core.ShadowingSubmissionStatusPending, AutoScore: &autoScore, AutoFeedback: "clear"}
+	other := core.ShadowingSubmission{ID: uuid.New(), UserID: "learner", EpisodeID: uuid.New(), AssetID: uuid.New(), Status: core.ShadowingSubmissionStatusPending}
+	for _, submission := range []core.ShadowingSubmission{pending, other} {
+		if _, err := repo.CreateShadowingSubmission(ctx, submission); err != nil {
+			t.Fatalf("CreateShadowingSubmission() error = %v", err)
+		}
+	}
+
+	stored, err := repo.GetShadowingSubmission(ctx, pending.ID)
+	if err != nil {
+		t.Fatalf("GetShadowingSubmission() error = %v", err)
+	}
+	if stored.AutoScore == nil || *stored.AutoScore != autoScore || stored.ReviewScore != nil || stored.ReviewedAt != nil {
+		t.Fatalf("unexpected stored submission %+v", stored)
+	}
+
+	reviewedAt := now.Add(time.Hour)
+	reviewScore := 4
+	stored.Status = core.ShadowingSubmissionStatusReviewed
+	stored.ReviewerID = "teacher"
+	stored.ReviewScore = &reviewScore
+	stored.ReviewComment = "Watch the vowel in 'cup'."
+	stored.ReviewedAt = &reviewedAt
+	reviewed, err := repo.UpdateShadowingSubmission(ctx, *stored)
+	if err != nil {
+		t.Fatalf("UpdateShadowingSubmission() error = %v", err)
+	}
+	if reviewed.ReviewScore == nil || *reviewed.ReviewScore != 4 || reviewed.ReviewedAt == nil || !reviewed.ReviewedAt.Equal(reviewedAt) || reviewed.ReviewerID != "teacher" {
+		t.Fatalf("expected the review to be stored, got %+v", reviewed)
+	}
+
+	listed, next, err := repo.ListShadowingSubmissions(ctx, core.ShadowingSubmissionFilter{Statuses: []core.ShadowingSubmissionStatus{core.ShadowingSubmissionStatusPending}})
+	if err != nil {
+		t.Fatalf("ListShadowingSubmissions() error = %v", err)
+	}
+	if len(listed) != 1 || listed[0].ID != other.ID || next != "" {
+		t.Fatalf("expected only the unreviewed submission, got %+v", listed)
+	}
+	listed, _, err = repo.ListShadowingSubmissions(ctx, core.ShadowingSubmissionFilter{UserID: "learner", EpisodeID: episodeID})
+	if err != nil {
+		t.Fatalf("ListShadowingSubmissions() error = %v", err)
+	}
+	if len(listed) != 1 || listed[0].ID != pending.ID {
+		t.Fatalf("expected the submission for the episode, got %+v", listed)
+	}
+
+	if _, err := repo.GetShadowingSubmission(ctx, uuid.New()); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if _, err := repo.UpdateShadowingSubmission(ctx, core.ShadowingSubmission{ID: uuid.New()}); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound updating a missing submission, got %v", err)
+	}
+}
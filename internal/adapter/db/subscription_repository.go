@@ -0,0 +1,221 @@
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entplan "github.com/eslsoft/lession/internal/adapter/db/ent/generated/plan"
+	entsubscription "github.com/eslsoft/lession/internal/adapter/db/ent/generated/subscription"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// SubscriptionRepository persists plans and subscriptions using Ent.
+type SubscriptionRepository struct {
+	client *entgenerated.Client
+}
+
+// NewSubscriptionRepository constructs an Ent-backed subscription repository.
+func NewSubscriptionRepository(client *entgenerated.Client) *SubscriptionRepository {
+	return &SubscriptionRepository{client: client}
+}
+
+var _ core.SubscriptionRepository = (*SubscriptionRepository)(nil)
+
+// CreatePlan stores a new plan.
+func (r *SubscriptionRepository) CreatePlan(ctx context.Context, plan core.Plan) (*core.Plan, error) {
+	row, err := r.client.Plan.Create().
+		SetID(plan.ID).
+		SetCode(plan.Code).
+		SetName(plan.Name).
+		SetDescription(plan.Description).
+		SetPriceCents(plan.PriceCents).
+		SetCurrency(plan.Currency).
+		SetInterval(int(plan.Interval)).
+		SetActive(plan.Active).
+		Save(ctx)
+	if err != nil {
+		if entgenerated.IsConstraintError(err) {
+			return nil, core.ErrAlreadyExists
+		}
+		return nil, err
+	}
+	return toDomainPlan(row), nil
+}
+
+// GetPlan loads a plan by identifier.
+func (r *SubscriptionRepository) GetPlan(ctx context.Context, id uuid.UUID) (*core.Plan, error) {
+	row, err := r.client.Plan.Get(ctx, id)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainPlan(row), nil
+}
+
+// UpdatePlan persists plan attributes.
+func (r *SubscriptionRepository) UpdatePlan(ctx context.Context, plan core.Plan) (*core.Plan, error) {
+	row, err := r.client.Plan.UpdateOneID(plan.ID).
+		SetCode(plan.Code).
+		SetName(plan.Name).
+		SetDescription(plan.Description).
+		SetPriceCents(plan.PriceCents).
+		SetCurrency(plan.Currency).
+		SetInterval(int(plan.Interval)).
+		SetActive(plan.Active).
+		Save(ctx)
+	if err != nil {
+		switch {
+		case entgenerated.IsNotFound(err):
+			return nil, core.ErrNotFound
+		case entgenerated.IsConstraintError(err):
+			return nil, core.ErrAlreadyExists
+		}
+		return nil, err
+	}
+	return toDomainPlan(row), nil
+}
+
+// ListPlans returns plans ordered by price, optionally including retired ones.
+func (r *SubscriptionRepository) ListPlans(ctx context.Context, includeInactive bool) ([]core.Plan, error) {
+	q := r.client.Plan.Query()
+	if !includeInactive {
+		q = q.Where(entplan.Active(true))
+	}
+
+	rows, err := q.
+		Order(entplan.ByPriceCents(), entplan.ByCode()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row *entgenerated.Plan, _ int) core.Plan {
+		return *toDomainPlan(row)
+	}), nil
+}
+
+// CreateSubscription stores a new subscription.
+func (r *SubscriptionRepository) CreateSubscription(ctx context.Context, subscription core.Subscription) (*core.Subscription, error) {
+	row, err := r.client.Subscription.Create().
+		SetID(subscription.ID).
+		SetUserID(subscription.UserID).
+		SetPlanID(subscription.PlanID).
+		SetStatus(int(subscription.Status)).
+		SetCurrentPeriodStart(subscription.CurrentPeriodStart).
+		SetCurrentPeriodEnd(subscription.CurrentPeriodEnd).
+		SetBillingProvider(subscription.BillingProvider).
+		SetExternalID(subscription.ExternalID).
+		SetNillableCanceledAt(subscription.CanceledAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainSubscription(row), nil
+}
+
+// GetSubscription loads a subscription by identifier.
+func (r *SubscriptionRepository) GetSubscription(ctx context.Context, id uuid.UUID) (*core.Subscription, error) {
+	row, err := r.client.Subscription.Get(ctx, id)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainSubscription(row), nil
+}
+
+// UpdateSubscription persists subscription status and billing period.
+func (r *SubscriptionRepository) UpdateSubscription(ctx context.Context, subscription core.Subscription) (*core.Subscription, error) {
+	builder := r.client.Subscription.UpdateOneID(subscription.ID).
+		SetPlanID(subscription.PlanID).
+		SetStatus(int(subscription.Status)).
+		SetCurrentPeriodStart(subscription.CurrentPeriodStart).
+		SetCurrentPeriodEnd(subscription.CurrentPeriodEnd).
+		SetBillingProvider(subscription.BillingProvider).
+		SetExternalID(subscription.ExternalID)
+	if subscription.CanceledAt != nil {
+		builder.SetCanceledAt(*subscription.CanceledAt)
+	} else {
+		builder.ClearCanceledAt()
+	}
+
+	row, err := builder.Save(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainSubscription(row), nil
+}
+
+// FindSubscriptionByExternalID loads the subscription a billing provider knows by externalID.
+func (r *SubscriptionRepository) FindSubscriptionByExternalID(ctx context.Context, provider, externalID string) (*core.Subscription, error) {
+	row, err := r.client.Subscription.Query().
+		Where(
+			entsubscription.BillingProvider(provider),
+			entsubscription.ExternalID(externalID),
+		).
+		Order(entsubscription.ByCreatedAt(sql.OrderDesc())).
+		First(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainSubscription(row), nil
+}
+
+// ListSubscriptions returns the learner's subscriptions, newest first.
+func (r *SubscriptionRepository) ListSubscriptions(ctx context.Context, userID string) ([]core.Subscription, error) {
+	rows, err := r.client.Subscription.Query().
+		Where(entsubscription.UserID(userID)).
+		Order(entsubscription.ByCreatedAt(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row *entgenerated.Subscription, _ int) core.Subscription {
+		return *toDomainSubscription(row)
+	}), nil
+}
+
+func toDomainPlan(row *entgenerated.Plan) *core.Plan {
+	return &core.Plan{
+		ID:          row.ID,
+		Code:        row.Code,
+		Name:        row.Name,
+		Description: row.Description,
+		PriceCents:  row.PriceCents,
+		Currency:    row.Currency,
+		Interval:    core.BillingInterval(row.Interval),
+		Active:      row.Active,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+func toDomainSubscription(row *entgenerated.Subscription) *core.Subscription {
+	return &core.Subscription{
+		ID:                 row.ID,
+		UserID:             row.UserID,
+		PlanID:             row.PlanID,
+		Status:             core.SubscriptionStatus(row.Status),
+		CurrentPeriodStart: row.CurrentPeriodStart.UTC(),
+		CurrentPeriodEnd:   row.CurrentPeriodEnd.UTC(),
+		BillingProvider:    row.BillingProvider,
+		ExternalID:         row.ExternalID,
+		CanceledAt:         row.CanceledAt,
+		CreatedAt:          row.CreatedAt,
+		UpdatedAt:          row.UpdatedAt,
+	}
+}
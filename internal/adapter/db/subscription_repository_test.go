@@ -0,0 +1,112 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestSubscriptionRepository_Plans(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSubscriptionRepository(newTestClient(t, "subscription_repo"))
+
+	yearly := core.Plan{ID: uuid.New(), Code: "yearly", Name: "Yearly", PriceCents: 9900, Currency: "usd", Interval: core.BillingIntervalYear, Active: true}
+	monthly := core.Plan{ID: uuid.New(), Code: "monthly", Name: "Monthly", PriceCents: 999, Currency: "usd", Interval: core.BillingIntervalMonth, Active: true}
+	for _, plan := range []core.Plan{yearly, monthly} {
+		if _, err := repo.CreatePlan(ctx, plan); err != nil {
+			t.Fatalf("CreatePlan() error = %v", err)
+		}
+	}
+	if _, err := repo.CreatePlan(ctx, core.Plan{ID: uuid.New(), Code: "monthly", Name: "Copy", Currency: "usd"}); !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected ErrAlreadyExists for a duplicate code, got %v", err)
+	}
+
+	plans, err := repo.ListPlans(ctx, false)
+	if err != nil {
+		t.Fatalf("ListPlans() error = %v", err)
+	}
+	if len(plans) != 2 || plans[0].Code != "monthly" || plans[1].Interval != core.BillingIntervalYear {
+		t.Fatalf("expected plans ordered by price, got %+v", plans)
+	}
+
+	yearly.Active = false
+	if _, err := repo.UpdatePlan(ctx, yearly); err != nil {
+		t.Fatalf("UpdatePlan() error = %v", err)
+	}
+	if active, err := repo.ListPlans(ctx, false); err != nil || len(active) != 1 || active[0].ID != monthly.ID {
+		t.Fatalf("expected retired plans to be hidden, got %+v, %v", active, err)
+	}
+	if all, err := repo.ListPlans(ctx, true); err != nil || len(all) != 2 {
+		t.Fatalf("expected retired plans when asked for, got %+v, %v", all, err)
+	}
+
+	monthly.Code = "yearly"
+	if _, err := repo.UpdatePlan(ctx, monthly); !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected ErrAlreadyExists renaming onto a taken code, got %v", err)
+	}
+	if _, err := repo.GetPlan(ctx, uuid.New()); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestSubscriptionRepository_Subscriptions(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSubscriptionRepository(newTestClient(t, "subscription_repo"))
+
+	now := time.Date(2024, 8, 5, 9, 0, 0, 0, time.UTC)
+	planID := uuid.New()
+	lapsed := core.Subscription{ID: uuid.New(), UserID: "learner", PlanID: planID, Status: core.SubscriptionStatusExpired, CurrentPeriodStart: now.AddDate(0, -2, 0), CurrentPeriodEnd: now.AddDate(0, -1, 0)}
+	current := core.Subscription{ID: uuid.New(), UserID: "learner", PlanID: planID, Status: core.SubscriptionStatusActive, CurrentPeriodStart: now, CurrentPeriodEnd: now.AddDate(0, 1, 0), BillingProvider: "stripe", ExternalID: "sub_123"}
+	for _, subscription := range []core.Subscription{lapsed, current} {
+		if _, err := repo.CreateSubscription(ctx, subscription); err != nil {
+			t.Fatalf("CreateSubscription() error = %v", err)
+		}
+	}
+
+	listed, err := repo.ListSubscriptions(ctx, "learner")
+	if err != nil {
+		t.Fatalf("ListSubscriptions() error = %v", err)
+	}
+	if len(listed) != 2 || listed[0].ID != current.ID {
+		t.Fatalf("expected subscriptions newest first, got %+v", listed)
+	}
+
+	found, err := repo.FindSubscriptionByExternalID(ctx, "stripe", "sub_123")
+	if err != nil {
+		t.Fatalf("FindSubscriptionByExternalID() error = %v", err)
+	}
+	if found.ID != current.ID || !found.CurrentPeriodEnd.Equal(current.CurrentPeriodEnd) {
+		t.Fatalf("unexpected subscription %+v", found)
+	}
+	if _, err := repo.FindSubscriptionByExternalID(ctx, "paddle", "sub_123"); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for another provider, got %v", err)
+	}
+
+	canceledAt := now.Add(24 * time.Hour)
+	found.Status = core.SubscriptionStatusCanceled
+	found.CanceledAt = &canceledAt
+	if _, err := repo.UpdateSubscription(ctx, *found); err != nil {
+		t.Fatalf("UpdateSubscription() error = %v", err)
+	}
+	stored, err := repo.GetSubscription(ctx, current.ID)
+	if err != nil {
+		t.Fatalf("GetSubscription() error = %v", err)
+	}
+	if stored.Status != core.SubscriptionStatusCanceled || stored.CanceledAt == nil || !stored.CanceledAt.Equal(canceledAt) {
+		t.Fatalf("expected the cancellation to be stored, got %+v", stored)
+	}
+
+	stored.Status = core.SubscriptionStatusActive
+	stored.CanceledAt = nil
+	if resumed, err := repo.UpdateSubscription(ctx, *stored); err != nil || resumed.CanceledAt != nil {
+		t.Fatalf("expected resuming to clear the cancellation, got %+v, %v", resumed, err)
+	}
+	if _, err := repo.UpdateSubscription(ctx, core.Subscription{ID: uuid.New()}); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound updating a missing subscription, got %v", err)
+	}
+}
@@ -0,0 +1,129 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingDriver wraps an Ent driver, recording a client span for every
+// statement and transaction so slow queries show up under the RPC that
+// issued them.
+type TracingDriver struct {
+	dialect.Driver
+	tracer trace.Tracer
+}
+
+// NewTracingDriver wraps drv with spans from the given tracer provider.
+func NewTracingDriver(drv dialect.Driver, provider trace.TracerProvider) *TracingDriver {
+	return &TracingDriver{
+		Driver: drv,
+		tracer: provider.Tracer("github.com/eslsoft/lession/internal/adapter/db"),
+	}
+}
+
+var _ dialect.Driver = (*TracingDriver)(nil)
+
+// Exec runs a statement inside a span.
+func (d *TracingDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return traceStatement(ctx, d.tracer, d.Dialect(), query, func(ctx context.Context) error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+// Query runs a query inside a span.
+func (d *TracingDriver) Query(ctx context.Context, query string, args, v any) error {
+	return traceStatement(ctx, d.tracer, d.Dialect(), query, func(ctx context.Context) error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+// Tx starts a transaction whose statements are traced.
+func (d *TracingDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.BeginTx(ctx, nil)
+}
+
+// BeginTx starts a transaction with options whose statements are traced.
+func (d *TracingDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	var (
+		tx  dialect.Tx
+		err error
+	)
+	if beginner, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}); ok {
+		tx, err = beginner.BeginTx(ctx, opts)
+	} else {
+		tx, err = d.Driver.Tx(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &tracingTx{Tx: tx, tracer: d.tracer, dialect: d.Dialect(), ctx: ctx}, nil
+}
+
+type tracingTx struct {
+	dialect.Tx
+	tracer  trace.Tracer
+	dialect string
+	// ctx is the context the transaction was started with; Commit and
+	// Rollback take none, so their spans join it.
+	ctx context.Context
+}
+
+func (t *tracingTx) Exec(ctx context.Context, query string, args, v any) error {
+	return traceStatement(ctx, t.tracer, t.dialect, query, func(ctx context.Context) error {
+		return t.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+func (t *tracingTx) Query(ctx context.Context, query string, args, v any) error {
+	return traceStatement(ctx, t.tracer, t.dialect, query, func(ctx context.Context) error {
+		return t.Tx.Query(ctx, query, args, v)
+	})
+}
+
+func (t *tracingTx) Commit() error {
+	return traceStatement(t.ctx, t.tracer, t.dialect, "COMMIT", func(context.Context) error {
+		return t.Tx.Commit()
+	})
+}
+
+func (t *tracingTx) Rollback() error {
+	return traceStatement(t.ctx, t.tracer, t.dialect, "ROLLBACK", func(context.Context) error {
+		return t.Tx.Rollback()
+	})
+}
+
+func traceStatement(ctx context.Context, tracer trace.Tracer, system, statement string, run func(ctx context.Context) error) error {
+	ctx, span := tracer.Start(ctx, spanName(statement),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", system),
+			attribute.String("db.statement", statement),
+		),
+	)
+	defer span.End()
+
+	err := run(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+// spanName names a span after the SQL verb, e.g. "db.SELECT", keeping span
+// names low-cardinality.
+func spanName(statement string) string {
+	var verb string
+	if _, err := fmt.Sscan(statement, &verb); err != nil || verb == "" {
+		return "db.query"
+	}
+	return "db." + verb
+}
@@ -0,0 +1,38 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/enttest"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestTracingDriver_RecordsStatementSpans(t *testing.T) {
+	ctx := context.Background()
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	driver := NewTracingDriver(openSQLiteDriver(t, "tracing_driver"), provider)
+	client := enttest.NewClient(t, enttest.WithOptions(entgenerated.Driver(driver)))
+	defer client.Close()
+
+	repo := NewSeriesRepository(client)
+	if _, _, err := repo.ListSeries(ctx, core.SeriesListFilter{}); err != nil {
+		t.Fatalf("ListSeries() error = %v", err)
+	}
+
+	var selects int
+	for _, span := range recorder.Ended() {
+		if span.Name() == "db.SELECT" {
+			selects++
+		}
+	}
+	if selects == 0 {
+		t.Fatalf("expected a db.SELECT span, got %d spans", len(recorder.Ended()))
+	}
+}
@@ -0,0 +1,306 @@
+package db
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entepisode "github.com/eslsoft/lession/internal/adapter/db/ent/generated/episode"
+	entepisodetranscript "github.com/eslsoft/lession/internal/adapter/db/ent/generated/episodetranscript"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/predicate"
+	enttranscriptreplacejob "github.com/eslsoft/lession/internal/adapter/db/ent/generated/transcriptreplacejob"
+	enttranscriptrevision "github.com/eslsoft/lession/internal/adapter/db/ent/generated/transcriptrevision"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// TranscriptAdminRepository persists bulk transcript edit jobs and revisions using Ent.
+type TranscriptAdminRepository struct {
+	client *entgenerated.Client
+}
+
+// NewTranscriptAdminRepository constructs an Ent-backed transcript admin repository.
+func NewTranscriptAdminRepository(client *entgenerated.Client) *TranscriptAdminRepository {
+	return &TranscriptAdminRepository{client: client}
+}
+
+var _ core.TranscriptAdminRepository = (*TranscriptAdminRepository)(nil)
+
+// CreateTranscriptReplaceJob stores a new job.
+func (r *TranscriptAdminRepository) CreateTranscriptReplaceJob(ctx context.Context, job core.TranscriptReplaceJob) (*core.TranscriptReplaceJob, error) {
+	builder := r.client.TranscriptReplaceJob.Create().
+		SetID(job.ID).
+		SetPattern(job.Pattern).
+		SetReplacement(job.Replacement).
+		SetRegex(job.Regex).
+		SetCaseInsensitive(job.CaseInsensitive).
+		SetScopeLanguage(job.Scope.Language).
+		SetDryRun(job.DryRun).
+		SetRequestedBy(job.RequestedBy).
+		SetStatus(int(job.Status))
+	if job.Scope.SeriesID != uuid.Nil {
+		builder.SetScopeSeriesID(job.Scope.SeriesID)
+	}
+
+	row, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainTranscriptReplaceJob(row), nil
+}
+
+// GetTranscriptReplaceJob loads a job by identifier.
+func (r *TranscriptAdminRepository) GetTranscriptReplaceJob(ctx context.Context, id uuid.UUID) (*core.TranscriptReplaceJob, error) {
+	row, err := r.client.TranscriptReplaceJob.Get(ctx, id)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainTranscriptReplaceJob(row), nil
+}
+
+// UpdateTranscriptReplaceJob persists job progress and outcome.
+func (r *TranscriptAdminRepository) UpdateTranscriptReplaceJob(ctx context.Context, job core.TranscriptReplaceJob) (*core.TranscriptReplaceJob, error) {
+	row, err := r.client.TranscriptReplaceJob.UpdateOneID(job.ID).
+		SetStatus(int(job.Status)).
+		SetEpisodesScanned(job.EpisodesScanned).
+		SetEpisodesChanged(job.EpisodesChanged).
+		SetMatches(job.Matches).
+		SetChanges(job.Changes).
+		SetError(job.Error).
+		SetNillableStartedAt(job.StartedAt).
+		SetNillableFinishedAt(job.FinishedAt).
+		SetNillableRolledBackAt(job.RolledBackAt).
+		Save(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainTranscriptReplaceJob(row), nil
+}
+
+// ListTranscriptReplaceJobs returns jobs, newest first.
+func (r *TranscriptAdminRepository) ListTranscriptReplaceJobs(ctx context.Context, filter core.TranscriptReplaceJobFilter) ([]core.TranscriptReplaceJob, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	rows, err := r.client.TranscriptReplaceJob.Query().
+		Order(enttranscriptreplacejob.ByCreatedAt(sql.OrderDesc())).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return lo.Map(rows, func(row *entgenerated.TranscriptReplaceJob, _ int) core.TranscriptReplaceJob {
+		return *toDomainTranscriptReplaceJob(row)
+	}), nextToken, nil
+}
+
+// ListTranscriptEpisodes returns live episodes with transcripts in scope, ordered by ID.
+func (r *TranscriptAdminRepository) ListTranscriptEpisodes(ctx context.Context, scope core.TranscriptReplaceScope, after uuid.UUID, limit int) ([]core.Episode, error) {
+	q := r.client.Episode.Query().
+		Where(entepisode.Or(entepisode.TranscriptContentNEQ(""), entepisode.TranscriptOffloaded(true))).
+		WithTranscript()
+	if scope.SeriesID != uuid.Nil {
+		q = q.Where(entepisode.SeriesID(scope.SeriesID))
+	}
+	if scope.Language != "" {
+		q = q.Where(entepisode.TranscriptLanguageEqualFold(scope.Language))
+	}
+	if after != uuid.Nil {
+		q = q.Where(entepisode.IDGT(after))
+	}
+
+	rows, err := q.
+		Order(entepisode.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(rows, func(row *entgenerated.Episode, _ int) core.Episode {
+		return *toDomainEpisode(row)
+	}), nil
+}
+
+// ApplyTranscriptRevisions writes revised transcripts guarded by their previous content.
+func (r *TranscriptAdminRepository) ApplyTranscriptRevisions(ctx context.Context, revisions []core.TranscriptRevision) ([]uuid.UUID, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var conflicts []uuid.UUID
+	for _, revision := range revisions {
+		updated, err := swapTranscript(ctx, tx,
+			[]predicate.Episode{entepisode.ID(revision.EpisodeID), entepisode.DeletedAtIsNil()},
+			revision.Before, revision.After)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		if !updated {
+			conflicts = append(conflicts, revision.EpisodeID)
+			continue
+		}
+
+		err = tx.TranscriptRevision.Create().
+			SetID(revision.ID).
+			SetJobID(revision.JobID).
+			SetEpisodeID(revision.EpisodeID).
+			SetBefore(revision.Before).
+			SetAfter(revision.After).
+			Exec(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return conflicts, nil
+}
+
+// RollbackTranscriptRevisions restores transcripts changed by a job in a single transaction.
+func (r *TranscriptAdminRepository) RollbackTranscriptRevisions(ctx context.Context, jobID uuid.UUID) (*core.TranscriptRollbackResult, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := tx.TranscriptRevision.Query().
+		Where(
+			enttranscriptrevision.JobID(jobID),
+			enttranscriptrevision.RolledBackAtIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	result := &core.TranscriptRollbackResult{}
+	var restoredIDs []uuid.UUID
+	for _, revision := range revisions {
+		restored, err := swapTranscript(ctx, tx,
+			[]predicate.Episode{entepisode.ID(revision.EpisodeID)},
+			revision.After, revision.Before)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		if !restored {
+			result.Conflicts = append(result.Conflicts, revision.EpisodeID)
+			continue
+		}
+
+		if err := tx.TranscriptRevision.UpdateOneID(revision.ID).SetRolledBackAt(now).Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		result.Restored++
+		restoredIDs = append(restoredIDs, revision.EpisodeID)
+	}
+
+	if len(restoredIDs) > 0 {
+		err := tx.Episode.Query().
+			Where(entepisode.IDIn(restoredIDs...)).
+			Unique(true).
+			Select(entepisode.FieldSeriesID).
+			Scan(ctx, &result.SeriesIDs)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func toDomainTranscriptReplaceJob(row *entgenerated.TranscriptReplaceJob) *core.TranscriptReplaceJob {
+	job := &core.TranscriptReplaceJob{
+		ID:              row.ID,
+		Pattern:         row.Pattern,
+		Replacement:     row.Replacement,
+		Regex:           row.Regex,
+		CaseInsensitive: row.CaseInsensitive,
+		Scope:           core.TranscriptReplaceScope{Language: row.ScopeLanguage},
+		DryRun:          row.DryRun,
+		RequestedBy:     row.RequestedBy,
+		Status:          core.TranscriptReplaceJobStatus(row.Status),
+		EpisodesScanned: row.EpisodesScanned,
+		EpisodesChanged: row.EpisodesChanged,
+		Matches:         row.Matches,
+		Changes:         row.Changes,
+		Error:           row.Error,
+		CreatedAt:       row.CreatedAt,
+		StartedAt:       row.StartedAt,
+		FinishedAt:      row.FinishedAt,
+		RolledBackAt:    row.RolledBackAt,
+	}
+	if row.ScopeSeriesID != nil {
+		job.Scope.SeriesID = *row.ScopeSeriesID
+	}
+	return job
+}
+
+// swapTranscript replaces the transcript of the episode matching where with
+// to, guarded by its current content being from, wherever the transcript is
+// stored, and reports whether it was replaced. The transcript is moved
+// in or out of episode_transcripts when the episode is next saved.
+func swapTranscript(ctx context.Context, tx *entgenerated.Tx, where []predicate.Episode, from, to string) (bool, error) {
+	offloaded, err := tx.EpisodeTranscript.Update().
+		Where(
+			entepisodetranscript.Content(from),
+			entepisodetranscript.HasEpisodeWith(append(slices.Clone(where), entepisode.TranscriptOffloaded(true))...),
+		).
+		SetContent(to).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	update := tx.Episode.Update().
+		Where(where...).
+		// Findings locate spans of the old content; the next save of the
+		// episode scans the transcript again.
+		ClearTranscriptFindings()
+	if offloaded == 0 {
+		update.
+			Where(entepisode.TranscriptOffloaded(false), entepisode.TranscriptContent(from)).
+			SetTranscriptContent(to)
+	}
+	updated, err := update.Save(ctx)
+	return updated > 0, err
+}
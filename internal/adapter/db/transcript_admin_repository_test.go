@@ -0,0 +1,100 @@
+package db
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestTranscriptAdminRepository_ApplyAndRollback(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "transcript_admin_repo")
+	repo := NewTranscriptAdminRepository(client)
+
+	seriesRepo := NewSeriesRepository(client)
+	seriesRepo.WithTranscriptOffload(len("colour"))
+	seriesID := uuid.New()
+	first, second, untouched, offloaded := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	episode := func(id uuid.UUID, seq uint32, language, content string) core.Episode {
+		return core.Episode{
+			ID:         id,
+			SeriesID:   seriesID,
+			Seq:        seq,
+			Title:      "Episode",
+			Status:     core.EpisodeStatusPublished,
+			Transcript: core.Transcript{Language: language, Format: core.TranscriptFormatPlain, Content: content},
+		}
+	}
+	createSeriesForTest(t, seriesRepo, ctx, core.Series{
+		ID:     seriesID,
+		Slug:   "captions",
+		Title:  "Captions",
+		Status: core.SeriesStatusPublished,
+		Episodes: []core.Episode{
+			episode(first, 1, "en", "colour"),
+			episode(second, 2, "en", "colour"),
+			episode(untouched, 3, "fr", "couleur"),
+			episode(offloaded, 4, "en", "colour colour"),
+		},
+	})
+
+	episodes, err := repo.ListTranscriptEpisodes(ctx, core.TranscriptReplaceScope{SeriesID: seriesID, Language: "EN"}, uuid.Nil, 10)
+	if err != nil {
+		t.Fatalf("ListTranscriptEpisodes() error = %v", err)
+	}
+	if len(episodes) != 3 || episodes[slices.IndexFunc(episodes, func(e core.Episode) bool { return e.ID == offloaded })].Transcript.Content != "colour colour" {
+		t.Fatalf("expected 3 english transcripts in scope, got %+v", episodes)
+	}
+
+	jobID := uuid.New()
+	revision := func(episodeID uuid.UUID, before string) core.TranscriptRevision {
+		return core.TranscriptRevision{ID: uuid.New(), JobID: jobID, EpisodeID: episodeID, Before: before, After: "color"}
+	}
+	conflicts, err := repo.ApplyTranscriptRevisions(ctx, []core.TranscriptRevision{
+		revision(first, "colour"),
+		revision(second, "stale content"),
+		revision(offloaded, "colour colour"),
+	})
+	if err != nil {
+		t.Fatalf("ApplyTranscriptRevisions() error = %v", err)
+	}
+	if len(conflicts) != 1 || conflicts[0] != second {
+		t.Fatalf("expected stale revision to conflict, got %v", conflicts)
+	}
+	assertTranscript(t, ctx, seriesRepo, first, "color")
+	assertTranscript(t, ctx, seriesRepo, second, "colour")
+	assertTranscript(t, ctx, seriesRepo, offloaded, "color")
+
+	result, err := repo.RollbackTranscriptRevisions(ctx, jobID)
+	if err != nil {
+		t.Fatalf("RollbackTranscriptRevisions() error = %v", err)
+	}
+	if result.Restored != 2 || len(result.Conflicts) != 0 || len(result.SeriesIDs) != 1 || result.SeriesIDs[0] != seriesID {
+		t.Fatalf("unexpected rollback result %#v", result)
+	}
+	assertTranscript(t, ctx, seriesRepo, first, "colour")
+	assertTranscript(t, ctx, seriesRepo, offloaded, "colour colour")
+
+	result, err = repo.RollbackTranscriptRevisions(ctx, jobID)
+	if err != nil {
+		t.Fatalf("second RollbackTranscriptRevisions() error = %v", err)
+	}
+	if result.Restored != 0 {
+		t.Fatalf("expected rollback to be idempotent, got %#v", result)
+	}
+}
+
+func assertTranscript(t *testing.T, ctx context.Context, repo *SeriesRepository, id uuid.UUID, want string) {
+	t.Helper()
+	episode, err := repo.GetEpisode(ctx, id)
+	if err != nil {
+		t.Fatalf("GetEpisode() error = %v", err)
+	}
+	if episode.Transcript.Content != want {
+		t.Fatalf("transcript = %q, want %q", episode.Transcript.Content, want)
+	}
+}
@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type txContextKey struct{}
+
+// TxManager implements core.TxManager as an Ent driver wrapper. Inside
+// WithinTx, every statement of the clients opened on it runs in one shared
+// transaction, and the transactions repositories begin join it: their
+// commits and rollbacks are left to WithinTx, so a repository that fails
+// midway cannot leave part of the unit of work committed.
+type TxManager struct {
+	dialect.Driver
+}
+
+// NewTxManager wraps drv, which begins the shared transactions.
+func NewTxManager(drv dialect.Driver) *TxManager {
+	return &TxManager{Driver: drv}
+}
+
+var (
+	_ dialect.Driver = (*TxManager)(nil)
+	_ core.TxManager = (*TxManager)(nil)
+)
+
+// WithinTx implements core.TxManager.
+func (m *TxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txContextKey{}).(dialect.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.Driver.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	committed := false
+	defer func() {
+		// Also roll back when fn panics.
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, tx)); err != nil {
+		return err
+	}
+	committed = true
+	return tx.Commit()
+}
+
+// Exec runs the statement in the transaction of ctx, if any.
+func (m *TxManager) Exec(ctx context.Context, query string, args, v any) error {
+	if tx, ok := ctx.Value(txContextKey{}).(dialect.Tx); ok {
+		return tx.Exec(ctx, query, args, v)
+	}
+	return m.Driver.Exec(ctx, query, args, v)
+}
+
+// Query runs the query in the transaction of ctx, if any.
+func (m *TxManager) Query(ctx context.Context, query string, args, v any) error {
+	if tx, ok := ctx.Value(txContextKey{}).(dialect.Tx); ok {
+		return tx.Query(ctx, query, args, v)
+	}
+	return m.Driver.Query(ctx, query, args, v)
+}
+
+// Tx joins the transaction of ctx or starts a new one.
+func (m *TxManager) Tx(ctx context.Context) (dialect.Tx, error) {
+	return m.BeginTx(ctx, nil)
+}
+
+// BeginTx joins the transaction of ctx, ignoring opts, or starts a new one
+// with them.
+func (m *TxManager) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	if tx, ok := ctx.Value(txContextKey{}).(dialect.Tx); ok {
+		return joinedTx{Tx: tx}, nil
+	}
+	if beginner, ok := m.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}); ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+	return m.Driver.Tx(ctx)
+}
+
+// joinedTx is a transaction joined inside WithinTx, which alone ends it.
+type joinedTx struct {
+	dialect.Tx
+}
+
+func (joinedTx) Commit() error   { return nil }
+func (joinedTx) Rollback() error { return nil }
@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	"github.com/eslsoft/lession/internal/adapter/db/ent/generated/enttest"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestTxManager_WithinTx(t *testing.T) {
+	ctx := context.Background()
+	txManager := NewTxManager(openSQLiteDriver(t, "tx_manager"))
+	client := enttest.NewClient(t, enttest.WithOptions(entgenerated.Driver(txManager)))
+	defer client.Close()
+	repo := NewSeriesRepository(client)
+
+	newSeries := func(slug string) core.Series {
+		return core.Series{ID: uuid.New(), Slug: slug, Title: slug, Status: core.SeriesStatusDraft}
+	}
+	committed, rolledBack, nested := newSeries("committed"), newSeries("rolled-back"), newSeries("nested")
+
+	err := txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := repo.CreateSeries(ctx, committed); err != nil {
+			return err
+		}
+		// Reads inside the transaction see its writes.
+		_, err := repo.GetSeries(ctx, committed.ID, core.SeriesQueryOptions{})
+		return err
+	})
+	if err != nil {
+		t.Fatalf("WithinTx() error = %v", err)
+	}
+
+	errAbort := errors.New("abort")
+	err = txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := repo.CreateSeries(ctx, rolledBack); err != nil {
+			return err
+		}
+		return txManager.WithinTx(ctx, func(ctx context.Context) error {
+			if _, err := repo.CreateSeries(ctx, nested); err != nil {
+				return err
+			}
+			return errAbort
+		})
+	})
+	if !errors.Is(err, errAbort) {
+		t.Fatalf("expected the error of fn, got %v", err)
+	}
+
+	if _, err := repo.GetSeries(ctx, committed.ID, core.SeriesQueryOptions{}); err != nil {
+		t.Fatalf("expected the committed series, got %v", err)
+	}
+	for _, series := range []core.Series{rolledBack, nested} {
+		if _, err := repo.GetSeries(ctx, series.ID, core.SeriesQueryOptions{}); !errors.Is(err, core.ErrNotFound) {
+			t.Fatalf("expected series %q rolled back despite its repository committing, got %v", series.Slug, err)
+		}
+	}
+}
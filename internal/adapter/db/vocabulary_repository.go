@@ -0,0 +1,172 @@
+package db
+
+import (
+	"context"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entvocabulary "github.com/eslsoft/lession/internal/adapter/db/ent/generated/vocabularyword"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// vocabularyLookupBatch bounds the words bound into one IN clause.
+const vocabularyLookupBatch = 500
+
+// VocabularyRepository persists learners' vocabulary using Ent.
+type VocabularyRepository struct {
+	client *entgenerated.Client
+}
+
+// NewVocabularyRepository constructs an Ent-backed vocabulary repository.
+func NewVocabularyRepository(client *entgenerated.Client) *VocabularyRepository {
+	return &VocabularyRepository{client: client}
+}
+
+var _ core.VocabularyRepository = (*VocabularyRepository)(nil)
+
+// SaveWords inserts the words, or replaces the status and episode of words the
+// learner already marked, in a single transaction.
+func (r *VocabularyRepository) SaveWords(ctx context.Context, words ...core.VocabularyWord) error {
+	if len(words) == 0 {
+		return nil
+	}
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	for userID, marked := range lo.GroupBy(words, func(word core.VocabularyWord) string { return word.UserID }) {
+		for _, chunk := range lo.Chunk(marked, vocabularyLookupBatch) {
+			rows, err := tx.VocabularyWord.Query().
+				Where(
+					entvocabulary.UserID(userID),
+					entvocabulary.WordIn(lo.Map(chunk, func(word core.VocabularyWord, _ int) string { return word.Word })...),
+				).
+				All(ctx)
+			if err != nil {
+				_ = tx.Rollback()
+				return err
+			}
+			existing := lo.KeyBy(rows, func(row *entgenerated.VocabularyWord) string { return row.Word })
+
+			var creates []*entgenerated.VocabularyWordCreate
+			for _, word := range chunk {
+				row, ok := existing[word.Word]
+				if !ok {
+					create := tx.VocabularyWord.Create().
+						SetUserID(word.UserID).
+						SetWord(word.Word).
+						SetStatus(int(word.Status))
+					if word.EpisodeID != uuid.Nil {
+						create.SetEpisodeID(word.EpisodeID)
+					}
+					creates = append(creates, create)
+					continue
+				}
+				update := tx.VocabularyWord.UpdateOne(row).
+					SetStatus(int(word.Status))
+				if word.EpisodeID == uuid.Nil {
+					update = update.ClearEpisodeID()
+				} else {
+					update = update.SetEpisodeID(word.EpisodeID)
+				}
+				if err := update.Exec(ctx); err != nil {
+					_ = tx.Rollback()
+					return err
+				}
+			}
+			if len(creates) > 0 {
+				if err := tx.VocabularyWord.CreateBulk(creates...).Exec(ctx); err != nil {
+					_ = tx.Rollback()
+					return err
+				}
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// DeleteWords removes the learner's marks for the words.
+func (r *VocabularyRepository) DeleteWords(ctx context.Context, userID string, words []string) error {
+	for _, chunk := range lo.Chunk(words, vocabularyLookupBatch) {
+		if _, err := r.client.VocabularyWord.Delete().
+			Where(entvocabulary.UserID(userID), entvocabulary.WordIn(chunk...)).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListWords returns the learner's words matching the filter, most recently
+// marked first.
+func (r *VocabularyRepository) ListWords(ctx context.Context, filter core.VocabularyFilter) ([]core.VocabularyWord, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	q := r.client.VocabularyWord.Query().
+		Where(entvocabulary.UserID(filter.UserID))
+	if filter.Status != core.VocabularyStatusUnspecified {
+		q = q.Where(entvocabulary.Status(int(filter.Status)))
+	}
+
+	rows, err := q.
+		Order(entvocabulary.ByUpdatedAt(sql.OrderDesc()), entvocabulary.ByWord()).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return lo.Map(rows, func(row *entgenerated.VocabularyWord, _ int) core.VocabularyWord {
+		return toDomainVocabularyWord(row)
+	}), nextToken, nil
+}
+
+// WordStatuses returns the learner's marks for those of the words they marked.
+func (r *VocabularyRepository) WordStatuses(ctx context.Context, userID string, words []string) (map[string]core.VocabularyStatus, error) {
+	statuses := make(map[string]core.VocabularyStatus)
+	for _, chunk := range lo.Chunk(words, vocabularyLookupBatch) {
+		rows, err := r.client.VocabularyWord.Query().
+			Where(entvocabulary.UserID(userID), entvocabulary.WordIn(chunk...)).
+			Select(entvocabulary.FieldWord, entvocabulary.FieldStatus).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			statuses[row.Word] = core.VocabularyStatus(row.Status)
+		}
+	}
+	return statuses, nil
+}
+
+func toDomainVocabularyWord(row *entgenerated.VocabularyWord) core.VocabularyWord {
+	return core.VocabularyWord{
+		UserID:    row.UserID,
+		Word:      row.Word,
+		Status:    core.VocabularyStatus(row.Status),
+		EpisodeID: lo.FromPtr(row.EpisodeID),
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
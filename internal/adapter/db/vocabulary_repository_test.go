@@ -0,0 +1,77 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestVocabularyRepository_SaveAndList(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "vocabulary_repo")
+	repo := NewVocabularyRepository(client)
+
+	episodeID := uuid.New()
+	mark := func(status core.VocabularyStatus, words ...string) {
+		t.Helper()
+		marked := make([]core.VocabularyWord, 0, len(words))
+		for _, word := range words {
+			marked = append(marked, core.VocabularyWord{UserID: "learner", Word: word, Status: status, EpisodeID: episodeID})
+		}
+		if err := repo.SaveWords(ctx, marked...); err != nil {
+			t.Fatalf("SaveWords() error = %v", err)
+		}
+	}
+	mark(core.VocabularyStatusUnknown, "barista", "espresso")
+	mark(core.VocabularyStatusKnown, "espresso", "milk")
+	if err := repo.SaveWords(ctx, core.VocabularyWord{UserID: "other", Word: "barista", Status: core.VocabularyStatusKnown}); err != nil {
+		t.Fatalf("SaveWords() error = %v", err)
+	}
+
+	statuses, err := repo.WordStatuses(ctx, "learner", []string{"barista", "espresso", "milk", "tea"})
+	if err != nil {
+		t.Fatalf("WordStatuses() error = %v", err)
+	}
+	want := map[string]core.VocabularyStatus{
+		"barista":  core.VocabularyStatusUnknown,
+		"espresso": core.VocabularyStatusKnown,
+		"milk":     core.VocabularyStatusKnown,
+	}
+	if len(statuses) != len(want) {
+		t.Fatalf("WordStatuses() = %v, want %v", statuses, want)
+	}
+	for word, status := range want {
+		if statuses[word] != status {
+			t.Fatalf("WordStatuses()[%q] = %v, want %v", word, statuses[word], status)
+		}
+	}
+
+	known, next, err := repo.ListWords(ctx, core.VocabularyFilter{UserID: "learner", Status: core.VocabularyStatusKnown, PageSize: 1})
+	if err != nil {
+		t.Fatalf("ListWords() error = %v", err)
+	}
+	if len(known) != 1 || known[0].Word != "milk" || next != "1" {
+		t.Fatalf("unexpected first page %#v next=%q", known, next)
+	}
+	known, _, err = repo.ListWords(ctx, core.VocabularyFilter{UserID: "learner", Status: core.VocabularyStatusKnown, PageSize: 1, PageToken: next})
+	if err != nil {
+		t.Fatalf("ListWords() error = %v", err)
+	}
+	if len(known) != 1 || known[0].Word != "espresso" || !known[0].CreatedAt.Before(known[0].UpdatedAt) || known[0].EpisodeID != episodeID {
+		t.Fatalf("expected espresso with its original creation time and episode, got %#v", known)
+	}
+
+	if err := repo.DeleteWords(ctx, "learner", []string{"espresso", "barista"}); err != nil {
+		t.Fatalf("DeleteWords() error = %v", err)
+	}
+	words, _, err := repo.ListWords(ctx, core.VocabularyFilter{UserID: "learner"})
+	if err != nil {
+		t.Fatalf("ListWords() error = %v", err)
+	}
+	if len(words) != 1 || words[0].Word != "milk" {
+		t.Fatalf("expected only milk to remain, got %#v", words)
+	}
+}
@@ -0,0 +1,241 @@
+package db
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entepisode "github.com/eslsoft/lession/internal/adapter/db/ent/generated/episode"
+	entplayback "github.com/eslsoft/lession/internal/adapter/db/ent/generated/playbacksession"
+	entschema "github.com/eslsoft/lession/internal/adapter/db/ent/schema"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// WatchHistoryRepository persists playback sessions using Ent.
+type WatchHistoryRepository struct {
+	client *entgenerated.Client
+}
+
+// NewWatchHistoryRepository constructs an Ent-backed watch history repository.
+func NewWatchHistoryRepository(client *entgenerated.Client) *WatchHistoryRepository {
+	return &WatchHistoryRepository{client: client}
+}
+
+var (
+	_ core.WatchHistoryRepository         = (*WatchHistoryRepository)(nil)
+	_ core.RecommendationSignalRepository = (*WatchHistoryRepository)(nil)
+)
+
+// CreatePlaybackSession stores a new playback session.
+func (r *WatchHistoryRepository) CreatePlaybackSession(ctx context.Context, session core.PlaybackSession) (*core.PlaybackSession, error) {
+	row, err := r.client.PlaybackSession.Create().
+		SetID(session.ID).
+		SetUserID(session.UserID).
+		SetEpisodeID(session.EpisodeID).
+		SetDevice(session.Device).
+		SetStartedAt(session.StartedAt).
+		SetNillableFinishedAt(session.FinishedAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainPlaybackSession(row), nil
+}
+
+// GetPlaybackSession loads a playback session by identifier.
+func (r *WatchHistoryRepository) GetPlaybackSession(ctx context.Context, id uuid.UUID) (*core.PlaybackSession, error) {
+	row, err := r.client.PlaybackSession.Get(ctx, id)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainPlaybackSession(row), nil
+}
+
+// FinishPlaybackSession records when a playback session ended.
+func (r *WatchHistoryRepository) FinishPlaybackSession(ctx context.Context, id uuid.UUID, finishedAt time.Time) (*core.PlaybackSession, error) {
+	row, err := r.client.PlaybackSession.UpdateOneID(id).
+		SetFinishedAt(finishedAt).
+		Save(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainPlaybackSession(row), nil
+}
+
+// ListPlaybackSessions returns sessions matching the filter, most recently started first.
+func (r *WatchHistoryRepository) ListPlaybackSessions(ctx context.Context, filter core.WatchHistoryFilter) ([]core.PlaybackSession, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	q := r.client.PlaybackSession.Query().
+		Where(entplayback.UserID(filter.UserID))
+	if filter.EpisodeID != uuid.Nil {
+		q = q.Where(entplayback.EpisodeID(filter.EpisodeID))
+	}
+	if !filter.StartedAfter.IsZero() {
+		q = q.Where(entplayback.StartedAtGTE(filter.StartedAfter))
+	}
+	if !filter.StartedBefore.IsZero() {
+		q = q.Where(entplayback.StartedAtLT(filter.StartedBefore))
+	}
+
+	rows, err := q.
+		Order(entplayback.ByStartedAt(sql.OrderDesc()), entplayback.ByID()).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return lo.Map(rows, func(row *entgenerated.PlaybackSession, _ int) core.PlaybackSession {
+		return *toDomainPlaybackSession(row)
+	}), nextToken, nil
+}
+
+// FirstFinishedAt returns the earliest finish time per episode among the learner's finished sessions.
+func (r *WatchHistoryRepository) FirstFinishedAt(ctx context.Context, userID string, episodeIDs []uuid.UUID) (map[uuid.UUID]time.Time, error) {
+	finished := make(map[uuid.UUID]time.Time)
+	if len(episodeIDs) == 0 {
+		return finished, nil
+	}
+
+	rows, err := r.client.PlaybackSession.Query().
+		Where(
+			entplayback.UserID(userID),
+			entplayback.EpisodeIDIn(episodeIDs...),
+			entplayback.FinishedAtNotNil(),
+		).
+		Order(entplayback.ByFinishedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		if _, ok := finished[row.EpisodeID]; !ok {
+			finished[row.EpisodeID] = row.FinishedAt.UTC()
+		}
+	}
+	return finished, nil
+}
+
+// DeletePlaybackSessions removes a learner's sessions started before the cutoff, or all when it is zero.
+func (r *WatchHistoryRepository) DeletePlaybackSessions(ctx context.Context, userID string, before time.Time) (int, error) {
+	q := r.client.PlaybackSession.Delete().
+		Where(entplayback.UserID(userID))
+	if !before.IsZero() {
+		q = q.Where(entplayback.StartedAtLT(before))
+	}
+	return q.Exec(ctx)
+}
+
+// ListWatchedSeriesIDs returns the series of every episode the learner has finished.
+func (r *WatchHistoryRepository) ListWatchedSeriesIDs(ctx context.Context, userID string) ([]uuid.UUID, error) {
+	var episodeIDs []uuid.UUID
+	err := r.client.PlaybackSession.Query().
+		Where(
+			entplayback.UserID(userID),
+			entplayback.FinishedAtNotNil(),
+		).
+		Unique(true).
+		Select(entplayback.FieldEpisodeID).
+		Scan(ctx, &episodeIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(episodeIDs) == 0 {
+		return nil, nil
+	}
+
+	var seriesIDs []uuid.UUID
+	err = r.client.Episode.Query().
+		Where(entepisode.IDIn(episodeIDs...)).
+		Unique(true).
+		Select(entepisode.FieldSeriesID).
+		Scan(entschema.IncludeDeleted(ctx), &seriesIDs)
+	if err != nil {
+		return nil, err
+	}
+	return seriesIDs, nil
+}
+
+// episodePlayCount scans grouped playback session counts.
+type episodePlayCount struct {
+	EpisodeID uuid.UUID `json:"episode_id"`
+	Count     int       `json:"count"`
+}
+
+// CountSeriesPlays sums playback sessions started since the cutoff per series.
+func (r *WatchHistoryRepository) CountSeriesPlays(ctx context.Context, since time.Time) (map[uuid.UUID]int, error) {
+	var perEpisode []episodePlayCount
+	err := r.client.PlaybackSession.Query().
+		Where(entplayback.StartedAtGTE(since)).
+		GroupBy(entplayback.FieldEpisodeID).
+		Aggregate(entgenerated.Count()).
+		Scan(ctx, &perEpisode)
+	if err != nil {
+		return nil, err
+	}
+	if len(perEpisode) == 0 {
+		return map[uuid.UUID]int{}, nil
+	}
+
+	// Plays of deleted episodes still count toward their series.
+	episodes, err := r.client.Episode.Query().
+		Where(entepisode.IDIn(lo.Map(perEpisode, func(row episodePlayCount, _ int) uuid.UUID {
+			return row.EpisodeID
+		})...)).
+		Select(entepisode.FieldID, entepisode.FieldSeriesID).
+		All(entschema.IncludeDeleted(ctx))
+	if err != nil {
+		return nil, err
+	}
+	seriesByEpisode := lo.SliceToMap(episodes, func(row *entgenerated.Episode) (uuid.UUID, uuid.UUID) {
+		return row.ID, row.SeriesID
+	})
+
+	plays := make(map[uuid.UUID]int)
+	for _, row := range perEpisode {
+		if seriesID, ok := seriesByEpisode[row.EpisodeID]; ok {
+			plays[seriesID] += row.Count
+		}
+	}
+	return plays, nil
+}
+
+func toDomainPlaybackSession(row *entgenerated.PlaybackSession) *core.PlaybackSession {
+	return &core.PlaybackSession{
+		ID:         row.ID,
+		UserID:     row.UserID,
+		EpisodeID:  row.EpisodeID,
+		Device:     row.Device,
+		StartedAt:  row.StartedAt.UTC(),
+		FinishedAt: row.FinishedAt,
+		CreatedAt:  row.CreatedAt,
+	}
+}
@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestWatchHistoryRepository_ListAndClear(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "watch_history_repo")
+	repo := NewWatchHistoryRepository(client)
+
+	day := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	episodeID := uuid.New()
+	for i := range 3 {
+		started := day.Add(time.Duration(i) * 24 * time.Hour)
+		_, err := repo.CreatePlaybackSession(ctx, core.PlaybackSession{
+			ID:        uuid.New(),
+			UserID:    "learner",
+			EpisodeID: episodeID,
+			Device:    "web",
+			StartedAt: started,
+		})
+		if err != nil {
+			t.Fatalf("CreatePlaybackSession() error = %v", err)
+		}
+	}
+	_, err := repo.CreatePlaybackSession(ctx, core.PlaybackSession{ID: uuid.New(), UserID: "other", EpisodeID: episodeID, StartedAt: day})
+	if err != nil {
+		t.Fatalf("CreatePlaybackSession() error = %v", err)
+	}
+
+	sessions, next, err := repo.ListPlaybackSessions(ctx, core.WatchHistoryFilter{
+		UserID:        "learner",
+		StartedAfter:  day.Add(24 * time.Hour),
+		StartedBefore: day.Add(72 * time.Hour),
+		PageSize:      1,
+	})
+	if err != nil {
+		t.Fatalf("ListPlaybackSessions() error = %v", err)
+	}
+	if len(sessions) != 1 || next == "" || !sessions[0].StartedAt.Equal(day.Add(48*time.Hour)) {
+		t.Fatalf("expected newest session in range first, got %#v (next %q)", sessions, next)
+	}
+
+	deleted, err := repo.DeletePlaybackSessions(ctx, "learner", day.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("DeletePlaybackSessions() error = %v", err)
+	}
+	if deleted != 1 {
+		t.Fatalf("expected 1 session deleted before cutoff, got %d", deleted)
+	}
+
+	deleted, err = repo.DeletePlaybackSessions(ctx, "learner", time.Time{})
+	if err != nil {
+		t.Fatalf("DeletePlaybackSessions() error = %v", err)
+	}
+	if deleted != 2 {
+		t.Fatalf("expected remaining 2 sessions deleted, got %d", deleted)
+	}
+
+	others, _, err := repo.ListPlaybackSessions(ctx, core.WatchHistoryFilter{UserID: "other"})
+	if err != nil {
+		t.Fatalf("ListPlaybackSessions() error = %v", err)
+	}
+	if len(others) != 1 {
+		t.Fatalf("expected other learner's history untouched, got %d", len(others))
+	}
+}
+
+func TestWatchHistoryRepository_RecommendationSignals(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "watch_history_repo")
+	repo := NewWatchHistoryRepository(client)
+
+	seriesRepo := NewSeriesRepository(client)
+	seriesIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	episodeIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	for i, slug := range []string{"travel", "business"} {
+		episodes := []core.Episode{{ID: episodeIDs[i], SeriesID: seriesIDs[i], Seq: 1, Title: slug, Status: core.EpisodeStatusPublished}}
+		if i == 0 {
+			episodes = append(episodes, core.Episode{ID: episodeIDs[2], SeriesID: seriesIDs[i], Seq: 2, Title: slug + " 2", Status: core.EpisodeStatusPublished})
+		}
+		createSeriesForTest(t, seriesRepo, ctx, core.Series{ID: seriesIDs[i], Slug: slug, Title: slug, Status: core.SeriesStatusPublished, Episodes: episodes})
+	}
+
+	now := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	record := func(userID string, episodeID uuid.UUID, started time.Time, finished bool) {
+		session := core.PlaybackSession{ID: uuid.New(), UserID: userID, EpisodeID: episodeID, StartedAt: started}
+		if finished {
+			session.FinishedAt = &started
+		}
+		if _, err := repo.CreatePlaybackSession(ctx, session); err != nil {
+			t.Fatalf("CreatePlaybackSession() error = %v", err)
+		}
+	}
+	record("learner", episodeIDs[0], now, true)
+	record("learner", episodeIDs[2], now, true)
+	record("learner", episodeIDs[1], now, false)
+	record("other", episodeIDs[1], now.Add(-90*24*time.Hour), true)
+
+	watched, err := repo.ListWatchedSeriesIDs(ctx, "learner")
+	if err != nil {
+		t.Fatalf("ListWatchedSeriesIDs() error = %v", err)
+	}
+	if len(watched) != 1 || watched[0] != seriesIDs[0] {
+		t.Fatalf("expected only the finished series, got %v", watched)
+	}
+
+	plays, err := repo.CountSeriesPlays(ctx, now.Add(-24*time.Hour))
+	if err != nil {
+		t.Fatalf("CountSeriesPlays() error = %v", err)
+	}
+	if plays[seriesIDs[0]] != 2 || plays[seriesIDs[1]] != 1 {
+		t.Fatalf("unexpected play counts %v", plays)
+	}
+}
@@ -0,0 +1,358 @@
+package db
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	entwebhookdelivery "github.com/eslsoft/lession/internal/adapter/db/ent/generated/webhookdelivery"
+	entwebhookendpoint "github.com/eslsoft/lession/internal/adapter/db/ent/generated/webhookendpoint"
+	entwebhookevent "github.com/eslsoft/lession/internal/adapter/db/ent/generated/webhookevent"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// WebhookRepository persists webhook endpoints, deliveries and recent events using Ent.
+type WebhookRepository struct {
+	client *entgenerated.Client
+}
+
+// NewWebhookRepository constructs an Ent-backed webhook repository.
+func NewWebhookRepository(client *entgenerated.Client) *WebhookRepository {
+	return &WebhookRepository{client: client}
+}
+
+var _ core.WebhookRepository = (*WebhookRepository)(nil)
+
+// CreateWebhookEndpoint stores a new endpoint.
+func (r *WebhookRepository) CreateWebhookEndpoint(ctx context.Context, endpoint core.WebhookEndpoint) (*core.WebhookEndpoint, error) {
+	row, err := r.client.WebhookEndpoint.Create().
+		SetID(endpoint.ID).
+		SetURL(endpoint.URL).
+		SetDescription(endpoint.Description).
+		SetEventTypes(endpoint.EventTypes).
+		SetSecret(endpoint.Secret).
+		SetEnabled(endpoint.Enabled).
+		Save(ctx)
+	if err != nil {
+		if entgenerated.IsConstraintError(err) {
+			return nil, core.ErrAlreadyExists
+		}
+		return nil, err
+	}
+	return toDomainWebhookEndpoint(row), nil
+}
+
+// GetWebhookEndpoint loads an endpoint by identifier.
+func (r *WebhookRepository) GetWebhookEndpoint(ctx context.Context, id uuid.UUID) (*core.WebhookEndpoint, error) {
+	row, err := r.client.WebhookEndpoint.Get(ctx, id)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainWebhookEndpoint(row), nil
+}
+
+// UpdateWebhookEndpoint persists endpoint settings; the secret is immutable.
+func (r *WebhookRepository) UpdateWebhookEndpoint(ctx context.Context, endpoint core.WebhookEndpoint) (*core.WebhookEndpoint, error) {
+	row, err := r.client.WebhookEndpoint.UpdateOneID(endpoint.ID).
+		SetURL(endpoint.URL).
+		SetDescription(endpoint.Description).
+		SetEventTypes(endpoint.EventTypes).
+		SetEnabled(endpoint.Enabled).
+		Save(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainWebhookEndpoint(row), nil
+}
+
+// DeleteWebhookEndpoint removes an endpoint and its delivery log.
+func (r *WebhookRepository) DeleteWebhookEndpoint(ctx context.Context, id uuid.UUID) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.WebhookDelivery.Delete().Where(entwebhookdelivery.EndpointID(id)).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.WebhookEndpoint.DeleteOneID(id).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		if entgenerated.IsNotFound(err) {
+			return core.ErrNotFound
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ListWebhookEndpoints returns endpoints in registration order.
+func (r *WebhookRepository) ListWebhookEndpoints(ctx context.Context, filter core.WebhookEndpointListFilter) ([]core.WebhookEndpoint, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	rows, err := r.client.WebhookEndpoint.Query().
+		Order(entwebhookendpoint.ByCreatedAt(), entwebhookendpoint.ByID()).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return lo.Map(rows, func(row *entgenerated.WebhookEndpoint, _ int) core.WebhookEndpoint {
+		return *toDomainWebhookEndpoint(row)
+	}), nextToken, nil
+}
+
+// ListEnabledWebhookEndpoints returns every endpoint that accepts deliveries.
+func (r *WebhookRepository) ListEnabledWebhookEndpoints(ctx context.Context) ([]core.WebhookEndpoint, error) {
+	rows, err := r.client.WebhookEndpoint.Query().
+		Where(entwebhookendpoint.Enabled(true)).
+		Order(entwebhookendpoint.ByCreatedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(row *entgenerated.WebhookEndpoint, _ int) core.WebhookEndpoint {
+		return *toDomainWebhookEndpoint(row)
+	}), nil
+}
+
+// CreateWebhookDeliveries stores pending deliveries in one batch.
+func (r *WebhookRepository) CreateWebhookDeliveries(ctx context.Context, deliveries []core.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	builders := lo.Map(deliveries, func(delivery core.WebhookDelivery, _ int) *entgenerated.WebhookDeliveryCreate {
+		return r.client.WebhookDelivery.Create().
+			SetID(delivery.ID).
+			SetEndpointID(delivery.EndpointID).
+			SetEventID(delivery.EventID).
+			SetEventType(int(delivery.EventType)).
+			SetPayload(delivery.Payload).
+			SetStatus(int(delivery.Status)).
+			SetAttempts(delivery.Attempts).
+			SetNillableNextAttemptAt(delivery.NextAttemptAt)
+	})
+	if err := r.client.WebhookDelivery.CreateBulk(builders...).Exec(ctx); err != nil {
+		if entgenerated.IsConstraintError(err) {
+			return core.ErrAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+// GetWebhookDelivery loads a delivery by identifier.
+func (r *WebhookRepository) GetWebhookDelivery(ctx context.Context, id uuid.UUID) (*core.WebhookDelivery, error) {
+	row, err := r.client.WebhookDelivery.Get(ctx, id)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainWebhookDelivery(row), nil
+}
+
+// UpdateWebhookDelivery records the outcome of a delivery attempt.
+func (r *WebhookRepository) UpdateWebhookDelivery(ctx context.Context, delivery core.WebhookDelivery) (*core.WebhookDelivery, error) {
+	builder := r.client.WebhookDelivery.UpdateOneID(delivery.ID).
+		SetStatus(int(delivery.Status)).
+		SetAttempts(delivery.Attempts).
+		SetResponseStatus(delivery.ResponseStatus).
+		SetError(delivery.Error).
+		SetNillableDeliveredAt(delivery.DeliveredAt)
+	if delivery.NextAttemptAt != nil {
+		builder.SetNextAttemptAt(*delivery.NextAttemptAt)
+	} else {
+		builder.ClearNextAttemptAt()
+	}
+
+	row, err := builder.Save(ctx)
+	if err != nil {
+		if entgenerated.IsNotFound(err) {
+			return nil, core.ErrNotFound
+		}
+		return nil, err
+	}
+	return toDomainWebhookDelivery(row), nil
+}
+
+// ListWebhookDeliveries returns deliveries matching the filter, newest first.
+func (r *WebhookRepository) ListWebhookDeliveries(ctx context.Context, filter core.WebhookDeliveryListFilter) ([]core.WebhookDelivery, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	q := r.client.WebhookDelivery.Query()
+	if filter.EndpointID != uuid.Nil {
+		q = q.Where(entwebhookdelivery.EndpointID(filter.EndpointID))
+	}
+	if len(filter.Statuses) > 0 {
+		q = q.Where(entwebhookdelivery.StatusIn(lo.Map(filter.Statuses, func(status core.WebhookDeliveryStatus, _ int) int {
+			return int(status)
+		})...))
+	}
+
+	rows, err := q.
+		Order(entwebhookdelivery.ByCreatedAt(sql.OrderDesc()), entwebhookdelivery.ByID()).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return lo.Map(rows, func(row *entgenerated.WebhookDelivery, _ int) core.WebhookDelivery {
+		return *toDomainWebhookDelivery(row)
+	}), nextToken, nil
+}
+
+// ListDueWebhookDeliveries returns pending deliveries due for another attempt, oldest first.
+func (r *WebhookRepository) ListDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]core.WebhookDelivery, error) {
+	rows, err := r.client.WebhookDelivery.Query().
+		Where(
+			entwebhookdelivery.Status(int(core.WebhookDeliveryStatusPending)),
+			entwebhookdelivery.NextAttemptAtLTE(now),
+		).
+		Order(entwebhookdelivery.ByNextAttemptAt(), entwebhookdelivery.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(row *entgenerated.WebhookDelivery, _ int) core.WebhookDelivery {
+		return *toDomainWebhookDelivery(row)
+	}), nil
+}
+
+// CreateWebhookEvent records an event for ListWebhookEvents.
+func (r *WebhookRepository) CreateWebhookEvent(ctx context.Context, event core.WebhookEvent) error {
+	return r.client.WebhookEvent.Create().
+		SetID(event.ID).
+		SetEventType(int(event.Type)).
+		SetPayload(event.Payload).
+		Exec(ctx)
+}
+
+// ListWebhookEvents returns events matching the filter, newest first.
+func (r *WebhookRepository) ListWebhookEvents(ctx context.Context, filter core.WebhookEventListFilter) ([]core.WebhookEvent, string, error) {
+	offset, err := parseOffsetToken(filter.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	q := r.client.WebhookEvent.Query()
+	if len(filter.Types) > 0 {
+		q = q.Where(entwebhookevent.EventTypeIn(lo.Map(filter.Types, func(eventType core.WebhookEventType, _ int) int {
+			return int(eventType)
+		})...))
+	}
+
+	rows, err := q.
+		Order(entwebhookevent.ByCreatedAt(sql.OrderDesc()), entwebhookevent.ByID()).
+		Offset(offset).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		nextToken = strconv.Itoa(offset + pageSize)
+	}
+
+	return lo.Map(rows, func(row *entgenerated.WebhookEvent, _ int) core.WebhookEvent {
+		return core.WebhookEvent{
+			ID:        row.ID,
+			Type:      core.WebhookEventType(row.EventType),
+			Payload:   row.Payload,
+			CreatedAt: row.CreatedAt,
+		}
+	}), nextToken, nil
+}
+
+// DeleteWebhookEventsBefore removes the events created before the given time.
+func (r *WebhookRepository) DeleteWebhookEventsBefore(ctx context.Context, before time.Time) (int, error) {
+	return r.client.WebhookEvent.Delete().
+		Where(entwebhookevent.CreatedAtLT(before)).
+		Exec(ctx)
+}
+
+func toDomainWebhookEndpoint(row *entgenerated.WebhookEndpoint) *core.WebhookEndpoint {
+	return &core.WebhookEndpoint{
+		ID:          row.ID,
+		URL:         row.URL,
+		Description: row.Description,
+		EventTypes:  row.EventTypes,
+		Secret:      row.Secret,
+		Enabled:     row.Enabled,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+func toDomainWebhookDelivery(row *entgenerated.WebhookDelivery) *core.WebhookDelivery {
+	return &core.WebhookDelivery{
+		ID:             row.ID,
+		EndpointID:     row.EndpointID,
+		EventID:        row.EventID,
+		EventType:      core.WebhookEventType(row.EventType),
+		Payload:        row.Payload,
+		Status:         core.WebhookDeliveryStatus(row.Status),
+		Attempts:       row.Attempts,
+		ResponseStatus: row.ResponseStatus,
+		Error:          row.Error,
+		NextAttemptAt:  row.NextAttemptAt,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+		DeliveredAt:    row.DeliveredAt,
+	}
+}
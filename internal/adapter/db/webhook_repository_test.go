@@ -0,0 +1,167 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestWebhookRepository_Endpoints(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "webhook_repo")
+	repo := NewWebhookRepository(client)
+
+	endpoint, err := repo.CreateWebhookEndpoint(ctx, core.WebhookEndpoint{
+		ID:         uuid.New(),
+		URL:        "https://hooks.example.com/lession",
+		EventTypes: []core.WebhookEventType{core.WebhookEventTypeSeriesPublished},
+		Secret:     "whsec_test",
+		Enabled:    true,
+	})
+	if err != nil {
+		t.Fatalf("CreateWebhookEndpoint() error = %v", err)
+	}
+	if _, err := repo.CreateWebhookEndpoint(ctx, core.WebhookEndpoint{
+		ID: uuid.New(), URL: "https://other.example.com", Secret: "whsec_other",
+	}); err != nil {
+		t.Fatalf("CreateWebhookEndpoint() error = %v", err)
+	}
+
+	enabled, err := repo.ListEnabledWebhookEndpoints(ctx)
+	if err != nil {
+		t.Fatalf("ListEnabledWebhookEndpoints() error = %v", err)
+	}
+	if len(enabled) != 1 || enabled[0].ID != endpoint.ID || !enabled[0].Subscribes(core.WebhookEventTypeSeriesPublished) || enabled[0].Secret != "whsec_test" {
+		t.Fatalf("unexpected enabled endpoints %+v", enabled)
+	}
+
+	endpoint.EventTypes = nil
+	endpoint.Description = "catalog sync"
+	if _, err := repo.UpdateWebhookEndpoint(ctx, *endpoint); err != nil {
+		t.Fatalf("UpdateWebhookEndpoint() error = %v", err)
+	}
+
+	listed, next, err := repo.ListWebhookEndpoints(ctx, core.WebhookEndpointListFilter{PageSize: 1})
+	if err != nil {
+		t.Fatalf("ListWebhookEndpoints() error = %v", err)
+	}
+	if len(listed) != 1 || next != "1" || listed[0].Description != "catalog sync" || !listed[0].Subscribes(core.WebhookEventTypeAssetReady) {
+		t.Fatalf("unexpected endpoints %+v (next %q)", listed, next)
+	}
+}
+
+func TestWebhookRepository_Deliveries(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "webhook_repo")
+	repo := NewWebhookRepository(client)
+
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	endpoint, err := repo.CreateWebhookEndpoint(ctx, core.WebhookEndpoint{
+		ID: uuid.New(), URL: "https://hooks.example.com", Secret: "whsec_test", Enabled: true,
+	})
+	if err != nil {
+		t.Fatalf("CreateWebhookEndpoint() error = %v", err)
+	}
+
+	due := now.Add(-time.Minute)
+	later := now.Add(time.Hour)
+	deliveries := []core.WebhookDelivery{
+		{ID: uuid.New(), EndpointID: endpoint.ID, EventID: uuid.New(), EventType: core.WebhookEventTypeAssetReady, Payload: []byte(`{}`), Status: core.WebhookDeliveryStatusPending, NextAttemptAt: &due},
+		{ID: uuid.New(), EndpointID: endpoint.ID, EventID: uuid.New(), EventType: core.WebhookEventTypeAssetReady, Payload: []byte(`{}`), Status: core.WebhookDeliveryStatusPending, NextAttemptAt: &later},
+	}
+	if err := repo.CreateWebhookDeliveries(ctx, deliveries); err != nil {
+		t.Fatalf("CreateWebhookDeliveries() error = %v", err)
+	}
+	duplicate := deliveries[0]
+	duplicate.ID = uuid.New()
+	if err := repo.CreateWebhookDeliveries(ctx, []core.WebhookDelivery{duplicate}); !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected ErrAlreadyExists for a repeated event, got %v", err)
+	}
+
+	dueDeliveries, err := repo.ListDueWebhookDeliveries(ctx, now, 10)
+	if err != nil {
+		t.Fatalf("ListDueWebhookDeliveries() error = %v", err)
+	}
+	if len(dueDeliveries) != 1 || dueDeliveries[0].ID != deliveries[0].ID {
+		t.Fatalf("unexpected due deliveries %+v", dueDeliveries)
+	}
+
+	delivered := dueDeliveries[0]
+	delivered.Status = core.WebhookDeliveryStatusSucceeded
+	delivered.Attempts = 1
+	delivered.ResponseStatus = 204
+	delivered.NextAttemptAt = nil
+	delivered.DeliveredAt = &now
+	if _, err := repo.UpdateWebhookDelivery(ctx, delivered); err != nil {
+		t.Fatalf("UpdateWebhookDelivery() error = %v", err)
+	}
+
+	succeeded, _, err := repo.ListWebhookDeliveries(ctx, core.WebhookDeliveryListFilter{
+		EndpointID: endpoint.ID,
+		Statuses:   []core.WebhookDeliveryStatus{core.WebhookDeliveryStatusSucceeded},
+	})
+	if err != nil {
+		t.Fatalf("ListWebhookDeliveries() error = %v", err)
+	}
+	if len(succeeded) != 1 || succeeded[0].ResponseStatus != 204 || succeeded[0].NextAttemptAt != nil || succeeded[0].DeliveredAt == nil {
+		t.Fatalf("unexpected delivery log %+v", succeeded)
+	}
+
+	if err := repo.DeleteWebhookEndpoint(ctx, endpoint.ID); err != nil {
+		t.Fatalf("DeleteWebhookEndpoint() error = %v", err)
+	}
+	if _, err := repo.GetWebhookDelivery(ctx, deliveries[1].ID); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected deliveries to be removed with the endpoint, got %v", err)
+	}
+}
+
+func TestWebhookRepository_Events(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, "webhook_repo")
+	repo := NewWebhookRepository(client)
+
+	var cutoff time.Time
+	ids := make([]uuid.UUID, 3)
+	for i, eventType := range []core.WebhookEventType{
+		core.WebhookEventTypeAssetReady,
+		core.WebhookEventTypeEpisodePublished,
+		core.WebhookEventTypeEpisodePublished,
+	} {
+		if i == len(ids)-1 {
+			cutoff = time.Now()
+		}
+		ids[i] = uuid.New()
+		if err := repo.CreateWebhookEvent(ctx, core.WebhookEvent{
+			ID:      ids[i],
+			Type:    eventType,
+			Payload: []byte(`{"data":{}}`),
+		}); err != nil {
+			t.Fatalf("CreateWebhookEvent() error = %v", err)
+		}
+	}
+
+	published, next, err := repo.ListWebhookEvents(ctx, core.WebhookEventListFilter{
+		Types:    []core.WebhookEventType{core.WebhookEventTypeEpisodePublished},
+		PageSize: 1,
+	})
+	if err != nil {
+		t.Fatalf("ListWebhookEvents() error = %v", err)
+	}
+	if len(published) != 1 || published[0].ID != ids[2] || next == "" {
+		t.Fatalf("expected the newest published event and a next page, got %+v %q", published, next)
+	}
+
+	deleted, err := repo.DeleteWebhookEventsBefore(ctx, cutoff)
+	if err != nil || deleted != 2 {
+		t.Fatalf("DeleteWebhookEventsBefore() = %d, %v; want 2", deleted, err)
+	}
+	remaining, _, err := repo.ListWebhookEvents(ctx, core.WebhookEventListFilter{})
+	if err != nil || len(remaining) != 1 {
+		t.Fatalf("expected one remaining event, got %+v, %v", remaining, err)
+	}
+}
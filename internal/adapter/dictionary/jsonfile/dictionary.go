@@ -0,0 +1,129 @@
+// Package jsonfile looks words up in a dictionary loaded from a JSON file.
+package jsonfile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// ProviderName identifies the dictionary in configuration.
+const ProviderName = "jsonfile"
+
+// file is the document a dictionary is loaded from, for example:
+//
+//	{
+//	  "language": "en",
+//	  "entries": [
+//	    {"word": "run", "forms": ["ran", "running", "runs"], "part_of_speech": "verb",
+//	     "definition": "to move quickly on foot", "translations": {"es": "correr"}}
+//	  ]
+//	}
+type file struct {
+	Language string      `json:"language"`
+	Entries  []fileEntry `json:"entries"`
+}
+
+type fileEntry struct {
+	Word         string            `json:"word"`
+	Forms        []string          `json:"forms"`
+	PartOfSpeech string            `json:"part_of_speech"`
+	Definition   string            `json:"definition"`
+	Translations map[string]string `json:"translations"`
+}
+
+// Dictionary implements core.DictionaryProvider for the words of one
+// language. Inflected forms listed with an entry resolve to it, with the
+// entry's word as their lemma.
+type Dictionary struct {
+	language string
+	words    map[string]*fileEntry
+}
+
+// LoadFile reads a dictionary from path.
+func LoadFile(path string) (*Dictionary, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("jsonfile: %w", err)
+	}
+	defer f.Close()
+	return parse(f)
+}
+
+func parse(r io.Reader) (*Dictionary, error) {
+	var doc file
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("jsonfile: %w", err)
+	}
+	d := &Dictionary{language: primaryLanguage(doc.Language), words: map[string]*fileEntry{}}
+	// Forms are indexed after every headword, so a form never shadows a
+	// word with an entry of its own.
+	for i := range doc.Entries {
+		entry := &doc.Entries[i]
+		entry.Word = strings.ToLower(strings.TrimSpace(entry.Word))
+		if entry.Word == "" {
+			return nil, fmt.Errorf("jsonfile: entry %d has no word", i)
+		}
+		if _, ok := d.words[entry.Word]; !ok {
+			d.words[entry.Word] = entry
+		}
+	}
+	for i := range doc.Entries {
+		for _, form := range doc.Entries[i].Forms {
+			form = strings.ToLower(strings.TrimSpace(form))
+			if _, ok := d.words[form]; !ok && form != "" {
+				d.words[form] = &doc.Entries[i]
+			}
+		}
+	}
+	return d, nil
+}
+
+var _ core.DictionaryProvider = (*Dictionary)(nil)
+
+// LookupWords implements core.DictionaryProvider. Words in a language other
+// than the dictionary's are not found.
+func (d *Dictionary) LookupWords(_ context.Context, sourceLanguage, targetLanguage string, words []string) (map[string]core.DictionaryEntry, error) {
+	entries := map[string]core.DictionaryEntry{}
+	if source := primaryLanguage(sourceLanguage); source != "" && d.language != "" && source != d.language {
+		return entries, nil
+	}
+	for _, word := range words {
+		entry, ok := d.words[word]
+		if !ok {
+			continue
+		}
+		entries[word] = core.DictionaryEntry{
+			Word:         word,
+			Lemma:        entry.Word,
+			PartOfSpeech: entry.PartOfSpeech,
+			Definition:   entry.Definition,
+			Translation:  translation(entry.Translations, targetLanguage),
+		}
+	}
+	return entries, nil
+}
+
+// translation prefers an exact match for the language tag, then its
+// primary language, so "pt-BR" falls back to "pt".
+func translation(translations map[string]string, language string) string {
+	if language == "" {
+		return ""
+	}
+	for tag, text := range translations {
+		if strings.EqualFold(tag, language) {
+			return text
+		}
+	}
+	return translations[primaryLanguage(language)]
+}
+
+func primaryLanguage(tag string) string {
+	primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+	return primary
+}
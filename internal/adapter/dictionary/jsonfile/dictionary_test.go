@@ -0,0 +1,83 @@
+package jsonfile
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const testDictionary = `{
+  "language": "en",
+  "entries": [
+    {"word": "Run", "forms": ["ran", "running", "runs"], "part_of_speech": "verb",
+     "definition": "to move quickly on foot", "translations": {"es": "correr", "pt": "correr", "pt-BR": "correr (BR)"}},
+    {"word": "running", "part_of_speech": "noun", "definition": "the sport of racing on foot"}
+  ]
+}`
+
+func TestDictionary_LookupWords(t *testing.T) {
+	dictionary, err := parse(strings.NewReader(testDictionary))
+	if err != nil {
+		t.Fatalf("parse() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		source string
+		target string
+		words  []string
+		want   map[string]core.DictionaryEntry
+	}{
+		{
+			name:   "form resolves to its lemma",
+			source: "en-GB",
+			target: "es",
+			words:  []string{"ran", "coffee"},
+			want: map[string]core.DictionaryEntry{
+				"ran": {Word: "ran", Lemma: "run", PartOfSpeech: "verb", Definition: "to move quickly on foot", Translation: "correr"},
+			},
+		},
+		{
+			name:   "headword wins over form",
+			source: "en",
+			words:  []string{"running"},
+			want: map[string]core.DictionaryEntry{
+				"running": {Word: "running", Lemma: "running", PartOfSpeech: "noun", Definition: "the sport of racing on foot"},
+			},
+		},
+		{
+			name:   "regional translation",
+			target: "PT-br",
+			words:  []string{"runs"},
+			want: map[string]core.DictionaryEntry{
+				"runs": {Word: "runs", Lemma: "run", PartOfSpeech: "verb", Definition: "to move quickly on foot", Translation: "correr (BR)"},
+			},
+		},
+		{
+			name:   "other language",
+			source: "fr",
+			words:  []string{"run"},
+			want:   map[string]core.DictionaryEntry{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := dictionary.LookupWords(context.Background(), tt.source, tt.target, tt.words)
+			if err != nil {
+				t.Fatalf("LookupWords() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("LookupWords() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_RejectsEntryWithoutWord(t *testing.T) {
+	if _, err := parse(strings.NewReader(`{"entries": [{"definition": "nothing"}]}`)); err == nil {
+		t.Fatal("expected an entry without a word to be rejected")
+	}
+}
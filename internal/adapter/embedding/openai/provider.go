@@ -0,0 +1,130 @@
+// Package openai embeds text with the embeddings API of OpenAI or any
+// service compatible with it.
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// ProviderName identifies the provider in configuration.
+	ProviderName = "openai"
+	// DefaultBaseURL is OpenAI's production API endpoint.
+	DefaultBaseURL = "https://api.openai.com/v1"
+	// DefaultModel is used when no model is configured.
+	DefaultModel = "text-embedding-3-small"
+
+	maxErrorBodySize = 4096
+)
+
+// Provider implements core.EmbeddingProvider with an embeddings model.
+type Provider struct {
+	baseURL    string
+	apiKey     string
+	model      string
+	httpClient *http.Client
+}
+
+// NewProvider constructs a provider authenticating with apiKey. model falls
+// back to DefaultModel when empty.
+func NewProvider(apiKey, model string) (*Provider, error) {
+	if apiKey == "" {
+		return nil, errors.New("openai: api key is required")
+	}
+	if model == "" {
+		model = DefaultModel
+	}
+	return &Provider{
+		baseURL:    DefaultBaseURL,
+		apiKey:     apiKey,
+		model:      model,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// WithBaseURL points the provider at a compatible API, or a test server.
+func (p *Provider) WithBaseURL(baseURL string) {
+	if baseURL != "" {
+		p.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithHTTPClient overrides the HTTP client used for API requests.
+func (p *Provider) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		p.httpClient = client
+	}
+}
+
+var _ core.EmbeddingProvider = (*Provider)(nil)
+
+// Model names the model vectors are produced by.
+func (p *Provider) Model() string {
+	return p.model
+}
+
+// EmbedTexts returns one vector per text, in the order of texts.
+func (p *Provider) EmbedTexts(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+	payload, err := json.Marshal(map[string]any{
+		"model": p.model,
+		"input": texts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/embeddings", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+p.apiKey)
+
+	resp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("openai: embeddings: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return nil, fmt.Errorf("openai: embeddings: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var result struct {
+		Data []struct {
+			Index     int       `json:"index"`
+			Embedding []float32 `json:"embedding"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("openai: decode response: %w", err)
+	}
+
+	// The API reports each vector's input index; don't rely on the order.
+	vectors := make([][]float32, len(texts))
+	for _, item := range result.Data {
+		if item.Index < 0 || item.Index >= len(texts) || vectors[item.Index] != nil {
+			return nil, fmt.Errorf("openai: unexpected embedding index %d", item.Index)
+		}
+		vectors[item.Index] = item.Embedding
+	}
+	for i, vector := range vectors {
+		if len(vector) == 0 {
+			return nil, fmt.Errorf("openai: no embedding for input %d", i)
+		}
+	}
+	return vectors, nil
+}
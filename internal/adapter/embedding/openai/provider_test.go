@@ -0,0 +1,75 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestProvider_EmbedTexts(t *testing.T) {
+	var request struct {
+		Model string   `json:"model"`
+		Input []string `json:"input"`
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /embeddings", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		// Answer out of order to check vectors are matched up by index.
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": []any{
+				map[string]any{"index": 1, "embedding": []float32{0, 1}},
+				map[string]any{"index": 0, "embedding": []float32{1, 0}},
+			},
+		})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	provider, err := NewProvider("secret", "")
+	if err != nil {
+		t.Fatalf("NewProvider() error = %v", err)
+	}
+	provider.WithBaseURL(server.URL)
+
+	vectors, err := provider.EmbedTexts(context.Background(), []string{"coffee", "trains"})
+	if err != nil {
+		t.Fatalf("EmbedTexts() error = %v", err)
+	}
+	if want := [][]float32{{1, 0}, {0, 1}}; !reflect.DeepEqual(vectors, want) {
+		t.Fatalf("EmbedTexts() = %v, want %v", vectors, want)
+	}
+	if request.Model != DefaultModel || !reflect.DeepEqual(request.Input, []string{"coffee", "trains"}) {
+		t.Fatalf("unexpected request %+v", request)
+	}
+	if provider.Model() != DefaultModel {
+		t.Fatalf("Model() = %q", provider.Model())
+	}
+}
+
+func TestProvider_EmbedTextsMissingVector(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": []any{map[string]any{"index": 0, "embedding": []float32{1, 0}}},
+		})
+	}))
+	defer server.Close()
+
+	provider, _ := NewProvider("secret", "")
+	provider.WithBaseURL(server.URL)
+	if _, err := provider.EmbedTexts(context.Background(), []string{"coffee", "trains"}); err == nil {
+		t.Fatal("expected an error when a vector is missing")
+	}
+}
+
+func TestNewProvider(t *testing.T) {
+	if _, err := NewProvider("", ""); err == nil {
+		t.Fatal("expected a missing api key to be rejected")
+	}
+}
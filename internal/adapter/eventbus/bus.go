@@ -0,0 +1,149 @@
+// Package eventbus dispatches domain events to in-process subscribers and,
+// optionally, appends them to the event log.
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// listenerBuffer is how many events a listener may fall behind by before
+// further events are dropped for it.
+const listenerBuffer = 64
+
+// Bus implements core.EventPublisher and core.EventListener. Subscribers of an event run
+// concurrently, detached from the cancellation of the relaying context so a
+// shutdown never interrupts a handler halfway.
+type Bus struct {
+	store core.EventRepository
+
+	mu        sync.RWMutex
+	handlers  map[core.EventType][]core.EventHandler
+	listeners map[*listener]struct{}
+	closed    bool
+}
+
+// listener is a live consumer registered with Listen.
+type listener struct {
+	types  map[core.EventType]bool
+	events chan core.EventEnvelope
+}
+
+// NewBus constructs a bus that persists events to store; a nil store keeps
+// events in memory only.
+func NewBus(store core.EventRepository) *Bus {
+	return &Bus{
+		store:     store,
+		handlers:  map[core.EventType][]core.EventHandler{},
+		listeners: map[*listener]struct{}{},
+	}
+}
+
+var (
+	_ core.EventPublisher = (*Bus)(nil)
+	_ core.EventListener  = (*Bus)(nil)
+)
+
+// Subscribe registers handler for events of the given type.
+func (b *Bus) Subscribe(eventType core.EventType, handler core.EventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+}
+
+// Listen registers a listener for events of the given types, or of every
+// type when none are given. Republished envelopes reach listeners again.
+func (b *Bus) Listen(types ...core.EventType) (<-chan core.EventEnvelope, func()) {
+	l := &listener{
+		types:  make(map[core.EventType]bool, len(types)),
+		events: make(chan core.EventEnvelope, listenerBuffer),
+	}
+	for _, eventType := range types {
+		l.types[eventType] = true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		close(l.events)
+		return l.events, func() {}
+	}
+	b.listeners[l] = struct{}{}
+
+	return l.events, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.listeners[l]; ok {
+			delete(b.listeners, l)
+			close(l.events)
+		}
+	}
+}
+
+// Close ends every listener by closing its channel, so live streams finish
+// during shutdown; later listeners are closed at once. Subscribers keep
+// receiving published events.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for l := range b.listeners {
+		delete(b.listeners, l)
+		close(l.events)
+	}
+}
+
+// Publish stores the event, when persistence is enabled, hands it to the
+// listeners of its type without blocking and to every subscriber of its
+// type, returning once the subscribers all have finished. Republishing an
+// envelope does not duplicate it in the event log.
+func (b *Bus) Publish(ctx context.Context, envelope core.EventEnvelope) error {
+	event := envelope.Event
+	if b.store != nil {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("encode %s event: %w", event.EventType(), err)
+		}
+		err = b.store.AppendEvent(ctx, core.StoredEvent{
+			ID:          envelope.ID,
+			Type:        event.EventType(),
+			AggregateID: event.AggregateID(),
+			Payload:     payload,
+			OccurredAt:  envelope.OccurredAt,
+		})
+		if err != nil && !errors.Is(err, core.ErrAlreadyExists) {
+			return err
+		}
+	}
+
+	b.mu.RLock()
+	handlers := append([]core.EventHandler(nil), b.handlers[event.EventType()]...)
+	for l := range b.listeners {
+		if len(l.types) > 0 && !l.types[event.EventType()] {
+			continue
+		}
+		select {
+		case l.events <- envelope:
+		default:
+		}
+	}
+	b.mu.RUnlock()
+
+	ctx = context.WithoutCancel(ctx)
+	errs := make([]error, len(handlers))
+	var wg sync.WaitGroup
+	for i, handler := range handlers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = handler(ctx, envelope)
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
@@ -0,0 +1,160 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubEventRepo struct {
+	events []core.StoredEvent
+	err    error
+}
+
+func (s *stubEventRepo) AppendEvent(ctx context.Context, event core.StoredEvent) error {
+	if s.err != nil {
+		return s.err
+	}
+	for _, existing := range s.events {
+		if existing.ID == event.ID {
+			return core.ErrAlreadyExists
+		}
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *stubEventRepo) ListEvents(ctx context.Context, filter core.EventListFilter) ([]core.StoredEvent, string, error) {
+	return s.events, "", nil
+}
+
+func TestBus_PublishPersistsAndDispatches(t *testing.T) {
+	store := &stubEventRepo{}
+	bus := NewBus(store)
+
+	var mu sync.Mutex
+	var received []core.EventEnvelope
+	record := func(ctx context.Context, envelope core.EventEnvelope) error {
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, envelope)
+		return nil
+	}
+	bus.Subscribe(core.EventTypeEpisodeDeleted, record)
+	bus.Subscribe(core.EventTypeEpisodeDeleted, func(ctx context.Context, envelope core.EventEnvelope) error {
+		return errors.New("index unavailable")
+	})
+	bus.Subscribe(core.EventTypeAssetReady, record)
+
+	episode := core.Episode{ID: uuid.New(), Title: "Ordering coffee"}
+	envelope := core.EventEnvelope{
+		ID:         uuid.New(),
+		OccurredAt: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+		Event:      core.EpisodeDeleted{Episode: episode},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := bus.Publish(ctx, envelope); err == nil {
+		t.Fatal("expected the failing subscriber's error to be returned")
+	}
+	if len(received) != 1 || received[0].ID != envelope.ID {
+		t.Fatalf("unexpected deliveries %+v", received)
+	}
+	if len(store.events) != 1 || store.events[0].ID != envelope.ID || store.events[0].AggregateID != episode.ID.String() {
+		t.Fatalf("unexpected stored events %+v", store.events)
+	}
+	var payload core.EpisodeDeleted
+	if err := json.Unmarshal(store.events[0].Payload, &payload); err != nil || payload.Episode.Title != "Ordering coffee" {
+		t.Fatalf("unexpected payload %s (%v)", store.events[0].Payload, err)
+	}
+
+	// A redelivery reaches the subscribers again without duplicating the log entry.
+	_ = bus.Publish(ctx, envelope)
+	if len(received) != 2 || len(store.events) != 1 {
+		t.Fatalf("unexpected redelivery: %d deliveries, %d stored events", len(received), len(store.events))
+	}
+}
+
+func TestBus_PublishFailsWhenStoreFails(t *testing.T) {
+	bus := NewBus(&stubEventRepo{err: errors.New("database down")})
+	called := false
+	bus.Subscribe(core.EventTypeAssetReady, func(ctx context.Context, envelope core.EventEnvelope) error {
+		called = true
+		return nil
+	})
+
+	if err := bus.Publish(context.Background(), core.EventEnvelope{ID: uuid.New(), Event: core.AssetReady{}}); err == nil {
+		t.Fatal("expected the store error to be returned")
+	}
+	if called {
+		t.Fatal("expected subscribers not to see unpersisted events")
+	}
+}
+
+func TestBus_Listen(t *testing.T) {
+	bus := NewBus(nil)
+	ready, stopReady := bus.Listen(core.EventTypeAssetReady)
+	all, stopAll := bus.Listen()
+	defer stopAll()
+
+	envelopes := []core.EventEnvelope{
+		{ID: uuid.New(), Event: core.SeriesPublished{}},
+		{ID: uuid.New(), Event: core.AssetReady{}},
+	}
+	for _, envelope := range envelopes {
+		if err := bus.Publish(context.Background(), envelope); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+	}
+
+	if got := <-ready; got.ID != envelopes[1].ID {
+		t.Fatalf("expected only the asset event, got %v", got.Event.EventType())
+	}
+	for _, want := range envelopes {
+		if got := <-all; got.ID != want.ID {
+			t.Fatalf("expected %v, got %v", want.Event.EventType(), got.Event.EventType())
+		}
+	}
+
+	stopReady()
+	stopReady()
+	if _, ok := <-ready; ok {
+		t.Fatal("expected the stopped listener's channel to be closed")
+	}
+
+	// A listener that is not draining its channel never blocks publishing.
+	for range listenerBuffer + 1 {
+		if err := bus.Publish(context.Background(), core.EventEnvelope{ID: uuid.New(), Event: core.AssetReady{}}); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+	}
+	if len(all) != listenerBuffer {
+		t.Fatalf("expected the listener buffer to fill up, got %d events", len(all))
+	}
+}
+
+func TestBus_CloseEndsListeners(t *testing.T) {
+	bus := NewBus(nil)
+	events, stop := bus.Listen()
+
+	bus.Close()
+	if _, ok := <-events; ok {
+		t.Fatal("expected Close to close the listener's channel")
+	}
+	stop()
+
+	late, _ := bus.Listen()
+	if _, ok := <-late; ok {
+		t.Fatal("expected listeners after Close to be closed at once")
+	}
+	if err := bus.Publish(context.Background(), core.EventEnvelope{ID: uuid.New(), Event: core.AssetReady{}}); err != nil {
+		t.Fatalf("Publish() after Close error = %v", err)
+	}
+}
@@ -0,0 +1,308 @@
+// Package feed fetches YouTube playlists and podcast RSS feeds for ingestion
+// and copies the media of licensed podcasts into the upload pipeline.
+//
+// YouTube playlists are read from their public Atom feed, which needs no API
+// key but only lists the latest 15 videos; older videos are not ingested.
+package feed
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eslsoft/lession/internal/adapter/safehttp"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	defaultTimeout     = 30 * time.Second
+	defaultYouTubeBase = "https://www.youtube.com"
+	// maxFeedSize bounds the feed documents read.
+	maxFeedSize = 16 << 20
+	userAgent   = "Lession-Ingest/1"
+)
+
+var (
+	// youTubePlaylistID matches the ids of YouTube playlists.
+	youTubePlaylistID = regexp.MustCompile(`^[A-Za-z0-9_-]{10,64}$`)
+	// feedLanguage matches the language codes of podcast feeds, e.g. en-us.
+	feedLanguage = regexp.MustCompile(`^([a-zA-Z]{2})(?:[-_].*)?$`)
+)
+
+// pubDateLayouts are the date formats found in the pubDate of podcast feeds.
+var pubDateLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006 15:04:05 -0700",
+	time.RFC3339,
+}
+
+// Fetcher implements core.FeedFetcher over HTTP.
+type Fetcher struct {
+	httpClient  *http.Client
+	youTubeBase string
+}
+
+// NewFetcher constructs a feed fetcher with a bounded request timeout. It only
+// connects to public addresses, since feed URLs are chosen by callers.
+func NewFetcher() *Fetcher {
+	return &Fetcher{
+		httpClient:  safehttp.NewClient(defaultTimeout),
+		youTubeBase: defaultYouTubeBase,
+	}
+}
+
+// WithHTTPClient overrides the HTTP client, e.g. in tests.
+func (f *Fetcher) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		f.httpClient = client
+	}
+}
+
+// WithYouTubeBase overrides the origin YouTube feeds are read from, e.g. in tests.
+func (f *Fetcher) WithYouTubeBase(base string) {
+	if base != "" {
+		f.youTubeBase = strings.TrimRight(base, "/")
+	}
+}
+
+var _ core.FeedFetcher = (*Fetcher)(nil)
+
+// FetchFeed loads a YouTube playlist, given its playlist URL, or a podcast feed.
+func (f *Fetcher) FetchFeed(ctx context.Context, kind core.FeedKind, sourceURL string) (*core.Feed, error) {
+	switch kind {
+	case core.FeedKindYouTubePlaylist:
+		id, err := YouTubePlaylistID(sourceURL)
+		if err != nil {
+			return nil, err
+		}
+		var doc youTubeFeedXML
+		if err := f.get(ctx, f.youTubeBase+"/feeds/videos.xml?playlist_id="+url.QueryEscape(id), &doc); err != nil {
+			return nil, err
+		}
+		return doc.toFeed(), nil
+	case core.FeedKindPodcast:
+		u, err := url.Parse(sourceURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("%w: podcast feed must be an absolute http or https URL", core.ErrValidation)
+		}
+		var doc podcastFeedXML
+		if err := f.get(ctx, sourceURL, &doc); err != nil {
+			return nil, err
+		}
+		return doc.toFeed(), nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported feed kind", core.ErrValidation)
+	}
+}
+
+// YouTubePlaylistID extracts the playlist id from a YouTube playlist or feed
+// URL, or accepts a bare playlist id.
+func YouTubePlaylistID(sourceURL string) (string, error) {
+	if youTubePlaylistID.MatchString(sourceURL) {
+		return sourceURL, nil
+	}
+	u, err := url.Parse(sourceURL)
+	if err == nil {
+		host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+		if host == "youtube.com" || host == "m.youtube.com" || host == "music.youtube.com" {
+			id := u.Query().Get("list")
+			if id == "" {
+				id = u.Query().Get("playlist_id")
+			}
+			if youTubePlaylistID.MatchString(id) {
+				return id, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("%w: %q is not a YouTube playlist URL", core.ErrValidation, sourceURL)
+}
+
+func (f *Fetcher) get(ctx context.Context, rawURL string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return fmt.Errorf("feed: build request: %w", err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "application/rss+xml, application/atom+xml, application/xml;q=0.9, */*;q=0.8")
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("feed: get %s: %w", rawURL, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("%w: feed %s", core.ErrNotFound, rawURL)
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return fmt.Errorf("feed: get %s: %s", rawURL, resp.Status)
+	}
+
+	limited := &io.LimitedReader{R: resp.Body, N: maxFeedSize + 1}
+	decoder := xml.NewDecoder(limited)
+	// Feeds declare all sorts of legacy encodings; their markup is ASCII, so
+	// reading them as UTF-8 only garbles the odd character.
+	decoder.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) { return input, nil }
+	if err := decoder.Decode(v); err != nil {
+		if limited.N <= 0 {
+			return fmt.Errorf("feed: %s is larger than %d bytes", rawURL, maxFeedSize)
+		}
+		return fmt.Errorf("feed: parse %s: %w", rawURL, err)
+	}
+	return nil
+}
+
+type youTubeFeedXML struct {
+	Title   string `xml:"http://www.w3.org/2005/Atom title"`
+	Entries []struct {
+		VideoID   string `xml:"http://www.youtube.com/xml/schemas/2015 videoId"`
+		Title     string `xml:"http://www.w3.org/2005/Atom title"`
+		Published string `xml:"http://www.w3.org/2005/Atom published"`
+		Group     struct {
+			Description string `xml:"http://search.yahoo.com/mrss/ description"`
+			Thumbnail   struct {
+				URL string `xml:"url,attr"`
+			} `xml:"http://search.yahoo.com/mrss/ thumbnail"`
+		} `xml:"http://search.yahoo.com/mrss/ group"`
+	} `xml:"http://www.w3.org/2005/Atom entry"`
+}
+
+func (doc youTubeFeedXML) toFeed() *core.Feed {
+	feed := &core.Feed{Title: strings.TrimSpace(doc.Title)}
+	for _, entry := range doc.Entries {
+		if entry.VideoID == "" {
+			continue
+		}
+		if feed.ImageURL == "" {
+			feed.ImageURL = entry.Group.Thumbnail.URL
+		}
+		published, _ := time.Parse(time.RFC3339, entry.Published)
+		feed.Items = append(feed.Items, core.FeedItem{
+			GUID:        "yt:video:" + entry.VideoID,
+			Title:       strings.TrimSpace(entry.Title),
+			Description: strings.TrimSpace(entry.Group.Description),
+			MediaURL:    "https://www.youtube.com/watch?v=" + url.QueryEscape(entry.VideoID),
+			MediaType:   core.MediaTypeVideo,
+			PublishedAt: published,
+		})
+	}
+	return feed
+}
+
+type podcastFeedXML struct {
+	Channel struct {
+		Title       string `xml:"title"`
+		Description string `xml:"description"`
+		Language    string `xml:"language"`
+		// ITunesImage precedes Image, which would also match itunes:image.
+		ITunesImage struct {
+			Href string `xml:"href,attr"`
+		} `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd image"`
+		Image struct {
+			URL string `xml:"url"`
+		} `xml:"image"`
+		Items []struct {
+			Title       string `xml:"title"`
+			Description string `xml:"description"`
+			GUID        string `xml:"guid"`
+			PubDate     string `xml:"pubDate"`
+			Enclosure   struct {
+				URL    string `xml:"url,attr"`
+				Length string `xml:"length,attr"`
+				Type   string `xml:"type,attr"`
+			} `xml:"enclosure"`
+			Summary  string `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd summary"`
+			Duration string `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd duration"`
+		} `xml:"item"`
+	} `xml:"channel"`
+}
+
+func (doc podcastFeedXML) toFeed() *core.Feed {
+	channel := doc.Channel
+	feed := &core.Feed{
+		Title:       strings.TrimSpace(channel.Title),
+		Description: strings.TrimSpace(channel.Description),
+		ImageURL:    channel.ITunesImage.Href,
+	}
+	if feed.ImageURL == "" {
+		feed.ImageURL = channel.Image.URL
+	}
+	if match := feedLanguage.FindStringSubmatch(strings.TrimSpace(channel.Language)); match != nil {
+		feed.Language = strings.ToLower(match[1])
+	}
+
+	for _, item := range channel.Items {
+		// Items without media are show notes or announcements.
+		if item.Enclosure.URL == "" {
+			continue
+		}
+		guid := strings.TrimSpace(item.GUID)
+		if guid == "" {
+			guid = item.Enclosure.URL
+		}
+		mimeType := strings.ToLower(strings.TrimSpace(item.Enclosure.Type))
+		mediaType := core.MediaTypeAudio
+		if strings.HasPrefix(mimeType, "video/") {
+			mediaType = core.MediaTypeVideo
+		}
+		length, _ := strconv.ParseInt(strings.TrimSpace(item.Enclosure.Length), 10, 64)
+		description := strings.TrimSpace(item.Summary)
+		if description == "" {
+			description = strings.TrimSpace(item.Description)
+		}
+		feed.Items = append(feed.Items, core.FeedItem{
+			GUID:          guid,
+			Title:         strings.TrimSpace(item.Title),
+			Description:   description,
+			MediaURL:      item.Enclosure.URL,
+			MediaType:     mediaType,
+			MimeType:      mimeType,
+			ContentLength: max(length, 0),
+			Duration:      parseITunesDuration(item.Duration),
+			PublishedAt:   parsePubDate(item.PubDate),
+		})
+	}
+	return feed
+}
+
+// parseITunesDuration parses seconds, mm:ss or hh:mm:ss, returning zero for
+// anything else.
+func parseITunesDuration(raw string) time.Duration {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return 0
+	}
+	var total int64
+	parts := strings.Split(raw, ":")
+	if len(parts) > 3 {
+		return 0
+	}
+	for _, part := range parts {
+		value, err := strconv.ParseInt(part, 10, 64)
+		if err != nil || value < 0 {
+			return 0
+		}
+		total = total*60 + value
+	}
+	return time.Duration(total) * time.Second
+}
+
+func parsePubDate(raw string) time.Time {
+	raw = strings.TrimSpace(raw)
+	for _, layout := range pubDateLayouts {
+		if t, err := time.Parse(layout, raw); err == nil {
+			return t.UTC()
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,188 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/eslsoft/lession/internal/adapter/safehttp"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const podcastFeed = `<?xml version="1.0" encoding="ISO-8859-1"?>
+<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
+<channel>
+  <title>Everyday English</title>
+  <description>Short conversations.</description>
+  <language>en-us</language>
+  <image><url>https://cdn.example.com/rss.png</url></image>
+  <itunes:image href="https://cdn.example.com/itunes.png"/>
+  <item>
+    <title>At the cafe</title>
+    <description>Ordering coffee.</description>
+    <guid isPermaLink="false">ep-2</guid>
+    <pubDate>Tue, 04 Mar 2025 08:00:00 +0000</pubDate>
+    <enclosure url="https://cdn.example.com/ep2.mp3" length="1234" type="audio/mpeg"/>
+    <itunes:duration>12:05</itunes:duration>
+  </item>
+  <item>
+    <title>Show notes</title>
+  </item>
+  <item>
+    <title>Greetings</title>
+    <itunes:summary>Saying hello.</itunes:summary>
+    <enclosure url="https://cdn.example.com/ep1.mp4" type="video/mp4"/>
+    <itunes:duration>95</itunes:duration>
+  </item>
+</channel>
+</rss>`
+
+const youTubeFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns:yt="http://www.youtube.com/xml/schemas/2015" xmlns:media="http://search.yahoo.com/mrss/" xmlns="http://www.w3.org/2005/Atom">
+  <title>Pronunciation drills</title>
+  <entry>
+    <id>yt:video:abc123</id>
+    <yt:videoId>abc123</yt:videoId>
+    <title>Vowels</title>
+    <published>2025-02-01T10:00:00+00:00</published>
+    <media:group>
+      <media:title>Vowels</media:title>
+      <media:thumbnail url="https://i.ytimg.com/vi/abc123/hqdefault.jpg" width="480" height="360"/>
+      <media:description>Long and short vowels.</media:description>
+    </media:group>
+  </entry>
+</feed>`
+
+func TestFetcher_FetchPodcast(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, podcastFeed)
+	}))
+	defer server.Close()
+
+	fetcher := NewFetcher()
+	fetcher.WithHTTPClient(server.Client())
+	feed, err := fetcher.FetchFeed(context.Background(), core.FeedKindPodcast, server.URL+"/feed.xml")
+	if err != nil {
+		t.Fatalf("FetchFeed() error = %v", err)
+	}
+	if feed.Title != "Everyday English" || feed.Language != "en" || feed.ImageURL != "https://cdn.example.com/itunes.png" {
+		t.Fatalf("unexpected feed %+v", feed)
+	}
+	if len(feed.Items) != 2 {
+		t.Fatalf("expected the items with media, got %+v", feed.Items)
+	}
+
+	first := feed.Items[0]
+	if first.GUID != "ep-2" || first.MediaType != core.MediaTypeAudio || first.MimeType != "audio/mpeg" ||
+		first.ContentLength != 1234 || first.Duration != 12*time.Minute+5*time.Second ||
+		!first.PublishedAt.Equal(time.Date(2025, 3, 4, 8, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected item %+v", first)
+	}
+	second := feed.Items[1]
+	if second.GUID != "https://cdn.example.com/ep1.mp4" || second.MediaType != core.MediaTypeVideo || second.Description != "Saying hello." || second.Duration != 95*time.Second {
+		t.Fatalf("unexpected item %+v", second)
+	}
+}
+
+func TestFetcher_FetchYouTubePlaylist(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Path + "?" + r.URL.RawQuery
+		_, _ = io.WriteString(w, youTubeFeed)
+	}))
+	defer server.Close()
+
+	fetcher := NewFetcher()
+	fetcher.WithHTTPClient(server.Client())
+	fetcher.WithYouTubeBase(server.URL)
+	feed, err := fetcher.FetchFeed(context.Background(), core.FeedKindYouTubePlaylist, "https://www.youtube.com/playlist?list=PLabcdefghij")
+	if err != nil {
+		t.Fatalf("FetchFeed() error = %v", err)
+	}
+	if query != "/feeds/videos.xml?playlist_id=PLabcdefghij" {
+		t.Fatalf("unexpected feed request %s", query)
+	}
+	if feed.Title != "Pronunciation drills" || feed.ImageURL != "https://i.ytimg.com/vi/abc123/hqdefault.jpg" || len(feed.Items) != 1 {
+		t.Fatalf("unexpected feed %+v", feed)
+	}
+	item := feed.Items[0]
+	if item.GUID != "yt:video:abc123" || item.MediaURL != "https://www.youtube.com/watch?v=abc123" || item.MediaType != core.MediaTypeVideo || item.Description != "Long and short vowels." {
+		t.Fatalf("unexpected item %+v", item)
+	}
+}
+
+func TestYouTubePlaylistID(t *testing.T) {
+	for _, raw := range []string{
+		"PLabcdefghij",
+		"https://www.youtube.com/playlist?list=PLabcdefghij",
+		"https://m.youtube.com/watch?v=xyz&list=PLabcdefghij",
+		"https://www.youtube.com/feeds/videos.xml?playlist_id=PLabcdefghij",
+	} {
+		if id, err := YouTubePlaylistID(raw); err != nil || id != "PLabcdefghij" {
+			t.Fatalf("YouTubePlaylistID(%q) = %q, %v", raw, id, err)
+		}
+	}
+	for _, raw := range []string{"https://example.com/playlist?list=PLabcdefghij", "https://www.youtube.com/watch?v=abc"} {
+		if _, err := YouTubePlaylistID(raw); !errors.Is(err, core.ErrValidation) {
+			t.Fatalf("YouTubePlaylistID(%q) expected validation error, got %v", raw, err)
+		}
+	}
+}
+
+func TestTransferer_TransferMedia(t *testing.T) {
+	var uploaded []byte
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = io.WriteString(w, "media bytes")
+		case http.MethodPut:
+			header = r.Header
+			uploaded, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	transferer := NewTransferer()
+	transferer.WithHTTPClient(server.Client())
+	n, err := transferer.TransferMedia(context.Background(), server.URL+"/ep1.mp3", core.UploadTarget{
+		Method:  http.MethodPut,
+		URL:     server.URL + "/upload",
+		Headers: map[string]string{"Content-Type": "audio/mpeg"},
+	})
+	if err != nil {
+		t.Fatalf("TransferMedia() error = %v", err)
+	}
+	if n != int64(len("media bytes")) || string(uploaded) != "media bytes" || header.Get("Content-Type") != "audio/mpeg" {
+		t.Fatalf("unexpected upload %d %q %v", n, uploaded, header)
+	}
+
+	if _, err := transferer.TransferMedia(context.Background(), server.URL, core.UploadTarget{Method: http.MethodPost, URL: server.URL}); err == nil {
+		t.Fatal("expected POST targets to be rejected")
+	}
+}
+
+func TestFeed_RefusesInternalSources(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+	}))
+	defer server.Close()
+
+	if _, err := NewFetcher().FetchFeed(context.Background(), core.FeedKindPodcast, server.URL+"/feed.xml"); !errors.Is(err, safehttp.ErrNonPublicAddress) {
+		t.Fatalf("FetchFeed() error = %v, want ErrNonPublicAddress", err)
+	}
+	_, err := NewTransferer().TransferMedia(context.Background(), server.URL+"/ep1.mp3", core.UploadTarget{Method: http.MethodPut, URL: server.URL + "/upload"})
+	if !errors.Is(err, safehttp.ErrNonPublicAddress) {
+		t.Fatalf("TransferMedia() error = %v, want ErrNonPublicAddress", err)
+	}
+	if hits.Load() != 0 {
+		t.Fatalf("internal server was reached %d times", hits.Load())
+	}
+}
@@ -0,0 +1,110 @@
+package feed
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/eslsoft/lession/internal/adapter/safehttp"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// transferTimeout bounds copying one media file.
+	transferTimeout = 30 * time.Minute
+	maxErrorBody    = 512
+)
+
+// Transferer implements core.MediaTransferer by streaming media from its URL
+// to a pre-signed PUT upload target.
+type Transferer struct {
+	// sourceClient downloads media from URLs found in feeds, so it only
+	// connects to public addresses.
+	sourceClient *http.Client
+	// uploadClient uploads to targets issued by the storage adapter, which
+	// may live on the internal network.
+	uploadClient *http.Client
+}
+
+// NewTransferer constructs a media transferer with a bounded transfer time.
+func NewTransferer() *Transferer {
+	return &Transferer{
+		sourceClient: safehttp.NewClient(transferTimeout),
+		uploadClient: &http.Client{Timeout: transferTimeout},
+	}
+}
+
+// WithHTTPClient overrides both HTTP clients, e.g. in tests.
+func (t *Transferer) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		t.sourceClient = client
+		t.uploadClient = client
+	}
+}
+
+var _ core.MediaTransferer = (*Transferer)(nil)
+
+// TransferMedia streams the media at sourceURL to target without buffering it.
+func (t *Transferer) TransferMedia(ctx context.Context, sourceURL string, target core.UploadTarget) (int64, error) {
+	method := target.Method
+	if method == "" {
+		method = http.MethodPut
+	}
+	if method != http.MethodPut || len(target.FormFields) > 0 {
+		return 0, fmt.Errorf("feed: upload targets using %s are not supported", method)
+	}
+
+	getReq, err := http.NewRequestWithContext(ctx, http.MethodGet, sourceURL, nil)
+	if err != nil {
+		return 0, fmt.Errorf("feed: build request: %w", err)
+	}
+	getReq.Header.Set("User-Agent", userAgent)
+	source, err := t.sourceClient.Do(getReq)
+	if err != nil {
+		return 0, fmt.Errorf("feed: get %s: %w", sourceURL, err)
+	}
+	defer source.Body.Close()
+	if source.StatusCode < 200 || source.StatusCode > 299 {
+		return 0, fmt.Errorf("feed: get %s: %s", sourceURL, source.Status)
+	}
+
+	counter := &countingReader{r: source.Body}
+	putReq, err := http.NewRequestWithContext(ctx, method, target.URL, counter)
+	if err != nil {
+		return 0, fmt.Errorf("feed: build upload request: %w", err)
+	}
+	// Pre-signed URLs usually need the length up front; unknown lengths are
+	// sent chunked.
+	putReq.ContentLength = source.ContentLength
+	if putReq.ContentLength == 0 {
+		putReq.Body = http.NoBody
+	}
+	for key, value := range target.Headers {
+		putReq.Header.Set(key, value)
+	}
+	upload, err := t.uploadClient.Do(putReq)
+	if err != nil {
+		return 0, fmt.Errorf("feed: upload %s: %w", sourceURL, err)
+	}
+	defer upload.Body.Close()
+	if upload.StatusCode < 200 || upload.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(upload.Body, maxErrorBody))
+		return 0, fmt.Errorf("feed: upload target responded %s: %s", upload.Status, bytes.TrimSpace(body))
+	}
+	_, _ = io.Copy(io.Discard, upload.Body)
+	return counter.n, nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,214 @@
+// Package fixtures reads the declarative YAML files `lession seed` loads.
+package fixtures
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+//go:embed demo.yaml
+var demo []byte
+
+// Demo returns the built-in demo content.
+func Demo() (core.Fixtures, error) {
+	return Decode(bytes.NewReader(demo))
+}
+
+// Decode parses a fixtures file, rejecting unknown keys so typos do not go
+// unnoticed.
+func Decode(r io.Reader) (core.Fixtures, error) {
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+	var file fixturesFile
+	if err := decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return core.Fixtures{}, fmt.Errorf("decode fixtures: %w", err)
+	}
+	return file.toDomain()
+}
+
+type fixturesFile struct {
+	Assets []assetFixture  `yaml:"assets"`
+	Plans  []planFixture   `yaml:"plans"`
+	Series []seriesFixture `yaml:"series"`
+	Users  []userFixture   `yaml:"users"`
+}
+
+type assetFixture struct {
+	Key         string        `yaml:"key"`
+	Type        string        `yaml:"type"`
+	Filename    string        `yaml:"filename"`
+	MimeType    string        `yaml:"mime_type"`
+	Filesize    int64         `yaml:"filesize"`
+	Duration    time.Duration `yaml:"duration"`
+	PlaybackURL string        `yaml:"playback_url"`
+}
+
+type planFixture struct {
+	Code        string `yaml:"code"`
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	PriceCents  int64  `yaml:"price_cents"`
+	Currency    string `yaml:"currency"`
+	Interval    string `yaml:"interval"`
+	// Active defaults to true.
+	Active *bool `yaml:"active"`
+}
+
+type seriesFixture struct {
+	Slug      string           `yaml:"slug"`
+	Title     string           `yaml:"title"`
+	Summary   string           `yaml:"summary"`
+	Language  string           `yaml:"language"`
+	Level     string           `yaml:"level"`
+	Tags      []string         `yaml:"tags"`
+	CoverURL  string           `yaml:"cover_url"`
+	Status    string           `yaml:"status"`
+	AuthorIDs []string         `yaml:"author_ids"`
+	Episodes  []episodeFixture `yaml:"episodes"`
+}
+
+type episodeFixture struct {
+	Seq         uint32             `yaml:"seq"`
+	Title       string             `yaml:"title"`
+	Description string             `yaml:"description"`
+	Duration    time.Duration      `yaml:"duration"`
+	Status      string             `yaml:"status"`
+	Preview     bool               `yaml:"preview"`
+	Asset       string             `yaml:"asset"`
+	Transcript  *transcriptFixture `yaml:"transcript"`
+}
+
+type transcriptFixture struct {
+	Language string `yaml:"language"`
+	Format   string `yaml:"format"`
+	Content  string `yaml:"content"`
+}
+
+type userFixture struct {
+	ID   string `yaml:"id"`
+	Plan string `yaml:"plan"`
+}
+
+var (
+	assetTypes = map[string]core.AssetType{
+		"video": core.AssetTypeVideo,
+		"audio": core.AssetTypeAudio,
+	}
+	billingIntervals = map[string]core.BillingInterval{
+		"month": core.BillingIntervalMonth,
+		"year":  core.BillingIntervalYear,
+	}
+	seriesStatuses = map[string]core.SeriesStatus{
+		"":          core.SeriesStatusUnspecified,
+		"draft":     core.SeriesStatusDraft,
+		"published": core.SeriesStatusPublished,
+		"archived":  core.SeriesStatusArchived,
+	}
+	episodeStatuses = map[string]core.EpisodeStatus{
+		"":          core.EpisodeStatusUnspecified,
+		"draft":     core.EpisodeStatusDraft,
+		"ready":     core.EpisodeStatusReady,
+		"published": core.EpisodeStatusPublished,
+		"archived":  core.EpisodeStatusArchived,
+	}
+	transcriptFormats = map[string]core.TranscriptFormat{
+		"":         core.TranscriptFormatPlain,
+		"plain":    core.TranscriptFormatPlain,
+		"markdown": core.TranscriptFormatMarkdown,
+		"srt":      core.TranscriptFormatSRT,
+		"json":     core.TranscriptFormatJSON,
+	}
+)
+
+func (f fixturesFile) toDomain() (core.Fixtures, error) {
+	var fixtures core.Fixtures
+	for _, asset := range f.Assets {
+		assetType, ok := assetTypes[asset.Type]
+		if !ok {
+			return fixtures, fmt.Errorf("asset %q: unknown type %q", asset.Key, asset.Type)
+		}
+		fixtures.Assets = append(fixtures.Assets, core.AssetFixture{
+			Key:         asset.Key,
+			Type:        assetType,
+			Filename:    asset.Filename,
+			MimeType:    asset.MimeType,
+			Filesize:    asset.Filesize,
+			Duration:    asset.Duration,
+			PlaybackURL: asset.PlaybackURL,
+		})
+	}
+
+	for _, plan := range f.Plans {
+		interval, ok := billingIntervals[plan.Interval]
+		if !ok {
+			return fixtures, fmt.Errorf("plan %q: unknown interval %q", plan.Code, plan.Interval)
+		}
+		fixtures.Plans = append(fixtures.Plans, core.PlanDraft{
+			Code:        plan.Code,
+			Name:        plan.Name,
+			Description: plan.Description,
+			PriceCents:  plan.PriceCents,
+			Currency:    plan.Currency,
+			Interval:    interval,
+			Active:      plan.Active == nil || *plan.Active,
+		})
+	}
+
+	for _, series := range f.Series {
+		status, ok := seriesStatuses[series.Status]
+		if !ok {
+			return fixtures, fmt.Errorf("series %q: unknown status %q", series.Slug, series.Status)
+		}
+		fixture := core.SeriesFixture{Series: core.SeriesDraft{
+			Slug:      series.Slug,
+			Title:     series.Title,
+			Summary:   series.Summary,
+			Language:  series.Language,
+			Level:     series.Level,
+			Tags:      series.Tags,
+			CoverURL:  series.CoverURL,
+			Status:    status,
+			AuthorIDs: series.AuthorIDs,
+		}}
+		for _, episode := range series.Episodes {
+			status, ok := episodeStatuses[episode.Status]
+			if !ok {
+				return fixtures, fmt.Errorf("series %q: episode %q: unknown status %q", series.Slug, episode.Title, episode.Status)
+			}
+			draft := core.EpisodeDraft{
+				Seq:         episode.Seq,
+				Title:       episode.Title,
+				Description: episode.Description,
+				Duration:    episode.Duration,
+				Status:      status,
+				Preview:     episode.Preview,
+			}
+			if episode.Transcript != nil {
+				format, ok := transcriptFormats[episode.Transcript.Format]
+				if !ok {
+					return fixtures, fmt.Errorf("series %q: episode %q: unknown transcript format %q", series.Slug, episode.Title, episode.Transcript.Format)
+				}
+				draft.Transcript = &core.Transcript{
+					Language: episode.Transcript.Language,
+					Format:   format,
+					Content:  episode.Transcript.Content,
+				}
+			}
+			fixture.Episodes = append(fixture.Episodes, core.EpisodeFixture{Episode: draft, AssetKey: episode.Asset})
+		}
+		fixtures.Series = append(fixtures.Series, fixture)
+	}
+
+	for _, user := range f.Users {
+		fixtures.Users = append(fixtures.Users, core.UserFixture{ID: user.ID, PlanCode: user.Plan})
+	}
+	return fixtures, nil
+}
@@ -0,0 +1,77 @@
+package fixtures
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestDemo(t *testing.T) {
+	fixtures, err := Demo()
+	if err != nil {
+		t.Fatalf("Demo() error = %v", err)
+	}
+	if len(fixtures.Assets) == 0 || len(fixtures.Series) == 0 || len(fixtures.Plans) == 0 || len(fixtures.Users) == 0 {
+		t.Fatalf("expected demo content of every kind, got %+v", fixtures)
+	}
+	keys := make(map[string]bool, len(fixtures.Assets))
+	for _, asset := range fixtures.Assets {
+		keys[asset.Key] = true
+	}
+	for _, series := range fixtures.Series {
+		for _, episode := range series.Episodes {
+			if episode.AssetKey != "" && !keys[episode.AssetKey] {
+				t.Fatalf("episode %q plays undeclared asset %q", episode.Episode.Title, episode.AssetKey)
+			}
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	fixtures, err := Decode(strings.NewReader(`
+assets:
+  - key: intro
+    type: audio
+    duration: 1m30s
+plans:
+  - code: basic
+    interval: year
+series:
+  - slug: intro
+    title: Intro
+    status: published
+    episodes:
+      - title: Hello
+        asset: intro
+        transcript:
+          format: srt
+          content: hi
+users:
+  - id: learner
+    plan: basic
+`))
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if asset := fixtures.Assets[0]; asset.Type != core.AssetTypeAudio || asset.Duration != 90*time.Second {
+		t.Fatalf("unexpected asset %+v", asset)
+	}
+	if plan := fixtures.Plans[0]; plan.Interval != core.BillingIntervalYear || !plan.Active {
+		t.Fatalf("expected an active yearly plan, got %+v", plan)
+	}
+	episode := fixtures.Series[0].Episodes[0]
+	if fixtures.Series[0].Series.Status != core.SeriesStatusPublished || episode.AssetKey != "intro" || episode.Episode.Transcript.Format != core.TranscriptFormatSRT {
+		t.Fatalf("unexpected series %+v", fixtures.Series[0])
+	}
+	if fixtures.Users[0] != (core.UserFixture{ID: "learner", PlanCode: "basic"}) {
+		t.Fatalf("unexpected user %+v", fixtures.Users[0])
+	}
+
+	for _, input := range []string{"series:\n  - slug: x\n    titel: typo\n", "assets:\n  - key: x\n    type: image\n"} {
+		if _, err := Decode(strings.NewReader(input)); err == nil {
+			t.Fatalf("expected an error for %q", input)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package googleauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTokenSource_CachesUntilExpiryOrReset(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey() error = %v", err)
+	}
+
+	var scopes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(r.FormValue("assertion"), ".")
+		if r.FormValue("grant_type") != "urn:ietf:params:oauth:grant-type:jwt-bearer" || len(parts) != 3 {
+			http.Error(w, "invalid_grant", http.StatusBadRequest)
+			return
+		}
+		var claims struct {
+			Scope string `json:"scope"`
+		}
+		payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+		_ = json.Unmarshal(payload, &claims)
+		scopes = append(scopes, claims.Scope)
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "token", "expires_in": 3600})
+	}))
+	t.Cleanup(server.Close)
+
+	credentials, _ := json.Marshal(ServiceAccount{
+		ProjectID:   "lession-app",
+		ClientEmail: "exporter@lession-app.iam.gserviceaccount.com",
+		PrivateKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		TokenURI:    server.URL,
+	})
+	tokens, err := NewTokenSource(credentials, "https://www.googleapis.com/auth/bigquery")
+	if err != nil {
+		t.Fatalf("NewTokenSource() error = %v", err)
+	}
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	tokens.WithClock(func() time.Time { return now })
+	ctx := context.Background()
+
+	for range 2 {
+		if token, err := tokens.Token(ctx); err != nil || token != "token" {
+			t.Fatalf("Token() = %q, %v", token, err)
+		}
+	}
+	if len(scopes) != 1 || scopes[0] != "https://www.googleapis.com/auth/bigquery" {
+		t.Fatalf("expected one token request for the scope, got %v", scopes)
+	}
+
+	now = now.Add(time.Hour - 30*time.Second)
+	if _, err := tokens.Token(ctx); err != nil {
+		t.Fatalf("Token() error = %v", err)
+	}
+	tokens.Reset()
+	if _, err := tokens.Token(ctx); err != nil {
+		t.Fatalf("Token() error = %v", err)
+	}
+	if len(scopes) != 3 {
+		t.Fatalf("expected a new token near expiry and after a reset, got %d requests", len(scopes))
+	}
+}
+
+func TestNewTokenSource_RejectsIncompleteKeys(t *testing.T) {
+	credentials, _ := json.Marshal(ServiceAccount{ProjectID: "lession-app", TokenURI: "https://oauth2.googleapis.com/token"})
+	if _, err := NewTokenSource(credentials, "scope"); err == nil {
+		t.Fatal("expected an error for a key without client_email")
+	}
+}
@@ -0,0 +1,250 @@
+// Package local validates and resizes uploaded images in process with the
+// standard library codecs, writing the variants to a local directory.
+package local
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif" // register the GIF decoder
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// ProviderName identifies the processor in configuration.
+	ProviderName = "local"
+
+	// maxSourceSize caps the size of the images downloaded for processing.
+	maxSourceSize = 25 << 20
+	// jpegQuality is the quality variants are encoded at.
+	jpegQuality = 85
+)
+
+// supportedFormats lists the image formats accepted, as reported by the
+// image package.
+var supportedFormats = map[string]bool{
+	"jpeg": true,
+	"png":  true,
+	"gif":  true,
+}
+
+// Processor implements core.ImageProcessor. Each asset's variants are
+// written to a directory per asset under an output directory that is served
+// at a base URL. EXIF orientation is not applied.
+type Processor struct {
+	outputDir  string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewProcessor constructs a processor writing the variants of an asset to
+// outputDir/<asset id>, served at baseURL/<asset id>/<variant>.<ext>.
+func NewProcessor(outputDir, baseURL string) (*Processor, error) {
+	if outputDir == "" {
+		return nil, errors.New("local: output directory is required")
+	}
+	return &Processor{
+		outputDir:  outputDir,
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: time.Minute},
+	}, nil
+}
+
+// WithHTTPClient overrides the HTTP client used to download source images.
+func (p *Processor) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		p.httpClient = client
+	}
+}
+
+var _ core.ImageProcessor = (*Processor)(nil)
+
+// ProcessImage downloads the image, checks its format and dimensions before
+// decoding it in full, and writes one variant per spec. PNG sources keep
+// their transparency in PNG variants; other formats are encoded as JPEG. The
+// variants are written to a temporary directory first and swapped in once
+// complete.
+func (p *Processor) ProcessImage(ctx context.Context, req core.ImageProcessRequest) (*core.ProcessedImage, error) {
+	if req.SourceURL == "" {
+		return nil, errors.New("local: source url is required")
+	}
+	data, err := p.download(ctx, req.SourceURL)
+	if err != nil {
+		return nil, err
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: image cannot be decoded: %v", core.ErrValidation, err)
+	}
+	if !supportedFormats[format] {
+		return nil, fmt.Errorf("%w: image format %q is not supported", core.ErrValidation, format)
+	}
+	if err := checkLimits(config.Width, config.Height, req.Limits); err != nil {
+		return nil, err
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: image cannot be decoded: %v", core.ErrValidation, err)
+	}
+
+	if err := os.MkdirAll(p.outputDir, 0o755); err != nil {
+		return nil, fmt.Errorf("local: %w", err)
+	}
+	dir, err := os.MkdirTemp(p.outputDir, ".tmp-"+req.AssetID.String()+"-")
+	if err != nil {
+		return nil, fmt.Errorf("local: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	ext := ".jpg"
+	if format == "png" {
+		ext = ".png"
+	}
+	result := &core.ProcessedImage{Format: format, Width: config.Width, Height: config.Height}
+	for _, spec := range req.Variants {
+		variant := resize(src, spec.Width, spec.Height)
+		name := spec.Name + ext
+		if err := writeImage(filepath.Join(dir, name), variant, ext); err != nil {
+			return nil, err
+		}
+		bounds := variant.Bounds()
+		result.Variants = append(result.Variants, core.ImageVariant{
+			Name:   spec.Name,
+			URL:    fmt.Sprintf("%s/%s/%s", p.baseURL, req.AssetID, name),
+			Width:  bounds.Dx(),
+			Height: bounds.Dy(),
+		})
+	}
+	if err := os.Chmod(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("local: %w", err)
+	}
+
+	final := filepath.Join(p.outputDir, req.AssetID.String())
+	previous := final + ".old"
+	if err := os.RemoveAll(previous); err != nil {
+		return nil, fmt.Errorf("local: %w", err)
+	}
+	if err := os.Rename(final, previous); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("local: %w", err)
+	}
+	if err := os.Rename(dir, final); err != nil {
+		return nil, fmt.Errorf("local: %w", err)
+	}
+	_ = os.RemoveAll(previous)
+	return result, nil
+}
+
+// download reads the source image, failing with ErrValidation when it is
+// larger than maxSourceSize.
+func (p *Processor) download(ctx context.Context, sourceURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sourceURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("local: %w", err)
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("local: download image: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("local: download image: unexpected status %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSourceSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("local: download image: %w", err)
+	}
+	if len(data) > maxSourceSize {
+		return nil, fmt.Errorf("%w: image is larger than %d bytes", core.ErrValidation, maxSourceSize)
+	}
+	return data, nil
+}
+
+// checkLimits fails with ErrValidation when the dimensions fall outside the
+// limits; zero limits are not checked.
+func checkLimits(width, height int, limits core.ImageLimits) error {
+	switch {
+	case width < limits.MinWidth || height < limits.MinHeight:
+		return fmt.Errorf("%w: image is %dx%d, at least %dx%d is required", core.ErrValidation, width, height, limits.MinWidth, limits.MinHeight)
+	case limits.MaxWidth > 0 && width > limits.MaxWidth, limits.MaxHeight > 0 && height > limits.MaxHeight:
+		return fmt.Errorf("%w: image is %dx%d, at most %dx%d is allowed", core.ErrValidation, width, height, limits.MaxWidth, limits.MaxHeight)
+	default:
+		return nil
+	}
+}
+
+func writeImage(name string, img image.Image, ext string) error {
+	var buf bytes.Buffer
+	var err error
+	if ext == ".png" {
+		err = png.Encode(&buf, img)
+	} else {
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	}
+	if err != nil {
+		return fmt.Errorf("local: encode %s: %w", filepath.Base(name), err)
+	}
+	if err := os.WriteFile(name, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("local: %w", err)
+	}
+	return nil
+}
+
+// resize crops the centre of src to the aspect ratio of width x height and
+// scales it down to that size by averaging the source pixels each target
+// pixel covers. Crops smaller than the target are not scaled up.
+func resize(src image.Image, width, height int) *image.RGBA {
+	bounds := src.Bounds()
+	cropW, cropH := bounds.Dx(), bounds.Dy()
+	if cropW*height > cropH*width {
+		cropW = max(1, cropH*width/height)
+	} else {
+		cropH = max(1, cropW*height/width)
+	}
+	crop := image.Rect(0, 0, cropW, cropH).Add(bounds.Min).Add(image.Pt((bounds.Dx()-cropW)/2, (bounds.Dy()-cropH)/2))
+
+	// Averaging premultiplied colours keeps transparent pixels from
+	// bleeding their colour into the edges of opaque ones.
+	source := image.NewRGBA(image.Rect(0, 0, cropW, cropH))
+	draw.Draw(source, source.Bounds(), src, crop.Min, draw.Src)
+	if cropW <= width {
+		return source
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		y0, y1 := y*cropH/height, max((y+1)*cropH/height, y*cropH/height+1)
+		for x := range width {
+			x0, x1 := x*cropW/width, max((x+1)*cropW/width, x*cropW/width+1)
+			var r, g, b, a, n int
+			for sy := y0; sy < y1; sy++ {
+				row := source.Pix[sy*source.Stride+x0*4 : sy*source.Stride+x1*4]
+				for i := 0; i < len(row); i += 4 {
+					r += int(row[i])
+					g += int(row[i+1])
+					b += int(row[i+2])
+					a += int(row[i+3])
+					n++
+				}
+			}
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(b / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,91 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestProcessor_ProcessImage(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 400, 200))
+	for y := range 200 {
+		for x := range 400 {
+			src.Set(x, y, color.NRGBA{R: 200, G: 100, B: 50, A: 255})
+		}
+	}
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, src); err != nil {
+		t.Fatalf("encode source: %v", err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cover.png" {
+			_, _ = w.Write([]byte("not an image"))
+			return
+		}
+		_, _ = w.Write(encoded.Bytes())
+	}))
+	defer server.Close()
+
+	outputDir := t.TempDir()
+	processor, err := NewProcessor(outputDir, "https://media.example.com/images/")
+	if err != nil {
+		t.Fatalf("NewProcessor() error = %v", err)
+	}
+	req := core.ImageProcessRequest{
+		AssetID:   uuid.New(),
+		SourceURL: server.URL + "/cover.png",
+		Limits:    core.ImageLimits{MinWidth: 100, MinHeight: 100},
+		Variants: []core.ImageVariantSpec{
+			{Name: core.ImageVariantThumbnail, Width: 100, Height: 100},
+			{Name: core.ImageVariantHero, Width: 1600, Height: 900},
+		},
+	}
+	result, err := processor.ProcessImage(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ProcessImage() error = %v", err)
+	}
+	if result.Format != "png" || result.Width != 400 || result.Height != 200 || len(result.Variants) != 2 {
+		t.Fatalf("unexpected result %#v", result)
+	}
+	thumbnail, hero := result.Variants[0], result.Variants[1]
+	if thumbnail.Width != 100 || thumbnail.Height != 100 || thumbnail.URL != "https://media.example.com/images/"+req.AssetID.String()+"/thumbnail.png" {
+		t.Fatalf("unexpected thumbnail %#v", thumbnail)
+	}
+	if hero.Width != 355 || hero.Height != 200 {
+		t.Fatalf("expected the hero cropped to 16:9 without upscaling, got %#v", hero)
+	}
+
+	file, err := os.Open(filepath.Join(outputDir, req.AssetID.String(), "thumbnail.png"))
+	if err != nil {
+		t.Fatalf("open thumbnail: %v", err)
+	}
+	defer file.Close()
+	img, err := png.Decode(file)
+	if err != nil {
+		t.Fatalf("decode thumbnail: %v", err)
+	}
+	if r, g, b, _ := img.At(50, 50).RGBA(); r>>8 != 200 || g>>8 != 100 || b>>8 != 50 {
+		t.Fatalf("unexpected thumbnail colour %d,%d,%d", r>>8, g>>8, b>>8)
+	}
+
+	req.Limits.MinHeight = 300
+	if _, err := processor.ProcessImage(context.Background(), req); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected a small image to be rejected, got %v", err)
+	}
+	req.SourceURL = server.URL + "/notes.txt"
+	if _, err := processor.ProcessImage(context.Background(), req); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected a non-image to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,215 @@
+// Package lmspackage renders series as SCORM 1.2 or xAPI zip packages for
+// LMSes that cannot embed episodes over LTI.
+package lmspackage
+
+import (
+	"archive/zip"
+	"bytes"
+	"embed"
+	"encoding/xml"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+var (
+	xmlTemplates = texttemplate.Must(texttemplate.New("xml").
+			Funcs(texttemplate.FuncMap{"xml": escapeXML}).
+			ParseFS(templateFS, "templates/*.xml.tmpl"))
+	htmlTemplates = htmltemplate.Must(htmltemplate.ParseFS(templateFS, "templates/*.html.tmpl"))
+)
+
+// Builder implements core.PackageBuilder.
+type Builder struct{}
+
+// NewBuilder constructs a package builder.
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+var _ core.PackageBuilder = (*Builder)(nil)
+
+type packageData struct {
+	Identifier string
+	ActivityID string
+	Title      string
+	Summary    string
+	Language   string
+	Episodes   []episodeData
+}
+
+type episodeData struct {
+	Position    int
+	Path        string
+	ActivityID  string
+	Mode        string
+	Title       string
+	Description string
+	SeriesTitle string
+	Language    string
+	MediaURL    string
+	Audio       bool
+	Transcript  string
+}
+
+// BuildPackage renders the series as a zip archive named after its slug.
+// SCORM packages expose each episode as a SCO listed in imsmanifest.xml; xAPI
+// packages describe the series and episodes in tincan.xml and launch from
+// index.html.
+func (b *Builder) BuildPackage(format core.PackageFormat, series core.Series, episodes []core.Episode) (*core.ContentPackage, error) {
+	var mode, manifest string
+	switch format {
+	case core.PackageFormatSCORM12:
+		mode, manifest = "scorm12", "imsmanifest.xml"
+	case core.PackageFormatXAPI:
+		mode, manifest = "xapi", "tincan.xml"
+	default:
+		return nil, fmt.Errorf("%w: unsupported package format", core.ErrValidation)
+	}
+
+	language := series.Language
+	if language == "" {
+		language = "en"
+	}
+	data := packageData{
+		Identifier: "lession-series-" + series.ID.String(),
+		ActivityID: "urn:lession:series:" + series.ID.String(),
+		Title:      series.Title,
+		Summary:    series.Summary,
+		Language:   language,
+	}
+	for i, episode := range episodes {
+		data.Episodes = append(data.Episodes, episodeData{
+			Position:    i + 1,
+			Path:        fmt.Sprintf("episodes/%03d.html", i+1),
+			ActivityID:  "urn:lession:episode:" + episode.ID.String(),
+			Mode:        mode,
+			Title:       episode.Title,
+			Description: episode.Description,
+			SeriesTitle: series.Title,
+			Language:    language,
+			MediaURL:    episode.Resource.PlaybackURL,
+			Audio:       episode.Resource.Type == core.MediaTypeAudio,
+			Transcript:  plainTranscript(episode.Transcript),
+		})
+	}
+
+	archive := newArchive(series.UpdatedAt)
+	if err := archive.renderXML(manifest, manifest+".tmpl", data); err != nil {
+		return nil, err
+	}
+	if format == core.PackageFormatXAPI {
+		if err := archive.renderHTML("index.html", "index.html.tmpl", data); err != nil {
+			return nil, err
+		}
+	}
+	for _, episode := range data.Episodes {
+		if err := archive.renderHTML(episode.Path, "episode.html.tmpl", episode); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range []string{"player.js", "player.css"} {
+		if err := archive.copyStatic(name); err != nil {
+			return nil, err
+		}
+	}
+
+	body, err := archive.close()
+	if err != nil {
+		return nil, err
+	}
+	slug := series.Slug
+	if slug == "" {
+		slug = series.ID.String()
+	}
+	return &core.ContentPackage{
+		Filename: fmt.Sprintf("%s-%s.zip", slug, mode),
+		Data:     body,
+	}, nil
+}
+
+// archive writes zip entries with a fixed modification time so exports of
+// unchanged content are byte-identical.
+type archive struct {
+	buf      bytes.Buffer
+	zip      *zip.Writer
+	modified time.Time
+}
+
+func newArchive(modified time.Time) *archive {
+	if modified.IsZero() {
+		modified = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	a := &archive{modified: modified.UTC()}
+	a.zip = zip.NewWriter(&a.buf)
+	return a
+}
+
+func (a *archive) create(name string) (*bytes.Buffer, func() error) {
+	var content bytes.Buffer
+	return &content, func() error {
+		w, err := a.zip.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: a.modified})
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(content.Bytes())
+		return err
+	}
+}
+
+func (a *archive) renderXML(name, tmpl string, data any) error {
+	content, flush := a.create(name)
+	if err := xmlTemplates.ExecuteTemplate(content, tmpl, data); err != nil {
+		return fmt.Errorf("render %s: %w", name, err)
+	}
+	return flush()
+}
+
+func (a *archive) renderHTML(name, tmpl string, data any) error {
+	content, flush := a.create(name)
+	if err := htmlTemplates.ExecuteTemplate(content, tmpl, data); err != nil {
+		return fmt.Errorf("render %s: %w", name, err)
+	}
+	return flush()
+}
+
+func (a *archive) copyStatic(name string) error {
+	body, err := templateFS.ReadFile("templates/" + name)
+	if err != nil {
+		return err
+	}
+	content, flush := a.create(name)
+	content.Write(body)
+	return flush()
+}
+
+func (a *archive) close() ([]byte, error) {
+	if err := a.zip.Close(); err != nil {
+		return nil, err
+	}
+	return a.buf.Bytes(), nil
+}
+
+// plainTranscript returns transcript text suitable for display. Structured
+// JSON transcripts are omitted rather than shown raw.
+func plainTranscript(transcript core.Transcript) string {
+	if transcript.Format == core.TranscriptFormatJSON {
+		return ""
+	}
+	return strings.TrimSpace(transcript.Content)
+}
+
+func escapeXML(value string) (string, error) {
+	var buf strings.Builder
+	if err := xml.EscapeText(&buf, []byte(value)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
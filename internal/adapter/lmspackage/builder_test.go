@@ -0,0 +1,138 @@
+package lmspackage
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func testSeries() (core.Series, []core.Episode) {
+	series := core.Series{
+		ID:        uuid.New(),
+		Slug:      "coffee-english",
+		Title:     "Coffee & Conversation",
+		Language:  "en",
+		UpdatedAt: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+	episodes := []core.Episode{
+		{
+			ID:         uuid.New(),
+			Seq:        1,
+			Title:      "Ordering <coffee>",
+			Resource:   core.MediaResource{Type: core.MediaTypeVideo, PlaybackURL: "https://cdn.example.com/1.m3u8"},
+			Transcript: core.Transcript{Format: core.TranscriptFormatPlain, Content: "A latte, please."},
+		},
+		{
+			ID:       uuid.New(),
+			Seq:      2,
+			Title:    "Small talk",
+			Resource: core.MediaResource{Type: core.MediaTypeAudio, PlaybackURL: "https://cdn.example.com/2.mp3"},
+		},
+	}
+	return series, episodes
+}
+
+func readArchive(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("open zip: %v", err)
+	}
+	files := map[string]string{}
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", file.Name, err)
+		}
+		body, _ := io.ReadAll(rc)
+		_ = rc.Close()
+		files[file.Name] = string(body)
+	}
+	return files
+}
+
+func TestBuildPackageSCORM12(t *testing.T) {
+	series, episodes := testSeries()
+
+	pkg, err := NewBuilder().BuildPackage(core.PackageFormatSCORM12, series, episodes)
+	if err != nil {
+		t.Fatalf("BuildPackage() error = %v", err)
+	}
+	if pkg.Filename != "coffee-english-scorm12.zip" {
+		t.Fatalf("unexpected filename %q", pkg.Filename)
+	}
+
+	files := readArchive(t, pkg.Data)
+	for _, name := range []string{"imsmanifest.xml", "episodes/001.html", "episodes/002.html", "player.js", "player.css"} {
+		if _, ok := files[name]; !ok {
+			t.Fatalf("package is missing %s", name)
+		}
+	}
+	if _, ok := files["index.html"]; ok {
+		t.Fatal("SCORM packages launch each SCO directly and need no index")
+	}
+
+	var manifest struct {
+		Title     string `xml:"organizations>organization>title"`
+		Resources []struct {
+			Href string `xml:"href,attr"`
+		} `xml:"resources>resource"`
+	}
+	if err := xml.Unmarshal([]byte(files["imsmanifest.xml"]), &manifest); err != nil {
+		t.Fatalf("manifest is not valid XML: %v", err)
+	}
+	if manifest.Title != "Coffee & Conversation" || len(manifest.Resources) != 3 || manifest.Resources[0].Href != "episodes/001.html" {
+		t.Fatalf("unexpected manifest %+v", manifest)
+	}
+
+	first := files["episodes/001.html"]
+	if !strings.Contains(first, "Ordering &lt;coffee&gt;") || !strings.Contains(first, "A latte, please.") || !strings.Contains(first, "<video") {
+		t.Fatalf("unexpected episode page:\n%s", first)
+	}
+	if !strings.Contains(files["episodes/002.html"], "<audio") {
+		t.Fatal("audio episodes should render an audio player")
+	}
+}
+
+func TestBuildPackageXAPI(t *testing.T) {
+	series, episodes := testSeries()
+
+	pkg, err := NewBuilder().BuildPackage(core.PackageFormatXAPI, series, episodes)
+	if err != nil {
+		t.Fatalf("BuildPackage() error = %v", err)
+	}
+	files := readArchive(t, pkg.Data)
+	if _, ok := files["index.html"]; !ok {
+		t.Fatal("xAPI packages launch from index.html")
+	}
+
+	var manifest struct {
+		Activities []struct {
+			ID     string `xml:"id,attr"`
+			Launch string `xml:"launch"`
+		} `xml:"activities>activity"`
+	}
+	if err := xml.Unmarshal([]byte(files["tincan.xml"]), &manifest); err != nil {
+		t.Fatalf("tincan.xml is not valid XML: %v", err)
+	}
+	if len(manifest.Activities) != 3 || manifest.Activities[0].Launch != "index.html" || manifest.Activities[1].ID != "urn:lession:episode:"+episodes[0].ID.String() {
+		t.Fatalf("unexpected activities %+v", manifest.Activities)
+	}
+
+	again, err := NewBuilder().BuildPackage(core.PackageFormatXAPI, series, episodes)
+	if err != nil {
+		t.Fatalf("BuildPackage() error = %v", err)
+	}
+	if !bytes.Equal(pkg.Data, again.Data) {
+		t.Fatal("expected exports of unchanged content to be byte-identical")
+	}
+}
@@ -0,0 +1,462 @@
+package lti
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// LaunchPath is where platforms post id_tokens; it is the tool's redirect URI.
+	LaunchPath = "/lti/v1/launch"
+
+	// DefaultClockSkew is the leeway allowed when checking token timestamps.
+	DefaultClockSkew = time.Minute
+	// DefaultKeySetTTL bounds how long a platform's JWKS is cached.
+	DefaultKeySetTTL = time.Hour
+
+	ltiVersion           = "1.3.0"
+	scoreScope           = "https://purl.imsglobal.org/spec/lti-ags/scope/score"
+	scoreContentType     = "application/vnd.ims.lis.v1.score+json"
+	clientAssertionType  = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+	messageResourceLink  = "LtiResourceLinkRequest"
+	messageDeepLinking   = "LtiDeepLinkingRequest"
+	messageDeepLinkReply = "LtiDeepLinkingResponse"
+)
+
+// Client implements LTI 1.3 message security, deep linking and the AGS score
+// service using only the standard library.
+type Client struct {
+	toolURL    string
+	key        *rsa.PrivateKey
+	keyID      string
+	httpClient *http.Client
+	skew       time.Duration
+	keySetTTL  time.Duration
+	now        func() time.Time
+
+	mu      sync.Mutex
+	keySets map[string]cachedKeySet
+	tokens  map[string]cachedToken
+}
+
+type cachedKeySet struct {
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+type cachedToken struct {
+	value     string
+	expiresAt time.Time
+}
+
+// NewClient constructs a client for the tool served at toolURL that signs its
+// messages with key.
+func NewClient(toolURL string, key *rsa.PrivateKey) (*Client, error) {
+	if toolURL == "" || key == nil {
+		return nil, errors.New("lti: tool url and signing key are required")
+	}
+	digest := sha256.Sum256(key.N.Bytes())
+	return &Client{
+		toolURL:    strings.TrimRight(toolURL, "/"),
+		key:        key,
+		keyID:      hex.EncodeToString(digest[:8]),
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		skew:       DefaultClockSkew,
+		keySetTTL:  DefaultKeySetTTL,
+		now:        time.Now,
+		keySets:    map[string]cachedKeySet{},
+		tokens:     map[string]cachedToken{},
+	}, nil
+}
+
+// ParsePrivateKey decodes a PEM-encoded PKCS#1 or PKCS#8 RSA private key.
+func ParsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("lti: private key is not PEM encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("lti: parse private key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("lti: private key is not an RSA key")
+	}
+	return key, nil
+}
+
+// GenerateKey creates a random signing key. Platforms must re-fetch the JWKS
+// after every restart, so it is only suitable for local development.
+func GenerateKey() (*rsa.PrivateKey, error) {
+	return rsa.GenerateKey(rand.Reader, 2048)
+}
+
+// WithClock overrides the clock used for token timestamps.
+func (c *Client) WithClock(fn func() time.Time) {
+	if fn != nil {
+		c.now = fn
+	}
+}
+
+// WithHTTPClient overrides the HTTP client used to reach platforms.
+func (c *Client) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		c.httpClient = client
+	}
+}
+
+var _ core.LTIClient = (*Client)(nil)
+
+// RedirectURI returns the launch endpoint registered with platforms.
+func (c *Client) RedirectURI() string {
+	return c.toolURL + LaunchPath
+}
+
+// PublicKeySet returns the tool's JWKS document.
+func (c *Client) PublicKeySet() ([]byte, error) {
+	return json.Marshal(jwkSet{Keys: []jwk{publicJWK(c.keyID, &c.key.PublicKey)}})
+}
+
+// audience accepts the aud claim as either a string or an array.
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*a = many
+	return nil
+}
+
+type launchClaims struct {
+	Issuer        string         `json:"iss"`
+	Audience      audience       `json:"aud"`
+	AuthorizedBy  string         `json:"azp"`
+	Subject       string         `json:"sub"`
+	ExpiresAt     int64          `json:"exp"`
+	IssuedAt      int64          `json:"iat"`
+	Nonce         string         `json:"nonce"`
+	Name          string         `json:"name"`
+	MessageType   string         `json:"https://purl.imsglobal.org/spec/lti/claim/message_type"`
+	Version       string         `json:"https://purl.imsglobal.org/spec/lti/claim/version"`
+	DeploymentID  string         `json:"https://purl.imsglobal.org/spec/lti/claim/deployment_id"`
+	TargetLinkURI string         `json:"https://purl.imsglobal.org/spec/lti/claim/target_link_uri"`
+	Roles         []string       `json:"https://purl.imsglobal.org/spec/lti/claim/roles"`
+	Custom        map[string]any `json:"https://purl.imsglobal.org/spec/lti/claim/custom"`
+	ResourceLink  struct {
+		ID string `json:"id"`
+	} `json:"https://purl.imsglobal.org/spec/lti/claim/resource_link"`
+	Endpoint *struct {
+		Scope    []string `json:"scope"`
+		LineItem string   `json:"lineitem"`
+	} `json:"https://purl.imsglobal.org/spec/lti-ags/claim/endpoint"`
+	DeepLinking *struct {
+		ReturnURL string `json:"deep_link_return_url"`
+		Data      string `json:"data"`
+	} `json:"https://purl.imsglobal.org/spec/lti-dl/claim/deep_linking_settings"`
+}
+
+// VerifyLaunch verifies an id_token signed by the platform and decodes its
+// LTI claims. Nonce and deployment checks are left to the caller, which knows
+// the login state.
+func (c *Client) VerifyLaunch(ctx context.Context, platform core.LTIPlatform, idToken string) (*core.LTIMessage, error) {
+	token, err := parseJWT(idToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", core.ErrValidation, err)
+	}
+	key, err := c.platformKey(ctx, platform.JWKSURL, token.header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err := token.verify(key); err != nil {
+		return nil, fmt.Errorf("%w: %v", core.ErrValidation, err)
+	}
+
+	var claims launchClaims
+	if err := json.Unmarshal(token.payload, &claims); err != nil {
+		return nil, fmt.Errorf("%w: decode id_token claims: %v", core.ErrValidation, err)
+	}
+
+	now := c.now()
+	switch {
+	case claims.Issuer != platform.Issuer:
+		return nil, fmt.Errorf("%w: id_token issuer %q does not match the platform", core.ErrValidation, claims.Issuer)
+	case !slices.Contains(claims.Audience, platform.ClientID):
+		return nil, fmt.Errorf("%w: id_token is not addressed to client %q", core.ErrValidation, platform.ClientID)
+	case len(claims.Audience) > 1 && claims.AuthorizedBy != platform.ClientID:
+		return nil, fmt.Errorf("%w: id_token azp does not match client %q", core.ErrValidation, platform.ClientID)
+	case now.After(time.Unix(claims.ExpiresAt, 0).Add(c.skew)):
+		return nil, fmt.Errorf("%w: id_token expired", core.ErrValidation)
+	case time.Unix(claims.IssuedAt, 0).After(now.Add(c.skew)):
+		return nil, fmt.Errorf("%w: id_token issued in the future", core.ErrValidation)
+	case claims.Version != ltiVersion:
+		return nil, fmt.Errorf("%w: unsupported lti version %q", core.ErrValidation, claims.Version)
+	}
+
+	message := &core.LTIMessage{
+		DeploymentID:   claims.DeploymentID,
+		Subject:        claims.Subject,
+		Nonce:          claims.Nonce,
+		Name:           claims.Name,
+		Roles:          claims.Roles,
+		TargetLinkURI:  claims.TargetLinkURI,
+		ResourceLinkID: claims.ResourceLink.ID,
+		Custom:         make(map[string]string, len(claims.Custom)),
+	}
+	for name, value := range claims.Custom {
+		message.Custom[name] = fmt.Sprint(value)
+	}
+	switch claims.MessageType {
+	case messageResourceLink:
+		message.Type = core.LTIMessageTypeResourceLink
+	case messageDeepLinking:
+		message.Type = core.LTIMessageTypeDeepLinking
+	}
+	if claims.Endpoint != nil && slices.Contains(claims.Endpoint.Scope, scoreScope) {
+		message.LineItemURL = claims.Endpoint.LineItem
+	}
+	if claims.DeepLinking != nil {
+		message.DeepLinkReturnURL = claims.DeepLinking.ReturnURL
+		message.DeepLinkData = claims.DeepLinking.Data
+	}
+	return message, nil
+}
+
+// SignDeepLinkingResponse returns the deep linking response JWT listing the
+// chosen episodes as resource links, each with a gradebook line item.
+func (c *Client) SignDeepLinkingResponse(platform core.LTIPlatform, deploymentID, data string, items []core.LTIContentItem) (string, error) {
+	contentItems := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		contentItems = append(contentItems, map[string]any{
+			"type":   "ltiResourceLink",
+			"title":  item.Title,
+			"url":    c.RedirectURI() + "?episode_id=" + item.EpisodeID.String(),
+			"custom": map[string]string{"episode_id": item.EpisodeID.String()},
+			"lineItem": map[string]any{
+				"scoreMaximum": 1,
+				"label":        item.Title,
+				"resourceId":   item.EpisodeID.String(),
+			},
+		})
+	}
+
+	now := c.now()
+	claims := map[string]any{
+		"iss":   platform.ClientID,
+		"aud":   platform.Issuer,
+		"iat":   now.Unix(),
+		"exp":   now.Add(5 * time.Minute).Unix(),
+		"nonce": uuid.NewString(),
+		"https://purl.imsglobal.org/spec/lti/claim/message_type":     messageDeepLinkReply,
+		"https://purl.imsglobal.org/spec/lti/claim/version":          ltiVersion,
+		"https://purl.imsglobal.org/spec/lti/claim/deployment_id":    deploymentID,
+		"https://purl.imsglobal.org/spec/lti-dl/claim/content_items": contentItems,
+	}
+	if data != "" {
+		claims["https://purl.imsglobal.org/spec/lti-dl/claim/data"] = data
+	}
+	return signRS256(c.key, c.keyID, claims)
+}
+
+// PublishScore posts a score to the line item's scores endpoint, obtaining
+// an access token through the OAuth2 client credentials grant.
+func (c *Client) PublishScore(ctx context.Context, platform core.LTIPlatform, lineItemURL string, score core.LTIScore) error {
+	scoresURL, err := url.Parse(lineItemURL)
+	if err != nil {
+		return fmt.Errorf("lti: parse line item url: %w", err)
+	}
+	scoresURL.Path = strings.TrimRight(scoresURL.Path, "/") + "/scores"
+
+	token, err := c.accessToken(ctx, platform)
+	if err != nil {
+		return err
+	}
+
+	activity, grading := "InProgress", "Pending"
+	if score.Completed {
+		activity, grading = "Completed", "FullyGraded"
+	}
+	body, err := json.Marshal(map[string]any{
+		"userId":           score.Subject,
+		"scoreGiven":       score.ScoreGiven,
+		"scoreMaximum":     score.ScoreMaximum,
+		"activityProgress": activity,
+		"gradingProgress":  grading,
+		"timestamp":        score.Timestamp.UTC().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, scoresURL.String(), strings.NewReader(string(body)))
+	if err != nil {
+		return fmt.Errorf("lti: build score request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", scoreContentType)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("lti: post score: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("lti: post score: platform returned %s: %s", resp.Status, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
+
+// accessToken returns a cached AGS token for the platform or requests a new
+// one with a signed client assertion.
+func (c *Client) accessToken(ctx context.Context, platform core.LTIPlatform) (string, error) {
+	cacheKey := platform.AuthTokenURL + "|" + platform.ClientID
+	now := c.now()
+
+	c.mu.Lock()
+	cached, ok := c.tokens[cacheKey]
+	c.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.value, nil
+	}
+
+	assertion, err := signRS256(c.key, c.keyID, map[string]any{
+		"iss": platform.ClientID,
+		"sub": platform.ClientID,
+		"aud": platform.AuthTokenURL,
+		"iat": now.Unix(),
+		"exp": now.Add(5 * time.Minute).Unix(),
+		"jti": uuid.NewString(),
+	})
+	if err != nil {
+		return "", err
+	}
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_assertion_type", clientAssertionType)
+	form.Set("client_assertion", assertion)
+	form.Set("scope", scoreScope)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, platform.AuthTokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("lti: build token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("lti: request access token: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("lti: request access token: platform returned %s: %s", resp.Status, strings.TrimSpace(string(detail)))
+	}
+
+	var payload struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return "", fmt.Errorf("lti: decode access token: %w", err)
+	}
+	if payload.AccessToken == "" {
+		return "", errors.New("lti: platform returned an empty access token")
+	}
+
+	// Refresh a minute early so tokens do not expire mid-request.
+	ttl := time.Duration(payload.ExpiresIn)*time.Second - time.Minute
+	if ttl > 0 {
+		c.mu.Lock()
+		c.tokens[cacheKey] = cachedToken{value: payload.AccessToken, expiresAt: now.Add(ttl)}
+		c.mu.Unlock()
+	}
+	return payload.AccessToken, nil
+}
+
+// platformKey returns the platform's verification key for kid, refetching the
+// JWKS when the cache is stale or does not know the key (e.g. after rotation).
+func (c *Client) platformKey(ctx context.Context, jwksURL, kid string) (*rsa.PublicKey, error) {
+	now := c.now()
+	c.mu.Lock()
+	cached, ok := c.keySets[jwksURL]
+	c.mu.Unlock()
+	if ok && now.Sub(cached.fetchedAt) < c.keySetTTL {
+		if key, found := cached.keys[kid]; found {
+			return key, nil
+		}
+	}
+
+	keys, err := c.fetchKeySet(ctx, jwksURL)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.keySets[jwksURL] = cachedKeySet{keys: keys, fetchedAt: now}
+	c.mu.Unlock()
+
+	key, found := keys[kid]
+	if !found {
+		return nil, fmt.Errorf("%w: platform has no key %q", core.ErrValidation, kid)
+	}
+	return key, nil
+}
+
+func (c *Client) fetchKeySet(ctx context.Context, jwksURL string) (map[string]*rsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("lti: build jwks request: %w", err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("lti: fetch platform jwks: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("lti: fetch platform jwks: platform returned %s", resp.Status)
+	}
+
+	var set jwkSet
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&set); err != nil {
+		return nil, fmt.Errorf("lti: decode platform jwks: %w", err)
+	}
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, entry := range set.Keys {
+		if entry.Kty != "RSA" || (entry.Use != "" && entry.Use != "sig") {
+			continue
+		}
+		key, err := entry.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("lti: platform key %q: %w", entry.Kid, err)
+		}
+		keys[entry.Kid] = key
+	}
+	return keys, nil
+}
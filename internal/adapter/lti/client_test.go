@@ -0,0 +1,197 @@
+package lti
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+var testNow = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// fakePlatform serves a JWKS, a token endpoint and an AGS scores endpoint.
+type fakePlatform struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	tool   *rsa.PublicKey
+	scores []map[string]any
+}
+
+func newFakePlatform(t *testing.T) *fakePlatform {
+	t.Helper()
+
+	key, err := GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	platform := &fakePlatform{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(jwkSet{Keys: []jwk{publicJWK("platform-key", &key.PublicKey)}})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		assertion, err := parseJWT(r.FormValue("client_assertion"))
+		if err != nil || assertion.verify(platform.tool) != nil || r.FormValue("scope") != scoreScope {
+			http.Error(w, "invalid client", http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "ags-token", "expires_in": 3600})
+	})
+	mux.HandleFunc("POST /lineitems/1/scores", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer ags-token" || r.Header.Get("Content-Type") != scoreContentType {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		var score map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&score)
+		platform.scores = append(platform.scores, score)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	platform.server = httptest.NewServer(mux)
+	t.Cleanup(platform.server.Close)
+	return platform
+}
+
+func (p *fakePlatform) registration() core.LTIPlatform {
+	return core.LTIPlatform{
+		Issuer:       "https://lms.example.edu",
+		ClientID:     "client-1",
+		AuthTokenURL: p.server.URL + "/token",
+		JWKSURL:      p.server.URL + "/jwks",
+	}
+}
+
+func (p *fakePlatform) idToken(t *testing.T, overrides map[string]any) string {
+	t.Helper()
+
+	claims := map[string]any{
+		"iss":   "https://lms.example.edu",
+		"aud":   "client-1",
+		"sub":   "student-7",
+		"iat":   testNow.Unix(),
+		"exp":   testNow.Add(5 * time.Minute).Unix(),
+		"nonce": "nonce-1",
+		"https://purl.imsglobal.org/spec/lti/claim/message_type":  messageResourceLink,
+		"https://purl.imsglobal.org/spec/lti/claim/version":       ltiVersion,
+		"https://purl.imsglobal.org/spec/lti/claim/deployment_id": "deployment-1",
+		"https://purl.imsglobal.org/spec/lti/claim/resource_link": map[string]any{"id": "link-1"},
+		"https://purl.imsglobal.org/spec/lti/claim/custom":        map[string]any{"episode_id": "ep-1"},
+		"https://purl.imsglobal.org/spec/lti-ags/claim/endpoint": map[string]any{
+			"scope":    []string{scoreScope},
+			"lineitem": p.server.URL + "/lineitems/1",
+		},
+	}
+	for name, value := range overrides {
+		claims[name] = value
+	}
+	token, err := signRS256(p.key, "platform-key", claims)
+	if err != nil {
+		t.Fatalf("sign id_token: %v", err)
+	}
+	return token
+}
+
+func newTestClient(t *testing.T, platform *fakePlatform) *Client {
+	t.Helper()
+
+	key, err := GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	client, err := NewClient("https://tool.example.com/", key)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	client.WithClock(func() time.Time { return testNow })
+	platform.tool = &key.PublicKey
+	return client
+}
+
+func TestClientVerifyLaunch(t *testing.T) {
+	platform := newFakePlatform(t)
+	client := newTestClient(t, platform)
+
+	message, err := client.VerifyLaunch(context.Background(), platform.registration(), platform.idToken(t, nil))
+	if err != nil {
+		t.Fatalf("VerifyLaunch() error = %v", err)
+	}
+	if message.Type != core.LTIMessageTypeResourceLink || message.Subject != "student-7" || message.Nonce != "nonce-1" {
+		t.Fatalf("unexpected message %+v", message)
+	}
+	if message.Custom["episode_id"] != "ep-1" || message.LineItemURL != platform.server.URL+"/lineitems/1" {
+		t.Fatalf("unexpected launch claims %+v", message)
+	}
+}
+
+func TestClientVerifyLaunchRejectsInvalidTokens(t *testing.T) {
+	platform := newFakePlatform(t)
+	client := newTestClient(t, platform)
+
+	tests := map[string]string{
+		"wrong audience": platform.idToken(t, map[string]any{"aud": "other-client"}),
+		"wrong issuer":   platform.idToken(t, map[string]any{"iss": "https://evil.example.com"}),
+		"expired":        platform.idToken(t, map[string]any{"exp": testNow.Add(-time.Hour).Unix()}),
+		"tampered":       platform.idToken(t, nil)[:40] + "x" + platform.idToken(t, nil)[41:],
+		"unsigned":       "eyJhbGciOiJub25lIn0.e30.",
+	}
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := client.VerifyLaunch(context.Background(), platform.registration(), token); !errors.Is(err, core.ErrValidation) {
+				t.Fatalf("VerifyLaunch() error = %v, want ErrValidation", err)
+			}
+		})
+	}
+}
+
+func TestClientPublishScore(t *testing.T) {
+	platform := newFakePlatform(t)
+	client := newTestClient(t, platform)
+
+	score := core.LTIScore{Subject: "student-7", ScoreGiven: 1, ScoreMaximum: 1, Completed: true, Timestamp: testNow}
+	for range 2 {
+		if err := client.PublishScore(context.Background(), platform.registration(), platform.server.URL+"/lineitems/1", score); err != nil {
+			t.Fatalf("PublishScore() error = %v", err)
+		}
+	}
+	if len(platform.scores) != 2 {
+		t.Fatalf("expected 2 scores, got %d", len(platform.scores))
+	}
+	if got := platform.scores[0]; got["userId"] != "student-7" || got["gradingProgress"] != "FullyGraded" || got["activityProgress"] != "Completed" {
+		t.Fatalf("unexpected score payload %v", got)
+	}
+}
+
+func TestClientSignDeepLinkingResponse(t *testing.T) {
+	platform := newFakePlatform(t)
+	client := newTestClient(t, platform)
+
+	signed, err := client.SignDeepLinkingResponse(platform.registration(), "deployment-1", "opaque", []core.LTIContentItem{{Title: "Ordering coffee"}})
+	if err != nil {
+		t.Fatalf("SignDeepLinkingResponse() error = %v", err)
+	}
+
+	var set jwkSet
+	raw, err := client.PublicKeySet()
+	if err != nil || json.Unmarshal(raw, &set) != nil || len(set.Keys) != 1 {
+		t.Fatalf("unexpected key set %s (%v)", raw, err)
+	}
+	key, err := set.Keys[0].publicKey()
+	if err != nil {
+		t.Fatalf("publicKey() error = %v", err)
+	}
+	token, err := parseJWT(signed)
+	if err != nil || token.verify(key) != nil || token.header.Kid != set.Keys[0].Kid {
+		t.Fatalf("deep linking response does not verify against the published key")
+	}
+	if !strings.Contains(string(token.payload), messageDeepLinkReply) || !strings.Contains(string(token.payload), `"opaque"`) {
+		t.Fatalf("unexpected payload %s", token.payload)
+	}
+}
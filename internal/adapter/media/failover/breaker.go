@@ -0,0 +1,140 @@
+package failover
+
+import (
+	"sync"
+	"time"
+)
+
+// BreakerOptions tunes when the primary provider is considered unhealthy.
+type BreakerOptions struct {
+	// Window is the number of most recent primary calls considered.
+	Window int
+	// MinRequests is the number of calls required in the window before tripping.
+	MinRequests int
+	// FailureRatio trips the breaker once failures/calls reaches this value.
+	FailureRatio float64
+	// Cooldown keeps the breaker open before a trial call is let through.
+	Cooldown time.Duration
+}
+
+// DefaultBreakerOptions returns conservative defaults suitable for upload providers.
+func DefaultBreakerOptions() BreakerOptions {
+	return BreakerOptions{
+		Window:       20,
+		MinRequests:  5,
+		FailureRatio: 0.5,
+		Cooldown:     30 * time.Second,
+	}
+}
+
+type breakerState int
+
+const (
+	stateClosed breakerState = iota
+	stateOpen
+	stateHalfOpen
+)
+
+// breaker is a count-based circuit breaker over a sliding window of outcomes.
+type breaker struct {
+	mu       sync.Mutex
+	opts     BreakerOptions
+	now      func() time.Time
+	state    breakerState
+	outcomes []bool
+	next     int
+	filled   int
+	openedAt time.Time
+	trialing bool
+}
+
+func newBreaker(opts BreakerOptions, now func() time.Time) *breaker {
+	defaults := DefaultBreakerOptions()
+	if opts.Window <= 0 {
+		opts.Window = defaults.Window
+	}
+	if opts.MinRequests <= 0 {
+		opts.MinRequests = defaults.MinRequests
+	}
+	if opts.FailureRatio <= 0 || opts.FailureRatio > 1 {
+		opts.FailureRatio = defaults.FailureRatio
+	}
+	if opts.Cooldown <= 0 {
+		opts.Cooldown = defaults.Cooldown
+	}
+	return &breaker{
+		opts:     opts,
+		now:      now,
+		outcomes: make([]bool, opts.Window),
+	}
+}
+
+// allow reports whether a call may be sent to the guarded provider.
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case stateOpen:
+		if b.now().Sub(b.openedAt) < b.opts.Cooldown {
+			return false
+		}
+		b.state = stateHalfOpen
+		b.trialing = true
+		return true
+	case stateHalfOpen:
+		// Only one trial call at a time while probing recovery.
+		if b.trialing {
+			return false
+		}
+		b.trialing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// record feeds the outcome of a call back into the breaker.
+func (b *breaker) record(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == stateHalfOpen {
+		b.trialing = false
+		if success {
+			b.reset()
+		} else {
+			b.trip()
+		}
+		return
+	}
+
+	b.outcomes[b.next] = success
+	b.next = (b.next + 1) % len(b.outcomes)
+	if b.filled < len(b.outcomes) {
+		b.filled++
+	}
+
+	if b.state == stateClosed && b.filled >= b.opts.MinRequests {
+		failures := 0
+		for i := 0; i < b.filled; i++ {
+			if !b.outcomes[i] {
+				failures++
+			}
+		}
+		if float64(failures)/float64(b.filled) >= b.opts.FailureRatio {
+			b.trip()
+		}
+	}
+}
+
+func (b *breaker) trip() {
+	b.state = stateOpen
+	b.openedAt = b.now()
+}
+
+func (b *breaker) reset() {
+	b.state = stateClosed
+	b.next = 0
+	b.filled = 0
+}
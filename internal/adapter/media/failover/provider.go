@@ -0,0 +1,137 @@
+package failover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// Provider routes new uploads to a primary provider and fails over to a
+// fallback while the primary's circuit breaker is open. Completions are routed
+// to whichever provider issued the upload.
+type Provider struct {
+	primary  core.UploadProvider
+	fallback core.UploadProvider
+	breaker  *breaker
+}
+
+// NewProvider constructs a failover provider. Both providers must report distinct names.
+func NewProvider(primary, fallback core.UploadProvider, opts BreakerOptions) (*Provider, error) {
+	if primary == nil || fallback == nil {
+		return nil, errors.New("failover: primary and fallback providers are required")
+	}
+	if primary.Name() == fallback.Name() {
+		return nil, fmt.Errorf("failover: primary and fallback share the name %q", primary.Name())
+	}
+	return &Provider{
+		primary:  primary,
+		fallback: fallback,
+		breaker:  newBreaker(opts, time.Now),
+	}, nil
+}
+
+// WithClock overrides the clock used by the circuit breaker.
+func (p *Provider) WithClock(fn func() time.Time) {
+	if fn != nil {
+		p.breaker.now = fn
+	}
+}
+
+var (
+	_ core.UploadProvider = (*Provider)(nil)
+	_ core.HealthChecker  = (*Provider)(nil)
+	_ core.UploadAborter  = (*Provider)(nil)
+)
+
+// Name reports the primary provider's name.
+func (p *Provider) Name() string {
+	return p.primary.Name()
+}
+
+// CreateUpload issues the upload through the primary when healthy, otherwise through the fallback.
+func (p *Provider) CreateUpload(ctx context.Context, params core.ProviderCreateUploadParams) (*core.ProviderCreateUploadResult, error) {
+	if p.breaker.allow() {
+		res, err := p.primary.CreateUpload(ctx, params)
+		if ctx.Err() == nil {
+			p.breaker.record(err == nil)
+		}
+		if err == nil {
+			return withProvider(res, p.primary.Name()), nil
+		}
+		if ctx.Err() != nil {
+			return nil, err
+		}
+	}
+
+	res, err := p.fallback.CreateUpload(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return withProvider(res, p.fallback.Name()), nil
+}
+
+// CompleteUpload routes the completion to the provider recorded with the upload.
+func (p *Provider) CompleteUpload(ctx context.Context, params core.ProviderCompleteUploadParams) (*core.ProviderCompleteUploadResult, error) {
+	switch params.Provider {
+	case "", p.primary.Name():
+		// Uploads created before providers were recorded belong to the primary.
+		return p.primary.CompleteUpload(ctx, params)
+	case p.fallback.Name():
+		return p.fallback.CompleteUpload(ctx, params)
+	default:
+		return nil, fmt.Errorf("%w: unknown upload provider %q", core.ErrUploadInvalidState, params.Provider)
+	}
+}
+
+// AbortUpload routes the abort to the provider recorded with the upload.
+// Providers that hold nothing for unfinished uploads have nothing to abort.
+func (p *Provider) AbortUpload(ctx context.Context, params core.ProviderAbortUploadParams) error {
+	var provider core.UploadProvider
+	switch params.Provider {
+	case "", p.primary.Name():
+		provider = p.primary
+	case p.fallback.Name():
+		provider = p.fallback
+	default:
+		return fmt.Errorf("%w: unknown upload provider %q", core.ErrUploadInvalidState, params.Provider)
+	}
+	if aborter, ok := provider.(core.UploadAborter); ok {
+		return aborter.AbortUpload(ctx, params)
+	}
+	return nil
+}
+
+// CheckHealth reports the provider healthy while either the primary or the
+// fallback is reachable, since uploads fail over between them. Providers
+// that cannot report their health are assumed reachable.
+func (p *Provider) CheckHealth(ctx context.Context) error {
+	primaryErr := checkHealth(ctx, p.primary)
+	if primaryErr == nil {
+		return nil
+	}
+	fallbackErr := checkHealth(ctx, p.fallback)
+	if fallbackErr == nil {
+		return nil
+	}
+	return errors.Join(
+		fmt.Errorf("%s: %w", p.primary.Name(), primaryErr),
+		fmt.Errorf("%s: %w", p.fallback.Name(), fallbackErr),
+	)
+}
+
+func checkHealth(ctx context.Context, provider core.UploadProvider) error {
+	if checker, ok := provider.(core.HealthChecker); ok {
+		return checker.CheckHealth(ctx)
+	}
+	return nil
+}
+
+func withProvider(res *core.ProviderCreateUploadResult, name string) *core.ProviderCreateUploadResult {
+	if res != nil && res.Provider == "" {
+		res.Provider = name
+	}
+	return res
+}
@@ -0,0 +1,125 @@
+package failover
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestProvider_FailsOverWhenBreakerTrips(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	primary := &stubProvider{name: "primary", createErr: errors.New("unavailable")}
+	fallback := &stubProvider{name: "fallback"}
+
+	provider, err := NewProvider(primary, fallback, BreakerOptions{Window: 4, MinRequests: 2, FailureRatio: 0.5, Cooldown: time.Minute})
+	if err != nil {
+		t.Fatalf("NewProvider() error = %v", err)
+	}
+	provider.WithClock(func() time.Time { return now })
+
+	for i := 0; i < 2; i++ {
+		res, err := provider.CreateUpload(context.Background(), core.ProviderCreateUploadParams{})
+		if err != nil {
+			t.Fatalf("CreateUpload() error = %v", err)
+		}
+		if res.Provider != "fallback" {
+			t.Fatalf("expected fallback to serve failed primary call, got %q", res.Provider)
+		}
+	}
+	if primary.createCalls != 2 {
+		t.Fatalf("expected 2 primary attempts before tripping, got %d", primary.createCalls)
+	}
+
+	if _, err := provider.CreateUpload(context.Background(), core.ProviderCreateUploadParams{}); err != nil {
+		t.Fatalf("CreateUpload() error = %v", err)
+	}
+	if primary.createCalls != 2 {
+		t.Fatalf("expected open breaker to skip primary, got %d calls", primary.createCalls)
+	}
+
+	primary.createErr = nil
+	now = now.Add(2 * time.Minute)
+	res, err := provider.CreateUpload(context.Background(), core.ProviderCreateUploadParams{})
+	if err != nil {
+		t.Fatalf("CreateUpload() error = %v", err)
+	}
+	if res.Provider != "primary" {
+		t.Fatalf("expected primary to recover after cooldown, got %q", res.Provider)
+	}
+}
+
+func TestProvider_CompleteUploadRoutesByProvider(t *testing.T) {
+	primary := &stubProvider{name: "primary"}
+	fallback := &stubProvider{name: "fallback"}
+	provider, err := NewProvider(primary, fallback, DefaultBreakerOptions())
+	if err != nil {
+		t.Fatalf("NewProvider() error = %v", err)
+	}
+
+	if _, err := provider.CompleteUpload(context.Background(), core.ProviderCompleteUploadParams{Provider: "fallback"}); err != nil {
+		t.Fatalf("CompleteUpload() error = %v", err)
+	}
+	if _, err := provider.CompleteUpload(context.Background(), core.ProviderCompleteUploadParams{}); err != nil {
+		t.Fatalf("CompleteUpload() error = %v", err)
+	}
+	if fallback.completeCalls != 1 || primary.completeCalls != 1 {
+		t.Fatalf("unexpected routing primary=%d fallback=%d", primary.completeCalls, fallback.completeCalls)
+	}
+
+	_, err = provider.CompleteUpload(context.Background(), core.ProviderCompleteUploadParams{Provider: "retired"})
+	if !errors.Is(err, core.ErrUploadInvalidState) {
+		t.Fatalf("expected ErrUploadInvalidState for unknown provider, got %v", err)
+	}
+}
+
+func TestProvider_AbortUploadRoutesByProvider(t *testing.T) {
+	primary := &stubProvider{name: "primary"}
+	fallback := &stubProvider{name: "fallback"}
+	provider, err := NewProvider(primary, fallback, DefaultBreakerOptions())
+	if err != nil {
+		t.Fatalf("NewProvider() error = %v", err)
+	}
+
+	if err := provider.AbortUpload(context.Background(), core.ProviderAbortUploadParams{Provider: "fallback"}); err != nil {
+		t.Fatalf("AbortUpload() error = %v", err)
+	}
+	if fallback.abortCalls != 1 || primary.abortCalls != 0 {
+		t.Fatalf("unexpected routing primary=%d fallback=%d", primary.abortCalls, fallback.abortCalls)
+	}
+
+	err = provider.AbortUpload(context.Background(), core.ProviderAbortUploadParams{Provider: "retired"})
+	if !errors.Is(err, core.ErrUploadInvalidState) {
+		t.Fatalf("expected ErrUploadInvalidState for unknown provider, got %v", err)
+	}
+}
+
+type stubProvider struct {
+	name          string
+	createErr     error
+	createCalls   int
+	completeCalls int
+	abortCalls    int
+}
+
+func (s *stubProvider) Name() string { return s.name }
+
+func (s *stubProvider) CreateUpload(ctx context.Context, params core.ProviderCreateUploadParams) (*core.ProviderCreateUploadResult, error) {
+	s.createCalls++
+	if s.createErr != nil {
+		return nil, s.createErr
+	}
+	return &core.ProviderCreateUploadResult{AssetKey: "key"}, nil
+}
+
+func (s *stubProvider) CompleteUpload(ctx context.Context, params core.ProviderCompleteUploadParams) (*core.ProviderCompleteUploadResult, error) {
+	s.completeCalls++
+	return &core.ProviderCompleteUploadResult{}, nil
+}
+
+func (s *stubProvider) AbortUpload(ctx context.Context, params core.ProviderAbortUploadParams) error {
+	s.abortCalls++
+	return nil
+}
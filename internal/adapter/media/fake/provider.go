@@ -11,8 +11,12 @@ import (
 	"github.com/eslsoft/lession/internal/core"
 )
 
+// ProviderName is the default name reported by the fake provider.
+const ProviderName = "fake"
+
 // Provider offers a simplified upload provider that simulates storage behaviour.
 type Provider struct {
+	name         string
 	uploadBase   string
 	playbackBase string
 	expiry       time.Duration
@@ -25,6 +29,7 @@ func NewProvider(uploadBase, playbackBase string, expiry time.Duration) *Provide
 		expiry = 15 * time.Minute
 	}
 	return &Provider{
+		name:         ProviderName,
 		uploadBase:   uploadBase,
 		playbackBase: playbackBase,
 		expiry:       expiry,
@@ -39,7 +44,28 @@ func (p *Provider) WithClock(fn func() time.Time) {
 	}
 }
 
-var _ core.UploadProvider = (*Provider)(nil)
+// WithName overrides the provider name, allowing several fake instances to be
+// registered side by side (e.g. to exercise failover locally).
+func (p *Provider) WithName(name string) {
+	if name != "" {
+		p.name = name
+	}
+}
+
+var (
+	_ core.UploadProvider = (*Provider)(nil)
+	_ core.HealthChecker  = (*Provider)(nil)
+)
+
+// Name reports the provider name persisted with uploads.
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// CheckHealth always succeeds; the fake provider has no remote endpoint.
+func (p *Provider) CheckHealth(ctx context.Context) error {
+	return nil
+}
 
 // CreateUpload simulates issuing a pre-signed upload target.
 func (p *Provider) CreateUpload(ctx context.Context, params core.ProviderCreateUploadParams) (*core.ProviderCreateUploadResult, error) {
@@ -49,6 +75,7 @@ func (p *Provider) CreateUpload(ctx context.Context, params core.ProviderCreateU
 	uploadURL := fmt.Sprintf("%s/%s", normalizeBase(p.uploadBase, "https://fake-upload.example.com"), assetKey)
 
 	return &core.ProviderCreateUploadResult{
+		Provider: p.name,
 		AssetKey: assetKey,
 		Protocol: core.UploadProtocolPresignedPut,
 		Target: core.UploadTarget{
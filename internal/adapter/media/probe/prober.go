@@ -0,0 +1,207 @@
+// Package probe checks that media hosted elsewhere is reachable and reads
+// the metadata its server declares.
+//
+// Durations are only read from HLS playlists, which list the length of every
+// segment; other media would have to be downloaded and decoded.
+package probe
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eslsoft/lession/internal/adapter/safehttp"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	defaultTimeout = 15 * time.Second
+	// maxPlaylistSize bounds the HLS playlists read.
+	maxPlaylistSize = 4 << 20
+	userAgent       = "Lession-Probe/1"
+)
+
+// playlistTypes lists the MIME types servers declare HLS playlists with.
+var playlistTypes = map[string]bool{
+	"application/vnd.apple.mpegurl": true,
+	"application/x-mpegurl":         true,
+	"audio/mpegurl":                 true,
+	"audio/x-mpegurl":               true,
+}
+
+// Prober implements core.MediaProber over HTTP.
+type Prober struct {
+	httpClient *http.Client
+}
+
+// NewProber constructs a media prober with a bounded request timeout. It only
+// connects to public addresses, since media URLs are chosen by callers.
+func NewProber() *Prober {
+	return &Prober{httpClient: safehttp.NewClient(defaultTimeout)}
+}
+
+// WithHTTPClient overrides the HTTP client, e.g. in tests.
+func (p *Prober) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		p.httpClient = client
+	}
+}
+
+var _ core.MediaProber = (*Prober)(nil)
+
+// ProbeMedia requests the first byte of the media, which reveals its type
+// and size without downloading it, or the whole playlist of an HLS stream.
+func (p *Prober) ProbeMedia(ctx context.Context, rawURL string) (*core.MediaProbe, error) {
+	resp, err := p.get(ctx, rawURL, true)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	mimeType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	mimeType = strings.ToLower(mimeType)
+	if playlistTypes[mimeType] || strings.EqualFold(path.Ext(resp.Request.URL.Path), ".m3u8") {
+		duration, err := p.playlistDuration(ctx, resp)
+		if err != nil {
+			return nil, err
+		}
+		return &core.MediaProbe{MimeType: "application/vnd.apple.mpegurl", Duration: duration}, nil
+	}
+
+	return &core.MediaProbe{MimeType: mimeType, ContentLength: contentLength(resp)}, nil
+}
+
+// get requests rawURL; ranged requests ask for the first byte only.
+func (p *Prober) get(ctx context.Context, rawURL string, ranged bool) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("probe: build request: %w", err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	if ranged {
+		req.Header.Set("Range", "bytes=0-0")
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("probe: get %s: %w", rawURL, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("probe: get %s: %s", rawURL, resp.Status)
+	}
+	return resp, nil
+}
+
+// contentLength returns the full size of the media, which a partial response
+// declares in Content-Range.
+func contentLength(resp *http.Response) int64 {
+	if resp.StatusCode == http.StatusPartialContent {
+		contentRange := resp.Header.Get("Content-Range")
+		if i := strings.LastIndexByte(contentRange, '/'); i >= 0 {
+			if size, err := strconv.ParseInt(contentRange[i+1:], 10, 64); err == nil && size > 0 {
+				return size
+			}
+		}
+		return 0
+	}
+	return max(resp.ContentLength, 0)
+}
+
+// playlistDuration sums the segments of a VOD playlist, following the first
+// variant of a master playlist. Live playlists have no duration.
+func (p *Prober) playlistDuration(ctx context.Context, resp *http.Response) (time.Duration, error) {
+	// A server honouring the range only sent the first byte of the playlist.
+	if resp.StatusCode == http.StatusPartialContent {
+		full, err := p.get(ctx, resp.Request.URL.String(), false)
+		if err != nil {
+			return 0, err
+		}
+		defer full.Body.Close()
+		resp = full
+	}
+
+	master, err := readPlaylist(resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("probe: read playlist %s: %w", resp.Request.URL, err)
+	}
+	if master.variant == "" {
+		return master.duration, nil
+	}
+
+	variantURL, err := resp.Request.URL.Parse(master.variant)
+	if err != nil {
+		return 0, fmt.Errorf("probe: invalid variant %q: %w", master.variant, err)
+	}
+	variant, err := p.get(ctx, variantURL.String(), false)
+	if err != nil {
+		return 0, err
+	}
+	defer variant.Body.Close()
+	media, err := readPlaylist(variant.Body)
+	if err != nil {
+		return 0, fmt.Errorf("probe: read playlist %s: %w", variantURL, err)
+	}
+	return media.duration, nil
+}
+
+type playlist struct {
+	// variant is the URI of the first variant of a master playlist.
+	variant string
+	// duration is the length of a VOD media playlist, zero for live ones.
+	duration time.Duration
+}
+
+func readPlaylist(r io.Reader) (playlist, error) {
+	var (
+		result   playlist
+		seconds  float64
+		ended    bool
+		inStream bool
+	)
+	scanner := bufio.NewScanner(io.LimitReader(r, maxPlaylistSize))
+	first := true
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if first {
+			if line != "#EXTM3U" {
+				return playlist{}, fmt.Errorf("not an HLS playlist")
+			}
+			first = false
+			continue
+		}
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "#EXT-X-STREAM-INF"):
+			inStream = true
+		case strings.HasPrefix(line, "#EXTINF:"):
+			value, _, _ := strings.Cut(strings.TrimPrefix(line, "#EXTINF:"), ",")
+			if d, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil && d > 0 {
+				seconds += d
+			}
+		case line == "#EXT-X-ENDLIST":
+			ended = true
+		case strings.HasPrefix(line, "#"):
+		case inStream:
+			result.variant = line
+			return result, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return playlist{}, err
+	}
+	if first {
+		return playlist{}, fmt.Errorf("empty playlist")
+	}
+	if ended {
+		result.duration = time.Duration(seconds * float64(time.Second)).Round(time.Millisecond)
+	}
+	return result, nil
+}
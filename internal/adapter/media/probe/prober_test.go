@@ -0,0 +1,82 @@
+package probe
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eslsoft/lession/internal/adapter/safehttp"
+)
+
+// testProber returns a prober allowed to reach the loopback test server.
+func testProber(server *httptest.Server) *Prober {
+	prober := NewProber()
+	prober.WithHTTPClient(server.Client())
+	return prober
+}
+
+func TestProber_ProbeMediaFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Range") != "bytes=0-0" {
+			t.Errorf("expected a ranged request, got %q", r.Header.Get("Range"))
+		}
+		w.Header().Set("Content-Type", "audio/mpeg")
+		w.Header().Set("Content-Range", "bytes 0-0/52000")
+		w.WriteHeader(http.StatusPartialContent)
+		_, _ = w.Write([]byte{0xff})
+	}))
+	defer server.Close()
+
+	probe, err := testProber(server).ProbeMedia(context.Background(), server.URL+"/lesson.mp3")
+	if err != nil {
+		t.Fatalf("ProbeMedia() error = %v", err)
+	}
+	if probe.MimeType != "audio/mpeg" || probe.ContentLength != 52000 || probe.Duration != 0 {
+		t.Fatalf("unexpected probe %+v", probe)
+	}
+}
+
+func TestProber_ProbeMediaPlaylist(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hls/master.m3u8", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=128000\nlow/index.m3u8\n"))
+	})
+	mux.HandleFunc("/hls/low/index.m3u8", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("#EXTM3U\n#EXT-X-TARGETDURATION:10\n#EXTINF:10.0,\na.ts\n#EXTINF:4.5,\nb.ts\n#EXT-X-ENDLIST\n"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	probe, err := testProber(server).ProbeMedia(context.Background(), server.URL+"/hls/master.m3u8")
+	if err != nil {
+		t.Fatalf("ProbeMedia() error = %v", err)
+	}
+	if probe.MimeType != "application/vnd.apple.mpegurl" || probe.Duration != 14500*time.Millisecond {
+		t.Fatalf("unexpected probe %+v", probe)
+	}
+}
+
+func TestProber_ProbeMediaUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	if _, err := testProber(server).ProbeMedia(context.Background(), server.URL+"/missing.mp4"); err == nil {
+		t.Fatal("expected an error for a missing file")
+	}
+}
+
+func TestProber_ProbeMediaRefusesInternalAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("internal server was reached")
+	}))
+	defer server.Close()
+
+	for _, url := range []string{server.URL + "/lesson.mp3", "http://169.254.169.254/latest/meta-data/"} {
+		if _, err := NewProber().ProbeMedia(context.Background(), url); !errors.Is(err, safehttp.ErrNonPublicAddress) {
+			t.Fatalf("ProbeMedia(%s) error = %v, want ErrNonPublicAddress", url, err)
+		}
+	}
+}
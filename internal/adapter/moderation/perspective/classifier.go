@@ -0,0 +1,134 @@
+// Package perspective screens text for toxicity with Google's Perspective API.
+package perspective
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	defaultBaseURL   = "https://commentanalyzer.googleapis.com"
+	maxErrorBodySize = 4096
+)
+
+// attributes maps the Perspective attributes requested to the labels
+// reported for them.
+var attributes = map[string]string{
+	"TOXICITY":        "toxicity",
+	"SEVERE_TOXICITY": "severe_toxicity",
+	"INSULT":          "insult",
+	"THREAT":          "threat",
+	"IDENTITY_ATTACK": "identity_attack",
+}
+
+// Classifier implements core.TextClassifier with the Perspective API. Text is
+// flagged when any attribute scores at or above the threshold.
+type Classifier struct {
+	baseURL    string
+	apiKey     string
+	threshold  float64
+	httpClient *http.Client
+}
+
+// NewClassifier constructs a classifier authenticating with apiKey.
+// threshold is a probability between 0 and 1.
+func NewClassifier(apiKey string, threshold float64) *Classifier {
+	return &Classifier{
+		baseURL:    defaultBaseURL,
+		apiKey:     apiKey,
+		threshold:  threshold,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// WithBaseURL overrides the API endpoint, for tests.
+func (c *Classifier) WithBaseURL(baseURL string) {
+	if baseURL != "" {
+		c.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithHTTPClient overrides the HTTP client used for API requests.
+func (c *Classifier) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		c.httpClient = client
+	}
+}
+
+var _ core.TextClassifier = (*Classifier)(nil)
+
+// ClassifyText scores text on every requested attribute and reports the
+// attributes at or above the threshold as labels.
+func (c *Classifier) ClassifyText(ctx context.Context, text string) (*core.TextClassification, error) {
+	requested := make(map[string]struct{}, len(attributes))
+	for attribute := range attributes {
+		requested[attribute] = struct{}{}
+	}
+	payload, err := json.Marshal(map[string]any{
+		"comment":             map[string]string{"text": text},
+		"requestedAttributes": requested,
+		"doNotStore":          true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := c.baseURL + "/v1alpha1/comments:analyze?key=" + url.QueryEscape(c.apiKey)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		// The request URL carries the API key, so it is left out of the error.
+		return nil, fmt.Errorf("perspective: analyze: %w", unwrapURLError(err))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return nil, fmt.Errorf("perspective: analyze: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var response struct {
+		AttributeScores map[string]struct {
+			SummaryScore struct {
+				Value float64 `json:"value"`
+			} `json:"summaryScore"`
+		} `json:"attributeScores"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("perspective: decode response: %w", err)
+	}
+
+	result := &core.TextClassification{}
+	for attribute, score := range response.AttributeScores {
+		value := score.SummaryScore.Value
+		result.Score = max(result.Score, value)
+		label, ok := attributes[attribute]
+		if ok && value >= c.threshold {
+			result.Flagged = true
+			result.Labels = append(result.Labels, label)
+		}
+	}
+	sort.Strings(result.Labels)
+	return result, nil
+}
+
+func unwrapURLError(err error) error {
+	if urlErr, ok := err.(*url.Error); ok {
+		return urlErr.Err
+	}
+	return err
+}
@@ -0,0 +1,62 @@
+package perspective
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClassifier_ClassifyText(t *testing.T) {
+	var request map[string]any
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1alpha1/comments:analyze", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("key") != "secret" {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		_ = json.NewEncoder(w).Encode(map[string]any{"attributeScores": map[string]any{
+			"TOXICITY": map[string]any{"summaryScore": map[string]any{"value": 0.91}},
+			"INSULT":   map[string]any{"summaryScore": map[string]any{"value": 0.85}},
+			"THREAT":   map[string]any{"summaryScore": map[string]any{"value": 0.02}},
+		}})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	classifier := NewClassifier("secret", 0.8)
+	classifier.WithBaseURL(server.URL)
+
+	result, err := classifier.ClassifyText(context.Background(), "you are all idiots")
+	if err != nil {
+		t.Fatalf("ClassifyText() error = %v", err)
+	}
+	if !result.Flagged || result.Score != 0.91 || !reflect.DeepEqual(result.Labels, []string{"insult", "toxicity"}) {
+		t.Fatalf("unexpected verdict %+v", result)
+	}
+	if request["comment"].(map[string]any)["text"] != "you are all idiots" || request["doNotStore"] != true {
+		t.Fatalf("unexpected request %v", request)
+	}
+	if len(request["requestedAttributes"].(map[string]any)) != len(attributes) {
+		t.Fatalf("expected every attribute to be requested, got %v", request["requestedAttributes"])
+	}
+}
+
+func TestClassifier_ClassifyTextError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "quota exceeded", http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	classifier := NewClassifier("secret", 0.8)
+	classifier.WithBaseURL(server.URL)
+
+	_, err := classifier.ClassifyText(context.Background(), "hello")
+	if err == nil || !strings.Contains(err.Error(), "429") || strings.Contains(err.Error(), "secret") {
+		t.Fatalf("expected a status error without the key, got %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+// Package pii detects personal data, such as email addresses and phone
+// numbers, in text.
+package pii
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// Kinds of personal data the detector recognises.
+const (
+	KindEmail      = "email"
+	KindPhone      = "phone"
+	KindCardNumber = "card_number"
+)
+
+// Kinds lists every kind the detector recognises.
+var Kinds = []string{KindEmail, KindPhone, KindCardNumber}
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`)
+	// phonePattern allows the usual separators within a number but not line
+	// breaks, so the numbers and timestamps of SRT cues are not joined up.
+	phonePattern = regexp.MustCompile(`\+?\(?\d[\d .()-]{6,}\d`)
+	cardPattern  = regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`)
+)
+
+// Detector implements core.TextScanner with patterns for each kind of
+// personal data.
+type Detector struct {
+	kinds []string
+}
+
+// NewDetector constructs a detector for the given kinds, or every kind when
+// none is given.
+func NewDetector(kinds ...string) (*Detector, error) {
+	if len(kinds) == 0 {
+		kinds = Kinds
+	}
+	if unknown := lo.Without(kinds, Kinds...); len(unknown) > 0 {
+		return nil, fmt.Errorf("pii: unknown kind %q", unknown[0])
+	}
+	return &Detector{kinds: lo.Uniq(kinds)}, nil
+}
+
+var _ core.TextScanner = (*Detector)(nil)
+
+// ScanText returns the spans of personal data in text, ordered by offset.
+// Card numbers are checked before phone numbers so that a card number is
+// reported once, as a card number.
+func (d *Detector) ScanText(text string) []core.TextFinding {
+	var findings []core.TextFinding
+	claimed := func(start, end int) bool {
+		return lo.SomeBy(findings, func(f core.TextFinding) bool { return start < f.End && end > f.Start })
+	}
+
+	if lo.Contains(d.kinds, KindEmail) {
+		for _, loc := range emailPattern.FindAllStringIndex(text, -1) {
+			findings = append(findings, core.TextFinding{Kind: KindEmail, Start: loc[0], End: loc[1]})
+		}
+	}
+	if lo.Contains(d.kinds, KindCardNumber) {
+		for _, loc := range cardPattern.FindAllStringIndex(text, -1) {
+			if luhnValid(text[loc[0]:loc[1]]) && !claimed(loc[0], loc[1]) {
+				findings = append(findings, core.TextFinding{Kind: KindCardNumber, Start: loc[0], End: loc[1]})
+			}
+		}
+	}
+	if lo.Contains(d.kinds, KindPhone) {
+		for _, loc := range phonePattern.FindAllStringIndex(text, -1) {
+			if digits := countDigits(text[loc[0]:loc[1]]); digits < 9 || digits > 15 || claimed(loc[0], loc[1]) {
+				continue
+			}
+			findings = append(findings, core.TextFinding{Kind: KindPhone, Start: loc[0], End: loc[1]})
+		}
+	}
+
+	sort.Slice(findings, func(i, j int) bool { return findings[i].Start < findings[j].Start })
+	return findings
+}
+
+func countDigits(s string) int {
+	n := 0
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			n++
+		}
+	}
+	return n
+}
+
+// luhnValid reports whether the digits of s pass the Luhn checksum card
+// numbers carry, which rules out most other long numbers.
+func luhnValid(s string) bool {
+	sum, double := 0, false
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
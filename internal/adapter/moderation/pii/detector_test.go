@@ -0,0 +1,52 @@
+package pii
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDetector_ScanText(t *testing.T) {
+	detector, err := NewDetector()
+	if err != nil {
+		t.Fatalf("NewDetector() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "clean", text: "Let's order a coffee.", want: nil},
+		{name: "email", text: "Write to jane.doe+esl@example.co.uk today.", want: []string{"email:jane.doe+esl@example.co.uk"}},
+		{name: "phone", text: "Call me on +44 (20) 7946-0958, please.", want: []string{"phone:+44 (20) 7946-0958"}},
+		{name: "card number", text: "My card is 4111 1111 1111 1111.", want: []string{"card_number:4111 1111 1111 1111"}},
+		{name: "long number failing the checksum", text: "Order 4111 1111 1111 1112 shipped.", want: nil},
+		{name: "short numbers", text: "It costs 12.50 and starts at 10:30.", want: nil},
+		{name: "srt cue", text: "12\n00:01:02,500 --> 00:01:04,000\nHello", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, f := range detector.ScanText(tt.text) {
+				got = append(got, f.Kind+":"+tt.text[f.Start:f.End])
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("ScanText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewDetector(t *testing.T) {
+	detector, err := NewDetector(KindEmail)
+	if err != nil {
+		t.Fatalf("NewDetector() error = %v", err)
+	}
+	if findings := detector.ScanText("mail a@example.com or call 020 7946 0958"); len(findings) != 1 || findings[0].Kind != KindEmail {
+		t.Fatalf("expected only the email, got %+v", findings)
+	}
+
+	if _, err := NewDetector("passport"); err == nil {
+		t.Fatal("expected an unknown kind to be rejected")
+	}
+}
@@ -0,0 +1,155 @@
+// Package wordlist screens text against a list of blocked words and phrases.
+package wordlist
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// Label is reported for text containing a blocked word.
+const Label = "profanity"
+
+// Classifier implements core.TextClassifier by matching whole words, case
+// insensitively, so blocking "ass" does not flag "class".
+type Classifier struct {
+	// phrases holds each blocked entry as a sequence of lower-case words.
+	phrases [][]string
+}
+
+// NewClassifier constructs a classifier blocking the given words. Entries
+// may be phrases of several words; blank entries are ignored.
+func NewClassifier(words []string) *Classifier {
+	c := &Classifier{}
+	for _, word := range words {
+		if tokens := lo.Map(tokenize(word), func(t token, _ int) string { return t.word }); len(tokens) > 0 {
+			c.phrases = append(c.phrases, tokens)
+		}
+	}
+	return c
+}
+
+// LoadFile reads a word list with one entry per line. Blank lines and lines
+// starting with # are skipped.
+func LoadFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("wordlist: %w", err)
+	}
+	defer f.Close()
+	return parse(f)
+}
+
+func parse(r io.Reader) ([]string, error) {
+	var words []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("wordlist: %w", err)
+	}
+	return words, nil
+}
+
+var (
+	_ core.TextClassifier = (*Classifier)(nil)
+	_ core.TextScanner    = (*Classifier)(nil)
+)
+
+// ClassifyText flags text containing any blocked word with full confidence.
+func (c *Classifier) ClassifyText(_ context.Context, text string) (*core.TextClassification, error) {
+	if len(c.Find(text)) == 0 {
+		return &core.TextClassification{}, nil
+	}
+	return &core.TextClassification{
+		Flagged: true,
+		Labels:  []string{Label},
+		Score:   1,
+	}, nil
+}
+
+// ScanText reports every blocked word in text as a finding of kind Label.
+func (c *Classifier) ScanText(text string) []core.TextFinding {
+	return lo.Map(c.Find(text), func(span Span, _ int) core.TextFinding {
+		return core.TextFinding{Kind: Label, Start: span.Start, End: span.End}
+	})
+}
+
+// Span is the byte range [Start, End) of a blocked word within a text.
+type Span struct {
+	Start int
+	End   int
+}
+
+// Find returns the spans of blocked words in text, in order of appearance.
+// Overlapping matches are reported once, for the earliest entry.
+func (c *Classifier) Find(text string) []Span {
+	if len(c.phrases) == 0 {
+		return nil
+	}
+	tokens := tokenize(text)
+	var spans []Span
+	for i := 0; i < len(tokens); i++ {
+		for _, phrase := range c.phrases {
+			if !matchesAt(tokens, i, phrase) {
+				continue
+			}
+			spans = append(spans, Span{Start: tokens[i].start, End: tokens[i+len(phrase)-1].end})
+			i += len(phrase) - 1
+			break
+		}
+	}
+	return spans
+}
+
+func matchesAt(tokens []token, i int, phrase []string) bool {
+	if i+len(phrase) > len(tokens) {
+		return false
+	}
+	for j, word := range phrase {
+		if tokens[i+j].word != word {
+			return false
+		}
+	}
+	return true
+}
+
+// token is a lower-cased word and its byte range in the original text.
+type token struct {
+	word  string
+	start int
+	end   int
+}
+
+// tokenize splits text into words of letters, digits and apostrophes.
+func tokenize(text string) []token {
+	var tokens []token
+	start := -1
+	for i, r := range text {
+		inWord := unicode.IsLetter(r) || unicode.IsDigit(r) || r == '\''
+		switch {
+		case inWord && start < 0:
+			start = i
+		case !inWord && start >= 0:
+			tokens = append(tokens, token{word: strings.ToLower(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{word: strings.ToLower(text[start:]), start: start, end: len(text)})
+	}
+	return tokens
+}
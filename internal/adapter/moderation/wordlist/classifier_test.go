@@ -0,0 +1,69 @@
+package wordlist
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClassifier_ClassifyText(t *testing.T) {
+	classifier := NewClassifier([]string{"Darn", "heck no", "  "})
+
+	tests := []struct {
+		name    string
+		text    string
+		flagged bool
+	}{
+		{name: "clean", text: "A playlist for ordering coffee", flagged: false},
+		{name: "case insensitive", text: "Well, DARN it.", flagged: true},
+		{name: "whole words only", text: "Darnell's favourite episodes", flagged: false},
+		{name: "phrase", text: "Oh heck  no!", flagged: true},
+		{name: "partial phrase", text: "heck yes", flagged: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := classifier.ClassifyText(context.Background(), tt.text)
+			if err != nil {
+				t.Fatalf("ClassifyText() error = %v", err)
+			}
+			if result.Flagged != tt.flagged {
+				t.Fatalf("Flagged = %v, want %v", result.Flagged, tt.flagged)
+			}
+			if tt.flagged && (result.Score != 1 || !reflect.DeepEqual(result.Labels, []string{Label})) {
+				t.Fatalf("unexpected verdict %+v", result)
+			}
+		})
+	}
+}
+
+func TestClassifier_Find(t *testing.T) {
+	classifier := NewClassifier([]string{"darn", "heck no"})
+	text := "Darn, heck no, darn."
+
+	spans := classifier.Find(text)
+	got := make([]string, 0, len(spans))
+	for _, span := range spans {
+		got = append(got, text[span.Start:span.End])
+	}
+	if want := []string{"Darn", "heck no", "darn"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Find() = %q, want %q", got, want)
+	}
+}
+
+func TestClassifier_ScanText(t *testing.T) {
+	findings := NewClassifier([]string{"darn"}).ScanText("Oh darn.")
+	if len(findings) != 1 || findings[0].Kind != Label || findings[0].Start != 3 || findings[0].End != 7 {
+		t.Fatalf("unexpected findings %+v", findings)
+	}
+}
+
+func TestParse(t *testing.T) {
+	words, err := parse(strings.NewReader("# blocked words\ndarn\n\n  heck no  \n"))
+	if err != nil {
+		t.Fatalf("parse() error = %v", err)
+	}
+	if want := []string{"darn", "heck no"}; !reflect.DeepEqual(words, want) {
+		t.Fatalf("parse() = %q, want %q", words, want)
+	}
+}
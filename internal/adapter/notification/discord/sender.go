@@ -0,0 +1,111 @@
+// Package discord posts team notifications to Discord channel webhooks,
+// created under a channel's "Integrations" settings.
+package discord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	defaultTimeout   = 10 * time.Second
+	maxErrorBodySize = 4096
+	// maxContentLength is the longest message content Discord accepts, in characters.
+	maxContentLength = 2000
+)
+
+// markdownEscaper escapes Discord markdown so quoted user text is shown as written.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "*", `\*`, "_", `\_`, "~", `\~`, "`", "\\`", "|", `\|`, ">", `\>`,
+)
+
+// Sender implements core.NotificationSender for the Discord channel.
+type Sender struct {
+	httpClient *http.Client
+}
+
+// NewSender constructs a Discord sender with a bounded request timeout.
+func NewSender() *Sender {
+	return &Sender{httpClient: &http.Client{Timeout: defaultTimeout}}
+}
+
+// WithHTTPClient overrides the HTTP client, e.g. in tests.
+func (s *Sender) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		s.httpClient = client
+	}
+}
+
+var _ core.NotificationSender = (*Sender)(nil)
+
+// Channel reports the Discord channel.
+func (s *Sender) Channel() core.NotificationChannel {
+	return core.NotificationChannelDiscord
+}
+
+// Send posts message to the webhook URL in message.To, with the subject in
+// bold. Mentions are disabled so quoted user text cannot ping anyone.
+// Deleted webhooks yield an error wrapping core.ErrNotFound.
+func (s *Sender) Send(ctx context.Context, message core.NotificationMessage) error {
+	webhook, err := url.Parse(message.To)
+	if err != nil || webhook.Scheme != "https" || webhook.Host == "" {
+		return fmt.Errorf("%w: invalid Discord webhook URL", core.ErrValidation)
+	}
+
+	content := markdownEscaper.Replace(message.Body)
+	if message.Subject != "" {
+		content = "**" + markdownEscaper.Replace(message.Subject) + "**\n" + content
+	}
+	if runes := []rune(content); len(runes) > maxContentLength {
+		content = string(runes[:maxContentLength-1]) + "…"
+	}
+	payload, err := json.Marshal(map[string]any{
+		"content":          content,
+		"allowed_mentions": map[string]any{"parse": []string{}},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.String(), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		// The webhook URL embeds its token; keep it out of the recorded error.
+		return fmt.Errorf("discord: send: %w", redact(err))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: discord webhook no longer exists", core.ErrNotFound)
+	}
+	return fmt.Errorf("discord: send: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// redact drops the request URL from transport errors.
+func redact(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+	return err
+}
@@ -0,0 +1,72 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type webhookPayload struct {
+	Content         string `json:"content"`
+	AllowedMentions struct {
+		Parse []string `json:"parse"`
+	} `json:"allowed_mentions"`
+}
+
+func TestSender_Send(t *testing.T) {
+	var payloads []webhookPayload
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/webhooks/1/token", func(w http.ResponseWriter, r *http.Request) {
+		var payload webhookPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			http.Error(w, `{"message":"Cannot send an empty message"}`, http.StatusBadRequest)
+			return
+		}
+		payloads = append(payloads, payload)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("POST /api/webhooks/2/token", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Unknown Webhook","code":10015}`, http.StatusNotFound)
+	})
+	server := httptest.NewTLSServer(mux)
+	t.Cleanup(server.Close)
+
+	sender := NewSender()
+	sender.WithHTTPClient(server.Client())
+	ctx := context.Background()
+
+	err := sender.Send(ctx, core.NotificationMessage{
+		To:      server.URL + "/api/webhooks/1/token",
+		Subject: "New episode in Coffee Talk",
+		Body:    `"Ordering *decaf*" has just been published. @everyone`,
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	want := "**New episode in Coffee Talk**\n\"Ordering \\*decaf\\*\" has just been published. @everyone"
+	if len(payloads) != 1 || payloads[0].Content != want {
+		t.Fatalf("payloads = %+v, want content %q", payloads, want)
+	}
+	if payloads[0].AllowedMentions.Parse == nil || len(payloads[0].AllowedMentions.Parse) != 0 {
+		t.Fatalf("allowed mentions = %v, want none", payloads[0].AllowedMentions.Parse)
+	}
+
+	if err := sender.Send(ctx, core.NotificationMessage{To: server.URL + "/api/webhooks/1/token", Body: strings.Repeat("é", 3000)}); err != nil {
+		t.Fatalf("Send() long message error = %v", err)
+	}
+	if got := utf8.RuneCountInString(payloads[1].Content); got != maxContentLength {
+		t.Fatalf("long message length = %d, want %d", got, maxContentLength)
+	}
+
+	err = sender.Send(ctx, core.NotificationMessage{To: server.URL + "/api/webhooks/2/token", Body: "hello"})
+	if !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("Send() to deleted webhook error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,131 @@
+// Package email delivers notifications over SMTP. Amazon SES is supported
+// through its SMTP interface, e.g. email-smtp.us-east-1.amazonaws.com:587
+// with SMTP credentials generated in the SES console.
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// Sender implements core.NotificationSender for the email channel.
+type Sender struct {
+	addr     string
+	host     string
+	from     mail.Address
+	auth     smtp.Auth
+	sendMail func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error
+	now      func() time.Time
+}
+
+// NewSender constructs an SMTP sender for the server at addr (host:port).
+// Credentials are optional; when given, PLAIN auth is used, which net/smtp
+// only permits over TLS or to localhost.
+func NewSender(addr, username, password, from string) (*Sender, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("email: invalid SMTP address %q: %w", addr, err)
+	}
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("email: invalid from address %q: %w", from, err)
+	}
+
+	s := &Sender{
+		addr:     addr,
+		host:     host,
+		from:     *sender,
+		sendMail: smtp.SendMail,
+		now:      time.Now,
+	}
+	if username != "" {
+		s.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return s, nil
+}
+
+// WithClock overrides the clock used for the Date header.
+func (s *Sender) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.NotificationSender = (*Sender)(nil)
+
+// Channel reports the email channel.
+func (s *Sender) Channel() core.NotificationChannel {
+	return core.NotificationChannelEmail
+}
+
+// Send delivers message as a plain-text UTF-8 email to message.To.
+func (s *Sender) Send(ctx context.Context, message core.NotificationMessage) error {
+	to, err := mail.ParseAddress(message.To)
+	if err != nil {
+		return fmt.Errorf("%w: invalid recipient address %q", core.ErrValidation, message.To)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	body, err := s.compose(*to, message)
+	if err != nil {
+		return err
+	}
+	if err := s.sendMail(s.addr, s.auth, s.from.Address, []string{to.Address}, body); err != nil {
+		return fmt.Errorf("email: send to %s: %w", to.Address, err)
+	}
+	return nil
+}
+
+func (s *Sender) compose(to mail.Address, message core.NotificationMessage) ([]byte, error) {
+	id, err := messageID(s.host)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	headers := [][2]string{
+		{"From", s.from.String()},
+		{"To", to.String()},
+		{"Subject", mime.QEncoding.Encode("utf-8", message.Subject)},
+		{"Date", s.now().Format(time.RFC1123Z)},
+		{"Message-ID", id},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", `text/plain; charset="utf-8"`},
+		{"Content-Transfer-Encoding", "quoted-printable"},
+	}
+	for _, header := range headers {
+		fmt.Fprintf(&buf, "%s: %s\r\n", header[0], header[1])
+	}
+	buf.WriteString("\r\n")
+
+	qp := quotedprintable.NewWriter(&buf)
+	if _, err := qp.Write([]byte(message.Body)); err != nil {
+		return nil, err
+	}
+	if err := qp.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func messageID(host string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.New("email: generate message id")
+	}
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(b), host), nil
+}
@@ -0,0 +1,72 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"mime"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestSenderSend(t *testing.T) {
+	sender, err := NewSender("smtp.example.com:587", "user", "secret", "Lession <noreply@example.com>")
+	if err != nil {
+		t.Fatalf("NewSender() error = %v", err)
+	}
+	sender.WithClock(func() time.Time { return time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC) })
+
+	var gotFrom string
+	var gotTo []string
+	var gotMsg []byte
+	sender.sendMail = func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error {
+		if addr != "smtp.example.com:587" || auth == nil {
+			t.Fatalf("unexpected server %s (auth %v)", addr, auth)
+		}
+		gotFrom, gotTo, gotMsg = from, to, msg
+		return nil
+	}
+
+	err = sender.Send(context.Background(), core.NotificationMessage{
+		To:      "learner@example.com",
+		Subject: "旅行英语 有新单集",
+		Body:    "《点咖啡》已在 旅行英语 中发布。",
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if gotFrom != "noreply@example.com" || len(gotTo) != 1 || gotTo[0] != "learner@example.com" {
+		t.Fatalf("unexpected envelope from=%s to=%v", gotFrom, gotTo)
+	}
+
+	parsed, err := mail.ReadMessage(strings.NewReader(string(gotMsg)))
+	if err != nil {
+		t.Fatalf("ReadMessage() error = %v", err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	if err != nil || subject != "旅行英语 有新单集" {
+		t.Fatalf("unexpected subject %q (%v)", subject, err)
+	}
+	if parsed.Header.Get("Date") != "Sat, 01 Mar 2025 12:00:00 +0000" {
+		t.Fatalf("unexpected date %q", parsed.Header.Get("Date"))
+	}
+}
+
+func TestSenderSendRejectsInvalidRecipient(t *testing.T) {
+	sender, err := NewSender("localhost:25", "", "", "noreply@example.com")
+	if err != nil {
+		t.Fatalf("NewSender() error = %v", err)
+	}
+	sender.sendMail = func(string, smtp.Auth, string, []string, []byte) error {
+		t.Fatal("sendMail should not be called")
+		return nil
+	}
+
+	if err := sender.Send(context.Background(), core.NotificationMessage{To: "not-an-address"}); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("Send() error = %v, want ErrValidation", err)
+	}
+}
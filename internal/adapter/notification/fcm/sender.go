@@ -0,0 +1,144 @@
+// Package fcm delivers push notifications through the Firebase Cloud
+// Messaging HTTP v1 API. FCM reaches Android and iOS apps as well as browsers
+// that subscribed through the Firebase JS SDK, which uses Web Push.
+package fcm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/eslsoft/lession/internal/adapter/googleauth"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// DefaultAPIBase is the FCM production endpoint.
+	DefaultAPIBase   = "https://fcm.googleapis.com"
+	messagingScope   = "https://www.googleapis.com/auth/firebase.messaging"
+	maxErrorBodySize = 4096
+)
+
+// Sender implements core.NotificationSender for the push channel.
+type Sender struct {
+	tokens     *googleauth.TokenSource
+	apiBase    string
+	httpClient *http.Client
+}
+
+// NewSender constructs a sender from a service account key file, as
+// downloaded from the Firebase console.
+func NewSender(credentials []byte) (*Sender, error) {
+	tokens, err := googleauth.NewTokenSource(credentials, messagingScope)
+	if err != nil {
+		return nil, fmt.Errorf("fcm: %w", err)
+	}
+	return &Sender{
+		tokens:     tokens,
+		apiBase:    DefaultAPIBase,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// WithAPIBase points the sender at a different FCM endpoint, such as a mock server.
+func (s *Sender) WithAPIBase(base string) {
+	if base != "" {
+		s.apiBase = strings.TrimRight(base, "/")
+	}
+}
+
+// WithHTTPClient overrides the HTTP client used for FCM and token requests.
+func (s *Sender) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		s.httpClient = client
+		s.tokens.WithHTTPClient(client)
+	}
+}
+
+// WithClock overrides the clock used for token assertions and expiry.
+func (s *Sender) WithClock(fn func() time.Time) {
+	s.tokens.WithClock(fn)
+}
+
+var _ core.NotificationSender = (*Sender)(nil)
+
+// Channel reports the push channel.
+func (s *Sender) Channel() core.NotificationChannel {
+	return core.NotificationChannelPush
+}
+
+// Send pushes message to the registration token in message.To. Tokens FCM
+// reports as unregistered yield an error wrapping core.ErrNotFound.
+func (s *Sender) Send(ctx context.Context, message core.NotificationMessage) error {
+	accessToken, err := s.tokens.Token(ctx)
+	if err != nil {
+		return fmt.Errorf("fcm: %w", err)
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"message": map[string]any{
+			"token": message.To,
+			"notification": map[string]string{
+				"title": message.Subject,
+				"body":  message.Body,
+			},
+			"data": message.Data,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/v1/projects/%s/messages:send", s.apiBase, url.PathEscape(s.tokens.ProjectID()))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("fcm: send: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if resp.StatusCode == http.StatusUnauthorized {
+		s.tokens.Reset()
+	}
+	if code := errorCode(body); resp.StatusCode == http.StatusNotFound || code == "UNREGISTERED" {
+		return fmt.Errorf("%w: fcm registration token is no longer valid", core.ErrNotFound)
+	}
+	return fmt.Errorf("fcm: send: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// errorCode extracts the FCM error code from an error response, if any.
+func errorCode(body []byte) string {
+	var response struct {
+		Error struct {
+			Details []struct {
+				ErrorCode string `json:"errorCode"`
+			} `json:"details"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &response) != nil {
+		return ""
+	}
+	for _, detail := range response.Error.Details {
+		if detail.ErrorCode != "" {
+			return detail.ErrorCode
+		}
+	}
+	return ""
+}
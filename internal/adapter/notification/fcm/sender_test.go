@@ -0,0 +1,113 @@
+package fcm
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eslsoft/lession/internal/adapter/googleauth"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func newTestSender(t *testing.T) (*Sender, *[]map[string]any, *int) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey() error = %v", err)
+	}
+
+	var messages []map[string]any
+	tokenRequests := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		if r.FormValue("grant_type") != "urn:ietf:params:oauth:grant-type:jwt-bearer" || strings.Count(r.FormValue("assertion"), ".") != 2 {
+			http.Error(w, "invalid_grant", http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "fcm-token", "expires_in": 3600})
+	})
+	mux.HandleFunc("POST /v1/projects/lession-app/messages:send", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer fcm-token" {
+			http.Error(w, "unauthenticated", http.StatusUnauthorized)
+			return
+		}
+		var body struct {
+			Message map[string]any `json:"message"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Message["token"] == "stale" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":404,"status":"NOT_FOUND","details":[{"errorCode":"UNREGISTERED"}]}}`))
+			return
+		}
+		messages = append(messages, body.Message)
+		_, _ = w.Write([]byte(`{"name":"projects/lession-app/messages/1"}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	credentials, _ := json.Marshal(googleauth.ServiceAccount{
+		ProjectID:   "lession-app",
+		ClientEmail: "push@lession-app.iam.gserviceaccount.com",
+		PrivateKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		TokenURI:    server.URL + "/token",
+	})
+	sender, err := NewSender(credentials)
+	if err != nil {
+		t.Fatalf("NewSender() error = %v", err)
+	}
+	sender.WithAPIBase(server.URL)
+	sender.WithClock(func() time.Time { return time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC) })
+	return sender, &messages, &tokenRequests
+}
+
+func TestSenderSend(t *testing.T) {
+	sender, messages, tokenRequests := newTestSender(t)
+
+	for range 2 {
+		err := sender.Send(context.Background(), core.NotificationMessage{
+			To:      "device-1",
+			Subject: "New episode in Travel English",
+			Body:    "\"Ordering coffee\" has just been published.",
+			Data:    map[string]string{"episode_id": "ep-1"},
+		})
+		if err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+	}
+	if *tokenRequests != 1 {
+		t.Fatalf("expected the access token to be cached, got %d token requests", *tokenRequests)
+	}
+	if len(*messages) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(*messages))
+	}
+	message := (*messages)[0]
+	notification, _ := message["notification"].(map[string]any)
+	data, _ := message["data"].(map[string]any)
+	if message["token"] != "device-1" || notification["title"] != "New episode in Travel English" || data["episode_id"] != "ep-1" {
+		t.Fatalf("unexpected message %v", message)
+	}
+}
+
+func TestSenderSendUnregisteredToken(t *testing.T) {
+	sender, _, _ := newTestSender(t)
+
+	if err := sender.Send(context.Background(), core.NotificationMessage{To: "stale"}); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("Send() error = %v, want ErrNotFound", err)
+	}
+}
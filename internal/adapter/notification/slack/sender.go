@@ -0,0 +1,102 @@
+// Package slack posts team notifications to Slack incoming webhooks, created
+// under "Incoming Webhooks" in a Slack app's settings.
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	defaultTimeout   = 10 * time.Second
+	maxErrorBodySize = 4096
+)
+
+// mrkdwnEscaper escapes the characters Slack reserves for links and
+// mentions, so quoted user text cannot ping a channel.
+var mrkdwnEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// Sender implements core.NotificationSender for the Slack channel.
+type Sender struct {
+	httpClient *http.Client
+}
+
+// NewSender constructs a Slack sender with a bounded request timeout.
+func NewSender() *Sender {
+	return &Sender{httpClient: &http.Client{Timeout: defaultTimeout}}
+}
+
+// WithHTTPClient overrides the HTTP client, e.g. in tests.
+func (s *Sender) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		s.httpClient = client
+	}
+}
+
+var _ core.NotificationSender = (*Sender)(nil)
+
+// Channel reports the Slack channel.
+func (s *Sender) Channel() core.NotificationChannel {
+	return core.NotificationChannelSlack
+}
+
+// Send posts message to the incoming webhook URL in message.To, with the
+// subject in bold. Webhooks Slack reports as removed, or whose channel was
+// archived, yield an error wrapping core.ErrNotFound.
+func (s *Sender) Send(ctx context.Context, message core.NotificationMessage) error {
+	webhook, err := url.Parse(message.To)
+	if err != nil || webhook.Scheme != "https" || webhook.Host == "" {
+		return fmt.Errorf("%w: invalid Slack webhook URL", core.ErrValidation)
+	}
+
+	text := mrkdwnEscaper.Replace(message.Body)
+	if message.Subject != "" {
+		text = "*" + mrkdwnEscaper.Replace(message.Subject) + "*\n" + text
+	}
+	payload, err := json.Marshal(map[string]any{"text": text})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.String(), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		// The webhook URL is a secret; keep it out of the recorded error.
+		return fmt.Errorf("slack: send: %w", redact(err))
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+		return fmt.Errorf("%w: slack webhook is no longer valid: %s", core.ErrNotFound, strings.TrimSpace(string(body)))
+	}
+	return fmt.Errorf("slack: send: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// redact drops the request URL from transport errors.
+func redact(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+	return err
+}
@@ -0,0 +1,60 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestSender_Send(t *testing.T) {
+	var texts []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /services/T1/B1/secret", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Text string `json:"text"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "invalid_payload", http.StatusBadRequest)
+			return
+		}
+		texts = append(texts, body.Text)
+		_, _ = w.Write([]byte("ok"))
+	})
+	mux.HandleFunc("POST /services/T1/B1/removed", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "no_service", http.StatusNotFound)
+	})
+	server := httptest.NewTLSServer(mux)
+	t.Cleanup(server.Close)
+
+	sender := NewSender()
+	sender.WithHTTPClient(server.Client())
+	ctx := context.Background()
+
+	err := sender.Send(ctx, core.NotificationMessage{
+		To:      server.URL + "/services/T1/B1/secret",
+		Subject: "Moderation review requested",
+		Body:    `A playlist is waiting: "<!channel> & friends"`,
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	want := "*Moderation review requested*\nA playlist is waiting: \"&lt;!channel&gt; &amp; friends\""
+	if len(texts) != 1 || texts[0] != want {
+		t.Fatalf("texts = %q, want %q", texts, want)
+	}
+
+	err = sender.Send(ctx, core.NotificationMessage{To: server.URL + "/services/T1/B1/removed", Body: "hello"})
+	if !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("Send() to removed webhook error = %v, want ErrNotFound", err)
+	}
+
+	err = sender.Send(ctx, core.NotificationMessage{To: "http://hooks.slack.com/services/T1/B1/secret", Body: "hello"})
+	if !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("Send() to plain HTTP URL error = %v, want ErrValidation", err)
+	}
+}
@@ -0,0 +1,182 @@
+// Package ffmpeg packages audio for HLS delivery by running the ffmpeg
+// command line tool (https://ffmpeg.org/).
+package ffmpeg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// ProviderName identifies the packager in configuration.
+	ProviderName = "ffmpeg"
+
+	// masterPlaylist is the file name of the HLS master playlist.
+	masterPlaylist = "master.m3u8"
+	// segmentSeconds is the target duration of HLS segments.
+	segmentSeconds = 6
+
+	maxOutputSize = 4096
+)
+
+// Packager implements core.AudioPackager by transcoding the audio to AAC at
+// each bitrate and segmenting the variants into a directory per asset under
+// an output directory that is served at a base URL.
+type Packager struct {
+	ffmpeg    string
+	outputDir string
+	baseURL   string
+	bitrates  []int
+	// run executes a command and returns its combined output.
+	run func(ctx context.Context, name string, args ...string) ([]byte, error)
+}
+
+// NewPackager constructs a packager running the given ffmpeg binary. Each
+// asset's rendition is written to outputDir/<asset id> and its master
+// playlist is served at baseURL/<asset id>/master.m3u8.
+func NewPackager(ffmpeg, outputDir, baseURL string, bitrates []int) (*Packager, error) {
+	if ffmpeg == "" {
+		ffmpeg = "ffmpeg"
+	}
+	if outputDir == "" {
+		return nil, errors.New("ffmpeg: output directory is required")
+	}
+	if len(bitrates) == 0 {
+		return nil, errors.New("ffmpeg: at least one bitrate is required")
+	}
+	for _, bitrate := range bitrates {
+		if bitrate <= 0 {
+			return nil, fmt.Errorf("ffmpeg: invalid bitrate %d", bitrate)
+		}
+	}
+	return &Packager{
+		ffmpeg:    ffmpeg,
+		outputDir: outputDir,
+		baseURL:   strings.TrimRight(baseURL, "/"),
+		bitrates:  bitrates,
+		run: func(ctx context.Context, name string, args ...string) ([]byte, error) {
+			return exec.CommandContext(ctx, name, args...).CombinedOutput()
+		},
+	}, nil
+}
+
+var _ core.AudioPackager = (*Packager)(nil)
+
+// PackageAudio segments the audio into one AAC variant per bitrate. The
+// rendition is written to a temporary directory first and swapped in once
+// complete, so players never see a half-written one. Encrypted renditions
+// reference the key by its URI only; the key file ffmpeg reads is written
+// outside the output directory and removed afterwards.
+func (p *Packager) PackageAudio(ctx context.Context, req core.AudioPackageRequest) (*core.AudioPackage, error) {
+	if req.SourceURL == "" {
+		return nil, errors.New("ffmpeg: source url is required")
+	}
+	if err := os.MkdirAll(p.outputDir, 0o755); err != nil {
+		return nil, fmt.Errorf("ffmpeg: %w", err)
+	}
+	dir, err := os.MkdirTemp(p.outputDir, ".tmp-"+req.AssetID.String()+"-")
+	if err != nil {
+		return nil, fmt.Errorf("ffmpeg: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	var keyInfo string
+	if req.Encryption != nil {
+		keyDir, err := os.MkdirTemp("", "lession-hls-key-")
+		if err != nil {
+			return nil, fmt.Errorf("ffmpeg: %w", err)
+		}
+		defer os.RemoveAll(keyDir)
+		if keyInfo, err = writeKeyInfo(keyDir, req.Encryption); err != nil {
+			return nil, err
+		}
+	}
+
+	if output, err := p.run(ctx, p.ffmpeg, p.args(req.SourceURL, dir, keyInfo)...); err != nil {
+		if len(output) > maxOutputSize {
+			output = output[len(output)-maxOutputSize:]
+		}
+		return nil, fmt.Errorf("ffmpeg: package audio: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+	if _, err := os.Stat(filepath.Join(dir, masterPlaylist)); err != nil {
+		return nil, fmt.Errorf("ffmpeg: no master playlist written: %w", err)
+	}
+	if err := os.Chmod(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("ffmpeg: %w", err)
+	}
+
+	final := filepath.Join(p.outputDir, req.AssetID.String())
+	previous := final + ".old"
+	if err := os.RemoveAll(previous); err != nil {
+		return nil, fmt.Errorf("ffmpeg: %w", err)
+	}
+	if err := os.Rename(final, previous); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("ffmpeg: %w", err)
+	}
+	if err := os.Rename(dir, final); err != nil {
+		return nil, fmt.Errorf("ffmpeg: %w", err)
+	}
+	_ = os.RemoveAll(previous)
+
+	return &core.AudioPackage{
+		ManifestURL: fmt.Sprintf("%s/%s/%s", p.baseURL, req.AssetID, masterPlaylist),
+		Bitrates:    p.bitrates,
+	}, nil
+}
+
+// writeKeyInfo writes the AES-128 key and the key info file ffmpeg reads it
+// through into dir, returning the path of the key info file.
+func writeKeyInfo(dir string, encryption *core.AudioEncryption) (string, error) {
+	if len(encryption.Key) != 16 {
+		return "", fmt.Errorf("ffmpeg: AES-128 keys are 16 bytes, got %d", len(encryption.Key))
+	}
+	if encryption.KeyURI == "" {
+		return "", errors.New("ffmpeg: key uri is required")
+	}
+	keyFile := filepath.Join(dir, "key")
+	if err := os.WriteFile(keyFile, encryption.Key, 0o600); err != nil {
+		return "", fmt.Errorf("ffmpeg: %w", err)
+	}
+	keyInfo := filepath.Join(dir, "key.info")
+	if err := os.WriteFile(keyInfo, []byte(encryption.KeyURI+"\n"+keyFile+"\n"), 0o600); err != nil {
+		return "", fmt.Errorf("ffmpeg: %w", err)
+	}
+	return keyInfo, nil
+}
+
+// args builds an ffmpeg command writing a variant playlist per bitrate,
+// named after the bitrate, and a master playlist into dir. Segments are
+// encrypted with the key described by keyInfo unless it is empty.
+func (p *Packager) args(source, dir, keyInfo string) []string {
+	args := []string{"-hide_banner", "-nostdin", "-y", "-i", source, "-vn"}
+	streams := make([]string, len(p.bitrates))
+	for range p.bitrates {
+		args = append(args, "-map", "0:a:0")
+	}
+	args = append(args, "-c:a", "aac")
+	for i, bitrate := range p.bitrates {
+		kbps := strconv.Itoa(bitrate) + "k"
+		args = append(args, "-b:a:"+strconv.Itoa(i), kbps)
+		streams[i] = fmt.Sprintf("a:%d,name:%s", i, kbps)
+	}
+	args = append(args,
+		"-f", "hls",
+		"-hls_time", strconv.Itoa(segmentSeconds),
+		"-hls_playlist_type", "vod",
+		"-hls_segment_filename", filepath.Join(dir, "%v_%03d.ts"),
+		"-master_pl_name", masterPlaylist,
+		"-var_stream_map", strings.Join(streams, " "),
+	)
+	if keyInfo != "" {
+		args = append(args, "-hls_key_info_file", keyInfo)
+	}
+	return append(args, filepath.Join(dir, "%v.m3u8"))
+}
@@ -0,0 +1,141 @@
+package ffmpeg
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestPackager_PackageAudio(t *testing.T) {
+	outputDir := t.TempDir()
+	packager, err := NewPackager("", outputDir, "https://media.example.com/hls/", []int{64, 128})
+	if err != nil {
+		t.Fatalf("NewPackager() error = %v", err)
+	}
+	var args []string
+	packager.run = func(_ context.Context, name string, a ...string) ([]byte, error) {
+		args = append([]string{name}, a...)
+		dir := filepath.Dir(a[len(a)-1])
+		return nil, os.WriteFile(filepath.Join(dir, masterPlaylist), []byte("#EXTM3U\n"), 0o644)
+	}
+
+	assetID := uuid.New()
+	// A previous rendition is replaced.
+	if err := os.MkdirAll(filepath.Join(outputDir, assetID.String()), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(outputDir, assetID.String(), "stale.ts"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	pkg, err := packager.PackageAudio(context.Background(), core.AudioPackageRequest{
+		AssetID:   assetID,
+		SourceURL: "https://cdn.example.com/lesson.mp3",
+		MimeType:  "audio/mpeg",
+	})
+	if err != nil {
+		t.Fatalf("PackageAudio() error = %v", err)
+	}
+	if pkg.ManifestURL != "https://media.example.com/hls/"+assetID.String()+"/master.m3u8" || !slices.Equal(pkg.Bitrates, []int{64, 128}) {
+		t.Fatalf("unexpected package %#v", pkg)
+	}
+	if args[0] != "ffmpeg" || !slices.Contains(args, "https://cdn.example.com/lesson.mp3") || !slices.Contains(args, "a:0,name:64k a:1,name:128k") {
+		t.Fatalf("unexpected command %q", args)
+	}
+	if _, err := os.Stat(filepath.Join(outputDir, assetID.String(), masterPlaylist)); err != nil {
+		t.Fatalf("expected master playlist in place: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(outputDir, assetID.String(), "stale.ts")); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected the previous rendition to be removed, got %v", err)
+	}
+	entries, _ := os.ReadDir(outputDir)
+	if len(entries) != 1 {
+		t.Fatalf("expected only the rendition directory to remain, got %d entries", len(entries))
+	}
+}
+
+func TestPackager_PackageAudioFailure(t *testing.T) {
+	outputDir := t.TempDir()
+	packager, err := NewPackager("/usr/bin/ffmpeg", outputDir, "", []int{96})
+	if err != nil {
+		t.Fatalf("NewPackager() error = %v", err)
+	}
+	packager.run = func(context.Context, string, ...string) ([]byte, error) {
+		return []byte("Invalid data found when processing input"), errors.New("exit status 1")
+	}
+
+	_, err = packager.PackageAudio(context.Background(), core.AudioPackageRequest{AssetID: uuid.New(), SourceURL: "https://cdn.example.com/x.mp3"})
+	if err == nil || !strings.Contains(err.Error(), "Invalid data found") {
+		t.Fatalf("expected ffmpeg output in error, got %v", err)
+	}
+	entries, _ := os.ReadDir(outputDir)
+	if len(entries) != 0 {
+		t.Fatalf("expected temporary output to be removed, got %d entries", len(entries))
+	}
+
+	if _, err := NewPackager("", outputDir, "", nil); err == nil {
+		t.Fatal("expected bitrates to be required")
+	}
+}
+
+func TestPackager_PackageAudioEncrypted(t *testing.T) {
+	outputDir := t.TempDir()
+	packager, err := NewPackager("", outputDir, "https://media.example.com/hls", []int{64})
+	if err != nil {
+		t.Fatalf("NewPackager() error = %v", err)
+	}
+	key := []byte("0123456789abcdef")
+	var keyInfo string
+	packager.run = func(_ context.Context, _ string, a ...string) ([]byte, error) {
+		i := slices.Index(a, "-hls_key_info_file")
+		if i < 0 {
+			return nil, errors.New("no key info file")
+		}
+		data, err := os.ReadFile(a[i+1])
+		if err != nil {
+			return nil, err
+		}
+		keyInfo = a[i+1]
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		if len(lines) != 2 || lines[0] != "https://api.example.com/drm/v1/keys/k1" {
+			return nil, errors.New("unexpected key info " + string(data))
+		}
+		if written, err := os.ReadFile(lines[1]); err != nil || string(written) != string(key) {
+			return nil, errors.New("unexpected key file")
+		}
+		dir := filepath.Dir(a[len(a)-1])
+		return nil, os.WriteFile(filepath.Join(dir, masterPlaylist), []byte("#EXTM3U\n"), 0o644)
+	}
+
+	_, err = packager.PackageAudio(context.Background(), core.AudioPackageRequest{
+		AssetID:    uuid.New(),
+		SourceURL:  "https://cdn.example.com/lesson.mp3",
+		Encryption: &core.AudioEncryption{Key: key, KeyURI: "https://api.example.com/drm/v1/keys/k1"},
+	})
+	if err != nil {
+		t.Fatalf("PackageAudio() error = %v", err)
+	}
+	if strings.HasPrefix(keyInfo, outputDir) {
+		t.Fatalf("expected the key to be kept out of the served directory, got %q", keyInfo)
+	}
+	if _, err := os.Stat(keyInfo); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected the key files to be removed, got %v", err)
+	}
+
+	_, err = packager.PackageAudio(context.Background(), core.AudioPackageRequest{
+		AssetID:    uuid.New(),
+		SourceURL:  "https://cdn.example.com/lesson.mp3",
+		Encryption: &core.AudioEncryption{Key: key[:8], KeyURI: "https://api.example.com/drm/v1/keys/k1"},
+	})
+	if err == nil {
+		t.Fatal("expected short keys to be rejected")
+	}
+}
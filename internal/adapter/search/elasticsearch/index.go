@@ -0,0 +1,317 @@
+// Package elasticsearch keeps content in an Elasticsearch index and searches
+// it with fuzzy matching, which tolerates typos.
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	maxErrorBodySize = 4096
+	// fragmentSize is the length of snippets, in characters.
+	fragmentSize = 160
+)
+
+// kindNames are the values of the kind field.
+var kindNames = map[core.SearchKind]string{
+	core.SearchKindSeries:  "series",
+	core.SearchKindEpisode: "episode",
+}
+
+// mappings keeps the filter fields exact and the text fields analyzed.
+var mappings = map[string]any{
+	"properties": map[string]any{
+		"kind":       map[string]string{"type": "keyword"},
+		"series_id":  map[string]string{"type": "keyword"},
+		"episode_id": map[string]string{"type": "keyword"},
+		"title":      map[string]string{"type": "text"},
+		"body":       map[string]string{"type": "text"},
+		"language":   map[string]string{"type": "keyword"},
+		"level":      map[string]string{"type": "keyword"},
+		"tags":       map[string]string{"type": "keyword"},
+	},
+}
+
+// document is a core.SearchDocument as stored in Elasticsearch.
+type document struct {
+	Kind      string   `json:"kind"`
+	SeriesID  string   `json:"series_id"`
+	EpisodeID string   `json:"episode_id,omitempty"`
+	Title     string   `json:"title"`
+	Body      string   `json:"body"`
+	Language  string   `json:"language"`
+	Level     string   `json:"level"`
+	Tags      []string `json:"tags"`
+}
+
+// Index implements core.SearchIndex on an Elasticsearch index, which is
+// created with its mappings before it is first used.
+type Index struct {
+	baseURL    string
+	apiKey     string
+	name       string
+	httpClient *http.Client
+
+	mu         sync.Mutex
+	configured bool
+}
+
+// NewIndex constructs an index named name on the Elasticsearch cluster at
+// baseURL. apiKey is an encoded Elasticsearch API key and may be empty for
+// clusters without security.
+func NewIndex(baseURL, apiKey, name string) *Index {
+	return &Index{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		apiKey:     apiKey,
+		name:       name,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// WithHTTPClient overrides the HTTP client used for Elasticsearch requests.
+func (i *Index) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		i.httpClient = client
+	}
+}
+
+var _ core.SearchIndex = (*Index)(nil)
+
+// IndexDocuments adds or replaces documents in one bulk request.
+func (i *Index) IndexDocuments(ctx context.Context, docs ...core.SearchDocument) error {
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, doc := range docs {
+		if err := encoder.Encode(map[string]any{"index": map[string]string{"_index": i.name, "_id": doc.ID}}); err != nil {
+			return err
+		}
+		if err := encoder.Encode(document{
+			Kind:      kindNames[doc.Kind],
+			SeriesID:  doc.SeriesID.String(),
+			EpisodeID: lo.Ternary(doc.EpisodeID == uuid.Nil, "", doc.EpisodeID.String()),
+			Title:     doc.Title,
+			Body:      doc.Body,
+			Language:  doc.Language,
+			Level:     doc.Level,
+			Tags:      lo.Ternary(doc.Tags == nil, []string{}, doc.Tags),
+		}); err != nil {
+			return err
+		}
+	}
+	return i.bulk(ctx, &body)
+}
+
+// DeleteDocuments removes documents by ID in one bulk request.
+func (i *Index) DeleteDocuments(ctx context.Context, ids ...string) error {
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, id := range ids {
+		if err := encoder.Encode(map[string]any{"delete": map[string]string{"_index": i.name, "_id": id}}); err != nil {
+			return err
+		}
+	}
+	return i.bulk(ctx, &body)
+}
+
+// Search returns a page of the documents matching the query, matching
+// terms within their automatic edit distance and title matches counting
+// double. Page tokens are offsets into the results.
+func (i *Index) Search(ctx context.Context, query core.SearchQuery) ([]core.SearchHit, string, error) {
+	offset := 0
+	if query.PageToken != "" {
+		var err error
+		if offset, err = strconv.Atoi(query.PageToken); err != nil || offset < 0 {
+			return nil, "", fmt.Errorf("%w: %q", core.ErrInvalidPageToken, query.PageToken)
+		}
+	}
+	if err := i.configure(ctx); err != nil {
+		return nil, "", err
+	}
+
+	request := map[string]any{
+		"from": offset,
+		"size": query.PageSize + 1,
+		"query": map[string]any{
+			"bool": map[string]any{
+				"must": map[string]any{
+					"multi_match": map[string]any{
+						"query":     query.Query,
+						"fields":    []string{"title^2", "body"},
+						"fuzziness": "AUTO",
+					},
+				},
+				"filter": filters(query),
+			},
+		},
+		"highlight": map[string]any{
+			"fields": map[string]any{
+				"body": map[string]any{"fragment_size": fragmentSize, "number_of_fragments": 1},
+			},
+		},
+	}
+	var response struct {
+		Hits struct {
+			Hits []struct {
+				Score     float64             `json:"_score"`
+				Source    document            `json:"_source"`
+				Highlight map[string][]string `json:"highlight"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err := i.do(ctx, http.MethodPost, "/"+url.PathEscape(i.name)+"/_search", "application/json", request, &response); err != nil {
+		return nil, "", err
+	}
+
+	found := response.Hits.Hits
+	nextToken := ""
+	if len(found) > query.PageSize {
+		found = found[:query.PageSize]
+		nextToken = strconv.Itoa(offset + query.PageSize)
+	}
+	hits := make([]core.SearchHit, 0, len(found))
+	for _, hit := range found {
+		seriesID, _ := uuid.Parse(hit.Source.SeriesID)
+		episodeID, _ := uuid.Parse(hit.Source.EpisodeID)
+		kind, _ := lo.FindKey(kindNames, hit.Source.Kind)
+		snippet := hit.Source.Body
+		if fragments := hit.Highlight["body"]; len(fragments) > 0 {
+			snippet = fragments[0]
+		} else if len([]rune(snippet)) > fragmentSize {
+			snippet = string([]rune(snippet)[:fragmentSize]) + "…"
+		}
+		hits = append(hits, core.SearchHit{
+			Kind:      kind,
+			SeriesID:  seriesID,
+			EpisodeID: episodeID,
+			Title:     hit.Source.Title,
+			Snippet:   snippet,
+			Score:     hit.Score,
+		})
+	}
+	return hits, nextToken, nil
+}
+
+// filters translates the filters of query into Elasticsearch filter clauses.
+func filters(query core.SearchQuery) []any {
+	clauses := []any{}
+	if len(query.Kinds) > 0 {
+		clauses = append(clauses, map[string]any{"terms": map[string]any{"kind": lo.Map(query.Kinds, func(kind core.SearchKind, _ int) string {
+			return kindNames[kind]
+		})}})
+	}
+	if query.Language != "" {
+		clauses = append(clauses, map[string]any{"term": map[string]any{"language": query.Language}})
+	}
+	if query.Level != "" {
+		clauses = append(clauses, map[string]any{"term": map[string]any{"level": query.Level}})
+	}
+	if len(query.Tags) > 0 {
+		clauses = append(clauses, map[string]any{"terms": map[string]any{"tags": query.Tags}})
+	}
+	return clauses
+}
+
+// bulk sends a bulk request and fails when any of its actions failed.
+// Deleting a missing document is not a failure.
+func (i *Index) bulk(ctx context.Context, body *bytes.Buffer) error {
+	if body.Len() == 0 {
+		return nil
+	}
+	if err := i.configure(ctx); err != nil {
+		return err
+	}
+
+	var response struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			Status int `json:"status"`
+		} `json:"items"`
+	}
+	if err := i.do(ctx, http.MethodPost, "/_bulk", "application/x-ndjson", body, &response); err != nil {
+		return err
+	}
+	if !response.Errors {
+		return nil
+	}
+	for _, item := range response.Items {
+		for action, result := range item {
+			if result.Status >= 300 && !(action == "delete" && result.Status == http.StatusNotFound) {
+				return fmt.Errorf("elasticsearch: bulk %s failed with status %d", action, result.Status)
+			}
+		}
+	}
+	return nil
+}
+
+// configure creates the index with its mappings once per process. An index
+// that already exists is left as it is.
+func (i *Index) configure(ctx context.Context) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.configured {
+		return nil
+	}
+
+	err := i.do(ctx, http.MethodPut, "/"+url.PathEscape(i.name), "application/json", map[string]any{"mappings": mappings}, nil)
+	if err != nil && !strings.Contains(err.Error(), "resource_already_exists_exception") {
+		return err
+	}
+	i.configured = true
+	return nil
+}
+
+// do sends body, JSON-encoded unless it is a buffer, and decodes the
+// response into out, when set.
+func (i *Index) do(ctx context.Context, method, path, contentType string, body, out any) error {
+	var reader io.Reader
+	if buf, ok := body.(*bytes.Buffer); ok {
+		reader = buf
+	} else {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, i.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	if i.apiKey != "" {
+		req.Header.Set("Authorization", "ApiKey "+i.apiKey)
+	}
+
+	resp, err := i.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("elasticsearch: %s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return fmt.Errorf("elasticsearch: %s %s: unexpected status %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	if out == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("elasticsearch: decode %s response: %w", path, err)
+	}
+	return nil
+}
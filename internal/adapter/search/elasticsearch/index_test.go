@@ -0,0 +1,82 @@
+package elasticsearch
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestIndex_IndexAndSearch(t *testing.T) {
+	seriesID := uuid.New()
+
+	var (
+		bulkLines []string
+		search    map[string]any
+		created   int
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /content", func(w http.ResponseWriter, r *http.Request) {
+		created++
+		if created > 1 {
+			http.Error(w, `{"error":{"type":"resource_already_exists_exception"}}`, http.StatusBadRequest)
+		}
+	})
+	mux.HandleFunc("POST /_bulk", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "ApiKey secret" || r.Header.Get("Content-Type") != "application/x-ndjson" {
+			http.Error(w, "unauthenticated", http.StatusUnauthorized)
+			return
+		}
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			bulkLines = append(bulkLines, scanner.Text())
+		}
+		if strings.Contains(bulkLines[len(bulkLines)-1], `"delete"`) {
+			_, _ = w.Write([]byte(`{"errors":true,"items":[{"delete":{"status":404}}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"errors":false,"items":[{"index":{"status":201}}]}`))
+	})
+	mux.HandleFunc("POST /content/_search", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&search)
+		_ = json.NewEncoder(w).Encode(map[string]any{"hits": map[string]any{"hits": []map[string]any{
+			{"_score": 3.2, "_source": map[string]any{"kind": "series", "series_id": seriesID, "title": "Coffee English", "body": "Learn to order"}, "highlight": map[string]any{"body": []string{"Learn to <em>order</em>"}}},
+		}}})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	index := NewIndex(server.URL, "secret", "content")
+	ctx := context.Background()
+
+	if err := index.IndexDocuments(ctx, core.SearchDocument{ID: "series-1", Kind: core.SearchKindSeries, SeriesID: seriesID, Title: "Coffee English"}); err != nil {
+		t.Fatalf("IndexDocuments() error = %v", err)
+	}
+	if len(bulkLines) != 2 || !strings.Contains(bulkLines[0], `"_id":"series-1"`) || !strings.Contains(bulkLines[1], `"kind":"series"`) {
+		t.Fatalf("unexpected bulk request %v", bulkLines)
+	}
+	if err := index.DeleteDocuments(ctx, "series-2"); err != nil {
+		t.Fatalf("DeleteDocuments() of a missing document error = %v", err)
+	}
+
+	// Another process created the index first.
+	other := NewIndex(server.URL, "secret", "content")
+	hits, next, err := other.Search(ctx, core.SearchQuery{Query: "cofee", Level: "beginner", PageSize: 10})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(hits) != 1 || hits[0].Kind != core.SearchKindSeries || hits[0].SeriesID != seriesID || hits[0].Snippet != "Learn to <em>order</em>" || hits[0].Score != 3.2 || next != "" {
+		t.Fatalf("unexpected hits %+v, next %q", hits, next)
+	}
+	query, _ := json.Marshal(search["query"])
+	if !strings.Contains(string(query), `"fuzziness":"AUTO"`) || !strings.Contains(string(query), `{"term":{"level":"beginner"}}`) {
+		t.Fatalf("unexpected search query %s", query)
+	}
+}
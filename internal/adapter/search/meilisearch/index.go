@@ -0,0 +1,257 @@
+// Package meilisearch keeps content in a Meilisearch index and searches it
+// with Meilisearch's built-in typo tolerance.
+package meilisearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	maxErrorBodySize = 4096
+	// cropLength is the length of snippets, in words.
+	cropLength = 30
+)
+
+// kindNames are the values of the kind attribute.
+var kindNames = map[core.SearchKind]string{
+	core.SearchKindSeries:  "series",
+	core.SearchKindEpisode: "episode",
+}
+
+// document is a core.SearchDocument as stored in Meilisearch.
+type document struct {
+	ID        string   `json:"id"`
+	Kind      string   `json:"kind"`
+	SeriesID  string   `json:"series_id"`
+	EpisodeID string   `json:"episode_id,omitempty"`
+	Title     string   `json:"title"`
+	Body      string   `json:"body"`
+	Language  string   `json:"language"`
+	Level     string   `json:"level"`
+	Tags      []string `json:"tags"`
+}
+
+// Index implements core.SearchIndex on a Meilisearch index. The index is
+// created and its searchable and filterable attributes are set before it is
+// first used.
+type Index struct {
+	baseURL    string
+	apiKey     string
+	name       string
+	httpClient *http.Client
+
+	mu         sync.Mutex
+	configured bool
+}
+
+// NewIndex constructs an index named name on the Meilisearch server at
+// baseURL. apiKey may be empty for servers without a master key.
+func NewIndex(baseURL, apiKey, name string) *Index {
+	return &Index{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		apiKey:     apiKey,
+		name:       name,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// WithHTTPClient overrides the HTTP client used for Meilisearch requests.
+func (i *Index) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		i.httpClient = client
+	}
+}
+
+var _ core.SearchIndex = (*Index)(nil)
+
+// IndexDocuments adds or replaces documents. Meilisearch applies them
+// asynchronously, so they show up in searches shortly after.
+func (i *Index) IndexDocuments(ctx context.Context, docs ...core.SearchDocument) error {
+	if err := i.configure(ctx); err != nil {
+		return err
+	}
+	body := lo.Map(docs, func(doc core.SearchDocument, _ int) document {
+		return document{
+			ID:        doc.ID,
+			Kind:      kindNames[doc.Kind],
+			SeriesID:  doc.SeriesID.String(),
+			EpisodeID: lo.Ternary(doc.EpisodeID == uuid.Nil, "", doc.EpisodeID.String()),
+			Title:     doc.Title,
+			Body:      doc.Body,
+			Language:  doc.Language,
+			Level:     doc.Level,
+			Tags:      lo.Ternary(doc.Tags == nil, []string{}, doc.Tags),
+		}
+	})
+	return i.do(ctx, http.MethodPost, i.indexPath("/documents?primaryKey=id"), body, nil)
+}
+
+// DeleteDocuments removes documents by ID.
+func (i *Index) DeleteDocuments(ctx context.Context, ids ...string) error {
+	if err := i.configure(ctx); err != nil {
+		return err
+	}
+	return i.do(ctx, http.MethodPost, i.indexPath("/documents/delete-batch"), ids, nil)
+}
+
+// Search returns a page of the documents matching the query. Page tokens are
+// offsets into the results.
+func (i *Index) Search(ctx context.Context, query core.SearchQuery) ([]core.SearchHit, string, error) {
+	offset := 0
+	if query.PageToken != "" {
+		var err error
+		if offset, err = strconv.Atoi(query.PageToken); err != nil || offset < 0 {
+			return nil, "", fmt.Errorf("%w: %q", core.ErrInvalidPageToken, query.PageToken)
+		}
+	}
+	if err := i.configure(ctx); err != nil {
+		return nil, "", err
+	}
+
+	request := map[string]any{
+		"q":                query.Query,
+		"offset":           offset,
+		"limit":            query.PageSize + 1,
+		"filter":           filters(query),
+		"attributesToCrop": []string{"body"},
+		"cropLength":       cropLength,
+		"showRankingScore": true,
+	}
+	var response struct {
+		Hits []struct {
+			document
+			Formatted struct {
+				Body string `json:"body"`
+			} `json:"_formatted"`
+			RankingScore float64 `json:"_rankingScore"`
+		} `json:"hits"`
+	}
+	if err := i.do(ctx, http.MethodPost, i.indexPath("/search"), request, &response); err != nil {
+		return nil, "", err
+	}
+
+	nextToken := ""
+	if len(response.Hits) > query.PageSize {
+		response.Hits = response.Hits[:query.PageSize]
+		nextToken = strconv.Itoa(offset + query.PageSize)
+	}
+	hits := make([]core.SearchHit, 0, len(response.Hits))
+	for _, hit := range response.Hits {
+		seriesID, _ := uuid.Parse(hit.SeriesID)
+		episodeID, _ := uuid.Parse(hit.EpisodeID)
+		kind, _ := lo.FindKey(kindNames, hit.Kind)
+		hits = append(hits, core.SearchHit{
+			Kind:      kind,
+			SeriesID:  seriesID,
+			EpisodeID: episodeID,
+			Title:     hit.Title,
+			Snippet:   hit.Formatted.Body,
+			Score:     hit.RankingScore,
+		})
+	}
+	return hits, nextToken, nil
+}
+
+// filters translates the filters of query into Meilisearch filter
+// expressions, which are ANDed.
+func filters(query core.SearchQuery) []string {
+	var exprs []string
+	if len(query.Kinds) > 0 {
+		exprs = append(exprs, "kind IN "+quoteList(lo.Map(query.Kinds, func(kind core.SearchKind, _ int) string {
+			return kindNames[kind]
+		})))
+	}
+	if query.Language != "" {
+		exprs = append(exprs, "language = "+strconv.Quote(query.Language))
+	}
+	if query.Level != "" {
+		exprs = append(exprs, "level = "+strconv.Quote(query.Level))
+	}
+	if len(query.Tags) > 0 {
+		exprs = append(exprs, "tags IN "+quoteList(query.Tags))
+	}
+	return exprs
+}
+
+func quoteList(values []string) string {
+	return "[" + strings.Join(lo.Map(values, func(value string, _ int) string { return strconv.Quote(value) }), ", ") + "]"
+}
+
+// configure creates the index and sets its attributes once per process.
+// Both calls are idempotent, so processes racing to configure are fine.
+func (i *Index) configure(ctx context.Context) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.configured {
+		return nil
+	}
+
+	err := i.do(ctx, http.MethodPost, "/indexes", map[string]string{"uid": i.name, "primaryKey": "id"}, nil)
+	if err != nil {
+		return err
+	}
+	settings := map[string]any{
+		"searchableAttributes": []string{"title", "body"},
+		"filterableAttributes": []string{"kind", "language", "level", "tags"},
+	}
+	if err := i.do(ctx, http.MethodPatch, i.indexPath("/settings"), settings, nil); err != nil {
+		return err
+	}
+	i.configured = true
+	return nil
+}
+
+func (i *Index) indexPath(path string) string {
+	return "/indexes/" + url.PathEscape(i.name) + path
+}
+
+// do sends body as JSON and decodes the response into out, when set. Index
+// and settings changes are accepted with 202 and applied asynchronously;
+// creating an index that exists fails only in the task Meilisearch queues.
+func (i *Index) do(ctx context.Context, method, path string, body, out any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, i.baseURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if i.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+i.apiKey)
+	}
+
+	resp, err := i.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("meilisearch: %s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return fmt.Errorf("meilisearch: %s %s: unexpected status %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	if out == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("meilisearch: decode %s response: %w", path, err)
+	}
+	return nil
+}
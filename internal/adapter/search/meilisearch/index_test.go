@@ -0,0 +1,96 @@
+package meilisearch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestIndex_IndexAndSearch(t *testing.T) {
+	seriesID := uuid.New()
+	episodeID := uuid.New()
+
+	var (
+		indexed  []document
+		deleted  []string
+		search   map[string]any
+		settings map[string]any
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /indexes", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+	mux.HandleFunc("PATCH /indexes/content/settings", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&settings)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	mux.HandleFunc("POST /indexes/content/documents", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(w, "unauthenticated", http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&indexed)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	mux.HandleFunc("POST /indexes/content/documents/delete-batch", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&deleted)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	mux.HandleFunc("POST /indexes/content/search", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&search)
+		_ = json.NewEncoder(w).Encode(map[string]any{"hits": []map[string]any{
+			{"id": "episode-1", "kind": "episode", "series_id": seriesID, "episode_id": episodeID, "title": "Ordering coffee", "_formatted": map[string]string{"body": "…order a coffee…"}, "_rankingScore": 0.9},
+			{"id": "series-1", "kind": "series", "series_id": seriesID, "title": "Coffee English", "_rankingScore": 0.5},
+		}})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	index := NewIndex(server.URL+"/", "secret", "content")
+	ctx := context.Background()
+
+	if err := index.IndexDocuments(ctx, core.SearchDocument{ID: "episode-1", Kind: core.SearchKindEpisode, SeriesID: seriesID, EpisodeID: episodeID, Title: "Ordering coffee"}); err != nil {
+		t.Fatalf("IndexDocuments() error = %v", err)
+	}
+	if len(indexed) != 1 || indexed[0].Kind != "episode" || indexed[0].EpisodeID != episodeID.String() || indexed[0].Tags == nil {
+		t.Fatalf("unexpected indexed documents %+v", indexed)
+	}
+	if filterable, _ := settings["filterableAttributes"].([]any); len(filterable) != 4 {
+		t.Fatalf("expected the filterable attributes to be set, got %+v", settings)
+	}
+	if err := index.DeleteDocuments(ctx, "series-1"); err != nil || strings.Join(deleted, ",") != "series-1" {
+		t.Fatalf("DeleteDocuments() = %v, deleted %v", err, deleted)
+	}
+
+	hits, next, err := index.Search(ctx, core.SearchQuery{
+		Query:    "cofee",
+		Kinds:    []core.SearchKind{core.SearchKindEpisode},
+		Language: "en",
+		Tags:     []string{"food"},
+		PageSize: 1,
+	})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(hits) != 1 || hits[0].Kind != core.SearchKindEpisode || hits[0].EpisodeID != episodeID || hits[0].Snippet != "…order a coffee…" || next != "1" {
+		t.Fatalf("unexpected hits %+v, next %q", hits, next)
+	}
+	filter, _ := json.Marshal(search["filter"])
+	if string(filter) != `["kind IN [\"episode\"]","language = \"en\"","tags IN [\"food\"]"]` || search["limit"] != float64(2) {
+		t.Fatalf("unexpected search request %+v", search)
+	}
+}
+
+func TestIndex_SearchRejectsBadPageToken(t *testing.T) {
+	index := NewIndex("http://meilisearch.invalid", "", "content")
+	if _, _, err := index.Search(context.Background(), core.SearchQuery{Query: "coffee", PageToken: "x"}); err == nil {
+		t.Fatal("expected an invalid page token error")
+	}
+}
@@ -0,0 +1,254 @@
+// Package spreadsheet reads the rows of CSV files and XLSX workbooks for
+// bulk imports.
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// maxPartSize bounds how much of a workbook part is decompressed, so a
+	// small archive cannot expand into gigabytes.
+	maxPartSize = 64 << 20
+	// maxRows bounds the row numbers of a sheet, which rows are placed at.
+	maxRows = 1 << 20
+)
+
+// Reader implements core.SpreadsheetReader.
+type Reader struct{}
+
+// NewReader constructs a spreadsheet reader.
+func NewReader() *Reader {
+	return &Reader{}
+}
+
+var _ core.SpreadsheetReader = (*Reader)(nil)
+
+// ReadRows returns the rows of a CSV file or of the first sheet of an XLSX
+// workbook. Trailing empty rows are dropped.
+func (r *Reader) ReadRows(format core.SpreadsheetFormat, data []byte) ([][]string, error) {
+	var (
+		rows [][]string
+		err  error
+	)
+	switch format {
+	case core.SpreadsheetFormatCSV:
+		rows, err = readCSV(data)
+	case core.SpreadsheetFormatXLSX:
+		rows, err = readXLSX(data)
+	default:
+		return nil, fmt.Errorf("%w: unsupported spreadsheet format", core.ErrValidation)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for len(rows) > 0 && strings.TrimSpace(strings.Join(rows[len(rows)-1], "")) == "" {
+		rows = rows[:len(rows)-1]
+	}
+	return rows, nil
+}
+
+func readCSV(data []byte) ([][]string, error) {
+	// Spreadsheet applications prefix UTF-8 exports with a byte order mark.
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+	if !utf8.Valid(data) {
+		return nil, fmt.Errorf("%w: csv must be UTF-8 encoded", core.ErrValidation)
+	}
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid csv: %v", core.ErrValidation, err)
+	}
+	return rows, nil
+}
+
+type workbookXML struct {
+	Sheets []struct {
+		RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type relationshipsXML struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type sharedStringsXML struct {
+	Items []richTextXML `xml:"si"`
+}
+
+// richTextXML is a plain (t) or rich text (r/t) string.
+type richTextXML struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t richTextXML) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+	var b strings.Builder
+	for _, run := range t.Runs {
+		b.WriteString(run.Text)
+	}
+	return b.String()
+}
+
+type worksheetXML struct {
+	Rows []struct {
+		Index int `xml:"r,attr"`
+		Cells []struct {
+			Ref    string      `xml:"r,attr"`
+			Type   string      `xml:"t,attr"`
+			Value  string      `xml:"v"`
+			Inline richTextXML `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+func readXLSX(data []byte) ([][]string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid xlsx: %v", core.ErrValidation, err)
+	}
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, file := range archive.File {
+		files[file.Name] = file
+	}
+
+	sheetPath, err := firstSheetPath(files)
+	if err != nil {
+		return nil, err
+	}
+	var shared sharedStringsXML
+	if _, ok := files["xl/sharedStrings.xml"]; ok {
+		if err := decodePart(files, "xl/sharedStrings.xml", &shared); err != nil {
+			return nil, err
+		}
+	}
+	var sheet worksheetXML
+	if err := decodePart(files, sheetPath, &sheet); err != nil {
+		return nil, err
+	}
+
+	var rows [][]string
+	for i, row := range sheet.Rows {
+		index := row.Index
+		if index == 0 {
+			index = i + 1
+		}
+		if index > maxRows {
+			return nil, fmt.Errorf("%w: invalid xlsx: sheet has more than %d rows", core.ErrValidation, maxRows)
+		}
+		for len(rows) < index {
+			rows = append(rows, nil)
+		}
+		cells := rows[index-1]
+		for j, cell := range row.Cells {
+			column := j
+			if cell.Ref != "" {
+				if column, err = columnIndex(cell.Ref); err != nil {
+					return nil, err
+				}
+			}
+			for len(cells) <= column {
+				cells = append(cells, "")
+			}
+			switch cell.Type {
+			case "s":
+				n, err := strconv.Atoi(cell.Value)
+				if err != nil || n < 0 || n >= len(shared.Items) {
+					return nil, fmt.Errorf("%w: invalid xlsx: cell %s references a missing string", core.ErrValidation, cell.Ref)
+				}
+				cells[column] = shared.Items[n].String()
+			case "inlineStr":
+				cells[column] = cell.Inline.String()
+			case "b":
+				cells[column] = strings.ToUpper(strconv.FormatBool(cell.Value == "1"))
+			default:
+				cells[column] = cell.Value
+			}
+		}
+		rows[index-1] = cells
+	}
+	return rows, nil
+}
+
+// firstSheetPath resolves the part holding the first sheet of the workbook.
+func firstSheetPath(files map[string]*zip.File) (string, error) {
+	var workbook workbookXML
+	if err := decodePart(files, "xl/workbook.xml", &workbook); err != nil {
+		return "", err
+	}
+	var rels relationshipsXML
+	if err := decodePart(files, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return "", err
+	}
+	if len(workbook.Sheets) == 0 {
+		return "", fmt.Errorf("%w: invalid xlsx: workbook has no sheets", core.ErrValidation)
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID != workbook.Sheets[0].RelID {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+	return "", fmt.Errorf("%w: invalid xlsx: first sheet not found", core.ErrValidation)
+}
+
+func decodePart(files map[string]*zip.File, name string, v any) error {
+	file, ok := files[name]
+	if !ok {
+		return fmt.Errorf("%w: invalid xlsx: missing %s", core.ErrValidation, name)
+	}
+	rc, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("%w: invalid xlsx: %v", core.ErrValidation, err)
+	}
+	defer rc.Close()
+
+	limited := &io.LimitedReader{R: rc, N: maxPartSize + 1}
+	if err := xml.NewDecoder(limited).Decode(v); err != nil {
+		if limited.N <= 0 {
+			return fmt.Errorf("%w: invalid xlsx: %s is larger than %d bytes", core.ErrValidation, name, maxPartSize)
+		}
+		return fmt.Errorf("%w: invalid xlsx: %s: %v", core.ErrValidation, name, err)
+	}
+	return nil
+}
+
+// columnIndex returns the 0-based column of a cell reference such as "AB12".
+func columnIndex(ref string) (int, error) {
+	column := 0
+	letters := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		column = column*26 + int(r-'A'+1)
+		letters++
+	}
+	if letters == 0 || letters > 3 {
+		return 0, fmt.Errorf("%w: invalid xlsx: invalid cell reference %q", core.ErrValidation, ref)
+	}
+	return column - 1, nil
+}
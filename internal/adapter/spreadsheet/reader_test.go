@@ -0,0 +1,79 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func buildWorkbook(t *testing.T, parts map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for name, body := range parts {
+		w, err := writer.Create(name)
+		if err != nil {
+			t.Fatalf("create %s: %v", name, err)
+		}
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("close zip: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestReader_ReadRowsXLSX(t *testing.T) {
+	data := buildWorkbook(t, map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Catalog" sheetId="1" r:id="rId7"/><sheet name="Notes" sheetId="2" r:id="rId8"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId8" Target="worksheets/sheet2.xml"/><Relationship Id="rId7" Target="worksheets/sheet1.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<si><t>series_slug</t></si><si><t>episode_title</t></si><si><r><t>Coffee </t></r><r><t>talk</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="C1" t="s"><v>1</v></c></row>
+<row r="3"><c r="A3" t="inlineStr"><is><t>coffee</t></is></c><c r="B3"><v>42</v></c><c r="C3" t="s"><v>2</v></c><c r="D3" t="b"><v>1</v></c></row>
+<row r="4"/></sheetData></worksheet>`,
+		"xl/worksheets/sheet2.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData/></worksheet>`,
+	})
+
+	rows, err := NewReader().ReadRows(core.SpreadsheetFormatXLSX, data)
+	if err != nil {
+		t.Fatalf("ReadRows() error = %v", err)
+	}
+	want := [][]string{
+		{"series_slug", "", "episode_title"},
+		nil,
+		{"coffee", "42", "Coffee talk", "TRUE"},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Fatalf("unexpected rows %q", rows)
+	}
+
+	if _, err := NewReader().ReadRows(core.SpreadsheetFormatXLSX, []byte("not a zip")); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected ErrValidation for a broken workbook, got %v", err)
+	}
+}
+
+func TestReader_ReadRowsCSV(t *testing.T) {
+	rows, err := NewReader().ReadRows(core.SpreadsheetFormatCSV, []byte("\ufeffseries_slug,episode_title\ncoffee,\"Hello, world\"\ntea\n\n"))
+	if err != nil {
+		t.Fatalf("ReadRows() error = %v", err)
+	}
+	want := [][]string{{"series_slug", "episode_title"}, {"coffee", "Hello, world"}, {"tea"}}
+	if !reflect.DeepEqual(rows, want) {
+		t.Fatalf("unexpected rows %q", rows)
+	}
+
+	if _, err := NewReader().ReadRows(core.SpreadsheetFormatCSV, []byte("a,\"b\n")); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected ErrValidation for broken csv, got %v", err)
+	}
+}
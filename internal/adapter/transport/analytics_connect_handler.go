@@ -0,0 +1,125 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// AnalyticsHandler implements the generated Connect service for engagement analytics.
+type AnalyticsHandler struct {
+	service core.AnalyticsService
+}
+
+// NewAnalyticsHandler constructs a new analytics handler backed by the provided service.
+func NewAnalyticsHandler(service core.AnalyticsService) *AnalyticsHandler {
+	return &AnalyticsHandler{service: service}
+}
+
+var _ lessionv1connect.AnalyticsServiceHandler = (*AnalyticsHandler)(nil)
+
+// GetEpisodeAnalytics returns the engagement with an episode.
+func (h *AnalyticsHandler) GetEpisodeAnalytics(ctx context.Context, req *connect.Request[lessionv1.GetEpisodeAnalyticsRequest]) (*connect.Response[lessionv1.GetEpisodeAnalyticsResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetEpisodeId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+	}
+
+	analytics, err := h.service.GetEpisodeAnalytics(ctx, core.EngagementAnalyticsQuery{
+		ID:   id,
+		From: optionalTime(req.Msg.GetFrom()),
+		To:   optionalTime(req.Msg.GetTo()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetEpisodeAnalyticsResponse{
+		Analytics: toProtoEngagementAnalytics(analytics),
+	}), nil
+}
+
+// GetSeriesAnalytics returns the engagement with a series across its episodes.
+func (h *AnalyticsHandler) GetSeriesAnalytics(ctx context.Context, req *connect.Request[lessionv1.GetSeriesAnalyticsRequest]) (*connect.Response[lessionv1.GetSeriesAnalyticsResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetSeriesId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid series_id %q", core.ErrValidation, req.Msg.GetSeriesId())
+	}
+
+	analytics, err := h.service.GetSeriesAnalytics(ctx, core.EngagementAnalyticsQuery{
+		ID:   id,
+		From: optionalTime(req.Msg.GetFrom()),
+		To:   optionalTime(req.Msg.GetTo()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetSeriesAnalyticsResponse{
+		Analytics: toProtoEngagementAnalytics(analytics),
+	}), nil
+}
+
+// RollupEngagement queues a rollup of a day.
+func (h *AnalyticsHandler) RollupEngagement(ctx context.Context, req *connect.Request[lessionv1.RollupEngagementRequest]) (*connect.Response[lessionv1.RollupEngagementResponse], error) {
+	job, err := h.service.RequestEngagementRollup(ctx, optionalTime(req.Msg.GetDay()))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.RollupEngagementResponse{
+		Job: toProtoJob(job),
+	}), nil
+}
+
+func toProtoEngagementAnalytics(analytics *core.EngagementAnalytics) *lessionv1.EngagementAnalytics {
+	if analytics == nil {
+		return nil
+	}
+	pb := &lessionv1.EngagementAnalytics{
+		SeriesId: analytics.SeriesID.String(),
+		Total:    toProtoEngagementMetrics(analytics.Total),
+		Days: lo.Map(analytics.Days, func(day core.DailyEngagement, _ int) *lessionv1.DailyEngagement {
+			return &lessionv1.DailyEngagement{
+				Day:     timestamppb.New(day.Day),
+				Metrics: toProtoEngagementMetrics(day.Metrics),
+			}
+		}),
+	}
+	if analytics.SubjectID != analytics.SeriesID {
+		pb.EpisodeId = analytics.SubjectID.String()
+	}
+	if analytics.RolledUpAt != nil {
+		pb.RolledUpAt = timestamppb.New(*analytics.RolledUpAt)
+	}
+	return pb
+}
+
+func toProtoEngagementMetrics(metrics core.EngagementMetrics) *lessionv1.EngagementMetrics {
+	return &lessionv1.EngagementMetrics{
+		Sessions:         int32(metrics.Sessions),
+		FinishedSessions: int32(metrics.FinishedSessions),
+		UniqueListeners:  int32(metrics.UniqueListeners),
+		Completers:       int32(metrics.Completers),
+		CompletionRate:   metrics.CompletionRate(),
+		ListenThrough:    metrics.ListenThrough(),
+	}
+}
+
+// optionalTime converts an optional timestamp, returning the zero time when
+// it is unset.
+func optionalTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
@@ -0,0 +1,108 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// APIKeyHandler implements the generated Connect service for API keys.
+type APIKeyHandler struct {
+	service core.APIKeyService
+}
+
+// NewAPIKeyHandler constructs a new API key handler backed by the provided service.
+func NewAPIKeyHandler(service core.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{service: service}
+}
+
+var _ lessionv1connect.ApiKeyServiceHandler = (*APIKeyHandler)(nil)
+
+// CreateApiKey issues a key and returns it once.
+func (h *APIKeyHandler) CreateApiKey(ctx context.Context, req *connect.Request[lessionv1.CreateApiKeyRequest]) (*connect.Response[lessionv1.CreateApiKeyResponse], error) {
+	draft := core.APIKey{
+		Name:   req.Msg.GetName(),
+		Scopes: req.Msg.GetScopes(),
+	}
+	if req.Msg.GetExpiresAt() != nil {
+		expiresAt := req.Msg.GetExpiresAt().AsTime()
+		draft.ExpiresAt = &expiresAt
+	}
+
+	key, secret, err := h.service.CreateAPIKey(ctx, draft)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.CreateApiKeyResponse{
+		ApiKey: toProtoAPIKey(key),
+		Key:    secret,
+	}), nil
+}
+
+// RevokeApiKey stops a key from authenticating.
+func (h *APIKeyHandler) RevokeApiKey(ctx context.Context, req *connect.Request[lessionv1.RevokeApiKeyRequest]) (*connect.Response[lessionv1.RevokeApiKeyResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetApiKeyId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid api_key_id %q", core.ErrValidation, req.Msg.GetApiKeyId())
+	}
+
+	key, err := h.service.RevokeAPIKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.RevokeApiKeyResponse{
+		ApiKey: toProtoAPIKey(key),
+	}), nil
+}
+
+// ListApiKeys returns keys in creation order.
+func (h *APIKeyHandler) ListApiKeys(ctx context.Context, req *connect.Request[lessionv1.ListApiKeysRequest]) (*connect.Response[lessionv1.ListApiKeysResponse], error) {
+	keys, nextToken, err := h.service.ListAPIKeys(ctx, core.APIKeyListFilter{
+		IncludeRevoked: req.Msg.GetIncludeRevoked(),
+		PageSize:       int(req.Msg.GetPageSize()),
+		PageToken:      req.Msg.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListApiKeysResponse{
+		ApiKeys: lo.Map(keys, func(key core.APIKey, _ int) *lessionv1.ApiKey {
+			return toProtoAPIKey(&key)
+		}),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+func toProtoAPIKey(key *core.APIKey) *lessionv1.ApiKey {
+	if key == nil {
+		return nil
+	}
+	out := &lessionv1.ApiKey{
+		Id:        key.ID.String(),
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Scopes:    key.Scopes,
+		CreatedAt: timestamppb.New(key.CreatedAt),
+	}
+	if key.LastUsedAt != nil {
+		out.LastUsedAt = timestamppb.New(*key.LastUsedAt)
+	}
+	if key.ExpiresAt != nil {
+		out.ExpiresAt = timestamppb.New(*key.ExpiresAt)
+	}
+	if key.RevokedAt != nil {
+		out.RevokedAt = timestamppb.New(*key.RevokedAt)
+	}
+	return out
+}
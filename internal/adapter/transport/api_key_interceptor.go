@@ -0,0 +1,81 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// APIKeyCallerPrefix marks callers authenticated by an API key; the key's
+// identifier follows it, e.g. "apikey:6f1c...".
+const APIKeyCallerPrefix = "apikey:"
+
+// NewAPIKeyInterceptor authenticates requests sent with an
+// `Authorization: Bearer lsk_...` header, for unary and streaming handlers.
+// The key becomes the caller, and requests outside its scopes fail with
+// PermissionDenied. Requests without an API key pass through unchanged. It
+// must run inside the error interceptor so failures map to Unauthenticated
+// and PermissionDenied.
+func NewAPIKeyInterceptor(keys core.APIKeyService) connect.Interceptor {
+	return apiKeyInterceptor{keys: keys}
+}
+
+type apiKeyInterceptor struct {
+	keys core.APIKeyService
+}
+
+func (i apiKeyInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := i.authenticate(ctx, req.Header(), req.Spec().Procedure)
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (apiKeyInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i apiKeyInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.authenticate(ctx, conn.RequestHeader(), conn.Spec().Procedure)
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+// authenticate makes the API key in header the caller of procedure. The
+// context is returned unchanged when the request carries no API key.
+func (i apiKeyInterceptor) authenticate(ctx context.Context, header http.Header, procedure string) (context.Context, error) {
+	secret, ok := bearerAPIKey(header.Get("Authorization"))
+	if !ok {
+		return ctx, nil
+	}
+
+	key, err := i.keys.Authenticate(ctx, secret)
+	if err != nil {
+		return nil, err
+	}
+	if !key.Allows(procedure) {
+		return nil, fmt.Errorf("%w: api key %s is not scoped for %s", core.ErrPermissionDenied, key.Prefix, procedure)
+	}
+	return core.NewCallerContext(ctx, core.Caller{UserID: APIKeyCallerPrefix + key.ID.String(), APIKeyScopes: key.Scopes}), nil
+}
+
+func bearerAPIKey(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(header), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, strings.HasPrefix(token, core.APIKeyPrefix)
+}
@@ -7,6 +7,7 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -18,12 +19,14 @@ import (
 
 // AssetHandler implements the generated Connect service for asset operations.
 type AssetHandler struct {
-	service core.AssetService
+	service   core.AssetService
+	packaging core.AudioPackagingService
+	usage     core.AssetUsageService
 }
 
-// NewAssetHandler constructs a new Asset handler backed by the provided service.
-func NewAssetHandler(service core.AssetService) *AssetHandler {
-	return &AssetHandler{service: service}
+// NewAssetHandler constructs a new Asset handler backed by the provided services.
+func NewAssetHandler(service core.AssetService, packaging core.AudioPackagingService, usage core.AssetUsageService) *AssetHandler {
+	return &AssetHandler{service: service, packaging: packaging, usage: usage}
 }
 
 var _ lessionv1connect.AssetServiceHandler = (*AssetHandler)(nil)
@@ -65,6 +68,37 @@ func (h *AssetHandler) GetUpload(ctx context.Context, req *connect.Request[lessi
 	}), nil
 }
 
+// ListUploads enumerates upload sessions, newest first.
+func (h *AssetHandler) ListUploads(ctx context.Context, req *connect.Request[lessionv1.ListUploadsRequest]) (*connect.Response[lessionv1.ListUploadsResponse], error) {
+	filter := core.UploadListFilter{
+		PageSize:  int(req.Msg.GetPageSize()),
+		PageToken: req.Msg.GetPageToken(),
+		Statuses:  fromProtoUploadStatuses(req.Msg.GetStatuses()),
+		Types:     fromProtoMediaTypes(req.Msg.GetTypes()),
+	}
+	if req.Msg.GetCreatedAfter() != nil {
+		filter.CreatedAfter = req.Msg.GetCreatedAfter().AsTime()
+	}
+	if req.Msg.GetCreatedBefore() != nil {
+		filter.CreatedBefore = req.Msg.GetCreatedBefore().AsTime()
+	}
+
+	sessions, nextToken, err := h.service.ListUploads(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	protoSessions := make([]*lessionv1.UploadSession, 0, len(sessions))
+	for i := range sessions {
+		protoSessions = append(protoSessions, toProtoUploadSession(&sessions[i]))
+	}
+
+	return connect.NewResponse(&lessionv1.ListUploadsResponse{
+		Uploads:       protoSessions,
+		NextPageToken: nextToken,
+	}), nil
+}
+
 // CompleteUpload finalizes an upload session and transitions the asset to processing.
 func (h *AssetHandler) CompleteUpload(ctx context.Context, req *connect.Request[lessionv1.CompleteUploadRequest]) (*connect.Response[lessionv1.CompleteUploadResponse], error) {
 	identifier, err := buildUploadIdentifier(req.Msg.GetUploadId(), req.Msg.GetAssetKey())
@@ -87,6 +121,38 @@ func (h *AssetHandler) CompleteUpload(ctx context.Context, req *connect.Request[
 	}), nil
 }
 
+// CancelUpload abandons an upload session that has not completed.
+func (h *AssetHandler) CancelUpload(ctx context.Context, req *connect.Request[lessionv1.CancelUploadRequest]) (*connect.Response[lessionv1.CancelUploadResponse], error) {
+	identifier, err := buildUploadIdentifier(req.Msg.GetUploadId(), req.Msg.GetAssetKey())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.service.CancelUpload(ctx, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.CancelUploadResponse{
+		Asset:  toProtoAsset(&result.Asset),
+		Upload: toProtoUploadSession(&result.Session),
+	}), nil
+}
+
+// RegisterExternalAsset creates a ready asset played from media hosted elsewhere.
+func (h *AssetHandler) RegisterExternalAsset(ctx context.Context, req *connect.Request[lessionv1.RegisterExternalAssetRequest]) (*connect.Response[lessionv1.RegisterExternalAssetResponse], error) {
+	asset, err := h.service.RegisterExternalAsset(ctx, core.RegisterExternalAssetParams{
+		Type:             fromProtoMediaType(req.Msg.GetType()),
+		URL:              req.Msg.GetUrl(),
+		OriginalFilename: req.Msg.GetOriginalFilename(),
+		Duration:         req.Msg.GetDuration().AsDuration(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lessionv1.RegisterExternalAssetResponse{Asset: toProtoAsset(asset)}), nil
+}
+
 // GetAsset returns details for a single managed asset.
 func (h *AssetHandler) GetAsset(ctx context.Context, req *connect.Request[lessionv1.GetAssetRequest]) (*connect.Response[lessionv1.GetAssetResponse], error) {
 	identifier := req.Msg.GetIdentifier()
@@ -118,11 +184,12 @@ func (h *AssetHandler) GetAsset(ctx context.Context, req *connect.Request[lessio
 // ListAssets returns a filtered, paginated collection of assets.
 func (h *AssetHandler) ListAssets(ctx context.Context, req *connect.Request[lessionv1.ListAssetsRequest]) (*connect.Response[lessionv1.ListAssetsResponse], error) {
 	filter := core.AssetListFilter{
-		PageSize:  int(req.Msg.GetPageSize()),
-		PageToken: req.Msg.GetPageToken(),
-		Statuses:  fromProtoAssetStatuses(req.Msg.GetStatuses()),
-		Types:     fromProtoMediaTypes(req.Msg.GetTypes()),
-		AssetKeys: req.Msg.GetAssetKeys(),
+		PageSize:     int(req.Msg.GetPageSize()),
+		PageToken:    req.Msg.GetPageToken(),
+		Statuses:     fromProtoAssetStatuses(req.Msg.GetStatuses()),
+		Types:        fromProtoMediaTypes(req.Msg.GetTypes()),
+		AssetKeys:    req.Msg.GetAssetKeys(),
+		FailureCodes: fromProtoAssetFailureCodes(req.Msg.GetFailureCodes()),
 	}
 
 	assets, nextToken, err := h.service.ListAssets(ctx, filter)
@@ -200,6 +267,109 @@ func (h *AssetHandler) DeleteAsset(ctx context.Context, req *connect.Request[les
 	}), nil
 }
 
+// WatchAsset streams the asset now and on every status change until it settles.
+func (h *AssetHandler) WatchAsset(ctx context.Context, req *connect.Request[lessionv1.WatchAssetRequest], stream *connect.ServerStream[lessionv1.WatchAssetResponse]) error {
+	assetID, err := uuid.Parse(req.Msg.GetAssetId())
+	if err != nil {
+		return fmt.Errorf("%w: invalid asset_id %q", core.ErrValidation, req.Msg.GetAssetId())
+	}
+
+	return h.service.WatchAsset(ctx, assetID, func(asset core.Asset) error {
+		return stream.Send(&lessionv1.WatchAssetResponse{Asset: toProtoAsset(&asset)})
+	})
+}
+
+// PackageAsset packages an audio asset as HLS again, rotating its content key
+// when it is encrypted.
+func (h *AssetHandler) PackageAsset(ctx context.Context, req *connect.Request[lessionv1.PackageAssetRequest]) (*connect.Response[lessionv1.PackageAssetResponse], error) {
+	assetID, err := uuid.Parse(req.Msg.GetAssetId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid asset_id %q", core.ErrValidation, req.Msg.GetAssetId())
+	}
+
+	asset, err := h.packaging.PackageAsset(ctx, assetID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lessionv1.PackageAssetResponse{Asset: toProtoAsset(asset)}), nil
+}
+
+// RetryAssetProcessing reruns the processing pipeline on a failed asset.
+func (h *AssetHandler) RetryAssetProcessing(ctx context.Context, req *connect.Request[lessionv1.RetryAssetProcessingRequest]) (*connect.Response[lessionv1.RetryAssetProcessingResponse], error) {
+	assetID, err := uuid.Parse(req.Msg.GetAssetId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid asset_id %q", core.ErrValidation, req.Msg.GetAssetId())
+	}
+
+	asset, err := h.service.RetryAssetProcessing(ctx, assetID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lessionv1.RetryAssetProcessingResponse{Asset: toProtoAsset(asset)}), nil
+}
+
+// ReplaceAssetContent starts an upload of new content for an existing asset.
+func (h *AssetHandler) ReplaceAssetContent(ctx context.Context, req *connect.Request[lessionv1.ReplaceAssetContentRequest]) (*connect.Response[lessionv1.ReplaceAssetContentResponse], error) {
+	assetID, err := uuid.Parse(req.Msg.GetAssetId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid asset_id %q", core.ErrValidation, req.Msg.GetAssetId())
+	}
+
+	result, err := h.service.ReplaceAssetContent(ctx, core.ReplaceAssetContentParams{
+		AssetID:          assetID,
+		OriginalFilename: req.Msg.GetOriginalFilename(),
+		MimeType:         req.Msg.GetMimeType(),
+		ContentLength:    req.Msg.GetContentLength(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ReplaceAssetContentResponse{
+		Upload: toProtoUploadSession(&result.Session),
+		Asset:  toProtoAsset(&result.Asset),
+	}), nil
+}
+
+// ListAssetVersions lists the content an asset was served from before it was replaced.
+func (h *AssetHandler) ListAssetVersions(ctx context.Context, req *connect.Request[lessionv1.ListAssetVersionsRequest]) (*connect.Response[lessionv1.ListAssetVersionsResponse], error) {
+	assetID, err := uuid.Parse(req.Msg.GetAssetId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid asset_id %q", core.ErrValidation, req.Msg.GetAssetId())
+	}
+
+	versions, err := h.service.ListAssetVersions(ctx, assetID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lessionv1.ListAssetVersionsResponse{
+		Versions: lo.Map(versions, func(version core.AssetVersion, _ int) *lessionv1.AssetVersion {
+			return toProtoAssetVersion(version)
+		}),
+	}), nil
+}
+
+// GetAssetUsage reports how often the media of an asset was accessed, per day.
+func (h *AssetHandler) GetAssetUsage(ctx context.Context, req *connect.Request[lessionv1.GetAssetUsageRequest]) (*connect.Response[lessionv1.GetAssetUsageResponse], error) {
+	assetID, err := uuid.Parse(req.Msg.GetAssetId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid asset_id %q", core.ErrValidation, req.Msg.GetAssetId())
+	}
+	query := core.AssetUsageQuery{AssetID: assetID}
+	if req.Msg.GetFrom() != nil {
+		query.From = req.Msg.GetFrom().AsTime()
+	}
+	if req.Msg.GetTo() != nil {
+		query.To = req.Msg.GetTo().AsTime()
+	}
+
+	usage, err := h.usage.GetAssetUsage(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lessionv1.GetAssetUsageResponse{Usage: toProtoAssetUsage(usage)}), nil
+}
+
 func buildUploadIdentifier(uploadID, assetKey string) (core.UploadIdentifier, error) {
 	var identifier core.UploadIdentifier
 	if trimmed := strings.TrimSpace(uploadID); trimmed != "" {
@@ -222,6 +392,8 @@ func fromProtoMediaType(t lessionv1.MediaType) core.AssetType {
 		return core.AssetTypeAudio
 	case lessionv1.MediaType_MEDIA_TYPE_VIDEO:
 		return core.AssetTypeVideo
+	case lessionv1.MediaType_MEDIA_TYPE_IMAGE:
+		return core.AssetTypeImage
 	default:
 		return core.AssetTypeUnspecified
 	}
@@ -266,11 +438,74 @@ func fromProtoAssetStatuses(statuses []lessionv1.AssetStatus) []core.AssetStatus
 	return result
 }
 
+func fromProtoUploadStatus(status lessionv1.UploadStatus) core.UploadStatus {
+	switch status {
+	case lessionv1.UploadStatus_UPLOAD_STATUS_AWAITING_UPLOAD:
+		return core.UploadStatusAwaitingUpload
+	case lessionv1.UploadStatus_UPLOAD_STATUS_UPLOADING:
+		return core.UploadStatusUploading
+	case lessionv1.UploadStatus_UPLOAD_STATUS_COMPLETED:
+		return core.UploadStatusCompleted
+	case lessionv1.UploadStatus_UPLOAD_STATUS_EXPIRED:
+		return core.UploadStatusExpired
+	case lessionv1.UploadStatus_UPLOAD_STATUS_FAILED:
+		return core.UploadStatusFailed
+	case lessionv1.UploadStatus_UPLOAD_STATUS_CANCELLED:
+		return core.UploadStatusCancelled
+	default:
+		return core.UploadStatusUnspecified
+	}
+}
+
+func fromProtoUploadStatuses(statuses []lessionv1.UploadStatus) []core.UploadStatus {
+	if len(statuses) == 0 {
+		return nil
+	}
+	result := make([]core.UploadStatus, 0, len(statuses))
+	for _, status := range statuses {
+		result = append(result, fromProtoUploadStatus(status))
+	}
+	return result
+}
+
+func fromProtoAssetFailureCode(code lessionv1.AssetFailureCode) core.AssetFailureCode {
+	switch code {
+	case lessionv1.AssetFailureCode_ASSET_FAILURE_CODE_UPLOAD_EXPIRED:
+		return core.AssetFailureCodeUploadExpired
+	case lessionv1.AssetFailureCode_ASSET_FAILURE_CODE_INVALID_MEDIA:
+		return core.AssetFailureCodeInvalidMedia
+	default:
+		return core.AssetFailureCodeUnspecified
+	}
+}
+
+func fromProtoAssetFailureCodes(codes []lessionv1.AssetFailureCode) []core.AssetFailureCode {
+	if len(codes) == 0 {
+		return nil
+	}
+	result := make([]core.AssetFailureCode, 0, len(codes))
+	for _, code := range codes {
+		result = append(result, fromProtoAssetFailureCode(code))
+	}
+	return result
+}
+
+func toProtoAssetFailureCode(code core.AssetFailureCode) lessionv1.AssetFailureCode {
+	switch code {
+	case core.AssetFailureCodeUploadExpired:
+		return lessionv1.AssetFailureCode_ASSET_FAILURE_CODE_UPLOAD_EXPIRED
+	case core.AssetFailureCodeInvalidMedia:
+		return lessionv1.AssetFailureCode_ASSET_FAILURE_CODE_INVALID_MEDIA
+	default:
+		return lessionv1.AssetFailureCode_ASSET_FAILURE_CODE_UNSPECIFIED
+	}
+}
+
 func toProtoUploadSession(session *core.UploadSession) *lessionv1.UploadSession {
 	if session == nil {
 		return nil
 	}
-	return &lessionv1.UploadSession{
+	proto := &lessionv1.UploadSession{
 		Id:               session.ID.String(),
 		AssetKey:         session.AssetKey,
 		Type:             toProtoMediaType(session.Type),
@@ -283,9 +518,55 @@ func toProtoUploadSession(session *core.UploadSession) *lessionv1.UploadSession
 		ExpiresAt:        timestamppb.New(session.ExpiresAt),
 		CreatedAt:        timestamppb.New(session.CreatedAt),
 		UpdatedAt:        timestamppb.New(session.UpdatedAt),
+		Provider:         session.Provider,
+	}
+	if session.ReplacesAssetID != uuid.Nil {
+		proto.ReplacesAssetId = session.ReplacesAssetID.String()
+	}
+	return proto
+}
+
+func toProtoAssetUsage(usage *core.AssetUsage) *lessionv1.AssetUsage {
+	return &lessionv1.AssetUsage{
+		AssetId: usage.AssetID.String(),
+		From:    timestamppb.New(usage.From),
+		To:      timestamppb.New(usage.To),
+		Total:   toProtoAssetUsageCounts(usage.Total),
+		Days: lo.Map(usage.Days, func(day core.DailyAssetUsage, _ int) *lessionv1.DailyAssetUsage {
+			return &lessionv1.DailyAssetUsage{
+				Day:    timestamppb.New(day.Day),
+				Counts: toProtoAssetUsageCounts(day.Counts),
+			}
+		}),
 	}
 }
 
+func toProtoAssetUsageCounts(counts core.AssetUsageCounts) *lessionv1.AssetUsageCounts {
+	return &lessionv1.AssetUsageCounts{
+		PlaybackUrlsIssued: counts.PlaybackURLsIssued,
+		Downloads:          counts.Downloads,
+	}
+}
+
+func toProtoAssetVersion(version core.AssetVersion) *lessionv1.AssetVersion {
+	proto := &lessionv1.AssetVersion{
+		Id:               version.ID.String(),
+		AssetId:          version.AssetID.String(),
+		AssetKey:         version.AssetKey,
+		Provider:         version.Provider,
+		OriginalFilename: version.OriginalFilename,
+		MimeType:         version.MimeType,
+		Filesize:         version.Filesize,
+		PlaybackUrl:      version.PlaybackURL,
+		HlsManifestUrl:   version.HLSManifestURL,
+		CreatedAt:        timestamppb.New(version.CreatedAt),
+	}
+	if version.Duration > 0 {
+		proto.Duration = durationpb.New(version.Duration)
+	}
+	return proto
+}
+
 func toProtoUploadTarget(target core.UploadTarget) *lessionv1.UploadTarget {
 	return &lessionv1.UploadTarget{
 		Method:     target.Method,
@@ -308,8 +589,23 @@ func toProtoAsset(asset *core.Asset) *lessionv1.Asset {
 		MimeType:         asset.MimeType,
 		Filesize:         asset.Filesize,
 		PlaybackUrl:      asset.PlaybackURL,
-		CreatedAt:        timestamppb.New(asset.CreatedAt),
-		UpdatedAt:        timestamppb.New(asset.UpdatedAt),
+		HlsManifestUrl:   asset.HLSManifestURL,
+		HlsEncrypted:     asset.HLSKeyID != uuid.Nil,
+		Width:            int32(asset.Width),
+		Height:           int32(asset.Height),
+		ImageVariants: lo.Map(asset.ImageVariants, func(variant core.ImageVariant, _ int) *lessionv1.ImageVariant {
+			return &lessionv1.ImageVariant{
+				Name:   variant.Name,
+				Url:    variant.URL,
+				Width:  int32(variant.Width),
+				Height: int32(variant.Height),
+			}
+		}),
+		FailureCode:   toProtoAssetFailureCode(asset.FailureCode),
+		FailureReason: asset.FailureReason,
+		CreatedAt:     timestamppb.New(asset.CreatedAt),
+		UpdatedAt:     timestamppb.New(asset.UpdatedAt),
+		Provider:      asset.Provider,
 	}
 	if asset.Duration > 0 {
 		proto.Duration = durationpb.New(asset.Duration)
@@ -326,6 +622,8 @@ func toProtoMediaType(t core.AssetType) lessionv1.MediaType {
 		return lessionv1.MediaType_MEDIA_TYPE_AUDIO
 	case core.AssetTypeVideo:
 		return lessionv1.MediaType_MEDIA_TYPE_VIDEO
+	case core.AssetTypeImage:
+		return lessionv1.MediaType_MEDIA_TYPE_IMAGE
 	default:
 		return lessionv1.MediaType_MEDIA_TYPE_UNSPECIFIED
 	}
@@ -373,6 +671,8 @@ func toProtoUploadStatus(status core.UploadStatus) lessionv1.UploadStatus {
 		return lessionv1.UploadStatus_UPLOAD_STATUS_EXPIRED
 	case core.UploadStatusFailed:
 		return lessionv1.UploadStatus_UPLOAD_STATUS_FAILED
+	case core.UploadStatusCancelled:
+		return lessionv1.UploadStatus_UPLOAD_STATUS_CANCELLED
 	default:
 		return lessionv1.UploadStatus_UPLOAD_STATUS_UNSPECIFIED
 	}
@@ -0,0 +1,81 @@
+package transport
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/samber/lo"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// AuditHandler implements the generated Connect service for the audit log.
+type AuditHandler struct {
+	service core.AuditService
+}
+
+// NewAuditHandler constructs a new audit handler backed by the provided service.
+func NewAuditHandler(service core.AuditService) *AuditHandler {
+	return &AuditHandler{service: service}
+}
+
+var _ lessionv1connect.AuditServiceHandler = (*AuditHandler)(nil)
+
+// ListAuditEntries returns audit entries, newest first.
+func (h *AuditHandler) ListAuditEntries(ctx context.Context, req *connect.Request[lessionv1.ListAuditEntriesRequest]) (*connect.Response[lessionv1.ListAuditEntriesResponse], error) {
+	entries, nextToken, err := h.service.ListAuditEntries(ctx, core.AuditListFilter{
+		EntityType: req.Msg.GetEntityType(),
+		EntityID:   req.Msg.GetEntityId(),
+		ActorID:    req.Msg.GetActorId(),
+		PageSize:   int(req.Msg.GetPageSize()),
+		PageToken:  req.Msg.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListAuditEntriesResponse{
+		Entries: lo.Map(entries, func(entry core.AuditEntry, _ int) *lessionv1.AuditEntry {
+			return toProtoAuditEntry(&entry)
+		}),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+func toProtoAuditEntry(entry *core.AuditEntry) *lessionv1.AuditEntry {
+	if entry == nil {
+		return nil
+	}
+	return &lessionv1.AuditEntry{
+		Id:         entry.ID.String(),
+		ActorId:    entry.ActorID,
+		Procedure:  entry.Procedure,
+		EntityType: entry.EntityType,
+		EntityId:   entry.EntityID,
+		Action:     toProtoAuditAction(entry.Action),
+		Changes: lo.Map(entry.Changes, func(change core.AuditChange, _ int) *lessionv1.AuditChange {
+			return &lessionv1.AuditChange{
+				Field:  change.Field,
+				Before: change.Before,
+				After:  change.After,
+			}
+		}),
+		CreatedAt: timestamppb.New(entry.CreatedAt),
+	}
+}
+
+func toProtoAuditAction(action core.AuditAction) lessionv1.AuditAction {
+	switch action {
+	case core.AuditActionCreate:
+		return lessionv1.AuditAction_AUDIT_ACTION_CREATE
+	case core.AuditActionUpdate:
+		return lessionv1.AuditAction_AUDIT_ACTION_UPDATE
+	case core.AuditActionDelete:
+		return lessionv1.AuditAction_AUDIT_ACTION_DELETE
+	default:
+		return lessionv1.AuditAction_AUDIT_ACTION_UNSPECIFIED
+	}
+}
@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// NewAuditInterceptor stores the procedure being served in the request
+// context, for unary and streaming handlers, so the audit log attributes the
+// changes it makes to the RPC.
+func NewAuditInterceptor() connect.Interceptor {
+	return auditInterceptor{}
+}
+
+type auditInterceptor struct{}
+
+func (auditInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return next(core.NewProcedureContext(ctx, req.Spec().Procedure), req)
+	}
+}
+
+func (auditInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (auditInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(core.NewProcedureContext(ctx, conn.Spec().Procedure), conn)
+	}
+}
@@ -0,0 +1,68 @@
+package transport
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// AuthoringHandler implements the generated Connect service for machine-assisted authoring.
+type AuthoringHandler struct {
+	service core.AuthoringService
+}
+
+// NewAuthoringHandler constructs a new authoring handler backed by the provided service.
+func NewAuthoringHandler(service core.AuthoringService) *AuthoringHandler {
+	return &AuthoringHandler{service: service}
+}
+
+var _ lessionv1connect.AuthoringServiceHandler = (*AuthoringHandler)(nil)
+
+// GenerateEpisodeDraft drafts lesson material for an editor to review.
+func (h *AuthoringHandler) GenerateEpisodeDraft(ctx context.Context, req *connect.Request[lessionv1.GenerateEpisodeDraftRequest]) (*connect.Response[lessionv1.GenerateEpisodeDraftResponse], error) {
+	draft, err := h.service.GenerateEpisodeDraft(ctx, core.EpisodeDraftRequest{
+		Topic:    req.Msg.GetTopic(),
+		Level:    req.Msg.GetLevel(),
+		Language: req.Msg.GetLanguage(),
+		Length:   time.Duration(req.Msg.GetLengthMinutes()) * time.Minute,
+		TenantID: callerTenant(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GenerateEpisodeDraftResponse{Draft: toProtoGeneratedEpisodeDraft(draft)}), nil
+}
+
+func toProtoGeneratedEpisodeDraft(draft *core.GeneratedEpisodeDraft) *lessionv1.GeneratedEpisodeDraft {
+	if draft == nil {
+		return nil
+	}
+	return &lessionv1.GeneratedEpisodeDraft{
+		Title:   draft.Title,
+		Summary: draft.Summary,
+		Script:  draft.Script,
+		Vocabulary: lo.Map(draft.Vocabulary, func(item core.DraftVocabularyItem, _ int) *lessionv1.DraftVocabularyItem {
+			return &lessionv1.DraftVocabularyItem{Term: item.Term, Definition: item.Definition, Example: item.Example}
+		}),
+		Questions: lo.Map(draft.Questions, func(question core.DraftQuizQuestion, _ int) *lessionv1.DraftQuizQuestion {
+			return &lessionv1.DraftQuizQuestion{
+				Prompt:      question.Prompt,
+				Choices:     question.Choices,
+				AnswerIndex: uint32(question.AnswerIndex),
+				Explanation: question.Explanation,
+			}
+		}),
+		Model: draft.Model,
+		Usage: &lessionv1.GenerationUsage{
+			PromptTokens:     uint32(draft.Usage.PromptTokens),
+			CompletionTokens: uint32(draft.Usage.CompletionTokens),
+		},
+	}
+}
@@ -0,0 +1,104 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// BillingHandler implements the generated Connect service for checkout and invoices.
+type BillingHandler struct {
+	service core.BillingService
+}
+
+// NewBillingHandler constructs a new billing handler backed by the provided service.
+func NewBillingHandler(service core.BillingService) *BillingHandler {
+	return &BillingHandler{service: service}
+}
+
+var _ lessionv1connect.BillingServiceHandler = (*BillingHandler)(nil)
+
+// CreateCheckoutSession starts a hosted checkout for a paid plan.
+func (h *BillingHandler) CreateCheckoutSession(ctx context.Context, req *connect.Request[lessionv1.CreateCheckoutSessionRequest]) (*connect.Response[lessionv1.CreateCheckoutSessionResponse], error) {
+	planID, err := uuid.Parse(req.Msg.GetPlanId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid plan_id %q", core.ErrValidation, req.Msg.GetPlanId())
+	}
+
+	session, err := h.service.CreateCheckoutSession(ctx, req.Msg.GetUserId(), planID, req.Msg.GetSuccessUrl(), req.Msg.GetCancelUrl())
+	if err != nil {
+		return nil, err
+	}
+
+	pb := &lessionv1.CheckoutSession{
+		Id:  session.ID,
+		Url: session.URL,
+	}
+	if !session.ExpiresAt.IsZero() {
+		pb.ExpiresAt = timestamppb.New(session.ExpiresAt)
+	}
+	return connect.NewResponse(&lessionv1.CreateCheckoutSessionResponse{Session: pb}), nil
+}
+
+// ListInvoices returns a learner's invoices, newest first.
+func (h *BillingHandler) ListInvoices(ctx context.Context, req *connect.Request[lessionv1.ListInvoicesRequest]) (*connect.Response[lessionv1.ListInvoicesResponse], error) {
+	invoices, err := h.service.ListInvoices(ctx, req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListInvoicesResponse{
+		Invoices: lo.Map(invoices, func(invoice core.Invoice, _ int) *lessionv1.Invoice {
+			return toProtoInvoice(&invoice)
+		}),
+	}), nil
+}
+
+func toProtoInvoice(invoice *core.Invoice) *lessionv1.Invoice {
+	if invoice == nil {
+		return nil
+	}
+	pb := &lessionv1.Invoice{
+		Id:              invoice.ID.String(),
+		SubscriptionId:  invoice.SubscriptionID.String(),
+		UserId:          invoice.UserID,
+		BillingProvider: invoice.BillingProvider,
+		ExternalId:      invoice.ExternalID,
+		AmountCents:     invoice.AmountCents,
+		Currency:        invoice.Currency,
+		Status:          toProtoInvoiceStatus(invoice.Status),
+		HostedUrl:       invoice.HostedURL,
+		CreatedAt:       timestamppb.New(invoice.CreatedAt),
+	}
+	if !invoice.PeriodStart.IsZero() {
+		pb.PeriodStart = timestamppb.New(invoice.PeriodStart)
+	}
+	if !invoice.PeriodEnd.IsZero() {
+		pb.PeriodEnd = timestamppb.New(invoice.PeriodEnd)
+	}
+	if invoice.PaidAt != nil {
+		pb.PaidAt = timestamppb.New(*invoice.PaidAt)
+	}
+	return pb
+}
+
+func toProtoInvoiceStatus(status core.InvoiceStatus) lessionv1.InvoiceStatus {
+	switch status {
+	case core.InvoiceStatusOpen:
+		return lessionv1.InvoiceStatus_INVOICE_STATUS_OPEN
+	case core.InvoiceStatusPaid:
+		return lessionv1.InvoiceStatus_INVOICE_STATUS_PAID
+	case core.InvoiceStatusVoid:
+		return lessionv1.InvoiceStatus_INVOICE_STATUS_VOID
+	default:
+		return lessionv1.InvoiceStatus_INVOICE_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,50 @@
+package transport
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// maxWebhookBytes bounds webhook payloads; provider events are far smaller.
+const maxWebhookBytes = 1 << 20
+
+// BillingWebhookHandler receives payment processor webhooks. Processors sign
+// the raw body, so these are plain HTTP endpoints rather than Connect RPCs.
+type BillingWebhookHandler struct {
+	service core.BillingService
+}
+
+// NewBillingWebhookHandler constructs a webhook handler backed by the billing service.
+func NewBillingWebhookHandler(service core.BillingService) *BillingWebhookHandler {
+	return &BillingWebhookHandler{service: service}
+}
+
+// Register mounts the webhook endpoint on mux.
+func (h *BillingWebhookHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("POST /webhooks/v1/billing/{provider}", h.receive)
+}
+
+// receive acknowledges events with 204. Other statuses make the processor
+// redeliver, which is what events arriving ahead of their checkout need.
+func (h *BillingWebhookHandler) receive(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBytes))
+	if err != nil {
+		http.Error(w, "payload too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	err = h.service.HandleWebhook(r.Context(), r.PathValue("provider"), payload, r.Header)
+	switch {
+	case err == nil:
+		w.WriteHeader(http.StatusNoContent)
+	case errors.Is(err, core.ErrValidation):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, core.ErrNotFound):
+		http.Error(w, "not found", http.StatusNotFound)
+	default:
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,217 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// BookingHandler implements the generated Connect service for one-to-one bookings.
+type BookingHandler struct {
+	service core.BookingService
+}
+
+// NewBookingHandler constructs a new booking handler backed by the provided service.
+func NewBookingHandler(service core.BookingService) *BookingHandler {
+	return &BookingHandler{service: service}
+}
+
+var _ lessionv1connect.BookingServiceHandler = (*BookingHandler)(nil)
+
+// AddAvailability offers slots for a teacher.
+func (h *BookingHandler) AddAvailability(ctx context.Context, req *connect.Request[lessionv1.AddAvailabilityRequest]) (*connect.Response[lessionv1.AddAvailabilityResponse], error) {
+	ranges := lo.Map(req.Msg.GetSlots(), func(r *lessionv1.TimeRange, _ int) core.TimeRange {
+		return core.TimeRange{
+			StartsAt: optionalTime(r.GetStartsAt()),
+			EndsAt:   optionalTime(r.GetEndsAt()),
+		}
+	})
+	slots, err := h.service.AddAvailability(ctx, req.Msg.GetTeacherId(), ranges)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.AddAvailabilityResponse{
+		Slots: toProtoAvailabilitySlots(slots),
+	}), nil
+}
+
+// RemoveAvailability withdraws a slot that is not booked.
+func (h *BookingHandler) RemoveAvailability(ctx context.Context, req *connect.Request[lessionv1.RemoveAvailabilityRequest]) (*connect.Response[lessionv1.RemoveAvailabilityResponse], error) {
+	slotID, err := parseBookingUUID("slot_id", req.Msg.GetSlotId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.RemoveAvailability(ctx, req.Msg.GetTeacherId(), slotID); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.RemoveAvailabilityResponse{}), nil
+}
+
+// ListAvailability returns slots in a time range, earliest first.
+func (h *BookingHandler) ListAvailability(ctx context.Context, req *connect.Request[lessionv1.ListAvailabilityRequest]) (*connect.Response[lessionv1.ListAvailabilityResponse], error) {
+	slots, err := h.service.ListAvailability(ctx, core.AvailabilityFilter{
+		TeacherID:     req.Msg.GetTeacherId(),
+		From:          optionalTime(req.Msg.GetFrom()),
+		To:            optionalTime(req.Msg.GetTo()),
+		IncludeBooked: req.Msg.GetIncludeBooked(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListAvailabilityResponse{
+		Slots: toProtoAvailabilitySlots(slots),
+	}), nil
+}
+
+// BookSlot reserves a slot for a learner.
+func (h *BookingHandler) BookSlot(ctx context.Context, req *connect.Request[lessionv1.BookSlotRequest]) (*connect.Response[lessionv1.BookSlotResponse], error) {
+	slotID, err := parseBookingUUID("slot_id", req.Msg.GetSlotId())
+	if err != nil {
+		return nil, err
+	}
+
+	booking, err := h.service.BookSlot(ctx, slotID, req.Msg.GetLearnerId(), req.Msg.GetNote())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.BookSlotResponse{
+		Booking: toProtoBooking(booking),
+	}), nil
+}
+
+// GetBooking returns a single booking by identifier.
+func (h *BookingHandler) GetBooking(ctx context.Context, req *connect.Request[lessionv1.GetBookingRequest]) (*connect.Response[lessionv1.GetBookingResponse], error) {
+	id, err := parseBookingUUID("booking_id", req.Msg.GetBookingId())
+	if err != nil {
+		return nil, err
+	}
+
+	booking, err := h.service.GetBooking(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetBookingResponse{
+		Booking: toProtoBooking(booking),
+	}), nil
+}
+
+// CancelBooking cancels a booking and offers its slot again.
+func (h *BookingHandler) CancelBooking(ctx context.Context, req *connect.Request[lessionv1.CancelBookingRequest]) (*connect.Response[lessionv1.CancelBookingResponse], error) {
+	id, err := parseBookingUUID("booking_id", req.Msg.GetBookingId())
+	if err != nil {
+		return nil, err
+	}
+
+	booking, err := h.service.CancelBooking(ctx, id, req.Msg.GetUserId(), req.Msg.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.CancelBookingResponse{
+		Booking: toProtoBooking(booking),
+	}), nil
+}
+
+// ListBookings returns bookings, earliest first.
+func (h *BookingHandler) ListBookings(ctx context.Context, req *connect.Request[lessionv1.ListBookingsRequest]) (*connect.Response[lessionv1.ListBookingsResponse], error) {
+	bookings, nextToken, err := h.service.ListBookings(ctx, core.BookingListFilter{
+		PageSize:  int(req.Msg.GetPageSize()),
+		PageToken: req.Msg.GetPageToken(),
+		TeacherID: req.Msg.GetTeacherId(),
+		LearnerID: req.Msg.GetLearnerId(),
+		From:      optionalTime(req.Msg.GetFrom()),
+		To:        optionalTime(req.Msg.GetTo()),
+		Status:    fromProtoBookingStatus(req.Msg.GetStatus()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListBookingsResponse{
+		Bookings: lo.Map(bookings, func(booking core.Booking, _ int) *lessionv1.Booking {
+			return toProtoBooking(&booking)
+		}),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+func parseBookingUUID(field, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: invalid %s %q", core.ErrValidation, field, value)
+	}
+	return id, nil
+}
+
+func toProtoAvailabilitySlots(slots []core.AvailabilitySlot) []*lessionv1.AvailabilitySlot {
+	return lo.Map(slots, func(slot core.AvailabilitySlot, _ int) *lessionv1.AvailabilitySlot {
+		return &lessionv1.AvailabilitySlot{
+			Id:        slot.ID.String(),
+			TeacherId: slot.TeacherID,
+			StartsAt:  timestamppb.New(slot.StartsAt),
+			EndsAt:    timestamppb.New(slot.EndsAt),
+			Booked:    slot.Booked,
+			CreatedAt: timestamppb.New(slot.CreatedAt),
+		}
+	})
+}
+
+func toProtoBooking(booking *core.Booking) *lessionv1.Booking {
+	if booking == nil {
+		return nil
+	}
+	pb := &lessionv1.Booking{
+		Id:           booking.ID.String(),
+		SlotId:       booking.SlotID.String(),
+		TeacherId:    booking.TeacherID,
+		LearnerId:    booking.LearnerID,
+		StartsAt:     timestamppb.New(booking.StartsAt),
+		EndsAt:       timestamppb.New(booking.EndsAt),
+		Note:         booking.Note,
+		Status:       toProtoBookingStatus(booking.Status),
+		CancelledBy:  booking.CancelledBy,
+		CancelReason: booking.CancelReason,
+		CreatedAt:    timestamppb.New(booking.CreatedAt),
+		UpdatedAt:    timestamppb.New(booking.UpdatedAt),
+	}
+	if booking.CancelledAt != nil {
+		pb.CancelledAt = timestamppb.New(*booking.CancelledAt)
+	}
+	return pb
+}
+
+func toProtoBookingStatus(status core.BookingStatus) lessionv1.BookingStatus {
+	switch status {
+	case core.BookingStatusConfirmed:
+		return lessionv1.BookingStatus_BOOKING_STATUS_CONFIRMED
+	case core.BookingStatusCancelled:
+		return lessionv1.BookingStatus_BOOKING_STATUS_CANCELLED
+	default:
+		return lessionv1.BookingStatus_BOOKING_STATUS_UNSPECIFIED
+	}
+}
+
+func fromProtoBookingStatus(status lessionv1.BookingStatus) core.BookingStatus {
+	switch status {
+	case lessionv1.BookingStatus_BOOKING_STATUS_CONFIRMED:
+		return core.BookingStatusConfirmed
+	case lessionv1.BookingStatus_BOOKING_STATUS_CANCELLED:
+		return core.BookingStatusCancelled
+	default:
+		return core.BookingStatusUnspecified
+	}
+}
@@ -0,0 +1,224 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+const (
+	catalogCacheMaxAge               = 5 * time.Minute
+	catalogCacheStaleWhileRevalidate = time.Hour
+)
+
+// catalogCacheControl lets browsers and CDNs share catalog responses, which
+// never depend on the caller, and keep serving them while revalidating.
+var catalogCacheControl = "public, max-age=" + strconv.Itoa(int(catalogCacheMaxAge/time.Second)) +
+	", stale-while-revalidate=" + strconv.Itoa(int(catalogCacheStaleWhileRevalidate/time.Second))
+
+// CatalogHandler implements the generated Connect service for the public catalog.
+type CatalogHandler struct {
+	service  core.CatalogService
+	previews core.LinkPreviewService
+}
+
+// NewCatalogHandler constructs a catalog handler backed by the provided services.
+func NewCatalogHandler(service core.CatalogService, previews core.LinkPreviewService) *CatalogHandler {
+	return &CatalogHandler{service: service, previews: previews}
+}
+
+var _ lessionv1connect.CatalogServiceHandler = (*CatalogHandler)(nil)
+
+// ListCatalogSeries returns a page of published series.
+func (h *CatalogHandler) ListCatalogSeries(ctx context.Context, req *connect.Request[lessionv1.ListCatalogSeriesRequest]) (*connect.Response[lessionv1.ListCatalogSeriesResponse], error) {
+	filter := core.CatalogListFilter{
+		PageSize:           int(req.Msg.GetPageSize()),
+		PageToken:          req.Msg.GetPageToken(),
+		Language:           req.Msg.GetLanguage(),
+		Level:              req.Msg.GetLevel(),
+		EstimatedLevel:     req.Msg.GetEstimatedLevel(),
+		Tags:               req.Msg.GetTags(),
+		Query:              req.Msg.GetQuery(),
+		IncludeEpisodes:    req.Msg.GetIncludeEpisodes(),
+		IncludeTranscripts: req.Msg.GetIncludeTranscripts(),
+	}
+	series, nextToken, err := h.service.ListSeries(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return cacheableCatalogResponse(&lessionv1.ListCatalogSeriesResponse{
+		Series: lo.Map(series, func(item core.Series, _ int) *lessionv1.CatalogSeries {
+			res := toProtoCatalogSeries(item)
+			if !filter.IncludeTranscripts {
+				for _, episode := range res.Episodes {
+					episode.Transcript = nil
+				}
+			}
+			return res
+		}),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+// GetCatalogSeries returns a published series.
+func (h *CatalogHandler) GetCatalogSeries(ctx context.Context, req *connect.Request[lessionv1.GetCatalogSeriesRequest]) (*connect.Response[lessionv1.GetCatalogSeriesResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetSeriesId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid series_id %q", core.ErrValidation, req.Msg.GetSeriesId())
+	}
+
+	series, err := h.service.GetSeries(ctx, id, req.Msg.GetIncludeEpisodes())
+	if err != nil {
+		return nil, err
+	}
+
+	return cacheableCatalogResponse(&lessionv1.GetCatalogSeriesResponse{
+		Series: toProtoCatalogSeries(*series),
+	}), nil
+}
+
+// GetCatalogEpisode returns a published episode of a published series.
+func (h *CatalogHandler) GetCatalogEpisode(ctx context.Context, req *connect.Request[lessionv1.GetCatalogEpisodeRequest]) (*connect.Response[lessionv1.GetCatalogEpisodeResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetEpisodeId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+	}
+
+	episode, err := h.service.GetEpisode(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return cacheableCatalogResponse(&lessionv1.GetCatalogEpisodeResponse{
+		Episode: toProtoCatalogEpisode(*episode),
+	}), nil
+}
+
+// GetCatalogPreview returns the link preview of a published series or episode.
+func (h *CatalogHandler) GetCatalogPreview(ctx context.Context, req *connect.Request[lessionv1.GetCatalogPreviewRequest]) (*connect.Response[lessionv1.GetCatalogPreviewResponse], error) {
+	var (
+		preview *core.LinkPreview
+		err     error
+	)
+	switch target := req.Msg.GetTarget().(type) {
+	case *lessionv1.GetCatalogPreviewRequest_Url:
+		preview, err = h.previews.PreviewURL(ctx, target.Url)
+	case *lessionv1.GetCatalogPreviewRequest_SeriesId:
+		id, parseErr := uuid.Parse(target.SeriesId)
+		if parseErr != nil {
+			return nil, fmt.Errorf("%w: invalid series_id %q", core.ErrValidation, target.SeriesId)
+		}
+		preview, err = h.previews.PreviewSeries(ctx, id)
+	case *lessionv1.GetCatalogPreviewRequest_EpisodeId:
+		id, parseErr := uuid.Parse(target.EpisodeId)
+		if parseErr != nil {
+			return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, target.EpisodeId)
+		}
+		preview, err = h.previews.PreviewEpisode(ctx, id)
+	default:
+		return nil, fmt.Errorf("%w: url, series_id or episode_id required", core.ErrValidation)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return cacheableCatalogResponse(&lessionv1.GetCatalogPreviewResponse{
+		Preview: toProtoCatalogPreview(*preview),
+	}), nil
+}
+
+func cacheableCatalogResponse[T any](msg *T) *connect.Response[T] {
+	res := connect.NewResponse(msg)
+	res.Header().Set("Cache-Control", catalogCacheControl)
+	return res
+}
+
+// toProtoCatalogSeries maps a series already stripped by the catalog service.
+// The catalog messages have no fields for author or asset identifiers.
+func toProtoCatalogSeries(series core.Series) *lessionv1.CatalogSeries {
+	res := &lessionv1.CatalogSeries{
+		Id:             series.ID.String(),
+		Slug:           series.Slug,
+		Title:          series.Title,
+		Summary:        series.Summary,
+		Language:       series.Language,
+		Level:          series.Level,
+		EstimatedLevel: series.EstimatedLevel,
+		Tags:           series.Tags,
+		CoverUrl:       series.CoverURL,
+		AccentColor:    series.Branding.AccentColor,
+		HeroImageUrl:   series.Branding.HeroImageURL,
+		Layout:         toProtoSeriesLayout(series.Branding.Layout),
+		EpisodeCount:   uint32(series.EpisodeCount),
+		Episodes: lo.Map(series.Episodes, func(episode core.Episode, _ int) *lessionv1.CatalogEpisode {
+			return toProtoCatalogEpisode(episode)
+		}),
+	}
+	if series.PublishedAt != nil {
+		res.PublishedAt = timestamppb.New(*series.PublishedAt)
+	}
+	return res
+}
+
+func toProtoCatalogEpisode(episode core.Episode) *lessionv1.CatalogEpisode {
+	res := &lessionv1.CatalogEpisode{
+		Id:          episode.ID.String(),
+		SeriesId:    episode.SeriesID.String(),
+		Seq:         episode.Seq,
+		Title:       episode.Title,
+		Description: episode.Description,
+		Preview:     episode.Preview,
+		MediaType:   seriesToProtoMediaType(episode.Resource.Type),
+		MimeType:    episode.Resource.MimeType,
+		PlaybackUrl: episode.Resource.PlaybackURL,
+		Transcript: &lessionv1.Transcript{
+			Language: episode.Transcript.Language,
+			Format:   toProtoTranscriptFormat(episode.Transcript.Format),
+			Content:  episode.Transcript.Content,
+		},
+		EstimatedLevel: episode.EstimatedLevel,
+	}
+	if episode.Duration > 0 {
+		res.Duration = durationpb.New(episode.Duration)
+	}
+	if episode.PublishedAt != nil {
+		res.PublishedAt = timestamppb.New(*episode.PublishedAt)
+	}
+	return res
+}
+
+func toProtoCatalogPreview(preview core.LinkPreview) *lessionv1.CatalogPreview {
+	res := &lessionv1.CatalogPreview{
+		Url:         preview.URL,
+		SeriesId:    preview.SeriesID.String(),
+		Title:       preview.Title,
+		Description: preview.Description,
+		ImageUrl:    preview.ImageURL,
+		ImageWidth:  uint32(preview.ImageWidth),
+		ImageHeight: uint32(preview.ImageHeight),
+		MediaType:   seriesToProtoMediaType(preview.MediaType),
+		EmbedUrl:    preview.EmbedURL,
+		EmbedHtml:   playerEmbedHTML(preview, preview.EmbedWidth, preview.EmbedHeight),
+		EmbedWidth:  uint32(preview.EmbedWidth),
+		EmbedHeight: uint32(preview.EmbedHeight),
+	}
+	if preview.EpisodeID != uuid.Nil {
+		res.EpisodeId = preview.EpisodeID.String()
+	}
+	if preview.Duration > 0 {
+		res.Duration = durationpb.New(preview.Duration)
+	}
+	return res
+}
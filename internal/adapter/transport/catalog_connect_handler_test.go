@@ -0,0 +1,67 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+type stubCatalogService struct {
+	core.CatalogService
+	series core.Series
+}
+
+func (s stubCatalogService) GetSeries(ctx context.Context, id uuid.UUID, includeEpisodes bool) (*core.Series, error) {
+	if id != s.series.ID {
+		return nil, core.ErrNotFound
+	}
+	return &s.series, nil
+}
+
+func TestCatalogHandler_GetCatalogSeriesOverGET(t *testing.T) {
+	series := core.Series{
+		ID:       uuid.New(),
+		Title:    "Coffee",
+		Branding: core.SeriesBranding{AccentColor: "#ff8800", Layout: core.SeriesLayoutGrid},
+		Episodes: []core.Episode{{ID: uuid.New(), Seq: 1, Preview: true, Resource: core.MediaResource{PlaybackURL: "https://media.example.com/1.m3u8"}}},
+	}
+	mux := http.NewServeMux()
+	mux.Handle(lessionv1connect.NewCatalogServiceHandler(
+		NewCatalogHandler(stubCatalogService{series: series}, nil),
+		connect.WithInterceptors(NewErrorInterceptor()),
+	))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client := lessionv1connect.NewCatalogServiceClient(server.Client(), server.URL, connect.WithHTTPGet())
+
+	res, err := client.GetCatalogSeries(context.Background(), connect.NewRequest(&lessionv1.GetCatalogSeriesRequest{
+		SeriesId:        series.ID.String(),
+		IncludeEpisodes: true,
+	}))
+	if err != nil {
+		t.Fatalf("GetCatalogSeries() error = %v", err)
+	}
+	if got := res.Header().Get("Cache-Control"); got != catalogCacheControl {
+		t.Fatalf("expected Cache-Control %q, got %q", catalogCacheControl, got)
+	}
+	got := res.Msg.GetSeries()
+	if got.GetTitle() != "Coffee" || got.GetAccentColor() != "#ff8800" || got.GetLayout() != lessionv1.SeriesLayout_SERIES_LAYOUT_GRID {
+		t.Fatalf("unexpected series %v", got)
+	}
+	if len(got.GetEpisodes()) != 1 || got.GetEpisodes()[0].GetPlaybackUrl() != "https://media.example.com/1.m3u8" {
+		t.Fatalf("unexpected episodes %v", got.GetEpisodes())
+	}
+
+	_, err = client.GetCatalogSeries(context.Background(), connect.NewRequest(&lessionv1.GetCatalogSeriesRequest{SeriesId: uuid.NewString()}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package transport
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+)
+
+// NewCDNInterceptor rewrites the media URLs of every response to their CDN
+// URLs. Origin URLs stay in the database so the CDN can be switched or
+// dropped without migrating data.
+func NewCDNInterceptor(cdn core.CDNService) connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			res, err := next(ctx, req)
+			if err != nil {
+				return res, err
+			}
+			if msg, ok := res.Any().(proto.Message); ok {
+				rewriteMediaURLs(msg.ProtoReflect(), cdn.RewriteURL)
+			}
+			return res, nil
+		}
+	})
+}
+
+// rewriteMediaURLs walks msg and rewrites the URLs of media resources,
+// assets, image variants, series covers and hero images, catalog entries and
+// dictation items.
+func rewriteMediaURLs(msg protoreflect.Message, rewrite func(string) string) {
+	if !msg.IsValid() {
+		return
+	}
+	switch m := msg.Interface().(type) {
+	case *lessionv1.MediaResource:
+		m.PlaybackUrl = rewrite(m.PlaybackUrl)
+		return
+	case *lessionv1.Asset:
+		m.PlaybackUrl = rewrite(m.PlaybackUrl)
+		m.HlsManifestUrl = rewrite(m.HlsManifestUrl)
+	case *lessionv1.ImageVariant:
+		m.Url = rewrite(m.Url)
+		return
+	case *lessionv1.Series:
+		m.CoverUrl = rewrite(m.CoverUrl)
+	case *lessionv1.SeriesBranding:
+		m.HeroImageUrl = rewrite(m.HeroImageUrl)
+		return
+	case *lessionv1.CatalogSeries:
+		m.CoverUrl = rewrite(m.CoverUrl)
+		m.HeroImageUrl = rewrite(m.HeroImageUrl)
+	case *lessionv1.CatalogEpisode:
+		m.PlaybackUrl = rewrite(m.PlaybackUrl)
+		return
+	case *lessionv1.DictationItem:
+		m.AudioUrl = rewrite(m.AudioUrl)
+	}
+
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind {
+			return true
+		}
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					rewriteMediaURLs(v.Message(), rewrite)
+					return true
+				})
+			}
+		case fd.IsList():
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				rewriteMediaURLs(list.Get(i).Message(), rewrite)
+			}
+		default:
+			rewriteMediaURLs(value.Message(), rewrite)
+		}
+		return true
+	})
+}
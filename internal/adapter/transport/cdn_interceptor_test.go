@@ -0,0 +1,39 @@
+package transport
+
+import (
+	"strings"
+	"testing"
+
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+)
+
+func TestRewriteMediaURLs(t *testing.T) {
+	rewrite := func(raw string) string {
+		return strings.Replace(raw, "https://media.example.com", "https://cdn.example.com", 1)
+	}
+	res := &lessionv1.GetSeriesResponse{Series: &lessionv1.Series{
+		Episodes: []*lessionv1.Episode{
+			{Resource: &lessionv1.MediaResource{PlaybackUrl: "https://media.example.com/a.mp3"}},
+			{Resource: &lessionv1.MediaResource{PlaybackUrl: "https://other.example.com/b.mp3"}},
+		},
+	}}
+	rewriteMediaURLs(res.ProtoReflect(), rewrite)
+	episodes := res.GetSeries().GetEpisodes()
+	if got := episodes[0].GetResource().GetPlaybackUrl(); got != "https://cdn.example.com/a.mp3" {
+		t.Fatalf("unexpected playback url %q", got)
+	}
+	if got := episodes[1].GetResource().GetPlaybackUrl(); got != "https://other.example.com/b.mp3" {
+		t.Fatalf("expected other origins to be left alone, got %q", got)
+	}
+
+	asset := &lessionv1.Asset{
+		PlaybackUrl:    "https://media.example.com/a.mp3",
+		HlsManifestUrl: "https://media.example.com/hls/a/master.m3u8",
+		ImageVariants:  []*lessionv1.ImageVariant{{Name: "card", Url: "https://media.example.com/images/a/card.jpg"}},
+	}
+	rewriteMediaURLs(asset.ProtoReflect(), rewrite)
+	if asset.GetPlaybackUrl() != "https://cdn.example.com/a.mp3" || asset.GetHlsManifestUrl() != "https://cdn.example.com/hls/a/master.m3u8" ||
+		asset.GetImageVariants()[0].GetUrl() != "https://cdn.example.com/images/a/card.jpg" {
+		t.Fatalf("unexpected asset urls %#v", asset)
+	}
+}
@@ -0,0 +1,315 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// ClassroomHandler implements the generated Connect service for classrooms.
+type ClassroomHandler struct {
+	service core.ClassroomService
+}
+
+// NewClassroomHandler constructs a new classroom handler backed by the provided service.
+func NewClassroomHandler(service core.ClassroomService) *ClassroomHandler {
+	return &ClassroomHandler{service: service}
+}
+
+var _ lessionv1connect.ClassroomServiceHandler = (*ClassroomHandler)(nil)
+
+// CreateClassroom stores a new classroom.
+func (h *ClassroomHandler) CreateClassroom(ctx context.Context, req *connect.Request[lessionv1.CreateClassroomRequest]) (*connect.Response[lessionv1.CreateClassroomResponse], error) {
+	draft := req.Msg.GetClassroom()
+	classroom, err := h.service.CreateClassroom(ctx, req.Msg.GetTeacherId(), core.ClassroomDraft{
+		Name:        draft.GetName(),
+		Description: draft.GetDescription(),
+		LearnerIDs:  draft.GetLearnerIds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.CreateClassroomResponse{
+		Classroom: toProtoClassroom(classroom),
+	}), nil
+}
+
+// GetClassroom returns a single classroom by identifier.
+func (h *ClassroomHandler) GetClassroom(ctx context.Context, req *connect.Request[lessionv1.GetClassroomRequest]) (*connect.Response[lessionv1.GetClassroomResponse], error) {
+	id, err := parseClassroomID(req.Msg.GetClassroomId())
+	if err != nil {
+		return nil, err
+	}
+
+	classroom, err := h.service.GetClassroom(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetClassroomResponse{
+		Classroom: toProtoClassroom(classroom),
+	}), nil
+}
+
+// ListClassrooms returns classrooms, most recently updated first.
+func (h *ClassroomHandler) ListClassrooms(ctx context.Context, req *connect.Request[lessionv1.ListClassroomsRequest]) (*connect.Response[lessionv1.ListClassroomsResponse], error) {
+	classrooms, nextToken, err := h.service.ListClassrooms(ctx, core.ClassroomListFilter{
+		PageSize:  int(req.Msg.GetPageSize()),
+		PageToken: req.Msg.GetPageToken(),
+		TeacherID: req.Msg.GetTeacherId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListClassroomsResponse{
+		Classrooms: lo.Map(classrooms, func(classroom core.Classroom, _ int) *lessionv1.Classroom {
+			return toProtoClassroom(&classroom)
+		}),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+// UpdateClassroom applies partial updates to classroom metadata.
+func (h *ClassroomHandler) UpdateClassroom(ctx context.Context, req *connect.Request[lessionv1.UpdateClassroomRequest]) (*connect.Response[lessionv1.UpdateClassroomResponse], error) {
+	id, err := parseClassroomID(req.Msg.GetClassroomId())
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := h.service.GetClassroom(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	mask := req.Msg.GetUpdateMask()
+	if isFieldMaskEmpty(mask) {
+		mask = &fieldmaskpb.FieldMask{
+			Paths: []string{"name", "description"},
+		}
+	}
+
+	if err := applyClassroomFieldMask(existing, req.Msg.GetClassroom(), mask); err != nil {
+		return nil, err
+	}
+
+	updated, err := h.service.UpdateClassroom(ctx, *existing)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.UpdateClassroomResponse{
+		Classroom: toProtoClassroom(updated),
+	}), nil
+}
+
+// DeleteClassroom removes a classroom with its enrolments and assignments.
+func (h *ClassroomHandler) DeleteClassroom(ctx context.Context, req *connect.Request[lessionv1.DeleteClassroomRequest]) (*connect.Response[lessionv1.DeleteClassroomResponse], error) {
+	id, err := parseClassroomID(req.Msg.GetClassroomId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.DeleteClassroom(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.DeleteClassroomResponse{}), nil
+}
+
+// AddClassroomLearners enrols learners in a classroom.
+func (h *ClassroomHandler) AddClassroomLearners(ctx context.Context, req *connect.Request[lessionv1.AddClassroomLearnersRequest]) (*connect.Response[lessionv1.AddClassroomLearnersResponse], error) {
+	id, err := parseClassroomID(req.Msg.GetClassroomId())
+	if err != nil {
+		return nil, err
+	}
+
+	classroom, err := h.service.AddClassroomLearners(ctx, id, req.Msg.GetLearnerIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.AddClassroomLearnersResponse{
+		Classroom: toProtoClassroom(classroom),
+	}), nil
+}
+
+// RemoveClassroomLearners withdraws learners from a classroom.
+func (h *ClassroomHandler) RemoveClassroomLearners(ctx context.Context, req *connect.Request[lessionv1.RemoveClassroomLearnersRequest]) (*connect.Response[lessionv1.RemoveClassroomLearnersResponse], error) {
+	id, err := parseClassroomID(req.Msg.GetClassroomId())
+	if err != nil {
+		return nil, err
+	}
+
+	classroom, err := h.service.RemoveClassroomLearners(ctx, id, req.Msg.GetLearnerIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.RemoveClassroomLearnersResponse{
+		Classroom: toProtoClassroom(classroom),
+	}), nil
+}
+
+// AssignSeries asks a classroom's learners to finish a published series by a due date.
+func (h *ClassroomHandler) AssignSeries(ctx context.Context, req *connect.Request[lessionv1.AssignSeriesRequest]) (*connect.Response[lessionv1.AssignSeriesResponse], error) {
+	classroomID, err := parseClassroomID(req.Msg.GetClassroomId())
+	if err != nil {
+		return nil, err
+	}
+	seriesID, err := uuid.Parse(req.Msg.GetSeriesId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid series_id %q", core.ErrValidation, req.Msg.GetSeriesId())
+	}
+
+	assignment, err := h.service.AssignSeries(ctx, classroomID, seriesID, req.Msg.GetDueAt().AsTime())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.AssignSeriesResponse{
+		Assignment: toProtoClassroomAssignment(assignment),
+	}), nil
+}
+
+// RemoveAssignment withdraws an assignment from a classroom.
+func (h *ClassroomHandler) RemoveAssignment(ctx context.Context, req *connect.Request[lessionv1.RemoveAssignmentRequest]) (*connect.Response[lessionv1.RemoveAssignmentResponse], error) {
+	classroomID, err := parseClassroomID(req.Msg.GetClassroomId())
+	if err != nil {
+		return nil, err
+	}
+	assignmentID, err := uuid.Parse(req.Msg.GetAssignmentId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid assignment_id %q", core.ErrValidation, req.Msg.GetAssignmentId())
+	}
+
+	if err := h.service.RemoveAssignment(ctx, classroomID, assignmentID); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.RemoveAssignmentResponse{}), nil
+}
+
+// GetClassroomReport returns every learner's progress on every assignment.
+func (h *ClassroomHandler) GetClassroomReport(ctx context.Context, req *connect.Request[lessionv1.GetClassroomReportRequest]) (*connect.Response[lessionv1.GetClassroomReportResponse], error) {
+	id, err := parseClassroomID(req.Msg.GetClassroomId())
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := h.service.GetClassroomReport(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetClassroomReportResponse{
+		Classroom: toProtoClassroom(&report.Classroom),
+		Students: lo.Map(report.Students, func(student core.StudentProgress, _ int) *lessionv1.StudentProgress {
+			return toProtoStudentProgress(student)
+		}),
+		GeneratedAt: timestamppb.New(report.GeneratedAt),
+	}), nil
+}
+
+func parseClassroomID(value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: invalid classroom_id %q", core.ErrValidation, value)
+	}
+	return id, nil
+}
+
+func applyClassroomFieldMask(target *core.Classroom, patch *lessionv1.ClassroomDraft, mask *fieldmaskpb.FieldMask) error {
+	for _, path := range mask.Paths {
+		switch strings.ToLower(path) {
+		case "name":
+			target.Name = patch.GetName()
+		case "description":
+			target.Description = patch.GetDescription()
+		default:
+			return fmt.Errorf("%w: unsupported update path %q", core.ErrValidation, path)
+		}
+	}
+	return nil
+}
+
+func toProtoClassroom(classroom *core.Classroom) *lessionv1.Classroom {
+	if classroom == nil {
+		return nil
+	}
+	return &lessionv1.Classroom{
+		Id:          classroom.ID.String(),
+		TeacherId:   classroom.TeacherID,
+		Name:        classroom.Name,
+		Description: classroom.Description,
+		LearnerIds:  classroom.LearnerIDs,
+		Assignments: lo.Map(classroom.Assignments, func(assignment core.ClassroomAssignment, _ int) *lessionv1.ClassroomAssignment {
+			return toProtoClassroomAssignment(&assignment)
+		}),
+		CreatedAt: timestamppb.New(classroom.CreatedAt),
+		UpdatedAt: timestamppb.New(classroom.UpdatedAt),
+	}
+}
+
+func toProtoClassroomAssignment(assignment *core.ClassroomAssignment) *lessionv1.ClassroomAssignment {
+	if assignment == nil {
+		return nil
+	}
+	return &lessionv1.ClassroomAssignment{
+		Id:          assignment.ID.String(),
+		ClassroomId: assignment.ClassroomID.String(),
+		SeriesId:    assignment.SeriesID.String(),
+		DueAt:       timestamppb.New(assignment.DueAt),
+		CreatedAt:   timestamppb.New(assignment.CreatedAt),
+	}
+}
+
+func toProtoStudentProgress(student core.StudentProgress) *lessionv1.StudentProgress {
+	return &lessionv1.StudentProgress{
+		UserId:               student.UserID,
+		AssignmentsCompleted: int32(student.AssignmentsCompleted),
+		Assignments: lo.Map(student.Assignments, func(progress core.AssignmentProgress, _ int) *lessionv1.AssignmentProgress {
+			pb := &lessionv1.AssignmentProgress{
+				AssignmentId:      progress.AssignmentID.String(),
+				SeriesId:          progress.SeriesID.String(),
+				SeriesTitle:       progress.SeriesTitle,
+				DueAt:             timestamppb.New(progress.DueAt),
+				EpisodesCompleted: int32(progress.EpisodesCompleted),
+				EpisodeCount:      int32(progress.EpisodeCount),
+				Status:            toProtoAssignmentStatus(progress.Status),
+			}
+			if progress.CompletedAt != nil {
+				pb.CompletedAt = timestamppb.New(*progress.CompletedAt)
+			}
+			return pb
+		}),
+	}
+}
+
+func toProtoAssignmentStatus(status core.AssignmentStatus) lessionv1.AssignmentStatus {
+	switch status {
+	case core.AssignmentStatusNotStarted:
+		return lessionv1.AssignmentStatus_ASSIGNMENT_STATUS_NOT_STARTED
+	case core.AssignmentStatusInProgress:
+		return lessionv1.AssignmentStatus_ASSIGNMENT_STATUS_IN_PROGRESS
+	case core.AssignmentStatusCompleted:
+		return lessionv1.AssignmentStatus_ASSIGNMENT_STATUS_COMPLETED
+	case core.AssignmentStatusCompletedLate:
+		return lessionv1.AssignmentStatus_ASSIGNMENT_STATUS_COMPLETED_LATE
+	case core.AssignmentStatusOverdue:
+		return lessionv1.AssignmentStatus_ASSIGNMENT_STATUS_OVERDUE
+	default:
+		return lessionv1.AssignmentStatus_ASSIGNMENT_STATUS_UNSPECIFIED
+	}
+}
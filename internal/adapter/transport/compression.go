@@ -0,0 +1,69 @@
+package transport
+
+import (
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// CompressionGzip is supported by every Connect, gRPC and gRPC-Web client.
+	CompressionGzip = "gzip"
+	// CompressionZstd compresses better and faster than gzip for clients
+	// that support it.
+	CompressionZstd = "zstd"
+)
+
+// CompressionOptions configures message compression for Connect handlers.
+type CompressionOptions struct {
+	// Algorithms lists the compression algorithms clients may use; messages
+	// are sent uncompressed when empty.
+	Algorithms []string
+	// MinBytes is the smallest response message that is compressed, since
+	// compressing small messages costs more than it saves.
+	MinBytes int
+}
+
+// HandlerOption registers the configured algorithms with a Connect handler.
+func (o CompressionOptions) HandlerOption() (connect.HandlerOption, error) {
+	options := []connect.HandlerOption{connect.WithCompressMinBytes(o.MinBytes)}
+	for _, algorithm := range o.Algorithms {
+		switch algorithm {
+		case CompressionGzip:
+			// Registered by Connect by default.
+		case CompressionZstd:
+			options = append(options, connect.WithCompression(CompressionZstd, newZstdDecompressor, newZstdCompressor))
+		default:
+			return nil, fmt.Errorf("unsupported compression algorithm %q", algorithm)
+		}
+	}
+	if !slices.Contains(o.Algorithms, CompressionGzip) {
+		options = append(options, connect.WithCompression(CompressionGzip, nil, nil))
+	}
+	return connect.WithHandlerOptions(options...), nil
+}
+
+// zstdDecompressor keeps the decoder usable after Close: Connect closes
+// decompressors before returning them to its pool, while a closed zstd
+// decoder cannot be reset.
+type zstdDecompressor struct {
+	*zstd.Decoder
+}
+
+func (d zstdDecompressor) Close() error {
+	return nil
+}
+
+func newZstdDecompressor() connect.Decompressor {
+	// Synchronous decoding avoids a goroutine per pooled decoder. Creating
+	// the decoder only fails for invalid options.
+	decoder, _ := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+	return zstdDecompressor{Decoder: decoder}
+}
+
+func newZstdCompressor() connect.Compressor {
+	encoder, _ := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	return encoder
+}
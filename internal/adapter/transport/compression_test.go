@@ -0,0 +1,69 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+func TestCompressionOptions_GRPCWebAndConnect(t *testing.T) {
+	compression, err := CompressionOptions{Algorithms: []string{CompressionGzip, CompressionZstd}, MinBytes: 256}.HandlerOption()
+	if err != nil {
+		t.Fatalf("HandlerOption() error = %v", err)
+	}
+
+	episodes := make([]*lessionv1.Episode, 50)
+	for i := range episodes {
+		episodes[i] = &lessionv1.Episode{Id: uuid.NewString(), Title: strings.Repeat("listening practice ", 4)}
+	}
+	mux := http.NewServeMux()
+	mux.Handle(lessionv1connect.NewSeriesServiceHandler(stubEpisodeSeriesHandler{episodes: episodes}, compression))
+
+	var encoding string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.ServeHTTP(w, r)
+		encoding = w.Header().Get("Grpc-Encoding") + w.Header().Get("Content-Encoding")
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		opts      []connect.ClientOption
+		algorithm string
+	}{
+		{name: "grpc-web zstd", opts: []connect.ClientOption{
+			connect.WithGRPCWeb(),
+			connect.WithAcceptCompression(CompressionZstd, newZstdDecompressor, newZstdCompressor),
+			connect.WithSendCompression(CompressionZstd),
+		}, algorithm: CompressionZstd},
+		{name: "grpc-web gzip", opts: []connect.ClientOption{connect.WithGRPCWeb(), connect.WithSendGzip()}, algorithm: CompressionGzip},
+		{name: "connect gzip", opts: []connect.ClientOption{connect.WithSendGzip()}, algorithm: CompressionGzip},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := lessionv1connect.NewSeriesServiceClient(server.Client(), server.URL, tt.opts...)
+			res, err := client.GetSeries(context.Background(), connect.NewRequest(&lessionv1.GetSeriesRequest{SeriesId: uuid.NewString()}))
+			if err != nil {
+				t.Fatalf("GetSeries() error = %v", err)
+			}
+			if len(res.Msg.GetSeries().GetEpisodes()) != len(episodes) {
+				t.Fatalf("expected %d episodes, got %d", len(episodes), len(res.Msg.GetSeries().GetEpisodes()))
+			}
+			if encoding != tt.algorithm {
+				t.Fatalf("expected a %s response, got %q", tt.algorithm, encoding)
+			}
+		})
+	}
+
+	if _, err := (CompressionOptions{Algorithms: []string{"br"}}).HandlerOption(); err == nil {
+		t.Fatal("expected an unsupported algorithm to be rejected")
+	}
+}
@@ -0,0 +1,52 @@
+package transport
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// ContentKeyHandler delivers the AES-128 keys of encrypted HLS renditions to
+// players. Keys are served raw, as HLS players expect, to callers identified
+// by UserHeader like the Connect services.
+type ContentKeyHandler struct {
+	service core.ContentKeyService
+}
+
+// NewContentKeyHandler constructs a content key handler backed by the provided service.
+func NewContentKeyHandler(service core.ContentKeyService) *ContentKeyHandler {
+	return &ContentKeyHandler{service: service}
+}
+
+// Register mounts the key endpoint on mux.
+func (h *ContentKeyHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /drm/v1/keys/{key_id}", h.serveKey)
+}
+
+func (h *ContentKeyHandler) serveKey(w http.ResponseWriter, r *http.Request) {
+	keyID, err := uuid.Parse(r.PathValue("key_id"))
+	if err != nil {
+		writeHTTPError(w, fmt.Errorf("%w: invalid key_id %q", core.ErrValidation, r.PathValue("key_id")))
+		return
+	}
+	ctx := r.Context()
+	if userID := strings.TrimSpace(r.Header.Get(UserHeader)); userID != "" {
+		ctx = core.NewCallerContext(ctx, core.Caller{UserID: userID})
+	}
+
+	key, err := h.service.GetContentKey(ctx, keyID)
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+	// Entitlement is checked per learner, so keys must not be shared by caches.
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.Itoa(len(key.Key)))
+	_, _ = w.Write(key.Key)
+}
@@ -0,0 +1,61 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubContentKeyService struct {
+	key *core.ContentKey
+}
+
+func (s stubContentKeyService) GetContentKey(ctx context.Context, id uuid.UUID) (*core.ContentKey, error) {
+	if id != s.key.ID {
+		return nil, core.ErrNotFound
+	}
+	if caller, ok := core.CallerFromContext(ctx); !ok || caller.UserID != "subscriber" {
+		return nil, fmt.Errorf("%w: not a subscriber", core.ErrSubscriptionRequired)
+	}
+	return s.key, nil
+}
+
+func TestContentKeyHandler(t *testing.T) {
+	key := &core.ContentKey{ID: uuid.New(), Key: []byte("0123456789abcdef")}
+	mux := http.NewServeMux()
+	NewContentKeyHandler(stubContentKeyService{key: key}).Register(mux)
+
+	tests := []struct {
+		name       string
+		path       string
+		userID     string
+		wantStatus int
+	}{
+		{name: "subscriber", path: "/drm/v1/keys/" + key.ID.String(), userID: "subscriber", wantStatus: http.StatusOK},
+		{name: "not entitled", path: "/drm/v1/keys/" + key.ID.String(), userID: "learner", wantStatus: http.StatusForbidden},
+		{name: "unknown key", path: "/drm/v1/keys/" + uuid.NewString(), userID: "subscriber", wantStatus: http.StatusNotFound},
+		{name: "invalid id", path: "/drm/v1/keys/nope", wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.userID != "" {
+				req.Header.Set(UserHeader, tt.userID)
+			}
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusOK && (rec.Body.String() != string(key.Key) || rec.Header().Get("Cache-Control") != "private, no-store") {
+				t.Fatalf("unexpected response %q with headers %v", rec.Body.String(), rec.Header())
+			}
+		})
+	}
+}
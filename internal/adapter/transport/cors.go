@@ -0,0 +1,101 @@
+package transport
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	connectcors "connectrpc.com/cors"
+)
+
+// CORSOptions configures cross-origin access for browser clients.
+type CORSOptions struct {
+	// AllowedOrigins lists origins such as "https://app.example.com". "*"
+	// allows any origin and "https://*.example.com" any subdomain. CORS is
+	// disabled when empty.
+	AllowedOrigins []string
+	// AllowedHeaders lists request headers allowed in addition to the
+	// Connect, gRPC-Web and service headers.
+	AllowedHeaders []string
+	// MaxAge is how long browsers may cache a preflight response.
+	MaxAge time.Duration
+}
+
+// serviceHeaders are the request headers the service reads besides the
+// Connect protocol headers.
+var serviceHeaders = []string{
+	"Authorization",
+	"Accept-Language",
+	UserHeader,
+	RequestIDHeader,
+	ReadConsistencyHeader,
+	"If-None-Match",
+}
+
+// NewCORSMiddleware answers preflight requests and adds CORS headers to
+// responses for allowed origins, covering the Connect, gRPC-Web and
+// service-specific headers. Requests from other origins are served without
+// CORS headers, so browsers block them.
+func NewCORSMiddleware(opts CORSOptions, next http.Handler) http.Handler {
+	if len(opts.AllowedOrigins) == 0 {
+		return next
+	}
+
+	allowedMethods := strings.Join(connectcors.AllowedMethods(), ", ")
+	allowedHeaders := strings.Join(slices.Concat(connectcors.AllowedHeaders(), serviceHeaders, opts.AllowedHeaders), ", ")
+	exposedHeaders := strings.Join(append(connectcors.ExposedHeaders(), RequestIDHeader, "ETag", NotModifiedHeader), ", ")
+	maxAge := strconv.Itoa(int(opts.MaxAge / time.Second))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		header := w.Header()
+		header.Add("Vary", "Origin")
+		preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+		if preflight {
+			header.Add("Vary", "Access-Control-Request-Method")
+			header.Add("Vary", "Access-Control-Request-Headers")
+		}
+		if !corsOriginAllowed(opts.AllowedOrigins, origin) {
+			if preflight {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		header.Set("Access-Control-Allow-Origin", origin)
+		if preflight {
+			header.Set("Access-Control-Allow-Methods", allowedMethods)
+			header.Set("Access-Control-Allow-Headers", allowedHeaders)
+			header.Set("Access-Control-Max-Age", maxAge)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		header.Set("Access-Control-Expose-Headers", exposedHeaders)
+		next.ServeHTTP(w, r)
+	})
+}
+
+func corsOriginAllowed(allowed []string, origin string) bool {
+	for _, pattern := range allowed {
+		if pattern == "*" || strings.EqualFold(pattern, origin) {
+			return true
+		}
+		// "https://*.example.com" matches any subdomain, but not the apex.
+		if prefix, suffix, ok := strings.Cut(pattern, "*"); ok &&
+			len(origin) > len(prefix)+len(suffix) &&
+			strings.HasPrefix(strings.ToLower(origin), strings.ToLower(prefix)) &&
+			strings.HasSuffix(strings.ToLower(origin), strings.ToLower(suffix)) {
+			return true
+		}
+	}
+	return false
+}
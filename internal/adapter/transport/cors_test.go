@@ -0,0 +1,80 @@
+package transport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCORSMiddleware(t *testing.T) {
+	var served int
+	handler := NewCORSMiddleware(CORSOptions{
+		AllowedOrigins: []string{"https://app.example.com", "https://*.lession.dev"},
+		AllowedHeaders: []string{"X-Client-Version"},
+		MaxAge:         2 * time.Hour,
+	}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name        string
+		method      string
+		origin      string
+		preflight   bool
+		wantOrigin  string
+		wantStatus  int
+		wantServed  bool
+		wantHeaders []string
+	}{
+		{name: "preflight from allowed origin", method: http.MethodOptions, origin: "https://app.example.com", preflight: true,
+			wantOrigin: "https://app.example.com", wantStatus: http.StatusNoContent,
+			wantHeaders: []string{"Connect-Protocol-Version", "X-Grpc-Web", "Authorization", "X-Client-Version"}},
+		{name: "preflight from subdomain", method: http.MethodOptions, origin: "https://staging.lession.dev", preflight: true,
+			wantOrigin: "https://staging.lession.dev", wantStatus: http.StatusNoContent},
+		{name: "preflight from apex of wildcard", method: http.MethodOptions, origin: "https://.lession.dev", preflight: true,
+			wantStatus: http.StatusNoContent},
+		{name: "preflight from other origin", method: http.MethodOptions, origin: "https://evil.example.com", preflight: true,
+			wantStatus: http.StatusNoContent},
+		{name: "request from allowed origin", method: http.MethodPost, origin: "https://app.example.com",
+			wantOrigin: "https://app.example.com", wantStatus: http.StatusOK, wantServed: true},
+		{name: "request from other origin", method: http.MethodPost, origin: "https://evil.example.com",
+			wantStatus: http.StatusOK, wantServed: true},
+		{name: "same-origin request", method: http.MethodPost, wantStatus: http.StatusOK, wantServed: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			served = 0
+			req := httptest.NewRequest(tt.method, "/lession.v1.SeriesService/ListSeries", nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			if tt.preflight {
+				req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus || (served == 1) != tt.wantServed {
+				t.Fatalf("expected status %d served %v, got %d served %d", tt.wantStatus, tt.wantServed, rec.Code, served)
+			}
+			if got := rec.Header().Get("Access-Control-Allow-Origin"); got != tt.wantOrigin {
+				t.Fatalf("expected allowed origin %q, got %q", tt.wantOrigin, got)
+			}
+			allowed := rec.Header().Get("Access-Control-Allow-Headers")
+			for _, header := range tt.wantHeaders {
+				if !strings.Contains(allowed, header) {
+					t.Fatalf("expected %s in allowed headers %q", header, allowed)
+				}
+			}
+			if tt.preflight && tt.wantOrigin != "" && rec.Header().Get("Access-Control-Max-Age") != "7200" {
+				t.Fatalf("expected max age 7200, got %q", rec.Header().Get("Access-Control-Max-Age"))
+			}
+			if !tt.preflight && tt.wantOrigin != "" && !strings.Contains(rec.Header().Get("Access-Control-Expose-Headers"), RequestIDHeader) {
+				t.Fatalf("expected %s to be exposed", RequestIDHeader)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// DictationHandler implements the generated Connect service for dictation practice.
+type DictationHandler struct {
+	service core.DictationService
+}
+
+// NewDictationHandler constructs a new dictation handler backed by the provided service.
+func NewDictationHandler(service core.DictationService) *DictationHandler {
+	return &DictationHandler{service: service}
+}
+
+var _ lessionv1connect.DictationServiceHandler = (*DictationHandler)(nil)
+
+// ListDictationItems slices an episode's timed transcript into dictation items.
+func (h *DictationHandler) ListDictationItems(ctx context.Context, req *connect.Request[lessionv1.ListDictationItemsRequest]) (*connect.Response[lessionv1.ListDictationItemsResponse], error) {
+	episodeID, err := uuid.Parse(req.Msg.GetEpisodeId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+	}
+
+	items, err := h.service.ListDictationItems(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListDictationItemsResponse{
+		Items: lo.Map(items, func(item core.DictationItem, _ int) *lessionv1.DictationItem {
+			return &lessionv1.DictationItem{
+				EpisodeId: item.EpisodeID.String(),
+				Index:     int32(item.Index),
+				Start:     durationpb.New(item.Start),
+				End:       durationpb.New(item.End),
+				AudioUrl:  item.AudioURL,
+				WordCount: int32(item.WordCount),
+			}
+		}),
+	}), nil
+}
+
+// SubmitDictationAnswer scores a learner's typed answer and records the attempt.
+func (h *DictationHandler) SubmitDictationAnswer(ctx context.Context, req *connect.Request[lessionv1.SubmitDictationAnswerRequest]) (*connect.Response[lessionv1.SubmitDictationAnswerResponse], error) {
+	episodeID, err := uuid.Parse(req.Msg.GetEpisodeId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+	}
+
+	attempt, err := h.service.SubmitDictationAnswer(ctx, core.SubmitDictationParams{
+		UserID:    req.Msg.GetUserId(),
+		EpisodeID: episodeID,
+		ItemIndex: int(req.Msg.GetItemIndex()),
+		Answer:    req.Msg.GetAnswer(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.SubmitDictationAnswerResponse{
+		Attempt: toProtoDictationAttempt(attempt),
+	}), nil
+}
+
+// ListDictationAttempts returns recorded attempts, newest first.
+func (h *DictationHandler) ListDictationAttempts(ctx context.Context, req *connect.Request[lessionv1.ListDictationAttemptsRequest]) (*connect.Response[lessionv1.ListDictationAttemptsResponse], error) {
+	filter := core.DictationAttemptFilter{
+		PageSize:  int(req.Msg.GetPageSize()),
+		PageToken: req.Msg.GetPageToken(),
+		UserID:    req.Msg.GetUserId(),
+	}
+	if req.Msg.GetEpisodeId() != "" {
+		episodeID, err := uuid.Parse(req.Msg.GetEpisodeId())
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+		}
+		filter.EpisodeID = episodeID
+	}
+
+	attempts, nextToken, err := h.service.ListDictationAttempts(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListDictationAttemptsResponse{
+		Attempts: lo.Map(attempts, func(attempt core.DictationAttempt, _ int) *lessionv1.DictationAttempt {
+			return toProtoDictationAttempt(&attempt)
+		}),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+func toProtoDictationAttempt(attempt *core.DictationAttempt) *lessionv1.DictationAttempt {
+	if attempt == nil {
+		return nil
+	}
+	return &lessionv1.DictationAttempt{
+		Id:        attempt.ID.String(),
+		UserId:    attempt.UserID,
+		EpisodeId: attempt.EpisodeID.String(),
+		ItemIndex: int32(attempt.ItemIndex),
+		Answer:    attempt.Answer,
+		Expected:  attempt.Expected,
+		Score:     attempt.Score,
+		Feedback: lo.Map(attempt.Feedback, func(token core.DictationToken, _ int) *lessionv1.DictationToken {
+			return &lessionv1.DictationToken{
+				Text: token.Text,
+				Op:   toProtoDictationTokenOp(token.Op),
+			}
+		}),
+		CreatedAt: timestamppb.New(attempt.CreatedAt),
+	}
+}
+
+func toProtoDictationTokenOp(op core.DictationTokenOp) lessionv1.DictationTokenOp {
+	switch op {
+	case core.DictationTokenOpMatch:
+		return lessionv1.DictationTokenOp_DICTATION_TOKEN_OP_MATCH
+	case core.DictationTokenOpMissing:
+		return lessionv1.DictationTokenOp_DICTATION_TOKEN_OP_MISSING
+	case core.DictationTokenOpExtra:
+		return lessionv1.DictationTokenOp_DICTATION_TOKEN_OP_EXTRA
+	default:
+		return lessionv1.DictationTokenOp_DICTATION_TOKEN_OP_UNSPECIFIED
+	}
+}
@@ -0,0 +1,73 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// DownloadHandler implements the generated Connect service for offline downloads.
+type DownloadHandler struct {
+	service core.DownloadService
+}
+
+// NewDownloadHandler constructs a new download handler backed by the provided service.
+func NewDownloadHandler(service core.DownloadService) *DownloadHandler {
+	return &DownloadHandler{service: service}
+}
+
+var _ lessionv1connect.DownloadServiceHandler = (*DownloadHandler)(nil)
+
+// CreateDownloadBundle signs the files of an episode for offline playback.
+func (h *DownloadHandler) CreateDownloadBundle(ctx context.Context, req *connect.Request[lessionv1.CreateDownloadBundleRequest]) (*connect.Response[lessionv1.CreateDownloadBundleResponse], error) {
+	episodeID, err := uuid.Parse(req.Msg.GetEpisodeId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+	}
+
+	bundle, err := h.service.CreateDownloadBundle(ctx, episodeID, req.Msg.GetTranslationLanguage())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.CreateDownloadBundleResponse{
+		Bundle: &lessionv1.DownloadBundle{
+			EpisodeId:           bundle.EpisodeID.String(),
+			SeriesId:            bundle.SeriesID.String(),
+			Title:               bundle.Title,
+			Version:             timestamppb.New(bundle.Version),
+			TranslationLanguage: bundle.TranslationLanguage,
+			Files: lo.Map(bundle.Files, func(file core.DownloadFile, _ int) *lessionv1.DownloadFile {
+				return &lessionv1.DownloadFile{
+					Kind:     toProtoDownloadFileKind(file.Kind),
+					Name:     file.Name,
+					MimeType: file.MimeType,
+					Size:     uint64(file.Size),
+					Url:      file.URL,
+				}
+			}),
+			ExpiresAt: timestamppb.New(bundle.ExpiresAt),
+		},
+	}), nil
+}
+
+func toProtoDownloadFileKind(kind core.DownloadFileKind) lessionv1.DownloadFileKind {
+	switch kind {
+	case core.DownloadFileKindMedia:
+		return lessionv1.DownloadFileKind_DOWNLOAD_FILE_KIND_MEDIA
+	case core.DownloadFileKindTranscript:
+		return lessionv1.DownloadFileKind_DOWNLOAD_FILE_KIND_TRANSCRIPT
+	case core.DownloadFileKindVocabulary:
+		return lessionv1.DownloadFileKind_DOWNLOAD_FILE_KIND_VOCABULARY
+	default:
+		return lessionv1.DownloadFileKind_DOWNLOAD_FILE_KIND_UNSPECIFIED
+	}
+}
@@ -0,0 +1,82 @@
+package transport
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// DownloadFileHandler serves the files of offline download bundles. Requests
+// are authorized by the signature in the URL alone, so download managers can
+// fetch files without API credentials.
+type DownloadFileHandler struct {
+	service core.DownloadService
+}
+
+// NewDownloadFileHandler constructs a download file handler backed by the provided service.
+func NewDownloadFileHandler(service core.DownloadService) *DownloadFileHandler {
+	return &DownloadFileHandler{service: service}
+}
+
+// Register mounts the download endpoint on mux.
+func (h *DownloadFileHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /downloads/v1/episodes/{episode_id}/{file}", h.serveFile)
+}
+
+func (h *DownloadFileHandler) serveFile(w http.ResponseWriter, r *http.Request) {
+	episodeID, err := uuid.Parse(r.PathValue("episode_id"))
+	if err != nil {
+		writeHTTPError(w, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, r.PathValue("episode_id")))
+		return
+	}
+	kind, err := parseDownloadFileKind(r.PathValue("file"))
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+	query := r.URL.Query()
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil {
+		writeHTTPError(w, fmt.Errorf("%w: invalid expires %q", core.ErrValidation, query.Get("expires")))
+		return
+	}
+
+	content, err := h.service.OpenDownloadFile(r.Context(), core.DownloadFileRequest{
+		EpisodeID:           episodeID,
+		Kind:                kind,
+		TranslationLanguage: query.Get("lang"),
+		ExpiresAt:           time.Unix(expires, 0),
+		Signature:           query.Get("signature"),
+	})
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+	if content.RedirectURL != "" {
+		http.Redirect(w, r, content.RedirectURL, http.StatusFound)
+		return
+	}
+	w.Header().Set("Content-Type", content.MimeType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": content.Name}))
+	w.Header().Set("Content-Length", strconv.Itoa(len(content.Data)))
+	_, _ = w.Write(content.Data)
+}
+
+func parseDownloadFileKind(value string) (core.DownloadFileKind, error) {
+	switch value {
+	case "media":
+		return core.DownloadFileKindMedia, nil
+	case "transcript":
+		return core.DownloadFileKindTranscript, nil
+	case "vocabulary":
+		return core.DownloadFileKindVocabulary, nil
+	default:
+		return core.DownloadFileKindUnspecified, fmt.Errorf("%w: unknown download file %q", core.ErrNotFound, value)
+	}
+}
@@ -0,0 +1,79 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// EmbedHandler implements the generated Connect service for embed tokens.
+type EmbedHandler struct {
+	service core.EmbedService
+}
+
+// NewEmbedHandler constructs an embed handler backed by the provided service.
+func NewEmbedHandler(service core.EmbedService) *EmbedHandler {
+	return &EmbedHandler{service: service}
+}
+
+var _ lessionv1connect.EmbedServiceHandler = (*EmbedHandler)(nil)
+
+// CreateEmbedToken issues an embed token for a published episode.
+func (h *EmbedHandler) CreateEmbedToken(ctx context.Context, req *connect.Request[lessionv1.CreateEmbedTokenRequest]) (*connect.Response[lessionv1.CreateEmbedTokenResponse], error) {
+	episodeID, err := uuid.Parse(req.Msg.GetEpisodeId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+	}
+
+	token, err := h.service.CreateEmbedToken(ctx, core.EmbedTokenRequest{
+		EpisodeID:      episodeID,
+		AllowedDomains: req.Msg.GetAllowedDomains(),
+		TTL:            req.Msg.GetTtl().AsDuration(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.CreateEmbedTokenResponse{
+		Token: &lessionv1.EmbedToken{
+			Token:          token.Token,
+			EpisodeId:      token.EpisodeID.String(),
+			AllowedDomains: token.AllowedDomains,
+			ExpiresAt:      timestamppb.New(token.ExpiresAt),
+			EmbedUrl:       token.EmbedURL,
+		},
+	}), nil
+}
+
+// GetEmbedPlayback exchanges an embed token for its episode and playback URL.
+func (h *EmbedHandler) GetEmbedPlayback(ctx context.Context, req *connect.Request[lessionv1.GetEmbedPlaybackRequest]) (*connect.Response[lessionv1.GetEmbedPlaybackResponse], error) {
+	playback, err := h.service.EmbedPlayback(ctx, req.Msg.GetToken(), requestDomain(req.Header()))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetEmbedPlaybackResponse{
+		Episode:   toProtoCatalogEpisode(playback.Episode),
+		ExpiresAt: timestamppb.New(playback.ExpiresAt),
+	}), nil
+}
+
+// requestDomain returns the host of the page a browser request comes from,
+// from its Origin header or, failing that, its Referer.
+func requestDomain(header http.Header) string {
+	for _, name := range []string{"Origin", "Referer"} {
+		if u, err := url.Parse(header.Get(name)); err == nil && u.Hostname() != "" {
+			return u.Hostname()
+		}
+	}
+	return ""
+}
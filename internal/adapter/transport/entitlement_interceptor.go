@@ -0,0 +1,272 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/samber/lo"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+const (
+	// SubscriptionRequiredReason is the google.rpc.ErrorInfo reason attached
+	// when a caller needs a subscription; metadata lists plans to upgrade to.
+	SubscriptionRequiredReason = "SUBSCRIPTION_REQUIRED"
+
+	errorInfoDomain = "lession.eslsoft.com"
+)
+
+type entitlementMode int
+
+const (
+	// entitlementDeny rejects the request when it returns a locked episode.
+	entitlementDeny entitlementMode = iota + 1
+	// entitlementRedact strips playback URLs and assets from locked episodes.
+	entitlementRedact
+)
+
+// assetServicePrefix starts the procedures of AssetService, whose responses
+// carry the media URLs of assets.
+const assetServicePrefix = "/" + lessionv1connect.AssetServiceName + "/"
+
+// entitlementProcedures lists the read RPCs that issue playback URLs.
+var entitlementProcedures = map[string]entitlementMode{
+	lessionv1connect.SeriesServiceGetEpisodeProcedure:          entitlementDeny,
+	lessionv1connect.SeriesServiceGetSeriesProcedure:           entitlementRedact,
+	lessionv1connect.SeriesServiceListSeriesProcedure:          entitlementRedact,
+	lessionv1connect.PlaylistServiceGetPlaylistProcedure:       entitlementRedact,
+	lessionv1connect.PlaylistServiceGetSharedPlaylistProcedure: entitlementRedact,
+	lessionv1connect.PlaylistServiceListPlaylistsProcedure:     entitlementRedact,
+}
+
+// NewEntitlementInterceptor gates playback URLs of non-preview episodes behind
+// an active subscription. Fetching such an episode directly fails with
+// PermissionDenied and an upgrade hint; listings keep the episode metadata but
+// omit its playback URL and asset. AssetService responses, streamed ones
+// included, omit the media URLs of audio and video assets unless the caller
+// is a subscriber or an API key. It relies on the caller set by
+// NewIdentityInterceptor and NewAPIKeyInterceptor.
+func NewEntitlementInterceptor(subscriptions core.SubscriptionService) connect.Interceptor {
+	return entitlementInterceptor{subscriptions: subscriptions}
+}
+
+type entitlementInterceptor struct {
+	subscriptions core.SubscriptionService
+}
+
+func (i entitlementInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		res, err := next(ctx, req)
+		if err != nil {
+			return res, err
+		}
+		msg, ok := res.Any().(proto.Message)
+		if !ok {
+			return res, nil
+		}
+		procedure := req.Spec().Procedure
+		if strings.HasPrefix(procedure, assetServicePrefix) {
+			if err := i.redactAssetURLs(ctx, msg); err != nil {
+				return nil, err
+			}
+			return res, nil
+		}
+		mode, gated := entitlementProcedures[procedure]
+		if !gated {
+			return res, nil
+		}
+
+		locked := collectLockedEpisodes(msg.ProtoReflect(), nil)
+		if len(locked) == 0 {
+			return res, nil
+		}
+
+		entitled, err := callerEntitled(ctx, i.subscriptions)
+		if err != nil {
+			return nil, err
+		}
+		if entitled {
+			return res, nil
+		}
+
+		if mode == entitlementDeny {
+			return nil, subscriptionRequiredError(ctx, i.subscriptions, locked[0])
+		}
+		for _, episode := range locked {
+			// The asset would lead to the playback URL through AssetService.
+			episode.Resource.PlaybackUrl = ""
+			episode.Resource.AssetId = ""
+		}
+		return res, nil
+	}
+}
+
+func (entitlementInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i entitlementInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if !strings.HasPrefix(conn.Spec().Procedure, assetServicePrefix) {
+			return next(ctx, conn)
+		}
+		return next(ctx, assetRedactingConn{StreamingHandlerConn: conn, ctx: ctx, interceptor: i})
+	}
+}
+
+// assetRedactingConn strips asset media URLs from streamed messages.
+type assetRedactingConn struct {
+	connect.StreamingHandlerConn
+	ctx         context.Context
+	interceptor entitlementInterceptor
+}
+
+func (c assetRedactingConn) Send(msg any) error {
+	if message, ok := msg.(proto.Message); ok {
+		if err := c.interceptor.redactAssetURLs(c.ctx, message); err != nil {
+			return err
+		}
+	}
+	return c.StreamingHandlerConn.Send(msg)
+}
+
+// redactAssetURLs clears the media URLs of the audio and video assets and
+// asset versions in msg unless the caller may play them.
+func (i entitlementInterceptor) redactAssetURLs(ctx context.Context, msg proto.Message) error {
+	assets, versions := mediaURLHolders(msg)
+	if len(assets) == 0 && len(versions) == 0 {
+		return nil
+	}
+
+	if caller, ok := core.CallerFromContext(ctx); ok && len(caller.APIKeyScopes) > 0 {
+		return nil
+	}
+	entitled, err := callerEntitled(ctx, i.subscriptions)
+	if err != nil || entitled {
+		return err
+	}
+	clearMediaURLs(assets, versions)
+	return nil
+}
+
+// mediaURLHolders returns the audio and video assets and the asset versions
+// in msg that carry media URLs. Image assets are covers and hero images,
+// which are public anyway.
+func mediaURLHolders(msg proto.Message) ([]*lessionv1.Asset, []*lessionv1.AssetVersion) {
+	var assets []*lessionv1.Asset
+	var versions []*lessionv1.AssetVersion
+	walkMessages(msg.ProtoReflect(), func(m protoreflect.Message) bool {
+		switch value := m.Interface().(type) {
+		case *lessionv1.Asset:
+			if value.GetType() != lessionv1.MediaType_MEDIA_TYPE_IMAGE && (value.GetPlaybackUrl() != "" || value.GetHlsManifestUrl() != "") {
+				assets = append(assets, value)
+			}
+			return true
+		case *lessionv1.AssetVersion:
+			if value.GetPlaybackUrl() != "" || value.GetHlsManifestUrl() != "" {
+				versions = append(versions, value)
+			}
+			return true
+		}
+		return false
+	})
+	return assets, versions
+}
+
+func clearMediaURLs(assets []*lessionv1.Asset, versions []*lessionv1.AssetVersion) {
+	for _, asset := range assets {
+		asset.PlaybackUrl = ""
+		asset.HlsManifestUrl = ""
+	}
+	for _, version := range versions {
+		version.PlaybackUrl = ""
+		version.HlsManifestUrl = ""
+	}
+}
+
+func callerEntitled(ctx context.Context, subscriptions core.SubscriptionService) (bool, error) {
+	caller, ok := core.CallerFromContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	if _, err := subscriptions.ActiveSubscription(ctx, caller.UserID); err != nil {
+		if errors.Is(err, core.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func subscriptionRequiredError(ctx context.Context, subscriptions core.SubscriptionService, episode *lessionv1.Episode) *connect.Error {
+	err := connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%w: episode %s is not a preview", core.ErrSubscriptionRequired, episode.GetId()))
+
+	metadata := map[string]string{"episode_id": episode.GetId()}
+	// The upgrade hint is best-effort; the denial stands even if plans cannot be loaded.
+	if plans, listErr := subscriptions.ListPlans(ctx, false); listErr == nil && len(plans) > 0 {
+		metadata["upgrade_plans"] = strings.Join(lo.Map(plans, func(plan core.Plan, _ int) string {
+			return plan.Code
+		}), ",")
+	}
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.ErrorInfo{
+		Reason:   SubscriptionRequiredReason,
+		Domain:   errorInfoDomain,
+		Metadata: metadata,
+	}); detailErr == nil {
+		err.AddDetail(detail)
+	}
+	return err
+}
+
+// collectLockedEpisodes walks msg and returns every non-preview episode that
+// carries a playback URL.
+func collectLockedEpisodes(msg protoreflect.Message, locked []*lessionv1.Episode) []*lessionv1.Episode {
+	walkMessages(msg, func(m protoreflect.Message) bool {
+		episode, ok := m.Interface().(*lessionv1.Episode)
+		if ok && !episode.GetPreview() && episode.GetResource().GetPlaybackUrl() != "" {
+			locked = append(locked, episode)
+		}
+		return ok
+	})
+	return locked
+}
+
+// walkMessages calls visit for msg and every message nested in it, skipping
+// the fields of messages for which visit returns true.
+func walkMessages(msg protoreflect.Message, visit func(protoreflect.Message) bool) {
+	if !msg.IsValid() || visit(msg) {
+		return
+	}
+
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind {
+			return true
+		}
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					walkMessages(v.Message(), visit)
+					return true
+				})
+			}
+		case fd.IsList():
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				walkMessages(list.Get(i).Message(), visit)
+			}
+		default:
+			walkMessages(value.Message(), visit)
+		}
+		return true
+	})
+}
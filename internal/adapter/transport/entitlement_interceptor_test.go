@@ -0,0 +1,241 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+type stubEntitlementSubscriptions struct {
+	core.SubscriptionService
+	entitled map[string]bool
+}
+
+func (s stubEntitlementSubscriptions) ActiveSubscription(ctx context.Context, userID string) (*core.Subscription, error) {
+	if !s.entitled[userID] {
+		return nil, core.ErrNotFound
+	}
+	return &core.Subscription{ID: uuid.New(), UserID: userID, Status: core.SubscriptionStatusActive}, nil
+}
+
+func (s stubEntitlementSubscriptions) ListPlans(ctx context.Context, includeInactive bool) ([]core.Plan, error) {
+	return []core.Plan{{Code: "monthly", Active: true}, {Code: "yearly", Active: true}}, nil
+}
+
+type stubEpisodeSeriesHandler struct {
+	lessionv1connect.UnimplementedSeriesServiceHandler
+	episodes []*lessionv1.Episode
+}
+
+func (h stubEpisodeSeriesHandler) GetEpisode(ctx context.Context, req *connect.Request[lessionv1.GetEpisodeRequest]) (*connect.Response[lessionv1.GetEpisodeResponse], error) {
+	for _, episode := range h.episodes {
+		if episode.GetId() == req.Msg.GetEpisodeId() {
+			return connect.NewResponse(&lessionv1.GetEpisodeResponse{Episode: episode}), nil
+		}
+	}
+	return nil, connect.NewError(connect.CodeNotFound, core.ErrNotFound)
+}
+
+func (h stubEpisodeSeriesHandler) GetSeries(ctx context.Context, req *connect.Request[lessionv1.GetSeriesRequest]) (*connect.Response[lessionv1.GetSeriesResponse], error) {
+	// Clone so redaction in one call does not leak into the next.
+	series := &lessionv1.Series{Episodes: h.episodes}
+	return connect.NewResponse(&lessionv1.GetSeriesResponse{Series: proto.Clone(series).(*lessionv1.Series)}), nil
+}
+
+func newEntitlementTestClient(t *testing.T, episodes ...*lessionv1.Episode) lessionv1connect.SeriesServiceClient {
+	t.Helper()
+
+	subscriptions := stubEntitlementSubscriptions{entitled: map[string]bool{"subscriber": true}}
+	mux := http.NewServeMux()
+	mux.Handle(lessionv1connect.NewSeriesServiceHandler(
+		stubEpisodeSeriesHandler{episodes: episodes},
+		connect.WithInterceptors(NewIdentityInterceptor(), NewErrorInterceptor(), NewEntitlementInterceptor(subscriptions)),
+	))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return lessionv1connect.NewSeriesServiceClient(server.Client(), server.URL)
+}
+
+func TestEntitlementInterceptor_GetEpisode(t *testing.T) {
+	locked := &lessionv1.Episode{Id: uuid.NewString(), Resource: &lessionv1.MediaResource{PlaybackUrl: "https://cdn/locked.m3u8"}}
+	preview := &lessionv1.Episode{Id: uuid.NewString(), Preview: true, Resource: &lessionv1.MediaResource{PlaybackUrl: "https://cdn/preview.m3u8"}}
+	client := newEntitlementTestClient(t, locked, preview)
+
+	tests := []struct {
+		name      string
+		userID    string
+		episodeID string
+		wantURL   string
+		wantCode  connect.Code
+	}{
+		{name: "preview is open to anonymous callers", episodeID: preview.GetId(), wantURL: "https://cdn/preview.m3u8"},
+		{name: "subscriber gets locked episode", userID: "subscriber", episodeID: locked.GetId(), wantURL: "https://cdn/locked.m3u8"},
+		{name: "anonymous caller is denied", episodeID: locked.GetId(), wantCode: connect.CodePermissionDenied},
+		{name: "learner without subscription is denied", userID: "learner", episodeID: locked.GetId(), wantCode: connect.CodePermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := connect.NewRequest(&lessionv1.GetEpisodeRequest{EpisodeId: tt.episodeID})
+			if tt.userID != "" {
+				req.Header().Set(UserHeader, tt.userID)
+			}
+
+			res, err := client.GetEpisode(context.Background(), req)
+			if tt.wantCode != 0 {
+				var connectErr *connect.Error
+				if !errors.As(err, &connectErr) || connectErr.Code() != tt.wantCode {
+					t.Fatalf("GetEpisode() error = %v, want code %v", err, tt.wantCode)
+				}
+				info := findErrorInfo(t, connectErr)
+				if info.GetReason() != SubscriptionRequiredReason {
+					t.Fatalf("reason = %q, want %q", info.GetReason(), SubscriptionRequiredReason)
+				}
+				if got := info.GetMetadata()["upgrade_plans"]; got != "monthly,yearly" {
+					t.Fatalf("upgrade_plans = %q, want %q", got, "monthly,yearly")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetEpisode() error = %v", err)
+			}
+			if got := res.Msg.GetEpisode().GetResource().GetPlaybackUrl(); got != tt.wantURL {
+				t.Fatalf("playback_url = %q, want %q", got, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestEntitlementInterceptor_RedactsSeriesEpisodes(t *testing.T) {
+	locked := &lessionv1.Episode{Id: uuid.NewString(), Resource: &lessionv1.MediaResource{AssetId: uuid.NewString(), PlaybackUrl: "https://cdn/locked.m3u8"}}
+	preview := &lessionv1.Episode{Id: uuid.NewString(), Preview: true, Resource: &lessionv1.MediaResource{PlaybackUrl: "https://cdn/preview.m3u8"}}
+	client := newEntitlementTestClient(t, locked, preview)
+
+	res, err := client.GetSeries(context.Background(), connect.NewRequest(&lessionv1.GetSeriesRequest{}))
+	if err != nil {
+		t.Fatalf("GetSeries() error = %v", err)
+	}
+	episodes := res.Msg.GetSeries().GetEpisodes()
+	if got := episodes[0].GetResource(); got.GetPlaybackUrl() != "" || got.GetAssetId() != "" {
+		t.Fatalf("locked resource = %v, want playback_url and asset_id redacted", got)
+	}
+	if got := episodes[1].GetResource().GetPlaybackUrl(); got != "https://cdn/preview.m3u8" {
+		t.Fatalf("preview playback_url = %q, want kept", got)
+	}
+
+	req := connect.NewRequest(&lessionv1.GetSeriesRequest{})
+	req.Header().Set(UserHeader, "subscriber")
+	res, err = client.GetSeries(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetSeries() as subscriber error = %v", err)
+	}
+	if got := res.Msg.GetSeries().GetEpisodes()[0].GetResource().GetPlaybackUrl(); got != "https://cdn/locked.m3u8" {
+		t.Fatalf("subscriber playback_url = %q, want kept", got)
+	}
+}
+
+type stubEntitlementAssetHandler struct {
+	lessionv1connect.UnimplementedAssetServiceHandler
+	assets []*lessionv1.Asset
+}
+
+func (h stubEntitlementAssetHandler) ListAssets(ctx context.Context, req *connect.Request[lessionv1.ListAssetsRequest]) (*connect.Response[lessionv1.ListAssetsResponse], error) {
+	assets := make([]*lessionv1.Asset, 0, len(h.assets))
+	for _, asset := range h.assets {
+		assets = append(assets, proto.Clone(asset).(*lessionv1.Asset))
+	}
+	return connect.NewResponse(&lessionv1.ListAssetsResponse{Assets: assets}), nil
+}
+
+func (h stubEntitlementAssetHandler) WatchAsset(ctx context.Context, req *connect.Request[lessionv1.WatchAssetRequest], stream *connect.ServerStream[lessionv1.WatchAssetResponse]) error {
+	return stream.Send(&lessionv1.WatchAssetResponse{Asset: proto.Clone(h.assets[0]).(*lessionv1.Asset)})
+}
+
+func TestEntitlementInterceptor_RedactsAssetURLs(t *testing.T) {
+	audio := &lessionv1.Asset{Id: uuid.NewString(), Type: lessionv1.MediaType_MEDIA_TYPE_AUDIO, PlaybackUrl: "https://cdn/a.mp3", HlsManifestUrl: "https://cdn/a.m3u8"}
+	cover := &lessionv1.Asset{Id: uuid.NewString(), Type: lessionv1.MediaType_MEDIA_TYPE_IMAGE, PlaybackUrl: "https://cdn/c.jpg"}
+	subscriptions := stubEntitlementSubscriptions{entitled: map[string]bool{"subscriber": true}}
+	mux := http.NewServeMux()
+	mux.Handle(lessionv1connect.NewAssetServiceHandler(
+		stubEntitlementAssetHandler{assets: []*lessionv1.Asset{audio, cover}},
+		connect.WithInterceptors(NewIdentityInterceptor(), NewErrorInterceptor(), NewEntitlementInterceptor(subscriptions)),
+	))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client := lessionv1connect.NewAssetServiceClient(server.Client(), server.URL)
+	ctx := context.Background()
+
+	res, err := client.ListAssets(ctx, connect.NewRequest(&lessionv1.ListAssetsRequest{}))
+	if err != nil {
+		t.Fatalf("ListAssets() error = %v", err)
+	}
+	if got := res.Msg.GetAssets()[0]; got.GetPlaybackUrl() != "" || got.GetHlsManifestUrl() != "" {
+		t.Fatalf("audio asset = %v, want its urls redacted", got)
+	}
+	if got := res.Msg.GetAssets()[1].GetPlaybackUrl(); got != cover.GetPlaybackUrl() {
+		t.Fatalf("image playback_url = %q, want kept", got)
+	}
+
+	stream, err := client.WatchAsset(ctx, connect.NewRequest(&lessionv1.WatchAssetRequest{}))
+	if err != nil {
+		t.Fatalf("WatchAsset() error = %v", err)
+	}
+	if !stream.Receive() {
+		t.Fatalf("WatchAsset() error = %v", stream.Err())
+	}
+	if got := stream.Msg().GetAsset().GetPlaybackUrl(); got != "" {
+		t.Fatalf("watched playback_url = %q, want redacted", got)
+	}
+	stream.Close()
+
+	req := connect.NewRequest(&lessionv1.ListAssetsRequest{})
+	req.Header().Set(UserHeader, "subscriber")
+	res, err = client.ListAssets(ctx, req)
+	if err != nil {
+		t.Fatalf("ListAssets() as subscriber error = %v", err)
+	}
+	if got := res.Msg.GetAssets()[0].GetPlaybackUrl(); got != audio.GetPlaybackUrl() {
+		t.Fatalf("subscriber playback_url = %q, want kept", got)
+	}
+
+	watch := connect.NewRequest(&lessionv1.WatchAssetRequest{})
+	watch.Header().Set(UserHeader, "subscriber")
+	stream, err = client.WatchAsset(ctx, watch)
+	if err != nil {
+		t.Fatalf("WatchAsset() as subscriber error = %v", err)
+	}
+	if !stream.Receive() {
+		t.Fatalf("WatchAsset() as subscriber error = %v", stream.Err())
+	}
+	if got := stream.Msg().GetAsset().GetPlaybackUrl(); got != audio.GetPlaybackUrl() {
+		t.Fatalf("subscriber watched playback_url = %q, want kept", got)
+	}
+	stream.Close()
+}
+
+func findErrorInfo(t *testing.T, err *connect.Error) *errdetails.ErrorInfo {
+	t.Helper()
+	for _, detail := range err.Details() {
+		value, valueErr := detail.Value()
+		if valueErr != nil {
+			continue
+		}
+		if info, ok := value.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	t.Fatalf("error %v has no ErrorInfo detail", err)
+	return nil
+}
@@ -5,42 +5,150 @@ import (
 	"errors"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 
 	"github.com/eslsoft/lession/internal/core"
+	"github.com/eslsoft/lession/internal/i18n"
 )
 
+// domainError pairs a domain error with its Connect code and message catalog key.
+type domainError struct {
+	err  error
+	code connect.Code
+	key  string
+}
+
+var domainErrors = []domainError{
+	{err: core.ErrValidation, code: connect.CodeInvalidArgument, key: "error.validation"},
+	{err: core.ErrInvalidPageToken, code: connect.CodeInvalidArgument, key: "error.invalid_page_token"},
+	{err: core.ErrUploadIdentifierRequired, code: connect.CodeInvalidArgument, key: "error.upload_identifier_required"},
+	{err: core.ErrNotFound, code: connect.CodeNotFound, key: "error.not_found"},
+	{err: core.ErrAlreadyExists, code: connect.CodeAlreadyExists, key: "error.already_exists"},
+	{err: core.ErrUploadInvalidState, code: connect.CodeFailedPrecondition, key: "error.upload_invalid_state"},
+	{err: core.ErrTranscriptNotTimed, code: connect.CodeFailedPrecondition, key: "error.transcript_not_timed"},
+	{err: core.ErrInvalidState, code: connect.CodeFailedPrecondition, key: "error.invalid_state"},
+	{err: core.ErrSubscriptionRequired, code: connect.CodePermissionDenied, key: "error.subscription_required"},
+	{err: core.ErrUnauthenticated, code: connect.CodeUnauthenticated, key: "error.unauthenticated"},
+	{err: core.ErrPermissionDenied, code: connect.CodePermissionDenied, key: "error.permission_denied"},
+	{err: core.ErrTimeout, code: connect.CodeDeadlineExceeded, key: "error.timeout"},
+}
+
+const internalErrorKey = "error.internal"
+
 // NewErrorInterceptor creates a Connect interceptor that maps domain errors
-// to transport-friendly Connect errors.
+// to transport-friendly Connect errors, for unary and streaming handlers.
+// When the request carries a localizer (see NewLocaleInterceptor) a
+// google.rpc.LocalizedMessage detail is attached, and publish checklist
+// failures carry a google.rpc.PreconditionFailure detail listing the rules
+// broken.
 func NewErrorInterceptor() connect.Interceptor {
-	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			res, err := next(ctx, req)
-			if err == nil {
-				return res, nil
-			}
-			return nil, mapError(err)
+	return errorInterceptor{}
+}
+
+type errorInterceptor struct{}
+
+func (errorInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		res, err := next(ctx, req)
+		if err == nil {
+			return res, nil
 		}
-	})
+		return nil, localizeError(ctx, err)
+	}
 }
 
-func mapError(err error) error {
+func (errorInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (errorInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := next(ctx, conn); err != nil {
+			return localizeError(ctx, err)
+		}
+		return nil
+	}
+}
+
+func localizeError(ctx context.Context, err error) *connect.Error {
+	mapped := mapError(err)
+	attachPublishViolations(mapped, err)
+	if localizer, ok := i18n.FromContext(ctx); ok {
+		attachLocalizedMessage(mapped, localizer, errorMessageKey(err))
+	}
+	return mapped
+}
+
+func mapError(err error) *connect.Error {
 	var connectErr *connect.Error
 	if errors.As(err, &connectErr) {
-		return err
-	}
-
-	switch {
-	case errors.Is(err, core.ErrValidation):
-		return connect.NewError(connect.CodeInvalidArgument, err)
-	case errors.Is(err, core.ErrInvalidPageToken):
-		return connect.NewError(connect.CodeInvalidArgument, err)
-	case errors.Is(err, core.ErrUploadIdentifierRequired):
-		return connect.NewError(connect.CodeInvalidArgument, err)
-	case errors.Is(err, core.ErrNotFound):
-		return connect.NewError(connect.CodeNotFound, err)
-	case errors.Is(err, core.ErrUploadInvalidState):
-		return connect.NewError(connect.CodeFailedPrecondition, err)
-	default:
-		return connect.NewError(connect.CodeInternal, err)
+		return connectErr
+	}
+
+	for _, candidate := range domainErrors {
+		if errors.Is(err, candidate.err) {
+			return connect.NewError(candidate.code, err)
+		}
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+func errorMessageKey(err error) string {
+	for _, candidate := range domainErrors {
+		if errors.Is(err, candidate.err) {
+			return candidate.key
+		}
+	}
+
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) && connectErr.Code() != connect.CodeInternal && connectErr.Code() != connect.CodeUnknown {
+		return ""
+	}
+	return internalErrorKey
+}
+
+func attachLocalizedMessage(err *connect.Error, localizer *i18n.Localizer, key string) {
+	if key == "" {
+		return
+	}
+	message, ok := localizer.Lookup(key)
+	if !ok {
+		return
+	}
+	detail, detailErr := connect.NewErrorDetail(&errdetails.LocalizedMessage{
+		Locale:  localizer.Locale(),
+		Message: message,
+	})
+	if detailErr != nil {
+		return
+	}
+	err.AddDetail(detail)
+}
+
+// attachPublishViolations lists the violations of a
+// *core.PublishReadinessError as a PreconditionFailure, with the rule as
+// the violation type and the series or episode as its subject.
+func attachPublishViolations(mapped *connect.Error, err error) {
+	var readiness *core.PublishReadinessError
+	if !errors.As(err, &readiness) {
+		return
+	}
+	failure := &errdetails.PreconditionFailure{}
+	for _, violation := range readiness.Violations {
+		subject := "series/" + violation.SeriesID.String()
+		if violation.EpisodeID != uuid.Nil {
+			subject = "episodes/" + violation.EpisodeID.String()
+		}
+		failure.Violations = append(failure.Violations, &errdetails.PreconditionFailure_Violation{
+			Type:        string(violation.Rule),
+			Subject:     subject,
+			Description: violation.Description,
+		})
+	}
+	detail, detailErr := connect.NewErrorDetail(failure)
+	if detailErr != nil {
+		return
 	}
+	mapped.AddDetail(detail)
 }
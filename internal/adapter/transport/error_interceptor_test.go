@@ -0,0 +1,56 @@
+package transport
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+)
+
+func TestErrorInterceptor_PublishViolations(t *testing.T) {
+	seriesID, episodeID := uuid.New(), uuid.New()
+	readiness := &core.PublishReadinessError{Violations: []core.PublishViolation{
+		{Rule: core.PublishRuleCover, SeriesID: seriesID, Description: "series has no cover"},
+		{Rule: core.PublishRuleTranscripts, SeriesID: seriesID, EpisodeID: episodeID, Description: "episode 2 has no transcript"},
+	}}
+	handler := NewErrorInterceptor().WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return nil, readiness
+	})
+
+	_, err := handler(context.Background(), connect.NewRequest(&lessionv1.UpdateSeriesRequest{}))
+	connectErr, ok := err.(*connect.Error)
+	if !ok {
+		t.Fatalf("expected *connect.Error, got %T", err)
+	}
+	if connectErr.Code() != connect.CodeFailedPrecondition {
+		t.Fatalf("code = %v, want %v", connectErr.Code(), connect.CodeFailedPrecondition)
+	}
+
+	details := connectErr.Details()
+	if len(details) != 1 {
+		t.Fatalf("expected 1 error detail, got %d", len(details))
+	}
+	value, err := details[0].Value()
+	if err != nil {
+		t.Fatalf("detail Value() error = %v", err)
+	}
+	failure, ok := value.(*errdetails.PreconditionFailure)
+	if !ok {
+		t.Fatalf("expected PreconditionFailure detail, got %T", value)
+	}
+	violations := failure.GetViolations()
+	if len(violations) != 2 {
+		t.Fatalf("expected 2 violations, got %v", violations)
+	}
+	if violations[0].GetType() != "cover" || violations[0].GetSubject() != "series/"+seriesID.String() {
+		t.Fatalf("unexpected series violation %v", violations[0])
+	}
+	if violations[1].GetType() != "transcripts" || violations[1].GetSubject() != "episodes/"+episodeID.String() || violations[1].GetDescription() != "episode 2 has no transcript" {
+		t.Fatalf("unexpected episode violation %v", violations[1])
+	}
+}
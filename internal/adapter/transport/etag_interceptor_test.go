@@ -0,0 +1,76 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+type stubETagSeriesHandler struct {
+	lessionv1connect.UnimplementedSeriesServiceHandler
+	title *string
+}
+
+func (h stubETagSeriesHandler) GetSeries(ctx context.Context, req *connect.Request[lessionv1.GetSeriesRequest]) (*connect.Response[lessionv1.GetSeriesResponse], error) {
+	return connect.NewResponse(&lessionv1.GetSeriesResponse{Series: &lessionv1.Series{Id: req.Msg.GetSeriesId(), Title: *h.title}}), nil
+}
+
+func (h stubETagSeriesHandler) ListSeries(ctx context.Context, req *connect.Request[lessionv1.ListSeriesRequest]) (*connect.Response[lessionv1.ListSeriesResponse], error) {
+	return connect.NewResponse(&lessionv1.ListSeriesResponse{}), nil
+}
+
+func TestETagInterceptor(t *testing.T) {
+	title := "Coffee"
+	mux := http.NewServeMux()
+	mux.Handle(lessionv1connect.NewSeriesServiceHandler(stubETagSeriesHandler{title: &title}, connect.WithInterceptors(NewETagInterceptor())))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client := lessionv1connect.NewSeriesServiceClient(server.Client(), server.URL)
+	ctx := context.Background()
+	id := uuid.NewString()
+
+	get := func(ifNoneMatch string) *connect.Response[lessionv1.GetSeriesResponse] {
+		t.Helper()
+		req := connect.NewRequest(&lessionv1.GetSeriesRequest{SeriesId: id})
+		if ifNoneMatch != "" {
+			req.Header().Set("If-None-Match", ifNoneMatch)
+		}
+		res, err := client.GetSeries(ctx, req)
+		if err != nil {
+			t.Fatalf("GetSeries() error = %v", err)
+		}
+		return res
+	}
+
+	first := get("")
+	etag := first.Header().Get("ETag")
+	if etag == "" || first.Header().Get(NotModifiedHeader) != "" || first.Msg.GetSeries().GetTitle() != "Coffee" {
+		t.Fatalf("expected a full response with an ETag, got etag %q and %v", etag, first.Msg)
+	}
+
+	cached := get(`"stale", W/` + etag)
+	if cached.Header().Get(NotModifiedHeader) != "true" || cached.Msg.GetSeries() != nil || cached.Header().Get("ETag") != etag {
+		t.Fatalf("expected an empty not-modified response, got %v", cached.Msg)
+	}
+
+	title = "Tea"
+	changed := get(etag)
+	if changed.Header().Get(NotModifiedHeader) != "" || changed.Msg.GetSeries().GetTitle() != "Tea" || changed.Header().Get("ETag") == etag {
+		t.Fatalf("expected a changed series to be sent again, got %v", changed.Msg)
+	}
+
+	list, err := client.ListSeries(ctx, connect.NewRequest(&lessionv1.ListSeriesRequest{}))
+	if err != nil {
+		t.Fatalf("ListSeries() error = %v", err)
+	}
+	if list.Header().Get("ETag") != "" {
+		t.Fatal("expected listings to carry no ETag")
+	}
+}
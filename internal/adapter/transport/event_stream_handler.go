@@ -0,0 +1,254 @@
+package transport
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// EventStreamScope is the API key scope granting access to the event stream;
+// keys scoped to everything may stream too.
+const EventStreamScope = "events/stream"
+
+// defaultEventStreamHeartbeat is how often an idle stream sends a comment so
+// proxies keep the connection open.
+const defaultEventStreamHeartbeat = 15 * time.Second
+
+// defaultStreamedEventTypes are streamed when the types query parameter is absent.
+var defaultStreamedEventTypes = []core.EventType{
+	core.EventTypeSeriesPublished,
+	core.EventTypeEpisodePublished,
+	core.EventTypeAssetReady,
+}
+
+// streamableEventTypes are the event types clients may ask for.
+var streamableEventTypes = map[core.EventType]bool{
+	core.EventTypeSeriesPublished:    true,
+	core.EventTypeEpisodeCreated:     true,
+	core.EventTypeEpisodePublished:   true,
+	core.EventTypeEpisodeDeleted:     true,
+	core.EventTypeAssetReady:         true,
+	core.EventTypeAssetStatusChanged: true,
+}
+
+// EventStreamHandler streams content events as Server-Sent Events so admin
+// dashboards can update live instead of polling the list endpoints. Events
+// include unpublished drafts, so callers need an API key scoped for
+// EventStreamScope, sent as `Authorization: Bearer lsk_...`. Media URLs are
+// left out of streamed assets and episodes; dashboards fetch them through
+// the Connect API, where they are gated.
+type EventStreamHandler struct {
+	listener  core.EventListener
+	keys      core.APIKeyService
+	heartbeat time.Duration
+}
+
+// NewEventStreamHandler constructs an event stream handler backed by the
+// provided listener, authenticating callers with keys.
+func NewEventStreamHandler(listener core.EventListener, keys core.APIKeyService) *EventStreamHandler {
+	return &EventStreamHandler{
+		listener:  listener,
+		keys:      keys,
+		heartbeat: defaultEventStreamHeartbeat,
+	}
+}
+
+// Register mounts the event stream on mux. Events are narrowed with the
+// types query parameter, a comma separated list of event types, and with
+// series_id and asset_id: when either is given, only series and episode
+// events of that series and asset events of that asset are streamed.
+func (h *EventStreamHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /events/stream", h.stream)
+}
+
+// eventStreamFilter selects the events of a stream.
+type eventStreamFilter struct {
+	types    []core.EventType
+	seriesID uuid.UUID
+	assetID  uuid.UUID
+}
+
+func (h *EventStreamHandler) stream(w http.ResponseWriter, r *http.Request) {
+	if err := h.authorize(r); err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+	filter, err := parseEventStreamFilter(r)
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+
+	// Streams outlive any write timeout configured for regular requests.
+	controller := http.NewResponseController(w)
+	_ = controller.SetWriteDeadline(time.Time{})
+
+	events, stop := h.listener.Listen(filter.types...)
+	defer stop()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if _, err := fmt.Fprint(w, ": connected\n\n"); err != nil || controller.Flush() != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		case envelope, ok := <-events:
+			if !ok {
+				return
+			}
+			if !filter.matches(envelope.Event) {
+				continue
+			}
+			data, err := encodeStreamedEvent(envelope.Event)
+			if err != nil {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", envelope.ID, envelope.Event.EventType(), data); err != nil {
+				return
+			}
+		}
+		if controller.Flush() != nil {
+			return
+		}
+	}
+}
+
+// authorize requires the request to carry an API key allowed to stream.
+func (h *EventStreamHandler) authorize(r *http.Request) error {
+	secret, ok := bearerAPIKey(r.Header.Get("Authorization"))
+	if !ok {
+		return fmt.Errorf("%w: an api key is required", core.ErrUnauthenticated)
+	}
+	key, err := h.keys.Authenticate(r.Context(), secret)
+	if err != nil {
+		return err
+	}
+	if !key.Allows(EventStreamScope) {
+		return fmt.Errorf("%w: api key %s is not scoped for %s", core.ErrPermissionDenied, key.Prefix, EventStreamScope)
+	}
+	return nil
+}
+
+func parseEventStreamFilter(r *http.Request) (eventStreamFilter, error) {
+	query := r.URL.Query()
+	filter := eventStreamFilter{types: defaultStreamedEventTypes}
+
+	if raw := strings.TrimSpace(query.Get("types")); raw != "" {
+		filter.types = nil
+		for _, value := range strings.Split(raw, ",") {
+			eventType := core.EventType(strings.TrimSpace(value))
+			if !streamableEventTypes[eventType] {
+				return filter, fmt.Errorf("%w: unsupported event type %q", core.ErrValidation, eventType)
+			}
+			filter.types = append(filter.types, eventType)
+		}
+	}
+
+	for param, target := range map[string]*uuid.UUID{"series_id": &filter.seriesID, "asset_id": &filter.assetID} {
+		value := query.Get(param)
+		if value == "" {
+			continue
+		}
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return filter, fmt.Errorf("%w: invalid %s %q", core.ErrValidation, param, value)
+		}
+		*target = id
+	}
+	return filter, nil
+}
+
+func (f eventStreamFilter) matches(event core.Event) bool {
+	if f.seriesID == uuid.Nil && f.assetID == uuid.Nil {
+		return true
+	}
+	switch e := event.(type) {
+	case core.SeriesPublished:
+		return f.seriesID != uuid.Nil && e.Series.ID == f.seriesID
+	case core.EpisodeCreated:
+		return f.seriesID != uuid.Nil && e.Episode.SeriesID == f.seriesID
+	case core.EpisodePublished:
+		return f.seriesID != uuid.Nil && e.Episode.SeriesID == f.seriesID
+	case core.EpisodeDeleted:
+		return f.seriesID != uuid.Nil && e.Episode.SeriesID == f.seriesID
+	case core.AssetReady:
+		return f.assetID != uuid.Nil && e.Asset.ID == f.assetID
+	case core.AssetStatusChanged:
+		return f.assetID != uuid.Nil && e.Asset.ID == f.assetID
+	default:
+		return false
+	}
+}
+
+// encodeStreamedEvent renders an event's data as JSON, using the same
+// message shapes as the Connect API without media URLs.
+func encodeStreamedEvent(event core.Event) ([]byte, error) {
+	fields := map[string]proto.Message{}
+	extra := map[string]string{}
+	switch e := event.(type) {
+	case core.SeriesPublished:
+		fields["series"] = toProtoSeries(&e.Series, false)
+	case core.EpisodeCreated:
+		fields["episode"] = toProtoEpisode(&e.Episode)
+	case core.EpisodePublished:
+		fields["episode"] = toProtoEpisode(&e.Episode)
+	case core.EpisodeDeleted:
+		fields["episode"] = toProtoEpisode(&e.Episode)
+	case core.AssetReady:
+		fields["asset"] = toProtoAsset(&e.Asset)
+	case core.AssetStatusChanged:
+		fields["asset"] = toProtoAsset(&e.Asset)
+		extra["previousStatus"] = toProtoAssetStatus(e.PreviousStatus).String()
+	default:
+		return nil, fmt.Errorf("event type %q is not streamed", event.EventType())
+	}
+
+	data := make(map[string]json.RawMessage, len(fields)+len(extra))
+	for name, message := range fields {
+		stripMediaURLs(message)
+		encoded, err := protojson.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+		data[name] = encoded
+	}
+	for name, value := range extra {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		data[name] = encoded
+	}
+	return json.Marshal(data)
+}
+
+// stripMediaURLs clears the media URLs of the assets in msg and the playback
+// URLs of its non-preview episodes, as the entitlement interceptor does for
+// callers without a subscription.
+func stripMediaURLs(msg proto.Message) {
+	clearMediaURLs(mediaURLHolders(msg))
+	for _, episode := range collectLockedEpisodes(msg.ProtoReflect(), nil) {
+		episode.Resource.PlaybackUrl = ""
+	}
+}
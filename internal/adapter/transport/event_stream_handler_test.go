@@ -0,0 +1,157 @@
+package transport
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubEventListener struct {
+	events chan core.EventEnvelope
+	types  chan []core.EventType
+}
+
+func (s *stubEventListener) Listen(types ...core.EventType) (<-chan core.EventEnvelope, func()) {
+	s.types <- types
+	return s.events, func() {}
+}
+
+// stubEventStreamKeys authenticates the keys it holds, by secret.
+type stubEventStreamKeys struct {
+	core.APIKeyService
+	keys map[string]core.APIKey
+}
+
+func (s stubEventStreamKeys) Authenticate(ctx context.Context, secret string) (*core.APIKey, error) {
+	key, ok := s.keys[secret]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown api key", core.ErrUnauthenticated)
+	}
+	return &key, nil
+}
+
+const testEventStreamKey = "lsk_dashboard"
+
+var testEventStreamKeys = stubEventStreamKeys{keys: map[string]core.APIKey{
+	testEventStreamKey: {ID: uuid.New(), Prefix: "lsk_dash", Scopes: []string{EventStreamScope}},
+	"lsk_series":       {ID: uuid.New(), Prefix: "lsk_seri", Scopes: []string{"lession.v1.SeriesService"}},
+}}
+
+func TestEventStreamHandler_StreamsMatchingEvents(t *testing.T) {
+	listener := &stubEventListener{events: make(chan core.EventEnvelope, 2), types: make(chan []core.EventType, 1)}
+	mux := http.NewServeMux()
+	NewEventStreamHandler(listener, testEventStreamKeys).Register(mux)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	seriesID := uuid.New()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/events/stream?types=episode.published&series_id="+seriesID.String(), nil)
+	req.Header.Set("Authorization", "Bearer "+testEventStreamKey)
+	res, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatalf("GET /events/stream error = %v", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK || res.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("unexpected response %d %q", res.StatusCode, res.Header.Get("Content-Type"))
+	}
+	if types := <-listener.types; len(types) != 1 || types[0] != core.EventTypeEpisodePublished {
+		t.Fatalf("expected to listen for episode.published, got %v", types)
+	}
+
+	// Only the episode of the requested series is streamed.
+	matching := core.EventEnvelope{ID: uuid.New(), Event: core.EpisodePublished{Episode: core.Episode{ID: uuid.New(), SeriesID: seriesID, Title: "Ordering coffee"}}}
+	listener.events <- core.EventEnvelope{ID: uuid.New(), Event: core.EpisodePublished{Episode: core.Episode{ID: uuid.New(), SeriesID: uuid.New()}}}
+	listener.events <- matching
+
+	reader := bufio.NewReader(res.Body)
+	var lines []string
+	for len(lines) < 3 {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatalf("read stream error = %v", err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" || strings.HasPrefix(line, ":") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if lines[0] != "id: "+matching.ID.String() || lines[1] != "event: episode.published" {
+		t.Fatalf("unexpected event %q", lines)
+	}
+	if !strings.HasPrefix(lines[2], `data: {"episode":{`) || !strings.Contains(lines[2], "Ordering coffee") {
+		t.Fatalf("unexpected event data %q", lines[2])
+	}
+}
+
+func TestEventStreamHandler_RejectsInvalidFilters(t *testing.T) {
+	mux := http.NewServeMux()
+	NewEventStreamHandler(&stubEventListener{}, testEventStreamKeys).Register(mux)
+
+	for _, query := range []string{"types=job.failed", "series_id=nope"} {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/events/stream?"+query, nil)
+		req.Header.Set("Authorization", "Bearer "+testEventStreamKey)
+		mux.ServeHTTP(rec, req)
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected 400 for %q, got %d", query, rec.Code)
+		}
+	}
+}
+
+func TestEventStreamHandler_RequiresAnAPIKeyScopedForTheStream(t *testing.T) {
+	listener := &stubEventListener{types: make(chan []core.EventType, 1)}
+	mux := http.NewServeMux()
+	NewEventStreamHandler(listener, testEventStreamKeys).Register(mux)
+
+	for header, want := range map[string]int{
+		"":                     http.StatusUnauthorized,
+		"Bearer lsk_unknown":   http.StatusUnauthorized,
+		"Bearer lsk_series":    http.StatusForbidden,
+		"Basic dXNlcjpwYXNz==": http.StatusUnauthorized,
+	} {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/events/stream", nil)
+		req.Header.Set(UserHeader, "learner")
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		mux.ServeHTTP(rec, req)
+		if rec.Code != want {
+			t.Fatalf("Authorization %q: expected %d, got %d", header, want, rec.Code)
+		}
+	}
+	if len(listener.types) != 0 {
+		t.Fatal("expected rejected callers not to be subscribed to events")
+	}
+}
+
+func TestEncodeStreamedEvent_StripsMediaURLs(t *testing.T) {
+	asset := core.Asset{ID: uuid.New(), Type: core.AssetTypeAudio, Status: core.AssetStatusReady, PlaybackURL: "https://cdn/a.mp3", HLSManifestURL: "https://cdn/a.m3u8"}
+	episode := core.Episode{ID: uuid.New(), SeriesID: uuid.New(), Title: "Draft", Resource: core.MediaResource{AssetID: asset.ID, PlaybackURL: "https://cdn/a.mp3"}}
+
+	for _, event := range []core.Event{
+		core.AssetReady{Asset: asset},
+		core.AssetStatusChanged{Asset: asset, PreviousStatus: core.AssetStatusProcessing},
+		core.EpisodeCreated{Episode: episode},
+	} {
+		data, err := encodeStreamedEvent(event)
+		if err != nil {
+			t.Fatalf("encodeStreamedEvent(%s) error = %v", event.EventType(), err)
+		}
+		if strings.Contains(string(data), "https://cdn/") {
+			t.Fatalf("%s leaked a media url: %s", event.EventType(), data)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// FeedHandler implements the generated Connect service for feed ingestion.
+type FeedHandler struct {
+	service core.FeedIngestService
+}
+
+// NewFeedHandler constructs a feed handler backed by the provided service.
+func NewFeedHandler(service core.FeedIngestService) *FeedHandler {
+	return &FeedHandler{service: service}
+}
+
+var _ lessionv1connect.FeedServiceHandler = (*FeedHandler)(nil)
+
+// CreateFeedSubscription creates a draft series from a feed and ingests its current items.
+func (h *FeedHandler) CreateFeedSubscription(ctx context.Context, req *connect.Request[lessionv1.CreateFeedSubscriptionRequest]) (*connect.Response[lessionv1.CreateFeedSubscriptionResponse], error) {
+	result, err := h.service.CreateFeedSubscription(ctx, core.FeedSubscriptionRequest{
+		Kind:          fromProtoFeedKind(req.Msg.GetKind()),
+		SourceURL:     req.Msg.GetSourceUrl(),
+		DownloadMedia: req.Msg.GetDownloadMedia(),
+		AutoPublish:   req.Msg.GetAutoPublish(),
+		Slug:          req.Msg.GetSlug(),
+		Level:         req.Msg.GetLevel(),
+		AuthorIDs:     req.Msg.GetAuthorIds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.CreateFeedSubscriptionResponse{
+		Subscription:      toProtoFeedSubscription(&result.Subscription),
+		CreatedEpisodeIds: lo.Map(result.CreatedEpisodeIDs, func(id uuid.UUID, _ int) string { return id.String() }),
+	}), nil
+}
+
+// GetFeedSubscription returns a single subscription by identifier.
+func (h *FeedHandler) GetFeedSubscription(ctx context.Context, req *connect.Request[lessionv1.GetFeedSubscriptionRequest]) (*connect.Response[lessionv1.GetFeedSubscriptionResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetSubscriptionId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid subscription_id %q", core.ErrValidation, req.Msg.GetSubscriptionId())
+	}
+
+	subscription, err := h.service.GetFeedSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetFeedSubscriptionResponse{
+		Subscription: toProtoFeedSubscription(subscription),
+	}), nil
+}
+
+// ListFeedSubscriptions returns subscriptions in creation order.
+func (h *FeedHandler) ListFeedSubscriptions(ctx context.Context, req *connect.Request[lessionv1.ListFeedSubscriptionsRequest]) (*connect.Response[lessionv1.ListFeedSubscriptionsResponse], error) {
+	subscriptions, nextToken, err := h.service.ListFeedSubscriptions(ctx, core.FeedSubscriptionListFilter{
+		PageSize:  int(req.Msg.GetPageSize()),
+		PageToken: req.Msg.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListFeedSubscriptionsResponse{
+		Subscriptions: lo.Map(subscriptions, func(subscription core.FeedSubscription, _ int) *lessionv1.FeedSubscription {
+			return toProtoFeedSubscription(&subscription)
+		}),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+// DeleteFeedSubscription stops syncing a series; its episodes are kept.
+func (h *FeedHandler) DeleteFeedSubscription(ctx context.Context, req *connect.Request[lessionv1.DeleteFeedSubscriptionRequest]) (*connect.Response[lessionv1.DeleteFeedSubscriptionResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetSubscriptionId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid subscription_id %q", core.ErrValidation, req.Msg.GetSubscriptionId())
+	}
+
+	if err := h.service.DeleteFeedSubscription(ctx, id); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lessionv1.DeleteFeedSubscriptionResponse{}), nil
+}
+
+// SyncFeedSubscription adds the new items of a feed.
+func (h *FeedHandler) SyncFeedSubscription(ctx context.Context, req *connect.Request[lessionv1.SyncFeedSubscriptionRequest]) (*connect.Response[lessionv1.SyncFeedSubscriptionResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetSubscriptionId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid subscription_id %q", core.ErrValidation, req.Msg.GetSubscriptionId())
+	}
+
+	result, err := h.service.SyncFeedSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.SyncFeedSubscriptionResponse{
+		Subscription:      toProtoFeedSubscription(&result.Subscription),
+		CreatedEpisodeIds: lo.Map(result.CreatedEpisodeIDs, func(id uuid.UUID, _ int) string { return id.String() }),
+	}), nil
+}
+
+func toProtoFeedSubscription(subscription *core.FeedSubscription) *lessionv1.FeedSubscription {
+	if subscription == nil {
+		return nil
+	}
+	out := &lessionv1.FeedSubscription{
+		Id:            subscription.ID.String(),
+		SeriesId:      subscription.SeriesID.String(),
+		Kind:          toProtoFeedKind(subscription.Kind),
+		SourceUrl:     subscription.SourceURL,
+		DownloadMedia: subscription.DownloadMedia,
+		AutoPublish:   subscription.AutoPublish,
+		LastError:     subscription.LastError,
+		CreatedAt:     timestamppb.New(subscription.CreatedAt),
+		UpdatedAt:     timestamppb.New(subscription.UpdatedAt),
+	}
+	if subscription.LastSyncedAt != nil {
+		out.LastSyncedAt = timestamppb.New(*subscription.LastSyncedAt)
+	}
+	return out
+}
+
+func toProtoFeedKind(kind core.FeedKind) lessionv1.FeedKind {
+	switch kind {
+	case core.FeedKindYouTubePlaylist:
+		return lessionv1.FeedKind_FEED_KIND_YOUTUBE_PLAYLIST
+	case core.FeedKindPodcast:
+		return lessionv1.FeedKind_FEED_KIND_PODCAST
+	default:
+		return lessionv1.FeedKind_FEED_KIND_UNSPECIFIED
+	}
+}
+
+func fromProtoFeedKind(kind lessionv1.FeedKind) core.FeedKind {
+	switch kind {
+	case lessionv1.FeedKind_FEED_KIND_YOUTUBE_PLAYLIST:
+		return core.FeedKindYouTubePlaylist
+	case lessionv1.FeedKind_FEED_KIND_PODCAST:
+		return core.FeedKindPodcast
+	default:
+		return core.FeedKindUnspecified
+	}
+}
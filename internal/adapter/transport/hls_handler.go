@@ -0,0 +1,49 @@
+package transport
+
+import (
+	"net/http"
+	"path"
+	"strings"
+)
+
+// hlsContentTypes gives the content types of HLS files, which the mime
+// package does not know on every platform.
+var hlsContentTypes = map[string]string{
+	".m3u8": "application/vnd.apple.mpegurl",
+	".ts":   "video/mp2t",
+}
+
+// HLSHandler serves the HLS renditions the audio packager writes to a local
+// directory. Only files are served: directory listings and the packager's
+// hidden work directories are not.
+type HLSHandler struct {
+	dir    string
+	prefix string
+}
+
+// NewHLSHandler constructs a handler serving dir under the URL path prefix.
+// An empty dir serves nothing.
+func NewHLSHandler(dir, prefix string) *HLSHandler {
+	return &HLSHandler{
+		dir:    dir,
+		prefix: "/" + strings.Trim(prefix, "/"),
+	}
+}
+
+// Register mounts the renditions on mux.
+func (h *HLSHandler) Register(mux *http.ServeMux) {
+	if h.dir == "" {
+		return
+	}
+	files := http.StripPrefix(h.prefix, http.FileServer(http.Dir(h.dir)))
+	mux.HandleFunc("GET "+h.prefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/") || strings.Contains(r.URL.Path, "/.") {
+			http.NotFound(w, r)
+			return
+		}
+		if contentType, ok := hlsContentTypes[path.Ext(r.URL.Path)]; ok {
+			w.Header().Set("Content-Type", contentType)
+		}
+		files.ServeHTTP(w, r)
+	})
+}
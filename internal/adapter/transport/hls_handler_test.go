@@ -0,0 +1,44 @@
+package transport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestHLSHandler(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "asset", ".tmp-x"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "asset", "master.m3u8"), []byte("#EXTM3U\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	mux := http.NewServeMux()
+	NewHLSHandler(dir, "/hls/").Register(mux)
+
+	tests := []struct {
+		path        string
+		wantStatus  int
+		contentType string
+	}{
+		{path: "/hls/asset/master.m3u8", wantStatus: http.StatusOK, contentType: "application/vnd.apple.mpegurl"},
+		{path: "/hls/asset/", wantStatus: http.StatusNotFound},
+		{path: "/hls/asset/.tmp-x/master.m3u8", wantStatus: http.StatusNotFound},
+		{path: "/hls/other/master.m3u8", wantStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.contentType != "" && rec.Header().Get("Content-Type") != tt.contentType {
+				t.Fatalf("content type = %q, want %q", rec.Header().Get("Content-Type"), tt.contentType)
+			}
+		})
+	}
+}
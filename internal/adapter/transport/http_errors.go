@@ -0,0 +1,27 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// writeHTTPError maps domain errors to status codes for the plain HTTP
+// endpoints. Unexpected errors are not echoed to the client.
+func writeHTTPError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, core.ErrValidation):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, core.ErrNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, core.ErrInvalidState):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, core.ErrUnauthenticated):
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+	case errors.Is(err, core.ErrPermissionDenied), errors.Is(err, core.ErrSubscriptionRequired):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	default:
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,47 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// UserHeader carries the authenticated learner's identifier. It is expected to
+// be set by the gateway in front of the service after authentication.
+const UserHeader = "X-User-Id"
+
+// NewIdentityInterceptor stores the caller named in UserHeader in the request
+// context, for unary and streaming handlers; requests without the header
+// proceed anonymously.
+func NewIdentityInterceptor() connect.Interceptor {
+	return identityInterceptor{}
+}
+
+type identityInterceptor struct{}
+
+func (identityInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return next(callerFromHeader(ctx, req.Header()), req)
+	}
+}
+
+func (identityInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (identityInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(callerFromHeader(ctx, conn.RequestHeader()), conn)
+	}
+}
+
+func callerFromHeader(ctx context.Context, header http.Header) context.Context {
+	if userID := strings.TrimSpace(header.Get(UserHeader)); userID != "" {
+		ctx = core.NewCallerContext(ctx, core.Caller{UserID: userID})
+	}
+	return ctx
+}
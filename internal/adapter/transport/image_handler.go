@@ -0,0 +1,40 @@
+package transport
+
+import (
+	"net/http"
+	"strings"
+)
+
+// ImageHandler serves the image variants the image processor writes to a
+// local directory. Only files are served: directory listings and the
+// processor's hidden work directories are not.
+type ImageHandler struct {
+	dir    string
+	prefix string
+}
+
+// NewImageHandler constructs a handler serving dir under the URL path
+// prefix. An empty dir serves nothing.
+func NewImageHandler(dir, prefix string) *ImageHandler {
+	return &ImageHandler{
+		dir:    dir,
+		prefix: "/" + strings.Trim(prefix, "/"),
+	}
+}
+
+// Register mounts the variants on mux.
+func (h *ImageHandler) Register(mux *http.ServeMux) {
+	if h.dir == "" {
+		return
+	}
+	files := http.StripPrefix(h.prefix, http.FileServer(http.Dir(h.dir)))
+	mux.HandleFunc("GET "+h.prefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/") || strings.Contains(r.URL.Path, "/.") {
+			http.NotFound(w, r)
+			return
+		}
+		// Browsers must not sniff a variant into anything but an image.
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		files.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,66 @@
+package transport
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// ImportHandler implements the generated Connect service for catalog imports.
+type ImportHandler struct {
+	service core.CatalogImportService
+}
+
+// NewImportHandler constructs an import handler backed by the provided service.
+func NewImportHandler(service core.CatalogImportService) *ImportHandler {
+	return &ImportHandler{service: service}
+}
+
+var _ lessionv1connect.ImportServiceHandler = (*ImportHandler)(nil)
+
+// ImportCatalog creates draft series and episodes from a spreadsheet.
+func (h *ImportHandler) ImportCatalog(ctx context.Context, req *connect.Request[lessionv1.ImportCatalogRequest]) (*connect.Response[lessionv1.ImportCatalogResponse], error) {
+	report, err := h.service.ImportCatalog(ctx, core.CatalogImportRequest{
+		Format:    toCoreSpreadsheetFormat(req.Msg.GetFormat()),
+		Data:      req.Msg.GetData(),
+		DryRun:    req.Msg.GetDryRun(),
+		AuthorIDs: req.Msg.GetAuthorIds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &lessionv1.ImportCatalogResponse{
+		Rows:         uint32(report.Rows),
+		SeriesCount:  uint32(report.SeriesCount),
+		EpisodeCount: uint32(report.EpisodeCount),
+		CreatedSeriesIds: lo.Map(report.CreatedSeriesIDs, func(id uuid.UUID, _ int) string {
+			return id.String()
+		}),
+	}
+	for _, rowErr := range report.Errors {
+		res.Errors = append(res.Errors, &lessionv1.ImportRowError{
+			Row:     uint32(rowErr.Row),
+			Column:  rowErr.Column,
+			Message: rowErr.Message,
+		})
+	}
+	return connect.NewResponse(res), nil
+}
+
+func toCoreSpreadsheetFormat(format lessionv1.SpreadsheetFormat) core.SpreadsheetFormat {
+	switch format {
+	case lessionv1.SpreadsheetFormat_SPREADSHEET_FORMAT_CSV:
+		return core.SpreadsheetFormatCSV
+	case lessionv1.SpreadsheetFormat_SPREADSHEET_FORMAT_XLSX:
+		return core.SpreadsheetFormatXLSX
+	default:
+		return core.SpreadsheetFormatUnspecified
+	}
+}
@@ -0,0 +1,68 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// InteractiveTranscriptHandler implements the generated Connect service for
+// interactive transcripts.
+type InteractiveTranscriptHandler struct {
+	service core.InteractiveTranscriptService
+}
+
+// NewInteractiveTranscriptHandler constructs a new interactive transcript handler backed by the provided service.
+func NewInteractiveTranscriptHandler(service core.InteractiveTranscriptService) *InteractiveTranscriptHandler {
+	return &InteractiveTranscriptHandler{service: service}
+}
+
+var _ lessionv1connect.InteractiveTranscriptServiceHandler = (*InteractiveTranscriptHandler)(nil)
+
+// GetInteractiveTranscript splits an episode's transcript into tokens with dictionary lookups.
+func (h *InteractiveTranscriptHandler) GetInteractiveTranscript(ctx context.Context, req *connect.Request[lessionv1.GetInteractiveTranscriptRequest]) (*connect.Response[lessionv1.GetInteractiveTranscriptResponse], error) {
+	episodeID, err := uuid.Parse(req.Msg.GetEpisodeId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+	}
+
+	transcript, err := h.service.GetInteractiveTranscript(ctx, episodeID, req.Msg.GetTranslationLanguage())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetInteractiveTranscriptResponse{
+		Transcript: &lessionv1.InteractiveTranscript{
+			EpisodeId:           transcript.EpisodeID.String(),
+			Language:            transcript.Language,
+			TranslationLanguage: transcript.TranslationLanguage,
+			Segments: lo.Map(transcript.Segments, func(segment core.InteractiveSegment, _ int) *lessionv1.InteractiveSegment {
+				return &lessionv1.InteractiveSegment{
+					Index: int32(segment.Index),
+					Start: durationpb.New(segment.Start),
+					End:   durationpb.New(segment.End),
+					Tokens: lo.Map(segment.Tokens, func(token core.TranscriptToken, _ int) *lessionv1.TranscriptToken {
+						return &lessionv1.TranscriptToken{Text: token.Text, Word: token.Word}
+					}),
+				}
+			}),
+			Entries: lo.Map(transcript.Entries, func(entry core.DictionaryEntry, _ int) *lessionv1.DictionaryEntry {
+				return &lessionv1.DictionaryEntry{
+					Word:         entry.Word,
+					Lemma:        entry.Lemma,
+					PartOfSpeech: entry.PartOfSpeech,
+					Definition:   entry.Definition,
+					Translation:  entry.Translation,
+				}
+			}),
+		},
+	}), nil
+}
@@ -0,0 +1,118 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// JobHandler implements the generated Connect service for background jobs.
+type JobHandler struct {
+	service core.JobService
+}
+
+// NewJobHandler constructs a new job handler backed by the provided service.
+func NewJobHandler(service core.JobService) *JobHandler {
+	return &JobHandler{service: service}
+}
+
+var _ lessionv1connect.JobServiceHandler = (*JobHandler)(nil)
+
+// ListJobs returns jobs, newest first.
+func (h *JobHandler) ListJobs(ctx context.Context, req *connect.Request[lessionv1.ListJobsRequest]) (*connect.Response[lessionv1.ListJobsResponse], error) {
+	jobs, nextToken, err := h.service.ListJobs(ctx, core.JobListFilter{
+		Kinds: req.Msg.GetKinds(),
+		Statuses: lo.Map(req.Msg.GetStatuses(), func(status lessionv1.JobStatus, _ int) core.JobStatus {
+			return fromProtoJobStatus(status)
+		}),
+		PageSize:  int(req.Msg.GetPageSize()),
+		PageToken: req.Msg.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListJobsResponse{
+		Jobs: lo.Map(jobs, func(job core.Job, _ int) *lessionv1.Job {
+			return toProtoJob(&job)
+		}),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+// RetryJob requeues a failed job with a fresh set of attempts.
+func (h *JobHandler) RetryJob(ctx context.Context, req *connect.Request[lessionv1.RetryJobRequest]) (*connect.Response[lessionv1.RetryJobResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetJobId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid job_id %q", core.ErrValidation, req.Msg.GetJobId())
+	}
+
+	job, err := h.service.RetryJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.RetryJobResponse{
+		Job: toProtoJob(job),
+	}), nil
+}
+
+func toProtoJob(job *core.Job) *lessionv1.Job {
+	if job == nil {
+		return nil
+	}
+	out := &lessionv1.Job{
+		Id:        job.ID.String(),
+		Kind:      job.Kind,
+		Payload:   string(job.Payload),
+		Status:    toProtoJobStatus(job.Status),
+		Attempts:  uint32(job.Attempts),
+		LastError: job.LastError,
+		RunAt:     timestamppb.New(job.RunAt),
+		LockedBy:  job.LockedBy,
+		CreatedAt: timestamppb.New(job.CreatedAt),
+		UpdatedAt: timestamppb.New(job.UpdatedAt),
+	}
+	if job.FinishedAt != nil {
+		out.FinishedAt = timestamppb.New(*job.FinishedAt)
+	}
+	return out
+}
+
+func toProtoJobStatus(status core.JobStatus) lessionv1.JobStatus {
+	switch status {
+	case core.JobStatusPending:
+		return lessionv1.JobStatus_JOB_STATUS_PENDING
+	case core.JobStatusRunning:
+		return lessionv1.JobStatus_JOB_STATUS_RUNNING
+	case core.JobStatusSucceeded:
+		return lessionv1.JobStatus_JOB_STATUS_SUCCEEDED
+	case core.JobStatusFailed:
+		return lessionv1.JobStatus_JOB_STATUS_FAILED
+	default:
+		return lessionv1.JobStatus_JOB_STATUS_UNSPECIFIED
+	}
+}
+
+func fromProtoJobStatus(status lessionv1.JobStatus) core.JobStatus {
+	switch status {
+	case lessionv1.JobStatus_JOB_STATUS_PENDING:
+		return core.JobStatusPending
+	case lessionv1.JobStatus_JOB_STATUS_RUNNING:
+		return core.JobStatusRunning
+	case lessionv1.JobStatus_JOB_STATUS_SUCCEEDED:
+		return core.JobStatusSucceeded
+	case lessionv1.JobStatus_JOB_STATUS_FAILED:
+		return core.JobStatusFailed
+	default:
+		return core.JobStatusUnspecified
+	}
+}
@@ -0,0 +1,107 @@
+package transport
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/samber/lo"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// LearnerStatsHandler implements the generated Connect service for learner stats.
+type LearnerStatsHandler struct {
+	service core.LearnerStatsService
+}
+
+// NewLearnerStatsHandler constructs a new learner stats handler backed by the provided service.
+func NewLearnerStatsHandler(service core.LearnerStatsService) *LearnerStatsHandler {
+	return &LearnerStatsHandler{service: service}
+}
+
+var _ lessionv1connect.LearnerStatsServiceHandler = (*LearnerStatsHandler)(nil)
+
+// RecordActivity adds listening minutes and completed episodes to a learner's day.
+func (h *LearnerStatsHandler) RecordActivity(ctx context.Context, req *connect.Request[lessionv1.RecordActivityRequest]) (*connect.Response[lessionv1.RecordActivityResponse], error) {
+	activity := core.LearnerActivity{
+		UserID:            req.Msg.GetUserId(),
+		MinutesListened:   int(req.Msg.GetMinutesListened()),
+		EpisodesCompleted: int(req.Msg.GetEpisodesCompleted()),
+	}
+	if req.Msg.GetOccurredAt() != nil {
+		activity.Day = req.Msg.GetOccurredAt().AsTime()
+	}
+
+	recorded, err := h.service.RecordActivity(ctx, activity)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.RecordActivityResponse{
+		Activity: toProtoLearnerActivity(recorded),
+	}), nil
+}
+
+// GetLearnerStats returns lifetime totals and streaks for a learner.
+func (h *LearnerStatsHandler) GetLearnerStats(ctx context.Context, req *connect.Request[lessionv1.GetLearnerStatsRequest]) (*connect.Response[lessionv1.GetLearnerStatsResponse], error) {
+	stats, err := h.service.GetLearnerStats(ctx, req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetLearnerStatsResponse{
+		Stats: toProtoLearnerStats(stats),
+	}), nil
+}
+
+// ListWeeklySummaries aggregates learner activity into recent weeks.
+func (h *LearnerStatsHandler) ListWeeklySummaries(ctx context.Context, req *connect.Request[lessionv1.ListWeeklySummariesRequest]) (*connect.Response[lessionv1.ListWeeklySummariesResponse], error) {
+	summaries, err := h.service.ListWeeklySummaries(ctx, req.Msg.GetUserId(), int(req.Msg.GetWeeks()))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListWeeklySummariesResponse{
+		Summaries: lo.Map(summaries, func(summary core.WeeklySummary, _ int) *lessionv1.WeeklySummary {
+			return &lessionv1.WeeklySummary{
+				WeekStart:         timestamppb.New(summary.WeekStart),
+				ActiveDays:        int32(summary.ActiveDays),
+				MinutesListened:   int32(summary.MinutesListened),
+				EpisodesCompleted: int32(summary.EpisodesCompleted),
+			}
+		}),
+	}), nil
+}
+
+func toProtoLearnerActivity(activity *core.LearnerActivity) *lessionv1.LearnerActivity {
+	if activity == nil {
+		return nil
+	}
+	return &lessionv1.LearnerActivity{
+		UserId:            activity.UserID,
+		Day:               timestamppb.New(activity.Day),
+		MinutesListened:   int32(activity.MinutesListened),
+		EpisodesCompleted: int32(activity.EpisodesCompleted),
+	}
+}
+
+func toProtoLearnerStats(stats *core.LearnerStats) *lessionv1.LearnerStats {
+	if stats == nil {
+		return nil
+	}
+	pb := &lessionv1.LearnerStats{
+		UserId:                 stats.UserID,
+		CurrentStreakDays:      int32(stats.CurrentStreakDays),
+		LongestStreakDays:      int32(stats.LongestStreakDays),
+		ActiveDays:             int32(stats.ActiveDays),
+		TotalMinutesListened:   int32(stats.TotalMinutesListened),
+		TotalEpisodesCompleted: int32(stats.TotalEpisodesCompleted),
+	}
+	if stats.LastActiveDay != nil {
+		pb.LastActiveDay = timestamppb.New(*stats.LastActiveDay)
+	}
+	return pb
+}
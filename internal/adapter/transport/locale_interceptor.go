@@ -0,0 +1,90 @@
+package transport
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/eslsoft/lession/internal/i18n"
+)
+
+// labelFieldSuffix marks string fields that carry the localized label of the
+// sibling enum field, e.g. status_label for status.
+const labelFieldSuffix = "_label"
+
+// NewLocaleInterceptor resolves the caller's Accept-Language preferences
+// against catalog, stores the localizer in the request context, and fills
+// <enum>_label fields on responses with localized enum labels.
+func NewLocaleInterceptor(catalog *i18n.Catalog) connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if catalog == nil {
+				return next(ctx, req)
+			}
+
+			localizer := catalog.Localizer(req.Header().Get("Accept-Language"))
+			res, err := next(i18n.NewContext(ctx, localizer), req)
+			if err != nil {
+				return res, err
+			}
+
+			if msg, ok := res.Any().(proto.Message); ok {
+				applyEnumLabels(msg.ProtoReflect(), localizer)
+			}
+			if locale := localizer.Locale(); locale != "" {
+				res.Header().Set("Content-Language", locale)
+			}
+			return res, nil
+		}
+	})
+}
+
+// applyEnumLabels walks msg and its nested messages, setting each
+// <enum>_label string field from the enum.<VALUE_NAME> catalog key.
+func applyEnumLabels(msg protoreflect.Message, localizer *i18n.Localizer) {
+	if !msg.IsValid() {
+		return
+	}
+
+	fields := msg.Descriptor().Fields()
+	labels := make(map[protoreflect.FieldDescriptor]string)
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					applyEnumLabels(v.Message(), localizer)
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Kind() == protoreflect.MessageKind {
+				list := value.List()
+				for i := 0; i < list.Len(); i++ {
+					applyEnumLabels(list.Get(i).Message(), localizer)
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			applyEnumLabels(value.Message(), localizer)
+		case fd.Kind() == protoreflect.EnumKind:
+			labelField := fields.ByName(fd.Name() + labelFieldSuffix)
+			if labelField == nil || labelField.Kind() != protoreflect.StringKind || labelField.IsList() {
+				return true
+			}
+			enumValue := fd.Enum().Values().ByNumber(value.Enum())
+			if enumValue == nil {
+				return true
+			}
+			if label, ok := localizer.Lookup("enum." + string(enumValue.Name())); ok {
+				labels[labelField] = label
+			}
+		}
+		return true
+	})
+
+	for fd, label := range labels {
+		msg.Set(fd, protoreflect.ValueOfString(label))
+	}
+}
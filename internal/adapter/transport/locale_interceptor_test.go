@@ -0,0 +1,127 @@
+package transport
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/eslsoft/lession/internal/core"
+	"github.com/eslsoft/lession/internal/i18n"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+)
+
+func TestLocaleInterceptor_FillsEnumLabels(t *testing.T) {
+	interceptor := NewLocaleInterceptor(i18n.NewDefaultCatalog())
+
+	unary := interceptor.WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&lessionv1.GetSeriesResponse{
+			Series: &lessionv1.Series{
+				Status: lessionv1.SeriesStatus_SERIES_STATUS_PUBLISHED,
+				Episodes: []*lessionv1.Episode{
+					{Status: lessionv1.EpisodeStatus_EPISODE_STATUS_DRAFT},
+					{},
+				},
+			},
+		}), nil
+	})
+
+	req := connect.NewRequest(&lessionv1.GetSeriesRequest{})
+	req.Header().Set("Accept-Language", "zh-CN,en;q=0.8")
+
+	res, err := unary(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unary() error = %v", err)
+	}
+
+	series := res.Any().(*lessionv1.GetSeriesResponse).GetSeries()
+	if got := series.GetStatusLabel(); got != "已发布" {
+		t.Fatalf("series status_label = %q, want %q", got, "已发布")
+	}
+	if got := series.GetEpisodes()[0].GetStatusLabel(); got != "草稿" {
+		t.Fatalf("episode status_label = %q, want %q", got, "草稿")
+	}
+	if got := series.GetEpisodes()[1].GetStatusLabel(); got != "" {
+		t.Fatalf("unspecified status_label = %q, want empty", got)
+	}
+	if got := res.Header().Get("Content-Language"); got != "zh" {
+		t.Fatalf("Content-Language = %q, want zh", got)
+	}
+}
+
+func TestErrorInterceptor_AttachesLocalizedMessage(t *testing.T) {
+	catalog := i18n.NewDefaultCatalog()
+
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		err            error
+		wantCode       connect.Code
+		wantLocale     string
+		wantMessage    string
+	}{
+		{
+			name:           "not found in chinese",
+			acceptLanguage: "zh-CN",
+			err:            core.ErrNotFound,
+			wantCode:       connect.CodeNotFound,
+			wantLocale:     "zh",
+			wantMessage:    "未找到请求的资源。",
+		},
+		{
+			name:           "validation falls back to english",
+			acceptLanguage: "fr",
+			err:            core.ErrValidation,
+			wantCode:       connect.CodeInvalidArgument,
+			wantLocale:     "en",
+			wantMessage:    "The request is invalid. Please check the highlighted fields and try again.",
+		},
+		{
+			name:           "unknown error is internal",
+			acceptLanguage: "en",
+			err:            context.DeadlineExceeded,
+			wantCode:       connect.CodeInternal,
+			wantLocale:     "en",
+			wantMessage:    "Something went wrong on our side. Please try again later.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewLocaleInterceptor(catalog).WrapUnary(
+				NewErrorInterceptor().WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+					return nil, tt.err
+				}),
+			)
+
+			req := connect.NewRequest(&lessionv1.GetSeriesRequest{})
+			req.Header().Set("Accept-Language", tt.acceptLanguage)
+
+			_, err := handler(context.Background(), req)
+			connectErr, ok := err.(*connect.Error)
+			if !ok {
+				t.Fatalf("expected *connect.Error, got %T", err)
+			}
+			if connectErr.Code() != tt.wantCode {
+				t.Fatalf("code = %v, want %v", connectErr.Code(), tt.wantCode)
+			}
+
+			details := connectErr.Details()
+			if len(details) != 1 {
+				t.Fatalf("expected 1 error detail, got %d", len(details))
+			}
+			value, err := details[0].Value()
+			if err != nil {
+				t.Fatalf("detail Value() error = %v", err)
+			}
+			localized, ok := value.(*errdetails.LocalizedMessage)
+			if !ok {
+				t.Fatalf("expected LocalizedMessage detail, got %T", value)
+			}
+			if localized.GetLocale() != tt.wantLocale || localized.GetMessage() != tt.wantMessage {
+				t.Fatalf("detail = (%q, %q), want (%q, %q)", localized.GetLocale(), localized.GetMessage(), tt.wantLocale, tt.wantMessage)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// LTIHandler implements the generated Connect service for LMS integrations.
+type LTIHandler struct {
+	service core.LTIService
+}
+
+// NewLTIHandler constructs a new LTI handler backed by the provided service.
+func NewLTIHandler(service core.LTIService) *LTIHandler {
+	return &LTIHandler{service: service}
+}
+
+var _ lessionv1connect.LtiServiceHandler = (*LTIHandler)(nil)
+
+// RegisterLtiPlatform registers an LMS platform.
+func (h *LTIHandler) RegisterLtiPlatform(ctx context.Context, req *connect.Request[lessionv1.RegisterLtiPlatformRequest]) (*connect.Response[lessionv1.RegisterLtiPlatformResponse], error) {
+	platform, err := h.service.RegisterPlatform(ctx, core.LTIPlatform{
+		Issuer:       req.Msg.GetIssuer(),
+		ClientID:     req.Msg.GetClientId(),
+		DeploymentID: req.Msg.GetDeploymentId(),
+		AuthLoginURL: req.Msg.GetAuthLoginUrl(),
+		AuthTokenURL: req.Msg.GetAuthTokenUrl(),
+		JWKSURL:      req.Msg.GetJwksUrl(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lessionv1.RegisterLtiPlatformResponse{Platform: toProtoLTIPlatform(platform)}), nil
+}
+
+// ListLtiPlatforms returns every registered platform.
+func (h *LTIHandler) ListLtiPlatforms(ctx context.Context, _ *connect.Request[lessionv1.ListLtiPlatformsRequest]) (*connect.Response[lessionv1.ListLtiPlatformsResponse], error) {
+	platforms, err := h.service.ListPlatforms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lessionv1.ListLtiPlatformsResponse{
+		Platforms: lo.Map(platforms, func(platform core.LTIPlatform, _ int) *lessionv1.LtiPlatform {
+			return toProtoLTIPlatform(&platform)
+		}),
+	}), nil
+}
+
+// GetLtiLaunch returns a launch so the embedded player can open its episode.
+func (h *LTIHandler) GetLtiLaunch(ctx context.Context, req *connect.Request[lessionv1.GetLtiLaunchRequest]) (*connect.Response[lessionv1.GetLtiLaunchResponse], error) {
+	launchID, err := parseLTILaunchID(req.Msg.GetLaunchId())
+	if err != nil {
+		return nil, err
+	}
+
+	launch, err := h.service.GetLaunch(ctx, launchID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lessionv1.GetLtiLaunchResponse{Launch: toProtoLTILaunch(launch)}), nil
+}
+
+// CreateLtiDeepLinkingResponse signs the episodes an instructor picked.
+func (h *LTIHandler) CreateLtiDeepLinkingResponse(ctx context.Context, req *connect.Request[lessionv1.CreateLtiDeepLinkingResponseRequest]) (*connect.Response[lessionv1.CreateLtiDeepLinkingResponseResponse], error) {
+	launchID, err := parseLTILaunchID(req.Msg.GetLaunchId())
+	if err != nil {
+		return nil, err
+	}
+	episodeIDs := make([]uuid.UUID, 0, len(req.Msg.GetEpisodeIds()))
+	for _, raw := range req.Msg.GetEpisodeIds() {
+		episodeID, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, raw)
+		}
+		episodeIDs = append(episodeIDs, episodeID)
+	}
+
+	response, err := h.service.CreateDeepLinkingResponse(ctx, launchID, episodeIDs)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lessionv1.CreateLtiDeepLinkingResponseResponse{
+		ReturnUrl: response.ReturnURL,
+		Jwt:       response.JWT,
+	}), nil
+}
+
+// SubmitLtiScore posts the learner's completion to the platform gradebook.
+func (h *LTIHandler) SubmitLtiScore(ctx context.Context, req *connect.Request[lessionv1.SubmitLtiScoreRequest]) (*connect.Response[lessionv1.SubmitLtiScoreResponse], error) {
+	launchID, err := parseLTILaunchID(req.Msg.GetLaunchId())
+	if err != nil {
+		return nil, err
+	}
+
+	launch, err := h.service.SubmitScore(ctx, launchID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lessionv1.SubmitLtiScoreResponse{Launch: toProtoLTILaunch(launch)}), nil
+}
+
+func parseLTILaunchID(raw string) (uuid.UUID, error) {
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: invalid launch_id %q", core.ErrValidation, raw)
+	}
+	return id, nil
+}
+
+func toProtoLTIPlatform(platform *core.LTIPlatform) *lessionv1.LtiPlatform {
+	if platform == nil {
+		return nil
+	}
+	return &lessionv1.LtiPlatform{
+		Id:           platform.ID.String(),
+		Issuer:       platform.Issuer,
+		ClientId:     platform.ClientID,
+		DeploymentId: platform.DeploymentID,
+		AuthLoginUrl: platform.AuthLoginURL,
+		AuthTokenUrl: platform.AuthTokenURL,
+		JwksUrl:      platform.JWKSURL,
+		CreatedAt:    timestamppb.New(platform.CreatedAt),
+	}
+}
+
+func toProtoLTILaunch(launch *core.LTILaunch) *lessionv1.LtiLaunch {
+	if launch == nil {
+		return nil
+	}
+	pb := &lessionv1.LtiLaunch{
+		Id:                   launch.ID.String(),
+		PlatformId:           launch.PlatformID.String(),
+		MessageType:          toProtoLTIMessageType(launch.Type),
+		UserId:               launch.UserID,
+		GradePassbackEnabled: launch.LineItemURL != "",
+		CreatedAt:            timestamppb.New(launch.CreatedAt),
+	}
+	if launch.EpisodeID != uuid.Nil {
+		pb.EpisodeId = launch.EpisodeID.String()
+	}
+	if launch.ScoreSubmittedAt != nil {
+		pb.ScoreSubmittedAt = timestamppb.New(*launch.ScoreSubmittedAt)
+	}
+	return pb
+}
+
+func toProtoLTIMessageType(messageType core.LTIMessageType) lessionv1.LtiMessageType {
+	switch messageType {
+	case core.LTIMessageTypeResourceLink:
+		return lessionv1.LtiMessageType_LTI_MESSAGE_TYPE_RESOURCE_LINK
+	case core.LTIMessageTypeDeepLinking:
+		return lessionv1.LtiMessageType_LTI_MESSAGE_TYPE_DEEP_LINKING
+	default:
+		return lessionv1.LtiMessageType_LTI_MESSAGE_TYPE_UNSPECIFIED
+	}
+}
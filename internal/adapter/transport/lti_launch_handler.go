@@ -0,0 +1,82 @@
+package transport
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// LTILaunchHandler serves the LTI 1.3 browser flow. Platforms drive it with form
+// posts and redirects, so these are plain HTTP endpoints rather than Connect RPCs.
+type LTILaunchHandler struct {
+	service   core.LTIService
+	playerURL string
+}
+
+// NewLTILaunchHandler constructs a launch handler that hands verified launches to the
+// web player served at playerURL.
+func NewLTILaunchHandler(service core.LTIService, playerURL string) *LTILaunchHandler {
+	return &LTILaunchHandler{
+		service:   service,
+		playerURL: strings.TrimRight(playerURL, "/"),
+	}
+}
+
+// Register mounts the LTI endpoints on mux.
+func (h *LTILaunchHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /lti/v1/login", h.login)
+	mux.HandleFunc("POST /lti/v1/login", h.login)
+	mux.HandleFunc("POST /lti/v1/launch", h.launch)
+	mux.HandleFunc("GET /lti/v1/jwks", h.keySet)
+}
+
+// login answers the platform's third-party login initiation by redirecting
+// the browser to the platform's OIDC authorization endpoint.
+func (h *LTILaunchHandler) login(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	redirect, err := h.service.InitiateLogin(r.Context(), core.LTILoginRequest{
+		Issuer:         r.Form.Get("iss"),
+		ClientID:       r.Form.Get("client_id"),
+		LoginHint:      r.Form.Get("login_hint"),
+		LTIMessageHint: r.Form.Get("lti_message_hint"),
+		TargetLinkURI:  r.Form.Get("target_link_uri"),
+	})
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+	http.Redirect(w, r, redirect, http.StatusFound)
+}
+
+// launch verifies the id_token the platform posted and sends the browser to
+// the player, which loads the launch to open the episode or the picker.
+func (h *LTILaunchHandler) launch(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	launch, err := h.service.Launch(r.Context(), r.PostForm.Get("id_token"), r.PostForm.Get("state"))
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+	http.Redirect(w, r, h.playerURL+"/lti/launches/"+launch.ID.String(), http.StatusSeeOther)
+}
+
+// keySet publishes the tool's public keys for platforms to verify its messages.
+func (h *LTILaunchHandler) keySet(w http.ResponseWriter, _ *http.Request) {
+	body, err := h.service.PublicKeySet()
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	_, _ = w.Write(body)
+}
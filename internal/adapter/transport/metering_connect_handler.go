@@ -0,0 +1,161 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// MeteringHandler implements the generated Connect service for usage metering.
+type MeteringHandler struct {
+	service core.MeteringService
+}
+
+// NewMeteringHandler constructs a new metering handler backed by the provided service.
+func NewMeteringHandler(service core.MeteringService) *MeteringHandler {
+	return &MeteringHandler{service: service}
+}
+
+var _ lessionv1connect.MeteringServiceHandler = (*MeteringHandler)(nil)
+
+// RecordUsage reports a metered quantity for a tenant.
+func (h *MeteringHandler) RecordUsage(ctx context.Context, req *connect.Request[lessionv1.RecordUsageRequest]) (*connect.Response[lessionv1.RecordUsageResponse], error) {
+	record := core.UsageRecord{
+		TenantID: req.Msg.GetTenantId(),
+		Metric:   fromProtoUsageMetric(req.Msg.GetMetric()),
+		Quantity: req.Msg.GetQuantity(),
+	}
+	if req.Msg.GetOccurredAt() != nil {
+		record.OccurredAt = req.Msg.GetOccurredAt().AsTime()
+	}
+
+	if err := h.service.RecordUsage(ctx, record); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.RecordUsageResponse{}), nil
+}
+
+// CreateUsageSnapshot aggregates a closed month of usage into an immutable snapshot.
+func (h *MeteringHandler) CreateUsageSnapshot(ctx context.Context, req *connect.Request[lessionv1.CreateUsageSnapshotRequest]) (*connect.Response[lessionv1.CreateUsageSnapshotResponse], error) {
+	var month time.Time
+	if req.Msg.GetMonth() != nil {
+		month = req.Msg.GetMonth().AsTime()
+	}
+
+	snapshot, err := h.service.CreateUsageSnapshot(ctx, req.Msg.GetTenantId(), month)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.CreateUsageSnapshotResponse{
+		Snapshot: toProtoUsageSnapshot(snapshot),
+	}), nil
+}
+
+// ListUsageSnapshots returns stored snapshots, newest period first.
+func (h *MeteringHandler) ListUsageSnapshots(ctx context.Context, req *connect.Request[lessionv1.ListUsageSnapshotsRequest]) (*connect.Response[lessionv1.ListUsageSnapshotsResponse], error) {
+	snapshots, nextToken, err := h.service.ListUsageSnapshots(ctx, core.UsageSnapshotFilter{
+		PageSize:  int(req.Msg.GetPageSize()),
+		PageToken: req.Msg.GetPageToken(),
+		TenantID:  req.Msg.GetTenantId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListUsageSnapshotsResponse{
+		Snapshots: lo.Map(snapshots, func(snapshot core.UsageSnapshot, _ int) *lessionv1.UsageSnapshot {
+			return toProtoUsageSnapshot(&snapshot)
+		}),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+// ExportInvoiceLineItems converts a snapshot into invoice line items.
+func (h *MeteringHandler) ExportInvoiceLineItems(ctx context.Context, req *connect.Request[lessionv1.ExportInvoiceLineItemsRequest]) (*connect.Response[lessionv1.ExportInvoiceLineItemsResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetSnapshotId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid snapshot_id %q", core.ErrValidation, req.Msg.GetSnapshotId())
+	}
+
+	items, err := h.service.ExportInvoiceLineItems(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ExportInvoiceLineItemsResponse{
+		LineItems: lo.Map(items, func(item core.InvoiceLineItem, _ int) *lessionv1.InvoiceLineItem {
+			return &lessionv1.InvoiceLineItem{
+				Metric:      toProtoUsageMetric(item.Metric),
+				Description: item.Description,
+				Quantity:    item.Quantity,
+				Unit:        item.Unit,
+				PeriodStart: timestamppb.New(item.PeriodStart),
+				PeriodEnd:   timestamppb.New(item.PeriodEnd),
+			}
+		}),
+	}), nil
+}
+
+func toProtoUsageSnapshot(snapshot *core.UsageSnapshot) *lessionv1.UsageSnapshot {
+	if snapshot == nil {
+		return nil
+	}
+	return &lessionv1.UsageSnapshot{
+		Id:          snapshot.ID.String(),
+		TenantId:    snapshot.TenantID,
+		PeriodStart: timestamppb.New(snapshot.PeriodStart),
+		PeriodEnd:   timestamppb.New(snapshot.PeriodEnd),
+		Lines: lo.Map(snapshot.Lines, func(line core.UsageLine, _ int) *lessionv1.UsageLine {
+			return &lessionv1.UsageLine{
+				Metric:   toProtoUsageMetric(line.Metric),
+				Quantity: line.Quantity,
+			}
+		}),
+		CreatedAt: timestamppb.New(snapshot.CreatedAt),
+	}
+}
+
+func fromProtoUsageMetric(metric lessionv1.UsageMetric) core.UsageMetric {
+	switch metric {
+	case lessionv1.UsageMetric_USAGE_METRIC_STORAGE_GB_HOURS:
+		return core.UsageMetricStorageGBHours
+	case lessionv1.UsageMetric_USAGE_METRIC_PROCESSING_MINUTES:
+		return core.UsageMetricProcessingMinutes
+	case lessionv1.UsageMetric_USAGE_METRIC_PLAYBACK_MINUTES:
+		return core.UsageMetricPlaybackMinutes
+	case lessionv1.UsageMetric_USAGE_METRIC_API_CALLS:
+		return core.UsageMetricAPICalls
+	case lessionv1.UsageMetric_USAGE_METRIC_GENERATION_TOKENS:
+		return core.UsageMetricGenerationTokens
+	default:
+		return core.UsageMetricUnspecified
+	}
+}
+
+func toProtoUsageMetric(metric core.UsageMetric) lessionv1.UsageMetric {
+	switch metric {
+	case core.UsageMetricStorageGBHours:
+		return lessionv1.UsageMetric_USAGE_METRIC_STORAGE_GB_HOURS
+	case core.UsageMetricProcessingMinutes:
+		return lessionv1.UsageMetric_USAGE_METRIC_PROCESSING_MINUTES
+	case core.UsageMetricPlaybackMinutes:
+		return lessionv1.UsageMetric_USAGE_METRIC_PLAYBACK_MINUTES
+	case core.UsageMetricAPICalls:
+		return lessionv1.UsageMetric_USAGE_METRIC_API_CALLS
+	case core.UsageMetricGenerationTokens:
+		return lessionv1.UsageMetric_USAGE_METRIC_GENERATION_TOKENS
+	default:
+		return lessionv1.UsageMetric_USAGE_METRIC_UNSPECIFIED
+	}
+}
@@ -0,0 +1,42 @@
+package transport
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// NewMeteringInterceptor counts one API call per unary request made with an
+// API key, for the tenant the key belongs to (see callerTenant). Counts are
+// buffered by usage and recorded in batches, so metering adds no write to
+// the request and never fails it. It must run inside the API key
+// interceptor, which establishes the caller.
+func NewMeteringInterceptor(usage core.UsageCounter) connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			res, err := next(ctx, req)
+			if tenantID := callerTenant(ctx); tenantID != "" && usage != nil {
+				usage.CountUsage(tenantID, core.UsageMetricAPICalls, 1)
+			}
+			return res, err
+		}
+	})
+}
+
+// callerTenant returns the tenant usage of the caller in ctx is metered for:
+// API keys are tenants of their own, identified by the key's ID. Other
+// callers are not metered.
+func callerTenant(ctx context.Context) string {
+	caller, ok := core.CallerFromContext(ctx)
+	if !ok {
+		return ""
+	}
+	keyID, ok := strings.CutPrefix(caller.UserID, APIKeyCallerPrefix)
+	if !ok {
+		return ""
+	}
+	return keyID
+}
@@ -0,0 +1,42 @@
+package transport
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+)
+
+type stubUsageCounter struct {
+	counts map[string]float64
+}
+
+func (s *stubUsageCounter) CountUsage(tenantID string, metric core.UsageMetric, quantity float64) {
+	if s.counts == nil {
+		s.counts = make(map[string]float64)
+	}
+	s.counts[tenantID] += quantity
+}
+
+func TestMeteringInterceptor_CountsAPIKeyCallers(t *testing.T) {
+	usage := &stubUsageCounter{}
+	handler := NewMeteringInterceptor(usage).WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&lessionv1.GetSeriesResponse{}), nil
+	})
+	req := connect.NewRequest(&lessionv1.GetSeriesRequest{})
+
+	apiKey := core.NewCallerContext(context.Background(), core.Caller{UserID: APIKeyCallerPrefix + "key-1", APIKeyScopes: []string{core.APIKeyScopeAll}})
+	learner := core.NewCallerContext(context.Background(), core.Caller{UserID: "learner-1"})
+	for _, ctx := range []context.Context{apiKey, apiKey, learner, context.Background()} {
+		if _, err := handler(ctx, req); err != nil {
+			t.Fatalf("handler() error = %v", err)
+		}
+	}
+
+	if len(usage.counts) != 1 || usage.counts["key-1"] != 2 {
+		t.Fatalf("expected only the API key calls to be counted, got %v", usage.counts)
+	}
+}
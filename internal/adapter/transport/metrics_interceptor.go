@@ -0,0 +1,74 @@
+package transport
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsInterceptor records request counts and latencies per procedure and
+// status code for Prometheus.
+type MetricsInterceptor struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// NewMetricsInterceptor registers the RPC metrics with registerer.
+func NewMetricsInterceptor(registerer prometheus.Registerer) (*MetricsInterceptor, error) {
+	i := &MetricsInterceptor{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "lession_rpc_requests_total",
+			Help: "Connect requests handled, by procedure and status code.",
+		}, []string{"procedure", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "lession_rpc_request_duration_seconds",
+			Help:    "Connect request latency, by procedure and status code.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"procedure", "code"}),
+	}
+	for _, collector := range []prometheus.Collector{i.requests, i.duration} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return i, nil
+}
+
+var _ connect.Interceptor = (*MetricsInterceptor)(nil)
+
+// WrapUnary measures unary calls.
+func (i *MetricsInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		startedAt := time.Now()
+		res, err := next(ctx, req)
+		i.observe(req.Spec().Procedure, err, time.Since(startedAt))
+		return res, err
+	}
+}
+
+// WrapStreamingClient leaves outgoing streams unchanged; the server only
+// measures the calls it handles.
+func (i *MetricsInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler measures streaming calls from start to close.
+func (i *MetricsInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		startedAt := time.Now()
+		err := next(ctx, conn)
+		i.observe(conn.Spec().Procedure, err, time.Since(startedAt))
+		return err
+	}
+}
+
+func (i *MetricsInterceptor) observe(procedure string, err error, elapsed time.Duration) {
+	code := "ok"
+	if err != nil {
+		code = connect.CodeOf(err).String()
+	}
+	i.requests.WithLabelValues(procedure, code).Inc()
+	i.duration.WithLabelValues(procedure, code).Observe(elapsed.Seconds())
+}
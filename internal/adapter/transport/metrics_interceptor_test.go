@@ -0,0 +1,50 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+func TestMetricsInterceptor_CountsByProcedureAndCode(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics, err := NewMetricsInterceptor(registry)
+	if err != nil {
+		t.Fatalf("NewMetricsInterceptor() error = %v", err)
+	}
+
+	episode := &lessionv1.Episode{Id: uuid.NewString()}
+	mux := http.NewServeMux()
+	mux.Handle(lessionv1connect.NewSeriesServiceHandler(
+		stubEpisodeSeriesHandler{episodes: []*lessionv1.Episode{episode}},
+		connect.WithInterceptors(metrics),
+	))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := lessionv1connect.NewSeriesServiceClient(server.Client(), server.URL)
+
+	ctx := context.Background()
+	_, _ = client.GetEpisode(ctx, connect.NewRequest(&lessionv1.GetEpisodeRequest{EpisodeId: episode.GetId()}))
+	_, _ = client.GetEpisode(ctx, connect.NewRequest(&lessionv1.GetEpisodeRequest{EpisodeId: uuid.NewString()}))
+	_, _ = client.GetEpisode(ctx, connect.NewRequest(&lessionv1.GetEpisodeRequest{EpisodeId: uuid.NewString()}))
+
+	procedure := lessionv1connect.SeriesServiceGetEpisodeProcedure
+	if got := testutil.ToFloat64(metrics.requests.WithLabelValues(procedure, "ok")); got != 1 {
+		t.Fatalf("expected 1 successful request, got %v", got)
+	}
+	if got := testutil.ToFloat64(metrics.requests.WithLabelValues(procedure, connect.CodeNotFound.String())); got != 2 {
+		t.Fatalf("expected 2 not found requests, got %v", got)
+	}
+	if got := testutil.CollectAndCount(metrics.duration); got != 2 {
+		t.Fatalf("expected a latency histogram per code, got %d", got)
+	}
+}
@@ -0,0 +1,173 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// ModerationHandler implements the generated Connect service for the moderation queue.
+type ModerationHandler struct {
+	service core.ModerationService
+}
+
+// NewModerationHandler constructs a new moderation handler backed by the provided service.
+func NewModerationHandler(service core.ModerationService) *ModerationHandler {
+	return &ModerationHandler{service: service}
+}
+
+var _ lessionv1connect.ModerationServiceHandler = (*ModerationHandler)(nil)
+
+// ListModerationItems returns queued items, oldest first.
+func (h *ModerationHandler) ListModerationItems(ctx context.Context, req *connect.Request[lessionv1.ListModerationItemsRequest]) (*connect.Response[lessionv1.ListModerationItemsResponse], error) {
+	items, nextToken, err := h.service.ListModerationItems(ctx, core.ModerationListFilter{
+		PageSize:    int(req.Msg.GetPageSize()),
+		PageToken:   req.Msg.GetPageToken(),
+		SubjectType: req.Msg.GetSubjectType(),
+		Statuses: lo.Map(req.Msg.GetStatuses(), func(status lessionv1.ModerationStatus, _ int) core.ModerationStatus {
+			return fromProtoModerationStatus(status)
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListModerationItemsResponse{
+		Items: lo.Map(items, func(item core.ModerationItem, _ int) *lessionv1.ModerationItem {
+			return toProtoModerationItem(&item)
+		}),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+// GetModerationItem returns a single item by identifier.
+func (h *ModerationHandler) GetModerationItem(ctx context.Context, req *connect.Request[lessionv1.GetModerationItemRequest]) (*connect.Response[lessionv1.GetModerationItemResponse], error) {
+	id, err := parseModerationItemID(req.Msg.GetItemId())
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := h.service.GetModerationItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetModerationItemResponse{
+		Item: toProtoModerationItem(item),
+	}), nil
+}
+
+// ApproveModerationItem clears the text for everyone to see.
+func (h *ModerationHandler) ApproveModerationItem(ctx context.Context, req *connect.Request[lessionv1.ApproveModerationItemRequest]) (*connect.Response[lessionv1.ApproveModerationItemResponse], error) {
+	item, err := h.review(ctx, req.Msg.GetItemId(), req.Msg.GetReviewerId(), req.Msg.GetNote(), core.ModerationStatusApproved)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lessionv1.ApproveModerationItemResponse{Item: item}), nil
+}
+
+// RejectModerationItem hides the text until its author edits it.
+func (h *ModerationHandler) RejectModerationItem(ctx context.Context, req *connect.Request[lessionv1.RejectModerationItemRequest]) (*connect.Response[lessionv1.RejectModerationItemResponse], error) {
+	item, err := h.review(ctx, req.Msg.GetItemId(), req.Msg.GetReviewerId(), req.Msg.GetNote(), core.ModerationStatusRejected)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lessionv1.RejectModerationItemResponse{Item: item}), nil
+}
+
+// FlagModerationItem keeps the text hidden and escalates it for a second look.
+func (h *ModerationHandler) FlagModerationItem(ctx context.Context, req *connect.Request[lessionv1.FlagModerationItemRequest]) (*connect.Response[lessionv1.FlagModerationItemResponse], error) {
+	item, err := h.review(ctx, req.Msg.GetItemId(), req.Msg.GetReviewerId(), req.Msg.GetNote(), core.ModerationStatusFlagged)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lessionv1.FlagModerationItemResponse{Item: item}), nil
+}
+
+func (h *ModerationHandler) review(ctx context.Context, itemID, reviewerID, note string, status core.ModerationStatus) (*lessionv1.ModerationItem, error) {
+	id, err := parseModerationItemID(itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := h.service.ReviewModerationItem(ctx, core.ModerationReview{
+		ItemID:     id,
+		ReviewerID: reviewerID,
+		Status:     status,
+		Note:       note,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toProtoModerationItem(item), nil
+}
+
+func parseModerationItemID(value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: invalid item_id %q", core.ErrValidation, value)
+	}
+	return id, nil
+}
+
+func toProtoModerationItem(item *core.ModerationItem) *lessionv1.ModerationItem {
+	if item == nil {
+		return nil
+	}
+	pb := &lessionv1.ModerationItem{
+		Id:          item.ID.String(),
+		SubjectType: item.SubjectType,
+		SubjectId:   item.SubjectID.String(),
+		AuthorId:    item.AuthorID,
+		Text:        item.Text,
+		Status:      toProtoModerationStatus(item.Status),
+		Labels:      item.Labels,
+		Score:       item.Score,
+		ReviewerId:  item.ReviewerID,
+		ReviewNote:  item.ReviewNote,
+		CreatedAt:   timestamppb.New(item.CreatedAt),
+		UpdatedAt:   timestamppb.New(item.UpdatedAt),
+	}
+	if item.ReviewedAt != nil {
+		pb.ReviewedAt = timestamppb.New(*item.ReviewedAt)
+	}
+	return pb
+}
+
+func toProtoModerationStatus(status core.ModerationStatus) lessionv1.ModerationStatus {
+	switch status {
+	case core.ModerationStatusPending:
+		return lessionv1.ModerationStatus_MODERATION_STATUS_PENDING
+	case core.ModerationStatusApproved:
+		return lessionv1.ModerationStatus_MODERATION_STATUS_APPROVED
+	case core.ModerationStatusRejected:
+		return lessionv1.ModerationStatus_MODERATION_STATUS_REJECTED
+	case core.ModerationStatusFlagged:
+		return lessionv1.ModerationStatus_MODERATION_STATUS_FLAGGED
+	default:
+		return lessionv1.ModerationStatus_MODERATION_STATUS_UNSPECIFIED
+	}
+}
+
+func fromProtoModerationStatus(status lessionv1.ModerationStatus) core.ModerationStatus {
+	switch status {
+	case lessionv1.ModerationStatus_MODERATION_STATUS_PENDING:
+		return core.ModerationStatusPending
+	case lessionv1.ModerationStatus_MODERATION_STATUS_APPROVED:
+		return core.ModerationStatusApproved
+	case lessionv1.ModerationStatus_MODERATION_STATUS_REJECTED:
+		return core.ModerationStatusRejected
+	case lessionv1.ModerationStatus_MODERATION_STATUS_FLAGGED:
+		return core.ModerationStatusFlagged
+	default:
+		return core.ModerationStatusUnspecified
+	}
+}
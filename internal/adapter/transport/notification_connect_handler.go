@@ -0,0 +1,245 @@
+package transport
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/samber/lo"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// NotificationHandler implements the generated Connect service for notifications.
+type NotificationHandler struct {
+	service core.NotificationService
+}
+
+// NewNotificationHandler constructs a new notification handler backed by the provided service.
+func NewNotificationHandler(service core.NotificationService) *NotificationHandler {
+	return &NotificationHandler{service: service}
+}
+
+var _ lessionv1connect.NotificationServiceHandler = (*NotificationHandler)(nil)
+
+// GetNotificationPreferences returns a user's notification preferences.
+func (h *NotificationHandler) GetNotificationPreferences(ctx context.Context, req *connect.Request[lessionv1.GetNotificationPreferencesRequest]) (*connect.Response[lessionv1.GetNotificationPreferencesResponse], error) {
+	preferences, err := h.service.GetPreferences(ctx, req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetNotificationPreferencesResponse{
+		Preferences: toProtoNotificationPreferences(preferences),
+	}), nil
+}
+
+// UpdateNotificationPreferences replaces a user's notification preferences.
+func (h *NotificationHandler) UpdateNotificationPreferences(ctx context.Context, req *connect.Request[lessionv1.UpdateNotificationPreferencesRequest]) (*connect.Response[lessionv1.UpdateNotificationPreferencesResponse], error) {
+	msg := req.Msg.GetPreferences()
+	preferences, err := h.service.UpdatePreferences(ctx, core.NotificationPreferences{
+		UserID: msg.GetUserId(),
+		Email:  msg.GetEmail(),
+		Locale: msg.GetLocale(),
+		OptOuts: lo.Map(msg.GetOptOuts(), func(optOut *lessionv1.NotificationOptOut, _ int) core.NotificationOptOut {
+			return core.NotificationOptOut{
+				Kind:    fromProtoNotificationKind(optOut.GetKind()),
+				Channel: fromProtoNotificationChannel(optOut.GetChannel()),
+			}
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.UpdateNotificationPreferencesResponse{
+		Preferences: toProtoNotificationPreferences(preferences),
+	}), nil
+}
+
+// ListNotifications returns a page of a user's notifications.
+func (h *NotificationHandler) ListNotifications(ctx context.Context, req *connect.Request[lessionv1.ListNotificationsRequest]) (*connect.Response[lessionv1.ListNotificationsResponse], error) {
+	notifications, nextToken, err := h.service.ListNotifications(ctx, core.NotificationListFilter{
+		UserID:    req.Msg.GetUserId(),
+		PageSize:  int(req.Msg.GetPageSize()),
+		PageToken: req.Msg.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListNotificationsResponse{
+		Notifications: lo.Map(notifications, func(notification core.Notification, _ int) *lessionv1.Notification {
+			return toProtoNotification(&notification)
+		}),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+// RegisterDevice subscribes a device to push notifications.
+func (h *NotificationHandler) RegisterDevice(ctx context.Context, req *connect.Request[lessionv1.RegisterDeviceRequest]) (*connect.Response[lessionv1.RegisterDeviceResponse], error) {
+	device, err := h.service.RegisterDevice(ctx, core.DeviceToken{
+		UserID:   req.Msg.GetUserId(),
+		Token:    req.Msg.GetToken(),
+		Platform: fromProtoDevicePlatform(req.Msg.GetPlatform()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.RegisterDeviceResponse{
+		Device: toProtoDeviceToken(device),
+	}), nil
+}
+
+// UnregisterDevice stops push notifications to a device.
+func (h *NotificationHandler) UnregisterDevice(ctx context.Context, req *connect.Request[lessionv1.UnregisterDeviceRequest]) (*connect.Response[lessionv1.UnregisterDeviceResponse], error) {
+	if err := h.service.UnregisterDevice(ctx, req.Msg.GetUserId(), req.Msg.GetToken()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&lessionv1.UnregisterDeviceResponse{}), nil
+}
+
+func toProtoNotificationPreferences(preferences *core.NotificationPreferences) *lessionv1.NotificationPreferences {
+	if preferences == nil {
+		return nil
+	}
+	pb := &lessionv1.NotificationPreferences{
+		UserId: preferences.UserID,
+		Email:  preferences.Email,
+		Locale: preferences.Locale,
+		OptOuts: lo.Map(preferences.OptOuts, func(optOut core.NotificationOptOut, _ int) *lessionv1.NotificationOptOut {
+			return &lessionv1.NotificationOptOut{
+				Kind:    toProtoNotificationKind(optOut.Kind),
+				Channel: toProtoNotificationChannel(optOut.Channel),
+			}
+		}),
+	}
+	if !preferences.UpdatedAt.IsZero() {
+		pb.UpdatedAt = timestamppb.New(preferences.UpdatedAt)
+	}
+	return pb
+}
+
+func toProtoNotification(notification *core.Notification) *lessionv1.Notification {
+	if notification == nil {
+		return nil
+	}
+	pb := &lessionv1.Notification{
+		Id:        notification.ID.String(),
+		UserId:    notification.UserID,
+		Kind:      toProtoNotificationKind(notification.Kind),
+		Channel:   toProtoNotificationChannel(notification.Channel),
+		Subject:   notification.Subject,
+		Body:      notification.Body,
+		Status:    toProtoNotificationStatus(notification.Status),
+		Error:     notification.Error,
+		CreatedAt: timestamppb.New(notification.CreatedAt),
+	}
+	if notification.SentAt != nil {
+		pb.SentAt = timestamppb.New(*notification.SentAt)
+	}
+	return pb
+}
+
+func toProtoDeviceToken(device *core.DeviceToken) *lessionv1.DeviceToken {
+	if device == nil {
+		return nil
+	}
+	return &lessionv1.DeviceToken{
+		Token:     device.Token,
+		UserId:    device.UserID,
+		Platform:  toProtoDevicePlatform(device.Platform),
+		CreatedAt: timestamppb.New(device.CreatedAt),
+		UpdatedAt: timestamppb.New(device.UpdatedAt),
+	}
+}
+
+func fromProtoNotificationKind(kind lessionv1.NotificationKind) core.NotificationKind {
+	switch kind {
+	case lessionv1.NotificationKind_NOTIFICATION_KIND_EPISODE_PUBLISHED:
+		return core.NotificationKindEpisodePublished
+	case lessionv1.NotificationKind_NOTIFICATION_KIND_ASSIGNMENT_DUE:
+		return core.NotificationKindAssignmentDue
+	case lessionv1.NotificationKind_NOTIFICATION_KIND_STREAK_REMINDER:
+		return core.NotificationKindStreakReminder
+	default:
+		return core.NotificationKindUnspecified
+	}
+}
+
+func toProtoNotificationKind(kind core.NotificationKind) lessionv1.NotificationKind {
+	switch kind {
+	case core.NotificationKindEpisodePublished:
+		return lessionv1.NotificationKind_NOTIFICATION_KIND_EPISODE_PUBLISHED
+	case core.NotificationKindAssignmentDue:
+		return lessionv1.NotificationKind_NOTIFICATION_KIND_ASSIGNMENT_DUE
+	case core.NotificationKindStreakReminder:
+		return lessionv1.NotificationKind_NOTIFICATION_KIND_STREAK_REMINDER
+	default:
+		return lessionv1.NotificationKind_NOTIFICATION_KIND_UNSPECIFIED
+	}
+}
+
+func fromProtoNotificationChannel(channel lessionv1.NotificationChannel) core.NotificationChannel {
+	switch channel {
+	case lessionv1.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL:
+		return core.NotificationChannelEmail
+	case lessionv1.NotificationChannel_NOTIFICATION_CHANNEL_PUSH:
+		return core.NotificationChannelPush
+	default:
+		return core.NotificationChannelUnspecified
+	}
+}
+
+func toProtoNotificationChannel(channel core.NotificationChannel) lessionv1.NotificationChannel {
+	switch channel {
+	case core.NotificationChannelEmail:
+		return lessionv1.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL
+	case core.NotificationChannelPush:
+		return lessionv1.NotificationChannel_NOTIFICATION_CHANNEL_PUSH
+	default:
+		return lessionv1.NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED
+	}
+}
+
+func toProtoNotificationStatus(status core.NotificationStatus) lessionv1.NotificationStatus {
+	switch status {
+	case core.NotificationStatusPending:
+		return lessionv1.NotificationStatus_NOTIFICATION_STATUS_PENDING
+	case core.NotificationStatusSent:
+		return lessionv1.NotificationStatus_NOTIFICATION_STATUS_SENT
+	case core.NotificationStatusFailed:
+		return lessionv1.NotificationStatus_NOTIFICATION_STATUS_FAILED
+	default:
+		return lessionv1.NotificationStatus_NOTIFICATION_STATUS_UNSPECIFIED
+	}
+}
+
+func fromProtoDevicePlatform(platform lessionv1.DevicePlatform) core.DevicePlatform {
+	switch platform {
+	case lessionv1.DevicePlatform_DEVICE_PLATFORM_ANDROID:
+		return core.DevicePlatformAndroid
+	case lessionv1.DevicePlatform_DEVICE_PLATFORM_IOS:
+		return core.DevicePlatformIOS
+	case lessionv1.DevicePlatform_DEVICE_PLATFORM_WEB:
+		return core.DevicePlatformWeb
+	default:
+		return core.DevicePlatformUnspecified
+	}
+}
+
+func toProtoDevicePlatform(platform core.DevicePlatform) lessionv1.DevicePlatform {
+	switch platform {
+	case core.DevicePlatformAndroid:
+		return lessionv1.DevicePlatform_DEVICE_PLATFORM_ANDROID
+	case core.DevicePlatformIOS:
+		return lessionv1.DevicePlatform_DEVICE_PLATFORM_IOS
+	case core.DevicePlatformWeb:
+		return lessionv1.DevicePlatform_DEVICE_PLATFORM_WEB
+	default:
+		return lessionv1.DevicePlatform_DEVICE_PLATFORM_UNSPECIFIED
+	}
+}
@@ -0,0 +1,134 @@
+package transport
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// OEmbedHandler serves /oembed (https://oembed.com) so shared links to
+// series and episodes unfurl on other sites and their player can be embedded.
+type OEmbedHandler struct {
+	previews core.LinkPreviewService
+}
+
+// NewOEmbedHandler constructs an oEmbed handler backed by the link preview service.
+func NewOEmbedHandler(previews core.LinkPreviewService) *OEmbedHandler {
+	return &OEmbedHandler{previews: previews}
+}
+
+type oembedResponse struct {
+	Type            string `json:"type"`
+	Version         string `json:"version"`
+	Title           string `json:"title,omitempty"`
+	CacheAge        int    `json:"cache_age,omitempty"`
+	ThumbnailURL    string `json:"thumbnail_url,omitempty"`
+	ThumbnailWidth  int    `json:"thumbnail_width,omitempty"`
+	ThumbnailHeight int    `json:"thumbnail_height,omitempty"`
+	HTML            string `json:"html,omitempty"`
+	Width           int    `json:"width,omitempty"`
+	Height          int    `json:"height,omitempty"`
+}
+
+// Register mounts the oEmbed endpoint on mux.
+func (h *OEmbedHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /oembed", h.serve)
+}
+
+func (h *OEmbedHandler) serve(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if format := query.Get("format"); format != "" && format != "json" {
+		http.Error(w, "only the json format is supported", http.StatusNotImplemented)
+		return
+	}
+	rawURL := query.Get("url")
+	if rawURL == "" {
+		writeHTTPError(w, fmt.Errorf("%w: url required", core.ErrValidation))
+		return
+	}
+	maxWidth, err := oembedDimension(query.Get("maxwidth"))
+	if err != nil {
+		writeHTTPError(w, fmt.Errorf("%w: invalid maxwidth", core.ErrValidation))
+		return
+	}
+	maxHeight, err := oembedDimension(query.Get("maxheight"))
+	if err != nil {
+		writeHTTPError(w, fmt.Errorf("%w: invalid maxheight", core.ErrValidation))
+		return
+	}
+
+	preview, err := h.previews.PreviewURL(r.Context(), rawURL)
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+
+	res := oembedResponse{
+		Type:     "link",
+		Version:  "1.0",
+		Title:    preview.Title,
+		CacheAge: int(catalogCacheMaxAge / time.Second),
+	}
+	if preview.ImageURL != "" && fitsWithin(preview.ImageWidth, preview.ImageHeight, maxWidth, maxHeight) {
+		res.ThumbnailURL = preview.ImageURL
+		res.ThumbnailWidth = preview.ImageWidth
+		res.ThumbnailHeight = preview.ImageHeight
+	}
+	if preview.EmbedURL != "" {
+		res.Type = "rich"
+		if preview.MediaType == core.MediaTypeVideo {
+			res.Type = "video"
+		}
+		res.Width, res.Height = scaleToFit(preview.EmbedWidth, preview.EmbedHeight, maxWidth, maxHeight)
+		res.HTML = playerEmbedHTML(*preview, res.Width, res.Height)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", catalogCacheControl)
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+// playerEmbedHTML returns the iframe embedding the player of a preview at the
+// given size, or an empty string when the preview has no player.
+func playerEmbedHTML(preview core.LinkPreview, width, height int) string {
+	if preview.EmbedURL == "" {
+		return ""
+	}
+	return fmt.Sprintf(`<iframe src="%s" width="%d" height="%d" title="%s" frameborder="0" allow="autoplay; encrypted-media; fullscreen; picture-in-picture" allowfullscreen></iframe>`,
+		html.EscapeString(preview.EmbedURL), width, height, html.EscapeString(preview.Title))
+}
+
+// oembedDimension parses a maxwidth or maxheight parameter; zero means no limit.
+func oembedDimension(raw string) (int, error) {
+	if raw == "" {
+		return 0, nil
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("invalid dimension %q", raw)
+	}
+	return value, nil
+}
+
+func fitsWithin(width, height, maxWidth, maxHeight int) bool {
+	return (maxWidth == 0 || width <= maxWidth) && (maxHeight == 0 || height <= maxHeight)
+}
+
+// scaleToFit scales a size down, keeping its aspect ratio, until it fits the
+// limits; zero limits are ignored.
+func scaleToFit(width, height, maxWidth, maxHeight int) (int, int) {
+	if maxWidth > 0 && width > maxWidth {
+		height = height * maxWidth / width
+		width = maxWidth
+	}
+	if maxHeight > 0 && height > maxHeight {
+		width = width * maxHeight / height
+		height = maxHeight
+	}
+	return width, height
+}
@@ -0,0 +1,84 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubLinkPreviewService struct {
+	core.LinkPreviewService
+	previews map[string]core.LinkPreview
+}
+
+func (s stubLinkPreviewService) PreviewURL(ctx context.Context, rawURL string) (*core.LinkPreview, error) {
+	preview, ok := s.previews[rawURL]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	return &preview, nil
+}
+
+func TestOEmbedHandler(t *testing.T) {
+	mux := http.NewServeMux()
+	NewOEmbedHandler(stubLinkPreviewService{previews: map[string]core.LinkPreview{
+		"https://example.com/series/coffee/1": {
+			Title:       `Coffee & "tea"`,
+			ImageURL:    "https://img.example.com/cover.jpg",
+			ImageWidth:  640,
+			ImageHeight: 360,
+			MediaType:   core.MediaTypeVideo,
+			EmbedURL:    "https://example.com/embed/1?a=1&b=2",
+			EmbedWidth:  640,
+			EmbedHeight: 360,
+		},
+		"https://example.com/series/tea": {Title: "Tea"},
+	}}).Register(mux)
+
+	get := func(query url.Values) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/oembed?"+query.Encode(), nil))
+		return rec
+	}
+
+	rec := get(url.Values{"url": {"https://example.com/series/coffee/1"}, "maxwidth": {"320"}})
+	if rec.Code != http.StatusOK || rec.Header().Get("Cache-Control") != catalogCacheControl {
+		t.Fatalf("unexpected response %d %q", rec.Code, rec.Header().Get("Cache-Control"))
+	}
+	var res oembedResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if res.Type != "video" || res.Version != "1.0" || res.Width != 320 || res.Height != 180 {
+		t.Fatalf("unexpected embed %+v", res)
+	}
+	if res.ThumbnailURL != "" {
+		t.Fatalf("expected a thumbnail wider than maxwidth to be left out, got %q", res.ThumbnailURL)
+	}
+	want := `<iframe src="https://example.com/embed/1?a=1&amp;b=2" width="320" height="180" title="Coffee &amp; &#34;tea&#34;"`
+	if !strings.HasPrefix(res.HTML, want) {
+		t.Fatalf("unexpected html %s", res.HTML)
+	}
+
+	rec = get(url.Values{"url": {"https://example.com/series/tea"}})
+	res = oembedResponse{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil || res.Type != "link" || res.HTML != "" || res.Title != "Tea" {
+		t.Fatalf("expected a link without a player, got %+v (%v)", res, err)
+	}
+
+	if rec := get(url.Values{"url": {"https://example.com/other"}}); rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 for an unknown page, got %d", rec.Code)
+	}
+	if rec := get(url.Values{"url": {"https://example.com/series/tea"}, "format": {"xml"}}); rec.Code != http.StatusNotImplemented {
+		t.Fatalf("expected 501 for the xml format, got %d", rec.Code)
+	}
+	if rec := get(url.Values{}); rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 without a url, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,87 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// PackageExportScope is the API key scope granting package downloads; keys
+// scoped to everything may download packages too.
+const PackageExportScope = "exports"
+
+// PackageExportHandler serves SCORM and xAPI packages as zip downloads so
+// they can be uploaded to an LMS as is. Callers are identified by UserHeader
+// or an API key like the Connect services, since packages of episodes other
+// than previews require a subscription.
+type PackageExportHandler struct {
+	service core.PackageExportService
+	keys    core.APIKeyService
+}
+
+// NewPackageExportHandler constructs an export handler backed by the
+// provided service, authenticating API keys with keys.
+func NewPackageExportHandler(service core.PackageExportService, keys core.APIKeyService) *PackageExportHandler {
+	return &PackageExportHandler{service: service, keys: keys}
+}
+
+// Register mounts the export endpoints on mux. The package format is chosen
+// with the format query parameter: "scorm12" or "xapi".
+func (h *PackageExportHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /exports/v1/series/{series_id}/package", h.seriesPackage)
+	mux.HandleFunc("GET /exports/v1/episodes/{episode_id}/package", h.episodePackage)
+}
+
+func (h *PackageExportHandler) seriesPackage(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, "series_id", h.service.ExportSeriesPackage)
+}
+
+func (h *PackageExportHandler) episodePackage(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, "episode_id", h.service.ExportEpisodePackage)
+}
+
+func (h *PackageExportHandler) serve(w http.ResponseWriter, r *http.Request, param string, export func(context.Context, uuid.UUID, core.PackageFormat) (*core.ContentPackage, error)) {
+	id, err := uuid.Parse(r.PathValue(param))
+	if err != nil {
+		writeHTTPError(w, fmt.Errorf("%w: invalid %s %q", core.ErrValidation, param, r.PathValue(param)))
+		return
+	}
+	format, err := parsePackageFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+
+	ctx, err := apiKeyInterceptor{keys: h.keys}.authenticate(callerFromHeader(r.Context(), r.Header), r.Header, PackageExportScope)
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+
+	pkg, err := export(ctx, id, format)
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": pkg.Filename}))
+	w.Header().Set("Content-Length", strconv.Itoa(len(pkg.Data)))
+	_, _ = w.Write(pkg.Data)
+}
+
+func parsePackageFormat(value string) (core.PackageFormat, error) {
+	switch value {
+	case "scorm12":
+		return core.PackageFormatSCORM12, nil
+	case "xapi":
+		return core.PackageFormatXAPI, nil
+	default:
+		return core.PackageFormatUnspecified, fmt.Errorf("%w: format must be \"scorm12\" or \"xapi\"", core.ErrValidation)
+	}
+}
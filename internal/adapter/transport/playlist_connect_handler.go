@@ -0,0 +1,288 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// PlaylistHandler implements the generated Connect service for playlists.
+type PlaylistHandler struct {
+	service core.PlaylistService
+}
+
+// NewPlaylistHandler constructs a new playlist handler backed by the provided service.
+func NewPlaylistHandler(service core.PlaylistService) *PlaylistHandler {
+	return &PlaylistHandler{service: service}
+}
+
+var _ lessionv1connect.PlaylistServiceHandler = (*PlaylistHandler)(nil)
+
+// CreatePlaylist stores a new playlist.
+func (h *PlaylistHandler) CreatePlaylist(ctx context.Context, req *connect.Request[lessionv1.CreatePlaylistRequest]) (*connect.Response[lessionv1.CreatePlaylistResponse], error) {
+	draft, err := fromProtoPlaylistDraft(req.Msg.GetPlaylist())
+	if err != nil {
+		return nil, err
+	}
+
+	playlist, err := h.service.CreatePlaylist(ctx, req.Msg.GetOwnerId(), draft)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.CreatePlaylistResponse{
+		Playlist: toProtoPlaylist(playlist),
+	}), nil
+}
+
+// GetPlaylist returns a single playlist by identifier.
+func (h *PlaylistHandler) GetPlaylist(ctx context.Context, req *connect.Request[lessionv1.GetPlaylistRequest]) (*connect.Response[lessionv1.GetPlaylistResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetPlaylistId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid playlist_id %q", core.ErrValidation, req.Msg.GetPlaylistId())
+	}
+
+	playlist, err := h.service.GetPlaylist(ctx, id, core.PlaylistQueryOptions{
+		IncludeEpisodes: req.Msg.GetIncludeEpisodes(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetPlaylistResponse{
+		Playlist: toProtoPlaylist(playlist),
+	}), nil
+}
+
+// GetSharedPlaylist returns a public playlist by its share slug.
+func (h *PlaylistHandler) GetSharedPlaylist(ctx context.Context, req *connect.Request[lessionv1.GetSharedPlaylistRequest]) (*connect.Response[lessionv1.GetSharedPlaylistResponse], error) {
+	playlist, err := h.service.GetSharedPlaylist(ctx, req.Msg.GetSlug(), core.PlaylistQueryOptions{
+		IncludeEpisodes: req.Msg.GetIncludeEpisodes(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetSharedPlaylistResponse{
+		Playlist: toProtoPlaylist(playlist),
+	}), nil
+}
+
+// ListPlaylists returns playlists, most recently updated first.
+func (h *PlaylistHandler) ListPlaylists(ctx context.Context, req *connect.Request[lessionv1.ListPlaylistsRequest]) (*connect.Response[lessionv1.ListPlaylistsResponse], error) {
+	playlists, nextToken, err := h.service.ListPlaylists(ctx, core.PlaylistListFilter{
+		PageSize:        int(req.Msg.GetPageSize()),
+		PageToken:       req.Msg.GetPageToken(),
+		OwnerID:         req.Msg.GetOwnerId(),
+		IncludeEpisodes: req.Msg.GetIncludeEpisodes(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListPlaylistsResponse{
+		Playlists: lo.Map(playlists, func(playlist core.Playlist, _ int) *lessionv1.Playlist {
+			return toProtoPlaylist(&playlist)
+		}),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+// UpdatePlaylist applies partial updates to playlist metadata and sharing.
+func (h *PlaylistHandler) UpdatePlaylist(ctx context.Context, req *connect.Request[lessionv1.UpdatePlaylistRequest]) (*connect.Response[lessionv1.UpdatePlaylistResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetPlaylistId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid playlist_id %q", core.ErrValidation, req.Msg.GetPlaylistId())
+	}
+
+	existing, err := h.service.GetPlaylist(ctx, id, core.PlaylistQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	mask := req.Msg.GetUpdateMask()
+	if isFieldMaskEmpty(mask) {
+		mask = &fieldmaskpb.FieldMask{
+			Paths: []string{"title", "description", "public", "slug"},
+		}
+	}
+
+	if err := applyPlaylistFieldMask(existing, req.Msg.GetPlaylist(), mask); err != nil {
+		return nil, err
+	}
+
+	updated, err := h.service.UpdatePlaylist(ctx, *existing)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.UpdatePlaylistResponse{
+		Playlist: toProtoPlaylist(updated),
+	}), nil
+}
+
+// DeletePlaylist removes a playlist.
+func (h *PlaylistHandler) DeletePlaylist(ctx context.Context, req *connect.Request[lessionv1.DeletePlaylistRequest]) (*connect.Response[lessionv1.DeletePlaylistResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetPlaylistId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid playlist_id %q", core.ErrValidation, req.Msg.GetPlaylistId())
+	}
+
+	if err := h.service.DeletePlaylist(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.DeletePlaylistResponse{}), nil
+}
+
+// AddPlaylistEpisodes appends episodes to a playlist.
+func (h *PlaylistHandler) AddPlaylistEpisodes(ctx context.Context, req *connect.Request[lessionv1.AddPlaylistEpisodesRequest]) (*connect.Response[lessionv1.AddPlaylistEpisodesResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetPlaylistId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid playlist_id %q", core.ErrValidation, req.Msg.GetPlaylistId())
+	}
+	episodeIDs, err := parseEpisodeIDs(req.Msg.GetEpisodeIds())
+	if err != nil {
+		return nil, err
+	}
+
+	playlist, err := h.service.AddPlaylistEpisodes(ctx, id, episodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.AddPlaylistEpisodesResponse{
+		Playlist: toProtoPlaylist(playlist),
+	}), nil
+}
+
+// RemovePlaylistEpisode removes an episode from a playlist.
+func (h *PlaylistHandler) RemovePlaylistEpisode(ctx context.Context, req *connect.Request[lessionv1.RemovePlaylistEpisodeRequest]) (*connect.Response[lessionv1.RemovePlaylistEpisodeResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetPlaylistId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid playlist_id %q", core.ErrValidation, req.Msg.GetPlaylistId())
+	}
+	episodeID, err := uuid.Parse(req.Msg.GetEpisodeId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+	}
+
+	playlist, err := h.service.RemovePlaylistEpisode(ctx, id, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.RemovePlaylistEpisodeResponse{
+		Playlist: toProtoPlaylist(playlist),
+	}), nil
+}
+
+// ReorderPlaylist rewrites the order of the playlist entries.
+func (h *PlaylistHandler) ReorderPlaylist(ctx context.Context, req *connect.Request[lessionv1.ReorderPlaylistRequest]) (*connect.Response[lessionv1.ReorderPlaylistResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetPlaylistId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid playlist_id %q", core.ErrValidation, req.Msg.GetPlaylistId())
+	}
+	episodeIDs, err := parseEpisodeIDs(req.Msg.GetEpisodeIds())
+	if err != nil {
+		return nil, err
+	}
+
+	playlist, err := h.service.ReorderPlaylist(ctx, id, episodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ReorderPlaylistResponse{
+		Playlist: toProtoPlaylist(playlist),
+	}), nil
+}
+
+func fromProtoPlaylistDraft(draft *lessionv1.PlaylistDraft) (core.PlaylistDraft, error) {
+	if draft == nil {
+		return core.PlaylistDraft{}, fmt.Errorf("%w: playlist is required", core.ErrValidation)
+	}
+	episodeIDs, err := parseEpisodeIDs(draft.GetEpisodeIds())
+	if err != nil {
+		return core.PlaylistDraft{}, err
+	}
+	return core.PlaylistDraft{
+		Title:       draft.GetTitle(),
+		Description: draft.GetDescription(),
+		Public:      draft.GetPublic(),
+		Slug:        draft.GetSlug(),
+		EpisodeIDs:  episodeIDs,
+	}, nil
+}
+
+func applyPlaylistFieldMask(target *core.Playlist, patch *lessionv1.PlaylistDraft, mask *fieldmaskpb.FieldMask) error {
+	for _, path := range mask.Paths {
+		switch strings.ToLower(path) {
+		case "title":
+			target.Title = patch.GetTitle()
+		case "description":
+			target.Description = patch.GetDescription()
+		case "public":
+			target.Public = patch.GetPublic()
+		case "slug":
+			target.Slug = patch.GetSlug()
+		default:
+			return fmt.Errorf("%w: unsupported update path %q", core.ErrValidation, path)
+		}
+	}
+	return nil
+}
+
+func parseEpisodeIDs(values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, value := range values {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, value)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func toProtoPlaylist(playlist *core.Playlist) *lessionv1.Playlist {
+	if playlist == nil {
+		return nil
+	}
+	return &lessionv1.Playlist{
+		Id:          playlist.ID.String(),
+		OwnerId:     playlist.OwnerID,
+		Title:       playlist.Title,
+		Description: playlist.Description,
+		Public:      playlist.Public,
+		Slug:        playlist.Slug,
+		Items: lo.Map(playlist.Items, func(item core.PlaylistItem, _ int) *lessionv1.PlaylistItem {
+			return toProtoPlaylistItem(item)
+		}),
+		ModerationStatus: toProtoModerationStatus(playlist.ModerationStatus),
+		CreatedAt:        timestamppb.New(playlist.CreatedAt),
+		UpdatedAt:        timestamppb.New(playlist.UpdatedAt),
+	}
+}
+
+func toProtoPlaylistItem(item core.PlaylistItem) *lessionv1.PlaylistItem {
+	pb := &lessionv1.PlaylistItem{
+		EpisodeId: item.EpisodeID.String(),
+		Position:  uint32(item.Position),
+	}
+	if item.Episode != nil {
+		pb.Episode = toProtoEpisode(item.Episode)
+		pb.Episode.Transcript = nil
+	}
+	return pb
+}
@@ -0,0 +1,165 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// QuizHandler implements the generated Connect service for episode exercises.
+type QuizHandler struct {
+	service core.ClozeService
+}
+
+// NewQuizHandler constructs a new quiz handler backed by the provided service.
+func NewQuizHandler(service core.ClozeService) *QuizHandler {
+	return &QuizHandler{service: service}
+}
+
+var _ lessionv1connect.QuizServiceHandler = (*QuizHandler)(nil)
+
+// GenerateClozeItems replaces an episode's cloze items with new ones.
+func (h *QuizHandler) GenerateClozeItems(ctx context.Context, req *connect.Request[lessionv1.GenerateClozeItemsRequest]) (*connect.Response[lessionv1.GenerateClozeItemsResponse], error) {
+	episodeID, err := uuid.Parse(req.Msg.GetEpisodeId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+	}
+
+	items, err := h.service.GenerateClozeItems(ctx, episodeID, core.ClozeOptions{
+		MaxItems: int(req.Msg.GetMaxItems()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GenerateClozeItemsResponse{
+		Items: toProtoQuizItems(items),
+	}), nil
+}
+
+// ListQuizItems returns an episode's quiz items in order.
+func (h *QuizHandler) ListQuizItems(ctx context.Context, req *connect.Request[lessionv1.ListQuizItemsRequest]) (*connect.Response[lessionv1.ListQuizItemsResponse], error) {
+	episodeID, err := uuid.Parse(req.Msg.GetEpisodeId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+	}
+	kind, err := fromProtoQuizItemKind(req.Msg.GetKind())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := core.QuizItemFilter{EpisodeID: episodeID, Kind: kind}
+	if req.Msg.GetNeedsReview() {
+		filter.NeedsReview = lo.ToPtr(true)
+	}
+	items, err := h.service.ListQuizItems(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListQuizItemsResponse{
+		Items: toProtoQuizItems(items),
+	}), nil
+}
+
+// UpdateQuizItem applies an editor's changes to a quiz item.
+func (h *QuizHandler) UpdateQuizItem(ctx context.Context, req *connect.Request[lessionv1.UpdateQuizItemRequest]) (*connect.Response[lessionv1.UpdateQuizItemResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetQuizItemId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid quiz_item_id %q", core.ErrValidation, req.Msg.GetQuizItemId())
+	}
+
+	existing, err := h.service.GetQuizItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	mask := req.Msg.GetUpdateMask()
+	if isFieldMaskEmpty(mask) {
+		mask = &fieldmaskpb.FieldMask{
+			Paths: []string{"prompt", "answer", "position", "needs_review"},
+		}
+	}
+
+	if err := applyQuizItemFieldMask(existing, req.Msg.GetItem(), mask); err != nil {
+		return nil, err
+	}
+
+	updated, err := h.service.UpdateQuizItem(ctx, *existing)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.UpdateQuizItemResponse{
+		Item: toProtoQuizItem(*updated),
+	}), nil
+}
+
+func applyQuizItemFieldMask(target *core.QuizItem, patch *lessionv1.QuizItem, mask *fieldmaskpb.FieldMask) error {
+	for _, path := range mask.Paths {
+		switch strings.ToLower(path) {
+		case "prompt":
+			target.Prompt = patch.GetPrompt()
+		case "answer":
+			target.Answer = patch.GetAnswer()
+		case "position":
+			target.Position = int(patch.GetPosition())
+		case "needs_review":
+			target.NeedsReview = patch.GetNeedsReview()
+		default:
+			return fmt.Errorf("%w: unsupported update path %q", core.ErrValidation, path)
+		}
+	}
+	return nil
+}
+
+func toProtoQuizItems(items []core.QuizItem) []*lessionv1.QuizItem {
+	return lo.Map(items, func(item core.QuizItem, _ int) *lessionv1.QuizItem {
+		return toProtoQuizItem(item)
+	})
+}
+
+func toProtoQuizItem(item core.QuizItem) *lessionv1.QuizItem {
+	return &lessionv1.QuizItem{
+		Id:          item.ID.String(),
+		EpisodeId:   item.EpisodeID.String(),
+		Kind:        toProtoQuizItemKind(item.Kind),
+		Position:    uint32(item.Position),
+		Prompt:      item.Prompt,
+		Answer:      item.Answer,
+		Sentence:    item.Sentence,
+		NeedsReview: item.NeedsReview,
+		CreatedAt:   timestamppb.New(item.CreatedAt),
+		UpdatedAt:   timestamppb.New(item.UpdatedAt),
+	}
+}
+
+func fromProtoQuizItemKind(kind lessionv1.QuizItemKind) (core.QuizItemKind, error) {
+	switch kind {
+	case lessionv1.QuizItemKind_QUIZ_ITEM_KIND_UNSPECIFIED:
+		return core.QuizItemKindUnspecified, nil
+	case lessionv1.QuizItemKind_QUIZ_ITEM_KIND_CLOZE:
+		return core.QuizItemKindCloze, nil
+	default:
+		return core.QuizItemKindUnspecified, fmt.Errorf("%w: invalid quiz item kind %d", core.ErrValidation, kind)
+	}
+}
+
+func toProtoQuizItemKind(kind core.QuizItemKind) lessionv1.QuizItemKind {
+	switch kind {
+	case core.QuizItemKindCloze:
+		return lessionv1.QuizItemKind_QUIZ_ITEM_KIND_CLOZE
+	default:
+		return lessionv1.QuizItemKind_QUIZ_ITEM_KIND_UNSPECIFIED
+	}
+}
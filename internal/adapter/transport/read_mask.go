@@ -0,0 +1,107 @@
+package transport
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// readMaskTree holds the paths of a read mask by field name. A nil subtree
+// keeps the whole field.
+type readMaskTree map[string]readMaskTree
+
+// parseReadMask validates the paths of a read mask against md, descending
+// through singular and repeated message fields, and returns them as a tree.
+// An empty mask yields a nil tree, which keeps every field.
+func parseReadMask(mask *fieldmaskpb.FieldMask, md protoreflect.MessageDescriptor) (readMaskTree, error) {
+	if isFieldMaskEmpty(mask) {
+		return nil, nil
+	}
+
+	tree := readMaskTree{}
+	for _, path := range mask.GetPaths() {
+		segments := strings.Split(path, ".")
+		desc, node := md, tree
+		for i, segment := range segments {
+			if desc == nil {
+				return nil, fmt.Errorf("%w: invalid read_mask path %q", core.ErrValidation, path)
+			}
+			fd := desc.Fields().ByName(protoreflect.Name(segment))
+			if fd == nil {
+				return nil, fmt.Errorf("%w: invalid read_mask path %q", core.ErrValidation, path)
+			}
+			desc = nil
+			if fd.Message() != nil && !fd.IsMap() {
+				desc = fd.Message()
+			}
+
+			child, seen := node[segment]
+			if seen && child == nil {
+				// An ancestor already keeps the whole field.
+				break
+			}
+			if i == len(segments)-1 {
+				node[segment] = nil
+				break
+			}
+			if !seen {
+				child = readMaskTree{}
+				node[segment] = child
+			}
+			node = child
+		}
+	}
+	return tree, nil
+}
+
+// wants reports whether the mask asks for any part of the field at path, so
+// callers can skip loading data nobody will see.
+func (t readMaskTree) wants(path string) bool {
+	if t == nil {
+		return true
+	}
+	node := t
+	for _, segment := range strings.Split(path, ".") {
+		child, ok := node[segment]
+		if !ok {
+			return false
+		}
+		if child == nil {
+			return true
+		}
+		node = child
+	}
+	return true
+}
+
+// apply clears every field of msg the mask does not ask for.
+func (t readMaskTree) apply(msg protoreflect.Message) {
+	if t == nil || !msg.IsValid() {
+		return
+	}
+
+	var cleared []protoreflect.FieldDescriptor
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		child, ok := t[string(fd.Name())]
+		switch {
+		case !ok:
+			cleared = append(cleared, fd)
+		case child == nil, fd.Message() == nil, fd.IsMap():
+		case fd.IsList():
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				child.apply(list.Get(i).Message())
+			}
+		default:
+			child.apply(value.Message())
+		}
+		return true
+	})
+	for _, fd := range cleared {
+		msg.Clear(fd)
+	}
+}
@@ -0,0 +1,61 @@
+package transport
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+)
+
+func TestReadMask(t *testing.T) {
+	md := (&lessionv1.Series{}).ProtoReflect().Descriptor()
+
+	tree, err := parseReadMask(&fieldmaskpb.FieldMask{Paths: []string{"title", "episodes.title", "episodes.resource.playback_url"}}, md)
+	if err != nil {
+		t.Fatalf("parseReadMask() error = %v", err)
+	}
+	if !tree.wants("episodes") || tree.wants("episodes.transcript") || tree.wants("summary") {
+		t.Fatalf("unexpected wanted fields for %v", tree)
+	}
+
+	series := &lessionv1.Series{
+		Title:   "Coffee",
+		Summary: "Ordering coffee",
+		Tags:    []string{"food"},
+		Episodes: []*lessionv1.Episode{{
+			Title:      "Espresso",
+			Resource:   &lessionv1.MediaResource{PlaybackUrl: "https://media.example.com/1.m3u8", MimeType: "audio/mpeg"},
+			Transcript: &lessionv1.Transcript{Content: "long transcript"},
+		}},
+	}
+	tree.apply(series.ProtoReflect())
+	if series.GetTitle() != "Coffee" || series.GetSummary() != "" || series.GetTags() != nil {
+		t.Fatalf("expected only the title kept at the top level, got %v", series)
+	}
+	episode := series.GetEpisodes()[0]
+	if episode.GetTitle() != "Espresso" || episode.GetTranscript() != nil || episode.GetResource().GetMimeType() != "" || episode.GetResource().GetPlaybackUrl() == "" {
+		t.Fatalf("expected nested paths applied to each episode, got %v", episode)
+	}
+
+	whole, err := parseReadMask(&fieldmaskpb.FieldMask{Paths: []string{"episodes.title", "episodes"}}, md)
+	if err != nil {
+		t.Fatalf("parseReadMask() error = %v", err)
+	}
+	if !whole.wants("episodes.transcript") {
+		t.Fatal("expected a parent path to keep the whole field")
+	}
+
+	var all readMaskTree
+	if !all.wants("episodes.transcript.content") {
+		t.Fatal("expected an empty mask to keep everything")
+	}
+
+	for _, path := range []string{"nope", "title.length", "episodes.nope"} {
+		if _, err := parseReadMask(&fieldmaskpb.FieldMask{Paths: []string{path}}, md); !errors.Is(err, core.ErrValidation) {
+			t.Fatalf("expected path %q to be rejected, got %v", path, err)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// readinessTimeout bounds how long a readiness probe waits for dependencies.
+const readinessTimeout = 2 * time.Second
+
+// ReadinessCheck probes one dependency the service needs to serve traffic.
+type ReadinessCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// ReadinessHandler serves /readyz, reporting 503 while any dependency is
+// unreachable. Unlike /healthz it tells load balancers to stop routing
+// traffic to an instance rather than to restart it.
+type ReadinessHandler struct {
+	checks []ReadinessCheck
+}
+
+// NewReadinessHandler constructs a readiness handler running the given checks.
+func NewReadinessHandler(checks ...ReadinessCheck) *ReadinessHandler {
+	return &ReadinessHandler{checks: checks}
+}
+
+type readinessResponse struct {
+	Status string `json:"status"`
+	// Checks maps every dependency to "ok" or the reason it failed.
+	Checks map[string]string `json:"checks"`
+}
+
+// Register mounts the readiness endpoint on mux.
+func (h *ReadinessHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /readyz", h.readyz)
+}
+
+func (h *ReadinessHandler) readyz(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+	defer cancel()
+
+	errs := make([]error, len(h.checks))
+	var wg sync.WaitGroup
+	for i, check := range h.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = check.Check(ctx)
+		}()
+	}
+	wg.Wait()
+
+	res := readinessResponse{Status: "ok", Checks: make(map[string]string, len(h.checks))}
+	status := http.StatusOK
+	for i, check := range h.checks {
+		if errs[i] != nil {
+			res.Checks[check.Name] = errs[i].Error()
+			res.Status = "unavailable"
+			status = http.StatusServiceUnavailable
+			continue
+		}
+		res.Checks[check.Name] = "ok"
+	}
+
+	body, _ := json.Marshal(res)
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,41 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestReadinessHandler_ReportsFailingDependencies(t *testing.T) {
+	dbErr := errors.New("connection refused")
+	handler := NewReadinessHandler(
+		ReadinessCheck{Name: "database", Check: func(ctx context.Context) error { return dbErr }},
+		ReadinessCheck{Name: "upload_provider", Check: func(ctx context.Context) error { return nil }},
+	)
+	mux := http.NewServeMux()
+	handler.Register(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503, got %d", rec.Code)
+	}
+	var body readinessResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	if body.Status != "unavailable" || body.Checks["database"] != "connection refused" || body.Checks["upload_provider"] != "ok" {
+		t.Fatalf("unexpected body %+v", body)
+	}
+
+	dbErr = nil
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 once the database is back, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,46 @@
+package transport
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// RecommendationHandler implements the generated Connect service for recommendations.
+type RecommendationHandler struct {
+	service core.RecommendationService
+}
+
+// NewRecommendationHandler constructs a new recommendation handler backed by the provided service.
+func NewRecommendationHandler(service core.RecommendationService) *RecommendationHandler {
+	return &RecommendationHandler{service: service}
+}
+
+var _ lessionv1connect.RecommendationServiceHandler = (*RecommendationHandler)(nil)
+
+// GetRecommendations returns published series ranked for the learner.
+func (h *RecommendationHandler) GetRecommendations(ctx context.Context, req *connect.Request[lessionv1.GetRecommendationsRequest]) (*connect.Response[lessionv1.GetRecommendationsResponse], error) {
+	recommendations, err := h.service.GetRecommendations(ctx, core.RecommendationRequest{
+		UserID: req.Msg.GetUserId(),
+		Level:  req.Msg.GetLevel(),
+		Limit:  int(req.Msg.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetRecommendationsResponse{
+		Recommendations: lo.Map(recommendations, func(recommendation core.Recommendation, _ int) *lessionv1.Recommendation {
+			return &lessionv1.Recommendation{
+				Series:  toProtoSeries(&recommendation.Series, false),
+				Score:   recommendation.Score,
+				Reasons: recommendation.Reasons,
+			}
+		}),
+	}), nil
+}
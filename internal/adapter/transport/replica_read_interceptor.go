@@ -0,0 +1,40 @@
+package transport
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/eslsoft/lession/internal/core"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// ReadConsistencyHeader lets a client that just wrote ask for a read from the
+// primary database with the value "strong", e.g. fetching a series it created.
+const ReadConsistencyHeader = "X-Read-Consistency"
+
+// primaryReadProcedures are read-only procedures whose callers expect to see
+// a write made moments before by another request, so they skip the replica.
+var primaryReadProcedures = map[string]bool{
+	// The player fetches a launch right after the LMS launch recorded it.
+	lessionv1connect.LtiServiceGetLtiLaunchProcedure: true,
+}
+
+// NewReplicaReadInterceptor lets the Get and List procedures read from the
+// database replica, unless the client asks for a strongly consistent read.
+// Every other procedure reads from the primary, so it sees its own writes.
+func NewReplicaReadInterceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			procedure := req.Spec().Procedure
+			method := procedure[strings.LastIndex(procedure, "/")+1:]
+			readOnly := strings.HasPrefix(method, "Get") || strings.HasPrefix(method, "List")
+			strong := strings.EqualFold(strings.TrimSpace(req.Header().Get(ReadConsistencyHeader)), "strong")
+			if readOnly && !strong && !primaryReadProcedures[procedure] {
+				ctx = core.NewReplicaReadContext(ctx)
+			}
+			return next(ctx, req)
+		}
+	})
+}
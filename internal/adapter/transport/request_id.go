@@ -0,0 +1,73 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// RequestIDHeader carries the request identifier. Clients and the gateway
+// may set it; otherwise one is generated. It is always echoed back.
+const RequestIDHeader = "X-Request-Id"
+
+// maxRequestIDLength bounds accepted identifiers so they stay usable as
+// header values and span attributes.
+const maxRequestIDLength = 128
+
+// NewRequestIDMiddleware accepts the identifier in RequestIDHeader, or
+// generates one when it is missing or malformed, stores it in the request
+// context and returns it in the response headers.
+func NewRequestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(RequestIDHeader)
+		if !validRequestID(requestID) {
+			requestID = uuid.NewString()
+		}
+		w.Header().Set(RequestIDHeader, requestID)
+		next.ServeHTTP(w, r.WithContext(core.NewRequestIDContext(r.Context(), requestID)))
+	})
+}
+
+// NewRequestIDInterceptor records the request identifier on the RPC span and
+// attaches it to error responses as a google.rpc.RequestInfo detail. It must
+// run inside the tracing interceptor and outside the error interceptor.
+func NewRequestIDInterceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			requestID, ok := core.RequestIDFromContext(ctx)
+			if !ok {
+				return next(ctx, req)
+			}
+			trace.SpanFromContext(ctx).SetAttributes(attribute.String("request.id", requestID))
+
+			res, err := next(ctx, req)
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) {
+				if detail, detailErr := connect.NewErrorDetail(&errdetails.RequestInfo{RequestId: requestID}); detailErr == nil {
+					connectErr.AddDetail(detail)
+				}
+			}
+			return res, err
+		}
+	})
+}
+
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(requestID); i++ {
+		if c := requestID[i]; c < '!' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
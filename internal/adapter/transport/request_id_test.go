@@ -0,0 +1,71 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle(lessionv1connect.NewSeriesServiceHandler(
+		stubEpisodeSeriesHandler{},
+		connect.WithInterceptors(NewRequestIDInterceptor(), NewErrorInterceptor()),
+	))
+	server := httptest.NewServer(NewRequestIDMiddleware(mux))
+	defer server.Close()
+	client := lessionv1connect.NewSeriesServiceClient(server.Client(), server.URL)
+
+	tests := []struct {
+		name      string
+		requestID string
+		wantSame  bool
+	}{
+		{name: "accepted", requestID: "req-123", wantSame: true},
+		{name: "generated when missing"},
+		{name: "generated when malformed", requestID: "has spaces"},
+		{name: "generated when too long", requestID: strings.Repeat("a", maxRequestIDLength+1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := connect.NewRequest(&lessionv1.GetEpisodeRequest{EpisodeId: uuid.NewString()})
+			if tt.requestID != "" {
+				req.Header().Set(RequestIDHeader, tt.requestID)
+			}
+			_, err := client.GetEpisode(context.Background(), req)
+
+			var connectErr *connect.Error
+			if !errors.As(err, &connectErr) {
+				t.Fatalf("expected a connect error, got %v", err)
+			}
+			got := connectErr.Meta().Get(RequestIDHeader)
+			if tt.wantSame && got != tt.requestID {
+				t.Fatalf("expected request id %q to be echoed, got %q", tt.requestID, got)
+			}
+			if !tt.wantSame && (got == "" || got == tt.requestID) {
+				t.Fatalf("expected a generated request id, got %q", got)
+			}
+
+			var detailID string
+			for _, detail := range connectErr.Details() {
+				value, err := detail.Value()
+				if info, ok := value.(*errdetails.RequestInfo); err == nil && ok {
+					detailID = info.GetRequestId()
+				}
+			}
+			if detailID != got {
+				t.Fatalf("expected RequestInfo detail %q, got %q", got, detailID)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package transport
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/samber/lo"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// SchedulerHandler implements the generated Connect service for scheduled tasks.
+type SchedulerHandler struct {
+	service core.SchedulerService
+}
+
+// NewSchedulerHandler constructs a new scheduler handler backed by the provided service.
+func NewSchedulerHandler(service core.SchedulerService) *SchedulerHandler {
+	return &SchedulerHandler{service: service}
+}
+
+var _ lessionv1connect.SchedulerServiceHandler = (*SchedulerHandler)(nil)
+
+// ListScheduledTasks returns every scheduled task with the state of its last run.
+func (h *SchedulerHandler) ListScheduledTasks(ctx context.Context, req *connect.Request[lessionv1.ListScheduledTasksRequest]) (*connect.Response[lessionv1.ListScheduledTasksResponse], error) {
+	tasks, err := h.service.ListScheduledTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListScheduledTasksResponse{
+		Tasks: lo.Map(tasks, func(task core.ScheduledTask, _ int) *lessionv1.ScheduledTask {
+			return toProtoScheduledTask(&task)
+		}),
+	}), nil
+}
+
+func toProtoScheduledTask(task *core.ScheduledTask) *lessionv1.ScheduledTask {
+	if task == nil {
+		return nil
+	}
+	out := &lessionv1.ScheduledTask{
+		Name:         task.Name,
+		Interval:     durationpb.New(task.Interval),
+		NextRunAt:    timestamppb.New(task.NextRunAt),
+		LastStatus:   toProtoScheduledTaskStatus(task.LastStatus),
+		LastError:    task.LastError,
+		LastDuration: durationpb.New(task.LastDuration),
+		LockedBy:     task.LockedBy,
+	}
+	if task.LastRunAt != nil {
+		out.LastRunAt = timestamppb.New(*task.LastRunAt)
+	}
+	return out
+}
+
+func toProtoScheduledTaskStatus(status core.ScheduledTaskStatus) lessionv1.ScheduledTaskStatus {
+	switch status {
+	case core.ScheduledTaskStatusSucceeded:
+		return lessionv1.ScheduledTaskStatus_SCHEDULED_TASK_STATUS_SUCCEEDED
+	case core.ScheduledTaskStatusFailed:
+		return lessionv1.ScheduledTaskStatus_SCHEDULED_TASK_STATUS_FAILED
+	default:
+		return lessionv1.ScheduledTaskStatus_SCHEDULED_TASK_STATUS_UNSPECIFIED
+	}
+}
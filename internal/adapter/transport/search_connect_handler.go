@@ -0,0 +1,144 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// SearchHandler implements the generated Connect service for content search.
+type SearchHandler struct {
+	service  core.SearchService
+	semantic core.SemanticSearchService
+}
+
+// NewSearchHandler constructs a new search handler backed by the provided
+// keyword and semantic search services.
+func NewSearchHandler(service core.SearchService, semantic core.SemanticSearchService) *SearchHandler {
+	return &SearchHandler{service: service, semantic: semantic}
+}
+
+var _ lessionv1connect.SearchServiceHandler = (*SearchHandler)(nil)
+
+// SearchContent returns the published series and episodes matching a query.
+func (h *SearchHandler) SearchContent(ctx context.Context, req *connect.Request[lessionv1.SearchContentRequest]) (*connect.Response[lessionv1.SearchContentResponse], error) {
+	kinds, err := fromProtoSearchKinds(req.Msg.GetKinds())
+	if err != nil {
+		return nil, err
+	}
+
+	hits, nextToken, err := h.service.SearchContent(ctx, core.SearchQuery{
+		Query:     req.Msg.GetQuery(),
+		Kinds:     kinds,
+		Language:  req.Msg.GetLanguage(),
+		Level:     req.Msg.GetLevel(),
+		Tags:      req.Msg.GetTags(),
+		PageSize:  int(req.Msg.GetPageSize()),
+		PageToken: req.Msg.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.SearchContentResponse{
+		Hits:          lo.Map(hits, toProtoSearchHit),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+// SemanticSearch returns the published series and episodes closest in
+// meaning to a query.
+func (h *SearchHandler) SemanticSearch(ctx context.Context, req *connect.Request[lessionv1.SemanticSearchRequest]) (*connect.Response[lessionv1.SemanticSearchResponse], error) {
+	kinds, err := fromProtoSearchKinds(req.Msg.GetKinds())
+	if err != nil {
+		return nil, err
+	}
+
+	hits, nextToken, err := h.semantic.SemanticSearch(ctx, core.SearchQuery{
+		Query:     req.Msg.GetQuery(),
+		Kinds:     kinds,
+		Language:  req.Msg.GetLanguage(),
+		Level:     req.Msg.GetLevel(),
+		Tags:      req.Msg.GetTags(),
+		PageSize:  int(req.Msg.GetPageSize()),
+		PageToken: req.Msg.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.SemanticSearchResponse{
+		Hits:          lo.Map(hits, toProtoSearchHit),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+// FindSimilarEpisodes returns the published episodes closest in meaning to
+// a published episode.
+func (h *SearchHandler) FindSimilarEpisodes(ctx context.Context, req *connect.Request[lessionv1.FindSimilarEpisodesRequest]) (*connect.Response[lessionv1.FindSimilarEpisodesResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetEpisodeId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+	}
+
+	hits, err := h.semantic.FindSimilarEpisodes(ctx, id, int(req.Msg.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.FindSimilarEpisodesResponse{
+		Hits: lo.Map(hits, toProtoSearchHit),
+	}), nil
+}
+
+func toProtoSearchHit(hit core.SearchHit, _ int) *lessionv1.SearchHit {
+	return &lessionv1.SearchHit{
+		Kind:      toProtoSearchKind(hit.Kind),
+		SeriesId:  hit.SeriesID.String(),
+		EpisodeId: lo.Ternary(hit.EpisodeID == uuid.Nil, "", hit.EpisodeID.String()),
+		Title:     hit.Title,
+		Snippet:   hit.Snippet,
+		Score:     hit.Score,
+	}
+}
+
+func fromProtoSearchKinds(kinds []lessionv1.SearchKind) ([]core.SearchKind, error) {
+	converted := make([]core.SearchKind, 0, len(kinds))
+	for _, kind := range kinds {
+		k, err := fromProtoSearchKind(kind)
+		if err != nil {
+			return nil, err
+		}
+		converted = append(converted, k)
+	}
+	return converted, nil
+}
+
+func fromProtoSearchKind(kind lessionv1.SearchKind) (core.SearchKind, error) {
+	switch kind {
+	case lessionv1.SearchKind_SEARCH_KIND_SERIES:
+		return core.SearchKindSeries, nil
+	case lessionv1.SearchKind_SEARCH_KIND_EPISODE:
+		return core.SearchKindEpisode, nil
+	default:
+		return core.SearchKindUnspecified, fmt.Errorf("%w: invalid search kind %d", core.ErrValidation, kind)
+	}
+}
+
+func toProtoSearchKind(kind core.SearchKind) lessionv1.SearchKind {
+	switch kind {
+	case core.SearchKindSeries:
+		return lessionv1.SearchKind_SEARCH_KIND_SERIES
+	case core.SearchKindEpisode:
+		return lessionv1.SearchKind_SEARCH_KIND_EPISODE
+	default:
+		return lessionv1.SearchKind_SEARCH_KIND_UNSPECIFIED
+	}
+}
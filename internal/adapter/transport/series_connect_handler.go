@@ -36,6 +36,10 @@ func (h *SeriesHandler) ListSeries(ctx context.Context, req *connect.Request[les
 	if err != nil {
 		return nil, err
 	}
+	readMask, err := parseReadMask(req.Msg.GetReadMask(), (&lessionv1.Series{}).ProtoReflect().Descriptor())
+	if err != nil {
+		return nil, err
+	}
 
 	filter := core.SeriesListFilter{
 		PageSize:        int(req.Msg.GetPageSize()),
@@ -43,9 +47,11 @@ func (h *SeriesHandler) ListSeries(ctx context.Context, req *connect.Request[les
 		Statuses:        statuses,
 		Language:        req.Msg.GetLanguage(),
 		Level:           req.Msg.GetLevel(),
+		EstimatedLevel:  req.Msg.GetEstimatedLevel(),
 		Tags:            lo.Map(req.Msg.GetTags(), func(tag string, _ int) string { return tag }),
 		Query:           req.Msg.GetQuery(),
-		IncludeEpisodes: req.Msg.GetIncludeEpisodes(),
+		IncludeEpisodes: req.Msg.GetIncludeEpisodes() && readMask.wants("episodes"),
+		OmitTranscripts: !req.Msg.GetIncludeTranscripts() || !readMask.wants("episodes.transcript"),
 		AuthorIDs:       lo.Map(req.Msg.GetAuthorIds(), func(id string, _ int) string { return id }),
 	}
 
@@ -56,7 +62,16 @@ func (h *SeriesHandler) ListSeries(ctx context.Context, req *connect.Request[les
 
 	protoSeries := make([]*lessionv1.Series, 0, len(seriesList))
 	for i := range seriesList {
-		protoSeries = append(protoSeries, toProtoSeries(&seriesList[i], filter.IncludeEpisodes))
+		series := toProtoSeries(&seriesList[i], filter.IncludeEpisodes)
+		if filter.OmitTranscripts {
+			// Leave transcripts unset rather than empty so clients can tell
+			// them apart from blank ones.
+			for _, episode := range series.Episodes {
+				episode.Transcript = nil
+			}
+		}
+		readMask.apply(series.ProtoReflect())
+		protoSeries = append(protoSeries, series)
 	}
 
 	return connect.NewResponse(&lessionv1.ListSeriesResponse{
@@ -89,17 +104,25 @@ func (h *SeriesHandler) GetSeries(ctx context.Context, req *connect.Request[less
 		return nil, fmt.Errorf("%w: invalid series_id %q", core.ErrValidation, req.Msg.GetSeriesId())
 	}
 
+	readMask, err := parseReadMask(req.Msg.GetReadMask(), (&lessionv1.Series{}).ProtoReflect().Descriptor())
+	if err != nil {
+		return nil, err
+	}
+
 	opts := core.SeriesQueryOptions{
-		IncludeEpisodes: req.Msg.GetIncludeEpisodes(),
+		IncludeEpisodes: req.Msg.GetIncludeEpisodes() && readMask.wants("episodes"),
 		IncludeMetadata: req.Msg.GetIncludeMetadata(),
+		OmitTranscripts: !readMask.wants("episodes.transcript"),
 	}
 	series, err := h.service.GetSeries(ctx, id, opts)
 	if err != nil {
 		return nil, err
 	}
 
+	res := toProtoSeries(series, opts.IncludeEpisodes)
+	readMask.apply(res.ProtoReflect())
 	return connect.NewResponse(&lessionv1.GetSeriesResponse{
-		Series: toProtoSeries(series, opts.IncludeEpisodes),
+		Series: res,
 	}), nil
 }
 
@@ -118,7 +141,7 @@ func (h *SeriesHandler) UpdateSeries(ctx context.Context, req *connect.Request[l
 	mask := req.Msg.GetUpdateMask()
 	if isFieldMaskEmpty(mask) {
 		mask = &fieldmaskpb.FieldMask{
-			Paths: []string{"slug", "title", "summary", "language", "level", "tags", "cover_url", "status", "author_ids"},
+			Paths: []string{"slug", "title", "summary", "language", "level", "tags", "cover_url", "cover_asset_id", "status", "author_ids", "drm_enabled", "branding"},
 		}
 	}
 
@@ -193,7 +216,7 @@ func (h *SeriesHandler) UpdateEpisode(ctx context.Context, req *connect.Request[
 	mask := req.Msg.GetUpdateMask()
 	if isFieldMaskEmpty(mask) {
 		mask = &fieldmaskpb.FieldMask{
-			Paths: []string{"seq", "title", "description", "duration", "status", "resource", "transcript"},
+			Paths: []string{"seq", "title", "description", "duration", "status", "preview", "resource", "transcript"},
 		}
 	}
 
@@ -228,6 +251,77 @@ func (h *SeriesHandler) DeleteEpisode(ctx context.Context, req *connect.Request[
 	}), nil
 }
 
+// RenameTag replaces a tag with a new name across all series.
+func (h *SeriesHandler) RenameTag(ctx context.Context, req *connect.Request[lessionv1.RenameTagRequest]) (*connect.Response[lessionv1.RenameTagResponse], error) {
+	ids, err := h.service.RenameTag(ctx, req.Msg.GetTag(), req.Msg.GetNewTag())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.RenameTagResponse{
+		SeriesIds: lo.Map(ids, func(id uuid.UUID, _ int) string { return id.String() }),
+	}), nil
+}
+
+// MergeTags folds one or more source tags into a target tag across all series.
+func (h *SeriesHandler) MergeTags(ctx context.Context, req *connect.Request[lessionv1.MergeTagsRequest]) (*connect.Response[lessionv1.MergeTagsResponse], error) {
+	ids, err := h.service.MergeTags(ctx, req.Msg.GetSourceTags(), req.Msg.GetTargetTag())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.MergeTagsResponse{
+		SeriesIds: lo.Map(ids, func(id uuid.UUID, _ int) string { return id.String() }),
+	}), nil
+}
+
+// ReassignContent transfers ownership of all series from one author to another.
+func (h *SeriesHandler) ReassignContent(ctx context.Context, req *connect.Request[lessionv1.ReassignContentRequest]) (*connect.Response[lessionv1.ReassignContentResponse], error) {
+	reassignment, err := h.service.ReassignContent(ctx, req.Msg.GetFromAuthorId(), req.Msg.GetToAuthorId())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ReassignContentResponse{
+		Reassignment: toProtoContentReassignment(reassignment),
+	}), nil
+}
+
+// GetEpisodeTextStats counts the words of an episode's transcript.
+func (h *SeriesHandler) GetEpisodeTextStats(ctx context.Context, req *connect.Request[lessionv1.GetEpisodeTextStatsRequest]) (*connect.Response[lessionv1.GetEpisodeTextStatsResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetEpisodeId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+	}
+
+	stats, err := h.service.GetEpisodeTextStats(ctx, id, core.TextStatsOptions{
+		TopWords:           int(req.Msg.GetTopWords()),
+		ExcludeCommonWords: req.Msg.GetExcludeCommonWords(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetEpisodeTextStatsResponse{
+		Stats: &lessionv1.EpisodeTextStats{
+			EpisodeId:        stats.EpisodeID.String(),
+			WordCount:        uint32(stats.WordCount),
+			UniqueWordCount:  uint32(stats.UniqueWordCount),
+			SentenceCount:    uint32(stats.SentenceCount),
+			WordsPerSentence: stats.WordsPerSentence,
+			WordsPerMinute:   stats.WordsPerMinute,
+			TopWords:         toProtoWordFrequencies(stats.TopWords),
+			EstimatedLevel:   stats.EstimatedLevel,
+		},
+	}), nil
+}
+
+func toProtoWordFrequencies(frequencies []core.WordFrequency) []*lessionv1.WordFrequency {
+	return lo.Map(frequencies, func(frequency core.WordFrequency, _ int) *lessionv1.WordFrequency {
+		return &lessionv1.WordFrequency{Word: frequency.Word, Count: uint32(frequency.Count)}
+	})
+}
+
 func fromProtoSeriesDraft(draft *lessionv1.SeriesDraft) (core.SeriesDraft, error) {
 	if draft == nil {
 		return core.SeriesDraft{}, fmt.Errorf("%w: series draft required", core.ErrValidation)
@@ -237,6 +331,16 @@ func fromProtoSeriesDraft(draft *lessionv1.SeriesDraft) (core.SeriesDraft, error
 		return core.SeriesDraft{}, err
 	}
 
+	coverAssetID, err := parseImageAssetID("cover_asset_id", draft.GetCoverAssetId())
+	if err != nil {
+		return core.SeriesDraft{}, err
+	}
+
+	branding, err := fromProtoSeriesBranding(draft.GetBranding())
+	if err != nil {
+		return core.SeriesDraft{}, err
+	}
+
 	episodes := make([]core.EpisodeDraft, 0, len(draft.GetEpisodes()))
 	for _, ep := range draft.GetEpisodes() {
 		episodeDraft, err := fromProtoEpisodeDraft(ep)
@@ -247,16 +351,19 @@ func fromProtoSeriesDraft(draft *lessionv1.SeriesDraft) (core.SeriesDraft, error
 	}
 
 	return core.SeriesDraft{
-		Slug:      draft.GetSlug(),
-		Title:     draft.GetTitle(),
-		Summary:   draft.GetSummary(),
-		Language:  draft.GetLanguage(),
-		Level:     draft.GetLevel(),
-		Tags:      lo.Map(draft.GetTags(), func(tag string, _ int) string { return tag }),
-		CoverURL:  draft.GetCoverUrl(),
-		Status:    status,
-		AuthorIDs: lo.Map(draft.GetAuthorIds(), func(id string, _ int) string { return id }),
-		Episodes:  episodes,
+		Slug:         draft.GetSlug(),
+		Title:        draft.GetTitle(),
+		Summary:      draft.GetSummary(),
+		Language:     draft.GetLanguage(),
+		Level:        draft.GetLevel(),
+		Tags:         lo.Map(draft.GetTags(), func(tag string, _ int) string { return tag }),
+		CoverURL:     draft.GetCoverUrl(),
+		CoverAssetID: coverAssetID,
+		Status:       status,
+		DRMEnabled:   draft.GetDrmEnabled(),
+		Branding:     branding,
+		AuthorIDs:    lo.Map(draft.GetAuthorIds(), func(id string, _ int) string { return id }),
+		Episodes:     episodes,
 	}, nil
 }
 
@@ -298,6 +405,7 @@ func fromProtoEpisodeDraft(draft *lessionv1.EpisodeDraft) (core.EpisodeDraft, er
 		Description: draft.GetDescription(),
 		Duration:    duration,
 		Status:      status,
+		Preview:     draft.GetPreview(),
 		Resource:    resource,
 		Transcript:  transcript,
 	}, nil
@@ -363,6 +471,34 @@ func applySeriesFieldMask(target *core.Series, patch *lessionv1.SeriesDraft, mas
 			target.Tags = lo.Ternary(len(tags) > 0, tags, []string(nil))
 		case "cover_url":
 			target.CoverURL = patch.GetCoverUrl()
+		case "cover_asset_id":
+			id, err := parseImageAssetID("cover_asset_id", patch.GetCoverAssetId())
+			if err != nil {
+				return err
+			}
+			target.CoverAssetID = id
+		case "drm_enabled":
+			target.DRMEnabled = patch.GetDrmEnabled()
+		case "branding":
+			branding, err := fromProtoSeriesBranding(patch.GetBranding())
+			if err != nil {
+				return err
+			}
+			target.Branding = branding
+		case "branding.accent_color":
+			target.Branding.AccentColor = patch.GetBranding().GetAccentColor()
+		case "branding.hero_asset_id":
+			id, err := parseImageAssetID("branding.hero_asset_id", patch.GetBranding().GetHeroAssetId())
+			if err != nil {
+				return err
+			}
+			target.Branding.HeroAssetID = id
+		case "branding.layout":
+			layout, err := fromProtoSeriesLayout(patch.GetBranding().GetLayout())
+			if err != nil {
+				return err
+			}
+			target.Branding.Layout = layout
 		case "status":
 			status, err := fromProtoSeriesStatus(patch.GetStatus())
 			if err != nil {
@@ -379,6 +515,51 @@ func applySeriesFieldMask(target *core.Series, patch *lessionv1.SeriesDraft, mas
 	return nil
 }
 
+// parseImageAssetID parses an optional image asset id held in field.
+func parseImageAssetID(field, raw string) (uuid.UUID, error) {
+	if raw == "" {
+		return uuid.Nil, nil
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: invalid %s %q", core.ErrValidation, field, raw)
+	}
+	return id, nil
+}
+
+// fromProtoSeriesBranding maps the editable branding fields; the hero image
+// URL is derived from the hero asset by the service.
+func fromProtoSeriesBranding(branding *lessionv1.SeriesBranding) (core.SeriesBranding, error) {
+	if branding == nil {
+		return core.SeriesBranding{}, nil
+	}
+	heroAssetID, err := parseImageAssetID("branding.hero_asset_id", branding.GetHeroAssetId())
+	if err != nil {
+		return core.SeriesBranding{}, err
+	}
+	layout, err := fromProtoSeriesLayout(branding.GetLayout())
+	if err != nil {
+		return core.SeriesBranding{}, err
+	}
+	return core.SeriesBranding{
+		AccentColor: branding.GetAccentColor(),
+		HeroAssetID: heroAssetID,
+		Layout:      layout,
+	}, nil
+}
+
+func toProtoSeriesBranding(branding core.SeriesBranding) *lessionv1.SeriesBranding {
+	if branding == (core.SeriesBranding{}) {
+		return nil
+	}
+	return &lessionv1.SeriesBranding{
+		AccentColor:  branding.AccentColor,
+		HeroAssetId:  lo.Ternary(branding.HeroAssetID != uuid.Nil, branding.HeroAssetID.String(), ""),
+		HeroImageUrl: branding.HeroImageURL,
+		Layout:       toProtoSeriesLayout(branding.Layout),
+	}
+}
+
 func applyEpisodeFieldMask(target *core.Episode, patch *lessionv1.EpisodeDraft, mask *fieldmaskpb.FieldMask) error {
 	for _, path := range mask.Paths {
 		switch strings.ToLower(path) {
@@ -400,6 +581,8 @@ func applyEpisodeFieldMask(target *core.Episode, patch *lessionv1.EpisodeDraft,
 				return err
 			}
 			target.Status = status
+		case "preview":
+			target.Preview = patch.GetPreview()
 		case "resource":
 			if patch.GetResource() == nil {
 				target.Resource = core.MediaResource{}
@@ -489,17 +672,21 @@ func toProtoSeries(series *core.Series, includeEpisodes bool) *lessionv1.Series
 	}
 
 	res := &lessionv1.Series{
-		Id:           series.ID.String(),
-		Slug:         series.Slug,
-		Title:        series.Title,
-		Summary:      series.Summary,
-		Language:     series.Language,
-		Level:        series.Level,
-		Tags:         lo.Map(series.Tags, func(tag string, _ int) string { return tag }),
-		CoverUrl:     series.CoverURL,
-		Status:       toProtoSeriesStatus(series.Status),
-		EpisodeCount: uint32(series.EpisodeCount),
-		AuthorIds:    lo.Map(series.AuthorIDs, func(id string, _ int) string { return id }),
+		Id:             series.ID.String(),
+		Slug:           series.Slug,
+		Title:          series.Title,
+		Summary:        series.Summary,
+		Language:       series.Language,
+		Level:          series.Level,
+		EstimatedLevel: series.EstimatedLevel,
+		Tags:           lo.Map(series.Tags, func(tag string, _ int) string { return tag }),
+		CoverUrl:       series.CoverURL,
+		CoverAssetId:   lo.Ternary(series.CoverAssetID != uuid.Nil, series.CoverAssetID.String(), ""),
+		Status:         toProtoSeriesStatus(series.Status),
+		DrmEnabled:     series.DRMEnabled,
+		Branding:       toProtoSeriesBranding(series.Branding),
+		EpisodeCount:   uint32(series.EpisodeCount),
+		AuthorIds:      lo.Map(series.AuthorIDs, func(id string, _ int) string { return id }),
 	}
 
 	if !series.CreatedAt.IsZero() {
@@ -521,20 +708,35 @@ func toProtoSeries(series *core.Series, includeEpisodes bool) *lessionv1.Series
 	return res
 }
 
+func toProtoContentReassignment(reassignment *core.ContentReassignment) *lessionv1.ContentReassignment {
+	if reassignment == nil {
+		return nil
+	}
+	return &lessionv1.ContentReassignment{
+		Id:           reassignment.ID.String(),
+		FromAuthorId: reassignment.FromAuthorID,
+		ToAuthorId:   reassignment.ToAuthorID,
+		SeriesIds:    lo.Map(reassignment.SeriesIDs, func(id uuid.UUID, _ int) string { return id.String() }),
+		CreatedAt:    timestamppb.New(reassignment.CreatedAt),
+	}
+}
+
 func toProtoEpisode(episode *core.Episode) *lessionv1.Episode {
 	if episode == nil {
 		return nil
 	}
 
 	res := &lessionv1.Episode{
-		Id:          episode.ID.String(),
-		SeriesId:    episode.SeriesID.String(),
-		Seq:         episode.Seq,
-		Title:       episode.Title,
-		Description: episode.Description,
-		Status:      toProtoEpisodeStatus(episode.Status),
-		Resource:    toProtoMediaResource(episode.Resource),
-		Transcript:  toProtoTranscript(episode.Transcript),
+		Id:             episode.ID.String(),
+		SeriesId:       episode.SeriesID.String(),
+		Seq:            episode.Seq,
+		Title:          episode.Title,
+		Description:    episode.Description,
+		Status:         toProtoEpisodeStatus(episode.Status),
+		Preview:        episode.Preview,
+		Resource:       toProtoMediaResource(episode.Resource),
+		Transcript:     toProtoTranscript(episode.Transcript),
+		EstimatedLevel: episode.EstimatedLevel,
 	}
 
 	if episode.Duration > 0 {
@@ -570,6 +772,15 @@ func toProtoTranscript(t core.Transcript) *lessionv1.Transcript {
 		Language: t.Language,
 		Format:   toProtoTranscriptFormat(t.Format),
 		Content:  t.Content,
+		Findings: lo.Map(t.Findings, func(f core.TranscriptFinding, _ int) *lessionv1.TranscriptFinding {
+			return &lessionv1.TranscriptFinding{
+				Kind:   f.Kind,
+				Line:   uint32(f.Line),
+				Start:  uint32(f.Start),
+				End:    uint32(f.End),
+				Masked: f.Masked,
+			}
+		}),
 	}
 }
 
@@ -603,6 +814,34 @@ func toProtoSeriesStatus(status core.SeriesStatus) lessionv1.SeriesStatus {
 	}
 }
 
+func fromProtoSeriesLayout(layout lessionv1.SeriesLayout) (core.SeriesLayout, error) {
+	switch layout {
+	case lessionv1.SeriesLayout_SERIES_LAYOUT_UNSPECIFIED:
+		return core.SeriesLayoutUnspecified, nil
+	case lessionv1.SeriesLayout_SERIES_LAYOUT_LIST:
+		return core.SeriesLayoutList, nil
+	case lessionv1.SeriesLayout_SERIES_LAYOUT_GRID:
+		return core.SeriesLayoutGrid, nil
+	case lessionv1.SeriesLayout_SERIES_LAYOUT_FEATURED:
+		return core.SeriesLayoutFeatured, nil
+	default:
+		return core.SeriesLayoutUnspecified, fmt.Errorf("%w: invalid series layout %d", core.ErrValidation, layout)
+	}
+}
+
+func toProtoSeriesLayout(layout core.SeriesLayout) lessionv1.SeriesLayout {
+	switch layout {
+	case core.SeriesLayoutList:
+		return lessionv1.SeriesLayout_SERIES_LAYOUT_LIST
+	case core.SeriesLayoutGrid:
+		return lessionv1.SeriesLayout_SERIES_LAYOUT_GRID
+	case core.SeriesLayoutFeatured:
+		return lessionv1.SeriesLayout_SERIES_LAYOUT_FEATURED
+	default:
+		return lessionv1.SeriesLayout_SERIES_LAYOUT_UNSPECIFIED
+	}
+}
+
 func fromProtoEpisodeStatus(status lessionv1.EpisodeStatus) (core.EpisodeStatus, error) {
 	switch status {
 	case lessionv1.EpisodeStatus_EPISODE_STATUS_UNSPECIFIED:
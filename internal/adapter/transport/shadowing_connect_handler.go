@@ -0,0 +1,172 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// ShadowingHandler implements the generated Connect service for shadowing practice.
+type ShadowingHandler struct {
+	service core.ShadowingService
+}
+
+// NewShadowingHandler constructs a new shadowing handler backed by the provided service.
+func NewShadowingHandler(service core.ShadowingService) *ShadowingHandler {
+	return &ShadowingHandler{service: service}
+}
+
+var _ lessionv1connect.ShadowingServiceHandler = (*ShadowingHandler)(nil)
+
+// CreateShadowingSubmission attaches an uploaded recording to a transcript segment.
+func (h *ShadowingHandler) CreateShadowingSubmission(ctx context.Context, req *connect.Request[lessionv1.CreateShadowingSubmissionRequest]) (*connect.Response[lessionv1.CreateShadowingSubmissionResponse], error) {
+	episodeID, err := uuid.Parse(req.Msg.GetEpisodeId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+	}
+	assetID, err := uuid.Parse(req.Msg.GetAssetId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid asset_id %q", core.ErrValidation, req.Msg.GetAssetId())
+	}
+
+	submission, err := h.service.CreateShadowingSubmission(ctx, core.CreateShadowingSubmissionParams{
+		UserID:       req.Msg.GetUserId(),
+		EpisodeID:    episodeID,
+		SegmentIndex: int(req.Msg.GetSegmentIndex()),
+		AssetID:      assetID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.CreateShadowingSubmissionResponse{
+		Submission: toProtoShadowingSubmission(submission),
+	}), nil
+}
+
+// GetShadowingSubmission returns a single submission.
+func (h *ShadowingHandler) GetShadowingSubmission(ctx context.Context, req *connect.Request[lessionv1.GetShadowingSubmissionRequest]) (*connect.Response[lessionv1.GetShadowingSubmissionResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetSubmissionId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid submission_id %q", core.ErrValidation, req.Msg.GetSubmissionId())
+	}
+
+	submission, err := h.service.GetShadowingSubmission(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetShadowingSubmissionResponse{
+		Submission: toProtoShadowingSubmission(submission),
+	}), nil
+}
+
+// ListShadowingSubmissions returns submissions, newest first.
+func (h *ShadowingHandler) ListShadowingSubmissions(ctx context.Context, req *connect.Request[lessionv1.ListShadowingSubmissionsRequest]) (*connect.Response[lessionv1.ListShadowingSubmissionsResponse], error) {
+	filter := core.ShadowingSubmissionFilter{
+		PageSize:  int(req.Msg.GetPageSize()),
+		PageToken: req.Msg.GetPageToken(),
+		UserID:    req.Msg.GetUserId(),
+		Statuses: lo.Map(req.Msg.GetStatuses(), func(status lessionv1.ShadowingSubmissionStatus, _ int) core.ShadowingSubmissionStatus {
+			return fromProtoShadowingSubmissionStatus(status)
+		}),
+	}
+	if req.Msg.GetEpisodeId() != "" {
+		episodeID, err := uuid.Parse(req.Msg.GetEpisodeId())
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+		}
+		filter.EpisodeID = episodeID
+	}
+
+	submissions, nextToken, err := h.service.ListShadowingSubmissions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListShadowingSubmissionsResponse{
+		Submissions: lo.Map(submissions, func(submission core.ShadowingSubmission, _ int) *lessionv1.ShadowingSubmission {
+			return toProtoShadowingSubmission(&submission)
+		}),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+// ReviewShadowingSubmission records a teacher's score and comment.
+func (h *ShadowingHandler) ReviewShadowingSubmission(ctx context.Context, req *connect.Request[lessionv1.ReviewShadowingSubmissionRequest]) (*connect.Response[lessionv1.ReviewShadowingSubmissionResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetSubmissionId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid submission_id %q", core.ErrValidation, req.Msg.GetSubmissionId())
+	}
+
+	submission, err := h.service.ReviewShadowingSubmission(ctx, core.ReviewShadowingSubmissionParams{
+		SubmissionID: id,
+		ReviewerID:   req.Msg.GetReviewerId(),
+		Score:        int(req.Msg.GetScore()),
+		Comment:      req.Msg.GetComment(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ReviewShadowingSubmissionResponse{
+		Submission: toProtoShadowingSubmission(submission),
+	}), nil
+}
+
+func toProtoShadowingSubmission(submission *core.ShadowingSubmission) *lessionv1.ShadowingSubmission {
+	if submission == nil {
+		return nil
+	}
+	pb := &lessionv1.ShadowingSubmission{
+		Id:            submission.ID.String(),
+		UserId:        submission.UserID,
+		EpisodeId:     submission.EpisodeID.String(),
+		SegmentIndex:  int32(submission.SegmentIndex),
+		AssetId:       submission.AssetID.String(),
+		Status:        toProtoShadowingSubmissionStatus(submission.Status),
+		AutoScore:     submission.AutoScore,
+		AutoFeedback:  submission.AutoFeedback,
+		ReviewerId:    submission.ReviewerID,
+		ReviewComment: submission.ReviewComment,
+		CreatedAt:     timestamppb.New(submission.CreatedAt),
+		UpdatedAt:     timestamppb.New(submission.UpdatedAt),
+	}
+	if submission.ReviewScore != nil {
+		pb.ReviewScore = lo.ToPtr(int32(*submission.ReviewScore))
+	}
+	if submission.ReviewedAt != nil {
+		pb.ReviewedAt = timestamppb.New(*submission.ReviewedAt)
+	}
+	return pb
+}
+
+func fromProtoShadowingSubmissionStatus(status lessionv1.ShadowingSubmissionStatus) core.ShadowingSubmissionStatus {
+	switch status {
+	case lessionv1.ShadowingSubmissionStatus_SHADOWING_SUBMISSION_STATUS_PENDING:
+		return core.ShadowingSubmissionStatusPending
+	case lessionv1.ShadowingSubmissionStatus_SHADOWING_SUBMISSION_STATUS_REVIEWED:
+		return core.ShadowingSubmissionStatusReviewed
+	default:
+		return core.ShadowingSubmissionStatusUnspecified
+	}
+}
+
+func toProtoShadowingSubmissionStatus(status core.ShadowingSubmissionStatus) lessionv1.ShadowingSubmissionStatus {
+	switch status {
+	case core.ShadowingSubmissionStatusPending:
+		return lessionv1.ShadowingSubmissionStatus_SHADOWING_SUBMISSION_STATUS_PENDING
+	case core.ShadowingSubmissionStatusReviewed:
+		return lessionv1.ShadowingSubmissionStatus_SHADOWING_SUBMISSION_STATUS_REVIEWED
+	default:
+		return lessionv1.ShadowingSubmissionStatus_SHADOWING_SUBMISSION_STATUS_UNSPECIFIED
+	}
+}
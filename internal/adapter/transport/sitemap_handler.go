@@ -0,0 +1,72 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/xml"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	sitemapNamespace   = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	sitemapCacheMaxAge = time.Hour
+)
+
+// SitemapHandler serves /sitemap.xml for search engines.
+type SitemapHandler struct {
+	sitemap core.SitemapService
+}
+
+// NewSitemapHandler constructs a sitemap handler. A nil service serves nothing.
+func NewSitemapHandler(sitemap core.SitemapService) *SitemapHandler {
+	return &SitemapHandler{sitemap: sitemap}
+}
+
+type sitemapURLSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// Register mounts the sitemap on mux.
+func (h *SitemapHandler) Register(mux *http.ServeMux) {
+	if h.sitemap == nil {
+		return
+	}
+	mux.HandleFunc("GET /sitemap.xml", h.serve)
+}
+
+func (h *SitemapHandler) serve(w http.ResponseWriter, r *http.Request) {
+	sitemap, err := h.sitemap.Sitemap(r.Context())
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+
+	set := sitemapURLSet{Xmlns: sitemapNamespace, URLs: make([]sitemapURL, 0, len(sitemap.Entries))}
+	for _, entry := range sitemap.Entries {
+		u := sitemapURL{Loc: entry.Loc}
+		if !entry.LastMod.IsZero() {
+			u.LastMod = entry.LastMod.UTC().Format(time.RFC3339)
+		}
+		set.URLs = append(set.URLs, u)
+	}
+	body, err := xml.Marshal(set)
+	if err != nil {
+		writeHTTPError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(int(sitemapCacheMaxAge/time.Second)))
+	// ServeContent answers If-Modified-Since from the generation time.
+	http.ServeContent(w, r, "sitemap.xml", sitemap.GeneratedAt, bytes.NewReader(append([]byte(xml.Header), body...)))
+}
@@ -0,0 +1,61 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubSitemapService struct {
+	sitemap core.Sitemap
+}
+
+func (s stubSitemapService) Sitemap(context.Context) (*core.Sitemap, error) {
+	return &s.sitemap, nil
+}
+
+func TestSitemapHandler(t *testing.T) {
+	generated := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+	mux := http.NewServeMux()
+	NewSitemapHandler(stubSitemapService{sitemap: core.Sitemap{
+		GeneratedAt: generated,
+		Entries: []core.SitemapEntry{
+			{Loc: "https://example.com/series/coffee?a=1&b=2", LastMod: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)},
+			{Loc: "https://example.com/series/tea"},
+		},
+	}}).Register(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/sitemap.xml", nil))
+	if rec.Code != http.StatusOK || !strings.HasPrefix(rec.Header().Get("Content-Type"), "application/xml") {
+		t.Fatalf("unexpected response %d %q", rec.Code, rec.Header().Get("Content-Type"))
+	}
+	want := `<?xml version="1.0" encoding="UTF-8"?>` + "\n" +
+		`<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">` +
+		`<url><loc>https://example.com/series/coffee?a=1&amp;b=2</loc><lastmod>2024-05-01T12:00:00Z</lastmod></url>` +
+		`<url><loc>https://example.com/series/tea</loc></url></urlset>`
+	if rec.Body.String() != want {
+		t.Fatalf("unexpected sitemap\n%s", rec.Body.String())
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/sitemap.xml", nil)
+	req.Header.Set("If-Modified-Since", generated.Format(http.TimeFormat))
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotModified {
+		t.Fatalf("expected 304 for an unchanged sitemap, got %d", rec.Code)
+	}
+
+	disabled := http.NewServeMux()
+	NewSitemapHandler(nil).Register(disabled)
+	rec = httptest.NewRecorder()
+	disabled.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/sitemap.xml", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected no sitemap without a service, got %d", rec.Code)
+	}
+}
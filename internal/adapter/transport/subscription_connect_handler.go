@@ -0,0 +1,254 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// SubscriptionHandler implements the generated Connect service for plans and subscriptions.
+type SubscriptionHandler struct {
+	service core.SubscriptionService
+}
+
+// NewSubscriptionHandler constructs a new subscription handler backed by the provided service.
+func NewSubscriptionHandler(service core.SubscriptionService) *SubscriptionHandler {
+	return &SubscriptionHandler{service: service}
+}
+
+var _ lessionv1connect.SubscriptionServiceHandler = (*SubscriptionHandler)(nil)
+
+// CreatePlan adds a purchasable plan.
+func (h *SubscriptionHandler) CreatePlan(ctx context.Context, req *connect.Request[lessionv1.CreatePlanRequest]) (*connect.Response[lessionv1.CreatePlanResponse], error) {
+	draft := req.Msg.GetPlan()
+	interval, err := fromProtoBillingInterval(draft.GetInterval())
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := h.service.CreatePlan(ctx, core.PlanDraft{
+		Code:        draft.GetCode(),
+		Name:        draft.GetName(),
+		Description: draft.GetDescription(),
+		PriceCents:  draft.GetPriceCents(),
+		Currency:    draft.GetCurrency(),
+		Interval:    interval,
+		Active:      draft.GetActive(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.CreatePlanResponse{
+		Plan: toProtoPlan(plan),
+	}), nil
+}
+
+// UpdatePlan applies partial updates to a plan.
+func (h *SubscriptionHandler) UpdatePlan(ctx context.Context, req *connect.Request[lessionv1.UpdatePlanRequest]) (*connect.Response[lessionv1.UpdatePlanResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetPlanId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid plan_id %q", core.ErrValidation, req.Msg.GetPlanId())
+	}
+
+	existing, err := h.service.GetPlan(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	mask := req.Msg.GetUpdateMask()
+	if isFieldMaskEmpty(mask) {
+		mask = &fieldmaskpb.FieldMask{
+			Paths: []string{"code", "name", "description", "price_cents", "currency", "interval", "active"},
+		}
+	}
+
+	if err := applyPlanFieldMask(existing, req.Msg.GetPlan(), mask); err != nil {
+		return nil, err
+	}
+
+	updated, err := h.service.UpdatePlan(ctx, *existing)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.UpdatePlanResponse{
+		Plan: toProtoPlan(updated),
+	}), nil
+}
+
+// ListPlans returns plans, cheapest first.
+func (h *SubscriptionHandler) ListPlans(ctx context.Context, req *connect.Request[lessionv1.ListPlansRequest]) (*connect.Response[lessionv1.ListPlansResponse], error) {
+	plans, err := h.service.ListPlans(ctx, req.Msg.GetIncludeInactive())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListPlansResponse{
+		Plans: lo.Map(plans, func(plan core.Plan, _ int) *lessionv1.Plan {
+			return toProtoPlan(&plan)
+		}),
+	}), nil
+}
+
+// Subscribe starts a learner's subscription to a plan.
+func (h *SubscriptionHandler) Subscribe(ctx context.Context, req *connect.Request[lessionv1.SubscribeRequest]) (*connect.Response[lessionv1.SubscribeResponse], error) {
+	planID, err := uuid.Parse(req.Msg.GetPlanId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid plan_id %q", core.ErrValidation, req.Msg.GetPlanId())
+	}
+
+	subscription, err := h.service.Subscribe(ctx, req.Msg.GetUserId(), planID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.SubscribeResponse{
+		Subscription: toProtoSubscription(subscription),
+	}), nil
+}
+
+// CancelSubscription stops renewal; access continues until the period ends.
+func (h *SubscriptionHandler) CancelSubscription(ctx context.Context, req *connect.Request[lessionv1.CancelSubscriptionRequest]) (*connect.Response[lessionv1.CancelSubscriptionResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetSubscriptionId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid subscription_id %q", core.ErrValidation, req.Msg.GetSubscriptionId())
+	}
+
+	subscription, err := h.service.CancelSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.CancelSubscriptionResponse{
+		Subscription: toProtoSubscription(subscription),
+	}), nil
+}
+
+// ListSubscriptions returns a learner's subscriptions, newest first.
+func (h *SubscriptionHandler) ListSubscriptions(ctx context.Context, req *connect.Request[lessionv1.ListSubscriptionsRequest]) (*connect.Response[lessionv1.ListSubscriptionsResponse], error) {
+	subscriptions, err := h.service.ListSubscriptions(ctx, req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListSubscriptionsResponse{
+		Subscriptions: lo.Map(subscriptions, func(subscription core.Subscription, _ int) *lessionv1.Subscription {
+			return toProtoSubscription(&subscription)
+		}),
+	}), nil
+}
+
+func applyPlanFieldMask(target *core.Plan, patch *lessionv1.PlanDraft, mask *fieldmaskpb.FieldMask) error {
+	for _, path := range mask.Paths {
+		switch strings.ToLower(path) {
+		case "code":
+			target.Code = patch.GetCode()
+		case "name":
+			target.Name = patch.GetName()
+		case "description":
+			target.Description = patch.GetDescription()
+		case "price_cents":
+			target.PriceCents = patch.GetPriceCents()
+		case "currency":
+			target.Currency = patch.GetCurrency()
+		case "interval":
+			interval, err := fromProtoBillingInterval(patch.GetInterval())
+			if err != nil {
+				return err
+			}
+			target.Interval = interval
+		case "active":
+			target.Active = patch.GetActive()
+		default:
+			return fmt.Errorf("%w: unsupported update path %q", core.ErrValidation, path)
+		}
+	}
+	return nil
+}
+
+func toProtoPlan(plan *core.Plan) *lessionv1.Plan {
+	if plan == nil {
+		return nil
+	}
+	return &lessionv1.Plan{
+		Id:          plan.ID.String(),
+		Code:        plan.Code,
+		Name:        plan.Name,
+		Description: plan.Description,
+		PriceCents:  plan.PriceCents,
+		Currency:    plan.Currency,
+		Interval:    toProtoBillingInterval(plan.Interval),
+		Active:      plan.Active,
+		CreatedAt:   timestamppb.New(plan.CreatedAt),
+		UpdatedAt:   timestamppb.New(plan.UpdatedAt),
+	}
+}
+
+func toProtoSubscription(subscription *core.Subscription) *lessionv1.Subscription {
+	if subscription == nil {
+		return nil
+	}
+	pb := &lessionv1.Subscription{
+		Id:                 subscription.ID.String(),
+		UserId:             subscription.UserID,
+		PlanId:             subscription.PlanID.String(),
+		Status:             toProtoSubscriptionStatus(subscription.Status),
+		CurrentPeriodStart: timestamppb.New(subscription.CurrentPeriodStart),
+		CurrentPeriodEnd:   timestamppb.New(subscription.CurrentPeriodEnd),
+		CreatedAt:          timestamppb.New(subscription.CreatedAt),
+		UpdatedAt:          timestamppb.New(subscription.UpdatedAt),
+		BillingProvider:    subscription.BillingProvider,
+	}
+	if subscription.CanceledAt != nil {
+		pb.CanceledAt = timestamppb.New(*subscription.CanceledAt)
+	}
+	return pb
+}
+
+func fromProtoBillingInterval(interval lessionv1.BillingInterval) (core.BillingInterval, error) {
+	switch interval {
+	case lessionv1.BillingInterval_BILLING_INTERVAL_UNSPECIFIED:
+		return core.BillingIntervalUnspecified, nil
+	case lessionv1.BillingInterval_BILLING_INTERVAL_MONTH:
+		return core.BillingIntervalMonth, nil
+	case lessionv1.BillingInterval_BILLING_INTERVAL_YEAR:
+		return core.BillingIntervalYear, nil
+	default:
+		return core.BillingIntervalUnspecified, fmt.Errorf("%w: invalid billing interval %d", core.ErrValidation, interval)
+	}
+}
+
+func toProtoBillingInterval(interval core.BillingInterval) lessionv1.BillingInterval {
+	switch interval {
+	case core.BillingIntervalMonth:
+		return lessionv1.BillingInterval_BILLING_INTERVAL_MONTH
+	case core.BillingIntervalYear:
+		return lessionv1.BillingInterval_BILLING_INTERVAL_YEAR
+	default:
+		return lessionv1.BillingInterval_BILLING_INTERVAL_UNSPECIFIED
+	}
+}
+
+func toProtoSubscriptionStatus(status core.SubscriptionStatus) lessionv1.SubscriptionStatus {
+	switch status {
+	case core.SubscriptionStatusActive:
+		return lessionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE
+	case core.SubscriptionStatusCanceled:
+		return lessionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_CANCELED
+	case core.SubscriptionStatusExpired:
+		return lessionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_EXPIRED
+	default:
+		return lessionv1.SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED
+	}
+}
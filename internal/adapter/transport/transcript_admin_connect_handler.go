@@ -0,0 +1,178 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// TranscriptAdminHandler implements the generated Connect service for bulk transcript maintenance.
+type TranscriptAdminHandler struct {
+	service core.TranscriptAdminService
+}
+
+// NewTranscriptAdminHandler constructs a new transcript admin handler backed by the provided service.
+func NewTranscriptAdminHandler(service core.TranscriptAdminService) *TranscriptAdminHandler {
+	return &TranscriptAdminHandler{service: service}
+}
+
+var _ lessionv1connect.TranscriptAdminServiceHandler = (*TranscriptAdminHandler)(nil)
+
+// SearchReplaceTranscripts starts an asynchronous search-and-replace job.
+func (h *TranscriptAdminHandler) SearchReplaceTranscripts(ctx context.Context, req *connect.Request[lessionv1.SearchReplaceTranscriptsRequest]) (*connect.Response[lessionv1.SearchReplaceTranscriptsResponse], error) {
+	params := core.SearchReplaceTranscriptsParams{
+		Pattern:         req.Msg.GetPattern(),
+		Replacement:     req.Msg.GetReplacement(),
+		Regex:           req.Msg.GetRegex(),
+		CaseInsensitive: req.Msg.GetCaseInsensitive(),
+		Scope:           core.TranscriptReplaceScope{Language: req.Msg.GetLanguage()},
+		DryRun:          req.Msg.GetDryRun(),
+		RequestedBy:     req.Msg.GetRequestedBy(),
+	}
+	if req.Msg.GetSeriesId() != "" {
+		seriesID, err := uuid.Parse(req.Msg.GetSeriesId())
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid series_id %q", core.ErrValidation, req.Msg.GetSeriesId())
+		}
+		params.Scope.SeriesID = seriesID
+	}
+
+	job, err := h.service.SearchReplaceTranscripts(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.SearchReplaceTranscriptsResponse{
+		Job: toProtoTranscriptReplaceJob(job),
+	}), nil
+}
+
+// GetTranscriptReplaceJob returns a job with its progress and diff preview.
+func (h *TranscriptAdminHandler) GetTranscriptReplaceJob(ctx context.Context, req *connect.Request[lessionv1.GetTranscriptReplaceJobRequest]) (*connect.Response[lessionv1.GetTranscriptReplaceJobResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetJobId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid job_id %q", core.ErrValidation, req.Msg.GetJobId())
+	}
+
+	job, err := h.service.GetTranscriptReplaceJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetTranscriptReplaceJobResponse{
+		Job: toProtoTranscriptReplaceJob(job),
+	}), nil
+}
+
+// ListTranscriptReplaceJobs returns jobs, newest first.
+func (h *TranscriptAdminHandler) ListTranscriptReplaceJobs(ctx context.Context, req *connect.Request[lessionv1.ListTranscriptReplaceJobsRequest]) (*connect.Response[lessionv1.ListTranscriptReplaceJobsResponse], error) {
+	jobs, nextToken, err := h.service.ListTranscriptReplaceJobs(ctx, core.TranscriptReplaceJobFilter{
+		PageSize:  int(req.Msg.GetPageSize()),
+		PageToken: req.Msg.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListTranscriptReplaceJobsResponse{
+		Jobs: lo.Map(jobs, func(job core.TranscriptReplaceJob, _ int) *lessionv1.TranscriptReplaceJob {
+			return toProtoTranscriptReplaceJob(&job)
+		}),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+// RollbackTranscriptReplaceJob restores the transcripts a completed job changed.
+func (h *TranscriptAdminHandler) RollbackTranscriptReplaceJob(ctx context.Context, req *connect.Request[lessionv1.RollbackTranscriptReplaceJobRequest]) (*connect.Response[lessionv1.RollbackTranscriptReplaceJobResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetJobId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid job_id %q", core.ErrValidation, req.Msg.GetJobId())
+	}
+
+	job, result, err := h.service.RollbackTranscriptReplaceJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.RollbackTranscriptReplaceJobResponse{
+		Job:      toProtoTranscriptReplaceJob(job),
+		Restored: uint32(result.Restored),
+		ConflictEpisodeIds: lo.Map(result.Conflicts, func(id uuid.UUID, _ int) string {
+			return id.String()
+		}),
+	}), nil
+}
+
+func toProtoTranscriptReplaceJob(job *core.TranscriptReplaceJob) *lessionv1.TranscriptReplaceJob {
+	if job == nil {
+		return nil
+	}
+	pb := &lessionv1.TranscriptReplaceJob{
+		Id:              job.ID.String(),
+		Pattern:         job.Pattern,
+		Replacement:     job.Replacement,
+		Regex:           job.Regex,
+		CaseInsensitive: job.CaseInsensitive,
+		Language:        job.Scope.Language,
+		DryRun:          job.DryRun,
+		RequestedBy:     job.RequestedBy,
+		Status:          toProtoTranscriptReplaceJobStatus(job.Status),
+		EpisodesScanned: uint32(job.EpisodesScanned),
+		EpisodesChanged: uint32(job.EpisodesChanged),
+		Matches:         uint32(job.Matches),
+		Changes: lo.Map(job.Changes, func(change core.TranscriptChange, _ int) *lessionv1.TranscriptChange {
+			return &lessionv1.TranscriptChange{
+				EpisodeId: change.EpisodeID.String(),
+				Matches:   uint32(change.Matches),
+				Lines: lo.Map(change.Lines, func(line core.TranscriptLineDiff, _ int) *lessionv1.TranscriptLineDiff {
+					return &lessionv1.TranscriptLineDiff{
+						Line:   uint32(line.Line),
+						Before: line.Before,
+						After:  line.After,
+					}
+				}),
+				SkipReason: change.SkipReason,
+			}
+		}),
+		Error:     job.Error,
+		CreatedAt: timestamppb.New(job.CreatedAt),
+	}
+	if job.Scope.SeriesID != uuid.Nil {
+		pb.SeriesId = job.Scope.SeriesID.String()
+	}
+	if job.StartedAt != nil {
+		pb.StartedAt = timestamppb.New(*job.StartedAt)
+	}
+	if job.FinishedAt != nil {
+		pb.FinishedAt = timestamppb.New(*job.FinishedAt)
+	}
+	if job.RolledBackAt != nil {
+		pb.RolledBackAt = timestamppb.New(*job.RolledBackAt)
+	}
+	return pb
+}
+
+func toProtoTranscriptReplaceJobStatus(status core.TranscriptReplaceJobStatus) lessionv1.TranscriptReplaceJobStatus {
+	switch status {
+	case core.TranscriptReplaceJobStatusPending:
+		return lessionv1.TranscriptReplaceJobStatus_TRANSCRIPT_REPLACE_JOB_STATUS_PENDING
+	case core.TranscriptReplaceJobStatusRunning:
+		return lessionv1.TranscriptReplaceJobStatus_TRANSCRIPT_REPLACE_JOB_STATUS_RUNNING
+	case core.TranscriptReplaceJobStatusSucceeded:
+		return lessionv1.TranscriptReplaceJobStatus_TRANSCRIPT_REPLACE_JOB_STATUS_SUCCEEDED
+	case core.TranscriptReplaceJobStatusFailed:
+		return lessionv1.TranscriptReplaceJobStatus_TRANSCRIPT_REPLACE_JOB_STATUS_FAILED
+	case core.TranscriptReplaceJobStatusRolledBack:
+		return lessionv1.TranscriptReplaceJobStatus_TRANSCRIPT_REPLACE_JOB_STATUS_ROLLED_BACK
+	default:
+		return lessionv1.TranscriptReplaceJobStatus_TRANSCRIPT_REPLACE_JOB_STATUS_UNSPECIFIED
+	}
+}
@@ -11,18 +11,62 @@ import (
 	"github.com/eslsoft/lession/internal/core"
 )
 
-// NewValidationInterceptor validates incoming requests against protobuf rules using ProtoValidate.
+// NewValidationInterceptor validates incoming requests against protobuf rules
+// using ProtoValidate. Streaming handlers have each message validated as it
+// is received.
 func NewValidationInterceptor(validator protovalidate.Validator) connect.Interceptor {
-	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			if validator != nil {
-				if msg, ok := req.Any().(proto.Message); ok {
-					if err := validator.Validate(msg); err != nil {
-						return nil, fmt.Errorf("%w: %s", core.ErrValidation, err.Error())
-					}
-				}
-			}
-			return next(ctx, req)
+	return validationInterceptor{validator: validator}
+}
+
+type validationInterceptor struct {
+	validator protovalidate.Validator
+}
+
+func (i validationInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := i.validate(req.Any()); err != nil {
+			return nil, err
 		}
-	})
+		return next(ctx, req)
+	}
+}
+
+func (validationInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i validationInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if i.validator == nil {
+			return next(ctx, conn)
+		}
+		return next(ctx, validatingConn{StreamingHandlerConn: conn, interceptor: i})
+	}
+}
+
+func (i validationInterceptor) validate(msg any) error {
+	if i.validator == nil {
+		return nil
+	}
+	message, ok := msg.(proto.Message)
+	if !ok {
+		return nil
+	}
+	if err := i.validator.Validate(message); err != nil {
+		return fmt.Errorf("%w: %s", core.ErrValidation, err.Error())
+	}
+	return nil
+}
+
+// validatingConn validates every message received on a stream.
+type validatingConn struct {
+	connect.StreamingHandlerConn
+	interceptor validationInterceptor
+}
+
+func (c validatingConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	return c.interceptor.validate(msg)
 }
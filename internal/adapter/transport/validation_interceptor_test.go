@@ -3,13 +3,17 @@ package transport
 import (
 	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	protovalidate "buf.build/go/protovalidate"
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
 
 	"github.com/eslsoft/lession/internal/core"
 	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
 )
 
 func TestValidationInterceptor_AllowsValidRequest(t *testing.T) {
@@ -86,3 +90,39 @@ func TestValidationInterceptor_AllowsWhenValidatorNil(t *testing.T) {
 		t.Fatal("expected next to be called when validator is nil")
 	}
 }
+
+func TestValidationInterceptor_ValidatesStreamingRequests(t *testing.T) {
+	validator, err := protovalidate.New()
+	if err != nil {
+		t.Fatalf("protovalidate.New() error = %v", err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(lessionv1connect.NewAssetServiceHandler(
+		stubEntitlementAssetHandler{assets: []*lessionv1.Asset{{Id: uuid.NewString()}}},
+		connect.WithInterceptors(NewErrorInterceptor(), NewValidationInterceptor(validator)),
+	))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client := lessionv1connect.NewAssetServiceClient(server.Client(), server.URL)
+
+	stream, err := client.WatchAsset(context.Background(), connect.NewRequest(&lessionv1.WatchAssetRequest{AssetId: "not-a-uuid"}))
+	if err != nil {
+		t.Fatalf("WatchAsset() error = %v", err)
+	}
+	if stream.Receive() {
+		t.Fatal("expected the stream to be rejected before sending")
+	}
+	if code := connect.CodeOf(stream.Err()); code != connect.CodeInvalidArgument {
+		t.Fatalf("WatchAsset() code = %v, want InvalidArgument", code)
+	}
+	stream.Close()
+
+	stream, err = client.WatchAsset(context.Background(), connect.NewRequest(&lessionv1.WatchAssetRequest{AssetId: uuid.NewString()}))
+	if err != nil {
+		t.Fatalf("WatchAsset() error = %v", err)
+	}
+	if !stream.Receive() {
+		t.Fatalf("WatchAsset() error = %v", stream.Err())
+	}
+	stream.Close()
+}
@@ -0,0 +1,147 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// VocabularyHandler implements the generated Connect service for vocabulary tracking.
+type VocabularyHandler struct {
+	service core.VocabularyService
+}
+
+// NewVocabularyHandler constructs a new vocabulary handler backed by the provided service.
+func NewVocabularyHandler(service core.VocabularyService) *VocabularyHandler {
+	return &VocabularyHandler{service: service}
+}
+
+var _ lessionv1connect.VocabularyServiceHandler = (*VocabularyHandler)(nil)
+
+// MarkWords marks words as known or unknown for a learner.
+func (h *VocabularyHandler) MarkWords(ctx context.Context, req *connect.Request[lessionv1.MarkWordsRequest]) (*connect.Response[lessionv1.MarkWordsResponse], error) {
+	status, err := fromProtoVocabularyStatus(req.Msg.GetStatus())
+	if err != nil {
+		return nil, err
+	}
+	params := core.MarkWordsParams{
+		UserID: req.Msg.GetUserId(),
+		Words:  req.Msg.GetWords(),
+		Status: status,
+	}
+	if req.Msg.GetEpisodeId() != "" {
+		episodeID, err := uuid.Parse(req.Msg.GetEpisodeId())
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+		}
+		params.EpisodeID = episodeID
+	}
+
+	words, err := h.service.MarkWords(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.MarkWordsResponse{
+		Words: lo.Map(words, func(word core.VocabularyWord, _ int) *lessionv1.VocabularyWord {
+			return toProtoVocabularyWord(word)
+		}),
+	}), nil
+}
+
+// ListVocabulary returns a learner's marked words, most recently marked first.
+func (h *VocabularyHandler) ListVocabulary(ctx context.Context, req *connect.Request[lessionv1.ListVocabularyRequest]) (*connect.Response[lessionv1.ListVocabularyResponse], error) {
+	status, err := fromProtoVocabularyStatus(req.Msg.GetStatus())
+	if err != nil {
+		return nil, err
+	}
+
+	words, nextToken, err := h.service.ListVocabulary(ctx, core.VocabularyFilter{
+		UserID:    req.Msg.GetUserId(),
+		Status:    status,
+		PageSize:  int(req.Msg.GetPageSize()),
+		PageToken: req.Msg.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListVocabularyResponse{
+		Words: lo.Map(words, func(word core.VocabularyWord, _ int) *lessionv1.VocabularyWord {
+			return toProtoVocabularyWord(word)
+		}),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+// GetVocabularyCoverage reports the share of an episode's words a learner knows.
+func (h *VocabularyHandler) GetVocabularyCoverage(ctx context.Context, req *connect.Request[lessionv1.GetVocabularyCoverageRequest]) (*connect.Response[lessionv1.GetVocabularyCoverageResponse], error) {
+	episodeID, err := uuid.Parse(req.Msg.GetEpisodeId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+	}
+
+	coverage, err := h.service.GetVocabularyCoverage(ctx, req.Msg.GetUserId(), episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetVocabularyCoverageResponse{
+		Coverage: &lessionv1.VocabularyCoverage{
+			EpisodeId:            coverage.EpisodeID.String(),
+			UserId:               coverage.UserID,
+			WordCount:            uint32(coverage.WordCount),
+			UniqueWordCount:      uint32(coverage.UniqueWordCount),
+			KnownWordCount:       uint32(coverage.KnownWordCount),
+			KnownUniqueWordCount: uint32(coverage.KnownUniqueWordCount),
+			KnownPercent:         coverage.KnownPercent,
+			NewWords:             toProtoWordFrequencies(coverage.NewWords),
+		},
+	}), nil
+}
+
+func toProtoVocabularyWord(word core.VocabularyWord) *lessionv1.VocabularyWord {
+	msg := &lessionv1.VocabularyWord{
+		UserId:    word.UserID,
+		Word:      word.Word,
+		Status:    toProtoVocabularyStatus(word.Status),
+		CreatedAt: timestamppb.New(word.CreatedAt),
+		UpdatedAt: timestamppb.New(word.UpdatedAt),
+	}
+	if word.EpisodeID != uuid.Nil {
+		msg.EpisodeId = word.EpisodeID.String()
+	}
+	return msg
+}
+
+func fromProtoVocabularyStatus(status lessionv1.VocabularyStatus) (core.VocabularyStatus, error) {
+	switch status {
+	case lessionv1.VocabularyStatus_VOCABULARY_STATUS_UNSPECIFIED:
+		return core.VocabularyStatusUnspecified, nil
+	case lessionv1.VocabularyStatus_VOCABULARY_STATUS_KNOWN:
+		return core.VocabularyStatusKnown, nil
+	case lessionv1.VocabularyStatus_VOCABULARY_STATUS_UNKNOWN:
+		return core.VocabularyStatusUnknown, nil
+	default:
+		return core.VocabularyStatusUnspecified, fmt.Errorf("%w: invalid vocabulary status %d", core.ErrValidation, status)
+	}
+}
+
+func toProtoVocabularyStatus(status core.VocabularyStatus) lessionv1.VocabularyStatus {
+	switch status {
+	case core.VocabularyStatusKnown:
+		return lessionv1.VocabularyStatus_VOCABULARY_STATUS_KNOWN
+	case core.VocabularyStatusUnknown:
+		return lessionv1.VocabularyStatus_VOCABULARY_STATUS_UNKNOWN
+	default:
+		return lessionv1.VocabularyStatus_VOCABULARY_STATUS_UNSPECIFIED
+	}
+}
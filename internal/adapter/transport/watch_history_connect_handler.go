@@ -0,0 +1,148 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// WatchHistoryHandler implements the generated Connect service for watch history.
+type WatchHistoryHandler struct {
+	service core.WatchHistoryService
+}
+
+// NewWatchHistoryHandler constructs a new watch history handler backed by the provided service.
+func NewWatchHistoryHandler(service core.WatchHistoryService) *WatchHistoryHandler {
+	return &WatchHistoryHandler{service: service}
+}
+
+var _ lessionv1connect.WatchHistoryServiceHandler = (*WatchHistoryHandler)(nil)
+
+// RecordPlaybackSession appends a playback session to a learner's history.
+func (h *WatchHistoryHandler) RecordPlaybackSession(ctx context.Context, req *connect.Request[lessionv1.RecordPlaybackSessionRequest]) (*connect.Response[lessionv1.RecordPlaybackSessionResponse], error) {
+	episodeID, err := uuid.Parse(req.Msg.GetEpisodeId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+	}
+
+	session := core.PlaybackSession{
+		UserID:    req.Msg.GetUserId(),
+		EpisodeID: episodeID,
+		Device:    req.Msg.GetDevice(),
+	}
+	if req.Msg.GetStartedAt() != nil {
+		session.StartedAt = req.Msg.GetStartedAt().AsTime()
+	}
+	if req.Msg.GetFinishedAt() != nil {
+		session.FinishedAt = lo.ToPtr(req.Msg.GetFinishedAt().AsTime())
+	}
+
+	recorded, err := h.service.RecordPlaybackSession(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.RecordPlaybackSessionResponse{
+		Session: toProtoPlaybackSession(recorded),
+	}), nil
+}
+
+// FinishPlaybackSession records when an open playback session ended.
+func (h *WatchHistoryHandler) FinishPlaybackSession(ctx context.Context, req *connect.Request[lessionv1.FinishPlaybackSessionRequest]) (*connect.Response[lessionv1.FinishPlaybackSessionResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetSessionId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid session_id %q", core.ErrValidation, req.Msg.GetSessionId())
+	}
+
+	var finishedAt time.Time
+	if req.Msg.GetFinishedAt() != nil {
+		finishedAt = req.Msg.GetFinishedAt().AsTime()
+	}
+
+	session, err := h.service.FinishPlaybackSession(ctx, id, finishedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.FinishPlaybackSessionResponse{
+		Session: toProtoPlaybackSession(session),
+	}), nil
+}
+
+// ListHistory returns a learner's playback sessions, most recently started first.
+func (h *WatchHistoryHandler) ListHistory(ctx context.Context, req *connect.Request[lessionv1.ListHistoryRequest]) (*connect.Response[lessionv1.ListHistoryResponse], error) {
+	filter := core.WatchHistoryFilter{
+		UserID:    req.Msg.GetUserId(),
+		PageSize:  int(req.Msg.GetPageSize()),
+		PageToken: req.Msg.GetPageToken(),
+	}
+	if req.Msg.GetEpisodeId() != "" {
+		episodeID, err := uuid.Parse(req.Msg.GetEpisodeId())
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, req.Msg.GetEpisodeId())
+		}
+		filter.EpisodeID = episodeID
+	}
+	if req.Msg.GetStartedAfter() != nil {
+		filter.StartedAfter = req.Msg.GetStartedAfter().AsTime()
+	}
+	if req.Msg.GetStartedBefore() != nil {
+		filter.StartedBefore = req.Msg.GetStartedBefore().AsTime()
+	}
+
+	sessions, nextToken, err := h.service.ListHistory(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListHistoryResponse{
+		Sessions: lo.Map(sessions, func(session core.PlaybackSession, _ int) *lessionv1.PlaybackSession {
+			return toProtoPlaybackSession(&session)
+		}),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+// ClearHistory deletes a learner's playback sessions.
+func (h *WatchHistoryHandler) ClearHistory(ctx context.Context, req *connect.Request[lessionv1.ClearHistoryRequest]) (*connect.Response[lessionv1.ClearHistoryResponse], error) {
+	var before time.Time
+	if req.Msg.GetBefore() != nil {
+		before = req.Msg.GetBefore().AsTime()
+	}
+
+	deleted, err := h.service.ClearHistory(ctx, req.Msg.GetUserId(), before)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ClearHistoryResponse{
+		Deleted: uint32(deleted),
+	}), nil
+}
+
+func toProtoPlaybackSession(session *core.PlaybackSession) *lessionv1.PlaybackSession {
+	if session == nil {
+		return nil
+	}
+	pb := &lessionv1.PlaybackSession{
+		Id:        session.ID.String(),
+		UserId:    session.UserID,
+		EpisodeId: session.EpisodeID.String(),
+		Device:    session.Device,
+		StartedAt: timestamppb.New(session.StartedAt),
+		CreatedAt: timestamppb.New(session.CreatedAt),
+	}
+	if session.FinishedAt != nil {
+		pb.FinishedAt = timestamppb.New(*session.FinishedAt)
+	}
+	return pb
+}
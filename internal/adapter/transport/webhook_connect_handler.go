@@ -0,0 +1,346 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/eslsoft/lession/internal/core"
+	lessionv1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	"github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
+)
+
+// WebhookHandler implements the generated Connect service for webhooks.
+type WebhookHandler struct {
+	service core.WebhookService
+}
+
+// NewWebhookHandler constructs a new webhook handler backed by the provided service.
+func NewWebhookHandler(service core.WebhookService) *WebhookHandler {
+	return &WebhookHandler{service: service}
+}
+
+var _ lessionv1connect.WebhookServiceHandler = (*WebhookHandler)(nil)
+
+// CreateWebhookEndpoint registers an endpoint and returns its signing secret.
+func (h *WebhookHandler) CreateWebhookEndpoint(ctx context.Context, req *connect.Request[lessionv1.CreateWebhookEndpointRequest]) (*connect.Response[lessionv1.CreateWebhookEndpointResponse], error) {
+	draft := req.Msg.GetEndpoint()
+	endpoint, err := h.service.CreateWebhookEndpoint(ctx, core.WebhookEndpoint{
+		URL:         draft.GetUrl(),
+		Description: draft.GetDescription(),
+		EventTypes:  fromProtoWebhookEventTypes(draft.GetEventTypes()),
+		Enabled:     draft.GetEnabled(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := toProtoWebhookEndpoint(endpoint)
+	out.Secret = endpoint.Secret
+	return connect.NewResponse(&lessionv1.CreateWebhookEndpointResponse{
+		Endpoint: out,
+	}), nil
+}
+
+// GetWebhookEndpoint returns a single endpoint by identifier.
+func (h *WebhookHandler) GetWebhookEndpoint(ctx context.Context, req *connect.Request[lessionv1.GetWebhookEndpointRequest]) (*connect.Response[lessionv1.GetWebhookEndpointResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetEndpointId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid endpoint_id %q", core.ErrValidation, req.Msg.GetEndpointId())
+	}
+
+	endpoint, err := h.service.GetWebhookEndpoint(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.GetWebhookEndpointResponse{
+		Endpoint: toProtoWebhookEndpoint(endpoint),
+	}), nil
+}
+
+// ListWebhookEndpoints returns endpoints in registration order.
+func (h *WebhookHandler) ListWebhookEndpoints(ctx context.Context, req *connect.Request[lessionv1.ListWebhookEndpointsRequest]) (*connect.Response[lessionv1.ListWebhookEndpointsResponse], error) {
+	endpoints, nextToken, err := h.service.ListWebhookEndpoints(ctx, core.WebhookEndpointListFilter{
+		PageSize:  int(req.Msg.GetPageSize()),
+		PageToken: req.Msg.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListWebhookEndpointsResponse{
+		Endpoints: lo.Map(endpoints, func(endpoint core.WebhookEndpoint, _ int) *lessionv1.WebhookEndpoint {
+			return toProtoWebhookEndpoint(&endpoint)
+		}),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+// UpdateWebhookEndpoint applies partial updates to an endpoint.
+func (h *WebhookHandler) UpdateWebhookEndpoint(ctx context.Context, req *connect.Request[lessionv1.UpdateWebhookEndpointRequest]) (*connect.Response[lessionv1.UpdateWebhookEndpointResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetEndpointId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid endpoint_id %q", core.ErrValidation, req.Msg.GetEndpointId())
+	}
+
+	existing, err := h.service.GetWebhookEndpoint(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	mask := req.Msg.GetUpdateMask()
+	if isFieldMaskEmpty(mask) {
+		mask = &fieldmaskpb.FieldMask{
+			Paths: []string{"url", "description", "event_types", "enabled"},
+		}
+	}
+
+	if err := applyWebhookEndpointFieldMask(existing, req.Msg.GetEndpoint(), mask); err != nil {
+		return nil, err
+	}
+
+	updated, err := h.service.UpdateWebhookEndpoint(ctx, *existing)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.UpdateWebhookEndpointResponse{
+		Endpoint: toProtoWebhookEndpoint(updated),
+	}), nil
+}
+
+// DeleteWebhookEndpoint removes an endpoint and its delivery log.
+func (h *WebhookHandler) DeleteWebhookEndpoint(ctx context.Context, req *connect.Request[lessionv1.DeleteWebhookEndpointRequest]) (*connect.Response[lessionv1.DeleteWebhookEndpointResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetEndpointId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid endpoint_id %q", core.ErrValidation, req.Msg.GetEndpointId())
+	}
+
+	if err := h.service.DeleteWebhookEndpoint(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.DeleteWebhookEndpointResponse{}), nil
+}
+
+// ListWebhookDeliveries returns the delivery log, newest first.
+func (h *WebhookHandler) ListWebhookDeliveries(ctx context.Context, req *connect.Request[lessionv1.ListWebhookDeliveriesRequest]) (*connect.Response[lessionv1.ListWebhookDeliveriesResponse], error) {
+	filter := core.WebhookDeliveryListFilter{
+		PageSize:  int(req.Msg.GetPageSize()),
+		PageToken: req.Msg.GetPageToken(),
+		Statuses: lo.Map(req.Msg.GetStatuses(), func(status lessionv1.WebhookDeliveryStatus, _ int) core.WebhookDeliveryStatus {
+			return fromProtoWebhookDeliveryStatus(status)
+		}),
+	}
+	if value := req.Msg.GetEndpointId(); value != "" {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid endpoint_id %q", core.ErrValidation, value)
+		}
+		filter.EndpointID = id
+	}
+
+	deliveries, nextToken, err := h.service.ListWebhookDeliveries(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.ListWebhookDeliveriesResponse{
+		Deliveries: lo.Map(deliveries, func(delivery core.WebhookDelivery, _ int) *lessionv1.WebhookDelivery {
+			return toProtoWebhookDelivery(&delivery)
+		}),
+		NextPageToken: nextToken,
+	}), nil
+}
+
+// RedeliverWebhook attempts a logged delivery again.
+func (h *WebhookHandler) RedeliverWebhook(ctx context.Context, req *connect.Request[lessionv1.RedeliverWebhookRequest]) (*connect.Response[lessionv1.RedeliverWebhookResponse], error) {
+	id, err := uuid.Parse(req.Msg.GetDeliveryId())
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid delivery_id %q", core.ErrValidation, req.Msg.GetDeliveryId())
+	}
+
+	delivery, err := h.service.RedeliverWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&lessionv1.RedeliverWebhookResponse{
+		Delivery: toProtoWebhookDelivery(delivery),
+	}), nil
+}
+
+// ListRecentEvents returns the recent events, newest first.
+func (h *WebhookHandler) ListRecentEvents(ctx context.Context, req *connect.Request[lessionv1.ListRecentEventsRequest]) (*connect.Response[lessionv1.ListRecentEventsResponse], error) {
+	events, nextToken, err := h.service.ListRecentEvents(ctx, core.WebhookEventListFilter{
+		Types:     fromProtoWebhookEventTypes(req.Msg.GetEventTypes()),
+		PageSize:  int(req.Msg.GetPageSize()),
+		PageToken: req.Msg.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &lessionv1.ListRecentEventsResponse{NextPageToken: nextToken}
+	for _, event := range events {
+		out, err := toProtoWebhookEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		res.Events = append(res.Events, out)
+	}
+	return connect.NewResponse(res), nil
+}
+
+func applyWebhookEndpointFieldMask(target *core.WebhookEndpoint, patch *lessionv1.WebhookEndpointDraft, mask *fieldmaskpb.FieldMask) error {
+	for _, path := range mask.Paths {
+		switch strings.ToLower(path) {
+		case "url":
+			target.URL = patch.GetUrl()
+		case "description":
+			target.Description = patch.GetDescription()
+		case "event_types":
+			target.EventTypes = fromProtoWebhookEventTypes(patch.GetEventTypes())
+		case "enabled":
+			target.Enabled = patch.GetEnabled()
+		default:
+			return fmt.Errorf("%w: unsupported update path %q", core.ErrValidation, path)
+		}
+	}
+	return nil
+}
+
+// toProtoWebhookEndpoint omits the secret, which is only returned on creation.
+func toProtoWebhookEndpoint(endpoint *core.WebhookEndpoint) *lessionv1.WebhookEndpoint {
+	if endpoint == nil {
+		return nil
+	}
+	return &lessionv1.WebhookEndpoint{
+		Id:          endpoint.ID.String(),
+		Url:         endpoint.URL,
+		Description: endpoint.Description,
+		EventTypes: lo.Map(endpoint.EventTypes, func(eventType core.WebhookEventType, _ int) lessionv1.WebhookEventType {
+			return toProtoWebhookEventType(eventType)
+		}),
+		Enabled:   endpoint.Enabled,
+		CreatedAt: timestamppb.New(endpoint.CreatedAt),
+		UpdatedAt: timestamppb.New(endpoint.UpdatedAt),
+	}
+}
+
+func toProtoWebhookDelivery(delivery *core.WebhookDelivery) *lessionv1.WebhookDelivery {
+	if delivery == nil {
+		return nil
+	}
+	out := &lessionv1.WebhookDelivery{
+		Id:             delivery.ID.String(),
+		EndpointId:     delivery.EndpointID.String(),
+		EventId:        delivery.EventID.String(),
+		EventType:      toProtoWebhookEventType(delivery.EventType),
+		Payload:        string(delivery.Payload),
+		Status:         toProtoWebhookDeliveryStatus(delivery.Status),
+		Attempts:       uint32(delivery.Attempts),
+		ResponseStatus: int32(delivery.ResponseStatus),
+		Error:          delivery.Error,
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+	}
+	if delivery.NextAttemptAt != nil {
+		out.NextAttemptAt = timestamppb.New(*delivery.NextAttemptAt)
+	}
+	if delivery.DeliveredAt != nil {
+		out.DeliveredAt = timestamppb.New(*delivery.DeliveredAt)
+	}
+	return out
+}
+
+// toProtoWebhookEvent unpacks the data object of the stored webhook payload.
+func toProtoWebhookEvent(event core.WebhookEvent) (*lessionv1.WebhookEvent, error) {
+	var payload struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return nil, fmt.Errorf("decode webhook event %s: %w", event.ID, err)
+	}
+	data, err := structpb.NewStruct(payload.Data)
+	if err != nil {
+		return nil, fmt.Errorf("decode webhook event %s: %w", event.ID, err)
+	}
+	return &lessionv1.WebhookEvent{
+		Id:        event.ID.String(),
+		Type:      toProtoWebhookEventType(event.Type),
+		Name:      event.Type.String(),
+		CreatedAt: timestamppb.New(event.CreatedAt),
+		Data:      data,
+	}, nil
+}
+
+func fromProtoWebhookEventTypes(eventTypes []lessionv1.WebhookEventType) []core.WebhookEventType {
+	return lo.Map(eventTypes, func(eventType lessionv1.WebhookEventType, _ int) core.WebhookEventType {
+		return fromProtoWebhookEventType(eventType)
+	})
+}
+
+func fromProtoWebhookEventType(eventType lessionv1.WebhookEventType) core.WebhookEventType {
+	switch eventType {
+	case lessionv1.WebhookEventType_WEBHOOK_EVENT_TYPE_SERIES_PUBLISHED:
+		return core.WebhookEventTypeSeriesPublished
+	case lessionv1.WebhookEventType_WEBHOOK_EVENT_TYPE_EPISODE_CREATED:
+		return core.WebhookEventTypeEpisodeCreated
+	case lessionv1.WebhookEventType_WEBHOOK_EVENT_TYPE_ASSET_READY:
+		return core.WebhookEventTypeAssetReady
+	case lessionv1.WebhookEventType_WEBHOOK_EVENT_TYPE_EPISODE_PUBLISHED:
+		return core.WebhookEventTypeEpisodePublished
+	default:
+		return core.WebhookEventTypeUnspecified
+	}
+}
+
+func toProtoWebhookEventType(eventType core.WebhookEventType) lessionv1.WebhookEventType {
+	switch eventType {
+	case core.WebhookEventTypeSeriesPublished:
+		return lessionv1.WebhookEventType_WEBHOOK_EVENT_TYPE_SERIES_PUBLISHED
+	case core.WebhookEventTypeEpisodeCreated:
+		return lessionv1.WebhookEventType_WEBHOOK_EVENT_TYPE_EPISODE_CREATED
+	case core.WebhookEventTypeAssetReady:
+		return lessionv1.WebhookEventType_WEBHOOK_EVENT_TYPE_ASSET_READY
+	case core.WebhookEventTypeEpisodePublished:
+		return lessionv1.WebhookEventType_WEBHOOK_EVENT_TYPE_EPISODE_PUBLISHED
+	default:
+		return lessionv1.WebhookEventType_WEBHOOK_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+func fromProtoWebhookDeliveryStatus(status lessionv1.WebhookDeliveryStatus) core.WebhookDeliveryStatus {
+	switch status {
+	case lessionv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING:
+		return core.WebhookDeliveryStatusPending
+	case lessionv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED:
+		return core.WebhookDeliveryStatusSucceeded
+	case lessionv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED:
+		return core.WebhookDeliveryStatusFailed
+	default:
+		return core.WebhookDeliveryStatusUnspecified
+	}
+}
+
+func toProtoWebhookDeliveryStatus(status core.WebhookDeliveryStatus) lessionv1.WebhookDeliveryStatus {
+	switch status {
+	case core.WebhookDeliveryStatusPending:
+		return lessionv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	case core.WebhookDeliveryStatusSucceeded:
+		return lessionv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+	case core.WebhookDeliveryStatusFailed:
+		return lessionv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+	default:
+		return lessionv1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,210 @@
+package transport
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// WidgetSignatureHeader carries the Ed25519 signature over the exact response body.
+	WidgetSignatureHeader = "X-Widget-Signature"
+
+	widgetCacheMaxAge = 5 * time.Minute
+)
+
+// WidgetHandler serves signed, cacheable JSON for embeddable public widgets.
+// Payloads are intentionally minimal so third-party sites can show verified
+// badges and stats without API credentials.
+type WidgetHandler struct {
+	widgets  core.WidgetService
+	learners core.LearnerStatsService
+	signer   *WidgetSigner
+}
+
+// NewWidgetHandler constructs a widget handler backed by the provided services.
+func NewWidgetHandler(widgets core.WidgetService, learners core.LearnerStatsService, signer *WidgetSigner) *WidgetHandler {
+	return &WidgetHandler{
+		widgets:  widgets,
+		learners: learners,
+		signer:   signer,
+	}
+}
+
+type widgetSeriesStats struct {
+	SeriesID             string `json:"series_id"`
+	Slug                 string `json:"slug"`
+	Title                string `json:"title"`
+	EpisodeCount         int    `json:"episode_count"`
+	TotalDurationSeconds int64  `json:"total_duration_seconds"`
+}
+
+type widgetCertificate struct {
+	UserID            string     `json:"user_id"`
+	SeriesID          string     `json:"series_id"`
+	SeriesTitle       string     `json:"series_title"`
+	EpisodesCompleted int        `json:"episodes_completed"`
+	EpisodeCount      int        `json:"episode_count"`
+	Verified          bool       `json:"verified"`
+	CompletedAt       *time.Time `json:"completed_at,omitempty"`
+}
+
+type widgetLearnerBadge struct {
+	UserID                 string `json:"user_id"`
+	CurrentStreakDays      int    `json:"current_streak_days"`
+	LongestStreakDays      int    `json:"longest_streak_days"`
+	TotalMinutesListened   int    `json:"total_minutes_listened"`
+	TotalEpisodesCompleted int    `json:"total_episodes_completed"`
+}
+
+type widgetSigningKey struct {
+	KeyID     string `json:"key_id"`
+	Algorithm string `json:"algorithm"`
+	PublicKey string `json:"public_key"`
+}
+
+type widgetError struct {
+	Error string `json:"error"`
+}
+
+// Register mounts the widget endpoints on mux.
+func (h *WidgetHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /widgets/v1/series/{series_id}/stats", h.seriesStats)
+	mux.HandleFunc("GET /widgets/v1/series/{series_id}/certificates/{user_id}", h.certificate)
+	mux.HandleFunc("GET /widgets/v1/learners/{user_id}/badge", h.learnerBadge)
+	mux.HandleFunc("GET /widgets/v1/signing-key", h.signingKey)
+}
+
+func (h *WidgetHandler) seriesStats(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, func(ctx context.Context) (any, error) {
+		seriesID, err := parseWidgetSeriesID(r)
+		if err != nil {
+			return nil, err
+		}
+		stats, err := h.widgets.GetSeriesWidgetStats(ctx, seriesID)
+		if err != nil {
+			return nil, err
+		}
+		return widgetSeriesStats{
+			SeriesID:             stats.SeriesID.String(),
+			Slug:                 stats.Slug,
+			Title:                stats.Title,
+			EpisodeCount:         stats.EpisodeCount,
+			TotalDurationSeconds: int64(stats.TotalDuration / time.Second),
+		}, nil
+	})
+}
+
+func (h *WidgetHandler) certificate(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, func(ctx context.Context) (any, error) {
+		seriesID, err := parseWidgetSeriesID(r)
+		if err != nil {
+			return nil, err
+		}
+		certificate, err := h.widgets.VerifySeriesCertificate(ctx, seriesID, r.PathValue("user_id"))
+		if err != nil {
+			return nil, err
+		}
+		return widgetCertificate{
+			UserID:            certificate.UserID,
+			SeriesID:          certificate.SeriesID.String(),
+			SeriesTitle:       certificate.SeriesTitle,
+			EpisodesCompleted: certificate.EpisodesCompleted,
+			EpisodeCount:      certificate.EpisodeCount,
+			Verified:          certificate.Completed,
+			CompletedAt:       certificate.CompletedAt,
+		}, nil
+	})
+}
+
+func (h *WidgetHandler) learnerBadge(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, func(ctx context.Context) (any, error) {
+		stats, err := h.learners.GetLearnerStats(ctx, r.PathValue("user_id"))
+		if err != nil {
+			return nil, err
+		}
+		return widgetLearnerBadge{
+			UserID:                 stats.UserID,
+			CurrentStreakDays:      stats.CurrentStreakDays,
+			LongestStreakDays:      stats.LongestStreakDays,
+			TotalMinutesListened:   stats.TotalMinutesListened,
+			TotalEpisodesCompleted: stats.TotalEpisodesCompleted,
+		}, nil
+	})
+}
+
+func (h *WidgetHandler) signingKey(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, func(context.Context) (any, error) {
+		return widgetSigningKey{
+			KeyID:     h.signer.KeyID(),
+			Algorithm: "ed25519",
+			PublicKey: base64.StdEncoding.EncodeToString(h.signer.PublicKey()),
+		}, nil
+	})
+}
+
+// serve encodes the payload, signs it, and answers conditional requests with
+// 304 so widgets can be cached by browsers and CDNs.
+func (h *WidgetHandler) serve(w http.ResponseWriter, r *http.Request, load func(context.Context) (any, error)) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	payload, err := load(r.Context())
+	if err != nil {
+		writeWidgetError(w, err)
+		return
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		writeWidgetError(w, err)
+		return
+	}
+
+	digest := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(digest[:16]) + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(int(widgetCacheMaxAge/time.Second)))
+	w.Header().Set(WidgetSignatureHeader, fmt.Sprintf("keyid=%s, sig=%s", h.signer.KeyID(), h.signer.Sign(body)))
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+func parseWidgetSeriesID(r *http.Request) (uuid.UUID, error) {
+	id, err := uuid.Parse(r.PathValue("series_id"))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: invalid series_id %q", core.ErrValidation, r.PathValue("series_id"))
+	}
+	return id, nil
+}
+
+func writeWidgetError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	message := "internal error"
+	switch {
+	case errors.Is(err, core.ErrValidation):
+		status, message = http.StatusBadRequest, err.Error()
+	case errors.Is(err, core.ErrNotFound):
+		status, message = http.StatusNotFound, "not found"
+	}
+
+	body, _ := json.Marshal(widgetError{Error: message})
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
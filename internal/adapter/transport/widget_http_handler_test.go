@@ -0,0 +1,82 @@
+package transport
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestWidgetHandler_SignedAndCacheable(t *testing.T) {
+	seriesID := uuid.New()
+	signer, err := NewWidgetSigner(make([]byte, ed25519.SeedSize))
+	if err != nil {
+		t.Fatalf("NewWidgetSigner() error = %v", err)
+	}
+	handler := NewWidgetHandler(stubWidgetService{
+		stats: map[uuid.UUID]core.SeriesWidgetStats{
+			seriesID: {SeriesID: seriesID, Slug: "travel", Title: "Travel", EpisodeCount: 2, TotalDuration: 90 * time.Second},
+		},
+	}, nil, signer)
+	mux := http.NewServeMux()
+	handler.Register(mux)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/widgets/v1/series/"+seriesID.String()+"/stats", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body.String())
+	}
+	body := rec.Body.Bytes()
+	if !strings.Contains(string(body), `"total_duration_seconds":90`) {
+		t.Fatalf("unexpected body %s", body)
+	}
+
+	header := rec.Header().Get(WidgetSignatureHeader)
+	sig, ok := strings.CutPrefix(header, "keyid="+signer.KeyID()+", sig=")
+	if !ok {
+		t.Fatalf("unexpected signature header %q", header)
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !ed25519.Verify(signer.PublicKey(), body, raw) {
+		t.Fatalf("signature does not verify against body")
+	}
+
+	etag := rec.Header().Get("ETag")
+	req := httptest.NewRequest(http.MethodGet, "/widgets/v1/series/"+seriesID.String()+"/stats", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+		t.Fatalf("expected 304 for matching etag, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/widgets/v1/series/"+uuid.NewString()+"/stats", nil))
+	if rec.Code != http.StatusNotFound || rec.Header().Get("Cache-Control") != "no-store" {
+		t.Fatalf("expected uncached 404, got %d %q", rec.Code, rec.Header().Get("Cache-Control"))
+	}
+}
+
+type stubWidgetService struct {
+	stats map[uuid.UUID]core.SeriesWidgetStats
+}
+
+func (s stubWidgetService) GetSeriesWidgetStats(ctx context.Context, seriesID uuid.UUID) (*core.SeriesWidgetStats, error) {
+	stats, ok := s.stats[seriesID]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	return &stats, nil
+}
+
+func (s stubWidgetService) VerifySeriesCertificate(ctx context.Context, seriesID uuid.UUID, userID string) (*core.SeriesCertificate, error) {
+	return nil, core.ErrNotFound
+}
@@ -0,0 +1,55 @@
+package transport
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// WidgetSigner signs public widget payloads with Ed25519 so embedding sites
+// can verify responses against the published public key.
+type WidgetSigner struct {
+	key   ed25519.PrivateKey
+	keyID string
+}
+
+// NewWidgetSigner constructs a signer from a 32-byte Ed25519 seed.
+func NewWidgetSigner(seed []byte) (*WidgetSigner, error) {
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("widget signing key must be a %d-byte seed, got %d bytes", ed25519.SeedSize, len(seed))
+	}
+	key := ed25519.NewKeyFromSeed(seed)
+	digest := sha256.Sum256(key.Public().(ed25519.PublicKey))
+	return &WidgetSigner{
+		key:   key,
+		keyID: hex.EncodeToString(digest[:8]),
+	}, nil
+}
+
+// NewEphemeralWidgetSigner generates a random signing key. Signatures do not
+// survive restarts, so it is only suitable for local development.
+func NewEphemeralWidgetSigner() (*WidgetSigner, error) {
+	seed := make([]byte, ed25519.SeedSize)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, err
+	}
+	return NewWidgetSigner(seed)
+}
+
+// KeyID identifies the signing key.
+func (s *WidgetSigner) KeyID() string {
+	return s.keyID
+}
+
+// PublicKey returns the key embedding sites verify signatures with.
+func (s *WidgetSigner) PublicKey() ed25519.PublicKey {
+	return s.key.Public().(ed25519.PublicKey)
+}
+
+// Sign returns the base64url-encoded signature of payload.
+func (s *WidgetSigner) Sign(payload []byte) string {
+	return base64.RawURLEncoding.EncodeToString(ed25519.Sign(s.key, payload))
+}
@@ -0,0 +1,318 @@
+// Package bigquery exports analytics to BigQuery tables partitioned by day,
+// loading each day with a load job that replaces the day's partition.
+package bigquery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/adapter/googleauth"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// DefaultAPIBase is the BigQuery production endpoint.
+	DefaultAPIBase   = "https://bigquery.googleapis.com"
+	bigqueryScope    = "https://www.googleapis.com/auth/bigquery"
+	maxErrorBodySize = 4096
+	// defaultPollInterval is how often a running load job is checked.
+	defaultPollInterval = 2 * time.Second
+
+	rollupsTable  = "engagement_rollups"
+	sessionsTable = "playback_sessions"
+)
+
+// field describes a column of a BigQuery table.
+type field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Mode string `json:"mode"`
+}
+
+// tableSchemas are the columns of the exported tables. Tables are created by
+// the first load and partitioned by their first column.
+var tableSchemas = map[string][]field{
+	rollupsTable: {
+		{Name: "day", Type: "DATE", Mode: "REQUIRED"},
+		{Name: "subject_type", Type: "STRING", Mode: "REQUIRED"},
+		{Name: "subject_id", Type: "STRING", Mode: "REQUIRED"},
+		{Name: "series_id", Type: "STRING", Mode: "REQUIRED"},
+		{Name: "episode_id", Type: "STRING", Mode: "NULLABLE"},
+		{Name: "sessions", Type: "INTEGER", Mode: "REQUIRED"},
+		{Name: "finished_sessions", Type: "INTEGER", Mode: "REQUIRED"},
+		{Name: "unique_listeners", Type: "INTEGER", Mode: "REQUIRED"},
+		{Name: "completers", Type: "INTEGER", Mode: "REQUIRED"},
+		{Name: "completion_rate", Type: "FLOAT", Mode: "REQUIRED"},
+		{Name: "listen_through", Type: "FLOAT", Mode: "REQUIRED"},
+		{Name: "updated_at", Type: "TIMESTAMP", Mode: "REQUIRED"},
+	},
+	sessionsTable: {
+		{Name: "started_at", Type: "TIMESTAMP", Mode: "REQUIRED"},
+		{Name: "id", Type: "STRING", Mode: "REQUIRED"},
+		{Name: "user_id", Type: "STRING", Mode: "REQUIRED"},
+		{Name: "episode_id", Type: "STRING", Mode: "REQUIRED"},
+		{Name: "device", Type: "STRING", Mode: "REQUIRED"},
+		{Name: "finished_at", Type: "TIMESTAMP", Mode: "NULLABLE"},
+	},
+}
+
+// Sink implements core.AnalyticsExportSink on a BigQuery dataset.
+type Sink struct {
+	tokens       *googleauth.TokenSource
+	projectID    string
+	dataset      string
+	apiBase      string
+	httpClient   *http.Client
+	pollInterval time.Duration
+}
+
+// NewSink constructs a sink loading into dataset with a service account key
+// file. Jobs run in the service account's project.
+func NewSink(credentials []byte, dataset string) (*Sink, error) {
+	tokens, err := googleauth.NewTokenSource(credentials, bigqueryScope)
+	if err != nil {
+		return nil, fmt.Errorf("bigquery: %w", err)
+	}
+	return &Sink{
+		tokens:       tokens,
+		projectID:    tokens.ProjectID(),
+		dataset:      dataset,
+		apiBase:      DefaultAPIBase,
+		httpClient:   &http.Client{Timeout: 5 * time.Minute},
+		pollInterval: defaultPollInterval,
+	}, nil
+}
+
+// WithProject loads into a dataset of another project than the service
+// account's.
+func (s *Sink) WithProject(projectID string) {
+	if projectID != "" {
+		s.projectID = projectID
+	}
+}
+
+// WithAPIBase points the sink at a different BigQuery endpoint, such as a
+// mock server.
+func (s *Sink) WithAPIBase(base string) {
+	if base != "" {
+		s.apiBase = strings.TrimRight(base, "/")
+	}
+}
+
+// WithHTTPClient overrides the HTTP client used for BigQuery and token
+// requests.
+func (s *Sink) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		s.httpClient = client
+		s.tokens.WithHTTPClient(client)
+	}
+}
+
+// WithPollInterval overrides how often running load jobs are checked.
+func (s *Sink) WithPollInterval(interval time.Duration) {
+	if interval > 0 {
+		s.pollInterval = interval
+	}
+}
+
+// WithClock overrides the clock used for token assertions and expiry.
+func (s *Sink) WithClock(fn func() time.Time) {
+	s.tokens.WithClock(fn)
+}
+
+var _ core.AnalyticsExportSink = (*Sink)(nil)
+
+// ExportEngagementRollups replaces the day's partition of the
+// engagement_rollups table with the rollups.
+func (s *Sink) ExportEngagementRollups(ctx context.Context, day time.Time, rollups []core.EngagementRollup) error {
+	rows := make([]map[string]any, 0, len(rollups))
+	for _, rollup := range rollups {
+		row := map[string]any{
+			"day":               day.UTC().Format(time.DateOnly),
+			"subject_type":      "series",
+			"subject_id":        rollup.SubjectID.String(),
+			"series_id":         rollup.SeriesID.String(),
+			"sessions":          rollup.Metrics.Sessions,
+			"finished_sessions": rollup.Metrics.FinishedSessions,
+			"unique_listeners":  rollup.Metrics.UniqueListeners,
+			"completers":        rollup.Metrics.Completers,
+			"completion_rate":   rollup.Metrics.CompletionRate(),
+			"listen_through":    rollup.Metrics.ListenThrough(),
+			"updated_at":        rollup.UpdatedAt.UTC().Format(time.RFC3339Nano),
+		}
+		if episodeID := rollup.EpisodeID(); episodeID != uuid.Nil {
+			row["subject_type"] = "episode"
+			row["episode_id"] = episodeID.String()
+		}
+		rows = append(rows, row)
+	}
+	return s.load(ctx, rollupsTable, day, rows)
+}
+
+// ExportPlaybackSessions replaces the day's partition of the
+// playback_sessions table with the sessions.
+func (s *Sink) ExportPlaybackSessions(ctx context.Context, day time.Time, sessions []core.PlaybackSession) error {
+	rows := make([]map[string]any, 0, len(sessions))
+	for _, session := range sessions {
+		row := map[string]any{
+			"started_at": session.StartedAt.UTC().Format(time.RFC3339Nano),
+			"id":         session.ID.String(),
+			"user_id":    session.UserID,
+			"episode_id": session.EpisodeID.String(),
+			"device":     session.Device,
+		}
+		if session.FinishedAt != nil {
+			row["finished_at"] = session.FinishedAt.UTC().Format(time.RFC3339Nano)
+		}
+		rows = append(rows, row)
+	}
+	return s.load(ctx, sessionsTable, day, rows)
+}
+
+// load runs a load job of newline-delimited JSON rows into the day's
+// partition of table and waits for it to finish. WRITE_TRUNCATE on the
+// partition decorator replaces only that day.
+func (s *Sink) load(ctx context.Context, table string, day time.Time, rows []map[string]any) error {
+	var data bytes.Buffer
+	encoder := json.NewEncoder(&data)
+	for _, row := range rows {
+		if err := encoder.Encode(row); err != nil {
+			return err
+		}
+	}
+
+	schema := tableSchemas[table]
+	config := map[string]any{
+		"jobReference": map[string]string{
+			"projectId": s.projectID,
+			"jobId":     fmt.Sprintf("lession_%s_%s_%s", table, day.UTC().Format("20060102"), uuid.NewString()),
+		},
+		"configuration": map[string]any{
+			"load": map[string]any{
+				"destinationTable": map[string]string{
+					"projectId": s.projectID,
+					"datasetId": s.dataset,
+					"tableId":   table + "$" + day.UTC().Format("20060102"),
+				},
+				"schema":            map[string]any{"fields": schema},
+				"timePartitioning":  map[string]string{"type": "DAY", "field": schema[0].Name},
+				"sourceFormat":      "NEWLINE_DELIMITED_JSON",
+				"writeDisposition":  "WRITE_TRUNCATE",
+				"createDisposition": "CREATE_IF_NEEDED",
+			},
+		},
+	}
+	metadata, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for _, part := range []struct {
+		contentType string
+		content     []byte
+	}{
+		{contentType: "application/json; charset=UTF-8", content: metadata},
+		{contentType: "application/octet-stream", content: data.Bytes()},
+	} {
+		w, err := writer.CreatePart(textproto.MIMEHeader{"Content-Type": {part.contentType}})
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(part.content); err != nil {
+			return err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("%s/upload/bigquery/v2/projects/%s/jobs?uploadType=multipart", s.apiBase, url.PathEscape(s.projectID))
+	var job loadJob
+	if err := s.do(ctx, http.MethodPost, endpoint, "multipart/related; boundary="+writer.Boundary(), &body, &job); err != nil {
+		return fmt.Errorf("bigquery: load %s: %w", table, err)
+	}
+	return s.wait(ctx, table, job)
+}
+
+// loadJob holds the fields of a BigQuery job resource the sink reads.
+type loadJob struct {
+	JobReference struct {
+		ProjectID string `json:"projectId"`
+		JobID     string `json:"jobId"`
+		Location  string `json:"location"`
+	} `json:"jobReference"`
+	Status struct {
+		State       string `json:"state"`
+		ErrorResult *struct {
+			Reason  string `json:"reason"`
+			Message string `json:"message"`
+		} `json:"errorResult"`
+	} `json:"status"`
+}
+
+// wait polls the job until it is done and reports its error, if any.
+func (s *Sink) wait(ctx context.Context, table string, job loadJob) error {
+	for job.Status.State != "DONE" {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.pollInterval):
+		}
+		query := url.Values{}
+		if job.JobReference.Location != "" {
+			query.Set("location", job.JobReference.Location)
+		}
+		endpoint := fmt.Sprintf("%s/bigquery/v2/projects/%s/jobs/%s?%s", s.apiBase,
+			url.PathEscape(job.JobReference.ProjectID), url.PathEscape(job.JobReference.JobID), query.Encode())
+		if err := s.do(ctx, http.MethodGet, endpoint, "", nil, &job); err != nil {
+			return fmt.Errorf("bigquery: load %s: %w", table, err)
+		}
+	}
+	if result := job.Status.ErrorResult; result != nil {
+		return fmt.Errorf("bigquery: load %s: %s: %s", table, result.Reason, result.Message)
+	}
+	return nil
+}
+
+// do sends an authorized request and decodes the JSON response into out.
+func (s *Sink) do(ctx context.Context, method, endpoint, contentType string, body io.Reader, out any) error {
+	accessToken, err := s.tokens.Token(ctx)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		if resp.StatusCode == http.StatusUnauthorized {
+			s.tokens.Reset()
+		}
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
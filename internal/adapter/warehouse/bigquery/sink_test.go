@@ -0,0 +1,146 @@
+package bigquery
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/adapter/googleauth"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// loadRequest is a load job as received by the test server.
+type loadRequest struct {
+	config map[string]any
+	rows   []map[string]any
+}
+
+func newTestSink(t *testing.T, failJob bool) (*Sink, *[]loadRequest) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey() error = %v", err)
+	}
+
+	var loads []loadRequest
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "bq-token", "expires_in": 3600})
+	})
+	mux.HandleFunc("POST /upload/bigquery/v2/projects/analytics-project/jobs", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer bq-token" {
+			http.Error(w, "unauthenticated", http.StatusUnauthorized)
+			return
+		}
+		_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		reader := multipart.NewReader(r.Body, params["boundary"])
+		var load loadRequest
+		part, _ := reader.NextPart()
+		_ = json.NewDecoder(part).Decode(&load.config)
+		part, _ = reader.NextPart()
+		scanner := bufio.NewScanner(part)
+		for scanner.Scan() {
+			var row map[string]any
+			_ = json.Unmarshal(scanner.Bytes(), &row)
+			load.rows = append(load.rows, row)
+		}
+		loads = append(loads, load)
+		_, _ = io.WriteString(w, `{"jobReference":{"projectId":"analytics-project","jobId":"job-1","location":"EU"},"status":{"state":"RUNNING"}}`)
+	})
+	mux.HandleFunc("GET /bigquery/v2/projects/analytics-project/jobs/job-1", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("location") != "EU" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		if failJob {
+			_, _ = io.WriteString(w, `{"jobReference":{"projectId":"analytics-project","jobId":"job-1"},"status":{"state":"DONE","errorResult":{"reason":"invalid","message":"bad row"}}}`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"jobReference":{"projectId":"analytics-project","jobId":"job-1"},"status":{"state":"DONE"}}`)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	credentials, _ := json.Marshal(googleauth.ServiceAccount{
+		ProjectID:   "lession-app",
+		ClientEmail: "export@lession-app.iam.gserviceaccount.com",
+		PrivateKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		TokenURI:    server.URL + "/token",
+	})
+	sink, err := NewSink(credentials, "lession")
+	if err != nil {
+		t.Fatalf("NewSink() error = %v", err)
+	}
+	sink.WithProject("analytics-project")
+	sink.WithAPIBase(server.URL)
+	sink.WithPollInterval(time.Millisecond)
+	return sink, &loads
+}
+
+func TestSink_ExportEngagementRollups(t *testing.T) {
+	sink, loads := newTestSink(t, false)
+	day := time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)
+	seriesID, episodeID := uuid.New(), uuid.New()
+
+	err := sink.ExportEngagementRollups(context.Background(), day, []core.EngagementRollup{
+		{SubjectID: seriesID, SeriesID: seriesID, Metrics: core.EngagementMetrics{Sessions: 2, UniqueListeners: 2, Completers: 1}},
+		{SubjectID: episodeID, SeriesID: seriesID, Metrics: core.EngagementMetrics{Sessions: 2, FinishedSessions: 1}},
+	})
+	if err != nil {
+		t.Fatalf("ExportEngagementRollups() error = %v", err)
+	}
+
+	if len(*loads) != 1 {
+		t.Fatalf("expected one load job, got %d", len(*loads))
+	}
+	load := (*loads)[0]
+	config := load.config["configuration"].(map[string]any)["load"].(map[string]any)
+	table := config["destinationTable"].(map[string]any)
+	if table["projectId"] != "analytics-project" || table["datasetId"] != "lession" || table["tableId"] != "engagement_rollups$20240515" {
+		t.Fatalf("unexpected destination %v", table)
+	}
+	if config["writeDisposition"] != "WRITE_TRUNCATE" {
+		t.Fatalf("expected the partition to be replaced, got %v", config["writeDisposition"])
+	}
+	if len(load.rows) != 2 || load.rows[0]["subject_type"] != "series" || load.rows[0]["completion_rate"] != 0.5 {
+		t.Fatalf("unexpected series row %v", load.rows)
+	}
+	if load.rows[1]["episode_id"] != episodeID.String() || load.rows[1]["day"] != "2024-05-15" {
+		t.Fatalf("unexpected episode row %v", load.rows[1])
+	}
+}
+
+func TestSink_ExportPlaybackSessionsJobError(t *testing.T) {
+	sink, _ := newTestSink(t, true)
+	day := time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)
+
+	err := sink.ExportPlaybackSessions(context.Background(), day, []core.PlaybackSession{
+		{ID: uuid.New(), UserID: "u1", EpisodeID: uuid.New(), StartedAt: day},
+	})
+	if err == nil || !strings.Contains(err.Error(), "bad row") {
+		t.Fatalf("expected the job error, got %v", err)
+	}
+}
@@ -0,0 +1,267 @@
+// Package s3 exports analytics as Parquet files to an S3 bucket, or any
+// S3-compatible store, partitioned by day in the Hive layout query engines
+// such as Athena, Spark and DuckDB read directly.
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/parquet-go/parquet-go"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	maxErrorBodySize = 4096
+	// objectName is the name of the single file written per partition, so
+	// exporting a day again overwrites it.
+	objectName = "part-0.parquet"
+)
+
+// rollupRow is a core.EngagementRollup as written to Parquet.
+type rollupRow struct {
+	Day              time.Time `parquet:"day,timestamp(millisecond)"`
+	SubjectType      string    `parquet:"subject_type"`
+	SubjectID        string    `parquet:"subject_id"`
+	SeriesID         string    `parquet:"series_id"`
+	EpisodeID        string    `parquet:"episode_id,optional"`
+	Sessions         int64     `parquet:"sessions"`
+	FinishedSessions int64     `parquet:"finished_sessions"`
+	UniqueListeners  int64     `parquet:"unique_listeners"`
+	Completers       int64     `parquet:"completers"`
+	CompletionRate   float64   `parquet:"completion_rate"`
+	ListenThrough    float64   `parquet:"listen_through"`
+	UpdatedAt        time.Time `parquet:"updated_at,timestamp(millisecond)"`
+}
+
+// sessionRow is a core.PlaybackSession as written to Parquet.
+type sessionRow struct {
+	ID        string    `parquet:"id"`
+	UserID    string    `parquet:"user_id"`
+	EpisodeID string    `parquet:"episode_id"`
+	Device    string    `parquet:"device"`
+	StartedAt time.Time `parquet:"started_at,timestamp(millisecond)"`
+	// FinishedAt is null for sessions that were not played to the end.
+	FinishedAt time.Time `parquet:"finished_at,timestamp(millisecond),optional"`
+}
+
+// Sink implements core.AnalyticsExportSink on an S3 bucket. Requests use
+// path-style URLs signed with AWS Signature Version 4.
+type Sink struct {
+	endpoint        string
+	bucket          string
+	region          string
+	prefix          string
+	accessKeyID     string
+	secretAccessKey string
+	httpClient      *http.Client
+	now             func() time.Time
+}
+
+// NewSink constructs a sink writing to bucket in region with the given
+// access key.
+func NewSink(bucket, region, accessKeyID, secretAccessKey string) *Sink {
+	return &Sink{
+		endpoint:        fmt.Sprintf("https://s3.%s.amazonaws.com", region),
+		bucket:          bucket,
+		region:          region,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		httpClient:      &http.Client{Timeout: 5 * time.Minute},
+		now:             time.Now,
+	}
+}
+
+// WithEndpoint points the sink at an S3-compatible store such as MinIO or
+// Cloudflare R2.
+func (s *Sink) WithEndpoint(endpoint string) {
+	if endpoint != "" {
+		s.endpoint = strings.TrimRight(endpoint, "/")
+	}
+}
+
+// WithPrefix stores the exports under prefix within the bucket.
+func (s *Sink) WithPrefix(prefix string) {
+	s.prefix = strings.Trim(prefix, "/")
+}
+
+// WithHTTPClient overrides the HTTP client used for S3 requests.
+func (s *Sink) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		s.httpClient = client
+	}
+}
+
+// WithClock overrides the clock used to sign requests.
+func (s *Sink) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.AnalyticsExportSink = (*Sink)(nil)
+
+// ExportEngagementRollups writes the rollups to
+// engagement_rollups/day=YYYY-MM-DD/part-0.parquet.
+func (s *Sink) ExportEngagementRollups(ctx context.Context, day time.Time, rollups []core.EngagementRollup) error {
+	rows := lo.Map(rollups, func(rollup core.EngagementRollup, _ int) rollupRow {
+		row := rollupRow{
+			Day:              day,
+			SubjectType:      "series",
+			SubjectID:        rollup.SubjectID.String(),
+			SeriesID:         rollup.SeriesID.String(),
+			Sessions:         int64(rollup.Metrics.Sessions),
+			FinishedSessions: int64(rollup.Metrics.FinishedSessions),
+			UniqueListeners:  int64(rollup.Metrics.UniqueListeners),
+			Completers:       int64(rollup.Metrics.Completers),
+			CompletionRate:   rollup.Metrics.CompletionRate(),
+			ListenThrough:    rollup.Metrics.ListenThrough(),
+			UpdatedAt:        rollup.UpdatedAt,
+		}
+		if episodeID := rollup.EpisodeID(); episodeID != uuid.Nil {
+			row.SubjectType = "episode"
+			row.EpisodeID = episodeID.String()
+		}
+		return row
+	})
+	return write(ctx, s, s.key("engagement_rollups", day), rows)
+}
+
+// ExportPlaybackSessions writes the sessions to
+// playback_sessions/day=YYYY-MM-DD/part-0.parquet.
+func (s *Sink) ExportPlaybackSessions(ctx context.Context, day time.Time, sessions []core.PlaybackSession) error {
+	rows := lo.Map(sessions, func(session core.PlaybackSession, _ int) sessionRow {
+		row := sessionRow{
+			ID:        session.ID.String(),
+			UserID:    session.UserID,
+			EpisodeID: session.EpisodeID.String(),
+			Device:    session.Device,
+			StartedAt: session.StartedAt,
+		}
+		if session.FinishedAt != nil {
+			row.FinishedAt = *session.FinishedAt
+		}
+		return row
+	})
+	return write(ctx, s, s.key("playback_sessions", day), rows)
+}
+
+func (s *Sink) key(table string, day time.Time) string {
+	key := fmt.Sprintf("%s/day=%s/%s", table, day.UTC().Format(time.DateOnly), objectName)
+	if s.prefix != "" {
+		key = s.prefix + "/" + key
+	}
+	return key
+}
+
+// write encodes rows as a Snappy-compressed Parquet file and uploads it.
+func write[T any](ctx context.Context, s *Sink, key string, rows []T) error {
+	var buf bytes.Buffer
+	if err := parquet.Write(&buf, rows, parquet.Compression(&parquet.Snappy)); err != nil {
+		return fmt.Errorf("s3: encode %s: %w", key, err)
+	}
+	return s.put(ctx, key, buf.Bytes())
+}
+
+// put uploads an object, replacing any object with the same key.
+func (s *Sink) put(ctx context.Context, key string, body []byte) error {
+	endpoint := fmt.Sprintf("%s/%s/%s", s.endpoint, s.bucket, escapePath(key))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/vnd.apache.parquet")
+	s.sign(req, body)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("s3: put %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return fmt.Errorf("s3: put %s: unexpected status %d: %s", key, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to req.
+func (s *Sink) sign(req *http.Request, body []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "content-type;host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		"",
+		"content-type:" + req.Header.Get("Content-Type"),
+		"host:" + req.URL.Host,
+		"x-amz-content-sha256:" + payloadHash,
+		"x-amz-date:" + amzDate,
+		"",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretAccessKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKeyID, scope, signedHeaders, signature))
+}
+
+// escapePath percent-encodes every byte of an object key except unreserved
+// characters and slashes, as Signature Version 4 canonical URIs require.
+func escapePath(key string) string {
+	var b strings.Builder
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		if c == '/' || c == '-' || c == '_' || c == '.' || c == '~' ||
+			('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9') {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
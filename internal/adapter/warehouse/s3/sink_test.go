@@ -0,0 +1,92 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestSink_ExportEngagementRollups(t *testing.T) {
+	day := time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)
+	seriesID, episodeID := uuid.New(), uuid.New()
+
+	var (
+		path, auth string
+		body       []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		path = r.URL.EscapedPath()
+		auth = r.Header.Get("Authorization")
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	sink := NewSink("analytics", "eu-west-1", "AKID", "secret")
+	sink.WithEndpoint(server.URL)
+	sink.WithPrefix("/lession/")
+	sink.WithClock(func() time.Time { return day.Add(26 * time.Hour) })
+
+	err := sink.ExportEngagementRollups(context.Background(), day, []core.EngagementRollup{
+		{SubjectID: seriesID, SeriesID: seriesID, Day: &day, Metrics: core.EngagementMetrics{Sessions: 4, UniqueListeners: 2, Completers: 1}},
+		{SubjectID: episodeID, SeriesID: seriesID, Day: &day, Metrics: core.EngagementMetrics{Sessions: 2, FinishedSessions: 1}},
+	})
+	if err != nil {
+		t.Fatalf("ExportEngagementRollups() error = %v", err)
+	}
+
+	if path != "/analytics/lession/engagement_rollups/day%3D2024-05-15/part-0.parquet" {
+		t.Fatalf("unexpected object path %q", path)
+	}
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/20240516/eu-west-1/s3/aws4_request, SignedHeaders=") {
+		t.Fatalf("unexpected authorization %q", auth)
+	}
+
+	rows, err := parquet.Read[rollupRow](bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		t.Fatalf("read parquet: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+	if rows[0].SubjectType != "series" || rows[0].EpisodeID != "" || rows[0].CompletionRate != 0.5 {
+		t.Fatalf("unexpected series row %#v", rows[0])
+	}
+	if rows[1].SubjectType != "episode" || rows[1].EpisodeID != episodeID.String() || rows[1].ListenThrough != 0.5 {
+		t.Fatalf("unexpected episode row %#v", rows[1])
+	}
+	if !rows[1].Day.Equal(day) {
+		t.Fatalf("expected day %v, got %v", day, rows[1].Day)
+	}
+}
+
+func TestSink_ExportPlaybackSessionsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("<Error><Code>AccessDenied</Code></Error>"))
+	}))
+	defer server.Close()
+
+	sink := NewSink("analytics", "us-east-1", "AKID", "secret")
+	sink.WithEndpoint(server.URL)
+
+	finished := time.Date(2024, 5, 15, 1, 0, 0, 0, time.UTC)
+	err := sink.ExportPlaybackSessions(context.Background(), finished, []core.PlaybackSession{
+		{ID: uuid.New(), UserID: "u1", EpisodeID: uuid.New(), StartedAt: finished, FinishedAt: &finished},
+	})
+	if err == nil || !strings.Contains(err.Error(), "AccessDenied") {
+		t.Fatalf("expected the S3 error, got %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+// Package webhook posts signed event payloads to integrator endpoints.
+//
+// Every request carries the delivery id, a Unix timestamp and an HMAC-SHA256
+// signature over "<timestamp>.<body>" keyed by the endpoint secret, so
+// receivers can authenticate payloads, reject replays and drop duplicates.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/eslsoft/lession/internal/adapter/safehttp"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// HeaderID carries the delivery id, which is stable across retries.
+	HeaderID = "Lession-Webhook-Id"
+	// HeaderEvent carries the event name, e.g. "series.published".
+	HeaderEvent = "Lession-Webhook-Event"
+	// HeaderTimestamp carries the Unix time the request was signed at.
+	HeaderTimestamp = "Lession-Webhook-Timestamp"
+	// HeaderSignature carries "v1=" followed by the hex-encoded signature.
+	HeaderSignature = "Lession-Webhook-Signature"
+
+	defaultTimeout = 10 * time.Second
+)
+
+// Client implements core.WebhookClient over HTTP.
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient constructs a webhook client with a bounded request timeout. It
+// only connects to public addresses, since endpoint URLs are chosen by
+// integrators.
+func NewClient() *Client {
+	return &Client{httpClient: safehttp.NewClient(defaultTimeout)}
+}
+
+// WithHTTPClient overrides the HTTP client, e.g. in tests.
+func (c *Client) WithHTTPClient(client *http.Client) {
+	if client != nil {
+		c.httpClient = client
+	}
+}
+
+var _ core.WebhookClient = (*Client)(nil)
+
+// Deliver posts the signed payload, treating any non-2xx response as a failure.
+// Errors carry the response status only; the response body is never read
+// back, so endpoints cannot surface content into the delivery log.
+func (c *Client) Deliver(ctx context.Context, req core.WebhookRequest) (int, error) {
+	timestamp := strconv.FormatInt(req.Timestamp.Unix(), 10)
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("webhook: build request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "Lession-Webhooks/1")
+	httpReq.Header.Set(HeaderID, req.DeliveryID.String())
+	httpReq.Header.Set(HeaderEvent, req.EventType.String())
+	httpReq.Header.Set(HeaderTimestamp, timestamp)
+	httpReq.Header.Set(HeaderSignature, "v1="+Sign(req.Secret, timestamp, req.Payload))
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		if safehttp.Blocked(err) {
+			return 0, fmt.Errorf("webhook: post: %w", safehttp.ErrNonPublicAddress)
+		}
+		return 0, fmt.Errorf("webhook: post: %w", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook: endpoint responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the hex-encoded HMAC-SHA256 of "<timestamp>.<payload>" keyed by secret.
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
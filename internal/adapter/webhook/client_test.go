@@ -0,0 +1,93 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/adapter/safehttp"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestClient_DeliverSignsPayload(t *testing.T) {
+	var got *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	req := core.WebhookRequest{
+		URL:        server.URL,
+		Secret:     "whsec_test",
+		DeliveryID: uuid.New(),
+		EventType:  core.WebhookEventTypeSeriesPublished,
+		Payload:    []byte(`{"type":"series.published"}`),
+		Timestamp:  time.Unix(1740830400, 0),
+	}
+	client := NewClient()
+	client.WithHTTPClient(server.Client())
+	status, err := client.Deliver(context.Background(), req)
+	if err != nil || status != http.StatusNoContent {
+		t.Fatalf("Deliver() = %d, %v", status, err)
+	}
+
+	if string(body) != string(req.Payload) {
+		t.Fatalf("unexpected body %s", body)
+	}
+	if got.Header.Get(HeaderID) != req.DeliveryID.String() || got.Header.Get(HeaderEvent) != "series.published" {
+		t.Fatalf("unexpected headers %v", got.Header)
+	}
+	if want := "v1=" + Sign("whsec_test", "1740830400", req.Payload); got.Header.Get(HeaderSignature) != want {
+		t.Fatalf("signature = %q, want %q", got.Header.Get(HeaderSignature), want)
+	}
+}
+
+func TestClient_DeliverRejectsNon2xx(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "maintenance token=secret", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient()
+	client.WithHTTPClient(server.Client())
+	status, err := client.Deliver(context.Background(), core.WebhookRequest{URL: server.URL, Payload: []byte(`{}`)})
+	if err == nil || status != http.StatusServiceUnavailable {
+		t.Fatalf("expected a failed delivery with status 503, got %d, %v", status, err)
+	}
+	if strings.Contains(err.Error(), "maintenance") {
+		t.Fatalf("error %q includes the response body", err)
+	}
+}
+
+func TestClient_DeliverRefusesInternalAddresses(t *testing.T) {
+	var hits atomic.Int32
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+	}))
+	defer internal.Close()
+	redirector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, internal.URL, http.StatusFound)
+	}))
+	defer redirector.Close()
+
+	for _, url := range []string{internal.URL, redirector.URL, "http://169.254.169.254/latest/meta-data/"} {
+		status, err := NewClient().Deliver(context.Background(), core.WebhookRequest{URL: url, Payload: []byte(`{}`)})
+		if !errors.Is(err, safehttp.ErrNonPublicAddress) || status != 0 {
+			t.Fatalf("Deliver(%s) = %d, %v, want ErrNonPublicAddress", url, status, err)
+		}
+	}
+	if hits.Load() != 0 {
+		t.Fatalf("internal server was reached %d times", hits.Load())
+	}
+}
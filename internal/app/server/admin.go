@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	"github.com/eslsoft/lession/internal/adapter/transport"
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// Admin exposes the catalog to the admin CLI when it works against the
+// database directly instead of through the Connect API. It serves the same
+// handlers as the API, without the interceptors in front of them.
+type Admin struct {
+	Series    *transport.SeriesHandler
+	Assets    *transport.AssetHandler
+	Exports   core.PackageExportService
+	Seeds     core.SeedService
+	Backups   core.BackupService
+	Search    core.SearchService
+	Semantic  core.SemanticSearchService
+	Analytics core.AnalyticsExportService
+
+	entClient *entgenerated.Client
+	tracing   *sdktrace.TracerProvider
+}
+
+// NewAdmin constructs an Admin from the provided dependencies.
+func NewAdmin(series *transport.SeriesHandler, assets *transport.AssetHandler, exports core.PackageExportService, seeds core.SeedService, backups core.BackupService, search core.SearchService, semantic core.SemanticSearchService, analytics core.AnalyticsExportService, entClient *entgenerated.Client, tracing *sdktrace.TracerProvider) *Admin {
+	return &Admin{
+		Series:    series,
+		Assets:    assets,
+		Exports:   exports,
+		Seeds:     seeds,
+		Backups:   backups,
+		Search:    search,
+		Semantic:  semantic,
+		Analytics: analytics,
+		entClient: entClient,
+		tracing:   tracing,
+	}
+}
+
+// Close flushes traces and closes the database.
+func (a *Admin) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_ = a.tracing.Shutdown(ctx)
+	return a.entClient.Close()
+}
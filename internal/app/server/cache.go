@@ -0,0 +1,75 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/eslsoft/lession/internal/adapter/cache"
+	"github.com/eslsoft/lession/internal/adapter/db"
+	"github.com/eslsoft/lession/internal/config"
+	"github.com/eslsoft/lession/internal/core"
+	"github.com/eslsoft/lession/internal/usecase"
+)
+
+// cacheKeyPrefix namespaces the service's keys on a shared Redis server.
+const cacheKeyPrefix = "lession:"
+
+// NewCacheStore builds the configured cache store. It returns nil when
+// caching is disabled.
+func NewCacheStore(cfg config.Config) (cache.Store, error) {
+	switch cfg.CacheStore {
+	case "":
+		return nil, nil
+	case "memory":
+		return cache.NewMemoryStore(cfg.CacheSize), nil
+	case "redis":
+		opts, err := redis.ParseURL(cfg.RedisURL)
+		if err != nil {
+			return nil, fmt.Errorf("parse REDIS_URL: %w", err)
+		}
+		return cache.NewRedisStore(redis.NewClient(opts), cacheKeyPrefix), nil
+	default:
+		return nil, fmt.Errorf("unknown cache store %q", cfg.CacheStore)
+	}
+}
+
+// NewSeriesCache builds the cache in front of the series repository. It
+// returns nil when caching is disabled.
+func NewSeriesCache(cfg config.Config, repo *db.SeriesRepository, store cache.Store) *cache.SeriesRepository {
+	if store == nil {
+		return nil
+	}
+	seriesCache := cache.NewSeriesRepository(repo, store, cfg.CacheTTL)
+	seriesCache.WithListTTL(cfg.CacheListTTL)
+	return seriesCache
+}
+
+// NewSeriesRepository serves series through the cache when one is configured.
+func NewSeriesRepository(repo *db.SeriesRepository, seriesCache *cache.SeriesRepository) core.SeriesRepository {
+	if seriesCache == nil {
+		return repo
+	}
+	return seriesCache
+}
+
+// NewTranscriptAdminService builds the transcript admin service, which
+// invalidates the series cache for the transcripts its jobs change.
+func NewTranscriptAdminService(repo core.TranscriptAdminRepository, seriesCache *cache.SeriesRepository) *usecase.TranscriptAdminService {
+	service := usecase.NewTranscriptAdminService(repo)
+	if seriesCache != nil {
+		service.WithSeriesCache(seriesCache)
+	}
+	return service
+}
+
+// NewInteractiveTranscriptService builds the interactive transcript service,
+// which keeps the transcripts it prepares in the cache when one is
+// configured.
+func NewInteractiveTranscriptService(cfg config.Config, series core.SeriesRepository, dictionary core.DictionaryProvider, store cache.Store) *usecase.InteractiveTranscriptService {
+	service := usecase.NewInteractiveTranscriptService(series, dictionary)
+	if store != nil {
+		service.WithCache(cache.NewInteractiveTranscriptCache(store, cfg.CacheTTL))
+	}
+	return service
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime/debug"
+
+	"github.com/eslsoft/lession/internal/config"
+)
+
+// debugServer serves pprof profiles, expvar variables and build information
+// on their own address, so they are never exposed with the API.
+type debugServer struct {
+	httpServer *http.Server
+}
+
+// newDebugServer returns nil when no debug address is configured.
+func newDebugServer(cfg config.Config) *debugServer {
+	if cfg.DebugAddress == "" {
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.HandleFunc("/debug/buildinfo", serveBuildInfo)
+
+	return &debugServer{httpServer: &http.Server{
+		Addr:              cfg.DebugAddress,
+		Handler:           mux,
+		ReadHeaderTimeout: cfg.HTTPReadHeaderTimeout,
+		IdleTimeout:       cfg.HTTPIdleTimeout,
+		// CPU profiles and traces stream for as long as the request asks, so
+		// no write timeout applies.
+	}}
+}
+
+// start listens on the debug address, failing fast on a bad one, and
+// serves until ctx is cancelled. Profiles still streaming then are cut off.
+func (d *debugServer) start(ctx context.Context) error {
+	listener, err := net.Listen("tcp", d.httpServer.Addr)
+	if err != nil {
+		return fmt.Errorf("listen on debug address: %w", err)
+	}
+	go func() { _ = d.httpServer.Serve(listener) }()
+	go func() {
+		<-ctx.Done()
+		_ = d.httpServer.Close()
+	}()
+	return nil
+}
+
+// serveBuildInfo reports the Go version, module versions and VCS revision the
+// binary was built from.
+func serveBuildInfo(w http.ResponseWriter, _ *http.Request) {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		http.Error(w, "build information is unavailable", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(info)
+}
@@ -2,11 +2,19 @@ package server
 
 import (
 	"net/http"
+	"strings"
 
 	protovalidate "buf.build/go/protovalidate"
 	"connectrpc.com/connect"
+	"connectrpc.com/grpchealth"
+	"connectrpc.com/grpcreflect"
+	"connectrpc.com/otelconnect"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/eslsoft/lession/internal/adapter/transport"
+	"github.com/eslsoft/lession/internal/core"
+	"github.com/eslsoft/lession/internal/i18n"
 	lessionv1connect "github.com/eslsoft/lession/pkg/api/lession/v1/lessionv1connect"
 )
 
@@ -14,29 +22,265 @@ import (
 func NewHTTPHandler(
 	assetHandler *transport.AssetHandler,
 	seriesHandler *transport.SeriesHandler,
+	learnerStatsHandler *transport.LearnerStatsHandler,
+	dictationHandler *transport.DictationHandler,
+	vocabularyHandler *transport.VocabularyHandler,
+	interactiveTranscriptHandler *transport.InteractiveTranscriptHandler,
+	quizHandler *transport.QuizHandler,
+	downloadHandler *transport.DownloadHandler,
+	downloadFileHandler *transport.DownloadFileHandler,
+	hlsHandler *transport.HLSHandler,
+	imageHandler *transport.ImageHandler,
+	contentKeyHandler *transport.ContentKeyHandler,
+	meteringHandler *transport.MeteringHandler,
+	shadowingHandler *transport.ShadowingHandler,
+	playlistHandler *transport.PlaylistHandler,
+	transcriptAdminHandler *transport.TranscriptAdminHandler,
+	watchHistoryHandler *transport.WatchHistoryHandler,
+	widgetHandler *transport.WidgetHandler,
+	catalogHandler *transport.CatalogHandler,
+	sitemapHandler *transport.SitemapHandler,
+	oembedHandler *transport.OEmbedHandler,
+	embedHandler *transport.EmbedHandler,
+	importHandler *transport.ImportHandler,
+	feedHandler *transport.FeedHandler,
+	recommendationHandler *transport.RecommendationHandler,
+	searchHandler *transport.SearchHandler,
+	analyticsHandler *transport.AnalyticsHandler,
+	subscriptionHandler *transport.SubscriptionHandler,
+	billingHandler *transport.BillingHandler,
+	billingWebhookHandler *transport.BillingWebhookHandler,
+	classroomHandler *transport.ClassroomHandler,
+	bookingHandler *transport.BookingHandler,
+	moderationHandler *transport.ModerationHandler,
+	authoringHandler *transport.AuthoringHandler,
+	ltiHandler *transport.LTIHandler,
+	ltiLaunchHandler *transport.LTILaunchHandler,
+	packageExportHandler *transport.PackageExportHandler,
+	notificationHandler *transport.NotificationHandler,
+	webhookHandler *transport.WebhookHandler,
+	jobHandler *transport.JobHandler,
+	schedulerHandler *transport.SchedulerHandler,
+	auditHandler *transport.AuditHandler,
+	apiKeyHandler *transport.APIKeyHandler,
+	eventStreamHandler *transport.EventStreamHandler,
+	readinessHandler *transport.ReadinessHandler,
+	tracing *otelconnect.Interceptor,
+	metrics *transport.MetricsInterceptor,
+	metricsRegistry *prometheus.Registry,
+	usage core.UsageCounter,
+	subscriptions core.SubscriptionService,
+	cdn core.CDNService,
+	apiKeys core.APIKeyService,
 	validator protovalidate.Validator,
-) http.Handler {
+	catalog *i18n.Catalog,
+	cors transport.CORSOptions,
+	compression transport.CompressionOptions,
+) (http.Handler, error) {
 	mux := http.NewServeMux()
 
-	validationInterceptor := transport.NewValidationInterceptor(validator)
-	errorInterceptor := transport.NewErrorInterceptor()
+	// Compression applies to every protocol Connect serves: Connect, gRPC and
+	// gRPC-Web, which browsers use without a proxy.
+	compressionOption, err := compression.HandlerOption()
+	if err != nil {
+		return nil, err
+	}
 
-	assetPath, assetSvc := lessionv1connect.NewAssetServiceHandler(
-		assetHandler,
-		connect.WithInterceptors(validationInterceptor, errorInterceptor),
+	// Validation runs inside the error interceptor so rejected requests are
+	// mapped to InvalidArgument and localized like any other domain error.
+	// API key authentication and entitlement sit there too so their denials
+	// are localized, after identity so a key overrides the caller header.
+	// Metering follows API key authentication, which names the tenant.
+	// Tracing and metrics are outermost so they cover the whole call and
+	// record the final status code. ETags are hashed from the final response,
+	// outside every interceptor that rewrites it.
+	interceptors := connect.WithInterceptors(
+		tracing,
+		metrics,
+		transport.NewRequestIDInterceptor(),
+		transport.NewETagInterceptor(),
+		transport.NewLocaleInterceptor(catalog),
+		transport.NewIdentityInterceptor(),
+		transport.NewReplicaReadInterceptor(),
+		transport.NewAuditInterceptor(),
+		transport.NewErrorInterceptor(),
+		transport.NewAPIKeyInterceptor(apiKeys),
+		transport.NewMeteringInterceptor(usage),
+		transport.NewEntitlementInterceptor(subscriptions),
+		transport.NewCDNInterceptor(cdn),
+		transport.NewValidationInterceptor(validator),
 	)
-	mux.Handle(assetPath, assetSvc)
+	handlerOptions := connect.WithHandlerOptions(interceptors, compressionOption)
 
-	seriesPath, seriesSvc := lessionv1connect.NewSeriesServiceHandler(
-		seriesHandler,
-		connect.WithInterceptors(validationInterceptor, errorInterceptor),
-	)
-	mux.Handle(seriesPath, seriesSvc)
+	// Every Connect service is also reported by the gRPC health and
+	// reflection services registered below.
+	var services []string
+	registerService := func(path string, handler http.Handler) {
+		mux.Handle(path, handler)
+		services = append(services, strings.Trim(path, "/"))
+	}
+
+	assetPath, assetSvc := lessionv1connect.NewAssetServiceHandler(assetHandler, handlerOptions)
+	registerService(assetPath, assetSvc)
+
+	seriesPath, seriesSvc := lessionv1connect.NewSeriesServiceHandler(seriesHandler, handlerOptions)
+	registerService(seriesPath, seriesSvc)
+
+	learnerStatsPath, learnerStatsSvc := lessionv1connect.NewLearnerStatsServiceHandler(learnerStatsHandler, handlerOptions)
+	registerService(learnerStatsPath, learnerStatsSvc)
+
+	dictationPath, dictationSvc := lessionv1connect.NewDictationServiceHandler(dictationHandler, handlerOptions)
+	registerService(dictationPath, dictationSvc)
+
+	vocabularyPath, vocabularySvc := lessionv1connect.NewVocabularyServiceHandler(vocabularyHandler, handlerOptions)
+	registerService(vocabularyPath, vocabularySvc)
+
+	interactiveTranscriptPath, interactiveTranscriptSvc := lessionv1connect.NewInteractiveTranscriptServiceHandler(interactiveTranscriptHandler, handlerOptions)
+	registerService(interactiveTranscriptPath, interactiveTranscriptSvc)
+
+	quizPath, quizSvc := lessionv1connect.NewQuizServiceHandler(quizHandler, handlerOptions)
+	registerService(quizPath, quizSvc)
+
+	downloadPath, downloadSvc := lessionv1connect.NewDownloadServiceHandler(downloadHandler, handlerOptions)
+	registerService(downloadPath, downloadSvc)
+
+	meteringPath, meteringSvc := lessionv1connect.NewMeteringServiceHandler(meteringHandler, handlerOptions)
+	registerService(meteringPath, meteringSvc)
+
+	shadowingPath, shadowingSvc := lessionv1connect.NewShadowingServiceHandler(shadowingHandler, handlerOptions)
+	registerService(shadowingPath, shadowingSvc)
+
+	playlistPath, playlistSvc := lessionv1connect.NewPlaylistServiceHandler(playlistHandler, handlerOptions)
+	registerService(playlistPath, playlistSvc)
+
+	transcriptAdminPath, transcriptAdminSvc := lessionv1connect.NewTranscriptAdminServiceHandler(transcriptAdminHandler, handlerOptions)
+	registerService(transcriptAdminPath, transcriptAdminSvc)
+
+	watchHistoryPath, watchHistorySvc := lessionv1connect.NewWatchHistoryServiceHandler(watchHistoryHandler, handlerOptions)
+	registerService(watchHistoryPath, watchHistorySvc)
+
+	recommendationPath, recommendationSvc := lessionv1connect.NewRecommendationServiceHandler(recommendationHandler, handlerOptions)
+	registerService(recommendationPath, recommendationSvc)
+
+	// The catalog is safe to expose unauthenticated: it only serves published
+	// content and its side-effect free RPCs accept HTTP GET for caching.
+	catalogPath, catalogSvc := lessionv1connect.NewCatalogServiceHandler(catalogHandler, handlerOptions)
+	registerService(catalogPath, catalogSvc)
+
+	// Publishers create embed tokens for their own series; embedded players
+	// authenticate with the token in the request, so publishers never hand
+	// their API keys to the sites embedding them.
+	embedPath, embedSvc := lessionv1connect.NewEmbedServiceHandler(embedHandler, handlerOptions)
+	registerService(embedPath, embedSvc)
+
+	importPath, importSvc := lessionv1connect.NewImportServiceHandler(importHandler, handlerOptions)
+	registerService(importPath, importSvc)
+
+	feedPath, feedSvc := lessionv1connect.NewFeedServiceHandler(feedHandler, handlerOptions)
+	registerService(feedPath, feedSvc)
+
+	searchPath, searchSvc := lessionv1connect.NewSearchServiceHandler(searchHandler, handlerOptions)
+	registerService(searchPath, searchSvc)
+
+	analyticsPath, analyticsSvc := lessionv1connect.NewAnalyticsServiceHandler(analyticsHandler, handlerOptions)
+	registerService(analyticsPath, analyticsSvc)
+
+	subscriptionPath, subscriptionSvc := lessionv1connect.NewSubscriptionServiceHandler(subscriptionHandler, handlerOptions)
+	registerService(subscriptionPath, subscriptionSvc)
+
+	billingPath, billingSvc := lessionv1connect.NewBillingServiceHandler(billingHandler, handlerOptions)
+	registerService(billingPath, billingSvc)
+
+	classroomPath, classroomSvc := lessionv1connect.NewClassroomServiceHandler(classroomHandler, handlerOptions)
+	registerService(classroomPath, classroomSvc)
+
+	bookingPath, bookingSvc := lessionv1connect.NewBookingServiceHandler(bookingHandler, handlerOptions)
+	registerService(bookingPath, bookingSvc)
+
+	moderationPath, moderationSvc := lessionv1connect.NewModerationServiceHandler(moderationHandler, handlerOptions)
+	registerService(moderationPath, moderationSvc)
+
+	authoringPath, authoringSvc := lessionv1connect.NewAuthoringServiceHandler(authoringHandler, handlerOptions)
+	registerService(authoringPath, authoringSvc)
+
+	ltiPath, ltiSvc := lessionv1connect.NewLtiServiceHandler(ltiHandler, handlerOptions)
+	registerService(ltiPath, ltiSvc)
+
+	notificationPath, notificationSvc := lessionv1connect.NewNotificationServiceHandler(notificationHandler, handlerOptions)
+	registerService(notificationPath, notificationSvc)
+
+	webhookPath, webhookSvc := lessionv1connect.NewWebhookServiceHandler(webhookHandler, handlerOptions)
+	registerService(webhookPath, webhookSvc)
+
+	jobPath, jobSvc := lessionv1connect.NewJobServiceHandler(jobHandler, handlerOptions)
+	registerService(jobPath, jobSvc)
+
+	schedulerPath, schedulerSvc := lessionv1connect.NewSchedulerServiceHandler(schedulerHandler, handlerOptions)
+	registerService(schedulerPath, schedulerSvc)
+
+	auditPath, auditSvc := lessionv1connect.NewAuditServiceHandler(auditHandler, handlerOptions)
+	registerService(auditPath, auditSvc)
+
+	apiKeyPath, apiKeySvc := lessionv1connect.NewApiKeyServiceHandler(apiKeyHandler, handlerOptions)
+	registerService(apiKeyPath, apiKeySvc)
+
+	// Standard gRPC health checking and server reflection let load
+	// balancers, Kubernetes probes and grpcurl work against the RPC surface.
+	mux.Handle(grpchealth.NewHandler(grpchealth.NewStaticChecker(services...)))
+	reflector := grpcreflect.NewStaticReflector(services...)
+	mux.Handle(grpcreflect.NewHandlerV1(reflector))
+	mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
+
+	// Billing webhooks are verified against the raw body, outside Connect.
+	billingWebhookHandler.Register(mux)
+
+	// The LTI 1.3 launch flow is driven by LMS form posts and redirects.
+	ltiLaunchHandler.Register(mux)
+
+	// LMS packages are zip downloads meant to be uploaded to an LMS as is.
+	packageExportHandler.Register(mux)
+
+	// Offline bundle files are fetched by download managers through signed
+	// URLs that carry no credentials.
+	downloadFileHandler.Register(mux)
+
+	// Packaged HLS renditions are static playlists and segments.
+	hlsHandler.Register(mux)
+
+	// Processed image variants are static files as well.
+	imageHandler.Register(mux)
+
+	// HLS players fetch the keys of encrypted renditions as raw bytes.
+	contentKeyHandler.Register(mux)
+
+	// Widgets are plain JSON over GET so they can be embedded and cached without Connect clients.
+	widgetHandler.Register(mux)
+
+	// Search engines crawl the published catalog from the sitemap.
+	sitemapHandler.Register(mux)
+
+	// Chat apps and third-party sites unfurl shared links and embed the
+	// player through oEmbed.
+	oembedHandler.Register(mux)
+
+	// Admin dashboards follow publishing and asset processing over
+	// Server-Sent Events rather than polling the list endpoints. The route
+	// sits outside the Connect interceptors and checks API keys itself.
+	eventStreamHandler.Register(mux)
 
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write([]byte("ok"))
 	})
 
-	return mux
+	// /readyz fails while a dependency is down, taking the instance out of
+	// rotation without restarting it.
+	readinessHandler.Register(mux)
+
+	mux.Handle("GET /metrics", promhttp.HandlerFor(metricsRegistry, promhttp.HandlerOpts{Registry: metricsRegistry}))
+
+	// Every response, including plain HTTP errors, carries a request id that
+	// clients can quote when reporting failures. Browser preflights are
+	// answered before reaching the mux.
+	return transport.NewRequestIDMiddleware(transport.NewCORSMiddleware(cors, mux)), nil
 }
@@ -0,0 +1,38 @@
+package server
+
+import (
+	"database/sql"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+
+	"github.com/eslsoft/lession/internal/adapter/cache"
+	"github.com/eslsoft/lession/internal/adapter/db"
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+)
+
+// NewMetricsRegistry creates the Prometheus registry served on /metrics,
+// with runtime and process metrics, statistics of the connection pools of
+// the database and its replica, the upload session and job queue gauges, and
+// the cache hit rates when a cache is configured.
+func NewMetricsRegistry(database *sql.DB, replica ReplicaDatabase, client *entgenerated.Client, seriesCache *cache.SeriesRepository) (*prometheus.Registry, error) {
+	registry := prometheus.NewRegistry()
+	metrics := []prometheus.Collector{
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		collectors.NewDBStatsCollector(database, "lession"),
+		db.NewMetricsCollector(client),
+	}
+	if replica.DB != nil {
+		metrics = append(metrics, collectors.NewDBStatsCollector(replica.DB, "lession_replica"))
+	}
+	if seriesCache != nil {
+		metrics = append(metrics, seriesCache)
+	}
+	for _, collector := range metrics {
+		if err := registry.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
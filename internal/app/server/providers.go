@@ -1,12 +1,55 @@
 package server
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
 	"time"
 
 	protovalidate "buf.build/go/protovalidate"
 
+	"github.com/eslsoft/lession/internal/adapter/alignment/aeneas"
+	"github.com/eslsoft/lession/internal/adapter/authoring/openai"
+	"github.com/eslsoft/lession/internal/adapter/billing/stripe"
+	"github.com/eslsoft/lession/internal/adapter/cache"
+	"github.com/eslsoft/lession/internal/adapter/cdn/cloudflare"
+	"github.com/eslsoft/lession/internal/adapter/cdn/cloudfront"
+	"github.com/eslsoft/lession/internal/adapter/db"
+	"github.com/eslsoft/lession/internal/adapter/dictionary/jsonfile"
+	embeddingopenai "github.com/eslsoft/lession/internal/adapter/embedding/openai"
+	"github.com/eslsoft/lession/internal/adapter/eventbus"
+	"github.com/eslsoft/lession/internal/adapter/imaging/local"
+	"github.com/eslsoft/lession/internal/adapter/lti"
+	"github.com/eslsoft/lession/internal/adapter/media/failover"
 	"github.com/eslsoft/lession/internal/adapter/media/fake"
+	"github.com/eslsoft/lession/internal/adapter/media/probe"
+	"github.com/eslsoft/lession/internal/adapter/moderation/perspective"
+	"github.com/eslsoft/lession/internal/adapter/moderation/pii"
+	"github.com/eslsoft/lession/internal/adapter/moderation/wordlist"
+	"github.com/eslsoft/lession/internal/adapter/notification/discord"
+	"github.com/eslsoft/lession/internal/adapter/notification/email"
+	"github.com/eslsoft/lession/internal/adapter/notification/fcm"
+	"github.com/eslsoft/lession/internal/adapter/notification/slack"
+	"github.com/eslsoft/lession/internal/adapter/packaging/ffmpeg"
+	"github.com/eslsoft/lession/internal/adapter/search/elasticsearch"
+	"github.com/eslsoft/lession/internal/adapter/search/meilisearch"
+	"github.com/eslsoft/lession/internal/adapter/transport"
+	"github.com/eslsoft/lession/internal/adapter/warehouse/bigquery"
+	"github.com/eslsoft/lession/internal/adapter/warehouse/s3"
 	"github.com/eslsoft/lession/internal/config"
+	"github.com/eslsoft/lession/internal/core"
+	"github.com/eslsoft/lession/internal/i18n"
+	"github.com/eslsoft/lession/internal/usecase"
 )
 
 // NewConfig loads the runtime configuration for dependency injection.
@@ -14,12 +57,861 @@ func NewConfig() (config.Config, error) {
 	return config.Load()
 }
 
+// NewAssetService builds the asset service, which writes upload sessions and
+// their assets in one transaction and probes media registered from URLs.
+func NewAssetService(repo core.AssetRepository, provider core.UploadProvider, txManager core.TxManager) *usecase.AssetService {
+	service := usecase.NewAssetService(repo, provider)
+	service.WithTxManager(txManager)
+	service.WithMediaProber(probe.NewProber())
+	return service
+}
+
+// NewBackupRepository builds the backup repository on the driver of the
+// transaction manager, so restores join the transaction of the service.
+func NewBackupRepository(txManager *db.TxManager) *db.BackupRepository {
+	return db.NewBackupRepository(txManager)
+}
+
+// NewBackupService builds the backup service, which restores an archive in
+// one transaction.
+func NewBackupService(repo *db.BackupRepository, assets core.AssetRepository, txManager core.TxManager) *usecase.BackupService {
+	service := usecase.NewBackupService(repo, assets)
+	service.WithTxManager(txManager)
+	return service
+}
+
+// NewSearchIndex builds the configured search index, the full-text search
+// of the database unless an external search engine is configured.
+func NewSearchIndex(cfg config.Config, database *db.SearchRepository) core.SearchIndex {
+	switch cfg.SearchEngine {
+	case "meilisearch":
+		return meilisearch.NewIndex(cfg.SearchURL, cfg.SearchAPIKey, cfg.SearchIndex)
+	case "elasticsearch":
+		return elasticsearch.NewIndex(cfg.SearchURL, cfg.SearchAPIKey, cfg.SearchIndex)
+	default:
+		return database
+	}
+}
+
+// NewSearchService builds the search service. It indexes content read from
+// the database rather than the series cache, so the index never picks up a
+// stale entry.
+func NewSearchService(index core.SearchIndex, repo *db.SeriesRepository) *usecase.SearchService {
+	return usecase.NewSearchService(index, repo)
+}
+
+// NewEmbeddingProvider builds the configured embedding model. It returns nil
+// when semantic search is disabled.
+func NewEmbeddingProvider(cfg config.Config) (core.EmbeddingProvider, error) {
+	switch cfg.EmbeddingProvider {
+	case "":
+		return nil, nil
+	case embeddingopenai.ProviderName:
+		provider, err := embeddingopenai.NewProvider(cfg.OpenAIAPIKey, cfg.EmbeddingModel)
+		if err != nil {
+			return nil, err
+		}
+		provider.WithBaseURL(cfg.OpenAIBaseURL)
+		return provider, nil
+	default:
+		return nil, fmt.Errorf("unknown embedding provider %q", cfg.EmbeddingProvider)
+	}
+}
+
+// NewSemanticSearchService builds the semantic search service. Like the
+// search service, it embeds content read from the database rather than the
+// series cache.
+func NewSemanticSearchService(provider core.EmbeddingProvider, repo *db.EmbeddingRepository, series *db.SeriesRepository) *usecase.SemanticSearchService {
+	return usecase.NewSemanticSearchService(provider, repo, series)
+}
+
+// NewAnalyticsExportSink builds the warehouse sink analytics are exported
+// to, or nil when no sink is configured.
+func NewAnalyticsExportSink(cfg config.Config) (core.AnalyticsExportSink, error) {
+	switch cfg.AnalyticsExportSink {
+	case "bigquery":
+		credentials, err := os.ReadFile(cfg.BigQueryCredentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("read ANALYTICS_BIGQUERY_CREDENTIALS_FILE: %w", err)
+		}
+		sink, err := bigquery.NewSink(credentials, cfg.BigQueryDataset)
+		if err != nil {
+			return nil, err
+		}
+		sink.WithProject(cfg.BigQueryProject)
+		return sink, nil
+	case "s3":
+		sink := s3.NewSink(cfg.AnalyticsS3Bucket, cfg.AnalyticsS3Region, cfg.AnalyticsS3AccessKeyID, cfg.AnalyticsS3SecretAccessKey)
+		sink.WithEndpoint(cfg.AnalyticsS3Endpoint)
+		sink.WithPrefix(cfg.AnalyticsS3Prefix)
+		return sink, nil
+	default:
+		return nil, nil
+	}
+}
+
+// NewAnalyticsExportService builds the analytics export service.
+func NewAnalyticsExportService(cfg config.Config, repo core.EngagementRepository, sink core.AnalyticsExportSink) *usecase.AnalyticsExportService {
+	service := usecase.NewAnalyticsExportService(repo, sink)
+	service.WithPlaybackSessions(cfg.AnalyticsExportPlaybackSessions)
+	return service
+}
+
+// NewUploadProvider builds the configured upload provider, wrapping it with
+// health-aware failover when a fallback provider is configured.
+func NewUploadProvider(cfg config.Config) (core.UploadProvider, error) {
+	primary, err := newNamedUploadProvider(cfg.UploadProvider)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.UploadFallbackProvider == "" {
+		return primary, nil
+	}
+
+	fallback, err := newNamedUploadProvider(cfg.UploadFallbackProvider)
+	if err != nil {
+		return nil, err
+	}
+	return failover.NewProvider(primary, fallback, failover.DefaultBreakerOptions())
+}
+
+// newNamedUploadProvider resolves a provider by name. Fake providers may carry
+// a suffix (e.g. "fake-secondary") so failover can be exercised locally.
+func newNamedUploadProvider(name string) (core.UploadProvider, error) {
+	switch {
+	case name == fake.ProviderName || strings.HasPrefix(name, fake.ProviderName+"-"):
+		provider := NewFakeUploadProvider()
+		provider.WithName(name)
+		return provider, nil
+	default:
+		return nil, fmt.Errorf("unknown upload provider %q", name)
+	}
+}
+
 // NewFakeUploadProvider returns a fake upload provider implementation.
 func NewFakeUploadProvider() *fake.Provider {
 	return fake.NewProvider("https://upload.local", "https://cdn.local", 15*time.Minute)
 }
 
+// NewCORSOptions builds the cross-origin policy for browser clients.
+func NewCORSOptions(cfg config.Config) transport.CORSOptions {
+	return transport.CORSOptions{
+		AllowedOrigins: cfg.CORSAllowedOrigins,
+		AllowedHeaders: cfg.CORSAllowedHeaders,
+		MaxAge:         cfg.CORSMaxAge,
+	}
+}
+
+// NewCompressionOptions builds the RPC compression settings.
+func NewCompressionOptions(cfg config.Config) transport.CompressionOptions {
+	return transport.CompressionOptions{
+		Algorithms: cfg.RPCCompression,
+		MinBytes:   cfg.RPCCompressMinBytes,
+	}
+}
+
+// NewReadinessHandler builds the readiness probe over the database and the
+// upload provider, when the provider can report its health.
+func NewReadinessHandler(database *sql.DB, provider core.UploadProvider) *transport.ReadinessHandler {
+	checks := []transport.ReadinessCheck{
+		{Name: "database", Check: database.PingContext},
+	}
+	if checker, ok := provider.(core.HealthChecker); ok {
+		checks = append(checks, transport.ReadinessCheck{Name: "upload_provider", Check: checker.CheckHealth})
+	}
+	return transport.NewReadinessHandler(checks...)
+}
+
 // NewProtoValidator constructs a protovalidate Validator for request validation.
 func NewProtoValidator() (protovalidate.Validator, error) {
 	return protovalidate.New()
 }
+
+// NewMessageCatalog returns the message catalog used to localize responses.
+func NewMessageCatalog() *i18n.Catalog {
+	return i18n.NewDefaultCatalog()
+}
+
+// NewWidgetSigner builds the signer for public widget responses from the
+// configured seed, falling back to a per-process key for local development.
+func NewWidgetSigner(cfg config.Config) (*transport.WidgetSigner, error) {
+	if cfg.WidgetSigningKey == "" {
+		return transport.NewEphemeralWidgetSigner()
+	}
+	seed, err := base64.StdEncoding.DecodeString(cfg.WidgetSigningKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode WIDGET_SIGNING_KEY: %w", err)
+	}
+	return transport.NewWidgetSigner(seed)
+}
+
+// NewDownloadService builds the offline download service, signing URLs with
+// the configured key or, for local development, a per-process one.
+func NewDownloadService(cfg config.Config, series core.SeriesRepository, assets core.AssetRepository, subscriptions core.SubscriptionService, dictionary core.DictionaryProvider, cdn core.CDNService, usage core.AssetUsageService) (*usecase.DownloadService, error) {
+	key := make([]byte, sha256.Size)
+	if cfg.DownloadSigningKey == "" {
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if key, err = base64.StdEncoding.DecodeString(cfg.DownloadSigningKey); err != nil {
+			return nil, fmt.Errorf("decode DOWNLOAD_SIGNING_KEY: %w", err)
+		}
+	}
+	service := usecase.NewDownloadService(series, assets, subscriptions, key, cfg.DownloadBaseURL)
+	service.WithTTL(cfg.DownloadURLTTL)
+	service.WithDictionary(dictionary)
+	service.WithCDN(cdn)
+	service.WithAssetUsage(usage)
+	return service, nil
+}
+
+// NewEmbedService builds the embed token service, signing tokens with the
+// configured key or, for local development, a per-process one.
+func NewEmbedService(cfg config.Config, series core.SeriesRepository, usage core.AssetUsageService) (*usecase.EmbedService, error) {
+	key := make([]byte, sha256.Size)
+	if cfg.EmbedTokenSigningKey == "" {
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if key, err = base64.StdEncoding.DecodeString(cfg.EmbedTokenSigningKey); err != nil {
+			return nil, fmt.Errorf("decode EMBED_TOKEN_SIGNING_KEY: %w", err)
+		}
+	}
+	service := usecase.NewEmbedService(series, key, cfg.EmbedPlayerURL)
+	service.WithMaxTTL(cfg.EmbedTokenMaxTTL)
+	service.WithAssetUsage(usage)
+	return service, nil
+}
+
+// NewBillingProvider builds the configured payment processor. It returns nil
+// when billing is disabled, which leaves checkout unavailable.
+func NewBillingProvider(cfg config.Config) (core.BillingProvider, error) {
+	switch cfg.BillingProvider {
+	case "":
+		return nil, nil
+	case stripe.ProviderName:
+		return stripe.NewProvider(cfg.StripeSecretKey, cfg.StripeWebhookSecret)
+	default:
+		return nil, fmt.Errorf("unknown billing provider %q", cfg.BillingProvider)
+	}
+}
+
+// NewEpisodeDraftGenerator builds the configured language model for lesson
+// drafting. It returns nil when drafting is disabled.
+func NewEpisodeDraftGenerator(cfg config.Config) (core.EpisodeDraftGenerator, error) {
+	switch cfg.AuthoringProvider {
+	case "":
+		return nil, nil
+	case openai.ProviderName:
+		generator, err := openai.NewGenerator(cfg.OpenAIAPIKey, cfg.OpenAIModel)
+		if err != nil {
+			return nil, err
+		}
+		generator.WithBaseURL(cfg.OpenAIBaseURL)
+		return generator, nil
+	default:
+		return nil, fmt.Errorf("unknown authoring provider %q", cfg.AuthoringProvider)
+	}
+}
+
+// NewTranscriptAligner builds the configured forced aligner. It returns nil
+// when alignment is disabled.
+func NewTranscriptAligner(cfg config.Config) (core.TranscriptAligner, error) {
+	switch cfg.TranscriptAligner {
+	case "":
+		return nil, nil
+	case aeneas.ProviderName:
+		return aeneas.NewAligner(cfg.AeneasPython), nil
+	default:
+		return nil, fmt.Errorf("unknown transcript aligner %q", cfg.TranscriptAligner)
+	}
+}
+
+// NewAudioPackager builds the configured HLS packager. It returns nil when
+// audio is served as uploaded.
+func NewAudioPackager(cfg config.Config) (core.AudioPackager, error) {
+	switch cfg.AudioPackager {
+	case "":
+		return nil, nil
+	case ffmpeg.ProviderName:
+		return ffmpeg.NewPackager(cfg.FFmpegPath, cfg.HLSOutputDir, cfg.HLSBaseURL, cfg.HLSAudioBitrates)
+	default:
+		return nil, fmt.Errorf("unknown audio packager %q", cfg.AudioPackager)
+	}
+}
+
+// NewAudioPackagingService builds the packaging service, writing the key URL
+// into the playlists of encrypted renditions.
+func NewAudioPackagingService(cfg config.Config, assets core.AssetRepository, series core.SeriesRepository, keys core.ContentKeyRepository, packager core.AudioPackager) *usecase.AudioPackagingService {
+	service := usecase.NewAudioPackagingService(assets, series, keys, packager)
+	service.WithKeyURL(cfg.DRMKeyURL)
+	return service
+}
+
+// NewImageProcessor builds the configured image processor. It returns nil
+// when images are not processed.
+func NewImageProcessor(cfg config.Config) (core.ImageProcessor, error) {
+	switch cfg.ImageProcessor {
+	case "":
+		return nil, nil
+	case local.ProviderName:
+		return local.NewProcessor(cfg.ImageOutputDir, cfg.ImageBaseURL)
+	default:
+		return nil, fmt.Errorf("unknown image processor %q", cfg.ImageProcessor)
+	}
+}
+
+// NewSitemapService builds the sitemap from the SITEMAP_*_URL templates.
+func NewSitemapService(cfg config.Config, repo core.SeriesRepository) *usecase.SitemapService {
+	return usecase.NewSitemapService(repo, cfg.SitemapSeriesURL, cfg.SitemapEpisodeURL, cfg.SitemapMaxAge)
+}
+
+// NewSitemapHandler serves /sitemap.xml when SITEMAP_SERIES_URL is set.
+func NewSitemapHandler(cfg config.Config, sitemap *usecase.SitemapService) *transport.SitemapHandler {
+	if cfg.SitemapSeriesURL == "" {
+		return transport.NewSitemapHandler(nil)
+	}
+	return transport.NewSitemapHandler(sitemap)
+}
+
+// NewLinkPreviewService previews the pages of the SITEMAP_*_URL templates and
+// embeds the player of EMBED_PLAYER_URL.
+func NewLinkPreviewService(cfg config.Config, catalog core.CatalogService, repo core.SeriesRepository, cdn core.CDNService) *usecase.LinkPreviewService {
+	service := usecase.NewLinkPreviewService(catalog, repo, cfg.SitemapSeriesURL, cfg.SitemapEpisodeURL, cfg.EmbedPlayerURL)
+	service.WithCDN(cdn)
+	return service
+}
+
+// NewImageHandler serves the image variants at the path of IMAGE_BASE_URL
+// when the local processor writes them to a local directory.
+func NewImageHandler(cfg config.Config) (*transport.ImageHandler, error) {
+	if cfg.ImageProcessor != local.ProviderName {
+		return transport.NewImageHandler("", ""), nil
+	}
+	base, err := url.Parse(cfg.ImageBaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse IMAGE_BASE_URL: %w", err)
+	}
+	return transport.NewImageHandler(cfg.ImageOutputDir, base.Path), nil
+}
+
+// NewCDNProvider builds the configured CDN. It returns nil when media is
+// served from its origin.
+func NewCDNProvider(cfg config.Config) (core.CDNProvider, error) {
+	switch cfg.CDNProvider {
+	case "":
+		return nil, nil
+	case cloudfront.ProviderName:
+		return cloudfront.NewProvider(cfg.CloudFrontDistributionID, cfg.CDNAWSAccessKeyID, cfg.CDNAWSSecretAccessKey)
+	case cloudflare.ProviderName:
+		return cloudflare.NewProvider(cfg.CloudflareZoneID, cfg.CloudflareAPIToken)
+	default:
+		return nil, fmt.Errorf("unknown CDN provider %q", cfg.CDNProvider)
+	}
+}
+
+// NewCDNService builds the CDN service. Media URLs are only rewritten when a
+// CDN is configured.
+func NewCDNService(cfg config.Config, assets core.AssetRepository, provider core.CDNProvider) *usecase.CDNService {
+	baseURL := cfg.CDNBaseURL
+	if provider == nil {
+		baseURL = ""
+	}
+	return usecase.NewCDNService(assets, provider, baseURL, cfg.CDNOrigins)
+}
+
+// NewHLSHandler serves the packaged renditions at the path of HLS_BASE_URL
+// when the ffmpeg packager writes them to a local directory.
+func NewHLSHandler(cfg config.Config) (*transport.HLSHandler, error) {
+	if cfg.AudioPackager != ffmpeg.ProviderName {
+		return transport.NewHLSHandler("", ""), nil
+	}
+	base, err := url.Parse(cfg.HLSBaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse HLS_BASE_URL: %w", err)
+	}
+	return transport.NewHLSHandler(cfg.HLSOutputDir, base.Path), nil
+}
+
+// NewDictionaryProvider builds the configured dictionary. It returns nil
+// when word lookups are disabled.
+func NewDictionaryProvider(cfg config.Config) (core.DictionaryProvider, error) {
+	switch cfg.DictionaryProvider {
+	case "":
+		return nil, nil
+	case jsonfile.ProviderName:
+		dictionary, err := jsonfile.LoadFile(cfg.DictionaryFile)
+		if err != nil {
+			return nil, fmt.Errorf("read DICTIONARY_FILE: %w", err)
+		}
+		return dictionary, nil
+	default:
+		return nil, fmt.Errorf("unknown dictionary provider %q", cfg.DictionaryProvider)
+	}
+}
+
+// NewLTIClient builds the LTI 1.3 protocol client from the configured signing
+// key, falling back to a per-process key for local development.
+func NewLTIClient(cfg config.Config) (core.LTIClient, error) {
+	key, err := newLTISigningKey(cfg.LTIPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	return lti.NewClient(cfg.LTIToolURL, key)
+}
+
+func newLTISigningKey(pemKey string) (*rsa.PrivateKey, error) {
+	if pemKey == "" {
+		return lti.GenerateKey()
+	}
+	key, err := lti.ParsePrivateKey([]byte(pemKey))
+	if err != nil {
+		return nil, fmt.Errorf("decode LTI_PRIVATE_KEY: %w", err)
+	}
+	return key, nil
+}
+
+// NewLTILaunchHandler builds the LTI launch endpoints, which hand launches to
+// the configured web player.
+func NewLTILaunchHandler(cfg config.Config, service core.LTIService) *transport.LTILaunchHandler {
+	return transport.NewLTILaunchHandler(service, cfg.LTIPlayerURL)
+}
+
+// Job kinds running the reactions to domain events. Each subscriber is its
+// own job so it retries independently of the others.
+const (
+	jobKindNotifyEpisodePublished  = "notification.episode_published"
+	jobKindNotifyAssetFailed       = "notification.asset_failed"
+	jobKindNotifyReviewRequested   = "notification.review_requested"
+	jobKindWebhookSeriesPublished  = "webhook.series_published"
+	jobKindWebhookEpisodeCreated   = "webhook.episode_created"
+	jobKindWebhookAssetReady       = "webhook.asset_ready"
+	jobKindWebhookEpisodePublished = "webhook.episode_published"
+	jobKindAlignEpisodeCreated     = "transcript_alignment.episode_created"
+	jobKindAlignEpisodeUpdated     = "transcript_alignment.episode_updated"
+	jobKindDifficultyCreated       = "difficulty.episode_created"
+	jobKindDifficultyUpdated       = "difficulty.episode_updated"
+	jobKindClozeCreated            = "cloze.episode_created"
+	jobKindClozeUpdated            = "cloze.episode_updated"
+	jobKindPackageAssetReady       = "audio_packaging.asset_ready"
+	jobKindProtectSeriesUpdated    = "audio_packaging.series_updated"
+	jobKindProtectEpisodeCreated   = "audio_packaging.episode_created"
+	jobKindProtectEpisodeUpdated   = "audio_packaging.episode_updated"
+	jobKindProcessImageReady       = "image.asset_ready"
+	jobKindCDNEpisodeUnpublished   = "cdn.episode_unpublished"
+	jobKindCDNEpisodeDeleted       = "cdn.episode_deleted"
+	jobKindCDNRenditionReplaced    = "cdn.asset_rendition_replaced"
+	jobKindDurationAssetReady      = "episode_duration.asset_ready"
+	jobKindDurationAssetChanged    = "episode_duration.asset_duration_changed"
+	// jobKindSearchIndexPrefix prefixes the event type in the kinds of the
+	// jobs updating an external search engine.
+	jobKindSearchIndexPrefix = "search.index."
+	// jobKindEmbeddingIndexPrefix prefixes the event type in the kinds of
+	// the jobs re-embedding content for semantic search.
+	jobKindEmbeddingIndexPrefix = "embedding.index."
+)
+
+// eventJobs maps every event subscriber to the job kind that runs it.
+var eventJobs = []struct {
+	eventType core.EventType
+	kind      string
+}{
+	{core.EventTypeEpisodePublished, jobKindNotifyEpisodePublished},
+	{core.EventTypeSeriesPublished, jobKindWebhookSeriesPublished},
+	{core.EventTypeEpisodeCreated, jobKindWebhookEpisodeCreated},
+	{core.EventTypeAssetReady, jobKindWebhookAssetReady},
+	{core.EventTypeEpisodePublished, jobKindWebhookEpisodePublished},
+	{core.EventTypeEpisodeCreated, jobKindDifficultyCreated},
+	{core.EventTypeEpisodeUpdated, jobKindDifficultyUpdated},
+	{core.EventTypeEpisodeCreated, jobKindClozeCreated},
+	{core.EventTypeEpisodeUpdated, jobKindClozeUpdated},
+	{core.EventTypeAssetReady, jobKindDurationAssetReady},
+	{core.EventTypeAssetDurationChanged, jobKindDurationAssetChanged},
+}
+
+// NewEventBus builds the domain event bus the outbox relay publishes to,
+// persisting events when enabled, and enqueues a job for every subscriber of
+// a published event, including the search indexer when an external search
+// engine is configured, the embedding indexer when an embedding provider is
+// and transcript alignment when an aligner is. Asset status changes also
+// wake the WatchAsset streams of this process, and series changes invalidate
+// the series cache and the sitemap.
+func NewEventBus(cfg config.Config, repo *db.EventRepository, jobs core.JobQueue, assets core.AssetService, seriesCache *cache.SeriesRepository, sitemap *usecase.SitemapService) *eventbus.Bus {
+	var store core.EventRepository
+	if cfg.PersistEvents {
+		store = repo
+	}
+	bus := eventbus.NewBus(store)
+
+	bus.Subscribe(core.EventTypeAssetStatusChanged, func(ctx context.Context, envelope core.EventEnvelope) error {
+		return assets.HandleAssetStatusChanged(ctx, envelope.Event.(core.AssetStatusChanged))
+	})
+	if seriesCache != nil {
+		for _, eventType := range cache.SeriesEventTypes {
+			bus.Subscribe(eventType, seriesCache.HandleEvent)
+		}
+	}
+	if cfg.SitemapSeriesURL != "" {
+		for _, eventType := range usecase.SitemapEventTypes {
+			bus.Subscribe(eventType, sitemap.HandleEvent)
+		}
+	}
+
+	enqueue := func(eventType core.EventType, kind string) {
+		bus.Subscribe(eventType, func(ctx context.Context, envelope core.EventEnvelope) error {
+			payload, err := json.Marshal(envelope.Event)
+			if err != nil {
+				return err
+			}
+			// The relay may publish an event again; the dedupe key keeps
+			// that from queueing the same reaction twice.
+			_, err = jobs.Enqueue(ctx, core.JobRequest{
+				Kind:      kind,
+				Payload:   payload,
+				DedupeKey: kind + ":" + envelope.ID.String(),
+			})
+			if errors.Is(err, core.ErrAlreadyExists) {
+				return nil
+			}
+			return err
+		})
+	}
+	for _, eventJob := range eventJobs {
+		enqueue(eventJob.eventType, eventJob.kind)
+	}
+	if cfg.SearchEngine != "" {
+		for _, eventType := range usecase.SearchEventTypes {
+			enqueue(eventType, jobKindSearchIndexPrefix+string(eventType))
+		}
+	}
+	if cfg.EmbeddingProvider != "" {
+		for _, eventType := range usecase.SearchEventTypes {
+			enqueue(eventType, jobKindEmbeddingIndexPrefix+string(eventType))
+		}
+	}
+	if hasTeamChannels(cfg) {
+		enqueue(core.EventTypeAssetStatusChanged, jobKindNotifyAssetFailed)
+		enqueue(core.EventTypeModerationReviewRequested, jobKindNotifyReviewRequested)
+	}
+	if cfg.TranscriptAligner != "" {
+		enqueue(core.EventTypeEpisodeCreated, jobKindAlignEpisodeCreated)
+		enqueue(core.EventTypeEpisodeUpdated, jobKindAlignEpisodeUpdated)
+	}
+	if cfg.AudioPackager != "" {
+		enqueue(core.EventTypeAssetReady, jobKindPackageAssetReady)
+		enqueue(core.EventTypeSeriesUpdated, jobKindProtectSeriesUpdated)
+		enqueue(core.EventTypeEpisodeCreated, jobKindProtectEpisodeCreated)
+		enqueue(core.EventTypeEpisodeUpdated, jobKindProtectEpisodeUpdated)
+	}
+	if cfg.ImageProcessor != "" {
+		enqueue(core.EventTypeAssetReady, jobKindProcessImageReady)
+	}
+	if cfg.CDNProvider != "" {
+		enqueue(core.EventTypeEpisodeUnpublished, jobKindCDNEpisodeUnpublished)
+		enqueue(core.EventTypeEpisodeDeleted, jobKindCDNEpisodeDeleted)
+		enqueue(core.EventTypeAssetRenditionReplaced, jobKindCDNRenditionReplaced)
+	}
+	return bus
+}
+
+// NewJobWorker builds the background job worker with a handler for every
+// job kind.
+func NewJobWorker(cfg config.Config, repo core.JobRepository, notifications core.NotificationService, webhooks core.WebhookService, search core.SearchService, semantic core.SemanticSearchService, analytics core.AnalyticsService, alignment core.TranscriptAlignmentService, difficulty core.DifficultyService, cloze core.ClozeService, packaging core.AudioPackagingService, images core.ImageService, cdn core.CDNService, series core.SeriesService) *usecase.JobWorker {
+	worker := usecase.NewJobWorker(repo)
+	worker.WithConcurrency(cfg.JobWorkerConcurrency)
+	if host, err := os.Hostname(); err == nil {
+		worker.WithName(fmt.Sprintf("%s:%d", host, os.Getpid()))
+	}
+
+	handleEvent := func(kind string, eventType core.EventType, handle func(ctx context.Context, event core.Event) error) {
+		worker.Handle(kind, func(ctx context.Context, job core.Job) error {
+			event, err := core.DecodeEvent(eventType, job.Payload)
+			if err != nil {
+				return err
+			}
+			return handle(ctx, event)
+		}, usecase.DefaultJobRetryPolicy)
+	}
+	handleEvent(jobKindNotifyEpisodePublished, core.EventTypeEpisodePublished, func(ctx context.Context, event core.Event) error {
+		_, err := notifications.NotifyEpisodePublished(ctx, event.(core.EpisodePublished).Episode)
+		return err
+	})
+	handleEvent(jobKindWebhookSeriesPublished, core.EventTypeSeriesPublished, func(ctx context.Context, event core.Event) error {
+		_, err := webhooks.NotifySeriesPublished(ctx, event.(core.SeriesPublished).Series)
+		return err
+	})
+	handleEvent(jobKindWebhookEpisodeCreated, core.EventTypeEpisodeCreated, func(ctx context.Context, event core.Event) error {
+		_, err := webhooks.NotifyEpisodeCreated(ctx, event.(core.EpisodeCreated).Episode)
+		return err
+	})
+	handleEvent(jobKindWebhookAssetReady, core.EventTypeAssetReady, func(ctx context.Context, event core.Event) error {
+		_, err := webhooks.NotifyAssetReady(ctx, event.(core.AssetReady).Asset)
+		return err
+	})
+	handleEvent(jobKindWebhookEpisodePublished, core.EventTypeEpisodePublished, func(ctx context.Context, event core.Event) error {
+		_, err := webhooks.NotifyEpisodePublished(ctx, event.(core.EpisodePublished).Episode)
+		return err
+	})
+	handleEvent(jobKindDifficultyCreated, core.EventTypeEpisodeCreated, difficulty.HandleEpisodeEvent)
+	handleEvent(jobKindDifficultyUpdated, core.EventTypeEpisodeUpdated, difficulty.HandleEpisodeEvent)
+	handleEvent(jobKindClozeCreated, core.EventTypeEpisodeCreated, cloze.HandleEpisodeEvent)
+	handleEvent(jobKindClozeUpdated, core.EventTypeEpisodeUpdated, cloze.HandleEpisodeEvent)
+	handleEvent(jobKindDurationAssetReady, core.EventTypeAssetReady, series.HandleAssetEvent)
+	handleEvent(jobKindDurationAssetChanged, core.EventTypeAssetDurationChanged, series.HandleAssetEvent)
+	worker.Handle(usecase.EngagementRollupJobKind, analytics.HandleRollupJob, usecase.DefaultJobRetryPolicy)
+	if cfg.SearchEngine != "" {
+		for _, eventType := range usecase.SearchEventTypes {
+			handleEvent(jobKindSearchIndexPrefix+string(eventType), eventType, search.HandleEvent)
+		}
+	}
+	if cfg.EmbeddingProvider != "" {
+		for _, eventType := range usecase.SearchEventTypes {
+			handleEvent(jobKindEmbeddingIndexPrefix+string(eventType), eventType, semantic.HandleEvent)
+		}
+	}
+	if hasTeamChannels(cfg) {
+		handleEvent(jobKindNotifyAssetFailed, core.EventTypeAssetStatusChanged, func(ctx context.Context, event core.Event) error {
+			asset := event.(core.AssetStatusChanged).Asset
+			if asset.Status != core.AssetStatusFailed {
+				return nil
+			}
+			_, err := notifications.NotifyAssetFailed(ctx, asset)
+			return err
+		})
+		handleEvent(jobKindNotifyReviewRequested, core.EventTypeModerationReviewRequested, func(ctx context.Context, event core.Event) error {
+			_, err := notifications.NotifyReviewRequested(ctx, event.(core.ModerationReviewRequested).Item)
+			return err
+		})
+	}
+	if cfg.TranscriptAligner != "" {
+		handleEvent(jobKindAlignEpisodeCreated, core.EventTypeEpisodeCreated, alignment.HandleEpisodeEvent)
+		handleEvent(jobKindAlignEpisodeUpdated, core.EventTypeEpisodeUpdated, alignment.HandleEpisodeEvent)
+	}
+	if cfg.AudioPackager != "" {
+		handleEvent(jobKindPackageAssetReady, core.EventTypeAssetReady, packaging.HandleAssetReady)
+		handleEvent(jobKindProtectSeriesUpdated, core.EventTypeSeriesUpdated, packaging.HandleProtectionEvent)
+		handleEvent(jobKindProtectEpisodeCreated, core.EventTypeEpisodeCreated, packaging.HandleProtectionEvent)
+		handleEvent(jobKindProtectEpisodeUpdated, core.EventTypeEpisodeUpdated, packaging.HandleProtectionEvent)
+	}
+	if cfg.ImageProcessor != "" {
+		handleEvent(jobKindProcessImageReady, core.EventTypeAssetReady, images.HandleAssetReady)
+	}
+	if cfg.CDNProvider != "" {
+		handleEvent(jobKindCDNEpisodeUnpublished, core.EventTypeEpisodeUnpublished, cdn.HandleEvent)
+		handleEvent(jobKindCDNEpisodeDeleted, core.EventTypeEpisodeDeleted, cdn.HandleEvent)
+		handleEvent(jobKindCDNRenditionReplaced, core.EventTypeAssetRenditionReplaced, cdn.HandleEvent)
+	}
+	return worker
+}
+
+// NewScheduler builds the scheduler running the periodic maintenance tasks.
+// Every task gets up to a tenth of its interval as jitter.
+func NewScheduler(cfg config.Config, repo core.ScheduledTaskRepository, assets core.AssetService, notifications core.NotificationService, webhooks core.WebhookService, series core.SeriesService, analytics core.AnalyticsService, exports core.AnalyticsExportService, feeds core.FeedIngestService) *usecase.Scheduler {
+	scheduler := usecase.NewScheduler(repo)
+	if host, err := os.Hostname(); err == nil {
+		scheduler.WithName(fmt.Sprintf("%s:%d", host, os.Getpid()))
+	}
+
+	register := func(name string, interval time.Duration, run func(ctx context.Context) error) {
+		scheduler.Register(usecase.ScheduledTaskDefinition{
+			Name:     name,
+			Interval: interval,
+			Jitter:   interval / 10,
+			Run:      run,
+		})
+	}
+	register("upload_expiry", cfg.UploadExpiryInterval, func(ctx context.Context) error {
+		_, err := assets.ExpireUploadSessions(ctx)
+		return err
+	})
+	register("asset_gc", cfg.AssetGCInterval, func(ctx context.Context) error {
+		_, err := assets.PurgeDeletedAssets(ctx, cfg.AssetGCRetention)
+		return err
+	})
+	register("notification_reminders", cfg.NotificationReminderInterval, func(ctx context.Context) error {
+		_, assignmentErr := notifications.SendAssignmentReminders(ctx)
+		_, streakErr := notifications.SendStreakReminders(ctx)
+		return errors.Join(assignmentErr, streakErr)
+	})
+	register("webhook_retries", cfg.WebhookRetryInterval, func(ctx context.Context) error {
+		_, err := webhooks.RetryWebhookDeliveries(ctx)
+		return err
+	})
+	register("episode_count_reconciliation", cfg.EpisodeCountReconcileInterval, func(ctx context.Context) error {
+		_, err := series.ReconcileEpisodeCounts(ctx)
+		return err
+	})
+	register("engagement_rollup", cfg.EngagementRollupInterval, func(ctx context.Context) error {
+		_, err := analytics.RollupRecentEngagement(ctx)
+		return err
+	})
+	exportInterval := cfg.AnalyticsExportInterval
+	if cfg.AnalyticsExportSink == "" {
+		exportInterval = 0
+	}
+	register("analytics_export", exportInterval, func(ctx context.Context) error {
+		_, err := exports.ExportRecentAnalytics(ctx)
+		return err
+	})
+	register("feed_sync", cfg.FeedSyncInterval, func(ctx context.Context) error {
+		_, err := feeds.SyncFeedSubscriptions(ctx)
+		return err
+	})
+	return scheduler
+}
+
+// NewNotificationSenders builds a sender for every configured notification
+// channel. Nothing is delivered when no channel is configured.
+func NewNotificationSenders(cfg config.Config) ([]core.NotificationSender, error) {
+	var senders []core.NotificationSender
+	if cfg.SMTPAddress != "" {
+		sender, err := email.NewSender(cfg.SMTPAddress, cfg.SMTPUsername, cfg.SMTPPassword, cfg.NotificationEmailFrom)
+		if err != nil {
+			return nil, err
+		}
+		senders = append(senders, sender)
+	}
+	if cfg.FCMCredentialsFile != "" {
+		credentials, err := os.ReadFile(cfg.FCMCredentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("read FCM_CREDENTIALS_FILE: %w", err)
+		}
+		sender, err := fcm.NewSender(credentials)
+		if err != nil {
+			return nil, err
+		}
+		senders = append(senders, sender)
+	}
+	if cfg.SlackWebhookURL != "" {
+		senders = append(senders, slack.NewSender())
+	}
+	if cfg.DiscordWebhookURL != "" {
+		senders = append(senders, discord.NewSender())
+	}
+	return senders, nil
+}
+
+// teamNotificationKinds maps the names used by SLACK_NOTIFICATION_KINDS and
+// DISCORD_NOTIFICATION_KINDS to notification kinds.
+var teamNotificationKinds = map[string]core.NotificationKind{
+	"episode_published": core.NotificationKindEpisodePublished,
+	"asset_failed":      core.NotificationKindAssetFailed,
+	"review_requested":  core.NotificationKindReviewRequested,
+}
+
+// hasTeamChannels reports whether a Slack or Discord webhook is configured.
+func hasTeamChannels(cfg config.Config) bool {
+	return cfg.SlackWebhookURL != "" || cfg.DiscordWebhookURL != ""
+}
+
+// NewNotificationService builds the notification service, posting team
+// notifications to the configured Slack and Discord webhooks.
+func NewNotificationService(cfg config.Config, repo core.NotificationRepository, classrooms core.ClassroomRepository, series core.SeriesRepository, activity core.LearnerActivityRepository, senders []core.NotificationSender, catalog *i18n.Catalog) *usecase.NotificationService {
+	service := usecase.NewNotificationService(repo, classrooms, series, activity, senders, catalog)
+	var channels []core.TeamChannel
+	for _, team := range []struct {
+		channel    core.NotificationChannel
+		webhookURL string
+		kinds      []string
+	}{
+		{core.NotificationChannelSlack, cfg.SlackWebhookURL, cfg.SlackNotificationKinds},
+		{core.NotificationChannelDiscord, cfg.DiscordWebhookURL, cfg.DiscordNotificationKinds},
+	} {
+		if team.webhookURL == "" {
+			continue
+		}
+		channel := core.TeamChannel{Channel: team.channel, WebhookURL: team.webhookURL}
+		for _, kind := range team.kinds {
+			channel.Kinds = append(channel.Kinds, teamNotificationKinds[kind])
+		}
+		channels = append(channels, channel)
+	}
+	service.WithTeamChannels(channels...)
+	return service
+}
+
+// NewSeriesService builds the series service, running the transcript
+// sanitization pass when one is configured.
+func NewSeriesService(cfg config.Config, repo core.SeriesRepository, assets core.AssetRepository) (*usecase.SeriesService, error) {
+	service := usecase.NewSeriesService(repo)
+	service.WithCoverAssets(assets)
+	checklist := core.PublishChecklist{MinSummaryLength: cfg.PublishMinSummaryLength}
+	for _, rule := range cfg.PublishRules {
+		checklist.Rules = append(checklist.Rules, core.PublishRule(rule))
+	}
+	service.WithPublishChecklist(checklist)
+	if cfg.TranscriptSanitizeMode == "" {
+		return service, nil
+	}
+
+	detector, err := pii.NewDetector(cfg.TranscriptPIIDetectors...)
+	if err != nil {
+		return nil, fmt.Errorf("TRANSCRIPT_PII_DETECTORS: %w", err)
+	}
+	scanners := []core.TextScanner{detector}
+	words, err := moderationBlockedWords(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if len(words) > 0 {
+		scanners = append(scanners, wordlist.NewClassifier(words))
+	}
+
+	mode := usecase.TranscriptSanitizeFlag
+	if cfg.TranscriptSanitizeMode == "mask" {
+		mode = usecase.TranscriptSanitizeMask
+	}
+	service.WithTranscriptSanitizer(mode, scanners...)
+	return service, nil
+}
+
+// NewTextClassifiers builds the automatic filters user-generated text is
+// screened with: the blocked word list and, when an API key is configured,
+// Perspective toxicity scoring.
+func NewTextClassifiers(cfg config.Config) ([]core.TextClassifier, error) {
+	var classifiers []core.TextClassifier
+	words, err := moderationBlockedWords(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if len(words) > 0 {
+		classifiers = append(classifiers, wordlist.NewClassifier(words))
+	}
+	if cfg.PerspectiveAPIKey != "" {
+		classifiers = append(classifiers, perspective.NewClassifier(cfg.PerspectiveAPIKey, cfg.ModerationToxicityThreshold))
+	}
+	return classifiers, nil
+}
+
+// moderationBlockedWords combines the configured blocked words with those
+// of the word list file.
+func moderationBlockedWords(cfg config.Config) ([]string, error) {
+	words := slices.Clone(cfg.ModerationBlockedWords)
+	if cfg.ModerationWordlistFile != "" {
+		fileWords, err := wordlist.LoadFile(cfg.ModerationWordlistFile)
+		if err != nil {
+			return nil, fmt.Errorf("read MODERATION_WORDLIST_FILE: %w", err)
+		}
+		words = append(words, fileWords...)
+	}
+	return words, nil
+}
+
+// NewModerationService builds the moderation service.
+func NewModerationService(cfg config.Config, repo core.ModerationRepository, classifiers []core.TextClassifier) *usecase.ModerationService {
+	service := usecase.NewModerationService(repo, classifiers)
+	service.WithRequireReview(cfg.ModerationRequireReview)
+	return service
+}
+
+// NewPlaylistService builds the playlist service, which screens the text of
+// public playlists before they are shared.
+func NewPlaylistService(repo core.PlaylistRepository, series core.SeriesRepository, moderation core.ModerationService) *usecase.PlaylistService {
+	service := usecase.NewPlaylistService(repo, series)
+	service.WithModeration(moderation)
+	return service
+}
@@ -4,55 +4,196 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"sync"
 	"time"
 
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"golang.org/x/crypto/acme/autocert"
+
 	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	"github.com/eslsoft/lession/internal/adapter/eventbus"
 	"github.com/eslsoft/lession/internal/config"
+	"github.com/eslsoft/lession/internal/core"
+	"github.com/eslsoft/lession/internal/usecase"
 )
 
 // Server wraps the HTTP server and its dependencies.
 type Server struct {
 	cfg        config.Config
 	httpServer *http.Server
+	debug      *debugServer
 	entClient  *entgenerated.Client
+	outbox     core.OutboxRelay
+	jobs       *usecase.JobWorker
+	scheduler  *usecase.Scheduler
+	usage      *usecase.UsageBuffer
+	tracing    *sdktrace.TracerProvider
 }
 
-// NewServer constructs a Server from the provided dependencies.
-func NewServer(cfg config.Config, handler http.Handler, entClient *entgenerated.Client) *Server {
+// NewServer constructs a Server from the provided dependencies. Live event
+// streams and asset watches are ended as soon as shutdown starts, since they
+// would otherwise hold the drain open until it times out.
+func NewServer(cfg config.Config, handler http.Handler, entClient *entgenerated.Client, outbox core.OutboxRelay, jobs *usecase.JobWorker, scheduler *usecase.Scheduler, usage *usecase.UsageBuffer, bus *eventbus.Bus, assets *usecase.AssetService, tracing *sdktrace.TracerProvider) *Server {
+	httpServer := newHTTPServer(cfg, handler)
+	httpServer.RegisterOnShutdown(bus.Close)
+	httpServer.RegisterOnShutdown(assets.StopWatching)
+
 	return &Server{
-		cfg: cfg,
-		httpServer: &http.Server{
-			Addr:    cfg.HTTPAddress,
-			Handler: handler,
-		},
-		entClient: entClient,
+		cfg:        cfg,
+		httpServer: httpServer,
+		debug:      newDebugServer(cfg),
+		entClient:  entClient,
+		outbox:     outbox,
+		jobs:       jobs,
+		scheduler:  scheduler,
+		usage:      usage,
+		tracing:    tracing,
 	}
 }
 
-// Run starts the HTTP server and blocks until the context is cancelled or an error occurs.
+// Run starts the HTTP server and blocks until the context is cancelled or an
+// error occurs. On shutdown, in-flight requests, jobs and scheduled tasks
+// get up to the configured drain timeout to finish; events their writes
+// committed to the outbox are relayed and metered usage is recorded before
+// the database is closed.
 func (s *Server) Run(ctx context.Context) error {
-	errCh := make(chan error, 1)
+	background, stopBackground := context.WithCancel(ctx)
+	defer stopBackground()
 
-	go func() {
-		if err := s.httpServer.ListenAndServe(); err != nil {
-			errCh <- err
-		} else {
-			close(errCh)
+	if s.debug != nil {
+		if err := s.debug.start(background); err != nil {
+			return err
 		}
+	}
+
+	var wg sync.WaitGroup
+	if s.cfg.OutboxRelayInterval > 0 {
+		wg.Go(func() { s.runOutboxRelay(background) })
+	}
+	wg.Go(func() { s.usage.Run(background, s.cfg.UsageFlushInterval) })
+	if s.cfg.EmbeddedWorker {
+		wg.Go(func() { s.jobs.Run(background, s.cfg.JobPollInterval) })
+		wg.Go(func() { s.scheduler.Run(background, s.cfg.SchedulerPollInterval) })
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.listenAndServe()
 	}()
 
+	var serveErr error
 	select {
 	case <-ctx.Done():
-		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-		_ = s.httpServer.Shutdown(shutdownCtx)
-		_ = s.entClient.Close()
-		return nil
 	case err := <-errCh:
-		if err != nil && !errors.Is(err, http.ErrServerClosed) {
-			_ = s.entClient.Close()
-			return err
+		if !errors.Is(err, http.ErrServerClosed) {
+			serveErr = err
 		}
-		return nil
+	}
+
+	drainCtx, cancel := context.WithTimeout(context.Background(), s.cfg.ShutdownTimeout)
+	defer cancel()
+
+	// Stop accepting requests and let in-flight ones finish, then stop the
+	// background loops; running jobs complete their current attempt.
+	if err := s.httpServer.Shutdown(drainCtx); err != nil {
+		_ = s.httpServer.Close()
+	}
+	stopBackground()
+	waitWithin(drainCtx, &wg)
+
+	if s.cfg.OutboxRelayInterval > 0 {
+		relayPending(drainCtx, s.outbox)
+	}
+	_, _ = s.usage.FlushUsage(drainCtx)
+
+	_ = s.tracing.Shutdown(drainCtx)
+	if err := s.entClient.Close(); err != nil && serveErr == nil {
+		return err
+	}
+	return serveErr
+}
+
+// newHTTPServer configures the HTTP server's protocols, TLS and limits.
+func newHTTPServer(cfg config.Config, handler http.Handler) *http.Server {
+	// gRPC clients, including health probes and grpcurl, need HTTP/2, which
+	// plaintext listeners only speak when unencrypted HTTP/2 is enabled.
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetHTTP2(true)
+	protocols.SetUnencryptedHTTP2(cfg.H2C)
+
+	srv := &http.Server{
+		Addr:              cfg.HTTPAddress,
+		Handler:           handler,
+		Protocols:         protocols,
+		ReadHeaderTimeout: cfg.HTTPReadHeaderTimeout,
+		ReadTimeout:       cfg.HTTPReadTimeout,
+		WriteTimeout:      cfg.HTTPWriteTimeout,
+		IdleTimeout:       cfg.HTTPIdleTimeout,
+		MaxHeaderBytes:    cfg.HTTPMaxHeaderBytes,
+	}
+	if len(cfg.ACMEDomains) > 0 {
+		manager := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(cfg.ACMEDomains...),
+			Cache:      autocert.DirCache(cfg.ACMECacheDir),
+			Email:      cfg.ACMEEmail,
+		}
+		srv.TLSConfig = manager.TLSConfig()
+	}
+	return srv
+}
+
+// listenAndServe serves TLS when a certificate is configured or obtained
+// through ACME, and plain HTTP otherwise.
+func (s *Server) listenAndServe() error {
+	switch {
+	case s.cfg.TLSCertFile != "":
+		return s.httpServer.ListenAndServeTLS(s.cfg.TLSCertFile, s.cfg.TLSKeyFile)
+	case s.httpServer.TLSConfig != nil:
+		return s.httpServer.ListenAndServeTLS("", "")
+	default:
+		return s.httpServer.ListenAndServe()
+	}
+}
+
+// runOutboxRelay periodically publishes committed outbox messages until ctx
+// is cancelled. Passes repeat while messages are being dispatched so a
+// backlog drains without waiting for the ticker.
+func (s *Server) runOutboxRelay(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.OutboxRelayInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			relayPending(ctx, s.outbox)
+		}
+	}
+}
+
+// relayPending relays outbox messages until none are left, a pass fails or
+// ctx is done.
+func relayPending(ctx context.Context, outbox core.OutboxRelay) {
+	for {
+		n, err := outbox.RelayOutbox(ctx)
+		if err != nil || n == 0 || ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// waitWithin waits for wg, giving up once ctx is done.
+func waitWithin(ctx context.Context, wg *sync.WaitGroup) {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
 	}
 }
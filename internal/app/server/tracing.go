@@ -0,0 +1,45 @@
+package server
+
+import (
+	"context"
+
+	"connectrpc.com/otelconnect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/eslsoft/lession/internal/config"
+)
+
+// NewTracerProvider configures OpenTelemetry tracing and installs it as the
+// global provider, together with W3C trace context propagation. Spans are
+// exported over OTLP when configured and dropped otherwise. The provider
+// must be shut down to flush buffered spans.
+func NewTracerProvider(cfg config.Config) (*sdktrace.TracerProvider, error) {
+	opts := []sdktrace.TracerProviderOption{}
+	if cfg.OTLPTracing {
+		exporter, err := otlptracehttp.New(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	} else {
+		opts = append(opts, sdktrace.WithSampler(sdktrace.NeverSample()))
+	}
+
+	provider := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return provider, nil
+}
+
+// NewTracingInterceptor records a server span for every Connect call. The
+// service runs behind a gateway, so traces started there are continued.
+func NewTracingInterceptor(provider trace.TracerProvider) (*otelconnect.Interceptor, error) {
+	return otelconnect.NewInterceptor(
+		otelconnect.WithTracerProvider(provider),
+		otelconnect.WithTrustRemote(),
+	)
+}
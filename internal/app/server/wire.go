@@ -4,10 +4,17 @@ package server
 
 import (
 	"github.com/google/wire"
+	"github.com/prometheus/client_golang/prometheus"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/eslsoft/lession/internal/adapter/db"
-	"github.com/eslsoft/lession/internal/adapter/media/fake"
+	"github.com/eslsoft/lession/internal/adapter/eventbus"
+	"github.com/eslsoft/lession/internal/adapter/feed"
+	"github.com/eslsoft/lession/internal/adapter/lmspackage"
+	"github.com/eslsoft/lession/internal/adapter/spreadsheet"
 	adaptertransport "github.com/eslsoft/lession/internal/adapter/transport"
+	"github.com/eslsoft/lession/internal/adapter/webhook"
 	"github.com/eslsoft/lession/internal/core"
 	"github.com/eslsoft/lession/internal/usecase"
 )
@@ -16,22 +23,417 @@ import (
 func InitializeServer() (*Server, error) {
 	wire.Build(
 		NewConfig,
+		NewDatabase,
+		NewReplicaDatabase,
+		NewTracerProvider,
+		wire.Bind(new(trace.TracerProvider), new(*sdktrace.TracerProvider)),
+		NewMigrator,
+		NewTxManager,
+		wire.Bind(new(core.TxManager), new(*db.TxManager)),
 		NewEntClient,
 		wire.Bind(new(core.AssetRepository), new(*db.AssetRepository)),
 		db.NewAssetRepository,
-		wire.Bind(new(core.SeriesRepository), new(*db.SeriesRepository)),
-		db.NewSeriesRepository,
-		wire.Bind(new(core.UploadProvider), new(*fake.Provider)),
-		NewFakeUploadProvider,
+		NewDBSeriesRepository,
+		NewCacheStore,
+		NewSeriesCache,
+		NewSeriesRepository,
+		wire.Bind(new(core.LearnerActivityRepository), new(*db.LearnerActivityRepository)),
+		db.NewLearnerActivityRepository,
+		wire.Bind(new(core.DictationAttemptRepository), new(*db.DictationRepository)),
+		db.NewDictationRepository,
+		wire.Bind(new(core.VocabularyRepository), new(*db.VocabularyRepository)),
+		db.NewVocabularyRepository,
+		wire.Bind(new(core.MeteringRepository), new(*db.MeteringRepository)),
+		db.NewMeteringRepository,
+		wire.Bind(new(core.AssetUsageRepository), new(*db.AssetUsageRepository)),
+		db.NewAssetUsageRepository,
+		wire.Bind(new(core.ShadowingRepository), new(*db.ShadowingRepository)),
+		db.NewShadowingRepository,
+		wire.Bind(new(core.PlaylistRepository), new(*db.PlaylistRepository)),
+		db.NewPlaylistRepository,
+		wire.Bind(new(core.TranscriptAdminRepository), new(*db.TranscriptAdminRepository)),
+		db.NewTranscriptAdminRepository,
+		wire.Bind(new(core.WatchHistoryRepository), new(*db.WatchHistoryRepository)),
+		db.NewWatchHistoryRepository,
+		wire.Bind(new(core.RecommendationSignalRepository), new(*db.WatchHistoryRepository)),
+		wire.Bind(new(core.SubscriptionRepository), new(*db.SubscriptionRepository)),
+		db.NewSubscriptionRepository,
+		wire.Bind(new(core.InvoiceRepository), new(*db.InvoiceRepository)),
+		db.NewInvoiceRepository,
+		wire.Bind(new(core.ClassroomRepository), new(*db.ClassroomRepository)),
+		db.NewClassroomRepository,
+		wire.Bind(new(core.BookingRepository), new(*db.BookingRepository)),
+		db.NewBookingRepository,
+		wire.Bind(new(core.ModerationRepository), new(*db.ModerationRepository)),
+		db.NewModerationRepository,
+		wire.Bind(new(core.LTIRepository), new(*db.LTIRepository)),
+		db.NewLTIRepository,
+		wire.Bind(new(core.NotificationRepository), new(*db.NotificationRepository)),
+		db.NewNotificationRepository,
+		wire.Bind(new(core.WebhookRepository), new(*db.WebhookRepository)),
+		db.NewWebhookRepository,
+		db.NewEventRepository,
+		wire.Bind(new(core.EventPublisher), new(*eventbus.Bus)),
+		wire.Bind(new(core.EventListener), new(*eventbus.Bus)),
+		NewEventBus,
+		wire.Bind(new(core.OutboxRepository), new(*db.OutboxRepository)),
+		db.NewOutboxRepository,
+		wire.Bind(new(core.OutboxRelay), new(*usecase.OutboxRelay)),
+		usecase.NewOutboxRelay,
+		wire.Bind(new(core.JobRepository), new(*db.JobRepository)),
+		db.NewJobRepository,
+		wire.Bind(new(core.JobQueue), new(*usecase.JobService)),
+		wire.Bind(new(core.JobService), new(*usecase.JobService)),
+		usecase.NewJobService,
+		NewJobWorker,
+		wire.Bind(new(core.ScheduledTaskRepository), new(*db.ScheduledTaskRepository)),
+		db.NewScheduledTaskRepository,
+		wire.Bind(new(core.SchedulerService), new(*usecase.Scheduler)),
+		NewScheduler,
+		wire.Bind(new(core.AuditRepository), new(*db.AuditRepository)),
+		db.NewAuditRepository,
+		wire.Bind(new(core.AuditService), new(*usecase.AuditService)),
+		usecase.NewAuditService,
+		wire.Bind(new(core.APIKeyRepository), new(*db.APIKeyRepository)),
+		db.NewAPIKeyRepository,
+		wire.Bind(new(core.APIKeyService), new(*usecase.APIKeyService)),
+		usecase.NewAPIKeyService,
+		NewUploadProvider,
 		wire.Bind(new(core.AssetService), new(*usecase.AssetService)),
-		usecase.NewAssetService,
+		NewAssetService,
 		wire.Bind(new(core.SeriesService), new(*usecase.SeriesService)),
-		usecase.NewSeriesService,
+		NewSeriesService,
+		NewTranscriptAligner,
+		wire.Bind(new(core.TranscriptAlignmentService), new(*usecase.TranscriptAlignmentService)),
+		usecase.NewTranscriptAlignmentService,
+		wire.Bind(new(core.DifficultyService), new(*usecase.DifficultyService)),
+		usecase.NewDifficultyService,
+		wire.Bind(new(core.QuizItemRepository), new(*db.QuizRepository)),
+		db.NewQuizRepository,
+		wire.Bind(new(core.ClozeService), new(*usecase.ClozeService)),
+		usecase.NewClozeService,
+		NewAudioPackager,
+		wire.Bind(new(core.ContentKeyRepository), new(*db.ContentKeyRepository)),
+		db.NewContentKeyRepository,
+		wire.Bind(new(core.AudioPackagingService), new(*usecase.AudioPackagingService)),
+		NewAudioPackagingService,
+		NewImageProcessor,
+		wire.Bind(new(core.ImageService), new(*usecase.ImageService)),
+		usecase.NewImageService,
+		NewCDNProvider,
+		wire.Bind(new(core.CDNService), new(*usecase.CDNService)),
+		NewCDNService,
+		wire.Bind(new(core.ContentKeyService), new(*usecase.ContentKeyService)),
+		usecase.NewContentKeyService,
+		wire.Bind(new(core.LearnerStatsService), new(*usecase.LearnerStatsService)),
+		usecase.NewLearnerStatsService,
+		wire.Bind(new(core.DictationService), new(*usecase.DictationService)),
+		usecase.NewDictationService,
+		wire.Bind(new(core.VocabularyService), new(*usecase.VocabularyService)),
+		usecase.NewVocabularyService,
+		NewDictionaryProvider,
+		wire.Bind(new(core.InteractiveTranscriptService), new(*usecase.InteractiveTranscriptService)),
+		NewInteractiveTranscriptService,
+		wire.Bind(new(core.DownloadService), new(*usecase.DownloadService)),
+		NewDownloadService,
+		wire.Bind(new(core.MeteringService), new(*usecase.MeteringService)),
+		usecase.NewMeteringService,
+		wire.Bind(new(core.UsageCounter), new(*usecase.UsageBuffer)),
+		usecase.NewUsageBuffer,
+		wire.Bind(new(core.AssetUsageService), new(*usecase.AssetUsageService)),
+		usecase.NewAssetUsageService,
+		wire.Bind(new(core.ShadowingService), new(*usecase.ShadowingService)),
+		usecase.NewShadowingService,
+		wire.Bind(new(core.PlaylistService), new(*usecase.PlaylistService)),
+		NewPlaylistService,
+		wire.Bind(new(core.TranscriptAdminService), new(*usecase.TranscriptAdminService)),
+		NewTranscriptAdminService,
+		wire.Bind(new(core.WatchHistoryService), new(*usecase.WatchHistoryService)),
+		usecase.NewWatchHistoryService,
+		wire.Bind(new(core.WidgetService), new(*usecase.WidgetService)),
+		usecase.NewWidgetService,
+		wire.Bind(new(core.CatalogService), new(*usecase.CatalogService)),
+		usecase.NewCatalogService,
+		NewSitemapService,
+		wire.Bind(new(core.LinkPreviewService), new(*usecase.LinkPreviewService)),
+		NewLinkPreviewService,
+		wire.Bind(new(core.EmbedService), new(*usecase.EmbedService)),
+		NewEmbedService,
+		wire.Bind(new(core.SpreadsheetReader), new(*spreadsheet.Reader)),
+		spreadsheet.NewReader,
+		wire.Bind(new(core.CatalogImportService), new(*usecase.CatalogImportService)),
+		usecase.NewCatalogImportService,
+		wire.Bind(new(core.FeedSubscriptionRepository), new(*db.FeedSubscriptionRepository)),
+		db.NewFeedSubscriptionRepository,
+		wire.Bind(new(core.FeedFetcher), new(*feed.Fetcher)),
+		feed.NewFetcher,
+		wire.Bind(new(core.MediaTransferer), new(*feed.Transferer)),
+		feed.NewTransferer,
+		wire.Bind(new(core.FeedIngestService), new(*usecase.FeedIngestService)),
+		usecase.NewFeedIngestService,
+		wire.Bind(new(core.RecommendationService), new(*usecase.RecommendationService)),
+		usecase.NewRecommendationService,
+		wire.Bind(new(core.SearchService), new(*usecase.SearchService)),
+		db.NewSearchRepository,
+		NewSearchIndex,
+		NewSearchService,
+		db.NewEmbeddingRepository,
+		NewEmbeddingProvider,
+		wire.Bind(new(core.SemanticSearchService), new(*usecase.SemanticSearchService)),
+		NewSemanticSearchService,
+		wire.Bind(new(core.EngagementRepository), new(*db.EngagementRepository)),
+		db.NewEngagementRepository,
+		wire.Bind(new(core.AnalyticsService), new(*usecase.AnalyticsService)),
+		usecase.NewAnalyticsService,
+		NewAnalyticsExportSink,
+		wire.Bind(new(core.AnalyticsExportService), new(*usecase.AnalyticsExportService)),
+		NewAnalyticsExportService,
+		wire.Bind(new(core.SubscriptionService), new(*usecase.SubscriptionService)),
+		usecase.NewSubscriptionService,
+		wire.Bind(new(core.BillingService), new(*usecase.BillingService)),
+		usecase.NewBillingService,
+		wire.Bind(new(core.ClassroomService), new(*usecase.ClassroomService)),
+		usecase.NewClassroomService,
+		wire.Bind(new(core.BookingService), new(*usecase.BookingService)),
+		usecase.NewBookingService,
+		NewTextClassifiers,
+		wire.Bind(new(core.ModerationService), new(*usecase.ModerationService)),
+		NewModerationService,
+		wire.Bind(new(core.AuthoringService), new(*usecase.AuthoringService)),
+		usecase.NewAuthoringService,
+		wire.Bind(new(core.LTIService), new(*usecase.LTIService)),
+		usecase.NewLTIService,
+		wire.Bind(new(core.NotificationService), new(*usecase.NotificationService)),
+		NewNotificationService,
+		wire.Bind(new(core.WebhookClient), new(*webhook.Client)),
+		webhook.NewClient,
+		wire.Bind(new(core.WebhookService), new(*usecase.WebhookService)),
+		usecase.NewWebhookService,
+		wire.Bind(new(core.PackageBuilder), new(*lmspackage.Builder)),
+		lmspackage.NewBuilder,
+		wire.Bind(new(core.PackageExportService), new(*usecase.PackageExportService)),
+		usecase.NewPackageExportService,
 		adaptertransport.NewAssetHandler,
 		adaptertransport.NewSeriesHandler,
+		adaptertransport.NewLearnerStatsHandler,
+		adaptertransport.NewDictationHandler,
+		adaptertransport.NewVocabularyHandler,
+		adaptertransport.NewInteractiveTranscriptHandler,
+		adaptertransport.NewQuizHandler,
+		adaptertransport.NewDownloadHandler,
+		adaptertransport.NewDownloadFileHandler,
+		NewHLSHandler,
+		NewImageHandler,
+		adaptertransport.NewContentKeyHandler,
+		adaptertransport.NewMeteringHandler,
+		adaptertransport.NewShadowingHandler,
+		adaptertransport.NewPlaylistHandler,
+		adaptertransport.NewTranscriptAdminHandler,
+		adaptertransport.NewWatchHistoryHandler,
+		adaptertransport.NewWidgetHandler,
+		adaptertransport.NewCatalogHandler,
+		NewSitemapHandler,
+		adaptertransport.NewOEmbedHandler,
+		adaptertransport.NewEmbedHandler,
+		adaptertransport.NewImportHandler,
+		adaptertransport.NewFeedHandler,
+		adaptertransport.NewRecommendationHandler,
+		adaptertransport.NewSearchHandler,
+		adaptertransport.NewAnalyticsHandler,
+		adaptertransport.NewSubscriptionHandler,
+		adaptertransport.NewBillingHandler,
+		adaptertransport.NewBillingWebhookHandler,
+		adaptertransport.NewClassroomHandler,
+		adaptertransport.NewBookingHandler,
+		adaptertransport.NewModerationHandler,
+		adaptertransport.NewAuthoringHandler,
+		adaptertransport.NewLTIHandler,
+		NewLTILaunchHandler,
+		adaptertransport.NewPackageExportHandler,
+		adaptertransport.NewNotificationHandler,
+		adaptertransport.NewWebhookHandler,
+		adaptertransport.NewJobHandler,
+		adaptertransport.NewSchedulerHandler,
+		adaptertransport.NewAuditHandler,
+		adaptertransport.NewAPIKeyHandler,
+		adaptertransport.NewEventStreamHandler,
+		NewReadinessHandler,
+		NewTracingInterceptor,
+		NewCORSOptions,
+		NewCompressionOptions,
+		NewMetricsRegistry,
+		wire.Bind(new(prometheus.Registerer), new(*prometheus.Registry)),
+		adaptertransport.NewMetricsInterceptor,
+		NewLTIClient,
+		NewNotificationSenders,
+		NewBillingProvider,
+		NewEpisodeDraftGenerator,
+		NewWidgetSigner,
 		NewProtoValidator,
+		NewMessageCatalog,
 		NewHTTPHandler,
 		NewServer,
 	)
 	return nil, nil
 }
+
+// InitializeWorker sets up the background job worker and scheduler with all dependencies wired.
+func InitializeWorker() (*Worker, error) {
+	wire.Build(
+		NewConfig,
+		NewDatabase,
+		NewReplicaDatabase,
+		NewTracerProvider,
+		wire.Bind(new(trace.TracerProvider), new(*sdktrace.TracerProvider)),
+		NewMigrator,
+		NewTxManager,
+		wire.Bind(new(core.TxManager), new(*db.TxManager)),
+		NewEntClient,
+		wire.Bind(new(core.AssetRepository), new(*db.AssetRepository)),
+		db.NewAssetRepository,
+		NewDBSeriesRepository,
+		NewCacheStore,
+		NewSeriesCache,
+		NewSeriesRepository,
+		wire.Bind(new(core.LearnerActivityRepository), new(*db.LearnerActivityRepository)),
+		db.NewLearnerActivityRepository,
+		wire.Bind(new(core.ClassroomRepository), new(*db.ClassroomRepository)),
+		db.NewClassroomRepository,
+		wire.Bind(new(core.NotificationRepository), new(*db.NotificationRepository)),
+		db.NewNotificationRepository,
+		wire.Bind(new(core.WebhookRepository), new(*db.WebhookRepository)),
+		db.NewWebhookRepository,
+		wire.Bind(new(core.JobRepository), new(*db.JobRepository)),
+		db.NewJobRepository,
+		wire.Bind(new(core.ScheduledTaskRepository), new(*db.ScheduledTaskRepository)),
+		db.NewScheduledTaskRepository,
+		NewUploadProvider,
+		wire.Bind(new(core.AssetService), new(*usecase.AssetService)),
+		NewAssetService,
+		wire.Bind(new(core.NotificationService), new(*usecase.NotificationService)),
+		NewNotificationService,
+		wire.Bind(new(core.WebhookClient), new(*webhook.Client)),
+		webhook.NewClient,
+		wire.Bind(new(core.WebhookService), new(*usecase.WebhookService)),
+		usecase.NewWebhookService,
+		wire.Bind(new(core.SeriesService), new(*usecase.SeriesService)),
+		NewSeriesService,
+		NewTranscriptAligner,
+		wire.Bind(new(core.TranscriptAlignmentService), new(*usecase.TranscriptAlignmentService)),
+		usecase.NewTranscriptAlignmentService,
+		wire.Bind(new(core.DifficultyService), new(*usecase.DifficultyService)),
+		usecase.NewDifficultyService,
+		wire.Bind(new(core.QuizItemRepository), new(*db.QuizRepository)),
+		db.NewQuizRepository,
+		wire.Bind(new(core.ClozeService), new(*usecase.ClozeService)),
+		usecase.NewClozeService,
+		NewAudioPackager,
+		wire.Bind(new(core.ContentKeyRepository), new(*db.ContentKeyRepository)),
+		db.NewContentKeyRepository,
+		wire.Bind(new(core.AudioPackagingService), new(*usecase.AudioPackagingService)),
+		NewAudioPackagingService,
+		NewImageProcessor,
+		wire.Bind(new(core.ImageService), new(*usecase.ImageService)),
+		usecase.NewImageService,
+		NewCDNProvider,
+		wire.Bind(new(core.CDNService), new(*usecase.CDNService)),
+		NewCDNService,
+		wire.Bind(new(core.SearchService), new(*usecase.SearchService)),
+		db.NewSearchRepository,
+		NewSearchIndex,
+		NewSearchService,
+		db.NewEmbeddingRepository,
+		NewEmbeddingProvider,
+		wire.Bind(new(core.SemanticSearchService), new(*usecase.SemanticSearchService)),
+		NewSemanticSearchService,
+		wire.Bind(new(core.JobQueue), new(*usecase.JobService)),
+		usecase.NewJobService,
+		wire.Bind(new(core.EngagementRepository), new(*db.EngagementRepository)),
+		db.NewEngagementRepository,
+		wire.Bind(new(core.AnalyticsService), new(*usecase.AnalyticsService)),
+		usecase.NewAnalyticsService,
+		NewAnalyticsExportSink,
+		wire.Bind(new(core.AnalyticsExportService), new(*usecase.AnalyticsExportService)),
+		NewAnalyticsExportService,
+		wire.Bind(new(core.FeedSubscriptionRepository), new(*db.FeedSubscriptionRepository)),
+		db.NewFeedSubscriptionRepository,
+		wire.Bind(new(core.FeedFetcher), new(*feed.Fetcher)),
+		feed.NewFetcher,
+		wire.Bind(new(core.MediaTransferer), new(*feed.Transferer)),
+		feed.NewTransferer,
+		wire.Bind(new(core.FeedIngestService), new(*usecase.FeedIngestService)),
+		usecase.NewFeedIngestService,
+		NewJobWorker,
+		NewScheduler,
+		NewNotificationSenders,
+		NewMessageCatalog,
+		NewWorker,
+	)
+	return nil, nil
+}
+
+// InitializeAdmin sets up the catalog handlers, seeding, backups, search
+// indexing and analytics exports the admin CLI uses in direct mode.
+func InitializeAdmin() (*Admin, error) {
+	wire.Build(
+		NewConfig,
+		NewDatabase,
+		NewReplicaDatabase,
+		NewTracerProvider,
+		wire.Bind(new(trace.TracerProvider), new(*sdktrace.TracerProvider)),
+		NewMigrator,
+		NewTxManager,
+		wire.Bind(new(core.TxManager), new(*db.TxManager)),
+		NewEntClient,
+		wire.Bind(new(core.AssetRepository), new(*db.AssetRepository)),
+		db.NewAssetRepository,
+		NewDBSeriesRepository,
+		NewCacheStore,
+		NewSeriesCache,
+		NewSeriesRepository,
+		NewUploadProvider,
+		wire.Bind(new(core.AssetService), new(*usecase.AssetService)),
+		NewAssetService,
+		wire.Bind(new(core.SeriesService), new(*usecase.SeriesService)),
+		NewSeriesService,
+		wire.Bind(new(core.PackageBuilder), new(*lmspackage.Builder)),
+		lmspackage.NewBuilder,
+		wire.Bind(new(core.PackageExportService), new(*usecase.PackageExportService)),
+		usecase.NewPackageExportService,
+		wire.Bind(new(core.SubscriptionRepository), new(*db.SubscriptionRepository)),
+		db.NewSubscriptionRepository,
+		wire.Bind(new(core.SubscriptionService), new(*usecase.SubscriptionService)),
+		usecase.NewSubscriptionService,
+		wire.Bind(new(core.SeedService), new(*usecase.SeedService)),
+		usecase.NewSeedService,
+		NewBackupRepository,
+		wire.Bind(new(core.BackupService), new(*usecase.BackupService)),
+		NewBackupService,
+		wire.Bind(new(core.SearchService), new(*usecase.SearchService)),
+		db.NewSearchRepository,
+		NewSearchIndex,
+		NewSearchService,
+		db.NewEmbeddingRepository,
+		NewEmbeddingProvider,
+		wire.Bind(new(core.SemanticSearchService), new(*usecase.SemanticSearchService)),
+		NewSemanticSearchService,
+		wire.Bind(new(core.EngagementRepository), new(*db.EngagementRepository)),
+		db.NewEngagementRepository,
+		NewAnalyticsExportSink,
+		wire.Bind(new(core.AnalyticsExportService), new(*usecase.AnalyticsExportService)),
+		NewAnalyticsExportService,
+		NewAudioPackager,
+		wire.Bind(new(core.ContentKeyRepository), new(*db.ContentKeyRepository)),
+		db.NewContentKeyRepository,
+		wire.Bind(new(core.AudioPackagingService), new(*usecase.AudioPackagingService)),
+		NewAudioPackagingService,
+		wire.Bind(new(core.AssetUsageRepository), new(*db.AssetUsageRepository)),
+		db.NewAssetUsageRepository,
+		wire.Bind(new(core.AssetUsageService), new(*usecase.AssetUsageService)),
+		usecase.NewAssetUsageService,
+		adaptertransport.NewAssetHandler,
+		adaptertransport.NewSeriesHandler,
+		NewAdmin,
+	)
+	return nil, nil
+}
@@ -8,12 +8,17 @@ package server
 
 import (
 	"github.com/eslsoft/lession/internal/adapter/db"
+	"github.com/eslsoft/lession/internal/adapter/feed"
+	"github.com/eslsoft/lession/internal/adapter/lmspackage"
+	"github.com/eslsoft/lession/internal/adapter/spreadsheet"
 	"github.com/eslsoft/lession/internal/adapter/transport"
+	"github.com/eslsoft/lession/internal/adapter/webhook"
 	"github.com/eslsoft/lession/internal/usecase"
 )
 
 import (
 	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
 )
 
 // Injectors from wire.go:
@@ -24,22 +29,440 @@ func InitializeServer() (*Server, error) {
 	if err != nil {
 		return nil, err
 	}
-	client, err := NewEntClient(config)
+	sqlDB, err := NewDatabase(config)
+	if err != nil {
+		return nil, err
+	}
+	replicaDatabase, err := NewReplicaDatabase(config)
+	if err != nil {
+		return nil, err
+	}
+	txManager := NewTxManager(config, sqlDB, replicaDatabase)
+	migrator := NewMigrator(sqlDB)
+	tracerProvider, err := NewTracerProvider(config)
+	if err != nil {
+		return nil, err
+	}
+	client, err := NewEntClient(config, txManager, migrator, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	assetRepository := db.NewAssetRepository(client)
-	provider := NewFakeUploadProvider()
-	assetService := usecase.NewAssetService(assetRepository, provider)
-	assetHandler := transport.NewAssetHandler(assetService)
-	seriesRepository := db.NewSeriesRepository(client)
-	seriesService := usecase.NewSeriesService(seriesRepository)
+	uploadProvider, err := NewUploadProvider(config)
+	if err != nil {
+		return nil, err
+	}
+	assetService := NewAssetService(assetRepository, uploadProvider, txManager)
+	seriesRepository := NewDBSeriesRepository(config, client)
+	store, err := NewCacheStore(config)
+	if err != nil {
+		return nil, err
+	}
+	cacheSeriesRepository := NewSeriesCache(config, seriesRepository, store)
+	coreSeriesRepository := NewSeriesRepository(seriesRepository, cacheSeriesRepository)
+	contentKeyRepository := db.NewContentKeyRepository(client)
+	audioPackager, err := NewAudioPackager(config)
+	if err != nil {
+		return nil, err
+	}
+	audioPackagingService := NewAudioPackagingService(config, assetRepository, coreSeriesRepository, contentKeyRepository, audioPackager)
+	assetUsageRepository := db.NewAssetUsageRepository(client)
+	assetUsageService := usecase.NewAssetUsageService(assetUsageRepository, assetRepository)
+	assetHandler := transport.NewAssetHandler(assetService, audioPackagingService, assetUsageService)
+	seriesService, err := NewSeriesService(config, coreSeriesRepository, assetRepository)
+	if err != nil {
+		return nil, err
+	}
 	seriesHandler := transport.NewSeriesHandler(seriesService)
+	learnerActivityRepository := db.NewLearnerActivityRepository(client)
+	learnerStatsService := usecase.NewLearnerStatsService(learnerActivityRepository)
+	learnerStatsHandler := transport.NewLearnerStatsHandler(learnerStatsService)
+	dictationRepository := db.NewDictationRepository(client)
+	dictationService := usecase.NewDictationService(coreSeriesRepository, dictationRepository)
+	dictationHandler := transport.NewDictationHandler(dictationService)
+	vocabularyRepository := db.NewVocabularyRepository(client)
+	vocabularyService := usecase.NewVocabularyService(vocabularyRepository, coreSeriesRepository)
+	vocabularyHandler := transport.NewVocabularyHandler(vocabularyService)
+	dictionaryProvider, err := NewDictionaryProvider(config)
+	if err != nil {
+		return nil, err
+	}
+	interactiveTranscriptService := NewInteractiveTranscriptService(config, coreSeriesRepository, dictionaryProvider, store)
+	interactiveTranscriptHandler := transport.NewInteractiveTranscriptHandler(interactiveTranscriptService)
+	quizRepository := db.NewQuizRepository(client)
+	clozeService := usecase.NewClozeService(coreSeriesRepository, quizRepository)
+	quizHandler := transport.NewQuizHandler(clozeService)
+	subscriptionRepository := db.NewSubscriptionRepository(client)
+	subscriptionService := usecase.NewSubscriptionService(subscriptionRepository)
+	cdnProvider, err := NewCDNProvider(config)
+	if err != nil {
+		return nil, err
+	}
+	cdnService := NewCDNService(config, assetRepository, cdnProvider)
+	downloadService, err := NewDownloadService(config, coreSeriesRepository, assetRepository, subscriptionService, dictionaryProvider, cdnService, assetUsageService)
+	if err != nil {
+		return nil, err
+	}
+	downloadHandler := transport.NewDownloadHandler(downloadService)
+	downloadFileHandler := transport.NewDownloadFileHandler(downloadService)
+	hlsHandler, err := NewHLSHandler(config)
+	if err != nil {
+		return nil, err
+	}
+	imageHandler, err := NewImageHandler(config)
+	if err != nil {
+		return nil, err
+	}
+	contentKeyService := usecase.NewContentKeyService(contentKeyRepository, assetRepository, coreSeriesRepository, subscriptionService)
+	contentKeyHandler := transport.NewContentKeyHandler(contentKeyService)
+	meteringRepository := db.NewMeteringRepository(client)
+	meteringService := usecase.NewMeteringService(meteringRepository)
+	meteringHandler := transport.NewMeteringHandler(meteringService)
+	shadowingRepository := db.NewShadowingRepository(client)
+	shadowingService := usecase.NewShadowingService(shadowingRepository, coreSeriesRepository, assetRepository)
+	shadowingHandler := transport.NewShadowingHandler(shadowingService)
+	playlistRepository := db.NewPlaylistRepository(client)
+	moderationRepository := db.NewModerationRepository(client)
+	v, err := NewTextClassifiers(config)
+	if err != nil {
+		return nil, err
+	}
+	moderationService := NewModerationService(config, moderationRepository, v)
+	playlistService := NewPlaylistService(playlistRepository, coreSeriesRepository, moderationService)
+	playlistHandler := transport.NewPlaylistHandler(playlistService)
+	transcriptAdminRepository := db.NewTranscriptAdminRepository(client)
+	transcriptAdminService := NewTranscriptAdminService(transcriptAdminRepository, cacheSeriesRepository)
+	transcriptAdminHandler := transport.NewTranscriptAdminHandler(transcriptAdminService)
+	watchHistoryRepository := db.NewWatchHistoryRepository(client)
+	watchHistoryService := usecase.NewWatchHistoryService(watchHistoryRepository, coreSeriesRepository)
+	watchHistoryHandler := transport.NewWatchHistoryHandler(watchHistoryService)
+	widgetService := usecase.NewWidgetService(coreSeriesRepository, watchHistoryRepository)
+	widgetSigner, err := NewWidgetSigner(config)
+	if err != nil {
+		return nil, err
+	}
+	widgetHandler := transport.NewWidgetHandler(widgetService, learnerStatsService, widgetSigner)
+	catalogService := usecase.NewCatalogService(coreSeriesRepository)
+	linkPreviewService := NewLinkPreviewService(config, catalogService, coreSeriesRepository, cdnService)
+	catalogHandler := transport.NewCatalogHandler(catalogService, linkPreviewService)
+	sitemapService := NewSitemapService(config, coreSeriesRepository)
+	sitemapHandler := NewSitemapHandler(config, sitemapService)
+	oEmbedHandler := transport.NewOEmbedHandler(linkPreviewService)
+	embedService, err := NewEmbedService(config, coreSeriesRepository, assetUsageService)
+	if err != nil {
+		return nil, err
+	}
+	embedHandler := transport.NewEmbedHandler(embedService)
+	reader := spreadsheet.NewReader()
+	catalogImportService := usecase.NewCatalogImportService(reader, seriesService, coreSeriesRepository)
+	importHandler := transport.NewImportHandler(catalogImportService)
+	feedSubscriptionRepository := db.NewFeedSubscriptionRepository(client)
+	fetcher := feed.NewFetcher()
+	transferer := feed.NewTransferer()
+	feedIngestService := usecase.NewFeedIngestService(feedSubscriptionRepository, fetcher, seriesService, assetService, transferer)
+	feedHandler := transport.NewFeedHandler(feedIngestService)
+	recommendationService := usecase.NewRecommendationService(coreSeriesRepository, watchHistoryRepository)
+	recommendationHandler := transport.NewRecommendationHandler(recommendationService)
+	searchRepository := db.NewSearchRepository(client)
+	searchIndex := NewSearchIndex(config, searchRepository)
+	searchService := NewSearchService(searchIndex, seriesRepository)
+	embeddingProvider, err := NewEmbeddingProvider(config)
+	if err != nil {
+		return nil, err
+	}
+	embeddingRepository := db.NewEmbeddingRepository(client)
+	semanticSearchService := NewSemanticSearchService(embeddingProvider, embeddingRepository, seriesRepository)
+	searchHandler := transport.NewSearchHandler(searchService, semanticSearchService)
+	engagementRepository := db.NewEngagementRepository(client)
+	jobRepository := db.NewJobRepository(client)
+	jobService := usecase.NewJobService(jobRepository)
+	analyticsService := usecase.NewAnalyticsService(engagementRepository, coreSeriesRepository, jobService)
+	analyticsHandler := transport.NewAnalyticsHandler(analyticsService)
+	subscriptionHandler := transport.NewSubscriptionHandler(subscriptionService)
+	invoiceRepository := db.NewInvoiceRepository(client)
+	billingProvider, err := NewBillingProvider(config)
+	if err != nil {
+		return nil, err
+	}
+	billingService := usecase.NewBillingService(subscriptionRepository, invoiceRepository, billingProvider)
+	billingHandler := transport.NewBillingHandler(billingService)
+	billingWebhookHandler := transport.NewBillingWebhookHandler(billingService)
+	classroomRepository := db.NewClassroomRepository(client)
+	classroomService := usecase.NewClassroomService(classroomRepository, coreSeriesRepository, watchHistoryRepository)
+	classroomHandler := transport.NewClassroomHandler(classroomService)
+	bookingRepository := db.NewBookingRepository(client)
+	bookingService := usecase.NewBookingService(bookingRepository)
+	bookingHandler := transport.NewBookingHandler(bookingService)
+	moderationHandler := transport.NewModerationHandler(moderationService)
+	episodeDraftGenerator, err := NewEpisodeDraftGenerator(config)
+	if err != nil {
+		return nil, err
+	}
+	authoringService := usecase.NewAuthoringService(episodeDraftGenerator, meteringService)
+	authoringHandler := transport.NewAuthoringHandler(authoringService)
+	ltiRepository := db.NewLTIRepository(client)
+	ltiClient, err := NewLTIClient(config)
+	if err != nil {
+		return nil, err
+	}
+	ltiService := usecase.NewLTIService(ltiRepository, coreSeriesRepository, watchHistoryRepository, ltiClient)
+	ltiHandler := transport.NewLTIHandler(ltiService)
+	ltiLaunchHandler := NewLTILaunchHandler(config, ltiService)
+	builder := lmspackage.NewBuilder()
+	packageExportService := usecase.NewPackageExportService(coreSeriesRepository, builder, subscriptionService)
+	apiKeyRepository := db.NewAPIKeyRepository(client)
+	apiKeyService := usecase.NewAPIKeyService(apiKeyRepository)
+	packageExportHandler := transport.NewPackageExportHandler(packageExportService, apiKeyService)
+	notificationRepository := db.NewNotificationRepository(client)
+	v2, err := NewNotificationSenders(config)
+	if err != nil {
+		return nil, err
+	}
+	catalog := NewMessageCatalog()
+	notificationService := NewNotificationService(config, notificationRepository, classroomRepository, coreSeriesRepository, learnerActivityRepository, v2, catalog)
+	notificationHandler := transport.NewNotificationHandler(notificationService)
+	webhookRepository := db.NewWebhookRepository(client)
+	webhookClient := webhook.NewClient()
+	webhookService := usecase.NewWebhookService(webhookRepository, webhookClient)
+	webhookHandler := transport.NewWebhookHandler(webhookService)
+	jobHandler := transport.NewJobHandler(jobService)
+	scheduledTaskRepository := db.NewScheduledTaskRepository(client)
+	analyticsExportSink, err := NewAnalyticsExportSink(config)
+	if err != nil {
+		return nil, err
+	}
+	analyticsExportService := NewAnalyticsExportService(config, engagementRepository, analyticsExportSink)
+	scheduler := NewScheduler(config, scheduledTaskRepository, assetService, notificationService, webhookService, seriesService, analyticsService, analyticsExportService, feedIngestService)
+	schedulerHandler := transport.NewSchedulerHandler(scheduler)
+	auditRepository := db.NewAuditRepository(client)
+	auditService := usecase.NewAuditService(auditRepository)
+	auditHandler := transport.NewAuditHandler(auditService)
+	apiKeyHandler := transport.NewAPIKeyHandler(apiKeyService)
+	eventRepository := db.NewEventRepository(client)
+	bus := NewEventBus(config, eventRepository, jobService, assetService, cacheSeriesRepository, sitemapService)
+	eventStreamHandler := transport.NewEventStreamHandler(bus, apiKeyService)
+	readinessHandler := NewReadinessHandler(sqlDB, uploadProvider)
+	interceptor, err := NewTracingInterceptor(tracerProvider)
+	if err != nil {
+		return nil, err
+	}
+	registry, err := NewMetricsRegistry(sqlDB, replicaDatabase, client, cacheSeriesRepository)
+	if err != nil {
+		return nil, err
+	}
+	metricsInterceptor, err := transport.NewMetricsInterceptor(registry)
+	if err != nil {
+		return nil, err
+	}
+	usageBuffer := usecase.NewUsageBuffer(meteringService)
 	validator, err := NewProtoValidator()
 	if err != nil {
 		return nil, err
 	}
-	handler := NewHTTPHandler(assetHandler, seriesHandler, validator)
-	server := NewServer(config, handler, client)
+	corsOptions := NewCORSOptions(config)
+	compressionOptions := NewCompressionOptions(config)
+	handler, err := NewHTTPHandler(assetHandler, seriesHandler, learnerStatsHandler, dictationHandler, vocabularyHandler, interactiveTranscriptHandler, quizHandler, downloadHandler, downloadFileHandler, hlsHandler, imageHandler, contentKeyHandler, meteringHandler, shadowingHandler, playlistHandler, transcriptAdminHandler, watchHistoryHandler, widgetHandler, catalogHandler, sitemapHandler, oEmbedHandler, embedHandler, importHandler, feedHandler, recommendationHandler, searchHandler, analyticsHandler, subscriptionHandler, billingHandler, billingWebhookHandler, classroomHandler, bookingHandler, moderationHandler, authoringHandler, ltiHandler, ltiLaunchHandler, packageExportHandler, notificationHandler, webhookHandler, jobHandler, schedulerHandler, auditHandler, apiKeyHandler, eventStreamHandler, readinessHandler, interceptor, metricsInterceptor, registry, usageBuffer, subscriptionService, cdnService, apiKeyService, validator, catalog, corsOptions, compressionOptions)
+	if err != nil {
+		return nil, err
+	}
+	outboxRepository := db.NewOutboxRepository(client)
+	outboxRelay := usecase.NewOutboxRelay(outboxRepository, bus)
+	transcriptAligner, err := NewTranscriptAligner(config)
+	if err != nil {
+		return nil, err
+	}
+	transcriptAlignmentService := usecase.NewTranscriptAlignmentService(seriesService, transcriptAligner)
+	difficultyService := usecase.NewDifficultyService(coreSeriesRepository)
+	imageProcessor, err := NewImageProcessor(config)
+	if err != nil {
+		return nil, err
+	}
+	imageService := usecase.NewImageService(assetRepository, imageProcessor)
+	jobWorker := NewJobWorker(config, jobRepository, notificationService, webhookService, searchService, semanticSearchService, analyticsService, transcriptAlignmentService, difficultyService, clozeService, audioPackagingService, imageService, cdnService, seriesService)
+	server := NewServer(config, handler, client, outboxRelay, jobWorker, scheduler, usageBuffer, bus, assetService, tracerProvider)
 	return server, nil
 }
+
+// InitializeWorker sets up the background job worker and scheduler with all dependencies wired.
+func InitializeWorker() (*Worker, error) {
+	config, err := NewConfig()
+	if err != nil {
+		return nil, err
+	}
+	sqlDB, err := NewDatabase(config)
+	if err != nil {
+		return nil, err
+	}
+	replicaDatabase, err := NewReplicaDatabase(config)
+	if err != nil {
+		return nil, err
+	}
+	txManager := NewTxManager(config, sqlDB, replicaDatabase)
+	migrator := NewMigrator(sqlDB)
+	tracerProvider, err := NewTracerProvider(config)
+	if err != nil {
+		return nil, err
+	}
+	client, err := NewEntClient(config, txManager, migrator, tracerProvider)
+	if err != nil {
+		return nil, err
+	}
+	jobRepository := db.NewJobRepository(client)
+	notificationRepository := db.NewNotificationRepository(client)
+	classroomRepository := db.NewClassroomRepository(client)
+	seriesRepository := NewDBSeriesRepository(config, client)
+	store, err := NewCacheStore(config)
+	if err != nil {
+		return nil, err
+	}
+	cacheSeriesRepository := NewSeriesCache(config, seriesRepository, store)
+	coreSeriesRepository := NewSeriesRepository(seriesRepository, cacheSeriesRepository)
+	learnerActivityRepository := db.NewLearnerActivityRepository(client)
+	v, err := NewNotificationSenders(config)
+	if err != nil {
+		return nil, err
+	}
+	catalog := NewMessageCatalog()
+	notificationService := NewNotificationService(config, notificationRepository, classroomRepository, coreSeriesRepository, learnerActivityRepository, v, catalog)
+	webhookRepository := db.NewWebhookRepository(client)
+	webhookClient := webhook.NewClient()
+	webhookService := usecase.NewWebhookService(webhookRepository, webhookClient)
+	searchRepository := db.NewSearchRepository(client)
+	searchIndex := NewSearchIndex(config, searchRepository)
+	searchService := NewSearchService(searchIndex, seriesRepository)
+	embeddingProvider, err := NewEmbeddingProvider(config)
+	if err != nil {
+		return nil, err
+	}
+	embeddingRepository := db.NewEmbeddingRepository(client)
+	semanticSearchService := NewSemanticSearchService(embeddingProvider, embeddingRepository, seriesRepository)
+	engagementRepository := db.NewEngagementRepository(client)
+	jobService := usecase.NewJobService(jobRepository)
+	analyticsService := usecase.NewAnalyticsService(engagementRepository, coreSeriesRepository, jobService)
+	assetRepository := db.NewAssetRepository(client)
+	seriesService, err := NewSeriesService(config, coreSeriesRepository, assetRepository)
+	if err != nil {
+		return nil, err
+	}
+	transcriptAligner, err := NewTranscriptAligner(config)
+	if err != nil {
+		return nil, err
+	}
+	transcriptAlignmentService := usecase.NewTranscriptAlignmentService(seriesService, transcriptAligner)
+	difficultyService := usecase.NewDifficultyService(coreSeriesRepository)
+	quizRepository := db.NewQuizRepository(client)
+	clozeService := usecase.NewClozeService(coreSeriesRepository, quizRepository)
+	contentKeyRepository := db.NewContentKeyRepository(client)
+	audioPackager, err := NewAudioPackager(config)
+	if err != nil {
+		return nil, err
+	}
+	audioPackagingService := NewAudioPackagingService(config, assetRepository, coreSeriesRepository, contentKeyRepository, audioPackager)
+	imageProcessor, err := NewImageProcessor(config)
+	if err != nil {
+		return nil, err
+	}
+	imageService := usecase.NewImageService(assetRepository, imageProcessor)
+	cdnProvider, err := NewCDNProvider(config)
+	if err != nil {
+		return nil, err
+	}
+	cdnService := NewCDNService(config, assetRepository, cdnProvider)
+	jobWorker := NewJobWorker(config, jobRepository, notificationService, webhookService, searchService, semanticSearchService, analyticsService, transcriptAlignmentService, difficultyService, clozeService, audioPackagingService, imageService, cdnService, seriesService)
+	scheduledTaskRepository := db.NewScheduledTaskRepository(client)
+	uploadProvider, err := NewUploadProvider(config)
+	if err != nil {
+		return nil, err
+	}
+	assetService := NewAssetService(assetRepository, uploadProvider, txManager)
+	analyticsExportSink, err := NewAnalyticsExportSink(config)
+	if err != nil {
+		return nil, err
+	}
+	analyticsExportService := NewAnalyticsExportService(config, engagementRepository, analyticsExportSink)
+	feedSubscriptionRepository := db.NewFeedSubscriptionRepository(client)
+	fetcher := feed.NewFetcher()
+	transferer := feed.NewTransferer()
+	feedIngestService := usecase.NewFeedIngestService(feedSubscriptionRepository, fetcher, seriesService, assetService, transferer)
+	scheduler := NewScheduler(config, scheduledTaskRepository, assetService, notificationService, webhookService, seriesService, analyticsService, analyticsExportService, feedIngestService)
+	worker := NewWorker(config, client, jobWorker, scheduler, tracerProvider)
+	return worker, nil
+}
+
+// InitializeAdmin sets up the catalog handlers, seeding, backups, search
+// indexing and analytics exports the admin CLI uses in direct mode.
+func InitializeAdmin() (*Admin, error) {
+	config, err := NewConfig()
+	if err != nil {
+		return nil, err
+	}
+	sqlDB, err := NewDatabase(config)
+	if err != nil {
+		return nil, err
+	}
+	replicaDatabase, err := NewReplicaDatabase(config)
+	if err != nil {
+		return nil, err
+	}
+	txManager := NewTxManager(config, sqlDB, replicaDatabase)
+	migrator := NewMigrator(sqlDB)
+	tracerProvider, err := NewTracerProvider(config)
+	if err != nil {
+		return nil, err
+	}
+	client, err := NewEntClient(config, txManager, migrator, tracerProvider)
+	if err != nil {
+		return nil, err
+	}
+	seriesRepository := NewDBSeriesRepository(config, client)
+	store, err := NewCacheStore(config)
+	if err != nil {
+		return nil, err
+	}
+	cacheSeriesRepository := NewSeriesCache(config, seriesRepository, store)
+	coreSeriesRepository := NewSeriesRepository(seriesRepository, cacheSeriesRepository)
+	assetRepository := db.NewAssetRepository(client)
+	seriesService, err := NewSeriesService(config, coreSeriesRepository, assetRepository)
+	if err != nil {
+		return nil, err
+	}
+	seriesHandler := transport.NewSeriesHandler(seriesService)
+	uploadProvider, err := NewUploadProvider(config)
+	if err != nil {
+		return nil, err
+	}
+	assetService := NewAssetService(assetRepository, uploadProvider, txManager)
+	contentKeyRepository := db.NewContentKeyRepository(client)
+	audioPackager, err := NewAudioPackager(config)
+	if err != nil {
+		return nil, err
+	}
+	audioPackagingService := NewAudioPackagingService(config, assetRepository, coreSeriesRepository, contentKeyRepository, audioPackager)
+	assetUsageRepository := db.NewAssetUsageRepository(client)
+	assetUsageService := usecase.NewAssetUsageService(assetUsageRepository, assetRepository)
+	assetHandler := transport.NewAssetHandler(assetService, audioPackagingService, assetUsageService)
+	builder := lmspackage.NewBuilder()
+	subscriptionRepository := db.NewSubscriptionRepository(client)
+	subscriptionService := usecase.NewSubscriptionService(subscriptionRepository)
+	packageExportService := usecase.NewPackageExportService(coreSeriesRepository, builder, subscriptionService)
+	seedService := usecase.NewSeedService(assetRepository, seriesService, subscriptionService)
+	backupRepository := NewBackupRepository(txManager)
+	backupService := NewBackupService(backupRepository, assetRepository, txManager)
+	searchRepository := db.NewSearchRepository(client)
+	searchIndex := NewSearchIndex(config, searchRepository)
+	searchService := NewSearchService(searchIndex, seriesRepository)
+	embeddingProvider, err := NewEmbeddingProvider(config)
+	if err != nil {
+		return nil, err
+	}
+	embeddingRepository := db.NewEmbeddingRepository(client)
+	semanticSearchService := NewSemanticSearchService(embeddingProvider, embeddingRepository, seriesRepository)
+	engagementRepository := db.NewEngagementRepository(client)
+	analyticsExportSink, err := NewAnalyticsExportSink(config)
+	if err != nil {
+		return nil, err
+	}
+	analyticsExportService := NewAnalyticsExportService(config, engagementRepository, analyticsExportSink)
+	admin := NewAdmin(seriesHandler, assetHandler, packageExportService, seedService, backupService, searchService, semanticSearchService, analyticsExportService, client, tracerProvider)
+	return admin, nil
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+	"sync"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	entgenerated "github.com/eslsoft/lession/internal/adapter/db/ent/generated"
+	"github.com/eslsoft/lession/internal/config"
+	"github.com/eslsoft/lession/internal/usecase"
+)
+
+// Worker runs background jobs and scheduled tasks outside the HTTP server.
+type Worker struct {
+	cfg       config.Config
+	entClient *entgenerated.Client
+	debug     *debugServer
+	jobs      *usecase.JobWorker
+	scheduler *usecase.Scheduler
+	tracing   *sdktrace.TracerProvider
+}
+
+// NewWorker constructs a Worker from the provided dependencies.
+func NewWorker(cfg config.Config, entClient *entgenerated.Client, jobs *usecase.JobWorker, scheduler *usecase.Scheduler, tracing *sdktrace.TracerProvider) *Worker {
+	return &Worker{
+		cfg:       cfg,
+		entClient: entClient,
+		debug:     newDebugServer(cfg),
+		jobs:      jobs,
+		scheduler: scheduler,
+		tracing:   tracing,
+	}
+}
+
+// Run processes jobs and scheduled tasks until the context is cancelled,
+// then gives running jobs and tasks up to the configured drain timeout to
+// record their outcome.
+func (w *Worker) Run(ctx context.Context) error {
+	if w.debug != nil {
+		if err := w.debug.start(ctx); err != nil {
+			return err
+		}
+	}
+
+	var wg sync.WaitGroup
+	wg.Go(func() { w.jobs.Run(ctx, w.cfg.JobPollInterval) })
+	wg.Go(func() { w.scheduler.Run(ctx, w.cfg.SchedulerPollInterval) })
+	<-ctx.Done()
+
+	drainCtx, cancel := context.WithTimeout(context.Background(), w.cfg.ShutdownTimeout)
+	defer cancel()
+	waitWithin(drainCtx, &wg)
+
+	// Flush the spans of the last jobs before exiting.
+	_ = w.tracing.Shutdown(drainCtx)
+	return w.entClient.Close()
+}
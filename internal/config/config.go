@@ -3,31 +3,914 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
 )
 
 // Config captures the runtime configuration for the service.
 type Config struct {
 	HTTPAddress string
+	// TLSCertFile and TLSKeyFile are the PEM certificate chain and key the
+	// server terminates TLS with; the server speaks plain HTTP when unset.
+	TLSCertFile string
+	TLSKeyFile  string
+	// ACMEDomains lists the host names to obtain certificates for from Let's
+	// Encrypt, using the TLS-ALPN challenge on the HTTP address. It is an
+	// alternative to TLSCertFile and TLSKeyFile.
+	ACMEDomains []string
+	// ACMECacheDir is where ACME certificates and the account key are kept.
+	ACMECacheDir string
+	// ACMEEmail is the contact address registered with the ACME account.
+	ACMEEmail string
+	// H2C serves HTTP/2 without TLS, which gRPC clients, health probes and
+	// grpcurl need on plaintext listeners.
+	H2C bool
+	// HTTPReadHeaderTimeout bounds how long a client may take to send the
+	// request headers.
+	HTTPReadHeaderTimeout time.Duration
+	// HTTPReadTimeout and HTTPWriteTimeout bound reading a whole request and
+	// writing its response; zero disables them. A write timeout also ends
+	// streaming RPCs, while the event stream is exempt.
+	HTTPReadTimeout  time.Duration
+	HTTPWriteTimeout time.Duration
+	// HTTPIdleTimeout is how long an idle keep-alive connection stays open.
+	HTTPIdleTimeout time.Duration
+	// HTTPMaxHeaderBytes caps the size of request headers.
+	HTTPMaxHeaderBytes int
+	// ShutdownTimeout bounds how long in-flight requests, jobs and scheduled
+	// tasks may take to finish once shutdown starts.
+	ShutdownTimeout time.Duration
+	// DebugAddress is where pprof profiles, expvar variables and build
+	// information are served, apart from the API; nothing is served when
+	// empty. It should only be reachable from inside the deployment.
+	DebugAddress string
+
+	// DatabaseURL locates the database: a postgres:// URL or key/value
+	// connection string, a mysql:// URL or a sqlite:// file path.
 	DatabaseURL string
+	// DatabaseDialect is the Ent dialect detected from DatabaseURL.
+	DatabaseDialect string
+	// DatabaseReplicaURL is a read-only replica of the database that Get and
+	// List requests read from; everything reads the primary when empty.
+	DatabaseReplicaURL string
+	// DatabaseQueryTimeout bounds every query and write the repositories
+	// issue; zero leaves them bounded by the request alone.
+	DatabaseQueryTimeout time.Duration
+	// DatabaseStatementTimeout is set as the statement_timeout of every
+	// PostgreSQL session, a server-side backstop that also applies to
+	// migrations, or as the max_execution_time of MySQL sessions, which
+	// bounds reads only; zero keeps the server's setting.
+	DatabaseStatementTimeout time.Duration
+	// DatabaseMaxOpenConns caps the connections of each pool, the primary's
+	// and the replica's.
+	DatabaseMaxOpenConns int
+	// DatabaseMaxIdleConns caps the idle connections kept in each pool.
+	DatabaseMaxIdleConns int
+	// DatabaseConnMaxLifetime is how long a connection is reused before it
+	// is closed; zero reuses connections forever.
+	DatabaseConnMaxLifetime time.Duration
+	// DatabaseConnMaxIdleTime is how long a connection may sit idle before
+	// it is closed; zero keeps idle connections.
+	DatabaseConnMaxIdleTime time.Duration
+	// DatabaseConnectTimeout bounds establishing a new connection, rounded
+	// up to whole seconds; zero waits indefinitely.
+	DatabaseConnectTimeout time.Duration
+	// DatabaseTranscriptOffloadBytes is the size above which episode
+	// transcripts are stored in a table of their own, read only when
+	// transcripts are requested; zero keeps every transcript in the episodes
+	// table.
+	DatabaseTranscriptOffloadBytes int
+	// AutoMigrate applies pending database migrations when a process starts.
+	// Production deployments disable it and run `lession migrate up` instead.
+	AutoMigrate bool
+
+	UploadProvider         string
+	UploadFallbackProvider string
+	// WidgetSigningKey is the base64-encoded Ed25519 seed used to sign public
+	// widget responses. A random key is generated per process when empty.
+	WidgetSigningKey string
+	// BillingProvider names the payment processor used for checkout; billing
+	// is disabled when empty.
+	BillingProvider     string
+	StripeSecretKey     string
+	StripeWebhookSecret string
+	// AuthoringProvider names the language model service that drafts
+	// lessons; drafting is disabled when empty.
+	AuthoringProvider string
+	OpenAIAPIKey      string
+	// OpenAIModel defaults to the provider's own default when empty.
+	OpenAIModel string
+	// OpenAIBaseURL points the provider at an OpenAI-compatible API.
+	OpenAIBaseURL string
+	// LTIToolURL is the public base URL LMS platforms reach the tool at.
+	LTIToolURL string
+	// LTIPrivateKey is the PEM-encoded RSA key the tool signs LTI messages
+	// with. A random key is generated per process when empty.
+	LTIPrivateKey string
+	// LTIPlayerURL is the web player learners are sent to after a launch.
+	LTIPlayerURL string
+	// DownloadBaseURL is the public base URL the files of offline download
+	// bundles are served under.
+	DownloadBaseURL string
+	// DownloadSigningKey is the base64-encoded HMAC key download URLs are
+	// signed with. A random key is generated per process when empty.
+	DownloadSigningKey string
+	// DownloadURLTTL is how long download URLs work.
+	DownloadURLTTL time.Duration
+	// SMTPAddress is the host:port of the SMTP relay used for email
+	// notifications, e.g. an Amazon SES SMTP endpoint; email is disabled
+	// when empty.
+	SMTPAddress           string
+	SMTPUsername          string
+	SMTPPassword          string
+	NotificationEmailFrom string
+	// FCMCredentialsFile is the path of the Firebase service account key used
+	// for push notifications; push is disabled when empty.
+	FCMCredentialsFile string
+	// SlackWebhookURL is the Slack incoming webhook team notifications are
+	// posted to; Slack is disabled when empty.
+	SlackWebhookURL string
+	// SlackNotificationKinds lists the team notifications posted to Slack,
+	// out of episode_published, asset_failed and review_requested; every
+	// kind is posted when empty.
+	SlackNotificationKinds []string
+	// DiscordWebhookURL is the Discord channel webhook team notifications
+	// are posted to; Discord is disabled when empty.
+	DiscordWebhookURL string
+	// DiscordNotificationKinds lists the team notifications posted to
+	// Discord, like SlackNotificationKinds.
+	DiscordNotificationKinds []string
+	// NotificationReminderInterval is how often assignment and streak
+	// reminders are swept; zero disables them.
+	NotificationReminderInterval time.Duration
+	// WebhookRetryInterval is how often failed webhook deliveries are
+	// retried; zero disables retries.
+	WebhookRetryInterval time.Duration
+	// UploadExpiryInterval is how often upload sessions past their expiry
+	// are closed; zero disables the sweep.
+	UploadExpiryInterval time.Duration
+	// AssetGCInterval is how often archived assets are purged; zero disables
+	// the purge.
+	AssetGCInterval time.Duration
+	// AssetGCRetention is how long an archived asset is kept before it is
+	// purged.
+	AssetGCRetention time.Duration
+	// EpisodeCountReconcileInterval is how often stored series episode counts
+	// are checked against the episodes; zero disables the check.
+	EpisodeCountReconcileInterval time.Duration
+	// EngagementRollupInterval is how often recent playback sessions are
+	// rolled up into engagement analytics; zero disables the rollups.
+	EngagementRollupInterval time.Duration
+	// SchedulerPollInterval is how often a worker checks for due scheduled
+	// tasks.
+	SchedulerPollInterval time.Duration
+	// OutboxRelayInterval is how often committed outbox messages are relayed
+	// to event subscribers; zero disables the relay.
+	OutboxRelayInterval time.Duration
+	// UsageFlushInterval is how often API calls counted for usage metering
+	// are recorded.
+	UsageFlushInterval time.Duration
+	// JobWorkerConcurrency is how many background jobs a worker runs at once.
+	JobWorkerConcurrency int
+	// JobPollInterval is how often an idle worker checks for due jobs.
+	JobPollInterval time.Duration
+	// EmbeddedWorker runs a job worker and scheduler inside the HTTP server, so a separate
+	// `lession worker` process is optional.
+	EmbeddedWorker bool
+	// OTLPTracing exports OpenTelemetry traces over OTLP/HTTP. It is enabled
+	// by setting OTEL_EXPORTER_OTLP_ENDPOINT or
+	// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT; the exporter reads its remaining
+	// settings from the standard OTEL_* variables.
+	OTLPTracing bool
+	// CORSAllowedOrigins lists the browser origins allowed to call the API;
+	// "*" allows any origin and "https://*.example.com" any subdomain. CORS
+	// is disabled when empty.
+	CORSAllowedOrigins []string
+	// CORSAllowedHeaders lists extra request headers browsers may send.
+	CORSAllowedHeaders []string
+	// CORSMaxAge is how long browsers may cache preflight responses.
+	CORSMaxAge time.Duration
+	// RPCCompression lists the compression algorithms RPC clients may use,
+	// "gzip" and "zstd"; messages are sent uncompressed when empty.
+	RPCCompression []string
+	// RPCCompressMinBytes is the smallest RPC response that is compressed.
+	RPCCompressMinBytes int
+	// PersistEvents appends every domain event to the events table in
+	// addition to dispatching it in process.
+	PersistEvents bool
+
+	// CacheStore names where series reads are cached: "memory" for an
+	// in-process LRU on single-node deployments, "redis" for a server shared
+	// by every replica, or empty to always read the database. It defaults to
+	// redis when RedisURL is set.
+	CacheStore string
+	// RedisURL is the Redis server of the redis cache store, as a redis://
+	// or rediss:// URL.
+	RedisURL string
+	// CacheSize caps the entries of the memory cache store.
+	CacheSize int
+	// CacheTTL is how long cached series live. It bounds how stale a read
+	// can be after a write that bypasses the cache's invalidation.
+	CacheTTL time.Duration
+	// CacheListTTL is how long cached series listings live.
+	CacheListTTL time.Duration
+
+	// SearchEngine names the engine SearchContent queries: "meilisearch" or
+	// "elasticsearch", kept in sync from domain events, or empty to search
+	// the database with PostgreSQL full-text search.
+	SearchEngine string
+	// SearchURL is the base URL of the search engine.
+	SearchURL string
+	// SearchAPIKey authenticates with the search engine: the Meilisearch
+	// API key or an Elasticsearch API key.
+	SearchAPIKey string
+	// SearchIndex is the index content is stored in.
+	SearchIndex string
+	// EmbeddingProvider names the embedding model service SemanticSearch and
+	// FindSimilarEpisodes use, or is empty to disable semantic search. The
+	// openai provider shares the OPENAI_* credentials with authoring.
+	EmbeddingProvider string
+	// EmbeddingModel defaults to the provider's own default when empty.
+	EmbeddingModel string
+
+	// AnalyticsExportSink names the warehouse engagement analytics are
+	// exported to: "bigquery" or "s3" for Parquet files; nothing is exported
+	// when empty.
+	AnalyticsExportSink string
+	// AnalyticsExportInterval is how often the days the rollups recompute
+	// are exported; zero disables the export.
+	AnalyticsExportInterval time.Duration
+	// AnalyticsExportPlaybackSessions also exports the raw playback sessions
+	// the rollups are computed from.
+	AnalyticsExportPlaybackSessions bool
+	// BigQueryCredentialsFile is the service account key file the bigquery
+	// sink authenticates with.
+	BigQueryCredentialsFile string
+	// BigQueryProject is the project of the dataset, the service account's
+	// when empty.
+	BigQueryProject string
+	// BigQueryDataset is the dataset the tables are loaded into.
+	BigQueryDataset string
+	// AnalyticsS3Bucket, AnalyticsS3Region and AnalyticsS3Prefix locate the
+	// Parquet files of the s3 sink.
+	AnalyticsS3Bucket string
+	AnalyticsS3Region string
+	AnalyticsS3Prefix string
+	// AnalyticsS3Endpoint is the URL of an S3-compatible store; AWS when
+	// empty.
+	AnalyticsS3Endpoint string
+	// AnalyticsS3AccessKeyID and AnalyticsS3SecretAccessKey sign the s3
+	// sink's requests.
+	AnalyticsS3AccessKeyID     string
+	AnalyticsS3SecretAccessKey string
+
+	// ModerationBlockedWords lists words and phrases that hold
+	// user-generated text for review.
+	ModerationBlockedWords []string
+	// ModerationWordlistFile is a file of further blocked words, one per line.
+	ModerationWordlistFile string
+	// PerspectiveAPIKey enables toxicity screening with the Perspective API.
+	PerspectiveAPIKey string
+	// ModerationToxicityThreshold is the Perspective score, from 0 to 1, at
+	// which text is held for review.
+	ModerationToxicityThreshold float64
+	// ModerationRequireReview holds all user-generated text for review,
+	// not only text the filters flag.
+	ModerationRequireReview bool
+
+	// TranscriptSanitizeMode enables the transcript sanitization pass:
+	// "flag" records blocked words and personal data for editors, "mask"
+	// also masks them when episodes are published; off when empty.
+	TranscriptSanitizeMode string
+	// TranscriptPIIDetectors lists the kinds of personal data the pass
+	// looks for: email, phone and card_number.
+	TranscriptPIIDetectors []string
+	// PublishRules lists the checks series and episodes must pass when they
+	// are published: cover, summary, ready_episode and transcripts.
+	PublishRules []string
+	// PublishMinSummaryLength is the number of characters the summary rule
+	// requires of series summaries.
+	PublishMinSummaryLength int
+	// TranscriptAligner names the forced aligner that times plain
+	// transcripts against episode media; alignment is off when empty.
+	TranscriptAligner string
+	// AeneasPython is the Python interpreter with aeneas installed.
+	AeneasPython string
+	// DictionaryProvider names the dictionary interactive transcripts look
+	// words up in; they are off when empty.
+	DictionaryProvider string
+	// DictionaryFile is the dictionary the jsonfile provider loads.
+	DictionaryFile string
+	// AudioPackager names the tool that segments MP3 and AAC uploads into
+	// multi-bitrate HLS; audio is served as uploaded when empty.
+	AudioPackager string
+	// FFmpegPath is the ffmpeg binary the ffmpeg packager runs.
+	FFmpegPath string
+	// HLSOutputDir is where packaged renditions are written. The worker
+	// writes it and the server serves it, so they must share it.
+	HLSOutputDir string
+	// HLSBaseURL is the public URL HLSOutputDir is served at.
+	HLSBaseURL string
+	// HLSAudioBitrates lists the bitrates, in kbit/s, of the audio variants.
+	HLSAudioBitrates []int
+	// DRMKeyURL is the public URL of the content key endpoint written into
+	// the playlists of series with DRM enabled.
+	DRMKeyURL string
+	// ImageProcessor names the processor that validates image uploads and
+	// resizes them into variants; images are not processed when empty.
+	ImageProcessor string
+	// ImageOutputDir is where image variants are written. The worker writes
+	// it and the server serves it, so they must share it.
+	ImageOutputDir string
+	// ImageBaseURL is the public URL ImageOutputDir is served at.
+	ImageBaseURL string
+	// CDNProvider names the CDN media is served through and purged from;
+	// media is served from its origin when empty.
+	CDNProvider string
+	// CDNBaseURL is the CDN URL media URLs under CDNOrigins are rewritten to.
+	CDNBaseURL string
+	// CDNOrigins lists the URL prefixes of media the CDN fronts, HLSBaseURL
+	// and ImageBaseURL by default.
+	CDNOrigins []string
+	// CloudFrontDistributionID, CDNAWSAccessKeyID and CDNAWSSecretAccessKey
+	// configure the cloudfront provider.
+	CloudFrontDistributionID string
+	CDNAWSAccessKeyID        string
+	CDNAWSSecretAccessKey    string
+	// CloudflareZoneID and CloudflareAPIToken configure the cloudflare
+	// provider; the token needs the Cache Purge permission.
+	CloudflareZoneID   string
+	CloudflareAPIToken string
+	// SitemapSeriesURL is the page URL of a series listed in /sitemap.xml
+	// and recognized by /oembed, with {series_id} and {series_slug}
+	// placeholders. The sitemap is disabled when empty.
+	SitemapSeriesURL string
+	// SitemapEpisodeURL is the page URL of an episode, which may also use
+	// {episode_id} and {episode_seq}. Episodes are not listed when empty.
+	SitemapEpisodeURL string
+	// SitemapMaxAge bounds how long a generated sitemap is served before it
+	// is regenerated; publishing events regenerate it sooner.
+	SitemapMaxAge time.Duration
+	// EmbedPlayerURL is the player page third-party sites embed, with the
+	// placeholders of SitemapEpisodeURL; the episode placeholders are empty
+	// when a series is embedded. Link previews carry no player when empty.
+	EmbedPlayerURL string
+	// EmbedTokenSigningKey is the base64-encoded HMAC key embed tokens are
+	// signed with. A random key is generated per process when empty.
+	EmbedTokenSigningKey string
+	// EmbedTokenMaxTTL is the longest lifetime an embed token can be issued with.
+	EmbedTokenMaxTTL time.Duration
+	// FeedSyncInterval is how often series ingested from YouTube playlists
+	// and podcast feeds are checked for new episodes; zero disables syncing.
+	FeedSyncInterval time.Duration
 }
 
-// Load reads configuration from the environment with sensible defaults.
+// FileEnv names the environment variable holding the path of the
+// configuration file.
+const FileEnv = "LESSION_CONFIG"
+
+// Load reads configuration from the file named by FileEnv, if any, and the
+// environment.
 func Load() (Config, error) {
+	return LoadFile(os.Getenv(FileEnv))
+}
+
+// LoadFile reads configuration from a YAML or TOML file and the environment
+// with sensible defaults. Environment variables take precedence over the
+// file; an empty path reads the environment alone.
+func LoadFile(path string) (Config, error) {
+	getenv, err := fileLookup(path)
+	if err != nil {
+		return Config{}, err
+	}
+
 	cfg := Config{
-		HTTPAddress: valueOrDefault(os.Getenv("HTTP_ADDRESS"), ":8080"),
-		DatabaseURL: valueOrDefault(os.Getenv("DATABASE_URL"), ""),
+		HTTPAddress:  valueOrDefault(getenv("HTTP_ADDRESS"), ":8080"),
+		TLSCertFile:  getenv("TLS_CERT_FILE"),
+		TLSKeyFile:   getenv("TLS_KEY_FILE"),
+		ACMEDomains:  splitList(getenv("ACME_DOMAINS")),
+		ACMECacheDir: valueOrDefault(getenv("ACME_CACHE_DIR"), "acme-cache"),
+		ACMEEmail:    getenv("ACME_EMAIL"),
+		DebugAddress: getenv("DEBUG_ADDRESS"),
+		DatabaseURL:  valueOrDefault(getenv("DATABASE_URL"), ""),
+
+		DatabaseReplicaURL: getenv("DATABASE_REPLICA_URL"),
+
+		UploadProvider:         valueOrDefault(getenv("UPLOAD_PROVIDER"), "fake"),
+		UploadFallbackProvider: getenv("UPLOAD_FALLBACK_PROVIDER"),
+
+		WidgetSigningKey: getenv("WIDGET_SIGNING_KEY"),
+
+		BillingProvider:     getenv("BILLING_PROVIDER"),
+		StripeSecretKey:     getenv("STRIPE_SECRET_KEY"),
+		StripeWebhookSecret: getenv("STRIPE_WEBHOOK_SECRET"),
+
+		AuthoringProvider: getenv("AUTHORING_PROVIDER"),
+		OpenAIAPIKey:      getenv("OPENAI_API_KEY"),
+		OpenAIModel:       getenv("OPENAI_MODEL"),
+		OpenAIBaseURL:     getenv("OPENAI_BASE_URL"),
+
+		LTIToolURL:    valueOrDefault(getenv("LTI_TOOL_URL"), "http://localhost:8080"),
+		LTIPrivateKey: getenv("LTI_PRIVATE_KEY"),
+		LTIPlayerURL:  valueOrDefault(getenv("LTI_PLAYER_URL"), "http://localhost:3000"),
+
+		DownloadBaseURL:    valueOrDefault(getenv("DOWNLOAD_BASE_URL"), "http://localhost:8080"),
+		DownloadSigningKey: getenv("DOWNLOAD_SIGNING_KEY"),
+
+		SMTPAddress:           getenv("SMTP_ADDRESS"),
+		SMTPUsername:          getenv("SMTP_USERNAME"),
+		SMTPPassword:          getenv("SMTP_PASSWORD"),
+		NotificationEmailFrom: valueOrDefault(getenv("NOTIFICATION_EMAIL_FROM"), "Lession <noreply@localhost>"),
+		FCMCredentialsFile:    getenv("FCM_CREDENTIALS_FILE"),
+
+		SlackWebhookURL:          getenv("SLACK_WEBHOOK_URL"),
+		SlackNotificationKinds:   splitList(getenv("SLACK_NOTIFICATION_KINDS")),
+		DiscordWebhookURL:        getenv("DISCORD_WEBHOOK_URL"),
+		DiscordNotificationKinds: splitList(getenv("DISCORD_NOTIFICATION_KINDS")),
+	}
+
+	if (cfg.TLSCertFile == "") != (cfg.TLSKeyFile == "") {
+		return cfg, fmt.Errorf("TLS_CERT_FILE and TLS_KEY_FILE must be provided together")
+	}
+	if cfg.TLSCertFile != "" && len(cfg.ACMEDomains) > 0 {
+		return cfg, fmt.Errorf("ACME_DOMAINS cannot be combined with TLS_CERT_FILE")
+	}
+
+	h2c, err := strconv.ParseBool(valueOrDefault(getenv("HTTP_H2C"), "true"))
+	if err != nil {
+		return cfg, fmt.Errorf("HTTP_H2C must be a boolean")
+	}
+	cfg.H2C = h2c
+
+	readHeaderTimeout, err := time.ParseDuration(valueOrDefault(getenv("HTTP_READ_HEADER_TIMEOUT"), "10s"))
+	if err != nil || readHeaderTimeout < 0 {
+		return cfg, fmt.Errorf("HTTP_READ_HEADER_TIMEOUT must be a non-negative duration")
+	}
+	cfg.HTTPReadHeaderTimeout = readHeaderTimeout
+
+	readTimeout, err := time.ParseDuration(valueOrDefault(getenv("HTTP_READ_TIMEOUT"), "0s"))
+	if err != nil || readTimeout < 0 {
+		return cfg, fmt.Errorf("HTTP_READ_TIMEOUT must be a non-negative duration")
+	}
+	cfg.HTTPReadTimeout = readTimeout
+
+	writeTimeout, err := time.ParseDuration(valueOrDefault(getenv("HTTP_WRITE_TIMEOUT"), "0s"))
+	if err != nil || writeTimeout < 0 {
+		return cfg, fmt.Errorf("HTTP_WRITE_TIMEOUT must be a non-negative duration")
+	}
+	cfg.HTTPWriteTimeout = writeTimeout
+
+	idleTimeout, err := time.ParseDuration(valueOrDefault(getenv("HTTP_IDLE_TIMEOUT"), "2m"))
+	if err != nil || idleTimeout < 0 {
+		return cfg, fmt.Errorf("HTTP_IDLE_TIMEOUT must be a non-negative duration")
+	}
+	cfg.HTTPIdleTimeout = idleTimeout
+
+	maxHeaderBytes, err := strconv.Atoi(valueOrDefault(getenv("HTTP_MAX_HEADER_BYTES"), "1048576"))
+	if err != nil || maxHeaderBytes <= 0 {
+		return cfg, fmt.Errorf("HTTP_MAX_HEADER_BYTES must be a positive integer")
+	}
+	cfg.HTTPMaxHeaderBytes = maxHeaderBytes
+
+	shutdownTimeout, err := time.ParseDuration(valueOrDefault(getenv("SHUTDOWN_TIMEOUT"), "30s"))
+	if err != nil || shutdownTimeout <= 0 {
+		return cfg, fmt.Errorf("SHUTDOWN_TIMEOUT must be a positive duration")
+	}
+	cfg.ShutdownTimeout = shutdownTimeout
+
+	transcriptOffloadBytes, err := strconv.Atoi(valueOrDefault(getenv("DATABASE_TRANSCRIPT_OFFLOAD_BYTES"), "0"))
+	if err != nil || transcriptOffloadBytes < 0 {
+		return cfg, fmt.Errorf("DATABASE_TRANSCRIPT_OFFLOAD_BYTES must be a non-negative integer")
+	}
+	cfg.DatabaseTranscriptOffloadBytes = transcriptOffloadBytes
+
+	autoMigrate, err := strconv.ParseBool(valueOrDefault(getenv("AUTO_MIGRATE"), "true"))
+	if err != nil {
+		return cfg, fmt.Errorf("AUTO_MIGRATE must be a boolean")
+	}
+	cfg.AutoMigrate = autoMigrate
+
+	queryTimeout, err := time.ParseDuration(valueOrDefault(getenv("DATABASE_QUERY_TIMEOUT"), "30s"))
+	if err != nil || queryTimeout < 0 {
+		return cfg, fmt.Errorf("DATABASE_QUERY_TIMEOUT must be a non-negative duration")
+	}
+	cfg.DatabaseQueryTimeout = queryTimeout
+
+	statementTimeout, err := time.ParseDuration(valueOrDefault(getenv("DATABASE_STATEMENT_TIMEOUT"), "0"))
+	if err != nil || statementTimeout < 0 {
+		return cfg, fmt.Errorf("DATABASE_STATEMENT_TIMEOUT must be a non-negative duration")
+	}
+	cfg.DatabaseStatementTimeout = statementTimeout
+
+	maxOpenConns, err := strconv.Atoi(valueOrDefault(getenv("DATABASE_MAX_OPEN_CONNS"), "25"))
+	if err != nil || maxOpenConns <= 0 {
+		return cfg, fmt.Errorf("DATABASE_MAX_OPEN_CONNS must be a positive integer")
+	}
+	cfg.DatabaseMaxOpenConns = maxOpenConns
+
+	maxIdleConns, err := strconv.Atoi(valueOrDefault(getenv("DATABASE_MAX_IDLE_CONNS"), "10"))
+	if err != nil || maxIdleConns < 0 || maxIdleConns > maxOpenConns {
+		return cfg, fmt.Errorf("DATABASE_MAX_IDLE_CONNS must be a non-negative integer no greater than DATABASE_MAX_OPEN_CONNS")
+	}
+	cfg.DatabaseMaxIdleConns = maxIdleConns
+
+	connMaxLifetime, err := time.ParseDuration(valueOrDefault(getenv("DATABASE_CONN_MAX_LIFETIME"), "30m"))
+	if err != nil || connMaxLifetime < 0 {
+		return cfg, fmt.Errorf("DATABASE_CONN_MAX_LIFETIME must be a non-negative duration")
+	}
+	cfg.DatabaseConnMaxLifetime = connMaxLifetime
+
+	connMaxIdleTime, err := time.ParseDuration(valueOrDefault(getenv("DATABASE_CONN_MAX_IDLE_TIME"), "5m"))
+	if err != nil || connMaxIdleTime < 0 {
+		return cfg, fmt.Errorf("DATABASE_CONN_MAX_IDLE_TIME must be a non-negative duration")
+	}
+	cfg.DatabaseConnMaxIdleTime = connMaxIdleTime
+
+	connectTimeout, err := time.ParseDuration(valueOrDefault(getenv("DATABASE_CONNECT_TIMEOUT"), "5s"))
+	if err != nil || connectTimeout < 0 {
+		return cfg, fmt.Errorf("DATABASE_CONNECT_TIMEOUT must be a non-negative duration")
+	}
+	cfg.DatabaseConnectTimeout = connectTimeout
+
+	interval, err := time.ParseDuration(valueOrDefault(getenv("NOTIFICATION_REMINDER_INTERVAL"), "1h"))
+	if err != nil || interval < 0 {
+		return cfg, fmt.Errorf("NOTIFICATION_REMINDER_INTERVAL must be a non-negative duration")
+	}
+	cfg.NotificationReminderInterval = interval
+
+	for _, setting := range []struct {
+		name  string
+		kinds []string
+	}{
+		{"SLACK_NOTIFICATION_KINDS", cfg.SlackNotificationKinds},
+		{"DISCORD_NOTIFICATION_KINDS", cfg.DiscordNotificationKinds},
+	} {
+		for _, kind := range setting.kinds {
+			switch kind {
+			case "episode_published", "asset_failed", "review_requested":
+			default:
+				return cfg, fmt.Errorf("%s supports episode_published, asset_failed and review_requested, got %q", setting.name, kind)
+			}
+		}
+	}
+
+	retryInterval, err := time.ParseDuration(valueOrDefault(getenv("WEBHOOK_RETRY_INTERVAL"), "1m"))
+	if err != nil || retryInterval < 0 {
+		return cfg, fmt.Errorf("WEBHOOK_RETRY_INTERVAL must be a non-negative duration")
+	}
+	cfg.WebhookRetryInterval = retryInterval
+
+	expiryInterval, err := time.ParseDuration(valueOrDefault(getenv("UPLOAD_EXPIRY_INTERVAL"), "5m"))
+	if err != nil || expiryInterval < 0 {
+		return cfg, fmt.Errorf("UPLOAD_EXPIRY_INTERVAL must be a non-negative duration")
+	}
+	cfg.UploadExpiryInterval = expiryInterval
+
+	gcInterval, err := time.ParseDuration(valueOrDefault(getenv("ASSET_GC_INTERVAL"), "24h"))
+	if err != nil || gcInterval < 0 {
+		return cfg, fmt.Errorf("ASSET_GC_INTERVAL must be a non-negative duration")
+	}
+	cfg.AssetGCInterval = gcInterval
+
+	gcRetention, err := time.ParseDuration(valueOrDefault(getenv("ASSET_GC_RETENTION"), "720h"))
+	if err != nil || gcRetention < 0 {
+		return cfg, fmt.Errorf("ASSET_GC_RETENTION must be a non-negative duration")
+	}
+	cfg.AssetGCRetention = gcRetention
+
+	reconcileInterval, err := time.ParseDuration(valueOrDefault(getenv("EPISODE_COUNT_RECONCILE_INTERVAL"), "24h"))
+	if err != nil || reconcileInterval < 0 {
+		return cfg, fmt.Errorf("EPISODE_COUNT_RECONCILE_INTERVAL must be a non-negative duration")
+	}
+	cfg.EpisodeCountReconcileInterval = reconcileInterval
+
+	rollupInterval, err := time.ParseDuration(valueOrDefault(getenv("ENGAGEMENT_ROLLUP_INTERVAL"), "24h"))
+	if err != nil || rollupInterval < 0 {
+		return cfg, fmt.Errorf("ENGAGEMENT_ROLLUP_INTERVAL must be a non-negative duration")
+	}
+	cfg.EngagementRollupInterval = rollupInterval
+
+	schedulerInterval, err := time.ParseDuration(valueOrDefault(getenv("SCHEDULER_POLL_INTERVAL"), "30s"))
+	if err != nil || schedulerInterval <= 0 {
+		return cfg, fmt.Errorf("SCHEDULER_POLL_INTERVAL must be a positive duration")
+	}
+	cfg.SchedulerPollInterval = schedulerInterval
+
+	relayInterval, err := time.ParseDuration(valueOrDefault(getenv("OUTBOX_RELAY_INTERVAL"), "1s"))
+	if err != nil || relayInterval < 0 {
+		return cfg, fmt.Errorf("OUTBOX_RELAY_INTERVAL must be a non-negative duration")
+	}
+	cfg.OutboxRelayInterval = relayInterval
+
+	usageInterval, err := time.ParseDuration(valueOrDefault(getenv("USAGE_FLUSH_INTERVAL"), "1m"))
+	if err != nil || usageInterval <= 0 {
+		return cfg, fmt.Errorf("USAGE_FLUSH_INTERVAL must be a positive duration")
+	}
+	cfg.UsageFlushInterval = usageInterval
+
+	concurrency, err := strconv.Atoi(valueOrDefault(getenv("JOB_WORKER_CONCURRENCY"), "4"))
+	if err != nil || concurrency <= 0 {
+		return cfg, fmt.Errorf("JOB_WORKER_CONCURRENCY must be a positive integer")
+	}
+	cfg.JobWorkerConcurrency = concurrency
+
+	pollInterval, err := time.ParseDuration(valueOrDefault(getenv("JOB_POLL_INTERVAL"), "1s"))
+	if err != nil || pollInterval <= 0 {
+		return cfg, fmt.Errorf("JOB_POLL_INTERVAL must be a positive duration")
+	}
+	cfg.JobPollInterval = pollInterval
+
+	embeddedWorker, err := strconv.ParseBool(valueOrDefault(getenv("EMBEDDED_WORKER"), "true"))
+	if err != nil {
+		return cfg, fmt.Errorf("EMBEDDED_WORKER must be a boolean")
+	}
+	cfg.EmbeddedWorker = embeddedWorker
+
+	cfg.OTLPTracing = os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+
+	cfg.CORSAllowedOrigins = splitList(getenv("CORS_ALLOWED_ORIGINS"))
+	cfg.CORSAllowedHeaders = splitList(getenv("CORS_ALLOWED_HEADERS"))
+	corsMaxAge, err := time.ParseDuration(valueOrDefault(getenv("CORS_MAX_AGE"), "2h"))
+	if err != nil || corsMaxAge < 0 {
+		return cfg, fmt.Errorf("CORS_MAX_AGE must be a non-negative duration")
+	}
+	cfg.CORSMaxAge = corsMaxAge
+
+	cfg.RPCCompression = splitList(valueOrDefault(getenv("RPC_COMPRESSION"), "gzip,zstd"))
+	for _, algorithm := range cfg.RPCCompression {
+		if algorithm != "gzip" && algorithm != "zstd" {
+			return cfg, fmt.Errorf("RPC_COMPRESSION supports gzip and zstd, got %q", algorithm)
+		}
+	}
+	compressMinBytes, err := strconv.Atoi(valueOrDefault(getenv("RPC_COMPRESS_MIN_BYTES"), "1024"))
+	if err != nil || compressMinBytes < 0 {
+		return cfg, fmt.Errorf("RPC_COMPRESS_MIN_BYTES must be a non-negative integer")
+	}
+	cfg.RPCCompressMinBytes = compressMinBytes
+
+	persistEvents, err := strconv.ParseBool(valueOrDefault(getenv("PERSIST_EVENTS"), "true"))
+	if err != nil {
+		return cfg, fmt.Errorf("PERSIST_EVENTS must be a boolean")
+	}
+	cfg.PersistEvents = persistEvents
+
+	cfg.RedisURL = getenv("REDIS_URL")
+	cfg.CacheStore = getenv("CACHE_STORE")
+	if cfg.CacheStore == "" && cfg.RedisURL != "" {
+		cfg.CacheStore = "redis"
+	}
+	switch cfg.CacheStore {
+	case "", "memory":
+	case "redis":
+		if cfg.RedisURL == "" {
+			return cfg, fmt.Errorf("REDIS_URL must be provided for the redis cache store")
+		}
+	default:
+		return cfg, fmt.Errorf("CACHE_STORE supports memory and redis, got %q", cfg.CacheStore)
+	}
+	cacheSize, err := strconv.Atoi(valueOrDefault(getenv("CACHE_SIZE"), "10000"))
+	if err != nil || cacheSize <= 0 {
+		return cfg, fmt.Errorf("CACHE_SIZE must be a positive integer")
+	}
+	cfg.CacheSize = cacheSize
+	cacheTTL, err := time.ParseDuration(valueOrDefault(getenv("CACHE_TTL"), "1m"))
+	if err != nil || cacheTTL <= 0 {
+		return cfg, fmt.Errorf("CACHE_TTL must be a positive duration")
+	}
+	cfg.CacheTTL = cacheTTL
+	cacheListTTL, err := time.ParseDuration(valueOrDefault(getenv("CACHE_LIST_TTL"), "30s"))
+	if err != nil || cacheListTTL <= 0 {
+		return cfg, fmt.Errorf("CACHE_LIST_TTL must be a positive duration")
+	}
+	cfg.CacheListTTL = cacheListTTL
+
+	cfg.SearchEngine = getenv("SEARCH_ENGINE")
+	cfg.SearchURL = getenv("SEARCH_URL")
+	cfg.SearchAPIKey = getenv("SEARCH_API_KEY")
+	cfg.SearchIndex = valueOrDefault(getenv("SEARCH_INDEX"), "lession")
+	cfg.EmbeddingProvider = getenv("EMBEDDING_PROVIDER")
+	cfg.EmbeddingModel = getenv("EMBEDDING_MODEL")
+	switch cfg.SearchEngine {
+	case "":
+	case "meilisearch", "elasticsearch":
+		if cfg.SearchURL == "" {
+			return cfg, fmt.Errorf("SEARCH_URL must be provided for the %s search engine", cfg.SearchEngine)
+		}
+	default:
+		return cfg, fmt.Errorf("SEARCH_ENGINE supports meilisearch and elasticsearch, got %q", cfg.SearchEngine)
+	}
+
+	cfg.AnalyticsExportSink = getenv("ANALYTICS_EXPORT_SINK")
+	exportInterval, err := time.ParseDuration(valueOrDefault(getenv("ANALYTICS_EXPORT_INTERVAL"), "24h"))
+	if err != nil || exportInterval < 0 {
+		return cfg, fmt.Errorf("ANALYTICS_EXPORT_INTERVAL must be a non-negative duration")
+	}
+	cfg.AnalyticsExportInterval = exportInterval
+	exportSessions, err := strconv.ParseBool(valueOrDefault(getenv("ANALYTICS_EXPORT_PLAYBACK_SESSIONS"), "false"))
+	if err != nil {
+		return cfg, fmt.Errorf("ANALYTICS_EXPORT_PLAYBACK_SESSIONS must be a boolean")
+	}
+	cfg.AnalyticsExportPlaybackSessions = exportSessions
+	cfg.BigQueryCredentialsFile = getenv("ANALYTICS_BIGQUERY_CREDENTIALS_FILE")
+	cfg.BigQueryProject = getenv("ANALYTICS_BIGQUERY_PROJECT")
+	cfg.BigQueryDataset = getenv("ANALYTICS_BIGQUERY_DATASET")
+	cfg.AnalyticsS3Bucket = getenv("ANALYTICS_S3_BUCKET")
+	cfg.AnalyticsS3Region = valueOrDefault(getenv("ANALYTICS_S3_REGION"), "us-east-1")
+	cfg.AnalyticsS3Prefix = getenv("ANALYTICS_S3_PREFIX")
+	cfg.AnalyticsS3Endpoint = getenv("ANALYTICS_S3_ENDPOINT")
+	cfg.AnalyticsS3AccessKeyID = getenv("ANALYTICS_S3_ACCESS_KEY_ID")
+	cfg.AnalyticsS3SecretAccessKey = getenv("ANALYTICS_S3_SECRET_ACCESS_KEY")
+	switch cfg.AnalyticsExportSink {
+	case "":
+	case "bigquery":
+		if cfg.BigQueryCredentialsFile == "" || cfg.BigQueryDataset == "" {
+			return cfg, fmt.Errorf("ANALYTICS_BIGQUERY_CREDENTIALS_FILE and ANALYTICS_BIGQUERY_DATASET must be provided for the bigquery sink")
+		}
+	case "s3":
+		if cfg.AnalyticsS3Bucket == "" || cfg.AnalyticsS3AccessKeyID == "" || cfg.AnalyticsS3SecretAccessKey == "" {
+			return cfg, fmt.Errorf("ANALYTICS_S3_BUCKET, ANALYTICS_S3_ACCESS_KEY_ID and ANALYTICS_S3_SECRET_ACCESS_KEY must be provided for the s3 sink")
+		}
+	default:
+		return cfg, fmt.Errorf("ANALYTICS_EXPORT_SINK supports bigquery and s3, got %q", cfg.AnalyticsExportSink)
 	}
 
+	cfg.ModerationBlockedWords = splitList(getenv("MODERATION_BLOCKED_WORDS"))
+	cfg.ModerationWordlistFile = getenv("MODERATION_WORDLIST_FILE")
+	cfg.PerspectiveAPIKey = getenv("PERSPECTIVE_API_KEY")
+	toxicityThreshold, err := strconv.ParseFloat(valueOrDefault(getenv("MODERATION_TOXICITY_THRESHOLD"), "0.8"), 64)
+	if err != nil || toxicityThreshold <= 0 || toxicityThreshold > 1 {
+		return cfg, fmt.Errorf("MODERATION_TOXICITY_THRESHOLD must be a number above 0 and at most 1")
+	}
+	cfg.ModerationToxicityThreshold = toxicityThreshold
+	requireReview, err := strconv.ParseBool(valueOrDefault(getenv("MODERATION_REQUIRE_REVIEW"), "false"))
+	if err != nil {
+		return cfg, fmt.Errorf("MODERATION_REQUIRE_REVIEW must be a boolean")
+	}
+	cfg.ModerationRequireReview = requireReview
+
+	cfg.TranscriptSanitizeMode = getenv("TRANSCRIPT_SANITIZE_MODE")
+	switch cfg.TranscriptSanitizeMode {
+	case "", "flag", "mask":
+	default:
+		return cfg, fmt.Errorf("TRANSCRIPT_SANITIZE_MODE supports flag and mask, got %q", cfg.TranscriptSanitizeMode)
+	}
+	cfg.TranscriptPIIDetectors = splitList(valueOrDefault(getenv("TRANSCRIPT_PII_DETECTORS"), "email,phone,card_number"))
+	cfg.TranscriptAligner = getenv("TRANSCRIPT_ALIGNER")
+
+	cfg.PublishRules = splitList(getenv("PUBLISH_RULES"))
+	for _, rule := range cfg.PublishRules {
+		switch rule {
+		case "cover", "summary", "ready_episode", "transcripts":
+		default:
+			return cfg, fmt.Errorf("PUBLISH_RULES supports cover, summary, ready_episode and transcripts, got %q", rule)
+		}
+	}
+	minSummaryLength, err := strconv.Atoi(valueOrDefault(getenv("PUBLISH_MIN_SUMMARY_LENGTH"), "80"))
+	if err != nil || minSummaryLength < 0 {
+		return cfg, fmt.Errorf("PUBLISH_MIN_SUMMARY_LENGTH must be a non-negative integer")
+	}
+	cfg.PublishMinSummaryLength = minSummaryLength
+	cfg.AeneasPython = valueOrDefault(getenv("AENEAS_PYTHON"), "python3")
+	cfg.DictionaryProvider = getenv("DICTIONARY_PROVIDER")
+	cfg.DictionaryFile = getenv("DICTIONARY_FILE")
+
+	cfg.AudioPackager = getenv("AUDIO_PACKAGER")
+	cfg.FFmpegPath = valueOrDefault(getenv("FFMPEG_PATH"), "ffmpeg")
+	cfg.HLSOutputDir = valueOrDefault(getenv("HLS_OUTPUT_DIR"), "hls")
+	cfg.HLSBaseURL = valueOrDefault(getenv("HLS_BASE_URL"), "http://localhost:8080/hls")
+	for _, value := range splitList(valueOrDefault(getenv("HLS_AUDIO_BITRATES"), "64,128,192")) {
+		bitrate, err := strconv.Atoi(value)
+		if err != nil || bitrate <= 0 {
+			return cfg, fmt.Errorf("HLS_AUDIO_BITRATES must list positive bitrates in kbit/s")
+		}
+		cfg.HLSAudioBitrates = append(cfg.HLSAudioBitrates, bitrate)
+	}
+	cfg.DRMKeyURL = valueOrDefault(getenv("DRM_KEY_URL"), "http://localhost:8080/drm/v1/keys")
+
+	cfg.ImageProcessor = getenv("IMAGE_PROCESSOR")
+	switch cfg.ImageProcessor {
+	case "", "local":
+	default:
+		return cfg, fmt.Errorf("IMAGE_PROCESSOR supports local, got %q", cfg.ImageProcessor)
+	}
+	cfg.ImageOutputDir = valueOrDefault(getenv("IMAGE_OUTPUT_DIR"), "images")
+	cfg.ImageBaseURL = valueOrDefault(getenv("IMAGE_BASE_URL"), "http://localhost:8080/images")
+
+	cfg.CDNProvider = getenv("CDN_PROVIDER")
+	cfg.CDNBaseURL = getenv("CDN_BASE_URL")
+	cfg.CDNOrigins = splitList(valueOrDefault(getenv("CDN_ORIGINS"), cfg.HLSBaseURL+","+cfg.ImageBaseURL))
+	cfg.CloudFrontDistributionID = getenv("CDN_CLOUDFRONT_DISTRIBUTION_ID")
+	cfg.CDNAWSAccessKeyID = getenv("CDN_AWS_ACCESS_KEY_ID")
+	cfg.CDNAWSSecretAccessKey = getenv("CDN_AWS_SECRET_ACCESS_KEY")
+	cfg.CloudflareZoneID = getenv("CDN_CLOUDFLARE_ZONE_ID")
+	cfg.CloudflareAPIToken = getenv("CDN_CLOUDFLARE_API_TOKEN")
+	switch cfg.CDNProvider {
+	case "":
+	case "cloudfront":
+		if cfg.CloudFrontDistributionID == "" || cfg.CDNAWSAccessKeyID == "" || cfg.CDNAWSSecretAccessKey == "" {
+			return cfg, fmt.Errorf("CDN_CLOUDFRONT_DISTRIBUTION_ID, CDN_AWS_ACCESS_KEY_ID and CDN_AWS_SECRET_ACCESS_KEY must be provided for the cloudfront provider")
+		}
+	case "cloudflare":
+		if cfg.CloudflareZoneID == "" || cfg.CloudflareAPIToken == "" {
+			return cfg, fmt.Errorf("CDN_CLOUDFLARE_ZONE_ID and CDN_CLOUDFLARE_API_TOKEN must be provided for the cloudflare provider")
+		}
+	default:
+		return cfg, fmt.Errorf("CDN_PROVIDER supports cloudfront and cloudflare, got %q", cfg.CDNProvider)
+	}
+	if cfg.CDNProvider != "" && cfg.CDNBaseURL == "" {
+		return cfg, fmt.Errorf("CDN_BASE_URL must be provided with CDN_PROVIDER")
+	}
+
+	cfg.SitemapSeriesURL = getenv("SITEMAP_SERIES_URL")
+	cfg.SitemapEpisodeURL = getenv("SITEMAP_EPISODE_URL")
+	if cfg.SitemapEpisodeURL != "" && cfg.SitemapSeriesURL == "" {
+		return cfg, fmt.Errorf("SITEMAP_SERIES_URL must be provided with SITEMAP_EPISODE_URL")
+	}
+	sitemapMaxAge, err := time.ParseDuration(valueOrDefault(getenv("SITEMAP_MAX_AGE"), "1h"))
+	if err != nil || sitemapMaxAge <= 0 {
+		return cfg, fmt.Errorf("SITEMAP_MAX_AGE must be a positive duration")
+	}
+	cfg.SitemapMaxAge = sitemapMaxAge
+	cfg.EmbedPlayerURL = getenv("EMBED_PLAYER_URL")
+	cfg.EmbedTokenSigningKey = getenv("EMBED_TOKEN_SIGNING_KEY")
+	embedTokenMaxTTL, err := time.ParseDuration(valueOrDefault(getenv("EMBED_TOKEN_MAX_TTL"), "720h"))
+	if err != nil || embedTokenMaxTTL <= 0 {
+		return cfg, fmt.Errorf("EMBED_TOKEN_MAX_TTL must be a positive duration")
+	}
+	cfg.EmbedTokenMaxTTL = embedTokenMaxTTL
+	feedSyncInterval, err := time.ParseDuration(valueOrDefault(getenv("FEED_SYNC_INTERVAL"), "1h"))
+	if err != nil || feedSyncInterval < 0 {
+		return cfg, fmt.Errorf("FEED_SYNC_INTERVAL must be a non-negative duration")
+	}
+	cfg.FeedSyncInterval = feedSyncInterval
+
+	downloadURLTTL, err := time.ParseDuration(valueOrDefault(getenv("DOWNLOAD_URL_TTL"), "24h"))
+	if err != nil || downloadURLTTL <= 0 {
+		return cfg, fmt.Errorf("DOWNLOAD_URL_TTL must be a positive duration")
+	}
+	cfg.DownloadURLTTL = downloadURLTTL
+
 	if cfg.DatabaseURL == "" {
 		return cfg, fmt.Errorf("DATABASE_URL must be provided")
 	}
+	cfg.DatabaseDialect, err = databaseDialect(cfg.DatabaseURL)
+	if err != nil {
+		return cfg, fmt.Errorf("DATABASE_URL: %w", err)
+	}
+	if cfg.DatabaseReplicaURL != "" {
+		replicaDialect, err := databaseDialect(cfg.DatabaseReplicaURL)
+		if err != nil || replicaDialect != cfg.DatabaseDialect {
+			return cfg, fmt.Errorf("DATABASE_REPLICA_URL must be a %s database like DATABASE_URL", cfg.DatabaseDialect)
+		}
+	}
+
+	if cfg.DebugAddress != "" && cfg.DebugAddress == cfg.HTTPAddress {
+		return cfg, fmt.Errorf("DEBUG_ADDRESS must differ from HTTP_ADDRESS")
+	}
+
+	if cfg.UploadFallbackProvider != "" && cfg.UploadFallbackProvider == cfg.UploadProvider {
+		return cfg, fmt.Errorf("UPLOAD_FALLBACK_PROVIDER must differ from UPLOAD_PROVIDER")
+	}
 
 	return cfg, nil
 }
 
+// databaseDialect detects the Ent dialect of a database URL from its scheme.
+// Strings without a scheme are PostgreSQL key/value connection strings.
+func databaseDialect(databaseURL string) (string, error) {
+	scheme, _, found := strings.Cut(databaseURL, "://")
+	if !found {
+		return dialect.Postgres, nil
+	}
+	switch strings.ToLower(scheme) {
+	case "postgres", "postgresql":
+		return dialect.Postgres, nil
+	case "mysql":
+		return dialect.MySQL, nil
+	case "sqlite":
+		return dialect.SQLite, nil
+	default:
+		return "", fmt.Errorf("unsupported database scheme %q", scheme)
+	}
+}
+
 func valueOrDefault(value, fallback string) string {
 	if value != "" {
 		return value
 	}
 	return fallback
 }
+
+// splitList parses a comma-separated list, dropping empty entries.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,221 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/knadh/koanf/parsers/toml/v2"
+	"github.com/knadh/koanf/parsers/yaml"
+	"github.com/knadh/koanf/providers/file"
+	"github.com/knadh/koanf/v2"
+	"github.com/samber/lo"
+)
+
+// fileKeys maps the keys of a configuration file to the environment
+// variables they stand in for.
+var fileKeys = map[string]string{
+	"server.http_address":           "HTTP_ADDRESS",
+	"server.h2c":                    "HTTP_H2C",
+	"server.read_header_timeout":    "HTTP_READ_HEADER_TIMEOUT",
+	"server.read_timeout":           "HTTP_READ_TIMEOUT",
+	"server.write_timeout":          "HTTP_WRITE_TIMEOUT",
+	"server.idle_timeout":           "HTTP_IDLE_TIMEOUT",
+	"server.max_header_bytes":       "HTTP_MAX_HEADER_BYTES",
+	"server.shutdown_timeout":       "SHUTDOWN_TIMEOUT",
+	"server.debug_address":          "DEBUG_ADDRESS",
+	"server.tls.cert_file":          "TLS_CERT_FILE",
+	"server.tls.key_file":           "TLS_KEY_FILE",
+	"server.acme.domains":           "ACME_DOMAINS",
+	"server.acme.cache_dir":         "ACME_CACHE_DIR",
+	"server.acme.email":             "ACME_EMAIL",
+	"server.cors.allowed_origins":   "CORS_ALLOWED_ORIGINS",
+	"server.cors.allowed_headers":   "CORS_ALLOWED_HEADERS",
+	"server.cors.max_age":           "CORS_MAX_AGE",
+	"server.rpc.compression":        "RPC_COMPRESSION",
+	"server.rpc.compress_min_bytes": "RPC_COMPRESS_MIN_BYTES",
+
+	"database.url":                      "DATABASE_URL",
+	"database.replica_url":              "DATABASE_REPLICA_URL",
+	"database.query_timeout":            "DATABASE_QUERY_TIMEOUT",
+	"database.statement_timeout":        "DATABASE_STATEMENT_TIMEOUT",
+	"database.max_open_conns":           "DATABASE_MAX_OPEN_CONNS",
+	"database.max_idle_conns":           "DATABASE_MAX_IDLE_CONNS",
+	"database.conn_max_lifetime":        "DATABASE_CONN_MAX_LIFETIME",
+	"database.conn_max_idle_time":       "DATABASE_CONN_MAX_IDLE_TIME",
+	"database.connect_timeout":          "DATABASE_CONNECT_TIMEOUT",
+	"database.transcript_offload_bytes": "DATABASE_TRANSCRIPT_OFFLOAD_BYTES",
+	"database.auto_migrate":             "AUTO_MIGRATE",
+
+	"storage.upload_provider":          "UPLOAD_PROVIDER",
+	"storage.upload_fallback_provider": "UPLOAD_FALLBACK_PROVIDER",
+	"storage.upload_expiry_interval":   "UPLOAD_EXPIRY_INTERVAL",
+	"storage.asset_gc_interval":        "ASSET_GC_INTERVAL",
+	"storage.asset_gc_retention":       "ASSET_GC_RETENTION",
+	"storage.audio_packager":           "AUDIO_PACKAGER",
+	"storage.ffmpeg_path":              "FFMPEG_PATH",
+	"storage.hls_output_dir":           "HLS_OUTPUT_DIR",
+	"storage.hls_base_url":             "HLS_BASE_URL",
+	"storage.hls_audio_bitrates":       "HLS_AUDIO_BITRATES",
+	"storage.drm_key_url":              "DRM_KEY_URL",
+	"storage.image_processor":          "IMAGE_PROCESSOR",
+	"storage.image_output_dir":         "IMAGE_OUTPUT_DIR",
+	"storage.image_base_url":           "IMAGE_BASE_URL",
+
+	"cdn.provider":                     "CDN_PROVIDER",
+	"cdn.base_url":                     "CDN_BASE_URL",
+	"cdn.origins":                      "CDN_ORIGINS",
+	"cdn.cloudfront.distribution_id":   "CDN_CLOUDFRONT_DISTRIBUTION_ID",
+	"cdn.cloudfront.access_key_id":     "CDN_AWS_ACCESS_KEY_ID",
+	"cdn.cloudfront.secret_access_key": "CDN_AWS_SECRET_ACCESS_KEY",
+	"cdn.cloudflare.zone_id":           "CDN_CLOUDFLARE_ZONE_ID",
+	"cdn.cloudflare.api_token":         "CDN_CLOUDFLARE_API_TOKEN",
+	"seo.sitemap_series_url":           "SITEMAP_SERIES_URL",
+	"seo.sitemap_episode_url":          "SITEMAP_EPISODE_URL",
+	"seo.sitemap_max_age":              "SITEMAP_MAX_AGE",
+	"seo.embed_player_url":             "EMBED_PLAYER_URL",
+	"embeds.token_signing_key":         "EMBED_TOKEN_SIGNING_KEY",
+	"embeds.token_max_ttl":             "EMBED_TOKEN_MAX_TTL",
+	"feeds.sync_interval":              "FEED_SYNC_INTERVAL",
+
+	"auth.widget_signing_key": "WIDGET_SIGNING_KEY",
+	"auth.lti.tool_url":       "LTI_TOOL_URL",
+	"auth.lti.private_key":    "LTI_PRIVATE_KEY",
+	"auth.lti.player_url":     "LTI_PLAYER_URL",
+
+	"downloads.base_url":    "DOWNLOAD_BASE_URL",
+	"downloads.signing_key": "DOWNLOAD_SIGNING_KEY",
+	"downloads.url_ttl":     "DOWNLOAD_URL_TTL",
+
+	"billing.provider":              "BILLING_PROVIDER",
+	"billing.stripe_secret_key":     "STRIPE_SECRET_KEY",
+	"billing.stripe_webhook_secret": "STRIPE_WEBHOOK_SECRET",
+
+	"authoring.provider":        "AUTHORING_PROVIDER",
+	"authoring.openai_api_key":  "OPENAI_API_KEY",
+	"authoring.openai_model":    "OPENAI_MODEL",
+	"authoring.openai_base_url": "OPENAI_BASE_URL",
+
+	"notifications.smtp_address":         "SMTP_ADDRESS",
+	"notifications.smtp_username":        "SMTP_USERNAME",
+	"notifications.smtp_password":        "SMTP_PASSWORD",
+	"notifications.email_from":           "NOTIFICATION_EMAIL_FROM",
+	"notifications.fcm_credentials_file": "FCM_CREDENTIALS_FILE",
+	"notifications.slack_webhook_url":    "SLACK_WEBHOOK_URL",
+	"notifications.slack_kinds":          "SLACK_NOTIFICATION_KINDS",
+	"notifications.discord_webhook_url":  "DISCORD_WEBHOOK_URL",
+	"notifications.discord_kinds":        "DISCORD_NOTIFICATION_KINDS",
+	"notifications.reminder_interval":    "NOTIFICATION_REMINDER_INTERVAL",
+
+	"webhooks.retry_interval": "WEBHOOK_RETRY_INTERVAL",
+
+	"jobs.worker_concurrency":               "JOB_WORKER_CONCURRENCY",
+	"jobs.poll_interval":                    "JOB_POLL_INTERVAL",
+	"jobs.scheduler_poll_interval":          "SCHEDULER_POLL_INTERVAL",
+	"jobs.outbox_relay_interval":            "OUTBOX_RELAY_INTERVAL",
+	"jobs.usage_flush_interval":             "USAGE_FLUSH_INTERVAL",
+	"jobs.episode_count_reconcile_interval": "EPISODE_COUNT_RECONCILE_INTERVAL",
+	"jobs.engagement_rollup_interval":       "ENGAGEMENT_ROLLUP_INTERVAL",
+
+	"cache.store":     "CACHE_STORE",
+	"cache.redis_url": "REDIS_URL",
+	"cache.size":      "CACHE_SIZE",
+	"cache.ttl":       "CACHE_TTL",
+	"cache.list_ttl":  "CACHE_LIST_TTL",
+
+	"search.engine":  "SEARCH_ENGINE",
+	"search.url":     "SEARCH_URL",
+	"search.api_key": "SEARCH_API_KEY",
+	"search.index":   "SEARCH_INDEX",
+
+	"search.embedding_provider": "EMBEDDING_PROVIDER",
+	"search.embedding_model":    "EMBEDDING_MODEL",
+
+	"analytics_export.sink":                      "ANALYTICS_EXPORT_SINK",
+	"analytics_export.interval":                  "ANALYTICS_EXPORT_INTERVAL",
+	"analytics_export.playback_sessions":         "ANALYTICS_EXPORT_PLAYBACK_SESSIONS",
+	"analytics_export.bigquery.credentials_file": "ANALYTICS_BIGQUERY_CREDENTIALS_FILE",
+	"analytics_export.bigquery.project":          "ANALYTICS_BIGQUERY_PROJECT",
+	"analytics_export.bigquery.dataset":          "ANALYTICS_BIGQUERY_DATASET",
+	"analytics_export.s3.bucket":                 "ANALYTICS_S3_BUCKET",
+	"analytics_export.s3.region":                 "ANALYTICS_S3_REGION",
+	"analytics_export.s3.prefix":                 "ANALYTICS_S3_PREFIX",
+	"analytics_export.s3.endpoint":               "ANALYTICS_S3_ENDPOINT",
+	"analytics_export.s3.access_key_id":          "ANALYTICS_S3_ACCESS_KEY_ID",
+	"analytics_export.s3.secret_access_key":      "ANALYTICS_S3_SECRET_ACCESS_KEY",
+
+	"moderation.blocked_words":       "MODERATION_BLOCKED_WORDS",
+	"moderation.wordlist_file":       "MODERATION_WORDLIST_FILE",
+	"moderation.perspective_api_key": "PERSPECTIVE_API_KEY",
+	"moderation.toxicity_threshold":  "MODERATION_TOXICITY_THRESHOLD",
+	"moderation.require_review":      "MODERATION_REQUIRE_REVIEW",
+
+	"transcripts.sanitize_mode":   "TRANSCRIPT_SANITIZE_MODE",
+	"transcripts.pii_detectors":   "TRANSCRIPT_PII_DETECTORS",
+	"transcripts.aligner":         "TRANSCRIPT_ALIGNER",
+	"transcripts.aeneas_python":   "AENEAS_PYTHON",
+	"transcripts.dictionary":      "DICTIONARY_PROVIDER",
+	"transcripts.dictionary_file": "DICTIONARY_FILE",
+
+	"publishing.rules":              "PUBLISH_RULES",
+	"publishing.min_summary_length": "PUBLISH_MIN_SUMMARY_LENGTH",
+
+	"features.embedded_worker": "EMBEDDED_WORKER",
+	"features.persist_events":  "PERSIST_EVENTS",
+}
+
+// fileLookup returns a lookup of configuration values by environment
+// variable that falls back to the file at path for unset or empty
+// variables, so the environment always overrides the file.
+func fileLookup(path string) (func(string) string, error) {
+	if path == "" {
+		return os.Getenv, nil
+	}
+
+	var parser koanf.Parser
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		parser = yaml.Parser()
+	case ".toml":
+		parser = toml.Parser()
+	default:
+		return nil, fmt.Errorf("config file %s must be .yaml, .yml or .toml", path)
+	}
+	k := koanf.New(".")
+	if err := k.Load(file.Provider(path), parser); err != nil {
+		return nil, fmt.Errorf("read config file %s: %w", path, err)
+	}
+
+	values := make(map[string]string, len(fileKeys))
+	var unknown []string
+	for _, key := range k.Keys() {
+		env, ok := fileKeys[key]
+		if !ok {
+			unknown = append(unknown, key)
+			continue
+		}
+		values[env] = fileValue(k.Get(key))
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("config file %s has unknown keys: %s", path, strings.Join(unknown, ", "))
+	}
+
+	return func(env string) string {
+		if value := os.Getenv(env); value != "" {
+			return value
+		}
+		return values[env]
+	}, nil
+}
+
+// fileValue formats a file value the way it would be written in the
+// environment; lists become comma-separated.
+func fileValue(value any) string {
+	if list, ok := value.([]any); ok {
+		return strings.Join(lo.Map(list, func(item any, _ int) string { return fmt.Sprint(item) }), ",")
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,204 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		env     map[string]string
+		check   func(t *testing.T, cfg Config)
+		wantErr string
+	}{
+		{
+			name: "yaml",
+			file: "lession.yaml",
+			content: `server:
+  http_address: ":9000"
+  shutdown_timeout: 45s
+  cors:
+    allowed_origins: [https://a.example, https://b.example]
+database:
+  url: postgres://file
+  auto_migrate: false
+features:
+  embedded_worker: true
+`,
+			check: func(t *testing.T, cfg Config) {
+				if cfg.HTTPAddress != ":9000" || cfg.ShutdownTimeout != 45*time.Second {
+					t.Fatalf("expected server settings from the file, got %q, %v", cfg.HTTPAddress, cfg.ShutdownTimeout)
+				}
+				if strings.Join(cfg.CORSAllowedOrigins, " ") != "https://a.example https://b.example" {
+					t.Fatalf("expected origins from the file, got %v", cfg.CORSAllowedOrigins)
+				}
+				if cfg.DatabaseURL != "postgres://file" || cfg.DatabaseDialect != "postgres" || cfg.AutoMigrate || !cfg.EmbeddedWorker {
+					t.Fatalf("expected database and features from the file, got %+v", cfg)
+				}
+			},
+		},
+		{
+			name: "toml with env override",
+			file: "lession.toml",
+			content: `[server]
+http_address = ":9000"
+
+[database]
+url = "postgres://file"
+
+[jobs]
+worker_concurrency = 8
+`,
+			env: map[string]string{"DATABASE_URL": "postgres://env"},
+			check: func(t *testing.T, cfg Config) {
+				if cfg.HTTPAddress != ":9000" || cfg.JobWorkerConcurrency != 8 {
+					t.Fatalf("expected settings from the file, got %q, %d", cfg.HTTPAddress, cfg.JobWorkerConcurrency)
+				}
+				if cfg.DatabaseURL != "postgres://env" {
+					t.Fatalf("expected the environment to win, got %q", cfg.DatabaseURL)
+				}
+			},
+		},
+		{
+			name:    "sqlite database",
+			file:    "lession.yaml",
+			content: "database:\n  url: sqlite:///var/lib/lession/lession.db\n",
+			check: func(t *testing.T, cfg Config) {
+				if cfg.DatabaseDialect != "sqlite3" {
+					t.Fatalf("expected the sqlite dialect, got %q", cfg.DatabaseDialect)
+				}
+			},
+		},
+		{
+			name:    "replica of another dialect",
+			file:    "lession.yaml",
+			content: "database:\n  url: mysql://lession@db/lession\n  replica_url: postgres://replica\n",
+			wantErr: "DATABASE_REPLICA_URL",
+		},
+		{
+			name:    "unsupported database scheme",
+			file:    "lession.yaml",
+			content: "database:\n  url: mongodb://db/lession\n",
+			wantErr: "mongodb",
+		},
+		{
+			name:    "search engine without url",
+			file:    "lession.yaml",
+			content: "database:\n  url: postgres://file\nsearch:\n  engine: meilisearch\n",
+			wantErr: "SEARCH_URL",
+		},
+		{
+			name: "s3 analytics export",
+			file: "lession.yaml",
+			content: `database:
+  url: postgres://file
+analytics_export:
+  sink: s3
+  playback_sessions: true
+  s3:
+    bucket: analytics
+    access_key_id: AKID
+    secret_access_key: secret
+`,
+			check: func(t *testing.T, cfg Config) {
+				if cfg.AnalyticsExportSink != "s3" || !cfg.AnalyticsExportPlaybackSessions || cfg.AnalyticsExportInterval != 24*time.Hour {
+					t.Fatalf("expected the export settings from the file, got %+v", cfg)
+				}
+				if cfg.AnalyticsS3Bucket != "analytics" || cfg.AnalyticsS3Region != "us-east-1" || cfg.AnalyticsS3AccessKeyID != "AKID" {
+					t.Fatalf("expected the s3 settings from the file, got %+v", cfg)
+				}
+			},
+		},
+		{
+			name:    "bigquery export without dataset",
+			file:    "lession.yaml",
+			content: "database:\n  url: postgres://file\nanalytics_export:\n  sink: bigquery\n  bigquery:\n    credentials_file: /etc/lession/bq.json\n",
+			wantErr: "ANALYTICS_BIGQUERY_DATASET",
+		},
+		{
+			name: "moderation",
+			file: "lession.yaml",
+			content: `database:
+  url: postgres://file
+moderation:
+  blocked_words: [darn, heck no]
+  require_review: true
+`,
+			check: func(t *testing.T, cfg Config) {
+				if !reflect.DeepEqual(cfg.ModerationBlockedWords, []string{"darn", "heck no"}) || !cfg.ModerationRequireReview || cfg.ModerationToxicityThreshold != 0.8 {
+					t.Fatalf("expected the moderation settings from the file, got %+v", cfg)
+				}
+			},
+		},
+		{
+			name:    "toxicity threshold out of range",
+			file:    "lession.yaml",
+			content: "database:\n  url: postgres://file\nmoderation:\n  toxicity_threshold: 1.5\n",
+			wantErr: "MODERATION_TOXICITY_THRESHOLD",
+		},
+		{
+			name:    "unknown transcript sanitize mode",
+			file:    "lession.yaml",
+			content: "database:\n  url: postgres://file\ntranscripts:\n  sanitize_mode: redact\n",
+			wantErr: "TRANSCRIPT_SANITIZE_MODE",
+		},
+		{
+			name:    "unknown publish rule",
+			file:    "lession.yaml",
+			content: "database:\n  url: postgres://file\npublishing:\n  rules: [cover, trailer]\n",
+			wantErr: "PUBLISH_RULES",
+		},
+		{
+			name:    "unknown key",
+			file:    "lession.yaml",
+			content: "database:\n  url: postgres://file\n  pool_size: 5\n",
+			wantErr: "database.pool_size",
+		},
+		{
+			name:    "invalid value",
+			file:    "lession.yaml",
+			content: "database:\n  url: postgres://file\nserver:\n  shutdown_timeout: soon\n",
+			wantErr: "SHUTDOWN_TIMEOUT",
+		},
+		{
+			name:    "unsupported format",
+			file:    "lession.json",
+			content: "{}",
+			wantErr: ".toml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range fileKeys {
+				t.Setenv(key, "")
+			}
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			path := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatalf("write config file: %v", err)
+			}
+
+			cfg, err := LoadFile(path)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected an error mentioning %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFile() error = %v", err)
+			}
+			tt.check(t, cfg)
+		})
+	}
+}
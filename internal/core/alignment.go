@@ -0,0 +1,33 @@
+package core
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// AlignmentRequest asks for the sentences of a transcript to be located in
+// the audio of a media asset.
+type AlignmentRequest struct {
+	AudioURL string
+	MimeType string
+	// Language is the BCP 47 tag of the spoken language.
+	Language  string
+	Sentences []string
+}
+
+// TranscriptAligner force-aligns transcript text with recorded speech. It
+// returns one segment per sentence, in order.
+type TranscriptAligner interface {
+	AlignTranscript(ctx context.Context, req AlignmentRequest) ([]TranscriptSegment, error)
+}
+
+// TranscriptAlignmentService upgrades plain transcripts to timed ones.
+type TranscriptAlignmentService interface {
+	// AlignEpisodeTranscript replaces the plain transcript of an episode
+	// with an SRT transcript timed against its media.
+	AlignEpisodeTranscript(ctx context.Context, episodeID uuid.UUID) (*Episode, error)
+	// HandleEpisodeEvent aligns the transcript of a created or updated
+	// episode when it is plain and the episode has media.
+	HandleEpisodeEvent(ctx context.Context, event Event) error
+}
@@ -0,0 +1,129 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EngagementMetrics aggregates the playback sessions of an episode or series.
+type EngagementMetrics struct {
+	Sessions int
+	// FinishedSessions counts the sessions played to the end.
+	FinishedSessions int
+	UniqueListeners  int
+	// Completers counts the listeners who finished at least one session.
+	Completers int
+}
+
+// CompletionRate returns the share of listeners who finished, zero without
+// listeners.
+func (m EngagementMetrics) CompletionRate() float64 {
+	if m.UniqueListeners == 0 {
+		return 0
+	}
+	return float64(m.Completers) / float64(m.UniqueListeners)
+}
+
+// ListenThrough returns the share of sessions played to the end, zero
+// without sessions.
+func (m EngagementMetrics) ListenThrough() float64 {
+	if m.Sessions == 0 {
+		return 0
+	}
+	return float64(m.FinishedSessions) / float64(m.Sessions)
+}
+
+// EngagementRollup stores the metrics of an episode or series, computed from
+// the playback sessions that started on a UTC day or, without a day, from
+// all of them.
+type EngagementRollup struct {
+	// SubjectID is the episode or series the metrics belong to.
+	SubjectID uuid.UUID
+	SeriesID  uuid.UUID
+	// Day is the UTC midnight of the day, nil for all-time totals.
+	Day       *time.Time
+	Metrics   EngagementMetrics
+	UpdatedAt time.Time
+}
+
+// EpisodeID returns the episode the rollup belongs to, uuid.Nil for series
+// rollups.
+func (r EngagementRollup) EpisodeID() uuid.UUID {
+	if r.SubjectID == r.SeriesID {
+		return uuid.Nil
+	}
+	return r.SubjectID
+}
+
+// DailyEngagement is the engagement with an episode or series on a UTC day.
+type DailyEngagement struct {
+	Day     time.Time
+	Metrics EngagementMetrics
+}
+
+// EngagementAnalytics reports the rolled-up engagement with an episode or
+// series.
+type EngagementAnalytics struct {
+	SubjectID uuid.UUID
+	SeriesID  uuid.UUID
+	// Total covers every session up to the last rollup.
+	Total EngagementMetrics
+	// Days covers every day of the requested range, oldest first, including
+	// days without sessions.
+	Days []DailyEngagement
+	// RolledUpAt is when the totals were last rolled up, nil before the
+	// first rollup.
+	RolledUpAt *time.Time
+}
+
+// EngagementAnalyticsQuery selects an episode or series and the days to
+// report. From is inclusive and To exclusive; zero values report the last
+// 30 days.
+type EngagementAnalyticsQuery struct {
+	ID   uuid.UUID
+	From time.Time
+	To   time.Time
+}
+
+// EngagementRepository aggregates playback sessions and persists the
+// resulting rollups.
+type EngagementRepository interface {
+	// ListPlayedEpisodes returns the episodes with sessions started within
+	// [from, to).
+	ListPlayedEpisodes(ctx context.Context, from, to time.Time) ([]uuid.UUID, error)
+	// SummarizePlayback aggregates the sessions of the episodes started
+	// within [from, to); zero bounds are unbounded.
+	SummarizePlayback(ctx context.Context, episodeIDs []uuid.UUID, from, to time.Time) (EngagementMetrics, error)
+	// SaveEngagementRollups replaces the stored rollups with the same subject
+	// and day.
+	SaveEngagementRollups(ctx context.Context, rollups []EngagementRollup) error
+	// ListEngagementRollups returns the all-time rollup of the subject, when
+	// stored, followed by its daily rollups within [from, to), oldest first.
+	ListEngagementRollups(ctx context.Context, subjectID uuid.UUID, from, to time.Time) ([]EngagementRollup, error)
+	// ListDailyEngagementRollups returns the rollups of every subject for
+	// the UTC day starting at day.
+	ListDailyEngagementRollups(ctx context.Context, day time.Time) ([]EngagementRollup, error)
+	// ListPlaybackSessions returns the sessions started within [from, to),
+	// oldest first.
+	ListPlaybackSessions(ctx context.Context, from, to time.Time) ([]PlaybackSession, error)
+}
+
+// AnalyticsService exposes engagement analytics to adapters and rolls up
+// playback sessions.
+type AnalyticsService interface {
+	GetEpisodeAnalytics(ctx context.Context, query EngagementAnalyticsQuery) (*EngagementAnalytics, error)
+	GetSeriesAnalytics(ctx context.Context, query EngagementAnalyticsQuery) (*EngagementAnalytics, error)
+	// RollupEngagement rolls up the sessions started on the UTC day of day,
+	// with the totals of the episodes and series played that day, returning
+	// how many rollups were written.
+	RollupEngagement(ctx context.Context, day time.Time) (int, error)
+	// RollupRecentEngagement rolls up the previous UTC days whose sessions
+	// may still have been finishing at their last rollup.
+	RollupRecentEngagement(ctx context.Context) (int, error)
+	// RequestEngagementRollup queues a rollup of day for a worker.
+	RequestEngagementRollup(ctx context.Context, day time.Time) (*Job, error)
+	// HandleRollupJob runs a rollup queued by RequestEngagementRollup.
+	HandleRollupJob(ctx context.Context, job Job) error
+}
@@ -0,0 +1,27 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+// AnalyticsExportSink ships analytics to an external warehouse. Every export
+// covers one UTC day and replaces what was exported for that day before, so
+// days can be exported again after their rollups change.
+type AnalyticsExportSink interface {
+	// ExportEngagementRollups writes the daily rollups of day.
+	ExportEngagementRollups(ctx context.Context, day time.Time, rollups []EngagementRollup) error
+	// ExportPlaybackSessions writes the playback sessions started on day.
+	ExportPlaybackSessions(ctx context.Context, day time.Time, sessions []PlaybackSession) error
+}
+
+// AnalyticsExportService exports rolled-up analytics, and optionally the raw
+// playback sessions, to the configured warehouse.
+type AnalyticsExportService interface {
+	// ExportAnalytics exports the UTC day of day, returning how many rows
+	// were exported.
+	ExportAnalytics(ctx context.Context, day time.Time) (int, error)
+	// ExportRecentAnalytics exports the previous UTC days the engagement
+	// rollups recompute.
+	ExportRecentAnalytics(ctx context.Context) (int, error)
+}
@@ -14,6 +14,9 @@ const (
 	AssetTypeUnspecified AssetType = iota
 	AssetTypeVideo
 	AssetTypeAudio
+	// AssetTypeImage is a cover or avatar image, resized into the standard
+	// ImageVariants once uploaded.
+	AssetTypeImage
 )
 
 // AssetStatus represents the lifecycle state of an asset.
@@ -28,6 +31,31 @@ const (
 	AssetStatusDeleted
 )
 
+// Settled reports whether the asset has left processing for good: it is
+// ready, failed or deleted.
+func (s AssetStatus) Settled() bool {
+	return s == AssetStatusReady || s == AssetStatusFailed || s == AssetStatusDeleted
+}
+
+// AssetFailureCode classifies why an asset failed.
+type AssetFailureCode int
+
+const (
+	// AssetFailureCodeUnspecified is reported for assets that have not
+	// failed, or were marked failed through UpdateAsset.
+	AssetFailureCodeUnspecified AssetFailureCode = iota
+	// AssetFailureCodeUploadExpired marks assets whose upload session expired
+	// before the upload completed; the media was never received.
+	AssetFailureCodeUploadExpired
+	// AssetFailureCodeInvalidMedia marks media the processing pipeline
+	// rejected, such as an image that cannot be decoded.
+	AssetFailureCodeInvalidMedia
+)
+
+// ExternalAssetProvider is the provider of assets registered from media
+// hosted elsewhere, which are played from their source URL.
+const ExternalAssetProvider = "external"
+
 // UploadProtocol defines the upload mechanism used by a provider.
 type UploadProtocol int
 
@@ -49,6 +77,7 @@ const (
 	UploadStatusCompleted
 	UploadStatusExpired
 	UploadStatusFailed
+	UploadStatusCancelled
 )
 
 // UploadTarget contains the instructions required for a client-side upload.
@@ -70,9 +99,26 @@ type Asset struct {
 	Filesize         int64
 	Duration         time.Duration
 	PlaybackURL      string
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
-	ReadyAt          *time.Time
+	// HLSManifestURL is the HLS master playlist of a packaged audio asset,
+	// empty until the asset has been packaged.
+	HLSManifestURL string
+	// HLSKeyID identifies the content key the HLS rendition is encrypted
+	// with; it is uuid.Nil when the rendition is not encrypted.
+	HLSKeyID uuid.UUID
+	// Width and Height are the pixel dimensions of an image asset, zero
+	// until the image has been processed.
+	Width  int
+	Height int
+	// ImageVariants are the resized renditions of a processed image asset.
+	ImageVariants []ImageVariant
+	Provider      string
+	// FailureCode and FailureReason explain why a failed asset failed; both
+	// are cleared when the asset leaves the failed status.
+	FailureCode   AssetFailureCode
+	FailureReason string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	ReadyAt       *time.Time
 }
 
 // UploadSession represents a single upload flow managed by the platform.
@@ -87,8 +133,29 @@ type UploadSession struct {
 	MimeType         string
 	ContentLength    int64
 	ExpiresAt        time.Time
-	CreatedAt        time.Time
-	UpdatedAt        time.Time
+	Provider         string
+	// ReplacesAssetID is the asset whose content the upload replaces, or
+	// uuid.Nil for uploads that create a new asset.
+	ReplacesAssetID uuid.UUID
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// AssetVersion is content an asset was served from before it was replaced.
+// The stored object is kept so the replacement can be undone by hand.
+type AssetVersion struct {
+	ID               uuid.UUID
+	AssetID          uuid.UUID
+	AssetKey         string
+	Provider         string
+	OriginalFilename string
+	MimeType         string
+	Filesize         int64
+	Duration         time.Duration
+	PlaybackURL      string
+	HLSManifestURL   string
+	// CreatedAt is when the content was replaced.
+	CreatedAt time.Time
 }
 
 // CreateUploadParams describes the user-facing inputs when requesting an upload session.
@@ -99,6 +166,14 @@ type CreateUploadParams struct {
 	ContentLength    int64
 }
 
+// ReplaceAssetContentParams describes the file replacing an asset's content.
+type ReplaceAssetContentParams struct {
+	AssetID          uuid.UUID
+	OriginalFilename string
+	MimeType         string
+	ContentLength    int64
+}
+
 // CreateUploadResult bundles the created upload session and corresponding asset.
 type CreateUploadResult struct {
 	Session UploadSession
@@ -124,6 +199,36 @@ type CompleteUploadResult struct {
 	Session UploadSession
 }
 
+// CancelUploadResult returns the cancelled session and its deleted asset.
+type CancelUploadResult struct {
+	Asset   Asset
+	Session UploadSession
+}
+
+// RegisterExternalAssetParams describes media already hosted elsewhere.
+type RegisterExternalAssetParams struct {
+	Type AssetType
+	URL  string
+	// OriginalFilename defaults to the last segment of the URL path.
+	OriginalFilename string
+	// Duration is used when probing the media cannot determine it.
+	Duration time.Duration
+}
+
+// MediaProbe is the metadata of a media URL as served.
+type MediaProbe struct {
+	MimeType string
+	// ContentLength is zero when the server does not declare it.
+	ContentLength int64
+	// Duration is zero when it cannot be determined without decoding the media.
+	Duration time.Duration
+}
+
+// MediaProber checks that a media URL is reachable and reads its metadata.
+type MediaProber interface {
+	ProbeMedia(ctx context.Context, url string) (*MediaProbe, error)
+}
+
 // AssetListFilter describes pagination and filtering options.
 type AssetListFilter struct {
 	PageSize  int
@@ -131,25 +236,61 @@ type AssetListFilter struct {
 	Statuses  []AssetStatus
 	Types     []AssetType
 	AssetKeys []string
+	// FailureCodes restricts the list to failed assets with any of the codes.
+	FailureCodes []AssetFailureCode
+	// UpdatedBefore, when set, restricts the list to assets last changed
+	// before the given time.
+	UpdatedBefore time.Time
+}
+
+// UploadListFilter describes pagination and filtering options for upload
+// sessions.
+type UploadListFilter struct {
+	PageSize  int
+	PageToken string
+	Statuses  []UploadStatus
+	Types     []AssetType
+	// CreatedAfter and CreatedBefore, when set, bound the creation time of
+	// the listed sessions; the lower bound is inclusive, the upper exclusive.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
 }
 
 // AssetRepository defines the persistence contract for assets and upload sessions.
 type AssetRepository interface {
-	CreateUploadSession(ctx context.Context, session UploadSession) error
-	UpdateUploadSession(ctx context.Context, session UploadSession) error
+	CreateUploadSession(ctx context.Context, session UploadSession) (*UploadSession, error)
+	UpdateUploadSession(ctx context.Context, session UploadSession) (*UploadSession, error)
+	// TransitionUploadSession stores the status of session only while the
+	// stored status is one of from, and returns ErrUploadInvalidState when it
+	// is not, so concurrent transitions cannot both apply.
+	TransitionUploadSession(ctx context.Context, session UploadSession, from ...UploadStatus) (*UploadSession, error)
 	GetUploadSessionByID(ctx context.Context, id uuid.UUID) (*UploadSession, error)
 	GetUploadSessionByAssetKey(ctx context.Context, assetKey string) (*UploadSession, error)
+	// ListExpiredUploadSessions returns open upload sessions whose upload
+	// window closed before now.
+	ListExpiredUploadSessions(ctx context.Context, now time.Time, limit int) ([]UploadSession, error)
+	// ListUploadSessions returns upload sessions newest first.
+	ListUploadSessions(ctx context.Context, filter UploadListFilter) ([]UploadSession, string, error)
 
-	CreateAsset(ctx context.Context, asset Asset) error
-	UpdateAsset(ctx context.Context, asset Asset) error
+	// CreateAsset records the given events in the outbox atomically with the asset.
+	CreateAsset(ctx context.Context, asset Asset, events ...Event) (*Asset, error)
+	// UpdateAsset records the given events in the outbox atomically with the change.
+	UpdateAsset(ctx context.Context, asset Asset, events ...Event) (*Asset, error)
 	GetAssetByID(ctx context.Context, id uuid.UUID) (*Asset, error)
 	GetAssetByKey(ctx context.Context, assetKey string) (*Asset, error)
 	ListAssets(ctx context.Context, filter AssetListFilter) ([]Asset, string, error)
 	DeleteAsset(ctx context.Context, id uuid.UUID, hardDelete bool) (*Asset, error)
+
+	CreateAssetVersion(ctx context.Context, version AssetVersion) error
+	// ListAssetVersions returns the replaced content of an asset, newest first.
+	ListAssetVersions(ctx context.Context, assetID uuid.UUID) ([]AssetVersion, error)
 }
 
 // UploadProvider defines the contract for vendor-specific upload orchestration.
 type UploadProvider interface {
+	// Name identifies the provider; it is persisted with uploads so completion
+	// can be routed back to the provider that issued them.
+	Name() string
 	CreateUpload(ctx context.Context, params ProviderCreateUploadParams) (*ProviderCreateUploadResult, error)
 	CompleteUpload(ctx context.Context, params ProviderCompleteUploadParams) (*ProviderCompleteUploadResult, error)
 }
@@ -164,6 +305,7 @@ type ProviderCreateUploadParams struct {
 
 // ProviderCreateUploadResult contains provider-issued instructions.
 type ProviderCreateUploadResult struct {
+	Provider        string
 	AssetKey        string
 	Protocol        UploadProtocol
 	Target          UploadTarget
@@ -173,11 +315,24 @@ type ProviderCreateUploadResult struct {
 
 // ProviderCompleteUploadParams contains details when an upload completes.
 type ProviderCompleteUploadParams struct {
+	Provider      string
 	AssetKey      string
 	Checksum      string
 	ContentLength int64
 }
 
+// UploadAborter is implemented by upload providers that hold resources for
+// unfinished uploads, such as S3 multipart uploads, and can release them.
+type UploadAborter interface {
+	AbortUpload(ctx context.Context, params ProviderAbortUploadParams) error
+}
+
+// ProviderAbortUploadParams identifies the upload to abort.
+type ProviderAbortUploadParams struct {
+	Provider string
+	AssetKey string
+}
+
 // ProviderCompleteUploadResult conveys the playback details produced by the provider.
 type ProviderCompleteUploadResult struct {
 	PlaybackURL string
@@ -188,10 +343,43 @@ type ProviderCompleteUploadResult struct {
 type AssetService interface {
 	CreateUpload(ctx context.Context, params CreateUploadParams) (*CreateUploadResult, error)
 	GetUploadSession(ctx context.Context, id UploadIdentifier) (*UploadSession, error)
+	// ListUploads enumerates upload sessions newest first, e.g. to find
+	// abandoned uploads.
+	ListUploads(ctx context.Context, filter UploadListFilter) ([]UploadSession, string, error)
 	CompleteUpload(ctx context.Context, params CompleteUploadParams) (*CompleteUploadResult, error)
+	// ReplaceAssetContent starts an upload of new content for an existing
+	// asset. The asset keeps serving its current content until the upload
+	// completes, when the current content is kept as a version and the asset
+	// switches to the new content, so episodes need not be relinked.
+	ReplaceAssetContent(ctx context.Context, params ReplaceAssetContentParams) (*CreateUploadResult, error)
+	// ListAssetVersions returns the content an asset was served from before
+	// it was replaced, newest first.
+	ListAssetVersions(ctx context.Context, assetID uuid.UUID) ([]AssetVersion, error)
+	// CancelUpload abandons an open upload session: the provider is told to
+	// abort the upload and the asset waiting for it is deleted. Assets whose
+	// content the upload would have replaced are left as they are.
+	CancelUpload(ctx context.Context, id UploadIdentifier) (*CancelUploadResult, error)
+	// RegisterExternalAsset creates a ready asset played from media hosted
+	// elsewhere, after checking that the media is reachable.
+	RegisterExternalAsset(ctx context.Context, params RegisterExternalAssetParams) (*Asset, error)
 	GetAsset(ctx context.Context, id uuid.UUID) (*Asset, error)
 	GetAssetByKey(ctx context.Context, assetKey string) (*Asset, error)
 	ListAssets(ctx context.Context, filter AssetListFilter) ([]Asset, string, error)
 	UpdateAsset(ctx context.Context, asset Asset) (*Asset, error)
 	DeleteAsset(ctx context.Context, id uuid.UUID, hardDelete bool) (*Asset, error)
+	// RetryAssetProcessing returns a failed asset to ready and announces it
+	// again, so the processing pipeline reruns. Assets whose upload never
+	// completed cannot be retried.
+	RetryAssetProcessing(ctx context.Context, id uuid.UUID) (*Asset, error)
+	// ExpireUploadSessions marks open upload sessions past their expiry as
+	// expired and fails the assets still waiting for them.
+	ExpireUploadSessions(ctx context.Context) (int, error)
+	// PurgeDeletedAssets hard deletes assets archived longer than retention ago.
+	PurgeDeletedAssets(ctx context.Context, retention time.Duration) (int, error)
+	// WatchAsset calls send with the asset and again on every status change,
+	// returning once the asset has settled or ctx is done.
+	WatchAsset(ctx context.Context, id uuid.UUID, send func(Asset) error) error
+	// HandleAssetStatusChanged wakes the WatchAsset calls of this process
+	// watching the asset.
+	HandleAssetStatusChanged(ctx context.Context, event AssetStatusChanged) error
 }
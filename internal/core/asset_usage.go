@@ -0,0 +1,68 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AssetAccessKind classifies how the media of an asset was accessed.
+type AssetAccessKind int
+
+const (
+	AssetAccessKindUnspecified AssetAccessKind = iota
+	// AssetAccessKindPlaybackURL is a playback URL handed to a player.
+	AssetAccessKindPlaybackURL
+	// AssetAccessKindDownload is a file fetched through the download endpoint.
+	AssetAccessKindDownload
+)
+
+// AssetUsageCounts counts the accesses of an asset's media.
+type AssetUsageCounts struct {
+	PlaybackURLsIssued int64
+	Downloads          int64
+}
+
+// DailyAssetUsage is the usage of an asset on a UTC day.
+type DailyAssetUsage struct {
+	// Day is the UTC midnight of the day.
+	Day    time.Time
+	Counts AssetUsageCounts
+}
+
+// AssetUsageQuery selects an asset and the UTC days [From, To) to report.
+// Zero bounds default to the 30 days ending today.
+type AssetUsageQuery struct {
+	AssetID uuid.UUID
+	From    time.Time
+	To      time.Time
+}
+
+// AssetUsage reports how often the media of an asset was accessed.
+type AssetUsage struct {
+	AssetID uuid.UUID
+	From    time.Time
+	To      time.Time
+	Total   AssetUsageCounts
+	// Days lists every day of the range, oldest first.
+	Days []DailyAssetUsage
+}
+
+// AssetUsageRepository persists daily access counts of assets.
+type AssetUsageRepository interface {
+	// IncrementAssetUsage counts one access of the asset on day, a UTC midnight.
+	IncrementAssetUsage(ctx context.Context, assetID uuid.UUID, kind AssetAccessKind, day time.Time) error
+	// ListAssetUsage returns the days within [from, to) the asset was
+	// accessed on, oldest first.
+	ListAssetUsage(ctx context.Context, assetID uuid.UUID, from, to time.Time) ([]DailyAssetUsage, error)
+}
+
+// AssetUsageService tracks which media is actually consumed, so content
+// owners can prune what nobody plays.
+type AssetUsageService interface {
+	// RecordAssetAccess counts an access of the asset's media.
+	RecordAssetAccess(ctx context.Context, assetID uuid.UUID, kind AssetAccessKind) error
+	// GetAssetUsage reports the daily access counts of an asset.
+	GetAssetUsage(ctx context.Context, query AssetUsageQuery) (*AssetUsage, error)
+}
@@ -0,0 +1,54 @@
+package core
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// AudioPackageRequest describes an audio file to package for HLS delivery.
+type AudioPackageRequest struct {
+	AssetID   uuid.UUID
+	SourceURL string
+	MimeType  string
+	// Encryption, when set, encrypts the segments with AES-128.
+	Encryption *AudioEncryption
+}
+
+// AudioEncryption describes the AES-128 key HLS segments are encrypted with.
+type AudioEncryption struct {
+	Key []byte
+	// KeyURI is where players fetch the key from; it is written to the
+	// playlists.
+	KeyURI string
+}
+
+// AudioPackage locates the HLS rendition of an audio file.
+type AudioPackage struct {
+	// ManifestURL is the master playlist listing a variant per bitrate.
+	ManifestURL string
+	// Bitrates lists the variant bitrates in kbit/s.
+	Bitrates []int
+}
+
+// AudioPackager segments audio files into HLS renditions.
+type AudioPackager interface {
+	// PackageAudio packages the audio, replacing any earlier rendition of
+	// the same asset.
+	PackageAudio(ctx context.Context, req AudioPackageRequest) (*AudioPackage, error)
+}
+
+// AudioPackagingService packages uploaded audio assets for adaptive delivery.
+type AudioPackagingService interface {
+	// PackageAsset packages a ready MP3 or AAC asset and records the
+	// manifest location on it. Assets played in a series with DRM enabled
+	// are encrypted under a new content key each time, so packaging again
+	// rotates the key.
+	PackageAsset(ctx context.Context, assetID uuid.UUID) (*Asset, error)
+	// HandleAssetReady packages assets as they become ready.
+	HandleAssetReady(ctx context.Context, event Event) error
+	// HandleProtectionEvent packages the packaged assets of an updated
+	// series or a created or updated episode again when their encryption
+	// no longer matches whether their series have DRM enabled.
+	HandleProtectionEvent(ctx context.Context, event Event) error
+}
@@ -0,0 +1,76 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditAction classifies the change recorded by an audit entry.
+type AuditAction int
+
+const (
+	AuditActionUnspecified AuditAction = iota
+	AuditActionCreate
+	AuditActionUpdate
+	AuditActionDelete
+)
+
+// AuditChange is the value of one field before and after a change, encoded
+// as JSON. Before is empty for created entities.
+type AuditChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// AuditEntry records who changed an entity, through which RPC, and how.
+type AuditEntry struct {
+	ID uuid.UUID
+	// ActorID is the caller that made the change; empty for changes made by
+	// background work.
+	ActorID string
+	// Procedure is the RPC that made the change, e.g.
+	// "/lession.v1.SeriesService/UpdateSeries".
+	Procedure  string
+	EntityType string
+	EntityID   string
+	Action     AuditAction
+	Changes    []AuditChange
+	CreatedAt  time.Time
+}
+
+// AuditListFilter describes filters and pagination for the audit log.
+type AuditListFilter struct {
+	EntityType string
+	EntityID   string
+	ActorID    string
+	PageSize   int
+	PageToken  string
+}
+
+// AuditRepository reads the audit log. Entries are written by the
+// persistence layer alongside the changes they describe.
+type AuditRepository interface {
+	// ListAuditEntries returns entries, newest first.
+	ListAuditEntries(ctx context.Context, filter AuditListFilter) ([]AuditEntry, string, error)
+}
+
+// AuditService exposes the audit log to administrators.
+type AuditService interface {
+	ListAuditEntries(ctx context.Context, filter AuditListFilter) ([]AuditEntry, string, error)
+}
+
+type procedureContextKey struct{}
+
+// NewProcedureContext returns a context carrying the RPC being served.
+func NewProcedureContext(ctx context.Context, procedure string) context.Context {
+	return context.WithValue(ctx, procedureContextKey{}, procedure)
+}
+
+// ProcedureFromContext returns the RPC stored in ctx, if any.
+func ProcedureFromContext(ctx context.Context) (string, bool) {
+	procedure, ok := ctx.Value(procedureContextKey{}).(string)
+	return procedure, ok && procedure != ""
+}
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+// EpisodeDraftRequest describes the lesson an editor wants drafted.
+type EpisodeDraftRequest struct {
+	Topic string
+	// Level is the CEFR level the lesson targets, such as "B1".
+	Level    string
+	Language string
+	// Length is the approximate running time of the script when read aloud.
+	Length time.Duration
+	// TenantID attributes the generation to a tenant for usage metering.
+	TenantID string
+}
+
+// DraftVocabularyItem is a word or phrase the drafted lesson teaches.
+type DraftVocabularyItem struct {
+	Term       string
+	Definition string
+	Example    string
+}
+
+// DraftQuizQuestion is a multiple-choice question on the drafted lesson.
+type DraftQuizQuestion struct {
+	Prompt      string
+	Choices     []string
+	AnswerIndex int
+	Explanation string
+}
+
+// GenerationUsage counts the model tokens a generation consumed.
+type GenerationUsage struct {
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// Total returns the tokens consumed in all.
+func (u GenerationUsage) Total() int {
+	return u.PromptTokens + u.CompletionTokens
+}
+
+// GeneratedEpisodeDraft is machine-written lesson material for an editor to
+// review before it becomes an episode.
+type GeneratedEpisodeDraft struct {
+	Title      string
+	Summary    string
+	Script     string
+	Vocabulary []DraftVocabularyItem
+	Questions  []DraftQuizQuestion
+	// Model names the language model that wrote the draft.
+	Model string
+	Usage GenerationUsage
+}
+
+// EpisodeDraftGenerator writes lesson drafts with a language model.
+type EpisodeDraftGenerator interface {
+	GenerateEpisodeDraft(ctx context.Context, req EpisodeDraftRequest) (*GeneratedEpisodeDraft, error)
+}
+
+// AuthoringService exposes machine-assisted authoring to adapters.
+type AuthoringService interface {
+	GenerateEpisodeDraft(ctx context.Context, req EpisodeDraftRequest) (*GeneratedEpisodeDraft, error)
+}
@@ -0,0 +1,70 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BackupFormatVersion is the version of the backup archive layout. Restore
+// rejects archives of other versions.
+const BackupFormatVersion = 1
+
+// BackupOptions selects what a backup contains besides the database rows.
+type BackupOptions struct {
+	// IncludeMedia adds a manifest of where the media of every asset is
+	// stored. The media itself stays with the upload provider.
+	IncludeMedia bool
+}
+
+// BackupManifest describes a backup archive.
+type BackupManifest struct {
+	FormatVersion int           `json:"format_version"`
+	CreatedAt     time.Time     `json:"created_at"`
+	Tables        []BackupTable `json:"tables"`
+	// Media counts the assets in the media manifest; zero when the backup
+	// has none.
+	Media int `json:"media"`
+}
+
+// BackupTable counts the rows a backup holds for a table.
+type BackupTable struct {
+	Name string `json:"name"`
+	Rows int    `json:"rows"`
+}
+
+// BackupMedia locates the stored media of an asset, so it can be copied
+// along with a backup when cloning an environment.
+type BackupMedia struct {
+	AssetID     uuid.UUID `json:"asset_id"`
+	AssetKey    string    `json:"asset_key"`
+	Provider    string    `json:"provider"`
+	PlaybackURL string    `json:"playback_url,omitempty"`
+	MimeType    string    `json:"mime_type"`
+	Filesize    int64     `json:"filesize"`
+}
+
+// BackupRepository reads and writes the rows of every table, each encoded
+// as a JSON object keyed by column name.
+type BackupRepository interface {
+	// BackupTables lists the tables in the order they restore in, tables
+	// before the tables referring to them.
+	BackupTables() []string
+	// DumpTable calls fn with every row of a table in primary key order.
+	DumpTable(ctx context.Context, table string, fn func(row json.RawMessage) error) error
+	// RestoreTable inserts the rows next returns, until it returns io.EOF,
+	// into a table that must be empty, and returns how many it inserted.
+	RestoreTable(ctx context.Context, table string, next func() (json.RawMessage, error)) (int, error)
+}
+
+// BackupService dumps the whole catalog into an archive and loads it back,
+// as a safety net before migrations and to clone environments.
+type BackupService interface {
+	Backup(ctx context.Context, w io.Writer, opts BackupOptions) (*BackupManifest, error)
+	// Restore loads an archive into an empty database migrated to the same
+	// schema, all or nothing.
+	Restore(ctx context.Context, r io.Reader) (*BackupManifest, error)
+}
@@ -0,0 +1,110 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InvoiceStatus denotes the payment state of an invoice.
+type InvoiceStatus int
+
+const (
+	InvoiceStatusUnspecified InvoiceStatus = iota
+	InvoiceStatusOpen
+	InvoiceStatusPaid
+	InvoiceStatusVoid
+)
+
+// Invoice records a charge a billing provider issued for a subscription period.
+type Invoice struct {
+	ID              uuid.UUID
+	SubscriptionID  uuid.UUID
+	UserID          string
+	BillingProvider string
+	ExternalID      string
+	AmountCents     int64
+	Currency        string
+	Status          InvoiceStatus
+	PeriodStart     time.Time
+	PeriodEnd       time.Time
+	HostedURL       string
+	PaidAt          *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// CheckoutRequest describes a hosted checkout for a learner purchasing a plan.
+type CheckoutRequest struct {
+	UserID     string
+	Plan       Plan
+	SuccessURL string
+	CancelURL  string
+}
+
+// CheckoutSession is a provider-hosted payment page the learner is sent to.
+type CheckoutSession struct {
+	ID        string
+	URL       string
+	ExpiresAt time.Time
+}
+
+// BillingEventType enumerates the provider notifications the service acts on.
+type BillingEventType int
+
+const (
+	BillingEventUnspecified BillingEventType = iota
+	// BillingEventCheckoutCompleted reports a paid checkout that started a subscription.
+	BillingEventCheckoutCompleted
+	// BillingEventSubscriptionUpdated reports a renewal or a scheduled cancellation.
+	BillingEventSubscriptionUpdated
+	// BillingEventSubscriptionDeleted reports a subscription that ended immediately.
+	BillingEventSubscriptionDeleted
+	// BillingEventInvoiceUpdated reports an invoice that was paid, failed or voided.
+	BillingEventInvoiceUpdated
+)
+
+// BillingEvent is a provider webhook normalized to the fields the service needs.
+// Fields irrelevant to the event type are left zero.
+type BillingEvent struct {
+	ID                     string
+	Type                   BillingEventType
+	ExternalSubscriptionID string
+	// UserID and PlanID are echoed back from the checkout request metadata.
+	UserID             string
+	PlanID             uuid.UUID
+	CurrentPeriodStart time.Time
+	CurrentPeriodEnd   time.Time
+	CancelAtPeriodEnd  bool
+	Invoice            *Invoice
+}
+
+// BillingProvider integrates a payment processor.
+type BillingProvider interface {
+	// Name identifies the provider in webhook routes and persisted records.
+	Name() string
+	CreateCheckoutSession(ctx context.Context, req CheckoutRequest) (*CheckoutSession, error)
+	// ParseWebhookEvent verifies the signature carried in the request header
+	// over payload and decodes it. Events the service does not act on decode
+	// with BillingEventUnspecified.
+	ParseWebhookEvent(payload []byte, header map[string][]string) (*BillingEvent, error)
+}
+
+// InvoiceRepository persists invoices received from billing providers.
+type InvoiceRepository interface {
+	// UpsertInvoice stores the invoice, replacing the status, amounts and
+	// payment time of an existing invoice with the same provider and external ID.
+	UpsertInvoice(ctx context.Context, invoice Invoice) (*Invoice, error)
+	// ListInvoices returns the learner's invoices, newest first.
+	ListInvoices(ctx context.Context, userID string) ([]Invoice, error)
+}
+
+// BillingService exposes checkout and webhook handling to adapters.
+type BillingService interface {
+	CreateCheckoutSession(ctx context.Context, userID string, planID uuid.UUID, successURL, cancelURL string) (*CheckoutSession, error)
+	// HandleWebhook applies a verified provider notification. Redelivered
+	// events are applied idempotently.
+	HandleWebhook(ctx context.Context, provider string, payload []byte, header map[string][]string) error
+	ListInvoices(ctx context.Context, userID string) ([]Invoice, error)
+}
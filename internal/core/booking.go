@@ -0,0 +1,115 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AvailabilitySlot is a window in which a teacher offers a one-to-one session.
+type AvailabilitySlot struct {
+	ID        uuid.UUID
+	TeacherID string
+	StartsAt  time.Time
+	EndsAt    time.Time
+	// Booked is set while a confirmed booking holds the slot.
+	Booked    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TimeRange is a half-open interval [StartsAt, EndsAt).
+type TimeRange struct {
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+// Overlaps reports whether r and other share any instant.
+func (r TimeRange) Overlaps(other TimeRange) bool {
+	return r.StartsAt.Before(other.EndsAt) && other.StartsAt.Before(r.EndsAt)
+}
+
+// BookingStatus enumerates the lifecycle states of a booking.
+type BookingStatus int
+
+const (
+	BookingStatusUnspecified BookingStatus = iota
+	BookingStatusConfirmed
+	BookingStatusCancelled
+)
+
+// Booking reserves a teacher's availability slot for a learner.
+type Booking struct {
+	ID        uuid.UUID
+	SlotID    uuid.UUID
+	TeacherID string
+	LearnerID string
+	StartsAt  time.Time
+	EndsAt    time.Time
+	Note      string
+	Status    BookingStatus
+	// CancelledAt, CancelledBy and CancelReason are set once the teacher or
+	// the learner cancels the booking.
+	CancelledAt  *time.Time
+	CancelledBy  string
+	CancelReason string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// AvailabilityFilter selects the slots starting in [From, To).
+type AvailabilityFilter struct {
+	TeacherID string
+	From      time.Time
+	To        time.Time
+	// IncludeBooked also returns slots already booked.
+	IncludeBooked bool
+}
+
+// BookingListFilter describes pagination and filtering options when listing bookings.
+type BookingListFilter struct {
+	PageSize  int
+	PageToken string
+	TeacherID string
+	LearnerID string
+	// From and To restrict bookings to those starting in [From, To); zero
+	// values leave the range open.
+	From   time.Time
+	To     time.Time
+	Status BookingStatus
+}
+
+// BookingRepository defines persistence operations for availability and
+// bookings. Conflicts are detected here, inside the write, so concurrent
+// requests cannot double-book a teacher or a learner.
+type BookingRepository interface {
+	// CreateAvailabilitySlots stores slots, failing with ErrAlreadyExists
+	// when one overlaps another slot of the same teacher.
+	CreateAvailabilitySlots(ctx context.Context, slots []AvailabilitySlot) ([]AvailabilitySlot, error)
+	GetAvailabilitySlot(ctx context.Context, id uuid.UUID) (*AvailabilitySlot, error)
+	// DeleteAvailabilitySlot fails with ErrInvalidState while the slot is
+	// booked; slots that were booked and cancelled are kept for history.
+	DeleteAvailabilitySlot(ctx context.Context, id uuid.UUID) error
+	ListAvailabilitySlots(ctx context.Context, filter AvailabilityFilter) ([]AvailabilitySlot, error)
+	// CreateBooking claims the booking's slot, failing with ErrAlreadyExists
+	// when the slot is taken or the learner has an overlapping confirmed
+	// booking.
+	CreateBooking(ctx context.Context, booking Booking) (*Booking, error)
+	GetBooking(ctx context.Context, id uuid.UUID) (*Booking, error)
+	// CancelBooking marks a confirmed booking cancelled and frees its slot,
+	// failing with ErrInvalidState when it is already cancelled.
+	CancelBooking(ctx context.Context, booking Booking) (*Booking, error)
+	ListBookings(ctx context.Context, filter BookingListFilter) ([]Booking, string, error)
+}
+
+// BookingService exposes one-to-one booking use cases to adapters.
+type BookingService interface {
+	AddAvailability(ctx context.Context, teacherID string, ranges []TimeRange) ([]AvailabilitySlot, error)
+	RemoveAvailability(ctx context.Context, teacherID string, slotID uuid.UUID) error
+	ListAvailability(ctx context.Context, filter AvailabilityFilter) ([]AvailabilitySlot, error)
+	BookSlot(ctx context.Context, slotID uuid.UUID, learnerID, note string) (*Booking, error)
+	GetBooking(ctx context.Context, id uuid.UUID) (*Booking, error)
+	CancelBooking(ctx context.Context, id uuid.UUID, userID, reason string) (*Booking, error)
+	ListBookings(ctx context.Context, filter BookingListFilter) ([]Booking, string, error)
+}
@@ -0,0 +1,37 @@
+package core
+
+import (
+	"context"
+	"slices"
+)
+
+// Caller identifies who issued a request, as established by the transport layer.
+type Caller struct {
+	UserID string
+	// APIKeyScopes holds the scopes of the API key the caller authenticated
+	// with; it is empty for users.
+	APIKeyScopes []string
+}
+
+// ManagesSeries reports whether the caller may act for series as its
+// publisher: one of its authors, or an API key scoped to every series or
+// to this one.
+func (c Caller) ManagesSeries(series Series) bool {
+	if len(c.APIKeyScopes) > 0 {
+		return APIKey{Scopes: c.APIKeyScopes}.ManagesSeries(series.ID)
+	}
+	return c.UserID != "" && slices.Contains(series.AuthorIDs, c.UserID)
+}
+
+type callerContextKey struct{}
+
+// NewCallerContext returns a context carrying the caller.
+func NewCallerContext(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerContextKey{}, caller)
+}
+
+// CallerFromContext returns the caller stored in ctx, if any.
+func CallerFromContext(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(callerContextKey{}).(Caller)
+	return caller, ok && caller.UserID != ""
+}
@@ -0,0 +1,31 @@
+package core
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// CatalogListFilter narrows the published series listed by the catalog.
+type CatalogListFilter struct {
+	PageSize        int
+	PageToken       string
+	Language        string
+	Level           string
+	EstimatedLevel  string
+	Tags            []string
+	Query           string
+	IncludeEpisodes bool
+	// IncludeTranscripts loads the transcripts of the embedded episodes.
+	IncludeTranscripts bool
+}
+
+// CatalogService is the read-only, public view of published content. It only
+// returns published series and their published episodes, stripped of author
+// and asset identifiers, and never depends on the caller so its results can
+// be cached and shared.
+type CatalogService interface {
+	ListSeries(ctx context.Context, filter CatalogListFilter) ([]Series, string, error)
+	GetSeries(ctx context.Context, id uuid.UUID, includeEpisodes bool) (*Series, error)
+	GetEpisode(ctx context.Context, id uuid.UUID) (*Episode, error)
+}
@@ -0,0 +1,62 @@
+package core
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// SpreadsheetFormat identifies the file format of an uploaded spreadsheet.
+type SpreadsheetFormat int
+
+const (
+	SpreadsheetFormatUnspecified SpreadsheetFormat = iota
+	// SpreadsheetFormatCSV is comma-separated values with a header row.
+	SpreadsheetFormatCSV
+	// SpreadsheetFormatXLSX is an Office Open XML workbook; only its first
+	// sheet is read.
+	SpreadsheetFormatXLSX
+)
+
+// SpreadsheetReader reads the rows of a spreadsheet as text cells. Rows may
+// have different lengths; missing trailing cells are empty.
+type SpreadsheetReader interface {
+	ReadRows(format SpreadsheetFormat, data []byte) ([][]string, error)
+}
+
+// CatalogImportRequest carries a spreadsheet of series and episodes to import.
+type CatalogImportRequest struct {
+	Format SpreadsheetFormat
+	Data   []byte
+	// DryRun only validates the rows.
+	DryRun bool
+	// AuthorIDs are recorded as the authors of every imported series.
+	AuthorIDs []string
+}
+
+// CatalogImportRowError reports why a spreadsheet row cannot be imported.
+type CatalogImportRowError struct {
+	// Row is the 1-based row number in the spreadsheet, the header being row 1.
+	Row int
+	// Column names the offending column; empty for problems with the whole row.
+	Column  string
+	Message string
+}
+
+// CatalogImportReport summarises an import. Nothing is created while any row
+// has an error.
+type CatalogImportReport struct {
+	Rows         int
+	SeriesCount  int
+	EpisodeCount int
+	// CreatedSeriesIDs lists the imported series in spreadsheet order; empty
+	// on dry runs and when rows have errors.
+	CreatedSeriesIDs []uuid.UUID
+	Errors           []CatalogImportRowError
+}
+
+// CatalogImportService imports series and their episodes from spreadsheets,
+// as draft content, to migrate existing course catalogs.
+type CatalogImportService interface {
+	ImportCatalog(ctx context.Context, req CatalogImportRequest) (*CatalogImportReport, error)
+}
@@ -0,0 +1,20 @@
+package core
+
+import "context"
+
+// CDNProvider purges content cached by a content delivery network.
+type CDNProvider interface {
+	// Invalidate purges the given CDN URLs. A URL ending in "*" purges
+	// every URL it prefixes.
+	Invalidate(ctx context.Context, urls []string) error
+}
+
+// CDNService serves media through a content delivery network.
+type CDNService interface {
+	// RewriteURL returns the CDN URL of a media URL served from a configured
+	// origin, or the URL unchanged.
+	RewriteURL(raw string) string
+	// HandleEvent purges the media of unpublished or deleted episodes and
+	// replaced asset renditions.
+	HandleEvent(ctx context.Context, event Event) error
+}
@@ -0,0 +1,120 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Classroom groups learners under a teacher so series can be assigned to them together.
+type Classroom struct {
+	ID          uuid.UUID
+	TeacherID   string
+	Name        string
+	Description string
+	LearnerIDs  []string
+	Assignments []ClassroomAssignment
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ClassroomAssignment asks every learner in a classroom to finish a series by a due date.
+type ClassroomAssignment struct {
+	ID          uuid.UUID
+	ClassroomID uuid.UUID
+	SeriesID    uuid.UUID
+	DueAt       time.Time
+	CreatedAt   time.Time
+}
+
+// ClassroomDraft contains user-modifiable classroom attributes.
+type ClassroomDraft struct {
+	Name        string
+	Description string
+	LearnerIDs  []string
+}
+
+// ClassroomListFilter describes pagination and filtering options when listing classrooms.
+type ClassroomListFilter struct {
+	PageSize  int
+	PageToken string
+	TeacherID string
+}
+
+// AssignmentStatus summarises a learner's progress on an assignment.
+type AssignmentStatus int
+
+const (
+	AssignmentStatusUnspecified AssignmentStatus = iota
+	AssignmentStatusNotStarted
+	AssignmentStatusInProgress
+	AssignmentStatusCompleted
+	// AssignmentStatusCompletedLate marks assignments finished after the due date.
+	AssignmentStatusCompletedLate
+	// AssignmentStatusOverdue marks unfinished assignments past the due date.
+	AssignmentStatusOverdue
+)
+
+// AssignmentProgress reports how far a learner got through an assigned series.
+// Only published episodes count towards completion.
+type AssignmentProgress struct {
+	AssignmentID      uuid.UUID
+	SeriesID          uuid.UUID
+	SeriesTitle       string
+	DueAt             time.Time
+	EpisodesCompleted int
+	EpisodeCount      int
+	Status            AssignmentStatus
+	// CompletedAt is when the last outstanding episode was first finished.
+	CompletedAt *time.Time
+}
+
+// StudentProgress collects a learner's progress across a classroom's assignments.
+type StudentProgress struct {
+	UserID               string
+	Assignments          []AssignmentProgress
+	AssignmentsCompleted int
+}
+
+// ClassroomReport is the teacher's per-student view of a classroom.
+type ClassroomReport struct {
+	Classroom   Classroom
+	Students    []StudentProgress
+	GeneratedAt time.Time
+}
+
+// ClassroomRepository defines persistence operations for classrooms.
+type ClassroomRepository interface {
+	CreateClassroom(ctx context.Context, classroom Classroom) (*Classroom, error)
+	GetClassroom(ctx context.Context, id uuid.UUID) (*Classroom, error)
+	UpdateClassroom(ctx context.Context, classroom Classroom) (*Classroom, error)
+	DeleteClassroom(ctx context.Context, id uuid.UUID) error
+	ListClassrooms(ctx context.Context, filter ClassroomListFilter) ([]Classroom, string, error)
+	// AddClassroomLearners enrols learners, ignoring those already enrolled.
+	AddClassroomLearners(ctx context.Context, id uuid.UUID, learnerIDs []string, joinedAt time.Time) (*Classroom, error)
+	RemoveClassroomLearners(ctx context.Context, id uuid.UUID, learnerIDs []string) (*Classroom, error)
+	// CreateClassroomAssignment fails with ErrAlreadyExists when the series is already assigned.
+	CreateClassroomAssignment(ctx context.Context, assignment ClassroomAssignment) (*ClassroomAssignment, error)
+	DeleteClassroomAssignment(ctx context.Context, classroomID, assignmentID uuid.UUID) error
+	// ListSeriesLearners returns the distinct learners enrolled in classrooms
+	// the series is assigned to.
+	ListSeriesLearners(ctx context.Context, seriesID uuid.UUID) ([]string, error)
+	// ListClassroomsWithAssignmentsDue returns classrooms with assignments due
+	// in [from, to); each classroom only lists those assignments.
+	ListClassroomsWithAssignmentsDue(ctx context.Context, from, to time.Time) ([]Classroom, error)
+}
+
+// ClassroomService exposes classroom use cases to adapters.
+type ClassroomService interface {
+	CreateClassroom(ctx context.Context, teacherID string, draft ClassroomDraft) (*Classroom, error)
+	GetClassroom(ctx context.Context, id uuid.UUID) (*Classroom, error)
+	UpdateClassroom(ctx context.Context, classroom Classroom) (*Classroom, error)
+	DeleteClassroom(ctx context.Context, id uuid.UUID) error
+	ListClassrooms(ctx context.Context, filter ClassroomListFilter) ([]Classroom, string, error)
+	AddClassroomLearners(ctx context.Context, id uuid.UUID, learnerIDs []string) (*Classroom, error)
+	RemoveClassroomLearners(ctx context.Context, id uuid.UUID, learnerIDs []string) (*Classroom, error)
+	AssignSeries(ctx context.Context, classroomID, seriesID uuid.UUID, dueAt time.Time) (*ClassroomAssignment, error)
+	RemoveAssignment(ctx context.Context, classroomID, assignmentID uuid.UUID) error
+	GetClassroomReport(ctx context.Context, id uuid.UUID) (*ClassroomReport, error)
+}
@@ -0,0 +1,38 @@
+package core
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// PackageFormat selects the LMS interchange standard a content package targets.
+type PackageFormat int
+
+const (
+	PackageFormatUnspecified PackageFormat = iota
+	// PackageFormatSCORM12 packages content as SCORM 1.2 SCOs.
+	PackageFormatSCORM12
+	// PackageFormatXAPI packages content as an xAPI (Tin Can) launchable course.
+	PackageFormatXAPI
+)
+
+// ContentPackage is a zip archive ready to be imported into an LMS.
+type ContentPackage struct {
+	Filename string
+	Data     []byte
+}
+
+// PackageBuilder renders a series and the supplied episodes as an LMS package.
+// Media is referenced by playback URL rather than copied into the archive.
+type PackageBuilder interface {
+	BuildPackage(format PackageFormat, series Series, episodes []Episode) (*ContentPackage, error)
+}
+
+// PackageExportService exposes LMS package export use cases to adapters.
+type PackageExportService interface {
+	// ExportSeriesPackage packages every published episode of a published series.
+	ExportSeriesPackage(ctx context.Context, seriesID uuid.UUID, format PackageFormat) (*ContentPackage, error)
+	// ExportEpisodePackage packages a single published episode.
+	ExportEpisodePackage(ctx context.Context, episodeID uuid.UUID, format PackageFormat) (*ContentPackage, error)
+}
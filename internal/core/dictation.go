@@ -0,0 +1,76 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DictationTokenOp classifies how an answer word compares to the transcript.
+type DictationTokenOp int
+
+const (
+	DictationTokenOpUnspecified DictationTokenOp = iota
+	DictationTokenOpMatch
+	DictationTokenOpMissing
+	DictationTokenOpExtra
+)
+
+// DictationToken is a single word of diff-based feedback for a dictation answer.
+type DictationToken struct {
+	Text string
+	Op   DictationTokenOp
+}
+
+// DictationItem references a timed transcript segment the learner should transcribe.
+type DictationItem struct {
+	EpisodeID uuid.UUID
+	Index     int
+	Start     time.Duration
+	End       time.Duration
+	AudioURL  string
+	WordCount int
+}
+
+// DictationAttempt records a learner's answer for a dictation item and its score.
+type DictationAttempt struct {
+	ID        uuid.UUID
+	UserID    string
+	EpisodeID uuid.UUID
+	ItemIndex int
+	Answer    string
+	Expected  string
+	Score     float64
+	Feedback  []DictationToken
+	CreatedAt time.Time
+}
+
+// SubmitDictationParams carries a learner's typed answer for a dictation item.
+type SubmitDictationParams struct {
+	UserID    string
+	EpisodeID uuid.UUID
+	ItemIndex int
+	Answer    string
+}
+
+// DictationAttemptFilter describes pagination and filtering options for attempts.
+type DictationAttemptFilter struct {
+	PageSize  int
+	PageToken string
+	UserID    string
+	EpisodeID uuid.UUID
+}
+
+// DictationAttemptRepository persists dictation practice attempts.
+type DictationAttemptRepository interface {
+	CreateDictationAttempt(ctx context.Context, attempt DictationAttempt) (*DictationAttempt, error)
+	ListDictationAttempts(ctx context.Context, filter DictationAttemptFilter) ([]DictationAttempt, string, error)
+}
+
+// DictationService exposes dictation practice use cases to adapters.
+type DictationService interface {
+	ListDictationItems(ctx context.Context, episodeID uuid.UUID) ([]DictationItem, error)
+	SubmitDictationAnswer(ctx context.Context, params SubmitDictationParams) (*DictationAttempt, error)
+	ListDictationAttempts(ctx context.Context, filter DictationAttemptFilter) ([]DictationAttempt, string, error)
+}
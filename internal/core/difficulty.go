@@ -0,0 +1,70 @@
+package core
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// CEFRLevels lists the levels of the Common European Framework of
+// Reference, easiest first.
+var CEFRLevels = []string{"A1", "A2", "B1", "B2", "C1", "C2"}
+
+// SeriesEstimatedLevel summarises the estimated levels of the episodes of a
+// series as their median, so a single outlier does not move the series.
+// Episodes without an estimate are ignored.
+func SeriesEstimatedLevel(episodeLevels []string) string {
+	var ranks []int
+	for _, level := range episodeLevels {
+		if rank := slices.Index(CEFRLevels, level); rank >= 0 {
+			ranks = append(ranks, rank)
+		}
+	}
+	if len(ranks) == 0 {
+		return ""
+	}
+	slices.Sort(ranks)
+	return CEFRLevels[ranks[len(ranks)/2]]
+}
+
+// EpisodeTextStats summarises the words of an episode's transcript.
+type EpisodeTextStats struct {
+	EpisodeID       uuid.UUID
+	WordCount       int
+	UniqueWordCount int
+	// SentenceCount counts lines instead when the transcript has no
+	// punctuation.
+	SentenceCount    int
+	WordsPerSentence float64
+	// WordsPerMinute is zero when neither the episode duration nor the
+	// transcript timings are known.
+	WordsPerMinute float64
+	TopWords       []WordFrequency
+	EstimatedLevel string
+}
+
+// WordFrequency counts the occurrences of a lower-cased word.
+type WordFrequency struct {
+	Word  string
+	Count int
+}
+
+// TextStatsOptions tunes the word frequencies of EpisodeTextStats.
+type TextStatsOptions struct {
+	// TopWords limits the frequencies returned.
+	TopWords int
+	// ExcludeCommonWords leaves the most common English words out.
+	ExcludeCommonWords bool
+}
+
+// DifficultyService estimates the CEFR level of episodes from their
+// transcripts.
+type DifficultyService interface {
+	// EstimateEpisodeLevel estimates and stores the level of an episode. The
+	// estimate is empty when the transcript is too short to judge.
+	EstimateEpisodeLevel(ctx context.Context, id uuid.UUID) (string, error)
+	// HandleEpisodeEvent estimates the level of the episode an
+	// EpisodeCreated or EpisodeUpdated event is about.
+	HandleEpisodeEvent(ctx context.Context, event Event) error
+}
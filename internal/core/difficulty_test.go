@@ -0,0 +1,23 @@
+package core
+
+import "testing"
+
+func TestSeriesEstimatedLevel(t *testing.T) {
+	tests := []struct {
+		name   string
+		levels []string
+		want   string
+	}{
+		{name: "none", levels: []string{"", ""}, want: ""},
+		{name: "single", levels: []string{"B1"}, want: "B1"},
+		{name: "median ignores outliers", levels: []string{"A2", "C2", "A2", "", "B1", "A2"}, want: "A2"},
+		{name: "even count takes the upper middle", levels: []string{"A1", "B2"}, want: "B2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SeriesEstimatedLevel(tt.levels); got != tt.want {
+				t.Fatalf("SeriesEstimatedLevel() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
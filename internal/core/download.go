@@ -0,0 +1,81 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DownloadFileKind identifies a file of an offline download bundle.
+type DownloadFileKind int
+
+const (
+	DownloadFileKindUnspecified DownloadFileKind = iota
+	// DownloadFileKindMedia is the episode's audio or video rendition.
+	DownloadFileKindMedia
+	// DownloadFileKindTranscript is the transcript in its stored format.
+	DownloadFileKindTranscript
+	// DownloadFileKindVocabulary is a JSON list of the episode's key words
+	// with their dictionary entries.
+	DownloadFileKindVocabulary
+)
+
+// DownloadFile is a file of a download bundle.
+type DownloadFile struct {
+	Kind DownloadFileKind
+	// Name is the file name to save the file under.
+	Name     string
+	MimeType string
+	// Size is the file size in bytes, zero when unknown.
+	Size int64
+	// URL is a signed URL the file can be fetched from without credentials
+	// until the bundle expires.
+	URL string
+}
+
+// DownloadBundle is the manifest of the files a mobile client stores to play
+// an episode offline.
+type DownloadBundle struct {
+	EpisodeID uuid.UUID
+	SeriesID  uuid.UUID
+	Title     string
+	// Version is the episode's update time; clients holding an older bundle
+	// should download it again.
+	Version             time.Time
+	TranslationLanguage string
+	Files               []DownloadFile
+	// ExpiresAt is when the signed file URLs stop working.
+	ExpiresAt time.Time
+}
+
+// DownloadFileRequest is a request for a bundle file made through its signed URL.
+type DownloadFileRequest struct {
+	EpisodeID           uuid.UUID
+	Kind                DownloadFileKind
+	TranslationLanguage string
+	ExpiresAt           time.Time
+	Signature           string
+}
+
+// DownloadFileContent is a bundle file ready to serve. Media is not proxied:
+// RedirectURL is set instead of Data.
+type DownloadFileContent struct {
+	Name        string
+	MimeType    string
+	Data        []byte
+	RedirectURL string
+}
+
+// DownloadService packages episodes for offline playback.
+type DownloadService interface {
+	// CreateDownloadBundle signs the files of a published episode for the
+	// caller. Episodes that are not previews need an active subscription.
+	// The vocabulary is translated into translationLanguage when the
+	// dictionary has translations.
+	CreateDownloadBundle(ctx context.Context, episodeID uuid.UUID, translationLanguage string) (*DownloadBundle, error)
+	// OpenDownloadFile checks the signature and expiry of a request and
+	// returns the file. Invalid or expired requests fail with
+	// ErrPermissionDenied.
+	OpenDownloadFile(ctx context.Context, req DownloadFileRequest) (*DownloadFileContent, error)
+}
@@ -0,0 +1,31 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ContentKey is an AES-128 key the HLS rendition of an asset is encrypted
+// with. Packaging an asset again creates a new key.
+type ContentKey struct {
+	ID        uuid.UUID
+	AssetID   uuid.UUID
+	Key       []byte
+	CreatedAt time.Time
+}
+
+// ContentKeyRepository persists content keys.
+type ContentKeyRepository interface {
+	CreateContentKey(ctx context.Context, key ContentKey) error
+	GetContentKey(ctx context.Context, id uuid.UUID) (*ContentKey, error)
+}
+
+// ContentKeyService hands content keys to players.
+type ContentKeyService interface {
+	// GetContentKey returns a key when the caller may play an episode
+	// using its asset: a published preview episode, or any published
+	// episode for a learner with an active subscription.
+	GetContentKey(ctx context.Context, id uuid.UUID) (*ContentKey, error)
+}
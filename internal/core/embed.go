@@ -0,0 +1,48 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmbedTokenRequest asks for an embed token for a published episode.
+type EmbedTokenRequest struct {
+	EpisodeID uuid.UUID
+	// AllowedDomains locks the token to pages on these hosts or their
+	// subdomains; the token works on any site when empty. The lock trusts
+	// the Origin and Referer headers, which only browsers set honestly, so
+	// it is not an access control.
+	AllowedDomains []string
+	// TTL is how long the token works; a default applies when zero.
+	TTL time.Duration
+}
+
+// EmbedToken lets an external site play a single published episode without
+// credentials of its own until it expires.
+type EmbedToken struct {
+	Token          string
+	EpisodeID      uuid.UUID
+	AllowedDomains []string
+	ExpiresAt      time.Time
+	// EmbedURL is the player page playing the episode with the token; empty
+	// when embedding is not configured.
+	EmbedURL string
+}
+
+// EmbedPlayback is what an embedded player receives for a valid token.
+type EmbedPlayback struct {
+	// Episode is the public view of the episode, with its playback URL.
+	Episode   Episode
+	ExpiresAt time.Time
+}
+
+// EmbedService issues embed tokens to publishers and exchanges them for
+// playback URLs on the sites they are embedded in.
+type EmbedService interface {
+	CreateEmbedToken(ctx context.Context, req EmbedTokenRequest) (*EmbedToken, error)
+	// EmbedPlayback validates a token for a page on domain, the host the
+	// playback request comes from, and returns the episode it plays.
+	EmbedPlayback(ctx context.Context, token, domain string) (*EmbedPlayback, error)
+}
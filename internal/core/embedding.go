@@ -0,0 +1,81 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ContentEmbedding is the vector embedding of a search document. The
+// filters and display fields of the document are kept alongside so
+// similarity queries can filter and render hits on their own.
+type ContentEmbedding struct {
+	DocumentID string
+	Kind       SearchKind
+	SeriesID   uuid.UUID
+	EpisodeID  uuid.UUID
+	Title      string
+	Snippet    string
+	Language   string
+	Level      string
+	Tags       []string
+	// Model names the embedding model; vectors of different models are not
+	// comparable.
+	Model string
+	// ContentHash identifies the text the vector was computed from, so
+	// unchanged documents are not embedded again.
+	ContentHash string
+	Vector      []float32
+	UpdatedAt   time.Time
+}
+
+// SimilarityQuery asks for the embeddings nearest to a vector. Kinds,
+// Language, Level and Tags narrow the results as in SearchQuery.
+type SimilarityQuery struct {
+	Vector   []float32
+	Model    string
+	Kinds    []SearchKind
+	Language string
+	Level    string
+	Tags     []string
+	// ExcludeIDs leaves out documents, such as the one similar ones are
+	// looked up for.
+	ExcludeIDs []string
+	Limit      int
+}
+
+// EmbeddingProvider turns text into vectors with an embedding model.
+type EmbeddingProvider interface {
+	// Model names the model vectors are computed with.
+	Model() string
+	// EmbedTexts returns one vector per text, in order.
+	EmbedTexts(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// EmbeddingRepository stores content embeddings and finds the nearest ones.
+type EmbeddingRepository interface {
+	GetEmbedding(ctx context.Context, documentID string) (*ContentEmbedding, error)
+	// SaveEmbeddings inserts embeddings or replaces those with the same
+	// document IDs.
+	SaveEmbeddings(ctx context.Context, embeddings ...ContentEmbedding) error
+	// DeleteEmbeddings removes embeddings; missing IDs are ignored.
+	DeleteEmbeddings(ctx context.Context, documentIDs ...string) error
+	// NearestEmbeddings returns hits for the embeddings most similar to the
+	// query vector, scored by cosine similarity, best first.
+	NearestEmbeddings(ctx context.Context, query SimilarityQuery) ([]SearchHit, error)
+}
+
+// SemanticSearchService finds published content by meaning rather than
+// keywords and keeps the embeddings in sync with the catalog.
+type SemanticSearchService interface {
+	SemanticSearch(ctx context.Context, query SearchQuery) ([]SearchHit, string, error)
+	// FindSimilarEpisodes returns the published episodes closest in meaning
+	// to an episode.
+	FindSimilarEpisodes(ctx context.Context, episodeID uuid.UUID, limit int) ([]SearchHit, error)
+	// HandleEvent updates the embeddings for a series or episode event.
+	HandleEvent(ctx context.Context, event Event) error
+	// ReembedContent embeds every published series and episode whose text
+	// changed, returning how many documents were processed.
+	ReembedContent(ctx context.Context) (int, error)
+}
@@ -13,4 +13,18 @@ var (
 	ErrUploadIdentifierRequired = errors.New("upload identifier required")
 	// ErrUploadInvalidState indicates an upload cannot transition from its current status.
 	ErrUploadInvalidState = errors.New("upload session is in an invalid state")
+	// ErrAlreadyExists indicates the resource being created conflicts with an existing one.
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrTranscriptNotTimed indicates a transcript has no timed segments to work with.
+	ErrTranscriptNotTimed = errors.New("transcript has no timed segments")
+	// ErrInvalidState indicates a resource cannot perform the requested transition from its current state.
+	ErrInvalidState = errors.New("invalid state")
+	// ErrSubscriptionRequired indicates the caller needs an active subscription to access the resource.
+	ErrSubscriptionRequired = errors.New("subscription required")
+	// ErrUnauthenticated indicates the request carries missing or invalid credentials.
+	ErrUnauthenticated = errors.New("unauthenticated")
+	// ErrPermissionDenied indicates the caller's credentials do not grant the requested operation.
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrTimeout indicates an operation ran out of time, such as a database query exceeding its timeout.
+	ErrTimeout = errors.New("timed out")
 )
@@ -0,0 +1,262 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType names a domain event, e.g. "series.published".
+type EventType string
+
+const (
+	EventTypeSeriesCreated    EventType = "series.created"
+	EventTypeSeriesUpdated    EventType = "series.updated"
+	EventTypeSeriesPublished  EventType = "series.published"
+	EventTypeEpisodeCreated   EventType = "episode.created"
+	EventTypeEpisodeUpdated   EventType = "episode.updated"
+	EventTypeEpisodePublished EventType = "episode.published"
+	// EventTypeEpisodeUnpublished fires when a published episode is moved
+	// back out of the published status.
+	EventTypeEpisodeUnpublished EventType = "episode.unpublished"
+	EventTypeEpisodeDeleted     EventType = "episode.deleted"
+	EventTypeAssetReady         EventType = "asset.ready"
+	// EventTypeAssetStatusChanged fires on every asset status transition.
+	EventTypeAssetStatusChanged EventType = "asset.status_changed"
+	// EventTypeAssetRenditionReplaced fires when an asset is served from new
+	// media, leaving copies of the old media cached downstream stale.
+	EventTypeAssetRenditionReplaced EventType = "asset.rendition_replaced"
+	// EventTypeAssetDurationChanged fires when the duration of an asset is
+	// corrected through UpdateAsset.
+	EventTypeAssetDurationChanged EventType = "asset.duration_changed"
+	// EventTypeModerationReviewRequested fires when text is held in the
+	// moderation queue for a moderator.
+	EventTypeModerationReviewRequested EventType = "moderation.review_requested"
+)
+
+// Event is a typed domain event. Use cases hand events to their repository,
+// which records them in the outbox in the same transaction as the change.
+type Event interface {
+	EventType() EventType
+	// AggregateID identifies the entity the event is about.
+	AggregateID() string
+}
+
+// SeriesCreated is emitted when a series is created, together with its
+// initial episodes.
+type SeriesCreated struct {
+	Series Series
+}
+
+func (SeriesCreated) EventType() EventType  { return EventTypeSeriesCreated }
+func (e SeriesCreated) AggregateID() string { return e.Series.ID.String() }
+
+// SeriesUpdated is emitted whenever a series is updated.
+type SeriesUpdated struct {
+	Series Series
+}
+
+func (SeriesUpdated) EventType() EventType  { return EventTypeSeriesUpdated }
+func (e SeriesUpdated) AggregateID() string { return e.Series.ID.String() }
+
+// SeriesPublished is emitted when a series is first published.
+type SeriesPublished struct {
+	Series Series
+}
+
+func (SeriesPublished) EventType() EventType  { return EventTypeSeriesPublished }
+func (e SeriesPublished) AggregateID() string { return e.Series.ID.String() }
+
+// EpisodeCreated is emitted when an episode is added to a series.
+type EpisodeCreated struct {
+	Episode Episode
+}
+
+func (EpisodeCreated) EventType() EventType  { return EventTypeEpisodeCreated }
+func (e EpisodeCreated) AggregateID() string { return e.Episode.ID.String() }
+
+// EpisodeUpdated is emitted whenever an episode is updated.
+type EpisodeUpdated struct {
+	Episode Episode
+}
+
+func (EpisodeUpdated) EventType() EventType  { return EventTypeEpisodeUpdated }
+func (e EpisodeUpdated) AggregateID() string { return e.Episode.ID.String() }
+
+// EpisodePublished is emitted when an episode is first published, either on
+// creation or by a later update.
+type EpisodePublished struct {
+	Episode Episode
+}
+
+func (EpisodePublished) EventType() EventType  { return EventTypeEpisodePublished }
+func (e EpisodePublished) AggregateID() string { return e.Episode.ID.String() }
+
+// EpisodeUnpublished is emitted when a published episode is updated to
+// another status.
+type EpisodeUnpublished struct {
+	Episode Episode
+}
+
+func (EpisodeUnpublished) EventType() EventType  { return EventTypeEpisodeUnpublished }
+func (e EpisodeUnpublished) AggregateID() string { return e.Episode.ID.String() }
+
+// EpisodeDeleted is emitted when an episode is deleted.
+type EpisodeDeleted struct {
+	Episode Episode
+}
+
+func (EpisodeDeleted) EventType() EventType  { return EventTypeEpisodeDeleted }
+func (e EpisodeDeleted) AggregateID() string { return e.Episode.ID.String() }
+
+// AssetReady is emitted when an uploaded asset becomes playable.
+type AssetReady struct {
+	Asset Asset
+}
+
+func (AssetReady) EventType() EventType  { return EventTypeAssetReady }
+func (e AssetReady) AggregateID() string { return e.Asset.ID.String() }
+
+// AssetStatusChanged is emitted whenever an asset moves to another status.
+type AssetStatusChanged struct {
+	Asset          Asset
+	PreviousStatus AssetStatus
+}
+
+func (AssetStatusChanged) EventType() EventType  { return EventTypeAssetStatusChanged }
+func (e AssetStatusChanged) AggregateID() string { return e.Asset.ID.String() }
+
+// AssetRenditionReplaced is emitted when the playback URL or HLS rendition of
+// an asset is replaced.
+type AssetRenditionReplaced struct {
+	Asset Asset
+	// PreviousURLs lists the replaced playback and HLS manifest URLs.
+	PreviousURLs []string
+}
+
+func (AssetRenditionReplaced) EventType() EventType  { return EventTypeAssetRenditionReplaced }
+func (e AssetRenditionReplaced) AggregateID() string { return e.Asset.ID.String() }
+
+// AssetDurationChanged is emitted when the duration of an asset is changed.
+type AssetDurationChanged struct {
+	Asset            Asset
+	PreviousDuration time.Duration
+}
+
+func (AssetDurationChanged) EventType() EventType  { return EventTypeAssetDurationChanged }
+func (e AssetDurationChanged) AggregateID() string { return e.Asset.ID.String() }
+
+// ModerationReviewRequested is emitted when submitted text is held for a moderator.
+type ModerationReviewRequested struct {
+	Item ModerationItem
+}
+
+func (ModerationReviewRequested) EventType() EventType  { return EventTypeModerationReviewRequested }
+func (e ModerationReviewRequested) AggregateID() string { return e.Item.ID.String() }
+
+// EventEnvelope carries an event to subscribers together with its identity.
+type EventEnvelope struct {
+	ID         uuid.UUID
+	OccurredAt time.Time
+	Event      Event
+}
+
+// EventHandler reacts to a published event. Handlers run outside the request
+// that emitted the event; delivery is at-least-once, so a handler may see the
+// same envelope again after any handler for it failed.
+type EventHandler func(ctx context.Context, envelope EventEnvelope) error
+
+// EventPublisher delivers relayed events to their subscribers.
+type EventPublisher interface {
+	// Publish returns once every subscriber has handled the envelope, joining
+	// their errors.
+	Publish(ctx context.Context, envelope EventEnvelope) error
+}
+
+// EventListener streams published events to live consumers such as
+// dashboards. Unlike subscribers, listeners are best effort: a listener that
+// falls behind misses events rather than holding up delivery.
+type EventListener interface {
+	// Listen returns a channel receiving published events of the given types,
+	// or of every type when none are given, and a function that stops
+	// listening and closes the channel.
+	Listen(types ...EventType) (<-chan EventEnvelope, func())
+}
+
+// DecodeEvent restores a typed event from its JSON encoding.
+func DecodeEvent(eventType EventType, payload []byte) (Event, error) {
+	var (
+		event Event
+		err   error
+	)
+	switch eventType {
+	case EventTypeSeriesCreated:
+		event, err = decodeEvent[SeriesCreated](payload)
+	case EventTypeSeriesUpdated:
+		event, err = decodeEvent[SeriesUpdated](payload)
+	case EventTypeSeriesPublished:
+		event, err = decodeEvent[SeriesPublished](payload)
+	case EventTypeEpisodeCreated:
+		event, err = decodeEvent[EpisodeCreated](payload)
+	case EventTypeEpisodeUpdated:
+		event, err = decodeEvent[EpisodeUpdated](payload)
+	case EventTypeEpisodePublished:
+		event, err = decodeEvent[EpisodePublished](payload)
+	case EventTypeEpisodeUnpublished:
+		event, err = decodeEvent[EpisodeUnpublished](payload)
+	case EventTypeEpisodeDeleted:
+		event, err = decodeEvent[EpisodeDeleted](payload)
+	case EventTypeAssetReady:
+		event, err = decodeEvent[AssetReady](payload)
+	case EventTypeAssetStatusChanged:
+		event, err = decodeEvent[AssetStatusChanged](payload)
+	case EventTypeAssetRenditionReplaced:
+		event, err = decodeEvent[AssetRenditionReplaced](payload)
+	case EventTypeAssetDurationChanged:
+		event, err = decodeEvent[AssetDurationChanged](payload)
+	case EventTypeModerationReviewRequested:
+		event, err = decodeEvent[ModerationReviewRequested](payload)
+	default:
+		return nil, fmt.Errorf("unknown event type %q", eventType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decode %s event: %w", eventType, err)
+	}
+	return event, nil
+}
+
+func decodeEvent[T Event](payload []byte) (Event, error) {
+	var event T
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// StoredEvent is a domain event as persisted in the event log.
+type StoredEvent struct {
+	ID          uuid.UUID
+	Type        EventType
+	AggregateID string
+	// Payload is the JSON encoding of the event.
+	Payload    []byte
+	OccurredAt time.Time
+}
+
+// EventListFilter describes filters and pagination for the event log.
+type EventListFilter struct {
+	Types       []EventType
+	AggregateID string
+	PageSize    int
+	PageToken   string
+}
+
+// EventRepository persists the domain event log.
+type EventRepository interface {
+	AppendEvent(ctx context.Context, event StoredEvent) error
+	// ListEvents returns stored events, newest first.
+	ListEvents(ctx context.Context, filter EventListFilter) ([]StoredEvent, string, error)
+}
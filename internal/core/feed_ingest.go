@@ -0,0 +1,137 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FeedKind identifies the kind of external feed a series is ingested from.
+type FeedKind int
+
+const (
+	FeedKindUnspecified FeedKind = iota
+	// FeedKindYouTubePlaylist is a YouTube playlist; its videos are always
+	// played from YouTube.
+	FeedKindYouTubePlaylist
+	// FeedKindPodcast is a podcast RSS feed with media enclosures.
+	FeedKindPodcast
+)
+
+// Feed is an external feed as fetched, its items newest first.
+type Feed struct {
+	Title       string
+	Description string
+	// Language is the ISO 639-1 code of the feed, empty when not declared.
+	Language string
+	ImageURL string
+	Items    []FeedItem
+}
+
+// FeedItem is one video or podcast episode of a feed.
+type FeedItem struct {
+	// GUID identifies the item within its feed across fetches.
+	GUID        string
+	Title       string
+	Description string
+	// MediaURL is where the item is played, or downloaded from when the
+	// subscription copies media.
+	MediaURL  string
+	MediaType MediaType
+	MimeType  string
+	// ContentLength is the declared size of the media, zero when unknown.
+	ContentLength int64
+	Duration      time.Duration
+	PublishedAt   time.Time
+}
+
+// FeedFetcher loads external feeds.
+type FeedFetcher interface {
+	FetchFeed(ctx context.Context, kind FeedKind, sourceURL string) (*Feed, error)
+}
+
+// MediaTransferer copies media from a URL to an upload target issued by the
+// upload pipeline, returning the number of bytes copied.
+type MediaTransferer interface {
+	TransferMedia(ctx context.Context, sourceURL string, target UploadTarget) (int64, error)
+}
+
+// FeedSubscription keeps a series in sync with an external feed. New items
+// are added as episodes on every sync; items already ingested are never
+// changed or removed.
+type FeedSubscription struct {
+	ID        uuid.UUID
+	SeriesID  uuid.UUID
+	Kind      FeedKind
+	SourceURL string
+	// DownloadMedia copies the media of new items through the upload pipeline
+	// instead of playing them from the feed. Only set it for content the
+	// platform is licensed to host; YouTube playlists cannot be downloaded.
+	DownloadMedia bool
+	// AutoPublish publishes new episodes; otherwise they are drafts.
+	AutoPublish  bool
+	LastSyncedAt *time.Time
+	// LastError is the error of the last sync, empty when it succeeded.
+	LastError string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// FeedSubscriptionRequest describes a feed to ingest into a new series.
+type FeedSubscriptionRequest struct {
+	Kind          FeedKind
+	SourceURL     string
+	DownloadMedia bool
+	AutoPublish   bool
+	// Slug names the new series; it defaults to one derived from the feed title.
+	Slug      string
+	Level     string
+	AuthorIDs []string
+}
+
+// FeedSyncResult reports the outcome of syncing a subscription.
+type FeedSyncResult struct {
+	Subscription FeedSubscription
+	// CreatedEpisodeIDs lists the episodes added by the sync.
+	CreatedEpisodeIDs []uuid.UUID
+}
+
+// FeedSubscriptionListFilter describes pagination options when listing subscriptions.
+type FeedSubscriptionListFilter struct {
+	PageSize  int
+	PageToken string
+}
+
+// FeedSubscriptionRepository persists feed subscriptions and the items ingested from them.
+type FeedSubscriptionRepository interface {
+	// CreateFeedSubscription returns ErrAlreadyExists when the series already has a subscription.
+	CreateFeedSubscription(ctx context.Context, subscription FeedSubscription) (*FeedSubscription, error)
+	GetFeedSubscription(ctx context.Context, id uuid.UUID) (*FeedSubscription, error)
+	// UpdateFeedSubscription records the outcome of a sync.
+	UpdateFeedSubscription(ctx context.Context, subscription FeedSubscription) (*FeedSubscription, error)
+	// DeleteFeedSubscription removes a subscription and its item log; the
+	// series and its episodes are kept.
+	DeleteFeedSubscription(ctx context.Context, id uuid.UUID) error
+	ListFeedSubscriptions(ctx context.Context, filter FeedSubscriptionListFilter) ([]FeedSubscription, string, error)
+	// ListFeedItemGUIDs returns the GUIDs of the items already ingested.
+	ListFeedItemGUIDs(ctx context.Context, subscriptionID uuid.UUID) ([]string, error)
+	// RecordFeedItem remembers that an item was ingested as an episode.
+	RecordFeedItem(ctx context.Context, subscriptionID uuid.UUID, guid string, episodeID uuid.UUID) error
+}
+
+// FeedIngestService ingests series from YouTube playlists and podcast feeds
+// and keeps them in sync.
+type FeedIngestService interface {
+	// CreateFeedSubscription creates a draft series from a feed, ingests its
+	// current items and keeps syncing new ones.
+	CreateFeedSubscription(ctx context.Context, req FeedSubscriptionRequest) (*FeedSyncResult, error)
+	GetFeedSubscription(ctx context.Context, id uuid.UUID) (*FeedSubscription, error)
+	ListFeedSubscriptions(ctx context.Context, filter FeedSubscriptionListFilter) ([]FeedSubscription, string, error)
+	DeleteFeedSubscription(ctx context.Context, id uuid.UUID) error
+	// SyncFeedSubscription adds the new items of a feed as episodes.
+	SyncFeedSubscription(ctx context.Context, id uuid.UUID) (*FeedSyncResult, error)
+	// SyncFeedSubscriptions syncs every subscription, returning how many
+	// episodes were added.
+	SyncFeedSubscriptions(ctx context.Context) (int, error)
+}
@@ -0,0 +1,9 @@
+package core
+
+import "context"
+
+// HealthChecker is implemented by dependencies that can report whether they
+// are reachable. Readiness probes call it on every dependency that does.
+type HealthChecker interface {
+	CheckHealth(ctx context.Context) error
+}
@@ -0,0 +1,73 @@
+package core
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Standard image variant names.
+const (
+	ImageVariantThumbnail = "thumbnail"
+	ImageVariantCard      = "card"
+	ImageVariantHero      = "hero"
+)
+
+// ImageVariantSpec describes a resized rendition of an image. The image is
+// cropped to the aspect ratio of the spec and scaled down to fit; it is
+// never scaled up.
+type ImageVariantSpec struct {
+	Name   string
+	Width  int
+	Height int
+}
+
+// ImageVariant locates a resized rendition of an image asset.
+type ImageVariant struct {
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// ImageLimits bounds the dimensions of images accepted for processing.
+type ImageLimits struct {
+	MinWidth  int
+	MinHeight int
+	MaxWidth  int
+	MaxHeight int
+}
+
+// ImageProcessRequest describes an uploaded image to validate and resize.
+type ImageProcessRequest struct {
+	AssetID   uuid.UUID
+	SourceURL string
+	Limits    ImageLimits
+	Variants  []ImageVariantSpec
+}
+
+// ProcessedImage describes a validated image and its variants.
+type ProcessedImage struct {
+	// Format is the decoded image format, such as "jpeg" or "png".
+	Format   string
+	Width    int
+	Height   int
+	Variants []ImageVariant
+}
+
+// ImageProcessor validates and resizes uploaded images.
+type ImageProcessor interface {
+	// ProcessImage writes the variants of the image, replacing any earlier
+	// ones of the same asset. Images that cannot be decoded or fall outside
+	// the limits fail with ErrValidation.
+	ProcessImage(ctx context.Context, req ImageProcessRequest) (*ProcessedImage, error)
+}
+
+// ImageService processes uploaded image assets.
+type ImageService interface {
+	// ProcessAsset validates a ready image asset and records its dimensions
+	// and variants on it. Invalid images fail the asset.
+	ProcessAsset(ctx context.Context, assetID uuid.UUID) (*Asset, error)
+	// HandleAssetReady processes image assets as they become ready.
+	HandleAssetReady(ctx context.Context, event Event) error
+}
@@ -0,0 +1,86 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DictionaryEntry describes a word for a learner reading a transcript.
+type DictionaryEntry struct {
+	// Word is the lower-cased word as it appears in the text.
+	Word string
+	// Lemma is the dictionary form of the word, such as "run" for "ran".
+	Lemma        string
+	PartOfSpeech string
+	Definition   string
+	// Translation is into the language the lookup asked for, and empty
+	// when the dictionary has none.
+	Translation string
+}
+
+// DictionaryProvider looks words up in a dictionary.
+type DictionaryProvider interface {
+	// LookupWords returns entries for those of the lower-cased words the
+	// dictionary knows, keyed by word. sourceLanguage is the BCP 47 tag of
+	// the language the words are in and targetLanguage that of the
+	// translations; an empty targetLanguage asks for none.
+	LookupWords(ctx context.Context, sourceLanguage, targetLanguage string, words []string) (map[string]DictionaryEntry, error)
+}
+
+// TranscriptToken is a run of transcript text. Concatenating the tokens of
+// a segment reproduces its text.
+type TranscriptToken struct {
+	Text string
+	// Word is the lower-cased form of a word token, used to find its
+	// dictionary entry, and empty for spaces and punctuation.
+	Word string
+}
+
+// InteractiveSegment is a transcript segment split into tokens. Plain and
+// Markdown transcripts have a single untimed segment.
+type InteractiveSegment struct {
+	Index  int
+	Start  time.Duration
+	End    time.Duration
+	Tokens []TranscriptToken
+}
+
+// InteractiveTranscript is an episode transcript prepared for tap-to-translate
+// reading, with the dictionary entries of its words.
+type InteractiveTranscript struct {
+	EpisodeID uuid.UUID
+	// Language is the BCP 47 tag of the transcript.
+	Language string
+	// TranslationLanguage is the BCP 47 tag translations are in.
+	TranslationLanguage string
+	Segments            []InteractiveSegment
+	// Entries holds an entry for each word the dictionary knows, ordered
+	// by word.
+	Entries []DictionaryEntry
+}
+
+// InteractiveTranscriptKey identifies a cached interactive transcript. The
+// episode's update time is part of the key, so edits are never served stale.
+type InteractiveTranscriptKey struct {
+	EpisodeID           uuid.UUID
+	TranslationLanguage string
+	EpisodeUpdatedAt    time.Time
+}
+
+// InteractiveTranscriptCache keeps interactive transcripts so dictionary
+// lookups are made once per episode and language. Cache failures are
+// treated as misses.
+type InteractiveTranscriptCache interface {
+	GetInteractiveTranscript(ctx context.Context, key InteractiveTranscriptKey) (*InteractiveTranscript, bool)
+	SetInteractiveTranscript(ctx context.Context, key InteractiveTranscriptKey, transcript InteractiveTranscript)
+}
+
+// InteractiveTranscriptService serves transcripts for tap-to-translate reading.
+type InteractiveTranscriptService interface {
+	// GetInteractiveTranscript splits the transcript of an episode into
+	// tokens and looks its words up, translating into translationLanguage
+	// when it is not empty.
+	GetInteractiveTranscript(ctx context.Context, episodeID uuid.UUID, translationLanguage string) (*InteractiveTranscript, error)
+}
@@ -0,0 +1,101 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobStatus tracks a background job through the queue.
+type JobStatus int
+
+const (
+	JobStatusUnspecified JobStatus = iota
+	// JobStatusPending jobs wait for a worker once RunAt has passed.
+	JobStatusPending
+	// JobStatusRunning jobs are leased by a worker until LockedUntil.
+	JobStatusRunning
+	JobStatusSucceeded
+	// JobStatusFailed jobs exhausted their retries and wait for RetryJob.
+	JobStatusFailed
+)
+
+// Job is a unit of asynchronous work executed by a worker.
+type Job struct {
+	ID   uuid.UUID
+	Kind string
+	// Payload is the handler-specific JSON input.
+	Payload []byte
+	Status  JobStatus
+	// Attempts counts the runs started so far.
+	Attempts  int
+	LastError string
+	// DedupeKey, when set, makes enqueueing the same work twice a no-op.
+	DedupeKey string
+	RunAt     time.Time
+	// LockedBy names the worker holding the lease of a running job.
+	LockedBy    string
+	LockedUntil *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	FinishedAt  *time.Time
+}
+
+// JobRequest describes work to enqueue.
+type JobRequest struct {
+	Kind      string
+	Payload   []byte
+	DedupeKey string
+	// RunAt delays the job; zero runs it as soon as a worker is free.
+	RunAt time.Time
+}
+
+// JobClaim describes the jobs a worker is ready to lease.
+type JobClaim struct {
+	Worker string
+	// Kinds restricts the claim to the kinds the worker can handle.
+	Kinds []string
+	Now   time.Time
+	// Lease is how long the worker holds the jobs before another worker may
+	// take them over.
+	Lease time.Duration
+	Limit int
+}
+
+// JobListFilter describes filters and pagination for the job list.
+type JobListFilter struct {
+	Kinds     []string
+	Statuses  []JobStatus
+	PageSize  int
+	PageToken string
+}
+
+// JobHandler executes one job. A returned error schedules a retry.
+type JobHandler func(ctx context.Context, job Job) error
+
+// JobRepository persists the job queue.
+type JobRepository interface {
+	// CreateJob returns ErrAlreadyExists when a job with the same dedupe key exists.
+	CreateJob(ctx context.Context, job Job) (*Job, error)
+	GetJob(ctx context.Context, id uuid.UUID) (*Job, error)
+	UpdateJob(ctx context.Context, job Job) (*Job, error)
+	// ListJobs returns jobs, newest first.
+	ListJobs(ctx context.Context, filter JobListFilter) ([]Job, string, error)
+	// ClaimJobs leases due pending jobs, and running jobs whose lease expired,
+	// without blocking on jobs other workers are claiming concurrently.
+	ClaimJobs(ctx context.Context, claim JobClaim) ([]Job, error)
+}
+
+// JobQueue accepts work from producers.
+type JobQueue interface {
+	Enqueue(ctx context.Context, req JobRequest) (*Job, error)
+}
+
+// JobService exposes the job queue to producers and administrators.
+type JobService interface {
+	JobQueue
+	ListJobs(ctx context.Context, filter JobListFilter) ([]Job, string, error)
+	// RetryJob requeues a failed job with a fresh set of attempts.
+	RetryJob(ctx context.Context, id uuid.UUID) (*Job, error)
+}
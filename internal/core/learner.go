@@ -0,0 +1,50 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+// LearnerActivity aggregates a learner's practice for a single UTC day.
+type LearnerActivity struct {
+	UserID            string
+	Day               time.Time
+	MinutesListened   int
+	EpisodesCompleted int
+}
+
+// LearnerStats summarizes a learner's lifetime activity and streaks.
+type LearnerStats struct {
+	UserID                 string
+	CurrentStreakDays      int
+	LongestStreakDays      int
+	ActiveDays             int
+	TotalMinutesListened   int
+	TotalEpisodesCompleted int
+	LastActiveDay          *time.Time
+}
+
+// WeeklySummary aggregates learner activity for a Monday-based UTC week.
+type WeeklySummary struct {
+	WeekStart         time.Time
+	ActiveDays        int
+	MinutesListened   int
+	EpisodesCompleted int
+}
+
+// LearnerActivityRepository persists daily learner activity.
+type LearnerActivityRepository interface {
+	// RecordActivity adds the supplied counters to the learner's totals for the given day.
+	RecordActivity(ctx context.Context, activity LearnerActivity) (*LearnerActivity, error)
+	// ListActivity returns the learner's days on or after since, ordered by day ascending.
+	ListActivity(ctx context.Context, userID string, since time.Time) ([]LearnerActivity, error)
+	// ListActiveUsers returns the learners with any activity on the given day.
+	ListActiveUsers(ctx context.Context, day time.Time) ([]string, error)
+}
+
+// LearnerStatsService exposes learner activity tracking and gamification stats.
+type LearnerStatsService interface {
+	RecordActivity(ctx context.Context, activity LearnerActivity) (*LearnerActivity, error)
+	GetLearnerStats(ctx context.Context, userID string) (*LearnerStats, error)
+	ListWeeklySummaries(ctx context.Context, userID string, weeks int) ([]WeeklySummary, error)
+}
@@ -0,0 +1,43 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LinkPreview describes how a shared link to a published series or episode
+// unfurls and how its player is embedded by third-party sites.
+type LinkPreview struct {
+	// URL is the canonical page URL; empty when page URLs are not configured.
+	URL       string
+	SeriesID  uuid.UUID
+	EpisodeID uuid.UUID
+	Title     string
+	// Description is the series summary or the episode description.
+	Description string
+	ImageURL    string
+	// ImageWidth and ImageHeight are the size of the card rendition covers
+	// are served as.
+	ImageWidth  int
+	ImageHeight int
+	// Duration is the length of the episode, or of every published episode
+	// of a series.
+	Duration  time.Duration
+	MediaType MediaType
+	// EmbedURL is the player page to embed in an iframe of EmbedWidth by
+	// EmbedHeight pixels; empty when embedding is not configured.
+	EmbedURL    string
+	EmbedWidth  int
+	EmbedHeight int
+}
+
+// LinkPreviewService previews published series and episodes. Drafts and
+// archived content are reported as not found.
+type LinkPreviewService interface {
+	// PreviewURL previews the series or episode a page URL points at.
+	PreviewURL(ctx context.Context, rawURL string) (*LinkPreview, error)
+	PreviewSeries(ctx context.Context, id uuid.UUID) (*LinkPreview, error)
+	PreviewEpisode(ctx context.Context, id uuid.UUID) (*LinkPreview, error)
+}
@@ -0,0 +1,148 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LTIMessageType distinguishes the LTI 1.3 launches the tool accepts.
+type LTIMessageType int
+
+const (
+	LTIMessageTypeUnspecified LTIMessageType = iota
+	// LTIMessageTypeResourceLink opens an embedded episode for a learner.
+	LTIMessageTypeResourceLink
+	// LTIMessageTypeDeepLinking asks an instructor to pick episodes to embed.
+	LTIMessageTypeDeepLinking
+)
+
+// LTIPlatform is an LMS registration (e.g. a Moodle or Canvas instance)
+// allowed to launch the tool.
+type LTIPlatform struct {
+	ID           uuid.UUID
+	Issuer       string
+	ClientID     string
+	DeploymentID string
+	AuthLoginURL string
+	AuthTokenURL string
+	JWKSURL      string
+	CreatedAt    time.Time
+}
+
+// LTILoginState binds an OIDC login initiation to the launch that completes it.
+type LTILoginState struct {
+	State      string
+	Nonce      string
+	PlatformID uuid.UUID
+	ExpiresAt  time.Time
+}
+
+// LTIMessage holds the verified claims of a platform's id_token.
+type LTIMessage struct {
+	Type           LTIMessageType
+	DeploymentID   string
+	Subject        string
+	Nonce          string
+	Name           string
+	Roles          []string
+	TargetLinkURI  string
+	ResourceLinkID string
+	// Custom carries the custom parameters set on the resource link.
+	Custom map[string]string
+	// LineItemURL is the AGS line item scores are posted to; empty when the
+	// platform did not grant grade passback.
+	LineItemURL string
+	// DeepLinkReturnURL and DeepLinkData are set on deep linking requests.
+	DeepLinkReturnURL string
+	DeepLinkData      string
+}
+
+// LTILaunch records a verified launch so later calls can act on it.
+type LTILaunch struct {
+	ID                uuid.UUID
+	PlatformID        uuid.UUID
+	Type              LTIMessageType
+	Subject           string
+	UserID            string
+	EpisodeID         uuid.UUID
+	ResourceLinkID    string
+	LineItemURL       string
+	DeepLinkReturnURL string
+	DeepLinkData      string
+	ScoreSubmittedAt  *time.Time
+	CreatedAt         time.Time
+}
+
+// LTIContentItem is an episode returned to the platform from deep linking.
+type LTIContentItem struct {
+	EpisodeID uuid.UUID
+	Title     string
+}
+
+// LTIScore is the completion result posted to the platform's gradebook.
+type LTIScore struct {
+	Subject      string
+	ScoreGiven   float64
+	ScoreMaximum float64
+	Completed    bool
+	Timestamp    time.Time
+}
+
+// LTIDeepLinkingResponse is the signed message the browser posts back to the platform.
+type LTIDeepLinkingResponse struct {
+	ReturnURL string
+	JWT       string
+}
+
+// LTIClient implements the LTI 1.3 security and service protocols.
+type LTIClient interface {
+	// RedirectURI is the tool's launch endpoint registered with platforms.
+	RedirectURI() string
+	// PublicKeySet returns the tool's public keys as a JWKS document.
+	PublicKeySet() ([]byte, error)
+	// VerifyLaunch validates an id_token against the platform's keys and registration.
+	VerifyLaunch(ctx context.Context, platform LTIPlatform, idToken string) (*LTIMessage, error)
+	SignDeepLinkingResponse(platform LTIPlatform, deploymentID, data string, items []LTIContentItem) (string, error)
+	// PublishScore posts a score to an AGS line item.
+	PublishScore(ctx context.Context, platform LTIPlatform, lineItemURL string, score LTIScore) error
+}
+
+// LTIRepository persists platform registrations, login state and launches.
+type LTIRepository interface {
+	CreatePlatform(ctx context.Context, platform LTIPlatform) (*LTIPlatform, error)
+	GetPlatform(ctx context.Context, id uuid.UUID) (*LTIPlatform, error)
+	// FindPlatform returns the registration for an issuer and client ID, or ErrNotFound.
+	FindPlatform(ctx context.Context, issuer, clientID string) (*LTIPlatform, error)
+	ListPlatforms(ctx context.Context) ([]LTIPlatform, error)
+	SaveLoginState(ctx context.Context, state LTILoginState) error
+	// ConsumeLoginState returns and deletes a login state so it cannot be replayed.
+	ConsumeLoginState(ctx context.Context, state string) (*LTILoginState, error)
+	CreateLaunch(ctx context.Context, launch LTILaunch) (*LTILaunch, error)
+	GetLaunch(ctx context.Context, id uuid.UUID) (*LTILaunch, error)
+	MarkScoreSubmitted(ctx context.Context, id uuid.UUID, at time.Time) (*LTILaunch, error)
+}
+
+// LTILoginRequest carries the platform's third-party login initiation.
+type LTILoginRequest struct {
+	Issuer         string
+	ClientID       string
+	LoginHint      string
+	LTIMessageHint string
+	TargetLinkURI  string
+}
+
+// LTIService exposes LTI tool provider use cases to adapters.
+type LTIService interface {
+	RegisterPlatform(ctx context.Context, platform LTIPlatform) (*LTIPlatform, error)
+	ListPlatforms(ctx context.Context) ([]LTIPlatform, error)
+	// InitiateLogin returns the platform authorization URL to redirect the browser to.
+	InitiateLogin(ctx context.Context, req LTILoginRequest) (string, error)
+	Launch(ctx context.Context, idToken, state string) (*LTILaunch, error)
+	GetLaunch(ctx context.Context, id uuid.UUID) (*LTILaunch, error)
+	CreateDeepLinkingResponse(ctx context.Context, launchID uuid.UUID, episodeIDs []uuid.UUID) (*LTIDeepLinkingResponse, error)
+	// SubmitScore posts full marks for the launch's episode once the learner has finished it.
+	SubmitScore(ctx context.Context, launchID uuid.UUID) (*LTILaunch, error)
+	PublicKeySet() ([]byte, error)
+}
@@ -0,0 +1,88 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UsageMetric enumerates the billable quantities tracked per tenant.
+type UsageMetric int
+
+const (
+	UsageMetricUnspecified UsageMetric = iota
+	UsageMetricStorageGBHours
+	UsageMetricProcessingMinutes
+	UsageMetricPlaybackMinutes
+	UsageMetricAPICalls
+	UsageMetricGenerationTokens
+)
+
+// UsageRecord is a single metered quantity reported for a tenant.
+type UsageRecord struct {
+	ID         uuid.UUID
+	TenantID   string
+	Metric     UsageMetric
+	Quantity   float64
+	OccurredAt time.Time
+}
+
+// UsageLine aggregates the quantity of one metric.
+type UsageLine struct {
+	Metric   UsageMetric
+	Quantity float64
+}
+
+// UsageSnapshot is an immutable monthly usage aggregate for a tenant.
+type UsageSnapshot struct {
+	ID          uuid.UUID
+	TenantID    string
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Lines       []UsageLine
+	CreatedAt   time.Time
+}
+
+// InvoiceLineItem describes a billable quantity derived from a usage snapshot.
+type InvoiceLineItem struct {
+	Metric      UsageMetric
+	Description string
+	Quantity    float64
+	Unit        string
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+}
+
+// UsageSnapshotFilter describes pagination and filtering options for snapshots.
+type UsageSnapshotFilter struct {
+	PageSize  int
+	PageToken string
+	TenantID  string
+}
+
+// MeteringRepository persists usage records and snapshots.
+type MeteringRepository interface {
+	RecordUsage(ctx context.Context, record UsageRecord) error
+	// SumUsage totals recorded usage per metric within [from, to).
+	SumUsage(ctx context.Context, tenantID string, from, to time.Time) ([]UsageLine, error)
+	// CreateUsageSnapshot stores a snapshot, returning ErrAlreadyExists when the
+	// tenant already has one for the period.
+	CreateUsageSnapshot(ctx context.Context, snapshot UsageSnapshot) (*UsageSnapshot, error)
+	GetUsageSnapshot(ctx context.Context, id uuid.UUID) (*UsageSnapshot, error)
+	ListUsageSnapshots(ctx context.Context, filter UsageSnapshotFilter) ([]UsageSnapshot, string, error)
+}
+
+// MeteringService exposes usage metering and invoicing exports to adapters.
+type MeteringService interface {
+	RecordUsage(ctx context.Context, record UsageRecord) error
+	CreateUsageSnapshot(ctx context.Context, tenantID string, month time.Time) (*UsageSnapshot, error)
+	ListUsageSnapshots(ctx context.Context, filter UsageSnapshotFilter) ([]UsageSnapshot, string, error)
+	ExportInvoiceLineItems(ctx context.Context, snapshotID uuid.UUID) ([]InvoiceLineItem, error)
+}
+
+// UsageCounter counts usage in memory and records it later in batches, so
+// counting adds no write to the request being counted.
+type UsageCounter interface {
+	CountUsage(tenantID string, metric UsageMetric, quantity float64)
+}
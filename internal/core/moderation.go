@@ -0,0 +1,129 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ModerationSubjectPlaylist is the subject type of public playlist titles
+// and descriptions.
+const ModerationSubjectPlaylist = "playlist"
+
+// ModerationStatus denotes where user-generated text stands in moderation.
+type ModerationStatus int
+
+const (
+	// ModerationStatusUnspecified is reported for content never submitted
+	// for moderation, such as content written before moderation existed.
+	ModerationStatusUnspecified ModerationStatus = iota
+	// ModerationStatusPending marks text waiting for a moderator.
+	ModerationStatusPending
+	ModerationStatusApproved
+	ModerationStatusRejected
+	// ModerationStatusFlagged marks text a moderator escalated for a second
+	// look; it stays hidden like pending text.
+	ModerationStatusFlagged
+)
+
+// Visible reports whether content in this status is shown to users other
+// than its author.
+func (s ModerationStatus) Visible() bool {
+	return s == ModerationStatusUnspecified || s == ModerationStatusApproved
+}
+
+// ModerationItem is a piece of user-generated text in the moderation queue.
+// Each subject has a single item, reset whenever its text changes.
+type ModerationItem struct {
+	ID          uuid.UUID
+	SubjectType string
+	SubjectID   uuid.UUID
+	AuthorID    string
+	Text        string
+	Status      ModerationStatus
+	// Labels name the categories the automatic filters matched, such as
+	// "profanity" or "toxicity".
+	Labels []string
+	// Score is the highest confidence any filter reported, from 0 to 1.
+	Score      float64
+	ReviewerID string
+	ReviewNote string
+	ReviewedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// ModerationSubmission describes text submitted for moderation.
+type ModerationSubmission struct {
+	SubjectType string
+	SubjectID   uuid.UUID
+	AuthorID    string
+	Text        string
+}
+
+// ModerationReview carries a moderator's decision on an item.
+type ModerationReview struct {
+	ItemID     uuid.UUID
+	ReviewerID string
+	Status     ModerationStatus
+	Note       string
+}
+
+// ModerationListFilter describes pagination and filtering options for the moderation queue.
+type ModerationListFilter struct {
+	PageSize    int
+	PageToken   string
+	SubjectType string
+	Statuses    []ModerationStatus
+}
+
+// TextClassification is the verdict of an automatic text filter.
+type TextClassification struct {
+	Flagged bool
+	Labels  []string
+	Score   float64
+}
+
+// TextClassifier automatically screens user-generated text, for example
+// against a profanity word list or a toxicity model.
+type TextClassifier interface {
+	ClassifyText(ctx context.Context, text string) (*TextClassification, error)
+}
+
+// TextFinding is a span of text a scanner matched, as byte offsets
+// [Start, End) into the scanned text.
+type TextFinding struct {
+	Kind  string
+	Start int
+	End   int
+}
+
+// TextScanner locates problematic spans of text, such as blocked words or
+// personal data, so they can be flagged or masked.
+type TextScanner interface {
+	ScanText(text string) []TextFinding
+}
+
+// ModerationRepository persists the moderation queue.
+type ModerationRepository interface {
+	// SaveModerationItem creates the subject's item or replaces it.
+	SaveModerationItem(ctx context.Context, item ModerationItem, events ...Event) (*ModerationItem, error)
+	GetModerationItem(ctx context.Context, id uuid.UUID) (*ModerationItem, error)
+	GetModerationItemBySubject(ctx context.Context, subjectType string, subjectID uuid.UUID) (*ModerationItem, error)
+	UpdateModerationReview(ctx context.Context, item ModerationItem) (*ModerationItem, error)
+	ListModerationItems(ctx context.Context, filter ModerationListFilter) ([]ModerationItem, string, error)
+	// ListModerationStatuses returns the status of every subject that has
+	// an item; subjects without one are left out.
+	ListModerationStatuses(ctx context.Context, subjectType string, subjectIDs []uuid.UUID) (map[uuid.UUID]ModerationStatus, error)
+}
+
+// ModerationService exposes moderation use cases to adapters and to the
+// services owning user-generated text.
+type ModerationService interface {
+	SubmitForModeration(ctx context.Context, submission ModerationSubmission) (*ModerationItem, error)
+	ModerationStatuses(ctx context.Context, subjectType string, subjectIDs []uuid.UUID) (map[uuid.UUID]ModerationStatus, error)
+	GetModerationItem(ctx context.Context, id uuid.UUID) (*ModerationItem, error)
+	ListModerationItems(ctx context.Context, filter ModerationListFilter) ([]ModerationItem, string, error)
+	ReviewModerationItem(ctx context.Context, review ModerationReview) (*ModerationItem, error)
+}
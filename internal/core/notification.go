@@ -0,0 +1,204 @@
+package core
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationKind enumerates the events learners can be notified about.
+type NotificationKind int
+
+const (
+	NotificationKindUnspecified NotificationKind = iota
+	// NotificationKindEpisodePublished announces a new episode in a series
+	// assigned to one of the learner's classrooms.
+	NotificationKindEpisodePublished
+	// NotificationKindAssignmentDue reminds learners of assignments due within a day.
+	NotificationKindAssignmentDue
+	// NotificationKindStreakReminder nudges learners who practised yesterday
+	// but not yet today, before their streak lapses.
+	NotificationKindStreakReminder
+	// NotificationKindAssetFailed tells the team an uploaded asset could not
+	// be processed. It is only delivered to team channels.
+	NotificationKindAssetFailed
+	// NotificationKindReviewRequested tells the team text is waiting in the
+	// moderation queue. It is only delivered to team channels.
+	NotificationKindReviewRequested
+)
+
+// NotificationChannel enumerates the ways a notification can be delivered.
+type NotificationChannel int
+
+const (
+	NotificationChannelUnspecified NotificationChannel = iota
+	NotificationChannelEmail
+	// NotificationChannelPush delivers to the user's registered mobile and web devices.
+	NotificationChannelPush
+	// NotificationChannelSlack posts to a Slack incoming webhook. Like
+	// Discord, it is a team channel: it reaches the team, never a user.
+	NotificationChannelSlack
+	// NotificationChannelDiscord posts to a Discord channel webhook.
+	NotificationChannelDiscord
+)
+
+// TeamChannel routes team notifications to a chat webhook such as a Slack
+// incoming webhook or a Discord channel webhook.
+type TeamChannel struct {
+	Channel NotificationChannel
+	// WebhookURL is the address messages are posted to.
+	WebhookURL string
+	// Kinds lists the notifications posted; every kind is posted when empty.
+	Kinds []NotificationKind
+}
+
+// Accepts reports whether the channel posts kind notifications.
+func (c TeamChannel) Accepts(kind NotificationKind) bool {
+	return len(c.Kinds) == 0 || slices.Contains(c.Kinds, kind)
+}
+
+// NotificationStatus tracks delivery of a single notification.
+type NotificationStatus int
+
+const (
+	NotificationStatusUnspecified NotificationStatus = iota
+	NotificationStatusPending
+	NotificationStatusSent
+	NotificationStatusFailed
+)
+
+// NotificationOptOut mutes one kind of notification on one channel. An
+// unspecified kind mutes the channel for every kind.
+type NotificationOptOut struct {
+	Kind    NotificationKind    `json:"kind"`
+	Channel NotificationChannel `json:"channel"`
+}
+
+// NotificationPreferences records where and in which language a user wants
+// to be notified, and what they opted out of. Users without stored
+// preferences receive every notification their contact details allow.
+type NotificationPreferences struct {
+	UserID string
+	Email  string
+	// Locale selects the notification templates, e.g. "zh"; the catalog
+	// default applies when empty.
+	Locale    string
+	OptOuts   []NotificationOptOut
+	UpdatedAt time.Time
+}
+
+// Allows reports whether the user accepts kind notifications on channel.
+func (p NotificationPreferences) Allows(kind NotificationKind, channel NotificationChannel) bool {
+	return !slices.ContainsFunc(p.OptOuts, func(optOut NotificationOptOut) bool {
+		return optOut.Channel == channel && (optOut.Kind == NotificationKindUnspecified || optOut.Kind == kind)
+	})
+}
+
+// Notification is a delivery attempt of one event to one user on one channel.
+type Notification struct {
+	ID uuid.UUID
+	// UserID is empty for notifications posted to team channels.
+	UserID  string
+	Kind    NotificationKind
+	Channel NotificationChannel
+	// DedupeKey identifies the event, recipient and channel so the same event
+	// is never delivered twice.
+	DedupeKey string
+	Subject   string
+	Body      string
+	Status    NotificationStatus
+	Error     string
+	CreatedAt time.Time
+	SentAt    *time.Time
+}
+
+// NotificationMessage is a rendered notification handed to a sender.
+type NotificationMessage struct {
+	UserID string
+	// To is the channel-specific address, e.g. an email address, a device
+	// token or a team channel's webhook URL.
+	To      string
+	Subject string
+	Body    string
+	// Data carries identifiers clients use to deep link, e.g. episode_id.
+	Data map[string]string
+}
+
+// NotificationSender delivers messages over one channel. Senders return an
+// error wrapping ErrNotFound when the address no longer exists, such as an
+// unregistered device token.
+type NotificationSender interface {
+	Channel() NotificationChannel
+	Send(ctx context.Context, message NotificationMessage) error
+}
+
+// DevicePlatform identifies the kind of device a push token belongs to.
+type DevicePlatform int
+
+const (
+	DevicePlatformUnspecified DevicePlatform = iota
+	DevicePlatformAndroid
+	DevicePlatformIOS
+	DevicePlatformWeb
+)
+
+// DeviceToken registers a device to receive push notifications for a user.
+// Tokens are unique; registering a token again moves it to the new user.
+type DeviceToken struct {
+	Token     string
+	UserID    string
+	Platform  DevicePlatform
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NotificationListFilter describes pagination options when listing a user's notifications.
+type NotificationListFilter struct {
+	UserID    string
+	PageSize  int
+	PageToken string
+}
+
+// NotificationRepository persists notification preferences and deliveries.
+type NotificationRepository interface {
+	GetNotificationPreferences(ctx context.Context, userID string) (*NotificationPreferences, error)
+	// ListNotificationPreferences returns the stored preferences of the given
+	// users; users without preferences are omitted.
+	ListNotificationPreferences(ctx context.Context, userIDs []string) ([]NotificationPreferences, error)
+	SaveNotificationPreferences(ctx context.Context, preferences NotificationPreferences) (*NotificationPreferences, error)
+	// CreateNotification fails with ErrAlreadyExists when the dedupe key was already used.
+	CreateNotification(ctx context.Context, notification Notification) (*Notification, error)
+	UpdateNotification(ctx context.Context, notification Notification) (*Notification, error)
+	ListNotifications(ctx context.Context, filter NotificationListFilter) ([]Notification, string, error)
+	SaveDeviceToken(ctx context.Context, token DeviceToken) (*DeviceToken, error)
+	// ListDeviceTokens returns the devices registered by the given users.
+	ListDeviceTokens(ctx context.Context, userIDs []string) ([]DeviceToken, error)
+	// DeleteDeviceToken removes a token; an empty user id matches any owner.
+	DeleteDeviceToken(ctx context.Context, userID, token string) error
+}
+
+// NotificationService exposes notification use cases to adapters.
+type NotificationService interface {
+	GetPreferences(ctx context.Context, userID string) (*NotificationPreferences, error)
+	UpdatePreferences(ctx context.Context, preferences NotificationPreferences) (*NotificationPreferences, error)
+	ListNotifications(ctx context.Context, filter NotificationListFilter) ([]Notification, string, error)
+	RegisterDevice(ctx context.Context, token DeviceToken) (*DeviceToken, error)
+	UnregisterDevice(ctx context.Context, userID, token string) error
+	// NotifyEpisodePublished notifies learners enrolled in classrooms the
+	// episode's series is assigned to, and the team channels, returning how
+	// many notifications were sent.
+	NotifyEpisodePublished(ctx context.Context, episode Episode) (int, error)
+	// NotifyAssetFailed tells the team channels an asset failed processing.
+	NotifyAssetFailed(ctx context.Context, asset Asset) (int, error)
+	// NotifyReviewRequested tells the team channels an item is waiting for
+	// a moderator.
+	NotifyReviewRequested(ctx context.Context, item ModerationItem) (int, error)
+	// SendAssignmentReminders notifies learners of assignments due within the
+	// next day. Each learner is reminded once per assignment.
+	SendAssignmentReminders(ctx context.Context) (int, error)
+	// SendStreakReminders notifies learners who were active yesterday but
+	// not yet today. Reminders go out once per day, in the evening UTC.
+	SendStreakReminders(ctx context.Context) (int, error)
+}
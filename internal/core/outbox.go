@@ -0,0 +1,40 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxMessage is a domain event recorded in the same transaction as the
+// change that produced it, waiting to be relayed to subscribers.
+type OutboxMessage struct {
+	ID          uuid.UUID
+	EventType   EventType
+	AggregateID string
+	// Payload is the JSON encoding of the event.
+	Payload       []byte
+	OccurredAt    time.Time
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+	// DispatchedAt is set once every subscriber handled the event.
+	DispatchedAt *time.Time
+}
+
+// OutboxRepository reads and updates the transactional outbox. Messages are
+// written by the repositories whose changes emit them.
+type OutboxRepository interface {
+	// ListPendingOutboxMessages returns undispatched messages due at now,
+	// oldest first.
+	ListPendingOutboxMessages(ctx context.Context, now time.Time, limit int) ([]OutboxMessage, error)
+	UpdateOutboxMessage(ctx context.Context, message OutboxMessage) error
+}
+
+// OutboxRelay publishes outbox messages to subscribers, guaranteeing
+// at-least-once delivery of every committed event.
+type OutboxRelay interface {
+	// RelayOutbox publishes due messages and reports how many were dispatched.
+	RelayOutbox(ctx context.Context) (int, error)
+}
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Playlist is a user-curated, ordered study list of episodes drawn from any series.
+type Playlist struct {
+	ID          uuid.UUID
+	OwnerID     string
+	Title       string
+	Description string
+	Public      bool
+	Slug        string
+	Items       []PlaylistItem
+	// ModerationStatus reports the review state of the title and
+	// description of public playlists. It is not stored with the playlist.
+	ModerationStatus ModerationStatus
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// PlaylistItem places an episode at a position within a playlist.
+type PlaylistItem struct {
+	EpisodeID uuid.UUID
+	Position  int
+	Episode   *Episode
+}
+
+// PlaylistDraft contains user-modifiable playlist attributes.
+type PlaylistDraft struct {
+	Title       string
+	Description string
+	Public      bool
+	Slug        string
+	EpisodeIDs  []uuid.UUID
+}
+
+// PlaylistQueryOptions customise loaded associations for a playlist.
+type PlaylistQueryOptions struct {
+	IncludeEpisodes bool
+}
+
+// PlaylistListFilter describes pagination and filtering options when listing playlists.
+type PlaylistListFilter struct {
+	PageSize        int
+	PageToken       string
+	OwnerID         string
+	IncludeEpisodes bool
+}
+
+// PlaylistRepository defines persistence operations for playlists.
+type PlaylistRepository interface {
+	CreatePlaylist(ctx context.Context, playlist Playlist) (*Playlist, error)
+	GetPlaylist(ctx context.Context, id uuid.UUID, opts PlaylistQueryOptions) (*Playlist, error)
+	GetPlaylistBySlug(ctx context.Context, slug string, opts PlaylistQueryOptions) (*Playlist, error)
+	UpdatePlaylist(ctx context.Context, playlist Playlist) (*Playlist, error)
+	ReplacePlaylistItems(ctx context.Context, id uuid.UUID, episodeIDs []uuid.UUID) (*Playlist, error)
+	DeletePlaylist(ctx context.Context, id uuid.UUID) error
+	ListPlaylists(ctx context.Context, filter PlaylistListFilter) ([]Playlist, string, error)
+}
+
+// PlaylistService exposes playlist use cases to adapters.
+type PlaylistService interface {
+	CreatePlaylist(ctx context.Context, ownerID string, draft PlaylistDraft) (*Playlist, error)
+	GetPlaylist(ctx context.Context, id uuid.UUID, opts PlaylistQueryOptions) (*Playlist, error)
+	GetSharedPlaylist(ctx context.Context, slug string, opts PlaylistQueryOptions) (*Playlist, error)
+	UpdatePlaylist(ctx context.Context, playlist Playlist) (*Playlist, error)
+	DeletePlaylist(ctx context.Context, id uuid.UUID) error
+	ListPlaylists(ctx context.Context, filter PlaylistListFilter) ([]Playlist, string, error)
+	AddPlaylistEpisodes(ctx context.Context, id uuid.UUID, episodeIDs []uuid.UUID) (*Playlist, error)
+	RemovePlaylistEpisode(ctx context.Context, id uuid.UUID, episodeID uuid.UUID) (*Playlist, error)
+	ReorderPlaylist(ctx context.Context, id uuid.UUID, episodeIDs []uuid.UUID) (*Playlist, error)
+}
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// PublishRule names a check of the publish checklist.
+type PublishRule string
+
+const (
+	// PublishRuleCover requires published series to have a cover.
+	PublishRuleCover PublishRule = "cover"
+	// PublishRuleSummary requires published series to have a summary of at
+	// least PublishChecklist.MinSummaryLength characters.
+	PublishRuleSummary PublishRule = "summary"
+	// PublishRuleReadyEpisode requires published series to have at least one
+	// ready or published episode.
+	PublishRuleReadyEpisode PublishRule = "ready_episode"
+	// PublishRuleTranscripts requires published episodes to have a transcript.
+	PublishRuleTranscripts PublishRule = "transcripts"
+)
+
+// PublishChecklist configures the checks series and episodes must pass when
+// they are published. An empty checklist lets everything be published.
+type PublishChecklist struct {
+	Rules []PublishRule
+	// MinSummaryLength is the number of characters PublishRuleSummary
+	// requires.
+	MinSummaryLength int
+}
+
+// Has reports whether the checklist includes rule.
+func (c PublishChecklist) Has(rule PublishRule) bool {
+	return slices.Contains(c.Rules, rule)
+}
+
+// PublishViolation describes a publish checklist rule a series or one of its
+// episodes breaks.
+type PublishViolation struct {
+	Rule     PublishRule
+	SeriesID uuid.UUID
+	// EpisodeID is set when the violation concerns a single episode.
+	EpisodeID   uuid.UUID
+	Description string
+}
+
+// PublishReadinessError lists the publish checklist rules that block a
+// series or episode from being published. It wraps ErrInvalidState.
+type PublishReadinessError struct {
+	Violations []PublishViolation
+}
+
+func (e *PublishReadinessError) Error() string {
+	descriptions := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		descriptions = append(descriptions, violation.Description)
+	}
+	return fmt.Sprintf("%v: not ready to publish: %s", ErrInvalidState, strings.Join(descriptions, "; "))
+}
+
+func (e *PublishReadinessError) Unwrap() error {
+	return ErrInvalidState
+}
@@ -0,0 +1,78 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QuizItemKind enumerates the kinds of quiz items.
+type QuizItemKind int
+
+const (
+	QuizItemKindUnspecified QuizItemKind = iota
+	// QuizItemKindCloze asks the learner to fill a gap left in a
+	// transcript sentence.
+	QuizItemKindCloze
+)
+
+// ClozeBlank marks the gap in the prompt of a cloze item.
+const ClozeBlank = "_____"
+
+// QuizItem is an exercise on an episode.
+type QuizItem struct {
+	ID        uuid.UUID
+	EpisodeID uuid.UUID
+	Kind      QuizItemKind
+	// Position orders the items of an episode.
+	Position int
+	// Prompt is the sentence with the answer replaced by ClozeBlank.
+	Prompt string
+	Answer string
+	// Sentence is the transcript sentence the item was made from.
+	Sentence string
+	// NeedsReview is set on generated items until an editor checks them.
+	NeedsReview bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// QuizItemFilter describes filtering options when listing quiz items.
+type QuizItemFilter struct {
+	EpisodeID uuid.UUID
+	Kind      QuizItemKind
+	// NeedsReview restricts the items to those with the flag set or clear.
+	NeedsReview *bool
+}
+
+// ClozeOptions tunes cloze generation.
+type ClozeOptions struct {
+	// MaxItems caps the items generated; see the service for the default.
+	MaxItems int
+}
+
+// QuizItemRepository persists quiz items.
+type QuizItemRepository interface {
+	// ReplaceQuizItems swaps the episode's items of a kind for the given
+	// ones in a single transaction.
+	ReplaceQuizItems(ctx context.Context, episodeID uuid.UUID, kind QuizItemKind, items []QuizItem) ([]QuizItem, error)
+	// ListQuizItems returns the matching items ordered by position.
+	ListQuizItems(ctx context.Context, filter QuizItemFilter) ([]QuizItem, error)
+	GetQuizItem(ctx context.Context, id uuid.UUID) (*QuizItem, error)
+	UpdateQuizItem(ctx context.Context, item QuizItem) (*QuizItem, error)
+}
+
+// ClozeService generates gap-fill exercises from episode transcripts.
+type ClozeService interface {
+	// GenerateClozeItems replaces the episode's cloze items with new ones
+	// made from its current transcript, all flagged for review.
+	GenerateClozeItems(ctx context.Context, episodeID uuid.UUID, opts ClozeOptions) ([]QuizItem, error)
+	ListQuizItems(ctx context.Context, filter QuizItemFilter) ([]QuizItem, error)
+	GetQuizItem(ctx context.Context, id uuid.UUID) (*QuizItem, error)
+	// UpdateQuizItem saves an editor's changes to an item.
+	UpdateQuizItem(ctx context.Context, item QuizItem) (*QuizItem, error)
+	// HandleEpisodeEvent generates cloze items for a created or updated
+	// episode that has none.
+	HandleEpisodeEvent(ctx context.Context, event Event) error
+}
@@ -0,0 +1,25 @@
+package core
+
+import "context"
+
+type replicaReadsContextKey struct{}
+
+// NewReplicaReadContext returns a context whose plain reads may be served by
+// a read replica, which can lag behind the primary database. Only read-only
+// requests should use it; writes and transactions always go to the primary.
+func NewReplicaReadContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replicaReadsContextKey{}, true)
+}
+
+// NewPrimaryReadContext returns a context whose reads go to the primary
+// again, for paths that must see the writes they just made.
+func NewPrimaryReadContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replicaReadsContextKey{}, false)
+}
+
+// ReplicaReadsAllowed reports whether reads in ctx may be served by a read
+// replica.
+func ReplicaReadsAllowed(ctx context.Context) bool {
+	allowed, _ := ctx.Value(replicaReadsContextKey{}).(bool)
+	return allowed
+}
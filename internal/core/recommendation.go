@@ -0,0 +1,59 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LearnerProfile captures the signals used to personalise recommendations.
+type LearnerProfile struct {
+	UserID string
+	// Level is the learner's target level, either supplied or inferred from history.
+	Level string
+	// TagAffinity counts how many watched series carry each (lower-cased) tag.
+	TagAffinity map[string]int
+	// WatchedSeriesIDs lists series the learner has finished at least one episode of.
+	WatchedSeriesIDs map[uuid.UUID]struct{}
+}
+
+// RecommendationCandidate is a published series considered for recommendation.
+type RecommendationCandidate struct {
+	Series Series
+	// Plays counts recent playback sessions across the series' episodes.
+	Plays int
+}
+
+// Recommendation is a ranked series suggestion with the reasons it scored.
+type Recommendation struct {
+	Series  Series
+	Score   float64
+	Reasons []string
+}
+
+// RecommendationScorer ranks a candidate for a learner. Implementations can be
+// swapped to experiment with different strategies; higher scores rank first.
+type RecommendationScorer interface {
+	Score(profile LearnerProfile, candidate RecommendationCandidate) (float64, []string)
+}
+
+// RecommendationSignalRepository exposes the behavioural signals recommendations draw on.
+type RecommendationSignalRepository interface {
+	// ListWatchedSeriesIDs returns series containing an episode the learner finished.
+	ListWatchedSeriesIDs(ctx context.Context, userID string) ([]uuid.UUID, error)
+	// CountSeriesPlays returns playback sessions started since the cutoff, per series.
+	CountSeriesPlays(ctx context.Context, since time.Time) (map[uuid.UUID]int, error)
+}
+
+// RecommendationRequest selects the learner and shapes the returned list.
+type RecommendationRequest struct {
+	UserID string
+	Level  string
+	Limit  int
+}
+
+// RecommendationService exposes personalised series recommendations to adapters.
+type RecommendationService interface {
+	GetRecommendations(ctx context.Context, req RecommendationRequest) ([]Recommendation, error)
+}
@@ -0,0 +1,17 @@
+package core
+
+import "context"
+
+type requestIDContextKey struct{}
+
+// NewRequestIDContext returns a context carrying the identifier of the
+// request being served, used to correlate client reports with traces.
+func NewRequestIDContext(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request identifier stored in ctx, if any.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDContextKey{}).(string)
+	return requestID, ok && requestID != ""
+}
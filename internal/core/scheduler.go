@@ -0,0 +1,63 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+// ScheduledTaskStatus reports the outcome of the last run of a scheduled task.
+type ScheduledTaskStatus int
+
+const (
+	// ScheduledTaskStatusUnspecified tasks have not run yet.
+	ScheduledTaskStatusUnspecified ScheduledTaskStatus = iota
+	ScheduledTaskStatusSucceeded
+	ScheduledTaskStatusFailed
+)
+
+// ScheduledTask is a periodic maintenance task and the state of its runs.
+// Tasks are shared by every worker process; one process runs a due task
+// while holding its lease.
+type ScheduledTask struct {
+	Name     string
+	Interval time.Duration
+	// NextRunAt is when the task becomes due again.
+	NextRunAt    time.Time
+	LastRunAt    *time.Time
+	LastStatus   ScheduledTaskStatus
+	LastError    string
+	LastDuration time.Duration
+	// LockedBy names the worker running the task until LockedUntil.
+	LockedBy    string
+	LockedUntil *time.Time
+	UpdatedAt   time.Time
+}
+
+// ScheduledTaskClaim describes the task a worker is ready to run.
+type ScheduledTaskClaim struct {
+	Name   string
+	Worker string
+	Now    time.Time
+	// Lease is how long the worker holds the task before another worker may
+	// take it over.
+	Lease time.Duration
+}
+
+// ScheduledTaskRepository persists scheduled tasks and their run state.
+type ScheduledTaskRepository interface {
+	// EnsureScheduledTask registers the task, or updates the interval of an
+	// already registered task while keeping its run state.
+	EnsureScheduledTask(ctx context.Context, task ScheduledTask) (*ScheduledTask, error)
+	// ClaimScheduledTask leases the task when it is due and no other worker
+	// holds it, reporting whether the lease was taken.
+	ClaimScheduledTask(ctx context.Context, claim ScheduledTaskClaim) (bool, error)
+	// UpdateScheduledTask records the outcome of a run and releases the lease.
+	UpdateScheduledTask(ctx context.Context, task ScheduledTask) (*ScheduledTask, error)
+	// ListScheduledTasks returns every registered task ordered by name.
+	ListScheduledTasks(ctx context.Context) ([]ScheduledTask, error)
+}
+
+// SchedulerService exposes the scheduled tasks to administrators.
+type SchedulerService interface {
+	ListScheduledTasks(ctx context.Context) ([]ScheduledTask, error)
+}
@@ -0,0 +1,89 @@
+package core
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// SearchKind tells series and episodes apart in search results.
+type SearchKind int
+
+const (
+	SearchKindUnspecified SearchKind = iota
+	SearchKindSeries
+	SearchKindEpisode
+)
+
+// SearchDocument is the searchable form of a published series or episode.
+// Episodes carry the language, level and tags of their series so both kinds
+// filter alike.
+type SearchDocument struct {
+	// ID is unique across kinds, see SearchDocumentID.
+	ID        string
+	Kind      SearchKind
+	SeriesID  uuid.UUID
+	EpisodeID uuid.UUID
+	Title     string
+	// Body is the series summary or the episode description and transcript.
+	Body     string
+	Language string
+	Level    string
+	Tags     []string
+}
+
+// SearchDocumentID returns the ID of the document of the series or episode
+// with the given ID.
+func SearchDocumentID(kind SearchKind, id uuid.UUID) string {
+	if kind == SearchKindEpisode {
+		return "episode-" + id.String()
+	}
+	return "series-" + id.String()
+}
+
+// SearchQuery describes a content search. Kinds, Language, Level and Tags
+// narrow the results; a document matches when it carries any of the tags.
+type SearchQuery struct {
+	Query     string
+	Kinds     []SearchKind
+	Language  string
+	Level     string
+	Tags      []string
+	PageSize  int
+	PageToken string
+}
+
+// SearchHit is a document matching a search, best match first.
+type SearchHit struct {
+	Kind      SearchKind
+	SeriesID  uuid.UUID
+	EpisodeID uuid.UUID
+	Title     string
+	// Snippet is an excerpt of the matching text.
+	Snippet string
+	// Score ranks the hit among the others of its page; engines score
+	// differently, so it is not comparable across engines.
+	Score float64
+}
+
+// SearchIndex finds published content. The database index searches the
+// content tables directly; external engines are kept in sync through
+// IndexDocuments and DeleteDocuments.
+type SearchIndex interface {
+	// IndexDocuments adds documents or replaces those with the same IDs.
+	IndexDocuments(ctx context.Context, docs ...SearchDocument) error
+	// DeleteDocuments removes documents; missing IDs are ignored.
+	DeleteDocuments(ctx context.Context, ids ...string) error
+	Search(ctx context.Context, query SearchQuery) ([]SearchHit, string, error)
+}
+
+// SearchService exposes content search to adapters and keeps the search
+// index in sync with the catalog.
+type SearchService interface {
+	SearchContent(ctx context.Context, query SearchQuery) ([]SearchHit, string, error)
+	// HandleEvent updates the index for a series or episode event.
+	HandleEvent(ctx context.Context, event Event) error
+	// ReindexContent indexes every published series and episode, returning
+	// how many documents were indexed.
+	ReindexContent(ctx context.Context) (int, error)
+}
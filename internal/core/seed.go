@@ -0,0 +1,63 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+// Fixtures declares sample content for a development or test environment.
+type Fixtures struct {
+	Assets []AssetFixture
+	Plans  []PlanDraft
+	Series []SeriesFixture
+	Users  []UserFixture
+}
+
+// AssetFixture declares a ready media asset. Episodes refer to it by Key,
+// which becomes its asset key.
+type AssetFixture struct {
+	Key         string
+	Type        AssetType
+	Filename    string
+	MimeType    string
+	Filesize    int64
+	Duration    time.Duration
+	PlaybackURL string
+}
+
+// SeriesFixture declares a series and its episodes. The episodes of Series
+// are ignored in favour of Episodes.
+type SeriesFixture struct {
+	Series   SeriesDraft
+	Episodes []EpisodeFixture
+}
+
+// EpisodeFixture declares an episode playing the asset with AssetKey, if
+// any. Its seq defaults to its position and its duration to the asset's.
+type EpisodeFixture struct {
+	Episode  EpisodeDraft
+	AssetKey string
+}
+
+// UserFixture declares a learner, subscribed to the plan with PlanCode when
+// set.
+type UserFixture struct {
+	ID       string
+	PlanCode string
+}
+
+// SeedResult counts what seeding created and what already existed.
+type SeedResult struct {
+	Assets        int
+	Plans         int
+	Series        int
+	Subscriptions int
+	Skipped       int
+}
+
+// SeedService loads fixtures. Seeding is idempotent: assets, plans and
+// series that already exist by key, code or slug are left alone, as are
+// learners who already have an active subscription.
+type SeedService interface {
+	Seed(ctx context.Context, fixtures Fixtures) (*SeedResult, error)
+}
@@ -17,6 +17,31 @@ const (
 	SeriesStatusArchived
 )
 
+// SeriesLayout hints how white-label frontends lay out a series page.
+type SeriesLayout int
+
+const (
+	// SeriesLayoutUnspecified leaves the layout to the frontend.
+	SeriesLayoutUnspecified SeriesLayout = iota
+	// SeriesLayoutList lists the episodes in order.
+	SeriesLayoutList
+	// SeriesLayoutGrid shows the episodes as a grid of cards.
+	SeriesLayoutGrid
+	// SeriesLayoutFeatured leads with the hero image and the first episode.
+	SeriesLayoutFeatured
+)
+
+// SeriesBranding is the presentation metadata of a series.
+type SeriesBranding struct {
+	// AccentColor is a #rrggbb colour, empty for the frontend's default.
+	AccentColor string
+	// HeroAssetID is the image asset shown as the hero of the series page.
+	HeroAssetID uuid.UUID
+	// HeroImageURL is the hero variant of the hero image asset.
+	HeroImageURL string
+	Layout       SeriesLayout
+}
+
 // EpisodeStatus denotes the lifecycle stage for an episode.
 type EpisodeStatus int
 
@@ -61,6 +86,22 @@ type Transcript struct {
 	Language string
 	Format   TranscriptFormat
 	Content  string
+	// Findings lists what the sanitization pass flagged in Content when
+	// the episode was last saved.
+	Findings []TranscriptFinding
+}
+
+// TranscriptFinding locates a span of a transcript flagged by the
+// sanitization pass, such as profanity or personal data.
+type TranscriptFinding struct {
+	Kind string
+	// Line is the 1-based line of the transcript the span starts on.
+	Line int
+	// Start and End are byte offsets of the span in the transcript content.
+	Start int
+	End   int
+	// Masked reports that the span was replaced with asterisks.
+	Masked bool
 }
 
 // Episode represents a persisted content unit within a series.
@@ -71,26 +112,44 @@ type Episode struct {
 	Title       string
 	Description string
 	Duration    time.Duration
-	Status      EpisodeStatus
-	Resource    MediaResource
-	Transcript  Transcript
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	PublishedAt *time.Time
-	DeletedAt   *time.Time
+	// DurationFromAsset reports that Duration was copied from the resource
+	// asset, which keeps it in step with the asset's duration.
+	DurationFromAsset bool
+	Status            EpisodeStatus
+	// Preview episodes are playable without an active subscription.
+	Preview    bool
+	Resource   MediaResource
+	Transcript Transcript
+	// EstimatedLevel is the CEFR level estimated from the transcript, empty
+	// until estimated. Only the DifficultyService writes it.
+	EstimatedLevel string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	PublishedAt    *time.Time
+	DeletedAt      *time.Time
 }
 
 // Series represents a persisted series.
 type Series struct {
-	ID           uuid.UUID
-	Slug         string
-	Title        string
-	Summary      string
-	Language     string
-	Level        string
-	Tags         []string
+	ID       uuid.UUID
+	Slug     string
+	Title    string
+	Summary  string
+	Language string
+	Level    string
+	// EstimatedLevel summarises the estimated levels of the episodes; see
+	// SeriesEstimatedLevel.
+	EstimatedLevel string
+	Tags           []string
+	// CoverURL is the card variant of the cover image asset when
+	// CoverAssetID is set, or a legacy external URL otherwise.
 	CoverURL     string
+	CoverAssetID uuid.UUID
 	Status       SeriesStatus
+	// DRMEnabled encrypts the HLS renditions of the series' audio, whose
+	// keys are only handed to entitled learners.
+	DRMEnabled   bool
+	Branding     SeriesBranding
 	EpisodeCount int
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
@@ -101,16 +160,21 @@ type Series struct {
 
 // SeriesDraft contains user-modifiable series attributes.
 type SeriesDraft struct {
-	Slug      string
-	Title     string
-	Summary   string
-	Language  string
-	Level     string
-	Tags      []string
-	CoverURL  string
-	Status    SeriesStatus
-	AuthorIDs []string
-	Episodes  []EpisodeDraft
+	Slug     string
+	Title    string
+	Summary  string
+	Language string
+	Level    string
+	Tags     []string
+	CoverURL string
+	// CoverAssetID, when set, makes an image asset the cover; CoverURL is
+	// then taken from the asset.
+	CoverAssetID uuid.UUID
+	Status       SeriesStatus
+	DRMEnabled   bool
+	Branding     SeriesBranding
+	AuthorIDs    []string
+	Episodes     []EpisodeDraft
 }
 
 // EpisodeDraft contains user-modifiable episode attributes.
@@ -120,6 +184,7 @@ type EpisodeDraft struct {
 	Description string
 	Duration    time.Duration
 	Status      EpisodeStatus
+	Preview     bool
 	Resource    *MediaResource
 	Transcript  *Transcript
 }
@@ -131,9 +196,12 @@ type SeriesListFilter struct {
 	Statuses        []SeriesStatus
 	Language        string
 	Level           string
+	EstimatedLevel  string
 	Tags            []string
 	Query           string
 	IncludeEpisodes bool
+	// OmitTranscripts skips loading the transcripts of embedded episodes.
+	OmitTranscripts bool
 	AuthorIDs       []string
 }
 
@@ -141,6 +209,8 @@ type SeriesListFilter struct {
 type SeriesQueryOptions struct {
 	IncludeEpisodes bool
 	IncludeMetadata bool
+	// OmitTranscripts skips loading the transcripts of embedded episodes.
+	OmitTranscripts bool
 }
 
 // CreateEpisodeParams describes the inputs required to create an episode.
@@ -149,16 +219,52 @@ type CreateEpisodeParams struct {
 	Draft    EpisodeDraft
 }
 
+// TagReplacement describes a bulk rewrite of series tags.
+type TagReplacement struct {
+	Sources []string
+	Target  string
+}
+
+// ContentReassignment records a bulk transfer of series ownership between authors.
+type ContentReassignment struct {
+	ID           uuid.UUID
+	FromAuthorID string
+	ToAuthorID   string
+	SeriesIDs    []uuid.UUID
+	CreatedAt    time.Time
+}
+
 // SeriesRepository defines persistence operations for series and episodes.
+// Write methods record the given events in the outbox atomically with the change.
 type SeriesRepository interface {
 	ListSeries(ctx context.Context, filter SeriesListFilter) ([]Series, string, error)
-	CreateSeries(ctx context.Context, series Series) (*Series, error)
+	CreateSeries(ctx context.Context, series Series, events ...Event) (*Series, error)
 	GetSeries(ctx context.Context, id uuid.UUID, opts SeriesQueryOptions) (*Series, error)
-	UpdateSeries(ctx context.Context, series Series) (*Series, error)
-	CreateEpisode(ctx context.Context, episode Episode) (*Episode, error)
+	GetSeriesBySlug(ctx context.Context, slug string, opts SeriesQueryOptions) (*Series, error)
+	UpdateSeries(ctx context.Context, series Series, events ...Event) (*Series, error)
+	CreateEpisode(ctx context.Context, episode Episode, events ...Event) (*Episode, error)
 	GetEpisode(ctx context.Context, id uuid.UUID) (*Episode, error)
-	UpdateEpisode(ctx context.Context, episode Episode) (*Episode, error)
-	DeleteEpisode(ctx context.Context, id uuid.UUID) (*Episode, error)
+	UpdateEpisode(ctx context.Context, episode Episode, events ...Event) (*Episode, error)
+	DeleteEpisode(ctx context.Context, id uuid.UUID, events ...Event) (*Episode, error)
+	// ReplaceTags records a SeriesUpdated event for every series it changes.
+	ReplaceTags(ctx context.Context, replacement TagReplacement) ([]uuid.UUID, error)
+	// ReassignAuthor records a SeriesUpdated event for every series it moves.
+	ReassignAuthor(ctx context.Context, reassignment ContentReassignment) (*ContentReassignment, error)
+	// ReconcileEpisodeCounts corrects series whose stored episode count no
+	// longer matches their live episodes and returns their ids.
+	ReconcileEpisodeCounts(ctx context.Context) ([]uuid.UUID, error)
+	// SetEpisodeEstimatedLevel stores the estimated level of an episode and
+	// refreshes the estimate of its series from its live episodes.
+	SetEpisodeEstimatedLevel(ctx context.Context, id uuid.UUID, level string) (*Episode, error)
+	// ListEpisodesByAsset returns the live episodes playing an asset.
+	ListEpisodesByAsset(ctx context.Context, assetID uuid.UUID) ([]Episode, error)
+}
+
+// SeriesCacheInvalidator drops cached reads of series whose content changed
+// without going through the SeriesRepository, such as by bulk transcript
+// edits. Without ids it drops cached listings only.
+type SeriesCacheInvalidator interface {
+	InvalidateSeries(ctx context.Context, ids ...uuid.UUID)
 }
 
 // SeriesService exposes the series use cases to adapters.
@@ -171,4 +277,12 @@ type SeriesService interface {
 	GetEpisode(ctx context.Context, id uuid.UUID) (*Episode, error)
 	UpdateEpisode(ctx context.Context, episode Episode) (*Episode, error)
 	DeleteEpisode(ctx context.Context, id uuid.UUID) (*Episode, error)
+	RenameTag(ctx context.Context, tag, newTag string) ([]uuid.UUID, error)
+	MergeTags(ctx context.Context, sources []string, target string) ([]uuid.UUID, error)
+	ReassignContent(ctx context.Context, fromAuthorID, toAuthorID string) (*ContentReassignment, error)
+	ReconcileEpisodeCounts(ctx context.Context) ([]uuid.UUID, error)
+	GetEpisodeTextStats(ctx context.Context, id uuid.UUID, opts TextStatsOptions) (*EpisodeTextStats, error)
+	// HandleAssetEvent updates the durations episodes copied from an asset
+	// that became ready or whose duration changed.
+	HandleAssetEvent(ctx context.Context, event Event) error
 }
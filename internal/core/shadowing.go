@@ -0,0 +1,94 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ShadowingSubmissionStatus denotes the review state of a shadowing submission.
+type ShadowingSubmissionStatus int
+
+const (
+	ShadowingSubmissionStatusUnspecified ShadowingSubmissionStatus = iota
+	ShadowingSubmissionStatusPending
+	ShadowingSubmissionStatusReviewed
+)
+
+// ShadowingSubmission is a learner recording of a transcript segment awaiting or holding review.
+type ShadowingSubmission struct {
+	ID            uuid.UUID
+	UserID        string
+	EpisodeID     uuid.UUID
+	SegmentIndex  int
+	AssetID       uuid.UUID
+	Status        ShadowingSubmissionStatus
+	AutoScore     *float64
+	AutoFeedback  string
+	ReviewerID    string
+	ReviewScore   *int
+	ReviewComment string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	ReviewedAt    *time.Time
+}
+
+// CreateShadowingSubmissionParams describes a learner's recording submission.
+type CreateShadowingSubmissionParams struct {
+	UserID       string
+	EpisodeID    uuid.UUID
+	SegmentIndex int
+	AssetID      uuid.UUID
+}
+
+// ReviewShadowingSubmissionParams carries a teacher's review of a submission.
+type ReviewShadowingSubmissionParams struct {
+	SubmissionID uuid.UUID
+	ReviewerID   string
+	Score        int
+	Comment      string
+}
+
+// ShadowingSubmissionFilter describes pagination and filtering options for submissions.
+type ShadowingSubmissionFilter struct {
+	PageSize  int
+	PageToken string
+	UserID    string
+	EpisodeID uuid.UUID
+	Statuses  []ShadowingSubmissionStatus
+}
+
+// PronunciationScoreParams supplies a recording and its reference text for scoring.
+type PronunciationScoreParams struct {
+	Asset        Asset
+	ExpectedText string
+	Language     string
+}
+
+// PronunciationScore is the outcome of automatic pronunciation scoring.
+type PronunciationScore struct {
+	Score    float64
+	Feedback string
+}
+
+// PronunciationScorer automatically scores shadowing recordings.
+type PronunciationScorer interface {
+	ScoreRecording(ctx context.Context, params PronunciationScoreParams) (*PronunciationScore, error)
+}
+
+// ShadowingRepository persists shadowing submissions.
+type ShadowingRepository interface {
+	CreateShadowingSubmission(ctx context.Context, submission ShadowingSubmission) (*ShadowingSubmission, error)
+	GetShadowingSubmission(ctx context.Context, id uuid.UUID) (*ShadowingSubmission, error)
+	UpdateShadowingSubmission(ctx context.Context, submission ShadowingSubmission) (*ShadowingSubmission, error)
+	ListShadowingSubmissions(ctx context.Context, filter ShadowingSubmissionFilter) ([]ShadowingSubmission, string, error)
+}
+
+// ShadowingService exposes shadowing practice use cases to adapters.
+type ShadowingService interface {
+	CreateShadowingSubmission(ctx context.Context, params CreateShadowingSubmissionParams) (*ShadowingSubmission, error)
+	GetShadowingSubmission(ctx context.Context, id uuid.UUID) (*ShadowingSubmission, error)
+	ListShadowingSubmissions(ctx context.Context, filter ShadowingSubmissionFilter) ([]ShadowingSubmission, string, error)
+	ReviewShadowingSubmission(ctx context.Context, params ReviewShadowingSubmissionParams) (*ShadowingSubmission, error)
+}
@@ -0,0 +1,23 @@
+package core
+
+import (
+	"context"
+	"time"
+)
+
+// SitemapEntry is a page listed in the sitemap.
+type SitemapEntry struct {
+	Loc     string
+	LastMod time.Time
+}
+
+// Sitemap lists the pages of the published series and episodes.
+type Sitemap struct {
+	Entries     []SitemapEntry
+	GeneratedAt time.Time
+}
+
+// SitemapService builds the sitemap search engines crawl.
+type SitemapService interface {
+	Sitemap(ctx context.Context) (*Sitemap, error)
+}
@@ -0,0 +1,110 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BillingInterval denotes how often a plan renews.
+type BillingInterval int
+
+const (
+	BillingIntervalUnspecified BillingInterval = iota
+	BillingIntervalMonth
+	BillingIntervalYear
+)
+
+// SubscriptionStatus denotes the lifecycle stage of a subscription.
+type SubscriptionStatus int
+
+const (
+	SubscriptionStatusUnspecified SubscriptionStatus = iota
+	SubscriptionStatusActive
+	SubscriptionStatusCanceled
+	SubscriptionStatusExpired
+)
+
+// Plan is a purchasable tier granting access to non-preview episodes.
+type Plan struct {
+	ID          uuid.UUID
+	Code        string
+	Name        string
+	Description string
+	PriceCents  int64
+	Currency    string
+	Interval    BillingInterval
+	Active      bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// PlanDraft contains user-modifiable plan attributes.
+type PlanDraft struct {
+	Code        string
+	Name        string
+	Description string
+	PriceCents  int64
+	Currency    string
+	Interval    BillingInterval
+	Active      bool
+}
+
+// Subscription grants a learner a plan for a billing period.
+type Subscription struct {
+	ID                 uuid.UUID
+	UserID             string
+	PlanID             uuid.UUID
+	Status             SubscriptionStatus
+	CurrentPeriodStart time.Time
+	CurrentPeriodEnd   time.Time
+	// BillingProvider and ExternalID link subscriptions purchased through a
+	// payment processor to the processor's record; both are empty otherwise.
+	BillingProvider string
+	ExternalID      string
+	CanceledAt      *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Entitled reports whether the subscription grants access at the given time.
+// Canceled subscriptions keep access until the paid period ends.
+func (s Subscription) Entitled(at time.Time) bool {
+	switch s.Status {
+	case SubscriptionStatusActive, SubscriptionStatusCanceled:
+		return at.Before(s.CurrentPeriodEnd)
+	default:
+		return false
+	}
+}
+
+// SubscriptionRepository persists plans and subscriptions.
+type SubscriptionRepository interface {
+	CreatePlan(ctx context.Context, plan Plan) (*Plan, error)
+	GetPlan(ctx context.Context, id uuid.UUID) (*Plan, error)
+	UpdatePlan(ctx context.Context, plan Plan) (*Plan, error)
+	ListPlans(ctx context.Context, includeInactive bool) ([]Plan, error)
+	CreateSubscription(ctx context.Context, subscription Subscription) (*Subscription, error)
+	GetSubscription(ctx context.Context, id uuid.UUID) (*Subscription, error)
+	UpdateSubscription(ctx context.Context, subscription Subscription) (*Subscription, error)
+	// FindSubscriptionByExternalID returns the subscription a billing provider
+	// knows by externalID, or ErrNotFound.
+	FindSubscriptionByExternalID(ctx context.Context, provider, externalID string) (*Subscription, error)
+	// ListSubscriptions returns the learner's subscriptions, newest first.
+	ListSubscriptions(ctx context.Context, userID string) ([]Subscription, error)
+}
+
+// SubscriptionService exposes plan management and entitlement checks to adapters.
+type SubscriptionService interface {
+	CreatePlan(ctx context.Context, draft PlanDraft) (*Plan, error)
+	GetPlan(ctx context.Context, id uuid.UUID) (*Plan, error)
+	UpdatePlan(ctx context.Context, plan Plan) (*Plan, error)
+	ListPlans(ctx context.Context, includeInactive bool) ([]Plan, error)
+	Subscribe(ctx context.Context, userID string, planID uuid.UUID) (*Subscription, error)
+	CancelSubscription(ctx context.Context, id uuid.UUID) (*Subscription, error)
+	ListSubscriptions(ctx context.Context, userID string) ([]Subscription, error)
+	// ActiveSubscription returns the subscription currently entitling the
+	// learner, or ErrNotFound when there is none.
+	ActiveSubscription(ctx context.Context, userID string) (*Subscription, error)
+}
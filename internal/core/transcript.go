@@ -0,0 +1,208 @@
+package core
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TranscriptSegment is a timed span of transcript text.
+type TranscriptSegment struct {
+	Index int
+	Start time.Duration
+	End   time.Duration
+	Text  string
+}
+
+// ParseTranscriptSegments extracts timed segments from SRT or JSON transcripts.
+// Plain and Markdown transcripts carry no timing and yield ErrTranscriptNotTimed.
+func ParseTranscriptSegments(transcript Transcript) ([]TranscriptSegment, error) {
+	var (
+		segments []TranscriptSegment
+		err      error
+	)
+	switch transcript.Format {
+	case TranscriptFormatSRT:
+		segments, err = parseSRTSegments(transcript.Content)
+	case TranscriptFormatJSON:
+		segments, err = parseJSONSegments(transcript.Content)
+	default:
+		return nil, ErrTranscriptNotTimed
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) == 0 {
+		return nil, ErrTranscriptNotTimed
+	}
+	for i := range segments {
+		segments[i].Index = i
+	}
+	return segments, nil
+}
+
+// FormatSRT renders segments as an SRT transcript, numbering cues from one.
+func FormatSRT(segments []TranscriptSegment) string {
+	var b strings.Builder
+	for i, segment := range segments {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "%d\n%s --> %s\n%s\n", i+1, formatSRTTimestamp(segment.Start), formatSRTTimestamp(segment.End), segment.Text)
+	}
+	return b.String()
+}
+
+func formatSRTTimestamp(d time.Duration) string {
+	ms := d.Round(time.Millisecond).Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d,%03d", ms/3600000, ms/60000%60, ms/1000%60, ms%1000)
+}
+
+func parseSRTSegments(content string) ([]TranscriptSegment, error) {
+	var (
+		segments []TranscriptSegment
+		current  *TranscriptSegment
+		lines    []string
+	)
+	flush := func() {
+		if current != nil {
+			current.Text = strings.TrimSpace(strings.Join(lines, " "))
+			if current.Text != "" {
+				segments = append(segments, *current)
+			}
+		}
+		current = nil
+		lines = nil
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(strings.TrimPrefix(scanner.Text(), "\ufeff"))
+		switch {
+		case line == "":
+			flush()
+		case strings.Contains(line, "-->"):
+			flush()
+			start, end, err := parseSRTTiming(line)
+			if err != nil {
+				return nil, err
+			}
+			current = &TranscriptSegment{Start: start, End: end}
+		case current != nil:
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+
+	return segments, nil
+}
+
+func parseSRTTiming(line string) (time.Duration, time.Duration, error) {
+	parts := strings.SplitN(line, "-->", 2)
+	start, err := parseSRTTimestamp(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	// Cue settings may follow the end timestamp; only the first field is the time.
+	endFields := strings.Fields(parts[1])
+	if len(endFields) == 0 {
+		return 0, 0, fmt.Errorf("%w: missing end timestamp in %q", ErrValidation, line)
+	}
+	end, err := parseSRTTimestamp(endFields[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	if end < start {
+		return 0, 0, fmt.Errorf("%w: segment ends before it starts in %q", ErrValidation, line)
+	}
+	return start, end, nil
+}
+
+func parseSRTTimestamp(value string) (time.Duration, error) {
+	value = strings.ReplaceAll(strings.TrimSpace(value), ",", ".")
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("%w: invalid timestamp %q", ErrValidation, value)
+	}
+
+	var total float64
+	for _, part := range parts {
+		n, err := strconv.ParseFloat(part, 64)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("%w: invalid timestamp %q", ErrValidation, value)
+		}
+		total = total*60 + n
+	}
+	return secondsToDuration(total), nil
+}
+
+type jsonTranscriptSegment struct {
+	Start float64 `json:"start"`
+	End   float64 `json:"end"`
+	Text  string  `json:"text"`
+}
+
+// parseJSONSegments accepts either a bare array of segments or an object with
+// a "segments" array, with start and end expressed in seconds.
+func parseJSONSegments(content string) ([]TranscriptSegment, error) {
+	content = strings.TrimSpace(content)
+	var raw []jsonTranscriptSegment
+	if strings.HasPrefix(content, "[") {
+		if err := json.Unmarshal([]byte(content), &raw); err != nil {
+			return nil, fmt.Errorf("%w: invalid JSON transcript: %v", ErrValidation, err)
+		}
+	} else {
+		var wrapper struct {
+			Segments []jsonTranscriptSegment `json:"segments"`
+		}
+		if err := json.Unmarshal([]byte(content), &wrapper); err != nil {
+			return nil, fmt.Errorf("%w: invalid JSON transcript: %v", ErrValidation, err)
+		}
+		raw = wrapper.Segments
+	}
+
+	segments := make([]TranscriptSegment, 0, len(raw))
+	for _, segment := range raw {
+		text := strings.TrimSpace(segment.Text)
+		if text == "" {
+			continue
+		}
+		if segment.Start < 0 || segment.End < segment.Start {
+			return nil, fmt.Errorf("%w: invalid segment timing %.3f-%.3f", ErrValidation, segment.Start, segment.End)
+		}
+		segments = append(segments, TranscriptSegment{
+			Start: secondsToDuration(segment.Start),
+			End:   secondsToDuration(segment.End),
+			Text:  text,
+		})
+	}
+	return segments, nil
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second)).Round(time.Millisecond)
+}
+
+// TranscriptText returns the words of a transcript without cue numbers or
+// timings, one segment per line for timed formats. A timed transcript that
+// does not parse has no text.
+func TranscriptText(transcript Transcript) string {
+	if transcript.Format != TranscriptFormatSRT && transcript.Format != TranscriptFormatJSON {
+		return transcript.Content
+	}
+	segments, err := ParseTranscriptSegments(transcript)
+	if err != nil {
+		return ""
+	}
+	lines := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		lines = append(lines, segment.Text)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,125 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TranscriptReplaceJobStatus denotes the lifecycle stage of a bulk transcript edit.
+type TranscriptReplaceJobStatus int
+
+const (
+	TranscriptReplaceJobStatusUnspecified TranscriptReplaceJobStatus = iota
+	TranscriptReplaceJobStatusPending
+	TranscriptReplaceJobStatusRunning
+	TranscriptReplaceJobStatusSucceeded
+	TranscriptReplaceJobStatusFailed
+	TranscriptReplaceJobStatusRolledBack
+)
+
+// TranscriptReplaceScope restricts which episode transcripts a job touches.
+// Zero values match everything.
+type TranscriptReplaceScope struct {
+	SeriesID uuid.UUID
+	Language string
+}
+
+// TranscriptLineDiff previews a single changed transcript line.
+type TranscriptLineDiff struct {
+	Line   int
+	Before string
+	After  string
+}
+
+// TranscriptChange summarises the edit made (or previewed) for one episode.
+type TranscriptChange struct {
+	EpisodeID  uuid.UUID
+	Matches    int
+	Lines      []TranscriptLineDiff
+	SkipReason string
+}
+
+// TranscriptReplaceJob is an asynchronous search-and-replace across transcripts.
+type TranscriptReplaceJob struct {
+	ID              uuid.UUID
+	Pattern         string
+	Replacement     string
+	Regex           bool
+	CaseInsensitive bool
+	Scope           TranscriptReplaceScope
+	DryRun          bool
+	RequestedBy     string
+	Status          TranscriptReplaceJobStatus
+	EpisodesScanned int
+	EpisodesChanged int
+	Matches         int
+	Changes         []TranscriptChange
+	Error           string
+	CreatedAt       time.Time
+	StartedAt       *time.Time
+	FinishedAt      *time.Time
+	RolledBackAt    *time.Time
+}
+
+// SearchReplaceTranscriptsParams describes a bulk transcript edit request.
+type SearchReplaceTranscriptsParams struct {
+	Pattern         string
+	Replacement     string
+	Regex           bool
+	CaseInsensitive bool
+	Scope           TranscriptReplaceScope
+	DryRun          bool
+	RequestedBy     string
+}
+
+// TranscriptRevision records the transcript content replaced by a job so it can be rolled back.
+type TranscriptRevision struct {
+	ID        uuid.UUID
+	JobID     uuid.UUID
+	EpisodeID uuid.UUID
+	Before    string
+	After     string
+	CreatedAt time.Time
+}
+
+// TranscriptRollbackResult reports the outcome of rolling back a job.
+type TranscriptRollbackResult struct {
+	Restored  int
+	Conflicts []uuid.UUID
+	// SeriesIDs lists the series whose transcripts were restored.
+	SeriesIDs []uuid.UUID
+}
+
+// TranscriptReplaceJobFilter describes pagination options when listing jobs.
+type TranscriptReplaceJobFilter struct {
+	PageSize  int
+	PageToken string
+}
+
+// TranscriptAdminRepository persists bulk transcript edit jobs and revisions.
+type TranscriptAdminRepository interface {
+	CreateTranscriptReplaceJob(ctx context.Context, job TranscriptReplaceJob) (*TranscriptReplaceJob, error)
+	GetTranscriptReplaceJob(ctx context.Context, id uuid.UUID) (*TranscriptReplaceJob, error)
+	UpdateTranscriptReplaceJob(ctx context.Context, job TranscriptReplaceJob) (*TranscriptReplaceJob, error)
+	ListTranscriptReplaceJobs(ctx context.Context, filter TranscriptReplaceJobFilter) ([]TranscriptReplaceJob, string, error)
+	// ListTranscriptEpisodes returns live episodes with transcripts in scope,
+	// ordered by ID and starting after the supplied cursor.
+	ListTranscriptEpisodes(ctx context.Context, scope TranscriptReplaceScope, after uuid.UUID, limit int) ([]Episode, error)
+	// ApplyTranscriptRevisions writes each revision's After content when the
+	// transcript still matches Before, recording the revision. Episodes edited
+	// concurrently are skipped and returned.
+	ApplyTranscriptRevisions(ctx context.Context, revisions []TranscriptRevision) ([]uuid.UUID, error)
+	// RollbackTranscriptRevisions restores Before content for a job's revisions
+	// whose transcripts still match After.
+	RollbackTranscriptRevisions(ctx context.Context, jobID uuid.UUID) (*TranscriptRollbackResult, error)
+}
+
+// TranscriptAdminService exposes bulk transcript maintenance use cases to adapters.
+type TranscriptAdminService interface {
+	SearchReplaceTranscripts(ctx context.Context, params SearchReplaceTranscriptsParams) (*TranscriptReplaceJob, error)
+	GetTranscriptReplaceJob(ctx context.Context, id uuid.UUID) (*TranscriptReplaceJob, error)
+	ListTranscriptReplaceJobs(ctx context.Context, filter TranscriptReplaceJobFilter) ([]TranscriptReplaceJob, string, error)
+	RollbackTranscriptReplaceJob(ctx context.Context, id uuid.UUID) (*TranscriptReplaceJob, *TranscriptRollbackResult, error)
+}
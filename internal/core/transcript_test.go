@@ -0,0 +1,103 @@
+package core
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseTranscriptSegments(t *testing.T) {
+	tests := []struct {
+		name       string
+		transcript Transcript
+		want       []TranscriptSegment
+		wantErr    error
+	}{
+		{
+			name:       "srt",
+			transcript: Transcript{Format: TranscriptFormatSRT, Content: "1\n00:00:01,000 --> 00:00:02,500\nHello there.\n\n2\n00:00:03,000 --> 00:00:05,250 align:start\nHow are\nyou?\n"},
+			want: []TranscriptSegment{
+				{Index: 0, Start: time.Second, End: 2500 * time.Millisecond, Text: "Hello there."},
+				{Index: 1, Start: 3 * time.Second, End: 5250 * time.Millisecond, Text: "How are you?"},
+			},
+		},
+		{
+			name:       "json array",
+			transcript: Transcript{Format: TranscriptFormatJSON, Content: `[{"start":0.5,"end":1.25,"text":"Hi"},{"start":2,"end":3,"text":"  "}]`},
+			want:       []TranscriptSegment{{Index: 0, Start: 500 * time.Millisecond, End: 1250 * time.Millisecond, Text: "Hi"}},
+		},
+		{
+			name:       "json object",
+			transcript: Transcript{Format: TranscriptFormatJSON, Content: `{"segments":[{"start":1,"end":2,"text":"One"}]}`},
+			want:       []TranscriptSegment{{Index: 0, Start: time.Second, End: 2 * time.Second, Text: "One"}},
+		},
+		{
+			name:       "plain text has no timing",
+			transcript: Transcript{Format: TranscriptFormatPlain, Content: "Hello"},
+			wantErr:    ErrTranscriptNotTimed,
+		},
+		{
+			name:       "invalid timestamp",
+			transcript: Transcript{Format: TranscriptFormatSRT, Content: "1\n00:xx:01,000 --> 00:00:02,000\nHi\n"},
+			wantErr:    ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTranscriptSegments(tt.transcript)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseTranscriptSegments() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %d segments, got %#v", len(tt.want), got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("segment %d = %#v, want %#v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFormatSRT(t *testing.T) {
+	segments := []TranscriptSegment{
+		{Start: 1500 * time.Millisecond, End: 3 * time.Second, Text: "Hello there."},
+		{Start: time.Hour + 2*time.Minute + 3*time.Second + 40*time.Millisecond, End: time.Hour + 2*time.Minute + 5*time.Second, Text: "Bye."},
+	}
+	want := "1\n00:00:01,500 --> 00:00:03,000\nHello there.\n\n2\n01:02:03,040 --> 01:02:05,000\nBye.\n"
+	if got := FormatSRT(segments); got != want {
+		t.Fatalf("FormatSRT() = %q, want %q", got, want)
+	}
+
+	parsed, err := ParseTranscriptSegments(Transcript{Format: TranscriptFormatSRT, Content: want})
+	if err != nil || len(parsed) != 2 || parsed[1].Start != segments[1].Start {
+		t.Fatalf("expected the output to parse back, got %+v, %v", parsed, err)
+	}
+}
+
+func TestTranscriptText(t *testing.T) {
+	tests := []struct {
+		name       string
+		transcript Transcript
+		want       string
+	}{
+		{name: "plain", transcript: Transcript{Format: TranscriptFormatPlain, Content: "Hello there."}, want: "Hello there."},
+		{name: "srt", transcript: Transcript{Format: TranscriptFormatSRT, Content: "1\n00:00:01,000 --> 00:00:02,000\nHello\nthere.\n\n2\n00:00:02,000 --> 00:00:03,000\nBye.\n"}, want: "Hello there.\nBye."},
+		{name: "malformed json", transcript: Transcript{Format: TranscriptFormatJSON, Content: "{"}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TranscriptText(tt.transcript); got != tt.want {
+				t.Fatalf("TranscriptText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package core
+
+import "context"
+
+// TxManager runs units of work that span several repositories atomically.
+type TxManager interface {
+	// WithinTx runs fn in a transaction, committing it when fn returns nil
+	// and rolling it back otherwise. Repository calls made with the context
+	// fn receives take part in the transaction, and a nested WithinTx joins
+	// the outer one.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
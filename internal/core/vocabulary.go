@@ -0,0 +1,84 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VocabularyStatus records whether a learner knows a word.
+type VocabularyStatus int
+
+const (
+	VocabularyStatusUnspecified VocabularyStatus = iota
+	VocabularyStatusKnown
+	VocabularyStatusUnknown
+)
+
+// VocabularyWord is a word a learner marked as known or unknown. Words are
+// stored lower-cased, once per learner, with the latest mark.
+type VocabularyWord struct {
+	UserID string
+	Word   string
+	Status VocabularyStatus
+	// EpisodeID is the episode the word was last marked in, if any.
+	EpisodeID uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// MarkWordsParams marks words for a learner. VocabularyStatusUnspecified
+// forgets the marks.
+type MarkWordsParams struct {
+	UserID    string
+	EpisodeID uuid.UUID
+	Words     []string
+	Status    VocabularyStatus
+}
+
+// VocabularyFilter describes pagination and filtering options when listing
+// a learner's words.
+type VocabularyFilter struct {
+	UserID    string
+	Status    VocabularyStatus
+	PageSize  int
+	PageToken string
+}
+
+// VocabularyCoverage compares the words of an episode's transcript with the
+// words a learner knows.
+type VocabularyCoverage struct {
+	EpisodeID       uuid.UUID
+	UserID          string
+	WordCount       int
+	UniqueWordCount int
+	// KnownWordCount counts the running words the learner knows, so a known
+	// word used twice counts twice.
+	KnownWordCount       int
+	KnownUniqueWordCount int
+	// KnownPercent is the share of running words known, from 0 to 100.
+	// Content at around 95 is within reach without a dictionary.
+	KnownPercent float64
+	// NewWords lists the most frequent words the learner has not marked as
+	// known, most frequent first.
+	NewWords []WordFrequency
+}
+
+// VocabularyRepository persists learners' vocabulary.
+type VocabularyRepository interface {
+	// SaveWords inserts the words or replaces the marks of existing ones.
+	SaveWords(ctx context.Context, words ...VocabularyWord) error
+	DeleteWords(ctx context.Context, userID string, words []string) error
+	ListWords(ctx context.Context, filter VocabularyFilter) ([]VocabularyWord, string, error)
+	// WordStatuses returns the marks of those of the words the learner
+	// marked.
+	WordStatuses(ctx context.Context, userID string, words []string) (map[string]VocabularyStatus, error)
+}
+
+// VocabularyService exposes vocabulary tracking use cases to adapters.
+type VocabularyService interface {
+	MarkWords(ctx context.Context, params MarkWordsParams) ([]VocabularyWord, error)
+	ListVocabulary(ctx context.Context, filter VocabularyFilter) ([]VocabularyWord, string, error)
+	GetVocabularyCoverage(ctx context.Context, userID string, episodeID uuid.UUID) (*VocabularyCoverage, error)
+}
@@ -0,0 +1,54 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PlaybackSession records a single time a learner played an episode. Sessions
+// form an append-only history and are never merged; resumable playback
+// positions are tracked separately.
+type PlaybackSession struct {
+	ID         uuid.UUID
+	UserID     string
+	EpisodeID  uuid.UUID
+	Device     string
+	StartedAt  time.Time
+	FinishedAt *time.Time
+	CreatedAt  time.Time
+}
+
+// WatchHistoryFilter describes filters and pagination when listing history.
+// StartedAfter is inclusive and StartedBefore exclusive; zero values are unbounded.
+type WatchHistoryFilter struct {
+	UserID        string
+	EpisodeID     uuid.UUID
+	StartedAfter  time.Time
+	StartedBefore time.Time
+	PageSize      int
+	PageToken     string
+}
+
+// WatchHistoryRepository persists playback sessions.
+type WatchHistoryRepository interface {
+	CreatePlaybackSession(ctx context.Context, session PlaybackSession) (*PlaybackSession, error)
+	GetPlaybackSession(ctx context.Context, id uuid.UUID) (*PlaybackSession, error)
+	FinishPlaybackSession(ctx context.Context, id uuid.UUID, finishedAt time.Time) (*PlaybackSession, error)
+	ListPlaybackSessions(ctx context.Context, filter WatchHistoryFilter) ([]PlaybackSession, string, error)
+	// FirstFinishedAt returns, for each of the supplied episodes the learner has
+	// finished, the earliest time a session of it finished.
+	FirstFinishedAt(ctx context.Context, userID string, episodeIDs []uuid.UUID) (map[uuid.UUID]time.Time, error)
+	// DeletePlaybackSessions removes the learner's sessions started before the
+	// cutoff, or all of them when before is zero, returning how many were removed.
+	DeletePlaybackSessions(ctx context.Context, userID string, before time.Time) (int, error)
+}
+
+// WatchHistoryService exposes watch history use cases to adapters.
+type WatchHistoryService interface {
+	RecordPlaybackSession(ctx context.Context, session PlaybackSession) (*PlaybackSession, error)
+	FinishPlaybackSession(ctx context.Context, id uuid.UUID, finishedAt time.Time) (*PlaybackSession, error)
+	ListHistory(ctx context.Context, filter WatchHistoryFilter) ([]PlaybackSession, string, error)
+	ClearHistory(ctx context.Context, userID string, before time.Time) (int, error)
+}
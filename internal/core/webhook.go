@@ -0,0 +1,189 @@
+package core
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookEventType enumerates the content lifecycle events delivered to webhook endpoints.
+type WebhookEventType int
+
+const (
+	WebhookEventTypeUnspecified WebhookEventType = iota
+	// WebhookEventTypeSeriesPublished fires when a series is first published.
+	WebhookEventTypeSeriesPublished
+	// WebhookEventTypeEpisodeCreated fires when an episode is added to a series.
+	WebhookEventTypeEpisodeCreated
+	// WebhookEventTypeAssetReady fires when an uploaded asset becomes playable.
+	WebhookEventTypeAssetReady
+	// WebhookEventTypeEpisodePublished fires when an episode is first published.
+	WebhookEventTypeEpisodePublished
+)
+
+// String returns the event name used in webhook payloads, e.g. "series.published".
+func (t WebhookEventType) String() string {
+	switch t {
+	case WebhookEventTypeSeriesPublished:
+		return "series.published"
+	case WebhookEventTypeEpisodeCreated:
+		return "episode.created"
+	case WebhookEventTypeAssetReady:
+		return "asset.ready"
+	case WebhookEventTypeEpisodePublished:
+		return "episode.published"
+	default:
+		return "unspecified"
+	}
+}
+
+// WebhookDeliveryStatus tracks delivery of one event to one endpoint.
+type WebhookDeliveryStatus int
+
+const (
+	WebhookDeliveryStatusUnspecified WebhookDeliveryStatus = iota
+	// WebhookDeliveryStatusPending deliveries have not succeeded yet and will be retried.
+	WebhookDeliveryStatusPending
+	WebhookDeliveryStatusSucceeded
+	// WebhookDeliveryStatusFailed deliveries exhausted their retries.
+	WebhookDeliveryStatusFailed
+)
+
+// WebhookEndpoint is an integrator-registered URL that receives signed event payloads.
+type WebhookEndpoint struct {
+	ID          uuid.UUID
+	URL         string
+	Description string
+	// EventTypes filters the events delivered to the endpoint; empty subscribes to every event.
+	EventTypes []WebhookEventType
+	// Secret keys the HMAC signature sent with every delivery.
+	Secret    string
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Subscribes reports whether the endpoint receives events of type t.
+func (e WebhookEndpoint) Subscribes(t WebhookEventType) bool {
+	return len(e.EventTypes) == 0 || slices.Contains(e.EventTypes, t)
+}
+
+// WebhookDelivery records the attempts to deliver one event to one endpoint.
+type WebhookDelivery struct {
+	ID         uuid.UUID
+	EndpointID uuid.UUID
+	EventID    uuid.UUID
+	EventType  WebhookEventType
+	// Payload is the exact JSON body sent to the endpoint.
+	Payload  []byte
+	Status   WebhookDeliveryStatus
+	Attempts int
+	// ResponseStatus is the HTTP status of the last attempt, zero when no response was received.
+	ResponseStatus int
+	Error          string
+	NextAttemptAt  *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+// WebhookEvent is an event as delivered to webhook endpoints, kept for a
+// while so automation tools can poll for it instead of receiving it.
+type WebhookEvent struct {
+	ID   uuid.UUID
+	Type WebhookEventType
+	// Payload is the JSON body delivered to endpoints.
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+// WebhookRequest is a signed delivery attempt handed to a WebhookClient.
+type WebhookRequest struct {
+	URL        string
+	Secret     string
+	DeliveryID uuid.UUID
+	EventType  WebhookEventType
+	Payload    []byte
+	Timestamp  time.Time
+}
+
+// WebhookClient posts signed payloads to webhook endpoints. It returns the
+// response status code, and an error when the request failed or the endpoint
+// did not answer with a 2xx status.
+type WebhookClient interface {
+	Deliver(ctx context.Context, req WebhookRequest) (int, error)
+}
+
+// WebhookEndpointListFilter describes pagination options when listing endpoints.
+type WebhookEndpointListFilter struct {
+	PageSize  int
+	PageToken string
+}
+
+// WebhookDeliveryListFilter describes filters and pagination for the delivery log.
+type WebhookDeliveryListFilter struct {
+	EndpointID uuid.UUID
+	Statuses   []WebhookDeliveryStatus
+	PageSize   int
+	PageToken  string
+}
+
+// WebhookEventListFilter describes filters and pagination for recent events.
+type WebhookEventListFilter struct {
+	// Types restricts the list to the given event types; empty lists every event.
+	Types     []WebhookEventType
+	PageSize  int
+	PageToken string
+}
+
+// WebhookRepository persists webhook endpoints, their delivery log and the
+// recent events.
+type WebhookRepository interface {
+	CreateWebhookEndpoint(ctx context.Context, endpoint WebhookEndpoint) (*WebhookEndpoint, error)
+	GetWebhookEndpoint(ctx context.Context, id uuid.UUID) (*WebhookEndpoint, error)
+	UpdateWebhookEndpoint(ctx context.Context, endpoint WebhookEndpoint) (*WebhookEndpoint, error)
+	// DeleteWebhookEndpoint removes an endpoint together with its deliveries.
+	DeleteWebhookEndpoint(ctx context.Context, id uuid.UUID) error
+	ListWebhookEndpoints(ctx context.Context, filter WebhookEndpointListFilter) ([]WebhookEndpoint, string, error)
+	ListEnabledWebhookEndpoints(ctx context.Context) ([]WebhookEndpoint, error)
+	CreateWebhookDeliveries(ctx context.Context, deliveries []WebhookDelivery) error
+	GetWebhookDelivery(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery WebhookDelivery) (*WebhookDelivery, error)
+	// ListWebhookDeliveries returns the delivery log, newest first.
+	ListWebhookDeliveries(ctx context.Context, filter WebhookDeliveryListFilter) ([]WebhookDelivery, string, error)
+	// ListDueWebhookDeliveries returns up to limit pending deliveries whose next attempt is at or before now.
+	ListDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]WebhookDelivery, error)
+	CreateWebhookEvent(ctx context.Context, event WebhookEvent) error
+	// ListWebhookEvents returns recent events, newest first.
+	ListWebhookEvents(ctx context.Context, filter WebhookEventListFilter) ([]WebhookEvent, string, error)
+	// DeleteWebhookEventsBefore drops the events created before the given time.
+	DeleteWebhookEventsBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+// WebhookService exposes webhook registration and delivery use cases to adapters.
+type WebhookService interface {
+	CreateWebhookEndpoint(ctx context.Context, endpoint WebhookEndpoint) (*WebhookEndpoint, error)
+	GetWebhookEndpoint(ctx context.Context, id uuid.UUID) (*WebhookEndpoint, error)
+	UpdateWebhookEndpoint(ctx context.Context, endpoint WebhookEndpoint) (*WebhookEndpoint, error)
+	DeleteWebhookEndpoint(ctx context.Context, id uuid.UUID) error
+	ListWebhookEndpoints(ctx context.Context, filter WebhookEndpointListFilter) ([]WebhookEndpoint, string, error)
+	ListWebhookDeliveries(ctx context.Context, filter WebhookDeliveryListFilter) ([]WebhookDelivery, string, error)
+	// RedeliverWebhook attempts a logged delivery again, regardless of its status.
+	RedeliverWebhook(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error)
+	// ListRecentEvents returns the events of the last days, newest first,
+	// whether or not any endpoint subscribes to them, for automation tools
+	// that poll instead of receiving webhooks.
+	ListRecentEvents(ctx context.Context, filter WebhookEventListFilter) ([]WebhookEvent, string, error)
+	// NotifySeriesPublished, NotifyEpisodeCreated, NotifyEpisodePublished and
+	// NotifyAssetReady record the event, queue it for every subscribed
+	// endpoint and attempt each delivery once, returning how many succeeded.
+	NotifySeriesPublished(ctx context.Context, series Series) (int, error)
+	NotifyEpisodeCreated(ctx context.Context, episode Episode) (int, error)
+	NotifyEpisodePublished(ctx context.Context, episode Episode) (int, error)
+	NotifyAssetReady(ctx context.Context, asset Asset) (int, error)
+	// RetryWebhookDeliveries attempts pending deliveries that are due,
+	// returning how many succeeded, and drops events past their retention.
+	RetryWebhookDeliveries(ctx context.Context) (int, error)
+}
@@ -0,0 +1,35 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SeriesWidgetStats is the public summary shown by embeddable series widgets.
+type SeriesWidgetStats struct {
+	SeriesID      uuid.UUID
+	Slug          string
+	Title         string
+	EpisodeCount  int
+	TotalDuration time.Duration
+}
+
+// SeriesCertificate reports whether a learner finished every published episode of a series.
+type SeriesCertificate struct {
+	UserID            string
+	SeriesID          uuid.UUID
+	SeriesTitle       string
+	EpisodesCompleted int
+	EpisodeCount      int
+	Completed         bool
+	// CompletedAt is when the last outstanding episode was first finished.
+	CompletedAt *time.Time
+}
+
+// WidgetService exposes the public, read-only data behind embeddable widgets.
+type WidgetService interface {
+	GetSeriesWidgetStats(ctx context.Context, seriesID uuid.UUID) (*SeriesWidgetStats, error)
+	VerifySeriesCertificate(ctx context.Context, seriesID uuid.UUID, userID string) (*SeriesCertificate, error)
+}
@@ -0,0 +1,148 @@
+// Package i18n provides a message catalog with locale fallback chains used to
+// localize human-readable strings selected by the Accept-Language header.
+package i18n
+
+import (
+	"context"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// DefaultLocale is the locale every fallback chain ends with.
+const DefaultLocale = "en"
+
+// Catalog stores message templates keyed by locale and message key.
+// Templates may reference parameters as {name}. Catalogs are populated at
+// start-up and must not be modified while lookups are in flight.
+type Catalog struct {
+	defaultLocale string
+	messages      map[string]map[string]string
+}
+
+// NewCatalog creates an empty catalog that falls back to defaultLocale.
+func NewCatalog(defaultLocale string) *Catalog {
+	return &Catalog{
+		defaultLocale: canonicalLocale(defaultLocale),
+		messages:      make(map[string]map[string]string),
+	}
+}
+
+// Add registers messages for locale, overriding existing keys.
+func (c *Catalog) Add(locale string, messages map[string]string) {
+	locale = canonicalLocale(locale)
+	bucket, ok := c.messages[locale]
+	if !ok {
+		bucket = make(map[string]string, len(messages))
+		c.messages[locale] = bucket
+	}
+	for key, message := range messages {
+		bucket[key] = message
+	}
+}
+
+// Localizer resolves the fallback chain for an Accept-Language header value.
+// Each requested tag contributes itself and its parents (zh-Hant-TW, zh-Hant)
+// in preference order, followed by the catalog default locale.
+func (c *Catalog) Localizer(acceptLanguage string) *Localizer {
+	var chain []string
+	seen := make(map[string]struct{})
+	add := func(locale string) {
+		if _, ok := c.messages[locale]; !ok {
+			return
+		}
+		if _, ok := seen[locale]; ok {
+			return
+		}
+		seen[locale] = struct{}{}
+		chain = append(chain, locale)
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err == nil {
+		for _, tag := range tags {
+			for t := tag; t != language.Und; t = t.Parent() {
+				add(t.String())
+			}
+		}
+	}
+	add(c.defaultLocale)
+
+	return &Localizer{catalog: c, chain: chain}
+}
+
+// Localizer looks up messages along a resolved locale fallback chain.
+type Localizer struct {
+	catalog *Catalog
+	chain   []string
+}
+
+// Locale reports the most preferred locale the catalog can serve, or an empty
+// string when the catalog has no messages at all.
+func (l *Localizer) Locale() string {
+	if l == nil || len(l.chain) == 0 {
+		return ""
+	}
+	return l.chain[0]
+}
+
+// Chain returns the locales consulted for each lookup, most preferred first.
+func (l *Localizer) Chain() []string {
+	if l == nil {
+		return nil
+	}
+	return append([]string(nil), l.chain...)
+}
+
+// Lookup returns the template for key from the first locale in the chain that
+// defines it.
+func (l *Localizer) Lookup(key string) (string, bool) {
+	if l == nil {
+		return "", false
+	}
+	for _, locale := range l.chain {
+		if message, ok := l.catalog.messages[locale][key]; ok {
+			return message, true
+		}
+	}
+	return "", false
+}
+
+// Message renders key with params substituted for {name} placeholders. The key
+// itself is returned when no locale in the chain defines it.
+func (l *Localizer) Message(key string, params map[string]string) string {
+	message, ok := l.Lookup(key)
+	if !ok {
+		return key
+	}
+	if len(params) == 0 {
+		return message
+	}
+
+	pairs := make([]string, 0, len(params)*2)
+	for name, value := range params {
+		pairs = append(pairs, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(pairs...).Replace(message)
+}
+
+type localizerKey struct{}
+
+// NewContext returns a copy of ctx carrying the localizer.
+func NewContext(ctx context.Context, l *Localizer) context.Context {
+	return context.WithValue(ctx, localizerKey{}, l)
+}
+
+// FromContext returns the localizer stored in ctx, if any.
+func FromContext(ctx context.Context) (*Localizer, bool) {
+	l, ok := ctx.Value(localizerKey{}).(*Localizer)
+	return l, ok && l != nil
+}
+
+func canonicalLocale(locale string) string {
+	tag, err := language.Parse(strings.TrimSpace(locale))
+	if err != nil {
+		return strings.TrimSpace(locale)
+	}
+	return tag.String()
+}
@@ -0,0 +1,98 @@
+package i18n
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func newTestCatalog() *Catalog {
+	catalog := NewCatalog("en")
+	catalog.Add("en", map[string]string{
+		"greeting": "Hello, {name}!",
+		"farewell": "Goodbye",
+	})
+	catalog.Add("en-GB", map[string]string{"farewell": "Cheerio"})
+	catalog.Add("zh", map[string]string{"greeting": "你好，{name}！"})
+	catalog.Add("zh-Hant", map[string]string{"greeting": "您好，{name}！"})
+	return catalog
+}
+
+func TestCatalog_LocalizerChain(t *testing.T) {
+	catalog := newTestCatalog()
+
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		want           []string
+	}{
+		{name: "empty header uses default", acceptLanguage: "", want: []string{"en"}},
+		{name: "malformed header uses default", acceptLanguage: "%%%", want: []string{"en"}},
+		{name: "region falls back to language", acceptLanguage: "zh-CN", want: []string{"zh", "en"}},
+		{name: "script parent", acceptLanguage: "zh-Hant-TW", want: []string{"zh-Hant", "en"}},
+		{name: "quality ordering", acceptLanguage: "en-GB;q=0.5, zh;q=0.9", want: []string{"zh", "en-GB", "en"}},
+		{name: "zero quality excluded", acceptLanguage: "zh;q=0, en-GB", want: []string{"en-GB", "en"}},
+		{name: "unknown locale", acceptLanguage: "fr-FR", want: []string{"en"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := catalog.Localizer(tt.acceptLanguage).Chain()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Chain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalizer_Message(t *testing.T) {
+	catalog := newTestCatalog()
+
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		key            string
+		params         map[string]string
+		want           string
+	}{
+		{name: "substitutes params", acceptLanguage: "zh-CN", key: "greeting", params: map[string]string{"name": "Li"}, want: "你好，Li！"},
+		{name: "falls back along chain", acceptLanguage: "zh", key: "farewell", want: "Goodbye"},
+		{name: "regional override", acceptLanguage: "en-GB", key: "farewell", want: "Cheerio"},
+		{name: "unknown key returns key", acceptLanguage: "en", key: "missing", want: "missing"},
+		{name: "unused placeholder kept", acceptLanguage: "en", key: "greeting", want: "Hello, {name}!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := catalog.Localizer(tt.acceptLanguage).Message(tt.key, tt.params)
+			if got != tt.want {
+				t.Fatalf("Message() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalizer_Context(t *testing.T) {
+	if _, ok := FromContext(context.Background()); ok {
+		t.Fatal("expected no localizer in empty context")
+	}
+
+	l := newTestCatalog().Localizer("zh")
+	got, ok := FromContext(NewContext(context.Background(), l))
+	if !ok || got.Locale() != "zh" {
+		t.Fatalf("FromContext() = %v, %v; want zh localizer", got, ok)
+	}
+}
+
+func TestDefaultCatalog_LocalesDefineSameKeys(t *testing.T) {
+	for key := range englishMessages {
+		if _, ok := chineseMessages[key]; !ok {
+			t.Errorf("zh catalog missing key %q", key)
+		}
+	}
+	for key := range chineseMessages {
+		if _, ok := englishMessages[key]; !ok {
+			t.Errorf("en catalog missing key %q", key)
+		}
+	}
+}
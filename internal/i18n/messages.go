@@ -0,0 +1,159 @@
+package i18n
+
+// NewDefaultCatalog returns the built-in catalog with English and Simplified
+// Chinese messages for status labels, error descriptions, and notifications.
+//
+// Keys follow three namespaces:
+//   - enum.<PROTO_ENUM_VALUE> for human-readable enum labels
+//   - error.<reason> for localized error descriptions
+//   - notification.<event>.subject / .body for notification templates
+func NewDefaultCatalog() *Catalog {
+	catalog := NewCatalog(DefaultLocale)
+	catalog.Add("en", englishMessages)
+	catalog.Add("zh", chineseMessages)
+	return catalog
+}
+
+var englishMessages = map[string]string{
+	"enum.ASSET_STATUS_PENDING":    "Pending",
+	"enum.ASSET_STATUS_PROCESSING": "Processing",
+	"enum.ASSET_STATUS_READY":      "Ready",
+	"enum.ASSET_STATUS_FAILED":     "Failed",
+	"enum.ASSET_STATUS_DELETED":    "Deleted",
+
+	"enum.UPLOAD_STATUS_AWAITING_UPLOAD": "Awaiting upload",
+	"enum.UPLOAD_STATUS_UPLOADING":       "Uploading",
+	"enum.UPLOAD_STATUS_COMPLETED":       "Completed",
+	"enum.UPLOAD_STATUS_EXPIRED":         "Expired",
+	"enum.UPLOAD_STATUS_FAILED":          "Failed",
+	"enum.UPLOAD_STATUS_CANCELLED":       "Cancelled",
+
+	"enum.SERIES_STATUS_DRAFT":     "Draft",
+	"enum.SERIES_STATUS_PUBLISHED": "Published",
+	"enum.SERIES_STATUS_ARCHIVED":  "Archived",
+
+	"enum.EPISODE_STATUS_DRAFT":     "Draft",
+	"enum.EPISODE_STATUS_READY":     "Ready",
+	"enum.EPISODE_STATUS_PUBLISHED": "Published",
+	"enum.EPISODE_STATUS_ARCHIVED":  "Archived",
+
+	"enum.SHADOWING_SUBMISSION_STATUS_PENDING":  "Awaiting review",
+	"enum.SHADOWING_SUBMISSION_STATUS_REVIEWED": "Reviewed",
+
+	"enum.TRANSCRIPT_REPLACE_JOB_STATUS_PENDING":     "Queued",
+	"enum.TRANSCRIPT_REPLACE_JOB_STATUS_RUNNING":     "Running",
+	"enum.TRANSCRIPT_REPLACE_JOB_STATUS_SUCCEEDED":   "Completed",
+	"enum.TRANSCRIPT_REPLACE_JOB_STATUS_FAILED":      "Failed",
+	"enum.TRANSCRIPT_REPLACE_JOB_STATUS_ROLLED_BACK": "Rolled back",
+
+	"enum.SUBSCRIPTION_STATUS_ACTIVE":       "Active",
+	"enum.SUBSCRIPTION_STATUS_CANCELED":     "Canceled",
+	"enum.SUBSCRIPTION_STATUS_EXPIRED":      "Expired",
+	"enum.INVOICE_STATUS_OPEN":              "Open",
+	"enum.INVOICE_STATUS_PAID":              "Paid",
+	"enum.INVOICE_STATUS_VOID":              "Void",
+	"enum.ASSIGNMENT_STATUS_NOT_STARTED":    "Not started",
+	"enum.ASSIGNMENT_STATUS_IN_PROGRESS":    "In progress",
+	"enum.ASSIGNMENT_STATUS_COMPLETED":      "Completed",
+	"enum.ASSIGNMENT_STATUS_COMPLETED_LATE": "Completed late",
+	"enum.ASSIGNMENT_STATUS_OVERDUE":        "Overdue",
+
+	"error.validation":                 "The request is invalid. Please check the highlighted fields and try again.",
+	"error.invalid_page_token":         "The page token is invalid or has expired.",
+	"error.upload_identifier_required": "An upload ID or asset key is required.",
+	"error.not_found":                  "The requested resource could not be found.",
+	"error.already_exists":             "The resource already exists.",
+	"error.upload_invalid_state":       "The upload cannot be changed in its current state.",
+	"error.transcript_not_timed":       "This transcript has no timestamps, so it cannot be split into segments.",
+	"error.invalid_state":              "This action is not allowed in the resource's current state.",
+	"error.subscription_required":      "This episode requires an active subscription. Upgrade your plan to keep listening.",
+	"error.unauthenticated":            "The credentials supplied with this request are missing or invalid.",
+	"error.permission_denied":          "You do not have permission to perform this action.",
+	"error.timeout":                    "The request took too long to complete. Please narrow it down or try again later.",
+	"error.internal":                   "Something went wrong on our side. Please try again later.",
+
+	"notification.episode_published.subject":   "New episode in {series}",
+	"notification.episode_published.body":      "\"{episode}\" has just been published in {series}.",
+	"notification.submission_reviewed.subject": "Your shadowing recording was reviewed",
+	"notification.submission_reviewed.body":    "Your recording was scored {score}/100. {comment}",
+	"notification.assignment_due.subject":      "Assignment due tomorrow",
+	"notification.assignment_due.body":         "\"{assignment}\" is due on {due_date}.",
+	"notification.streak_reminder.subject":     "Keep your streak going",
+	"notification.streak_reminder.body":        "You practised yesterday. Listen to an episode today to keep your streak alive.",
+	"notification.asset_failed.subject":        "Asset processing failed",
+	"notification.asset_failed.body":           "\"{filename}\" ({asset_id}) could not be processed. Check the source file and upload it again.",
+	"notification.review_requested.subject":    "Moderation review requested",
+	"notification.review_requested.body":       "A {subject} by {author} is waiting in the moderation queue: \"{text}\"",
+}
+
+var chineseMessages = map[string]string{
+	"enum.ASSET_STATUS_PENDING":    "等待中",
+	"enum.ASSET_STATUS_PROCESSING": "处理中",
+	"enum.ASSET_STATUS_READY":      "就绪",
+	"enum.ASSET_STATUS_FAILED":     "失败",
+	"enum.ASSET_STATUS_DELETED":    "已删除",
+
+	"enum.UPLOAD_STATUS_AWAITING_UPLOAD": "等待上传",
+	"enum.UPLOAD_STATUS_UPLOADING":       "上传中",
+	"enum.UPLOAD_STATUS_COMPLETED":       "已完成",
+	"enum.UPLOAD_STATUS_EXPIRED":         "已过期",
+	"enum.UPLOAD_STATUS_FAILED":          "失败",
+	"enum.UPLOAD_STATUS_CANCELLED":       "已取消",
+
+	"enum.SERIES_STATUS_DRAFT":     "草稿",
+	"enum.SERIES_STATUS_PUBLISHED": "已发布",
+	"enum.SERIES_STATUS_ARCHIVED":  "已归档",
+
+	"enum.EPISODE_STATUS_DRAFT":     "草稿",
+	"enum.EPISODE_STATUS_READY":     "待发布",
+	"enum.EPISODE_STATUS_PUBLISHED": "已发布",
+	"enum.EPISODE_STATUS_ARCHIVED":  "已归档",
+
+	"enum.SHADOWING_SUBMISSION_STATUS_PENDING":  "待点评",
+	"enum.SHADOWING_SUBMISSION_STATUS_REVIEWED": "已点评",
+
+	"enum.TRANSCRIPT_REPLACE_JOB_STATUS_PENDING":     "排队中",
+	"enum.TRANSCRIPT_REPLACE_JOB_STATUS_RUNNING":     "运行中",
+	"enum.TRANSCRIPT_REPLACE_JOB_STATUS_SUCCEEDED":   "已完成",
+	"enum.TRANSCRIPT_REPLACE_JOB_STATUS_FAILED":      "失败",
+	"enum.TRANSCRIPT_REPLACE_JOB_STATUS_ROLLED_BACK": "已回滚",
+
+	"enum.SUBSCRIPTION_STATUS_ACTIVE":       "生效中",
+	"enum.SUBSCRIPTION_STATUS_CANCELED":     "已取消",
+	"enum.SUBSCRIPTION_STATUS_EXPIRED":      "已过期",
+	"enum.INVOICE_STATUS_OPEN":              "待支付",
+	"enum.INVOICE_STATUS_PAID":              "已支付",
+	"enum.INVOICE_STATUS_VOID":              "已作废",
+	"enum.ASSIGNMENT_STATUS_NOT_STARTED":    "未开始",
+	"enum.ASSIGNMENT_STATUS_IN_PROGRESS":    "进行中",
+	"enum.ASSIGNMENT_STATUS_COMPLETED":      "已完成",
+	"enum.ASSIGNMENT_STATUS_COMPLETED_LATE": "逾期完成",
+	"enum.ASSIGNMENT_STATUS_OVERDUE":        "已逾期",
+
+	"error.validation":                 "请求参数无效，请检查后重试。",
+	"error.invalid_page_token":         "分页标记无效或已过期。",
+	"error.upload_identifier_required": "需要提供上传 ID 或资源键。",
+	"error.not_found":                  "未找到请求的资源。",
+	"error.already_exists":             "资源已存在。",
+	"error.upload_invalid_state":       "上传当前状态不允许此操作。",
+	"error.transcript_not_timed":       "该字幕没有时间轴，无法切分片段。",
+	"error.invalid_state":              "当前状态不允许此操作。",
+	"error.subscription_required":      "该单集需要有效订阅，请升级套餐后继续收听。",
+	"error.unauthenticated":            "请求缺少有效的身份凭证。",
+	"error.permission_denied":          "您无权执行此操作。",
+	"error.timeout":                    "请求处理超时，请缩小查询范围或稍后再试。",
+	"error.internal":                   "服务器内部错误，请稍后再试。",
+
+	"notification.episode_published.subject":   "{series} 有新单集",
+	"notification.episode_published.body":      "《{episode}》已在 {series} 中发布。",
+	"notification.submission_reviewed.subject": "你的跟读录音已被点评",
+	"notification.submission_reviewed.body":    "你的录音得分 {score}/100。{comment}",
+	"notification.assignment_due.subject":      "作业明天截止",
+	"notification.assignment_due.body":         "《{assignment}》将于 {due_date} 截止。",
+	"notification.streak_reminder.subject":     "别让连续学习中断",
+	"notification.streak_reminder.body":        "你昨天坚持了学习，今天听一集就能延续连续学习记录。",
+	"notification.asset_failed.subject":        "素材处理失败",
+	"notification.asset_failed.body":           "《{filename}》（{asset_id}）处理失败，请检查源文件后重新上传。",
+	"notification.review_requested.subject":    "有内容待审核",
+	"notification.review_requested.body":       "{author} 提交的 {subject} 正在审核队列中等待处理：“{text}”",
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// AnalyticsExportService ships the daily engagement rollups, and optionally
+// the playback sessions they were computed from, to a warehouse one UTC day
+// at a time. Sinks replace a day's data on every export, so exporting the
+// days the rollups recompute keeps the warehouse in step with them.
+type AnalyticsExportService struct {
+	repo     core.EngagementRepository
+	sink     core.AnalyticsExportSink
+	sessions bool
+	now      func() time.Time
+}
+
+// NewAnalyticsExportService constructs an export service reading from the
+// engagement repository and writing to sink.
+func NewAnalyticsExportService(repo core.EngagementRepository, sink core.AnalyticsExportSink) *AnalyticsExportService {
+	return &AnalyticsExportService{
+		repo: repo,
+		sink: sink,
+		now:  time.Now,
+	}
+}
+
+// WithPlaybackSessions also exports the raw playback sessions of each day.
+func (s *AnalyticsExportService) WithPlaybackSessions(enabled bool) {
+	s.sessions = enabled
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *AnalyticsExportService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.AnalyticsExportService = (*AnalyticsExportService)(nil)
+
+// ExportAnalytics exports the rollups of the UTC day of day and, when
+// enabled, the sessions started that day. Days without rollups are exported
+// too, which clears data exported for them before.
+func (s *AnalyticsExportService) ExportAnalytics(ctx context.Context, day time.Time) (int, error) {
+	if s.sink == nil {
+		return 0, fmt.Errorf("%w: no analytics export sink is configured", core.ErrInvalidState)
+	}
+	if day.IsZero() {
+		return 0, fmt.Errorf("%w: day is required", core.ErrValidation)
+	}
+	day = truncateDay(day)
+	if !day.Before(truncateDay(s.now())) {
+		return 0, fmt.Errorf("%w: only past days can be exported", core.ErrValidation)
+	}
+
+	rollups, err := s.repo.ListDailyEngagementRollups(ctx, day)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.sink.ExportEngagementRollups(ctx, day, rollups); err != nil {
+		return 0, fmt.Errorf("export engagement rollups of %s: %w", day.Format(time.DateOnly), err)
+	}
+	exported := len(rollups)
+
+	if !s.sessions {
+		return exported, nil
+	}
+	sessions, err := s.repo.ListPlaybackSessions(ctx, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		return exported, err
+	}
+	if err := s.sink.ExportPlaybackSessions(ctx, day, sessions); err != nil {
+		return exported, fmt.Errorf("export playback sessions of %s: %w", day.Format(time.DateOnly), err)
+	}
+	return exported + len(sessions), nil
+}
+
+// ExportRecentAnalytics exports the days RollupRecentEngagement rolls up,
+// oldest first.
+func (s *AnalyticsExportService) ExportRecentAnalytics(ctx context.Context) (int, error) {
+	today := truncateDay(s.now())
+	total := 0
+	for days := recentRollupDays; days >= 1; days-- {
+		exported, err := s.ExportAnalytics(ctx, today.AddDate(0, 0, -days))
+		total += exported
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// recordingExportSink records the days and row counts it is given.
+type recordingExportSink struct {
+	rollupDays  []time.Time
+	sessionDays []time.Time
+	rows        int
+	err         error
+}
+
+func (s *recordingExportSink) ExportEngagementRollups(ctx context.Context, day time.Time, rollups []core.EngagementRollup) error {
+	s.rollupDays = append(s.rollupDays, day)
+	s.rows += len(rollups)
+	return s.err
+}
+
+func (s *recordingExportSink) ExportPlaybackSessions(ctx context.Context, day time.Time, sessions []core.PlaybackSession) error {
+	s.sessionDays = append(s.sessionDays, day)
+	s.rows += len(sessions)
+	return s.err
+}
+
+func TestAnalyticsExportService_ExportAnalytics(t *testing.T) {
+	ctx := context.Background()
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.UTC) }
+	day := date(2024, 5, 15)
+	seriesID, episodeID := uuid.New(), uuid.New()
+	repo := &stubEngagementRepo{
+		sessions: []core.PlaybackSession{
+			{UserID: "u1", EpisodeID: episodeID, StartedAt: day.Add(time.Hour)},
+			{UserID: "u2", EpisodeID: episodeID, StartedAt: day.Add(-time.Hour)},
+		},
+		rollups: []core.EngagementRollup{
+			{SubjectID: seriesID, SeriesID: seriesID, Day: &day},
+			{SubjectID: episodeID, SeriesID: seriesID, Day: &day},
+			{SubjectID: episodeID, SeriesID: seriesID},
+		},
+	}
+	sink := &recordingExportSink{}
+	service := NewAnalyticsExportService(repo, sink)
+	service.WithClock(func() time.Time { return date(2024, 5, 16).Add(3 * time.Hour) })
+
+	exported, err := service.ExportAnalytics(ctx, day.Add(12*time.Hour))
+	if err != nil {
+		t.Fatalf("ExportAnalytics() error = %v", err)
+	}
+	if exported != 2 || len(sink.rollupDays) != 1 || !sink.rollupDays[0].Equal(day) || len(sink.sessionDays) != 0 {
+		t.Fatalf("expected the 2 daily rollups of %v only, got %d rows for %v and sessions for %v", day, exported, sink.rollupDays, sink.sessionDays)
+	}
+
+	service.WithPlaybackSessions(true)
+	exported, err = service.ExportAnalytics(ctx, day)
+	if err != nil {
+		t.Fatalf("ExportAnalytics() error = %v", err)
+	}
+	if exported != 3 || len(sink.sessionDays) != 1 {
+		t.Fatalf("expected the rollups and the session started that day, got %d rows", exported)
+	}
+
+	sink.rollupDays = nil
+	if _, err := service.ExportRecentAnalytics(ctx); err != nil {
+		t.Fatalf("ExportRecentAnalytics() error = %v", err)
+	}
+	if len(sink.rollupDays) != 2 || !sink.rollupDays[0].Equal(date(2024, 5, 14)) || !sink.rollupDays[1].Equal(day) {
+		t.Fatalf("expected the 2 previous days oldest first, got %v", sink.rollupDays)
+	}
+}
+
+func TestAnalyticsExportService_ExportAnalyticsErrors(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 5, 16, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		sink core.AnalyticsExportSink
+		day  time.Time
+		want error
+	}{
+		{name: "no sink", sink: nil, day: now.AddDate(0, 0, -1), want: core.ErrInvalidState},
+		{name: "missing day", sink: &recordingExportSink{}, want: core.ErrValidation},
+		{name: "today", sink: &recordingExportSink{}, day: now, want: core.ErrValidation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewAnalyticsExportService(&stubEngagementRepo{}, tt.sink)
+			service.WithClock(func() time.Time { return now })
+			if _, err := service.ExportAnalytics(ctx, tt.day); !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	sinkErr := errors.New("warehouse unavailable")
+	service := NewAnalyticsExportService(&stubEngagementRepo{}, &recordingExportSink{err: sinkErr})
+	service.WithClock(func() time.Time { return now })
+	if _, err := service.ExportAnalytics(ctx, now.AddDate(0, 0, -1)); !errors.Is(err, sinkErr) {
+		t.Fatalf("expected the sink error, got %v", err)
+	}
+}
@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// EngagementRollupJobKind is the kind of the jobs queued by
+	// RequestEngagementRollup.
+	EngagementRollupJobKind = "analytics.rollup"
+
+	defaultAnalyticsDays = 30
+	maxAnalyticsDays     = 366
+	// recentRollupDays is how many previous days RollupRecentEngagement
+	// rolls up: sessions started late in a day finish on the next.
+	recentRollupDays = 2
+)
+
+// engagementRollupPayload is the payload of engagement rollup jobs.
+type engagementRollupPayload struct {
+	Day time.Time `json:"day"`
+}
+
+// AnalyticsService rolls up playback sessions into per-episode and
+// per-series engagement metrics and reports them. Rollups are recomputed
+// from the sessions, so rolling up a day again replaces its metrics.
+type AnalyticsService struct {
+	repo   core.EngagementRepository
+	series core.SeriesRepository
+	jobs   core.JobQueue
+	now    func() time.Time
+}
+
+// NewAnalyticsService constructs an analytics service on the engagement
+// repository, reading episodes from the series repository and queueing
+// rollups on jobs.
+func NewAnalyticsService(repo core.EngagementRepository, series core.SeriesRepository, jobs core.JobQueue) *AnalyticsService {
+	return &AnalyticsService{
+		repo:   repo,
+		series: series,
+		jobs:   jobs,
+		now:    time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *AnalyticsService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.AnalyticsService = (*AnalyticsService)(nil)
+
+// GetEpisodeAnalytics reports the engagement with an episode.
+func (s *AnalyticsService) GetEpisodeAnalytics(ctx context.Context, query core.EngagementAnalyticsQuery) (*core.EngagementAnalytics, error) {
+	if query.ID == uuid.Nil {
+		return nil, fmt.Errorf("%w: episode id is required", core.ErrValidation)
+	}
+	episode, err := s.series.GetEpisode(ctx, query.ID)
+	if err != nil {
+		return nil, err
+	}
+	return s.analytics(ctx, episode.ID, episode.SeriesID, query)
+}
+
+// GetSeriesAnalytics reports the engagement with a series across its
+// episodes; a learner playing several episodes counts as one listener.
+func (s *AnalyticsService) GetSeriesAnalytics(ctx context.Context, query core.EngagementAnalyticsQuery) (*core.EngagementAnalytics, error) {
+	if query.ID == uuid.Nil {
+		return nil, fmt.Errorf("%w: series id is required", core.ErrValidation)
+	}
+	series, err := s.series.GetSeries(ctx, query.ID, core.SeriesQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return s.analytics(ctx, series.ID, series.ID, query)
+}
+
+func (s *AnalyticsService) analytics(ctx context.Context, subjectID, seriesID uuid.UUID, query core.EngagementAnalyticsQuery) (*core.EngagementAnalytics, error) {
+	from, to, err := reportDays(query.From, query.To, s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	rollups, err := s.repo.ListEngagementRollups(ctx, subjectID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	analytics := &core.EngagementAnalytics{
+		SubjectID: subjectID,
+		SeriesID:  seriesID,
+	}
+	daily := make(map[time.Time]core.EngagementMetrics, len(rollups))
+	for _, rollup := range rollups {
+		if rollup.Day == nil {
+			updatedAt := rollup.UpdatedAt
+			analytics.Total = rollup.Metrics
+			analytics.RolledUpAt = &updatedAt
+			continue
+		}
+		daily[truncateDay(*rollup.Day)] = rollup.Metrics
+	}
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		analytics.Days = append(analytics.Days, core.DailyEngagement{Day: day, Metrics: daily[day]})
+	}
+	return analytics, nil
+}
+
+// reportDays resolves the UTC days [from, to) of a report. Missing bounds
+// default to a range of defaultAnalyticsDays, ending today when both are.
+func reportDays(from, to, now time.Time) (time.Time, time.Time, error) {
+	start, end := truncateDay(from), truncateDay(to)
+	switch {
+	case from.IsZero() && to.IsZero():
+		end = truncateDay(now).AddDate(0, 0, 1)
+		start = end.AddDate(0, 0, -defaultAnalyticsDays)
+	case from.IsZero():
+		start = end.AddDate(0, 0, -defaultAnalyticsDays)
+	case to.IsZero():
+		end = start.AddDate(0, 0, defaultAnalyticsDays)
+	}
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: range must end after it starts", core.ErrValidation)
+	}
+	if end.Sub(start) > maxAnalyticsDays*oneDay {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: range must not exceed %d days", core.ErrValidation, maxAnalyticsDays)
+	}
+	return start, end, nil
+}
+
+// RollupEngagement recomputes the day's rollups of every episode with
+// sessions started that day and of their series, along with their all-time
+// totals. Episodes deleted since are skipped.
+func (s *AnalyticsService) RollupEngagement(ctx context.Context, day time.Time) (int, error) {
+	if day.IsZero() {
+		return 0, fmt.Errorf("%w: day is required", core.ErrValidation)
+	}
+	from := truncateDay(day)
+	to := from.AddDate(0, 0, 1)
+
+	episodeIDs, err := s.repo.ListPlayedEpisodes(ctx, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		rollups   []core.EngagementRollup
+		seriesIDs []uuid.UUID
+		seen      = map[uuid.UUID]bool{}
+	)
+	rollup := func(subjectID, seriesID uuid.UUID, episodeIDs []uuid.UUID) error {
+		daily, err := s.repo.SummarizePlayback(ctx, episodeIDs, from, to)
+		if err != nil {
+			return err
+		}
+		total, err := s.repo.SummarizePlayback(ctx, episodeIDs, time.Time{}, time.Time{})
+		if err != nil {
+			return err
+		}
+		rollups = append(rollups,
+			core.EngagementRollup{SubjectID: subjectID, SeriesID: seriesID, Day: &from, Metrics: daily},
+			core.EngagementRollup{SubjectID: subjectID, SeriesID: seriesID, Metrics: total},
+		)
+		return nil
+	}
+
+	for _, id := range episodeIDs {
+		episode, err := s.series.GetEpisode(ctx, id)
+		if isNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		if err := rollup(episode.ID, episode.SeriesID, []uuid.UUID{episode.ID}); err != nil {
+			return 0, err
+		}
+		if !seen[episode.SeriesID] {
+			seen[episode.SeriesID] = true
+			seriesIDs = append(seriesIDs, episode.SeriesID)
+		}
+	}
+	for _, id := range seriesIDs {
+		series, err := s.series.GetSeries(ctx, id, core.SeriesQueryOptions{IncludeEpisodes: true})
+		if isNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		ids := make([]uuid.UUID, 0, len(series.Episodes))
+		for _, episode := range series.Episodes {
+			ids = append(ids, episode.ID)
+		}
+		if err := rollup(series.ID, series.ID, ids); err != nil {
+			return 0, err
+		}
+	}
+
+	if len(rollups) == 0 {
+		return 0, nil
+	}
+	if err := s.repo.SaveEngagementRollups(ctx, rollups); err != nil {
+		return 0, err
+	}
+	return len(rollups), nil
+}
+
+// RollupRecentEngagement rolls up yesterday and the day before, which
+// picks up the sessions started before midnight that finished after the
+// day's first rollup.
+func (s *AnalyticsService) RollupRecentEngagement(ctx context.Context) (int, error) {
+	today := truncateDay(s.now())
+	total := 0
+	for days := recentRollupDays; days >= 1; days-- {
+		written, err := s.RollupEngagement(ctx, today.AddDate(0, 0, -days))
+		total += written
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// RequestEngagementRollup queues a rollup of the UTC day of day.
+func (s *AnalyticsService) RequestEngagementRollup(ctx context.Context, day time.Time) (*core.Job, error) {
+	if day.IsZero() {
+		return nil, fmt.Errorf("%w: day is required", core.ErrValidation)
+	}
+	day = truncateDay(day)
+	if day.After(s.now()) {
+		return nil, fmt.Errorf("%w: day must not be in the future", core.ErrValidation)
+	}
+	payload, err := json.Marshal(engagementRollupPayload{Day: day})
+	if err != nil {
+		return nil, err
+	}
+	return s.jobs.Enqueue(ctx, core.JobRequest{
+		Kind:    EngagementRollupJobKind,
+		Payload: payload,
+	})
+}
+
+// HandleRollupJob runs a rollup queued by RequestEngagementRollup.
+func (s *AnalyticsService) HandleRollupJob(ctx context.Context, job core.Job) error {
+	var payload engagementRollupPayload
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return fmt.Errorf("decode rollup job payload: %w", err)
+	}
+	_, err := s.RollupEngagement(ctx, payload.Day)
+	return err
+}
@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestAnalyticsService_RollupEngagement(t *testing.T) {
+	ctx := context.Background()
+	fixedNow := time.Date(2024, 5, 16, 3, 0, 0, 0, time.UTC)
+	seriesID := uuid.New()
+	episodes := []core.Episode{{ID: uuid.New(), SeriesID: seriesID}, {ID: uuid.New(), SeriesID: seriesID}}
+	finished := fixedNow
+	repo := &stubEngagementRepo{savedAt: fixedNow, sessions: []core.PlaybackSession{
+		{UserID: "u1", EpisodeID: episodes[0].ID, StartedAt: date(2024, 5, 15).Add(time.Hour), FinishedAt: &finished},
+		{UserID: "u1", EpisodeID: episodes[1].ID, StartedAt: date(2024, 5, 15).Add(2 * time.Hour)},
+		{UserID: "u2", EpisodeID: episodes[0].ID, StartedAt: date(2024, 5, 15).Add(3 * time.Hour)},
+		{UserID: "u3", EpisodeID: episodes[0].ID, StartedAt: date(2024, 5, 10), FinishedAt: &finished},
+	}}
+	service := NewAnalyticsService(repo, analyticsSeriesRepo(seriesID, episodes), NewJobService(&stubJobRepo{}))
+	service.WithClock(func() time.Time { return fixedNow })
+
+	written, err := service.RollupEngagement(ctx, date(2024, 5, 15).Add(12*time.Hour))
+	if err != nil {
+		t.Fatalf("RollupEngagement() error = %v", err)
+	}
+	if written != 6 {
+		t.Fatalf("expected daily and total rollups of 2 episodes and 1 series, got %d", written)
+	}
+
+	analytics, err := service.GetEpisodeAnalytics(ctx, core.EngagementAnalyticsQuery{ID: episodes[0].ID, From: date(2024, 5, 14), To: date(2024, 5, 16)})
+	if err != nil {
+		t.Fatalf("GetEpisodeAnalytics() error = %v", err)
+	}
+	if analytics.Total != (core.EngagementMetrics{Sessions: 3, FinishedSessions: 2, UniqueListeners: 3, Completers: 2}) {
+		t.Fatalf("unexpected episode totals %#v", analytics.Total)
+	}
+	if len(analytics.Days) != 2 || analytics.Days[0].Metrics.Sessions != 0 || analytics.Days[1].Metrics.Sessions != 2 {
+		t.Fatalf("expected an empty day then the rolled-up day, got %#v", analytics.Days)
+	}
+	if got := analytics.Days[1].Metrics.CompletionRate(); got != 0.5 {
+		t.Fatalf("expected completion rate 0.5, got %v", got)
+	}
+	if analytics.RolledUpAt == nil || !analytics.RolledUpAt.Equal(fixedNow) {
+		t.Fatalf("expected rollup time %v, got %v", fixedNow, analytics.RolledUpAt)
+	}
+
+	analytics, err = service.GetSeriesAnalytics(ctx, core.EngagementAnalyticsQuery{ID: seriesID})
+	if err != nil {
+		t.Fatalf("GetSeriesAnalytics() error = %v", err)
+	}
+	if len(analytics.Days) != defaultAnalyticsDays || !analytics.Days[len(analytics.Days)-1].Day.Equal(date(2024, 5, 16)) {
+		t.Fatalf("expected the last %d days up to today, got %d", defaultAnalyticsDays, len(analytics.Days))
+	}
+	if analytics.Total.Sessions != 4 || analytics.Total.UniqueListeners != 3 {
+		t.Fatalf("expected a learner playing two episodes to count once, got %#v", analytics.Total)
+	}
+}
+
+func TestAnalyticsService_GetEpisodeAnalyticsValidatesRange(t *testing.T) {
+	seriesID := uuid.New()
+	episode := core.Episode{ID: uuid.New(), SeriesID: seriesID}
+	service := NewAnalyticsService(&stubEngagementRepo{}, analyticsSeriesRepo(seriesID, []core.Episode{episode}), NewJobService(&stubJobRepo{}))
+
+	tests := []struct {
+		name  string
+		query core.EngagementAnalyticsQuery
+	}{
+		{name: "missing id", query: core.EngagementAnalyticsQuery{}},
+		{name: "reversed", query: core.EngagementAnalyticsQuery{ID: episode.ID, From: date(2024, 5, 2), To: date(2024, 5, 1)}},
+		{name: "too long", query: core.EngagementAnalyticsQuery{ID: episode.ID, From: date(2023, 1, 1), To: date(2024, 5, 1)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := service.GetEpisodeAnalytics(context.Background(), tt.query); !errors.Is(err, core.ErrValidation) {
+				t.Fatalf("expected validation error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestAnalyticsService_RequestEngagementRollup(t *testing.T) {
+	ctx := context.Background()
+	fixedNow := time.Date(2024, 5, 16, 3, 0, 0, 0, time.UTC)
+	jobs := &stubJobRepo{}
+	service := NewAnalyticsService(&stubEngagementRepo{}, &stubSeriesRepo{}, NewJobService(jobs))
+	service.WithClock(func() time.Time { return fixedNow })
+
+	if _, err := service.RequestEngagementRollup(ctx, fixedNow.AddDate(0, 0, 1)); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected validation error for a future day, got %v", err)
+	}
+	job, err := service.RequestEngagementRollup(ctx, fixedNow)
+	if err != nil {
+		t.Fatalf("RequestEngagementRollup() error = %v", err)
+	}
+	if job.Kind != EngagementRollupJobKind {
+		t.Fatalf("unexpected job kind %q", job.Kind)
+	}
+	if err := service.HandleRollupJob(ctx, *job); err != nil {
+		t.Fatalf("HandleRollupJob() error = %v", err)
+	}
+}
+
+func analyticsSeriesRepo(seriesID uuid.UUID, episodes []core.Episode) *stubSeriesRepo {
+	return &stubSeriesRepo{
+		getSeriesFn: func(ctx context.Context, id uuid.UUID, opts core.SeriesQueryOptions) (*core.Series, error) {
+			if id != seriesID {
+				return nil, core.ErrNotFound
+			}
+			return &core.Series{ID: seriesID, Episodes: episodes}, nil
+		},
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			for _, episode := range episodes {
+				if episode.ID == id {
+					return &episode, nil
+				}
+			}
+			return nil, core.ErrNotFound
+		},
+	}
+}
+
+// stubEngagementRepo aggregates in-memory sessions like the database does.
+type stubEngagementRepo struct {
+	sessions []core.PlaybackSession
+	rollups  []core.EngagementRollup
+	// savedAt stamps saved rollups, as the time mixin does.
+	savedAt time.Time
+}
+
+func (s *stubEngagementRepo) ListPlayedEpisodes(ctx context.Context, from, to time.Time) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	for _, session := range s.sessions {
+		if !session.StartedAt.Before(from) && session.StartedAt.Before(to) && !slices.Contains(ids, session.EpisodeID) {
+			ids = append(ids, session.EpisodeID)
+		}
+	}
+	return ids, nil
+}
+
+func (s *stubEngagementRepo) SummarizePlayback(ctx context.Context, episodeIDs []uuid.UUID, from, to time.Time) (core.EngagementMetrics, error) {
+	var metrics core.EngagementMetrics
+	listeners, completers := map[string]bool{}, map[string]bool{}
+	for _, session := range s.sessions {
+		if !slices.Contains(episodeIDs, session.EpisodeID) {
+			continue
+		}
+		if (!from.IsZero() && session.StartedAt.Before(from)) || (!to.IsZero() && !session.StartedAt.Before(to)) {
+			continue
+		}
+		metrics.Sessions++
+		listeners[session.UserID] = true
+		if session.FinishedAt != nil {
+			metrics.FinishedSessions++
+			completers[session.UserID] = true
+		}
+	}
+	metrics.UniqueListeners, metrics.Completers = len(listeners), len(completers)
+	return metrics, nil
+}
+
+func (s *stubEngagementRepo) SaveEngagementRollups(ctx context.Context, rollups []core.EngagementRollup) error {
+	for _, rollup := range rollups {
+		s.rollups = slices.DeleteFunc(s.rollups, func(existing core.EngagementRollup) bool {
+			return existing.SubjectID == rollup.SubjectID && (existing.Day == nil) == (rollup.Day == nil) &&
+				(existing.Day == nil || existing.Day.Equal(*rollup.Day))
+		})
+		rollup.UpdatedAt = s.savedAt
+		s.rollups = append(s.rollups, rollup)
+	}
+	return nil
+}
+
+func (s *stubEngagementRepo) ListEngagementRollups(ctx context.Context, subjectID uuid.UUID, from, to time.Time) ([]core.EngagementRollup, error) {
+	var rollups []core.EngagementRollup
+	for _, rollup := range s.rollups {
+		if rollup.SubjectID != subjectID {
+			continue
+		}
+		if rollup.Day == nil || (!rollup.Day.Before(from) && rollup.Day.Before(to)) {
+			rollups = append(rollups, rollup)
+		}
+	}
+	return rollups, nil
+}
+
+func (s *stubEngagementRepo) ListDailyEngagementRollups(ctx context.Context, day time.Time) ([]core.EngagementRollup, error) {
+	var rollups []core.EngagementRollup
+	for _, rollup := range s.rollups {
+		if rollup.Day != nil && rollup.Day.Equal(day) {
+			rollups = append(rollups, rollup)
+		}
+	}
+	return rollups, nil
+}
+
+func (s *stubEngagementRepo) ListPlaybackSessions(ctx context.Context, from, to time.Time) ([]core.PlaybackSession, error) {
+	var sessions []core.PlaybackSession
+	for _, session := range s.sessions {
+		if !session.StartedAt.Before(from) && session.StartedAt.Before(to) {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions, nil
+}
@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// apiKeyDisplayLength is how much of a key is kept to tell keys apart.
+	apiKeyDisplayLength = len(core.APIKeyPrefix) + 8
+	// apiKeyTouchInterval limits how often last-used times are written, so
+	// busy integrations do not write on every request.
+	apiKeyTouchInterval = time.Minute
+	maxAPIKeyScopes     = 50
+)
+
+// APIKeyService issues API keys for machine clients and authenticates them.
+// Only the SHA-256 digest of a key is stored.
+type APIKeyService struct {
+	repo core.APIKeyRepository
+	now  func() time.Time
+}
+
+// NewAPIKeyService constructs an API key service using the supplied repository.
+func NewAPIKeyService(repo core.APIKeyRepository) *APIKeyService {
+	return &APIKeyService{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *APIKeyService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.APIKeyService = (*APIKeyService)(nil)
+
+// CreateAPIKey generates a key with the given name, scopes and expiry.
+func (s *APIKeyService) CreateAPIKey(ctx context.Context, key core.APIKey) (*core.APIKey, string, error) {
+	now := s.now().UTC()
+	key.Name = strings.TrimSpace(key.Name)
+	if key.Name == "" {
+		return nil, "", fmt.Errorf("%w: api key name required", core.ErrValidation)
+	}
+	scopes, err := normalizeAPIKeyScopes(key.Scopes)
+	if err != nil {
+		return nil, "", err
+	}
+	if key.ExpiresAt != nil && !key.ExpiresAt.After(now) {
+		return nil, "", fmt.Errorf("%w: api key expiry must be in the future", core.ErrValidation)
+	}
+
+	secret, err := generateAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	key.ID = uuid.New()
+	key.Prefix = secret[:apiKeyDisplayLength]
+	key.Hash = hashAPIKey(secret)
+	key.Scopes = scopes
+	key.LastUsedAt = nil
+	key.RevokedAt = nil
+	created, err := s.repo.CreateAPIKey(ctx, key)
+	if err != nil {
+		return nil, "", err
+	}
+	return created, secret, nil
+}
+
+// RevokeAPIKey stops a key from authenticating.
+func (s *APIKeyService) RevokeAPIKey(ctx context.Context, id uuid.UUID) (*core.APIKey, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: api key id required", core.ErrValidation)
+	}
+	return s.repo.RevokeAPIKey(ctx, id, s.now().UTC())
+}
+
+// ListAPIKeys returns a paginated collection of keys.
+func (s *APIKeyService) ListAPIKeys(ctx context.Context, filter core.APIKeyListFilter) ([]core.APIKey, string, error) {
+	return s.repo.ListAPIKeys(ctx, filter)
+}
+
+// Authenticate resolves a presented key and records that it was used.
+func (s *APIKeyService) Authenticate(ctx context.Context, secret string) (*core.APIKey, error) {
+	if !strings.HasPrefix(secret, core.APIKeyPrefix) {
+		return nil, fmt.Errorf("%w: malformed api key", core.ErrUnauthenticated)
+	}
+	key, err := s.repo.GetAPIKeyByHash(ctx, hashAPIKey(secret))
+	if err != nil {
+		if errors.Is(err, core.ErrNotFound) {
+			return nil, fmt.Errorf("%w: unknown api key", core.ErrUnauthenticated)
+		}
+		return nil, err
+	}
+
+	now := s.now().UTC()
+	if !key.Active(now) {
+		return nil, fmt.Errorf("%w: api key revoked or expired", core.ErrUnauthenticated)
+	}
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyTouchInterval {
+		// Usage tracking is best-effort and never fails the request.
+		if err := s.repo.TouchAPIKey(ctx, key.ID, now); err == nil {
+			key.LastUsedAt = &now
+		}
+	}
+	return key, nil
+}
+
+// normalizeAPIKeyScopes trims and deduplicates scopes, rejecting keys that
+// would grant nothing.
+func normalizeAPIKeyScopes(scopes []string) ([]string, error) {
+	scopes = lo.Uniq(lo.Map(scopes, func(scope string, _ int) string {
+		return strings.Trim(strings.TrimSpace(scope), "/")
+	}))
+	scopes = lo.Compact(scopes)
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("%w: at least one api key scope required", core.ErrValidation)
+	}
+	if len(scopes) > maxAPIKeyScopes {
+		return nil, fmt.Errorf("%w: at most %d api key scopes allowed", core.ErrValidation, maxAPIKeyScopes)
+	}
+	for _, scope := range scopes {
+		if strings.ContainsAny(scope, " \t\n") {
+			return nil, fmt.Errorf("%w: invalid api key scope %q", core.ErrValidation, scope)
+		}
+		if id, ok := strings.CutPrefix(scope, core.APIKeySeriesScopePrefix); ok {
+			if _, err := uuid.Parse(id); err != nil {
+				return nil, fmt.Errorf("%w: invalid series in api key scope %q", core.ErrValidation, scope)
+			}
+		}
+	}
+	return scopes, nil
+}
+
+func generateAPIKey() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate api key: %w", err)
+	}
+	return core.APIKeyPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashAPIKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
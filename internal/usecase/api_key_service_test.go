@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubAPIKeyRepo struct {
+	keys    map[uuid.UUID]core.APIKey
+	touches int
+}
+
+func newStubAPIKeyRepo() *stubAPIKeyRepo {
+	return &stubAPIKeyRepo{keys: map[uuid.UUID]core.APIKey{}}
+}
+
+func (s *stubAPIKeyRepo) CreateAPIKey(ctx context.Context, key core.APIKey) (*core.APIKey, error) {
+	s.keys[key.ID] = key
+	return &key, nil
+}
+
+func (s *stubAPIKeyRepo) GetAPIKey(ctx context.Context, id uuid.UUID) (*core.APIKey, error) {
+	key, ok := s.keys[id]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	return &key, nil
+}
+
+func (s *stubAPIKeyRepo) GetAPIKeyByHash(ctx context.Context, hash string) (*core.APIKey, error) {
+	for _, key := range s.keys {
+		if key.Hash == hash {
+			return &key, nil
+		}
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubAPIKeyRepo) RevokeAPIKey(ctx context.Context, id uuid.UUID, revokedAt time.Time) (*core.APIKey, error) {
+	key, ok := s.keys[id]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	if key.RevokedAt == nil {
+		key.RevokedAt = &revokedAt
+	}
+	s.keys[id] = key
+	return &key, nil
+}
+
+func (s *stubAPIKeyRepo) TouchAPIKey(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	key, ok := s.keys[id]
+	if !ok {
+		return core.ErrNotFound
+	}
+	key.LastUsedAt = &usedAt
+	s.keys[id] = key
+	s.touches++
+	return nil
+}
+
+func (s *stubAPIKeyRepo) ListAPIKeys(ctx context.Context, filter core.APIKeyListFilter) ([]core.APIKey, string, error) {
+	var keys []core.APIKey
+	for _, key := range s.keys {
+		keys = append(keys, key)
+	}
+	return keys, "", nil
+}
+
+func TestAPIKeyService_CreateAndAuthenticate(t *testing.T) {
+	ctx := context.Background()
+	repo := newStubAPIKeyRepo()
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	svc := NewAPIKeyService(repo)
+	svc.WithClock(func() time.Time { return now })
+
+	key, secret, err := svc.CreateAPIKey(ctx, core.APIKey{
+		Name:   " catalog sync ",
+		Scopes: []string{"/lession.v1.SeriesService/", "lession.v1.SeriesService", ""},
+	})
+	if err != nil {
+		t.Fatalf("CreateAPIKey() error = %v", err)
+	}
+	if !strings.HasPrefix(secret, core.APIKeyPrefix) || !strings.HasPrefix(secret, key.Prefix) || key.Hash == "" || strings.Contains(key.Hash, secret) {
+		t.Fatalf("unexpected key %+v for secret %q", key, secret)
+	}
+	if key.Name != "catalog sync" || len(key.Scopes) != 1 || key.Scopes[0] != "lession.v1.SeriesService" {
+		t.Fatalf("expected normalized name and scopes, got %+v", key)
+	}
+
+	authenticated, err := svc.Authenticate(ctx, secret)
+	if err != nil || authenticated.ID != key.ID {
+		t.Fatalf("expected the key to authenticate, got %v, %v", authenticated, err)
+	}
+	if !authenticated.Allows("/lession.v1.SeriesService/ListSeries") || authenticated.Allows("/lession.v1.ApiKeyService/CreateApiKey") {
+		t.Fatalf("unexpected scope checks for %v", authenticated.Scopes)
+	}
+
+	// Usage is written at most once per interval.
+	now = now.Add(10 * time.Second)
+	if _, err := svc.Authenticate(ctx, secret); err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if repo.touches != 1 {
+		t.Fatalf("expected last use to be recorded once, got %d", repo.touches)
+	}
+
+	if _, err := svc.Authenticate(ctx, secret+"x"); !errors.Is(err, core.ErrUnauthenticated) {
+		t.Fatalf("expected ErrUnauthenticated for an unknown key, got %v", err)
+	}
+	if _, err := svc.RevokeAPIKey(ctx, key.ID); err != nil {
+		t.Fatalf("RevokeAPIKey() error = %v", err)
+	}
+	if _, err := svc.Authenticate(ctx, secret); !errors.Is(err, core.ErrUnauthenticated) {
+		t.Fatalf("expected ErrUnauthenticated for a revoked key, got %v", err)
+	}
+}
+
+func TestAPIKeyService_CreateValidation(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	svc := NewAPIKeyService(newStubAPIKeyRepo())
+	svc.WithClock(func() time.Time { return now })
+
+	past := now.Add(-time.Hour)
+	tests := []struct {
+		name string
+		key  core.APIKey
+	}{
+		{name: "missing name", key: core.APIKey{Scopes: []string{core.APIKeyScopeAll}}},
+		{name: "no scopes", key: core.APIKey{Name: "sync", Scopes: []string{" "}}},
+		{name: "expired", key: core.APIKey{Name: "sync", Scopes: []string{core.APIKeyScopeAll}, ExpiresAt: &past}},
+		{name: "invalid series scope", key: core.APIKey{Name: "sync", Scopes: []string{"series:coffee"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := svc.CreateAPIKey(ctx, tt.key); !errors.Is(err, core.ErrValidation) {
+				t.Fatalf("expected ErrValidation, got %v", err)
+			}
+		})
+	}
+}
@@ -4,27 +4,52 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"mime"
+	"net/url"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 
 	"github.com/eslsoft/lession/internal/core"
 )
 
+// imageUploadTypes lists the MIME types accepted for image uploads.
+var imageUploadTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// openUploadStatuses lists the statuses upload sessions can still be completed
+// or cancelled from.
+var openUploadStatuses = []core.UploadStatus{core.UploadStatusAwaitingUpload, core.UploadStatusUploading}
+
+// maxImageUploadSize caps the size of image uploads.
+const maxImageUploadSize = 25 << 20
+
 // AssetService coordinates asset-related use cases, delegating vendor specifics
 // to a pluggable upload provider and persistence to the repository.
 type AssetService struct {
-	repo     core.AssetRepository
-	provider core.UploadProvider
-	now      func() time.Time
+	repo      core.AssetRepository
+	provider  core.UploadProvider
+	prober    core.MediaProber
+	tx        core.TxManager
+	now       func() time.Time
+	watchers  *assetWatchers
+	watchPoll time.Duration
 }
 
 // NewAssetService constructs an asset service using the supplied repository and provider.
 func NewAssetService(repo core.AssetRepository, provider core.UploadProvider) *AssetService {
 	return &AssetService{
-		repo:     repo,
-		provider: provider,
-		now:      time.Now,
+		repo:      repo,
+		provider:  provider,
+		now:       time.Now,
+		watchers:  newAssetWatchers(),
+		watchPoll: defaultAssetWatchPoll,
 	}
 }
 
@@ -35,35 +60,27 @@ func (s *AssetService) WithClock(fn func() time.Time) {
 	}
 }
 
+// WithTxManager makes the service write upload sessions and their assets
+// in one transaction.
+func (s *AssetService) WithTxManager(tx core.TxManager) {
+	s.tx = tx
+}
+
+// WithMediaProber enables registering media hosted elsewhere as assets.
+func (s *AssetService) WithMediaProber(prober core.MediaProber) {
+	s.prober = prober
+}
+
 var _ core.AssetService = (*AssetService)(nil)
 
 // CreateUpload starts a new upload session by coordinating with the provider and persisting state.
 func (s *AssetService) CreateUpload(ctx context.Context, params core.CreateUploadParams) (*core.CreateUploadResult, error) {
-	providerRes, err := s.provider.CreateUpload(ctx, core.ProviderCreateUploadParams{
-		Type:             params.Type,
-		OriginalFilename: params.OriginalFilename,
-		MimeType:         params.MimeType,
-		ContentLength:    params.ContentLength,
-	})
-	if err != nil {
+	if err := validateUpload(params); err != nil {
 		return nil, err
 	}
-
-	now := s.now().UTC()
-
-	session := core.UploadSession{
-		ID:               uuid.New(),
-		AssetKey:         providerRes.AssetKey,
-		Type:             params.Type,
-		Protocol:         providerRes.Protocol,
-		Status:           core.UploadStatusAwaitingUpload,
-		Target:           providerRes.Target,
-		OriginalFilename: params.OriginalFilename,
-		MimeType:         params.MimeType,
-		ContentLength:    params.ContentLength,
-		ExpiresAt:        providerRes.ExpiresAt,
-		CreatedAt:        now,
-		UpdatedAt:        now,
+	session, providerRes, err := s.startUpload(ctx, params)
+	if err != nil {
+		return nil, err
 	}
 
 	assetStatus := providerRes.EstimatedStatus
@@ -73,29 +90,133 @@ func (s *AssetService) CreateUpload(ctx context.Context, params core.CreateUploa
 
 	asset := core.Asset{
 		ID:               uuid.New(),
-		AssetKey:         providerRes.AssetKey,
+		AssetKey:         session.AssetKey,
 		Type:             params.Type,
 		Status:           assetStatus,
 		OriginalFilename: params.OriginalFilename,
 		MimeType:         params.MimeType,
 		Filesize:         params.ContentLength,
-		CreatedAt:        now,
-		UpdatedAt:        now,
+		Provider:         session.Provider,
+	}
+
+	var result core.CreateUploadResult
+	if err := withinTx(ctx, s.tx, func(ctx context.Context) error {
+		stored, err := s.repo.CreateUploadSession(ctx, *session)
+		if err != nil {
+			return err
+		}
+		created, err := s.repo.CreateAsset(ctx, asset)
+		if err != nil {
+			return err
+		}
+		result = core.CreateUploadResult{Session: *stored, Asset: *created}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ReplaceAssetContent starts an upload of new content for an existing ready
+// or failed asset. Nothing about the asset changes until the upload completes.
+func (s *AssetService) ReplaceAssetContent(ctx context.Context, params core.ReplaceAssetContentParams) (*core.CreateUploadResult, error) {
+	if params.AssetID == uuid.Nil {
+		return nil, fmt.Errorf("%w: asset id required", core.ErrValidation)
+	}
+	asset, err := s.repo.GetAssetByID(ctx, params.AssetID)
+	if err != nil {
+		return nil, err
+	}
+	if asset.Status != core.AssetStatusReady && asset.Status != core.AssetStatusFailed {
+		return nil, fmt.Errorf("%w: only ready or failed assets can be replaced", core.ErrInvalidState)
 	}
 
-	if err := s.repo.CreateUploadSession(ctx, session); err != nil {
+	upload := core.CreateUploadParams{
+		Type:             asset.Type,
+		OriginalFilename: params.OriginalFilename,
+		MimeType:         params.MimeType,
+		ContentLength:    params.ContentLength,
+	}
+	if err := validateUpload(upload); err != nil {
+		return nil, err
+	}
+	session, _, err := s.startUpload(ctx, upload)
+	if err != nil {
 		return nil, err
 	}
-	if err := s.repo.CreateAsset(ctx, asset); err != nil {
+	session.ReplacesAssetID = asset.ID
+	stored, err := s.repo.CreateUploadSession(ctx, *session)
+	if err != nil {
 		return nil, err
 	}
 
 	return &core.CreateUploadResult{
-		Session: session,
-		Asset:   asset,
+		Session: *stored,
+		Asset:   *asset,
 	}, nil
 }
 
+// ListAssetVersions returns the content an asset was served from before it
+// was replaced, newest first.
+func (s *AssetService) ListAssetVersions(ctx context.Context, assetID uuid.UUID) ([]core.AssetVersion, error) {
+	if assetID == uuid.Nil {
+		return nil, fmt.Errorf("%w: asset id required", core.ErrValidation)
+	}
+	if _, err := s.repo.GetAssetByID(ctx, assetID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListAssetVersions(ctx, assetID)
+}
+
+// startUpload asks the provider for upload instructions and returns the
+// session to persist for them.
+func (s *AssetService) startUpload(ctx context.Context, params core.CreateUploadParams) (*core.UploadSession, *core.ProviderCreateUploadResult, error) {
+	providerRes, err := s.provider.CreateUpload(ctx, core.ProviderCreateUploadParams{
+		Type:             params.Type,
+		OriginalFilename: params.OriginalFilename,
+		MimeType:         params.MimeType,
+		ContentLength:    params.ContentLength,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	providerName := providerRes.Provider
+	if providerName == "" {
+		providerName = s.provider.Name()
+	}
+
+	return &core.UploadSession{
+		ID:               uuid.New(),
+		AssetKey:         providerRes.AssetKey,
+		Type:             params.Type,
+		Protocol:         providerRes.Protocol,
+		Status:           core.UploadStatusAwaitingUpload,
+		Target:           providerRes.Target,
+		OriginalFilename: params.OriginalFilename,
+		MimeType:         params.MimeType,
+		ContentLength:    params.ContentLength,
+		ExpiresAt:        providerRes.ExpiresAt,
+		Provider:         providerName,
+	}, providerRes, nil
+}
+
+// validateUpload checks the declared format and size of image uploads; their
+// dimensions are checked once the image is processed.
+func validateUpload(params core.CreateUploadParams) error {
+	if params.Type != core.AssetTypeImage {
+		return nil
+	}
+	mediaType, _, _ := mime.ParseMediaType(params.MimeType)
+	if !imageUploadTypes[strings.ToLower(mediaType)] {
+		return fmt.Errorf("%w: image type %q is not supported; upload JPEG, PNG or GIF", core.ErrValidation, params.MimeType)
+	}
+	if params.ContentLength > maxImageUploadSize {
+		return fmt.Errorf("%w: images are limited to %d bytes", core.ErrValidation, maxImageUploadSize)
+	}
+	return nil
+}
+
 // GetUploadSession fetches an upload session by either ID or asset key.
 func (s *AssetService) GetUploadSession(ctx context.Context, id core.UploadIdentifier) (*core.UploadSession, error) {
 	session, err := s.lookupUploadSession(ctx, id)
@@ -105,6 +226,14 @@ func (s *AssetService) GetUploadSession(ctx context.Context, id core.UploadIdent
 	return session, nil
 }
 
+// ListUploads enumerates upload sessions newest first.
+func (s *AssetService) ListUploads(ctx context.Context, filter core.UploadListFilter) ([]core.UploadSession, string, error) {
+	if !filter.CreatedAfter.IsZero() && !filter.CreatedBefore.IsZero() && !filter.CreatedAfter.Before(filter.CreatedBefore) {
+		return nil, "", fmt.Errorf("%w: created_after must be before created_before", core.ErrValidation)
+	}
+	return s.repo.ListUploadSessions(ctx, filter)
+}
+
 // CompleteUpload finalises an upload, requesting the provider to produce playback details.
 func (s *AssetService) CompleteUpload(ctx context.Context, params core.CompleteUploadParams) (*core.CompleteUploadResult, error) {
 	session, err := s.lookupUploadSession(ctx, params.Identifier)
@@ -112,16 +241,14 @@ func (s *AssetService) CompleteUpload(ctx context.Context, params core.CompleteU
 		return nil, err
 	}
 
-	switch session.Status {
-	case core.UploadStatusAwaitingUpload, core.UploadStatusUploading:
-		// allowed transitions
-	case core.UploadStatusCompleted:
-		return nil, core.ErrUploadInvalidState
-	default:
+	// Sessions already closed are turned away before the provider is asked to
+	// finalise them.
+	if !lo.Contains(openUploadStatuses, session.Status) {
 		return nil, core.ErrUploadInvalidState
 	}
 
 	providerRes, err := s.provider.CompleteUpload(ctx, core.ProviderCompleteUploadParams{
+		Provider:      session.Provider,
 		AssetKey:      session.AssetKey,
 		Checksum:      params.Checksum,
 		ContentLength: params.ContentLength,
@@ -132,34 +259,267 @@ func (s *AssetService) CompleteUpload(ctx context.Context, params core.CompleteU
 
 	now := s.now().UTC()
 	session.Status = core.UploadStatusCompleted
-	session.UpdatedAt = now
 
-	if err := s.repo.UpdateUploadSession(ctx, *session); err != nil {
+	var asset *core.Asset
+	if err := withinTx(ctx, s.tx, func(ctx context.Context) error {
+		// The status read above may be stale by now; only one of concurrent
+		// completions or cancellations gets to move the session on.
+		completed, err := s.repo.TransitionUploadSession(ctx, *session, openUploadStatuses...)
+		if err != nil {
+			return err
+		}
+		session = completed
+
+		if session.ReplacesAssetID != uuid.Nil {
+			found, err := s.repo.GetAssetByID(ctx, session.ReplacesAssetID)
+			if err != nil {
+				return err
+			}
+			asset = found
+			return s.replaceContent(ctx, asset, *session, providerRes, params.ContentLength, now)
+		}
+
+		found, err := s.repo.GetAssetByKey(ctx, session.AssetKey)
+		if err != nil {
+			return err
+		}
+		asset = found
+
+		previousStatus := asset.Status
+		asset.Status = core.AssetStatusReady
+		asset.PlaybackURL = providerRes.PlaybackURL
+		asset.Duration = providerRes.Duration
+		asset.Filesize = params.ContentLength
+		asset.ReadyAt = &now
+
+		events := []core.Event{core.AssetReady{Asset: *asset}}
+		if previousStatus != asset.Status {
+			events = append(events, core.AssetStatusChanged{Asset: *asset, PreviousStatus: previousStatus})
+		}
+		asset, err = s.repo.UpdateAsset(ctx, *asset, events...)
+		return err
+	}); err != nil {
 		return nil, err
 	}
 
-	asset, err := s.repo.GetAssetByKey(ctx, session.AssetKey)
+	return &core.CompleteUploadResult{
+		Asset:   *asset,
+		Session: *session,
+	}, nil
+}
+
+// replaceContent switches asset to the content of a completed replacement
+// upload, keeping its current content as a version. Renditions derived from
+// the old content are dropped; AssetReady has the pipeline produce new ones.
+func (s *AssetService) replaceContent(ctx context.Context, asset *core.Asset, session core.UploadSession, providerRes *core.ProviderCompleteUploadResult, contentLength int64, now time.Time) error {
+	version := core.AssetVersion{
+		ID:               uuid.New(),
+		AssetID:          asset.ID,
+		AssetKey:         asset.AssetKey,
+		Provider:         asset.Provider,
+		OriginalFilename: asset.OriginalFilename,
+		MimeType:         asset.MimeType,
+		Filesize:         asset.Filesize,
+		Duration:         asset.Duration,
+		PlaybackURL:      asset.PlaybackURL,
+		HLSManifestURL:   asset.HLSManifestURL,
+	}
+	if err := s.repo.CreateAssetVersion(ctx, version); err != nil {
+		return err
+	}
+
+	previousStatus := asset.Status
+	previousURLs := lo.Compact([]string{asset.PlaybackURL, asset.HLSManifestURL})
+	*asset = core.Asset{
+		ID:               asset.ID,
+		AssetKey:         session.AssetKey,
+		Type:             asset.Type,
+		Status:           core.AssetStatusReady,
+		OriginalFilename: session.OriginalFilename,
+		MimeType:         session.MimeType,
+		Filesize:         contentLength,
+		Duration:         providerRes.Duration,
+		PlaybackURL:      providerRes.PlaybackURL,
+		Provider:         session.Provider,
+		ReadyAt:          &now,
+	}
+
+	events := []core.Event{core.AssetReady{Asset: *asset}}
+	if previousStatus != asset.Status {
+		events = append(events, core.AssetStatusChanged{Asset: *asset, PreviousStatus: previousStatus})
+	}
+	if len(previousURLs) > 0 {
+		events = append(events, core.AssetRenditionReplaced{Asset: *asset, PreviousURLs: previousURLs})
+	}
+	updated, err := s.repo.UpdateAsset(ctx, *asset, events...)
+	if err != nil {
+		return err
+	}
+	*asset = *updated
+	return nil
+}
+
+// CancelUpload abandons an open upload session. The provider is asked to
+// release the unfinished upload first, so a failure there leaves the session
+// open for the client to cancel again.
+func (s *AssetService) CancelUpload(ctx context.Context, id core.UploadIdentifier) (*core.CancelUploadResult, error) {
+	session, err := s.lookupUploadSession(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if !lo.Contains(openUploadStatuses, session.Status) {
+		return nil, core.ErrUploadInvalidState
+	}
 
-	asset.Status = core.AssetStatusReady
-	asset.PlaybackURL = providerRes.PlaybackURL
-	asset.Duration = providerRes.Duration
-	asset.Filesize = params.ContentLength
-	asset.UpdatedAt = now
-	asset.ReadyAt = &now
+	if aborter, ok := s.provider.(core.UploadAborter); ok {
+		err := aborter.AbortUpload(ctx, core.ProviderAbortUploadParams{
+			Provider: session.Provider,
+			AssetKey: session.AssetKey,
+		})
+		// Uploads the provider no longer knows have nothing left to release.
+		if err != nil && !isNotFound(err) {
+			return nil, err
+		}
+	}
+
+	session.Status = core.UploadStatusCancelled
 
-	if err := s.repo.UpdateAsset(ctx, *asset); err != nil {
+	var asset *core.Asset
+	if err := withinTx(ctx, s.tx, func(ctx context.Context) error {
+		cancelled, err := s.repo.TransitionUploadSession(ctx, *session, openUploadStatuses...)
+		if err != nil {
+			return err
+		}
+		session = cancelled
+
+		// The asset whose content an upload would have replaced stays as it was.
+		if session.ReplacesAssetID != uuid.Nil {
+			found, err := s.repo.GetAssetByID(ctx, session.ReplacesAssetID)
+			asset = found
+			return err
+		}
+
+		found, err := s.repo.GetAssetByKey(ctx, session.AssetKey)
+		if err != nil {
+			return err
+		}
+		asset = found
+		if asset.Status != core.AssetStatusPending {
+			return nil
+		}
+
+		asset.Status = core.AssetStatusDeleted
+		changed := core.AssetStatusChanged{Asset: *asset, PreviousStatus: core.AssetStatusPending}
+		if _, err := s.repo.UpdateAsset(ctx, *asset, changed); err != nil {
+			return err
+		}
+		deleted, err := s.repo.DeleteAsset(ctx, asset.ID, false)
+		if err != nil {
+			return err
+		}
+		asset = deleted
+		return nil
+	}); err != nil {
 		return nil, err
 	}
 
-	return &core.CompleteUploadResult{
+	return &core.CancelUploadResult{
 		Asset:   *asset,
 		Session: *session,
 	}, nil
 }
 
+// RegisterExternalAsset probes media hosted elsewhere and records it as a
+// ready asset played from its URL, so episodes can reference it like an
+// uploaded asset.
+func (s *AssetService) RegisterExternalAsset(ctx context.Context, params core.RegisterExternalAssetParams) (*core.Asset, error) {
+	if s.prober == nil {
+		return nil, fmt.Errorf("%w: external assets are not supported", core.ErrValidation)
+	}
+	if params.Type != core.AssetTypeVideo && params.Type != core.AssetTypeAudio {
+		return nil, fmt.Errorf("%w: only video and audio can be registered from a URL", core.ErrValidation)
+	}
+	params.URL = strings.TrimSpace(params.URL)
+	if problem := importURLProblem(params.URL); problem != "" {
+		return nil, fmt.Errorf("%w: url %s", core.ErrValidation, problem)
+	}
+	if params.Duration < 0 {
+		return nil, fmt.Errorf("%w: duration must not be negative", core.ErrValidation)
+	}
+
+	// The probe error is not passed on, so callers cannot use it to learn
+	// about hosts the prober refused or could not reach.
+	probe, err := s.prober.ProbeMedia(ctx, params.URL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: media is not reachable", core.ErrValidation)
+	}
+	if problem := externalMediaProblem(params.Type, probe.MimeType); problem != "" {
+		return nil, fmt.Errorf("%w: %s", core.ErrValidation, problem)
+	}
+
+	filename := strings.TrimSpace(params.OriginalFilename)
+	if filename == "" {
+		filename = externalFilename(params.URL)
+	}
+	mimeType := probe.MimeType
+	if mimeType == "" || mimeType == "application/octet-stream" {
+		mimeType = lo.CoalesceOrEmpty(mime.TypeByExtension(path.Ext(filename)), mimeType)
+	}
+
+	now := s.now().UTC()
+	id := uuid.New()
+	asset := core.Asset{
+		ID:               id,
+		AssetKey:         core.ExternalAssetProvider + "/" + id.String(),
+		Type:             params.Type,
+		Status:           core.AssetStatusReady,
+		OriginalFilename: truncateRunes(filename, 512),
+		MimeType:         mimeType,
+		Filesize:         probe.ContentLength,
+		Duration:         lo.CoalesceOrEmpty(probe.Duration, params.Duration),
+		PlaybackURL:      params.URL,
+		Provider:         core.ExternalAssetProvider,
+		ReadyAt:          &now,
+	}
+	return s.repo.CreateAsset(ctx, asset, core.AssetReady{Asset: asset})
+}
+
+// externalMediaProblem rejects media served as another kind of content, such
+// as the HTML page of a player. Servers that do not declare a specific type
+// get the benefit of the doubt.
+func externalMediaProblem(assetType core.AssetType, mimeType string) string {
+	kind := "video/"
+	if assetType == core.AssetTypeAudio {
+		kind = "audio/"
+	}
+	switch {
+	case mimeType == "", mimeType == "application/octet-stream", mimeType == "binary/octet-stream":
+		return ""
+	case strings.HasPrefix(mimeType, kind), mimeType == "application/vnd.apple.mpegurl":
+		return ""
+	case assetType == core.AssetTypeVideo && mimeType == "application/dash+xml":
+		return ""
+	default:
+		return fmt.Sprintf("url serves %s, not %s media", mimeType, strings.TrimSuffix(kind, "/"))
+	}
+}
+
+// externalFilename names external media after the last segment of its URL
+// path, or its host when the path is empty.
+func externalFilename(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	if name := path.Base(u.Path); name != "." && name != "/" {
+		if unescaped, err := url.PathUnescape(name); err == nil {
+			return unescaped
+		}
+		return name
+	}
+	return u.Host
+}
+
 // GetAsset retrieves an asset by its identifier.
 func (s *AssetService) GetAsset(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
 	return s.repo.GetAssetByID(ctx, id)
@@ -178,16 +538,66 @@ func (s *AssetService) ListAssets(ctx context.Context, filter core.AssetListFilt
 	return s.repo.ListAssets(ctx, filter)
 }
 
-// UpdateAsset mutates the provided asset record.
+// UpdateAsset mutates the provided asset record, announcing status changes,
+// replaced playback URLs and corrected durations.
 func (s *AssetService) UpdateAsset(ctx context.Context, asset core.Asset) (*core.Asset, error) {
 	if asset.ID == uuid.Nil {
 		return nil, fmt.Errorf("%w: asset id required", core.ErrValidation)
 	}
-	asset.UpdatedAt = s.now().UTC()
-	if err := s.repo.UpdateAsset(ctx, asset); err != nil {
+	existing, err := s.repo.GetAssetByID(ctx, asset.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if asset.Status != core.AssetStatusFailed {
+		asset.FailureCode = core.AssetFailureCodeUnspecified
+		asset.FailureReason = ""
+	}
+	var events []core.Event
+	if existing.Status != asset.Status {
+		events = append(events, core.AssetStatusChanged{Asset: asset, PreviousStatus: existing.Status})
+	}
+	if existing.PlaybackURL != "" && existing.PlaybackURL != asset.PlaybackURL {
+		events = append(events, core.AssetRenditionReplaced{Asset: asset, PreviousURLs: []string{existing.PlaybackURL}})
+	}
+	if existing.Duration != asset.Duration {
+		events = append(events, core.AssetDurationChanged{Asset: asset, PreviousDuration: existing.Duration})
+	}
+	return s.repo.UpdateAsset(ctx, asset, events...)
+}
+
+// RetryAssetProcessing returns a failed asset to ready and announces it
+// with AssetReady again, so image processing, audio packaging and the other
+// subscribers rerun on its media. Media the pipeline rejected is likely to
+// fail again unless the cause was transient. Assets whose upload expired
+// never received their media and must be uploaded again.
+func (s *AssetService) RetryAssetProcessing(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: asset id required", core.ErrValidation)
+	}
+	asset, err := s.repo.GetAssetByID(ctx, id)
+	if err != nil {
 		return nil, err
 	}
-	return &asset, nil
+	if asset.Status != core.AssetStatusFailed {
+		return nil, fmt.Errorf("%w: only failed assets can be retried", core.ErrInvalidState)
+	}
+	if asset.PlaybackURL == "" {
+		return nil, fmt.Errorf("%w: the asset's media was never received; upload it again", core.ErrInvalidState)
+	}
+
+	now := s.now().UTC()
+	asset.Status = core.AssetStatusReady
+	asset.FailureCode = core.AssetFailureCodeUnspecified
+	asset.FailureReason = ""
+	if asset.ReadyAt == nil {
+		asset.ReadyAt = &now
+	}
+	events := []core.Event{
+		core.AssetStatusChanged{Asset: *asset, PreviousStatus: core.AssetStatusFailed},
+		core.AssetReady{Asset: *asset},
+	}
+	return s.repo.UpdateAsset(ctx, *asset, events...)
 }
 
 // DeleteAsset removes (or hard deletes) an asset.
@@ -198,6 +608,101 @@ func (s *AssetService) DeleteAsset(ctx context.Context, id uuid.UUID, hardDelete
 	return s.repo.DeleteAsset(ctx, id, hardDelete)
 }
 
+// maintenanceBatchSize bounds how many records a maintenance sweep loads at once.
+const maintenanceBatchSize = 100
+
+// ExpireUploadSessions marks open upload sessions past their expiry as
+// expired and fails the assets still waiting for them.
+func (s *AssetService) ExpireUploadSessions(ctx context.Context) (int, error) {
+	expired := 0
+	for {
+		now := s.now().UTC()
+		sessions, err := s.repo.ListExpiredUploadSessions(ctx, now, maintenanceBatchSize)
+		if err != nil {
+			return expired, err
+		}
+
+		for _, session := range sessions {
+			err := withinTx(ctx, s.tx, func(ctx context.Context) error {
+				return s.expireUploadSession(ctx, session)
+			})
+			if errors.Is(err, core.ErrUploadInvalidState) {
+				// The upload completed or was cancelled since it was listed.
+				continue
+			}
+			if err != nil {
+				return expired, err
+			}
+			expired++
+		}
+
+		if len(sessions) < maintenanceBatchSize {
+			return expired, nil
+		}
+	}
+}
+
+// expireUploadSession expires session and fails its asset when it is still
+// waiting for the upload. It returns core.ErrUploadInvalidState when the
+// session is no longer open.
+func (s *AssetService) expireUploadSession(ctx context.Context, session core.UploadSession) error {
+	session.Status = core.UploadStatusExpired
+	if _, err := s.repo.TransitionUploadSession(ctx, session, openUploadStatuses...); err != nil {
+		return err
+	}
+
+	asset, err := s.repo.GetAssetByKey(ctx, session.AssetKey)
+	if isNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if asset.Status != core.AssetStatusPending {
+		return nil
+	}
+	asset.Status = core.AssetStatusFailed
+	asset.FailureCode = core.AssetFailureCodeUploadExpired
+	asset.FailureReason = "the upload session expired before the upload completed"
+	changed := core.AssetStatusChanged{Asset: *asset, PreviousStatus: core.AssetStatusPending}
+	_, err = s.repo.UpdateAsset(ctx, *asset, changed)
+	return err
+}
+
+// PurgeDeletedAssets hard deletes assets archived longer than retention ago.
+// Stored media is left to the provider's own lifecycle rules.
+func (s *AssetService) PurgeDeletedAssets(ctx context.Context, retention time.Duration) (int, error) {
+	if retention < 0 {
+		return 0, fmt.Errorf("%w: retention must not be negative", core.ErrValidation)
+	}
+
+	cutoff := s.now().UTC().Add(-retention)
+	purged := 0
+	for {
+		// Purged assets drop out of the filter, so every batch starts over
+		// from the first page.
+		assets, _, err := s.repo.ListAssets(ctx, core.AssetListFilter{
+			PageSize:      maintenanceBatchSize,
+			Statuses:      []core.AssetStatus{core.AssetStatusDeleted},
+			UpdatedBefore: cutoff,
+		})
+		if err != nil {
+			return purged, err
+		}
+
+		for _, asset := range assets {
+			if _, err := s.repo.DeleteAsset(ctx, asset.ID, true); err != nil && !isNotFound(err) {
+				return purged, err
+			}
+			purged++
+		}
+
+		if len(assets) < maintenanceBatchSize {
+			return purged, nil
+		}
+	}
+}
+
 func (s *AssetService) lookupUploadSession(ctx context.Context, id core.UploadIdentifier) (*core.UploadSession, error) {
 	if id.UploadID == uuid.Nil && id.AssetKey == "" {
 		return nil, core.ErrUploadIdentifierRequired
@@ -0,0 +1,414 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubMediaProber struct {
+	probe *core.MediaProbe
+	err   error
+}
+
+func (s stubMediaProber) ProbeMedia(ctx context.Context, url string) (*core.MediaProbe, error) {
+	return s.probe, s.err
+}
+
+func TestAssetService_RegisterExternalAsset(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	var created core.Asset
+	repo := &stubAssetRepo{createAssetFn: func(ctx context.Context, asset core.Asset) error {
+		created = asset
+		return nil
+	}}
+	svc := NewAssetService(repo, nil)
+	svc.WithClock(func() time.Time { return now })
+	svc.WithMediaProber(stubMediaProber{probe: &core.MediaProbe{MimeType: "application/octet-stream", ContentLength: 4096}})
+
+	asset, err := svc.RegisterExternalAsset(ctx, core.RegisterExternalAssetParams{
+		Type:     core.AssetTypeAudio,
+		URL:      " https://cdn.example.com/shows/Lesson%201.mp3 ",
+		Duration: 90 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("RegisterExternalAsset() error = %v", err)
+	}
+	if asset.Status != core.AssetStatusReady || asset.ReadyAt == nil || !asset.ReadyAt.Equal(now) {
+		t.Fatalf("expected a ready asset, got %+v", asset)
+	}
+	if asset.Provider != core.ExternalAssetProvider || asset.PlaybackURL != "https://cdn.example.com/shows/Lesson%201.mp3" {
+		t.Fatalf("expected the asset to play from its URL, got provider %q url %q", asset.Provider, asset.PlaybackURL)
+	}
+	if asset.OriginalFilename != "Lesson 1.mp3" || asset.MimeType != "audio/mpeg" {
+		t.Fatalf("expected name and type from the URL, got %q %q", asset.OriginalFilename, asset.MimeType)
+	}
+	if asset.Filesize != 4096 || asset.Duration != 90*time.Second {
+		t.Fatalf("expected probed size and given duration, got %d %v", asset.Filesize, asset.Duration)
+	}
+	if created.ID != asset.ID {
+		t.Fatalf("expected the asset to be stored")
+	}
+}
+
+func TestAssetService_RegisterExternalAssetValidates(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name   string
+		params core.RegisterExternalAssetParams
+		prober stubMediaProber
+	}{
+		{
+			name:   "image",
+			params: core.RegisterExternalAssetParams{Type: core.AssetTypeImage, URL: "https://cdn.example.com/cover.png"},
+			prober: stubMediaProber{probe: &core.MediaProbe{MimeType: "image/png"}},
+		},
+		{
+			name:   "relative url",
+			params: core.RegisterExternalAssetParams{Type: core.AssetTypeVideo, URL: "/videos/intro.mp4"},
+			prober: stubMediaProber{probe: &core.MediaProbe{MimeType: "video/mp4"}},
+		},
+		{
+			name:   "unreachable",
+			params: core.RegisterExternalAssetParams{Type: core.AssetTypeVideo, URL: "https://cdn.example.com/intro.mp4"},
+			prober: stubMediaProber{err: errors.New("probe: get http://10.0.0.5/intro.mp4: connection refused")},
+		},
+		{
+			name:   "web page",
+			params: core.RegisterExternalAssetParams{Type: core.AssetTypeVideo, URL: "https://example.com/watch/intro"},
+			prober: stubMediaProber{probe: &core.MediaProbe{MimeType: "text/html"}},
+		},
+		{
+			name:   "audio served as video",
+			params: core.RegisterExternalAssetParams{Type: core.AssetTypeVideo, URL: "https://cdn.example.com/intro.mp3"},
+			prober: stubMediaProber{probe: &core.MediaProbe{MimeType: "audio/mpeg"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &stubAssetRepo{createAssetFn: func(ctx context.Context, asset core.Asset) error {
+				t.Fatalf("expected no asset to be created")
+				return nil
+			}}
+			svc := NewAssetService(repo, nil)
+			svc.WithMediaProber(tt.prober)
+			_, err := svc.RegisterExternalAsset(ctx, tt.params)
+			if !errors.Is(err, core.ErrValidation) {
+				t.Fatalf("expected validation error, got %v", err)
+			}
+			if strings.Contains(err.Error(), "10.0.0.5") {
+				t.Fatalf("error %q leaks the probe failure", err)
+			}
+		})
+	}
+}
+
+func TestAssetService_ListUploadsValidatesRange(t *testing.T) {
+	ctx := context.Background()
+	svc := NewAssetService(&stubAssetRepo{}, nil)
+	now := time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)
+
+	_, _, err := svc.ListUploads(ctx, core.UploadListFilter{CreatedAfter: now, CreatedBefore: now})
+	if !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected validation error for an empty range, got %v", err)
+	}
+	if _, _, err := svc.ListUploads(ctx, core.UploadListFilter{CreatedAfter: now}); err != nil {
+		t.Fatalf("ListUploads() with an open range error = %v", err)
+	}
+}
+
+func TestAssetService_RetryAssetProcessing(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	failed := core.Asset{
+		ID:            uuid.New(),
+		Type:          core.AssetTypeImage,
+		Status:        core.AssetStatusFailed,
+		PlaybackURL:   "https://uploads.example.com/cover.jpg",
+		FailureCode:   core.AssetFailureCodeInvalidMedia,
+		FailureReason: "image cannot be decoded",
+	}
+	expired := core.Asset{ID: uuid.New(), Status: core.AssetStatusFailed, FailureCode: core.AssetFailureCodeUploadExpired}
+	ready := core.Asset{ID: uuid.New(), Status: core.AssetStatusReady, PlaybackURL: "https://uploads.example.com/ready.jpg"}
+	assets := map[uuid.UUID]core.Asset{failed.ID: failed, expired.ID: expired, ready.ID: ready}
+
+	repo := &stubAssetRepo{getAssetByIDFn: func(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
+		asset, ok := assets[id]
+		if !ok {
+			return nil, core.ErrNotFound
+		}
+		return &asset, nil
+	}}
+	svc := NewAssetService(repo, nil)
+	svc.WithClock(func() time.Time { return now })
+
+	asset, err := svc.RetryAssetProcessing(ctx, failed.ID)
+	if err != nil {
+		t.Fatalf("RetryAssetProcessing() error = %v", err)
+	}
+	if asset.Status != core.AssetStatusReady || asset.FailureCode != core.AssetFailureCodeUnspecified || asset.FailureReason != "" {
+		t.Fatalf("expected a ready asset without failure, got %+v", asset)
+	}
+	if len(repo.events) != 2 || repo.events[1].EventType() != core.EventTypeAssetReady {
+		t.Fatalf("expected the asset to be announced ready again, got %v", repo.events)
+	}
+
+	for name, id := range map[string]uuid.UUID{"upload expired": expired.ID, "not failed": ready.ID} {
+		if _, err := svc.RetryAssetProcessing(ctx, id); !errors.Is(err, core.ErrInvalidState) {
+			t.Fatalf("%s: expected ErrInvalidState, got %v", name, err)
+		}
+	}
+}
+
+type stubAbortingProvider struct {
+	core.UploadProvider
+	aborted []core.ProviderAbortUploadParams
+}
+
+func (p *stubAbortingProvider) AbortUpload(ctx context.Context, params core.ProviderAbortUploadParams) error {
+	p.aborted = append(p.aborted, params)
+	return nil
+}
+
+func TestAssetService_CancelUpload(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	open := core.UploadSession{ID: uuid.New(), AssetKey: "uploads/lesson.mp3", Status: core.UploadStatusUploading, Provider: "s3"}
+	completed := core.UploadSession{ID: uuid.New(), AssetKey: "uploads/done.mp3", Status: core.UploadStatusCompleted}
+	sessions := map[uuid.UUID]core.UploadSession{open.ID: open, completed.ID: completed}
+	pending := core.Asset{ID: uuid.New(), AssetKey: open.AssetKey, Status: core.AssetStatusPending}
+
+	repo := &stubAssetRepo{
+		getSessionByIDFn: func(ctx context.Context, id uuid.UUID) (*core.UploadSession, error) {
+			session, ok := sessions[id]
+			if !ok {
+				return nil, core.ErrNotFound
+			}
+			return &session, nil
+		},
+		getAssetByKeyFn: func(ctx context.Context, assetKey string) (*core.Asset, error) {
+			asset := pending
+			return &asset, nil
+		},
+		deleteAssetFn: func(ctx context.Context, id uuid.UUID, hardDelete bool) (*core.Asset, error) {
+			if hardDelete {
+				t.Fatalf("expected the pending asset to be archived, not purged")
+			}
+			return &core.Asset{ID: id, AssetKey: pending.AssetKey, Status: core.AssetStatusDeleted}, nil
+		},
+	}
+	provider := &stubAbortingProvider{}
+	svc := NewAssetService(repo, provider)
+	svc.WithClock(func() time.Time { return now })
+
+	result, err := svc.CancelUpload(ctx, core.UploadIdentifier{UploadID: open.ID})
+	if err != nil {
+		t.Fatalf("CancelUpload() error = %v", err)
+	}
+	if result.Session.Status != core.UploadStatusCancelled || result.Asset.Status != core.AssetStatusDeleted {
+		t.Fatalf("expected a cancelled session and deleted asset, got %+v", result)
+	}
+	want := []core.ProviderAbortUploadParams{{Provider: "s3", AssetKey: open.AssetKey}}
+	if !reflect.DeepEqual(provider.aborted, want) {
+		t.Fatalf("aborted = %+v, want %+v", provider.aborted, want)
+	}
+	if len(repo.events) != 1 || repo.events[0].(core.AssetStatusChanged).PreviousStatus != core.AssetStatusPending {
+		t.Fatalf("expected the deletion to be announced, got %v", repo.events)
+	}
+
+	if _, err := svc.CancelUpload(ctx, core.UploadIdentifier{UploadID: completed.ID}); !errors.Is(err, core.ErrUploadInvalidState) {
+		t.Fatalf("expected ErrUploadInvalidState for a completed upload, got %v", err)
+	}
+	if len(provider.aborted) != 1 {
+		t.Fatalf("expected completed uploads not to be aborted, got %+v", provider.aborted)
+	}
+
+	// A completion landing between the status check and the update wins.
+	repo.transitionFn = func(ctx context.Context, session core.UploadSession, from ...core.UploadStatus) error {
+		return core.ErrUploadInvalidState
+	}
+	repo.deleteAssetFn = func(ctx context.Context, id uuid.UUID, hardDelete bool) (*core.Asset, error) {
+		t.Fatalf("expected the asset of a concurrently completed upload to be kept")
+		return nil, nil
+	}
+	if _, err := svc.CancelUpload(ctx, core.UploadIdentifier{UploadID: open.ID}); !errors.Is(err, core.ErrUploadInvalidState) {
+		t.Fatalf("expected ErrUploadInvalidState for a concurrently completed upload, got %v", err)
+	}
+}
+
+func TestAssetService_ExpireUploadSessionsSkipsSessionsThatMovedOn(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	stale := core.UploadSession{ID: uuid.New(), AssetKey: "uploads/stale.mp3", Status: core.UploadStatusAwaitingUpload}
+	completed := core.UploadSession{ID: uuid.New(), AssetKey: "uploads/done.mp3", Status: core.UploadStatusUploading}
+
+	var transitioned []core.UploadSession
+	repo := &stubAssetRepo{
+		listExpiredFn: func(ctx context.Context, now time.Time, limit int) ([]core.UploadSession, error) {
+			return []core.UploadSession{stale, completed}, nil
+		},
+		transitionFn: func(ctx context.Context, session core.UploadSession, from ...core.UploadStatus) error {
+			if !reflect.DeepEqual(from, openUploadStatuses) {
+				t.Fatalf("expected only open sessions to expire, got %v", from)
+			}
+			// The second upload completed after the sweep listed it.
+			if session.ID == completed.ID {
+				return core.ErrUploadInvalidState
+			}
+			transitioned = append(transitioned, session)
+			return nil
+		},
+		getAssetByKeyFn: func(ctx context.Context, assetKey string) (*core.Asset, error) {
+			if assetKey != stale.AssetKey {
+				t.Fatalf("expected the asset of the completed upload to be left alone, got %q", assetKey)
+			}
+			return &core.Asset{ID: uuid.New(), AssetKey: assetKey, Status: core.AssetStatusPending}, nil
+		},
+	}
+	svc := NewAssetService(repo, nil)
+	svc.WithClock(func() time.Time { return now })
+
+	expired, err := svc.ExpireUploadSessions(ctx)
+	if err != nil {
+		t.Fatalf("ExpireUploadSessions() error = %v", err)
+	}
+	if expired != 1 || len(transitioned) != 1 || transitioned[0].Status != core.UploadStatusExpired {
+		t.Fatalf("expected only the stale session to expire, got %d, %+v", expired, transitioned)
+	}
+	if len(repo.events) != 1 || repo.events[0].(core.AssetStatusChanged).Asset.Status != core.AssetStatusFailed {
+		t.Fatalf("expected the stale asset to fail, got %v", repo.events)
+	}
+}
+
+type stubReplacingProvider struct{}
+
+func (stubReplacingProvider) Name() string { return "s3" }
+
+func (stubReplacingProvider) CreateUpload(ctx context.Context, params core.ProviderCreateUploadParams) (*core.ProviderCreateUploadResult, error) {
+	return &core.ProviderCreateUploadResult{AssetKey: "uploads/lesson-v2.mp3", Protocol: core.UploadProtocolPresignedPut}, nil
+}
+
+func (stubReplacingProvider) CompleteUpload(ctx context.Context, params core.ProviderCompleteUploadParams) (*core.ProviderCompleteUploadResult, error) {
+	return &core.ProviderCompleteUploadResult{PlaybackURL: "https://cdn.example.com/" + params.AssetKey, Duration: 95 * time.Second}, nil
+}
+
+func TestAssetService_ReplaceAssetContent(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	current := core.Asset{
+		ID:               uuid.New(),
+		AssetKey:         "uploads/lesson.mp3",
+		Type:             core.AssetTypeAudio,
+		Status:           core.AssetStatusReady,
+		OriginalFilename: "lesson.mp3",
+		MimeType:         "audio/mpeg",
+		Filesize:         1024,
+		Duration:         90 * time.Second,
+		PlaybackURL:      "https://cdn.example.com/uploads/lesson.mp3",
+		HLSManifestURL:   "https://cdn.example.com/hls/lesson/master.m3u8",
+		Provider:         "s3",
+	}
+	var session core.UploadSession
+	repo := &stubAssetRepo{
+		getAssetByIDFn: func(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
+			if id != current.ID {
+				return nil, core.ErrNotFound
+			}
+			asset := current
+			return &asset, nil
+		},
+		getSessionByIDFn: func(ctx context.Context, id uuid.UUID) (*core.UploadSession, error) {
+			return &session, nil
+		},
+	}
+	svc := NewAssetService(repo, stubReplacingProvider{})
+	svc.WithClock(func() time.Time { return now })
+
+	started, err := svc.ReplaceAssetContent(ctx, core.ReplaceAssetContentParams{
+		AssetID:          current.ID,
+		OriginalFilename: "lesson-fixed.mp3",
+		MimeType:         "audio/mpeg",
+		ContentLength:    2048,
+	})
+	if err != nil {
+		t.Fatalf("ReplaceAssetContent() error = %v", err)
+	}
+	if started.Session.ReplacesAssetID != current.ID || started.Asset.PlaybackURL != current.PlaybackURL {
+		t.Fatalf("expected an upload bound to the unchanged asset, got %+v", started)
+	}
+
+	session = started.Session
+	completed, err := svc.CompleteUpload(ctx, core.CompleteUploadParams{
+		Identifier:    core.UploadIdentifier{UploadID: session.ID},
+		ContentLength: 2048,
+	})
+	if err != nil {
+		t.Fatalf("CompleteUpload() error = %v", err)
+	}
+	asset := completed.Asset
+	if asset.ID != current.ID || asset.AssetKey != "uploads/lesson-v2.mp3" || asset.PlaybackURL != "https://cdn.example.com/uploads/lesson-v2.mp3" {
+		t.Fatalf("expected the asset to switch to the new content, got %+v", asset)
+	}
+	if asset.HLSManifestURL != "" || asset.OriginalFilename != "lesson-fixed.mp3" || asset.Duration != 95*time.Second {
+		t.Fatalf("expected renditions of the old content to be dropped, got %+v", asset)
+	}
+
+	if len(repo.versions) != 1 {
+		t.Fatalf("expected one version, got %+v", repo.versions)
+	}
+	version := repo.versions[0]
+	if version.AssetKey != current.AssetKey || version.PlaybackURL != current.PlaybackURL || version.Duration != current.Duration {
+		t.Fatalf("expected the old content to be kept as a version, got %+v", version)
+	}
+
+	replaced, ok := repo.events[len(repo.events)-1].(core.AssetRenditionReplaced)
+	if !ok || !reflect.DeepEqual(replaced.PreviousURLs, []string{current.PlaybackURL, current.HLSManifestURL}) {
+		t.Fatalf("expected the old URLs to be purged, got %v", repo.events)
+	}
+
+	current.Status = core.AssetStatusPending
+	if _, err := svc.ReplaceAssetContent(ctx, core.ReplaceAssetContentParams{AssetID: current.ID, OriginalFilename: "x.mp3", MimeType: "audio/mpeg"}); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected ErrInvalidState for a pending asset, got %v", err)
+	}
+}
+
+func TestAssetService_UpdateAssetAnnouncesDurationChange(t *testing.T) {
+	existing := core.Asset{ID: uuid.New(), Type: core.AssetTypeAudio, Status: core.AssetStatusReady, Duration: time.Minute}
+	repo := &stubAssetRepo{
+		getAssetByIDFn: func(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
+			found := existing
+			return &found, nil
+		},
+	}
+	svc := NewAssetService(repo, nil)
+
+	asset := existing
+	asset.OriginalFilename = "renamed.mp3"
+	if _, err := svc.UpdateAsset(context.Background(), asset); err != nil {
+		t.Fatalf("UpdateAsset() error = %v", err)
+	}
+	if len(repo.events) != 0 {
+		t.Fatalf("expected no events for a rename, got %v", repo.events)
+	}
+
+	asset.Duration = 75 * time.Second
+	if _, err := svc.UpdateAsset(context.Background(), asset); err != nil {
+		t.Fatalf("UpdateAsset() error = %v", err)
+	}
+	if len(repo.events) != 1 {
+		t.Fatalf("expected one event, got %v", repo.events)
+	}
+	changed, ok := repo.events[0].(core.AssetDurationChanged)
+	if !ok || changed.PreviousDuration != time.Minute || changed.Asset.Duration != 75*time.Second {
+		t.Fatalf("expected AssetDurationChanged, got %v", repo.events)
+	}
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// AssetUsageService counts the playback URLs handed out for each asset and
+// the downloads of its media, per UTC day.
+type AssetUsageService struct {
+	repo   core.AssetUsageRepository
+	assets core.AssetRepository
+	now    func() time.Time
+}
+
+// NewAssetUsageService constructs an asset usage service.
+func NewAssetUsageService(repo core.AssetUsageRepository, assets core.AssetRepository) *AssetUsageService {
+	return &AssetUsageService{
+		repo:   repo,
+		assets: assets,
+		now:    time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *AssetUsageService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.AssetUsageService = (*AssetUsageService)(nil)
+
+// RecordAssetAccess counts an access of the asset's media today.
+func (s *AssetUsageService) RecordAssetAccess(ctx context.Context, assetID uuid.UUID, kind core.AssetAccessKind) error {
+	if assetID == uuid.Nil {
+		return fmt.Errorf("%w: asset id is required", core.ErrValidation)
+	}
+	if kind != core.AssetAccessKindPlaybackURL && kind != core.AssetAccessKindDownload {
+		return fmt.Errorf("%w: unknown access kind %d", core.ErrValidation, kind)
+	}
+	return s.repo.IncrementAssetUsage(ctx, assetID, kind, truncateDay(s.now()))
+}
+
+// GetAssetUsage reports the daily access counts of an asset, listing days
+// without accesses as zero so unused media stands out.
+func (s *AssetUsageService) GetAssetUsage(ctx context.Context, query core.AssetUsageQuery) (*core.AssetUsage, error) {
+	if query.AssetID == uuid.Nil {
+		return nil, fmt.Errorf("%w: asset id is required", core.ErrValidation)
+	}
+	from, to, err := reportDays(query.From, query.To, s.now())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.assets.GetAssetByID(ctx, query.AssetID); err != nil {
+		return nil, err
+	}
+
+	days, err := s.repo.ListAssetUsage(ctx, query.AssetID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	daily := make(map[time.Time]core.AssetUsageCounts, len(days))
+	for _, day := range days {
+		daily[truncateDay(day.Day)] = day.Counts
+	}
+
+	usage := &core.AssetUsage{AssetID: query.AssetID, From: from, To: to}
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		counts := daily[day]
+		usage.Total.PlaybackURLsIssued += counts.PlaybackURLsIssued
+		usage.Total.Downloads += counts.Downloads
+		usage.Days = append(usage.Days, core.DailyAssetUsage{Day: day, Counts: counts})
+	}
+	return usage, nil
+}
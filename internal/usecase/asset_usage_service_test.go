@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type assetUsageKey struct {
+	assetID uuid.UUID
+	day     time.Time
+}
+
+type stubAssetUsageRepo struct {
+	counts map[assetUsageKey]core.AssetUsageCounts
+}
+
+func newStubAssetUsageRepo() *stubAssetUsageRepo {
+	return &stubAssetUsageRepo{counts: map[assetUsageKey]core.AssetUsageCounts{}}
+}
+
+func (r *stubAssetUsageRepo) IncrementAssetUsage(ctx context.Context, assetID uuid.UUID, kind core.AssetAccessKind, day time.Time) error {
+	key := assetUsageKey{assetID, day}
+	counts := r.counts[key]
+	if kind == core.AssetAccessKindPlaybackURL {
+		counts.PlaybackURLsIssued++
+	} else {
+		counts.Downloads++
+	}
+	r.counts[key] = counts
+	return nil
+}
+
+func (r *stubAssetUsageRepo) ListAssetUsage(ctx context.Context, assetID uuid.UUID, from, to time.Time) ([]core.DailyAssetUsage, error) {
+	var days []core.DailyAssetUsage
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		if counts, ok := r.counts[assetUsageKey{assetID, day}]; ok {
+			days = append(days, core.DailyAssetUsage{Day: day, Counts: counts})
+		}
+	}
+	return days, nil
+}
+
+func TestAssetUsageService(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 5, 15, 15, 0, 0, 0, time.UTC)
+	assetID := uuid.New()
+	assets := &stubAssetRepo{getAssetByIDFn: func(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
+		if id != assetID {
+			return nil, core.ErrNotFound
+		}
+		return &core.Asset{ID: id}, nil
+	}}
+	repo := newStubAssetUsageRepo()
+	service := NewAssetUsageService(repo, assets)
+	service.WithClock(func() time.Time { return now })
+
+	for _, kind := range []core.AssetAccessKind{core.AssetAccessKindPlaybackURL, core.AssetAccessKindPlaybackURL, core.AssetAccessKindDownload} {
+		if err := service.RecordAssetAccess(ctx, assetID, kind); err != nil {
+			t.Fatalf("RecordAssetAccess() error = %v", err)
+		}
+	}
+	service.WithClock(func() time.Time { return now.AddDate(0, 0, -3) })
+	if err := service.RecordAssetAccess(ctx, assetID, core.AssetAccessKindDownload); err != nil {
+		t.Fatalf("RecordAssetAccess() error = %v", err)
+	}
+	if err := service.RecordAssetAccess(ctx, assetID, core.AssetAccessKindUnspecified); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected validation error for an unknown kind, got %v", err)
+	}
+
+	service.WithClock(func() time.Time { return now })
+	usage, err := service.GetAssetUsage(ctx, core.AssetUsageQuery{AssetID: assetID})
+	if err != nil {
+		t.Fatalf("GetAssetUsage() error = %v", err)
+	}
+	today := truncateDay(now)
+	if len(usage.Days) != defaultAnalyticsDays || !usage.To.Equal(today.AddDate(0, 0, 1)) {
+		t.Fatalf("expected the 30 days ending today, got %d days to %s", len(usage.Days), usage.To)
+	}
+	if want := (core.AssetUsageCounts{PlaybackURLsIssued: 2, Downloads: 2}); usage.Total != want {
+		t.Fatalf("total = %+v, want %+v", usage.Total, want)
+	}
+	last := usage.Days[len(usage.Days)-1]
+	if !last.Day.Equal(today) || last.Counts != (core.AssetUsageCounts{PlaybackURLsIssued: 2, Downloads: 1}) {
+		t.Fatalf("unexpected last day %+v", last)
+	}
+	if idle := usage.Days[len(usage.Days)-2]; idle.Counts != (core.AssetUsageCounts{}) {
+		t.Fatalf("expected a day without accesses to count zero, got %+v", idle)
+	}
+
+	if _, err := service.GetAssetUsage(ctx, core.AssetUsageQuery{AssetID: uuid.New()}); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for an unknown asset, got %v", err)
+	}
+	if _, err := service.GetAssetUsage(ctx, core.AssetUsageQuery{AssetID: assetID, From: now, To: now.AddDate(-2, 0, 0)}); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected validation error for an inverted range, got %v", err)
+	}
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// defaultAssetWatchPoll is how often WatchAsset re-reads the asset. Status
+// changes relayed to another process do not reach this one's watchers, so
+// polling bounds how late those are noticed.
+const defaultAssetWatchPoll = 5 * time.Second
+
+// WithWatchPollInterval sets how often WatchAsset re-reads the asset when no
+// status change has been relayed to this process.
+func (s *AssetService) WithWatchPollInterval(interval time.Duration) {
+	if interval > 0 {
+		s.watchPoll = interval
+	}
+}
+
+// WatchAsset sends the asset, then sends it again whenever its status
+// changes, until it is ready, failed or deleted, or ctx is done.
+func (s *AssetService) WatchAsset(ctx context.Context, id uuid.UUID, send func(core.Asset) error) error {
+	wake, stop := s.watchers.add(id)
+	defer stop()
+
+	ticker := time.NewTicker(s.watchPoll)
+	defer ticker.Stop()
+
+	var last core.AssetStatus
+	for {
+		asset, err := s.repo.GetAssetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if asset.Status != last {
+			if err := send(*asset); err != nil {
+				return err
+			}
+			last = asset.Status
+		}
+		if asset.Status.Settled() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.watchers.stopped:
+			return nil
+		case <-wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// HandleAssetStatusChanged wakes this process's watchers of the asset so
+// they re-read it.
+func (s *AssetService) HandleAssetStatusChanged(ctx context.Context, event core.AssetStatusChanged) error {
+	s.watchers.notify(event.Asset.ID)
+	return nil
+}
+
+// StopWatching ends every WatchAsset call so streams finish during
+// shutdown; clients watch again on another instance.
+func (s *AssetService) StopWatching() {
+	s.watchers.stop()
+}
+
+// assetWatchers tracks the WatchAsset calls in this process by asset.
+type assetWatchers struct {
+	mu      sync.Mutex
+	byAsset map[uuid.UUID]map[chan struct{}]struct{}
+
+	stopOnce sync.Once
+	stopped  chan struct{}
+}
+
+func newAssetWatchers() *assetWatchers {
+	return &assetWatchers{
+		byAsset: map[uuid.UUID]map[chan struct{}]struct{}{},
+		stopped: make(chan struct{}),
+	}
+}
+
+// stop ends every current and future watch.
+func (w *assetWatchers) stop() {
+	w.stopOnce.Do(func() { close(w.stopped) })
+}
+
+// add registers a watcher of the asset, returning the channel it is woken on
+// and a function removing it.
+func (w *assetWatchers) add(id uuid.UUID) (<-chan struct{}, func()) {
+	wake := make(chan struct{}, 1)
+
+	w.mu.Lock()
+	if w.byAsset[id] == nil {
+		w.byAsset[id] = map[chan struct{}]struct{}{}
+	}
+	w.byAsset[id][wake] = struct{}{}
+	w.mu.Unlock()
+
+	return wake, func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		delete(w.byAsset[id], wake)
+		if len(w.byAsset[id]) == 0 {
+			delete(w.byAsset, id)
+		}
+	}
+}
+
+// notify wakes every watcher of the asset without blocking; a watcher that
+// has not yet consumed an earlier wake-up re-reads the asset only once.
+func (w *assetWatchers) notify(id uuid.UUID) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for wake := range w.byAsset[id] {
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestAssetService_WatchAsset(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	var mu sync.Mutex
+	status := core.AssetStatusPending
+	repo := &stubAssetRepo{getAssetByIDFn: func(ctx context.Context, assetID uuid.UUID) (*core.Asset, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		return &core.Asset{ID: assetID, Status: status}, nil
+	}}
+	svc := NewAssetService(repo, nil)
+	// Poll slower than the test so only events can wake the watcher.
+	svc.WithWatchPollInterval(time.Hour)
+
+	sent := make(chan core.AssetStatus, 4)
+	done := make(chan error, 1)
+	go func() {
+		done <- svc.WatchAsset(ctx, id, func(asset core.Asset) error {
+			sent <- asset.Status
+			return nil
+		})
+	}()
+
+	if got := <-sent; got != core.AssetStatusPending {
+		t.Fatalf("expected the current status first, got %v", got)
+	}
+
+	for _, next := range []core.AssetStatus{core.AssetStatusProcessing, core.AssetStatusReady} {
+		mu.Lock()
+		status = next
+		mu.Unlock()
+		if err := svc.HandleAssetStatusChanged(ctx, core.AssetStatusChanged{Asset: core.Asset{ID: id, Status: next}}); err != nil {
+			t.Fatalf("HandleAssetStatusChanged() error = %v", err)
+		}
+		select {
+		case got := <-sent:
+			if got != next {
+				t.Fatalf("expected status %v, got %v", next, got)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("watcher was not woken for status %v", next)
+		}
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("WatchAsset() error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected WatchAsset to return once the asset is ready")
+	}
+	if len(svc.watchers.byAsset) != 0 {
+		t.Fatalf("expected the watcher to be removed, got %d", len(svc.watchers.byAsset))
+	}
+}
+
+func TestAssetService_WatchAssetNotFound(t *testing.T) {
+	svc := NewAssetService(&stubAssetRepo{}, nil)
+	err := svc.WatchAsset(context.Background(), uuid.New(), func(core.Asset) error {
+		t.Fatal("send should not be called")
+		return nil
+	})
+	if !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestAssetService_StopWatching(t *testing.T) {
+	repo := &stubAssetRepo{getAssetByIDFn: func(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
+		return &core.Asset{ID: id, Status: core.AssetStatusProcessing}, nil
+	}}
+	svc := NewAssetService(repo, nil)
+	svc.WithWatchPollInterval(time.Hour)
+
+	done := make(chan error, 1)
+	sent := make(chan struct{}, 1)
+	go func() {
+		done <- svc.WatchAsset(context.Background(), uuid.New(), func(core.Asset) error {
+			sent <- struct{}{}
+			return nil
+		})
+	}()
+	<-sent
+
+	svc.StopWatching()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("WatchAsset() error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected StopWatching to end the watch")
+	}
+}
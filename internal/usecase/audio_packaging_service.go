@@ -0,0 +1,254 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"mime"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// packagedAudioTypes lists the MIME types of the audio formats packaged for HLS.
+var packagedAudioTypes = map[string]bool{
+	"audio/mpeg":  true,
+	"audio/mp3":   true,
+	"audio/aac":   true,
+	"audio/mp4":   true,
+	"audio/x-m4a": true,
+}
+
+// contentKeySize is the size in bytes of an AES-128 content key.
+const contentKeySize = 16
+
+// AudioPackagingService segments uploaded MP3 and AAC audio into multi-bitrate
+// HLS so long lessons seek quickly and adapt to the listener's connection.
+// Audio played in a series with DRM enabled is encrypted with AES-128 under
+// a key of its own, which players fetch from the key URL.
+type AudioPackagingService struct {
+	assets   core.AssetRepository
+	series   core.SeriesRepository
+	keys     core.ContentKeyRepository
+	packager core.AudioPackager
+	keyURL   string
+}
+
+// NewAudioPackagingService constructs a packaging service. packager may be nil
+// when no packager is configured, in which case packaging is unavailable and
+// asset events are ignored.
+func NewAudioPackagingService(assets core.AssetRepository, series core.SeriesRepository, keys core.ContentKeyRepository, packager core.AudioPackager) *AudioPackagingService {
+	return &AudioPackagingService{
+		assets:   assets,
+		series:   series,
+		keys:     keys,
+		packager: packager,
+	}
+}
+
+// WithKeyURL sets the URL content keys are served under; a key's URI is the
+// URL followed by its id. Encrypted packaging is unavailable without it.
+func (s *AudioPackagingService) WithKeyURL(keyURL string) {
+	s.keyURL = strings.TrimRight(keyURL, "/")
+}
+
+var _ core.AudioPackagingService = (*AudioPackagingService)(nil)
+
+// PackageAsset packages a ready audio asset and records the manifest on it.
+// A protected asset is encrypted under a new content key, stored before the
+// rendition is published so players never meet a key that does not exist.
+func (s *AudioPackagingService) PackageAsset(ctx context.Context, assetID uuid.UUID) (*core.Asset, error) {
+	if s.packager == nil {
+		return nil, fmt.Errorf("%w: audio packaging is not configured", core.ErrInvalidState)
+	}
+	asset, err := s.assets.GetAssetByID(ctx, assetID)
+	if err != nil {
+		return nil, err
+	}
+	if reason := packagingSkipReason(asset); reason != "" {
+		return nil, fmt.Errorf("%w: %s", core.ErrInvalidState, reason)
+	}
+
+	req := core.AudioPackageRequest{
+		AssetID:   asset.ID,
+		SourceURL: asset.PlaybackURL,
+		MimeType:  asset.MimeType,
+	}
+	protected, err := s.protected(ctx, asset.ID)
+	if err != nil {
+		return nil, err
+	}
+	var keyID uuid.UUID
+	if protected {
+		key, err := s.createContentKey(ctx, asset.ID)
+		if err != nil {
+			return nil, err
+		}
+		keyID = key.ID
+		req.Encryption = &core.AudioEncryption{Key: key.Key, KeyURI: s.keyURL + "/" + key.ID.String()}
+	}
+
+	pkg, err := s.packager.PackageAudio(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Packaging takes a while; the asset may have been deleted or replaced
+	// since, in which case the rendition is for stale audio.
+	current, err := s.assets.GetAssetByID(ctx, assetID)
+	if err != nil {
+		return nil, err
+	}
+	if current.Status != core.AssetStatusReady || current.PlaybackURL != asset.PlaybackURL {
+		return nil, fmt.Errorf("%w: asset changed during packaging", core.ErrInvalidState)
+	}
+	// The new rendition is written over the previous one, which may still
+	// be cached downstream.
+	previous := current.HLSManifestURL
+	current.HLSManifestURL = pkg.ManifestURL
+	current.HLSKeyID = keyID
+	var events []core.Event
+	if previous != "" {
+		events = append(events, core.AssetRenditionReplaced{Asset: *current, PreviousURLs: []string{previous}})
+	}
+	return s.assets.UpdateAsset(ctx, *current, events...)
+}
+
+// HandleAssetReady packages an audio asset that became ready.
+func (s *AudioPackagingService) HandleAssetReady(ctx context.Context, event core.Event) error {
+	if s.packager == nil {
+		return nil
+	}
+	ready, ok := event.(core.AssetReady)
+	if !ok || packagingSkipReason(&ready.Asset) != "" {
+		return nil
+	}
+
+	// A deleted or changed asset leaves nothing to retry.
+	_, err := s.PackageAsset(ctx, ready.Asset.ID)
+	if isNotFound(err) || errors.Is(err, core.ErrInvalidState) {
+		return nil
+	}
+	return err
+}
+
+// HandleProtectionEvent packages again the packaged assets of an updated
+// series, or of a created or updated episode, whose encryption no longer
+// matches whether a series playing them has DRM enabled.
+func (s *AudioPackagingService) HandleProtectionEvent(ctx context.Context, event core.Event) error {
+	if s.packager == nil {
+		return nil
+	}
+	var assetIDs []uuid.UUID
+	switch e := event.(type) {
+	case core.SeriesUpdated:
+		series, err := s.series.GetSeries(ctx, e.Series.ID, core.SeriesQueryOptions{IncludeEpisodes: true})
+		if isNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, episode := range series.Episodes {
+			assetIDs = append(assetIDs, episode.Resource.AssetID)
+		}
+	case core.EpisodeCreated:
+		assetIDs = append(assetIDs, e.Episode.Resource.AssetID)
+	case core.EpisodeUpdated:
+		assetIDs = append(assetIDs, e.Episode.Resource.AssetID)
+	default:
+		return nil
+	}
+
+	for _, assetID := range lo.Uniq(assetIDs) {
+		if assetID == uuid.Nil {
+			continue
+		}
+		asset, err := s.assets.GetAssetByID(ctx, assetID)
+		if isNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		// Unpackaged assets are left to HandleAssetReady.
+		if asset.HLSManifestURL == "" {
+			continue
+		}
+		protected, err := s.protected(ctx, asset.ID)
+		if err != nil {
+			return err
+		}
+		if protected == (asset.HLSKeyID != uuid.Nil) {
+			continue
+		}
+		_, err = s.PackageAsset(ctx, asset.ID)
+		if err != nil && !isNotFound(err) && !errors.Is(err, core.ErrInvalidState) {
+			return err
+		}
+	}
+	return nil
+}
+
+// protected reports whether any live episode playing the asset belongs to a
+// series with DRM enabled.
+func (s *AudioPackagingService) protected(ctx context.Context, assetID uuid.UUID) (bool, error) {
+	episodes, err := s.series.ListEpisodesByAsset(ctx, assetID)
+	if err != nil {
+		return false, err
+	}
+	for _, seriesID := range lo.Uniq(lo.Map(episodes, func(episode core.Episode, _ int) uuid.UUID { return episode.SeriesID })) {
+		series, err := s.series.GetSeries(ctx, seriesID, core.SeriesQueryOptions{})
+		if isNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		if series.DRMEnabled {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// createContentKey stores a new random key for the asset.
+func (s *AudioPackagingService) createContentKey(ctx context.Context, assetID uuid.UUID) (*core.ContentKey, error) {
+	if s.keyURL == "" {
+		return nil, fmt.Errorf("%w: DRM key url is not configured", core.ErrInvalidState)
+	}
+	key := core.ContentKey{
+		ID:      uuid.New(),
+		AssetID: assetID,
+		Key:     make([]byte, contentKeySize),
+	}
+	if _, err := rand.Read(key.Key); err != nil {
+		return nil, fmt.Errorf("generate content key: %w", err)
+	}
+	if err := s.keys.CreateContentKey(ctx, key); err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// packagingSkipReason explains why an asset cannot be packaged, or returns
+// an empty string when it can.
+func packagingSkipReason(asset *core.Asset) string {
+	mediaType, _, _ := mime.ParseMediaType(asset.MimeType)
+	switch {
+	case asset.Type != core.AssetTypeAudio:
+		return "only audio assets are packaged"
+	case asset.Status != core.AssetStatusReady:
+		return "asset is not ready"
+	case asset.PlaybackURL == "":
+		return "asset has no playback url"
+	case !packagedAudioTypes[strings.ToLower(mediaType)]:
+		return fmt.Sprintf("audio type %q is not packaged", asset.MimeType)
+	default:
+		return ""
+	}
+}
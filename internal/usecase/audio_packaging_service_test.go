@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestAudioPackagingService_PackageAsset(t *testing.T) {
+	asset := core.Asset{
+		ID:          uuid.New(),
+		Type:        core.AssetTypeAudio,
+		Status:      core.AssetStatusReady,
+		MimeType:    "audio/mpeg",
+		PlaybackURL: "https://cdn.example.com/lesson.mp3",
+	}
+	var updated *core.Asset
+	assets := &stubAssetRepo{
+		getAssetByIDFn: func(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
+			if id != asset.ID {
+				return nil, core.ErrNotFound
+			}
+			found := asset
+			return &found, nil
+		},
+		updateAssetFn: func(ctx context.Context, a core.Asset) error {
+			updated = &a
+			return nil
+		},
+	}
+	packager := &stubAudioPackager{manifestURL: "https://media.example.com/hls/master.m3u8"}
+	service := NewAudioPackagingService(assets, &stubSeriesRepo{}, newStubContentKeyRepo(), packager)
+
+	if err := service.HandleAssetReady(context.Background(), core.AssetReady{Asset: asset}); err != nil {
+		t.Fatalf("HandleAssetReady() error = %v", err)
+	}
+	if updated == nil || updated.HLSManifestURL != packager.manifestURL {
+		t.Fatalf("expected manifest to be recorded, got %#v", updated)
+	}
+	if packager.req.SourceURL != asset.PlaybackURL || packager.req.AssetID != asset.ID {
+		t.Fatalf("unexpected package request %#v", packager.req)
+	}
+
+	packager.calls = 0
+	video := asset
+	video.Type = core.AssetTypeVideo
+	if err := service.HandleAssetReady(context.Background(), core.AssetReady{Asset: video}); err != nil || packager.calls != 0 {
+		t.Fatalf("expected video to be skipped, got calls=%d err=%v", packager.calls, err)
+	}
+	wav := asset
+	wav.MimeType = "audio/wav"
+	if err := service.HandleAssetReady(context.Background(), core.AssetReady{Asset: wav}); err != nil || packager.calls != 0 {
+		t.Fatalf("expected WAV to be skipped, got calls=%d err=%v", packager.calls, err)
+	}
+
+	asset.Status = core.AssetStatusDeleted
+	if _, err := service.PackageAsset(context.Background(), asset.ID); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected deleted asset to be refused, got %v", err)
+	}
+
+	disabled := NewAudioPackagingService(assets, &stubSeriesRepo{}, newStubContentKeyRepo(), nil)
+	if _, err := disabled.PackageAsset(context.Background(), asset.ID); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected packaging to be unavailable, got %v", err)
+	}
+}
+
+func TestAudioPackagingService_Encryption(t *testing.T) {
+	asset := core.Asset{
+		ID:             uuid.New(),
+		Type:           core.AssetTypeAudio,
+		Status:         core.AssetStatusReady,
+		MimeType:       "audio/mpeg",
+		PlaybackURL:    "https://cdn.example.com/lesson.mp3",
+		HLSManifestURL: "https://media.example.com/hls/master.m3u8",
+	}
+	assets := &stubAssetRepo{
+		getAssetByIDFn: func(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
+			found := asset
+			return &found, nil
+		},
+		updateAssetFn: func(ctx context.Context, a core.Asset) error {
+			asset = a
+			return nil
+		},
+	}
+	series := core.Series{ID: uuid.New()}
+	episode := core.Episode{ID: uuid.New(), SeriesID: series.ID, Resource: core.MediaResource{AssetID: asset.ID}}
+	seriesRepo := &stubSeriesRepo{
+		getSeriesFn: func(ctx context.Context, id uuid.UUID, opts core.SeriesQueryOptions) (*core.Series, error) {
+			found := series
+			if opts.IncludeEpisodes {
+				found.Episodes = []core.Episode{episode}
+			}
+			return &found, nil
+		},
+		listEpisodesByAssetFn: func(ctx context.Context, assetID uuid.UUID) ([]core.Episode, error) {
+			return []core.Episode{episode}, nil
+		},
+	}
+	keys := newStubContentKeyRepo()
+	packager := &stubAudioPackager{manifestURL: asset.HLSManifestURL}
+	service := NewAudioPackagingService(assets, seriesRepo, keys, packager)
+	service.WithKeyURL("https://api.example.com/drm/v1/keys/")
+
+	// Nothing changes while the series plays the asset in the clear.
+	if err := service.HandleProtectionEvent(context.Background(), core.SeriesUpdated{Series: series}); err != nil || packager.calls != 0 {
+		t.Fatalf("expected no packaging, got calls=%d err=%v", packager.calls, err)
+	}
+
+	series.DRMEnabled = true
+	if err := service.HandleProtectionEvent(context.Background(), core.SeriesUpdated{Series: series}); err != nil {
+		t.Fatalf("HandleProtectionEvent() error = %v", err)
+	}
+	first := asset.HLSKeyID
+	key, err := keys.GetContentKey(context.Background(), first)
+	if err != nil || key.AssetID != asset.ID || len(key.Key) != contentKeySize {
+		t.Fatalf("expected a stored content key, got %#v, %v", key, err)
+	}
+	encryption := packager.req.Encryption
+	if encryption == nil || string(encryption.Key) != string(key.Key) || encryption.KeyURI != "https://api.example.com/drm/v1/keys/"+first.String() {
+		t.Fatalf("unexpected encryption %#v", encryption)
+	}
+
+	// Packaging again rotates the key.
+	if _, err := service.PackageAsset(context.Background(), asset.ID); err != nil {
+		t.Fatalf("PackageAsset() error = %v", err)
+	}
+	if asset.HLSKeyID == uuid.Nil || asset.HLSKeyID == first {
+		t.Fatalf("expected a new key, got %s", asset.HLSKeyID)
+	}
+
+	series.DRMEnabled = false
+	if err := service.HandleProtectionEvent(context.Background(), core.EpisodeUpdated{Episode: episode}); err != nil {
+		t.Fatalf("HandleProtectionEvent() error = %v", err)
+	}
+	if asset.HLSKeyID != uuid.Nil || packager.req.Encryption != nil {
+		t.Fatalf("expected the asset to be packaged in the clear, got key %s", asset.HLSKeyID)
+	}
+}
+
+type stubAudioPackager struct {
+	manifestURL string
+	req         core.AudioPackageRequest
+	calls       int
+}
+
+func (s *stubAudioPackager) PackageAudio(ctx context.Context, req core.AudioPackageRequest) (*core.AudioPackage, error) {
+	s.calls++
+	s.req = req
+	return &core.AudioPackage{ManifestURL: s.manifestURL, Bitrates: []int{64, 128}}, nil
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// AuditService lets administrators read the audit log.
+type AuditService struct {
+	repo core.AuditRepository
+}
+
+// NewAuditService constructs an audit service backed by the provided repository.
+func NewAuditService(repo core.AuditRepository) *AuditService {
+	return &AuditService{repo: repo}
+}
+
+var _ core.AuditService = (*AuditService)(nil)
+
+// ListAuditEntries returns audit entries, newest first.
+func (s *AuditService) ListAuditEntries(ctx context.Context, filter core.AuditListFilter) ([]core.AuditEntry, string, error) {
+	return s.repo.ListAuditEntries(ctx, filter)
+}
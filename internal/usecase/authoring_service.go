@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	defaultDraftLength = 5 * time.Minute
+	maxDraftLength     = 30 * time.Minute
+	maxDraftTopic      = 500
+)
+
+// AuthoringService drafts lesson material with a language model for editors
+// to review, metering the tokens each draft consumes.
+type AuthoringService struct {
+	generator core.EpisodeDraftGenerator
+	metering  core.MeteringService
+}
+
+// NewAuthoringService constructs an authoring service. generator may be nil
+// when no language model is configured, in which case drafting is
+// unavailable.
+func NewAuthoringService(generator core.EpisodeDraftGenerator, metering core.MeteringService) *AuthoringService {
+	return &AuthoringService{
+		generator: generator,
+		metering:  metering,
+	}
+}
+
+var _ core.AuthoringService = (*AuthoringService)(nil)
+
+// GenerateEpisodeDraft writes a script, summary, vocabulary list and quiz on
+// the requested topic. Nothing is saved; the editor decides what to keep.
+func (s *AuthoringService) GenerateEpisodeDraft(ctx context.Context, req core.EpisodeDraftRequest) (*core.GeneratedEpisodeDraft, error) {
+	if s.generator == nil {
+		return nil, fmt.Errorf("%w: lesson generation is not configured", core.ErrInvalidState)
+	}
+
+	req.Topic = strings.TrimSpace(req.Topic)
+	req.Level = strings.ToUpper(strings.TrimSpace(req.Level))
+	req.Language = strings.TrimSpace(req.Language)
+	req.TenantID = strings.TrimSpace(req.TenantID)
+	if req.Length == 0 {
+		req.Length = defaultDraftLength
+	}
+	switch {
+	case req.Topic == "":
+		return nil, fmt.Errorf("%w: topic is required", core.ErrValidation)
+	case utf8.RuneCountInString(req.Topic) > maxDraftTopic:
+		return nil, fmt.Errorf("%w: topics are at most %d characters", core.ErrValidation, maxDraftTopic)
+	case req.Level != "" && !isCEFRLevel(req.Level):
+		return nil, fmt.Errorf("%w: level %q is not a CEFR level", core.ErrValidation, req.Level)
+	case req.Length < time.Minute || req.Length > maxDraftLength:
+		return nil, fmt.Errorf("%w: length must be between 1 and %d minutes", core.ErrValidation, int(maxDraftLength.Minutes()))
+	}
+
+	draft, err := s.generator.GenerateEpisodeDraft(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tokens are billed whether or not the editor keeps the draft. Like API
+	// call metering, recording usage is best-effort.
+	if tokens := draft.Usage.Total(); tokens > 0 && req.TenantID != "" && s.metering != nil {
+		_ = s.metering.RecordUsage(context.WithoutCancel(ctx), core.UsageRecord{
+			TenantID: req.TenantID,
+			Metric:   core.UsageMetricGenerationTokens,
+			Quantity: float64(tokens),
+		})
+	}
+
+	if strings.TrimSpace(draft.Script) == "" {
+		return nil, fmt.Errorf("%w: the model returned an empty script", core.ErrInvalidState)
+	}
+	for i, question := range draft.Questions {
+		if question.AnswerIndex < 0 || question.AnswerIndex >= len(question.Choices) {
+			return nil, fmt.Errorf("%w: the model returned question %d without a valid answer", core.ErrInvalidState, i+1)
+		}
+	}
+	return draft, nil
+}
+
+// isCEFRLevel reports whether level is one of A1 through C2.
+func isCEFRLevel(level string) bool {
+	return len(level) == 2 && strings.ContainsRune("ABC", rune(level[0])) && (level[1] == '1' || level[1] == '2')
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// draftGeneratorFunc adapts a function to core.EpisodeDraftGenerator.
+type draftGeneratorFunc func(ctx context.Context, req core.EpisodeDraftRequest) (*core.GeneratedEpisodeDraft, error)
+
+func (f draftGeneratorFunc) GenerateEpisodeDraft(ctx context.Context, req core.EpisodeDraftRequest) (*core.GeneratedEpisodeDraft, error) {
+	return f(ctx, req)
+}
+
+func TestAuthoringService_GenerateEpisodeDraft(t *testing.T) {
+	var got core.EpisodeDraftRequest
+	generator := draftGeneratorFunc(func(_ context.Context, req core.EpisodeDraftRequest) (*core.GeneratedEpisodeDraft, error) {
+		got = req
+		return &core.GeneratedEpisodeDraft{
+			Title:     "At the café",
+			Script:    "Barista: What can I get you?",
+			Questions: []core.DraftQuizQuestion{{Prompt: "Where are they?", Choices: []string{"A café", "A bank"}, AnswerIndex: 0}},
+			Usage:     core.GenerationUsage{PromptTokens: 120, CompletionTokens: 880},
+		}, nil
+	})
+	var recorded []core.UsageRecord
+	metering := NewMeteringService(&stubMeteringRepo{recordUsageFn: func(_ context.Context, record core.UsageRecord) error {
+		recorded = append(recorded, record)
+		return nil
+	}})
+	service := NewAuthoringService(generator, metering)
+
+	draft, err := service.GenerateEpisodeDraft(context.Background(), core.EpisodeDraftRequest{
+		Topic:    "  Ordering coffee ",
+		Level:    "b1",
+		TenantID: "acme",
+	})
+	if err != nil {
+		t.Fatalf("GenerateEpisodeDraft() error = %v", err)
+	}
+	if draft.Title != "At the café" {
+		t.Fatalf("unexpected draft %+v", draft)
+	}
+	if got.Topic != "Ordering coffee" || got.Level != "B1" || got.Length != defaultDraftLength {
+		t.Fatalf("unexpected request %+v", got)
+	}
+	if len(recorded) != 1 || recorded[0].TenantID != "acme" || recorded[0].Metric != core.UsageMetricGenerationTokens || recorded[0].Quantity != 1000 {
+		t.Fatalf("unexpected usage %+v", recorded)
+	}
+}
+
+func TestAuthoringService_GenerateEpisodeDraftRejects(t *testing.T) {
+	valid := draftGeneratorFunc(func(context.Context, core.EpisodeDraftRequest) (*core.GeneratedEpisodeDraft, error) {
+		return &core.GeneratedEpisodeDraft{Script: "Hello."}, nil
+	})
+	badQuiz := draftGeneratorFunc(func(context.Context, core.EpisodeDraftRequest) (*core.GeneratedEpisodeDraft, error) {
+		return &core.GeneratedEpisodeDraft{Script: "Hello.", Questions: []core.DraftQuizQuestion{{Prompt: "?", Choices: []string{"a"}, AnswerIndex: 3}}}, nil
+	})
+
+	tests := []struct {
+		name      string
+		generator core.EpisodeDraftGenerator
+		req       core.EpisodeDraftRequest
+		wantErr   error
+	}{
+		{name: "not configured", req: core.EpisodeDraftRequest{Topic: "Travel"}, wantErr: core.ErrInvalidState},
+		{name: "missing topic", generator: valid, req: core.EpisodeDraftRequest{Topic: " "}, wantErr: core.ErrValidation},
+		{name: "long topic", generator: valid, req: core.EpisodeDraftRequest{Topic: strings.Repeat("x", maxDraftTopic+1)}, wantErr: core.ErrValidation},
+		{name: "unknown level", generator: valid, req: core.EpisodeDraftRequest{Topic: "Travel", Level: "D1"}, wantErr: core.ErrValidation},
+		{name: "too long", generator: valid, req: core.EpisodeDraftRequest{Topic: "Travel", Length: time.Hour}, wantErr: core.ErrValidation},
+		{name: "question without answer", generator: badQuiz, req: core.EpisodeDraftRequest{Topic: "Travel"}, wantErr: core.ErrInvalidState},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewAuthoringService(tt.generator, nil)
+			if _, err := service.GenerateEpisodeDraft(context.Background(), tt.req); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GenerateEpisodeDraft() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package usecase
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// Entries of a backup archive. Tables come first, one JSON object per line,
+// in restore order; the manifest comes last.
+const (
+	backupTablesDir    = "tables/"
+	backupMediaEntry   = "media.json"
+	backupManifestName = "manifest.json"
+)
+
+// BackupService writes the catalog into a gzip-compressed tar archive and
+// restores it from one.
+type BackupService struct {
+	repo   core.BackupRepository
+	assets core.AssetRepository
+	tx     core.TxManager
+	now    func() time.Time
+}
+
+// NewBackupService constructs a backup service using the supplied repositories.
+func NewBackupService(repo core.BackupRepository, assets core.AssetRepository) *BackupService {
+	return &BackupService{
+		repo:   repo,
+		assets: assets,
+		now:    time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *BackupService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+// WithTxManager makes the service restore an archive in one transaction.
+func (s *BackupService) WithTxManager(tx core.TxManager) {
+	s.tx = tx
+}
+
+var _ core.BackupService = (*BackupService)(nil)
+
+// Backup writes every table, and the media manifest when asked for, to w.
+// Each table is held in memory while it is written.
+func (s *BackupService) Backup(ctx context.Context, w io.Writer, opts core.BackupOptions) (*core.BackupManifest, error) {
+	manifest := &core.BackupManifest{
+		FormatVersion: core.BackupFormatVersion,
+		CreatedAt:     s.now().UTC(),
+	}
+	gz := gzip.NewWriter(w)
+	archive := tar.NewWriter(gz)
+
+	for _, table := range s.repo.BackupTables() {
+		var buf bytes.Buffer
+		rows := 0
+		if err := s.repo.DumpTable(ctx, table, func(row json.RawMessage) error {
+			rows++
+			buf.Write(row)
+			return buf.WriteByte('\n')
+		}); err != nil {
+			return nil, err
+		}
+		if err := writeBackupEntry(archive, backupTablesDir+table+".jsonl", buf.Bytes(), manifest.CreatedAt); err != nil {
+			return nil, err
+		}
+		manifest.Tables = append(manifest.Tables, core.BackupTable{Name: table, Rows: rows})
+	}
+
+	if opts.IncludeMedia {
+		media, err := s.listMedia(ctx)
+		if err != nil {
+			return nil, err
+		}
+		encoded, err := json.MarshalIndent(media, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		if err := writeBackupEntry(archive, backupMediaEntry, encoded, manifest.CreatedAt); err != nil {
+			return nil, err
+		}
+		manifest.Media = len(media)
+	}
+
+	encoded, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := writeBackupEntry(archive, backupManifestName, encoded, manifest.CreatedAt); err != nil {
+		return nil, err
+	}
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// Restore loads the tables of an archive in one transaction, then checks
+// them against its manifest, so a truncated or mismatched archive restores
+// nothing.
+func (s *BackupService) Restore(ctx context.Context, r io.Reader) (*core.BackupManifest, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: not a gzip archive: %w", core.ErrValidation, err)
+	}
+	defer gz.Close()
+	archive := tar.NewReader(gz)
+
+	var manifest *core.BackupManifest
+	err = withinTx(ctx, s.tx, func(ctx context.Context) error {
+		restored := map[string]int{}
+		for {
+			header, err := archive.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("%w: read archive: %w", core.ErrValidation, err)
+			}
+
+			switch name := header.Name; {
+			case name == backupManifestName:
+				manifest = &core.BackupManifest{}
+				if err := json.NewDecoder(archive).Decode(manifest); err != nil {
+					return fmt.Errorf("%w: decode manifest: %w", core.ErrValidation, err)
+				}
+			case strings.HasPrefix(name, backupTablesDir):
+				table := strings.TrimSuffix(path.Base(name), ".jsonl")
+				rows, err := s.repo.RestoreTable(ctx, table, jsonLines(archive))
+				if err != nil {
+					return err
+				}
+				restored[table] = rows
+			}
+		}
+		return checkRestore(manifest, restored)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// checkRestore verifies the restored tables against the manifest.
+func checkRestore(manifest *core.BackupManifest, restored map[string]int) error {
+	if manifest == nil {
+		return fmt.Errorf("%w: archive has no manifest", core.ErrValidation)
+	}
+	if manifest.FormatVersion != core.BackupFormatVersion {
+		return fmt.Errorf("%w: unsupported backup format %d", core.ErrValidation, manifest.FormatVersion)
+	}
+	for _, table := range manifest.Tables {
+		if restored[table.Name] != table.Rows {
+			return fmt.Errorf("%w: table %s restored %d of %d rows", core.ErrValidation, table.Name, restored[table.Name], table.Rows)
+		}
+	}
+	return nil
+}
+
+func (s *BackupService) listMedia(ctx context.Context) ([]core.BackupMedia, error) {
+	media := []core.BackupMedia{}
+	token := ""
+	for {
+		assets, next, err := s.assets.ListAssets(ctx, core.AssetListFilter{PageSize: maintenanceBatchSize, PageToken: token})
+		if err != nil {
+			return nil, err
+		}
+		for _, asset := range assets {
+			media = append(media, core.BackupMedia{
+				AssetID:     asset.ID,
+				AssetKey:    asset.AssetKey,
+				Provider:    asset.Provider,
+				PlaybackURL: asset.PlaybackURL,
+				MimeType:    asset.MimeType,
+				Filesize:    asset.Filesize,
+			})
+		}
+		if next == "" {
+			return media, nil
+		}
+		token = next
+	}
+}
+
+func writeBackupEntry(archive *tar.Writer, name string, content []byte, modTime time.Time) error {
+	if err := archive.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(content)),
+		ModTime: modTime,
+	}); err != nil {
+		return err
+	}
+	_, err := archive.Write(content)
+	return err
+}
+
+// jsonLines returns the lines of r one at a time, then io.EOF.
+func jsonLines(r io.Reader) func() (json.RawMessage, error) {
+	scanner := bufio.NewScanner(r)
+	// Rows carry whole transcripts.
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	return func() (json.RawMessage, error) {
+		for scanner.Scan() {
+			if line := bytes.TrimSpace(scanner.Bytes()); len(line) > 0 {
+				return append(json.RawMessage(nil), line...), nil
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestBackupService_BackupAndRestore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	source := newStubBackupRepo()
+	source.rows["series"] = []json.RawMessage{json.RawMessage(`{"id":"s1"}`), json.RawMessage(`{"id":"s2"}`)}
+	source.rows["episodes"] = []json.RawMessage{json.RawMessage(`{"id":"e1","transcript":"Hello"}`)}
+	assets := &stubAssetRepo{listAssetsFn: func(ctx context.Context, filter core.AssetListFilter) ([]core.Asset, string, error) {
+		return []core.Asset{{ID: uuid.New(), AssetKey: "coffee", Provider: "fake", PlaybackURL: "https://cdn.local/coffee.mp3"}}, "", nil
+	}}
+	svc := NewBackupService(source, assets)
+	svc.WithClock(func() time.Time { return now })
+
+	var archive bytes.Buffer
+	manifest, err := svc.Backup(ctx, &archive, core.BackupOptions{IncludeMedia: true})
+	if err != nil {
+		t.Fatalf("Backup() error = %v", err)
+	}
+	want := []core.BackupTable{{Name: "series", Rows: 2}, {Name: "episodes", Rows: 1}}
+	if fmt.Sprint(manifest.Tables) != fmt.Sprint(want) || manifest.Media != 1 || !manifest.CreatedAt.Equal(now) {
+		t.Fatalf("unexpected manifest %+v", manifest)
+	}
+
+	target := newStubBackupRepo()
+	restored, err := NewBackupService(target, &stubAssetRepo{}).Restore(ctx, bytes.NewReader(archive.Bytes()))
+	if err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+	if restored.FormatVersion != core.BackupFormatVersion || len(restored.Tables) != 2 {
+		t.Fatalf("unexpected restored manifest %+v", restored)
+	}
+	for table, rows := range source.rows {
+		if fmt.Sprint(target.rows[table]) != fmt.Sprint(rows) {
+			t.Fatalf("table %s restored as %s, want %s", table, target.rows[table], rows)
+		}
+	}
+}
+
+func TestBackupService_RestoreRejectsBadArchives(t *testing.T) {
+	ctx := context.Background()
+	svc := NewBackupService(newStubBackupRepo(), &stubAssetRepo{})
+
+	if _, err := svc.Restore(ctx, bytes.NewReader([]byte("not an archive"))); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected ErrValidation for a non-archive, got %v", err)
+	}
+
+	// The manifest counts more rows than the archive holds.
+	source := newStubBackupRepo()
+	source.rows["series"] = []json.RawMessage{json.RawMessage(`{"id":"s1"}`)}
+	var archive bytes.Buffer
+	if _, err := NewBackupService(source, &stubAssetRepo{}).Backup(ctx, &archive, core.BackupOptions{}); err != nil {
+		t.Fatalf("Backup() error = %v", err)
+	}
+	target := newStubBackupRepo()
+	target.dropRows = true
+	if _, err := NewBackupService(target, &stubAssetRepo{}).Restore(ctx, &archive); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected ErrValidation for a row count mismatch, got %v", err)
+	}
+}
+
+type stubBackupRepo struct {
+	rows     map[string][]json.RawMessage
+	dropRows bool
+}
+
+func newStubBackupRepo() *stubBackupRepo {
+	return &stubBackupRepo{rows: map[string][]json.RawMessage{}}
+}
+
+func (s *stubBackupRepo) BackupTables() []string {
+	return []string{"series", "episodes"}
+}
+
+func (s *stubBackupRepo) DumpTable(ctx context.Context, table string, fn func(json.RawMessage) error) error {
+	for _, row := range s.rows[table] {
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *stubBackupRepo) RestoreTable(ctx context.Context, table string, next func() (json.RawMessage, error)) (int, error) {
+	restored := 0
+	for {
+		row, err := next()
+		if errors.Is(err, io.EOF) {
+			return restored, nil
+		}
+		if err != nil {
+			return restored, err
+		}
+		if s.dropRows {
+			continue
+		}
+		s.rows[table] = append(s.rows[table], row)
+		restored++
+	}
+}
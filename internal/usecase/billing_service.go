@@ -0,0 +1,239 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// BillingService sells plans through a payment processor and keeps
+// subscriptions and invoices in sync with the processor's webhooks.
+type BillingService struct {
+	subscriptions core.SubscriptionRepository
+	invoices      core.InvoiceRepository
+	provider      core.BillingProvider
+	now           func() time.Time
+}
+
+// NewBillingService constructs a billing service. provider may be nil when no
+// payment processor is configured, in which case checkout is unavailable.
+func NewBillingService(subscriptions core.SubscriptionRepository, invoices core.InvoiceRepository, provider core.BillingProvider) *BillingService {
+	return &BillingService{
+		subscriptions: subscriptions,
+		invoices:      invoices,
+		provider:      provider,
+		now:           time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *BillingService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.BillingService = (*BillingService)(nil)
+
+// CreateCheckoutSession starts a hosted checkout for a paid, active plan.
+func (s *BillingService) CreateCheckoutSession(ctx context.Context, userID string, planID uuid.UUID, successURL, cancelURL string) (*core.CheckoutSession, error) {
+	if s.provider == nil {
+		return nil, fmt.Errorf("%w: billing is not configured", core.ErrInvalidState)
+	}
+
+	userID = strings.TrimSpace(userID)
+	switch {
+	case userID == "":
+		return nil, fmt.Errorf("%w: user id is required", core.ErrValidation)
+	case planID == uuid.Nil:
+		return nil, fmt.Errorf("%w: plan id is required", core.ErrValidation)
+	case strings.TrimSpace(successURL) == "" || strings.TrimSpace(cancelURL) == "":
+		return nil, fmt.Errorf("%w: success and cancel urls are required", core.ErrValidation)
+	}
+
+	plan, err := s.subscriptions.GetPlan(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case !plan.Active:
+		return nil, fmt.Errorf("%w: plan %q is no longer offered", core.ErrInvalidState, plan.Code)
+	case plan.PriceCents == 0:
+		return nil, fmt.Errorf("%w: plan %q is free and needs no checkout", core.ErrInvalidState, plan.Code)
+	}
+
+	if _, err := findEntitledSubscription(ctx, s.subscriptions, userID, s.now()); err == nil {
+		return nil, fmt.Errorf("%w: learner already has an active subscription", core.ErrInvalidState)
+	} else if !isNotFound(err) {
+		return nil, err
+	}
+
+	return s.provider.CreateCheckoutSession(ctx, core.CheckoutRequest{
+		UserID:     userID,
+		Plan:       *plan,
+		SuccessURL: successURL,
+		CancelURL:  cancelURL,
+	})
+}
+
+// HandleWebhook verifies and applies a provider notification. Events that
+// reference a subscription not yet recorded fail with ErrNotFound so the
+// provider retries them after the checkout event arrives.
+func (s *BillingService) HandleWebhook(ctx context.Context, provider string, payload []byte, header map[string][]string) error {
+	if s.provider == nil || provider != s.provider.Name() {
+		return fmt.Errorf("%w: billing provider %q", core.ErrNotFound, provider)
+	}
+
+	event, err := s.provider.ParseWebhookEvent(payload, header)
+	if err != nil {
+		return err
+	}
+
+	switch event.Type {
+	case core.BillingEventCheckoutCompleted:
+		return s.applyCheckoutCompleted(ctx, event)
+	case core.BillingEventSubscriptionUpdated:
+		return s.applySubscriptionUpdated(ctx, event)
+	case core.BillingEventSubscriptionDeleted:
+		return s.applySubscriptionDeleted(ctx, event)
+	case core.BillingEventInvoiceUpdated:
+		return s.applyInvoiceUpdated(ctx, event)
+	default:
+		return nil
+	}
+}
+
+// ListInvoices returns the learner's invoices, newest first.
+func (s *BillingService) ListInvoices(ctx context.Context, userID string) ([]core.Invoice, error) {
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", core.ErrValidation)
+	}
+	return s.invoices.ListInvoices(ctx, userID)
+}
+
+func (s *BillingService) applyCheckoutCompleted(ctx context.Context, event *core.BillingEvent) error {
+	if event.ExternalSubscriptionID == "" || event.UserID == "" || event.PlanID == uuid.Nil {
+		return fmt.Errorf("%w: checkout event %s lacks subscription, user or plan", core.ErrValidation, event.ID)
+	}
+
+	// Redelivered checkout events must not start a second subscription.
+	if _, err := s.subscriptions.FindSubscriptionByExternalID(ctx, s.provider.Name(), event.ExternalSubscriptionID); err == nil {
+		return nil
+	} else if !isNotFound(err) {
+		return err
+	}
+
+	plan, err := s.subscriptions.GetPlan(ctx, event.PlanID)
+	if err != nil {
+		return err
+	}
+
+	now := s.now().UTC()
+	start, end := event.CurrentPeriodStart, event.CurrentPeriodEnd
+	if start.IsZero() {
+		start = now
+	}
+	if end.IsZero() {
+		end = addBillingInterval(start, plan.Interval)
+	}
+
+	_, err = s.subscriptions.CreateSubscription(ctx, core.Subscription{
+		ID:                 uuid.New(),
+		UserID:             event.UserID,
+		PlanID:             plan.ID,
+		Status:             core.SubscriptionStatusActive,
+		CurrentPeriodStart: start,
+		CurrentPeriodEnd:   end,
+		BillingProvider:    s.provider.Name(),
+		ExternalID:         event.ExternalSubscriptionID,
+	})
+	return err
+}
+
+func (s *BillingService) applySubscriptionUpdated(ctx context.Context, event *core.BillingEvent) error {
+	subscription, err := s.subscriptions.FindSubscriptionByExternalID(ctx, s.provider.Name(), event.ExternalSubscriptionID)
+	if err != nil {
+		return err
+	}
+	if subscription.Status == core.SubscriptionStatusExpired {
+		return nil
+	}
+
+	now := s.now().UTC()
+	if !event.CurrentPeriodEnd.IsZero() {
+		subscription.CurrentPeriodStart = event.CurrentPeriodStart
+		subscription.CurrentPeriodEnd = event.CurrentPeriodEnd
+	}
+	switch {
+	case event.CancelAtPeriodEnd && subscription.Status == core.SubscriptionStatusActive:
+		subscription.Status = core.SubscriptionStatusCanceled
+		subscription.CanceledAt = &now
+	case !event.CancelAtPeriodEnd && subscription.Status == core.SubscriptionStatusCanceled:
+		// The learner resumed renewal before the period ended.
+		subscription.Status = core.SubscriptionStatusActive
+		subscription.CanceledAt = nil
+	}
+
+	_, err = s.subscriptions.UpdateSubscription(ctx, *subscription)
+	return err
+}
+
+func (s *BillingService) applySubscriptionDeleted(ctx context.Context, event *core.BillingEvent) error {
+	subscription, err := s.subscriptions.FindSubscriptionByExternalID(ctx, s.provider.Name(), event.ExternalSubscriptionID)
+	if err != nil {
+		return err
+	}
+	if subscription.Status == core.SubscriptionStatusExpired {
+		return nil
+	}
+
+	now := s.now().UTC()
+	subscription.Status = core.SubscriptionStatusExpired
+	if subscription.CurrentPeriodEnd.After(now) {
+		subscription.CurrentPeriodEnd = now
+	}
+	if subscription.CanceledAt == nil {
+		subscription.CanceledAt = &now
+	}
+
+	_, err = s.subscriptions.UpdateSubscription(ctx, *subscription)
+	return err
+}
+
+func (s *BillingService) applyInvoiceUpdated(ctx context.Context, event *core.BillingEvent) error {
+	if event.Invoice == nil || event.Invoice.ExternalID == "" {
+		return fmt.Errorf("%w: invoice event %s lacks an invoice", core.ErrValidation, event.ID)
+	}
+
+	subscription, err := s.subscriptions.FindSubscriptionByExternalID(ctx, s.provider.Name(), event.ExternalSubscriptionID)
+	if err != nil {
+		return err
+	}
+
+	invoice := *event.Invoice
+	invoice.ID = uuid.New()
+	invoice.SubscriptionID = subscription.ID
+	invoice.UserID = subscription.UserID
+	invoice.BillingProvider = s.provider.Name()
+	if _, err := s.invoices.UpsertInvoice(ctx, invoice); err != nil {
+		return err
+	}
+
+	// A paid renewal invoice extends access even if the subscription update
+	// event has not arrived yet.
+	if invoice.Status == core.InvoiceStatusPaid &&
+		subscription.Status == core.SubscriptionStatusActive &&
+		invoice.PeriodEnd.After(subscription.CurrentPeriodEnd) {
+		subscription.CurrentPeriodStart = invoice.PeriodStart
+		subscription.CurrentPeriodEnd = invoice.PeriodEnd
+		_, err = s.subscriptions.UpdateSubscription(ctx, *subscription)
+		return err
+	}
+	return nil
+}
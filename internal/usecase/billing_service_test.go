@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubBillingProvider struct {
+	events   map[string]*core.BillingEvent
+	checkout []core.CheckoutRequest
+}
+
+func (p *stubBillingProvider) Name() string {
+	return "stub"
+}
+
+func (p *stubBillingProvider) CreateCheckoutSession(ctx context.Context, req core.CheckoutRequest) (*core.CheckoutSession, error) {
+	p.checkout = append(p.checkout, req)
+	return &core.CheckoutSession{ID: "cs_1", URL: "https://pay.local/cs_1"}, nil
+}
+
+// ParseWebhookEvent looks up the event by its payload instead of decoding it.
+func (p *stubBillingProvider) ParseWebhookEvent(payload []byte, header map[string][]string) (*core.BillingEvent, error) {
+	event, ok := p.events[string(payload)]
+	if !ok {
+		return nil, core.ErrValidation
+	}
+	return event, nil
+}
+
+type stubInvoiceRepo struct {
+	invoices map[string]core.Invoice
+}
+
+func (s *stubInvoiceRepo) UpsertInvoice(ctx context.Context, invoice core.Invoice) (*core.Invoice, error) {
+	if s.invoices == nil {
+		s.invoices = make(map[string]core.Invoice)
+	}
+	s.invoices[invoice.ExternalID] = invoice
+	return &invoice, nil
+}
+
+func (s *stubInvoiceRepo) ListInvoices(ctx context.Context, userID string) ([]core.Invoice, error) {
+	var invoices []core.Invoice
+	for _, invoice := range s.invoices {
+		if invoice.UserID == userID {
+			invoices = append(invoices, invoice)
+		}
+	}
+	return invoices, nil
+}
+
+func TestBillingService_CreateCheckoutSession(t *testing.T) {
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	paid := core.Plan{ID: uuid.New(), Code: "monthly", PriceCents: 999, Currency: "USD", Interval: core.BillingIntervalMonth, Active: true}
+	free := core.Plan{ID: uuid.New(), Code: "free", Interval: core.BillingIntervalMonth, Active: true}
+
+	subscriptions := newStubSubscriptionRepo(paid, free)
+	subscriptions.subscriptions = []core.Subscription{
+		{ID: uuid.New(), UserID: "subscriber", Status: core.SubscriptionStatusActive, CurrentPeriodEnd: now.Add(time.Hour)},
+	}
+	provider := &stubBillingProvider{}
+	service := NewBillingService(subscriptions, &stubInvoiceRepo{}, provider)
+	service.WithClock(func() time.Time { return now })
+
+	tests := []struct {
+		name    string
+		userID  string
+		planID  uuid.UUID
+		wantErr error
+	}{
+		{name: "paid plan", userID: "learner", planID: paid.ID},
+		{name: "free plan", userID: "learner", planID: free.ID, wantErr: core.ErrInvalidState},
+		{name: "already subscribed", userID: "subscriber", planID: paid.ID, wantErr: core.ErrInvalidState},
+		{name: "unknown plan", userID: "learner", planID: uuid.New(), wantErr: core.ErrNotFound},
+		{name: "missing user", planID: paid.ID, wantErr: core.ErrValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session, err := service.CreateCheckoutSession(context.Background(), tt.userID, tt.planID, "https://app/ok", "https://app/cancel")
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("CreateCheckoutSession() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateCheckoutSession() error = %v", err)
+			}
+			if session.URL == "" {
+				t.Fatalf("session URL is empty")
+			}
+		})
+	}
+
+	if len(provider.checkout) != 1 || provider.checkout[0].Plan.ID != paid.ID {
+		t.Fatalf("provider checkouts = %#v, want one for the paid plan", provider.checkout)
+	}
+
+	unconfigured := NewBillingService(subscriptions, &stubInvoiceRepo{}, nil)
+	if _, err := unconfigured.CreateCheckoutSession(context.Background(), "learner", paid.ID, "https://app/ok", "https://app/cancel"); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("CreateCheckoutSession() without provider error = %v, want ErrInvalidState", err)
+	}
+}
+
+func TestBillingService_HandleWebhook(t *testing.T) {
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	plan := core.Plan{ID: uuid.New(), Code: "monthly", PriceCents: 999, Currency: "USD", Interval: core.BillingIntervalMonth, Active: true}
+	renewedEnd := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	provider := &stubBillingProvider{events: map[string]*core.BillingEvent{
+		"early-update": {Type: core.BillingEventSubscriptionUpdated, ExternalSubscriptionID: "sub_1"},
+		"checkout":     {Type: core.BillingEventCheckoutCompleted, ExternalSubscriptionID: "sub_1", UserID: "learner", PlanID: plan.ID},
+		"renewal": {
+			Type:                   core.BillingEventInvoiceUpdated,
+			ExternalSubscriptionID: "sub_1",
+			Invoice: &core.Invoice{
+				ExternalID:  "in_1",
+				AmountCents: 999,
+				Currency:    "USD",
+				Status:      core.InvoiceStatusPaid,
+				PeriodStart: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+				PeriodEnd:   renewedEnd,
+			},
+		},
+		"cancel-at-end": {Type: core.BillingEventSubscriptionUpdated, ExternalSubscriptionID: "sub_1", CancelAtPeriodEnd: true},
+		"deleted":       {Type: core.BillingEventSubscriptionDeleted, ExternalSubscriptionID: "sub_1"},
+		"ignored":       {Type: core.BillingEventUnspecified},
+	}}
+	subscriptions := newStubSubscriptionRepo(plan)
+	invoices := &stubInvoiceRepo{}
+	service := NewBillingService(subscriptions, invoices, provider)
+	service.WithClock(func() time.Time { return now })
+	ctx := context.Background()
+
+	deliver := func(payload string) error {
+		return service.HandleWebhook(ctx, "stub", []byte(payload), nil)
+	}
+
+	if err := deliver("ignored"); err != nil {
+		t.Fatalf("ignored event error = %v", err)
+	}
+	if err := service.HandleWebhook(ctx, "other", []byte("checkout"), nil); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("unknown provider error = %v, want ErrNotFound", err)
+	}
+	if err := deliver("early-update"); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("update before checkout error = %v, want ErrNotFound so the provider retries", err)
+	}
+
+	for range 2 {
+		if err := deliver("checkout"); err != nil {
+			t.Fatalf("checkout event error = %v", err)
+		}
+	}
+	if len(subscriptions.subscriptions) != 1 {
+		t.Fatalf("subscriptions = %d, want 1 after redelivery", len(subscriptions.subscriptions))
+	}
+	subscription := subscriptions.subscriptions[0]
+	if subscription.Status != core.SubscriptionStatusActive || subscription.BillingProvider != "stub" {
+		t.Fatalf("subscription = %#v, want active and billed by stub", subscription)
+	}
+	if want := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC); !subscription.CurrentPeriodEnd.Equal(want) {
+		t.Fatalf("period end = %v, want %v", subscription.CurrentPeriodEnd, want)
+	}
+
+	if err := deliver("renewal"); err != nil {
+		t.Fatalf("invoice event error = %v", err)
+	}
+	invoice := invoices.invoices["in_1"]
+	if invoice.UserID != "learner" || invoice.SubscriptionID != subscription.ID {
+		t.Fatalf("invoice = %#v, want attributed to the subscription", invoice)
+	}
+	if got := subscriptions.subscriptions[0].CurrentPeriodEnd; !got.Equal(renewedEnd) {
+		t.Fatalf("period end after renewal = %v, want %v", got, renewedEnd)
+	}
+
+	if err := deliver("cancel-at-end"); err != nil {
+		t.Fatalf("cancel event error = %v", err)
+	}
+	if got := subscriptions.subscriptions[0]; got.Status != core.SubscriptionStatusCanceled || !got.Entitled(now) {
+		t.Fatalf("subscription after cancel = %#v, want canceled but entitled", got)
+	}
+
+	if err := deliver("deleted"); err != nil {
+		t.Fatalf("deleted event error = %v", err)
+	}
+	if got := subscriptions.subscriptions[0]; got.Status != core.SubscriptionStatusExpired || got.Entitled(now) {
+		t.Fatalf("subscription after delete = %#v, want expired", got)
+	}
+}
@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	minSlotDuration         = 15 * time.Minute
+	maxSlotDuration         = 4 * time.Hour
+	maxSlotsPerRequest      = 100
+	defaultAvailabilityDays = 14
+	maxAvailabilityDays     = 90
+	maxBookingNoteLength    = 1000
+)
+
+// BookingService lets teachers publish availability slots and learners book
+// them for one-to-one sessions. Double bookings are rejected by the
+// repository, which checks for conflicts in the same write.
+type BookingService struct {
+	repo core.BookingRepository
+	now  func() time.Time
+}
+
+// NewBookingService constructs a booking service using the supplied repository.
+func NewBookingService(repo core.BookingRepository) *BookingService {
+	return &BookingService{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *BookingService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.BookingService = (*BookingService)(nil)
+
+// AddAvailability offers a slot for every range. Ranges must lie in the
+// future and not overlap each other or the teacher's existing slots.
+func (s *BookingService) AddAvailability(ctx context.Context, teacherID string, ranges []core.TimeRange) ([]core.AvailabilitySlot, error) {
+	teacherID = strings.TrimSpace(teacherID)
+	switch {
+	case teacherID == "":
+		return nil, fmt.Errorf("%w: teacher id is required", core.ErrValidation)
+	case len(ranges) == 0:
+		return nil, fmt.Errorf("%w: at least one time range is required", core.ErrValidation)
+	case len(ranges) > maxSlotsPerRequest:
+		return nil, fmt.Errorf("%w: at most %d slots can be added at once", core.ErrValidation, maxSlotsPerRequest)
+	}
+
+	now := s.now().UTC()
+	ranges = lo.Map(ranges, func(r core.TimeRange, _ int) core.TimeRange {
+		return core.TimeRange{StartsAt: r.StartsAt.UTC(), EndsAt: r.EndsAt.UTC()}
+	})
+	slices.SortFunc(ranges, func(a, b core.TimeRange) int { return a.StartsAt.Compare(b.StartsAt) })
+	for i, r := range ranges {
+		duration := r.EndsAt.Sub(r.StartsAt)
+		switch {
+		case r.StartsAt.IsZero() || r.EndsAt.IsZero():
+			return nil, fmt.Errorf("%w: slots need a start and an end time", core.ErrValidation)
+		case duration < minSlotDuration || duration > maxSlotDuration:
+			return nil, fmt.Errorf("%w: slots must last between %s and %s", core.ErrValidation, minSlotDuration, maxSlotDuration)
+		case !r.StartsAt.After(now):
+			return nil, fmt.Errorf("%w: slots must start in the future", core.ErrValidation)
+		case i > 0 && r.Overlaps(ranges[i-1]):
+			return nil, fmt.Errorf("%w: slots must not overlap each other", core.ErrValidation)
+		}
+	}
+
+	return s.repo.CreateAvailabilitySlots(ctx, lo.Map(ranges, func(r core.TimeRange, _ int) core.AvailabilitySlot {
+		return core.AvailabilitySlot{
+			ID:        uuid.New(),
+			TeacherID: teacherID,
+			StartsAt:  r.StartsAt,
+			EndsAt:    r.EndsAt,
+		}
+	}))
+}
+
+// RemoveAvailability withdraws one of the teacher's slots. Booked slots
+// must have their booking cancelled first.
+func (s *BookingService) RemoveAvailability(ctx context.Context, teacherID string, slotID uuid.UUID) error {
+	teacherID = strings.TrimSpace(teacherID)
+	if teacherID == "" || slotID == uuid.Nil {
+		return fmt.Errorf("%w: teacher id and slot id required", core.ErrValidation)
+	}
+	slot, err := s.repo.GetAvailabilitySlot(ctx, slotID)
+	if err != nil {
+		return err
+	}
+	if slot.TeacherID != teacherID {
+		return fmt.Errorf("%w: slot belongs to another teacher", core.ErrPermissionDenied)
+	}
+	return s.repo.DeleteAvailabilitySlot(ctx, slotID)
+}
+
+// ListAvailability returns slots starting in the filter's range, earliest
+// first. The range defaults to the next two weeks.
+func (s *BookingService) ListAvailability(ctx context.Context, filter core.AvailabilityFilter) ([]core.AvailabilitySlot, error) {
+	filter.TeacherID = strings.TrimSpace(filter.TeacherID)
+	if filter.From.IsZero() {
+		filter.From = s.now()
+	}
+	if filter.To.IsZero() {
+		filter.To = filter.From.AddDate(0, 0, defaultAvailabilityDays)
+	}
+	filter.From, filter.To = filter.From.UTC(), filter.To.UTC()
+	switch {
+	case !filter.To.After(filter.From):
+		return nil, fmt.Errorf("%w: to must be after from", core.ErrValidation)
+	case filter.To.Sub(filter.From) > maxAvailabilityDays*24*time.Hour:
+		return nil, fmt.Errorf("%w: availability spans at most %d days", core.ErrValidation, maxAvailabilityDays)
+	}
+	return s.repo.ListAvailabilitySlots(ctx, filter)
+}
+
+// BookSlot reserves a slot that has not started yet for a learner.
+func (s *BookingService) BookSlot(ctx context.Context, slotID uuid.UUID, learnerID, note string) (*core.Booking, error) {
+	learnerID = strings.TrimSpace(learnerID)
+	note = strings.TrimSpace(note)
+	switch {
+	case slotID == uuid.Nil:
+		return nil, fmt.Errorf("%w: slot id required", core.ErrValidation)
+	case learnerID == "":
+		return nil, fmt.Errorf("%w: learner id is required", core.ErrValidation)
+	case utf8.RuneCountInString(note) > maxBookingNoteLength:
+		return nil, fmt.Errorf("%w: notes are at most %d characters", core.ErrValidation, maxBookingNoteLength)
+	}
+
+	slot, err := s.repo.GetAvailabilitySlot(ctx, slotID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now().UTC()
+	switch {
+	case slot.TeacherID == learnerID:
+		return nil, fmt.Errorf("%w: teachers cannot book their own slots", core.ErrValidation)
+	case !slot.StartsAt.After(now):
+		return nil, fmt.Errorf("%w: slot has already started", core.ErrInvalidState)
+	case slot.Booked:
+		return nil, fmt.Errorf("%w: slot is already booked", core.ErrAlreadyExists)
+	}
+
+	return s.repo.CreateBooking(ctx, core.Booking{
+		ID:        uuid.New(),
+		SlotID:    slot.ID,
+		TeacherID: slot.TeacherID,
+		LearnerID: learnerID,
+		StartsAt:  slot.StartsAt,
+		EndsAt:    slot.EndsAt,
+		Note:      note,
+		Status:    core.BookingStatusConfirmed,
+	})
+}
+
+// GetBooking retrieves a booking by identifier.
+func (s *BookingService) GetBooking(ctx context.Context, id uuid.UUID) (*core.Booking, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: booking id required", core.ErrValidation)
+	}
+	return s.repo.GetBooking(ctx, id)
+}
+
+// CancelBooking cancels a confirmed booking on behalf of its teacher or
+// learner and offers the slot again. Sessions that have started can no
+// longer be cancelled.
+func (s *BookingService) CancelBooking(ctx context.Context, id uuid.UUID, userID, reason string) (*core.Booking, error) {
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", core.ErrValidation)
+	}
+	booking, err := s.GetBooking(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now().UTC()
+	switch {
+	case userID != booking.TeacherID && userID != booking.LearnerID:
+		return nil, fmt.Errorf("%w: only the teacher or the learner can cancel a booking", core.ErrPermissionDenied)
+	case booking.Status != core.BookingStatusConfirmed:
+		return nil, fmt.Errorf("%w: booking is already cancelled", core.ErrInvalidState)
+	case !booking.StartsAt.After(now):
+		return nil, fmt.Errorf("%w: booking has already started", core.ErrInvalidState)
+	}
+
+	booking.Status = core.BookingStatusCancelled
+	booking.CancelledAt = &now
+	booking.CancelledBy = userID
+	booking.CancelReason = strings.TrimSpace(reason)
+	return s.repo.CancelBooking(ctx, *booking)
+}
+
+// ListBookings returns bookings matching the filter, earliest first.
+func (s *BookingService) ListBookings(ctx context.Context, filter core.BookingListFilter) ([]core.Booking, string, error) {
+	filter.TeacherID = strings.TrimSpace(filter.TeacherID)
+	filter.LearnerID = strings.TrimSpace(filter.LearnerID)
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.To.After(filter.From) {
+		return nil, "", fmt.Errorf("%w: to must be after from", core.ErrValidation)
+	}
+	return s.repo.ListBookings(ctx, filter)
+}
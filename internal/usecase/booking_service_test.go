@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestBookingService_AddAvailability(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	at := func(hours int) time.Time { return now.Add(time.Duration(hours) * time.Hour) }
+
+	tests := []struct {
+		name   string
+		ranges []core.TimeRange
+		want   error
+	}{
+		{name: "valid", ranges: []core.TimeRange{{StartsAt: at(3), EndsAt: at(4)}, {StartsAt: at(1), EndsAt: at(2)}}},
+		{name: "no ranges", want: core.ErrValidation},
+		{name: "too short", ranges: []core.TimeRange{{StartsAt: at(1), EndsAt: at(1).Add(10 * time.Minute)}}, want: core.ErrValidation},
+		{name: "too long", ranges: []core.TimeRange{{StartsAt: at(1), EndsAt: at(6)}}, want: core.ErrValidation},
+		{name: "in the past", ranges: []core.TimeRange{{StartsAt: at(-2), EndsAt: at(-1)}}, want: core.ErrValidation},
+		{name: "overlapping", ranges: []core.TimeRange{{StartsAt: at(1), EndsAt: at(3)}, {StartsAt: at(2), EndsAt: at(4)}}, want: core.ErrValidation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubBookingRepo()
+			service := NewBookingService(repo)
+			service.WithClock(func() time.Time { return now })
+
+			slots, err := service.AddAvailability(ctx, " teacher ", tt.ranges)
+			if tt.want != nil {
+				if !errors.Is(err, tt.want) {
+					t.Fatalf("expected %v, got %v", tt.want, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AddAvailability() error = %v", err)
+			}
+			if len(slots) != 2 || !slots[0].StartsAt.Equal(at(1)) || slots[0].TeacherID != "teacher" {
+				t.Fatalf("expected the slots earliest first, got %#v", slots)
+			}
+		})
+	}
+}
+
+func TestBookingService_BookAndCancel(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	repo := newStubBookingRepo()
+	service := NewBookingService(repo)
+	service.WithClock(func() time.Time { return now })
+
+	slots, err := service.AddAvailability(ctx, "teacher", []core.TimeRange{{StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour)}})
+	if err != nil {
+		t.Fatalf("AddAvailability() error = %v", err)
+	}
+	slotID := slots[0].ID
+
+	if _, err := service.BookSlot(ctx, slotID, "teacher", ""); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected teachers not to book themselves, got %v", err)
+	}
+	booking, err := service.BookSlot(ctx, slotID, "alice", " past tense ")
+	if err != nil {
+		t.Fatalf("BookSlot() error = %v", err)
+	}
+	if booking.Status != core.BookingStatusConfirmed || booking.TeacherID != "teacher" || booking.Note != "past tense" {
+		t.Fatalf("unexpected booking %#v", booking)
+	}
+	if _, err := service.BookSlot(ctx, slotID, "bob", ""); !errors.Is(err, core.ErrAlreadyExists) {
+		t.Fatalf("expected the slot to be taken, got %v", err)
+	}
+	if err := service.RemoveAvailability(ctx, "other", slotID); !errors.Is(err, core.ErrPermissionDenied) {
+		t.Fatalf("expected another teacher to be denied, got %v", err)
+	}
+
+	if _, err := service.CancelBooking(ctx, booking.ID, "bob", ""); !errors.Is(err, core.ErrPermissionDenied) {
+		t.Fatalf("expected a stranger to be denied, got %v", err)
+	}
+	cancelled, err := service.CancelBooking(ctx, booking.ID, "teacher", "travelling")
+	if err != nil {
+		t.Fatalf("CancelBooking() error = %v", err)
+	}
+	if cancelled.Status != core.BookingStatusCancelled || cancelled.CancelledBy != "teacher" || cancelled.CancelledAt == nil {
+		t.Fatalf("unexpected cancelled booking %#v", cancelled)
+	}
+	if _, err := service.CancelBooking(ctx, booking.ID, "alice", ""); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected a second cancellation to fail, got %v", err)
+	}
+
+	service.WithClock(func() time.Time { return now.Add(90 * time.Minute) })
+	if _, err := service.BookSlot(ctx, slotID, "bob", ""); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected a started slot not to be bookable, got %v", err)
+	}
+}
+
+func TestBookingService_ListAvailability(t *testing.T) {
+	now := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	repo := newStubBookingRepo()
+	service := NewBookingService(repo)
+	service.WithClock(func() time.Time { return now })
+
+	if _, err := service.ListAvailability(context.Background(), core.AvailabilityFilter{}); err != nil {
+		t.Fatalf("ListAvailability() error = %v", err)
+	}
+	if !repo.lastFilter.From.Equal(now) || !repo.lastFilter.To.Equal(now.AddDate(0, 0, defaultAvailabilityDays)) {
+		t.Fatalf("expected the default window, got %v to %v", repo.lastFilter.From, repo.lastFilter.To)
+	}
+	if _, err := service.ListAvailability(context.Background(), core.AvailabilityFilter{From: now, To: now.AddDate(0, 0, 120)}); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected a long window to be rejected, got %v", err)
+	}
+}
+
+// stubBookingRepo keeps slots and bookings in memory.
+type stubBookingRepo struct {
+	slots      map[uuid.UUID]core.AvailabilitySlot
+	bookings   map[uuid.UUID]core.Booking
+	lastFilter core.AvailabilityFilter
+}
+
+func newStubBookingRepo() *stubBookingRepo {
+	return &stubBookingRepo{
+		slots:    map[uuid.UUID]core.AvailabilitySlot{},
+		bookings: map[uuid.UUID]core.Booking{},
+	}
+}
+
+func (s *stubBookingRepo) CreateAvailabilitySlots(ctx context.Context, slots []core.AvailabilitySlot) ([]core.AvailabilitySlot, error) {
+	for _, slot := range slots {
+		s.slots[slot.ID] = slot
+	}
+	return slots, nil
+}
+
+func (s *stubBookingRepo) GetAvailabilitySlot(ctx context.Context, id uuid.UUID) (*core.AvailabilitySlot, error) {
+	slot, ok := s.slots[id]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	return &slot, nil
+}
+
+func (s *stubBookingRepo) DeleteAvailabilitySlot(ctx context.Context, id uuid.UUID) error {
+	delete(s.slots, id)
+	return nil
+}
+
+func (s *stubBookingRepo) ListAvailabilitySlots(ctx context.Context, filter core.AvailabilityFilter) ([]core.AvailabilitySlot, error) {
+	s.lastFilter = filter
+	return nil, nil
+}
+
+func (s *stubBookingRepo) CreateBooking(ctx context.Context, booking core.Booking) (*core.Booking, error) {
+	slot := s.slots[booking.SlotID]
+	if slot.Booked {
+		return nil, core.ErrAlreadyExists
+	}
+	slot.Booked = true
+	s.slots[slot.ID] = slot
+	s.bookings[booking.ID] = booking
+	return &booking, nil
+}
+
+func (s *stubBookingRepo) GetBooking(ctx context.Context, id uuid.UUID) (*core.Booking, error) {
+	booking, ok := s.bookings[id]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	return &booking, nil
+}
+
+func (s *stubBookingRepo) CancelBooking(ctx context.Context, booking core.Booking) (*core.Booking, error) {
+	slot := s.slots[booking.SlotID]
+	slot.Booked = false
+	s.slots[slot.ID] = slot
+	s.bookings[booking.ID] = booking
+	return &booking, nil
+}
+
+func (s *stubBookingRepo) ListBookings(ctx context.Context, filter core.BookingListFilter) ([]core.Booking, string, error) {
+	return nil, "", nil
+}
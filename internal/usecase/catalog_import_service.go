@@ -0,0 +1,355 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// maxImportRows bounds the data rows of one import.
+const maxImportRows = 10000
+
+// Columns of an import spreadsheet. Only series_slug is required in the
+// header; every row names its series by slug and rows with episode columns
+// add an episode to it.
+const (
+	importColumnSeriesSlug         = "series_slug"
+	importColumnSeriesTitle        = "series_title"
+	importColumnSeriesSummary      = "series_summary"
+	importColumnSeriesLanguage     = "series_language"
+	importColumnSeriesLevel        = "series_level"
+	importColumnSeriesTags         = "series_tags"
+	importColumnSeriesCoverURL     = "series_cover_url"
+	importColumnEpisodeSeq         = "episode_seq"
+	importColumnEpisodeTitle       = "episode_title"
+	importColumnEpisodeDescription = "episode_description"
+	importColumnEpisodeDuration    = "episode_duration"
+	importColumnEpisodePreview     = "episode_preview"
+	importColumnMediaURL           = "media_url"
+	importColumnMediaType          = "media_type"
+	importColumnMimeType           = "mime_type"
+)
+
+var importColumns = []string{
+	importColumnSeriesSlug, importColumnSeriesTitle, importColumnSeriesSummary,
+	importColumnSeriesLanguage, importColumnSeriesLevel, importColumnSeriesTags,
+	importColumnSeriesCoverURL, importColumnEpisodeSeq, importColumnEpisodeTitle,
+	importColumnEpisodeDescription, importColumnEpisodeDuration, importColumnEpisodePreview,
+	importColumnMediaURL, importColumnMediaType, importColumnMimeType,
+}
+
+var (
+	importEpisodeColumns = []string{
+		importColumnEpisodeSeq, importColumnEpisodeTitle, importColumnEpisodeDescription,
+		importColumnEpisodeDuration, importColumnEpisodePreview, importColumnMediaURL,
+		importColumnMediaType, importColumnMimeType,
+	}
+	importLanguagePattern = regexp.MustCompile(`^[a-zA-Z]{2}$`)
+	// importClockDuration matches durations written as mm:ss or hh:mm:ss.
+	importClockDuration = regexp.MustCompile(`^(?:(\d+):)?(\d{1,2}):(\d{2})$`)
+)
+
+// CatalogImportService creates draft series and episodes from spreadsheets.
+// Every row is validated before anything is written, and each series is then
+// created with its episodes in one transaction.
+type CatalogImportService struct {
+	reader core.SpreadsheetReader
+	series core.SeriesService
+	repo   core.SeriesRepository
+}
+
+// NewCatalogImportService constructs an import service creating series
+// through the series service.
+func NewCatalogImportService(reader core.SpreadsheetReader, series core.SeriesService, repo core.SeriesRepository) *CatalogImportService {
+	return &CatalogImportService{reader: reader, series: series, repo: repo}
+}
+
+var _ core.CatalogImportService = (*CatalogImportService)(nil)
+
+// importedSeries is a series of the spreadsheet and the row it starts at.
+type importedSeries struct {
+	row   int
+	draft core.SeriesDraft
+}
+
+// ImportCatalog validates a spreadsheet and, unless it is a dry run or a row
+// has errors, creates its series in spreadsheet order. Creation stops at the
+// first series that fails; the series created before it are reported.
+func (s *CatalogImportService) ImportCatalog(ctx context.Context, req core.CatalogImportRequest) (*core.CatalogImportReport, error) {
+	rows, err := s.reader.ReadRows(req.Format, req.Data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: spreadsheet is empty", core.ErrValidation)
+	}
+	if len(rows)-1 > maxImportRows {
+		return nil, fmt.Errorf("%w: at most %d rows can be imported at once", core.ErrValidation, maxImportRows)
+	}
+	columns, err := importHeader(rows[0])
+	if err != nil {
+		return nil, err
+	}
+
+	report := &core.CatalogImportReport{Rows: len(rows) - 1}
+	series, err := s.parseRows(ctx, rows[1:], columns, req.AuthorIDs, report)
+	if err != nil {
+		return nil, err
+	}
+	report.SeriesCount = len(series)
+	for _, item := range series {
+		report.EpisodeCount += len(item.draft.Episodes)
+	}
+	if len(report.Errors) > 0 || req.DryRun {
+		return report, nil
+	}
+
+	for _, item := range series {
+		created, err := s.series.CreateSeries(ctx, item.draft)
+		if err != nil {
+			if !errors.Is(err, core.ErrValidation) && !errors.Is(err, core.ErrAlreadyExists) {
+				return nil, err
+			}
+			report.Errors = append(report.Errors, core.CatalogImportRowError{Row: item.row, Message: err.Error()})
+			return report, nil
+		}
+		report.CreatedSeriesIDs = append(report.CreatedSeriesIDs, created.ID)
+	}
+	return report, nil
+}
+
+// importHeader maps the known columns of the header row to their index.
+func importHeader(header []string) (map[string]int, error) {
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !lo.Contains(importColumns, name) {
+			return nil, fmt.Errorf("%w: unknown column %q; columns are %s", core.ErrValidation, name, strings.Join(importColumns, ", "))
+		}
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("%w: duplicate column %q", core.ErrValidation, name)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns[importColumnSeriesSlug]; !ok {
+		return nil, fmt.Errorf("%w: column %q is required", core.ErrValidation, importColumnSeriesSlug)
+	}
+	return columns, nil
+}
+
+// parseRows groups the rows by series, recording every problem in report.
+func (s *CatalogImportService) parseRows(ctx context.Context, rows [][]string, columns map[string]int, authorIDs []string, report *core.CatalogImportReport) ([]*importedSeries, error) {
+	var ordered []*importedSeries
+	bySlug := map[string]*importedSeries{}
+	for i, cells := range rows {
+		row := i + 2
+		cell := func(column string) string {
+			index, ok := columns[column]
+			if !ok || index >= len(cells) {
+				return ""
+			}
+			return strings.TrimSpace(cells[index])
+		}
+		fail := func(column, format string, args ...any) {
+			report.Errors = append(report.Errors, core.CatalogImportRowError{Row: row, Column: column, Message: fmt.Sprintf(format, args...)})
+		}
+		if strings.TrimSpace(strings.Join(cells, "")) == "" {
+			continue
+		}
+
+		slug := cell(importColumnSeriesSlug)
+		if slug == "" {
+			fail(importColumnSeriesSlug, "series slug is required")
+			continue
+		}
+		series, seen := bySlug[slug]
+		if !seen {
+			series = &importedSeries{row: row, draft: core.SeriesDraft{Slug: slug, Status: core.SeriesStatusDraft, AuthorIDs: authorIDs}}
+			bySlug[slug] = series
+			ordered = append(ordered, series)
+			if utf8.RuneCountInString(slug) > 128 {
+				fail(importColumnSeriesSlug, "series slug is longer than 128 characters")
+			} else if _, err := s.repo.GetSeriesBySlug(ctx, slug, core.SeriesQueryOptions{}); err == nil {
+				fail(importColumnSeriesSlug, "series %q already exists", slug)
+			} else if !errors.Is(err, core.ErrNotFound) {
+				return nil, err
+			}
+		}
+		importSeriesFields(&series.draft, cell, fail)
+		if !seen && series.draft.Title == "" {
+			fail(importColumnSeriesTitle, "series title is required on the first row of a series")
+		}
+
+		if lo.SomeBy(importEpisodeColumns, func(column string) bool { return cell(column) != "" }) {
+			// Episodes with errors are kept so later rows are checked against them.
+			series.draft.Episodes = append(series.draft.Episodes, importEpisode(series, cell, fail))
+		}
+	}
+	return ordered, nil
+}
+
+// importSeriesFields sets the series fields of a row on draft. Rows after the
+// first may repeat them but not change them.
+func importSeriesFields(draft *core.SeriesDraft, cell func(string) string, fail func(string, string, ...any)) {
+	set := func(column string, field *string, validate func(string) string) {
+		value := cell(column)
+		if value == "" {
+			return
+		}
+		if *field != "" {
+			if *field != value {
+				fail(column, "conflicts with an earlier row of series %q", draft.Slug)
+			}
+			return
+		}
+		if problem := validate(value); problem != "" {
+			fail(column, "%s", problem)
+			return
+		}
+		*field = value
+	}
+	maxLength := func(limit int) func(string) string {
+		return func(value string) string {
+			if utf8.RuneCountInString(value) > limit {
+				return fmt.Sprintf("longer than %d characters", limit)
+			}
+			return ""
+		}
+	}
+
+	set(importColumnSeriesTitle, &draft.Title, maxLength(256))
+	set(importColumnSeriesSummary, &draft.Summary, maxLength(1024))
+	set(importColumnSeriesLevel, &draft.Level, maxLength(64))
+	set(importColumnSeriesLanguage, &draft.Language, func(value string) string {
+		if !importLanguagePattern.MatchString(value) {
+			return "language must be a two-letter ISO 639-1 code"
+		}
+		return ""
+	})
+	set(importColumnSeriesCoverURL, &draft.CoverURL, importURLProblem)
+
+	if raw := cell(importColumnSeriesTags); raw != "" {
+		tags := lo.Uniq(lo.Compact(lo.Map(strings.FieldsFunc(raw, func(r rune) bool { return r == ',' || r == ';' }), func(tag string, _ int) string {
+			return strings.TrimSpace(tag)
+		})))
+		switch {
+		case len(draft.Tags) > 0 && !slices.Equal(draft.Tags, tags):
+			fail(importColumnSeriesTags, "conflicts with an earlier row of series %q", draft.Slug)
+		case lo.SomeBy(tags, func(tag string) bool { return utf8.RuneCountInString(tag) > 64 }):
+			fail(importColumnSeriesTags, "tags must be at most 64 characters")
+		default:
+			draft.Tags = tags
+		}
+	}
+}
+
+// importEpisode parses the episode columns of a row.
+func importEpisode(series *importedSeries, cell func(string) string, fail func(string, string, ...any)) core.EpisodeDraft {
+	episode := core.EpisodeDraft{
+		Title:       cell(importColumnEpisodeTitle),
+		Description: cell(importColumnEpisodeDescription),
+		Status:      core.EpisodeStatusDraft,
+	}
+	switch length := utf8.RuneCountInString(episode.Title); {
+	case length == 0:
+		fail(importColumnEpisodeTitle, "episode title is required")
+	case length > 256:
+		fail(importColumnEpisodeTitle, "longer than 256 characters")
+	}
+	if utf8.RuneCountInString(episode.Description) > 2048 {
+		fail(importColumnEpisodeDescription, "longer than 2048 characters")
+	}
+
+	if raw := cell(importColumnEpisodeSeq); raw != "" {
+		seq, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil || seq == 0 {
+			fail(importColumnEpisodeSeq, "episode seq must be a positive integer")
+		}
+		episode.Seq = uint32(seq)
+	} else {
+		// Unnumbered episodes follow the highest seq of the series so far.
+		episode.Seq = lo.Max(lo.Map(series.draft.Episodes, func(e core.EpisodeDraft, _ int) uint32 { return e.Seq })) + 1
+	}
+	if lo.ContainsBy(series.draft.Episodes, func(e core.EpisodeDraft) bool { return e.Seq == episode.Seq }) {
+		fail(importColumnEpisodeSeq, "episode seq %d is used by an earlier row of series %q", episode.Seq, series.draft.Slug)
+	}
+
+	if raw := cell(importColumnEpisodeDuration); raw != "" {
+		duration, err := parseImportDuration(raw)
+		if err != nil {
+			fail(importColumnEpisodeDuration, "duration must be seconds, mm:ss, hh:mm:ss or a duration such as 5m30s")
+		}
+		episode.Duration = duration
+	}
+	if raw := cell(importColumnEpisodePreview); raw != "" {
+		preview, err := strconv.ParseBool(strings.ToLower(raw))
+		if err != nil {
+			fail(importColumnEpisodePreview, "preview must be true or false")
+		}
+		episode.Preview = preview
+	}
+
+	mediaURL, mediaType, mimeType := cell(importColumnMediaURL), cell(importColumnMediaType), cell(importColumnMimeType)
+	if mediaURL != "" || mediaType != "" || mimeType != "" {
+		resource := &core.MediaResource{PlaybackURL: mediaURL, MimeType: mimeType}
+		if mediaURL == "" {
+			fail(importColumnMediaURL, "media url is required with a media or mime type")
+		} else if message := importURLProblem(mediaURL); message != "" {
+			fail(importColumnMediaURL, "%s", message)
+		}
+		switch {
+		case strings.EqualFold(mediaType, "audio"), mediaType == "" && strings.HasPrefix(mimeType, "audio/"):
+			resource.Type = core.MediaTypeAudio
+		case strings.EqualFold(mediaType, "video"), mediaType == "" && strings.HasPrefix(mimeType, "video/"):
+			resource.Type = core.MediaTypeVideo
+		default:
+			fail(importColumnMediaType, "media type must be audio or video")
+		}
+		episode.Resource = resource
+	}
+	return episode
+}
+
+// parseImportDuration accepts whole seconds, mm:ss, hh:mm:ss and Go
+// durations such as 5m30s.
+func parseImportDuration(raw string) (time.Duration, error) {
+	if seconds, err := strconv.ParseUint(raw, 10, 32); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	if match := importClockDuration.FindStringSubmatch(raw); match != nil {
+		hours, _ := strconv.Atoi(lo.CoalesceOrEmpty(match[1], "0"))
+		minutes, _ := strconv.Atoi(match[2])
+		seconds, _ := strconv.Atoi(match[3])
+		if seconds >= 60 || (match[1] != "" && minutes >= 60) {
+			return 0, fmt.Errorf("invalid duration %q", raw)
+		}
+		return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second, nil
+	}
+	duration, err := time.ParseDuration(raw)
+	if err != nil || duration < 0 {
+		return 0, fmt.Errorf("invalid duration %q", raw)
+	}
+	return duration, nil
+}
+
+func importURLProblem(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "must be an absolute http or https URL"
+	}
+	if len(raw) > 2048 {
+		return "longer than 2048 characters"
+	}
+	return ""
+}
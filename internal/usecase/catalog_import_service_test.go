@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubSpreadsheetReader struct {
+	rows [][]string
+}
+
+func (s stubSpreadsheetReader) ReadRows(core.SpreadsheetFormat, []byte) ([][]string, error) {
+	return s.rows, nil
+}
+
+func newTestCatalogImportService(rows [][]string, repo *stubSeriesRepo) *CatalogImportService {
+	return NewCatalogImportService(stubSpreadsheetReader{rows: rows}, NewSeriesService(repo), repo)
+}
+
+func TestCatalogImportService_ImportCatalog(t *testing.T) {
+	var created []core.Series
+	repo := &stubSeriesRepo{
+		createSeriesFn: func(ctx context.Context, series core.Series) (*core.Series, error) {
+			created = append(created, series)
+			return &series, nil
+		},
+	}
+	rows := [][]string{
+		{"series_slug", "series_title", "series_tags", "episode_seq", "episode_title", "episode_duration", "media_url", "mime_type"},
+		{"basics", "Basics", "grammar; beginner", "", "Greetings", "1:30", "https://cdn.example.com/1.mp3", "audio/mpeg"},
+		{"basics", "Basics", "", "", "Numbers", "90", "", ""},
+		{"travel", "Travel", "", "5", "At the airport", "", "https://cdn.example.com/t.mp4", "video/mp4"},
+		{"basics", "", "", "", "Colours", "2m", "", ""},
+		{"", "", "", "", "", "", "", ""},
+	}
+
+	service := newTestCatalogImportService(rows, repo)
+	report, err := service.ImportCatalog(context.Background(), core.CatalogImportRequest{Format: core.SpreadsheetFormatCSV, AuthorIDs: []string{"author-1"}})
+	if err != nil {
+		t.Fatalf("ImportCatalog() error = %v", err)
+	}
+	if len(report.Errors) != 0 {
+		t.Fatalf("unexpected errors %+v", report.Errors)
+	}
+	if report.Rows != 5 || report.SeriesCount != 2 || report.EpisodeCount != 4 {
+		t.Fatalf("unexpected counts %+v", report)
+	}
+	if len(created) != 2 || len(report.CreatedSeriesIDs) != 2 || report.CreatedSeriesIDs[0] != created[0].ID {
+		t.Fatalf("expected both series created in order, got %+v", report.CreatedSeriesIDs)
+	}
+
+	basics := created[0]
+	if basics.Slug != "basics" || basics.Status != core.SeriesStatusDraft || len(basics.Tags) != 2 || basics.AuthorIDs[0] != "author-1" {
+		t.Fatalf("unexpected series %+v", basics)
+	}
+	if len(basics.Episodes) != 3 {
+		t.Fatalf("expected 3 episodes, got %d", len(basics.Episodes))
+	}
+	for i, want := range []struct {
+		seq      uint32
+		duration time.Duration
+	}{{1, 90 * time.Second}, {2, 90 * time.Second}, {3, 2 * time.Minute}} {
+		episode := basics.Episodes[i]
+		if episode.Seq != want.seq || episode.Duration != want.duration || episode.Status != core.EpisodeStatusDraft {
+			t.Fatalf("episode %d = %+v", i, episode)
+		}
+	}
+	if basics.Episodes[0].Resource.Type != core.MediaTypeAudio || basics.Episodes[0].Resource.PlaybackURL != "https://cdn.example.com/1.mp3" {
+		t.Fatalf("unexpected resource %+v", basics.Episodes[0].Resource)
+	}
+	if travel := created[1]; travel.Episodes[0].Seq != 5 || travel.Episodes[0].Resource.Type != core.MediaTypeVideo {
+		t.Fatalf("unexpected travel episode %+v", travel.Episodes[0])
+	}
+}
+
+func TestCatalogImportService_ImportCatalogRowErrors(t *testing.T) {
+	repo := &stubSeriesRepo{
+		getBySlugFn: func(ctx context.Context, slug string, opts core.SeriesQueryOptions) (*core.Series, error) {
+			if slug == "existing" {
+				return &core.Series{ID: uuid.New(), Slug: slug}, nil
+			}
+			return nil, core.ErrNotFound
+		},
+		createSeriesFn: func(ctx context.Context, series core.Series) (*core.Series, error) {
+			t.Fatal("nothing should be created when rows have errors")
+			return nil, nil
+		},
+	}
+	rows := [][]string{
+		{"series_slug", "series_title", "episode_seq", "episode_title", "episode_duration", "media_type"},
+		{"existing", "Existing", "", "", "", ""},
+		{"untitled", "", "1", "One", "", ""},
+		{"basics", "Basics", "1", "One", "soon", ""},
+		{"basics", "Other title", "1", "Again", "", ""},
+		{"", "Orphan", "", "", "", ""},
+		{"basics", "", "2", "Two", "", "podcast"},
+	}
+
+	service := newTestCatalogImportService(rows, repo)
+	report, err := service.ImportCatalog(context.Background(), core.CatalogImportRequest{Format: core.SpreadsheetFormatCSV})
+	if err != nil {
+		t.Fatalf("ImportCatalog() error = %v", err)
+	}
+
+	want := []core.CatalogImportRowError{
+		{Row: 2, Column: "series_slug"},
+		{Row: 3, Column: "series_title"},
+		{Row: 4, Column: "episode_duration"},
+		{Row: 5, Column: "series_title"},
+		{Row: 5, Column: "episode_seq"},
+		{Row: 6, Column: "series_slug"},
+		{Row: 7, Column: "media_url"},
+		{Row: 7, Column: "media_type"},
+	}
+	if len(report.Errors) != len(want) {
+		t.Fatalf("expected %d errors, got %+v", len(want), report.Errors)
+	}
+	for i, w := range want {
+		if got := report.Errors[i]; got.Row != w.Row || got.Column != w.Column || got.Message == "" {
+			t.Fatalf("error %d = %+v, want row %d column %s", i, got, w.Row, w.Column)
+		}
+	}
+	if len(report.CreatedSeriesIDs) != 0 {
+		t.Fatalf("expected nothing created, got %v", report.CreatedSeriesIDs)
+	}
+}
+
+func TestCatalogImportService_ImportCatalogDryRun(t *testing.T) {
+	repo := &stubSeriesRepo{
+		createSeriesFn: func(ctx context.Context, series core.Series) (*core.Series, error) {
+			t.Fatal("dry runs should not create series")
+			return nil, nil
+		},
+	}
+	rows := [][]string{
+		{"series_slug", "series_title", "episode_title"},
+		{"basics", "Basics", "One"},
+	}
+
+	service := newTestCatalogImportService(rows, repo)
+	report, err := service.ImportCatalog(context.Background(), core.CatalogImportRequest{Format: core.SpreadsheetFormatCSV, DryRun: true})
+	if err != nil {
+		t.Fatalf("ImportCatalog() error = %v", err)
+	}
+	if report.SeriesCount != 1 || report.EpisodeCount != 1 || len(report.Errors) != 0 || len(report.CreatedSeriesIDs) != 0 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+}
+
+func TestCatalogImportService_ImportCatalogHeader(t *testing.T) {
+	for name, header := range map[string][]string{
+		"missing slug":   {"series_title"},
+		"unknown column": {"series_slug", "price"},
+		"duplicate":      {"series_slug", "Series_Slug"},
+	} {
+		service := newTestCatalogImportService([][]string{header}, &stubSeriesRepo{})
+		_, err := service.ImportCatalog(context.Background(), core.CatalogImportRequest{Format: core.SpreadsheetFormatCSV})
+		if !errors.Is(err, core.ErrValidation) {
+			t.Fatalf("%s: expected validation error, got %v", name, err)
+		}
+	}
+}
+
+func TestParseImportDuration(t *testing.T) {
+	cases := map[string]time.Duration{
+		"45":      45 * time.Second,
+		"3:05":    3*time.Minute + 5*time.Second,
+		"1:02:03": time.Hour + 2*time.Minute + 3*time.Second,
+		"5m30s":   5*time.Minute + 30*time.Second,
+	}
+	for raw, want := range cases {
+		got, err := parseImportDuration(raw)
+		if err != nil || got != want {
+			t.Fatalf("parseImportDuration(%q) = %v, %v; want %v", raw, got, err, want)
+		}
+	}
+	for _, raw := range []string{"1:75", "1:60:00", "-5s", "soon"} {
+		if _, err := parseImportDuration(raw); err == nil {
+			t.Fatalf("parseImportDuration(%q) expected error", raw)
+		}
+	}
+}
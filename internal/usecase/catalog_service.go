@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// CatalogService serves the public catalog. Drafts, archived content and
+// deleted episodes are reported as not found, and what is returned carries no
+// author or asset identifiers, transcript findings or playback URLs of
+// episodes that need a subscription.
+type CatalogService struct {
+	series core.SeriesRepository
+}
+
+// NewCatalogService constructs a catalog service reading from the series repository.
+func NewCatalogService(series core.SeriesRepository) *CatalogService {
+	return &CatalogService{series: series}
+}
+
+var _ core.CatalogService = (*CatalogService)(nil)
+
+// ListSeries returns a page of published series.
+func (s *CatalogService) ListSeries(ctx context.Context, filter core.CatalogListFilter) ([]core.Series, string, error) {
+	// Episodes are always loaded so the counts only include published ones.
+	series, nextToken, err := s.series.ListSeries(ctx, core.SeriesListFilter{
+		PageSize:        filter.PageSize,
+		PageToken:       filter.PageToken,
+		Statuses:        []core.SeriesStatus{core.SeriesStatusPublished},
+		Language:        filter.Language,
+		Level:           filter.Level,
+		EstimatedLevel:  filter.EstimatedLevel,
+		Tags:            filter.Tags,
+		Query:           filter.Query,
+		IncludeEpisodes: true,
+		OmitTranscripts: !filter.IncludeEpisodes || !filter.IncludeTranscripts,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	public := make([]core.Series, 0, len(series))
+	for _, item := range series {
+		if item.Status != core.SeriesStatusPublished {
+			continue
+		}
+		public = append(public, publicSeries(item, filter.IncludeEpisodes))
+	}
+	return public, nextToken, nil
+}
+
+// GetSeries returns a published series.
+func (s *CatalogService) GetSeries(ctx context.Context, id uuid.UUID, includeEpisodes bool) (*core.Series, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: series id required", core.ErrValidation)
+	}
+	series, err := s.series.GetSeries(ctx, id, core.SeriesQueryOptions{IncludeEpisodes: true})
+	if err != nil {
+		return nil, err
+	}
+	if series.Status != core.SeriesStatusPublished {
+		return nil, fmt.Errorf("%w: series %s", core.ErrNotFound, id)
+	}
+	public := publicSeries(*series, includeEpisodes)
+	return &public, nil
+}
+
+// GetEpisode returns a published episode of a published series.
+func (s *CatalogService) GetEpisode(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: episode id required", core.ErrValidation)
+	}
+	episode, err := s.series.GetEpisode(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !episodePublished(*episode) {
+		return nil, fmt.Errorf("%w: episode %s", core.ErrNotFound, id)
+	}
+	series, err := s.series.GetSeries(ctx, episode.SeriesID, core.SeriesQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if series.Status != core.SeriesStatusPublished {
+		return nil, fmt.Errorf("%w: episode %s", core.ErrNotFound, id)
+	}
+	public := publicEpisode(*episode)
+	return &public, nil
+}
+
+func episodePublished(episode core.Episode) bool {
+	return episode.Status == core.EpisodeStatusPublished && episode.DeletedAt == nil
+}
+
+// publicSeries strips a published series down to its public fields, counting
+// and optionally embedding only its published episodes.
+func publicSeries(series core.Series, includeEpisodes bool) core.Series {
+	episodes := lo.Filter(series.Episodes, func(episode core.Episode, _ int) bool {
+		return episodePublished(episode)
+	})
+
+	series.AuthorIDs = nil
+	series.CoverAssetID = uuid.Nil
+	series.Branding.HeroAssetID = uuid.Nil
+	series.EpisodeCount = len(episodes)
+	series.Episodes = nil
+	if includeEpisodes && len(episodes) > 0 {
+		series.Episodes = lo.Map(episodes, func(episode core.Episode, _ int) core.Episode {
+			return publicEpisode(episode)
+		})
+	}
+	return series
+}
+
+// publicEpisode strips an episode down to its public fields. Playback URLs are
+// kept for previews only, as the catalog does not know who is listening.
+func publicEpisode(episode core.Episode) core.Episode {
+	episode.Resource.AssetID = uuid.Nil
+	if !episode.Preview {
+		episode.Resource.PlaybackURL = ""
+	}
+	episode.Transcript.Findings = nil
+	return episode
+}
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestCatalogService(t *testing.T) {
+	published, draftSeries := uuid.New(), uuid.New()
+	preview, gated, draft, deleted := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	deletedAt := time.Now()
+	episodes := []core.Episode{
+		{
+			ID: preview, SeriesID: published, Seq: 1, Status: core.EpisodeStatusPublished, Preview: true,
+			Resource:   core.MediaResource{AssetID: uuid.New(), PlaybackURL: "https://media.example.com/1.m3u8"},
+			Transcript: core.Transcript{Content: "hello", Findings: []core.TranscriptFinding{{Kind: "email"}}},
+		},
+		{
+			ID: gated, SeriesID: published, Seq: 2, Status: core.EpisodeStatusPublished,
+			Resource: core.MediaResource{AssetID: uuid.New(), PlaybackURL: "https://media.example.com/2.m3u8"},
+		},
+		{ID: draft, SeriesID: published, Seq: 3, Status: core.EpisodeStatusDraft},
+		{ID: deleted, SeriesID: published, Seq: 4, Status: core.EpisodeStatusPublished, DeletedAt: &deletedAt},
+		{ID: uuid.New(), SeriesID: draftSeries, Seq: 1, Status: core.EpisodeStatusPublished},
+	}
+	series := map[uuid.UUID]core.Series{
+		published: {
+			ID:           published,
+			Status:       core.SeriesStatusPublished,
+			AuthorIDs:    []string{"author"},
+			CoverAssetID: uuid.New(),
+			Branding:     core.SeriesBranding{HeroAssetID: uuid.New(), HeroImageURL: "https://media.example.com/hero.jpg"},
+			EpisodeCount: 4,
+			Episodes:     episodes[:4],
+		},
+		draftSeries: {ID: draftSeries, Status: core.SeriesStatusDraft, Episodes: episodes[4:]},
+	}
+	var listed core.SeriesListFilter
+	repo := &stubSeriesRepo{
+		listSeriesFn: func(ctx context.Context, filter core.SeriesListFilter) ([]core.Series, string, error) {
+			listed = filter
+			return []core.Series{series[published]}, "next", nil
+		},
+		getSeriesFn: func(ctx context.Context, id uuid.UUID, opts core.SeriesQueryOptions) (*core.Series, error) {
+			found, ok := series[id]
+			if !ok {
+				return nil, core.ErrNotFound
+			}
+			return &found, nil
+		},
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			for _, episode := range episodes {
+				if episode.ID == id {
+					return &episode, nil
+				}
+			}
+			return nil, core.ErrNotFound
+		},
+	}
+	service := NewCatalogService(repo)
+	ctx := context.Background()
+
+	list, next, err := service.ListSeries(ctx, core.CatalogListFilter{Language: "en"})
+	if err != nil {
+		t.Fatalf("ListSeries() error = %v", err)
+	}
+	if len(listed.Statuses) != 1 || listed.Statuses[0] != core.SeriesStatusPublished || listed.Language != "en" {
+		t.Fatalf("expected only published series to be listed, got %+v", listed)
+	}
+	if !listed.OmitTranscripts {
+		t.Fatal("expected listings to leave transcripts out by default")
+	}
+	if next != "next" || len(list) != 1 || list[0].Episodes != nil || list[0].EpisodeCount != 2 {
+		t.Fatalf("expected the published episode count without episodes, got %+v", list)
+	}
+
+	if _, _, err := service.ListSeries(ctx, core.CatalogListFilter{IncludeEpisodes: true, IncludeTranscripts: true}); err != nil {
+		t.Fatalf("ListSeries() error = %v", err)
+	}
+	if listed.OmitTranscripts {
+		t.Fatal("expected transcripts to be loaded when asked for")
+	}
+
+	got, err := service.GetSeries(ctx, published, true)
+	if err != nil {
+		t.Fatalf("GetSeries() error = %v", err)
+	}
+	if got.AuthorIDs != nil || got.CoverAssetID != uuid.Nil || got.Branding.HeroAssetID != uuid.Nil || got.Branding.HeroImageURL == "" {
+		t.Fatalf("expected internal identifiers stripped, got %+v", got)
+	}
+	if len(got.Episodes) != 2 || got.Episodes[0].ID != preview || got.Episodes[1].ID != gated {
+		t.Fatalf("expected only the published episodes, got %+v", got.Episodes)
+	}
+	if got.Episodes[0].Resource.PlaybackURL == "" || got.Episodes[1].Resource.PlaybackURL != "" {
+		t.Fatalf("expected playback urls on previews only, got %+v", got.Episodes)
+	}
+	if got.Episodes[0].Resource.AssetID != uuid.Nil || got.Episodes[0].Transcript.Findings != nil {
+		t.Fatalf("expected asset ids and findings stripped, got %+v", got.Episodes[0])
+	}
+	if episodes[0].Resource.AssetID == uuid.Nil {
+		t.Fatal("expected the repository episodes to be left untouched")
+	}
+
+	if _, err := service.GetSeries(ctx, draftSeries, false); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected a draft series to be hidden, got %v", err)
+	}
+
+	episode, err := service.GetEpisode(ctx, gated)
+	if err != nil {
+		t.Fatalf("GetEpisode() error = %v", err)
+	}
+	if episode.Resource.PlaybackURL != "" {
+		t.Fatalf("expected the gated playback url stripped, got %q", episode.Resource.PlaybackURL)
+	}
+	for _, id := range []uuid.UUID{draft, deleted, episodes[4].ID} {
+		if _, err := service.GetEpisode(ctx, id); !errors.Is(err, core.ErrNotFound) {
+			t.Fatalf("expected episode %s to be hidden, got %v", id, err)
+		}
+	}
+}
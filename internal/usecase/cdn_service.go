@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// CDNService serves media from configured origins through a CDN domain and
+// purges the CDN when media is withdrawn or replaced, so learners do not keep
+// getting unpublished or outdated audio from edge caches.
+type CDNService struct {
+	assets   core.AssetRepository
+	provider core.CDNProvider
+	baseURL  string
+	origins  []string
+}
+
+// NewCDNService constructs a CDN service serving URLs under each origin from
+// baseURL instead. URLs are left alone when baseURL is empty. provider may be
+// nil, in which case nothing is purged.
+func NewCDNService(assets core.AssetRepository, provider core.CDNProvider, baseURL string, origins []string) *CDNService {
+	return &CDNService{
+		assets:   assets,
+		provider: provider,
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		origins: lo.FilterMap(origins, func(origin string, _ int) (string, bool) {
+			origin = strings.TrimRight(strings.TrimSpace(origin), "/")
+			return origin, origin != ""
+		}),
+	}
+}
+
+var _ core.CDNService = (*CDNService)(nil)
+
+// RewriteURL swaps the origin of a URL served through the CDN for the CDN
+// base URL, keeping the path and query.
+func (s *CDNService) RewriteURL(raw string) string {
+	if s.baseURL == "" {
+		return raw
+	}
+	for _, origin := range s.origins {
+		if rest, ok := strings.CutPrefix(raw, origin); ok && (rest == "" || strings.ContainsRune("/?", rune(rest[0]))) {
+			return s.baseURL + rest
+		}
+	}
+	return raw
+}
+
+// HandleEvent purges the media of an episode that was unpublished or deleted
+// while published, and the renditions an asset no longer serves.
+func (s *CDNService) HandleEvent(ctx context.Context, event core.Event) error {
+	if s.provider == nil {
+		return nil
+	}
+	var urls []string
+	switch e := event.(type) {
+	case core.EpisodeUnpublished:
+		urls = s.episodeURLs(ctx, e.Episode)
+	case core.EpisodeDeleted:
+		if e.Episode.Status != core.EpisodeStatusPublished {
+			return nil
+		}
+		urls = s.episodeURLs(ctx, e.Episode)
+	case core.AssetRenditionReplaced:
+		urls = e.PreviousURLs
+	default:
+		return nil
+	}
+
+	urls = lo.Uniq(lo.FilterMap(urls, func(raw string, _ int) (string, bool) {
+		return s.purgeURL(raw)
+	}))
+	if len(urls) == 0 {
+		return nil
+	}
+	return s.provider.Invalidate(ctx, urls)
+}
+
+// episodeURLs lists the episode's playback URL along with the renditions of
+// its asset. An asset deleted since is skipped.
+func (s *CDNService) episodeURLs(ctx context.Context, episode core.Episode) []string {
+	urls := []string{episode.Resource.PlaybackURL}
+	if episode.Resource.AssetID == uuid.Nil {
+		return urls
+	}
+	asset, err := s.assets.GetAssetByID(ctx, episode.Resource.AssetID)
+	if err != nil {
+		return urls
+	}
+	return append(urls, asset.PlaybackURL, asset.HLSManifestURL)
+}
+
+// purgeURL returns the CDN URL to purge for a media URL, or false when the
+// URL is not served through the CDN. An HLS playlist is purged with its
+// variants and segments, which sit next to it.
+func (s *CDNService) purgeURL(raw string) (string, bool) {
+	if raw == "" || s.baseURL == "" {
+		return "", false
+	}
+	cdnURL := s.RewriteURL(raw)
+	if cdnURL == raw && !strings.HasPrefix(raw, s.baseURL+"/") {
+		return "", false
+	}
+	cdnURL, _, _ = strings.Cut(cdnURL, "?")
+	if strings.HasSuffix(cdnURL, ".m3u8") {
+		cdnURL = cdnURL[:strings.LastIndex(cdnURL, "/")+1] + "*"
+	}
+	return cdnURL, true
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubCDNProvider struct {
+	calls [][]string
+}
+
+func (p *stubCDNProvider) Invalidate(ctx context.Context, urls []string) error {
+	p.calls = append(p.calls, urls)
+	return nil
+}
+
+func TestCDNService_RewriteURL(t *testing.T) {
+	service := NewCDNService(&stubAssetRepo{}, nil, "https://cdn.example.com/", []string{"https://media.example.com/", " "})
+
+	cases := map[string]string{
+		"https://media.example.com/audio/a.mp3?v=2": "https://cdn.example.com/audio/a.mp3?v=2",
+		"https://media.example.com":                 "https://cdn.example.com",
+		"https://media.example.com.evil/a.mp3":      "https://media.example.com.evil/a.mp3",
+		"https://other.example.com/a.mp3":           "https://other.example.com/a.mp3",
+		"":                                          "",
+	}
+	for raw, want := range cases {
+		if got := service.RewriteURL(raw); got != want {
+			t.Errorf("RewriteURL(%q) = %q, want %q", raw, got, want)
+		}
+	}
+
+	disabled := NewCDNService(&stubAssetRepo{}, nil, "", []string{"https://media.example.com"})
+	if got := disabled.RewriteURL("https://media.example.com/a.mp3"); got != "https://media.example.com/a.mp3" {
+		t.Fatalf("expected URLs to be left alone without a CDN, got %q", got)
+	}
+}
+
+func TestCDNService_HandleEvent(t *testing.T) {
+	asset := core.Asset{
+		ID:             uuid.New(),
+		PlaybackURL:    "https://media.example.com/audio/a.mp3",
+		HLSManifestURL: "https://media.example.com/hls/a/master.m3u8",
+	}
+	assets := &stubAssetRepo{
+		getAssetByIDFn: func(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
+			if id != asset.ID {
+				return nil, core.ErrNotFound
+			}
+			found := asset
+			return &found, nil
+		},
+	}
+	provider := &stubCDNProvider{}
+	service := NewCDNService(assets, provider, "https://cdn.example.com", []string{"https://media.example.com"})
+	episode := core.Episode{
+		ID:       uuid.New(),
+		Status:   core.EpisodeStatusDraft,
+		Resource: core.MediaResource{AssetID: asset.ID, PlaybackURL: asset.PlaybackURL},
+	}
+
+	if err := service.HandleEvent(context.Background(), core.EpisodeUnpublished{Episode: episode}); err != nil {
+		t.Fatalf("HandleEvent(EpisodeUnpublished) error = %v", err)
+	}
+	want := []string{"https://cdn.example.com/audio/a.mp3", "https://cdn.example.com/hls/a/*"}
+	if len(provider.calls) != 1 || !slices.Equal(provider.calls[0], want) {
+		t.Fatalf("unexpected invalidations %#v", provider.calls)
+	}
+
+	if err := service.HandleEvent(context.Background(), core.EpisodeDeleted{Episode: episode}); err != nil || len(provider.calls) != 1 {
+		t.Fatalf("expected deleted drafts not to be purged, got %#v, %v", provider.calls, err)
+	}
+
+	replaced := core.AssetRenditionReplaced{Asset: asset, PreviousURLs: []string{"https://other.example.com/a.mp3", "https://cdn.example.com/old.mp3?v=1"}}
+	if err := service.HandleEvent(context.Background(), replaced); err != nil {
+		t.Fatalf("HandleEvent(AssetRenditionReplaced) error = %v", err)
+	}
+	if len(provider.calls) != 2 || !slices.Equal(provider.calls[1], []string{"https://cdn.example.com/old.mp3"}) {
+		t.Fatalf("expected only URLs served through the CDN to be purged, got %#v", provider.calls)
+	}
+}
@@ -0,0 +1,309 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const maxClassroomLearners = 500
+
+// ClassroomService lets teachers group learners, assign series and follow
+// each learner's progress.
+type ClassroomService struct {
+	repo    core.ClassroomRepository
+	series  core.SeriesRepository
+	history core.WatchHistoryRepository
+	now     func() time.Time
+}
+
+// NewClassroomService constructs a classroom service using the supplied repositories.
+func NewClassroomService(repo core.ClassroomRepository, series core.SeriesRepository, history core.WatchHistoryRepository) *ClassroomService {
+	return &ClassroomService{
+		repo:    repo,
+		series:  series,
+		history: history,
+		now:     time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *ClassroomService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.ClassroomService = (*ClassroomService)(nil)
+
+// CreateClassroom stores a new classroom taught by teacherID.
+func (s *ClassroomService) CreateClassroom(ctx context.Context, teacherID string, draft core.ClassroomDraft) (*core.Classroom, error) {
+	teacherID = strings.TrimSpace(teacherID)
+	if teacherID == "" {
+		return nil, fmt.Errorf("%w: teacher id is required", core.ErrValidation)
+	}
+	name := strings.TrimSpace(draft.Name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: classroom name is required", core.ErrValidation)
+	}
+	learnerIDs, err := normalizeLearnerIDs(draft.LearnerIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(learnerIDs) > maxClassroomLearners {
+		return nil, fmt.Errorf("%w: classrooms hold at most %d learners", core.ErrValidation, maxClassroomLearners)
+	}
+
+	return s.repo.CreateClassroom(ctx, core.Classroom{
+		ID:          uuid.New(),
+		TeacherID:   teacherID,
+		Name:        name,
+		Description: strings.TrimSpace(draft.Description),
+		LearnerIDs:  learnerIDs,
+	})
+}
+
+// GetClassroom retrieves a classroom by identifier.
+func (s *ClassroomService) GetClassroom(ctx context.Context, id uuid.UUID) (*core.Classroom, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: classroom id required", core.ErrValidation)
+	}
+	return s.repo.GetClassroom(ctx, id)
+}
+
+// UpdateClassroom applies metadata changes to a classroom.
+func (s *ClassroomService) UpdateClassroom(ctx context.Context, classroom core.Classroom) (*core.Classroom, error) {
+	if classroom.ID == uuid.Nil {
+		return nil, fmt.Errorf("%w: classroom id required", core.ErrValidation)
+	}
+	classroom.Name = strings.TrimSpace(classroom.Name)
+	if classroom.Name == "" {
+		return nil, fmt.Errorf("%w: classroom name is required", core.ErrValidation)
+	}
+	classroom.Description = strings.TrimSpace(classroom.Description)
+
+	return s.repo.UpdateClassroom(ctx, classroom)
+}
+
+// DeleteClassroom removes a classroom with its enrolments and assignments.
+// Learners' watch history is unaffected.
+func (s *ClassroomService) DeleteClassroom(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("%w: classroom id required", core.ErrValidation)
+	}
+	return s.repo.DeleteClassroom(ctx, id)
+}
+
+// ListClassrooms returns classrooms matching the filter.
+func (s *ClassroomService) ListClassrooms(ctx context.Context, filter core.ClassroomListFilter) ([]core.Classroom, string, error) {
+	filter.TeacherID = strings.TrimSpace(filter.TeacherID)
+	return s.repo.ListClassrooms(ctx, filter)
+}
+
+// AddClassroomLearners enrols learners; those already enrolled are left as is.
+func (s *ClassroomService) AddClassroomLearners(ctx context.Context, id uuid.UUID, learnerIDs []string) (*core.Classroom, error) {
+	classroom, err := s.GetClassroom(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	learnerIDs, err = normalizeLearnerIDs(learnerIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(learnerIDs) == 0 {
+		return nil, fmt.Errorf("%w: learner ids are required", core.ErrValidation)
+	}
+	if len(lo.Union(classroom.LearnerIDs, learnerIDs)) > maxClassroomLearners {
+		return nil, fmt.Errorf("%w: classrooms hold at most %d learners", core.ErrValidation, maxClassroomLearners)
+	}
+
+	return s.repo.AddClassroomLearners(ctx, id, learnerIDs, s.now().UTC())
+}
+
+// RemoveClassroomLearners withdraws learners from a classroom.
+func (s *ClassroomService) RemoveClassroomLearners(ctx context.Context, id uuid.UUID, learnerIDs []string) (*core.Classroom, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: classroom id required", core.ErrValidation)
+	}
+	learnerIDs, err := normalizeLearnerIDs(learnerIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(learnerIDs) == 0 {
+		return nil, fmt.Errorf("%w: learner ids are required", core.ErrValidation)
+	}
+
+	return s.repo.RemoveClassroomLearners(ctx, id, learnerIDs)
+}
+
+// AssignSeries asks the classroom's learners to finish a published series by dueAt.
+func (s *ClassroomService) AssignSeries(ctx context.Context, classroomID, seriesID uuid.UUID, dueAt time.Time) (*core.ClassroomAssignment, error) {
+	switch {
+	case classroomID == uuid.Nil:
+		return nil, fmt.Errorf("%w: classroom id required", core.ErrValidation)
+	case seriesID == uuid.Nil:
+		return nil, fmt.Errorf("%w: series id required", core.ErrValidation)
+	case dueAt.IsZero():
+		return nil, fmt.Errorf("%w: due date is required", core.ErrValidation)
+	}
+
+	if _, err := s.repo.GetClassroom(ctx, classroomID); err != nil {
+		return nil, err
+	}
+	series, err := s.series.GetSeries(ctx, seriesID, core.SeriesQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if series.Status != core.SeriesStatusPublished {
+		return nil, fmt.Errorf("%w: only published series can be assigned", core.ErrInvalidState)
+	}
+
+	return s.repo.CreateClassroomAssignment(ctx, core.ClassroomAssignment{
+		ID:          uuid.New(),
+		ClassroomID: classroomID,
+		SeriesID:    seriesID,
+		DueAt:       dueAt.UTC(),
+	})
+}
+
+// RemoveAssignment withdraws an assignment from a classroom.
+func (s *ClassroomService) RemoveAssignment(ctx context.Context, classroomID, assignmentID uuid.UUID) error {
+	if classroomID == uuid.Nil || assignmentID == uuid.Nil {
+		return fmt.Errorf("%w: classroom id and assignment id required", core.ErrValidation)
+	}
+	return s.repo.DeleteClassroomAssignment(ctx, classroomID, assignmentID)
+}
+
+// GetClassroomReport reports every learner's progress on every assignment.
+// Progress counts the published episodes of each series a learner has
+// finished at least once, whether before or after the series was assigned.
+func (s *ClassroomService) GetClassroomReport(ctx context.Context, id uuid.UUID) (*core.ClassroomReport, error) {
+	classroom, err := s.GetClassroom(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	assigned := make([]assignedSeries, 0, len(classroom.Assignments))
+	for _, assignment := range classroom.Assignments {
+		series, err := s.loadAssignedSeries(ctx, assignment)
+		if err != nil {
+			return nil, err
+		}
+		assigned = append(assigned, series)
+	}
+	episodeIDs := lo.FlatMap(assigned, func(series assignedSeries, _ int) []uuid.UUID {
+		return series.episodeIDs
+	})
+
+	now := s.now().UTC()
+	students := make([]core.StudentProgress, 0, len(classroom.LearnerIDs))
+	for _, learnerID := range classroom.LearnerIDs {
+		finished := map[uuid.UUID]time.Time{}
+		if len(episodeIDs) > 0 {
+			finished, err = s.history.FirstFinishedAt(ctx, learnerID, episodeIDs)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		student := core.StudentProgress{UserID: learnerID}
+		for _, series := range assigned {
+			progress := series.progress(finished, now)
+			if progress.CompletedAt != nil {
+				student.AssignmentsCompleted++
+			}
+			student.Assignments = append(student.Assignments, progress)
+		}
+		students = append(students, student)
+	}
+
+	return &core.ClassroomReport{
+		Classroom:   *classroom,
+		Students:    students,
+		GeneratedAt: now,
+	}, nil
+}
+
+// assignedSeries pairs an assignment with the published episodes that count towards it.
+type assignedSeries struct {
+	assignment core.ClassroomAssignment
+	title      string
+	episodeIDs []uuid.UUID
+}
+
+// loadAssignedSeries loads the published episodes of an assignment's series. A
+// series that has since been unpublished is reported with no episodes.
+func (s *ClassroomService) loadAssignedSeries(ctx context.Context, assignment core.ClassroomAssignment) (assignedSeries, error) {
+	result := assignedSeries{assignment: assignment}
+
+	series, err := s.series.GetSeries(ctx, assignment.SeriesID, core.SeriesQueryOptions{IncludeEpisodes: true})
+	if err != nil {
+		if isNotFound(err) {
+			return result, nil
+		}
+		return result, err
+	}
+	result.title = series.Title
+	if series.Status != core.SeriesStatusPublished {
+		return result, nil
+	}
+
+	result.episodeIDs = lo.FilterMap(series.Episodes, func(episode core.Episode, _ int) (uuid.UUID, bool) {
+		return episode.ID, episode.Status == core.EpisodeStatusPublished && episode.DeletedAt == nil
+	})
+	return result, nil
+}
+
+func (a assignedSeries) progress(finished map[uuid.UUID]time.Time, now time.Time) core.AssignmentProgress {
+	progress := core.AssignmentProgress{
+		AssignmentID: a.assignment.ID,
+		SeriesID:     a.assignment.SeriesID,
+		SeriesTitle:  a.title,
+		DueAt:        a.assignment.DueAt,
+		EpisodeCount: len(a.episodeIDs),
+	}
+
+	var completedAt time.Time
+	for _, episodeID := range a.episodeIDs {
+		if at, ok := finished[episodeID]; ok {
+			progress.EpisodesCompleted++
+			if at.After(completedAt) {
+				completedAt = at
+			}
+		}
+	}
+
+	switch {
+	case progress.EpisodeCount > 0 && progress.EpisodesCompleted == progress.EpisodeCount:
+		progress.CompletedAt = &completedAt
+		progress.Status = core.AssignmentStatusCompleted
+		if completedAt.After(a.assignment.DueAt) {
+			progress.Status = core.AssignmentStatusCompletedLate
+		}
+	case now.After(a.assignment.DueAt):
+		progress.Status = core.AssignmentStatusOverdue
+	case progress.EpisodesCompleted > 0:
+		progress.Status = core.AssignmentStatusInProgress
+	default:
+		progress.Status = core.AssignmentStatusNotStarted
+	}
+	return progress
+}
+
+func normalizeLearnerIDs(learnerIDs []string) ([]string, error) {
+	normalized := make([]string, 0, len(learnerIDs))
+	for _, learnerID := range learnerIDs {
+		learnerID = strings.TrimSpace(learnerID)
+		if learnerID == "" {
+			return nil, fmt.Errorf("%w: learner ids must not be blank", core.ErrValidation)
+		}
+		normalized = append(normalized, learnerID)
+	}
+	return lo.Uniq(normalized), nil
+}
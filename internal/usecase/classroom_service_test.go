@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubClassroomRepo struct {
+	classroom   *core.Classroom
+	assignments []core.ClassroomAssignment
+}
+
+func (s *stubClassroomRepo) CreateClassroom(ctx context.Context, classroom core.Classroom) (*core.Classroom, error) {
+	s.classroom = &classroom
+	return &classroom, nil
+}
+
+func (s *stubClassroomRepo) GetClassroom(ctx context.Context, id uuid.UUID) (*core.Classroom, error) {
+	if s.classroom == nil || s.classroom.ID != id {
+		return nil, core.ErrNotFound
+	}
+	classroom := *s.classroom
+	return &classroom, nil
+}
+
+func (s *stubClassroomRepo) UpdateClassroom(ctx context.Context, classroom core.Classroom) (*core.Classroom, error) {
+	s.classroom = &classroom
+	return &classroom, nil
+}
+
+func (s *stubClassroomRepo) DeleteClassroom(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (s *stubClassroomRepo) ListClassrooms(ctx context.Context, filter core.ClassroomListFilter) ([]core.Classroom, string, error) {
+	return nil, "", nil
+}
+
+func (s *stubClassroomRepo) AddClassroomLearners(ctx context.Context, id uuid.UUID, learnerIDs []string, joinedAt time.Time) (*core.Classroom, error) {
+	return s.GetClassroom(ctx, id)
+}
+
+func (s *stubClassroomRepo) RemoveClassroomLearners(ctx context.Context, id uuid.UUID, learnerIDs []string) (*core.Classroom, error) {
+	return s.GetClassroom(ctx, id)
+}
+
+func (s *stubClassroomRepo) CreateClassroomAssignment(ctx context.Context, assignment core.ClassroomAssignment) (*core.ClassroomAssignment, error) {
+	s.assignments = append(s.assignments, assignment)
+	return &assignment, nil
+}
+
+func (s *stubClassroomRepo) DeleteClassroomAssignment(ctx context.Context, classroomID, assignmentID uuid.UUID) error {
+	return nil
+}
+
+func (s *stubClassroomRepo) ListSeriesLearners(ctx context.Context, seriesID uuid.UUID) ([]string, error) {
+	if s.classroom == nil {
+		return nil, nil
+	}
+	for _, assignment := range s.classroom.Assignments {
+		if assignment.SeriesID == seriesID {
+			return s.classroom.LearnerIDs, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *stubClassroomRepo) ListClassroomsWithAssignmentsDue(ctx context.Context, from, to time.Time) ([]core.Classroom, error) {
+	if s.classroom == nil {
+		return nil, nil
+	}
+	classroom := *s.classroom
+	classroom.Assignments = nil
+	for _, assignment := range s.classroom.Assignments {
+		if !assignment.DueAt.Before(from) && assignment.DueAt.Before(to) {
+			classroom.Assignments = append(classroom.Assignments, assignment)
+		}
+	}
+	if len(classroom.Assignments) == 0 {
+		return nil, nil
+	}
+	return []core.Classroom{classroom}, nil
+}
+
+func TestClassroomService_CreateClassroom(t *testing.T) {
+	service := NewClassroomService(&stubClassroomRepo{}, &stubSeriesRepo{}, &stubWatchHistoryRepo{})
+
+	classroom, err := service.CreateClassroom(context.Background(), " teacher ", core.ClassroomDraft{
+		Name:       " Spring cohort ",
+		LearnerIDs: []string{"b", " a", "b"},
+	})
+	if err != nil {
+		t.Fatalf("CreateClassroom() error = %v", err)
+	}
+	if classroom.TeacherID != "teacher" || classroom.Name != "Spring cohort" {
+		t.Fatalf("classroom = %#v", classroom)
+	}
+	if len(classroom.LearnerIDs) != 2 {
+		t.Fatalf("learner ids = %v, want duplicates removed", classroom.LearnerIDs)
+	}
+
+	if _, err := service.CreateClassroom(context.Background(), "teacher", core.ClassroomDraft{Name: "x", LearnerIDs: []string{" "}}); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("blank learner error = %v, want ErrValidation", err)
+	}
+}
+
+func TestClassroomService_AssignSeries(t *testing.T) {
+	classroomID := uuid.New()
+	published := uuid.New()
+	draft := uuid.New()
+	repo := &stubClassroomRepo{classroom: &core.Classroom{ID: classroomID}}
+	seriesRepo := &stubSeriesRepo{
+		getSeriesFn: func(ctx context.Context, id uuid.UUID, opts core.SeriesQueryOptions) (*core.Series, error) {
+			switch id {
+			case published:
+				return &core.Series{ID: id, Status: core.SeriesStatusPublished}, nil
+			case draft:
+				return &core.Series{ID: id, Status: core.SeriesStatusDraft}, nil
+			default:
+				return nil, core.ErrNotFound
+			}
+		},
+	}
+	service := NewClassroomService(repo, seriesRepo, &stubWatchHistoryRepo{})
+	dueAt := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		classroomID uuid.UUID
+		seriesID    uuid.UUID
+		dueAt       time.Time
+		wantErr     error
+	}{
+		{name: "published series", classroomID: classroomID, seriesID: published, dueAt: dueAt},
+		{name: "draft series", classroomID: classroomID, seriesID: draft, dueAt: dueAt, wantErr: core.ErrInvalidState},
+		{name: "unknown series", classroomID: classroomID, seriesID: uuid.New(), dueAt: dueAt, wantErr: core.ErrNotFound},
+		{name: "unknown classroom", classroomID: uuid.New(), seriesID: published, dueAt: dueAt, wantErr: core.ErrNotFound},
+		{name: "missing due date", classroomID: classroomID, seriesID: published, wantErr: core.ErrValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.AssignSeries(context.Background(), tt.classroomID, tt.seriesID, tt.dueAt)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("AssignSeries() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AssignSeries() error = %v", err)
+			}
+		})
+	}
+
+	if len(repo.assignments) != 1 || repo.assignments[0].SeriesID != published {
+		t.Fatalf("assignments = %#v, want one for the published series", repo.assignments)
+	}
+}
+
+func TestClassroomService_GetClassroomReport(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	dueAt := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	seriesID := uuid.New()
+	first, second, unpublished := uuid.New(), uuid.New(), uuid.New()
+	assignmentID := uuid.New()
+
+	classroomID := uuid.New()
+	repo := &stubClassroomRepo{classroom: &core.Classroom{
+		ID:          classroomID,
+		LearnerIDs:  []string{"late", "ontime", "partial"},
+		Assignments: []core.ClassroomAssignment{{ID: assignmentID, ClassroomID: classroomID, SeriesID: seriesID, DueAt: dueAt}},
+	}}
+	seriesRepo := &stubSeriesRepo{
+		getSeriesFn: func(ctx context.Context, id uuid.UUID, opts core.SeriesQueryOptions) (*core.Series, error) {
+			return &core.Series{
+				ID:     seriesID,
+				Title:  "Travel English",
+				Status: core.SeriesStatusPublished,
+				Episodes: []core.Episode{
+					{ID: first, Status: core.EpisodeStatusPublished},
+					{ID: second, Status: core.EpisodeStatusPublished},
+					{ID: unpublished, Status: core.EpisodeStatusDraft},
+				},
+			}, nil
+		},
+	}
+
+	sessions := map[uuid.UUID]core.PlaybackSession{}
+	finish := func(userID string, episodeID uuid.UUID, at time.Time) {
+		id := uuid.New()
+		sessions[id] = core.PlaybackSession{ID: id, UserID: userID, EpisodeID: episodeID, FinishedAt: &at}
+	}
+	finish("ontime", first, dueAt.Add(-48*time.Hour))
+	finish("ontime", second, dueAt.Add(-24*time.Hour))
+	finish("late", first, dueAt.Add(-24*time.Hour))
+	finish("late", second, dueAt.Add(24*time.Hour))
+	finish("partial", first, dueAt.Add(-time.Hour))
+
+	service := NewClassroomService(repo, seriesRepo, &stubWatchHistoryRepo{sessions: sessions})
+	service.WithClock(func() time.Time { return now })
+
+	report, err := service.GetClassroomReport(context.Background(), classroomID)
+	if err != nil {
+		t.Fatalf("GetClassroomReport() error = %v", err)
+	}
+
+	want := map[string]struct {
+		status    core.AssignmentStatus
+		completed int
+	}{
+		"late":    {status: core.AssignmentStatusCompletedLate, completed: 2},
+		"ontime":  {status: core.AssignmentStatusCompleted, completed: 2},
+		"partial": {status: core.AssignmentStatusOverdue, completed: 1},
+	}
+	if len(report.Students) != len(want) {
+		t.Fatalf("students = %d, want %d", len(report.Students), len(want))
+	}
+	for _, student := range report.Students {
+		progress := student.Assignments[0]
+		if progress.EpisodeCount != 2 {
+			t.Fatalf("%s episode count = %d, want 2 published episodes", student.UserID, progress.EpisodeCount)
+		}
+		if progress.Status != want[student.UserID].status || progress.EpisodesCompleted != want[student.UserID].completed {
+			t.Fatalf("%s progress = %#v, want %+v", student.UserID, progress, want[student.UserID])
+		}
+	}
+	if report.Students[2].AssignmentsCompleted != 0 || report.Students[1].AssignmentsCompleted != 1 {
+		t.Fatalf("assignments completed = %d/%d", report.Students[1].AssignmentsCompleted, report.Students[2].AssignmentsCompleted)
+	}
+}
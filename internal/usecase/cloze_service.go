@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// defaultClozeItems is the number of cloze items generated by default.
+	defaultClozeItems = 10
+	// maxClozeItems caps the cloze items generated for an episode.
+	maxClozeItems = 50
+	// Sentences outside these lengths, in words, make poor cloze items:
+	// short ones give too little context and long ones too much to read.
+	minClozeSentenceWords = 5
+	maxClozeSentenceWords = 30
+	// minClozeWordLength keeps short function words out of the gaps.
+	minClozeWordLength = 4
+)
+
+// ClozeService blanks a vocabulary word in each of a selection of transcript
+// sentences. It prefers the rarest words, so the gaps test the vocabulary an
+// episode teaches rather than its grammar words. Generated items are flagged
+// for an editor to review.
+type ClozeService struct {
+	series core.SeriesRepository
+	repo   core.QuizItemRepository
+}
+
+// NewClozeService constructs a cloze service using the supplied repositories.
+func NewClozeService(series core.SeriesRepository, repo core.QuizItemRepository) *ClozeService {
+	return &ClozeService{
+		series: series,
+		repo:   repo,
+	}
+}
+
+var _ core.ClozeService = (*ClozeService)(nil)
+
+// GenerateClozeItems replaces the episode's cloze items with ones made from
+// its current transcript, including any an editor has reviewed.
+func (s *ClozeService) GenerateClozeItems(ctx context.Context, episodeID uuid.UUID, opts core.ClozeOptions) ([]core.QuizItem, error) {
+	if episodeID == uuid.Nil {
+		return nil, fmt.Errorf("%w: episode id required", core.ErrValidation)
+	}
+	episode, err := s.series.GetEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+	if opts.MaxItems <= 0 {
+		opts.MaxItems = defaultClozeItems
+	}
+	opts.MaxItems = min(opts.MaxItems, maxClozeItems)
+
+	items := generateClozeItems(episode.Transcript, opts.MaxItems)
+	for i := range items {
+		items[i].ID = uuid.New()
+		items[i].EpisodeID = episode.ID
+		items[i].Position = i
+		items[i].NeedsReview = true
+	}
+	return s.repo.ReplaceQuizItems(ctx, episode.ID, core.QuizItemKindCloze, items)
+}
+
+// ListQuizItems returns an episode's quiz items in order.
+func (s *ClozeService) ListQuizItems(ctx context.Context, filter core.QuizItemFilter) ([]core.QuizItem, error) {
+	if filter.EpisodeID == uuid.Nil {
+		return nil, fmt.Errorf("%w: episode id required", core.ErrValidation)
+	}
+	return s.repo.ListQuizItems(ctx, filter)
+}
+
+// GetQuizItem returns a quiz item.
+func (s *ClozeService) GetQuizItem(ctx context.Context, id uuid.UUID) (*core.QuizItem, error) {
+	return s.repo.GetQuizItem(ctx, id)
+}
+
+// UpdateQuizItem saves an editor's changes to a quiz item. The prompt of a
+// cloze item must keep exactly one gap.
+func (s *ClozeService) UpdateQuizItem(ctx context.Context, item core.QuizItem) (*core.QuizItem, error) {
+	if item.ID == uuid.Nil {
+		return nil, fmt.Errorf("%w: quiz item id required", core.ErrValidation)
+	}
+	item.Answer = strings.TrimSpace(item.Answer)
+	if item.Answer == "" {
+		return nil, fmt.Errorf("%w: answer is required", core.ErrValidation)
+	}
+	if item.Kind == core.QuizItemKindCloze && strings.Count(item.Prompt, core.ClozeBlank) != 1 {
+		return nil, fmt.Errorf("%w: cloze prompt must contain one %s gap", core.ErrValidation, core.ClozeBlank)
+	}
+	return s.repo.UpdateQuizItem(ctx, item)
+}
+
+// HandleEpisodeEvent generates cloze items for a created or updated episode
+// that has none, so editors' reviewed items are never replaced behind their
+// backs. Episodes deleted since the event are skipped.
+func (s *ClozeService) HandleEpisodeEvent(ctx context.Context, event core.Event) error {
+	var id uuid.UUID
+	switch e := event.(type) {
+	case core.EpisodeCreated:
+		id = e.Episode.ID
+	case core.EpisodeUpdated:
+		id = e.Episode.ID
+	default:
+		return nil
+	}
+
+	existing, err := s.repo.ListQuizItems(ctx, core.QuizItemFilter{EpisodeID: id, Kind: core.QuizItemKindCloze})
+	if err != nil || len(existing) > 0 {
+		return err
+	}
+	_, err = s.GenerateClozeItems(ctx, id, core.ClozeOptions{})
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// clozeCandidate is a word that could be blanked in a sentence.
+type clozeCandidate struct {
+	sentence int
+	tokens   []core.TranscriptToken
+	token    int
+}
+
+// generateClozeItems blanks a word in up to limit sentences. The rarest
+// eligible words are placed first, each in one sentence only, so common
+// words fill the sentences left over. Items follow the order of the
+// transcript.
+func generateClozeItems(transcript core.Transcript, limit int) []core.QuizItem {
+	text := core.TranscriptText(transcript)
+	counts := lo.CountValues(splitWords(text))
+	english := isEnglish(transcript.Language)
+
+	// Captions break lines mid-sentence, so lines are joined up unless the
+	// text has no terminal punctuation to split sentences at.
+	joined := text
+	if strings.ContainsAny(text, ".!?") {
+		joined = strings.Join(strings.Fields(text), " ")
+	}
+	var candidates []clozeCandidate
+	for i, sentence := range splitSentences(joined) {
+		tokens := tokenizeTranscript(sentence)
+		words := lo.CountBy(tokens, func(token core.TranscriptToken) bool { return token.Word != "" })
+		if words < minClozeSentenceWords || words > maxClozeSentenceWords {
+			continue
+		}
+		seen := map[string]bool{}
+		for j, token := range tokens {
+			if clozeEligible(token.Word, english) && !seen[token.Word] {
+				seen[token.Word] = true
+				candidates = append(candidates, clozeCandidate{sentence: i, tokens: tokens, token: j})
+			}
+		}
+	}
+
+	slices.SortStableFunc(candidates, func(a, b clozeCandidate) int {
+		return compareClozeWords(a.tokens[a.token].Word, b.tokens[b.token].Word, counts)
+	})
+	usedWords, usedSentences := map[string]bool{}, map[int]bool{}
+	var chosen []clozeCandidate
+	for _, candidate := range candidates {
+		if len(chosen) == limit {
+			break
+		}
+		word := candidate.tokens[candidate.token].Word
+		if usedWords[word] || usedSentences[candidate.sentence] {
+			continue
+		}
+		usedWords[word], usedSentences[candidate.sentence] = true, true
+		chosen = append(chosen, candidate)
+	}
+	slices.SortFunc(chosen, func(a, b clozeCandidate) int { return a.sentence - b.sentence })
+
+	return lo.Map(chosen, func(candidate clozeCandidate, _ int) core.QuizItem {
+		var prompt, sentence strings.Builder
+		for j, token := range candidate.tokens {
+			sentence.WriteString(token.Text)
+			if j == candidate.token {
+				prompt.WriteString(core.ClozeBlank)
+				continue
+			}
+			prompt.WriteString(token.Text)
+		}
+		return core.QuizItem{
+			Kind:     core.QuizItemKindCloze,
+			Prompt:   prompt.String(),
+			Answer:   candidate.tokens[candidate.token].Text,
+			Sentence: sentence.String(),
+		}
+	})
+}
+
+// clozeEligible reports whether word may be blanked: long enough, not
+// contracted and, in English, outside the basic vocabulary.
+func clozeEligible(word string, english bool) bool {
+	if utf8.RuneCountInString(word) < minClozeWordLength || strings.ContainsRune(word, '\'') {
+		return false
+	}
+	if english {
+		if _, basic := basicEnglishWords[word]; basic {
+			return false
+		}
+	}
+	return true
+}
+
+// compareClozeWords orders the rarer word in the transcript first, then the
+// longer, then alphabetically.
+func compareClozeWords(a, b string, counts map[string]int) int {
+	return cmp.Or(
+		counts[a]-counts[b],
+		utf8.RuneCountInString(b)-utf8.RuneCountInString(a),
+		strings.Compare(a, b),
+	)
+}
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestGenerateClozeItems(t *testing.T) {
+	transcript := core.Transcript{
+		Language: "en",
+		Format:   core.TranscriptFormatSRT,
+		Content: "1\n00:00:01,000 --> 00:00:03,000\nThe barista poured the milk\n\n" +
+			"2\n00:00:03,000 --> 00:00:05,000\ninto a small espresso cup.\n\n" +
+			"3\n00:00:05,000 --> 00:00:07,000\nToo short.\n\n" +
+			"4\n00:00:07,000 --> 00:00:09,000\nThen the barista poured a second espresso.\n\n" +
+			"5\n00:00:09,000 --> 00:00:11,000\nShe ground fresh beans for the next customer.\n",
+	}
+
+	items := generateClozeItems(transcript, 10)
+	if len(items) != 3 {
+		t.Fatalf("expected three items, got %#v", items)
+	}
+	// "customer" occurs once and is placed first. "espresso" and "barista"
+	// occur twice; the longer "espresso" takes the first sentence, leaving
+	// "barista" for the second.
+	want := []core.QuizItem{
+		{
+			Kind:     core.QuizItemKindCloze,
+			Prompt:   "The barista poured the milk into a small _____ cup.",
+			Answer:   "espresso",
+			Sentence: "The barista poured the milk into a small espresso cup.",
+		},
+		{
+			Kind:     core.QuizItemKindCloze,
+			Prompt:   "Then the _____ poured a second espresso.",
+			Answer:   "barista",
+			Sentence: "Then the barista poured a second espresso.",
+		},
+		{
+			Kind:     core.QuizItemKindCloze,
+			Prompt:   "She ground fresh beans for the next _____.",
+			Answer:   "customer",
+			Sentence: "She ground fresh beans for the next customer.",
+		},
+	}
+	for i := range want {
+		if items[i] != want[i] {
+			t.Fatalf("items[%d] = %#v, want %#v", i, items[i], want[i])
+		}
+	}
+
+	if limited := generateClozeItems(transcript, 1); len(limited) != 1 || limited[0].Answer != "customer" {
+		t.Fatalf("expected the limit to keep the rarest word, got %#v", limited)
+	}
+}
+
+func TestClozeService_GenerateAndUpdate(t *testing.T) {
+	episodeID := uuid.New()
+	seriesRepo := &stubSeriesRepo{
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			if id != episodeID {
+				return nil, core.ErrNotFound
+			}
+			return &core.Episode{
+				ID: id,
+				Transcript: core.Transcript{
+					Format:  core.TranscriptFormatPlain,
+					Content: "The barista poured the milk into a small espresso cup.",
+				},
+			}, nil
+		},
+	}
+	repo := &stubQuizItemRepo{}
+	service := NewClozeService(seriesRepo, repo)
+
+	if err := service.HandleEpisodeEvent(context.Background(), core.EpisodeCreated{Episode: core.Episode{ID: episodeID}}); err != nil {
+		t.Fatalf("HandleEpisodeEvent() error = %v", err)
+	}
+	if len(repo.items) != 1 || !repo.items[0].NeedsReview || repo.items[0].EpisodeID != episodeID {
+		t.Fatalf("expected one item flagged for review, got %#v", repo.items)
+	}
+
+	// Items an editor has seen are not regenerated on later updates.
+	reviewed := repo.items[0]
+	reviewed.NeedsReview = false
+	reviewed.Prompt = "The _____ poured the milk into a small espresso cup."
+	reviewed.Answer = "barista"
+	if _, err := service.UpdateQuizItem(context.Background(), reviewed); err != nil {
+		t.Fatalf("UpdateQuizItem() error = %v", err)
+	}
+	if err := service.HandleEpisodeEvent(context.Background(), core.EpisodeUpdated{Episode: core.Episode{ID: episodeID}}); err != nil {
+		t.Fatalf("HandleEpisodeEvent() error = %v", err)
+	}
+	if repo.replaced != 1 || repo.items[0].Answer != "barista" {
+		t.Fatalf("expected the reviewed item to be kept, got %#v", repo.items)
+	}
+
+	if err := service.HandleEpisodeEvent(context.Background(), core.EpisodeCreated{Episode: core.Episode{ID: uuid.New()}}); err != nil {
+		t.Fatalf("expected a deleted episode to be skipped, got %v", err)
+	}
+
+	invalid := reviewed
+	invalid.Prompt = "No gap here."
+	if _, err := service.UpdateQuizItem(context.Background(), invalid); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected a prompt without a gap to be rejected, got %v", err)
+	}
+}
+
+type stubQuizItemRepo struct {
+	items    []core.QuizItem
+	replaced int
+}
+
+func (s *stubQuizItemRepo) ReplaceQuizItems(ctx context.Context, episodeID uuid.UUID, kind core.QuizItemKind, items []core.QuizItem) ([]core.QuizItem, error) {
+	s.replaced++
+	s.items = items
+	return items, nil
+}
+
+func (s *stubQuizItemRepo) ListQuizItems(ctx context.Context, filter core.QuizItemFilter) ([]core.QuizItem, error) {
+	var items []core.QuizItem
+	for _, item := range s.items {
+		if item.EpisodeID == filter.EpisodeID {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+func (s *stubQuizItemRepo) GetQuizItem(ctx context.Context, id uuid.UUID) (*core.QuizItem, error) {
+	for _, item := range s.items {
+		if item.ID == id {
+			return &item, nil
+		}
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubQuizItemRepo) UpdateQuizItem(ctx context.Context, item core.QuizItem) (*core.QuizItem, error) {
+	for i := range s.items {
+		if s.items[i].ID == item.ID {
+			s.items[i] = item
+			return &item, nil
+		}
+	}
+	return nil, core.ErrNotFound
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// ContentKeyService delivers the AES-128 keys of encrypted HLS renditions.
+// A key is handed out under the same entitlement rules as playback: anyone
+// may play a published preview episode, while other published episodes need
+// an active subscription.
+type ContentKeyService struct {
+	keys          core.ContentKeyRepository
+	assets        core.AssetRepository
+	series        core.SeriesRepository
+	subscriptions core.SubscriptionService
+}
+
+// NewContentKeyService constructs a content key service.
+func NewContentKeyService(keys core.ContentKeyRepository, assets core.AssetRepository, series core.SeriesRepository, subscriptions core.SubscriptionService) *ContentKeyService {
+	return &ContentKeyService{
+		keys:          keys,
+		assets:        assets,
+		series:        series,
+		subscriptions: subscriptions,
+	}
+}
+
+var _ core.ContentKeyService = (*ContentKeyService)(nil)
+
+// GetContentKey returns the key if it is the current key of its asset and
+// the caller may play an episode using the asset. Keys retired by packaging
+// the asset again are not found.
+func (s *ContentKeyService) GetContentKey(ctx context.Context, id uuid.UUID) (*core.ContentKey, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: key id required", core.ErrValidation)
+	}
+	key, err := s.keys.GetContentKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	asset, err := s.assets.GetAssetByID(ctx, key.AssetID)
+	if err != nil {
+		return nil, err
+	}
+	if asset.HLSKeyID != key.ID {
+		return nil, fmt.Errorf("%w: content key %s has been retired", core.ErrNotFound, key.ID)
+	}
+
+	episodes, err := s.series.ListEpisodesByAsset(ctx, asset.ID)
+	if err != nil {
+		return nil, err
+	}
+	published := lo.Filter(episodes, func(episode core.Episode, _ int) bool {
+		return episode.Status == core.EpisodeStatusPublished
+	})
+	if len(published) == 0 {
+		return nil, fmt.Errorf("%w: no published episode plays asset %s", core.ErrNotFound, asset.ID)
+	}
+	if lo.SomeBy(published, func(episode core.Episode) bool { return episode.Preview }) {
+		return key, nil
+	}
+
+	caller, ok := core.CallerFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: asset %s is not in a preview", core.ErrSubscriptionRequired, asset.ID)
+	}
+	if _, err := s.subscriptions.ActiveSubscription(ctx, caller.UserID); err != nil {
+		if errors.Is(err, core.ErrNotFound) {
+			return nil, fmt.Errorf("%w: asset %s is not in a preview", core.ErrSubscriptionRequired, asset.ID)
+		}
+		return nil, err
+	}
+	return key, nil
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestContentKeyService_GetContentKey(t *testing.T) {
+	ctx := context.Background()
+	asset := core.Asset{ID: uuid.New(), HLSKeyID: uuid.New()}
+	keys := newStubContentKeyRepo()
+	current := core.ContentKey{ID: asset.HLSKeyID, AssetID: asset.ID, Key: make([]byte, contentKeySize)}
+	retired := core.ContentKey{ID: uuid.New(), AssetID: asset.ID, Key: make([]byte, contentKeySize)}
+	for _, key := range []core.ContentKey{current, retired} {
+		if err := keys.CreateContentKey(ctx, key); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assets := &stubAssetRepo{
+		getAssetByIDFn: func(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
+			found := asset
+			return &found, nil
+		},
+	}
+	episode := core.Episode{ID: uuid.New(), SeriesID: uuid.New(), Status: core.EpisodeStatusPublished}
+	seriesRepo := &stubSeriesRepo{
+		listEpisodesByAssetFn: func(ctx context.Context, assetID uuid.UUID) ([]core.Episode, error) {
+			return []core.Episode{episode}, nil
+		},
+	}
+	monthly := core.Plan{ID: uuid.New(), Code: "monthly", Interval: core.BillingIntervalMonth, Active: true}
+	subscriptions := NewSubscriptionService(newStubSubscriptionRepo(monthly))
+	if _, err := subscriptions.Subscribe(ctx, "subscriber", monthly.ID); err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+	service := NewContentKeyService(keys, assets, seriesRepo, subscriptions)
+
+	learner := core.NewCallerContext(ctx, core.Caller{UserID: "learner"})
+	if _, err := service.GetContentKey(learner, current.ID); !errors.Is(err, core.ErrSubscriptionRequired) {
+		t.Fatalf("expected subscription to be required, got %v", err)
+	}
+	if _, err := service.GetContentKey(ctx, current.ID); !errors.Is(err, core.ErrSubscriptionRequired) {
+		t.Fatalf("expected anonymous callers to be refused, got %v", err)
+	}
+	subscriber := core.NewCallerContext(ctx, core.Caller{UserID: "subscriber"})
+	if key, err := service.GetContentKey(subscriber, current.ID); err != nil || key.ID != current.ID {
+		t.Fatalf("GetContentKey(subscriber) = %#v, %v", key, err)
+	}
+	if _, err := service.GetContentKey(subscriber, retired.ID); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected retired key to be refused, got %v", err)
+	}
+
+	episode.Preview = true
+	if _, err := service.GetContentKey(learner, current.ID); err != nil {
+		t.Fatalf("expected preview key to be delivered, got %v", err)
+	}
+	episode.Status = core.EpisodeStatusDraft
+	if _, err := service.GetContentKey(subscriber, current.ID); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected keys of unpublished audio to be refused, got %v", err)
+	}
+}
+
+type stubContentKeyRepo struct {
+	keys map[uuid.UUID]core.ContentKey
+}
+
+func newStubContentKeyRepo() *stubContentKeyRepo {
+	return &stubContentKeyRepo{keys: make(map[uuid.UUID]core.ContentKey)}
+}
+
+func (s *stubContentKeyRepo) CreateContentKey(ctx context.Context, key core.ContentKey) error {
+	s.keys[key.ID] = key
+	return nil
+}
+
+func (s *stubContentKeyRepo) GetContentKey(ctx context.Context, id uuid.UUID) (*core.ContentKey, error) {
+	key, ok := s.keys[id]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	return &key, nil
+}
@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// DictationService slices episode transcripts into dictation items and scores learner answers.
+type DictationService struct {
+	series   core.SeriesRepository
+	attempts core.DictationAttemptRepository
+}
+
+// NewDictationService constructs a dictation service using the supplied repositories.
+func NewDictationService(series core.SeriesRepository, attempts core.DictationAttemptRepository) *DictationService {
+	return &DictationService{
+		series:   series,
+		attempts: attempts,
+	}
+}
+
+var _ core.DictationService = (*DictationService)(nil)
+
+// ListDictationItems returns one item per timed transcript segment of the episode.
+func (s *DictationService) ListDictationItems(ctx context.Context, episodeID uuid.UUID) ([]core.DictationItem, error) {
+	episode, segments, err := s.loadSegments(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(segments, func(segment core.TranscriptSegment, _ int) core.DictationItem {
+		return core.DictationItem{
+			EpisodeID: episode.ID,
+			Index:     segment.Index,
+			Start:     segment.Start,
+			End:       segment.End,
+			AudioURL:  segmentAudioURL(episode.Resource.PlaybackURL, segment),
+			WordCount: len(strings.Fields(segment.Text)),
+		}
+	}), nil
+}
+
+// SubmitDictationAnswer scores a typed answer against the segment text and records the attempt.
+func (s *DictationService) SubmitDictationAnswer(ctx context.Context, params core.SubmitDictationParams) (*core.DictationAttempt, error) {
+	userID := strings.TrimSpace(params.UserID)
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", core.ErrValidation)
+	}
+
+	_, segments, err := s.loadSegments(ctx, params.EpisodeID)
+	if err != nil {
+		return nil, err
+	}
+	if params.ItemIndex < 0 || params.ItemIndex >= len(segments) {
+		return nil, fmt.Errorf("%w: dictation item %d", core.ErrNotFound, params.ItemIndex)
+	}
+
+	expected := segments[params.ItemIndex].Text
+	score, feedback := scoreDictation(expected, params.Answer)
+
+	return s.attempts.CreateDictationAttempt(ctx, core.DictationAttempt{
+		ID:        uuid.New(),
+		UserID:    userID,
+		EpisodeID: params.EpisodeID,
+		ItemIndex: params.ItemIndex,
+		Answer:    params.Answer,
+		Expected:  expected,
+		Score:     score,
+		Feedback:  feedback,
+	})
+}
+
+// ListDictationAttempts returns recorded attempts, newest first.
+func (s *DictationService) ListDictationAttempts(ctx context.Context, filter core.DictationAttemptFilter) ([]core.DictationAttempt, string, error) {
+	return s.attempts.ListDictationAttempts(ctx, filter)
+}
+
+func (s *DictationService) loadSegments(ctx context.Context, episodeID uuid.UUID) (*core.Episode, []core.TranscriptSegment, error) {
+	episode, err := s.series.GetEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	segments, err := core.ParseTranscriptSegments(episode.Transcript)
+	if err != nil {
+		return nil, nil, err
+	}
+	return episode, segments, nil
+}
+
+// segmentAudioURL addresses the segment with a W3C media fragment so players
+// can seek straight to it without server-side clipping.
+func segmentAudioURL(playbackURL string, segment core.TranscriptSegment) string {
+	if playbackURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s#t=%.3f,%.3f", playbackURL, segment.Start.Seconds(), segment.End.Seconds())
+}
+
+// scoreDictation aligns answer words to expected words using a longest common
+// subsequence and returns the share of expected words reproduced.
+func scoreDictation(expected, answer string) (float64, []core.DictationToken) {
+	want := strings.Fields(expected)
+	got := strings.Fields(answer)
+	wantKeys := lo.Map(want, func(word string, _ int) string { return normalizeDictationWord(word) })
+	gotKeys := lo.Map(got, func(word string, _ int) string { return normalizeDictationWord(word) })
+
+	lcs := make([][]int, len(want)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(got)+1)
+	}
+	for i := len(want) - 1; i >= 0; i-- {
+		for j := len(got) - 1; j >= 0; j-- {
+			if wantKeys[i] == gotKeys[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	feedback := make([]core.DictationToken, 0, len(want)+len(got))
+	i, j := 0, 0
+	for i < len(want) && j < len(got) {
+		switch {
+		case wantKeys[i] == gotKeys[j]:
+			feedback = append(feedback, core.DictationToken{Text: want[i], Op: core.DictationTokenOpMatch})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			feedback = append(feedback, core.DictationToken{Text: want[i], Op: core.DictationTokenOpMissing})
+			i++
+		default:
+			feedback = append(feedback, core.DictationToken{Text: got[j], Op: core.DictationTokenOpExtra})
+			j++
+		}
+	}
+	for ; i < len(want); i++ {
+		feedback = append(feedback, core.DictationToken{Text: want[i], Op: core.DictationTokenOpMissing})
+	}
+	for ; j < len(got); j++ {
+		feedback = append(feedback, core.DictationToken{Text: got[j], Op: core.DictationTokenOpExtra})
+	}
+
+	if len(want) == 0 {
+		return 0, feedback
+	}
+	return float64(lcs[0][0]) / float64(len(want)), feedback
+}
+
+func normalizeDictationWord(word string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '\'' {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, strings.ReplaceAll(word, "’", "'"))
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestDictationService_SubmitDictationAnswer(t *testing.T) {
+	episodeID := uuid.New()
+	seriesRepo := &stubSeriesRepo{
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			return &core.Episode{
+				ID:       id,
+				Resource: core.MediaResource{PlaybackURL: "https://cdn.local/a.mp3"},
+				Transcript: core.Transcript{
+					Format:  core.TranscriptFormatSRT,
+					Content: "1\n00:00:01,000 --> 00:00:03,000\nThe quick brown fox.\n",
+				},
+			}, nil
+		},
+	}
+	attempts := &stubDictationAttemptRepo{}
+	service := NewDictationService(seriesRepo, attempts)
+
+	items, err := service.ListDictationItems(context.Background(), episodeID)
+	if err != nil {
+		t.Fatalf("ListDictationItems() error = %v", err)
+	}
+	if len(items) != 1 || items[0].AudioURL != "https://cdn.local/a.mp3#t=1.000,3.000" || items[0].WordCount != 4 {
+		t.Fatalf("unexpected items %#v", items)
+	}
+
+	attempt, err := service.SubmitDictationAnswer(context.Background(), core.SubmitDictationParams{
+		UserID:    "u1",
+		EpisodeID: episodeID,
+		Answer:    "the quick red fox",
+	})
+	if err != nil {
+		t.Fatalf("SubmitDictationAnswer() error = %v", err)
+	}
+	if attempt.Score != 0.75 {
+		t.Fatalf("expected score 0.75, got %v", attempt.Score)
+	}
+	wantOps := []core.DictationTokenOp{
+		core.DictationTokenOpMatch,
+		core.DictationTokenOpMatch,
+		core.DictationTokenOpMissing,
+		core.DictationTokenOpExtra,
+		core.DictationTokenOpMatch,
+	}
+	if len(attempt.Feedback) != len(wantOps) {
+		t.Fatalf("unexpected feedback %#v", attempt.Feedback)
+	}
+	for i, op := range wantOps {
+		if attempt.Feedback[i].Op != op {
+			t.Fatalf("feedback[%d] = %#v, want op %v", i, attempt.Feedback[i], op)
+		}
+	}
+	if len(attempts.created) != 1 {
+		t.Fatalf("expected attempt to be persisted, got %#v", attempts.created)
+	}
+
+	_, err = service.SubmitDictationAnswer(context.Background(), core.SubmitDictationParams{
+		UserID:    "u1",
+		EpisodeID: episodeID,
+		ItemIndex: 3,
+		Answer:    "anything",
+	})
+	if !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected not found for out-of-range item, got %v", err)
+	}
+}
+
+type stubDictationAttemptRepo struct {
+	created []core.DictationAttempt
+}
+
+func (s *stubDictationAttemptRepo) CreateDictationAttempt(ctx context.Context, attempt core.DictationAttempt) (*core.DictationAttempt, error) {
+	s.created = append(s.created, attempt)
+	return &attempt, nil
+}
+
+func (s *stubDictationAttemptRepo) ListDictationAttempts(ctx context.Context, filter core.DictationAttemptFilter) ([]core.DictationAttempt, string, error) {
+	return s.created, "", nil
+}
@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// minEstimateWords is the shortest transcript, in words, the difficulty of
+// which is estimated; shorter texts say too little about their level.
+const minEstimateWords = 40
+
+// DifficultyService estimates the CEFR level of English transcripts from the
+// share of words outside a basic vocabulary, the length of sentences and
+// the length of words. It is a readability heuristic, good for sorting
+// content into bands rather than for certifying a level.
+type DifficultyService struct {
+	repo core.SeriesRepository
+}
+
+// NewDifficultyService constructs a difficulty service storing estimates in
+// repo.
+func NewDifficultyService(repo core.SeriesRepository) *DifficultyService {
+	return &DifficultyService{repo: repo}
+}
+
+var _ core.DifficultyService = (*DifficultyService)(nil)
+
+// EstimateEpisodeLevel estimates the level of an episode from its current
+// transcript and stores the estimate when it changed.
+func (s *DifficultyService) EstimateEpisodeLevel(ctx context.Context, id uuid.UUID) (string, error) {
+	if id == uuid.Nil {
+		return "", fmt.Errorf("%w: episode id required", core.ErrValidation)
+	}
+	episode, err := s.repo.GetEpisode(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	level := ""
+	if isEnglish(episode.Transcript.Language) {
+		level = estimateCEFRLevel(core.TranscriptText(episode.Transcript))
+	}
+	if level == episode.EstimatedLevel {
+		return level, nil
+	}
+	if _, err := s.repo.SetEpisodeEstimatedLevel(ctx, id, level); err != nil {
+		return "", err
+	}
+	return level, nil
+}
+
+// HandleEpisodeEvent estimates the level of a created or updated episode.
+// Episodes deleted since the event are skipped.
+func (s *DifficultyService) HandleEpisodeEvent(ctx context.Context, event core.Event) error {
+	var id uuid.UUID
+	switch e := event.(type) {
+	case core.EpisodeCreated:
+		id = e.Episode.ID
+	case core.EpisodeUpdated:
+		id = e.Episode.ID
+	default:
+		return nil
+	}
+	_, err := s.EstimateEpisodeLevel(ctx, id)
+	if errors.Is(err, core.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// isEnglish reports whether a transcript language is English. Transcripts
+// without a language are assumed to be.
+func isEnglish(language string) bool {
+	language = strings.ToLower(language)
+	return language == "" || language == "en" || strings.HasPrefix(language, "en-")
+}
+
+// estimateCEFRLevel maps the readability of text to a CEFR level, or returns
+// empty when the text is too short to judge.
+func estimateCEFRLevel(text string) string {
+	stats := measureReadability(text)
+	if stats.words < minEstimateWords {
+		return ""
+	}
+
+	// Each measure is scaled from a typical A1 text (0) to a typical C2 text
+	// (1); the vocabulary and the sentences weigh the most.
+	words := float64(stats.words)
+	score := 0.4*scale(float64(stats.rareWords)/words, 0.15, 0.6) +
+		0.4*scale(words/float64(max(stats.sentences, 1)), 6, 30) +
+		0.2*scale(float64(stats.letters)/words, 3.6, 5.6)
+	return core.CEFRLevels[min(int(score*float64(len(core.CEFRLevels))), len(core.CEFRLevels)-1)]
+}
+
+func scale(value, low, high float64) float64 {
+	return max(0, min(1, (value-low)/(high-low)))
+}
+
+// readability counts what estimateCEFRLevel scores.
+type readability struct {
+	words     int
+	rareWords int
+	letters   int
+	sentences int
+}
+
+func measureReadability(text string) readability {
+	var r readability
+	for _, word := range splitWords(text) {
+		r.words++
+		r.letters += len([]rune(word))
+		if _, ok := basicEnglishWords[word]; !ok {
+			r.rareWords++
+		}
+	}
+
+	// Sentences end at terminal punctuation. Transcripts without any, such
+	// as raw captions, fall back to one sentence per line.
+	separators := ".!?"
+	if !strings.ContainsAny(text, separators) {
+		separators = "\n"
+	}
+	for _, sentence := range strings.FieldsFunc(text, func(c rune) bool { return strings.ContainsRune(separators, c) }) {
+		if strings.IndexFunc(sentence, unicode.IsLetter) >= 0 {
+			r.sentences++
+		}
+	}
+	return r
+}
+
+// splitWords returns the lower-cased words of text. Apostrophes within a
+// word, as in "don't", are kept.
+func splitWords(text string) []string {
+	var words []string
+	for _, word := range strings.FieldsFunc(text, func(c rune) bool { return !unicode.IsLetter(c) && c != '\'' }) {
+		if word = strings.Trim(strings.ToLower(word), "'"); word != "" {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// basicEnglishWords holds the most frequent English words and their common
+// inflections, which learners meet at A1 and A2.
+var basicEnglishWords = func() map[string]struct{} {
+	words := map[string]struct{}{}
+	for _, word := range strings.Fields(`
+		a about above after again ago all also always am an and angry animal another answer any anything
+		are aren't around as ask asked at away back bad bag be beautiful because bed been before began
+		begin behind best better between big bike bird black blue book both box boy bread breakfast
+		brother brown bus busy but buy by cake call called came can can't car cat chair child children
+		city class clean close clothes coffee cold colour come comes coming computer cook could couldn't
+		country cup dad day dear did didn't different dinner do does doesn't dog doing don't door down
+		drink drive each early easy eat egg eight end evening every everyone everything eye eyes face
+		family far fast father feel few find fine first fish five floor food for four friend friends
+		from front fruit full fun game garden gave get gets getting girl give go goes going gone good got
+		great green had hair half hand happy has hat have haven't he he's head hear hello help her here
+		hers hi him his home horse hot hotel hour house how hungry i i'd i'll i'm i've if in into is
+		isn't it it's its job just key kind kitchen know last late learn left leg less let let's letter
+		like likes little live lives long look looking lot love lunch made make man many map may me meat
+		meet milk minute mine money month more morning most mother much mum music must my name near need
+		never new next nice night nine no not nothing now number of off often oh ok okay old on once one
+		only open or orange other our out over page park party pen people person phone picture place
+		play please pretty put question quick quiet rain read ready really red right room run said same
+		saw say school sea second see seven she she's shoe shop short should sister sit six sleep slow
+		small so some someone something sometimes son soon sorry speak sport start stop story street
+		student study sun sure swim table take talk tea teacher tell ten than thank thanks that that's
+		the their them then there there's these they they're thing things think this those three time
+		tired to today together told tomorrow too took town train tree two under up us use very visit
+		wait walk want wanted was wasn't watch water way we we're weather week well went were weren't
+		what what's when where which white who why will window with woman women won't word words work
+		world would wouldn't write year years yellow yes yesterday you you're young your yours
+	`) {
+		words[word] = struct{}{}
+	}
+	return words
+}()
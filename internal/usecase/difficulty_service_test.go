@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	beginnerText = `Hi, I'm Tom. I live in a small house with my mum and dad. I have a dog.
+His name is Max. Max is black and white. Every morning I eat bread and drink milk.
+Then I go to school by bus. My teacher is nice. I like my school. After school I play with my friends in the park.`
+
+	intermediateText = `Last summer my family decided to spend two weeks travelling around the coast,
+which turned out to be more complicated than we had expected. The train was delayed for several hours,
+so we missed our connection and had to find somewhere to stay overnight. Fortunately, the receptionist
+at a small hotel near the station recommended a restaurant where we enjoyed a wonderful seafood dinner.`
+
+	advancedText = `Notwithstanding the considerable methodological limitations inherent in longitudinal
+observational research, the accumulating empirical evidence substantiates the hypothesis that sustained
+exposure to comprehensible linguistic input, rather than explicit grammatical instruction, constitutes
+the predominant mechanism underlying successful second language acquisition, particularly among
+adolescents whose metalinguistic awareness remains comparatively undeveloped.`
+)
+
+func TestEstimateCEFRLevel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "too short", text: "Hello, my name is Tom.", want: ""},
+		{name: "beginner", text: beginnerText, want: "A1"},
+		{name: "intermediate", text: intermediateText, want: "B2"},
+		{name: "advanced", text: advancedText, want: "C2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := estimateCEFRLevel(tt.text); got != tt.want {
+				t.Fatalf("estimateCEFRLevel() = %q, want %q (%+v)", got, tt.want, measureReadability(tt.text))
+			}
+		})
+	}
+}
+
+func TestDifficultyService_EstimateEpisodeLevel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	episode := core.Episode{
+		ID:         uuid.New(),
+		SeriesID:   uuid.New(),
+		Transcript: core.Transcript{Language: "en-GB", Format: core.TranscriptFormatPlain, Content: beginnerText},
+	}
+	var stored []string
+	repo := &stubSeriesRepo{
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			if id != episode.ID {
+				return nil, core.ErrNotFound
+			}
+			result := episode
+			return &result, nil
+		},
+		setEpisodeEstimatedLevelFn: func(ctx context.Context, id uuid.UUID, level string) (*core.Episode, error) {
+			stored = append(stored, level)
+			episode.EstimatedLevel = level
+			result := episode
+			return &result, nil
+		},
+	}
+	svc := NewDifficultyService(repo)
+
+	level, err := svc.EstimateEpisodeLevel(ctx, episode.ID)
+	if err != nil || level != "A1" {
+		t.Fatalf("EstimateEpisodeLevel() = %q, %v", level, err)
+	}
+	// An unchanged estimate is not written again.
+	if err := svc.HandleEpisodeEvent(ctx, core.EpisodeUpdated{Episode: episode}); err != nil {
+		t.Fatalf("HandleEpisodeEvent() error = %v", err)
+	}
+	if len(stored) != 1 {
+		t.Fatalf("expected one write, got %q", stored)
+	}
+
+	// Other languages are not estimated, which clears the old estimate.
+	episode.Transcript.Language = "es"
+	if level, err := svc.EstimateEpisodeLevel(ctx, episode.ID); err != nil || level != "" {
+		t.Fatalf("EstimateEpisodeLevel() = %q, %v", level, err)
+	}
+	if len(stored) != 2 || stored[1] != "" {
+		t.Fatalf("expected the estimate to be cleared, got %q", stored)
+	}
+
+	if err := svc.HandleEpisodeEvent(ctx, core.EpisodeCreated{Episode: core.Episode{ID: uuid.New()}}); err != nil {
+		t.Fatalf("expected deleted episodes to be skipped, got %v", err)
+	}
+	if _, err := svc.EstimateEpisodeLevel(ctx, uuid.Nil); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected ErrValidation, got %v", err)
+	}
+}
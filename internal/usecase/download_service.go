@@ -0,0 +1,321 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// defaultDownloadTTL is how long the file URLs of a download bundle work.
+const defaultDownloadTTL = 24 * time.Hour
+
+// downloadFilePaths names the bundle files in their URLs, which the download
+// HTTP handler serves at /downloads/v1/episodes/{episode_id}/{file}.
+var downloadFilePaths = map[core.DownloadFileKind]string{
+	core.DownloadFileKindMedia:      "media",
+	core.DownloadFileKindTranscript: "transcript",
+	core.DownloadFileKindVocabulary: "vocabulary",
+}
+
+// transcriptFileTypes gives the file extension and MIME type of each
+// transcript format.
+var transcriptFileTypes = map[core.TranscriptFormat][2]string{
+	core.TranscriptFormatPlain:    {".txt", "text/plain; charset=utf-8"},
+	core.TranscriptFormatMarkdown: {".md", "text/markdown; charset=utf-8"},
+	core.TranscriptFormatSRT:      {".srt", "application/x-subrip"},
+	core.TranscriptFormatJSON:     {".json", "application/json"},
+}
+
+// DownloadService signs the files of published episodes so mobile clients
+// can fetch them for offline playback without credentials. File URLs carry
+// an expiry and an HMAC-SHA256 signature over the episode, file, translation
+// language and expiry.
+type DownloadService struct {
+	series        core.SeriesRepository
+	assets        core.AssetRepository
+	subscriptions core.SubscriptionService
+	dictionary    core.DictionaryProvider
+	cdn           core.CDNService
+	usage         core.AssetUsageService
+	key           []byte
+	baseURL       string
+	ttl           time.Duration
+	now           func() time.Time
+}
+
+// NewDownloadService constructs a download service that signs URLs under
+// baseURL with key.
+func NewDownloadService(series core.SeriesRepository, assets core.AssetRepository, subscriptions core.SubscriptionService, key []byte, baseURL string) *DownloadService {
+	return &DownloadService{
+		series:        series,
+		assets:        assets,
+		subscriptions: subscriptions,
+		key:           key,
+		baseURL:       strings.TrimRight(baseURL, "/"),
+		ttl:           defaultDownloadTTL,
+		now:           time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *DownloadService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+// WithTTL changes how long bundle URLs work.
+func (s *DownloadService) WithTTL(ttl time.Duration) {
+	if ttl > 0 {
+		s.ttl = ttl
+	}
+}
+
+// WithDictionary adds dictionary entries to the vocabulary file.
+func (s *DownloadService) WithDictionary(dictionary core.DictionaryProvider) {
+	s.dictionary = dictionary
+}
+
+// WithCDN redirects media downloads to the CDN.
+func (s *DownloadService) WithCDN(cdn core.CDNService) {
+	s.cdn = cdn
+}
+
+// WithAssetUsage counts the media downloads of each asset.
+func (s *DownloadService) WithAssetUsage(usage core.AssetUsageService) {
+	s.usage = usage
+}
+
+var _ core.DownloadService = (*DownloadService)(nil)
+
+// CreateDownloadBundle signs the media, transcript and vocabulary of a
+// published episode. Files the episode has no content for are left out.
+func (s *DownloadService) CreateDownloadBundle(ctx context.Context, episodeID uuid.UUID, translationLanguage string) (*core.DownloadBundle, error) {
+	if episodeID == uuid.Nil {
+		return nil, fmt.Errorf("%w: episode id required", core.ErrValidation)
+	}
+	episode, err := s.series.GetEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+	if !isPackageable(*episode) {
+		return nil, fmt.Errorf("%w: only playable published episodes can be downloaded", core.ErrInvalidState)
+	}
+	if err := s.checkEntitlement(ctx, *episode); err != nil {
+		return nil, err
+	}
+
+	bundle := &core.DownloadBundle{
+		EpisodeID:           episode.ID,
+		SeriesID:            episode.SeriesID,
+		Title:               episode.Title,
+		Version:             episode.UpdatedAt,
+		TranslationLanguage: strings.TrimSpace(translationLanguage),
+		ExpiresAt:           s.now().UTC().Add(s.ttl).Truncate(time.Second),
+	}
+	for _, kind := range []core.DownloadFileKind{core.DownloadFileKindMedia, core.DownloadFileKindTranscript, core.DownloadFileKindVocabulary} {
+		content, size, err := s.downloadFile(ctx, *episode, kind, bundle.TranslationLanguage)
+		if err != nil {
+			return nil, err
+		}
+		if content == nil {
+			continue
+		}
+		bundle.Files = append(bundle.Files, core.DownloadFile{
+			Kind:     kind,
+			Name:     content.Name,
+			MimeType: content.MimeType,
+			Size:     size,
+			URL:      s.signedURL(episode.ID, kind, bundle.TranslationLanguage, bundle.ExpiresAt),
+		})
+	}
+	return bundle, nil
+}
+
+// OpenDownloadFile returns a file of a bundle requested through its signed
+// URL. The episode must still be published.
+func (s *DownloadService) OpenDownloadFile(ctx context.Context, req core.DownloadFileRequest) (*core.DownloadFileContent, error) {
+	if _, ok := downloadFilePaths[req.Kind]; !ok {
+		return nil, fmt.Errorf("%w: unknown download file", core.ErrValidation)
+	}
+	want := s.sign(req.EpisodeID, req.Kind, req.TranslationLanguage, req.ExpiresAt)
+	if !hmac.Equal([]byte(req.Signature), []byte(want)) {
+		return nil, fmt.Errorf("%w: invalid download signature", core.ErrPermissionDenied)
+	}
+	if !s.now().Before(req.ExpiresAt) {
+		return nil, fmt.Errorf("%w: download link expired", core.ErrPermissionDenied)
+	}
+
+	episode, err := s.series.GetEpisode(ctx, req.EpisodeID)
+	if err != nil {
+		return nil, err
+	}
+	if !isPackageable(*episode) {
+		return nil, fmt.Errorf("%w: episode is no longer available", core.ErrNotFound)
+	}
+	content, _, err := s.downloadFile(ctx, *episode, req.Kind, req.TranslationLanguage)
+	if err != nil {
+		return nil, err
+	}
+	if content == nil {
+		return nil, fmt.Errorf("%w: episode has no %s", core.ErrNotFound, downloadFilePaths[req.Kind])
+	}
+	// Like usage metering, counting the download is best-effort.
+	if s.usage != nil && req.Kind == core.DownloadFileKindMedia && episode.Resource.AssetID != uuid.Nil {
+		_ = s.usage.RecordAssetAccess(context.WithoutCancel(ctx), episode.Resource.AssetID, core.AssetAccessKindDownload)
+	}
+	return content, nil
+}
+
+// checkEntitlement lets anyone download previews and requires an active
+// subscription for the other episodes.
+func (s *DownloadService) checkEntitlement(ctx context.Context, episode core.Episode) error {
+	if episode.Preview {
+		return nil
+	}
+	caller, ok := core.CallerFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("%w: episode %s is not a preview", core.ErrSubscriptionRequired, episode.ID)
+	}
+	if _, err := s.subscriptions.ActiveSubscription(ctx, caller.UserID); err != nil {
+		if errors.Is(err, core.ErrNotFound) {
+			return fmt.Errorf("%w: episode %s is not a preview", core.ErrSubscriptionRequired, episode.ID)
+		}
+		return err
+	}
+	return nil
+}
+
+// downloadFile builds a bundle file of the episode along with its size. It
+// returns nil when the episode has nothing to put in the file.
+func (s *DownloadService) downloadFile(ctx context.Context, episode core.Episode, kind core.DownloadFileKind, translationLanguage string) (*core.DownloadFileContent, int64, error) {
+	switch kind {
+	case core.DownloadFileKindMedia:
+		content := &core.DownloadFileContent{
+			Name:        "media",
+			MimeType:    episode.Resource.MimeType,
+			RedirectURL: episode.Resource.PlaybackURL,
+		}
+		if s.cdn != nil {
+			content.RedirectURL = s.cdn.RewriteURL(content.RedirectURL)
+		}
+		var size int64
+		if episode.Resource.AssetID != uuid.Nil {
+			asset, err := s.assets.GetAssetByID(ctx, episode.Resource.AssetID)
+			if err != nil && !isNotFound(err) {
+				return nil, 0, err
+			}
+			if asset != nil {
+				content.Name += path.Ext(asset.OriginalFilename)
+				size = asset.Filesize
+			}
+		}
+		return content, size, nil
+	case core.DownloadFileKindTranscript:
+		if strings.TrimSpace(episode.Transcript.Content) == "" {
+			return nil, 0, nil
+		}
+		fileType, ok := transcriptFileTypes[episode.Transcript.Format]
+		if !ok {
+			fileType = transcriptFileTypes[core.TranscriptFormatPlain]
+		}
+		data := []byte(episode.Transcript.Content)
+		return &core.DownloadFileContent{Name: "transcript" + fileType[0], MimeType: fileType[1], Data: data}, int64(len(data)), nil
+	case core.DownloadFileKindVocabulary:
+		data, err := s.vocabularyFile(ctx, episode, translationLanguage)
+		if err != nil || data == nil {
+			return nil, 0, err
+		}
+		return &core.DownloadFileContent{Name: "vocabulary.json", MimeType: "application/json", Data: data}, int64(len(data)), nil
+	default:
+		return nil, 0, fmt.Errorf("%w: unknown download file", core.ErrValidation)
+	}
+}
+
+// downloadVocabulary is the JSON document of the vocabulary file.
+type downloadVocabulary struct {
+	EpisodeID           string                   `json:"episode_id"`
+	Language            string                   `json:"language,omitempty"`
+	TranslationLanguage string                   `json:"translation_language,omitempty"`
+	Words               []downloadVocabularyWord `json:"words"`
+}
+
+type downloadVocabularyWord struct {
+	Word         string `json:"word"`
+	Count        int    `json:"count"`
+	Lemma        string `json:"lemma,omitempty"`
+	PartOfSpeech string `json:"part_of_speech,omitempty"`
+	Definition   string `json:"definition,omitempty"`
+	Translation  string `json:"translation,omitempty"`
+}
+
+// vocabularyFile lists the most frequent words of the transcript, leaving
+// out basic English, with their dictionary entries when a dictionary is
+// configured.
+func (s *DownloadService) vocabularyFile(ctx context.Context, episode core.Episode, translationLanguage string) ([]byte, error) {
+	stats := episodeTextStats(episode, core.TextStatsOptions{
+		TopWords:           maxTopWords,
+		ExcludeCommonWords: isEnglish(episode.Transcript.Language),
+	})
+	if len(stats.TopWords) == 0 {
+		return nil, nil
+	}
+
+	var entries map[string]core.DictionaryEntry
+	if s.dictionary != nil {
+		words := lo.Map(stats.TopWords, func(word core.WordFrequency, _ int) string { return word.Word })
+		var err error
+		entries, err = s.dictionary.LookupWords(ctx, episode.Transcript.Language, translationLanguage, words)
+		if err != nil {
+			return nil, fmt.Errorf("look up vocabulary: %w", err)
+		}
+	}
+
+	return json.Marshal(downloadVocabulary{
+		EpisodeID:           episode.ID.String(),
+		Language:            episode.Transcript.Language,
+		TranslationLanguage: translationLanguage,
+		Words: lo.Map(stats.TopWords, func(word core.WordFrequency, _ int) downloadVocabularyWord {
+			entry := entries[word.Word]
+			return downloadVocabularyWord{
+				Word:         word.Word,
+				Count:        word.Count,
+				Lemma:        entry.Lemma,
+				PartOfSpeech: entry.PartOfSpeech,
+				Definition:   entry.Definition,
+				Translation:  entry.Translation,
+			}
+		}),
+	})
+}
+
+func (s *DownloadService) signedURL(episodeID uuid.UUID, kind core.DownloadFileKind, translationLanguage string, expiresAt time.Time) string {
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expiresAt.Unix(), 10))
+	if translationLanguage != "" {
+		query.Set("lang", translationLanguage)
+	}
+	query.Set("signature", s.sign(episodeID, kind, translationLanguage, expiresAt))
+	return fmt.Sprintf("%s/downloads/v1/episodes/%s/%s?%s", s.baseURL, episodeID, downloadFilePaths[kind], query.Encode())
+}
+
+// sign returns the base64url-encoded HMAC-SHA256 of a file request.
+func (s *DownloadService) sign(episodeID uuid.UUID, kind core.DownloadFileKind, translationLanguage string, expiresAt time.Time) string {
+	mac := hmac.New(sha256.New, s.key)
+	fmt.Fprintf(mac, "%s\n%s\n%s\n%d", episodeID, downloadFilePaths[kind], translationLanguage, expiresAt.Unix())
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestDownloadService_CreateAndOpen(t *testing.T) {
+	fixedNow := time.Date(2024, 5, 15, 15, 0, 0, 0, time.UTC)
+	episode := core.Episode{
+		ID:       uuid.New(),
+		SeriesID: uuid.New(),
+		Title:    "Ordering coffee",
+		Status:   core.EpisodeStatusPublished,
+		Preview:  true,
+		Resource: core.MediaResource{
+			AssetID:     uuid.New(),
+			PlaybackURL: "https://cdn.example.com/coffee.mp3",
+			MimeType:    "audio/mpeg",
+		},
+		Transcript: core.Transcript{
+			Language: "en",
+			Format:   core.TranscriptFormatPlain,
+			Content:  "The barista poured an espresso. The espresso was strong.",
+		},
+		UpdatedAt: fixedNow.Add(-time.Hour),
+	}
+	seriesRepo := &stubSeriesRepo{
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			if id != episode.ID {
+				return nil, core.ErrNotFound
+			}
+			found := episode
+			return &found, nil
+		},
+	}
+	assets := &stubAssetRepo{
+		getAssetByIDFn: func(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
+			return &core.Asset{ID: id, OriginalFilename: "coffee.mp3", Filesize: 2048}, nil
+		},
+	}
+	dictionary := &stubDictionaryProvider{entries: map[string]core.DictionaryEntry{
+		"espresso": {Word: "espresso", Definition: "strong black coffee", Translation: "浓缩咖啡"},
+	}}
+	service := NewDownloadService(seriesRepo, assets, NewSubscriptionService(newStubSubscriptionRepo()), []byte("secret"), "https://api.example.com/")
+	service.WithClock(func() time.Time { return fixedNow })
+	service.WithDictionary(dictionary)
+	usageRepo := newStubAssetUsageRepo()
+	usage := NewAssetUsageService(usageRepo, assets)
+	usage.WithClock(func() time.Time { return fixedNow })
+	service.WithAssetUsage(usage)
+
+	bundle, err := service.CreateDownloadBundle(context.Background(), episode.ID, "zh")
+	if err != nil {
+		t.Fatalf("CreateDownloadBundle() error = %v", err)
+	}
+	if !bundle.ExpiresAt.Equal(fixedNow.Add(defaultDownloadTTL)) || !bundle.Version.Equal(episode.UpdatedAt) {
+		t.Fatalf("unexpected bundle %#v", bundle)
+	}
+	if len(bundle.Files) != 3 {
+		t.Fatalf("expected media, transcript and vocabulary files, got %#v", bundle.Files)
+	}
+	media, transcript, vocabulary := bundle.Files[0], bundle.Files[1], bundle.Files[2]
+	if media.Name != "media.mp3" || media.Size != 2048 || media.MimeType != "audio/mpeg" {
+		t.Fatalf("unexpected media file %#v", media)
+	}
+	if transcript.Name != "transcript.txt" || transcript.Size != int64(len(episode.Transcript.Content)) {
+		t.Fatalf("unexpected transcript file %#v", transcript)
+	}
+	if !strings.HasPrefix(vocabulary.URL, "https://api.example.com/downloads/v1/episodes/"+episode.ID.String()+"/vocabulary?") {
+		t.Fatalf("unexpected vocabulary url %q", vocabulary.URL)
+	}
+
+	open := func(file core.DownloadFile) (*core.DownloadFileContent, error) {
+		t.Helper()
+		u, err := url.Parse(file.URL)
+		if err != nil {
+			t.Fatalf("parse url: %v", err)
+		}
+		req := core.DownloadFileRequest{
+			EpisodeID:           episode.ID,
+			Kind:                file.Kind,
+			TranslationLanguage: u.Query().Get("lang"),
+			ExpiresAt:           bundle.ExpiresAt,
+			Signature:           u.Query().Get("signature"),
+		}
+		return service.OpenDownloadFile(context.Background(), req)
+	}
+
+	content, err := open(media)
+	if err != nil || content.RedirectURL != episode.Resource.PlaybackURL {
+		t.Fatalf("OpenDownloadFile(media) = %#v, %v", content, err)
+	}
+	content, err = open(vocabulary)
+	if err != nil {
+		t.Fatalf("OpenDownloadFile(vocabulary) error = %v", err)
+	}
+	if counts := usageRepo.counts[assetUsageKey{episode.Resource.AssetID, truncateDay(fixedNow)}]; counts.Downloads != 1 {
+		t.Fatalf("expected only the media download to be counted, got %+v", counts)
+	}
+	var words downloadVocabulary
+	if err := json.Unmarshal(content.Data, &words); err != nil {
+		t.Fatalf("decode vocabulary: %v", err)
+	}
+	if words.TranslationLanguage != "zh" || len(words.Words) == 0 || words.Words[0].Word != "espresso" || words.Words[0].Translation != "浓缩咖啡" {
+		t.Fatalf("unexpected vocabulary %#v", words)
+	}
+
+	tampered := transcript
+	tampered.Kind = core.DownloadFileKindVocabulary
+	if _, err := open(tampered); !errors.Is(err, core.ErrPermissionDenied) {
+		t.Fatalf("expected a transcript signature to be rejected for another file, got %v", err)
+	}
+	service.WithClock(func() time.Time { return bundle.ExpiresAt })
+	if _, err := open(transcript); !errors.Is(err, core.ErrPermissionDenied) {
+		t.Fatalf("expected expired link to be rejected, got %v", err)
+	}
+}
+
+func TestDownloadService_CreateDownloadBundleEntitlement(t *testing.T) {
+	episode := core.Episode{
+		ID:       uuid.New(),
+		Status:   core.EpisodeStatusPublished,
+		Resource: core.MediaResource{PlaybackURL: "https://cdn.example.com/coffee.mp3"},
+	}
+	seriesRepo := &stubSeriesRepo{
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			found := episode
+			return &found, nil
+		},
+	}
+	service := NewDownloadService(seriesRepo, &stubAssetRepo{}, NewSubscriptionService(newStubSubscriptionRepo()), []byte("secret"), "")
+
+	ctx := core.NewCallerContext(context.Background(), core.Caller{UserID: "u1"})
+	if _, err := service.CreateDownloadBundle(ctx, episode.ID, ""); !errors.Is(err, core.ErrSubscriptionRequired) {
+		t.Fatalf("expected subscription to be required, got %v", err)
+	}
+
+	episode.Preview = true
+	episode.Status = core.EpisodeStatusDraft
+	if _, err := service.CreateDownloadBundle(ctx, episode.ID, ""); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected drafts to be refused, got %v", err)
+	}
+
+	episode.Status = core.EpisodeStatusPublished
+	bundle, err := service.CreateDownloadBundle(ctx, episode.ID, "")
+	if err != nil {
+		t.Fatalf("CreateDownloadBundle() error = %v", err)
+	}
+	if len(bundle.Files) != 1 || bundle.Files[0].Kind != core.DownloadFileKindMedia {
+		t.Fatalf("expected only the media of an episode without transcript, got %#v", bundle.Files)
+	}
+}
@@ -0,0 +1,243 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// defaultEmbedTokenTTL is how long embed tokens work unless asked otherwise.
+	defaultEmbedTokenTTL = 24 * time.Hour
+	// defaultEmbedTokenMaxTTL bounds the lifetime publishers can ask for.
+	defaultEmbedTokenMaxTTL = 30 * 24 * time.Hour
+	maxEmbedDomains         = 20
+)
+
+// embedDomainPattern matches lowercase host names without a scheme, port or path.
+var embedDomainPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*$`)
+
+// embedClaims is the signed payload of an embed token.
+type embedClaims struct {
+	EpisodeID uuid.UUID `json:"e"`
+	ExpiresAt int64     `json:"x"`
+	Domains   []string  `json:"d,omitempty"`
+}
+
+// EmbedService issues stateless embed tokens: a base64url JSON payload naming
+// the episode, expiry and allowed domains, and its base64url HMAC-SHA256
+// signature, joined by a dot. Tokens cannot be revoked; unpublishing the
+// episode stops them working.
+type EmbedService struct {
+	series   core.SeriesRepository
+	key      []byte
+	embedURL string
+	maxTTL   time.Duration
+	usage    core.AssetUsageService
+	now      func() time.Time
+}
+
+// NewEmbedService constructs an embed service signing tokens with key.
+// embedURL is the template of the player page, which may be empty.
+func NewEmbedService(series core.SeriesRepository, key []byte, embedURL string) *EmbedService {
+	return &EmbedService{
+		series:   series,
+		key:      key,
+		embedURL: embedURL,
+		maxTTL:   defaultEmbedTokenMaxTTL,
+		now:      time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *EmbedService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+// WithMaxTTL changes the longest lifetime a token can be issued with.
+func (s *EmbedService) WithMaxTTL(ttl time.Duration) {
+	if ttl > 0 {
+		s.maxTTL = ttl
+	}
+}
+
+// WithAssetUsage counts the playback URLs handed to embedded players.
+func (s *EmbedService) WithAssetUsage(usage core.AssetUsageService) {
+	s.usage = usage
+}
+
+var _ core.EmbedService = (*EmbedService)(nil)
+
+// CreateEmbedToken issues a token for a published episode to an author of
+// its series, or an API key scoped to the series. Episodes of series with
+// DRM cannot be embedded, as their keys need a signed-in learner.
+func (s *EmbedService) CreateEmbedToken(ctx context.Context, req core.EmbedTokenRequest) (*core.EmbedToken, error) {
+	caller, ok := core.CallerFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: sign in to create embed tokens", core.ErrUnauthenticated)
+	}
+	if req.EpisodeID == uuid.Nil {
+		return nil, fmt.Errorf("%w: episode id required", core.ErrValidation)
+	}
+	ttl := req.TTL
+	if ttl == 0 {
+		ttl = defaultEmbedTokenTTL
+	}
+	if ttl < 0 || ttl > s.maxTTL {
+		return nil, fmt.Errorf("%w: ttl must be positive and at most %s", core.ErrValidation, s.maxTTL)
+	}
+	domains, err := normalizeEmbedDomains(req.AllowedDomains)
+	if err != nil {
+		return nil, err
+	}
+
+	series, episode, err := s.publishedEpisode(ctx, req.EpisodeID)
+	if err != nil {
+		return nil, err
+	}
+	if !caller.ManagesSeries(*series) {
+		return nil, fmt.Errorf("%w: only the publisher of series %s can embed its episodes", core.ErrPermissionDenied, series.ID)
+	}
+	if series.DRMEnabled {
+		return nil, fmt.Errorf("%w: episodes of series with DRM cannot be embedded", core.ErrInvalidState)
+	}
+
+	claims := embedClaims{
+		EpisodeID: episode.ID,
+		ExpiresAt: s.now().Add(ttl).Unix(),
+		Domains:   domains,
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return nil, err
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	token := &core.EmbedToken{
+		Token:          encoded + "." + s.sign(encoded),
+		EpisodeID:      episode.ID,
+		AllowedDomains: domains,
+		ExpiresAt:      time.Unix(claims.ExpiresAt, 0).UTC(),
+	}
+	if s.embedURL != "" {
+		token.EmbedURL = withQueryParam(expandPageURL(s.embedURL, *series, *episode), "token", token.Token)
+	}
+	return token, nil
+}
+
+// EmbedPlayback returns the episode of a valid token with its playback URL.
+// Domain-locked tokens need the request to come from an allowed domain, as
+// reported by the caller; the lock keeps other sites from embedding the
+// player but anyone holding the token can claim an allowed domain.
+func (s *EmbedService) EmbedPlayback(ctx context.Context, token, domain string) (*core.EmbedPlayback, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(s.sign(encoded))) {
+		return nil, fmt.Errorf("%w: invalid embed token", core.ErrPermissionDenied)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid embed token", core.ErrPermissionDenied)
+	}
+	var claims embedClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("%w: invalid embed token", core.ErrPermissionDenied)
+	}
+	expiresAt := time.Unix(claims.ExpiresAt, 0).UTC()
+	if !s.now().Before(expiresAt) {
+		return nil, fmt.Errorf("%w: embed token expired", core.ErrPermissionDenied)
+	}
+	if len(claims.Domains) > 0 && !embedDomainAllowed(claims.Domains, domain) {
+		return nil, fmt.Errorf("%w: embed token is not valid on %q", core.ErrPermissionDenied, domain)
+	}
+
+	_, episode, err := s.publishedEpisode(ctx, claims.EpisodeID)
+	if err != nil {
+		return nil, err
+	}
+	public := publicEpisode(*episode)
+	public.Resource.PlaybackURL = episode.Resource.PlaybackURL
+	// Like usage metering, counting the access is best-effort.
+	if s.usage != nil && episode.Resource.AssetID != uuid.Nil {
+		_ = s.usage.RecordAssetAccess(context.WithoutCancel(ctx), episode.Resource.AssetID, core.AssetAccessKindPlaybackURL)
+	}
+	return &core.EmbedPlayback{Episode: public, ExpiresAt: expiresAt}, nil
+}
+
+// publishedEpisode loads a published episode and its published series;
+// anything else is reported as not found.
+func (s *EmbedService) publishedEpisode(ctx context.Context, id uuid.UUID) (*core.Series, *core.Episode, error) {
+	episode, err := s.series.GetEpisode(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !episodePublished(*episode) {
+		return nil, nil, fmt.Errorf("%w: episode %s", core.ErrNotFound, id)
+	}
+	series, err := s.series.GetSeries(ctx, episode.SeriesID, core.SeriesQueryOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	if series.Status != core.SeriesStatusPublished {
+		return nil, nil, fmt.Errorf("%w: episode %s", core.ErrNotFound, id)
+	}
+	return series, episode, nil
+}
+
+// sign returns the base64url-encoded HMAC-SHA256 of an encoded payload.
+func (s *EmbedService) sign(encoded string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(encoded))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// normalizeEmbedDomains lowercases and deduplicates allowed domains.
+func normalizeEmbedDomains(domains []string) ([]string, error) {
+	if len(domains) > maxEmbedDomains {
+		return nil, fmt.Errorf("%w: at most %d allowed domains", core.ErrValidation, maxEmbedDomains)
+	}
+	normalized := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if !embedDomainPattern.MatchString(domain) {
+			return nil, fmt.Errorf("%w: invalid allowed domain %q", core.ErrValidation, domain)
+		}
+		normalized = append(normalized, domain)
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
+
+// embedDomainAllowed reports whether domain is one of allowed or a subdomain of one.
+func embedDomainAllowed(allowed []string, domain string) bool {
+	domain = strings.ToLower(domain)
+	return lo.SomeBy(allowed, func(candidate string) bool {
+		return domain == candidate || strings.HasSuffix(domain, "."+candidate)
+	})
+}
+
+// withQueryParam sets a query parameter on a URL, or returns it unchanged when
+// it does not parse.
+func withQueryParam(raw, key, value string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	query := u.Query()
+	query.Set(key, value)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
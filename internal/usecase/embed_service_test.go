@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestEmbedService(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	series := core.Series{ID: uuid.New(), Status: core.SeriesStatusPublished, AuthorIDs: []string{"author-1"}}
+	episode := core.Episode{
+		ID:       uuid.New(),
+		SeriesID: series.ID,
+		Status:   core.EpisodeStatusPublished,
+		Resource: core.MediaResource{AssetID: uuid.New(), PlaybackURL: "https://media.example.com/1.m3u8"},
+	}
+	repo := &stubSeriesRepo{
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			if id != episode.ID {
+				return nil, core.ErrNotFound
+			}
+			found := episode
+			return &found, nil
+		},
+		getSeriesFn: func(ctx context.Context, id uuid.UUID, opts core.SeriesQueryOptions) (*core.Series, error) {
+			found := series
+			return &found, nil
+		},
+	}
+	service := NewEmbedService(repo, []byte("secret"), "https://example.com/embed/{episode_id}")
+	service.WithClock(func() time.Time { return now })
+	ctx := core.NewCallerContext(context.Background(), core.Caller{UserID: "author-1"})
+
+	token, err := service.CreateEmbedToken(ctx, core.EmbedTokenRequest{
+		EpisodeID:      episode.ID,
+		AllowedDomains: []string{"Blog.Example.org", "blog.example.org"},
+		TTL:            time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("CreateEmbedToken() error = %v", err)
+	}
+	if !token.ExpiresAt.Equal(now.Add(time.Hour)) || len(token.AllowedDomains) != 1 || token.AllowedDomains[0] != "blog.example.org" {
+		t.Fatalf("unexpected token %+v", token)
+	}
+	if token.EmbedURL != "https://example.com/embed/"+episode.ID.String()+"?token="+token.Token {
+		t.Fatalf("unexpected embed url %q", token.EmbedURL)
+	}
+
+	playback, err := service.EmbedPlayback(ctx, token.Token, "www.blog.example.org")
+	if err != nil {
+		t.Fatalf("EmbedPlayback() error = %v", err)
+	}
+	if playback.Episode.Resource.PlaybackURL != episode.Resource.PlaybackURL || playback.Episode.Resource.AssetID != uuid.Nil {
+		t.Fatalf("unexpected playback %+v", playback.Episode.Resource)
+	}
+
+	for name, domain := range map[string]string{"other domain": "evil.example", "suffix lookalike": "notblog.example.org", "no domain": ""} {
+		if _, err := service.EmbedPlayback(ctx, token.Token, domain); !errors.Is(err, core.ErrPermissionDenied) {
+			t.Fatalf("%s: expected ErrPermissionDenied, got %v", name, err)
+		}
+	}
+	payload, signature, _ := strings.Cut(token.Token, ".")
+	if _, err := service.EmbedPlayback(ctx, payload+"x."+signature, "blog.example.org"); !errors.Is(err, core.ErrPermissionDenied) {
+		t.Fatalf("expected a tampered token to be rejected, got %v", err)
+	}
+
+	anywhere, err := service.CreateEmbedToken(ctx, core.EmbedTokenRequest{EpisodeID: episode.ID})
+	if err != nil {
+		t.Fatalf("CreateEmbedToken() without domains error = %v", err)
+	}
+	if !anywhere.ExpiresAt.Equal(now.Add(defaultEmbedTokenTTL)) {
+		t.Fatalf("expected the default ttl, got %v", anywhere.ExpiresAt)
+	}
+	if _, err := service.EmbedPlayback(ctx, anywhere.Token, ""); err != nil {
+		t.Fatalf("expected a token without domains to work anywhere, got %v", err)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if _, err := service.EmbedPlayback(ctx, token.Token, "blog.example.org"); !errors.Is(err, core.ErrPermissionDenied) {
+		t.Fatalf("expected an expired token to be rejected, got %v", err)
+	}
+
+	episode.Status = core.EpisodeStatusDraft
+	if _, err := service.EmbedPlayback(ctx, anywhere.Token, ""); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected an unpublished episode to stop playing, got %v", err)
+	}
+	episode.Status = core.EpisodeStatusPublished
+
+	if _, err := service.CreateEmbedToken(ctx, core.EmbedTokenRequest{EpisodeID: episode.ID, TTL: 365 * 24 * time.Hour}); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected a ttl over the maximum to fail, got %v", err)
+	}
+	if _, err := service.CreateEmbedToken(ctx, core.EmbedTokenRequest{EpisodeID: episode.ID, AllowedDomains: []string{"https://example.com"}}); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected a url as domain to fail, got %v", err)
+	}
+
+	for name, caller := range map[string]context.Context{
+		"anonymous":             context.Background(),
+		"another user":          core.NewCallerContext(context.Background(), core.Caller{UserID: "reader-1"}),
+		"key of another series": core.NewCallerContext(context.Background(), core.Caller{UserID: "apikey:1", APIKeyScopes: []string{"lession.v1.EmbedService", "series:" + uuid.NewString()}}),
+	} {
+		if _, err := service.CreateEmbedToken(caller, core.EmbedTokenRequest{EpisodeID: episode.ID}); !errors.Is(err, core.ErrUnauthenticated) && !errors.Is(err, core.ErrPermissionDenied) {
+			t.Fatalf("%s: expected the token to be refused, got %v", name, err)
+		}
+	}
+	scoped := core.NewCallerContext(context.Background(), core.Caller{UserID: "apikey:2", APIKeyScopes: []string{"lession.v1.EmbedService", "series:" + series.ID.String()}})
+	if _, err := service.CreateEmbedToken(scoped, core.EmbedTokenRequest{EpisodeID: episode.ID}); err != nil {
+		t.Fatalf("CreateEmbedToken() with a key scoped to the series error = %v", err)
+	}
+
+	series.DRMEnabled = true
+	if _, err := service.CreateEmbedToken(ctx, core.EmbedTokenRequest{EpisodeID: episode.ID}); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected DRM episodes to be refused, got %v", err)
+	}
+}
@@ -0,0 +1,339 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	feedSyncPageSize         = 100
+	maxFeedErrorLength       = 512
+	maxFeedSlugLength        = 128
+	maxFeedTitleLength       = 256
+	maxFeedSummaryLength     = 1024
+	maxFeedDescriptionLength = 2048
+)
+
+// feedSlugSeparators matches the runs of characters replaced by a dash when
+// deriving series slugs from feed titles.
+var feedSlugSeparators = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Feed and media fetch failures are reported without their cause, which could
+// reveal how hosts on the network respond.
+var (
+	errFeedUnreadable  = errors.New("cannot read feed")
+	errMediaUnreadable = errors.New("cannot copy media")
+)
+
+// FeedIngestService creates series from YouTube playlists and podcast feeds
+// and adds their new items as episodes on every sync. Items are remembered by
+// GUID, so episodes edited or deleted after ingestion are left alone.
+type FeedIngestService struct {
+	repo       core.FeedSubscriptionRepository
+	fetcher    core.FeedFetcher
+	series     core.SeriesService
+	assets     core.AssetService
+	transferer core.MediaTransferer
+	now        func() time.Time
+}
+
+// NewFeedIngestService constructs a feed ingestion service. Media of
+// subscriptions that download it goes through assets via transferer.
+func NewFeedIngestService(repo core.FeedSubscriptionRepository, fetcher core.FeedFetcher, series core.SeriesService, assets core.AssetService, transferer core.MediaTransferer) *FeedIngestService {
+	return &FeedIngestService{
+		repo:       repo,
+		fetcher:    fetcher,
+		series:     series,
+		assets:     assets,
+		transferer: transferer,
+		now:        time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *FeedIngestService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.FeedIngestService = (*FeedIngestService)(nil)
+
+// CreateFeedSubscription creates a draft series from a feed and ingests its
+// current items. The subscription is kept even when ingesting an item fails;
+// the error is recorded and the item is retried on the next sync.
+func (s *FeedIngestService) CreateFeedSubscription(ctx context.Context, req core.FeedSubscriptionRequest) (*core.FeedSyncResult, error) {
+	switch req.Kind {
+	case core.FeedKindYouTubePlaylist:
+		if req.DownloadMedia {
+			return nil, fmt.Errorf("%w: YouTube videos cannot be downloaded", core.ErrValidation)
+		}
+	case core.FeedKindPodcast:
+	default:
+		return nil, fmt.Errorf("%w: feed kind required", core.ErrValidation)
+	}
+	req.SourceURL = strings.TrimSpace(req.SourceURL)
+	if req.SourceURL == "" {
+		return nil, fmt.Errorf("%w: source url required", core.ErrValidation)
+	}
+
+	feed, err := s.fetcher.FetchFeed(ctx, req.Kind, req.SourceURL)
+	if err != nil {
+		if errors.Is(err, core.ErrValidation) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %w", core.ErrValidation, errFeedUnreadable)
+	}
+	if feed.Title == "" {
+		return nil, fmt.Errorf("%w: feed has no title", core.ErrValidation)
+	}
+
+	slug := req.Slug
+	if slug == "" {
+		if slug = feedSlug(feed.Title); slug == "" {
+			return nil, fmt.Errorf("%w: cannot derive a slug from the feed title; set one", core.ErrValidation)
+		}
+	}
+	draft := core.SeriesDraft{
+		Slug:      slug,
+		Title:     truncateRunes(feed.Title, maxFeedTitleLength),
+		Summary:   truncateRunes(feed.Description, maxFeedSummaryLength),
+		Language:  feed.Language,
+		Level:     req.Level,
+		Status:    core.SeriesStatusDraft,
+		AuthorIDs: req.AuthorIDs,
+	}
+	if importURLProblem(feed.ImageURL) == "" {
+		draft.CoverURL = feed.ImageURL
+	}
+	series, err := s.series.CreateSeries(ctx, draft)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.repo.CreateFeedSubscription(ctx, core.FeedSubscription{
+		ID:            uuid.New(),
+		SeriesID:      series.ID,
+		Kind:          req.Kind,
+		SourceURL:     req.SourceURL,
+		DownloadMedia: req.DownloadMedia,
+		AutoPublish:   req.AutoPublish,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Ingestion errors are recorded on the subscription, which exists now.
+	result, err := s.finishSync(ctx, subscription, feed)
+	if result == nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetFeedSubscription returns a single subscription.
+func (s *FeedIngestService) GetFeedSubscription(ctx context.Context, id uuid.UUID) (*core.FeedSubscription, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: subscription id required", core.ErrValidation)
+	}
+	return s.repo.GetFeedSubscription(ctx, id)
+}
+
+// ListFeedSubscriptions returns a paginated collection of subscriptions.
+func (s *FeedIngestService) ListFeedSubscriptions(ctx context.Context, filter core.FeedSubscriptionListFilter) ([]core.FeedSubscription, string, error) {
+	return s.repo.ListFeedSubscriptions(ctx, filter)
+}
+
+// DeleteFeedSubscription stops syncing a series; its episodes are kept.
+func (s *FeedIngestService) DeleteFeedSubscription(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("%w: subscription id required", core.ErrValidation)
+	}
+	return s.repo.DeleteFeedSubscription(ctx, id)
+}
+
+// SyncFeedSubscription fetches a feed and adds its new items as episodes.
+// The outcome is recorded on the subscription; a failed sync returns the
+// episodes added before the failure along with the error.
+func (s *FeedIngestService) SyncFeedSubscription(ctx context.Context, id uuid.UUID) (*core.FeedSyncResult, error) {
+	subscription, err := s.GetFeedSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	feed, err := s.fetcher.FetchFeed(ctx, subscription.Kind, subscription.SourceURL)
+	if err != nil {
+		return s.recordSync(ctx, subscription, nil, errFeedUnreadable)
+	}
+	return s.finishSync(ctx, subscription, feed)
+}
+
+// SyncFeedSubscriptions syncs every subscription, returning how many
+// episodes were added. A feed failing to sync does not stop the others.
+func (s *FeedIngestService) SyncFeedSubscriptions(ctx context.Context) (int, error) {
+	created, failed, total := 0, 0, 0
+	pageToken := ""
+	for {
+		subscriptions, next, err := s.repo.ListFeedSubscriptions(ctx, core.FeedSubscriptionListFilter{PageSize: feedSyncPageSize, PageToken: pageToken})
+		if err != nil {
+			return created, err
+		}
+		for _, subscription := range subscriptions {
+			total++
+			result, err := s.SyncFeedSubscription(ctx, subscription.ID)
+			if result != nil {
+				created += len(result.CreatedEpisodeIDs)
+			}
+			if err != nil {
+				if ctx.Err() != nil {
+					return created, ctx.Err()
+				}
+				failed++
+			}
+		}
+		if next == "" {
+			break
+		}
+		pageToken = next
+	}
+	if failed > 0 {
+		return created, fmt.Errorf("%d of %d feeds failed to sync", failed, total)
+	}
+	return created, nil
+}
+
+// finishSync ingests the new items of a fetched feed and records the outcome.
+func (s *FeedIngestService) finishSync(ctx context.Context, subscription *core.FeedSubscription, feed *core.Feed) (*core.FeedSyncResult, error) {
+	created, err := s.ingest(ctx, *subscription, feed)
+	return s.recordSync(ctx, subscription, created, err)
+}
+
+func (s *FeedIngestService) recordSync(ctx context.Context, subscription *core.FeedSubscription, created []uuid.UUID, syncErr error) (*core.FeedSyncResult, error) {
+	now := s.now().UTC()
+	subscription.LastSyncedAt = &now
+	subscription.LastError = ""
+	if syncErr != nil {
+		subscription.LastError = truncateRunes(syncErr.Error(), maxFeedErrorLength)
+	}
+	updated, err := s.repo.UpdateFeedSubscription(ctx, *subscription)
+	if err != nil {
+		return nil, errors.Join(syncErr, err)
+	}
+	return &core.FeedSyncResult{Subscription: *updated, CreatedEpisodeIDs: created}, syncErr
+}
+
+// ingest adds the items not ingested yet, oldest first, numbering them after
+// the last episode of the series.
+func (s *FeedIngestService) ingest(ctx context.Context, subscription core.FeedSubscription, feed *core.Feed) ([]uuid.UUID, error) {
+	guids, err := s.repo.ListFeedItemGUIDs(ctx, subscription.ID)
+	if err != nil {
+		return nil, err
+	}
+	series, err := s.series.GetSeries(ctx, subscription.SeriesID, core.SeriesQueryOptions{IncludeEpisodes: true, OmitTranscripts: true})
+	if err != nil {
+		return nil, err
+	}
+	seq := lo.Max(lo.Map(series.Episodes, func(e core.Episode, _ int) uint32 { return e.Seq }))
+
+	seen := lo.SliceToMap(guids, func(guid string) (string, struct{}) { return guid, struct{}{} })
+	// Feeds list their items newest first.
+	items := slices.Clone(feed.Items)
+	slices.Reverse(items)
+
+	var created []uuid.UUID
+	for _, item := range items {
+		if _, ok := seen[item.GUID]; ok || item.GUID == "" || item.MediaURL == "" {
+			continue
+		}
+		seen[item.GUID] = struct{}{}
+
+		resource := &core.MediaResource{PlaybackURL: item.MediaURL, Type: item.MediaType, MimeType: item.MimeType}
+		duration := item.Duration
+		if subscription.DownloadMedia {
+			asset, err := s.copyMedia(ctx, item)
+			if err != nil {
+				return created, fmt.Errorf("download %q: %w", item.Title, err)
+			}
+			resource = &core.MediaResource{
+				AssetID:     asset.ID,
+				Type:        item.MediaType,
+				PlaybackURL: asset.PlaybackURL,
+				MimeType:    asset.MimeType,
+			}
+			if duration == 0 {
+				duration = asset.Duration
+			}
+		}
+
+		seq++
+		title := truncateRunes(item.Title, maxFeedTitleLength)
+		if title == "" {
+			title = fmt.Sprintf("Episode %d", seq)
+		}
+		episode, err := s.series.CreateEpisode(ctx, core.CreateEpisodeParams{
+			SeriesID: subscription.SeriesID,
+			Draft: core.EpisodeDraft{
+				Seq:         seq,
+				Title:       title,
+				Description: truncateRunes(item.Description, maxFeedDescriptionLength),
+				Duration:    duration,
+				Status:      lo.Ternary(subscription.AutoPublish, core.EpisodeStatusPublished, core.EpisodeStatusDraft),
+				Resource:    resource,
+			},
+		})
+		if err != nil {
+			return created, err
+		}
+		if err := s.repo.RecordFeedItem(ctx, subscription.ID, item.GUID, episode.ID); err != nil {
+			return created, err
+		}
+		created = append(created, episode.ID)
+	}
+	return created, nil
+}
+
+// copyMedia uploads the media of an item through the upload pipeline.
+func (s *FeedIngestService) copyMedia(ctx context.Context, item core.FeedItem) (*core.Asset, error) {
+	filename := "media"
+	if u, err := url.Parse(item.MediaURL); err == nil && path.Base(u.Path) != "/" && path.Base(u.Path) != "." {
+		filename = path.Base(u.Path)
+	}
+	upload, err := s.assets.CreateUpload(ctx, core.CreateUploadParams{
+		Type:             lo.Ternary(item.MediaType == core.MediaTypeVideo, core.AssetTypeVideo, core.AssetTypeAudio),
+		OriginalFilename: filename,
+		MimeType:         item.MimeType,
+		ContentLength:    item.ContentLength,
+	})
+	if err != nil {
+		return nil, err
+	}
+	n, err := s.transferer.TransferMedia(ctx, item.MediaURL, upload.Session.Target)
+	if err != nil {
+		return nil, errMediaUnreadable
+	}
+	completed, err := s.assets.CompleteUpload(ctx, core.CompleteUploadParams{
+		Identifier:    core.UploadIdentifier{UploadID: upload.Session.ID},
+		ContentLength: n,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &completed.Asset, nil
+}
+
+// feedSlug derives a series slug from a feed title; it is empty for titles
+// without latin letters or digits.
+func feedSlug(title string) string {
+	slug := strings.Trim(feedSlugSeparators.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	return strings.TrimRight(truncateRunes(slug, maxFeedSlugLength), "-")
+}
@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubFeedFetcher struct {
+	feed *core.Feed
+	err  error
+}
+
+func (s *stubFeedFetcher) FetchFeed(context.Context, core.FeedKind, string) (*core.Feed, error) {
+	return s.feed, s.err
+}
+
+type memoryFeedSubscriptionRepo struct {
+	subscriptions map[uuid.UUID]core.FeedSubscription
+	items         map[uuid.UUID][]string
+}
+
+func newMemoryFeedSubscriptionRepo() *memoryFeedSubscriptionRepo {
+	return &memoryFeedSubscriptionRepo{subscriptions: map[uuid.UUID]core.FeedSubscription{}, items: map[uuid.UUID][]string{}}
+}
+
+func (r *memoryFeedSubscriptionRepo) CreateFeedSubscription(ctx context.Context, subscription core.FeedSubscription) (*core.FeedSubscription, error) {
+	r.subscriptions[subscription.ID] = subscription
+	return &subscription, nil
+}
+
+func (r *memoryFeedSubscriptionRepo) GetFeedSubscription(ctx context.Context, id uuid.UUID) (*core.FeedSubscription, error) {
+	subscription, ok := r.subscriptions[id]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	return &subscription, nil
+}
+
+func (r *memoryFeedSubscriptionRepo) UpdateFeedSubscription(ctx context.Context, subscription core.FeedSubscription) (*core.FeedSubscription, error) {
+	r.subscriptions[subscription.ID] = subscription
+	return &subscription, nil
+}
+
+func (r *memoryFeedSubscriptionRepo) DeleteFeedSubscription(ctx context.Context, id uuid.UUID) error {
+	delete(r.subscriptions, id)
+	return nil
+}
+
+func (r *memoryFeedSubscriptionRepo) ListFeedSubscriptions(ctx context.Context, filter core.FeedSubscriptionListFilter) ([]core.FeedSubscription, string, error) {
+	var subscriptions []core.FeedSubscription
+	for _, subscription := range r.subscriptions {
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, "", nil
+}
+
+func (r *memoryFeedSubscriptionRepo) ListFeedItemGUIDs(ctx context.Context, subscriptionID uuid.UUID) ([]string, error) {
+	return r.items[subscriptionID], nil
+}
+
+func (r *memoryFeedSubscriptionRepo) RecordFeedItem(ctx context.Context, subscriptionID uuid.UUID, guid string, episodeID uuid.UUID) error {
+	r.items[subscriptionID] = append(r.items[subscriptionID], guid)
+	return nil
+}
+
+// feedSeriesRepo keeps the series and episodes created through it.
+func feedSeriesRepo() (*stubSeriesRepo, *core.Series) {
+	series := &core.Series{}
+	repo := &stubSeriesRepo{
+		createSeriesFn: func(ctx context.Context, created core.Series) (*core.Series, error) {
+			*series = created
+			return &created, nil
+		},
+		getSeriesFn: func(ctx context.Context, id uuid.UUID, opts core.SeriesQueryOptions) (*core.Series, error) {
+			copy := *series
+			return &copy, nil
+		},
+		createEpisodeFn: func(ctx context.Context, episode core.Episode) (*core.Episode, error) {
+			series.Episodes = append(series.Episodes, episode)
+			return &episode, nil
+		},
+	}
+	return repo, series
+}
+
+func TestFeedIngestService_CreateAndSync(t *testing.T) {
+	fetcher := &stubFeedFetcher{feed: &core.Feed{
+		Title:    "Everyday English: Podcast",
+		Language: "en",
+		ImageURL: "https://cdn.example.com/cover.png",
+		Items: []core.FeedItem{
+			{GUID: "ep-2", Title: "At the cafe", MediaURL: "https://cdn.example.com/2.mp3", MediaType: core.MediaTypeAudio, Duration: time.Minute},
+			{GUID: "ep-1", Title: "Greetings", MediaURL: "https://cdn.example.com/1.mp3", MediaType: core.MediaTypeAudio},
+		},
+	}}
+	seriesRepo, series := feedSeriesRepo()
+	repo := newMemoryFeedSubscriptionRepo()
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	service := NewFeedIngestService(repo, fetcher, NewSeriesService(seriesRepo), nil, nil)
+	service.WithClock(func() time.Time { return now })
+
+	result, err := service.CreateFeedSubscription(context.Background(), core.FeedSubscriptionRequest{
+		Kind:        core.FeedKindPodcast,
+		SourceURL:   "https://podcasts.example.com/feed.xml",
+		AutoPublish: true,
+	})
+	if err != nil {
+		t.Fatalf("CreateFeedSubscription() error = %v", err)
+	}
+	if series.Slug != "everyday-english-podcast" || series.Status != core.SeriesStatusDraft || series.CoverURL != "https://cdn.example.com/cover.png" {
+		t.Fatalf("unexpected series %+v", series)
+	}
+	if len(result.CreatedEpisodeIDs) != 2 || result.Subscription.LastSyncedAt == nil || result.Subscription.LastError != "" {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	if first := series.Episodes[0]; first.Seq != 1 || first.Title != "Greetings" || first.Status != core.EpisodeStatusPublished || first.Resource.PlaybackURL != "https://cdn.example.com/1.mp3" {
+		t.Fatalf("expected the oldest item first, got %+v", first)
+	}
+
+	fetcher.feed.Items = append([]core.FeedItem{{GUID: "ep-3", Title: "", MediaURL: "https://cdn.example.com/3.mp3", MediaType: core.MediaTypeAudio}}, fetcher.feed.Items...)
+	result, err = service.SyncFeedSubscription(context.Background(), result.Subscription.ID)
+	if err != nil {
+		t.Fatalf("SyncFeedSubscription() error = %v", err)
+	}
+	if len(result.CreatedEpisodeIDs) != 1 || len(series.Episodes) != 3 {
+		t.Fatalf("expected only the new item to be ingested, got %+v", result)
+	}
+	if third := series.Episodes[2]; third.Seq != 3 || third.Title != "Episode 3" {
+		t.Fatalf("unexpected episode %+v", third)
+	}
+
+	fetcher.err = errors.New("feed: get: 503 Service Unavailable")
+	created, err := service.SyncFeedSubscriptions(context.Background())
+	if err == nil || created != 0 {
+		t.Fatalf("SyncFeedSubscriptions() = %d, %v", created, err)
+	}
+	if subscription := repo.subscriptions[result.Subscription.ID]; subscription.LastError != "cannot read feed" {
+		t.Fatalf("expected a generic failure to be recorded, got %q", subscription.LastError)
+	}
+}
+
+func TestFeedIngestService_CreateValidation(t *testing.T) {
+	service := NewFeedIngestService(newMemoryFeedSubscriptionRepo(), &stubFeedFetcher{err: errors.New("connection refused")}, NewSeriesService(&stubSeriesRepo{}), nil, nil)
+	for name, req := range map[string]core.FeedSubscriptionRequest{
+		"kind":             {SourceURL: "https://podcasts.example.com/feed.xml"},
+		"youtube download": {Kind: core.FeedKindYouTubePlaylist, SourceURL: "PLabcdefghij", DownloadMedia: true},
+		"unreadable feed":  {Kind: core.FeedKindPodcast, SourceURL: "https://podcasts.example.com/feed.xml"},
+	} {
+		if _, err := service.CreateFeedSubscription(context.Background(), req); !errors.Is(err, core.ErrValidation) {
+			t.Fatalf("%s: expected validation error, got %v", name, err)
+		}
+	}
+}
+
+type stubUploadAssetService struct {
+	core.AssetService
+	created core.CreateUploadParams
+}
+
+func (s *stubUploadAssetService) CreateUpload(ctx context.Context, params core.CreateUploadParams) (*core.CreateUploadResult, error) {
+	s.created = params
+	return &core.CreateUploadResult{Session: core.UploadSession{ID: uuid.New(), Target: core.UploadTarget{Method: "PUT", URL: "https://upload.example.com/1"}}}, nil
+}
+
+func (s *stubUploadAssetService) CompleteUpload(ctx context.Context, params core.CompleteUploadParams) (*core.CompleteUploadResult, error) {
+	return &core.CompleteUploadResult{Asset: core.Asset{ID: uuid.New(), PlaybackURL: "https://playback.example.com/1.m3u8", MimeType: s.created.MimeType, Duration: 2 * time.Minute}}, nil
+}
+
+type stubMediaTransferer struct {
+	sources []string
+}
+
+func (s *stubMediaTransferer) TransferMedia(ctx context.Context, sourceURL string, target core.UploadTarget) (int64, error) {
+	s.sources = append(s.sources, sourceURL)
+	return 2048, nil
+}
+
+func TestFeedIngestService_DownloadMedia(t *testing.T) {
+	fetcher := &stubFeedFetcher{feed: &core.Feed{
+		Title: "Licensed show",
+		Items: []core.FeedItem{{GUID: "ep-1", Title: "One", MediaURL: "https://cdn.example.com/shows/one.mp4", MediaType: core.MediaTypeVideo, MimeType: "video/mp4"}},
+	}}
+	seriesRepo, series := feedSeriesRepo()
+	assets := &stubUploadAssetService{}
+	transferer := &stubMediaTransferer{}
+	service := NewFeedIngestService(newMemoryFeedSubscriptionRepo(), fetcher, NewSeriesService(seriesRepo), assets, transferer)
+
+	if _, err := service.CreateFeedSubscription(context.Background(), core.FeedSubscriptionRequest{
+		Kind: core.FeedKindPodcast, SourceURL: "https://podcasts.example.com/feed.xml", DownloadMedia: true, Slug: "licensed",
+	}); err != nil {
+		t.Fatalf("CreateFeedSubscription() error = %v", err)
+	}
+	if assets.created.Type != core.AssetTypeVideo || assets.created.OriginalFilename != "one.mp4" || len(transferer.sources) != 1 {
+		t.Fatalf("unexpected upload %+v via %v", assets.created, transferer.sources)
+	}
+	episode := series.Episodes[0]
+	if episode.Resource.AssetID == uuid.Nil || episode.Resource.PlaybackURL != "https://playback.example.com/1.m3u8" || episode.Duration != 2*time.Minute || episode.Status != core.EpisodeStatusDraft {
+		t.Fatalf("expected the episode to play the uploaded asset, got %+v", episode)
+	}
+}
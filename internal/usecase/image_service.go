@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// imageVariantSpecs are the standard renditions of image assets: square
+// thumbnails for avatars and lists, cards for catalogue tiles and heroes
+// for series pages.
+var imageVariantSpecs = []core.ImageVariantSpec{
+	{Name: core.ImageVariantThumbnail, Width: 160, Height: 160},
+	{Name: core.ImageVariantCard, Width: 640, Height: 360},
+	{Name: core.ImageVariantHero, Width: 1920, Height: 1080},
+}
+
+// imageLimits bounds the dimensions of image assets. Images must be large
+// enough for a sharp thumbnail; the upper bound keeps decoding memory in
+// check.
+var imageLimits = core.ImageLimits{
+	MinWidth:  160,
+	MinHeight: 160,
+	MaxWidth:  8000,
+	MaxHeight: 8000,
+}
+
+// ImageService validates uploaded images and resizes them into the standard
+// variants. Images that fail validation fail their asset.
+type ImageService struct {
+	assets    core.AssetRepository
+	processor core.ImageProcessor
+}
+
+// NewImageService constructs an image service. processor may be nil when no
+// processor is configured, in which case processing is unavailable and
+// asset events are ignored.
+func NewImageService(assets core.AssetRepository, processor core.ImageProcessor) *ImageService {
+	return &ImageService{
+		assets:    assets,
+		processor: processor,
+	}
+}
+
+var _ core.ImageService = (*ImageService)(nil)
+
+// ProcessAsset validates a ready image asset and records its dimensions and
+// variants on it. An image the processor rejects fails the asset and the
+// validation error is returned.
+func (s *ImageService) ProcessAsset(ctx context.Context, assetID uuid.UUID) (*core.Asset, error) {
+	if s.processor == nil {
+		return nil, fmt.Errorf("%w: image processing is not configured", core.ErrInvalidState)
+	}
+	asset, err := s.assets.GetAssetByID(ctx, assetID)
+	if err != nil {
+		return nil, err
+	}
+	if reason := imageSkipReason(asset); reason != "" {
+		return nil, fmt.Errorf("%w: %s", core.ErrInvalidState, reason)
+	}
+
+	processed, processErr := s.processor.ProcessImage(ctx, core.ImageProcessRequest{
+		AssetID:   asset.ID,
+		SourceURL: asset.PlaybackURL,
+		Limits:    imageLimits,
+		Variants:  imageVariantSpecs,
+	})
+	if processErr != nil && !errors.Is(processErr, core.ErrValidation) {
+		return nil, processErr
+	}
+
+	// Processing takes a while; the asset may have been deleted or replaced
+	// since, in which case the result is for a stale image.
+	current, err := s.assets.GetAssetByID(ctx, assetID)
+	if err != nil {
+		return nil, err
+	}
+	if current.Status != core.AssetStatusReady || current.PlaybackURL != asset.PlaybackURL {
+		return nil, fmt.Errorf("%w: asset changed during processing", core.ErrInvalidState)
+	}
+
+	if processErr != nil {
+		current.Status = core.AssetStatusFailed
+		current.FailureCode = core.AssetFailureCodeInvalidMedia
+		current.FailureReason = strings.TrimPrefix(processErr.Error(), core.ErrValidation.Error()+": ")
+		changed := core.AssetStatusChanged{Asset: *current, PreviousStatus: core.AssetStatusReady}
+		if _, err := s.assets.UpdateAsset(ctx, *current, changed); err != nil {
+			return nil, err
+		}
+		return nil, processErr
+	}
+
+	// Variants are written over the previous ones, which may still be
+	// cached downstream.
+	previous := lo.Map(current.ImageVariants, func(variant core.ImageVariant, _ int) string { return variant.URL })
+	current.Width = processed.Width
+	current.Height = processed.Height
+	current.ImageVariants = processed.Variants
+	var events []core.Event
+	if len(previous) > 0 {
+		events = append(events, core.AssetRenditionReplaced{Asset: *current, PreviousURLs: previous})
+	}
+	return s.assets.UpdateAsset(ctx, *current, events...)
+}
+
+// HandleAssetReady processes an image asset that became ready.
+func (s *ImageService) HandleAssetReady(ctx context.Context, event core.Event) error {
+	if s.processor == nil {
+		return nil
+	}
+	ready, ok := event.(core.AssetReady)
+	if !ok || imageSkipReason(&ready.Asset) != "" {
+		return nil
+	}
+
+	// Invalid images fail their asset, and a deleted or changed asset
+	// leaves nothing to retry.
+	_, err := s.ProcessAsset(ctx, ready.Asset.ID)
+	if isNotFound(err) || errors.Is(err, core.ErrInvalidState) || errors.Is(err, core.ErrValidation) {
+		return nil
+	}
+	return err
+}
+
+// imageSkipReason explains why an asset cannot be processed, or returns an
+// empty string when it can.
+func imageSkipReason(asset *core.Asset) string {
+	switch {
+	case asset.Type != core.AssetTypeImage:
+		return "only image assets are processed"
+	case asset.Status != core.AssetStatusReady:
+		return "asset is not ready"
+	case asset.PlaybackURL == "":
+		return "asset has no url"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubImageProcessor struct {
+	err   error
+	req   core.ImageProcessRequest
+	calls int
+}
+
+func (p *stubImageProcessor) ProcessImage(ctx context.Context, req core.ImageProcessRequest) (*core.ProcessedImage, error) {
+	p.req = req
+	p.calls++
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &core.ProcessedImage{
+		Format: "jpeg",
+		Width:  1200,
+		Height: 800,
+		Variants: []core.ImageVariant{
+			{Name: core.ImageVariantThumbnail, URL: "https://media.example.com/images/a/thumbnail.jpg", Width: 160, Height: 160},
+			{Name: core.ImageVariantCard, URL: "https://media.example.com/images/a/card.jpg", Width: 640, Height: 360},
+		},
+	}, nil
+}
+
+func TestImageService_HandleAssetReady(t *testing.T) {
+	asset := core.Asset{
+		ID:          uuid.New(),
+		Type:        core.AssetTypeImage,
+		Status:      core.AssetStatusReady,
+		MimeType:    "image/jpeg",
+		PlaybackURL: "https://uploads.example.com/cover.jpg",
+	}
+	var updated *core.Asset
+	assets := &stubAssetRepo{
+		getAssetByIDFn: func(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
+			found := asset
+			return &found, nil
+		},
+		updateAssetFn: func(ctx context.Context, a core.Asset) error {
+			updated = &a
+			return nil
+		},
+	}
+	processor := &stubImageProcessor{}
+	service := NewImageService(assets, processor)
+
+	if err := service.HandleAssetReady(context.Background(), core.AssetReady{Asset: asset}); err != nil {
+		t.Fatalf("HandleAssetReady() error = %v", err)
+	}
+	if updated == nil || updated.Width != 1200 || updated.Height != 800 || len(updated.ImageVariants) != 2 {
+		t.Fatalf("expected dimensions and variants to be recorded, got %#v", updated)
+	}
+	if processor.req.SourceURL != asset.PlaybackURL || len(processor.req.Variants) != len(imageVariantSpecs) || processor.req.Limits != imageLimits {
+		t.Fatalf("unexpected process request %#v", processor.req)
+	}
+
+	audio := asset
+	audio.Type = core.AssetTypeAudio
+	if err := service.HandleAssetReady(context.Background(), core.AssetReady{Asset: audio}); err != nil || processor.calls != 1 {
+		t.Fatalf("expected audio to be skipped, got calls=%d err=%v", processor.calls, err)
+	}
+
+	updated = nil
+	processor.err = fmt.Errorf("%w: image is 9000 pixels wide", core.ErrValidation)
+	if err := service.HandleAssetReady(context.Background(), core.AssetReady{Asset: asset}); err != nil {
+		t.Fatalf("expected an invalid image not to be retried, got %v", err)
+	}
+	if updated == nil || updated.Status != core.AssetStatusFailed {
+		t.Fatalf("expected the invalid image to fail its asset, got %#v", updated)
+	}
+	if updated.FailureCode != core.AssetFailureCodeInvalidMedia || updated.FailureReason != "image is 9000 pixels wide" {
+		t.Fatalf("expected the rejection to be recorded, got %v %q", updated.FailureCode, updated.FailureReason)
+	}
+
+	if _, err := NewImageService(assets, nil).ProcessAsset(context.Background(), asset.ID); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected processing to be unavailable without a processor, got %v", err)
+	}
+}
+
+func TestAssetService_CreateUploadValidatesImages(t *testing.T) {
+	service := NewAssetService(&stubAssetRepo{}, nil)
+
+	cases := []core.CreateUploadParams{
+		{Type: core.AssetTypeImage, OriginalFilename: "cover.tiff", MimeType: "image/tiff", ContentLength: 1024},
+		{Type: core.AssetTypeImage, OriginalFilename: "cover.png", MimeType: "image/png", ContentLength: maxImageUploadSize + 1},
+	}
+	for _, params := range cases {
+		if _, err := service.CreateUpload(context.Background(), params); !errors.Is(err, core.ErrValidation) {
+			t.Errorf("CreateUpload(%s, %d bytes) error = %v, want validation error", params.MimeType, params.ContentLength, err)
+		}
+	}
+}
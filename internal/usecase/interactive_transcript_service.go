@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// InteractiveTranscriptService prepares transcripts for tap-to-translate
+// reading.
+type InteractiveTranscriptService struct {
+	series     core.SeriesRepository
+	dictionary core.DictionaryProvider
+	cache      core.InteractiveTranscriptCache
+}
+
+// NewInteractiveTranscriptService constructs the service. A nil dictionary
+// disables it.
+func NewInteractiveTranscriptService(series core.SeriesRepository, dictionary core.DictionaryProvider) *InteractiveTranscriptService {
+	return &InteractiveTranscriptService{
+		series:     series,
+		dictionary: dictionary,
+	}
+}
+
+// WithCache keeps prepared transcripts in cache, so each episode and
+// language is looked up once.
+func (s *InteractiveTranscriptService) WithCache(cache core.InteractiveTranscriptCache) {
+	s.cache = cache
+}
+
+var _ core.InteractiveTranscriptService = (*InteractiveTranscriptService)(nil)
+
+// GetInteractiveTranscript splits an episode's transcript into tokens and
+// looks its words up in the dictionary.
+func (s *InteractiveTranscriptService) GetInteractiveTranscript(ctx context.Context, episodeID uuid.UUID, translationLanguage string) (*core.InteractiveTranscript, error) {
+	if s.dictionary == nil {
+		return nil, fmt.Errorf("%w: dictionary is not configured", core.ErrInvalidState)
+	}
+	episode, err := s.series.GetEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	key := core.InteractiveTranscriptKey{
+		EpisodeID:           episode.ID,
+		TranslationLanguage: strings.TrimSpace(translationLanguage),
+		EpisodeUpdatedAt:    episode.UpdatedAt,
+	}
+	if s.cache != nil {
+		if cached, ok := s.cache.GetInteractiveTranscript(ctx, key); ok {
+			return cached, nil
+		}
+	}
+
+	segments, err := interactiveSegments(episode.Transcript)
+	if err != nil {
+		return nil, err
+	}
+	var words []string
+	for _, segment := range segments {
+		for _, token := range segment.Tokens {
+			if token.Word != "" {
+				words = append(words, token.Word)
+			}
+		}
+	}
+	words = lo.Uniq(words)
+
+	transcript := &core.InteractiveTranscript{
+		EpisodeID:           episode.ID,
+		Language:            episode.Transcript.Language,
+		TranslationLanguage: key.TranslationLanguage,
+		Segments:            segments,
+	}
+	if len(words) > 0 {
+		entries, err := s.dictionary.LookupWords(ctx, episode.Transcript.Language, key.TranslationLanguage, words)
+		if err != nil {
+			return nil, fmt.Errorf("look up transcript words: %w", err)
+		}
+		transcript.Entries = lo.Values(entries)
+		slices.SortFunc(transcript.Entries, func(a, b core.DictionaryEntry) int {
+			return strings.Compare(a.Word, b.Word)
+		})
+	}
+
+	if s.cache != nil {
+		s.cache.SetInteractiveTranscript(ctx, key, *transcript)
+	}
+	return transcript, nil
+}
+
+// interactiveSegments tokenizes each segment of a timed transcript, or the
+// whole of an untimed one as a single segment.
+func interactiveSegments(transcript core.Transcript) ([]core.InteractiveSegment, error) {
+	segments, err := core.ParseTranscriptSegments(transcript)
+	if errors.Is(err, core.ErrTranscriptNotTimed) {
+		segments, err = []core.TranscriptSegment{{Text: transcript.Content}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(segments, func(segment core.TranscriptSegment, _ int) core.InteractiveSegment {
+		return core.InteractiveSegment{
+			Index:  segment.Index,
+			Start:  segment.Start,
+			End:    segment.End,
+			Tokens: tokenizeTranscript(segment.Text),
+		}
+	}), nil
+}
+
+// tokenizeTranscript splits text into alternating runs of word and other
+// characters. Words are letters and apostrophes, normalised the way
+// splitWords normalises them.
+func tokenizeTranscript(text string) []core.TranscriptToken {
+	var tokens []core.TranscriptToken
+	start, inWord := 0, false
+	flush := func(end int) {
+		if end == start {
+			return
+		}
+		token := core.TranscriptToken{Text: text[start:end]}
+		if inWord {
+			token.Word = strings.Trim(strings.ToLower(token.Text), "'")
+		}
+		tokens = append(tokens, token)
+		start = end
+	}
+	for i, r := range text {
+		if isWord := unicode.IsLetter(r) || r == '\''; isWord != inWord {
+			flush(i)
+			inWord = isWord
+		}
+	}
+	flush(len(text))
+	return tokens
+}
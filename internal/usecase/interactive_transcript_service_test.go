@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestInteractiveTranscriptService_GetInteractiveTranscript(t *testing.T) {
+	updatedAt := time.Date(2024, 5, 15, 15, 0, 0, 0, time.UTC)
+	seriesRepo := &stubSeriesRepo{
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			return &core.Episode{
+				ID: id,
+				Transcript: core.Transcript{
+					Language: "en",
+					Format:   core.TranscriptFormatSRT,
+					Content:  "1\n00:00:01,000 --> 00:00:03,000\nShe ran home.\n\n2\n00:00:03,000 --> 00:00:04,000\nDon't run!\n",
+				},
+				UpdatedAt: updatedAt,
+			}, nil
+		},
+	}
+	dictionary := &stubDictionaryProvider{entries: map[string]core.DictionaryEntry{
+		"ran": {Word: "ran", Lemma: "run", Translation: "corrió"},
+		"run": {Word: "run", Lemma: "run", Translation: "correr"},
+	}}
+	cache := &stubInteractiveTranscriptCache{}
+	service := NewInteractiveTranscriptService(seriesRepo, dictionary)
+	service.WithCache(cache)
+	episodeID := uuid.New()
+
+	transcript, err := service.GetInteractiveTranscript(context.Background(), episodeID, " es ")
+	if err != nil {
+		t.Fatalf("GetInteractiveTranscript() error = %v", err)
+	}
+	if len(transcript.Segments) != 2 || transcript.Segments[1].Start != 3*time.Second || transcript.Language != "en" || transcript.TranslationLanguage != "es" {
+		t.Fatalf("unexpected transcript %#v", transcript)
+	}
+	wantTokens := []core.TranscriptToken{
+		{Text: "Don't", Word: "don't"},
+		{Text: " "},
+		{Text: "run", Word: "run"},
+		{Text: "!"},
+	}
+	if !reflect.DeepEqual(transcript.Segments[1].Tokens, wantTokens) {
+		t.Fatalf("tokens = %#v, want %#v", transcript.Segments[1].Tokens, wantTokens)
+	}
+	if len(transcript.Entries) != 2 || transcript.Entries[0].Word != "ran" || transcript.Entries[1].Word != "run" {
+		t.Fatalf("unexpected entries %#v", transcript.Entries)
+	}
+	if want := []string{"she", "ran", "home", "don't", "run"}; !reflect.DeepEqual(dictionary.words, want) || dictionary.source != "en" || dictionary.target != "es" {
+		t.Fatalf("unexpected lookup of %q from %q to %q", dictionary.words, dictionary.source, dictionary.target)
+	}
+
+	if _, err := service.GetInteractiveTranscript(context.Background(), episodeID, "es"); err != nil {
+		t.Fatalf("GetInteractiveTranscript() error = %v", err)
+	}
+	if dictionary.lookups != 1 {
+		t.Fatalf("expected the cached transcript to be served, got %d lookups", dictionary.lookups)
+	}
+	if cache.key.EpisodeID != episodeID || !cache.key.EpisodeUpdatedAt.Equal(updatedAt) {
+		t.Fatalf("unexpected cache key %#v", cache.key)
+	}
+
+	disabled := NewInteractiveTranscriptService(seriesRepo, nil)
+	if _, err := disabled.GetInteractiveTranscript(context.Background(), episodeID, ""); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected invalid state without a dictionary, got %v", err)
+	}
+}
+
+func TestTokenizeTranscript(t *testing.T) {
+	text := "\"Café, s'il vous plaît,\" she said."
+	tokens := tokenizeTranscript(text)
+
+	var rebuilt strings.Builder
+	var words []string
+	for _, token := range tokens {
+		rebuilt.WriteString(token.Text)
+		if token.Word != "" {
+			words = append(words, token.Word)
+		}
+	}
+	if rebuilt.String() != text {
+		t.Fatalf("tokens rebuild %q, want %q", rebuilt.String(), text)
+	}
+	if want := []string{"café", "s'il", "vous", "plaît", "she", "said"}; !reflect.DeepEqual(words, want) {
+		t.Fatalf("words = %q, want %q", words, want)
+	}
+}
+
+type stubDictionaryProvider struct {
+	entries map[string]core.DictionaryEntry
+	lookups int
+	source  string
+	target  string
+	words   []string
+}
+
+func (s *stubDictionaryProvider) LookupWords(ctx context.Context, sourceLanguage, targetLanguage string, words []string) (map[string]core.DictionaryEntry, error) {
+	s.lookups++
+	s.source, s.target, s.words = sourceLanguage, targetLanguage, words
+	found := map[string]core.DictionaryEntry{}
+	for _, word := range words {
+		if entry, ok := s.entries[word]; ok {
+			found[word] = entry
+		}
+	}
+	return found, nil
+}
+
+type stubInteractiveTranscriptCache struct {
+	key        core.InteractiveTranscriptKey
+	transcript *core.InteractiveTranscript
+}
+
+func (s *stubInteractiveTranscriptCache) GetInteractiveTranscript(ctx context.Context, key core.InteractiveTranscriptKey) (*core.InteractiveTranscript, bool) {
+	if s.transcript == nil || s.key != key {
+		return nil, false
+	}
+	return s.transcript, true
+}
+
+func (s *stubInteractiveTranscriptCache) SetInteractiveTranscript(ctx context.Context, key core.InteractiveTranscriptKey, transcript core.InteractiveTranscript) {
+	s.key, s.transcript = key, &transcript
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// JobService enqueues background jobs and lets administrators inspect and
+// retry them.
+type JobService struct {
+	repo core.JobRepository
+	now  func() time.Time
+}
+
+// NewJobService constructs a job service backed by the provided repository.
+func NewJobService(repo core.JobRepository) *JobService {
+	return &JobService{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *JobService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.JobService = (*JobService)(nil)
+
+// Enqueue stores a pending job. Enqueueing a dedupe key that is already
+// queued returns core.ErrAlreadyExists.
+func (s *JobService) Enqueue(ctx context.Context, req core.JobRequest) (*core.Job, error) {
+	kind := strings.TrimSpace(req.Kind)
+	if kind == "" {
+		return nil, fmt.Errorf("%w: job kind required", core.ErrValidation)
+	}
+
+	now := s.now().UTC()
+	runAt := req.RunAt.UTC()
+	if req.RunAt.IsZero() {
+		runAt = now
+	}
+
+	return s.repo.CreateJob(ctx, core.Job{
+		ID:        uuid.New(),
+		Kind:      kind,
+		Payload:   req.Payload,
+		Status:    core.JobStatusPending,
+		DedupeKey: req.DedupeKey,
+		RunAt:     runAt,
+	})
+}
+
+// ListJobs returns jobs, newest first.
+func (s *JobService) ListJobs(ctx context.Context, filter core.JobListFilter) ([]core.Job, string, error) {
+	return s.repo.ListJobs(ctx, filter)
+}
+
+// RetryJob requeues a failed job with a fresh set of attempts.
+func (s *JobService) RetryJob(ctx context.Context, id uuid.UUID) (*core.Job, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: job id required", core.ErrValidation)
+	}
+
+	job, err := s.repo.GetJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != core.JobStatusFailed {
+		return nil, fmt.Errorf("%w: only failed jobs can be retried", core.ErrInvalidState)
+	}
+
+	now := s.now().UTC()
+	job.Status = core.JobStatusPending
+	job.Attempts = 0
+	job.RunAt = now
+	job.LockedBy = ""
+	job.LockedUntil = nil
+	job.FinishedAt = nil
+	return s.repo.UpdateJob(ctx, *job)
+}
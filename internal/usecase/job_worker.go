@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// JobRetryPolicy controls how often and how soon a failing job is retried.
+type JobRetryPolicy struct {
+	// MaxAttempts is the number of runs before the job is marked failed.
+	MaxAttempts int
+	// InitialBackoff is the delay after the first failure; it doubles after
+	// every further failure up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultJobRetryPolicy runs a job up to five times, backing off from ten
+// seconds to at most an hour.
+var DefaultJobRetryPolicy = JobRetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: 10 * time.Second,
+	MaxBackoff:     time.Hour,
+}
+
+// backoff returns the delay before the run following the given attempt.
+func (p JobRetryPolicy) backoff(attempts int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < attempts && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxBackoff)
+}
+
+// defaultJobLease bounds how long a worker may run a job before the job is
+// handed to another worker.
+const defaultJobLease = 5 * time.Minute
+
+type jobRegistration struct {
+	handler core.JobHandler
+	policy  JobRetryPolicy
+}
+
+// JobWorker claims jobs of the kinds it has handlers for and runs them,
+// recording successes and scheduling retries with backoff.
+type JobWorker struct {
+	repo        core.JobRepository
+	name        string
+	concurrency int
+	lease       time.Duration
+	now         func() time.Time
+	handlers    map[string]jobRegistration
+}
+
+// NewJobWorker constructs a worker claiming jobs from repo.
+func NewJobWorker(repo core.JobRepository) *JobWorker {
+	return &JobWorker{
+		repo:        repo,
+		name:        uuid.NewString(),
+		concurrency: 1,
+		lease:       defaultJobLease,
+		now:         time.Now,
+		handlers:    map[string]jobRegistration{},
+	}
+}
+
+// WithClock allows tests to override the clock used by the worker.
+func (w *JobWorker) WithClock(fn func() time.Time) {
+	if fn != nil {
+		w.now = fn
+	}
+}
+
+// WithName sets the name recorded on the jobs the worker leases.
+func (w *JobWorker) WithName(name string) {
+	if name != "" {
+		w.name = name
+	}
+}
+
+// WithConcurrency sets how many jobs the worker runs at once.
+func (w *JobWorker) WithConcurrency(n int) {
+	if n > 0 {
+		w.concurrency = n
+	}
+}
+
+// WithLease sets how long a job may run before another worker takes it over.
+// Handlers are cancelled when the lease runs out.
+func (w *JobWorker) WithLease(lease time.Duration) {
+	if lease > 0 {
+		w.lease = lease
+	}
+}
+
+// Handle registers the handler for jobs of the given kind.
+func (w *JobWorker) Handle(kind string, handler core.JobHandler, policy JobRetryPolicy) {
+	w.handlers[kind] = jobRegistration{handler: handler, policy: policy}
+}
+
+// RunJobs claims one batch of due jobs, runs them concurrently and reports
+// how many ran.
+func (w *JobWorker) RunJobs(ctx context.Context) (int, error) {
+	if len(w.handlers) == 0 {
+		return 0, nil
+	}
+
+	kinds := make([]string, 0, len(w.handlers))
+	for kind := range w.handlers {
+		kinds = append(kinds, kind)
+	}
+	slices.Sort(kinds)
+
+	jobs, err := w.repo.ClaimJobs(ctx, core.JobClaim{
+		Worker: w.name,
+		Kinds:  kinds,
+		Now:    w.now().UTC(),
+		Lease:  w.lease,
+		Limit:  w.concurrency,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	errs := make([]error, len(jobs))
+	var wg sync.WaitGroup
+	for i, job := range jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = w.run(ctx, job)
+		}()
+	}
+	wg.Wait()
+	return len(jobs), errors.Join(errs...)
+}
+
+// Run processes jobs until ctx is cancelled, polling every interval while
+// the queue is empty. Jobs already running finish within their lease.
+func (w *JobWorker) Run(ctx context.Context, interval time.Duration) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		n, err := w.RunJobs(ctx)
+		if err == nil && n > 0 {
+			timer.Reset(0)
+		} else {
+			timer.Reset(interval)
+		}
+	}
+}
+
+// run executes a claimed job and records the outcome.
+func (w *JobWorker) run(ctx context.Context, job core.Job) error {
+	registration := w.handlers[job.Kind]
+	ctx = context.WithoutCancel(ctx)
+
+	runCtx, span := tracer.Start(ctx, "job "+job.Kind, trace.WithAttributes(
+		attribute.String("lession.job.id", job.ID.String()),
+		attribute.Int("lession.job.attempt", job.Attempts),
+	))
+	runCtx, cancel := context.WithTimeout(runCtx, w.lease)
+	err := callJobHandler(runCtx, registration.handler, job)
+	cancel()
+	endSpan(span, err)
+
+	now := w.now().UTC()
+	job.LockedBy = ""
+	job.LockedUntil = nil
+	switch {
+	case err == nil:
+		job.Status = core.JobStatusSucceeded
+		job.LastError = ""
+		job.FinishedAt = &now
+	case job.Attempts >= registration.policy.MaxAttempts:
+		job.Status = core.JobStatusFailed
+		job.LastError = err.Error()
+		job.FinishedAt = &now
+	default:
+		job.Status = core.JobStatusPending
+		job.LastError = err.Error()
+		job.RunAt = now.Add(registration.policy.backoff(job.Attempts))
+	}
+
+	_, err = w.repo.UpdateJob(ctx, job)
+	return err
+}
+
+// callJobHandler turns a panicking handler into a failed attempt.
+func callJobHandler(ctx context.Context, handler core.JobHandler, job core.Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job %s panicked: %v", job.Kind, r)
+		}
+	}()
+	return handler(ctx, job)
+}
@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// stubJobRepo keeps jobs in memory and leases them like the database does.
+type stubJobRepo struct {
+	mu   sync.Mutex
+	jobs []core.Job
+}
+
+func (s *stubJobRepo) CreateJob(ctx context.Context, job core.Job) (*core.Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, existing := range s.jobs {
+		if job.DedupeKey != "" && existing.DedupeKey == job.DedupeKey {
+			return nil, core.ErrAlreadyExists
+		}
+	}
+	s.jobs = append(s.jobs, job)
+	return &job, nil
+}
+
+func (s *stubJobRepo) GetJob(ctx context.Context, id uuid.UUID) (*core.Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, job := range s.jobs {
+		if job.ID == id {
+			return &job, nil
+		}
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubJobRepo) UpdateJob(ctx context.Context, job core.Job) (*core.Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.jobs {
+		if s.jobs[i].ID == job.ID {
+			s.jobs[i] = job
+			return &job, nil
+		}
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubJobRepo) ListJobs(ctx context.Context, filter core.JobListFilter) ([]core.Job, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.jobs), "", nil
+}
+
+func (s *stubJobRepo) ClaimJobs(ctx context.Context, claim core.JobClaim) ([]core.Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var claimed []core.Job
+	for i, job := range s.jobs {
+		if len(claimed) == claim.Limit || !slices.Contains(claim.Kinds, job.Kind) {
+			continue
+		}
+		if job.Status != core.JobStatusPending || job.RunAt.After(claim.Now) {
+			continue
+		}
+		lockedUntil := claim.Now.Add(claim.Lease)
+		job.Status = core.JobStatusRunning
+		job.Attempts++
+		job.LockedBy = claim.Worker
+		job.LockedUntil = &lockedUntil
+		s.jobs[i] = job
+		claimed = append(claimed, job)
+	}
+	return claimed, nil
+}
+
+func TestJobWorker_RetriesWithBackoffAndFails(t *testing.T) {
+	repo := &stubJobRepo{}
+	jobs := NewJobService(repo)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	jobs.WithClock(clock)
+
+	worker := NewJobWorker(repo)
+	worker.WithClock(clock)
+	worker.WithConcurrency(2)
+	var ran []string
+	var mu sync.Mutex
+	worker.Handle("transcode", func(ctx context.Context, job core.Job) error {
+		mu.Lock()
+		defer mu.Unlock()
+		ran = append(ran, string(job.Payload))
+		if string(job.Payload) == "broken" {
+			return errors.New("unsupported codec")
+		}
+		return nil
+	}, JobRetryPolicy{MaxAttempts: 2, InitialBackoff: time.Minute, MaxBackoff: time.Hour})
+	worker.Handle("crash", func(ctx context.Context, job core.Job) error {
+		panic("nil transcript")
+	}, JobRetryPolicy{MaxAttempts: 1})
+
+	ctx := context.Background()
+	for _, req := range []core.JobRequest{
+		{Kind: "transcode", Payload: []byte("ok")},
+		{Kind: "transcode", Payload: []byte("broken")},
+		{Kind: "crash"},
+		{Kind: "unhandled"},
+	} {
+		if _, err := jobs.Enqueue(ctx, req); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+	}
+	if _, err := jobs.Enqueue(ctx, core.JobRequest{Kind: " "}); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected ErrValidation for a missing kind, got %v", err)
+	}
+
+	if n, err := worker.RunJobs(ctx); err != nil || n != 2 {
+		t.Fatalf("RunJobs() = %d, %v; want 2 jobs", n, err)
+	}
+	if n, err := worker.RunJobs(ctx); err != nil || n != 1 {
+		t.Fatalf("RunJobs() = %d, %v; want the crashing job", n, err)
+	}
+	if n, _ := worker.RunJobs(ctx); n != 0 {
+		t.Fatalf("expected the failed job to wait for its backoff, ran %d", n)
+	}
+
+	byPayload := func(payload string) core.Job {
+		idx := slices.IndexFunc(repo.jobs, func(job core.Job) bool { return string(job.Payload) == payload })
+		return repo.jobs[idx]
+	}
+	if job := byPayload("ok"); job.Status != core.JobStatusSucceeded || job.FinishedAt == nil || job.LockedUntil != nil {
+		t.Fatalf("unexpected succeeded job %+v", job)
+	}
+	if job := byPayload("broken"); job.Status != core.JobStatusPending || job.LastError != "unsupported codec" || !job.RunAt.Equal(now.Add(time.Minute)) {
+		t.Fatalf("unexpected retry state %+v", job)
+	}
+	crashed := repo.jobs[2]
+	if crashed.Status != core.JobStatusFailed || crashed.LastError != "job crash panicked: nil transcript" {
+		t.Fatalf("expected the panicking job to fail, got %+v", crashed)
+	}
+	if unhandled := repo.jobs[3]; unhandled.Status != core.JobStatusPending || unhandled.Attempts != 0 {
+		t.Fatalf("expected jobs without a handler to stay queued, got %+v", unhandled)
+	}
+
+	now = now.Add(time.Minute)
+	if n, _ := worker.RunJobs(ctx); n != 1 {
+		t.Fatalf("expected the retry to run, ran %d", n)
+	}
+	broken := byPayload("broken")
+	if broken.Status != core.JobStatusFailed || broken.Attempts != 2 {
+		t.Fatalf("expected the job to fail after its last attempt, got %+v", broken)
+	}
+
+	retried, err := jobs.RetryJob(ctx, broken.ID)
+	if err != nil {
+		t.Fatalf("RetryJob() error = %v", err)
+	}
+	if retried.Status != core.JobStatusPending || retried.Attempts != 0 || retried.FinishedAt != nil {
+		t.Fatalf("unexpected retried job %+v", retried)
+	}
+	if _, err := jobs.RetryJob(ctx, broken.ID); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected ErrInvalidState when retrying a pending job, got %v", err)
+	}
+}
+
+func TestJobRetryPolicy_Backoff(t *testing.T) {
+	for attempts, want := range map[int]time.Duration{
+		1:  10 * time.Second,
+		2:  20 * time.Second,
+		3:  40 * time.Second,
+		20: time.Hour,
+	} {
+		if got := DefaultJobRetryPolicy.backoff(attempts); got != want {
+			t.Fatalf("backoff(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
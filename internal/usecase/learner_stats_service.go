@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	defaultSummaryWeeks = 4
+	maxSummaryWeeks     = 52
+	oneDay              = 24 * time.Hour
+)
+
+// LearnerStatsService records learner activity and derives streaks and summaries from it.
+type LearnerStatsService struct {
+	repo core.LearnerActivityRepository
+	now  func() time.Time
+}
+
+// NewLearnerStatsService constructs a learner stats service backed by the repository.
+func NewLearnerStatsService(repo core.LearnerActivityRepository) *LearnerStatsService {
+	return &LearnerStatsService{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *LearnerStatsService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.LearnerStatsService = (*LearnerStatsService)(nil)
+
+// RecordActivity adds listening minutes and completed episodes to the learner's day.
+func (s *LearnerStatsService) RecordActivity(ctx context.Context, activity core.LearnerActivity) (*core.LearnerActivity, error) {
+	activity.UserID = strings.TrimSpace(activity.UserID)
+	if activity.UserID == "" {
+		return nil, fmt.Errorf("%w: user id is required", core.ErrValidation)
+	}
+	if activity.MinutesListened < 0 || activity.EpisodesCompleted < 0 {
+		return nil, fmt.Errorf("%w: activity counters must not be negative", core.ErrValidation)
+	}
+	if activity.MinutesListened == 0 && activity.EpisodesCompleted == 0 {
+		return nil, fmt.Errorf("%w: activity must record minutes or completed episodes", core.ErrValidation)
+	}
+
+	if activity.Day.IsZero() {
+		activity.Day = s.now()
+	}
+	activity.Day = truncateDay(activity.Day)
+
+	return s.repo.RecordActivity(ctx, activity)
+}
+
+// GetLearnerStats computes lifetime totals and the current and longest streaks.
+func (s *LearnerStatsService) GetLearnerStats(ctx context.Context, userID string) (*core.LearnerStats, error) {
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", core.ErrValidation)
+	}
+
+	activities, err := s.repo.ListActivity(ctx, userID, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &core.LearnerStats{UserID: userID}
+	var (
+		previous time.Time
+		streak   int
+	)
+	for _, activity := range activities {
+		if activity.MinutesListened == 0 && activity.EpisodesCompleted == 0 {
+			continue
+		}
+		current := truncateDay(activity.Day)
+		if !previous.IsZero() && current.Sub(previous) == oneDay {
+			streak++
+		} else {
+			streak = 1
+		}
+		previous = current
+
+		stats.ActiveDays++
+		stats.TotalMinutesListened += activity.MinutesListened
+		stats.TotalEpisodesCompleted += activity.EpisodesCompleted
+		stats.LongestStreakDays = max(stats.LongestStreakDays, streak)
+	}
+
+	if !previous.IsZero() {
+		lastActive := previous
+		stats.LastActiveDay = &lastActive
+
+		// A streak stays alive until the learner misses a full day, so activity
+		// yesterday still counts while today is in progress.
+		if truncateDay(s.now()).Sub(previous) <= oneDay {
+			stats.CurrentStreakDays = streak
+		}
+	}
+
+	return stats, nil
+}
+
+// ListWeeklySummaries aggregates activity into the most recent weeks, newest first.
+func (s *LearnerStatsService) ListWeeklySummaries(ctx context.Context, userID string, weeks int) ([]core.WeeklySummary, error) {
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", core.ErrValidation)
+	}
+	if weeks <= 0 {
+		weeks = defaultSummaryWeeks
+	}
+	if weeks > maxSummaryWeeks {
+		weeks = maxSummaryWeeks
+	}
+
+	currentWeek := weekStart(s.now())
+	since := currentWeek.AddDate(0, 0, -7*(weeks-1))
+
+	activities, err := s.repo.ListActivity(ctx, userID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]core.WeeklySummary, weeks)
+	for i := range summaries {
+		summaries[i].WeekStart = currentWeek.AddDate(0, 0, -7*i)
+	}
+	for _, activity := range activities {
+		index := int(currentWeek.Sub(weekStart(activity.Day)) / (7 * oneDay))
+		if index < 0 || index >= weeks {
+			continue
+		}
+		summary := &summaries[index]
+		if activity.MinutesListened > 0 || activity.EpisodesCompleted > 0 {
+			summary.ActiveDays++
+		}
+		summary.MinutesListened += activity.MinutesListened
+		summary.EpisodesCompleted += activity.EpisodesCompleted
+	}
+
+	return summaries, nil
+}
+
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func weekStart(t time.Time) time.Time {
+	d := truncateDay(t)
+	offset := (int(d.Weekday()) + 6) % 7
+	return d.AddDate(0, 0, -offset)
+}
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestLearnerStatsService_GetLearnerStats(t *testing.T) {
+	// Wednesday afternoon; the learner has not practised yet today.
+	fixedNow := time.Date(2024, 5, 15, 15, 0, 0, 0, time.UTC)
+	repo := &stubLearnerActivityRepo{
+		activities: []core.LearnerActivity{
+			{UserID: "u1", Day: date(2024, 5, 1), MinutesListened: 10},
+			{UserID: "u1", Day: date(2024, 5, 2), MinutesListened: 5},
+			{UserID: "u1", Day: date(2024, 5, 3), MinutesListened: 5, EpisodesCompleted: 1},
+			{UserID: "u1", Day: date(2024, 5, 13), MinutesListened: 20},
+			{UserID: "u1", Day: date(2024, 5, 14), EpisodesCompleted: 2},
+		},
+	}
+	service := NewLearnerStatsService(repo)
+	service.WithClock(func() time.Time { return fixedNow })
+
+	stats, err := service.GetLearnerStats(context.Background(), "u1")
+	if err != nil {
+		t.Fatalf("GetLearnerStats() error = %v", err)
+	}
+	if stats.CurrentStreakDays != 2 {
+		t.Fatalf("expected current streak 2, got %d", stats.CurrentStreakDays)
+	}
+	if stats.LongestStreakDays != 3 {
+		t.Fatalf("expected longest streak 3, got %d", stats.LongestStreakDays)
+	}
+	if stats.ActiveDays != 5 || stats.TotalMinutesListened != 40 || stats.TotalEpisodesCompleted != 3 {
+		t.Fatalf("unexpected totals %#v", stats)
+	}
+
+	service.WithClock(func() time.Time { return fixedNow.AddDate(0, 0, 2) })
+	stats, err = service.GetLearnerStats(context.Background(), "u1")
+	if err != nil {
+		t.Fatalf("GetLearnerStats() error = %v", err)
+	}
+	if stats.CurrentStreakDays != 0 {
+		t.Fatalf("expected broken streak, got %d", stats.CurrentStreakDays)
+	}
+}
+
+func TestLearnerStatsService_ListWeeklySummaries(t *testing.T) {
+	fixedNow := time.Date(2024, 5, 15, 15, 0, 0, 0, time.UTC)
+	repo := &stubLearnerActivityRepo{
+		activities: []core.LearnerActivity{
+			{UserID: "u1", Day: date(2024, 5, 6), MinutesListened: 10},
+			{UserID: "u1", Day: date(2024, 5, 12), MinutesListened: 5, EpisodesCompleted: 1},
+			{UserID: "u1", Day: date(2024, 5, 13), MinutesListened: 20},
+		},
+	}
+	service := NewLearnerStatsService(repo)
+	service.WithClock(func() time.Time { return fixedNow })
+
+	summaries, err := service.ListWeeklySummaries(context.Background(), "u1", 3)
+	if err != nil {
+		t.Fatalf("ListWeeklySummaries() error = %v", err)
+	}
+	if len(summaries) != 3 {
+		t.Fatalf("expected 3 summaries, got %d", len(summaries))
+	}
+	if !summaries[0].WeekStart.Equal(date(2024, 5, 13)) || summaries[0].MinutesListened != 20 {
+		t.Fatalf("unexpected current week %#v", summaries[0])
+	}
+	if summaries[1].ActiveDays != 2 || summaries[1].MinutesListened != 15 || summaries[1].EpisodesCompleted != 1 {
+		t.Fatalf("unexpected previous week %#v", summaries[1])
+	}
+	if summaries[2].ActiveDays != 0 {
+		t.Fatalf("expected empty oldest week, got %#v", summaries[2])
+	}
+	if !repo.since.Equal(date(2024, 4, 29)) {
+		t.Fatalf("expected activity since 2024-04-29, got %v", repo.since)
+	}
+}
+
+func TestLearnerStatsService_RecordActivity(t *testing.T) {
+	fixedNow := time.Date(2024, 5, 15, 15, 0, 0, 0, time.UTC)
+	repo := &stubLearnerActivityRepo{}
+	service := NewLearnerStatsService(repo)
+	service.WithClock(func() time.Time { return fixedNow })
+
+	if _, err := service.RecordActivity(context.Background(), core.LearnerActivity{UserID: "u1"}); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected validation error for empty activity, got %v", err)
+	}
+
+	recorded, err := service.RecordActivity(context.Background(), core.LearnerActivity{UserID: " u1 ", MinutesListened: 5})
+	if err != nil {
+		t.Fatalf("RecordActivity() error = %v", err)
+	}
+	if recorded.UserID != "u1" || !recorded.Day.Equal(date(2024, 5, 15)) {
+		t.Fatalf("unexpected recorded activity %#v", recorded)
+	}
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+type stubLearnerActivityRepo struct {
+	activities []core.LearnerActivity
+	since      time.Time
+}
+
+func (s *stubLearnerActivityRepo) RecordActivity(ctx context.Context, activity core.LearnerActivity) (*core.LearnerActivity, error) {
+	s.activities = append(s.activities, activity)
+	return &activity, nil
+}
+
+func (s *stubLearnerActivityRepo) ListActivity(ctx context.Context, userID string, since time.Time) ([]core.LearnerActivity, error) {
+	s.since = since
+	var out []core.LearnerActivity
+	for _, activity := range s.activities {
+		if activity.UserID == userID && !activity.Day.Before(since) {
+			out = append(out, activity)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubLearnerActivityRepo) ListActiveUsers(ctx context.Context, day time.Time) ([]string, error) {
+	var out []string
+	for _, activity := range s.activities {
+		if activity.Day.Equal(day) && (activity.MinutesListened > 0 || activity.EpisodesCompleted > 0) {
+			out = append(out, activity.UserID)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// Sizes of the embedded player; audio players only need room for controls.
+const (
+	playerEmbedWidth       = 640
+	playerEmbedVideoHeight = 360
+	playerEmbedAudioHeight = 160
+)
+
+// LinkPreviewService previews published series and episodes for link
+// unfurling and embedding. It reads through the catalog, so it only sees what
+// the public catalog serves, and recognizes the page URLs the sitemap lists.
+type LinkPreviewService struct {
+	catalog    core.CatalogService
+	series     core.SeriesRepository
+	seriesURL  string
+	episodeURL string
+	embedURL   string
+	cdn        core.CDNService
+}
+
+// NewLinkPreviewService constructs a link preview service. seriesURL and
+// episodeURL are the page URL templates of the sitemap and embedURL the
+// template of the player page; any of them may be empty.
+func NewLinkPreviewService(catalog core.CatalogService, series core.SeriesRepository, seriesURL, episodeURL, embedURL string) *LinkPreviewService {
+	return &LinkPreviewService{
+		catalog:    catalog,
+		series:     series,
+		seriesURL:  seriesURL,
+		episodeURL: episodeURL,
+		embedURL:   embedURL,
+	}
+}
+
+// WithCDN serves preview images from the CDN.
+func (s *LinkPreviewService) WithCDN(cdn core.CDNService) {
+	s.cdn = cdn
+}
+
+var _ core.LinkPreviewService = (*LinkPreviewService)(nil)
+
+// PreviewURL previews the series or episode a page URL points at. URLs that
+// match neither page URL template are reported as not found.
+func (s *LinkPreviewService) PreviewURL(ctx context.Context, rawURL string) (*core.LinkPreview, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("%w: invalid url %q", core.ErrValidation, rawURL)
+	}
+
+	if values, ok := matchPageURL(s.episodeURL, u); ok {
+		if raw, ok := values["episode_id"]; ok {
+			id, err := uuid.Parse(raw)
+			if err != nil {
+				return nil, fmt.Errorf("%w: no episode at %s", core.ErrNotFound, rawURL)
+			}
+			return s.PreviewEpisode(ctx, id)
+		}
+		seq, err := strconv.ParseUint(values["episode_seq"], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w: no episode at %s", core.ErrNotFound, rawURL)
+		}
+		series, err := s.seriesAt(ctx, values, rawURL)
+		if err != nil {
+			return nil, err
+		}
+		episode, ok := lo.Find(series.Episodes, func(episode core.Episode) bool {
+			return episode.Seq == uint32(seq)
+		})
+		if !ok {
+			return nil, fmt.Errorf("%w: no episode at %s", core.ErrNotFound, rawURL)
+		}
+		return s.episodePreview(*series, episode), nil
+	}
+
+	if values, ok := matchPageURL(s.seriesURL, u); ok {
+		series, err := s.seriesAt(ctx, values, rawURL)
+		if err != nil {
+			return nil, err
+		}
+		return s.seriesPreview(*series), nil
+	}
+	return nil, fmt.Errorf("%w: no series or episode at %s", core.ErrNotFound, rawURL)
+}
+
+// PreviewSeries previews a published series.
+func (s *LinkPreviewService) PreviewSeries(ctx context.Context, id uuid.UUID) (*core.LinkPreview, error) {
+	series, err := s.catalog.GetSeries(ctx, id, true)
+	if err != nil {
+		return nil, err
+	}
+	return s.seriesPreview(*series), nil
+}
+
+// PreviewEpisode previews a published episode of a published series.
+func (s *LinkPreviewService) PreviewEpisode(ctx context.Context, id uuid.UUID) (*core.LinkPreview, error) {
+	episode, err := s.catalog.GetEpisode(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	series, err := s.catalog.GetSeries(ctx, episode.SeriesID, false)
+	if err != nil {
+		return nil, err
+	}
+	return s.episodePreview(*series, *episode), nil
+}
+
+// seriesAt loads the published series, with its episodes, that the
+// placeholders captured from a page URL identify.
+func (s *LinkPreviewService) seriesAt(ctx context.Context, values map[string]string, rawURL string) (*core.Series, error) {
+	if raw, ok := values["series_id"]; ok {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: no series at %s", core.ErrNotFound, rawURL)
+		}
+		return s.catalog.GetSeries(ctx, id, true)
+	}
+	slug, ok := values["series_slug"]
+	if !ok {
+		return nil, fmt.Errorf("%w: no series at %s", core.ErrNotFound, rawURL)
+	}
+	series, err := s.series.GetSeriesBySlug(ctx, slug, core.SeriesQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return s.catalog.GetSeries(ctx, series.ID, true)
+}
+
+// seriesPreview previews a series whose published episodes are loaded. The
+// player of a series starts at its first episode.
+func (s *LinkPreviewService) seriesPreview(series core.Series) *core.LinkPreview {
+	preview := s.preview(series, core.Episode{})
+	preview.Title = series.Title
+	preview.Description = series.Summary
+	preview.Duration = lo.SumBy(series.Episodes, func(episode core.Episode) time.Duration {
+		return episode.Duration
+	})
+	if len(series.Episodes) > 0 {
+		preview.MediaType = series.Episodes[0].Resource.Type
+	}
+	s.embed(preview, series, core.Episode{})
+	return preview
+}
+
+func (s *LinkPreviewService) episodePreview(series core.Series, episode core.Episode) *core.LinkPreview {
+	preview := s.preview(series, episode)
+	preview.EpisodeID = episode.ID
+	preview.Title = episode.Title
+	preview.Description = lo.CoalesceOrEmpty(episode.Description, series.Summary)
+	preview.Duration = episode.Duration
+	preview.MediaType = episode.Resource.Type
+	s.embed(preview, series, episode)
+	return preview
+}
+
+func (s *LinkPreviewService) preview(series core.Series, episode core.Episode) *core.LinkPreview {
+	template := s.seriesURL
+	if episode.ID != uuid.Nil {
+		template = s.episodeURL
+	}
+	preview := &core.LinkPreview{SeriesID: series.ID, ImageURL: series.CoverURL}
+	if template != "" {
+		preview.URL = expandPageURL(template, series, episode)
+	}
+	if preview.ImageURL != "" {
+		card, _ := lo.Find(imageVariantSpecs, func(spec core.ImageVariantSpec) bool {
+			return spec.Name == core.ImageVariantCard
+		})
+		preview.ImageWidth, preview.ImageHeight = card.Width, card.Height
+		if s.cdn != nil {
+			preview.ImageURL = s.cdn.RewriteURL(preview.ImageURL)
+		}
+	}
+	return preview
+}
+
+func (s *LinkPreviewService) embed(preview *core.LinkPreview, series core.Series, episode core.Episode) {
+	if s.embedURL == "" {
+		return
+	}
+	preview.EmbedURL = expandPageURL(s.embedURL, series, episode)
+	preview.EmbedWidth = playerEmbedWidth
+	preview.EmbedHeight = playerEmbedVideoHeight
+	if preview.MediaType == core.MediaTypeAudio {
+		preview.EmbedHeight = playerEmbedAudioHeight
+	}
+}
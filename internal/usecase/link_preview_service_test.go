@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestLinkPreviewService(t *testing.T) {
+	series := core.Series{
+		ID:       uuid.New(),
+		Slug:     "coffee-talk",
+		Title:    "Coffee Talk",
+		Summary:  "Daily chats",
+		CoverURL: "https://img.example.com/cover.jpg",
+		Status:   core.SeriesStatusPublished,
+		Episodes: []core.Episode{
+			{ID: uuid.New(), Seq: 1, Title: "Espresso", Description: "Short", Duration: 2 * time.Minute, Status: core.EpisodeStatusPublished, Resource: core.MediaResource{Type: core.MediaTypeAudio}},
+			{ID: uuid.New(), Seq: 2, Title: "Latte", Duration: 3 * time.Minute, Status: core.EpisodeStatusPublished, Resource: core.MediaResource{Type: core.MediaTypeAudio}},
+			{ID: uuid.New(), Seq: 3, Title: "Draft", Duration: time.Hour, Status: core.EpisodeStatusDraft},
+		},
+	}
+	series.Episodes[1].SeriesID = series.ID
+	repo := &stubSeriesRepo{
+		getSeriesFn: func(ctx context.Context, id uuid.UUID, opts core.SeriesQueryOptions) (*core.Series, error) {
+			if id != series.ID {
+				return nil, core.ErrNotFound
+			}
+			found := series
+			return &found, nil
+		},
+		getBySlugFn: func(ctx context.Context, slug string, opts core.SeriesQueryOptions) (*core.Series, error) {
+			if slug != series.Slug {
+				return nil, core.ErrNotFound
+			}
+			found := series
+			return &found, nil
+		},
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			for _, episode := range series.Episodes {
+				if episode.ID == id {
+					return &episode, nil
+				}
+			}
+			return nil, core.ErrNotFound
+		},
+	}
+	service := NewLinkPreviewService(NewCatalogService(repo), repo,
+		"https://example.com/series/{series_slug}",
+		"https://example.com/series/{series_slug}/{episode_seq}",
+		"https://example.com/embed/{series_id}?episode={episode_id}")
+	ctx := context.Background()
+
+	preview, err := service.PreviewURL(ctx, "http://EXAMPLE.com/series/coffee-talk/?utm_source=chat")
+	if err != nil {
+		t.Fatalf("PreviewURL(series) error = %v", err)
+	}
+	if preview.Title != "Coffee Talk" || preview.Description != "Daily chats" || preview.Duration != 5*time.Minute || preview.EpisodeID != uuid.Nil {
+		t.Fatalf("unexpected series preview %+v", preview)
+	}
+	if preview.URL != "https://example.com/series/coffee-talk" || preview.EmbedURL != "https://example.com/embed/"+series.ID.String()+"?episode=" {
+		t.Fatalf("unexpected series urls %q %q", preview.URL, preview.EmbedURL)
+	}
+	if preview.ImageWidth != 640 || preview.EmbedWidth != playerEmbedWidth || preview.EmbedHeight != playerEmbedAudioHeight {
+		t.Fatalf("unexpected sizes %+v", preview)
+	}
+
+	preview, err = service.PreviewURL(ctx, "https://example.com/series/coffee-talk/2")
+	if err != nil {
+		t.Fatalf("PreviewURL(episode) error = %v", err)
+	}
+	episode := series.Episodes[1]
+	if preview.EpisodeID != episode.ID || preview.Title != "Latte" || preview.Description != "Daily chats" || preview.Duration != 3*time.Minute {
+		t.Fatalf("unexpected episode preview %+v", preview)
+	}
+	if preview.URL != "https://example.com/series/coffee-talk/2" || preview.EmbedURL != "https://example.com/embed/"+series.ID.String()+"?episode="+episode.ID.String() {
+		t.Fatalf("unexpected episode urls %q %q", preview.URL, preview.EmbedURL)
+	}
+
+	byID, err := service.PreviewEpisode(ctx, episode.ID)
+	if err != nil || *byID != *preview {
+		t.Fatalf("expected the same preview by id, got %+v (%v)", byID, err)
+	}
+
+	for _, rawURL := range []string{
+		"https://example.com/series/coffee-talk/3",
+		"https://example.com/series/other",
+		"https://other.example.com/series/coffee-talk",
+		"https://example.com/about",
+	} {
+		if _, err := service.PreviewURL(ctx, rawURL); !errors.Is(err, core.ErrNotFound) {
+			t.Fatalf("PreviewURL(%s) expected not found, got %v", rawURL, err)
+		}
+	}
+	if _, err := service.PreviewURL(ctx, "not a url"); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected an invalid url to fail validation, got %v", err)
+	}
+}
@@ -0,0 +1,347 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	ltiLoginStateTTL          = 10 * time.Minute
+	maxLTIDeepLinkingEpisodes = 50
+	ltiEpisodeParam           = "episode_id"
+)
+
+// LTIService lets LMS platforms embed episodes through LTI 1.3 and receive
+// completion scores through Assignment and Grade Services.
+type LTIService struct {
+	repo    core.LTIRepository
+	series  core.SeriesRepository
+	history core.WatchHistoryRepository
+	client  core.LTIClient
+	now     func() time.Time
+}
+
+// NewLTIService constructs an LTI service using the supplied repositories and protocol client.
+func NewLTIService(repo core.LTIRepository, series core.SeriesRepository, history core.WatchHistoryRepository, client core.LTIClient) *LTIService {
+	return &LTIService{
+		repo:    repo,
+		series:  series,
+		history: history,
+		client:  client,
+		now:     time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *LTIService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.LTIService = (*LTIService)(nil)
+
+// RegisterPlatform stores an LMS registration.
+func (s *LTIService) RegisterPlatform(ctx context.Context, platform core.LTIPlatform) (*core.LTIPlatform, error) {
+	platform.Issuer = strings.TrimSpace(platform.Issuer)
+	platform.ClientID = strings.TrimSpace(platform.ClientID)
+	platform.DeploymentID = strings.TrimSpace(platform.DeploymentID)
+	switch {
+	case platform.Issuer == "":
+		return nil, fmt.Errorf("%w: issuer is required", core.ErrValidation)
+	case platform.ClientID == "":
+		return nil, fmt.Errorf("%w: client id is required", core.ErrValidation)
+	}
+	for name, raw := range map[string]string{
+		"auth login url": platform.AuthLoginURL,
+		"auth token url": platform.AuthTokenURL,
+		"jwks url":       platform.JWKSURL,
+	} {
+		if u, err := url.Parse(raw); err != nil || u.Scheme != "https" || u.Host == "" {
+			return nil, fmt.Errorf("%w: %s must be an absolute https url", core.ErrValidation, name)
+		}
+	}
+
+	platform.ID = uuid.New()
+	return s.repo.CreatePlatform(ctx, platform)
+}
+
+// ListPlatforms returns every registered platform.
+func (s *LTIService) ListPlatforms(ctx context.Context) ([]core.LTIPlatform, error) {
+	return s.repo.ListPlatforms(ctx)
+}
+
+// InitiateLogin answers a platform's third-party login initiation with the
+// OIDC authorization request the browser must be redirected to.
+func (s *LTIService) InitiateLogin(ctx context.Context, req core.LTILoginRequest) (string, error) {
+	if strings.TrimSpace(req.Issuer) == "" || strings.TrimSpace(req.ClientID) == "" {
+		return "", fmt.Errorf("%w: iss and client_id are required", core.ErrValidation)
+	}
+	if strings.TrimSpace(req.LoginHint) == "" {
+		return "", fmt.Errorf("%w: login_hint is required", core.ErrValidation)
+	}
+
+	platform, err := s.repo.FindPlatform(ctx, req.Issuer, req.ClientID)
+	if err != nil {
+		if isNotFound(err) {
+			return "", fmt.Errorf("%w: no platform registered for issuer %q", core.ErrNotFound, req.Issuer)
+		}
+		return "", err
+	}
+
+	state, err := randomLTIToken()
+	if err != nil {
+		return "", err
+	}
+	nonce, err := randomLTIToken()
+	if err != nil {
+		return "", err
+	}
+	if err := s.repo.SaveLoginState(ctx, core.LTILoginState{
+		State:      state,
+		Nonce:      nonce,
+		PlatformID: platform.ID,
+		ExpiresAt:  s.now().UTC().Add(ltiLoginStateTTL),
+	}); err != nil {
+		return "", err
+	}
+
+	authURL, err := url.Parse(platform.AuthLoginURL)
+	if err != nil {
+		return "", err
+	}
+	query := authURL.Query()
+	query.Set("scope", "openid")
+	query.Set("response_type", "id_token")
+	query.Set("response_mode", "form_post")
+	query.Set("prompt", "none")
+	query.Set("client_id", platform.ClientID)
+	query.Set("redirect_uri", s.client.RedirectURI())
+	query.Set("login_hint", req.LoginHint)
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	if req.LTIMessageHint != "" {
+		query.Set("lti_message_hint", req.LTIMessageHint)
+	}
+	authURL.RawQuery = query.Encode()
+	return authURL.String(), nil
+}
+
+// Launch verifies the platform's id_token and records the launch. Resource
+// link launches must target a published episode through the episode_id custom
+// parameter or target link query.
+func (s *LTIService) Launch(ctx context.Context, idToken, state string) (*core.LTILaunch, error) {
+	if strings.TrimSpace(idToken) == "" || strings.TrimSpace(state) == "" {
+		return nil, fmt.Errorf("%w: id_token and state are required", core.ErrValidation)
+	}
+
+	login, err := s.repo.ConsumeLoginState(ctx, state)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, fmt.Errorf("%w: unknown or reused login state", core.ErrValidation)
+		}
+		return nil, err
+	}
+	now := s.now().UTC()
+	if now.After(login.ExpiresAt) {
+		return nil, fmt.Errorf("%w: login state expired", core.ErrValidation)
+	}
+
+	platform, err := s.repo.GetPlatform(ctx, login.PlatformID)
+	if err != nil {
+		return nil, err
+	}
+	message, err := s.client.VerifyLaunch(ctx, *platform, idToken)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case message.Nonce != login.Nonce:
+		return nil, fmt.Errorf("%w: id_token nonce does not match the login", core.ErrValidation)
+	case platform.DeploymentID != "" && message.DeploymentID != platform.DeploymentID:
+		return nil, fmt.Errorf("%w: unknown deployment %q", core.ErrValidation, message.DeploymentID)
+	case strings.TrimSpace(message.Subject) == "":
+		return nil, fmt.Errorf("%w: anonymous launches are not supported", core.ErrValidation)
+	}
+
+	launch := core.LTILaunch{
+		ID:         uuid.New(),
+		PlatformID: platform.ID,
+		Type:       message.Type,
+		Subject:    message.Subject,
+		UserID:     ltiUserID(platform.ID, message.Subject),
+	}
+	switch message.Type {
+	case core.LTIMessageTypeResourceLink:
+		episodeID, err := ltiTargetEpisode(message)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := s.publishedEpisode(ctx, episodeID); err != nil {
+			return nil, err
+		}
+		launch.EpisodeID = episodeID
+		launch.ResourceLinkID = message.ResourceLinkID
+		launch.LineItemURL = message.LineItemURL
+	case core.LTIMessageTypeDeepLinking:
+		if message.DeepLinkReturnURL == "" {
+			return nil, fmt.Errorf("%w: deep linking request lacks a return url", core.ErrValidation)
+		}
+		launch.DeepLinkReturnURL = message.DeepLinkReturnURL
+		launch.DeepLinkData = message.DeepLinkData
+	default:
+		return nil, fmt.Errorf("%w: unsupported lti message type", core.ErrValidation)
+	}
+
+	return s.repo.CreateLaunch(ctx, launch)
+}
+
+// GetLaunch retrieves a launch by identifier.
+func (s *LTIService) GetLaunch(ctx context.Context, id uuid.UUID) (*core.LTILaunch, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: launch id required", core.ErrValidation)
+	}
+	return s.repo.GetLaunch(ctx, id)
+}
+
+// CreateDeepLinkingResponse signs the episodes an instructor picked during a
+// deep linking launch so the browser can return them to the platform.
+func (s *LTIService) CreateDeepLinkingResponse(ctx context.Context, launchID uuid.UUID, episodeIDs []uuid.UUID) (*core.LTIDeepLinkingResponse, error) {
+	switch {
+	case launchID == uuid.Nil:
+		return nil, fmt.Errorf("%w: launch id required", core.ErrValidation)
+	case len(episodeIDs) == 0:
+		return nil, fmt.Errorf("%w: at least one episode is required", core.ErrValidation)
+	case len(episodeIDs) > maxLTIDeepLinkingEpisodes:
+		return nil, fmt.Errorf("%w: at most %d episodes can be linked at once", core.ErrValidation, maxLTIDeepLinkingEpisodes)
+	}
+
+	launch, err := s.repo.GetLaunch(ctx, launchID)
+	if err != nil {
+		return nil, err
+	}
+	if launch.Type != core.LTIMessageTypeDeepLinking {
+		return nil, fmt.Errorf("%w: launch is not a deep linking request", core.ErrInvalidState)
+	}
+	platform, err := s.repo.GetPlatform(ctx, launch.PlatformID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]core.LTIContentItem, 0, len(episodeIDs))
+	for _, episodeID := range episodeIDs {
+		episode, err := s.publishedEpisode(ctx, episodeID)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, core.LTIContentItem{EpisodeID: episode.ID, Title: episode.Title})
+	}
+
+	jwt, err := s.client.SignDeepLinkingResponse(*platform, platform.DeploymentID, launch.DeepLinkData, items)
+	if err != nil {
+		return nil, err
+	}
+	return &core.LTIDeepLinkingResponse{ReturnURL: launch.DeepLinkReturnURL, JWT: jwt}, nil
+}
+
+// SubmitScore posts full marks to the platform gradebook once the launching
+// learner has finished the episode. Resubmitting is harmless: AGS keeps the
+// latest score for the learner.
+func (s *LTIService) SubmitScore(ctx context.Context, launchID uuid.UUID) (*core.LTILaunch, error) {
+	if launchID == uuid.Nil {
+		return nil, fmt.Errorf("%w: launch id required", core.ErrValidation)
+	}
+
+	launch, err := s.repo.GetLaunch(ctx, launchID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case launch.Type != core.LTIMessageTypeResourceLink:
+		return nil, fmt.Errorf("%w: only resource link launches are graded", core.ErrInvalidState)
+	case launch.LineItemURL == "":
+		return nil, fmt.Errorf("%w: platform did not enable grade passback for this link", core.ErrInvalidState)
+	}
+
+	finished, err := s.history.FirstFinishedAt(ctx, launch.UserID, []uuid.UUID{launch.EpisodeID})
+	if err != nil {
+		return nil, err
+	}
+	finishedAt, ok := finished[launch.EpisodeID]
+	if !ok {
+		return nil, fmt.Errorf("%w: episode has not been finished yet", core.ErrInvalidState)
+	}
+
+	platform, err := s.repo.GetPlatform(ctx, launch.PlatformID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.PublishScore(ctx, *platform, launch.LineItemURL, core.LTIScore{
+		Subject:      launch.Subject,
+		ScoreGiven:   1,
+		ScoreMaximum: 1,
+		Completed:    true,
+		Timestamp:    finishedAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.repo.MarkScoreSubmitted(ctx, launch.ID, s.now().UTC())
+}
+
+// PublicKeySet returns the tool's JWKS for platforms to verify its messages.
+func (s *LTIService) PublicKeySet() ([]byte, error) {
+	return s.client.PublicKeySet()
+}
+
+func (s *LTIService) publishedEpisode(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+	episode, err := s.series.GetEpisode(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if episode.Status != core.EpisodeStatusPublished || episode.DeletedAt != nil {
+		return nil, fmt.Errorf("%w: episode %s is not published", core.ErrInvalidState, id)
+	}
+	return episode, nil
+}
+
+// ltiTargetEpisode reads the launched episode from the resource link's custom
+// parameters, falling back to the target link URI query.
+func ltiTargetEpisode(message *core.LTIMessage) (uuid.UUID, error) {
+	raw := message.Custom[ltiEpisodeParam]
+	if raw == "" && message.TargetLinkURI != "" {
+		if target, err := url.Parse(message.TargetLinkURI); err == nil {
+			raw = target.Query().Get(ltiEpisodeParam)
+		}
+	}
+	if raw == "" {
+		return uuid.Nil, fmt.Errorf("%w: launch does not target an episode", core.ErrValidation)
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: invalid episode_id %q", core.ErrValidation, raw)
+	}
+	return id, nil
+}
+
+// ltiUserID scopes platform subjects so learners from different LMSs never collide.
+func ltiUserID(platformID uuid.UUID, subject string) string {
+	return "lti:" + platformID.String() + ":" + subject
+}
+
+func randomLTIToken() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,289 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubLTIRepo struct {
+	platform *core.LTIPlatform
+	states   map[string]core.LTILoginState
+	launches map[uuid.UUID]core.LTILaunch
+}
+
+func newStubLTIRepo(platform core.LTIPlatform) *stubLTIRepo {
+	return &stubLTIRepo{
+		platform: &platform,
+		states:   map[string]core.LTILoginState{},
+		launches: map[uuid.UUID]core.LTILaunch{},
+	}
+}
+
+func (s *stubLTIRepo) CreatePlatform(ctx context.Context, platform core.LTIPlatform) (*core.LTIPlatform, error) {
+	s.platform = &platform
+	return &platform, nil
+}
+
+func (s *stubLTIRepo) GetPlatform(ctx context.Context, id uuid.UUID) (*core.LTIPlatform, error) {
+	if s.platform == nil || s.platform.ID != id {
+		return nil, core.ErrNotFound
+	}
+	return s.platform, nil
+}
+
+func (s *stubLTIRepo) FindPlatform(ctx context.Context, issuer, clientID string) (*core.LTIPlatform, error) {
+	if s.platform == nil || s.platform.Issuer != issuer || s.platform.ClientID != clientID {
+		return nil, core.ErrNotFound
+	}
+	return s.platform, nil
+}
+
+func (s *stubLTIRepo) ListPlatforms(ctx context.Context) ([]core.LTIPlatform, error) {
+	return []core.LTIPlatform{*s.platform}, nil
+}
+
+func (s *stubLTIRepo) SaveLoginState(ctx context.Context, state core.LTILoginState) error {
+	s.states[state.State] = state
+	return nil
+}
+
+func (s *stubLTIRepo) ConsumeLoginState(ctx context.Context, state string) (*core.LTILoginState, error) {
+	login, ok := s.states[state]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	delete(s.states, state)
+	return &login, nil
+}
+
+func (s *stubLTIRepo) CreateLaunch(ctx context.Context, launch core.LTILaunch) (*core.LTILaunch, error) {
+	s.launches[launch.ID] = launch
+	return &launch, nil
+}
+
+func (s *stubLTIRepo) GetLaunch(ctx context.Context, id uuid.UUID) (*core.LTILaunch, error) {
+	launch, ok := s.launches[id]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	return &launch, nil
+}
+
+func (s *stubLTIRepo) MarkScoreSubmitted(ctx context.Context, id uuid.UUID, at time.Time) (*core.LTILaunch, error) {
+	launch := s.launches[id]
+	launch.ScoreSubmittedAt = &at
+	s.launches[id] = launch
+	return &launch, nil
+}
+
+// stubLTIClient accepts any id_token and returns the configured message.
+type stubLTIClient struct {
+	message *core.LTIMessage
+	scores  []core.LTIScore
+}
+
+func (c *stubLTIClient) RedirectURI() string {
+	return "https://tool.example.com/lti/v1/launch"
+}
+
+func (c *stubLTIClient) PublicKeySet() ([]byte, error) {
+	return []byte(`{"keys":[]}`), nil
+}
+
+func (c *stubLTIClient) VerifyLaunch(ctx context.Context, platform core.LTIPlatform, idToken string) (*core.LTIMessage, error) {
+	message := *c.message
+	return &message, nil
+}
+
+func (c *stubLTIClient) SignDeepLinkingResponse(platform core.LTIPlatform, deploymentID, data string, items []core.LTIContentItem) (string, error) {
+	return "signed", nil
+}
+
+func (c *stubLTIClient) PublishScore(ctx context.Context, platform core.LTIPlatform, lineItemURL string, score core.LTIScore) error {
+	c.scores = append(c.scores, score)
+	return nil
+}
+
+func newTestLTIService(t *testing.T, message *core.LTIMessage, sessions map[uuid.UUID]core.PlaybackSession, episode core.Episode) (*LTIService, *stubLTIRepo, *stubLTIClient) {
+	t.Helper()
+
+	repo := newStubLTIRepo(core.LTIPlatform{
+		ID:           uuid.New(),
+		Issuer:       "https://lms.example.edu",
+		ClientID:     "client-1",
+		DeploymentID: "deployment-1",
+		AuthLoginURL: "https://lms.example.edu/auth",
+		AuthTokenURL: "https://lms.example.edu/token",
+		JWKSURL:      "https://lms.example.edu/jwks",
+	})
+	series := &stubSeriesRepo{
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			if id != episode.ID {
+				return nil, core.ErrNotFound
+			}
+			return &episode, nil
+		},
+	}
+	client := &stubLTIClient{message: message}
+	service := NewLTIService(repo, series, &stubWatchHistoryRepo{sessions: sessions}, client)
+	service.WithClock(func() time.Time { return time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC) })
+	return service, repo, client
+}
+
+// loginState drives InitiateLogin and returns the state and nonce it issued.
+func loginState(t *testing.T, service *LTIService) (string, string) {
+	t.Helper()
+
+	redirect, err := service.InitiateLogin(context.Background(), core.LTILoginRequest{
+		Issuer:    "https://lms.example.edu",
+		ClientID:  "client-1",
+		LoginHint: "hint",
+	})
+	if err != nil {
+		t.Fatalf("InitiateLogin() error = %v", err)
+	}
+	parsed, err := url.Parse(redirect)
+	if err != nil {
+		t.Fatalf("parse redirect: %v", err)
+	}
+	query := parsed.Query()
+	if query.Get("redirect_uri") != "https://tool.example.com/lti/v1/launch" || query.Get("login_hint") != "hint" {
+		t.Fatalf("unexpected authorization request %s", redirect)
+	}
+	return query.Get("state"), query.Get("nonce")
+}
+
+func TestLTIService_LaunchAndSubmitScore(t *testing.T) {
+	episode := core.Episode{ID: uuid.New(), Title: "Ordering coffee", Status: core.EpisodeStatusPublished}
+	message := &core.LTIMessage{
+		Type:         core.LTIMessageTypeResourceLink,
+		DeploymentID: "deployment-1",
+		Subject:      "student-7",
+		Custom:       map[string]string{"episode_id": episode.ID.String()},
+		LineItemURL:  "https://lms.example.edu/lineitems/1",
+	}
+	sessions := map[uuid.UUID]core.PlaybackSession{}
+	service, repo, client := newTestLTIService(t, message, sessions, episode)
+	ctx := context.Background()
+
+	state, nonce := loginState(t, service)
+	message.Nonce = nonce
+	launch, err := service.Launch(ctx, "token", state)
+	if err != nil {
+		t.Fatalf("Launch() error = %v", err)
+	}
+	if launch.EpisodeID != episode.ID || launch.UserID != "lti:"+repo.platform.ID.String()+":student-7" {
+		t.Fatalf("unexpected launch %+v", launch)
+	}
+
+	if _, err := service.Launch(ctx, "token", state); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected replayed state to fail validation, got %v", err)
+	}
+
+	if _, err := service.SubmitScore(ctx, launch.ID); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected unfinished episode to be rejected, got %v", err)
+	}
+
+	finishedAt := time.Date(2025, 3, 1, 11, 30, 0, 0, time.UTC)
+	sessions[uuid.New()] = core.PlaybackSession{UserID: launch.UserID, EpisodeID: episode.ID, FinishedAt: &finishedAt}
+	graded, err := service.SubmitScore(ctx, launch.ID)
+	if err != nil {
+		t.Fatalf("SubmitScore() error = %v", err)
+	}
+	if graded.ScoreSubmittedAt == nil {
+		t.Fatal("expected score submission to be recorded")
+	}
+	if len(client.scores) != 1 {
+		t.Fatalf("expected one published score, got %d", len(client.scores))
+	}
+	if score := client.scores[0]; score.Subject != "student-7" || score.ScoreGiven != 1 || !score.Completed || !score.Timestamp.Equal(finishedAt) {
+		t.Fatalf("unexpected score %+v", score)
+	}
+}
+
+func TestLTIService_LaunchRejectsMismatches(t *testing.T) {
+	episode := core.Episode{ID: uuid.New(), Status: core.EpisodeStatusDraft}
+	tests := []struct {
+		name    string
+		message core.LTIMessage
+		want    error
+	}{
+		{
+			name:    "nonce",
+			message: core.LTIMessage{Type: core.LTIMessageTypeResourceLink, DeploymentID: "deployment-1", Subject: "s", Nonce: "other"},
+			want:    core.ErrValidation,
+		},
+		{
+			name:    "deployment",
+			message: core.LTIMessage{Type: core.LTIMessageTypeResourceLink, DeploymentID: "deployment-2", Subject: "s"},
+			want:    core.ErrValidation,
+		},
+		{
+			name:    "missing episode",
+			message: core.LTIMessage{Type: core.LTIMessageTypeResourceLink, DeploymentID: "deployment-1", Subject: "s"},
+			want:    core.ErrValidation,
+		},
+		{
+			name: "unpublished episode",
+			message: core.LTIMessage{
+				Type:          core.LTIMessageTypeResourceLink,
+				DeploymentID:  "deployment-1",
+				Subject:       "s",
+				TargetLinkURI: "https://tool.example.com/lti/v1/launch?episode_id=" + episode.ID.String(),
+			},
+			want: core.ErrInvalidState,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := tt.message
+			service, _, _ := newTestLTIService(t, &message, nil, episode)
+			state, nonce := loginState(t, service)
+			if message.Nonce == "" {
+				message.Nonce = nonce
+			}
+			if _, err := service.Launch(context.Background(), "token", state); !errors.Is(err, tt.want) {
+				t.Fatalf("Launch() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLTIService_CreateDeepLinkingResponse(t *testing.T) {
+	episode := core.Episode{ID: uuid.New(), Title: "Ordering coffee", Status: core.EpisodeStatusPublished}
+	message := &core.LTIMessage{
+		Type:              core.LTIMessageTypeDeepLinking,
+		DeploymentID:      "deployment-1",
+		Subject:           "teacher-1",
+		DeepLinkReturnURL: "https://lms.example.edu/deep-link/return",
+	}
+	service, _, _ := newTestLTIService(t, message, nil, episode)
+	ctx := context.Background()
+
+	state, nonce := loginState(t, service)
+	message.Nonce = nonce
+	launch, err := service.Launch(ctx, "token", state)
+	if err != nil {
+		t.Fatalf("Launch() error = %v", err)
+	}
+
+	response, err := service.CreateDeepLinkingResponse(ctx, launch.ID, []uuid.UUID{episode.ID})
+	if err != nil {
+		t.Fatalf("CreateDeepLinkingResponse() error = %v", err)
+	}
+	if response.ReturnURL != message.DeepLinkReturnURL || response.JWT != "signed" {
+		t.Fatalf("unexpected response %+v", response)
+	}
+
+	if _, err := service.SubmitScore(ctx, launch.ID); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected deep linking launch not to be graded, got %v", err)
+	}
+}
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// MeteringService records tenant usage and turns closed months into immutable snapshots.
+type MeteringService struct {
+	repo core.MeteringRepository
+	now  func() time.Time
+}
+
+// NewMeteringService constructs a metering service backed by the repository.
+func NewMeteringService(repo core.MeteringRepository) *MeteringService {
+	return &MeteringService{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *MeteringService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.MeteringService = (*MeteringService)(nil)
+
+// RecordUsage stores a metered quantity for a tenant.
+func (s *MeteringService) RecordUsage(ctx context.Context, record core.UsageRecord) error {
+	record.TenantID = strings.TrimSpace(record.TenantID)
+	if record.TenantID == "" {
+		return fmt.Errorf("%w: tenant id is required", core.ErrValidation)
+	}
+	if _, ok := usageMetricUnits[record.Metric]; !ok {
+		return fmt.Errorf("%w: unsupported usage metric %d", core.ErrValidation, record.Metric)
+	}
+	if record.Quantity <= 0 {
+		return fmt.Errorf("%w: usage quantity must be positive", core.ErrValidation)
+	}
+
+	if record.ID == uuid.Nil {
+		record.ID = uuid.New()
+	}
+	if record.OccurredAt.IsZero() {
+		record.OccurredAt = s.now()
+	}
+	record.OccurredAt = record.OccurredAt.UTC()
+
+	return s.repo.RecordUsage(ctx, record)
+}
+
+// CreateUsageSnapshot aggregates a closed calendar month of usage for the tenant.
+func (s *MeteringService) CreateUsageSnapshot(ctx context.Context, tenantID string, month time.Time) (*core.UsageSnapshot, error) {
+	tenantID = strings.TrimSpace(tenantID)
+	if tenantID == "" {
+		return nil, fmt.Errorf("%w: tenant id is required", core.ErrValidation)
+	}
+
+	now := s.now().UTC()
+	if month.IsZero() {
+		month = now.AddDate(0, -1, 0)
+	}
+	month = month.UTC()
+	periodStart := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := periodStart.AddDate(0, 1, 0)
+	if periodEnd.After(now) {
+		return nil, fmt.Errorf("%w: usage period %s has not closed yet", core.ErrValidation, periodStart.Format("2006-01"))
+	}
+
+	lines, err := s.repo.SumUsage(ctx, tenantID, periodStart, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.CreateUsageSnapshot(ctx, core.UsageSnapshot{
+		ID:          uuid.New(),
+		TenantID:    tenantID,
+		PeriodStart: periodStart,
+		PeriodEnd:   periodEnd,
+		Lines:       lines,
+	})
+}
+
+// ListUsageSnapshots returns stored snapshots, newest period first.
+func (s *MeteringService) ListUsageSnapshots(ctx context.Context, filter core.UsageSnapshotFilter) ([]core.UsageSnapshot, string, error) {
+	return s.repo.ListUsageSnapshots(ctx, filter)
+}
+
+// ExportInvoiceLineItems converts a snapshot into invoice line items, one per metric.
+func (s *MeteringService) ExportInvoiceLineItems(ctx context.Context, snapshotID uuid.UUID) ([]core.InvoiceLineItem, error) {
+	snapshot, err := s.repo.GetUsageSnapshot(ctx, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+
+	period := snapshot.PeriodStart.Format("2006-01")
+	lines := lo.Filter(snapshot.Lines, func(line core.UsageLine, _ int) bool {
+		return line.Quantity > 0
+	})
+	return lo.Map(lines, func(line core.UsageLine, _ int) core.InvoiceLineItem {
+		return core.InvoiceLineItem{
+			Metric:      line.Metric,
+			Description: fmt.Sprintf("%s (%s)", usageMetricNames[line.Metric], period),
+			Quantity:    line.Quantity,
+			Unit:        usageMetricUnits[line.Metric],
+			PeriodStart: snapshot.PeriodStart,
+			PeriodEnd:   snapshot.PeriodEnd,
+		}
+	}), nil
+}
+
+var usageMetricNames = map[core.UsageMetric]string{
+	core.UsageMetricStorageGBHours:    "Storage",
+	core.UsageMetricProcessingMinutes: "Media processing",
+	core.UsageMetricPlaybackMinutes:   "Playback",
+	core.UsageMetricAPICalls:          "API calls",
+	core.UsageMetricGenerationTokens:  "Lesson generation",
+}
+
+var usageMetricUnits = map[core.UsageMetric]string{
+	core.UsageMetricStorageGBHours:    "GB-hour",
+	core.UsageMetricProcessingMinutes: "minute",
+	core.UsageMetricPlaybackMinutes:   "minute",
+	core.UsageMetricAPICalls:          "request",
+	core.UsageMetricGenerationTokens:  "token",
+}
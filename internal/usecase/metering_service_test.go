@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestMeteringService_CreateUsageSnapshot(t *testing.T) {
+	fixedNow := time.Date(2024, 6, 3, 8, 0, 0, 0, time.UTC)
+	var captured core.UsageSnapshot
+	repo := &stubMeteringRepo{
+		sumUsageFn: func(ctx context.Context, tenantID string, from, to time.Time) ([]core.UsageLine, error) {
+			if !from.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)) || !to.Equal(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)) {
+				t.Fatalf("unexpected period %v - %v", from, to)
+			}
+			return []core.UsageLine{{Metric: core.UsageMetricAPICalls, Quantity: 3}}, nil
+		},
+		createSnapshotFn: func(ctx context.Context, snapshot core.UsageSnapshot) (*core.UsageSnapshot, error) {
+			captured = snapshot
+			return &snapshot, nil
+		},
+	}
+	service := NewMeteringService(repo)
+	service.WithClock(func() time.Time { return fixedNow })
+
+	if _, err := service.CreateUsageSnapshot(context.Background(), "acme", fixedNow); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected validation error for open period, got %v", err)
+	}
+
+	if _, err := service.CreateUsageSnapshot(context.Background(), "acme", time.Time{}); err != nil {
+		t.Fatalf("CreateUsageSnapshot() error = %v", err)
+	}
+	if captured.TenantID != "acme" || len(captured.Lines) != 1 {
+		t.Fatalf("unexpected snapshot %#v", captured)
+	}
+}
+
+func TestMeteringService_ExportInvoiceLineItems(t *testing.T) {
+	snapshotID := uuid.New()
+	periodStart := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	repo := &stubMeteringRepo{
+		getSnapshotFn: func(ctx context.Context, id uuid.UUID) (*core.UsageSnapshot, error) {
+			return &core.UsageSnapshot{
+				ID:          id,
+				TenantID:    "acme",
+				PeriodStart: periodStart,
+				PeriodEnd:   periodStart.AddDate(0, 1, 0),
+				Lines: []core.UsageLine{
+					{Metric: core.UsageMetricStorageGBHours, Quantity: 720},
+					{Metric: core.UsageMetricProcessingMinutes, Quantity: 0},
+				},
+			}, nil
+		},
+	}
+	service := NewMeteringService(repo)
+
+	items, err := service.ExportInvoiceLineItems(context.Background(), snapshotID)
+	if err != nil {
+		t.Fatalf("ExportInvoiceLineItems() error = %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("expected zero-quantity lines to be skipped, got %#v", items)
+	}
+	if items[0].Description != "Storage (2024-05)" || items[0].Unit != "GB-hour" || items[0].Quantity != 720 {
+		t.Fatalf("unexpected line item %#v", items[0])
+	}
+}
+
+type stubMeteringRepo struct {
+	recordUsageFn    func(ctx context.Context, record core.UsageRecord) error
+	sumUsageFn       func(ctx context.Context, tenantID string, from, to time.Time) ([]core.UsageLine, error)
+	createSnapshotFn func(ctx context.Context, snapshot core.UsageSnapshot) (*core.UsageSnapshot, error)
+	getSnapshotFn    func(ctx context.Context, id uuid.UUID) (*core.UsageSnapshot, error)
+}
+
+func (s *stubMeteringRepo) RecordUsage(ctx context.Context, record core.UsageRecord) error {
+	if s.recordUsageFn != nil {
+		return s.recordUsageFn(ctx, record)
+	}
+	return nil
+}
+
+func (s *stubMeteringRepo) SumUsage(ctx context.Context, tenantID string, from, to time.Time) ([]core.UsageLine, error) {
+	if s.sumUsageFn != nil {
+		return s.sumUsageFn(ctx, tenantID, from, to)
+	}
+	return nil, nil
+}
+
+func (s *stubMeteringRepo) CreateUsageSnapshot(ctx context.Context, snapshot core.UsageSnapshot) (*core.UsageSnapshot, error) {
+	if s.createSnapshotFn != nil {
+		return s.createSnapshotFn(ctx, snapshot)
+	}
+	return &snapshot, nil
+}
+
+func (s *stubMeteringRepo) GetUsageSnapshot(ctx context.Context, id uuid.UUID) (*core.UsageSnapshot, error) {
+	if s.getSnapshotFn != nil {
+		return s.getSnapshotFn(ctx, id)
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubMeteringRepo) ListUsageSnapshots(ctx context.Context, filter core.UsageSnapshotFilter) ([]core.UsageSnapshot, string, error) {
+	return nil, "", nil
+}
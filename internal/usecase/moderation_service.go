@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// moderationLabelUnclassified marks text a classifier failed to screen.
+const moderationLabelUnclassified = "unclassified"
+
+// ModerationService screens user-generated text with the configured
+// classifiers and keeps a queue of items for moderators to review.
+type ModerationService struct {
+	repo          core.ModerationRepository
+	classifiers   []core.TextClassifier
+	requireReview bool
+	now           func() time.Time
+}
+
+// NewModerationService constructs a moderation service. Text is approved
+// automatically unless a classifier flags it.
+func NewModerationService(repo core.ModerationRepository, classifiers []core.TextClassifier) *ModerationService {
+	return &ModerationService{
+		repo:        repo,
+		classifiers: classifiers,
+		now:         time.Now,
+	}
+}
+
+// WithRequireReview holds all submitted text for a moderator, including
+// text no classifier flagged.
+func (s *ModerationService) WithRequireReview(require bool) {
+	s.requireReview = require
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *ModerationService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.ModerationService = (*ModerationService)(nil)
+
+// SubmitForModeration classifies the subject's text and queues it. Text
+// identical to what was last submitted keeps its earlier verdict, so
+// editing unrelated fields does not undo a moderator's review. Text held
+// for a moderator emits ModerationReviewRequested.
+func (s *ModerationService) SubmitForModeration(ctx context.Context, submission core.ModerationSubmission) (*core.ModerationItem, error) {
+	submission.SubjectType = strings.TrimSpace(submission.SubjectType)
+	submission.Text = strings.TrimSpace(submission.Text)
+	if submission.SubjectType == "" || submission.SubjectID == uuid.Nil {
+		return nil, fmt.Errorf("%w: subject type and id required", core.ErrValidation)
+	}
+
+	existing, err := s.repo.GetModerationItemBySubject(ctx, submission.SubjectType, submission.SubjectID)
+	switch {
+	case err == nil:
+		if existing.Text == submission.Text {
+			return existing, nil
+		}
+	case !isNotFound(err):
+		return nil, err
+	}
+
+	item := core.ModerationItem{
+		ID:          uuid.New(),
+		SubjectType: submission.SubjectType,
+		SubjectID:   submission.SubjectID,
+		AuthorID:    strings.TrimSpace(submission.AuthorID),
+		Text:        submission.Text,
+		Status:      core.ModerationStatusApproved,
+	}
+	if existing != nil {
+		item.ID = existing.ID
+	}
+
+	flagged := s.classify(ctx, &item)
+	if !flagged && !s.requireReview {
+		return s.repo.SaveModerationItem(ctx, item)
+	}
+	item.Status = core.ModerationStatusPending
+	return s.repo.SaveModerationItem(ctx, item, core.ModerationReviewRequested{Item: item})
+}
+
+// classify runs every classifier over the item's text and records the labels
+// and highest score. A failing classifier holds the text for review, labelled
+// unclassified, rather than letting it through unchecked.
+func (s *ModerationService) classify(ctx context.Context, item *core.ModerationItem) bool {
+	if item.Text == "" {
+		return false
+	}
+	flagged := false
+	for _, classifier := range s.classifiers {
+		result, err := classifier.ClassifyText(ctx, item.Text)
+		if err != nil {
+			flagged = true
+			item.Labels = lo.Uniq(append(item.Labels, moderationLabelUnclassified))
+			continue
+		}
+		if result == nil {
+			continue
+		}
+		if result.Flagged {
+			flagged = true
+			item.Labels = lo.Uniq(append(item.Labels, result.Labels...))
+		}
+		item.Score = max(item.Score, result.Score)
+	}
+	return flagged
+}
+
+// ModerationStatuses returns the status of each listed subject. Subjects
+// never submitted are reported as unspecified.
+func (s *ModerationService) ModerationStatuses(ctx context.Context, subjectType string, subjectIDs []uuid.UUID) (map[uuid.UUID]core.ModerationStatus, error) {
+	return s.repo.ListModerationStatuses(ctx, subjectType, lo.Uniq(subjectIDs))
+}
+
+// GetModerationItem retrieves an item by identifier.
+func (s *ModerationService) GetModerationItem(ctx context.Context, id uuid.UUID) (*core.ModerationItem, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: moderation item id required", core.ErrValidation)
+	}
+	return s.repo.GetModerationItem(ctx, id)
+}
+
+// ListModerationItems returns queued items, oldest first. Without a status
+// filter the items awaiting a moderator are listed.
+func (s *ModerationService) ListModerationItems(ctx context.Context, filter core.ModerationListFilter) ([]core.ModerationItem, string, error) {
+	filter.SubjectType = strings.TrimSpace(filter.SubjectType)
+	filter.Statuses = lo.Without(lo.Uniq(filter.Statuses), core.ModerationStatusUnspecified)
+	if len(filter.Statuses) == 0 {
+		filter.Statuses = []core.ModerationStatus{core.ModerationStatusPending, core.ModerationStatusFlagged}
+	}
+	return s.repo.ListModerationItems(ctx, filter)
+}
+
+// ReviewModerationItem records a moderator approving, rejecting or flagging an item.
+func (s *ModerationService) ReviewModerationItem(ctx context.Context, review core.ModerationReview) (*core.ModerationItem, error) {
+	review.ReviewerID = strings.TrimSpace(review.ReviewerID)
+	switch {
+	case review.ReviewerID == "":
+		return nil, fmt.Errorf("%w: reviewer id is required", core.ErrValidation)
+	case !slices.Contains([]core.ModerationStatus{core.ModerationStatusApproved, core.ModerationStatusRejected, core.ModerationStatusFlagged}, review.Status):
+		return nil, fmt.Errorf("%w: items can only be approved, rejected or flagged", core.ErrValidation)
+	}
+
+	item, err := s.GetModerationItem(ctx, review.ItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now().UTC()
+	item.Status = review.Status
+	item.ReviewerID = review.ReviewerID
+	item.ReviewNote = strings.TrimSpace(review.Note)
+	item.ReviewedAt = &now
+	return s.repo.UpdateModerationReview(ctx, *item)
+}
@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestModerationService_SubmitForModeration(t *testing.T) {
+	ctx := context.Background()
+	blocklist := classifierFunc(func(_ context.Context, text string) (*core.TextClassification, error) {
+		if strings.Contains(text, "darn") {
+			return &core.TextClassification{Flagged: true, Labels: []string{"profanity"}, Score: 1}, nil
+		}
+		return &core.TextClassification{Score: 0.1}, nil
+	})
+	failing := classifierFunc(func(context.Context, string) (*core.TextClassification, error) {
+		return nil, errors.New("unavailable")
+	})
+
+	tests := []struct {
+		name          string
+		classifiers   []core.TextClassifier
+		requireReview bool
+		text          string
+		wantStatus    core.ModerationStatus
+		wantLabels    []string
+	}{
+		{name: "clean text is approved", classifiers: []core.TextClassifier{blocklist}, text: "Coffee phrases", wantStatus: core.ModerationStatusApproved},
+		{name: "flagged text is held", classifiers: []core.TextClassifier{blocklist}, text: "darn phrases", wantStatus: core.ModerationStatusPending, wantLabels: []string{"profanity"}},
+		{name: "classifier failure holds text", classifiers: []core.TextClassifier{blocklist, failing}, text: "Coffee phrases", wantStatus: core.ModerationStatusPending, wantLabels: []string{moderationLabelUnclassified}},
+		{name: "review required", requireReview: true, text: "Coffee phrases", wantStatus: core.ModerationStatusPending},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStubModerationRepo()
+			service := NewModerationService(repo, tt.classifiers)
+			service.WithRequireReview(tt.requireReview)
+
+			item, err := service.SubmitForModeration(ctx, core.ModerationSubmission{
+				SubjectType: core.ModerationSubjectPlaylist,
+				SubjectID:   uuid.New(),
+				AuthorID:    "user-1",
+				Text:        tt.text,
+			})
+			if err != nil {
+				t.Fatalf("SubmitForModeration() error = %v", err)
+			}
+			if item.Status != tt.wantStatus || !reflect.DeepEqual(item.Labels, tt.wantLabels) {
+				t.Fatalf("got status %v labels %v, want %v %v", item.Status, item.Labels, tt.wantStatus, tt.wantLabels)
+			}
+			var wantEvents []core.Event
+			if tt.wantStatus == core.ModerationStatusPending {
+				wantEvents = []core.Event{core.ModerationReviewRequested{Item: *item}}
+			}
+			if !reflect.DeepEqual(repo.events, wantEvents) {
+				t.Fatalf("events = %v, want %v", repo.events, wantEvents)
+			}
+		})
+	}
+}
+
+func TestModerationService_ResubmitAndReview(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	repo := newStubModerationRepo()
+	service := NewModerationService(repo, nil)
+	service.WithRequireReview(true)
+	service.WithClock(func() time.Time { return now })
+
+	submission := core.ModerationSubmission{SubjectType: core.ModerationSubjectPlaylist, SubjectID: uuid.New(), AuthorID: "user-1", Text: "Weekly picks"}
+	item, err := service.SubmitForModeration(ctx, submission)
+	if err != nil {
+		t.Fatalf("SubmitForModeration() error = %v", err)
+	}
+
+	if _, err := service.ReviewModerationItem(ctx, core.ModerationReview{ItemID: item.ID, ReviewerID: "mod-1", Status: core.ModerationStatusPending}); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected pending to be rejected as a decision, got %v", err)
+	}
+	reviewed, err := service.ReviewModerationItem(ctx, core.ModerationReview{ItemID: item.ID, ReviewerID: " mod-1 ", Status: core.ModerationStatusApproved, Note: " fine "})
+	if err != nil {
+		t.Fatalf("ReviewModerationItem() error = %v", err)
+	}
+	if reviewed.Status != core.ModerationStatusApproved || reviewed.ReviewerID != "mod-1" || reviewed.ReviewNote != "fine" || reviewed.ReviewedAt == nil {
+		t.Fatalf("unexpected review %+v", reviewed)
+	}
+
+	unchanged, err := service.SubmitForModeration(ctx, submission)
+	if err != nil {
+		t.Fatalf("SubmitForModeration() error = %v", err)
+	}
+	if unchanged.Status != core.ModerationStatusApproved {
+		t.Fatalf("expected unchanged text to keep its review, got %v", unchanged.Status)
+	}
+
+	submission.Text = "Weekly picks, edited"
+	edited, err := service.SubmitForModeration(ctx, submission)
+	if err != nil {
+		t.Fatalf("SubmitForModeration() error = %v", err)
+	}
+	if edited.ID != item.ID || edited.Status != core.ModerationStatusPending || edited.ReviewerID != "" || edited.ReviewedAt != nil {
+		t.Fatalf("expected edited text to be queued again, got %+v", edited)
+	}
+
+	statuses, err := service.ModerationStatuses(ctx, core.ModerationSubjectPlaylist, []uuid.UUID{submission.SubjectID, uuid.New()})
+	if err != nil {
+		t.Fatalf("ModerationStatuses() error = %v", err)
+	}
+	if len(statuses) != 1 || statuses[submission.SubjectID] != core.ModerationStatusPending {
+		t.Fatalf("unexpected statuses %v", statuses)
+	}
+}
+
+func TestModerationService_ListModerationItemsDefaultsToQueue(t *testing.T) {
+	repo := newStubModerationRepo()
+	service := NewModerationService(repo, nil)
+
+	if _, _, err := service.ListModerationItems(context.Background(), core.ModerationListFilter{}); err != nil {
+		t.Fatalf("ListModerationItems() error = %v", err)
+	}
+	if want := []core.ModerationStatus{core.ModerationStatusPending, core.ModerationStatusFlagged}; !reflect.DeepEqual(repo.lastFilter.Statuses, want) {
+		t.Fatalf("statuses = %v, want %v", repo.lastFilter.Statuses, want)
+	}
+}
+
+type classifierFunc func(ctx context.Context, text string) (*core.TextClassification, error)
+
+func (f classifierFunc) ClassifyText(ctx context.Context, text string) (*core.TextClassification, error) {
+	return f(ctx, text)
+}
+
+type stubModerationRepo struct {
+	items      map[uuid.UUID]core.ModerationItem
+	events     []core.Event
+	lastFilter core.ModerationListFilter
+}
+
+func newStubModerationRepo() *stubModerationRepo {
+	return &stubModerationRepo{items: make(map[uuid.UUID]core.ModerationItem)}
+}
+
+func (s *stubModerationRepo) SaveModerationItem(ctx context.Context, item core.ModerationItem, events ...core.Event) (*core.ModerationItem, error) {
+	s.items[item.ID] = item
+	s.events = append(s.events, events...)
+	return &item, nil
+}
+
+func (s *stubModerationRepo) GetModerationItem(ctx context.Context, id uuid.UUID) (*core.ModerationItem, error) {
+	item, ok := s.items[id]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	return &item, nil
+}
+
+func (s *stubModerationRepo) GetModerationItemBySubject(ctx context.Context, subjectType string, subjectID uuid.UUID) (*core.ModerationItem, error) {
+	for _, item := range s.items {
+		if item.SubjectType == subjectType && item.SubjectID == subjectID {
+			return &item, nil
+		}
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubModerationRepo) UpdateModerationReview(ctx context.Context, item core.ModerationItem) (*core.ModerationItem, error) {
+	return s.SaveModerationItem(ctx, item)
+}
+
+func (s *stubModerationRepo) ListModerationItems(ctx context.Context, filter core.ModerationListFilter) ([]core.ModerationItem, string, error) {
+	s.lastFilter = filter
+	return nil, "", nil
+}
+
+func (s *stubModerationRepo) ListModerationStatuses(ctx context.Context, subjectType string, subjectIDs []uuid.UUID) (map[uuid.UUID]core.ModerationStatus, error) {
+	statuses := make(map[uuid.UUID]core.ModerationStatus)
+	for _, id := range subjectIDs {
+		if item, err := s.GetModerationItemBySubject(ctx, subjectType, id); err == nil {
+			statuses[id] = item.Status
+		}
+	}
+	return statuses, nil
+}
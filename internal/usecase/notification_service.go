@@ -0,0 +1,560 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+	"github.com/eslsoft/lession/internal/i18n"
+)
+
+const (
+	assignmentReminderWindow     = 24 * time.Hour
+	assignmentReminderDateLayout = "2006-01-02 15:04 MST"
+	// streakReminderHour is the UTC hour from which learners who have not
+	// practised yet today are reminded of their streak.
+	streakReminderHour         = 18
+	defaultNotificationWorkers = 8
+	maxNotificationOptOuts     = 32
+	maxDeviceTokenLength       = 4096
+	notificationErrorMaxSize   = 1024
+	// teamNotificationQuoteSize bounds the user text quoted in team notifications.
+	teamNotificationQuoteSize = 280
+)
+
+// NotificationService renders domain events into localized messages and
+// delivers them over every configured channel the recipient accepts.
+// Recipients are fanned out over a pool of workers. Events the team follows
+// are also posted to the configured team channels.
+type NotificationService struct {
+	repo         core.NotificationRepository
+	classrooms   core.ClassroomRepository
+	series       core.SeriesRepository
+	activity     core.LearnerActivityRepository
+	senders      []core.NotificationSender
+	teamChannels []core.TeamChannel
+	catalog      *i18n.Catalog
+	workers      int
+	now          func() time.Time
+}
+
+// NewNotificationService constructs a notification service. Channels without
+// a sender are never used.
+func NewNotificationService(
+	repo core.NotificationRepository,
+	classrooms core.ClassroomRepository,
+	series core.SeriesRepository,
+	activity core.LearnerActivityRepository,
+	senders []core.NotificationSender,
+	catalog *i18n.Catalog,
+) *NotificationService {
+	return &NotificationService{
+		repo:       repo,
+		classrooms: classrooms,
+		series:     series,
+		activity:   activity,
+		senders:    senders,
+		catalog:    catalog,
+		workers:    defaultNotificationWorkers,
+		now:        time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *NotificationService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+// WithWorkers sets how many recipients are notified concurrently.
+func (s *NotificationService) WithWorkers(n int) {
+	if n > 0 {
+		s.workers = n
+	}
+}
+
+// WithTeamChannels posts team notifications to the given channels. Channels
+// without a sender are skipped.
+func (s *NotificationService) WithTeamChannels(channels ...core.TeamChannel) {
+	s.teamChannels = channels
+}
+
+var _ core.NotificationService = (*NotificationService)(nil)
+
+// GetPreferences returns a user's preferences, or defaults when none were saved.
+func (s *NotificationService) GetPreferences(ctx context.Context, userID string) (*core.NotificationPreferences, error) {
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id required", core.ErrValidation)
+	}
+	preferences, err := s.repo.GetNotificationPreferences(ctx, userID)
+	if isNotFound(err) {
+		return &core.NotificationPreferences{UserID: userID}, nil
+	}
+	return preferences, err
+}
+
+// UpdatePreferences replaces a user's preferences.
+func (s *NotificationService) UpdatePreferences(ctx context.Context, preferences core.NotificationPreferences) (*core.NotificationPreferences, error) {
+	preferences.UserID = strings.TrimSpace(preferences.UserID)
+	if preferences.UserID == "" {
+		return nil, fmt.Errorf("%w: user id required", core.ErrValidation)
+	}
+	preferences.Email = strings.TrimSpace(preferences.Email)
+	if preferences.Email != "" && !strings.Contains(preferences.Email, "@") {
+		return nil, fmt.Errorf("%w: invalid email address %q", core.ErrValidation, preferences.Email)
+	}
+	preferences.Locale = strings.TrimSpace(preferences.Locale)
+	if len(preferences.OptOuts) > maxNotificationOptOuts {
+		return nil, fmt.Errorf("%w: at most %d opt-outs allowed", core.ErrValidation, maxNotificationOptOuts)
+	}
+	for _, optOut := range preferences.OptOuts {
+		if optOut.Channel == core.NotificationChannelUnspecified {
+			return nil, fmt.Errorf("%w: opt-out channel required", core.ErrValidation)
+		}
+	}
+	preferences.OptOuts = lo.Uniq(preferences.OptOuts)
+	return s.repo.SaveNotificationPreferences(ctx, preferences)
+}
+
+// ListNotifications returns a user's notifications, most recent first.
+func (s *NotificationService) ListNotifications(ctx context.Context, filter core.NotificationListFilter) ([]core.Notification, string, error) {
+	filter.UserID = strings.TrimSpace(filter.UserID)
+	if filter.UserID == "" {
+		return nil, "", fmt.Errorf("%w: user id required", core.ErrValidation)
+	}
+	return s.repo.ListNotifications(ctx, filter)
+}
+
+// RegisterDevice subscribes a device to push notifications for a user.
+func (s *NotificationService) RegisterDevice(ctx context.Context, token core.DeviceToken) (*core.DeviceToken, error) {
+	token.UserID = strings.TrimSpace(token.UserID)
+	token.Token = strings.TrimSpace(token.Token)
+	if token.UserID == "" || token.Token == "" {
+		return nil, fmt.Errorf("%w: user id and device token required", core.ErrValidation)
+	}
+	if len(token.Token) > maxDeviceTokenLength {
+		return nil, fmt.Errorf("%w: device token too long", core.ErrValidation)
+	}
+	if token.Platform == core.DevicePlatformUnspecified {
+		return nil, fmt.Errorf("%w: device platform required", core.ErrValidation)
+	}
+	return s.repo.SaveDeviceToken(ctx, token)
+}
+
+// UnregisterDevice stops push notifications to a user's device.
+func (s *NotificationService) UnregisterDevice(ctx context.Context, userID, token string) error {
+	userID = strings.TrimSpace(userID)
+	token = strings.TrimSpace(token)
+	if userID == "" || token == "" {
+		return fmt.Errorf("%w: user id and device token required", core.ErrValidation)
+	}
+	return s.repo.DeleteDeviceToken(ctx, userID, token)
+}
+
+// NotifyEpisodePublished tells the team channels and the learners whose
+// classrooms are assigned the episode's series that a new episode is
+// available. Episodes of unpublished series are not announced.
+func (s *NotificationService) NotifyEpisodePublished(ctx context.Context, episode core.Episode) (int, error) {
+	if episode.ID == uuid.Nil || episode.SeriesID == uuid.Nil {
+		return 0, fmt.Errorf("%w: episode and series id required", core.ErrValidation)
+	}
+	if episode.Status != core.EpisodeStatusPublished {
+		return 0, fmt.Errorf("%w: episode is not published", core.ErrInvalidState)
+	}
+
+	series, err := s.series.GetSeries(ctx, episode.SeriesID, core.SeriesQueryOptions{})
+	if err != nil {
+		return 0, err
+	}
+	if series.Status != core.SeriesStatusPublished {
+		return 0, nil
+	}
+
+	params := map[string]string{"series": series.Title, "episode": episode.Title}
+	event := notificationEvent{
+		kind: core.NotificationKindEpisodePublished,
+		key:  "episode_published:" + episode.ID.String(),
+		data: map[string]string{"series_id": series.ID.String(), "episode_id": episode.ID.String()},
+		render: func(l *i18n.Localizer) (string, string) {
+			return l.Message("notification.episode_published.subject", params),
+				l.Message("notification.episode_published.body", params)
+		},
+	}
+	posted, err := s.notifyTeam(ctx, event)
+	if err != nil {
+		return posted, err
+	}
+
+	learners, err := s.classrooms.ListSeriesLearners(ctx, episode.SeriesID)
+	if err != nil {
+		return posted, err
+	}
+	sent, err := s.deliver(ctx, event, learners)
+	return posted + sent, err
+}
+
+// NotifyAssetFailed tells the team channels an asset could not be processed.
+func (s *NotificationService) NotifyAssetFailed(ctx context.Context, asset core.Asset) (int, error) {
+	if asset.ID == uuid.Nil {
+		return 0, fmt.Errorf("%w: asset id required", core.ErrValidation)
+	}
+	if asset.Status != core.AssetStatusFailed {
+		return 0, fmt.Errorf("%w: asset has not failed", core.ErrInvalidState)
+	}
+
+	params := map[string]string{
+		"filename": lo.CoalesceOrEmpty(asset.OriginalFilename, asset.AssetKey),
+		"asset_id": asset.ID.String(),
+	}
+	return s.notifyTeam(ctx, notificationEvent{
+		kind: core.NotificationKindAssetFailed,
+		key:  fmt.Sprintf("asset_failed:%s:%d", asset.ID, asset.UpdatedAt.UnixNano()),
+		data: map[string]string{"asset_id": asset.ID.String()},
+		render: func(l *i18n.Localizer) (string, string) {
+			return l.Message("notification.asset_failed.subject", params),
+				l.Message("notification.asset_failed.body", params)
+		},
+	})
+}
+
+// NotifyReviewRequested tells the team channels text is waiting in the
+// moderation queue, quoting the start of the text.
+func (s *NotificationService) NotifyReviewRequested(ctx context.Context, item core.ModerationItem) (int, error) {
+	if item.ID == uuid.Nil {
+		return 0, fmt.Errorf("%w: moderation item id required", core.ErrValidation)
+	}
+	if item.Status != core.ModerationStatusPending && item.Status != core.ModerationStatusFlagged {
+		return 0, fmt.Errorf("%w: moderation item is not awaiting review", core.ErrInvalidState)
+	}
+
+	quote := item.Text
+	if len([]rune(quote)) > teamNotificationQuoteSize {
+		quote = lo.Substring(quote, 0, teamNotificationQuoteSize) + "…"
+	}
+	params := map[string]string{
+		"subject": item.SubjectType,
+		"author":  item.AuthorID,
+		"text":    quote,
+	}
+	return s.notifyTeam(ctx, notificationEvent{
+		kind: core.NotificationKindReviewRequested,
+		key:  fmt.Sprintf("review_requested:%s:%d", item.ID, item.UpdatedAt.UnixNano()),
+		data: map[string]string{
+			"moderation_item_id": item.ID.String(),
+			"subject_type":       item.SubjectType,
+			"subject_id":         item.SubjectID.String(),
+		},
+		render: func(l *i18n.Localizer) (string, string) {
+			return l.Message("notification.review_requested.subject", params),
+				l.Message("notification.review_requested.body", params)
+		},
+	})
+}
+
+// SendAssignmentReminders reminds learners of assignments due within the
+// next day. It is safe to run repeatedly; each learner is reminded once.
+func (s *NotificationService) SendAssignmentReminders(ctx context.Context) (int, error) {
+	now := s.now().UTC()
+	classrooms, err := s.classrooms.ListClassroomsWithAssignmentsDue(ctx, now, now.Add(assignmentReminderWindow))
+	if err != nil {
+		return 0, err
+	}
+
+	titles := make(map[uuid.UUID]string)
+	sent := 0
+	for _, classroom := range classrooms {
+		for _, assignment := range classroom.Assignments {
+			title, ok := titles[assignment.SeriesID]
+			if !ok {
+				series, err := s.series.GetSeries(ctx, assignment.SeriesID, core.SeriesQueryOptions{})
+				if err != nil {
+					return sent, err
+				}
+				title = series.Title
+				titles[assignment.SeriesID] = title
+			}
+
+			params := map[string]string{
+				"assignment": title,
+				"due_date":   assignment.DueAt.UTC().Format(assignmentReminderDateLayout),
+			}
+			n, err := s.deliver(ctx, notificationEvent{
+				kind: core.NotificationKindAssignmentDue,
+				key:  "assignment_due:" + assignment.ID.String(),
+				data: map[string]string{
+					"classroom_id":  classroom.ID.String(),
+					"assignment_id": assignment.ID.String(),
+					"series_id":     assignment.SeriesID.String(),
+				},
+				render: func(l *i18n.Localizer) (string, string) {
+					return l.Message("notification.assignment_due.subject", params),
+						l.Message("notification.assignment_due.body", params)
+				},
+			}, classroom.LearnerIDs)
+			sent += n
+			if err != nil {
+				return sent, err
+			}
+		}
+	}
+	return sent, nil
+}
+
+// SendStreakReminders reminds learners who practised yesterday but not yet
+// today that their streak lapses at midnight UTC. Nothing is sent before
+// streakReminderHour, and each learner is reminded at most once per day.
+func (s *NotificationService) SendStreakReminders(ctx context.Context) (int, error) {
+	now := s.now().UTC()
+	if now.Hour() < streakReminderHour {
+		return 0, nil
+	}
+	today := truncateDay(now)
+
+	yesterday, err := s.activity.ListActiveUsers(ctx, today.AddDate(0, 0, -1))
+	if err != nil {
+		return 0, err
+	}
+	practised, err := s.activity.ListActiveUsers(ctx, today)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.deliver(ctx, notificationEvent{
+		kind: core.NotificationKindStreakReminder,
+		key:  "streak_reminder:" + today.Format(time.DateOnly),
+		render: func(l *i18n.Localizer) (string, string) {
+			return l.Message("notification.streak_reminder.subject", nil),
+				l.Message("notification.streak_reminder.body", nil)
+		},
+	}, lo.Without(yesterday, practised...))
+}
+
+// notificationEvent describes one occurrence of a notification kind.
+type notificationEvent struct {
+	kind core.NotificationKind
+	// key identifies the occurrence; deliveries are deduplicated on it.
+	key    string
+	data   map[string]string
+	render func(*i18n.Localizer) (subject, body string)
+}
+
+// deliver sends event to each user on every channel they accept, spreading
+// users over the worker pool. Delivery failures are recorded on the
+// notification and do not stop the remaining deliveries; persistence
+// failures stop handing out further users and are returned.
+func (s *NotificationService) deliver(ctx context.Context, event notificationEvent, userIDs []string) (int, error) {
+	userIDs = lo.Uniq(lo.Compact(userIDs))
+	if len(userIDs) == 0 || len(s.senders) == 0 {
+		return 0, nil
+	}
+
+	stored, err := s.repo.ListNotificationPreferences(ctx, userIDs)
+	if err != nil {
+		return 0, err
+	}
+	preferences := lo.SliceToMap(stored, func(p core.NotificationPreferences) (string, core.NotificationPreferences) {
+		return p.UserID, p
+	})
+
+	var devices map[string][]core.DeviceToken
+	if lo.ContainsBy(s.senders, func(sender core.NotificationSender) bool {
+		return sender.Channel() == core.NotificationChannelPush
+	}) {
+		tokens, err := s.repo.ListDeviceTokens(ctx, userIDs)
+		if err != nil {
+			return 0, err
+		}
+		devices = lo.GroupBy(tokens, func(token core.DeviceToken) string { return token.UserID })
+	}
+
+	var (
+		sent     atomic.Int64
+		failed   atomic.Bool
+		errOnce  sync.Once
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	jobs := make(chan string)
+	for range min(s.workers, len(userIDs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for userID := range jobs {
+				prefs, ok := preferences[userID]
+				if !ok {
+					prefs = core.NotificationPreferences{UserID: userID}
+				}
+				n, err := s.deliverTo(ctx, event, prefs, devices[userID])
+				sent.Add(int64(n))
+				if err != nil {
+					errOnce.Do(func() { firstErr = err })
+					failed.Store(true)
+				}
+			}
+		}()
+	}
+	for _, userID := range userIDs {
+		if failed.Load() {
+			break
+		}
+		jobs <- userID
+	}
+	close(jobs)
+	wg.Wait()
+
+	return int(sent.Load()), firstErr
+}
+
+// deliverTo sends event to one user, recording one notification per channel.
+// A channel counts as delivered when any of the user's addresses accepted it.
+func (s *NotificationService) deliverTo(ctx context.Context, event notificationEvent, prefs core.NotificationPreferences, devices []core.DeviceToken) (int, error) {
+	subject, body := event.render(s.catalog.Localizer(prefs.Locale))
+
+	sent := 0
+	for _, sender := range s.senders {
+		channel := sender.Channel()
+		addresses := notificationAddresses(prefs, devices, channel)
+		if len(addresses) == 0 || !prefs.Allows(event.kind, channel) {
+			continue
+		}
+
+		notification, err := s.repo.CreateNotification(ctx, core.Notification{
+			ID:        uuid.New(),
+			UserID:    prefs.UserID,
+			Kind:      event.kind,
+			Channel:   channel,
+			DedupeKey: fmt.Sprintf("%s:%s:%d", event.key, prefs.UserID, channel),
+			Subject:   subject,
+			Body:      body,
+			Status:    core.NotificationStatusPending,
+		})
+		if errors.Is(err, core.ErrAlreadyExists) {
+			continue
+		}
+		if err != nil {
+			return sent, err
+		}
+
+		var sendErrs []error
+		delivered := false
+		for _, to := range addresses {
+			err := sender.Send(ctx, core.NotificationMessage{
+				UserID:  prefs.UserID,
+				To:      to,
+				Subject: subject,
+				Body:    body,
+				Data:    event.data,
+			})
+			if err == nil {
+				delivered = true
+				continue
+			}
+			sendErrs = append(sendErrs, err)
+			if channel == core.NotificationChannelPush && errors.Is(err, core.ErrNotFound) {
+				// The device uninstalled the app or revoked permission.
+				if err := s.repo.DeleteDeviceToken(ctx, "", to); err != nil && !isNotFound(err) {
+					return sent, err
+				}
+			}
+		}
+
+		var deliveryErr error
+		if delivered {
+			sent++
+		} else {
+			deliveryErr = errors.Join(sendErrs...)
+		}
+		if err := s.recordDelivery(ctx, *notification, deliveryErr); err != nil {
+			return sent, err
+		}
+	}
+	return sent, nil
+}
+
+// notifyTeam posts event to every team channel accepting its kind, rendered
+// in the catalog's default locale. Each post is recorded as a notification
+// without a user; failed posts are recorded, not returned.
+func (s *NotificationService) notifyTeam(ctx context.Context, event notificationEvent) (int, error) {
+	sent := 0
+	for _, channel := range s.teamChannels {
+		if !channel.Accepts(event.kind) {
+			continue
+		}
+		sender, ok := lo.Find(s.senders, func(sender core.NotificationSender) bool {
+			return sender.Channel() == channel.Channel
+		})
+		if !ok {
+			continue
+		}
+
+		subject, body := event.render(s.catalog.Localizer(""))
+		notification, err := s.repo.CreateNotification(ctx, core.Notification{
+			ID:        uuid.New(),
+			Kind:      event.kind,
+			Channel:   channel.Channel,
+			DedupeKey: fmt.Sprintf("%s:team:%d", event.key, channel.Channel),
+			Subject:   subject,
+			Body:      body,
+			Status:    core.NotificationStatusPending,
+		})
+		if errors.Is(err, core.ErrAlreadyExists) {
+			continue
+		}
+		if err != nil {
+			return sent, err
+		}
+
+		sendErr := sender.Send(ctx, core.NotificationMessage{
+			To:      channel.WebhookURL,
+			Subject: subject,
+			Body:    body,
+			Data:    event.data,
+		})
+		if sendErr == nil {
+			sent++
+		}
+		if err := s.recordDelivery(ctx, *notification, sendErr); err != nil {
+			return sent, err
+		}
+	}
+	return sent, nil
+}
+
+// recordDelivery marks notification sent, or failed with sendErr.
+func (s *NotificationService) recordDelivery(ctx context.Context, notification core.Notification, sendErr error) error {
+	if sendErr == nil {
+		notification.Status = core.NotificationStatusSent
+		notification.SentAt = lo.ToPtr(s.now().UTC())
+	} else {
+		notification.Status = core.NotificationStatusFailed
+		notification.Error = lo.Substring(sendErr.Error(), 0, notificationErrorMaxSize)
+	}
+	_, err := s.repo.UpdateNotification(ctx, notification)
+	return err
+}
+
+// notificationAddresses returns where the user receives notifications on
+// channel; it is empty when they cannot be reached there.
+func notificationAddresses(preferences core.NotificationPreferences, devices []core.DeviceToken, channel core.NotificationChannel) []string {
+	switch channel {
+	case core.NotificationChannelEmail:
+		if preferences.Email == "" {
+			return nil
+		}
+		return []string{preferences.Email}
+	case core.NotificationChannelPush:
+		return lo.Map(devices, func(device core.DeviceToken, _ int) string { return device.Token })
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,472 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+	"github.com/eslsoft/lession/internal/i18n"
+)
+
+// stubNotificationRepo is safe for concurrent use by the delivery workers.
+type stubNotificationRepo struct {
+	mu            sync.Mutex
+	preferences   map[string]core.NotificationPreferences
+	notifications []core.Notification
+	devices       map[string]core.DeviceToken
+}
+
+func newStubNotificationRepo(preferences ...core.NotificationPreferences) *stubNotificationRepo {
+	repo := &stubNotificationRepo{
+		preferences: map[string]core.NotificationPreferences{},
+		devices:     map[string]core.DeviceToken{},
+	}
+	for _, p := range preferences {
+		repo.preferences[p.UserID] = p
+	}
+	return repo
+}
+
+func (s *stubNotificationRepo) GetNotificationPreferences(ctx context.Context, userID string) (*core.NotificationPreferences, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	preferences, ok := s.preferences[userID]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	return &preferences, nil
+}
+
+func (s *stubNotificationRepo) ListNotificationPreferences(ctx context.Context, userIDs []string) ([]core.NotificationPreferences, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var out []core.NotificationPreferences
+	for _, userID := range userIDs {
+		if preferences, ok := s.preferences[userID]; ok {
+			out = append(out, preferences)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubNotificationRepo) SaveNotificationPreferences(ctx context.Context, preferences core.NotificationPreferences) (*core.NotificationPreferences, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.preferences[preferences.UserID] = preferences
+	return &preferences, nil
+}
+
+func (s *stubNotificationRepo) CreateNotification(ctx context.Context, notification core.Notification) (*core.Notification, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.notifications {
+		if existing.DedupeKey == notification.DedupeKey {
+			return nil, core.ErrAlreadyExists
+		}
+	}
+	s.notifications = append(s.notifications, notification)
+	return &notification, nil
+}
+
+func (s *stubNotificationRepo) UpdateNotification(ctx context.Context, notification core.Notification) (*core.Notification, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, existing := range s.notifications {
+		if existing.ID == notification.ID {
+			s.notifications[i] = notification
+			return &notification, nil
+		}
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubNotificationRepo) ListNotifications(ctx context.Context, filter core.NotificationListFilter) ([]core.Notification, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.notifications, "", nil
+}
+
+func (s *stubNotificationRepo) SaveDeviceToken(ctx context.Context, token core.DeviceToken) (*core.DeviceToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.devices[token.Token] = token
+	return &token, nil
+}
+
+func (s *stubNotificationRepo) ListDeviceTokens(ctx context.Context, userIDs []string) ([]core.DeviceToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var out []core.DeviceToken
+	for _, device := range s.devices {
+		if slices.Contains(userIDs, device.UserID) {
+			out = append(out, device)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubNotificationRepo) DeleteDeviceToken(ctx context.Context, userID, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	device, ok := s.devices[token]
+	if !ok || (userID != "" && device.UserID != userID) {
+		return core.ErrNotFound
+	}
+	delete(s.devices, token)
+	return nil
+}
+
+// stubNotificationSender records messages and fails deliveries to addresses in errs.
+type stubNotificationSender struct {
+	mu       sync.Mutex
+	channel  core.NotificationChannel
+	messages []core.NotificationMessage
+	errs     map[string]error
+}
+
+func (s *stubNotificationSender) Channel() core.NotificationChannel {
+	return s.channel
+}
+
+func (s *stubNotificationSender) Send(ctx context.Context, message core.NotificationMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages = append(s.messages, message)
+	return s.errs[message.To]
+}
+
+// messageTo returns the message delivered to address; workers deliver in no particular order.
+func (s *stubNotificationSender) messageTo(t *testing.T, address string) core.NotificationMessage {
+	t.Helper()
+	for _, message := range s.messages {
+		if message.To == address {
+			return message
+		}
+	}
+	t.Fatalf("no message sent to %s in %+v", address, s.messages)
+	return core.NotificationMessage{}
+}
+
+func newTestNotificationService(repo *stubNotificationRepo, classroom *core.Classroom, series core.Series, senders ...*stubNotificationSender) *NotificationService {
+	seriesRepo := &stubSeriesRepo{
+		getSeriesFn: func(ctx context.Context, id uuid.UUID, opts core.SeriesQueryOptions) (*core.Series, error) {
+			if id != series.ID {
+				return nil, core.ErrNotFound
+			}
+			return &series, nil
+		},
+	}
+	notificationSenders := make([]core.NotificationSender, 0, len(senders))
+	for _, sender := range senders {
+		notificationSenders = append(notificationSenders, sender)
+	}
+	service := NewNotificationService(repo, &stubClassroomRepo{classroom: classroom}, seriesRepo, &stubLearnerActivityRepo{}, notificationSenders, i18n.NewDefaultCatalog())
+	service.WithClock(func() time.Time { return time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC) })
+	return service
+}
+
+func TestNotificationService_NotifyEpisodePublished(t *testing.T) {
+	series := core.Series{ID: uuid.New(), Title: "Travel English", Status: core.SeriesStatusPublished}
+	classroom := &core.Classroom{
+		ID:          uuid.New(),
+		LearnerIDs:  []string{"alice", "bob", "carol", "dave"},
+		Assignments: []core.ClassroomAssignment{{ID: uuid.New(), SeriesID: series.ID}},
+	}
+	repo := newStubNotificationRepo(
+		core.NotificationPreferences{UserID: "alice", Email: "alice@example.com"},
+		core.NotificationPreferences{UserID: "bob", Email: "bob@example.com", Locale: "zh"},
+		core.NotificationPreferences{
+			UserID:  "carol",
+			Email:   "carol@example.com",
+			OptOuts: []core.NotificationOptOut{{Kind: core.NotificationKindEpisodePublished, Channel: core.NotificationChannelEmail}},
+		},
+	)
+	sender := &stubNotificationSender{channel: core.NotificationChannelEmail}
+	service := newTestNotificationService(repo, classroom, series, sender)
+	episode := core.Episode{ID: uuid.New(), SeriesID: series.ID, Title: "Ordering coffee", Status: core.EpisodeStatusPublished}
+
+	sent, err := service.NotifyEpisodePublished(context.Background(), episode)
+	if err != nil {
+		t.Fatalf("NotifyEpisodePublished() error = %v", err)
+	}
+	if sent != 2 || len(sender.messages) != 2 {
+		t.Fatalf("expected alice and bob to be notified, got %d: %+v", sent, sender.messages)
+	}
+	if got := sender.messageTo(t, "alice@example.com"); got.Subject != "New episode in Travel English" || got.Data["episode_id"] != episode.ID.String() {
+		t.Fatalf("unexpected english message %+v", got)
+	}
+	if got := sender.messageTo(t, "bob@example.com"); !strings.Contains(got.Body, "《Ordering coffee》") {
+		t.Fatalf("unexpected chinese message %+v", got)
+	}
+
+	if sent, err := service.NotifyEpisodePublished(context.Background(), episode); err != nil || sent != 0 {
+		t.Fatalf("expected redelivery to be deduplicated, got %d (%v)", sent, err)
+	}
+	for _, notification := range repo.notifications {
+		if notification.Status != core.NotificationStatusSent || notification.SentAt == nil {
+			t.Fatalf("expected notification to be marked sent, got %+v", notification)
+		}
+	}
+}
+
+func TestNotificationService_NotifyEpisodePublishedRecordsFailures(t *testing.T) {
+	series := core.Series{ID: uuid.New(), Title: "Travel English", Status: core.SeriesStatusPublished}
+	classroom := &core.Classroom{
+		LearnerIDs:  []string{"alice"},
+		Assignments: []core.ClassroomAssignment{{ID: uuid.New(), SeriesID: series.ID}},
+	}
+	repo := newStubNotificationRepo(core.NotificationPreferences{UserID: "alice", Email: "alice@example.com"})
+	sender := &stubNotificationSender{
+		channel: core.NotificationChannelEmail,
+		errs:    map[string]error{"alice@example.com": errors.New("mailbox unavailable")},
+	}
+	service := newTestNotificationService(repo, classroom, series, sender)
+
+	sent, err := service.NotifyEpisodePublished(context.Background(), core.Episode{ID: uuid.New(), SeriesID: series.ID, Status: core.EpisodeStatusPublished})
+	if err != nil || sent != 0 {
+		t.Fatalf("NotifyEpisodePublished() = %d, %v", sent, err)
+	}
+	if len(repo.notifications) != 1 || repo.notifications[0].Status != core.NotificationStatusFailed || repo.notifications[0].Error == "" {
+		t.Fatalf("expected failed delivery to be recorded, got %+v", repo.notifications)
+	}
+
+	if _, err := service.NotifyEpisodePublished(context.Background(), core.Episode{ID: uuid.New(), SeriesID: series.ID, Status: core.EpisodeStatusDraft}); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected draft episode to be rejected, got %v", err)
+	}
+}
+
+func TestNotificationService_SendAssignmentReminders(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	series := core.Series{ID: uuid.New(), Title: "Travel English", Status: core.SeriesStatusPublished}
+	classroom := &core.Classroom{
+		ID:         uuid.New(),
+		LearnerIDs: []string{"alice"},
+		Assignments: []core.ClassroomAssignment{
+			{ID: uuid.New(), SeriesID: series.ID, DueAt: now.Add(20 * time.Hour)},
+			{ID: uuid.New(), SeriesID: series.ID, DueAt: now.Add(72 * time.Hour)},
+		},
+	}
+	repo := newStubNotificationRepo(core.NotificationPreferences{UserID: "alice", Email: "alice@example.com"})
+	sender := &stubNotificationSender{channel: core.NotificationChannelEmail}
+	service := newTestNotificationService(repo, classroom, series, sender)
+
+	for range 2 {
+		if _, err := service.SendAssignmentReminders(context.Background()); err != nil {
+			t.Fatalf("SendAssignmentReminders() error = %v", err)
+		}
+	}
+	if len(sender.messages) != 1 {
+		t.Fatalf("expected a single reminder, got %+v", sender.messages)
+	}
+	if got := sender.messages[0]; got.Subject != "Assignment due tomorrow" || got.Body != `"Travel English" is due on 2025-03-02 08:00 UTC.` {
+		t.Fatalf("unexpected reminder %+v", got)
+	}
+}
+
+func TestNotificationService_UpdatePreferences(t *testing.T) {
+	repo := newStubNotificationRepo()
+	service := newTestNotificationService(repo, nil, core.Series{})
+	ctx := context.Background()
+
+	defaults, err := service.GetPreferences(ctx, "alice")
+	if err != nil || defaults.UserID != "alice" || len(defaults.OptOuts) != 0 {
+		t.Fatalf("GetPreferences() = %+v, %v", defaults, err)
+	}
+
+	if _, err := service.UpdatePreferences(ctx, core.NotificationPreferences{UserID: "alice", Email: "nope"}); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected invalid email to be rejected, got %v", err)
+	}
+	if _, err := service.UpdatePreferences(ctx, core.NotificationPreferences{UserID: "alice", OptOuts: []core.NotificationOptOut{{}}}); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected opt-out without channel to be rejected, got %v", err)
+	}
+
+	optOut := core.NotificationOptOut{Channel: core.NotificationChannelEmail}
+	saved, err := service.UpdatePreferences(ctx, core.NotificationPreferences{
+		UserID:  " alice ",
+		Email:   " alice@example.com ",
+		OptOuts: []core.NotificationOptOut{optOut, optOut},
+	})
+	if err != nil {
+		t.Fatalf("UpdatePreferences() error = %v", err)
+	}
+	if saved.UserID != "alice" || saved.Email != "alice@example.com" || len(saved.OptOuts) != 1 {
+		t.Fatalf("unexpected preferences %+v", saved)
+	}
+	if saved.Allows(core.NotificationKindAssignmentDue, core.NotificationChannelEmail) {
+		t.Fatal("expected a channel-wide opt-out to mute every kind")
+	}
+}
+
+func TestNotificationService_PushFanOut(t *testing.T) {
+	series := core.Series{ID: uuid.New(), Title: "Travel English", Status: core.SeriesStatusPublished}
+	learners := []string{"alice", "bob", "carol"}
+	classroom := &core.Classroom{
+		LearnerIDs:  learners,
+		Assignments: []core.ClassroomAssignment{{ID: uuid.New(), SeriesID: series.ID}},
+	}
+	repo := newStubNotificationRepo(core.NotificationPreferences{
+		UserID:  "carol",
+		OptOuts: []core.NotificationOptOut{{Channel: core.NotificationChannelPush}},
+	})
+	push := &stubNotificationSender{
+		channel: core.NotificationChannelPush,
+		errs:    map[string]error{"bob-stale": core.ErrNotFound},
+	}
+	service := newTestNotificationService(repo, classroom, series, push)
+	service.WithWorkers(2)
+	ctx := context.Background()
+
+	devices := map[string]string{"alice-phone": "alice", "alice-browser": "alice", "bob-stale": "bob", "carol-phone": "carol"}
+	for token, userID := range devices {
+		if _, err := service.RegisterDevice(ctx, core.DeviceToken{UserID: userID, Token: token, Platform: core.DevicePlatformAndroid}); err != nil {
+			t.Fatalf("RegisterDevice() error = %v", err)
+		}
+	}
+	if _, err := service.RegisterDevice(ctx, core.DeviceToken{UserID: "alice", Token: "x"}); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected missing platform to be rejected, got %v", err)
+	}
+
+	sent, err := service.NotifyEpisodePublished(ctx, core.Episode{ID: uuid.New(), SeriesID: series.ID, Status: core.EpisodeStatusPublished})
+	if err != nil {
+		t.Fatalf("NotifyEpisodePublished() error = %v", err)
+	}
+	if sent != 1 || len(push.messages) != 3 {
+		t.Fatalf("expected alice's two devices and bob's stale device to be tried, got %d sent: %+v", sent, push.messages)
+	}
+	if _, ok := repo.devices["bob-stale"]; ok {
+		t.Fatal("expected the unregistered device token to be removed")
+	}
+
+	if err := service.UnregisterDevice(ctx, "bob", "alice-phone"); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected another user's device to be left alone, got %v", err)
+	}
+}
+
+func TestNotificationService_SendStreakReminders(t *testing.T) {
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	activity := &stubLearnerActivityRepo{activities: []core.LearnerActivity{
+		{UserID: "alice", Day: day.AddDate(0, 0, -1), MinutesListened: 10},
+		{UserID: "bob", Day: day.AddDate(0, 0, -1), MinutesListened: 5},
+		{UserID: "bob", Day: day, EpisodesCompleted: 1},
+		{UserID: "carol", Day: day.AddDate(0, 0, -2), MinutesListened: 5},
+	}}
+	repo := newStubNotificationRepo(
+		core.NotificationPreferences{UserID: "alice", Email: "alice@example.com"},
+		core.NotificationPreferences{UserID: "bob", Email: "bob@example.com"},
+		core.NotificationPreferences{UserID: "carol", Email: "carol@example.com"},
+	)
+	sender := &stubNotificationSender{channel: core.NotificationChannelEmail}
+	service := NewNotificationService(repo, &stubClassroomRepo{}, &stubSeriesRepo{}, activity, []core.NotificationSender{sender}, i18n.NewDefaultCatalog())
+
+	service.WithClock(func() time.Time { return day.Add(9 * time.Hour) })
+	if sent, err := service.SendStreakReminders(context.Background()); err != nil || sent != 0 {
+		t.Fatalf("expected no reminders in the morning, got %d (%v)", sent, err)
+	}
+
+	service.WithClock(func() time.Time { return day.Add(19 * time.Hour) })
+	for range 2 {
+		if _, err := service.SendStreakReminders(context.Background()); err != nil {
+			t.Fatalf("SendStreakReminders() error = %v", err)
+		}
+	}
+	if len(sender.messages) != 1 || sender.messages[0].To != "alice@example.com" || sender.messages[0].Subject != "Keep your streak going" {
+		t.Fatalf("expected a single reminder to alice, got %+v", sender.messages)
+	}
+}
+
+func TestNotificationService_TeamChannels(t *testing.T) {
+	ctx := context.Background()
+	series := core.Series{ID: uuid.New(), Title: "Travel English", Status: core.SeriesStatusPublished}
+	repo := newStubNotificationRepo()
+	slack := &stubNotificationSender{channel: core.NotificationChannelSlack}
+	discord := &stubNotificationSender{
+		channel: core.NotificationChannelDiscord,
+		errs:    map[string]error{"https://discord.test/hook": errors.New("rate limited")},
+	}
+	service := newTestNotificationService(repo, &core.Classroom{}, series, slack, discord)
+	service.WithTeamChannels(
+		core.TeamChannel{Channel: core.NotificationChannelSlack, WebhookURL: "https://slack.test/hook"},
+		core.TeamChannel{
+			Channel:    core.NotificationChannelDiscord,
+			WebhookURL: "https://discord.test/hook",
+			Kinds:      []core.NotificationKind{core.NotificationKindReviewRequested},
+		},
+	)
+
+	episode := core.Episode{ID: uuid.New(), SeriesID: series.ID, Title: "At the airport", Status: core.EpisodeStatusPublished}
+	sent, err := service.NotifyEpisodePublished(ctx, episode)
+	if err != nil {
+		t.Fatalf("NotifyEpisodePublished() error = %v", err)
+	}
+	if sent != 1 || len(discord.messages) != 0 {
+		t.Fatalf("sent = %d, discord messages = %d; want 1 and 0", sent, len(discord.messages))
+	}
+	if message := slack.messageTo(t, "https://slack.test/hook"); message.Subject != "New episode in Travel English" {
+		t.Fatalf("slack subject = %q", message.Subject)
+	}
+
+	asset := core.Asset{ID: uuid.New(), OriginalFilename: "airport.mp3", Status: core.AssetStatusFailed}
+	if _, err := service.NotifyAssetFailed(ctx, asset); err != nil {
+		t.Fatalf("NotifyAssetFailed() error = %v", err)
+	}
+	if body := slack.messages[1].Body; !strings.Contains(body, "airport.mp3") {
+		t.Fatalf("asset failed body = %q", body)
+	}
+	// The relay may deliver an event again.
+	if sent, err := service.NotifyAssetFailed(ctx, asset); err != nil || sent != 0 {
+		t.Fatalf("repeated NotifyAssetFailed() = %d, %v; want 0, nil", sent, err)
+	}
+	asset.Status = core.AssetStatusReady
+	if _, err := service.NotifyAssetFailed(ctx, asset); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("NotifyAssetFailed() on ready asset error = %v, want ErrInvalidState", err)
+	}
+
+	item := core.ModerationItem{
+		ID:          uuid.New(),
+		SubjectType: core.ModerationSubjectPlaylist,
+		SubjectID:   uuid.New(),
+		AuthorID:    "user-1",
+		Text:        strings.Repeat("darn ", 100),
+		Status:      core.ModerationStatusPending,
+	}
+	sent, err = service.NotifyReviewRequested(ctx, item)
+	if err != nil {
+		t.Fatalf("NotifyReviewRequested() error = %v", err)
+	}
+	if sent != 1 || len(slack.messages) != 3 || len(discord.messages) != 1 {
+		t.Fatalf("sent = %d, slack = %d, discord = %d; want 1, 3, 1", sent, len(slack.messages), len(discord.messages))
+	}
+	if body := slack.messages[2].Body; !strings.Contains(body, "…") || len([]rune(body)) > teamNotificationQuoteSize+100 {
+		t.Fatalf("review requested body = %q, want a shortened quote", body)
+	}
+
+	var failed core.Notification
+	for _, notification := range repo.notifications {
+		if notification.UserID != "" {
+			t.Fatalf("team notification recorded for user %q", notification.UserID)
+		}
+		if notification.Channel == core.NotificationChannelDiscord {
+			failed = notification
+		}
+	}
+	if len(repo.notifications) != 4 || failed.Status != core.NotificationStatusFailed || failed.Error != "rate limited" {
+		t.Fatalf("notifications = %+v", repo.notifications)
+	}
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// outboxRelayBatchSize bounds how many outbox messages one relay pass publishes.
+const outboxRelayBatchSize = 100
+
+// outboxMaxBackoff caps the delay between attempts to relay a failing message.
+const outboxMaxBackoff = time.Hour
+
+// OutboxRelay publishes committed outbox messages to event subscribers and
+// marks them dispatched. A message is retried with exponential backoff until
+// every subscriber handled it, so delivery is at-least-once.
+type OutboxRelay struct {
+	repo      core.OutboxRepository
+	publisher core.EventPublisher
+	now       func() time.Time
+}
+
+// NewOutboxRelay constructs a relay reading from repo and publishing to publisher.
+func NewOutboxRelay(repo core.OutboxRepository, publisher core.EventPublisher) *OutboxRelay {
+	return &OutboxRelay{
+		repo:      repo,
+		publisher: publisher,
+		now:       time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the relay.
+func (r *OutboxRelay) WithClock(fn func() time.Time) {
+	if fn != nil {
+		r.now = fn
+	}
+}
+
+var _ core.OutboxRelay = (*OutboxRelay)(nil)
+
+// RelayOutbox publishes due messages in the order they occurred and reports
+// how many were dispatched.
+func (r *OutboxRelay) RelayOutbox(ctx context.Context) (int, error) {
+	pending, err := r.repo.ListPendingOutboxMessages(ctx, r.now().UTC(), outboxRelayBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	dispatched := 0
+	for _, message := range pending {
+		err := r.publish(ctx, message)
+
+		now := r.now().UTC()
+		message.Attempts++
+		if err != nil {
+			message.LastError = err.Error()
+			message.NextAttemptAt = now.Add(outboxBackoff(message.Attempts))
+		} else {
+			message.LastError = ""
+			message.DispatchedAt = &now
+			dispatched++
+		}
+		if err := r.repo.UpdateOutboxMessage(ctx, message); err != nil {
+			return dispatched, err
+		}
+	}
+	return dispatched, nil
+}
+
+func (r *OutboxRelay) publish(ctx context.Context, message core.OutboxMessage) error {
+	event, err := core.DecodeEvent(message.EventType, message.Payload)
+	if err != nil {
+		return err
+	}
+	return r.publisher.Publish(ctx, core.EventEnvelope{
+		ID:         message.ID,
+		OccurredAt: message.OccurredAt,
+		Event:      event,
+	})
+}
+
+// outboxBackoff doubles the delay after every failed attempt, starting at
+// five seconds.
+func outboxBackoff(attempts int) time.Duration {
+	delay := 5 * time.Second
+	for i := 1; i < attempts && delay < outboxMaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxMaxBackoff)
+}
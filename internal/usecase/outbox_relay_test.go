@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubOutboxRepo struct {
+	messages []core.OutboxMessage
+}
+
+func (s *stubOutboxRepo) ListPendingOutboxMessages(ctx context.Context, now time.Time, limit int) ([]core.OutboxMessage, error) {
+	var out []core.OutboxMessage
+	for _, message := range s.messages {
+		if message.DispatchedAt == nil && !message.NextAttemptAt.After(now) {
+			out = append(out, message)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubOutboxRepo) UpdateOutboxMessage(ctx context.Context, message core.OutboxMessage) error {
+	for i := range s.messages {
+		if s.messages[i].ID == message.ID {
+			s.messages[i] = message
+			return nil
+		}
+	}
+	return core.ErrNotFound
+}
+
+// stubEventPublisher fails events whose aggregate is listed in failing.
+type stubEventPublisher struct {
+	published []core.EventEnvelope
+	failing   map[string]bool
+}
+
+func (p *stubEventPublisher) Publish(ctx context.Context, envelope core.EventEnvelope) error {
+	p.published = append(p.published, envelope)
+	if p.failing[envelope.Event.AggregateID()] {
+		return errors.New("subscriber unavailable")
+	}
+	return nil
+}
+
+func newOutboxMessage(t *testing.T, event core.Event, occurredAt time.Time) core.OutboxMessage {
+	t.Helper()
+	payload, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("encode event: %v", err)
+	}
+	return core.OutboxMessage{
+		ID:            uuid.New(),
+		EventType:     event.EventType(),
+		AggregateID:   event.AggregateID(),
+		Payload:       payload,
+		OccurredAt:    occurredAt,
+		NextAttemptAt: occurredAt,
+	}
+}
+
+func TestOutboxRelay_RelayOutbox(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	ready := core.Asset{ID: uuid.New(), Type: core.AssetTypeAudio}
+	failing := core.Episode{ID: uuid.New(), Title: "Ordering coffee"}
+	repo := &stubOutboxRepo{messages: []core.OutboxMessage{
+		newOutboxMessage(t, core.AssetReady{Asset: ready}, now),
+		newOutboxMessage(t, core.EpisodePublished{Episode: failing}, now),
+	}}
+	publisher := &stubEventPublisher{failing: map[string]bool{failing.ID.String(): true}}
+	relay := NewOutboxRelay(repo, publisher)
+	relay.WithClock(func() time.Time { return now })
+	ctx := context.Background()
+
+	dispatched, err := relay.RelayOutbox(ctx)
+	if err != nil {
+		t.Fatalf("RelayOutbox() error = %v", err)
+	}
+	if dispatched != 1 || len(publisher.published) != 2 {
+		t.Fatalf("expected one dispatched message out of two, got %d (%+v)", dispatched, publisher.published)
+	}
+	envelope := publisher.published[0]
+	if envelope.ID != repo.messages[0].ID || envelope.Event.(core.AssetReady).Asset.ID != ready.ID {
+		t.Fatalf("unexpected envelope %+v", envelope)
+	}
+	if repo.messages[0].DispatchedAt == nil || !repo.messages[0].DispatchedAt.Equal(now) {
+		t.Fatalf("expected the first message to be dispatched, got %+v", repo.messages[0])
+	}
+	retry := repo.messages[1]
+	if retry.DispatchedAt != nil || retry.Attempts != 1 || retry.LastError == "" || !retry.NextAttemptAt.Equal(now.Add(5*time.Second)) {
+		t.Fatalf("unexpected retry state %+v", retry)
+	}
+
+	// Nothing is due before the backoff elapses; afterwards the same envelope is redelivered.
+	if dispatched, _ := relay.RelayOutbox(ctx); dispatched != 0 || len(publisher.published) != 2 {
+		t.Fatalf("expected no attempt during backoff, got %d", dispatched)
+	}
+	now = now.Add(5 * time.Second)
+	publisher.failing = nil
+	if dispatched, _ := relay.RelayOutbox(ctx); dispatched != 1 || publisher.published[2].ID != retry.ID {
+		t.Fatalf("expected the failed message to be redelivered, got %d (%+v)", dispatched, publisher.published)
+	}
+	if repo.messages[1].Attempts != 2 || repo.messages[1].LastError != "" {
+		t.Fatalf("unexpected final state %+v", repo.messages[1])
+	}
+}
+
+func TestOutboxBackoff(t *testing.T) {
+	for attempts, want := range map[int]time.Duration{
+		1:  5 * time.Second,
+		2:  10 * time.Second,
+		4:  40 * time.Second,
+		30: time.Hour,
+	} {
+		if got := outboxBackoff(attempts); got != want {
+			t.Fatalf("outboxBackoff(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// PackageExportService packages published content for import into legacy
+// LMSes. Packages stream media from playback URLs, so non-preview episodes
+// are only packaged for subscribers and API keys.
+type PackageExportService struct {
+	series        core.SeriesRepository
+	builder       core.PackageBuilder
+	subscriptions core.SubscriptionService
+}
+
+// NewPackageExportService constructs an export service using the supplied
+// repository and builder, checking entitlements with subscriptions.
+func NewPackageExportService(series core.SeriesRepository, builder core.PackageBuilder, subscriptions core.SubscriptionService) *PackageExportService {
+	return &PackageExportService{
+		series:        series,
+		builder:       builder,
+		subscriptions: subscriptions,
+	}
+}
+
+var _ core.PackageExportService = (*PackageExportService)(nil)
+
+// ExportSeriesPackage packages every playable, published episode of a
+// published series. Callers without a subscription only get its previews.
+func (s *PackageExportService) ExportSeriesPackage(ctx context.Context, seriesID uuid.UUID, format core.PackageFormat) (*core.ContentPackage, error) {
+	if seriesID == uuid.Nil {
+		return nil, fmt.Errorf("%w: series id required", core.ErrValidation)
+	}
+	if err := validatePackageFormat(format); err != nil {
+		return nil, err
+	}
+
+	series, err := s.series.GetSeries(ctx, seriesID, core.SeriesQueryOptions{IncludeEpisodes: true})
+	if err != nil {
+		return nil, err
+	}
+	if series.Status != core.SeriesStatusPublished {
+		return nil, fmt.Errorf("%w: only published series can be exported", core.ErrInvalidState)
+	}
+
+	episodes := lo.Filter(series.Episodes, func(episode core.Episode, _ int) bool {
+		return isPackageable(episode)
+	})
+	if len(episodes) == 0 {
+		return nil, fmt.Errorf("%w: series has no playable published episodes", core.ErrInvalidState)
+	}
+	if !lo.EveryBy(episodes, func(episode core.Episode) bool { return episode.Preview }) {
+		entitled, err := s.entitled(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !entitled {
+			episodes = lo.Filter(episodes, func(episode core.Episode, _ int) bool { return episode.Preview })
+		}
+	}
+	if len(episodes) == 0 {
+		return nil, fmt.Errorf("%w: series %s has no preview episodes", core.ErrSubscriptionRequired, series.ID)
+	}
+	return s.builder.BuildPackage(format, *series, episodes)
+}
+
+// ExportEpisodePackage packages a single published episode under its series'
+// metadata. Episodes other than previews require a subscription.
+func (s *PackageExportService) ExportEpisodePackage(ctx context.Context, episodeID uuid.UUID, format core.PackageFormat) (*core.ContentPackage, error) {
+	if episodeID == uuid.Nil {
+		return nil, fmt.Errorf("%w: episode id required", core.ErrValidation)
+	}
+	if err := validatePackageFormat(format); err != nil {
+		return nil, err
+	}
+
+	episode, err := s.series.GetEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+	if !isPackageable(*episode) {
+		return nil, fmt.Errorf("%w: only playable published episodes can be exported", core.ErrInvalidState)
+	}
+	if !episode.Preview {
+		entitled, err := s.entitled(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !entitled {
+			return nil, fmt.Errorf("%w: episode %s is not a preview", core.ErrSubscriptionRequired, episode.ID)
+		}
+	}
+	series, err := s.series.GetSeries(ctx, episode.SeriesID, core.SeriesQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// Name the archive after the episode so it is not mistaken for the whole series.
+	series.Slug = fmt.Sprintf("%s-episode-%d", series.Slug, episode.Seq)
+	return s.builder.BuildPackage(format, *series, []core.Episode{*episode})
+}
+
+// entitled reports whether the caller may package episodes other than
+// previews: API keys may, learners need an active subscription.
+func (s *PackageExportService) entitled(ctx context.Context) (bool, error) {
+	caller, ok := core.CallerFromContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	if len(caller.APIKeyScopes) > 0 {
+		return true, nil
+	}
+	if _, err := s.subscriptions.ActiveSubscription(ctx, caller.UserID); err != nil {
+		if errors.Is(err, core.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func validatePackageFormat(format core.PackageFormat) error {
+	switch format {
+	case core.PackageFormatSCORM12, core.PackageFormatXAPI:
+		return nil
+	default:
+		return fmt.Errorf("%w: package format is required", core.ErrValidation)
+	}
+}
+
+// isPackageable reports whether an episode can be played from an exported
+// package, which streams media from its playback URL.
+func isPackageable(episode core.Episode) bool {
+	return episode.Status == core.EpisodeStatusPublished &&
+		episode.DeletedAt == nil &&
+		episode.Resource.PlaybackURL != ""
+}
@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubPackageBuilder struct {
+	series   core.Series
+	episodes []core.Episode
+}
+
+func (b *stubPackageBuilder) BuildPackage(format core.PackageFormat, series core.Series, episodes []core.Episode) (*core.ContentPackage, error) {
+	b.series, b.episodes = series, episodes
+	return &core.ContentPackage{Filename: series.Slug + ".zip"}, nil
+}
+
+func TestPackageExportService_ExportSeriesPackage(t *testing.T) {
+	playable := core.MediaResource{PlaybackURL: "https://cdn.example.com/a.m3u8"}
+	published := core.Episode{ID: uuid.New(), Status: core.EpisodeStatusPublished, Resource: playable}
+	series := core.Series{
+		ID:     uuid.New(),
+		Slug:   "coffee",
+		Status: core.SeriesStatusPublished,
+		Episodes: []core.Episode{
+			published,
+			{ID: uuid.New(), Status: core.EpisodeStatusDraft, Resource: playable},
+			{ID: uuid.New(), Status: core.EpisodeStatusPublished},
+		},
+	}
+	repo := &stubSeriesRepo{
+		getSeriesFn: func(ctx context.Context, id uuid.UUID, opts core.SeriesQueryOptions) (*core.Series, error) {
+			copied := series
+			return &copied, nil
+		},
+	}
+	builder := &stubPackageBuilder{}
+	service := NewPackageExportService(repo, builder, NewSubscriptionService(newStubSubscriptionRepo()))
+	ctx := core.NewCallerContext(context.Background(), core.Caller{UserID: "apikey:lms", APIKeyScopes: []string{core.APIKeyScopeAll}})
+
+	if _, err := service.ExportSeriesPackage(ctx, series.ID, core.PackageFormatUnspecified); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected missing format to fail validation, got %v", err)
+	}
+
+	if _, err := service.ExportSeriesPackage(ctx, series.ID, core.PackageFormatSCORM12); err != nil {
+		t.Fatalf("ExportSeriesPackage() error = %v", err)
+	}
+	if len(builder.episodes) != 1 || builder.episodes[0].ID != published.ID {
+		t.Fatalf("expected only the playable published episode, got %+v", builder.episodes)
+	}
+
+	series.Status = core.SeriesStatusDraft
+	if _, err := service.ExportSeriesPackage(ctx, series.ID, core.PackageFormatXAPI); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected draft series to be rejected, got %v", err)
+	}
+}
+
+func TestPackageExportService_ExportEpisodePackage(t *testing.T) {
+	series := core.Series{ID: uuid.New(), Slug: "coffee", Status: core.SeriesStatusPublished}
+	episode := core.Episode{
+		ID:       uuid.New(),
+		SeriesID: series.ID,
+		Seq:      3,
+		Preview:  true,
+		Status:   core.EpisodeStatusPublished,
+		Resource: core.MediaResource{PlaybackURL: "https://cdn.example.com/a.m3u8"},
+	}
+	repo := &stubSeriesRepo{
+		getSeriesFn: func(ctx context.Context, id uuid.UUID, opts core.SeriesQueryOptions) (*core.Series, error) {
+			copied := series
+			return &copied, nil
+		},
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			return &episode, nil
+		},
+	}
+	service := NewPackageExportService(repo, &stubPackageBuilder{}, NewSubscriptionService(newStubSubscriptionRepo()))
+
+	pkg, err := service.ExportEpisodePackage(context.Background(), episode.ID, core.PackageFormatXAPI)
+	if err != nil {
+		t.Fatalf("ExportEpisodePackage() error = %v", err)
+	}
+	if pkg.Filename != "coffee-episode-3.zip" {
+		t.Fatalf("unexpected filename %q", pkg.Filename)
+	}
+}
+
+func TestPackageExportService_RequiresSubscriptionForNonPreviewEpisodes(t *testing.T) {
+	ctx := context.Background()
+	playable := core.MediaResource{PlaybackURL: "https://cdn.example.com/a.m3u8"}
+	preview := core.Episode{ID: uuid.New(), Status: core.EpisodeStatusPublished, Preview: true, Resource: playable}
+	locked := core.Episode{ID: uuid.New(), Status: core.EpisodeStatusPublished, Resource: playable}
+	series := core.Series{ID: uuid.New(), Slug: "coffee", Status: core.SeriesStatusPublished, Episodes: []core.Episode{preview, locked}}
+	preview.SeriesID, locked.SeriesID = series.ID, series.ID
+	repo := &stubSeriesRepo{
+		getSeriesFn: func(ctx context.Context, id uuid.UUID, opts core.SeriesQueryOptions) (*core.Series, error) {
+			copied := series
+			return &copied, nil
+		},
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			return &locked, nil
+		},
+	}
+	monthly := core.Plan{ID: uuid.New(), Code: "monthly", Interval: core.BillingIntervalMonth, Active: true}
+	subscriptions := NewSubscriptionService(newStubSubscriptionRepo(monthly))
+	if _, err := subscriptions.Subscribe(ctx, "subscriber", monthly.ID); err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+	builder := &stubPackageBuilder{}
+	service := NewPackageExportService(repo, builder, subscriptions)
+
+	learner := core.NewCallerContext(ctx, core.Caller{UserID: "learner"})
+	for name, caller := range map[string]context.Context{"anonymous": ctx, "learner": learner} {
+		if _, err := service.ExportSeriesPackage(caller, series.ID, core.PackageFormatSCORM12); err != nil {
+			t.Fatalf("ExportSeriesPackage(%s) error = %v", name, err)
+		}
+		if len(builder.episodes) != 1 || builder.episodes[0].ID != preview.ID {
+			t.Fatalf("expected %s to get only the preview, got %+v", name, builder.episodes)
+		}
+		if _, err := service.ExportEpisodePackage(caller, locked.ID, core.PackageFormatSCORM12); !errors.Is(err, core.ErrSubscriptionRequired) {
+			t.Fatalf("expected %s to need a subscription for the episode, got %v", name, err)
+		}
+	}
+
+	subscriber := core.NewCallerContext(ctx, core.Caller{UserID: "subscriber"})
+	if _, err := service.ExportSeriesPackage(subscriber, series.ID, core.PackageFormatSCORM12); err != nil || len(builder.episodes) != 2 {
+		t.Fatalf("expected subscribers to get every episode, got %+v, %v", builder.episodes, err)
+	}
+	if _, err := service.ExportEpisodePackage(subscriber, locked.ID, core.PackageFormatSCORM12); err != nil {
+		t.Fatalf("ExportEpisodePackage(subscriber) error = %v", err)
+	}
+
+	series.Episodes = []core.Episode{locked}
+	if _, err := service.ExportSeriesPackage(learner, series.ID, core.PackageFormatSCORM12); !errors.Is(err, core.ErrSubscriptionRequired) {
+		t.Fatalf("expected a subscription to be required without previews, got %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// pageURLPlaceholder matches the placeholders of page URL templates.
+var pageURLPlaceholder = regexp.MustCompile(`\{(series_id|series_slug|episode_id|episode_seq)\}`)
+
+// expandPageURL fills the placeholders of a page URL template. The episode
+// placeholders are left empty when episode is the zero value.
+func expandPageURL(template string, series core.Series, episode core.Episode) string {
+	episodeID, episodeSeq := "", ""
+	if episode.ID != uuid.Nil {
+		episodeID = episode.ID.String()
+		episodeSeq = strconv.FormatUint(uint64(episode.Seq), 10)
+	}
+	return strings.NewReplacer(
+		"{series_id}", series.ID.String(),
+		"{series_slug}", url.PathEscape(series.Slug),
+		"{episode_id}", episodeID,
+		"{episode_seq}", episodeSeq,
+	).Replace(template)
+}
+
+// matchPageURL reports whether u is a page built from template and returns
+// the values of its placeholders. Schemes, the case of hosts, trailing
+// slashes and query parameters the template does not use are ignored.
+func matchPageURL(template string, u *url.URL) (map[string]string, bool) {
+	if template == "" {
+		return nil, false
+	}
+	tu, err := url.Parse(template)
+	if err != nil || !strings.EqualFold(tu.Host, u.Host) {
+		return nil, false
+	}
+
+	values := map[string]string{}
+	if !matchPageURLPart(tu.Path, u.EscapedPath(), values, url.PathUnescape) {
+		return nil, false
+	}
+	query := u.Query()
+	for key, want := range tu.Query() {
+		if len(want) == 0 || !matchPageURLPart(want[0], query.Get(key), values, nil) {
+			return nil, false
+		}
+	}
+	return values, true
+}
+
+// matchPageURLPart matches one part of a URL against the same part of a
+// template, storing the placeholder values it captures in values.
+func matchPageURLPart(template, value string, values map[string]string, unescape func(string) (string, error)) bool {
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	last := 0
+	for _, loc := range pageURLPlaceholder.FindAllStringSubmatchIndex(template, -1) {
+		pattern.WriteString(regexp.QuoteMeta(template[last:loc[0]]))
+		pattern.WriteString("(?P<" + template[loc[2]:loc[3]] + ">[^/]+)")
+		last = loc[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(strings.TrimSuffix(template[last:], "/")))
+	pattern.WriteString("/?$")
+
+	re, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return false
+	}
+	match := re.FindStringSubmatch(value)
+	if match == nil {
+		return false
+	}
+	for i, name := range re.SubexpNames() {
+		if name == "" {
+			continue
+		}
+		captured := match[i]
+		if unescape != nil {
+			if captured, err = unescape(captured); err != nil {
+				return false
+			}
+		}
+		values[name] = captured
+	}
+	return true
+}
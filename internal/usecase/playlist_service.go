@@ -0,0 +1,326 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	maxPlaylistItems   = 500
+	maxPlaylistSlugLen = 48
+)
+
+// PlaylistService coordinates user-curated study lists of episodes.
+type PlaylistService struct {
+	repo       core.PlaylistRepository
+	series     core.SeriesRepository
+	moderation core.ModerationService
+}
+
+// NewPlaylistService constructs a playlist service using the supplied repositories.
+func NewPlaylistService(repo core.PlaylistRepository, series core.SeriesRepository) *PlaylistService {
+	return &PlaylistService{
+		repo:   repo,
+		series: series,
+	}
+}
+
+// WithModeration screens the title and description of public playlists.
+// Shared playlists are hidden until their text is approved.
+func (s *PlaylistService) WithModeration(moderation core.ModerationService) {
+	s.moderation = moderation
+}
+
+var _ core.PlaylistService = (*PlaylistService)(nil)
+
+// CreatePlaylist stores a new playlist owned by ownerID.
+func (s *PlaylistService) CreatePlaylist(ctx context.Context, ownerID string, draft core.PlaylistDraft) (*core.Playlist, error) {
+	ownerID = strings.TrimSpace(ownerID)
+	if ownerID == "" {
+		return nil, fmt.Errorf("%w: owner id is required", core.ErrValidation)
+	}
+	title := strings.TrimSpace(draft.Title)
+	if title == "" {
+		return nil, fmt.Errorf("%w: playlist title is required", core.ErrValidation)
+	}
+	if len(lo.Uniq(draft.EpisodeIDs)) != len(draft.EpisodeIDs) {
+		return nil, fmt.Errorf("%w: playlist episodes must be unique", core.ErrValidation)
+	}
+	if len(draft.EpisodeIDs) > maxPlaylistItems {
+		return nil, fmt.Errorf("%w: playlists hold at most %d episodes", core.ErrValidation, maxPlaylistItems)
+	}
+	if err := s.ensureEpisodes(ctx, draft.EpisodeIDs); err != nil {
+		return nil, err
+	}
+
+	playlist := core.Playlist{
+		ID:          uuid.New(),
+		OwnerID:     ownerID,
+		Title:       title,
+		Description: strings.TrimSpace(draft.Description),
+		Public:      draft.Public,
+		Slug:        normalizePlaylistSlug(draft.Slug),
+		Items: lo.Map(draft.EpisodeIDs, func(id uuid.UUID, i int) core.PlaylistItem {
+			return core.PlaylistItem{EpisodeID: id, Position: i}
+		}),
+	}
+	if playlist.Public && playlist.Slug == "" {
+		playlist.Slug = generatePlaylistSlug(playlist.Title, playlist.ID)
+	}
+
+	created, err := s.repo.CreatePlaylist(ctx, playlist)
+	if err != nil {
+		return nil, err
+	}
+	return s.moderate(ctx, created)
+}
+
+// GetPlaylist retrieves a playlist by identifier.
+func (s *PlaylistService) GetPlaylist(ctx context.Context, id uuid.UUID, opts core.PlaylistQueryOptions) (*core.Playlist, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: playlist id required", core.ErrValidation)
+	}
+	playlist, err := s.repo.GetPlaylist(ctx, id, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.annotateModeration(ctx, []*core.Playlist{playlist}); err != nil {
+		return nil, err
+	}
+	return playlist, nil
+}
+
+// GetSharedPlaylist retrieves a public playlist by its share slug.
+// Private playlists and playlists held by moderation are reported as not found.
+func (s *PlaylistService) GetSharedPlaylist(ctx context.Context, slug string, opts core.PlaylistQueryOptions) (*core.Playlist, error) {
+	slug = normalizePlaylistSlug(slug)
+	if slug == "" {
+		return nil, fmt.Errorf("%w: playlist slug required", core.ErrValidation)
+	}
+
+	playlist, err := s.repo.GetPlaylistBySlug(ctx, slug, opts)
+	if err != nil {
+		return nil, err
+	}
+	if !playlist.Public {
+		return nil, core.ErrNotFound
+	}
+	if err := s.annotateModeration(ctx, []*core.Playlist{playlist}); err != nil {
+		return nil, err
+	}
+	if !playlist.ModerationStatus.Visible() {
+		return nil, core.ErrNotFound
+	}
+	return playlist, nil
+}
+
+// UpdatePlaylist applies metadata and sharing changes to a playlist.
+func (s *PlaylistService) UpdatePlaylist(ctx context.Context, playlist core.Playlist) (*core.Playlist, error) {
+	if playlist.ID == uuid.Nil {
+		return nil, fmt.Errorf("%w: playlist id required", core.ErrValidation)
+	}
+	playlist.Title = strings.TrimSpace(playlist.Title)
+	if playlist.Title == "" {
+		return nil, fmt.Errorf("%w: playlist title is required", core.ErrValidation)
+	}
+	playlist.Description = strings.TrimSpace(playlist.Description)
+	playlist.Slug = normalizePlaylistSlug(playlist.Slug)
+	if playlist.Public && playlist.Slug == "" {
+		playlist.Slug = generatePlaylistSlug(playlist.Title, playlist.ID)
+	}
+
+	updated, err := s.repo.UpdatePlaylist(ctx, playlist)
+	if err != nil {
+		return nil, err
+	}
+	return s.moderate(ctx, updated)
+}
+
+// DeletePlaylist removes a playlist and its items.
+func (s *PlaylistService) DeletePlaylist(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("%w: playlist id required", core.ErrValidation)
+	}
+	return s.repo.DeletePlaylist(ctx, id)
+}
+
+// ListPlaylists returns playlists matching the filter.
+func (s *PlaylistService) ListPlaylists(ctx context.Context, filter core.PlaylistListFilter) ([]core.Playlist, string, error) {
+	filter.OwnerID = strings.TrimSpace(filter.OwnerID)
+	playlists, nextToken, err := s.repo.ListPlaylists(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+	refs := make([]*core.Playlist, len(playlists))
+	for i := range playlists {
+		refs[i] = &playlists[i]
+	}
+	if err := s.annotateModeration(ctx, refs); err != nil {
+		return nil, "", err
+	}
+	return playlists, nextToken, nil
+}
+
+// AddPlaylistEpisodes appends episodes to the end of a playlist. Episodes
+// already in the playlist are left at their current position.
+func (s *PlaylistService) AddPlaylistEpisodes(ctx context.Context, id uuid.UUID, episodeIDs []uuid.UUID) (*core.Playlist, error) {
+	playlist, err := s.GetPlaylist(ctx, id, core.PlaylistQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	current := playlistEpisodeIDs(playlist)
+	added := lo.Without(lo.Uniq(episodeIDs), current...)
+	if len(added) == 0 {
+		return playlist, nil
+	}
+	if len(current)+len(added) > maxPlaylistItems {
+		return nil, fmt.Errorf("%w: playlists hold at most %d episodes", core.ErrValidation, maxPlaylistItems)
+	}
+	if err := s.ensureEpisodes(ctx, added); err != nil {
+		return nil, err
+	}
+
+	return s.replaceItems(ctx, playlist.ID, append(current, added...))
+}
+
+// RemovePlaylistEpisode removes an episode from a playlist, closing the gap it leaves.
+func (s *PlaylistService) RemovePlaylistEpisode(ctx context.Context, id uuid.UUID, episodeID uuid.UUID) (*core.Playlist, error) {
+	playlist, err := s.GetPlaylist(ctx, id, core.PlaylistQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	current := playlistEpisodeIDs(playlist)
+	if !lo.Contains(current, episodeID) {
+		return nil, fmt.Errorf("%w: episode %s is not in the playlist", core.ErrNotFound, episodeID)
+	}
+
+	return s.replaceItems(ctx, playlist.ID, lo.Without(current, episodeID))
+}
+
+// ReorderPlaylist rewrites the order of a playlist. episodeIDs must list every
+// episode currently in the playlist exactly once.
+func (s *PlaylistService) ReorderPlaylist(ctx context.Context, id uuid.UUID, episodeIDs []uuid.UUID) (*core.Playlist, error) {
+	playlist, err := s.GetPlaylist(ctx, id, core.PlaylistQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	current := playlistEpisodeIDs(playlist)
+	if len(episodeIDs) != len(current) || len(lo.Uniq(episodeIDs)) != len(episodeIDs) || len(lo.Without(episodeIDs, current...)) > 0 {
+		return nil, fmt.Errorf("%w: reorder must list every playlist episode exactly once", core.ErrValidation)
+	}
+
+	return s.replaceItems(ctx, playlist.ID, episodeIDs)
+}
+
+func (s *PlaylistService) replaceItems(ctx context.Context, id uuid.UUID, episodeIDs []uuid.UUID) (*core.Playlist, error) {
+	playlist, err := s.repo.ReplacePlaylistItems(ctx, id, episodeIDs)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.annotateModeration(ctx, []*core.Playlist{playlist}); err != nil {
+		return nil, err
+	}
+	return playlist, nil
+}
+
+// moderate submits the text of a public playlist for moderation and records
+// the resulting status. Private playlists are only seen by their owner and
+// are not moderated until they are shared.
+func (s *PlaylistService) moderate(ctx context.Context, playlist *core.Playlist) (*core.Playlist, error) {
+	if s.moderation == nil || !playlist.Public {
+		return playlist, nil
+	}
+	item, err := s.moderation.SubmitForModeration(ctx, core.ModerationSubmission{
+		SubjectType: core.ModerationSubjectPlaylist,
+		SubjectID:   playlist.ID,
+		AuthorID:    playlist.OwnerID,
+		Text:        strings.TrimSpace(playlist.Title + "\n\n" + playlist.Description),
+	})
+	if err != nil {
+		return nil, err
+	}
+	playlist.ModerationStatus = item.Status
+	return playlist, nil
+}
+
+// annotateModeration fills in the moderation status of public playlists.
+func (s *PlaylistService) annotateModeration(ctx context.Context, playlists []*core.Playlist) error {
+	if s.moderation == nil {
+		return nil
+	}
+	public := lo.Filter(playlists, func(playlist *core.Playlist, _ int) bool { return playlist.Public })
+	if len(public) == 0 {
+		return nil
+	}
+	statuses, err := s.moderation.ModerationStatuses(ctx, core.ModerationSubjectPlaylist, lo.Map(public, func(playlist *core.Playlist, _ int) uuid.UUID {
+		return playlist.ID
+	}))
+	if err != nil {
+		return err
+	}
+	for _, playlist := range public {
+		playlist.ModerationStatus = statuses[playlist.ID]
+	}
+	return nil
+}
+
+func (s *PlaylistService) ensureEpisodes(ctx context.Context, ids []uuid.UUID) error {
+	for _, id := range ids {
+		episode, err := s.series.GetEpisode(ctx, id)
+		if err != nil {
+			return err
+		}
+		if episode.DeletedAt != nil {
+			return fmt.Errorf("%w: episode %s", core.ErrNotFound, id)
+		}
+	}
+	return nil
+}
+
+func playlistEpisodeIDs(playlist *core.Playlist) []uuid.UUID {
+	return lo.Map(playlist.Items, func(item core.PlaylistItem, _ int) uuid.UUID {
+		return item.EpisodeID
+	})
+}
+
+func normalizePlaylistSlug(slug string) string {
+	return strings.ToLower(strings.TrimSpace(slug))
+}
+
+// generatePlaylistSlug derives an ASCII share slug from the title, suffixed
+// with part of the playlist ID so titles need not be unique.
+func generatePlaylistSlug(title string, id uuid.UUID) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if b.Len() > 0 && !dash {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+
+	base := []rune(strings.Trim(b.String(), "-"))
+	if len(base) > maxPlaylistSlugLen {
+		base = []rune(strings.Trim(string(base[:maxPlaylistSlugLen]), "-"))
+	}
+
+	suffix := strings.ReplaceAll(id.String(), "-", "")[:8]
+	if len(base) == 0 {
+		return suffix
+	}
+	return string(base) + "-" + suffix
+}
@@ -0,0 +1,332 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestPlaylistService_CreatePlaylist(t *testing.T) {
+	fixedNow := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	deletedAt := fixedNow.Add(-time.Hour)
+	liveEpisode := uuid.New()
+	deletedEpisode := uuid.New()
+
+	seriesRepo := &stubSeriesRepo{
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			switch id {
+			case liveEpisode:
+				return &core.Episode{ID: id}, nil
+			case deletedEpisode:
+				return &core.Episode{ID: id, DeletedAt: &deletedAt}, nil
+			default:
+				return nil, core.ErrNotFound
+			}
+		},
+	}
+
+	var created core.Playlist
+	repo := &stubPlaylistRepo{
+		createPlaylistFn: func(ctx context.Context, playlist core.Playlist) (*core.Playlist, error) {
+			created = playlist
+			return &playlist, nil
+		},
+	}
+
+	service := NewPlaylistService(repo, seriesRepo)
+
+	tests := []struct {
+		name    string
+		ownerID string
+		draft   core.PlaylistDraft
+		wantErr error
+	}{
+		{name: "public playlist gets slug", ownerID: "learner", draft: core.PlaylistDraft{Title: "Morning Listening!", Public: true, EpisodeIDs: []uuid.UUID{liveEpisode}}},
+		{name: "missing owner", draft: core.PlaylistDraft{Title: "x"}, wantErr: core.ErrValidation},
+		{name: "missing title", ownerID: "learner", draft: core.PlaylistDraft{Title: "  "}, wantErr: core.ErrValidation},
+		{name: "duplicate episodes", ownerID: "learner", draft: core.PlaylistDraft{Title: "x", EpisodeIDs: []uuid.UUID{liveEpisode, liveEpisode}}, wantErr: core.ErrValidation},
+		{name: "deleted episode", ownerID: "learner", draft: core.PlaylistDraft{Title: "x", EpisodeIDs: []uuid.UUID{deletedEpisode}}, wantErr: core.ErrNotFound},
+		{name: "unknown episode", ownerID: "learner", draft: core.PlaylistDraft{Title: "x", EpisodeIDs: []uuid.UUID{uuid.New()}}, wantErr: core.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.CreatePlaylist(context.Background(), tt.ownerID, tt.draft)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreatePlaylist() error = %v", err)
+			}
+		})
+	}
+
+	if created.Slug != generatePlaylistSlug("Morning Listening!", created.ID) {
+		t.Fatalf("expected generated slug, got %q", created.Slug)
+	}
+	if len(created.Items) != 1 || created.Items[0].EpisodeID != liveEpisode {
+		t.Fatalf("unexpected created playlist %#v", created)
+	}
+}
+
+func TestPlaylistService_ItemOperations(t *testing.T) {
+	playlistID := uuid.New()
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+
+	var replaced []uuid.UUID
+	repo := &stubPlaylistRepo{
+		getPlaylistFn: func(ctx context.Context, id uuid.UUID, opts core.PlaylistQueryOptions) (*core.Playlist, error) {
+			return &core.Playlist{
+				ID: id,
+				Items: []core.PlaylistItem{
+					{EpisodeID: a, Position: 0},
+					{EpisodeID: b, Position: 1},
+				},
+			}, nil
+		},
+		replaceItemsFn: func(ctx context.Context, id uuid.UUID, episodeIDs []uuid.UUID) (*core.Playlist, error) {
+			replaced = episodeIDs
+			return &core.Playlist{ID: id}, nil
+		},
+	}
+	seriesRepo := &stubSeriesRepo{
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			return &core.Episode{ID: id}, nil
+		},
+	}
+	service := NewPlaylistService(repo, seriesRepo)
+
+	tests := []struct {
+		name    string
+		run     func() error
+		want    []uuid.UUID
+		wantErr error
+	}{
+		{
+			name: "add skips existing episodes",
+			run: func() error {
+				_, err := service.AddPlaylistEpisodes(context.Background(), playlistID, []uuid.UUID{b, c, c})
+				return err
+			},
+			want: []uuid.UUID{a, b, c},
+		},
+		{
+			name: "remove closes gap",
+			run: func() error {
+				_, err := service.RemovePlaylistEpisode(context.Background(), playlistID, a)
+				return err
+			},
+			want: []uuid.UUID{b},
+		},
+		{
+			name: "remove missing episode",
+			run: func() error {
+				_, err := service.RemovePlaylistEpisode(context.Background(), playlistID, c)
+				return err
+			},
+			wantErr: core.ErrNotFound,
+		},
+		{
+			name: "reorder permutation",
+			run: func() error {
+				_, err := service.ReorderPlaylist(context.Background(), playlistID, []uuid.UUID{b, a})
+				return err
+			},
+			want: []uuid.UUID{b, a},
+		},
+		{
+			name: "reorder with foreign episode",
+			run: func() error {
+				_, err := service.ReorderPlaylist(context.Background(), playlistID, []uuid.UUID{b, c})
+				return err
+			},
+			wantErr: core.ErrValidation,
+		},
+		{
+			name: "reorder missing entry",
+			run: func() error {
+				_, err := service.ReorderPlaylist(context.Background(), playlistID, []uuid.UUID{a})
+				return err
+			},
+			wantErr: core.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			replaced = nil
+			err := tt.run()
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if !reflect.DeepEqual(replaced, tt.want) {
+				t.Fatalf("replaced items = %v, want %v", replaced, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlaylistService_GetSharedPlaylist(t *testing.T) {
+	public := true
+	repo := &stubPlaylistRepo{
+		getBySlugFn: func(ctx context.Context, slug string, opts core.PlaylistQueryOptions) (*core.Playlist, error) {
+			if slug != "weekly-picks" {
+				return nil, core.ErrNotFound
+			}
+			return &core.Playlist{Slug: slug, Public: public}, nil
+		},
+	}
+	service := NewPlaylistService(repo, &stubSeriesRepo{})
+
+	if _, err := service.GetSharedPlaylist(context.Background(), " Weekly-Picks ", core.PlaylistQueryOptions{}); err != nil {
+		t.Fatalf("GetSharedPlaylist() error = %v", err)
+	}
+
+	public = false
+	if _, err := service.GetSharedPlaylist(context.Background(), "weekly-picks", core.PlaylistQueryOptions{}); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected private playlist to be hidden, got %v", err)
+	}
+}
+
+func TestPlaylistService_Moderation(t *testing.T) {
+	ctx := context.Background()
+	stored := map[uuid.UUID]core.Playlist{}
+	repo := &stubPlaylistRepo{
+		createPlaylistFn: func(ctx context.Context, playlist core.Playlist) (*core.Playlist, error) {
+			stored[playlist.ID] = playlist
+			return &playlist, nil
+		},
+		getBySlugFn: func(ctx context.Context, slug string, opts core.PlaylistQueryOptions) (*core.Playlist, error) {
+			for _, playlist := range stored {
+				if playlist.Slug == slug {
+					return &playlist, nil
+				}
+			}
+			return nil, core.ErrNotFound
+		},
+	}
+	moderation := NewModerationService(newStubModerationRepo(), []core.TextClassifier{
+		classifierFunc(func(_ context.Context, text string) (*core.TextClassification, error) {
+			return &core.TextClassification{Flagged: strings.Contains(strings.ToLower(text), "darn")}, nil
+		}),
+	})
+	service := NewPlaylistService(repo, &stubSeriesRepo{})
+	service.WithModeration(moderation)
+
+	clean, err := service.CreatePlaylist(ctx, "user-1", core.PlaylistDraft{Title: "Weekly picks", Public: true, Slug: "weekly-picks"})
+	if err != nil {
+		t.Fatalf("CreatePlaylist() error = %v", err)
+	}
+	if clean.ModerationStatus != core.ModerationStatusApproved {
+		t.Fatalf("expected clean playlist to be approved, got %v", clean.ModerationStatus)
+	}
+	if _, err := service.GetSharedPlaylist(ctx, "weekly-picks", core.PlaylistQueryOptions{}); err != nil {
+		t.Fatalf("GetSharedPlaylist() error = %v", err)
+	}
+
+	held, err := service.CreatePlaylist(ctx, "user-1", core.PlaylistDraft{Title: "Darn good picks", Public: true, Slug: "darn-good"})
+	if err != nil {
+		t.Fatalf("CreatePlaylist() error = %v", err)
+	}
+	if held.ModerationStatus != core.ModerationStatusPending {
+		t.Fatalf("expected flagged playlist to be pending, got %v", held.ModerationStatus)
+	}
+	if _, err := service.GetSharedPlaylist(ctx, "darn-good", core.PlaylistQueryOptions{}); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected pending playlist to be hidden, got %v", err)
+	}
+
+	private, err := service.CreatePlaylist(ctx, "user-1", core.PlaylistDraft{Title: "Darn private notes"})
+	if err != nil {
+		t.Fatalf("CreatePlaylist() error = %v", err)
+	}
+	if private.ModerationStatus != core.ModerationStatusUnspecified {
+		t.Fatalf("expected private playlist to skip moderation, got %v", private.ModerationStatus)
+	}
+}
+
+func TestGeneratePlaylistSlug(t *testing.T) {
+	id := uuid.MustParse("0123abcd-0000-0000-0000-000000000000")
+
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{title: "Morning Listening!", want: "morning-listening-0123abcd"},
+		{title: "  B1 -- Travel & Food  ", want: "b1-travel-food-0123abcd"},
+		{title: "晨间听力", want: "0123abcd"},
+		{title: strings.Repeat("a", 80), want: strings.Repeat("a", maxPlaylistSlugLen) + "-0123abcd"},
+	}
+
+	for _, tt := range tests {
+		if got := generatePlaylistSlug(tt.title, id); got != tt.want {
+			t.Fatalf("generatePlaylistSlug(%q) = %q, want %q", tt.title, got, tt.want)
+		}
+	}
+}
+
+type stubPlaylistRepo struct {
+	createPlaylistFn func(ctx context.Context, playlist core.Playlist) (*core.Playlist, error)
+	getPlaylistFn    func(ctx context.Context, id uuid.UUID, opts core.PlaylistQueryOptions) (*core.Playlist, error)
+	getBySlugFn      func(ctx context.Context, slug string, opts core.PlaylistQueryOptions) (*core.Playlist, error)
+	updatePlaylistFn func(ctx context.Context, playlist core.Playlist) (*core.Playlist, error)
+	replaceItemsFn   func(ctx context.Context, id uuid.UUID, episodeIDs []uuid.UUID) (*core.Playlist, error)
+}
+
+func (s *stubPlaylistRepo) CreatePlaylist(ctx context.Context, playlist core.Playlist) (*core.Playlist, error) {
+	if s.createPlaylistFn != nil {
+		return s.createPlaylistFn(ctx, playlist)
+	}
+	return &playlist, nil
+}
+
+func (s *stubPlaylistRepo) GetPlaylist(ctx context.Context, id uuid.UUID, opts core.PlaylistQueryOptions) (*core.Playlist, error) {
+	if s.getPlaylistFn != nil {
+		return s.getPlaylistFn(ctx, id, opts)
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubPlaylistRepo) GetPlaylistBySlug(ctx context.Context, slug string, opts core.PlaylistQueryOptions) (*core.Playlist, error) {
+	if s.getBySlugFn != nil {
+		return s.getBySlugFn(ctx, slug, opts)
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubPlaylistRepo) UpdatePlaylist(ctx context.Context, playlist core.Playlist) (*core.Playlist, error) {
+	if s.updatePlaylistFn != nil {
+		return s.updatePlaylistFn(ctx, playlist)
+	}
+	return &playlist, nil
+}
+
+func (s *stubPlaylistRepo) ReplacePlaylistItems(ctx context.Context, id uuid.UUID, episodeIDs []uuid.UUID) (*core.Playlist, error) {
+	if s.replaceItemsFn != nil {
+		return s.replaceItemsFn(ctx, id, episodeIDs)
+	}
+	return nil, nil
+}
+
+func (s *stubPlaylistRepo) DeletePlaylist(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (s *stubPlaylistRepo) ListPlaylists(ctx context.Context, filter core.PlaylistListFilter) ([]core.Playlist, string, error) {
+	return nil, "", nil
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// seriesViolations checks a series about to be published against the series
+// rules of the checklist, and each of its published episodes against the
+// episode rules.
+func seriesViolations(checklist core.PublishChecklist, series core.Series) []core.PublishViolation {
+	var violations []core.PublishViolation
+	violate := func(rule core.PublishRule, format string, args ...any) {
+		violations = append(violations, core.PublishViolation{
+			Rule:        rule,
+			SeriesID:    series.ID,
+			Description: fmt.Sprintf(format, args...),
+		})
+	}
+
+	if checklist.Has(core.PublishRuleCover) && series.CoverURL == "" {
+		violate(core.PublishRuleCover, "series has no cover")
+	}
+	if checklist.Has(core.PublishRuleSummary) {
+		if length := utf8.RuneCountInString(strings.TrimSpace(series.Summary)); length < checklist.MinSummaryLength {
+			violate(core.PublishRuleSummary, "series summary has %d characters, at least %d are required", length, checklist.MinSummaryLength)
+		}
+	}
+
+	ready := false
+	for _, episode := range series.Episodes {
+		if episode.DeletedAt != nil {
+			continue
+		}
+		ready = ready || episode.Status == core.EpisodeStatusReady || episode.Status == core.EpisodeStatusPublished
+		violations = append(violations, episodeViolations(checklist, episode)...)
+	}
+	if checklist.Has(core.PublishRuleReadyEpisode) && !ready {
+		violate(core.PublishRuleReadyEpisode, "series has no ready episode")
+	}
+	return violations
+}
+
+// episodeViolations checks an episode against the episode rules of the
+// checklist if it is published.
+func episodeViolations(checklist core.PublishChecklist, episode core.Episode) []core.PublishViolation {
+	if episode.Status != core.EpisodeStatusPublished {
+		return nil
+	}
+	if checklist.Has(core.PublishRuleTranscripts) && strings.TrimSpace(episode.Transcript.Content) == "" {
+		return []core.PublishViolation{{
+			Rule:        core.PublishRuleTranscripts,
+			SeriesID:    episode.SeriesID,
+			EpisodeID:   episode.ID,
+			Description: fmt.Sprintf("episode %d has no transcript", episode.Seq),
+		}}
+	}
+	return nil
+}
+
+// publishReadinessError returns a *core.PublishReadinessError listing
+// violations, or nil when there are none.
+func publishReadinessError(violations []core.PublishViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	return &core.PublishReadinessError{Violations: violations}
+}
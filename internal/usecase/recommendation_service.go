@@ -0,0 +1,272 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	defaultRecommendationLimit  = 10
+	maxRecommendationLimit      = 50
+	maxRecommendationCandidates = 1000
+	recommendationPageSize      = 100
+	recommendationPopularWindow = 30 * 24 * time.Hour
+)
+
+// levelRanks orders the level vocabularies used by series so adjacent levels
+// can be recognised. Unknown levels only ever match exactly.
+var levelRanks = map[string]int{
+	"a1": 1, "beginner": 1,
+	"a2": 2, "elementary": 2,
+	"b1": 3, "intermediate": 3,
+	"b2": 4, "upper-intermediate": 4,
+	"c1": 5, "advanced": 5,
+	"c2": 6, "proficient": 6,
+}
+
+// WeightedRecommendationScorer is the default scorer: a weighted sum of level
+// fit, overlap with tags from watched series, and recent popularity.
+type WeightedRecommendationScorer struct {
+	LevelWeight      float64
+	TagWeight        float64
+	PopularityWeight float64
+}
+
+// NewWeightedRecommendationScorer returns the scorer with default weights.
+func NewWeightedRecommendationScorer() *WeightedRecommendationScorer {
+	return &WeightedRecommendationScorer{
+		LevelWeight:      3,
+		TagWeight:        2,
+		PopularityWeight: 0.5,
+	}
+}
+
+var _ core.RecommendationScorer = (*WeightedRecommendationScorer)(nil)
+
+// Score implements core.RecommendationScorer.
+func (w *WeightedRecommendationScorer) Score(profile core.LearnerProfile, candidate core.RecommendationCandidate) (float64, []string) {
+	var (
+		score   float64
+		reasons []string
+	)
+
+	switch distance, ok := levelDistance(profile.Level, candidate.Series.Level); {
+	case ok && distance == 0:
+		score += w.LevelWeight
+		reasons = append(reasons, "matches your level")
+	case ok && distance == 1:
+		score += w.LevelWeight / 3
+		reasons = append(reasons, "close to your level")
+	}
+
+	watched := len(profile.WatchedSeriesIDs)
+	if watched > 0 {
+		var (
+			affinity float64
+			shared   []string
+		)
+		for _, tag := range lo.Uniq(lo.Map(candidate.Series.Tags, func(tag string, _ int) string {
+			return strings.ToLower(tag)
+		})) {
+			if count := profile.TagAffinity[tag]; count > 0 {
+				affinity += float64(count) / float64(watched)
+				shared = append(shared, tag)
+			}
+		}
+		if len(shared) > 0 {
+			score += w.TagWeight * affinity
+			reasons = append(reasons, "shares tags with series you watched: "+strings.Join(shared, ", "))
+		}
+	}
+
+	if candidate.Plays > 0 {
+		score += w.PopularityWeight * math.Log1p(float64(candidate.Plays))
+		reasons = append(reasons, "popular with learners")
+	}
+
+	return score, reasons
+}
+
+// RecommendationService ranks published series for a learner.
+type RecommendationService struct {
+	series  core.SeriesRepository
+	signals core.RecommendationSignalRepository
+	scorer  core.RecommendationScorer
+	now     func() time.Time
+}
+
+// NewRecommendationService constructs a recommendation service using the
+// weighted scorer; see WithScorer to swap strategies.
+func NewRecommendationService(series core.SeriesRepository, signals core.RecommendationSignalRepository) *RecommendationService {
+	return &RecommendationService{
+		series:  series,
+		signals: signals,
+		scorer:  NewWeightedRecommendationScorer(),
+		now:     time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *RecommendationService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+// WithScorer replaces the strategy used to rank candidates.
+func (s *RecommendationService) WithScorer(scorer core.RecommendationScorer) {
+	if scorer != nil {
+		s.scorer = scorer
+	}
+}
+
+var _ core.RecommendationService = (*RecommendationService)(nil)
+
+// GetRecommendations ranks published series the learner has not started yet.
+// When no level is supplied it is inferred from the learner's watched series.
+func (s *RecommendationService) GetRecommendations(ctx context.Context, req core.RecommendationRequest) ([]core.Recommendation, error) {
+	userID := strings.TrimSpace(req.UserID)
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", core.ErrValidation)
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultRecommendationLimit
+	}
+	limit = min(limit, maxRecommendationLimit)
+
+	profile, err := s.learnerProfile(ctx, userID, req.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	plays, err := s.signals.CountSeriesPlays(ctx, s.now().Add(-recommendationPopularWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := s.publishedSeries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recommendations := make([]core.Recommendation, 0, len(candidates))
+	for _, series := range candidates {
+		if _, watched := profile.WatchedSeriesIDs[series.ID]; watched {
+			continue
+		}
+		score, reasons := s.scorer.Score(profile, core.RecommendationCandidate{
+			Series: series,
+			Plays:  plays[series.ID],
+		})
+		recommendations = append(recommendations, core.Recommendation{
+			Series:  series,
+			Score:   score,
+			Reasons: reasons,
+		})
+	}
+
+	slices.SortStableFunc(recommendations, func(a, b core.Recommendation) int {
+		return cmp.Or(
+			cmp.Compare(b.Score, a.Score),
+			cmp.Compare(plays[b.Series.ID], plays[a.Series.ID]),
+			strings.Compare(a.Series.Title, b.Series.Title),
+		)
+	})
+	return lo.Slice(recommendations, 0, limit), nil
+}
+
+func (s *RecommendationService) learnerProfile(ctx context.Context, userID, level string) (core.LearnerProfile, error) {
+	profile := core.LearnerProfile{
+		UserID:           userID,
+		Level:            strings.TrimSpace(level),
+		TagAffinity:      make(map[string]int),
+		WatchedSeriesIDs: make(map[uuid.UUID]struct{}),
+	}
+
+	watchedIDs, err := s.signals.ListWatchedSeriesIDs(ctx, userID)
+	if err != nil {
+		return profile, err
+	}
+
+	levels := make(map[string]int)
+	for _, id := range watchedIDs {
+		series, err := s.series.GetSeries(ctx, id, core.SeriesQueryOptions{})
+		if err != nil {
+			if isNotFound(err) {
+				continue
+			}
+			return profile, err
+		}
+		profile.WatchedSeriesIDs[id] = struct{}{}
+		for _, tag := range lo.Uniq(lo.Map(series.Tags, func(tag string, _ int) string {
+			return strings.ToLower(tag)
+		})) {
+			profile.TagAffinity[tag]++
+		}
+		if series.Level != "" {
+			levels[strings.ToLower(series.Level)]++
+		}
+	}
+
+	if profile.Level == "" && len(levels) > 0 {
+		// Most watched level wins; ties resolve alphabetically for stable results.
+		entries := lo.Entries(levels)
+		slices.SortFunc(entries, func(a, b lo.Entry[string, int]) int {
+			return cmp.Or(cmp.Compare(b.Value, a.Value), strings.Compare(a.Key, b.Key))
+		})
+		profile.Level = entries[0].Key
+	}
+	return profile, nil
+}
+
+func (s *RecommendationService) publishedSeries(ctx context.Context) ([]core.Series, error) {
+	var (
+		all   []core.Series
+		token string
+	)
+	for len(all) < maxRecommendationCandidates {
+		page, next, err := s.series.ListSeries(ctx, core.SeriesListFilter{
+			PageSize:  recommendationPageSize,
+			PageToken: token,
+			Statuses:  []core.SeriesStatus{core.SeriesStatusPublished},
+		})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page...)
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	return lo.Slice(all, 0, maxRecommendationCandidates), nil
+}
+
+// levelDistance returns how many steps apart two levels are. Levels outside
+// the known ladders only compare equal to themselves.
+func levelDistance(a, b string) (int, bool) {
+	a, b = strings.ToLower(strings.TrimSpace(a)), strings.ToLower(strings.TrimSpace(b))
+	if a == "" || b == "" {
+		return 0, false
+	}
+	if a == b {
+		return 0, true
+	}
+	rankA, okA := levelRanks[a]
+	rankB, okB := levelRanks[b]
+	if !okA || !okB {
+		return 0, false
+	}
+	return max(rankA-rankB, rankB-rankA), true
+}
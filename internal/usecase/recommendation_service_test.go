@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestRecommendationService_GetRecommendations(t *testing.T) {
+	watched := core.Series{ID: uuid.New(), Title: "Watched", Level: "B1", Tags: []string{"Travel", "food"}, Status: core.SeriesStatusPublished}
+	levelAndTags := core.Series{ID: uuid.New(), Title: "Street Food", Level: "b1", Tags: []string{"food"}, Status: core.SeriesStatusPublished}
+	adjacent := core.Series{ID: uuid.New(), Title: "Airports", Level: "B2", Tags: []string{"travel"}, Status: core.SeriesStatusPublished}
+	popular := core.Series{ID: uuid.New(), Title: "Business", Level: "C1", Status: core.SeriesStatusPublished}
+	catalog := []core.Series{watched, levelAndTags, adjacent, popular}
+
+	seriesRepo := &stubSeriesRepo{
+		listSeriesFn: func(ctx context.Context, filter core.SeriesListFilter) ([]core.Series, string, error) {
+			if filter.PageToken == "" {
+				return catalog[:2], "2", nil
+			}
+			return catalog[2:], "", nil
+		},
+		getSeriesFn: func(ctx context.Context, id uuid.UUID, opts core.SeriesQueryOptions) (*core.Series, error) {
+			series, ok := lo.Find(catalog, func(series core.Series) bool { return series.ID == id })
+			if !ok {
+				return nil, core.ErrNotFound
+			}
+			return &series, nil
+		},
+	}
+	signals := &stubRecommendationSignals{
+		watched: []uuid.UUID{watched.ID, uuid.New()},
+		plays:   map[uuid.UUID]int{popular.ID: 40, adjacent.ID: 2},
+	}
+	service := NewRecommendationService(seriesRepo, signals)
+	fixedNow := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	service.WithClock(func() time.Time { return fixedNow })
+
+	recommendations, err := service.GetRecommendations(context.Background(), core.RecommendationRequest{UserID: "learner"})
+	if err != nil {
+		t.Fatalf("GetRecommendations() error = %v", err)
+	}
+	titles := lo.Map(recommendations, func(r core.Recommendation, _ int) string { return r.Series.Title })
+	if want := []string{"Street Food", "Airports", "Business"}; !slices.Equal(titles, want) {
+		t.Fatalf("titles = %v, want %v", titles, want)
+	}
+	if len(recommendations[0].Reasons) != 2 {
+		t.Fatalf("expected level and tag reasons, got %v", recommendations[0].Reasons)
+	}
+	if !signals.since.Equal(fixedNow.Add(-recommendationPopularWindow)) {
+		t.Fatalf("popularity window started at %v", signals.since)
+	}
+
+	service.WithScorer(popularityOnlyScorer{})
+	recommendations, err = service.GetRecommendations(context.Background(), core.RecommendationRequest{UserID: "learner", Limit: 1})
+	if err != nil {
+		t.Fatalf("GetRecommendations() error = %v", err)
+	}
+	if len(recommendations) != 1 || recommendations[0].Series.ID != popular.ID {
+		t.Fatalf("expected swapped scorer to rank by popularity, got %#v", recommendations)
+	}
+
+	if _, err := service.GetRecommendations(context.Background(), core.RecommendationRequest{}); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+}
+
+func TestLevelDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		distance int
+		ok       bool
+	}{
+		{a: "B1", b: "intermediate", distance: 0, ok: true},
+		{a: "a1", b: "c2", distance: 5, ok: true},
+		{a: "Kids", b: "kids", distance: 0, ok: true},
+		{a: "kids", b: "a1", ok: false},
+		{a: "", b: "a1", ok: false},
+	}
+	for _, tt := range tests {
+		distance, ok := levelDistance(tt.a, tt.b)
+		if distance != tt.distance || ok != tt.ok {
+			t.Fatalf("levelDistance(%q, %q) = %d, %v; want %d, %v", tt.a, tt.b, distance, ok, tt.distance, tt.ok)
+		}
+	}
+}
+
+type popularityOnlyScorer struct{}
+
+func (popularityOnlyScorer) Score(profile core.LearnerProfile, candidate core.RecommendationCandidate) (float64, []string) {
+	return float64(candidate.Plays), nil
+}
+
+type stubRecommendationSignals struct {
+	watched []uuid.UUID
+	plays   map[uuid.UUID]int
+	since   time.Time
+}
+
+func (s *stubRecommendationSignals) ListWatchedSeriesIDs(ctx context.Context, userID string) ([]uuid.UUID, error) {
+	return s.watched, nil
+}
+
+func (s *stubRecommendationSignals) CountSeriesPlays(ctx context.Context, since time.Time) (map[uuid.UUID]int, error) {
+	s.since = since
+	return s.plays, nil
+}
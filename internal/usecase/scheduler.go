@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// ScheduledTaskDefinition describes a periodic task run by the scheduler.
+type ScheduledTaskDefinition struct {
+	Name     string
+	Interval time.Duration
+	// Jitter is the largest random delay added to every run, spreading the
+	// load of tasks sharing an interval; zero runs exactly on the interval.
+	Jitter time.Duration
+	Run    func(ctx context.Context) error
+}
+
+// defaultScheduledTaskLease bounds how long a worker may run a task before
+// another worker may take it over.
+const defaultScheduledTaskLease = 30 * time.Minute
+
+// Scheduler runs registered periodic tasks. Run state is kept in the
+// repository, so when several workers run a scheduler every due task still
+// runs only once.
+type Scheduler struct {
+	repo    core.ScheduledTaskRepository
+	name    string
+	lease   time.Duration
+	now     func() time.Time
+	jitter  func(max time.Duration) time.Duration
+	tasks   []ScheduledTaskDefinition
+	ensured bool
+}
+
+// NewScheduler constructs a scheduler storing task state in repo.
+func NewScheduler(repo core.ScheduledTaskRepository) *Scheduler {
+	return &Scheduler{
+		repo:  repo,
+		name:  uuid.NewString(),
+		lease: defaultScheduledTaskLease,
+		now:   time.Now,
+		jitter: func(max time.Duration) time.Duration {
+			return rand.N(max + 1)
+		},
+	}
+}
+
+// WithClock allows tests to override the clock used by the scheduler.
+func (s *Scheduler) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+// WithName sets the name recorded on the tasks the scheduler leases.
+func (s *Scheduler) WithName(name string) {
+	if name != "" {
+		s.name = name
+	}
+}
+
+// WithLease sets how long a task may run before another worker takes it
+// over. Tasks are cancelled when the lease runs out.
+func (s *Scheduler) WithLease(lease time.Duration) {
+	if lease > 0 {
+		s.lease = lease
+	}
+}
+
+// Register adds a task. Tasks with a non-positive interval are disabled.
+func (s *Scheduler) Register(task ScheduledTaskDefinition) {
+	if task.Interval <= 0 {
+		return
+	}
+	s.tasks = append(s.tasks, task)
+	s.ensured = false
+}
+
+var _ core.SchedulerService = (*Scheduler)(nil)
+
+// ListScheduledTasks returns the registered tasks with the state of their
+// last run.
+func (s *Scheduler) ListScheduledTasks(ctx context.Context) ([]core.ScheduledTask, error) {
+	return s.repo.ListScheduledTasks(ctx)
+}
+
+// RunDueTasks runs every due task this scheduler manages to lease and
+// reports how many ran.
+func (s *Scheduler) RunDueTasks(ctx context.Context) (int, error) {
+	if err := s.ensureTasks(ctx); err != nil {
+		return 0, err
+	}
+
+	now := s.now().UTC()
+	var claimed []ScheduledTaskDefinition
+	for _, task := range s.tasks {
+		ok, err := s.repo.ClaimScheduledTask(ctx, core.ScheduledTaskClaim{
+			Name:   task.Name,
+			Worker: s.name,
+			Now:    now,
+			Lease:  s.lease,
+		})
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			claimed = append(claimed, task)
+		}
+	}
+
+	errs := make([]error, len(claimed))
+	var wg sync.WaitGroup
+	for i, task := range claimed {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = s.run(ctx, task)
+		}()
+	}
+	wg.Wait()
+	return len(claimed), errors.Join(errs...)
+}
+
+// Run checks for due tasks every interval until ctx is cancelled. Tasks
+// already running finish within their lease.
+func (s *Scheduler) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		_, _ = s.RunDueTasks(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ensureTasks registers the tasks with the repository once. New tasks
+// become due after a random share of their jitter.
+func (s *Scheduler) ensureTasks(ctx context.Context) error {
+	if s.ensured {
+		return nil
+	}
+	now := s.now().UTC()
+	for _, task := range s.tasks {
+		if _, err := s.repo.EnsureScheduledTask(ctx, core.ScheduledTask{
+			Name:      task.Name,
+			Interval:  task.Interval,
+			NextRunAt: now.Add(s.jitterFor(task)),
+		}); err != nil {
+			return err
+		}
+	}
+	s.ensured = true
+	return nil
+}
+
+// run executes a leased task and records the outcome.
+func (s *Scheduler) run(ctx context.Context, task ScheduledTaskDefinition) error {
+	ctx = context.WithoutCancel(ctx)
+	startedAt := s.now().UTC()
+
+	runCtx, span := tracer.Start(ctx, "scheduled task "+task.Name)
+	runCtx, cancel := context.WithTimeout(runCtx, s.lease)
+	err := callScheduledTask(runCtx, task)
+	cancel()
+	endSpan(span, err)
+
+	now := s.now().UTC()
+	state := core.ScheduledTask{
+		Name:         task.Name,
+		Interval:     task.Interval,
+		NextRunAt:    startedAt.Add(task.Interval + s.jitterFor(task)),
+		LastRunAt:    &startedAt,
+		LastStatus:   core.ScheduledTaskStatusSucceeded,
+		LastDuration: now.Sub(startedAt),
+	}
+	if err != nil {
+		state.LastStatus = core.ScheduledTaskStatusFailed
+		state.LastError = err.Error()
+	}
+
+	_, err = s.repo.UpdateScheduledTask(ctx, state)
+	return err
+}
+
+func (s *Scheduler) jitterFor(task ScheduledTaskDefinition) time.Duration {
+	if task.Jitter <= 0 {
+		return 0
+	}
+	return s.jitter(task.Jitter)
+}
+
+// callScheduledTask turns a panicking task into a failed run.
+func callScheduledTask(ctx context.Context, task ScheduledTaskDefinition) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("scheduled task %s panicked: %v", task.Name, r)
+		}
+	}()
+	return task.Run(ctx)
+}
@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// stubScheduledTaskRepo keeps tasks in memory and leases them like the
+// database does.
+type stubScheduledTaskRepo struct {
+	mu    sync.Mutex
+	tasks map[string]core.ScheduledTask
+}
+
+func (s *stubScheduledTaskRepo) EnsureScheduledTask(ctx context.Context, task core.ScheduledTask) (*core.ScheduledTask, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tasks == nil {
+		s.tasks = map[string]core.ScheduledTask{}
+	}
+	if existing, ok := s.tasks[task.Name]; ok {
+		existing.Interval = task.Interval
+		task = existing
+	}
+	s.tasks[task.Name] = task
+	return &task, nil
+}
+
+func (s *stubScheduledTaskRepo) ClaimScheduledTask(ctx context.Context, claim core.ScheduledTaskClaim) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	task, ok := s.tasks[claim.Name]
+	if !ok || task.NextRunAt.After(claim.Now) || (task.LockedUntil != nil && !task.LockedUntil.Before(claim.Now)) {
+		return false, nil
+	}
+	lockedUntil := claim.Now.Add(claim.Lease)
+	task.LockedBy = claim.Worker
+	task.LockedUntil = &lockedUntil
+	s.tasks[claim.Name] = task
+	return true, nil
+}
+
+func (s *stubScheduledTaskRepo) UpdateScheduledTask(ctx context.Context, task core.ScheduledTask) (*core.ScheduledTask, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.tasks[task.Name]; !ok {
+		return nil, core.ErrNotFound
+	}
+	s.tasks[task.Name] = task
+	return &task, nil
+}
+
+func (s *stubScheduledTaskRepo) ListScheduledTasks(ctx context.Context) ([]core.ScheduledTask, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var tasks []core.ScheduledTask
+	for _, task := range s.tasks {
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func TestScheduler_RunDueTasks(t *testing.T) {
+	ctx := context.Background()
+	repo := &stubScheduledTaskRepo{}
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	scheduler := NewScheduler(repo)
+	scheduler.WithClock(func() time.Time { return now })
+
+	var gcRuns, expiryRuns int
+	scheduler.Register(ScheduledTaskDefinition{
+		Name:     "asset_gc",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			gcRuns++
+			return nil
+		},
+	})
+	scheduler.Register(ScheduledTaskDefinition{
+		Name:     "upload_expiry",
+		Interval: 5 * time.Minute,
+		Run: func(ctx context.Context) error {
+			expiryRuns++
+			return errors.New("storage unavailable")
+		},
+	})
+	scheduler.Register(ScheduledTaskDefinition{
+		Name: "disabled",
+		Run: func(ctx context.Context) error {
+			t.Fatal("expected a task without an interval not to run")
+			return nil
+		},
+	})
+
+	n, err := scheduler.RunDueTasks(ctx)
+	if err != nil || n != 2 {
+		t.Fatalf("expected both tasks to run, got %d, %v", n, err)
+	}
+
+	gc := repo.tasks["asset_gc"]
+	if gc.LastStatus != core.ScheduledTaskStatusSucceeded || !gc.NextRunAt.Equal(now.Add(time.Hour)) || gc.LockedUntil != nil {
+		t.Fatalf("unexpected asset_gc state %+v", gc)
+	}
+	expiry := repo.tasks["upload_expiry"]
+	if expiry.LastStatus != core.ScheduledTaskStatusFailed || expiry.LastError != "storage unavailable" || !expiry.NextRunAt.Equal(now.Add(5*time.Minute)) {
+		t.Fatalf("unexpected upload_expiry state %+v", expiry)
+	}
+
+	// Nothing is due until the shorter interval has passed.
+	if n, _ := scheduler.RunDueTasks(ctx); n != 0 {
+		t.Fatalf("expected no task to be due, got %d", n)
+	}
+	now = now.Add(5 * time.Minute)
+	if n, _ := scheduler.RunDueTasks(ctx); n != 1 || gcRuns != 1 || expiryRuns != 2 {
+		t.Fatalf("expected only upload_expiry to run again, got %d runs (gc %d, expiry %d)", n, gcRuns, expiryRuns)
+	}
+}
+
+func TestScheduler_SkipsTaskLeasedElsewhere(t *testing.T) {
+	ctx := context.Background()
+	repo := &stubScheduledTaskRepo{}
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	newScheduler := func(name string) *Scheduler {
+		scheduler := NewScheduler(repo)
+		scheduler.WithClock(func() time.Time { return now })
+		scheduler.WithName(name)
+		scheduler.Register(ScheduledTaskDefinition{
+			Name:     "webhook_retries",
+			Interval: time.Minute,
+			Run:      func(ctx context.Context) error { return nil },
+		})
+		return scheduler
+	}
+	a, b := newScheduler("worker-a"), newScheduler("worker-b")
+	if err := b.ensureTasks(ctx); err != nil {
+		t.Fatalf("ensureTasks() error = %v", err)
+	}
+
+	lockedUntil := now.Add(time.Minute)
+	task := repo.tasks["webhook_retries"]
+	task.LockedBy = "worker-a"
+	task.LockedUntil = &lockedUntil
+	repo.tasks["webhook_retries"] = task
+
+	if n, _ := b.RunDueTasks(ctx); n != 0 {
+		t.Fatalf("expected the leased task to be skipped, got %d", n)
+	}
+	now = now.Add(2 * time.Minute)
+	if n, _ := a.RunDueTasks(ctx); n != 1 {
+		t.Fatalf("expected the expired lease to be taken over, got %d", n)
+	}
+}
@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// SearchEventTypes lists the events that change what the search index holds.
+var SearchEventTypes = []core.EventType{
+	core.EventTypeSeriesCreated,
+	core.EventTypeSeriesUpdated,
+	core.EventTypeSeriesPublished,
+	core.EventTypeEpisodeCreated,
+	core.EventTypeEpisodeUpdated,
+	core.EventTypeEpisodePublished,
+	core.EventTypeEpisodeDeleted,
+}
+
+// maxSearchPageSize caps the hits of one SearchContent page.
+const maxSearchPageSize = 100
+
+// SearchService searches published content and keeps the search index in
+// sync with the catalog. Events only say which series or episode changed:
+// the index is updated from its current state, so events handled late or
+// twice leave it right.
+type SearchService struct {
+	index  core.SearchIndex
+	series core.SeriesRepository
+}
+
+// NewSearchService constructs a search service on the index and the
+// repository content is indexed from.
+func NewSearchService(index core.SearchIndex, series core.SeriesRepository) *SearchService {
+	return &SearchService{
+		index:  index,
+		series: series,
+	}
+}
+
+var _ core.SearchService = (*SearchService)(nil)
+
+// SearchContent returns a page of the published series and episodes
+// matching the query, best match first.
+func (s *SearchService) SearchContent(ctx context.Context, query core.SearchQuery) ([]core.SearchHit, string, error) {
+	query.Query = strings.TrimSpace(query.Query)
+	if query.Query == "" {
+		return nil, "", fmt.Errorf("%w: query required", core.ErrValidation)
+	}
+	if query.PageSize <= 0 {
+		query.PageSize = 20
+	}
+	query.PageSize = min(query.PageSize, maxSearchPageSize)
+	return s.index.Search(ctx, query)
+}
+
+// HandleEvent reindexes the series or episode an event of SearchEventTypes
+// is about.
+func (s *SearchService) HandleEvent(ctx context.Context, event core.Event) error {
+	switch event := event.(type) {
+	case core.SeriesCreated:
+		return s.reindexSeries(ctx, event.Series.ID)
+	case core.SeriesUpdated:
+		return s.reindexSeries(ctx, event.Series.ID)
+	case core.SeriesPublished:
+		return s.reindexSeries(ctx, event.Series.ID)
+	case core.EpisodeCreated:
+		return s.reindexEpisode(ctx, event.Episode.ID)
+	case core.EpisodeUpdated:
+		return s.reindexEpisode(ctx, event.Episode.ID)
+	case core.EpisodePublished:
+		return s.reindexEpisode(ctx, event.Episode.ID)
+	case core.EpisodeDeleted:
+		return s.index.DeleteDocuments(ctx, core.SearchDocumentID(core.SearchKindEpisode, event.Episode.ID))
+	}
+	return nil
+}
+
+// ReindexContent indexes every published series and episode. Documents of
+// content that is no longer published are left to the events.
+func (s *SearchService) ReindexContent(ctx context.Context) (int, error) {
+	indexed := 0
+	token := ""
+	for {
+		page, next, err := s.series.ListSeries(ctx, core.SeriesListFilter{
+			PageSize:        maintenanceBatchSize,
+			PageToken:       token,
+			Statuses:        []core.SeriesStatus{core.SeriesStatusPublished},
+			IncludeEpisodes: true,
+		})
+		if err != nil {
+			return indexed, err
+		}
+
+		var docs []core.SearchDocument
+		for _, series := range page {
+			docs = append(docs, seriesDocument(series))
+			for _, episode := range series.Episodes {
+				if episode.Status == core.EpisodeStatusPublished {
+					docs = append(docs, episodeDocument(series, episode))
+				}
+			}
+		}
+		if len(docs) > 0 {
+			if err := s.index.IndexDocuments(ctx, docs...); err != nil {
+				return indexed, err
+			}
+			indexed += len(docs)
+		}
+
+		if next == "" {
+			return indexed, nil
+		}
+		token = next
+	}
+}
+
+// reindexSeries indexes a published series with its published episodes,
+// which carry its filters, and removes the others.
+func (s *SearchService) reindexSeries(ctx context.Context, id uuid.UUID) error {
+	series, err := s.series.GetSeries(ctx, id, core.SeriesQueryOptions{IncludeEpisodes: true})
+	if isNotFound(err) {
+		return s.index.DeleteDocuments(ctx, core.SearchDocumentID(core.SearchKindSeries, id))
+	}
+	if err != nil {
+		return err
+	}
+
+	var (
+		docs    []core.SearchDocument
+		removed []string
+	)
+	published := series.Status == core.SeriesStatusPublished
+	if published {
+		docs = append(docs, seriesDocument(*series))
+	} else {
+		removed = append(removed, core.SearchDocumentID(core.SearchKindSeries, series.ID))
+	}
+	for _, episode := range series.Episodes {
+		if published && episode.Status == core.EpisodeStatusPublished {
+			docs = append(docs, episodeDocument(*series, episode))
+		} else {
+			removed = append(removed, core.SearchDocumentID(core.SearchKindEpisode, episode.ID))
+		}
+	}
+
+	if len(docs) > 0 {
+		if err := s.index.IndexDocuments(ctx, docs...); err != nil {
+			return err
+		}
+	}
+	if len(removed) > 0 {
+		return s.index.DeleteDocuments(ctx, removed...)
+	}
+	return nil
+}
+
+// reindexEpisode indexes an episode when it and its series are published,
+// and removes it otherwise.
+func (s *SearchService) reindexEpisode(ctx context.Context, id uuid.UUID) error {
+	docID := core.SearchDocumentID(core.SearchKindEpisode, id)
+	episode, err := s.series.GetEpisode(ctx, id)
+	if isNotFound(err) {
+		return s.index.DeleteDocuments(ctx, docID)
+	}
+	if err != nil {
+		return err
+	}
+	if episode.Status != core.EpisodeStatusPublished {
+		return s.index.DeleteDocuments(ctx, docID)
+	}
+
+	series, err := s.series.GetSeries(ctx, episode.SeriesID, core.SeriesQueryOptions{})
+	if isNotFound(err) {
+		return s.index.DeleteDocuments(ctx, docID)
+	}
+	if err != nil {
+		return err
+	}
+	if series.Status != core.SeriesStatusPublished {
+		return s.index.DeleteDocuments(ctx, docID)
+	}
+	return s.index.IndexDocuments(ctx, episodeDocument(*series, *episode))
+}
+
+func seriesDocument(series core.Series) core.SearchDocument {
+	return core.SearchDocument{
+		ID:       core.SearchDocumentID(core.SearchKindSeries, series.ID),
+		Kind:     core.SearchKindSeries,
+		SeriesID: series.ID,
+		Title:    series.Title,
+		Body:     series.Summary,
+		Language: series.Language,
+		Level:    series.Level,
+		Tags:     series.Tags,
+	}
+}
+
+func episodeDocument(series core.Series, episode core.Episode) core.SearchDocument {
+	return core.SearchDocument{
+		ID:        core.SearchDocumentID(core.SearchKindEpisode, episode.ID),
+		Kind:      core.SearchKindEpisode,
+		SeriesID:  series.ID,
+		EpisodeID: episode.ID,
+		Title:     episode.Title,
+		Body:      strings.TrimSpace(episode.Description + "\n" + episode.Transcript.Content),
+		Language:  series.Language,
+		Level:     series.Level,
+		Tags:      series.Tags,
+	}
+}
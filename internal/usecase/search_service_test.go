@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubSearchIndex struct {
+	indexed map[string]core.SearchDocument
+	query   core.SearchQuery
+}
+
+func newStubSearchIndex() *stubSearchIndex {
+	return &stubSearchIndex{indexed: map[string]core.SearchDocument{}}
+}
+
+func (s *stubSearchIndex) IndexDocuments(ctx context.Context, docs ...core.SearchDocument) error {
+	for _, doc := range docs {
+		s.indexed[doc.ID] = doc
+	}
+	return nil
+}
+
+func (s *stubSearchIndex) DeleteDocuments(ctx context.Context, ids ...string) error {
+	for _, id := range ids {
+		delete(s.indexed, id)
+	}
+	return nil
+}
+
+func (s *stubSearchIndex) Search(ctx context.Context, query core.SearchQuery) ([]core.SearchHit, string, error) {
+	s.query = query
+	return nil, "", nil
+}
+
+func TestSearchService_SearchContentValidatesQuery(t *testing.T) {
+	t.Parallel()
+
+	index := newStubSearchIndex()
+	svc := NewSearchService(index, &stubSeriesRepo{})
+
+	if _, _, err := svc.SearchContent(context.Background(), core.SearchQuery{Query: "  "}); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected ErrValidation, got %v", err)
+	}
+	if _, _, err := svc.SearchContent(context.Background(), core.SearchQuery{Query: " hotel ", PageSize: 1000}); err != nil {
+		t.Fatalf("SearchContent() error = %v", err)
+	}
+	if index.query.Query != "hotel" || index.query.PageSize != maxSearchPageSize {
+		t.Fatalf("unexpected query passed to the index: %+v", index.query)
+	}
+}
+
+func TestSearchService_HandleEventSyncsIndex(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	series := core.Series{ID: uuid.New(), Title: "Travel English", Language: "en", Level: "A2", Tags: []string{"travel"}, Status: core.SeriesStatusPublished}
+	published := core.Episode{
+		ID: uuid.New(), SeriesID: series.ID, Title: "Checking in", Description: "At the desk.",
+		Status: core.EpisodeStatusPublished, Transcript: core.Transcript{Content: "I booked a room."},
+	}
+	draft := core.Episode{ID: uuid.New(), SeriesID: series.ID, Title: "Breakfast", Status: core.EpisodeStatusDraft}
+	repo := &stubSeriesRepo{
+		getSeriesFn: func(ctx context.Context, id uuid.UUID, opts core.SeriesQueryOptions) (*core.Series, error) {
+			if id != series.ID {
+				return nil, core.ErrNotFound
+			}
+			result := series
+			if opts.IncludeEpisodes {
+				result.Episodes = []core.Episode{published, draft}
+			}
+			return &result, nil
+		},
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			for _, episode := range []core.Episode{published, draft} {
+				if episode.ID == id {
+					return &episode, nil
+				}
+			}
+			return nil, core.ErrNotFound
+		},
+	}
+	index := newStubSearchIndex()
+	svc := NewSearchService(index, repo)
+
+	seriesDocID := core.SearchDocumentID(core.SearchKindSeries, series.ID)
+	publishedDocID := core.SearchDocumentID(core.SearchKindEpisode, published.ID)
+	if err := svc.HandleEvent(ctx, core.SeriesPublished{Series: series}); err != nil {
+		t.Fatalf("HandleEvent(SeriesPublished) error = %v", err)
+	}
+	ids := make([]string, 0, len(index.indexed))
+	for id := range index.indexed {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	if want := []string{publishedDocID, seriesDocID}; !slices.Equal(ids, want) {
+		t.Fatalf("expected documents %v, got %v", want, ids)
+	}
+	doc := index.indexed[publishedDocID]
+	if doc.Body != "At the desk.\nI booked a room." || doc.Level != "A2" || !slices.Equal(doc.Tags, series.Tags) {
+		t.Fatalf("unexpected episode document: %+v", doc)
+	}
+
+	// A draft episode is never indexed, even when its event arrives.
+	if err := svc.HandleEvent(ctx, core.EpisodeUpdated{Episode: draft}); err != nil {
+		t.Fatalf("HandleEvent(EpisodeUpdated) error = %v", err)
+	}
+	if _, ok := index.indexed[core.SearchDocumentID(core.SearchKindEpisode, draft.ID)]; ok {
+		t.Fatal("expected draft episode to stay out of the index")
+	}
+
+	if err := svc.HandleEvent(ctx, core.EpisodeDeleted{Episode: published}); err != nil {
+		t.Fatalf("HandleEvent(EpisodeDeleted) error = %v", err)
+	}
+	if _, ok := index.indexed[publishedDocID]; ok {
+		t.Fatal("expected deleted episode to leave the index")
+	}
+
+	// Unpublishing the series takes it out of the index.
+	series.Status = core.SeriesStatusDraft
+	if err := svc.HandleEvent(ctx, core.SeriesUpdated{Series: series}); err != nil {
+		t.Fatalf("HandleEvent(SeriesUpdated) error = %v", err)
+	}
+	if len(index.indexed) != 0 {
+		t.Fatalf("expected empty index, got %v", index.indexed)
+	}
+}
@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// seedAssetProvider is recorded as the provider of seeded assets.
+const seedAssetProvider = "seed"
+
+// SeedService loads fixtures through the catalog and subscription services,
+// so seeded content passes the same validation and emits the same events as
+// content created through the API.
+type SeedService struct {
+	assets        core.AssetRepository
+	series        core.SeriesService
+	subscriptions core.SubscriptionService
+	now           func() time.Time
+}
+
+// NewSeedService constructs a SeedService.
+func NewSeedService(assets core.AssetRepository, series core.SeriesService, subscriptions core.SubscriptionService) *SeedService {
+	return &SeedService{
+		assets:        assets,
+		series:        series,
+		subscriptions: subscriptions,
+		now:           time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *SeedService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.SeedService = (*SeedService)(nil)
+
+// Seed loads assets first, then plans, series and subscriptions, so later
+// fixtures can refer to earlier ones.
+func (s *SeedService) Seed(ctx context.Context, fixtures core.Fixtures) (*core.SeedResult, error) {
+	result := &core.SeedResult{}
+
+	assets := make(map[string]core.Asset, len(fixtures.Assets))
+	for _, fixture := range fixtures.Assets {
+		asset, created, err := s.seedAsset(ctx, fixture)
+		if err != nil {
+			return result, fmt.Errorf("seed asset %q: %w", fixture.Key, err)
+		}
+		assets[asset.AssetKey] = *asset
+		result.Assets += lo.Ternary(created, 1, 0)
+		result.Skipped += lo.Ternary(created, 0, 1)
+	}
+
+	existing, err := s.subscriptions.ListPlans(ctx, true)
+	if err != nil {
+		return result, err
+	}
+	plans := lo.SliceToMap(existing, func(plan core.Plan) (string, uuid.UUID) { return plan.Code, plan.ID })
+	for _, draft := range fixtures.Plans {
+		code := strings.ToLower(strings.TrimSpace(draft.Code))
+		if _, ok := plans[code]; ok {
+			result.Skipped++
+			continue
+		}
+		plan, err := s.subscriptions.CreatePlan(ctx, draft)
+		if err != nil {
+			return result, fmt.Errorf("seed plan %q: %w", draft.Code, err)
+		}
+		plans[plan.Code] = plan.ID
+		result.Plans++
+	}
+
+	for _, fixture := range fixtures.Series {
+		draft, err := seriesDraftFromFixture(fixture, assets)
+		if err != nil {
+			return result, fmt.Errorf("seed series %q: %w", fixture.Series.Slug, err)
+		}
+		if _, err := s.series.CreateSeries(ctx, draft); errors.Is(err, core.ErrAlreadyExists) {
+			result.Skipped++
+			continue
+		} else if err != nil {
+			return result, fmt.Errorf("seed series %q: %w", fixture.Series.Slug, err)
+		}
+		result.Series++
+	}
+
+	for _, user := range fixtures.Users {
+		if user.PlanCode == "" {
+			continue
+		}
+		planID, ok := plans[strings.ToLower(strings.TrimSpace(user.PlanCode))]
+		if !ok {
+			return result, fmt.Errorf("%w: user %q subscribes to unknown plan %q", core.ErrValidation, user.ID, user.PlanCode)
+		}
+		if _, err := s.subscriptions.ActiveSubscription(ctx, user.ID); err == nil {
+			result.Skipped++
+			continue
+		} else if !isNotFound(err) {
+			return result, err
+		}
+		if _, err := s.subscriptions.Subscribe(ctx, user.ID, planID); err != nil {
+			return result, fmt.Errorf("seed user %q: %w", user.ID, err)
+		}
+		result.Subscriptions++
+	}
+	return result, nil
+}
+
+// seedAsset returns the asset with the fixture's key, creating it as ready
+// when missing.
+func (s *SeedService) seedAsset(ctx context.Context, fixture core.AssetFixture) (*core.Asset, bool, error) {
+	if strings.TrimSpace(fixture.Key) == "" {
+		return nil, false, fmt.Errorf("%w: asset key is required", core.ErrValidation)
+	}
+	if fixture.Type == core.AssetTypeUnspecified {
+		return nil, false, fmt.Errorf("%w: asset type is required", core.ErrValidation)
+	}
+	if existing, err := s.assets.GetAssetByKey(ctx, fixture.Key); err == nil {
+		return existing, false, nil
+	} else if !isNotFound(err) {
+		return nil, false, err
+	}
+
+	now := s.now().UTC()
+	asset := core.Asset{
+		ID:               uuid.New(),
+		AssetKey:         fixture.Key,
+		Type:             fixture.Type,
+		Status:           core.AssetStatusReady,
+		OriginalFilename: fixture.Filename,
+		MimeType:         fixture.MimeType,
+		Filesize:         fixture.Filesize,
+		Duration:         fixture.Duration,
+		PlaybackURL:      fixture.PlaybackURL,
+		Provider:         seedAssetProvider,
+		ReadyAt:          ptrTime(now),
+	}
+	created, err := s.assets.CreateAsset(ctx, asset)
+	if err != nil {
+		return nil, false, err
+	}
+	return created, true, nil
+}
+
+func seriesDraftFromFixture(fixture core.SeriesFixture, assets map[string]core.Asset) (core.SeriesDraft, error) {
+	draft := fixture.Series
+	draft.Episodes = make([]core.EpisodeDraft, 0, len(fixture.Episodes))
+	for i, episode := range fixture.Episodes {
+		ed := episode.Episode
+		if ed.Seq == 0 {
+			ed.Seq = uint32(i + 1)
+		}
+		if episode.AssetKey != "" {
+			asset, ok := assets[episode.AssetKey]
+			if !ok {
+				return draft, fmt.Errorf("%w: episode %q plays unknown asset %q", core.ErrValidation, ed.Title, episode.AssetKey)
+			}
+			ed.Resource = &core.MediaResource{
+				AssetID:     asset.ID,
+				Type:        lo.Ternary(asset.Type == core.AssetTypeAudio, core.MediaTypeAudio, core.MediaTypeVideo),
+				PlaybackURL: asset.PlaybackURL,
+				MimeType:    asset.MimeType,
+			}
+			if ed.Duration == 0 {
+				ed.Duration = asset.Duration
+			}
+		}
+		draft.Episodes = append(draft.Episodes, ed)
+	}
+	return draft, nil
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestSeedService_SeedIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	assets := map[string]core.Asset{}
+	assetRepo := &stubAssetRepo{
+		getAssetByKeyFn: func(ctx context.Context, key string) (*core.Asset, error) {
+			if asset, ok := assets[key]; ok {
+				return &asset, nil
+			}
+			return nil, core.ErrNotFound
+		},
+		createAssetFn: func(ctx context.Context, asset core.Asset) error {
+			assets[asset.AssetKey] = asset
+			return nil
+		},
+	}
+	slugs := map[string]core.Series{}
+	seriesRepo := &stubSeriesRepo{createSeriesFn: func(ctx context.Context, series core.Series) (*core.Series, error) {
+		if _, ok := slugs[series.Slug]; ok {
+			return nil, fmt.Errorf("%w: slug taken", core.ErrAlreadyExists)
+		}
+		slugs[series.Slug] = series
+		return &series, nil
+	}}
+	subscriptionRepo := newStubSubscriptionRepo()
+	subscriptions := NewSubscriptionService(subscriptionRepo)
+	subscriptions.WithClock(func() time.Time { return now })
+	svc := NewSeedService(assetRepo, NewSeriesService(seriesRepo), subscriptions)
+	svc.WithClock(func() time.Time { return now })
+
+	fixtures := core.Fixtures{
+		Assets: []core.AssetFixture{{Key: "coffee", Type: core.AssetTypeAudio, MimeType: "audio/mpeg", Duration: 3 * time.Minute, PlaybackURL: "https://cdn.local/coffee.mp3"}},
+		Plans:  []core.PlanDraft{{Code: "premium", Name: "Premium", PriceCents: 999, Currency: "usd", Interval: core.BillingIntervalMonth, Active: true}},
+		Series: []core.SeriesFixture{{
+			Series:   core.SeriesDraft{Slug: "coffee-english", Title: "Coffee English", Status: core.SeriesStatusPublished},
+			Episodes: []core.EpisodeFixture{{Episode: core.EpisodeDraft{Title: "Ordering", Status: core.EpisodeStatusPublished}, AssetKey: "coffee"}},
+		}},
+		Users: []core.UserFixture{{ID: "learner-1", PlanCode: "premium"}, {ID: "learner-2"}},
+	}
+
+	result, err := svc.Seed(ctx, fixtures)
+	if err != nil {
+		t.Fatalf("Seed() error = %v", err)
+	}
+	if *result != (core.SeedResult{Assets: 1, Plans: 1, Series: 1, Subscriptions: 1}) {
+		t.Fatalf("unexpected first result %+v", *result)
+	}
+	asset := assets["coffee"]
+	if asset.Status != core.AssetStatusReady || asset.ReadyAt == nil {
+		t.Fatalf("expected a ready asset, got %+v", asset)
+	}
+	episode := slugs["coffee-english"].Episodes[0]
+	if episode.Seq != 1 || episode.Resource.AssetID != asset.ID || episode.Resource.Type != core.MediaTypeAudio || episode.Duration != 3*time.Minute {
+		t.Fatalf("expected the episode to play the seeded asset, got %+v", episode)
+	}
+	if _, err := subscriptions.ActiveSubscription(ctx, "learner-1"); err != nil {
+		t.Fatalf("expected learner-1 to be subscribed, got %v", err)
+	}
+
+	result, err = svc.Seed(ctx, fixtures)
+	if err != nil {
+		t.Fatalf("second Seed() error = %v", err)
+	}
+	if *result != (core.SeedResult{Skipped: 4}) {
+		t.Fatalf("expected everything to be skipped, got %+v", *result)
+	}
+}
+
+func TestSeedService_RejectsUnknownReferences(t *testing.T) {
+	svc := NewSeedService(&stubAssetRepo{}, NewSeriesService(&stubSeriesRepo{}), NewSubscriptionService(newStubSubscriptionRepo()))
+
+	for name, fixtures := range map[string]core.Fixtures{
+		"asset": {Series: []core.SeriesFixture{{
+			Series:   core.SeriesDraft{Slug: "s", Title: "S"},
+			Episodes: []core.EpisodeFixture{{Episode: core.EpisodeDraft{Title: "E"}, AssetKey: "missing"}},
+		}}},
+		"plan": {Users: []core.UserFixture{{ID: "learner", PlanCode: "missing"}}},
+	} {
+		if _, err := svc.Seed(context.Background(), fixtures); !errors.Is(err, core.ErrValidation) {
+			t.Fatalf("expected ErrValidation for an unknown %s, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// maxEmbeddingRunes cuts long transcripts to what embedding models
+	// accept; the opening of a lesson says what it is about.
+	maxEmbeddingRunes = 8000
+	// embeddingBatchSize bounds the texts sent to the model at once.
+	embeddingBatchSize = 32
+	// embeddingSnippetRunes is the length of the snippets of semantic hits.
+	embeddingSnippetRunes = 160
+	// maxSimilarEpisodes caps the episodes FindSimilarEpisodes returns.
+	maxSimilarEpisodes = 50
+)
+
+// EmbeddingIndex is a core.SearchIndex matching documents by the similarity
+// of their embeddings to the embedding of the query.
+type EmbeddingIndex struct {
+	provider core.EmbeddingProvider
+	repo     core.EmbeddingRepository
+	now      func() time.Time
+}
+
+// NewEmbeddingIndex constructs an index embedding documents with provider.
+func NewEmbeddingIndex(provider core.EmbeddingProvider, repo core.EmbeddingRepository) *EmbeddingIndex {
+	return &EmbeddingIndex{
+		provider: provider,
+		repo:     repo,
+		now:      time.Now,
+	}
+}
+
+var _ core.SearchIndex = (*EmbeddingIndex)(nil)
+
+// IndexDocuments embeds the documents whose text changed since they were
+// last embedded and refreshes the filters of the others.
+func (i *EmbeddingIndex) IndexDocuments(ctx context.Context, docs ...core.SearchDocument) error {
+	model := i.provider.Model()
+
+	var (
+		embeddings []core.ContentEmbedding
+		stale      []int
+		texts      []string
+	)
+	for _, doc := range docs {
+		text := embeddingText(doc)
+		embedding := core.ContentEmbedding{
+			DocumentID:  doc.ID,
+			Kind:        doc.Kind,
+			SeriesID:    doc.SeriesID,
+			EpisodeID:   doc.EpisodeID,
+			Title:       doc.Title,
+			Snippet:     truncateRunes(strings.Join(strings.Fields(doc.Body), " "), embeddingSnippetRunes),
+			Language:    doc.Language,
+			Level:       doc.Level,
+			Tags:        doc.Tags,
+			Model:       model,
+			ContentHash: contentHash(text),
+		}
+		existing, err := i.repo.GetEmbedding(ctx, doc.ID)
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+		if existing != nil && existing.Model == model && existing.ContentHash == embedding.ContentHash {
+			embedding.Vector = existing.Vector
+		} else {
+			stale = append(stale, len(embeddings))
+			texts = append(texts, text)
+		}
+		embeddings = append(embeddings, embedding)
+	}
+
+	for _, batch := range lo.Chunk(lo.Range(len(texts)), embeddingBatchSize) {
+		vectors, err := i.provider.EmbedTexts(ctx, lo.Map(batch, func(n int, _ int) string { return texts[n] }))
+		if err != nil {
+			return err
+		}
+		if len(vectors) != len(batch) {
+			return fmt.Errorf("embedding provider returned %d vectors for %d texts", len(vectors), len(batch))
+		}
+		for j, n := range batch {
+			embeddings[stale[n]].Vector = vectors[j]
+		}
+	}
+	return i.repo.SaveEmbeddings(ctx, embeddings...)
+}
+
+// DeleteDocuments removes the embeddings of the documents.
+func (i *EmbeddingIndex) DeleteDocuments(ctx context.Context, ids ...string) error {
+	return i.repo.DeleteEmbeddings(ctx, ids...)
+}
+
+// Search returns the documents closest in meaning to the query text.
+func (i *EmbeddingIndex) Search(ctx context.Context, query core.SearchQuery) ([]core.SearchHit, string, error) {
+	offset := 0
+	if query.PageToken != "" {
+		n, err := strconv.Atoi(query.PageToken)
+		if err != nil || n < 0 {
+			return nil, "", core.ErrInvalidPageToken
+		}
+		offset = n
+	}
+
+	vectors, err := i.provider.EmbedTexts(ctx, []string{query.Query})
+	if err != nil {
+		return nil, "", err
+	}
+	if len(vectors) != 1 {
+		return nil, "", fmt.Errorf("embedding provider returned %d vectors for 1 text", len(vectors))
+	}
+	hits, err := i.repo.NearestEmbeddings(ctx, core.SimilarityQuery{
+		Vector:   vectors[0],
+		Model:    i.provider.Model(),
+		Kinds:    query.Kinds,
+		Language: query.Language,
+		Level:    query.Level,
+		Tags:     query.Tags,
+		Limit:    offset + query.PageSize + 1,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if offset >= len(hits) {
+		return []core.SearchHit{}, "", nil
+	}
+	hits = hits[offset:]
+	nextToken := ""
+	if len(hits) > query.PageSize {
+		hits = hits[:query.PageSize]
+		nextToken = strconv.Itoa(offset + query.PageSize)
+	}
+	return hits, nextToken, nil
+}
+
+// SemanticSearchService searches published content by meaning. It keeps the
+// embeddings in sync the way SearchService keeps an external search engine
+// in sync, with an EmbeddingIndex as the engine.
+type SemanticSearchService struct {
+	index *EmbeddingIndex
+	repo  core.EmbeddingRepository
+	sync  *SearchService
+}
+
+// NewSemanticSearchService constructs a semantic search service. provider
+// may be nil when no embedding model is configured, in which case semantic
+// search is unavailable and events are ignored.
+func NewSemanticSearchService(provider core.EmbeddingProvider, repo core.EmbeddingRepository, series core.SeriesRepository) *SemanticSearchService {
+	s := &SemanticSearchService{repo: repo}
+	if provider != nil {
+		s.index = NewEmbeddingIndex(provider, repo)
+		s.sync = NewSearchService(s.index, series)
+	}
+	return s
+}
+
+var _ core.SemanticSearchService = (*SemanticSearchService)(nil)
+
+// SemanticSearch returns a page of the published series and episodes
+// closest in meaning to the query, best match first.
+func (s *SemanticSearchService) SemanticSearch(ctx context.Context, query core.SearchQuery) ([]core.SearchHit, string, error) {
+	if s.sync == nil {
+		return nil, "", fmt.Errorf("%w: semantic search is not configured", core.ErrInvalidState)
+	}
+	return s.sync.SearchContent(ctx, query)
+}
+
+// FindSimilarEpisodes returns the published episodes closest in meaning to
+// a published episode.
+func (s *SemanticSearchService) FindSimilarEpisodes(ctx context.Context, episodeID uuid.UUID, limit int) ([]core.SearchHit, error) {
+	if s.index == nil {
+		return nil, fmt.Errorf("%w: semantic search is not configured", core.ErrInvalidState)
+	}
+	if episodeID == uuid.Nil {
+		return nil, fmt.Errorf("%w: episode id required", core.ErrValidation)
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+	limit = min(limit, maxSimilarEpisodes)
+
+	docID := core.SearchDocumentID(core.SearchKindEpisode, episodeID)
+	embedding, err := s.repo.GetEmbedding(ctx, docID)
+	if err != nil {
+		// Only published episodes are embedded.
+		return nil, err
+	}
+	return s.repo.NearestEmbeddings(ctx, core.SimilarityQuery{
+		Vector:     embedding.Vector,
+		Model:      embedding.Model,
+		Kinds:      []core.SearchKind{core.SearchKindEpisode},
+		ExcludeIDs: []string{docID},
+		Limit:      limit,
+	})
+}
+
+// HandleEvent re-embeds the series or episode an event of SearchEventTypes
+// is about.
+func (s *SemanticSearchService) HandleEvent(ctx context.Context, event core.Event) error {
+	if s.sync == nil {
+		return nil
+	}
+	return s.sync.HandleEvent(ctx, event)
+}
+
+// ReembedContent embeds every published series and episode whose text
+// changed since it was last embedded.
+func (s *SemanticSearchService) ReembedContent(ctx context.Context) (int, error) {
+	if s.sync == nil {
+		return 0, fmt.Errorf("%w: semantic search is not configured", core.ErrInvalidState)
+	}
+	return s.sync.ReindexContent(ctx)
+}
+
+// embeddingText is the text a document is embedded from.
+func embeddingText(doc core.SearchDocument) string {
+	return truncateRunes(strings.TrimSpace(doc.Title+"\n"+doc.Body), maxEmbeddingRunes)
+}
+
+func contentHash(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
+
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
@@ -0,0 +1,227 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// keywordEmbedder embeds texts by how often they mention each keyword, so
+// texts about the same thing end up close together.
+type keywordEmbedder struct {
+	keywords []string
+	embedded []string
+}
+
+func (e *keywordEmbedder) Model() string { return "keywords" }
+
+func (e *keywordEmbedder) EmbedTexts(ctx context.Context, texts []string) ([][]float32, error) {
+	e.embedded = append(e.embedded, texts...)
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vector := []float32{0.1}
+		for _, keyword := range e.keywords {
+			vector = append(vector, float32(strings.Count(strings.ToLower(text), keyword)))
+		}
+		vectors[i] = vector
+	}
+	return vectors, nil
+}
+
+type stubEmbeddingRepo struct {
+	embeddings map[string]core.ContentEmbedding
+}
+
+func newStubEmbeddingRepo() *stubEmbeddingRepo {
+	return &stubEmbeddingRepo{embeddings: map[string]core.ContentEmbedding{}}
+}
+
+func (r *stubEmbeddingRepo) GetEmbedding(ctx context.Context, documentID string) (*core.ContentEmbedding, error) {
+	embedding, ok := r.embeddings[documentID]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	return &embedding, nil
+}
+
+func (r *stubEmbeddingRepo) SaveEmbeddings(ctx context.Context, embeddings ...core.ContentEmbedding) error {
+	for _, embedding := range embeddings {
+		r.embeddings[embedding.DocumentID] = embedding
+	}
+	return nil
+}
+
+func (r *stubEmbeddingRepo) DeleteEmbeddings(ctx context.Context, documentIDs ...string) error {
+	for _, id := range documentIDs {
+		delete(r.embeddings, id)
+	}
+	return nil
+}
+
+func (r *stubEmbeddingRepo) NearestEmbeddings(ctx context.Context, query core.SimilarityQuery) ([]core.SearchHit, error) {
+	var hits []core.SearchHit
+	for _, embedding := range r.embeddings {
+		if len(query.Kinds) > 0 && !slices.Contains(query.Kinds, embedding.Kind) {
+			continue
+		}
+		if slices.Contains(query.ExcludeIDs, embedding.DocumentID) {
+			continue
+		}
+		hits = append(hits, core.SearchHit{
+			Kind:      embedding.Kind,
+			SeriesID:  embedding.SeriesID,
+			EpisodeID: embedding.EpisodeID,
+			Title:     embedding.Title,
+			Score:     cosine(query.Vector, embedding.Vector),
+		})
+	}
+	slices.SortFunc(hits, func(a, b core.SearchHit) int {
+		switch {
+		case a.Score > b.Score:
+			return -1
+		case a.Score < b.Score:
+			return 1
+		}
+		return strings.Compare(a.Title, b.Title)
+	})
+	return hits[:min(len(hits), query.Limit)], nil
+}
+
+func cosine(a, b []float32) float64 {
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	return dot / math.Sqrt(na*nb)
+}
+
+func semanticTitles(hits []core.SearchHit) []string {
+	titles := make([]string, 0, len(hits))
+	for _, hit := range hits {
+		titles = append(titles, hit.Title)
+	}
+	return titles
+}
+
+func TestEmbeddingIndex_IndexDocumentsSkipsUnchangedText(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	embedder := &keywordEmbedder{keywords: []string{"coffee"}}
+	repo := newStubEmbeddingRepo()
+	index := NewEmbeddingIndex(embedder, repo)
+
+	doc := core.SearchDocument{ID: "episode:1", Kind: core.SearchKindEpisode, Title: "At the café", Body: "Ordering   a coffee.", Language: "en"}
+	if err := index.IndexDocuments(ctx, doc); err != nil {
+		t.Fatalf("IndexDocuments() error = %v", err)
+	}
+	// Only the level changed; the vector is reused.
+	doc.Level = "A2"
+	if err := index.IndexDocuments(ctx, doc); err != nil {
+		t.Fatalf("IndexDocuments() error = %v", err)
+	}
+	if len(embedder.embedded) != 1 {
+		t.Fatalf("expected one embedding call, got %q", embedder.embedded)
+	}
+	saved := repo.embeddings["episode:1"]
+	if saved.Level != "A2" || saved.Snippet != "Ordering a coffee." || len(saved.Vector) != 2 || saved.Model != "keywords" {
+		t.Fatalf("unexpected embedding %+v", saved)
+	}
+
+	doc.Body = "Ordering two coffees."
+	if err := index.IndexDocuments(ctx, doc); err != nil {
+		t.Fatalf("IndexDocuments() error = %v", err)
+	}
+	if len(embedder.embedded) != 2 {
+		t.Fatalf("expected the changed text to be embedded again, got %q", embedder.embedded)
+	}
+}
+
+func TestEmbeddingIndex_SearchPages(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	index := NewEmbeddingIndex(&keywordEmbedder{keywords: []string{"coffee", "train"}}, newStubEmbeddingRepo())
+	if err := index.IndexDocuments(ctx,
+		core.SearchDocument{ID: "episode:1", Kind: core.SearchKindEpisode, Title: "Café", Body: "coffee coffee"},
+		core.SearchDocument{ID: "episode:2", Kind: core.SearchKindEpisode, Title: "Breakfast", Body: "coffee and a train timetable"},
+		core.SearchDocument{ID: "episode:3", Kind: core.SearchKindEpisode, Title: "Station", Body: "train train"},
+	); err != nil {
+		t.Fatalf("IndexDocuments() error = %v", err)
+	}
+
+	hits, next, err := index.Search(ctx, core.SearchQuery{Query: "where can I get a coffee", PageSize: 2})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if got := semanticTitles(hits); !slices.Equal(got, []string{"Café", "Breakfast"}) || next != "2" {
+		t.Fatalf("Search() = %q, %q", got, next)
+	}
+	hits, next, err = index.Search(ctx, core.SearchQuery{Query: "where can I get a coffee", PageSize: 2, PageToken: next})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if got := semanticTitles(hits); !slices.Equal(got, []string{"Station"}) || next != "" {
+		t.Fatalf("Search() = %q, %q", got, next)
+	}
+
+	if _, _, err := index.Search(ctx, core.SearchQuery{Query: "coffee", PageSize: 2, PageToken: "x"}); !errors.Is(err, core.ErrInvalidPageToken) {
+		t.Fatalf("expected ErrInvalidPageToken, got %v", err)
+	}
+}
+
+func TestSemanticSearchService_FindSimilarEpisodes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := newStubEmbeddingRepo()
+	svc := NewSemanticSearchService(&keywordEmbedder{keywords: []string{"coffee", "train"}}, repo, &stubSeriesRepo{})
+
+	cafe, breakfast, station := uuid.New(), uuid.New(), uuid.New()
+	if err := svc.index.IndexDocuments(ctx,
+		core.SearchDocument{ID: core.SearchDocumentID(core.SearchKindSeries, uuid.New()), Kind: core.SearchKindSeries, Title: "Coffee English", Body: "coffee"},
+		core.SearchDocument{ID: core.SearchDocumentID(core.SearchKindEpisode, cafe), Kind: core.SearchKindEpisode, EpisodeID: cafe, Title: "Café", Body: "coffee coffee"},
+		core.SearchDocument{ID: core.SearchDocumentID(core.SearchKindEpisode, breakfast), Kind: core.SearchKindEpisode, EpisodeID: breakfast, Title: "Breakfast", Body: "coffee and a train"},
+		core.SearchDocument{ID: core.SearchDocumentID(core.SearchKindEpisode, station), Kind: core.SearchKindEpisode, EpisodeID: station, Title: "Station", Body: "train train"},
+	); err != nil {
+		t.Fatalf("IndexDocuments() error = %v", err)
+	}
+
+	hits, err := svc.FindSimilarEpisodes(ctx, cafe, 0)
+	if err != nil {
+		t.Fatalf("FindSimilarEpisodes() error = %v", err)
+	}
+	if got := semanticTitles(hits); !slices.Equal(got, []string{"Breakfast", "Station"}) {
+		t.Fatalf("FindSimilarEpisodes() = %q", got)
+	}
+
+	if _, err := svc.FindSimilarEpisodes(ctx, uuid.New(), 5); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for an episode without an embedding, got %v", err)
+	}
+}
+
+func TestSemanticSearchService_NotConfigured(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	svc := NewSemanticSearchService(nil, newStubEmbeddingRepo(), &stubSeriesRepo{})
+
+	if _, _, err := svc.SemanticSearch(ctx, core.SearchQuery{Query: "coffee"}); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected ErrInvalidState, got %v", err)
+	}
+	if _, err := svc.FindSimilarEpisodes(ctx, uuid.New(), 5); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected ErrInvalidState, got %v", err)
+	}
+	if err := svc.HandleEvent(ctx, core.EpisodePublished{}); err != nil {
+		t.Fatalf("HandleEvent() error = %v", err)
+	}
+}
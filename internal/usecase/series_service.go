@@ -3,18 +3,28 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/eslsoft/lession/internal/core"
 )
 
+var accentColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
 // SeriesService coordinates series-related use cases.
 type SeriesService struct {
-	repo core.SeriesRepository
-	now  func() time.Time
+	repo         core.SeriesRepository
+	assets       core.AssetRepository
+	scanners     []core.TextScanner
+	sanitizeMode TranscriptSanitizeMode
+	checklist    core.PublishChecklist
+	now          func() time.Time
 }
 
 // NewSeriesService constructs a SeriesService backed by the provided repository.
@@ -32,10 +42,38 @@ func (s *SeriesService) WithClock(fn func() time.Time) {
 	}
 }
 
+// WithTranscriptSanitizer runs a sanitization pass over transcripts
+// whenever an episode is saved, flagging or masking the spans the scanners
+// find, such as profanity or personal data.
+func (s *SeriesService) WithTranscriptSanitizer(mode TranscriptSanitizeMode, scanners ...core.TextScanner) {
+	s.sanitizeMode = mode
+	s.scanners = scanners
+}
+
+// WithCoverAssets lets series use processed image assets as covers and
+// hero images, and episodes without a duration take the duration of their
+// resource asset.
+func (s *SeriesService) WithCoverAssets(assets core.AssetRepository) {
+	s.assets = assets
+}
+
+// WithPublishChecklist makes series and episodes pass the checklist when
+// they are published.
+func (s *SeriesService) WithPublishChecklist(checklist core.PublishChecklist) {
+	s.checklist = checklist
+}
+
 var _ core.SeriesService = (*SeriesService)(nil)
 
 // ListSeries returns a filtered, paginated collection of series.
-func (s *SeriesService) ListSeries(ctx context.Context, filter core.SeriesListFilter) ([]core.Series, string, error) {
+func (s *SeriesService) ListSeries(ctx context.Context, filter core.SeriesListFilter) (series []core.Series, nextToken string, err error) {
+	ctx, span := tracer.Start(ctx, "SeriesService.ListSeries", trace.WithAttributes(
+		attribute.Int("lession.page_size", filter.PageSize),
+		attribute.Bool("lession.query", filter.Query != ""),
+		attribute.Bool("lession.include_episodes", filter.IncludeEpisodes),
+	))
+	defer func() { endSpan(span, err) }()
+
 	return s.repo.ListSeries(ctx, filter)
 }
 
@@ -53,18 +91,25 @@ func (s *SeriesService) CreateSeries(ctx context.Context, draft core.SeriesDraft
 	authorIDs := lo.Map(draft.AuthorIDs, func(id string, _ int) string { return id })
 
 	series := core.Series{
-		ID:        seriesID,
-		Slug:      draft.Slug,
-		Title:     draft.Title,
-		Summary:   draft.Summary,
-		Language:  draft.Language,
-		Level:     draft.Level,
-		Tags:      lo.Ternary(len(tags) > 0, tags, []string(nil)),
-		CoverURL:  draft.CoverURL,
-		Status:    status,
-		CreatedAt: now,
-		UpdatedAt: now,
-		AuthorIDs: lo.Ternary(len(authorIDs) > 0, authorIDs, []string(nil)),
+		ID:           seriesID,
+		Slug:         draft.Slug,
+		Title:        draft.Title,
+		Summary:      draft.Summary,
+		Language:     draft.Language,
+		Level:        draft.Level,
+		Tags:         lo.Ternary(len(tags) > 0, tags, []string(nil)),
+		CoverURL:     draft.CoverURL,
+		CoverAssetID: draft.CoverAssetID,
+		Status:       status,
+		DRMEnabled:   draft.DRMEnabled,
+		Branding:     draft.Branding,
+		AuthorIDs:    lo.Ternary(len(authorIDs) > 0, authorIDs, []string(nil)),
+	}
+	if err := s.resolveCover(ctx, &series); err != nil {
+		return nil, err
+	}
+	if err := s.resolveBranding(ctx, &series); err != nil {
+		return nil, err
 	}
 
 	if status == core.SeriesStatusPublished {
@@ -84,13 +129,32 @@ func (s *SeriesService) CreateSeries(ctx context.Context, draft core.SeriesDraft
 			if err != nil {
 				return nil, err
 			}
+			if err := s.resolveDuration(ctx, &episode, nil); err != nil {
+				return nil, err
+			}
 			episodes = append(episodes, episode)
 		}
 		series.Episodes = episodes
 		series.EpisodeCount = len(episodes)
 	}
 
-	return s.repo.CreateSeries(ctx, series)
+	var violations []core.PublishViolation
+	if status == core.SeriesStatusPublished {
+		violations = seriesViolations(s.checklist, series)
+	} else {
+		for _, episode := range series.Episodes {
+			violations = append(violations, episodeViolations(s.checklist, episode)...)
+		}
+	}
+	if err := publishReadinessError(violations); err != nil {
+		return nil, err
+	}
+
+	events := []core.Event{core.SeriesCreated{Series: series}}
+	if series.Status == core.SeriesStatusPublished {
+		events = append(events, core.SeriesPublished{Series: series})
+	}
+	return s.repo.CreateSeries(ctx, series, events...)
 }
 
 // GetSeries returns details for a single series.
@@ -109,11 +173,105 @@ func (s *SeriesService) UpdateSeries(ctx context.Context, series core.Series) (*
 	if series.Status == core.SeriesStatusUnspecified {
 		return nil, fmt.Errorf("%w: series status required", core.ErrValidation)
 	}
-	series.UpdatedAt = s.now().UTC()
-	if series.Status == core.SeriesStatusPublished && series.PublishedAt == nil {
-		series.PublishedAt = ptrTime(series.UpdatedAt)
+	if err := s.resolveCover(ctx, &series); err != nil {
+		return nil, err
+	}
+	if err := s.resolveBranding(ctx, &series); err != nil {
+		return nil, err
+	}
+	if err := s.checkSeriesPublish(ctx, series); err != nil {
+		return nil, err
+	}
+	firstPublished := series.Status == core.SeriesStatusPublished && series.PublishedAt == nil
+	if firstPublished {
+		series.PublishedAt = ptrTime(s.now().UTC())
+	}
+	events := []core.Event{core.SeriesUpdated{Series: series}}
+	if firstPublished {
+		events = append(events, core.SeriesPublished{Series: series})
+	}
+	return s.repo.UpdateSeries(ctx, series, events...)
+}
+
+// checkSeriesPublish checks a series moving to published, together with its
+// stored episodes, against the publish checklist.
+func (s *SeriesService) checkSeriesPublish(ctx context.Context, series core.Series) error {
+	if len(s.checklist.Rules) == 0 || series.Status != core.SeriesStatusPublished {
+		return nil
+	}
+	existing, err := s.repo.GetSeries(ctx, series.ID, core.SeriesQueryOptions{IncludeEpisodes: true})
+	if err != nil {
+		return err
+	}
+	if existing.Status == core.SeriesStatusPublished {
+		return nil
+	}
+	series.Episodes = existing.Episodes
+	return publishReadinessError(seriesViolations(s.checklist, series))
+}
+
+// resolveCover points the cover URL of a series with a cover image asset at
+// the asset's card variant. The asset must be a processed image.
+func (s *SeriesService) resolveCover(ctx context.Context, series *core.Series) error {
+	if series.CoverAssetID == uuid.Nil {
+		return nil
+	}
+	url, err := s.imageVariantURL(ctx, "cover", series.CoverAssetID, core.ImageVariantCard)
+	if err != nil {
+		return err
+	}
+	series.CoverURL = url
+	return nil
+}
+
+// resolveBranding validates the presentation metadata of a series and points
+// its hero image URL at the hero variant of the hero image asset.
+func (s *SeriesService) resolveBranding(ctx context.Context, series *core.Series) error {
+	branding := &series.Branding
+	if branding.AccentColor != "" {
+		if !accentColorPattern.MatchString(branding.AccentColor) {
+			return fmt.Errorf("%w: accent color %q must be #rrggbb", core.ErrValidation, branding.AccentColor)
+		}
+		branding.AccentColor = strings.ToLower(branding.AccentColor)
+	}
+	if branding.Layout < core.SeriesLayoutUnspecified || branding.Layout > core.SeriesLayoutFeatured {
+		return fmt.Errorf("%w: invalid series layout %d", core.ErrValidation, branding.Layout)
+	}
+	if branding.HeroAssetID == uuid.Nil {
+		branding.HeroImageURL = ""
+		return nil
+	}
+	url, err := s.imageVariantURL(ctx, "hero", branding.HeroAssetID, core.ImageVariantHero)
+	if err != nil {
+		return err
+	}
+	branding.HeroImageURL = url
+	return nil
+}
+
+// imageVariantURL returns the URL of the named variant of a processed image
+// asset; role names the asset's use in errors.
+func (s *SeriesService) imageVariantURL(ctx context.Context, role string, assetID uuid.UUID, name string) (string, error) {
+	if s.assets == nil {
+		return "", fmt.Errorf("%w: %s images are not configured", core.ErrInvalidState, role)
+	}
+	asset, err := s.assets.GetAssetByID(ctx, assetID)
+	if isNotFound(err) {
+		return "", fmt.Errorf("%w: %s asset %s not found", core.ErrValidation, role, assetID)
+	}
+	if err != nil {
+		return "", err
+	}
+	if asset.Type != core.AssetTypeImage {
+		return "", fmt.Errorf("%w: %s asset %s is not an image", core.ErrValidation, role, asset.ID)
 	}
-	return s.repo.UpdateSeries(ctx, series)
+	variant, ok := lo.Find(asset.ImageVariants, func(variant core.ImageVariant) bool {
+		return variant.Name == name
+	})
+	if asset.Status != core.AssetStatusReady || !ok {
+		return "", fmt.Errorf("%w: %s image %s has not been processed", core.ErrInvalidState, role, asset.ID)
+	}
+	return variant.URL, nil
 }
 
 // CreateEpisode adds a new episode to an existing series.
@@ -127,7 +285,17 @@ func (s *SeriesService) CreateEpisode(ctx context.Context, params core.CreateEpi
 	if err != nil {
 		return nil, err
 	}
-	return s.repo.CreateEpisode(ctx, episode)
+	if err := s.resolveDuration(ctx, &episode, nil); err != nil {
+		return nil, err
+	}
+	if err := publishReadinessError(episodeViolations(s.checklist, episode)); err != nil {
+		return nil, err
+	}
+	events := []core.Event{core.EpisodeCreated{Episode: episode}}
+	if episode.Status == core.EpisodeStatusPublished {
+		events = append(events, core.EpisodePublished{Episode: episode})
+	}
+	return s.repo.CreateEpisode(ctx, episode, events...)
 }
 
 // GetEpisode returns details for a single episode.
@@ -138,6 +306,19 @@ func (s *SeriesService) GetEpisode(ctx context.Context, id uuid.UUID) (*core.Epi
 	return s.repo.GetEpisode(ctx, id)
 }
 
+// GetEpisodeTextStats counts the words of an episode's transcript.
+func (s *SeriesService) GetEpisodeTextStats(ctx context.Context, id uuid.UUID, opts core.TextStatsOptions) (*core.EpisodeTextStats, error) {
+	episode, err := s.GetEpisode(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if opts.TopWords <= 0 {
+		opts.TopWords = defaultTopWords
+	}
+	opts.TopWords = min(opts.TopWords, maxTopWords)
+	return episodeTextStats(*episode, opts), nil
+}
+
 // UpdateEpisode applies updates to an episode.
 func (s *SeriesService) UpdateEpisode(ctx context.Context, episode core.Episode) (*core.Episode, error) {
 	if episode.ID == uuid.Nil {
@@ -149,11 +330,85 @@ func (s *SeriesService) UpdateEpisode(ctx context.Context, episode core.Episode)
 	if episode.Status == core.EpisodeStatusUnspecified {
 		return nil, fmt.Errorf("%w: episode status required", core.ErrValidation)
 	}
-	episode.UpdatedAt = s.now().UTC()
-	if episode.Status == core.EpisodeStatusPublished && episode.PublishedAt == nil {
-		episode.PublishedAt = ptrTime(episode.UpdatedAt)
+	existing, err := s.repo.GetEpisode(ctx, episode.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.resolveDuration(ctx, &episode, existing); err != nil {
+		return nil, err
+	}
+	if existing.Status != core.EpisodeStatusPublished {
+		if err := publishReadinessError(episodeViolations(s.checklist, episode)); err != nil {
+			return nil, err
+		}
+	}
+	sanitizeTranscript(&episode, s.scanners, s.sanitizeMode)
+	firstPublished := episode.Status == core.EpisodeStatusPublished && episode.PublishedAt == nil
+	if firstPublished {
+		episode.PublishedAt = ptrTime(s.now().UTC())
+	}
+	events := []core.Event{core.EpisodeUpdated{Episode: episode}}
+	if firstPublished {
+		events = append(events, core.EpisodePublished{Episode: episode})
+	}
+	if existing.Status == core.EpisodeStatusPublished && episode.Status != core.EpisodeStatusPublished {
+		events = append(events, core.EpisodeUnpublished{Episode: episode})
+	}
+	return s.repo.UpdateEpisode(ctx, episode, events...)
+}
+
+// resolveDuration copies the duration of the resource asset into an episode
+// without an explicit duration. A duration copied earlier and left unchanged
+// is not explicit, so it follows the asset the episode now links to.
+func (s *SeriesService) resolveDuration(ctx context.Context, episode *core.Episode, existing *core.Episode) error {
+	inherited := existing != nil && existing.DurationFromAsset && episode.Duration == existing.Duration
+	episode.DurationFromAsset = false
+	if s.assets == nil || episode.Resource.AssetID == uuid.Nil || (episode.Duration > 0 && !inherited) {
+		return nil
+	}
+	asset, err := s.assets.GetAssetByID(ctx, episode.Resource.AssetID)
+	if isNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// Episodes store whole seconds.
+	episode.Duration = asset.Duration.Round(time.Second)
+	episode.DurationFromAsset = true
+	return nil
+}
+
+// HandleAssetEvent updates the durations episodes copied from an asset that
+// became ready or whose duration changed.
+func (s *SeriesService) HandleAssetEvent(ctx context.Context, event core.Event) error {
+	var asset core.Asset
+	switch e := event.(type) {
+	case core.AssetReady:
+		asset = e.Asset
+	case core.AssetDurationChanged:
+		asset = e.Asset
+	default:
+		return nil
+	}
+
+	// Episodes store whole seconds.
+	duration := asset.Duration.Round(time.Second)
+	episodes, err := s.repo.ListEpisodesByAsset(ctx, asset.ID)
+	if err != nil {
+		return err
+	}
+	for _, episode := range episodes {
+		if !episode.DurationFromAsset || episode.DeletedAt != nil || episode.Duration == duration {
+			continue
+		}
+		episode.Duration = duration
+		_, err := s.repo.UpdateEpisode(ctx, episode, core.EpisodeUpdated{Episode: episode})
+		if err != nil && !isNotFound(err) {
+			return err
+		}
 	}
-	return s.repo.UpdateEpisode(ctx, episode)
+	return nil
 }
 
 // DeleteEpisode performs a soft delete on an episode.
@@ -161,7 +416,61 @@ func (s *SeriesService) DeleteEpisode(ctx context.Context, id uuid.UUID) (*core.
 	if id == uuid.Nil {
 		return nil, fmt.Errorf("%w: episode id required", core.ErrValidation)
 	}
-	return s.repo.DeleteEpisode(ctx, id)
+	existing, err := s.repo.GetEpisode(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.DeleteEpisode(ctx, id, core.EpisodeDeleted{Episode: *existing})
+}
+
+// RenameTag replaces a tag with a new name across all series.
+func (s *SeriesService) RenameTag(ctx context.Context, tag, newTag string) ([]uuid.UUID, error) {
+	return s.MergeTags(ctx, []string{tag}, newTag)
+}
+
+// MergeTags folds the source tags into the target tag across all series.
+func (s *SeriesService) MergeTags(ctx context.Context, sources []string, target string) ([]uuid.UUID, error) {
+	target = strings.TrimSpace(target)
+	if target == "" {
+		return nil, fmt.Errorf("%w: target tag required", core.ErrValidation)
+	}
+
+	sources = lo.Uniq(lo.FilterMap(sources, func(tag string, _ int) (string, bool) {
+		tag = strings.TrimSpace(tag)
+		return tag, tag != "" && tag != target
+	}))
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("%w: at least one source tag different from the target is required", core.ErrValidation)
+	}
+
+	return s.repo.ReplaceTags(ctx, core.TagReplacement{
+		Sources: sources,
+		Target:  target,
+	})
+}
+
+// ReassignContent transfers ownership of every series from one author to another.
+func (s *SeriesService) ReassignContent(ctx context.Context, fromAuthorID, toAuthorID string) (*core.ContentReassignment, error) {
+	fromAuthorID = strings.TrimSpace(fromAuthorID)
+	toAuthorID = strings.TrimSpace(toAuthorID)
+	if fromAuthorID == "" || toAuthorID == "" {
+		return nil, fmt.Errorf("%w: both from and to author ids are required", core.ErrValidation)
+	}
+	if fromAuthorID == toAuthorID {
+		return nil, fmt.Errorf("%w: from and to authors must differ", core.ErrValidation)
+	}
+
+	return s.repo.ReassignAuthor(ctx, core.ContentReassignment{
+		ID:           uuid.New(),
+		FromAuthorID: fromAuthorID,
+		ToAuthorID:   toAuthorID,
+	})
+}
+
+// ReconcileEpisodeCounts repairs stored episode counts that drifted from the
+// live episodes of their series.
+func (s *SeriesService) ReconcileEpisodeCounts(ctx context.Context) ([]uuid.UUID, error) {
+	return s.repo.ReconcileEpisodeCounts(ctx)
 }
 
 func (s *SeriesService) buildEpisodeFromDraft(seriesID uuid.UUID, draft core.EpisodeDraft, now time.Time) (core.Episode, error) {
@@ -188,15 +497,15 @@ func (s *SeriesService) buildEpisodeFromDraft(seriesID uuid.UUID, draft core.Epi
 		Description: draft.Description,
 		Duration:    draft.Duration,
 		Status:      status,
+		Preview:     draft.Preview,
 		Resource:    resource,
 		Transcript:  transcript,
-		CreatedAt:   now,
-		UpdatedAt:   now,
 	}
 
 	if status == core.EpisodeStatusPublished {
 		episode.PublishedAt = ptrTime(now)
 	}
+	sanitizeTranscript(&episode, s.scanners, s.sanitizeMode)
 
 	return episode, nil
 }
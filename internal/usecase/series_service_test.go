@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 	"time"
 
@@ -55,12 +56,6 @@ func TestSeriesService_CreateSeries(t *testing.T) {
 	if captured.Status != core.SeriesStatusDraft {
 		t.Fatalf("expected status default to draft, got %v", captured.Status)
 	}
-	if captured.CreatedAt != fixedNow {
-		t.Fatalf("expected CreatedAt %v, got %v", fixedNow, captured.CreatedAt)
-	}
-	if captured.UpdatedAt != fixedNow {
-		t.Fatalf("expected UpdatedAt %v, got %v", fixedNow, captured.UpdatedAt)
-	}
 	if captured.EpisodeCount != 1 {
 		t.Fatalf("expected EpisodeCount 1, got %d", captured.EpisodeCount)
 	}
@@ -74,12 +69,6 @@ func TestSeriesService_CreateSeries(t *testing.T) {
 	if episode.Status != core.EpisodeStatusDraft {
 		t.Fatalf("expected episode status default to draft, got %v", episode.Status)
 	}
-	if episode.CreatedAt != fixedNow {
-		t.Fatalf("expected episode CreatedAt %v, got %v", fixedNow, episode.CreatedAt)
-	}
-	if episode.UpdatedAt != fixedNow {
-		t.Fatalf("expected episode UpdatedAt %v, got %v", fixedNow, episode.UpdatedAt)
-	}
 }
 
 func TestSeriesService_CreateSeriesDuplicateSequence(t *testing.T) {
@@ -134,9 +123,6 @@ func TestSeriesService_UpdateSeries(t *testing.T) {
 	if got == nil {
 		t.Fatal("UpdateSeries() returned nil series")
 	}
-	if captured.UpdatedAt != fixedNow {
-		t.Fatalf("expected UpdatedAt %v, got %v", fixedNow, captured.UpdatedAt)
-	}
 	if captured.PublishedAt == nil || !captured.PublishedAt.Equal(fixedNow) {
 		t.Fatalf("expected PublishedAt to be set to %v", fixedNow)
 	}
@@ -185,9 +171,6 @@ func TestSeriesService_CreateEpisode(t *testing.T) {
 	if captured.ID == uuid.Nil {
 		t.Fatal("expected generated episode ID")
 	}
-	if captured.CreatedAt != fixedNow {
-		t.Fatalf("expected CreatedAt %v, got %v", fixedNow, captured.CreatedAt)
-	}
 	if captured.Status != core.EpisodeStatusDraft {
 		t.Fatalf("expected status default to draft, got %v", captured.Status)
 	}
@@ -205,6 +188,9 @@ func TestSeriesService_UpdateEpisode(t *testing.T) {
 	var captured core.Episode
 
 	repo := &stubSeriesRepo{
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			return &core.Episode{ID: id, Status: core.EpisodeStatusDraft}, nil
+		},
 		updateEpisodeFn: func(ctx context.Context, episode core.Episode) (*core.Episode, error) {
 			captured = episode
 			copy := episode
@@ -235,14 +221,91 @@ func TestSeriesService_UpdateEpisode(t *testing.T) {
 	if got == nil {
 		t.Fatal("UpdateEpisode() returned nil episode")
 	}
-	if captured.UpdatedAt != fixedNow {
-		t.Fatalf("expected UpdatedAt %v, got %v", fixedNow, captured.UpdatedAt)
-	}
 	if captured.PublishedAt == nil || !captured.PublishedAt.Equal(fixedNow) {
 		t.Fatalf("expected PublishedAt to be set to %v", fixedNow)
 	}
 }
 
+func TestSeriesService_UpdateEpisodeRecordsEventOnce(t *testing.T) {
+	var stored *core.Episode
+	repo := &stubSeriesRepo{
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			if stored == nil {
+				return &core.Episode{ID: id, Status: core.EpisodeStatusDraft}, nil
+			}
+			return stored, nil
+		},
+		updateEpisodeFn: func(ctx context.Context, episode core.Episode) (*core.Episode, error) {
+			stored = &episode
+			return &episode, nil
+		},
+	}
+	service := NewSeriesService(repo)
+
+	episode := core.Episode{ID: uuid.New(), SeriesID: uuid.New(), Status: core.EpisodeStatusPublished}
+	got, err := service.UpdateEpisode(context.Background(), episode)
+	if err != nil {
+		t.Fatalf("UpdateEpisode() error = %v", err)
+	}
+	if _, err := service.UpdateEpisode(context.Background(), *got); err != nil {
+		t.Fatalf("UpdateEpisode() error = %v", err)
+	}
+	published := eventsOfType(repo.events, core.EventTypeEpisodePublished)
+	if len(published) != 1 || published[0].(core.EpisodePublished).Episode.ID != episode.ID {
+		t.Fatalf("expected one EpisodePublished event for %s, got %+v", episode.ID, repo.events)
+	}
+	if updated := eventsOfType(repo.events, core.EventTypeEpisodeUpdated); len(updated) != 2 {
+		t.Fatalf("expected an EpisodeUpdated event per update, got %+v", repo.events)
+	}
+
+	draft := *got
+	draft.Status = core.EpisodeStatusDraft
+	if _, err := service.UpdateEpisode(context.Background(), draft); err != nil {
+		t.Fatalf("UpdateEpisode() error = %v", err)
+	}
+	if _, err := service.UpdateEpisode(context.Background(), draft); err != nil {
+		t.Fatalf("UpdateEpisode() error = %v", err)
+	}
+	if unpublished := eventsOfType(repo.events, core.EventTypeEpisodeUnpublished); len(unpublished) != 1 {
+		t.Fatalf("expected one EpisodeUnpublished event, got %+v", repo.events)
+	}
+}
+
+func TestSeriesService_UpdateSeriesRecordsEventOnce(t *testing.T) {
+	repo := &stubSeriesRepo{
+		updateSeriesFn: func(ctx context.Context, series core.Series) (*core.Series, error) {
+			return &series, nil
+		},
+	}
+	service := NewSeriesService(repo)
+
+	series := core.Series{ID: uuid.New(), Status: core.SeriesStatusPublished}
+	got, err := service.UpdateSeries(context.Background(), series)
+	if err != nil {
+		t.Fatalf("UpdateSeries() error = %v", err)
+	}
+	if _, err := service.UpdateSeries(context.Background(), *got); err != nil {
+		t.Fatalf("UpdateSeries() error = %v", err)
+	}
+	published := eventsOfType(repo.events, core.EventTypeSeriesPublished)
+	if len(published) != 1 || published[0].(core.SeriesPublished).Series.ID != series.ID {
+		t.Fatalf("expected one SeriesPublished event for %s, got %+v", series.ID, repo.events)
+	}
+	if updated := eventsOfType(repo.events, core.EventTypeSeriesUpdated); len(updated) != 2 {
+		t.Fatalf("expected a SeriesUpdated event per update, got %+v", repo.events)
+	}
+}
+
+func eventsOfType(events []core.Event, eventType core.EventType) []core.Event {
+	var matched []core.Event
+	for _, event := range events {
+		if event.EventType() == eventType {
+			matched = append(matched, event)
+		}
+	}
+	return matched
+}
+
 func TestSeriesService_DeleteEpisodeValidation(t *testing.T) {
 	service := NewSeriesService(&stubSeriesRepo{})
 	if _, err := service.DeleteEpisode(context.Background(), uuid.Nil); err == nil {
@@ -250,15 +313,106 @@ func TestSeriesService_DeleteEpisodeValidation(t *testing.T) {
 	}
 }
 
+func TestSeriesService_DeleteEpisodeRecordsEvent(t *testing.T) {
+	seriesID := uuid.New()
+	repo := &stubSeriesRepo{
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			return &core.Episode{ID: id, SeriesID: seriesID}, nil
+		},
+		deleteEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			return &core.Episode{ID: id, Status: core.EpisodeStatusArchived}, nil
+		},
+	}
+	service := NewSeriesService(repo)
+
+	id := uuid.New()
+	if _, err := service.DeleteEpisode(context.Background(), id); err != nil {
+		t.Fatalf("DeleteEpisode() error = %v", err)
+	}
+	if len(repo.events) != 1 || repo.events[0].(core.EpisodeDeleted).Episode.SeriesID != seriesID || repo.events[0].AggregateID() != id.String() {
+		t.Fatalf("expected an EpisodeDeleted event for %s, got %+v", id, repo.events)
+	}
+}
+
+func TestSeriesService_MergeTags(t *testing.T) {
+	fixedNow := time.Date(2024, 6, 7, 8, 9, 10, 0, time.UTC)
+	var captured core.TagReplacement
+
+	repo := &stubSeriesRepo{
+		replaceTagsFn: func(ctx context.Context, replacement core.TagReplacement) ([]uuid.UUID, error) {
+			captured = replacement
+			return []uuid.UUID{uuid.New()}, nil
+		},
+	}
+	service := NewSeriesService(repo)
+	service.WithClock(func() time.Time { return fixedNow })
+
+	if _, err := service.MergeTags(context.Background(), []string{"a"}, " "); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected validation error for empty target, got %v", err)
+	}
+	if _, err := service.RenameTag(context.Background(), "same", "same"); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected validation error for identical rename, got %v", err)
+	}
+
+	ids, err := service.MergeTags(context.Background(), []string{" grammar ", "grammar", "Grammar", "english"}, "english")
+	if err != nil {
+		t.Fatalf("MergeTags() error = %v", err)
+	}
+	if len(ids) != 1 {
+		t.Fatalf("expected 1 affected series, got %d", len(ids))
+	}
+	if len(captured.Sources) != 2 || captured.Sources[0] != "grammar" || captured.Sources[1] != "Grammar" {
+		t.Fatalf("unexpected normalized sources %#v", captured.Sources)
+	}
+	if captured.Target != "english" {
+		t.Fatalf("unexpected target %q", captured.Target)
+	}
+}
+
+func TestSeriesService_ReassignContent(t *testing.T) {
+	var captured core.ContentReassignment
+
+	repo := &stubSeriesRepo{
+		reassignFn: func(ctx context.Context, reassignment core.ContentReassignment) (*core.ContentReassignment, error) {
+			captured = reassignment
+			return &reassignment, nil
+		},
+	}
+	service := NewSeriesService(repo)
+
+	if _, err := service.ReassignContent(context.Background(), "alice", " alice "); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected validation error for identical authors, got %v", err)
+	}
+
+	if _, err := service.ReassignContent(context.Background(), " alice ", "bob"); err != nil {
+		t.Fatalf("ReassignContent() error = %v", err)
+	}
+	if captured.FromAuthorID != "alice" || captured.ToAuthorID != "bob" {
+		t.Fatalf("unexpected authors %q -> %q", captured.FromAuthorID, captured.ToAuthorID)
+	}
+	if captured.ID == uuid.Nil {
+		t.Fatalf("expected a generated id, got %#v", captured)
+	}
+}
+
 type stubSeriesRepo struct {
 	listSeriesFn    func(ctx context.Context, filter core.SeriesListFilter) ([]core.Series, string, error)
 	createSeriesFn  func(ctx context.Context, series core.Series) (*core.Series, error)
 	getSeriesFn     func(ctx context.Context, id uuid.UUID, opts core.SeriesQueryOptions) (*core.Series, error)
+	getBySlugFn     func(ctx context.Context, slug string, opts core.SeriesQueryOptions) (*core.Series, error)
 	updateSeriesFn  func(ctx context.Context, series core.Series) (*core.Series, error)
 	createEpisodeFn func(ctx context.Context, episode core.Episode) (*core.Episode, error)
 	getEpisodeFn    func(ctx context.Context, id uuid.UUID) (*core.Episode, error)
 	updateEpisodeFn func(ctx context.Context, episode core.Episode) (*core.Episode, error)
 	deleteEpisodeFn func(ctx context.Context, id uuid.UUID) (*core.Episode, error)
+	replaceTagsFn   func(ctx context.Context, replacement core.TagReplacement) ([]uuid.UUID, error)
+	reassignFn      func(ctx context.Context, reassignment core.ContentReassignment) (*core.ContentReassignment, error)
+
+	setEpisodeEstimatedLevelFn func(ctx context.Context, id uuid.UUID, level string) (*core.Episode, error)
+	listEpisodesByAssetFn      func(ctx context.Context, assetID uuid.UUID) ([]core.Episode, error)
+
+	// events collects the events handed to write methods for the outbox.
+	events []core.Event
 }
 
 func (s *stubSeriesRepo) ListSeries(ctx context.Context, filter core.SeriesListFilter) ([]core.Series, string, error) {
@@ -268,7 +422,8 @@ func (s *stubSeriesRepo) ListSeries(ctx context.Context, filter core.SeriesListF
 	return nil, "", nil
 }
 
-func (s *stubSeriesRepo) CreateSeries(ctx context.Context, series core.Series) (*core.Series, error) {
+func (s *stubSeriesRepo) CreateSeries(ctx context.Context, series core.Series, events ...core.Event) (*core.Series, error) {
+	s.events = append(s.events, events...)
 	if s.createSeriesFn != nil {
 		return s.createSeriesFn(ctx, series)
 	}
@@ -282,14 +437,23 @@ func (s *stubSeriesRepo) GetSeries(ctx context.Context, id uuid.UUID, opts core.
 	return nil, nil
 }
 
-func (s *stubSeriesRepo) UpdateSeries(ctx context.Context, series core.Series) (*core.Series, error) {
+func (s *stubSeriesRepo) GetSeriesBySlug(ctx context.Context, slug string, opts core.SeriesQueryOptions) (*core.Series, error) {
+	if s.getBySlugFn != nil {
+		return s.getBySlugFn(ctx, slug, opts)
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubSeriesRepo) UpdateSeries(ctx context.Context, series core.Series, events ...core.Event) (*core.Series, error) {
+	s.events = append(s.events, events...)
 	if s.updateSeriesFn != nil {
 		return s.updateSeriesFn(ctx, series)
 	}
 	return nil, nil
 }
 
-func (s *stubSeriesRepo) CreateEpisode(ctx context.Context, episode core.Episode) (*core.Episode, error) {
+func (s *stubSeriesRepo) CreateEpisode(ctx context.Context, episode core.Episode, events ...core.Event) (*core.Episode, error) {
+	s.events = append(s.events, events...)
 	if s.createEpisodeFn != nil {
 		return s.createEpisodeFn(ctx, episode)
 	}
@@ -303,16 +467,348 @@ func (s *stubSeriesRepo) GetEpisode(ctx context.Context, id uuid.UUID) (*core.Ep
 	return nil, nil
 }
 
-func (s *stubSeriesRepo) UpdateEpisode(ctx context.Context, episode core.Episode) (*core.Episode, error) {
+func (s *stubSeriesRepo) UpdateEpisode(ctx context.Context, episode core.Episode, events ...core.Event) (*core.Episode, error) {
+	s.events = append(s.events, events...)
 	if s.updateEpisodeFn != nil {
 		return s.updateEpisodeFn(ctx, episode)
 	}
 	return nil, nil
 }
 
-func (s *stubSeriesRepo) DeleteEpisode(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+func (s *stubSeriesRepo) DeleteEpisode(ctx context.Context, id uuid.UUID, events ...core.Event) (*core.Episode, error) {
+	s.events = append(s.events, events...)
 	if s.deleteEpisodeFn != nil {
 		return s.deleteEpisodeFn(ctx, id)
 	}
 	return nil, nil
 }
+
+func (s *stubSeriesRepo) ReplaceTags(ctx context.Context, replacement core.TagReplacement) ([]uuid.UUID, error) {
+	if s.replaceTagsFn != nil {
+		return s.replaceTagsFn(ctx, replacement)
+	}
+	return nil, nil
+}
+
+func (s *stubSeriesRepo) ReassignAuthor(ctx context.Context, reassignment core.ContentReassignment) (*core.ContentReassignment, error) {
+	if s.reassignFn != nil {
+		return s.reassignFn(ctx, reassignment)
+	}
+	return nil, nil
+}
+
+func (s *stubSeriesRepo) ReconcileEpisodeCounts(context.Context) ([]uuid.UUID, error) {
+	return nil, nil
+}
+
+func (s *stubSeriesRepo) SetEpisodeEstimatedLevel(ctx context.Context, id uuid.UUID, level string) (*core.Episode, error) {
+	if s.setEpisodeEstimatedLevelFn != nil {
+		return s.setEpisodeEstimatedLevelFn(ctx, id, level)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (s *stubSeriesRepo) ListEpisodesByAsset(ctx context.Context, assetID uuid.UUID) ([]core.Episode, error) {
+	if s.listEpisodesByAssetFn != nil {
+		return s.listEpisodesByAssetFn(ctx, assetID)
+	}
+	return nil, nil
+}
+
+func TestSeriesService_CoverAsset(t *testing.T) {
+	cover := core.Asset{
+		ID:     uuid.New(),
+		Type:   core.AssetTypeImage,
+		Status: core.AssetStatusReady,
+		ImageVariants: []core.ImageVariant{
+			{Name: core.ImageVariantThumbnail, URL: "https://media.example.com/images/c/thumbnail.jpg"},
+			{Name: core.ImageVariantCard, URL: "https://media.example.com/images/c/card.jpg"},
+		},
+	}
+	audio := core.Asset{ID: uuid.New(), Type: core.AssetTypeAudio, Status: core.AssetStatusReady}
+	assets := &stubAssetRepo{
+		getAssetByIDFn: func(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
+			for _, asset := range []core.Asset{cover, audio} {
+				if asset.ID == id {
+					found := asset
+					return &found, nil
+				}
+			}
+			return nil, core.ErrNotFound
+		},
+	}
+	repo := &stubSeriesRepo{
+		createSeriesFn: func(ctx context.Context, series core.Series) (*core.Series, error) {
+			return &series, nil
+		},
+	}
+	service := NewSeriesService(repo)
+
+	draft := core.SeriesDraft{Slug: "coffee", Title: "Coffee", CoverURL: "https://elsewhere.example.com/old.png", CoverAssetID: cover.ID}
+	if _, err := service.CreateSeries(context.Background(), draft); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected covers to be unavailable without assets, got %v", err)
+	}
+
+	service.WithCoverAssets(assets)
+	created, err := service.CreateSeries(context.Background(), draft)
+	if err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+	if created.CoverAssetID != cover.ID || created.CoverURL != "https://media.example.com/images/c/card.jpg" {
+		t.Fatalf("expected the cover url taken from the card variant, got %q", created.CoverURL)
+	}
+
+	for _, id := range []uuid.UUID{audio.ID, uuid.New()} {
+		draft.CoverAssetID = id
+		if _, err := service.CreateSeries(context.Background(), draft); !errors.Is(err, core.ErrValidation) {
+			t.Fatalf("expected cover asset %s to be rejected, got %v", id, err)
+		}
+	}
+}
+
+func TestSeriesService_Branding(t *testing.T) {
+	hero := core.Asset{
+		ID:     uuid.New(),
+		Type:   core.AssetTypeImage,
+		Status: core.AssetStatusReady,
+		ImageVariants: []core.ImageVariant{
+			{Name: core.ImageVariantCard, URL: "https://media.example.com/images/h/card.jpg"},
+			{Name: core.ImageVariantHero, URL: "https://media.example.com/images/h/hero.jpg"},
+		},
+	}
+	repo := &stubSeriesRepo{
+		updateSeriesFn: func(ctx context.Context, series core.Series) (*core.Series, error) {
+			return &series, nil
+		},
+	}
+	service := NewSeriesService(repo)
+	service.WithCoverAssets(&stubAssetRepo{
+		getAssetByIDFn: func(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
+			if id != hero.ID {
+				return nil, core.ErrNotFound
+			}
+			found := hero
+			return &found, nil
+		},
+	})
+
+	series := core.Series{
+		ID:     uuid.New(),
+		Status: core.SeriesStatusDraft,
+		Branding: core.SeriesBranding{
+			AccentColor:  "#FF8800",
+			HeroAssetID:  hero.ID,
+			HeroImageURL: "https://spoofed.example.com/hero.jpg",
+			Layout:       core.SeriesLayoutFeatured,
+		},
+	}
+	updated, err := service.UpdateSeries(context.Background(), series)
+	if err != nil {
+		t.Fatalf("UpdateSeries() error = %v", err)
+	}
+	if updated.Branding.AccentColor != "#ff8800" {
+		t.Fatalf("expected a normalized accent color, got %q", updated.Branding.AccentColor)
+	}
+	if updated.Branding.HeroImageURL != "https://media.example.com/images/h/hero.jpg" {
+		t.Fatalf("expected the hero url taken from the hero variant, got %q", updated.Branding.HeroImageURL)
+	}
+
+	series.Branding.HeroAssetID = uuid.Nil
+	updated, err = service.UpdateSeries(context.Background(), series)
+	if err != nil {
+		t.Fatalf("UpdateSeries() error = %v", err)
+	}
+	if updated.Branding.HeroImageURL != "" {
+		t.Fatalf("expected the hero url cleared with the hero asset, got %q", updated.Branding.HeroImageURL)
+	}
+
+	for _, branding := range []core.SeriesBranding{
+		{AccentColor: "orange"},
+		{Layout: core.SeriesLayout(9)},
+		{HeroAssetID: uuid.New()},
+	} {
+		series.Branding = branding
+		if _, err := service.UpdateSeries(context.Background(), series); !errors.Is(err, core.ErrValidation) {
+			t.Fatalf("expected branding %+v to be rejected, got %v", branding, err)
+		}
+	}
+}
+
+func TestSeriesService_EpisodeDurationFromAsset(t *testing.T) {
+	audio := core.Asset{ID: uuid.New(), Type: core.AssetTypeAudio, Status: core.AssetStatusReady, Duration: 90*time.Second + 400*time.Millisecond}
+	other := core.Asset{ID: uuid.New(), Type: core.AssetTypeAudio, Status: core.AssetStatusReady, Duration: 2 * time.Minute}
+	var stored core.Episode
+	repo := &stubSeriesRepo{
+		createEpisodeFn: func(ctx context.Context, episode core.Episode) (*core.Episode, error) {
+			stored = episode
+			return &episode, nil
+		},
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			found := stored
+			return &found, nil
+		},
+		updateEpisodeFn: func(ctx context.Context, episode core.Episode) (*core.Episode, error) {
+			stored = episode
+			return &episode, nil
+		},
+		listEpisodesByAssetFn: func(ctx context.Context, assetID uuid.UUID) ([]core.Episode, error) {
+			if stored.Resource.AssetID != assetID {
+				return nil, nil
+			}
+			return []core.Episode{stored}, nil
+		},
+	}
+	service := NewSeriesService(repo)
+	service.WithCoverAssets(&stubAssetRepo{
+		getAssetByIDFn: func(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
+			for _, asset := range []core.Asset{audio, other} {
+				if asset.ID == id {
+					found := asset
+					return &found, nil
+				}
+			}
+			return nil, core.ErrNotFound
+		},
+	})
+	ctx := context.Background()
+
+	created, err := service.CreateEpisode(ctx, core.CreateEpisodeParams{
+		SeriesID: uuid.New(),
+		Draft:    core.EpisodeDraft{Seq: 1, Title: "Ordering", Resource: &core.MediaResource{AssetID: audio.ID}},
+	})
+	if err != nil {
+		t.Fatalf("CreateEpisode() error = %v", err)
+	}
+	if created.Duration != 90*time.Second || !created.DurationFromAsset {
+		t.Fatalf("expected the asset duration in whole seconds, got %v (from asset %v)", created.Duration, created.DurationFromAsset)
+	}
+
+	// Relinking an episode whose duration came from its asset follows the new asset.
+	episode := *created
+	episode.Resource.AssetID = other.ID
+	updated, err := service.UpdateEpisode(ctx, episode)
+	if err != nil {
+		t.Fatalf("UpdateEpisode() error = %v", err)
+	}
+	if updated.Duration != 2*time.Minute || !updated.DurationFromAsset {
+		t.Fatalf("expected the duration of the new asset, got %v", updated.Duration)
+	}
+
+	other.Duration = 150 * time.Second
+	repo.events = nil
+	if err := service.HandleAssetEvent(ctx, core.AssetDurationChanged{Asset: other, PreviousDuration: 2 * time.Minute}); err != nil {
+		t.Fatalf("HandleAssetEvent() error = %v", err)
+	}
+	if stored.Duration != 150*time.Second || len(repo.events) != 1 {
+		t.Fatalf("expected the episode synced once to the asset, got %v with events %v", stored.Duration, repo.events)
+	}
+
+	// An explicit duration is kept and no longer synced.
+	episode = stored
+	episode.Duration = 3 * time.Minute
+	updated, err = service.UpdateEpisode(ctx, episode)
+	if err != nil {
+		t.Fatalf("UpdateEpisode() error = %v", err)
+	}
+	if updated.Duration != 3*time.Minute || updated.DurationFromAsset {
+		t.Fatalf("expected the explicit duration kept, got %v (from asset %v)", updated.Duration, updated.DurationFromAsset)
+	}
+	repo.events = nil
+	if err := service.HandleAssetEvent(ctx, core.AssetReady{Asset: other}); err != nil {
+		t.Fatalf("HandleAssetEvent() error = %v", err)
+	}
+	if stored.Duration != 3*time.Minute || len(repo.events) != 0 {
+		t.Fatalf("expected the explicit duration left alone, got %v", stored.Duration)
+	}
+}
+
+func TestSeriesService_PublishChecklist(t *testing.T) {
+	seriesID := uuid.New()
+	stored := core.Series{
+		ID:      seriesID,
+		Status:  core.SeriesStatusDraft,
+		Summary: "Short",
+		Episodes: []core.Episode{
+			{ID: uuid.New(), SeriesID: seriesID, Seq: 1, Status: core.EpisodeStatusDraft},
+			{ID: uuid.New(), SeriesID: seriesID, Seq: 2, Status: core.EpisodeStatusPublished},
+		},
+	}
+	repo := &stubSeriesRepo{
+		getSeriesFn: func(ctx context.Context, id uuid.UUID, opts core.SeriesQueryOptions) (*core.Series, error) {
+			if !opts.IncludeEpisodes {
+				t.Fatal("expected the episodes to be checked as well")
+			}
+			found := stored
+			return &found, nil
+		},
+		updateSeriesFn: func(ctx context.Context, series core.Series) (*core.Series, error) {
+			return &series, nil
+		},
+		createEpisodeFn: func(ctx context.Context, episode core.Episode) (*core.Episode, error) {
+			return &episode, nil
+		},
+	}
+	service := NewSeriesService(repo)
+	service.WithPublishChecklist(core.PublishChecklist{
+		Rules: []core.PublishRule{
+			core.PublishRuleCover,
+			core.PublishRuleSummary,
+			core.PublishRuleReadyEpisode,
+			core.PublishRuleTranscripts,
+		},
+		MinSummaryLength: 10,
+	})
+	ctx := context.Background()
+
+	publish := stored
+	publish.Episodes = nil
+	publish.Status = core.SeriesStatusPublished
+	_, err := service.UpdateSeries(ctx, publish)
+	var readiness *core.PublishReadinessError
+	if !errors.As(err, &readiness) || !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected a publish readiness error, got %v", err)
+	}
+	var rules []core.PublishRule
+	for _, violation := range readiness.Violations {
+		rules = append(rules, violation.Rule)
+	}
+	if !reflect.DeepEqual(rules, []core.PublishRule{core.PublishRuleCover, core.PublishRuleSummary, core.PublishRuleTranscripts}) {
+		t.Fatalf("unexpected violations %+v", readiness.Violations)
+	}
+	if episode := readiness.Violations[2]; episode.EpisodeID != stored.Episodes[1].ID {
+		t.Fatalf("expected the transcript violation to name the episode, got %+v", episode)
+	}
+
+	stored.Episodes[1].Transcript.Content = "Hello there."
+	publish.CoverURL = "https://media.example.com/cover.jpg"
+	publish.Summary = "Ordering coffee in English"
+	if _, err := service.UpdateSeries(ctx, publish); err != nil {
+		t.Fatalf("UpdateSeries() error = %v", err)
+	}
+
+	// Series that are already published are not checked again.
+	stored.Status = core.SeriesStatusPublished
+	publish.Summary = ""
+	if _, err := service.UpdateSeries(ctx, publish); err != nil {
+		t.Fatalf("UpdateSeries() of a published series error = %v", err)
+	}
+
+	_, err = service.CreateEpisode(ctx, core.CreateEpisodeParams{
+		SeriesID: seriesID,
+		Draft:    core.EpisodeDraft{Seq: 3, Title: "Paying", Status: core.EpisodeStatusPublished},
+	})
+	if !errors.As(err, &readiness) || readiness.Violations[0].Rule != core.PublishRuleTranscripts {
+		t.Fatalf("expected an episode without a transcript to be rejected, got %v", err)
+	}
+	if _, err := service.CreateEpisode(ctx, core.CreateEpisodeParams{
+		SeriesID: seriesID,
+		Draft:    core.EpisodeDraft{Seq: 3, Title: "Paying", Status: core.EpisodeStatusReady},
+	}); err != nil {
+		t.Fatalf("CreateEpisode() of a ready episode error = %v", err)
+	}
+
+	_, err = service.CreateSeries(ctx, core.SeriesDraft{Slug: "empty", Title: "Empty", Status: core.SeriesStatusPublished, CoverURL: "https://media.example.com/c.jpg", Summary: "Nothing to listen to yet"})
+	if !errors.As(err, &readiness) || len(readiness.Violations) != 1 || readiness.Violations[0].Rule != core.PublishRuleReadyEpisode {
+		t.Fatalf("expected a series without episodes to be rejected, got %v", err)
+	}
+}
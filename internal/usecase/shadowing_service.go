@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// ShadowingService records learner recordings of transcript segments and their reviews.
+type ShadowingService struct {
+	repo   core.ShadowingRepository
+	series core.SeriesRepository
+	assets core.AssetRepository
+	scorer core.PronunciationScorer
+	now    func() time.Time
+}
+
+// NewShadowingService constructs a shadowing service using the supplied repositories.
+func NewShadowingService(repo core.ShadowingRepository, series core.SeriesRepository, assets core.AssetRepository) *ShadowingService {
+	return &ShadowingService{
+		repo:   repo,
+		series: series,
+		assets: assets,
+		now:    time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *ShadowingService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+// WithScorer enables automatic pronunciation scoring of new submissions.
+func (s *ShadowingService) WithScorer(scorer core.PronunciationScorer) {
+	s.scorer = scorer
+}
+
+var _ core.ShadowingService = (*ShadowingService)(nil)
+
+// CreateShadowingSubmission attaches an uploaded recording to a transcript segment.
+func (s *ShadowingService) CreateShadowingSubmission(ctx context.Context, params core.CreateShadowingSubmissionParams) (*core.ShadowingSubmission, error) {
+	userID := strings.TrimSpace(params.UserID)
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", core.ErrValidation)
+	}
+
+	episode, err := s.series.GetEpisode(ctx, params.EpisodeID)
+	if err != nil {
+		return nil, err
+	}
+	segments, err := core.ParseTranscriptSegments(episode.Transcript)
+	if err != nil {
+		return nil, err
+	}
+	if params.SegmentIndex < 0 || params.SegmentIndex >= len(segments) {
+		return nil, fmt.Errorf("%w: transcript segment %d", core.ErrNotFound, params.SegmentIndex)
+	}
+
+	asset, err := s.assets.GetAssetByID(ctx, params.AssetID)
+	if err != nil {
+		return nil, err
+	}
+	if asset.Type != core.AssetTypeAudio {
+		return nil, fmt.Errorf("%w: recording must be an audio asset", core.ErrValidation)
+	}
+	if asset.Status != core.AssetStatusReady {
+		return nil, fmt.Errorf("%w: recording upload has not completed", core.ErrUploadInvalidState)
+	}
+
+	submission := core.ShadowingSubmission{
+		ID:           uuid.New(),
+		UserID:       userID,
+		EpisodeID:    episode.ID,
+		SegmentIndex: params.SegmentIndex,
+		AssetID:      asset.ID,
+		Status:       core.ShadowingSubmissionStatusPending,
+	}
+
+	if s.scorer != nil {
+		score, err := s.scorer.ScoreRecording(ctx, core.PronunciationScoreParams{
+			Asset:        *asset,
+			ExpectedText: segments[params.SegmentIndex].Text,
+			Language:     episode.Transcript.Language,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if score != nil {
+			submission.AutoScore = &score.Score
+			submission.AutoFeedback = score.Feedback
+		}
+	}
+
+	return s.repo.CreateShadowingSubmission(ctx, submission)
+}
+
+// GetShadowingSubmission retrieves a submission by identifier.
+func (s *ShadowingService) GetShadowingSubmission(ctx context.Context, id uuid.UUID) (*core.ShadowingSubmission, error) {
+	return s.repo.GetShadowingSubmission(ctx, id)
+}
+
+// ListShadowingSubmissions returns submissions, newest first.
+func (s *ShadowingService) ListShadowingSubmissions(ctx context.Context, filter core.ShadowingSubmissionFilter) ([]core.ShadowingSubmission, string, error) {
+	return s.repo.ListShadowingSubmissions(ctx, filter)
+}
+
+// ReviewShadowingSubmission records a teacher's score and comment on a submission.
+func (s *ShadowingService) ReviewShadowingSubmission(ctx context.Context, params core.ReviewShadowingSubmissionParams) (*core.ShadowingSubmission, error) {
+	reviewerID := strings.TrimSpace(params.ReviewerID)
+	if reviewerID == "" {
+		return nil, fmt.Errorf("%w: reviewer id is required", core.ErrValidation)
+	}
+	if params.Score < 0 || params.Score > 100 {
+		return nil, fmt.Errorf("%w: review score must be between 0 and 100", core.ErrValidation)
+	}
+
+	submission, err := s.repo.GetShadowingSubmission(ctx, params.SubmissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now().UTC()
+	score := params.Score
+	submission.Status = core.ShadowingSubmissionStatusReviewed
+	submission.ReviewerID = reviewerID
+	submission.ReviewScore = &score
+	submission.ReviewComment = strings.TrimSpace(params.Comment)
+	submission.ReviewedAt = &now
+
+	return s.repo.UpdateShadowingSubmission(ctx, *submission)
+}
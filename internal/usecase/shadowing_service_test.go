@@ -0,0 +1,263 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestShadowingService_CreateShadowingSubmission(t *testing.T) {
+	fixedNow := time.Date(2024, 5, 15, 15, 0, 0, 0, time.UTC)
+	episodeID := uuid.New()
+	assetID := uuid.New()
+
+	seriesRepo := &stubSeriesRepo{
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			return &core.Episode{
+				ID: id,
+				Transcript: core.Transcript{
+					Language: "en",
+					Format:   core.TranscriptFormatJSON,
+					Content:  `[{"start":0,"end":2,"text":"Good morning."}]`,
+				},
+			}, nil
+		},
+	}
+	assetRepo := &stubAssetRepo{
+		getAssetByIDFn: func(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
+			return &core.Asset{ID: id, Type: core.AssetTypeAudio, Status: core.AssetStatusReady}, nil
+		},
+	}
+	var scored core.PronunciationScoreParams
+	scorer := scorerFunc(func(ctx context.Context, params core.PronunciationScoreParams) (*core.PronunciationScore, error) {
+		scored = params
+		return &core.PronunciationScore{Score: 87.5, Feedback: "clear"}, nil
+	})
+
+	service := NewShadowingService(&stubShadowingRepo{}, seriesRepo, assetRepo)
+	service.WithClock(func() time.Time { return fixedNow })
+	service.WithScorer(scorer)
+
+	submission, err := service.CreateShadowingSubmission(context.Background(), core.CreateShadowingSubmissionParams{
+		UserID:    "learner",
+		EpisodeID: episodeID,
+		AssetID:   assetID,
+	})
+	if err != nil {
+		t.Fatalf("CreateShadowingSubmission() error = %v", err)
+	}
+	if submission.Status != core.ShadowingSubmissionStatusPending {
+		t.Fatalf("expected pending status, got %v", submission.Status)
+	}
+	if submission.AutoScore == nil || *submission.AutoScore != 87.5 || submission.AutoFeedback != "clear" {
+		t.Fatalf("expected automatic score to be recorded, got %#v", submission)
+	}
+	if scored.ExpectedText != "Good morning." || scored.Language != "en" {
+		t.Fatalf("unexpected scorer params %#v", scored)
+	}
+
+	_, err = service.CreateShadowingSubmission(context.Background(), core.CreateShadowingSubmissionParams{
+		UserID:       "learner",
+		EpisodeID:    episodeID,
+		SegmentIndex: 1,
+		AssetID:      assetID,
+	})
+	if !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected not found for missing segment, got %v", err)
+	}
+
+	assetRepo.getAssetByIDFn = func(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
+		return &core.Asset{ID: id, Type: core.AssetTypeAudio, Status: core.AssetStatusPending}, nil
+	}
+	_, err = service.CreateShadowingSubmission(context.Background(), core.CreateShadowingSubmissionParams{
+		UserID:    "learner",
+		EpisodeID: episodeID,
+		AssetID:   assetID,
+	})
+	if !errors.Is(err, core.ErrUploadInvalidState) {
+		t.Fatalf("expected invalid state for incomplete upload, got %v", err)
+	}
+}
+
+func TestShadowingService_ReviewShadowingSubmission(t *testing.T) {
+	fixedNow := time.Date(2024, 5, 15, 15, 0, 0, 0, time.UTC)
+	id := uuid.New()
+	repo := &stubShadowingRepo{
+		submissions: map[uuid.UUID]core.ShadowingSubmission{
+			id: {ID: id, Status: core.ShadowingSubmissionStatusPending},
+		},
+	}
+	service := NewShadowingService(repo, &stubSeriesRepo{}, &stubAssetRepo{})
+	service.WithClock(func() time.Time { return fixedNow })
+
+	if _, err := service.ReviewShadowingSubmission(context.Background(), core.ReviewShadowingSubmissionParams{SubmissionID: id, ReviewerID: "t1", Score: 101}); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected validation error for out-of-range score, got %v", err)
+	}
+
+	reviewed, err := service.ReviewShadowingSubmission(context.Background(), core.ReviewShadowingSubmissionParams{
+		SubmissionID: id,
+		ReviewerID:   "t1",
+		Score:        80,
+		Comment:      " nice intonation ",
+	})
+	if err != nil {
+		t.Fatalf("ReviewShadowingSubmission() error = %v", err)
+	}
+	if reviewed.Status != core.ShadowingSubmissionStatusReviewed || *reviewed.ReviewScore != 80 || reviewed.ReviewComment != "nice intonation" {
+		t.Fatalf("unexpected review %#v", reviewed)
+	}
+	if reviewed.ReviewedAt == nil || !reviewed.ReviewedAt.Equal(fixedNow) {
+		t.Fatalf("expected reviewed_at %v, got %v", fixedNow, reviewed.ReviewedAt)
+	}
+}
+
+type scorerFunc func(ctx context.Context, params core.PronunciationScoreParams) (*core.PronunciationScore, error)
+
+func (f scorerFunc) ScoreRecording(ctx context.Context, params core.PronunciationScoreParams) (*core.PronunciationScore, error) {
+	return f(ctx, params)
+}
+
+type stubShadowingRepo struct {
+	submissions map[uuid.UUID]core.ShadowingSubmission
+}
+
+func (s *stubShadowingRepo) CreateShadowingSubmission(ctx context.Context, submission core.ShadowingSubmission) (*core.ShadowingSubmission, error) {
+	if s.submissions == nil {
+		s.submissions = map[uuid.UUID]core.ShadowingSubmission{}
+	}
+	s.submissions[submission.ID] = submission
+	return &submission, nil
+}
+
+func (s *stubShadowingRepo) GetShadowingSubmission(ctx context.Context, id uuid.UUID) (*core.ShadowingSubmission, error) {
+	submission, ok := s.submissions[id]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	return &submission, nil
+}
+
+func (s *stubShadowingRepo) UpdateShadowingSubmission(ctx context.Context, submission core.ShadowingSubmission) (*core.ShadowingSubmission, error) {
+	s.submissions[submission.ID] = submission
+	return &submission, nil
+}
+
+func (s *stubShadowingRepo) ListShadowingSubmissions(ctx context.Context, filter core.ShadowingSubmissionFilter) ([]core.ShadowingSubmission, string, error) {
+	return nil, "", nil
+}
+
+type stubAssetRepo struct {
+	getSessionByIDFn func(ctx context.Context, id uuid.UUID) (*core.UploadSession, error)
+	transitionFn     func(ctx context.Context, session core.UploadSession, from ...core.UploadStatus) error
+	listExpiredFn    func(ctx context.Context, now time.Time, limit int) ([]core.UploadSession, error)
+	getAssetByIDFn   func(ctx context.Context, id uuid.UUID) (*core.Asset, error)
+	getAssetByKeyFn  func(ctx context.Context, assetKey string) (*core.Asset, error)
+	createAssetFn    func(ctx context.Context, asset core.Asset) error
+	updateAssetFn    func(ctx context.Context, asset core.Asset) error
+	listAssetsFn     func(ctx context.Context, filter core.AssetListFilter) ([]core.Asset, string, error)
+	deleteAssetFn    func(ctx context.Context, id uuid.UUID, hardDelete bool) (*core.Asset, error)
+	// events records the events handed to UpdateAsset.
+	events []core.Event
+	// versions records the versions handed to CreateAssetVersion.
+	versions []core.AssetVersion
+}
+
+func (s *stubAssetRepo) CreateUploadSession(ctx context.Context, session core.UploadSession) (*core.UploadSession, error) {
+	return &session, nil
+}
+
+func (s *stubAssetRepo) UpdateUploadSession(ctx context.Context, session core.UploadSession) (*core.UploadSession, error) {
+	return &session, nil
+}
+
+func (s *stubAssetRepo) TransitionUploadSession(ctx context.Context, session core.UploadSession, from ...core.UploadStatus) (*core.UploadSession, error) {
+	if s.transitionFn != nil {
+		if err := s.transitionFn(ctx, session, from...); err != nil {
+			return nil, err
+		}
+	}
+	return &session, nil
+}
+
+func (s *stubAssetRepo) GetUploadSessionByID(ctx context.Context, id uuid.UUID) (*core.UploadSession, error) {
+	if s.getSessionByIDFn != nil {
+		return s.getSessionByIDFn(ctx, id)
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubAssetRepo) GetUploadSessionByAssetKey(ctx context.Context, assetKey string) (*core.UploadSession, error) {
+	return nil, core.ErrNotFound
+}
+
+func (s *stubAssetRepo) ListExpiredUploadSessions(ctx context.Context, now time.Time, limit int) ([]core.UploadSession, error) {
+	if s.listExpiredFn != nil {
+		return s.listExpiredFn(ctx, now, limit)
+	}
+	return nil, nil
+}
+
+func (s *stubAssetRepo) ListUploadSessions(ctx context.Context, filter core.UploadListFilter) ([]core.UploadSession, string, error) {
+	return nil, "", nil
+}
+
+func (s *stubAssetRepo) CreateAsset(ctx context.Context, asset core.Asset, events ...core.Event) (*core.Asset, error) {
+	if s.createAssetFn != nil {
+		if err := s.createAssetFn(ctx, asset); err != nil {
+			return nil, err
+		}
+	}
+	return &asset, nil
+}
+
+func (s *stubAssetRepo) UpdateAsset(ctx context.Context, asset core.Asset, events ...core.Event) (*core.Asset, error) {
+	s.events = append(s.events, events...)
+	if s.updateAssetFn != nil {
+		if err := s.updateAssetFn(ctx, asset); err != nil {
+			return nil, err
+		}
+	}
+	return &asset, nil
+}
+
+func (s *stubAssetRepo) GetAssetByID(ctx context.Context, id uuid.UUID) (*core.Asset, error) {
+	if s.getAssetByIDFn != nil {
+		return s.getAssetByIDFn(ctx, id)
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubAssetRepo) GetAssetByKey(ctx context.Context, assetKey string) (*core.Asset, error) {
+	if s.getAssetByKeyFn != nil {
+		return s.getAssetByKeyFn(ctx, assetKey)
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubAssetRepo) ListAssets(ctx context.Context, filter core.AssetListFilter) ([]core.Asset, string, error) {
+	if s.listAssetsFn != nil {
+		return s.listAssetsFn(ctx, filter)
+	}
+	return nil, "", nil
+}
+
+func (s *stubAssetRepo) DeleteAsset(ctx context.Context, id uuid.UUID, hardDelete bool) (*core.Asset, error) {
+	if s.deleteAssetFn != nil {
+		return s.deleteAssetFn(ctx, id, hardDelete)
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubAssetRepo) CreateAssetVersion(ctx context.Context, version core.AssetVersion) error {
+	s.versions = append(s.versions, version)
+	return nil
+}
+
+func (s *stubAssetRepo) ListAssetVersions(ctx context.Context, assetID uuid.UUID) ([]core.AssetVersion, error) {
+	return s.versions, nil
+}
@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// sitemapMaxEntries is the most URLs the sitemap protocol allows in one
+	// sitemap; later series are left out.
+	sitemapMaxEntries = 50000
+	sitemapPageSize   = 100
+)
+
+// SitemapEventTypes lists the events that change which pages the sitemap lists.
+var SitemapEventTypes = []core.EventType{
+	core.EventTypeSeriesPublished,
+	core.EventTypeSeriesUpdated,
+	core.EventTypeEpisodePublished,
+	core.EventTypeEpisodeUnpublished,
+	core.EventTypeEpisodeUpdated,
+	core.EventTypeEpisodeDeleted,
+}
+
+// SitemapService lists the pages of published series and their published
+// episodes, built from URL templates. The sitemap is generated on demand and
+// kept until it is older than maxAge or a publishing event makes it stale.
+type SitemapService struct {
+	series     core.SeriesRepository
+	seriesURL  string
+	episodeURL string
+	maxAge     time.Duration
+	now        func() time.Time
+
+	mu      sync.Mutex
+	current *core.Sitemap
+}
+
+// NewSitemapService constructs a sitemap service. seriesURL may use the
+// {series_id} and {series_slug} placeholders; episodeURL may use those and
+// {episode_id} and {episode_seq}, and episodes are left out when it is empty.
+func NewSitemapService(series core.SeriesRepository, seriesURL, episodeURL string, maxAge time.Duration) *SitemapService {
+	return &SitemapService{
+		series:     series,
+		seriesURL:  seriesURL,
+		episodeURL: episodeURL,
+		maxAge:     maxAge,
+		now:        time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *SitemapService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.SitemapService = (*SitemapService)(nil)
+
+// Sitemap returns the current sitemap, generating it when it is missing or
+// stale.
+func (s *SitemapService) Sitemap(ctx context.Context) (*core.Sitemap, error) {
+	if s.seriesURL == "" {
+		return nil, fmt.Errorf("%w: sitemap is not configured", core.ErrInvalidState)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.current != nil && s.now().Sub(s.current.GeneratedAt) < s.maxAge {
+		return s.current, nil
+	}
+
+	sitemap, err := s.generate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.current = sitemap
+	return sitemap, nil
+}
+
+// HandleEvent drops the cached sitemap when content is published,
+// unpublished or changed, so the next request regenerates it.
+func (s *SitemapService) HandleEvent(ctx context.Context, envelope core.EventEnvelope) error {
+	s.mu.Lock()
+	s.current = nil
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *SitemapService) generate(ctx context.Context) (*core.Sitemap, error) {
+	sitemap := &core.Sitemap{GeneratedAt: s.now()}
+	pageToken := ""
+	for {
+		page, nextToken, err := s.series.ListSeries(ctx, core.SeriesListFilter{
+			PageSize:        sitemapPageSize,
+			PageToken:       pageToken,
+			Statuses:        []core.SeriesStatus{core.SeriesStatusPublished},
+			IncludeEpisodes: s.episodeURL != "",
+			OmitTranscripts: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, series := range page {
+			if series.Status != core.SeriesStatusPublished {
+				continue
+			}
+			if len(sitemap.Entries) >= sitemapMaxEntries {
+				return sitemap, nil
+			}
+			sitemap.Entries = append(sitemap.Entries, core.SitemapEntry{
+				Loc:     expandPageURL(s.seriesURL, series, core.Episode{}),
+				LastMod: series.UpdatedAt,
+			})
+			for _, episode := range series.Episodes {
+				if episode.Status != core.EpisodeStatusPublished || episode.DeletedAt != nil {
+					continue
+				}
+				if len(sitemap.Entries) >= sitemapMaxEntries {
+					return sitemap, nil
+				}
+				sitemap.Entries = append(sitemap.Entries, core.SitemapEntry{
+					Loc:     expandPageURL(s.episodeURL, series, episode),
+					LastMod: episode.UpdatedAt,
+				})
+			}
+		}
+
+		if nextToken == "" {
+			return sitemap, nil
+		}
+		pageToken = nextToken
+	}
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestSitemapService(t *testing.T) {
+	updated := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	seriesID, episodeID := uuid.New(), uuid.New()
+	calls := 0
+	repo := &stubSeriesRepo{
+		listSeriesFn: func(ctx context.Context, filter core.SeriesListFilter) ([]core.Series, string, error) {
+			calls++
+			if len(filter.Statuses) != 1 || filter.Statuses[0] != core.SeriesStatusPublished || !filter.IncludeEpisodes || !filter.OmitTranscripts {
+				t.Fatalf("unexpected filter %+v", filter)
+			}
+			if filter.PageToken == "" {
+				return []core.Series{{
+					ID: seriesID, Slug: "coffee talk", Status: core.SeriesStatusPublished, UpdatedAt: updated,
+					Episodes: []core.Episode{
+						{ID: episodeID, Seq: 2, Status: core.EpisodeStatusPublished, UpdatedAt: updated.Add(time.Hour)},
+						{ID: uuid.New(), Seq: 3, Status: core.EpisodeStatusDraft},
+					},
+				}}, "1", nil
+			}
+			return []core.Series{{ID: uuid.New(), Slug: "draft", Status: core.SeriesStatusDraft}}, "", nil
+		},
+	}
+	now := updated
+	service := NewSitemapService(repo, "https://example.com/series/{series_slug}", "https://example.com/s/{series_id}/{episode_seq}?e={episode_id}", time.Hour)
+	service.WithClock(func() time.Time { return now })
+	ctx := context.Background()
+
+	sitemap, err := service.Sitemap(ctx)
+	if err != nil {
+		t.Fatalf("Sitemap() error = %v", err)
+	}
+	want := []core.SitemapEntry{
+		{Loc: "https://example.com/series/coffee%20talk", LastMod: updated},
+		{Loc: "https://example.com/s/" + seriesID.String() + "/2?e=" + episodeID.String(), LastMod: updated.Add(time.Hour)},
+	}
+	if len(sitemap.Entries) != len(want) || sitemap.Entries[0] != want[0] || sitemap.Entries[1] != want[1] {
+		t.Fatalf("unexpected entries %+v", sitemap.Entries)
+	}
+	if calls != 2 {
+		t.Fatalf("expected every page to be listed, got %d calls", calls)
+	}
+
+	if _, err := service.Sitemap(ctx); err != nil || calls != 2 {
+		t.Fatalf("expected the sitemap to be served from memory, got %d calls and %v", calls, err)
+	}
+	if err := service.HandleEvent(ctx, core.EventEnvelope{Event: core.SeriesPublished{}}); err != nil {
+		t.Fatalf("HandleEvent() error = %v", err)
+	}
+	if _, err := service.Sitemap(ctx); err != nil || calls != 4 {
+		t.Fatalf("expected an event to regenerate the sitemap, got %d calls and %v", calls, err)
+	}
+	now = now.Add(2 * time.Hour)
+	if _, err := service.Sitemap(ctx); err != nil || calls != 6 {
+		t.Fatalf("expected an old sitemap to be regenerated, got %d calls and %v", calls, err)
+	}
+
+	disabled := NewSitemapService(repo, "", "", time.Hour)
+	if _, err := disabled.Sitemap(ctx); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected an unconfigured sitemap to fail, got %v", err)
+	}
+}
@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+var (
+	planCodePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+// SubscriptionService manages plans and learner subscriptions and answers
+// entitlement questions for gated content.
+type SubscriptionService struct {
+	repo core.SubscriptionRepository
+	now  func() time.Time
+}
+
+// NewSubscriptionService constructs a subscription service backed by the repository.
+func NewSubscriptionService(repo core.SubscriptionRepository) *SubscriptionService {
+	return &SubscriptionService{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *SubscriptionService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.SubscriptionService = (*SubscriptionService)(nil)
+
+// CreatePlan validates and stores a new plan.
+func (s *SubscriptionService) CreatePlan(ctx context.Context, draft core.PlanDraft) (*core.Plan, error) {
+	plan := core.Plan{
+		ID:          uuid.New(),
+		Code:        draft.Code,
+		Name:        draft.Name,
+		Description: draft.Description,
+		PriceCents:  draft.PriceCents,
+		Currency:    draft.Currency,
+		Interval:    draft.Interval,
+		Active:      draft.Active,
+	}
+	if err := normalizePlan(&plan); err != nil {
+		return nil, err
+	}
+	return s.repo.CreatePlan(ctx, plan)
+}
+
+// GetPlan returns a plan by identifier.
+func (s *SubscriptionService) GetPlan(ctx context.Context, id uuid.UUID) (*core.Plan, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: plan id required", core.ErrValidation)
+	}
+	return s.repo.GetPlan(ctx, id)
+}
+
+// UpdatePlan validates and persists plan changes. Existing subscriptions keep
+// their current period; price changes apply from the next renewal.
+func (s *SubscriptionService) UpdatePlan(ctx context.Context, plan core.Plan) (*core.Plan, error) {
+	if plan.ID == uuid.Nil {
+		return nil, fmt.Errorf("%w: plan id required", core.ErrValidation)
+	}
+	if err := normalizePlan(&plan); err != nil {
+		return nil, err
+	}
+	return s.repo.UpdatePlan(ctx, plan)
+}
+
+// ListPlans returns purchasable plans, cheapest first.
+func (s *SubscriptionService) ListPlans(ctx context.Context, includeInactive bool) ([]core.Plan, error) {
+	return s.repo.ListPlans(ctx, includeInactive)
+}
+
+// Subscribe starts a subscription to an active plan for one billing period.
+func (s *SubscriptionService) Subscribe(ctx context.Context, userID string, planID uuid.UUID) (*core.Subscription, error) {
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", core.ErrValidation)
+	}
+
+	plan, err := s.GetPlan(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	if !plan.Active {
+		return nil, fmt.Errorf("%w: plan %q is no longer offered", core.ErrInvalidState, plan.Code)
+	}
+
+	if _, err := s.ActiveSubscription(ctx, userID); err == nil {
+		return nil, fmt.Errorf("%w: learner already has an active subscription", core.ErrInvalidState)
+	} else if !isNotFound(err) {
+		return nil, err
+	}
+
+	now := s.now().UTC()
+	return s.repo.CreateSubscription(ctx, core.Subscription{
+		ID:                 uuid.New(),
+		UserID:             userID,
+		PlanID:             plan.ID,
+		Status:             core.SubscriptionStatusActive,
+		CurrentPeriodStart: now,
+		CurrentPeriodEnd:   addBillingInterval(now, plan.Interval),
+	})
+}
+
+// CancelSubscription stops renewal. Access continues until the current period ends.
+func (s *SubscriptionService) CancelSubscription(ctx context.Context, id uuid.UUID) (*core.Subscription, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: subscription id required", core.ErrValidation)
+	}
+
+	subscription, err := s.repo.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now().UTC()
+	if subscription.Status != core.SubscriptionStatusActive || !subscription.Entitled(now) {
+		return nil, fmt.Errorf("%w: only active subscriptions can be canceled", core.ErrInvalidState)
+	}
+	if subscription.BillingProvider != "" {
+		// Canceling locally would leave the processor charging the learner.
+		return nil, fmt.Errorf("%w: subscription is billed by %s and must be canceled there", core.ErrInvalidState, subscription.BillingProvider)
+	}
+
+	subscription.Status = core.SubscriptionStatusCanceled
+	subscription.CanceledAt = &now
+	return s.repo.UpdateSubscription(ctx, *subscription)
+}
+
+// ListSubscriptions returns the learner's subscriptions, newest first.
+// Subscriptions whose period has ended are reported as expired.
+func (s *SubscriptionService) ListSubscriptions(ctx context.Context, userID string) ([]core.Subscription, error) {
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", core.ErrValidation)
+	}
+
+	subscriptions, err := s.repo.ListSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	return lo.Map(subscriptions, func(subscription core.Subscription, _ int) core.Subscription {
+		if subscription.Status != core.SubscriptionStatusExpired && !subscription.Entitled(now) {
+			subscription.Status = core.SubscriptionStatusExpired
+		}
+		return subscription
+	}), nil
+}
+
+// ActiveSubscription returns the subscription currently entitling the learner.
+func (s *SubscriptionService) ActiveSubscription(ctx context.Context, userID string) (*core.Subscription, error) {
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return nil, core.ErrNotFound
+	}
+
+	return findEntitledSubscription(ctx, s.repo, userID, s.now())
+}
+
+// findEntitledSubscription returns the learner's subscription granting access at now.
+func findEntitledSubscription(ctx context.Context, repo core.SubscriptionRepository, userID string, now time.Time) (*core.Subscription, error) {
+	subscriptions, err := repo.ListSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	active, ok := lo.Find(subscriptions, func(subscription core.Subscription) bool {
+		return subscription.Entitled(now)
+	})
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	return &active, nil
+}
+
+func normalizePlan(plan *core.Plan) error {
+	plan.Code = strings.ToLower(strings.TrimSpace(plan.Code))
+	plan.Name = strings.TrimSpace(plan.Name)
+	plan.Description = strings.TrimSpace(plan.Description)
+	plan.Currency = strings.ToUpper(strings.TrimSpace(plan.Currency))
+
+	switch {
+	case !planCodePattern.MatchString(plan.Code):
+		return fmt.Errorf("%w: plan code must be lowercase letters, digits, '-' or '_'", core.ErrValidation)
+	case plan.Name == "":
+		return fmt.Errorf("%w: plan name is required", core.ErrValidation)
+	case plan.PriceCents < 0:
+		return fmt.Errorf("%w: plan price must not be negative", core.ErrValidation)
+	case !currencyPattern.MatchString(plan.Currency):
+		return fmt.Errorf("%w: currency must be an ISO 4217 code", core.ErrValidation)
+	case plan.Interval != core.BillingIntervalMonth && plan.Interval != core.BillingIntervalYear:
+		return fmt.Errorf("%w: plan billing interval required", core.ErrValidation)
+	}
+	return nil
+}
+
+func addBillingInterval(start time.Time, interval core.BillingInterval) time.Time {
+	if interval == core.BillingIntervalYear {
+		return start.AddDate(1, 0, 0)
+	}
+	return start.AddDate(0, 1, 0)
+}
@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubSubscriptionRepo struct {
+	plans         map[uuid.UUID]core.Plan
+	subscriptions []core.Subscription
+}
+
+func newStubSubscriptionRepo(plans ...core.Plan) *stubSubscriptionRepo {
+	repo := &stubSubscriptionRepo{plans: make(map[uuid.UUID]core.Plan)}
+	for _, plan := range plans {
+		repo.plans[plan.ID] = plan
+	}
+	return repo
+}
+
+func (s *stubSubscriptionRepo) CreatePlan(ctx context.Context, plan core.Plan) (*core.Plan, error) {
+	s.plans[plan.ID] = plan
+	return &plan, nil
+}
+
+func (s *stubSubscriptionRepo) GetPlan(ctx context.Context, id uuid.UUID) (*core.Plan, error) {
+	plan, ok := s.plans[id]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	return &plan, nil
+}
+
+func (s *stubSubscriptionRepo) UpdatePlan(ctx context.Context, plan core.Plan) (*core.Plan, error) {
+	s.plans[plan.ID] = plan
+	return &plan, nil
+}
+
+func (s *stubSubscriptionRepo) ListPlans(ctx context.Context, includeInactive bool) ([]core.Plan, error) {
+	var plans []core.Plan
+	for _, plan := range s.plans {
+		if plan.Active || includeInactive {
+			plans = append(plans, plan)
+		}
+	}
+	return plans, nil
+}
+
+func (s *stubSubscriptionRepo) CreateSubscription(ctx context.Context, subscription core.Subscription) (*core.Subscription, error) {
+	s.subscriptions = append([]core.Subscription{subscription}, s.subscriptions...)
+	return &subscription, nil
+}
+
+func (s *stubSubscriptionRepo) GetSubscription(ctx context.Context, id uuid.UUID) (*core.Subscription, error) {
+	for _, subscription := range s.subscriptions {
+		if subscription.ID == id {
+			return &subscription, nil
+		}
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubSubscriptionRepo) UpdateSubscription(ctx context.Context, subscription core.Subscription) (*core.Subscription, error) {
+	for i := range s.subscriptions {
+		if s.subscriptions[i].ID == subscription.ID {
+			s.subscriptions[i] = subscription
+			return &subscription, nil
+		}
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubSubscriptionRepo) FindSubscriptionByExternalID(ctx context.Context, provider, externalID string) (*core.Subscription, error) {
+	for _, subscription := range s.subscriptions {
+		if subscription.BillingProvider == provider && subscription.ExternalID == externalID {
+			return &subscription, nil
+		}
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubSubscriptionRepo) ListSubscriptions(ctx context.Context, userID string) ([]core.Subscription, error) {
+	var subscriptions []core.Subscription
+	for _, subscription := range s.subscriptions {
+		if subscription.UserID == userID {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	return subscriptions, nil
+}
+
+func TestSubscriptionService_SubscribeAndCancel(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	monthly := core.Plan{ID: uuid.New(), Code: "monthly", Interval: core.BillingIntervalMonth, Active: true}
+	retired := core.Plan{ID: uuid.New(), Code: "legacy", Interval: core.BillingIntervalYear}
+
+	repo := newStubSubscriptionRepo(monthly, retired)
+	service := NewSubscriptionService(repo)
+	service.WithClock(func() time.Time { return now })
+	ctx := context.Background()
+
+	if _, err := service.Subscribe(ctx, "learner", retired.ID); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("Subscribe(retired) error = %v, want ErrInvalidState", err)
+	}
+	if _, err := service.Subscribe(ctx, " ", monthly.ID); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("Subscribe(blank user) error = %v, want ErrValidation", err)
+	}
+
+	subscription, err := service.Subscribe(ctx, "learner", monthly.ID)
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+	if subscription.Status != core.SubscriptionStatusActive {
+		t.Fatalf("status = %v, want active", subscription.Status)
+	}
+	if want := time.Date(2024, 2, 15, 12, 0, 0, 0, time.UTC); !subscription.CurrentPeriodEnd.Equal(want) {
+		t.Fatalf("period end = %v, want %v", subscription.CurrentPeriodEnd, want)
+	}
+	if _, err := service.Subscribe(ctx, "learner", monthly.ID); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("second Subscribe() error = %v, want ErrInvalidState", err)
+	}
+
+	canceled, err := service.CancelSubscription(ctx, subscription.ID)
+	if err != nil {
+		t.Fatalf("CancelSubscription() error = %v", err)
+	}
+	if canceled.Status != core.SubscriptionStatusCanceled || canceled.CanceledAt == nil {
+		t.Fatalf("canceled subscription = %#v", canceled)
+	}
+	if _, err := service.CancelSubscription(ctx, subscription.ID); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("second CancelSubscription() error = %v, want ErrInvalidState", err)
+	}
+
+	// Canceling keeps access until the paid period ends.
+	if _, err := service.ActiveSubscription(ctx, "learner"); err != nil {
+		t.Fatalf("ActiveSubscription() after cancel error = %v", err)
+	}
+}
+
+func TestSubscriptionService_ExpiredSubscriptions(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	lapsed := core.Subscription{
+		ID:               uuid.New(),
+		UserID:           "learner",
+		Status:           core.SubscriptionStatusActive,
+		CurrentPeriodEnd: now.Add(-time.Second),
+	}
+
+	repo := newStubSubscriptionRepo()
+	repo.subscriptions = []core.Subscription{lapsed}
+	service := NewSubscriptionService(repo)
+	service.WithClock(func() time.Time { return now })
+	ctx := context.Background()
+
+	if _, err := service.ActiveSubscription(ctx, "learner"); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("ActiveSubscription() error = %v, want ErrNotFound", err)
+	}
+	if _, err := service.ActiveSubscription(ctx, ""); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("ActiveSubscription(anonymous) error = %v, want ErrNotFound", err)
+	}
+
+	subscriptions, err := service.ListSubscriptions(ctx, "learner")
+	if err != nil {
+		t.Fatalf("ListSubscriptions() error = %v", err)
+	}
+	if len(subscriptions) != 1 || subscriptions[0].Status != core.SubscriptionStatusExpired {
+		t.Fatalf("subscriptions = %#v, want one expired", subscriptions)
+	}
+	if _, err := service.CancelSubscription(ctx, lapsed.ID); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("CancelSubscription(lapsed) error = %v, want ErrInvalidState", err)
+	}
+}
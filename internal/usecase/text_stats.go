@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	// defaultTopWords is the number of word frequencies returned by default.
+	defaultTopWords = 20
+	// maxTopWords caps the word frequencies returned.
+	maxTopWords = 100
+)
+
+// episodeTextStats counts the words of the transcript of an episode.
+func episodeTextStats(episode core.Episode, opts core.TextStatsOptions) *core.EpisodeTextStats {
+	text := core.TranscriptText(episode.Transcript)
+	words := splitWords(text)
+	readability := measureReadability(text)
+
+	counts := map[string]int{}
+	for _, word := range words {
+		counts[word]++
+	}
+	stats := &core.EpisodeTextStats{
+		EpisodeID:       episode.ID,
+		WordCount:       len(words),
+		UniqueWordCount: len(counts),
+		SentenceCount:   readability.sentences,
+		EstimatedLevel:  episode.EstimatedLevel,
+	}
+	if stats.SentenceCount > 0 {
+		stats.WordsPerSentence = float64(stats.WordCount) / float64(stats.SentenceCount)
+	}
+	if duration := speakingDuration(episode); duration > 0 {
+		stats.WordsPerMinute = float64(stats.WordCount) / duration.Minutes()
+	}
+
+	for word, count := range counts {
+		if opts.ExcludeCommonWords {
+			if _, common := basicEnglishWords[word]; common {
+				continue
+			}
+		}
+		stats.TopWords = append(stats.TopWords, core.WordFrequency{Word: word, Count: count})
+	}
+	slices.SortFunc(stats.TopWords, func(a, b core.WordFrequency) int {
+		return cmp.Or(b.Count-a.Count, strings.Compare(a.Word, b.Word))
+	})
+	stats.TopWords = stats.TopWords[:min(len(stats.TopWords), opts.TopWords)]
+	return stats
+}
+
+// speakingDuration is the episode duration, or the span of the transcript
+// timings when the episode has none.
+func speakingDuration(episode core.Episode) time.Duration {
+	if episode.Duration > 0 {
+		return episode.Duration
+	}
+	segments, err := core.ParseTranscriptSegments(episode.Transcript)
+	if err != nil {
+		return 0
+	}
+	return segments[len(segments)-1].End - segments[0].Start
+}
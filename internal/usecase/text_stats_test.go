@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestSeriesService_GetEpisodeTextStats(t *testing.T) {
+	t.Parallel()
+
+	episode := core.Episode{
+		ID: uuid.New(),
+		Transcript: core.Transcript{
+			Format: core.TranscriptFormatSRT,
+			Content: "1\n00:00:00,000 --> 00:00:05,000\nThe coffee is hot. The coffee is good!\n\n" +
+				"2\n00:00:05,000 --> 00:00:30,000\nI don't like cold coffee, but I like espresso.\n",
+		},
+		EstimatedLevel: "A1",
+	}
+	svc := NewSeriesService(&stubSeriesRepo{
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			if id != episode.ID {
+				return nil, core.ErrNotFound
+			}
+			result := episode
+			return &result, nil
+		},
+	})
+
+	tests := []struct {
+		name     string
+		duration time.Duration
+		opts     core.TextStatsOptions
+		wantWPM  float64
+		wantTop  []core.WordFrequency
+	}{
+		{
+			name:    "timings without a duration",
+			opts:    core.TextStatsOptions{TopWords: 3},
+			wantWPM: 34,
+			wantTop: []core.WordFrequency{{Word: "coffee", Count: 3}, {Word: "i", Count: 2}, {Word: "is", Count: 2}},
+		},
+		{
+			name:     "duration and common words excluded",
+			duration: 2 * time.Minute,
+			opts:     core.TextStatsOptions{ExcludeCommonWords: true},
+			wantWPM:  8.5,
+			wantTop:  []core.WordFrequency{{Word: "espresso", Count: 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			episode.Duration = tt.duration
+			stats, err := svc.GetEpisodeTextStats(context.Background(), episode.ID, tt.opts)
+			if err != nil {
+				t.Fatalf("GetEpisodeTextStats() error = %v", err)
+			}
+			if stats.WordCount != 17 || stats.UniqueWordCount != 11 || stats.SentenceCount != 3 || stats.EstimatedLevel != "A1" {
+				t.Fatalf("unexpected stats %+v", stats)
+			}
+			if stats.WordsPerMinute != tt.wantWPM {
+				t.Fatalf("WordsPerMinute = %v, want %v", stats.WordsPerMinute, tt.wantWPM)
+			}
+			if !reflect.DeepEqual(stats.TopWords, tt.wantTop) {
+				t.Fatalf("TopWords = %+v, want %+v", stats.TopWords, tt.wantTop)
+			}
+		})
+	}
+
+	if _, err := svc.GetEpisodeTextStats(context.Background(), uuid.New(), core.TextStatsOptions{}); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
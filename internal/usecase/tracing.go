@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer records usecase spans through the global tracer provider, so the
+// spans end up wherever the application configured tracing to go.
+var tracer = otel.Tracer("github.com/eslsoft/lession/internal/usecase")
+
+// endSpan marks the span failed when err is set and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
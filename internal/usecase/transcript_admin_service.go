@@ -0,0 +1,322 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	transcriptScanBatchSize       = 100
+	maxTranscriptPreviewEpisodes  = 200
+	maxTranscriptPreviewLines     = 20
+	transcriptTimingSkipReason    = "replacement would change segment timing or structure"
+	transcriptConflictSkipReason  = "transcript was edited while the job was running"
+	transcriptReplaceErrorMaxSize = 1024
+)
+
+// TranscriptAdminService runs bulk maintenance jobs over episode transcripts.
+type TranscriptAdminService struct {
+	repo        core.TranscriptAdminRepository
+	seriesCache core.SeriesCacheInvalidator
+	dispatch    func(func())
+	now         func() time.Time
+}
+
+// NewTranscriptAdminService constructs a transcript admin service. Jobs run
+// in the background once accepted.
+func NewTranscriptAdminService(repo core.TranscriptAdminRepository) *TranscriptAdminService {
+	return &TranscriptAdminService{
+		repo:     repo,
+		dispatch: func(fn func()) { go fn() },
+		now:      time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *TranscriptAdminService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+// WithDispatcher overrides how accepted jobs are started, e.g. synchronously in tests.
+func (s *TranscriptAdminService) WithDispatcher(fn func(func())) {
+	if fn != nil {
+		s.dispatch = fn
+	}
+}
+
+// WithSeriesCache sets the cache to invalidate for series whose transcripts
+// a job changes.
+func (s *TranscriptAdminService) WithSeriesCache(cache core.SeriesCacheInvalidator) {
+	s.seriesCache = cache
+}
+
+var _ core.TranscriptAdminService = (*TranscriptAdminService)(nil)
+
+// SearchReplaceTranscripts validates and enqueues a bulk search-and-replace job.
+// Dry-run jobs only record the diffs they would apply.
+func (s *TranscriptAdminService) SearchReplaceTranscripts(ctx context.Context, params core.SearchReplaceTranscriptsParams) (*core.TranscriptReplaceJob, error) {
+	if params.Pattern == "" {
+		return nil, fmt.Errorf("%w: pattern is required", core.ErrValidation)
+	}
+	re, err := compileTranscriptPattern(params.Pattern, params.Regex, params.CaseInsensitive)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.repo.CreateTranscriptReplaceJob(ctx, core.TranscriptReplaceJob{
+		ID:              uuid.New(),
+		Pattern:         params.Pattern,
+		Replacement:     params.Replacement,
+		Regex:           params.Regex,
+		CaseInsensitive: params.CaseInsensitive,
+		Scope: core.TranscriptReplaceScope{
+			SeriesID: params.Scope.SeriesID,
+			Language: strings.TrimSpace(params.Scope.Language),
+		},
+		DryRun:      params.DryRun,
+		RequestedBy: strings.TrimSpace(params.RequestedBy),
+		Status:      core.TranscriptReplaceJobStatusPending,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	queued := *job
+	runCtx := context.WithoutCancel(ctx)
+	s.dispatch(func() { s.runJob(runCtx, queued, re) })
+
+	return job, nil
+}
+
+// GetTranscriptReplaceJob returns a job with its progress and diff preview.
+func (s *TranscriptAdminService) GetTranscriptReplaceJob(ctx context.Context, id uuid.UUID) (*core.TranscriptReplaceJob, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: job id required", core.ErrValidation)
+	}
+	return s.repo.GetTranscriptReplaceJob(ctx, id)
+}
+
+// ListTranscriptReplaceJobs returns jobs, newest first.
+func (s *TranscriptAdminService) ListTranscriptReplaceJobs(ctx context.Context, filter core.TranscriptReplaceJobFilter) ([]core.TranscriptReplaceJob, string, error) {
+	return s.repo.ListTranscriptReplaceJobs(ctx, filter)
+}
+
+// RollbackTranscriptReplaceJob restores the transcripts a completed job changed.
+// Transcripts edited after the job ran are left untouched and reported as conflicts.
+func (s *TranscriptAdminService) RollbackTranscriptReplaceJob(ctx context.Context, id uuid.UUID) (*core.TranscriptReplaceJob, *core.TranscriptRollbackResult, error) {
+	job, err := s.GetTranscriptReplaceJob(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if job.DryRun {
+		return nil, nil, fmt.Errorf("%w: dry-run jobs have nothing to roll back", core.ErrInvalidState)
+	}
+	if job.Status != core.TranscriptReplaceJobStatusSucceeded {
+		return nil, nil, fmt.Errorf("%w: only succeeded jobs can be rolled back", core.ErrInvalidState)
+	}
+
+	result, err := s.repo.RollbackTranscriptRevisions(ctx, job.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.invalidateSeries(ctx, result.SeriesIDs)
+
+	now := s.now().UTC()
+	job.Status = core.TranscriptReplaceJobStatusRolledBack
+	job.RolledBackAt = &now
+	updated, err := s.repo.UpdateTranscriptReplaceJob(ctx, *job)
+	if err != nil {
+		return nil, nil, err
+	}
+	return updated, result, nil
+}
+
+func (s *TranscriptAdminService) runJob(ctx context.Context, job core.TranscriptReplaceJob, re *regexp.Regexp) {
+	started := s.now().UTC()
+	job.Status = core.TranscriptReplaceJobStatusRunning
+	job.StartedAt = &started
+	if _, err := s.repo.UpdateTranscriptReplaceJob(ctx, job); err != nil {
+		return
+	}
+
+	err := s.scanTranscripts(ctx, &job, re)
+
+	finished := s.now().UTC()
+	job.FinishedAt = &finished
+	job.Status = core.TranscriptReplaceJobStatusSucceeded
+	if err != nil {
+		job.Status = core.TranscriptReplaceJobStatusFailed
+		job.Error = lo.Substring(err.Error(), 0, transcriptReplaceErrorMaxSize)
+	}
+	_, _ = s.repo.UpdateTranscriptReplaceJob(ctx, job)
+}
+
+func (s *TranscriptAdminService) scanTranscripts(ctx context.Context, job *core.TranscriptReplaceJob, re *regexp.Regexp) error {
+	cursor := uuid.Nil
+	for {
+		episodes, err := s.repo.ListTranscriptEpisodes(ctx, job.Scope, cursor, transcriptScanBatchSize)
+		if err != nil {
+			return err
+		}
+
+		var (
+			revisions []core.TranscriptRevision
+			changes   = make(map[uuid.UUID]*core.TranscriptChange)
+		)
+		for _, episode := range episodes {
+			job.EpisodesScanned++
+			after, change := rewriteTranscript(episode.ID, episode.Transcript, re, job.Replacement, job.Regex)
+			if change.Matches == 0 {
+				continue
+			}
+			job.Matches += change.Matches
+			changes[episode.ID] = change
+
+			if change.SkipReason != "" || after == episode.Transcript.Content {
+				continue
+			}
+			job.EpisodesChanged++
+			revisions = append(revisions, core.TranscriptRevision{
+				ID:        uuid.New(),
+				JobID:     job.ID,
+				EpisodeID: episode.ID,
+				Before:    episode.Transcript.Content,
+				After:     after,
+			})
+		}
+
+		if !job.DryRun && len(revisions) > 0 {
+			conflicts, err := s.repo.ApplyTranscriptRevisions(ctx, revisions)
+			if err != nil {
+				return err
+			}
+			for _, id := range conflicts {
+				job.EpisodesChanged--
+				changes[id].SkipReason = transcriptConflictSkipReason
+			}
+			s.invalidateSeries(ctx, changedSeries(episodes, revisions, conflicts))
+		}
+
+		for _, episode := range episodes {
+			if change, ok := changes[episode.ID]; ok && len(job.Changes) < maxTranscriptPreviewEpisodes {
+				job.Changes = append(job.Changes, *change)
+			}
+		}
+
+		if len(episodes) < transcriptScanBatchSize {
+			return nil
+		}
+		cursor = episodes[len(episodes)-1].ID
+	}
+}
+
+// invalidateSeries drops cached reads of series whose transcripts changed.
+func (s *TranscriptAdminService) invalidateSeries(ctx context.Context, ids []uuid.UUID) {
+	if s.seriesCache != nil && len(ids) > 0 {
+		s.seriesCache.InvalidateSeries(ctx, ids...)
+	}
+}
+
+// changedSeries returns the series of the episodes whose revisions applied.
+func changedSeries(episodes []core.Episode, revisions []core.TranscriptRevision, conflicts []uuid.UUID) []uuid.UUID {
+	seriesOf := lo.SliceToMap(episodes, func(episode core.Episode) (uuid.UUID, uuid.UUID) {
+		return episode.ID, episode.SeriesID
+	})
+	applied := lo.Without(lo.Map(revisions, func(revision core.TranscriptRevision, _ int) uuid.UUID {
+		return revision.EpisodeID
+	}), conflicts...)
+	return lo.Uniq(lo.Map(applied, func(id uuid.UUID, _ int) uuid.UUID { return seriesOf[id] }))
+}
+
+func compileTranscriptPattern(pattern string, regex, caseInsensitive bool) (*regexp.Regexp, error) {
+	expr := pattern
+	if !regex {
+		expr = regexp.QuoteMeta(pattern)
+	}
+	if caseInsensitive {
+		expr = "(?i)" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid pattern: %v", core.ErrValidation, err)
+	}
+	if re.MatchString("") {
+		return nil, fmt.Errorf("%w: pattern must not match empty text", core.ErrValidation)
+	}
+	return re, nil
+}
+
+// rewriteTranscript applies re line by line. Regex replacements may reference
+// capture groups ($1); literal replacements are inserted verbatim. SRT cue
+// numbers and timing lines are never edited, and edits to timed transcripts
+// that would alter their segment timing are reported as skipped.
+func rewriteTranscript(episodeID uuid.UUID, transcript core.Transcript, re *regexp.Regexp, replacement string, expand bool) (string, *core.TranscriptChange) {
+	change := &core.TranscriptChange{EpisodeID: episodeID}
+	lines := strings.Split(transcript.Content, "\n")
+	for i, line := range lines {
+		if transcript.Format == core.TranscriptFormatSRT && isSRTControlLine(line) {
+			continue
+		}
+		matches := len(re.FindAllStringIndex(line, -1))
+		if matches == 0 {
+			continue
+		}
+		change.Matches += matches
+
+		rewritten := lo.Ternary(expand, re.ReplaceAllString(line, replacement), re.ReplaceAllLiteralString(line, replacement))
+		if rewritten == line {
+			continue
+		}
+		lines[i] = rewritten
+		if len(change.Lines) < maxTranscriptPreviewLines {
+			change.Lines = append(change.Lines, core.TranscriptLineDiff{Line: i + 1, Before: line, After: rewritten})
+		}
+	}
+
+	after := strings.Join(lines, "\n")
+	if change.Matches > 0 && !sameTranscriptTiming(transcript, after) {
+		change.SkipReason = transcriptTimingSkipReason
+	}
+	return after, change
+}
+
+func isSRTControlLine(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	if strings.Contains(trimmed, "-->") {
+		return true
+	}
+	return trimmed != "" && strings.Trim(trimmed, "0123456789") == ""
+}
+
+func sameTranscriptTiming(transcript core.Transcript, content string) bool {
+	if transcript.Format != core.TranscriptFormatSRT && transcript.Format != core.TranscriptFormatJSON {
+		return true
+	}
+	before, err := core.ParseTranscriptSegments(transcript)
+	if err != nil {
+		// The original was not parseable either; there is no timing to preserve.
+		return true
+	}
+	updated := transcript
+	updated.Content = content
+	after, err := core.ParseTranscriptSegments(updated)
+	if err != nil || len(after) != len(before) {
+		return false
+	}
+	for i := range before {
+		if before[i].Start != after[i].Start || before[i].End != after[i].End {
+			return false
+		}
+	}
+	return true
+}
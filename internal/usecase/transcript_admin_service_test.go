@@ -0,0 +1,236 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestRewriteTranscript(t *testing.T) {
+	srt := "1\n00:00:01,000 --> 00:00:02,000\nteh cat\n\n2\n00:00:03,000 --> 00:00:04,000\nteh dog\n"
+
+	tests := []struct {
+		name        string
+		transcript  core.Transcript
+		pattern     string
+		replacement string
+		regex       bool
+		insensitive bool
+		wantContent string
+		wantMatches int
+		wantSkip    bool
+	}{
+		{
+			name:        "literal plain text",
+			transcript:  core.Transcript{Format: core.TranscriptFormatPlain, Content: "a.b a.b\naxb"},
+			pattern:     "a.b",
+			replacement: "$1",
+			wantContent: "$1 $1\naxb",
+			wantMatches: 2,
+		},
+		{
+			name:        "regex with groups",
+			transcript:  core.Transcript{Format: core.TranscriptFormatPlain, Content: "Mr Smith and mr Jones"},
+			pattern:     `mr (\w+)`,
+			replacement: "Mr. $1",
+			regex:       true,
+			insensitive: true,
+			wantContent: "Mr. Smith and Mr. Jones",
+			wantMatches: 2,
+		},
+		{
+			name:        "srt timing lines untouched",
+			transcript:  core.Transcript{Format: core.TranscriptFormatSRT, Content: srt},
+			pattern:     "teh",
+			replacement: "the",
+			wantContent: "1\n00:00:01,000 --> 00:00:02,000\nthe cat\n\n2\n00:00:03,000 --> 00:00:04,000\nthe dog\n",
+			wantMatches: 2,
+		},
+		{
+			name:        "srt digits are never matched",
+			transcript:  core.Transcript{Format: core.TranscriptFormatSRT, Content: srt},
+			pattern:     "2",
+			replacement: "9",
+			wantContent: srt,
+			wantMatches: 0,
+		},
+		{
+			name:        "json edit breaking timing is skipped",
+			transcript:  core.Transcript{Format: core.TranscriptFormatJSON, Content: `[{"start":1,"end":2,"text":"hi"}]`},
+			pattern:     `"end":2`,
+			replacement: `"end":5`,
+			wantMatches: 1,
+			wantSkip:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			re, err := compileTranscriptPattern(tt.pattern, tt.regex, tt.insensitive)
+			if err != nil {
+				t.Fatalf("compileTranscriptPattern() error = %v", err)
+			}
+			after, change := rewriteTranscript(uuid.New(), tt.transcript, re, tt.replacement, tt.regex)
+			if change.Matches != tt.wantMatches {
+				t.Fatalf("matches = %d, want %d", change.Matches, tt.wantMatches)
+			}
+			if tt.wantSkip {
+				if change.SkipReason == "" {
+					t.Fatal("expected change to be skipped")
+				}
+				return
+			}
+			if after != tt.wantContent {
+				t.Fatalf("content = %q, want %q", after, tt.wantContent)
+			}
+		})
+	}
+}
+
+func TestCompileTranscriptPattern_Rejects(t *testing.T) {
+	for _, pattern := range []string{"(", "x*"} {
+		if _, err := compileTranscriptPattern(pattern, true, false); !errors.Is(err, core.ErrValidation) {
+			t.Fatalf("expected validation error for %q, got %v", pattern, err)
+		}
+	}
+}
+
+func TestTranscriptAdminService_SearchReplaceTranscripts(t *testing.T) {
+	fixedNow := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	matching := core.Episode{ID: uuid.New(), SeriesID: uuid.New(), Transcript: core.Transcript{Format: core.TranscriptFormatPlain, Content: "colour colour"}}
+	other := core.Episode{ID: uuid.New(), Transcript: core.Transcript{Format: core.TranscriptFormatPlain, Content: "nothing"}}
+
+	for _, dryRun := range []bool{true, false} {
+		repo := &stubTranscriptAdminRepo{
+			episodes: []core.Episode{matching, other},
+		}
+		seriesCache := &stubSeriesCache{}
+		service := NewTranscriptAdminService(repo)
+		service.WithClock(func() time.Time { return fixedNow })
+		service.WithDispatcher(func(fn func()) { fn() })
+		service.WithSeriesCache(seriesCache)
+
+		job, err := service.SearchReplaceTranscripts(context.Background(), core.SearchReplaceTranscriptsParams{
+			Pattern:     "colour",
+			Replacement: "color",
+			DryRun:      dryRun,
+		})
+		if err != nil {
+			t.Fatalf("SearchReplaceTranscripts() error = %v", err)
+		}
+		if job.Status != core.TranscriptReplaceJobStatusPending {
+			t.Fatalf("expected accepted job to be pending, got %v", job.Status)
+		}
+
+		final := repo.job
+		if final.Status != core.TranscriptReplaceJobStatusSucceeded || final.EpisodesScanned != 2 || final.EpisodesChanged != 1 || final.Matches != 2 {
+			t.Fatalf("unexpected job outcome (dry run %v): %#v", dryRun, final)
+		}
+		if len(final.Changes) != 1 || final.Changes[0].Lines[0].After != "color color" {
+			t.Fatalf("unexpected diff preview %#v", final.Changes)
+		}
+		if dryRun && len(repo.applied) != 0 {
+			t.Fatalf("dry run must not apply revisions, got %d", len(repo.applied))
+		}
+		if !dryRun && (len(repo.applied) != 1 || repo.applied[0].Before != "colour colour" || repo.applied[0].JobID != job.ID) {
+			t.Fatalf("unexpected applied revisions %#v", repo.applied)
+		}
+		if wantInvalidated := !dryRun; wantInvalidated != (len(seriesCache.invalidated) == 1 && seriesCache.invalidated[0] == matching.SeriesID) {
+			t.Fatalf("unexpected cache invalidation (dry run %v): %v", dryRun, seriesCache.invalidated)
+		}
+	}
+}
+
+func TestTranscriptAdminService_RollbackTranscriptReplaceJob(t *testing.T) {
+	tests := []struct {
+		name    string
+		job     core.TranscriptReplaceJob
+		wantErr error
+	}{
+		{name: "succeeded job", job: core.TranscriptReplaceJob{Status: core.TranscriptReplaceJobStatusSucceeded}},
+		{name: "dry run", job: core.TranscriptReplaceJob{Status: core.TranscriptReplaceJobStatusSucceeded, DryRun: true}, wantErr: core.ErrInvalidState},
+		{name: "running job", job: core.TranscriptReplaceJob{Status: core.TranscriptReplaceJobStatusRunning}, wantErr: core.ErrInvalidState},
+		{name: "already rolled back", job: core.TranscriptReplaceJob{Status: core.TranscriptReplaceJobStatusRolledBack}, wantErr: core.ErrInvalidState},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.job.ID = uuid.New()
+			repo := &stubTranscriptAdminRepo{job: tt.job}
+			service := NewTranscriptAdminService(repo)
+
+			job, result, err := service.RollbackTranscriptReplaceJob(context.Background(), tt.job.ID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RollbackTranscriptReplaceJob() error = %v", err)
+			}
+			if job.Status != core.TranscriptReplaceJobStatusRolledBack || job.RolledBackAt == nil || result.Restored != 1 {
+				t.Fatalf("unexpected rollback outcome %#v %#v", job, result)
+			}
+		})
+	}
+}
+
+// stubTranscriptAdminRepo keeps a single job in memory and serves a fixed episode list.
+type stubTranscriptAdminRepo struct {
+	job      core.TranscriptReplaceJob
+	episodes []core.Episode
+	applied  []core.TranscriptRevision
+}
+
+func (s *stubTranscriptAdminRepo) CreateTranscriptReplaceJob(ctx context.Context, job core.TranscriptReplaceJob) (*core.TranscriptReplaceJob, error) {
+	s.job = job
+	return &job, nil
+}
+
+func (s *stubTranscriptAdminRepo) GetTranscriptReplaceJob(ctx context.Context, id uuid.UUID) (*core.TranscriptReplaceJob, error) {
+	if s.job.ID != id {
+		return nil, core.ErrNotFound
+	}
+	job := s.job
+	return &job, nil
+}
+
+func (s *stubTranscriptAdminRepo) UpdateTranscriptReplaceJob(ctx context.Context, job core.TranscriptReplaceJob) (*core.TranscriptReplaceJob, error) {
+	s.job = job
+	return &job, nil
+}
+
+func (s *stubTranscriptAdminRepo) ListTranscriptReplaceJobs(ctx context.Context, filter core.TranscriptReplaceJobFilter) ([]core.TranscriptReplaceJob, string, error) {
+	return []core.TranscriptReplaceJob{s.job}, "", nil
+}
+
+func (s *stubTranscriptAdminRepo) ListTranscriptEpisodes(ctx context.Context, scope core.TranscriptReplaceScope, after uuid.UUID, limit int) ([]core.Episode, error) {
+	if after != uuid.Nil {
+		return nil, nil
+	}
+	return s.episodes, nil
+}
+
+func (s *stubTranscriptAdminRepo) ApplyTranscriptRevisions(ctx context.Context, revisions []core.TranscriptRevision) ([]uuid.UUID, error) {
+	s.applied = append(s.applied, revisions...)
+	return nil, nil
+}
+
+func (s *stubTranscriptAdminRepo) RollbackTranscriptRevisions(ctx context.Context, jobID uuid.UUID) (*core.TranscriptRollbackResult, error) {
+	return &core.TranscriptRollbackResult{Restored: 1}, nil
+}
+
+// stubSeriesCache records invalidated series.
+type stubSeriesCache struct {
+	invalidated []uuid.UUID
+}
+
+func (s *stubSeriesCache) InvalidateSeries(_ context.Context, ids ...uuid.UUID) {
+	s.invalidated = append(s.invalidated, ids...)
+}
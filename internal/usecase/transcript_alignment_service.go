@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// TranscriptAlignmentService upgrades plain transcripts to timed SRT
+// transcripts by force-aligning each sentence with the episode's media.
+type TranscriptAlignmentService struct {
+	series  core.SeriesService
+	aligner core.TranscriptAligner
+}
+
+// NewTranscriptAlignmentService constructs an alignment service. aligner may
+// be nil when no aligner is configured, in which case alignment is
+// unavailable and episode events are ignored.
+func NewTranscriptAlignmentService(series core.SeriesService, aligner core.TranscriptAligner) *TranscriptAlignmentService {
+	return &TranscriptAlignmentService{
+		series:  series,
+		aligner: aligner,
+	}
+}
+
+var _ core.TranscriptAlignmentService = (*TranscriptAlignmentService)(nil)
+
+// AlignEpisodeTranscript replaces the plain transcript of an episode with an
+// SRT transcript holding one cue per sentence.
+func (s *TranscriptAlignmentService) AlignEpisodeTranscript(ctx context.Context, episodeID uuid.UUID) (*core.Episode, error) {
+	if s.aligner == nil {
+		return nil, fmt.Errorf("%w: transcript alignment is not configured", core.ErrInvalidState)
+	}
+	episode, err := s.series.GetEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+	if reason := alignmentSkipReason(episode); reason != "" {
+		return nil, fmt.Errorf("%w: %s", core.ErrInvalidState, reason)
+	}
+
+	sentences := splitSentences(episode.Transcript.Content)
+	segments, err := s.aligner.AlignTranscript(ctx, core.AlignmentRequest{
+		AudioURL:  episode.Resource.PlaybackURL,
+		MimeType:  episode.Resource.MimeType,
+		Language:  episode.Transcript.Language,
+		Sentences: sentences,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) != len(sentences) {
+		return nil, fmt.Errorf("aligner returned %d segments for %d sentences", len(segments), len(sentences))
+	}
+	for i := range segments {
+		// The aligner may normalise the text it was given; cues keep the
+		// editor's wording.
+		segments[i].Text = sentences[i]
+		if segments[i].End < segments[i].Start || (i > 0 && segments[i].Start < segments[i-1].Start) {
+			return nil, fmt.Errorf("aligner returned segments out of order at sentence %d", i+1)
+		}
+	}
+
+	// Alignment takes a while; an editor may have changed the transcript
+	// since. Their change wins and queues an alignment of its own.
+	current, err := s.series.GetEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+	if current.Transcript.Format != core.TranscriptFormatPlain || current.Transcript.Content != episode.Transcript.Content {
+		return nil, fmt.Errorf("%w: transcript changed during alignment", core.ErrInvalidState)
+	}
+
+	current.Transcript.Format = core.TranscriptFormatSRT
+	current.Transcript.Content = core.FormatSRT(segments)
+	return s.series.UpdateEpisode(ctx, *current)
+}
+
+// HandleEpisodeEvent aligns the transcript of a created or updated episode
+// when it is plain and the episode has media.
+func (s *TranscriptAlignmentService) HandleEpisodeEvent(ctx context.Context, event core.Event) error {
+	if s.aligner == nil {
+		return nil
+	}
+	var episode core.Episode
+	switch e := event.(type) {
+	case core.EpisodeCreated:
+		episode = e.Episode
+	case core.EpisodeUpdated:
+		episode = e.Episode
+	default:
+		return nil
+	}
+	if alignmentSkipReason(&episode) != "" {
+		return nil
+	}
+
+	// A deleted episode or an edited transcript leaves nothing to retry.
+	_, err := s.AlignEpisodeTranscript(ctx, episode.ID)
+	if isNotFound(err) || errors.Is(err, core.ErrInvalidState) {
+		return nil
+	}
+	return err
+}
+
+// alignmentSkipReason explains why an episode's transcript cannot be
+// aligned, or returns an empty string when it can.
+func alignmentSkipReason(episode *core.Episode) string {
+	switch {
+	case episode.DeletedAt != nil:
+		return "episode is deleted"
+	case episode.Transcript.Format != core.TranscriptFormatPlain:
+		return "only plain transcripts are aligned"
+	case strings.TrimSpace(episode.Transcript.Content) == "":
+		return "episode has no transcript"
+	case episode.Resource.PlaybackURL == "":
+		return "episode has no media to align against"
+	default:
+		return ""
+	}
+}
+
+// splitSentences breaks a plain transcript into sentences. Line breaks
+// always end a sentence; within a line, a sentence ends at terminal
+// punctuation followed by a space and a capital letter, digit or quote.
+func splitSentences(content string) []string {
+	var sentences []string
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		start := 0
+		for i, r := range line {
+			if !strings.ContainsRune(".!?…", r) {
+				continue
+			}
+			next := i + utf8.RuneLen(r)
+			rest := strings.TrimLeftFunc(line[next:], func(r rune) bool { return strings.ContainsRune(".!?…\"'”’)", r) })
+			trimmed := strings.TrimLeftFunc(rest, unicode.IsSpace)
+			if len(trimmed) == len(rest) || trimmed == "" {
+				continue
+			}
+			first, _ := utf8.DecodeRuneInString(trimmed)
+			if !unicode.IsUpper(first) && !unicode.IsDigit(first) && !strings.ContainsRune("\"'“‘¿¡", first) {
+				continue
+			}
+			end := len(line) - len(rest)
+			if end <= start {
+				// A later mark of an ellipsis or "?!" already ended the sentence.
+				continue
+			}
+			sentences = append(sentences, strings.TrimSpace(line[start:end]))
+			start = len(line) - len(trimmed)
+		}
+		if sentence := strings.TrimSpace(line[start:]); sentence != "" {
+			sentences = append(sentences, sentence)
+		}
+	}
+	return sentences
+}
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// alignerFunc adapts a function to core.TranscriptAligner.
+type alignerFunc func(ctx context.Context, req core.AlignmentRequest) ([]core.TranscriptSegment, error)
+
+func (f alignerFunc) AlignTranscript(ctx context.Context, req core.AlignmentRequest) ([]core.TranscriptSegment, error) {
+	return f(ctx, req)
+}
+
+// evenAligner gives every sentence two seconds.
+var evenAligner = alignerFunc(func(_ context.Context, req core.AlignmentRequest) ([]core.TranscriptSegment, error) {
+	segments := make([]core.TranscriptSegment, len(req.Sentences))
+	for i := range segments {
+		segments[i] = core.TranscriptSegment{Start: time.Duration(2*i) * time.Second, End: time.Duration(2*i+2) * time.Second, Text: "normalised"}
+	}
+	return segments, nil
+})
+
+func TestTranscriptAlignmentService_AlignEpisodeTranscript(t *testing.T) {
+	episode := core.Episode{
+		ID:         uuid.New(),
+		SeriesID:   uuid.New(),
+		Status:     core.EpisodeStatusDraft,
+		Resource:   core.MediaResource{Type: core.MediaTypeAudio, PlaybackURL: "https://cdn.example/audio.mp3"},
+		Transcript: core.Transcript{Language: "en", Format: core.TranscriptFormatPlain, Content: "Hello there. How are you?\nFine!"},
+	}
+	var saved core.Episode
+	repo := &stubSeriesRepo{
+		getEpisodeFn: func(context.Context, uuid.UUID) (*core.Episode, error) {
+			copied := episode
+			return &copied, nil
+		},
+		updateEpisodeFn: func(_ context.Context, e core.Episode) (*core.Episode, error) {
+			saved = e
+			return &e, nil
+		},
+	}
+	var request core.AlignmentRequest
+	aligner := alignerFunc(func(ctx context.Context, req core.AlignmentRequest) ([]core.TranscriptSegment, error) {
+		request = req
+		return evenAligner(ctx, req)
+	})
+	service := NewTranscriptAlignmentService(NewSeriesService(repo), aligner)
+
+	if _, err := service.AlignEpisodeTranscript(context.Background(), episode.ID); err != nil {
+		t.Fatalf("AlignEpisodeTranscript() error = %v", err)
+	}
+	if want := []string{"Hello there.", "How are you?", "Fine!"}; !reflect.DeepEqual(request.Sentences, want) {
+		t.Fatalf("sentences = %q, want %q", request.Sentences, want)
+	}
+	if request.AudioURL != episode.Resource.PlaybackURL || request.Language != "en" {
+		t.Fatalf("unexpected request %+v", request)
+	}
+	if saved.Transcript.Format != core.TranscriptFormatSRT {
+		t.Fatalf("expected an SRT transcript, got format %v", saved.Transcript.Format)
+	}
+	segments, err := core.ParseTranscriptSegments(saved.Transcript)
+	if err != nil || len(segments) != 3 || segments[1].Text != "How are you?" || segments[2].Start != 4*time.Second {
+		t.Fatalf("unexpected segments %+v, %v", segments, err)
+	}
+}
+
+func TestTranscriptAlignmentService_HandleEpisodeEvent(t *testing.T) {
+	plain := core.Episode{
+		ID:         uuid.New(),
+		SeriesID:   uuid.New(),
+		Status:     core.EpisodeStatusDraft,
+		Resource:   core.MediaResource{PlaybackURL: "https://cdn.example/audio.mp3"},
+		Transcript: core.Transcript{Format: core.TranscriptFormatPlain, Content: "Hello."},
+	}
+	timed := plain
+	timed.Transcript = core.Transcript{Format: core.TranscriptFormatSRT, Content: "1\n00:00:00,000 --> 00:00:01,000\nHello.\n"}
+	noMedia := plain
+	noMedia.Resource = core.MediaResource{}
+	edited := plain
+	edited.Transcript.Content = "Goodbye."
+
+	tests := []struct {
+		name  string
+		event core.Event
+		// stored holds what successive reads of the episode return; the
+		// last entry is repeated.
+		stored    []core.Episode
+		aligner   core.TranscriptAligner
+		wantSaved bool
+		wantErr   bool
+	}{
+		{name: "created plain", event: core.EpisodeCreated{Episode: plain}, stored: []core.Episode{plain}, aligner: evenAligner, wantSaved: true},
+		{name: "updated plain", event: core.EpisodeUpdated{Episode: plain}, stored: []core.Episode{plain}, aligner: evenAligner, wantSaved: true},
+		{name: "already timed", event: core.EpisodeUpdated{Episode: timed}, stored: []core.Episode{timed}, aligner: evenAligner},
+		{name: "no media", event: core.EpisodeUpdated{Episode: noMedia}, stored: []core.Episode{noMedia}, aligner: evenAligner},
+		{name: "edited since", event: core.EpisodeUpdated{Episode: plain}, stored: []core.Episode{plain, edited}, aligner: evenAligner},
+		{name: "not configured", event: core.EpisodeCreated{Episode: plain}, stored: []core.Episode{plain}},
+		{
+			name:   "aligner failure is retried",
+			event:  core.EpisodeCreated{Episode: plain},
+			stored: []core.Episode{plain},
+			aligner: alignerFunc(func(context.Context, core.AlignmentRequest) ([]core.TranscriptSegment, error) {
+				return nil, errors.New("boom")
+			}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved, reads := false, 0
+			repo := &stubSeriesRepo{
+				getEpisodeFn: func(context.Context, uuid.UUID) (*core.Episode, error) {
+					copied := tt.stored[min(reads, len(tt.stored)-1)]
+					reads++
+					return &copied, nil
+				},
+				updateEpisodeFn: func(_ context.Context, e core.Episode) (*core.Episode, error) {
+					saved = true
+					return &e, nil
+				},
+			}
+			service := NewTranscriptAlignmentService(NewSeriesService(repo), tt.aligner)
+			err := service.HandleEpisodeEvent(context.Background(), tt.event)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("HandleEpisodeEvent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if saved != tt.wantSaved {
+				t.Fatalf("saved = %v, want %v", saved, tt.wantSaved)
+			}
+		})
+	}
+}
+
+func TestSplitSentences(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{name: "terminal punctuation", content: "Hi! How are you? I'm fine.", want: []string{"Hi!", "How are you?", "I'm fine."}},
+		{name: "lines", content: "Good morning\n\n  Good night  ", want: []string{"Good morning", "Good night"}},
+		{name: "lower case continues", content: "It costs 2.50 a cup. e.g. this one.", want: []string{"It costs 2.50 a cup. e.g. this one."}},
+		{name: "ellipsis and quotes", content: `Well... "Really?!" She left.`, want: []string{"Well...", `"Really?!"`, "She left."}},
+		{name: "non-latin", content: "¿Qué tal? ¡Muy bien!", want: []string{"¿Qué tal?", "¡Muy bien!"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitSentences(tt.content); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("splitSentences() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// TranscriptSanitizeMode selects what the transcript sanitization pass does
+// with the spans its scanners find.
+type TranscriptSanitizeMode int
+
+const (
+	// TranscriptSanitizeOff skips the sanitization pass.
+	TranscriptSanitizeOff TranscriptSanitizeMode = iota
+	// TranscriptSanitizeFlag records findings for editors to review and
+	// leaves the transcript untouched.
+	TranscriptSanitizeFlag
+	// TranscriptSanitizeMask also replaces each finding with asterisks
+	// when the episode is published.
+	TranscriptSanitizeMask
+)
+
+// sanitizeTranscript scans the episode's transcript and records what the
+// scanners found, masking the spans of published episodes in mask mode.
+func sanitizeTranscript(episode *core.Episode, scanners []core.TextScanner, mode TranscriptSanitizeMode) {
+	episode.Transcript.Findings = nil
+	content := episode.Transcript.Content
+	if mode == TranscriptSanitizeOff || content == "" {
+		return
+	}
+
+	var spans []core.TextFinding
+	for _, scanner := range scanners {
+		spans = append(spans, scanner.ScanText(content)...)
+	}
+	spans = disjointFindings(spans)
+	if len(spans) == 0 {
+		return
+	}
+
+	mask := mode == TranscriptSanitizeMask && episode.Status == core.EpisodeStatusPublished
+	var masked strings.Builder
+	last, shift := 0, 0
+	for _, span := range spans {
+		finding := core.TranscriptFinding{
+			Kind:   span.Kind,
+			Line:   strings.Count(content[:span.Start], "\n") + 1,
+			Start:  span.Start + shift,
+			End:    span.End + shift,
+			Masked: mask,
+		}
+		if mask {
+			// One asterisk per character keeps the shape of the text; the
+			// offsets move when masked characters span several bytes.
+			stars := utf8.RuneCountInString(content[span.Start:span.End])
+			masked.WriteString(content[last:span.Start])
+			masked.WriteString(strings.Repeat("*", stars))
+			last = span.End
+			shift += stars - (span.End - span.Start)
+			finding.End = finding.Start + stars
+		}
+		episode.Transcript.Findings = append(episode.Transcript.Findings, finding)
+	}
+	if mask {
+		masked.WriteString(content[last:])
+		episode.Transcript.Content = masked.String()
+	}
+}
+
+// disjointFindings orders findings by offset and drops any overlapping an
+// earlier or longer one, so each span of text is reported once.
+func disjointFindings(findings []core.TextFinding) []core.TextFinding {
+	slices.SortStableFunc(findings, func(a, b core.TextFinding) int {
+		if a.Start != b.Start {
+			return a.Start - b.Start
+		}
+		return (b.End - b.Start) - (a.End - a.Start)
+	})
+	disjoint := findings[:0]
+	end := -1
+	for _, finding := range findings {
+		if finding.Start < end {
+			continue
+		}
+		disjoint = append(disjoint, finding)
+		end = finding.End
+	}
+	return disjoint
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// scannerFunc adapts a function to core.TextScanner.
+type scannerFunc func(text string) []core.TextFinding
+
+func (f scannerFunc) ScanText(text string) []core.TextFinding { return f(text) }
+
+// wordScanner reports every occurrence of word as a finding of kind.
+func wordScanner(kind, word string) core.TextScanner {
+	return scannerFunc(func(text string) []core.TextFinding {
+		var findings []core.TextFinding
+		for offset := 0; ; {
+			i := strings.Index(text[offset:], word)
+			if i < 0 {
+				return findings
+			}
+			start := offset + i
+			findings = append(findings, core.TextFinding{Kind: kind, Start: start, End: start + len(word)})
+			offset = start + len(word)
+		}
+	})
+}
+
+func TestSanitizeTranscript(t *testing.T) {
+	scanners := []core.TextScanner{
+		wordScanner("profanity", "darn"),
+		wordScanner("email", "café@example.com"),
+		wordScanner("phone", "example.com"),
+	}
+	content := "Hello.\nOh darn, write to café@example.com.\nBye darn"
+
+	tests := []struct {
+		name         string
+		mode         TranscriptSanitizeMode
+		status       core.EpisodeStatus
+		wantContent  string
+		wantFindings []core.TranscriptFinding
+	}{
+		{
+			name:        "off",
+			mode:        TranscriptSanitizeOff,
+			status:      core.EpisodeStatusPublished,
+			wantContent: content,
+		},
+		{
+			name:        "flag",
+			mode:        TranscriptSanitizeFlag,
+			status:      core.EpisodeStatusPublished,
+			wantContent: content,
+			wantFindings: []core.TranscriptFinding{
+				{Kind: "profanity", Line: 2, Start: 10, End: 14},
+				{Kind: "email", Line: 2, Start: 25, End: 42},
+				{Kind: "profanity", Line: 3, Start: 48, End: 52},
+			},
+		},
+		{
+			name:        "mask leaves drafts untouched",
+			mode:        TranscriptSanitizeMask,
+			status:      core.EpisodeStatusDraft,
+			wantContent: content,
+			wantFindings: []core.TranscriptFinding{
+				{Kind: "profanity", Line: 2, Start: 10, End: 14},
+				{Kind: "email", Line: 2, Start: 25, End: 42},
+				{Kind: "profanity", Line: 3, Start: 48, End: 52},
+			},
+		},
+		{
+			name:        "mask published",
+			mode:        TranscriptSanitizeMask,
+			status:      core.EpisodeStatusPublished,
+			wantContent: "Hello.\nOh ****, write to ****************.\nBye ****",
+			wantFindings: []core.TranscriptFinding{
+				{Kind: "profanity", Line: 2, Start: 10, End: 14, Masked: true},
+				{Kind: "email", Line: 2, Start: 25, End: 41, Masked: true},
+				{Kind: "profanity", Line: 3, Start: 47, End: 51, Masked: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			episode := core.Episode{
+				Status: tt.status,
+				Transcript: core.Transcript{
+					Content:  content,
+					Findings: []core.TranscriptFinding{{Kind: "stale"}},
+				},
+			}
+			sanitizeTranscript(&episode, scanners, tt.mode)
+			if episode.Transcript.Content != tt.wantContent {
+				t.Fatalf("content = %q, want %q", episode.Transcript.Content, tt.wantContent)
+			}
+			if !reflect.DeepEqual(episode.Transcript.Findings, tt.wantFindings) {
+				t.Fatalf("findings = %+v, want %+v", episode.Transcript.Findings, tt.wantFindings)
+			}
+			for _, f := range episode.Transcript.Findings {
+				if f.Masked && strings.Trim(episode.Transcript.Content[f.Start:f.End], "*") != "" {
+					t.Fatalf("finding %+v does not point at the mask", f)
+				}
+			}
+		})
+	}
+}
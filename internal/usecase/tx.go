@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// withinTx runs fn in a transaction of tx, or directly when the service was
+// built without a TxManager.
+func withinTx(ctx context.Context, tx core.TxManager, fn func(ctx context.Context) error) error {
+	if tx == nil {
+		return fn(ctx)
+	}
+	return tx.WithinTx(ctx, fn)
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// UsageBuffer counts usage in memory and records it through the metering
+// service in batches, one record per tenant, metric and minute.
+type UsageBuffer struct {
+	metering core.MeteringService
+	now      func() time.Time
+
+	mu      sync.Mutex
+	pending map[usageBucket]float64
+}
+
+// usageBucket is the tenant, metric and minute usage is aggregated by.
+type usageBucket struct {
+	tenantID string
+	metric   core.UsageMetric
+	minute   time.Time
+}
+
+// NewUsageBuffer constructs a usage buffer recording through metering.
+func NewUsageBuffer(metering core.MeteringService) *UsageBuffer {
+	return &UsageBuffer{
+		metering: metering,
+		now:      time.Now,
+		pending:  make(map[usageBucket]float64),
+	}
+}
+
+// WithClock allows tests to override the clock used by the buffer.
+func (b *UsageBuffer) WithClock(fn func() time.Time) {
+	if fn != nil {
+		b.now = fn
+	}
+}
+
+var _ core.UsageCounter = (*UsageBuffer)(nil)
+
+// CountUsage adds quantity to the tenant's count of metric for the current
+// minute.
+func (b *UsageBuffer) CountUsage(tenantID string, metric core.UsageMetric, quantity float64) {
+	tenantID = strings.TrimSpace(tenantID)
+	if tenantID == "" || quantity <= 0 {
+		return
+	}
+	bucket := usageBucket{tenantID: tenantID, metric: metric, minute: b.now().UTC().Truncate(time.Minute)}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.pending[bucket] += quantity
+}
+
+// FlushUsage records the usage counted so far and returns how many records
+// it wrote. Counts that fail to record are kept for the next flush, unless
+// the metering service rejects them as invalid.
+func (b *UsageBuffer) FlushUsage(ctx context.Context) (int, error) {
+	b.mu.Lock()
+	pending := b.pending
+	b.pending = make(map[usageBucket]float64)
+	b.mu.Unlock()
+
+	flushed := 0
+	var errs []error
+	for bucket, quantity := range pending {
+		err := b.metering.RecordUsage(ctx, core.UsageRecord{
+			TenantID:   bucket.tenantID,
+			Metric:     bucket.metric,
+			Quantity:   quantity,
+			OccurredAt: bucket.minute,
+		})
+		if err == nil {
+			flushed++
+			continue
+		}
+		errs = append(errs, err)
+		if !errors.Is(err, core.ErrValidation) {
+			b.requeue(bucket, quantity)
+		}
+	}
+	return flushed, errors.Join(errs...)
+}
+
+// Run flushes the counted usage every interval until ctx is cancelled.
+func (b *UsageBuffer) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, _ = b.FlushUsage(ctx)
+		}
+	}
+}
+
+func (b *UsageBuffer) requeue(bucket usageBucket, quantity float64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.pending[bucket] += quantity
+}
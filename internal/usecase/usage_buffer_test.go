@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestUsageBuffer_FlushUsageAggregatesPerTenantAndMinute(t *testing.T) {
+	now := time.Date(2024, 6, 3, 8, 0, 10, 0, time.UTC)
+	var recorded []core.UsageRecord
+	buffer := NewUsageBuffer(NewMeteringService(&stubMeteringRepo{recordUsageFn: func(_ context.Context, record core.UsageRecord) error {
+		recorded = append(recorded, record)
+		return nil
+	}}))
+	buffer.WithClock(func() time.Time { return now })
+
+	buffer.CountUsage("acme", core.UsageMetricAPICalls, 1)
+	buffer.CountUsage("acme", core.UsageMetricAPICalls, 1)
+	buffer.CountUsage(" ", core.UsageMetricAPICalls, 1)
+	now = now.Add(30 * time.Second)
+	buffer.CountUsage("acme", core.UsageMetricAPICalls, 1)
+
+	flushed, err := buffer.FlushUsage(context.Background())
+	if err != nil {
+		t.Fatalf("FlushUsage() error = %v", err)
+	}
+	if flushed != 1 || len(recorded) != 1 {
+		t.Fatalf("expected one record for the minute, got %d: %+v", flushed, recorded)
+	}
+	record := recorded[0]
+	if record.TenantID != "acme" || record.Quantity != 3 || !record.OccurredAt.Equal(time.Date(2024, 6, 3, 8, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected record %+v", record)
+	}
+
+	if flushed, err := buffer.FlushUsage(context.Background()); err != nil || flushed != 0 {
+		t.Fatalf("expected nothing left to flush, got %d, %v", flushed, err)
+	}
+}
+
+func TestUsageBuffer_FlushUsageRetriesFailedCounts(t *testing.T) {
+	now := time.Date(2024, 6, 3, 8, 0, 0, 0, time.UTC)
+	failures := 1
+	var recorded []core.UsageRecord
+	buffer := NewUsageBuffer(NewMeteringService(&stubMeteringRepo{recordUsageFn: func(_ context.Context, record core.UsageRecord) error {
+		if failures > 0 {
+			failures--
+			return errors.New("connection reset")
+		}
+		recorded = append(recorded, record)
+		return nil
+	}}))
+	buffer.WithClock(func() time.Time { return now })
+
+	buffer.CountUsage("acme", core.UsageMetricAPICalls, 2)
+	buffer.CountUsage("acme", core.UsageMetric(99), 1)
+	if _, err := buffer.FlushUsage(context.Background()); err == nil {
+		t.Fatal("expected the failed record to be reported")
+	}
+
+	buffer.CountUsage("acme", core.UsageMetricAPICalls, 1)
+	flushed, err := buffer.FlushUsage(context.Background())
+	if err != nil {
+		t.Fatalf("FlushUsage() error = %v", err)
+	}
+	if flushed != 1 || len(recorded) != 1 || recorded[0].Quantity != 3 {
+		t.Fatalf("expected the failed count to be retried with the new one, got %+v", recorded)
+	}
+}
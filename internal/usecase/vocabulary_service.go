@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// maxMarkedWords caps the words marked in one call.
+const maxMarkedWords = 500
+
+// VocabularyService records the words learners know and compares them with
+// episode transcripts.
+type VocabularyService struct {
+	repo   core.VocabularyRepository
+	series core.SeriesRepository
+}
+
+// NewVocabularyService constructs a vocabulary service using the supplied repositories.
+func NewVocabularyService(repo core.VocabularyRepository, series core.SeriesRepository) *VocabularyService {
+	return &VocabularyService{
+		repo:   repo,
+		series: series,
+	}
+}
+
+var _ core.VocabularyService = (*VocabularyService)(nil)
+
+// MarkWords marks words as known or unknown for a learner, or forgets them
+// when the status is unspecified. Words are normalised the way transcripts
+// are split, so "Coffee," and "coffee" are the same word.
+func (s *VocabularyService) MarkWords(ctx context.Context, params core.MarkWordsParams) ([]core.VocabularyWord, error) {
+	userID := strings.TrimSpace(params.UserID)
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", core.ErrValidation)
+	}
+	switch params.Status {
+	case core.VocabularyStatusUnspecified, core.VocabularyStatusKnown, core.VocabularyStatusUnknown:
+	default:
+		return nil, fmt.Errorf("%w: unknown vocabulary status %d", core.ErrValidation, params.Status)
+	}
+	if len(params.Words) == 0 || len(params.Words) > maxMarkedWords {
+		return nil, fmt.Errorf("%w: between 1 and %d words are required", core.ErrValidation, maxMarkedWords)
+	}
+
+	words := make([]string, 0, len(params.Words))
+	for _, raw := range params.Words {
+		split := splitWords(raw)
+		if len(split) != 1 {
+			return nil, fmt.Errorf("%w: %q is not a single word", core.ErrValidation, raw)
+		}
+		words = append(words, split[0])
+	}
+	words = lo.Uniq(words)
+
+	if params.Status == core.VocabularyStatusUnspecified {
+		if err := s.repo.DeleteWords(ctx, userID, words); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	if params.EpisodeID != uuid.Nil {
+		if _, err := s.series.GetEpisode(ctx, params.EpisodeID); err != nil {
+			return nil, err
+		}
+	}
+	marked := lo.Map(words, func(word string, _ int) core.VocabularyWord {
+		return core.VocabularyWord{
+			UserID:    userID,
+			Word:      word,
+			Status:    params.Status,
+			EpisodeID: params.EpisodeID,
+		}
+	})
+	if err := s.repo.SaveWords(ctx, marked...); err != nil {
+		return nil, err
+	}
+	return marked, nil
+}
+
+// ListVocabulary returns a learner's marked words, most recently marked first.
+func (s *VocabularyService) ListVocabulary(ctx context.Context, filter core.VocabularyFilter) ([]core.VocabularyWord, string, error) {
+	filter.UserID = strings.TrimSpace(filter.UserID)
+	if filter.UserID == "" {
+		return nil, "", fmt.Errorf("%w: user id is required", core.ErrValidation)
+	}
+	return s.repo.ListWords(ctx, filter)
+}
+
+// GetVocabularyCoverage reports how much of an episode's transcript the
+// learner already knows, and the most frequent words they do not.
+func (s *VocabularyService) GetVocabularyCoverage(ctx context.Context, userID string, episodeID uuid.UUID) (*core.VocabularyCoverage, error) {
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", core.ErrValidation)
+	}
+	episode, err := s.series.GetEpisode(ctx, episodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	words := splitWords(core.TranscriptText(episode.Transcript))
+	counts := map[string]int{}
+	for _, word := range words {
+		counts[word]++
+	}
+	statuses, err := s.repo.WordStatuses(ctx, userID, lo.Keys(counts))
+	if err != nil {
+		return nil, err
+	}
+
+	coverage := &core.VocabularyCoverage{
+		EpisodeID:       episode.ID,
+		UserID:          userID,
+		WordCount:       len(words),
+		UniqueWordCount: len(counts),
+	}
+	for word, count := range counts {
+		if statuses[word] == core.VocabularyStatusKnown {
+			coverage.KnownWordCount += count
+			coverage.KnownUniqueWordCount++
+			continue
+		}
+		coverage.NewWords = append(coverage.NewWords, core.WordFrequency{Word: word, Count: count})
+	}
+	if coverage.WordCount > 0 {
+		coverage.KnownPercent = 100 * float64(coverage.KnownWordCount) / float64(coverage.WordCount)
+	}
+	slices.SortFunc(coverage.NewWords, func(a, b core.WordFrequency) int {
+		return cmp.Or(b.Count-a.Count, strings.Compare(a.Word, b.Word))
+	})
+	coverage.NewWords = coverage.NewWords[:min(len(coverage.NewWords), defaultTopWords)]
+	return coverage, nil
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestVocabularyService_MarkWords(t *testing.T) {
+	episodeID := uuid.New()
+	seriesRepo := &stubSeriesRepo{
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			if id != episodeID {
+				return nil, core.ErrNotFound
+			}
+			return &core.Episode{ID: id}, nil
+		},
+	}
+	repo := &stubVocabularyRepo{}
+	service := NewVocabularyService(repo, seriesRepo)
+
+	marked, err := service.MarkWords(context.Background(), core.MarkWordsParams{
+		UserID:    " u1 ",
+		EpisodeID: episodeID,
+		Words:     []string{"Coffee,", "coffee", "'Barista'"},
+		Status:    core.VocabularyStatusKnown,
+	})
+	if err != nil {
+		t.Fatalf("MarkWords() error = %v", err)
+	}
+	if len(marked) != 2 || marked[0].Word != "coffee" || marked[1].Word != "barista" || marked[0].UserID != "u1" {
+		t.Fatalf("unexpected marked words %#v", marked)
+	}
+	if len(repo.saved) != 2 || repo.saved[0].EpisodeID != episodeID {
+		t.Fatalf("expected words to be persisted, got %#v", repo.saved)
+	}
+
+	if _, err := service.MarkWords(context.Background(), core.MarkWordsParams{
+		UserID: "u1",
+		Words:  []string{"Coffee"},
+	}); err != nil {
+		t.Fatalf("MarkWords() forget error = %v", err)
+	}
+	if !reflect.DeepEqual(repo.deleted, []string{"coffee"}) {
+		t.Fatalf("expected coffee to be forgotten, got %q", repo.deleted)
+	}
+
+	tests := []struct {
+		name    string
+		params  core.MarkWordsParams
+		wantErr error
+	}{
+		{name: "missing user", params: core.MarkWordsParams{Words: []string{"tea"}, Status: core.VocabularyStatusKnown}, wantErr: core.ErrValidation},
+		{name: "no words", params: core.MarkWordsParams{UserID: "u1", Status: core.VocabularyStatusKnown}, wantErr: core.ErrValidation},
+		{name: "phrase", params: core.MarkWordsParams{UserID: "u1", Words: []string{"green tea"}, Status: core.VocabularyStatusKnown}, wantErr: core.ErrValidation},
+		{name: "unknown status", params: core.MarkWordsParams{UserID: "u1", Words: []string{"tea"}, Status: 9}, wantErr: core.ErrValidation},
+		{name: "missing episode", params: core.MarkWordsParams{UserID: "u1", EpisodeID: uuid.New(), Words: []string{"tea"}, Status: core.VocabularyStatusUnknown}, wantErr: core.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := service.MarkWords(context.Background(), tt.params); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MarkWords() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVocabularyService_GetVocabularyCoverage(t *testing.T) {
+	episodeID := uuid.New()
+	seriesRepo := &stubSeriesRepo{
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			return &core.Episode{
+				ID: id,
+				Transcript: core.Transcript{
+					Format:  core.TranscriptFormatPlain,
+					Content: "The barista made a flat white. The espresso was strong, the milk was hot.",
+				},
+			}, nil
+		},
+	}
+	repo := &stubVocabularyRepo{statuses: map[string]core.VocabularyStatus{
+		"the":      core.VocabularyStatusKnown,
+		"was":      core.VocabularyStatusKnown,
+		"milk":     core.VocabularyStatusKnown,
+		"hot":      core.VocabularyStatusKnown,
+		"espresso": core.VocabularyStatusUnknown,
+	}}
+	service := NewVocabularyService(repo, seriesRepo)
+
+	coverage, err := service.GetVocabularyCoverage(context.Background(), "u1", episodeID)
+	if err != nil {
+		t.Fatalf("GetVocabularyCoverage() error = %v", err)
+	}
+	if coverage.WordCount != 14 || coverage.UniqueWordCount != 11 {
+		t.Fatalf("unexpected word counts %#v", coverage)
+	}
+	if coverage.KnownWordCount != 7 || coverage.KnownUniqueWordCount != 4 || coverage.KnownPercent != 50 {
+		t.Fatalf("unexpected known counts %#v", coverage)
+	}
+	want := []core.WordFrequency{
+		{Word: "a", Count: 1},
+		{Word: "barista", Count: 1},
+		{Word: "espresso", Count: 1},
+		{Word: "flat", Count: 1},
+		{Word: "made", Count: 1},
+		{Word: "strong", Count: 1},
+	}
+	if !reflect.DeepEqual(coverage.NewWords[:6], want) {
+		t.Fatalf("NewWords = %#v, want %#v", coverage.NewWords, want)
+	}
+
+	if _, err := service.GetVocabularyCoverage(context.Background(), "", episodeID); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected validation error without a user, got %v", err)
+	}
+}
+
+type stubVocabularyRepo struct {
+	saved    []core.VocabularyWord
+	deleted  []string
+	statuses map[string]core.VocabularyStatus
+}
+
+func (s *stubVocabularyRepo) SaveWords(ctx context.Context, words ...core.VocabularyWord) error {
+	s.saved = append(s.saved, words...)
+	return nil
+}
+
+func (s *stubVocabularyRepo) DeleteWords(ctx context.Context, userID string, words []string) error {
+	s.deleted = append(s.deleted, words...)
+	return nil
+}
+
+func (s *stubVocabularyRepo) ListWords(ctx context.Context, filter core.VocabularyFilter) ([]core.VocabularyWord, string, error) {
+	return s.saved, "", nil
+}
+
+func (s *stubVocabularyRepo) WordStatuses(ctx context.Context, userID string, words []string) (map[string]core.VocabularyStatus, error) {
+	statuses := map[string]core.VocabularyStatus{}
+	for _, word := range words {
+		if status, ok := s.statuses[word]; ok {
+			statuses[word] = status
+		}
+	}
+	return statuses, nil
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const maxPlaybackDeviceLen = 128
+
+// WatchHistoryService records playback sessions and serves a learner's watch history.
+type WatchHistoryService struct {
+	repo   core.WatchHistoryRepository
+	series core.SeriesRepository
+	now    func() time.Time
+}
+
+// NewWatchHistoryService constructs a watch history service using the supplied repositories.
+func NewWatchHistoryService(repo core.WatchHistoryRepository, series core.SeriesRepository) *WatchHistoryService {
+	return &WatchHistoryService{
+		repo:   repo,
+		series: series,
+		now:    time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *WatchHistoryService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.WatchHistoryService = (*WatchHistoryService)(nil)
+
+// RecordPlaybackSession appends a playback session to the learner's history.
+// StartedAt defaults to now; FinishedAt may be supplied when the session is
+// reported after it ended.
+func (s *WatchHistoryService) RecordPlaybackSession(ctx context.Context, session core.PlaybackSession) (*core.PlaybackSession, error) {
+	session.UserID = strings.TrimSpace(session.UserID)
+	if session.UserID == "" {
+		return nil, fmt.Errorf("%w: user id is required", core.ErrValidation)
+	}
+	if session.EpisodeID == uuid.Nil {
+		return nil, fmt.Errorf("%w: episode id is required", core.ErrValidation)
+	}
+	session.Device = strings.TrimSpace(session.Device)
+	if len(session.Device) > maxPlaybackDeviceLen {
+		return nil, fmt.Errorf("%w: device must be at most %d bytes", core.ErrValidation, maxPlaybackDeviceLen)
+	}
+
+	now := s.now().UTC()
+	if session.StartedAt.IsZero() {
+		session.StartedAt = now
+	}
+	session.StartedAt = session.StartedAt.UTC()
+	if session.FinishedAt != nil {
+		finishedAt := session.FinishedAt.UTC()
+		if finishedAt.Before(session.StartedAt) {
+			return nil, fmt.Errorf("%w: finished_at must not be before started_at", core.ErrValidation)
+		}
+		session.FinishedAt = &finishedAt
+	}
+
+	episode, err := s.series.GetEpisode(ctx, session.EpisodeID)
+	if err != nil {
+		return nil, err
+	}
+	if episode.DeletedAt != nil {
+		return nil, fmt.Errorf("%w: episode %s", core.ErrNotFound, session.EpisodeID)
+	}
+
+	session.ID = uuid.New()
+	return s.repo.CreatePlaybackSession(ctx, session)
+}
+
+// FinishPlaybackSession stamps the end of a session. finishedAt defaults to now.
+func (s *WatchHistoryService) FinishPlaybackSession(ctx context.Context, id uuid.UUID, finishedAt time.Time) (*core.PlaybackSession, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: session id required", core.ErrValidation)
+	}
+
+	session, err := s.repo.GetPlaybackSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if session.FinishedAt != nil {
+		return nil, fmt.Errorf("%w: playback session already finished", core.ErrInvalidState)
+	}
+
+	if finishedAt.IsZero() {
+		finishedAt = s.now()
+	}
+	finishedAt = finishedAt.UTC()
+	if finishedAt.Before(session.StartedAt) {
+		return nil, fmt.Errorf("%w: finished_at must not be before started_at", core.ErrValidation)
+	}
+
+	return s.repo.FinishPlaybackSession(ctx, id, finishedAt)
+}
+
+// ListHistory returns a learner's playback sessions, most recently started first.
+func (s *WatchHistoryService) ListHistory(ctx context.Context, filter core.WatchHistoryFilter) ([]core.PlaybackSession, string, error) {
+	filter.UserID = strings.TrimSpace(filter.UserID)
+	if filter.UserID == "" {
+		return nil, "", fmt.Errorf("%w: user id is required", core.ErrValidation)
+	}
+	if !filter.StartedAfter.IsZero() && !filter.StartedBefore.IsZero() && !filter.StartedAfter.Before(filter.StartedBefore) {
+		return nil, "", fmt.Errorf("%w: started_after must be before started_before", core.ErrValidation)
+	}
+	return s.repo.ListPlaybackSessions(ctx, filter)
+}
+
+// ClearHistory deletes a learner's playback sessions started before the
+// cutoff, or the whole history when before is zero.
+func (s *WatchHistoryService) ClearHistory(ctx context.Context, userID string, before time.Time) (int, error) {
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return 0, fmt.Errorf("%w: user id is required", core.ErrValidation)
+	}
+	return s.repo.DeletePlaybackSessions(ctx, userID, before)
+}
@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestWatchHistoryService_RecordPlaybackSession(t *testing.T) {
+	fixedNow := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	liveEpisode := uuid.New()
+	deletedEpisode := uuid.New()
+	deletedAt := fixedNow.Add(-time.Hour)
+	seriesRepo := &stubSeriesRepo{
+		getEpisodeFn: func(ctx context.Context, id uuid.UUID) (*core.Episode, error) {
+			switch id {
+			case liveEpisode:
+				return &core.Episode{ID: id}, nil
+			case deletedEpisode:
+				return &core.Episode{ID: id, DeletedAt: &deletedAt}, nil
+			}
+			return nil, core.ErrNotFound
+		},
+	}
+	earlier := fixedNow.Add(-2 * time.Hour)
+
+	tests := []struct {
+		name    string
+		session core.PlaybackSession
+		wantErr error
+	}{
+		{name: "defaults start to now", session: core.PlaybackSession{UserID: " learner ", EpisodeID: liveEpisode, Device: "ios"}},
+		{name: "missing user", session: core.PlaybackSession{EpisodeID: liveEpisode}, wantErr: core.ErrValidation},
+		{name: "finish before start", session: core.PlaybackSession{UserID: "learner", EpisodeID: liveEpisode, StartedAt: fixedNow, FinishedAt: &earlier}, wantErr: core.ErrValidation},
+		{name: "deleted episode", session: core.PlaybackSession{UserID: "learner", EpisodeID: deletedEpisode}, wantErr: core.ErrNotFound},
+		{name: "unknown episode", session: core.PlaybackSession{UserID: "learner", EpisodeID: uuid.New()}, wantErr: core.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &stubWatchHistoryRepo{}
+			service := NewWatchHistoryService(repo, seriesRepo)
+			service.WithClock(func() time.Time { return fixedNow })
+
+			session, err := service.RecordPlaybackSession(context.Background(), tt.session)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RecordPlaybackSession() error = %v", err)
+			}
+			if session.UserID != "learner" || !session.StartedAt.Equal(fixedNow) || session.ID == uuid.Nil {
+				t.Fatalf("unexpected session %#v", session)
+			}
+		})
+	}
+}
+
+func TestWatchHistoryService_FinishPlaybackSession(t *testing.T) {
+	fixedNow := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	started := fixedNow.Add(-30 * time.Minute)
+	open := core.PlaybackSession{ID: uuid.New(), UserID: "learner", StartedAt: started}
+	finished := core.PlaybackSession{ID: uuid.New(), UserID: "learner", StartedAt: started, FinishedAt: &fixedNow}
+
+	repo := &stubWatchHistoryRepo{sessions: map[uuid.UUID]core.PlaybackSession{open.ID: open, finished.ID: finished}}
+	service := NewWatchHistoryService(repo, &stubSeriesRepo{})
+	service.WithClock(func() time.Time { return fixedNow })
+
+	if _, err := service.FinishPlaybackSession(context.Background(), finished.ID, time.Time{}); !errors.Is(err, core.ErrInvalidState) {
+		t.Fatalf("expected invalid state for finished session, got %v", err)
+	}
+	if _, err := service.FinishPlaybackSession(context.Background(), open.ID, started.Add(-time.Minute)); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected validation error for early finish, got %v", err)
+	}
+
+	session, err := service.FinishPlaybackSession(context.Background(), open.ID, time.Time{})
+	if err != nil {
+		t.Fatalf("FinishPlaybackSession() error = %v", err)
+	}
+	if session.FinishedAt == nil || !session.FinishedAt.Equal(fixedNow) {
+		t.Fatalf("expected session finished at %v, got %v", fixedNow, session.FinishedAt)
+	}
+}
+
+func TestWatchHistoryService_ListHistoryRejectsInvertedRange(t *testing.T) {
+	service := NewWatchHistoryService(&stubWatchHistoryRepo{}, &stubSeriesRepo{})
+	now := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	_, _, err := service.ListHistory(context.Background(), core.WatchHistoryFilter{
+		UserID:        "learner",
+		StartedAfter:  now,
+		StartedBefore: now.Add(-time.Hour),
+	})
+	if !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+}
+
+type stubWatchHistoryRepo struct {
+	sessions map[uuid.UUID]core.PlaybackSession
+}
+
+func (s *stubWatchHistoryRepo) CreatePlaybackSession(ctx context.Context, session core.PlaybackSession) (*core.PlaybackSession, error) {
+	return &session, nil
+}
+
+func (s *stubWatchHistoryRepo) GetPlaybackSession(ctx context.Context, id uuid.UUID) (*core.PlaybackSession, error) {
+	session, ok := s.sessions[id]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	return &session, nil
+}
+
+func (s *stubWatchHistoryRepo) FinishPlaybackSession(ctx context.Context, id uuid.UUID, finishedAt time.Time) (*core.PlaybackSession, error) {
+	session := s.sessions[id]
+	session.FinishedAt = &finishedAt
+	return &session, nil
+}
+
+func (s *stubWatchHistoryRepo) ListPlaybackSessions(ctx context.Context, filter core.WatchHistoryFilter) ([]core.PlaybackSession, string, error) {
+	return nil, "", nil
+}
+
+func (s *stubWatchHistoryRepo) FirstFinishedAt(ctx context.Context, userID string, episodeIDs []uuid.UUID) (map[uuid.UUID]time.Time, error) {
+	finished := make(map[uuid.UUID]time.Time)
+	for _, session := range s.sessions {
+		if session.UserID != userID || session.FinishedAt == nil || !slices.Contains(episodeIDs, session.EpisodeID) {
+			continue
+		}
+		if first, ok := finished[session.EpisodeID]; !ok || session.FinishedAt.Before(first) {
+			finished[session.EpisodeID] = *session.FinishedAt
+		}
+	}
+	return finished, nil
+}
+
+func (s *stubWatchHistoryRepo) DeletePlaybackSessions(ctx context.Context, userID string, before time.Time) (int, error) {
+	return 0, nil
+}
@@ -0,0 +1,431 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+const (
+	maxWebhookErrorLength = 512
+	webhookRetryBatchSize = 100
+	// webhookEventRetention is how long events can be polled with ListRecentEvents.
+	webhookEventRetention = 7 * 24 * time.Hour
+)
+
+// webhookRetrySchedule is the delay before each retry of a failed delivery;
+// deliveries fail permanently once it is exhausted, about a day after the event.
+var webhookRetrySchedule = []time.Duration{
+	time.Minute,
+	5 * time.Minute,
+	30 * time.Minute,
+	2 * time.Hour,
+	6 * time.Hour,
+	12 * time.Hour,
+}
+
+// WebhookService registers integrator endpoints and delivers signed content
+// lifecycle events to them, retrying failed deliveries with backoff.
+type WebhookService struct {
+	repo   core.WebhookRepository
+	client core.WebhookClient
+	now    func() time.Time
+}
+
+// NewWebhookService constructs a webhook service using the supplied repository and client.
+func NewWebhookService(repo core.WebhookRepository, client core.WebhookClient) *WebhookService {
+	return &WebhookService{
+		repo:   repo,
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// WithClock allows tests to override the clock used by the service.
+func (s *WebhookService) WithClock(fn func() time.Time) {
+	if fn != nil {
+		s.now = fn
+	}
+}
+
+var _ core.WebhookService = (*WebhookService)(nil)
+
+// webhookPayload is the JSON body delivered to endpoints.
+type webhookPayload struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+type webhookSeries struct {
+	ID          string     `json:"id"`
+	Slug        string     `json:"slug"`
+	Title       string     `json:"title"`
+	Language    string     `json:"language"`
+	Level       string     `json:"level"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+}
+
+type webhookEpisode struct {
+	ID              string     `json:"id"`
+	SeriesID        string     `json:"series_id"`
+	Seq             uint32     `json:"seq"`
+	Title           string     `json:"title"`
+	Description     string     `json:"description"`
+	Status          string     `json:"status"`
+	DurationSeconds float64    `json:"duration_seconds"`
+	PublishedAt     *time.Time `json:"published_at,omitempty"`
+}
+
+type webhookAsset struct {
+	ID              string  `json:"id"`
+	AssetKey        string  `json:"asset_key"`
+	Type            string  `json:"type"`
+	PlaybackURL     string  `json:"playback_url"`
+	DurationSeconds float64 `json:"duration_seconds"`
+}
+
+// CreateWebhookEndpoint registers an endpoint and generates its signing secret.
+func (s *WebhookService) CreateWebhookEndpoint(ctx context.Context, endpoint core.WebhookEndpoint) (*core.WebhookEndpoint, error) {
+	if err := normalizeWebhookEndpoint(&endpoint); err != nil {
+		return nil, err
+	}
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint.ID = uuid.New()
+	endpoint.Secret = secret
+	return s.repo.CreateWebhookEndpoint(ctx, endpoint)
+}
+
+// GetWebhookEndpoint returns a single endpoint.
+func (s *WebhookService) GetWebhookEndpoint(ctx context.Context, id uuid.UUID) (*core.WebhookEndpoint, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: endpoint id required", core.ErrValidation)
+	}
+	return s.repo.GetWebhookEndpoint(ctx, id)
+}
+
+// UpdateWebhookEndpoint applies new settings to an endpoint; the secret is kept.
+func (s *WebhookService) UpdateWebhookEndpoint(ctx context.Context, endpoint core.WebhookEndpoint) (*core.WebhookEndpoint, error) {
+	if endpoint.ID == uuid.Nil {
+		return nil, fmt.Errorf("%w: endpoint id required", core.ErrValidation)
+	}
+	if err := normalizeWebhookEndpoint(&endpoint); err != nil {
+		return nil, err
+	}
+	return s.repo.UpdateWebhookEndpoint(ctx, endpoint)
+}
+
+// DeleteWebhookEndpoint removes an endpoint and its delivery log.
+func (s *WebhookService) DeleteWebhookEndpoint(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("%w: endpoint id required", core.ErrValidation)
+	}
+	return s.repo.DeleteWebhookEndpoint(ctx, id)
+}
+
+// ListWebhookEndpoints returns a paginated collection of endpoints.
+func (s *WebhookService) ListWebhookEndpoints(ctx context.Context, filter core.WebhookEndpointListFilter) ([]core.WebhookEndpoint, string, error) {
+	return s.repo.ListWebhookEndpoints(ctx, filter)
+}
+
+// ListWebhookDeliveries returns the delivery log, newest first.
+func (s *WebhookService) ListWebhookDeliveries(ctx context.Context, filter core.WebhookDeliveryListFilter) ([]core.WebhookDelivery, string, error) {
+	return s.repo.ListWebhookDeliveries(ctx, filter)
+}
+
+// RedeliverWebhook attempts a delivery again. The delivery takes the status
+// of the new attempt; pending deliveries keep their retry schedule.
+func (s *WebhookService) RedeliverWebhook(ctx context.Context, id uuid.UUID) (*core.WebhookDelivery, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("%w: delivery id required", core.ErrValidation)
+	}
+	delivery, err := s.repo.GetWebhookDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := s.repo.GetWebhookEndpoint(ctx, delivery.EndpointID)
+	if err != nil {
+		return nil, err
+	}
+	return s.attempt(ctx, *endpoint, *delivery)
+}
+
+// ListRecentEvents returns the events of the last seven days, newest first.
+func (s *WebhookService) ListRecentEvents(ctx context.Context, filter core.WebhookEventListFilter) ([]core.WebhookEvent, string, error) {
+	for _, eventType := range filter.Types {
+		if !validWebhookEventType(eventType) {
+			return nil, "", fmt.Errorf("%w: unknown webhook event type %d", core.ErrValidation, eventType)
+		}
+	}
+	return s.repo.ListWebhookEvents(ctx, filter)
+}
+
+// NotifySeriesPublished delivers a series.published event.
+func (s *WebhookService) NotifySeriesPublished(ctx context.Context, series core.Series) (int, error) {
+	return s.publish(ctx, core.WebhookEventTypeSeriesPublished, map[string]any{
+		"series": webhookSeries{
+			ID:          series.ID.String(),
+			Slug:        series.Slug,
+			Title:       series.Title,
+			Language:    series.Language,
+			Level:       series.Level,
+			PublishedAt: series.PublishedAt,
+		},
+	})
+}
+
+// NotifyEpisodeCreated delivers an episode.created event.
+func (s *WebhookService) NotifyEpisodeCreated(ctx context.Context, episode core.Episode) (int, error) {
+	return s.publish(ctx, core.WebhookEventTypeEpisodeCreated, map[string]any{
+		"episode": toWebhookEpisode(episode),
+	})
+}
+
+// NotifyEpisodePublished delivers an episode.published event.
+func (s *WebhookService) NotifyEpisodePublished(ctx context.Context, episode core.Episode) (int, error) {
+	return s.publish(ctx, core.WebhookEventTypeEpisodePublished, map[string]any{
+		"episode": toWebhookEpisode(episode),
+	})
+}
+
+// NotifyAssetReady delivers an asset.ready event.
+func (s *WebhookService) NotifyAssetReady(ctx context.Context, asset core.Asset) (int, error) {
+	return s.publish(ctx, core.WebhookEventTypeAssetReady, map[string]any{
+		"asset": webhookAsset{
+			ID:              asset.ID.String(),
+			AssetKey:        asset.AssetKey,
+			Type:            webhookAssetType(asset.Type),
+			PlaybackURL:     asset.PlaybackURL,
+			DurationSeconds: asset.Duration.Seconds(),
+		},
+	})
+}
+
+// RetryWebhookDeliveries attempts pending deliveries whose backoff elapsed
+// and drops the events older than the retention of ListRecentEvents.
+func (s *WebhookService) RetryWebhookDeliveries(ctx context.Context) (int, error) {
+	now := s.now().UTC()
+	if _, err := s.repo.DeleteWebhookEventsBefore(ctx, now.Add(-webhookEventRetention)); err != nil {
+		return 0, err
+	}
+	due, err := s.repo.ListDueWebhookDeliveries(ctx, now, webhookRetryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	endpoints := map[uuid.UUID]*core.WebhookEndpoint{}
+	succeeded := 0
+	for _, delivery := range due {
+		endpoint, ok := endpoints[delivery.EndpointID]
+		if !ok {
+			endpoint, err = s.repo.GetWebhookEndpoint(ctx, delivery.EndpointID)
+			if err != nil {
+				return succeeded, err
+			}
+			endpoints[delivery.EndpointID] = endpoint
+		}
+		if !endpoint.Enabled {
+			delivery.Status = core.WebhookDeliveryStatusFailed
+			delivery.Error = "endpoint disabled"
+			delivery.NextAttemptAt = nil
+			if _, err := s.repo.UpdateWebhookDelivery(ctx, delivery); err != nil {
+				return succeeded, err
+			}
+			continue
+		}
+
+		result, err := s.attempt(ctx, *endpoint, delivery)
+		if err != nil {
+			return succeeded, err
+		}
+		if result.Status == core.WebhookDeliveryStatusSucceeded {
+			succeeded++
+		}
+	}
+	return succeeded, nil
+}
+
+// publish records the event, logs a delivery for every subscribed endpoint
+// and attempts each once.
+func (s *WebhookService) publish(ctx context.Context, eventType core.WebhookEventType, data any) (int, error) {
+	now := s.now().UTC()
+	eventID := uuid.New()
+	payload, err := json.Marshal(webhookPayload{
+		ID:        eventID.String(),
+		Type:      eventType.String(),
+		CreatedAt: now,
+		Data:      data,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("encode webhook payload: %w", err)
+	}
+	if err := s.repo.CreateWebhookEvent(ctx, core.WebhookEvent{
+		ID:      eventID,
+		Type:    eventType,
+		Payload: payload,
+	}); err != nil {
+		return 0, err
+	}
+
+	endpoints, err := s.repo.ListEnabledWebhookEndpoints(ctx)
+	if err != nil {
+		return 0, err
+	}
+	endpoints = lo.Filter(endpoints, func(endpoint core.WebhookEndpoint, _ int) bool {
+		return endpoint.Subscribes(eventType)
+	})
+	if len(endpoints) == 0 {
+		return 0, nil
+	}
+
+	// The first attempt happens right away; scheduling it one backoff step
+	// ahead keeps the retry sweep from racing it.
+	nextAttemptAt := now.Add(webhookRetrySchedule[0])
+	deliveries := lo.Map(endpoints, func(endpoint core.WebhookEndpoint, _ int) core.WebhookDelivery {
+		return core.WebhookDelivery{
+			ID:            uuid.New(),
+			EndpointID:    endpoint.ID,
+			EventID:       eventID,
+			EventType:     eventType,
+			Payload:       payload,
+			Status:        core.WebhookDeliveryStatusPending,
+			NextAttemptAt: &nextAttemptAt,
+		}
+	})
+	if err := s.repo.CreateWebhookDeliveries(ctx, deliveries); err != nil {
+		return 0, err
+	}
+
+	succeeded := 0
+	for i, delivery := range deliveries {
+		result, err := s.attempt(ctx, endpoints[i], delivery)
+		if err != nil {
+			return succeeded, err
+		}
+		if result.Status == core.WebhookDeliveryStatusSucceeded {
+			succeeded++
+		}
+	}
+	return succeeded, nil
+}
+
+// attempt posts the delivery once and records the outcome. Endpoint failures
+// are recorded on the delivery; only persistence errors are returned.
+func (s *WebhookService) attempt(ctx context.Context, endpoint core.WebhookEndpoint, delivery core.WebhookDelivery) (*core.WebhookDelivery, error) {
+	now := s.now().UTC()
+	status, err := s.client.Deliver(ctx, core.WebhookRequest{
+		URL:        endpoint.URL,
+		Secret:     endpoint.Secret,
+		DeliveryID: delivery.ID,
+		EventType:  delivery.EventType,
+		Payload:    delivery.Payload,
+		Timestamp:  now,
+	})
+
+	delivery.Attempts++
+	delivery.ResponseStatus = status
+	delivery.NextAttemptAt = nil
+	if err == nil {
+		delivery.Status = core.WebhookDeliveryStatusSucceeded
+		delivery.Error = ""
+		delivery.DeliveredAt = &now
+	} else {
+		delivery.Error = truncateWebhookError(err.Error())
+		if delivery.Status == core.WebhookDeliveryStatusPending && delivery.Attempts <= len(webhookRetrySchedule) {
+			delivery.NextAttemptAt = ptrTime(now.Add(webhookRetrySchedule[delivery.Attempts-1]))
+		} else {
+			delivery.Status = core.WebhookDeliveryStatusFailed
+		}
+	}
+
+	return s.repo.UpdateWebhookDelivery(ctx, delivery)
+}
+
+func normalizeWebhookEndpoint(endpoint *core.WebhookEndpoint) error {
+	endpoint.URL = strings.TrimSpace(endpoint.URL)
+	parsed, err := url.Parse(endpoint.URL)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return fmt.Errorf("%w: endpoint url must be an absolute http(s) url", core.ErrValidation)
+	}
+	for _, eventType := range endpoint.EventTypes {
+		if !validWebhookEventType(eventType) {
+			return fmt.Errorf("%w: unknown webhook event type %d", core.ErrValidation, eventType)
+		}
+	}
+	endpoint.EventTypes = lo.Uniq(endpoint.EventTypes)
+	endpoint.Description = strings.TrimSpace(endpoint.Description)
+	return nil
+}
+
+func validWebhookEventType(eventType core.WebhookEventType) bool {
+	return eventType > core.WebhookEventTypeUnspecified && eventType <= core.WebhookEventTypeEpisodePublished
+}
+
+func generateWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate webhook secret: %w", err)
+	}
+	return "whsec_" + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func truncateWebhookError(message string) string {
+	if len(message) <= maxWebhookErrorLength {
+		return message
+	}
+	return message[:maxWebhookErrorLength]
+}
+
+func toWebhookEpisode(episode core.Episode) webhookEpisode {
+	return webhookEpisode{
+		ID:              episode.ID.String(),
+		SeriesID:        episode.SeriesID.String(),
+		Seq:             episode.Seq,
+		Title:           episode.Title,
+		Description:     episode.Description,
+		Status:          webhookEpisodeStatus(episode.Status),
+		DurationSeconds: episode.Duration.Seconds(),
+		PublishedAt:     episode.PublishedAt,
+	}
+}
+
+func webhookEpisodeStatus(status core.EpisodeStatus) string {
+	switch status {
+	case core.EpisodeStatusDraft:
+		return "draft"
+	case core.EpisodeStatusReady:
+		return "ready"
+	case core.EpisodeStatusPublished:
+		return "published"
+	case core.EpisodeStatusArchived:
+		return "archived"
+	default:
+		return "unspecified"
+	}
+}
+
+func webhookAssetType(assetType core.AssetType) string {
+	switch assetType {
+	case core.AssetTypeVideo:
+		return "video"
+	case core.AssetTypeAudio:
+		return "audio"
+	default:
+		return "unspecified"
+	}
+}
@@ -0,0 +1,322 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+type stubWebhookRepo struct {
+	endpoints  map[uuid.UUID]core.WebhookEndpoint
+	deliveries []core.WebhookDelivery
+	events     []core.WebhookEvent
+	// now stamps created events, as the time mixin does.
+	now func() time.Time
+}
+
+func newStubWebhookRepo() *stubWebhookRepo {
+	return &stubWebhookRepo{endpoints: map[uuid.UUID]core.WebhookEndpoint{}}
+}
+
+func (s *stubWebhookRepo) CreateWebhookEndpoint(ctx context.Context, endpoint core.WebhookEndpoint) (*core.WebhookEndpoint, error) {
+	s.endpoints[endpoint.ID] = endpoint
+	return &endpoint, nil
+}
+
+func (s *stubWebhookRepo) GetWebhookEndpoint(ctx context.Context, id uuid.UUID) (*core.WebhookEndpoint, error) {
+	endpoint, ok := s.endpoints[id]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	return &endpoint, nil
+}
+
+func (s *stubWebhookRepo) UpdateWebhookEndpoint(ctx context.Context, endpoint core.WebhookEndpoint) (*core.WebhookEndpoint, error) {
+	existing, ok := s.endpoints[endpoint.ID]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+	endpoint.Secret = existing.Secret
+	s.endpoints[endpoint.ID] = endpoint
+	return &endpoint, nil
+}
+
+func (s *stubWebhookRepo) DeleteWebhookEndpoint(ctx context.Context, id uuid.UUID) error {
+	delete(s.endpoints, id)
+	return nil
+}
+
+func (s *stubWebhookRepo) ListWebhookEndpoints(ctx context.Context, filter core.WebhookEndpointListFilter) ([]core.WebhookEndpoint, string, error) {
+	return nil, "", nil
+}
+
+func (s *stubWebhookRepo) ListEnabledWebhookEndpoints(ctx context.Context) ([]core.WebhookEndpoint, error) {
+	var out []core.WebhookEndpoint
+	for _, endpoint := range s.endpoints {
+		if endpoint.Enabled {
+			out = append(out, endpoint)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubWebhookRepo) CreateWebhookDeliveries(ctx context.Context, deliveries []core.WebhookDelivery) error {
+	s.deliveries = append(s.deliveries, deliveries...)
+	return nil
+}
+
+func (s *stubWebhookRepo) GetWebhookDelivery(ctx context.Context, id uuid.UUID) (*core.WebhookDelivery, error) {
+	for _, delivery := range s.deliveries {
+		if delivery.ID == id {
+			return &delivery, nil
+		}
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubWebhookRepo) UpdateWebhookDelivery(ctx context.Context, delivery core.WebhookDelivery) (*core.WebhookDelivery, error) {
+	for i := range s.deliveries {
+		if s.deliveries[i].ID == delivery.ID {
+			s.deliveries[i] = delivery
+			return &delivery, nil
+		}
+	}
+	return nil, core.ErrNotFound
+}
+
+func (s *stubWebhookRepo) ListWebhookDeliveries(ctx context.Context, filter core.WebhookDeliveryListFilter) ([]core.WebhookDelivery, string, error) {
+	return s.deliveries, "", nil
+}
+
+func (s *stubWebhookRepo) ListDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]core.WebhookDelivery, error) {
+	var out []core.WebhookDelivery
+	for _, delivery := range s.deliveries {
+		if delivery.Status == core.WebhookDeliveryStatusPending && !delivery.NextAttemptAt.After(now) {
+			out = append(out, delivery)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubWebhookRepo) CreateWebhookEvent(ctx context.Context, event core.WebhookEvent) error {
+	if s.now != nil {
+		event.CreatedAt = s.now()
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *stubWebhookRepo) ListWebhookEvents(ctx context.Context, filter core.WebhookEventListFilter) ([]core.WebhookEvent, string, error) {
+	var out []core.WebhookEvent
+	for _, event := range slices.Backward(s.events) {
+		if len(filter.Types) == 0 || slices.Contains(filter.Types, event.Type) {
+			out = append(out, event)
+		}
+	}
+	return out, "", nil
+}
+
+func (s *stubWebhookRepo) DeleteWebhookEventsBefore(ctx context.Context, before time.Time) (int, error) {
+	kept := slices.DeleteFunc(s.events, func(event core.WebhookEvent) bool {
+		return event.CreatedAt.Before(before)
+	})
+	deleted := len(s.events) - len(kept)
+	s.events = kept
+	return deleted, nil
+}
+
+// stubWebhookClient fails deliveries to URLs listed in failing.
+type stubWebhookClient struct {
+	requests []core.WebhookRequest
+	failing  map[string]bool
+}
+
+func (s *stubWebhookClient) Deliver(ctx context.Context, req core.WebhookRequest) (int, error) {
+	s.requests = append(s.requests, req)
+	if s.failing[req.URL] {
+		return 500, errors.New("webhook: endpoint responded 500 Internal Server Error")
+	}
+	return 204, nil
+}
+
+func TestWebhookService_CreateWebhookEndpoint(t *testing.T) {
+	service := NewWebhookService(newStubWebhookRepo(), &stubWebhookClient{})
+
+	endpoint, err := service.CreateWebhookEndpoint(context.Background(), core.WebhookEndpoint{
+		URL:        " https://hooks.example.com/lession ",
+		EventTypes: []core.WebhookEventType{core.WebhookEventTypeAssetReady, core.WebhookEventTypeAssetReady},
+		Enabled:    true,
+	})
+	if err != nil {
+		t.Fatalf("CreateWebhookEndpoint() error = %v", err)
+	}
+	if endpoint.URL != "https://hooks.example.com/lession" || !strings.HasPrefix(endpoint.Secret, "whsec_") || len(endpoint.EventTypes) != 1 {
+		t.Fatalf("unexpected endpoint %+v", endpoint)
+	}
+
+	for _, invalid := range []core.WebhookEndpoint{
+		{URL: "ftp://hooks.example.com"},
+		{URL: "/relative"},
+		{URL: "https://hooks.example.com", EventTypes: []core.WebhookEventType{core.WebhookEventTypeUnspecified}},
+	} {
+		if _, err := service.CreateWebhookEndpoint(context.Background(), invalid); !errors.Is(err, core.ErrValidation) {
+			t.Fatalf("expected ErrValidation for %+v, got %v", invalid, err)
+		}
+	}
+}
+
+func TestWebhookService_NotifyDeliversToSubscribedEndpoints(t *testing.T) {
+	repo := newStubWebhookRepo()
+	client := &stubWebhookClient{failing: map[string]bool{"https://down.example.com": true}}
+	service := NewWebhookService(repo, client)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	service.WithClock(func() time.Time { return now })
+	ctx := context.Background()
+
+	for _, endpoint := range []core.WebhookEndpoint{
+		{URL: "https://catalog.example.com", EventTypes: []core.WebhookEventType{core.WebhookEventTypeSeriesPublished}, Enabled: true},
+		{URL: "https://down.example.com", Enabled: true},
+		{URL: "https://assets.example.com", EventTypes: []core.WebhookEventType{core.WebhookEventTypeAssetReady}, Enabled: true},
+		{URL: "https://paused.example.com", Enabled: false},
+	} {
+		if _, err := service.CreateWebhookEndpoint(ctx, endpoint); err != nil {
+			t.Fatalf("CreateWebhookEndpoint() error = %v", err)
+		}
+	}
+
+	series := core.Series{ID: uuid.New(), Slug: "travel-english", Title: "Travel English", PublishedAt: &now}
+	sent, err := service.NotifySeriesPublished(ctx, series)
+	if err != nil {
+		t.Fatalf("NotifySeriesPublished() error = %v", err)
+	}
+	if sent != 1 || len(client.requests) != 2 || len(repo.deliveries) != 2 {
+		t.Fatalf("expected deliveries to the catalog and the failing endpoint, got %d sent, %+v", sent, client.requests)
+	}
+
+	var payload struct {
+		Type string `json:"type"`
+		Data struct {
+			Series struct {
+				ID string `json:"id"`
+			} `json:"series"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(client.requests[0].Payload, &payload); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	if payload.Type != "series.published" || payload.Data.Series.ID != series.ID.String() {
+		t.Fatalf("unexpected payload %s", client.requests[0].Payload)
+	}
+
+	idx := slices.IndexFunc(repo.deliveries, func(delivery core.WebhookDelivery) bool {
+		return delivery.Status == core.WebhookDeliveryStatusPending
+	})
+	if idx < 0 {
+		t.Fatalf("expected the failed delivery to stay pending, got %+v", repo.deliveries)
+	}
+	failed := repo.deliveries[idx]
+	if failed.Attempts != 1 || failed.ResponseStatus != 500 || !failed.NextAttemptAt.Equal(now.Add(time.Minute)) {
+		t.Fatalf("unexpected retry state %+v", failed)
+	}
+}
+
+func TestWebhookService_RetryWebhookDeliveriesGivesUp(t *testing.T) {
+	repo := newStubWebhookRepo()
+	client := &stubWebhookClient{failing: map[string]bool{"https://down.example.com": true}}
+	service := NewWebhookService(repo, client)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	service.WithClock(func() time.Time { return now })
+	ctx := context.Background()
+
+	if _, err := service.CreateWebhookEndpoint(ctx, core.WebhookEndpoint{URL: "https://down.example.com", Enabled: true}); err != nil {
+		t.Fatalf("CreateWebhookEndpoint() error = %v", err)
+	}
+	if _, err := service.NotifyAssetReady(ctx, core.Asset{ID: uuid.New(), Type: core.AssetTypeAudio}); err != nil {
+		t.Fatalf("NotifyAssetReady() error = %v", err)
+	}
+
+	for range webhookRetrySchedule {
+		now = now.Add(24 * time.Hour)
+		if _, err := service.RetryWebhookDeliveries(ctx); err != nil {
+			t.Fatalf("RetryWebhookDeliveries() error = %v", err)
+		}
+	}
+
+	delivery := repo.deliveries[0]
+	if delivery.Status != core.WebhookDeliveryStatusFailed || delivery.Attempts != len(webhookRetrySchedule)+1 || delivery.NextAttemptAt != nil {
+		t.Fatalf("expected the delivery to fail after exhausting retries, got %+v", delivery)
+	}
+
+	client.failing = nil
+	redelivered, err := service.RedeliverWebhook(ctx, delivery.ID)
+	if err != nil {
+		t.Fatalf("RedeliverWebhook() error = %v", err)
+	}
+	if redelivered.Status != core.WebhookDeliveryStatusSucceeded || redelivered.DeliveredAt == nil || redelivered.Error != "" {
+		t.Fatalf("unexpected redelivery %+v", redelivered)
+	}
+}
+
+func TestWebhookService_ListRecentEvents(t *testing.T) {
+	repo := newStubWebhookRepo()
+	service := NewWebhookService(repo, &stubWebhookClient{})
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	service.WithClock(func() time.Time { return now })
+	repo.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	// Events are recorded even when no endpoint subscribes to them.
+	if _, err := service.NotifyAssetReady(ctx, core.Asset{ID: uuid.New(), Type: core.AssetTypeVideo}); err != nil {
+		t.Fatalf("NotifyAssetReady() error = %v", err)
+	}
+	now = now.Add(time.Hour)
+	episode := core.Episode{ID: uuid.New(), SeriesID: uuid.New(), Seq: 3, Title: "Ordering food", Status: core.EpisodeStatusPublished, PublishedAt: &now}
+	if _, err := service.NotifyEpisodePublished(ctx, episode); err != nil {
+		t.Fatalf("NotifyEpisodePublished() error = %v", err)
+	}
+
+	events, _, err := service.ListRecentEvents(ctx, core.WebhookEventListFilter{})
+	if err != nil {
+		t.Fatalf("ListRecentEvents() error = %v", err)
+	}
+	if len(events) != 2 || events[0].Type != core.WebhookEventTypeEpisodePublished {
+		t.Fatalf("expected both events, newest first, got %+v", events)
+	}
+	var payload struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+		Data struct {
+			Episode struct {
+				Title  string `json:"title"`
+				Status string `json:"status"`
+			} `json:"episode"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(events[0].Payload, &payload); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	if payload.ID != events[0].ID.String() || payload.Type != "episode.published" || payload.Data.Episode.Title != "Ordering food" || payload.Data.Episode.Status != "published" {
+		t.Fatalf("unexpected payload %s", events[0].Payload)
+	}
+
+	if _, _, err := service.ListRecentEvents(ctx, core.WebhookEventListFilter{Types: []core.WebhookEventType{42}}); !errors.Is(err, core.ErrValidation) {
+		t.Fatalf("expected ErrValidation for an unknown type, got %v", err)
+	}
+
+	now = now.Add(webhookEventRetention)
+	if _, err := service.RetryWebhookDeliveries(ctx); err != nil {
+		t.Fatalf("RetryWebhookDeliveries() error = %v", err)
+	}
+	events, _, _ = service.ListRecentEvents(ctx, core.WebhookEventListFilter{})
+	if len(events) != 1 || events[0].Type != core.WebhookEventTypeEpisodePublished {
+		t.Fatalf("expected only the event within the retention, got %+v", events)
+	}
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+// WidgetService derives the public data shown by embeddable widgets. Only
+// published series and their published episodes are ever exposed.
+type WidgetService struct {
+	series  core.SeriesRepository
+	history core.WatchHistoryRepository
+}
+
+// NewWidgetService constructs a widget service using the supplied repositories.
+func NewWidgetService(series core.SeriesRepository, history core.WatchHistoryRepository) *WidgetService {
+	return &WidgetService{
+		series:  series,
+		history: history,
+	}
+}
+
+var _ core.WidgetService = (*WidgetService)(nil)
+
+// GetSeriesWidgetStats returns the episode count and total duration of a published series.
+func (s *WidgetService) GetSeriesWidgetStats(ctx context.Context, seriesID uuid.UUID) (*core.SeriesWidgetStats, error) {
+	series, episodes, err := s.publishedSeries(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.SeriesWidgetStats{
+		SeriesID:     series.ID,
+		Slug:         series.Slug,
+		Title:        series.Title,
+		EpisodeCount: len(episodes),
+		TotalDuration: lo.SumBy(episodes, func(episode core.Episode) time.Duration {
+			return episode.Duration
+		}),
+	}, nil
+}
+
+// VerifySeriesCertificate reports how many of a series' published episodes the
+// learner has finished. The certificate is complete once every one has been.
+func (s *WidgetService) VerifySeriesCertificate(ctx context.Context, seriesID uuid.UUID, userID string) (*core.SeriesCertificate, error) {
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return nil, fmt.Errorf("%w: user id is required", core.ErrValidation)
+	}
+
+	series, episodes, err := s.publishedSeries(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	finished, err := s.history.FirstFinishedAt(ctx, userID, lo.Map(episodes, func(episode core.Episode, _ int) uuid.UUID {
+		return episode.ID
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	certificate := &core.SeriesCertificate{
+		UserID:            userID,
+		SeriesID:          series.ID,
+		SeriesTitle:       series.Title,
+		EpisodesCompleted: len(finished),
+		EpisodeCount:      len(episodes),
+		Completed:         len(episodes) > 0 && len(finished) == len(episodes),
+	}
+	if certificate.Completed {
+		completedAt := lo.MaxBy(lo.Values(finished), func(a, b time.Time) bool {
+			return a.After(b)
+		})
+		certificate.CompletedAt = &completedAt
+	}
+	return certificate, nil
+}
+
+func (s *WidgetService) publishedSeries(ctx context.Context, seriesID uuid.UUID) (*core.Series, []core.Episode, error) {
+	if seriesID == uuid.Nil {
+		return nil, nil, fmt.Errorf("%w: series id required", core.ErrValidation)
+	}
+
+	series, err := s.series.GetSeries(ctx, seriesID, core.SeriesQueryOptions{IncludeEpisodes: true})
+	if err != nil {
+		return nil, nil, err
+	}
+	if series.Status != core.SeriesStatusPublished {
+		return nil, nil, core.ErrNotFound
+	}
+
+	episodes := lo.Filter(series.Episodes, func(episode core.Episode, _ int) bool {
+		return episode.Status == core.EpisodeStatusPublished && episode.DeletedAt == nil
+	})
+	return series, episodes, nil
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/eslsoft/lession/internal/core"
+)
+
+func TestWidgetService_VerifySeriesCertificate(t *testing.T) {
+	seriesID := uuid.New()
+	draftSeriesID := uuid.New()
+	first, second, draft := uuid.New(), uuid.New(), uuid.New()
+	seriesRepo := &stubSeriesRepo{
+		getSeriesFn: func(ctx context.Context, id uuid.UUID, opts core.SeriesQueryOptions) (*core.Series, error) {
+			switch id {
+			case seriesID:
+				return &core.Series{ID: id, Title: "Travel", Status: core.SeriesStatusPublished, Episodes: []core.Episode{
+					{ID: first, Status: core.EpisodeStatusPublished, Duration: time.Minute},
+					{ID: second, Status: core.EpisodeStatusPublished, Duration: 2 * time.Minute},
+					{ID: draft, Status: core.EpisodeStatusDraft, Duration: time.Hour},
+				}}, nil
+			case draftSeriesID:
+				return &core.Series{ID: id, Status: core.SeriesStatusDraft}, nil
+			}
+			return nil, core.ErrNotFound
+		},
+	}
+
+	day := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	finished := func(episodeID uuid.UUID, at time.Time) core.PlaybackSession {
+		return core.PlaybackSession{ID: uuid.New(), UserID: "learner", EpisodeID: episodeID, FinishedAt: &at}
+	}
+	sessions := []core.PlaybackSession{
+		finished(first, day),
+		finished(first, day.Add(72*time.Hour)),
+		finished(second, day.Add(24*time.Hour)),
+		{ID: uuid.New(), UserID: "partial", EpisodeID: first},
+	}
+	repo := &stubWatchHistoryRepo{sessions: make(map[uuid.UUID]core.PlaybackSession)}
+	for _, session := range sessions {
+		repo.sessions[session.ID] = session
+	}
+	service := NewWidgetService(seriesRepo, repo)
+	ctx := context.Background()
+
+	certificate, err := service.VerifySeriesCertificate(ctx, seriesID, "learner")
+	if err != nil {
+		t.Fatalf("VerifySeriesCertificate() error = %v", err)
+	}
+	if !certificate.Completed || certificate.EpisodeCount != 2 || certificate.CompletedAt == nil || !certificate.CompletedAt.Equal(day.Add(24*time.Hour)) {
+		t.Fatalf("unexpected certificate %#v", certificate)
+	}
+
+	certificate, err = service.VerifySeriesCertificate(ctx, seriesID, "partial")
+	if err != nil {
+		t.Fatalf("VerifySeriesCertificate() error = %v", err)
+	}
+	if certificate.Completed || certificate.EpisodesCompleted != 0 {
+		t.Fatalf("expected unfinished sessions not to count, got %#v", certificate)
+	}
+
+	stats, err := service.GetSeriesWidgetStats(ctx, seriesID)
+	if err != nil {
+		t.Fatalf("GetSeriesWidgetStats() error = %v", err)
+	}
+	if stats.EpisodeCount != 2 || stats.TotalDuration != 3*time.Minute {
+		t.Fatalf("expected only published episodes counted, got %#v", stats)
+	}
+
+	if _, err := service.GetSeriesWidgetStats(ctx, draftSeriesID); !errors.Is(err, core.ErrNotFound) {
+		t.Fatalf("expected draft series to be hidden, got %v", err)
+	}
+}
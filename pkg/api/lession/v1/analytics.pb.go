@@ -0,0 +1,334 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/analytics.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EngagementMetrics aggregates the playback sessions of an episode or series.
+type EngagementMetrics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sessions counts the playback sessions.
+	Sessions int32 `protobuf:"varint,1,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	// finished_sessions counts the sessions played to the end.
+	FinishedSessions int32 `protobuf:"varint,2,opt,name=finished_sessions,json=finishedSessions,proto3" json:"finished_sessions,omitempty"`
+	// unique_listeners counts the learners with any session.
+	UniqueListeners int32 `protobuf:"varint,3,opt,name=unique_listeners,json=uniqueListeners,proto3" json:"unique_listeners,omitempty"`
+	// completers counts the listeners who finished at least one session.
+	Completers int32 `protobuf:"varint,4,opt,name=completers,proto3" json:"completers,omitempty"`
+	// completion_rate is the share of unique listeners who finished.
+	CompletionRate float64 `protobuf:"fixed64,5,opt,name=completion_rate,json=completionRate,proto3" json:"completion_rate,omitempty"`
+	// listen_through is the share of sessions played to the end.
+	ListenThrough float64 `protobuf:"fixed64,6,opt,name=listen_through,json=listenThrough,proto3" json:"listen_through,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EngagementMetrics) Reset() {
+	*x = EngagementMetrics{}
+	mi := &file_lession_v1_analytics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EngagementMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngagementMetrics) ProtoMessage() {}
+
+func (x *EngagementMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_analytics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngagementMetrics.ProtoReflect.Descriptor instead.
+func (*EngagementMetrics) Descriptor() ([]byte, []int) {
+	return file_lession_v1_analytics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EngagementMetrics) GetSessions() int32 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *EngagementMetrics) GetFinishedSessions() int32 {
+	if x != nil {
+		return x.FinishedSessions
+	}
+	return 0
+}
+
+func (x *EngagementMetrics) GetUniqueListeners() int32 {
+	if x != nil {
+		return x.UniqueListeners
+	}
+	return 0
+}
+
+func (x *EngagementMetrics) GetCompleters() int32 {
+	if x != nil {
+		return x.Completers
+	}
+	return 0
+}
+
+func (x *EngagementMetrics) GetCompletionRate() float64 {
+	if x != nil {
+		return x.CompletionRate
+	}
+	return 0
+}
+
+func (x *EngagementMetrics) GetListenThrough() float64 {
+	if x != nil {
+		return x.ListenThrough
+	}
+	return 0
+}
+
+// DailyEngagement is the engagement on a UTC day.
+type DailyEngagement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// day is the UTC midnight of the day the sessions started.
+	Day *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	// metrics aggregates the sessions started that day.
+	Metrics       *EngagementMetrics `protobuf:"bytes,2,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyEngagement) Reset() {
+	*x = DailyEngagement{}
+	mi := &file_lession_v1_analytics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyEngagement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyEngagement) ProtoMessage() {}
+
+func (x *DailyEngagement) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_analytics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyEngagement.ProtoReflect.Descriptor instead.
+func (*DailyEngagement) Descriptor() ([]byte, []int) {
+	return file_lession_v1_analytics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DailyEngagement) GetDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *DailyEngagement) GetMetrics() *EngagementMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+// EngagementAnalytics reports the rolled-up engagement with an episode or series.
+type EngagementAnalytics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// series_id references the series, or the series of the episode.
+	SeriesId string `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// episode_id references the episode; empty for series analytics.
+	EpisodeId string `protobuf:"bytes,2,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// total covers every session up to the last rollup.
+	Total *EngagementMetrics `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	// days covers every day of the requested range, oldest first.
+	Days []*DailyEngagement `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"`
+	// rolled_up_at is when the totals were last rolled up; unset before the first rollup.
+	RolledUpAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=rolled_up_at,json=rolledUpAt,proto3" json:"rolled_up_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EngagementAnalytics) Reset() {
+	*x = EngagementAnalytics{}
+	mi := &file_lession_v1_analytics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EngagementAnalytics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngagementAnalytics) ProtoMessage() {}
+
+func (x *EngagementAnalytics) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_analytics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngagementAnalytics.ProtoReflect.Descriptor instead.
+func (*EngagementAnalytics) Descriptor() ([]byte, []int) {
+	return file_lession_v1_analytics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EngagementAnalytics) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *EngagementAnalytics) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *EngagementAnalytics) GetTotal() *EngagementMetrics {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *EngagementAnalytics) GetDays() []*DailyEngagement {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *EngagementAnalytics) GetRolledUpAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RolledUpAt
+	}
+	return nil
+}
+
+var File_lession_v1_analytics_proto protoreflect.FileDescriptor
+
+const file_lession_v1_analytics_proto_rawDesc = "" +
+	"\n" +
+	"\x1alession/v1/analytics.proto\x12\n" +
+	"lession.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf7\x01\n" +
+	"\x11EngagementMetrics\x12\x1a\n" +
+	"\bsessions\x18\x01 \x01(\x05R\bsessions\x12+\n" +
+	"\x11finished_sessions\x18\x02 \x01(\x05R\x10finishedSessions\x12)\n" +
+	"\x10unique_listeners\x18\x03 \x01(\x05R\x0funiqueListeners\x12\x1e\n" +
+	"\n" +
+	"completers\x18\x04 \x01(\x05R\n" +
+	"completers\x12'\n" +
+	"\x0fcompletion_rate\x18\x05 \x01(\x01R\x0ecompletionRate\x12%\n" +
+	"\x0elisten_through\x18\x06 \x01(\x01R\rlistenThrough\"x\n" +
+	"\x0fDailyEngagement\x12,\n" +
+	"\x03day\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x03day\x127\n" +
+	"\ametrics\x18\x02 \x01(\v2\x1d.lession.v1.EngagementMetricsR\ametrics\"\xf5\x01\n" +
+	"\x13EngagementAnalytics\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x02 \x01(\tR\tepisodeId\x123\n" +
+	"\x05total\x18\x03 \x01(\v2\x1d.lession.v1.EngagementMetricsR\x05total\x12/\n" +
+	"\x04days\x18\x04 \x03(\v2\x1b.lession.v1.DailyEngagementR\x04days\x12<\n" +
+	"\frolled_up_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"rolledUpAtB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_analytics_proto_rawDescOnce sync.Once
+	file_lession_v1_analytics_proto_rawDescData []byte
+)
+
+func file_lession_v1_analytics_proto_rawDescGZIP() []byte {
+	file_lession_v1_analytics_proto_rawDescOnce.Do(func() {
+		file_lession_v1_analytics_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_analytics_proto_rawDesc), len(file_lession_v1_analytics_proto_rawDesc)))
+	})
+	return file_lession_v1_analytics_proto_rawDescData
+}
+
+var file_lession_v1_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_lession_v1_analytics_proto_goTypes = []any{
+	(*EngagementMetrics)(nil),     // 0: lession.v1.EngagementMetrics
+	(*DailyEngagement)(nil),       // 1: lession.v1.DailyEngagement
+	(*EngagementAnalytics)(nil),   // 2: lession.v1.EngagementAnalytics
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_lession_v1_analytics_proto_depIdxs = []int32{
+	3, // 0: lession.v1.DailyEngagement.day:type_name -> google.protobuf.Timestamp
+	0, // 1: lession.v1.DailyEngagement.metrics:type_name -> lession.v1.EngagementMetrics
+	0, // 2: lession.v1.EngagementAnalytics.total:type_name -> lession.v1.EngagementMetrics
+	1, // 3: lession.v1.EngagementAnalytics.days:type_name -> lession.v1.DailyEngagement
+	3, // 4: lession.v1.EngagementAnalytics.rolled_up_at:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_analytics_proto_init() }
+func file_lession_v1_analytics_proto_init() {
+	if File_lession_v1_analytics_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_analytics_proto_rawDesc), len(file_lession_v1_analytics_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_analytics_proto_goTypes,
+		DependencyIndexes: file_lession_v1_analytics_proto_depIdxs,
+		MessageInfos:      file_lession_v1_analytics_proto_msgTypes,
+	}.Build()
+	File_lession_v1_analytics_proto = out.File
+	file_lession_v1_analytics_proto_goTypes = nil
+	file_lession_v1_analytics_proto_depIdxs = nil
+}
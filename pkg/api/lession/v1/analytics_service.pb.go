@@ -0,0 +1,436 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/analytics_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetEpisodeAnalyticsRequest selects the episode and days to report.
+type GetEpisodeAnalyticsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// episode_id references the target episode.
+	EpisodeId string `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// from is the first day to report; defaults to 30 days before to.
+	From *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the day after the last day to report; defaults to tomorrow, or 30 days after from.
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpisodeAnalyticsRequest) Reset() {
+	*x = GetEpisodeAnalyticsRequest{}
+	mi := &file_lession_v1_analytics_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodeAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodeAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetEpisodeAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_analytics_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodeAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetEpisodeAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_analytics_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetEpisodeAnalyticsRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *GetEpisodeAnalyticsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetEpisodeAnalyticsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// GetEpisodeAnalyticsResponse returns the engagement with the episode.
+type GetEpisodeAnalyticsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// analytics contains the totals and daily metrics.
+	Analytics     *EngagementAnalytics `protobuf:"bytes,1,opt,name=analytics,proto3" json:"analytics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpisodeAnalyticsResponse) Reset() {
+	*x = GetEpisodeAnalyticsResponse{}
+	mi := &file_lession_v1_analytics_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodeAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodeAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetEpisodeAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_analytics_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodeAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetEpisodeAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_analytics_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetEpisodeAnalyticsResponse) GetAnalytics() *EngagementAnalytics {
+	if x != nil {
+		return x.Analytics
+	}
+	return nil
+}
+
+// GetSeriesAnalyticsRequest selects the series and days to report.
+type GetSeriesAnalyticsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// series_id references the target series.
+	SeriesId string `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// from is the first day to report; defaults to 30 days before to.
+	From *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the day after the last day to report; defaults to tomorrow, or 30 days after from.
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeriesAnalyticsRequest) Reset() {
+	*x = GetSeriesAnalyticsRequest{}
+	mi := &file_lession_v1_analytics_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeriesAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeriesAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetSeriesAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_analytics_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeriesAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetSeriesAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_analytics_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSeriesAnalyticsRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *GetSeriesAnalyticsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetSeriesAnalyticsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// GetSeriesAnalyticsResponse returns the engagement with the series.
+type GetSeriesAnalyticsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// analytics contains the totals and daily metrics.
+	Analytics     *EngagementAnalytics `protobuf:"bytes,1,opt,name=analytics,proto3" json:"analytics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeriesAnalyticsResponse) Reset() {
+	*x = GetSeriesAnalyticsResponse{}
+	mi := &file_lession_v1_analytics_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeriesAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeriesAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetSeriesAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_analytics_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeriesAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetSeriesAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_analytics_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSeriesAnalyticsResponse) GetAnalytics() *EngagementAnalytics {
+	if x != nil {
+		return x.Analytics
+	}
+	return nil
+}
+
+// RollupEngagementRequest selects the day to roll up.
+type RollupEngagementRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// day is any time within the UTC day to roll up.
+	Day           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollupEngagementRequest) Reset() {
+	*x = RollupEngagementRequest{}
+	mi := &file_lession_v1_analytics_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollupEngagementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollupEngagementRequest) ProtoMessage() {}
+
+func (x *RollupEngagementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_analytics_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollupEngagementRequest.ProtoReflect.Descriptor instead.
+func (*RollupEngagementRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_analytics_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RollupEngagementRequest) GetDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+// RollupEngagementResponse returns the queued rollup job.
+type RollupEngagementResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// job runs the rollup; follow it with the JobService.
+	Job           *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollupEngagementResponse) Reset() {
+	*x = RollupEngagementResponse{}
+	mi := &file_lession_v1_analytics_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollupEngagementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollupEngagementResponse) ProtoMessage() {}
+
+func (x *RollupEngagementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_analytics_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollupEngagementResponse.ProtoReflect.Descriptor instead.
+func (*RollupEngagementResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_analytics_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RollupEngagementResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+var File_lession_v1_analytics_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_analytics_service_proto_rawDesc = "" +
+	"\n" +
+	"\"lession/v1/analytics_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1alession/v1/analytics.proto\x1a\x14lession/v1/job.proto\"\xa1\x01\n" +
+	"\x1aGetEpisodeAnalyticsRequest\x12'\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tepisodeId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\\\n" +
+	"\x1bGetEpisodeAnalyticsResponse\x12=\n" +
+	"\tanalytics\x18\x01 \x01(\v2\x1f.lession.v1.EngagementAnalyticsR\tanalytics\"\x9e\x01\n" +
+	"\x19GetSeriesAnalyticsRequest\x12%\n" +
+	"\tseries_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bseriesId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"[\n" +
+	"\x1aGetSeriesAnalyticsResponse\x12=\n" +
+	"\tanalytics\x18\x01 \x01(\v2\x1f.lession.v1.EngagementAnalyticsR\tanalytics\"O\n" +
+	"\x17RollupEngagementRequest\x124\n" +
+	"\x03day\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x03day\"=\n" +
+	"\x18RollupEngagementResponse\x12!\n" +
+	"\x03job\x18\x01 \x01(\v2\x0f.lession.v1.JobR\x03job2\xbe\x02\n" +
+	"\x10AnalyticsService\x12f\n" +
+	"\x13GetEpisodeAnalytics\x12&.lession.v1.GetEpisodeAnalyticsRequest\x1a'.lession.v1.GetEpisodeAnalyticsResponse\x12c\n" +
+	"\x12GetSeriesAnalytics\x12%.lession.v1.GetSeriesAnalyticsRequest\x1a&.lession.v1.GetSeriesAnalyticsResponse\x12]\n" +
+	"\x10RollupEngagement\x12#.lession.v1.RollupEngagementRequest\x1a$.lession.v1.RollupEngagementResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_analytics_service_proto_rawDescOnce sync.Once
+	file_lession_v1_analytics_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_analytics_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_analytics_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_analytics_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_analytics_service_proto_rawDesc), len(file_lession_v1_analytics_service_proto_rawDesc)))
+	})
+	return file_lession_v1_analytics_service_proto_rawDescData
+}
+
+var file_lession_v1_analytics_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_lession_v1_analytics_service_proto_goTypes = []any{
+	(*GetEpisodeAnalyticsRequest)(nil),  // 0: lession.v1.GetEpisodeAnalyticsRequest
+	(*GetEpisodeAnalyticsResponse)(nil), // 1: lession.v1.GetEpisodeAnalyticsResponse
+	(*GetSeriesAnalyticsRequest)(nil),   // 2: lession.v1.GetSeriesAnalyticsRequest
+	(*GetSeriesAnalyticsResponse)(nil),  // 3: lession.v1.GetSeriesAnalyticsResponse
+	(*RollupEngagementRequest)(nil),     // 4: lession.v1.RollupEngagementRequest
+	(*RollupEngagementResponse)(nil),    // 5: lession.v1.RollupEngagementResponse
+	(*timestamppb.Timestamp)(nil),       // 6: google.protobuf.Timestamp
+	(*EngagementAnalytics)(nil),         // 7: lession.v1.EngagementAnalytics
+	(*Job)(nil),                         // 8: lession.v1.Job
+}
+var file_lession_v1_analytics_service_proto_depIdxs = []int32{
+	6,  // 0: lession.v1.GetEpisodeAnalyticsRequest.from:type_name -> google.protobuf.Timestamp
+	6,  // 1: lession.v1.GetEpisodeAnalyticsRequest.to:type_name -> google.protobuf.Timestamp
+	7,  // 2: lession.v1.GetEpisodeAnalyticsResponse.analytics:type_name -> lession.v1.EngagementAnalytics
+	6,  // 3: lession.v1.GetSeriesAnalyticsRequest.from:type_name -> google.protobuf.Timestamp
+	6,  // 4: lession.v1.GetSeriesAnalyticsRequest.to:type_name -> google.protobuf.Timestamp
+	7,  // 5: lession.v1.GetSeriesAnalyticsResponse.analytics:type_name -> lession.v1.EngagementAnalytics
+	6,  // 6: lession.v1.RollupEngagementRequest.day:type_name -> google.protobuf.Timestamp
+	8,  // 7: lession.v1.RollupEngagementResponse.job:type_name -> lession.v1.Job
+	0,  // 8: lession.v1.AnalyticsService.GetEpisodeAnalytics:input_type -> lession.v1.GetEpisodeAnalyticsRequest
+	2,  // 9: lession.v1.AnalyticsService.GetSeriesAnalytics:input_type -> lession.v1.GetSeriesAnalyticsRequest
+	4,  // 10: lession.v1.AnalyticsService.RollupEngagement:input_type -> lession.v1.RollupEngagementRequest
+	1,  // 11: lession.v1.AnalyticsService.GetEpisodeAnalytics:output_type -> lession.v1.GetEpisodeAnalyticsResponse
+	3,  // 12: lession.v1.AnalyticsService.GetSeriesAnalytics:output_type -> lession.v1.GetSeriesAnalyticsResponse
+	5,  // 13: lession.v1.AnalyticsService.RollupEngagement:output_type -> lession.v1.RollupEngagementResponse
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_analytics_service_proto_init() }
+func file_lession_v1_analytics_service_proto_init() {
+	if File_lession_v1_analytics_service_proto != nil {
+		return
+	}
+	file_lession_v1_analytics_proto_init()
+	file_lession_v1_job_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_analytics_service_proto_rawDesc), len(file_lession_v1_analytics_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_analytics_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_analytics_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_analytics_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_analytics_service_proto = out.File
+	file_lession_v1_analytics_service_proto_goTypes = nil
+	file_lession_v1_analytics_service_proto_depIdxs = nil
+}
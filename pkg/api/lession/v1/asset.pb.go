@@ -89,6 +89,59 @@ func (AssetStatus) EnumDescriptor() ([]byte, []int) {
 	return file_lession_v1_asset_proto_rawDescGZIP(), []int{0}
 }
 
+// AssetFailureCode classifies why an asset failed.
+type AssetFailureCode int32
+
+const (
+	// ASSET_FAILURE_CODE_UNSPECIFIED is reported for assets that have not failed, or were marked failed through UpdateAsset.
+	AssetFailureCode_ASSET_FAILURE_CODE_UNSPECIFIED AssetFailureCode = 0
+	// ASSET_FAILURE_CODE_UPLOAD_EXPIRED indicates the upload session expired before the upload completed.
+	AssetFailureCode_ASSET_FAILURE_CODE_UPLOAD_EXPIRED AssetFailureCode = 1
+	// ASSET_FAILURE_CODE_INVALID_MEDIA indicates the processing pipeline rejected the media, e.g. an image that cannot be decoded.
+	AssetFailureCode_ASSET_FAILURE_CODE_INVALID_MEDIA AssetFailureCode = 2
+)
+
+// Enum value maps for AssetFailureCode.
+var (
+	AssetFailureCode_name = map[int32]string{
+		0: "ASSET_FAILURE_CODE_UNSPECIFIED",
+		1: "ASSET_FAILURE_CODE_UPLOAD_EXPIRED",
+		2: "ASSET_FAILURE_CODE_INVALID_MEDIA",
+	}
+	AssetFailureCode_value = map[string]int32{
+		"ASSET_FAILURE_CODE_UNSPECIFIED":    0,
+		"ASSET_FAILURE_CODE_UPLOAD_EXPIRED": 1,
+		"ASSET_FAILURE_CODE_INVALID_MEDIA":  2,
+	}
+)
+
+func (x AssetFailureCode) Enum() *AssetFailureCode {
+	p := new(AssetFailureCode)
+	*p = x
+	return p
+}
+
+func (x AssetFailureCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssetFailureCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_asset_proto_enumTypes[1].Descriptor()
+}
+
+func (AssetFailureCode) Type() protoreflect.EnumType {
+	return &file_lession_v1_asset_proto_enumTypes[1]
+}
+
+func (x AssetFailureCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssetFailureCode.Descriptor instead.
+func (AssetFailureCode) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{1}
+}
+
 // UploadStatus enumerates lifecycle stages for upload sessions.
 type UploadStatus int32
 
@@ -105,6 +158,8 @@ const (
 	UploadStatus_UPLOAD_STATUS_EXPIRED UploadStatus = 4
 	// UPLOAD_STATUS_FAILED indicates the upload failed and cannot be resumed.
 	UploadStatus_UPLOAD_STATUS_FAILED UploadStatus = 5
+	// UPLOAD_STATUS_CANCELLED indicates the client abandoned the upload.
+	UploadStatus_UPLOAD_STATUS_CANCELLED UploadStatus = 6
 )
 
 // Enum value maps for UploadStatus.
@@ -116,6 +171,7 @@ var (
 		3: "UPLOAD_STATUS_COMPLETED",
 		4: "UPLOAD_STATUS_EXPIRED",
 		5: "UPLOAD_STATUS_FAILED",
+		6: "UPLOAD_STATUS_CANCELLED",
 	}
 	UploadStatus_value = map[string]int32{
 		"UPLOAD_STATUS_UNSPECIFIED":     0,
@@ -124,6 +180,7 @@ var (
 		"UPLOAD_STATUS_COMPLETED":       3,
 		"UPLOAD_STATUS_EXPIRED":         4,
 		"UPLOAD_STATUS_FAILED":          5,
+		"UPLOAD_STATUS_CANCELLED":       6,
 	}
 )
 
@@ -138,11 +195,11 @@ func (x UploadStatus) String() string {
 }
 
 func (UploadStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_lession_v1_asset_proto_enumTypes[1].Descriptor()
+	return file_lession_v1_asset_proto_enumTypes[2].Descriptor()
 }
 
 func (UploadStatus) Type() protoreflect.EnumType {
-	return &file_lession_v1_asset_proto_enumTypes[1]
+	return &file_lession_v1_asset_proto_enumTypes[2]
 }
 
 func (x UploadStatus) Number() protoreflect.EnumNumber {
@@ -151,7 +208,7 @@ func (x UploadStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UploadStatus.Descriptor instead.
 func (UploadStatus) EnumDescriptor() ([]byte, []int) {
-	return file_lession_v1_asset_proto_rawDescGZIP(), []int{1}
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{2}
 }
 
 // UploadProtocol enumerates supported client upload patterns.
@@ -195,11 +252,11 @@ func (x UploadProtocol) String() string {
 }
 
 func (UploadProtocol) Descriptor() protoreflect.EnumDescriptor {
-	return file_lession_v1_asset_proto_enumTypes[2].Descriptor()
+	return file_lession_v1_asset_proto_enumTypes[3].Descriptor()
 }
 
 func (UploadProtocol) Type() protoreflect.EnumType {
-	return &file_lession_v1_asset_proto_enumTypes[2]
+	return &file_lession_v1_asset_proto_enumTypes[3]
 }
 
 func (x UploadProtocol) Number() protoreflect.EnumNumber {
@@ -208,7 +265,7 @@ func (x UploadProtocol) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UploadProtocol.Descriptor instead.
 func (UploadProtocol) EnumDescriptor() ([]byte, []int) {
-	return file_lession_v1_asset_proto_rawDescGZIP(), []int{2}
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{3}
 }
 
 // Asset represents a managed media object stored by the platform.
@@ -237,7 +294,26 @@ type Asset struct {
 	// updated_at records when the asset was last modified.
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	// ready_at records when the asset became available for playback.
-	ReadyAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=ready_at,json=readyAt,proto3" json:"ready_at,omitempty"`
+	ReadyAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=ready_at,json=readyAt,proto3" json:"ready_at,omitempty"`
+	// provider names the upload provider that stores the asset.
+	Provider string `protobuf:"bytes,13,opt,name=provider,proto3" json:"provider,omitempty"`
+	// status_label is the localized, human-readable asset status, selected by Accept-Language.
+	StatusLabel string `protobuf:"bytes,14,opt,name=status_label,json=statusLabel,proto3" json:"status_label,omitempty"`
+	// hls_manifest_url is the HLS master playlist of packaged audio, empty until the asset is packaged.
+	HlsManifestUrl string `protobuf:"bytes,15,opt,name=hls_manifest_url,json=hlsManifestUrl,proto3" json:"hls_manifest_url,omitempty"`
+	// hls_encrypted reports whether the HLS segments are encrypted with AES-128; players fetch the key from the URI in the playlists.
+	HlsEncrypted bool `protobuf:"varint,16,opt,name=hls_encrypted,json=hlsEncrypted,proto3" json:"hls_encrypted,omitempty"`
+	// width is the width in pixels of a processed image asset.
+	Width int32 `protobuf:"varint,17,opt,name=width,proto3" json:"width,omitempty"`
+	// height is the height in pixels of a processed image asset.
+	Height int32 `protobuf:"varint,18,opt,name=height,proto3" json:"height,omitempty"`
+	// image_variants lists the resized renditions of a processed image asset.
+	ImageVariants []*ImageVariant `protobuf:"bytes,19,rep,name=image_variants,json=imageVariants,proto3" json:"image_variants,omitempty"`
+	// failure_code classifies why a FAILED asset failed.
+	FailureCode AssetFailureCode `protobuf:"varint,20,opt,name=failure_code,json=failureCode,proto3,enum=lession.v1.AssetFailureCode" json:"failure_code,omitempty"`
+	// failure_reason describes why a FAILED asset failed, e.g. the error an
+	// image was rejected with.
+	FailureReason string `protobuf:"bytes,21,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -356,6 +432,278 @@ func (x *Asset) GetReadyAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Asset) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Asset) GetStatusLabel() string {
+	if x != nil {
+		return x.StatusLabel
+	}
+	return ""
+}
+
+func (x *Asset) GetHlsManifestUrl() string {
+	if x != nil {
+		return x.HlsManifestUrl
+	}
+	return ""
+}
+
+func (x *Asset) GetHlsEncrypted() bool {
+	if x != nil {
+		return x.HlsEncrypted
+	}
+	return false
+}
+
+func (x *Asset) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Asset) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Asset) GetImageVariants() []*ImageVariant {
+	if x != nil {
+		return x.ImageVariants
+	}
+	return nil
+}
+
+func (x *Asset) GetFailureCode() AssetFailureCode {
+	if x != nil {
+		return x.FailureCode
+	}
+	return AssetFailureCode_ASSET_FAILURE_CODE_UNSPECIFIED
+}
+
+func (x *Asset) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+// AssetVersion is content an asset was served from before it was replaced.
+type AssetVersion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the server-assigned identifier for the version.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// asset_id references the asset the content belonged to.
+	AssetId string `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// asset_key locates the content in object storage.
+	AssetKey string `protobuf:"bytes,3,opt,name=asset_key,json=assetKey,proto3" json:"asset_key,omitempty"`
+	// provider names the upload provider that stores the content.
+	Provider string `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	// original_filename captures the client-supplied file name.
+	OriginalFilename string `protobuf:"bytes,5,opt,name=original_filename,json=originalFilename,proto3" json:"original_filename,omitempty"`
+	// mime_type conveys the content type of the content.
+	MimeType string `protobuf:"bytes,6,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	// filesize stores the size of the content in bytes.
+	Filesize int64 `protobuf:"varint,7,opt,name=filesize,proto3" json:"filesize,omitempty"`
+	// duration stores the media duration, if known.
+	Duration *durationpb.Duration `protobuf:"bytes,8,opt,name=duration,proto3" json:"duration,omitempty"`
+	// playback_url is where the content was played from.
+	PlaybackUrl string `protobuf:"bytes,9,opt,name=playback_url,json=playbackUrl,proto3" json:"playback_url,omitempty"`
+	// hls_manifest_url is the HLS master playlist the content was packaged as, if any.
+	HlsManifestUrl string `protobuf:"bytes,10,opt,name=hls_manifest_url,json=hlsManifestUrl,proto3" json:"hls_manifest_url,omitempty"`
+	// created_at records when the content was replaced.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssetVersion) Reset() {
+	*x = AssetVersion{}
+	mi := &file_lession_v1_asset_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetVersion) ProtoMessage() {}
+
+func (x *AssetVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetVersion.ProtoReflect.Descriptor instead.
+func (*AssetVersion) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AssetVersion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AssetVersion) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *AssetVersion) GetAssetKey() string {
+	if x != nil {
+		return x.AssetKey
+	}
+	return ""
+}
+
+func (x *AssetVersion) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *AssetVersion) GetOriginalFilename() string {
+	if x != nil {
+		return x.OriginalFilename
+	}
+	return ""
+}
+
+func (x *AssetVersion) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *AssetVersion) GetFilesize() int64 {
+	if x != nil {
+		return x.Filesize
+	}
+	return 0
+}
+
+func (x *AssetVersion) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *AssetVersion) GetPlaybackUrl() string {
+	if x != nil {
+		return x.PlaybackUrl
+	}
+	return ""
+}
+
+func (x *AssetVersion) GetHlsManifestUrl() string {
+	if x != nil {
+		return x.HlsManifestUrl
+	}
+	return ""
+}
+
+func (x *AssetVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ImageVariant locates a resized rendition of an image asset.
+type ImageVariant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name identifies the variant: thumbnail, card or hero.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// url locates the rendition.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// width is the width of the rendition in pixels.
+	Width int32 `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	// height is the height of the rendition in pixels.
+	Height        int32 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageVariant) Reset() {
+	*x = ImageVariant{}
+	mi := &file_lession_v1_asset_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageVariant) ProtoMessage() {}
+
+func (x *ImageVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageVariant.ProtoReflect.Descriptor instead.
+func (*ImageVariant) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImageVariant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImageVariant) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ImageVariant) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageVariant) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
 // UploadSession orchestrates client-side uploads into managed storage.
 type UploadSession struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -382,14 +730,21 @@ type UploadSession struct {
 	// created_at records when the upload session was created.
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// updated_at records when the upload session was last modified.
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// provider names the upload provider that issued the session.
+	Provider string `protobuf:"bytes,13,opt,name=provider,proto3" json:"provider,omitempty"`
+	// status_label is the localized, human-readable upload status, selected by Accept-Language.
+	StatusLabel string `protobuf:"bytes,14,opt,name=status_label,json=statusLabel,proto3" json:"status_label,omitempty"`
+	// replaces_asset_id is set on uploads that replace the content of an
+	// existing asset; completing them switches that asset to the new content.
+	ReplacesAssetId string `protobuf:"bytes,15,opt,name=replaces_asset_id,json=replacesAssetId,proto3" json:"replaces_asset_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UploadSession) Reset() {
 	*x = UploadSession{}
-	mi := &file_lession_v1_asset_proto_msgTypes[1]
+	mi := &file_lession_v1_asset_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -401,7 +756,7 @@ func (x *UploadSession) String() string {
 func (*UploadSession) ProtoMessage() {}
 
 func (x *UploadSession) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_asset_proto_msgTypes[1]
+	mi := &file_lession_v1_asset_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -414,7 +769,7 @@ func (x *UploadSession) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadSession.ProtoReflect.Descriptor instead.
 func (*UploadSession) Descriptor() ([]byte, []int) {
-	return file_lession_v1_asset_proto_rawDescGZIP(), []int{1}
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UploadSession) GetId() string {
@@ -501,6 +856,27 @@ func (x *UploadSession) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UploadSession) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *UploadSession) GetStatusLabel() string {
+	if x != nil {
+		return x.StatusLabel
+	}
+	return ""
+}
+
+func (x *UploadSession) GetReplacesAssetId() string {
+	if x != nil {
+		return x.ReplacesAssetId
+	}
+	return ""
+}
+
 // UploadTarget provides instructions for executing an upload.
 type UploadTarget struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -518,7 +894,7 @@ type UploadTarget struct {
 
 func (x *UploadTarget) Reset() {
 	*x = UploadTarget{}
-	mi := &file_lession_v1_asset_proto_msgTypes[2]
+	mi := &file_lession_v1_asset_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -530,7 +906,7 @@ func (x *UploadTarget) String() string {
 func (*UploadTarget) ProtoMessage() {}
 
 func (x *UploadTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_asset_proto_msgTypes[2]
+	mi := &file_lession_v1_asset_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -543,7 +919,7 @@ func (x *UploadTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadTarget.ProtoReflect.Descriptor instead.
 func (*UploadTarget) Descriptor() ([]byte, []int) {
-	return file_lession_v1_asset_proto_rawDescGZIP(), []int{2}
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UploadTarget) GetMethod() string {
@@ -591,7 +967,7 @@ type CreateUploadRequest struct {
 
 func (x *CreateUploadRequest) Reset() {
 	*x = CreateUploadRequest{}
-	mi := &file_lession_v1_asset_proto_msgTypes[3]
+	mi := &file_lession_v1_asset_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -603,7 +979,7 @@ func (x *CreateUploadRequest) String() string {
 func (*CreateUploadRequest) ProtoMessage() {}
 
 func (x *CreateUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_asset_proto_msgTypes[3]
+	mi := &file_lession_v1_asset_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,7 +992,7 @@ func (x *CreateUploadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUploadRequest.ProtoReflect.Descriptor instead.
 func (*CreateUploadRequest) Descriptor() ([]byte, []int) {
-	return file_lession_v1_asset_proto_rawDescGZIP(), []int{3}
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateUploadRequest) GetType() MediaType {
@@ -658,7 +1034,7 @@ type CreateUploadResponse struct {
 
 func (x *CreateUploadResponse) Reset() {
 	*x = CreateUploadResponse{}
-	mi := &file_lession_v1_asset_proto_msgTypes[4]
+	mi := &file_lession_v1_asset_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -669,8 +1045,139 @@ func (x *CreateUploadResponse) String() string {
 
 func (*CreateUploadResponse) ProtoMessage() {}
 
-func (x *CreateUploadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_asset_proto_msgTypes[4]
+func (x *CreateUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUploadResponse.ProtoReflect.Descriptor instead.
+func (*CreateUploadResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateUploadResponse) GetUpload() *UploadSession {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
+// GetUploadRequest retrieves details about an upload session.
+type GetUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Identifier:
+	//
+	//	*GetUploadRequest_UploadId
+	//	*GetUploadRequest_AssetKey
+	Identifier    isGetUploadRequest_Identifier `protobuf_oneof:"identifier"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUploadRequest) Reset() {
+	*x = GetUploadRequest{}
+	mi := &file_lession_v1_asset_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadRequest) ProtoMessage() {}
+
+func (x *GetUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadRequest.ProtoReflect.Descriptor instead.
+func (*GetUploadRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetUploadRequest) GetIdentifier() isGetUploadRequest_Identifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+func (x *GetUploadRequest) GetUploadId() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetUploadRequest_UploadId); ok {
+			return x.UploadId
+		}
+	}
+	return ""
+}
+
+func (x *GetUploadRequest) GetAssetKey() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetUploadRequest_AssetKey); ok {
+			return x.AssetKey
+		}
+	}
+	return ""
+}
+
+type isGetUploadRequest_Identifier interface {
+	isGetUploadRequest_Identifier()
+}
+
+type GetUploadRequest_UploadId struct {
+	// upload_id directly references the upload session.
+	UploadId string `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3,oneof"`
+}
+
+type GetUploadRequest_AssetKey struct {
+	// asset_key references the upload session via its asset key.
+	AssetKey string `protobuf:"bytes,2,opt,name=asset_key,json=assetKey,proto3,oneof"`
+}
+
+func (*GetUploadRequest_UploadId) isGetUploadRequest_Identifier() {}
+
+func (*GetUploadRequest_AssetKey) isGetUploadRequest_Identifier() {}
+
+// GetUploadResponse returns an upload session.
+type GetUploadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// upload contains the requested session.
+	Upload        *UploadSession `protobuf:"bytes,1,opt,name=upload,proto3" json:"upload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUploadResponse) Reset() {
+	*x = GetUploadResponse{}
+	mi := &file_lession_v1_asset_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadResponse) ProtoMessage() {}
+
+func (x *GetUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,45 +1188,52 @@ func (x *CreateUploadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUploadResponse.ProtoReflect.Descriptor instead.
-func (*CreateUploadResponse) Descriptor() ([]byte, []int) {
-	return file_lession_v1_asset_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetUploadResponse.ProtoReflect.Descriptor instead.
+func (*GetUploadResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateUploadResponse) GetUpload() *UploadSession {
+func (x *GetUploadResponse) GetUpload() *UploadSession {
 	if x != nil {
 		return x.Upload
 	}
 	return nil
 }
 
-// GetUploadRequest retrieves details about an upload session.
-type GetUploadRequest struct {
+// ListUploadsRequest lists upload sessions.
+type ListUploadsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Identifier:
-	//
-	//	*GetUploadRequest_UploadId
-	//	*GetUploadRequest_AssetKey
-	Identifier    isGetUploadRequest_Identifier `protobuf_oneof:"identifier"`
+	// page_size limits the number of returned sessions.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token continues a prior ListUploads response.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// statuses filters sessions by upload state.
+	Statuses []UploadStatus `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=lession.v1.UploadStatus" json:"statuses,omitempty"`
+	// types filters sessions by the media type of the uploaded asset.
+	Types []MediaType `protobuf:"varint,4,rep,packed,name=types,proto3,enum=lession.v1.MediaType" json:"types,omitempty"`
+	// created_after restricts the list to sessions created at or after the time.
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// created_before restricts the list to sessions created before the time.
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUploadRequest) Reset() {
-	*x = GetUploadRequest{}
-	mi := &file_lession_v1_asset_proto_msgTypes[5]
+func (x *ListUploadsRequest) Reset() {
+	*x = ListUploadsRequest{}
+	mi := &file_lession_v1_asset_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUploadRequest) String() string {
+func (x *ListUploadsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUploadRequest) ProtoMessage() {}
+func (*ListUploadsRequest) ProtoMessage() {}
 
-func (x *GetUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_asset_proto_msgTypes[5]
+func (x *ListUploadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,78 +1244,79 @@ func (x *GetUploadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUploadRequest.ProtoReflect.Descriptor instead.
-func (*GetUploadRequest) Descriptor() ([]byte, []int) {
-	return file_lession_v1_asset_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListUploadsRequest.ProtoReflect.Descriptor instead.
+func (*ListUploadsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetUploadRequest) GetIdentifier() isGetUploadRequest_Identifier {
+func (x *ListUploadsRequest) GetPageSize() uint32 {
 	if x != nil {
-		return x.Identifier
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *GetUploadRequest) GetUploadId() string {
+func (x *ListUploadsRequest) GetPageToken() string {
 	if x != nil {
-		if x, ok := x.Identifier.(*GetUploadRequest_UploadId); ok {
-			return x.UploadId
-		}
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *GetUploadRequest) GetAssetKey() string {
+func (x *ListUploadsRequest) GetStatuses() []UploadStatus {
 	if x != nil {
-		if x, ok := x.Identifier.(*GetUploadRequest_AssetKey); ok {
-			return x.AssetKey
-		}
+		return x.Statuses
 	}
-	return ""
+	return nil
 }
 
-type isGetUploadRequest_Identifier interface {
-	isGetUploadRequest_Identifier()
+func (x *ListUploadsRequest) GetTypes() []MediaType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
 }
 
-type GetUploadRequest_UploadId struct {
-	// upload_id directly references the upload session.
-	UploadId string `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3,oneof"`
+func (x *ListUploadsRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
 }
 
-type GetUploadRequest_AssetKey struct {
-	// asset_key references the upload session via its asset key.
-	AssetKey string `protobuf:"bytes,2,opt,name=asset_key,json=assetKey,proto3,oneof"`
+func (x *ListUploadsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
 }
 
-func (*GetUploadRequest_UploadId) isGetUploadRequest_Identifier() {}
-
-func (*GetUploadRequest_AssetKey) isGetUploadRequest_Identifier() {}
-
-// GetUploadResponse returns an upload session.
-type GetUploadResponse struct {
+// ListUploadsResponse returns a page of upload sessions.
+type ListUploadsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// upload contains the requested session.
-	Upload        *UploadSession `protobuf:"bytes,1,opt,name=upload,proto3" json:"upload,omitempty"`
+	// uploads contains the sessions in the page.
+	Uploads []*UploadSession `protobuf:"bytes,1,rep,name=uploads,proto3" json:"uploads,omitempty"`
+	// next_page_token is supplied when more data is available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUploadResponse) Reset() {
-	*x = GetUploadResponse{}
-	mi := &file_lession_v1_asset_proto_msgTypes[6]
+func (x *ListUploadsResponse) Reset() {
+	*x = ListUploadsResponse{}
+	mi := &file_lession_v1_asset_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUploadResponse) String() string {
+func (x *ListUploadsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUploadResponse) ProtoMessage() {}
+func (*ListUploadsResponse) ProtoMessage() {}
 
-func (x *GetUploadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_asset_proto_msgTypes[6]
+func (x *ListUploadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,18 +1327,25 @@ func (x *GetUploadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUploadResponse.ProtoReflect.Descriptor instead.
-func (*GetUploadResponse) Descriptor() ([]byte, []int) {
-	return file_lession_v1_asset_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListUploadsResponse.ProtoReflect.Descriptor instead.
+func (*ListUploadsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetUploadResponse) GetUpload() *UploadSession {
+func (x *ListUploadsResponse) GetUploads() []*UploadSession {
 	if x != nil {
-		return x.Upload
+		return x.Uploads
 	}
 	return nil
 }
 
+func (x *ListUploadsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // CompleteUploadRequest finalizes an upload session after client upload succeeds.
 type CompleteUploadRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -842,7 +1364,7 @@ type CompleteUploadRequest struct {
 
 func (x *CompleteUploadRequest) Reset() {
 	*x = CompleteUploadRequest{}
-	mi := &file_lession_v1_asset_proto_msgTypes[7]
+	mi := &file_lession_v1_asset_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -854,7 +1376,7 @@ func (x *CompleteUploadRequest) String() string {
 func (*CompleteUploadRequest) ProtoMessage() {}
 
 func (x *CompleteUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_asset_proto_msgTypes[7]
+	mi := &file_lession_v1_asset_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -867,7 +1389,7 @@ func (x *CompleteUploadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompleteUploadRequest.ProtoReflect.Descriptor instead.
 func (*CompleteUploadRequest) Descriptor() ([]byte, []int) {
-	return file_lession_v1_asset_proto_rawDescGZIP(), []int{7}
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CompleteUploadRequest) GetIdentifier() isCompleteUploadRequest_Identifier {
@@ -940,7 +1462,7 @@ type CompleteUploadResponse struct {
 
 func (x *CompleteUploadResponse) Reset() {
 	*x = CompleteUploadResponse{}
-	mi := &file_lession_v1_asset_proto_msgTypes[8]
+	mi := &file_lession_v1_asset_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -952,7 +1474,7 @@ func (x *CompleteUploadResponse) String() string {
 func (*CompleteUploadResponse) ProtoMessage() {}
 
 func (x *CompleteUploadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_asset_proto_msgTypes[8]
+	mi := &file_lession_v1_asset_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,7 +1487,7 @@ func (x *CompleteUploadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompleteUploadResponse.ProtoReflect.Descriptor instead.
 func (*CompleteUploadResponse) Descriptor() ([]byte, []int) {
-	return file_lession_v1_asset_proto_rawDescGZIP(), []int{8}
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CompleteUploadResponse) GetAsset() *Asset {
@@ -982,6 +1504,146 @@ func (x *CompleteUploadResponse) GetUpload() *UploadSession {
 	return nil
 }
 
+// CancelUploadRequest abandons an upload session that has not completed.
+type CancelUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Identifier:
+	//
+	//	*CancelUploadRequest_UploadId
+	//	*CancelUploadRequest_AssetKey
+	Identifier    isCancelUploadRequest_Identifier `protobuf_oneof:"identifier"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelUploadRequest) Reset() {
+	*x = CancelUploadRequest{}
+	mi := &file_lession_v1_asset_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelUploadRequest) ProtoMessage() {}
+
+func (x *CancelUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelUploadRequest.ProtoReflect.Descriptor instead.
+func (*CancelUploadRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CancelUploadRequest) GetIdentifier() isCancelUploadRequest_Identifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+func (x *CancelUploadRequest) GetUploadId() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*CancelUploadRequest_UploadId); ok {
+			return x.UploadId
+		}
+	}
+	return ""
+}
+
+func (x *CancelUploadRequest) GetAssetKey() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*CancelUploadRequest_AssetKey); ok {
+			return x.AssetKey
+		}
+	}
+	return ""
+}
+
+type isCancelUploadRequest_Identifier interface {
+	isCancelUploadRequest_Identifier()
+}
+
+type CancelUploadRequest_UploadId struct {
+	// upload_id directly references the upload session.
+	UploadId string `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3,oneof"`
+}
+
+type CancelUploadRequest_AssetKey struct {
+	// asset_key references the upload session via its asset key.
+	AssetKey string `protobuf:"bytes,2,opt,name=asset_key,json=assetKey,proto3,oneof"`
+}
+
+func (*CancelUploadRequest_UploadId) isCancelUploadRequest_Identifier() {}
+
+func (*CancelUploadRequest_AssetKey) isCancelUploadRequest_Identifier() {}
+
+// CancelUploadResponse returns the cancelled upload session and its asset.
+type CancelUploadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// asset is the asset that was waiting for the upload, now deleted.
+	Asset *Asset `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	// upload contains the cancelled upload session.
+	Upload        *UploadSession `protobuf:"bytes,2,opt,name=upload,proto3" json:"upload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelUploadResponse) Reset() {
+	*x = CancelUploadResponse{}
+	mi := &file_lession_v1_asset_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelUploadResponse) ProtoMessage() {}
+
+func (x *CancelUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelUploadResponse.ProtoReflect.Descriptor instead.
+func (*CancelUploadResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CancelUploadResponse) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *CancelUploadResponse) GetUpload() *UploadSession {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
 // GetAssetRequest retrieves details about a managed asset.
 type GetAssetRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -996,7 +1658,7 @@ type GetAssetRequest struct {
 
 func (x *GetAssetRequest) Reset() {
 	*x = GetAssetRequest{}
-	mi := &file_lession_v1_asset_proto_msgTypes[9]
+	mi := &file_lession_v1_asset_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1008,7 +1670,7 @@ func (x *GetAssetRequest) String() string {
 func (*GetAssetRequest) ProtoMessage() {}
 
 func (x *GetAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_asset_proto_msgTypes[9]
+	mi := &file_lession_v1_asset_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1021,7 +1683,7 @@ func (x *GetAssetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAssetRequest.ProtoReflect.Descriptor instead.
 func (*GetAssetRequest) Descriptor() ([]byte, []int) {
-	return file_lession_v1_asset_proto_rawDescGZIP(), []int{9}
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetAssetRequest) GetIdentifier() isGetAssetRequest_Identifier {
@@ -1078,7 +1740,7 @@ type GetAssetResponse struct {
 
 func (x *GetAssetResponse) Reset() {
 	*x = GetAssetResponse{}
-	mi := &file_lession_v1_asset_proto_msgTypes[10]
+	mi := &file_lession_v1_asset_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1090,7 +1752,7 @@ func (x *GetAssetResponse) String() string {
 func (*GetAssetResponse) ProtoMessage() {}
 
 func (x *GetAssetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_asset_proto_msgTypes[10]
+	mi := &file_lession_v1_asset_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1103,7 +1765,7 @@ func (x *GetAssetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAssetResponse.ProtoReflect.Descriptor instead.
 func (*GetAssetResponse) Descriptor() ([]byte, []int) {
-	return file_lession_v1_asset_proto_rawDescGZIP(), []int{10}
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetAssetResponse) GetAsset() *Asset {
@@ -1125,14 +1787,17 @@ type ListAssetsRequest struct {
 	// types filters assets by media type.
 	Types []MediaType `protobuf:"varint,4,rep,packed,name=types,proto3,enum=lession.v1.MediaType" json:"types,omitempty"`
 	// asset_keys filters assets matching any of the supplied storage keys.
-	AssetKeys     []string `protobuf:"bytes,5,rep,name=asset_keys,json=assetKeys,proto3" json:"asset_keys,omitempty"`
+	AssetKeys []string `protobuf:"bytes,5,rep,name=asset_keys,json=assetKeys,proto3" json:"asset_keys,omitempty"`
+	// failure_codes restricts the list to FAILED assets that failed with any
+	// of the codes. List every failed asset with statuses = [ASSET_STATUS_FAILED].
+	FailureCodes  []AssetFailureCode `protobuf:"varint,6,rep,packed,name=failure_codes,json=failureCodes,proto3,enum=lession.v1.AssetFailureCode" json:"failure_codes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListAssetsRequest) Reset() {
 	*x = ListAssetsRequest{}
-	mi := &file_lession_v1_asset_proto_msgTypes[11]
+	mi := &file_lession_v1_asset_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1144,7 +1809,7 @@ func (x *ListAssetsRequest) String() string {
 func (*ListAssetsRequest) ProtoMessage() {}
 
 func (x *ListAssetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_asset_proto_msgTypes[11]
+	mi := &file_lession_v1_asset_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1157,7 +1822,7 @@ func (x *ListAssetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAssetsRequest.ProtoReflect.Descriptor instead.
 func (*ListAssetsRequest) Descriptor() ([]byte, []int) {
-	return file_lession_v1_asset_proto_rawDescGZIP(), []int{11}
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListAssetsRequest) GetPageSize() uint32 {
@@ -1195,6 +1860,13 @@ func (x *ListAssetsRequest) GetAssetKeys() []string {
 	return nil
 }
 
+func (x *ListAssetsRequest) GetFailureCodes() []AssetFailureCode {
+	if x != nil {
+		return x.FailureCodes
+	}
+	return nil
+}
+
 // ListAssetsResponse returns a page of assets.
 type ListAssetsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1208,7 +1880,7 @@ type ListAssetsResponse struct {
 
 func (x *ListAssetsResponse) Reset() {
 	*x = ListAssetsResponse{}
-	mi := &file_lession_v1_asset_proto_msgTypes[12]
+	mi := &file_lession_v1_asset_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1220,7 +1892,7 @@ func (x *ListAssetsResponse) String() string {
 func (*ListAssetsResponse) ProtoMessage() {}
 
 func (x *ListAssetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_asset_proto_msgTypes[12]
+	mi := &file_lession_v1_asset_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1233,7 +1905,7 @@ func (x *ListAssetsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAssetsResponse.ProtoReflect.Descriptor instead.
 func (*ListAssetsResponse) Descriptor() ([]byte, []int) {
-	return file_lession_v1_asset_proto_rawDescGZIP(), []int{12}
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListAssetsResponse) GetAssets() []*Asset {
@@ -1263,7 +1935,7 @@ type DeleteAssetRequest struct {
 
 func (x *DeleteAssetRequest) Reset() {
 	*x = DeleteAssetRequest{}
-	mi := &file_lession_v1_asset_proto_msgTypes[13]
+	mi := &file_lession_v1_asset_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1275,7 +1947,7 @@ func (x *DeleteAssetRequest) String() string {
 func (*DeleteAssetRequest) ProtoMessage() {}
 
 func (x *DeleteAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_asset_proto_msgTypes[13]
+	mi := &file_lession_v1_asset_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,7 +1960,7 @@ func (x *DeleteAssetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAssetRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAssetRequest) Descriptor() ([]byte, []int) {
-	return file_lession_v1_asset_proto_rawDescGZIP(), []int{13}
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DeleteAssetRequest) GetAssetId() string {
@@ -1316,7 +1988,7 @@ type DeleteAssetResponse struct {
 
 func (x *DeleteAssetResponse) Reset() {
 	*x = DeleteAssetResponse{}
-	mi := &file_lession_v1_asset_proto_msgTypes[14]
+	mi := &file_lession_v1_asset_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1328,7 +2000,7 @@ func (x *DeleteAssetResponse) String() string {
 func (*DeleteAssetResponse) ProtoMessage() {}
 
 func (x *DeleteAssetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_asset_proto_msgTypes[14]
+	mi := &file_lession_v1_asset_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1341,7 +2013,7 @@ func (x *DeleteAssetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAssetResponse.ProtoReflect.Descriptor instead.
 func (*DeleteAssetResponse) Descriptor() ([]byte, []int) {
-	return file_lession_v1_asset_proto_rawDescGZIP(), []int{14}
+	return file_lession_v1_asset_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DeleteAssetResponse) GetAsset() *Asset {
@@ -1356,7 +2028,7 @@ var File_lession_v1_asset_proto protoreflect.FileDescriptor
 const file_lession_v1_asset_proto_rawDesc = "" +
 	"\n" +
 	"\x16lession/v1/asset.proto\x12\n" +
-	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17lession/v1/series.proto\"\xfd\x03\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17lession/v1/series.proto\"\xe2\x06\n" +
 	"\x05Asset\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tasset_key\x18\x02 \x01(\tR\bassetKey\x12)\n" +
@@ -1372,7 +2044,35 @@ const file_lession_v1_asset_proto_rawDesc = "" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x125\n" +
-	"\bready_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\areadyAt\"\xa5\x04\n" +
+	"\bready_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\areadyAt\x12\x1a\n" +
+	"\bprovider\x18\r \x01(\tR\bprovider\x12!\n" +
+	"\fstatus_label\x18\x0e \x01(\tR\vstatusLabel\x12(\n" +
+	"\x10hls_manifest_url\x18\x0f \x01(\tR\x0ehlsManifestUrl\x12#\n" +
+	"\rhls_encrypted\x18\x10 \x01(\bR\fhlsEncrypted\x12\x14\n" +
+	"\x05width\x18\x11 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x12 \x01(\x05R\x06height\x12?\n" +
+	"\x0eimage_variants\x18\x13 \x03(\v2\x18.lession.v1.ImageVariantR\rimageVariants\x12?\n" +
+	"\ffailure_code\x18\x14 \x01(\x0e2\x1c.lession.v1.AssetFailureCodeR\vfailureCode\x12%\n" +
+	"\x0efailure_reason\x18\x15 \x01(\tR\rfailureReason\"\x97\x03\n" +
+	"\fAssetVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\x12\x1b\n" +
+	"\tasset_key\x18\x03 \x01(\tR\bassetKey\x12\x1a\n" +
+	"\bprovider\x18\x04 \x01(\tR\bprovider\x12+\n" +
+	"\x11original_filename\x18\x05 \x01(\tR\x10originalFilename\x12\x1b\n" +
+	"\tmime_type\x18\x06 \x01(\tR\bmimeType\x12\x1a\n" +
+	"\bfilesize\x18\a \x01(\x03R\bfilesize\x125\n" +
+	"\bduration\x18\b \x01(\v2\x19.google.protobuf.DurationR\bduration\x12!\n" +
+	"\fplayback_url\x18\t \x01(\tR\vplaybackUrl\x12(\n" +
+	"\x10hls_manifest_url\x18\n" +
+	" \x01(\tR\x0ehlsManifestUrl\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"b\n" +
+	"\fImageVariant\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x05R\x06height\"\x90\x05\n" +
 	"\rUploadSession\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tasset_key\x18\x02 \x01(\tR\bassetKey\x12)\n" +
@@ -1389,7 +2089,10 @@ const file_lession_v1_asset_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xbf\x02\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\bprovider\x18\r \x01(\tR\bprovider\x12!\n" +
+	"\fstatus_label\x18\x0e \x01(\tR\vstatusLabel\x12*\n" +
+	"\x11replaces_asset_id\x18\x0f \x01(\tR\x0freplacesAssetId\"\xbf\x02\n" +
 	"\fUploadTarget\x12\x16\n" +
 	"\x06method\x18\x01 \x01(\tR\x06method\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12?\n" +
@@ -1418,7 +2121,20 @@ const file_lession_v1_asset_proto_rawDesc = "" +
 	"\n" +
 	"identifier\x12\x05\xbaH\x02\b\x01\"F\n" +
 	"\x11GetUploadResponse\x121\n" +
-	"\x06upload\x18\x01 \x01(\v2\x19.lession.v1.UploadSessionR\x06upload\"\xd3\x01\n" +
+	"\x06upload\x18\x01 \x01(\v2\x19.lession.v1.UploadSessionR\x06upload\"\xd5\x02\n" +
+	"\x12ListUploadsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12C\n" +
+	"\bstatuses\x18\x03 \x03(\x0e2\x18.lession.v1.UploadStatusB\r\xbaH\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\bstatuses\x12:\n" +
+	"\x05types\x18\x04 \x03(\x0e2\x15.lession.v1.MediaTypeB\r\xbaH\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\x05types\x12?\n" +
+	"\rcreated_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\"r\n" +
+	"\x13ListUploadsResponse\x123\n" +
+	"\auploads\x18\x01 \x03(\v2\x19.lession.v1.UploadSessionR\auploads\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xd3\x01\n" +
 	"\x15CompleteUploadRequest\x12'\n" +
 	"\tupload_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\buploadId\x12&\n" +
 	"\tasset_key\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\bassetKey\x12$\n" +
@@ -1428,6 +2144,14 @@ const file_lession_v1_asset_proto_rawDesc = "" +
 	"identifier\x12\x05\xbaH\x02\b\x01\"t\n" +
 	"\x16CompleteUploadResponse\x12'\n" +
 	"\x05asset\x18\x01 \x01(\v2\x11.lession.v1.AssetR\x05asset\x121\n" +
+	"\x06upload\x18\x02 \x01(\v2\x19.lession.v1.UploadSessionR\x06upload\"{\n" +
+	"\x13CancelUploadRequest\x12'\n" +
+	"\tupload_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\buploadId\x12&\n" +
+	"\tasset_key\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\bassetKeyB\x13\n" +
+	"\n" +
+	"identifier\x12\x05\xbaH\x02\b\x01\"r\n" +
+	"\x14CancelUploadResponse\x12'\n" +
+	"\x05asset\x18\x01 \x01(\v2\x11.lession.v1.AssetR\x05asset\x121\n" +
 	"\x06upload\x18\x02 \x01(\v2\x19.lession.v1.UploadSessionR\x06upload\"u\n" +
 	"\x0fGetAssetRequest\x12%\n" +
 	"\basset_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aassetId\x12&\n" +
@@ -1435,7 +2159,7 @@ const file_lession_v1_asset_proto_rawDesc = "" +
 	"\n" +
 	"identifier\x12\x05\xbaH\x02\b\x01\";\n" +
 	"\x10GetAssetResponse\x12'\n" +
-	"\x05asset\x18\x01 \x01(\v2\x11.lession.v1.AssetR\x05asset\"\xfc\x01\n" +
+	"\x05asset\x18\x01 \x01(\v2\x11.lession.v1.AssetR\x05asset\"\xce\x02\n" +
 	"\x11ListAssetsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\rR\bpageSize\x12\x1d\n" +
 	"\n" +
@@ -1445,7 +2169,9 @@ const file_lession_v1_asset_proto_rawDesc = "" +
 	"\x05types\x18\x04 \x03(\x0e2\x15.lession.v1.MediaTypeB\r\xbaH\n" +
 	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\x05types\x12+\n" +
 	"\n" +
-	"asset_keys\x18\x05 \x03(\tB\f\xbaH\t\x92\x01\x06\"\x04r\x02\x10\x01R\tassetKeys\"g\n" +
+	"asset_keys\x18\x05 \x03(\tB\f\xbaH\t\x92\x01\x06\"\x04r\x02\x10\x01R\tassetKeys\x12P\n" +
+	"\rfailure_codes\x18\x06 \x03(\x0e2\x1c.lession.v1.AssetFailureCodeB\r\xbaH\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\ffailureCodes\"g\n" +
 	"\x12ListAssetsResponse\x12)\n" +
 	"\x06assets\x18\x01 \x03(\v2\x11.lession.v1.AssetR\x06assets\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"Z\n" +
@@ -1461,14 +2187,19 @@ const file_lession_v1_asset_proto_rawDesc = "" +
 	"\x17ASSET_STATUS_PROCESSING\x10\x02\x12\x16\n" +
 	"\x12ASSET_STATUS_READY\x10\x03\x12\x17\n" +
 	"\x13ASSET_STATUS_FAILED\x10\x04\x12\x18\n" +
-	"\x14ASSET_STATUS_DELETED\x10\x05*\xbf\x01\n" +
+	"\x14ASSET_STATUS_DELETED\x10\x05*\x83\x01\n" +
+	"\x10AssetFailureCode\x12\"\n" +
+	"\x1eASSET_FAILURE_CODE_UNSPECIFIED\x10\x00\x12%\n" +
+	"!ASSET_FAILURE_CODE_UPLOAD_EXPIRED\x10\x01\x12$\n" +
+	" ASSET_FAILURE_CODE_INVALID_MEDIA\x10\x02*\xdc\x01\n" +
 	"\fUploadStatus\x12\x1d\n" +
 	"\x19UPLOAD_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
 	"\x1dUPLOAD_STATUS_AWAITING_UPLOAD\x10\x01\x12\x1b\n" +
 	"\x17UPLOAD_STATUS_UPLOADING\x10\x02\x12\x1b\n" +
 	"\x17UPLOAD_STATUS_COMPLETED\x10\x03\x12\x19\n" +
 	"\x15UPLOAD_STATUS_EXPIRED\x10\x04\x12\x18\n" +
-	"\x14UPLOAD_STATUS_FAILED\x10\x05*\x97\x01\n" +
+	"\x14UPLOAD_STATUS_FAILED\x10\x05\x12\x1b\n" +
+	"\x17UPLOAD_STATUS_CANCELLED\x10\x06*\x97\x01\n" +
 	"\x0eUploadProtocol\x12\x1f\n" +
 	"\x1bUPLOAD_PROTOCOL_UNSPECIFIED\x10\x00\x12!\n" +
 	"\x1dUPLOAD_PROTOCOL_PRESIGNED_PUT\x10\x01\x12\"\n" +
@@ -1487,64 +2218,83 @@ func file_lession_v1_asset_proto_rawDescGZIP() []byte {
 	return file_lession_v1_asset_proto_rawDescData
 }
 
-var file_lession_v1_asset_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_lession_v1_asset_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_lession_v1_asset_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_lession_v1_asset_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_lession_v1_asset_proto_goTypes = []any{
 	(AssetStatus)(0),               // 0: lession.v1.AssetStatus
-	(UploadStatus)(0),              // 1: lession.v1.UploadStatus
-	(UploadProtocol)(0),            // 2: lession.v1.UploadProtocol
-	(*Asset)(nil),                  // 3: lession.v1.Asset
-	(*UploadSession)(nil),          // 4: lession.v1.UploadSession
-	(*UploadTarget)(nil),           // 5: lession.v1.UploadTarget
-	(*CreateUploadRequest)(nil),    // 6: lession.v1.CreateUploadRequest
-	(*CreateUploadResponse)(nil),   // 7: lession.v1.CreateUploadResponse
-	(*GetUploadRequest)(nil),       // 8: lession.v1.GetUploadRequest
-	(*GetUploadResponse)(nil),      // 9: lession.v1.GetUploadResponse
-	(*CompleteUploadRequest)(nil),  // 10: lession.v1.CompleteUploadRequest
-	(*CompleteUploadResponse)(nil), // 11: lession.v1.CompleteUploadResponse
-	(*GetAssetRequest)(nil),        // 12: lession.v1.GetAssetRequest
-	(*GetAssetResponse)(nil),       // 13: lession.v1.GetAssetResponse
-	(*ListAssetsRequest)(nil),      // 14: lession.v1.ListAssetsRequest
-	(*ListAssetsResponse)(nil),     // 15: lession.v1.ListAssetsResponse
-	(*DeleteAssetRequest)(nil),     // 16: lession.v1.DeleteAssetRequest
-	(*DeleteAssetResponse)(nil),    // 17: lession.v1.DeleteAssetResponse
-	nil,                            // 18: lession.v1.UploadTarget.HeadersEntry
-	nil,                            // 19: lession.v1.UploadTarget.FormFieldsEntry
-	(MediaType)(0),                 // 20: lession.v1.MediaType
-	(*durationpb.Duration)(nil),    // 21: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),  // 22: google.protobuf.Timestamp
+	(AssetFailureCode)(0),          // 1: lession.v1.AssetFailureCode
+	(UploadStatus)(0),              // 2: lession.v1.UploadStatus
+	(UploadProtocol)(0),            // 3: lession.v1.UploadProtocol
+	(*Asset)(nil),                  // 4: lession.v1.Asset
+	(*AssetVersion)(nil),           // 5: lession.v1.AssetVersion
+	(*ImageVariant)(nil),           // 6: lession.v1.ImageVariant
+	(*UploadSession)(nil),          // 7: lession.v1.UploadSession
+	(*UploadTarget)(nil),           // 8: lession.v1.UploadTarget
+	(*CreateUploadRequest)(nil),    // 9: lession.v1.CreateUploadRequest
+	(*CreateUploadResponse)(nil),   // 10: lession.v1.CreateUploadResponse
+	(*GetUploadRequest)(nil),       // 11: lession.v1.GetUploadRequest
+	(*GetUploadResponse)(nil),      // 12: lession.v1.GetUploadResponse
+	(*ListUploadsRequest)(nil),     // 13: lession.v1.ListUploadsRequest
+	(*ListUploadsResponse)(nil),    // 14: lession.v1.ListUploadsResponse
+	(*CompleteUploadRequest)(nil),  // 15: lession.v1.CompleteUploadRequest
+	(*CompleteUploadResponse)(nil), // 16: lession.v1.CompleteUploadResponse
+	(*CancelUploadRequest)(nil),    // 17: lession.v1.CancelUploadRequest
+	(*CancelUploadResponse)(nil),   // 18: lession.v1.CancelUploadResponse
+	(*GetAssetRequest)(nil),        // 19: lession.v1.GetAssetRequest
+	(*GetAssetResponse)(nil),       // 20: lession.v1.GetAssetResponse
+	(*ListAssetsRequest)(nil),      // 21: lession.v1.ListAssetsRequest
+	(*ListAssetsResponse)(nil),     // 22: lession.v1.ListAssetsResponse
+	(*DeleteAssetRequest)(nil),     // 23: lession.v1.DeleteAssetRequest
+	(*DeleteAssetResponse)(nil),    // 24: lession.v1.DeleteAssetResponse
+	nil,                            // 25: lession.v1.UploadTarget.HeadersEntry
+	nil,                            // 26: lession.v1.UploadTarget.FormFieldsEntry
+	(MediaType)(0),                 // 27: lession.v1.MediaType
+	(*durationpb.Duration)(nil),    // 28: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),  // 29: google.protobuf.Timestamp
 }
 var file_lession_v1_asset_proto_depIdxs = []int32{
-	20, // 0: lession.v1.Asset.type:type_name -> lession.v1.MediaType
+	27, // 0: lession.v1.Asset.type:type_name -> lession.v1.MediaType
 	0,  // 1: lession.v1.Asset.status:type_name -> lession.v1.AssetStatus
-	21, // 2: lession.v1.Asset.duration:type_name -> google.protobuf.Duration
-	22, // 3: lession.v1.Asset.created_at:type_name -> google.protobuf.Timestamp
-	22, // 4: lession.v1.Asset.updated_at:type_name -> google.protobuf.Timestamp
-	22, // 5: lession.v1.Asset.ready_at:type_name -> google.protobuf.Timestamp
-	20, // 6: lession.v1.UploadSession.type:type_name -> lession.v1.MediaType
-	2,  // 7: lession.v1.UploadSession.protocol:type_name -> lession.v1.UploadProtocol
-	1,  // 8: lession.v1.UploadSession.status:type_name -> lession.v1.UploadStatus
-	5,  // 9: lession.v1.UploadSession.target:type_name -> lession.v1.UploadTarget
-	22, // 10: lession.v1.UploadSession.expires_at:type_name -> google.protobuf.Timestamp
-	22, // 11: lession.v1.UploadSession.created_at:type_name -> google.protobuf.Timestamp
-	22, // 12: lession.v1.UploadSession.updated_at:type_name -> google.protobuf.Timestamp
-	18, // 13: lession.v1.UploadTarget.headers:type_name -> lession.v1.UploadTarget.HeadersEntry
-	19, // 14: lession.v1.UploadTarget.form_fields:type_name -> lession.v1.UploadTarget.FormFieldsEntry
-	20, // 15: lession.v1.CreateUploadRequest.type:type_name -> lession.v1.MediaType
-	4,  // 16: lession.v1.CreateUploadResponse.upload:type_name -> lession.v1.UploadSession
-	4,  // 17: lession.v1.GetUploadResponse.upload:type_name -> lession.v1.UploadSession
-	3,  // 18: lession.v1.CompleteUploadResponse.asset:type_name -> lession.v1.Asset
-	4,  // 19: lession.v1.CompleteUploadResponse.upload:type_name -> lession.v1.UploadSession
-	3,  // 20: lession.v1.GetAssetResponse.asset:type_name -> lession.v1.Asset
-	0,  // 21: lession.v1.ListAssetsRequest.statuses:type_name -> lession.v1.AssetStatus
-	20, // 22: lession.v1.ListAssetsRequest.types:type_name -> lession.v1.MediaType
-	3,  // 23: lession.v1.ListAssetsResponse.assets:type_name -> lession.v1.Asset
-	3,  // 24: lession.v1.DeleteAssetResponse.asset:type_name -> lession.v1.Asset
-	25, // [25:25] is the sub-list for method output_type
-	25, // [25:25] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	28, // 2: lession.v1.Asset.duration:type_name -> google.protobuf.Duration
+	29, // 3: lession.v1.Asset.created_at:type_name -> google.protobuf.Timestamp
+	29, // 4: lession.v1.Asset.updated_at:type_name -> google.protobuf.Timestamp
+	29, // 5: lession.v1.Asset.ready_at:type_name -> google.protobuf.Timestamp
+	6,  // 6: lession.v1.Asset.image_variants:type_name -> lession.v1.ImageVariant
+	1,  // 7: lession.v1.Asset.failure_code:type_name -> lession.v1.AssetFailureCode
+	28, // 8: lession.v1.AssetVersion.duration:type_name -> google.protobuf.Duration
+	29, // 9: lession.v1.AssetVersion.created_at:type_name -> google.protobuf.Timestamp
+	27, // 10: lession.v1.UploadSession.type:type_name -> lession.v1.MediaType
+	3,  // 11: lession.v1.UploadSession.protocol:type_name -> lession.v1.UploadProtocol
+	2,  // 12: lession.v1.UploadSession.status:type_name -> lession.v1.UploadStatus
+	8,  // 13: lession.v1.UploadSession.target:type_name -> lession.v1.UploadTarget
+	29, // 14: lession.v1.UploadSession.expires_at:type_name -> google.protobuf.Timestamp
+	29, // 15: lession.v1.UploadSession.created_at:type_name -> google.protobuf.Timestamp
+	29, // 16: lession.v1.UploadSession.updated_at:type_name -> google.protobuf.Timestamp
+	25, // 17: lession.v1.UploadTarget.headers:type_name -> lession.v1.UploadTarget.HeadersEntry
+	26, // 18: lession.v1.UploadTarget.form_fields:type_name -> lession.v1.UploadTarget.FormFieldsEntry
+	27, // 19: lession.v1.CreateUploadRequest.type:type_name -> lession.v1.MediaType
+	7,  // 20: lession.v1.CreateUploadResponse.upload:type_name -> lession.v1.UploadSession
+	7,  // 21: lession.v1.GetUploadResponse.upload:type_name -> lession.v1.UploadSession
+	2,  // 22: lession.v1.ListUploadsRequest.statuses:type_name -> lession.v1.UploadStatus
+	27, // 23: lession.v1.ListUploadsRequest.types:type_name -> lession.v1.MediaType
+	29, // 24: lession.v1.ListUploadsRequest.created_after:type_name -> google.protobuf.Timestamp
+	29, // 25: lession.v1.ListUploadsRequest.created_before:type_name -> google.protobuf.Timestamp
+	7,  // 26: lession.v1.ListUploadsResponse.uploads:type_name -> lession.v1.UploadSession
+	4,  // 27: lession.v1.CompleteUploadResponse.asset:type_name -> lession.v1.Asset
+	7,  // 28: lession.v1.CompleteUploadResponse.upload:type_name -> lession.v1.UploadSession
+	4,  // 29: lession.v1.CancelUploadResponse.asset:type_name -> lession.v1.Asset
+	7,  // 30: lession.v1.CancelUploadResponse.upload:type_name -> lession.v1.UploadSession
+	4,  // 31: lession.v1.GetAssetResponse.asset:type_name -> lession.v1.Asset
+	0,  // 32: lession.v1.ListAssetsRequest.statuses:type_name -> lession.v1.AssetStatus
+	27, // 33: lession.v1.ListAssetsRequest.types:type_name -> lession.v1.MediaType
+	1,  // 34: lession.v1.ListAssetsRequest.failure_codes:type_name -> lession.v1.AssetFailureCode
+	4,  // 35: lession.v1.ListAssetsResponse.assets:type_name -> lession.v1.Asset
+	4,  // 36: lession.v1.DeleteAssetResponse.asset:type_name -> lession.v1.Asset
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_lession_v1_asset_proto_init() }
@@ -1553,15 +2303,19 @@ func file_lession_v1_asset_proto_init() {
 		return
 	}
 	file_lession_v1_series_proto_init()
-	file_lession_v1_asset_proto_msgTypes[5].OneofWrappers = []any{
+	file_lession_v1_asset_proto_msgTypes[7].OneofWrappers = []any{
 		(*GetUploadRequest_UploadId)(nil),
 		(*GetUploadRequest_AssetKey)(nil),
 	}
-	file_lession_v1_asset_proto_msgTypes[7].OneofWrappers = []any{
+	file_lession_v1_asset_proto_msgTypes[11].OneofWrappers = []any{
 		(*CompleteUploadRequest_UploadId)(nil),
 		(*CompleteUploadRequest_AssetKey)(nil),
 	}
-	file_lession_v1_asset_proto_msgTypes[9].OneofWrappers = []any{
+	file_lession_v1_asset_proto_msgTypes[13].OneofWrappers = []any{
+		(*CancelUploadRequest_UploadId)(nil),
+		(*CancelUploadRequest_AssetKey)(nil),
+	}
+	file_lession_v1_asset_proto_msgTypes[15].OneofWrappers = []any{
 		(*GetAssetRequest_AssetId)(nil),
 		(*GetAssetRequest_AssetKey)(nil),
 	}
@@ -1570,8 +2324,8 @@ func file_lession_v1_asset_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_asset_proto_rawDesc), len(file_lession_v1_asset_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   17,
+			NumEnums:      4,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -10,7 +10,9 @@ import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,6 +25,125 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// RegisterExternalAssetRequest describes media hosted elsewhere.
+type RegisterExternalAssetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type classifies the media; only video and audio are supported.
+	Type MediaType `protobuf:"varint,1,opt,name=type,proto3,enum=lession.v1.MediaType" json:"type,omitempty"`
+	// url locates the media file or HLS playlist.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// original_filename names the asset; it defaults to the last segment of the URL path.
+	OriginalFilename string `protobuf:"bytes,3,opt,name=original_filename,json=originalFilename,proto3" json:"original_filename,omitempty"`
+	// duration is used when it cannot be read from the media, which is only possible for HLS playlists.
+	Duration      *durationpb.Duration `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterExternalAssetRequest) Reset() {
+	*x = RegisterExternalAssetRequest{}
+	mi := &file_lession_v1_asset_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterExternalAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterExternalAssetRequest) ProtoMessage() {}
+
+func (x *RegisterExternalAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterExternalAssetRequest.ProtoReflect.Descriptor instead.
+func (*RegisterExternalAssetRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RegisterExternalAssetRequest) GetType() MediaType {
+	if x != nil {
+		return x.Type
+	}
+	return MediaType_MEDIA_TYPE_UNSPECIFIED
+}
+
+func (x *RegisterExternalAssetRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RegisterExternalAssetRequest) GetOriginalFilename() string {
+	if x != nil {
+		return x.OriginalFilename
+	}
+	return ""
+}
+
+func (x *RegisterExternalAssetRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+// RegisterExternalAssetResponse returns the registered asset.
+type RegisterExternalAssetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// asset is the ready asset, played from the external URL.
+	Asset         *Asset `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterExternalAssetResponse) Reset() {
+	*x = RegisterExternalAssetResponse{}
+	mi := &file_lession_v1_asset_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterExternalAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterExternalAssetResponse) ProtoMessage() {}
+
+func (x *RegisterExternalAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterExternalAssetResponse.ProtoReflect.Descriptor instead.
+func (*RegisterExternalAssetResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisterExternalAssetResponse) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
 // UpdateAssetRequest applies partial updates to an asset.
 type UpdateAssetRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -36,7 +157,7 @@ type UpdateAssetRequest struct {
 
 func (x *UpdateAssetRequest) Reset() {
 	*x = UpdateAssetRequest{}
-	mi := &file_lession_v1_asset_service_proto_msgTypes[0]
+	mi := &file_lession_v1_asset_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48,7 +169,7 @@ func (x *UpdateAssetRequest) String() string {
 func (*UpdateAssetRequest) ProtoMessage() {}
 
 func (x *UpdateAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_asset_service_proto_msgTypes[0]
+	mi := &file_lession_v1_asset_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -61,7 +182,7 @@ func (x *UpdateAssetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAssetRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAssetRequest) Descriptor() ([]byte, []int) {
-	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{0}
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *UpdateAssetRequest) GetAsset() *Asset {
@@ -89,7 +210,7 @@ type UpdateAssetResponse struct {
 
 func (x *UpdateAssetResponse) Reset() {
 	*x = UpdateAssetResponse{}
-	mi := &file_lession_v1_asset_service_proto_msgTypes[1]
+	mi := &file_lession_v1_asset_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -101,7 +222,7 @@ func (x *UpdateAssetResponse) String() string {
 func (*UpdateAssetResponse) ProtoMessage() {}
 
 func (x *UpdateAssetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_asset_service_proto_msgTypes[1]
+	mi := &file_lession_v1_asset_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -114,7 +235,7 @@ func (x *UpdateAssetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAssetResponse.ProtoReflect.Descriptor instead.
 func (*UpdateAssetResponse) Descriptor() ([]byte, []int) {
-	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{1}
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UpdateAssetResponse) GetAsset() *Asset {
@@ -124,27 +245,888 @@ func (x *UpdateAssetResponse) GetAsset() *Asset {
 	return nil
 }
 
-var File_lession_v1_asset_service_proto protoreflect.FileDescriptor
+// WatchAssetRequest identifies the asset to watch.
+type WatchAssetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// asset_id references the asset.
+	AssetId       string `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_lession_v1_asset_service_proto_rawDesc = "" +
-	"\n" +
-	"\x1elession/v1/asset_service.proto\x12\n" +
-	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x16lession/v1/asset.proto\"\x82\x01\n" +
-	"\x12UpdateAssetRequest\x12/\n" +
-	"\x05asset\x18\x01 \x01(\v2\x11.lession.v1.AssetB\x06\xbaH\x03\xc8\x01\x01R\x05asset\x12;\n" +
-	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\">\n" +
-	"\x13UpdateAssetResponse\x12'\n" +
-	"\x05asset\x18\x01 \x01(\v2\x11.lession.v1.AssetR\x05asset2\xb8\x04\n" +
-	"\fAssetService\x12Q\n" +
-	"\fCreateUpload\x12\x1f.lession.v1.CreateUploadRequest\x1a .lession.v1.CreateUploadResponse\x12H\n" +
-	"\tGetUpload\x12\x1c.lession.v1.GetUploadRequest\x1a\x1d.lession.v1.GetUploadResponse\x12W\n" +
-	"\x0eCompleteUpload\x12!.lession.v1.CompleteUploadRequest\x1a\".lession.v1.CompleteUploadResponse\x12E\n" +
-	"\bGetAsset\x12\x1b.lession.v1.GetAssetRequest\x1a\x1c.lession.v1.GetAssetResponse\x12K\n" +
-	"\n" +
-	"ListAssets\x12\x1d.lession.v1.ListAssetsRequest\x1a\x1e.lession.v1.ListAssetsResponse\x12N\n" +
-	"\vUpdateAsset\x12\x1e.lession.v1.UpdateAssetRequest\x1a\x1f.lession.v1.UpdateAssetResponse\x12N\n" +
-	"\vDeleteAsset\x12\x1e.lession.v1.DeleteAssetRequest\x1a\x1f.lession.v1.DeleteAssetResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+func (x *WatchAssetRequest) Reset() {
+	*x = WatchAssetRequest{}
+	mi := &file_lession_v1_asset_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAssetRequest) ProtoMessage() {}
+
+func (x *WatchAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAssetRequest.ProtoReflect.Descriptor instead.
+func (*WatchAssetRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WatchAssetRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+// WatchAssetResponse carries the asset after a status change.
+type WatchAssetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// asset is the asset in its new status.
+	Asset         *Asset `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchAssetResponse) Reset() {
+	*x = WatchAssetResponse{}
+	mi := &file_lession_v1_asset_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAssetResponse) ProtoMessage() {}
+
+func (x *WatchAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAssetResponse.ProtoReflect.Descriptor instead.
+func (*WatchAssetResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WatchAssetResponse) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+// PackageAssetRequest identifies the asset to package.
+type PackageAssetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// asset_id references the asset.
+	AssetId       string `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PackageAssetRequest) Reset() {
+	*x = PackageAssetRequest{}
+	mi := &file_lession_v1_asset_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackageAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageAssetRequest) ProtoMessage() {}
+
+func (x *PackageAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageAssetRequest.ProtoReflect.Descriptor instead.
+func (*PackageAssetRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PackageAssetRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+// PackageAssetResponse returns the packaged asset.
+type PackageAssetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// asset is the asset with its new HLS rendition.
+	Asset         *Asset `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PackageAssetResponse) Reset() {
+	*x = PackageAssetResponse{}
+	mi := &file_lession_v1_asset_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackageAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageAssetResponse) ProtoMessage() {}
+
+func (x *PackageAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageAssetResponse.ProtoReflect.Descriptor instead.
+func (*PackageAssetResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PackageAssetResponse) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+// RetryAssetProcessingRequest identifies the failed asset to retry.
+type RetryAssetProcessingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// asset_id references the asset.
+	AssetId       string `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryAssetProcessingRequest) Reset() {
+	*x = RetryAssetProcessingRequest{}
+	mi := &file_lession_v1_asset_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryAssetProcessingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryAssetProcessingRequest) ProtoMessage() {}
+
+func (x *RetryAssetProcessingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryAssetProcessingRequest.ProtoReflect.Descriptor instead.
+func (*RetryAssetProcessingRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RetryAssetProcessingRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+// RetryAssetProcessingResponse returns the asset being processed again.
+type RetryAssetProcessingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// asset is the asset, READY again with its failure cleared.
+	Asset         *Asset `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryAssetProcessingResponse) Reset() {
+	*x = RetryAssetProcessingResponse{}
+	mi := &file_lession_v1_asset_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryAssetProcessingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryAssetProcessingResponse) ProtoMessage() {}
+
+func (x *RetryAssetProcessingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryAssetProcessingResponse.ProtoReflect.Descriptor instead.
+func (*RetryAssetProcessingResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RetryAssetProcessingResponse) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+// ReplaceAssetContentRequest describes the file replacing an asset's content.
+type ReplaceAssetContentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// asset_id references the asset.
+	AssetId string `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// original_filename captures the client-supplied file name.
+	OriginalFilename string `protobuf:"bytes,2,opt,name=original_filename,json=originalFilename,proto3" json:"original_filename,omitempty"`
+	// mime_type conveys the expected content type for the upload.
+	MimeType string `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	// content_length stores the expected size of the upload in bytes.
+	ContentLength int64 `protobuf:"varint,4,opt,name=content_length,json=contentLength,proto3" json:"content_length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceAssetContentRequest) Reset() {
+	*x = ReplaceAssetContentRequest{}
+	mi := &file_lession_v1_asset_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceAssetContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceAssetContentRequest) ProtoMessage() {}
+
+func (x *ReplaceAssetContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceAssetContentRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceAssetContentRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReplaceAssetContentRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *ReplaceAssetContentRequest) GetOriginalFilename() string {
+	if x != nil {
+		return x.OriginalFilename
+	}
+	return ""
+}
+
+func (x *ReplaceAssetContentRequest) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *ReplaceAssetContentRequest) GetContentLength() int64 {
+	if x != nil {
+		return x.ContentLength
+	}
+	return 0
+}
+
+// ReplaceAssetContentResponse returns the upload of the new content.
+type ReplaceAssetContentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// upload contains details required to perform the upload.
+	Upload *UploadSession `protobuf:"bytes,1,opt,name=upload,proto3" json:"upload,omitempty"`
+	// asset is the asset, still serving its current content.
+	Asset         *Asset `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceAssetContentResponse) Reset() {
+	*x = ReplaceAssetContentResponse{}
+	mi := &file_lession_v1_asset_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceAssetContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceAssetContentResponse) ProtoMessage() {}
+
+func (x *ReplaceAssetContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceAssetContentResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceAssetContentResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReplaceAssetContentResponse) GetUpload() *UploadSession {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
+func (x *ReplaceAssetContentResponse) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+// ListAssetVersionsRequest identifies the asset whose versions to list.
+type ListAssetVersionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// asset_id references the asset.
+	AssetId       string `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssetVersionsRequest) Reset() {
+	*x = ListAssetVersionsRequest{}
+	mi := &file_lession_v1_asset_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssetVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssetVersionsRequest) ProtoMessage() {}
+
+func (x *ListAssetVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssetVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAssetVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListAssetVersionsRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+// ListAssetVersionsResponse returns the versions of an asset.
+type ListAssetVersionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// versions lists the replaced content, newest first.
+	Versions      []*AssetVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssetVersionsResponse) Reset() {
+	*x = ListAssetVersionsResponse{}
+	mi := &file_lession_v1_asset_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssetVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssetVersionsResponse) ProtoMessage() {}
+
+func (x *ListAssetVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssetVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListAssetVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListAssetVersionsResponse) GetVersions() []*AssetVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+// GetAssetUsageRequest selects the asset and days to report.
+type GetAssetUsageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// asset_id references the asset.
+	AssetId string `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// from is the first day to report; defaults to 30 days before to.
+	From *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the day after the last day to report; defaults to tomorrow, or 30 days after from.
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssetUsageRequest) Reset() {
+	*x = GetAssetUsageRequest{}
+	mi := &file_lession_v1_asset_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssetUsageRequest) ProtoMessage() {}
+
+func (x *GetAssetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetAssetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetAssetUsageRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *GetAssetUsageRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetAssetUsageRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// GetAssetUsageResponse returns the usage of the asset.
+type GetAssetUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// usage contains the totals and daily counts.
+	Usage         *AssetUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssetUsageResponse) Reset() {
+	*x = GetAssetUsageResponse{}
+	mi := &file_lession_v1_asset_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssetUsageResponse) ProtoMessage() {}
+
+func (x *GetAssetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetAssetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetAssetUsageResponse) GetUsage() *AssetUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// AssetUsage counts the accesses of an asset's media over a range of days.
+type AssetUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// asset_id references the asset.
+	AssetId string `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// from is the first day reported.
+	From *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the day after the last day reported.
+	To *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// total sums the counts of every day reported.
+	Total *AssetUsageCounts `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
+	// days covers every day of the range, oldest first.
+	Days          []*DailyAssetUsage `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssetUsage) Reset() {
+	*x = AssetUsage{}
+	mi := &file_lession_v1_asset_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetUsage) ProtoMessage() {}
+
+func (x *AssetUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetUsage.ProtoReflect.Descriptor instead.
+func (*AssetUsage) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AssetUsage) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *AssetUsage) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AssetUsage) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *AssetUsage) GetTotal() *AssetUsageCounts {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *AssetUsage) GetDays() []*DailyAssetUsage {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+// DailyAssetUsage counts the accesses of an asset's media on a UTC day.
+type DailyAssetUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// day is the UTC midnight starting the day.
+	Day *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	// counts are the accesses on the day.
+	Counts        *AssetUsageCounts `protobuf:"bytes,2,opt,name=counts,proto3" json:"counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyAssetUsage) Reset() {
+	*x = DailyAssetUsage{}
+	mi := &file_lession_v1_asset_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyAssetUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyAssetUsage) ProtoMessage() {}
+
+func (x *DailyAssetUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyAssetUsage.ProtoReflect.Descriptor instead.
+func (*DailyAssetUsage) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DailyAssetUsage) GetDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *DailyAssetUsage) GetCounts() *AssetUsageCounts {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+// AssetUsageCounts counts the accesses of an asset's media.
+type AssetUsageCounts struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// playback_urls_issued counts the playback URLs handed to embedded players.
+	PlaybackUrlsIssued int64 `protobuf:"varint,1,opt,name=playback_urls_issued,json=playbackUrlsIssued,proto3" json:"playback_urls_issued,omitempty"`
+	// downloads counts the media downloads through offline download bundles.
+	Downloads     int64 `protobuf:"varint,2,opt,name=downloads,proto3" json:"downloads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssetUsageCounts) Reset() {
+	*x = AssetUsageCounts{}
+	mi := &file_lession_v1_asset_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetUsageCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetUsageCounts) ProtoMessage() {}
+
+func (x *AssetUsageCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_asset_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetUsageCounts.ProtoReflect.Descriptor instead.
+func (*AssetUsageCounts) Descriptor() ([]byte, []int) {
+	return file_lession_v1_asset_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AssetUsageCounts) GetPlaybackUrlsIssued() int64 {
+	if x != nil {
+		return x.PlaybackUrlsIssued
+	}
+	return 0
+}
+
+func (x *AssetUsageCounts) GetDownloads() int64 {
+	if x != nil {
+		return x.Downloads
+	}
+	return 0
+}
+
+var File_lession_v1_asset_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_asset_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1elession/v1/asset_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16lession/v1/asset.proto\x1a\x17lession/v1/series.proto\"\xe4\x01\n" +
+	"\x1cRegisterExternalAssetRequest\x127\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x15.lession.v1.MediaTypeB\f\xbaH\t\x82\x01\x06\x10\x01\x18\x01\x18\x02R\x04type\x12\x1d\n" +
+	"\x03url\x18\x02 \x01(\tB\v\xbaH\br\x06\x18\x80\x10\x88\x01\x01R\x03url\x125\n" +
+	"\x11original_filename\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x04R\x10originalFilename\x125\n" +
+	"\bduration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bduration\"H\n" +
+	"\x1dRegisterExternalAssetResponse\x12'\n" +
+	"\x05asset\x18\x01 \x01(\v2\x11.lession.v1.AssetR\x05asset\"\x82\x01\n" +
+	"\x12UpdateAssetRequest\x12/\n" +
+	"\x05asset\x18\x01 \x01(\v2\x11.lession.v1.AssetB\x06\xbaH\x03\xc8\x01\x01R\x05asset\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\">\n" +
+	"\x13UpdateAssetResponse\x12'\n" +
+	"\x05asset\x18\x01 \x01(\v2\x11.lession.v1.AssetR\x05asset\"8\n" +
+	"\x11WatchAssetRequest\x12#\n" +
+	"\basset_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aassetId\"=\n" +
+	"\x12WatchAssetResponse\x12'\n" +
+	"\x05asset\x18\x01 \x01(\v2\x11.lession.v1.AssetR\x05asset\":\n" +
+	"\x13PackageAssetRequest\x12#\n" +
+	"\basset_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aassetId\"?\n" +
+	"\x14PackageAssetResponse\x12'\n" +
+	"\x05asset\x18\x01 \x01(\v2\x11.lession.v1.AssetR\x05asset\"B\n" +
+	"\x1bRetryAssetProcessingRequest\x12#\n" +
+	"\basset_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aassetId\"G\n" +
+	"\x1cRetryAssetProcessingResponse\x12'\n" +
+	"\x05asset\x18\x01 \x01(\v2\x11.lession.v1.AssetR\x05asset\"\xd3\x01\n" +
+	"\x1aReplaceAssetContentRequest\x12#\n" +
+	"\basset_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aassetId\x127\n" +
+	"\x11original_filename\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x04R\x10originalFilename\x12'\n" +
+	"\tmime_type\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x02R\bmimeType\x12.\n" +
+	"\x0econtent_length\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\rcontentLength\"y\n" +
+	"\x1bReplaceAssetContentResponse\x121\n" +
+	"\x06upload\x18\x01 \x01(\v2\x19.lession.v1.UploadSessionR\x06upload\x12'\n" +
+	"\x05asset\x18\x02 \x01(\v2\x11.lession.v1.AssetR\x05asset\"?\n" +
+	"\x18ListAssetVersionsRequest\x12#\n" +
+	"\basset_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aassetId\"Q\n" +
+	"\x19ListAssetVersionsResponse\x124\n" +
+	"\bversions\x18\x01 \x03(\v2\x18.lession.v1.AssetVersionR\bversions\"\x97\x01\n" +
+	"\x14GetAssetUsageRequest\x12#\n" +
+	"\basset_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aassetId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"E\n" +
+	"\x15GetAssetUsageResponse\x12,\n" +
+	"\x05usage\x18\x01 \x01(\v2\x16.lession.v1.AssetUsageR\x05usage\"\xe8\x01\n" +
+	"\n" +
+	"AssetUsage\x12\x19\n" +
+	"\basset_id\x18\x01 \x01(\tR\aassetId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x122\n" +
+	"\x05total\x18\x04 \x01(\v2\x1c.lession.v1.AssetUsageCountsR\x05total\x12/\n" +
+	"\x04days\x18\x05 \x03(\v2\x1b.lession.v1.DailyAssetUsageR\x04days\"u\n" +
+	"\x0fDailyAssetUsage\x12,\n" +
+	"\x03day\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x03day\x124\n" +
+	"\x06counts\x18\x02 \x01(\v2\x1c.lession.v1.AssetUsageCountsR\x06counts\"b\n" +
+	"\x10AssetUsageCounts\x120\n" +
+	"\x14playback_urls_issued\x18\x01 \x01(\x03R\x12playbackUrlsIssued\x12\x1c\n" +
+	"\tdownloads\x18\x02 \x01(\x03R\tdownloads2\xf6\n" +
+	"\n" +
+	"\fAssetService\x12Q\n" +
+	"\fCreateUpload\x12\x1f.lession.v1.CreateUploadRequest\x1a .lession.v1.CreateUploadResponse\x12H\n" +
+	"\tGetUpload\x12\x1c.lession.v1.GetUploadRequest\x1a\x1d.lession.v1.GetUploadResponse\x12N\n" +
+	"\vListUploads\x12\x1e.lession.v1.ListUploadsRequest\x1a\x1f.lession.v1.ListUploadsResponse\x12W\n" +
+	"\x0eCompleteUpload\x12!.lession.v1.CompleteUploadRequest\x1a\".lession.v1.CompleteUploadResponse\x12Q\n" +
+	"\fCancelUpload\x12\x1f.lession.v1.CancelUploadRequest\x1a .lession.v1.CancelUploadResponse\x12l\n" +
+	"\x15RegisterExternalAsset\x12(.lession.v1.RegisterExternalAssetRequest\x1a).lession.v1.RegisterExternalAssetResponse\x12E\n" +
+	"\bGetAsset\x12\x1b.lession.v1.GetAssetRequest\x1a\x1c.lession.v1.GetAssetResponse\x12K\n" +
+	"\n" +
+	"ListAssets\x12\x1d.lession.v1.ListAssetsRequest\x1a\x1e.lession.v1.ListAssetsResponse\x12N\n" +
+	"\vUpdateAsset\x12\x1e.lession.v1.UpdateAssetRequest\x1a\x1f.lession.v1.UpdateAssetResponse\x12N\n" +
+	"\vDeleteAsset\x12\x1e.lession.v1.DeleteAssetRequest\x1a\x1f.lession.v1.DeleteAssetResponse\x12M\n" +
+	"\n" +
+	"WatchAsset\x12\x1d.lession.v1.WatchAssetRequest\x1a\x1e.lession.v1.WatchAssetResponse0\x01\x12Q\n" +
+	"\fPackageAsset\x12\x1f.lession.v1.PackageAssetRequest\x1a .lession.v1.PackageAssetResponse\x12i\n" +
+	"\x14RetryAssetProcessing\x12'.lession.v1.RetryAssetProcessingRequest\x1a(.lession.v1.RetryAssetProcessingResponse\x12f\n" +
+	"\x13ReplaceAssetContent\x12&.lession.v1.ReplaceAssetContentRequest\x1a'.lession.v1.ReplaceAssetContentResponse\x12`\n" +
+	"\x11ListAssetVersions\x12$.lession.v1.ListAssetVersionsRequest\x1a%.lession.v1.ListAssetVersionsResponse\x12T\n" +
+	"\rGetAssetUsage\x12 .lession.v1.GetAssetUsageRequest\x1a!.lession.v1.GetAssetUsageResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
 
 var (
 	file_lession_v1_asset_service_proto_rawDescOnce sync.Once
@@ -158,48 +1140,110 @@ func file_lession_v1_asset_service_proto_rawDescGZIP() []byte {
 	return file_lession_v1_asset_service_proto_rawDescData
 }
 
-var file_lession_v1_asset_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_lession_v1_asset_service_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_lession_v1_asset_service_proto_goTypes = []any{
-	(*UpdateAssetRequest)(nil),     // 0: lession.v1.UpdateAssetRequest
-	(*UpdateAssetResponse)(nil),    // 1: lession.v1.UpdateAssetResponse
-	(*Asset)(nil),                  // 2: lession.v1.Asset
-	(*fieldmaskpb.FieldMask)(nil),  // 3: google.protobuf.FieldMask
-	(*CreateUploadRequest)(nil),    // 4: lession.v1.CreateUploadRequest
-	(*GetUploadRequest)(nil),       // 5: lession.v1.GetUploadRequest
-	(*CompleteUploadRequest)(nil),  // 6: lession.v1.CompleteUploadRequest
-	(*GetAssetRequest)(nil),        // 7: lession.v1.GetAssetRequest
-	(*ListAssetsRequest)(nil),      // 8: lession.v1.ListAssetsRequest
-	(*DeleteAssetRequest)(nil),     // 9: lession.v1.DeleteAssetRequest
-	(*CreateUploadResponse)(nil),   // 10: lession.v1.CreateUploadResponse
-	(*GetUploadResponse)(nil),      // 11: lession.v1.GetUploadResponse
-	(*CompleteUploadResponse)(nil), // 12: lession.v1.CompleteUploadResponse
-	(*GetAssetResponse)(nil),       // 13: lession.v1.GetAssetResponse
-	(*ListAssetsResponse)(nil),     // 14: lession.v1.ListAssetsResponse
-	(*DeleteAssetResponse)(nil),    // 15: lession.v1.DeleteAssetResponse
+	(*RegisterExternalAssetRequest)(nil),  // 0: lession.v1.RegisterExternalAssetRequest
+	(*RegisterExternalAssetResponse)(nil), // 1: lession.v1.RegisterExternalAssetResponse
+	(*UpdateAssetRequest)(nil),            // 2: lession.v1.UpdateAssetRequest
+	(*UpdateAssetResponse)(nil),           // 3: lession.v1.UpdateAssetResponse
+	(*WatchAssetRequest)(nil),             // 4: lession.v1.WatchAssetRequest
+	(*WatchAssetResponse)(nil),            // 5: lession.v1.WatchAssetResponse
+	(*PackageAssetRequest)(nil),           // 6: lession.v1.PackageAssetRequest
+	(*PackageAssetResponse)(nil),          // 7: lession.v1.PackageAssetResponse
+	(*RetryAssetProcessingRequest)(nil),   // 8: lession.v1.RetryAssetProcessingRequest
+	(*RetryAssetProcessingResponse)(nil),  // 9: lession.v1.RetryAssetProcessingResponse
+	(*ReplaceAssetContentRequest)(nil),    // 10: lession.v1.ReplaceAssetContentRequest
+	(*ReplaceAssetContentResponse)(nil),   // 11: lession.v1.ReplaceAssetContentResponse
+	(*ListAssetVersionsRequest)(nil),      // 12: lession.v1.ListAssetVersionsRequest
+	(*ListAssetVersionsResponse)(nil),     // 13: lession.v1.ListAssetVersionsResponse
+	(*GetAssetUsageRequest)(nil),          // 14: lession.v1.GetAssetUsageRequest
+	(*GetAssetUsageResponse)(nil),         // 15: lession.v1.GetAssetUsageResponse
+	(*AssetUsage)(nil),                    // 16: lession.v1.AssetUsage
+	(*DailyAssetUsage)(nil),               // 17: lession.v1.DailyAssetUsage
+	(*AssetUsageCounts)(nil),              // 18: lession.v1.AssetUsageCounts
+	(MediaType)(0),                        // 19: lession.v1.MediaType
+	(*durationpb.Duration)(nil),           // 20: google.protobuf.Duration
+	(*Asset)(nil),                         // 21: lession.v1.Asset
+	(*fieldmaskpb.FieldMask)(nil),         // 22: google.protobuf.FieldMask
+	(*UploadSession)(nil),                 // 23: lession.v1.UploadSession
+	(*AssetVersion)(nil),                  // 24: lession.v1.AssetVersion
+	(*timestamppb.Timestamp)(nil),         // 25: google.protobuf.Timestamp
+	(*CreateUploadRequest)(nil),           // 26: lession.v1.CreateUploadRequest
+	(*GetUploadRequest)(nil),              // 27: lession.v1.GetUploadRequest
+	(*ListUploadsRequest)(nil),            // 28: lession.v1.ListUploadsRequest
+	(*CompleteUploadRequest)(nil),         // 29: lession.v1.CompleteUploadRequest
+	(*CancelUploadRequest)(nil),           // 30: lession.v1.CancelUploadRequest
+	(*GetAssetRequest)(nil),               // 31: lession.v1.GetAssetRequest
+	(*ListAssetsRequest)(nil),             // 32: lession.v1.ListAssetsRequest
+	(*DeleteAssetRequest)(nil),            // 33: lession.v1.DeleteAssetRequest
+	(*CreateUploadResponse)(nil),          // 34: lession.v1.CreateUploadResponse
+	(*GetUploadResponse)(nil),             // 35: lession.v1.GetUploadResponse
+	(*ListUploadsResponse)(nil),           // 36: lession.v1.ListUploadsResponse
+	(*CompleteUploadResponse)(nil),        // 37: lession.v1.CompleteUploadResponse
+	(*CancelUploadResponse)(nil),          // 38: lession.v1.CancelUploadResponse
+	(*GetAssetResponse)(nil),              // 39: lession.v1.GetAssetResponse
+	(*ListAssetsResponse)(nil),            // 40: lession.v1.ListAssetsResponse
+	(*DeleteAssetResponse)(nil),           // 41: lession.v1.DeleteAssetResponse
 }
 var file_lession_v1_asset_service_proto_depIdxs = []int32{
-	2,  // 0: lession.v1.UpdateAssetRequest.asset:type_name -> lession.v1.Asset
-	3,  // 1: lession.v1.UpdateAssetRequest.update_mask:type_name -> google.protobuf.FieldMask
-	2,  // 2: lession.v1.UpdateAssetResponse.asset:type_name -> lession.v1.Asset
-	4,  // 3: lession.v1.AssetService.CreateUpload:input_type -> lession.v1.CreateUploadRequest
-	5,  // 4: lession.v1.AssetService.GetUpload:input_type -> lession.v1.GetUploadRequest
-	6,  // 5: lession.v1.AssetService.CompleteUpload:input_type -> lession.v1.CompleteUploadRequest
-	7,  // 6: lession.v1.AssetService.GetAsset:input_type -> lession.v1.GetAssetRequest
-	8,  // 7: lession.v1.AssetService.ListAssets:input_type -> lession.v1.ListAssetsRequest
-	0,  // 8: lession.v1.AssetService.UpdateAsset:input_type -> lession.v1.UpdateAssetRequest
-	9,  // 9: lession.v1.AssetService.DeleteAsset:input_type -> lession.v1.DeleteAssetRequest
-	10, // 10: lession.v1.AssetService.CreateUpload:output_type -> lession.v1.CreateUploadResponse
-	11, // 11: lession.v1.AssetService.GetUpload:output_type -> lession.v1.GetUploadResponse
-	12, // 12: lession.v1.AssetService.CompleteUpload:output_type -> lession.v1.CompleteUploadResponse
-	13, // 13: lession.v1.AssetService.GetAsset:output_type -> lession.v1.GetAssetResponse
-	14, // 14: lession.v1.AssetService.ListAssets:output_type -> lession.v1.ListAssetsResponse
-	1,  // 15: lession.v1.AssetService.UpdateAsset:output_type -> lession.v1.UpdateAssetResponse
-	15, // 16: lession.v1.AssetService.DeleteAsset:output_type -> lession.v1.DeleteAssetResponse
-	10, // [10:17] is the sub-list for method output_type
-	3,  // [3:10] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	19, // 0: lession.v1.RegisterExternalAssetRequest.type:type_name -> lession.v1.MediaType
+	20, // 1: lession.v1.RegisterExternalAssetRequest.duration:type_name -> google.protobuf.Duration
+	21, // 2: lession.v1.RegisterExternalAssetResponse.asset:type_name -> lession.v1.Asset
+	21, // 3: lession.v1.UpdateAssetRequest.asset:type_name -> lession.v1.Asset
+	22, // 4: lession.v1.UpdateAssetRequest.update_mask:type_name -> google.protobuf.FieldMask
+	21, // 5: lession.v1.UpdateAssetResponse.asset:type_name -> lession.v1.Asset
+	21, // 6: lession.v1.WatchAssetResponse.asset:type_name -> lession.v1.Asset
+	21, // 7: lession.v1.PackageAssetResponse.asset:type_name -> lession.v1.Asset
+	21, // 8: lession.v1.RetryAssetProcessingResponse.asset:type_name -> lession.v1.Asset
+	23, // 9: lession.v1.ReplaceAssetContentResponse.upload:type_name -> lession.v1.UploadSession
+	21, // 10: lession.v1.ReplaceAssetContentResponse.asset:type_name -> lession.v1.Asset
+	24, // 11: lession.v1.ListAssetVersionsResponse.versions:type_name -> lession.v1.AssetVersion
+	25, // 12: lession.v1.GetAssetUsageRequest.from:type_name -> google.protobuf.Timestamp
+	25, // 13: lession.v1.GetAssetUsageRequest.to:type_name -> google.protobuf.Timestamp
+	16, // 14: lession.v1.GetAssetUsageResponse.usage:type_name -> lession.v1.AssetUsage
+	25, // 15: lession.v1.AssetUsage.from:type_name -> google.protobuf.Timestamp
+	25, // 16: lession.v1.AssetUsage.to:type_name -> google.protobuf.Timestamp
+	18, // 17: lession.v1.AssetUsage.total:type_name -> lession.v1.AssetUsageCounts
+	17, // 18: lession.v1.AssetUsage.days:type_name -> lession.v1.DailyAssetUsage
+	25, // 19: lession.v1.DailyAssetUsage.day:type_name -> google.protobuf.Timestamp
+	18, // 20: lession.v1.DailyAssetUsage.counts:type_name -> lession.v1.AssetUsageCounts
+	26, // 21: lession.v1.AssetService.CreateUpload:input_type -> lession.v1.CreateUploadRequest
+	27, // 22: lession.v1.AssetService.GetUpload:input_type -> lession.v1.GetUploadRequest
+	28, // 23: lession.v1.AssetService.ListUploads:input_type -> lession.v1.ListUploadsRequest
+	29, // 24: lession.v1.AssetService.CompleteUpload:input_type -> lession.v1.CompleteUploadRequest
+	30, // 25: lession.v1.AssetService.CancelUpload:input_type -> lession.v1.CancelUploadRequest
+	0,  // 26: lession.v1.AssetService.RegisterExternalAsset:input_type -> lession.v1.RegisterExternalAssetRequest
+	31, // 27: lession.v1.AssetService.GetAsset:input_type -> lession.v1.GetAssetRequest
+	32, // 28: lession.v1.AssetService.ListAssets:input_type -> lession.v1.ListAssetsRequest
+	2,  // 29: lession.v1.AssetService.UpdateAsset:input_type -> lession.v1.UpdateAssetRequest
+	33, // 30: lession.v1.AssetService.DeleteAsset:input_type -> lession.v1.DeleteAssetRequest
+	4,  // 31: lession.v1.AssetService.WatchAsset:input_type -> lession.v1.WatchAssetRequest
+	6,  // 32: lession.v1.AssetService.PackageAsset:input_type -> lession.v1.PackageAssetRequest
+	8,  // 33: lession.v1.AssetService.RetryAssetProcessing:input_type -> lession.v1.RetryAssetProcessingRequest
+	10, // 34: lession.v1.AssetService.ReplaceAssetContent:input_type -> lession.v1.ReplaceAssetContentRequest
+	12, // 35: lession.v1.AssetService.ListAssetVersions:input_type -> lession.v1.ListAssetVersionsRequest
+	14, // 36: lession.v1.AssetService.GetAssetUsage:input_type -> lession.v1.GetAssetUsageRequest
+	34, // 37: lession.v1.AssetService.CreateUpload:output_type -> lession.v1.CreateUploadResponse
+	35, // 38: lession.v1.AssetService.GetUpload:output_type -> lession.v1.GetUploadResponse
+	36, // 39: lession.v1.AssetService.ListUploads:output_type -> lession.v1.ListUploadsResponse
+	37, // 40: lession.v1.AssetService.CompleteUpload:output_type -> lession.v1.CompleteUploadResponse
+	38, // 41: lession.v1.AssetService.CancelUpload:output_type -> lession.v1.CancelUploadResponse
+	1,  // 42: lession.v1.AssetService.RegisterExternalAsset:output_type -> lession.v1.RegisterExternalAssetResponse
+	39, // 43: lession.v1.AssetService.GetAsset:output_type -> lession.v1.GetAssetResponse
+	40, // 44: lession.v1.AssetService.ListAssets:output_type -> lession.v1.ListAssetsResponse
+	3,  // 45: lession.v1.AssetService.UpdateAsset:output_type -> lession.v1.UpdateAssetResponse
+	41, // 46: lession.v1.AssetService.DeleteAsset:output_type -> lession.v1.DeleteAssetResponse
+	5,  // 47: lession.v1.AssetService.WatchAsset:output_type -> lession.v1.WatchAssetResponse
+	7,  // 48: lession.v1.AssetService.PackageAsset:output_type -> lession.v1.PackageAssetResponse
+	9,  // 49: lession.v1.AssetService.RetryAssetProcessing:output_type -> lession.v1.RetryAssetProcessingResponse
+	11, // 50: lession.v1.AssetService.ReplaceAssetContent:output_type -> lession.v1.ReplaceAssetContentResponse
+	13, // 51: lession.v1.AssetService.ListAssetVersions:output_type -> lession.v1.ListAssetVersionsResponse
+	15, // 52: lession.v1.AssetService.GetAssetUsage:output_type -> lession.v1.GetAssetUsageResponse
+	37, // [37:53] is the sub-list for method output_type
+	21, // [21:37] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_lession_v1_asset_service_proto_init() }
@@ -208,13 +1252,14 @@ func file_lession_v1_asset_service_proto_init() {
 		return
 	}
 	file_lession_v1_asset_proto_init()
+	file_lession_v1_series_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_asset_service_proto_rawDesc), len(file_lession_v1_asset_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
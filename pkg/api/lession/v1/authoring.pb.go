@@ -0,0 +1,399 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/authoring.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GeneratedEpisodeDraft is machine-written lesson material for an editor to review.
+type GeneratedEpisodeDraft struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// title suggests a title for the episode.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// summary describes the lesson in a sentence or two.
+	Summary string `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	// script is the text to record, one speaker turn per line.
+	Script string `protobuf:"bytes,3,opt,name=script,proto3" json:"script,omitempty"`
+	// vocabulary lists the words and phrases the lesson teaches.
+	Vocabulary []*DraftVocabularyItem `protobuf:"bytes,4,rep,name=vocabulary,proto3" json:"vocabulary,omitempty"`
+	// questions quiz learners on the lesson.
+	Questions []*DraftQuizQuestion `protobuf:"bytes,5,rep,name=questions,proto3" json:"questions,omitempty"`
+	// model names the language model that wrote the draft.
+	Model string `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
+	// usage counts the tokens the draft consumed.
+	Usage         *GenerationUsage `protobuf:"bytes,7,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratedEpisodeDraft) Reset() {
+	*x = GeneratedEpisodeDraft{}
+	mi := &file_lession_v1_authoring_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratedEpisodeDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratedEpisodeDraft) ProtoMessage() {}
+
+func (x *GeneratedEpisodeDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_authoring_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratedEpisodeDraft.ProtoReflect.Descriptor instead.
+func (*GeneratedEpisodeDraft) Descriptor() ([]byte, []int) {
+	return file_lession_v1_authoring_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GeneratedEpisodeDraft) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GeneratedEpisodeDraft) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *GeneratedEpisodeDraft) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
+}
+
+func (x *GeneratedEpisodeDraft) GetVocabulary() []*DraftVocabularyItem {
+	if x != nil {
+		return x.Vocabulary
+	}
+	return nil
+}
+
+func (x *GeneratedEpisodeDraft) GetQuestions() []*DraftQuizQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *GeneratedEpisodeDraft) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *GeneratedEpisodeDraft) GetUsage() *GenerationUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// DraftVocabularyItem is a word or phrase a drafted lesson teaches.
+type DraftVocabularyItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// term is the word or phrase.
+	Term string `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	// definition explains the term at the learner's level.
+	Definition string `protobuf:"bytes,2,opt,name=definition,proto3" json:"definition,omitempty"`
+	// example uses the term in a sentence.
+	Example       string `protobuf:"bytes,3,opt,name=example,proto3" json:"example,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DraftVocabularyItem) Reset() {
+	*x = DraftVocabularyItem{}
+	mi := &file_lession_v1_authoring_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftVocabularyItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftVocabularyItem) ProtoMessage() {}
+
+func (x *DraftVocabularyItem) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_authoring_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftVocabularyItem.ProtoReflect.Descriptor instead.
+func (*DraftVocabularyItem) Descriptor() ([]byte, []int) {
+	return file_lession_v1_authoring_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DraftVocabularyItem) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *DraftVocabularyItem) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
+
+func (x *DraftVocabularyItem) GetExample() string {
+	if x != nil {
+		return x.Example
+	}
+	return ""
+}
+
+// DraftQuizQuestion is a multiple-choice question on a drafted lesson.
+type DraftQuizQuestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// prompt is the question.
+	Prompt string `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	// choices lists the possible answers.
+	Choices []string `protobuf:"bytes,2,rep,name=choices,proto3" json:"choices,omitempty"`
+	// answer_index is the zero-based index of the correct choice.
+	AnswerIndex uint32 `protobuf:"varint,3,opt,name=answer_index,json=answerIndex,proto3" json:"answer_index,omitempty"`
+	// explanation says why the answer is correct.
+	Explanation   string `protobuf:"bytes,4,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DraftQuizQuestion) Reset() {
+	*x = DraftQuizQuestion{}
+	mi := &file_lession_v1_authoring_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftQuizQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftQuizQuestion) ProtoMessage() {}
+
+func (x *DraftQuizQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_authoring_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftQuizQuestion.ProtoReflect.Descriptor instead.
+func (*DraftQuizQuestion) Descriptor() ([]byte, []int) {
+	return file_lession_v1_authoring_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DraftQuizQuestion) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *DraftQuizQuestion) GetChoices() []string {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *DraftQuizQuestion) GetAnswerIndex() uint32 {
+	if x != nil {
+		return x.AnswerIndex
+	}
+	return 0
+}
+
+func (x *DraftQuizQuestion) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+// GenerationUsage counts the language model tokens a generation consumed.
+type GenerationUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// prompt_tokens counts the tokens sent to the model.
+	PromptTokens uint32 `protobuf:"varint,1,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	// completion_tokens counts the tokens the model wrote.
+	CompletionTokens uint32 `protobuf:"varint,2,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GenerationUsage) Reset() {
+	*x = GenerationUsage{}
+	mi := &file_lession_v1_authoring_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerationUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerationUsage) ProtoMessage() {}
+
+func (x *GenerationUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_authoring_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerationUsage.ProtoReflect.Descriptor instead.
+func (*GenerationUsage) Descriptor() ([]byte, []int) {
+	return file_lession_v1_authoring_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GenerationUsage) GetPromptTokens() uint32 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *GenerationUsage) GetCompletionTokens() uint32 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+var File_lession_v1_authoring_proto protoreflect.FileDescriptor
+
+const file_lession_v1_authoring_proto_rawDesc = "" +
+	"\n" +
+	"\x1alession/v1/authoring.proto\x12\n" +
+	"lession.v1\"\xa6\x02\n" +
+	"\x15GeneratedEpisodeDraft\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
+	"\asummary\x18\x02 \x01(\tR\asummary\x12\x16\n" +
+	"\x06script\x18\x03 \x01(\tR\x06script\x12?\n" +
+	"\n" +
+	"vocabulary\x18\x04 \x03(\v2\x1f.lession.v1.DraftVocabularyItemR\n" +
+	"vocabulary\x12;\n" +
+	"\tquestions\x18\x05 \x03(\v2\x1d.lession.v1.DraftQuizQuestionR\tquestions\x12\x14\n" +
+	"\x05model\x18\x06 \x01(\tR\x05model\x121\n" +
+	"\x05usage\x18\a \x01(\v2\x1b.lession.v1.GenerationUsageR\x05usage\"c\n" +
+	"\x13DraftVocabularyItem\x12\x12\n" +
+	"\x04term\x18\x01 \x01(\tR\x04term\x12\x1e\n" +
+	"\n" +
+	"definition\x18\x02 \x01(\tR\n" +
+	"definition\x12\x18\n" +
+	"\aexample\x18\x03 \x01(\tR\aexample\"\x8a\x01\n" +
+	"\x11DraftQuizQuestion\x12\x16\n" +
+	"\x06prompt\x18\x01 \x01(\tR\x06prompt\x12\x18\n" +
+	"\achoices\x18\x02 \x03(\tR\achoices\x12!\n" +
+	"\fanswer_index\x18\x03 \x01(\rR\vanswerIndex\x12 \n" +
+	"\vexplanation\x18\x04 \x01(\tR\vexplanation\"c\n" +
+	"\x0fGenerationUsage\x12#\n" +
+	"\rprompt_tokens\x18\x01 \x01(\rR\fpromptTokens\x12+\n" +
+	"\x11completion_tokens\x18\x02 \x01(\rR\x10completionTokensB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_authoring_proto_rawDescOnce sync.Once
+	file_lession_v1_authoring_proto_rawDescData []byte
+)
+
+func file_lession_v1_authoring_proto_rawDescGZIP() []byte {
+	file_lession_v1_authoring_proto_rawDescOnce.Do(func() {
+		file_lession_v1_authoring_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_authoring_proto_rawDesc), len(file_lession_v1_authoring_proto_rawDesc)))
+	})
+	return file_lession_v1_authoring_proto_rawDescData
+}
+
+var file_lession_v1_authoring_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_lession_v1_authoring_proto_goTypes = []any{
+	(*GeneratedEpisodeDraft)(nil), // 0: lession.v1.GeneratedEpisodeDraft
+	(*DraftVocabularyItem)(nil),   // 1: lession.v1.DraftVocabularyItem
+	(*DraftQuizQuestion)(nil),     // 2: lession.v1.DraftQuizQuestion
+	(*GenerationUsage)(nil),       // 3: lession.v1.GenerationUsage
+}
+var file_lession_v1_authoring_proto_depIdxs = []int32{
+	1, // 0: lession.v1.GeneratedEpisodeDraft.vocabulary:type_name -> lession.v1.DraftVocabularyItem
+	2, // 1: lession.v1.GeneratedEpisodeDraft.questions:type_name -> lession.v1.DraftQuizQuestion
+	3, // 2: lession.v1.GeneratedEpisodeDraft.usage:type_name -> lession.v1.GenerationUsage
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_authoring_proto_init() }
+func file_lession_v1_authoring_proto_init() {
+	if File_lession_v1_authoring_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_authoring_proto_rawDesc), len(file_lession_v1_authoring_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_authoring_proto_goTypes,
+		DependencyIndexes: file_lession_v1_authoring_proto_depIdxs,
+		MessageInfos:      file_lession_v1_authoring_proto_msgTypes,
+	}.Build()
+	File_lession_v1_authoring_proto = out.File
+	file_lession_v1_authoring_proto_goTypes = nil
+	file_lession_v1_authoring_proto_depIdxs = nil
+}
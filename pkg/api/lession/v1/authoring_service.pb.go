@@ -0,0 +1,213 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/authoring_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GenerateEpisodeDraftRequest describes the lesson to draft.
+type GenerateEpisodeDraftRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// topic is what the lesson is about.
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	// level is the CEFR level the lesson targets, A1 through C2.
+	Level string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	// language is the BCP 47 tag of the lesson language; English when empty.
+	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	// length_minutes is the running time of the script read aloud; five minutes when zero.
+	LengthMinutes uint32 `protobuf:"varint,4,opt,name=length_minutes,json=lengthMinutes,proto3" json:"length_minutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateEpisodeDraftRequest) Reset() {
+	*x = GenerateEpisodeDraftRequest{}
+	mi := &file_lession_v1_authoring_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateEpisodeDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateEpisodeDraftRequest) ProtoMessage() {}
+
+func (x *GenerateEpisodeDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_authoring_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateEpisodeDraftRequest.ProtoReflect.Descriptor instead.
+func (*GenerateEpisodeDraftRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_authoring_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenerateEpisodeDraftRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *GenerateEpisodeDraftRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *GenerateEpisodeDraftRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GenerateEpisodeDraftRequest) GetLengthMinutes() uint32 {
+	if x != nil {
+		return x.LengthMinutes
+	}
+	return 0
+}
+
+// GenerateEpisodeDraftResponse returns the draft.
+type GenerateEpisodeDraftResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// draft is the generated lesson material.
+	Draft         *GeneratedEpisodeDraft `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateEpisodeDraftResponse) Reset() {
+	*x = GenerateEpisodeDraftResponse{}
+	mi := &file_lession_v1_authoring_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateEpisodeDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateEpisodeDraftResponse) ProtoMessage() {}
+
+func (x *GenerateEpisodeDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_authoring_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateEpisodeDraftResponse.ProtoReflect.Descriptor instead.
+func (*GenerateEpisodeDraftResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_authoring_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GenerateEpisodeDraftResponse) GetDraft() *GeneratedEpisodeDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+var File_lession_v1_authoring_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_authoring_service_proto_rawDesc = "" +
+	"\n" +
+	"\"lession/v1/authoring_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1alession/v1/authoring.proto\"\xb3\x01\n" +
+	"\x1bGenerateEpisodeDraftRequest\x12 \n" +
+	"\x05topic\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xf4\x03R\x05topic\x12\x1d\n" +
+	"\x05level\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18\x02R\x05level\x12#\n" +
+	"\blanguage\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18#R\blanguage\x12.\n" +
+	"\x0elength_minutes\x18\x04 \x01(\rB\a\xbaH\x04*\x02\x18\x1eR\rlengthMinutes\"W\n" +
+	"\x1cGenerateEpisodeDraftResponse\x127\n" +
+	"\x05draft\x18\x01 \x01(\v2!.lession.v1.GeneratedEpisodeDraftR\x05draft2}\n" +
+	"\x10AuthoringService\x12i\n" +
+	"\x14GenerateEpisodeDraft\x12'.lession.v1.GenerateEpisodeDraftRequest\x1a(.lession.v1.GenerateEpisodeDraftResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_authoring_service_proto_rawDescOnce sync.Once
+	file_lession_v1_authoring_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_authoring_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_authoring_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_authoring_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_authoring_service_proto_rawDesc), len(file_lession_v1_authoring_service_proto_rawDesc)))
+	})
+	return file_lession_v1_authoring_service_proto_rawDescData
+}
+
+var file_lession_v1_authoring_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_lession_v1_authoring_service_proto_goTypes = []any{
+	(*GenerateEpisodeDraftRequest)(nil),  // 0: lession.v1.GenerateEpisodeDraftRequest
+	(*GenerateEpisodeDraftResponse)(nil), // 1: lession.v1.GenerateEpisodeDraftResponse
+	(*GeneratedEpisodeDraft)(nil),        // 2: lession.v1.GeneratedEpisodeDraft
+}
+var file_lession_v1_authoring_service_proto_depIdxs = []int32{
+	2, // 0: lession.v1.GenerateEpisodeDraftResponse.draft:type_name -> lession.v1.GeneratedEpisodeDraft
+	0, // 1: lession.v1.AuthoringService.GenerateEpisodeDraft:input_type -> lession.v1.GenerateEpisodeDraftRequest
+	1, // 2: lession.v1.AuthoringService.GenerateEpisodeDraft:output_type -> lession.v1.GenerateEpisodeDraftResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_authoring_service_proto_init() }
+func file_lession_v1_authoring_service_proto_init() {
+	if File_lession_v1_authoring_service_proto != nil {
+		return
+	}
+	file_lession_v1_authoring_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_authoring_service_proto_rawDesc), len(file_lession_v1_authoring_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_authoring_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_authoring_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_authoring_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_authoring_service_proto = out.File
+	file_lession_v1_authoring_service_proto_goTypes = nil
+	file_lession_v1_authoring_service_proto_depIdxs = nil
+}
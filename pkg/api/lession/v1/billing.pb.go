@@ -0,0 +1,403 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/billing.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// InvoiceStatus enumerates payment states for invoices.
+type InvoiceStatus int32
+
+const (
+	// INVOICE_STATUS_UNSPECIFIED is the default zero value.
+	InvoiceStatus_INVOICE_STATUS_UNSPECIFIED InvoiceStatus = 0
+	// INVOICE_STATUS_OPEN indicates payment is due or a payment attempt failed.
+	InvoiceStatus_INVOICE_STATUS_OPEN InvoiceStatus = 1
+	// INVOICE_STATUS_PAID indicates the invoice was paid.
+	InvoiceStatus_INVOICE_STATUS_PAID InvoiceStatus = 2
+	// INVOICE_STATUS_VOID indicates the invoice was canceled and is not owed.
+	InvoiceStatus_INVOICE_STATUS_VOID InvoiceStatus = 3
+)
+
+// Enum value maps for InvoiceStatus.
+var (
+	InvoiceStatus_name = map[int32]string{
+		0: "INVOICE_STATUS_UNSPECIFIED",
+		1: "INVOICE_STATUS_OPEN",
+		2: "INVOICE_STATUS_PAID",
+		3: "INVOICE_STATUS_VOID",
+	}
+	InvoiceStatus_value = map[string]int32{
+		"INVOICE_STATUS_UNSPECIFIED": 0,
+		"INVOICE_STATUS_OPEN":        1,
+		"INVOICE_STATUS_PAID":        2,
+		"INVOICE_STATUS_VOID":        3,
+	}
+)
+
+func (x InvoiceStatus) Enum() *InvoiceStatus {
+	p := new(InvoiceStatus)
+	*p = x
+	return p
+}
+
+func (x InvoiceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvoiceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_billing_proto_enumTypes[0].Descriptor()
+}
+
+func (InvoiceStatus) Type() protoreflect.EnumType {
+	return &file_lession_v1_billing_proto_enumTypes[0]
+}
+
+func (x InvoiceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InvoiceStatus.Descriptor instead.
+func (InvoiceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_billing_proto_rawDescGZIP(), []int{0}
+}
+
+// Invoice records a charge a billing provider issued for a subscription period.
+type Invoice struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the invoice.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// subscription_id references the subscription the invoice bills.
+	SubscriptionId string `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// billing_provider names the payment processor that issued the invoice, e.g. "stripe".
+	BillingProvider string `protobuf:"bytes,4,opt,name=billing_provider,json=billingProvider,proto3" json:"billing_provider,omitempty"`
+	// external_id is the invoice identifier at the billing provider.
+	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// amount_cents is the amount paid, or due when unpaid, in the currency's minor unit.
+	AmountCents int64 `protobuf:"varint,6,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	// currency is the ISO 4217 currency code.
+	Currency string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	// status is the payment state of the invoice.
+	Status InvoiceStatus `protobuf:"varint,8,opt,name=status,proto3,enum=lession.v1.InvoiceStatus" json:"status,omitempty"`
+	// period_start is when the billed service period begins.
+	PeriodStart *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// period_end is when the billed service period ends.
+	PeriodEnd *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	// hosted_url links to the provider-hosted invoice page.
+	HostedUrl string `protobuf:"bytes,11,opt,name=hosted_url,json=hostedUrl,proto3" json:"hosted_url,omitempty"`
+	// paid_at is when the invoice was paid.
+	PaidAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	// created_at is when the invoice was first recorded.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// status_label is the localized, human-readable invoice status, selected by Accept-Language.
+	StatusLabel   string `protobuf:"bytes,14,opt,name=status_label,json=statusLabel,proto3" json:"status_label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_lession_v1_billing_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_billing_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_lession_v1_billing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Invoice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invoice) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *Invoice) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Invoice) GetBillingProvider() string {
+	if x != nil {
+		return x.BillingProvider
+	}
+	return ""
+}
+
+func (x *Invoice) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *Invoice) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *Invoice) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Invoice) GetStatus() InvoiceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InvoiceStatus_INVOICE_STATUS_UNSPECIFIED
+}
+
+func (x *Invoice) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *Invoice) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *Invoice) GetHostedUrl() string {
+	if x != nil {
+		return x.HostedUrl
+	}
+	return ""
+}
+
+func (x *Invoice) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+func (x *Invoice) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Invoice) GetStatusLabel() string {
+	if x != nil {
+		return x.StatusLabel
+	}
+	return ""
+}
+
+// CheckoutSession is a provider-hosted payment page.
+type CheckoutSession struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the session identifier at the billing provider.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// url is where the learner should be redirected to pay.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// expires_at is when the session can no longer be completed.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutSession) Reset() {
+	*x = CheckoutSession{}
+	mi := &file_lession_v1_billing_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutSession) ProtoMessage() {}
+
+func (x *CheckoutSession) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_billing_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutSession.ProtoReflect.Descriptor instead.
+func (*CheckoutSession) Descriptor() ([]byte, []int) {
+	return file_lession_v1_billing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CheckoutSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CheckoutSession) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CheckoutSession) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+var File_lession_v1_billing_proto protoreflect.FileDescriptor
+
+const file_lession_v1_billing_proto_rawDesc = "" +
+	"\n" +
+	"\x18lession/v1/billing.proto\x12\n" +
+	"lession.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc5\x04\n" +
+	"\aInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12)\n" +
+	"\x10billing_provider\x18\x04 \x01(\tR\x0fbillingProvider\x12\x1f\n" +
+	"\vexternal_id\x18\x05 \x01(\tR\n" +
+	"externalId\x12!\n" +
+	"\famount_cents\x18\x06 \x01(\x03R\vamountCents\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x121\n" +
+	"\x06status\x18\b \x01(\x0e2\x19.lession.v1.InvoiceStatusR\x06status\x12=\n" +
+	"\fperiod_start\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12\x1d\n" +
+	"\n" +
+	"hosted_url\x18\v \x01(\tR\thostedUrl\x123\n" +
+	"\apaid_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x06paidAt\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
+	"\fstatus_label\x18\x0e \x01(\tR\vstatusLabel\"n\n" +
+	"\x0fCheckoutSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt*z\n" +
+	"\rInvoiceStatus\x12\x1e\n" +
+	"\x1aINVOICE_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13INVOICE_STATUS_OPEN\x10\x01\x12\x17\n" +
+	"\x13INVOICE_STATUS_PAID\x10\x02\x12\x17\n" +
+	"\x13INVOICE_STATUS_VOID\x10\x03B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_billing_proto_rawDescOnce sync.Once
+	file_lession_v1_billing_proto_rawDescData []byte
+)
+
+func file_lession_v1_billing_proto_rawDescGZIP() []byte {
+	file_lession_v1_billing_proto_rawDescOnce.Do(func() {
+		file_lession_v1_billing_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_billing_proto_rawDesc), len(file_lession_v1_billing_proto_rawDesc)))
+	})
+	return file_lession_v1_billing_proto_rawDescData
+}
+
+var file_lession_v1_billing_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lession_v1_billing_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_lession_v1_billing_proto_goTypes = []any{
+	(InvoiceStatus)(0),            // 0: lession.v1.InvoiceStatus
+	(*Invoice)(nil),               // 1: lession.v1.Invoice
+	(*CheckoutSession)(nil),       // 2: lession.v1.CheckoutSession
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_lession_v1_billing_proto_depIdxs = []int32{
+	0, // 0: lession.v1.Invoice.status:type_name -> lession.v1.InvoiceStatus
+	3, // 1: lession.v1.Invoice.period_start:type_name -> google.protobuf.Timestamp
+	3, // 2: lession.v1.Invoice.period_end:type_name -> google.protobuf.Timestamp
+	3, // 3: lession.v1.Invoice.paid_at:type_name -> google.protobuf.Timestamp
+	3, // 4: lession.v1.Invoice.created_at:type_name -> google.protobuf.Timestamp
+	3, // 5: lession.v1.CheckoutSession.expires_at:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_billing_proto_init() }
+func file_lession_v1_billing_proto_init() {
+	if File_lession_v1_billing_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_billing_proto_rawDesc), len(file_lession_v1_billing_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_billing_proto_goTypes,
+		DependencyIndexes: file_lession_v1_billing_proto_depIdxs,
+		EnumInfos:         file_lession_v1_billing_proto_enumTypes,
+		MessageInfos:      file_lession_v1_billing_proto_msgTypes,
+	}.Build()
+	File_lession_v1_billing_proto = out.File
+	file_lession_v1_billing_proto_goTypes = nil
+	file_lession_v1_billing_proto_depIdxs = nil
+}
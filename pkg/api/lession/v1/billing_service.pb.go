@@ -0,0 +1,317 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/billing_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CreateCheckoutSessionRequest selects the learner and plan.
+type CreateCheckoutSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// plan_id references the plan to purchase.
+	PlanId string `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// success_url is where the provider redirects after payment.
+	SuccessUrl string `protobuf:"bytes,3,opt,name=success_url,json=successUrl,proto3" json:"success_url,omitempty"`
+	// cancel_url is where the provider redirects if the learner abandons checkout.
+	CancelUrl     string `protobuf:"bytes,4,opt,name=cancel_url,json=cancelUrl,proto3" json:"cancel_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCheckoutSessionRequest) Reset() {
+	*x = CreateCheckoutSessionRequest{}
+	mi := &file_lession_v1_billing_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCheckoutSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCheckoutSessionRequest) ProtoMessage() {}
+
+func (x *CreateCheckoutSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_billing_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCheckoutSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCheckoutSessionRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_billing_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateCheckoutSessionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateCheckoutSessionRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *CreateCheckoutSessionRequest) GetSuccessUrl() string {
+	if x != nil {
+		return x.SuccessUrl
+	}
+	return ""
+}
+
+func (x *CreateCheckoutSessionRequest) GetCancelUrl() string {
+	if x != nil {
+		return x.CancelUrl
+	}
+	return ""
+}
+
+// CreateCheckoutSessionResponse returns the hosted checkout.
+type CreateCheckoutSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// session is the checkout the learner should be redirected to.
+	Session       *CheckoutSession `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCheckoutSessionResponse) Reset() {
+	*x = CreateCheckoutSessionResponse{}
+	mi := &file_lession_v1_billing_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCheckoutSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCheckoutSessionResponse) ProtoMessage() {}
+
+func (x *CreateCheckoutSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_billing_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCheckoutSessionResponse.ProtoReflect.Descriptor instead.
+func (*CreateCheckoutSessionResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_billing_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCheckoutSessionResponse) GetSession() *CheckoutSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+// ListInvoicesRequest selects the learner.
+type ListInvoicesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id identifies the learner.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesRequest) Reset() {
+	*x = ListInvoicesRequest{}
+	mi := &file_lession_v1_billing_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesRequest) ProtoMessage() {}
+
+func (x *ListInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_billing_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_billing_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListInvoicesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// ListInvoicesResponse returns the learner's invoices.
+type ListInvoicesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// invoices are ordered newest first.
+	Invoices      []*Invoice `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvoicesResponse) Reset() {
+	*x = ListInvoicesResponse{}
+	mi := &file_lession_v1_billing_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvoicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvoicesResponse) ProtoMessage() {}
+
+func (x *ListInvoicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_billing_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvoicesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvoicesResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_billing_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListInvoicesResponse) GetInvoices() []*Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+var File_lession_v1_billing_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_billing_service_proto_rawDesc = "" +
+	"\n" +
+	" lession/v1/billing_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x18lession/v1/billing.proto\"\xb7\x01\n" +
+	"\x1cCreateCheckoutSessionRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\x12!\n" +
+	"\aplan_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06planId\x12)\n" +
+	"\vsuccess_url\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x88\x01\x01R\n" +
+	"successUrl\x12'\n" +
+	"\n" +
+	"cancel_url\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x88\x01\x01R\tcancelUrl\"V\n" +
+	"\x1dCreateCheckoutSessionResponse\x125\n" +
+	"\asession\x18\x01 \x01(\v2\x1b.lession.v1.CheckoutSessionR\asession\"7\n" +
+	"\x13ListInvoicesRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\"G\n" +
+	"\x14ListInvoicesResponse\x12/\n" +
+	"\binvoices\x18\x01 \x03(\v2\x13.lession.v1.InvoiceR\binvoices2\xd1\x01\n" +
+	"\x0eBillingService\x12l\n" +
+	"\x15CreateCheckoutSession\x12(.lession.v1.CreateCheckoutSessionRequest\x1a).lession.v1.CreateCheckoutSessionResponse\x12Q\n" +
+	"\fListInvoices\x12\x1f.lession.v1.ListInvoicesRequest\x1a .lession.v1.ListInvoicesResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_billing_service_proto_rawDescOnce sync.Once
+	file_lession_v1_billing_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_billing_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_billing_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_billing_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_billing_service_proto_rawDesc), len(file_lession_v1_billing_service_proto_rawDesc)))
+	})
+	return file_lession_v1_billing_service_proto_rawDescData
+}
+
+var file_lession_v1_billing_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_lession_v1_billing_service_proto_goTypes = []any{
+	(*CreateCheckoutSessionRequest)(nil),  // 0: lession.v1.CreateCheckoutSessionRequest
+	(*CreateCheckoutSessionResponse)(nil), // 1: lession.v1.CreateCheckoutSessionResponse
+	(*ListInvoicesRequest)(nil),           // 2: lession.v1.ListInvoicesRequest
+	(*ListInvoicesResponse)(nil),          // 3: lession.v1.ListInvoicesResponse
+	(*CheckoutSession)(nil),               // 4: lession.v1.CheckoutSession
+	(*Invoice)(nil),                       // 5: lession.v1.Invoice
+}
+var file_lession_v1_billing_service_proto_depIdxs = []int32{
+	4, // 0: lession.v1.CreateCheckoutSessionResponse.session:type_name -> lession.v1.CheckoutSession
+	5, // 1: lession.v1.ListInvoicesResponse.invoices:type_name -> lession.v1.Invoice
+	0, // 2: lession.v1.BillingService.CreateCheckoutSession:input_type -> lession.v1.CreateCheckoutSessionRequest
+	2, // 3: lession.v1.BillingService.ListInvoices:input_type -> lession.v1.ListInvoicesRequest
+	1, // 4: lession.v1.BillingService.CreateCheckoutSession:output_type -> lession.v1.CreateCheckoutSessionResponse
+	3, // 5: lession.v1.BillingService.ListInvoices:output_type -> lession.v1.ListInvoicesResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_billing_service_proto_init() }
+func file_lession_v1_billing_service_proto_init() {
+	if File_lession_v1_billing_service_proto != nil {
+		return
+	}
+	file_lession_v1_billing_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_billing_service_proto_rawDesc), len(file_lession_v1_billing_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_billing_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_billing_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_billing_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_billing_service_proto = out.File
+	file_lession_v1_billing_service_proto_goTypes = nil
+	file_lession_v1_billing_service_proto_depIdxs = nil
+}
@@ -0,0 +1,484 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/booking.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BookingStatus enumerates the lifecycle states of a booking.
+type BookingStatus int32
+
+const (
+	// BOOKING_STATUS_UNSPECIFIED is the default zero value.
+	BookingStatus_BOOKING_STATUS_UNSPECIFIED BookingStatus = 0
+	// BOOKING_STATUS_CONFIRMED indicates the session will take place.
+	BookingStatus_BOOKING_STATUS_CONFIRMED BookingStatus = 1
+	// BOOKING_STATUS_CANCELLED indicates the teacher or the learner cancelled the session.
+	BookingStatus_BOOKING_STATUS_CANCELLED BookingStatus = 2
+)
+
+// Enum value maps for BookingStatus.
+var (
+	BookingStatus_name = map[int32]string{
+		0: "BOOKING_STATUS_UNSPECIFIED",
+		1: "BOOKING_STATUS_CONFIRMED",
+		2: "BOOKING_STATUS_CANCELLED",
+	}
+	BookingStatus_value = map[string]int32{
+		"BOOKING_STATUS_UNSPECIFIED": 0,
+		"BOOKING_STATUS_CONFIRMED":   1,
+		"BOOKING_STATUS_CANCELLED":   2,
+	}
+)
+
+func (x BookingStatus) Enum() *BookingStatus {
+	p := new(BookingStatus)
+	*p = x
+	return p
+}
+
+func (x BookingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BookingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_booking_proto_enumTypes[0].Descriptor()
+}
+
+func (BookingStatus) Type() protoreflect.EnumType {
+	return &file_lession_v1_booking_proto_enumTypes[0]
+}
+
+func (x BookingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BookingStatus.Descriptor instead.
+func (BookingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_booking_proto_rawDescGZIP(), []int{0}
+}
+
+// AvailabilitySlot is a window in which a teacher offers a one-to-one session.
+type AvailabilitySlot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the slot.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// teacher_id identifies the teacher offering the slot.
+	TeacherId string `protobuf:"bytes,2,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	// starts_at is when the session starts.
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	// ends_at is when the session ends.
+	EndsAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// booked is set while a confirmed booking holds the slot.
+	Booked bool `protobuf:"varint,5,opt,name=booked,proto3" json:"booked,omitempty"`
+	// created_at is when the slot was offered.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailabilitySlot) Reset() {
+	*x = AvailabilitySlot{}
+	mi := &file_lession_v1_booking_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilitySlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilitySlot) ProtoMessage() {}
+
+func (x *AvailabilitySlot) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_booking_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilitySlot.ProtoReflect.Descriptor instead.
+func (*AvailabilitySlot) Descriptor() ([]byte, []int) {
+	return file_lession_v1_booking_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AvailabilitySlot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AvailabilitySlot) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *AvailabilitySlot) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *AvailabilitySlot) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *AvailabilitySlot) GetBooked() bool {
+	if x != nil {
+		return x.Booked
+	}
+	return false
+}
+
+func (x *AvailabilitySlot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// TimeRange is a half-open interval of time.
+type TimeRange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// starts_at is the first instant of the range.
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	// ends_at is the instant the range ends, exclusive.
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeRange) Reset() {
+	*x = TimeRange{}
+	mi := &file_lession_v1_booking_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRange) ProtoMessage() {}
+
+func (x *TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_booking_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return file_lession_v1_booking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TimeRange) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *TimeRange) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+// Booking reserves a teacher's availability slot for a learner.
+type Booking struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the booking.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// slot_id references the booked slot.
+	SlotId string `protobuf:"bytes,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	// teacher_id identifies the teacher giving the session.
+	TeacherId string `protobuf:"bytes,3,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	// learner_id identifies the learner who booked the session.
+	LearnerId string `protobuf:"bytes,4,opt,name=learner_id,json=learnerId,proto3" json:"learner_id,omitempty"`
+	// starts_at is when the session starts.
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	// ends_at is when the session ends.
+	EndsAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// note is what the learner wants to cover in the session.
+	Note string `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	// status is the lifecycle state of the booking.
+	Status BookingStatus `protobuf:"varint,8,opt,name=status,proto3,enum=lession.v1.BookingStatus" json:"status,omitempty"`
+	// cancelled_at is when the booking was cancelled.
+	CancelledAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	// cancelled_by identifies who cancelled the booking, the teacher or the learner.
+	CancelledBy string `protobuf:"bytes,10,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	// cancel_reason explains the cancellation.
+	CancelReason string `protobuf:"bytes,11,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	// created_at is when the slot was booked.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is when the booking last changed.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Booking) Reset() {
+	*x = Booking{}
+	mi := &file_lession_v1_booking_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Booking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Booking) ProtoMessage() {}
+
+func (x *Booking) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_booking_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
+func (*Booking) Descriptor() ([]byte, []int) {
+	return file_lession_v1_booking_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Booking) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Booking) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *Booking) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *Booking) GetLearnerId() string {
+	if x != nil {
+		return x.LearnerId
+	}
+	return ""
+}
+
+func (x *Booking) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Booking) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Booking) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Booking) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *Booking) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *Booking) GetCancelledBy() string {
+	if x != nil {
+		return x.CancelledBy
+	}
+	return ""
+}
+
+func (x *Booking) GetCancelReason() string {
+	if x != nil {
+		return x.CancelReason
+	}
+	return ""
+}
+
+func (x *Booking) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Booking) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_lession_v1_booking_proto protoreflect.FileDescriptor
+
+const file_lession_v1_booking_proto_rawDesc = "" +
+	"\n" +
+	"\x18lession/v1/booking.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x82\x02\n" +
+	"\x10AvailabilitySlot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x02 \x01(\tR\tteacherId\x127\n" +
+	"\tstarts_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x16\n" +
+	"\x06booked\x18\x05 \x01(\bR\x06booked\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x89\x01\n" +
+	"\tTimeRange\x12?\n" +
+	"\tstarts_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\bstartsAt\x12;\n" +
+	"\aends_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x06endsAt\"\xa2\x04\n" +
+	"\aBooking\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aslot_id\x18\x02 \x01(\tR\x06slotId\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x03 \x01(\tR\tteacherId\x12\x1d\n" +
+	"\n" +
+	"learner_id\x18\x04 \x01(\tR\tlearnerId\x127\n" +
+	"\tstarts_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x121\n" +
+	"\x06status\x18\b \x01(\x0e2\x19.lession.v1.BookingStatusR\x06status\x12=\n" +
+	"\fcancelled_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x12!\n" +
+	"\fcancelled_by\x18\n" +
+	" \x01(\tR\vcancelledBy\x12#\n" +
+	"\rcancel_reason\x18\v \x01(\tR\fcancelReason\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt*k\n" +
+	"\rBookingStatus\x12\x1e\n" +
+	"\x1aBOOKING_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18BOOKING_STATUS_CONFIRMED\x10\x01\x12\x1c\n" +
+	"\x18BOOKING_STATUS_CANCELLED\x10\x02B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_booking_proto_rawDescOnce sync.Once
+	file_lession_v1_booking_proto_rawDescData []byte
+)
+
+func file_lession_v1_booking_proto_rawDescGZIP() []byte {
+	file_lession_v1_booking_proto_rawDescOnce.Do(func() {
+		file_lession_v1_booking_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_booking_proto_rawDesc), len(file_lession_v1_booking_proto_rawDesc)))
+	})
+	return file_lession_v1_booking_proto_rawDescData
+}
+
+var file_lession_v1_booking_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lession_v1_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_lession_v1_booking_proto_goTypes = []any{
+	(BookingStatus)(0),            // 0: lession.v1.BookingStatus
+	(*AvailabilitySlot)(nil),      // 1: lession.v1.AvailabilitySlot
+	(*TimeRange)(nil),             // 2: lession.v1.TimeRange
+	(*Booking)(nil),               // 3: lession.v1.Booking
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_lession_v1_booking_proto_depIdxs = []int32{
+	4,  // 0: lession.v1.AvailabilitySlot.starts_at:type_name -> google.protobuf.Timestamp
+	4,  // 1: lession.v1.AvailabilitySlot.ends_at:type_name -> google.protobuf.Timestamp
+	4,  // 2: lession.v1.AvailabilitySlot.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 3: lession.v1.TimeRange.starts_at:type_name -> google.protobuf.Timestamp
+	4,  // 4: lession.v1.TimeRange.ends_at:type_name -> google.protobuf.Timestamp
+	4,  // 5: lession.v1.Booking.starts_at:type_name -> google.protobuf.Timestamp
+	4,  // 6: lession.v1.Booking.ends_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: lession.v1.Booking.status:type_name -> lession.v1.BookingStatus
+	4,  // 8: lession.v1.Booking.cancelled_at:type_name -> google.protobuf.Timestamp
+	4,  // 9: lession.v1.Booking.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 10: lession.v1.Booking.updated_at:type_name -> google.protobuf.Timestamp
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_booking_proto_init() }
+func file_lession_v1_booking_proto_init() {
+	if File_lession_v1_booking_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_booking_proto_rawDesc), len(file_lession_v1_booking_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_booking_proto_goTypes,
+		DependencyIndexes: file_lession_v1_booking_proto_depIdxs,
+		EnumInfos:         file_lession_v1_booking_proto_enumTypes,
+		MessageInfos:      file_lession_v1_booking_proto_msgTypes,
+	}.Build()
+	File_lession_v1_booking_proto = out.File
+	file_lession_v1_booking_proto_goTypes = nil
+	file_lession_v1_booking_proto_depIdxs = nil
+}
@@ -0,0 +1,965 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/booking_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AddAvailabilityRequest supplies the slots a teacher offers.
+type AddAvailabilityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// teacher_id identifies the teacher offering the slots.
+	TeacherId string `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	// slots lists the time ranges to offer, each between 15 minutes and 4 hours long.
+	Slots         []*TimeRange `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAvailabilityRequest) Reset() {
+	*x = AddAvailabilityRequest{}
+	mi := &file_lession_v1_booking_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAvailabilityRequest) ProtoMessage() {}
+
+func (x *AddAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_booking_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*AddAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_booking_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddAvailabilityRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *AddAvailabilityRequest) GetSlots() []*TimeRange {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// AddAvailabilityResponse returns the created slots.
+type AddAvailabilityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// slots contains the persisted slots, earliest first.
+	Slots         []*AvailabilitySlot `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAvailabilityResponse) Reset() {
+	*x = AddAvailabilityResponse{}
+	mi := &file_lession_v1_booking_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAvailabilityResponse) ProtoMessage() {}
+
+func (x *AddAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_booking_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*AddAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_booking_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddAvailabilityResponse) GetSlots() []*AvailabilitySlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// RemoveAvailabilityRequest identifies the slot to withdraw.
+type RemoveAvailabilityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// teacher_id identifies the teacher who offered the slot.
+	TeacherId string `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	// slot_id references the slot to withdraw.
+	SlotId        string `protobuf:"bytes,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAvailabilityRequest) Reset() {
+	*x = RemoveAvailabilityRequest{}
+	mi := &file_lession_v1_booking_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAvailabilityRequest) ProtoMessage() {}
+
+func (x *RemoveAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_booking_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_booking_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RemoveAvailabilityRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *RemoveAvailabilityRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+// RemoveAvailabilityResponse acknowledges the removal.
+type RemoveAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAvailabilityResponse) Reset() {
+	*x = RemoveAvailabilityResponse{}
+	mi := &file_lession_v1_booking_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAvailabilityResponse) ProtoMessage() {}
+
+func (x *RemoveAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_booking_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*RemoveAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_booking_service_proto_rawDescGZIP(), []int{3}
+}
+
+// ListAvailabilityRequest carries filters for listing slots.
+type ListAvailabilityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// teacher_id restricts slots to a single teacher.
+	TeacherId string `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	// from is the earliest start time to return, now when unset.
+	From *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the start time to stop at, two weeks after from when unset; ranges span at most 90 days.
+	To *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// include_booked also returns booked slots.
+	IncludeBooked bool `protobuf:"varint,4,opt,name=include_booked,json=includeBooked,proto3" json:"include_booked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAvailabilityRequest) Reset() {
+	*x = ListAvailabilityRequest{}
+	mi := &file_lession_v1_booking_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAvailabilityRequest) ProtoMessage() {}
+
+func (x *ListAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_booking_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*ListAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_booking_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAvailabilityRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *ListAvailabilityRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListAvailabilityRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListAvailabilityRequest) GetIncludeBooked() bool {
+	if x != nil {
+		return x.IncludeBooked
+	}
+	return false
+}
+
+// ListAvailabilityResponse returns the matching slots.
+type ListAvailabilityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// slots contains the matching slots, earliest first.
+	Slots         []*AvailabilitySlot `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAvailabilityResponse) Reset() {
+	*x = ListAvailabilityResponse{}
+	mi := &file_lession_v1_booking_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAvailabilityResponse) ProtoMessage() {}
+
+func (x *ListAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_booking_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*ListAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_booking_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAvailabilityResponse) GetSlots() []*AvailabilitySlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// BookSlotRequest reserves a slot.
+type BookSlotRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// slot_id references the slot to book.
+	SlotId string `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	// learner_id identifies the learner booking the slot.
+	LearnerId string `protobuf:"bytes,2,opt,name=learner_id,json=learnerId,proto3" json:"learner_id,omitempty"`
+	// note tells the teacher what the learner wants to cover.
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookSlotRequest) Reset() {
+	*x = BookSlotRequest{}
+	mi := &file_lession_v1_booking_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookSlotRequest) ProtoMessage() {}
+
+func (x *BookSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_booking_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookSlotRequest.ProtoReflect.Descriptor instead.
+func (*BookSlotRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_booking_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BookSlotRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *BookSlotRequest) GetLearnerId() string {
+	if x != nil {
+		return x.LearnerId
+	}
+	return ""
+}
+
+func (x *BookSlotRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// BookSlotResponse returns the booking.
+type BookSlotResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// booking is the persisted booking.
+	Booking       *Booking `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookSlotResponse) Reset() {
+	*x = BookSlotResponse{}
+	mi := &file_lession_v1_booking_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookSlotResponse) ProtoMessage() {}
+
+func (x *BookSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_booking_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookSlotResponse.ProtoReflect.Descriptor instead.
+func (*BookSlotResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_booking_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BookSlotResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+// GetBookingRequest identifies the booking to retrieve.
+type GetBookingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// booking_id references the target booking.
+	BookingId     string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingRequest) Reset() {
+	*x = GetBookingRequest{}
+	mi := &file_lession_v1_booking_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingRequest) ProtoMessage() {}
+
+func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_booking_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_booking_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+// GetBookingResponse returns a single booking.
+type GetBookingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// booking is the requested resource.
+	Booking       *Booking `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingResponse) Reset() {
+	*x = GetBookingResponse{}
+	mi := &file_lession_v1_booking_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingResponse) ProtoMessage() {}
+
+func (x *GetBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_booking_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_booking_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+// CancelBookingRequest cancels a booking.
+type CancelBookingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// booking_id references the booking to cancel.
+	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	// user_id identifies who cancels, which must be the booking's teacher or learner.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// reason explains the cancellation to the other party.
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	mi := &file_lession_v1_booking_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_booking_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_booking_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *CancelBookingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelBookingRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// CancelBookingResponse returns the cancelled booking.
+type CancelBookingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// booking is the booking after cancellation.
+	Booking       *Booking `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingResponse) Reset() {
+	*x = CancelBookingResponse{}
+	mi := &file_lession_v1_booking_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingResponse) ProtoMessage() {}
+
+func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_booking_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_booking_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+// ListBookingsRequest carries filters for listing bookings.
+type ListBookingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_size limits the number of returned bookings.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token continues a prior ListBookings response.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// teacher_id restricts bookings to a single teacher.
+	TeacherId string `protobuf:"bytes,3,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	// learner_id restricts bookings to a single learner.
+	LearnerId string `protobuf:"bytes,4,opt,name=learner_id,json=learnerId,proto3" json:"learner_id,omitempty"`
+	// from restricts bookings to those starting at or after this time.
+	From *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	// to restricts bookings to those starting before this time.
+	To *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	// status restricts bookings to a single state.
+	Status        BookingStatus `protobuf:"varint,7,opt,name=status,proto3,enum=lession.v1.BookingStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookingsRequest) Reset() {
+	*x = ListBookingsRequest{}
+	mi := &file_lession_v1_booking_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingsRequest) ProtoMessage() {}
+
+func (x *ListBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_booking_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_booking_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListBookingsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBookingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListBookingsRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *ListBookingsRequest) GetLearnerId() string {
+	if x != nil {
+		return x.LearnerId
+	}
+	return ""
+}
+
+func (x *ListBookingsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListBookingsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListBookingsRequest) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+// ListBookingsResponse returns a page of bookings.
+type ListBookingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// bookings contains the matching bookings.
+	Bookings []*Booking `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	// next_page_token is supplied when more data is available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookingsResponse) Reset() {
+	*x = ListBookingsResponse{}
+	mi := &file_lession_v1_booking_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingsResponse) ProtoMessage() {}
+
+func (x *ListBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_booking_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ListBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_booking_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListBookingsResponse) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+func (x *ListBookingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_lession_v1_booking_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_booking_service_proto_rawDesc = "" +
+	"\n" +
+	" lession/v1/booking_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18lession/v1/booking.proto\"y\n" +
+	"\x16AddAvailabilityRequest\x12&\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tteacherId\x127\n" +
+	"\x05slots\x18\x02 \x03(\v2\x15.lession.v1.TimeRangeB\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x10dR\x05slots\"M\n" +
+	"\x17AddAvailabilityResponse\x122\n" +
+	"\x05slots\x18\x01 \x03(\v2\x1c.lession.v1.AvailabilitySlotR\x05slots\"f\n" +
+	"\x19RemoveAvailabilityRequest\x12&\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tteacherId\x12!\n" +
+	"\aslot_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06slotId\"\x1c\n" +
+	"\x1aRemoveAvailabilityResponse\"\xbb\x01\n" +
+	"\x17ListAvailabilityRequest\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tR\tteacherId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12%\n" +
+	"\x0einclude_booked\x18\x04 \x01(\bR\rincludeBooked\"N\n" +
+	"\x18ListAvailabilityResponse\x122\n" +
+	"\x05slots\x18\x01 \x03(\v2\x1c.lession.v1.AvailabilitySlotR\x05slots\"z\n" +
+	"\x0fBookSlotRequest\x12!\n" +
+	"\aslot_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06slotId\x12&\n" +
+	"\n" +
+	"learner_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tlearnerId\x12\x1c\n" +
+	"\x04note\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\x04note\"A\n" +
+	"\x10BookSlotResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.lession.v1.BookingR\abooking\"<\n" +
+	"\x11GetBookingRequest\x12'\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\"C\n" +
+	"\x12GetBookingResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.lession.v1.BookingR\abooking\"\x83\x01\n" +
+	"\x14CancelBookingRequest\x12'\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\x12 \n" +
+	"\auser_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\x12 \n" +
+	"\x06reason\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\x06reason\"F\n" +
+	"\x15CancelBookingResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.lession.v1.BookingR\abooking\"\xb1\x02\n" +
+	"\x13ListBookingsRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\rB\a\xbaH\x04*\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x03 \x01(\tR\tteacherId\x12\x1d\n" +
+	"\n" +
+	"learner_id\x18\x04 \x01(\tR\tlearnerId\x12.\n" +
+	"\x04from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12;\n" +
+	"\x06status\x18\a \x01(\x0e2\x19.lession.v1.BookingStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\"o\n" +
+	"\x14ListBookingsResponse\x12/\n" +
+	"\bbookings\x18\x01 \x03(\v2\x13.lession.v1.BookingR\bbookings\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\xed\x04\n" +
+	"\x0eBookingService\x12Z\n" +
+	"\x0fAddAvailability\x12\".lession.v1.AddAvailabilityRequest\x1a#.lession.v1.AddAvailabilityResponse\x12c\n" +
+	"\x12RemoveAvailability\x12%.lession.v1.RemoveAvailabilityRequest\x1a&.lession.v1.RemoveAvailabilityResponse\x12]\n" +
+	"\x10ListAvailability\x12#.lession.v1.ListAvailabilityRequest\x1a$.lession.v1.ListAvailabilityResponse\x12E\n" +
+	"\bBookSlot\x12\x1b.lession.v1.BookSlotRequest\x1a\x1c.lession.v1.BookSlotResponse\x12K\n" +
+	"\n" +
+	"GetBooking\x12\x1d.lession.v1.GetBookingRequest\x1a\x1e.lession.v1.GetBookingResponse\x12T\n" +
+	"\rCancelBooking\x12 .lession.v1.CancelBookingRequest\x1a!.lession.v1.CancelBookingResponse\x12Q\n" +
+	"\fListBookings\x12\x1f.lession.v1.ListBookingsRequest\x1a .lession.v1.ListBookingsResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_booking_service_proto_rawDescOnce sync.Once
+	file_lession_v1_booking_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_booking_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_booking_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_booking_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_booking_service_proto_rawDesc), len(file_lession_v1_booking_service_proto_rawDesc)))
+	})
+	return file_lession_v1_booking_service_proto_rawDescData
+}
+
+var file_lession_v1_booking_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_lession_v1_booking_service_proto_goTypes = []any{
+	(*AddAvailabilityRequest)(nil),     // 0: lession.v1.AddAvailabilityRequest
+	(*AddAvailabilityResponse)(nil),    // 1: lession.v1.AddAvailabilityResponse
+	(*RemoveAvailabilityRequest)(nil),  // 2: lession.v1.RemoveAvailabilityRequest
+	(*RemoveAvailabilityResponse)(nil), // 3: lession.v1.RemoveAvailabilityResponse
+	(*ListAvailabilityRequest)(nil),    // 4: lession.v1.ListAvailabilityRequest
+	(*ListAvailabilityResponse)(nil),   // 5: lession.v1.ListAvailabilityResponse
+	(*BookSlotRequest)(nil),            // 6: lession.v1.BookSlotRequest
+	(*BookSlotResponse)(nil),           // 7: lession.v1.BookSlotResponse
+	(*GetBookingRequest)(nil),          // 8: lession.v1.GetBookingRequest
+	(*GetBookingResponse)(nil),         // 9: lession.v1.GetBookingResponse
+	(*CancelBookingRequest)(nil),       // 10: lession.v1.CancelBookingRequest
+	(*CancelBookingResponse)(nil),      // 11: lession.v1.CancelBookingResponse
+	(*ListBookingsRequest)(nil),        // 12: lession.v1.ListBookingsRequest
+	(*ListBookingsResponse)(nil),       // 13: lession.v1.ListBookingsResponse
+	(*TimeRange)(nil),                  // 14: lession.v1.TimeRange
+	(*AvailabilitySlot)(nil),           // 15: lession.v1.AvailabilitySlot
+	(*timestamppb.Timestamp)(nil),      // 16: google.protobuf.Timestamp
+	(*Booking)(nil),                    // 17: lession.v1.Booking
+	(BookingStatus)(0),                 // 18: lession.v1.BookingStatus
+}
+var file_lession_v1_booking_service_proto_depIdxs = []int32{
+	14, // 0: lession.v1.AddAvailabilityRequest.slots:type_name -> lession.v1.TimeRange
+	15, // 1: lession.v1.AddAvailabilityResponse.slots:type_name -> lession.v1.AvailabilitySlot
+	16, // 2: lession.v1.ListAvailabilityRequest.from:type_name -> google.protobuf.Timestamp
+	16, // 3: lession.v1.ListAvailabilityRequest.to:type_name -> google.protobuf.Timestamp
+	15, // 4: lession.v1.ListAvailabilityResponse.slots:type_name -> lession.v1.AvailabilitySlot
+	17, // 5: lession.v1.BookSlotResponse.booking:type_name -> lession.v1.Booking
+	17, // 6: lession.v1.GetBookingResponse.booking:type_name -> lession.v1.Booking
+	17, // 7: lession.v1.CancelBookingResponse.booking:type_name -> lession.v1.Booking
+	16, // 8: lession.v1.ListBookingsRequest.from:type_name -> google.protobuf.Timestamp
+	16, // 9: lession.v1.ListBookingsRequest.to:type_name -> google.protobuf.Timestamp
+	18, // 10: lession.v1.ListBookingsRequest.status:type_name -> lession.v1.BookingStatus
+	17, // 11: lession.v1.ListBookingsResponse.bookings:type_name -> lession.v1.Booking
+	0,  // 12: lession.v1.BookingService.AddAvailability:input_type -> lession.v1.AddAvailabilityRequest
+	2,  // 13: lession.v1.BookingService.RemoveAvailability:input_type -> lession.v1.RemoveAvailabilityRequest
+	4,  // 14: lession.v1.BookingService.ListAvailability:input_type -> lession.v1.ListAvailabilityRequest
+	6,  // 15: lession.v1.BookingService.BookSlot:input_type -> lession.v1.BookSlotRequest
+	8,  // 16: lession.v1.BookingService.GetBooking:input_type -> lession.v1.GetBookingRequest
+	10, // 17: lession.v1.BookingService.CancelBooking:input_type -> lession.v1.CancelBookingRequest
+	12, // 18: lession.v1.BookingService.ListBookings:input_type -> lession.v1.ListBookingsRequest
+	1,  // 19: lession.v1.BookingService.AddAvailability:output_type -> lession.v1.AddAvailabilityResponse
+	3,  // 20: lession.v1.BookingService.RemoveAvailability:output_type -> lession.v1.RemoveAvailabilityResponse
+	5,  // 21: lession.v1.BookingService.ListAvailability:output_type -> lession.v1.ListAvailabilityResponse
+	7,  // 22: lession.v1.BookingService.BookSlot:output_type -> lession.v1.BookSlotResponse
+	9,  // 23: lession.v1.BookingService.GetBooking:output_type -> lession.v1.GetBookingResponse
+	11, // 24: lession.v1.BookingService.CancelBooking:output_type -> lession.v1.CancelBookingResponse
+	13, // 25: lession.v1.BookingService.ListBookings:output_type -> lession.v1.ListBookingsResponse
+	19, // [19:26] is the sub-list for method output_type
+	12, // [12:19] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_booking_service_proto_init() }
+func file_lession_v1_booking_service_proto_init() {
+	if File_lession_v1_booking_service_proto != nil {
+		return
+	}
+	file_lession_v1_booking_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_booking_service_proto_rawDesc), len(file_lession_v1_booking_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_booking_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_booking_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_booking_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_booking_service_proto = out.File
+	file_lession_v1_booking_service_proto_goTypes = nil
+	file_lession_v1_booking_service_proto_depIdxs = nil
+}
@@ -0,0 +1,645 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/catalog.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CatalogSeries is the public view of a published series. It carries no
+// author or asset identifiers.
+type CatalogSeries struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the identifier of the series.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// slug is a human-readable, unique identifier used in URLs.
+	Slug string `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	// title is the series headline shown to listeners.
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// summary provides a short synopsis of the series.
+	Summary string `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	// language declares the primary locale of the series content (ISO 639-1).
+	Language string `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	// level indicates the difficulty level (e.g. beginner, intermediate).
+	Level string `protobuf:"bytes,6,opt,name=level,proto3" json:"level,omitempty"`
+	// estimated_level is the CEFR level (A1-C2) estimated from the transcripts of the episodes.
+	EstimatedLevel string `protobuf:"bytes,7,opt,name=estimated_level,json=estimatedLevel,proto3" json:"estimated_level,omitempty"`
+	// tags captures optional classification keywords.
+	Tags []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	// cover_url references artwork that represents the series.
+	CoverUrl string `protobuf:"bytes,9,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	// accent_color is a #rrggbb colour; empty leaves the frontend's default.
+	AccentColor string `protobuf:"bytes,10,opt,name=accent_color,json=accentColor,proto3" json:"accent_color,omitempty"`
+	// hero_image_url references the hero image of the series page.
+	HeroImageUrl string `protobuf:"bytes,11,opt,name=hero_image_url,json=heroImageUrl,proto3" json:"hero_image_url,omitempty"`
+	// layout hints how the series page is laid out.
+	Layout SeriesLayout `protobuf:"varint,12,opt,name=layout,proto3,enum=lession.v1.SeriesLayout" json:"layout,omitempty"`
+	// episode_count is the number of published episodes in the series.
+	EpisodeCount uint32 `protobuf:"varint,13,opt,name=episode_count,json=episodeCount,proto3" json:"episode_count,omitempty"`
+	// published_at records when the series was first published.
+	PublishedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	// episodes contains the published episodes of the series in order, when requested.
+	Episodes      []*CatalogEpisode `protobuf:"bytes,20,rep,name=episodes,proto3" json:"episodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogSeries) Reset() {
+	*x = CatalogSeries{}
+	mi := &file_lession_v1_catalog_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogSeries) ProtoMessage() {}
+
+func (x *CatalogSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_catalog_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogSeries.ProtoReflect.Descriptor instead.
+func (*CatalogSeries) Descriptor() ([]byte, []int) {
+	return file_lession_v1_catalog_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CatalogSeries) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CatalogSeries) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CatalogSeries) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CatalogSeries) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *CatalogSeries) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *CatalogSeries) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *CatalogSeries) GetEstimatedLevel() string {
+	if x != nil {
+		return x.EstimatedLevel
+	}
+	return ""
+}
+
+func (x *CatalogSeries) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CatalogSeries) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+func (x *CatalogSeries) GetAccentColor() string {
+	if x != nil {
+		return x.AccentColor
+	}
+	return ""
+}
+
+func (x *CatalogSeries) GetHeroImageUrl() string {
+	if x != nil {
+		return x.HeroImageUrl
+	}
+	return ""
+}
+
+func (x *CatalogSeries) GetLayout() SeriesLayout {
+	if x != nil {
+		return x.Layout
+	}
+	return SeriesLayout_SERIES_LAYOUT_UNSPECIFIED
+}
+
+func (x *CatalogSeries) GetEpisodeCount() uint32 {
+	if x != nil {
+		return x.EpisodeCount
+	}
+	return 0
+}
+
+func (x *CatalogSeries) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *CatalogSeries) GetEpisodes() []*CatalogEpisode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+// CatalogEpisode is the public view of a published episode.
+type CatalogEpisode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the identifier of the episode.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// series_id is the identifier of the parent series.
+	SeriesId string `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// seq is the display order of the episode inside the series.
+	Seq uint32 `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	// title is the episode headline shown to listeners.
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// description provides additional context for the episode.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// duration tracks the expected consumption time for the episode.
+	Duration *durationpb.Duration `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	// preview marks episodes playable without an active subscription.
+	Preview bool `protobuf:"varint,7,opt,name=preview,proto3" json:"preview,omitempty"`
+	// media_type classifies the media of the episode.
+	MediaType MediaType `protobuf:"varint,8,opt,name=media_type,json=mediaType,proto3,enum=lession.v1.MediaType" json:"media_type,omitempty"`
+	// mime_type conveys the content type of the media.
+	MimeType string `protobuf:"bytes,9,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	// playback_url streams the media. Catalog responses are shared by every
+	// caller, so it is only set on preview episodes.
+	PlaybackUrl string `protobuf:"bytes,10,opt,name=playback_url,json=playbackUrl,proto3" json:"playback_url,omitempty"`
+	// transcript stores the textual version of the episode content. Sanitization findings are never included.
+	Transcript *Transcript `protobuf:"bytes,11,opt,name=transcript,proto3" json:"transcript,omitempty"`
+	// estimated_level is the CEFR level (A1-C2) estimated from the transcript.
+	EstimatedLevel string `protobuf:"bytes,12,opt,name=estimated_level,json=estimatedLevel,proto3" json:"estimated_level,omitempty"`
+	// published_at records when the episode was first published.
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogEpisode) Reset() {
+	*x = CatalogEpisode{}
+	mi := &file_lession_v1_catalog_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogEpisode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogEpisode) ProtoMessage() {}
+
+func (x *CatalogEpisode) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_catalog_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogEpisode.ProtoReflect.Descriptor instead.
+func (*CatalogEpisode) Descriptor() ([]byte, []int) {
+	return file_lession_v1_catalog_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CatalogEpisode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CatalogEpisode) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *CatalogEpisode) GetSeq() uint32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *CatalogEpisode) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CatalogEpisode) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CatalogEpisode) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *CatalogEpisode) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
+func (x *CatalogEpisode) GetMediaType() MediaType {
+	if x != nil {
+		return x.MediaType
+	}
+	return MediaType_MEDIA_TYPE_UNSPECIFIED
+}
+
+func (x *CatalogEpisode) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *CatalogEpisode) GetPlaybackUrl() string {
+	if x != nil {
+		return x.PlaybackUrl
+	}
+	return ""
+}
+
+func (x *CatalogEpisode) GetTranscript() *Transcript {
+	if x != nil {
+		return x.Transcript
+	}
+	return nil
+}
+
+func (x *CatalogEpisode) GetEstimatedLevel() string {
+	if x != nil {
+		return x.EstimatedLevel
+	}
+	return ""
+}
+
+func (x *CatalogEpisode) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+// CatalogPreview describes how a shared link to a published series or
+// episode unfurls, as Open Graph tags or an oEmbed response, and how the
+// player is embedded.
+type CatalogPreview struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// url is the canonical page URL of the series or episode.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// series_id is the identifier of the series, or the parent series of the episode.
+	SeriesId string `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// episode_id is the identifier of the episode; empty when previewing a series.
+	EpisodeId string `protobuf:"bytes,3,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// title is the headline of the link.
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// description is the summary of the series or the description of the episode.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// image_url references the cover of the series.
+	ImageUrl string `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	// duration is the length of the episode, or of all published episodes of a series.
+	Duration *durationpb.Duration `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	// media_type classifies the media of the episode, or of the first published episode of a series.
+	MediaType MediaType `protobuf:"varint,8,opt,name=media_type,json=mediaType,proto3,enum=lession.v1.MediaType" json:"media_type,omitempty"`
+	// embed_url is the player page to embed; empty when embedding is not configured.
+	EmbedUrl string `protobuf:"bytes,9,opt,name=embed_url,json=embedUrl,proto3" json:"embed_url,omitempty"`
+	// embed_html is the iframe embedding the player at embed_width by embed_height pixels.
+	EmbedHtml string `protobuf:"bytes,10,opt,name=embed_html,json=embedHtml,proto3" json:"embed_html,omitempty"`
+	// embed_width is the width of the embedded player in pixels.
+	EmbedWidth uint32 `protobuf:"varint,11,opt,name=embed_width,json=embedWidth,proto3" json:"embed_width,omitempty"`
+	// embed_height is the height of the embedded player in pixels.
+	EmbedHeight uint32 `protobuf:"varint,12,opt,name=embed_height,json=embedHeight,proto3" json:"embed_height,omitempty"`
+	// image_width is the width of the image in pixels.
+	ImageWidth uint32 `protobuf:"varint,13,opt,name=image_width,json=imageWidth,proto3" json:"image_width,omitempty"`
+	// image_height is the height of the image in pixels.
+	ImageHeight   uint32 `protobuf:"varint,14,opt,name=image_height,json=imageHeight,proto3" json:"image_height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogPreview) Reset() {
+	*x = CatalogPreview{}
+	mi := &file_lession_v1_catalog_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogPreview) ProtoMessage() {}
+
+func (x *CatalogPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_catalog_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogPreview.ProtoReflect.Descriptor instead.
+func (*CatalogPreview) Descriptor() ([]byte, []int) {
+	return file_lession_v1_catalog_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CatalogPreview) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CatalogPreview) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *CatalogPreview) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *CatalogPreview) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CatalogPreview) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CatalogPreview) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CatalogPreview) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *CatalogPreview) GetMediaType() MediaType {
+	if x != nil {
+		return x.MediaType
+	}
+	return MediaType_MEDIA_TYPE_UNSPECIFIED
+}
+
+func (x *CatalogPreview) GetEmbedUrl() string {
+	if x != nil {
+		return x.EmbedUrl
+	}
+	return ""
+}
+
+func (x *CatalogPreview) GetEmbedHtml() string {
+	if x != nil {
+		return x.EmbedHtml
+	}
+	return ""
+}
+
+func (x *CatalogPreview) GetEmbedWidth() uint32 {
+	if x != nil {
+		return x.EmbedWidth
+	}
+	return 0
+}
+
+func (x *CatalogPreview) GetEmbedHeight() uint32 {
+	if x != nil {
+		return x.EmbedHeight
+	}
+	return 0
+}
+
+func (x *CatalogPreview) GetImageWidth() uint32 {
+	if x != nil {
+		return x.ImageWidth
+	}
+	return 0
+}
+
+func (x *CatalogPreview) GetImageHeight() uint32 {
+	if x != nil {
+		return x.ImageHeight
+	}
+	return 0
+}
+
+var File_lession_v1_catalog_proto protoreflect.FileDescriptor
+
+const file_lession_v1_catalog_proto_rawDesc = "" +
+	"\n" +
+	"\x18lession/v1/catalog.proto\x12\n" +
+	"lession.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17lession/v1/series.proto\"\x86\x04\n" +
+	"\rCatalogSeries\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\asummary\x18\x04 \x01(\tR\asummary\x12\x1a\n" +
+	"\blanguage\x18\x05 \x01(\tR\blanguage\x12\x14\n" +
+	"\x05level\x18\x06 \x01(\tR\x05level\x12'\n" +
+	"\x0festimated_level\x18\a \x01(\tR\x0eestimatedLevel\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x1b\n" +
+	"\tcover_url\x18\t \x01(\tR\bcoverUrl\x12!\n" +
+	"\faccent_color\x18\n" +
+	" \x01(\tR\vaccentColor\x12$\n" +
+	"\x0ehero_image_url\x18\v \x01(\tR\fheroImageUrl\x120\n" +
+	"\x06layout\x18\f \x01(\x0e2\x18.lession.v1.SeriesLayoutR\x06layout\x12#\n" +
+	"\repisode_count\x18\r \x01(\rR\fepisodeCount\x12=\n" +
+	"\fpublished_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x126\n" +
+	"\bepisodes\x18\x14 \x03(\v2\x1a.lession.v1.CatalogEpisodeR\bepisodes\"\xee\x03\n" +
+	"\x0eCatalogEpisode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\x12\x10\n" +
+	"\x03seq\x18\x03 \x01(\rR\x03seq\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x125\n" +
+	"\bduration\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x18\n" +
+	"\apreview\x18\a \x01(\bR\apreview\x124\n" +
+	"\n" +
+	"media_type\x18\b \x01(\x0e2\x15.lession.v1.MediaTypeR\tmediaType\x12\x1b\n" +
+	"\tmime_type\x18\t \x01(\tR\bmimeType\x12!\n" +
+	"\fplayback_url\x18\n" +
+	" \x01(\tR\vplaybackUrl\x126\n" +
+	"\n" +
+	"transcript\x18\v \x01(\v2\x16.lession.v1.TranscriptR\n" +
+	"transcript\x12'\n" +
+	"\x0festimated_level\x18\f \x01(\tR\x0eestimatedLevel\x12=\n" +
+	"\fpublished_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\"\xe4\x03\n" +
+	"\x0eCatalogPreview\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x03 \x01(\tR\tepisodeId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1b\n" +
+	"\timage_url\x18\x06 \x01(\tR\bimageUrl\x125\n" +
+	"\bduration\x18\a \x01(\v2\x19.google.protobuf.DurationR\bduration\x124\n" +
+	"\n" +
+	"media_type\x18\b \x01(\x0e2\x15.lession.v1.MediaTypeR\tmediaType\x12\x1b\n" +
+	"\tembed_url\x18\t \x01(\tR\bembedUrl\x12\x1d\n" +
+	"\n" +
+	"embed_html\x18\n" +
+	" \x01(\tR\tembedHtml\x12\x1f\n" +
+	"\vembed_width\x18\v \x01(\rR\n" +
+	"embedWidth\x12!\n" +
+	"\fembed_height\x18\f \x01(\rR\vembedHeight\x12\x1f\n" +
+	"\vimage_width\x18\r \x01(\rR\n" +
+	"imageWidth\x12!\n" +
+	"\fimage_height\x18\x0e \x01(\rR\vimageHeightB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_catalog_proto_rawDescOnce sync.Once
+	file_lession_v1_catalog_proto_rawDescData []byte
+)
+
+func file_lession_v1_catalog_proto_rawDescGZIP() []byte {
+	file_lession_v1_catalog_proto_rawDescOnce.Do(func() {
+		file_lession_v1_catalog_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_catalog_proto_rawDesc), len(file_lession_v1_catalog_proto_rawDesc)))
+	})
+	return file_lession_v1_catalog_proto_rawDescData
+}
+
+var file_lession_v1_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_lession_v1_catalog_proto_goTypes = []any{
+	(*CatalogSeries)(nil),         // 0: lession.v1.CatalogSeries
+	(*CatalogEpisode)(nil),        // 1: lession.v1.CatalogEpisode
+	(*CatalogPreview)(nil),        // 2: lession.v1.CatalogPreview
+	(SeriesLayout)(0),             // 3: lession.v1.SeriesLayout
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 5: google.protobuf.Duration
+	(MediaType)(0),                // 6: lession.v1.MediaType
+	(*Transcript)(nil),            // 7: lession.v1.Transcript
+}
+var file_lession_v1_catalog_proto_depIdxs = []int32{
+	3, // 0: lession.v1.CatalogSeries.layout:type_name -> lession.v1.SeriesLayout
+	4, // 1: lession.v1.CatalogSeries.published_at:type_name -> google.protobuf.Timestamp
+	1, // 2: lession.v1.CatalogSeries.episodes:type_name -> lession.v1.CatalogEpisode
+	5, // 3: lession.v1.CatalogEpisode.duration:type_name -> google.protobuf.Duration
+	6, // 4: lession.v1.CatalogEpisode.media_type:type_name -> lession.v1.MediaType
+	7, // 5: lession.v1.CatalogEpisode.transcript:type_name -> lession.v1.Transcript
+	4, // 6: lession.v1.CatalogEpisode.published_at:type_name -> google.protobuf.Timestamp
+	5, // 7: lession.v1.CatalogPreview.duration:type_name -> google.protobuf.Duration
+	6, // 8: lession.v1.CatalogPreview.media_type:type_name -> lession.v1.MediaType
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_catalog_proto_init() }
+func file_lession_v1_catalog_proto_init() {
+	if File_lession_v1_catalog_proto != nil {
+		return
+	}
+	file_lession_v1_series_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_catalog_proto_rawDesc), len(file_lession_v1_catalog_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_catalog_proto_goTypes,
+		DependencyIndexes: file_lession_v1_catalog_proto_depIdxs,
+		MessageInfos:      file_lession_v1_catalog_proto_msgTypes,
+	}.Build()
+	File_lession_v1_catalog_proto = out.File
+	file_lession_v1_catalog_proto_goTypes = nil
+	file_lession_v1_catalog_proto_depIdxs = nil
+}
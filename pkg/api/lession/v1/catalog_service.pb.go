@@ -0,0 +1,658 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/catalog_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ListCatalogSeriesRequest carries filters for listing published series.
+type ListCatalogSeriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_size limits the number of returned series.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token continues a prior ListCatalogSeries response.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// language filters series by primary locale.
+	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	// level filters series by difficulty level.
+	Level string `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`
+	// estimated_level filters series by the CEFR level estimated from their transcripts.
+	EstimatedLevel string `protobuf:"bytes,5,opt,name=estimated_level,json=estimatedLevel,proto3" json:"estimated_level,omitempty"`
+	// tags filters series that contain any of the supplied tags.
+	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// query performs a fuzzy match against titles and summaries.
+	Query string `protobuf:"bytes,7,opt,name=query,proto3" json:"query,omitempty"`
+	// include_episodes requests that the published episodes are embedded in the response.
+	// Their transcripts are left out unless include_transcripts is set.
+	IncludeEpisodes bool `protobuf:"varint,8,opt,name=include_episodes,json=includeEpisodes,proto3" json:"include_episodes,omitempty"`
+	// include_transcripts embeds the transcripts of the episodes as well.
+	IncludeTranscripts bool `protobuf:"varint,9,opt,name=include_transcripts,json=includeTranscripts,proto3" json:"include_transcripts,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListCatalogSeriesRequest) Reset() {
+	*x = ListCatalogSeriesRequest{}
+	mi := &file_lession_v1_catalog_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCatalogSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCatalogSeriesRequest) ProtoMessage() {}
+
+func (x *ListCatalogSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_catalog_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCatalogSeriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCatalogSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_catalog_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListCatalogSeriesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCatalogSeriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCatalogSeriesRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ListCatalogSeriesRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *ListCatalogSeriesRequest) GetEstimatedLevel() string {
+	if x != nil {
+		return x.EstimatedLevel
+	}
+	return ""
+}
+
+func (x *ListCatalogSeriesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListCatalogSeriesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListCatalogSeriesRequest) GetIncludeEpisodes() bool {
+	if x != nil {
+		return x.IncludeEpisodes
+	}
+	return false
+}
+
+func (x *ListCatalogSeriesRequest) GetIncludeTranscripts() bool {
+	if x != nil {
+		return x.IncludeTranscripts
+	}
+	return false
+}
+
+// ListCatalogSeriesResponse returns a page of published series.
+type ListCatalogSeriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// series contains the requested page of series.
+	Series []*CatalogSeries `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	// next_page_token is supplied when more data is available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCatalogSeriesResponse) Reset() {
+	*x = ListCatalogSeriesResponse{}
+	mi := &file_lession_v1_catalog_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCatalogSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCatalogSeriesResponse) ProtoMessage() {}
+
+func (x *ListCatalogSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_catalog_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCatalogSeriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCatalogSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_catalog_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListCatalogSeriesResponse) GetSeries() []*CatalogSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *ListCatalogSeriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetCatalogSeriesRequest identifies the series to retrieve.
+type GetCatalogSeriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// series_id references the target series.
+	SeriesId string `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// include_episodes requests that the published episodes are embedded in the response.
+	IncludeEpisodes bool `protobuf:"varint,2,opt,name=include_episodes,json=includeEpisodes,proto3" json:"include_episodes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCatalogSeriesRequest) Reset() {
+	*x = GetCatalogSeriesRequest{}
+	mi := &file_lession_v1_catalog_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCatalogSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCatalogSeriesRequest) ProtoMessage() {}
+
+func (x *GetCatalogSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_catalog_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCatalogSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetCatalogSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_catalog_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetCatalogSeriesRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *GetCatalogSeriesRequest) GetIncludeEpisodes() bool {
+	if x != nil {
+		return x.IncludeEpisodes
+	}
+	return false
+}
+
+// GetCatalogSeriesResponse returns the requested series.
+type GetCatalogSeriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// series is the published series.
+	Series        *CatalogSeries `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCatalogSeriesResponse) Reset() {
+	*x = GetCatalogSeriesResponse{}
+	mi := &file_lession_v1_catalog_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCatalogSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCatalogSeriesResponse) ProtoMessage() {}
+
+func (x *GetCatalogSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_catalog_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCatalogSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetCatalogSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_catalog_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCatalogSeriesResponse) GetSeries() *CatalogSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+// GetCatalogEpisodeRequest identifies the episode to retrieve.
+type GetCatalogEpisodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// episode_id references the target episode.
+	EpisodeId     string `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCatalogEpisodeRequest) Reset() {
+	*x = GetCatalogEpisodeRequest{}
+	mi := &file_lession_v1_catalog_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCatalogEpisodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCatalogEpisodeRequest) ProtoMessage() {}
+
+func (x *GetCatalogEpisodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_catalog_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCatalogEpisodeRequest.ProtoReflect.Descriptor instead.
+func (*GetCatalogEpisodeRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_catalog_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCatalogEpisodeRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+// GetCatalogEpisodeResponse returns the requested episode.
+type GetCatalogEpisodeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// episode is the published episode.
+	Episode       *CatalogEpisode `protobuf:"bytes,1,opt,name=episode,proto3" json:"episode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCatalogEpisodeResponse) Reset() {
+	*x = GetCatalogEpisodeResponse{}
+	mi := &file_lession_v1_catalog_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCatalogEpisodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCatalogEpisodeResponse) ProtoMessage() {}
+
+func (x *GetCatalogEpisodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_catalog_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCatalogEpisodeResponse.ProtoReflect.Descriptor instead.
+func (*GetCatalogEpisodeResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_catalog_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCatalogEpisodeResponse) GetEpisode() *CatalogEpisode {
+	if x != nil {
+		return x.Episode
+	}
+	return nil
+}
+
+// GetCatalogPreviewRequest identifies the series or episode to preview.
+type GetCatalogPreviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*GetCatalogPreviewRequest_Url
+	//	*GetCatalogPreviewRequest_SeriesId
+	//	*GetCatalogPreviewRequest_EpisodeId
+	Target        isGetCatalogPreviewRequest_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCatalogPreviewRequest) Reset() {
+	*x = GetCatalogPreviewRequest{}
+	mi := &file_lession_v1_catalog_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCatalogPreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCatalogPreviewRequest) ProtoMessage() {}
+
+func (x *GetCatalogPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_catalog_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCatalogPreviewRequest.ProtoReflect.Descriptor instead.
+func (*GetCatalogPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_catalog_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetCatalogPreviewRequest) GetTarget() isGetCatalogPreviewRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *GetCatalogPreviewRequest) GetUrl() string {
+	if x != nil {
+		if x, ok := x.Target.(*GetCatalogPreviewRequest_Url); ok {
+			return x.Url
+		}
+	}
+	return ""
+}
+
+func (x *GetCatalogPreviewRequest) GetSeriesId() string {
+	if x != nil {
+		if x, ok := x.Target.(*GetCatalogPreviewRequest_SeriesId); ok {
+			return x.SeriesId
+		}
+	}
+	return ""
+}
+
+func (x *GetCatalogPreviewRequest) GetEpisodeId() string {
+	if x != nil {
+		if x, ok := x.Target.(*GetCatalogPreviewRequest_EpisodeId); ok {
+			return x.EpisodeId
+		}
+	}
+	return ""
+}
+
+type isGetCatalogPreviewRequest_Target interface {
+	isGetCatalogPreviewRequest_Target()
+}
+
+type GetCatalogPreviewRequest_Url struct {
+	// url is the page URL of a series or episode, as listed in the sitemap.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3,oneof"`
+}
+
+type GetCatalogPreviewRequest_SeriesId struct {
+	// series_id references a series.
+	SeriesId string `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3,oneof"`
+}
+
+type GetCatalogPreviewRequest_EpisodeId struct {
+	// episode_id references an episode.
+	EpisodeId string `protobuf:"bytes,3,opt,name=episode_id,json=episodeId,proto3,oneof"`
+}
+
+func (*GetCatalogPreviewRequest_Url) isGetCatalogPreviewRequest_Target() {}
+
+func (*GetCatalogPreviewRequest_SeriesId) isGetCatalogPreviewRequest_Target() {}
+
+func (*GetCatalogPreviewRequest_EpisodeId) isGetCatalogPreviewRequest_Target() {}
+
+// GetCatalogPreviewResponse returns the requested preview.
+type GetCatalogPreviewResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// preview describes the series or episode.
+	Preview       *CatalogPreview `protobuf:"bytes,1,opt,name=preview,proto3" json:"preview,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCatalogPreviewResponse) Reset() {
+	*x = GetCatalogPreviewResponse{}
+	mi := &file_lession_v1_catalog_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCatalogPreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCatalogPreviewResponse) ProtoMessage() {}
+
+func (x *GetCatalogPreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_catalog_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCatalogPreviewResponse.ProtoReflect.Descriptor instead.
+func (*GetCatalogPreviewResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_catalog_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetCatalogPreviewResponse) GetPreview() *CatalogPreview {
+	if x != nil {
+		return x.Preview
+	}
+	return nil
+}
+
+var File_lession_v1_catalog_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_catalog_service_proto_rawDesc = "" +
+	"\n" +
+	" lession/v1/catalog_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x18lession/v1/catalog.proto\"\x9e\x03\n" +
+	"\x18ListCatalogSeriesRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\rB\a\xbaH\x04*\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x123\n" +
+	"\blanguage\x18\x03 \x01(\tB\x17\xbaH\x14\xd8\x01\x01r\x0f2\r^[a-zA-Z]{2}$R\blanguage\x12\x1d\n" +
+	"\x05level\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18@R\x05level\x12I\n" +
+	"\x0festimated_level\x18\x05 \x01(\tB \xbaH\x1d\xd8\x01\x01r\x18R\x02A1R\x02A2R\x02B1R\x02B2R\x02C1R\x02C2R\x0eestimatedLevel\x12\"\n" +
+	"\x04tags\x18\x06 \x03(\tB\x0e\xbaH\v\x92\x01\b\"\x06r\x04\x10\x01\x18@R\x04tags\x12\x1e\n" +
+	"\x05query\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\x80\x02R\x05query\x12)\n" +
+	"\x10include_episodes\x18\b \x01(\bR\x0fincludeEpisodes\x12/\n" +
+	"\x13include_transcripts\x18\t \x01(\bR\x12includeTranscripts\"v\n" +
+	"\x19ListCatalogSeriesResponse\x121\n" +
+	"\x06series\x18\x01 \x03(\v2\x19.lession.v1.CatalogSeriesR\x06series\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"k\n" +
+	"\x17GetCatalogSeriesRequest\x12%\n" +
+	"\tseries_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bseriesId\x12)\n" +
+	"\x10include_episodes\x18\x02 \x01(\bR\x0fincludeEpisodes\"M\n" +
+	"\x18GetCatalogSeriesResponse\x121\n" +
+	"\x06series\x18\x01 \x01(\v2\x19.lession.v1.CatalogSeriesR\x06series\"C\n" +
+	"\x18GetCatalogEpisodeRequest\x12'\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tepisodeId\"Q\n" +
+	"\x19GetCatalogEpisodeResponse\x124\n" +
+	"\aepisode\x18\x01 \x01(\v2\x1a.lession.v1.CatalogEpisodeR\aepisode\"\xa0\x01\n" +
+	"\x18GetCatalogPreviewRequest\x12\x1f\n" +
+	"\x03url\x18\x01 \x01(\tB\v\xbaH\br\x06\x18\x80\x10\x88\x01\x01H\x00R\x03url\x12'\n" +
+	"\tseries_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\bseriesId\x12)\n" +
+	"\n" +
+	"episode_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\tepisodeIdB\x0f\n" +
+	"\x06target\x12\x05\xbaH\x02\b\x01\"Q\n" +
+	"\x19GetCatalogPreviewResponse\x124\n" +
+	"\apreview\x18\x01 \x01(\v2\x1a.lession.v1.CatalogPreviewR\apreview2\xa9\x03\n" +
+	"\x0eCatalogService\x12e\n" +
+	"\x11ListCatalogSeries\x12$.lession.v1.ListCatalogSeriesRequest\x1a%.lession.v1.ListCatalogSeriesResponse\"\x03\x90\x02\x01\x12b\n" +
+	"\x10GetCatalogSeries\x12#.lession.v1.GetCatalogSeriesRequest\x1a$.lession.v1.GetCatalogSeriesResponse\"\x03\x90\x02\x01\x12e\n" +
+	"\x11GetCatalogEpisode\x12$.lession.v1.GetCatalogEpisodeRequest\x1a%.lession.v1.GetCatalogEpisodeResponse\"\x03\x90\x02\x01\x12e\n" +
+	"\x11GetCatalogPreview\x12$.lession.v1.GetCatalogPreviewRequest\x1a%.lession.v1.GetCatalogPreviewResponse\"\x03\x90\x02\x01B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_catalog_service_proto_rawDescOnce sync.Once
+	file_lession_v1_catalog_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_catalog_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_catalog_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_catalog_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_catalog_service_proto_rawDesc), len(file_lession_v1_catalog_service_proto_rawDesc)))
+	})
+	return file_lession_v1_catalog_service_proto_rawDescData
+}
+
+var file_lession_v1_catalog_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_lession_v1_catalog_service_proto_goTypes = []any{
+	(*ListCatalogSeriesRequest)(nil),  // 0: lession.v1.ListCatalogSeriesRequest
+	(*ListCatalogSeriesResponse)(nil), // 1: lession.v1.ListCatalogSeriesResponse
+	(*GetCatalogSeriesRequest)(nil),   // 2: lession.v1.GetCatalogSeriesRequest
+	(*GetCatalogSeriesResponse)(nil),  // 3: lession.v1.GetCatalogSeriesResponse
+	(*GetCatalogEpisodeRequest)(nil),  // 4: lession.v1.GetCatalogEpisodeRequest
+	(*GetCatalogEpisodeResponse)(nil), // 5: lession.v1.GetCatalogEpisodeResponse
+	(*GetCatalogPreviewRequest)(nil),  // 6: lession.v1.GetCatalogPreviewRequest
+	(*GetCatalogPreviewResponse)(nil), // 7: lession.v1.GetCatalogPreviewResponse
+	(*CatalogSeries)(nil),             // 8: lession.v1.CatalogSeries
+	(*CatalogEpisode)(nil),            // 9: lession.v1.CatalogEpisode
+	(*CatalogPreview)(nil),            // 10: lession.v1.CatalogPreview
+}
+var file_lession_v1_catalog_service_proto_depIdxs = []int32{
+	8,  // 0: lession.v1.ListCatalogSeriesResponse.series:type_name -> lession.v1.CatalogSeries
+	8,  // 1: lession.v1.GetCatalogSeriesResponse.series:type_name -> lession.v1.CatalogSeries
+	9,  // 2: lession.v1.GetCatalogEpisodeResponse.episode:type_name -> lession.v1.CatalogEpisode
+	10, // 3: lession.v1.GetCatalogPreviewResponse.preview:type_name -> lession.v1.CatalogPreview
+	0,  // 4: lession.v1.CatalogService.ListCatalogSeries:input_type -> lession.v1.ListCatalogSeriesRequest
+	2,  // 5: lession.v1.CatalogService.GetCatalogSeries:input_type -> lession.v1.GetCatalogSeriesRequest
+	4,  // 6: lession.v1.CatalogService.GetCatalogEpisode:input_type -> lession.v1.GetCatalogEpisodeRequest
+	6,  // 7: lession.v1.CatalogService.GetCatalogPreview:input_type -> lession.v1.GetCatalogPreviewRequest
+	1,  // 8: lession.v1.CatalogService.ListCatalogSeries:output_type -> lession.v1.ListCatalogSeriesResponse
+	3,  // 9: lession.v1.CatalogService.GetCatalogSeries:output_type -> lession.v1.GetCatalogSeriesResponse
+	5,  // 10: lession.v1.CatalogService.GetCatalogEpisode:output_type -> lession.v1.GetCatalogEpisodeResponse
+	7,  // 11: lession.v1.CatalogService.GetCatalogPreview:output_type -> lession.v1.GetCatalogPreviewResponse
+	8,  // [8:12] is the sub-list for method output_type
+	4,  // [4:8] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_catalog_service_proto_init() }
+func file_lession_v1_catalog_service_proto_init() {
+	if File_lession_v1_catalog_service_proto != nil {
+		return
+	}
+	file_lession_v1_catalog_proto_init()
+	file_lession_v1_catalog_service_proto_msgTypes[6].OneofWrappers = []any{
+		(*GetCatalogPreviewRequest_Url)(nil),
+		(*GetCatalogPreviewRequest_SeriesId)(nil),
+		(*GetCatalogPreviewRequest_EpisodeId)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_catalog_service_proto_rawDesc), len(file_lession_v1_catalog_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_catalog_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_catalog_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_catalog_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_catalog_service_proto = out.File
+	file_lession_v1_catalog_service_proto_goTypes = nil
+	file_lession_v1_catalog_service_proto_depIdxs = nil
+}
@@ -0,0 +1,645 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/classroom.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AssignmentStatus enumerates progress states for a learner's assignment.
+type AssignmentStatus int32
+
+const (
+	// ASSIGNMENT_STATUS_UNSPECIFIED is the default zero value.
+	AssignmentStatus_ASSIGNMENT_STATUS_UNSPECIFIED AssignmentStatus = 0
+	// ASSIGNMENT_STATUS_NOT_STARTED indicates no episode has been finished yet.
+	AssignmentStatus_ASSIGNMENT_STATUS_NOT_STARTED AssignmentStatus = 1
+	// ASSIGNMENT_STATUS_IN_PROGRESS indicates some episodes remain before the due date.
+	AssignmentStatus_ASSIGNMENT_STATUS_IN_PROGRESS AssignmentStatus = 2
+	// ASSIGNMENT_STATUS_COMPLETED indicates every episode was finished by the due date.
+	AssignmentStatus_ASSIGNMENT_STATUS_COMPLETED AssignmentStatus = 3
+	// ASSIGNMENT_STATUS_COMPLETED_LATE indicates every episode was finished after the due date.
+	AssignmentStatus_ASSIGNMENT_STATUS_COMPLETED_LATE AssignmentStatus = 4
+	// ASSIGNMENT_STATUS_OVERDUE indicates the due date passed with episodes remaining.
+	AssignmentStatus_ASSIGNMENT_STATUS_OVERDUE AssignmentStatus = 5
+)
+
+// Enum value maps for AssignmentStatus.
+var (
+	AssignmentStatus_name = map[int32]string{
+		0: "ASSIGNMENT_STATUS_UNSPECIFIED",
+		1: "ASSIGNMENT_STATUS_NOT_STARTED",
+		2: "ASSIGNMENT_STATUS_IN_PROGRESS",
+		3: "ASSIGNMENT_STATUS_COMPLETED",
+		4: "ASSIGNMENT_STATUS_COMPLETED_LATE",
+		5: "ASSIGNMENT_STATUS_OVERDUE",
+	}
+	AssignmentStatus_value = map[string]int32{
+		"ASSIGNMENT_STATUS_UNSPECIFIED":    0,
+		"ASSIGNMENT_STATUS_NOT_STARTED":    1,
+		"ASSIGNMENT_STATUS_IN_PROGRESS":    2,
+		"ASSIGNMENT_STATUS_COMPLETED":      3,
+		"ASSIGNMENT_STATUS_COMPLETED_LATE": 4,
+		"ASSIGNMENT_STATUS_OVERDUE":        5,
+	}
+)
+
+func (x AssignmentStatus) Enum() *AssignmentStatus {
+	p := new(AssignmentStatus)
+	*p = x
+	return p
+}
+
+func (x AssignmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssignmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_classroom_proto_enumTypes[0].Descriptor()
+}
+
+func (AssignmentStatus) Type() protoreflect.EnumType {
+	return &file_lession_v1_classroom_proto_enumTypes[0]
+}
+
+func (x AssignmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssignmentStatus.Descriptor instead.
+func (AssignmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_proto_rawDescGZIP(), []int{0}
+}
+
+// Classroom groups learners under a teacher so series can be assigned to them together.
+type Classroom struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the classroom.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// teacher_id identifies the teacher who runs the classroom.
+	TeacherId string `protobuf:"bytes,2,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	// name is the display name of the classroom.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// description tells learners what the classroom is for.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// learner_ids lists the enrolled learners.
+	LearnerIds []string `protobuf:"bytes,5,rep,name=learner_ids,json=learnerIds,proto3" json:"learner_ids,omitempty"`
+	// assignments lists the assigned series, earliest due first.
+	Assignments []*ClassroomAssignment `protobuf:"bytes,6,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	// created_at is when the classroom was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is when the classroom or its enrolment last changed.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Classroom) Reset() {
+	*x = Classroom{}
+	mi := &file_lession_v1_classroom_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Classroom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Classroom) ProtoMessage() {}
+
+func (x *Classroom) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Classroom.ProtoReflect.Descriptor instead.
+func (*Classroom) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Classroom) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Classroom) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *Classroom) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Classroom) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Classroom) GetLearnerIds() []string {
+	if x != nil {
+		return x.LearnerIds
+	}
+	return nil
+}
+
+func (x *Classroom) GetAssignments() []*ClassroomAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *Classroom) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Classroom) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ClassroomAssignment asks every learner in a classroom to finish a series by a due date.
+type ClassroomAssignment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the assignment.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// classroom_id references the owning classroom.
+	ClassroomId string `protobuf:"bytes,2,opt,name=classroom_id,json=classroomId,proto3" json:"classroom_id,omitempty"`
+	// series_id references the assigned series.
+	SeriesId string `protobuf:"bytes,3,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// due_at is when learners should have finished the series.
+	DueAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	// created_at is when the series was assigned.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassroomAssignment) Reset() {
+	*x = ClassroomAssignment{}
+	mi := &file_lession_v1_classroom_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassroomAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassroomAssignment) ProtoMessage() {}
+
+func (x *ClassroomAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassroomAssignment.ProtoReflect.Descriptor instead.
+func (*ClassroomAssignment) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClassroomAssignment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClassroomAssignment) GetClassroomId() string {
+	if x != nil {
+		return x.ClassroomId
+	}
+	return ""
+}
+
+func (x *ClassroomAssignment) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *ClassroomAssignment) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *ClassroomAssignment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ClassroomDraft contains user-modifiable classroom attributes.
+type ClassroomDraft struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the display name of the classroom.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// description tells learners what the classroom is for.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// learner_ids lists the learners to enrol when the classroom is created.
+	LearnerIds    []string `protobuf:"bytes,3,rep,name=learner_ids,json=learnerIds,proto3" json:"learner_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassroomDraft) Reset() {
+	*x = ClassroomDraft{}
+	mi := &file_lession_v1_classroom_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassroomDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassroomDraft) ProtoMessage() {}
+
+func (x *ClassroomDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassroomDraft.ProtoReflect.Descriptor instead.
+func (*ClassroomDraft) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClassroomDraft) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClassroomDraft) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ClassroomDraft) GetLearnerIds() []string {
+	if x != nil {
+		return x.LearnerIds
+	}
+	return nil
+}
+
+// AssignmentProgress reports how far a learner got through an assigned series.
+type AssignmentProgress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// assignment_id references the assignment.
+	AssignmentId string `protobuf:"bytes,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	// series_id references the assigned series.
+	SeriesId string `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// series_title is the title of the assigned series.
+	SeriesTitle string `protobuf:"bytes,3,opt,name=series_title,json=seriesTitle,proto3" json:"series_title,omitempty"`
+	// due_at is when the learner should have finished the series.
+	DueAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	// episodes_completed counts published episodes the learner has finished.
+	EpisodesCompleted int32 `protobuf:"varint,5,opt,name=episodes_completed,json=episodesCompleted,proto3" json:"episodes_completed,omitempty"`
+	// episode_count is the number of published episodes in the series.
+	EpisodeCount int32 `protobuf:"varint,6,opt,name=episode_count,json=episodeCount,proto3" json:"episode_count,omitempty"`
+	// status summarises the learner's progress.
+	Status AssignmentStatus `protobuf:"varint,7,opt,name=status,proto3,enum=lession.v1.AssignmentStatus" json:"status,omitempty"`
+	// completed_at is when the last outstanding episode was first finished.
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// status_label is the localized, human-readable assignment status, selected by Accept-Language.
+	StatusLabel   string `protobuf:"bytes,9,opt,name=status_label,json=statusLabel,proto3" json:"status_label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignmentProgress) Reset() {
+	*x = AssignmentProgress{}
+	mi := &file_lession_v1_classroom_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentProgress) ProtoMessage() {}
+
+func (x *AssignmentProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentProgress.ProtoReflect.Descriptor instead.
+func (*AssignmentProgress) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AssignmentProgress) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+func (x *AssignmentProgress) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *AssignmentProgress) GetSeriesTitle() string {
+	if x != nil {
+		return x.SeriesTitle
+	}
+	return ""
+}
+
+func (x *AssignmentProgress) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *AssignmentProgress) GetEpisodesCompleted() int32 {
+	if x != nil {
+		return x.EpisodesCompleted
+	}
+	return 0
+}
+
+func (x *AssignmentProgress) GetEpisodeCount() int32 {
+	if x != nil {
+		return x.EpisodeCount
+	}
+	return 0
+}
+
+func (x *AssignmentProgress) GetStatus() AssignmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AssignmentStatus_ASSIGNMENT_STATUS_UNSPECIFIED
+}
+
+func (x *AssignmentProgress) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *AssignmentProgress) GetStatusLabel() string {
+	if x != nil {
+		return x.StatusLabel
+	}
+	return ""
+}
+
+// StudentProgress collects a learner's progress across a classroom's assignments.
+type StudentProgress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// assignments reports progress per assignment, in the classroom's assignment order.
+	Assignments []*AssignmentProgress `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	// assignments_completed counts assignments the learner has finished.
+	AssignmentsCompleted int32 `protobuf:"varint,3,opt,name=assignments_completed,json=assignmentsCompleted,proto3" json:"assignments_completed,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *StudentProgress) Reset() {
+	*x = StudentProgress{}
+	mi := &file_lession_v1_classroom_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentProgress) ProtoMessage() {}
+
+func (x *StudentProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentProgress.ProtoReflect.Descriptor instead.
+func (*StudentProgress) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StudentProgress) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StudentProgress) GetAssignments() []*AssignmentProgress {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *StudentProgress) GetAssignmentsCompleted() int32 {
+	if x != nil {
+		return x.AssignmentsCompleted
+	}
+	return 0
+}
+
+var File_lession_v1_classroom_proto protoreflect.FileDescriptor
+
+const file_lession_v1_classroom_proto_rawDesc = "" +
+	"\n" +
+	"\x1alession/v1/classroom.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xca\x02\n" +
+	"\tClassroom\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x02 \x01(\tR\tteacherId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vlearner_ids\x18\x05 \x03(\tR\n" +
+	"learnerIds\x12A\n" +
+	"\vassignments\x18\x06 \x03(\v2\x1f.lession.v1.ClassroomAssignmentR\vassignments\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd3\x01\n" +
+	"\x13ClassroomAssignment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fclassroom_id\x18\x02 \x01(\tR\vclassroomId\x12\x1b\n" +
+	"\tseries_id\x18\x03 \x01(\tR\bseriesId\x121\n" +
+	"\x06due_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8e\x01\n" +
+	"\x0eClassroomDraft\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x01R\x04name\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\x120\n" +
+	"\vlearner_ids\x18\x03 \x03(\tB\x0f\xbaH\f\x92\x01\t\x10\xf4\x03\"\x04r\x02\x10\x01R\n" +
+	"learnerIds\"\x98\x03\n" +
+	"\x12AssignmentProgress\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\tR\fassignmentId\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\x12!\n" +
+	"\fseries_title\x18\x03 \x01(\tR\vseriesTitle\x121\n" +
+	"\x06due_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12-\n" +
+	"\x12episodes_completed\x18\x05 \x01(\x05R\x11episodesCompleted\x12#\n" +
+	"\repisode_count\x18\x06 \x01(\x05R\fepisodeCount\x124\n" +
+	"\x06status\x18\a \x01(\x0e2\x1c.lession.v1.AssignmentStatusR\x06status\x12=\n" +
+	"\fcompleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12!\n" +
+	"\fstatus_label\x18\t \x01(\tR\vstatusLabel\"\xa1\x01\n" +
+	"\x0fStudentProgress\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12@\n" +
+	"\vassignments\x18\x02 \x03(\v2\x1e.lession.v1.AssignmentProgressR\vassignments\x123\n" +
+	"\x15assignments_completed\x18\x03 \x01(\x05R\x14assignmentsCompleted*\xe1\x01\n" +
+	"\x10AssignmentStatus\x12!\n" +
+	"\x1dASSIGNMENT_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dASSIGNMENT_STATUS_NOT_STARTED\x10\x01\x12!\n" +
+	"\x1dASSIGNMENT_STATUS_IN_PROGRESS\x10\x02\x12\x1f\n" +
+	"\x1bASSIGNMENT_STATUS_COMPLETED\x10\x03\x12$\n" +
+	" ASSIGNMENT_STATUS_COMPLETED_LATE\x10\x04\x12\x1d\n" +
+	"\x19ASSIGNMENT_STATUS_OVERDUE\x10\x05B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_classroom_proto_rawDescOnce sync.Once
+	file_lession_v1_classroom_proto_rawDescData []byte
+)
+
+func file_lession_v1_classroom_proto_rawDescGZIP() []byte {
+	file_lession_v1_classroom_proto_rawDescOnce.Do(func() {
+		file_lession_v1_classroom_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_classroom_proto_rawDesc), len(file_lession_v1_classroom_proto_rawDesc)))
+	})
+	return file_lession_v1_classroom_proto_rawDescData
+}
+
+var file_lession_v1_classroom_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lession_v1_classroom_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_lession_v1_classroom_proto_goTypes = []any{
+	(AssignmentStatus)(0),         // 0: lession.v1.AssignmentStatus
+	(*Classroom)(nil),             // 1: lession.v1.Classroom
+	(*ClassroomAssignment)(nil),   // 2: lession.v1.ClassroomAssignment
+	(*ClassroomDraft)(nil),        // 3: lession.v1.ClassroomDraft
+	(*AssignmentProgress)(nil),    // 4: lession.v1.AssignmentProgress
+	(*StudentProgress)(nil),       // 5: lession.v1.StudentProgress
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+}
+var file_lession_v1_classroom_proto_depIdxs = []int32{
+	2, // 0: lession.v1.Classroom.assignments:type_name -> lession.v1.ClassroomAssignment
+	6, // 1: lession.v1.Classroom.created_at:type_name -> google.protobuf.Timestamp
+	6, // 2: lession.v1.Classroom.updated_at:type_name -> google.protobuf.Timestamp
+	6, // 3: lession.v1.ClassroomAssignment.due_at:type_name -> google.protobuf.Timestamp
+	6, // 4: lession.v1.ClassroomAssignment.created_at:type_name -> google.protobuf.Timestamp
+	6, // 5: lession.v1.AssignmentProgress.due_at:type_name -> google.protobuf.Timestamp
+	0, // 6: lession.v1.AssignmentProgress.status:type_name -> lession.v1.AssignmentStatus
+	6, // 7: lession.v1.AssignmentProgress.completed_at:type_name -> google.protobuf.Timestamp
+	4, // 8: lession.v1.StudentProgress.assignments:type_name -> lession.v1.AssignmentProgress
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_classroom_proto_init() }
+func file_lession_v1_classroom_proto_init() {
+	if File_lession_v1_classroom_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_classroom_proto_rawDesc), len(file_lession_v1_classroom_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_classroom_proto_goTypes,
+		DependencyIndexes: file_lession_v1_classroom_proto_depIdxs,
+		EnumInfos:         file_lession_v1_classroom_proto_enumTypes,
+		MessageInfos:      file_lession_v1_classroom_proto_msgTypes,
+	}.Build()
+	File_lession_v1_classroom_proto = out.File
+	file_lession_v1_classroom_proto_goTypes = nil
+	file_lession_v1_classroom_proto_depIdxs = nil
+}
@@ -0,0 +1,1231 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/classroom_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CreateClassroomRequest supplies attributes for a new classroom.
+type CreateClassroomRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// teacher_id identifies the teacher creating the classroom.
+	TeacherId string `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	// classroom contains the initial attributes and learners.
+	Classroom     *ClassroomDraft `protobuf:"bytes,2,opt,name=classroom,proto3" json:"classroom,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClassroomRequest) Reset() {
+	*x = CreateClassroomRequest{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClassroomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClassroomRequest) ProtoMessage() {}
+
+func (x *CreateClassroomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClassroomRequest.ProtoReflect.Descriptor instead.
+func (*CreateClassroomRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateClassroomRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *CreateClassroomRequest) GetClassroom() *ClassroomDraft {
+	if x != nil {
+		return x.Classroom
+	}
+	return nil
+}
+
+// CreateClassroomResponse returns the newly created classroom.
+type CreateClassroomResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// classroom is the persisted classroom.
+	Classroom     *Classroom `protobuf:"bytes,1,opt,name=classroom,proto3" json:"classroom,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClassroomResponse) Reset() {
+	*x = CreateClassroomResponse{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClassroomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClassroomResponse) ProtoMessage() {}
+
+func (x *CreateClassroomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClassroomResponse.ProtoReflect.Descriptor instead.
+func (*CreateClassroomResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateClassroomResponse) GetClassroom() *Classroom {
+	if x != nil {
+		return x.Classroom
+	}
+	return nil
+}
+
+// GetClassroomRequest identifies the classroom to retrieve.
+type GetClassroomRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// classroom_id references the target classroom.
+	ClassroomId   string `protobuf:"bytes,1,opt,name=classroom_id,json=classroomId,proto3" json:"classroom_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassroomRequest) Reset() {
+	*x = GetClassroomRequest{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassroomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassroomRequest) ProtoMessage() {}
+
+func (x *GetClassroomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassroomRequest.ProtoReflect.Descriptor instead.
+func (*GetClassroomRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetClassroomRequest) GetClassroomId() string {
+	if x != nil {
+		return x.ClassroomId
+	}
+	return ""
+}
+
+// GetClassroomResponse returns a single classroom.
+type GetClassroomResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// classroom is the requested resource.
+	Classroom     *Classroom `protobuf:"bytes,1,opt,name=classroom,proto3" json:"classroom,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassroomResponse) Reset() {
+	*x = GetClassroomResponse{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassroomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassroomResponse) ProtoMessage() {}
+
+func (x *GetClassroomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassroomResponse.ProtoReflect.Descriptor instead.
+func (*GetClassroomResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetClassroomResponse) GetClassroom() *Classroom {
+	if x != nil {
+		return x.Classroom
+	}
+	return nil
+}
+
+// ListClassroomsRequest carries filters for listing classrooms.
+type ListClassroomsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_size limits the number of returned classrooms.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token continues a prior ListClassrooms response.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// teacher_id restricts classrooms to a single teacher.
+	TeacherId     string `protobuf:"bytes,3,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClassroomsRequest) Reset() {
+	*x = ListClassroomsRequest{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClassroomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClassroomsRequest) ProtoMessage() {}
+
+func (x *ListClassroomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClassroomsRequest.ProtoReflect.Descriptor instead.
+func (*ListClassroomsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListClassroomsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListClassroomsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListClassroomsRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+// ListClassroomsResponse returns a page of classrooms.
+type ListClassroomsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// classrooms contains the matching classrooms.
+	Classrooms []*Classroom `protobuf:"bytes,1,rep,name=classrooms,proto3" json:"classrooms,omitempty"`
+	// next_page_token is supplied when more data is available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClassroomsResponse) Reset() {
+	*x = ListClassroomsResponse{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClassroomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClassroomsResponse) ProtoMessage() {}
+
+func (x *ListClassroomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClassroomsResponse.ProtoReflect.Descriptor instead.
+func (*ListClassroomsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListClassroomsResponse) GetClassrooms() []*Classroom {
+	if x != nil {
+		return x.Classrooms
+	}
+	return nil
+}
+
+func (x *ListClassroomsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// UpdateClassroomRequest applies a partial update to a classroom.
+type UpdateClassroomRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// classroom_id references the target classroom.
+	ClassroomId string `protobuf:"bytes,1,opt,name=classroom_id,json=classroomId,proto3" json:"classroom_id,omitempty"`
+	// classroom contains the fields to update; learner_ids is ignored, use the learner RPCs instead.
+	Classroom *ClassroomDraft `protobuf:"bytes,2,opt,name=classroom,proto3" json:"classroom,omitempty"`
+	// update_mask indicates which fields in classroom should be applied.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateClassroomRequest) Reset() {
+	*x = UpdateClassroomRequest{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateClassroomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClassroomRequest) ProtoMessage() {}
+
+func (x *UpdateClassroomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClassroomRequest.ProtoReflect.Descriptor instead.
+func (*UpdateClassroomRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateClassroomRequest) GetClassroomId() string {
+	if x != nil {
+		return x.ClassroomId
+	}
+	return ""
+}
+
+func (x *UpdateClassroomRequest) GetClassroom() *ClassroomDraft {
+	if x != nil {
+		return x.Classroom
+	}
+	return nil
+}
+
+func (x *UpdateClassroomRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateClassroomResponse returns the updated classroom.
+type UpdateClassroomResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// classroom is the persisted classroom after the update.
+	Classroom     *Classroom `protobuf:"bytes,1,opt,name=classroom,proto3" json:"classroom,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateClassroomResponse) Reset() {
+	*x = UpdateClassroomResponse{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateClassroomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClassroomResponse) ProtoMessage() {}
+
+func (x *UpdateClassroomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClassroomResponse.ProtoReflect.Descriptor instead.
+func (*UpdateClassroomResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateClassroomResponse) GetClassroom() *Classroom {
+	if x != nil {
+		return x.Classroom
+	}
+	return nil
+}
+
+// DeleteClassroomRequest identifies the classroom to remove.
+type DeleteClassroomRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// classroom_id references the target classroom.
+	ClassroomId   string `protobuf:"bytes,1,opt,name=classroom_id,json=classroomId,proto3" json:"classroom_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClassroomRequest) Reset() {
+	*x = DeleteClassroomRequest{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClassroomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClassroomRequest) ProtoMessage() {}
+
+func (x *DeleteClassroomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClassroomRequest.ProtoReflect.Descriptor instead.
+func (*DeleteClassroomRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteClassroomRequest) GetClassroomId() string {
+	if x != nil {
+		return x.ClassroomId
+	}
+	return ""
+}
+
+// DeleteClassroomResponse acknowledges the removal.
+type DeleteClassroomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClassroomResponse) Reset() {
+	*x = DeleteClassroomResponse{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClassroomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClassroomResponse) ProtoMessage() {}
+
+func (x *DeleteClassroomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClassroomResponse.ProtoReflect.Descriptor instead.
+func (*DeleteClassroomResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{9}
+}
+
+// AddClassroomLearnersRequest enrols learners in a classroom.
+type AddClassroomLearnersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// classroom_id references the target classroom.
+	ClassroomId string `protobuf:"bytes,1,opt,name=classroom_id,json=classroomId,proto3" json:"classroom_id,omitempty"`
+	// learner_ids lists the learners to enrol; learners already enrolled are skipped.
+	LearnerIds    []string `protobuf:"bytes,2,rep,name=learner_ids,json=learnerIds,proto3" json:"learner_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddClassroomLearnersRequest) Reset() {
+	*x = AddClassroomLearnersRequest{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddClassroomLearnersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddClassroomLearnersRequest) ProtoMessage() {}
+
+func (x *AddClassroomLearnersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddClassroomLearnersRequest.ProtoReflect.Descriptor instead.
+func (*AddClassroomLearnersRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddClassroomLearnersRequest) GetClassroomId() string {
+	if x != nil {
+		return x.ClassroomId
+	}
+	return ""
+}
+
+func (x *AddClassroomLearnersRequest) GetLearnerIds() []string {
+	if x != nil {
+		return x.LearnerIds
+	}
+	return nil
+}
+
+// AddClassroomLearnersResponse returns the updated classroom.
+type AddClassroomLearnersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// classroom is the classroom after the learners were enrolled.
+	Classroom     *Classroom `protobuf:"bytes,1,opt,name=classroom,proto3" json:"classroom,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddClassroomLearnersResponse) Reset() {
+	*x = AddClassroomLearnersResponse{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddClassroomLearnersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddClassroomLearnersResponse) ProtoMessage() {}
+
+func (x *AddClassroomLearnersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddClassroomLearnersResponse.ProtoReflect.Descriptor instead.
+func (*AddClassroomLearnersResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddClassroomLearnersResponse) GetClassroom() *Classroom {
+	if x != nil {
+		return x.Classroom
+	}
+	return nil
+}
+
+// RemoveClassroomLearnersRequest withdraws learners from a classroom.
+type RemoveClassroomLearnersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// classroom_id references the target classroom.
+	ClassroomId string `protobuf:"bytes,1,opt,name=classroom_id,json=classroomId,proto3" json:"classroom_id,omitempty"`
+	// learner_ids lists the learners to withdraw.
+	LearnerIds    []string `protobuf:"bytes,2,rep,name=learner_ids,json=learnerIds,proto3" json:"learner_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveClassroomLearnersRequest) Reset() {
+	*x = RemoveClassroomLearnersRequest{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveClassroomLearnersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveClassroomLearnersRequest) ProtoMessage() {}
+
+func (x *RemoveClassroomLearnersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveClassroomLearnersRequest.ProtoReflect.Descriptor instead.
+func (*RemoveClassroomLearnersRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RemoveClassroomLearnersRequest) GetClassroomId() string {
+	if x != nil {
+		return x.ClassroomId
+	}
+	return ""
+}
+
+func (x *RemoveClassroomLearnersRequest) GetLearnerIds() []string {
+	if x != nil {
+		return x.LearnerIds
+	}
+	return nil
+}
+
+// RemoveClassroomLearnersResponse returns the updated classroom.
+type RemoveClassroomLearnersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// classroom is the classroom after the learners were withdrawn.
+	Classroom     *Classroom `protobuf:"bytes,1,opt,name=classroom,proto3" json:"classroom,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveClassroomLearnersResponse) Reset() {
+	*x = RemoveClassroomLearnersResponse{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveClassroomLearnersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveClassroomLearnersResponse) ProtoMessage() {}
+
+func (x *RemoveClassroomLearnersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveClassroomLearnersResponse.ProtoReflect.Descriptor instead.
+func (*RemoveClassroomLearnersResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveClassroomLearnersResponse) GetClassroom() *Classroom {
+	if x != nil {
+		return x.Classroom
+	}
+	return nil
+}
+
+// AssignSeriesRequest assigns a series to a classroom.
+type AssignSeriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// classroom_id references the target classroom.
+	ClassroomId string `protobuf:"bytes,1,opt,name=classroom_id,json=classroomId,proto3" json:"classroom_id,omitempty"`
+	// series_id references the published series to assign.
+	SeriesId string `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// due_at is when learners should have finished the series.
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignSeriesRequest) Reset() {
+	*x = AssignSeriesRequest{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignSeriesRequest) ProtoMessage() {}
+
+func (x *AssignSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignSeriesRequest.ProtoReflect.Descriptor instead.
+func (*AssignSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AssignSeriesRequest) GetClassroomId() string {
+	if x != nil {
+		return x.ClassroomId
+	}
+	return ""
+}
+
+func (x *AssignSeriesRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *AssignSeriesRequest) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+// AssignSeriesResponse returns the new assignment.
+type AssignSeriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// assignment is the persisted assignment.
+	Assignment    *ClassroomAssignment `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignSeriesResponse) Reset() {
+	*x = AssignSeriesResponse{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignSeriesResponse) ProtoMessage() {}
+
+func (x *AssignSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignSeriesResponse.ProtoReflect.Descriptor instead.
+func (*AssignSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AssignSeriesResponse) GetAssignment() *ClassroomAssignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+// RemoveAssignmentRequest identifies the assignment to withdraw.
+type RemoveAssignmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// classroom_id references the classroom holding the assignment.
+	ClassroomId string `protobuf:"bytes,1,opt,name=classroom_id,json=classroomId,proto3" json:"classroom_id,omitempty"`
+	// assignment_id references the assignment to withdraw.
+	AssignmentId  string `protobuf:"bytes,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAssignmentRequest) Reset() {
+	*x = RemoveAssignmentRequest{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAssignmentRequest) ProtoMessage() {}
+
+func (x *RemoveAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RemoveAssignmentRequest) GetClassroomId() string {
+	if x != nil {
+		return x.ClassroomId
+	}
+	return ""
+}
+
+func (x *RemoveAssignmentRequest) GetAssignmentId() string {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return ""
+}
+
+// RemoveAssignmentResponse acknowledges the removal.
+type RemoveAssignmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAssignmentResponse) Reset() {
+	*x = RemoveAssignmentResponse{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAssignmentResponse) ProtoMessage() {}
+
+func (x *RemoveAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*RemoveAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{17}
+}
+
+// GetClassroomReportRequest identifies the classroom to report on.
+type GetClassroomReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// classroom_id references the target classroom.
+	ClassroomId   string `protobuf:"bytes,1,opt,name=classroom_id,json=classroomId,proto3" json:"classroom_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassroomReportRequest) Reset() {
+	*x = GetClassroomReportRequest{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassroomReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassroomReportRequest) ProtoMessage() {}
+
+func (x *GetClassroomReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassroomReportRequest.ProtoReflect.Descriptor instead.
+func (*GetClassroomReportRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetClassroomReportRequest) GetClassroomId() string {
+	if x != nil {
+		return x.ClassroomId
+	}
+	return ""
+}
+
+// GetClassroomReportResponse returns per-student progress.
+type GetClassroomReportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// classroom is the classroom the report covers.
+	Classroom *Classroom `protobuf:"bytes,1,opt,name=classroom,proto3" json:"classroom,omitempty"`
+	// students reports each enrolled learner's progress, ordered by user_id.
+	Students []*StudentProgress `protobuf:"bytes,2,rep,name=students,proto3" json:"students,omitempty"`
+	// generated_at is the time against which overdue assignments were judged.
+	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassroomReportResponse) Reset() {
+	*x = GetClassroomReportResponse{}
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassroomReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassroomReportResponse) ProtoMessage() {}
+
+func (x *GetClassroomReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_classroom_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassroomReportResponse.ProtoReflect.Descriptor instead.
+func (*GetClassroomReportResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_classroom_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetClassroomReportResponse) GetClassroom() *Classroom {
+	if x != nil {
+		return x.Classroom
+	}
+	return nil
+}
+
+func (x *GetClassroomReportResponse) GetStudents() []*StudentProgress {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+func (x *GetClassroomReportResponse) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+var File_lession_v1_classroom_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_classroom_service_proto_rawDesc = "" +
+	"\n" +
+	"\"lession/v1/classroom_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1alession/v1/classroom.proto\"\x82\x01\n" +
+	"\x16CreateClassroomRequest\x12&\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tteacherId\x12@\n" +
+	"\tclassroom\x18\x02 \x01(\v2\x1a.lession.v1.ClassroomDraftB\x06\xbaH\x03\xc8\x01\x01R\tclassroom\"N\n" +
+	"\x17CreateClassroomResponse\x123\n" +
+	"\tclassroom\x18\x01 \x01(\v2\x15.lession.v1.ClassroomR\tclassroom\"B\n" +
+	"\x13GetClassroomRequest\x12+\n" +
+	"\fclassroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\vclassroomId\"K\n" +
+	"\x14GetClassroomResponse\x123\n" +
+	"\tclassroom\x18\x01 \x01(\v2\x15.lession.v1.ClassroomR\tclassroom\"{\n" +
+	"\x15ListClassroomsRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\rB\a\xbaH\x04*\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1d\n" +
+	"\n" +
+	"teacher_id\x18\x03 \x01(\tR\tteacherId\"w\n" +
+	"\x16ListClassroomsResponse\x125\n" +
+	"\n" +
+	"classrooms\x18\x01 \x03(\v2\x15.lession.v1.ClassroomR\n" +
+	"classrooms\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc4\x01\n" +
+	"\x16UpdateClassroomRequest\x12+\n" +
+	"\fclassroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\vclassroomId\x12@\n" +
+	"\tclassroom\x18\x02 \x01(\v2\x1a.lession.v1.ClassroomDraftB\x06\xbaH\x03\xc8\x01\x01R\tclassroom\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"N\n" +
+	"\x17UpdateClassroomResponse\x123\n" +
+	"\tclassroom\x18\x01 \x01(\v2\x15.lession.v1.ClassroomR\tclassroom\"E\n" +
+	"\x16DeleteClassroomRequest\x12+\n" +
+	"\fclassroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\vclassroomId\"\x19\n" +
+	"\x17DeleteClassroomResponse\"~\n" +
+	"\x1bAddClassroomLearnersRequest\x12+\n" +
+	"\fclassroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\vclassroomId\x122\n" +
+	"\vlearner_ids\x18\x02 \x03(\tB\x11\xbaH\x0e\x92\x01\v\b\x01\x10\xf4\x03\"\x04r\x02\x10\x01R\n" +
+	"learnerIds\"S\n" +
+	"\x1cAddClassroomLearnersResponse\x123\n" +
+	"\tclassroom\x18\x01 \x01(\v2\x15.lession.v1.ClassroomR\tclassroom\"\x81\x01\n" +
+	"\x1eRemoveClassroomLearnersRequest\x12+\n" +
+	"\fclassroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\vclassroomId\x122\n" +
+	"\vlearner_ids\x18\x02 \x03(\tB\x11\xbaH\x0e\x92\x01\v\b\x01\x10\xf4\x03\"\x04r\x02\x10\x01R\n" +
+	"learnerIds\"V\n" +
+	"\x1fRemoveClassroomLearnersResponse\x123\n" +
+	"\tclassroom\x18\x01 \x01(\v2\x15.lession.v1.ClassroomR\tclassroom\"\xa4\x01\n" +
+	"\x13AssignSeriesRequest\x12+\n" +
+	"\fclassroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\vclassroomId\x12%\n" +
+	"\tseries_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bseriesId\x129\n" +
+	"\x06due_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x05dueAt\"W\n" +
+	"\x14AssignSeriesResponse\x12?\n" +
+	"\n" +
+	"assignment\x18\x01 \x01(\v2\x1f.lession.v1.ClassroomAssignmentR\n" +
+	"assignment\"u\n" +
+	"\x17RemoveAssignmentRequest\x12+\n" +
+	"\fclassroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\vclassroomId\x12-\n" +
+	"\rassignment_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fassignmentId\"\x1a\n" +
+	"\x18RemoveAssignmentResponse\"H\n" +
+	"\x19GetClassroomReportRequest\x12+\n" +
+	"\fclassroom_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\vclassroomId\"\xc9\x01\n" +
+	"\x1aGetClassroomReportResponse\x123\n" +
+	"\tclassroom\x18\x01 \x01(\v2\x15.lession.v1.ClassroomR\tclassroom\x127\n" +
+	"\bstudents\x18\x02 \x03(\v2\x1b.lession.v1.StudentProgressR\bstudents\x12=\n" +
+	"\fgenerated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt2\xc8\a\n" +
+	"\x10ClassroomService\x12Z\n" +
+	"\x0fCreateClassroom\x12\".lession.v1.CreateClassroomRequest\x1a#.lession.v1.CreateClassroomResponse\x12Q\n" +
+	"\fGetClassroom\x12\x1f.lession.v1.GetClassroomRequest\x1a .lession.v1.GetClassroomResponse\x12W\n" +
+	"\x0eListClassrooms\x12!.lession.v1.ListClassroomsRequest\x1a\".lession.v1.ListClassroomsResponse\x12Z\n" +
+	"\x0fUpdateClassroom\x12\".lession.v1.UpdateClassroomRequest\x1a#.lession.v1.UpdateClassroomResponse\x12Z\n" +
+	"\x0fDeleteClassroom\x12\".lession.v1.DeleteClassroomRequest\x1a#.lession.v1.DeleteClassroomResponse\x12i\n" +
+	"\x14AddClassroomLearners\x12'.lession.v1.AddClassroomLearnersRequest\x1a(.lession.v1.AddClassroomLearnersResponse\x12r\n" +
+	"\x17RemoveClassroomLearners\x12*.lession.v1.RemoveClassroomLearnersRequest\x1a+.lession.v1.RemoveClassroomLearnersResponse\x12Q\n" +
+	"\fAssignSeries\x12\x1f.lession.v1.AssignSeriesRequest\x1a .lession.v1.AssignSeriesResponse\x12]\n" +
+	"\x10RemoveAssignment\x12#.lession.v1.RemoveAssignmentRequest\x1a$.lession.v1.RemoveAssignmentResponse\x12c\n" +
+	"\x12GetClassroomReport\x12%.lession.v1.GetClassroomReportRequest\x1a&.lession.v1.GetClassroomReportResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_classroom_service_proto_rawDescOnce sync.Once
+	file_lession_v1_classroom_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_classroom_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_classroom_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_classroom_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_classroom_service_proto_rawDesc), len(file_lession_v1_classroom_service_proto_rawDesc)))
+	})
+	return file_lession_v1_classroom_service_proto_rawDescData
+}
+
+var file_lession_v1_classroom_service_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_lession_v1_classroom_service_proto_goTypes = []any{
+	(*CreateClassroomRequest)(nil),          // 0: lession.v1.CreateClassroomRequest
+	(*CreateClassroomResponse)(nil),         // 1: lession.v1.CreateClassroomResponse
+	(*GetClassroomRequest)(nil),             // 2: lession.v1.GetClassroomRequest
+	(*GetClassroomResponse)(nil),            // 3: lession.v1.GetClassroomResponse
+	(*ListClassroomsRequest)(nil),           // 4: lession.v1.ListClassroomsRequest
+	(*ListClassroomsResponse)(nil),          // 5: lession.v1.ListClassroomsResponse
+	(*UpdateClassroomRequest)(nil),          // 6: lession.v1.UpdateClassroomRequest
+	(*UpdateClassroomResponse)(nil),         // 7: lession.v1.UpdateClassroomResponse
+	(*DeleteClassroomRequest)(nil),          // 8: lession.v1.DeleteClassroomRequest
+	(*DeleteClassroomResponse)(nil),         // 9: lession.v1.DeleteClassroomResponse
+	(*AddClassroomLearnersRequest)(nil),     // 10: lession.v1.AddClassroomLearnersRequest
+	(*AddClassroomLearnersResponse)(nil),    // 11: lession.v1.AddClassroomLearnersResponse
+	(*RemoveClassroomLearnersRequest)(nil),  // 12: lession.v1.RemoveClassroomLearnersRequest
+	(*RemoveClassroomLearnersResponse)(nil), // 13: lession.v1.RemoveClassroomLearnersResponse
+	(*AssignSeriesRequest)(nil),             // 14: lession.v1.AssignSeriesRequest
+	(*AssignSeriesResponse)(nil),            // 15: lession.v1.AssignSeriesResponse
+	(*RemoveAssignmentRequest)(nil),         // 16: lession.v1.RemoveAssignmentRequest
+	(*RemoveAssignmentResponse)(nil),        // 17: lession.v1.RemoveAssignmentResponse
+	(*GetClassroomReportRequest)(nil),       // 18: lession.v1.GetClassroomReportRequest
+	(*GetClassroomReportResponse)(nil),      // 19: lession.v1.GetClassroomReportResponse
+	(*ClassroomDraft)(nil),                  // 20: lession.v1.ClassroomDraft
+	(*Classroom)(nil),                       // 21: lession.v1.Classroom
+	(*fieldmaskpb.FieldMask)(nil),           // 22: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),           // 23: google.protobuf.Timestamp
+	(*ClassroomAssignment)(nil),             // 24: lession.v1.ClassroomAssignment
+	(*StudentProgress)(nil),                 // 25: lession.v1.StudentProgress
+}
+var file_lession_v1_classroom_service_proto_depIdxs = []int32{
+	20, // 0: lession.v1.CreateClassroomRequest.classroom:type_name -> lession.v1.ClassroomDraft
+	21, // 1: lession.v1.CreateClassroomResponse.classroom:type_name -> lession.v1.Classroom
+	21, // 2: lession.v1.GetClassroomResponse.classroom:type_name -> lession.v1.Classroom
+	21, // 3: lession.v1.ListClassroomsResponse.classrooms:type_name -> lession.v1.Classroom
+	20, // 4: lession.v1.UpdateClassroomRequest.classroom:type_name -> lession.v1.ClassroomDraft
+	22, // 5: lession.v1.UpdateClassroomRequest.update_mask:type_name -> google.protobuf.FieldMask
+	21, // 6: lession.v1.UpdateClassroomResponse.classroom:type_name -> lession.v1.Classroom
+	21, // 7: lession.v1.AddClassroomLearnersResponse.classroom:type_name -> lession.v1.Classroom
+	21, // 8: lession.v1.RemoveClassroomLearnersResponse.classroom:type_name -> lession.v1.Classroom
+	23, // 9: lession.v1.AssignSeriesRequest.due_at:type_name -> google.protobuf.Timestamp
+	24, // 10: lession.v1.AssignSeriesResponse.assignment:type_name -> lession.v1.ClassroomAssignment
+	21, // 11: lession.v1.GetClassroomReportResponse.classroom:type_name -> lession.v1.Classroom
+	25, // 12: lession.v1.GetClassroomReportResponse.students:type_name -> lession.v1.StudentProgress
+	23, // 13: lession.v1.GetClassroomReportResponse.generated_at:type_name -> google.protobuf.Timestamp
+	0,  // 14: lession.v1.ClassroomService.CreateClassroom:input_type -> lession.v1.CreateClassroomRequest
+	2,  // 15: lession.v1.ClassroomService.GetClassroom:input_type -> lession.v1.GetClassroomRequest
+	4,  // 16: lession.v1.ClassroomService.ListClassrooms:input_type -> lession.v1.ListClassroomsRequest
+	6,  // 17: lession.v1.ClassroomService.UpdateClassroom:input_type -> lession.v1.UpdateClassroomRequest
+	8,  // 18: lession.v1.ClassroomService.DeleteClassroom:input_type -> lession.v1.DeleteClassroomRequest
+	10, // 19: lession.v1.ClassroomService.AddClassroomLearners:input_type -> lession.v1.AddClassroomLearnersRequest
+	12, // 20: lession.v1.ClassroomService.RemoveClassroomLearners:input_type -> lession.v1.RemoveClassroomLearnersRequest
+	14, // 21: lession.v1.ClassroomService.AssignSeries:input_type -> lession.v1.AssignSeriesRequest
+	16, // 22: lession.v1.ClassroomService.RemoveAssignment:input_type -> lession.v1.RemoveAssignmentRequest
+	18, // 23: lession.v1.ClassroomService.GetClassroomReport:input_type -> lession.v1.GetClassroomReportRequest
+	1,  // 24: lession.v1.ClassroomService.CreateClassroom:output_type -> lession.v1.CreateClassroomResponse
+	3,  // 25: lession.v1.ClassroomService.GetClassroom:output_type -> lession.v1.GetClassroomResponse
+	5,  // 26: lession.v1.ClassroomService.ListClassrooms:output_type -> lession.v1.ListClassroomsResponse
+	7,  // 27: lession.v1.ClassroomService.UpdateClassroom:output_type -> lession.v1.UpdateClassroomResponse
+	9,  // 28: lession.v1.ClassroomService.DeleteClassroom:output_type -> lession.v1.DeleteClassroomResponse
+	11, // 29: lession.v1.ClassroomService.AddClassroomLearners:output_type -> lession.v1.AddClassroomLearnersResponse
+	13, // 30: lession.v1.ClassroomService.RemoveClassroomLearners:output_type -> lession.v1.RemoveClassroomLearnersResponse
+	15, // 31: lession.v1.ClassroomService.AssignSeries:output_type -> lession.v1.AssignSeriesResponse
+	17, // 32: lession.v1.ClassroomService.RemoveAssignment:output_type -> lession.v1.RemoveAssignmentResponse
+	19, // 33: lession.v1.ClassroomService.GetClassroomReport:output_type -> lession.v1.GetClassroomReportResponse
+	24, // [24:34] is the sub-list for method output_type
+	14, // [14:24] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_classroom_service_proto_init() }
+func file_lession_v1_classroom_service_proto_init() {
+	if File_lession_v1_classroom_service_proto != nil {
+		return
+	}
+	file_lession_v1_classroom_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_classroom_service_proto_rawDesc), len(file_lession_v1_classroom_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_classroom_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_classroom_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_classroom_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_classroom_service_proto = out.File
+	file_lession_v1_classroom_service_proto_goTypes = nil
+	file_lession_v1_classroom_service_proto_depIdxs = nil
+}
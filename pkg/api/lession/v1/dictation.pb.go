@@ -0,0 +1,442 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/dictation.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DictationTokenOp classifies a feedback word.
+type DictationTokenOp int32
+
+const (
+	// DICTATION_TOKEN_OP_UNSPECIFIED is the default zero value.
+	DictationTokenOp_DICTATION_TOKEN_OP_UNSPECIFIED DictationTokenOp = 0
+	// DICTATION_TOKEN_OP_MATCH indicates the learner typed the word correctly.
+	DictationTokenOp_DICTATION_TOKEN_OP_MATCH DictationTokenOp = 1
+	// DICTATION_TOKEN_OP_MISSING indicates the learner omitted or misspelled the word.
+	DictationTokenOp_DICTATION_TOKEN_OP_MISSING DictationTokenOp = 2
+	// DICTATION_TOKEN_OP_EXTRA indicates the learner typed a word not in the transcript.
+	DictationTokenOp_DICTATION_TOKEN_OP_EXTRA DictationTokenOp = 3
+)
+
+// Enum value maps for DictationTokenOp.
+var (
+	DictationTokenOp_name = map[int32]string{
+		0: "DICTATION_TOKEN_OP_UNSPECIFIED",
+		1: "DICTATION_TOKEN_OP_MATCH",
+		2: "DICTATION_TOKEN_OP_MISSING",
+		3: "DICTATION_TOKEN_OP_EXTRA",
+	}
+	DictationTokenOp_value = map[string]int32{
+		"DICTATION_TOKEN_OP_UNSPECIFIED": 0,
+		"DICTATION_TOKEN_OP_MATCH":       1,
+		"DICTATION_TOKEN_OP_MISSING":     2,
+		"DICTATION_TOKEN_OP_EXTRA":       3,
+	}
+)
+
+func (x DictationTokenOp) Enum() *DictationTokenOp {
+	p := new(DictationTokenOp)
+	*p = x
+	return p
+}
+
+func (x DictationTokenOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DictationTokenOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_dictation_proto_enumTypes[0].Descriptor()
+}
+
+func (DictationTokenOp) Type() protoreflect.EnumType {
+	return &file_lession_v1_dictation_proto_enumTypes[0]
+}
+
+func (x DictationTokenOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DictationTokenOp.Descriptor instead.
+func (DictationTokenOp) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_dictation_proto_rawDescGZIP(), []int{0}
+}
+
+// DictationItem references a timed transcript segment the learner should transcribe.
+type DictationItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// episode_id identifies the episode the segment belongs to.
+	EpisodeId string `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// index is the zero-based position of the segment within the transcript.
+	Index int32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// start is the segment offset from the beginning of the media.
+	Start *durationpb.Duration `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	// end is the offset at which the segment finishes.
+	End *durationpb.Duration `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	// audio_url points at the segment using a media fragment on the playback URL.
+	AudioUrl string `protobuf:"bytes,5,opt,name=audio_url,json=audioUrl,proto3" json:"audio_url,omitempty"`
+	// word_count hints how many words the learner should expect to type.
+	WordCount     int32 `protobuf:"varint,6,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DictationItem) Reset() {
+	*x = DictationItem{}
+	mi := &file_lession_v1_dictation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DictationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictationItem) ProtoMessage() {}
+
+func (x *DictationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_dictation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictationItem.ProtoReflect.Descriptor instead.
+func (*DictationItem) Descriptor() ([]byte, []int) {
+	return file_lession_v1_dictation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DictationItem) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *DictationItem) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *DictationItem) GetStart() *durationpb.Duration {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *DictationItem) GetEnd() *durationpb.Duration {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *DictationItem) GetAudioUrl() string {
+	if x != nil {
+		return x.AudioUrl
+	}
+	return ""
+}
+
+func (x *DictationItem) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+// DictationToken is a single word of diff-based feedback.
+type DictationToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// text is the word as it appears in the transcript or the answer.
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// op classifies how the word compares to the transcript.
+	Op            DictationTokenOp `protobuf:"varint,2,opt,name=op,proto3,enum=lession.v1.DictationTokenOp" json:"op,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DictationToken) Reset() {
+	*x = DictationToken{}
+	mi := &file_lession_v1_dictation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DictationToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictationToken) ProtoMessage() {}
+
+func (x *DictationToken) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_dictation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictationToken.ProtoReflect.Descriptor instead.
+func (*DictationToken) Descriptor() ([]byte, []int) {
+	return file_lession_v1_dictation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DictationToken) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *DictationToken) GetOp() DictationTokenOp {
+	if x != nil {
+		return x.Op
+	}
+	return DictationTokenOp_DICTATION_TOKEN_OP_UNSPECIFIED
+}
+
+// DictationAttempt records a learner's answer for a dictation item.
+type DictationAttempt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the server-assigned identifier for the attempt.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// user_id identifies the learner who submitted the answer.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// episode_id identifies the episode the item belongs to.
+	EpisodeId string `protobuf:"bytes,3,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// item_index identifies the dictation item within the episode.
+	ItemIndex int32 `protobuf:"varint,4,opt,name=item_index,json=itemIndex,proto3" json:"item_index,omitempty"`
+	// answer is the text typed by the learner.
+	Answer string `protobuf:"bytes,5,opt,name=answer,proto3" json:"answer,omitempty"`
+	// expected is the transcript text for the item.
+	Expected string `protobuf:"bytes,6,opt,name=expected,proto3" json:"expected,omitempty"`
+	// score is the share of expected words reproduced, between 0 and 1.
+	Score float64 `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"`
+	// feedback aligns the answer against the transcript word by word.
+	Feedback []*DictationToken `protobuf:"bytes,8,rep,name=feedback,proto3" json:"feedback,omitempty"`
+	// created_at records when the attempt was submitted.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DictationAttempt) Reset() {
+	*x = DictationAttempt{}
+	mi := &file_lession_v1_dictation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DictationAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictationAttempt) ProtoMessage() {}
+
+func (x *DictationAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_dictation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictationAttempt.ProtoReflect.Descriptor instead.
+func (*DictationAttempt) Descriptor() ([]byte, []int) {
+	return file_lession_v1_dictation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DictationAttempt) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DictationAttempt) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DictationAttempt) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *DictationAttempt) GetItemIndex() int32 {
+	if x != nil {
+		return x.ItemIndex
+	}
+	return 0
+}
+
+func (x *DictationAttempt) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *DictationAttempt) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+func (x *DictationAttempt) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *DictationAttempt) GetFeedback() []*DictationToken {
+	if x != nil {
+		return x.Feedback
+	}
+	return nil
+}
+
+func (x *DictationAttempt) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_lession_v1_dictation_proto protoreflect.FileDescriptor
+
+const file_lession_v1_dictation_proto_rawDesc = "" +
+	"\n" +
+	"\x1alession/v1/dictation.proto\x12\n" +
+	"lession.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xde\x01\n" +
+	"\rDictationItem\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\tepisodeId\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x05R\x05index\x12/\n" +
+	"\x05start\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x05start\x12+\n" +
+	"\x03end\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x03end\x12\x1b\n" +
+	"\taudio_url\x18\x05 \x01(\tR\baudioUrl\x12\x1d\n" +
+	"\n" +
+	"word_count\x18\x06 \x01(\x05R\twordCount\"R\n" +
+	"\x0eDictationToken\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12,\n" +
+	"\x02op\x18\x02 \x01(\x0e2\x1c.lession.v1.DictationTokenOpR\x02op\"\xb6\x02\n" +
+	"\x10DictationAttempt\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x03 \x01(\tR\tepisodeId\x12\x1d\n" +
+	"\n" +
+	"item_index\x18\x04 \x01(\x05R\titemIndex\x12\x16\n" +
+	"\x06answer\x18\x05 \x01(\tR\x06answer\x12\x1a\n" +
+	"\bexpected\x18\x06 \x01(\tR\bexpected\x12\x14\n" +
+	"\x05score\x18\a \x01(\x01R\x05score\x126\n" +
+	"\bfeedback\x18\b \x03(\v2\x1a.lession.v1.DictationTokenR\bfeedback\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt*\x92\x01\n" +
+	"\x10DictationTokenOp\x12\"\n" +
+	"\x1eDICTATION_TOKEN_OP_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18DICTATION_TOKEN_OP_MATCH\x10\x01\x12\x1e\n" +
+	"\x1aDICTATION_TOKEN_OP_MISSING\x10\x02\x12\x1c\n" +
+	"\x18DICTATION_TOKEN_OP_EXTRA\x10\x03B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_dictation_proto_rawDescOnce sync.Once
+	file_lession_v1_dictation_proto_rawDescData []byte
+)
+
+func file_lession_v1_dictation_proto_rawDescGZIP() []byte {
+	file_lession_v1_dictation_proto_rawDescOnce.Do(func() {
+		file_lession_v1_dictation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_dictation_proto_rawDesc), len(file_lession_v1_dictation_proto_rawDesc)))
+	})
+	return file_lession_v1_dictation_proto_rawDescData
+}
+
+var file_lession_v1_dictation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lession_v1_dictation_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_lession_v1_dictation_proto_goTypes = []any{
+	(DictationTokenOp)(0),         // 0: lession.v1.DictationTokenOp
+	(*DictationItem)(nil),         // 1: lession.v1.DictationItem
+	(*DictationToken)(nil),        // 2: lession.v1.DictationToken
+	(*DictationAttempt)(nil),      // 3: lession.v1.DictationAttempt
+	(*durationpb.Duration)(nil),   // 4: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_lession_v1_dictation_proto_depIdxs = []int32{
+	4, // 0: lession.v1.DictationItem.start:type_name -> google.protobuf.Duration
+	4, // 1: lession.v1.DictationItem.end:type_name -> google.protobuf.Duration
+	0, // 2: lession.v1.DictationToken.op:type_name -> lession.v1.DictationTokenOp
+	2, // 3: lession.v1.DictationAttempt.feedback:type_name -> lession.v1.DictationToken
+	5, // 4: lession.v1.DictationAttempt.created_at:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_dictation_proto_init() }
+func file_lession_v1_dictation_proto_init() {
+	if File_lession_v1_dictation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_dictation_proto_rawDesc), len(file_lession_v1_dictation_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_dictation_proto_goTypes,
+		DependencyIndexes: file_lession_v1_dictation_proto_depIdxs,
+		EnumInfos:         file_lession_v1_dictation_proto_enumTypes,
+		MessageInfos:      file_lession_v1_dictation_proto_msgTypes,
+	}.Build()
+	File_lession_v1_dictation_proto = out.File
+	file_lession_v1_dictation_proto_goTypes = nil
+	file_lession_v1_dictation_proto_depIdxs = nil
+}
@@ -0,0 +1,462 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/dictation_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ListDictationItemsRequest selects the episode to practise.
+type ListDictationItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// episode_id identifies the episode whose transcript is used.
+	EpisodeId     string `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDictationItemsRequest) Reset() {
+	*x = ListDictationItemsRequest{}
+	mi := &file_lession_v1_dictation_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDictationItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDictationItemsRequest) ProtoMessage() {}
+
+func (x *ListDictationItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_dictation_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDictationItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListDictationItemsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_dictation_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListDictationItemsRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+// ListDictationItemsResponse returns the dictation items for the episode.
+type ListDictationItemsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// items lists one entry per timed transcript segment.
+	Items         []*DictationItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDictationItemsResponse) Reset() {
+	*x = ListDictationItemsResponse{}
+	mi := &file_lession_v1_dictation_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDictationItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDictationItemsResponse) ProtoMessage() {}
+
+func (x *ListDictationItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_dictation_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDictationItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListDictationItemsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_dictation_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListDictationItemsResponse) GetItems() []*DictationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// SubmitDictationAnswerRequest carries a learner's typed answer.
+type SubmitDictationAnswerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// episode_id identifies the episode the item belongs to.
+	EpisodeId string `protobuf:"bytes,2,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// item_index identifies the dictation item within the episode.
+	ItemIndex int32 `protobuf:"varint,3,opt,name=item_index,json=itemIndex,proto3" json:"item_index,omitempty"`
+	// answer is the text typed by the learner.
+	Answer        string `protobuf:"bytes,4,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitDictationAnswerRequest) Reset() {
+	*x = SubmitDictationAnswerRequest{}
+	mi := &file_lession_v1_dictation_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitDictationAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDictationAnswerRequest) ProtoMessage() {}
+
+func (x *SubmitDictationAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_dictation_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDictationAnswerRequest.ProtoReflect.Descriptor instead.
+func (*SubmitDictationAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_dictation_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubmitDictationAnswerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubmitDictationAnswerRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *SubmitDictationAnswerRequest) GetItemIndex() int32 {
+	if x != nil {
+		return x.ItemIndex
+	}
+	return 0
+}
+
+func (x *SubmitDictationAnswerRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+// SubmitDictationAnswerResponse returns the scored attempt.
+type SubmitDictationAnswerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// attempt contains the score and word-level feedback.
+	Attempt       *DictationAttempt `protobuf:"bytes,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitDictationAnswerResponse) Reset() {
+	*x = SubmitDictationAnswerResponse{}
+	mi := &file_lession_v1_dictation_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitDictationAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitDictationAnswerResponse) ProtoMessage() {}
+
+func (x *SubmitDictationAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_dictation_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitDictationAnswerResponse.ProtoReflect.Descriptor instead.
+func (*SubmitDictationAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_dictation_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SubmitDictationAnswerResponse) GetAttempt() *DictationAttempt {
+	if x != nil {
+		return x.Attempt
+	}
+	return nil
+}
+
+// ListDictationAttemptsRequest filters recorded attempts.
+type ListDictationAttemptsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_size limits the number of returned attempts.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token continues a prior ListDictationAttempts response.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// user_id restricts attempts to a single learner.
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// episode_id restricts attempts to a single episode.
+	EpisodeId     string `protobuf:"bytes,4,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDictationAttemptsRequest) Reset() {
+	*x = ListDictationAttemptsRequest{}
+	mi := &file_lession_v1_dictation_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDictationAttemptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDictationAttemptsRequest) ProtoMessage() {}
+
+func (x *ListDictationAttemptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_dictation_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDictationAttemptsRequest.ProtoReflect.Descriptor instead.
+func (*ListDictationAttemptsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_dictation_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListDictationAttemptsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDictationAttemptsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDictationAttemptsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListDictationAttemptsRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+// ListDictationAttemptsResponse returns a page of attempts.
+type ListDictationAttemptsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// attempts contains the recorded attempts, newest first.
+	Attempts []*DictationAttempt `protobuf:"bytes,1,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	// next_page_token is supplied when more data is available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDictationAttemptsResponse) Reset() {
+	*x = ListDictationAttemptsResponse{}
+	mi := &file_lession_v1_dictation_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDictationAttemptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDictationAttemptsResponse) ProtoMessage() {}
+
+func (x *ListDictationAttemptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_dictation_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDictationAttemptsResponse.ProtoReflect.Descriptor instead.
+func (*ListDictationAttemptsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_dictation_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListDictationAttemptsResponse) GetAttempts() []*DictationAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+func (x *ListDictationAttemptsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_lession_v1_dictation_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_dictation_service_proto_rawDesc = "" +
+	"\n" +
+	"\"lession/v1/dictation_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1alession/v1/dictation.proto\"D\n" +
+	"\x19ListDictationItemsRequest\x12'\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tepisodeId\"M\n" +
+	"\x1aListDictationItemsResponse\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.lession.v1.DictationItemR\x05items\"\xb3\x01\n" +
+	"\x1cSubmitDictationAnswerRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\x12'\n" +
+	"\n" +
+	"episode_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tepisodeId\x12&\n" +
+	"\n" +
+	"item_index\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\titemIndex\x12 \n" +
+	"\x06answer\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\x06answer\"W\n" +
+	"\x1dSubmitDictationAnswerResponse\x126\n" +
+	"\aattempt\x18\x01 \x01(\v2\x1c.lession.v1.DictationAttemptR\aattempt\"\xa8\x01\n" +
+	"\x1cListDictationAttemptsRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\rB\a\xbaH\x04*\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12*\n" +
+	"\n" +
+	"episode_id\x18\x04 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\tepisodeId\"\x81\x01\n" +
+	"\x1dListDictationAttemptsResponse\x128\n" +
+	"\battempts\x18\x01 \x03(\v2\x1c.lession.v1.DictationAttemptR\battempts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\xd3\x02\n" +
+	"\x10DictationService\x12c\n" +
+	"\x12ListDictationItems\x12%.lession.v1.ListDictationItemsRequest\x1a&.lession.v1.ListDictationItemsResponse\x12l\n" +
+	"\x15SubmitDictationAnswer\x12(.lession.v1.SubmitDictationAnswerRequest\x1a).lession.v1.SubmitDictationAnswerResponse\x12l\n" +
+	"\x15ListDictationAttempts\x12(.lession.v1.ListDictationAttemptsRequest\x1a).lession.v1.ListDictationAttemptsResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_dictation_service_proto_rawDescOnce sync.Once
+	file_lession_v1_dictation_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_dictation_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_dictation_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_dictation_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_dictation_service_proto_rawDesc), len(file_lession_v1_dictation_service_proto_rawDesc)))
+	})
+	return file_lession_v1_dictation_service_proto_rawDescData
+}
+
+var file_lession_v1_dictation_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_lession_v1_dictation_service_proto_goTypes = []any{
+	(*ListDictationItemsRequest)(nil),     // 0: lession.v1.ListDictationItemsRequest
+	(*ListDictationItemsResponse)(nil),    // 1: lession.v1.ListDictationItemsResponse
+	(*SubmitDictationAnswerRequest)(nil),  // 2: lession.v1.SubmitDictationAnswerRequest
+	(*SubmitDictationAnswerResponse)(nil), // 3: lession.v1.SubmitDictationAnswerResponse
+	(*ListDictationAttemptsRequest)(nil),  // 4: lession.v1.ListDictationAttemptsRequest
+	(*ListDictationAttemptsResponse)(nil), // 5: lession.v1.ListDictationAttemptsResponse
+	(*DictationItem)(nil),                 // 6: lession.v1.DictationItem
+	(*DictationAttempt)(nil),              // 7: lession.v1.DictationAttempt
+}
+var file_lession_v1_dictation_service_proto_depIdxs = []int32{
+	6, // 0: lession.v1.ListDictationItemsResponse.items:type_name -> lession.v1.DictationItem
+	7, // 1: lession.v1.SubmitDictationAnswerResponse.attempt:type_name -> lession.v1.DictationAttempt
+	7, // 2: lession.v1.ListDictationAttemptsResponse.attempts:type_name -> lession.v1.DictationAttempt
+	0, // 3: lession.v1.DictationService.ListDictationItems:input_type -> lession.v1.ListDictationItemsRequest
+	2, // 4: lession.v1.DictationService.SubmitDictationAnswer:input_type -> lession.v1.SubmitDictationAnswerRequest
+	4, // 5: lession.v1.DictationService.ListDictationAttempts:input_type -> lession.v1.ListDictationAttemptsRequest
+	1, // 6: lession.v1.DictationService.ListDictationItems:output_type -> lession.v1.ListDictationItemsResponse
+	3, // 7: lession.v1.DictationService.SubmitDictationAnswer:output_type -> lession.v1.SubmitDictationAnswerResponse
+	5, // 8: lession.v1.DictationService.ListDictationAttempts:output_type -> lession.v1.ListDictationAttemptsResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_dictation_service_proto_init() }
+func file_lession_v1_dictation_service_proto_init() {
+	if File_lession_v1_dictation_service_proto != nil {
+		return
+	}
+	file_lession_v1_dictation_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_dictation_service_proto_rawDesc), len(file_lession_v1_dictation_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_dictation_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_dictation_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_dictation_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_dictation_service_proto = out.File
+	file_lession_v1_dictation_service_proto_goTypes = nil
+	file_lession_v1_dictation_service_proto_depIdxs = nil
+}
@@ -0,0 +1,347 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/download.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DownloadFileKind identifies the content of a bundle file.
+type DownloadFileKind int32
+
+const (
+	// DOWNLOAD_FILE_KIND_UNSPECIFIED is the default zero value.
+	DownloadFileKind_DOWNLOAD_FILE_KIND_UNSPECIFIED DownloadFileKind = 0
+	// DOWNLOAD_FILE_KIND_MEDIA is the episode's audio or video rendition.
+	DownloadFileKind_DOWNLOAD_FILE_KIND_MEDIA DownloadFileKind = 1
+	// DOWNLOAD_FILE_KIND_TRANSCRIPT is the transcript in its stored format.
+	DownloadFileKind_DOWNLOAD_FILE_KIND_TRANSCRIPT DownloadFileKind = 2
+	// DOWNLOAD_FILE_KIND_VOCABULARY is a JSON list of the episode's key words with their dictionary entries.
+	DownloadFileKind_DOWNLOAD_FILE_KIND_VOCABULARY DownloadFileKind = 3
+)
+
+// Enum value maps for DownloadFileKind.
+var (
+	DownloadFileKind_name = map[int32]string{
+		0: "DOWNLOAD_FILE_KIND_UNSPECIFIED",
+		1: "DOWNLOAD_FILE_KIND_MEDIA",
+		2: "DOWNLOAD_FILE_KIND_TRANSCRIPT",
+		3: "DOWNLOAD_FILE_KIND_VOCABULARY",
+	}
+	DownloadFileKind_value = map[string]int32{
+		"DOWNLOAD_FILE_KIND_UNSPECIFIED": 0,
+		"DOWNLOAD_FILE_KIND_MEDIA":       1,
+		"DOWNLOAD_FILE_KIND_TRANSCRIPT":  2,
+		"DOWNLOAD_FILE_KIND_VOCABULARY":  3,
+	}
+)
+
+func (x DownloadFileKind) Enum() *DownloadFileKind {
+	p := new(DownloadFileKind)
+	*p = x
+	return p
+}
+
+func (x DownloadFileKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DownloadFileKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_download_proto_enumTypes[0].Descriptor()
+}
+
+func (DownloadFileKind) Type() protoreflect.EnumType {
+	return &file_lession_v1_download_proto_enumTypes[0]
+}
+
+func (x DownloadFileKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DownloadFileKind.Descriptor instead.
+func (DownloadFileKind) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_download_proto_rawDescGZIP(), []int{0}
+}
+
+// DownloadBundle is the manifest of the files a mobile client stores to play an episode offline.
+type DownloadBundle struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// episode_id identifies the episode.
+	EpisodeId string `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// series_id identifies the series the episode belongs to.
+	SeriesId string `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// title is the episode title.
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// version is the episode's update time; clients holding an older bundle should download it again.
+	Version *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// translation_language is the BCP 47 tag the vocabulary is translated into.
+	TranslationLanguage string `protobuf:"bytes,5,opt,name=translation_language,json=translationLanguage,proto3" json:"translation_language,omitempty"`
+	// files lists the files of the bundle.
+	Files []*DownloadFile `protobuf:"bytes,6,rep,name=files,proto3" json:"files,omitempty"`
+	// expires_at is when the file URLs stop working.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadBundle) Reset() {
+	*x = DownloadBundle{}
+	mi := &file_lession_v1_download_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadBundle) ProtoMessage() {}
+
+func (x *DownloadBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_download_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadBundle.ProtoReflect.Descriptor instead.
+func (*DownloadBundle) Descriptor() ([]byte, []int) {
+	return file_lession_v1_download_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DownloadBundle) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *DownloadBundle) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *DownloadBundle) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DownloadBundle) GetVersion() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *DownloadBundle) GetTranslationLanguage() string {
+	if x != nil {
+		return x.TranslationLanguage
+	}
+	return ""
+}
+
+func (x *DownloadBundle) GetFiles() []*DownloadFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *DownloadBundle) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// DownloadFile is a file of a download bundle.
+type DownloadFile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kind identifies the content of the file.
+	Kind DownloadFileKind `protobuf:"varint,1,opt,name=kind,proto3,enum=lession.v1.DownloadFileKind" json:"kind,omitempty"`
+	// name is the file name to save the file under.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// mime_type is the media type of the file.
+	MimeType string `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	// size is the file size in bytes, zero when unknown.
+	Size uint64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// url is a signed URL the file can be fetched from without credentials until the bundle expires.
+	Url           string `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadFile) Reset() {
+	*x = DownloadFile{}
+	mi := &file_lession_v1_download_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFile) ProtoMessage() {}
+
+func (x *DownloadFile) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_download_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFile.ProtoReflect.Descriptor instead.
+func (*DownloadFile) Descriptor() ([]byte, []int) {
+	return file_lession_v1_download_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DownloadFile) GetKind() DownloadFileKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DownloadFileKind_DOWNLOAD_FILE_KIND_UNSPECIFIED
+}
+
+func (x *DownloadFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DownloadFile) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *DownloadFile) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DownloadFile) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+var File_lession_v1_download_proto protoreflect.FileDescriptor
+
+const file_lession_v1_download_proto_rawDesc = "" +
+	"\n" +
+	"\x19lession/v1/download.proto\x12\n" +
+	"lession.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb6\x02\n" +
+	"\x0eDownloadBundle\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\tepisodeId\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x124\n" +
+	"\aversion\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aversion\x121\n" +
+	"\x14translation_language\x18\x05 \x01(\tR\x13translationLanguage\x12.\n" +
+	"\x05files\x18\x06 \x03(\v2\x18.lession.v1.DownloadFileR\x05files\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x97\x01\n" +
+	"\fDownloadFile\x120\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1c.lession.v1.DownloadFileKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x04R\x04size\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url*\x9a\x01\n" +
+	"\x10DownloadFileKind\x12\"\n" +
+	"\x1eDOWNLOAD_FILE_KIND_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18DOWNLOAD_FILE_KIND_MEDIA\x10\x01\x12!\n" +
+	"\x1dDOWNLOAD_FILE_KIND_TRANSCRIPT\x10\x02\x12!\n" +
+	"\x1dDOWNLOAD_FILE_KIND_VOCABULARY\x10\x03B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_download_proto_rawDescOnce sync.Once
+	file_lession_v1_download_proto_rawDescData []byte
+)
+
+func file_lession_v1_download_proto_rawDescGZIP() []byte {
+	file_lession_v1_download_proto_rawDescOnce.Do(func() {
+		file_lession_v1_download_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_download_proto_rawDesc), len(file_lession_v1_download_proto_rawDesc)))
+	})
+	return file_lession_v1_download_proto_rawDescData
+}
+
+var file_lession_v1_download_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lession_v1_download_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_lession_v1_download_proto_goTypes = []any{
+	(DownloadFileKind)(0),         // 0: lession.v1.DownloadFileKind
+	(*DownloadBundle)(nil),        // 1: lession.v1.DownloadBundle
+	(*DownloadFile)(nil),          // 2: lession.v1.DownloadFile
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_lession_v1_download_proto_depIdxs = []int32{
+	3, // 0: lession.v1.DownloadBundle.version:type_name -> google.protobuf.Timestamp
+	2, // 1: lession.v1.DownloadBundle.files:type_name -> lession.v1.DownloadFile
+	3, // 2: lession.v1.DownloadBundle.expires_at:type_name -> google.protobuf.Timestamp
+	0, // 3: lession.v1.DownloadFile.kind:type_name -> lession.v1.DownloadFileKind
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_download_proto_init() }
+func file_lession_v1_download_proto_init() {
+	if File_lession_v1_download_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_download_proto_rawDesc), len(file_lession_v1_download_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_download_proto_goTypes,
+		DependencyIndexes: file_lession_v1_download_proto_depIdxs,
+		EnumInfos:         file_lession_v1_download_proto_enumTypes,
+		MessageInfos:      file_lession_v1_download_proto_msgTypes,
+	}.Build()
+	File_lession_v1_download_proto = out.File
+	file_lession_v1_download_proto_goTypes = nil
+	file_lession_v1_download_proto_depIdxs = nil
+}
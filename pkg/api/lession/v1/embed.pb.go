@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/embed.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EmbedToken lets an external site play a single published episode without
+// credentials of its own until it expires.
+type EmbedToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// token is passed to GetEmbedPlayback by the embedded player.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// episode_id identifies the episode the token plays.
+	EpisodeId string `protobuf:"bytes,2,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// allowed_domains lists the hosts, and their subdomains, the token works on; any when empty.
+	AllowedDomains []string `protobuf:"bytes,3,rep,name=allowed_domains,json=allowedDomains,proto3" json:"allowed_domains,omitempty"`
+	// expires_at is when the token stops working.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// embed_url is the player page playing the episode with the token; empty when embedding is not configured.
+	EmbedUrl      string `protobuf:"bytes,5,opt,name=embed_url,json=embedUrl,proto3" json:"embed_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmbedToken) Reset() {
+	*x = EmbedToken{}
+	mi := &file_lession_v1_embed_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmbedToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbedToken) ProtoMessage() {}
+
+func (x *EmbedToken) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_embed_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbedToken.ProtoReflect.Descriptor instead.
+func (*EmbedToken) Descriptor() ([]byte, []int) {
+	return file_lession_v1_embed_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EmbedToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *EmbedToken) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *EmbedToken) GetAllowedDomains() []string {
+	if x != nil {
+		return x.AllowedDomains
+	}
+	return nil
+}
+
+func (x *EmbedToken) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *EmbedToken) GetEmbedUrl() string {
+	if x != nil {
+		return x.EmbedUrl
+	}
+	return ""
+}
+
+var File_lession_v1_embed_proto protoreflect.FileDescriptor
+
+const file_lession_v1_embed_proto_rawDesc = "" +
+	"\n" +
+	"\x16lession/v1/embed.proto\x12\n" +
+	"lession.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc2\x01\n" +
+	"\n" +
+	"EmbedToken\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x02 \x01(\tR\tepisodeId\x12'\n" +
+	"\x0fallowed_domains\x18\x03 \x03(\tR\x0eallowedDomains\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1b\n" +
+	"\tembed_url\x18\x05 \x01(\tR\bembedUrlB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_embed_proto_rawDescOnce sync.Once
+	file_lession_v1_embed_proto_rawDescData []byte
+)
+
+func file_lession_v1_embed_proto_rawDescGZIP() []byte {
+	file_lession_v1_embed_proto_rawDescOnce.Do(func() {
+		file_lession_v1_embed_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_embed_proto_rawDesc), len(file_lession_v1_embed_proto_rawDesc)))
+	})
+	return file_lession_v1_embed_proto_rawDescData
+}
+
+var file_lession_v1_embed_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_lession_v1_embed_proto_goTypes = []any{
+	(*EmbedToken)(nil),            // 0: lession.v1.EmbedToken
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_lession_v1_embed_proto_depIdxs = []int32{
+	1, // 0: lession.v1.EmbedToken.expires_at:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_embed_proto_init() }
+func file_lession_v1_embed_proto_init() {
+	if File_lession_v1_embed_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_embed_proto_rawDesc), len(file_lession_v1_embed_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_embed_proto_goTypes,
+		DependencyIndexes: file_lession_v1_embed_proto_depIdxs,
+		MessageInfos:      file_lession_v1_embed_proto_msgTypes,
+	}.Build()
+	File_lession_v1_embed_proto = out.File
+	file_lession_v1_embed_proto_goTypes = nil
+	file_lession_v1_embed_proto_depIdxs = nil
+}
@@ -0,0 +1,325 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/embed_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CreateEmbedTokenRequest describes the token to issue.
+type CreateEmbedTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// episode_id identifies the episode to embed.
+	EpisodeId string `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// allowed_domains locks the token to these hosts and their subdomains, e.g. "example.com".
+	AllowedDomains []string `protobuf:"bytes,2,rep,name=allowed_domains,json=allowedDomains,proto3" json:"allowed_domains,omitempty"`
+	// ttl is how long the token works; 24 hours when unset.
+	Ttl           *durationpb.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEmbedTokenRequest) Reset() {
+	*x = CreateEmbedTokenRequest{}
+	mi := &file_lession_v1_embed_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmbedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmbedTokenRequest) ProtoMessage() {}
+
+func (x *CreateEmbedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_embed_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmbedTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateEmbedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_embed_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateEmbedTokenRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *CreateEmbedTokenRequest) GetAllowedDomains() []string {
+	if x != nil {
+		return x.AllowedDomains
+	}
+	return nil
+}
+
+func (x *CreateEmbedTokenRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+// CreateEmbedTokenResponse returns the issued token.
+type CreateEmbedTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// token is the issued embed token.
+	Token         *EmbedToken `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEmbedTokenResponse) Reset() {
+	*x = CreateEmbedTokenResponse{}
+	mi := &file_lession_v1_embed_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmbedTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmbedTokenResponse) ProtoMessage() {}
+
+func (x *CreateEmbedTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_embed_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmbedTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateEmbedTokenResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_embed_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateEmbedTokenResponse) GetToken() *EmbedToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+// GetEmbedPlaybackRequest carries the embed token.
+type GetEmbedPlaybackRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// token is an embed token from CreateEmbedToken.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmbedPlaybackRequest) Reset() {
+	*x = GetEmbedPlaybackRequest{}
+	mi := &file_lession_v1_embed_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmbedPlaybackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmbedPlaybackRequest) ProtoMessage() {}
+
+func (x *GetEmbedPlaybackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_embed_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmbedPlaybackRequest.ProtoReflect.Descriptor instead.
+func (*GetEmbedPlaybackRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_embed_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetEmbedPlaybackRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// GetEmbedPlaybackResponse returns the embedded episode.
+type GetEmbedPlaybackResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// episode is the published episode, with its playback URL.
+	Episode *CatalogEpisode `protobuf:"bytes,1,opt,name=episode,proto3" json:"episode,omitempty"`
+	// expires_at is when the token stops working.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmbedPlaybackResponse) Reset() {
+	*x = GetEmbedPlaybackResponse{}
+	mi := &file_lession_v1_embed_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmbedPlaybackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmbedPlaybackResponse) ProtoMessage() {}
+
+func (x *GetEmbedPlaybackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_embed_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmbedPlaybackResponse.ProtoReflect.Descriptor instead.
+func (*GetEmbedPlaybackResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_embed_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetEmbedPlaybackResponse) GetEpisode() *CatalogEpisode {
+	if x != nil {
+		return x.Episode
+	}
+	return nil
+}
+
+func (x *GetEmbedPlaybackResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+var File_lession_v1_embed_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_embed_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1elession/v1/embed_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18lession/v1/catalog.proto\x1a\x16lession/v1/embed.proto\"\xb2\x01\n" +
+	"\x17CreateEmbedTokenRequest\x12'\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tepisodeId\x127\n" +
+	"\x0fallowed_domains\x18\x02 \x03(\tB\x0e\xbaH\v\x92\x01\b\x10\x14\"\x04r\x02h\x01R\x0eallowedDomains\x125\n" +
+	"\x03ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationB\b\xbaH\x05\xaa\x01\x02*\x00R\x03ttl\"H\n" +
+	"\x18CreateEmbedTokenResponse\x12,\n" +
+	"\x05token\x18\x01 \x01(\v2\x16.lession.v1.EmbedTokenR\x05token\";\n" +
+	"\x17GetEmbedPlaybackRequest\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80 R\x05token\"\x8b\x01\n" +
+	"\x18GetEmbedPlaybackResponse\x124\n" +
+	"\aepisode\x18\x01 \x01(\v2\x1a.lession.v1.CatalogEpisodeR\aepisode\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt2\xcc\x01\n" +
+	"\fEmbedService\x12]\n" +
+	"\x10CreateEmbedToken\x12#.lession.v1.CreateEmbedTokenRequest\x1a$.lession.v1.CreateEmbedTokenResponse\x12]\n" +
+	"\x10GetEmbedPlayback\x12#.lession.v1.GetEmbedPlaybackRequest\x1a$.lession.v1.GetEmbedPlaybackResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_embed_service_proto_rawDescOnce sync.Once
+	file_lession_v1_embed_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_embed_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_embed_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_embed_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_embed_service_proto_rawDesc), len(file_lession_v1_embed_service_proto_rawDesc)))
+	})
+	return file_lession_v1_embed_service_proto_rawDescData
+}
+
+var file_lession_v1_embed_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_lession_v1_embed_service_proto_goTypes = []any{
+	(*CreateEmbedTokenRequest)(nil),  // 0: lession.v1.CreateEmbedTokenRequest
+	(*CreateEmbedTokenResponse)(nil), // 1: lession.v1.CreateEmbedTokenResponse
+	(*GetEmbedPlaybackRequest)(nil),  // 2: lession.v1.GetEmbedPlaybackRequest
+	(*GetEmbedPlaybackResponse)(nil), // 3: lession.v1.GetEmbedPlaybackResponse
+	(*durationpb.Duration)(nil),      // 4: google.protobuf.Duration
+	(*EmbedToken)(nil),               // 5: lession.v1.EmbedToken
+	(*CatalogEpisode)(nil),           // 6: lession.v1.CatalogEpisode
+	(*timestamppb.Timestamp)(nil),    // 7: google.protobuf.Timestamp
+}
+var file_lession_v1_embed_service_proto_depIdxs = []int32{
+	4, // 0: lession.v1.CreateEmbedTokenRequest.ttl:type_name -> google.protobuf.Duration
+	5, // 1: lession.v1.CreateEmbedTokenResponse.token:type_name -> lession.v1.EmbedToken
+	6, // 2: lession.v1.GetEmbedPlaybackResponse.episode:type_name -> lession.v1.CatalogEpisode
+	7, // 3: lession.v1.GetEmbedPlaybackResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0, // 4: lession.v1.EmbedService.CreateEmbedToken:input_type -> lession.v1.CreateEmbedTokenRequest
+	2, // 5: lession.v1.EmbedService.GetEmbedPlayback:input_type -> lession.v1.GetEmbedPlaybackRequest
+	1, // 6: lession.v1.EmbedService.CreateEmbedToken:output_type -> lession.v1.CreateEmbedTokenResponse
+	3, // 7: lession.v1.EmbedService.GetEmbedPlayback:output_type -> lession.v1.GetEmbedPlaybackResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_embed_service_proto_init() }
+func file_lession_v1_embed_service_proto_init() {
+	if File_lession_v1_embed_service_proto != nil {
+		return
+	}
+	file_lession_v1_catalog_proto_init()
+	file_lession_v1_embed_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_embed_service_proto_rawDesc), len(file_lession_v1_embed_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_embed_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_embed_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_embed_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_embed_service_proto = out.File
+	file_lession_v1_embed_service_proto_goTypes = nil
+	file_lession_v1_embed_service_proto_depIdxs = nil
+}
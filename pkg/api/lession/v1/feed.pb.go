@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/feed.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FeedKind identifies the kind of external feed a series is ingested from.
+type FeedKind int32
+
+const (
+	FeedKind_FEED_KIND_UNSPECIFIED FeedKind = 0
+	// FEED_KIND_YOUTUBE_PLAYLIST is a YouTube playlist; its videos are played from YouTube.
+	FeedKind_FEED_KIND_YOUTUBE_PLAYLIST FeedKind = 1
+	// FEED_KIND_PODCAST is a podcast RSS feed with media enclosures.
+	FeedKind_FEED_KIND_PODCAST FeedKind = 2
+)
+
+// Enum value maps for FeedKind.
+var (
+	FeedKind_name = map[int32]string{
+		0: "FEED_KIND_UNSPECIFIED",
+		1: "FEED_KIND_YOUTUBE_PLAYLIST",
+		2: "FEED_KIND_PODCAST",
+	}
+	FeedKind_value = map[string]int32{
+		"FEED_KIND_UNSPECIFIED":      0,
+		"FEED_KIND_YOUTUBE_PLAYLIST": 1,
+		"FEED_KIND_PODCAST":          2,
+	}
+)
+
+func (x FeedKind) Enum() *FeedKind {
+	p := new(FeedKind)
+	*p = x
+	return p
+}
+
+func (x FeedKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeedKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_feed_proto_enumTypes[0].Descriptor()
+}
+
+func (FeedKind) Type() protoreflect.EnumType {
+	return &file_lession_v1_feed_proto_enumTypes[0]
+}
+
+func (x FeedKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeedKind.Descriptor instead.
+func (FeedKind) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_feed_proto_rawDescGZIP(), []int{0}
+}
+
+// FeedSubscription keeps a series in sync with an external feed.
+type FeedSubscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the server-assigned identifier for the subscription.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// series_id references the series the feed is ingested into.
+	SeriesId string `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// kind is the kind of the feed.
+	Kind FeedKind `protobuf:"varint,3,opt,name=kind,proto3,enum=lession.v1.FeedKind" json:"kind,omitempty"`
+	// source_url is the playlist or feed URL.
+	SourceUrl string `protobuf:"bytes,4,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`
+	// download_media copies the media of new items through the upload pipeline.
+	DownloadMedia bool `protobuf:"varint,5,opt,name=download_media,json=downloadMedia,proto3" json:"download_media,omitempty"`
+	// auto_publish publishes new episodes instead of leaving them as drafts.
+	AutoPublish bool `protobuf:"varint,6,opt,name=auto_publish,json=autoPublish,proto3" json:"auto_publish,omitempty"`
+	// last_synced_at records when the feed was last synced.
+	LastSyncedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_synced_at,json=lastSyncedAt,proto3" json:"last_synced_at,omitempty"`
+	// last_error is the error of the last sync, empty when it succeeded.
+	LastError string `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// created_at records when the subscription was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at records when the subscription was last changed.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedSubscription) Reset() {
+	*x = FeedSubscription{}
+	mi := &file_lession_v1_feed_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedSubscription) ProtoMessage() {}
+
+func (x *FeedSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_feed_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedSubscription.ProtoReflect.Descriptor instead.
+func (*FeedSubscription) Descriptor() ([]byte, []int) {
+	return file_lession_v1_feed_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FeedSubscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FeedSubscription) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *FeedSubscription) GetKind() FeedKind {
+	if x != nil {
+		return x.Kind
+	}
+	return FeedKind_FEED_KIND_UNSPECIFIED
+}
+
+func (x *FeedSubscription) GetSourceUrl() string {
+	if x != nil {
+		return x.SourceUrl
+	}
+	return ""
+}
+
+func (x *FeedSubscription) GetDownloadMedia() bool {
+	if x != nil {
+		return x.DownloadMedia
+	}
+	return false
+}
+
+func (x *FeedSubscription) GetAutoPublish() bool {
+	if x != nil {
+		return x.AutoPublish
+	}
+	return false
+}
+
+func (x *FeedSubscription) GetLastSyncedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncedAt
+	}
+	return nil
+}
+
+func (x *FeedSubscription) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *FeedSubscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FeedSubscription) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_lession_v1_feed_proto protoreflect.FileDescriptor
+
+const file_lession_v1_feed_proto_rawDesc = "" +
+	"\n" +
+	"\x15lession/v1/feed.proto\x12\n" +
+	"lession.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa9\x03\n" +
+	"\x10FeedSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\x12(\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x14.lession.v1.FeedKindR\x04kind\x12\x1d\n" +
+	"\n" +
+	"source_url\x18\x04 \x01(\tR\tsourceUrl\x12%\n" +
+	"\x0edownload_media\x18\x05 \x01(\bR\rdownloadMedia\x12!\n" +
+	"\fauto_publish\x18\x06 \x01(\bR\vautoPublish\x12@\n" +
+	"\x0elast_synced_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\flastSyncedAt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt*\\\n" +
+	"\bFeedKind\x12\x19\n" +
+	"\x15FEED_KIND_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aFEED_KIND_YOUTUBE_PLAYLIST\x10\x01\x12\x15\n" +
+	"\x11FEED_KIND_PODCAST\x10\x02B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_feed_proto_rawDescOnce sync.Once
+	file_lession_v1_feed_proto_rawDescData []byte
+)
+
+func file_lession_v1_feed_proto_rawDescGZIP() []byte {
+	file_lession_v1_feed_proto_rawDescOnce.Do(func() {
+		file_lession_v1_feed_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_feed_proto_rawDesc), len(file_lession_v1_feed_proto_rawDesc)))
+	})
+	return file_lession_v1_feed_proto_rawDescData
+}
+
+var file_lession_v1_feed_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lession_v1_feed_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_lession_v1_feed_proto_goTypes = []any{
+	(FeedKind)(0),                 // 0: lession.v1.FeedKind
+	(*FeedSubscription)(nil),      // 1: lession.v1.FeedSubscription
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_lession_v1_feed_proto_depIdxs = []int32{
+	0, // 0: lession.v1.FeedSubscription.kind:type_name -> lession.v1.FeedKind
+	2, // 1: lession.v1.FeedSubscription.last_synced_at:type_name -> google.protobuf.Timestamp
+	2, // 2: lession.v1.FeedSubscription.created_at:type_name -> google.protobuf.Timestamp
+	2, // 3: lession.v1.FeedSubscription.updated_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_feed_proto_init() }
+func file_lession_v1_feed_proto_init() {
+	if File_lession_v1_feed_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_feed_proto_rawDesc), len(file_lession_v1_feed_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_feed_proto_goTypes,
+		DependencyIndexes: file_lession_v1_feed_proto_depIdxs,
+		EnumInfos:         file_lession_v1_feed_proto_enumTypes,
+		MessageInfos:      file_lession_v1_feed_proto_msgTypes,
+	}.Build()
+	File_lession_v1_feed_proto = out.File
+	file_lession_v1_feed_proto_goTypes = nil
+	file_lession_v1_feed_proto_depIdxs = nil
+}
@@ -0,0 +1,689 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/feed_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CreateFeedSubscriptionRequest describes the feed to ingest.
+type CreateFeedSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kind is the kind of the feed.
+	Kind FeedKind `protobuf:"varint,1,opt,name=kind,proto3,enum=lession.v1.FeedKind" json:"kind,omitempty"`
+	// source_url is a YouTube playlist URL or a podcast RSS feed URL.
+	SourceUrl string `protobuf:"bytes,2,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`
+	// download_media copies the media of podcast episodes through the upload
+	// pipeline instead of playing them from the feed. Only set it for content
+	// the platform is licensed to host.
+	DownloadMedia bool `protobuf:"varint,3,opt,name=download_media,json=downloadMedia,proto3" json:"download_media,omitempty"`
+	// auto_publish publishes new episodes instead of leaving them as drafts.
+	AutoPublish bool `protobuf:"varint,4,opt,name=auto_publish,json=autoPublish,proto3" json:"auto_publish,omitempty"`
+	// slug names the new series; it defaults to one derived from the feed title.
+	Slug string `protobuf:"bytes,5,opt,name=slug,proto3" json:"slug,omitempty"`
+	// level is the proficiency level of the new series.
+	Level string `protobuf:"bytes,6,opt,name=level,proto3" json:"level,omitempty"`
+	// author_ids are recorded as the authors of the new series.
+	AuthorIds     []string `protobuf:"bytes,7,rep,name=author_ids,json=authorIds,proto3" json:"author_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFeedSubscriptionRequest) Reset() {
+	*x = CreateFeedSubscriptionRequest{}
+	mi := &file_lession_v1_feed_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFeedSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFeedSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateFeedSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_feed_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFeedSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateFeedSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_feed_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateFeedSubscriptionRequest) GetKind() FeedKind {
+	if x != nil {
+		return x.Kind
+	}
+	return FeedKind_FEED_KIND_UNSPECIFIED
+}
+
+func (x *CreateFeedSubscriptionRequest) GetSourceUrl() string {
+	if x != nil {
+		return x.SourceUrl
+	}
+	return ""
+}
+
+func (x *CreateFeedSubscriptionRequest) GetDownloadMedia() bool {
+	if x != nil {
+		return x.DownloadMedia
+	}
+	return false
+}
+
+func (x *CreateFeedSubscriptionRequest) GetAutoPublish() bool {
+	if x != nil {
+		return x.AutoPublish
+	}
+	return false
+}
+
+func (x *CreateFeedSubscriptionRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreateFeedSubscriptionRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *CreateFeedSubscriptionRequest) GetAuthorIds() []string {
+	if x != nil {
+		return x.AuthorIds
+	}
+	return nil
+}
+
+// CreateFeedSubscriptionResponse returns the subscription and the episodes ingested.
+type CreateFeedSubscriptionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// subscription is the persisted subscription; last_error reports items
+	// that could not be ingested yet.
+	Subscription *FeedSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// created_episode_ids lists the episodes ingested from the feed.
+	CreatedEpisodeIds []string `protobuf:"bytes,2,rep,name=created_episode_ids,json=createdEpisodeIds,proto3" json:"created_episode_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateFeedSubscriptionResponse) Reset() {
+	*x = CreateFeedSubscriptionResponse{}
+	mi := &file_lession_v1_feed_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFeedSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFeedSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreateFeedSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_feed_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFeedSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateFeedSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_feed_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateFeedSubscriptionResponse) GetSubscription() *FeedSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *CreateFeedSubscriptionResponse) GetCreatedEpisodeIds() []string {
+	if x != nil {
+		return x.CreatedEpisodeIds
+	}
+	return nil
+}
+
+// GetFeedSubscriptionRequest identifies the subscription to retrieve.
+type GetFeedSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// subscription_id references the target subscription.
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetFeedSubscriptionRequest) Reset() {
+	*x = GetFeedSubscriptionRequest{}
+	mi := &file_lession_v1_feed_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedSubscriptionRequest) ProtoMessage() {}
+
+func (x *GetFeedSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_feed_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetFeedSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_feed_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetFeedSubscriptionRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+// GetFeedSubscriptionResponse returns a single subscription.
+type GetFeedSubscriptionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// subscription is the requested resource.
+	Subscription  *FeedSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeedSubscriptionResponse) Reset() {
+	*x = GetFeedSubscriptionResponse{}
+	mi := &file_lession_v1_feed_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedSubscriptionResponse) ProtoMessage() {}
+
+func (x *GetFeedSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_feed_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*GetFeedSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_feed_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetFeedSubscriptionResponse) GetSubscription() *FeedSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// ListFeedSubscriptionsRequest carries pagination options.
+type ListFeedSubscriptionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_size limits the number of returned subscriptions.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token continues a prior ListFeedSubscriptions response.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeedSubscriptionsRequest) Reset() {
+	*x = ListFeedSubscriptionsRequest{}
+	mi := &file_lession_v1_feed_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeedSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeedSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListFeedSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_feed_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeedSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListFeedSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_feed_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListFeedSubscriptionsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFeedSubscriptionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListFeedSubscriptionsResponse returns a page of subscriptions.
+type ListFeedSubscriptionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// subscriptions contains the subscriptions.
+	Subscriptions []*FeedSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	// next_page_token is supplied when more data is available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeedSubscriptionsResponse) Reset() {
+	*x = ListFeedSubscriptionsResponse{}
+	mi := &file_lession_v1_feed_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeedSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeedSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListFeedSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_feed_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeedSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListFeedSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_feed_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListFeedSubscriptionsResponse) GetSubscriptions() []*FeedSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *ListFeedSubscriptionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// DeleteFeedSubscriptionRequest identifies the subscription to remove.
+type DeleteFeedSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// subscription_id references the target subscription.
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteFeedSubscriptionRequest) Reset() {
+	*x = DeleteFeedSubscriptionRequest{}
+	mi := &file_lession_v1_feed_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFeedSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeedSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteFeedSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_feed_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFeedSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFeedSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_feed_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteFeedSubscriptionRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+// DeleteFeedSubscriptionResponse acknowledges the removal.
+type DeleteFeedSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFeedSubscriptionResponse) Reset() {
+	*x = DeleteFeedSubscriptionResponse{}
+	mi := &file_lession_v1_feed_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFeedSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeedSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteFeedSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_feed_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFeedSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFeedSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_feed_service_proto_rawDescGZIP(), []int{7}
+}
+
+// SyncFeedSubscriptionRequest identifies the subscription to sync.
+type SyncFeedSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// subscription_id references the target subscription.
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SyncFeedSubscriptionRequest) Reset() {
+	*x = SyncFeedSubscriptionRequest{}
+	mi := &file_lession_v1_feed_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncFeedSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncFeedSubscriptionRequest) ProtoMessage() {}
+
+func (x *SyncFeedSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_feed_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncFeedSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*SyncFeedSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_feed_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SyncFeedSubscriptionRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+// SyncFeedSubscriptionResponse returns the outcome of the sync.
+type SyncFeedSubscriptionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// subscription is the subscription after the sync.
+	Subscription *FeedSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// created_episode_ids lists the episodes added by the sync.
+	CreatedEpisodeIds []string `protobuf:"bytes,2,rep,name=created_episode_ids,json=createdEpisodeIds,proto3" json:"created_episode_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SyncFeedSubscriptionResponse) Reset() {
+	*x = SyncFeedSubscriptionResponse{}
+	mi := &file_lession_v1_feed_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncFeedSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncFeedSubscriptionResponse) ProtoMessage() {}
+
+func (x *SyncFeedSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_feed_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncFeedSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*SyncFeedSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_feed_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SyncFeedSubscriptionResponse) GetSubscription() *FeedSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *SyncFeedSubscriptionResponse) GetCreatedEpisodeIds() []string {
+	if x != nil {
+		return x.CreatedEpisodeIds
+	}
+	return nil
+}
+
+var File_lession_v1_feed_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_feed_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1dlession/v1/feed_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x15lession/v1/feed.proto\"\xb4\x02\n" +
+	"\x1dCreateFeedSubscriptionRequest\x124\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x14.lession.v1.FeedKindB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04kind\x12)\n" +
+	"\n" +
+	"source_url\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x10R\tsourceUrl\x12%\n" +
+	"\x0edownload_media\x18\x03 \x01(\bR\rdownloadMedia\x12!\n" +
+	"\fauto_publish\x18\x04 \x01(\bR\vautoPublish\x12\x1c\n" +
+	"\x04slug\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x01R\x04slug\x12\x1d\n" +
+	"\x05level\x18\x06 \x01(\tB\a\xbaH\x04r\x02\x18@R\x05level\x12+\n" +
+	"\n" +
+	"author_ids\x18\a \x03(\tB\f\xbaH\t\x92\x01\x06\"\x04r\x02\x10\x01R\tauthorIds\"\x92\x01\n" +
+	"\x1eCreateFeedSubscriptionResponse\x12@\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x1c.lession.v1.FeedSubscriptionR\fsubscription\x12.\n" +
+	"\x13created_episode_ids\x18\x02 \x03(\tR\x11createdEpisodeIds\"O\n" +
+	"\x1aGetFeedSubscriptionRequest\x121\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0esubscriptionId\"_\n" +
+	"\x1bGetFeedSubscriptionResponse\x12@\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x1c.lession.v1.FeedSubscriptionR\fsubscription\"c\n" +
+	"\x1cListFeedSubscriptionsRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\rB\a\xbaH\x04*\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x8b\x01\n" +
+	"\x1dListFeedSubscriptionsResponse\x12B\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x1c.lession.v1.FeedSubscriptionR\rsubscriptions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"R\n" +
+	"\x1dDeleteFeedSubscriptionRequest\x121\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0esubscriptionId\" \n" +
+	"\x1eDeleteFeedSubscriptionResponse\"P\n" +
+	"\x1bSyncFeedSubscriptionRequest\x121\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0esubscriptionId\"\x90\x01\n" +
+	"\x1cSyncFeedSubscriptionResponse\x12@\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x1c.lession.v1.FeedSubscriptionR\fsubscription\x12.\n" +
+	"\x13created_episode_ids\x18\x02 \x03(\tR\x11createdEpisodeIds2\xb0\x04\n" +
+	"\vFeedService\x12o\n" +
+	"\x16CreateFeedSubscription\x12).lession.v1.CreateFeedSubscriptionRequest\x1a*.lession.v1.CreateFeedSubscriptionResponse\x12f\n" +
+	"\x13GetFeedSubscription\x12&.lession.v1.GetFeedSubscriptionRequest\x1a'.lession.v1.GetFeedSubscriptionResponse\x12l\n" +
+	"\x15ListFeedSubscriptions\x12(.lession.v1.ListFeedSubscriptionsRequest\x1a).lession.v1.ListFeedSubscriptionsResponse\x12o\n" +
+	"\x16DeleteFeedSubscription\x12).lession.v1.DeleteFeedSubscriptionRequest\x1a*.lession.v1.DeleteFeedSubscriptionResponse\x12i\n" +
+	"\x14SyncFeedSubscription\x12'.lession.v1.SyncFeedSubscriptionRequest\x1a(.lession.v1.SyncFeedSubscriptionResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_feed_service_proto_rawDescOnce sync.Once
+	file_lession_v1_feed_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_feed_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_feed_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_feed_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_feed_service_proto_rawDesc), len(file_lession_v1_feed_service_proto_rawDesc)))
+	})
+	return file_lession_v1_feed_service_proto_rawDescData
+}
+
+var file_lession_v1_feed_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_lession_v1_feed_service_proto_goTypes = []any{
+	(*CreateFeedSubscriptionRequest)(nil),  // 0: lession.v1.CreateFeedSubscriptionRequest
+	(*CreateFeedSubscriptionResponse)(nil), // 1: lession.v1.CreateFeedSubscriptionResponse
+	(*GetFeedSubscriptionRequest)(nil),     // 2: lession.v1.GetFeedSubscriptionRequest
+	(*GetFeedSubscriptionResponse)(nil),    // 3: lession.v1.GetFeedSubscriptionResponse
+	(*ListFeedSubscriptionsRequest)(nil),   // 4: lession.v1.ListFeedSubscriptionsRequest
+	(*ListFeedSubscriptionsResponse)(nil),  // 5: lession.v1.ListFeedSubscriptionsResponse
+	(*DeleteFeedSubscriptionRequest)(nil),  // 6: lession.v1.DeleteFeedSubscriptionRequest
+	(*DeleteFeedSubscriptionResponse)(nil), // 7: lession.v1.DeleteFeedSubscriptionResponse
+	(*SyncFeedSubscriptionRequest)(nil),    // 8: lession.v1.SyncFeedSubscriptionRequest
+	(*SyncFeedSubscriptionResponse)(nil),   // 9: lession.v1.SyncFeedSubscriptionResponse
+	(FeedKind)(0),                          // 10: lession.v1.FeedKind
+	(*FeedSubscription)(nil),               // 11: lession.v1.FeedSubscription
+}
+var file_lession_v1_feed_service_proto_depIdxs = []int32{
+	10, // 0: lession.v1.CreateFeedSubscriptionRequest.kind:type_name -> lession.v1.FeedKind
+	11, // 1: lession.v1.CreateFeedSubscriptionResponse.subscription:type_name -> lession.v1.FeedSubscription
+	11, // 2: lession.v1.GetFeedSubscriptionResponse.subscription:type_name -> lession.v1.FeedSubscription
+	11, // 3: lession.v1.ListFeedSubscriptionsResponse.subscriptions:type_name -> lession.v1.FeedSubscription
+	11, // 4: lession.v1.SyncFeedSubscriptionResponse.subscription:type_name -> lession.v1.FeedSubscription
+	0,  // 5: lession.v1.FeedService.CreateFeedSubscription:input_type -> lession.v1.CreateFeedSubscriptionRequest
+	2,  // 6: lession.v1.FeedService.GetFeedSubscription:input_type -> lession.v1.GetFeedSubscriptionRequest
+	4,  // 7: lession.v1.FeedService.ListFeedSubscriptions:input_type -> lession.v1.ListFeedSubscriptionsRequest
+	6,  // 8: lession.v1.FeedService.DeleteFeedSubscription:input_type -> lession.v1.DeleteFeedSubscriptionRequest
+	8,  // 9: lession.v1.FeedService.SyncFeedSubscription:input_type -> lession.v1.SyncFeedSubscriptionRequest
+	1,  // 10: lession.v1.FeedService.CreateFeedSubscription:output_type -> lession.v1.CreateFeedSubscriptionResponse
+	3,  // 11: lession.v1.FeedService.GetFeedSubscription:output_type -> lession.v1.GetFeedSubscriptionResponse
+	5,  // 12: lession.v1.FeedService.ListFeedSubscriptions:output_type -> lession.v1.ListFeedSubscriptionsResponse
+	7,  // 13: lession.v1.FeedService.DeleteFeedSubscription:output_type -> lession.v1.DeleteFeedSubscriptionResponse
+	9,  // 14: lession.v1.FeedService.SyncFeedSubscription:output_type -> lession.v1.SyncFeedSubscriptionResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_feed_service_proto_init() }
+func file_lession_v1_feed_service_proto_init() {
+	if File_lession_v1_feed_service_proto != nil {
+		return
+	}
+	file_lession_v1_feed_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_feed_service_proto_rawDesc), len(file_lession_v1_feed_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_feed_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_feed_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_feed_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_feed_service_proto = out.File
+	file_lession_v1_feed_service_proto_goTypes = nil
+	file_lession_v1_feed_service_proto_depIdxs = nil
+}
@@ -0,0 +1,382 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/import_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SpreadsheetFormat identifies the file format of an uploaded spreadsheet.
+type SpreadsheetFormat int32
+
+const (
+	SpreadsheetFormat_SPREADSHEET_FORMAT_UNSPECIFIED SpreadsheetFormat = 0
+	// SPREADSHEET_FORMAT_CSV is UTF-8 comma-separated values.
+	SpreadsheetFormat_SPREADSHEET_FORMAT_CSV SpreadsheetFormat = 1
+	// SPREADSHEET_FORMAT_XLSX is an Excel workbook; only its first sheet is read.
+	SpreadsheetFormat_SPREADSHEET_FORMAT_XLSX SpreadsheetFormat = 2
+)
+
+// Enum value maps for SpreadsheetFormat.
+var (
+	SpreadsheetFormat_name = map[int32]string{
+		0: "SPREADSHEET_FORMAT_UNSPECIFIED",
+		1: "SPREADSHEET_FORMAT_CSV",
+		2: "SPREADSHEET_FORMAT_XLSX",
+	}
+	SpreadsheetFormat_value = map[string]int32{
+		"SPREADSHEET_FORMAT_UNSPECIFIED": 0,
+		"SPREADSHEET_FORMAT_CSV":         1,
+		"SPREADSHEET_FORMAT_XLSX":        2,
+	}
+)
+
+func (x SpreadsheetFormat) Enum() *SpreadsheetFormat {
+	p := new(SpreadsheetFormat)
+	*p = x
+	return p
+}
+
+func (x SpreadsheetFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SpreadsheetFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_import_service_proto_enumTypes[0].Descriptor()
+}
+
+func (SpreadsheetFormat) Type() protoreflect.EnumType {
+	return &file_lession_v1_import_service_proto_enumTypes[0]
+}
+
+func (x SpreadsheetFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SpreadsheetFormat.Descriptor instead.
+func (SpreadsheetFormat) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_import_service_proto_rawDescGZIP(), []int{0}
+}
+
+// ImportCatalogRequest carries the spreadsheet to import.
+type ImportCatalogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// format is the file format of data.
+	Format SpreadsheetFormat `protobuf:"varint,1,opt,name=format,proto3,enum=lession.v1.SpreadsheetFormat" json:"format,omitempty"`
+	// data is the content of the file.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// dry_run only validates the rows.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// author_ids are recorded as the authors of every imported series.
+	AuthorIds     []string `protobuf:"bytes,4,rep,name=author_ids,json=authorIds,proto3" json:"author_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCatalogRequest) Reset() {
+	*x = ImportCatalogRequest{}
+	mi := &file_lession_v1_import_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCatalogRequest) ProtoMessage() {}
+
+func (x *ImportCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_import_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCatalogRequest.ProtoReflect.Descriptor instead.
+func (*ImportCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_import_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ImportCatalogRequest) GetFormat() SpreadsheetFormat {
+	if x != nil {
+		return x.Format
+	}
+	return SpreadsheetFormat_SPREADSHEET_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportCatalogRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportCatalogRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportCatalogRequest) GetAuthorIds() []string {
+	if x != nil {
+		return x.AuthorIds
+	}
+	return nil
+}
+
+// ImportRowError reports why a row cannot be imported.
+type ImportRowError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// row is the 1-based row number, the header being row 1.
+	Row uint32 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	// column names the offending column; empty for problems with the whole row.
+	Column string `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	// message describes the problem.
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_lession_v1_import_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_import_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_lession_v1_import_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ImportRowError) GetRow() uint32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ImportCatalogResponse reports the outcome of an import.
+type ImportCatalogResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rows is the number of data rows read.
+	Rows uint32 `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	// series_count is the number of series in the spreadsheet.
+	SeriesCount uint32 `protobuf:"varint,2,opt,name=series_count,json=seriesCount,proto3" json:"series_count,omitempty"`
+	// episode_count is the number of episodes in the spreadsheet.
+	EpisodeCount uint32 `protobuf:"varint,3,opt,name=episode_count,json=episodeCount,proto3" json:"episode_count,omitempty"`
+	// created_series_ids lists the created series in spreadsheet order.
+	CreatedSeriesIds []string `protobuf:"bytes,4,rep,name=created_series_ids,json=createdSeriesIds,proto3" json:"created_series_ids,omitempty"`
+	// errors lists the problems found; nothing is created while a row has one.
+	Errors        []*ImportRowError `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCatalogResponse) Reset() {
+	*x = ImportCatalogResponse{}
+	mi := &file_lession_v1_import_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCatalogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCatalogResponse) ProtoMessage() {}
+
+func (x *ImportCatalogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_import_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCatalogResponse.ProtoReflect.Descriptor instead.
+func (*ImportCatalogResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_import_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImportCatalogResponse) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *ImportCatalogResponse) GetSeriesCount() uint32 {
+	if x != nil {
+		return x.SeriesCount
+	}
+	return 0
+}
+
+func (x *ImportCatalogResponse) GetEpisodeCount() uint32 {
+	if x != nil {
+		return x.EpisodeCount
+	}
+	return 0
+}
+
+func (x *ImportCatalogResponse) GetCreatedSeriesIds() []string {
+	if x != nil {
+		return x.CreatedSeriesIds
+	}
+	return nil
+}
+
+func (x *ImportCatalogResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+var File_lession_v1_import_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_import_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1flession/v1/import_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\"\xc1\x01\n" +
+	"\x14ImportCatalogRequest\x12A\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x1d.lession.v1.SpreadsheetFormatB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06format\x12 \n" +
+	"\x04data\x18\x02 \x01(\fB\f\xbaH\tz\a\x10\x01\x18\x80\x80\x80\n" +
+	"R\x04data\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12+\n" +
+	"\n" +
+	"author_ids\x18\x04 \x03(\tB\f\xbaH\t\x92\x01\x06\"\x04r\x02\x10\x01R\tauthorIds\"T\n" +
+	"\x0eImportRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\rR\x03row\x12\x16\n" +
+	"\x06column\x18\x02 \x01(\tR\x06column\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xd5\x01\n" +
+	"\x15ImportCatalogResponse\x12\x12\n" +
+	"\x04rows\x18\x01 \x01(\rR\x04rows\x12!\n" +
+	"\fseries_count\x18\x02 \x01(\rR\vseriesCount\x12#\n" +
+	"\repisode_count\x18\x03 \x01(\rR\fepisodeCount\x12,\n" +
+	"\x12created_series_ids\x18\x04 \x03(\tR\x10createdSeriesIds\x122\n" +
+	"\x06errors\x18\x05 \x03(\v2\x1a.lession.v1.ImportRowErrorR\x06errors*p\n" +
+	"\x11SpreadsheetFormat\x12\"\n" +
+	"\x1eSPREADSHEET_FORMAT_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SPREADSHEET_FORMAT_CSV\x10\x01\x12\x1b\n" +
+	"\x17SPREADSHEET_FORMAT_XLSX\x10\x022e\n" +
+	"\rImportService\x12T\n" +
+	"\rImportCatalog\x12 .lession.v1.ImportCatalogRequest\x1a!.lession.v1.ImportCatalogResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_import_service_proto_rawDescOnce sync.Once
+	file_lession_v1_import_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_import_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_import_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_import_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_import_service_proto_rawDesc), len(file_lession_v1_import_service_proto_rawDesc)))
+	})
+	return file_lession_v1_import_service_proto_rawDescData
+}
+
+var file_lession_v1_import_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lession_v1_import_service_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_lession_v1_import_service_proto_goTypes = []any{
+	(SpreadsheetFormat)(0),        // 0: lession.v1.SpreadsheetFormat
+	(*ImportCatalogRequest)(nil),  // 1: lession.v1.ImportCatalogRequest
+	(*ImportRowError)(nil),        // 2: lession.v1.ImportRowError
+	(*ImportCatalogResponse)(nil), // 3: lession.v1.ImportCatalogResponse
+}
+var file_lession_v1_import_service_proto_depIdxs = []int32{
+	0, // 0: lession.v1.ImportCatalogRequest.format:type_name -> lession.v1.SpreadsheetFormat
+	2, // 1: lession.v1.ImportCatalogResponse.errors:type_name -> lession.v1.ImportRowError
+	1, // 2: lession.v1.ImportService.ImportCatalog:input_type -> lession.v1.ImportCatalogRequest
+	3, // 3: lession.v1.ImportService.ImportCatalog:output_type -> lession.v1.ImportCatalogResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_import_service_proto_init() }
+func file_lession_v1_import_service_proto_init() {
+	if File_lession_v1_import_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_import_service_proto_rawDesc), len(file_lession_v1_import_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_import_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_import_service_proto_depIdxs,
+		EnumInfos:         file_lession_v1_import_service_proto_enumTypes,
+		MessageInfos:      file_lession_v1_import_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_import_service_proto = out.File
+	file_lession_v1_import_service_proto_goTypes = nil
+	file_lession_v1_import_service_proto_depIdxs = nil
+}
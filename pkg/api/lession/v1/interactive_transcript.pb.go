@@ -0,0 +1,402 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/interactive_transcript.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// InteractiveTranscript is an episode transcript prepared for tap-to-translate reading.
+type InteractiveTranscript struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// episode_id identifies the episode.
+	EpisodeId string `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// language is the BCP 47 tag of the transcript.
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	// translation_language is the BCP 47 tag translations are in.
+	TranslationLanguage string `protobuf:"bytes,3,opt,name=translation_language,json=translationLanguage,proto3" json:"translation_language,omitempty"`
+	// segments lists the transcript segments; plain transcripts have a single untimed one.
+	Segments []*InteractiveSegment `protobuf:"bytes,4,rep,name=segments,proto3" json:"segments,omitempty"`
+	// entries holds an entry for each word the dictionary knows, ordered by word.
+	Entries       []*DictionaryEntry `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InteractiveTranscript) Reset() {
+	*x = InteractiveTranscript{}
+	mi := &file_lession_v1_interactive_transcript_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InteractiveTranscript) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InteractiveTranscript) ProtoMessage() {}
+
+func (x *InteractiveTranscript) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_interactive_transcript_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InteractiveTranscript.ProtoReflect.Descriptor instead.
+func (*InteractiveTranscript) Descriptor() ([]byte, []int) {
+	return file_lession_v1_interactive_transcript_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InteractiveTranscript) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *InteractiveTranscript) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *InteractiveTranscript) GetTranslationLanguage() string {
+	if x != nil {
+		return x.TranslationLanguage
+	}
+	return ""
+}
+
+func (x *InteractiveTranscript) GetSegments() []*InteractiveSegment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *InteractiveTranscript) GetEntries() []*DictionaryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// InteractiveSegment is a transcript segment split into tokens.
+type InteractiveSegment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// index is the zero-based position of the segment within the transcript.
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// start is the segment offset from the beginning of the media.
+	Start *durationpb.Duration `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// end is the offset at which the segment finishes.
+	End *durationpb.Duration `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// tokens reproduce the segment text when concatenated.
+	Tokens        []*TranscriptToken `protobuf:"bytes,4,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InteractiveSegment) Reset() {
+	*x = InteractiveSegment{}
+	mi := &file_lession_v1_interactive_transcript_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InteractiveSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InteractiveSegment) ProtoMessage() {}
+
+func (x *InteractiveSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_interactive_transcript_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InteractiveSegment.ProtoReflect.Descriptor instead.
+func (*InteractiveSegment) Descriptor() ([]byte, []int) {
+	return file_lession_v1_interactive_transcript_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InteractiveSegment) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *InteractiveSegment) GetStart() *durationpb.Duration {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *InteractiveSegment) GetEnd() *durationpb.Duration {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *InteractiveSegment) GetTokens() []*TranscriptToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+// TranscriptToken is a run of transcript text.
+type TranscriptToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// text is the run as it appears in the transcript.
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// word is the lower-cased word matching a dictionary entry, empty for spaces and punctuation.
+	Word          string `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TranscriptToken) Reset() {
+	*x = TranscriptToken{}
+	mi := &file_lession_v1_interactive_transcript_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TranscriptToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranscriptToken) ProtoMessage() {}
+
+func (x *TranscriptToken) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_interactive_transcript_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranscriptToken.ProtoReflect.Descriptor instead.
+func (*TranscriptToken) Descriptor() ([]byte, []int) {
+	return file_lession_v1_interactive_transcript_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TranscriptToken) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TranscriptToken) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+// DictionaryEntry describes a word for a learner.
+type DictionaryEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// word is the lower-cased word as it appears in the text.
+	Word string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	// lemma is the dictionary form of the word.
+	Lemma string `protobuf:"bytes,2,opt,name=lemma,proto3" json:"lemma,omitempty"`
+	// part_of_speech is the word class, such as "verb".
+	PartOfSpeech string `protobuf:"bytes,3,opt,name=part_of_speech,json=partOfSpeech,proto3" json:"part_of_speech,omitempty"`
+	// definition explains the word in the transcript language.
+	Definition string `protobuf:"bytes,4,opt,name=definition,proto3" json:"definition,omitempty"`
+	// translation is into the requested language, empty when the dictionary has none.
+	Translation   string `protobuf:"bytes,5,opt,name=translation,proto3" json:"translation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DictionaryEntry) Reset() {
+	*x = DictionaryEntry{}
+	mi := &file_lession_v1_interactive_transcript_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DictionaryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryEntry) ProtoMessage() {}
+
+func (x *DictionaryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_interactive_transcript_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryEntry.ProtoReflect.Descriptor instead.
+func (*DictionaryEntry) Descriptor() ([]byte, []int) {
+	return file_lession_v1_interactive_transcript_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DictionaryEntry) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *DictionaryEntry) GetLemma() string {
+	if x != nil {
+		return x.Lemma
+	}
+	return ""
+}
+
+func (x *DictionaryEntry) GetPartOfSpeech() string {
+	if x != nil {
+		return x.PartOfSpeech
+	}
+	return ""
+}
+
+func (x *DictionaryEntry) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
+
+func (x *DictionaryEntry) GetTranslation() string {
+	if x != nil {
+		return x.Translation
+	}
+	return ""
+}
+
+var File_lession_v1_interactive_transcript_proto protoreflect.FileDescriptor
+
+const file_lession_v1_interactive_transcript_proto_rawDesc = "" +
+	"\n" +
+	"'lession/v1/interactive_transcript.proto\x12\n" +
+	"lession.v1\x1a\x1egoogle/protobuf/duration.proto\"\xf8\x01\n" +
+	"\x15InteractiveTranscript\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\tepisodeId\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x121\n" +
+	"\x14translation_language\x18\x03 \x01(\tR\x13translationLanguage\x12:\n" +
+	"\bsegments\x18\x04 \x03(\v2\x1e.lession.v1.InteractiveSegmentR\bsegments\x125\n" +
+	"\aentries\x18\x05 \x03(\v2\x1b.lession.v1.DictionaryEntryR\aentries\"\xbd\x01\n" +
+	"\x12InteractiveSegment\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12/\n" +
+	"\x05start\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x05start\x12+\n" +
+	"\x03end\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x03end\x123\n" +
+	"\x06tokens\x18\x04 \x03(\v2\x1b.lession.v1.TranscriptTokenR\x06tokens\"9\n" +
+	"\x0fTranscriptToken\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04word\x18\x02 \x01(\tR\x04word\"\xa3\x01\n" +
+	"\x0fDictionaryEntry\x12\x12\n" +
+	"\x04word\x18\x01 \x01(\tR\x04word\x12\x14\n" +
+	"\x05lemma\x18\x02 \x01(\tR\x05lemma\x12$\n" +
+	"\x0epart_of_speech\x18\x03 \x01(\tR\fpartOfSpeech\x12\x1e\n" +
+	"\n" +
+	"definition\x18\x04 \x01(\tR\n" +
+	"definition\x12 \n" +
+	"\vtranslation\x18\x05 \x01(\tR\vtranslationB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_interactive_transcript_proto_rawDescOnce sync.Once
+	file_lession_v1_interactive_transcript_proto_rawDescData []byte
+)
+
+func file_lession_v1_interactive_transcript_proto_rawDescGZIP() []byte {
+	file_lession_v1_interactive_transcript_proto_rawDescOnce.Do(func() {
+		file_lession_v1_interactive_transcript_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_interactive_transcript_proto_rawDesc), len(file_lession_v1_interactive_transcript_proto_rawDesc)))
+	})
+	return file_lession_v1_interactive_transcript_proto_rawDescData
+}
+
+var file_lession_v1_interactive_transcript_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_lession_v1_interactive_transcript_proto_goTypes = []any{
+	(*InteractiveTranscript)(nil), // 0: lession.v1.InteractiveTranscript
+	(*InteractiveSegment)(nil),    // 1: lession.v1.InteractiveSegment
+	(*TranscriptToken)(nil),       // 2: lession.v1.TranscriptToken
+	(*DictionaryEntry)(nil),       // 3: lession.v1.DictionaryEntry
+	(*durationpb.Duration)(nil),   // 4: google.protobuf.Duration
+}
+var file_lession_v1_interactive_transcript_proto_depIdxs = []int32{
+	1, // 0: lession.v1.InteractiveTranscript.segments:type_name -> lession.v1.InteractiveSegment
+	3, // 1: lession.v1.InteractiveTranscript.entries:type_name -> lession.v1.DictionaryEntry
+	4, // 2: lession.v1.InteractiveSegment.start:type_name -> google.protobuf.Duration
+	4, // 3: lession.v1.InteractiveSegment.end:type_name -> google.protobuf.Duration
+	2, // 4: lession.v1.InteractiveSegment.tokens:type_name -> lession.v1.TranscriptToken
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_interactive_transcript_proto_init() }
+func file_lession_v1_interactive_transcript_proto_init() {
+	if File_lession_v1_interactive_transcript_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_interactive_transcript_proto_rawDesc), len(file_lession_v1_interactive_transcript_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_interactive_transcript_proto_goTypes,
+		DependencyIndexes: file_lession_v1_interactive_transcript_proto_depIdxs,
+		MessageInfos:      file_lession_v1_interactive_transcript_proto_msgTypes,
+	}.Build()
+	File_lession_v1_interactive_transcript_proto = out.File
+	file_lession_v1_interactive_transcript_proto_goTypes = nil
+	file_lession_v1_interactive_transcript_proto_depIdxs = nil
+}
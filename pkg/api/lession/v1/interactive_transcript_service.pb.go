@@ -0,0 +1,195 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/interactive_transcript_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetInteractiveTranscriptRequest selects the episode and translation language.
+type GetInteractiveTranscriptRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// episode_id identifies the episode.
+	EpisodeId string `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// translation_language is the BCP 47 tag to translate words into; none are translated when empty.
+	TranslationLanguage string `protobuf:"bytes,2,opt,name=translation_language,json=translationLanguage,proto3" json:"translation_language,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetInteractiveTranscriptRequest) Reset() {
+	*x = GetInteractiveTranscriptRequest{}
+	mi := &file_lession_v1_interactive_transcript_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInteractiveTranscriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInteractiveTranscriptRequest) ProtoMessage() {}
+
+func (x *GetInteractiveTranscriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_interactive_transcript_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInteractiveTranscriptRequest.ProtoReflect.Descriptor instead.
+func (*GetInteractiveTranscriptRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_interactive_transcript_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetInteractiveTranscriptRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *GetInteractiveTranscriptRequest) GetTranslationLanguage() string {
+	if x != nil {
+		return x.TranslationLanguage
+	}
+	return ""
+}
+
+// GetInteractiveTranscriptResponse returns the prepared transcript.
+type GetInteractiveTranscriptResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// transcript contains the tokens and dictionary entries.
+	Transcript    *InteractiveTranscript `protobuf:"bytes,1,opt,name=transcript,proto3" json:"transcript,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInteractiveTranscriptResponse) Reset() {
+	*x = GetInteractiveTranscriptResponse{}
+	mi := &file_lession_v1_interactive_transcript_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInteractiveTranscriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInteractiveTranscriptResponse) ProtoMessage() {}
+
+func (x *GetInteractiveTranscriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_interactive_transcript_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInteractiveTranscriptResponse.ProtoReflect.Descriptor instead.
+func (*GetInteractiveTranscriptResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_interactive_transcript_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetInteractiveTranscriptResponse) GetTranscript() *InteractiveTranscript {
+	if x != nil {
+		return x.Transcript
+	}
+	return nil
+}
+
+var File_lession_v1_interactive_transcript_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_interactive_transcript_service_proto_rawDesc = "" +
+	"\n" +
+	"/lession/v1/interactive_transcript_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a'lession/v1/interactive_transcript.proto\"\x86\x01\n" +
+	"\x1fGetInteractiveTranscriptRequest\x12'\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tepisodeId\x12:\n" +
+	"\x14translation_language\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18#R\x13translationLanguage\"e\n" +
+	" GetInteractiveTranscriptResponse\x12A\n" +
+	"\n" +
+	"transcript\x18\x01 \x01(\v2!.lession.v1.InteractiveTranscriptR\n" +
+	"transcript2\x95\x01\n" +
+	"\x1cInteractiveTranscriptService\x12u\n" +
+	"\x18GetInteractiveTranscript\x12+.lession.v1.GetInteractiveTranscriptRequest\x1a,.lession.v1.GetInteractiveTranscriptResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_interactive_transcript_service_proto_rawDescOnce sync.Once
+	file_lession_v1_interactive_transcript_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_interactive_transcript_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_interactive_transcript_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_interactive_transcript_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_interactive_transcript_service_proto_rawDesc), len(file_lession_v1_interactive_transcript_service_proto_rawDesc)))
+	})
+	return file_lession_v1_interactive_transcript_service_proto_rawDescData
+}
+
+var file_lession_v1_interactive_transcript_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_lession_v1_interactive_transcript_service_proto_goTypes = []any{
+	(*GetInteractiveTranscriptRequest)(nil),  // 0: lession.v1.GetInteractiveTranscriptRequest
+	(*GetInteractiveTranscriptResponse)(nil), // 1: lession.v1.GetInteractiveTranscriptResponse
+	(*InteractiveTranscript)(nil),            // 2: lession.v1.InteractiveTranscript
+}
+var file_lession_v1_interactive_transcript_service_proto_depIdxs = []int32{
+	2, // 0: lession.v1.GetInteractiveTranscriptResponse.transcript:type_name -> lession.v1.InteractiveTranscript
+	0, // 1: lession.v1.InteractiveTranscriptService.GetInteractiveTranscript:input_type -> lession.v1.GetInteractiveTranscriptRequest
+	1, // 2: lession.v1.InteractiveTranscriptService.GetInteractiveTranscript:output_type -> lession.v1.GetInteractiveTranscriptResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_interactive_transcript_service_proto_init() }
+func file_lession_v1_interactive_transcript_service_proto_init() {
+	if File_lession_v1_interactive_transcript_service_proto != nil {
+		return
+	}
+	file_lession_v1_interactive_transcript_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_interactive_transcript_service_proto_rawDesc), len(file_lession_v1_interactive_transcript_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_interactive_transcript_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_interactive_transcript_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_interactive_transcript_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_interactive_transcript_service_proto = out.File
+	file_lession_v1_interactive_transcript_service_proto_goTypes = nil
+	file_lession_v1_interactive_transcript_service_proto_depIdxs = nil
+}
@@ -0,0 +1,351 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/learner_stats.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LearnerActivity aggregates a learner's practice for a single UTC day.
+type LearnerActivity struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// day is the UTC midnight of the day the activity belongs to.
+	Day *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"`
+	// minutes_listened is the total listening time recorded for the day.
+	MinutesListened int32 `protobuf:"varint,3,opt,name=minutes_listened,json=minutesListened,proto3" json:"minutes_listened,omitempty"`
+	// episodes_completed is the number of episodes finished during the day.
+	EpisodesCompleted int32 `protobuf:"varint,4,opt,name=episodes_completed,json=episodesCompleted,proto3" json:"episodes_completed,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LearnerActivity) Reset() {
+	*x = LearnerActivity{}
+	mi := &file_lession_v1_learner_stats_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LearnerActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LearnerActivity) ProtoMessage() {}
+
+func (x *LearnerActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_learner_stats_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LearnerActivity.ProtoReflect.Descriptor instead.
+func (*LearnerActivity) Descriptor() ([]byte, []int) {
+	return file_lession_v1_learner_stats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LearnerActivity) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LearnerActivity) GetDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *LearnerActivity) GetMinutesListened() int32 {
+	if x != nil {
+		return x.MinutesListened
+	}
+	return 0
+}
+
+func (x *LearnerActivity) GetEpisodesCompleted() int32 {
+	if x != nil {
+		return x.EpisodesCompleted
+	}
+	return 0
+}
+
+// LearnerStats summarizes a learner's lifetime activity and streaks.
+type LearnerStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// current_streak_days counts consecutive active days ending today or yesterday.
+	CurrentStreakDays int32 `protobuf:"varint,2,opt,name=current_streak_days,json=currentStreakDays,proto3" json:"current_streak_days,omitempty"`
+	// longest_streak_days is the longest run of consecutive active days.
+	LongestStreakDays int32 `protobuf:"varint,3,opt,name=longest_streak_days,json=longestStreakDays,proto3" json:"longest_streak_days,omitempty"`
+	// active_days is the number of days with any recorded activity.
+	ActiveDays int32 `protobuf:"varint,4,opt,name=active_days,json=activeDays,proto3" json:"active_days,omitempty"`
+	// total_minutes_listened sums listening time across all days.
+	TotalMinutesListened int32 `protobuf:"varint,5,opt,name=total_minutes_listened,json=totalMinutesListened,proto3" json:"total_minutes_listened,omitempty"`
+	// total_episodes_completed sums completed episodes across all days.
+	TotalEpisodesCompleted int32 `protobuf:"varint,6,opt,name=total_episodes_completed,json=totalEpisodesCompleted,proto3" json:"total_episodes_completed,omitempty"`
+	// last_active_day is the most recent day with recorded activity.
+	LastActiveDay *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_active_day,json=lastActiveDay,proto3" json:"last_active_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LearnerStats) Reset() {
+	*x = LearnerStats{}
+	mi := &file_lession_v1_learner_stats_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LearnerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LearnerStats) ProtoMessage() {}
+
+func (x *LearnerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_learner_stats_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LearnerStats.ProtoReflect.Descriptor instead.
+func (*LearnerStats) Descriptor() ([]byte, []int) {
+	return file_lession_v1_learner_stats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LearnerStats) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LearnerStats) GetCurrentStreakDays() int32 {
+	if x != nil {
+		return x.CurrentStreakDays
+	}
+	return 0
+}
+
+func (x *LearnerStats) GetLongestStreakDays() int32 {
+	if x != nil {
+		return x.LongestStreakDays
+	}
+	return 0
+}
+
+func (x *LearnerStats) GetActiveDays() int32 {
+	if x != nil {
+		return x.ActiveDays
+	}
+	return 0
+}
+
+func (x *LearnerStats) GetTotalMinutesListened() int32 {
+	if x != nil {
+		return x.TotalMinutesListened
+	}
+	return 0
+}
+
+func (x *LearnerStats) GetTotalEpisodesCompleted() int32 {
+	if x != nil {
+		return x.TotalEpisodesCompleted
+	}
+	return 0
+}
+
+func (x *LearnerStats) GetLastActiveDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActiveDay
+	}
+	return nil
+}
+
+// WeeklySummary aggregates learner activity for a Monday-based UTC week.
+type WeeklySummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// week_start is the UTC midnight of the Monday that begins the week.
+	WeekStart *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`
+	// active_days is the number of days in the week with recorded activity.
+	ActiveDays int32 `protobuf:"varint,2,opt,name=active_days,json=activeDays,proto3" json:"active_days,omitempty"`
+	// minutes_listened sums listening time across the week.
+	MinutesListened int32 `protobuf:"varint,3,opt,name=minutes_listened,json=minutesListened,proto3" json:"minutes_listened,omitempty"`
+	// episodes_completed sums completed episodes across the week.
+	EpisodesCompleted int32 `protobuf:"varint,4,opt,name=episodes_completed,json=episodesCompleted,proto3" json:"episodes_completed,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WeeklySummary) Reset() {
+	*x = WeeklySummary{}
+	mi := &file_lession_v1_learner_stats_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeeklySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeeklySummary) ProtoMessage() {}
+
+func (x *WeeklySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_learner_stats_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeeklySummary.ProtoReflect.Descriptor instead.
+func (*WeeklySummary) Descriptor() ([]byte, []int) {
+	return file_lession_v1_learner_stats_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WeeklySummary) GetWeekStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WeekStart
+	}
+	return nil
+}
+
+func (x *WeeklySummary) GetActiveDays() int32 {
+	if x != nil {
+		return x.ActiveDays
+	}
+	return 0
+}
+
+func (x *WeeklySummary) GetMinutesListened() int32 {
+	if x != nil {
+		return x.MinutesListened
+	}
+	return 0
+}
+
+func (x *WeeklySummary) GetEpisodesCompleted() int32 {
+	if x != nil {
+		return x.EpisodesCompleted
+	}
+	return 0
+}
+
+var File_lession_v1_learner_stats_proto protoreflect.FileDescriptor
+
+const file_lession_v1_learner_stats_proto_rawDesc = "" +
+	"\n" +
+	"\x1elession/v1/learner_stats.proto\x12\n" +
+	"lession.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb2\x01\n" +
+	"\x0fLearnerActivity\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12,\n" +
+	"\x03day\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03day\x12)\n" +
+	"\x10minutes_listened\x18\x03 \x01(\x05R\x0fminutesListened\x12-\n" +
+	"\x12episodes_completed\x18\x04 \x01(\x05R\x11episodesCompleted\"\xdc\x02\n" +
+	"\fLearnerStats\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12.\n" +
+	"\x13current_streak_days\x18\x02 \x01(\x05R\x11currentStreakDays\x12.\n" +
+	"\x13longest_streak_days\x18\x03 \x01(\x05R\x11longestStreakDays\x12\x1f\n" +
+	"\vactive_days\x18\x04 \x01(\x05R\n" +
+	"activeDays\x124\n" +
+	"\x16total_minutes_listened\x18\x05 \x01(\x05R\x14totalMinutesListened\x128\n" +
+	"\x18total_episodes_completed\x18\x06 \x01(\x05R\x16totalEpisodesCompleted\x12B\n" +
+	"\x0flast_active_day\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rlastActiveDay\"\xc5\x01\n" +
+	"\rWeeklySummary\x129\n" +
+	"\n" +
+	"week_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tweekStart\x12\x1f\n" +
+	"\vactive_days\x18\x02 \x01(\x05R\n" +
+	"activeDays\x12)\n" +
+	"\x10minutes_listened\x18\x03 \x01(\x05R\x0fminutesListened\x12-\n" +
+	"\x12episodes_completed\x18\x04 \x01(\x05R\x11episodesCompletedB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_learner_stats_proto_rawDescOnce sync.Once
+	file_lession_v1_learner_stats_proto_rawDescData []byte
+)
+
+func file_lession_v1_learner_stats_proto_rawDescGZIP() []byte {
+	file_lession_v1_learner_stats_proto_rawDescOnce.Do(func() {
+		file_lession_v1_learner_stats_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_learner_stats_proto_rawDesc), len(file_lession_v1_learner_stats_proto_rawDesc)))
+	})
+	return file_lession_v1_learner_stats_proto_rawDescData
+}
+
+var file_lession_v1_learner_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_lession_v1_learner_stats_proto_goTypes = []any{
+	(*LearnerActivity)(nil),       // 0: lession.v1.LearnerActivity
+	(*LearnerStats)(nil),          // 1: lession.v1.LearnerStats
+	(*WeeklySummary)(nil),         // 2: lession.v1.WeeklySummary
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_lession_v1_learner_stats_proto_depIdxs = []int32{
+	3, // 0: lession.v1.LearnerActivity.day:type_name -> google.protobuf.Timestamp
+	3, // 1: lession.v1.LearnerStats.last_active_day:type_name -> google.protobuf.Timestamp
+	3, // 2: lession.v1.WeeklySummary.week_start:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_learner_stats_proto_init() }
+func file_lession_v1_learner_stats_proto_init() {
+	if File_lession_v1_learner_stats_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_learner_stats_proto_rawDesc), len(file_lession_v1_learner_stats_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_learner_stats_proto_goTypes,
+		DependencyIndexes: file_lession_v1_learner_stats_proto_depIdxs,
+		MessageInfos:      file_lession_v1_learner_stats_proto_msgTypes,
+	}.Build()
+	File_lession_v1_learner_stats_proto = out.File
+	file_lession_v1_learner_stats_proto_goTypes = nil
+	file_lession_v1_learner_stats_proto_depIdxs = nil
+}
@@ -0,0 +1,432 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/learner_stats_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RecordActivityRequest adds activity to a learner's day.
+type RecordActivityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// occurred_at determines the UTC day the activity counts towards; defaults to now.
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// minutes_listened is the listening time to add.
+	MinutesListened int32 `protobuf:"varint,3,opt,name=minutes_listened,json=minutesListened,proto3" json:"minutes_listened,omitempty"`
+	// episodes_completed is the number of completed episodes to add.
+	EpisodesCompleted int32 `protobuf:"varint,4,opt,name=episodes_completed,json=episodesCompleted,proto3" json:"episodes_completed,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RecordActivityRequest) Reset() {
+	*x = RecordActivityRequest{}
+	mi := &file_lession_v1_learner_stats_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordActivityRequest) ProtoMessage() {}
+
+func (x *RecordActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_learner_stats_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordActivityRequest.ProtoReflect.Descriptor instead.
+func (*RecordActivityRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_learner_stats_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecordActivityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecordActivityRequest) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *RecordActivityRequest) GetMinutesListened() int32 {
+	if x != nil {
+		return x.MinutesListened
+	}
+	return 0
+}
+
+func (x *RecordActivityRequest) GetEpisodesCompleted() int32 {
+	if x != nil {
+		return x.EpisodesCompleted
+	}
+	return 0
+}
+
+// RecordActivityResponse returns the day's accumulated activity.
+type RecordActivityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// activity is the learner's activity for the day after recording.
+	Activity      *LearnerActivity `protobuf:"bytes,1,opt,name=activity,proto3" json:"activity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordActivityResponse) Reset() {
+	*x = RecordActivityResponse{}
+	mi := &file_lession_v1_learner_stats_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordActivityResponse) ProtoMessage() {}
+
+func (x *RecordActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_learner_stats_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordActivityResponse.ProtoReflect.Descriptor instead.
+func (*RecordActivityResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_learner_stats_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RecordActivityResponse) GetActivity() *LearnerActivity {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+// GetLearnerStatsRequest selects the learner whose stats are returned.
+type GetLearnerStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id identifies the learner.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLearnerStatsRequest) Reset() {
+	*x = GetLearnerStatsRequest{}
+	mi := &file_lession_v1_learner_stats_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLearnerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLearnerStatsRequest) ProtoMessage() {}
+
+func (x *GetLearnerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_learner_stats_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLearnerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetLearnerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_learner_stats_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetLearnerStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// GetLearnerStatsResponse returns the learner's stats.
+type GetLearnerStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// stats contains lifetime totals and streaks.
+	Stats         *LearnerStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLearnerStatsResponse) Reset() {
+	*x = GetLearnerStatsResponse{}
+	mi := &file_lession_v1_learner_stats_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLearnerStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLearnerStatsResponse) ProtoMessage() {}
+
+func (x *GetLearnerStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_learner_stats_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLearnerStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetLearnerStatsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_learner_stats_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetLearnerStatsResponse) GetStats() *LearnerStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// ListWeeklySummariesRequest selects the learner and number of weeks to summarize.
+type ListWeeklySummariesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// weeks is the number of recent weeks to return; defaults to 4.
+	Weeks         int32 `protobuf:"varint,2,opt,name=weeks,proto3" json:"weeks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWeeklySummariesRequest) Reset() {
+	*x = ListWeeklySummariesRequest{}
+	mi := &file_lession_v1_learner_stats_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWeeklySummariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWeeklySummariesRequest) ProtoMessage() {}
+
+func (x *ListWeeklySummariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_learner_stats_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWeeklySummariesRequest.ProtoReflect.Descriptor instead.
+func (*ListWeeklySummariesRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_learner_stats_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListWeeklySummariesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListWeeklySummariesRequest) GetWeeks() int32 {
+	if x != nil {
+		return x.Weeks
+	}
+	return 0
+}
+
+// ListWeeklySummariesResponse returns the weekly summaries, newest first.
+type ListWeeklySummariesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// summaries contains one entry per week, including weeks without activity.
+	Summaries     []*WeeklySummary `protobuf:"bytes,1,rep,name=summaries,proto3" json:"summaries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWeeklySummariesResponse) Reset() {
+	*x = ListWeeklySummariesResponse{}
+	mi := &file_lession_v1_learner_stats_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWeeklySummariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWeeklySummariesResponse) ProtoMessage() {}
+
+func (x *ListWeeklySummariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_learner_stats_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWeeklySummariesResponse.ProtoReflect.Descriptor instead.
+func (*ListWeeklySummariesResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_learner_stats_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListWeeklySummariesResponse) GetSummaries() []*WeeklySummary {
+	if x != nil {
+		return x.Summaries
+	}
+	return nil
+}
+
+var File_lession_v1_learner_stats_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_learner_stats_service_proto_rawDesc = "" +
+	"\n" +
+	"&lession/v1/learner_stats_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1elession/v1/learner_stats.proto\"\xe2\x01\n" +
+	"\x15RecordActivityRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\x12;\n" +
+	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x122\n" +
+	"\x10minutes_listened\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x0fminutesListened\x126\n" +
+	"\x12episodes_completed\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x11episodesCompleted\"Q\n" +
+	"\x16RecordActivityResponse\x127\n" +
+	"\bactivity\x18\x01 \x01(\v2\x1b.lession.v1.LearnerActivityR\bactivity\":\n" +
+	"\x16GetLearnerStatsRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\"I\n" +
+	"\x17GetLearnerStatsResponse\x12.\n" +
+	"\x05stats\x18\x01 \x01(\v2\x18.lession.v1.LearnerStatsR\x05stats\"_\n" +
+	"\x1aListWeeklySummariesRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\x12\x1f\n" +
+	"\x05weeks\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x184(\x00R\x05weeks\"V\n" +
+	"\x1bListWeeklySummariesResponse\x127\n" +
+	"\tsummaries\x18\x01 \x03(\v2\x19.lession.v1.WeeklySummaryR\tsummaries2\xb2\x02\n" +
+	"\x13LearnerStatsService\x12W\n" +
+	"\x0eRecordActivity\x12!.lession.v1.RecordActivityRequest\x1a\".lession.v1.RecordActivityResponse\x12Z\n" +
+	"\x0fGetLearnerStats\x12\".lession.v1.GetLearnerStatsRequest\x1a#.lession.v1.GetLearnerStatsResponse\x12f\n" +
+	"\x13ListWeeklySummaries\x12&.lession.v1.ListWeeklySummariesRequest\x1a'.lession.v1.ListWeeklySummariesResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_learner_stats_service_proto_rawDescOnce sync.Once
+	file_lession_v1_learner_stats_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_learner_stats_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_learner_stats_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_learner_stats_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_learner_stats_service_proto_rawDesc), len(file_lession_v1_learner_stats_service_proto_rawDesc)))
+	})
+	return file_lession_v1_learner_stats_service_proto_rawDescData
+}
+
+var file_lession_v1_learner_stats_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_lession_v1_learner_stats_service_proto_goTypes = []any{
+	(*RecordActivityRequest)(nil),       // 0: lession.v1.RecordActivityRequest
+	(*RecordActivityResponse)(nil),      // 1: lession.v1.RecordActivityResponse
+	(*GetLearnerStatsRequest)(nil),      // 2: lession.v1.GetLearnerStatsRequest
+	(*GetLearnerStatsResponse)(nil),     // 3: lession.v1.GetLearnerStatsResponse
+	(*ListWeeklySummariesRequest)(nil),  // 4: lession.v1.ListWeeklySummariesRequest
+	(*ListWeeklySummariesResponse)(nil), // 5: lession.v1.ListWeeklySummariesResponse
+	(*timestamppb.Timestamp)(nil),       // 6: google.protobuf.Timestamp
+	(*LearnerActivity)(nil),             // 7: lession.v1.LearnerActivity
+	(*LearnerStats)(nil),                // 8: lession.v1.LearnerStats
+	(*WeeklySummary)(nil),               // 9: lession.v1.WeeklySummary
+}
+var file_lession_v1_learner_stats_service_proto_depIdxs = []int32{
+	6, // 0: lession.v1.RecordActivityRequest.occurred_at:type_name -> google.protobuf.Timestamp
+	7, // 1: lession.v1.RecordActivityResponse.activity:type_name -> lession.v1.LearnerActivity
+	8, // 2: lession.v1.GetLearnerStatsResponse.stats:type_name -> lession.v1.LearnerStats
+	9, // 3: lession.v1.ListWeeklySummariesResponse.summaries:type_name -> lession.v1.WeeklySummary
+	0, // 4: lession.v1.LearnerStatsService.RecordActivity:input_type -> lession.v1.RecordActivityRequest
+	2, // 5: lession.v1.LearnerStatsService.GetLearnerStats:input_type -> lession.v1.GetLearnerStatsRequest
+	4, // 6: lession.v1.LearnerStatsService.ListWeeklySummaries:input_type -> lession.v1.ListWeeklySummariesRequest
+	1, // 7: lession.v1.LearnerStatsService.RecordActivity:output_type -> lession.v1.RecordActivityResponse
+	3, // 8: lession.v1.LearnerStatsService.GetLearnerStats:output_type -> lession.v1.GetLearnerStatsResponse
+	5, // 9: lession.v1.LearnerStatsService.ListWeeklySummaries:output_type -> lession.v1.ListWeeklySummariesResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_learner_stats_service_proto_init() }
+func file_lession_v1_learner_stats_service_proto_init() {
+	if File_lession_v1_learner_stats_service_proto != nil {
+		return
+	}
+	file_lession_v1_learner_stats_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_learner_stats_service_proto_rawDesc), len(file_lession_v1_learner_stats_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_learner_stats_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_learner_stats_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_learner_stats_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_learner_stats_service_proto = out.File
+	file_lession_v1_learner_stats_service_proto_goTypes = nil
+	file_lession_v1_learner_stats_service_proto_depIdxs = nil
+}
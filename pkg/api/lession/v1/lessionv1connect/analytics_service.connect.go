@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/analytics_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AnalyticsServiceName is the fully-qualified name of the AnalyticsService service.
+	AnalyticsServiceName = "lession.v1.AnalyticsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AnalyticsServiceGetEpisodeAnalyticsProcedure is the fully-qualified name of the
+	// AnalyticsService's GetEpisodeAnalytics RPC.
+	AnalyticsServiceGetEpisodeAnalyticsProcedure = "/lession.v1.AnalyticsService/GetEpisodeAnalytics"
+	// AnalyticsServiceGetSeriesAnalyticsProcedure is the fully-qualified name of the AnalyticsService's
+	// GetSeriesAnalytics RPC.
+	AnalyticsServiceGetSeriesAnalyticsProcedure = "/lession.v1.AnalyticsService/GetSeriesAnalytics"
+	// AnalyticsServiceRollupEngagementProcedure is the fully-qualified name of the AnalyticsService's
+	// RollupEngagement RPC.
+	AnalyticsServiceRollupEngagementProcedure = "/lession.v1.AnalyticsService/RollupEngagement"
+)
+
+// AnalyticsServiceClient is a client for the lession.v1.AnalyticsService service.
+type AnalyticsServiceClient interface {
+	// GetEpisodeAnalytics returns the engagement with an episode.
+	GetEpisodeAnalytics(context.Context, *connect.Request[v1.GetEpisodeAnalyticsRequest]) (*connect.Response[v1.GetEpisodeAnalyticsResponse], error)
+	// GetSeriesAnalytics returns the engagement with a series across its episodes.
+	GetSeriesAnalytics(context.Context, *connect.Request[v1.GetSeriesAnalyticsRequest]) (*connect.Response[v1.GetSeriesAnalyticsResponse], error)
+	// RollupEngagement queues a rollup of a day, on top of the nightly rollups.
+	RollupEngagement(context.Context, *connect.Request[v1.RollupEngagementRequest]) (*connect.Response[v1.RollupEngagementResponse], error)
+}
+
+// NewAnalyticsServiceClient constructs a client for the lession.v1.AnalyticsService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAnalyticsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AnalyticsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	analyticsServiceMethods := v1.File_lession_v1_analytics_service_proto.Services().ByName("AnalyticsService").Methods()
+	return &analyticsServiceClient{
+		getEpisodeAnalytics: connect.NewClient[v1.GetEpisodeAnalyticsRequest, v1.GetEpisodeAnalyticsResponse](
+			httpClient,
+			baseURL+AnalyticsServiceGetEpisodeAnalyticsProcedure,
+			connect.WithSchema(analyticsServiceMethods.ByName("GetEpisodeAnalytics")),
+			connect.WithClientOptions(opts...),
+		),
+		getSeriesAnalytics: connect.NewClient[v1.GetSeriesAnalyticsRequest, v1.GetSeriesAnalyticsResponse](
+			httpClient,
+			baseURL+AnalyticsServiceGetSeriesAnalyticsProcedure,
+			connect.WithSchema(analyticsServiceMethods.ByName("GetSeriesAnalytics")),
+			connect.WithClientOptions(opts...),
+		),
+		rollupEngagement: connect.NewClient[v1.RollupEngagementRequest, v1.RollupEngagementResponse](
+			httpClient,
+			baseURL+AnalyticsServiceRollupEngagementProcedure,
+			connect.WithSchema(analyticsServiceMethods.ByName("RollupEngagement")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// analyticsServiceClient implements AnalyticsServiceClient.
+type analyticsServiceClient struct {
+	getEpisodeAnalytics *connect.Client[v1.GetEpisodeAnalyticsRequest, v1.GetEpisodeAnalyticsResponse]
+	getSeriesAnalytics  *connect.Client[v1.GetSeriesAnalyticsRequest, v1.GetSeriesAnalyticsResponse]
+	rollupEngagement    *connect.Client[v1.RollupEngagementRequest, v1.RollupEngagementResponse]
+}
+
+// GetEpisodeAnalytics calls lession.v1.AnalyticsService.GetEpisodeAnalytics.
+func (c *analyticsServiceClient) GetEpisodeAnalytics(ctx context.Context, req *connect.Request[v1.GetEpisodeAnalyticsRequest]) (*connect.Response[v1.GetEpisodeAnalyticsResponse], error) {
+	return c.getEpisodeAnalytics.CallUnary(ctx, req)
+}
+
+// GetSeriesAnalytics calls lession.v1.AnalyticsService.GetSeriesAnalytics.
+func (c *analyticsServiceClient) GetSeriesAnalytics(ctx context.Context, req *connect.Request[v1.GetSeriesAnalyticsRequest]) (*connect.Response[v1.GetSeriesAnalyticsResponse], error) {
+	return c.getSeriesAnalytics.CallUnary(ctx, req)
+}
+
+// RollupEngagement calls lession.v1.AnalyticsService.RollupEngagement.
+func (c *analyticsServiceClient) RollupEngagement(ctx context.Context, req *connect.Request[v1.RollupEngagementRequest]) (*connect.Response[v1.RollupEngagementResponse], error) {
+	return c.rollupEngagement.CallUnary(ctx, req)
+}
+
+// AnalyticsServiceHandler is an implementation of the lession.v1.AnalyticsService service.
+type AnalyticsServiceHandler interface {
+	// GetEpisodeAnalytics returns the engagement with an episode.
+	GetEpisodeAnalytics(context.Context, *connect.Request[v1.GetEpisodeAnalyticsRequest]) (*connect.Response[v1.GetEpisodeAnalyticsResponse], error)
+	// GetSeriesAnalytics returns the engagement with a series across its episodes.
+	GetSeriesAnalytics(context.Context, *connect.Request[v1.GetSeriesAnalyticsRequest]) (*connect.Response[v1.GetSeriesAnalyticsResponse], error)
+	// RollupEngagement queues a rollup of a day, on top of the nightly rollups.
+	RollupEngagement(context.Context, *connect.Request[v1.RollupEngagementRequest]) (*connect.Response[v1.RollupEngagementResponse], error)
+}
+
+// NewAnalyticsServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAnalyticsServiceHandler(svc AnalyticsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	analyticsServiceMethods := v1.File_lession_v1_analytics_service_proto.Services().ByName("AnalyticsService").Methods()
+	analyticsServiceGetEpisodeAnalyticsHandler := connect.NewUnaryHandler(
+		AnalyticsServiceGetEpisodeAnalyticsProcedure,
+		svc.GetEpisodeAnalytics,
+		connect.WithSchema(analyticsServiceMethods.ByName("GetEpisodeAnalytics")),
+		connect.WithHandlerOptions(opts...),
+	)
+	analyticsServiceGetSeriesAnalyticsHandler := connect.NewUnaryHandler(
+		AnalyticsServiceGetSeriesAnalyticsProcedure,
+		svc.GetSeriesAnalytics,
+		connect.WithSchema(analyticsServiceMethods.ByName("GetSeriesAnalytics")),
+		connect.WithHandlerOptions(opts...),
+	)
+	analyticsServiceRollupEngagementHandler := connect.NewUnaryHandler(
+		AnalyticsServiceRollupEngagementProcedure,
+		svc.RollupEngagement,
+		connect.WithSchema(analyticsServiceMethods.ByName("RollupEngagement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.AnalyticsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AnalyticsServiceGetEpisodeAnalyticsProcedure:
+			analyticsServiceGetEpisodeAnalyticsHandler.ServeHTTP(w, r)
+		case AnalyticsServiceGetSeriesAnalyticsProcedure:
+			analyticsServiceGetSeriesAnalyticsHandler.ServeHTTP(w, r)
+		case AnalyticsServiceRollupEngagementProcedure:
+			analyticsServiceRollupEngagementHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAnalyticsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAnalyticsServiceHandler struct{}
+
+func (UnimplementedAnalyticsServiceHandler) GetEpisodeAnalytics(context.Context, *connect.Request[v1.GetEpisodeAnalyticsRequest]) (*connect.Response[v1.GetEpisodeAnalyticsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.AnalyticsService.GetEpisodeAnalytics is not implemented"))
+}
+
+func (UnimplementedAnalyticsServiceHandler) GetSeriesAnalytics(context.Context, *connect.Request[v1.GetSeriesAnalyticsRequest]) (*connect.Response[v1.GetSeriesAnalyticsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.AnalyticsService.GetSeriesAnalytics is not implemented"))
+}
+
+func (UnimplementedAnalyticsServiceHandler) RollupEngagement(context.Context, *connect.Request[v1.RollupEngagementRequest]) (*connect.Response[v1.RollupEngagementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.AnalyticsService.RollupEngagement is not implemented"))
+}
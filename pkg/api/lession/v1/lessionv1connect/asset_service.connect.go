@@ -38,9 +38,18 @@ const (
 	AssetServiceCreateUploadProcedure = "/lession.v1.AssetService/CreateUpload"
 	// AssetServiceGetUploadProcedure is the fully-qualified name of the AssetService's GetUpload RPC.
 	AssetServiceGetUploadProcedure = "/lession.v1.AssetService/GetUpload"
+	// AssetServiceListUploadsProcedure is the fully-qualified name of the AssetService's ListUploads
+	// RPC.
+	AssetServiceListUploadsProcedure = "/lession.v1.AssetService/ListUploads"
 	// AssetServiceCompleteUploadProcedure is the fully-qualified name of the AssetService's
 	// CompleteUpload RPC.
 	AssetServiceCompleteUploadProcedure = "/lession.v1.AssetService/CompleteUpload"
+	// AssetServiceCancelUploadProcedure is the fully-qualified name of the AssetService's CancelUpload
+	// RPC.
+	AssetServiceCancelUploadProcedure = "/lession.v1.AssetService/CancelUpload"
+	// AssetServiceRegisterExternalAssetProcedure is the fully-qualified name of the AssetService's
+	// RegisterExternalAsset RPC.
+	AssetServiceRegisterExternalAssetProcedure = "/lession.v1.AssetService/RegisterExternalAsset"
 	// AssetServiceGetAssetProcedure is the fully-qualified name of the AssetService's GetAsset RPC.
 	AssetServiceGetAssetProcedure = "/lession.v1.AssetService/GetAsset"
 	// AssetServiceListAssetsProcedure is the fully-qualified name of the AssetService's ListAssets RPC.
@@ -51,6 +60,23 @@ const (
 	// AssetServiceDeleteAssetProcedure is the fully-qualified name of the AssetService's DeleteAsset
 	// RPC.
 	AssetServiceDeleteAssetProcedure = "/lession.v1.AssetService/DeleteAsset"
+	// AssetServiceWatchAssetProcedure is the fully-qualified name of the AssetService's WatchAsset RPC.
+	AssetServiceWatchAssetProcedure = "/lession.v1.AssetService/WatchAsset"
+	// AssetServicePackageAssetProcedure is the fully-qualified name of the AssetService's PackageAsset
+	// RPC.
+	AssetServicePackageAssetProcedure = "/lession.v1.AssetService/PackageAsset"
+	// AssetServiceRetryAssetProcessingProcedure is the fully-qualified name of the AssetService's
+	// RetryAssetProcessing RPC.
+	AssetServiceRetryAssetProcessingProcedure = "/lession.v1.AssetService/RetryAssetProcessing"
+	// AssetServiceReplaceAssetContentProcedure is the fully-qualified name of the AssetService's
+	// ReplaceAssetContent RPC.
+	AssetServiceReplaceAssetContentProcedure = "/lession.v1.AssetService/ReplaceAssetContent"
+	// AssetServiceListAssetVersionsProcedure is the fully-qualified name of the AssetService's
+	// ListAssetVersions RPC.
+	AssetServiceListAssetVersionsProcedure = "/lession.v1.AssetService/ListAssetVersions"
+	// AssetServiceGetAssetUsageProcedure is the fully-qualified name of the AssetService's
+	// GetAssetUsage RPC.
+	AssetServiceGetAssetUsageProcedure = "/lession.v1.AssetService/GetAssetUsage"
 )
 
 // AssetServiceClient is a client for the lession.v1.AssetService service.
@@ -59,8 +85,19 @@ type AssetServiceClient interface {
 	CreateUpload(context.Context, *connect.Request[v1.CreateUploadRequest]) (*connect.Response[v1.CreateUploadResponse], error)
 	// GetUpload retrieves details for an existing upload session.
 	GetUpload(context.Context, *connect.Request[v1.GetUploadRequest]) (*connect.Response[v1.GetUploadResponse], error)
+	// ListUploads enumerates upload sessions, newest first, e.g. to find
+	// uploads that were abandoned.
+	ListUploads(context.Context, *connect.Request[v1.ListUploadsRequest]) (*connect.Response[v1.ListUploadsResponse], error)
 	// CompleteUpload finalizes an upload session and transitions the asset to processing.
 	CompleteUpload(context.Context, *connect.Request[v1.CompleteUploadRequest]) (*connect.Response[v1.CompleteUploadResponse], error)
+	// CancelUpload abandons an upload session that has not completed. The
+	// storage provider releases any partial upload and the PENDING asset
+	// waiting for it is DELETED.
+	CancelUpload(context.Context, *connect.Request[v1.CancelUploadRequest]) (*connect.Response[v1.CancelUploadResponse], error)
+	// RegisterExternalAsset creates a ready asset played from media already
+	// hosted elsewhere, after checking that the URL is reachable and serves
+	// media of the given type.
+	RegisterExternalAsset(context.Context, *connect.Request[v1.RegisterExternalAssetRequest]) (*connect.Response[v1.RegisterExternalAssetResponse], error)
 	// GetAsset returns details for a single managed asset.
 	GetAsset(context.Context, *connect.Request[v1.GetAssetRequest]) (*connect.Response[v1.GetAssetResponse], error)
 	// ListAssets returns a filtered, paginated collection of assets.
@@ -69,6 +106,29 @@ type AssetServiceClient interface {
 	UpdateAsset(context.Context, *connect.Request[v1.UpdateAssetRequest]) (*connect.Response[v1.UpdateAssetResponse], error)
 	// DeleteAsset archives or permanently deletes an asset.
 	DeleteAsset(context.Context, *connect.Request[v1.DeleteAssetRequest]) (*connect.Response[v1.DeleteAssetResponse], error)
+	// WatchAsset streams the asset now and on every status change, ending once
+	// it is READY, FAILED or DELETED.
+	WatchAsset(context.Context, *connect.Request[v1.WatchAssetRequest]) (*connect.ServerStreamForClient[v1.WatchAssetResponse], error)
+	// PackageAsset packages a ready audio asset as HLS again. Assets played in
+	// a series with DRM enabled get a new content key, so this rotates the key.
+	PackageAsset(context.Context, *connect.Request[v1.PackageAssetRequest]) (*connect.Response[v1.PackageAssetResponse], error)
+	// RetryAssetProcessing returns a FAILED asset to READY and reruns the
+	// processing pipeline on its media; subscribers are told the asset is
+	// ready again. Assets that failed with ASSET_FAILURE_CODE_UPLOAD_EXPIRED
+	// never received their media and must be uploaded again.
+	RetryAssetProcessing(context.Context, *connect.Request[v1.RetryAssetProcessingRequest]) (*connect.Response[v1.RetryAssetProcessingResponse], error)
+	// ReplaceAssetContent starts an upload of new content for a READY or FAILED
+	// asset. The asset keeps serving its current content until the upload is
+	// completed with CompleteUpload; the asset then switches to the new content
+	// and the old content is kept as a version, so episodes need not be relinked.
+	ReplaceAssetContent(context.Context, *connect.Request[v1.ReplaceAssetContentRequest]) (*connect.Response[v1.ReplaceAssetContentResponse], error)
+	// ListAssetVersions lists the content an asset was served from before it
+	// was replaced, newest first.
+	ListAssetVersions(context.Context, *connect.Request[v1.ListAssetVersionsRequest]) (*connect.Response[v1.ListAssetVersionsResponse], error)
+	// GetAssetUsage reports, per UTC day, how many playback URLs were handed
+	// to embedded players for the asset and how often its media was downloaded,
+	// so unused media can be found and pruned.
+	GetAssetUsage(context.Context, *connect.Request[v1.GetAssetUsageRequest]) (*connect.Response[v1.GetAssetUsageResponse], error)
 }
 
 // NewAssetServiceClient constructs a client for the lession.v1.AssetService service. By default, it
@@ -94,12 +154,30 @@ func NewAssetServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(assetServiceMethods.ByName("GetUpload")),
 			connect.WithClientOptions(opts...),
 		),
+		listUploads: connect.NewClient[v1.ListUploadsRequest, v1.ListUploadsResponse](
+			httpClient,
+			baseURL+AssetServiceListUploadsProcedure,
+			connect.WithSchema(assetServiceMethods.ByName("ListUploads")),
+			connect.WithClientOptions(opts...),
+		),
 		completeUpload: connect.NewClient[v1.CompleteUploadRequest, v1.CompleteUploadResponse](
 			httpClient,
 			baseURL+AssetServiceCompleteUploadProcedure,
 			connect.WithSchema(assetServiceMethods.ByName("CompleteUpload")),
 			connect.WithClientOptions(opts...),
 		),
+		cancelUpload: connect.NewClient[v1.CancelUploadRequest, v1.CancelUploadResponse](
+			httpClient,
+			baseURL+AssetServiceCancelUploadProcedure,
+			connect.WithSchema(assetServiceMethods.ByName("CancelUpload")),
+			connect.WithClientOptions(opts...),
+		),
+		registerExternalAsset: connect.NewClient[v1.RegisterExternalAssetRequest, v1.RegisterExternalAssetResponse](
+			httpClient,
+			baseURL+AssetServiceRegisterExternalAssetProcedure,
+			connect.WithSchema(assetServiceMethods.ByName("RegisterExternalAsset")),
+			connect.WithClientOptions(opts...),
+		),
 		getAsset: connect.NewClient[v1.GetAssetRequest, v1.GetAssetResponse](
 			httpClient,
 			baseURL+AssetServiceGetAssetProcedure,
@@ -124,18 +202,63 @@ func NewAssetServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(assetServiceMethods.ByName("DeleteAsset")),
 			connect.WithClientOptions(opts...),
 		),
+		watchAsset: connect.NewClient[v1.WatchAssetRequest, v1.WatchAssetResponse](
+			httpClient,
+			baseURL+AssetServiceWatchAssetProcedure,
+			connect.WithSchema(assetServiceMethods.ByName("WatchAsset")),
+			connect.WithClientOptions(opts...),
+		),
+		packageAsset: connect.NewClient[v1.PackageAssetRequest, v1.PackageAssetResponse](
+			httpClient,
+			baseURL+AssetServicePackageAssetProcedure,
+			connect.WithSchema(assetServiceMethods.ByName("PackageAsset")),
+			connect.WithClientOptions(opts...),
+		),
+		retryAssetProcessing: connect.NewClient[v1.RetryAssetProcessingRequest, v1.RetryAssetProcessingResponse](
+			httpClient,
+			baseURL+AssetServiceRetryAssetProcessingProcedure,
+			connect.WithSchema(assetServiceMethods.ByName("RetryAssetProcessing")),
+			connect.WithClientOptions(opts...),
+		),
+		replaceAssetContent: connect.NewClient[v1.ReplaceAssetContentRequest, v1.ReplaceAssetContentResponse](
+			httpClient,
+			baseURL+AssetServiceReplaceAssetContentProcedure,
+			connect.WithSchema(assetServiceMethods.ByName("ReplaceAssetContent")),
+			connect.WithClientOptions(opts...),
+		),
+		listAssetVersions: connect.NewClient[v1.ListAssetVersionsRequest, v1.ListAssetVersionsResponse](
+			httpClient,
+			baseURL+AssetServiceListAssetVersionsProcedure,
+			connect.WithSchema(assetServiceMethods.ByName("ListAssetVersions")),
+			connect.WithClientOptions(opts...),
+		),
+		getAssetUsage: connect.NewClient[v1.GetAssetUsageRequest, v1.GetAssetUsageResponse](
+			httpClient,
+			baseURL+AssetServiceGetAssetUsageProcedure,
+			connect.WithSchema(assetServiceMethods.ByName("GetAssetUsage")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // assetServiceClient implements AssetServiceClient.
 type assetServiceClient struct {
-	createUpload   *connect.Client[v1.CreateUploadRequest, v1.CreateUploadResponse]
-	getUpload      *connect.Client[v1.GetUploadRequest, v1.GetUploadResponse]
-	completeUpload *connect.Client[v1.CompleteUploadRequest, v1.CompleteUploadResponse]
-	getAsset       *connect.Client[v1.GetAssetRequest, v1.GetAssetResponse]
-	listAssets     *connect.Client[v1.ListAssetsRequest, v1.ListAssetsResponse]
-	updateAsset    *connect.Client[v1.UpdateAssetRequest, v1.UpdateAssetResponse]
-	deleteAsset    *connect.Client[v1.DeleteAssetRequest, v1.DeleteAssetResponse]
+	createUpload          *connect.Client[v1.CreateUploadRequest, v1.CreateUploadResponse]
+	getUpload             *connect.Client[v1.GetUploadRequest, v1.GetUploadResponse]
+	listUploads           *connect.Client[v1.ListUploadsRequest, v1.ListUploadsResponse]
+	completeUpload        *connect.Client[v1.CompleteUploadRequest, v1.CompleteUploadResponse]
+	cancelUpload          *connect.Client[v1.CancelUploadRequest, v1.CancelUploadResponse]
+	registerExternalAsset *connect.Client[v1.RegisterExternalAssetRequest, v1.RegisterExternalAssetResponse]
+	getAsset              *connect.Client[v1.GetAssetRequest, v1.GetAssetResponse]
+	listAssets            *connect.Client[v1.ListAssetsRequest, v1.ListAssetsResponse]
+	updateAsset           *connect.Client[v1.UpdateAssetRequest, v1.UpdateAssetResponse]
+	deleteAsset           *connect.Client[v1.DeleteAssetRequest, v1.DeleteAssetResponse]
+	watchAsset            *connect.Client[v1.WatchAssetRequest, v1.WatchAssetResponse]
+	packageAsset          *connect.Client[v1.PackageAssetRequest, v1.PackageAssetResponse]
+	retryAssetProcessing  *connect.Client[v1.RetryAssetProcessingRequest, v1.RetryAssetProcessingResponse]
+	replaceAssetContent   *connect.Client[v1.ReplaceAssetContentRequest, v1.ReplaceAssetContentResponse]
+	listAssetVersions     *connect.Client[v1.ListAssetVersionsRequest, v1.ListAssetVersionsResponse]
+	getAssetUsage         *connect.Client[v1.GetAssetUsageRequest, v1.GetAssetUsageResponse]
 }
 
 // CreateUpload calls lession.v1.AssetService.CreateUpload.
@@ -148,11 +271,26 @@ func (c *assetServiceClient) GetUpload(ctx context.Context, req *connect.Request
 	return c.getUpload.CallUnary(ctx, req)
 }
 
+// ListUploads calls lession.v1.AssetService.ListUploads.
+func (c *assetServiceClient) ListUploads(ctx context.Context, req *connect.Request[v1.ListUploadsRequest]) (*connect.Response[v1.ListUploadsResponse], error) {
+	return c.listUploads.CallUnary(ctx, req)
+}
+
 // CompleteUpload calls lession.v1.AssetService.CompleteUpload.
 func (c *assetServiceClient) CompleteUpload(ctx context.Context, req *connect.Request[v1.CompleteUploadRequest]) (*connect.Response[v1.CompleteUploadResponse], error) {
 	return c.completeUpload.CallUnary(ctx, req)
 }
 
+// CancelUpload calls lession.v1.AssetService.CancelUpload.
+func (c *assetServiceClient) CancelUpload(ctx context.Context, req *connect.Request[v1.CancelUploadRequest]) (*connect.Response[v1.CancelUploadResponse], error) {
+	return c.cancelUpload.CallUnary(ctx, req)
+}
+
+// RegisterExternalAsset calls lession.v1.AssetService.RegisterExternalAsset.
+func (c *assetServiceClient) RegisterExternalAsset(ctx context.Context, req *connect.Request[v1.RegisterExternalAssetRequest]) (*connect.Response[v1.RegisterExternalAssetResponse], error) {
+	return c.registerExternalAsset.CallUnary(ctx, req)
+}
+
 // GetAsset calls lession.v1.AssetService.GetAsset.
 func (c *assetServiceClient) GetAsset(ctx context.Context, req *connect.Request[v1.GetAssetRequest]) (*connect.Response[v1.GetAssetResponse], error) {
 	return c.getAsset.CallUnary(ctx, req)
@@ -173,14 +311,55 @@ func (c *assetServiceClient) DeleteAsset(ctx context.Context, req *connect.Reque
 	return c.deleteAsset.CallUnary(ctx, req)
 }
 
+// WatchAsset calls lession.v1.AssetService.WatchAsset.
+func (c *assetServiceClient) WatchAsset(ctx context.Context, req *connect.Request[v1.WatchAssetRequest]) (*connect.ServerStreamForClient[v1.WatchAssetResponse], error) {
+	return c.watchAsset.CallServerStream(ctx, req)
+}
+
+// PackageAsset calls lession.v1.AssetService.PackageAsset.
+func (c *assetServiceClient) PackageAsset(ctx context.Context, req *connect.Request[v1.PackageAssetRequest]) (*connect.Response[v1.PackageAssetResponse], error) {
+	return c.packageAsset.CallUnary(ctx, req)
+}
+
+// RetryAssetProcessing calls lession.v1.AssetService.RetryAssetProcessing.
+func (c *assetServiceClient) RetryAssetProcessing(ctx context.Context, req *connect.Request[v1.RetryAssetProcessingRequest]) (*connect.Response[v1.RetryAssetProcessingResponse], error) {
+	return c.retryAssetProcessing.CallUnary(ctx, req)
+}
+
+// ReplaceAssetContent calls lession.v1.AssetService.ReplaceAssetContent.
+func (c *assetServiceClient) ReplaceAssetContent(ctx context.Context, req *connect.Request[v1.ReplaceAssetContentRequest]) (*connect.Response[v1.ReplaceAssetContentResponse], error) {
+	return c.replaceAssetContent.CallUnary(ctx, req)
+}
+
+// ListAssetVersions calls lession.v1.AssetService.ListAssetVersions.
+func (c *assetServiceClient) ListAssetVersions(ctx context.Context, req *connect.Request[v1.ListAssetVersionsRequest]) (*connect.Response[v1.ListAssetVersionsResponse], error) {
+	return c.listAssetVersions.CallUnary(ctx, req)
+}
+
+// GetAssetUsage calls lession.v1.AssetService.GetAssetUsage.
+func (c *assetServiceClient) GetAssetUsage(ctx context.Context, req *connect.Request[v1.GetAssetUsageRequest]) (*connect.Response[v1.GetAssetUsageResponse], error) {
+	return c.getAssetUsage.CallUnary(ctx, req)
+}
+
 // AssetServiceHandler is an implementation of the lession.v1.AssetService service.
 type AssetServiceHandler interface {
 	// CreateUpload establishes a new upload session and returns client instructions.
 	CreateUpload(context.Context, *connect.Request[v1.CreateUploadRequest]) (*connect.Response[v1.CreateUploadResponse], error)
 	// GetUpload retrieves details for an existing upload session.
 	GetUpload(context.Context, *connect.Request[v1.GetUploadRequest]) (*connect.Response[v1.GetUploadResponse], error)
+	// ListUploads enumerates upload sessions, newest first, e.g. to find
+	// uploads that were abandoned.
+	ListUploads(context.Context, *connect.Request[v1.ListUploadsRequest]) (*connect.Response[v1.ListUploadsResponse], error)
 	// CompleteUpload finalizes an upload session and transitions the asset to processing.
 	CompleteUpload(context.Context, *connect.Request[v1.CompleteUploadRequest]) (*connect.Response[v1.CompleteUploadResponse], error)
+	// CancelUpload abandons an upload session that has not completed. The
+	// storage provider releases any partial upload and the PENDING asset
+	// waiting for it is DELETED.
+	CancelUpload(context.Context, *connect.Request[v1.CancelUploadRequest]) (*connect.Response[v1.CancelUploadResponse], error)
+	// RegisterExternalAsset creates a ready asset played from media already
+	// hosted elsewhere, after checking that the URL is reachable and serves
+	// media of the given type.
+	RegisterExternalAsset(context.Context, *connect.Request[v1.RegisterExternalAssetRequest]) (*connect.Response[v1.RegisterExternalAssetResponse], error)
 	// GetAsset returns details for a single managed asset.
 	GetAsset(context.Context, *connect.Request[v1.GetAssetRequest]) (*connect.Response[v1.GetAssetResponse], error)
 	// ListAssets returns a filtered, paginated collection of assets.
@@ -189,6 +368,29 @@ type AssetServiceHandler interface {
 	UpdateAsset(context.Context, *connect.Request[v1.UpdateAssetRequest]) (*connect.Response[v1.UpdateAssetResponse], error)
 	// DeleteAsset archives or permanently deletes an asset.
 	DeleteAsset(context.Context, *connect.Request[v1.DeleteAssetRequest]) (*connect.Response[v1.DeleteAssetResponse], error)
+	// WatchAsset streams the asset now and on every status change, ending once
+	// it is READY, FAILED or DELETED.
+	WatchAsset(context.Context, *connect.Request[v1.WatchAssetRequest], *connect.ServerStream[v1.WatchAssetResponse]) error
+	// PackageAsset packages a ready audio asset as HLS again. Assets played in
+	// a series with DRM enabled get a new content key, so this rotates the key.
+	PackageAsset(context.Context, *connect.Request[v1.PackageAssetRequest]) (*connect.Response[v1.PackageAssetResponse], error)
+	// RetryAssetProcessing returns a FAILED asset to READY and reruns the
+	// processing pipeline on its media; subscribers are told the asset is
+	// ready again. Assets that failed with ASSET_FAILURE_CODE_UPLOAD_EXPIRED
+	// never received their media and must be uploaded again.
+	RetryAssetProcessing(context.Context, *connect.Request[v1.RetryAssetProcessingRequest]) (*connect.Response[v1.RetryAssetProcessingResponse], error)
+	// ReplaceAssetContent starts an upload of new content for a READY or FAILED
+	// asset. The asset keeps serving its current content until the upload is
+	// completed with CompleteUpload; the asset then switches to the new content
+	// and the old content is kept as a version, so episodes need not be relinked.
+	ReplaceAssetContent(context.Context, *connect.Request[v1.ReplaceAssetContentRequest]) (*connect.Response[v1.ReplaceAssetContentResponse], error)
+	// ListAssetVersions lists the content an asset was served from before it
+	// was replaced, newest first.
+	ListAssetVersions(context.Context, *connect.Request[v1.ListAssetVersionsRequest]) (*connect.Response[v1.ListAssetVersionsResponse], error)
+	// GetAssetUsage reports, per UTC day, how many playback URLs were handed
+	// to embedded players for the asset and how often its media was downloaded,
+	// so unused media can be found and pruned.
+	GetAssetUsage(context.Context, *connect.Request[v1.GetAssetUsageRequest]) (*connect.Response[v1.GetAssetUsageResponse], error)
 }
 
 // NewAssetServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -210,12 +412,30 @@ func NewAssetServiceHandler(svc AssetServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(assetServiceMethods.ByName("GetUpload")),
 		connect.WithHandlerOptions(opts...),
 	)
+	assetServiceListUploadsHandler := connect.NewUnaryHandler(
+		AssetServiceListUploadsProcedure,
+		svc.ListUploads,
+		connect.WithSchema(assetServiceMethods.ByName("ListUploads")),
+		connect.WithHandlerOptions(opts...),
+	)
 	assetServiceCompleteUploadHandler := connect.NewUnaryHandler(
 		AssetServiceCompleteUploadProcedure,
 		svc.CompleteUpload,
 		connect.WithSchema(assetServiceMethods.ByName("CompleteUpload")),
 		connect.WithHandlerOptions(opts...),
 	)
+	assetServiceCancelUploadHandler := connect.NewUnaryHandler(
+		AssetServiceCancelUploadProcedure,
+		svc.CancelUpload,
+		connect.WithSchema(assetServiceMethods.ByName("CancelUpload")),
+		connect.WithHandlerOptions(opts...),
+	)
+	assetServiceRegisterExternalAssetHandler := connect.NewUnaryHandler(
+		AssetServiceRegisterExternalAssetProcedure,
+		svc.RegisterExternalAsset,
+		connect.WithSchema(assetServiceMethods.ByName("RegisterExternalAsset")),
+		connect.WithHandlerOptions(opts...),
+	)
 	assetServiceGetAssetHandler := connect.NewUnaryHandler(
 		AssetServiceGetAssetProcedure,
 		svc.GetAsset,
@@ -240,14 +460,56 @@ func NewAssetServiceHandler(svc AssetServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(assetServiceMethods.ByName("DeleteAsset")),
 		connect.WithHandlerOptions(opts...),
 	)
+	assetServiceWatchAssetHandler := connect.NewServerStreamHandler(
+		AssetServiceWatchAssetProcedure,
+		svc.WatchAsset,
+		connect.WithSchema(assetServiceMethods.ByName("WatchAsset")),
+		connect.WithHandlerOptions(opts...),
+	)
+	assetServicePackageAssetHandler := connect.NewUnaryHandler(
+		AssetServicePackageAssetProcedure,
+		svc.PackageAsset,
+		connect.WithSchema(assetServiceMethods.ByName("PackageAsset")),
+		connect.WithHandlerOptions(opts...),
+	)
+	assetServiceRetryAssetProcessingHandler := connect.NewUnaryHandler(
+		AssetServiceRetryAssetProcessingProcedure,
+		svc.RetryAssetProcessing,
+		connect.WithSchema(assetServiceMethods.ByName("RetryAssetProcessing")),
+		connect.WithHandlerOptions(opts...),
+	)
+	assetServiceReplaceAssetContentHandler := connect.NewUnaryHandler(
+		AssetServiceReplaceAssetContentProcedure,
+		svc.ReplaceAssetContent,
+		connect.WithSchema(assetServiceMethods.ByName("ReplaceAssetContent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	assetServiceListAssetVersionsHandler := connect.NewUnaryHandler(
+		AssetServiceListAssetVersionsProcedure,
+		svc.ListAssetVersions,
+		connect.WithSchema(assetServiceMethods.ByName("ListAssetVersions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	assetServiceGetAssetUsageHandler := connect.NewUnaryHandler(
+		AssetServiceGetAssetUsageProcedure,
+		svc.GetAssetUsage,
+		connect.WithSchema(assetServiceMethods.ByName("GetAssetUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/lession.v1.AssetService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AssetServiceCreateUploadProcedure:
 			assetServiceCreateUploadHandler.ServeHTTP(w, r)
 		case AssetServiceGetUploadProcedure:
 			assetServiceGetUploadHandler.ServeHTTP(w, r)
+		case AssetServiceListUploadsProcedure:
+			assetServiceListUploadsHandler.ServeHTTP(w, r)
 		case AssetServiceCompleteUploadProcedure:
 			assetServiceCompleteUploadHandler.ServeHTTP(w, r)
+		case AssetServiceCancelUploadProcedure:
+			assetServiceCancelUploadHandler.ServeHTTP(w, r)
+		case AssetServiceRegisterExternalAssetProcedure:
+			assetServiceRegisterExternalAssetHandler.ServeHTTP(w, r)
 		case AssetServiceGetAssetProcedure:
 			assetServiceGetAssetHandler.ServeHTTP(w, r)
 		case AssetServiceListAssetsProcedure:
@@ -256,6 +518,18 @@ func NewAssetServiceHandler(svc AssetServiceHandler, opts ...connect.HandlerOpti
 			assetServiceUpdateAssetHandler.ServeHTTP(w, r)
 		case AssetServiceDeleteAssetProcedure:
 			assetServiceDeleteAssetHandler.ServeHTTP(w, r)
+		case AssetServiceWatchAssetProcedure:
+			assetServiceWatchAssetHandler.ServeHTTP(w, r)
+		case AssetServicePackageAssetProcedure:
+			assetServicePackageAssetHandler.ServeHTTP(w, r)
+		case AssetServiceRetryAssetProcessingProcedure:
+			assetServiceRetryAssetProcessingHandler.ServeHTTP(w, r)
+		case AssetServiceReplaceAssetContentProcedure:
+			assetServiceReplaceAssetContentHandler.ServeHTTP(w, r)
+		case AssetServiceListAssetVersionsProcedure:
+			assetServiceListAssetVersionsHandler.ServeHTTP(w, r)
+		case AssetServiceGetAssetUsageProcedure:
+			assetServiceGetAssetUsageHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -273,10 +547,22 @@ func (UnimplementedAssetServiceHandler) GetUpload(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.AssetService.GetUpload is not implemented"))
 }
 
+func (UnimplementedAssetServiceHandler) ListUploads(context.Context, *connect.Request[v1.ListUploadsRequest]) (*connect.Response[v1.ListUploadsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.AssetService.ListUploads is not implemented"))
+}
+
 func (UnimplementedAssetServiceHandler) CompleteUpload(context.Context, *connect.Request[v1.CompleteUploadRequest]) (*connect.Response[v1.CompleteUploadResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.AssetService.CompleteUpload is not implemented"))
 }
 
+func (UnimplementedAssetServiceHandler) CancelUpload(context.Context, *connect.Request[v1.CancelUploadRequest]) (*connect.Response[v1.CancelUploadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.AssetService.CancelUpload is not implemented"))
+}
+
+func (UnimplementedAssetServiceHandler) RegisterExternalAsset(context.Context, *connect.Request[v1.RegisterExternalAssetRequest]) (*connect.Response[v1.RegisterExternalAssetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.AssetService.RegisterExternalAsset is not implemented"))
+}
+
 func (UnimplementedAssetServiceHandler) GetAsset(context.Context, *connect.Request[v1.GetAssetRequest]) (*connect.Response[v1.GetAssetResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.AssetService.GetAsset is not implemented"))
 }
@@ -292,3 +578,27 @@ func (UnimplementedAssetServiceHandler) UpdateAsset(context.Context, *connect.Re
 func (UnimplementedAssetServiceHandler) DeleteAsset(context.Context, *connect.Request[v1.DeleteAssetRequest]) (*connect.Response[v1.DeleteAssetResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.AssetService.DeleteAsset is not implemented"))
 }
+
+func (UnimplementedAssetServiceHandler) WatchAsset(context.Context, *connect.Request[v1.WatchAssetRequest], *connect.ServerStream[v1.WatchAssetResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.AssetService.WatchAsset is not implemented"))
+}
+
+func (UnimplementedAssetServiceHandler) PackageAsset(context.Context, *connect.Request[v1.PackageAssetRequest]) (*connect.Response[v1.PackageAssetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.AssetService.PackageAsset is not implemented"))
+}
+
+func (UnimplementedAssetServiceHandler) RetryAssetProcessing(context.Context, *connect.Request[v1.RetryAssetProcessingRequest]) (*connect.Response[v1.RetryAssetProcessingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.AssetService.RetryAssetProcessing is not implemented"))
+}
+
+func (UnimplementedAssetServiceHandler) ReplaceAssetContent(context.Context, *connect.Request[v1.ReplaceAssetContentRequest]) (*connect.Response[v1.ReplaceAssetContentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.AssetService.ReplaceAssetContent is not implemented"))
+}
+
+func (UnimplementedAssetServiceHandler) ListAssetVersions(context.Context, *connect.Request[v1.ListAssetVersionsRequest]) (*connect.Response[v1.ListAssetVersionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.AssetService.ListAssetVersions is not implemented"))
+}
+
+func (UnimplementedAssetServiceHandler) GetAssetUsage(context.Context, *connect.Request[v1.GetAssetUsageRequest]) (*connect.Response[v1.GetAssetUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.AssetService.GetAssetUsage is not implemented"))
+}
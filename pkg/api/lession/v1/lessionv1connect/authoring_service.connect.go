@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/authoring_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AuthoringServiceName is the fully-qualified name of the AuthoringService service.
+	AuthoringServiceName = "lession.v1.AuthoringService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AuthoringServiceGenerateEpisodeDraftProcedure is the fully-qualified name of the
+	// AuthoringService's GenerateEpisodeDraft RPC.
+	AuthoringServiceGenerateEpisodeDraftProcedure = "/lession.v1.AuthoringService/GenerateEpisodeDraft"
+)
+
+// AuthoringServiceClient is a client for the lession.v1.AuthoringService service.
+type AuthoringServiceClient interface {
+	// GenerateEpisodeDraft drafts a script, summary, vocabulary list and quiz for review.
+	// Nothing is saved; tokens are metered against the tenant of the calling API key.
+	GenerateEpisodeDraft(context.Context, *connect.Request[v1.GenerateEpisodeDraftRequest]) (*connect.Response[v1.GenerateEpisodeDraftResponse], error)
+}
+
+// NewAuthoringServiceClient constructs a client for the lession.v1.AuthoringService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAuthoringServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AuthoringServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	authoringServiceMethods := v1.File_lession_v1_authoring_service_proto.Services().ByName("AuthoringService").Methods()
+	return &authoringServiceClient{
+		generateEpisodeDraft: connect.NewClient[v1.GenerateEpisodeDraftRequest, v1.GenerateEpisodeDraftResponse](
+			httpClient,
+			baseURL+AuthoringServiceGenerateEpisodeDraftProcedure,
+			connect.WithSchema(authoringServiceMethods.ByName("GenerateEpisodeDraft")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// authoringServiceClient implements AuthoringServiceClient.
+type authoringServiceClient struct {
+	generateEpisodeDraft *connect.Client[v1.GenerateEpisodeDraftRequest, v1.GenerateEpisodeDraftResponse]
+}
+
+// GenerateEpisodeDraft calls lession.v1.AuthoringService.GenerateEpisodeDraft.
+func (c *authoringServiceClient) GenerateEpisodeDraft(ctx context.Context, req *connect.Request[v1.GenerateEpisodeDraftRequest]) (*connect.Response[v1.GenerateEpisodeDraftResponse], error) {
+	return c.generateEpisodeDraft.CallUnary(ctx, req)
+}
+
+// AuthoringServiceHandler is an implementation of the lession.v1.AuthoringService service.
+type AuthoringServiceHandler interface {
+	// GenerateEpisodeDraft drafts a script, summary, vocabulary list and quiz for review.
+	// Nothing is saved; tokens are metered against the tenant of the calling API key.
+	GenerateEpisodeDraft(context.Context, *connect.Request[v1.GenerateEpisodeDraftRequest]) (*connect.Response[v1.GenerateEpisodeDraftResponse], error)
+}
+
+// NewAuthoringServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAuthoringServiceHandler(svc AuthoringServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	authoringServiceMethods := v1.File_lession_v1_authoring_service_proto.Services().ByName("AuthoringService").Methods()
+	authoringServiceGenerateEpisodeDraftHandler := connect.NewUnaryHandler(
+		AuthoringServiceGenerateEpisodeDraftProcedure,
+		svc.GenerateEpisodeDraft,
+		connect.WithSchema(authoringServiceMethods.ByName("GenerateEpisodeDraft")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.AuthoringService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AuthoringServiceGenerateEpisodeDraftProcedure:
+			authoringServiceGenerateEpisodeDraftHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAuthoringServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAuthoringServiceHandler struct{}
+
+func (UnimplementedAuthoringServiceHandler) GenerateEpisodeDraft(context.Context, *connect.Request[v1.GenerateEpisodeDraftRequest]) (*connect.Response[v1.GenerateEpisodeDraftResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.AuthoringService.GenerateEpisodeDraft is not implemented"))
+}
@@ -0,0 +1,296 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/booking_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// BookingServiceName is the fully-qualified name of the BookingService service.
+	BookingServiceName = "lession.v1.BookingService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// BookingServiceAddAvailabilityProcedure is the fully-qualified name of the BookingService's
+	// AddAvailability RPC.
+	BookingServiceAddAvailabilityProcedure = "/lession.v1.BookingService/AddAvailability"
+	// BookingServiceRemoveAvailabilityProcedure is the fully-qualified name of the BookingService's
+	// RemoveAvailability RPC.
+	BookingServiceRemoveAvailabilityProcedure = "/lession.v1.BookingService/RemoveAvailability"
+	// BookingServiceListAvailabilityProcedure is the fully-qualified name of the BookingService's
+	// ListAvailability RPC.
+	BookingServiceListAvailabilityProcedure = "/lession.v1.BookingService/ListAvailability"
+	// BookingServiceBookSlotProcedure is the fully-qualified name of the BookingService's BookSlot RPC.
+	BookingServiceBookSlotProcedure = "/lession.v1.BookingService/BookSlot"
+	// BookingServiceGetBookingProcedure is the fully-qualified name of the BookingService's GetBooking
+	// RPC.
+	BookingServiceGetBookingProcedure = "/lession.v1.BookingService/GetBooking"
+	// BookingServiceCancelBookingProcedure is the fully-qualified name of the BookingService's
+	// CancelBooking RPC.
+	BookingServiceCancelBookingProcedure = "/lession.v1.BookingService/CancelBooking"
+	// BookingServiceListBookingsProcedure is the fully-qualified name of the BookingService's
+	// ListBookings RPC.
+	BookingServiceListBookingsProcedure = "/lession.v1.BookingService/ListBookings"
+)
+
+// BookingServiceClient is a client for the lession.v1.BookingService service.
+type BookingServiceClient interface {
+	// AddAvailability offers slots; they must not overlap the teacher's existing slots.
+	AddAvailability(context.Context, *connect.Request[v1.AddAvailabilityRequest]) (*connect.Response[v1.AddAvailabilityResponse], error)
+	// RemoveAvailability withdraws a slot that is not booked.
+	RemoveAvailability(context.Context, *connect.Request[v1.RemoveAvailabilityRequest]) (*connect.Response[v1.RemoveAvailabilityResponse], error)
+	// ListAvailability returns open slots in a time range, earliest first.
+	ListAvailability(context.Context, *connect.Request[v1.ListAvailabilityRequest]) (*connect.Response[v1.ListAvailabilityResponse], error)
+	// BookSlot reserves a slot for a learner.
+	BookSlot(context.Context, *connect.Request[v1.BookSlotRequest]) (*connect.Response[v1.BookSlotResponse], error)
+	// GetBooking returns a single booking by identifier.
+	GetBooking(context.Context, *connect.Request[v1.GetBookingRequest]) (*connect.Response[v1.GetBookingResponse], error)
+	// CancelBooking cancels a booking and offers its slot again.
+	CancelBooking(context.Context, *connect.Request[v1.CancelBookingRequest]) (*connect.Response[v1.CancelBookingResponse], error)
+	// ListBookings returns bookings, earliest first.
+	ListBookings(context.Context, *connect.Request[v1.ListBookingsRequest]) (*connect.Response[v1.ListBookingsResponse], error)
+}
+
+// NewBookingServiceClient constructs a client for the lession.v1.BookingService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewBookingServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) BookingServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	bookingServiceMethods := v1.File_lession_v1_booking_service_proto.Services().ByName("BookingService").Methods()
+	return &bookingServiceClient{
+		addAvailability: connect.NewClient[v1.AddAvailabilityRequest, v1.AddAvailabilityResponse](
+			httpClient,
+			baseURL+BookingServiceAddAvailabilityProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("AddAvailability")),
+			connect.WithClientOptions(opts...),
+		),
+		removeAvailability: connect.NewClient[v1.RemoveAvailabilityRequest, v1.RemoveAvailabilityResponse](
+			httpClient,
+			baseURL+BookingServiceRemoveAvailabilityProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("RemoveAvailability")),
+			connect.WithClientOptions(opts...),
+		),
+		listAvailability: connect.NewClient[v1.ListAvailabilityRequest, v1.ListAvailabilityResponse](
+			httpClient,
+			baseURL+BookingServiceListAvailabilityProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("ListAvailability")),
+			connect.WithClientOptions(opts...),
+		),
+		bookSlot: connect.NewClient[v1.BookSlotRequest, v1.BookSlotResponse](
+			httpClient,
+			baseURL+BookingServiceBookSlotProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("BookSlot")),
+			connect.WithClientOptions(opts...),
+		),
+		getBooking: connect.NewClient[v1.GetBookingRequest, v1.GetBookingResponse](
+			httpClient,
+			baseURL+BookingServiceGetBookingProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("GetBooking")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelBooking: connect.NewClient[v1.CancelBookingRequest, v1.CancelBookingResponse](
+			httpClient,
+			baseURL+BookingServiceCancelBookingProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("CancelBooking")),
+			connect.WithClientOptions(opts...),
+		),
+		listBookings: connect.NewClient[v1.ListBookingsRequest, v1.ListBookingsResponse](
+			httpClient,
+			baseURL+BookingServiceListBookingsProcedure,
+			connect.WithSchema(bookingServiceMethods.ByName("ListBookings")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// bookingServiceClient implements BookingServiceClient.
+type bookingServiceClient struct {
+	addAvailability    *connect.Client[v1.AddAvailabilityRequest, v1.AddAvailabilityResponse]
+	removeAvailability *connect.Client[v1.RemoveAvailabilityRequest, v1.RemoveAvailabilityResponse]
+	listAvailability   *connect.Client[v1.ListAvailabilityRequest, v1.ListAvailabilityResponse]
+	bookSlot           *connect.Client[v1.BookSlotRequest, v1.BookSlotResponse]
+	getBooking         *connect.Client[v1.GetBookingRequest, v1.GetBookingResponse]
+	cancelBooking      *connect.Client[v1.CancelBookingRequest, v1.CancelBookingResponse]
+	listBookings       *connect.Client[v1.ListBookingsRequest, v1.ListBookingsResponse]
+}
+
+// AddAvailability calls lession.v1.BookingService.AddAvailability.
+func (c *bookingServiceClient) AddAvailability(ctx context.Context, req *connect.Request[v1.AddAvailabilityRequest]) (*connect.Response[v1.AddAvailabilityResponse], error) {
+	return c.addAvailability.CallUnary(ctx, req)
+}
+
+// RemoveAvailability calls lession.v1.BookingService.RemoveAvailability.
+func (c *bookingServiceClient) RemoveAvailability(ctx context.Context, req *connect.Request[v1.RemoveAvailabilityRequest]) (*connect.Response[v1.RemoveAvailabilityResponse], error) {
+	return c.removeAvailability.CallUnary(ctx, req)
+}
+
+// ListAvailability calls lession.v1.BookingService.ListAvailability.
+func (c *bookingServiceClient) ListAvailability(ctx context.Context, req *connect.Request[v1.ListAvailabilityRequest]) (*connect.Response[v1.ListAvailabilityResponse], error) {
+	return c.listAvailability.CallUnary(ctx, req)
+}
+
+// BookSlot calls lession.v1.BookingService.BookSlot.
+func (c *bookingServiceClient) BookSlot(ctx context.Context, req *connect.Request[v1.BookSlotRequest]) (*connect.Response[v1.BookSlotResponse], error) {
+	return c.bookSlot.CallUnary(ctx, req)
+}
+
+// GetBooking calls lession.v1.BookingService.GetBooking.
+func (c *bookingServiceClient) GetBooking(ctx context.Context, req *connect.Request[v1.GetBookingRequest]) (*connect.Response[v1.GetBookingResponse], error) {
+	return c.getBooking.CallUnary(ctx, req)
+}
+
+// CancelBooking calls lession.v1.BookingService.CancelBooking.
+func (c *bookingServiceClient) CancelBooking(ctx context.Context, req *connect.Request[v1.CancelBookingRequest]) (*connect.Response[v1.CancelBookingResponse], error) {
+	return c.cancelBooking.CallUnary(ctx, req)
+}
+
+// ListBookings calls lession.v1.BookingService.ListBookings.
+func (c *bookingServiceClient) ListBookings(ctx context.Context, req *connect.Request[v1.ListBookingsRequest]) (*connect.Response[v1.ListBookingsResponse], error) {
+	return c.listBookings.CallUnary(ctx, req)
+}
+
+// BookingServiceHandler is an implementation of the lession.v1.BookingService service.
+type BookingServiceHandler interface {
+	// AddAvailability offers slots; they must not overlap the teacher's existing slots.
+	AddAvailability(context.Context, *connect.Request[v1.AddAvailabilityRequest]) (*connect.Response[v1.AddAvailabilityResponse], error)
+	// RemoveAvailability withdraws a slot that is not booked.
+	RemoveAvailability(context.Context, *connect.Request[v1.RemoveAvailabilityRequest]) (*connect.Response[v1.RemoveAvailabilityResponse], error)
+	// ListAvailability returns open slots in a time range, earliest first.
+	ListAvailability(context.Context, *connect.Request[v1.ListAvailabilityRequest]) (*connect.Response[v1.ListAvailabilityResponse], error)
+	// BookSlot reserves a slot for a learner.
+	BookSlot(context.Context, *connect.Request[v1.BookSlotRequest]) (*connect.Response[v1.BookSlotResponse], error)
+	// GetBooking returns a single booking by identifier.
+	GetBooking(context.Context, *connect.Request[v1.GetBookingRequest]) (*connect.Response[v1.GetBookingResponse], error)
+	// CancelBooking cancels a booking and offers its slot again.
+	CancelBooking(context.Context, *connect.Request[v1.CancelBookingRequest]) (*connect.Response[v1.CancelBookingResponse], error)
+	// ListBookings returns bookings, earliest first.
+	ListBookings(context.Context, *connect.Request[v1.ListBookingsRequest]) (*connect.Response[v1.ListBookingsResponse], error)
+}
+
+// NewBookingServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewBookingServiceHandler(svc BookingServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	bookingServiceMethods := v1.File_lession_v1_booking_service_proto.Services().ByName("BookingService").Methods()
+	bookingServiceAddAvailabilityHandler := connect.NewUnaryHandler(
+		BookingServiceAddAvailabilityProcedure,
+		svc.AddAvailability,
+		connect.WithSchema(bookingServiceMethods.ByName("AddAvailability")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceRemoveAvailabilityHandler := connect.NewUnaryHandler(
+		BookingServiceRemoveAvailabilityProcedure,
+		svc.RemoveAvailability,
+		connect.WithSchema(bookingServiceMethods.ByName("RemoveAvailability")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceListAvailabilityHandler := connect.NewUnaryHandler(
+		BookingServiceListAvailabilityProcedure,
+		svc.ListAvailability,
+		connect.WithSchema(bookingServiceMethods.ByName("ListAvailability")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceBookSlotHandler := connect.NewUnaryHandler(
+		BookingServiceBookSlotProcedure,
+		svc.BookSlot,
+		connect.WithSchema(bookingServiceMethods.ByName("BookSlot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceGetBookingHandler := connect.NewUnaryHandler(
+		BookingServiceGetBookingProcedure,
+		svc.GetBooking,
+		connect.WithSchema(bookingServiceMethods.ByName("GetBooking")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceCancelBookingHandler := connect.NewUnaryHandler(
+		BookingServiceCancelBookingProcedure,
+		svc.CancelBooking,
+		connect.WithSchema(bookingServiceMethods.ByName("CancelBooking")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bookingServiceListBookingsHandler := connect.NewUnaryHandler(
+		BookingServiceListBookingsProcedure,
+		svc.ListBookings,
+		connect.WithSchema(bookingServiceMethods.ByName("ListBookings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.BookingService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case BookingServiceAddAvailabilityProcedure:
+			bookingServiceAddAvailabilityHandler.ServeHTTP(w, r)
+		case BookingServiceRemoveAvailabilityProcedure:
+			bookingServiceRemoveAvailabilityHandler.ServeHTTP(w, r)
+		case BookingServiceListAvailabilityProcedure:
+			bookingServiceListAvailabilityHandler.ServeHTTP(w, r)
+		case BookingServiceBookSlotProcedure:
+			bookingServiceBookSlotHandler.ServeHTTP(w, r)
+		case BookingServiceGetBookingProcedure:
+			bookingServiceGetBookingHandler.ServeHTTP(w, r)
+		case BookingServiceCancelBookingProcedure:
+			bookingServiceCancelBookingHandler.ServeHTTP(w, r)
+		case BookingServiceListBookingsProcedure:
+			bookingServiceListBookingsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedBookingServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedBookingServiceHandler struct{}
+
+func (UnimplementedBookingServiceHandler) AddAvailability(context.Context, *connect.Request[v1.AddAvailabilityRequest]) (*connect.Response[v1.AddAvailabilityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.BookingService.AddAvailability is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) RemoveAvailability(context.Context, *connect.Request[v1.RemoveAvailabilityRequest]) (*connect.Response[v1.RemoveAvailabilityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.BookingService.RemoveAvailability is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) ListAvailability(context.Context, *connect.Request[v1.ListAvailabilityRequest]) (*connect.Response[v1.ListAvailabilityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.BookingService.ListAvailability is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) BookSlot(context.Context, *connect.Request[v1.BookSlotRequest]) (*connect.Response[v1.BookSlotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.BookingService.BookSlot is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) GetBooking(context.Context, *connect.Request[v1.GetBookingRequest]) (*connect.Response[v1.GetBookingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.BookingService.GetBooking is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) CancelBooking(context.Context, *connect.Request[v1.CancelBookingRequest]) (*connect.Response[v1.CancelBookingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.BookingService.CancelBooking is not implemented"))
+}
+
+func (UnimplementedBookingServiceHandler) ListBookings(context.Context, *connect.Request[v1.ListBookingsRequest]) (*connect.Response[v1.ListBookingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.BookingService.ListBookings is not implemented"))
+}
@@ -0,0 +1,214 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/catalog_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// CatalogServiceName is the fully-qualified name of the CatalogService service.
+	CatalogServiceName = "lession.v1.CatalogService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// CatalogServiceListCatalogSeriesProcedure is the fully-qualified name of the CatalogService's
+	// ListCatalogSeries RPC.
+	CatalogServiceListCatalogSeriesProcedure = "/lession.v1.CatalogService/ListCatalogSeries"
+	// CatalogServiceGetCatalogSeriesProcedure is the fully-qualified name of the CatalogService's
+	// GetCatalogSeries RPC.
+	CatalogServiceGetCatalogSeriesProcedure = "/lession.v1.CatalogService/GetCatalogSeries"
+	// CatalogServiceGetCatalogEpisodeProcedure is the fully-qualified name of the CatalogService's
+	// GetCatalogEpisode RPC.
+	CatalogServiceGetCatalogEpisodeProcedure = "/lession.v1.CatalogService/GetCatalogEpisode"
+	// CatalogServiceGetCatalogPreviewProcedure is the fully-qualified name of the CatalogService's
+	// GetCatalogPreview RPC.
+	CatalogServiceGetCatalogPreviewProcedure = "/lession.v1.CatalogService/GetCatalogPreview"
+)
+
+// CatalogServiceClient is a client for the lession.v1.CatalogService service.
+type CatalogServiceClient interface {
+	// ListCatalogSeries returns a filtered, paginated collection of published series.
+	ListCatalogSeries(context.Context, *connect.Request[v1.ListCatalogSeriesRequest]) (*connect.Response[v1.ListCatalogSeriesResponse], error)
+	// GetCatalogSeries returns a published series.
+	GetCatalogSeries(context.Context, *connect.Request[v1.GetCatalogSeriesRequest]) (*connect.Response[v1.GetCatalogSeriesResponse], error)
+	// GetCatalogEpisode returns a published episode of a published series.
+	GetCatalogEpisode(context.Context, *connect.Request[v1.GetCatalogEpisodeRequest]) (*connect.Response[v1.GetCatalogEpisodeResponse], error)
+	// GetCatalogPreview returns the link preview of a published series or
+	// episode, identified directly or by its page URL.
+	GetCatalogPreview(context.Context, *connect.Request[v1.GetCatalogPreviewRequest]) (*connect.Response[v1.GetCatalogPreviewResponse], error)
+}
+
+// NewCatalogServiceClient constructs a client for the lession.v1.CatalogService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCatalogServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CatalogServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	catalogServiceMethods := v1.File_lession_v1_catalog_service_proto.Services().ByName("CatalogService").Methods()
+	return &catalogServiceClient{
+		listCatalogSeries: connect.NewClient[v1.ListCatalogSeriesRequest, v1.ListCatalogSeriesResponse](
+			httpClient,
+			baseURL+CatalogServiceListCatalogSeriesProcedure,
+			connect.WithSchema(catalogServiceMethods.ByName("ListCatalogSeries")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getCatalogSeries: connect.NewClient[v1.GetCatalogSeriesRequest, v1.GetCatalogSeriesResponse](
+			httpClient,
+			baseURL+CatalogServiceGetCatalogSeriesProcedure,
+			connect.WithSchema(catalogServiceMethods.ByName("GetCatalogSeries")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getCatalogEpisode: connect.NewClient[v1.GetCatalogEpisodeRequest, v1.GetCatalogEpisodeResponse](
+			httpClient,
+			baseURL+CatalogServiceGetCatalogEpisodeProcedure,
+			connect.WithSchema(catalogServiceMethods.ByName("GetCatalogEpisode")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getCatalogPreview: connect.NewClient[v1.GetCatalogPreviewRequest, v1.GetCatalogPreviewResponse](
+			httpClient,
+			baseURL+CatalogServiceGetCatalogPreviewProcedure,
+			connect.WithSchema(catalogServiceMethods.ByName("GetCatalogPreview")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// catalogServiceClient implements CatalogServiceClient.
+type catalogServiceClient struct {
+	listCatalogSeries *connect.Client[v1.ListCatalogSeriesRequest, v1.ListCatalogSeriesResponse]
+	getCatalogSeries  *connect.Client[v1.GetCatalogSeriesRequest, v1.GetCatalogSeriesResponse]
+	getCatalogEpisode *connect.Client[v1.GetCatalogEpisodeRequest, v1.GetCatalogEpisodeResponse]
+	getCatalogPreview *connect.Client[v1.GetCatalogPreviewRequest, v1.GetCatalogPreviewResponse]
+}
+
+// ListCatalogSeries calls lession.v1.CatalogService.ListCatalogSeries.
+func (c *catalogServiceClient) ListCatalogSeries(ctx context.Context, req *connect.Request[v1.ListCatalogSeriesRequest]) (*connect.Response[v1.ListCatalogSeriesResponse], error) {
+	return c.listCatalogSeries.CallUnary(ctx, req)
+}
+
+// GetCatalogSeries calls lession.v1.CatalogService.GetCatalogSeries.
+func (c *catalogServiceClient) GetCatalogSeries(ctx context.Context, req *connect.Request[v1.GetCatalogSeriesRequest]) (*connect.Response[v1.GetCatalogSeriesResponse], error) {
+	return c.getCatalogSeries.CallUnary(ctx, req)
+}
+
+// GetCatalogEpisode calls lession.v1.CatalogService.GetCatalogEpisode.
+func (c *catalogServiceClient) GetCatalogEpisode(ctx context.Context, req *connect.Request[v1.GetCatalogEpisodeRequest]) (*connect.Response[v1.GetCatalogEpisodeResponse], error) {
+	return c.getCatalogEpisode.CallUnary(ctx, req)
+}
+
+// GetCatalogPreview calls lession.v1.CatalogService.GetCatalogPreview.
+func (c *catalogServiceClient) GetCatalogPreview(ctx context.Context, req *connect.Request[v1.GetCatalogPreviewRequest]) (*connect.Response[v1.GetCatalogPreviewResponse], error) {
+	return c.getCatalogPreview.CallUnary(ctx, req)
+}
+
+// CatalogServiceHandler is an implementation of the lession.v1.CatalogService service.
+type CatalogServiceHandler interface {
+	// ListCatalogSeries returns a filtered, paginated collection of published series.
+	ListCatalogSeries(context.Context, *connect.Request[v1.ListCatalogSeriesRequest]) (*connect.Response[v1.ListCatalogSeriesResponse], error)
+	// GetCatalogSeries returns a published series.
+	GetCatalogSeries(context.Context, *connect.Request[v1.GetCatalogSeriesRequest]) (*connect.Response[v1.GetCatalogSeriesResponse], error)
+	// GetCatalogEpisode returns a published episode of a published series.
+	GetCatalogEpisode(context.Context, *connect.Request[v1.GetCatalogEpisodeRequest]) (*connect.Response[v1.GetCatalogEpisodeResponse], error)
+	// GetCatalogPreview returns the link preview of a published series or
+	// episode, identified directly or by its page URL.
+	GetCatalogPreview(context.Context, *connect.Request[v1.GetCatalogPreviewRequest]) (*connect.Response[v1.GetCatalogPreviewResponse], error)
+}
+
+// NewCatalogServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCatalogServiceHandler(svc CatalogServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	catalogServiceMethods := v1.File_lession_v1_catalog_service_proto.Services().ByName("CatalogService").Methods()
+	catalogServiceListCatalogSeriesHandler := connect.NewUnaryHandler(
+		CatalogServiceListCatalogSeriesProcedure,
+		svc.ListCatalogSeries,
+		connect.WithSchema(catalogServiceMethods.ByName("ListCatalogSeries")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	catalogServiceGetCatalogSeriesHandler := connect.NewUnaryHandler(
+		CatalogServiceGetCatalogSeriesProcedure,
+		svc.GetCatalogSeries,
+		connect.WithSchema(catalogServiceMethods.ByName("GetCatalogSeries")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	catalogServiceGetCatalogEpisodeHandler := connect.NewUnaryHandler(
+		CatalogServiceGetCatalogEpisodeProcedure,
+		svc.GetCatalogEpisode,
+		connect.WithSchema(catalogServiceMethods.ByName("GetCatalogEpisode")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	catalogServiceGetCatalogPreviewHandler := connect.NewUnaryHandler(
+		CatalogServiceGetCatalogPreviewProcedure,
+		svc.GetCatalogPreview,
+		connect.WithSchema(catalogServiceMethods.ByName("GetCatalogPreview")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.CatalogService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CatalogServiceListCatalogSeriesProcedure:
+			catalogServiceListCatalogSeriesHandler.ServeHTTP(w, r)
+		case CatalogServiceGetCatalogSeriesProcedure:
+			catalogServiceGetCatalogSeriesHandler.ServeHTTP(w, r)
+		case CatalogServiceGetCatalogEpisodeProcedure:
+			catalogServiceGetCatalogEpisodeHandler.ServeHTTP(w, r)
+		case CatalogServiceGetCatalogPreviewProcedure:
+			catalogServiceGetCatalogPreviewHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCatalogServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCatalogServiceHandler struct{}
+
+func (UnimplementedCatalogServiceHandler) ListCatalogSeries(context.Context, *connect.Request[v1.ListCatalogSeriesRequest]) (*connect.Response[v1.ListCatalogSeriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.CatalogService.ListCatalogSeries is not implemented"))
+}
+
+func (UnimplementedCatalogServiceHandler) GetCatalogSeries(context.Context, *connect.Request[v1.GetCatalogSeriesRequest]) (*connect.Response[v1.GetCatalogSeriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.CatalogService.GetCatalogSeries is not implemented"))
+}
+
+func (UnimplementedCatalogServiceHandler) GetCatalogEpisode(context.Context, *connect.Request[v1.GetCatalogEpisodeRequest]) (*connect.Response[v1.GetCatalogEpisodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.CatalogService.GetCatalogEpisode is not implemented"))
+}
+
+func (UnimplementedCatalogServiceHandler) GetCatalogPreview(context.Context, *connect.Request[v1.GetCatalogPreviewRequest]) (*connect.Response[v1.GetCatalogPreviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.CatalogService.GetCatalogPreview is not implemented"))
+}
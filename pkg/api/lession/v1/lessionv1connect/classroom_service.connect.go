@@ -0,0 +1,390 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/classroom_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ClassroomServiceName is the fully-qualified name of the ClassroomService service.
+	ClassroomServiceName = "lession.v1.ClassroomService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ClassroomServiceCreateClassroomProcedure is the fully-qualified name of the ClassroomService's
+	// CreateClassroom RPC.
+	ClassroomServiceCreateClassroomProcedure = "/lession.v1.ClassroomService/CreateClassroom"
+	// ClassroomServiceGetClassroomProcedure is the fully-qualified name of the ClassroomService's
+	// GetClassroom RPC.
+	ClassroomServiceGetClassroomProcedure = "/lession.v1.ClassroomService/GetClassroom"
+	// ClassroomServiceListClassroomsProcedure is the fully-qualified name of the ClassroomService's
+	// ListClassrooms RPC.
+	ClassroomServiceListClassroomsProcedure = "/lession.v1.ClassroomService/ListClassrooms"
+	// ClassroomServiceUpdateClassroomProcedure is the fully-qualified name of the ClassroomService's
+	// UpdateClassroom RPC.
+	ClassroomServiceUpdateClassroomProcedure = "/lession.v1.ClassroomService/UpdateClassroom"
+	// ClassroomServiceDeleteClassroomProcedure is the fully-qualified name of the ClassroomService's
+	// DeleteClassroom RPC.
+	ClassroomServiceDeleteClassroomProcedure = "/lession.v1.ClassroomService/DeleteClassroom"
+	// ClassroomServiceAddClassroomLearnersProcedure is the fully-qualified name of the
+	// ClassroomService's AddClassroomLearners RPC.
+	ClassroomServiceAddClassroomLearnersProcedure = "/lession.v1.ClassroomService/AddClassroomLearners"
+	// ClassroomServiceRemoveClassroomLearnersProcedure is the fully-qualified name of the
+	// ClassroomService's RemoveClassroomLearners RPC.
+	ClassroomServiceRemoveClassroomLearnersProcedure = "/lession.v1.ClassroomService/RemoveClassroomLearners"
+	// ClassroomServiceAssignSeriesProcedure is the fully-qualified name of the ClassroomService's
+	// AssignSeries RPC.
+	ClassroomServiceAssignSeriesProcedure = "/lession.v1.ClassroomService/AssignSeries"
+	// ClassroomServiceRemoveAssignmentProcedure is the fully-qualified name of the ClassroomService's
+	// RemoveAssignment RPC.
+	ClassroomServiceRemoveAssignmentProcedure = "/lession.v1.ClassroomService/RemoveAssignment"
+	// ClassroomServiceGetClassroomReportProcedure is the fully-qualified name of the ClassroomService's
+	// GetClassroomReport RPC.
+	ClassroomServiceGetClassroomReportProcedure = "/lession.v1.ClassroomService/GetClassroomReport"
+)
+
+// ClassroomServiceClient is a client for the lession.v1.ClassroomService service.
+type ClassroomServiceClient interface {
+	// CreateClassroom stores a new classroom.
+	CreateClassroom(context.Context, *connect.Request[v1.CreateClassroomRequest]) (*connect.Response[v1.CreateClassroomResponse], error)
+	// GetClassroom returns a single classroom by identifier.
+	GetClassroom(context.Context, *connect.Request[v1.GetClassroomRequest]) (*connect.Response[v1.GetClassroomResponse], error)
+	// ListClassrooms returns classrooms, most recently updated first.
+	ListClassrooms(context.Context, *connect.Request[v1.ListClassroomsRequest]) (*connect.Response[v1.ListClassroomsResponse], error)
+	// UpdateClassroom applies partial updates to classroom metadata.
+	UpdateClassroom(context.Context, *connect.Request[v1.UpdateClassroomRequest]) (*connect.Response[v1.UpdateClassroomResponse], error)
+	// DeleteClassroom removes a classroom with its enrolments and assignments.
+	DeleteClassroom(context.Context, *connect.Request[v1.DeleteClassroomRequest]) (*connect.Response[v1.DeleteClassroomResponse], error)
+	// AddClassroomLearners enrols learners in a classroom.
+	AddClassroomLearners(context.Context, *connect.Request[v1.AddClassroomLearnersRequest]) (*connect.Response[v1.AddClassroomLearnersResponse], error)
+	// RemoveClassroomLearners withdraws learners from a classroom.
+	RemoveClassroomLearners(context.Context, *connect.Request[v1.RemoveClassroomLearnersRequest]) (*connect.Response[v1.RemoveClassroomLearnersResponse], error)
+	// AssignSeries asks a classroom's learners to finish a published series by a due date.
+	AssignSeries(context.Context, *connect.Request[v1.AssignSeriesRequest]) (*connect.Response[v1.AssignSeriesResponse], error)
+	// RemoveAssignment withdraws an assignment from a classroom.
+	RemoveAssignment(context.Context, *connect.Request[v1.RemoveAssignmentRequest]) (*connect.Response[v1.RemoveAssignmentResponse], error)
+	// GetClassroomReport returns every learner's progress on every assignment.
+	GetClassroomReport(context.Context, *connect.Request[v1.GetClassroomReportRequest]) (*connect.Response[v1.GetClassroomReportResponse], error)
+}
+
+// NewClassroomServiceClient constructs a client for the lession.v1.ClassroomService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewClassroomServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ClassroomServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	classroomServiceMethods := v1.File_lession_v1_classroom_service_proto.Services().ByName("ClassroomService").Methods()
+	return &classroomServiceClient{
+		createClassroom: connect.NewClient[v1.CreateClassroomRequest, v1.CreateClassroomResponse](
+			httpClient,
+			baseURL+ClassroomServiceCreateClassroomProcedure,
+			connect.WithSchema(classroomServiceMethods.ByName("CreateClassroom")),
+			connect.WithClientOptions(opts...),
+		),
+		getClassroom: connect.NewClient[v1.GetClassroomRequest, v1.GetClassroomResponse](
+			httpClient,
+			baseURL+ClassroomServiceGetClassroomProcedure,
+			connect.WithSchema(classroomServiceMethods.ByName("GetClassroom")),
+			connect.WithClientOptions(opts...),
+		),
+		listClassrooms: connect.NewClient[v1.ListClassroomsRequest, v1.ListClassroomsResponse](
+			httpClient,
+			baseURL+ClassroomServiceListClassroomsProcedure,
+			connect.WithSchema(classroomServiceMethods.ByName("ListClassrooms")),
+			connect.WithClientOptions(opts...),
+		),
+		updateClassroom: connect.NewClient[v1.UpdateClassroomRequest, v1.UpdateClassroomResponse](
+			httpClient,
+			baseURL+ClassroomServiceUpdateClassroomProcedure,
+			connect.WithSchema(classroomServiceMethods.ByName("UpdateClassroom")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteClassroom: connect.NewClient[v1.DeleteClassroomRequest, v1.DeleteClassroomResponse](
+			httpClient,
+			baseURL+ClassroomServiceDeleteClassroomProcedure,
+			connect.WithSchema(classroomServiceMethods.ByName("DeleteClassroom")),
+			connect.WithClientOptions(opts...),
+		),
+		addClassroomLearners: connect.NewClient[v1.AddClassroomLearnersRequest, v1.AddClassroomLearnersResponse](
+			httpClient,
+			baseURL+ClassroomServiceAddClassroomLearnersProcedure,
+			connect.WithSchema(classroomServiceMethods.ByName("AddClassroomLearners")),
+			connect.WithClientOptions(opts...),
+		),
+		removeClassroomLearners: connect.NewClient[v1.RemoveClassroomLearnersRequest, v1.RemoveClassroomLearnersResponse](
+			httpClient,
+			baseURL+ClassroomServiceRemoveClassroomLearnersProcedure,
+			connect.WithSchema(classroomServiceMethods.ByName("RemoveClassroomLearners")),
+			connect.WithClientOptions(opts...),
+		),
+		assignSeries: connect.NewClient[v1.AssignSeriesRequest, v1.AssignSeriesResponse](
+			httpClient,
+			baseURL+ClassroomServiceAssignSeriesProcedure,
+			connect.WithSchema(classroomServiceMethods.ByName("AssignSeries")),
+			connect.WithClientOptions(opts...),
+		),
+		removeAssignment: connect.NewClient[v1.RemoveAssignmentRequest, v1.RemoveAssignmentResponse](
+			httpClient,
+			baseURL+ClassroomServiceRemoveAssignmentProcedure,
+			connect.WithSchema(classroomServiceMethods.ByName("RemoveAssignment")),
+			connect.WithClientOptions(opts...),
+		),
+		getClassroomReport: connect.NewClient[v1.GetClassroomReportRequest, v1.GetClassroomReportResponse](
+			httpClient,
+			baseURL+ClassroomServiceGetClassroomReportProcedure,
+			connect.WithSchema(classroomServiceMethods.ByName("GetClassroomReport")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// classroomServiceClient implements ClassroomServiceClient.
+type classroomServiceClient struct {
+	createClassroom         *connect.Client[v1.CreateClassroomRequest, v1.CreateClassroomResponse]
+	getClassroom            *connect.Client[v1.GetClassroomRequest, v1.GetClassroomResponse]
+	listClassrooms          *connect.Client[v1.ListClassroomsRequest, v1.ListClassroomsResponse]
+	updateClassroom         *connect.Client[v1.UpdateClassroomRequest, v1.UpdateClassroomResponse]
+	deleteClassroom         *connect.Client[v1.DeleteClassroomRequest, v1.DeleteClassroomResponse]
+	addClassroomLearners    *connect.Client[v1.AddClassroomLearnersRequest, v1.AddClassroomLearnersResponse]
+	removeClassroomLearners *connect.Client[v1.RemoveClassroomLearnersRequest, v1.RemoveClassroomLearnersResponse]
+	assignSeries            *connect.Client[v1.AssignSeriesRequest, v1.AssignSeriesResponse]
+	removeAssignment        *connect.Client[v1.RemoveAssignmentRequest, v1.RemoveAssignmentResponse]
+	getClassroomReport      *connect.Client[v1.GetClassroomReportRequest, v1.GetClassroomReportResponse]
+}
+
+// CreateClassroom calls lession.v1.ClassroomService.CreateClassroom.
+func (c *classroomServiceClient) CreateClassroom(ctx context.Context, req *connect.Request[v1.CreateClassroomRequest]) (*connect.Response[v1.CreateClassroomResponse], error) {
+	return c.createClassroom.CallUnary(ctx, req)
+}
+
+// GetClassroom calls lession.v1.ClassroomService.GetClassroom.
+func (c *classroomServiceClient) GetClassroom(ctx context.Context, req *connect.Request[v1.GetClassroomRequest]) (*connect.Response[v1.GetClassroomResponse], error) {
+	return c.getClassroom.CallUnary(ctx, req)
+}
+
+// ListClassrooms calls lession.v1.ClassroomService.ListClassrooms.
+func (c *classroomServiceClient) ListClassrooms(ctx context.Context, req *connect.Request[v1.ListClassroomsRequest]) (*connect.Response[v1.ListClassroomsResponse], error) {
+	return c.listClassrooms.CallUnary(ctx, req)
+}
+
+// UpdateClassroom calls lession.v1.ClassroomService.UpdateClassroom.
+func (c *classroomServiceClient) UpdateClassroom(ctx context.Context, req *connect.Request[v1.UpdateClassroomRequest]) (*connect.Response[v1.UpdateClassroomResponse], error) {
+	return c.updateClassroom.CallUnary(ctx, req)
+}
+
+// DeleteClassroom calls lession.v1.ClassroomService.DeleteClassroom.
+func (c *classroomServiceClient) DeleteClassroom(ctx context.Context, req *connect.Request[v1.DeleteClassroomRequest]) (*connect.Response[v1.DeleteClassroomResponse], error) {
+	return c.deleteClassroom.CallUnary(ctx, req)
+}
+
+// AddClassroomLearners calls lession.v1.ClassroomService.AddClassroomLearners.
+func (c *classroomServiceClient) AddClassroomLearners(ctx context.Context, req *connect.Request[v1.AddClassroomLearnersRequest]) (*connect.Response[v1.AddClassroomLearnersResponse], error) {
+	return c.addClassroomLearners.CallUnary(ctx, req)
+}
+
+// RemoveClassroomLearners calls lession.v1.ClassroomService.RemoveClassroomLearners.
+func (c *classroomServiceClient) RemoveClassroomLearners(ctx context.Context, req *connect.Request[v1.RemoveClassroomLearnersRequest]) (*connect.Response[v1.RemoveClassroomLearnersResponse], error) {
+	return c.removeClassroomLearners.CallUnary(ctx, req)
+}
+
+// AssignSeries calls lession.v1.ClassroomService.AssignSeries.
+func (c *classroomServiceClient) AssignSeries(ctx context.Context, req *connect.Request[v1.AssignSeriesRequest]) (*connect.Response[v1.AssignSeriesResponse], error) {
+	return c.assignSeries.CallUnary(ctx, req)
+}
+
+// RemoveAssignment calls lession.v1.ClassroomService.RemoveAssignment.
+func (c *classroomServiceClient) RemoveAssignment(ctx context.Context, req *connect.Request[v1.RemoveAssignmentRequest]) (*connect.Response[v1.RemoveAssignmentResponse], error) {
+	return c.removeAssignment.CallUnary(ctx, req)
+}
+
+// GetClassroomReport calls lession.v1.ClassroomService.GetClassroomReport.
+func (c *classroomServiceClient) GetClassroomReport(ctx context.Context, req *connect.Request[v1.GetClassroomReportRequest]) (*connect.Response[v1.GetClassroomReportResponse], error) {
+	return c.getClassroomReport.CallUnary(ctx, req)
+}
+
+// ClassroomServiceHandler is an implementation of the lession.v1.ClassroomService service.
+type ClassroomServiceHandler interface {
+	// CreateClassroom stores a new classroom.
+	CreateClassroom(context.Context, *connect.Request[v1.CreateClassroomRequest]) (*connect.Response[v1.CreateClassroomResponse], error)
+	// GetClassroom returns a single classroom by identifier.
+	GetClassroom(context.Context, *connect.Request[v1.GetClassroomRequest]) (*connect.Response[v1.GetClassroomResponse], error)
+	// ListClassrooms returns classrooms, most recently updated first.
+	ListClassrooms(context.Context, *connect.Request[v1.ListClassroomsRequest]) (*connect.Response[v1.ListClassroomsResponse], error)
+	// UpdateClassroom applies partial updates to classroom metadata.
+	UpdateClassroom(context.Context, *connect.Request[v1.UpdateClassroomRequest]) (*connect.Response[v1.UpdateClassroomResponse], error)
+	// DeleteClassroom removes a classroom with its enrolments and assignments.
+	DeleteClassroom(context.Context, *connect.Request[v1.DeleteClassroomRequest]) (*connect.Response[v1.DeleteClassroomResponse], error)
+	// AddClassroomLearners enrols learners in a classroom.
+	AddClassroomLearners(context.Context, *connect.Request[v1.AddClassroomLearnersRequest]) (*connect.Response[v1.AddClassroomLearnersResponse], error)
+	// RemoveClassroomLearners withdraws learners from a classroom.
+	RemoveClassroomLearners(context.Context, *connect.Request[v1.RemoveClassroomLearnersRequest]) (*connect.Response[v1.RemoveClassroomLearnersResponse], error)
+	// AssignSeries asks a classroom's learners to finish a published series by a due date.
+	AssignSeries(context.Context, *connect.Request[v1.AssignSeriesRequest]) (*connect.Response[v1.AssignSeriesResponse], error)
+	// RemoveAssignment withdraws an assignment from a classroom.
+	RemoveAssignment(context.Context, *connect.Request[v1.RemoveAssignmentRequest]) (*connect.Response[v1.RemoveAssignmentResponse], error)
+	// GetClassroomReport returns every learner's progress on every assignment.
+	GetClassroomReport(context.Context, *connect.Request[v1.GetClassroomReportRequest]) (*connect.Response[v1.GetClassroomReportResponse], error)
+}
+
+// NewClassroomServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewClassroomServiceHandler(svc ClassroomServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	classroomServiceMethods := v1.File_lession_v1_classroom_service_proto.Services().ByName("ClassroomService").Methods()
+	classroomServiceCreateClassroomHandler := connect.NewUnaryHandler(
+		ClassroomServiceCreateClassroomProcedure,
+		svc.CreateClassroom,
+		connect.WithSchema(classroomServiceMethods.ByName("CreateClassroom")),
+		connect.WithHandlerOptions(opts...),
+	)
+	classroomServiceGetClassroomHandler := connect.NewUnaryHandler(
+		ClassroomServiceGetClassroomProcedure,
+		svc.GetClassroom,
+		connect.WithSchema(classroomServiceMethods.ByName("GetClassroom")),
+		connect.WithHandlerOptions(opts...),
+	)
+	classroomServiceListClassroomsHandler := connect.NewUnaryHandler(
+		ClassroomServiceListClassroomsProcedure,
+		svc.ListClassrooms,
+		connect.WithSchema(classroomServiceMethods.ByName("ListClassrooms")),
+		connect.WithHandlerOptions(opts...),
+	)
+	classroomServiceUpdateClassroomHandler := connect.NewUnaryHandler(
+		ClassroomServiceUpdateClassroomProcedure,
+		svc.UpdateClassroom,
+		connect.WithSchema(classroomServiceMethods.ByName("UpdateClassroom")),
+		connect.WithHandlerOptions(opts...),
+	)
+	classroomServiceDeleteClassroomHandler := connect.NewUnaryHandler(
+		ClassroomServiceDeleteClassroomProcedure,
+		svc.DeleteClassroom,
+		connect.WithSchema(classroomServiceMethods.ByName("DeleteClassroom")),
+		connect.WithHandlerOptions(opts...),
+	)
+	classroomServiceAddClassroomLearnersHandler := connect.NewUnaryHandler(
+		ClassroomServiceAddClassroomLearnersProcedure,
+		svc.AddClassroomLearners,
+		connect.WithSchema(classroomServiceMethods.ByName("AddClassroomLearners")),
+		connect.WithHandlerOptions(opts...),
+	)
+	classroomServiceRemoveClassroomLearnersHandler := connect.NewUnaryHandler(
+		ClassroomServiceRemoveClassroomLearnersProcedure,
+		svc.RemoveClassroomLearners,
+		connect.WithSchema(classroomServiceMethods.ByName("RemoveClassroomLearners")),
+		connect.WithHandlerOptions(opts...),
+	)
+	classroomServiceAssignSeriesHandler := connect.NewUnaryHandler(
+		ClassroomServiceAssignSeriesProcedure,
+		svc.AssignSeries,
+		connect.WithSchema(classroomServiceMethods.ByName("AssignSeries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	classroomServiceRemoveAssignmentHandler := connect.NewUnaryHandler(
+		ClassroomServiceRemoveAssignmentProcedure,
+		svc.RemoveAssignment,
+		connect.WithSchema(classroomServiceMethods.ByName("RemoveAssignment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	classroomServiceGetClassroomReportHandler := connect.NewUnaryHandler(
+		ClassroomServiceGetClassroomReportProcedure,
+		svc.GetClassroomReport,
+		connect.WithSchema(classroomServiceMethods.ByName("GetClassroomReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.ClassroomService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ClassroomServiceCreateClassroomProcedure:
+			classroomServiceCreateClassroomHandler.ServeHTTP(w, r)
+		case ClassroomServiceGetClassroomProcedure:
+			classroomServiceGetClassroomHandler.ServeHTTP(w, r)
+		case ClassroomServiceListClassroomsProcedure:
+			classroomServiceListClassroomsHandler.ServeHTTP(w, r)
+		case ClassroomServiceUpdateClassroomProcedure:
+			classroomServiceUpdateClassroomHandler.ServeHTTP(w, r)
+		case ClassroomServiceDeleteClassroomProcedure:
+			classroomServiceDeleteClassroomHandler.ServeHTTP(w, r)
+		case ClassroomServiceAddClassroomLearnersProcedure:
+			classroomServiceAddClassroomLearnersHandler.ServeHTTP(w, r)
+		case ClassroomServiceRemoveClassroomLearnersProcedure:
+			classroomServiceRemoveClassroomLearnersHandler.ServeHTTP(w, r)
+		case ClassroomServiceAssignSeriesProcedure:
+			classroomServiceAssignSeriesHandler.ServeHTTP(w, r)
+		case ClassroomServiceRemoveAssignmentProcedure:
+			classroomServiceRemoveAssignmentHandler.ServeHTTP(w, r)
+		case ClassroomServiceGetClassroomReportProcedure:
+			classroomServiceGetClassroomReportHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedClassroomServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedClassroomServiceHandler struct{}
+
+func (UnimplementedClassroomServiceHandler) CreateClassroom(context.Context, *connect.Request[v1.CreateClassroomRequest]) (*connect.Response[v1.CreateClassroomResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ClassroomService.CreateClassroom is not implemented"))
+}
+
+func (UnimplementedClassroomServiceHandler) GetClassroom(context.Context, *connect.Request[v1.GetClassroomRequest]) (*connect.Response[v1.GetClassroomResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ClassroomService.GetClassroom is not implemented"))
+}
+
+func (UnimplementedClassroomServiceHandler) ListClassrooms(context.Context, *connect.Request[v1.ListClassroomsRequest]) (*connect.Response[v1.ListClassroomsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ClassroomService.ListClassrooms is not implemented"))
+}
+
+func (UnimplementedClassroomServiceHandler) UpdateClassroom(context.Context, *connect.Request[v1.UpdateClassroomRequest]) (*connect.Response[v1.UpdateClassroomResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ClassroomService.UpdateClassroom is not implemented"))
+}
+
+func (UnimplementedClassroomServiceHandler) DeleteClassroom(context.Context, *connect.Request[v1.DeleteClassroomRequest]) (*connect.Response[v1.DeleteClassroomResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ClassroomService.DeleteClassroom is not implemented"))
+}
+
+func (UnimplementedClassroomServiceHandler) AddClassroomLearners(context.Context, *connect.Request[v1.AddClassroomLearnersRequest]) (*connect.Response[v1.AddClassroomLearnersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ClassroomService.AddClassroomLearners is not implemented"))
+}
+
+func (UnimplementedClassroomServiceHandler) RemoveClassroomLearners(context.Context, *connect.Request[v1.RemoveClassroomLearnersRequest]) (*connect.Response[v1.RemoveClassroomLearnersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ClassroomService.RemoveClassroomLearners is not implemented"))
+}
+
+func (UnimplementedClassroomServiceHandler) AssignSeries(context.Context, *connect.Request[v1.AssignSeriesRequest]) (*connect.Response[v1.AssignSeriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ClassroomService.AssignSeries is not implemented"))
+}
+
+func (UnimplementedClassroomServiceHandler) RemoveAssignment(context.Context, *connect.Request[v1.RemoveAssignmentRequest]) (*connect.Response[v1.RemoveAssignmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ClassroomService.RemoveAssignment is not implemented"))
+}
+
+func (UnimplementedClassroomServiceHandler) GetClassroomReport(context.Context, *connect.Request[v1.GetClassroomReportRequest]) (*connect.Response[v1.GetClassroomReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ClassroomService.GetClassroomReport is not implemented"))
+}
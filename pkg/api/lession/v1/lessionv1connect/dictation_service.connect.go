@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/dictation_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// DictationServiceName is the fully-qualified name of the DictationService service.
+	DictationServiceName = "lession.v1.DictationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// DictationServiceListDictationItemsProcedure is the fully-qualified name of the DictationService's
+	// ListDictationItems RPC.
+	DictationServiceListDictationItemsProcedure = "/lession.v1.DictationService/ListDictationItems"
+	// DictationServiceSubmitDictationAnswerProcedure is the fully-qualified name of the
+	// DictationService's SubmitDictationAnswer RPC.
+	DictationServiceSubmitDictationAnswerProcedure = "/lession.v1.DictationService/SubmitDictationAnswer"
+	// DictationServiceListDictationAttemptsProcedure is the fully-qualified name of the
+	// DictationService's ListDictationAttempts RPC.
+	DictationServiceListDictationAttemptsProcedure = "/lession.v1.DictationService/ListDictationAttempts"
+)
+
+// DictationServiceClient is a client for the lession.v1.DictationService service.
+type DictationServiceClient interface {
+	// ListDictationItems slices an episode's timed transcript into dictation items.
+	ListDictationItems(context.Context, *connect.Request[v1.ListDictationItemsRequest]) (*connect.Response[v1.ListDictationItemsResponse], error)
+	// SubmitDictationAnswer scores a learner's typed answer and records the attempt.
+	SubmitDictationAnswer(context.Context, *connect.Request[v1.SubmitDictationAnswerRequest]) (*connect.Response[v1.SubmitDictationAnswerResponse], error)
+	// ListDictationAttempts returns recorded attempts, newest first.
+	ListDictationAttempts(context.Context, *connect.Request[v1.ListDictationAttemptsRequest]) (*connect.Response[v1.ListDictationAttemptsResponse], error)
+}
+
+// NewDictationServiceClient constructs a client for the lession.v1.DictationService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewDictationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) DictationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	dictationServiceMethods := v1.File_lession_v1_dictation_service_proto.Services().ByName("DictationService").Methods()
+	return &dictationServiceClient{
+		listDictationItems: connect.NewClient[v1.ListDictationItemsRequest, v1.ListDictationItemsResponse](
+			httpClient,
+			baseURL+DictationServiceListDictationItemsProcedure,
+			connect.WithSchema(dictationServiceMethods.ByName("ListDictationItems")),
+			connect.WithClientOptions(opts...),
+		),
+		submitDictationAnswer: connect.NewClient[v1.SubmitDictationAnswerRequest, v1.SubmitDictationAnswerResponse](
+			httpClient,
+			baseURL+DictationServiceSubmitDictationAnswerProcedure,
+			connect.WithSchema(dictationServiceMethods.ByName("SubmitDictationAnswer")),
+			connect.WithClientOptions(opts...),
+		),
+		listDictationAttempts: connect.NewClient[v1.ListDictationAttemptsRequest, v1.ListDictationAttemptsResponse](
+			httpClient,
+			baseURL+DictationServiceListDictationAttemptsProcedure,
+			connect.WithSchema(dictationServiceMethods.ByName("ListDictationAttempts")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// dictationServiceClient implements DictationServiceClient.
+type dictationServiceClient struct {
+	listDictationItems    *connect.Client[v1.ListDictationItemsRequest, v1.ListDictationItemsResponse]
+	submitDictationAnswer *connect.Client[v1.SubmitDictationAnswerRequest, v1.SubmitDictationAnswerResponse]
+	listDictationAttempts *connect.Client[v1.ListDictationAttemptsRequest, v1.ListDictationAttemptsResponse]
+}
+
+// ListDictationItems calls lession.v1.DictationService.ListDictationItems.
+func (c *dictationServiceClient) ListDictationItems(ctx context.Context, req *connect.Request[v1.ListDictationItemsRequest]) (*connect.Response[v1.ListDictationItemsResponse], error) {
+	return c.listDictationItems.CallUnary(ctx, req)
+}
+
+// SubmitDictationAnswer calls lession.v1.DictationService.SubmitDictationAnswer.
+func (c *dictationServiceClient) SubmitDictationAnswer(ctx context.Context, req *connect.Request[v1.SubmitDictationAnswerRequest]) (*connect.Response[v1.SubmitDictationAnswerResponse], error) {
+	return c.submitDictationAnswer.CallUnary(ctx, req)
+}
+
+// ListDictationAttempts calls lession.v1.DictationService.ListDictationAttempts.
+func (c *dictationServiceClient) ListDictationAttempts(ctx context.Context, req *connect.Request[v1.ListDictationAttemptsRequest]) (*connect.Response[v1.ListDictationAttemptsResponse], error) {
+	return c.listDictationAttempts.CallUnary(ctx, req)
+}
+
+// DictationServiceHandler is an implementation of the lession.v1.DictationService service.
+type DictationServiceHandler interface {
+	// ListDictationItems slices an episode's timed transcript into dictation items.
+	ListDictationItems(context.Context, *connect.Request[v1.ListDictationItemsRequest]) (*connect.Response[v1.ListDictationItemsResponse], error)
+	// SubmitDictationAnswer scores a learner's typed answer and records the attempt.
+	SubmitDictationAnswer(context.Context, *connect.Request[v1.SubmitDictationAnswerRequest]) (*connect.Response[v1.SubmitDictationAnswerResponse], error)
+	// ListDictationAttempts returns recorded attempts, newest first.
+	ListDictationAttempts(context.Context, *connect.Request[v1.ListDictationAttemptsRequest]) (*connect.Response[v1.ListDictationAttemptsResponse], error)
+}
+
+// NewDictationServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewDictationServiceHandler(svc DictationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	dictationServiceMethods := v1.File_lession_v1_dictation_service_proto.Services().ByName("DictationService").Methods()
+	dictationServiceListDictationItemsHandler := connect.NewUnaryHandler(
+		DictationServiceListDictationItemsProcedure,
+		svc.ListDictationItems,
+		connect.WithSchema(dictationServiceMethods.ByName("ListDictationItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dictationServiceSubmitDictationAnswerHandler := connect.NewUnaryHandler(
+		DictationServiceSubmitDictationAnswerProcedure,
+		svc.SubmitDictationAnswer,
+		connect.WithSchema(dictationServiceMethods.ByName("SubmitDictationAnswer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dictationServiceListDictationAttemptsHandler := connect.NewUnaryHandler(
+		DictationServiceListDictationAttemptsProcedure,
+		svc.ListDictationAttempts,
+		connect.WithSchema(dictationServiceMethods.ByName("ListDictationAttempts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.DictationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case DictationServiceListDictationItemsProcedure:
+			dictationServiceListDictationItemsHandler.ServeHTTP(w, r)
+		case DictationServiceSubmitDictationAnswerProcedure:
+			dictationServiceSubmitDictationAnswerHandler.ServeHTTP(w, r)
+		case DictationServiceListDictationAttemptsProcedure:
+			dictationServiceListDictationAttemptsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedDictationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedDictationServiceHandler struct{}
+
+func (UnimplementedDictationServiceHandler) ListDictationItems(context.Context, *connect.Request[v1.ListDictationItemsRequest]) (*connect.Response[v1.ListDictationItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.DictationService.ListDictationItems is not implemented"))
+}
+
+func (UnimplementedDictationServiceHandler) SubmitDictationAnswer(context.Context, *connect.Request[v1.SubmitDictationAnswerRequest]) (*connect.Response[v1.SubmitDictationAnswerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.DictationService.SubmitDictationAnswer is not implemented"))
+}
+
+func (UnimplementedDictationServiceHandler) ListDictationAttempts(context.Context, *connect.Request[v1.ListDictationAttemptsRequest]) (*connect.Response[v1.ListDictationAttemptsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.DictationService.ListDictationAttempts is not implemented"))
+}
@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/download_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// DownloadServiceName is the fully-qualified name of the DownloadService service.
+	DownloadServiceName = "lession.v1.DownloadService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// DownloadServiceCreateDownloadBundleProcedure is the fully-qualified name of the DownloadService's
+	// CreateDownloadBundle RPC.
+	DownloadServiceCreateDownloadBundleProcedure = "/lession.v1.DownloadService/CreateDownloadBundle"
+)
+
+// DownloadServiceClient is a client for the lession.v1.DownloadService service.
+type DownloadServiceClient interface {
+	// CreateDownloadBundle signs the media, transcript and vocabulary of a
+	// published episode. Episodes that are not previews need an active
+	// subscription.
+	CreateDownloadBundle(context.Context, *connect.Request[v1.CreateDownloadBundleRequest]) (*connect.Response[v1.CreateDownloadBundleResponse], error)
+}
+
+// NewDownloadServiceClient constructs a client for the lession.v1.DownloadService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewDownloadServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) DownloadServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	downloadServiceMethods := v1.File_lession_v1_download_service_proto.Services().ByName("DownloadService").Methods()
+	return &downloadServiceClient{
+		createDownloadBundle: connect.NewClient[v1.CreateDownloadBundleRequest, v1.CreateDownloadBundleResponse](
+			httpClient,
+			baseURL+DownloadServiceCreateDownloadBundleProcedure,
+			connect.WithSchema(downloadServiceMethods.ByName("CreateDownloadBundle")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// downloadServiceClient implements DownloadServiceClient.
+type downloadServiceClient struct {
+	createDownloadBundle *connect.Client[v1.CreateDownloadBundleRequest, v1.CreateDownloadBundleResponse]
+}
+
+// CreateDownloadBundle calls lession.v1.DownloadService.CreateDownloadBundle.
+func (c *downloadServiceClient) CreateDownloadBundle(ctx context.Context, req *connect.Request[v1.CreateDownloadBundleRequest]) (*connect.Response[v1.CreateDownloadBundleResponse], error) {
+	return c.createDownloadBundle.CallUnary(ctx, req)
+}
+
+// DownloadServiceHandler is an implementation of the lession.v1.DownloadService service.
+type DownloadServiceHandler interface {
+	// CreateDownloadBundle signs the media, transcript and vocabulary of a
+	// published episode. Episodes that are not previews need an active
+	// subscription.
+	CreateDownloadBundle(context.Context, *connect.Request[v1.CreateDownloadBundleRequest]) (*connect.Response[v1.CreateDownloadBundleResponse], error)
+}
+
+// NewDownloadServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewDownloadServiceHandler(svc DownloadServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	downloadServiceMethods := v1.File_lession_v1_download_service_proto.Services().ByName("DownloadService").Methods()
+	downloadServiceCreateDownloadBundleHandler := connect.NewUnaryHandler(
+		DownloadServiceCreateDownloadBundleProcedure,
+		svc.CreateDownloadBundle,
+		connect.WithSchema(downloadServiceMethods.ByName("CreateDownloadBundle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.DownloadService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case DownloadServiceCreateDownloadBundleProcedure:
+			downloadServiceCreateDownloadBundleHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedDownloadServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedDownloadServiceHandler struct{}
+
+func (UnimplementedDownloadServiceHandler) CreateDownloadBundle(context.Context, *connect.Request[v1.CreateDownloadBundleRequest]) (*connect.Response[v1.CreateDownloadBundleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.DownloadService.CreateDownloadBundle is not implemented"))
+}
@@ -0,0 +1,156 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/embed_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// EmbedServiceName is the fully-qualified name of the EmbedService service.
+	EmbedServiceName = "lession.v1.EmbedService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// EmbedServiceCreateEmbedTokenProcedure is the fully-qualified name of the EmbedService's
+	// CreateEmbedToken RPC.
+	EmbedServiceCreateEmbedTokenProcedure = "/lession.v1.EmbedService/CreateEmbedToken"
+	// EmbedServiceGetEmbedPlaybackProcedure is the fully-qualified name of the EmbedService's
+	// GetEmbedPlayback RPC.
+	EmbedServiceGetEmbedPlaybackProcedure = "/lession.v1.EmbedService/GetEmbedPlayback"
+)
+
+// EmbedServiceClient is a client for the lession.v1.EmbedService service.
+type EmbedServiceClient interface {
+	// CreateEmbedToken issues an expiring token for a published episode,
+	// optionally locked to the domains it may be embedded on. Only authors of
+	// the series, and API keys scoped to it ("series:<id>") or to everything,
+	// can create tokens.
+	CreateEmbedToken(context.Context, *connect.Request[v1.CreateEmbedTokenRequest]) (*connect.Response[v1.CreateEmbedTokenResponse], error)
+	// GetEmbedPlayback exchanges an embed token for the episode and its
+	// playback URL. Domain-locked tokens only work when the request's Origin
+	// or Referer is on an allowed domain. Browsers set those headers, but any
+	// other client can forge them, so the lock stops other sites from
+	// embedding the player rather than protecting the playback URL.
+	GetEmbedPlayback(context.Context, *connect.Request[v1.GetEmbedPlaybackRequest]) (*connect.Response[v1.GetEmbedPlaybackResponse], error)
+}
+
+// NewEmbedServiceClient constructs a client for the lession.v1.EmbedService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewEmbedServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) EmbedServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	embedServiceMethods := v1.File_lession_v1_embed_service_proto.Services().ByName("EmbedService").Methods()
+	return &embedServiceClient{
+		createEmbedToken: connect.NewClient[v1.CreateEmbedTokenRequest, v1.CreateEmbedTokenResponse](
+			httpClient,
+			baseURL+EmbedServiceCreateEmbedTokenProcedure,
+			connect.WithSchema(embedServiceMethods.ByName("CreateEmbedToken")),
+			connect.WithClientOptions(opts...),
+		),
+		getEmbedPlayback: connect.NewClient[v1.GetEmbedPlaybackRequest, v1.GetEmbedPlaybackResponse](
+			httpClient,
+			baseURL+EmbedServiceGetEmbedPlaybackProcedure,
+			connect.WithSchema(embedServiceMethods.ByName("GetEmbedPlayback")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// embedServiceClient implements EmbedServiceClient.
+type embedServiceClient struct {
+	createEmbedToken *connect.Client[v1.CreateEmbedTokenRequest, v1.CreateEmbedTokenResponse]
+	getEmbedPlayback *connect.Client[v1.GetEmbedPlaybackRequest, v1.GetEmbedPlaybackResponse]
+}
+
+// CreateEmbedToken calls lession.v1.EmbedService.CreateEmbedToken.
+func (c *embedServiceClient) CreateEmbedToken(ctx context.Context, req *connect.Request[v1.CreateEmbedTokenRequest]) (*connect.Response[v1.CreateEmbedTokenResponse], error) {
+	return c.createEmbedToken.CallUnary(ctx, req)
+}
+
+// GetEmbedPlayback calls lession.v1.EmbedService.GetEmbedPlayback.
+func (c *embedServiceClient) GetEmbedPlayback(ctx context.Context, req *connect.Request[v1.GetEmbedPlaybackRequest]) (*connect.Response[v1.GetEmbedPlaybackResponse], error) {
+	return c.getEmbedPlayback.CallUnary(ctx, req)
+}
+
+// EmbedServiceHandler is an implementation of the lession.v1.EmbedService service.
+type EmbedServiceHandler interface {
+	// CreateEmbedToken issues an expiring token for a published episode,
+	// optionally locked to the domains it may be embedded on. Only authors of
+	// the series, and API keys scoped to it ("series:<id>") or to everything,
+	// can create tokens.
+	CreateEmbedToken(context.Context, *connect.Request[v1.CreateEmbedTokenRequest]) (*connect.Response[v1.CreateEmbedTokenResponse], error)
+	// GetEmbedPlayback exchanges an embed token for the episode and its
+	// playback URL. Domain-locked tokens only work when the request's Origin
+	// or Referer is on an allowed domain. Browsers set those headers, but any
+	// other client can forge them, so the lock stops other sites from
+	// embedding the player rather than protecting the playback URL.
+	GetEmbedPlayback(context.Context, *connect.Request[v1.GetEmbedPlaybackRequest]) (*connect.Response[v1.GetEmbedPlaybackResponse], error)
+}
+
+// NewEmbedServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewEmbedServiceHandler(svc EmbedServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	embedServiceMethods := v1.File_lession_v1_embed_service_proto.Services().ByName("EmbedService").Methods()
+	embedServiceCreateEmbedTokenHandler := connect.NewUnaryHandler(
+		EmbedServiceCreateEmbedTokenProcedure,
+		svc.CreateEmbedToken,
+		connect.WithSchema(embedServiceMethods.ByName("CreateEmbedToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	embedServiceGetEmbedPlaybackHandler := connect.NewUnaryHandler(
+		EmbedServiceGetEmbedPlaybackProcedure,
+		svc.GetEmbedPlayback,
+		connect.WithSchema(embedServiceMethods.ByName("GetEmbedPlayback")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.EmbedService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case EmbedServiceCreateEmbedTokenProcedure:
+			embedServiceCreateEmbedTokenHandler.ServeHTTP(w, r)
+		case EmbedServiceGetEmbedPlaybackProcedure:
+			embedServiceGetEmbedPlaybackHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedEmbedServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedEmbedServiceHandler struct{}
+
+func (UnimplementedEmbedServiceHandler) CreateEmbedToken(context.Context, *connect.Request[v1.CreateEmbedTokenRequest]) (*connect.Response[v1.CreateEmbedTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.EmbedService.CreateEmbedToken is not implemented"))
+}
+
+func (UnimplementedEmbedServiceHandler) GetEmbedPlayback(context.Context, *connect.Request[v1.GetEmbedPlaybackRequest]) (*connect.Response[v1.GetEmbedPlaybackResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.EmbedService.GetEmbedPlayback is not implemented"))
+}
@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/feed_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// FeedServiceName is the fully-qualified name of the FeedService service.
+	FeedServiceName = "lession.v1.FeedService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// FeedServiceCreateFeedSubscriptionProcedure is the fully-qualified name of the FeedService's
+	// CreateFeedSubscription RPC.
+	FeedServiceCreateFeedSubscriptionProcedure = "/lession.v1.FeedService/CreateFeedSubscription"
+	// FeedServiceGetFeedSubscriptionProcedure is the fully-qualified name of the FeedService's
+	// GetFeedSubscription RPC.
+	FeedServiceGetFeedSubscriptionProcedure = "/lession.v1.FeedService/GetFeedSubscription"
+	// FeedServiceListFeedSubscriptionsProcedure is the fully-qualified name of the FeedService's
+	// ListFeedSubscriptions RPC.
+	FeedServiceListFeedSubscriptionsProcedure = "/lession.v1.FeedService/ListFeedSubscriptions"
+	// FeedServiceDeleteFeedSubscriptionProcedure is the fully-qualified name of the FeedService's
+	// DeleteFeedSubscription RPC.
+	FeedServiceDeleteFeedSubscriptionProcedure = "/lession.v1.FeedService/DeleteFeedSubscription"
+	// FeedServiceSyncFeedSubscriptionProcedure is the fully-qualified name of the FeedService's
+	// SyncFeedSubscription RPC.
+	FeedServiceSyncFeedSubscriptionProcedure = "/lession.v1.FeedService/SyncFeedSubscription"
+)
+
+// FeedServiceClient is a client for the lession.v1.FeedService service.
+type FeedServiceClient interface {
+	// CreateFeedSubscription creates a draft series from a feed and ingests its current items.
+	CreateFeedSubscription(context.Context, *connect.Request[v1.CreateFeedSubscriptionRequest]) (*connect.Response[v1.CreateFeedSubscriptionResponse], error)
+	// GetFeedSubscription returns a single subscription by identifier.
+	GetFeedSubscription(context.Context, *connect.Request[v1.GetFeedSubscriptionRequest]) (*connect.Response[v1.GetFeedSubscriptionResponse], error)
+	// ListFeedSubscriptions returns subscriptions in creation order.
+	ListFeedSubscriptions(context.Context, *connect.Request[v1.ListFeedSubscriptionsRequest]) (*connect.Response[v1.ListFeedSubscriptionsResponse], error)
+	// DeleteFeedSubscription stops syncing a series; its episodes are kept.
+	DeleteFeedSubscription(context.Context, *connect.Request[v1.DeleteFeedSubscriptionRequest]) (*connect.Response[v1.DeleteFeedSubscriptionResponse], error)
+	// SyncFeedSubscription adds the new items of a feed without waiting for the periodic sync.
+	SyncFeedSubscription(context.Context, *connect.Request[v1.SyncFeedSubscriptionRequest]) (*connect.Response[v1.SyncFeedSubscriptionResponse], error)
+}
+
+// NewFeedServiceClient constructs a client for the lession.v1.FeedService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewFeedServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) FeedServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	feedServiceMethods := v1.File_lession_v1_feed_service_proto.Services().ByName("FeedService").Methods()
+	return &feedServiceClient{
+		createFeedSubscription: connect.NewClient[v1.CreateFeedSubscriptionRequest, v1.CreateFeedSubscriptionResponse](
+			httpClient,
+			baseURL+FeedServiceCreateFeedSubscriptionProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("CreateFeedSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		getFeedSubscription: connect.NewClient[v1.GetFeedSubscriptionRequest, v1.GetFeedSubscriptionResponse](
+			httpClient,
+			baseURL+FeedServiceGetFeedSubscriptionProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("GetFeedSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		listFeedSubscriptions: connect.NewClient[v1.ListFeedSubscriptionsRequest, v1.ListFeedSubscriptionsResponse](
+			httpClient,
+			baseURL+FeedServiceListFeedSubscriptionsProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("ListFeedSubscriptions")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteFeedSubscription: connect.NewClient[v1.DeleteFeedSubscriptionRequest, v1.DeleteFeedSubscriptionResponse](
+			httpClient,
+			baseURL+FeedServiceDeleteFeedSubscriptionProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("DeleteFeedSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		syncFeedSubscription: connect.NewClient[v1.SyncFeedSubscriptionRequest, v1.SyncFeedSubscriptionResponse](
+			httpClient,
+			baseURL+FeedServiceSyncFeedSubscriptionProcedure,
+			connect.WithSchema(feedServiceMethods.ByName("SyncFeedSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// feedServiceClient implements FeedServiceClient.
+type feedServiceClient struct {
+	createFeedSubscription *connect.Client[v1.CreateFeedSubscriptionRequest, v1.CreateFeedSubscriptionResponse]
+	getFeedSubscription    *connect.Client[v1.GetFeedSubscriptionRequest, v1.GetFeedSubscriptionResponse]
+	listFeedSubscriptions  *connect.Client[v1.ListFeedSubscriptionsRequest, v1.ListFeedSubscriptionsResponse]
+	deleteFeedSubscription *connect.Client[v1.DeleteFeedSubscriptionRequest, v1.DeleteFeedSubscriptionResponse]
+	syncFeedSubscription   *connect.Client[v1.SyncFeedSubscriptionRequest, v1.SyncFeedSubscriptionResponse]
+}
+
+// CreateFeedSubscription calls lession.v1.FeedService.CreateFeedSubscription.
+func (c *feedServiceClient) CreateFeedSubscription(ctx context.Context, req *connect.Request[v1.CreateFeedSubscriptionRequest]) (*connect.Response[v1.CreateFeedSubscriptionResponse], error) {
+	return c.createFeedSubscription.CallUnary(ctx, req)
+}
+
+// GetFeedSubscription calls lession.v1.FeedService.GetFeedSubscription.
+func (c *feedServiceClient) GetFeedSubscription(ctx context.Context, req *connect.Request[v1.GetFeedSubscriptionRequest]) (*connect.Response[v1.GetFeedSubscriptionResponse], error) {
+	return c.getFeedSubscription.CallUnary(ctx, req)
+}
+
+// ListFeedSubscriptions calls lession.v1.FeedService.ListFeedSubscriptions.
+func (c *feedServiceClient) ListFeedSubscriptions(ctx context.Context, req *connect.Request[v1.ListFeedSubscriptionsRequest]) (*connect.Response[v1.ListFeedSubscriptionsResponse], error) {
+	return c.listFeedSubscriptions.CallUnary(ctx, req)
+}
+
+// DeleteFeedSubscription calls lession.v1.FeedService.DeleteFeedSubscription.
+func (c *feedServiceClient) DeleteFeedSubscription(ctx context.Context, req *connect.Request[v1.DeleteFeedSubscriptionRequest]) (*connect.Response[v1.DeleteFeedSubscriptionResponse], error) {
+	return c.deleteFeedSubscription.CallUnary(ctx, req)
+}
+
+// SyncFeedSubscription calls lession.v1.FeedService.SyncFeedSubscription.
+func (c *feedServiceClient) SyncFeedSubscription(ctx context.Context, req *connect.Request[v1.SyncFeedSubscriptionRequest]) (*connect.Response[v1.SyncFeedSubscriptionResponse], error) {
+	return c.syncFeedSubscription.CallUnary(ctx, req)
+}
+
+// FeedServiceHandler is an implementation of the lession.v1.FeedService service.
+type FeedServiceHandler interface {
+	// CreateFeedSubscription creates a draft series from a feed and ingests its current items.
+	CreateFeedSubscription(context.Context, *connect.Request[v1.CreateFeedSubscriptionRequest]) (*connect.Response[v1.CreateFeedSubscriptionResponse], error)
+	// GetFeedSubscription returns a single subscription by identifier.
+	GetFeedSubscription(context.Context, *connect.Request[v1.GetFeedSubscriptionRequest]) (*connect.Response[v1.GetFeedSubscriptionResponse], error)
+	// ListFeedSubscriptions returns subscriptions in creation order.
+	ListFeedSubscriptions(context.Context, *connect.Request[v1.ListFeedSubscriptionsRequest]) (*connect.Response[v1.ListFeedSubscriptionsResponse], error)
+	// DeleteFeedSubscription stops syncing a series; its episodes are kept.
+	DeleteFeedSubscription(context.Context, *connect.Request[v1.DeleteFeedSubscriptionRequest]) (*connect.Response[v1.DeleteFeedSubscriptionResponse], error)
+	// SyncFeedSubscription adds the new items of a feed without waiting for the periodic sync.
+	SyncFeedSubscription(context.Context, *connect.Request[v1.SyncFeedSubscriptionRequest]) (*connect.Response[v1.SyncFeedSubscriptionResponse], error)
+}
+
+// NewFeedServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewFeedServiceHandler(svc FeedServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	feedServiceMethods := v1.File_lession_v1_feed_service_proto.Services().ByName("FeedService").Methods()
+	feedServiceCreateFeedSubscriptionHandler := connect.NewUnaryHandler(
+		FeedServiceCreateFeedSubscriptionProcedure,
+		svc.CreateFeedSubscription,
+		connect.WithSchema(feedServiceMethods.ByName("CreateFeedSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedServiceGetFeedSubscriptionHandler := connect.NewUnaryHandler(
+		FeedServiceGetFeedSubscriptionProcedure,
+		svc.GetFeedSubscription,
+		connect.WithSchema(feedServiceMethods.ByName("GetFeedSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedServiceListFeedSubscriptionsHandler := connect.NewUnaryHandler(
+		FeedServiceListFeedSubscriptionsProcedure,
+		svc.ListFeedSubscriptions,
+		connect.WithSchema(feedServiceMethods.ByName("ListFeedSubscriptions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedServiceDeleteFeedSubscriptionHandler := connect.NewUnaryHandler(
+		FeedServiceDeleteFeedSubscriptionProcedure,
+		svc.DeleteFeedSubscription,
+		connect.WithSchema(feedServiceMethods.ByName("DeleteFeedSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	feedServiceSyncFeedSubscriptionHandler := connect.NewUnaryHandler(
+		FeedServiceSyncFeedSubscriptionProcedure,
+		svc.SyncFeedSubscription,
+		connect.WithSchema(feedServiceMethods.ByName("SyncFeedSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.FeedService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case FeedServiceCreateFeedSubscriptionProcedure:
+			feedServiceCreateFeedSubscriptionHandler.ServeHTTP(w, r)
+		case FeedServiceGetFeedSubscriptionProcedure:
+			feedServiceGetFeedSubscriptionHandler.ServeHTTP(w, r)
+		case FeedServiceListFeedSubscriptionsProcedure:
+			feedServiceListFeedSubscriptionsHandler.ServeHTTP(w, r)
+		case FeedServiceDeleteFeedSubscriptionProcedure:
+			feedServiceDeleteFeedSubscriptionHandler.ServeHTTP(w, r)
+		case FeedServiceSyncFeedSubscriptionProcedure:
+			feedServiceSyncFeedSubscriptionHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedFeedServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedFeedServiceHandler struct{}
+
+func (UnimplementedFeedServiceHandler) CreateFeedSubscription(context.Context, *connect.Request[v1.CreateFeedSubscriptionRequest]) (*connect.Response[v1.CreateFeedSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.FeedService.CreateFeedSubscription is not implemented"))
+}
+
+func (UnimplementedFeedServiceHandler) GetFeedSubscription(context.Context, *connect.Request[v1.GetFeedSubscriptionRequest]) (*connect.Response[v1.GetFeedSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.FeedService.GetFeedSubscription is not implemented"))
+}
+
+func (UnimplementedFeedServiceHandler) ListFeedSubscriptions(context.Context, *connect.Request[v1.ListFeedSubscriptionsRequest]) (*connect.Response[v1.ListFeedSubscriptionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.FeedService.ListFeedSubscriptions is not implemented"))
+}
+
+func (UnimplementedFeedServiceHandler) DeleteFeedSubscription(context.Context, *connect.Request[v1.DeleteFeedSubscriptionRequest]) (*connect.Response[v1.DeleteFeedSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.FeedService.DeleteFeedSubscription is not implemented"))
+}
+
+func (UnimplementedFeedServiceHandler) SyncFeedSubscription(context.Context, *connect.Request[v1.SyncFeedSubscriptionRequest]) (*connect.Response[v1.SyncFeedSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.FeedService.SyncFeedSubscription is not implemented"))
+}
@@ -0,0 +1,123 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/import_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ImportServiceName is the fully-qualified name of the ImportService service.
+	ImportServiceName = "lession.v1.ImportService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ImportServiceImportCatalogProcedure is the fully-qualified name of the ImportService's
+	// ImportCatalog RPC.
+	ImportServiceImportCatalogProcedure = "/lession.v1.ImportService/ImportCatalog"
+)
+
+// ImportServiceClient is a client for the lession.v1.ImportService service.
+type ImportServiceClient interface {
+	// ImportCatalog creates series and their episodes from a spreadsheet with a
+	// header row. Columns are series_slug (required), series_title,
+	// series_summary, series_language, series_level, series_tags,
+	// series_cover_url, episode_seq, episode_title, episode_description,
+	// episode_duration, episode_preview, media_url, media_type and mime_type.
+	// Every row names its series by slug and rows with episode columns add an
+	// episode to it. Nothing is created while any row has an error.
+	ImportCatalog(context.Context, *connect.Request[v1.ImportCatalogRequest]) (*connect.Response[v1.ImportCatalogResponse], error)
+}
+
+// NewImportServiceClient constructs a client for the lession.v1.ImportService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewImportServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ImportServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	importServiceMethods := v1.File_lession_v1_import_service_proto.Services().ByName("ImportService").Methods()
+	return &importServiceClient{
+		importCatalog: connect.NewClient[v1.ImportCatalogRequest, v1.ImportCatalogResponse](
+			httpClient,
+			baseURL+ImportServiceImportCatalogProcedure,
+			connect.WithSchema(importServiceMethods.ByName("ImportCatalog")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// importServiceClient implements ImportServiceClient.
+type importServiceClient struct {
+	importCatalog *connect.Client[v1.ImportCatalogRequest, v1.ImportCatalogResponse]
+}
+
+// ImportCatalog calls lession.v1.ImportService.ImportCatalog.
+func (c *importServiceClient) ImportCatalog(ctx context.Context, req *connect.Request[v1.ImportCatalogRequest]) (*connect.Response[v1.ImportCatalogResponse], error) {
+	return c.importCatalog.CallUnary(ctx, req)
+}
+
+// ImportServiceHandler is an implementation of the lession.v1.ImportService service.
+type ImportServiceHandler interface {
+	// ImportCatalog creates series and their episodes from a spreadsheet with a
+	// header row. Columns are series_slug (required), series_title,
+	// series_summary, series_language, series_level, series_tags,
+	// series_cover_url, episode_seq, episode_title, episode_description,
+	// episode_duration, episode_preview, media_url, media_type and mime_type.
+	// Every row names its series by slug and rows with episode columns add an
+	// episode to it. Nothing is created while any row has an error.
+	ImportCatalog(context.Context, *connect.Request[v1.ImportCatalogRequest]) (*connect.Response[v1.ImportCatalogResponse], error)
+}
+
+// NewImportServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewImportServiceHandler(svc ImportServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	importServiceMethods := v1.File_lession_v1_import_service_proto.Services().ByName("ImportService").Methods()
+	importServiceImportCatalogHandler := connect.NewUnaryHandler(
+		ImportServiceImportCatalogProcedure,
+		svc.ImportCatalog,
+		connect.WithSchema(importServiceMethods.ByName("ImportCatalog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.ImportService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ImportServiceImportCatalogProcedure:
+			importServiceImportCatalogHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedImportServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedImportServiceHandler struct{}
+
+func (UnimplementedImportServiceHandler) ImportCatalog(context.Context, *connect.Request[v1.ImportCatalogRequest]) (*connect.Response[v1.ImportCatalogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ImportService.ImportCatalog is not implemented"))
+}
@@ -0,0 +1,114 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/interactive_transcript_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// InteractiveTranscriptServiceName is the fully-qualified name of the InteractiveTranscriptService
+	// service.
+	InteractiveTranscriptServiceName = "lession.v1.InteractiveTranscriptService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// InteractiveTranscriptServiceGetInteractiveTranscriptProcedure is the fully-qualified name of the
+	// InteractiveTranscriptService's GetInteractiveTranscript RPC.
+	InteractiveTranscriptServiceGetInteractiveTranscriptProcedure = "/lession.v1.InteractiveTranscriptService/GetInteractiveTranscript"
+)
+
+// InteractiveTranscriptServiceClient is a client for the lession.v1.InteractiveTranscriptService
+// service.
+type InteractiveTranscriptServiceClient interface {
+	// GetInteractiveTranscript splits an episode's transcript into tokens with dictionary lookups.
+	GetInteractiveTranscript(context.Context, *connect.Request[v1.GetInteractiveTranscriptRequest]) (*connect.Response[v1.GetInteractiveTranscriptResponse], error)
+}
+
+// NewInteractiveTranscriptServiceClient constructs a client for the
+// lession.v1.InteractiveTranscriptService service. By default, it uses the Connect protocol with
+// the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To use
+// the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewInteractiveTranscriptServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) InteractiveTranscriptServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	interactiveTranscriptServiceMethods := v1.File_lession_v1_interactive_transcript_service_proto.Services().ByName("InteractiveTranscriptService").Methods()
+	return &interactiveTranscriptServiceClient{
+		getInteractiveTranscript: connect.NewClient[v1.GetInteractiveTranscriptRequest, v1.GetInteractiveTranscriptResponse](
+			httpClient,
+			baseURL+InteractiveTranscriptServiceGetInteractiveTranscriptProcedure,
+			connect.WithSchema(interactiveTranscriptServiceMethods.ByName("GetInteractiveTranscript")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// interactiveTranscriptServiceClient implements InteractiveTranscriptServiceClient.
+type interactiveTranscriptServiceClient struct {
+	getInteractiveTranscript *connect.Client[v1.GetInteractiveTranscriptRequest, v1.GetInteractiveTranscriptResponse]
+}
+
+// GetInteractiveTranscript calls lession.v1.InteractiveTranscriptService.GetInteractiveTranscript.
+func (c *interactiveTranscriptServiceClient) GetInteractiveTranscript(ctx context.Context, req *connect.Request[v1.GetInteractiveTranscriptRequest]) (*connect.Response[v1.GetInteractiveTranscriptResponse], error) {
+	return c.getInteractiveTranscript.CallUnary(ctx, req)
+}
+
+// InteractiveTranscriptServiceHandler is an implementation of the
+// lession.v1.InteractiveTranscriptService service.
+type InteractiveTranscriptServiceHandler interface {
+	// GetInteractiveTranscript splits an episode's transcript into tokens with dictionary lookups.
+	GetInteractiveTranscript(context.Context, *connect.Request[v1.GetInteractiveTranscriptRequest]) (*connect.Response[v1.GetInteractiveTranscriptResponse], error)
+}
+
+// NewInteractiveTranscriptServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewInteractiveTranscriptServiceHandler(svc InteractiveTranscriptServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	interactiveTranscriptServiceMethods := v1.File_lession_v1_interactive_transcript_service_proto.Services().ByName("InteractiveTranscriptService").Methods()
+	interactiveTranscriptServiceGetInteractiveTranscriptHandler := connect.NewUnaryHandler(
+		InteractiveTranscriptServiceGetInteractiveTranscriptProcedure,
+		svc.GetInteractiveTranscript,
+		connect.WithSchema(interactiveTranscriptServiceMethods.ByName("GetInteractiveTranscript")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.InteractiveTranscriptService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case InteractiveTranscriptServiceGetInteractiveTranscriptProcedure:
+			interactiveTranscriptServiceGetInteractiveTranscriptHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedInteractiveTranscriptServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedInteractiveTranscriptServiceHandler struct{}
+
+func (UnimplementedInteractiveTranscriptServiceHandler) GetInteractiveTranscript(context.Context, *connect.Request[v1.GetInteractiveTranscriptRequest]) (*connect.Response[v1.GetInteractiveTranscriptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.InteractiveTranscriptService.GetInteractiveTranscript is not implemented"))
+}
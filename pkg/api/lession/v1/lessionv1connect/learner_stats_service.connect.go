@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/learner_stats_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// LearnerStatsServiceName is the fully-qualified name of the LearnerStatsService service.
+	LearnerStatsServiceName = "lession.v1.LearnerStatsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// LearnerStatsServiceRecordActivityProcedure is the fully-qualified name of the
+	// LearnerStatsService's RecordActivity RPC.
+	LearnerStatsServiceRecordActivityProcedure = "/lession.v1.LearnerStatsService/RecordActivity"
+	// LearnerStatsServiceGetLearnerStatsProcedure is the fully-qualified name of the
+	// LearnerStatsService's GetLearnerStats RPC.
+	LearnerStatsServiceGetLearnerStatsProcedure = "/lession.v1.LearnerStatsService/GetLearnerStats"
+	// LearnerStatsServiceListWeeklySummariesProcedure is the fully-qualified name of the
+	// LearnerStatsService's ListWeeklySummaries RPC.
+	LearnerStatsServiceListWeeklySummariesProcedure = "/lession.v1.LearnerStatsService/ListWeeklySummaries"
+)
+
+// LearnerStatsServiceClient is a client for the lession.v1.LearnerStatsService service.
+type LearnerStatsServiceClient interface {
+	// RecordActivity adds listening minutes and completed episodes to a learner's day.
+	RecordActivity(context.Context, *connect.Request[v1.RecordActivityRequest]) (*connect.Response[v1.RecordActivityResponse], error)
+	// GetLearnerStats returns lifetime totals and streaks for a learner.
+	GetLearnerStats(context.Context, *connect.Request[v1.GetLearnerStatsRequest]) (*connect.Response[v1.GetLearnerStatsResponse], error)
+	// ListWeeklySummaries aggregates learner activity into recent weeks.
+	ListWeeklySummaries(context.Context, *connect.Request[v1.ListWeeklySummariesRequest]) (*connect.Response[v1.ListWeeklySummariesResponse], error)
+}
+
+// NewLearnerStatsServiceClient constructs a client for the lession.v1.LearnerStatsService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewLearnerStatsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) LearnerStatsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	learnerStatsServiceMethods := v1.File_lession_v1_learner_stats_service_proto.Services().ByName("LearnerStatsService").Methods()
+	return &learnerStatsServiceClient{
+		recordActivity: connect.NewClient[v1.RecordActivityRequest, v1.RecordActivityResponse](
+			httpClient,
+			baseURL+LearnerStatsServiceRecordActivityProcedure,
+			connect.WithSchema(learnerStatsServiceMethods.ByName("RecordActivity")),
+			connect.WithClientOptions(opts...),
+		),
+		getLearnerStats: connect.NewClient[v1.GetLearnerStatsRequest, v1.GetLearnerStatsResponse](
+			httpClient,
+			baseURL+LearnerStatsServiceGetLearnerStatsProcedure,
+			connect.WithSchema(learnerStatsServiceMethods.ByName("GetLearnerStats")),
+			connect.WithClientOptions(opts...),
+		),
+		listWeeklySummaries: connect.NewClient[v1.ListWeeklySummariesRequest, v1.ListWeeklySummariesResponse](
+			httpClient,
+			baseURL+LearnerStatsServiceListWeeklySummariesProcedure,
+			connect.WithSchema(learnerStatsServiceMethods.ByName("ListWeeklySummaries")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// learnerStatsServiceClient implements LearnerStatsServiceClient.
+type learnerStatsServiceClient struct {
+	recordActivity      *connect.Client[v1.RecordActivityRequest, v1.RecordActivityResponse]
+	getLearnerStats     *connect.Client[v1.GetLearnerStatsRequest, v1.GetLearnerStatsResponse]
+	listWeeklySummaries *connect.Client[v1.ListWeeklySummariesRequest, v1.ListWeeklySummariesResponse]
+}
+
+// RecordActivity calls lession.v1.LearnerStatsService.RecordActivity.
+func (c *learnerStatsServiceClient) RecordActivity(ctx context.Context, req *connect.Request[v1.RecordActivityRequest]) (*connect.Response[v1.RecordActivityResponse], error) {
+	return c.recordActivity.CallUnary(ctx, req)
+}
+
+// GetLearnerStats calls lession.v1.LearnerStatsService.GetLearnerStats.
+func (c *learnerStatsServiceClient) GetLearnerStats(ctx context.Context, req *connect.Request[v1.GetLearnerStatsRequest]) (*connect.Response[v1.GetLearnerStatsResponse], error) {
+	return c.getLearnerStats.CallUnary(ctx, req)
+}
+
+// ListWeeklySummaries calls lession.v1.LearnerStatsService.ListWeeklySummaries.
+func (c *learnerStatsServiceClient) ListWeeklySummaries(ctx context.Context, req *connect.Request[v1.ListWeeklySummariesRequest]) (*connect.Response[v1.ListWeeklySummariesResponse], error) {
+	return c.listWeeklySummaries.CallUnary(ctx, req)
+}
+
+// LearnerStatsServiceHandler is an implementation of the lession.v1.LearnerStatsService service.
+type LearnerStatsServiceHandler interface {
+	// RecordActivity adds listening minutes and completed episodes to a learner's day.
+	RecordActivity(context.Context, *connect.Request[v1.RecordActivityRequest]) (*connect.Response[v1.RecordActivityResponse], error)
+	// GetLearnerStats returns lifetime totals and streaks for a learner.
+	GetLearnerStats(context.Context, *connect.Request[v1.GetLearnerStatsRequest]) (*connect.Response[v1.GetLearnerStatsResponse], error)
+	// ListWeeklySummaries aggregates learner activity into recent weeks.
+	ListWeeklySummaries(context.Context, *connect.Request[v1.ListWeeklySummariesRequest]) (*connect.Response[v1.ListWeeklySummariesResponse], error)
+}
+
+// NewLearnerStatsServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewLearnerStatsServiceHandler(svc LearnerStatsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	learnerStatsServiceMethods := v1.File_lession_v1_learner_stats_service_proto.Services().ByName("LearnerStatsService").Methods()
+	learnerStatsServiceRecordActivityHandler := connect.NewUnaryHandler(
+		LearnerStatsServiceRecordActivityProcedure,
+		svc.RecordActivity,
+		connect.WithSchema(learnerStatsServiceMethods.ByName("RecordActivity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	learnerStatsServiceGetLearnerStatsHandler := connect.NewUnaryHandler(
+		LearnerStatsServiceGetLearnerStatsProcedure,
+		svc.GetLearnerStats,
+		connect.WithSchema(learnerStatsServiceMethods.ByName("GetLearnerStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	learnerStatsServiceListWeeklySummariesHandler := connect.NewUnaryHandler(
+		LearnerStatsServiceListWeeklySummariesProcedure,
+		svc.ListWeeklySummaries,
+		connect.WithSchema(learnerStatsServiceMethods.ByName("ListWeeklySummaries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.LearnerStatsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case LearnerStatsServiceRecordActivityProcedure:
+			learnerStatsServiceRecordActivityHandler.ServeHTTP(w, r)
+		case LearnerStatsServiceGetLearnerStatsProcedure:
+			learnerStatsServiceGetLearnerStatsHandler.ServeHTTP(w, r)
+		case LearnerStatsServiceListWeeklySummariesProcedure:
+			learnerStatsServiceListWeeklySummariesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedLearnerStatsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedLearnerStatsServiceHandler struct{}
+
+func (UnimplementedLearnerStatsServiceHandler) RecordActivity(context.Context, *connect.Request[v1.RecordActivityRequest]) (*connect.Response[v1.RecordActivityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.LearnerStatsService.RecordActivity is not implemented"))
+}
+
+func (UnimplementedLearnerStatsServiceHandler) GetLearnerStats(context.Context, *connect.Request[v1.GetLearnerStatsRequest]) (*connect.Response[v1.GetLearnerStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.LearnerStatsService.GetLearnerStats is not implemented"))
+}
+
+func (UnimplementedLearnerStatsServiceHandler) ListWeeklySummaries(context.Context, *connect.Request[v1.ListWeeklySummariesRequest]) (*connect.Response[v1.ListWeeklySummariesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.LearnerStatsService.ListWeeklySummaries is not implemented"))
+}
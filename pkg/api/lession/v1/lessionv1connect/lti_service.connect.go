@@ -0,0 +1,238 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/lti_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// LtiServiceName is the fully-qualified name of the LtiService service.
+	LtiServiceName = "lession.v1.LtiService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// LtiServiceRegisterLtiPlatformProcedure is the fully-qualified name of the LtiService's
+	// RegisterLtiPlatform RPC.
+	LtiServiceRegisterLtiPlatformProcedure = "/lession.v1.LtiService/RegisterLtiPlatform"
+	// LtiServiceListLtiPlatformsProcedure is the fully-qualified name of the LtiService's
+	// ListLtiPlatforms RPC.
+	LtiServiceListLtiPlatformsProcedure = "/lession.v1.LtiService/ListLtiPlatforms"
+	// LtiServiceGetLtiLaunchProcedure is the fully-qualified name of the LtiService's GetLtiLaunch RPC.
+	LtiServiceGetLtiLaunchProcedure = "/lession.v1.LtiService/GetLtiLaunch"
+	// LtiServiceCreateLtiDeepLinkingResponseProcedure is the fully-qualified name of the LtiService's
+	// CreateLtiDeepLinkingResponse RPC.
+	LtiServiceCreateLtiDeepLinkingResponseProcedure = "/lession.v1.LtiService/CreateLtiDeepLinkingResponse"
+	// LtiServiceSubmitLtiScoreProcedure is the fully-qualified name of the LtiService's SubmitLtiScore
+	// RPC.
+	LtiServiceSubmitLtiScoreProcedure = "/lession.v1.LtiService/SubmitLtiScore"
+)
+
+// LtiServiceClient is a client for the lession.v1.LtiService service.
+type LtiServiceClient interface {
+	// RegisterLtiPlatform registers an LMS platform.
+	RegisterLtiPlatform(context.Context, *connect.Request[v1.RegisterLtiPlatformRequest]) (*connect.Response[v1.RegisterLtiPlatformResponse], error)
+	// ListLtiPlatforms returns every registered platform.
+	ListLtiPlatforms(context.Context, *connect.Request[v1.ListLtiPlatformsRequest]) (*connect.Response[v1.ListLtiPlatformsResponse], error)
+	// GetLtiLaunch returns a launch so the embedded player can open its episode.
+	GetLtiLaunch(context.Context, *connect.Request[v1.GetLtiLaunchRequest]) (*connect.Response[v1.GetLtiLaunchResponse], error)
+	// CreateLtiDeepLinkingResponse signs the episodes an instructor picked
+	// during a deep linking launch.
+	CreateLtiDeepLinkingResponse(context.Context, *connect.Request[v1.CreateLtiDeepLinkingResponseRequest]) (*connect.Response[v1.CreateLtiDeepLinkingResponseResponse], error)
+	// SubmitLtiScore posts full marks to the platform gradebook once the
+	// learner has finished the launched episode.
+	SubmitLtiScore(context.Context, *connect.Request[v1.SubmitLtiScoreRequest]) (*connect.Response[v1.SubmitLtiScoreResponse], error)
+}
+
+// NewLtiServiceClient constructs a client for the lession.v1.LtiService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewLtiServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) LtiServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	ltiServiceMethods := v1.File_lession_v1_lti_service_proto.Services().ByName("LtiService").Methods()
+	return &ltiServiceClient{
+		registerLtiPlatform: connect.NewClient[v1.RegisterLtiPlatformRequest, v1.RegisterLtiPlatformResponse](
+			httpClient,
+			baseURL+LtiServiceRegisterLtiPlatformProcedure,
+			connect.WithSchema(ltiServiceMethods.ByName("RegisterLtiPlatform")),
+			connect.WithClientOptions(opts...),
+		),
+		listLtiPlatforms: connect.NewClient[v1.ListLtiPlatformsRequest, v1.ListLtiPlatformsResponse](
+			httpClient,
+			baseURL+LtiServiceListLtiPlatformsProcedure,
+			connect.WithSchema(ltiServiceMethods.ByName("ListLtiPlatforms")),
+			connect.WithClientOptions(opts...),
+		),
+		getLtiLaunch: connect.NewClient[v1.GetLtiLaunchRequest, v1.GetLtiLaunchResponse](
+			httpClient,
+			baseURL+LtiServiceGetLtiLaunchProcedure,
+			connect.WithSchema(ltiServiceMethods.ByName("GetLtiLaunch")),
+			connect.WithClientOptions(opts...),
+		),
+		createLtiDeepLinkingResponse: connect.NewClient[v1.CreateLtiDeepLinkingResponseRequest, v1.CreateLtiDeepLinkingResponseResponse](
+			httpClient,
+			baseURL+LtiServiceCreateLtiDeepLinkingResponseProcedure,
+			connect.WithSchema(ltiServiceMethods.ByName("CreateLtiDeepLinkingResponse")),
+			connect.WithClientOptions(opts...),
+		),
+		submitLtiScore: connect.NewClient[v1.SubmitLtiScoreRequest, v1.SubmitLtiScoreResponse](
+			httpClient,
+			baseURL+LtiServiceSubmitLtiScoreProcedure,
+			connect.WithSchema(ltiServiceMethods.ByName("SubmitLtiScore")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// ltiServiceClient implements LtiServiceClient.
+type ltiServiceClient struct {
+	registerLtiPlatform          *connect.Client[v1.RegisterLtiPlatformRequest, v1.RegisterLtiPlatformResponse]
+	listLtiPlatforms             *connect.Client[v1.ListLtiPlatformsRequest, v1.ListLtiPlatformsResponse]
+	getLtiLaunch                 *connect.Client[v1.GetLtiLaunchRequest, v1.GetLtiLaunchResponse]
+	createLtiDeepLinkingResponse *connect.Client[v1.CreateLtiDeepLinkingResponseRequest, v1.CreateLtiDeepLinkingResponseResponse]
+	submitLtiScore               *connect.Client[v1.SubmitLtiScoreRequest, v1.SubmitLtiScoreResponse]
+}
+
+// RegisterLtiPlatform calls lession.v1.LtiService.RegisterLtiPlatform.
+func (c *ltiServiceClient) RegisterLtiPlatform(ctx context.Context, req *connect.Request[v1.RegisterLtiPlatformRequest]) (*connect.Response[v1.RegisterLtiPlatformResponse], error) {
+	return c.registerLtiPlatform.CallUnary(ctx, req)
+}
+
+// ListLtiPlatforms calls lession.v1.LtiService.ListLtiPlatforms.
+func (c *ltiServiceClient) ListLtiPlatforms(ctx context.Context, req *connect.Request[v1.ListLtiPlatformsRequest]) (*connect.Response[v1.ListLtiPlatformsResponse], error) {
+	return c.listLtiPlatforms.CallUnary(ctx, req)
+}
+
+// GetLtiLaunch calls lession.v1.LtiService.GetLtiLaunch.
+func (c *ltiServiceClient) GetLtiLaunch(ctx context.Context, req *connect.Request[v1.GetLtiLaunchRequest]) (*connect.Response[v1.GetLtiLaunchResponse], error) {
+	return c.getLtiLaunch.CallUnary(ctx, req)
+}
+
+// CreateLtiDeepLinkingResponse calls lession.v1.LtiService.CreateLtiDeepLinkingResponse.
+func (c *ltiServiceClient) CreateLtiDeepLinkingResponse(ctx context.Context, req *connect.Request[v1.CreateLtiDeepLinkingResponseRequest]) (*connect.Response[v1.CreateLtiDeepLinkingResponseResponse], error) {
+	return c.createLtiDeepLinkingResponse.CallUnary(ctx, req)
+}
+
+// SubmitLtiScore calls lession.v1.LtiService.SubmitLtiScore.
+func (c *ltiServiceClient) SubmitLtiScore(ctx context.Context, req *connect.Request[v1.SubmitLtiScoreRequest]) (*connect.Response[v1.SubmitLtiScoreResponse], error) {
+	return c.submitLtiScore.CallUnary(ctx, req)
+}
+
+// LtiServiceHandler is an implementation of the lession.v1.LtiService service.
+type LtiServiceHandler interface {
+	// RegisterLtiPlatform registers an LMS platform.
+	RegisterLtiPlatform(context.Context, *connect.Request[v1.RegisterLtiPlatformRequest]) (*connect.Response[v1.RegisterLtiPlatformResponse], error)
+	// ListLtiPlatforms returns every registered platform.
+	ListLtiPlatforms(context.Context, *connect.Request[v1.ListLtiPlatformsRequest]) (*connect.Response[v1.ListLtiPlatformsResponse], error)
+	// GetLtiLaunch returns a launch so the embedded player can open its episode.
+	GetLtiLaunch(context.Context, *connect.Request[v1.GetLtiLaunchRequest]) (*connect.Response[v1.GetLtiLaunchResponse], error)
+	// CreateLtiDeepLinkingResponse signs the episodes an instructor picked
+	// during a deep linking launch.
+	CreateLtiDeepLinkingResponse(context.Context, *connect.Request[v1.CreateLtiDeepLinkingResponseRequest]) (*connect.Response[v1.CreateLtiDeepLinkingResponseResponse], error)
+	// SubmitLtiScore posts full marks to the platform gradebook once the
+	// learner has finished the launched episode.
+	SubmitLtiScore(context.Context, *connect.Request[v1.SubmitLtiScoreRequest]) (*connect.Response[v1.SubmitLtiScoreResponse], error)
+}
+
+// NewLtiServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewLtiServiceHandler(svc LtiServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	ltiServiceMethods := v1.File_lession_v1_lti_service_proto.Services().ByName("LtiService").Methods()
+	ltiServiceRegisterLtiPlatformHandler := connect.NewUnaryHandler(
+		LtiServiceRegisterLtiPlatformProcedure,
+		svc.RegisterLtiPlatform,
+		connect.WithSchema(ltiServiceMethods.ByName("RegisterLtiPlatform")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ltiServiceListLtiPlatformsHandler := connect.NewUnaryHandler(
+		LtiServiceListLtiPlatformsProcedure,
+		svc.ListLtiPlatforms,
+		connect.WithSchema(ltiServiceMethods.ByName("ListLtiPlatforms")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ltiServiceGetLtiLaunchHandler := connect.NewUnaryHandler(
+		LtiServiceGetLtiLaunchProcedure,
+		svc.GetLtiLaunch,
+		connect.WithSchema(ltiServiceMethods.ByName("GetLtiLaunch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ltiServiceCreateLtiDeepLinkingResponseHandler := connect.NewUnaryHandler(
+		LtiServiceCreateLtiDeepLinkingResponseProcedure,
+		svc.CreateLtiDeepLinkingResponse,
+		connect.WithSchema(ltiServiceMethods.ByName("CreateLtiDeepLinkingResponse")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ltiServiceSubmitLtiScoreHandler := connect.NewUnaryHandler(
+		LtiServiceSubmitLtiScoreProcedure,
+		svc.SubmitLtiScore,
+		connect.WithSchema(ltiServiceMethods.ByName("SubmitLtiScore")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.LtiService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case LtiServiceRegisterLtiPlatformProcedure:
+			ltiServiceRegisterLtiPlatformHandler.ServeHTTP(w, r)
+		case LtiServiceListLtiPlatformsProcedure:
+			ltiServiceListLtiPlatformsHandler.ServeHTTP(w, r)
+		case LtiServiceGetLtiLaunchProcedure:
+			ltiServiceGetLtiLaunchHandler.ServeHTTP(w, r)
+		case LtiServiceCreateLtiDeepLinkingResponseProcedure:
+			ltiServiceCreateLtiDeepLinkingResponseHandler.ServeHTTP(w, r)
+		case LtiServiceSubmitLtiScoreProcedure:
+			ltiServiceSubmitLtiScoreHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedLtiServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedLtiServiceHandler struct{}
+
+func (UnimplementedLtiServiceHandler) RegisterLtiPlatform(context.Context, *connect.Request[v1.RegisterLtiPlatformRequest]) (*connect.Response[v1.RegisterLtiPlatformResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.LtiService.RegisterLtiPlatform is not implemented"))
+}
+
+func (UnimplementedLtiServiceHandler) ListLtiPlatforms(context.Context, *connect.Request[v1.ListLtiPlatformsRequest]) (*connect.Response[v1.ListLtiPlatformsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.LtiService.ListLtiPlatforms is not implemented"))
+}
+
+func (UnimplementedLtiServiceHandler) GetLtiLaunch(context.Context, *connect.Request[v1.GetLtiLaunchRequest]) (*connect.Response[v1.GetLtiLaunchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.LtiService.GetLtiLaunch is not implemented"))
+}
+
+func (UnimplementedLtiServiceHandler) CreateLtiDeepLinkingResponse(context.Context, *connect.Request[v1.CreateLtiDeepLinkingResponseRequest]) (*connect.Response[v1.CreateLtiDeepLinkingResponseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.LtiService.CreateLtiDeepLinkingResponse is not implemented"))
+}
+
+func (UnimplementedLtiServiceHandler) SubmitLtiScore(context.Context, *connect.Request[v1.SubmitLtiScoreRequest]) (*connect.Response[v1.SubmitLtiScoreResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.LtiService.SubmitLtiScore is not implemented"))
+}
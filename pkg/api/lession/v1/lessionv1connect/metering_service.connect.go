@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/metering_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// MeteringServiceName is the fully-qualified name of the MeteringService service.
+	MeteringServiceName = "lession.v1.MeteringService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// MeteringServiceRecordUsageProcedure is the fully-qualified name of the MeteringService's
+	// RecordUsage RPC.
+	MeteringServiceRecordUsageProcedure = "/lession.v1.MeteringService/RecordUsage"
+	// MeteringServiceCreateUsageSnapshotProcedure is the fully-qualified name of the MeteringService's
+	// CreateUsageSnapshot RPC.
+	MeteringServiceCreateUsageSnapshotProcedure = "/lession.v1.MeteringService/CreateUsageSnapshot"
+	// MeteringServiceListUsageSnapshotsProcedure is the fully-qualified name of the MeteringService's
+	// ListUsageSnapshots RPC.
+	MeteringServiceListUsageSnapshotsProcedure = "/lession.v1.MeteringService/ListUsageSnapshots"
+	// MeteringServiceExportInvoiceLineItemsProcedure is the fully-qualified name of the
+	// MeteringService's ExportInvoiceLineItems RPC.
+	MeteringServiceExportInvoiceLineItemsProcedure = "/lession.v1.MeteringService/ExportInvoiceLineItems"
+)
+
+// MeteringServiceClient is a client for the lession.v1.MeteringService service.
+type MeteringServiceClient interface {
+	// RecordUsage reports a metered quantity for a tenant.
+	RecordUsage(context.Context, *connect.Request[v1.RecordUsageRequest]) (*connect.Response[v1.RecordUsageResponse], error)
+	// CreateUsageSnapshot aggregates a closed month of usage into an immutable snapshot.
+	CreateUsageSnapshot(context.Context, *connect.Request[v1.CreateUsageSnapshotRequest]) (*connect.Response[v1.CreateUsageSnapshotResponse], error)
+	// ListUsageSnapshots returns stored snapshots, newest period first.
+	ListUsageSnapshots(context.Context, *connect.Request[v1.ListUsageSnapshotsRequest]) (*connect.Response[v1.ListUsageSnapshotsResponse], error)
+	// ExportInvoiceLineItems converts a snapshot into invoice line items.
+	ExportInvoiceLineItems(context.Context, *connect.Request[v1.ExportInvoiceLineItemsRequest]) (*connect.Response[v1.ExportInvoiceLineItemsResponse], error)
+}
+
+// NewMeteringServiceClient constructs a client for the lession.v1.MeteringService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMeteringServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MeteringServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	meteringServiceMethods := v1.File_lession_v1_metering_service_proto.Services().ByName("MeteringService").Methods()
+	return &meteringServiceClient{
+		recordUsage: connect.NewClient[v1.RecordUsageRequest, v1.RecordUsageResponse](
+			httpClient,
+			baseURL+MeteringServiceRecordUsageProcedure,
+			connect.WithSchema(meteringServiceMethods.ByName("RecordUsage")),
+			connect.WithClientOptions(opts...),
+		),
+		createUsageSnapshot: connect.NewClient[v1.CreateUsageSnapshotRequest, v1.CreateUsageSnapshotResponse](
+			httpClient,
+			baseURL+MeteringServiceCreateUsageSnapshotProcedure,
+			connect.WithSchema(meteringServiceMethods.ByName("CreateUsageSnapshot")),
+			connect.WithClientOptions(opts...),
+		),
+		listUsageSnapshots: connect.NewClient[v1.ListUsageSnapshotsRequest, v1.ListUsageSnapshotsResponse](
+			httpClient,
+			baseURL+MeteringServiceListUsageSnapshotsProcedure,
+			connect.WithSchema(meteringServiceMethods.ByName("ListUsageSnapshots")),
+			connect.WithClientOptions(opts...),
+		),
+		exportInvoiceLineItems: connect.NewClient[v1.ExportInvoiceLineItemsRequest, v1.ExportInvoiceLineItemsResponse](
+			httpClient,
+			baseURL+MeteringServiceExportInvoiceLineItemsProcedure,
+			connect.WithSchema(meteringServiceMethods.ByName("ExportInvoiceLineItems")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// meteringServiceClient implements MeteringServiceClient.
+type meteringServiceClient struct {
+	recordUsage            *connect.Client[v1.RecordUsageRequest, v1.RecordUsageResponse]
+	createUsageSnapshot    *connect.Client[v1.CreateUsageSnapshotRequest, v1.CreateUsageSnapshotResponse]
+	listUsageSnapshots     *connect.Client[v1.ListUsageSnapshotsRequest, v1.ListUsageSnapshotsResponse]
+	exportInvoiceLineItems *connect.Client[v1.ExportInvoiceLineItemsRequest, v1.ExportInvoiceLineItemsResponse]
+}
+
+// RecordUsage calls lession.v1.MeteringService.RecordUsage.
+func (c *meteringServiceClient) RecordUsage(ctx context.Context, req *connect.Request[v1.RecordUsageRequest]) (*connect.Response[v1.RecordUsageResponse], error) {
+	return c.recordUsage.CallUnary(ctx, req)
+}
+
+// CreateUsageSnapshot calls lession.v1.MeteringService.CreateUsageSnapshot.
+func (c *meteringServiceClient) CreateUsageSnapshot(ctx context.Context, req *connect.Request[v1.CreateUsageSnapshotRequest]) (*connect.Response[v1.CreateUsageSnapshotResponse], error) {
+	return c.createUsageSnapshot.CallUnary(ctx, req)
+}
+
+// ListUsageSnapshots calls lession.v1.MeteringService.ListUsageSnapshots.
+func (c *meteringServiceClient) ListUsageSnapshots(ctx context.Context, req *connect.Request[v1.ListUsageSnapshotsRequest]) (*connect.Response[v1.ListUsageSnapshotsResponse], error) {
+	return c.listUsageSnapshots.CallUnary(ctx, req)
+}
+
+// ExportInvoiceLineItems calls lession.v1.MeteringService.ExportInvoiceLineItems.
+func (c *meteringServiceClient) ExportInvoiceLineItems(ctx context.Context, req *connect.Request[v1.ExportInvoiceLineItemsRequest]) (*connect.Response[v1.ExportInvoiceLineItemsResponse], error) {
+	return c.exportInvoiceLineItems.CallUnary(ctx, req)
+}
+
+// MeteringServiceHandler is an implementation of the lession.v1.MeteringService service.
+type MeteringServiceHandler interface {
+	// RecordUsage reports a metered quantity for a tenant.
+	RecordUsage(context.Context, *connect.Request[v1.RecordUsageRequest]) (*connect.Response[v1.RecordUsageResponse], error)
+	// CreateUsageSnapshot aggregates a closed month of usage into an immutable snapshot.
+	CreateUsageSnapshot(context.Context, *connect.Request[v1.CreateUsageSnapshotRequest]) (*connect.Response[v1.CreateUsageSnapshotResponse], error)
+	// ListUsageSnapshots returns stored snapshots, newest period first.
+	ListUsageSnapshots(context.Context, *connect.Request[v1.ListUsageSnapshotsRequest]) (*connect.Response[v1.ListUsageSnapshotsResponse], error)
+	// ExportInvoiceLineItems converts a snapshot into invoice line items.
+	ExportInvoiceLineItems(context.Context, *connect.Request[v1.ExportInvoiceLineItemsRequest]) (*connect.Response[v1.ExportInvoiceLineItemsResponse], error)
+}
+
+// NewMeteringServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMeteringServiceHandler(svc MeteringServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	meteringServiceMethods := v1.File_lession_v1_metering_service_proto.Services().ByName("MeteringService").Methods()
+	meteringServiceRecordUsageHandler := connect.NewUnaryHandler(
+		MeteringServiceRecordUsageProcedure,
+		svc.RecordUsage,
+		connect.WithSchema(meteringServiceMethods.ByName("RecordUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	meteringServiceCreateUsageSnapshotHandler := connect.NewUnaryHandler(
+		MeteringServiceCreateUsageSnapshotProcedure,
+		svc.CreateUsageSnapshot,
+		connect.WithSchema(meteringServiceMethods.ByName("CreateUsageSnapshot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	meteringServiceListUsageSnapshotsHandler := connect.NewUnaryHandler(
+		MeteringServiceListUsageSnapshotsProcedure,
+		svc.ListUsageSnapshots,
+		connect.WithSchema(meteringServiceMethods.ByName("ListUsageSnapshots")),
+		connect.WithHandlerOptions(opts...),
+	)
+	meteringServiceExportInvoiceLineItemsHandler := connect.NewUnaryHandler(
+		MeteringServiceExportInvoiceLineItemsProcedure,
+		svc.ExportInvoiceLineItems,
+		connect.WithSchema(meteringServiceMethods.ByName("ExportInvoiceLineItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.MeteringService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MeteringServiceRecordUsageProcedure:
+			meteringServiceRecordUsageHandler.ServeHTTP(w, r)
+		case MeteringServiceCreateUsageSnapshotProcedure:
+			meteringServiceCreateUsageSnapshotHandler.ServeHTTP(w, r)
+		case MeteringServiceListUsageSnapshotsProcedure:
+			meteringServiceListUsageSnapshotsHandler.ServeHTTP(w, r)
+		case MeteringServiceExportInvoiceLineItemsProcedure:
+			meteringServiceExportInvoiceLineItemsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMeteringServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMeteringServiceHandler struct{}
+
+func (UnimplementedMeteringServiceHandler) RecordUsage(context.Context, *connect.Request[v1.RecordUsageRequest]) (*connect.Response[v1.RecordUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.MeteringService.RecordUsage is not implemented"))
+}
+
+func (UnimplementedMeteringServiceHandler) CreateUsageSnapshot(context.Context, *connect.Request[v1.CreateUsageSnapshotRequest]) (*connect.Response[v1.CreateUsageSnapshotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.MeteringService.CreateUsageSnapshot is not implemented"))
+}
+
+func (UnimplementedMeteringServiceHandler) ListUsageSnapshots(context.Context, *connect.Request[v1.ListUsageSnapshotsRequest]) (*connect.Response[v1.ListUsageSnapshotsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.MeteringService.ListUsageSnapshots is not implemented"))
+}
+
+func (UnimplementedMeteringServiceHandler) ExportInvoiceLineItems(context.Context, *connect.Request[v1.ExportInvoiceLineItemsRequest]) (*connect.Response[v1.ExportInvoiceLineItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.MeteringService.ExportInvoiceLineItems is not implemented"))
+}
@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/moderation_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ModerationServiceName is the fully-qualified name of the ModerationService service.
+	ModerationServiceName = "lession.v1.ModerationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ModerationServiceListModerationItemsProcedure is the fully-qualified name of the
+	// ModerationService's ListModerationItems RPC.
+	ModerationServiceListModerationItemsProcedure = "/lession.v1.ModerationService/ListModerationItems"
+	// ModerationServiceGetModerationItemProcedure is the fully-qualified name of the
+	// ModerationService's GetModerationItem RPC.
+	ModerationServiceGetModerationItemProcedure = "/lession.v1.ModerationService/GetModerationItem"
+	// ModerationServiceApproveModerationItemProcedure is the fully-qualified name of the
+	// ModerationService's ApproveModerationItem RPC.
+	ModerationServiceApproveModerationItemProcedure = "/lession.v1.ModerationService/ApproveModerationItem"
+	// ModerationServiceRejectModerationItemProcedure is the fully-qualified name of the
+	// ModerationService's RejectModerationItem RPC.
+	ModerationServiceRejectModerationItemProcedure = "/lession.v1.ModerationService/RejectModerationItem"
+	// ModerationServiceFlagModerationItemProcedure is the fully-qualified name of the
+	// ModerationService's FlagModerationItem RPC.
+	ModerationServiceFlagModerationItemProcedure = "/lession.v1.ModerationService/FlagModerationItem"
+)
+
+// ModerationServiceClient is a client for the lession.v1.ModerationService service.
+type ModerationServiceClient interface {
+	// ListModerationItems returns queued items, oldest first.
+	ListModerationItems(context.Context, *connect.Request[v1.ListModerationItemsRequest]) (*connect.Response[v1.ListModerationItemsResponse], error)
+	// GetModerationItem returns a single item by identifier.
+	GetModerationItem(context.Context, *connect.Request[v1.GetModerationItemRequest]) (*connect.Response[v1.GetModerationItemResponse], error)
+	// ApproveModerationItem clears the text for everyone to see.
+	ApproveModerationItem(context.Context, *connect.Request[v1.ApproveModerationItemRequest]) (*connect.Response[v1.ApproveModerationItemResponse], error)
+	// RejectModerationItem hides the text until its author edits it.
+	RejectModerationItem(context.Context, *connect.Request[v1.RejectModerationItemRequest]) (*connect.Response[v1.RejectModerationItemResponse], error)
+	// FlagModerationItem keeps the text hidden and escalates it for a second look.
+	FlagModerationItem(context.Context, *connect.Request[v1.FlagModerationItemRequest]) (*connect.Response[v1.FlagModerationItemResponse], error)
+}
+
+// NewModerationServiceClient constructs a client for the lession.v1.ModerationService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewModerationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ModerationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	moderationServiceMethods := v1.File_lession_v1_moderation_service_proto.Services().ByName("ModerationService").Methods()
+	return &moderationServiceClient{
+		listModerationItems: connect.NewClient[v1.ListModerationItemsRequest, v1.ListModerationItemsResponse](
+			httpClient,
+			baseURL+ModerationServiceListModerationItemsProcedure,
+			connect.WithSchema(moderationServiceMethods.ByName("ListModerationItems")),
+			connect.WithClientOptions(opts...),
+		),
+		getModerationItem: connect.NewClient[v1.GetModerationItemRequest, v1.GetModerationItemResponse](
+			httpClient,
+			baseURL+ModerationServiceGetModerationItemProcedure,
+			connect.WithSchema(moderationServiceMethods.ByName("GetModerationItem")),
+			connect.WithClientOptions(opts...),
+		),
+		approveModerationItem: connect.NewClient[v1.ApproveModerationItemRequest, v1.ApproveModerationItemResponse](
+			httpClient,
+			baseURL+ModerationServiceApproveModerationItemProcedure,
+			connect.WithSchema(moderationServiceMethods.ByName("ApproveModerationItem")),
+			connect.WithClientOptions(opts...),
+		),
+		rejectModerationItem: connect.NewClient[v1.RejectModerationItemRequest, v1.RejectModerationItemResponse](
+			httpClient,
+			baseURL+ModerationServiceRejectModerationItemProcedure,
+			connect.WithSchema(moderationServiceMethods.ByName("RejectModerationItem")),
+			connect.WithClientOptions(opts...),
+		),
+		flagModerationItem: connect.NewClient[v1.FlagModerationItemRequest, v1.FlagModerationItemResponse](
+			httpClient,
+			baseURL+ModerationServiceFlagModerationItemProcedure,
+			connect.WithSchema(moderationServiceMethods.ByName("FlagModerationItem")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// moderationServiceClient implements ModerationServiceClient.
+type moderationServiceClient struct {
+	listModerationItems   *connect.Client[v1.ListModerationItemsRequest, v1.ListModerationItemsResponse]
+	getModerationItem     *connect.Client[v1.GetModerationItemRequest, v1.GetModerationItemResponse]
+	approveModerationItem *connect.Client[v1.ApproveModerationItemRequest, v1.ApproveModerationItemResponse]
+	rejectModerationItem  *connect.Client[v1.RejectModerationItemRequest, v1.RejectModerationItemResponse]
+	flagModerationItem    *connect.Client[v1.FlagModerationItemRequest, v1.FlagModerationItemResponse]
+}
+
+// ListModerationItems calls lession.v1.ModerationService.ListModerationItems.
+func (c *moderationServiceClient) ListModerationItems(ctx context.Context, req *connect.Request[v1.ListModerationItemsRequest]) (*connect.Response[v1.ListModerationItemsResponse], error) {
+	return c.listModerationItems.CallUnary(ctx, req)
+}
+
+// GetModerationItem calls lession.v1.ModerationService.GetModerationItem.
+func (c *moderationServiceClient) GetModerationItem(ctx context.Context, req *connect.Request[v1.GetModerationItemRequest]) (*connect.Response[v1.GetModerationItemResponse], error) {
+	return c.getModerationItem.CallUnary(ctx, req)
+}
+
+// ApproveModerationItem calls lession.v1.ModerationService.ApproveModerationItem.
+func (c *moderationServiceClient) ApproveModerationItem(ctx context.Context, req *connect.Request[v1.ApproveModerationItemRequest]) (*connect.Response[v1.ApproveModerationItemResponse], error) {
+	return c.approveModerationItem.CallUnary(ctx, req)
+}
+
+// RejectModerationItem calls lession.v1.ModerationService.RejectModerationItem.
+func (c *moderationServiceClient) RejectModerationItem(ctx context.Context, req *connect.Request[v1.RejectModerationItemRequest]) (*connect.Response[v1.RejectModerationItemResponse], error) {
+	return c.rejectModerationItem.CallUnary(ctx, req)
+}
+
+// FlagModerationItem calls lession.v1.ModerationService.FlagModerationItem.
+func (c *moderationServiceClient) FlagModerationItem(ctx context.Context, req *connect.Request[v1.FlagModerationItemRequest]) (*connect.Response[v1.FlagModerationItemResponse], error) {
+	return c.flagModerationItem.CallUnary(ctx, req)
+}
+
+// ModerationServiceHandler is an implementation of the lession.v1.ModerationService service.
+type ModerationServiceHandler interface {
+	// ListModerationItems returns queued items, oldest first.
+	ListModerationItems(context.Context, *connect.Request[v1.ListModerationItemsRequest]) (*connect.Response[v1.ListModerationItemsResponse], error)
+	// GetModerationItem returns a single item by identifier.
+	GetModerationItem(context.Context, *connect.Request[v1.GetModerationItemRequest]) (*connect.Response[v1.GetModerationItemResponse], error)
+	// ApproveModerationItem clears the text for everyone to see.
+	ApproveModerationItem(context.Context, *connect.Request[v1.ApproveModerationItemRequest]) (*connect.Response[v1.ApproveModerationItemResponse], error)
+	// RejectModerationItem hides the text until its author edits it.
+	RejectModerationItem(context.Context, *connect.Request[v1.RejectModerationItemRequest]) (*connect.Response[v1.RejectModerationItemResponse], error)
+	// FlagModerationItem keeps the text hidden and escalates it for a second look.
+	FlagModerationItem(context.Context, *connect.Request[v1.FlagModerationItemRequest]) (*connect.Response[v1.FlagModerationItemResponse], error)
+}
+
+// NewModerationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewModerationServiceHandler(svc ModerationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	moderationServiceMethods := v1.File_lession_v1_moderation_service_proto.Services().ByName("ModerationService").Methods()
+	moderationServiceListModerationItemsHandler := connect.NewUnaryHandler(
+		ModerationServiceListModerationItemsProcedure,
+		svc.ListModerationItems,
+		connect.WithSchema(moderationServiceMethods.ByName("ListModerationItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	moderationServiceGetModerationItemHandler := connect.NewUnaryHandler(
+		ModerationServiceGetModerationItemProcedure,
+		svc.GetModerationItem,
+		connect.WithSchema(moderationServiceMethods.ByName("GetModerationItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	moderationServiceApproveModerationItemHandler := connect.NewUnaryHandler(
+		ModerationServiceApproveModerationItemProcedure,
+		svc.ApproveModerationItem,
+		connect.WithSchema(moderationServiceMethods.ByName("ApproveModerationItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	moderationServiceRejectModerationItemHandler := connect.NewUnaryHandler(
+		ModerationServiceRejectModerationItemProcedure,
+		svc.RejectModerationItem,
+		connect.WithSchema(moderationServiceMethods.ByName("RejectModerationItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	moderationServiceFlagModerationItemHandler := connect.NewUnaryHandler(
+		ModerationServiceFlagModerationItemProcedure,
+		svc.FlagModerationItem,
+		connect.WithSchema(moderationServiceMethods.ByName("FlagModerationItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.ModerationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ModerationServiceListModerationItemsProcedure:
+			moderationServiceListModerationItemsHandler.ServeHTTP(w, r)
+		case ModerationServiceGetModerationItemProcedure:
+			moderationServiceGetModerationItemHandler.ServeHTTP(w, r)
+		case ModerationServiceApproveModerationItemProcedure:
+			moderationServiceApproveModerationItemHandler.ServeHTTP(w, r)
+		case ModerationServiceRejectModerationItemProcedure:
+			moderationServiceRejectModerationItemHandler.ServeHTTP(w, r)
+		case ModerationServiceFlagModerationItemProcedure:
+			moderationServiceFlagModerationItemHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedModerationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedModerationServiceHandler struct{}
+
+func (UnimplementedModerationServiceHandler) ListModerationItems(context.Context, *connect.Request[v1.ListModerationItemsRequest]) (*connect.Response[v1.ListModerationItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ModerationService.ListModerationItems is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) GetModerationItem(context.Context, *connect.Request[v1.GetModerationItemRequest]) (*connect.Response[v1.GetModerationItemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ModerationService.GetModerationItem is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) ApproveModerationItem(context.Context, *connect.Request[v1.ApproveModerationItemRequest]) (*connect.Response[v1.ApproveModerationItemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ModerationService.ApproveModerationItem is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) RejectModerationItem(context.Context, *connect.Request[v1.RejectModerationItemRequest]) (*connect.Response[v1.RejectModerationItemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ModerationService.RejectModerationItem is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) FlagModerationItem(context.Context, *connect.Request[v1.FlagModerationItemRequest]) (*connect.Response[v1.FlagModerationItemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ModerationService.FlagModerationItem is not implemented"))
+}
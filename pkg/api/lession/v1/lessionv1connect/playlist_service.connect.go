@@ -0,0 +1,359 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/playlist_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PlaylistServiceName is the fully-qualified name of the PlaylistService service.
+	PlaylistServiceName = "lession.v1.PlaylistService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PlaylistServiceCreatePlaylistProcedure is the fully-qualified name of the PlaylistService's
+	// CreatePlaylist RPC.
+	PlaylistServiceCreatePlaylistProcedure = "/lession.v1.PlaylistService/CreatePlaylist"
+	// PlaylistServiceGetPlaylistProcedure is the fully-qualified name of the PlaylistService's
+	// GetPlaylist RPC.
+	PlaylistServiceGetPlaylistProcedure = "/lession.v1.PlaylistService/GetPlaylist"
+	// PlaylistServiceGetSharedPlaylistProcedure is the fully-qualified name of the PlaylistService's
+	// GetSharedPlaylist RPC.
+	PlaylistServiceGetSharedPlaylistProcedure = "/lession.v1.PlaylistService/GetSharedPlaylist"
+	// PlaylistServiceListPlaylistsProcedure is the fully-qualified name of the PlaylistService's
+	// ListPlaylists RPC.
+	PlaylistServiceListPlaylistsProcedure = "/lession.v1.PlaylistService/ListPlaylists"
+	// PlaylistServiceUpdatePlaylistProcedure is the fully-qualified name of the PlaylistService's
+	// UpdatePlaylist RPC.
+	PlaylistServiceUpdatePlaylistProcedure = "/lession.v1.PlaylistService/UpdatePlaylist"
+	// PlaylistServiceDeletePlaylistProcedure is the fully-qualified name of the PlaylistService's
+	// DeletePlaylist RPC.
+	PlaylistServiceDeletePlaylistProcedure = "/lession.v1.PlaylistService/DeletePlaylist"
+	// PlaylistServiceAddPlaylistEpisodesProcedure is the fully-qualified name of the PlaylistService's
+	// AddPlaylistEpisodes RPC.
+	PlaylistServiceAddPlaylistEpisodesProcedure = "/lession.v1.PlaylistService/AddPlaylistEpisodes"
+	// PlaylistServiceRemovePlaylistEpisodeProcedure is the fully-qualified name of the
+	// PlaylistService's RemovePlaylistEpisode RPC.
+	PlaylistServiceRemovePlaylistEpisodeProcedure = "/lession.v1.PlaylistService/RemovePlaylistEpisode"
+	// PlaylistServiceReorderPlaylistProcedure is the fully-qualified name of the PlaylistService's
+	// ReorderPlaylist RPC.
+	PlaylistServiceReorderPlaylistProcedure = "/lession.v1.PlaylistService/ReorderPlaylist"
+)
+
+// PlaylistServiceClient is a client for the lession.v1.PlaylistService service.
+type PlaylistServiceClient interface {
+	// CreatePlaylist stores a new playlist.
+	CreatePlaylist(context.Context, *connect.Request[v1.CreatePlaylistRequest]) (*connect.Response[v1.CreatePlaylistResponse], error)
+	// GetPlaylist returns a single playlist by identifier.
+	GetPlaylist(context.Context, *connect.Request[v1.GetPlaylistRequest]) (*connect.Response[v1.GetPlaylistResponse], error)
+	// GetSharedPlaylist returns a public playlist by its share slug.
+	GetSharedPlaylist(context.Context, *connect.Request[v1.GetSharedPlaylistRequest]) (*connect.Response[v1.GetSharedPlaylistResponse], error)
+	// ListPlaylists returns playlists, most recently updated first.
+	ListPlaylists(context.Context, *connect.Request[v1.ListPlaylistsRequest]) (*connect.Response[v1.ListPlaylistsResponse], error)
+	// UpdatePlaylist applies partial updates to playlist metadata and sharing.
+	UpdatePlaylist(context.Context, *connect.Request[v1.UpdatePlaylistRequest]) (*connect.Response[v1.UpdatePlaylistResponse], error)
+	// DeletePlaylist removes a playlist.
+	DeletePlaylist(context.Context, *connect.Request[v1.DeletePlaylistRequest]) (*connect.Response[v1.DeletePlaylistResponse], error)
+	// AddPlaylistEpisodes appends episodes to a playlist.
+	AddPlaylistEpisodes(context.Context, *connect.Request[v1.AddPlaylistEpisodesRequest]) (*connect.Response[v1.AddPlaylistEpisodesResponse], error)
+	// RemovePlaylistEpisode removes an episode from a playlist.
+	RemovePlaylistEpisode(context.Context, *connect.Request[v1.RemovePlaylistEpisodeRequest]) (*connect.Response[v1.RemovePlaylistEpisodeResponse], error)
+	// ReorderPlaylist rewrites the order of the playlist entries.
+	ReorderPlaylist(context.Context, *connect.Request[v1.ReorderPlaylistRequest]) (*connect.Response[v1.ReorderPlaylistResponse], error)
+}
+
+// NewPlaylistServiceClient constructs a client for the lession.v1.PlaylistService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPlaylistServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PlaylistServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	playlistServiceMethods := v1.File_lession_v1_playlist_service_proto.Services().ByName("PlaylistService").Methods()
+	return &playlistServiceClient{
+		createPlaylist: connect.NewClient[v1.CreatePlaylistRequest, v1.CreatePlaylistResponse](
+			httpClient,
+			baseURL+PlaylistServiceCreatePlaylistProcedure,
+			connect.WithSchema(playlistServiceMethods.ByName("CreatePlaylist")),
+			connect.WithClientOptions(opts...),
+		),
+		getPlaylist: connect.NewClient[v1.GetPlaylistRequest, v1.GetPlaylistResponse](
+			httpClient,
+			baseURL+PlaylistServiceGetPlaylistProcedure,
+			connect.WithSchema(playlistServiceMethods.ByName("GetPlaylist")),
+			connect.WithClientOptions(opts...),
+		),
+		getSharedPlaylist: connect.NewClient[v1.GetSharedPlaylistRequest, v1.GetSharedPlaylistResponse](
+			httpClient,
+			baseURL+PlaylistServiceGetSharedPlaylistProcedure,
+			connect.WithSchema(playlistServiceMethods.ByName("GetSharedPlaylist")),
+			connect.WithClientOptions(opts...),
+		),
+		listPlaylists: connect.NewClient[v1.ListPlaylistsRequest, v1.ListPlaylistsResponse](
+			httpClient,
+			baseURL+PlaylistServiceListPlaylistsProcedure,
+			connect.WithSchema(playlistServiceMethods.ByName("ListPlaylists")),
+			connect.WithClientOptions(opts...),
+		),
+		updatePlaylist: connect.NewClient[v1.UpdatePlaylistRequest, v1.UpdatePlaylistResponse](
+			httpClient,
+			baseURL+PlaylistServiceUpdatePlaylistProcedure,
+			connect.WithSchema(playlistServiceMethods.ByName("UpdatePlaylist")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePlaylist: connect.NewClient[v1.DeletePlaylistRequest, v1.DeletePlaylistResponse](
+			httpClient,
+			baseURL+PlaylistServiceDeletePlaylistProcedure,
+			connect.WithSchema(playlistServiceMethods.ByName("DeletePlaylist")),
+			connect.WithClientOptions(opts...),
+		),
+		addPlaylistEpisodes: connect.NewClient[v1.AddPlaylistEpisodesRequest, v1.AddPlaylistEpisodesResponse](
+			httpClient,
+			baseURL+PlaylistServiceAddPlaylistEpisodesProcedure,
+			connect.WithSchema(playlistServiceMethods.ByName("AddPlaylistEpisodes")),
+			connect.WithClientOptions(opts...),
+		),
+		removePlaylistEpisode: connect.NewClient[v1.RemovePlaylistEpisodeRequest, v1.RemovePlaylistEpisodeResponse](
+			httpClient,
+			baseURL+PlaylistServiceRemovePlaylistEpisodeProcedure,
+			connect.WithSchema(playlistServiceMethods.ByName("RemovePlaylistEpisode")),
+			connect.WithClientOptions(opts...),
+		),
+		reorderPlaylist: connect.NewClient[v1.ReorderPlaylistRequest, v1.ReorderPlaylistResponse](
+			httpClient,
+			baseURL+PlaylistServiceReorderPlaylistProcedure,
+			connect.WithSchema(playlistServiceMethods.ByName("ReorderPlaylist")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// playlistServiceClient implements PlaylistServiceClient.
+type playlistServiceClient struct {
+	createPlaylist        *connect.Client[v1.CreatePlaylistRequest, v1.CreatePlaylistResponse]
+	getPlaylist           *connect.Client[v1.GetPlaylistRequest, v1.GetPlaylistResponse]
+	getSharedPlaylist     *connect.Client[v1.GetSharedPlaylistRequest, v1.GetSharedPlaylistResponse]
+	listPlaylists         *connect.Client[v1.ListPlaylistsRequest, v1.ListPlaylistsResponse]
+	updatePlaylist        *connect.Client[v1.UpdatePlaylistRequest, v1.UpdatePlaylistResponse]
+	deletePlaylist        *connect.Client[v1.DeletePlaylistRequest, v1.DeletePlaylistResponse]
+	addPlaylistEpisodes   *connect.Client[v1.AddPlaylistEpisodesRequest, v1.AddPlaylistEpisodesResponse]
+	removePlaylistEpisode *connect.Client[v1.RemovePlaylistEpisodeRequest, v1.RemovePlaylistEpisodeResponse]
+	reorderPlaylist       *connect.Client[v1.ReorderPlaylistRequest, v1.ReorderPlaylistResponse]
+}
+
+// CreatePlaylist calls lession.v1.PlaylistService.CreatePlaylist.
+func (c *playlistServiceClient) CreatePlaylist(ctx context.Context, req *connect.Request[v1.CreatePlaylistRequest]) (*connect.Response[v1.CreatePlaylistResponse], error) {
+	return c.createPlaylist.CallUnary(ctx, req)
+}
+
+// GetPlaylist calls lession.v1.PlaylistService.GetPlaylist.
+func (c *playlistServiceClient) GetPlaylist(ctx context.Context, req *connect.Request[v1.GetPlaylistRequest]) (*connect.Response[v1.GetPlaylistResponse], error) {
+	return c.getPlaylist.CallUnary(ctx, req)
+}
+
+// GetSharedPlaylist calls lession.v1.PlaylistService.GetSharedPlaylist.
+func (c *playlistServiceClient) GetSharedPlaylist(ctx context.Context, req *connect.Request[v1.GetSharedPlaylistRequest]) (*connect.Response[v1.GetSharedPlaylistResponse], error) {
+	return c.getSharedPlaylist.CallUnary(ctx, req)
+}
+
+// ListPlaylists calls lession.v1.PlaylistService.ListPlaylists.
+func (c *playlistServiceClient) ListPlaylists(ctx context.Context, req *connect.Request[v1.ListPlaylistsRequest]) (*connect.Response[v1.ListPlaylistsResponse], error) {
+	return c.listPlaylists.CallUnary(ctx, req)
+}
+
+// UpdatePlaylist calls lession.v1.PlaylistService.UpdatePlaylist.
+func (c *playlistServiceClient) UpdatePlaylist(ctx context.Context, req *connect.Request[v1.UpdatePlaylistRequest]) (*connect.Response[v1.UpdatePlaylistResponse], error) {
+	return c.updatePlaylist.CallUnary(ctx, req)
+}
+
+// DeletePlaylist calls lession.v1.PlaylistService.DeletePlaylist.
+func (c *playlistServiceClient) DeletePlaylist(ctx context.Context, req *connect.Request[v1.DeletePlaylistRequest]) (*connect.Response[v1.DeletePlaylistResponse], error) {
+	return c.deletePlaylist.CallUnary(ctx, req)
+}
+
+// AddPlaylistEpisodes calls lession.v1.PlaylistService.AddPlaylistEpisodes.
+func (c *playlistServiceClient) AddPlaylistEpisodes(ctx context.Context, req *connect.Request[v1.AddPlaylistEpisodesRequest]) (*connect.Response[v1.AddPlaylistEpisodesResponse], error) {
+	return c.addPlaylistEpisodes.CallUnary(ctx, req)
+}
+
+// RemovePlaylistEpisode calls lession.v1.PlaylistService.RemovePlaylistEpisode.
+func (c *playlistServiceClient) RemovePlaylistEpisode(ctx context.Context, req *connect.Request[v1.RemovePlaylistEpisodeRequest]) (*connect.Response[v1.RemovePlaylistEpisodeResponse], error) {
+	return c.removePlaylistEpisode.CallUnary(ctx, req)
+}
+
+// ReorderPlaylist calls lession.v1.PlaylistService.ReorderPlaylist.
+func (c *playlistServiceClient) ReorderPlaylist(ctx context.Context, req *connect.Request[v1.ReorderPlaylistRequest]) (*connect.Response[v1.ReorderPlaylistResponse], error) {
+	return c.reorderPlaylist.CallUnary(ctx, req)
+}
+
+// PlaylistServiceHandler is an implementation of the lession.v1.PlaylistService service.
+type PlaylistServiceHandler interface {
+	// CreatePlaylist stores a new playlist.
+	CreatePlaylist(context.Context, *connect.Request[v1.CreatePlaylistRequest]) (*connect.Response[v1.CreatePlaylistResponse], error)
+	// GetPlaylist returns a single playlist by identifier.
+	GetPlaylist(context.Context, *connect.Request[v1.GetPlaylistRequest]) (*connect.Response[v1.GetPlaylistResponse], error)
+	// GetSharedPlaylist returns a public playlist by its share slug.
+	GetSharedPlaylist(context.Context, *connect.Request[v1.GetSharedPlaylistRequest]) (*connect.Response[v1.GetSharedPlaylistResponse], error)
+	// ListPlaylists returns playlists, most recently updated first.
+	ListPlaylists(context.Context, *connect.Request[v1.ListPlaylistsRequest]) (*connect.Response[v1.ListPlaylistsResponse], error)
+	// UpdatePlaylist applies partial updates to playlist metadata and sharing.
+	UpdatePlaylist(context.Context, *connect.Request[v1.UpdatePlaylistRequest]) (*connect.Response[v1.UpdatePlaylistResponse], error)
+	// DeletePlaylist removes a playlist.
+	DeletePlaylist(context.Context, *connect.Request[v1.DeletePlaylistRequest]) (*connect.Response[v1.DeletePlaylistResponse], error)
+	// AddPlaylistEpisodes appends episodes to a playlist.
+	AddPlaylistEpisodes(context.Context, *connect.Request[v1.AddPlaylistEpisodesRequest]) (*connect.Response[v1.AddPlaylistEpisodesResponse], error)
+	// RemovePlaylistEpisode removes an episode from a playlist.
+	RemovePlaylistEpisode(context.Context, *connect.Request[v1.RemovePlaylistEpisodeRequest]) (*connect.Response[v1.RemovePlaylistEpisodeResponse], error)
+	// ReorderPlaylist rewrites the order of the playlist entries.
+	ReorderPlaylist(context.Context, *connect.Request[v1.ReorderPlaylistRequest]) (*connect.Response[v1.ReorderPlaylistResponse], error)
+}
+
+// NewPlaylistServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPlaylistServiceHandler(svc PlaylistServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	playlistServiceMethods := v1.File_lession_v1_playlist_service_proto.Services().ByName("PlaylistService").Methods()
+	playlistServiceCreatePlaylistHandler := connect.NewUnaryHandler(
+		PlaylistServiceCreatePlaylistProcedure,
+		svc.CreatePlaylist,
+		connect.WithSchema(playlistServiceMethods.ByName("CreatePlaylist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	playlistServiceGetPlaylistHandler := connect.NewUnaryHandler(
+		PlaylistServiceGetPlaylistProcedure,
+		svc.GetPlaylist,
+		connect.WithSchema(playlistServiceMethods.ByName("GetPlaylist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	playlistServiceGetSharedPlaylistHandler := connect.NewUnaryHandler(
+		PlaylistServiceGetSharedPlaylistProcedure,
+		svc.GetSharedPlaylist,
+		connect.WithSchema(playlistServiceMethods.ByName("GetSharedPlaylist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	playlistServiceListPlaylistsHandler := connect.NewUnaryHandler(
+		PlaylistServiceListPlaylistsProcedure,
+		svc.ListPlaylists,
+		connect.WithSchema(playlistServiceMethods.ByName("ListPlaylists")),
+		connect.WithHandlerOptions(opts...),
+	)
+	playlistServiceUpdatePlaylistHandler := connect.NewUnaryHandler(
+		PlaylistServiceUpdatePlaylistProcedure,
+		svc.UpdatePlaylist,
+		connect.WithSchema(playlistServiceMethods.ByName("UpdatePlaylist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	playlistServiceDeletePlaylistHandler := connect.NewUnaryHandler(
+		PlaylistServiceDeletePlaylistProcedure,
+		svc.DeletePlaylist,
+		connect.WithSchema(playlistServiceMethods.ByName("DeletePlaylist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	playlistServiceAddPlaylistEpisodesHandler := connect.NewUnaryHandler(
+		PlaylistServiceAddPlaylistEpisodesProcedure,
+		svc.AddPlaylistEpisodes,
+		connect.WithSchema(playlistServiceMethods.ByName("AddPlaylistEpisodes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	playlistServiceRemovePlaylistEpisodeHandler := connect.NewUnaryHandler(
+		PlaylistServiceRemovePlaylistEpisodeProcedure,
+		svc.RemovePlaylistEpisode,
+		connect.WithSchema(playlistServiceMethods.ByName("RemovePlaylistEpisode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	playlistServiceReorderPlaylistHandler := connect.NewUnaryHandler(
+		PlaylistServiceReorderPlaylistProcedure,
+		svc.ReorderPlaylist,
+		connect.WithSchema(playlistServiceMethods.ByName("ReorderPlaylist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.PlaylistService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PlaylistServiceCreatePlaylistProcedure:
+			playlistServiceCreatePlaylistHandler.ServeHTTP(w, r)
+		case PlaylistServiceGetPlaylistProcedure:
+			playlistServiceGetPlaylistHandler.ServeHTTP(w, r)
+		case PlaylistServiceGetSharedPlaylistProcedure:
+			playlistServiceGetSharedPlaylistHandler.ServeHTTP(w, r)
+		case PlaylistServiceListPlaylistsProcedure:
+			playlistServiceListPlaylistsHandler.ServeHTTP(w, r)
+		case PlaylistServiceUpdatePlaylistProcedure:
+			playlistServiceUpdatePlaylistHandler.ServeHTTP(w, r)
+		case PlaylistServiceDeletePlaylistProcedure:
+			playlistServiceDeletePlaylistHandler.ServeHTTP(w, r)
+		case PlaylistServiceAddPlaylistEpisodesProcedure:
+			playlistServiceAddPlaylistEpisodesHandler.ServeHTTP(w, r)
+		case PlaylistServiceRemovePlaylistEpisodeProcedure:
+			playlistServiceRemovePlaylistEpisodeHandler.ServeHTTP(w, r)
+		case PlaylistServiceReorderPlaylistProcedure:
+			playlistServiceReorderPlaylistHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPlaylistServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPlaylistServiceHandler struct{}
+
+func (UnimplementedPlaylistServiceHandler) CreatePlaylist(context.Context, *connect.Request[v1.CreatePlaylistRequest]) (*connect.Response[v1.CreatePlaylistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.PlaylistService.CreatePlaylist is not implemented"))
+}
+
+func (UnimplementedPlaylistServiceHandler) GetPlaylist(context.Context, *connect.Request[v1.GetPlaylistRequest]) (*connect.Response[v1.GetPlaylistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.PlaylistService.GetPlaylist is not implemented"))
+}
+
+func (UnimplementedPlaylistServiceHandler) GetSharedPlaylist(context.Context, *connect.Request[v1.GetSharedPlaylistRequest]) (*connect.Response[v1.GetSharedPlaylistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.PlaylistService.GetSharedPlaylist is not implemented"))
+}
+
+func (UnimplementedPlaylistServiceHandler) ListPlaylists(context.Context, *connect.Request[v1.ListPlaylistsRequest]) (*connect.Response[v1.ListPlaylistsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.PlaylistService.ListPlaylists is not implemented"))
+}
+
+func (UnimplementedPlaylistServiceHandler) UpdatePlaylist(context.Context, *connect.Request[v1.UpdatePlaylistRequest]) (*connect.Response[v1.UpdatePlaylistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.PlaylistService.UpdatePlaylist is not implemented"))
+}
+
+func (UnimplementedPlaylistServiceHandler) DeletePlaylist(context.Context, *connect.Request[v1.DeletePlaylistRequest]) (*connect.Response[v1.DeletePlaylistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.PlaylistService.DeletePlaylist is not implemented"))
+}
+
+func (UnimplementedPlaylistServiceHandler) AddPlaylistEpisodes(context.Context, *connect.Request[v1.AddPlaylistEpisodesRequest]) (*connect.Response[v1.AddPlaylistEpisodesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.PlaylistService.AddPlaylistEpisodes is not implemented"))
+}
+
+func (UnimplementedPlaylistServiceHandler) RemovePlaylistEpisode(context.Context, *connect.Request[v1.RemovePlaylistEpisodeRequest]) (*connect.Response[v1.RemovePlaylistEpisodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.PlaylistService.RemovePlaylistEpisode is not implemented"))
+}
+
+func (UnimplementedPlaylistServiceHandler) ReorderPlaylist(context.Context, *connect.Request[v1.ReorderPlaylistRequest]) (*connect.Response[v1.ReorderPlaylistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.PlaylistService.ReorderPlaylist is not implemented"))
+}
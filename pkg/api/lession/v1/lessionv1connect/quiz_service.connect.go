@@ -0,0 +1,175 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/quiz_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// QuizServiceName is the fully-qualified name of the QuizService service.
+	QuizServiceName = "lession.v1.QuizService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// QuizServiceGenerateClozeItemsProcedure is the fully-qualified name of the QuizService's
+	// GenerateClozeItems RPC.
+	QuizServiceGenerateClozeItemsProcedure = "/lession.v1.QuizService/GenerateClozeItems"
+	// QuizServiceListQuizItemsProcedure is the fully-qualified name of the QuizService's ListQuizItems
+	// RPC.
+	QuizServiceListQuizItemsProcedure = "/lession.v1.QuizService/ListQuizItems"
+	// QuizServiceUpdateQuizItemProcedure is the fully-qualified name of the QuizService's
+	// UpdateQuizItem RPC.
+	QuizServiceUpdateQuizItemProcedure = "/lession.v1.QuizService/UpdateQuizItem"
+)
+
+// QuizServiceClient is a client for the lession.v1.QuizService service.
+type QuizServiceClient interface {
+	// GenerateClozeItems replaces an episode's cloze items with new ones made
+	// from its current transcript, including any an editor has reviewed.
+	GenerateClozeItems(context.Context, *connect.Request[v1.GenerateClozeItemsRequest]) (*connect.Response[v1.GenerateClozeItemsResponse], error)
+	// ListQuizItems returns an episode's quiz items in order.
+	ListQuizItems(context.Context, *connect.Request[v1.ListQuizItemsRequest]) (*connect.Response[v1.ListQuizItemsResponse], error)
+	// UpdateQuizItem applies an editor's changes to a quiz item.
+	UpdateQuizItem(context.Context, *connect.Request[v1.UpdateQuizItemRequest]) (*connect.Response[v1.UpdateQuizItemResponse], error)
+}
+
+// NewQuizServiceClient constructs a client for the lession.v1.QuizService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewQuizServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) QuizServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	quizServiceMethods := v1.File_lession_v1_quiz_service_proto.Services().ByName("QuizService").Methods()
+	return &quizServiceClient{
+		generateClozeItems: connect.NewClient[v1.GenerateClozeItemsRequest, v1.GenerateClozeItemsResponse](
+			httpClient,
+			baseURL+QuizServiceGenerateClozeItemsProcedure,
+			connect.WithSchema(quizServiceMethods.ByName("GenerateClozeItems")),
+			connect.WithClientOptions(opts...),
+		),
+		listQuizItems: connect.NewClient[v1.ListQuizItemsRequest, v1.ListQuizItemsResponse](
+			httpClient,
+			baseURL+QuizServiceListQuizItemsProcedure,
+			connect.WithSchema(quizServiceMethods.ByName("ListQuizItems")),
+			connect.WithClientOptions(opts...),
+		),
+		updateQuizItem: connect.NewClient[v1.UpdateQuizItemRequest, v1.UpdateQuizItemResponse](
+			httpClient,
+			baseURL+QuizServiceUpdateQuizItemProcedure,
+			connect.WithSchema(quizServiceMethods.ByName("UpdateQuizItem")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// quizServiceClient implements QuizServiceClient.
+type quizServiceClient struct {
+	generateClozeItems *connect.Client[v1.GenerateClozeItemsRequest, v1.GenerateClozeItemsResponse]
+	listQuizItems      *connect.Client[v1.ListQuizItemsRequest, v1.ListQuizItemsResponse]
+	updateQuizItem     *connect.Client[v1.UpdateQuizItemRequest, v1.UpdateQuizItemResponse]
+}
+
+// GenerateClozeItems calls lession.v1.QuizService.GenerateClozeItems.
+func (c *quizServiceClient) GenerateClozeItems(ctx context.Context, req *connect.Request[v1.GenerateClozeItemsRequest]) (*connect.Response[v1.GenerateClozeItemsResponse], error) {
+	return c.generateClozeItems.CallUnary(ctx, req)
+}
+
+// ListQuizItems calls lession.v1.QuizService.ListQuizItems.
+func (c *quizServiceClient) ListQuizItems(ctx context.Context, req *connect.Request[v1.ListQuizItemsRequest]) (*connect.Response[v1.ListQuizItemsResponse], error) {
+	return c.listQuizItems.CallUnary(ctx, req)
+}
+
+// UpdateQuizItem calls lession.v1.QuizService.UpdateQuizItem.
+func (c *quizServiceClient) UpdateQuizItem(ctx context.Context, req *connect.Request[v1.UpdateQuizItemRequest]) (*connect.Response[v1.UpdateQuizItemResponse], error) {
+	return c.updateQuizItem.CallUnary(ctx, req)
+}
+
+// QuizServiceHandler is an implementation of the lession.v1.QuizService service.
+type QuizServiceHandler interface {
+	// GenerateClozeItems replaces an episode's cloze items with new ones made
+	// from its current transcript, including any an editor has reviewed.
+	GenerateClozeItems(context.Context, *connect.Request[v1.GenerateClozeItemsRequest]) (*connect.Response[v1.GenerateClozeItemsResponse], error)
+	// ListQuizItems returns an episode's quiz items in order.
+	ListQuizItems(context.Context, *connect.Request[v1.ListQuizItemsRequest]) (*connect.Response[v1.ListQuizItemsResponse], error)
+	// UpdateQuizItem applies an editor's changes to a quiz item.
+	UpdateQuizItem(context.Context, *connect.Request[v1.UpdateQuizItemRequest]) (*connect.Response[v1.UpdateQuizItemResponse], error)
+}
+
+// NewQuizServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewQuizServiceHandler(svc QuizServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	quizServiceMethods := v1.File_lession_v1_quiz_service_proto.Services().ByName("QuizService").Methods()
+	quizServiceGenerateClozeItemsHandler := connect.NewUnaryHandler(
+		QuizServiceGenerateClozeItemsProcedure,
+		svc.GenerateClozeItems,
+		connect.WithSchema(quizServiceMethods.ByName("GenerateClozeItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	quizServiceListQuizItemsHandler := connect.NewUnaryHandler(
+		QuizServiceListQuizItemsProcedure,
+		svc.ListQuizItems,
+		connect.WithSchema(quizServiceMethods.ByName("ListQuizItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	quizServiceUpdateQuizItemHandler := connect.NewUnaryHandler(
+		QuizServiceUpdateQuizItemProcedure,
+		svc.UpdateQuizItem,
+		connect.WithSchema(quizServiceMethods.ByName("UpdateQuizItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.QuizService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case QuizServiceGenerateClozeItemsProcedure:
+			quizServiceGenerateClozeItemsHandler.ServeHTTP(w, r)
+		case QuizServiceListQuizItemsProcedure:
+			quizServiceListQuizItemsHandler.ServeHTTP(w, r)
+		case QuizServiceUpdateQuizItemProcedure:
+			quizServiceUpdateQuizItemHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedQuizServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedQuizServiceHandler struct{}
+
+func (UnimplementedQuizServiceHandler) GenerateClozeItems(context.Context, *connect.Request[v1.GenerateClozeItemsRequest]) (*connect.Response[v1.GenerateClozeItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.QuizService.GenerateClozeItems is not implemented"))
+}
+
+func (UnimplementedQuizServiceHandler) ListQuizItems(context.Context, *connect.Request[v1.ListQuizItemsRequest]) (*connect.Response[v1.ListQuizItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.QuizService.ListQuizItems is not implemented"))
+}
+
+func (UnimplementedQuizServiceHandler) UpdateQuizItem(context.Context, *connect.Request[v1.UpdateQuizItemRequest]) (*connect.Response[v1.UpdateQuizItemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.QuizService.UpdateQuizItem is not implemented"))
+}
@@ -0,0 +1,112 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/recommendation_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RecommendationServiceName is the fully-qualified name of the RecommendationService service.
+	RecommendationServiceName = "lession.v1.RecommendationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RecommendationServiceGetRecommendationsProcedure is the fully-qualified name of the
+	// RecommendationService's GetRecommendations RPC.
+	RecommendationServiceGetRecommendationsProcedure = "/lession.v1.RecommendationService/GetRecommendations"
+)
+
+// RecommendationServiceClient is a client for the lession.v1.RecommendationService service.
+type RecommendationServiceClient interface {
+	// GetRecommendations returns published series ranked for the learner.
+	GetRecommendations(context.Context, *connect.Request[v1.GetRecommendationsRequest]) (*connect.Response[v1.GetRecommendationsResponse], error)
+}
+
+// NewRecommendationServiceClient constructs a client for the lession.v1.RecommendationService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRecommendationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RecommendationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	recommendationServiceMethods := v1.File_lession_v1_recommendation_service_proto.Services().ByName("RecommendationService").Methods()
+	return &recommendationServiceClient{
+		getRecommendations: connect.NewClient[v1.GetRecommendationsRequest, v1.GetRecommendationsResponse](
+			httpClient,
+			baseURL+RecommendationServiceGetRecommendationsProcedure,
+			connect.WithSchema(recommendationServiceMethods.ByName("GetRecommendations")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// recommendationServiceClient implements RecommendationServiceClient.
+type recommendationServiceClient struct {
+	getRecommendations *connect.Client[v1.GetRecommendationsRequest, v1.GetRecommendationsResponse]
+}
+
+// GetRecommendations calls lession.v1.RecommendationService.GetRecommendations.
+func (c *recommendationServiceClient) GetRecommendations(ctx context.Context, req *connect.Request[v1.GetRecommendationsRequest]) (*connect.Response[v1.GetRecommendationsResponse], error) {
+	return c.getRecommendations.CallUnary(ctx, req)
+}
+
+// RecommendationServiceHandler is an implementation of the lession.v1.RecommendationService
+// service.
+type RecommendationServiceHandler interface {
+	// GetRecommendations returns published series ranked for the learner.
+	GetRecommendations(context.Context, *connect.Request[v1.GetRecommendationsRequest]) (*connect.Response[v1.GetRecommendationsResponse], error)
+}
+
+// NewRecommendationServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRecommendationServiceHandler(svc RecommendationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	recommendationServiceMethods := v1.File_lession_v1_recommendation_service_proto.Services().ByName("RecommendationService").Methods()
+	recommendationServiceGetRecommendationsHandler := connect.NewUnaryHandler(
+		RecommendationServiceGetRecommendationsProcedure,
+		svc.GetRecommendations,
+		connect.WithSchema(recommendationServiceMethods.ByName("GetRecommendations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.RecommendationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RecommendationServiceGetRecommendationsProcedure:
+			recommendationServiceGetRecommendationsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRecommendationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRecommendationServiceHandler struct{}
+
+func (UnimplementedRecommendationServiceHandler) GetRecommendations(context.Context, *connect.Request[v1.GetRecommendationsRequest]) (*connect.Response[v1.GetRecommendationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.RecommendationService.GetRecommendations is not implemented"))
+}
@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/scheduler_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SchedulerServiceName is the fully-qualified name of the SchedulerService service.
+	SchedulerServiceName = "lession.v1.SchedulerService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SchedulerServiceListScheduledTasksProcedure is the fully-qualified name of the SchedulerService's
+	// ListScheduledTasks RPC.
+	SchedulerServiceListScheduledTasksProcedure = "/lession.v1.SchedulerService/ListScheduledTasks"
+)
+
+// SchedulerServiceClient is a client for the lession.v1.SchedulerService service.
+type SchedulerServiceClient interface {
+	// ListScheduledTasks returns every scheduled task with the state of its last run.
+	ListScheduledTasks(context.Context, *connect.Request[v1.ListScheduledTasksRequest]) (*connect.Response[v1.ListScheduledTasksResponse], error)
+}
+
+// NewSchedulerServiceClient constructs a client for the lession.v1.SchedulerService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSchedulerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SchedulerServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	schedulerServiceMethods := v1.File_lession_v1_scheduler_service_proto.Services().ByName("SchedulerService").Methods()
+	return &schedulerServiceClient{
+		listScheduledTasks: connect.NewClient[v1.ListScheduledTasksRequest, v1.ListScheduledTasksResponse](
+			httpClient,
+			baseURL+SchedulerServiceListScheduledTasksProcedure,
+			connect.WithSchema(schedulerServiceMethods.ByName("ListScheduledTasks")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// schedulerServiceClient implements SchedulerServiceClient.
+type schedulerServiceClient struct {
+	listScheduledTasks *connect.Client[v1.ListScheduledTasksRequest, v1.ListScheduledTasksResponse]
+}
+
+// ListScheduledTasks calls lession.v1.SchedulerService.ListScheduledTasks.
+func (c *schedulerServiceClient) ListScheduledTasks(ctx context.Context, req *connect.Request[v1.ListScheduledTasksRequest]) (*connect.Response[v1.ListScheduledTasksResponse], error) {
+	return c.listScheduledTasks.CallUnary(ctx, req)
+}
+
+// SchedulerServiceHandler is an implementation of the lession.v1.SchedulerService service.
+type SchedulerServiceHandler interface {
+	// ListScheduledTasks returns every scheduled task with the state of its last run.
+	ListScheduledTasks(context.Context, *connect.Request[v1.ListScheduledTasksRequest]) (*connect.Response[v1.ListScheduledTasksResponse], error)
+}
+
+// NewSchedulerServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSchedulerServiceHandler(svc SchedulerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	schedulerServiceMethods := v1.File_lession_v1_scheduler_service_proto.Services().ByName("SchedulerService").Methods()
+	schedulerServiceListScheduledTasksHandler := connect.NewUnaryHandler(
+		SchedulerServiceListScheduledTasksProcedure,
+		svc.ListScheduledTasks,
+		connect.WithSchema(schedulerServiceMethods.ByName("ListScheduledTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.SchedulerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SchedulerServiceListScheduledTasksProcedure:
+			schedulerServiceListScheduledTasksHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSchedulerServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSchedulerServiceHandler struct{}
+
+func (UnimplementedSchedulerServiceHandler) ListScheduledTasks(context.Context, *connect.Request[v1.ListScheduledTasksRequest]) (*connect.Response[v1.ListScheduledTasksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.SchedulerService.ListScheduledTasks is not implemented"))
+}
@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/search_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SearchServiceName is the fully-qualified name of the SearchService service.
+	SearchServiceName = "lession.v1.SearchService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SearchServiceSearchContentProcedure is the fully-qualified name of the SearchService's
+	// SearchContent RPC.
+	SearchServiceSearchContentProcedure = "/lession.v1.SearchService/SearchContent"
+	// SearchServiceSemanticSearchProcedure is the fully-qualified name of the SearchService's
+	// SemanticSearch RPC.
+	SearchServiceSemanticSearchProcedure = "/lession.v1.SearchService/SemanticSearch"
+	// SearchServiceFindSimilarEpisodesProcedure is the fully-qualified name of the SearchService's
+	// FindSimilarEpisodes RPC.
+	SearchServiceFindSimilarEpisodesProcedure = "/lession.v1.SearchService/FindSimilarEpisodes"
+)
+
+// SearchServiceClient is a client for the lession.v1.SearchService service.
+type SearchServiceClient interface {
+	// SearchContent returns the published series and episodes matching a query, best match first.
+	SearchContent(context.Context, *connect.Request[v1.SearchContentRequest]) (*connect.Response[v1.SearchContentResponse], error)
+	// SemanticSearch returns the published series and episodes closest in meaning to a query, best match first.
+	SemanticSearch(context.Context, *connect.Request[v1.SemanticSearchRequest]) (*connect.Response[v1.SemanticSearchResponse], error)
+	// FindSimilarEpisodes returns the published episodes closest in meaning to a published episode.
+	FindSimilarEpisodes(context.Context, *connect.Request[v1.FindSimilarEpisodesRequest]) (*connect.Response[v1.FindSimilarEpisodesResponse], error)
+}
+
+// NewSearchServiceClient constructs a client for the lession.v1.SearchService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSearchServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SearchServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	searchServiceMethods := v1.File_lession_v1_search_service_proto.Services().ByName("SearchService").Methods()
+	return &searchServiceClient{
+		searchContent: connect.NewClient[v1.SearchContentRequest, v1.SearchContentResponse](
+			httpClient,
+			baseURL+SearchServiceSearchContentProcedure,
+			connect.WithSchema(searchServiceMethods.ByName("SearchContent")),
+			connect.WithClientOptions(opts...),
+		),
+		semanticSearch: connect.NewClient[v1.SemanticSearchRequest, v1.SemanticSearchResponse](
+			httpClient,
+			baseURL+SearchServiceSemanticSearchProcedure,
+			connect.WithSchema(searchServiceMethods.ByName("SemanticSearch")),
+			connect.WithClientOptions(opts...),
+		),
+		findSimilarEpisodes: connect.NewClient[v1.FindSimilarEpisodesRequest, v1.FindSimilarEpisodesResponse](
+			httpClient,
+			baseURL+SearchServiceFindSimilarEpisodesProcedure,
+			connect.WithSchema(searchServiceMethods.ByName("FindSimilarEpisodes")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// searchServiceClient implements SearchServiceClient.
+type searchServiceClient struct {
+	searchContent       *connect.Client[v1.SearchContentRequest, v1.SearchContentResponse]
+	semanticSearch      *connect.Client[v1.SemanticSearchRequest, v1.SemanticSearchResponse]
+	findSimilarEpisodes *connect.Client[v1.FindSimilarEpisodesRequest, v1.FindSimilarEpisodesResponse]
+}
+
+// SearchContent calls lession.v1.SearchService.SearchContent.
+func (c *searchServiceClient) SearchContent(ctx context.Context, req *connect.Request[v1.SearchContentRequest]) (*connect.Response[v1.SearchContentResponse], error) {
+	return c.searchContent.CallUnary(ctx, req)
+}
+
+// SemanticSearch calls lession.v1.SearchService.SemanticSearch.
+func (c *searchServiceClient) SemanticSearch(ctx context.Context, req *connect.Request[v1.SemanticSearchRequest]) (*connect.Response[v1.SemanticSearchResponse], error) {
+	return c.semanticSearch.CallUnary(ctx, req)
+}
+
+// FindSimilarEpisodes calls lession.v1.SearchService.FindSimilarEpisodes.
+func (c *searchServiceClient) FindSimilarEpisodes(ctx context.Context, req *connect.Request[v1.FindSimilarEpisodesRequest]) (*connect.Response[v1.FindSimilarEpisodesResponse], error) {
+	return c.findSimilarEpisodes.CallUnary(ctx, req)
+}
+
+// SearchServiceHandler is an implementation of the lession.v1.SearchService service.
+type SearchServiceHandler interface {
+	// SearchContent returns the published series and episodes matching a query, best match first.
+	SearchContent(context.Context, *connect.Request[v1.SearchContentRequest]) (*connect.Response[v1.SearchContentResponse], error)
+	// SemanticSearch returns the published series and episodes closest in meaning to a query, best match first.
+	SemanticSearch(context.Context, *connect.Request[v1.SemanticSearchRequest]) (*connect.Response[v1.SemanticSearchResponse], error)
+	// FindSimilarEpisodes returns the published episodes closest in meaning to a published episode.
+	FindSimilarEpisodes(context.Context, *connect.Request[v1.FindSimilarEpisodesRequest]) (*connect.Response[v1.FindSimilarEpisodesResponse], error)
+}
+
+// NewSearchServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSearchServiceHandler(svc SearchServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	searchServiceMethods := v1.File_lession_v1_search_service_proto.Services().ByName("SearchService").Methods()
+	searchServiceSearchContentHandler := connect.NewUnaryHandler(
+		SearchServiceSearchContentProcedure,
+		svc.SearchContent,
+		connect.WithSchema(searchServiceMethods.ByName("SearchContent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	searchServiceSemanticSearchHandler := connect.NewUnaryHandler(
+		SearchServiceSemanticSearchProcedure,
+		svc.SemanticSearch,
+		connect.WithSchema(searchServiceMethods.ByName("SemanticSearch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	searchServiceFindSimilarEpisodesHandler := connect.NewUnaryHandler(
+		SearchServiceFindSimilarEpisodesProcedure,
+		svc.FindSimilarEpisodes,
+		connect.WithSchema(searchServiceMethods.ByName("FindSimilarEpisodes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.SearchService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SearchServiceSearchContentProcedure:
+			searchServiceSearchContentHandler.ServeHTTP(w, r)
+		case SearchServiceSemanticSearchProcedure:
+			searchServiceSemanticSearchHandler.ServeHTTP(w, r)
+		case SearchServiceFindSimilarEpisodesProcedure:
+			searchServiceFindSimilarEpisodesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSearchServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSearchServiceHandler struct{}
+
+func (UnimplementedSearchServiceHandler) SearchContent(context.Context, *connect.Request[v1.SearchContentRequest]) (*connect.Response[v1.SearchContentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.SearchService.SearchContent is not implemented"))
+}
+
+func (UnimplementedSearchServiceHandler) SemanticSearch(context.Context, *connect.Request[v1.SemanticSearchRequest]) (*connect.Response[v1.SemanticSearchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.SearchService.SemanticSearch is not implemented"))
+}
+
+func (UnimplementedSearchServiceHandler) FindSimilarEpisodes(context.Context, *connect.Request[v1.FindSimilarEpisodesRequest]) (*connect.Response[v1.FindSimilarEpisodesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.SearchService.FindSimilarEpisodes is not implemented"))
+}
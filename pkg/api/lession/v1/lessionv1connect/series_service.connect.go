@@ -56,6 +56,16 @@ const (
 	// SeriesServiceDeleteEpisodeProcedure is the fully-qualified name of the SeriesService's
 	// DeleteEpisode RPC.
 	SeriesServiceDeleteEpisodeProcedure = "/lession.v1.SeriesService/DeleteEpisode"
+	// SeriesServiceRenameTagProcedure is the fully-qualified name of the SeriesService's RenameTag RPC.
+	SeriesServiceRenameTagProcedure = "/lession.v1.SeriesService/RenameTag"
+	// SeriesServiceMergeTagsProcedure is the fully-qualified name of the SeriesService's MergeTags RPC.
+	SeriesServiceMergeTagsProcedure = "/lession.v1.SeriesService/MergeTags"
+	// SeriesServiceReassignContentProcedure is the fully-qualified name of the SeriesService's
+	// ReassignContent RPC.
+	SeriesServiceReassignContentProcedure = "/lession.v1.SeriesService/ReassignContent"
+	// SeriesServiceGetEpisodeTextStatsProcedure is the fully-qualified name of the SeriesService's
+	// GetEpisodeTextStats RPC.
+	SeriesServiceGetEpisodeTextStatsProcedure = "/lession.v1.SeriesService/GetEpisodeTextStats"
 )
 
 // SeriesServiceClient is a client for the lession.v1.SeriesService service.
@@ -76,6 +86,14 @@ type SeriesServiceClient interface {
 	UpdateEpisode(context.Context, *connect.Request[v1.UpdateEpisodeRequest]) (*connect.Response[v1.UpdateEpisodeResponse], error)
 	// DeleteEpisode performs a soft delete of an episode.
 	DeleteEpisode(context.Context, *connect.Request[v1.DeleteEpisodeRequest]) (*connect.Response[v1.DeleteEpisodeResponse], error)
+	// RenameTag replaces a tag with a new name across all series.
+	RenameTag(context.Context, *connect.Request[v1.RenameTagRequest]) (*connect.Response[v1.RenameTagResponse], error)
+	// MergeTags folds one or more source tags into a target tag across all series.
+	MergeTags(context.Context, *connect.Request[v1.MergeTagsRequest]) (*connect.Response[v1.MergeTagsResponse], error)
+	// ReassignContent transfers ownership of all series from one author to another.
+	ReassignContent(context.Context, *connect.Request[v1.ReassignContentRequest]) (*connect.Response[v1.ReassignContentResponse], error)
+	// GetEpisodeTextStats returns word counts, the speaking rate and the most frequent words of an episode's transcript.
+	GetEpisodeTextStats(context.Context, *connect.Request[v1.GetEpisodeTextStatsRequest]) (*connect.Response[v1.GetEpisodeTextStatsResponse], error)
 }
 
 // NewSeriesServiceClient constructs a client for the lession.v1.SeriesService service. By default,
@@ -137,19 +155,47 @@ func NewSeriesServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(seriesServiceMethods.ByName("DeleteEpisode")),
 			connect.WithClientOptions(opts...),
 		),
+		renameTag: connect.NewClient[v1.RenameTagRequest, v1.RenameTagResponse](
+			httpClient,
+			baseURL+SeriesServiceRenameTagProcedure,
+			connect.WithSchema(seriesServiceMethods.ByName("RenameTag")),
+			connect.WithClientOptions(opts...),
+		),
+		mergeTags: connect.NewClient[v1.MergeTagsRequest, v1.MergeTagsResponse](
+			httpClient,
+			baseURL+SeriesServiceMergeTagsProcedure,
+			connect.WithSchema(seriesServiceMethods.ByName("MergeTags")),
+			connect.WithClientOptions(opts...),
+		),
+		reassignContent: connect.NewClient[v1.ReassignContentRequest, v1.ReassignContentResponse](
+			httpClient,
+			baseURL+SeriesServiceReassignContentProcedure,
+			connect.WithSchema(seriesServiceMethods.ByName("ReassignContent")),
+			connect.WithClientOptions(opts...),
+		),
+		getEpisodeTextStats: connect.NewClient[v1.GetEpisodeTextStatsRequest, v1.GetEpisodeTextStatsResponse](
+			httpClient,
+			baseURL+SeriesServiceGetEpisodeTextStatsProcedure,
+			connect.WithSchema(seriesServiceMethods.ByName("GetEpisodeTextStats")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // seriesServiceClient implements SeriesServiceClient.
 type seriesServiceClient struct {
-	listSeries    *connect.Client[v1.ListSeriesRequest, v1.ListSeriesResponse]
-	createSeries  *connect.Client[v1.CreateSeriesRequest, v1.CreateSeriesResponse]
-	getSeries     *connect.Client[v1.GetSeriesRequest, v1.GetSeriesResponse]
-	updateSeries  *connect.Client[v1.UpdateSeriesRequest, v1.UpdateSeriesResponse]
-	createEpisode *connect.Client[v1.CreateEpisodeRequest, v1.CreateEpisodeResponse]
-	getEpisode    *connect.Client[v1.GetEpisodeRequest, v1.GetEpisodeResponse]
-	updateEpisode *connect.Client[v1.UpdateEpisodeRequest, v1.UpdateEpisodeResponse]
-	deleteEpisode *connect.Client[v1.DeleteEpisodeRequest, v1.DeleteEpisodeResponse]
+	listSeries          *connect.Client[v1.ListSeriesRequest, v1.ListSeriesResponse]
+	createSeries        *connect.Client[v1.CreateSeriesRequest, v1.CreateSeriesResponse]
+	getSeries           *connect.Client[v1.GetSeriesRequest, v1.GetSeriesResponse]
+	updateSeries        *connect.Client[v1.UpdateSeriesRequest, v1.UpdateSeriesResponse]
+	createEpisode       *connect.Client[v1.CreateEpisodeRequest, v1.CreateEpisodeResponse]
+	getEpisode          *connect.Client[v1.GetEpisodeRequest, v1.GetEpisodeResponse]
+	updateEpisode       *connect.Client[v1.UpdateEpisodeRequest, v1.UpdateEpisodeResponse]
+	deleteEpisode       *connect.Client[v1.DeleteEpisodeRequest, v1.DeleteEpisodeResponse]
+	renameTag           *connect.Client[v1.RenameTagRequest, v1.RenameTagResponse]
+	mergeTags           *connect.Client[v1.MergeTagsRequest, v1.MergeTagsResponse]
+	reassignContent     *connect.Client[v1.ReassignContentRequest, v1.ReassignContentResponse]
+	getEpisodeTextStats *connect.Client[v1.GetEpisodeTextStatsRequest, v1.GetEpisodeTextStatsResponse]
 }
 
 // ListSeries calls lession.v1.SeriesService.ListSeries.
@@ -192,6 +238,26 @@ func (c *seriesServiceClient) DeleteEpisode(ctx context.Context, req *connect.Re
 	return c.deleteEpisode.CallUnary(ctx, req)
 }
 
+// RenameTag calls lession.v1.SeriesService.RenameTag.
+func (c *seriesServiceClient) RenameTag(ctx context.Context, req *connect.Request[v1.RenameTagRequest]) (*connect.Response[v1.RenameTagResponse], error) {
+	return c.renameTag.CallUnary(ctx, req)
+}
+
+// MergeTags calls lession.v1.SeriesService.MergeTags.
+func (c *seriesServiceClient) MergeTags(ctx context.Context, req *connect.Request[v1.MergeTagsRequest]) (*connect.Response[v1.MergeTagsResponse], error) {
+	return c.mergeTags.CallUnary(ctx, req)
+}
+
+// ReassignContent calls lession.v1.SeriesService.ReassignContent.
+func (c *seriesServiceClient) ReassignContent(ctx context.Context, req *connect.Request[v1.ReassignContentRequest]) (*connect.Response[v1.ReassignContentResponse], error) {
+	return c.reassignContent.CallUnary(ctx, req)
+}
+
+// GetEpisodeTextStats calls lession.v1.SeriesService.GetEpisodeTextStats.
+func (c *seriesServiceClient) GetEpisodeTextStats(ctx context.Context, req *connect.Request[v1.GetEpisodeTextStatsRequest]) (*connect.Response[v1.GetEpisodeTextStatsResponse], error) {
+	return c.getEpisodeTextStats.CallUnary(ctx, req)
+}
+
 // SeriesServiceHandler is an implementation of the lession.v1.SeriesService service.
 type SeriesServiceHandler interface {
 	// ListSeries returns a filtered, paginated collection of series.
@@ -210,6 +276,14 @@ type SeriesServiceHandler interface {
 	UpdateEpisode(context.Context, *connect.Request[v1.UpdateEpisodeRequest]) (*connect.Response[v1.UpdateEpisodeResponse], error)
 	// DeleteEpisode performs a soft delete of an episode.
 	DeleteEpisode(context.Context, *connect.Request[v1.DeleteEpisodeRequest]) (*connect.Response[v1.DeleteEpisodeResponse], error)
+	// RenameTag replaces a tag with a new name across all series.
+	RenameTag(context.Context, *connect.Request[v1.RenameTagRequest]) (*connect.Response[v1.RenameTagResponse], error)
+	// MergeTags folds one or more source tags into a target tag across all series.
+	MergeTags(context.Context, *connect.Request[v1.MergeTagsRequest]) (*connect.Response[v1.MergeTagsResponse], error)
+	// ReassignContent transfers ownership of all series from one author to another.
+	ReassignContent(context.Context, *connect.Request[v1.ReassignContentRequest]) (*connect.Response[v1.ReassignContentResponse], error)
+	// GetEpisodeTextStats returns word counts, the speaking rate and the most frequent words of an episode's transcript.
+	GetEpisodeTextStats(context.Context, *connect.Request[v1.GetEpisodeTextStatsRequest]) (*connect.Response[v1.GetEpisodeTextStatsResponse], error)
 }
 
 // NewSeriesServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -267,6 +341,30 @@ func NewSeriesServiceHandler(svc SeriesServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(seriesServiceMethods.ByName("DeleteEpisode")),
 		connect.WithHandlerOptions(opts...),
 	)
+	seriesServiceRenameTagHandler := connect.NewUnaryHandler(
+		SeriesServiceRenameTagProcedure,
+		svc.RenameTag,
+		connect.WithSchema(seriesServiceMethods.ByName("RenameTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	seriesServiceMergeTagsHandler := connect.NewUnaryHandler(
+		SeriesServiceMergeTagsProcedure,
+		svc.MergeTags,
+		connect.WithSchema(seriesServiceMethods.ByName("MergeTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	seriesServiceReassignContentHandler := connect.NewUnaryHandler(
+		SeriesServiceReassignContentProcedure,
+		svc.ReassignContent,
+		connect.WithSchema(seriesServiceMethods.ByName("ReassignContent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	seriesServiceGetEpisodeTextStatsHandler := connect.NewUnaryHandler(
+		SeriesServiceGetEpisodeTextStatsProcedure,
+		svc.GetEpisodeTextStats,
+		connect.WithSchema(seriesServiceMethods.ByName("GetEpisodeTextStats")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/lession.v1.SeriesService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SeriesServiceListSeriesProcedure:
@@ -285,6 +383,14 @@ func NewSeriesServiceHandler(svc SeriesServiceHandler, opts ...connect.HandlerOp
 			seriesServiceUpdateEpisodeHandler.ServeHTTP(w, r)
 		case SeriesServiceDeleteEpisodeProcedure:
 			seriesServiceDeleteEpisodeHandler.ServeHTTP(w, r)
+		case SeriesServiceRenameTagProcedure:
+			seriesServiceRenameTagHandler.ServeHTTP(w, r)
+		case SeriesServiceMergeTagsProcedure:
+			seriesServiceMergeTagsHandler.ServeHTTP(w, r)
+		case SeriesServiceReassignContentProcedure:
+			seriesServiceReassignContentHandler.ServeHTTP(w, r)
+		case SeriesServiceGetEpisodeTextStatsProcedure:
+			seriesServiceGetEpisodeTextStatsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -325,3 +431,19 @@ func (UnimplementedSeriesServiceHandler) UpdateEpisode(context.Context, *connect
 func (UnimplementedSeriesServiceHandler) DeleteEpisode(context.Context, *connect.Request[v1.DeleteEpisodeRequest]) (*connect.Response[v1.DeleteEpisodeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.SeriesService.DeleteEpisode is not implemented"))
 }
+
+func (UnimplementedSeriesServiceHandler) RenameTag(context.Context, *connect.Request[v1.RenameTagRequest]) (*connect.Response[v1.RenameTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.SeriesService.RenameTag is not implemented"))
+}
+
+func (UnimplementedSeriesServiceHandler) MergeTags(context.Context, *connect.Request[v1.MergeTagsRequest]) (*connect.Response[v1.MergeTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.SeriesService.MergeTags is not implemented"))
+}
+
+func (UnimplementedSeriesServiceHandler) ReassignContent(context.Context, *connect.Request[v1.ReassignContentRequest]) (*connect.Response[v1.ReassignContentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.SeriesService.ReassignContent is not implemented"))
+}
+
+func (UnimplementedSeriesServiceHandler) GetEpisodeTextStats(context.Context, *connect.Request[v1.GetEpisodeTextStatsRequest]) (*connect.Response[v1.GetEpisodeTextStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.SeriesService.GetEpisodeTextStats is not implemented"))
+}
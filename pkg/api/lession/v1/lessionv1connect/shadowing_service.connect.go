@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/shadowing_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ShadowingServiceName is the fully-qualified name of the ShadowingService service.
+	ShadowingServiceName = "lession.v1.ShadowingService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ShadowingServiceCreateShadowingSubmissionProcedure is the fully-qualified name of the
+	// ShadowingService's CreateShadowingSubmission RPC.
+	ShadowingServiceCreateShadowingSubmissionProcedure = "/lession.v1.ShadowingService/CreateShadowingSubmission"
+	// ShadowingServiceGetShadowingSubmissionProcedure is the fully-qualified name of the
+	// ShadowingService's GetShadowingSubmission RPC.
+	ShadowingServiceGetShadowingSubmissionProcedure = "/lession.v1.ShadowingService/GetShadowingSubmission"
+	// ShadowingServiceListShadowingSubmissionsProcedure is the fully-qualified name of the
+	// ShadowingService's ListShadowingSubmissions RPC.
+	ShadowingServiceListShadowingSubmissionsProcedure = "/lession.v1.ShadowingService/ListShadowingSubmissions"
+	// ShadowingServiceReviewShadowingSubmissionProcedure is the fully-qualified name of the
+	// ShadowingService's ReviewShadowingSubmission RPC.
+	ShadowingServiceReviewShadowingSubmissionProcedure = "/lession.v1.ShadowingService/ReviewShadowingSubmission"
+)
+
+// ShadowingServiceClient is a client for the lession.v1.ShadowingService service.
+type ShadowingServiceClient interface {
+	// CreateShadowingSubmission attaches an uploaded recording to a transcript segment.
+	CreateShadowingSubmission(context.Context, *connect.Request[v1.CreateShadowingSubmissionRequest]) (*connect.Response[v1.CreateShadowingSubmissionResponse], error)
+	// GetShadowingSubmission returns a single submission.
+	GetShadowingSubmission(context.Context, *connect.Request[v1.GetShadowingSubmissionRequest]) (*connect.Response[v1.GetShadowingSubmissionResponse], error)
+	// ListShadowingSubmissions returns submissions, newest first.
+	ListShadowingSubmissions(context.Context, *connect.Request[v1.ListShadowingSubmissionsRequest]) (*connect.Response[v1.ListShadowingSubmissionsResponse], error)
+	// ReviewShadowingSubmission records a teacher's score and comment.
+	ReviewShadowingSubmission(context.Context, *connect.Request[v1.ReviewShadowingSubmissionRequest]) (*connect.Response[v1.ReviewShadowingSubmissionResponse], error)
+}
+
+// NewShadowingServiceClient constructs a client for the lession.v1.ShadowingService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewShadowingServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ShadowingServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	shadowingServiceMethods := v1.File_lession_v1_shadowing_service_proto.Services().ByName("ShadowingService").Methods()
+	return &shadowingServiceClient{
+		createShadowingSubmission: connect.NewClient[v1.CreateShadowingSubmissionRequest, v1.CreateShadowingSubmissionResponse](
+			httpClient,
+			baseURL+ShadowingServiceCreateShadowingSubmissionProcedure,
+			connect.WithSchema(shadowingServiceMethods.ByName("CreateShadowingSubmission")),
+			connect.WithClientOptions(opts...),
+		),
+		getShadowingSubmission: connect.NewClient[v1.GetShadowingSubmissionRequest, v1.GetShadowingSubmissionResponse](
+			httpClient,
+			baseURL+ShadowingServiceGetShadowingSubmissionProcedure,
+			connect.WithSchema(shadowingServiceMethods.ByName("GetShadowingSubmission")),
+			connect.WithClientOptions(opts...),
+		),
+		listShadowingSubmissions: connect.NewClient[v1.ListShadowingSubmissionsRequest, v1.ListShadowingSubmissionsResponse](
+			httpClient,
+			baseURL+ShadowingServiceListShadowingSubmissionsProcedure,
+			connect.WithSchema(shadowingServiceMethods.ByName("ListShadowingSubmissions")),
+			connect.WithClientOptions(opts...),
+		),
+		reviewShadowingSubmission: connect.NewClient[v1.ReviewShadowingSubmissionRequest, v1.ReviewShadowingSubmissionResponse](
+			httpClient,
+			baseURL+ShadowingServiceReviewShadowingSubmissionProcedure,
+			connect.WithSchema(shadowingServiceMethods.ByName("ReviewShadowingSubmission")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// shadowingServiceClient implements ShadowingServiceClient.
+type shadowingServiceClient struct {
+	createShadowingSubmission *connect.Client[v1.CreateShadowingSubmissionRequest, v1.CreateShadowingSubmissionResponse]
+	getShadowingSubmission    *connect.Client[v1.GetShadowingSubmissionRequest, v1.GetShadowingSubmissionResponse]
+	listShadowingSubmissions  *connect.Client[v1.ListShadowingSubmissionsRequest, v1.ListShadowingSubmissionsResponse]
+	reviewShadowingSubmission *connect.Client[v1.ReviewShadowingSubmissionRequest, v1.ReviewShadowingSubmissionResponse]
+}
+
+// CreateShadowingSubmission calls lession.v1.ShadowingService.CreateShadowingSubmission.
+func (c *shadowingServiceClient) CreateShadowingSubmission(ctx context.Context, req *connect.Request[v1.CreateShadowingSubmissionRequest]) (*connect.Response[v1.CreateShadowingSubmissionResponse], error) {
+	return c.createShadowingSubmission.CallUnary(ctx, req)
+}
+
+// GetShadowingSubmission calls lession.v1.ShadowingService.GetShadowingSubmission.
+func (c *shadowingServiceClient) GetShadowingSubmission(ctx context.Context, req *connect.Request[v1.GetShadowingSubmissionRequest]) (*connect.Response[v1.GetShadowingSubmissionResponse], error) {
+	return c.getShadowingSubmission.CallUnary(ctx, req)
+}
+
+// ListShadowingSubmissions calls lession.v1.ShadowingService.ListShadowingSubmissions.
+func (c *shadowingServiceClient) ListShadowingSubmissions(ctx context.Context, req *connect.Request[v1.ListShadowingSubmissionsRequest]) (*connect.Response[v1.ListShadowingSubmissionsResponse], error) {
+	return c.listShadowingSubmissions.CallUnary(ctx, req)
+}
+
+// ReviewShadowingSubmission calls lession.v1.ShadowingService.ReviewShadowingSubmission.
+func (c *shadowingServiceClient) ReviewShadowingSubmission(ctx context.Context, req *connect.Request[v1.ReviewShadowingSubmissionRequest]) (*connect.Response[v1.ReviewShadowingSubmissionResponse], error) {
+	return c.reviewShadowingSubmission.CallUnary(ctx, req)
+}
+
+// ShadowingServiceHandler is an implementation of the lession.v1.ShadowingService service.
+type ShadowingServiceHandler interface {
+	// CreateShadowingSubmission attaches an uploaded recording to a transcript segment.
+	CreateShadowingSubmission(context.Context, *connect.Request[v1.CreateShadowingSubmissionRequest]) (*connect.Response[v1.CreateShadowingSubmissionResponse], error)
+	// GetShadowingSubmission returns a single submission.
+	GetShadowingSubmission(context.Context, *connect.Request[v1.GetShadowingSubmissionRequest]) (*connect.Response[v1.GetShadowingSubmissionResponse], error)
+	// ListShadowingSubmissions returns submissions, newest first.
+	ListShadowingSubmissions(context.Context, *connect.Request[v1.ListShadowingSubmissionsRequest]) (*connect.Response[v1.ListShadowingSubmissionsResponse], error)
+	// ReviewShadowingSubmission records a teacher's score and comment.
+	ReviewShadowingSubmission(context.Context, *connect.Request[v1.ReviewShadowingSubmissionRequest]) (*connect.Response[v1.ReviewShadowingSubmissionResponse], error)
+}
+
+// NewShadowingServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewShadowingServiceHandler(svc ShadowingServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	shadowingServiceMethods := v1.File_lession_v1_shadowing_service_proto.Services().ByName("ShadowingService").Methods()
+	shadowingServiceCreateShadowingSubmissionHandler := connect.NewUnaryHandler(
+		ShadowingServiceCreateShadowingSubmissionProcedure,
+		svc.CreateShadowingSubmission,
+		connect.WithSchema(shadowingServiceMethods.ByName("CreateShadowingSubmission")),
+		connect.WithHandlerOptions(opts...),
+	)
+	shadowingServiceGetShadowingSubmissionHandler := connect.NewUnaryHandler(
+		ShadowingServiceGetShadowingSubmissionProcedure,
+		svc.GetShadowingSubmission,
+		connect.WithSchema(shadowingServiceMethods.ByName("GetShadowingSubmission")),
+		connect.WithHandlerOptions(opts...),
+	)
+	shadowingServiceListShadowingSubmissionsHandler := connect.NewUnaryHandler(
+		ShadowingServiceListShadowingSubmissionsProcedure,
+		svc.ListShadowingSubmissions,
+		connect.WithSchema(shadowingServiceMethods.ByName("ListShadowingSubmissions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	shadowingServiceReviewShadowingSubmissionHandler := connect.NewUnaryHandler(
+		ShadowingServiceReviewShadowingSubmissionProcedure,
+		svc.ReviewShadowingSubmission,
+		connect.WithSchema(shadowingServiceMethods.ByName("ReviewShadowingSubmission")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.ShadowingService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ShadowingServiceCreateShadowingSubmissionProcedure:
+			shadowingServiceCreateShadowingSubmissionHandler.ServeHTTP(w, r)
+		case ShadowingServiceGetShadowingSubmissionProcedure:
+			shadowingServiceGetShadowingSubmissionHandler.ServeHTTP(w, r)
+		case ShadowingServiceListShadowingSubmissionsProcedure:
+			shadowingServiceListShadowingSubmissionsHandler.ServeHTTP(w, r)
+		case ShadowingServiceReviewShadowingSubmissionProcedure:
+			shadowingServiceReviewShadowingSubmissionHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedShadowingServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedShadowingServiceHandler struct{}
+
+func (UnimplementedShadowingServiceHandler) CreateShadowingSubmission(context.Context, *connect.Request[v1.CreateShadowingSubmissionRequest]) (*connect.Response[v1.CreateShadowingSubmissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ShadowingService.CreateShadowingSubmission is not implemented"))
+}
+
+func (UnimplementedShadowingServiceHandler) GetShadowingSubmission(context.Context, *connect.Request[v1.GetShadowingSubmissionRequest]) (*connect.Response[v1.GetShadowingSubmissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ShadowingService.GetShadowingSubmission is not implemented"))
+}
+
+func (UnimplementedShadowingServiceHandler) ListShadowingSubmissions(context.Context, *connect.Request[v1.ListShadowingSubmissionsRequest]) (*connect.Response[v1.ListShadowingSubmissionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ShadowingService.ListShadowingSubmissions is not implemented"))
+}
+
+func (UnimplementedShadowingServiceHandler) ReviewShadowingSubmission(context.Context, *connect.Request[v1.ReviewShadowingSubmissionRequest]) (*connect.Response[v1.ReviewShadowingSubmissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.ShadowingService.ReviewShadowingSubmission is not implemented"))
+}
@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/transcript_admin_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TranscriptAdminServiceName is the fully-qualified name of the TranscriptAdminService service.
+	TranscriptAdminServiceName = "lession.v1.TranscriptAdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TranscriptAdminServiceSearchReplaceTranscriptsProcedure is the fully-qualified name of the
+	// TranscriptAdminService's SearchReplaceTranscripts RPC.
+	TranscriptAdminServiceSearchReplaceTranscriptsProcedure = "/lession.v1.TranscriptAdminService/SearchReplaceTranscripts"
+	// TranscriptAdminServiceGetTranscriptReplaceJobProcedure is the fully-qualified name of the
+	// TranscriptAdminService's GetTranscriptReplaceJob RPC.
+	TranscriptAdminServiceGetTranscriptReplaceJobProcedure = "/lession.v1.TranscriptAdminService/GetTranscriptReplaceJob"
+	// TranscriptAdminServiceListTranscriptReplaceJobsProcedure is the fully-qualified name of the
+	// TranscriptAdminService's ListTranscriptReplaceJobs RPC.
+	TranscriptAdminServiceListTranscriptReplaceJobsProcedure = "/lession.v1.TranscriptAdminService/ListTranscriptReplaceJobs"
+	// TranscriptAdminServiceRollbackTranscriptReplaceJobProcedure is the fully-qualified name of the
+	// TranscriptAdminService's RollbackTranscriptReplaceJob RPC.
+	TranscriptAdminServiceRollbackTranscriptReplaceJobProcedure = "/lession.v1.TranscriptAdminService/RollbackTranscriptReplaceJob"
+)
+
+// TranscriptAdminServiceClient is a client for the lession.v1.TranscriptAdminService service.
+type TranscriptAdminServiceClient interface {
+	// SearchReplaceTranscripts starts an asynchronous search-and-replace job; poll GetTranscriptReplaceJob for progress.
+	SearchReplaceTranscripts(context.Context, *connect.Request[v1.SearchReplaceTranscriptsRequest]) (*connect.Response[v1.SearchReplaceTranscriptsResponse], error)
+	// GetTranscriptReplaceJob returns a job with its progress and diff preview.
+	GetTranscriptReplaceJob(context.Context, *connect.Request[v1.GetTranscriptReplaceJobRequest]) (*connect.Response[v1.GetTranscriptReplaceJobResponse], error)
+	// ListTranscriptReplaceJobs returns jobs, newest first.
+	ListTranscriptReplaceJobs(context.Context, *connect.Request[v1.ListTranscriptReplaceJobsRequest]) (*connect.Response[v1.ListTranscriptReplaceJobsResponse], error)
+	// RollbackTranscriptReplaceJob restores the transcripts a completed job changed.
+	RollbackTranscriptReplaceJob(context.Context, *connect.Request[v1.RollbackTranscriptReplaceJobRequest]) (*connect.Response[v1.RollbackTranscriptReplaceJobResponse], error)
+}
+
+// NewTranscriptAdminServiceClient constructs a client for the lession.v1.TranscriptAdminService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTranscriptAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TranscriptAdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	transcriptAdminServiceMethods := v1.File_lession_v1_transcript_admin_service_proto.Services().ByName("TranscriptAdminService").Methods()
+	return &transcriptAdminServiceClient{
+		searchReplaceTranscripts: connect.NewClient[v1.SearchReplaceTranscriptsRequest, v1.SearchReplaceTranscriptsResponse](
+			httpClient,
+			baseURL+TranscriptAdminServiceSearchReplaceTranscriptsProcedure,
+			connect.WithSchema(transcriptAdminServiceMethods.ByName("SearchReplaceTranscripts")),
+			connect.WithClientOptions(opts...),
+		),
+		getTranscriptReplaceJob: connect.NewClient[v1.GetTranscriptReplaceJobRequest, v1.GetTranscriptReplaceJobResponse](
+			httpClient,
+			baseURL+TranscriptAdminServiceGetTranscriptReplaceJobProcedure,
+			connect.WithSchema(transcriptAdminServiceMethods.ByName("GetTranscriptReplaceJob")),
+			connect.WithClientOptions(opts...),
+		),
+		listTranscriptReplaceJobs: connect.NewClient[v1.ListTranscriptReplaceJobsRequest, v1.ListTranscriptReplaceJobsResponse](
+			httpClient,
+			baseURL+TranscriptAdminServiceListTranscriptReplaceJobsProcedure,
+			connect.WithSchema(transcriptAdminServiceMethods.ByName("ListTranscriptReplaceJobs")),
+			connect.WithClientOptions(opts...),
+		),
+		rollbackTranscriptReplaceJob: connect.NewClient[v1.RollbackTranscriptReplaceJobRequest, v1.RollbackTranscriptReplaceJobResponse](
+			httpClient,
+			baseURL+TranscriptAdminServiceRollbackTranscriptReplaceJobProcedure,
+			connect.WithSchema(transcriptAdminServiceMethods.ByName("RollbackTranscriptReplaceJob")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// transcriptAdminServiceClient implements TranscriptAdminServiceClient.
+type transcriptAdminServiceClient struct {
+	searchReplaceTranscripts     *connect.Client[v1.SearchReplaceTranscriptsRequest, v1.SearchReplaceTranscriptsResponse]
+	getTranscriptReplaceJob      *connect.Client[v1.GetTranscriptReplaceJobRequest, v1.GetTranscriptReplaceJobResponse]
+	listTranscriptReplaceJobs    *connect.Client[v1.ListTranscriptReplaceJobsRequest, v1.ListTranscriptReplaceJobsResponse]
+	rollbackTranscriptReplaceJob *connect.Client[v1.RollbackTranscriptReplaceJobRequest, v1.RollbackTranscriptReplaceJobResponse]
+}
+
+// SearchReplaceTranscripts calls lession.v1.TranscriptAdminService.SearchReplaceTranscripts.
+func (c *transcriptAdminServiceClient) SearchReplaceTranscripts(ctx context.Context, req *connect.Request[v1.SearchReplaceTranscriptsRequest]) (*connect.Response[v1.SearchReplaceTranscriptsResponse], error) {
+	return c.searchReplaceTranscripts.CallUnary(ctx, req)
+}
+
+// GetTranscriptReplaceJob calls lession.v1.TranscriptAdminService.GetTranscriptReplaceJob.
+func (c *transcriptAdminServiceClient) GetTranscriptReplaceJob(ctx context.Context, req *connect.Request[v1.GetTranscriptReplaceJobRequest]) (*connect.Response[v1.GetTranscriptReplaceJobResponse], error) {
+	return c.getTranscriptReplaceJob.CallUnary(ctx, req)
+}
+
+// ListTranscriptReplaceJobs calls lession.v1.TranscriptAdminService.ListTranscriptReplaceJobs.
+func (c *transcriptAdminServiceClient) ListTranscriptReplaceJobs(ctx context.Context, req *connect.Request[v1.ListTranscriptReplaceJobsRequest]) (*connect.Response[v1.ListTranscriptReplaceJobsResponse], error) {
+	return c.listTranscriptReplaceJobs.CallUnary(ctx, req)
+}
+
+// RollbackTranscriptReplaceJob calls
+// lession.v1.TranscriptAdminService.RollbackTranscriptReplaceJob.
+func (c *transcriptAdminServiceClient) RollbackTranscriptReplaceJob(ctx context.Context, req *connect.Request[v1.RollbackTranscriptReplaceJobRequest]) (*connect.Response[v1.RollbackTranscriptReplaceJobResponse], error) {
+	return c.rollbackTranscriptReplaceJob.CallUnary(ctx, req)
+}
+
+// TranscriptAdminServiceHandler is an implementation of the lession.v1.TranscriptAdminService
+// service.
+type TranscriptAdminServiceHandler interface {
+	// SearchReplaceTranscripts starts an asynchronous search-and-replace job; poll GetTranscriptReplaceJob for progress.
+	SearchReplaceTranscripts(context.Context, *connect.Request[v1.SearchReplaceTranscriptsRequest]) (*connect.Response[v1.SearchReplaceTranscriptsResponse], error)
+	// GetTranscriptReplaceJob returns a job with its progress and diff preview.
+	GetTranscriptReplaceJob(context.Context, *connect.Request[v1.GetTranscriptReplaceJobRequest]) (*connect.Response[v1.GetTranscriptReplaceJobResponse], error)
+	// ListTranscriptReplaceJobs returns jobs, newest first.
+	ListTranscriptReplaceJobs(context.Context, *connect.Request[v1.ListTranscriptReplaceJobsRequest]) (*connect.Response[v1.ListTranscriptReplaceJobsResponse], error)
+	// RollbackTranscriptReplaceJob restores the transcripts a completed job changed.
+	RollbackTranscriptReplaceJob(context.Context, *connect.Request[v1.RollbackTranscriptReplaceJobRequest]) (*connect.Response[v1.RollbackTranscriptReplaceJobResponse], error)
+}
+
+// NewTranscriptAdminServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTranscriptAdminServiceHandler(svc TranscriptAdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	transcriptAdminServiceMethods := v1.File_lession_v1_transcript_admin_service_proto.Services().ByName("TranscriptAdminService").Methods()
+	transcriptAdminServiceSearchReplaceTranscriptsHandler := connect.NewUnaryHandler(
+		TranscriptAdminServiceSearchReplaceTranscriptsProcedure,
+		svc.SearchReplaceTranscripts,
+		connect.WithSchema(transcriptAdminServiceMethods.ByName("SearchReplaceTranscripts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transcriptAdminServiceGetTranscriptReplaceJobHandler := connect.NewUnaryHandler(
+		TranscriptAdminServiceGetTranscriptReplaceJobProcedure,
+		svc.GetTranscriptReplaceJob,
+		connect.WithSchema(transcriptAdminServiceMethods.ByName("GetTranscriptReplaceJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transcriptAdminServiceListTranscriptReplaceJobsHandler := connect.NewUnaryHandler(
+		TranscriptAdminServiceListTranscriptReplaceJobsProcedure,
+		svc.ListTranscriptReplaceJobs,
+		connect.WithSchema(transcriptAdminServiceMethods.ByName("ListTranscriptReplaceJobs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transcriptAdminServiceRollbackTranscriptReplaceJobHandler := connect.NewUnaryHandler(
+		TranscriptAdminServiceRollbackTranscriptReplaceJobProcedure,
+		svc.RollbackTranscriptReplaceJob,
+		connect.WithSchema(transcriptAdminServiceMethods.ByName("RollbackTranscriptReplaceJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.TranscriptAdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TranscriptAdminServiceSearchReplaceTranscriptsProcedure:
+			transcriptAdminServiceSearchReplaceTranscriptsHandler.ServeHTTP(w, r)
+		case TranscriptAdminServiceGetTranscriptReplaceJobProcedure:
+			transcriptAdminServiceGetTranscriptReplaceJobHandler.ServeHTTP(w, r)
+		case TranscriptAdminServiceListTranscriptReplaceJobsProcedure:
+			transcriptAdminServiceListTranscriptReplaceJobsHandler.ServeHTTP(w, r)
+		case TranscriptAdminServiceRollbackTranscriptReplaceJobProcedure:
+			transcriptAdminServiceRollbackTranscriptReplaceJobHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTranscriptAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTranscriptAdminServiceHandler struct{}
+
+func (UnimplementedTranscriptAdminServiceHandler) SearchReplaceTranscripts(context.Context, *connect.Request[v1.SearchReplaceTranscriptsRequest]) (*connect.Response[v1.SearchReplaceTranscriptsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.TranscriptAdminService.SearchReplaceTranscripts is not implemented"))
+}
+
+func (UnimplementedTranscriptAdminServiceHandler) GetTranscriptReplaceJob(context.Context, *connect.Request[v1.GetTranscriptReplaceJobRequest]) (*connect.Response[v1.GetTranscriptReplaceJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.TranscriptAdminService.GetTranscriptReplaceJob is not implemented"))
+}
+
+func (UnimplementedTranscriptAdminServiceHandler) ListTranscriptReplaceJobs(context.Context, *connect.Request[v1.ListTranscriptReplaceJobsRequest]) (*connect.Response[v1.ListTranscriptReplaceJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.TranscriptAdminService.ListTranscriptReplaceJobs is not implemented"))
+}
+
+func (UnimplementedTranscriptAdminServiceHandler) RollbackTranscriptReplaceJob(context.Context, *connect.Request[v1.RollbackTranscriptReplaceJobRequest]) (*connect.Response[v1.RollbackTranscriptReplaceJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.TranscriptAdminService.RollbackTranscriptReplaceJob is not implemented"))
+}
@@ -0,0 +1,175 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/vocabulary_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// VocabularyServiceName is the fully-qualified name of the VocabularyService service.
+	VocabularyServiceName = "lession.v1.VocabularyService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// VocabularyServiceMarkWordsProcedure is the fully-qualified name of the VocabularyService's
+	// MarkWords RPC.
+	VocabularyServiceMarkWordsProcedure = "/lession.v1.VocabularyService/MarkWords"
+	// VocabularyServiceListVocabularyProcedure is the fully-qualified name of the VocabularyService's
+	// ListVocabulary RPC.
+	VocabularyServiceListVocabularyProcedure = "/lession.v1.VocabularyService/ListVocabulary"
+	// VocabularyServiceGetVocabularyCoverageProcedure is the fully-qualified name of the
+	// VocabularyService's GetVocabularyCoverage RPC.
+	VocabularyServiceGetVocabularyCoverageProcedure = "/lession.v1.VocabularyService/GetVocabularyCoverage"
+)
+
+// VocabularyServiceClient is a client for the lession.v1.VocabularyService service.
+type VocabularyServiceClient interface {
+	// MarkWords marks words as known or unknown for a learner. An unspecified
+	// status forgets the marks.
+	MarkWords(context.Context, *connect.Request[v1.MarkWordsRequest]) (*connect.Response[v1.MarkWordsResponse], error)
+	// ListVocabulary returns a learner's marked words, most recently marked first.
+	ListVocabulary(context.Context, *connect.Request[v1.ListVocabularyRequest]) (*connect.Response[v1.ListVocabularyResponse], error)
+	// GetVocabularyCoverage reports the share of an episode's words a learner knows.
+	GetVocabularyCoverage(context.Context, *connect.Request[v1.GetVocabularyCoverageRequest]) (*connect.Response[v1.GetVocabularyCoverageResponse], error)
+}
+
+// NewVocabularyServiceClient constructs a client for the lession.v1.VocabularyService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewVocabularyServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) VocabularyServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	vocabularyServiceMethods := v1.File_lession_v1_vocabulary_service_proto.Services().ByName("VocabularyService").Methods()
+	return &vocabularyServiceClient{
+		markWords: connect.NewClient[v1.MarkWordsRequest, v1.MarkWordsResponse](
+			httpClient,
+			baseURL+VocabularyServiceMarkWordsProcedure,
+			connect.WithSchema(vocabularyServiceMethods.ByName("MarkWords")),
+			connect.WithClientOptions(opts...),
+		),
+		listVocabulary: connect.NewClient[v1.ListVocabularyRequest, v1.ListVocabularyResponse](
+			httpClient,
+			baseURL+VocabularyServiceListVocabularyProcedure,
+			connect.WithSchema(vocabularyServiceMethods.ByName("ListVocabulary")),
+			connect.WithClientOptions(opts...),
+		),
+		getVocabularyCoverage: connect.NewClient[v1.GetVocabularyCoverageRequest, v1.GetVocabularyCoverageResponse](
+			httpClient,
+			baseURL+VocabularyServiceGetVocabularyCoverageProcedure,
+			connect.WithSchema(vocabularyServiceMethods.ByName("GetVocabularyCoverage")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// vocabularyServiceClient implements VocabularyServiceClient.
+type vocabularyServiceClient struct {
+	markWords             *connect.Client[v1.MarkWordsRequest, v1.MarkWordsResponse]
+	listVocabulary        *connect.Client[v1.ListVocabularyRequest, v1.ListVocabularyResponse]
+	getVocabularyCoverage *connect.Client[v1.GetVocabularyCoverageRequest, v1.GetVocabularyCoverageResponse]
+}
+
+// MarkWords calls lession.v1.VocabularyService.MarkWords.
+func (c *vocabularyServiceClient) MarkWords(ctx context.Context, req *connect.Request[v1.MarkWordsRequest]) (*connect.Response[v1.MarkWordsResponse], error) {
+	return c.markWords.CallUnary(ctx, req)
+}
+
+// ListVocabulary calls lession.v1.VocabularyService.ListVocabulary.
+func (c *vocabularyServiceClient) ListVocabulary(ctx context.Context, req *connect.Request[v1.ListVocabularyRequest]) (*connect.Response[v1.ListVocabularyResponse], error) {
+	return c.listVocabulary.CallUnary(ctx, req)
+}
+
+// GetVocabularyCoverage calls lession.v1.VocabularyService.GetVocabularyCoverage.
+func (c *vocabularyServiceClient) GetVocabularyCoverage(ctx context.Context, req *connect.Request[v1.GetVocabularyCoverageRequest]) (*connect.Response[v1.GetVocabularyCoverageResponse], error) {
+	return c.getVocabularyCoverage.CallUnary(ctx, req)
+}
+
+// VocabularyServiceHandler is an implementation of the lession.v1.VocabularyService service.
+type VocabularyServiceHandler interface {
+	// MarkWords marks words as known or unknown for a learner. An unspecified
+	// status forgets the marks.
+	MarkWords(context.Context, *connect.Request[v1.MarkWordsRequest]) (*connect.Response[v1.MarkWordsResponse], error)
+	// ListVocabulary returns a learner's marked words, most recently marked first.
+	ListVocabulary(context.Context, *connect.Request[v1.ListVocabularyRequest]) (*connect.Response[v1.ListVocabularyResponse], error)
+	// GetVocabularyCoverage reports the share of an episode's words a learner knows.
+	GetVocabularyCoverage(context.Context, *connect.Request[v1.GetVocabularyCoverageRequest]) (*connect.Response[v1.GetVocabularyCoverageResponse], error)
+}
+
+// NewVocabularyServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewVocabularyServiceHandler(svc VocabularyServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	vocabularyServiceMethods := v1.File_lession_v1_vocabulary_service_proto.Services().ByName("VocabularyService").Methods()
+	vocabularyServiceMarkWordsHandler := connect.NewUnaryHandler(
+		VocabularyServiceMarkWordsProcedure,
+		svc.MarkWords,
+		connect.WithSchema(vocabularyServiceMethods.ByName("MarkWords")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vocabularyServiceListVocabularyHandler := connect.NewUnaryHandler(
+		VocabularyServiceListVocabularyProcedure,
+		svc.ListVocabulary,
+		connect.WithSchema(vocabularyServiceMethods.ByName("ListVocabulary")),
+		connect.WithHandlerOptions(opts...),
+	)
+	vocabularyServiceGetVocabularyCoverageHandler := connect.NewUnaryHandler(
+		VocabularyServiceGetVocabularyCoverageProcedure,
+		svc.GetVocabularyCoverage,
+		connect.WithSchema(vocabularyServiceMethods.ByName("GetVocabularyCoverage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.VocabularyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case VocabularyServiceMarkWordsProcedure:
+			vocabularyServiceMarkWordsHandler.ServeHTTP(w, r)
+		case VocabularyServiceListVocabularyProcedure:
+			vocabularyServiceListVocabularyHandler.ServeHTTP(w, r)
+		case VocabularyServiceGetVocabularyCoverageProcedure:
+			vocabularyServiceGetVocabularyCoverageHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedVocabularyServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedVocabularyServiceHandler struct{}
+
+func (UnimplementedVocabularyServiceHandler) MarkWords(context.Context, *connect.Request[v1.MarkWordsRequest]) (*connect.Response[v1.MarkWordsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.VocabularyService.MarkWords is not implemented"))
+}
+
+func (UnimplementedVocabularyServiceHandler) ListVocabulary(context.Context, *connect.Request[v1.ListVocabularyRequest]) (*connect.Response[v1.ListVocabularyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.VocabularyService.ListVocabulary is not implemented"))
+}
+
+func (UnimplementedVocabularyServiceHandler) GetVocabularyCoverage(context.Context, *connect.Request[v1.GetVocabularyCoverageRequest]) (*connect.Response[v1.GetVocabularyCoverageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.VocabularyService.GetVocabularyCoverage is not implemented"))
+}
@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: lession/v1/watch_history_service.proto
+
+package lessionv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/lession/pkg/api/lession/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WatchHistoryServiceName is the fully-qualified name of the WatchHistoryService service.
+	WatchHistoryServiceName = "lession.v1.WatchHistoryService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WatchHistoryServiceRecordPlaybackSessionProcedure is the fully-qualified name of the
+	// WatchHistoryService's RecordPlaybackSession RPC.
+	WatchHistoryServiceRecordPlaybackSessionProcedure = "/lession.v1.WatchHistoryService/RecordPlaybackSession"
+	// WatchHistoryServiceFinishPlaybackSessionProcedure is the fully-qualified name of the
+	// WatchHistoryService's FinishPlaybackSession RPC.
+	WatchHistoryServiceFinishPlaybackSessionProcedure = "/lession.v1.WatchHistoryService/FinishPlaybackSession"
+	// WatchHistoryServiceListHistoryProcedure is the fully-qualified name of the WatchHistoryService's
+	// ListHistory RPC.
+	WatchHistoryServiceListHistoryProcedure = "/lession.v1.WatchHistoryService/ListHistory"
+	// WatchHistoryServiceClearHistoryProcedure is the fully-qualified name of the WatchHistoryService's
+	// ClearHistory RPC.
+	WatchHistoryServiceClearHistoryProcedure = "/lession.v1.WatchHistoryService/ClearHistory"
+)
+
+// WatchHistoryServiceClient is a client for the lession.v1.WatchHistoryService service.
+type WatchHistoryServiceClient interface {
+	// RecordPlaybackSession appends a playback session to a learner's history.
+	RecordPlaybackSession(context.Context, *connect.Request[v1.RecordPlaybackSessionRequest]) (*connect.Response[v1.RecordPlaybackSessionResponse], error)
+	// FinishPlaybackSession records when an open playback session ended.
+	FinishPlaybackSession(context.Context, *connect.Request[v1.FinishPlaybackSessionRequest]) (*connect.Response[v1.FinishPlaybackSessionResponse], error)
+	// ListHistory returns a learner's playback sessions, most recently started first.
+	ListHistory(context.Context, *connect.Request[v1.ListHistoryRequest]) (*connect.Response[v1.ListHistoryResponse], error)
+	// ClearHistory deletes a learner's playback sessions.
+	ClearHistory(context.Context, *connect.Request[v1.ClearHistoryRequest]) (*connect.Response[v1.ClearHistoryResponse], error)
+}
+
+// NewWatchHistoryServiceClient constructs a client for the lession.v1.WatchHistoryService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWatchHistoryServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WatchHistoryServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	watchHistoryServiceMethods := v1.File_lession_v1_watch_history_service_proto.Services().ByName("WatchHistoryService").Methods()
+	return &watchHistoryServiceClient{
+		recordPlaybackSession: connect.NewClient[v1.RecordPlaybackSessionRequest, v1.RecordPlaybackSessionResponse](
+			httpClient,
+			baseURL+WatchHistoryServiceRecordPlaybackSessionProcedure,
+			connect.WithSchema(watchHistoryServiceMethods.ByName("RecordPlaybackSession")),
+			connect.WithClientOptions(opts...),
+		),
+		finishPlaybackSession: connect.NewClient[v1.FinishPlaybackSessionRequest, v1.FinishPlaybackSessionResponse](
+			httpClient,
+			baseURL+WatchHistoryServiceFinishPlaybackSessionProcedure,
+			connect.WithSchema(watchHistoryServiceMethods.ByName("FinishPlaybackSession")),
+			connect.WithClientOptions(opts...),
+		),
+		listHistory: connect.NewClient[v1.ListHistoryRequest, v1.ListHistoryResponse](
+			httpClient,
+			baseURL+WatchHistoryServiceListHistoryProcedure,
+			connect.WithSchema(watchHistoryServiceMethods.ByName("ListHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		clearHistory: connect.NewClient[v1.ClearHistoryRequest, v1.ClearHistoryResponse](
+			httpClient,
+			baseURL+WatchHistoryServiceClearHistoryProcedure,
+			connect.WithSchema(watchHistoryServiceMethods.ByName("ClearHistory")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// watchHistoryServiceClient implements WatchHistoryServiceClient.
+type watchHistoryServiceClient struct {
+	recordPlaybackSession *connect.Client[v1.RecordPlaybackSessionRequest, v1.RecordPlaybackSessionResponse]
+	finishPlaybackSession *connect.Client[v1.FinishPlaybackSessionRequest, v1.FinishPlaybackSessionResponse]
+	listHistory           *connect.Client[v1.ListHistoryRequest, v1.ListHistoryResponse]
+	clearHistory          *connect.Client[v1.ClearHistoryRequest, v1.ClearHistoryResponse]
+}
+
+// RecordPlaybackSession calls lession.v1.WatchHistoryService.RecordPlaybackSession.
+func (c *watchHistoryServiceClient) RecordPlaybackSession(ctx context.Context, req *connect.Request[v1.RecordPlaybackSessionRequest]) (*connect.Response[v1.RecordPlaybackSessionResponse], error) {
+	return c.recordPlaybackSession.CallUnary(ctx, req)
+}
+
+// FinishPlaybackSession calls lession.v1.WatchHistoryService.FinishPlaybackSession.
+func (c *watchHistoryServiceClient) FinishPlaybackSession(ctx context.Context, req *connect.Request[v1.FinishPlaybackSessionRequest]) (*connect.Response[v1.FinishPlaybackSessionResponse], error) {
+	return c.finishPlaybackSession.CallUnary(ctx, req)
+}
+
+// ListHistory calls lession.v1.WatchHistoryService.ListHistory.
+func (c *watchHistoryServiceClient) ListHistory(ctx context.Context, req *connect.Request[v1.ListHistoryRequest]) (*connect.Response[v1.ListHistoryResponse], error) {
+	return c.listHistory.CallUnary(ctx, req)
+}
+
+// ClearHistory calls lession.v1.WatchHistoryService.ClearHistory.
+func (c *watchHistoryServiceClient) ClearHistory(ctx context.Context, req *connect.Request[v1.ClearHistoryRequest]) (*connect.Response[v1.ClearHistoryResponse], error) {
+	return c.clearHistory.CallUnary(ctx, req)
+}
+
+// WatchHistoryServiceHandler is an implementation of the lession.v1.WatchHistoryService service.
+type WatchHistoryServiceHandler interface {
+	// RecordPlaybackSession appends a playback session to a learner's history.
+	RecordPlaybackSession(context.Context, *connect.Request[v1.RecordPlaybackSessionRequest]) (*connect.Response[v1.RecordPlaybackSessionResponse], error)
+	// FinishPlaybackSession records when an open playback session ended.
+	FinishPlaybackSession(context.Context, *connect.Request[v1.FinishPlaybackSessionRequest]) (*connect.Response[v1.FinishPlaybackSessionResponse], error)
+	// ListHistory returns a learner's playback sessions, most recently started first.
+	ListHistory(context.Context, *connect.Request[v1.ListHistoryRequest]) (*connect.Response[v1.ListHistoryResponse], error)
+	// ClearHistory deletes a learner's playback sessions.
+	ClearHistory(context.Context, *connect.Request[v1.ClearHistoryRequest]) (*connect.Response[v1.ClearHistoryResponse], error)
+}
+
+// NewWatchHistoryServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWatchHistoryServiceHandler(svc WatchHistoryServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	watchHistoryServiceMethods := v1.File_lession_v1_watch_history_service_proto.Services().ByName("WatchHistoryService").Methods()
+	watchHistoryServiceRecordPlaybackSessionHandler := connect.NewUnaryHandler(
+		WatchHistoryServiceRecordPlaybackSessionProcedure,
+		svc.RecordPlaybackSession,
+		connect.WithSchema(watchHistoryServiceMethods.ByName("RecordPlaybackSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	watchHistoryServiceFinishPlaybackSessionHandler := connect.NewUnaryHandler(
+		WatchHistoryServiceFinishPlaybackSessionProcedure,
+		svc.FinishPlaybackSession,
+		connect.WithSchema(watchHistoryServiceMethods.ByName("FinishPlaybackSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	watchHistoryServiceListHistoryHandler := connect.NewUnaryHandler(
+		WatchHistoryServiceListHistoryProcedure,
+		svc.ListHistory,
+		connect.WithSchema(watchHistoryServiceMethods.ByName("ListHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	watchHistoryServiceClearHistoryHandler := connect.NewUnaryHandler(
+		WatchHistoryServiceClearHistoryProcedure,
+		svc.ClearHistory,
+		connect.WithSchema(watchHistoryServiceMethods.ByName("ClearHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/lession.v1.WatchHistoryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WatchHistoryServiceRecordPlaybackSessionProcedure:
+			watchHistoryServiceRecordPlaybackSessionHandler.ServeHTTP(w, r)
+		case WatchHistoryServiceFinishPlaybackSessionProcedure:
+			watchHistoryServiceFinishPlaybackSessionHandler.ServeHTTP(w, r)
+		case WatchHistoryServiceListHistoryProcedure:
+			watchHistoryServiceListHistoryHandler.ServeHTTP(w, r)
+		case WatchHistoryServiceClearHistoryProcedure:
+			watchHistoryServiceClearHistoryHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWatchHistoryServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWatchHistoryServiceHandler struct{}
+
+func (UnimplementedWatchHistoryServiceHandler) RecordPlaybackSession(context.Context, *connect.Request[v1.RecordPlaybackSessionRequest]) (*connect.Response[v1.RecordPlaybackSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.WatchHistoryService.RecordPlaybackSession is not implemented"))
+}
+
+func (UnimplementedWatchHistoryServiceHandler) FinishPlaybackSession(context.Context, *connect.Request[v1.FinishPlaybackSessionRequest]) (*connect.Response[v1.FinishPlaybackSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.WatchHistoryService.FinishPlaybackSession is not implemented"))
+}
+
+func (UnimplementedWatchHistoryServiceHandler) ListHistory(context.Context, *connect.Request[v1.ListHistoryRequest]) (*connect.Response[v1.ListHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.WatchHistoryService.ListHistory is not implemented"))
+}
+
+func (UnimplementedWatchHistoryServiceHandler) ClearHistory(context.Context, *connect.Request[v1.ClearHistoryRequest]) (*connect.Response[v1.ClearHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lession.v1.WatchHistoryService.ClearHistory is not implemented"))
+}
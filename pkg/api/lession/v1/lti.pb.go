@@ -0,0 +1,385 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/lti.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LtiMessageType enumerates the LTI messages the tool accepts.
+type LtiMessageType int32
+
+const (
+	// LTI_MESSAGE_TYPE_UNSPECIFIED is the default zero value.
+	LtiMessageType_LTI_MESSAGE_TYPE_UNSPECIFIED LtiMessageType = 0
+	// LTI_MESSAGE_TYPE_RESOURCE_LINK opens an embedded episode for a learner.
+	LtiMessageType_LTI_MESSAGE_TYPE_RESOURCE_LINK LtiMessageType = 1
+	// LTI_MESSAGE_TYPE_DEEP_LINKING lets an instructor pick episodes to embed.
+	LtiMessageType_LTI_MESSAGE_TYPE_DEEP_LINKING LtiMessageType = 2
+)
+
+// Enum value maps for LtiMessageType.
+var (
+	LtiMessageType_name = map[int32]string{
+		0: "LTI_MESSAGE_TYPE_UNSPECIFIED",
+		1: "LTI_MESSAGE_TYPE_RESOURCE_LINK",
+		2: "LTI_MESSAGE_TYPE_DEEP_LINKING",
+	}
+	LtiMessageType_value = map[string]int32{
+		"LTI_MESSAGE_TYPE_UNSPECIFIED":   0,
+		"LTI_MESSAGE_TYPE_RESOURCE_LINK": 1,
+		"LTI_MESSAGE_TYPE_DEEP_LINKING":  2,
+	}
+)
+
+func (x LtiMessageType) Enum() *LtiMessageType {
+	p := new(LtiMessageType)
+	*p = x
+	return p
+}
+
+func (x LtiMessageType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LtiMessageType) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_lti_proto_enumTypes[0].Descriptor()
+}
+
+func (LtiMessageType) Type() protoreflect.EnumType {
+	return &file_lession_v1_lti_proto_enumTypes[0]
+}
+
+func (x LtiMessageType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LtiMessageType.Descriptor instead.
+func (LtiMessageType) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_lti_proto_rawDescGZIP(), []int{0}
+}
+
+// LtiPlatform is an LMS registration (e.g. a Moodle or Canvas instance)
+// allowed to launch the tool through LTI 1.3.
+type LtiPlatform struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the registration.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// issuer is the platform's OIDC issuer identifier.
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// client_id is the identifier the platform assigned to the tool.
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// deployment_id restricts launches to one deployment when set.
+	DeploymentId string `protobuf:"bytes,4,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	// auth_login_url is the platform's OIDC authorization endpoint.
+	AuthLoginUrl string `protobuf:"bytes,5,opt,name=auth_login_url,json=authLoginUrl,proto3" json:"auth_login_url,omitempty"`
+	// auth_token_url is the platform's OAuth2 token endpoint used for grade passback.
+	AuthTokenUrl string `protobuf:"bytes,6,opt,name=auth_token_url,json=authTokenUrl,proto3" json:"auth_token_url,omitempty"`
+	// jwks_url publishes the keys the platform signs launches with.
+	JwksUrl string `protobuf:"bytes,7,opt,name=jwks_url,json=jwksUrl,proto3" json:"jwks_url,omitempty"`
+	// created_at is when the platform was registered.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LtiPlatform) Reset() {
+	*x = LtiPlatform{}
+	mi := &file_lession_v1_lti_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LtiPlatform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LtiPlatform) ProtoMessage() {}
+
+func (x *LtiPlatform) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_lti_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LtiPlatform.ProtoReflect.Descriptor instead.
+func (*LtiPlatform) Descriptor() ([]byte, []int) {
+	return file_lession_v1_lti_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LtiPlatform) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LtiPlatform) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *LtiPlatform) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *LtiPlatform) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *LtiPlatform) GetAuthLoginUrl() string {
+	if x != nil {
+		return x.AuthLoginUrl
+	}
+	return ""
+}
+
+func (x *LtiPlatform) GetAuthTokenUrl() string {
+	if x != nil {
+		return x.AuthTokenUrl
+	}
+	return ""
+}
+
+func (x *LtiPlatform) GetJwksUrl() string {
+	if x != nil {
+		return x.JwksUrl
+	}
+	return ""
+}
+
+func (x *LtiPlatform) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// LtiLaunch is a verified launch from a platform.
+type LtiLaunch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the launch.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// platform_id references the launching platform.
+	PlatformId string `protobuf:"bytes,2,opt,name=platform_id,json=platformId,proto3" json:"platform_id,omitempty"`
+	// message_type is the kind of launch.
+	MessageType LtiMessageType `protobuf:"varint,3,opt,name=message_type,json=messageType,proto3,enum=lession.v1.LtiMessageType" json:"message_type,omitempty"`
+	// user_id identifies the learner within this service, scoped to the platform.
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// episode_id references the launched episode for resource link launches.
+	EpisodeId string `protobuf:"bytes,5,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// grade_passback_enabled reports whether the platform accepts scores for this launch.
+	GradePassbackEnabled bool `protobuf:"varint,6,opt,name=grade_passback_enabled,json=gradePassbackEnabled,proto3" json:"grade_passback_enabled,omitempty"`
+	// score_submitted_at is when a score was last posted to the platform.
+	ScoreSubmittedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=score_submitted_at,json=scoreSubmittedAt,proto3" json:"score_submitted_at,omitempty"`
+	// created_at is when the launch happened.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LtiLaunch) Reset() {
+	*x = LtiLaunch{}
+	mi := &file_lession_v1_lti_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LtiLaunch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LtiLaunch) ProtoMessage() {}
+
+func (x *LtiLaunch) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_lti_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LtiLaunch.ProtoReflect.Descriptor instead.
+func (*LtiLaunch) Descriptor() ([]byte, []int) {
+	return file_lession_v1_lti_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LtiLaunch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LtiLaunch) GetPlatformId() string {
+	if x != nil {
+		return x.PlatformId
+	}
+	return ""
+}
+
+func (x *LtiLaunch) GetMessageType() LtiMessageType {
+	if x != nil {
+		return x.MessageType
+	}
+	return LtiMessageType_LTI_MESSAGE_TYPE_UNSPECIFIED
+}
+
+func (x *LtiLaunch) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LtiLaunch) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *LtiLaunch) GetGradePassbackEnabled() bool {
+	if x != nil {
+		return x.GradePassbackEnabled
+	}
+	return false
+}
+
+func (x *LtiLaunch) GetScoreSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScoreSubmittedAt
+	}
+	return nil
+}
+
+func (x *LtiLaunch) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_lession_v1_lti_proto protoreflect.FileDescriptor
+
+const file_lession_v1_lti_proto_rawDesc = "" +
+	"\n" +
+	"\x14lession/v1/lti.proto\x12\n" +
+	"lession.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x99\x02\n" +
+	"\vLtiPlatform\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06issuer\x18\x02 \x01(\tR\x06issuer\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12#\n" +
+	"\rdeployment_id\x18\x04 \x01(\tR\fdeploymentId\x12$\n" +
+	"\x0eauth_login_url\x18\x05 \x01(\tR\fauthLoginUrl\x12$\n" +
+	"\x0eauth_token_url\x18\x06 \x01(\tR\fauthTokenUrl\x12\x19\n" +
+	"\bjwks_url\x18\a \x01(\tR\ajwksUrl\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xee\x02\n" +
+	"\tLtiLaunch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vplatform_id\x18\x02 \x01(\tR\n" +
+	"platformId\x12=\n" +
+	"\fmessage_type\x18\x03 \x01(\x0e2\x1a.lession.v1.LtiMessageTypeR\vmessageType\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x05 \x01(\tR\tepisodeId\x124\n" +
+	"\x16grade_passback_enabled\x18\x06 \x01(\bR\x14gradePassbackEnabled\x12H\n" +
+	"\x12score_submitted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x10scoreSubmittedAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt*y\n" +
+	"\x0eLtiMessageType\x12 \n" +
+	"\x1cLTI_MESSAGE_TYPE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eLTI_MESSAGE_TYPE_RESOURCE_LINK\x10\x01\x12!\n" +
+	"\x1dLTI_MESSAGE_TYPE_DEEP_LINKING\x10\x02B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_lti_proto_rawDescOnce sync.Once
+	file_lession_v1_lti_proto_rawDescData []byte
+)
+
+func file_lession_v1_lti_proto_rawDescGZIP() []byte {
+	file_lession_v1_lti_proto_rawDescOnce.Do(func() {
+		file_lession_v1_lti_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_lti_proto_rawDesc), len(file_lession_v1_lti_proto_rawDesc)))
+	})
+	return file_lession_v1_lti_proto_rawDescData
+}
+
+var file_lession_v1_lti_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lession_v1_lti_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_lession_v1_lti_proto_goTypes = []any{
+	(LtiMessageType)(0),           // 0: lession.v1.LtiMessageType
+	(*LtiPlatform)(nil),           // 1: lession.v1.LtiPlatform
+	(*LtiLaunch)(nil),             // 2: lession.v1.LtiLaunch
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_lession_v1_lti_proto_depIdxs = []int32{
+	3, // 0: lession.v1.LtiPlatform.created_at:type_name -> google.protobuf.Timestamp
+	0, // 1: lession.v1.LtiLaunch.message_type:type_name -> lession.v1.LtiMessageType
+	3, // 2: lession.v1.LtiLaunch.score_submitted_at:type_name -> google.protobuf.Timestamp
+	3, // 3: lession.v1.LtiLaunch.created_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_lti_proto_init() }
+func file_lession_v1_lti_proto_init() {
+	if File_lession_v1_lti_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_lti_proto_rawDesc), len(file_lession_v1_lti_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_lti_proto_goTypes,
+		DependencyIndexes: file_lession_v1_lti_proto_depIdxs,
+		EnumInfos:         file_lession_v1_lti_proto_enumTypes,
+		MessageInfos:      file_lession_v1_lti_proto_msgTypes,
+	}.Build()
+	File_lession_v1_lti_proto = out.File
+	file_lession_v1_lti_proto_goTypes = nil
+	file_lession_v1_lti_proto_depIdxs = nil
+}
@@ -0,0 +1,653 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/lti_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RegisterLtiPlatformRequest carries the platform's registration details.
+type RegisterLtiPlatformRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// issuer is the platform's OIDC issuer identifier.
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// client_id is the identifier the platform assigned to the tool.
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// deployment_id optionally restricts launches to one deployment.
+	DeploymentId string `protobuf:"bytes,3,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	// auth_login_url is the platform's OIDC authorization endpoint.
+	AuthLoginUrl string `protobuf:"bytes,4,opt,name=auth_login_url,json=authLoginUrl,proto3" json:"auth_login_url,omitempty"`
+	// auth_token_url is the platform's OAuth2 token endpoint.
+	AuthTokenUrl string `protobuf:"bytes,5,opt,name=auth_token_url,json=authTokenUrl,proto3" json:"auth_token_url,omitempty"`
+	// jwks_url publishes the platform's signing keys.
+	JwksUrl       string `protobuf:"bytes,6,opt,name=jwks_url,json=jwksUrl,proto3" json:"jwks_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterLtiPlatformRequest) Reset() {
+	*x = RegisterLtiPlatformRequest{}
+	mi := &file_lession_v1_lti_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterLtiPlatformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterLtiPlatformRequest) ProtoMessage() {}
+
+func (x *RegisterLtiPlatformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_lti_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterLtiPlatformRequest.ProtoReflect.Descriptor instead.
+func (*RegisterLtiPlatformRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_lti_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RegisterLtiPlatformRequest) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *RegisterLtiPlatformRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *RegisterLtiPlatformRequest) GetDeploymentId() string {
+	if x != nil {
+		return x.DeploymentId
+	}
+	return ""
+}
+
+func (x *RegisterLtiPlatformRequest) GetAuthLoginUrl() string {
+	if x != nil {
+		return x.AuthLoginUrl
+	}
+	return ""
+}
+
+func (x *RegisterLtiPlatformRequest) GetAuthTokenUrl() string {
+	if x != nil {
+		return x.AuthTokenUrl
+	}
+	return ""
+}
+
+func (x *RegisterLtiPlatformRequest) GetJwksUrl() string {
+	if x != nil {
+		return x.JwksUrl
+	}
+	return ""
+}
+
+// RegisterLtiPlatformResponse returns the registration.
+type RegisterLtiPlatformResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// platform is the stored registration.
+	Platform      *LtiPlatform `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterLtiPlatformResponse) Reset() {
+	*x = RegisterLtiPlatformResponse{}
+	mi := &file_lession_v1_lti_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterLtiPlatformResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterLtiPlatformResponse) ProtoMessage() {}
+
+func (x *RegisterLtiPlatformResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_lti_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterLtiPlatformResponse.ProtoReflect.Descriptor instead.
+func (*RegisterLtiPlatformResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_lti_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisterLtiPlatformResponse) GetPlatform() *LtiPlatform {
+	if x != nil {
+		return x.Platform
+	}
+	return nil
+}
+
+// ListLtiPlatformsRequest takes no parameters.
+type ListLtiPlatformsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLtiPlatformsRequest) Reset() {
+	*x = ListLtiPlatformsRequest{}
+	mi := &file_lession_v1_lti_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLtiPlatformsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLtiPlatformsRequest) ProtoMessage() {}
+
+func (x *ListLtiPlatformsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_lti_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLtiPlatformsRequest.ProtoReflect.Descriptor instead.
+func (*ListLtiPlatformsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_lti_service_proto_rawDescGZIP(), []int{2}
+}
+
+// ListLtiPlatformsResponse returns the registrations.
+type ListLtiPlatformsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// platforms are ordered oldest first.
+	Platforms     []*LtiPlatform `protobuf:"bytes,1,rep,name=platforms,proto3" json:"platforms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLtiPlatformsResponse) Reset() {
+	*x = ListLtiPlatformsResponse{}
+	mi := &file_lession_v1_lti_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLtiPlatformsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLtiPlatformsResponse) ProtoMessage() {}
+
+func (x *ListLtiPlatformsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_lti_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLtiPlatformsResponse.ProtoReflect.Descriptor instead.
+func (*ListLtiPlatformsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_lti_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListLtiPlatformsResponse) GetPlatforms() []*LtiPlatform {
+	if x != nil {
+		return x.Platforms
+	}
+	return nil
+}
+
+// GetLtiLaunchRequest selects a launch.
+type GetLtiLaunchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// launch_id references the launch.
+	LaunchId      string `protobuf:"bytes,1,opt,name=launch_id,json=launchId,proto3" json:"launch_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLtiLaunchRequest) Reset() {
+	*x = GetLtiLaunchRequest{}
+	mi := &file_lession_v1_lti_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLtiLaunchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLtiLaunchRequest) ProtoMessage() {}
+
+func (x *GetLtiLaunchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_lti_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLtiLaunchRequest.ProtoReflect.Descriptor instead.
+func (*GetLtiLaunchRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_lti_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetLtiLaunchRequest) GetLaunchId() string {
+	if x != nil {
+		return x.LaunchId
+	}
+	return ""
+}
+
+// GetLtiLaunchResponse returns the launch.
+type GetLtiLaunchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// launch is the requested launch.
+	Launch        *LtiLaunch `protobuf:"bytes,1,opt,name=launch,proto3" json:"launch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLtiLaunchResponse) Reset() {
+	*x = GetLtiLaunchResponse{}
+	mi := &file_lession_v1_lti_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLtiLaunchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLtiLaunchResponse) ProtoMessage() {}
+
+func (x *GetLtiLaunchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_lti_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLtiLaunchResponse.ProtoReflect.Descriptor instead.
+func (*GetLtiLaunchResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_lti_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetLtiLaunchResponse) GetLaunch() *LtiLaunch {
+	if x != nil {
+		return x.Launch
+	}
+	return nil
+}
+
+// CreateLtiDeepLinkingResponseRequest lists the chosen episodes.
+type CreateLtiDeepLinkingResponseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// launch_id references the deep linking launch.
+	LaunchId string `protobuf:"bytes,1,opt,name=launch_id,json=launchId,proto3" json:"launch_id,omitempty"`
+	// episode_ids are the published episodes to embed.
+	EpisodeIds    []string `protobuf:"bytes,2,rep,name=episode_ids,json=episodeIds,proto3" json:"episode_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLtiDeepLinkingResponseRequest) Reset() {
+	*x = CreateLtiDeepLinkingResponseRequest{}
+	mi := &file_lession_v1_lti_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLtiDeepLinkingResponseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLtiDeepLinkingResponseRequest) ProtoMessage() {}
+
+func (x *CreateLtiDeepLinkingResponseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_lti_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLtiDeepLinkingResponseRequest.ProtoReflect.Descriptor instead.
+func (*CreateLtiDeepLinkingResponseRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_lti_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateLtiDeepLinkingResponseRequest) GetLaunchId() string {
+	if x != nil {
+		return x.LaunchId
+	}
+	return ""
+}
+
+func (x *CreateLtiDeepLinkingResponseRequest) GetEpisodeIds() []string {
+	if x != nil {
+		return x.EpisodeIds
+	}
+	return nil
+}
+
+// CreateLtiDeepLinkingResponseResponse carries the message to return to the platform.
+type CreateLtiDeepLinkingResponseResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// return_url is where the browser must post the jwt.
+	ReturnUrl string `protobuf:"bytes,1,opt,name=return_url,json=returnUrl,proto3" json:"return_url,omitempty"`
+	// jwt is the signed deep linking response, posted as the "JWT" form field.
+	Jwt           string `protobuf:"bytes,2,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLtiDeepLinkingResponseResponse) Reset() {
+	*x = CreateLtiDeepLinkingResponseResponse{}
+	mi := &file_lession_v1_lti_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLtiDeepLinkingResponseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLtiDeepLinkingResponseResponse) ProtoMessage() {}
+
+func (x *CreateLtiDeepLinkingResponseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_lti_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLtiDeepLinkingResponseResponse.ProtoReflect.Descriptor instead.
+func (*CreateLtiDeepLinkingResponseResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_lti_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateLtiDeepLinkingResponseResponse) GetReturnUrl() string {
+	if x != nil {
+		return x.ReturnUrl
+	}
+	return ""
+}
+
+func (x *CreateLtiDeepLinkingResponseResponse) GetJwt() string {
+	if x != nil {
+		return x.Jwt
+	}
+	return ""
+}
+
+// SubmitLtiScoreRequest selects the launch to grade.
+type SubmitLtiScoreRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// launch_id references the resource link launch.
+	LaunchId      string `protobuf:"bytes,1,opt,name=launch_id,json=launchId,proto3" json:"launch_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitLtiScoreRequest) Reset() {
+	*x = SubmitLtiScoreRequest{}
+	mi := &file_lession_v1_lti_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitLtiScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitLtiScoreRequest) ProtoMessage() {}
+
+func (x *SubmitLtiScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_lti_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitLtiScoreRequest.ProtoReflect.Descriptor instead.
+func (*SubmitLtiScoreRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_lti_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SubmitLtiScoreRequest) GetLaunchId() string {
+	if x != nil {
+		return x.LaunchId
+	}
+	return ""
+}
+
+// SubmitLtiScoreResponse returns the updated launch.
+type SubmitLtiScoreResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// launch records when the score was submitted.
+	Launch        *LtiLaunch `protobuf:"bytes,1,opt,name=launch,proto3" json:"launch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitLtiScoreResponse) Reset() {
+	*x = SubmitLtiScoreResponse{}
+	mi := &file_lession_v1_lti_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitLtiScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitLtiScoreResponse) ProtoMessage() {}
+
+func (x *SubmitLtiScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_lti_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitLtiScoreResponse.ProtoReflect.Descriptor instead.
+func (*SubmitLtiScoreResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_lti_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SubmitLtiScoreResponse) GetLaunch() *LtiLaunch {
+	if x != nil {
+		return x.Launch
+	}
+	return nil
+}
+
+var File_lession_v1_lti_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_lti_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1clession/v1/lti_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x14lession/v1/lti.proto\"\x8d\x02\n" +
+	"\x1aRegisterLtiPlatformRequest\x12\x1f\n" +
+	"\x06issuer\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06issuer\x12$\n" +
+	"\tclient_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bclientId\x12#\n" +
+	"\rdeployment_id\x18\x03 \x01(\tR\fdeploymentId\x12.\n" +
+	"\x0eauth_login_url\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x88\x01\x01R\fauthLoginUrl\x12.\n" +
+	"\x0eauth_token_url\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x88\x01\x01R\fauthTokenUrl\x12#\n" +
+	"\bjwks_url\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x88\x01\x01R\ajwksUrl\"R\n" +
+	"\x1bRegisterLtiPlatformResponse\x123\n" +
+	"\bplatform\x18\x01 \x01(\v2\x17.lession.v1.LtiPlatformR\bplatform\"\x19\n" +
+	"\x17ListLtiPlatformsRequest\"Q\n" +
+	"\x18ListLtiPlatformsResponse\x125\n" +
+	"\tplatforms\x18\x01 \x03(\v2\x17.lession.v1.LtiPlatformR\tplatforms\"<\n" +
+	"\x13GetLtiLaunchRequest\x12%\n" +
+	"\tlaunch_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\blaunchId\"E\n" +
+	"\x14GetLtiLaunchResponse\x12-\n" +
+	"\x06launch\x18\x01 \x01(\v2\x15.lession.v1.LtiLaunchR\x06launch\"\x80\x01\n" +
+	"#CreateLtiDeepLinkingResponseRequest\x12%\n" +
+	"\tlaunch_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\blaunchId\x122\n" +
+	"\vepisode_ids\x18\x02 \x03(\tB\x11\xbaH\x0e\x92\x01\v\b\x01\x102\"\x05r\x03\xb0\x01\x01R\n" +
+	"episodeIds\"W\n" +
+	"$CreateLtiDeepLinkingResponseResponse\x12\x1d\n" +
+	"\n" +
+	"return_url\x18\x01 \x01(\tR\treturnUrl\x12\x10\n" +
+	"\x03jwt\x18\x02 \x01(\tR\x03jwt\">\n" +
+	"\x15SubmitLtiScoreRequest\x12%\n" +
+	"\tlaunch_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\blaunchId\"G\n" +
+	"\x16SubmitLtiScoreResponse\x12-\n" +
+	"\x06launch\x18\x01 \x01(\v2\x15.lession.v1.LtiLaunchR\x06launch2\x83\x04\n" +
+	"\n" +
+	"LtiService\x12f\n" +
+	"\x13RegisterLtiPlatform\x12&.lession.v1.RegisterLtiPlatformRequest\x1a'.lession.v1.RegisterLtiPlatformResponse\x12]\n" +
+	"\x10ListLtiPlatforms\x12#.lession.v1.ListLtiPlatformsRequest\x1a$.lession.v1.ListLtiPlatformsResponse\x12Q\n" +
+	"\fGetLtiLaunch\x12\x1f.lession.v1.GetLtiLaunchRequest\x1a .lession.v1.GetLtiLaunchResponse\x12\x81\x01\n" +
+	"\x1cCreateLtiDeepLinkingResponse\x12/.lession.v1.CreateLtiDeepLinkingResponseRequest\x1a0.lession.v1.CreateLtiDeepLinkingResponseResponse\x12W\n" +
+	"\x0eSubmitLtiScore\x12!.lession.v1.SubmitLtiScoreRequest\x1a\".lession.v1.SubmitLtiScoreResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_lti_service_proto_rawDescOnce sync.Once
+	file_lession_v1_lti_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_lti_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_lti_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_lti_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_lti_service_proto_rawDesc), len(file_lession_v1_lti_service_proto_rawDesc)))
+	})
+	return file_lession_v1_lti_service_proto_rawDescData
+}
+
+var file_lession_v1_lti_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_lession_v1_lti_service_proto_goTypes = []any{
+	(*RegisterLtiPlatformRequest)(nil),           // 0: lession.v1.RegisterLtiPlatformRequest
+	(*RegisterLtiPlatformResponse)(nil),          // 1: lession.v1.RegisterLtiPlatformResponse
+	(*ListLtiPlatformsRequest)(nil),              // 2: lession.v1.ListLtiPlatformsRequest
+	(*ListLtiPlatformsResponse)(nil),             // 3: lession.v1.ListLtiPlatformsResponse
+	(*GetLtiLaunchRequest)(nil),                  // 4: lession.v1.GetLtiLaunchRequest
+	(*GetLtiLaunchResponse)(nil),                 // 5: lession.v1.GetLtiLaunchResponse
+	(*CreateLtiDeepLinkingResponseRequest)(nil),  // 6: lession.v1.CreateLtiDeepLinkingResponseRequest
+	(*CreateLtiDeepLinkingResponseResponse)(nil), // 7: lession.v1.CreateLtiDeepLinkingResponseResponse
+	(*SubmitLtiScoreRequest)(nil),                // 8: lession.v1.SubmitLtiScoreRequest
+	(*SubmitLtiScoreResponse)(nil),               // 9: lession.v1.SubmitLtiScoreResponse
+	(*LtiPlatform)(nil),                          // 10: lession.v1.LtiPlatform
+	(*LtiLaunch)(nil),                            // 11: lession.v1.LtiLaunch
+}
+var file_lession_v1_lti_service_proto_depIdxs = []int32{
+	10, // 0: lession.v1.RegisterLtiPlatformResponse.platform:type_name -> lession.v1.LtiPlatform
+	10, // 1: lession.v1.ListLtiPlatformsResponse.platforms:type_name -> lession.v1.LtiPlatform
+	11, // 2: lession.v1.GetLtiLaunchResponse.launch:type_name -> lession.v1.LtiLaunch
+	11, // 3: lession.v1.SubmitLtiScoreResponse.launch:type_name -> lession.v1.LtiLaunch
+	0,  // 4: lession.v1.LtiService.RegisterLtiPlatform:input_type -> lession.v1.RegisterLtiPlatformRequest
+	2,  // 5: lession.v1.LtiService.ListLtiPlatforms:input_type -> lession.v1.ListLtiPlatformsRequest
+	4,  // 6: lession.v1.LtiService.GetLtiLaunch:input_type -> lession.v1.GetLtiLaunchRequest
+	6,  // 7: lession.v1.LtiService.CreateLtiDeepLinkingResponse:input_type -> lession.v1.CreateLtiDeepLinkingResponseRequest
+	8,  // 8: lession.v1.LtiService.SubmitLtiScore:input_type -> lession.v1.SubmitLtiScoreRequest
+	1,  // 9: lession.v1.LtiService.RegisterLtiPlatform:output_type -> lession.v1.RegisterLtiPlatformResponse
+	3,  // 10: lession.v1.LtiService.ListLtiPlatforms:output_type -> lession.v1.ListLtiPlatformsResponse
+	5,  // 11: lession.v1.LtiService.GetLtiLaunch:output_type -> lession.v1.GetLtiLaunchResponse
+	7,  // 12: lession.v1.LtiService.CreateLtiDeepLinkingResponse:output_type -> lession.v1.CreateLtiDeepLinkingResponseResponse
+	9,  // 13: lession.v1.LtiService.SubmitLtiScore:output_type -> lession.v1.SubmitLtiScoreResponse
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_lti_service_proto_init() }
+func file_lession_v1_lti_service_proto_init() {
+	if File_lession_v1_lti_service_proto != nil {
+		return
+	}
+	file_lession_v1_lti_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_lti_service_proto_rawDesc), len(file_lession_v1_lti_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_lti_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_lti_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_lti_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_lti_service_proto = out.File
+	file_lession_v1_lti_service_proto_goTypes = nil
+	file_lession_v1_lti_service_proto_depIdxs = nil
+}
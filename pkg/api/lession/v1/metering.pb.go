@@ -0,0 +1,421 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/metering.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UsageMetric enumerates the billable quantities tracked per tenant.
+type UsageMetric int32
+
+const (
+	// USAGE_METRIC_UNSPECIFIED is the default zero value.
+	UsageMetric_USAGE_METRIC_UNSPECIFIED UsageMetric = 0
+	// USAGE_METRIC_STORAGE_GB_HOURS measures stored media in gigabyte-hours.
+	UsageMetric_USAGE_METRIC_STORAGE_GB_HOURS UsageMetric = 1
+	// USAGE_METRIC_PROCESSING_MINUTES measures media processing time in minutes.
+	UsageMetric_USAGE_METRIC_PROCESSING_MINUTES UsageMetric = 2
+	// USAGE_METRIC_PLAYBACK_MINUTES measures delivered playback in minutes.
+	UsageMetric_USAGE_METRIC_PLAYBACK_MINUTES UsageMetric = 3
+	// USAGE_METRIC_API_CALLS counts API requests.
+	UsageMetric_USAGE_METRIC_API_CALLS UsageMetric = 4
+	// USAGE_METRIC_GENERATION_TOKENS counts language model tokens spent drafting lessons.
+	UsageMetric_USAGE_METRIC_GENERATION_TOKENS UsageMetric = 5
+)
+
+// Enum value maps for UsageMetric.
+var (
+	UsageMetric_name = map[int32]string{
+		0: "USAGE_METRIC_UNSPECIFIED",
+		1: "USAGE_METRIC_STORAGE_GB_HOURS",
+		2: "USAGE_METRIC_PROCESSING_MINUTES",
+		3: "USAGE_METRIC_PLAYBACK_MINUTES",
+		4: "USAGE_METRIC_API_CALLS",
+		5: "USAGE_METRIC_GENERATION_TOKENS",
+	}
+	UsageMetric_value = map[string]int32{
+		"USAGE_METRIC_UNSPECIFIED":        0,
+		"USAGE_METRIC_STORAGE_GB_HOURS":   1,
+		"USAGE_METRIC_PROCESSING_MINUTES": 2,
+		"USAGE_METRIC_PLAYBACK_MINUTES":   3,
+		"USAGE_METRIC_API_CALLS":          4,
+		"USAGE_METRIC_GENERATION_TOKENS":  5,
+	}
+)
+
+func (x UsageMetric) Enum() *UsageMetric {
+	p := new(UsageMetric)
+	*p = x
+	return p
+}
+
+func (x UsageMetric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UsageMetric) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_metering_proto_enumTypes[0].Descriptor()
+}
+
+func (UsageMetric) Type() protoreflect.EnumType {
+	return &file_lession_v1_metering_proto_enumTypes[0]
+}
+
+func (x UsageMetric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UsageMetric.Descriptor instead.
+func (UsageMetric) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_metering_proto_rawDescGZIP(), []int{0}
+}
+
+// UsageLine aggregates the quantity of one metric.
+type UsageLine struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// metric identifies the metered quantity.
+	Metric UsageMetric `protobuf:"varint,1,opt,name=metric,proto3,enum=lession.v1.UsageMetric" json:"metric,omitempty"`
+	// quantity is the aggregated amount, expressed in the metric's unit.
+	Quantity      float64 `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageLine) Reset() {
+	*x = UsageLine{}
+	mi := &file_lession_v1_metering_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageLine) ProtoMessage() {}
+
+func (x *UsageLine) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_metering_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageLine.ProtoReflect.Descriptor instead.
+func (*UsageLine) Descriptor() ([]byte, []int) {
+	return file_lession_v1_metering_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UsageLine) GetMetric() UsageMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return UsageMetric_USAGE_METRIC_UNSPECIFIED
+}
+
+func (x *UsageLine) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// UsageSnapshot is an immutable monthly usage aggregate for a tenant.
+type UsageSnapshot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the server-assigned identifier for the snapshot.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// tenant_id identifies the tenant the usage belongs to.
+	TenantId string `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// period_start is the inclusive start of the billed month in UTC.
+	PeriodStart *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// period_end is the exclusive end of the billed month in UTC.
+	PeriodEnd *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	// lines lists the aggregated quantity per metric.
+	Lines []*UsageLine `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"`
+	// created_at records when the snapshot was taken.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageSnapshot) Reset() {
+	*x = UsageSnapshot{}
+	mi := &file_lession_v1_metering_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageSnapshot) ProtoMessage() {}
+
+func (x *UsageSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_metering_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageSnapshot.ProtoReflect.Descriptor instead.
+func (*UsageSnapshot) Descriptor() ([]byte, []int) {
+	return file_lession_v1_metering_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UsageSnapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UsageSnapshot) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UsageSnapshot) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *UsageSnapshot) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *UsageSnapshot) GetLines() []*UsageLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *UsageSnapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// InvoiceLineItem describes a billable quantity derived from a usage snapshot.
+type InvoiceLineItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// metric identifies the metered quantity.
+	Metric UsageMetric `protobuf:"varint,1,opt,name=metric,proto3,enum=lession.v1.UsageMetric" json:"metric,omitempty"`
+	// description is a human-readable label for the invoice line.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// quantity is the billable amount, expressed in unit.
+	Quantity float64 `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// unit names the unit of measure, e.g. "GB-hour" or "minute".
+	Unit string `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	// period_start is the inclusive start of the billed period.
+	PeriodStart *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// period_end is the exclusive end of the billed period.
+	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvoiceLineItem) Reset() {
+	*x = InvoiceLineItem{}
+	mi := &file_lession_v1_metering_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceLineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceLineItem) ProtoMessage() {}
+
+func (x *InvoiceLineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_metering_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceLineItem.ProtoReflect.Descriptor instead.
+func (*InvoiceLineItem) Descriptor() ([]byte, []int) {
+	return file_lession_v1_metering_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InvoiceLineItem) GetMetric() UsageMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return UsageMetric_USAGE_METRIC_UNSPECIFIED
+}
+
+func (x *InvoiceLineItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InvoiceLineItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InvoiceLineItem) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *InvoiceLineItem) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *InvoiceLineItem) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+var File_lession_v1_metering_proto protoreflect.FileDescriptor
+
+const file_lession_v1_metering_proto_rawDesc = "" +
+	"\n" +
+	"\x19lession/v1/metering.proto\x12\n" +
+	"lession.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"X\n" +
+	"\tUsageLine\x12/\n" +
+	"\x06metric\x18\x01 \x01(\x0e2\x17.lession.v1.UsageMetricR\x06metric\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\"\x9e\x02\n" +
+	"\rUsageSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12=\n" +
+	"\fperiod_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12+\n" +
+	"\x05lines\x18\x05 \x03(\v2\x15.lession.v1.UsageLineR\x05lines\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8e\x02\n" +
+	"\x0fInvoiceLineItem\x12/\n" +
+	"\x06metric\x18\x01 \x01(\x0e2\x17.lession.v1.UsageMetricR\x06metric\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12=\n" +
+	"\fperiod_start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd*\xd6\x01\n" +
+	"\vUsageMetric\x12\x1c\n" +
+	"\x18USAGE_METRIC_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dUSAGE_METRIC_STORAGE_GB_HOURS\x10\x01\x12#\n" +
+	"\x1fUSAGE_METRIC_PROCESSING_MINUTES\x10\x02\x12!\n" +
+	"\x1dUSAGE_METRIC_PLAYBACK_MINUTES\x10\x03\x12\x1a\n" +
+	"\x16USAGE_METRIC_API_CALLS\x10\x04\x12\"\n" +
+	"\x1eUSAGE_METRIC_GENERATION_TOKENS\x10\x05B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_metering_proto_rawDescOnce sync.Once
+	file_lession_v1_metering_proto_rawDescData []byte
+)
+
+func file_lession_v1_metering_proto_rawDescGZIP() []byte {
+	file_lession_v1_metering_proto_rawDescOnce.Do(func() {
+		file_lession_v1_metering_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_metering_proto_rawDesc), len(file_lession_v1_metering_proto_rawDesc)))
+	})
+	return file_lession_v1_metering_proto_rawDescData
+}
+
+var file_lession_v1_metering_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lession_v1_metering_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_lession_v1_metering_proto_goTypes = []any{
+	(UsageMetric)(0),              // 0: lession.v1.UsageMetric
+	(*UsageLine)(nil),             // 1: lession.v1.UsageLine
+	(*UsageSnapshot)(nil),         // 2: lession.v1.UsageSnapshot
+	(*InvoiceLineItem)(nil),       // 3: lession.v1.InvoiceLineItem
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_lession_v1_metering_proto_depIdxs = []int32{
+	0, // 0: lession.v1.UsageLine.metric:type_name -> lession.v1.UsageMetric
+	4, // 1: lession.v1.UsageSnapshot.period_start:type_name -> google.protobuf.Timestamp
+	4, // 2: lession.v1.UsageSnapshot.period_end:type_name -> google.protobuf.Timestamp
+	1, // 3: lession.v1.UsageSnapshot.lines:type_name -> lession.v1.UsageLine
+	4, // 4: lession.v1.UsageSnapshot.created_at:type_name -> google.protobuf.Timestamp
+	0, // 5: lession.v1.InvoiceLineItem.metric:type_name -> lession.v1.UsageMetric
+	4, // 6: lession.v1.InvoiceLineItem.period_start:type_name -> google.protobuf.Timestamp
+	4, // 7: lession.v1.InvoiceLineItem.period_end:type_name -> google.protobuf.Timestamp
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_metering_proto_init() }
+func file_lession_v1_metering_proto_init() {
+	if File_lession_v1_metering_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_metering_proto_rawDesc), len(file_lession_v1_metering_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_metering_proto_goTypes,
+		DependencyIndexes: file_lession_v1_metering_proto_depIdxs,
+		EnumInfos:         file_lession_v1_metering_proto_enumTypes,
+		MessageInfos:      file_lession_v1_metering_proto_msgTypes,
+	}.Build()
+	File_lession_v1_metering_proto = out.File
+	file_lession_v1_metering_proto_goTypes = nil
+	file_lession_v1_metering_proto_depIdxs = nil
+}
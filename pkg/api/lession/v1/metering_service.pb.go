@@ -0,0 +1,559 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/metering_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RecordUsageRequest reports a metered quantity.
+type RecordUsageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id identifies the tenant consuming the resource.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// metric identifies the metered quantity.
+	Metric UsageMetric `protobuf:"varint,2,opt,name=metric,proto3,enum=lession.v1.UsageMetric" json:"metric,omitempty"`
+	// quantity is the amount consumed, expressed in the metric's unit.
+	Quantity float64 `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// occurred_at records when the usage happened; defaults to now.
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordUsageRequest) Reset() {
+	*x = RecordUsageRequest{}
+	mi := &file_lession_v1_metering_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordUsageRequest) ProtoMessage() {}
+
+func (x *RecordUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_metering_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordUsageRequest.ProtoReflect.Descriptor instead.
+func (*RecordUsageRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_metering_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecordUsageRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *RecordUsageRequest) GetMetric() UsageMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return UsageMetric_USAGE_METRIC_UNSPECIFIED
+}
+
+func (x *RecordUsageRequest) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RecordUsageRequest) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// RecordUsageResponse acknowledges a usage report.
+type RecordUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordUsageResponse) Reset() {
+	*x = RecordUsageResponse{}
+	mi := &file_lession_v1_metering_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordUsageResponse) ProtoMessage() {}
+
+func (x *RecordUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_metering_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordUsageResponse.ProtoReflect.Descriptor instead.
+func (*RecordUsageResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_metering_service_proto_rawDescGZIP(), []int{1}
+}
+
+// CreateUsageSnapshotRequest selects the tenant and month to snapshot.
+type CreateUsageSnapshotRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant_id identifies the tenant to snapshot.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// month is any instant within the month to snapshot; defaults to the previous month.
+	Month         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUsageSnapshotRequest) Reset() {
+	*x = CreateUsageSnapshotRequest{}
+	mi := &file_lession_v1_metering_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUsageSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUsageSnapshotRequest) ProtoMessage() {}
+
+func (x *CreateUsageSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_metering_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUsageSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CreateUsageSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_metering_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateUsageSnapshotRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateUsageSnapshotRequest) GetMonth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Month
+	}
+	return nil
+}
+
+// CreateUsageSnapshotResponse returns the stored snapshot.
+type CreateUsageSnapshotResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// snapshot contains the aggregated usage for the month.
+	Snapshot      *UsageSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUsageSnapshotResponse) Reset() {
+	*x = CreateUsageSnapshotResponse{}
+	mi := &file_lession_v1_metering_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUsageSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUsageSnapshotResponse) ProtoMessage() {}
+
+func (x *CreateUsageSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_metering_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUsageSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*CreateUsageSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_metering_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateUsageSnapshotResponse) GetSnapshot() *UsageSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+// ListUsageSnapshotsRequest filters stored snapshots.
+type ListUsageSnapshotsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_size limits the number of returned snapshots.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token continues a prior ListUsageSnapshots response.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// tenant_id restricts snapshots to a single tenant.
+	TenantId      string `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsageSnapshotsRequest) Reset() {
+	*x = ListUsageSnapshotsRequest{}
+	mi := &file_lession_v1_metering_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsageSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsageSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListUsageSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_metering_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsageSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListUsageSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_metering_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListUsageSnapshotsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUsageSnapshotsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUsageSnapshotsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// ListUsageSnapshotsResponse returns a page of snapshots.
+type ListUsageSnapshotsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// snapshots contains the stored snapshots, newest period first.
+	Snapshots []*UsageSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	// next_page_token is supplied when more data is available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsageSnapshotsResponse) Reset() {
+	*x = ListUsageSnapshotsResponse{}
+	mi := &file_lession_v1_metering_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsageSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsageSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListUsageSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_metering_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsageSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListUsageSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_metering_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListUsageSnapshotsResponse) GetSnapshots() []*UsageSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+func (x *ListUsageSnapshotsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ExportInvoiceLineItemsRequest selects the snapshot to export.
+type ExportInvoiceLineItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// snapshot_id identifies the usage snapshot.
+	SnapshotId    string `protobuf:"bytes,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportInvoiceLineItemsRequest) Reset() {
+	*x = ExportInvoiceLineItemsRequest{}
+	mi := &file_lession_v1_metering_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportInvoiceLineItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportInvoiceLineItemsRequest) ProtoMessage() {}
+
+func (x *ExportInvoiceLineItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_metering_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportInvoiceLineItemsRequest.ProtoReflect.Descriptor instead.
+func (*ExportInvoiceLineItemsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_metering_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExportInvoiceLineItemsRequest) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+// ExportInvoiceLineItemsResponse returns invoice line items for the snapshot.
+type ExportInvoiceLineItemsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// line_items contains one entry per metric with non-zero usage.
+	LineItems     []*InvoiceLineItem `protobuf:"bytes,1,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportInvoiceLineItemsResponse) Reset() {
+	*x = ExportInvoiceLineItemsResponse{}
+	mi := &file_lession_v1_metering_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportInvoiceLineItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportInvoiceLineItemsResponse) ProtoMessage() {}
+
+func (x *ExportInvoiceLineItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_metering_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportInvoiceLineItemsResponse.ProtoReflect.Descriptor instead.
+func (*ExportInvoiceLineItemsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_metering_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExportInvoiceLineItemsResponse) GetLineItems() []*InvoiceLineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+var File_lession_v1_metering_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_metering_service_proto_rawDesc = "" +
+	"\n" +
+	"!lession/v1/metering_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19lession/v1/metering.proto\"\xe0\x01\n" +
+	"\x12RecordUsageRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\btenantId\x12;\n" +
+	"\x06metric\x18\x02 \x01(\x0e2\x17.lession.v1.UsageMetricB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06metric\x12*\n" +
+	"\bquantity\x18\x03 \x01(\x01B\x0e\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\bquantity\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\x15\n" +
+	"\x13RecordUsageResponse\"t\n" +
+	"\x1aCreateUsageSnapshotRequest\x12$\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\btenantId\x120\n" +
+	"\x05month\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05month\"T\n" +
+	"\x1bCreateUsageSnapshotResponse\x125\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x19.lession.v1.UsageSnapshotR\bsnapshot\"}\n" +
+	"\x19ListUsageSnapshotsRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\rB\a\xbaH\x04*\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\"}\n" +
+	"\x1aListUsageSnapshotsResponse\x127\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x19.lession.v1.UsageSnapshotR\tsnapshots\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"J\n" +
+	"\x1dExportInvoiceLineItemsRequest\x12)\n" +
+	"\vsnapshot_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"snapshotId\"\\\n" +
+	"\x1eExportInvoiceLineItemsResponse\x12:\n" +
+	"\n" +
+	"line_items\x18\x01 \x03(\v2\x1b.lession.v1.InvoiceLineItemR\tlineItems2\x9f\x03\n" +
+	"\x0fMeteringService\x12N\n" +
+	"\vRecordUsage\x12\x1e.lession.v1.RecordUsageRequest\x1a\x1f.lession.v1.RecordUsageResponse\x12f\n" +
+	"\x13CreateUsageSnapshot\x12&.lession.v1.CreateUsageSnapshotRequest\x1a'.lession.v1.CreateUsageSnapshotResponse\x12c\n" +
+	"\x12ListUsageSnapshots\x12%.lession.v1.ListUsageSnapshotsRequest\x1a&.lession.v1.ListUsageSnapshotsResponse\x12o\n" +
+	"\x16ExportInvoiceLineItems\x12).lession.v1.ExportInvoiceLineItemsRequest\x1a*.lession.v1.ExportInvoiceLineItemsResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_metering_service_proto_rawDescOnce sync.Once
+	file_lession_v1_metering_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_metering_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_metering_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_metering_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_metering_service_proto_rawDesc), len(file_lession_v1_metering_service_proto_rawDesc)))
+	})
+	return file_lession_v1_metering_service_proto_rawDescData
+}
+
+var file_lession_v1_metering_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_lession_v1_metering_service_proto_goTypes = []any{
+	(*RecordUsageRequest)(nil),             // 0: lession.v1.RecordUsageRequest
+	(*RecordUsageResponse)(nil),            // 1: lession.v1.RecordUsageResponse
+	(*CreateUsageSnapshotRequest)(nil),     // 2: lession.v1.CreateUsageSnapshotRequest
+	(*CreateUsageSnapshotResponse)(nil),    // 3: lession.v1.CreateUsageSnapshotResponse
+	(*ListUsageSnapshotsRequest)(nil),      // 4: lession.v1.ListUsageSnapshotsRequest
+	(*ListUsageSnapshotsResponse)(nil),     // 5: lession.v1.ListUsageSnapshotsResponse
+	(*ExportInvoiceLineItemsRequest)(nil),  // 6: lession.v1.ExportInvoiceLineItemsRequest
+	(*ExportInvoiceLineItemsResponse)(nil), // 7: lession.v1.ExportInvoiceLineItemsResponse
+	(UsageMetric)(0),                       // 8: lession.v1.UsageMetric
+	(*timestamppb.Timestamp)(nil),          // 9: google.protobuf.Timestamp
+	(*UsageSnapshot)(nil),                  // 10: lession.v1.UsageSnapshot
+	(*InvoiceLineItem)(nil),                // 11: lession.v1.InvoiceLineItem
+}
+var file_lession_v1_metering_service_proto_depIdxs = []int32{
+	8,  // 0: lession.v1.RecordUsageRequest.metric:type_name -> lession.v1.UsageMetric
+	9,  // 1: lession.v1.RecordUsageRequest.occurred_at:type_name -> google.protobuf.Timestamp
+	9,  // 2: lession.v1.CreateUsageSnapshotRequest.month:type_name -> google.protobuf.Timestamp
+	10, // 3: lession.v1.CreateUsageSnapshotResponse.snapshot:type_name -> lession.v1.UsageSnapshot
+	10, // 4: lession.v1.ListUsageSnapshotsResponse.snapshots:type_name -> lession.v1.UsageSnapshot
+	11, // 5: lession.v1.ExportInvoiceLineItemsResponse.line_items:type_name -> lession.v1.InvoiceLineItem
+	0,  // 6: lession.v1.MeteringService.RecordUsage:input_type -> lession.v1.RecordUsageRequest
+	2,  // 7: lession.v1.MeteringService.CreateUsageSnapshot:input_type -> lession.v1.CreateUsageSnapshotRequest
+	4,  // 8: lession.v1.MeteringService.ListUsageSnapshots:input_type -> lession.v1.ListUsageSnapshotsRequest
+	6,  // 9: lession.v1.MeteringService.ExportInvoiceLineItems:input_type -> lession.v1.ExportInvoiceLineItemsRequest
+	1,  // 10: lession.v1.MeteringService.RecordUsage:output_type -> lession.v1.RecordUsageResponse
+	3,  // 11: lession.v1.MeteringService.CreateUsageSnapshot:output_type -> lession.v1.CreateUsageSnapshotResponse
+	5,  // 12: lession.v1.MeteringService.ListUsageSnapshots:output_type -> lession.v1.ListUsageSnapshotsResponse
+	7,  // 13: lession.v1.MeteringService.ExportInvoiceLineItems:output_type -> lession.v1.ExportInvoiceLineItemsResponse
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_metering_service_proto_init() }
+func file_lession_v1_metering_service_proto_init() {
+	if File_lession_v1_metering_service_proto != nil {
+		return
+	}
+	file_lession_v1_metering_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_metering_service_proto_rawDesc), len(file_lession_v1_metering_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_metering_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_metering_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_metering_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_metering_service_proto = out.File
+	file_lession_v1_metering_service_proto_goTypes = nil
+	file_lession_v1_metering_service_proto_depIdxs = nil
+}
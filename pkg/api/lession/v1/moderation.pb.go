@@ -0,0 +1,328 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/moderation.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ModerationStatus reports where user-generated text stands in moderation.
+type ModerationStatus int32
+
+const (
+	// MODERATION_STATUS_UNSPECIFIED is reported for text never submitted for moderation.
+	ModerationStatus_MODERATION_STATUS_UNSPECIFIED ModerationStatus = 0
+	// MODERATION_STATUS_PENDING marks text waiting for a moderator; it is hidden from other users.
+	ModerationStatus_MODERATION_STATUS_PENDING ModerationStatus = 1
+	// MODERATION_STATUS_APPROVED marks text cleared for everyone to see.
+	ModerationStatus_MODERATION_STATUS_APPROVED ModerationStatus = 2
+	// MODERATION_STATUS_REJECTED marks text a moderator refused; it stays hidden until edited.
+	ModerationStatus_MODERATION_STATUS_REJECTED ModerationStatus = 3
+	// MODERATION_STATUS_FLAGGED marks text escalated for a second look; it stays hidden.
+	ModerationStatus_MODERATION_STATUS_FLAGGED ModerationStatus = 4
+)
+
+// Enum value maps for ModerationStatus.
+var (
+	ModerationStatus_name = map[int32]string{
+		0: "MODERATION_STATUS_UNSPECIFIED",
+		1: "MODERATION_STATUS_PENDING",
+		2: "MODERATION_STATUS_APPROVED",
+		3: "MODERATION_STATUS_REJECTED",
+		4: "MODERATION_STATUS_FLAGGED",
+	}
+	ModerationStatus_value = map[string]int32{
+		"MODERATION_STATUS_UNSPECIFIED": 0,
+		"MODERATION_STATUS_PENDING":     1,
+		"MODERATION_STATUS_APPROVED":    2,
+		"MODERATION_STATUS_REJECTED":    3,
+		"MODERATION_STATUS_FLAGGED":     4,
+	}
+)
+
+func (x ModerationStatus) Enum() *ModerationStatus {
+	p := new(ModerationStatus)
+	*p = x
+	return p
+}
+
+func (x ModerationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_moderation_proto_enumTypes[0].Descriptor()
+}
+
+func (ModerationStatus) Type() protoreflect.EnumType {
+	return &file_lession_v1_moderation_proto_enumTypes[0]
+}
+
+func (x ModerationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationStatus.Descriptor instead.
+func (ModerationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_moderation_proto_rawDescGZIP(), []int{0}
+}
+
+// ModerationItem is a piece of user-generated text in the moderation queue.
+type ModerationItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the item.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// subject_type names the kind of content, such as "playlist".
+	SubjectType string `protobuf:"bytes,2,opt,name=subject_type,json=subjectType,proto3" json:"subject_type,omitempty"`
+	// subject_id identifies the content the text belongs to.
+	SubjectId string `protobuf:"bytes,3,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	// author_id identifies the user who wrote the text.
+	AuthorId string `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// text is the submitted text.
+	Text string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	// status is the moderation state of the text.
+	Status ModerationStatus `protobuf:"varint,6,opt,name=status,proto3,enum=lession.v1.ModerationStatus" json:"status,omitempty"`
+	// labels name the categories the automatic filters matched, such as "profanity" or "toxicity".
+	Labels []string `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty"`
+	// score is the highest confidence any automatic filter reported, from 0 to 1.
+	Score float64 `protobuf:"fixed64,8,opt,name=score,proto3" json:"score,omitempty"`
+	// reviewer_id identifies the moderator who last reviewed the item.
+	ReviewerId string `protobuf:"bytes,9,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// review_note is the moderator's note on the decision.
+	ReviewNote string `protobuf:"bytes,10,opt,name=review_note,json=reviewNote,proto3" json:"review_note,omitempty"`
+	// reviewed_at is when the item was last reviewed.
+	ReviewedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	// created_at is when the subject was first submitted.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is when the text or its status last changed.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerationItem) Reset() {
+	*x = ModerationItem{}
+	mi := &file_lession_v1_moderation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationItem) ProtoMessage() {}
+
+func (x *ModerationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_moderation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationItem.ProtoReflect.Descriptor instead.
+func (*ModerationItem) Descriptor() ([]byte, []int) {
+	return file_lession_v1_moderation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ModerationItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerationItem) GetSubjectType() string {
+	if x != nil {
+		return x.SubjectType
+	}
+	return ""
+}
+
+func (x *ModerationItem) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *ModerationItem) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ModerationItem) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ModerationItem) GetStatus() ModerationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ModerationStatus_MODERATION_STATUS_UNSPECIFIED
+}
+
+func (x *ModerationItem) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ModerationItem) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ModerationItem) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ModerationItem) GetReviewNote() string {
+	if x != nil {
+		return x.ReviewNote
+	}
+	return ""
+}
+
+func (x *ModerationItem) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *ModerationItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ModerationItem) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_lession_v1_moderation_proto protoreflect.FileDescriptor
+
+const file_lession_v1_moderation_proto_rawDesc = "" +
+	"\n" +
+	"\x1blession/v1/moderation.proto\x12\n" +
+	"lession.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xec\x03\n" +
+	"\x0eModerationItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fsubject_type\x18\x02 \x01(\tR\vsubjectType\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x03 \x01(\tR\tsubjectId\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\x124\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1c.lession.v1.ModerationStatusR\x06status\x12\x16\n" +
+	"\x06labels\x18\a \x03(\tR\x06labels\x12\x14\n" +
+	"\x05score\x18\b \x01(\x01R\x05score\x12\x1f\n" +
+	"\vreviewer_id\x18\t \x01(\tR\n" +
+	"reviewerId\x12\x1f\n" +
+	"\vreview_note\x18\n" +
+	" \x01(\tR\n" +
+	"reviewNote\x12;\n" +
+	"\vreviewed_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt*\xb3\x01\n" +
+	"\x10ModerationStatus\x12!\n" +
+	"\x1dMODERATION_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19MODERATION_STATUS_PENDING\x10\x01\x12\x1e\n" +
+	"\x1aMODERATION_STATUS_APPROVED\x10\x02\x12\x1e\n" +
+	"\x1aMODERATION_STATUS_REJECTED\x10\x03\x12\x1d\n" +
+	"\x19MODERATION_STATUS_FLAGGED\x10\x04B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_moderation_proto_rawDescOnce sync.Once
+	file_lession_v1_moderation_proto_rawDescData []byte
+)
+
+func file_lession_v1_moderation_proto_rawDescGZIP() []byte {
+	file_lession_v1_moderation_proto_rawDescOnce.Do(func() {
+		file_lession_v1_moderation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_moderation_proto_rawDesc), len(file_lession_v1_moderation_proto_rawDesc)))
+	})
+	return file_lession_v1_moderation_proto_rawDescData
+}
+
+var file_lession_v1_moderation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lession_v1_moderation_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_lession_v1_moderation_proto_goTypes = []any{
+	(ModerationStatus)(0),         // 0: lession.v1.ModerationStatus
+	(*ModerationItem)(nil),        // 1: lession.v1.ModerationItem
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_lession_v1_moderation_proto_depIdxs = []int32{
+	0, // 0: lession.v1.ModerationItem.status:type_name -> lession.v1.ModerationStatus
+	2, // 1: lession.v1.ModerationItem.reviewed_at:type_name -> google.protobuf.Timestamp
+	2, // 2: lession.v1.ModerationItem.created_at:type_name -> google.protobuf.Timestamp
+	2, // 3: lession.v1.ModerationItem.updated_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_moderation_proto_init() }
+func file_lession_v1_moderation_proto_init() {
+	if File_lession_v1_moderation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_moderation_proto_rawDesc), len(file_lession_v1_moderation_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_moderation_proto_goTypes,
+		DependencyIndexes: file_lession_v1_moderation_proto_depIdxs,
+		EnumInfos:         file_lession_v1_moderation_proto_enumTypes,
+		MessageInfos:      file_lession_v1_moderation_proto_msgTypes,
+	}.Build()
+	File_lession_v1_moderation_proto = out.File
+	file_lession_v1_moderation_proto_goTypes = nil
+	file_lession_v1_moderation_proto_depIdxs = nil
+}
@@ -0,0 +1,697 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/moderation_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ListModerationItemsRequest carries pagination and filters for the queue.
+type ListModerationItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_size limits the number of returned items.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token continues a previous listing.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// subject_type restricts items to one kind of content.
+	SubjectType string `protobuf:"bytes,3,opt,name=subject_type,json=subjectType,proto3" json:"subject_type,omitempty"`
+	// statuses restricts items to the given states; pending and flagged items are listed when empty.
+	Statuses      []ModerationStatus `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=lession.v1.ModerationStatus" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationItemsRequest) Reset() {
+	*x = ListModerationItemsRequest{}
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationItemsRequest) ProtoMessage() {}
+
+func (x *ListModerationItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListModerationItemsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_moderation_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListModerationItemsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListModerationItemsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListModerationItemsRequest) GetSubjectType() string {
+	if x != nil {
+		return x.SubjectType
+	}
+	return ""
+}
+
+func (x *ListModerationItemsRequest) GetStatuses() []ModerationStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// ListModerationItemsResponse returns a page of the queue.
+type ListModerationItemsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// items contains the matching items, oldest first.
+	Items []*ModerationItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// next_page_token is set when more items are available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModerationItemsResponse) Reset() {
+	*x = ListModerationItemsResponse{}
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModerationItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModerationItemsResponse) ProtoMessage() {}
+
+func (x *ListModerationItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModerationItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListModerationItemsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_moderation_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListModerationItemsResponse) GetItems() []*ModerationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListModerationItemsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetModerationItemRequest identifies the item to fetch.
+type GetModerationItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// item_id references the item.
+	ItemId        string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModerationItemRequest) Reset() {
+	*x = GetModerationItemRequest{}
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModerationItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationItemRequest) ProtoMessage() {}
+
+func (x *GetModerationItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationItemRequest.ProtoReflect.Descriptor instead.
+func (*GetModerationItemRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_moderation_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetModerationItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+// GetModerationItemResponse returns the requested item.
+type GetModerationItemResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// item is the requested item.
+	Item          *ModerationItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModerationItemResponse) Reset() {
+	*x = GetModerationItemResponse{}
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModerationItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationItemResponse) ProtoMessage() {}
+
+func (x *GetModerationItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationItemResponse.ProtoReflect.Descriptor instead.
+func (*GetModerationItemResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_moderation_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetModerationItemResponse) GetItem() *ModerationItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// ApproveModerationItemRequest records an approval.
+type ApproveModerationItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// item_id references the item.
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// reviewer_id identifies the moderator.
+	ReviewerId string `protobuf:"bytes,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// note optionally explains the decision.
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveModerationItemRequest) Reset() {
+	*x = ApproveModerationItemRequest{}
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveModerationItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveModerationItemRequest) ProtoMessage() {}
+
+func (x *ApproveModerationItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveModerationItemRequest.ProtoReflect.Descriptor instead.
+func (*ApproveModerationItemRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_moderation_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ApproveModerationItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ApproveModerationItemRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ApproveModerationItemRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// ApproveModerationItemResponse returns the reviewed item.
+type ApproveModerationItemResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// item is the reviewed item.
+	Item          *ModerationItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveModerationItemResponse) Reset() {
+	*x = ApproveModerationItemResponse{}
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveModerationItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveModerationItemResponse) ProtoMessage() {}
+
+func (x *ApproveModerationItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveModerationItemResponse.ProtoReflect.Descriptor instead.
+func (*ApproveModerationItemResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_moderation_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApproveModerationItemResponse) GetItem() *ModerationItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// RejectModerationItemRequest records a rejection.
+type RejectModerationItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// item_id references the item.
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// reviewer_id identifies the moderator.
+	ReviewerId string `protobuf:"bytes,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// note optionally explains the decision.
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectModerationItemRequest) Reset() {
+	*x = RejectModerationItemRequest{}
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectModerationItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectModerationItemRequest) ProtoMessage() {}
+
+func (x *RejectModerationItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectModerationItemRequest.ProtoReflect.Descriptor instead.
+func (*RejectModerationItemRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_moderation_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RejectModerationItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *RejectModerationItemRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *RejectModerationItemRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// RejectModerationItemResponse returns the reviewed item.
+type RejectModerationItemResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// item is the reviewed item.
+	Item          *ModerationItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectModerationItemResponse) Reset() {
+	*x = RejectModerationItemResponse{}
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectModerationItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectModerationItemResponse) ProtoMessage() {}
+
+func (x *RejectModerationItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectModerationItemResponse.ProtoReflect.Descriptor instead.
+func (*RejectModerationItemResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_moderation_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RejectModerationItemResponse) GetItem() *ModerationItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// FlagModerationItemRequest escalates an item.
+type FlagModerationItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// item_id references the item.
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// reviewer_id identifies the moderator.
+	ReviewerId string `protobuf:"bytes,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// note optionally explains the decision.
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlagModerationItemRequest) Reset() {
+	*x = FlagModerationItemRequest{}
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagModerationItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagModerationItemRequest) ProtoMessage() {}
+
+func (x *FlagModerationItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagModerationItemRequest.ProtoReflect.Descriptor instead.
+func (*FlagModerationItemRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_moderation_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FlagModerationItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *FlagModerationItemRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *FlagModerationItemRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// FlagModerationItemResponse returns the reviewed item.
+type FlagModerationItemResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// item is the reviewed item.
+	Item          *ModerationItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlagModerationItemResponse) Reset() {
+	*x = FlagModerationItemResponse{}
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagModerationItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagModerationItemResponse) ProtoMessage() {}
+
+func (x *FlagModerationItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_moderation_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagModerationItemResponse.ProtoReflect.Descriptor instead.
+func (*FlagModerationItemResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_moderation_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FlagModerationItemResponse) GetItem() *ModerationItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+var File_lession_v1_moderation_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_moderation_service_proto_rawDesc = "" +
+	"\n" +
+	"#lession/v1/moderation_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1blession/v1/moderation.proto\"\xcd\x01\n" +
+	"\x1aListModerationItemsRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\rB\a\xbaH\x04*\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12!\n" +
+	"\fsubject_type\x18\x03 \x01(\tR\vsubjectType\x12G\n" +
+	"\bstatuses\x18\x04 \x03(\x0e2\x1c.lession.v1.ModerationStatusB\r\xbaH\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\bstatuses\"w\n" +
+	"\x1bListModerationItemsResponse\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.lession.v1.ModerationItemR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"=\n" +
+	"\x18GetModerationItemRequest\x12!\n" +
+	"\aitem_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06itemId\"K\n" +
+	"\x19GetModerationItemResponse\x12.\n" +
+	"\x04item\x18\x01 \x01(\v2\x1a.lession.v1.ModerationItemR\x04item\"\x89\x01\n" +
+	"\x1cApproveModerationItemRequest\x12!\n" +
+	"\aitem_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06itemId\x12(\n" +
+	"\vreviewer_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"reviewerId\x12\x1c\n" +
+	"\x04note\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\x04note\"O\n" +
+	"\x1dApproveModerationItemResponse\x12.\n" +
+	"\x04item\x18\x01 \x01(\v2\x1a.lession.v1.ModerationItemR\x04item\"\x88\x01\n" +
+	"\x1bRejectModerationItemRequest\x12!\n" +
+	"\aitem_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06itemId\x12(\n" +
+	"\vreviewer_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"reviewerId\x12\x1c\n" +
+	"\x04note\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\x04note\"N\n" +
+	"\x1cRejectModerationItemResponse\x12.\n" +
+	"\x04item\x18\x01 \x01(\v2\x1a.lession.v1.ModerationItemR\x04item\"\x86\x01\n" +
+	"\x19FlagModerationItemRequest\x12!\n" +
+	"\aitem_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06itemId\x12(\n" +
+	"\vreviewer_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"reviewerId\x12\x1c\n" +
+	"\x04note\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\x04note\"L\n" +
+	"\x1aFlagModerationItemResponse\x12.\n" +
+	"\x04item\x18\x01 \x01(\v2\x1a.lession.v1.ModerationItemR\x04item2\x9b\x04\n" +
+	"\x11ModerationService\x12f\n" +
+	"\x13ListModerationItems\x12&.lession.v1.ListModerationItemsRequest\x1a'.lession.v1.ListModerationItemsResponse\x12`\n" +
+	"\x11GetModerationItem\x12$.lession.v1.GetModerationItemRequest\x1a%.lession.v1.GetModerationItemResponse\x12l\n" +
+	"\x15ApproveModerationItem\x12(.lession.v1.ApproveModerationItemRequest\x1a).lession.v1.ApproveModerationItemResponse\x12i\n" +
+	"\x14RejectModerationItem\x12'.lession.v1.RejectModerationItemRequest\x1a(.lession.v1.RejectModerationItemResponse\x12c\n" +
+	"\x12FlagModerationItem\x12%.lession.v1.FlagModerationItemRequest\x1a&.lession.v1.FlagModerationItemResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_moderation_service_proto_rawDescOnce sync.Once
+	file_lession_v1_moderation_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_moderation_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_moderation_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_moderation_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_moderation_service_proto_rawDesc), len(file_lession_v1_moderation_service_proto_rawDesc)))
+	})
+	return file_lession_v1_moderation_service_proto_rawDescData
+}
+
+var file_lession_v1_moderation_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_lession_v1_moderation_service_proto_goTypes = []any{
+	(*ListModerationItemsRequest)(nil),    // 0: lession.v1.ListModerationItemsRequest
+	(*ListModerationItemsResponse)(nil),   // 1: lession.v1.ListModerationItemsResponse
+	(*GetModerationItemRequest)(nil),      // 2: lession.v1.GetModerationItemRequest
+	(*GetModerationItemResponse)(nil),     // 3: lession.v1.GetModerationItemResponse
+	(*ApproveModerationItemRequest)(nil),  // 4: lession.v1.ApproveModerationItemRequest
+	(*ApproveModerationItemResponse)(nil), // 5: lession.v1.ApproveModerationItemResponse
+	(*RejectModerationItemRequest)(nil),   // 6: lession.v1.RejectModerationItemRequest
+	(*RejectModerationItemResponse)(nil),  // 7: lession.v1.RejectModerationItemResponse
+	(*FlagModerationItemRequest)(nil),     // 8: lession.v1.FlagModerationItemRequest
+	(*FlagModerationItemResponse)(nil),    // 9: lession.v1.FlagModerationItemResponse
+	(ModerationStatus)(0),                 // 10: lession.v1.ModerationStatus
+	(*ModerationItem)(nil),                // 11: lession.v1.ModerationItem
+}
+var file_lession_v1_moderation_service_proto_depIdxs = []int32{
+	10, // 0: lession.v1.ListModerationItemsRequest.statuses:type_name -> lession.v1.ModerationStatus
+	11, // 1: lession.v1.ListModerationItemsResponse.items:type_name -> lession.v1.ModerationItem
+	11, // 2: lession.v1.GetModerationItemResponse.item:type_name -> lession.v1.ModerationItem
+	11, // 3: lession.v1.ApproveModerationItemResponse.item:type_name -> lession.v1.ModerationItem
+	11, // 4: lession.v1.RejectModerationItemResponse.item:type_name -> lession.v1.ModerationItem
+	11, // 5: lession.v1.FlagModerationItemResponse.item:type_name -> lession.v1.ModerationItem
+	0,  // 6: lession.v1.ModerationService.ListModerationItems:input_type -> lession.v1.ListModerationItemsRequest
+	2,  // 7: lession.v1.ModerationService.GetModerationItem:input_type -> lession.v1.GetModerationItemRequest
+	4,  // 8: lession.v1.ModerationService.ApproveModerationItem:input_type -> lession.v1.ApproveModerationItemRequest
+	6,  // 9: lession.v1.ModerationService.RejectModerationItem:input_type -> lession.v1.RejectModerationItemRequest
+	8,  // 10: lession.v1.ModerationService.FlagModerationItem:input_type -> lession.v1.FlagModerationItemRequest
+	1,  // 11: lession.v1.ModerationService.ListModerationItems:output_type -> lession.v1.ListModerationItemsResponse
+	3,  // 12: lession.v1.ModerationService.GetModerationItem:output_type -> lession.v1.GetModerationItemResponse
+	5,  // 13: lession.v1.ModerationService.ApproveModerationItem:output_type -> lession.v1.ApproveModerationItemResponse
+	7,  // 14: lession.v1.ModerationService.RejectModerationItem:output_type -> lession.v1.RejectModerationItemResponse
+	9,  // 15: lession.v1.ModerationService.FlagModerationItem:output_type -> lession.v1.FlagModerationItemResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_moderation_service_proto_init() }
+func file_lession_v1_moderation_service_proto_init() {
+	if File_lession_v1_moderation_service_proto != nil {
+		return
+	}
+	file_lession_v1_moderation_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_moderation_service_proto_rawDesc), len(file_lession_v1_moderation_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_moderation_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_moderation_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_moderation_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_moderation_service_proto = out.File
+	file_lession_v1_moderation_service_proto_goTypes = nil
+	file_lession_v1_moderation_service_proto_depIdxs = nil
+}
@@ -0,0 +1,723 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/notification.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NotificationKind enumerates the events users can be notified about.
+type NotificationKind int32
+
+const (
+	// NOTIFICATION_KIND_UNSPECIFIED is the default zero value.
+	NotificationKind_NOTIFICATION_KIND_UNSPECIFIED NotificationKind = 0
+	// NOTIFICATION_KIND_EPISODE_PUBLISHED announces a new episode in an assigned series.
+	NotificationKind_NOTIFICATION_KIND_EPISODE_PUBLISHED NotificationKind = 1
+	// NOTIFICATION_KIND_ASSIGNMENT_DUE reminds learners of assignments due within a day.
+	NotificationKind_NOTIFICATION_KIND_ASSIGNMENT_DUE NotificationKind = 2
+	// NOTIFICATION_KIND_STREAK_REMINDER nudges learners before their practice streak lapses.
+	NotificationKind_NOTIFICATION_KIND_STREAK_REMINDER NotificationKind = 3
+)
+
+// Enum value maps for NotificationKind.
+var (
+	NotificationKind_name = map[int32]string{
+		0: "NOTIFICATION_KIND_UNSPECIFIED",
+		1: "NOTIFICATION_KIND_EPISODE_PUBLISHED",
+		2: "NOTIFICATION_KIND_ASSIGNMENT_DUE",
+		3: "NOTIFICATION_KIND_STREAK_REMINDER",
+	}
+	NotificationKind_value = map[string]int32{
+		"NOTIFICATION_KIND_UNSPECIFIED":       0,
+		"NOTIFICATION_KIND_EPISODE_PUBLISHED": 1,
+		"NOTIFICATION_KIND_ASSIGNMENT_DUE":    2,
+		"NOTIFICATION_KIND_STREAK_REMINDER":   3,
+	}
+)
+
+func (x NotificationKind) Enum() *NotificationKind {
+	p := new(NotificationKind)
+	*p = x
+	return p
+}
+
+func (x NotificationKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_notification_proto_enumTypes[0].Descriptor()
+}
+
+func (NotificationKind) Type() protoreflect.EnumType {
+	return &file_lession_v1_notification_proto_enumTypes[0]
+}
+
+func (x NotificationKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationKind.Descriptor instead.
+func (NotificationKind) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_notification_proto_rawDescGZIP(), []int{0}
+}
+
+// NotificationChannel enumerates delivery channels.
+type NotificationChannel int32
+
+const (
+	// NOTIFICATION_CHANNEL_UNSPECIFIED is the default zero value.
+	NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED NotificationChannel = 0
+	// NOTIFICATION_CHANNEL_EMAIL delivers notifications by email.
+	NotificationChannel_NOTIFICATION_CHANNEL_EMAIL NotificationChannel = 1
+	// NOTIFICATION_CHANNEL_PUSH delivers notifications to registered mobile and web devices.
+	NotificationChannel_NOTIFICATION_CHANNEL_PUSH NotificationChannel = 2
+)
+
+// Enum value maps for NotificationChannel.
+var (
+	NotificationChannel_name = map[int32]string{
+		0: "NOTIFICATION_CHANNEL_UNSPECIFIED",
+		1: "NOTIFICATION_CHANNEL_EMAIL",
+		2: "NOTIFICATION_CHANNEL_PUSH",
+	}
+	NotificationChannel_value = map[string]int32{
+		"NOTIFICATION_CHANNEL_UNSPECIFIED": 0,
+		"NOTIFICATION_CHANNEL_EMAIL":       1,
+		"NOTIFICATION_CHANNEL_PUSH":        2,
+	}
+)
+
+func (x NotificationChannel) Enum() *NotificationChannel {
+	p := new(NotificationChannel)
+	*p = x
+	return p
+}
+
+func (x NotificationChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_notification_proto_enumTypes[1].Descriptor()
+}
+
+func (NotificationChannel) Type() protoreflect.EnumType {
+	return &file_lession_v1_notification_proto_enumTypes[1]
+}
+
+func (x NotificationChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationChannel.Descriptor instead.
+func (NotificationChannel) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_notification_proto_rawDescGZIP(), []int{1}
+}
+
+// NotificationStatus enumerates delivery states.
+type NotificationStatus int32
+
+const (
+	// NOTIFICATION_STATUS_UNSPECIFIED is the default zero value.
+	NotificationStatus_NOTIFICATION_STATUS_UNSPECIFIED NotificationStatus = 0
+	// NOTIFICATION_STATUS_PENDING indicates delivery has not completed yet.
+	NotificationStatus_NOTIFICATION_STATUS_PENDING NotificationStatus = 1
+	// NOTIFICATION_STATUS_SENT indicates the channel accepted the notification.
+	NotificationStatus_NOTIFICATION_STATUS_SENT NotificationStatus = 2
+	// NOTIFICATION_STATUS_FAILED indicates the channel rejected the notification.
+	NotificationStatus_NOTIFICATION_STATUS_FAILED NotificationStatus = 3
+)
+
+// Enum value maps for NotificationStatus.
+var (
+	NotificationStatus_name = map[int32]string{
+		0: "NOTIFICATION_STATUS_UNSPECIFIED",
+		1: "NOTIFICATION_STATUS_PENDING",
+		2: "NOTIFICATION_STATUS_SENT",
+		3: "NOTIFICATION_STATUS_FAILED",
+	}
+	NotificationStatus_value = map[string]int32{
+		"NOTIFICATION_STATUS_UNSPECIFIED": 0,
+		"NOTIFICATION_STATUS_PENDING":     1,
+		"NOTIFICATION_STATUS_SENT":        2,
+		"NOTIFICATION_STATUS_FAILED":      3,
+	}
+)
+
+func (x NotificationStatus) Enum() *NotificationStatus {
+	p := new(NotificationStatus)
+	*p = x
+	return p
+}
+
+func (x NotificationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_notification_proto_enumTypes[2].Descriptor()
+}
+
+func (NotificationStatus) Type() protoreflect.EnumType {
+	return &file_lession_v1_notification_proto_enumTypes[2]
+}
+
+func (x NotificationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationStatus.Descriptor instead.
+func (NotificationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_notification_proto_rawDescGZIP(), []int{2}
+}
+
+// DevicePlatform enumerates the devices push notifications are delivered to.
+type DevicePlatform int32
+
+const (
+	// DEVICE_PLATFORM_UNSPECIFIED is the default zero value.
+	DevicePlatform_DEVICE_PLATFORM_UNSPECIFIED DevicePlatform = 0
+	// DEVICE_PLATFORM_ANDROID identifies Android apps.
+	DevicePlatform_DEVICE_PLATFORM_ANDROID DevicePlatform = 1
+	// DEVICE_PLATFORM_IOS identifies iOS apps.
+	DevicePlatform_DEVICE_PLATFORM_IOS DevicePlatform = 2
+	// DEVICE_PLATFORM_WEB identifies browsers subscribed through Web Push.
+	DevicePlatform_DEVICE_PLATFORM_WEB DevicePlatform = 3
+)
+
+// Enum value maps for DevicePlatform.
+var (
+	DevicePlatform_name = map[int32]string{
+		0: "DEVICE_PLATFORM_UNSPECIFIED",
+		1: "DEVICE_PLATFORM_ANDROID",
+		2: "DEVICE_PLATFORM_IOS",
+		3: "DEVICE_PLATFORM_WEB",
+	}
+	DevicePlatform_value = map[string]int32{
+		"DEVICE_PLATFORM_UNSPECIFIED": 0,
+		"DEVICE_PLATFORM_ANDROID":     1,
+		"DEVICE_PLATFORM_IOS":         2,
+		"DEVICE_PLATFORM_WEB":         3,
+	}
+)
+
+func (x DevicePlatform) Enum() *DevicePlatform {
+	p := new(DevicePlatform)
+	*p = x
+	return p
+}
+
+func (x DevicePlatform) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DevicePlatform) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_notification_proto_enumTypes[3].Descriptor()
+}
+
+func (DevicePlatform) Type() protoreflect.EnumType {
+	return &file_lession_v1_notification_proto_enumTypes[3]
+}
+
+func (x DevicePlatform) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DevicePlatform.Descriptor instead.
+func (DevicePlatform) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_notification_proto_rawDescGZIP(), []int{3}
+}
+
+// NotificationPreferences records how a user wants to be notified.
+type NotificationPreferences struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id identifies the user.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// email is the address email notifications are sent to; email is not used when empty.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// locale selects the notification language, e.g. "zh"; defaults to English.
+	Locale string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	// opt_outs lists the notifications the user does not want.
+	OptOuts []*NotificationOptOut `protobuf:"bytes,4,rep,name=opt_outs,json=optOuts,proto3" json:"opt_outs,omitempty"`
+	// updated_at is when the preferences were last changed.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_lession_v1_notification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferences) ProtoMessage() {}
+
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_notification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_lession_v1_notification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NotificationPreferences) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetOptOuts() []*NotificationOptOut {
+	if x != nil {
+		return x.OptOuts
+	}
+	return nil
+}
+
+func (x *NotificationPreferences) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// NotificationOptOut mutes one kind of notification on one channel.
+type NotificationOptOut struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kind is the muted notification kind; unspecified mutes every kind on the channel.
+	Kind NotificationKind `protobuf:"varint,1,opt,name=kind,proto3,enum=lession.v1.NotificationKind" json:"kind,omitempty"`
+	// channel is the muted delivery channel.
+	Channel       NotificationChannel `protobuf:"varint,2,opt,name=channel,proto3,enum=lession.v1.NotificationChannel" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationOptOut) Reset() {
+	*x = NotificationOptOut{}
+	mi := &file_lession_v1_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationOptOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationOptOut) ProtoMessage() {}
+
+func (x *NotificationOptOut) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationOptOut.ProtoReflect.Descriptor instead.
+func (*NotificationOptOut) Descriptor() ([]byte, []int) {
+	return file_lession_v1_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotificationOptOut) GetKind() NotificationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return NotificationKind_NOTIFICATION_KIND_UNSPECIFIED
+}
+
+func (x *NotificationOptOut) GetChannel() NotificationChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED
+}
+
+// Notification is the delivery of one event to a user over one channel.
+type Notification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the notification.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// user_id identifies the recipient.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// kind is the event the notification is about.
+	Kind NotificationKind `protobuf:"varint,3,opt,name=kind,proto3,enum=lession.v1.NotificationKind" json:"kind,omitempty"`
+	// channel is how the notification was delivered.
+	Channel NotificationChannel `protobuf:"varint,4,opt,name=channel,proto3,enum=lession.v1.NotificationChannel" json:"channel,omitempty"`
+	// subject is the localized notification title.
+	Subject string `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	// body is the localized notification text.
+	Body string `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	// status reports delivery progress.
+	Status NotificationStatus `protobuf:"varint,7,opt,name=status,proto3,enum=lession.v1.NotificationStatus" json:"status,omitempty"`
+	// error describes why delivery failed.
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// created_at is when the notification was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// sent_at is when the notification was delivered.
+	SentAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_lession_v1_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_lession_v1_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Notification) GetKind() NotificationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return NotificationKind_NOTIFICATION_KIND_UNSPECIFIED
+}
+
+func (x *Notification) GetChannel() NotificationChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED
+}
+
+func (x *Notification) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Notification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Notification) GetStatus() NotificationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return NotificationStatus_NOTIFICATION_STATUS_UNSPECIFIED
+}
+
+func (x *Notification) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Notification) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+// DeviceToken registers a device for push notifications.
+type DeviceToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// token is the FCM registration token of the device.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// user_id identifies the user the device belongs to.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// platform is the kind of device.
+	Platform DevicePlatform `protobuf:"varint,3,opt,name=platform,proto3,enum=lession.v1.DevicePlatform" json:"platform,omitempty"`
+	// created_at is when the device was first registered.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is when the device was last registered.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceToken) Reset() {
+	*x = DeviceToken{}
+	mi := &file_lession_v1_notification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceToken) ProtoMessage() {}
+
+func (x *DeviceToken) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_notification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceToken.ProtoReflect.Descriptor instead.
+func (*DeviceToken) Descriptor() ([]byte, []int) {
+	return file_lession_v1_notification_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeviceToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DeviceToken) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeviceToken) GetPlatform() DevicePlatform {
+	if x != nil {
+		return x.Platform
+	}
+	return DevicePlatform_DEVICE_PLATFORM_UNSPECIFIED
+}
+
+func (x *DeviceToken) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DeviceToken) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_lession_v1_notification_proto protoreflect.FileDescriptor
+
+const file_lession_v1_notification_proto_rawDesc = "" +
+	"\n" +
+	"\x1dlession/v1/notification.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf5\x01\n" +
+	"\x17NotificationPreferences\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\x05email\x18\x02 \x01(\tB\n" +
+	"\xbaH\a\xd8\x01\x01r\x02`\x01R\x05email\x12\x1f\n" +
+	"\x06locale\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18#R\x06locale\x12C\n" +
+	"\bopt_outs\x18\x04 \x03(\v2\x1e.lession.v1.NotificationOptOutB\b\xbaH\x05\x92\x01\x02\x10 R\aoptOuts\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x97\x01\n" +
+	"\x12NotificationOptOut\x12:\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1c.lession.v1.NotificationKindB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04kind\x12E\n" +
+	"\achannel\x18\x02 \x01(\x0e2\x1f.lession.v1.NotificationChannelB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\achannel\"\x90\x03\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x120\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x1c.lession.v1.NotificationKindR\x04kind\x129\n" +
+	"\achannel\x18\x04 \x01(\x0e2\x1f.lession.v1.NotificationChannelR\achannel\x12\x18\n" +
+	"\asubject\x18\x05 \x01(\tR\asubject\x12\x12\n" +
+	"\x04body\x18\x06 \x01(\tR\x04body\x126\n" +
+	"\x06status\x18\a \x01(\x0e2\x1e.lession.v1.NotificationStatusR\x06status\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x123\n" +
+	"\asent_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\"\xea\x01\n" +
+	"\vDeviceToken\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x126\n" +
+	"\bplatform\x18\x03 \x01(\x0e2\x1a.lession.v1.DevicePlatformR\bplatform\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt*\xab\x01\n" +
+	"\x10NotificationKind\x12!\n" +
+	"\x1dNOTIFICATION_KIND_UNSPECIFIED\x10\x00\x12'\n" +
+	"#NOTIFICATION_KIND_EPISODE_PUBLISHED\x10\x01\x12$\n" +
+	" NOTIFICATION_KIND_ASSIGNMENT_DUE\x10\x02\x12%\n" +
+	"!NOTIFICATION_KIND_STREAK_REMINDER\x10\x03*z\n" +
+	"\x13NotificationChannel\x12$\n" +
+	" NOTIFICATION_CHANNEL_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aNOTIFICATION_CHANNEL_EMAIL\x10\x01\x12\x1d\n" +
+	"\x19NOTIFICATION_CHANNEL_PUSH\x10\x02*\x98\x01\n" +
+	"\x12NotificationStatus\x12#\n" +
+	"\x1fNOTIFICATION_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bNOTIFICATION_STATUS_PENDING\x10\x01\x12\x1c\n" +
+	"\x18NOTIFICATION_STATUS_SENT\x10\x02\x12\x1e\n" +
+	"\x1aNOTIFICATION_STATUS_FAILED\x10\x03*\x80\x01\n" +
+	"\x0eDevicePlatform\x12\x1f\n" +
+	"\x1bDEVICE_PLATFORM_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17DEVICE_PLATFORM_ANDROID\x10\x01\x12\x17\n" +
+	"\x13DEVICE_PLATFORM_IOS\x10\x02\x12\x17\n" +
+	"\x13DEVICE_PLATFORM_WEB\x10\x03B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_notification_proto_rawDescOnce sync.Once
+	file_lession_v1_notification_proto_rawDescData []byte
+)
+
+func file_lession_v1_notification_proto_rawDescGZIP() []byte {
+	file_lession_v1_notification_proto_rawDescOnce.Do(func() {
+		file_lession_v1_notification_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_notification_proto_rawDesc), len(file_lession_v1_notification_proto_rawDesc)))
+	})
+	return file_lession_v1_notification_proto_rawDescData
+}
+
+var file_lession_v1_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_lession_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_lession_v1_notification_proto_goTypes = []any{
+	(NotificationKind)(0),           // 0: lession.v1.NotificationKind
+	(NotificationChannel)(0),        // 1: lession.v1.NotificationChannel
+	(NotificationStatus)(0),         // 2: lession.v1.NotificationStatus
+	(DevicePlatform)(0),             // 3: lession.v1.DevicePlatform
+	(*NotificationPreferences)(nil), // 4: lession.v1.NotificationPreferences
+	(*NotificationOptOut)(nil),      // 5: lession.v1.NotificationOptOut
+	(*Notification)(nil),            // 6: lession.v1.Notification
+	(*DeviceToken)(nil),             // 7: lession.v1.DeviceToken
+	(*timestamppb.Timestamp)(nil),   // 8: google.protobuf.Timestamp
+}
+var file_lession_v1_notification_proto_depIdxs = []int32{
+	5,  // 0: lession.v1.NotificationPreferences.opt_outs:type_name -> lession.v1.NotificationOptOut
+	8,  // 1: lession.v1.NotificationPreferences.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: lession.v1.NotificationOptOut.kind:type_name -> lession.v1.NotificationKind
+	1,  // 3: lession.v1.NotificationOptOut.channel:type_name -> lession.v1.NotificationChannel
+	0,  // 4: lession.v1.Notification.kind:type_name -> lession.v1.NotificationKind
+	1,  // 5: lession.v1.Notification.channel:type_name -> lession.v1.NotificationChannel
+	2,  // 6: lession.v1.Notification.status:type_name -> lession.v1.NotificationStatus
+	8,  // 7: lession.v1.Notification.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 8: lession.v1.Notification.sent_at:type_name -> google.protobuf.Timestamp
+	3,  // 9: lession.v1.DeviceToken.platform:type_name -> lession.v1.DevicePlatform
+	8,  // 10: lession.v1.DeviceToken.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 11: lession.v1.DeviceToken.updated_at:type_name -> google.protobuf.Timestamp
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_notification_proto_init() }
+func file_lession_v1_notification_proto_init() {
+	if File_lession_v1_notification_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_notification_proto_rawDesc), len(file_lession_v1_notification_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_notification_proto_goTypes,
+		DependencyIndexes: file_lession_v1_notification_proto_depIdxs,
+		EnumInfos:         file_lession_v1_notification_proto_enumTypes,
+		MessageInfos:      file_lession_v1_notification_proto_msgTypes,
+	}.Build()
+	File_lession_v1_notification_proto = out.File
+	file_lession_v1_notification_proto_goTypes = nil
+	file_lession_v1_notification_proto_depIdxs = nil
+}
@@ -0,0 +1,393 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/playlist.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Playlist is a user-curated, ordered study list of episodes from any series.
+type Playlist struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the server-assigned identifier for the playlist.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner_id identifies the user who curates the playlist.
+	OwnerId string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// title is the playlist headline.
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// description provides optional notes about the playlist.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// public exposes the playlist to anyone holding its slug.
+	Public bool `protobuf:"varint,5,opt,name=public,proto3" json:"public,omitempty"`
+	// slug is the share identifier used to fetch public playlists.
+	Slug string `protobuf:"bytes,6,opt,name=slug,proto3" json:"slug,omitempty"`
+	// items lists the playlist entries in order.
+	Items []*PlaylistItem `protobuf:"bytes,7,rep,name=items,proto3" json:"items,omitempty"`
+	// created_at records when the playlist was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at records when the playlist or its items were last modified.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// moderation_status reports the review state of a public playlist's title and description.
+	// Shared playlists are only served once approved.
+	ModerationStatus ModerationStatus `protobuf:"varint,10,opt,name=moderation_status,json=moderationStatus,proto3,enum=lession.v1.ModerationStatus" json:"moderation_status,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Playlist) Reset() {
+	*x = Playlist{}
+	mi := &file_lession_v1_playlist_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Playlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Playlist) ProtoMessage() {}
+
+func (x *Playlist) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Playlist.ProtoReflect.Descriptor instead.
+func (*Playlist) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Playlist) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Playlist) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Playlist) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Playlist) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Playlist) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *Playlist) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Playlist) GetItems() []*PlaylistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Playlist) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Playlist) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Playlist) GetModerationStatus() ModerationStatus {
+	if x != nil {
+		return x.ModerationStatus
+	}
+	return ModerationStatus_MODERATION_STATUS_UNSPECIFIED
+}
+
+// PlaylistItem is an episode entry within a playlist.
+type PlaylistItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// episode_id identifies the episode.
+	EpisodeId string `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// position is the zero-based position of the entry.
+	Position uint32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	// episode carries title, duration, and playback metadata when episodes are requested.
+	// Transcripts are omitted; fetch the episode directly for its transcript.
+	Episode       *Episode `protobuf:"bytes,3,opt,name=episode,proto3" json:"episode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaylistItem) Reset() {
+	*x = PlaylistItem{}
+	mi := &file_lession_v1_playlist_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaylistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistItem) ProtoMessage() {}
+
+func (x *PlaylistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistItem.ProtoReflect.Descriptor instead.
+func (*PlaylistItem) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlaylistItem) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *PlaylistItem) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PlaylistItem) GetEpisode() *Episode {
+	if x != nil {
+		return x.Episode
+	}
+	return nil
+}
+
+// PlaylistDraft contains user-modifiable playlist attributes.
+type PlaylistDraft struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// title is the playlist headline.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description provides optional notes about the playlist.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// public exposes the playlist to anyone holding its slug.
+	Public bool `protobuf:"varint,3,opt,name=public,proto3" json:"public,omitempty"`
+	// slug optionally chooses the share identifier; one is generated for public playlists when empty.
+	Slug string `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	// episode_ids lists the initial episodes in order.
+	EpisodeIds    []string `protobuf:"bytes,5,rep,name=episode_ids,json=episodeIds,proto3" json:"episode_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaylistDraft) Reset() {
+	*x = PlaylistDraft{}
+	mi := &file_lession_v1_playlist_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaylistDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistDraft) ProtoMessage() {}
+
+func (x *PlaylistDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistDraft.ProtoReflect.Descriptor instead.
+func (*PlaylistDraft) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlaylistDraft) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PlaylistDraft) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PlaylistDraft) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *PlaylistDraft) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *PlaylistDraft) GetEpisodeIds() []string {
+	if x != nil {
+		return x.EpisodeIds
+	}
+	return nil
+}
+
+var File_lession_v1_playlist_proto protoreflect.FileDescriptor
+
+const file_lession_v1_playlist_proto_rawDesc = "" +
+	"\n" +
+	"\x19lession/v1/playlist.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1blession/v1/moderation.proto\x1a\x17lession/v1/series.proto\"\x8a\x03\n" +
+	"\bPlaylist\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06public\x18\x05 \x01(\bR\x06public\x12\x12\n" +
+	"\x04slug\x18\x06 \x01(\tR\x04slug\x12.\n" +
+	"\x05items\x18\a \x03(\v2\x18.lession.v1.PlaylistItemR\x05items\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12I\n" +
+	"\x11moderation_status\x18\n" +
+	" \x01(\x0e2\x1c.lession.v1.ModerationStatusR\x10moderationStatus\"x\n" +
+	"\fPlaylistItem\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\tepisodeId\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\rR\bposition\x12-\n" +
+	"\aepisode\x18\x03 \x01(\v2\x13.lession.v1.EpisodeR\aepisode\"\xe1\x01\n" +
+	"\rPlaylistDraft\x12 \n" +
+	"\x05title\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x02R\x05title\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\x12\x16\n" +
+	"\x06public\x18\x03 \x01(\bR\x06public\x125\n" +
+	"\x04slug\x18\x04 \x01(\tB!\xbaH\x1e\xd8\x01\x01r\x19\x18\x80\x012\x14^[a-z0-9][a-z0-9-]*$R\x04slug\x123\n" +
+	"\vepisode_ids\x18\x05 \x03(\tB\x12\xbaH\x0f\x92\x01\f\x10\xf4\x03\x18\x01\"\x05r\x03\xb0\x01\x01R\n" +
+	"episodeIdsB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_playlist_proto_rawDescOnce sync.Once
+	file_lession_v1_playlist_proto_rawDescData []byte
+)
+
+func file_lession_v1_playlist_proto_rawDescGZIP() []byte {
+	file_lession_v1_playlist_proto_rawDescOnce.Do(func() {
+		file_lession_v1_playlist_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_playlist_proto_rawDesc), len(file_lession_v1_playlist_proto_rawDesc)))
+	})
+	return file_lession_v1_playlist_proto_rawDescData
+}
+
+var file_lession_v1_playlist_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_lession_v1_playlist_proto_goTypes = []any{
+	(*Playlist)(nil),              // 0: lession.v1.Playlist
+	(*PlaylistItem)(nil),          // 1: lession.v1.PlaylistItem
+	(*PlaylistDraft)(nil),         // 2: lession.v1.PlaylistDraft
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(ModerationStatus)(0),         // 4: lession.v1.ModerationStatus
+	(*Episode)(nil),               // 5: lession.v1.Episode
+}
+var file_lession_v1_playlist_proto_depIdxs = []int32{
+	1, // 0: lession.v1.Playlist.items:type_name -> lession.v1.PlaylistItem
+	3, // 1: lession.v1.Playlist.created_at:type_name -> google.protobuf.Timestamp
+	3, // 2: lession.v1.Playlist.updated_at:type_name -> google.protobuf.Timestamp
+	4, // 3: lession.v1.Playlist.moderation_status:type_name -> lession.v1.ModerationStatus
+	5, // 4: lession.v1.PlaylistItem.episode:type_name -> lession.v1.Episode
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_playlist_proto_init() }
+func file_lession_v1_playlist_proto_init() {
+	if File_lession_v1_playlist_proto != nil {
+		return
+	}
+	file_lession_v1_moderation_proto_init()
+	file_lession_v1_series_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_playlist_proto_rawDesc), len(file_lession_v1_playlist_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_playlist_proto_goTypes,
+		DependencyIndexes: file_lession_v1_playlist_proto_depIdxs,
+		MessageInfos:      file_lession_v1_playlist_proto_msgTypes,
+	}.Build()
+	File_lession_v1_playlist_proto = out.File
+	file_lession_v1_playlist_proto_goTypes = nil
+	file_lession_v1_playlist_proto_depIdxs = nil
+}
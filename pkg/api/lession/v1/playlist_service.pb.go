@@ -0,0 +1,1125 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/playlist_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CreatePlaylistRequest supplies attributes for a new playlist.
+type CreatePlaylistRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// owner_id identifies the user creating the playlist.
+	OwnerId string `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// playlist contains the initial attributes and episodes.
+	Playlist      *PlaylistDraft `protobuf:"bytes,2,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlaylistRequest) Reset() {
+	*x = CreatePlaylistRequest{}
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlaylistRequest) ProtoMessage() {}
+
+func (x *CreatePlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlaylistRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreatePlaylistRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CreatePlaylistRequest) GetPlaylist() *PlaylistDraft {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+// CreatePlaylistResponse returns the newly created playlist.
+type CreatePlaylistResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// playlist is the persisted playlist.
+	Playlist      *Playlist `protobuf:"bytes,1,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlaylistResponse) Reset() {
+	*x = CreatePlaylistResponse{}
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlaylistResponse) ProtoMessage() {}
+
+func (x *CreatePlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlaylistResponse.ProtoReflect.Descriptor instead.
+func (*CreatePlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePlaylistResponse) GetPlaylist() *Playlist {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+// GetPlaylistRequest identifies the playlist to retrieve.
+type GetPlaylistRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// playlist_id references the target playlist.
+	PlaylistId string `protobuf:"bytes,1,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	// include_episodes requests episode playback metadata for each entry.
+	IncludeEpisodes bool `protobuf:"varint,2,opt,name=include_episodes,json=includeEpisodes,proto3" json:"include_episodes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPlaylistRequest) Reset() {
+	*x = GetPlaylistRequest{}
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaylistRequest) ProtoMessage() {}
+
+func (x *GetPlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaylistRequest.ProtoReflect.Descriptor instead.
+func (*GetPlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPlaylistRequest) GetPlaylistId() string {
+	if x != nil {
+		return x.PlaylistId
+	}
+	return ""
+}
+
+func (x *GetPlaylistRequest) GetIncludeEpisodes() bool {
+	if x != nil {
+		return x.IncludeEpisodes
+	}
+	return false
+}
+
+// GetPlaylistResponse returns a single playlist.
+type GetPlaylistResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// playlist is the requested resource.
+	Playlist      *Playlist `protobuf:"bytes,1,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlaylistResponse) Reset() {
+	*x = GetPlaylistResponse{}
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaylistResponse) ProtoMessage() {}
+
+func (x *GetPlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaylistResponse.ProtoReflect.Descriptor instead.
+func (*GetPlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPlaylistResponse) GetPlaylist() *Playlist {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+// GetSharedPlaylistRequest identifies a public playlist by slug.
+type GetSharedPlaylistRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// slug is the share identifier of the playlist.
+	Slug string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	// include_episodes requests episode playback metadata for each entry.
+	IncludeEpisodes bool `protobuf:"varint,2,opt,name=include_episodes,json=includeEpisodes,proto3" json:"include_episodes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetSharedPlaylistRequest) Reset() {
+	*x = GetSharedPlaylistRequest{}
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedPlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedPlaylistRequest) ProtoMessage() {}
+
+func (x *GetSharedPlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedPlaylistRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedPlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSharedPlaylistRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *GetSharedPlaylistRequest) GetIncludeEpisodes() bool {
+	if x != nil {
+		return x.IncludeEpisodes
+	}
+	return false
+}
+
+// GetSharedPlaylistResponse returns the public playlist.
+type GetSharedPlaylistResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// playlist is the requested resource.
+	Playlist      *Playlist `protobuf:"bytes,1,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedPlaylistResponse) Reset() {
+	*x = GetSharedPlaylistResponse{}
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedPlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedPlaylistResponse) ProtoMessage() {}
+
+func (x *GetSharedPlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedPlaylistResponse.ProtoReflect.Descriptor instead.
+func (*GetSharedPlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSharedPlaylistResponse) GetPlaylist() *Playlist {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+// ListPlaylistsRequest carries filters for listing playlists.
+type ListPlaylistsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_size limits the number of returned playlists.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token continues a prior ListPlaylists response.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// owner_id restricts playlists to a single user.
+	OwnerId string `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// include_episodes requests episode playback metadata for each entry.
+	IncludeEpisodes bool `protobuf:"varint,4,opt,name=include_episodes,json=includeEpisodes,proto3" json:"include_episodes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListPlaylistsRequest) Reset() {
+	*x = ListPlaylistsRequest{}
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlaylistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlaylistsRequest) ProtoMessage() {}
+
+func (x *ListPlaylistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlaylistsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlaylistsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListPlaylistsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPlaylistsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPlaylistsRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ListPlaylistsRequest) GetIncludeEpisodes() bool {
+	if x != nil {
+		return x.IncludeEpisodes
+	}
+	return false
+}
+
+// ListPlaylistsResponse returns a page of playlists.
+type ListPlaylistsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// playlists contains the matching playlists.
+	Playlists []*Playlist `protobuf:"bytes,1,rep,name=playlists,proto3" json:"playlists,omitempty"`
+	// next_page_token is supplied when more data is available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlaylistsResponse) Reset() {
+	*x = ListPlaylistsResponse{}
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlaylistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlaylistsResponse) ProtoMessage() {}
+
+func (x *ListPlaylistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlaylistsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlaylistsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListPlaylistsResponse) GetPlaylists() []*Playlist {
+	if x != nil {
+		return x.Playlists
+	}
+	return nil
+}
+
+func (x *ListPlaylistsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// UpdatePlaylistRequest applies a partial update to a playlist.
+type UpdatePlaylistRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// playlist_id references the target playlist.
+	PlaylistId string `protobuf:"bytes,1,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	// playlist contains the fields to update; episode_ids is ignored, use the item RPCs instead.
+	Playlist *PlaylistDraft `protobuf:"bytes,2,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	// update_mask indicates which fields in playlist should be applied.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlaylistRequest) Reset() {
+	*x = UpdatePlaylistRequest{}
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlaylistRequest) ProtoMessage() {}
+
+func (x *UpdatePlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlaylistRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdatePlaylistRequest) GetPlaylistId() string {
+	if x != nil {
+		return x.PlaylistId
+	}
+	return ""
+}
+
+func (x *UpdatePlaylistRequest) GetPlaylist() *PlaylistDraft {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+func (x *UpdatePlaylistRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdatePlaylistResponse returns the updated playlist.
+type UpdatePlaylistResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// playlist is the persisted playlist after the update.
+	Playlist      *Playlist `protobuf:"bytes,1,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlaylistResponse) Reset() {
+	*x = UpdatePlaylistResponse{}
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlaylistResponse) ProtoMessage() {}
+
+func (x *UpdatePlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlaylistResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdatePlaylistResponse) GetPlaylist() *Playlist {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+// DeletePlaylistRequest identifies the playlist to remove.
+type DeletePlaylistRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// playlist_id references the target playlist.
+	PlaylistId    string `protobuf:"bytes,1,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlaylistRequest) Reset() {
+	*x = DeletePlaylistRequest{}
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlaylistRequest) ProtoMessage() {}
+
+func (x *DeletePlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlaylistRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeletePlaylistRequest) GetPlaylistId() string {
+	if x != nil {
+		return x.PlaylistId
+	}
+	return ""
+}
+
+// DeletePlaylistResponse acknowledges the removal.
+type DeletePlaylistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlaylistResponse) Reset() {
+	*x = DeletePlaylistResponse{}
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlaylistResponse) ProtoMessage() {}
+
+func (x *DeletePlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlaylistResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_service_proto_rawDescGZIP(), []int{11}
+}
+
+// AddPlaylistEpisodesRequest appends episodes to a playlist.
+type AddPlaylistEpisodesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// playlist_id references the target playlist.
+	PlaylistId string `protobuf:"bytes,1,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	// episode_ids lists the episodes to append, in order; episodes already present are skipped.
+	EpisodeIds    []string `protobuf:"bytes,2,rep,name=episode_ids,json=episodeIds,proto3" json:"episode_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPlaylistEpisodesRequest) Reset() {
+	*x = AddPlaylistEpisodesRequest{}
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPlaylistEpisodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPlaylistEpisodesRequest) ProtoMessage() {}
+
+func (x *AddPlaylistEpisodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPlaylistEpisodesRequest.ProtoReflect.Descriptor instead.
+func (*AddPlaylistEpisodesRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddPlaylistEpisodesRequest) GetPlaylistId() string {
+	if x != nil {
+		return x.PlaylistId
+	}
+	return ""
+}
+
+func (x *AddPlaylistEpisodesRequest) GetEpisodeIds() []string {
+	if x != nil {
+		return x.EpisodeIds
+	}
+	return nil
+}
+
+// AddPlaylistEpisodesResponse returns the updated playlist.
+type AddPlaylistEpisodesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// playlist is the playlist after the episodes were added.
+	Playlist      *Playlist `protobuf:"bytes,1,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPlaylistEpisodesResponse) Reset() {
+	*x = AddPlaylistEpisodesResponse{}
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPlaylistEpisodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPlaylistEpisodesResponse) ProtoMessage() {}
+
+func (x *AddPlaylistEpisodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPlaylistEpisodesResponse.ProtoReflect.Descriptor instead.
+func (*AddPlaylistEpisodesResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AddPlaylistEpisodesResponse) GetPlaylist() *Playlist {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+// RemovePlaylistEpisodeRequest removes an episode from a playlist.
+type RemovePlaylistEpisodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// playlist_id references the target playlist.
+	PlaylistId string `protobuf:"bytes,1,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	// episode_id identifies the entry to remove.
+	EpisodeId     string `protobuf:"bytes,2,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePlaylistEpisodeRequest) Reset() {
+	*x = RemovePlaylistEpisodeRequest{}
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePlaylistEpisodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePlaylistEpisodeRequest) ProtoMessage() {}
+
+func (x *RemovePlaylistEpisodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePlaylistEpisodeRequest.ProtoReflect.Descriptor instead.
+func (*RemovePlaylistEpisodeRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemovePlaylistEpisodeRequest) GetPlaylistId() string {
+	if x != nil {
+		return x.PlaylistId
+	}
+	return ""
+}
+
+func (x *RemovePlaylistEpisodeRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+// RemovePlaylistEpisodeResponse returns the updated playlist.
+type RemovePlaylistEpisodeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// playlist is the playlist after the episode was removed.
+	Playlist      *Playlist `protobuf:"bytes,1,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePlaylistEpisodeResponse) Reset() {
+	*x = RemovePlaylistEpisodeResponse{}
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePlaylistEpisodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePlaylistEpisodeResponse) ProtoMessage() {}
+
+func (x *RemovePlaylistEpisodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePlaylistEpisodeResponse.ProtoReflect.Descriptor instead.
+func (*RemovePlaylistEpisodeResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RemovePlaylistEpisodeResponse) GetPlaylist() *Playlist {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+// ReorderPlaylistRequest supplies the new order of a playlist.
+type ReorderPlaylistRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// playlist_id references the target playlist.
+	PlaylistId string `protobuf:"bytes,1,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	// episode_ids lists every episode in the playlist exactly once, in the desired order.
+	EpisodeIds    []string `protobuf:"bytes,2,rep,name=episode_ids,json=episodeIds,proto3" json:"episode_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderPlaylistRequest) Reset() {
+	*x = ReorderPlaylistRequest{}
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderPlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderPlaylistRequest) ProtoMessage() {}
+
+func (x *ReorderPlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderPlaylistRequest.ProtoReflect.Descriptor instead.
+func (*ReorderPlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReorderPlaylistRequest) GetPlaylistId() string {
+	if x != nil {
+		return x.PlaylistId
+	}
+	return ""
+}
+
+func (x *ReorderPlaylistRequest) GetEpisodeIds() []string {
+	if x != nil {
+		return x.EpisodeIds
+	}
+	return nil
+}
+
+// ReorderPlaylistResponse returns the reordered playlist.
+type ReorderPlaylistResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// playlist is the playlist in its new order.
+	Playlist      *Playlist `protobuf:"bytes,1,opt,name=playlist,proto3" json:"playlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderPlaylistResponse) Reset() {
+	*x = ReorderPlaylistResponse{}
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderPlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderPlaylistResponse) ProtoMessage() {}
+
+func (x *ReorderPlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_playlist_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderPlaylistResponse.ProtoReflect.Descriptor instead.
+func (*ReorderPlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_playlist_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReorderPlaylistResponse) GetPlaylist() *Playlist {
+	if x != nil {
+		return x.Playlist
+	}
+	return nil
+}
+
+var File_lession_v1_playlist_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_playlist_service_proto_rawDesc = "" +
+	"\n" +
+	"!lession/v1/playlist_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x19lession/v1/playlist.proto\"z\n" +
+	"\x15CreatePlaylistRequest\x12\"\n" +
+	"\bowner_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aownerId\x12=\n" +
+	"\bplaylist\x18\x02 \x01(\v2\x19.lession.v1.PlaylistDraftB\x06\xbaH\x03\xc8\x01\x01R\bplaylist\"J\n" +
+	"\x16CreatePlaylistResponse\x120\n" +
+	"\bplaylist\x18\x01 \x01(\v2\x14.lession.v1.PlaylistR\bplaylist\"j\n" +
+	"\x12GetPlaylistRequest\x12)\n" +
+	"\vplaylist_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"playlistId\x12)\n" +
+	"\x10include_episodes\x18\x02 \x01(\bR\x0fincludeEpisodes\"G\n" +
+	"\x13GetPlaylistResponse\x120\n" +
+	"\bplaylist\x18\x01 \x01(\v2\x14.lession.v1.PlaylistR\bplaylist\"e\n" +
+	"\x18GetSharedPlaylistRequest\x12\x1e\n" +
+	"\x04slug\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x01R\x04slug\x12)\n" +
+	"\x10include_episodes\x18\x02 \x01(\bR\x0fincludeEpisodes\"M\n" +
+	"\x19GetSharedPlaylistResponse\x120\n" +
+	"\bplaylist\x18\x01 \x01(\v2\x14.lession.v1.PlaylistR\bplaylist\"\xa1\x01\n" +
+	"\x14ListPlaylistsRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\rB\a\xbaH\x04*\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12)\n" +
+	"\x10include_episodes\x18\x04 \x01(\bR\x0fincludeEpisodes\"s\n" +
+	"\x15ListPlaylistsResponse\x122\n" +
+	"\tplaylists\x18\x01 \x03(\v2\x14.lession.v1.PlaylistR\tplaylists\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbe\x01\n" +
+	"\x15UpdatePlaylistRequest\x12)\n" +
+	"\vplaylist_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"playlistId\x12=\n" +
+	"\bplaylist\x18\x02 \x01(\v2\x19.lession.v1.PlaylistDraftB\x06\xbaH\x03\xc8\x01\x01R\bplaylist\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"J\n" +
+	"\x16UpdatePlaylistResponse\x120\n" +
+	"\bplaylist\x18\x01 \x01(\v2\x14.lession.v1.PlaylistR\bplaylist\"B\n" +
+	"\x15DeletePlaylistRequest\x12)\n" +
+	"\vplaylist_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"playlistId\"\x18\n" +
+	"\x16DeletePlaylistResponse\"{\n" +
+	"\x1aAddPlaylistEpisodesRequest\x12)\n" +
+	"\vplaylist_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"playlistId\x122\n" +
+	"\vepisode_ids\x18\x02 \x03(\tB\x11\xbaH\x0e\x92\x01\v\b\x01\x10d\"\x05r\x03\xb0\x01\x01R\n" +
+	"episodeIds\"O\n" +
+	"\x1bAddPlaylistEpisodesResponse\x120\n" +
+	"\bplaylist\x18\x01 \x01(\v2\x14.lession.v1.PlaylistR\bplaylist\"r\n" +
+	"\x1cRemovePlaylistEpisodeRequest\x12)\n" +
+	"\vplaylist_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"playlistId\x12'\n" +
+	"\n" +
+	"episode_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tepisodeId\"Q\n" +
+	"\x1dRemovePlaylistEpisodeResponse\x120\n" +
+	"\bplaylist\x18\x01 \x01(\v2\x14.lession.v1.PlaylistR\bplaylist\"x\n" +
+	"\x16ReorderPlaylistRequest\x12)\n" +
+	"\vplaylist_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"playlistId\x123\n" +
+	"\vepisode_ids\x18\x02 \x03(\tB\x12\xbaH\x0f\x92\x01\f\x10\xf4\x03\x18\x01\"\x05r\x03\xb0\x01\x01R\n" +
+	"episodeIds\"K\n" +
+	"\x17ReorderPlaylistResponse\x120\n" +
+	"\bplaylist\x18\x01 \x01(\v2\x14.lession.v1.PlaylistR\bplaylist2\xd6\x06\n" +
+	"\x0fPlaylistService\x12W\n" +
+	"\x0eCreatePlaylist\x12!.lession.v1.CreatePlaylistRequest\x1a\".lession.v1.CreatePlaylistResponse\x12N\n" +
+	"\vGetPlaylist\x12\x1e.lession.v1.GetPlaylistRequest\x1a\x1f.lession.v1.GetPlaylistResponse\x12`\n" +
+	"\x11GetSharedPlaylist\x12$.lession.v1.GetSharedPlaylistRequest\x1a%.lession.v1.GetSharedPlaylistResponse\x12T\n" +
+	"\rListPlaylists\x12 .lession.v1.ListPlaylistsRequest\x1a!.lession.v1.ListPlaylistsResponse\x12W\n" +
+	"\x0eUpdatePlaylist\x12!.lession.v1.UpdatePlaylistRequest\x1a\".lession.v1.UpdatePlaylistResponse\x12W\n" +
+	"\x0eDeletePlaylist\x12!.lession.v1.DeletePlaylistRequest\x1a\".lession.v1.DeletePlaylistResponse\x12f\n" +
+	"\x13AddPlaylistEpisodes\x12&.lession.v1.AddPlaylistEpisodesRequest\x1a'.lession.v1.AddPlaylistEpisodesResponse\x12l\n" +
+	"\x15RemovePlaylistEpisode\x12(.lession.v1.RemovePlaylistEpisodeRequest\x1a).lession.v1.RemovePlaylistEpisodeResponse\x12Z\n" +
+	"\x0fReorderPlaylist\x12\".lession.v1.ReorderPlaylistRequest\x1a#.lession.v1.ReorderPlaylistResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_playlist_service_proto_rawDescOnce sync.Once
+	file_lession_v1_playlist_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_playlist_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_playlist_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_playlist_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_playlist_service_proto_rawDesc), len(file_lession_v1_playlist_service_proto_rawDesc)))
+	})
+	return file_lession_v1_playlist_service_proto_rawDescData
+}
+
+var file_lession_v1_playlist_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_lession_v1_playlist_service_proto_goTypes = []any{
+	(*CreatePlaylistRequest)(nil),         // 0: lession.v1.CreatePlaylistRequest
+	(*CreatePlaylistResponse)(nil),        // 1: lession.v1.CreatePlaylistResponse
+	(*GetPlaylistRequest)(nil),            // 2: lession.v1.GetPlaylistRequest
+	(*GetPlaylistResponse)(nil),           // 3: lession.v1.GetPlaylistResponse
+	(*GetSharedPlaylistRequest)(nil),      // 4: lession.v1.GetSharedPlaylistRequest
+	(*GetSharedPlaylistResponse)(nil),     // 5: lession.v1.GetSharedPlaylistResponse
+	(*ListPlaylistsRequest)(nil),          // 6: lession.v1.ListPlaylistsRequest
+	(*ListPlaylistsResponse)(nil),         // 7: lession.v1.ListPlaylistsResponse
+	(*UpdatePlaylistRequest)(nil),         // 8: lession.v1.UpdatePlaylistRequest
+	(*UpdatePlaylistResponse)(nil),        // 9: lession.v1.UpdatePlaylistResponse
+	(*DeletePlaylistRequest)(nil),         // 10: lession.v1.DeletePlaylistRequest
+	(*DeletePlaylistResponse)(nil),        // 11: lession.v1.DeletePlaylistResponse
+	(*AddPlaylistEpisodesRequest)(nil),    // 12: lession.v1.AddPlaylistEpisodesRequest
+	(*AddPlaylistEpisodesResponse)(nil),   // 13: lession.v1.AddPlaylistEpisodesResponse
+	(*RemovePlaylistEpisodeRequest)(nil),  // 14: lession.v1.RemovePlaylistEpisodeRequest
+	(*RemovePlaylistEpisodeResponse)(nil), // 15: lession.v1.RemovePlaylistEpisodeResponse
+	(*ReorderPlaylistRequest)(nil),        // 16: lession.v1.ReorderPlaylistRequest
+	(*ReorderPlaylistResponse)(nil),       // 17: lession.v1.ReorderPlaylistResponse
+	(*PlaylistDraft)(nil),                 // 18: lession.v1.PlaylistDraft
+	(*Playlist)(nil),                      // 19: lession.v1.Playlist
+	(*fieldmaskpb.FieldMask)(nil),         // 20: google.protobuf.FieldMask
+}
+var file_lession_v1_playlist_service_proto_depIdxs = []int32{
+	18, // 0: lession.v1.CreatePlaylistRequest.playlist:type_name -> lession.v1.PlaylistDraft
+	19, // 1: lession.v1.CreatePlaylistResponse.playlist:type_name -> lession.v1.Playlist
+	19, // 2: lession.v1.GetPlaylistResponse.playlist:type_name -> lession.v1.Playlist
+	19, // 3: lession.v1.GetSharedPlaylistResponse.playlist:type_name -> lession.v1.Playlist
+	19, // 4: lession.v1.ListPlaylistsResponse.playlists:type_name -> lession.v1.Playlist
+	18, // 5: lession.v1.UpdatePlaylistRequest.playlist:type_name -> lession.v1.PlaylistDraft
+	20, // 6: lession.v1.UpdatePlaylistRequest.update_mask:type_name -> google.protobuf.FieldMask
+	19, // 7: lession.v1.UpdatePlaylistResponse.playlist:type_name -> lession.v1.Playlist
+	19, // 8: lession.v1.AddPlaylistEpisodesResponse.playlist:type_name -> lession.v1.Playlist
+	19, // 9: lession.v1.RemovePlaylistEpisodeResponse.playlist:type_name -> lession.v1.Playlist
+	19, // 10: lession.v1.ReorderPlaylistResponse.playlist:type_name -> lession.v1.Playlist
+	0,  // 11: lession.v1.PlaylistService.CreatePlaylist:input_type -> lession.v1.CreatePlaylistRequest
+	2,  // 12: lession.v1.PlaylistService.GetPlaylist:input_type -> lession.v1.GetPlaylistRequest
+	4,  // 13: lession.v1.PlaylistService.GetSharedPlaylist:input_type -> lession.v1.GetSharedPlaylistRequest
+	6,  // 14: lession.v1.PlaylistService.ListPlaylists:input_type -> lession.v1.ListPlaylistsRequest
+	8,  // 15: lession.v1.PlaylistService.UpdatePlaylist:input_type -> lession.v1.UpdatePlaylistRequest
+	10, // 16: lession.v1.PlaylistService.DeletePlaylist:input_type -> lession.v1.DeletePlaylistRequest
+	12, // 17: lession.v1.PlaylistService.AddPlaylistEpisodes:input_type -> lession.v1.AddPlaylistEpisodesRequest
+	14, // 18: lession.v1.PlaylistService.RemovePlaylistEpisode:input_type -> lession.v1.RemovePlaylistEpisodeRequest
+	16, // 19: lession.v1.PlaylistService.ReorderPlaylist:input_type -> lession.v1.ReorderPlaylistRequest
+	1,  // 20: lession.v1.PlaylistService.CreatePlaylist:output_type -> lession.v1.CreatePlaylistResponse
+	3,  // 21: lession.v1.PlaylistService.GetPlaylist:output_type -> lession.v1.GetPlaylistResponse
+	5,  // 22: lession.v1.PlaylistService.GetSharedPlaylist:output_type -> lession.v1.GetSharedPlaylistResponse
+	7,  // 23: lession.v1.PlaylistService.ListPlaylists:output_type -> lession.v1.ListPlaylistsResponse
+	9,  // 24: lession.v1.PlaylistService.UpdatePlaylist:output_type -> lession.v1.UpdatePlaylistResponse
+	11, // 25: lession.v1.PlaylistService.DeletePlaylist:output_type -> lession.v1.DeletePlaylistResponse
+	13, // 26: lession.v1.PlaylistService.AddPlaylistEpisodes:output_type -> lession.v1.AddPlaylistEpisodesResponse
+	15, // 27: lession.v1.PlaylistService.RemovePlaylistEpisode:output_type -> lession.v1.RemovePlaylistEpisodeResponse
+	17, // 28: lession.v1.PlaylistService.ReorderPlaylist:output_type -> lession.v1.ReorderPlaylistResponse
+	20, // [20:29] is the sub-list for method output_type
+	11, // [11:20] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_playlist_service_proto_init() }
+func file_lession_v1_playlist_service_proto_init() {
+	if File_lession_v1_playlist_service_proto != nil {
+		return
+	}
+	file_lession_v1_playlist_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_playlist_service_proto_rawDesc), len(file_lession_v1_playlist_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_playlist_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_playlist_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_playlist_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_playlist_service_proto = out.File
+	file_lession_v1_playlist_service_proto_goTypes = nil
+	file_lession_v1_playlist_service_proto_depIdxs = nil
+}
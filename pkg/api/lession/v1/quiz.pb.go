@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/quiz.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QuizItemKind enumerates the types of exercise.
+type QuizItemKind int32
+
+const (
+	// QUIZ_ITEM_KIND_UNSPECIFIED is the default zero value.
+	QuizItemKind_QUIZ_ITEM_KIND_UNSPECIFIED QuizItemKind = 0
+	// QUIZ_ITEM_KIND_CLOZE asks the learner to fill a gap left in a transcript sentence.
+	QuizItemKind_QUIZ_ITEM_KIND_CLOZE QuizItemKind = 1
+)
+
+// Enum value maps for QuizItemKind.
+var (
+	QuizItemKind_name = map[int32]string{
+		0: "QUIZ_ITEM_KIND_UNSPECIFIED",
+		1: "QUIZ_ITEM_KIND_CLOZE",
+	}
+	QuizItemKind_value = map[string]int32{
+		"QUIZ_ITEM_KIND_UNSPECIFIED": 0,
+		"QUIZ_ITEM_KIND_CLOZE":       1,
+	}
+)
+
+func (x QuizItemKind) Enum() *QuizItemKind {
+	p := new(QuizItemKind)
+	*p = x
+	return p
+}
+
+func (x QuizItemKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuizItemKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_quiz_proto_enumTypes[0].Descriptor()
+}
+
+func (QuizItemKind) Type() protoreflect.EnumType {
+	return &file_lession_v1_quiz_proto_enumTypes[0]
+}
+
+func (x QuizItemKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuizItemKind.Descriptor instead.
+func (QuizItemKind) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_quiz_proto_rawDescGZIP(), []int{0}
+}
+
+// QuizItem is an exercise on an episode.
+type QuizItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the item.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// episode_id identifies the episode the item belongs to.
+	EpisodeId string `protobuf:"bytes,2,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// kind identifies the type of exercise.
+	Kind QuizItemKind `protobuf:"varint,3,opt,name=kind,proto3,enum=lession.v1.QuizItemKind" json:"kind,omitempty"`
+	// position orders the items of an episode.
+	Position uint32 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	// prompt is the sentence with the answer replaced by a gap of five underscores.
+	Prompt string `protobuf:"bytes,5,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	// answer is the text that fills the gap.
+	Answer string `protobuf:"bytes,6,opt,name=answer,proto3" json:"answer,omitempty"`
+	// sentence is the transcript sentence the item was made from.
+	Sentence string `protobuf:"bytes,7,opt,name=sentence,proto3" json:"sentence,omitempty"`
+	// needs_review is set on generated items until an editor checks them.
+	NeedsReview bool `protobuf:"varint,8,opt,name=needs_review,json=needsReview,proto3" json:"needs_review,omitempty"`
+	// created_at records when the item was generated.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at records when the item was last changed.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizItem) Reset() {
+	*x = QuizItem{}
+	mi := &file_lession_v1_quiz_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizItem) ProtoMessage() {}
+
+func (x *QuizItem) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_quiz_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizItem.ProtoReflect.Descriptor instead.
+func (*QuizItem) Descriptor() ([]byte, []int) {
+	return file_lession_v1_quiz_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuizItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QuizItem) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *QuizItem) GetKind() QuizItemKind {
+	if x != nil {
+		return x.Kind
+	}
+	return QuizItemKind_QUIZ_ITEM_KIND_UNSPECIFIED
+}
+
+func (x *QuizItem) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *QuizItem) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *QuizItem) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *QuizItem) GetSentence() string {
+	if x != nil {
+		return x.Sentence
+	}
+	return ""
+}
+
+func (x *QuizItem) GetNeedsReview() bool {
+	if x != nil {
+		return x.NeedsReview
+	}
+	return false
+}
+
+func (x *QuizItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *QuizItem) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_lession_v1_quiz_proto protoreflect.FileDescriptor
+
+const file_lession_v1_quiz_proto_rawDesc = "" +
+	"\n" +
+	"\x15lession/v1/quiz.proto\x12\n" +
+	"lession.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe8\x02\n" +
+	"\bQuizItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x02 \x01(\tR\tepisodeId\x12,\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x18.lession.v1.QuizItemKindR\x04kind\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\rR\bposition\x12\x16\n" +
+	"\x06prompt\x18\x05 \x01(\tR\x06prompt\x12\x16\n" +
+	"\x06answer\x18\x06 \x01(\tR\x06answer\x12\x1a\n" +
+	"\bsentence\x18\a \x01(\tR\bsentence\x12!\n" +
+	"\fneeds_review\x18\b \x01(\bR\vneedsReview\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt*H\n" +
+	"\fQuizItemKind\x12\x1e\n" +
+	"\x1aQUIZ_ITEM_KIND_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14QUIZ_ITEM_KIND_CLOZE\x10\x01B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_quiz_proto_rawDescOnce sync.Once
+	file_lession_v1_quiz_proto_rawDescData []byte
+)
+
+func file_lession_v1_quiz_proto_rawDescGZIP() []byte {
+	file_lession_v1_quiz_proto_rawDescOnce.Do(func() {
+		file_lession_v1_quiz_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_quiz_proto_rawDesc), len(file_lession_v1_quiz_proto_rawDesc)))
+	})
+	return file_lession_v1_quiz_proto_rawDescData
+}
+
+var file_lession_v1_quiz_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lession_v1_quiz_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_lession_v1_quiz_proto_goTypes = []any{
+	(QuizItemKind)(0),             // 0: lession.v1.QuizItemKind
+	(*QuizItem)(nil),              // 1: lession.v1.QuizItem
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_lession_v1_quiz_proto_depIdxs = []int32{
+	0, // 0: lession.v1.QuizItem.kind:type_name -> lession.v1.QuizItemKind
+	2, // 1: lession.v1.QuizItem.created_at:type_name -> google.protobuf.Timestamp
+	2, // 2: lession.v1.QuizItem.updated_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_quiz_proto_init() }
+func file_lession_v1_quiz_proto_init() {
+	if File_lession_v1_quiz_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_quiz_proto_rawDesc), len(file_lession_v1_quiz_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_quiz_proto_goTypes,
+		DependencyIndexes: file_lession_v1_quiz_proto_depIdxs,
+		EnumInfos:         file_lession_v1_quiz_proto_enumTypes,
+		MessageInfos:      file_lession_v1_quiz_proto_msgTypes,
+	}.Build()
+	File_lession_v1_quiz_proto = out.File
+	file_lession_v1_quiz_proto_goTypes = nil
+	file_lession_v1_quiz_proto_depIdxs = nil
+}
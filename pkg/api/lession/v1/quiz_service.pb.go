@@ -0,0 +1,447 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/quiz_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GenerateClozeItemsRequest selects the episode to generate items for.
+type GenerateClozeItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// episode_id identifies the episode.
+	EpisodeId string `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// max_items caps the items generated; ten when zero.
+	MaxItems      uint32 `protobuf:"varint,2,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateClozeItemsRequest) Reset() {
+	*x = GenerateClozeItemsRequest{}
+	mi := &file_lession_v1_quiz_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateClozeItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateClozeItemsRequest) ProtoMessage() {}
+
+func (x *GenerateClozeItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_quiz_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateClozeItemsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateClozeItemsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_quiz_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenerateClozeItemsRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *GenerateClozeItemsRequest) GetMaxItems() uint32 {
+	if x != nil {
+		return x.MaxItems
+	}
+	return 0
+}
+
+// GenerateClozeItemsResponse returns the generated items.
+type GenerateClozeItemsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// items contains the new cloze items in transcript order.
+	Items         []*QuizItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateClozeItemsResponse) Reset() {
+	*x = GenerateClozeItemsResponse{}
+	mi := &file_lession_v1_quiz_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateClozeItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateClozeItemsResponse) ProtoMessage() {}
+
+func (x *GenerateClozeItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_quiz_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateClozeItemsResponse.ProtoReflect.Descriptor instead.
+func (*GenerateClozeItemsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_quiz_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GenerateClozeItemsResponse) GetItems() []*QuizItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// ListQuizItemsRequest filters an episode's quiz items.
+type ListQuizItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// episode_id identifies the episode.
+	EpisodeId string `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// kind restricts the items to a single type.
+	Kind QuizItemKind `protobuf:"varint,2,opt,name=kind,proto3,enum=lession.v1.QuizItemKind" json:"kind,omitempty"`
+	// needs_review restricts the items to those awaiting an editor.
+	NeedsReview   bool `protobuf:"varint,3,opt,name=needs_review,json=needsReview,proto3" json:"needs_review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuizItemsRequest) Reset() {
+	*x = ListQuizItemsRequest{}
+	mi := &file_lession_v1_quiz_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuizItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuizItemsRequest) ProtoMessage() {}
+
+func (x *ListQuizItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_quiz_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuizItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListQuizItemsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_quiz_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListQuizItemsRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *ListQuizItemsRequest) GetKind() QuizItemKind {
+	if x != nil {
+		return x.Kind
+	}
+	return QuizItemKind_QUIZ_ITEM_KIND_UNSPECIFIED
+}
+
+func (x *ListQuizItemsRequest) GetNeedsReview() bool {
+	if x != nil {
+		return x.NeedsReview
+	}
+	return false
+}
+
+// ListQuizItemsResponse returns the matching items.
+type ListQuizItemsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// items contains the quiz items ordered by kind and position.
+	Items         []*QuizItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuizItemsResponse) Reset() {
+	*x = ListQuizItemsResponse{}
+	mi := &file_lession_v1_quiz_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuizItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuizItemsResponse) ProtoMessage() {}
+
+func (x *ListQuizItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_quiz_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuizItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListQuizItemsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_quiz_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListQuizItemsResponse) GetItems() []*QuizItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// UpdateQuizItemRequest applies a partial update to a quiz item.
+type UpdateQuizItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// quiz_item_id references the target item.
+	QuizItemId string `protobuf:"bytes,1,opt,name=quiz_item_id,json=quizItemId,proto3" json:"quiz_item_id,omitempty"`
+	// item contains the fields to update.
+	Item *QuizItem `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	// update_mask indicates which of prompt, answer, position and needs_review
+	// should be applied; all of them when empty.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateQuizItemRequest) Reset() {
+	*x = UpdateQuizItemRequest{}
+	mi := &file_lession_v1_quiz_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQuizItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQuizItemRequest) ProtoMessage() {}
+
+func (x *UpdateQuizItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_quiz_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQuizItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQuizItemRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_quiz_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateQuizItemRequest) GetQuizItemId() string {
+	if x != nil {
+		return x.QuizItemId
+	}
+	return ""
+}
+
+func (x *UpdateQuizItemRequest) GetItem() *QuizItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *UpdateQuizItemRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateQuizItemResponse returns the updated item.
+type UpdateQuizItemResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// item is the updated quiz item.
+	Item          *QuizItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateQuizItemResponse) Reset() {
+	*x = UpdateQuizItemResponse{}
+	mi := &file_lession_v1_quiz_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQuizItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQuizItemResponse) ProtoMessage() {}
+
+func (x *UpdateQuizItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_quiz_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQuizItemResponse.ProtoReflect.Descriptor instead.
+func (*UpdateQuizItemResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_quiz_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateQuizItemResponse) GetItem() *QuizItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+var File_lession_v1_quiz_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_quiz_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1dlession/v1/quiz_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x15lession/v1/quiz.proto\"j\n" +
+	"\x19GenerateClozeItemsRequest\x12'\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tepisodeId\x12$\n" +
+	"\tmax_items\x18\x02 \x01(\rB\a\xbaH\x04*\x02\x182R\bmaxItems\"H\n" +
+	"\x1aGenerateClozeItemsResponse\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.lession.v1.QuizItemR\x05items\"\x9a\x01\n" +
+	"\x14ListQuizItemsRequest\x12'\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tepisodeId\x126\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x18.lession.v1.QuizItemKindB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04kind\x12!\n" +
+	"\fneeds_review\x18\x03 \x01(\bR\vneedsReview\"C\n" +
+	"\x15ListQuizItemsResponse\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.lession.v1.QuizItemR\x05items\"\xb2\x01\n" +
+	"\x15UpdateQuizItemRequest\x12*\n" +
+	"\fquiz_item_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"quizItemId\x120\n" +
+	"\x04item\x18\x02 \x01(\v2\x14.lession.v1.QuizItemB\x06\xbaH\x03\xc8\x01\x01R\x04item\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"B\n" +
+	"\x16UpdateQuizItemResponse\x12(\n" +
+	"\x04item\x18\x01 \x01(\v2\x14.lession.v1.QuizItemR\x04item2\xa1\x02\n" +
+	"\vQuizService\x12c\n" +
+	"\x12GenerateClozeItems\x12%.lession.v1.GenerateClozeItemsRequest\x1a&.lession.v1.GenerateClozeItemsResponse\x12T\n" +
+	"\rListQuizItems\x12 .lession.v1.ListQuizItemsRequest\x1a!.lession.v1.ListQuizItemsResponse\x12W\n" +
+	"\x0eUpdateQuizItem\x12!.lession.v1.UpdateQuizItemRequest\x1a\".lession.v1.UpdateQuizItemResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_quiz_service_proto_rawDescOnce sync.Once
+	file_lession_v1_quiz_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_quiz_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_quiz_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_quiz_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_quiz_service_proto_rawDesc), len(file_lession_v1_quiz_service_proto_rawDesc)))
+	})
+	return file_lession_v1_quiz_service_proto_rawDescData
+}
+
+var file_lession_v1_quiz_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_lession_v1_quiz_service_proto_goTypes = []any{
+	(*GenerateClozeItemsRequest)(nil),  // 0: lession.v1.GenerateClozeItemsRequest
+	(*GenerateClozeItemsResponse)(nil), // 1: lession.v1.GenerateClozeItemsResponse
+	(*ListQuizItemsRequest)(nil),       // 2: lession.v1.ListQuizItemsRequest
+	(*ListQuizItemsResponse)(nil),      // 3: lession.v1.ListQuizItemsResponse
+	(*UpdateQuizItemRequest)(nil),      // 4: lession.v1.UpdateQuizItemRequest
+	(*UpdateQuizItemResponse)(nil),     // 5: lession.v1.UpdateQuizItemResponse
+	(*QuizItem)(nil),                   // 6: lession.v1.QuizItem
+	(QuizItemKind)(0),                  // 7: lession.v1.QuizItemKind
+	(*fieldmaskpb.FieldMask)(nil),      // 8: google.protobuf.FieldMask
+}
+var file_lession_v1_quiz_service_proto_depIdxs = []int32{
+	6, // 0: lession.v1.GenerateClozeItemsResponse.items:type_name -> lession.v1.QuizItem
+	7, // 1: lession.v1.ListQuizItemsRequest.kind:type_name -> lession.v1.QuizItemKind
+	6, // 2: lession.v1.ListQuizItemsResponse.items:type_name -> lession.v1.QuizItem
+	6, // 3: lession.v1.UpdateQuizItemRequest.item:type_name -> lession.v1.QuizItem
+	8, // 4: lession.v1.UpdateQuizItemRequest.update_mask:type_name -> google.protobuf.FieldMask
+	6, // 5: lession.v1.UpdateQuizItemResponse.item:type_name -> lession.v1.QuizItem
+	0, // 6: lession.v1.QuizService.GenerateClozeItems:input_type -> lession.v1.GenerateClozeItemsRequest
+	2, // 7: lession.v1.QuizService.ListQuizItems:input_type -> lession.v1.ListQuizItemsRequest
+	4, // 8: lession.v1.QuizService.UpdateQuizItem:input_type -> lession.v1.UpdateQuizItemRequest
+	1, // 9: lession.v1.QuizService.GenerateClozeItems:output_type -> lession.v1.GenerateClozeItemsResponse
+	3, // 10: lession.v1.QuizService.ListQuizItems:output_type -> lession.v1.ListQuizItemsResponse
+	5, // 11: lession.v1.QuizService.UpdateQuizItem:output_type -> lession.v1.UpdateQuizItemResponse
+	9, // [9:12] is the sub-list for method output_type
+	6, // [6:9] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_quiz_service_proto_init() }
+func file_lession_v1_quiz_service_proto_init() {
+	if File_lession_v1_quiz_service_proto != nil {
+		return
+	}
+	file_lession_v1_quiz_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_quiz_service_proto_rawDesc), len(file_lession_v1_quiz_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_quiz_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_quiz_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_quiz_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_quiz_service_proto = out.File
+	file_lession_v1_quiz_service_proto_goTypes = nil
+	file_lession_v1_quiz_service_proto_depIdxs = nil
+}
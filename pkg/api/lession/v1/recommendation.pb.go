@@ -0,0 +1,148 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/recommendation.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Recommendation is a ranked series suggestion for a learner.
+type Recommendation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// series is the recommended series, without episodes.
+	Series *Series `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	// score is the relevance score; higher ranks first.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// reasons explains which signals contributed to the score.
+	Reasons       []string `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recommendation) Reset() {
+	*x = Recommendation{}
+	mi := &file_lession_v1_recommendation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recommendation) ProtoMessage() {}
+
+func (x *Recommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_recommendation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recommendation.ProtoReflect.Descriptor instead.
+func (*Recommendation) Descriptor() ([]byte, []int) {
+	return file_lession_v1_recommendation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Recommendation) GetSeries() *Series {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *Recommendation) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Recommendation) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+var File_lession_v1_recommendation_proto protoreflect.FileDescriptor
+
+const file_lession_v1_recommendation_proto_rawDesc = "" +
+	"\n" +
+	"\x1flession/v1/recommendation.proto\x12\n" +
+	"lession.v1\x1a\x17lession/v1/series.proto\"l\n" +
+	"\x0eRecommendation\x12*\n" +
+	"\x06series\x18\x01 \x01(\v2\x12.lession.v1.SeriesR\x06series\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x18\n" +
+	"\areasons\x18\x03 \x03(\tR\areasonsB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_recommendation_proto_rawDescOnce sync.Once
+	file_lession_v1_recommendation_proto_rawDescData []byte
+)
+
+func file_lession_v1_recommendation_proto_rawDescGZIP() []byte {
+	file_lession_v1_recommendation_proto_rawDescOnce.Do(func() {
+		file_lession_v1_recommendation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_recommendation_proto_rawDesc), len(file_lession_v1_recommendation_proto_rawDesc)))
+	})
+	return file_lession_v1_recommendation_proto_rawDescData
+}
+
+var file_lession_v1_recommendation_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_lession_v1_recommendation_proto_goTypes = []any{
+	(*Recommendation)(nil), // 0: lession.v1.Recommendation
+	(*Series)(nil),         // 1: lession.v1.Series
+}
+var file_lession_v1_recommendation_proto_depIdxs = []int32{
+	1, // 0: lession.v1.Recommendation.series:type_name -> lession.v1.Series
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_recommendation_proto_init() }
+func file_lession_v1_recommendation_proto_init() {
+	if File_lession_v1_recommendation_proto != nil {
+		return
+	}
+	file_lession_v1_series_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_recommendation_proto_rawDesc), len(file_lession_v1_recommendation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_recommendation_proto_goTypes,
+		DependencyIndexes: file_lession_v1_recommendation_proto_depIdxs,
+		MessageInfos:      file_lession_v1_recommendation_proto_msgTypes,
+	}.Build()
+	File_lession_v1_recommendation_proto = out.File
+	file_lession_v1_recommendation_proto_goTypes = nil
+	file_lession_v1_recommendation_proto_depIdxs = nil
+}
@@ -0,0 +1,202 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/recommendation_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetRecommendationsRequest selects the learner to recommend series for.
+type GetRecommendationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// level overrides the level inferred from the learner's watch history.
+	Level string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	// limit caps the number of recommendations; defaults to 10.
+	Limit         uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendationsRequest) Reset() {
+	*x = GetRecommendationsRequest{}
+	mi := &file_lession_v1_recommendation_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsRequest) ProtoMessage() {}
+
+func (x *GetRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_recommendation_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_recommendation_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetRecommendationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetRecommendationsRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *GetRecommendationsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// GetRecommendationsResponse returns the ranked recommendations.
+type GetRecommendationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// recommendations are ordered from most to least relevant.
+	Recommendations []*Recommendation `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetRecommendationsResponse) Reset() {
+	*x = GetRecommendationsResponse{}
+	mi := &file_lession_v1_recommendation_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsResponse) ProtoMessage() {}
+
+func (x *GetRecommendationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_recommendation_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsResponse.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_recommendation_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRecommendationsResponse) GetRecommendations() []*Recommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+var File_lession_v1_recommendation_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_recommendation_service_proto_rawDesc = "" +
+	"\n" +
+	"'lession/v1/recommendation_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1flession/v1/recommendation.proto\"r\n" +
+	"\x19GetRecommendationsRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\tR\x05level\x12\x1d\n" +
+	"\x05limit\x18\x03 \x01(\rB\a\xbaH\x04*\x02\x182R\x05limit\"b\n" +
+	"\x1aGetRecommendationsResponse\x12D\n" +
+	"\x0frecommendations\x18\x01 \x03(\v2\x1a.lession.v1.RecommendationR\x0frecommendations2|\n" +
+	"\x15RecommendationService\x12c\n" +
+	"\x12GetRecommendations\x12%.lession.v1.GetRecommendationsRequest\x1a&.lession.v1.GetRecommendationsResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_recommendation_service_proto_rawDescOnce sync.Once
+	file_lession_v1_recommendation_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_recommendation_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_recommendation_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_recommendation_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_recommendation_service_proto_rawDesc), len(file_lession_v1_recommendation_service_proto_rawDesc)))
+	})
+	return file_lession_v1_recommendation_service_proto_rawDescData
+}
+
+var file_lession_v1_recommendation_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_lession_v1_recommendation_service_proto_goTypes = []any{
+	(*GetRecommendationsRequest)(nil),  // 0: lession.v1.GetRecommendationsRequest
+	(*GetRecommendationsResponse)(nil), // 1: lession.v1.GetRecommendationsResponse
+	(*Recommendation)(nil),             // 2: lession.v1.Recommendation
+}
+var file_lession_v1_recommendation_service_proto_depIdxs = []int32{
+	2, // 0: lession.v1.GetRecommendationsResponse.recommendations:type_name -> lession.v1.Recommendation
+	0, // 1: lession.v1.RecommendationService.GetRecommendations:input_type -> lession.v1.GetRecommendationsRequest
+	1, // 2: lession.v1.RecommendationService.GetRecommendations:output_type -> lession.v1.GetRecommendationsResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_recommendation_service_proto_init() }
+func file_lession_v1_recommendation_service_proto_init() {
+	if File_lession_v1_recommendation_service_proto != nil {
+		return
+	}
+	file_lession_v1_recommendation_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_recommendation_service_proto_rawDesc), len(file_lession_v1_recommendation_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_recommendation_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_recommendation_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_recommendation_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_recommendation_service_proto = out.File
+	file_lession_v1_recommendation_service_proto_goTypes = nil
+	file_lession_v1_recommendation_service_proto_depIdxs = nil
+}
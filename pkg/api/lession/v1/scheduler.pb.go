@@ -0,0 +1,266 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/scheduler.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ScheduledTaskStatus enumerates outcomes of a scheduled task run.
+type ScheduledTaskStatus int32
+
+const (
+	// SCHEDULED_TASK_STATUS_UNSPECIFIED indicates the task has not run yet.
+	ScheduledTaskStatus_SCHEDULED_TASK_STATUS_UNSPECIFIED ScheduledTaskStatus = 0
+	// SCHEDULED_TASK_STATUS_SUCCEEDED indicates the last run completed.
+	ScheduledTaskStatus_SCHEDULED_TASK_STATUS_SUCCEEDED ScheduledTaskStatus = 1
+	// SCHEDULED_TASK_STATUS_FAILED indicates the last run returned an error.
+	ScheduledTaskStatus_SCHEDULED_TASK_STATUS_FAILED ScheduledTaskStatus = 2
+)
+
+// Enum value maps for ScheduledTaskStatus.
+var (
+	ScheduledTaskStatus_name = map[int32]string{
+		0: "SCHEDULED_TASK_STATUS_UNSPECIFIED",
+		1: "SCHEDULED_TASK_STATUS_SUCCEEDED",
+		2: "SCHEDULED_TASK_STATUS_FAILED",
+	}
+	ScheduledTaskStatus_value = map[string]int32{
+		"SCHEDULED_TASK_STATUS_UNSPECIFIED": 0,
+		"SCHEDULED_TASK_STATUS_SUCCEEDED":   1,
+		"SCHEDULED_TASK_STATUS_FAILED":      2,
+	}
+)
+
+func (x ScheduledTaskStatus) Enum() *ScheduledTaskStatus {
+	p := new(ScheduledTaskStatus)
+	*p = x
+	return p
+}
+
+func (x ScheduledTaskStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduledTaskStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_scheduler_proto_enumTypes[0].Descriptor()
+}
+
+func (ScheduledTaskStatus) Type() protoreflect.EnumType {
+	return &file_lession_v1_scheduler_proto_enumTypes[0]
+}
+
+func (x ScheduledTaskStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduledTaskStatus.Descriptor instead.
+func (ScheduledTaskStatus) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_scheduler_proto_rawDescGZIP(), []int{0}
+}
+
+// ScheduledTask is a periodic maintenance task run by the workers.
+type ScheduledTask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name identifies the task, e.g. "upload_expiry".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// interval is the time between runs.
+	Interval *durationpb.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// next_run_at is when the task becomes due again.
+	NextRunAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	// last_run_at records when the last run started.
+	LastRunAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	// last_status reports the outcome of the last run.
+	LastStatus ScheduledTaskStatus `protobuf:"varint,5,opt,name=last_status,json=lastStatus,proto3,enum=lession.v1.ScheduledTaskStatus" json:"last_status,omitempty"`
+	// last_error describes why the last run failed.
+	LastError string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// last_duration is how long the last run took.
+	LastDuration *durationpb.Duration `protobuf:"bytes,7,opt,name=last_duration,json=lastDuration,proto3" json:"last_duration,omitempty"`
+	// locked_by names the worker currently running the task.
+	LockedBy      string `protobuf:"bytes,8,opt,name=locked_by,json=lockedBy,proto3" json:"locked_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledTask) Reset() {
+	*x = ScheduledTask{}
+	mi := &file_lession_v1_scheduler_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledTask) ProtoMessage() {}
+
+func (x *ScheduledTask) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_scheduler_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledTask.ProtoReflect.Descriptor instead.
+func (*ScheduledTask) Descriptor() ([]byte, []int) {
+	return file_lession_v1_scheduler_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ScheduledTask) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScheduledTask) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *ScheduledTask) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
+func (x *ScheduledTask) GetLastRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return nil
+}
+
+func (x *ScheduledTask) GetLastStatus() ScheduledTaskStatus {
+	if x != nil {
+		return x.LastStatus
+	}
+	return ScheduledTaskStatus_SCHEDULED_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *ScheduledTask) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ScheduledTask) GetLastDuration() *durationpb.Duration {
+	if x != nil {
+		return x.LastDuration
+	}
+	return nil
+}
+
+func (x *ScheduledTask) GetLockedBy() string {
+	if x != nil {
+		return x.LockedBy
+	}
+	return ""
+}
+
+var File_lession_v1_scheduler_proto protoreflect.FileDescriptor
+
+const file_lession_v1_scheduler_proto_rawDesc = "" +
+	"\n" +
+	"\x1alession/v1/scheduler.proto\x12\n" +
+	"lession.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x90\x03\n" +
+	"\rScheduledTask\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x125\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12:\n" +
+	"\vnext_run_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tnextRunAt\x12:\n" +
+	"\vlast_run_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tlastRunAt\x12@\n" +
+	"\vlast_status\x18\x05 \x01(\x0e2\x1f.lession.v1.ScheduledTaskStatusR\n" +
+	"lastStatus\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x12>\n" +
+	"\rlast_duration\x18\a \x01(\v2\x19.google.protobuf.DurationR\flastDuration\x12\x1b\n" +
+	"\tlocked_by\x18\b \x01(\tR\blockedBy*\x83\x01\n" +
+	"\x13ScheduledTaskStatus\x12%\n" +
+	"!SCHEDULED_TASK_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fSCHEDULED_TASK_STATUS_SUCCEEDED\x10\x01\x12 \n" +
+	"\x1cSCHEDULED_TASK_STATUS_FAILED\x10\x02B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_scheduler_proto_rawDescOnce sync.Once
+	file_lession_v1_scheduler_proto_rawDescData []byte
+)
+
+func file_lession_v1_scheduler_proto_rawDescGZIP() []byte {
+	file_lession_v1_scheduler_proto_rawDescOnce.Do(func() {
+		file_lession_v1_scheduler_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_scheduler_proto_rawDesc), len(file_lession_v1_scheduler_proto_rawDesc)))
+	})
+	return file_lession_v1_scheduler_proto_rawDescData
+}
+
+var file_lession_v1_scheduler_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lession_v1_scheduler_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_lession_v1_scheduler_proto_goTypes = []any{
+	(ScheduledTaskStatus)(0),      // 0: lession.v1.ScheduledTaskStatus
+	(*ScheduledTask)(nil),         // 1: lession.v1.ScheduledTask
+	(*durationpb.Duration)(nil),   // 2: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_lession_v1_scheduler_proto_depIdxs = []int32{
+	2, // 0: lession.v1.ScheduledTask.interval:type_name -> google.protobuf.Duration
+	3, // 1: lession.v1.ScheduledTask.next_run_at:type_name -> google.protobuf.Timestamp
+	3, // 2: lession.v1.ScheduledTask.last_run_at:type_name -> google.protobuf.Timestamp
+	0, // 3: lession.v1.ScheduledTask.last_status:type_name -> lession.v1.ScheduledTaskStatus
+	2, // 4: lession.v1.ScheduledTask.last_duration:type_name -> google.protobuf.Duration
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_scheduler_proto_init() }
+func file_lession_v1_scheduler_proto_init() {
+	if File_lession_v1_scheduler_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_scheduler_proto_rawDesc), len(file_lession_v1_scheduler_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_scheduler_proto_goTypes,
+		DependencyIndexes: file_lession_v1_scheduler_proto_depIdxs,
+		EnumInfos:         file_lession_v1_scheduler_proto_enumTypes,
+		MessageInfos:      file_lession_v1_scheduler_proto_msgTypes,
+	}.Build()
+	File_lession_v1_scheduler_proto = out.File
+	file_lession_v1_scheduler_proto_goTypes = nil
+	file_lession_v1_scheduler_proto_depIdxs = nil
+}
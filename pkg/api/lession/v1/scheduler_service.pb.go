@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/scheduler_service.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ListScheduledTasksRequest is empty; the task list is small and unpaginated.
+type ListScheduledTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledTasksRequest) Reset() {
+	*x = ListScheduledTasksRequest{}
+	mi := &file_lession_v1_scheduler_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTasksRequest) ProtoMessage() {}
+
+func (x *ListScheduledTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_scheduler_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledTasksRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_scheduler_service_proto_rawDescGZIP(), []int{0}
+}
+
+// ListScheduledTasksResponse returns the scheduled tasks ordered by name.
+type ListScheduledTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tasks contains every registered task.
+	Tasks         []*ScheduledTask `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledTasksResponse) Reset() {
+	*x = ListScheduledTasksResponse{}
+	mi := &file_lession_v1_scheduler_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTasksResponse) ProtoMessage() {}
+
+func (x *ListScheduledTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_scheduler_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledTasksResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_scheduler_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListScheduledTasksResponse) GetTasks() []*ScheduledTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+var File_lession_v1_scheduler_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_scheduler_service_proto_rawDesc = "" +
+	"\n" +
+	"\"lession/v1/scheduler_service.proto\x12\n" +
+	"lession.v1\x1a\x1alession/v1/scheduler.proto\"\x1b\n" +
+	"\x19ListScheduledTasksRequest\"M\n" +
+	"\x1aListScheduledTasksResponse\x12/\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x19.lession.v1.ScheduledTaskR\x05tasks2w\n" +
+	"\x10SchedulerService\x12c\n" +
+	"\x12ListScheduledTasks\x12%.lession.v1.ListScheduledTasksRequest\x1a&.lession.v1.ListScheduledTasksResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_scheduler_service_proto_rawDescOnce sync.Once
+	file_lession_v1_scheduler_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_scheduler_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_scheduler_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_scheduler_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_scheduler_service_proto_rawDesc), len(file_lession_v1_scheduler_service_proto_rawDesc)))
+	})
+	return file_lession_v1_scheduler_service_proto_rawDescData
+}
+
+var file_lession_v1_scheduler_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_lession_v1_scheduler_service_proto_goTypes = []any{
+	(*ListScheduledTasksRequest)(nil),  // 0: lession.v1.ListScheduledTasksRequest
+	(*ListScheduledTasksResponse)(nil), // 1: lession.v1.ListScheduledTasksResponse
+	(*ScheduledTask)(nil),              // 2: lession.v1.ScheduledTask
+}
+var file_lession_v1_scheduler_service_proto_depIdxs = []int32{
+	2, // 0: lession.v1.ListScheduledTasksResponse.tasks:type_name -> lession.v1.ScheduledTask
+	0, // 1: lession.v1.SchedulerService.ListScheduledTasks:input_type -> lession.v1.ListScheduledTasksRequest
+	1, // 2: lession.v1.SchedulerService.ListScheduledTasks:output_type -> lession.v1.ListScheduledTasksResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_scheduler_service_proto_init() }
+func file_lession_v1_scheduler_service_proto_init() {
+	if File_lession_v1_scheduler_service_proto != nil {
+		return
+	}
+	file_lession_v1_scheduler_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_scheduler_service_proto_rawDesc), len(file_lession_v1_scheduler_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_scheduler_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_scheduler_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_scheduler_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_scheduler_service_proto = out.File
+	file_lession_v1_scheduler_service_proto_goTypes = nil
+	file_lession_v1_scheduler_service_proto_depIdxs = nil
+}
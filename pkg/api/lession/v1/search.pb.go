@@ -0,0 +1,238 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/search.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SearchKind tells series and episodes apart in search results.
+type SearchKind int32
+
+const (
+	// SEARCH_KIND_UNSPECIFIED is the default zero value.
+	SearchKind_SEARCH_KIND_UNSPECIFIED SearchKind = 0
+	// SEARCH_KIND_SERIES marks a series.
+	SearchKind_SEARCH_KIND_SERIES SearchKind = 1
+	// SEARCH_KIND_EPISODE marks an episode.
+	SearchKind_SEARCH_KIND_EPISODE SearchKind = 2
+)
+
+// Enum value maps for SearchKind.
+var (
+	SearchKind_name = map[int32]string{
+		0: "SEARCH_KIND_UNSPECIFIED",
+		1: "SEARCH_KIND_SERIES",
+		2: "SEARCH_KIND_EPISODE",
+	}
+	SearchKind_value = map[string]int32{
+		"SEARCH_KIND_UNSPECIFIED": 0,
+		"SEARCH_KIND_SERIES":      1,
+		"SEARCH_KIND_EPISODE":     2,
+	}
+)
+
+func (x SearchKind) Enum() *SearchKind {
+	p := new(SearchKind)
+	*p = x
+	return p
+}
+
+func (x SearchKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_search_proto_enumTypes[0].Descriptor()
+}
+
+func (SearchKind) Type() protoreflect.EnumType {
+	return &file_lession_v1_search_proto_enumTypes[0]
+}
+
+func (x SearchKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchKind.Descriptor instead.
+func (SearchKind) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_search_proto_rawDescGZIP(), []int{0}
+}
+
+// SearchHit is a published series or episode matching a search.
+type SearchHit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kind tells whether the hit is a series or an episode.
+	Kind SearchKind `protobuf:"varint,1,opt,name=kind,proto3,enum=lession.v1.SearchKind" json:"kind,omitempty"`
+	// series_id identifies the series, or the series of the episode.
+	SeriesId string `protobuf:"bytes,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// episode_id identifies the episode of episode hits.
+	EpisodeId string `protobuf:"bytes,3,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// title is the title of the series or episode.
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// snippet is an excerpt of the matching text.
+	Snippet string `protobuf:"bytes,5,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// score ranks the hit; higher ranks first. Scores depend on the search engine.
+	Score         float64 `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_lession_v1_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_lession_v1_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchHit) GetKind() SearchKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SearchKind_SEARCH_KIND_UNSPECIFIED
+}
+
+func (x *SearchHit) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *SearchHit) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *SearchHit) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+var File_lession_v1_search_proto protoreflect.FileDescriptor
+
+const file_lession_v1_search_proto_rawDesc = "" +
+	"\n" +
+	"\x17lession/v1/search.proto\x12\n" +
+	"lession.v1\"\xb9\x01\n" +
+	"\tSearchHit\x12*\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x16.lession.v1.SearchKindR\x04kind\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\tR\bseriesId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x03 \x01(\tR\tepisodeId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\asnippet\x18\x05 \x01(\tR\asnippet\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x01R\x05score*Z\n" +
+	"\n" +
+	"SearchKind\x12\x1b\n" +
+	"\x17SEARCH_KIND_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12SEARCH_KIND_SERIES\x10\x01\x12\x17\n" +
+	"\x13SEARCH_KIND_EPISODE\x10\x02B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_search_proto_rawDescOnce sync.Once
+	file_lession_v1_search_proto_rawDescData []byte
+)
+
+func file_lession_v1_search_proto_rawDescGZIP() []byte {
+	file_lession_v1_search_proto_rawDescOnce.Do(func() {
+		file_lession_v1_search_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_search_proto_rawDesc), len(file_lession_v1_search_proto_rawDesc)))
+	})
+	return file_lession_v1_search_proto_rawDescData
+}
+
+var file_lession_v1_search_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lession_v1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_lession_v1_search_proto_goTypes = []any{
+	(SearchKind)(0),   // 0: lession.v1.SearchKind
+	(*SearchHit)(nil), // 1: lession.v1.SearchHit
+}
+var file_lession_v1_search_proto_depIdxs = []int32{
+	0, // 0: lession.v1.SearchHit.kind:type_name -> lession.v1.SearchKind
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_search_proto_init() }
+func file_lession_v1_search_proto_init() {
+	if File_lession_v1_search_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_search_proto_rawDesc), len(file_lession_v1_search_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_search_proto_goTypes,
+		DependencyIndexes: file_lession_v1_search_proto_depIdxs,
+		EnumInfos:         file_lession_v1_search_proto_enumTypes,
+		MessageInfos:      file_lession_v1_search_proto_msgTypes,
+	}.Build()
+	File_lession_v1_search_proto = out.File
+	file_lession_v1_search_proto_goTypes = nil
+	file_lession_v1_search_proto_depIdxs = nil
+}
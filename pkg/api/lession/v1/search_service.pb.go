@@ -0,0 +1,544 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/search_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SearchContentRequest carries the query and filters of a search.
+type SearchContentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query is the text to search titles, summaries, descriptions and transcripts for.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// kinds limits the results to series or episodes; both are returned when empty.
+	Kinds []SearchKind `protobuf:"varint,2,rep,packed,name=kinds,proto3,enum=lession.v1.SearchKind" json:"kinds,omitempty"`
+	// language filters by the primary locale of the series.
+	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	// level filters by the difficulty level of the series.
+	Level string `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`
+	// tags filters by series carrying any of the supplied tags.
+	Tags []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// page_size limits the number of returned hits; defaults to 20.
+	PageSize uint32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token continues a prior SearchContent response.
+	PageToken     string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchContentRequest) Reset() {
+	*x = SearchContentRequest{}
+	mi := &file_lession_v1_search_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchContentRequest) ProtoMessage() {}
+
+func (x *SearchContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_search_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchContentRequest.ProtoReflect.Descriptor instead.
+func (*SearchContentRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_search_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchContentRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchContentRequest) GetKinds() []SearchKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *SearchContentRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SearchContentRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *SearchContentRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchContentRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchContentRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// SearchContentResponse returns a page of hits.
+type SearchContentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// hits are ordered from best to worst match.
+	Hits []*SearchHit `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	// next_page_token is supplied when more hits are available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchContentResponse) Reset() {
+	*x = SearchContentResponse{}
+	mi := &file_lession_v1_search_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchContentResponse) ProtoMessage() {}
+
+func (x *SearchContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_search_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchContentResponse.ProtoReflect.Descriptor instead.
+func (*SearchContentResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_search_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchContentResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchContentResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// SemanticSearchRequest carries the query and filters of a semantic search.
+type SemanticSearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query describes what the content should be about, in any wording.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// kinds limits the results to series or episodes; both are returned when empty.
+	Kinds []SearchKind `protobuf:"varint,2,rep,packed,name=kinds,proto3,enum=lession.v1.SearchKind" json:"kinds,omitempty"`
+	// language filters by the primary locale of the series.
+	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	// level filters by the difficulty level of the series.
+	Level string `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`
+	// tags filters by series carrying any of the supplied tags.
+	Tags []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// page_size limits the number of returned hits; defaults to 20.
+	PageSize uint32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token continues a prior SemanticSearch response.
+	PageToken     string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SemanticSearchRequest) Reset() {
+	*x = SemanticSearchRequest{}
+	mi := &file_lession_v1_search_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SemanticSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SemanticSearchRequest) ProtoMessage() {}
+
+func (x *SemanticSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_search_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SemanticSearchRequest.ProtoReflect.Descriptor instead.
+func (*SemanticSearchRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_search_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SemanticSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SemanticSearchRequest) GetKinds() []SearchKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *SemanticSearchRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SemanticSearchRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *SemanticSearchRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SemanticSearchRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SemanticSearchRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// SemanticSearchResponse returns a page of hits.
+type SemanticSearchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// hits are ordered from closest to furthest in meaning; score is the cosine similarity.
+	Hits []*SearchHit `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	// next_page_token is supplied when more hits are available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SemanticSearchResponse) Reset() {
+	*x = SemanticSearchResponse{}
+	mi := &file_lession_v1_search_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SemanticSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SemanticSearchResponse) ProtoMessage() {}
+
+func (x *SemanticSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_search_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SemanticSearchResponse.ProtoReflect.Descriptor instead.
+func (*SemanticSearchResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_search_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SemanticSearchResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SemanticSearchResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// FindSimilarEpisodesRequest identifies the episode to find neighbours of.
+type FindSimilarEpisodesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// episode_id identifies a published episode.
+	EpisodeId string `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// limit caps the number of returned episodes; defaults to 10.
+	Limit         uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindSimilarEpisodesRequest) Reset() {
+	*x = FindSimilarEpisodesRequest{}
+	mi := &file_lession_v1_search_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindSimilarEpisodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindSimilarEpisodesRequest) ProtoMessage() {}
+
+func (x *FindSimilarEpisodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_search_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSimilarEpisodesRequest.ProtoReflect.Descriptor instead.
+func (*FindSimilarEpisodesRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_search_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FindSimilarEpisodesRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *FindSimilarEpisodesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// FindSimilarEpisodesResponse lists the similar episodes.
+type FindSimilarEpisodesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// hits are ordered from most to least similar, excluding the episode itself.
+	Hits          []*SearchHit `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindSimilarEpisodesResponse) Reset() {
+	*x = FindSimilarEpisodesResponse{}
+	mi := &file_lession_v1_search_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindSimilarEpisodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindSimilarEpisodesResponse) ProtoMessage() {}
+
+func (x *FindSimilarEpisodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_search_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSimilarEpisodesResponse.ProtoReflect.Descriptor instead.
+func (*FindSimilarEpisodesResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_search_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FindSimilarEpisodesResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+var File_lession_v1_search_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_search_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1flession/v1/search_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x17lession/v1/search.proto\"\xb4\x02\n" +
+	"\x14SearchContentRequest\x12 \n" +
+	"\x05query\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x02R\x05query\x12=\n" +
+	"\x05kinds\x18\x02 \x03(\x0e2\x16.lession.v1.SearchKindB\x0f\xbaH\f\x92\x01\t\"\a\x82\x01\x04\x10\x01 \x00R\x05kinds\x123\n" +
+	"\blanguage\x18\x03 \x01(\tB\x17\xbaH\x14\xd8\x01\x01r\x0f2\r^[a-zA-Z]{2}$R\blanguage\x12\x1d\n" +
+	"\x05level\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18@R\x05level\x12\"\n" +
+	"\x04tags\x18\x05 \x03(\tB\x0e\xbaH\v\x92\x01\b\"\x06r\x04\x10\x01\x18@R\x04tags\x12$\n" +
+	"\tpage_size\x18\x06 \x01(\rB\a\xbaH\x04*\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"j\n" +
+	"\x15SearchContentResponse\x12)\n" +
+	"\x04hits\x18\x01 \x03(\v2\x15.lession.v1.SearchHitR\x04hits\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xb5\x02\n" +
+	"\x15SemanticSearchRequest\x12 \n" +
+	"\x05query\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\bR\x05query\x12=\n" +
+	"\x05kinds\x18\x02 \x03(\x0e2\x16.lession.v1.SearchKindB\x0f\xbaH\f\x92\x01\t\"\a\x82\x01\x04\x10\x01 \x00R\x05kinds\x123\n" +
+	"\blanguage\x18\x03 \x01(\tB\x17\xbaH\x14\xd8\x01\x01r\x0f2\r^[a-zA-Z]{2}$R\blanguage\x12\x1d\n" +
+	"\x05level\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18@R\x05level\x12\"\n" +
+	"\x04tags\x18\x05 \x03(\tB\x0e\xbaH\v\x92\x01\b\"\x06r\x04\x10\x01\x18@R\x04tags\x12$\n" +
+	"\tpage_size\x18\x06 \x01(\rB\a\xbaH\x04*\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"k\n" +
+	"\x16SemanticSearchResponse\x12)\n" +
+	"\x04hits\x18\x01 \x03(\v2\x15.lession.v1.SearchHitR\x04hits\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"d\n" +
+	"\x1aFindSimilarEpisodesRequest\x12'\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tepisodeId\x12\x1d\n" +
+	"\x05limit\x18\x02 \x01(\rB\a\xbaH\x04*\x02\x182R\x05limit\"H\n" +
+	"\x1bFindSimilarEpisodesResponse\x12)\n" +
+	"\x04hits\x18\x01 \x03(\v2\x15.lession.v1.SearchHitR\x04hits2\xa6\x02\n" +
+	"\rSearchService\x12T\n" +
+	"\rSearchContent\x12 .lession.v1.SearchContentRequest\x1a!.lession.v1.SearchContentResponse\x12W\n" +
+	"\x0eSemanticSearch\x12!.lession.v1.SemanticSearchRequest\x1a\".lession.v1.SemanticSearchResponse\x12f\n" +
+	"\x13FindSimilarEpisodes\x12&.lession.v1.FindSimilarEpisodesRequest\x1a'.lession.v1.FindSimilarEpisodesResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_search_service_proto_rawDescOnce sync.Once
+	file_lession_v1_search_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_search_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_search_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_search_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_search_service_proto_rawDesc), len(file_lession_v1_search_service_proto_rawDesc)))
+	})
+	return file_lession_v1_search_service_proto_rawDescData
+}
+
+var file_lession_v1_search_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_lession_v1_search_service_proto_goTypes = []any{
+	(*SearchContentRequest)(nil),        // 0: lession.v1.SearchContentRequest
+	(*SearchContentResponse)(nil),       // 1: lession.v1.SearchContentResponse
+	(*SemanticSearchRequest)(nil),       // 2: lession.v1.SemanticSearchRequest
+	(*SemanticSearchResponse)(nil),      // 3: lession.v1.SemanticSearchResponse
+	(*FindSimilarEpisodesRequest)(nil),  // 4: lession.v1.FindSimilarEpisodesRequest
+	(*FindSimilarEpisodesResponse)(nil), // 5: lession.v1.FindSimilarEpisodesResponse
+	(SearchKind)(0),                     // 6: lession.v1.SearchKind
+	(*SearchHit)(nil),                   // 7: lession.v1.SearchHit
+}
+var file_lession_v1_search_service_proto_depIdxs = []int32{
+	6, // 0: lession.v1.SearchContentRequest.kinds:type_name -> lession.v1.SearchKind
+	7, // 1: lession.v1.SearchContentResponse.hits:type_name -> lession.v1.SearchHit
+	6, // 2: lession.v1.SemanticSearchRequest.kinds:type_name -> lession.v1.SearchKind
+	7, // 3: lession.v1.SemanticSearchResponse.hits:type_name -> lession.v1.SearchHit
+	7, // 4: lession.v1.FindSimilarEpisodesResponse.hits:type_name -> lession.v1.SearchHit
+	0, // 5: lession.v1.SearchService.SearchContent:input_type -> lession.v1.SearchContentRequest
+	2, // 6: lession.v1.SearchService.SemanticSearch:input_type -> lession.v1.SemanticSearchRequest
+	4, // 7: lession.v1.SearchService.FindSimilarEpisodes:input_type -> lession.v1.FindSimilarEpisodesRequest
+	1, // 8: lession.v1.SearchService.SearchContent:output_type -> lession.v1.SearchContentResponse
+	3, // 9: lession.v1.SearchService.SemanticSearch:output_type -> lession.v1.SemanticSearchResponse
+	5, // 10: lession.v1.SearchService.FindSimilarEpisodes:output_type -> lession.v1.FindSimilarEpisodesResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_search_service_proto_init() }
+func file_lession_v1_search_service_proto_init() {
+	if File_lession_v1_search_service_proto != nil {
+		return
+	}
+	file_lession_v1_search_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_search_service_proto_rawDesc), len(file_lession_v1_search_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_search_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_search_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_search_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_search_service_proto = out.File
+	file_lession_v1_search_service_proto_goTypes = nil
+	file_lession_v1_search_service_proto_depIdxs = nil
+}
@@ -81,6 +81,63 @@ func (SeriesStatus) EnumDescriptor() ([]byte, []int) {
 	return file_lession_v1_series_proto_rawDescGZIP(), []int{0}
 }
 
+// SeriesLayout hints how white-label frontends lay out a series page.
+type SeriesLayout int32
+
+const (
+	// SERIES_LAYOUT_UNSPECIFIED leaves the layout to the frontend.
+	SeriesLayout_SERIES_LAYOUT_UNSPECIFIED SeriesLayout = 0
+	// SERIES_LAYOUT_LIST lists the episodes in order.
+	SeriesLayout_SERIES_LAYOUT_LIST SeriesLayout = 1
+	// SERIES_LAYOUT_GRID shows the episodes as a grid of cards.
+	SeriesLayout_SERIES_LAYOUT_GRID SeriesLayout = 2
+	// SERIES_LAYOUT_FEATURED leads with the hero image and the first episode.
+	SeriesLayout_SERIES_LAYOUT_FEATURED SeriesLayout = 3
+)
+
+// Enum value maps for SeriesLayout.
+var (
+	SeriesLayout_name = map[int32]string{
+		0: "SERIES_LAYOUT_UNSPECIFIED",
+		1: "SERIES_LAYOUT_LIST",
+		2: "SERIES_LAYOUT_GRID",
+		3: "SERIES_LAYOUT_FEATURED",
+	}
+	SeriesLayout_value = map[string]int32{
+		"SERIES_LAYOUT_UNSPECIFIED": 0,
+		"SERIES_LAYOUT_LIST":        1,
+		"SERIES_LAYOUT_GRID":        2,
+		"SERIES_LAYOUT_FEATURED":    3,
+	}
+)
+
+func (x SeriesLayout) Enum() *SeriesLayout {
+	p := new(SeriesLayout)
+	*p = x
+	return p
+}
+
+func (x SeriesLayout) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeriesLayout) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_series_proto_enumTypes[1].Descriptor()
+}
+
+func (SeriesLayout) Type() protoreflect.EnumType {
+	return &file_lession_v1_series_proto_enumTypes[1]
+}
+
+func (x SeriesLayout) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeriesLayout.Descriptor instead.
+func (SeriesLayout) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_series_proto_rawDescGZIP(), []int{1}
+}
+
 // EpisodeStatus enumerates lifecycle stages for episodes.
 type EpisodeStatus int32
 
@@ -126,11 +183,11 @@ func (x EpisodeStatus) String() string {
 }
 
 func (EpisodeStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_lession_v1_series_proto_enumTypes[1].Descriptor()
+	return file_lession_v1_series_proto_enumTypes[2].Descriptor()
 }
 
 func (EpisodeStatus) Type() protoreflect.EnumType {
-	return &file_lession_v1_series_proto_enumTypes[1]
+	return &file_lession_v1_series_proto_enumTypes[2]
 }
 
 func (x EpisodeStatus) Number() protoreflect.EnumNumber {
@@ -139,7 +196,7 @@ func (x EpisodeStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EpisodeStatus.Descriptor instead.
 func (EpisodeStatus) EnumDescriptor() ([]byte, []int) {
-	return file_lession_v1_series_proto_rawDescGZIP(), []int{1}
+	return file_lession_v1_series_proto_rawDescGZIP(), []int{2}
 }
 
 // MediaType enumerates supported media asset categories.
@@ -152,6 +209,8 @@ const (
 	MediaType_MEDIA_TYPE_VIDEO MediaType = 1
 	// MEDIA_TYPE_AUDIO represents an audio-only asset.
 	MediaType_MEDIA_TYPE_AUDIO MediaType = 2
+	// MEDIA_TYPE_IMAGE represents a cover or avatar image asset.
+	MediaType_MEDIA_TYPE_IMAGE MediaType = 3
 )
 
 // Enum value maps for MediaType.
@@ -160,11 +219,13 @@ var (
 		0: "MEDIA_TYPE_UNSPECIFIED",
 		1: "MEDIA_TYPE_VIDEO",
 		2: "MEDIA_TYPE_AUDIO",
+		3: "MEDIA_TYPE_IMAGE",
 	}
 	MediaType_value = map[string]int32{
 		"MEDIA_TYPE_UNSPECIFIED": 0,
 		"MEDIA_TYPE_VIDEO":       1,
 		"MEDIA_TYPE_AUDIO":       2,
+		"MEDIA_TYPE_IMAGE":       3,
 	}
 )
 
@@ -179,11 +240,11 @@ func (x MediaType) String() string {
 }
 
 func (MediaType) Descriptor() protoreflect.EnumDescriptor {
-	return file_lession_v1_series_proto_enumTypes[2].Descriptor()
+	return file_lession_v1_series_proto_enumTypes[3].Descriptor()
 }
 
 func (MediaType) Type() protoreflect.EnumType {
-	return &file_lession_v1_series_proto_enumTypes[2]
+	return &file_lession_v1_series_proto_enumTypes[3]
 }
 
 func (x MediaType) Number() protoreflect.EnumNumber {
@@ -192,7 +253,7 @@ func (x MediaType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MediaType.Descriptor instead.
 func (MediaType) EnumDescriptor() ([]byte, []int) {
-	return file_lession_v1_series_proto_rawDescGZIP(), []int{2}
+	return file_lession_v1_series_proto_rawDescGZIP(), []int{3}
 }
 
 // TranscriptFormat enumerates supported transcript formats.
@@ -240,11 +301,11 @@ func (x TranscriptFormat) String() string {
 }
 
 func (TranscriptFormat) Descriptor() protoreflect.EnumDescriptor {
-	return file_lession_v1_series_proto_enumTypes[3].Descriptor()
+	return file_lession_v1_series_proto_enumTypes[4].Descriptor()
 }
 
 func (TranscriptFormat) Type() protoreflect.EnumType {
-	return &file_lession_v1_series_proto_enumTypes[3]
+	return &file_lession_v1_series_proto_enumTypes[4]
 }
 
 func (x TranscriptFormat) Number() protoreflect.EnumNumber {
@@ -253,7 +314,7 @@ func (x TranscriptFormat) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TranscriptFormat.Descriptor instead.
 func (TranscriptFormat) EnumDescriptor() ([]byte, []int) {
-	return file_lession_v1_series_proto_rawDescGZIP(), []int{3}
+	return file_lession_v1_series_proto_rawDescGZIP(), []int{4}
 }
 
 // Series describes a media series with optional embedded episodes.
@@ -273,8 +334,10 @@ type Series struct {
 	Level string `protobuf:"bytes,6,opt,name=level,proto3" json:"level,omitempty"`
 	// tags captures optional classification keywords.
 	Tags []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
-	// cover_url references artwork that represents the series.
+	// cover_url references artwork that represents the series: the card variant of the cover image asset when cover_asset_id is set, or a legacy external URL.
 	CoverUrl string `protobuf:"bytes,8,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
+	// cover_asset_id identifies the image asset used as the cover; its variants are listed on the asset.
+	CoverAssetId string `protobuf:"bytes,18,opt,name=cover_asset_id,json=coverAssetId,proto3" json:"cover_asset_id,omitempty"`
 	// status tracks the lifecycle stage of the series.
 	Status SeriesStatus `protobuf:"varint,9,opt,name=status,proto3,enum=lession.v1.SeriesStatus" json:"status,omitempty"`
 	// episode_count is a cached number of episodes in the series.
@@ -287,6 +350,14 @@ type Series struct {
 	PublishedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
 	// author_ids references the creators responsible for the series.
 	AuthorIds []string `protobuf:"bytes,14,rep,name=author_ids,json=authorIds,proto3" json:"author_ids,omitempty"`
+	// status_label is the localized, human-readable series status, selected by Accept-Language.
+	StatusLabel string `protobuf:"bytes,15,opt,name=status_label,json=statusLabel,proto3" json:"status_label,omitempty"`
+	// estimated_level is the CEFR level (A1-C2) estimated from the transcripts of the episodes, alongside the level set by editors.
+	EstimatedLevel string `protobuf:"bytes,16,opt,name=estimated_level,json=estimatedLevel,proto3" json:"estimated_level,omitempty"`
+	// drm_enabled encrypts the HLS renditions of the series' audio; keys are only delivered to entitled learners.
+	DrmEnabled bool `protobuf:"varint,17,opt,name=drm_enabled,json=drmEnabled,proto3" json:"drm_enabled,omitempty"`
+	// branding carries presentation metadata for white-label frontends.
+	Branding *SeriesBranding `protobuf:"bytes,19,opt,name=branding,proto3" json:"branding,omitempty"`
 	// episodes optionally contains the ordered episodes of the series.
 	Episodes      []*Episode `protobuf:"bytes,20,rep,name=episodes,proto3" json:"episodes,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -379,6 +450,13 @@ func (x *Series) GetCoverUrl() string {
 	return ""
 }
 
+func (x *Series) GetCoverAssetId() string {
+	if x != nil {
+		return x.CoverAssetId
+	}
+	return ""
+}
+
 func (x *Series) GetStatus() SeriesStatus {
 	if x != nil {
 		return x.Status
@@ -421,6 +499,34 @@ func (x *Series) GetAuthorIds() []string {
 	return nil
 }
 
+func (x *Series) GetStatusLabel() string {
+	if x != nil {
+		return x.StatusLabel
+	}
+	return ""
+}
+
+func (x *Series) GetEstimatedLevel() string {
+	if x != nil {
+		return x.EstimatedLevel
+	}
+	return ""
+}
+
+func (x *Series) GetDrmEnabled() bool {
+	if x != nil {
+		return x.DrmEnabled
+	}
+	return false
+}
+
+func (x *Series) GetBranding() *SeriesBranding {
+	if x != nil {
+		return x.Branding
+	}
+	return nil
+}
+
 func (x *Series) GetEpisodes() []*Episode {
 	if x != nil {
 		return x.Episodes
@@ -428,6 +534,79 @@ func (x *Series) GetEpisodes() []*Episode {
 	return nil
 }
 
+// SeriesBranding carries presentation metadata for white-label frontends.
+type SeriesBranding struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// accent_color is a #rrggbb colour; empty leaves the frontend's default.
+	AccentColor string `protobuf:"bytes,1,opt,name=accent_color,json=accentColor,proto3" json:"accent_color,omitempty"`
+	// hero_asset_id identifies the processed image asset shown as the hero of the series page.
+	HeroAssetId string `protobuf:"bytes,2,opt,name=hero_asset_id,json=heroAssetId,proto3" json:"hero_asset_id,omitempty"`
+	// hero_image_url is the hero variant of the hero image asset. Output only.
+	HeroImageUrl string `protobuf:"bytes,3,opt,name=hero_image_url,json=heroImageUrl,proto3" json:"hero_image_url,omitempty"`
+	// layout hints how the series page is laid out.
+	Layout        SeriesLayout `protobuf:"varint,4,opt,name=layout,proto3,enum=lession.v1.SeriesLayout" json:"layout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesBranding) Reset() {
+	*x = SeriesBranding{}
+	mi := &file_lession_v1_series_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesBranding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesBranding) ProtoMessage() {}
+
+func (x *SeriesBranding) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_series_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesBranding.ProtoReflect.Descriptor instead.
+func (*SeriesBranding) Descriptor() ([]byte, []int) {
+	return file_lession_v1_series_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SeriesBranding) GetAccentColor() string {
+	if x != nil {
+		return x.AccentColor
+	}
+	return ""
+}
+
+func (x *SeriesBranding) GetHeroAssetId() string {
+	if x != nil {
+		return x.HeroAssetId
+	}
+	return ""
+}
+
+func (x *SeriesBranding) GetHeroImageUrl() string {
+	if x != nil {
+		return x.HeroImageUrl
+	}
+	return ""
+}
+
+func (x *SeriesBranding) GetLayout() SeriesLayout {
+	if x != nil {
+		return x.Layout
+	}
+	return SeriesLayout_SERIES_LAYOUT_UNSPECIFIED
+}
+
 // Episode captures content units within a series.
 type Episode struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -454,14 +633,20 @@ type Episode struct {
 	// updated_at records when the episode was last modified.
 	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	// published_at records when the episode was first published, if applicable.
-	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	PublishedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	// status_label is the localized, human-readable episode status, selected by Accept-Language.
+	StatusLabel string `protobuf:"bytes,13,opt,name=status_label,json=statusLabel,proto3" json:"status_label,omitempty"`
+	// preview marks episodes playable without an active subscription.
+	Preview bool `protobuf:"varint,14,opt,name=preview,proto3" json:"preview,omitempty"`
+	// estimated_level is the CEFR level (A1-C2) estimated from the transcript vocabulary and sentence complexity; empty until estimated or when the transcript is too short to judge.
+	EstimatedLevel string `protobuf:"bytes,15,opt,name=estimated_level,json=estimatedLevel,proto3" json:"estimated_level,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Episode) Reset() {
 	*x = Episode{}
-	mi := &file_lession_v1_series_proto_msgTypes[1]
+	mi := &file_lession_v1_series_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -473,7 +658,7 @@ func (x *Episode) String() string {
 func (*Episode) ProtoMessage() {}
 
 func (x *Episode) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_series_proto_msgTypes[1]
+	mi := &file_lession_v1_series_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -486,7 +671,7 @@ func (x *Episode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Episode.ProtoReflect.Descriptor instead.
 func (*Episode) Descriptor() ([]byte, []int) {
-	return file_lession_v1_series_proto_rawDescGZIP(), []int{1}
+	return file_lession_v1_series_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Episode) GetId() string {
@@ -573,6 +758,27 @@ func (x *Episode) GetPublishedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Episode) GetStatusLabel() string {
+	if x != nil {
+		return x.StatusLabel
+	}
+	return ""
+}
+
+func (x *Episode) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
+func (x *Episode) GetEstimatedLevel() string {
+	if x != nil {
+		return x.EstimatedLevel
+	}
+	return ""
+}
+
 // MediaResource binds an uploaded asset to an episode and exposes playback metadata.
 type MediaResource struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -590,7 +796,7 @@ type MediaResource struct {
 
 func (x *MediaResource) Reset() {
 	*x = MediaResource{}
-	mi := &file_lession_v1_series_proto_msgTypes[2]
+	mi := &file_lession_v1_series_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -602,7 +808,7 @@ func (x *MediaResource) String() string {
 func (*MediaResource) ProtoMessage() {}
 
 func (x *MediaResource) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_series_proto_msgTypes[2]
+	mi := &file_lession_v1_series_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -615,7 +821,7 @@ func (x *MediaResource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MediaResource.ProtoReflect.Descriptor instead.
 func (*MediaResource) Descriptor() ([]byte, []int) {
-	return file_lession_v1_series_proto_rawDescGZIP(), []int{2}
+	return file_lession_v1_series_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *MediaResource) GetAssetId() string {
@@ -654,14 +860,17 @@ type Transcript struct {
 	// format specifies the data shape for the transcript content.
 	Format TranscriptFormat `protobuf:"varint,2,opt,name=format,proto3,enum=lession.v1.TranscriptFormat" json:"format,omitempty"`
 	// content stores the transcript payload, encoded per format.
-	Content       string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// findings lists the spans the sanitization pass flagged in content, such as profanity
+	// or personal data, for editors to review. Set by the server; ignored on input.
+	Findings      []*TranscriptFinding `protobuf:"bytes,4,rep,name=findings,proto3" json:"findings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Transcript) Reset() {
 	*x = Transcript{}
-	mi := &file_lession_v1_series_proto_msgTypes[3]
+	mi := &file_lession_v1_series_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -673,7 +882,7 @@ func (x *Transcript) String() string {
 func (*Transcript) ProtoMessage() {}
 
 func (x *Transcript) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_series_proto_msgTypes[3]
+	mi := &file_lession_v1_series_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,7 +895,7 @@ func (x *Transcript) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Transcript.ProtoReflect.Descriptor instead.
 func (*Transcript) Descriptor() ([]byte, []int) {
-	return file_lession_v1_series_proto_rawDescGZIP(), []int{3}
+	return file_lession_v1_series_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Transcript) GetLanguage() string {
@@ -710,6 +919,341 @@ func (x *Transcript) GetContent() string {
 	return ""
 }
 
+func (x *Transcript) GetFindings() []*TranscriptFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+// EpisodeTextStats summarises the words of an episode's transcript.
+type EpisodeTextStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// episode_id is the identifier of the analysed episode.
+	EpisodeId string `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// word_count is the number of words in the transcript.
+	WordCount uint32 `protobuf:"varint,2,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	// unique_word_count is the number of distinct words, ignoring case.
+	UniqueWordCount uint32 `protobuf:"varint,3,opt,name=unique_word_count,json=uniqueWordCount,proto3" json:"unique_word_count,omitempty"`
+	// sentence_count is the number of sentences, or of lines when the transcript has no punctuation.
+	SentenceCount uint32 `protobuf:"varint,4,opt,name=sentence_count,json=sentenceCount,proto3" json:"sentence_count,omitempty"`
+	// words_per_sentence is the average sentence length.
+	WordsPerSentence float64 `protobuf:"fixed64,5,opt,name=words_per_sentence,json=wordsPerSentence,proto3" json:"words_per_sentence,omitempty"`
+	// words_per_minute is the speaking rate over the episode duration, or over the transcript timings when no duration is set; zero when neither is known.
+	WordsPerMinute float64 `protobuf:"fixed64,6,opt,name=words_per_minute,json=wordsPerMinute,proto3" json:"words_per_minute,omitempty"`
+	// top_words lists the most frequent words, most frequent first.
+	TopWords []*WordFrequency `protobuf:"bytes,7,rep,name=top_words,json=topWords,proto3" json:"top_words,omitempty"`
+	// estimated_level is the CEFR level estimated from the transcript.
+	EstimatedLevel string `protobuf:"bytes,8,opt,name=estimated_level,json=estimatedLevel,proto3" json:"estimated_level,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EpisodeTextStats) Reset() {
+	*x = EpisodeTextStats{}
+	mi := &file_lession_v1_series_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EpisodeTextStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpisodeTextStats) ProtoMessage() {}
+
+func (x *EpisodeTextStats) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_series_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpisodeTextStats.ProtoReflect.Descriptor instead.
+func (*EpisodeTextStats) Descriptor() ([]byte, []int) {
+	return file_lession_v1_series_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EpisodeTextStats) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *EpisodeTextStats) GetWordCount() uint32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+func (x *EpisodeTextStats) GetUniqueWordCount() uint32 {
+	if x != nil {
+		return x.UniqueWordCount
+	}
+	return 0
+}
+
+func (x *EpisodeTextStats) GetSentenceCount() uint32 {
+	if x != nil {
+		return x.SentenceCount
+	}
+	return 0
+}
+
+func (x *EpisodeTextStats) GetWordsPerSentence() float64 {
+	if x != nil {
+		return x.WordsPerSentence
+	}
+	return 0
+}
+
+func (x *EpisodeTextStats) GetWordsPerMinute() float64 {
+	if x != nil {
+		return x.WordsPerMinute
+	}
+	return 0
+}
+
+func (x *EpisodeTextStats) GetTopWords() []*WordFrequency {
+	if x != nil {
+		return x.TopWords
+	}
+	return nil
+}
+
+func (x *EpisodeTextStats) GetEstimatedLevel() string {
+	if x != nil {
+		return x.EstimatedLevel
+	}
+	return ""
+}
+
+// WordFrequency counts the occurrences of a word.
+type WordFrequency struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// word is the lower-cased word.
+	Word string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	// count is the number of occurrences.
+	Count         uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WordFrequency) Reset() {
+	*x = WordFrequency{}
+	mi := &file_lession_v1_series_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordFrequency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordFrequency) ProtoMessage() {}
+
+func (x *WordFrequency) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_series_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordFrequency.ProtoReflect.Descriptor instead.
+func (*WordFrequency) Descriptor() ([]byte, []int) {
+	return file_lession_v1_series_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WordFrequency) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *WordFrequency) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// TranscriptFinding locates a span of a transcript flagged by the sanitization pass.
+type TranscriptFinding struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kind names what was found: "profanity", "email", "phone" or "card_number".
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// line is the 1-based transcript line the span starts on.
+	Line uint32 `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	// start is the byte offset of the span in the transcript content.
+	Start uint32 `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	// end is the byte offset just past the span.
+	End uint32 `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	// masked reports that the span was replaced with asterisks on publication.
+	Masked        bool `protobuf:"varint,5,opt,name=masked,proto3" json:"masked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TranscriptFinding) Reset() {
+	*x = TranscriptFinding{}
+	mi := &file_lession_v1_series_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TranscriptFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranscriptFinding) ProtoMessage() {}
+
+func (x *TranscriptFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_series_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranscriptFinding.ProtoReflect.Descriptor instead.
+func (*TranscriptFinding) Descriptor() ([]byte, []int) {
+	return file_lession_v1_series_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TranscriptFinding) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *TranscriptFinding) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *TranscriptFinding) GetStart() uint32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *TranscriptFinding) GetEnd() uint32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *TranscriptFinding) GetMasked() bool {
+	if x != nil {
+		return x.Masked
+	}
+	return false
+}
+
+// ContentReassignment records a bulk transfer of series ownership between authors.
+type ContentReassignment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the server-assigned identifier for the reassignment record.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// from_author_id is the author who previously owned the content.
+	FromAuthorId string `protobuf:"bytes,2,opt,name=from_author_id,json=fromAuthorId,proto3" json:"from_author_id,omitempty"`
+	// to_author_id is the author who now owns the content.
+	ToAuthorId string `protobuf:"bytes,3,opt,name=to_author_id,json=toAuthorId,proto3" json:"to_author_id,omitempty"`
+	// series_ids lists the series whose ownership was transferred.
+	SeriesIds []string `protobuf:"bytes,4,rep,name=series_ids,json=seriesIds,proto3" json:"series_ids,omitempty"`
+	// created_at records when the reassignment was performed.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentReassignment) Reset() {
+	*x = ContentReassignment{}
+	mi := &file_lession_v1_series_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentReassignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentReassignment) ProtoMessage() {}
+
+func (x *ContentReassignment) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_series_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentReassignment.ProtoReflect.Descriptor instead.
+func (*ContentReassignment) Descriptor() ([]byte, []int) {
+	return file_lession_v1_series_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ContentReassignment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContentReassignment) GetFromAuthorId() string {
+	if x != nil {
+		return x.FromAuthorId
+	}
+	return ""
+}
+
+func (x *ContentReassignment) GetToAuthorId() string {
+	if x != nil {
+		return x.ToAuthorId
+	}
+	return ""
+}
+
+func (x *ContentReassignment) GetSeriesIds() []string {
+	if x != nil {
+		return x.SeriesIds
+	}
+	return nil
+}
+
+func (x *ContentReassignment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
 // SeriesDraft captures modifiable fields for creating or updating a series.
 type SeriesDraft struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -725,12 +1269,18 @@ type SeriesDraft struct {
 	Level string `protobuf:"bytes,5,opt,name=level,proto3" json:"level,omitempty"`
 	// tags captures optional classification keywords.
 	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
-	// cover_url references artwork that represents the series.
+	// cover_url references external artwork; it is ignored when cover_asset_id is set. Deprecated: upload an image asset and set cover_asset_id.
 	CoverUrl string `protobuf:"bytes,7,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
 	// status tracks the lifecycle stage of the series.
 	Status SeriesStatus `protobuf:"varint,8,opt,name=status,proto3,enum=lession.v1.SeriesStatus" json:"status,omitempty"`
 	// author_ids references the creators responsible for the series.
 	AuthorIds []string `protobuf:"bytes,9,rep,name=author_ids,json=authorIds,proto3" json:"author_ids,omitempty"`
+	// drm_enabled encrypts the HLS renditions of the series' audio; keys are only delivered to entitled learners.
+	DrmEnabled bool `protobuf:"varint,10,opt,name=drm_enabled,json=drmEnabled,proto3" json:"drm_enabled,omitempty"`
+	// cover_asset_id identifies a processed image asset to use as the cover.
+	CoverAssetId string `protobuf:"bytes,11,opt,name=cover_asset_id,json=coverAssetId,proto3" json:"cover_asset_id,omitempty"`
+	// branding carries presentation metadata for white-label frontends.
+	Branding *SeriesBranding `protobuf:"bytes,12,opt,name=branding,proto3" json:"branding,omitempty"`
 	// episodes provides initial or replacement episodes for the series.
 	Episodes      []*EpisodeDraft `protobuf:"bytes,20,rep,name=episodes,proto3" json:"episodes,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -739,7 +1289,7 @@ type SeriesDraft struct {
 
 func (x *SeriesDraft) Reset() {
 	*x = SeriesDraft{}
-	mi := &file_lession_v1_series_proto_msgTypes[4]
+	mi := &file_lession_v1_series_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -751,7 +1301,7 @@ func (x *SeriesDraft) String() string {
 func (*SeriesDraft) ProtoMessage() {}
 
 func (x *SeriesDraft) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_series_proto_msgTypes[4]
+	mi := &file_lession_v1_series_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,7 +1314,7 @@ func (x *SeriesDraft) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SeriesDraft.ProtoReflect.Descriptor instead.
 func (*SeriesDraft) Descriptor() ([]byte, []int) {
-	return file_lession_v1_series_proto_rawDescGZIP(), []int{4}
+	return file_lession_v1_series_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SeriesDraft) GetSlug() string {
@@ -830,6 +1380,27 @@ func (x *SeriesDraft) GetAuthorIds() []string {
 	return nil
 }
 
+func (x *SeriesDraft) GetDrmEnabled() bool {
+	if x != nil {
+		return x.DrmEnabled
+	}
+	return false
+}
+
+func (x *SeriesDraft) GetCoverAssetId() string {
+	if x != nil {
+		return x.CoverAssetId
+	}
+	return ""
+}
+
+func (x *SeriesDraft) GetBranding() *SeriesBranding {
+	if x != nil {
+		return x.Branding
+	}
+	return nil
+}
+
 func (x *SeriesDraft) GetEpisodes() []*EpisodeDraft {
 	if x != nil {
 		return x.Episodes
@@ -855,14 +1426,16 @@ type EpisodeDraft struct {
 	// are ignored.
 	Resource *MediaResource `protobuf:"bytes,6,opt,name=resource,proto3" json:"resource,omitempty"`
 	// transcript stores the textual version of the episode content.
-	Transcript    *Transcript `protobuf:"bytes,7,opt,name=transcript,proto3" json:"transcript,omitempty"`
+	Transcript *Transcript `protobuf:"bytes,7,opt,name=transcript,proto3" json:"transcript,omitempty"`
+	// preview marks episodes playable without an active subscription.
+	Preview       bool `protobuf:"varint,8,opt,name=preview,proto3" json:"preview,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EpisodeDraft) Reset() {
 	*x = EpisodeDraft{}
-	mi := &file_lession_v1_series_proto_msgTypes[5]
+	mi := &file_lession_v1_series_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -874,7 +1447,7 @@ func (x *EpisodeDraft) String() string {
 func (*EpisodeDraft) ProtoMessage() {}
 
 func (x *EpisodeDraft) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_series_proto_msgTypes[5]
+	mi := &file_lession_v1_series_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -887,7 +1460,7 @@ func (x *EpisodeDraft) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EpisodeDraft.ProtoReflect.Descriptor instead.
 func (*EpisodeDraft) Descriptor() ([]byte, []int) {
-	return file_lession_v1_series_proto_rawDescGZIP(), []int{5}
+	return file_lession_v1_series_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *EpisodeDraft) GetSeq() uint32 {
@@ -939,12 +1512,19 @@ func (x *EpisodeDraft) GetTranscript() *Transcript {
 	return nil
 }
 
+func (x *EpisodeDraft) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
 var File_lession_v1_series_proto protoreflect.FileDescriptor
 
 const file_lession_v1_series_proto_rawDesc = "" +
 	"\n" +
 	"\x17lession/v1/series.proto\x12\n" +
-	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9b\x04\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe6\x05\n" +
 	"\x06Series\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x14\n" +
@@ -953,7 +1533,8 @@ const file_lession_v1_series_proto_rawDesc = "" +
 	"\blanguage\x18\x05 \x01(\tR\blanguage\x12\x14\n" +
 	"\x05level\x18\x06 \x01(\tR\x05level\x12\x12\n" +
 	"\x04tags\x18\a \x03(\tR\x04tags\x12\x1b\n" +
-	"\tcover_url\x18\b \x01(\tR\bcoverUrl\x120\n" +
+	"\tcover_url\x18\b \x01(\tR\bcoverUrl\x12$\n" +
+	"\x0ecover_asset_id\x18\x12 \x01(\tR\fcoverAssetId\x120\n" +
 	"\x06status\x18\t \x01(\x0e2\x18.lession.v1.SeriesStatusR\x06status\x12#\n" +
 	"\repisode_count\x18\n" +
 	" \x01(\rR\fepisodeCount\x129\n" +
@@ -963,8 +1544,18 @@ const file_lession_v1_series_proto_rawDesc = "" +
 	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
 	"\fpublished_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x12\x1d\n" +
 	"\n" +
-	"author_ids\x18\x0e \x03(\tR\tauthorIds\x12/\n" +
-	"\bepisodes\x18\x14 \x03(\v2\x13.lession.v1.EpisodeR\bepisodes\"\x8e\x04\n" +
+	"author_ids\x18\x0e \x03(\tR\tauthorIds\x12!\n" +
+	"\fstatus_label\x18\x0f \x01(\tR\vstatusLabel\x12'\n" +
+	"\x0festimated_level\x18\x10 \x01(\tR\x0eestimatedLevel\x12\x1f\n" +
+	"\vdrm_enabled\x18\x11 \x01(\bR\n" +
+	"drmEnabled\x126\n" +
+	"\bbranding\x18\x13 \x01(\v2\x1a.lession.v1.SeriesBrandingR\bbranding\x12/\n" +
+	"\bepisodes\x18\x14 \x03(\v2\x13.lession.v1.EpisodeR\bepisodes\"\xe3\x01\n" +
+	"\x0eSeriesBranding\x12>\n" +
+	"\faccent_color\x18\x01 \x01(\tB\x1b\xbaH\x18\xd8\x01\x01r\x132\x11^#[0-9a-fA-F]{6}$R\vaccentColor\x12/\n" +
+	"\rhero_asset_id\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\vheroAssetId\x12$\n" +
+	"\x0ehero_image_url\x18\x03 \x01(\tR\fheroImageUrl\x12:\n" +
+	"\x06layout\x18\x04 \x01(\x0e2\x18.lession.v1.SeriesLayoutB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06layout\"\xf4\x04\n" +
 	"\aEpisode\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tseries_id\x18\x02 \x01(\tR\bseriesId\x12\x10\n" +
@@ -982,17 +1573,50 @@ const file_lession_v1_series_proto_rawDesc = "" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
-	"\fpublished_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\"\xac\x01\n" +
+	"\fpublished_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x12!\n" +
+	"\fstatus_label\x18\r \x01(\tR\vstatusLabel\x12\x18\n" +
+	"\apreview\x18\x0e \x01(\bR\apreview\x12'\n" +
+	"\x0festimated_level\x18\x0f \x01(\tR\x0eestimatedLevel\"\xac\x01\n" +
 	"\rMediaResource\x12&\n" +
 	"\basset_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\aassetId\x123\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x15.lession.v1.MediaTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04type\x12!\n" +
 	"\fplayback_url\x18\x03 \x01(\tR\vplaybackUrl\x12\x1b\n" +
-	"\tmime_type\x18\x04 \x01(\tR\bmimeType\"\x9b\x01\n" +
+	"\tmime_type\x18\x04 \x01(\tR\bmimeType\"\xd6\x01\n" +
 	"\n" +
 	"Transcript\x123\n" +
 	"\blanguage\x18\x01 \x01(\tB\x17\xbaH\x14\xd8\x01\x01r\x0f2\r^[a-zA-Z]{2}$R\blanguage\x12>\n" +
 	"\x06format\x18\x02 \x01(\x0e2\x1c.lession.v1.TranscriptFormatB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06format\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\"\xb4\x03\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x129\n" +
+	"\bfindings\x18\x04 \x03(\v2\x1d.lession.v1.TranscriptFindingR\bfindings\"\xdc\x02\n" +
+	"\x10EpisodeTextStats\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\tepisodeId\x12\x1d\n" +
+	"\n" +
+	"word_count\x18\x02 \x01(\rR\twordCount\x12*\n" +
+	"\x11unique_word_count\x18\x03 \x01(\rR\x0funiqueWordCount\x12%\n" +
+	"\x0esentence_count\x18\x04 \x01(\rR\rsentenceCount\x12,\n" +
+	"\x12words_per_sentence\x18\x05 \x01(\x01R\x10wordsPerSentence\x12(\n" +
+	"\x10words_per_minute\x18\x06 \x01(\x01R\x0ewordsPerMinute\x126\n" +
+	"\ttop_words\x18\a \x03(\v2\x19.lession.v1.WordFrequencyR\btopWords\x12'\n" +
+	"\x0festimated_level\x18\b \x01(\tR\x0eestimatedLevel\"9\n" +
+	"\rWordFrequency\x12\x12\n" +
+	"\x04word\x18\x01 \x01(\tR\x04word\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\"{\n" +
+	"\x11TranscriptFinding\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\rR\x04line\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\rR\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\rR\x03end\x12\x16\n" +
+	"\x06masked\x18\x05 \x01(\bR\x06masked\"\xc7\x01\n" +
+	"\x13ContentReassignment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
+	"\x0efrom_author_id\x18\x02 \x01(\tR\ffromAuthorId\x12 \n" +
+	"\fto_author_id\x18\x03 \x01(\tR\n" +
+	"toAuthorId\x12\x1d\n" +
+	"\n" +
+	"series_ids\x18\x04 \x03(\tR\tseriesIds\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc0\x04\n" +
 	"\vSeriesDraft\x12\x1e\n" +
 	"\x04slug\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\x80\x01R\x04slug\x12 \n" +
@@ -1005,8 +1629,13 @@ const file_lession_v1_series_proto_rawDesc = "" +
 	"\tcover_url\x18\a \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\x88\x01\x01R\bcoverUrl\x12:\n" +
 	"\x06status\x18\b \x01(\x0e2\x18.lession.v1.SeriesStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\x12+\n" +
 	"\n" +
-	"author_ids\x18\t \x03(\tB\f\xbaH\t\x92\x01\x06\"\x04r\x02\x10\x01R\tauthorIds\x124\n" +
-	"\bepisodes\x18\x14 \x03(\v2\x18.lession.v1.EpisodeDraftR\bepisodes\"\xda\x02\n" +
+	"author_ids\x18\t \x03(\tB\f\xbaH\t\x92\x01\x06\"\x04r\x02\x10\x01R\tauthorIds\x12\x1f\n" +
+	"\vdrm_enabled\x18\n" +
+	" \x01(\bR\n" +
+	"drmEnabled\x121\n" +
+	"\x0ecover_asset_id\x18\v \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\fcoverAssetId\x126\n" +
+	"\bbranding\x18\f \x01(\v2\x1a.lession.v1.SeriesBrandingR\bbranding\x124\n" +
+	"\bepisodes\x18\x14 \x03(\v2\x18.lession.v1.EpisodeDraftR\bepisodes\"\xf4\x02\n" +
 	"\fEpisodeDraft\x12\x19\n" +
 	"\x03seq\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x03seq\x12 \n" +
 	"\x05title\x18\x02 \x01(\tB\n" +
@@ -1017,22 +1646,29 @@ const file_lession_v1_series_proto_rawDesc = "" +
 	"\bresource\x18\x06 \x01(\v2\x19.lession.v1.MediaResourceR\bresource\x126\n" +
 	"\n" +
 	"transcript\x18\a \x01(\v2\x16.lession.v1.TranscriptR\n" +
-	"transcript*\x7f\n" +
+	"transcript\x12\x18\n" +
+	"\apreview\x18\b \x01(\bR\apreview*\x7f\n" +
 	"\fSeriesStatus\x12\x1d\n" +
 	"\x19SERIES_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13SERIES_STATUS_DRAFT\x10\x01\x12\x1b\n" +
 	"\x17SERIES_STATUS_PUBLISHED\x10\x02\x12\x1a\n" +
-	"\x16SERIES_STATUS_ARCHIVED\x10\x03*\x9e\x01\n" +
+	"\x16SERIES_STATUS_ARCHIVED\x10\x03*y\n" +
+	"\fSeriesLayout\x12\x1d\n" +
+	"\x19SERIES_LAYOUT_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12SERIES_LAYOUT_LIST\x10\x01\x12\x16\n" +
+	"\x12SERIES_LAYOUT_GRID\x10\x02\x12\x1a\n" +
+	"\x16SERIES_LAYOUT_FEATURED\x10\x03*\x9e\x01\n" +
 	"\rEpisodeStatus\x12\x1e\n" +
 	"\x1aEPISODE_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14EPISODE_STATUS_DRAFT\x10\x01\x12\x18\n" +
 	"\x14EPISODE_STATUS_READY\x10\x02\x12\x1c\n" +
 	"\x18EPISODE_STATUS_PUBLISHED\x10\x03\x12\x1b\n" +
-	"\x17EPISODE_STATUS_ARCHIVED\x10\x04*S\n" +
+	"\x17EPISODE_STATUS_ARCHIVED\x10\x04*i\n" +
 	"\tMediaType\x12\x1a\n" +
 	"\x16MEDIA_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10MEDIA_TYPE_VIDEO\x10\x01\x12\x14\n" +
-	"\x10MEDIA_TYPE_AUDIO\x10\x02*\xa9\x01\n" +
+	"\x10MEDIA_TYPE_AUDIO\x10\x02\x12\x14\n" +
+	"\x10MEDIA_TYPE_IMAGE\x10\x03*\xa9\x01\n" +
 	"\x10TranscriptFormat\x12!\n" +
 	"\x1dTRANSCRIPT_FORMAT_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17TRANSCRIPT_FORMAT_PLAIN\x10\x01\x12\x1e\n" +
@@ -1052,48 +1688,60 @@ func file_lession_v1_series_proto_rawDescGZIP() []byte {
 	return file_lession_v1_series_proto_rawDescData
 }
 
-var file_lession_v1_series_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_lession_v1_series_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_lession_v1_series_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_lession_v1_series_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_lession_v1_series_proto_goTypes = []any{
 	(SeriesStatus)(0),             // 0: lession.v1.SeriesStatus
-	(EpisodeStatus)(0),            // 1: lession.v1.EpisodeStatus
-	(MediaType)(0),                // 2: lession.v1.MediaType
-	(TranscriptFormat)(0),         // 3: lession.v1.TranscriptFormat
-	(*Series)(nil),                // 4: lession.v1.Series
-	(*Episode)(nil),               // 5: lession.v1.Episode
-	(*MediaResource)(nil),         // 6: lession.v1.MediaResource
-	(*Transcript)(nil),            // 7: lession.v1.Transcript
-	(*SeriesDraft)(nil),           // 8: lession.v1.SeriesDraft
-	(*EpisodeDraft)(nil),          // 9: lession.v1.EpisodeDraft
-	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),   // 11: google.protobuf.Duration
+	(SeriesLayout)(0),             // 1: lession.v1.SeriesLayout
+	(EpisodeStatus)(0),            // 2: lession.v1.EpisodeStatus
+	(MediaType)(0),                // 3: lession.v1.MediaType
+	(TranscriptFormat)(0),         // 4: lession.v1.TranscriptFormat
+	(*Series)(nil),                // 5: lession.v1.Series
+	(*SeriesBranding)(nil),        // 6: lession.v1.SeriesBranding
+	(*Episode)(nil),               // 7: lession.v1.Episode
+	(*MediaResource)(nil),         // 8: lession.v1.MediaResource
+	(*Transcript)(nil),            // 9: lession.v1.Transcript
+	(*EpisodeTextStats)(nil),      // 10: lession.v1.EpisodeTextStats
+	(*WordFrequency)(nil),         // 11: lession.v1.WordFrequency
+	(*TranscriptFinding)(nil),     // 12: lession.v1.TranscriptFinding
+	(*ContentReassignment)(nil),   // 13: lession.v1.ContentReassignment
+	(*SeriesDraft)(nil),           // 14: lession.v1.SeriesDraft
+	(*EpisodeDraft)(nil),          // 15: lession.v1.EpisodeDraft
+	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 17: google.protobuf.Duration
 }
 var file_lession_v1_series_proto_depIdxs = []int32{
 	0,  // 0: lession.v1.Series.status:type_name -> lession.v1.SeriesStatus
-	10, // 1: lession.v1.Series.created_at:type_name -> google.protobuf.Timestamp
-	10, // 2: lession.v1.Series.updated_at:type_name -> google.protobuf.Timestamp
-	10, // 3: lession.v1.Series.published_at:type_name -> google.protobuf.Timestamp
-	5,  // 4: lession.v1.Series.episodes:type_name -> lession.v1.Episode
-	11, // 5: lession.v1.Episode.duration:type_name -> google.protobuf.Duration
-	1,  // 6: lession.v1.Episode.status:type_name -> lession.v1.EpisodeStatus
-	6,  // 7: lession.v1.Episode.resource:type_name -> lession.v1.MediaResource
-	7,  // 8: lession.v1.Episode.transcript:type_name -> lession.v1.Transcript
-	10, // 9: lession.v1.Episode.created_at:type_name -> google.protobuf.Timestamp
-	10, // 10: lession.v1.Episode.updated_at:type_name -> google.protobuf.Timestamp
-	10, // 11: lession.v1.Episode.published_at:type_name -> google.protobuf.Timestamp
-	2,  // 12: lession.v1.MediaResource.type:type_name -> lession.v1.MediaType
-	3,  // 13: lession.v1.Transcript.format:type_name -> lession.v1.TranscriptFormat
-	0,  // 14: lession.v1.SeriesDraft.status:type_name -> lession.v1.SeriesStatus
-	9,  // 15: lession.v1.SeriesDraft.episodes:type_name -> lession.v1.EpisodeDraft
-	11, // 16: lession.v1.EpisodeDraft.duration:type_name -> google.protobuf.Duration
-	1,  // 17: lession.v1.EpisodeDraft.status:type_name -> lession.v1.EpisodeStatus
-	6,  // 18: lession.v1.EpisodeDraft.resource:type_name -> lession.v1.MediaResource
-	7,  // 19: lession.v1.EpisodeDraft.transcript:type_name -> lession.v1.Transcript
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	16, // 1: lession.v1.Series.created_at:type_name -> google.protobuf.Timestamp
+	16, // 2: lession.v1.Series.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 3: lession.v1.Series.published_at:type_name -> google.protobuf.Timestamp
+	6,  // 4: lession.v1.Series.branding:type_name -> lession.v1.SeriesBranding
+	7,  // 5: lession.v1.Series.episodes:type_name -> lession.v1.Episode
+	1,  // 6: lession.v1.SeriesBranding.layout:type_name -> lession.v1.SeriesLayout
+	17, // 7: lession.v1.Episode.duration:type_name -> google.protobuf.Duration
+	2,  // 8: lession.v1.Episode.status:type_name -> lession.v1.EpisodeStatus
+	8,  // 9: lession.v1.Episode.resource:type_name -> lession.v1.MediaResource
+	9,  // 10: lession.v1.Episode.transcript:type_name -> lession.v1.Transcript
+	16, // 11: lession.v1.Episode.created_at:type_name -> google.protobuf.Timestamp
+	16, // 12: lession.v1.Episode.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 13: lession.v1.Episode.published_at:type_name -> google.protobuf.Timestamp
+	3,  // 14: lession.v1.MediaResource.type:type_name -> lession.v1.MediaType
+	4,  // 15: lession.v1.Transcript.format:type_name -> lession.v1.TranscriptFormat
+	12, // 16: lession.v1.Transcript.findings:type_name -> lession.v1.TranscriptFinding
+	11, // 17: lession.v1.EpisodeTextStats.top_words:type_name -> lession.v1.WordFrequency
+	16, // 18: lession.v1.ContentReassignment.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 19: lession.v1.SeriesDraft.status:type_name -> lession.v1.SeriesStatus
+	6,  // 20: lession.v1.SeriesDraft.branding:type_name -> lession.v1.SeriesBranding
+	15, // 21: lession.v1.SeriesDraft.episodes:type_name -> lession.v1.EpisodeDraft
+	17, // 22: lession.v1.EpisodeDraft.duration:type_name -> google.protobuf.Duration
+	2,  // 23: lession.v1.EpisodeDraft.status:type_name -> lession.v1.EpisodeStatus
+	8,  // 24: lession.v1.EpisodeDraft.resource:type_name -> lession.v1.MediaResource
+	9,  // 25: lession.v1.EpisodeDraft.transcript:type_name -> lession.v1.Transcript
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_lession_v1_series_proto_init() }
@@ -1106,8 +1754,8 @@ func file_lession_v1_series_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_series_proto_rawDesc), len(file_lession_v1_series_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   6,
+			NumEnums:      5,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
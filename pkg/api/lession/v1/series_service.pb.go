@@ -40,12 +40,22 @@ type ListSeriesRequest struct {
 	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
 	// query performs a fuzzy match against titles and summaries.
 	Query string `protobuf:"bytes,7,opt,name=query,proto3" json:"query,omitempty"`
-	// include_episodes requests that episode details are embedded in the response.
+	// include_episodes requests that episode details are embedded in the response. Their
+	// transcripts are left out unless include_transcripts is set; GetEpisode loads one lazily.
 	IncludeEpisodes bool `protobuf:"varint,8,opt,name=include_episodes,json=includeEpisodes,proto3" json:"include_episodes,omitempty"`
 	// author_ids filters series that reference any of the supplied authors.
-	AuthorIds     []string `protobuf:"bytes,9,rep,name=author_ids,json=authorIds,proto3" json:"author_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	AuthorIds []string `protobuf:"bytes,9,rep,name=author_ids,json=authorIds,proto3" json:"author_ids,omitempty"`
+	// estimated_level filters series by the CEFR level estimated from their transcripts.
+	EstimatedLevel string `protobuf:"bytes,10,opt,name=estimated_level,json=estimatedLevel,proto3" json:"estimated_level,omitempty"`
+	// read_mask limits the returned fields of each series, e.g. "title" or "episodes.title";
+	// full series are returned when empty. Episodes and their transcripts are only loaded
+	// when the mask asks for them.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,11,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// include_transcripts embeds the transcripts of the episodes as well, which can make
+	// listings very large.
+	IncludeTranscripts bool `protobuf:"varint,12,opt,name=include_transcripts,json=includeTranscripts,proto3" json:"include_transcripts,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *ListSeriesRequest) Reset() {
@@ -141,6 +151,27 @@ func (x *ListSeriesRequest) GetAuthorIds() []string {
 	return nil
 }
 
+func (x *ListSeriesRequest) GetEstimatedLevel() string {
+	if x != nil {
+		return x.EstimatedLevel
+	}
+	return ""
+}
+
+func (x *ListSeriesRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *ListSeriesRequest) GetIncludeTranscripts() bool {
+	if x != nil {
+		return x.IncludeTranscripts
+	}
+	return false
+}
+
 // ListSeriesResponse returns a page of series.
 type ListSeriesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -297,8 +328,12 @@ type GetSeriesRequest struct {
 	IncludeEpisodes bool `protobuf:"varint,2,opt,name=include_episodes,json=includeEpisodes,proto3" json:"include_episodes,omitempty"`
 	// include_metadata requests that metadata is included when stored as a large payload.
 	IncludeMetadata bool `protobuf:"varint,3,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// read_mask limits the returned fields of the series, e.g. "title" or "episodes.title";
+	// the full series is returned when empty. Episodes and their transcripts are only loaded
+	// when the mask asks for them.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetSeriesRequest) Reset() {
@@ -352,6 +387,13 @@ func (x *GetSeriesRequest) GetIncludeMetadata() bool {
 	return false
 }
 
+func (x *GetSeriesRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
 // GetSeriesResponse returns a single series resource.
 type GetSeriesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -701,6 +743,116 @@ func (x *GetEpisodeResponse) GetEpisode() *Episode {
 	return nil
 }
 
+// GetEpisodeTextStatsRequest identifies the episode to analyse.
+type GetEpisodeTextStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// episode_id references the target episode.
+	EpisodeId string `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// top_words limits the returned word frequencies; defaults to 20.
+	TopWords uint32 `protobuf:"varint,2,opt,name=top_words,json=topWords,proto3" json:"top_words,omitempty"`
+	// exclude_common_words leaves the most common English words, such as "the" and "is", out of the frequencies.
+	ExcludeCommonWords bool `protobuf:"varint,3,opt,name=exclude_common_words,json=excludeCommonWords,proto3" json:"exclude_common_words,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetEpisodeTextStatsRequest) Reset() {
+	*x = GetEpisodeTextStatsRequest{}
+	mi := &file_lession_v1_series_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodeTextStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodeTextStatsRequest) ProtoMessage() {}
+
+func (x *GetEpisodeTextStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_series_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodeTextStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetEpisodeTextStatsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_series_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetEpisodeTextStatsRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *GetEpisodeTextStatsRequest) GetTopWords() uint32 {
+	if x != nil {
+		return x.TopWords
+	}
+	return 0
+}
+
+func (x *GetEpisodeTextStatsRequest) GetExcludeCommonWords() bool {
+	if x != nil {
+		return x.ExcludeCommonWords
+	}
+	return false
+}
+
+// GetEpisodeTextStatsResponse returns the statistics of the transcript.
+type GetEpisodeTextStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// stats describes the transcript of the episode.
+	Stats         *EpisodeTextStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEpisodeTextStatsResponse) Reset() {
+	*x = GetEpisodeTextStatsResponse{}
+	mi := &file_lession_v1_series_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEpisodeTextStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodeTextStatsResponse) ProtoMessage() {}
+
+func (x *GetEpisodeTextStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_series_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodeTextStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetEpisodeTextStatsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_series_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetEpisodeTextStatsResponse) GetStats() *EpisodeTextStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
 // UpdateEpisodeRequest applies a partial update to an episode.
 type UpdateEpisodeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -716,7 +868,7 @@ type UpdateEpisodeRequest struct {
 
 func (x *UpdateEpisodeRequest) Reset() {
 	*x = UpdateEpisodeRequest{}
-	mi := &file_lession_v1_series_service_proto_msgTypes[12]
+	mi := &file_lession_v1_series_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -728,7 +880,7 @@ func (x *UpdateEpisodeRequest) String() string {
 func (*UpdateEpisodeRequest) ProtoMessage() {}
 
 func (x *UpdateEpisodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_series_service_proto_msgTypes[12]
+	mi := &file_lession_v1_series_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -741,7 +893,7 @@ func (x *UpdateEpisodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEpisodeRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEpisodeRequest) Descriptor() ([]byte, []int) {
-	return file_lession_v1_series_service_proto_rawDescGZIP(), []int{12}
+	return file_lession_v1_series_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UpdateEpisodeRequest) GetEpisodeId() string {
@@ -776,7 +928,7 @@ type UpdateEpisodeResponse struct {
 
 func (x *UpdateEpisodeResponse) Reset() {
 	*x = UpdateEpisodeResponse{}
-	mi := &file_lession_v1_series_service_proto_msgTypes[13]
+	mi := &file_lession_v1_series_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -788,7 +940,7 @@ func (x *UpdateEpisodeResponse) String() string {
 func (*UpdateEpisodeResponse) ProtoMessage() {}
 
 func (x *UpdateEpisodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_series_service_proto_msgTypes[13]
+	mi := &file_lession_v1_series_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -801,7 +953,7 @@ func (x *UpdateEpisodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEpisodeResponse.ProtoReflect.Descriptor instead.
 func (*UpdateEpisodeResponse) Descriptor() ([]byte, []int) {
-	return file_lession_v1_series_service_proto_rawDescGZIP(), []int{13}
+	return file_lession_v1_series_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *UpdateEpisodeResponse) GetEpisode() *Episode {
@@ -822,7 +974,7 @@ type DeleteEpisodeRequest struct {
 
 func (x *DeleteEpisodeRequest) Reset() {
 	*x = DeleteEpisodeRequest{}
-	mi := &file_lession_v1_series_service_proto_msgTypes[14]
+	mi := &file_lession_v1_series_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -834,7 +986,7 @@ func (x *DeleteEpisodeRequest) String() string {
 func (*DeleteEpisodeRequest) ProtoMessage() {}
 
 func (x *DeleteEpisodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_series_service_proto_msgTypes[14]
+	mi := &file_lession_v1_series_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -847,7 +999,7 @@ func (x *DeleteEpisodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEpisodeRequest.ProtoReflect.Descriptor instead.
 func (*DeleteEpisodeRequest) Descriptor() ([]byte, []int) {
-	return file_lession_v1_series_service_proto_rawDescGZIP(), []int{14}
+	return file_lession_v1_series_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DeleteEpisodeRequest) GetEpisodeId() string {
@@ -868,7 +1020,7 @@ type DeleteEpisodeResponse struct {
 
 func (x *DeleteEpisodeResponse) Reset() {
 	*x = DeleteEpisodeResponse{}
-	mi := &file_lession_v1_series_service_proto_msgTypes[15]
+	mi := &file_lession_v1_series_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -880,7 +1032,7 @@ func (x *DeleteEpisodeResponse) String() string {
 func (*DeleteEpisodeResponse) ProtoMessage() {}
 
 func (x *DeleteEpisodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lession_v1_series_service_proto_msgTypes[15]
+	mi := &file_lession_v1_series_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -893,7 +1045,7 @@ func (x *DeleteEpisodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEpisodeResponse.ProtoReflect.Descriptor instead.
 func (*DeleteEpisodeResponse) Descriptor() ([]byte, []int) {
-	return file_lession_v1_series_service_proto_rawDescGZIP(), []int{15}
+	return file_lession_v1_series_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DeleteEpisodeResponse) GetEpisode() *Episode {
@@ -903,12 +1055,315 @@ func (x *DeleteEpisodeResponse) GetEpisode() *Episode {
 	return nil
 }
 
+// RenameTagRequest renames a tag across all series.
+type RenameTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tag is the existing tag to rename.
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// new_tag is the replacement name for the tag.
+	NewTag        string `protobuf:"bytes,2,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	mi := &file_lession_v1_series_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_series_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_series_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RenameTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetNewTag() string {
+	if x != nil {
+		return x.NewTag
+	}
+	return ""
+}
+
+// RenameTagResponse reports the series touched by a rename.
+type RenameTagResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// series_ids lists the series whose tags were rewritten.
+	SeriesIds     []string `protobuf:"bytes,1,rep,name=series_ids,json=seriesIds,proto3" json:"series_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagResponse) Reset() {
+	*x = RenameTagResponse{}
+	mi := &file_lession_v1_series_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagResponse) ProtoMessage() {}
+
+func (x *RenameTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_series_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagResponse.ProtoReflect.Descriptor instead.
+func (*RenameTagResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_series_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RenameTagResponse) GetSeriesIds() []string {
+	if x != nil {
+		return x.SeriesIds
+	}
+	return nil
+}
+
+// MergeTagsRequest merges source tags into a target tag across all series.
+type MergeTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// source_tags lists the tags to fold into target_tag.
+	SourceTags []string `protobuf:"bytes,1,rep,name=source_tags,json=sourceTags,proto3" json:"source_tags,omitempty"`
+	// target_tag is the tag that remains after the merge.
+	TargetTag     string `protobuf:"bytes,2,opt,name=target_tag,json=targetTag,proto3" json:"target_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsRequest) Reset() {
+	*x = MergeTagsRequest{}
+	mi := &file_lession_v1_series_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsRequest) ProtoMessage() {}
+
+func (x *MergeTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_series_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsRequest.ProtoReflect.Descriptor instead.
+func (*MergeTagsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_series_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MergeTagsRequest) GetSourceTags() []string {
+	if x != nil {
+		return x.SourceTags
+	}
+	return nil
+}
+
+func (x *MergeTagsRequest) GetTargetTag() string {
+	if x != nil {
+		return x.TargetTag
+	}
+	return ""
+}
+
+// MergeTagsResponse reports the series touched by a merge.
+type MergeTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// series_ids lists the series whose tags were rewritten.
+	SeriesIds     []string `protobuf:"bytes,1,rep,name=series_ids,json=seriesIds,proto3" json:"series_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsResponse) Reset() {
+	*x = MergeTagsResponse{}
+	mi := &file_lession_v1_series_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsResponse) ProtoMessage() {}
+
+func (x *MergeTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_series_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsResponse.ProtoReflect.Descriptor instead.
+func (*MergeTagsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_series_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MergeTagsResponse) GetSeriesIds() []string {
+	if x != nil {
+		return x.SeriesIds
+	}
+	return nil
+}
+
+// ReassignContentRequest transfers series ownership between authors.
+type ReassignContentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// from_author_id is the author whose content should be transferred.
+	FromAuthorId string `protobuf:"bytes,1,opt,name=from_author_id,json=fromAuthorId,proto3" json:"from_author_id,omitempty"`
+	// to_author_id is the author receiving the content.
+	ToAuthorId    string `protobuf:"bytes,2,opt,name=to_author_id,json=toAuthorId,proto3" json:"to_author_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignContentRequest) Reset() {
+	*x = ReassignContentRequest{}
+	mi := &file_lession_v1_series_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignContentRequest) ProtoMessage() {}
+
+func (x *ReassignContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_series_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignContentRequest.ProtoReflect.Descriptor instead.
+func (*ReassignContentRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_series_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReassignContentRequest) GetFromAuthorId() string {
+	if x != nil {
+		return x.FromAuthorId
+	}
+	return ""
+}
+
+func (x *ReassignContentRequest) GetToAuthorId() string {
+	if x != nil {
+		return x.ToAuthorId
+	}
+	return ""
+}
+
+// ReassignContentResponse returns the recorded reassignment.
+type ReassignContentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// reassignment is the audit record describing the transfer.
+	Reassignment  *ContentReassignment `protobuf:"bytes,1,opt,name=reassignment,proto3" json:"reassignment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignContentResponse) Reset() {
+	*x = ReassignContentResponse{}
+	mi := &file_lession_v1_series_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignContentResponse) ProtoMessage() {}
+
+func (x *ReassignContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_series_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignContentResponse.ProtoReflect.Descriptor instead.
+func (*ReassignContentResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_series_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReassignContentResponse) GetReassignment() *ContentReassignment {
+	if x != nil {
+		return x.Reassignment
+	}
+	return nil
+}
+
 var File_lession_v1_series_service_proto protoreflect.FileDescriptor
 
 const file_lession_v1_series_service_proto_rawDesc = "" +
 	"\n" +
 	"\x1flession/v1/series_service.proto\x12\n" +
-	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x17lession/v1/series.proto\"\xfa\x02\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x17lession/v1/series.proto\"\xaf\x04\n" +
 	"\x11ListSeriesRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\rR\bpageSize\x12\x1d\n" +
 	"\n" +
@@ -921,18 +1376,23 @@ const file_lession_v1_series_service_proto_rawDesc = "" +
 	"\x05query\x18\a \x01(\tR\x05query\x12)\n" +
 	"\x10include_episodes\x18\b \x01(\bR\x0fincludeEpisodes\x12+\n" +
 	"\n" +
-	"author_ids\x18\t \x03(\tB\f\xbaH\t\x92\x01\x06\"\x04r\x02\x10\x01R\tauthorIds\"h\n" +
+	"author_ids\x18\t \x03(\tB\f\xbaH\t\x92\x01\x06\"\x04r\x02\x10\x01R\tauthorIds\x12I\n" +
+	"\x0festimated_level\x18\n" +
+	" \x01(\tB \xbaH\x1d\xd8\x01\x01r\x18R\x02A1R\x02A2R\x02B1R\x02B2R\x02C1R\x02C2R\x0eestimatedLevel\x127\n" +
+	"\tread_mask\x18\v \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x12/\n" +
+	"\x13include_transcripts\x18\f \x01(\bR\x12includeTranscripts\"h\n" +
 	"\x12ListSeriesResponse\x12*\n" +
 	"\x06series\x18\x01 \x03(\v2\x12.lession.v1.SeriesR\x06series\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"N\n" +
 	"\x13CreateSeriesRequest\x127\n" +
 	"\x06series\x18\x01 \x01(\v2\x17.lession.v1.SeriesDraftB\x06\xbaH\x03\xc8\x01\x01R\x06series\"B\n" +
 	"\x14CreateSeriesResponse\x12*\n" +
-	"\x06series\x18\x01 \x01(\v2\x12.lession.v1.SeriesR\x06series\"\x8f\x01\n" +
+	"\x06series\x18\x01 \x01(\v2\x12.lession.v1.SeriesR\x06series\"\xc8\x01\n" +
 	"\x10GetSeriesRequest\x12%\n" +
 	"\tseries_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bseriesId\x12)\n" +
 	"\x10include_episodes\x18\x02 \x01(\bR\x0fincludeEpisodes\x12)\n" +
-	"\x10include_metadata\x18\x03 \x01(\bR\x0fincludeMetadata\"?\n" +
+	"\x10include_metadata\x18\x03 \x01(\bR\x0fincludeMetadata\x127\n" +
+	"\tread_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"?\n" +
 	"\x11GetSeriesResponse\x12*\n" +
 	"\x06series\x18\x01 \x01(\v2\x12.lession.v1.SeriesR\x06series\"\xb2\x01\n" +
 	"\x13UpdateSeriesRequest\x12%\n" +
@@ -951,7 +1411,14 @@ const file_lession_v1_series_service_proto_rawDesc = "" +
 	"\n" +
 	"episode_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tepisodeId\"C\n" +
 	"\x12GetEpisodeResponse\x12-\n" +
-	"\aepisode\x18\x01 \x01(\v2\x13.lession.v1.EpisodeR\aepisode\"\xb8\x01\n" +
+	"\aepisode\x18\x01 \x01(\v2\x13.lession.v1.EpisodeR\aepisode\"\x9d\x01\n" +
+	"\x1aGetEpisodeTextStatsRequest\x12'\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tepisodeId\x12$\n" +
+	"\ttop_words\x18\x02 \x01(\rB\a\xbaH\x04*\x02\x18dR\btopWords\x120\n" +
+	"\x14exclude_common_words\x18\x03 \x01(\bR\x12excludeCommonWords\"Q\n" +
+	"\x1bGetEpisodeTextStatsResponse\x122\n" +
+	"\x05stats\x18\x01 \x01(\v2\x1c.lession.v1.EpisodeTextStatsR\x05stats\"\xb8\x01\n" +
 	"\x14UpdateEpisodeRequest\x12'\n" +
 	"\n" +
 	"episode_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tepisodeId\x12:\n" +
@@ -964,7 +1431,28 @@ const file_lession_v1_series_service_proto_rawDesc = "" +
 	"\n" +
 	"episode_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tepisodeId\"F\n" +
 	"\x15DeleteEpisodeResponse\x12-\n" +
-	"\aepisode\x18\x01 \x01(\v2\x13.lession.v1.EpisodeR\aepisode2\x9b\x05\n" +
+	"\aepisode\x18\x01 \x01(\v2\x13.lession.v1.EpisodeR\aepisode\"S\n" +
+	"\x10RenameTagRequest\x12\x1b\n" +
+	"\x03tag\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x03tag\x12\"\n" +
+	"\anew_tag\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x06newTag\"2\n" +
+	"\x11RenameTagResponse\x12\x1d\n" +
+	"\n" +
+	"series_ids\x18\x01 \x03(\tR\tseriesIds\"o\n" +
+	"\x10MergeTagsRequest\x121\n" +
+	"\vsource_tags\x18\x01 \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\b\x01\"\x06r\x04\x10\x01\x18@R\n" +
+	"sourceTags\x12(\n" +
+	"\n" +
+	"target_tag\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\ttargetTag\"2\n" +
+	"\x11MergeTagsResponse\x12\x1d\n" +
+	"\n" +
+	"series_ids\x18\x01 \x03(\tR\tseriesIds\"r\n" +
+	"\x16ReassignContentRequest\x12-\n" +
+	"\x0efrom_author_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\ffromAuthorId\x12)\n" +
+	"\fto_author_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"toAuthorId\"^\n" +
+	"\x17ReassignContentResponse\x12C\n" +
+	"\freassignment\x18\x01 \x01(\v2\x1f.lession.v1.ContentReassignmentR\freassignment2\xf3\a\n" +
 	"\rSeriesService\x12K\n" +
 	"\n" +
 	"ListSeries\x12\x1d.lession.v1.ListSeriesRequest\x1a\x1e.lession.v1.ListSeriesResponse\x12Q\n" +
@@ -975,7 +1463,11 @@ const file_lession_v1_series_service_proto_rawDesc = "" +
 	"\n" +
 	"GetEpisode\x12\x1d.lession.v1.GetEpisodeRequest\x1a\x1e.lession.v1.GetEpisodeResponse\x12T\n" +
 	"\rUpdateEpisode\x12 .lession.v1.UpdateEpisodeRequest\x1a!.lession.v1.UpdateEpisodeResponse\x12T\n" +
-	"\rDeleteEpisode\x12 .lession.v1.DeleteEpisodeRequest\x1a!.lession.v1.DeleteEpisodeResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+	"\rDeleteEpisode\x12 .lession.v1.DeleteEpisodeRequest\x1a!.lession.v1.DeleteEpisodeResponse\x12H\n" +
+	"\tRenameTag\x12\x1c.lession.v1.RenameTagRequest\x1a\x1d.lession.v1.RenameTagResponse\x12H\n" +
+	"\tMergeTags\x12\x1c.lession.v1.MergeTagsRequest\x1a\x1d.lession.v1.MergeTagsResponse\x12Z\n" +
+	"\x0fReassignContent\x12\".lession.v1.ReassignContentRequest\x1a#.lession.v1.ReassignContentResponse\x12f\n" +
+	"\x13GetEpisodeTextStats\x12&.lession.v1.GetEpisodeTextStatsRequest\x1a'.lession.v1.GetEpisodeTextStatsResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
 
 var (
 	file_lession_v1_series_service_proto_rawDescOnce sync.Once
@@ -989,68 +1481,90 @@ func file_lession_v1_series_service_proto_rawDescGZIP() []byte {
 	return file_lession_v1_series_service_proto_rawDescData
 }
 
-var file_lession_v1_series_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_lession_v1_series_service_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_lession_v1_series_service_proto_goTypes = []any{
-	(*ListSeriesRequest)(nil),     // 0: lession.v1.ListSeriesRequest
-	(*ListSeriesResponse)(nil),    // 1: lession.v1.ListSeriesResponse
-	(*CreateSeriesRequest)(nil),   // 2: lession.v1.CreateSeriesRequest
-	(*CreateSeriesResponse)(nil),  // 3: lession.v1.CreateSeriesResponse
-	(*GetSeriesRequest)(nil),      // 4: lession.v1.GetSeriesRequest
-	(*GetSeriesResponse)(nil),     // 5: lession.v1.GetSeriesResponse
-	(*UpdateSeriesRequest)(nil),   // 6: lession.v1.UpdateSeriesRequest
-	(*UpdateSeriesResponse)(nil),  // 7: lession.v1.UpdateSeriesResponse
-	(*CreateEpisodeRequest)(nil),  // 8: lession.v1.CreateEpisodeRequest
-	(*CreateEpisodeResponse)(nil), // 9: lession.v1.CreateEpisodeResponse
-	(*GetEpisodeRequest)(nil),     // 10: lession.v1.GetEpisodeRequest
-	(*GetEpisodeResponse)(nil),    // 11: lession.v1.GetEpisodeResponse
-	(*UpdateEpisodeRequest)(nil),  // 12: lession.v1.UpdateEpisodeRequest
-	(*UpdateEpisodeResponse)(nil), // 13: lession.v1.UpdateEpisodeResponse
-	(*DeleteEpisodeRequest)(nil),  // 14: lession.v1.DeleteEpisodeRequest
-	(*DeleteEpisodeResponse)(nil), // 15: lession.v1.DeleteEpisodeResponse
-	(SeriesStatus)(0),             // 16: lession.v1.SeriesStatus
-	(*Series)(nil),                // 17: lession.v1.Series
-	(*SeriesDraft)(nil),           // 18: lession.v1.SeriesDraft
-	(*fieldmaskpb.FieldMask)(nil), // 19: google.protobuf.FieldMask
-	(*EpisodeDraft)(nil),          // 20: lession.v1.EpisodeDraft
-	(*Episode)(nil),               // 21: lession.v1.Episode
+	(*ListSeriesRequest)(nil),           // 0: lession.v1.ListSeriesRequest
+	(*ListSeriesResponse)(nil),          // 1: lession.v1.ListSeriesResponse
+	(*CreateSeriesRequest)(nil),         // 2: lession.v1.CreateSeriesRequest
+	(*CreateSeriesResponse)(nil),        // 3: lession.v1.CreateSeriesResponse
+	(*GetSeriesRequest)(nil),            // 4: lession.v1.GetSeriesRequest
+	(*GetSeriesResponse)(nil),           // 5: lession.v1.GetSeriesResponse
+	(*UpdateSeriesRequest)(nil),         // 6: lession.v1.UpdateSeriesRequest
+	(*UpdateSeriesResponse)(nil),        // 7: lession.v1.UpdateSeriesResponse
+	(*CreateEpisodeRequest)(nil),        // 8: lession.v1.CreateEpisodeRequest
+	(*CreateEpisodeResponse)(nil),       // 9: lession.v1.CreateEpisodeResponse
+	(*GetEpisodeRequest)(nil),           // 10: lession.v1.GetEpisodeRequest
+	(*GetEpisodeResponse)(nil),          // 11: lession.v1.GetEpisodeResponse
+	(*GetEpisodeTextStatsRequest)(nil),  // 12: lession.v1.GetEpisodeTextStatsRequest
+	(*GetEpisodeTextStatsResponse)(nil), // 13: lession.v1.GetEpisodeTextStatsResponse
+	(*UpdateEpisodeRequest)(nil),        // 14: lession.v1.UpdateEpisodeRequest
+	(*UpdateEpisodeResponse)(nil),       // 15: lession.v1.UpdateEpisodeResponse
+	(*DeleteEpisodeRequest)(nil),        // 16: lession.v1.DeleteEpisodeRequest
+	(*DeleteEpisodeResponse)(nil),       // 17: lession.v1.DeleteEpisodeResponse
+	(*RenameTagRequest)(nil),            // 18: lession.v1.RenameTagRequest
+	(*RenameTagResponse)(nil),           // 19: lession.v1.RenameTagResponse
+	(*MergeTagsRequest)(nil),            // 20: lession.v1.MergeTagsRequest
+	(*MergeTagsResponse)(nil),           // 21: lession.v1.MergeTagsResponse
+	(*ReassignContentRequest)(nil),      // 22: lession.v1.ReassignContentRequest
+	(*ReassignContentResponse)(nil),     // 23: lession.v1.ReassignContentResponse
+	(SeriesStatus)(0),                   // 24: lession.v1.SeriesStatus
+	(*fieldmaskpb.FieldMask)(nil),       // 25: google.protobuf.FieldMask
+	(*Series)(nil),                      // 26: lession.v1.Series
+	(*SeriesDraft)(nil),                 // 27: lession.v1.SeriesDraft
+	(*EpisodeDraft)(nil),                // 28: lession.v1.EpisodeDraft
+	(*Episode)(nil),                     // 29: lession.v1.Episode
+	(*EpisodeTextStats)(nil),            // 30: lession.v1.EpisodeTextStats
+	(*ContentReassignment)(nil),         // 31: lession.v1.ContentReassignment
 }
 var file_lession_v1_series_service_proto_depIdxs = []int32{
-	16, // 0: lession.v1.ListSeriesRequest.statuses:type_name -> lession.v1.SeriesStatus
-	17, // 1: lession.v1.ListSeriesResponse.series:type_name -> lession.v1.Series
-	18, // 2: lession.v1.CreateSeriesRequest.series:type_name -> lession.v1.SeriesDraft
-	17, // 3: lession.v1.CreateSeriesResponse.series:type_name -> lession.v1.Series
-	17, // 4: lession.v1.GetSeriesResponse.series:type_name -> lession.v1.Series
-	18, // 5: lession.v1.UpdateSeriesRequest.series:type_name -> lession.v1.SeriesDraft
-	19, // 6: lession.v1.UpdateSeriesRequest.update_mask:type_name -> google.protobuf.FieldMask
-	17, // 7: lession.v1.UpdateSeriesResponse.series:type_name -> lession.v1.Series
-	20, // 8: lession.v1.CreateEpisodeRequest.episode:type_name -> lession.v1.EpisodeDraft
-	21, // 9: lession.v1.CreateEpisodeResponse.episode:type_name -> lession.v1.Episode
-	21, // 10: lession.v1.GetEpisodeResponse.episode:type_name -> lession.v1.Episode
-	20, // 11: lession.v1.UpdateEpisodeRequest.episode:type_name -> lession.v1.EpisodeDraft
-	19, // 12: lession.v1.UpdateEpisodeRequest.update_mask:type_name -> google.protobuf.FieldMask
-	21, // 13: lession.v1.UpdateEpisodeResponse.episode:type_name -> lession.v1.Episode
-	21, // 14: lession.v1.DeleteEpisodeResponse.episode:type_name -> lession.v1.Episode
-	0,  // 15: lession.v1.SeriesService.ListSeries:input_type -> lession.v1.ListSeriesRequest
-	2,  // 16: lession.v1.SeriesService.CreateSeries:input_type -> lession.v1.CreateSeriesRequest
-	4,  // 17: lession.v1.SeriesService.GetSeries:input_type -> lession.v1.GetSeriesRequest
-	6,  // 18: lession.v1.SeriesService.UpdateSeries:input_type -> lession.v1.UpdateSeriesRequest
-	8,  // 19: lession.v1.SeriesService.CreateEpisode:input_type -> lession.v1.CreateEpisodeRequest
-	10, // 20: lession.v1.SeriesService.GetEpisode:input_type -> lession.v1.GetEpisodeRequest
-	12, // 21: lession.v1.SeriesService.UpdateEpisode:input_type -> lession.v1.UpdateEpisodeRequest
-	14, // 22: lession.v1.SeriesService.DeleteEpisode:input_type -> lession.v1.DeleteEpisodeRequest
-	1,  // 23: lession.v1.SeriesService.ListSeries:output_type -> lession.v1.ListSeriesResponse
-	3,  // 24: lession.v1.SeriesService.CreateSeries:output_type -> lession.v1.CreateSeriesResponse
-	5,  // 25: lession.v1.SeriesService.GetSeries:output_type -> lession.v1.GetSeriesResponse
-	7,  // 26: lession.v1.SeriesService.UpdateSeries:output_type -> lession.v1.UpdateSeriesResponse
-	9,  // 27: lession.v1.SeriesService.CreateEpisode:output_type -> lession.v1.CreateEpisodeResponse
-	11, // 28: lession.v1.SeriesService.GetEpisode:output_type -> lession.v1.GetEpisodeResponse
-	13, // 29: lession.v1.SeriesService.UpdateEpisode:output_type -> lession.v1.UpdateEpisodeResponse
-	15, // 30: lession.v1.SeriesService.DeleteEpisode:output_type -> lession.v1.DeleteEpisodeResponse
-	23, // [23:31] is the sub-list for method output_type
-	15, // [15:23] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	24, // 0: lession.v1.ListSeriesRequest.statuses:type_name -> lession.v1.SeriesStatus
+	25, // 1: lession.v1.ListSeriesRequest.read_mask:type_name -> google.protobuf.FieldMask
+	26, // 2: lession.v1.ListSeriesResponse.series:type_name -> lession.v1.Series
+	27, // 3: lession.v1.CreateSeriesRequest.series:type_name -> lession.v1.SeriesDraft
+	26, // 4: lession.v1.CreateSeriesResponse.series:type_name -> lession.v1.Series
+	25, // 5: lession.v1.GetSeriesRequest.read_mask:type_name -> google.protobuf.FieldMask
+	26, // 6: lession.v1.GetSeriesResponse.series:type_name -> lession.v1.Series
+	27, // 7: lession.v1.UpdateSeriesRequest.series:type_name -> lession.v1.SeriesDraft
+	25, // 8: lession.v1.UpdateSeriesRequest.update_mask:type_name -> google.protobuf.FieldMask
+	26, // 9: lession.v1.UpdateSeriesResponse.series:type_name -> lession.v1.Series
+	28, // 10: lession.v1.CreateEpisodeRequest.episode:type_name -> lession.v1.EpisodeDraft
+	29, // 11: lession.v1.CreateEpisodeResponse.episode:type_name -> lession.v1.Episode
+	29, // 12: lession.v1.GetEpisodeResponse.episode:type_name -> lession.v1.Episode
+	30, // 13: lession.v1.GetEpisodeTextStatsResponse.stats:type_name -> lession.v1.EpisodeTextStats
+	28, // 14: lession.v1.UpdateEpisodeRequest.episode:type_name -> lession.v1.EpisodeDraft
+	25, // 15: lession.v1.UpdateEpisodeRequest.update_mask:type_name -> google.protobuf.FieldMask
+	29, // 16: lession.v1.UpdateEpisodeResponse.episode:type_name -> lession.v1.Episode
+	29, // 17: lession.v1.DeleteEpisodeResponse.episode:type_name -> lession.v1.Episode
+	31, // 18: lession.v1.ReassignContentResponse.reassignment:type_name -> lession.v1.ContentReassignment
+	0,  // 19: lession.v1.SeriesService.ListSeries:input_type -> lession.v1.ListSeriesRequest
+	2,  // 20: lession.v1.SeriesService.CreateSeries:input_type -> lession.v1.CreateSeriesRequest
+	4,  // 21: lession.v1.SeriesService.GetSeries:input_type -> lession.v1.GetSeriesRequest
+	6,  // 22: lession.v1.SeriesService.UpdateSeries:input_type -> lession.v1.UpdateSeriesRequest
+	8,  // 23: lession.v1.SeriesService.CreateEpisode:input_type -> lession.v1.CreateEpisodeRequest
+	10, // 24: lession.v1.SeriesService.GetEpisode:input_type -> lession.v1.GetEpisodeRequest
+	14, // 25: lession.v1.SeriesService.UpdateEpisode:input_type -> lession.v1.UpdateEpisodeRequest
+	16, // 26: lession.v1.SeriesService.DeleteEpisode:input_type -> lession.v1.DeleteEpisodeRequest
+	18, // 27: lession.v1.SeriesService.RenameTag:input_type -> lession.v1.RenameTagRequest
+	20, // 28: lession.v1.SeriesService.MergeTags:input_type -> lession.v1.MergeTagsRequest
+	22, // 29: lession.v1.SeriesService.ReassignContent:input_type -> lession.v1.ReassignContentRequest
+	12, // 30: lession.v1.SeriesService.GetEpisodeTextStats:input_type -> lession.v1.GetEpisodeTextStatsRequest
+	1,  // 31: lession.v1.SeriesService.ListSeries:output_type -> lession.v1.ListSeriesResponse
+	3,  // 32: lession.v1.SeriesService.CreateSeries:output_type -> lession.v1.CreateSeriesResponse
+	5,  // 33: lession.v1.SeriesService.GetSeries:output_type -> lession.v1.GetSeriesResponse
+	7,  // 34: lession.v1.SeriesService.UpdateSeries:output_type -> lession.v1.UpdateSeriesResponse
+	9,  // 35: lession.v1.SeriesService.CreateEpisode:output_type -> lession.v1.CreateEpisodeResponse
+	11, // 36: lession.v1.SeriesService.GetEpisode:output_type -> lession.v1.GetEpisodeResponse
+	15, // 37: lession.v1.SeriesService.UpdateEpisode:output_type -> lession.v1.UpdateEpisodeResponse
+	17, // 38: lession.v1.SeriesService.DeleteEpisode:output_type -> lession.v1.DeleteEpisodeResponse
+	19, // 39: lession.v1.SeriesService.RenameTag:output_type -> lession.v1.RenameTagResponse
+	21, // 40: lession.v1.SeriesService.MergeTags:output_type -> lession.v1.MergeTagsResponse
+	23, // 41: lession.v1.SeriesService.ReassignContent:output_type -> lession.v1.ReassignContentResponse
+	13, // 42: lession.v1.SeriesService.GetEpisodeTextStats:output_type -> lession.v1.GetEpisodeTextStatsResponse
+	31, // [31:43] is the sub-list for method output_type
+	19, // [19:31] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_lession_v1_series_service_proto_init() }
@@ -1065,7 +1579,7 @@ func file_lession_v1_series_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_series_service_proto_rawDesc), len(file_lession_v1_series_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,341 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/shadowing.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ShadowingSubmissionStatus denotes the review state of a submission.
+type ShadowingSubmissionStatus int32
+
+const (
+	// SHADOWING_SUBMISSION_STATUS_UNSPECIFIED is the default zero value.
+	ShadowingSubmissionStatus_SHADOWING_SUBMISSION_STATUS_UNSPECIFIED ShadowingSubmissionStatus = 0
+	// SHADOWING_SUBMISSION_STATUS_PENDING indicates the submission awaits review.
+	ShadowingSubmissionStatus_SHADOWING_SUBMISSION_STATUS_PENDING ShadowingSubmissionStatus = 1
+	// SHADOWING_SUBMISSION_STATUS_REVIEWED indicates a teacher has reviewed the submission.
+	ShadowingSubmissionStatus_SHADOWING_SUBMISSION_STATUS_REVIEWED ShadowingSubmissionStatus = 2
+)
+
+// Enum value maps for ShadowingSubmissionStatus.
+var (
+	ShadowingSubmissionStatus_name = map[int32]string{
+		0: "SHADOWING_SUBMISSION_STATUS_UNSPECIFIED",
+		1: "SHADOWING_SUBMISSION_STATUS_PENDING",
+		2: "SHADOWING_SUBMISSION_STATUS_REVIEWED",
+	}
+	ShadowingSubmissionStatus_value = map[string]int32{
+		"SHADOWING_SUBMISSION_STATUS_UNSPECIFIED": 0,
+		"SHADOWING_SUBMISSION_STATUS_PENDING":     1,
+		"SHADOWING_SUBMISSION_STATUS_REVIEWED":    2,
+	}
+)
+
+func (x ShadowingSubmissionStatus) Enum() *ShadowingSubmissionStatus {
+	p := new(ShadowingSubmissionStatus)
+	*p = x
+	return p
+}
+
+func (x ShadowingSubmissionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShadowingSubmissionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_shadowing_proto_enumTypes[0].Descriptor()
+}
+
+func (ShadowingSubmissionStatus) Type() protoreflect.EnumType {
+	return &file_lession_v1_shadowing_proto_enumTypes[0]
+}
+
+func (x ShadowingSubmissionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShadowingSubmissionStatus.Descriptor instead.
+func (ShadowingSubmissionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_shadowing_proto_rawDescGZIP(), []int{0}
+}
+
+// ShadowingSubmission is a learner recording of a transcript segment.
+type ShadowingSubmission struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the server-assigned identifier for the submission.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// user_id identifies the learner who recorded the segment.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// episode_id identifies the episode the segment belongs to.
+	EpisodeId string `protobuf:"bytes,3,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// segment_index identifies the transcript segment that was shadowed.
+	SegmentIndex int32 `protobuf:"varint,4,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
+	// asset_id references the uploaded audio recording.
+	AssetId string `protobuf:"bytes,5,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// status tracks whether a teacher has reviewed the submission.
+	Status ShadowingSubmissionStatus `protobuf:"varint,6,opt,name=status,proto3,enum=lession.v1.ShadowingSubmissionStatus" json:"status,omitempty"`
+	// auto_score is the automatic pronunciation score between 0 and 100, when scoring is enabled.
+	AutoScore *float64 `protobuf:"fixed64,7,opt,name=auto_score,json=autoScore,proto3,oneof" json:"auto_score,omitempty"`
+	// auto_feedback carries notes produced by automatic scoring.
+	AutoFeedback string `protobuf:"bytes,8,opt,name=auto_feedback,json=autoFeedback,proto3" json:"auto_feedback,omitempty"`
+	// reviewer_id identifies the teacher who reviewed the submission.
+	ReviewerId string `protobuf:"bytes,9,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// review_score is the teacher's score between 0 and 100.
+	ReviewScore *int32 `protobuf:"varint,10,opt,name=review_score,json=reviewScore,proto3,oneof" json:"review_score,omitempty"`
+	// review_comment carries the teacher's feedback.
+	ReviewComment string `protobuf:"bytes,11,opt,name=review_comment,json=reviewComment,proto3" json:"review_comment,omitempty"`
+	// created_at records when the submission was made.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at records when the submission was last modified.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// reviewed_at records when the submission was reviewed.
+	ReviewedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	// status_label is the localized, human-readable submission status, selected by Accept-Language.
+	StatusLabel   string `protobuf:"bytes,15,opt,name=status_label,json=statusLabel,proto3" json:"status_label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShadowingSubmission) Reset() {
+	*x = ShadowingSubmission{}
+	mi := &file_lession_v1_shadowing_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShadowingSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShadowingSubmission) ProtoMessage() {}
+
+func (x *ShadowingSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_shadowing_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShadowingSubmission.ProtoReflect.Descriptor instead.
+func (*ShadowingSubmission) Descriptor() ([]byte, []int) {
+	return file_lession_v1_shadowing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ShadowingSubmission) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShadowingSubmission) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ShadowingSubmission) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *ShadowingSubmission) GetSegmentIndex() int32 {
+	if x != nil {
+		return x.SegmentIndex
+	}
+	return 0
+}
+
+func (x *ShadowingSubmission) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *ShadowingSubmission) GetStatus() ShadowingSubmissionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ShadowingSubmissionStatus_SHADOWING_SUBMISSION_STATUS_UNSPECIFIED
+}
+
+func (x *ShadowingSubmission) GetAutoScore() float64 {
+	if x != nil && x.AutoScore != nil {
+		return *x.AutoScore
+	}
+	return 0
+}
+
+func (x *ShadowingSubmission) GetAutoFeedback() string {
+	if x != nil {
+		return x.AutoFeedback
+	}
+	return ""
+}
+
+func (x *ShadowingSubmission) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ShadowingSubmission) GetReviewScore() int32 {
+	if x != nil && x.ReviewScore != nil {
+		return *x.ReviewScore
+	}
+	return 0
+}
+
+func (x *ShadowingSubmission) GetReviewComment() string {
+	if x != nil {
+		return x.ReviewComment
+	}
+	return ""
+}
+
+func (x *ShadowingSubmission) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ShadowingSubmission) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ShadowingSubmission) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *ShadowingSubmission) GetStatusLabel() string {
+	if x != nil {
+		return x.StatusLabel
+	}
+	return ""
+}
+
+var File_lession_v1_shadowing_proto protoreflect.FileDescriptor
+
+const file_lession_v1_shadowing_proto_rawDesc = "" +
+	"\n" +
+	"\x1alession/v1/shadowing.proto\x12\n" +
+	"lession.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8b\x05\n" +
+	"\x13ShadowingSubmission\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x03 \x01(\tR\tepisodeId\x12#\n" +
+	"\rsegment_index\x18\x04 \x01(\x05R\fsegmentIndex\x12\x19\n" +
+	"\basset_id\x18\x05 \x01(\tR\aassetId\x12=\n" +
+	"\x06status\x18\x06 \x01(\x0e2%.lession.v1.ShadowingSubmissionStatusR\x06status\x12\"\n" +
+	"\n" +
+	"auto_score\x18\a \x01(\x01H\x00R\tautoScore\x88\x01\x01\x12#\n" +
+	"\rauto_feedback\x18\b \x01(\tR\fautoFeedback\x12\x1f\n" +
+	"\vreviewer_id\x18\t \x01(\tR\n" +
+	"reviewerId\x12&\n" +
+	"\freview_score\x18\n" +
+	" \x01(\x05H\x01R\vreviewScore\x88\x01\x01\x12%\n" +
+	"\x0ereview_comment\x18\v \x01(\tR\rreviewComment\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\vreviewed_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x12!\n" +
+	"\fstatus_label\x18\x0f \x01(\tR\vstatusLabelB\r\n" +
+	"\v_auto_scoreB\x0f\n" +
+	"\r_review_score*\x9b\x01\n" +
+	"\x19ShadowingSubmissionStatus\x12+\n" +
+	"'SHADOWING_SUBMISSION_STATUS_UNSPECIFIED\x10\x00\x12'\n" +
+	"#SHADOWING_SUBMISSION_STATUS_PENDING\x10\x01\x12(\n" +
+	"$SHADOWING_SUBMISSION_STATUS_REVIEWED\x10\x02B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_shadowing_proto_rawDescOnce sync.Once
+	file_lession_v1_shadowing_proto_rawDescData []byte
+)
+
+func file_lession_v1_shadowing_proto_rawDescGZIP() []byte {
+	file_lession_v1_shadowing_proto_rawDescOnce.Do(func() {
+		file_lession_v1_shadowing_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_shadowing_proto_rawDesc), len(file_lession_v1_shadowing_proto_rawDesc)))
+	})
+	return file_lession_v1_shadowing_proto_rawDescData
+}
+
+var file_lession_v1_shadowing_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lession_v1_shadowing_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_lession_v1_shadowing_proto_goTypes = []any{
+	(ShadowingSubmissionStatus)(0), // 0: lession.v1.ShadowingSubmissionStatus
+	(*ShadowingSubmission)(nil),    // 1: lession.v1.ShadowingSubmission
+	(*timestamppb.Timestamp)(nil),  // 2: google.protobuf.Timestamp
+}
+var file_lession_v1_shadowing_proto_depIdxs = []int32{
+	0, // 0: lession.v1.ShadowingSubmission.status:type_name -> lession.v1.ShadowingSubmissionStatus
+	2, // 1: lession.v1.ShadowingSubmission.created_at:type_name -> google.protobuf.Timestamp
+	2, // 2: lession.v1.ShadowingSubmission.updated_at:type_name -> google.protobuf.Timestamp
+	2, // 3: lession.v1.ShadowingSubmission.reviewed_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_shadowing_proto_init() }
+func file_lession_v1_shadowing_proto_init() {
+	if File_lession_v1_shadowing_proto != nil {
+		return
+	}
+	file_lession_v1_shadowing_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_shadowing_proto_rawDesc), len(file_lession_v1_shadowing_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_shadowing_proto_goTypes,
+		DependencyIndexes: file_lession_v1_shadowing_proto_depIdxs,
+		EnumInfos:         file_lession_v1_shadowing_proto_enumTypes,
+		MessageInfos:      file_lession_v1_shadowing_proto_msgTypes,
+	}.Build()
+	File_lession_v1_shadowing_proto = out.File
+	file_lession_v1_shadowing_proto_goTypes = nil
+	file_lession_v1_shadowing_proto_depIdxs = nil
+}
@@ -0,0 +1,611 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/shadowing_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CreateShadowingSubmissionRequest attaches a recording to a segment.
+type CreateShadowingSubmissionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// episode_id identifies the episode the segment belongs to.
+	EpisodeId string `protobuf:"bytes,2,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// segment_index identifies the transcript segment that was shadowed.
+	SegmentIndex int32 `protobuf:"varint,3,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
+	// asset_id references the completed audio upload.
+	AssetId       string `protobuf:"bytes,4,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShadowingSubmissionRequest) Reset() {
+	*x = CreateShadowingSubmissionRequest{}
+	mi := &file_lession_v1_shadowing_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShadowingSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShadowingSubmissionRequest) ProtoMessage() {}
+
+func (x *CreateShadowingSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_shadowing_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShadowingSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*CreateShadowingSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_shadowing_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateShadowingSubmissionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateShadowingSubmissionRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *CreateShadowingSubmissionRequest) GetSegmentIndex() int32 {
+	if x != nil {
+		return x.SegmentIndex
+	}
+	return 0
+}
+
+func (x *CreateShadowingSubmissionRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+// CreateShadowingSubmissionResponse returns the stored submission.
+type CreateShadowingSubmissionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// submission is the persisted submission, including any automatic score.
+	Submission    *ShadowingSubmission `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShadowingSubmissionResponse) Reset() {
+	*x = CreateShadowingSubmissionResponse{}
+	mi := &file_lession_v1_shadowing_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShadowingSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShadowingSubmissionResponse) ProtoMessage() {}
+
+func (x *CreateShadowingSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_shadowing_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShadowingSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*CreateShadowingSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_shadowing_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateShadowingSubmissionResponse) GetSubmission() *ShadowingSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+// GetShadowingSubmissionRequest identifies a submission.
+type GetShadowingSubmissionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// submission_id identifies the submission.
+	SubmissionId  string `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShadowingSubmissionRequest) Reset() {
+	*x = GetShadowingSubmissionRequest{}
+	mi := &file_lession_v1_shadowing_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShadowingSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShadowingSubmissionRequest) ProtoMessage() {}
+
+func (x *GetShadowingSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_shadowing_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShadowingSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*GetShadowingSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_shadowing_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetShadowingSubmissionRequest) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+// GetShadowingSubmissionResponse returns the submission.
+type GetShadowingSubmissionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// submission is the requested submission.
+	Submission    *ShadowingSubmission `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShadowingSubmissionResponse) Reset() {
+	*x = GetShadowingSubmissionResponse{}
+	mi := &file_lession_v1_shadowing_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShadowingSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShadowingSubmissionResponse) ProtoMessage() {}
+
+func (x *GetShadowingSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_shadowing_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShadowingSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*GetShadowingSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_shadowing_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetShadowingSubmissionResponse) GetSubmission() *ShadowingSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+// ListShadowingSubmissionsRequest filters submissions.
+type ListShadowingSubmissionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_size limits the number of returned submissions.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token continues a prior ListShadowingSubmissions response.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// user_id restricts submissions to a single learner.
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// episode_id restricts submissions to a single episode.
+	EpisodeId string `protobuf:"bytes,4,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// statuses filters submissions by review state, e.g. pending for a review queue.
+	Statuses      []ShadowingSubmissionStatus `protobuf:"varint,5,rep,packed,name=statuses,proto3,enum=lession.v1.ShadowingSubmissionStatus" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShadowingSubmissionsRequest) Reset() {
+	*x = ListShadowingSubmissionsRequest{}
+	mi := &file_lession_v1_shadowing_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShadowingSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShadowingSubmissionsRequest) ProtoMessage() {}
+
+func (x *ListShadowingSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_shadowing_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShadowingSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListShadowingSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_shadowing_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListShadowingSubmissionsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListShadowingSubmissionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListShadowingSubmissionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListShadowingSubmissionsRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *ListShadowingSubmissionsRequest) GetStatuses() []ShadowingSubmissionStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// ListShadowingSubmissionsResponse returns a page of submissions.
+type ListShadowingSubmissionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// submissions contains the matching submissions, newest first.
+	Submissions []*ShadowingSubmission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	// next_page_token is supplied when more data is available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShadowingSubmissionsResponse) Reset() {
+	*x = ListShadowingSubmissionsResponse{}
+	mi := &file_lession_v1_shadowing_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShadowingSubmissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShadowingSubmissionsResponse) ProtoMessage() {}
+
+func (x *ListShadowingSubmissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_shadowing_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShadowingSubmissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListShadowingSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_shadowing_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListShadowingSubmissionsResponse) GetSubmissions() []*ShadowingSubmission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+func (x *ListShadowingSubmissionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ReviewShadowingSubmissionRequest records a teacher review.
+type ReviewShadowingSubmissionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// submission_id identifies the submission.
+	SubmissionId string `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	// reviewer_id identifies the reviewing teacher.
+	ReviewerId string `protobuf:"bytes,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// score is the teacher's score between 0 and 100.
+	Score int32 `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	// comment carries the teacher's feedback.
+	Comment       string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewShadowingSubmissionRequest) Reset() {
+	*x = ReviewShadowingSubmissionRequest{}
+	mi := &file_lession_v1_shadowing_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewShadowingSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewShadowingSubmissionRequest) ProtoMessage() {}
+
+func (x *ReviewShadowingSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_shadowing_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewShadowingSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*ReviewShadowingSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_shadowing_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReviewShadowingSubmissionRequest) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+func (x *ReviewShadowingSubmissionRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ReviewShadowingSubmissionRequest) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ReviewShadowingSubmissionRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// ReviewShadowingSubmissionResponse returns the reviewed submission.
+type ReviewShadowingSubmissionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// submission is the submission after review.
+	Submission    *ShadowingSubmission `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewShadowingSubmissionResponse) Reset() {
+	*x = ReviewShadowingSubmissionResponse{}
+	mi := &file_lession_v1_shadowing_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewShadowingSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewShadowingSubmissionResponse) ProtoMessage() {}
+
+func (x *ReviewShadowingSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_shadowing_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewShadowingSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*ReviewShadowingSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_shadowing_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReviewShadowingSubmissionResponse) GetSubmission() *ShadowingSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+var File_lession_v1_shadowing_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_shadowing_service_proto_rawDesc = "" +
+	"\n" +
+	"\"lession/v1/shadowing_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1alession/v1/shadowing.proto\"\xc0\x01\n" +
+	" CreateShadowingSubmissionRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\x12'\n" +
+	"\n" +
+	"episode_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tepisodeId\x12,\n" +
+	"\rsegment_index\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\fsegmentIndex\x12#\n" +
+	"\basset_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aassetId\"d\n" +
+	"!CreateShadowingSubmissionResponse\x12?\n" +
+	"\n" +
+	"submission\x18\x01 \x01(\v2\x1f.lession.v1.ShadowingSubmissionR\n" +
+	"submission\"N\n" +
+	"\x1dGetShadowingSubmissionRequest\x12-\n" +
+	"\rsubmission_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fsubmissionId\"a\n" +
+	"\x1eGetShadowingSubmissionResponse\x12?\n" +
+	"\n" +
+	"submission\x18\x01 \x01(\v2\x1f.lession.v1.ShadowingSubmissionR\n" +
+	"submission\"\xfd\x01\n" +
+	"\x1fListShadowingSubmissionsRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\rB\a\xbaH\x04*\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12*\n" +
+	"\n" +
+	"episode_id\x18\x04 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\tepisodeId\x12P\n" +
+	"\bstatuses\x18\x05 \x03(\x0e2%.lession.v1.ShadowingSubmissionStatusB\r\xbaH\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\bstatuses\"\x8d\x01\n" +
+	" ListShadowingSubmissionsResponse\x12A\n" +
+	"\vsubmissions\x18\x01 \x03(\v2\x1f.lession.v1.ShadowingSubmissionR\vsubmissions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc0\x01\n" +
+	" ReviewShadowingSubmissionRequest\x12-\n" +
+	"\rsubmission_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fsubmissionId\x12(\n" +
+	"\vreviewer_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"reviewerId\x12\x1f\n" +
+	"\x05score\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\x05score\x12\"\n" +
+	"\acomment\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\acomment\"d\n" +
+	"!ReviewShadowingSubmissionResponse\x12?\n" +
+	"\n" +
+	"submission\x18\x01 \x01(\v2\x1f.lession.v1.ShadowingSubmissionR\n" +
+	"submission2\xee\x03\n" +
+	"\x10ShadowingService\x12x\n" +
+	"\x19CreateShadowingSubmission\x12,.lession.v1.CreateShadowingSubmissionRequest\x1a-.lession.v1.CreateShadowingSubmissionResponse\x12o\n" +
+	"\x16GetShadowingSubmission\x12).lession.v1.GetShadowingSubmissionRequest\x1a*.lession.v1.GetShadowingSubmissionResponse\x12u\n" +
+	"\x18ListShadowingSubmissions\x12+.lession.v1.ListShadowingSubmissionsRequest\x1a,.lession.v1.ListShadowingSubmissionsResponse\x12x\n" +
+	"\x19ReviewShadowingSubmission\x12,.lession.v1.ReviewShadowingSubmissionRequest\x1a-.lession.v1.ReviewShadowingSubmissionResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_shadowing_service_proto_rawDescOnce sync.Once
+	file_lession_v1_shadowing_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_shadowing_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_shadowing_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_shadowing_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_shadowing_service_proto_rawDesc), len(file_lession_v1_shadowing_service_proto_rawDesc)))
+	})
+	return file_lession_v1_shadowing_service_proto_rawDescData
+}
+
+var file_lession_v1_shadowing_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_lession_v1_shadowing_service_proto_goTypes = []any{
+	(*CreateShadowingSubmissionRequest)(nil),  // 0: lession.v1.CreateShadowingSubmissionRequest
+	(*CreateShadowingSubmissionResponse)(nil), // 1: lession.v1.CreateShadowingSubmissionResponse
+	(*GetShadowingSubmissionRequest)(nil),     // 2: lession.v1.GetShadowingSubmissionRequest
+	(*GetShadowingSubmissionResponse)(nil),    // 3: lession.v1.GetShadowingSubmissionResponse
+	(*ListShadowingSubmissionsRequest)(nil),   // 4: lession.v1.ListShadowingSubmissionsRequest
+	(*ListShadowingSubmissionsResponse)(nil),  // 5: lession.v1.ListShadowingSubmissionsResponse
+	(*ReviewShadowingSubmissionRequest)(nil),  // 6: lession.v1.ReviewShadowingSubmissionRequest
+	(*ReviewShadowingSubmissionResponse)(nil), // 7: lession.v1.ReviewShadowingSubmissionResponse
+	(*ShadowingSubmission)(nil),               // 8: lession.v1.ShadowingSubmission
+	(ShadowingSubmissionStatus)(0),            // 9: lession.v1.ShadowingSubmissionStatus
+}
+var file_lession_v1_shadowing_service_proto_depIdxs = []int32{
+	8, // 0: lession.v1.CreateShadowingSubmissionResponse.submission:type_name -> lession.v1.ShadowingSubmission
+	8, // 1: lession.v1.GetShadowingSubmissionResponse.submission:type_name -> lession.v1.ShadowingSubmission
+	9, // 2: lession.v1.ListShadowingSubmissionsRequest.statuses:type_name -> lession.v1.ShadowingSubmissionStatus
+	8, // 3: lession.v1.ListShadowingSubmissionsResponse.submissions:type_name -> lession.v1.ShadowingSubmission
+	8, // 4: lession.v1.ReviewShadowingSubmissionResponse.submission:type_name -> lession.v1.ShadowingSubmission
+	0, // 5: lession.v1.ShadowingService.CreateShadowingSubmission:input_type -> lession.v1.CreateShadowingSubmissionRequest
+	2, // 6: lession.v1.ShadowingService.GetShadowingSubmission:input_type -> lession.v1.GetShadowingSubmissionRequest
+	4, // 7: lession.v1.ShadowingService.ListShadowingSubmissions:input_type -> lession.v1.ListShadowingSubmissionsRequest
+	6, // 8: lession.v1.ShadowingService.ReviewShadowingSubmission:input_type -> lession.v1.ReviewShadowingSubmissionRequest
+	1, // 9: lession.v1.ShadowingService.CreateShadowingSubmission:output_type -> lession.v1.CreateShadowingSubmissionResponse
+	3, // 10: lession.v1.ShadowingService.GetShadowingSubmission:output_type -> lession.v1.GetShadowingSubmissionResponse
+	5, // 11: lession.v1.ShadowingService.ListShadowingSubmissions:output_type -> lession.v1.ListShadowingSubmissionsResponse
+	7, // 12: lession.v1.ShadowingService.ReviewShadowingSubmission:output_type -> lession.v1.ReviewShadowingSubmissionResponse
+	9, // [9:13] is the sub-list for method output_type
+	5, // [5:9] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_shadowing_service_proto_init() }
+func file_lession_v1_shadowing_service_proto_init() {
+	if File_lession_v1_shadowing_service_proto != nil {
+		return
+	}
+	file_lession_v1_shadowing_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_shadowing_service_proto_rawDesc), len(file_lession_v1_shadowing_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_shadowing_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_shadowing_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_shadowing_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_shadowing_service_proto = out.File
+	file_lession_v1_shadowing_service_proto_goTypes = nil
+	file_lession_v1_shadowing_service_proto_depIdxs = nil
+}
@@ -0,0 +1,622 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/subscription.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BillingInterval enumerates how often plans renew.
+type BillingInterval int32
+
+const (
+	// BILLING_INTERVAL_UNSPECIFIED is the default zero value.
+	BillingInterval_BILLING_INTERVAL_UNSPECIFIED BillingInterval = 0
+	// BILLING_INTERVAL_MONTH renews every calendar month.
+	BillingInterval_BILLING_INTERVAL_MONTH BillingInterval = 1
+	// BILLING_INTERVAL_YEAR renews every year.
+	BillingInterval_BILLING_INTERVAL_YEAR BillingInterval = 2
+)
+
+// Enum value maps for BillingInterval.
+var (
+	BillingInterval_name = map[int32]string{
+		0: "BILLING_INTERVAL_UNSPECIFIED",
+		1: "BILLING_INTERVAL_MONTH",
+		2: "BILLING_INTERVAL_YEAR",
+	}
+	BillingInterval_value = map[string]int32{
+		"BILLING_INTERVAL_UNSPECIFIED": 0,
+		"BILLING_INTERVAL_MONTH":       1,
+		"BILLING_INTERVAL_YEAR":        2,
+	}
+)
+
+func (x BillingInterval) Enum() *BillingInterval {
+	p := new(BillingInterval)
+	*p = x
+	return p
+}
+
+func (x BillingInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BillingInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_subscription_proto_enumTypes[0].Descriptor()
+}
+
+func (BillingInterval) Type() protoreflect.EnumType {
+	return &file_lession_v1_subscription_proto_enumTypes[0]
+}
+
+func (x BillingInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BillingInterval.Descriptor instead.
+func (BillingInterval) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_subscription_proto_rawDescGZIP(), []int{0}
+}
+
+// SubscriptionStatus enumerates lifecycle stages for subscriptions.
+type SubscriptionStatus int32
+
+const (
+	// SUBSCRIPTION_STATUS_UNSPECIFIED is the default zero value.
+	SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED SubscriptionStatus = 0
+	// SUBSCRIPTION_STATUS_ACTIVE indicates the subscription renews and grants access.
+	SubscriptionStatus_SUBSCRIPTION_STATUS_ACTIVE SubscriptionStatus = 1
+	// SUBSCRIPTION_STATUS_CANCELED indicates renewal stopped; access lasts until the period ends.
+	SubscriptionStatus_SUBSCRIPTION_STATUS_CANCELED SubscriptionStatus = 2
+	// SUBSCRIPTION_STATUS_EXPIRED indicates the paid period has ended.
+	SubscriptionStatus_SUBSCRIPTION_STATUS_EXPIRED SubscriptionStatus = 3
+)
+
+// Enum value maps for SubscriptionStatus.
+var (
+	SubscriptionStatus_name = map[int32]string{
+		0: "SUBSCRIPTION_STATUS_UNSPECIFIED",
+		1: "SUBSCRIPTION_STATUS_ACTIVE",
+		2: "SUBSCRIPTION_STATUS_CANCELED",
+		3: "SUBSCRIPTION_STATUS_EXPIRED",
+	}
+	SubscriptionStatus_value = map[string]int32{
+		"SUBSCRIPTION_STATUS_UNSPECIFIED": 0,
+		"SUBSCRIPTION_STATUS_ACTIVE":      1,
+		"SUBSCRIPTION_STATUS_CANCELED":    2,
+		"SUBSCRIPTION_STATUS_EXPIRED":     3,
+	}
+)
+
+func (x SubscriptionStatus) Enum() *SubscriptionStatus {
+	p := new(SubscriptionStatus)
+	*p = x
+	return p
+}
+
+func (x SubscriptionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubscriptionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_subscription_proto_enumTypes[1].Descriptor()
+}
+
+func (SubscriptionStatus) Type() protoreflect.EnumType {
+	return &file_lession_v1_subscription_proto_enumTypes[1]
+}
+
+func (x SubscriptionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubscriptionStatus.Descriptor instead.
+func (SubscriptionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_subscription_proto_rawDescGZIP(), []int{1}
+}
+
+// Plan is a purchasable tier granting access to non-preview episodes.
+type Plan struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the plan.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// code is the stable, human-readable plan identifier, e.g. "premium".
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// name is the display name of the plan.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// description explains what the plan includes.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// price_cents is the price per billing interval in the currency's minor unit.
+	PriceCents int64 `protobuf:"varint,5,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	// currency is the ISO 4217 currency code.
+	Currency string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	// interval is how often the plan renews.
+	Interval BillingInterval `protobuf:"varint,7,opt,name=interval,proto3,enum=lession.v1.BillingInterval" json:"interval,omitempty"`
+	// active reports whether the plan can be subscribed to.
+	Active bool `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	// created_at is when the plan was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is when the plan was last modified.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Plan) Reset() {
+	*x = Plan{}
+	mi := &file_lession_v1_subscription_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan) ProtoMessage() {}
+
+func (x *Plan) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_subscription_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan.ProtoReflect.Descriptor instead.
+func (*Plan) Descriptor() ([]byte, []int) {
+	return file_lession_v1_subscription_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Plan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Plan) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Plan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Plan) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Plan) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+func (x *Plan) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Plan) GetInterval() BillingInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return BillingInterval_BILLING_INTERVAL_UNSPECIFIED
+}
+
+func (x *Plan) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Plan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Plan) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// PlanDraft contains user-modifiable plan attributes.
+type PlanDraft struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// code is the stable, human-readable plan identifier.
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// name is the display name of the plan.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// description explains what the plan includes.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// price_cents is the price per billing interval in the currency's minor unit.
+	PriceCents int64 `protobuf:"varint,4,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	// currency is the ISO 4217 currency code.
+	Currency string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	// interval is how often the plan renews.
+	Interval BillingInterval `protobuf:"varint,6,opt,name=interval,proto3,enum=lession.v1.BillingInterval" json:"interval,omitempty"`
+	// active reports whether the plan can be subscribed to.
+	Active        bool `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanDraft) Reset() {
+	*x = PlanDraft{}
+	mi := &file_lession_v1_subscription_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanDraft) ProtoMessage() {}
+
+func (x *PlanDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_subscription_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanDraft.ProtoReflect.Descriptor instead.
+func (*PlanDraft) Descriptor() ([]byte, []int) {
+	return file_lession_v1_subscription_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlanDraft) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PlanDraft) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlanDraft) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PlanDraft) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+func (x *PlanDraft) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PlanDraft) GetInterval() BillingInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return BillingInterval_BILLING_INTERVAL_UNSPECIFIED
+}
+
+func (x *PlanDraft) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+// Subscription grants a learner a plan for a billing period.
+type Subscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the subscription.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// plan_id references the subscribed plan.
+	PlanId string `protobuf:"bytes,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// status is the lifecycle stage of the subscription.
+	Status SubscriptionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=lession.v1.SubscriptionStatus" json:"status,omitempty"`
+	// current_period_start is when the current billing period began.
+	CurrentPeriodStart *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=current_period_start,json=currentPeriodStart,proto3" json:"current_period_start,omitempty"`
+	// current_period_end is when access lapses unless the subscription renews.
+	CurrentPeriodEnd *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=current_period_end,json=currentPeriodEnd,proto3" json:"current_period_end,omitempty"`
+	// canceled_at is when the learner canceled; access continues until current_period_end.
+	CanceledAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=canceled_at,json=canceledAt,proto3" json:"canceled_at,omitempty"`
+	// created_at is when the subscription started.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is when the subscription was last modified.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// status_label is the localized, human-readable subscription status, selected by Accept-Language.
+	StatusLabel string `protobuf:"bytes,10,opt,name=status_label,json=statusLabel,proto3" json:"status_label,omitempty"`
+	// billing_provider names the payment processor managing renewal, or is empty
+	// for subscriptions started without payment.
+	BillingProvider string `protobuf:"bytes,11,opt,name=billing_provider,json=billingProvider,proto3" json:"billing_provider,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_lession_v1_subscription_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_subscription_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_lession_v1_subscription_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Subscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subscription) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Subscription) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *Subscription) GetStatus() SubscriptionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubscriptionStatus_SUBSCRIPTION_STATUS_UNSPECIFIED
+}
+
+func (x *Subscription) GetCurrentPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CurrentPeriodStart
+	}
+	return nil
+}
+
+func (x *Subscription) GetCurrentPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CurrentPeriodEnd
+	}
+	return nil
+}
+
+func (x *Subscription) GetCanceledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CanceledAt
+	}
+	return nil
+}
+
+func (x *Subscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Subscription) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Subscription) GetStatusLabel() string {
+	if x != nil {
+		return x.StatusLabel
+	}
+	return ""
+}
+
+func (x *Subscription) GetBillingProvider() string {
+	if x != nil {
+		return x.BillingProvider
+	}
+	return ""
+}
+
+var File_lession_v1_subscription_proto protoreflect.FileDescriptor
+
+const file_lession_v1_subscription_proto_rawDesc = "" +
+	"\n" +
+	"\x1dlession/v1/subscription.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe4\x02\n" +
+	"\x04Plan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vprice_cents\x18\x05 \x01(\x03R\n" +
+	"priceCents\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x127\n" +
+	"\binterval\x18\a \x01(\x0e2\x1b.lession.v1.BillingIntervalR\binterval\x12\x16\n" +
+	"\x06active\x18\b \x01(\bR\x06active\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc3\x02\n" +
+	"\tPlanDraft\x124\n" +
+	"\x04code\x18\x01 \x01(\tB \xbaH\x1dr\x1b\x10\x01\x18@2\x15^[a-z0-9][a-z0-9_-]*$R\x04code\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x01R\x04name\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\x12(\n" +
+	"\vprice_cents\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\n" +
+	"priceCents\x12-\n" +
+	"\bcurrency\x18\x05 \x01(\tB\x11\xbaH\x0er\f2\n" +
+	"^[A-Z]{3}$R\bcurrency\x12C\n" +
+	"\binterval\x18\x06 \x01(\x0e2\x1b.lession.v1.BillingIntervalB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\binterval\x12\x16\n" +
+	"\x06active\x18\a \x01(\bR\x06active\"\xa1\x04\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\aplan_id\x18\x03 \x01(\tR\x06planId\x126\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1e.lession.v1.SubscriptionStatusR\x06status\x12L\n" +
+	"\x14current_period_start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x12currentPeriodStart\x12H\n" +
+	"\x12current_period_end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x10currentPeriodEnd\x12;\n" +
+	"\vcanceled_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"canceledAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12!\n" +
+	"\fstatus_label\x18\n" +
+	" \x01(\tR\vstatusLabel\x12)\n" +
+	"\x10billing_provider\x18\v \x01(\tR\x0fbillingProvider*j\n" +
+	"\x0fBillingInterval\x12 \n" +
+	"\x1cBILLING_INTERVAL_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16BILLING_INTERVAL_MONTH\x10\x01\x12\x19\n" +
+	"\x15BILLING_INTERVAL_YEAR\x10\x02*\x9c\x01\n" +
+	"\x12SubscriptionStatus\x12#\n" +
+	"\x1fSUBSCRIPTION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aSUBSCRIPTION_STATUS_ACTIVE\x10\x01\x12 \n" +
+	"\x1cSUBSCRIPTION_STATUS_CANCELED\x10\x02\x12\x1f\n" +
+	"\x1bSUBSCRIPTION_STATUS_EXPIRED\x10\x03B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_subscription_proto_rawDescOnce sync.Once
+	file_lession_v1_subscription_proto_rawDescData []byte
+)
+
+func file_lession_v1_subscription_proto_rawDescGZIP() []byte {
+	file_lession_v1_subscription_proto_rawDescOnce.Do(func() {
+		file_lession_v1_subscription_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_subscription_proto_rawDesc), len(file_lession_v1_subscription_proto_rawDesc)))
+	})
+	return file_lession_v1_subscription_proto_rawDescData
+}
+
+var file_lession_v1_subscription_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_lession_v1_subscription_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_lession_v1_subscription_proto_goTypes = []any{
+	(BillingInterval)(0),          // 0: lession.v1.BillingInterval
+	(SubscriptionStatus)(0),       // 1: lession.v1.SubscriptionStatus
+	(*Plan)(nil),                  // 2: lession.v1.Plan
+	(*PlanDraft)(nil),             // 3: lession.v1.PlanDraft
+	(*Subscription)(nil),          // 4: lession.v1.Subscription
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_lession_v1_subscription_proto_depIdxs = []int32{
+	0,  // 0: lession.v1.Plan.interval:type_name -> lession.v1.BillingInterval
+	5,  // 1: lession.v1.Plan.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 2: lession.v1.Plan.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: lession.v1.PlanDraft.interval:type_name -> lession.v1.BillingInterval
+	1,  // 4: lession.v1.Subscription.status:type_name -> lession.v1.SubscriptionStatus
+	5,  // 5: lession.v1.Subscription.current_period_start:type_name -> google.protobuf.Timestamp
+	5,  // 6: lession.v1.Subscription.current_period_end:type_name -> google.protobuf.Timestamp
+	5,  // 7: lession.v1.Subscription.canceled_at:type_name -> google.protobuf.Timestamp
+	5,  // 8: lession.v1.Subscription.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 9: lession.v1.Subscription.updated_at:type_name -> google.protobuf.Timestamp
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_subscription_proto_init() }
+func file_lession_v1_subscription_proto_init() {
+	if File_lession_v1_subscription_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_subscription_proto_rawDesc), len(file_lession_v1_subscription_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_subscription_proto_goTypes,
+		DependencyIndexes: file_lession_v1_subscription_proto_depIdxs,
+		EnumInfos:         file_lession_v1_subscription_proto_enumTypes,
+		MessageInfos:      file_lession_v1_subscription_proto_msgTypes,
+	}.Build()
+	File_lession_v1_subscription_proto = out.File
+	file_lession_v1_subscription_proto_goTypes = nil
+	file_lession_v1_subscription_proto_depIdxs = nil
+}
@@ -0,0 +1,553 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/transcript_admin.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TranscriptReplaceJobStatus enumerates job lifecycle stages.
+type TranscriptReplaceJobStatus int32
+
+const (
+	// TRANSCRIPT_REPLACE_JOB_STATUS_UNSPECIFIED indicates the status was not provided.
+	TranscriptReplaceJobStatus_TRANSCRIPT_REPLACE_JOB_STATUS_UNSPECIFIED TranscriptReplaceJobStatus = 0
+	// TRANSCRIPT_REPLACE_JOB_STATUS_PENDING indicates the job is queued.
+	TranscriptReplaceJobStatus_TRANSCRIPT_REPLACE_JOB_STATUS_PENDING TranscriptReplaceJobStatus = 1
+	// TRANSCRIPT_REPLACE_JOB_STATUS_RUNNING indicates the job is scanning transcripts.
+	TranscriptReplaceJobStatus_TRANSCRIPT_REPLACE_JOB_STATUS_RUNNING TranscriptReplaceJobStatus = 2
+	// TRANSCRIPT_REPLACE_JOB_STATUS_SUCCEEDED indicates the job finished.
+	TranscriptReplaceJobStatus_TRANSCRIPT_REPLACE_JOB_STATUS_SUCCEEDED TranscriptReplaceJobStatus = 3
+	// TRANSCRIPT_REPLACE_JOB_STATUS_FAILED indicates the job stopped with an error.
+	TranscriptReplaceJobStatus_TRANSCRIPT_REPLACE_JOB_STATUS_FAILED TranscriptReplaceJobStatus = 4
+	// TRANSCRIPT_REPLACE_JOB_STATUS_ROLLED_BACK indicates the job's edits were reverted.
+	TranscriptReplaceJobStatus_TRANSCRIPT_REPLACE_JOB_STATUS_ROLLED_BACK TranscriptReplaceJobStatus = 5
+)
+
+// Enum value maps for TranscriptReplaceJobStatus.
+var (
+	TranscriptReplaceJobStatus_name = map[int32]string{
+		0: "TRANSCRIPT_REPLACE_JOB_STATUS_UNSPECIFIED",
+		1: "TRANSCRIPT_REPLACE_JOB_STATUS_PENDING",
+		2: "TRANSCRIPT_REPLACE_JOB_STATUS_RUNNING",
+		3: "TRANSCRIPT_REPLACE_JOB_STATUS_SUCCEEDED",
+		4: "TRANSCRIPT_REPLACE_JOB_STATUS_FAILED",
+		5: "TRANSCRIPT_REPLACE_JOB_STATUS_ROLLED_BACK",
+	}
+	TranscriptReplaceJobStatus_value = map[string]int32{
+		"TRANSCRIPT_REPLACE_JOB_STATUS_UNSPECIFIED": 0,
+		"TRANSCRIPT_REPLACE_JOB_STATUS_PENDING":     1,
+		"TRANSCRIPT_REPLACE_JOB_STATUS_RUNNING":     2,
+		"TRANSCRIPT_REPLACE_JOB_STATUS_SUCCEEDED":   3,
+		"TRANSCRIPT_REPLACE_JOB_STATUS_FAILED":      4,
+		"TRANSCRIPT_REPLACE_JOB_STATUS_ROLLED_BACK": 5,
+	}
+)
+
+func (x TranscriptReplaceJobStatus) Enum() *TranscriptReplaceJobStatus {
+	p := new(TranscriptReplaceJobStatus)
+	*p = x
+	return p
+}
+
+func (x TranscriptReplaceJobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TranscriptReplaceJobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_transcript_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (TranscriptReplaceJobStatus) Type() protoreflect.EnumType {
+	return &file_lession_v1_transcript_admin_proto_enumTypes[0]
+}
+
+func (x TranscriptReplaceJobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TranscriptReplaceJobStatus.Descriptor instead.
+func (TranscriptReplaceJobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_transcript_admin_proto_rawDescGZIP(), []int{0}
+}
+
+// TranscriptReplaceJob is an asynchronous search-and-replace across episode transcripts.
+type TranscriptReplaceJob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the server-assigned identifier for the job.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pattern is the literal text or regular expression searched for.
+	Pattern string `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// replacement is the text substituted for each match; regex jobs may reference groups as $1.
+	Replacement string `protobuf:"bytes,3,opt,name=replacement,proto3" json:"replacement,omitempty"`
+	// regex interprets pattern as an RE2 regular expression.
+	Regex bool `protobuf:"varint,4,opt,name=regex,proto3" json:"regex,omitempty"`
+	// case_insensitive matches pattern regardless of case.
+	CaseInsensitive bool `protobuf:"varint,5,opt,name=case_insensitive,json=caseInsensitive,proto3" json:"case_insensitive,omitempty"`
+	// series_id restricts the job to one series when set.
+	SeriesId string `protobuf:"bytes,6,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// language restricts the job to transcripts in this language when set.
+	Language string `protobuf:"bytes,7,opt,name=language,proto3" json:"language,omitempty"`
+	// dry_run records a diff preview without changing any transcript.
+	DryRun bool `protobuf:"varint,8,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// requested_by identifies the administrator who started the job.
+	RequestedBy string `protobuf:"bytes,9,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	// status tracks the job lifecycle.
+	Status TranscriptReplaceJobStatus `protobuf:"varint,10,opt,name=status,proto3,enum=lession.v1.TranscriptReplaceJobStatus" json:"status,omitempty"`
+	// episodes_scanned counts transcripts examined so far.
+	EpisodesScanned uint32 `protobuf:"varint,11,opt,name=episodes_scanned,json=episodesScanned,proto3" json:"episodes_scanned,omitempty"`
+	// episodes_changed counts transcripts changed, or that would change for dry runs.
+	EpisodesChanged uint32 `protobuf:"varint,12,opt,name=episodes_changed,json=episodesChanged,proto3" json:"episodes_changed,omitempty"`
+	// matches counts pattern matches across all scanned transcripts.
+	Matches uint32 `protobuf:"varint,13,opt,name=matches,proto3" json:"matches,omitempty"`
+	// changes previews per-episode diffs; the preview is capped, counters are not.
+	Changes []*TranscriptChange `protobuf:"bytes,14,rep,name=changes,proto3" json:"changes,omitempty"`
+	// error describes why a failed job stopped.
+	Error string `protobuf:"bytes,15,opt,name=error,proto3" json:"error,omitempty"`
+	// created_at records when the job was accepted.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// started_at records when the job began scanning.
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// finished_at records when the job completed or failed.
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// rolled_back_at records when the job's edits were reverted.
+	RolledBackAt *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=rolled_back_at,json=rolledBackAt,proto3" json:"rolled_back_at,omitempty"`
+	// status_label is the localized, human-readable job status, selected by Accept-Language.
+	StatusLabel   string `protobuf:"bytes,20,opt,name=status_label,json=statusLabel,proto3" json:"status_label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TranscriptReplaceJob) Reset() {
+	*x = TranscriptReplaceJob{}
+	mi := &file_lession_v1_transcript_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TranscriptReplaceJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranscriptReplaceJob) ProtoMessage() {}
+
+func (x *TranscriptReplaceJob) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_transcript_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranscriptReplaceJob.ProtoReflect.Descriptor instead.
+func (*TranscriptReplaceJob) Descriptor() ([]byte, []int) {
+	return file_lession_v1_transcript_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TranscriptReplaceJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TranscriptReplaceJob) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *TranscriptReplaceJob) GetReplacement() string {
+	if x != nil {
+		return x.Replacement
+	}
+	return ""
+}
+
+func (x *TranscriptReplaceJob) GetRegex() bool {
+	if x != nil {
+		return x.Regex
+	}
+	return false
+}
+
+func (x *TranscriptReplaceJob) GetCaseInsensitive() bool {
+	if x != nil {
+		return x.CaseInsensitive
+	}
+	return false
+}
+
+func (x *TranscriptReplaceJob) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *TranscriptReplaceJob) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *TranscriptReplaceJob) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *TranscriptReplaceJob) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *TranscriptReplaceJob) GetStatus() TranscriptReplaceJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TranscriptReplaceJobStatus_TRANSCRIPT_REPLACE_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *TranscriptReplaceJob) GetEpisodesScanned() uint32 {
+	if x != nil {
+		return x.EpisodesScanned
+	}
+	return 0
+}
+
+func (x *TranscriptReplaceJob) GetEpisodesChanged() uint32 {
+	if x != nil {
+		return x.EpisodesChanged
+	}
+	return 0
+}
+
+func (x *TranscriptReplaceJob) GetMatches() uint32 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+func (x *TranscriptReplaceJob) GetChanges() []*TranscriptChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *TranscriptReplaceJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TranscriptReplaceJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TranscriptReplaceJob) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *TranscriptReplaceJob) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *TranscriptReplaceJob) GetRolledBackAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RolledBackAt
+	}
+	return nil
+}
+
+func (x *TranscriptReplaceJob) GetStatusLabel() string {
+	if x != nil {
+		return x.StatusLabel
+	}
+	return ""
+}
+
+// TranscriptChange summarises the edit for one episode.
+type TranscriptChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// episode_id identifies the edited episode.
+	EpisodeId string `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// matches counts pattern matches in the transcript.
+	Matches uint32 `protobuf:"varint,2,opt,name=matches,proto3" json:"matches,omitempty"`
+	// lines previews changed lines.
+	Lines []*TranscriptLineDiff `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	// skip_reason explains why a matching transcript was left unchanged.
+	SkipReason    string `protobuf:"bytes,4,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TranscriptChange) Reset() {
+	*x = TranscriptChange{}
+	mi := &file_lession_v1_transcript_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TranscriptChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranscriptChange) ProtoMessage() {}
+
+func (x *TranscriptChange) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_transcript_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranscriptChange.ProtoReflect.Descriptor instead.
+func (*TranscriptChange) Descriptor() ([]byte, []int) {
+	return file_lession_v1_transcript_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TranscriptChange) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *TranscriptChange) GetMatches() uint32 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+func (x *TranscriptChange) GetLines() []*TranscriptLineDiff {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *TranscriptChange) GetSkipReason() string {
+	if x != nil {
+		return x.SkipReason
+	}
+	return ""
+}
+
+// TranscriptLineDiff shows a transcript line before and after replacement.
+type TranscriptLineDiff struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// line is the one-based line number within the transcript.
+	Line uint32 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	// before is the original line.
+	Before string `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	// after is the line with replacements applied.
+	After         string `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TranscriptLineDiff) Reset() {
+	*x = TranscriptLineDiff{}
+	mi := &file_lession_v1_transcript_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TranscriptLineDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranscriptLineDiff) ProtoMessage() {}
+
+func (x *TranscriptLineDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_transcript_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranscriptLineDiff.ProtoReflect.Descriptor instead.
+func (*TranscriptLineDiff) Descriptor() ([]byte, []int) {
+	return file_lession_v1_transcript_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TranscriptLineDiff) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *TranscriptLineDiff) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *TranscriptLineDiff) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+var File_lession_v1_transcript_admin_proto protoreflect.FileDescriptor
+
+const file_lession_v1_transcript_admin_proto_rawDesc = "" +
+	"\n" +
+	"!lession/v1/transcript_admin.proto\x12\n" +
+	"lession.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xae\x06\n" +
+	"\x14TranscriptReplaceJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\apattern\x18\x02 \x01(\tR\apattern\x12 \n" +
+	"\vreplacement\x18\x03 \x01(\tR\vreplacement\x12\x14\n" +
+	"\x05regex\x18\x04 \x01(\bR\x05regex\x12)\n" +
+	"\x10case_insensitive\x18\x05 \x01(\bR\x0fcaseInsensitive\x12\x1b\n" +
+	"\tseries_id\x18\x06 \x01(\tR\bseriesId\x12\x1a\n" +
+	"\blanguage\x18\a \x01(\tR\blanguage\x12\x17\n" +
+	"\adry_run\x18\b \x01(\bR\x06dryRun\x12!\n" +
+	"\frequested_by\x18\t \x01(\tR\vrequestedBy\x12>\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2&.lession.v1.TranscriptReplaceJobStatusR\x06status\x12)\n" +
+	"\x10episodes_scanned\x18\v \x01(\rR\x0fepisodesScanned\x12)\n" +
+	"\x10episodes_changed\x18\f \x01(\rR\x0fepisodesChanged\x12\x18\n" +
+	"\amatches\x18\r \x01(\rR\amatches\x126\n" +
+	"\achanges\x18\x0e \x03(\v2\x1c.lession.v1.TranscriptChangeR\achanges\x12\x14\n" +
+	"\x05error\x18\x0f \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"started_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12@\n" +
+	"\x0erolled_back_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\frolledBackAt\x12!\n" +
+	"\fstatus_label\x18\x14 \x01(\tR\vstatusLabel\"\xa2\x01\n" +
+	"\x10TranscriptChange\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\tepisodeId\x12\x18\n" +
+	"\amatches\x18\x02 \x01(\rR\amatches\x124\n" +
+	"\x05lines\x18\x03 \x03(\v2\x1e.lession.v1.TranscriptLineDiffR\x05lines\x12\x1f\n" +
+	"\vskip_reason\x18\x04 \x01(\tR\n" +
+	"skipReason\"V\n" +
+	"\x12TranscriptLineDiff\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\rR\x04line\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after*\xa7\x02\n" +
+	"\x1aTranscriptReplaceJobStatus\x12-\n" +
+	")TRANSCRIPT_REPLACE_JOB_STATUS_UNSPECIFIED\x10\x00\x12)\n" +
+	"%TRANSCRIPT_REPLACE_JOB_STATUS_PENDING\x10\x01\x12)\n" +
+	"%TRANSCRIPT_REPLACE_JOB_STATUS_RUNNING\x10\x02\x12+\n" +
+	"'TRANSCRIPT_REPLACE_JOB_STATUS_SUCCEEDED\x10\x03\x12(\n" +
+	"$TRANSCRIPT_REPLACE_JOB_STATUS_FAILED\x10\x04\x12-\n" +
+	")TRANSCRIPT_REPLACE_JOB_STATUS_ROLLED_BACK\x10\x05B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_transcript_admin_proto_rawDescOnce sync.Once
+	file_lession_v1_transcript_admin_proto_rawDescData []byte
+)
+
+func file_lession_v1_transcript_admin_proto_rawDescGZIP() []byte {
+	file_lession_v1_transcript_admin_proto_rawDescOnce.Do(func() {
+		file_lession_v1_transcript_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_transcript_admin_proto_rawDesc), len(file_lession_v1_transcript_admin_proto_rawDesc)))
+	})
+	return file_lession_v1_transcript_admin_proto_rawDescData
+}
+
+var file_lession_v1_transcript_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lession_v1_transcript_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_lession_v1_transcript_admin_proto_goTypes = []any{
+	(TranscriptReplaceJobStatus)(0), // 0: lession.v1.TranscriptReplaceJobStatus
+	(*TranscriptReplaceJob)(nil),    // 1: lession.v1.TranscriptReplaceJob
+	(*TranscriptChange)(nil),        // 2: lession.v1.TranscriptChange
+	(*TranscriptLineDiff)(nil),      // 3: lession.v1.TranscriptLineDiff
+	(*timestamppb.Timestamp)(nil),   // 4: google.protobuf.Timestamp
+}
+var file_lession_v1_transcript_admin_proto_depIdxs = []int32{
+	0, // 0: lession.v1.TranscriptReplaceJob.status:type_name -> lession.v1.TranscriptReplaceJobStatus
+	2, // 1: lession.v1.TranscriptReplaceJob.changes:type_name -> lession.v1.TranscriptChange
+	4, // 2: lession.v1.TranscriptReplaceJob.created_at:type_name -> google.protobuf.Timestamp
+	4, // 3: lession.v1.TranscriptReplaceJob.started_at:type_name -> google.protobuf.Timestamp
+	4, // 4: lession.v1.TranscriptReplaceJob.finished_at:type_name -> google.protobuf.Timestamp
+	4, // 5: lession.v1.TranscriptReplaceJob.rolled_back_at:type_name -> google.protobuf.Timestamp
+	3, // 6: lession.v1.TranscriptChange.lines:type_name -> lession.v1.TranscriptLineDiff
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_transcript_admin_proto_init() }
+func file_lession_v1_transcript_admin_proto_init() {
+	if File_lession_v1_transcript_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_transcript_admin_proto_rawDesc), len(file_lession_v1_transcript_admin_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_transcript_admin_proto_goTypes,
+		DependencyIndexes: file_lession_v1_transcript_admin_proto_depIdxs,
+		EnumInfos:         file_lession_v1_transcript_admin_proto_enumTypes,
+		MessageInfos:      file_lession_v1_transcript_admin_proto_msgTypes,
+	}.Build()
+	File_lession_v1_transcript_admin_proto = out.File
+	file_lession_v1_transcript_admin_proto_goTypes = nil
+	file_lession_v1_transcript_admin_proto_depIdxs = nil
+}
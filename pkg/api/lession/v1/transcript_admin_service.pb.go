@@ -0,0 +1,600 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/transcript_admin_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SearchReplaceTranscriptsRequest describes a bulk transcript edit.
+type SearchReplaceTranscriptsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pattern is the literal text or regular expression to search for.
+	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// replacement is the text substituted for each match.
+	Replacement string `protobuf:"bytes,2,opt,name=replacement,proto3" json:"replacement,omitempty"`
+	// regex interprets pattern as an RE2 regular expression.
+	Regex bool `protobuf:"varint,3,opt,name=regex,proto3" json:"regex,omitempty"`
+	// case_insensitive matches pattern regardless of case.
+	CaseInsensitive bool `protobuf:"varint,4,opt,name=case_insensitive,json=caseInsensitive,proto3" json:"case_insensitive,omitempty"`
+	// series_id restricts the job to one series.
+	SeriesId string `protobuf:"bytes,5,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// language restricts the job to transcripts in this language (ISO 639-1).
+	Language string `protobuf:"bytes,6,opt,name=language,proto3" json:"language,omitempty"`
+	// dry_run records a diff preview without changing any transcript.
+	DryRun bool `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// requested_by identifies the administrator starting the job.
+	RequestedBy   string `protobuf:"bytes,8,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchReplaceTranscriptsRequest) Reset() {
+	*x = SearchReplaceTranscriptsRequest{}
+	mi := &file_lession_v1_transcript_admin_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchReplaceTranscriptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchReplaceTranscriptsRequest) ProtoMessage() {}
+
+func (x *SearchReplaceTranscriptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_transcript_admin_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchReplaceTranscriptsRequest.ProtoReflect.Descriptor instead.
+func (*SearchReplaceTranscriptsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_transcript_admin_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchReplaceTranscriptsRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *SearchReplaceTranscriptsRequest) GetReplacement() string {
+	if x != nil {
+		return x.Replacement
+	}
+	return ""
+}
+
+func (x *SearchReplaceTranscriptsRequest) GetRegex() bool {
+	if x != nil {
+		return x.Regex
+	}
+	return false
+}
+
+func (x *SearchReplaceTranscriptsRequest) GetCaseInsensitive() bool {
+	if x != nil {
+		return x.CaseInsensitive
+	}
+	return false
+}
+
+func (x *SearchReplaceTranscriptsRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *SearchReplaceTranscriptsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SearchReplaceTranscriptsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *SearchReplaceTranscriptsRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+// SearchReplaceTranscriptsResponse returns the accepted job.
+type SearchReplaceTranscriptsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// job is the queued job.
+	Job           *TranscriptReplaceJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchReplaceTranscriptsResponse) Reset() {
+	*x = SearchReplaceTranscriptsResponse{}
+	mi := &file_lession_v1_transcript_admin_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchReplaceTranscriptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchReplaceTranscriptsResponse) ProtoMessage() {}
+
+func (x *SearchReplaceTranscriptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_transcript_admin_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchReplaceTranscriptsResponse.ProtoReflect.Descriptor instead.
+func (*SearchReplaceTranscriptsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_transcript_admin_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchReplaceTranscriptsResponse) GetJob() *TranscriptReplaceJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// GetTranscriptReplaceJobRequest identifies a job.
+type GetTranscriptReplaceJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// job_id identifies the job.
+	JobId         string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTranscriptReplaceJobRequest) Reset() {
+	*x = GetTranscriptReplaceJobRequest{}
+	mi := &file_lession_v1_transcript_admin_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTranscriptReplaceJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTranscriptReplaceJobRequest) ProtoMessage() {}
+
+func (x *GetTranscriptReplaceJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_transcript_admin_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTranscriptReplaceJobRequest.ProtoReflect.Descriptor instead.
+func (*GetTranscriptReplaceJobRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_transcript_admin_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTranscriptReplaceJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// GetTranscriptReplaceJobResponse returns the job.
+type GetTranscriptReplaceJobResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// job is the requested job.
+	Job           *TranscriptReplaceJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTranscriptReplaceJobResponse) Reset() {
+	*x = GetTranscriptReplaceJobResponse{}
+	mi := &file_lession_v1_transcript_admin_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTranscriptReplaceJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTranscriptReplaceJobResponse) ProtoMessage() {}
+
+func (x *GetTranscriptReplaceJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_transcript_admin_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTranscriptReplaceJobResponse.ProtoReflect.Descriptor instead.
+func (*GetTranscriptReplaceJobResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_transcript_admin_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTranscriptReplaceJobResponse) GetJob() *TranscriptReplaceJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// ListTranscriptReplaceJobsRequest pages through jobs.
+type ListTranscriptReplaceJobsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_size limits the number of returned jobs.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token continues a prior ListTranscriptReplaceJobs response.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTranscriptReplaceJobsRequest) Reset() {
+	*x = ListTranscriptReplaceJobsRequest{}
+	mi := &file_lession_v1_transcript_admin_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTranscriptReplaceJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTranscriptReplaceJobsRequest) ProtoMessage() {}
+
+func (x *ListTranscriptReplaceJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_transcript_admin_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTranscriptReplaceJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListTranscriptReplaceJobsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_transcript_admin_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTranscriptReplaceJobsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTranscriptReplaceJobsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListTranscriptReplaceJobsResponse returns a page of jobs.
+type ListTranscriptReplaceJobsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// jobs contains the jobs, newest first.
+	Jobs []*TranscriptReplaceJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	// next_page_token is supplied when more data is available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTranscriptReplaceJobsResponse) Reset() {
+	*x = ListTranscriptReplaceJobsResponse{}
+	mi := &file_lession_v1_transcript_admin_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTranscriptReplaceJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTranscriptReplaceJobsResponse) ProtoMessage() {}
+
+func (x *ListTranscriptReplaceJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_transcript_admin_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTranscriptReplaceJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListTranscriptReplaceJobsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_transcript_admin_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTranscriptReplaceJobsResponse) GetJobs() []*TranscriptReplaceJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *ListTranscriptReplaceJobsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// RollbackTranscriptReplaceJobRequest identifies the job to revert.
+type RollbackTranscriptReplaceJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// job_id identifies the job.
+	JobId         string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackTranscriptReplaceJobRequest) Reset() {
+	*x = RollbackTranscriptReplaceJobRequest{}
+	mi := &file_lession_v1_transcript_admin_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackTranscriptReplaceJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackTranscriptReplaceJobRequest) ProtoMessage() {}
+
+func (x *RollbackTranscriptReplaceJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_transcript_admin_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackTranscriptReplaceJobRequest.ProtoReflect.Descriptor instead.
+func (*RollbackTranscriptReplaceJobRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_transcript_admin_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RollbackTranscriptReplaceJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// RollbackTranscriptReplaceJobResponse reports the rollback outcome.
+type RollbackTranscriptReplaceJobResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// job is the job after rollback.
+	Job *TranscriptReplaceJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	// restored counts transcripts returned to their previous content.
+	Restored uint32 `protobuf:"varint,2,opt,name=restored,proto3" json:"restored,omitempty"`
+	// conflict_episode_ids lists episodes edited after the job ran, which were left unchanged.
+	ConflictEpisodeIds []string `protobuf:"bytes,3,rep,name=conflict_episode_ids,json=conflictEpisodeIds,proto3" json:"conflict_episode_ids,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RollbackTranscriptReplaceJobResponse) Reset() {
+	*x = RollbackTranscriptReplaceJobResponse{}
+	mi := &file_lession_v1_transcript_admin_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackTranscriptReplaceJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackTranscriptReplaceJobResponse) ProtoMessage() {}
+
+func (x *RollbackTranscriptReplaceJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_transcript_admin_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackTranscriptReplaceJobResponse.ProtoReflect.Descriptor instead.
+func (*RollbackTranscriptReplaceJobResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_transcript_admin_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RollbackTranscriptReplaceJobResponse) GetJob() *TranscriptReplaceJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *RollbackTranscriptReplaceJobResponse) GetRestored() uint32 {
+	if x != nil {
+		return x.Restored
+	}
+	return 0
+}
+
+func (x *RollbackTranscriptReplaceJobResponse) GetConflictEpisodeIds() []string {
+	if x != nil {
+		return x.ConflictEpisodeIds
+	}
+	return nil
+}
+
+var File_lession_v1_transcript_admin_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_transcript_admin_service_proto_rawDesc = "" +
+	"\n" +
+	")lession/v1/transcript_admin_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a!lession/v1/transcript_admin.proto\"\xcf\x02\n" +
+	"\x1fSearchReplaceTranscriptsRequest\x12$\n" +
+	"\apattern\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\bR\apattern\x12*\n" +
+	"\vreplacement\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\vreplacement\x12\x14\n" +
+	"\x05regex\x18\x03 \x01(\bR\x05regex\x12)\n" +
+	"\x10case_insensitive\x18\x04 \x01(\bR\x0fcaseInsensitive\x12(\n" +
+	"\tseries_id\x18\x05 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\bseriesId\x123\n" +
+	"\blanguage\x18\x06 \x01(\tB\x17\xbaH\x14\xd8\x01\x01r\x0f2\r^[a-zA-Z]{2}$R\blanguage\x12\x17\n" +
+	"\adry_run\x18\a \x01(\bR\x06dryRun\x12!\n" +
+	"\frequested_by\x18\b \x01(\tR\vrequestedBy\"V\n" +
+	" SearchReplaceTranscriptsResponse\x122\n" +
+	"\x03job\x18\x01 \x01(\v2 .lession.v1.TranscriptReplaceJobR\x03job\"A\n" +
+	"\x1eGetTranscriptReplaceJobRequest\x12\x1f\n" +
+	"\x06job_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x05jobId\"U\n" +
+	"\x1fGetTranscriptReplaceJobResponse\x122\n" +
+	"\x03job\x18\x01 \x01(\v2 .lession.v1.TranscriptReplaceJobR\x03job\"g\n" +
+	" ListTranscriptReplaceJobsRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\rB\a\xbaH\x04*\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x81\x01\n" +
+	"!ListTranscriptReplaceJobsResponse\x124\n" +
+	"\x04jobs\x18\x01 \x03(\v2 .lession.v1.TranscriptReplaceJobR\x04jobs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"F\n" +
+	"#RollbackTranscriptReplaceJobRequest\x12\x1f\n" +
+	"\x06job_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x05jobId\"\xa8\x01\n" +
+	"$RollbackTranscriptReplaceJobResponse\x122\n" +
+	"\x03job\x18\x01 \x01(\v2 .lession.v1.TranscriptReplaceJobR\x03job\x12\x1a\n" +
+	"\brestored\x18\x02 \x01(\rR\brestored\x120\n" +
+	"\x14conflict_episode_ids\x18\x03 \x03(\tR\x12conflictEpisodeIds2\x81\x04\n" +
+	"\x16TranscriptAdminService\x12u\n" +
+	"\x18SearchReplaceTranscripts\x12+.lession.v1.SearchReplaceTranscriptsRequest\x1a,.lession.v1.SearchReplaceTranscriptsResponse\x12r\n" +
+	"\x17GetTranscriptReplaceJob\x12*.lession.v1.GetTranscriptReplaceJobRequest\x1a+.lession.v1.GetTranscriptReplaceJobResponse\x12x\n" +
+	"\x19ListTranscriptReplaceJobs\x12,.lession.v1.ListTranscriptReplaceJobsRequest\x1a-.lession.v1.ListTranscriptReplaceJobsResponse\x12\x81\x01\n" +
+	"\x1cRollbackTranscriptReplaceJob\x12/.lession.v1.RollbackTranscriptReplaceJobRequest\x1a0.lession.v1.RollbackTranscriptReplaceJobResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_transcript_admin_service_proto_rawDescOnce sync.Once
+	file_lession_v1_transcript_admin_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_transcript_admin_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_transcript_admin_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_transcript_admin_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_transcript_admin_service_proto_rawDesc), len(file_lession_v1_transcript_admin_service_proto_rawDesc)))
+	})
+	return file_lession_v1_transcript_admin_service_proto_rawDescData
+}
+
+var file_lession_v1_transcript_admin_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_lession_v1_transcript_admin_service_proto_goTypes = []any{
+	(*SearchReplaceTranscriptsRequest)(nil),      // 0: lession.v1.SearchReplaceTranscriptsRequest
+	(*SearchReplaceTranscriptsResponse)(nil),     // 1: lession.v1.SearchReplaceTranscriptsResponse
+	(*GetTranscriptReplaceJobRequest)(nil),       // 2: lession.v1.GetTranscriptReplaceJobRequest
+	(*GetTranscriptReplaceJobResponse)(nil),      // 3: lession.v1.GetTranscriptReplaceJobResponse
+	(*ListTranscriptReplaceJobsRequest)(nil),     // 4: lession.v1.ListTranscriptReplaceJobsRequest
+	(*ListTranscriptReplaceJobsResponse)(nil),    // 5: lession.v1.ListTranscriptReplaceJobsResponse
+	(*RollbackTranscriptReplaceJobRequest)(nil),  // 6: lession.v1.RollbackTranscriptReplaceJobRequest
+	(*RollbackTranscriptReplaceJobResponse)(nil), // 7: lession.v1.RollbackTranscriptReplaceJobResponse
+	(*TranscriptReplaceJob)(nil),                 // 8: lession.v1.TranscriptReplaceJob
+}
+var file_lession_v1_transcript_admin_service_proto_depIdxs = []int32{
+	8, // 0: lession.v1.SearchReplaceTranscriptsResponse.job:type_name -> lession.v1.TranscriptReplaceJob
+	8, // 1: lession.v1.GetTranscriptReplaceJobResponse.job:type_name -> lession.v1.TranscriptReplaceJob
+	8, // 2: lession.v1.ListTranscriptReplaceJobsResponse.jobs:type_name -> lession.v1.TranscriptReplaceJob
+	8, // 3: lession.v1.RollbackTranscriptReplaceJobResponse.job:type_name -> lession.v1.TranscriptReplaceJob
+	0, // 4: lession.v1.TranscriptAdminService.SearchReplaceTranscripts:input_type -> lession.v1.SearchReplaceTranscriptsRequest
+	2, // 5: lession.v1.TranscriptAdminService.GetTranscriptReplaceJob:input_type -> lession.v1.GetTranscriptReplaceJobRequest
+	4, // 6: lession.v1.TranscriptAdminService.ListTranscriptReplaceJobs:input_type -> lession.v1.ListTranscriptReplaceJobsRequest
+	6, // 7: lession.v1.TranscriptAdminService.RollbackTranscriptReplaceJob:input_type -> lession.v1.RollbackTranscriptReplaceJobRequest
+	1, // 8: lession.v1.TranscriptAdminService.SearchReplaceTranscripts:output_type -> lession.v1.SearchReplaceTranscriptsResponse
+	3, // 9: lession.v1.TranscriptAdminService.GetTranscriptReplaceJob:output_type -> lession.v1.GetTranscriptReplaceJobResponse
+	5, // 10: lession.v1.TranscriptAdminService.ListTranscriptReplaceJobs:output_type -> lession.v1.ListTranscriptReplaceJobsResponse
+	7, // 11: lession.v1.TranscriptAdminService.RollbackTranscriptReplaceJob:output_type -> lession.v1.RollbackTranscriptReplaceJobResponse
+	8, // [8:12] is the sub-list for method output_type
+	4, // [4:8] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_transcript_admin_service_proto_init() }
+func file_lession_v1_transcript_admin_service_proto_init() {
+	if File_lession_v1_transcript_admin_service_proto != nil {
+		return
+	}
+	file_lession_v1_transcript_admin_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_transcript_admin_service_proto_rawDesc), len(file_lession_v1_transcript_admin_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_transcript_admin_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_transcript_admin_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_transcript_admin_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_transcript_admin_service_proto = out.File
+	file_lession_v1_transcript_admin_service_proto_goTypes = nil
+	file_lession_v1_transcript_admin_service_proto_depIdxs = nil
+}
@@ -0,0 +1,367 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/vocabulary.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// VocabularyStatus records whether a learner knows a word.
+type VocabularyStatus int32
+
+const (
+	// VOCABULARY_STATUS_UNSPECIFIED is the default zero value.
+	VocabularyStatus_VOCABULARY_STATUS_UNSPECIFIED VocabularyStatus = 0
+	// VOCABULARY_STATUS_KNOWN indicates the learner knows the word.
+	VocabularyStatus_VOCABULARY_STATUS_KNOWN VocabularyStatus = 1
+	// VOCABULARY_STATUS_UNKNOWN indicates the learner is still learning the word.
+	VocabularyStatus_VOCABULARY_STATUS_UNKNOWN VocabularyStatus = 2
+)
+
+// Enum value maps for VocabularyStatus.
+var (
+	VocabularyStatus_name = map[int32]string{
+		0: "VOCABULARY_STATUS_UNSPECIFIED",
+		1: "VOCABULARY_STATUS_KNOWN",
+		2: "VOCABULARY_STATUS_UNKNOWN",
+	}
+	VocabularyStatus_value = map[string]int32{
+		"VOCABULARY_STATUS_UNSPECIFIED": 0,
+		"VOCABULARY_STATUS_KNOWN":       1,
+		"VOCABULARY_STATUS_UNKNOWN":     2,
+	}
+)
+
+func (x VocabularyStatus) Enum() *VocabularyStatus {
+	p := new(VocabularyStatus)
+	*p = x
+	return p
+}
+
+func (x VocabularyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VocabularyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_vocabulary_proto_enumTypes[0].Descriptor()
+}
+
+func (VocabularyStatus) Type() protoreflect.EnumType {
+	return &file_lession_v1_vocabulary_proto_enumTypes[0]
+}
+
+func (x VocabularyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VocabularyStatus.Descriptor instead.
+func (VocabularyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_vocabulary_proto_rawDescGZIP(), []int{0}
+}
+
+// VocabularyWord is a word a learner marked as known or unknown.
+type VocabularyWord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// word is the lower-cased word.
+	Word string `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	// status records whether the learner knows the word.
+	Status VocabularyStatus `protobuf:"varint,3,opt,name=status,proto3,enum=lession.v1.VocabularyStatus" json:"status,omitempty"`
+	// episode_id identifies the episode the word was last marked in, if any.
+	EpisodeId string `protobuf:"bytes,4,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// created_at records when the word was first marked.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at records when the word was last marked.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyWord) Reset() {
+	*x = VocabularyWord{}
+	mi := &file_lession_v1_vocabulary_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyWord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyWord) ProtoMessage() {}
+
+func (x *VocabularyWord) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_vocabulary_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyWord.ProtoReflect.Descriptor instead.
+func (*VocabularyWord) Descriptor() ([]byte, []int) {
+	return file_lession_v1_vocabulary_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VocabularyWord) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VocabularyWord) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *VocabularyWord) GetStatus() VocabularyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VocabularyStatus_VOCABULARY_STATUS_UNSPECIFIED
+}
+
+func (x *VocabularyWord) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *VocabularyWord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *VocabularyWord) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// VocabularyCoverage compares an episode's transcript with the words a learner knows.
+type VocabularyCoverage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// episode_id identifies the episode.
+	EpisodeId string `protobuf:"bytes,1,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// word_count is the number of running words in the transcript.
+	WordCount uint32 `protobuf:"varint,3,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	// unique_word_count is the number of distinct words in the transcript.
+	UniqueWordCount uint32 `protobuf:"varint,4,opt,name=unique_word_count,json=uniqueWordCount,proto3" json:"unique_word_count,omitempty"`
+	// known_word_count counts the running words the learner knows.
+	KnownWordCount uint32 `protobuf:"varint,5,opt,name=known_word_count,json=knownWordCount,proto3" json:"known_word_count,omitempty"`
+	// known_unique_word_count counts the distinct words the learner knows.
+	KnownUniqueWordCount uint32 `protobuf:"varint,6,opt,name=known_unique_word_count,json=knownUniqueWordCount,proto3" json:"known_unique_word_count,omitempty"`
+	// known_percent is the share of running words known, from 0 to 100.
+	KnownPercent float64 `protobuf:"fixed64,7,opt,name=known_percent,json=knownPercent,proto3" json:"known_percent,omitempty"`
+	// new_words lists the most frequent words the learner has not marked as known.
+	NewWords      []*WordFrequency `protobuf:"bytes,8,rep,name=new_words,json=newWords,proto3" json:"new_words,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyCoverage) Reset() {
+	*x = VocabularyCoverage{}
+	mi := &file_lession_v1_vocabulary_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyCoverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyCoverage) ProtoMessage() {}
+
+func (x *VocabularyCoverage) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_vocabulary_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyCoverage.ProtoReflect.Descriptor instead.
+func (*VocabularyCoverage) Descriptor() ([]byte, []int) {
+	return file_lession_v1_vocabulary_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VocabularyCoverage) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *VocabularyCoverage) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VocabularyCoverage) GetWordCount() uint32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+func (x *VocabularyCoverage) GetUniqueWordCount() uint32 {
+	if x != nil {
+		return x.UniqueWordCount
+	}
+	return 0
+}
+
+func (x *VocabularyCoverage) GetKnownWordCount() uint32 {
+	if x != nil {
+		return x.KnownWordCount
+	}
+	return 0
+}
+
+func (x *VocabularyCoverage) GetKnownUniqueWordCount() uint32 {
+	if x != nil {
+		return x.KnownUniqueWordCount
+	}
+	return 0
+}
+
+func (x *VocabularyCoverage) GetKnownPercent() float64 {
+	if x != nil {
+		return x.KnownPercent
+	}
+	return 0
+}
+
+func (x *VocabularyCoverage) GetNewWords() []*WordFrequency {
+	if x != nil {
+		return x.NewWords
+	}
+	return nil
+}
+
+var File_lession_v1_vocabulary_proto protoreflect.FileDescriptor
+
+const file_lession_v1_vocabulary_proto_rawDesc = "" +
+	"\n" +
+	"\x1blession/v1/vocabulary.proto\x12\n" +
+	"lession.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17lession/v1/series.proto\"\x88\x02\n" +
+	"\x0eVocabularyWord\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04word\x18\x02 \x01(\tR\x04word\x124\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1c.lession.v1.VocabularyStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x04 \x01(\tR\tepisodeId\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd5\x02\n" +
+	"\x12VocabularyCoverage\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x01 \x01(\tR\tepisodeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"word_count\x18\x03 \x01(\rR\twordCount\x12*\n" +
+	"\x11unique_word_count\x18\x04 \x01(\rR\x0funiqueWordCount\x12(\n" +
+	"\x10known_word_count\x18\x05 \x01(\rR\x0eknownWordCount\x125\n" +
+	"\x17known_unique_word_count\x18\x06 \x01(\rR\x14knownUniqueWordCount\x12#\n" +
+	"\rknown_percent\x18\a \x01(\x01R\fknownPercent\x126\n" +
+	"\tnew_words\x18\b \x03(\v2\x19.lession.v1.WordFrequencyR\bnewWords*q\n" +
+	"\x10VocabularyStatus\x12!\n" +
+	"\x1dVOCABULARY_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17VOCABULARY_STATUS_KNOWN\x10\x01\x12\x1d\n" +
+	"\x19VOCABULARY_STATUS_UNKNOWN\x10\x02B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_vocabulary_proto_rawDescOnce sync.Once
+	file_lession_v1_vocabulary_proto_rawDescData []byte
+)
+
+func file_lession_v1_vocabulary_proto_rawDescGZIP() []byte {
+	file_lession_v1_vocabulary_proto_rawDescOnce.Do(func() {
+		file_lession_v1_vocabulary_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_vocabulary_proto_rawDesc), len(file_lession_v1_vocabulary_proto_rawDesc)))
+	})
+	return file_lession_v1_vocabulary_proto_rawDescData
+}
+
+var file_lession_v1_vocabulary_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lession_v1_vocabulary_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_lession_v1_vocabulary_proto_goTypes = []any{
+	(VocabularyStatus)(0),         // 0: lession.v1.VocabularyStatus
+	(*VocabularyWord)(nil),        // 1: lession.v1.VocabularyWord
+	(*VocabularyCoverage)(nil),    // 2: lession.v1.VocabularyCoverage
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(*WordFrequency)(nil),         // 4: lession.v1.WordFrequency
+}
+var file_lession_v1_vocabulary_proto_depIdxs = []int32{
+	0, // 0: lession.v1.VocabularyWord.status:type_name -> lession.v1.VocabularyStatus
+	3, // 1: lession.v1.VocabularyWord.created_at:type_name -> google.protobuf.Timestamp
+	3, // 2: lession.v1.VocabularyWord.updated_at:type_name -> google.protobuf.Timestamp
+	4, // 3: lession.v1.VocabularyCoverage.new_words:type_name -> lession.v1.WordFrequency
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_vocabulary_proto_init() }
+func file_lession_v1_vocabulary_proto_init() {
+	if File_lession_v1_vocabulary_proto != nil {
+		return
+	}
+	file_lession_v1_series_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_vocabulary_proto_rawDesc), len(file_lession_v1_vocabulary_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_vocabulary_proto_goTypes,
+		DependencyIndexes: file_lession_v1_vocabulary_proto_depIdxs,
+		EnumInfos:         file_lession_v1_vocabulary_proto_enumTypes,
+		MessageInfos:      file_lession_v1_vocabulary_proto_msgTypes,
+	}.Build()
+	File_lession_v1_vocabulary_proto = out.File
+	file_lession_v1_vocabulary_proto_goTypes = nil
+	file_lession_v1_vocabulary_proto_depIdxs = nil
+}
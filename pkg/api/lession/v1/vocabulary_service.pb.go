@@ -0,0 +1,473 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/vocabulary_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MarkWordsRequest carries the words to mark.
+type MarkWordsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// words lists single words; case and surrounding punctuation are ignored.
+	Words []string `protobuf:"bytes,2,rep,name=words,proto3" json:"words,omitempty"`
+	// status is the mark to record.
+	Status VocabularyStatus `protobuf:"varint,3,opt,name=status,proto3,enum=lession.v1.VocabularyStatus" json:"status,omitempty"`
+	// episode_id optionally identifies the episode the words were met in.
+	EpisodeId     string `protobuf:"bytes,4,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkWordsRequest) Reset() {
+	*x = MarkWordsRequest{}
+	mi := &file_lession_v1_vocabulary_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkWordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkWordsRequest) ProtoMessage() {}
+
+func (x *MarkWordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_vocabulary_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkWordsRequest.ProtoReflect.Descriptor instead.
+func (*MarkWordsRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_vocabulary_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MarkWordsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MarkWordsRequest) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *MarkWordsRequest) GetStatus() VocabularyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VocabularyStatus_VOCABULARY_STATUS_UNSPECIFIED
+}
+
+func (x *MarkWordsRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+// MarkWordsResponse returns the marked words.
+type MarkWordsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// words contains the stored marks, empty when the marks were forgotten.
+	Words         []*VocabularyWord `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkWordsResponse) Reset() {
+	*x = MarkWordsResponse{}
+	mi := &file_lession_v1_vocabulary_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkWordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkWordsResponse) ProtoMessage() {}
+
+func (x *MarkWordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_vocabulary_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkWordsResponse.ProtoReflect.Descriptor instead.
+func (*MarkWordsResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_vocabulary_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MarkWordsResponse) GetWords() []*VocabularyWord {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+// ListVocabularyRequest filters a learner's words.
+type ListVocabularyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// status restricts the words to a single mark.
+	Status VocabularyStatus `protobuf:"varint,2,opt,name=status,proto3,enum=lession.v1.VocabularyStatus" json:"status,omitempty"`
+	// page_size limits the number of returned words.
+	PageSize uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token continues a prior ListVocabulary response.
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVocabularyRequest) Reset() {
+	*x = ListVocabularyRequest{}
+	mi := &file_lession_v1_vocabulary_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVocabularyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVocabularyRequest) ProtoMessage() {}
+
+func (x *ListVocabularyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_vocabulary_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVocabularyRequest.ProtoReflect.Descriptor instead.
+func (*ListVocabularyRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_vocabulary_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListVocabularyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListVocabularyRequest) GetStatus() VocabularyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VocabularyStatus_VOCABULARY_STATUS_UNSPECIFIED
+}
+
+func (x *ListVocabularyRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListVocabularyRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListVocabularyResponse returns a page of words.
+type ListVocabularyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// words contains the marked words, most recently marked first.
+	Words []*VocabularyWord `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	// next_page_token is supplied when more data is available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVocabularyResponse) Reset() {
+	*x = ListVocabularyResponse{}
+	mi := &file_lession_v1_vocabulary_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVocabularyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVocabularyResponse) ProtoMessage() {}
+
+func (x *ListVocabularyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_vocabulary_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVocabularyResponse.ProtoReflect.Descriptor instead.
+func (*ListVocabularyResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_vocabulary_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListVocabularyResponse) GetWords() []*VocabularyWord {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *ListVocabularyResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetVocabularyCoverageRequest selects the learner and episode to compare.
+type GetVocabularyCoverageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// episode_id identifies the episode.
+	EpisodeId     string `protobuf:"bytes,2,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVocabularyCoverageRequest) Reset() {
+	*x = GetVocabularyCoverageRequest{}
+	mi := &file_lession_v1_vocabulary_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVocabularyCoverageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVocabularyCoverageRequest) ProtoMessage() {}
+
+func (x *GetVocabularyCoverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_vocabulary_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVocabularyCoverageRequest.ProtoReflect.Descriptor instead.
+func (*GetVocabularyCoverageRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_vocabulary_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetVocabularyCoverageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetVocabularyCoverageRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+// GetVocabularyCoverageResponse returns the coverage.
+type GetVocabularyCoverageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// coverage compares the transcript with the learner's known words.
+	Coverage      *VocabularyCoverage `protobuf:"bytes,1,opt,name=coverage,proto3" json:"coverage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVocabularyCoverageResponse) Reset() {
+	*x = GetVocabularyCoverageResponse{}
+	mi := &file_lession_v1_vocabulary_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVocabularyCoverageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVocabularyCoverageResponse) ProtoMessage() {}
+
+func (x *GetVocabularyCoverageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_vocabulary_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVocabularyCoverageResponse.ProtoReflect.Descriptor instead.
+func (*GetVocabularyCoverageResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_vocabulary_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetVocabularyCoverageResponse) GetCoverage() *VocabularyCoverage {
+	if x != nil {
+		return x.Coverage
+	}
+	return nil
+}
+
+var File_lession_v1_vocabulary_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_vocabulary_service_proto_rawDesc = "" +
+	"\n" +
+	"#lession/v1/vocabulary_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1blession/v1/vocabulary.proto\"\xc3\x01\n" +
+	"\x10MarkWordsRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\x12!\n" +
+	"\x05words\x18\x02 \x03(\tB\v\xbaH\b\x92\x01\x05\b\x01\x10\xf4\x03R\x05words\x12>\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1c.lession.v1.VocabularyStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\x12*\n" +
+	"\n" +
+	"episode_id\x18\x04 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\tepisodeId\"E\n" +
+	"\x11MarkWordsResponse\x120\n" +
+	"\x05words\x18\x01 \x03(\v2\x1a.lession.v1.VocabularyWordR\x05words\"\xbe\x01\n" +
+	"\x15ListVocabularyRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\x12>\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1c.lession.v1.VocabularyStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\x12$\n" +
+	"\tpage_size\x18\x03 \x01(\rB\a\xbaH\x04*\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"r\n" +
+	"\x16ListVocabularyResponse\x120\n" +
+	"\x05words\x18\x01 \x03(\v2\x1a.lession.v1.VocabularyWordR\x05words\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"i\n" +
+	"\x1cGetVocabularyCoverageRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\x12'\n" +
+	"\n" +
+	"episode_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tepisodeId\"[\n" +
+	"\x1dGetVocabularyCoverageResponse\x12:\n" +
+	"\bcoverage\x18\x01 \x01(\v2\x1e.lession.v1.VocabularyCoverageR\bcoverage2\xa4\x02\n" +
+	"\x11VocabularyService\x12H\n" +
+	"\tMarkWords\x12\x1c.lession.v1.MarkWordsRequest\x1a\x1d.lession.v1.MarkWordsResponse\x12W\n" +
+	"\x0eListVocabulary\x12!.lession.v1.ListVocabularyRequest\x1a\".lession.v1.ListVocabularyResponse\x12l\n" +
+	"\x15GetVocabularyCoverage\x12(.lession.v1.GetVocabularyCoverageRequest\x1a).lession.v1.GetVocabularyCoverageResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_vocabulary_service_proto_rawDescOnce sync.Once
+	file_lession_v1_vocabulary_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_vocabulary_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_vocabulary_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_vocabulary_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_vocabulary_service_proto_rawDesc), len(file_lession_v1_vocabulary_service_proto_rawDesc)))
+	})
+	return file_lession_v1_vocabulary_service_proto_rawDescData
+}
+
+var file_lession_v1_vocabulary_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_lession_v1_vocabulary_service_proto_goTypes = []any{
+	(*MarkWordsRequest)(nil),              // 0: lession.v1.MarkWordsRequest
+	(*MarkWordsResponse)(nil),             // 1: lession.v1.MarkWordsResponse
+	(*ListVocabularyRequest)(nil),         // 2: lession.v1.ListVocabularyRequest
+	(*ListVocabularyResponse)(nil),        // 3: lession.v1.ListVocabularyResponse
+	(*GetVocabularyCoverageRequest)(nil),  // 4: lession.v1.GetVocabularyCoverageRequest
+	(*GetVocabularyCoverageResponse)(nil), // 5: lession.v1.GetVocabularyCoverageResponse
+	(VocabularyStatus)(0),                 // 6: lession.v1.VocabularyStatus
+	(*VocabularyWord)(nil),                // 7: lession.v1.VocabularyWord
+	(*VocabularyCoverage)(nil),            // 8: lession.v1.VocabularyCoverage
+}
+var file_lession_v1_vocabulary_service_proto_depIdxs = []int32{
+	6, // 0: lession.v1.MarkWordsRequest.status:type_name -> lession.v1.VocabularyStatus
+	7, // 1: lession.v1.MarkWordsResponse.words:type_name -> lession.v1.VocabularyWord
+	6, // 2: lession.v1.ListVocabularyRequest.status:type_name -> lession.v1.VocabularyStatus
+	7, // 3: lession.v1.ListVocabularyResponse.words:type_name -> lession.v1.VocabularyWord
+	8, // 4: lession.v1.GetVocabularyCoverageResponse.coverage:type_name -> lession.v1.VocabularyCoverage
+	0, // 5: lession.v1.VocabularyService.MarkWords:input_type -> lession.v1.MarkWordsRequest
+	2, // 6: lession.v1.VocabularyService.ListVocabulary:input_type -> lession.v1.ListVocabularyRequest
+	4, // 7: lession.v1.VocabularyService.GetVocabularyCoverage:input_type -> lession.v1.GetVocabularyCoverageRequest
+	1, // 8: lession.v1.VocabularyService.MarkWords:output_type -> lession.v1.MarkWordsResponse
+	3, // 9: lession.v1.VocabularyService.ListVocabulary:output_type -> lession.v1.ListVocabularyResponse
+	5, // 10: lession.v1.VocabularyService.GetVocabularyCoverage:output_type -> lession.v1.GetVocabularyCoverageResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_vocabulary_service_proto_init() }
+func file_lession_v1_vocabulary_service_proto_init() {
+	if File_lession_v1_vocabulary_service_proto != nil {
+		return
+	}
+	file_lession_v1_vocabulary_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_vocabulary_service_proto_rawDesc), len(file_lession_v1_vocabulary_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_vocabulary_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_vocabulary_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_vocabulary_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_vocabulary_service_proto = out.File
+	file_lession_v1_vocabulary_service_proto_goTypes = nil
+	file_lession_v1_vocabulary_service_proto_depIdxs = nil
+}
@@ -0,0 +1,194 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/watch_history.proto
+
+package lessionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PlaybackSession records a single time a learner played an episode.
+type PlaybackSession struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the session.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// episode_id identifies the episode that was played.
+	EpisodeId string `protobuf:"bytes,3,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// device describes the client the episode was played on, e.g. "ios".
+	Device string `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	// started_at is when playback began.
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// finished_at is when playback ended; unset while the session is open.
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// created_at is when the session was recorded.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaybackSession) Reset() {
+	*x = PlaybackSession{}
+	mi := &file_lession_v1_watch_history_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaybackSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaybackSession) ProtoMessage() {}
+
+func (x *PlaybackSession) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_watch_history_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaybackSession.ProtoReflect.Descriptor instead.
+func (*PlaybackSession) Descriptor() ([]byte, []int) {
+	return file_lession_v1_watch_history_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlaybackSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlaybackSession) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PlaybackSession) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *PlaybackSession) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *PlaybackSession) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *PlaybackSession) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *PlaybackSession) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_lession_v1_watch_history_proto protoreflect.FileDescriptor
+
+const file_lession_v1_watch_history_proto_rawDesc = "" +
+	"\n" +
+	"\x1elession/v1/watch_history.proto\x12\n" +
+	"lession.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa4\x02\n" +
+	"\x0fPlaybackSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"episode_id\x18\x03 \x01(\tR\tepisodeId\x12\x16\n" +
+	"\x06device\x18\x04 \x01(\tR\x06device\x129\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_watch_history_proto_rawDescOnce sync.Once
+	file_lession_v1_watch_history_proto_rawDescData []byte
+)
+
+func file_lession_v1_watch_history_proto_rawDescGZIP() []byte {
+	file_lession_v1_watch_history_proto_rawDescOnce.Do(func() {
+		file_lession_v1_watch_history_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_watch_history_proto_rawDesc), len(file_lession_v1_watch_history_proto_rawDesc)))
+	})
+	return file_lession_v1_watch_history_proto_rawDescData
+}
+
+var file_lession_v1_watch_history_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_lession_v1_watch_history_proto_goTypes = []any{
+	(*PlaybackSession)(nil),       // 0: lession.v1.PlaybackSession
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_lession_v1_watch_history_proto_depIdxs = []int32{
+	1, // 0: lession.v1.PlaybackSession.started_at:type_name -> google.protobuf.Timestamp
+	1, // 1: lession.v1.PlaybackSession.finished_at:type_name -> google.protobuf.Timestamp
+	1, // 2: lession.v1.PlaybackSession.created_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_watch_history_proto_init() }
+func file_lession_v1_watch_history_proto_init() {
+	if File_lession_v1_watch_history_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_watch_history_proto_rawDesc), len(file_lession_v1_watch_history_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_watch_history_proto_goTypes,
+		DependencyIndexes: file_lession_v1_watch_history_proto_depIdxs,
+		MessageInfos:      file_lession_v1_watch_history_proto_msgTypes,
+	}.Build()
+	File_lession_v1_watch_history_proto = out.File
+	file_lession_v1_watch_history_proto_goTypes = nil
+	file_lession_v1_watch_history_proto_depIdxs = nil
+}
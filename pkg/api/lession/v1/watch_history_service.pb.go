@@ -0,0 +1,622 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/watch_history_service.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RecordPlaybackSessionRequest describes a playback session.
+type RecordPlaybackSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// episode_id identifies the episode that was played.
+	EpisodeId string `protobuf:"bytes,2,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// device describes the client the episode was played on.
+	Device string `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	// started_at is when playback began; defaults to now.
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// finished_at is when playback ended, for sessions reported after the fact.
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordPlaybackSessionRequest) Reset() {
+	*x = RecordPlaybackSessionRequest{}
+	mi := &file_lession_v1_watch_history_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordPlaybackSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordPlaybackSessionRequest) ProtoMessage() {}
+
+func (x *RecordPlaybackSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_watch_history_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordPlaybackSessionRequest.ProtoReflect.Descriptor instead.
+func (*RecordPlaybackSessionRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_watch_history_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecordPlaybackSessionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecordPlaybackSessionRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *RecordPlaybackSessionRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *RecordPlaybackSessionRequest) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *RecordPlaybackSessionRequest) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+// RecordPlaybackSessionResponse returns the stored session.
+type RecordPlaybackSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// session is the persisted playback session.
+	Session       *PlaybackSession `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordPlaybackSessionResponse) Reset() {
+	*x = RecordPlaybackSessionResponse{}
+	mi := &file_lession_v1_watch_history_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordPlaybackSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordPlaybackSessionResponse) ProtoMessage() {}
+
+func (x *RecordPlaybackSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_watch_history_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordPlaybackSessionResponse.ProtoReflect.Descriptor instead.
+func (*RecordPlaybackSessionResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_watch_history_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RecordPlaybackSessionResponse) GetSession() *PlaybackSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+// FinishPlaybackSessionRequest identifies the session to close.
+type FinishPlaybackSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// session_id identifies the playback session.
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// finished_at is when playback ended; defaults to now.
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishPlaybackSessionRequest) Reset() {
+	*x = FinishPlaybackSessionRequest{}
+	mi := &file_lession_v1_watch_history_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPlaybackSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPlaybackSessionRequest) ProtoMessage() {}
+
+func (x *FinishPlaybackSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_watch_history_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishPlaybackSessionRequest.ProtoReflect.Descriptor instead.
+func (*FinishPlaybackSessionRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_watch_history_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FinishPlaybackSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *FinishPlaybackSessionRequest) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+// FinishPlaybackSessionResponse returns the finished session.
+type FinishPlaybackSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// session is the updated playback session.
+	Session       *PlaybackSession `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishPlaybackSessionResponse) Reset() {
+	*x = FinishPlaybackSessionResponse{}
+	mi := &file_lession_v1_watch_history_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPlaybackSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPlaybackSessionResponse) ProtoMessage() {}
+
+func (x *FinishPlaybackSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_watch_history_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishPlaybackSessionResponse.ProtoReflect.Descriptor instead.
+func (*FinishPlaybackSessionResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_watch_history_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FinishPlaybackSessionResponse) GetSession() *PlaybackSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+// ListHistoryRequest filters a learner's watch history.
+type ListHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_size limits the number of returned sessions.
+	PageSize uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token continues a prior ListHistory response.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// episode_id restricts history to a single episode.
+	EpisodeId string `protobuf:"bytes,4,opt,name=episode_id,json=episodeId,proto3" json:"episode_id,omitempty"`
+	// started_after keeps sessions started at or after this time.
+	StartedAfter *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_after,json=startedAfter,proto3" json:"started_after,omitempty"`
+	// started_before keeps sessions started before this time.
+	StartedBefore *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_before,json=startedBefore,proto3" json:"started_before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHistoryRequest) Reset() {
+	*x = ListHistoryRequest{}
+	mi := &file_lession_v1_watch_history_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHistoryRequest) ProtoMessage() {}
+
+func (x *ListHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_watch_history_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_watch_history_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListHistoryRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListHistoryRequest) GetEpisodeId() string {
+	if x != nil {
+		return x.EpisodeId
+	}
+	return ""
+}
+
+func (x *ListHistoryRequest) GetStartedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAfter
+	}
+	return nil
+}
+
+func (x *ListHistoryRequest) GetStartedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedBefore
+	}
+	return nil
+}
+
+// ListHistoryResponse returns a page of playback sessions.
+type ListHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sessions contains the matching sessions, most recently started first.
+	Sessions []*PlaybackSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	// next_page_token is supplied when more data is available.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHistoryResponse) Reset() {
+	*x = ListHistoryResponse{}
+	mi := &file_lession_v1_watch_history_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHistoryResponse) ProtoMessage() {}
+
+func (x *ListHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_watch_history_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_watch_history_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListHistoryResponse) GetSessions() []*PlaybackSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *ListHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ClearHistoryRequest selects the history to delete.
+type ClearHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user_id identifies the learner.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// before limits deletion to sessions started before this time; unset clears everything.
+	Before        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearHistoryRequest) Reset() {
+	*x = ClearHistoryRequest{}
+	mi := &file_lession_v1_watch_history_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearHistoryRequest) ProtoMessage() {}
+
+func (x *ClearHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_watch_history_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ClearHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_lession_v1_watch_history_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ClearHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ClearHistoryRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+// ClearHistoryResponse reports how many sessions were deleted.
+type ClearHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// deleted is the number of removed sessions.
+	Deleted       uint32 `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearHistoryResponse) Reset() {
+	*x = ClearHistoryResponse{}
+	mi := &file_lession_v1_watch_history_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearHistoryResponse) ProtoMessage() {}
+
+func (x *ClearHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_watch_history_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ClearHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_lession_v1_watch_history_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ClearHistoryResponse) GetDeleted() uint32 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+var File_lession_v1_watch_history_service_proto protoreflect.FileDescriptor
+
+const file_lession_v1_watch_history_service_proto_rawDesc = "" +
+	"\n" +
+	"&lession/v1/watch_history_service.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1elession/v1/watch_history.proto\"\x83\x02\n" +
+	"\x1cRecordPlaybackSessionRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\x12'\n" +
+	"\n" +
+	"episode_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tepisodeId\x12 \n" +
+	"\x06device\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x01R\x06device\x129\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"V\n" +
+	"\x1dRecordPlaybackSessionResponse\x125\n" +
+	"\asession\x18\x01 \x01(\v2\x1b.lession.v1.PlaybackSessionR\asession\"\x84\x01\n" +
+	"\x1cFinishPlaybackSessionRequest\x12'\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tsessionId\x12;\n" +
+	"\vfinished_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"V\n" +
+	"\x1dFinishPlaybackSessionResponse\x125\n" +
+	"\asession\x18\x01 \x01(\v2\x1b.lession.v1.PlaybackSessionR\asession\"\xab\x02\n" +
+	"\x12ListHistoryRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\rB\a\xbaH\x04*\x02\x18dR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12 \n" +
+	"\auser_id\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\x12*\n" +
+	"\n" +
+	"episode_id\x18\x04 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\tepisodeId\x12?\n" +
+	"\rstarted_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fstartedAfter\x12A\n" +
+	"\x0estarted_before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rstartedBefore\"v\n" +
+	"\x13ListHistoryResponse\x127\n" +
+	"\bsessions\x18\x01 \x03(\v2\x1b.lession.v1.PlaybackSessionR\bsessions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"k\n" +
+	"\x13ClearHistoryRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06userId\x122\n" +
+	"\x06before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\"0\n" +
+	"\x14ClearHistoryResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\rR\adeleted2\x94\x03\n" +
+	"\x13WatchHistoryService\x12l\n" +
+	"\x15RecordPlaybackSession\x12(.lession.v1.RecordPlaybackSessionRequest\x1a).lession.v1.RecordPlaybackSessionResponse\x12l\n" +
+	"\x15FinishPlaybackSession\x12(.lession.v1.FinishPlaybackSessionRequest\x1a).lession.v1.FinishPlaybackSessionResponse\x12N\n" +
+	"\vListHistory\x12\x1e.lession.v1.ListHistoryRequest\x1a\x1f.lession.v1.ListHistoryResponse\x12Q\n" +
+	"\fClearHistory\x12\x1f.lession.v1.ClearHistoryRequest\x1a .lession.v1.ClearHistoryResponseB9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_watch_history_service_proto_rawDescOnce sync.Once
+	file_lession_v1_watch_history_service_proto_rawDescData []byte
+)
+
+func file_lession_v1_watch_history_service_proto_rawDescGZIP() []byte {
+	file_lession_v1_watch_history_service_proto_rawDescOnce.Do(func() {
+		file_lession_v1_watch_history_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_watch_history_service_proto_rawDesc), len(file_lession_v1_watch_history_service_proto_rawDesc)))
+	})
+	return file_lession_v1_watch_history_service_proto_rawDescData
+}
+
+var file_lession_v1_watch_history_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_lession_v1_watch_history_service_proto_goTypes = []any{
+	(*RecordPlaybackSessionRequest)(nil),  // 0: lession.v1.RecordPlaybackSessionRequest
+	(*RecordPlaybackSessionResponse)(nil), // 1: lession.v1.RecordPlaybackSessionResponse
+	(*FinishPlaybackSessionRequest)(nil),  // 2: lession.v1.FinishPlaybackSessionRequest
+	(*FinishPlaybackSessionResponse)(nil), // 3: lession.v1.FinishPlaybackSessionResponse
+	(*ListHistoryRequest)(nil),            // 4: lession.v1.ListHistoryRequest
+	(*ListHistoryResponse)(nil),           // 5: lession.v1.ListHistoryResponse
+	(*ClearHistoryRequest)(nil),           // 6: lession.v1.ClearHistoryRequest
+	(*ClearHistoryResponse)(nil),          // 7: lession.v1.ClearHistoryResponse
+	(*timestamppb.Timestamp)(nil),         // 8: google.protobuf.Timestamp
+	(*PlaybackSession)(nil),               // 9: lession.v1.PlaybackSession
+}
+var file_lession_v1_watch_history_service_proto_depIdxs = []int32{
+	8,  // 0: lession.v1.RecordPlaybackSessionRequest.started_at:type_name -> google.protobuf.Timestamp
+	8,  // 1: lession.v1.RecordPlaybackSessionRequest.finished_at:type_name -> google.protobuf.Timestamp
+	9,  // 2: lession.v1.RecordPlaybackSessionResponse.session:type_name -> lession.v1.PlaybackSession
+	8,  // 3: lession.v1.FinishPlaybackSessionRequest.finished_at:type_name -> google.protobuf.Timestamp
+	9,  // 4: lession.v1.FinishPlaybackSessionResponse.session:type_name -> lession.v1.PlaybackSession
+	8,  // 5: lession.v1.ListHistoryRequest.started_after:type_name -> google.protobuf.Timestamp
+	8,  // 6: lession.v1.ListHistoryRequest.started_before:type_name -> google.protobuf.Timestamp
+	9,  // 7: lession.v1.ListHistoryResponse.sessions:type_name -> lession.v1.PlaybackSession
+	8,  // 8: lession.v1.ClearHistoryRequest.before:type_name -> google.protobuf.Timestamp
+	0,  // 9: lession.v1.WatchHistoryService.RecordPlaybackSession:input_type -> lession.v1.RecordPlaybackSessionRequest
+	2,  // 10: lession.v1.WatchHistoryService.FinishPlaybackSession:input_type -> lession.v1.FinishPlaybackSessionRequest
+	4,  // 11: lession.v1.WatchHistoryService.ListHistory:input_type -> lession.v1.ListHistoryRequest
+	6,  // 12: lession.v1.WatchHistoryService.ClearHistory:input_type -> lession.v1.ClearHistoryRequest
+	1,  // 13: lession.v1.WatchHistoryService.RecordPlaybackSession:output_type -> lession.v1.RecordPlaybackSessionResponse
+	3,  // 14: lession.v1.WatchHistoryService.FinishPlaybackSession:output_type -> lession.v1.FinishPlaybackSessionResponse
+	5,  // 15: lession.v1.WatchHistoryService.ListHistory:output_type -> lession.v1.ListHistoryResponse
+	7,  // 16: lession.v1.WatchHistoryService.ClearHistory:output_type -> lession.v1.ClearHistoryResponse
+	13, // [13:17] is the sub-list for method output_type
+	9,  // [9:13] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_watch_history_service_proto_init() }
+func file_lession_v1_watch_history_service_proto_init() {
+	if File_lession_v1_watch_history_service_proto != nil {
+		return
+	}
+	file_lession_v1_watch_history_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_watch_history_service_proto_rawDesc), len(file_lession_v1_watch_history_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lession_v1_watch_history_service_proto_goTypes,
+		DependencyIndexes: file_lession_v1_watch_history_service_proto_depIdxs,
+		MessageInfos:      file_lession_v1_watch_history_service_proto_msgTypes,
+	}.Build()
+	File_lession_v1_watch_history_service_proto = out.File
+	file_lession_v1_watch_history_service_proto_goTypes = nil
+	file_lession_v1_watch_history_service_proto_depIdxs = nil
+}
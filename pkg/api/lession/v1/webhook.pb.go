@@ -0,0 +1,693 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: lession/v1/webhook.proto
+
+package lessionv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// WebhookEventType enumerates the events delivered to webhook endpoints.
+//
+// Every payload is a JSON object {"id", "type", "created_at", "data"} where
+// type is the event name in parentheses below. The data objects use these
+// flat records:
+//
+//	series:  {id, slug, title, language, level, published_at}
+//	episode: {id, series_id, seq, title, description, status, duration_seconds, published_at}
+//	asset:   {id, asset_key, type, playback_url, duration_seconds}
+//
+// status is one of draft, ready, published or archived, and asset type is
+// video or audio. Timestamps are RFC 3339 strings.
+type WebhookEventType int32
+
+const (
+	// WEBHOOK_EVENT_TYPE_UNSPECIFIED is the default zero value.
+	WebhookEventType_WEBHOOK_EVENT_TYPE_UNSPECIFIED WebhookEventType = 0
+	// WEBHOOK_EVENT_TYPE_SERIES_PUBLISHED ("series.published") fires when a series is first published; data is {series}.
+	WebhookEventType_WEBHOOK_EVENT_TYPE_SERIES_PUBLISHED WebhookEventType = 1
+	// WEBHOOK_EVENT_TYPE_EPISODE_CREATED ("episode.created") fires when an episode is added to a series; data is {episode}.
+	WebhookEventType_WEBHOOK_EVENT_TYPE_EPISODE_CREATED WebhookEventType = 2
+	// WEBHOOK_EVENT_TYPE_ASSET_READY ("asset.ready") fires when an uploaded asset becomes playable; data is {asset}.
+	WebhookEventType_WEBHOOK_EVENT_TYPE_ASSET_READY WebhookEventType = 3
+	// WEBHOOK_EVENT_TYPE_EPISODE_PUBLISHED ("episode.published") fires when an episode is first published; data is {episode}.
+	WebhookEventType_WEBHOOK_EVENT_TYPE_EPISODE_PUBLISHED WebhookEventType = 4
+)
+
+// Enum value maps for WebhookEventType.
+var (
+	WebhookEventType_name = map[int32]string{
+		0: "WEBHOOK_EVENT_TYPE_UNSPECIFIED",
+		1: "WEBHOOK_EVENT_TYPE_SERIES_PUBLISHED",
+		2: "WEBHOOK_EVENT_TYPE_EPISODE_CREATED",
+		3: "WEBHOOK_EVENT_TYPE_ASSET_READY",
+		4: "WEBHOOK_EVENT_TYPE_EPISODE_PUBLISHED",
+	}
+	WebhookEventType_value = map[string]int32{
+		"WEBHOOK_EVENT_TYPE_UNSPECIFIED":       0,
+		"WEBHOOK_EVENT_TYPE_SERIES_PUBLISHED":  1,
+		"WEBHOOK_EVENT_TYPE_EPISODE_CREATED":   2,
+		"WEBHOOK_EVENT_TYPE_ASSET_READY":       3,
+		"WEBHOOK_EVENT_TYPE_EPISODE_PUBLISHED": 4,
+	}
+)
+
+func (x WebhookEventType) Enum() *WebhookEventType {
+	p := new(WebhookEventType)
+	*p = x
+	return p
+}
+
+func (x WebhookEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_webhook_proto_enumTypes[0].Descriptor()
+}
+
+func (WebhookEventType) Type() protoreflect.EnumType {
+	return &file_lession_v1_webhook_proto_enumTypes[0]
+}
+
+func (x WebhookEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookEventType.Descriptor instead.
+func (WebhookEventType) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_webhook_proto_rawDescGZIP(), []int{0}
+}
+
+// WebhookDeliveryStatus enumerates delivery states.
+type WebhookDeliveryStatus int32
+
+const (
+	// WEBHOOK_DELIVERY_STATUS_UNSPECIFIED is the default zero value.
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED WebhookDeliveryStatus = 0
+	// WEBHOOK_DELIVERY_STATUS_PENDING indicates the delivery has not succeeded yet and will be retried.
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING WebhookDeliveryStatus = 1
+	// WEBHOOK_DELIVERY_STATUS_SUCCEEDED indicates the endpoint answered with a 2xx status.
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED WebhookDeliveryStatus = 2
+	// WEBHOOK_DELIVERY_STATUS_FAILED indicates the delivery exhausted its retries.
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED WebhookDeliveryStatus = 3
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATUS_UNSPECIFIED",
+		1: "WEBHOOK_DELIVERY_STATUS_PENDING",
+		2: "WEBHOOK_DELIVERY_STATUS_SUCCEEDED",
+		3: "WEBHOOK_DELIVERY_STATUS_FAILED",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"WEBHOOK_DELIVERY_STATUS_PENDING":     1,
+		"WEBHOOK_DELIVERY_STATUS_SUCCEEDED":   2,
+		"WEBHOOK_DELIVERY_STATUS_FAILED":      3,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_lession_v1_webhook_proto_enumTypes[1].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_lession_v1_webhook_proto_enumTypes[1]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_lession_v1_webhook_proto_rawDescGZIP(), []int{1}
+}
+
+// WebhookEndpoint is an integrator URL that receives signed content lifecycle events.
+type WebhookEndpoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the server-assigned identifier for the endpoint.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// url receives the event payloads as JSON POST requests.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// description is an optional note about the integration.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// event_types filters the delivered events; empty subscribes to every event.
+	EventTypes []WebhookEventType `protobuf:"varint,4,rep,packed,name=event_types,json=eventTypes,proto3,enum=lession.v1.WebhookEventType" json:"event_types,omitempty"`
+	// secret keys the Lession-Webhook-Signature HMAC; it is only returned when the endpoint is created.
+	Secret string `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
+	// enabled controls whether events are delivered to the endpoint.
+	Enabled bool `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// created_at records when the endpoint was registered.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at records when the endpoint was last changed.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookEndpoint) Reset() {
+	*x = WebhookEndpoint{}
+	mi := &file_lession_v1_webhook_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEndpoint) ProtoMessage() {}
+
+func (x *WebhookEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_webhook_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEndpoint.ProtoReflect.Descriptor instead.
+func (*WebhookEndpoint) Descriptor() ([]byte, []int) {
+	return file_lession_v1_webhook_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WebhookEndpoint) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookEndpoint) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookEndpoint) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WebhookEndpoint) GetEventTypes() []WebhookEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookEndpoint) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookEndpoint) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WebhookEndpoint) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookEndpoint) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// WebhookEndpointDraft contains the user-modifiable endpoint attributes.
+type WebhookEndpointDraft struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// url receives the event payloads; it must be an absolute http(s) URL.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// description is an optional note about the integration.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// event_types filters the delivered events; empty subscribes to every event.
+	EventTypes []WebhookEventType `protobuf:"varint,3,rep,packed,name=event_types,json=eventTypes,proto3,enum=lession.v1.WebhookEventType" json:"event_types,omitempty"`
+	// enabled controls whether events are delivered to the endpoint.
+	Enabled       bool `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookEndpointDraft) Reset() {
+	*x = WebhookEndpointDraft{}
+	mi := &file_lession_v1_webhook_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookEndpointDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEndpointDraft) ProtoMessage() {}
+
+func (x *WebhookEndpointDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_webhook_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEndpointDraft.ProtoReflect.Descriptor instead.
+func (*WebhookEndpointDraft) Descriptor() ([]byte, []int) {
+	return file_lession_v1_webhook_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WebhookEndpointDraft) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookEndpointDraft) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WebhookEndpointDraft) GetEventTypes() []WebhookEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookEndpointDraft) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// WebhookDelivery logs the delivery of one event to one endpoint.
+type WebhookDelivery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id identifies the delivery and is sent as Lession-Webhook-Id, stable across retries.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// endpoint_id identifies the receiving endpoint.
+	EndpointId string `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	// event_id identifies the event; it is shared by the deliveries to every endpoint.
+	EventId string `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// event_type is the delivered event.
+	EventType WebhookEventType `protobuf:"varint,4,opt,name=event_type,json=eventType,proto3,enum=lession.v1.WebhookEventType" json:"event_type,omitempty"`
+	// payload is the JSON body sent to the endpoint.
+	Payload string `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	// status reports delivery progress.
+	Status WebhookDeliveryStatus `protobuf:"varint,6,opt,name=status,proto3,enum=lession.v1.WebhookDeliveryStatus" json:"status,omitempty"`
+	// attempts counts the delivery attempts made so far.
+	Attempts uint32 `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// response_status is the HTTP status of the last attempt, zero when no response was received.
+	ResponseStatus int32 `protobuf:"varint,8,opt,name=response_status,json=responseStatus,proto3" json:"response_status,omitempty"`
+	// error describes why the last attempt failed.
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// next_attempt_at is when a pending delivery is retried.
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	// created_at records when the event was queued for the endpoint.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// delivered_at records when the endpoint accepted the event.
+	DeliveredAt   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_lession_v1_webhook_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_webhook_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_lession_v1_webhook_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() WebhookEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return WebhookEventType_WEBHOOK_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseStatus() int32 {
+	if x != nil {
+		return x.ResponseStatus
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+// WebhookEvent is an event as delivered to webhook endpoints, kept for seven
+// days so automation tools can poll for it.
+type WebhookEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id identifies the event; it is the id of the webhook payload and the event_id of its deliveries.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// type is the event type.
+	Type WebhookEventType `protobuf:"varint,2,opt,name=type,proto3,enum=lession.v1.WebhookEventType" json:"type,omitempty"`
+	// name is the event name used in webhook payloads, e.g. "episode.published".
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// created_at records when the event happened.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// data is the data object of the webhook payload, documented per WebhookEventType.
+	Data          *structpb.Struct `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookEvent) Reset() {
+	*x = WebhookEvent{}
+	mi := &file_lession_v1_webhook_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEvent) ProtoMessage() {}
+
+func (x *WebhookEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_lession_v1_webhook_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEvent.ProtoReflect.Descriptor instead.
+func (*WebhookEvent) Descriptor() ([]byte, []int) {
+	return file_lession_v1_webhook_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WebhookEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetType() WebhookEventType {
+	if x != nil {
+		return x.Type
+	}
+	return WebhookEventType_WEBHOOK_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WebhookEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_lession_v1_webhook_proto protoreflect.FileDescriptor
+
+const file_lession_v1_webhook_proto_rawDesc = "" +
+	"\n" +
+	"\x18lession/v1/webhook.proto\x12\n" +
+	"lession.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbc\x02\n" +
+	"\x0fWebhookEndpoint\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12=\n" +
+	"\vevent_types\x18\x04 \x03(\x0e2\x1c.lession.v1.WebhookEventTypeR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06secret\x18\x05 \x01(\tR\x06secret\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xcd\x01\n" +
+	"\x14WebhookEndpointDraft\x12\x1d\n" +
+	"\x03url\x18\x01 \x01(\tB\v\xbaH\br\x06\x18\x80\x10\x88\x01\x01R\x03url\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x04R\vdescription\x12P\n" +
+	"\vevent_types\x18\x03 \x03(\x0e2\x1c.lession.v1.WebhookEventTypeB\x11\xbaH\x0e\x92\x01\v\x18\x01\"\a\x82\x01\x04\x10\x01 \x00R\n" +
+	"eventTypes\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\"\x88\x04\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vendpoint_id\x18\x02 \x01(\tR\n" +
+	"endpointId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12;\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\x0e2\x1c.lession.v1.WebhookEventTypeR\teventType\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x129\n" +
+	"\x06status\x18\x06 \x01(\x0e2!.lession.v1.WebhookDeliveryStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\a \x01(\rR\battempts\x12'\n" +
+	"\x0fresponse_status\x18\b \x01(\x05R\x0eresponseStatus\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12B\n" +
+	"\x0fnext_attempt_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fdelivered_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\"\xcc\x01\n" +
+	"\fWebhookEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1c.lession.v1.WebhookEventTypeR\x04type\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12+\n" +
+	"\x04data\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x04data*\xd5\x01\n" +
+	"\x10WebhookEventType\x12\"\n" +
+	"\x1eWEBHOOK_EVENT_TYPE_UNSPECIFIED\x10\x00\x12'\n" +
+	"#WEBHOOK_EVENT_TYPE_SERIES_PUBLISHED\x10\x01\x12&\n" +
+	"\"WEBHOOK_EVENT_TYPE_EPISODE_CREATED\x10\x02\x12\"\n" +
+	"\x1eWEBHOOK_EVENT_TYPE_ASSET_READY\x10\x03\x12(\n" +
+	"$WEBHOOK_EVENT_TYPE_EPISODE_PUBLISHED\x10\x04*\xb0\x01\n" +
+	"\x15WebhookDeliveryStatus\x12'\n" +
+	"#WEBHOOK_DELIVERY_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fWEBHOOK_DELIVERY_STATUS_PENDING\x10\x01\x12%\n" +
+	"!WEBHOOK_DELIVERY_STATUS_SUCCEEDED\x10\x02\x12\"\n" +
+	"\x1eWEBHOOK_DELIVERY_STATUS_FAILED\x10\x03B9Z7github.com/eslsoft/lession/pkg/api/lession/v1;lessionv1b\x06proto3"
+
+var (
+	file_lession_v1_webhook_proto_rawDescOnce sync.Once
+	file_lession_v1_webhook_proto_rawDescData []byte
+)
+
+func file_lession_v1_webhook_proto_rawDescGZIP() []byte {
+	file_lession_v1_webhook_proto_rawDescOnce.Do(func() {
+		file_lession_v1_webhook_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lession_v1_webhook_proto_rawDesc), len(file_lession_v1_webhook_proto_rawDesc)))
+	})
+	return file_lession_v1_webhook_proto_rawDescData
+}
+
+var file_lession_v1_webhook_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_lession_v1_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_lession_v1_webhook_proto_goTypes = []any{
+	(WebhookEventType)(0),         // 0: lession.v1.WebhookEventType
+	(WebhookDeliveryStatus)(0),    // 1: lession.v1.WebhookDeliveryStatus
+	(*WebhookEndpoint)(nil),       // 2: lession.v1.WebhookEndpoint
+	(*WebhookEndpointDraft)(nil),  // 3: lession.v1.WebhookEndpointDraft
+	(*WebhookDelivery)(nil),       // 4: lession.v1.WebhookDelivery
+	(*WebhookEvent)(nil),          // 5: lession.v1.WebhookEvent
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),       // 7: google.protobuf.Struct
+}
+var file_lession_v1_webhook_proto_depIdxs = []int32{
+	0,  // 0: lession.v1.WebhookEndpoint.event_types:type_name -> lession.v1.WebhookEventType
+	6,  // 1: lession.v1.WebhookEndpoint.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 2: lession.v1.WebhookEndpoint.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: lession.v1.WebhookEndpointDraft.event_types:type_name -> lession.v1.WebhookEventType
+	0,  // 4: lession.v1.WebhookDelivery.event_type:type_name -> lession.v1.WebhookEventType
+	1,  // 5: lession.v1.WebhookDelivery.status:type_name -> lession.v1.WebhookDeliveryStatus
+	6,  // 6: lession.v1.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	6,  // 7: lession.v1.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 8: lession.v1.WebhookDelivery.delivered_at:type_name -> google.protobuf.Timestamp
+	0,  // 9: lession.v1.WebhookEvent.type:type_name -> lession.v1.WebhookEventType
+	6,  // 10: lession.v1.WebhookEvent.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 11: lession.v1.WebhookEvent.data:type_name -> google.protobuf.Struct
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_lession_v1_webhook_proto_init() }
+func file_lession_v1_webhook_proto_init() {
+	if File_lession_v1_webhook_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lession_v1_webhook_proto_rawDesc), len(file_lession_v1_webhook_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lession_v1_webhook_proto_goTypes,
+		DependencyIndexes: file_lession_v1_webhook_proto_depIdxs,
+		EnumInfos:         file_lession_v1_webhook_proto_enumTypes,
+		MessageInfos:      file_lession_v1_webhook_proto_msgTypes,
+	}.Build()
+	File_lession_v1_webhook_proto = out.File
+	file_lession_v1_webhook_proto_goTypes = nil
+	file_lession_v1_webhook_proto_depIdxs = nil
+}